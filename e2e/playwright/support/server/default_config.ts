@@ -98,6 +98,7 @@ const defaultServerConfig: AdminConfig = {
         MaximumLoginAttempts: 10,
         GoroutineHealthThreshold: -1,
         EnableOAuthServiceProvider: true,
+        EnableOAuthServiceProviderOAuth21: false,
         EnableIncomingWebhooks: true,
         EnableOutgoingWebhooks: true,
         EnableCommands: true,
@@ -225,8 +226,12 @@ const defaultServerConfig: AdminConfig = {
         Trace: false,
         AtRestEncryptKey: '',
         QueryTimeout: 30,
+        SearchQueryTimeout: 30,
+        AnalyticsQueryTimeout: 30,
+        SlowQueryThresholdMilliseconds: 0,
         DisableDatabaseSearch: false,
         MigrationsStatementTimeoutSeconds: 100000,
+        PartitionsPrecreateMonths: 3,
         ReplicaLagSettings: [],
     },
     LogSettings: {
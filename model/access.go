@@ -32,6 +32,15 @@ type AccessResponse struct {
 	IdToken          string `json:"id_token"`
 }
 
+// OAuthRotatedRefreshToken is a refresh token that was exchanged for new tokens. Refresh tokens
+// are single use, so one being presented again means it leaked, and the grant gets revoked.
+type OAuthRotatedRefreshToken struct {
+	RefreshToken string `json:"refresh_token"`
+	ClientId     string `json:"client_id"`
+	UserId       string `json:"user_id"`
+	RotatedAt    int64  `json:"rotated_at"`
+}
+
 // IsValid validates the AccessData and returns an error if it isn't configured
 // correctly.
 func (ad *AccessData) IsValid() *AppError {
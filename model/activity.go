@@ -0,0 +1,139 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	ActivityTypeMention            = "mention"
+	ActivityTypeReaction           = "reaction"
+	ActivityTypeThreadReply        = "thread_reply"
+	ActivityTypePlaybookAssignment = "playbook_assignment"
+
+	// ActivityPropEmojiName is the emoji of a reaction.
+	ActivityPropEmojiName = "emoji_name"
+	// ActivityPropPlaybookRunId is the run a playbook assignment was made in.
+	ActivityPropPlaybookRunId = "playbook_run_id"
+	// ActivityPropMessage describes an activity that isn't about a post.
+	ActivityPropMessage = "message"
+
+	ActivityPropsMaxRunes = 8000
+
+	ActivityFeedDefaultPerPage = 60
+	ActivityFeedMaxPerPage     = 200
+)
+
+var activityTypes = map[string]bool{
+	ActivityTypeMention:            true,
+	ActivityTypeReaction:           true,
+	ActivityTypeThreadReply:        true,
+	ActivityTypePlaybookAssignment: true,
+}
+
+// IsValidActivityType returns whether activities of the given type can be added to the feeds.
+func IsValidActivityType(activityType string) bool {
+	return activityTypes[activityType]
+}
+
+// Activity is something another user did that concerns a user, such as mentioning them or
+// reacting to one of their posts, as listed in their activity feed.
+type Activity struct {
+	Id string `json:"id"`
+	// UserId is the user whose feed the activity is in.
+	UserId string `json:"user_id"`
+	Type   string `json:"type"`
+	// ActorId is the user who did what the activity is about.
+	ActorId   string    `json:"actor_id"`
+	TeamId    string    `json:"team_id"`
+	ChannelId string    `json:"channel_id"`
+	PostId    string    `json:"post_id"`
+	RootId    string    `json:"root_id"`
+	Props     StringMap `json:"props"`
+	CreateAt  int64     `json:"create_at"`
+	ReadAt    int64     `json:"read_at"`
+}
+
+// ActivityFeed is a page of the activity feed of a user, along with the posts the activities are
+// about.
+type ActivityFeed struct {
+	Activities  []*Activity `json:"activities"`
+	Posts       *PostList   `json:"posts"`
+	UnreadCount int64       `json:"unread_count"`
+	HasNext     bool        `json:"has_next"`
+}
+
+type ActivityGetOptions struct {
+	// Types limits the activities to the given types, all of them when empty.
+	Types      []string
+	UnreadOnly bool
+	Page       int
+	PerPage    int
+}
+
+// ActivityReadRequest marks some of the activities of a feed, or all of them, as read.
+type ActivityReadRequest struct {
+	ActivityIds []string `json:"activity_ids"`
+	All         bool     `json:"all"`
+}
+
+func (o *Activity) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("Activity.IsValid", "model.activity.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.UserId) {
+		return NewAppError("Activity.IsValid", "model.activity.is_valid.user_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidActivityType(o.Type) {
+		return NewAppError("Activity.IsValid", "model.activity.is_valid.type.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.ActorId != "" && !IsValidId(o.ActorId) {
+		return NewAppError("Activity.IsValid", "model.activity.is_valid.actor_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.TeamId != "" && !IsValidId(o.TeamId) {
+		return NewAppError("Activity.IsValid", "model.activity.is_valid.team_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.ChannelId != "" && !IsValidId(o.ChannelId) {
+		return NewAppError("Activity.IsValid", "model.activity.is_valid.channel_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.PostId != "" && !IsValidId(o.PostId) {
+		return NewAppError("Activity.IsValid", "model.activity.is_valid.post_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.RootId != "" && !IsValidId(o.RootId) {
+		return NewAppError("Activity.IsValid", "model.activity.is_valid.root_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(MapToJSON(o.Props)) > ActivityPropsMaxRunes {
+		return NewAppError("Activity.IsValid", "model.activity.is_valid.props.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("Activity.IsValid", "model.activity.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *Activity) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.Props == nil {
+		o.Props = StringMap{}
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
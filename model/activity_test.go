@@ -0,0 +1,40 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityIsValid(t *testing.T) {
+	activity := &Activity{
+		UserId:  NewId(),
+		Type:    ActivityTypeReaction,
+		ActorId: NewId(),
+		PostId:  NewId(),
+	}
+	activity.PreSave()
+	require.Nil(t, activity.IsValid())
+	assert.NotNil(t, activity.Props)
+
+	activity.Type = "junk"
+	require.NotNil(t, activity.IsValid())
+	activity.Type = ActivityTypePlaybookAssignment
+
+	activity.PostId = "junk"
+	require.NotNil(t, activity.IsValid())
+	activity.PostId = ""
+	require.Nil(t, activity.IsValid())
+
+	activity.Props[ActivityPropMessage] = strings.Repeat("a", ActivityPropsMaxRunes)
+	require.NotNil(t, activity.IsValid())
+	activity.Props[ActivityPropMessage] = "assigned you a task"
+
+	activity.CreateAt = 0
+	require.NotNil(t, activity.IsValid())
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// ArchivedPost locates a post moved from the database to the post archive: the segment of the
+// archive holding it, along with what the post is looked up by.
+type ArchivedPost struct {
+	PostId    string
+	ChannelId string
+	RootId    string
+	CreateAt  int64
+	DeleteAt  int64
+	Segment   string
+}
+
+// NewArchivedPost returns the location of the post in the given segment of the archive.
+func NewArchivedPost(post *Post, segment string) *ArchivedPost {
+	return &ArchivedPost{
+		PostId:    post.Id,
+		ChannelId: post.ChannelId,
+		RootId:    post.RootId,
+		CreateAt:  post.CreateAt,
+		DeleteAt:  post.DeleteAt,
+		Segment:   segment,
+	}
+}
@@ -4,7 +4,12 @@
 package model
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
 	"net/http"
+	"regexp"
+	"strings"
 )
 
 const (
@@ -12,8 +17,22 @@ const (
 	AuthCodeResponseType = "code"
 	ImplicitResponseType = "token"
 	DefaultScope         = "user"
+
+	// OpenID Connect scopes
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+
+	// PKCE code challenge methods, see RFC 7636
+	CodeChallengeMethodPlain = "plain"
+	CodeChallengeMethodS256  = "S256"
+
+	NonceMaxLength = 256
 )
 
+// Code verifiers and the challenges derived from them are made of 43 to 128 unreserved characters.
+var codeVerifierPattern = regexp.MustCompile(`^[A-Za-z0-9\-._~]{43,128}$`)
+
 type AuthData struct {
 	ClientId    string `json:"client_id"`
 	UserId      string `json:"user_id"`
@@ -23,6 +42,11 @@ type AuthData struct {
 	RedirectUri string `json:"redirect_uri"`
 	State       string `json:"state"`
 	Scope       string `json:"scope"`
+	// CodeChallenge is set when the client uses PKCE, in which case the code can only be
+	// exchanged for a token along with the matching code verifier.
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+	Nonce               string `json:"nonce"`
 }
 
 type AuthorizeRequest struct {
@@ -31,6 +55,11 @@ type AuthorizeRequest struct {
 	RedirectURI  string `json:"redirect_uri"`
 	Scope        string `json:"scope"`
 	State        string `json:"state"`
+	// CodeChallenge and CodeChallengeMethod are sent by clients using PKCE.
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+	// Nonce is passed through to the ID token of OpenID Connect clients.
+	Nonce string `json:"nonce"`
 }
 
 // IsValid validates the AuthData and returns an error if it isn't configured
@@ -69,6 +98,14 @@ func (ad *AuthData) IsValid() *AppError {
 		return NewAppError("AuthData.IsValid", "model.authorize.is_valid.scope.app_error", nil, "client_id="+ad.ClientId, http.StatusBadRequest)
 	}
 
+	if ad.CodeChallenge != "" && !isValidCodeChallenge(ad.CodeChallenge, ad.CodeChallengeMethod) {
+		return NewAppError("AuthData.IsValid", "model.authorize.is_valid.code_challenge.app_error", nil, "client_id="+ad.ClientId, http.StatusBadRequest)
+	}
+
+	if len(ad.Nonce) > NonceMaxLength {
+		return NewAppError("AuthData.IsValid", "model.authorize.is_valid.nonce.app_error", nil, "client_id="+ad.ClientId, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -96,6 +133,18 @@ func (ar *AuthorizeRequest) IsValid() *AppError {
 		return NewAppError("AuthData.IsValid", "model.authorize.is_valid.scope.app_error", nil, "client_id="+ar.ClientId, http.StatusBadRequest)
 	}
 
+	if ar.CodeChallenge != "" {
+		if ar.ResponseType != AuthCodeResponseType || !isValidCodeChallenge(ar.CodeChallenge, ar.CodeChallengeMethod) {
+			return NewAppError("AuthData.IsValid", "model.authorize.is_valid.code_challenge.app_error", nil, "client_id="+ar.ClientId, http.StatusBadRequest)
+		}
+	} else if ar.CodeChallengeMethod != "" {
+		return NewAppError("AuthData.IsValid", "model.authorize.is_valid.code_challenge.app_error", nil, "client_id="+ar.ClientId, http.StatusBadRequest)
+	}
+
+	if len(ar.Nonce) > NonceMaxLength {
+		return NewAppError("AuthData.IsValid", "model.authorize.is_valid.nonce.app_error", nil, "client_id="+ar.ClientId, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -111,8 +160,58 @@ func (ad *AuthData) PreSave() {
 	if ad.Scope == "" {
 		ad.Scope = DefaultScope
 	}
+
+	// The challenge method defaults to plain, see RFC 7636
+	if ad.CodeChallenge != "" && ad.CodeChallengeMethod == "" {
+		ad.CodeChallengeMethod = CodeChallengeMethodPlain
+	}
 }
 
 func (ad *AuthData) IsExpired() bool {
 	return GetMillis() > ad.CreateAt+int64(ad.ExpiresIn*1000)
 }
+
+// VerifyCodeVerifier checks the code verifier sent to exchange the code against the challenge
+// sent to get it. A verifier is only accepted when a challenge was sent, to prevent clients from
+// being downgraded out of PKCE.
+func (ad *AuthData) VerifyCodeVerifier(verifier string) bool {
+	if ad.CodeChallenge == "" {
+		return verifier == ""
+	}
+
+	if !codeVerifierPattern.MatchString(verifier) {
+		return false
+	}
+
+	var challenge string
+	switch ad.CodeChallengeMethod {
+	case CodeChallengeMethodS256:
+		hash := sha256.Sum256([]byte(verifier))
+		challenge = base64.RawURLEncoding.EncodeToString(hash[:])
+	case CodeChallengeMethodPlain, "":
+		challenge = verifier
+	default:
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(challenge), []byte(ad.CodeChallenge)) == 1
+}
+
+func isValidCodeChallenge(challenge, method string) bool {
+	switch method {
+	case CodeChallengeMethodS256, CodeChallengeMethodPlain, "":
+		return codeVerifierPattern.MatchString(challenge)
+	default:
+		return false
+	}
+}
+
+// ScopeContains tells whether the space separated list of scopes contains the given scope.
+func ScopeContains(scopes, scope string) bool {
+	for _, s := range strings.Fields(scopes) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -81,3 +81,69 @@ func TestAuthIsValid(t *testing.T) {
 	ad.RedirectUri = "http://example.com"
 	require.Nil(t, ad.IsValid())
 }
+
+func TestAuthorizeRequestCodeChallenge(t *testing.T) {
+	ar := AuthorizeRequest{
+		ResponseType: AuthCodeResponseType,
+		ClientId:     NewId(),
+		RedirectURI:  "http://example.com",
+	}
+	require.Nil(t, ar.IsValid())
+
+	ar.CodeChallengeMethod = CodeChallengeMethodS256
+	require.NotNil(t, ar.IsValid(), "Should have failed method without challenge")
+
+	ar.CodeChallenge = "short"
+	require.NotNil(t, ar.IsValid(), "Should have failed challenge too short")
+
+	ar.CodeChallenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+	require.Nil(t, ar.IsValid())
+
+	ar.CodeChallengeMethod = "S512"
+	require.NotNil(t, ar.IsValid(), "Should have failed unknown method")
+
+	ar.CodeChallengeMethod = ""
+	require.Nil(t, ar.IsValid())
+
+	ar.ResponseType = ImplicitResponseType
+	require.NotNil(t, ar.IsValid(), "Should have failed challenge with the implicit flow")
+
+	ar.ResponseType = AuthCodeResponseType
+	ar.Nonce = NewRandomString(NonceMaxLength + 1)
+	require.NotNil(t, ar.IsValid(), "Should have failed nonce too long")
+}
+
+func TestAuthVerifyCodeVerifier(t *testing.T) {
+	verifier := "dBjftJeZ4CVP-mJ92K9qHiBI5xqW3_9OMe2ds_6XB3Hh"
+	// BASE64URL(SHA256(verifier))
+	challenge := "_qSw4VBgLvxy28bURR6lnolxtMzN2ZP8Zz6yyjZJAIk"
+
+	t.Run("S256", func(t *testing.T) {
+		ad := AuthData{CodeChallenge: challenge, CodeChallengeMethod: CodeChallengeMethodS256}
+		require.True(t, ad.VerifyCodeVerifier(verifier))
+		require.False(t, ad.VerifyCodeVerifier(challenge))
+		require.False(t, ad.VerifyCodeVerifier(""))
+	})
+
+	t.Run("plain", func(t *testing.T) {
+		ad := AuthData{CodeChallenge: verifier}
+		ad.PreSave()
+		require.Equal(t, CodeChallengeMethodPlain, ad.CodeChallengeMethod)
+		require.True(t, ad.VerifyCodeVerifier(verifier))
+		require.False(t, ad.VerifyCodeVerifier(challenge))
+	})
+
+	t.Run("no challenge", func(t *testing.T) {
+		ad := AuthData{}
+		require.True(t, ad.VerifyCodeVerifier(""))
+		require.False(t, ad.VerifyCodeVerifier(verifier))
+	})
+}
+
+func TestScopeContains(t *testing.T) {
+	require.True(t, ScopeContains("openid profile", ScopeOpenID))
+	require.True(t, ScopeContains("openid  profile", ScopeProfile))
+	require.False(t, ScopeContains("openid profile", ScopeEmail))
+	require.False(t, ScopeContains("openidprofile", ScopeOpenID))
+	require.False(t, ScopeContains("", ScopeOpenID))
+}
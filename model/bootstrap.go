@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	BootstrapSectionUser           = "user"
+	BootstrapSectionPreferences    = "preferences"
+	BootstrapSectionTeams          = "teams"
+	BootstrapSectionTeamMembers    = "team_members"
+	BootstrapSectionChannelMembers = "channel_members"
+	BootstrapSectionCategories     = "categories"
+	BootstrapSectionUnreads        = "unreads"
+	BootstrapSectionLicense        = "license"
+	BootstrapSectionLimits         = "limits"
+)
+
+// Bootstrap is everything a client needs to start up, in one response. Each section has an ETag;
+// a section is left out when the client already has it, that is when the client sent the same
+// ETag for it. Empty sections are left out too, so clients tell them apart by their ETag.
+type Bootstrap struct {
+	User           *User                                `json:"user,omitempty"`
+	Preferences    Preferences                          `json:"preferences,omitempty"`
+	Teams          []*Team                              `json:"teams,omitempty"`
+	TeamMembers    []*TeamMember                        `json:"team_members,omitempty"`
+	ChannelMembers ChannelMembers                       `json:"channel_members,omitempty"`
+	Categories     map[string]*OrderedSidebarCategories `json:"categories,omitempty"`
+	Unreads        []*TeamUnread                        `json:"unreads,omitempty"`
+	License        map[string]string                    `json:"license,omitempty"`
+	// Limits are only set on cloud servers.
+	Limits *ProductLimits `json:"limits,omitempty"`
+
+	ETags map[string]string `json:"etags"`
+}
+
+// BootstrapETag returns the ETag of a bootstrap section.
+func BootstrapETag(section any) (string, error) {
+	b, err := json.Marshal(section)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", md5.Sum(b)), nil
+}
+
+// BootstrapETagsToString encodes the ETags of a bootstrap response to be sent back in the next
+// request.
+func BootstrapETagsToString(etags map[string]string) string {
+	pairs := make([]string, 0, len(etags))
+	for section, etag := range etags {
+		pairs = append(pairs, section+":"+etag)
+	}
+	return strings.Join(pairs, ",")
+}
+
+// ParseBootstrapETags parses the ETags the client sent, as encoded by BootstrapETagsToString.
+// Malformed pairs are ignored as they only make the section be sent again.
+func ParseBootstrapETags(s string) map[string]string {
+	etags := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		section, etag, found := strings.Cut(strings.TrimSpace(pair), ":")
+		if found && section != "" && etag != "" {
+			etags[section] = etag
+		}
+	}
+	return etags
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBootstrapETags(t *testing.T) {
+	etags := map[string]string{
+		BootstrapSectionUser:  "a1",
+		BootstrapSectionTeams: "b2",
+	}
+	assert.Equal(t, etags, ParseBootstrapETags(BootstrapETagsToString(etags)))
+
+	assert.Empty(t, ParseBootstrapETags(""))
+	assert.Equal(t, map[string]string{BootstrapSectionUser: "a1"}, ParseBootstrapETags("user:a1,teams,:b2,unreads:"))
+
+	etag, err := BootstrapETag([]*Team{{Id: "a"}})
+	require.NoError(t, err)
+	same, err := BootstrapETag([]*Team{{Id: "a"}})
+	require.NoError(t, err)
+	other, err := BootstrapETag([]*Team{{Id: "b"}})
+	require.NoError(t, err)
+	assert.Equal(t, etag, same)
+	assert.NotEqual(t, etag, other)
+}
@@ -10,4 +10,8 @@ const ExportDataDir = "data"
 type BulkExportOpts struct {
 	IncludeAttachments bool
 	CreateArchive      bool
+	// Since, when set, restricts the export to the teams, channels, users, posts and emoji created
+	// or updated at or after the given time, in milliseconds. Deletions aren't exported, since the
+	// import format has no way of representing them.
+	Since int64
 }
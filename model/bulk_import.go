@@ -0,0 +1,53 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// BulkImportValidationMaxIssues is the maximum number of errors and of warnings listed in a
+// validation report. The report still counts all of them.
+const BulkImportValidationMaxIssues = 100
+
+// BulkImportValidationIssue is a problem found with a line of the import data.
+type BulkImportValidationIssue struct {
+	LineNumber int    `json:"line_number"`
+	LineType   string `json:"line_type"`
+	Id         string `json:"id"`
+	Message    string `json:"message"`
+}
+
+// BulkImportValidationReport describes the problems found while validating import data without
+// importing it. The import is expected to fail if there are any errors, while warnings point at
+// data that is going to be imported incompletely.
+type BulkImportValidationReport struct {
+	Lines        int                         `json:"lines"`
+	ErrorCount   int                         `json:"error_count"`
+	WarningCount int                         `json:"warning_count"`
+	Errors       []BulkImportValidationIssue `json:"errors"`
+	Warnings     []BulkImportValidationIssue `json:"warnings"`
+}
+
+func NewBulkImportValidationReport() *BulkImportValidationReport {
+	return &BulkImportValidationReport{
+		Errors:   []BulkImportValidationIssue{},
+		Warnings: []BulkImportValidationIssue{},
+	}
+}
+
+func (r *BulkImportValidationReport) AddError(issue BulkImportValidationIssue) {
+	r.ErrorCount++
+	if len(r.Errors) < BulkImportValidationMaxIssues {
+		r.Errors = append(r.Errors, issue)
+	}
+}
+
+func (r *BulkImportValidationReport) AddWarning(issue BulkImportValidationIssue) {
+	r.WarningCount++
+	if len(r.Warnings) < BulkImportValidationMaxIssues {
+		r.Warnings = append(r.Warnings, issue)
+	}
+}
+
+// HasErrors reports whether the import is expected to fail.
+func (r *BulkImportValidationReport) HasErrors() bool {
+	return r.ErrorCount > 0
+}
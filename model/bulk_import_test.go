@@ -0,0 +1,26 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkImportValidationReport(t *testing.T) {
+	report := NewBulkImportValidationReport()
+	assert.False(t, report.HasErrors())
+
+	for i := 0; i < BulkImportValidationMaxIssues+5; i++ {
+		report.AddError(BulkImportValidationIssue{LineNumber: i + 1})
+	}
+	report.AddWarning(BulkImportValidationIssue{LineNumber: 1})
+
+	assert.True(t, report.HasErrors())
+	assert.Equal(t, BulkImportValidationMaxIssues+5, report.ErrorCount)
+	assert.Len(t, report.Errors, BulkImportValidationMaxIssues)
+	assert.Equal(t, 1, report.WarningCount)
+	assert.Len(t, report.Warnings, 1)
+}
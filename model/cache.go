@@ -0,0 +1,13 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// CacheInfo describes a cache of the node serving the request.
+type CacheInfo struct {
+	Name string `json:"name"`
+	// Len is the number of items in the cache.
+	Len int `json:"len"`
+	// Shared is true when the cache is shared by the nodes of the cluster.
+	Shared bool `json:"shared"`
+}
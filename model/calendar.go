@@ -0,0 +1,172 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	OAuthConnectionTokenMaxLength = 4096
+
+	// CalendarStatusSyncInterval is how often, in minutes, the calendars of the users who opted in
+	// are checked for meetings.
+	CalendarStatusSyncInterval = 5
+)
+
+// OAuthConnection holds the tokens a user granted the server to access an external service on
+// their behalf, through the OAuth app configured for that service in the SSO settings.
+type OAuthConnection struct {
+	UserId       string `json:"user_id"`
+	Service      string `json:"service"`
+	AccessToken  string `json:"access_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresAt    int64  `json:"expires_at"`
+	CreateAt     int64  `json:"create_at"`
+	UpdateAt     int64  `json:"update_at"`
+}
+
+func (o *OAuthConnection) IsValid() *AppError {
+	if !IsValidId(o.UserId) {
+		return NewAppError("OAuthConnection.IsValid", "model.oauth_connection.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidCalendarService(o.Service) {
+		return NewAppError("OAuthConnection.IsValid", "model.oauth_connection.is_valid.service.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if o.AccessToken == "" || len(o.AccessToken) > OAuthConnectionTokenMaxLength || len(o.RefreshToken) > OAuthConnectionTokenMaxLength {
+		return NewAppError("OAuthConnection.IsValid", "model.oauth_connection.is_valid.token.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 || o.UpdateAt == 0 {
+		return NewAppError("OAuthConnection.IsValid", "model.oauth_connection.is_valid.create_at.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *OAuthConnection) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+	o.UpdateAt = o.CreateAt
+}
+
+func (o *OAuthConnection) PreUpdate() {
+	o.UpdateAt = GetMillis()
+}
+
+// IsExpired tells whether the access token has expired and has to be refreshed before use.
+func (o *OAuthConnection) IsExpired() bool {
+	return o.ExpiresAt > 0 && GetMillis() > o.ExpiresAt
+}
+
+// Sanitize removes the tokens, which are never sent back to clients.
+func (o *OAuthConnection) Sanitize() {
+	o.AccessToken = ""
+	o.RefreshToken = ""
+}
+
+func (o *OAuthConnection) Auditable() map[string]any {
+	return map[string]any{
+		"user_id":    o.UserId,
+		"service":    o.Service,
+		"expires_at": o.ExpiresAt,
+		"create_at":  o.CreateAt,
+		"update_at":  o.UpdateAt,
+	}
+}
+
+// IsValidCalendarService tells whether free/busy data can be read from the calendars of the service.
+func IsValidCalendarService(service string) bool {
+	return service == ServiceGoogle || service == ServiceOffice365
+}
+
+// CalendarBusyPeriod is a period during which a user is busy in their calendar. Start and End are
+// in milliseconds.
+type CalendarBusyPeriod struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+// CalendarStatusSync holds the opt-in of a user to have their status set automatically while they
+// are in a meeting. It's stored as JSON in the PreferenceNameCalendarStatusSync preference.
+type CalendarStatusSync struct {
+	// Service is the calendar the meetings are read from.
+	Service string `json:"service"`
+	// SetDnd sets the status of the user to Do Not Disturb until the meeting ends.
+	SetDnd bool `json:"set_dnd"`
+	// Emoji and Text are the custom status set until the meeting ends. None is set when Text is empty.
+	Emoji string `json:"emoji,omitempty"`
+	Text  string `json:"text,omitempty"`
+}
+
+func CalendarStatusSyncFromJSON(data string) (*CalendarStatusSync, error) {
+	var sync CalendarStatusSync
+	if err := json.Unmarshal([]byte(data), &sync); err != nil {
+		return nil, err
+	}
+	if err := sync.IsValid(); err != nil {
+		return nil, err
+	}
+	return &sync, nil
+}
+
+func (s *CalendarStatusSync) IsValid() error {
+	if !IsValidCalendarService(s.Service) {
+		return fmt.Errorf("invalid calendar service %q", s.Service)
+	}
+	if !s.SetDnd && s.Text == "" {
+		return fmt.Errorf("neither a Do Not Disturb status nor a custom status is set")
+	}
+	if utf8.RuneCountInString(s.Text) > CustomStatusTextMaxRunes {
+		return fmt.Errorf("custom status text is longer than %d characters", CustomStatusTextMaxRunes)
+	}
+	return nil
+}
+
+// CustomStatus returns the custom status to set during a meeting ending at end, in milliseconds.
+func (s *CalendarStatusSync) CustomStatus(end int64) *CustomStatus {
+	if s.Text == "" {
+		return nil
+	}
+	cs := &CustomStatus{
+		Emoji:     s.Emoji,
+		Text:      s.Text,
+		ExpiresAt: GetTimeForMillis(end),
+	}
+	cs.PreSave()
+	return cs
+}
+
+// CurrentCalendarBusyPeriod returns the period the given time, in milliseconds, is in. Overlapping
+// and adjacent periods are merged so that the status lasts until the last of back-to-back meetings
+// ends.
+func CurrentCalendarBusyPeriod(periods []*CalendarBusyPeriod, now int64) *CalendarBusyPeriod {
+	var current *CalendarBusyPeriod
+	for extended := true; extended; {
+		extended = false
+		for _, period := range periods {
+			if period == nil || period.End <= period.Start {
+				continue
+			}
+			if current == nil {
+				if period.Start <= now && now < period.End {
+					current = &CalendarBusyPeriod{Start: period.Start, End: period.End}
+					extended = true
+				}
+				continue
+			}
+			if period.Start <= current.End && period.End > current.End {
+				current.End = period.End
+				extended = true
+			}
+		}
+	}
+	return current
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthConnectionIsValid(t *testing.T) {
+	connection := &OAuthConnection{UserId: NewId(), Service: ServiceGoogle, AccessToken: "token"}
+	connection.PreSave()
+	require.Nil(t, connection.IsValid())
+
+	connection.Service = ServiceGitlab
+	require.NotNil(t, connection.IsValid())
+	connection.Service = ServiceOffice365
+
+	connection.AccessToken = strings.Repeat("a", OAuthConnectionTokenMaxLength+1)
+	require.NotNil(t, connection.IsValid())
+	connection.AccessToken = ""
+	require.NotNil(t, connection.IsValid())
+
+	connection.AccessToken = "token"
+	connection.RefreshToken = "refresh"
+	connection.Sanitize()
+	assert.Empty(t, connection.AccessToken)
+	assert.Empty(t, connection.RefreshToken)
+
+	assert.False(t, (&OAuthConnection{}).IsExpired())
+	assert.True(t, (&OAuthConnection{ExpiresAt: GetMillis() - 1000}).IsExpired())
+}
+
+func TestCalendarStatusSyncFromJSON(t *testing.T) {
+	sync, err := CalendarStatusSyncFromJSON(`{"service":"google","set_dnd":true,"emoji":"calendar","text":"In a meeting"}`)
+	require.NoError(t, err)
+	assert.Equal(t, &CalendarStatusSync{Service: ServiceGoogle, SetDnd: true, Emoji: "calendar", Text: "In a meeting"}, sync)
+
+	for _, value := range []string{
+		"garbage",
+		`{"service":"gitlab","set_dnd":true}`,
+		`{"service":"google"}`,
+		`{"service":"google","text":"` + strings.Repeat("a", CustomStatusTextMaxRunes+1) + `"}`,
+	} {
+		_, err := CalendarStatusSyncFromJSON(value)
+		assert.Error(t, err, value)
+	}
+
+	end := GetMillis() + 3600000
+	cs := sync.CustomStatus(end)
+	require.NotNil(t, cs)
+	assert.Equal(t, "In a meeting", cs.Text)
+	assert.Equal(t, end, GetMillisForTime(cs.ExpiresAt))
+	assert.True(t, cs.AreDurationAndExpirationTimeValid())
+	assert.Nil(t, (&CalendarStatusSync{Service: ServiceGoogle, SetDnd: true}).CustomStatus(end))
+}
+
+func TestCurrentCalendarBusyPeriod(t *testing.T) {
+	periods := []*CalendarBusyPeriod{
+		{Start: 100, End: 200},
+		{Start: 300, End: 400},
+		{Start: 400, End: 500},
+		{Start: 450, End: 600},
+		{Start: 700, End: 650},
+	}
+
+	assert.Nil(t, CurrentCalendarBusyPeriod(periods, 50))
+	assert.Equal(t, &CalendarBusyPeriod{Start: 100, End: 200}, CurrentCalendarBusyPeriod(periods, 150))
+	assert.Nil(t, CurrentCalendarBusyPeriod(periods, 200))
+	assert.Equal(t, &CalendarBusyPeriod{Start: 300, End: 600}, CurrentCalendarBusyPeriod(periods, 350))
+	assert.Nil(t, CurrentCalendarBusyPeriod(periods, 680))
+	assert.Nil(t, CurrentCalendarBusyPeriod(nil, 150))
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package calendargoogle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
+)
+
+const defaultFreeBusyURL = "https://www.googleapis.com/calendar/v3/freeBusy"
+
+// GoogleCalendarProvider reads the free/busy data of the primary Google calendar of users.
+type GoogleCalendarProvider struct {
+	FreeBusyURL string
+	HTTPClient  *http.Client
+}
+
+type freeBusyRequest struct {
+	TimeMin string         `json:"timeMin"`
+	TimeMax string         `json:"timeMax"`
+	Items   []freeBusyItem `json:"items"`
+}
+
+type freeBusyItem struct {
+	Id string `json:"id"`
+}
+
+type freeBusyResponse struct {
+	Calendars map[string]struct {
+		Busy []struct {
+			Start time.Time `json:"start"`
+			End   time.Time `json:"end"`
+		} `json:"busy"`
+		Errors []struct {
+			Reason string `json:"reason"`
+		} `json:"errors"`
+	} `json:"calendars"`
+}
+
+func init() {
+	einterfaces.RegisterCalendarProvider(model.ServiceGoogle, &GoogleCalendarProvider{
+		FreeBusyURL: defaultFreeBusyURL,
+		HTTPClient:  http.DefaultClient,
+	})
+}
+
+func (p *GoogleCalendarProvider) GetBusyPeriods(ctx context.Context, connection *model.OAuthConnection, start, end int64) ([]*model.CalendarBusyPeriod, error) {
+	body, err := json.Marshal(freeBusyRequest{
+		TimeMin: model.GetTimeForMillis(start).UTC().Format(time.RFC3339),
+		TimeMax: model.GetTimeForMillis(end).UTC().Format(time.RFC3339),
+		Items:   []freeBusyItem{{Id: "primary"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.FreeBusyURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+connection.AccessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("free/busy request failed with status %d", resp.StatusCode)
+	}
+
+	var data freeBusyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	calendar, ok := data.Calendars["primary"]
+	if !ok {
+		return nil, fmt.Errorf("no free/busy data for the primary calendar")
+	}
+	if len(calendar.Errors) > 0 {
+		return nil, fmt.Errorf("free/busy request failed: %s", calendar.Errors[0].Reason)
+	}
+
+	periods := make([]*model.CalendarBusyPeriod, 0, len(calendar.Busy))
+	for _, busy := range calendar.Busy {
+		periods = append(periods, &model.CalendarBusyPeriod{
+			Start: model.GetMillisForTime(busy.Start),
+			End:   model.GetMillisForTime(busy.End),
+		})
+	}
+	return periods, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package calendargoogle
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetBusyPeriods(t *testing.T) {
+	start := time.Date(2023, time.March, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(12 * time.Hour)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		var req freeBusyRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "2023-03-01T09:00:00Z", req.TimeMin)
+		assert.Equal(t, "2023-03-01T21:00:00Z", req.TimeMax)
+		assert.Equal(t, []freeBusyItem{{Id: "primary"}}, req.Items)
+
+		if r.URL.Query().Get("fail") != "" {
+			w.Write([]byte(`{"calendars":{"primary":{"errors":[{"reason":"notFound"}]}}}`))
+			return
+		}
+		w.Write([]byte(`{"calendars":{"primary":{"busy":[{"start":"2023-03-01T10:00:00Z","end":"2023-03-01T11:30:00+01:00"}]}}}`))
+	}))
+	defer server.Close()
+
+	provider := &GoogleCalendarProvider{FreeBusyURL: server.URL, HTTPClient: server.Client()}
+	connection := &model.OAuthConnection{AccessToken: "token"}
+
+	periods, err := provider.GetBusyPeriods(context.Background(), connection, model.GetMillisForTime(start), model.GetMillisForTime(end))
+	require.NoError(t, err)
+	require.Len(t, periods, 1)
+	assert.Equal(t, model.GetMillisForTime(start.Add(time.Hour)), periods[0].Start)
+	assert.Equal(t, model.GetMillisForTime(start.Add(90*time.Minute)), periods[0].End)
+
+	provider.FreeBusyURL = server.URL + "?fail=1"
+	_, err = provider.GetBusyPeriods(context.Background(), connection, model.GetMillisForTime(start), model.GetMillisForTime(end))
+	require.Error(t, err)
+}
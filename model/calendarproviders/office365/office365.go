@@ -0,0 +1,114 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package calendaroffice365
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
+)
+
+const (
+	defaultCalendarViewURL = "https://graph.microsoft.com/v1.0/me/calendarView"
+
+	// graphDateTimeLayout is the layout of the dates returned by Microsoft Graph, which have no
+	// timezone. They are in UTC as requested in the Prefer header.
+	graphDateTimeLayout = "2006-01-02T15:04:05.9999999"
+)
+
+// Office365CalendarProvider reads the events of the default Outlook calendar of users through
+// Microsoft Graph.
+type Office365CalendarProvider struct {
+	CalendarViewURL string
+	HTTPClient      *http.Client
+}
+
+type graphDateTime struct {
+	DateTime string `json:"dateTime"`
+}
+
+type calendarViewResponse struct {
+	Value []struct {
+		ShowAs string        `json:"showAs"`
+		Start  graphDateTime `json:"start"`
+		End    graphDateTime `json:"end"`
+	} `json:"value"`
+	NextLink string `json:"@odata.nextLink"`
+}
+
+func init() {
+	einterfaces.RegisterCalendarProvider(model.ServiceOffice365, &Office365CalendarProvider{
+		CalendarViewURL: defaultCalendarViewURL,
+		HTTPClient:      http.DefaultClient,
+	})
+}
+
+func (p *Office365CalendarProvider) GetBusyPeriods(ctx context.Context, connection *model.OAuthConnection, start, end int64) ([]*model.CalendarBusyPeriod, error) {
+	query := url.Values{}
+	query.Set("startDateTime", model.GetTimeForMillis(start).UTC().Format(time.RFC3339))
+	query.Set("endDateTime", model.GetTimeForMillis(end).UTC().Format(time.RFC3339))
+	query.Set("$select", "showAs,start,end")
+	next := p.CalendarViewURL + "?" + query.Encode()
+
+	periods := []*model.CalendarBusyPeriod{}
+	for next != "" {
+		data, err := p.getCalendarView(ctx, connection, next)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range data.Value {
+			// free and workingElsewhere events don't make the user busy
+			if event.ShowAs != "busy" && event.ShowAs != "oof" {
+				continue
+			}
+
+			eventStart, err := time.Parse(graphDateTimeLayout, event.Start.DateTime)
+			if err != nil {
+				return nil, err
+			}
+			eventEnd, err := time.Parse(graphDateTimeLayout, event.End.DateTime)
+			if err != nil {
+				return nil, err
+			}
+			periods = append(periods, &model.CalendarBusyPeriod{
+				Start: model.GetMillisForTime(eventStart),
+				End:   model.GetMillisForTime(eventEnd),
+			})
+		}
+		next = data.NextLink
+	}
+	return periods, nil
+}
+
+func (p *Office365CalendarProvider) getCalendarView(ctx context.Context, connection *model.OAuthConnection, viewURL string) (*calendarViewResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, viewURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+connection.AccessToken)
+	req.Header.Set("Prefer", `outlook.timezone="UTC"`)
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("calendar view request failed with status %d", resp.StatusCode)
+	}
+
+	var data calendarViewResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package calendaroffice365
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetBusyPeriods(t *testing.T) {
+	start := time.Date(2023, time.March, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(12 * time.Hour)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, `outlook.timezone="UTC"`, r.Header.Get("Prefer"))
+
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"value":[{"showAs":"oof","start":{"dateTime":"2023-03-01T14:00:00.0000000"},"end":{"dateTime":"2023-03-01T15:00:00.0000000"}}]}`))
+			return
+		}
+
+		assert.Equal(t, "2023-03-01T09:00:00Z", r.URL.Query().Get("startDateTime"))
+		assert.Equal(t, "2023-03-01T21:00:00Z", r.URL.Query().Get("endDateTime"))
+		w.Write([]byte(`{"value":[
+			{"showAs":"busy","start":{"dateTime":"2023-03-01T10:00:00.0000000"},"end":{"dateTime":"2023-03-01T11:00:00.0000000"}},
+			{"showAs":"free","start":{"dateTime":"2023-03-01T12:00:00.0000000"},"end":{"dateTime":"2023-03-01T13:00:00.0000000"}}
+		],"@odata.nextLink":"` + server.URL + `?page=2"}`))
+	}))
+	defer server.Close()
+
+	provider := &Office365CalendarProvider{CalendarViewURL: server.URL, HTTPClient: server.Client()}
+	connection := &model.OAuthConnection{AccessToken: "token"}
+
+	periods, err := provider.GetBusyPeriods(context.Background(), connection, model.GetMillisForTime(start), model.GetMillisForTime(end))
+	require.NoError(t, err)
+	assert.Equal(t, []*model.CalendarBusyPeriod{
+		{Start: model.GetMillisForTime(start.Add(time.Hour)), End: model.GetMillisForTime(start.Add(2 * time.Hour))},
+		{Start: model.GetMillisForTime(start.Add(5 * time.Hour)), End: model.GetMillisForTime(start.Add(6 * time.Hour))},
+	}, periods)
+}
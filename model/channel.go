@@ -60,6 +60,10 @@ type Channel struct {
 	TotalMsgCountRoot int64          `json:"total_msg_count_root"`
 	PolicyID          *string        `json:"policy_id"`
 	LastRootPostAt    int64          `json:"last_root_post_at"`
+
+	// DefaultNotifyProps are the notification settings set by the channel admins for the members
+	// who haven't chosen their own.
+	DefaultNotifyProps StringMap `json:"default_notify_props,omitempty"`
 }
 
 func (o *Channel) Auditable() map[string]interface{} {
@@ -291,6 +295,10 @@ func (o *Channel) IsValid() *AppError {
 		return NewAppError("Channel.IsValid", "model.channel.is_valid.creator_id.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if err := IsValidChannelNotifyDefaults(o.DefaultNotifyProps); err != nil {
+		return err
+	}
+
 	if o.Type != ChannelTypeDirect && o.Type != ChannelTypeGroup {
 		userIds := strings.Split(o.Name, "__")
 		if ok := gmNameRegex.MatchString(o.Name); ok || (o.Type != ChannelTypeDirect && len(userIds) == 2 && IsValidId(userIds[0]) && IsValidId(userIds[1])) {
@@ -0,0 +1,142 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+type ChannelBookmarkType string
+
+const (
+	ChannelBookmarkLink ChannelBookmarkType = "link"
+	ChannelBookmarkFile ChannelBookmarkType = "file"
+
+	ChannelBookmarkDisplayNameMaxRunes = 64
+	ChannelBookmarkLinkURLMaxLength    = 1024
+	ChannelBookmarksMaxPerChannel      = 50
+)
+
+// ChannelBookmark is a link or a file bookmarked at the top of a channel. The bookmarks of a
+// channel are ordered by their SortOrder.
+type ChannelBookmark struct {
+	Id        string `json:"id"`
+	CreateAt  int64  `json:"create_at"`
+	UpdateAt  int64  `json:"update_at"`
+	DeleteAt  int64  `json:"delete_at"`
+	ChannelId string `json:"channel_id"`
+	// OwnerId is the user who added the bookmark. It's empty for bookmarks added by a bulk import.
+	OwnerId     string              `json:"owner_id"`
+	FileId      string              `json:"file_id,omitempty"`
+	DisplayName string              `json:"display_name"`
+	SortOrder   int64               `json:"sort_order"`
+	LinkUrl     string              `json:"link_url,omitempty"`
+	Emoji       string              `json:"emoji,omitempty"`
+	Type        ChannelBookmarkType `json:"type"`
+}
+
+// ChannelBookmarkWithFileInfo is a bookmark along with the file it bookmarks, if any.
+type ChannelBookmarkWithFileInfo struct {
+	*ChannelBookmark
+	FileInfo *FileInfo `json:"file,omitempty"`
+}
+
+type ChannelBookmarkPatch struct {
+	DisplayName *string `json:"display_name"`
+	LinkUrl     *string `json:"link_url"`
+	FileId      *string `json:"file_id"`
+	Emoji       *string `json:"emoji"`
+}
+
+func (o *ChannelBookmark) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 || o.UpdateAt == 0 {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.channel_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.OwnerId != "" && !IsValidId(o.OwnerId) {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.owner_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if strings.TrimSpace(o.DisplayName) == "" || utf8.RuneCountInString(o.DisplayName) > ChannelBookmarkDisplayNameMaxRunes {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.display_name.app_error", map[string]any{"Max": ChannelBookmarkDisplayNameMaxRunes}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.Emoji != "" && (len(o.Emoji) > EmojiNameMaxLength || !IsValidAlphaNumHyphenUnderscorePlus(o.Emoji)) {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.emoji.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	switch o.Type {
+	case ChannelBookmarkLink:
+		if o.FileId != "" || len(o.LinkUrl) > ChannelBookmarkLinkURLMaxLength || !IsValidHTTPURL(o.LinkUrl) {
+			return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.link_url.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	case ChannelBookmarkFile:
+		if o.LinkUrl != "" || !IsValidId(o.FileId) {
+			return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.file_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.type.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *ChannelBookmark) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+	o.DisplayName = strings.TrimSpace(o.DisplayName)
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+	o.DeleteAt = 0
+}
+
+func (o *ChannelBookmark) PreUpdate() {
+	o.DisplayName = strings.TrimSpace(o.DisplayName)
+	o.UpdateAt = GetMillis()
+}
+
+func (o *ChannelBookmark) Patch(patch *ChannelBookmarkPatch) {
+	if patch.DisplayName != nil {
+		o.DisplayName = *patch.DisplayName
+	}
+	if patch.Emoji != nil {
+		o.Emoji = *patch.Emoji
+	}
+	// the type of a bookmark doesn't change, so only its link or its file is patched
+	if patch.LinkUrl != nil && o.Type == ChannelBookmarkLink {
+		o.LinkUrl = *patch.LinkUrl
+	}
+	if patch.FileId != nil && o.Type == ChannelBookmarkFile {
+		o.FileId = *patch.FileId
+	}
+}
+
+func (o *ChannelBookmark) Clone() *ChannelBookmark {
+	bookmark := *o
+	return &bookmark
+}
+
+func (o *ChannelBookmark) Auditable() map[string]any {
+	return map[string]any{
+		"id":         o.Id,
+		"create_at":  o.CreateAt,
+		"update_at":  o.UpdateAt,
+		"delete_at":  o.DeleteAt,
+		"channel_id": o.ChannelId,
+		"owner_id":   o.OwnerId,
+		"file_id":    o.FileId,
+		"type":       o.Type,
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelBookmarkIsValid(t *testing.T) {
+	bookmark := &ChannelBookmark{
+		ChannelId:   NewId(),
+		OwnerId:     NewId(),
+		DisplayName: " Docs ",
+		LinkUrl:     "https://docs.mattermost.com",
+		Emoji:       "books",
+		Type:        ChannelBookmarkLink,
+	}
+	bookmark.PreSave()
+	require.Nil(t, bookmark.IsValid())
+	assert.Equal(t, "Docs", bookmark.DisplayName)
+
+	t.Run("display name", func(t *testing.T) {
+		b := bookmark.Clone()
+		b.DisplayName = strings.Repeat("a", ChannelBookmarkDisplayNameMaxRunes+1)
+		require.NotNil(t, b.IsValid())
+		b.DisplayName = ""
+		require.NotNil(t, b.IsValid())
+	})
+
+	t.Run("link", func(t *testing.T) {
+		b := bookmark.Clone()
+		b.LinkUrl = "docs.mattermost.com"
+		require.NotNil(t, b.IsValid())
+		b.LinkUrl = "https://docs.mattermost.com"
+		b.FileId = NewId()
+		require.NotNil(t, b.IsValid(), "link bookmarks can't have a file")
+	})
+
+	t.Run("file", func(t *testing.T) {
+		b := bookmark.Clone()
+		b.Type = ChannelBookmarkFile
+		require.NotNil(t, b.IsValid(), "file bookmarks can't have a link")
+		b.LinkUrl = ""
+		require.NotNil(t, b.IsValid())
+		b.FileId = NewId()
+		require.Nil(t, b.IsValid())
+	})
+
+	t.Run("emoji", func(t *testing.T) {
+		b := bookmark.Clone()
+		b.Emoji = "not an emoji"
+		require.NotNil(t, b.IsValid())
+	})
+
+	t.Run("type", func(t *testing.T) {
+		b := bookmark.Clone()
+		b.Type = "folder"
+		require.NotNil(t, b.IsValid())
+	})
+
+	t.Run("imported without owner", func(t *testing.T) {
+		b := bookmark.Clone()
+		b.OwnerId = ""
+		require.Nil(t, b.IsValid())
+	})
+}
+
+func TestChannelBookmarkPatch(t *testing.T) {
+	bookmark := &ChannelBookmark{
+		DisplayName: "Docs",
+		LinkUrl:     "https://docs.mattermost.com",
+		Type:        ChannelBookmarkLink,
+	}
+
+	bookmark.Patch(&ChannelBookmarkPatch{
+		DisplayName: NewString("Handbook"),
+		LinkUrl:     NewString("https://handbook.mattermost.com"),
+		FileId:      NewString(NewId()),
+		Emoji:       NewString("books"),
+	})
+	assert.Equal(t, "Handbook", bookmark.DisplayName)
+	assert.Equal(t, "https://handbook.mattermost.com", bookmark.LinkUrl)
+	assert.Equal(t, "books", bookmark.Emoji)
+	assert.Empty(t, bookmark.FileId, "the type of a bookmark can't change")
+}
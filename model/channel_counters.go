@@ -0,0 +1,15 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// ChannelCounters are the counts of a channel maintained as its members and pinned posts change,
+// for its stats to be read without counting its members. They're reconciled periodically with
+// the counts of the members and posts, to fix the drift of the changes not maintaining them.
+type ChannelCounters struct {
+	ChannelId       string `json:"channel_id"`
+	MemberCount     int64  `json:"member_count"`
+	GuestCount      int64  `json:"guest_count"`
+	PinnedPostCount int64  `json:"pinnedpost_count"`
+	UpdateAt        int64  `json:"update_at"`
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	ChannelJoinRequestStatusPending  = "pending"
+	ChannelJoinRequestStatusApproved = "approved"
+	ChannelJoinRequestStatusDenied   = "denied"
+
+	ChannelJoinRequestMessageMaxRunes = 1024
+)
+
+// ChannelJoinRequest is the request of a user to be added to a private channel, which the
+// channel admins approve or deny.
+type ChannelJoinRequest struct {
+	Id        string `json:"id"`
+	ChannelId string `json:"channel_id"`
+	UserId    string `json:"user_id"`
+	Message   string `json:"message"`
+	Status    string `json:"status"`
+	DeciderId string `json:"decider_id"`
+	PostId    string `json:"post_id"`
+	CreateAt  int64  `json:"create_at"`
+	UpdateAt  int64  `json:"update_at"`
+}
+
+func (o *ChannelJoinRequest) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         o.Id,
+		"channel_id": o.ChannelId,
+		"user_id":    o.UserId,
+		"status":     o.Status,
+		"decider_id": o.DeciderId,
+		"post_id":    o.PostId,
+		"create_at":  o.CreateAt,
+		"update_at":  o.UpdateAt,
+	}
+}
+
+func (o *ChannelJoinRequest) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("ChannelJoinRequest.IsValid", "model.channel_join_request.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("ChannelJoinRequest.IsValid", "model.channel_join_request.is_valid.channel_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.UserId) {
+		return NewAppError("ChannelJoinRequest.IsValid", "model.channel_join_request.is_valid.user_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.Message) > ChannelJoinRequestMessageMaxRunes {
+		return NewAppError("ChannelJoinRequest.IsValid", "model.channel_join_request.is_valid.message.app_error", map[string]any{"MaxLength": ChannelJoinRequestMessageMaxRunes}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	switch o.Status {
+	case ChannelJoinRequestStatusPending:
+		if o.DeciderId != "" {
+			return NewAppError("ChannelJoinRequest.IsValid", "model.channel_join_request.is_valid.decider_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	case ChannelJoinRequestStatusApproved, ChannelJoinRequestStatusDenied:
+		if !IsValidId(o.DeciderId) {
+			return NewAppError("ChannelJoinRequest.IsValid", "model.channel_join_request.is_valid.decider_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("ChannelJoinRequest.IsValid", "model.channel_join_request.is_valid.status.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.PostId != "" && !IsValidId(o.PostId) {
+		return NewAppError("ChannelJoinRequest.IsValid", "model.channel_join_request.is_valid.post_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("ChannelJoinRequest.IsValid", "model.channel_join_request.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("ChannelJoinRequest.IsValid", "model.channel_join_request.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *ChannelJoinRequest) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.Status == "" {
+		o.Status = ChannelJoinRequestStatusPending
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+}
+
+func (o *ChannelJoinRequest) PreUpdate() {
+	o.UpdateAt = GetMillis()
+}
+
+// IsPending returns whether the channel admins have yet to decide on the request.
+func (o *ChannelJoinRequest) IsPending() bool {
+	return o.Status == ChannelJoinRequestStatusPending
+}
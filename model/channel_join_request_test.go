@@ -0,0 +1,47 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelJoinRequestIsValid(t *testing.T) {
+	request := &ChannelJoinRequest{
+		ChannelId: NewId(),
+		UserId:    NewId(),
+		Message:   "let me in",
+	}
+	request.PreSave()
+	require.Equal(t, ChannelJoinRequestStatusPending, request.Status)
+	require.Nil(t, request.IsValid())
+
+	request.Message = strings.Repeat("a", ChannelJoinRequestMessageMaxRunes+1)
+	require.NotNil(t, request.IsValid())
+	request.Message = ""
+
+	request.DeciderId = NewId()
+	require.NotNil(t, request.IsValid(), "a pending request has no decider")
+
+	request.Status = ChannelJoinRequestStatusApproved
+	require.Nil(t, request.IsValid())
+
+	request.DeciderId = ""
+	require.NotNil(t, request.IsValid(), "a decided request has a decider")
+	request.DeciderId = NewId()
+
+	request.Status = "unknown"
+	require.NotNil(t, request.IsValid())
+	request.Status = ChannelJoinRequestStatusDenied
+
+	request.PostId = "invalid"
+	require.NotNil(t, request.IsValid())
+	request.PostId = ""
+
+	request.UserId = "invalid"
+	require.NotNil(t, request.IsValid())
+}
@@ -232,3 +232,64 @@ func GetDefaultChannelNotifyProps() StringMap {
 		IgnoreChannelMentionsNotifyProp: IgnoreChannelMentionsDefault,
 	}
 }
+
+// IsValidChannelNotifyDefaults checks the notification settings set by the admins of a channel.
+func IsValidChannelNotifyDefaults(props StringMap) *AppError {
+	for key, value := range props {
+		var valid bool
+		switch key {
+		case DesktopNotifyProp, PushNotifyProp:
+			valid = IsChannelNotifyLevelValid(value)
+		case EmailNotifyProp:
+			valid = IsSendEmailValid(value)
+		case MarkUnreadNotifyProp:
+			valid = IsChannelMarkUnreadLevelValid(value)
+		case IgnoreChannelMentionsNotifyProp:
+			valid = IsIgnoreChannelMentionsValid(value)
+		}
+		if !valid {
+			return NewAppError("IsValidChannelNotifyDefaults", "model.channel.is_valid.default_notify_props.app_error", map[string]any{"Key": key}, key+"="+value, http.StatusBadRequest)
+		}
+	}
+	return nil
+}
+
+// GetNewChannelMemberNotifyProps returns the notification settings of a new member of the
+// channel, which are the channel defaults if its admins set them.
+func GetNewChannelMemberNotifyProps(channel *Channel) StringMap {
+	props := GetDefaultChannelNotifyProps()
+	if channel != nil {
+		for key, value := range channel.DefaultNotifyProps {
+			props[key] = value
+		}
+	}
+	return props
+}
+
+// ApplyChannelNotifyDefaults returns the notification settings of a channel member with the
+// settings they left to default replaced by the channel defaults. The given props are not
+// modified.
+func ApplyChannelNotifyDefaults(props StringMap, defaults StringMap) StringMap {
+	if len(defaults) == 0 {
+		return props
+	}
+
+	var applied StringMap
+	for key, value := range defaults {
+		if current, ok := props[key]; ok && current != ChannelNotifyDefault {
+			continue
+		}
+		if applied == nil {
+			applied = make(StringMap, len(props)+len(defaults))
+			for k, v := range props {
+				applied[k] = v
+			}
+		}
+		applied[key] = value
+	}
+
+	if applied == nil {
+		return props
+	}
+	return applied
+}
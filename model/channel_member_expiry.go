@@ -0,0 +1,57 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+// ChannelMemberExpiry is the time at which a temporary channel member is removed from the channel.
+type ChannelMemberExpiry struct {
+	ChannelId string `json:"channel_id"`
+	UserId    string `json:"user_id"`
+	ExpiresAt int64  `json:"expires_at"`
+	CreatorId string `json:"creator_id"`
+	CreateAt  int64  `json:"create_at"`
+}
+
+func (o *ChannelMemberExpiry) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"channel_id": o.ChannelId,
+		"user_id":    o.UserId,
+		"expires_at": o.ExpiresAt,
+		"creator_id": o.CreatorId,
+		"create_at":  o.CreateAt,
+	}
+}
+
+func (o *ChannelMemberExpiry) IsValid() *AppError {
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("ChannelMemberExpiry.IsValid", "model.channel_member_expiry.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.UserId) {
+		return NewAppError("ChannelMemberExpiry.IsValid", "model.channel_member_expiry.is_valid.user_id.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if o.ExpiresAt <= 0 {
+		return NewAppError("ChannelMemberExpiry.IsValid", "model.channel_member_expiry.is_valid.expires_at.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if o.CreatorId != "" && !IsValidId(o.CreatorId) {
+		return NewAppError("ChannelMemberExpiry.IsValid", "model.channel_member_expiry.is_valid.creator_id.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("ChannelMemberExpiry.IsValid", "model.channel_member_expiry.is_valid.create_at.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *ChannelMemberExpiry) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
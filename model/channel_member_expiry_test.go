@@ -0,0 +1,34 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelMemberExpiryIsValid(t *testing.T) {
+	expiry := &ChannelMemberExpiry{
+		ChannelId: NewId(),
+		UserId:    NewId(),
+		ExpiresAt: GetMillis(),
+	}
+	expiry.PreSave()
+	require.Nil(t, expiry.IsValid())
+
+	expiry.CreatorId = NewId()
+	require.Nil(t, expiry.IsValid())
+
+	expiry.CreatorId = "invalid"
+	require.NotNil(t, expiry.IsValid())
+	expiry.CreatorId = ""
+
+	expiry.ExpiresAt = 0
+	require.NotNil(t, expiry.IsValid())
+	expiry.ExpiresAt = GetMillis()
+
+	expiry.UserId = "invalid"
+	require.NotNil(t, expiry.IsValid())
+}
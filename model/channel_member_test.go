@@ -38,3 +38,19 @@ func TestChannelMemberIsValid(t *testing.T) {
 	o.Roles = ""
 	require.Nil(t, o.IsValid(), "should be invalid")
 }
+
+func TestChannelNotifyDefaults(t *testing.T) {
+	require.Nil(t, IsValidChannelNotifyDefaults(StringMap{PushNotifyProp: ChannelNotifyMention, MarkUnreadNotifyProp: ChannelMarkUnreadMention}))
+	require.NotNil(t, IsValidChannelNotifyDefaults(StringMap{PushNotifyProp: "sometimes"}))
+	require.NotNil(t, IsValidChannelNotifyDefaults(StringMap{"unknown": ChannelNotifyAll}))
+
+	props := GetNewChannelMemberNotifyProps(&Channel{DefaultNotifyProps: StringMap{PushNotifyProp: ChannelNotifyNone}})
+	require.Equal(t, ChannelNotifyNone, props[PushNotifyProp])
+	require.Equal(t, ChannelNotifyDefault, props[DesktopNotifyProp])
+	require.Equal(t, GetDefaultChannelNotifyProps(), GetNewChannelMemberNotifyProps(nil))
+
+	memberProps := StringMap{DesktopNotifyProp: ChannelNotifyAll, PushNotifyProp: ChannelNotifyDefault}
+	applied := ApplyChannelNotifyDefaults(memberProps, StringMap{DesktopNotifyProp: ChannelNotifyNone, PushNotifyProp: ChannelNotifyMention, EmailNotifyProp: "false"})
+	require.Equal(t, StringMap{DesktopNotifyProp: ChannelNotifyAll, PushNotifyProp: ChannelNotifyMention, EmailNotifyProp: "false"}, applied)
+	require.Equal(t, ChannelNotifyDefault, memberProps[PushNotifyProp], "member props should not be modified")
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	ChannelMembersBulkActionAdd    = "add"
+	ChannelMembersBulkActionRemove = "remove"
+
+	// ChannelMembersBulkMaxUsers is the most users a channel_members_bulk job adds or removes.
+	ChannelMembersBulkMaxUsers = 5000
+)
+
+// ChannelMembersBulkRequest lists the users to add to, or remove from, a channel in one call.
+type ChannelMembersBulkRequest struct {
+	UserIds []string `json:"user_ids"`
+}
+
+func (o *ChannelMembersBulkRequest) IsValid() *AppError {
+	if len(o.UserIds) == 0 || len(o.UserIds) > ChannelMembersBulkMaxUsers {
+		return NewAppError("ChannelMembersBulkRequest.IsValid", "model.channel_members_bulk.is_valid.user_ids.app_error", map[string]any{"Max": ChannelMembersBulkMaxUsers}, "", http.StatusBadRequest)
+	}
+
+	for _, userID := range o.UserIds {
+		if !IsValidId(userID) {
+			return NewAppError("ChannelMembersBulkRequest.IsValid", "model.channel_members_bulk.is_valid.user_id.app_error", nil, "user_id="+userID, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// ChannelMembersBulkResult is the outcome of a channel_members_bulk job so far, read from the data
+// of the job. Failures maps the users that couldn't be added or removed to the id of the error.
+type ChannelMembersBulkResult struct {
+	JobId     string            `json:"job_id"`
+	ChannelId string            `json:"channel_id"`
+	Action    string            `json:"action"`
+	Status    string            `json:"status"`
+	Progress  int64             `json:"progress"`
+	Total     int               `json:"total"`
+	Processed int               `json:"processed"`
+	Failures  map[string]string `json:"failures"`
+}
+
+// ChannelMembersBulkResultFromJob reads the result of a channel_members_bulk job from its data.
+func ChannelMembersBulkResultFromJob(job *Job) *ChannelMembersBulkResult {
+	result := &ChannelMembersBulkResult{
+		JobId:     job.Id,
+		ChannelId: job.Data["channel_id"],
+		Action:    job.Data["action"],
+		Status:    job.Status,
+		Progress:  job.Progress,
+		Failures:  map[string]string{},
+	}
+	if userIDs := job.Data["user_ids"]; userIDs != "" {
+		result.Total = len(strings.Split(userIDs, ","))
+	}
+	result.Processed, _ = strconv.Atoi(job.Data["processed"])
+	if failures := job.Data["failures"]; failures != "" {
+		_ = json.Unmarshal([]byte(failures), &result.Failures)
+	}
+
+	return result
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelMembersBulkRequestIsValid(t *testing.T) {
+	request := &ChannelMembersBulkRequest{}
+	require.NotNil(t, request.IsValid())
+
+	request.UserIds = []string{NewId(), NewId()}
+	require.Nil(t, request.IsValid())
+
+	request.UserIds = append(request.UserIds, "invalid")
+	require.NotNil(t, request.IsValid())
+
+	request.UserIds = make([]string, ChannelMembersBulkMaxUsers+1)
+	for i := range request.UserIds {
+		request.UserIds[i] = NewId()
+	}
+	require.NotNil(t, request.IsValid())
+}
+
+func TestChannelMembersBulkResultFromJob(t *testing.T) {
+	userID := NewId()
+	job := &Job{
+		Id:       NewId(),
+		Status:   JobStatusSuccess,
+		Progress: 100,
+		Data: StringMap{
+			"channel_id": NewId(),
+			"action":     ChannelMembersBulkActionAdd,
+			"user_ids":   NewId() + "," + userID,
+			"processed":  "2",
+			"failures":   `{"` + userID + `":"app.channel.add_user.to.channel.failed.app_error"}`,
+		},
+	}
+
+	result := ChannelMembersBulkResultFromJob(job)
+	assert.Equal(t, job.Id, result.JobId)
+	assert.Equal(t, job.Data["channel_id"], result.ChannelId)
+	assert.Equal(t, ChannelMembersBulkActionAdd, result.Action)
+	assert.Equal(t, 2, result.Total)
+	assert.Equal(t, 2, result.Processed)
+	assert.Equal(t, map[string]string{userID: "app.channel.add_user.to.channel.failed.app_error"}, result.Failures)
+}
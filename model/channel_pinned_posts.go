@@ -0,0 +1,96 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	ChannelPinnedPostsMaxLimit = 1000
+)
+
+// ChannelPinnedPosts holds how the pinned posts of a channel are ordered and how many posts can be
+// pinned in it.
+type ChannelPinnedPosts struct {
+	ChannelId string `json:"channel_id"`
+	// PostOrder is the order of the pinned posts. The posts pinned since the last time they were
+	// reordered come after the ordered ones, oldest first.
+	PostOrder StringArray `json:"order"`
+	// PinLimit is the number of posts that can be pinned in the channel. There's no limit when 0.
+	PinLimit int   `json:"pin_limit"`
+	UpdateAt int64 `json:"update_at"`
+}
+
+type ChannelPinnedPostsLimit struct {
+	PinLimit int `json:"pin_limit"`
+}
+
+func (o *ChannelPinnedPosts) IsValid() *AppError {
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("ChannelPinnedPosts.IsValid", "model.channel_pinned_posts.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.PinLimit < 0 || o.PinLimit > ChannelPinnedPostsMaxLimit {
+		return NewAppError("ChannelPinnedPosts.IsValid", "model.channel_pinned_posts.is_valid.pin_limit.app_error", map[string]any{"Max": ChannelPinnedPostsMaxLimit}, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if len(o.PostOrder) > ChannelPinnedPostsMaxLimit {
+		return NewAppError("ChannelPinnedPosts.IsValid", "model.channel_pinned_posts.is_valid.order.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	seen := make(map[string]bool, len(o.PostOrder))
+	for _, postID := range o.PostOrder {
+		if !IsValidId(postID) || seen[postID] {
+			return NewAppError("ChannelPinnedPosts.IsValid", "model.channel_pinned_posts.is_valid.order.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+		}
+		seen[postID] = true
+	}
+
+	return nil
+}
+
+func (o *ChannelPinnedPosts) PreSave() {
+	if o.PostOrder == nil {
+		o.PostOrder = StringArray{}
+	}
+	o.UpdateAt = GetMillis()
+}
+
+func (o *ChannelPinnedPosts) Auditable() map[string]any {
+	return map[string]any{
+		"channel_id": o.ChannelId,
+		"order":      o.PostOrder,
+		"pin_limit":  o.PinLimit,
+		"update_at":  o.UpdateAt,
+	}
+}
+
+// SortPostList orders the pinned posts of the list: the ordered ones first, then the others in the
+// order they're already in.
+func (o *ChannelPinnedPosts) SortPostList(list *PostList) {
+	if len(o.PostOrder) == 0 {
+		return
+	}
+
+	inList := make(map[string]bool, len(list.Order))
+	for _, postID := range list.Order {
+		inList[postID] = true
+	}
+
+	order := make([]string, 0, len(list.Order))
+	ordered := make(map[string]bool, len(o.PostOrder))
+	for _, postID := range o.PostOrder {
+		if inList[postID] {
+			order = append(order, postID)
+			ordered[postID] = true
+		}
+	}
+	for _, postID := range list.Order {
+		if !ordered[postID] {
+			order = append(order, postID)
+		}
+	}
+	list.Order = order
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelPinnedPostsIsValid(t *testing.T) {
+	pinned := &ChannelPinnedPosts{ChannelId: NewId(), PostOrder: StringArray{NewId(), NewId()}, PinLimit: 10}
+	require.Nil(t, pinned.IsValid())
+
+	pinned.PinLimit = -1
+	require.NotNil(t, pinned.IsValid())
+	pinned.PinLimit = ChannelPinnedPostsMaxLimit + 1
+	require.NotNil(t, pinned.IsValid())
+	pinned.PinLimit = 0
+
+	pinned.PostOrder = append(pinned.PostOrder, pinned.PostOrder[0])
+	require.NotNil(t, pinned.IsValid(), "posts can't be ordered twice")
+	pinned.PostOrder = StringArray{"junk"}
+	require.NotNil(t, pinned.IsValid())
+
+	pinned.PostOrder = nil
+	pinned.ChannelId = "junk"
+	require.NotNil(t, pinned.IsValid())
+}
+
+func TestChannelPinnedPostsSortPostList(t *testing.T) {
+	first, second, third, unpinned := NewId(), NewId(), NewId(), NewId()
+
+	list := &PostList{Order: []string{first, second, third}}
+	(&ChannelPinnedPosts{}).SortPostList(list)
+	assert.Equal(t, []string{first, second, third}, list.Order, "the order shouldn't change until the posts are reordered")
+
+	(&ChannelPinnedPosts{PostOrder: StringArray{third, unpinned, first}}).SortPostList(list)
+	assert.Equal(t, []string{third, first, second}, list.Order)
+}
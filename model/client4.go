@@ -37,6 +37,7 @@ const (
 	HeaderFirstInaccessiblePostTime = "First-Inaccessible-Post-Time"
 	HeaderFirstInaccessibleFileTime = "First-Inaccessible-File-Time"
 	HeaderRange                     = "Range"
+	HeaderIPAccessBypassToken       = "X-IP-Access-Bypass-Token"
 	STATUS                          = "status"
 	StatusOk                        = "OK"
 	StatusFail                      = "FAIL"
@@ -386,6 +387,14 @@ func (c *Client4) outgoingWebhookRoute(hookID string) string {
 	return fmt.Sprintf(c.outgoingWebhooksRoute()+"/%v", hookID)
 }
 
+func (c *Client4) directOutgoingWebhooksRoute() string {
+	return "/hooks/direct_outgoing"
+}
+
+func (c *Client4) directOutgoingWebhookRoute(hookID string) string {
+	return fmt.Sprintf(c.directOutgoingWebhooksRoute()+"/%v", hookID)
+}
+
 func (c *Client4) preferencesRoute(userId string) string {
 	return fmt.Sprintf(c.userRoute(userId) + "/preferences")
 }
@@ -442,6 +451,14 @@ func (c *Client4) draftsRoute() string {
 	return "/drafts"
 }
 
+func (c *Client4) teamTemplatesRoute() string {
+	return "/team_templates"
+}
+
+func (c *Client4) teamTemplateRoute(templateId string) string {
+	return fmt.Sprintf(c.teamTemplatesRoute()+"/%v", templateId)
+}
+
 func (c *Client4) emojisRoute() string {
 	return "/emoji"
 }
@@ -794,6 +811,47 @@ func (c *Client4) login(m map[string]string) (*User, *Response, error) {
 	return &user, BuildResponse(r), nil
 }
 
+// BeginWebAuthnLogin returns the options to sign in with through navigator.credentials.get().
+// The security keys of the user are listed when a login id is given, to be used as a second
+// factor. Otherwise any passkey of the site can be used to sign in.
+func (c *Client4) BeginWebAuthnLogin(loginId string) (*WebAuthnRequestOptions, *Response, error) {
+	buf, err := json.Marshal(&WebAuthnLoginBeginRequest{LoginId: loginId})
+	if err != nil {
+		return nil, nil, NewAppError("BeginWebAuthnLogin", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes("/users/login/webauthn/begin", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var options WebAuthnRequestOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		return nil, nil, NewAppError("BeginWebAuthnLogin", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &options, BuildResponse(r), nil
+}
+
+// LoginWithWebAuthn authenticates a user with a passkey, in place of a login id and a password.
+func (c *Client4) LoginWithWebAuthn(credential *WebAuthnAssertionResponse, deviceId string) (*User, *Response, error) {
+	buf, err := json.Marshal(&WebAuthnLoginRequest{Credential: credential, DeviceId: deviceId})
+	if err != nil {
+		return nil, nil, NewAppError("LoginWithWebAuthn", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes("/users/login/webauthn", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	c.AuthToken = r.Header.Get(HeaderToken)
+	c.AuthType = HeaderBearer
+
+	var user User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		return nil, nil, NewAppError("LoginWithWebAuthn", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &user, BuildResponse(r), nil
+}
+
 // Logout terminates the current user's session.
 func (c *Client4) Logout() (*Response, error) {
 	r, err := c.DoAPIPost("/users/logout", "")
@@ -1445,6 +1503,77 @@ func (c *Client4) GenerateMfaSecret(userId string) (*MfaSecret, *Response, error
 	return &secret, BuildResponse(r), nil
 }
 
+// BeginWebAuthnRegistration returns the options for the user to create a passkey or security key
+// with, through navigator.credentials.create().
+func (c *Client4) BeginWebAuthnRegistration(userId string) (*WebAuthnCreationOptions, *Response, error) {
+	r, err := c.DoAPIPost(c.userRoute(userId)+"/webauthn/register/begin", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var options WebAuthnCreationOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		return nil, nil, NewAppError("BeginWebAuthnRegistration", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &options, BuildResponse(r), nil
+}
+
+// FinishWebAuthnRegistration registers the credential created by the authenticator.
+func (c *Client4) FinishWebAuthnRegistration(userId string, registration *WebAuthnRegistrationRequest) (*WebAuthnCredential, *Response, error) {
+	buf, err := json.Marshal(registration)
+	if err != nil {
+		return nil, nil, NewAppError("FinishWebAuthnRegistration", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.userRoute(userId)+"/webauthn/register/finish", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var credential WebAuthnCredential
+	if err := json.NewDecoder(r.Body).Decode(&credential); err != nil {
+		return nil, nil, NewAppError("FinishWebAuthnRegistration", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &credential, BuildResponse(r), nil
+}
+
+// GetWebAuthnCredentials returns the passkeys and security keys of a user.
+func (c *Client4) GetWebAuthnCredentials(userId string) ([]*WebAuthnCredential, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/webauthn/credentials", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var credentials []*WebAuthnCredential
+	if err := json.NewDecoder(r.Body).Decode(&credentials); err != nil {
+		return nil, nil, NewAppError("GetWebAuthnCredentials", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return credentials, BuildResponse(r), nil
+}
+
+// UpdateWebAuthnCredentialName renames a passkey or security key of a user.
+func (c *Client4) UpdateWebAuthnCredentialName(userId, credentialId, name string) (*WebAuthnCredential, *Response, error) {
+	r, err := c.DoAPIPut(c.userRoute(userId)+"/webauthn/credentials/"+credentialId, MapToJSON(map[string]string{"name": name}))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var credential WebAuthnCredential
+	if err := json.NewDecoder(r.Body).Decode(&credential); err != nil {
+		return nil, nil, NewAppError("UpdateWebAuthnCredentialName", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &credential, BuildResponse(r), nil
+}
+
+// DeleteWebAuthnCredential removes a passkey or security key of a user.
+func (c *Client4) DeleteWebAuthnCredential(userId, credentialId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.userRoute(userId) + "/webauthn/credentials/" + credentialId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // UpdateUserPassword updates a user's password. Must be logged in as the user or be a system administrator.
 func (c *Client4) UpdateUserPassword(userId, currentPassword, newPassword string) (*Response, error) {
 	requestBody := map[string]string{"current_password": currentPassword, "new_password": newPassword}
@@ -1487,6 +1616,177 @@ func (c *Client4) DemoteUserToGuest(guestId string) (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// GetGuestSponsorship returns the sponsor and the expiration of a guest.
+func (c *Client4) GetGuestSponsorship(guestId string) (*GuestSponsorship, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(guestId)+"/guest_sponsorship", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var sponsorship GuestSponsorship
+	if err := json.NewDecoder(r.Body).Decode(&sponsorship); err != nil {
+		return nil, nil, NewAppError("GetGuestSponsorship", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &sponsorship, BuildResponse(r), nil
+}
+
+// PatchGuestSponsorship changes the sponsor or the expiration of a guest.
+func (c *Client4) PatchGuestSponsorship(guestId string, patch *GuestSponsorshipPatch) (*GuestSponsorship, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchGuestSponsorship", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.userRoute(guestId)+"/guest_sponsorship", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var sponsorship GuestSponsorship
+	if err := json.NewDecoder(r.Body).Decode(&sponsorship); err != nil {
+		return nil, nil, NewAppError("PatchGuestSponsorship", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &sponsorship, BuildResponse(r), nil
+}
+
+// RenewGuestSponsorship extends the expiration of a guest, to expiresAt or, when nil, by the
+// default expiration period.
+func (c *Client4) RenewGuestSponsorship(guestId string, expiresAt *int64) (*GuestSponsorship, *Response, error) {
+	buf, err := json.Marshal(&GuestSponsorshipRenewal{ExpiresAt: expiresAt})
+	if err != nil {
+		return nil, nil, NewAppError("RenewGuestSponsorship", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.userRoute(guestId)+"/guest_sponsorship/renew", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var sponsorship GuestSponsorship
+	if err := json.NewDecoder(r.Body).Decode(&sponsorship); err != nil {
+		return nil, nil, NewAppError("RenewGuestSponsorship", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &sponsorship, BuildResponse(r), nil
+}
+
+// GetSponsoredGuests returns a page of the sponsorships of the guests sponsored by a user, the
+// soonest to expire first.
+func (c *Client4) GetSponsoredGuests(sponsorId string, page int, perPage int) ([]*GuestSponsorship, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(sponsorId)+fmt.Sprintf("/sponsored_guests?page=%v&per_page=%v", page, perPage), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*GuestSponsorship
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetSponsoredGuests", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetGuestSponsorReport returns a page of the number of active guests per sponsor.
+func (c *Client4) GetGuestSponsorReport(page int, perPage int) ([]*GuestSponsorReport, *Response, error) {
+	r, err := c.DoAPIGet(c.usersRoute()+fmt.Sprintf("/guest_sponsors/report?page=%v&per_page=%v", page, perPage), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*GuestSponsorReport
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetGuestSponsorReport", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// StartUserOffboarding starts a job handing over what a deactivated user owned to a successor.
+func (c *Client4) StartUserOffboarding(userId string, successorId string) (*Job, *Response, error) {
+	buf, err := json.Marshal(&UserOffboardingRequest{SuccessorId: successorId})
+	if err != nil {
+		return nil, nil, NewAppError("StartUserOffboarding", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.userRoute(userId)+"/offboarding", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var job Job
+	if err := json.NewDecoder(r.Body).Decode(&job); err != nil {
+		return nil, nil, NewAppError("StartUserOffboarding", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &job, BuildResponse(r), nil
+}
+
+// GetUserOffboardingReports returns the reports of the offboarding jobs of a user, the newest first.
+func (c *Client4) GetUserOffboardingReports(userId string) ([]*OffboardingReport, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/offboarding", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*OffboardingReport
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetUserOffboardingReports", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GrantSupportAccessConsent lets the admin view the app as the user, read-only, for the duration
+// of the consent.
+func (c *Client4) GrantSupportAccessConsent(userId string, consentRequest *SupportAccessConsentRequest) (*SupportAccessConsent, *Response, error) {
+	buf, err := json.Marshal(consentRequest)
+	if err != nil {
+		return nil, nil, NewAppError("GrantSupportAccessConsent", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.userRoute(userId)+"/support_access/consent", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var consent SupportAccessConsent
+	if err := json.NewDecoder(r.Body).Decode(&consent); err != nil {
+		return nil, nil, NewAppError("GrantSupportAccessConsent", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &consent, BuildResponse(r), nil
+}
+
+// GetSupportAccessConsents returns the support access consents a user granted, the newest first.
+func (c *Client4) GetSupportAccessConsents(userId string) ([]*SupportAccessConsent, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/support_access/consents", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*SupportAccessConsent
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetSupportAccessConsents", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// RevokeSupportAccessConsents revokes the support access consents of a user and ends the support
+// access sessions opened under them.
+func (c *Client4) RevokeSupportAccessConsents(userId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.userRoute(userId) + "/support_access/consent")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// CreateSupportAccessSession opens a read-only session of the user for the calling admin, under the
+// consent the user granted them.
+func (c *Client4) CreateSupportAccessSession(userId string) (*Session, *Response, error) {
+	r, err := c.DoAPIPost(c.userRoute(userId)+"/support_access/session", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var session Session
+	if err := json.NewDecoder(r.Body).Decode(&session); err != nil {
+		return nil, nil, NewAppError("CreateSupportAccessSession", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &session, BuildResponse(r), nil
+}
+
 // UpdateUserRoles updates a user's roles in the system. A user can have "system_user" and "system_admin" roles.
 func (c *Client4) UpdateUserRoles(userId, roles string) (*Response, error) {
 	requestBody := map[string]string{"roles": roles}
@@ -1615,6 +1915,21 @@ func (c *Client4) GetSessions(userId, etag string) ([]*Session, *Response, error
 	return list, BuildResponse(r), nil
 }
 
+// GetSessionsDetailed returns the unexpired sessions of a user, described with the device,
+// browser and location they were created from.
+func (c *Client4) GetSessionsDetailed(userId string) ([]*SessionDetailed, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/sessions/detailed", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*SessionDetailed
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetSessionsDetailed", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
 // RevokeSession revokes a user session based on the provided user id and session id strings.
 func (c *Client4) RevokeSession(userId, sessionId string) (*Response, error) {
 	requestBody := map[string]string{"session_id": sessionId}
@@ -1636,6 +1951,17 @@ func (c *Client4) RevokeAllSessions(userId string) (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// RevokeOtherSessions revokes all the sessions of the current user but the one used to make
+// the request.
+func (c *Client4) RevokeOtherSessions(userId string) (*Response, error) {
+	r, err := c.DoAPIPost(c.userRoute(userId)+"/sessions/revoke/others", "")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // RevokeAllSessions revokes all sessions for all the users.
 func (c *Client4) RevokeSessionsFromAllUsers() (*Response, error) {
 	r, err := c.DoAPIPost(c.usersRoute()+"/sessions/revoke/all", "")
@@ -1686,6 +2012,50 @@ func (c *Client4) GetTeamsUnreadForUser(userId, teamIdToExclude string, includeC
 }
 
 // GetUserAudits returns a list of audit based on the provided user id string.
+// GetPushNotificationReceipts returns the last push notifications sent to the user's devices and their statuses.
+func (c *Client4) GetPushNotificationReceipts(userId string) ([]*PushNotificationReceipt, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/push_notification_receipts", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var receipts []*PushNotificationReceipt
+	if err := json.NewDecoder(r.Body).Decode(&receipts); err != nil {
+		return nil, BuildResponse(r), NewAppError("GetPushNotificationReceipts", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return receipts, BuildResponse(r), nil
+}
+
+// GetNotificationRules returns the notification rules of the user.
+func (c *Client4) GetNotificationRules(userId string) (NotificationRules, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/notification_rules", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var rules NotificationRules
+	if err := json.NewDecoder(r.Body).Decode(&rules); err != nil {
+		return nil, BuildResponse(r), NewAppError("GetNotificationRules", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return rules, BuildResponse(r), nil
+}
+
+// UpdateNotificationRules replaces the notification rules of the user.
+func (c *Client4) UpdateNotificationRules(userId string, rules NotificationRules) (*Response, error) {
+	buf, err := json.Marshal(rules)
+	if err != nil {
+		return nil, NewAppError("UpdateNotificationRules", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.userRoute(userId)+"/notification_rules", buf)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 func (c *Client4) GetUserAudits(userId string, page int, perPage int, etag string) (Audits, *Response, error) {
 	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
 	r, err := c.DoAPIGet(c.userRoute(userId)+"/audits"+query, etag)
@@ -2350,6 +2720,47 @@ func (c *Client4) RestoreTeam(teamId string) (*Team, *Response, error) {
 	return &t, BuildResponse(r), nil
 }
 
+// ArchiveTeam hides a team and starts a job archiving its channels and suspending the jobs
+// referencing it.
+func (c *Client4) ArchiveTeam(teamId string) (*TeamArchive, *Response, error) {
+	return c.doTeamArchiveRequest("ArchiveTeam", c.teamRoute(teamId)+"/archive")
+}
+
+// GetTeamArchive returns the archive of a team, telling whether the team is still being archived
+// or restored.
+func (c *Client4) GetTeamArchive(teamId string) (*TeamArchive, *Response, error) {
+	r, err := c.DoAPIGet(c.teamRoute(teamId)+"/archive", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var archive TeamArchive
+	if err := json.NewDecoder(r.Body).Decode(&archive); err != nil {
+		return nil, nil, NewAppError("GetTeamArchive", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &archive, BuildResponse(r), nil
+}
+
+// RestoreArchivedTeam starts a job restoring an archived team along with its channels and jobs.
+func (c *Client4) RestoreArchivedTeam(teamId string) (*TeamArchive, *Response, error) {
+	return c.doTeamArchiveRequest("RestoreArchivedTeam", c.teamRoute(teamId)+"/archive/restore")
+}
+
+func (c *Client4) doTeamArchiveRequest(where, url string) (*TeamArchive, *Response, error) {
+	r, err := c.DoAPIPost(url, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var archive TeamArchive
+	if err := json.NewDecoder(r.Body).Decode(&archive); err != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &archive, BuildResponse(r), nil
+}
+
 // RegenerateTeamInviteId requests a new invite ID to be generated.
 func (c *Client4) RegenerateTeamInviteId(teamId string) (*Team, *Response, error) {
 	r, err := c.DoAPIPost(c.teamRoute(teamId)+"/regenerate_invite_id", "")
@@ -2836,39 +3247,138 @@ func (c *Client4) RemoveTeamIcon(teamId string) (*Response, error) {
 	return BuildResponse(r), nil
 }
 
-// Channel Section
-
-// GetAllChannels get all the channels. Must be a system administrator.
-func (c *Client4) GetAllChannels(page int, perPage int, etag string) (ChannelListWithTeamData, *Response, error) {
-	return c.getAllChannels(page, perPage, etag, ChannelSearchOpts{})
-}
-
-// GetAllChannelsIncludeDeleted get all the channels. Must be a system administrator.
-func (c *Client4) GetAllChannelsIncludeDeleted(page int, perPage int, etag string) (ChannelListWithTeamData, *Response, error) {
-	return c.getAllChannels(page, perPage, etag, ChannelSearchOpts{IncludeDeleted: true})
-}
-
-// GetAllChannelsExcludePolicyConstrained gets all channels which are not part of a data retention policy.
-// Must be a system administrator.
-func (c *Client4) GetAllChannelsExcludePolicyConstrained(page, perPage int, etag string) (ChannelListWithTeamData, *Response, error) {
-	return c.getAllChannels(page, perPage, etag, ChannelSearchOpts{ExcludePolicyConstrained: true})
-}
-
-func (c *Client4) getAllChannels(page int, perPage int, etag string, opts ChannelSearchOpts) (ChannelListWithTeamData, *Response, error) {
-	query := fmt.Sprintf("?page=%v&per_page=%v&include_deleted=%v&exclude_policy_constrained=%v",
-		page, perPage, opts.IncludeDeleted, opts.ExcludePolicyConstrained)
-	r, err := c.DoAPIGet(c.channelsRoute()+query, etag)
+// CreateTeamTemplate creates a team template.
+func (c *Client4) CreateTeamTemplate(template *TeamTemplate) (*TeamTemplate, *Response, error) {
+	buf, err := json.Marshal(template)
+	if err != nil {
+		return nil, nil, NewAppError("CreateTeamTemplate", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.teamTemplatesRoute(), buf)
 	if err != nil {
 		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
 
-	var ch ChannelListWithTeamData
-	err = json.NewDecoder(r.Body).Decode(&ch)
-	if err != nil {
-		return nil, BuildResponse(r), NewAppError("getAllChannels", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	var t TeamTemplate
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		return nil, nil, NewAppError("CreateTeamTemplate", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
-	return ch, BuildResponse(r), nil
+	return &t, BuildResponse(r), nil
+}
+
+// GetTeamTemplates returns a page of the team templates, sorted by display name.
+func (c *Client4) GetTeamTemplates(page, perPage int) ([]*TeamTemplate, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.teamTemplatesRoute()+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*TeamTemplate
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetTeamTemplates", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetTeamTemplate returns the team template with the given id.
+func (c *Client4) GetTeamTemplate(templateId string) (*TeamTemplate, *Response, error) {
+	r, err := c.DoAPIGet(c.teamTemplateRoute(templateId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var t TeamTemplate
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		return nil, nil, NewAppError("GetTeamTemplate", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &t, BuildResponse(r), nil
+}
+
+// UpdateTeamTemplate updates the display name, description and content of a team template.
+func (c *Client4) UpdateTeamTemplate(template *TeamTemplate) (*TeamTemplate, *Response, error) {
+	buf, err := json.Marshal(template)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateTeamTemplate", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.teamTemplateRoute(template.Id), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var t TeamTemplate
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		return nil, nil, NewAppError("UpdateTeamTemplate", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &t, BuildResponse(r), nil
+}
+
+// DeleteTeamTemplate deletes a team template.
+func (c *Client4) DeleteTeamTemplate(templateId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.teamTemplateRoute(templateId))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// InstantiateTeamTemplate creates a new team from a team template, along with the channels,
+// categories, playbooks and integrations of the template.
+func (c *Client4) InstantiateTeamTemplate(templateId string, instantiation *TeamTemplateInstantiation) (*TeamTemplateInstance, *Response, error) {
+	buf, err := json.Marshal(instantiation)
+	if err != nil {
+		return nil, nil, NewAppError("InstantiateTeamTemplate", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.teamTemplateRoute(templateId)+"/instantiate", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var instance TeamTemplateInstance
+	if err := json.NewDecoder(r.Body).Decode(&instance); err != nil {
+		return nil, nil, NewAppError("InstantiateTeamTemplate", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &instance, BuildResponse(r), nil
+}
+
+// Channel Section
+
+// GetAllChannels get all the channels. Must be a system administrator.
+func (c *Client4) GetAllChannels(page int, perPage int, etag string) (ChannelListWithTeamData, *Response, error) {
+	return c.getAllChannels(page, perPage, etag, ChannelSearchOpts{})
+}
+
+// GetAllChannelsIncludeDeleted get all the channels. Must be a system administrator.
+func (c *Client4) GetAllChannelsIncludeDeleted(page int, perPage int, etag string) (ChannelListWithTeamData, *Response, error) {
+	return c.getAllChannels(page, perPage, etag, ChannelSearchOpts{IncludeDeleted: true})
+}
+
+// GetAllChannelsExcludePolicyConstrained gets all channels which are not part of a data retention policy.
+// Must be a system administrator.
+func (c *Client4) GetAllChannelsExcludePolicyConstrained(page, perPage int, etag string) (ChannelListWithTeamData, *Response, error) {
+	return c.getAllChannels(page, perPage, etag, ChannelSearchOpts{ExcludePolicyConstrained: true})
+}
+
+func (c *Client4) getAllChannels(page int, perPage int, etag string, opts ChannelSearchOpts) (ChannelListWithTeamData, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v&include_deleted=%v&exclude_policy_constrained=%v",
+		page, perPage, opts.IncludeDeleted, opts.ExcludePolicyConstrained)
+	r, err := c.DoAPIGet(c.channelsRoute()+query, etag)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var ch ChannelListWithTeamData
+	err = json.NewDecoder(r.Body).Decode(&ch)
+	if err != nil {
+		return nil, BuildResponse(r), NewAppError("getAllChannels", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return ch, BuildResponse(r), nil
 }
 
 // GetAllChannelsWithCount get all the channels including the total count. Must be a system administrator.
@@ -3046,6 +3556,44 @@ func (c *Client4) GetChannelStats(channelId string, etag string, excludeFilesCou
 	return &stats, BuildResponse(r), nil
 }
 
+// GetChannelMatrixBridge returns the Matrix room the channel is bridged to.
+func (c *Client4) GetChannelMatrixBridge(channelId string) (*MatrixRoom, *Response, error) {
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/matrix_bridge", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var room MatrixRoom
+	if err := json.NewDecoder(r.Body).Decode(&room); err != nil {
+		return nil, nil, NewAppError("GetChannelMatrixBridge", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &room, BuildResponse(r), nil
+}
+
+// BridgeChannelToMatrix bridges the channel to the Matrix room.
+func (c *Client4) BridgeChannelToMatrix(channelId, roomId string) (*MatrixRoom, *Response, error) {
+	r, err := c.DoAPIPost(c.channelRoute(channelId)+"/matrix_bridge", MapToJSON(map[string]string{"room_id": roomId}))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var room MatrixRoom
+	if err := json.NewDecoder(r.Body).Decode(&room); err != nil {
+		return nil, nil, NewAppError("BridgeChannelToMatrix", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &room, BuildResponse(r), nil
+}
+
+// UnbridgeChannelFromMatrix stops bridging the channel to its Matrix room.
+func (c *Client4) UnbridgeChannelFromMatrix(channelId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.channelRoute(channelId) + "/matrix_bridge")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // GetChannelMembersTimezones gets a list of timezones for a channel.
 func (c *Client4) GetChannelMembersTimezones(channelId string) ([]string, *Response, error) {
 	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/timezones", "")
@@ -3075,6 +3623,152 @@ func (c *Client4) GetPinnedPosts(channelId string, etag string) (*PostList, *Res
 	return &list, BuildResponse(r), nil
 }
 
+// GetChannelPinnedPosts returns the order of the pinned posts of a channel and how many posts can be pinned in it.
+func (c *Client4) GetChannelPinnedPosts(channelId string) (*ChannelPinnedPosts, *Response, error) {
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/pinned/settings", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var pinned ChannelPinnedPosts
+	if err := json.NewDecoder(r.Body).Decode(&pinned); err != nil {
+		return nil, nil, NewAppError("GetChannelPinnedPosts", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &pinned, BuildResponse(r), nil
+}
+
+// UpdatePinnedPostsOrder reorders the pinned posts of a channel. The given posts come first,
+// followed by the other pinned posts in their current order.
+func (c *Client4) UpdatePinnedPostsOrder(channelId string, postIds []string) (*ChannelPinnedPosts, *Response, error) {
+	buf, err := json.Marshal(postIds)
+	if err != nil {
+		return nil, nil, NewAppError("UpdatePinnedPostsOrder", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.channelRoute(channelId)+"/pinned/order", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var pinned ChannelPinnedPosts
+	if err := json.NewDecoder(r.Body).Decode(&pinned); err != nil {
+		return nil, nil, NewAppError("UpdatePinnedPostsOrder", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &pinned, BuildResponse(r), nil
+}
+
+// UpdatePinnedPostsLimit sets how many posts can be pinned in a channel, 0 meaning no limit.
+func (c *Client4) UpdatePinnedPostsLimit(channelId string, limit int) (*ChannelPinnedPosts, *Response, error) {
+	buf, err := json.Marshal(&ChannelPinnedPostsLimit{PinLimit: limit})
+	if err != nil {
+		return nil, nil, NewAppError("UpdatePinnedPostsLimit", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.channelRoute(channelId)+"/pinned/limit", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var pinned ChannelPinnedPosts
+	if err := json.NewDecoder(r.Body).Decode(&pinned); err != nil {
+		return nil, nil, NewAppError("UpdatePinnedPostsLimit", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &pinned, BuildResponse(r), nil
+}
+
+func (c *Client4) channelBookmarksRoute(channelId string) string {
+	return c.channelRoute(channelId) + "/bookmarks"
+}
+
+// GetChannelBookmarks returns the bookmarks of a channel in order.
+func (c *Client4) GetChannelBookmarks(channelId string) ([]*ChannelBookmarkWithFileInfo, *Response, error) {
+	r, err := c.DoAPIGet(c.channelBookmarksRoute(channelId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var bookmarks []*ChannelBookmarkWithFileInfo
+	if err := json.NewDecoder(r.Body).Decode(&bookmarks); err != nil {
+		return nil, nil, NewAppError("GetChannelBookmarks", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return bookmarks, BuildResponse(r), nil
+}
+
+// CreateChannelBookmark adds a bookmark after the existing ones of its channel.
+func (c *Client4) CreateChannelBookmark(bookmark *ChannelBookmark) (*ChannelBookmarkWithFileInfo, *Response, error) {
+	buf, err := json.Marshal(bookmark)
+	if err != nil {
+		return nil, nil, NewAppError("CreateChannelBookmark", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.channelBookmarksRoute(bookmark.ChannelId), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var saved ChannelBookmarkWithFileInfo
+	if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+		return nil, nil, NewAppError("CreateChannelBookmark", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &saved, BuildResponse(r), nil
+}
+
+// PatchChannelBookmark changes the name, the emoji, and the link or the file of a bookmark.
+func (c *Client4) PatchChannelBookmark(channelId, bookmarkId string, patch *ChannelBookmarkPatch) (*ChannelBookmarkWithFileInfo, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchChannelBookmark", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPatchBytes(c.channelBookmarksRoute(channelId)+"/"+bookmarkId, buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var patched ChannelBookmarkWithFileInfo
+	if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+		return nil, nil, NewAppError("PatchChannelBookmark", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &patched, BuildResponse(r), nil
+}
+
+// DeleteChannelBookmark removes a bookmark from its channel.
+func (c *Client4) DeleteChannelBookmark(channelId, bookmarkId string) (*ChannelBookmarkWithFileInfo, *Response, error) {
+	r, err := c.DoAPIDelete(c.channelBookmarksRoute(channelId) + "/" + bookmarkId)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var deleted ChannelBookmarkWithFileInfo
+	if err := json.NewDecoder(r.Body).Decode(&deleted); err != nil {
+		return nil, nil, NewAppError("DeleteChannelBookmark", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &deleted, BuildResponse(r), nil
+}
+
+// UpdateChannelBookmarkSortOrder reorders the bookmarks of a channel. The bookmarks left out of the
+// order come after the ordered ones.
+func (c *Client4) UpdateChannelBookmarkSortOrder(channelId string, bookmarkIds []string) ([]*ChannelBookmarkWithFileInfo, *Response, error) {
+	buf, err := json.Marshal(bookmarkIds)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateChannelBookmarkSortOrder", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.channelBookmarksRoute(channelId)+"/order", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var bookmarks []*ChannelBookmarkWithFileInfo
+	if err := json.NewDecoder(r.Body).Decode(&bookmarks); err != nil {
+		return nil, nil, NewAppError("UpdateChannelBookmarkSortOrder", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return bookmarks, BuildResponse(r), nil
+}
+
 // GetPrivateChannelsForTeam returns a list of private channels based on the provided team id string.
 func (c *Client4) GetPrivateChannelsForTeam(teamId string, page int, perPage int, etag string) ([]*Channel, *Response, error) {
 	query := fmt.Sprintf("/private?page=%v&per_page=%v", page, perPage)
@@ -3357,6 +4051,22 @@ func (c *Client4) MoveChannel(channelId, teamId string, force bool) (*Channel, *
 	return ch, BuildResponse(r), nil
 }
 
+// ExportChannel starts a job exporting the channel's history into an archive. Once the job has
+// completed, the name of the archive is available in the job's export_file data.
+func (c *Client4) ExportChannel(channelId string) (*Job, *Response, error) {
+	r, err := c.DoAPIPost(c.channelRoute(channelId)+"/export", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var job Job
+	if err := json.NewDecoder(r.Body).Decode(&job); err != nil {
+		return nil, BuildResponse(r), NewAppError("ExportChannel", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &job, BuildResponse(r), nil
+}
+
 // GetChannelByName returns a channel based on the provided channel name and team id strings.
 func (c *Client4) GetChannelByName(channelName, teamId string, etag string) (*Channel, *Response, error) {
 	r, err := c.DoAPIGet(c.channelByNameRoute(channelName, teamId), etag)
@@ -3610,6 +4320,180 @@ func (c *Client4) AddChannelMemberWithRootId(channelId, userId, postRootId strin
 	return ch, BuildResponse(r), nil
 }
 
+// AddChannelMemberWithExpiry adds a user to a channel until expiresAt, when the user is removed
+// from the channel.
+func (c *Client4) AddChannelMemberWithExpiry(channelId, userId string, expiresAt int64) (*ChannelMember, *Response, error) {
+	requestBody := map[string]any{"user_id": userId, "expires_at": expiresAt}
+	buf, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, nil, NewAppError("AddChannelMemberWithExpiry", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.channelMembersRoute(channelId), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var ch *ChannelMember
+	if err := json.NewDecoder(r.Body).Decode(&ch); err != nil {
+		return nil, BuildResponse(r), NewAppError("AddChannelMemberWithExpiry", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return ch, BuildResponse(r), nil
+}
+
+// GetChannelMemberExpiries returns when the temporary members of a channel are removed from it.
+func (c *Client4) GetChannelMemberExpiries(channelId string) ([]*ChannelMemberExpiry, *Response, error) {
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/member_expiries", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*ChannelMemberExpiry
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetChannelMemberExpiries", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// SetChannelMemberExpiry makes the membership of a channel member temporary, until expiresAt.
+func (c *Client4) SetChannelMemberExpiry(channelId, userId string, expiresAt int64) (*ChannelMemberExpiry, *Response, error) {
+	buf, err := json.Marshal(&ChannelMemberExpiry{ExpiresAt: expiresAt})
+	if err != nil {
+		return nil, nil, NewAppError("SetChannelMemberExpiry", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.channelMemberRoute(channelId, userId)+"/expiry", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var expiry ChannelMemberExpiry
+	if err := json.NewDecoder(r.Body).Decode(&expiry); err != nil {
+		return nil, nil, NewAppError("SetChannelMemberExpiry", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &expiry, BuildResponse(r), nil
+}
+
+// DeleteChannelMemberExpiry makes the membership of a temporary channel member permanent.
+func (c *Client4) DeleteChannelMemberExpiry(channelId, userId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.channelMemberRoute(channelId, userId) + "/expiry")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// AddChannelMembersBulk starts a job adding the users to a channel, returning the result of the job
+// so far. The result of the job is then polled with GetChannelMembersBulkJob.
+func (c *Client4) AddChannelMembersBulk(channelId string, userIds []string) (*ChannelMembersBulkResult, *Response, error) {
+	return c.doChannelMembersBulkRequest("AddChannelMembersBulk", c.channelMembersRoute(channelId)+"/bulk", userIds)
+}
+
+// RemoveChannelMembersBulk starts a job removing the users from a channel, returning the result of
+// the job so far.
+func (c *Client4) RemoveChannelMembersBulk(channelId string, userIds []string) (*ChannelMembersBulkResult, *Response, error) {
+	return c.doChannelMembersBulkRequest("RemoveChannelMembersBulk", c.channelMembersRoute(channelId)+"/bulk/remove", userIds)
+}
+
+// GetChannelMembersBulkJob returns the result of a job adding users to, or removing them from, a
+// channel: how many users were processed and the errors of the users that failed.
+func (c *Client4) GetChannelMembersBulkJob(channelId, jobId string) (*ChannelMembersBulkResult, *Response, error) {
+	r, err := c.DoAPIGet(c.channelMembersRoute(channelId)+"/bulk/"+jobId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var result ChannelMembersBulkResult
+	if err := json.NewDecoder(r.Body).Decode(&result); err != nil {
+		return nil, nil, NewAppError("GetChannelMembersBulkJob", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &result, BuildResponse(r), nil
+}
+
+func (c *Client4) doChannelMembersBulkRequest(where, url string, userIds []string) (*ChannelMembersBulkResult, *Response, error) {
+	buf, err := json.Marshal(&ChannelMembersBulkRequest{UserIds: userIds})
+	if err != nil {
+		return nil, nil, NewAppError(where, "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(url, buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var result ChannelMembersBulkResult
+	if err := json.NewDecoder(r.Body).Decode(&result); err != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &result, BuildResponse(r), nil
+}
+
+// RequestToJoinChannel asks the admins of a private channel to add the current user to it.
+func (c *Client4) RequestToJoinChannel(channelId, message string) (*ChannelJoinRequest, *Response, error) {
+	buf, err := json.Marshal(&ChannelJoinRequest{Message: message})
+	if err != nil {
+		return nil, nil, NewAppError("RequestToJoinChannel", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.channelRoute(channelId)+"/join_requests", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var joinRequest ChannelJoinRequest
+	if err := json.NewDecoder(r.Body).Decode(&joinRequest); err != nil {
+		return nil, nil, NewAppError("RequestToJoinChannel", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &joinRequest, BuildResponse(r), nil
+}
+
+// GetChannelJoinRequests returns a page of the requests to join a channel, the most recent first.
+// An empty status returns the requests whatever their status.
+func (c *Client4) GetChannelJoinRequests(channelId, status string, page, perPage int) ([]*ChannelJoinRequest, *Response, error) {
+	values := url.Values{}
+	values.Set("status", status)
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/join_requests?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*ChannelJoinRequest
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetChannelJoinRequests", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// ApproveChannelJoinRequest approves a pending request to join a channel, adding its user to the channel.
+func (c *Client4) ApproveChannelJoinRequest(channelId, requestId string) (*ChannelJoinRequest, *Response, error) {
+	return c.decideChannelJoinRequest(channelId, requestId, "approve")
+}
+
+// DenyChannelJoinRequest denies a pending request to join a channel.
+func (c *Client4) DenyChannelJoinRequest(channelId, requestId string) (*ChannelJoinRequest, *Response, error) {
+	return c.decideChannelJoinRequest(channelId, requestId, "deny")
+}
+
+func (c *Client4) decideChannelJoinRequest(channelId, requestId, decision string) (*ChannelJoinRequest, *Response, error) {
+	r, err := c.DoAPIPost(c.channelRoute(channelId)+"/join_requests/"+requestId+"/"+decision, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var joinRequest ChannelJoinRequest
+	if err := json.NewDecoder(r.Body).Decode(&joinRequest); err != nil {
+		return nil, nil, NewAppError("decideChannelJoinRequest", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &joinRequest, BuildResponse(r), nil
+}
+
 // RemoveUserFromChannel will delete the channel member object for a user, effectively removing the user from a channel.
 func (c *Client4) RemoveUserFromChannel(channelId, userId string) (*Response, error) {
 	r, err := c.DoAPIDelete(c.channelMemberRoute(channelId, userId))
@@ -3981,123 +4865,366 @@ func (c *Client4) GetPostsForChannel(channelId string, page, perPage int, etag s
 	if includeDeleted {
 		query += "&include_deleted=true"
 	}
-	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/posts"+query, etag)
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/posts"+query, etag)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list PostList
+	if r.StatusCode == http.StatusNotModified {
+		return &list, BuildResponse(r), nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetPostsForChannel", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &list, BuildResponse(r), nil
+}
+
+// GetPostsByIds gets a list of posts by taking an array of post ids
+func (c *Client4) GetPostsByIds(postIds []string) ([]*Post, *Response, error) {
+	js, err := json.Marshal(postIds)
+	if err != nil {
+		return nil, nil, NewAppError("SearchFilesWithParams", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPost(c.postsRoute()+"/ids", string(js))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*Post
+	if r.StatusCode == http.StatusNotModified {
+		return list, BuildResponse(r), nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetPostsByIds", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetEditHistoryForPost gets a list of posts by taking a post ids
+func (c *Client4) GetEditHistoryForPost(postId string) ([]*Post, *Response, error) {
+	js, err := json.Marshal(postId)
+	if err != nil {
+		return nil, nil, NewAppError("GetEditHistoryForPost", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIGet(c.postRoute(postId)+"/edit_history", string(js))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*Post
+	if r.StatusCode == http.StatusNotModified {
+		return list, BuildResponse(r), nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetEditHistoryForPost", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// TranslatePost translates the message of a post into language, or into the user's translation
+// language if empty.
+func (c *Client4) TranslatePost(postId, language string) (*PostTranslation, *Response, error) {
+	r, err := c.DoAPIPost(c.postRoute(postId)+"/translate", MapToJSON(map[string]string{"language": language}))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var translation PostTranslation
+	if err := json.NewDecoder(r.Body).Decode(&translation); err != nil {
+		return nil, nil, NewAppError("TranslatePost", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &translation, BuildResponse(r), nil
+}
+
+// MarkPostAsRead records that the user has read the channel of the post up to that post.
+func (c *Client4) MarkPostAsRead(userId, postId string) (*ReadReceipt, *Response, error) {
+	r, err := c.DoAPIPost(c.userRoute(userId)+c.postRoute(postId)+"/read", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var receipt ReadReceipt
+	if err := json.NewDecoder(r.Body).Decode(&receipt); err != nil {
+		return nil, nil, NewAppError("MarkPostAsRead", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &receipt, BuildResponse(r), nil
+}
+
+// GetReadReceiptsForPost returns the read receipts of the members who have read the post.
+func (c *Client4) GetReadReceiptsForPost(postId string) ([]*ReadReceipt, *Response, error) {
+	r, err := c.DoAPIGet(c.postRoute(postId)+"/read_receipts", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*ReadReceipt
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetReadReceiptsForPost", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetFlaggedPostsForUser returns flagged posts of a user based on user id string.
+func (c *Client4) GetFlaggedPostsForUser(userId string, page int, perPage int) (*PostList, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/posts/flagged"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list PostList
+	if r.StatusCode == http.StatusNotModified {
+		return &list, BuildResponse(r), nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetFlaggedPostsForUser", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &list, BuildResponse(r), nil
+}
+
+// GetFlaggedPostsForUserInTeam returns flagged posts in team of a user based on user id string.
+func (c *Client4) GetFlaggedPostsForUserInTeam(userId string, teamId string, page int, perPage int) (*PostList, *Response, error) {
+	if !IsValidId(teamId) {
+		return nil, nil, NewAppError("GetFlaggedPostsForUserInTeam", "model.client.get_flagged_posts_in_team.missing_parameter.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	query := fmt.Sprintf("?team_id=%v&page=%v&per_page=%v", teamId, page, perPage)
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/posts/flagged"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list PostList
+	if r.StatusCode == http.StatusNotModified {
+		return &list, BuildResponse(r), nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetFlaggedPostsForUserInTeam", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &list, BuildResponse(r), nil
+}
+
+// GetFlaggedPostsForUserInChannel returns flagged posts in channel of a user based on user id string.
+func (c *Client4) GetFlaggedPostsForUserInChannel(userId string, channelId string, page int, perPage int) (*PostList, *Response, error) {
+	if !IsValidId(channelId) {
+		return nil, nil, NewAppError("GetFlaggedPostsForUserInChannel", "model.client.get_flagged_posts_in_channel.missing_parameter.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	query := fmt.Sprintf("?channel_id=%v&page=%v&per_page=%v", channelId, page, perPage)
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/posts/flagged"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list PostList
+	if r.StatusCode == http.StatusNotModified {
+		return &list, BuildResponse(r), nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetFlaggedPostsForUserInChannel", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &list, BuildResponse(r), nil
+}
+
+// GetFlaggedPostsForUserWithLabel returns the flagged posts of a user filed under one of their saved post labels.
+func (c *Client4) GetFlaggedPostsForUserWithLabel(userId string, labelId string, page int, perPage int) (*PostList, *Response, error) {
+	if !IsValidId(labelId) {
+		return nil, nil, NewAppError("GetFlaggedPostsForUserWithLabel", "model.client.get_flagged_posts_with_label.missing_parameter.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	query := fmt.Sprintf("?label_id=%v&page=%v&per_page=%v", labelId, page, perPage)
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/posts/flagged"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list PostList
+	if r.StatusCode == http.StatusNotModified {
+		return &list, BuildResponse(r), nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetFlaggedPostsForUserWithLabel", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &list, BuildResponse(r), nil
+}
+
+// GetSavedPostLabels returns the labels a user files their saved posts under.
+func (c *Client4) GetSavedPostLabels(userId string) ([]*SavedPostLabel, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/saved_post_labels", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var labels []*SavedPostLabel
+	if err := json.NewDecoder(r.Body).Decode(&labels); err != nil {
+		return nil, nil, NewAppError("GetSavedPostLabels", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return labels, BuildResponse(r), nil
+}
+
+// CreateSavedPostLabel creates a label for a user to file their saved posts under.
+func (c *Client4) CreateSavedPostLabel(userId string, label *SavedPostLabel) (*SavedPostLabel, *Response, error) {
+	buf, err := json.Marshal(label)
+	if err != nil {
+		return nil, nil, NewAppError("CreateSavedPostLabel", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.userRoute(userId)+"/saved_post_labels", buf)
 	if err != nil {
 		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
-	var list PostList
-	if r.StatusCode == http.StatusNotModified {
-		return &list, BuildResponse(r), nil
-	}
-	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
-		return nil, nil, NewAppError("GetPostsForChannel", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	var saved SavedPostLabel
+	if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+		return nil, nil, NewAppError("CreateSavedPostLabel", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
-	return &list, BuildResponse(r), nil
+	return &saved, BuildResponse(r), nil
 }
 
-// GetPostsByIds gets a list of posts by taking an array of post ids
-func (c *Client4) GetPostsByIds(postIds []string) ([]*Post, *Response, error) {
-	js, err := json.Marshal(postIds)
+// UpdateSavedPostLabel renames a saved post label of a user.
+func (c *Client4) UpdateSavedPostLabel(userId string, label *SavedPostLabel) (*SavedPostLabel, *Response, error) {
+	buf, err := json.Marshal(label)
 	if err != nil {
-		return nil, nil, NewAppError("SearchFilesWithParams", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return nil, nil, NewAppError("UpdateSavedPostLabel", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
-	r, err := c.DoAPIPost(c.postsRoute()+"/ids", string(js))
+	r, err := c.DoAPIPutBytes(c.userRoute(userId)+"/saved_post_labels/"+label.Id, buf)
 	if err != nil {
 		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
-	var list []*Post
-	if r.StatusCode == http.StatusNotModified {
-		return list, BuildResponse(r), nil
+	var updated SavedPostLabel
+	if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+		return nil, nil, NewAppError("UpdateSavedPostLabel", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
-	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
-		return nil, nil, NewAppError("GetPostsByIds", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	return &updated, BuildResponse(r), nil
+}
+
+// DeleteSavedPostLabel deletes a saved post label of a user. The posts filed under it stay saved.
+func (c *Client4) DeleteSavedPostLabel(userId string, labelId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.userRoute(userId) + "/saved_post_labels/" + labelId)
+	if err != nil {
+		return BuildResponse(r), err
 	}
-	return list, BuildResponse(r), nil
+	defer closeBody(r)
+	return BuildResponse(r), nil
 }
 
-// GetEditHistoryForPost gets a list of posts by taking a post ids
-func (c *Client4) GetEditHistoryForPost(postId string) ([]*Post, *Response, error) {
-	js, err := json.Marshal(postId)
+// LabelSavedPost files a post under a saved post label of a user, saving the post if needed.
+func (c *Client4) LabelSavedPost(userId string, postId string, labelId string) (*Response, error) {
+	r, err := c.DoAPIPost(c.userRoute(userId)+"/posts/"+postId+"/saved_post_labels/"+labelId, "")
 	if err != nil {
-		return nil, nil, NewAppError("GetEditHistoryForPost", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return BuildResponse(r), err
 	}
-	r, err := c.DoAPIGet(c.postRoute(postId)+"/edit_history", string(js))
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// UnlabelSavedPost removes a saved post label of a user from a post.
+func (c *Client4) UnlabelSavedPost(userId string, postId string, labelId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.userRoute(userId) + "/posts/" + postId + "/saved_post_labels/" + labelId)
 	if err != nil {
-		return nil, BuildResponse(r), err
+		return BuildResponse(r), err
 	}
 	defer closeBody(r)
-	var list []*Post
-	if r.StatusCode == http.StatusNotModified {
-		return list, BuildResponse(r), nil
+	return BuildResponse(r), nil
+}
+
+// GetBootstrap returns everything the current user needs to start a client up. Sections whose
+// ETag is in etags, as returned by a previous call, are left out when they haven't changed.
+func (c *Client4) GetBootstrap(etags map[string]string) (*Bootstrap, *Response, error) {
+	route := c.userRoute(Me) + "/bootstrap"
+	if len(etags) > 0 {
+		route += "?etags=" + url.QueryEscape(BootstrapETagsToString(etags))
 	}
-	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
-		return nil, nil, NewAppError("GetEditHistoryForPost", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+
+	r, err := c.DoAPIGet(route, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
 	}
-	return list, BuildResponse(r), nil
+	defer closeBody(r)
+	var bootstrap Bootstrap
+	if err := json.NewDecoder(r.Body).Decode(&bootstrap); err != nil {
+		return nil, nil, NewAppError("GetBootstrap", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &bootstrap, BuildResponse(r), nil
 }
 
-// GetFlaggedPostsForUser returns flagged posts of a user based on user id string.
-func (c *Client4) GetFlaggedPostsForUser(userId string, page int, perPage int) (*PostList, *Response, error) {
-	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
-	r, err := c.DoAPIGet(c.userRoute(userId)+"/posts/flagged"+query, "")
+// GetChannelResourceHints returns the profiles, custom emojis and link previews the latest posts
+// of a channel need, for the client to prefetch them when switching to the channel.
+func (c *Client4) GetChannelResourceHints(channelId string) (*ChannelResourceHints, *Response, error) {
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/resource_hints", "")
 	if err != nil {
 		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
-	var list PostList
-	if r.StatusCode == http.StatusNotModified {
-		return &list, BuildResponse(r), nil
+	var hints ChannelResourceHints
+	if err := json.NewDecoder(r.Body).Decode(&hints); err != nil {
+		return nil, nil, NewAppError("GetChannelResourceHints", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
-	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
-		return nil, nil, NewAppError("GetFlaggedPostsForUser", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
-	}
-	return &list, BuildResponse(r), nil
+	return &hints, BuildResponse(r), nil
 }
 
-// GetFlaggedPostsForUserInTeam returns flagged posts in team of a user based on user id string.
-func (c *Client4) GetFlaggedPostsForUserInTeam(userId string, teamId string, page int, perPage int) (*PostList, *Response, error) {
-	if !IsValidId(teamId) {
-		return nil, nil, NewAppError("GetFlaggedPostsForUserInTeam", "model.client.get_flagged_posts_in_team.missing_parameter.app_error", nil, "", http.StatusBadRequest)
+// GetInboxForUser returns a page of the unread channels and threads of a user across all their
+// teams. An empty cursor gets the first page, the NextCursor of a page gets the one after it.
+func (c *Client4) GetInboxForUser(userId, cursor string, perPage int) (*Inbox, *Response, error) {
+	query := url.Values{}
+	query.Set("per_page", strconv.Itoa(perPage))
+	if cursor != "" {
+		query.Set("cursor", cursor)
 	}
 
-	query := fmt.Sprintf("?team_id=%v&page=%v&per_page=%v", teamId, page, perPage)
-	r, err := c.DoAPIGet(c.userRoute(userId)+"/posts/flagged"+query, "")
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/inbox?"+query.Encode(), "")
 	if err != nil {
 		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
-	var list PostList
-	if r.StatusCode == http.StatusNotModified {
-		return &list, BuildResponse(r), nil
-	}
-	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
-		return nil, nil, NewAppError("GetFlaggedPostsForUserInTeam", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	var inbox Inbox
+	if err := json.NewDecoder(r.Body).Decode(&inbox); err != nil {
+		return nil, nil, NewAppError("GetInboxForUser", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
-	return &list, BuildResponse(r), nil
+	return &inbox, BuildResponse(r), nil
 }
 
-// GetFlaggedPostsForUserInChannel returns flagged posts in channel of a user based on user id string.
-func (c *Client4) GetFlaggedPostsForUserInChannel(userId string, channelId string, page int, perPage int) (*PostList, *Response, error) {
-	if !IsValidId(channelId) {
-		return nil, nil, NewAppError("GetFlaggedPostsForUserInChannel", "model.client.get_flagged_posts_in_channel.missing_parameter.app_error", nil, "", http.StatusBadRequest)
+// GetActivityFeed returns a page of the activity feed of a user, optionally limited to some
+// activity types or to the unread activities.
+func (c *Client4) GetActivityFeed(userId string, types []string, unreadOnly bool, page, perPage int) (*ActivityFeed, *Response, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("per_page", strconv.Itoa(perPage))
+	if len(types) > 0 {
+		query.Set("types", strings.Join(types, ","))
+	}
+	if unreadOnly {
+		query.Set("unread_only", "true")
 	}
 
-	query := fmt.Sprintf("?channel_id=%v&page=%v&per_page=%v", channelId, page, perPage)
-	r, err := c.DoAPIGet(c.userRoute(userId)+"/posts/flagged"+query, "")
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/activity?"+query.Encode(), "")
 	if err != nil {
 		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
-	var list PostList
-	if r.StatusCode == http.StatusNotModified {
-		return &list, BuildResponse(r), nil
+	var feed ActivityFeed
+	if err := json.NewDecoder(r.Body).Decode(&feed); err != nil {
+		return nil, nil, NewAppError("GetActivityFeed", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
-	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
-		return nil, nil, NewAppError("GetFlaggedPostsForUserInChannel", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	return &feed, BuildResponse(r), nil
+}
+
+// MarkActivitiesAsRead marks some of the activities of the feed of a user, or all of them, as read.
+func (c *Client4) MarkActivitiesAsRead(userId string, readRequest *ActivityReadRequest) (*Response, error) {
+	buf, err := json.Marshal(readRequest)
+	if err != nil {
+		return nil, NewAppError("MarkActivitiesAsRead", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
-	return &list, BuildResponse(r), nil
+	r, err := c.DoAPIPostBytes(c.userRoute(userId)+"/activity/read", buf)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
 }
 
 // GetPostsSince gets posts created after a specified time as Unix time in milliseconds.
@@ -4744,6 +5871,30 @@ func (c *Client4) InvalidateCaches() (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// GetCaches returns the caches of the server handling the request, with their number of items.
+func (c *Client4) GetCaches() ([]*CacheInfo, *Response, error) {
+	r, err := c.DoAPIGet(c.cacheRoute(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*CacheInfo
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetCaches", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// FlushCache purges the cache with the given name on every server of the cluster.
+func (c *Client4) FlushCache(name string) (*Response, error) {
+	r, err := c.DoAPIPost(c.cacheRoute()+"/"+name+"/flush", "")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // UpdateConfig will update the server configuration.
 func (c *Client4) UpdateConfig(config *Config) (*Config, *Response, error) {
 	buf, err := json.Marshal(config)
@@ -4761,6 +5912,52 @@ func (c *Client4) UpdateConfig(config *Config) (*Config, *Response, error) {
 	return cfg, BuildResponse(r), d.Decode(&cfg)
 }
 
+// ValidateConfig checks the config without saving it, probing the services it configures.
+func (c *Client4) ValidateConfig(config *Config) (*ConfigValidationReport, *Response, error) {
+	buf, err := json.Marshal(config)
+	if err != nil {
+		return nil, nil, NewAppError("ValidateConfig", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.configRoute()+"/validate", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var report ConfigValidationReport
+	if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+		return nil, nil, NewAppError("ValidateConfig", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &report, BuildResponse(r), nil
+}
+
+// GetConfigHistory returns a page of the changes made to the config, the newest first.
+func (c *Client4) GetConfigHistory(page, perPage int) ([]*ConfigChange, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.configRoute()+"/history"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*ConfigChange
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetConfigHistory", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// RollbackConfig restores the config saved by the given change.
+func (c *Client4) RollbackConfig(changeId string) (*Config, *Response, error) {
+	r, err := c.DoAPIPost(c.configRoute()+"/history/"+changeId+"/rollback", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var cfg *Config
+	d := json.NewDecoder(r.Body)
+	return cfg, BuildResponse(r), d.Decode(&cfg)
+}
+
 // MigrateConfig will migrate existing config to the new one.
 // DEPRECATED: The config migrate API has been moved to be a purely
 // mmctl --local endpoint. This method will be removed in a
@@ -4849,6 +6046,39 @@ func (c *Client4) GetAnalyticsOld(name, teamId string) (AnalyticsRows, *Response
 	return rows, BuildResponse(r), nil
 }
 
+// GetReactionAnalytics returns the emojis used the most in reactions and the posts that received
+// the most reactions, scoped by opts. Zero values in opts are left to the server's defaults.
+func (c *Client4) GetReactionAnalytics(opts ReactionAnalyticsOptions) (*ReactionAnalytics, *Response, error) {
+	values := url.Values{}
+	if opts.TeamId != "" {
+		values.Set("team_id", opts.TeamId)
+	}
+	if opts.ChannelId != "" {
+		values.Set("channel_id", opts.ChannelId)
+	}
+	if opts.Since != 0 {
+		values.Set("since", strconv.FormatInt(opts.Since, 10))
+	}
+	if opts.Until != 0 {
+		values.Set("until", strconv.FormatInt(opts.Until, 10))
+	}
+	if opts.Limit != 0 {
+		values.Set("limit", strconv.Itoa(opts.Limit))
+	}
+
+	r, err := c.DoAPIGet(c.analyticsRoute()+"/reactions?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var analytics ReactionAnalytics
+	if err := json.NewDecoder(r.Body).Decode(&analytics); err != nil {
+		return nil, BuildResponse(r), NewAppError("GetReactionAnalytics", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &analytics, BuildResponse(r), nil
+}
+
 // Webhooks Section
 
 // CreateIncomingWebhook creates an incoming webhook for a channel.
@@ -5080,6 +6310,81 @@ func (c *Client4) DeleteOutgoingWebhook(hookId string) (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// CreateDirectOutgoingWebhook creates an outgoing webhook for the direct and group messages of a bot.
+func (c *Client4) CreateDirectOutgoingWebhook(hook *DirectOutgoingWebhook) (*DirectOutgoingWebhook, *Response, error) {
+	buf, err := json.Marshal(hook)
+	if err != nil {
+		return nil, nil, NewAppError("CreateDirectOutgoingWebhook", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.directOutgoingWebhooksRoute(), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var ow DirectOutgoingWebhook
+	if err := json.NewDecoder(r.Body).Decode(&ow); err != nil {
+		return nil, nil, NewAppError("CreateDirectOutgoingWebhook", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &ow, BuildResponse(r), nil
+}
+
+// UpdateDirectOutgoingWebhook updates an outgoing webhook for the direct and group messages of a bot.
+func (c *Client4) UpdateDirectOutgoingWebhook(hook *DirectOutgoingWebhook) (*DirectOutgoingWebhook, *Response, error) {
+	buf, err := json.Marshal(hook)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateDirectOutgoingWebhook", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.directOutgoingWebhookRoute(hook.Id), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var ow DirectOutgoingWebhook
+	if err := json.NewDecoder(r.Body).Decode(&ow); err != nil {
+		return nil, nil, NewAppError("UpdateDirectOutgoingWebhook", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &ow, BuildResponse(r), nil
+}
+
+// GetDirectOutgoingWebhooks returns a page of the direct outgoing webhooks on the system. Page counting starts at 0.
+func (c *Client4) GetDirectOutgoingWebhooks(page int, perPage int) ([]*DirectOutgoingWebhook, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.directOutgoingWebhooksRoute()+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var owl []*DirectOutgoingWebhook
+	if err := json.NewDecoder(r.Body).Decode(&owl); err != nil {
+		return nil, nil, NewAppError("GetDirectOutgoingWebhooks", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return owl, BuildResponse(r), nil
+}
+
+// GetDirectOutgoingWebhook returns the direct outgoing webhook requested by Hook Id.
+func (c *Client4) GetDirectOutgoingWebhook(hookId string) (*DirectOutgoingWebhook, *Response, error) {
+	r, err := c.DoAPIGet(c.directOutgoingWebhookRoute(hookId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var ow DirectOutgoingWebhook
+	if err := json.NewDecoder(r.Body).Decode(&ow); err != nil {
+		return nil, nil, NewAppError("GetDirectOutgoingWebhook", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &ow, BuildResponse(r), nil
+}
+
+// DeleteDirectOutgoingWebhook deletes the direct outgoing webhook requested by Hook Id.
+func (c *Client4) DeleteDirectOutgoingWebhook(hookId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.directOutgoingWebhookRoute(hookId))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // Preferences Section
 
 // GetPreferences returns the user's preferences.
@@ -5410,6 +6715,44 @@ func (c *Client4) GetClusterStatus() ([]*ClusterInfo, *Response, error) {
 	return list, BuildResponse(r), nil
 }
 
+func (c *Client4) clusterNodeDrainRoute(nodeID string) string {
+	return c.clusterRoute() + "/nodes/" + nodeID + "/drain"
+}
+
+// GetClusterNodeDrainState returns the drain state of the cluster node.
+func (c *Client4) GetClusterNodeDrainState(nodeID string) (*ClusterNodeDrainState, *Response, error) {
+	r, err := c.DoAPIGet(c.clusterNodeDrainRoute(nodeID), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var state ClusterNodeDrainState
+	if err := json.NewDecoder(r.Body).Decode(&state); err != nil {
+		return nil, nil, NewAppError("GetClusterNodeDrainState", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &state, BuildResponse(r), nil
+}
+
+// DrainClusterNode starts draining the cluster node, for it to be stopped once drained.
+func (c *Client4) DrainClusterNode(nodeID string) (*Response, error) {
+	r, err := c.DoAPIPost(c.clusterNodeDrainRoute(nodeID), "")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// UndrainClusterNode stops draining the cluster node.
+func (c *Client4) UndrainClusterNode(nodeID string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.clusterNodeDrainRoute(nodeID))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // LDAP Section
 
 // SyncLdap will force a sync with the configured LDAP server.
@@ -5991,8 +7334,50 @@ func (c *Client4) GetOAuthAccessToken(data url.Values) (*AccessResponse, *Respon
 	if err != nil {
 		return nil, BuildResponse(rp), NewAppError(url, "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
-
-	return ar, BuildResponse(rp), nil
+
+	return ar, BuildResponse(rp), nil
+}
+
+// GetOpenIDConfiguration returns the OpenID Connect discovery document of the server.
+func (c *Client4) GetOpenIDConfiguration() (*OpenIDConfiguration, *Response, error) {
+	r, err := c.DoAPIRequest(http.MethodGet, c.URL+"/.well-known/openid-configuration", "", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var configuration OpenIDConfiguration
+	if err := json.NewDecoder(r.Body).Decode(&configuration); err != nil {
+		return nil, nil, NewAppError("GetOpenIDConfiguration", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &configuration, BuildResponse(r), nil
+}
+
+// GetOAuthJSONWebKeySet returns the keys the ID tokens of the server are signed with.
+func (c *Client4) GetOAuthJSONWebKeySet() (*JSONWebKeySet, *Response, error) {
+	r, err := c.DoAPIRequest(http.MethodGet, c.URL+"/oauth/jwks", "", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var keySet JSONWebKeySet
+	if err := json.NewDecoder(r.Body).Decode(&keySet); err != nil {
+		return nil, nil, NewAppError("GetOAuthJSONWebKeySet", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &keySet, BuildResponse(r), nil
+}
+
+// GetOAuthUserInfo returns the claims about the user of the OAuth access token of the client.
+func (c *Client4) GetOAuthUserInfo() (*OpenIDUserInfo, *Response, error) {
+	r, err := c.DoAPIRequest(http.MethodGet, c.URL+"/oauth/userinfo", "", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var userInfo OpenIDUserInfo
+	if err := json.NewDecoder(r.Body).Decode(&userInfo); err != nil {
+		return nil, nil, NewAppError("GetOAuthUserInfo", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &userInfo, BuildResponse(r), nil
 }
 
 // Elasticsearch Section
@@ -6030,6 +7415,23 @@ func (c *Client4) PurgeBleveIndexes() (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// Search Engines Section
+
+// GetSearchEngineQueryStats returns the latency and health of the search engines for each type of
+// query they were sent since the server started.
+func (c *Client4) GetSearchEngineQueryStats() ([]*SearchEngineQueryStats, *Response, error) {
+	r, err := c.DoAPIGet("/search_engines/stats", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*SearchEngineQueryStats
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetSearchEngineQueryStats", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
 // Data Retention Section
 
 // GetDataRetentionPolicy will get the current global data retention policy details.
@@ -6113,6 +7515,26 @@ func (c *Client4) CreateDataRetentionPolicy(policy *RetentionPolicyWithTeamAndCh
 	return &p, BuildResponse(r), nil
 }
 
+// PreviewDataRetentionPolicy reports how many posts and files the granular data retention policy
+// would delete if it was applied now. The policy is neither saved nor applied. To preview changes
+// to an existing policy, set its ID in the policy.
+func (c *Client4) PreviewDataRetentionPolicy(policy *RetentionPolicyWithTeamAndChannelIDs) (*RetentionPolicyPreview, *Response, error) {
+	policyJSON, err := json.Marshal(policy)
+	if err != nil {
+		return nil, nil, NewAppError("PreviewDataRetentionPolicy", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.dataRetentionRoute()+"/policies/preview", policyJSON)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var preview RetentionPolicyPreview
+	if err := json.NewDecoder(r.Body).Decode(&preview); err != nil {
+		return nil, nil, NewAppError("PreviewDataRetentionPolicy", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &preview, BuildResponse(r), nil
+}
+
 // DeleteDataRetentionPolicy will delete the granular data retention policy with the specified ID.
 func (c *Client4) DeleteDataRetentionPolicy(policyID string) (*Response, error) {
 	r, err := c.DoAPIDelete(c.dataRetentionPolicyRoute(policyID))
@@ -6319,6 +7741,27 @@ func (c *Client4) UpsertDraft(draft *Draft) (*Draft, *Response, error) {
 	return &df, BuildResponse(r), err
 }
 
+// MergeDraft will merge a draft into the stored one it conflicts with
+func (c *Client4) MergeDraft(draft *Draft) (*Draft, *Response, error) {
+	buf, err := json.Marshal(draft)
+	if err != nil {
+		return nil, nil, NewAppError("MergeDraft", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPostBytes(c.draftsRoute()+"/merge", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var df Draft
+	err = json.NewDecoder(r.Body).Decode(&df)
+	if err != nil {
+		return nil, nil, NewAppError("MergeDraft", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &df, BuildResponse(r), nil
+}
+
 // GetDrafts will get all drafts for a user
 func (c *Client4) GetDrafts(userId, teamId string) ([]*Draft, *Response, error) {
 	r, err := c.DoAPIGet(c.userRoute(userId)+c.teamRoute(teamId)+"/drafts", "")
@@ -6349,6 +7792,73 @@ func (c *Client4) DeleteDraft(userId, channelId, rootId string) (*Draft, *Respon
 	return df, BuildResponse(r), nil
 }
 
+// Scheduled Posts Section
+
+// CreateScheduledPost schedules a post to be created on behalf of the current user at its ScheduledAt time.
+func (c *Client4) CreateScheduledPost(scheduledPost *ScheduledPost) (*ScheduledPost, *Response, error) {
+	buf, err := json.Marshal(scheduledPost)
+	if err != nil {
+		return nil, nil, NewAppError("CreateScheduledPost", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPostBytes(c.postsRoute()+"/schedule", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var sp ScheduledPost
+	if err := json.NewDecoder(r.Body).Decode(&sp); err != nil {
+		return nil, nil, NewAppError("CreateScheduledPost", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &sp, BuildResponse(r), nil
+}
+
+// GetScheduledPosts returns the scheduled posts of a user, including those that failed to be delivered.
+func (c *Client4) GetScheduledPosts(userId string) ([]*ScheduledPost, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/posts/scheduled", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var scheduledPosts []*ScheduledPost
+	if err := json.NewDecoder(r.Body).Decode(&scheduledPosts); err != nil {
+		return nil, nil, NewAppError("GetScheduledPosts", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return scheduledPosts, BuildResponse(r), nil
+}
+
+// UpdateScheduledPost changes the content or the scheduled time of a scheduled post.
+func (c *Client4) UpdateScheduledPost(scheduledPost *ScheduledPost) (*ScheduledPost, *Response, error) {
+	buf, err := json.Marshal(scheduledPost)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateScheduledPost", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPutBytes(c.postsRoute()+"/schedule/"+scheduledPost.Id, buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var sp ScheduledPost
+	if err := json.NewDecoder(r.Body).Decode(&sp); err != nil {
+		return nil, nil, NewAppError("UpdateScheduledPost", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &sp, BuildResponse(r), nil
+}
+
+// DeleteScheduledPost discards a scheduled post.
+func (c *Client4) DeleteScheduledPost(scheduledPostId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.postsRoute() + "/schedule/" + scheduledPostId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // Commands Section
 
 // CreateCommand will create a new command if the user have the right permissions.
@@ -6611,6 +8121,51 @@ func (c *Client4) RemoveUserCustomStatus(userId string) (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// GetUserCalendarConnection returns the connection of a user to the calendar of a service, without its tokens.
+func (c *Client4) GetUserCalendarConnection(userId, service string) (*OAuthConnection, *Response, error) {
+	r, err := c.DoAPIGet(c.userStatusRoute(userId)+"/calendar/"+service, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var connection OAuthConnection
+	if err := json.NewDecoder(r.Body).Decode(&connection); err != nil {
+		return nil, BuildResponse(r), NewAppError("GetUserCalendarConnection", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &connection, BuildResponse(r), nil
+}
+
+// SaveUserCalendarConnection stores the OAuth tokens a user granted to read their calendar from a
+// service, which their status is synced with.
+func (c *Client4) SaveUserCalendarConnection(userId string, connection *OAuthConnection) (*OAuthConnection, *Response, error) {
+	buf, err := json.Marshal(connection)
+	if err != nil {
+		return nil, nil, NewAppError("SaveUserCalendarConnection", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.userStatusRoute(userId)+"/calendar/"+connection.Service, buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var saved OAuthConnection
+	if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+		return nil, BuildResponse(r), NewAppError("SaveUserCalendarConnection", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &saved, BuildResponse(r), nil
+}
+
+// DeleteUserCalendarConnection removes the connection of a user to the calendar of a service.
+func (c *Client4) DeleteUserCalendarConnection(userId, service string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.userStatusRoute(userId) + "/calendar/" + service)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // RemoveRecentUserCustomStatus remove a recent user's custom status based on the provided user id string.
 func (c *Client4) RemoveRecentUserCustomStatus(userId string) (*Response, error) {
 	r, err := c.DoAPIDelete(c.userStatusRoute(userId) + "/custom/recent")
@@ -6954,6 +8509,96 @@ func (c *Client4) GetJobsByType(jobType string, page int, perPage int) ([]*Job,
 	return list, BuildResponse(r), nil
 }
 
+// GetJobsByTypeAndStatus gets a page of the jobs of the type with the given status, e.g. to list
+// the recent failures of a type.
+func (c *Client4) GetJobsByTypeAndStatus(jobType string, status string, page int, perPage int) ([]*Job, *Response, error) {
+	values := url.Values{}
+	values.Set("status", status)
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	r, err := c.DoAPIGet(c.jobsRoute()+"/type/"+jobType+"?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*Job
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetJobsByTypeAndStatus", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetJobTypeSettings gets the priority, concurrency limit, schedule and whether the job types
+// are paused.
+func (c *Client4) GetJobTypeSettings() ([]*JobTypeSettings, *Response, error) {
+	r, err := c.DoAPIGet(c.jobsRoute()+"/settings", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*JobTypeSettings
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetJobTypeSettings", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// PatchJobTypeSettings changes the priority, concurrency limit or schedule of a job type.
+func (c *Client4) PatchJobTypeSettings(jobType string, patch *JobTypeSettingsPatch) (*JobTypeSettings, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchJobTypeSettings", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.jobsRoute()+"/type/"+jobType+"/settings", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var settings JobTypeSettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		return nil, nil, NewAppError("PatchJobTypeSettings", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &settings, BuildResponse(r), nil
+}
+
+// PauseJobType stops the pending jobs of a type from starting and the type from being scheduled.
+func (c *Client4) PauseJobType(jobType string) (*JobTypeSettings, *Response, error) {
+	return c.doJobTypeSettingsRequest("PauseJobType", c.jobsRoute()+"/type/"+jobType+"/pause")
+}
+
+// ResumeJobType resumes a paused job type.
+func (c *Client4) ResumeJobType(jobType string) (*JobTypeSettings, *Response, error) {
+	return c.doJobTypeSettingsRequest("ResumeJobType", c.jobsRoute()+"/type/"+jobType+"/resume")
+}
+
+func (c *Client4) doJobTypeSettingsRequest(where, url string) (*JobTypeSettings, *Response, error) {
+	r, err := c.DoAPIPost(url, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var settings JobTypeSettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &settings, BuildResponse(r), nil
+}
+
+// GetJobTypeStats summarizes the jobs created since the given time, in milliseconds, by type: how
+// many there are by status, how long they took and why they recently failed.
+func (c *Client4) GetJobTypeStats(since int64) ([]*JobTypeStats, *Response, error) {
+	r, err := c.DoAPIGet(c.jobsRoute()+"/stats?since="+strconv.FormatInt(since, 10), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*JobTypeStats
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetJobTypeStats", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
 // CreateJob creates a job based on the provided job struct.
 func (c *Client4) CreateJob(job *Job) (*Job, *Response, error) {
 	buf, err := json.Marshal(job)
@@ -7073,6 +8718,78 @@ func (c *Client4) PatchRole(roleId string, patch *RolePatch) (*Role, *Response,
 	return &role, BuildResponse(r), nil
 }
 
+// GetRoleSnapshots returns a page of the permission sets the role had before its changes, the
+// most recent first.
+func (c *Client4) GetRoleSnapshots(roleId string, page int, perPage int) ([]*RoleSnapshot, *Response, error) {
+	r, err := c.DoAPIGet(c.rolesRoute()+fmt.Sprintf("/%v/snapshots?page=%v&per_page=%v", roleId, page, perPage), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*RoleSnapshot
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetRoleSnapshots", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// RevertRoleToSnapshot restores the permissions a role had in one of its snapshots.
+func (c *Client4) RevertRoleToSnapshot(roleId string, snapshotId string) (*Role, *Response, error) {
+	r, err := c.DoAPIPost(c.rolesRoute()+fmt.Sprintf("/%v/snapshots/%v/revert", roleId, snapshotId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var role Role
+	if err := json.NewDecoder(r.Body).Decode(&role); err != nil {
+		return nil, nil, NewAppError("RevertRoleToSnapshot", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &role, BuildResponse(r), nil
+}
+
+// GetCustomAdminRoles returns the custom admin roles of the system.
+func (c *Client4) GetCustomAdminRoles() ([]*Role, *Response, error) {
+	r, err := c.DoAPIGet(c.rolesRoute()+"/custom_admin", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*Role
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetCustomAdminRoles", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// CreateCustomAdminRole creates a custom admin role out of capability sets and permissions,
+// optionally restricted to some teams.
+func (c *Client4) CreateCustomAdminRole(customRole *CustomAdminRole) (*Role, *Response, error) {
+	buf, err := json.Marshal(customRole)
+	if err != nil {
+		return nil, nil, NewAppError("CreateCustomAdminRole", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(c.rolesRoute()+"/custom_admin", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var role Role
+	if err := json.NewDecoder(r.Body).Decode(&role); err != nil {
+		return nil, nil, NewAppError("CreateCustomAdminRole", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &role, BuildResponse(r), nil
+}
+
+// DeleteCustomAdminRole deletes a custom admin role by ID.
+func (c *Client4) DeleteCustomAdminRole(roleId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.rolesRoute() + fmt.Sprintf("/custom_admin/%v", roleId))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // Schemes Section
 
 // CreateScheme creates a new Scheme.
@@ -7752,6 +9469,25 @@ func (c *Client4) GetChannelModerations(channelID string, etag string) ([]*Chann
 	return ch, BuildResponse(r), nil
 }
 
+// UpdateChannelNotifyDefaults sets the notification settings of the channel members who haven't chosen their own.
+func (c *Client4) UpdateChannelNotifyDefaults(channelID string, props StringMap) (*Channel, *Response, error) {
+	buf, err := json.Marshal(props)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateChannelNotifyDefaults", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(c.channelRoute(channelID)+"/notify_defaults", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var ch *Channel
+	if err := json.NewDecoder(r.Body).Decode(&ch); err != nil {
+		return nil, BuildResponse(r), NewAppError("UpdateChannelNotifyDefaults", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return ch, BuildResponse(r), nil
+}
+
 func (c *Client4) PatchChannelModerations(channelID string, patch []*ChannelModerationPatch) ([]*ChannelModeration, *Response, error) {
 	payload, err := json.Marshal(patch)
 	if err != nil {
@@ -8512,6 +10248,42 @@ func (c *Client4) GetRemoteClusterInfo(remoteID string) (RemoteClusterInfo, *Res
 	return rci, BuildResponse(r), nil
 }
 
+// GetSharedChannelFilters returns the filters applied to the content of a shared channel
+// before it is sent to remote clusters.
+func (c *Client4) GetSharedChannelFilters(channelID string) (*SharedChannelFilters, *Response, error) {
+	r, err := c.DoAPIGet(fmt.Sprintf("%s/%s/filters", c.sharedChannelsRoute(), channelID), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var filters SharedChannelFilters
+	if err := json.NewDecoder(r.Body).Decode(&filters); err != nil {
+		return nil, nil, NewAppError("GetSharedChannelFilters", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &filters, BuildResponse(r), nil
+}
+
+// UpdateSharedChannelFilters replaces the filters applied to the content of a shared channel
+// before it is sent to remote clusters.
+func (c *Client4) UpdateSharedChannelFilters(channelID string, filters *SharedChannelFilters) (*SharedChannelFilters, *Response, error) {
+	buf, err := json.Marshal(filters)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateSharedChannelFilters", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(fmt.Sprintf("%s/%s/filters", c.sharedChannelsRoute(), channelID), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var updated SharedChannelFilters
+	if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+		return nil, nil, NewAppError("UpdateSharedChannelFilters", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &updated, BuildResponse(r), nil
+}
+
 func (c *Client4) GetAncillaryPermissions(subsectionPermissions []string) ([]string, *Response, error) {
 	var returnedPermissions []string
 	url := fmt.Sprintf("%s/ancillary?subsection_permissions=%s", c.permissionsRoute(), strings.Join(subsectionPermissions, ","))
@@ -8555,6 +10327,46 @@ func (c *Client4) GetAppliedSchemaMigrations() ([]AppliedMigration, *Response, e
 	return list, BuildResponse(r), nil
 }
 
+// GetMigrationsStatus returns the schema version of the database along with the progress of its
+// online migrations.
+func (c *Client4) GetMigrationsStatus() (*MigrationsStatus, *Response, error) {
+	r, err := c.DoAPIGet(c.systemRoute()+"/schema/migrations/status", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var status MigrationsStatus
+	if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+		return nil, nil, NewAppError("GetMigrationsStatus", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &status, BuildResponse(r), nil
+}
+
+// GetIntegrationsHealth returns the health of the integrations the server has sent requests to.
+func (c *Client4) GetIntegrationsHealth() ([]*IntegrationHealth, *Response, error) {
+	r, err := c.DoAPIGet(c.systemRoute()+"/integrations/health", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*IntegrationHealth
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetIntegrationsHealth", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// ResetIntegrationHealth resets the health of an integration, closing its circuit breaker.
+func (c *Client4) ResetIntegrationHealth(integrationId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.systemRoute() + "/integrations/health/" + integrationId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // Usage Section
 
 // GetPostsUsage returns rounded off total usage of posts for the instance
@@ -0,0 +1,31 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	// The drain state of a draining node is shared with the other nodes of the cluster, the node
+	// refreshing it before it expires for as long as it's draining.
+	ClusterNodeDrainStateExpirySeconds = 60
+)
+
+// ClusterNodeDrainState is the state of a node of the cluster being drained before it's stopped,
+// e.g. for a rolling restart. A draining node reports itself unhealthy to the load balancers,
+// refuses new websocket connections and hands off its jobs to the other nodes, the node being
+// drained once the requests it was handling are finished.
+type ClusterNodeDrainState struct {
+	NodeId               string `json:"node_id"`
+	Draining             bool   `json:"draining"`
+	StartAt              int64  `json:"start_at"`
+	UpdateAt             int64  `json:"update_at"`
+	InFlightRequests     int64  `json:"in_flight_requests"`
+	WebSocketConnections int    `json:"websocket_connections"`
+	JobsHandedOff        bool   `json:"jobs_handed_off"`
+	Drained              bool   `json:"drained"`
+}
+
+// ClusterNodeDrain is the payload of the ClusterEventDrainNode cluster messages, asking the node
+// it's sent to to start or stop draining.
+type ClusterNodeDrain struct {
+	Draining bool `json:"draining"`
+}
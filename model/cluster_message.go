@@ -3,12 +3,24 @@
 
 package model
 
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strconv"
+)
+
 type ClusterEvent string
 
 const (
+	// ClusterEventNone is the invalidation event of the caches shared by the nodes, which don't
+	// need their invalidations sent across the cluster.
+	ClusterEventNone                                        ClusterEvent = ""
 	ClusterEventPublish                                     ClusterEvent = "publish"
 	ClusterEventUpdateStatus                                ClusterEvent = "update_status"
 	ClusterEventInvalidateAllCaches                         ClusterEvent = "inv_all_caches"
+	ClusterEventFlushCache                                  ClusterEvent = "flush_cache"
 	ClusterEventInvalidateCacheForReactions                 ClusterEvent = "inv_reactions"
 	ClusterEventInvalidateCacheForChannelMembersNotifyProps ClusterEvent = "inv_channel_members_notify_props"
 	ClusterEventInvalidateCacheForChannelByName             ClusterEvent = "inv_channel_name"
@@ -39,6 +51,9 @@ const (
 	ClusterEventPluginEvent                                 ClusterEvent = "plugin_event"
 	ClusterEventInvalidateCacheForTermsOfService            ClusterEvent = "inv_terms_of_service"
 	ClusterEventBusyStateChanged                            ClusterEvent = "busy_state_change"
+	ClusterEventPresenceChanged                             ClusterEvent = "presence_changed"
+	ClusterEventDisconnectWebConns                          ClusterEvent = "disconnect_web_conns"
+	ClusterEventDrainNode                                   ClusterEvent = "drain_node"
 
 	// Gossip communication
 	ClusterGossipEventRequestGetLogs            = "gossip_request_get_logs"
@@ -53,6 +68,16 @@ const (
 	// SendTypes for ClusterMessage.
 	ClusterSendBestEffort = "best_effort"
 	ClusterSendReliable   = "reliable"
+
+	// Props of the messages compressed or split in chunks to be sent to the other nodes, the
+	// receiving nodes reassembling and decompressing them before handling them.
+	ClusterMessagePropCompression = "mm_compression"
+	ClusterMessagePropChunkId     = "mm_chunk_id"
+	ClusterMessagePropChunkIndex  = "mm_chunk_index"
+	ClusterMessagePropChunkCount  = "mm_chunk_count"
+
+	ClusterMessageCompressionGzip = "gzip"
+	ClusterMessageChunkSizeMin    = 16 * 1024
 )
 
 type ClusterMessage struct {
@@ -62,3 +87,99 @@ type ClusterMessage struct {
 	Data             []byte            `json:"data,omitempty"`
 	Props            map[string]string `json:"props,omitempty"`
 }
+
+// Compressed returns a copy of the message with its data compressed with gzip when the data is
+// larger than the threshold and compressing makes it smaller, or the message itself otherwise.
+func (cm *ClusterMessage) Compressed(threshold int) (*ClusterMessage, error) {
+	if threshold <= 0 || len(cm.Data) <= threshold || cm.Props[ClusterMessagePropCompression] != "" {
+		return cm, nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(cm.Data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	if buf.Len() >= len(cm.Data) {
+		return cm, nil
+	}
+
+	compressed := cm.withProps(map[string]string{ClusterMessagePropCompression: ClusterMessageCompressionGzip})
+	compressed.Data = buf.Bytes()
+	return compressed, nil
+}
+
+// Decompress decompresses the data of a message compressed by Compressed, in place.
+func (cm *ClusterMessage) Decompress() error {
+	switch cm.Props[ClusterMessagePropCompression] {
+	case "":
+		return nil
+	case ClusterMessageCompressionGzip:
+	default:
+		return fmt.Errorf("unknown compression %q", cm.Props[ClusterMessagePropCompression])
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(cm.Data))
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return err
+	}
+
+	cm.Data = data
+	delete(cm.Props, ClusterMessagePropCompression)
+	return nil
+}
+
+// Split splits the data of the message in chunks of at most chunkSize bytes, each chunk being a
+// message of the same event and props identified by the chunk props. The message is returned as
+// it is when its data fits in a chunk.
+func (cm *ClusterMessage) Split(chunkSize int) []*ClusterMessage {
+	if chunkSize <= 0 || len(cm.Data) <= chunkSize {
+		return []*ClusterMessage{cm}
+	}
+
+	chunkID := NewId()
+	count := (len(cm.Data) + chunkSize - 1) / chunkSize
+	chunks := make([]*ClusterMessage, 0, count)
+	for i := 0; i < count; i++ {
+		chunk := cm.withProps(map[string]string{
+			ClusterMessagePropChunkId:    chunkID,
+			ClusterMessagePropChunkIndex: strconv.Itoa(i),
+			ClusterMessagePropChunkCount: strconv.Itoa(count),
+		})
+		end := (i + 1) * chunkSize
+		if end > len(cm.Data) {
+			end = len(cm.Data)
+		}
+		chunk.Data = cm.Data[i*chunkSize : end]
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// IsChunk reports whether the message is a chunk of a message split by Split.
+func (cm *ClusterMessage) IsChunk() bool {
+	return cm.Props[ClusterMessagePropChunkId] != ""
+}
+
+// withProps returns a copy of the message with the given props added to its own.
+func (cm *ClusterMessage) withProps(props map[string]string) *ClusterMessage {
+	msg := *cm
+	msg.Props = make(map[string]string, len(cm.Props)+len(props))
+	for key, value := range cm.Props {
+		msg.Props[key] = value
+	}
+	for key, value := range props {
+		msg.Props[key] = value
+	}
+	return &msg
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterMessageCompressed(t *testing.T) {
+	data := bytes.Repeat([]byte("compressible "), 1000)
+
+	t.Run("below the threshold", func(t *testing.T) {
+		msg := &ClusterMessage{Event: ClusterEventPublish, Data: data}
+
+		compressed, err := msg.Compressed(len(data))
+		require.NoError(t, err)
+		assert.Same(t, msg, compressed)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		msg := &ClusterMessage{Event: ClusterEventPublish, Data: data}
+
+		compressed, err := msg.Compressed(0)
+		require.NoError(t, err)
+		assert.Same(t, msg, compressed)
+	})
+
+	t.Run("above the threshold", func(t *testing.T) {
+		msg := &ClusterMessage{Event: ClusterEventPublish, Data: data, Props: map[string]string{"key": "value"}}
+
+		compressed, err := msg.Compressed(100)
+		require.NoError(t, err)
+		require.NotSame(t, msg, compressed)
+		assert.Less(t, len(compressed.Data), len(data))
+		assert.Equal(t, ClusterMessageCompressionGzip, compressed.Props[ClusterMessagePropCompression])
+		assert.Equal(t, "value", compressed.Props["key"])
+
+		// The original message is left untouched.
+		assert.Equal(t, data, msg.Data)
+		assert.Empty(t, msg.Props[ClusterMessagePropCompression])
+
+		require.NoError(t, compressed.Decompress())
+		assert.Equal(t, data, compressed.Data)
+		assert.Equal(t, map[string]string{"key": "value"}, compressed.Props)
+	})
+
+	t.Run("incompressible data", func(t *testing.T) {
+		msg := &ClusterMessage{Event: ClusterEventPublish, Data: []byte(NewId() + NewId())}
+
+		compressed, err := msg.Compressed(1)
+		require.NoError(t, err)
+		assert.Same(t, msg, compressed)
+	})
+}
+
+func TestClusterMessageDecompress(t *testing.T) {
+	t.Run("not compressed", func(t *testing.T) {
+		msg := &ClusterMessage{Event: ClusterEventPublish, Data: []byte("data")}
+		require.NoError(t, msg.Decompress())
+		assert.Equal(t, []byte("data"), msg.Data)
+	})
+
+	t.Run("unknown compression", func(t *testing.T) {
+		msg := &ClusterMessage{Event: ClusterEventPublish, Data: []byte("data"), Props: map[string]string{ClusterMessagePropCompression: "zstd"}}
+		require.Error(t, msg.Decompress())
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		msg := &ClusterMessage{Event: ClusterEventPublish, Data: []byte("data"), Props: map[string]string{ClusterMessagePropCompression: ClusterMessageCompressionGzip}}
+		require.Error(t, msg.Decompress())
+	})
+}
+
+func TestClusterMessageSplit(t *testing.T) {
+	data := []byte("0123456789")
+
+	t.Run("fits in a chunk", func(t *testing.T) {
+		msg := &ClusterMessage{Event: ClusterEventPublish, Data: data}
+
+		chunks := msg.Split(len(data))
+		require.Len(t, chunks, 1)
+		assert.Same(t, msg, chunks[0])
+		assert.False(t, chunks[0].IsChunk())
+	})
+
+	t.Run("split in chunks", func(t *testing.T) {
+		msg := &ClusterMessage{Event: ClusterEventPublish, Data: data, Props: map[string]string{"key": "value"}}
+
+		chunks := msg.Split(4)
+		require.Len(t, chunks, 3)
+
+		var joined []byte
+		for i, chunk := range chunks {
+			assert.True(t, chunk.IsChunk())
+			assert.Equal(t, ClusterEventPublish, chunk.Event)
+			assert.Equal(t, "value", chunk.Props["key"])
+			assert.Equal(t, chunks[0].Props[ClusterMessagePropChunkId], chunk.Props[ClusterMessagePropChunkId])
+			assert.Equal(t, "3", chunk.Props[ClusterMessagePropChunkCount])
+			assert.Equal(t, []string{"0", "1", "2"}[i], chunk.Props[ClusterMessagePropChunkIndex])
+			joined = append(joined, chunk.Data...)
+		}
+		assert.Equal(t, data, joined)
+		assert.Len(t, chunks[2].Data, 2)
+		assert.False(t, msg.IsChunk())
+	})
+}
@@ -6,6 +6,7 @@ package model
 import (
 	"crypto/tls"
 	"encoding/json"
+	"html/template"
 	"io"
 	"math"
 	"net"
@@ -49,6 +50,7 @@ const (
 	ServiceGoogle    = "google"
 	ServiceOffice365 = "office365"
 	ServiceOpenid    = "openid"
+	ServiceOIDC      = "oidc"
 
 	GenericNoChannelNotification = "generic_no_channel"
 	GenericNotification          = "generic"
@@ -112,6 +114,21 @@ const (
 	ServiceSettingsDefaultGfycatAPISecret  = "3wLVZPiswc3DnaiaFoLkDvB4X0IV6CpMkj4tf2inJRsBY6-FnkT08zGmppWFgeof"
 	ServiceSettingsDefaultDeveloperFlags   = ""
 
+	ServiceSettingsDefaultReadReceiptsMaxChannelMembers = 20
+
+	ServiceSettingsDefaultOutgoingIntegrationsRequestTimeoutSeconds         = 30
+	ServiceSettingsDefaultOutgoingIntegrationsCircuitBreakerThreshold       = 5
+	ServiceSettingsDefaultOutgoingIntegrationsCircuitBreakerCooldownSeconds = 60
+
+	ServiceSettingsDefaultTypingAggregationMemberThreshold      = 1000
+	ServiceSettingsDefaultTypingAggregationIntervalMilliseconds = 3000
+
+	ServiceSettingsDefaultExpiringPostsMaxSeconds = 7 * 24 * 60 * 60
+
+	ServiceSettingsDefaultEncryptedMessageMaxSize = 64 * 1024
+
+	ServiceSettingsDefaultGraphQLMaxComplexity = 5000
+
 	TeamSettingsDefaultSiteName              = "Mattermost"
 	TeamSettingsDefaultMaxUsersPerTeam       = 50
 	TeamSettingsDefaultCustomBrandText       = ""
@@ -127,6 +144,19 @@ const (
 
 	ExportSettingsDefaultDirectory     = "./export"
 	ExportSettingsDefaultRetentionDays = 30
+	ExportSettingsDefaultPartSizeMB    = 100
+
+	ColdStorageSettingsDefaultDirectory = "./data-cold/"
+	ColdStorageSettingsDefaultAfterDays = 365
+
+	MatrixBridgeSettingsDefaultUserPrefix = "mattermost_"
+
+	SemanticSearchSettingsDefaultEmbeddingModel        = "text-embedding-3-small"
+	SemanticSearchSettingsDefaultIndexingBatchSize     = 100
+	SemanticSearchSettingsDefaultSemanticWeightPercent = 50
+
+	SCIMSettingsDefaultRateLimitPerSec   = 10
+	SCIMSettingsDefaultRateLimitMaxBurst = 50
 
 	EmailSettingsDefaultFeedbackOrganization = ""
 
@@ -200,6 +230,13 @@ const (
 	ElasticsearchSettingsDefaultLiveIndexingBatchSize       = 1
 	ElasticsearchSettingsDefaultRequestTimeoutSeconds       = 30
 	ElasticsearchSettingsDefaultBatchSize                   = 10000
+	ElasticsearchSettingsDefaultFileIndexReplicas           = 1
+	ElasticsearchSettingsDefaultFileIndexShards             = 1
+	ElasticsearchSettingsDefaultRolloverMaxSizeGB           = 50
+	ElasticsearchSettingsDefaultRolloverMaxAgeDays          = 30
+
+	ElasticsearchSettingsESBackend = "elasticsearch"
+	ElasticsearchSettingsOSBackend = "opensearch"
 
 	BleveSettingsDefaultIndexDir  = ""
 	BleveSettingsDefaultBatchSize = 10000
@@ -229,6 +266,16 @@ const (
 	ImageProxyTypeLocal     = "local"
 	ImageProxyTypeAtmosCamo = "atmos/camo"
 
+	TranslationProviderLibreTranslate = "libretranslate"
+	TranslationProviderDeepL          = "deepl"
+	TranslationProviderAzure          = "azure"
+
+	EmbeddingProviderOpenAI = "openai"
+	EmbeddingProviderOllama = "ollama"
+
+	SemanticSearchVectorStoreDatabase = "database"
+	SemanticSearchVectorStoreExternal = "external"
+
 	GoogleSettingsDefaultScope           = "profile email"
 	GoogleSettingsDefaultAuthEndpoint    = "https://accounts.google.com/o/oauth2/v2/auth"
 	GoogleSettingsDefaultTokenEndpoint   = "https://www.googleapis.com/oauth2/v4/token"
@@ -248,6 +295,15 @@ const (
 
 	OpenidSettingsDefaultScope = "profile openid email"
 
+	OIDCSettingsDefaultScope         = "openid profile email"
+	OIDCSettingsDefaultButtonColor   = "#145DBF"
+	OIDCSettingsDefaultUsernameClaim = "preferred_username"
+	OIDCSettingsDefaultEmailClaim    = "email"
+
+	WebAuthnSettingsDefaultMaxCredentialsPerUser = 10
+
+	IPAccessSettingsEmergencyBypassTokenMinLength = 32
+
 	LocalModeSocketPath = "/var/tmp/mattermost_local.socket"
 )
 
@@ -303,8 +359,10 @@ type ServiceSettings struct {
 	MaximumLoginAttempts                *int     `access:"authentication_password,write_restrictable,cloud_restrictable"`
 	GoroutineHealthThreshold            *int     `access:"write_restrictable,cloud_restrictable"` // telemetry: none
 	EnableOAuthServiceProvider          *bool    `access:"integrations_integration_management"`
+	EnableOAuthServiceProviderOAuth21   *bool    `access:"integrations_integration_management"`
 	EnableIncomingWebhooks              *bool    `access:"integrations_integration_management"`
 	EnableOutgoingWebhooks              *bool    `access:"integrations_integration_management"`
+	EnableDirectOutgoingWebhooks        *bool    `access:"integrations_integration_management"`
 	EnableCommands                      *bool    `access:"integrations_integration_management"`
 	EnablePostUsernameOverride          *bool    `access:"integrations_integration_management"`
 	EnablePostIconOverride              *bool    `access:"integrations_integration_management"`
@@ -391,6 +449,24 @@ type ServiceSettings struct {
 	SelfHostedPurchase                                *bool   `access:"write_restrictable,cloud_restrictable"`
 	AllowSyncedDrafts                                 *bool   `access:"site_posts"`
 	SelfHostedExpansion                               *bool   `access:"write_restrictable,cloud_restrictable"`
+	EnableScheduledPosts                              *bool   `access:"site_posts"`
+	EnableReadReceipts                                *bool   `access:"site_posts"`
+	ReadReceiptsMaxChannelMembers                     *int    `access:"site_posts"`
+	OutgoingIntegrationsRequestTimeoutSeconds         *int    `access:"integrations_integration_management"`
+	OutgoingIntegrationsMaxRetries                    *int    `access:"integrations_integration_management"`
+	OutgoingIntegrationsCircuitBreakerThreshold       *int    `access:"integrations_integration_management"`
+	OutgoingIntegrationsCircuitBreakerCooldownSeconds *int    `access:"integrations_integration_management"`
+	EnableWebSocketCompression                        *bool   `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	TypingAggregationMemberThreshold                  *int    `access:"experimental_features,write_restrictable,cloud_restrictable"`
+	TypingAggregationIntervalMilliseconds             *int64  `access:"experimental_features,write_restrictable,cloud_restrictable"`
+	EnableExpiringPosts                               *bool   `access:"site_posts"`
+	ExpiringPostsMaxSeconds                           *int    `access:"site_posts"`
+	EnableEncryptedMessages                           *bool   `access:"site_posts"`
+	EncryptedMessageMaxSize                           *int    `access:"site_posts"`
+	EnableActivityFeed                                *bool   `access:"site_notifications"`
+	EnableGraphQL                                     *bool   `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	GraphQLMaxComplexity                              *int    `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	GraphQLPersistedQueriesOnly                       *bool   `access:"environment_web_server,write_restrictable,cloud_restrictable"`
 }
 
 func (s *ServiceSettings) SetDefaults(isUpdate bool) {
@@ -491,6 +567,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnableOAuthServiceProvider = NewBool(true)
 	}
 
+	if s.EnableOAuthServiceProviderOAuth21 == nil {
+		s.EnableOAuthServiceProviderOAuth21 = NewBool(false)
+	}
+
 	if s.EnableIncomingWebhooks == nil {
 		s.EnableIncomingWebhooks = NewBool(true)
 	}
@@ -499,6 +579,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnableOutgoingWebhooks = NewBool(true)
 	}
 
+	if s.EnableDirectOutgoingWebhooks == nil {
+		s.EnableDirectOutgoingWebhooks = NewBool(false)
+	}
+
 	if s.ConnectionSecurity == nil {
 		s.ConnectionSecurity = NewString("")
 	}
@@ -860,6 +944,80 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.AllowSyncedDrafts = NewBool(true)
 	}
 
+	if s.EnableScheduledPosts == nil {
+		s.EnableScheduledPosts = NewBool(true)
+	}
+
+	if s.EnableReadReceipts == nil {
+		s.EnableReadReceipts = NewBool(false)
+	}
+
+	if s.ReadReceiptsMaxChannelMembers == nil {
+		s.ReadReceiptsMaxChannelMembers = NewInt(ServiceSettingsDefaultReadReceiptsMaxChannelMembers)
+	}
+
+	if s.OutgoingIntegrationsRequestTimeoutSeconds == nil {
+		s.OutgoingIntegrationsRequestTimeoutSeconds = NewInt(ServiceSettingsDefaultOutgoingIntegrationsRequestTimeoutSeconds)
+	}
+
+	if s.OutgoingIntegrationsMaxRetries == nil {
+		s.OutgoingIntegrationsMaxRetries = NewInt(0)
+	}
+
+	// A threshold of 0 disables the circuit breakers.
+	if s.OutgoingIntegrationsCircuitBreakerThreshold == nil {
+		s.OutgoingIntegrationsCircuitBreakerThreshold = NewInt(ServiceSettingsDefaultOutgoingIntegrationsCircuitBreakerThreshold)
+	}
+
+	if s.OutgoingIntegrationsCircuitBreakerCooldownSeconds == nil {
+		s.OutgoingIntegrationsCircuitBreakerCooldownSeconds = NewInt(ServiceSettingsDefaultOutgoingIntegrationsCircuitBreakerCooldownSeconds)
+	}
+
+	if s.EnableWebSocketCompression == nil {
+		s.EnableWebSocketCompression = NewBool(false)
+	}
+
+	// A threshold of 0 disables the aggregation of typing events.
+	if s.TypingAggregationMemberThreshold == nil {
+		s.TypingAggregationMemberThreshold = NewInt(ServiceSettingsDefaultTypingAggregationMemberThreshold)
+	}
+
+	if s.TypingAggregationIntervalMilliseconds == nil {
+		s.TypingAggregationIntervalMilliseconds = NewInt64(ServiceSettingsDefaultTypingAggregationIntervalMilliseconds)
+	}
+
+	if s.EnableExpiringPosts == nil {
+		s.EnableExpiringPosts = NewBool(false)
+	}
+
+	if s.ExpiringPostsMaxSeconds == nil {
+		s.ExpiringPostsMaxSeconds = NewInt(ServiceSettingsDefaultExpiringPostsMaxSeconds)
+	}
+
+	if s.EnableEncryptedMessages == nil {
+		s.EnableEncryptedMessages = NewBool(false)
+	}
+
+	if s.EncryptedMessageMaxSize == nil {
+		s.EncryptedMessageMaxSize = NewInt(ServiceSettingsDefaultEncryptedMessageMaxSize)
+	}
+
+	if s.EnableActivityFeed == nil {
+		s.EnableActivityFeed = NewBool(false)
+	}
+
+	if s.EnableGraphQL == nil {
+		s.EnableGraphQL = NewBool(false)
+	}
+
+	if s.GraphQLMaxComplexity == nil {
+		s.GraphQLMaxComplexity = NewInt(ServiceSettingsDefaultGraphQLMaxComplexity)
+	}
+
+	if s.GraphQLPersistedQueriesOnly == nil {
+		s.GraphQLPersistedQueriesOnly = NewBool(false)
+	}
+
 	if s.SelfHostedPurchase == nil {
 		s.SelfHostedPurchase = NewBool(true)
 	}
@@ -885,6 +1043,10 @@ type ClusterSettings struct {
 	MaxIdleConns                       *int    `access:"environment_high_availability,write_restrictable,cloud_restrictable"` // telemetry: none
 	MaxIdleConnsPerHost                *int    `access:"environment_high_availability,write_restrictable,cloud_restrictable"` // telemetry: none
 	IdleConnTimeoutMilliseconds        *int    `access:"environment_high_availability,write_restrictable,cloud_restrictable"` // telemetry: none
+	// The messages sent to the other nodes are compressed above the threshold and split in chunks
+	// above the chunk size, zero disabling either. All the nodes must support them to enable them.
+	MessageCompressionThresholdBytes *int `access:"environment_high_availability,write_restrictable,cloud_restrictable"`
+	MessageChunkSizeBytes            *int `access:"environment_high_availability,write_restrictable,cloud_restrictable"`
 }
 
 func (s *ClusterSettings) SetDefaults() {
@@ -947,6 +1109,26 @@ func (s *ClusterSettings) SetDefaults() {
 	if s.IdleConnTimeoutMilliseconds == nil {
 		s.IdleConnTimeoutMilliseconds = NewInt(90000)
 	}
+
+	if s.MessageCompressionThresholdBytes == nil {
+		s.MessageCompressionThresholdBytes = NewInt(0)
+	}
+
+	if s.MessageChunkSizeBytes == nil {
+		s.MessageChunkSizeBytes = NewInt(0)
+	}
+}
+
+func (s *ClusterSettings) isValid() *AppError {
+	if *s.MessageCompressionThresholdBytes < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.cluster.message_compression_threshold.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.MessageChunkSizeBytes != 0 && *s.MessageChunkSizeBytes < ClusterMessageChunkSizeMin {
+		return NewAppError("Config.IsValid", "model.config.is_valid.cluster.message_chunk_size.app_error", map[string]any{"Min": ClusterMessageChunkSizeMin}, "", http.StatusBadRequest)
+	}
+
+	return nil
 }
 
 type MetricsSettings struct {
@@ -1020,13 +1202,18 @@ func (s *ExperimentalSettings) SetDefaults() {
 }
 
 type AnalyticsSettings struct {
-	MaxUsersForStatistics *int `access:"write_restrictable,cloud_restrictable"`
+	MaxUsersForStatistics   *int  `access:"write_restrictable,cloud_restrictable"`
+	EnableReactionSummaries *bool `access:"write_restrictable,cloud_restrictable"`
 }
 
 func (s *AnalyticsSettings) SetDefaults() {
 	if s.MaxUsersForStatistics == nil {
 		s.MaxUsersForStatistics = NewInt(AnalyticsSettingsDefaultMaxUsersForStatistics)
 	}
+
+	if s.EnableReactionSummaries == nil {
+		s.EnableReactionSummaries = NewBool(true)
+	}
 }
 
 type SSOSettings struct {
@@ -1147,6 +1334,219 @@ func (s *Office365Settings) SSOSettings() *SSOSettings {
 	return &ssoSettings
 }
 
+// OIDCSettings defines configuration settings for signing in through a generic OpenID Connect
+// identity provider, such as Keycloak or Authentik. The endpoints of the provider are read from
+// its discovery document.
+type OIDCSettings struct {
+	Enable *bool   `access:"authentication_openid"`
+	Secret *string `access:"authentication_openid"` // telemetry: none
+	Id     *string `access:"authentication_openid"` // telemetry: none
+	Scope  *string `access:"authentication_openid"` // telemetry: none
+	// The URL of the discovery document of the provider, usually ending with
+	// /.well-known/openid-configuration.
+	DiscoveryEndpoint *string `access:"authentication_openid"` // telemetry: none
+	ButtonText        *string `access:"authentication_openid"` // telemetry: none
+	ButtonColor       *string `access:"authentication_openid"` // telemetry: none
+	// The claims the username and the email of the users are read from.
+	UsernameClaim *string `access:"authentication_openid"` // telemetry: none
+	EmailClaim    *string `access:"authentication_openid"` // telemetry: none
+	// The claim listing the groups of the users, which are synchronized on sign in. Leave empty to
+	// not synchronize groups.
+	GroupsClaim *string `access:"authentication_openid"` // telemetry: none
+}
+
+func (s *OIDCSettings) isValid() *AppError {
+	if !*s.Enable {
+		return nil
+	}
+
+	if *s.Id == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.oidc.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidHTTPURL(*s.DiscoveryEndpoint) {
+		return NewAppError("Config.IsValid", "model.config.is_valid.oidc.discovery_endpoint.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !strings.Contains(*s.Scope, "openid") {
+		return NewAppError("Config.IsValid", "model.config.is_valid.oidc.scope.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.UsernameClaim == "" || *s.EmailClaim == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.oidc.claim.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *OIDCSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.Secret == nil {
+		s.Secret = NewString("")
+	}
+
+	if s.Id == nil {
+		s.Id = NewString("")
+	}
+
+	if s.Scope == nil {
+		s.Scope = NewString(OIDCSettingsDefaultScope)
+	}
+
+	if s.DiscoveryEndpoint == nil {
+		s.DiscoveryEndpoint = NewString("")
+	}
+
+	if s.ButtonText == nil {
+		s.ButtonText = NewString("")
+	}
+
+	if s.ButtonColor == nil {
+		s.ButtonColor = NewString(OIDCSettingsDefaultButtonColor)
+	}
+
+	if s.UsernameClaim == nil {
+		s.UsernameClaim = NewString(OIDCSettingsDefaultUsernameClaim)
+	}
+
+	if s.EmailClaim == nil {
+		s.EmailClaim = NewString(OIDCSettingsDefaultEmailClaim)
+	}
+
+	if s.GroupsClaim == nil {
+		s.GroupsClaim = NewString("")
+	}
+}
+
+// SSOSettings returns the settings shared with the other OAuth services. The endpoints are left
+// empty, the provider resolving them through discovery.
+func (s *OIDCSettings) SSOSettings() *SSOSettings {
+	ssoSettings := SSOSettings{}
+	ssoSettings.Enable = s.Enable
+	ssoSettings.Secret = s.Secret
+	ssoSettings.Id = s.Id
+	ssoSettings.Scope = s.Scope
+	ssoSettings.DiscoveryEndpoint = s.DiscoveryEndpoint
+	ssoSettings.AuthEndpoint = NewString("")
+	ssoSettings.TokenEndpoint = NewString("")
+	ssoSettings.UserAPIEndpoint = NewString("")
+	ssoSettings.ButtonText = s.ButtonText
+	ssoSettings.ButtonColor = s.ButtonColor
+	return &ssoSettings
+}
+
+// WebAuthnSettings defines configuration settings for passkeys and security keys, which the users
+// register to sign in without a password or as a second factor. The credentials are scoped to
+// the host of the site URL.
+type WebAuthnSettings struct {
+	Enable *bool `access:"authentication_mfa"`
+	// EnablePasskeyLogin allows signing in with a passkey alone, without a login id or a password.
+	EnablePasskeyLogin *bool `access:"authentication_mfa"`
+	// RequireUserVerification requires the authenticators to verify the users, with a PIN or
+	// biometrics, when used as a second factor. Passkey sign in always requires it.
+	RequireUserVerification *bool `access:"authentication_mfa"`
+	MaxCredentialsPerUser   *int  `access:"authentication_mfa"`
+}
+
+func (s *WebAuthnSettings) isValid() *AppError {
+	if *s.MaxCredentialsPerUser <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.webauthn.max_credentials_per_user.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *WebAuthnSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.EnablePasskeyLogin == nil {
+		s.EnablePasskeyLogin = NewBool(false)
+	}
+
+	if s.RequireUserVerification == nil {
+		s.RequireUserVerification = NewBool(false)
+	}
+
+	if s.MaxCredentialsPerUser == nil {
+		s.MaxCredentialsPerUser = NewInt(WebAuthnSettingsDefaultMaxCredentialsPerUser)
+	}
+}
+
+// IPAccessPolicy restricts the networks the users with a system role can use the server from.
+// Denied ranges take precedence, and an empty list of allowed ranges allows every address that
+// isn't denied.
+type IPAccessPolicy struct {
+	Role         *string  `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	AllowedCIDRs []string `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	DeniedCIDRs  []string `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+}
+
+func (p *IPAccessPolicy) isValid() *AppError {
+	if p.Role == nil || *p.Role == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.ip_access.role.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	for _, cidrs := range [][]string{p.AllowedCIDRs, p.DeniedCIDRs} {
+		for _, cidr := range cidrs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return NewAppError("Config.IsValid", "model.config.is_valid.ip_access.cidr.app_error", map[string]any{"Role": *p.Role, "CIDR": cidr}, "", http.StatusBadRequest).Wrap(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IPAccessSettings defines the IP access policies evaluated when the users log in and on each of
+// their requests. They can't be changed through the API, only from the configuration file or in
+// local mode, so that a compromised administrator account can't lift them.
+type IPAccessSettings struct {
+	Enable   *bool             `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	Policies []*IPAccessPolicy `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	// EmergencyBypassToken lets the requests presenting it through the policies, for the
+	// administrators to recover from a policy locking them out. Its uses are audited.
+	EmergencyBypassToken *string `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+}
+
+func (s *IPAccessSettings) isValid() *AppError {
+	for _, policy := range s.Policies {
+		if policy == nil {
+			return NewAppError("Config.IsValid", "model.config.is_valid.ip_access.role.app_error", nil, "", http.StatusBadRequest)
+		}
+		if appErr := policy.isValid(); appErr != nil {
+			return appErr
+		}
+	}
+
+	if token := *s.EmergencyBypassToken; token != "" && len(token) < IPAccessSettingsEmergencyBypassTokenMinLength {
+		return NewAppError("Config.IsValid", "model.config.is_valid.ip_access.emergency_bypass_token.app_error", map[string]any{"MinLength": IPAccessSettingsEmergencyBypassTokenMinLength}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *IPAccessSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.Policies == nil {
+		s.Policies = []*IPAccessPolicy{}
+	}
+
+	if s.EmergencyBypassToken == nil {
+		s.EmergencyBypassToken = NewString("")
+	}
+}
+
 type ReplicaLagSettings struct {
 	DataSource       *string `access:"environment,write_restrictable,cloud_restrictable"` // telemetry: none
 	QueryAbsoluteLag *string `access:"environment,write_restrictable,cloud_restrictable"` // telemetry: none
@@ -1165,8 +1565,12 @@ type SqlSettings struct {
 	Trace                             *bool                 `access:"environment_database,write_restrictable,cloud_restrictable"`
 	AtRestEncryptKey                  *string               `access:"environment_database,write_restrictable,cloud_restrictable"` // telemetry: none
 	QueryTimeout                      *int                  `access:"environment_database,write_restrictable,cloud_restrictable"`
+	SearchQueryTimeout                *int                  `access:"environment_database,write_restrictable,cloud_restrictable"`
+	AnalyticsQueryTimeout             *int                  `access:"environment_database,write_restrictable,cloud_restrictable"`
+	SlowQueryThresholdMilliseconds    *int                  `access:"environment_database,write_restrictable,cloud_restrictable"`
 	DisableDatabaseSearch             *bool                 `access:"environment_database,write_restrictable,cloud_restrictable"`
 	MigrationsStatementTimeoutSeconds *int                  `access:"environment_database,write_restrictable,cloud_restrictable"`
+	PartitionsPrecreateMonths         *int                  `access:"environment_database,write_restrictable,cloud_restrictable"`
 	ReplicaLagSettings                []*ReplicaLagSettings `access:"environment_database,write_restrictable,cloud_restrictable"` // telemetry: none
 }
 
@@ -1221,6 +1625,18 @@ func (s *SqlSettings) SetDefaults(isUpdate bool) {
 		s.QueryTimeout = NewInt(30)
 	}
 
+	if s.SearchQueryTimeout == nil {
+		s.SearchQueryTimeout = NewInt(30)
+	}
+
+	if s.AnalyticsQueryTimeout == nil {
+		s.AnalyticsQueryTimeout = NewInt(30)
+	}
+
+	if s.SlowQueryThresholdMilliseconds == nil {
+		s.SlowQueryThresholdMilliseconds = NewInt(0)
+	}
+
 	if s.DisableDatabaseSearch == nil {
 		s.DisableDatabaseSearch = NewBool(false)
 	}
@@ -1229,6 +1645,10 @@ func (s *SqlSettings) SetDefaults(isUpdate bool) {
 		s.MigrationsStatementTimeoutSeconds = NewInt(100000)
 	}
 
+	if s.PartitionsPrecreateMonths == nil {
+		s.PartitionsPrecreateMonths = NewInt(3)
+	}
+
 	if s.ReplicaLagSettings == nil {
 		s.ReplicaLagSettings = []*ReplicaLagSettings{}
 	}
@@ -1458,6 +1878,8 @@ type FileSettings struct {
 	AmazonS3SSL                        *bool   `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
 	AmazonS3SignV2                     *bool   `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
 	AmazonS3SSE                        *bool   `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	AmazonS3SSEKMSKeyId                *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
+	AmazonS3ObjectTagging              *bool   `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
 	AmazonS3Trace                      *bool   `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
 	AmazonS3RequestTimeoutMilliseconds *int64  `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
 }
@@ -1560,6 +1982,15 @@ func (s *FileSettings) SetDefaults(isUpdate bool) {
 		s.AmazonS3SSE = NewBool(false) // Not Encrypted by default.
 	}
 
+	if s.AmazonS3SSEKMSKeyId == nil {
+		// S3 managed keys are used unless a KMS key is set.
+		s.AmazonS3SSEKMSKeyId = NewString("")
+	}
+
+	if s.AmazonS3ObjectTagging == nil {
+		s.AmazonS3ObjectTagging = NewBool(false)
+	}
+
 	if s.AmazonS3Trace == nil {
 		s.AmazonS3Trace = NewBool(false)
 	}
@@ -1587,6 +2018,8 @@ func (s *FileSettings) ToFileBackendSettings(enableComplianceFeature bool, skipV
 		AmazonS3SSL:                        s.AmazonS3SSL == nil || *s.AmazonS3SSL,
 		AmazonS3SignV2:                     s.AmazonS3SignV2 != nil && *s.AmazonS3SignV2,
 		AmazonS3SSE:                        s.AmazonS3SSE != nil && *s.AmazonS3SSE && enableComplianceFeature,
+		AmazonS3SSEKMSKeyId:                *s.AmazonS3SSEKMSKeyId,
+		AmazonS3ObjectTagging:              s.AmazonS3ObjectTagging != nil && *s.AmazonS3ObjectTagging,
 		AmazonS3Trace:                      s.AmazonS3Trace != nil && *s.AmazonS3Trace,
 		AmazonS3RequestTimeoutMilliseconds: *s.AmazonS3RequestTimeoutMilliseconds,
 		SkipVerify:                         skipVerify,
@@ -1625,6 +2058,11 @@ type EmailSettings struct {
 	LoginButtonBorderColor            *string `access:"experimental_features"`
 	LoginButtonTextColor              *string `access:"experimental_features"`
 	EnableInactivityEmail             *bool
+	EmailDigestTemplate               *string `access:"site_notifications"` // telemetry: none
+	EmailDigestTemplateFile           *string `access:"site_notifications"` // telemetry: none
+
+	// PushNotificationTemplates customizes the push notifications, keyed by notification category.
+	PushNotificationTemplates map[string]*PushNotificationTemplate `access:"site_notifications"` // telemetry: none
 }
 
 func (s *EmailSettings) SetDefaults(isUpdate bool) {
@@ -1720,6 +2158,18 @@ func (s *EmailSettings) SetDefaults(isUpdate bool) {
 		s.PushNotificationBuffer = NewInt(1000)
 	}
 
+	if s.EmailDigestTemplate == nil {
+		s.EmailDigestTemplate = NewString("")
+	}
+
+	if s.EmailDigestTemplateFile == nil {
+		s.EmailDigestTemplateFile = NewString("")
+	}
+
+	if s.PushNotificationTemplates == nil {
+		s.PushNotificationTemplates = make(map[string]*PushNotificationTemplate)
+	}
+
 	if s.EnableEmailBatching == nil {
 		s.EnableEmailBatching = NewBool(false)
 	}
@@ -1980,6 +2430,7 @@ type TeamSettings struct {
 	EnableUserDeactivation    *bool   `access:"experimental_features"`
 	RestrictCreationToDomains *string `access:"authentication_signup"` // telemetry: none
 	EnableCustomUserStatuses  *bool   `access:"site_users_and_teams"`
+	EnableCalendarStatusSync  *bool   `access:"site_users_and_teams"`
 	EnableCustomBrand         *bool   `access:"site_customization"`
 	CustomBrandText           *string `access:"site_customization"`
 	CustomDescriptionText     *string `access:"site_customization"`
@@ -2024,6 +2475,10 @@ func (s *TeamSettings) SetDefaults() {
 		s.EnableCustomUserStatuses = NewBool(true)
 	}
 
+	if s.EnableCalendarStatusSync == nil {
+		s.EnableCalendarStatusSync = NewBool(false)
+	}
+
 	if s.EnableLastActiveTime == nil {
 		s.EnableLastActiveTime = NewBool(true)
 	}
@@ -2565,6 +3020,8 @@ type ElasticsearchSettings struct {
 	ChannelIndexShards            *int    `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
 	UserIndexReplicas             *int    `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
 	UserIndexShards               *int    `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	FileIndexReplicas             *int    `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	FileIndexShards               *int    `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
 	AggregatePostsAfterDays       *int    `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"` // telemetry: none
 	PostsAggregatorJobStartTime   *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"` // telemetry: none
 	IndexPrefix                   *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
@@ -2577,6 +3034,10 @@ type ElasticsearchSettings struct {
 	ClientCert                    *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
 	ClientKey                     *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
 	Trace                         *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	Backend                       *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	EnableLifecycleManagement     *bool   `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	RolloverMaxSizeGB             *int    `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	RolloverMaxAgeDays            *int    `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
 }
 
 func (s *ElasticsearchSettings) SetDefaults() {
@@ -2644,6 +3105,14 @@ func (s *ElasticsearchSettings) SetDefaults() {
 		s.UserIndexShards = NewInt(ElasticsearchSettingsDefaultUserIndexShards)
 	}
 
+	if s.FileIndexReplicas == nil {
+		s.FileIndexReplicas = NewInt(ElasticsearchSettingsDefaultFileIndexReplicas)
+	}
+
+	if s.FileIndexShards == nil {
+		s.FileIndexShards = NewInt(ElasticsearchSettingsDefaultFileIndexShards)
+	}
+
 	if s.AggregatePostsAfterDays == nil {
 		s.AggregatePostsAfterDays = NewInt(ElasticsearchSettingsDefaultAggregatePostsAfterDays)
 	}
@@ -2675,6 +3144,22 @@ func (s *ElasticsearchSettings) SetDefaults() {
 	if s.Trace == nil {
 		s.Trace = NewString("")
 	}
+
+	if s.Backend == nil {
+		s.Backend = NewString(ElasticsearchSettingsESBackend)
+	}
+
+	if s.EnableLifecycleManagement == nil {
+		s.EnableLifecycleManagement = NewBool(false)
+	}
+
+	if s.RolloverMaxSizeGB == nil {
+		s.RolloverMaxSizeGB = NewInt(ElasticsearchSettingsDefaultRolloverMaxSizeGB)
+	}
+
+	if s.RolloverMaxAgeDays == nil {
+		s.RolloverMaxAgeDays = NewInt(ElasticsearchSettingsDefaultRolloverMaxAgeDays)
+	}
 }
 
 type BleveSettings struct {
@@ -3016,6 +3501,7 @@ type GuestAccountsSettings struct {
 	AllowEmailAccounts               *bool   `access:"authentication_guest_access"`
 	EnforceMultifactorAuthentication *bool   `access:"authentication_guest_access"`
 	RestrictCreationToDomains        *string `access:"authentication_guest_access"`
+	DefaultExpirationDays            *int    `access:"authentication_guest_access"`
 }
 
 func (s *GuestAccountsSettings) SetDefaults() {
@@ -3034,6 +3520,18 @@ func (s *GuestAccountsSettings) SetDefaults() {
 	if s.RestrictCreationToDomains == nil {
 		s.RestrictCreationToDomains = NewString("")
 	}
+
+	if s.DefaultExpirationDays == nil {
+		s.DefaultExpirationDays = NewInt(0)
+	}
+}
+
+func (s *GuestAccountsSettings) isValid() *AppError {
+	if *s.DefaultExpirationDays < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.guest_accounts.default_expiration_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
 }
 
 type ImageProxySettings struct {
@@ -3098,6 +3596,10 @@ type ExportSettings struct {
 	Directory *string // telemetry: none
 	// The number of days to retain the exported files before deleting them.
 	RetentionDays *int
+	// The size in megabytes of the parts in which exports are uploaded to the file store. At most one
+	// part is kept in memory per export and, since Amazon S3 uploads are limited to 10000 parts, it
+	// bounds the size of the exports.
+	UploadPartSizeMB *int
 }
 
 func (s *ExportSettings) isValid() *AppError {
@@ -3109,6 +3611,10 @@ func (s *ExportSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.export.retention_days_too_low.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.UploadPartSizeMB < 5 || *s.UploadPartSizeMB > 5*1024 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.export.upload_part_size.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -3121,36 +3627,595 @@ func (s *ExportSettings) SetDefaults() {
 	if s.RetentionDays == nil {
 		s.RetentionDays = NewInt(ExportSettingsDefaultRetentionDays)
 	}
+
+	if s.UploadPartSizeMB == nil {
+		s.UploadPartSizeMB = NewInt(ExportSettingsDefaultPartSizeMB)
+	}
 }
 
-type ConfigFunc func() *Config
+// SCIMSettings defines configuration settings for user and group provisioning through SCIM 2.0.
+type SCIMSettings struct {
+	Enable *bool `access:"user_management_users"`
+	// The authentication service the provisioned users sign in with.
+	AuthService *string `access:"user_management_users"`
+	// The number of requests per second each identity provider token is allowed to make.
+	RateLimitPerSec *int `access:"user_management_users"`
+	// The number of requests each identity provider token is allowed to burst above the rate limit.
+	RateLimitMaxBurst *int `access:"user_management_users"`
+}
 
-const ConfigAccessTagType = "access"
-const ConfigAccessTagWriteRestrictable = "write_restrictable"
-const ConfigAccessTagCloudRestrictable = "cloud_restrictable"
+func (s *SCIMSettings) isValid() *AppError {
+	switch *s.AuthService {
+	case UserAuthServiceSaml, UserAuthServiceGitlab, ServiceGoogle, ServiceOffice365, ServiceOpenid, ServiceOIDC:
+	default:
+		return NewAppError("Config.IsValid", "model.config.is_valid.scim.auth_service.app_error", nil, "", http.StatusBadRequest)
+	}
 
-// Allows read access if any PermissionSysconsoleRead* is allowed
-const ConfigAccessTagAnySysConsoleRead = "*_read"
+	if *s.RateLimitPerSec <= 0 || *s.RateLimitMaxBurst <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.scim.rate_limit.app_error", nil, "", http.StatusBadRequest)
+	}
 
-// Config fields support the 'access' tag with the following values corresponding to the suffix of the associated
-// PermissionSysconsole* permission Id: 'about', 'reporting', 'user_management_users',
-// 'user_management_groups', 'user_management_teams', 'user_management_channels',
-// 'user_management_permissions', 'environment_web_server', 'environment_database', 'environment_elasticsearch',
-// 'environment_file_storage', 'environment_image_proxy', 'environment_smtp', 'environment_push_notification_server',
-// 'environment_high_availability', 'environment_rate_limiting', 'environment_logging', 'environment_session_lengths',
-// 'environment_performance_monitoring', 'environment_developer', 'site', 'authentication', 'plugins',
-// 'integrations', 'compliance', 'plugins', and 'experimental'. They grant read and/or write access to the config field
-// to roles without PermissionManageSystem.
-//
-// The 'access' tag '*_read' checks for any Sysconsole read permission and grants access if any read permission is allowed.
-//
-// By default config values can be written with PermissionManageSystem, but if ExperimentalSettings.RestrictSystemAdmin is true
-// and the access tag contains the value 'write_restrictable', then even PermissionManageSystem, does not grant write access.
-//
-// PermissionManageSystem always grants read access.
-//
-// Config values with the access tag 'cloud_restrictable' mean that are marked to be filtered when it's used in a cloud licensed
-// environment with ExperimentalSettings.RestrictedSystemAdmin set to true.
+	return nil
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *SCIMSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.AuthService == nil || *s.AuthService == "" {
+		s.AuthService = NewString(UserAuthServiceSaml)
+	}
+
+	if s.RateLimitPerSec == nil {
+		s.RateLimitPerSec = NewInt(SCIMSettingsDefaultRateLimitPerSec)
+	}
+
+	if s.RateLimitMaxBurst == nil {
+		s.RateLimitMaxBurst = NewInt(SCIMSettingsDefaultRateLimitMaxBurst)
+	}
+}
+
+// TranslationSettings defines configuration settings for translating messages through an external provider.
+type TranslationSettings struct {
+	Enable   *bool   `access:"site_localization"`
+	Provider *string `access:"site_localization"`
+	// The base URL of the provider's API. Leave empty to use the provider's global endpoint, or point it to
+	// a regional endpoint to keep messages within that region. Required for LibreTranslate.
+	APIURL *string `access:"site_localization"`
+	APIKey *string `access:"site_localization"`
+	// The region of the Azure Translator resource. Required for regional Azure resources.
+	AzureRegion *string `access:"site_localization"`
+	// Whether translations are stored in the database, so that each message is only sent to the provider
+	// once per language.
+	StoreTranslations *bool `access:"site_localization"`
+}
+
+func (s *TranslationSettings) isValid() *AppError {
+	if !*s.Enable {
+		return nil
+	}
+
+	switch *s.Provider {
+	case TranslationProviderLibreTranslate:
+		if *s.APIURL == "" {
+			return NewAppError("Config.IsValid", "model.config.is_valid.translation.api_url.app_error", nil, "", http.StatusBadRequest)
+		}
+	case TranslationProviderDeepL, TranslationProviderAzure:
+		if *s.APIKey == "" {
+			return NewAppError("Config.IsValid", "model.config.is_valid.translation.api_key.app_error", nil, "", http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("Config.IsValid", "model.config.is_valid.translation.provider.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.APIURL != "" && !IsValidHTTPURL(*s.APIURL) {
+		return NewAppError("Config.IsValid", "model.config.is_valid.translation.api_url.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *TranslationSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.Provider == nil {
+		s.Provider = NewString(TranslationProviderLibreTranslate)
+	}
+
+	if s.APIURL == nil {
+		s.APIURL = NewString("")
+	}
+
+	if s.APIKey == nil {
+		s.APIKey = NewString("")
+	}
+
+	if s.AzureRegion == nil {
+		s.AzureRegion = NewString("")
+	}
+
+	if s.StoreTranslations == nil {
+		s.StoreTranslations = NewBool(true)
+	}
+}
+
+// ColdStorageSettings defines configuration settings for moving old file attachments to a secondary,
+// cheaper file store. Thumbnails and previews are kept in the primary file store.
+type ColdStorageSettings struct {
+	Enable *bool `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	// The age in days after which file attachments are moved to cold storage.
+	AfterDays               *int    `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	DriverName              *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	Directory               *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
+	AmazonS3AccessKeyId     *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
+	AmazonS3SecretAccessKey *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
+	AmazonS3Bucket          *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
+	AmazonS3PathPrefix      *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
+	AmazonS3Region          *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
+	AmazonS3Endpoint        *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
+	AmazonS3SSL             *bool   `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	// The storage class of the moved objects, such as GLACIER_IR. Leave empty to use the bucket's default.
+	AmazonS3StorageClass *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	// The age in days after which posts are moved to the post archive in cold storage, or 0 to keep
+	// them in the database.
+	PostsAfterDays *int `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+}
+
+func (s *ColdStorageSettings) isValid() *AppError {
+	if !*s.Enable {
+		return nil
+	}
+
+	if *s.AfterDays <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.cold_storage.after_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.PostsAfterDays < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.cold_storage.posts_after_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	switch *s.DriverName {
+	case ImageDriverLocal:
+		if *s.Directory == "" {
+			return NewAppError("Config.IsValid", "model.config.is_valid.cold_storage.directory.app_error", nil, "", http.StatusBadRequest)
+		}
+	case ImageDriverS3:
+		if *s.AmazonS3Bucket == "" {
+			return NewAppError("Config.IsValid", "model.config.is_valid.cold_storage.bucket.app_error", nil, "", http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("Config.IsValid", "model.config.is_valid.cold_storage.driver.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *ColdStorageSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.AfterDays == nil {
+		s.AfterDays = NewInt(ColdStorageSettingsDefaultAfterDays)
+	}
+
+	if s.PostsAfterDays == nil {
+		s.PostsAfterDays = NewInt(0)
+	}
+
+	if s.DriverName == nil {
+		s.DriverName = NewString(ImageDriverLocal)
+	}
+
+	if s.Directory == nil || *s.Directory == "" {
+		s.Directory = NewString(ColdStorageSettingsDefaultDirectory)
+	}
+
+	if s.AmazonS3AccessKeyId == nil {
+		s.AmazonS3AccessKeyId = NewString("")
+	}
+
+	if s.AmazonS3SecretAccessKey == nil {
+		s.AmazonS3SecretAccessKey = NewString("")
+	}
+
+	if s.AmazonS3Bucket == nil {
+		s.AmazonS3Bucket = NewString("")
+	}
+
+	if s.AmazonS3PathPrefix == nil {
+		s.AmazonS3PathPrefix = NewString("")
+	}
+
+	if s.AmazonS3Region == nil {
+		s.AmazonS3Region = NewString("")
+	}
+
+	if s.AmazonS3Endpoint == nil {
+		s.AmazonS3Endpoint = NewString("s3.amazonaws.com")
+	}
+
+	if s.AmazonS3SSL == nil {
+		s.AmazonS3SSL = NewBool(true)
+	}
+
+	if s.AmazonS3StorageClass == nil {
+		s.AmazonS3StorageClass = NewString("")
+	}
+}
+
+// ToFileBackendSettings returns the settings of the cold storage file backend. The request timeout is
+// shared with the primary file store.
+func (s *ColdStorageSettings) ToFileBackendSettings(skipVerify bool, requestTimeoutMilliseconds int64) filestore.FileBackendSettings {
+	if *s.DriverName == ImageDriverLocal {
+		return filestore.FileBackendSettings{
+			DriverName: *s.DriverName,
+			Directory:  *s.Directory,
+		}
+	}
+	return filestore.FileBackendSettings{
+		DriverName:                         *s.DriverName,
+		AmazonS3AccessKeyId:                *s.AmazonS3AccessKeyId,
+		AmazonS3SecretAccessKey:            *s.AmazonS3SecretAccessKey,
+		AmazonS3Bucket:                     *s.AmazonS3Bucket,
+		AmazonS3PathPrefix:                 *s.AmazonS3PathPrefix,
+		AmazonS3Region:                     *s.AmazonS3Region,
+		AmazonS3Endpoint:                   *s.AmazonS3Endpoint,
+		AmazonS3SSL:                        *s.AmazonS3SSL,
+		AmazonS3StorageClass:               *s.AmazonS3StorageClass,
+		AmazonS3RequestTimeoutMilliseconds: requestTimeoutMilliseconds,
+		SkipVerify:                         skipVerify,
+	}
+}
+
+// MatrixBridgeSettings defines configuration settings for bridging channels to Matrix rooms, with this
+// server registered as an application service of the homeserver.
+type MatrixBridgeSettings struct {
+	Enable *bool `access:"experimental_features,write_restrictable,cloud_restrictable"`
+	// The URL this server sends Client-Server API requests to, such as https://matrix.example.com.
+	HomeserverURL *string `access:"experimental_features,write_restrictable,cloud_restrictable"` // telemetry: none
+	// The server name of the homeserver, used in Matrix user ids such as @alice:example.com.
+	HomeserverDomain *string `access:"experimental_features,write_restrictable,cloud_restrictable"` // telemetry: none
+	// The as_token of the application service registration, sent with requests to the homeserver.
+	AppServiceToken *string `access:"experimental_features,write_restrictable,cloud_restrictable"` // telemetry: none
+	// The hs_token of the application service registration, expected with requests from the homeserver.
+	HomeserverToken *string `access:"experimental_features,write_restrictable,cloud_restrictable"` // telemetry: none
+	// The prefix of the localpart of the Matrix users posting on behalf of Mattermost users. It must
+	// match the user namespace of the application service registration.
+	UserPrefix *string `access:"experimental_features,write_restrictable,cloud_restrictable"`
+}
+
+func (s *MatrixBridgeSettings) isValid() *AppError {
+	if !*s.Enable {
+		return nil
+	}
+
+	if !IsValidHTTPURL(*s.HomeserverURL) {
+		return NewAppError("Config.IsValid", "model.config.is_valid.matrix_bridge.homeserver_url.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.HomeserverDomain == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.matrix_bridge.homeserver_domain.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.AppServiceToken == "" || *s.HomeserverToken == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.matrix_bridge.tokens.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.UserPrefix == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.matrix_bridge.user_prefix.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *MatrixBridgeSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.HomeserverURL == nil {
+		s.HomeserverURL = NewString("")
+	}
+
+	if s.HomeserverDomain == nil {
+		s.HomeserverDomain = NewString("")
+	}
+
+	if s.AppServiceToken == nil {
+		s.AppServiceToken = NewString("")
+	}
+
+	if s.HomeserverToken == nil {
+		s.HomeserverToken = NewString("")
+	}
+
+	if s.UserPrefix == nil {
+		s.UserPrefix = NewString(MatrixBridgeSettingsDefaultUserPrefix)
+	}
+}
+
+// SemanticSearchSettings defines configuration settings for searching posts by meaning, through
+// embeddings of their messages computed by an external provider.
+type SemanticSearchSettings struct {
+	// Whether the indexing job computes the embeddings of new and edited posts.
+	EnableIndexing *bool `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	// Whether users can run semantic searches, ranked together with the keyword search results.
+	EnableSearching   *bool   `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	EmbeddingProvider *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	// The base URL of the provider's API. Leave empty to use the OpenAI API, or point it to any
+	// OpenAI-compatible service. Required for Ollama.
+	EmbeddingAPIURL *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	EmbeddingAPIKey *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"` // telemetry: none
+	// Changing the model requires the posts to be indexed again, as the embeddings of different models
+	// can't be compared.
+	EmbeddingModel *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	// Where the embeddings are stored. The database requires the pgvector extension to be installed on
+	// PostgreSQL to search them, and the external vector store has to be provided by an integration.
+	VectorStore *string `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	// The number of posts whose embeddings are requested from the provider at once.
+	IndexingBatchSize *int `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+	// How much the semantic results weigh against the keyword results when they're ranked together,
+	// as a percentage.
+	SemanticWeightPercent *int `access:"environment_elasticsearch,write_restrictable,cloud_restrictable"`
+}
+
+func (s *SemanticSearchSettings) isValid() *AppError {
+	if !*s.EnableIndexing && !*s.EnableSearching {
+		return nil
+	}
+
+	switch *s.EmbeddingProvider {
+	case EmbeddingProviderOpenAI:
+		if *s.EmbeddingAPIURL == "" && *s.EmbeddingAPIKey == "" {
+			return NewAppError("Config.IsValid", "model.config.is_valid.semantic_search.api_key.app_error", nil, "", http.StatusBadRequest)
+		}
+	case EmbeddingProviderOllama:
+		if *s.EmbeddingAPIURL == "" {
+			return NewAppError("Config.IsValid", "model.config.is_valid.semantic_search.api_url.app_error", nil, "", http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("Config.IsValid", "model.config.is_valid.semantic_search.provider.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.EmbeddingAPIURL != "" && !IsValidHTTPURL(*s.EmbeddingAPIURL) {
+		return NewAppError("Config.IsValid", "model.config.is_valid.semantic_search.api_url.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.EmbeddingModel == "" || len(*s.EmbeddingModel) > PostEmbeddingModelMaxLength {
+		return NewAppError("Config.IsValid", "model.config.is_valid.semantic_search.model.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.VectorStore != SemanticSearchVectorStoreDatabase && *s.VectorStore != SemanticSearchVectorStoreExternal {
+		return NewAppError("Config.IsValid", "model.config.is_valid.semantic_search.vector_store.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.IndexingBatchSize < 1 || *s.IndexingBatchSize > 2048 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.semantic_search.indexing_batch_size.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.SemanticWeightPercent < 0 || *s.SemanticWeightPercent > 100 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.semantic_search.semantic_weight.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *SemanticSearchSettings) SetDefaults() {
+	if s.EnableIndexing == nil {
+		s.EnableIndexing = NewBool(false)
+	}
+
+	if s.EnableSearching == nil {
+		s.EnableSearching = NewBool(false)
+	}
+
+	if s.EmbeddingProvider == nil {
+		s.EmbeddingProvider = NewString(EmbeddingProviderOpenAI)
+	}
+
+	if s.EmbeddingAPIURL == nil {
+		s.EmbeddingAPIURL = NewString("")
+	}
+
+	if s.EmbeddingAPIKey == nil {
+		s.EmbeddingAPIKey = NewString("")
+	}
+
+	if s.EmbeddingModel == nil {
+		s.EmbeddingModel = NewString(SemanticSearchSettingsDefaultEmbeddingModel)
+	}
+
+	if s.VectorStore == nil {
+		s.VectorStore = NewString(SemanticSearchVectorStoreDatabase)
+	}
+
+	if s.IndexingBatchSize == nil {
+		s.IndexingBatchSize = NewInt(SemanticSearchSettingsDefaultIndexingBatchSize)
+	}
+
+	if s.SemanticWeightPercent == nil {
+		s.SemanticWeightPercent = NewInt(SemanticSearchSettingsDefaultSemanticWeightPercent)
+	}
+}
+
+// OffboardingSettings defines configuration settings for the offboarding job started when a user
+// is deactivated, handing over what the user owned to a successor.
+type OffboardingSettings struct {
+	Enable *bool `access:"user_management_users"`
+	// Whether the slash commands, webhooks, OAuth apps and bots of the user are reassigned.
+	ReassignIntegrations *bool `access:"user_management_users"`
+	// Whether the in progress Playbooks runs owned by the user are reassigned.
+	ReassignPlaybookRuns *bool `access:"user_management_users"`
+	// Whether the successor becomes an admin of the private channels the user was the only admin of.
+	TransferPrivateChannels *bool `access:"user_management_users"`
+	// Whether the user is removed from the channels of their teams.
+	RemoveFromChannels *bool `access:"user_management_users"`
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *OffboardingSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.ReassignIntegrations == nil {
+		s.ReassignIntegrations = NewBool(true)
+	}
+
+	if s.ReassignPlaybookRuns == nil {
+		s.ReassignPlaybookRuns = NewBool(true)
+	}
+
+	if s.TransferPrivateChannels == nil {
+		s.TransferPrivateChannels = NewBool(true)
+	}
+
+	if s.RemoveFromChannels == nil {
+		s.RemoveFromChannels = NewBool(false)
+	}
+}
+
+// SecretsSettings defines the secret backends the config values referencing secrets, as
+// secret://<backend>/<name>[#<key>] URIs, are resolved from. They can't be changed through the
+// API, only from the environment, the configuration file or in local mode, so that an
+// administrator account can't point the server at files or hosts of its choosing.
+type SecretsSettings struct {
+	// VaultAddress is the address of the Vault server of the secret://vault/<path>#<key> references,
+	// resolved with the KV secrets engine.
+	VaultAddress *string `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	// VaultToken is best set through the MM_SECRETSSETTINGS_VAULTTOKEN environment variable.
+	VaultToken *string `access:"environment_web_server,write_restrictable,cloud_restrictable"` // telemetry: none
+	// AWSRegion is the region of the AWS Secrets Manager secrets of the secret://aws/<id>[#<key>]
+	// references, resolved with the credentials of the environment.
+	AWSRegion *string `access:"environment_web_server,write_restrictable,cloud_restrictable"` // telemetry: none
+	// EnvFile is the path of the file of KEY=VALUE lines of the secret://envfile/<KEY> references.
+	EnvFile *string `access:"environment_web_server,write_restrictable,cloud_restrictable"` // telemetry: none
+	// RefreshIntervalMinutes is how often the references are resolved again, for the rotated secrets
+	// to be picked up, or 0 to only resolve them when the config is loaded or saved.
+	RefreshIntervalMinutes *int `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *SecretsSettings) SetDefaults() {
+	if s.VaultAddress == nil {
+		s.VaultAddress = NewString("")
+	}
+
+	if s.VaultToken == nil {
+		s.VaultToken = NewString("")
+	}
+
+	if s.AWSRegion == nil {
+		s.AWSRegion = NewString("")
+	}
+
+	if s.EnvFile == nil {
+		s.EnvFile = NewString("")
+	}
+
+	if s.RefreshIntervalMinutes == nil {
+		s.RefreshIntervalMinutes = NewInt(15)
+	}
+}
+
+func (s *SecretsSettings) isValid() *AppError {
+	if *s.RefreshIntervalMinutes < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.secrets_refresh_interval.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+const (
+	CacheTypeLRU   = "lru"
+	CacheTypeRedis = "redis"
+)
+
+// CacheSettings defines the cache the nodes of a cluster share for the sessions, user profiles,
+// channel member counts and link metadata, instead of caching them in memory on every node and
+// invalidating them across the cluster. The other caches stay in memory.
+type CacheSettings struct {
+	// CacheType is either lru, caching in memory, or redis.
+	CacheType     *string `access:"environment_high_availability,write_restrictable,cloud_restrictable"`
+	RedisAddress  *string `access:"environment_high_availability,write_restrictable,cloud_restrictable"` // telemetry: none
+	RedisPassword *string `access:"environment_high_availability,write_restrictable,cloud_restrictable"` // telemetry: none
+	RedisDB       *int    `access:"environment_high_availability,write_restrictable,cloud_restrictable"`
+}
+
+// SetDefaults applies the default settings to the struct.
+func (s *CacheSettings) SetDefaults() {
+	if s.CacheType == nil {
+		s.CacheType = NewString(CacheTypeLRU)
+	}
+
+	if s.RedisAddress == nil {
+		s.RedisAddress = NewString("")
+	}
+
+	if s.RedisPassword == nil {
+		s.RedisPassword = NewString("")
+	}
+
+	if s.RedisDB == nil {
+		s.RedisDB = NewInt(0)
+	}
+}
+
+func (s *CacheSettings) isValid() *AppError {
+	switch *s.CacheType {
+	case CacheTypeLRU:
+	case CacheTypeRedis:
+		if *s.RedisAddress == "" {
+			return NewAppError("Config.IsValid", "model.config.is_valid.cache_redis_address.app_error", nil, "", http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("Config.IsValid", "model.config.is_valid.cache_type.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.RedisDB < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.cache_redis_db.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+type ConfigFunc func() *Config
+
+const ConfigAccessTagType = "access"
+const ConfigAccessTagWriteRestrictable = "write_restrictable"
+const ConfigAccessTagCloudRestrictable = "cloud_restrictable"
+
+// Allows read access if any PermissionSysconsoleRead* is allowed
+const ConfigAccessTagAnySysConsoleRead = "*_read"
+
+// Config fields support the 'access' tag with the following values corresponding to the suffix of the associated
+// PermissionSysconsole* permission Id: 'about', 'reporting', 'user_management_users',
+// 'user_management_groups', 'user_management_teams', 'user_management_channels',
+// 'user_management_permissions', 'environment_web_server', 'environment_database', 'environment_elasticsearch',
+// 'environment_file_storage', 'environment_image_proxy', 'environment_smtp', 'environment_push_notification_server',
+// 'environment_high_availability', 'environment_rate_limiting', 'environment_logging', 'environment_session_lengths',
+// 'environment_performance_monitoring', 'environment_developer', 'site', 'authentication', 'plugins',
+// 'integrations', 'compliance', 'plugins', and 'experimental'. They grant read and/or write access to the config field
+// to roles without PermissionManageSystem.
+//
+// The 'access' tag '*_read' checks for any Sysconsole read permission and grants access if any read permission is allowed.
+//
+// By default config values can be written with PermissionManageSystem, but if ExperimentalSettings.RestrictSystemAdmin is true
+// and the access tag contains the value 'write_restrictable', then even PermissionManageSystem, does not grant write access.
+//
+// PermissionManageSystem always grants read access.
+//
+// Config values with the access tag 'cloud_restrictable' mean that are marked to be filtered when it's used in a cloud licensed
+// environment with ExperimentalSettings.RestrictedSystemAdmin set to true.
 //
 // Example:
 //
@@ -3187,6 +4252,9 @@ type Config struct {
 	GoogleSettings            SSOSettings
 	Office365Settings         Office365Settings
 	OpenIdSettings            SSOSettings
+	OIDCSettings              OIDCSettings
+	WebAuthnSettings          WebAuthnSettings
+	IPAccessSettings          IPAccessSettings
 	LdapSettings              LdapSettings
 	ComplianceSettings        ComplianceSettings
 	LocalizationSettings      LocalizationSettings
@@ -3210,6 +4278,14 @@ type Config struct {
 	FeatureFlags              *FeatureFlags  `access:"*_read" json:",omitempty"`
 	ImportSettings            ImportSettings // telemetry: none
 	ExportSettings            ExportSettings
+	SCIMSettings              SCIMSettings // telemetry: none
+	TranslationSettings       TranslationSettings
+	ColdStorageSettings       ColdStorageSettings
+	MatrixBridgeSettings      MatrixBridgeSettings
+	SemanticSearchSettings    SemanticSearchSettings
+	OffboardingSettings       OffboardingSettings
+	SecretsSettings           SecretsSettings
+	CacheSettings             CacheSettings
 }
 
 func (o *Config) Auditable() map[string]interface{} {
@@ -3252,6 +4328,8 @@ func (o *Config) GetSSOService(service string) *SSOSettings {
 		return o.Office365Settings.SSOSettings()
 	case ServiceOpenid:
 		return &o.OpenIdSettings
+	case ServiceOIDC:
+		return o.OIDCSettings.SSOSettings()
 	}
 
 	return nil
@@ -3291,6 +4369,9 @@ func (o *Config) SetDefaults() {
 	o.GitLabSettings.setDefaults("", "", "", "", "")
 	o.GoogleSettings.setDefaults(GoogleSettingsDefaultScope, GoogleSettingsDefaultAuthEndpoint, GoogleSettingsDefaultTokenEndpoint, GoogleSettingsDefaultUserAPIEndpoint, "")
 	o.OpenIdSettings.setDefaults(OpenidSettingsDefaultScope, "", "", "", "#145DBF")
+	o.OIDCSettings.SetDefaults()
+	o.WebAuthnSettings.SetDefaults()
+	o.IPAccessSettings.SetDefaults()
 	o.ServiceSettings.SetDefaults(isUpdate)
 	o.PasswordSettings.SetDefaults()
 	o.TeamSettings.SetDefaults()
@@ -3325,6 +4406,14 @@ func (o *Config) SetDefaults() {
 	}
 	o.ImportSettings.SetDefaults()
 	o.ExportSettings.SetDefaults()
+	o.SCIMSettings.SetDefaults()
+	o.TranslationSettings.SetDefaults()
+	o.ColdStorageSettings.SetDefaults()
+	o.MatrixBridgeSettings.SetDefaults()
+	o.SemanticSearchSettings.SetDefaults()
+	o.OffboardingSettings.SetDefaults()
+	o.SecretsSettings.SetDefaults()
+	o.CacheSettings.SetDefaults()
 }
 
 func (o *Config) IsValid() *AppError {
@@ -3407,6 +4496,54 @@ func (o *Config) IsValid() *AppError {
 	if appErr := o.ImportSettings.isValid(); appErr != nil {
 		return appErr
 	}
+
+	if appErr := o.OIDCSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.WebAuthnSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.IPAccessSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.SCIMSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.TranslationSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.ColdStorageSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.MatrixBridgeSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.SemanticSearchSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.GuestAccountsSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.SecretsSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.CacheSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.ClusterSettings.isValid(); appErr != nil {
+		return appErr
+	}
 	return nil
 }
 
@@ -3463,6 +4600,22 @@ func (s *SqlSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.sql_query_timeout.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.SearchQueryTimeout <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.sql_search_query_timeout.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.AnalyticsQueryTimeout <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.sql_analytics_query_timeout.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.SlowQueryThresholdMilliseconds < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.sql_slow_query_threshold.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.PartitionsPrecreateMonths < 1 || *s.PartitionsPrecreateMonths > 24 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.sql_partitions_precreate_months.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if *s.DataSource == "" {
 		return NewAppError("Config.IsValid", "model.config.is_valid.sql_data_src.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -3519,6 +4672,22 @@ func (s *EmailSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.email_notification_contents_type.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.EmailDigestTemplate != "" && *s.EmailDigestTemplateFile != "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.email_digest_template_conflict.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.EmailDigestTemplate != "" {
+		if _, err := template.New("email_digest").Parse(*s.EmailDigestTemplate); err != nil {
+			return NewAppError("Config.IsValid", "model.config.is_valid.email_digest_template.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		}
+	}
+
+	for category, tmpl := range s.PushNotificationTemplates {
+		if !IsValidPushNotificationCategory(category) || tmpl == nil || !tmpl.IsValid() {
+			return NewAppError("Config.IsValid", "model.config.is_valid.push_notification_template.app_error", map[string]any{"Category": category}, "", http.StatusBadRequest)
+		}
+	}
+
 	return nil
 }
 
@@ -3761,6 +4930,46 @@ func (s *ServiceSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.collapsed_threads.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.ReadReceiptsMaxChannelMembers < 2 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.read_receipts_max_channel_members.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.OutgoingIntegrationsRequestTimeoutSeconds < 1 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.outgoing_integrations_request_timeout.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.OutgoingIntegrationsMaxRetries < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.outgoing_integrations_max_retries.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.OutgoingIntegrationsCircuitBreakerThreshold < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.outgoing_integrations_circuit_breaker_threshold.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.OutgoingIntegrationsCircuitBreakerCooldownSeconds < 1 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.outgoing_integrations_circuit_breaker_cooldown.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.TypingAggregationMemberThreshold < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.typing_aggregation_member_threshold.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.TypingAggregationIntervalMilliseconds < 1000 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.typing_aggregation_interval.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.ExpiringPostsMaxSeconds < PostExpireAfterMinSeconds {
+		return NewAppError("Config.IsValid", "model.config.is_valid.expiring_posts_max_seconds.app_error", map[string]any{"Min": PostExpireAfterMinSeconds}, "", http.StatusBadRequest)
+	}
+
+	if *s.EncryptedMessageMaxSize <= 0 || *s.EncryptedMessageMaxSize > PostPropsMaxUserRunes {
+		return NewAppError("Config.IsValid", "model.config.is_valid.encrypted_message_max_size.app_error", map[string]any{"Max": PostPropsMaxUserRunes}, "", http.StatusBadRequest)
+	}
+
+	if *s.GraphQLMaxComplexity <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.graphql_max_complexity.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -3800,6 +5009,30 @@ func (s *ElasticsearchSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.elastic_search.request_timeout_seconds.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.Backend != ElasticsearchSettingsESBackend && *s.Backend != ElasticsearchSettingsOSBackend {
+		return NewAppError("Config.IsValid", "model.config.is_valid.elastic_search.backend.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	for _, replicas := range []int{*s.PostIndexReplicas, *s.ChannelIndexReplicas, *s.UserIndexReplicas, *s.FileIndexReplicas} {
+		if replicas < 0 {
+			return NewAppError("Config.IsValid", "model.config.is_valid.elastic_search.index_replicas.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	for _, shards := range []int{*s.PostIndexShards, *s.ChannelIndexShards, *s.UserIndexShards, *s.FileIndexShards} {
+		if shards < 1 {
+			return NewAppError("Config.IsValid", "model.config.is_valid.elastic_search.index_shards.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	if *s.RolloverMaxSizeGB < 1 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.elastic_search.rollover_max_size.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.RolloverMaxAgeDays < 1 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.elastic_search.rollover_max_age.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -3968,6 +5201,10 @@ func (o *Config) Sanitize() {
 		*o.OpenIdSettings.Secret = FakeSetting
 	}
 
+	if o.OIDCSettings.Secret != nil && *o.OIDCSettings.Secret != "" {
+		*o.OIDCSettings.Secret = FakeSetting
+	}
+
 	if o.SqlSettings.DataSource != nil {
 		*o.SqlSettings.DataSource = FakeSetting
 	}
@@ -4001,6 +5238,38 @@ func (o *Config) Sanitize() {
 	if o.ServiceSettings.SplitKey != nil {
 		*o.ServiceSettings.SplitKey = FakeSetting
 	}
+
+	if o.TranslationSettings.APIKey != nil && *o.TranslationSettings.APIKey != "" {
+		*o.TranslationSettings.APIKey = FakeSetting
+	}
+
+	if o.ColdStorageSettings.AmazonS3SecretAccessKey != nil && *o.ColdStorageSettings.AmazonS3SecretAccessKey != "" {
+		*o.ColdStorageSettings.AmazonS3SecretAccessKey = FakeSetting
+	}
+
+	if o.MatrixBridgeSettings.AppServiceToken != nil && *o.MatrixBridgeSettings.AppServiceToken != "" {
+		*o.MatrixBridgeSettings.AppServiceToken = FakeSetting
+	}
+
+	if o.MatrixBridgeSettings.HomeserverToken != nil && *o.MatrixBridgeSettings.HomeserverToken != "" {
+		*o.MatrixBridgeSettings.HomeserverToken = FakeSetting
+	}
+
+	if o.SemanticSearchSettings.EmbeddingAPIKey != nil && *o.SemanticSearchSettings.EmbeddingAPIKey != "" {
+		*o.SemanticSearchSettings.EmbeddingAPIKey = FakeSetting
+	}
+
+	if o.IPAccessSettings.EmergencyBypassToken != nil && *o.IPAccessSettings.EmergencyBypassToken != "" {
+		*o.IPAccessSettings.EmergencyBypassToken = FakeSetting
+	}
+
+	if o.SecretsSettings.VaultToken != nil && *o.SecretsSettings.VaultToken != "" {
+		*o.SecretsSettings.VaultToken = FakeSetting
+	}
+
+	if o.CacheSettings.RedisPassword != nil && *o.CacheSettings.RedisPassword != "" {
+		*o.CacheSettings.RedisPassword = FakeSetting
+	}
 }
 
 // structToMapFilteredByTag converts a struct into a map removing those fields that has the tag passed
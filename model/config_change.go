@@ -0,0 +1,78 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+// ConfigChange records a save of the config by an admin: who made it, the settings it changed and
+// the config it saved, which the config can later be rolled back to.
+type ConfigChange struct {
+	Id       string `json:"id"`
+	UserId   string `json:"user_id"`
+	CreateAt int64  `json:"create_at"`
+	// RollbackToId is the id of the change whose config this change restored, if it's a rollback.
+	RollbackToId string              `json:"rollback_to_id,omitempty"`
+	Diffs        []*ConfigChangeDiff `json:"diffs"`
+	// Config is the config as saved, secrets included, so it's never sent to clients.
+	Config *Config `json:"-"`
+}
+
+// ConfigChangeDiff is a setting changed by a ConfigChange, identified by its path in the config,
+// e.g. ServiceSettings.SiteURL.
+type ConfigChangeDiff struct {
+	Path     string `json:"path"`
+	OldValue any    `json:"old_value"`
+	NewValue any    `json:"new_value"`
+}
+
+func (o *ConfigChange) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"id":             o.Id,
+		"user_id":        o.UserId,
+		"create_at":      o.CreateAt,
+		"rollback_to_id": o.RollbackToId,
+		"diffs":          len(o.Diffs),
+	}
+}
+
+func (o *ConfigChange) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("ConfigChange.IsValid", "model.config_change.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	// Changes made through the local mode have no user.
+	if o.UserId != "" && !IsValidId(o.UserId) {
+		return NewAppError("ConfigChange.IsValid", "model.config_change.is_valid.user_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("ConfigChange.IsValid", "model.config_change.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.RollbackToId != "" && !IsValidId(o.RollbackToId) {
+		return NewAppError("ConfigChange.IsValid", "model.config_change.is_valid.rollback_to_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.Config == nil {
+		return NewAppError("ConfigChange.IsValid", "model.config_change.is_valid.config.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *ConfigChange) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+
+	if o.Diffs == nil {
+		o.Diffs = []*ConfigChangeDiff{}
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigChangeIsValid(t *testing.T) {
+	change := &ConfigChange{Config: &Config{}}
+	change.PreSave()
+	require.Nil(t, change.IsValid(), "changes made through the local mode have no user")
+	assert.NotNil(t, change.Diffs)
+
+	change.UserId = "invalid"
+	assert.NotNil(t, change.IsValid())
+	change.UserId = NewId()
+	require.Nil(t, change.IsValid())
+
+	change.RollbackToId = "invalid"
+	assert.NotNil(t, change.IsValid())
+	change.RollbackToId = NewId()
+	require.Nil(t, change.IsValid())
+
+	change.Config = nil
+	assert.NotNil(t, change.IsValid())
+}
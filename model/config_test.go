@@ -119,6 +119,25 @@ func TestConfigDefaultFileSettingsS3SSE(t *testing.T) {
 	c1.SetDefaults()
 
 	require.False(t, *c1.FileSettings.AmazonS3SSE)
+	require.Equal(t, "", *c1.FileSettings.AmazonS3SSEKMSKeyId)
+	require.False(t, *c1.FileSettings.AmazonS3ObjectTagging)
+}
+
+func TestFileSettingsToFileBackendSettingsS3Options(t *testing.T) {
+	c1 := Config{}
+	c1.SetDefaults()
+	*c1.FileSettings.DriverName = ImageDriverS3
+	*c1.FileSettings.AmazonS3SSE = true
+	*c1.FileSettings.AmazonS3SSEKMSKeyId = "alias/mattermost"
+	*c1.FileSettings.AmazonS3ObjectTagging = true
+
+	settings := c1.FileSettings.ToFileBackendSettings(true, false)
+	require.True(t, settings.AmazonS3SSE)
+	require.Equal(t, "alias/mattermost", settings.AmazonS3SSEKMSKeyId)
+	require.True(t, settings.AmazonS3ObjectTagging)
+
+	settings = c1.FileSettings.ToFileBackendSettings(false, false)
+	require.False(t, settings.AmazonS3SSE)
 }
 
 func TestConfigDefaultSignatureAlgorithm(t *testing.T) {
@@ -419,6 +438,129 @@ func TestTeamSettingsIsValidSiteNameEmpty(t *testing.T) {
 	require.Nil(t, c1.TeamSettings.isValid())
 }
 
+func TestTranslationSettingsIsValid(t *testing.T) {
+	ts := &TranslationSettings{}
+	ts.SetDefaults()
+
+	// should not validate the provider settings while translation is disabled
+	require.Nil(t, ts.isValid())
+
+	ts.Enable = NewBool(true)
+	require.NotNil(t, ts.isValid(), "LibreTranslate requires an API URL")
+
+	ts.APIURL = NewString("https://translate.example.com")
+	require.Nil(t, ts.isValid())
+
+	ts.Provider = NewString(TranslationProviderDeepL)
+	require.NotNil(t, ts.isValid(), "DeepL requires an API key")
+
+	ts.APIKey = NewString("key")
+	require.Nil(t, ts.isValid())
+
+	ts.APIURL = NewString("not a url")
+	require.NotNil(t, ts.isValid())
+
+	ts.APIURL = NewString("")
+	ts.Provider = NewString("unknown")
+	require.NotNil(t, ts.isValid())
+}
+
+func TestOIDCSettingsIsValid(t *testing.T) {
+	settings := &OIDCSettings{}
+	settings.SetDefaults()
+
+	// should not validate the provider settings while OpenID Connect is disabled
+	require.Nil(t, settings.isValid())
+
+	settings.Enable = NewBool(true)
+	require.NotNil(t, settings.isValid(), "a client id is required")
+
+	settings.Id = NewString("client")
+	require.NotNil(t, settings.isValid(), "a discovery endpoint is required")
+
+	settings.DiscoveryEndpoint = NewString("https://idp.example.com/.well-known/openid-configuration")
+	require.Nil(t, settings.isValid())
+
+	settings.Scope = NewString("profile email")
+	require.NotNil(t, settings.isValid(), "the openid scope is required")
+
+	settings.Scope = NewString(OIDCSettingsDefaultScope)
+	settings.EmailClaim = NewString("")
+	require.NotNil(t, settings.isValid())
+}
+
+func TestSemanticSearchSettingsIsValid(t *testing.T) {
+	ss := &SemanticSearchSettings{}
+	ss.SetDefaults()
+
+	// should not validate the provider settings while semantic search is disabled
+	require.Nil(t, ss.isValid())
+
+	ss.EnableIndexing = NewBool(true)
+	require.NotNil(t, ss.isValid(), "OpenAI requires an API key or URL")
+
+	ss.EmbeddingAPIKey = NewString("key")
+	require.Nil(t, ss.isValid())
+
+	ss.EmbeddingProvider = NewString(EmbeddingProviderOllama)
+	require.NotNil(t, ss.isValid(), "Ollama requires an API URL")
+
+	ss.EmbeddingAPIURL = NewString("not a url")
+	require.NotNil(t, ss.isValid())
+
+	ss.EmbeddingAPIURL = NewString("http://localhost:11434")
+	require.Nil(t, ss.isValid())
+
+	ss.EmbeddingModel = NewString("")
+	require.NotNil(t, ss.isValid())
+
+	ss.EmbeddingModel = NewString("nomic-embed-text")
+	ss.VectorStore = NewString("redis")
+	require.NotNil(t, ss.isValid())
+
+	ss.VectorStore = NewString(SemanticSearchVectorStoreExternal)
+	ss.IndexingBatchSize = NewInt(0)
+	require.NotNil(t, ss.isValid())
+
+	ss.IndexingBatchSize = NewInt(10)
+	ss.SemanticWeightPercent = NewInt(101)
+	require.NotNil(t, ss.isValid())
+
+	ss.SemanticWeightPercent = NewInt(100)
+	require.Nil(t, ss.isValid())
+
+	ss.EmbeddingProvider = NewString("unknown")
+	require.NotNil(t, ss.isValid())
+}
+
+func TestElasticsearchSettingsIsValid(t *testing.T) {
+	es := &ElasticsearchSettings{}
+	es.SetDefaults()
+	require.Nil(t, es.isValid())
+
+	es.Backend = NewString(ElasticsearchSettingsOSBackend)
+	require.Nil(t, es.isValid())
+
+	es.Backend = NewString("solr")
+	require.NotNil(t, es.isValid())
+
+	es.Backend = NewString(ElasticsearchSettingsESBackend)
+	es.FileIndexReplicas = NewInt(-1)
+	require.NotNil(t, es.isValid())
+
+	es.FileIndexReplicas = NewInt(0)
+	es.FileIndexShards = NewInt(0)
+	require.NotNil(t, es.isValid())
+
+	es.FileIndexShards = NewInt(1)
+	es.RolloverMaxSizeGB = NewInt(0)
+	require.NotNil(t, es.isValid())
+
+	es.RolloverMaxSizeGB = NewInt(1)
+	es.RolloverMaxAgeDays = NewInt(0)
+	require.NotNil(t, es.isValid())
+}
+
 func TestMessageExportSettingsIsValidEnableExportNotSet(t *testing.T) {
 	mes := &MessageExportSettings{}
 
@@ -1298,6 +1440,7 @@ func TestConfigSanitize(t *testing.T) {
 	*c.OpenIdSettings.Secret = "secret"
 	c.SqlSettings.DataSourceReplicas = []string{"stuff"}
 	c.SqlSettings.DataSourceSearchReplicas = []string{"stuff"}
+	*c.IPAccessSettings.EmergencyBypassToken = NewRandomString(IPAccessSettingsEmergencyBypassTokenMinLength)
 
 	c.Sanitize()
 
@@ -1312,6 +1455,56 @@ func TestConfigSanitize(t *testing.T) {
 	assert.Equal(t, FakeSetting, *c.ElasticsearchSettings.Password)
 	assert.Equal(t, FakeSetting, c.SqlSettings.DataSourceReplicas[0])
 	assert.Equal(t, FakeSetting, c.SqlSettings.DataSourceSearchReplicas[0])
+	assert.Equal(t, FakeSetting, *c.IPAccessSettings.EmergencyBypassToken)
+}
+
+func TestIPAccessSettingsIsValid(t *testing.T) {
+	for name, test := range map[string]struct {
+		Settings      IPAccessSettings
+		ExpectedError string
+	}{
+		"defaults": {
+			Settings: IPAccessSettings{},
+		},
+		"valid policies": {
+			Settings: IPAccessSettings{
+				Policies: []*IPAccessPolicy{
+					{Role: NewString(SystemAdminRoleId), AllowedCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"}},
+					{Role: NewString(SystemUserRoleId), DeniedCIDRs: []string{"192.0.2.0/24"}},
+				},
+			},
+		},
+		"missing role": {
+			Settings: IPAccessSettings{
+				Policies: []*IPAccessPolicy{{AllowedCIDRs: []string{"10.0.0.0/8"}}},
+			},
+			ExpectedError: "model.config.is_valid.ip_access.role.app_error",
+		},
+		"address instead of a range": {
+			Settings: IPAccessSettings{
+				Policies: []*IPAccessPolicy{{Role: NewString(SystemAdminRoleId), DeniedCIDRs: []string{"10.0.0.1"}}},
+			},
+			ExpectedError: "model.config.is_valid.ip_access.cidr.app_error",
+		},
+		"short bypass token": {
+			Settings: IPAccessSettings{
+				EmergencyBypassToken: NewString("password"),
+			},
+			ExpectedError: "model.config.is_valid.ip_access.emergency_bypass_token.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.Settings.SetDefaults()
+
+			appErr := test.Settings.isValid()
+			if test.ExpectedError == "" {
+				assert.Nil(t, appErr)
+			} else {
+				require.NotNil(t, appErr)
+				assert.Equal(t, test.ExpectedError, appErr.Id)
+			}
+		})
+	}
 }
 
 func TestConfigFilteredByTag(t *testing.T) {
@@ -1536,3 +1729,112 @@ func TestConfigDefaultCallsPluginState(t *testing.T) {
 		assert.False(t, c1.PluginSettings.PluginStates["com.mattermost.calls"].Enable)
 	})
 }
+
+func TestColdStorageSettingsIsValid(t *testing.T) {
+	for name, test := range map[string]struct {
+		Settings ColdStorageSettings
+		ErrorId  string
+	}{
+		"disabled": {
+			Settings: ColdStorageSettings{Enable: NewBool(false), DriverName: NewString("unknown")},
+		},
+		"local": {
+			Settings: ColdStorageSettings{Enable: NewBool(true)},
+		},
+		"invalid age": {
+			Settings: ColdStorageSettings{Enable: NewBool(true), AfterDays: NewInt(0)},
+			ErrorId:  "model.config.is_valid.cold_storage.after_days.app_error",
+		},
+		"missing bucket": {
+			Settings: ColdStorageSettings{Enable: NewBool(true), DriverName: NewString(ImageDriverS3)},
+			ErrorId:  "model.config.is_valid.cold_storage.bucket.app_error",
+		},
+		"unknown driver": {
+			Settings: ColdStorageSettings{Enable: NewBool(true), DriverName: NewString("unknown")},
+			ErrorId:  "model.config.is_valid.cold_storage.driver.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.Settings.SetDefaults()
+
+			appErr := test.Settings.isValid()
+			if test.ErrorId == "" {
+				assert.Nil(t, appErr)
+			} else {
+				require.NotNil(t, appErr)
+				assert.Equal(t, test.ErrorId, appErr.Id)
+			}
+		})
+	}
+}
+
+func TestMatrixBridgeSettingsIsValid(t *testing.T) {
+	valid := func() MatrixBridgeSettings {
+		return MatrixBridgeSettings{
+			Enable:           NewBool(true),
+			HomeserverURL:    NewString("https://matrix.example.com"),
+			HomeserverDomain: NewString("example.com"),
+			AppServiceToken:  NewString("as_token"),
+			HomeserverToken:  NewString("hs_token"),
+		}
+	}
+
+	for name, test := range map[string]struct {
+		Settings func(s *MatrixBridgeSettings)
+		ErrorId  string
+	}{
+		"disabled": {
+			Settings: func(s *MatrixBridgeSettings) { *s = MatrixBridgeSettings{Enable: NewBool(false)} },
+		},
+		"valid": {
+			Settings: func(s *MatrixBridgeSettings) {},
+		},
+		"invalid homeserver URL": {
+			Settings: func(s *MatrixBridgeSettings) { s.HomeserverURL = NewString("matrix.example.com") },
+			ErrorId:  "model.config.is_valid.matrix_bridge.homeserver_url.app_error",
+		},
+		"missing domain": {
+			Settings: func(s *MatrixBridgeSettings) { s.HomeserverDomain = NewString("") },
+			ErrorId:  "model.config.is_valid.matrix_bridge.homeserver_domain.app_error",
+		},
+		"missing token": {
+			Settings: func(s *MatrixBridgeSettings) { s.HomeserverToken = NewString("") },
+			ErrorId:  "model.config.is_valid.matrix_bridge.tokens.app_error",
+		},
+		"missing user prefix": {
+			Settings: func(s *MatrixBridgeSettings) { s.UserPrefix = NewString("") },
+			ErrorId:  "model.config.is_valid.matrix_bridge.user_prefix.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			settings := valid()
+			test.Settings(&settings)
+			settings.SetDefaults()
+
+			appErr := settings.isValid()
+			if test.ErrorId == "" {
+				assert.Nil(t, appErr)
+			} else {
+				require.NotNil(t, appErr)
+				assert.Equal(t, test.ErrorId, appErr.Id)
+			}
+		})
+	}
+}
+
+func TestExportSettingsUploadPartSize(t *testing.T) {
+	s := ExportSettings{}
+	s.SetDefaults()
+	require.Equal(t, ExportSettingsDefaultPartSizeMB, *s.UploadPartSizeMB)
+	require.Nil(t, s.isValid())
+
+	for _, size := range []int{0, 4, 5*1024 + 1} {
+		s.UploadPartSizeMB = NewInt(size)
+		appErr := s.isValid()
+		require.NotNil(t, appErr, "size %d", size)
+		assert.Equal(t, "model.config.is_valid.export.upload_part_size.app_error", appErr.Id)
+	}
+
+	s.UploadPartSizeMB = NewInt(5)
+	require.Nil(t, s.isValid())
+}
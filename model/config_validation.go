@@ -0,0 +1,79 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	ConfigValidationCheckSettings  = "settings"
+	ConfigValidationCheckSSO       = "sso_site_url"
+	ConfigValidationCheckFileStore = "file_store"
+	ConfigValidationCheckSMTP      = "smtp"
+
+	ConfigValidationStatusPassed  = "passed"
+	ConfigValidationStatusFailed  = "failed"
+	ConfigValidationStatusSkipped = "skipped"
+)
+
+// ConfigValidationReport is the outcome of validating a config before saving it, the services
+// it depends on being probed with it.
+type ConfigValidationReport struct {
+	Valid  bool                     `json:"valid"`
+	Checks []*ConfigValidationCheck `json:"checks"`
+}
+
+// ConfigValidationCheck is the outcome of one of the checks of a config validation.
+type ConfigValidationCheck struct {
+	Name   string                   `json:"name"`
+	Status string                   `json:"status"`
+	Errors []*ConfigValidationError `json:"errors"`
+}
+
+// ConfigValidationError is an error found by a check, on the setting at the given path in the
+// config when it's known.
+type ConfigValidationError struct {
+	Setting string `json:"setting,omitempty"`
+	Id      string `json:"id"`
+	Message string `json:"message"`
+	// Detail is the error reported by the probed service, if any.
+	Detail string `json:"detail,omitempty"`
+}
+
+func NewConfigValidationReport() *ConfigValidationReport {
+	return &ConfigValidationReport{
+		Valid:  true,
+		Checks: []*ConfigValidationCheck{},
+	}
+}
+
+// AddCheck adds a check to the report, passed until it fails.
+func (r *ConfigValidationReport) AddCheck(name string) *ConfigValidationCheck {
+	check := &ConfigValidationCheck{
+		Name:   name,
+		Status: ConfigValidationStatusPassed,
+		Errors: []*ConfigValidationError{},
+	}
+	r.Checks = append(r.Checks, check)
+	return check
+}
+
+// SkipCheck adds a check that didn't apply to the config to the report.
+func (r *ConfigValidationReport) SkipCheck(name string) {
+	r.AddCheck(name).Status = ConfigValidationStatusSkipped
+}
+
+// Fail records an error on the setting, failing the check. The report is only valid once all its
+// checks passed or were skipped.
+func (r *ConfigValidationReport) Fail(check *ConfigValidationCheck, setting string, appErr *AppError) {
+	validationErr := &ConfigValidationError{
+		Setting: setting,
+		Id:      appErr.Id,
+		Message: appErr.Message,
+	}
+	if wrapped := appErr.Unwrap(); wrapped != nil {
+		validationErr.Detail = wrapped.Error()
+	}
+
+	check.Status = ConfigValidationStatusFailed
+	check.Errors = append(check.Errors, validationErr)
+	r.Valid = false
+}
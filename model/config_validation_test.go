@@ -0,0 +1,33 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidationReport(t *testing.T) {
+	report := NewConfigValidationReport()
+	report.AddCheck(ConfigValidationCheckSettings)
+	report.SkipCheck(ConfigValidationCheckSSO)
+	assert.True(t, report.Valid)
+
+	check := report.AddCheck(ConfigValidationCheckSMTP)
+	report.Fail(check, "EmailSettings.SMTPServer", NewAppError("test", "smtp", nil, "", http.StatusBadRequest).Wrap(errors.New("connection refused")))
+	assert.False(t, report.Valid)
+
+	require.Len(t, report.Checks, 3)
+	assert.Equal(t, ConfigValidationStatusPassed, report.Checks[0].Status)
+	assert.Equal(t, ConfigValidationStatusSkipped, report.Checks[1].Status)
+	assert.Equal(t, ConfigValidationStatusFailed, report.Checks[2].Status)
+	require.Len(t, check.Errors, 1)
+	assert.Equal(t, "EmailSettings.SMTPServer", check.Errors[0].Setting)
+	assert.Equal(t, "smtp", check.Errors[0].Id)
+	assert.Equal(t, "connection refused", check.Errors[0].Detail)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	for _, expr := range []string{
+		"* * * * *",
+		"*/15 9-17 * * 1-5",
+		"0,30 * 1,15 * *",
+		"5 4 * * 7",
+		"0 0-23/2 * 1-6 *",
+		" @daily ",
+		"@hourly",
+		"@weekly",
+		"@monthly",
+	} {
+		_, err := ParseCronSchedule(expr)
+		assert.NoError(t, err, expr)
+	}
+
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@yearly",
+	} {
+		_, err := ParseCronSchedule(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	// A Wednesday.
+	now := time.Date(2023, time.March, 15, 10, 7, 30, 0, time.UTC)
+
+	for _, tc := range []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2023, time.March, 15, 10, 8, 0, 0, time.UTC)},
+		{"*/15 9-17 * * 1-5", time.Date(2023, time.March, 15, 10, 15, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2023, time.March, 16, 9, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2023, time.March, 16, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2023, time.March, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2023, time.March, 19, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"30 2 29 2 *", time.Date(2024, time.February, 29, 2, 30, 0, 0, time.UTC)},
+		// Either day field matches when both are restricted.
+		{"0 0 20 * 5", time.Date(2023, time.March, 17, 0, 0, 0, 0, time.UTC)},
+	} {
+		schedule, err := ParseCronSchedule(tc.expr)
+		require.NoError(t, err, tc.expr)
+		assert.Equal(t, tc.expected, schedule.Next(now), tc.expr)
+	}
+
+	t.Run("never matching", func(t *testing.T) {
+		schedule, err := ParseCronSchedule("0 0 30 2 *")
+		require.NoError(t, err)
+		assert.True(t, schedule.Next(now).IsZero())
+	})
+}
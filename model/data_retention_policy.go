@@ -81,6 +81,16 @@ type RetentionPolicyForChannelList struct {
 	TotalCount int64                        `json:"total_count"`
 }
 
+// RetentionPolicyPreview reports how much data a granular retention policy would delete if it was
+// applied as of PostCutoff.
+type RetentionPolicyPreview struct {
+	// Posts created before the cutoff fall under the policy's post duration.
+	PostCutoff int64 `json:"post_cutoff"`
+	PostCount  int64 `json:"post_count"`
+	// The number of files attached to the posts that would be deleted.
+	FileCount int64 `json:"file_count"`
+}
+
 type RetentionPolicyCursor struct {
 	ChannelPoliciesDone bool
 	TeamPoliciesDone    bool
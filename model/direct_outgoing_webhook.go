@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DirectOutgoingWebhook is an outgoing webhook triggered by the posts made in the direct and group
+// messages a bot account is a member of. It only fires if every other member of the channel has
+// consented to share their messages with the bot, see PreferenceCategoryDirectWebhookConsent.
+// Responses are posted as the bot.
+type DirectOutgoingWebhook struct {
+	Id           string      `json:"id"`
+	Token        string      `json:"token"`
+	CreateAt     int64       `json:"create_at"`
+	UpdateAt     int64       `json:"update_at"`
+	DeleteAt     int64       `json:"delete_at"`
+	CreatorId    string      `json:"creator_id"`
+	BotUserId    string      `json:"bot_user_id"`
+	CallbackURLs StringArray `json:"callback_urls"`
+	DisplayName  string      `json:"display_name"`
+	Description  string      `json:"description"`
+	ContentType  string      `json:"content_type"`
+}
+
+func (o *DirectOutgoingWebhook) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            o.Id,
+		"create_at":     o.CreateAt,
+		"update_at":     o.UpdateAt,
+		"delete_at":     o.DeleteAt,
+		"creator_id":    o.CreatorId,
+		"bot_user_id":   o.BotUserId,
+		"callback_urls": o.CallbackURLs,
+		"display_name":  o.DisplayName,
+		"description":   o.Description,
+		"content_type":  o.ContentType,
+	}
+}
+
+func (o *DirectOutgoingWebhook) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("DirectOutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(o.Token) != 26 {
+		return NewAppError("DirectOutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.token.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("DirectOutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("DirectOutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("DirectOutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.BotUserId) {
+		return NewAppError("DirectOutgoingWebhook.IsValid", "model.direct_outgoing_hook.is_valid.bot_user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(o.CallbackURLs) == 0 || len(fmt.Sprintf("%s", o.CallbackURLs)) > 1024 {
+		return NewAppError("DirectOutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.callback.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	for _, callback := range o.CallbackURLs {
+		if !IsValidHTTPURL(callback) {
+			return NewAppError("DirectOutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.url.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	if len(o.DisplayName) > 64 {
+		return NewAppError("DirectOutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.display_name.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(o.Description) > 500 {
+		return NewAppError("DirectOutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.description.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(o.ContentType) > 128 {
+		return NewAppError("DirectOutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.content_type.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *DirectOutgoingWebhook) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.Token == "" {
+		o.Token = NewId()
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+}
+
+func (o *DirectOutgoingWebhook) PreUpdate() {
+	o.UpdateAt = GetMillis()
+}
+
+// ToOutgoingWebhook returns the outgoing webhook to trigger for the hook, posting its responses as
+// the bot.
+func (o *DirectOutgoingWebhook) ToOutgoingWebhook() *OutgoingWebhook {
+	return &OutgoingWebhook{
+		Id:           o.Id,
+		Token:        o.Token,
+		CreatorId:    o.BotUserId,
+		CallbackURLs: o.CallbackURLs,
+		DisplayName:  o.DisplayName,
+		ContentType:  o.ContentType,
+	}
+}
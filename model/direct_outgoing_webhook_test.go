@@ -0,0 +1,56 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectOutgoingWebhookIsValid(t *testing.T) {
+	o := DirectOutgoingWebhook{}
+	assert.NotNil(t, o.IsValid(), "empty declaration should be invalid")
+
+	o.PreSave()
+	assert.NotNil(t, o.IsValid(), "a hook without creator should be invalid")
+
+	o.CreatorId = NewId()
+	assert.NotNil(t, o.IsValid(), "a hook without bot should be invalid")
+
+	o.BotUserId = "123"
+	assert.NotNilf(t, o.IsValid(), "BotUserId %s should be invalid", o.BotUserId)
+
+	o.BotUserId = NewId()
+	assert.NotNil(t, o.IsValid(), "a hook without callback URLs should be invalid")
+
+	o.CallbackURLs = []string{"nowhere.com/"}
+	assert.NotNilf(t, o.IsValid(), "%v for CallbackURLs should be invalid", o.CallbackURLs)
+
+	o.CallbackURLs = []string{"http://nowhere.com/"}
+	assert.Nilf(t, o.IsValid(), "%v for CallbackURLs should be valid", o.CallbackURLs)
+
+	o.DisplayName = strings.Repeat("1", 65)
+	assert.NotNilf(t, o.IsValid(), "DisplayName length %d invalid, max length 64", len(o.DisplayName))
+
+	o.DisplayName = strings.Repeat("1", 64)
+	assert.Nilf(t, o.IsValid(), "DisplayName length %d should be valid", len(o.DisplayName))
+}
+
+func TestDirectOutgoingWebhookToOutgoingWebhook(t *testing.T) {
+	o := DirectOutgoingWebhook{
+		CreatorId:    NewId(),
+		BotUserId:    NewId(),
+		CallbackURLs: []string{"http://nowhere.com/"},
+		ContentType:  "application/json",
+	}
+	o.PreSave()
+
+	hook := o.ToOutgoingWebhook()
+	assert.Equal(t, o.Token, hook.Token)
+	assert.Equal(t, o.BotUserId, hook.CreatorId, "responses should be posted as the bot")
+	assert.Equal(t, o.CallbackURLs, hook.CallbackURLs)
+	assert.Equal(t, o.ContentType, hook.ContentType)
+}
@@ -5,6 +5,7 @@ package model
 
 import (
 	"net/http"
+	"strings"
 	"sync"
 	"unicode/utf8"
 )
@@ -24,6 +25,19 @@ type Draft struct {
 	FileIds  StringArray     `json:"file_ids,omitempty"`
 	Metadata *PostMetadata   `json:"metadata,omitempty"`
 	Priority StringInterface `json:"priority,omitempty"`
+
+	// Version is incremented each time the draft is saved. Clients send the version their changes
+	// are based on, and the draft isn't updated when it has changed since. The check is skipped
+	// when it's 0.
+	Version int64 `json:"version"`
+}
+
+// DraftConflict is the error returned when a draft is updated from an outdated version. It carries
+// both drafts for the client to choose one or merge them.
+type DraftConflict struct {
+	*AppError
+	ServerDraft *Draft `json:"server_draft"`
+	ClientDraft *Draft `json:"client_draft"`
 }
 
 func (o *Draft) IsValid(maxDraftSize int) *AppError {
@@ -85,6 +99,7 @@ func (o *Draft) PreSave() {
 
 	o.UpdateAt = o.CreateAt
 	o.DeleteAt = 0
+	o.Version = 1
 	o.PreCommit()
 }
 
@@ -105,3 +120,45 @@ func (o *Draft) PreUpdate() {
 	o.UpdateAt = GetMillis()
 	o.PreCommit()
 }
+
+// MergeDrafts combines the changes made to a draft on two clients into a draft based on the server
+// version. When a message extends the other, the longer one is kept, otherwise the client message
+// is appended to the server one. The files of both are kept, and the client props and priority take
+// precedence.
+func MergeDrafts(server, client *Draft) *Draft {
+	merged := &Draft{
+		CreateAt:  server.CreateAt,
+		UpdateAt:  server.UpdateAt,
+		UserId:    server.UserId,
+		ChannelId: server.ChannelId,
+		RootId:    server.RootId,
+		Priority:  server.Priority,
+		Version:   server.Version,
+	}
+
+	switch {
+	case strings.HasPrefix(client.Message, server.Message):
+		merged.Message = client.Message
+	case strings.HasPrefix(server.Message, client.Message):
+		merged.Message = server.Message
+	default:
+		merged.Message = server.Message + "\n\n" + client.Message
+	}
+
+	merged.FileIds = RemoveDuplicateStrings(append(append(StringArray{}, server.FileIds...), client.FileIds...))
+
+	props := make(StringInterface)
+	for key, value := range server.GetProps() {
+		props[key] = value
+	}
+	for key, value := range client.GetProps() {
+		props[key] = value
+	}
+	merged.SetProps(props)
+
+	if len(client.Priority) > 0 {
+		merged.Priority = client.Priority
+	}
+
+	return merged
+}
@@ -64,6 +64,7 @@ func TestDraftPreSave(t *testing.T) {
 	o.PreSave()
 
 	assert.LessOrEqual(t, o.CreateAt, past)
+	assert.Equal(t, int64(1), o.Version)
 }
 
 func TestDraftPreUpdate(t *testing.T) {
@@ -78,3 +79,47 @@ func TestDraftPreUpdate(t *testing.T) {
 
 	assert.GreaterOrEqual(t, o.UpdateAt, past)
 }
+
+func TestMergeDrafts(t *testing.T) {
+	server := &Draft{
+		CreateAt:  1,
+		UpdateAt:  2,
+		UserId:    NewId(),
+		ChannelId: NewId(),
+		Message:   "hello",
+		FileIds:   StringArray{"a", "b"},
+		Props:     StringInterface{"server": true, "both": "server"},
+		Version:   3,
+	}
+
+	t.Run("client message extending the server one", func(t *testing.T) {
+		merged := MergeDrafts(server, &Draft{Message: "hello world", Version: 2})
+		assert.Equal(t, "hello world", merged.Message)
+		assert.Equal(t, int64(3), merged.Version)
+		assert.Equal(t, server.UserId, merged.UserId)
+		assert.Equal(t, server.ChannelId, merged.ChannelId)
+	})
+
+	t.Run("server message extending the client one", func(t *testing.T) {
+		merged := MergeDrafts(server, &Draft{Message: "hel", Version: 2})
+		assert.Equal(t, "hello", merged.Message)
+	})
+
+	t.Run("diverging messages", func(t *testing.T) {
+		merged := MergeDrafts(server, &Draft{Message: "bye", Version: 2})
+		assert.Equal(t, "hello\n\nbye", merged.Message)
+	})
+
+	t.Run("files, props and priority", func(t *testing.T) {
+		merged := MergeDrafts(server, &Draft{
+			Message:  "hello",
+			FileIds:  StringArray{"b", "c"},
+			Props:    StringInterface{"client": true, "both": "client"},
+			Priority: StringInterface{"priority": "urgent"},
+		})
+		assert.ElementsMatch(t, StringArray{"a", "b", "c"}, merged.FileIds)
+		assert.Equal(t, StringInterface{"server": true, "client": true, "both": "client"}, merged.GetProps())
+		assert.Equal(t, StringInterface{"priority": "urgent"}, merged.Priority)
+		assert.ElementsMatch(t, StringArray{"a", "b"}, server.FileIds)
+	})
+}
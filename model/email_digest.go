@@ -0,0 +1,81 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	EmailDigestFrequencyHourly = "hourly"
+	EmailDigestFrequencyDaily  = "daily"
+	EmailDigestFrequencyWeekly = "weekly"
+)
+
+// EmailDigestSchedule is when a user receives the digest of their batched email notifications.
+// Hours and weekdays are in the user's own timezone.
+type EmailDigestSchedule struct {
+	Frequency string `json:"frequency"`
+	// Hour is the hour of the day a daily or weekly digest is sent at.
+	Hour int `json:"hour"`
+	// Minute is the minute of the hour the digest is sent at.
+	Minute int `json:"minute"`
+	// Weekday is the day of the week a weekly digest is sent on, with Sunday as 0.
+	Weekday time.Weekday `json:"weekday"`
+}
+
+func EmailDigestScheduleFromJSON(data string) (*EmailDigestSchedule, error) {
+	var schedule EmailDigestSchedule
+	if err := json.Unmarshal([]byte(data), &schedule); err != nil {
+		return nil, err
+	}
+	if !schedule.IsValid() {
+		return nil, errors.New("invalid email digest schedule")
+	}
+	return &schedule, nil
+}
+
+func (s *EmailDigestSchedule) IsValid() bool {
+	switch s.Frequency {
+	case EmailDigestFrequencyHourly, EmailDigestFrequencyDaily, EmailDigestFrequencyWeekly:
+	default:
+		return false
+	}
+
+	return s.Hour >= 0 && s.Hour < 24 &&
+		s.Minute >= 0 && s.Minute < 60 &&
+		s.Weekday >= time.Sunday && s.Weekday <= time.Saturday
+}
+
+// Next returns the first time strictly after the given one the digest is due, evaluated in loc.
+func (s *EmailDigestSchedule) Next(after time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t := after.In(loc)
+
+	switch s.Frequency {
+	case EmailDigestFrequencyHourly:
+		next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), s.Minute, 0, 0, loc)
+		if !next.After(after) {
+			next = next.Add(time.Hour)
+		}
+		return next
+	case EmailDigestFrequencyWeekly:
+		next := time.Date(t.Year(), t.Month(), t.Day(), s.Hour, s.Minute, 0, 0, loc)
+		next = next.AddDate(0, 0, (int(s.Weekday)-int(next.Weekday())+7)%7)
+		if !next.After(after) {
+			next = next.AddDate(0, 0, 7)
+		}
+		return next
+	default:
+		next := time.Date(t.Year(), t.Month(), t.Day(), s.Hour, s.Minute, 0, 0, loc)
+		if !next.After(after) {
+			next = next.AddDate(0, 0, 1)
+		}
+		return next
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailDigestScheduleFromJSON(t *testing.T) {
+	schedule, err := EmailDigestScheduleFromJSON(`{"frequency":"weekly","hour":9,"minute":30,"weekday":1}`)
+	require.NoError(t, err)
+	assert.Equal(t, &EmailDigestSchedule{Frequency: EmailDigestFrequencyWeekly, Hour: 9, Minute: 30, Weekday: time.Monday}, schedule)
+
+	for _, value := range []string{
+		"",
+		"garbage",
+		`{"frequency":"monthly"}`,
+		`{"frequency":"daily","hour":24}`,
+		`{"frequency":"daily","minute":-1}`,
+		`{"frequency":"weekly","weekday":7}`,
+	} {
+		_, err := EmailDigestScheduleFromJSON(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestEmailDigestScheduleNext(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// Wednesday, 10:15 in New York.
+	after := time.Date(2023, time.March, 1, 10, 15, 0, 0, loc)
+
+	for name, tc := range map[string]struct {
+		schedule EmailDigestSchedule
+		expected time.Time
+	}{
+		"hourly, later this hour": {
+			EmailDigestSchedule{Frequency: EmailDigestFrequencyHourly, Minute: 30},
+			time.Date(2023, time.March, 1, 10, 30, 0, 0, loc),
+		},
+		"hourly, next hour": {
+			EmailDigestSchedule{Frequency: EmailDigestFrequencyHourly, Minute: 15},
+			time.Date(2023, time.March, 1, 11, 15, 0, 0, loc),
+		},
+		"daily, later today": {
+			EmailDigestSchedule{Frequency: EmailDigestFrequencyDaily, Hour: 17},
+			time.Date(2023, time.March, 1, 17, 0, 0, 0, loc),
+		},
+		"daily, tomorrow": {
+			EmailDigestSchedule{Frequency: EmailDigestFrequencyDaily, Hour: 9},
+			time.Date(2023, time.March, 2, 9, 0, 0, 0, loc),
+		},
+		"weekly, later this week": {
+			EmailDigestSchedule{Frequency: EmailDigestFrequencyWeekly, Hour: 8, Weekday: time.Friday},
+			time.Date(2023, time.March, 3, 8, 0, 0, 0, loc),
+		},
+		"weekly, next week": {
+			EmailDigestSchedule{Frequency: EmailDigestFrequencyWeekly, Hour: 8, Weekday: time.Wednesday},
+			time.Date(2023, time.March, 8, 8, 0, 0, 0, loc),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			next := tc.schedule.Next(after.UTC(), loc)
+			assert.True(t, tc.expected.Equal(next), "expected %v, got %v", tc.expected, next)
+		})
+	}
+}
@@ -61,6 +61,9 @@ type FileInfo struct {
 	Content         string  `json:"-"`
 	RemoteId        *string `json:"remote_id"`
 	Archived        bool    `json:"archived"`
+	// ColdStorage is true once the file has been moved to the cold storage file store. Its thumbnail
+	// and preview are kept in the primary file store.
+	ColdStorage bool `json:"cold_storage,omitempty"`
 }
 
 func (fi *FileInfo) Auditable() map[string]interface{} {
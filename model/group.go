@@ -11,6 +11,8 @@ import (
 const (
 	GroupSourceLdap   GroupSource = "ldap"
 	GroupSourceCustom GroupSource = "custom"
+	GroupSourceSCIM   GroupSource = "scim"
+	GroupSourceOIDC   GroupSource = "oidc"
 
 	GroupNameMaxLength        = 64
 	GroupSourceMaxLength      = 64
@@ -24,10 +26,14 @@ type GroupSource string
 var allGroupSources = []GroupSource{
 	GroupSourceLdap,
 	GroupSourceCustom,
+	GroupSourceSCIM,
+	GroupSourceOIDC,
 }
 
 var groupSourcesRequiringRemoteID = []GroupSource{
 	GroupSourceLdap,
+	GroupSourceSCIM,
+	GroupSourceOIDC,
 }
 
 type Group struct {
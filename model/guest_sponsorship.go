@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+// GuestSponsorship links a guest account to the member responsible for it and to the time at
+// which the guest is automatically deactivated. An ExpiresAt of zero means the guest never expires.
+type GuestSponsorship struct {
+	UserId    string `json:"user_id"`
+	SponsorId string `json:"sponsor_id"`
+	ExpiresAt int64  `json:"expires_at"`
+	CreateAt  int64  `json:"create_at"`
+	UpdateAt  int64  `json:"update_at"`
+}
+
+type GuestSponsorshipPatch struct {
+	SponsorId *string `json:"sponsor_id"`
+	ExpiresAt *int64  `json:"expires_at"`
+}
+
+type GuestSponsorshipRenewal struct {
+	// ExpiresAt is the new expiration time. When omitted, the guest is renewed for the
+	// configured default expiration period.
+	ExpiresAt *int64 `json:"expires_at"`
+}
+
+// GuestSponsorReport summarizes the active guests sponsored by a member.
+type GuestSponsorReport struct {
+	SponsorId         string `json:"sponsor_id"`
+	GuestCount        int64  `json:"guest_count"`
+	NextExpiresAt     int64  `json:"next_expires_at"`
+	NonExpiringGuests int64  `json:"non_expiring_guests"`
+}
+
+func (o *GuestSponsorship) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":    o.UserId,
+		"sponsor_id": o.SponsorId,
+		"expires_at": o.ExpiresAt,
+		"create_at":  o.CreateAt,
+		"update_at":  o.UpdateAt,
+	}
+}
+
+func (o *GuestSponsorship) IsValid() *AppError {
+	if !IsValidId(o.UserId) {
+		return NewAppError("GuestSponsorship.IsValid", "model.guest_sponsorship.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.SponsorId) || o.SponsorId == o.UserId {
+		return NewAppError("GuestSponsorship.IsValid", "model.guest_sponsorship.is_valid.sponsor_id.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if o.ExpiresAt < 0 {
+		return NewAppError("GuestSponsorship.IsValid", "model.guest_sponsorship.is_valid.expires_at.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("GuestSponsorship.IsValid", "model.guest_sponsorship.is_valid.create_at.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("GuestSponsorship.IsValid", "model.guest_sponsorship.is_valid.update_at.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *GuestSponsorship) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+	o.UpdateAt = o.CreateAt
+}
+
+func (o *GuestSponsorship) PreUpdate() {
+	o.UpdateAt = GetMillis()
+}
+
+func (o *GuestSponsorship) Patch(patch *GuestSponsorshipPatch) {
+	if patch.SponsorId != nil {
+		o.SponsorId = *patch.SponsorId
+	}
+
+	if patch.ExpiresAt != nil {
+		o.ExpiresAt = *patch.ExpiresAt
+	}
+}
+
+// IsExpired reports whether the sponsorship has an expiration time that is not after now.
+func (o *GuestSponsorship) IsExpired(now int64) bool {
+	return o.ExpiresAt > 0 && o.ExpiresAt <= now
+}
+
+// GuestExpiresAt returns the expiration time for a guest created or renewed at now, given the
+// configured expiration period in days. Zero days means guests never expire.
+func GuestExpiresAt(now int64, days int) int64 {
+	if days <= 0 {
+		return 0
+	}
+
+	return now + int64(days)*24*60*60*1000
+}
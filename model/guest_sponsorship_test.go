@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuestSponsorshipIsValid(t *testing.T) {
+	sponsorship := &GuestSponsorship{
+		UserId:    NewId(),
+		SponsorId: NewId(),
+	}
+	sponsorship.PreSave()
+	require.Nil(t, sponsorship.IsValid())
+
+	sponsorship.SponsorId = sponsorship.UserId
+	require.NotNil(t, sponsorship.IsValid())
+	sponsorship.SponsorId = NewId()
+
+	sponsorship.ExpiresAt = -1
+	require.NotNil(t, sponsorship.IsValid())
+	sponsorship.ExpiresAt = 0
+
+	sponsorship.UserId = "invalid"
+	require.NotNil(t, sponsorship.IsValid())
+}
+
+func TestGuestSponsorshipPatch(t *testing.T) {
+	sponsorship := &GuestSponsorship{UserId: NewId(), SponsorId: NewId(), ExpiresAt: 100}
+
+	sponsorID := NewId()
+	sponsorship.Patch(&GuestSponsorshipPatch{SponsorId: &sponsorID})
+	assert.Equal(t, sponsorID, sponsorship.SponsorId)
+	assert.Equal(t, int64(100), sponsorship.ExpiresAt)
+
+	sponsorship.Patch(&GuestSponsorshipPatch{ExpiresAt: NewInt64(0)})
+	assert.Equal(t, sponsorID, sponsorship.SponsorId)
+	assert.Equal(t, int64(0), sponsorship.ExpiresAt)
+}
+
+func TestGuestSponsorshipIsExpired(t *testing.T) {
+	assert.False(t, (&GuestSponsorship{}).IsExpired(1000))
+	assert.False(t, (&GuestSponsorship{ExpiresAt: 1001}).IsExpired(1000))
+	assert.True(t, (&GuestSponsorship{ExpiresAt: 1000}).IsExpired(1000))
+}
+
+func TestGuestExpiresAt(t *testing.T) {
+	assert.Equal(t, int64(0), GuestExpiresAt(1000, 0))
+	assert.Equal(t, int64(1000+2*24*60*60*1000), GuestExpiresAt(1000, 2))
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	InboxItemTypeChannel = "channel"
+	InboxItemTypeThread  = "thread"
+
+	InboxDefaultPerPage = 60
+	InboxMaxPerPage     = 200
+)
+
+// InboxItem is an unread channel or followed thread of a user. It only carries what's needed to
+// order the item and show its badges; clients fetch the channels and threads they don't have yet.
+type InboxItem struct {
+	Type string `json:"type"`
+	// Id is the id of the channel, or of the root post of the thread.
+	Id        string `json:"id"`
+	TeamId    string `json:"team_id"`
+	ChannelId string `json:"channel_id"`
+	// LastActivityAt is the time of the last post in the channel, or of the last reply to the thread.
+	LastActivityAt int64 `json:"last_activity_at"`
+	// MsgCount is the number of unread messages of a channel. Threads only count their unread
+	// mentions.
+	MsgCount           int64     `json:"msg_count"`
+	MentionCount       int64     `json:"mention_count"`
+	UrgentMentionCount int64     `json:"urgent_mention_count"`
+	NotifyProps        StringMap `json:"-"`
+}
+
+// Inbox is a page of the unread channels and threads of a user across all their teams, the most
+// recently active first.
+type Inbox struct {
+	Items []*InboxItem `json:"items"`
+	// NextCursor gets the next page, it's empty on the last page.
+	NextCursor string `json:"next_cursor"`
+}
+
+// InboxCursor is the position of the last item of an inbox page.
+type InboxCursor struct {
+	LastActivityAt int64
+	Id             string
+}
+
+// IsZero returns whether the cursor points at the start of the inbox.
+func (c InboxCursor) IsZero() bool {
+	return c.LastActivityAt == 0 && c.Id == ""
+}
+
+func (c InboxCursor) String() string {
+	if c.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(c.LastActivityAt, 10) + ":" + c.Id
+}
+
+// ParseInboxCursor parses the NextCursor of an inbox page, an empty string being the start of
+// the inbox.
+func ParseInboxCursor(s string) (InboxCursor, bool) {
+	if s == "" {
+		return InboxCursor{}, true
+	}
+
+	at, id, found := strings.Cut(s, ":")
+	if !found || !IsValidId(id) {
+		return InboxCursor{}, false
+	}
+
+	lastActivityAt, err := strconv.ParseInt(at, 10, 64)
+	if err != nil || lastActivityAt <= 0 {
+		return InboxCursor{}, false
+	}
+
+	return InboxCursor{LastActivityAt: lastActivityAt, Id: id}, true
+}
+
+type GetInboxOptions struct {
+	// Cursor is where the page starts, exclusive.
+	Cursor InboxCursor
+	// CollapsedThreads counts channel unreads without thread replies, which are returned as
+	// thread items instead.
+	CollapsedThreads bool
+	PerPage          int
+}
+
+// Before returns whether the item comes before the other in the inbox.
+func (o *InboxItem) Before(other *InboxItem) bool {
+	if o.LastActivityAt != other.LastActivityAt {
+		return o.LastActivityAt > other.LastActivityAt
+	}
+	return o.Id > other.Id
+}
+
+// Cursor returns the position of the item in the inbox.
+func (o *InboxItem) Cursor() InboxCursor {
+	return InboxCursor{LastActivityAt: o.LastActivityAt, Id: o.Id}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboxCursor(t *testing.T) {
+	cursor, ok := ParseInboxCursor("")
+	require.True(t, ok)
+	assert.True(t, cursor.IsZero())
+	assert.Equal(t, "", cursor.String())
+
+	item := &InboxItem{Id: NewId(), LastActivityAt: 1234}
+	cursor, ok = ParseInboxCursor(item.Cursor().String())
+	require.True(t, ok)
+	assert.Equal(t, item.Cursor(), cursor)
+
+	for _, s := range []string{"1234", "1234:junk", "junk:" + NewId(), "-1:" + NewId()} {
+		_, ok = ParseInboxCursor(s)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestInboxItemBefore(t *testing.T) {
+	older := &InboxItem{Id: "b", LastActivityAt: 1}
+	newer := &InboxItem{Id: "a", LastActivityAt: 2}
+	assert.True(t, newer.Before(older))
+	assert.False(t, older.Before(newer))
+
+	tied := &InboxItem{Id: "c", LastActivityAt: 1}
+	assert.True(t, tied.Before(older))
+}
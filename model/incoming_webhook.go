@@ -6,15 +6,44 @@ package model
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"regexp"
+	"strings"
+	"text/template"
 )
 
 const (
 	DefaultWebhookUsername = "webhook"
+
+	IncomingWebhookMessageTemplateMaxLength = 4000
 )
 
+// incomingWebhookTemplateFuncs are the functions available to the message templates of incoming
+// webhooks, on top of the text/template builtins.
+var incomingWebhookTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"default": func(def, v any) any {
+		if v == nil || v == "" {
+			return def
+		}
+		return v
+	},
+	"join": func(sep string, values []any) string {
+		strs := make([]string, len(values))
+		for i, v := range values {
+			strs[i] = fmt.Sprint(v)
+		}
+		return strings.Join(strs, sep)
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
+
 type IncomingWebhook struct {
 	Id            string `json:"id"`
 	CreateAt      int64  `json:"create_at"`
@@ -28,22 +57,26 @@ type IncomingWebhook struct {
 	Username      string `json:"username"`
 	IconURL       string `json:"icon_url"`
 	ChannelLocked bool   `json:"channel_locked"`
+	// MessageTemplate is an optional Go text/template rendering the text of the posts from the
+	// JSON payloads posted to the hook, letting it accept the payloads of third-party services.
+	MessageTemplate string `json:"message_template"`
 }
 
 func (o *IncomingWebhook) Auditable() map[string]interface{} {
 	return map[string]interface{}{
-		"id":             o.Id,
-		"create_at":      o.CreateAt,
-		"update_at":      o.UpdateAt,
-		"delete_at":      o.DeleteAt,
-		"user_id":        o.UserId,
-		"channel_id":     o.ChannelId,
-		"team_id":        o.TeamId,
-		"display_name":   o.DisplayName,
-		"description":    o.Description,
-		"username":       o.Username,
-		"icon_url:":      o.IconURL,
-		"channel_locked": o.ChannelLocked,
+		"id":               o.Id,
+		"create_at":        o.CreateAt,
+		"update_at":        o.UpdateAt,
+		"delete_at":        o.DeleteAt,
+		"user_id":          o.UserId,
+		"channel_id":       o.ChannelId,
+		"team_id":          o.TeamId,
+		"display_name":     o.DisplayName,
+		"description":      o.Description,
+		"username":         o.Username,
+		"icon_url:":        o.IconURL,
+		"channel_locked":   o.ChannelLocked,
+		"message_template": o.MessageTemplate,
 	}
 }
 
@@ -100,9 +133,51 @@ func (o *IncomingWebhook) IsValid() *AppError {
 		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.icon_url.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	return o.IsValidMessageTemplate()
+}
+
+// IsValidMessageTemplate checks that the message template of the hook, if any, can be parsed.
+func (o *IncomingWebhook) IsValidMessageTemplate() *AppError {
+	if o.MessageTemplate == "" {
+		return nil
+	}
+
+	if len(o.MessageTemplate) > IncomingWebhookMessageTemplateMaxLength {
+		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.message_template.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if _, err := o.parseMessageTemplate(); err != nil {
+		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.message_template.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
 	return nil
 }
 
+func (o *IncomingWebhook) parseMessageTemplate() (*template.Template, error) {
+	return template.New("message").Funcs(incomingWebhookTemplateFuncs).Parse(o.MessageTemplate)
+}
+
+// RenderMessageTemplate renders the message template of the hook with the JSON document posted to
+// it, returning the request creating the post.
+func (o *IncomingWebhook) RenderMessageTemplate(payload io.Reader) (*IncomingWebhookRequest, error) {
+	tmpl, err := o.parseMessageTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	var data any
+	if err := json.NewDecoder(payload).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	var text strings.Builder
+	if err := tmpl.Execute(&text, data); err != nil {
+		return nil, err
+	}
+
+	return &IncomingWebhookRequest{Text: strings.TrimSpace(text.String())}, nil
+}
+
 func (o *IncomingWebhook) PreSave() {
 	if o.Id == "" {
 		o.Id = NewId()
@@ -65,6 +65,39 @@ func TestIncomingWebhookIsValid(t *testing.T) {
 
 	o.IconURL = strings.Repeat("1", 1024)
 	require.Nil(t, o.IsValid())
+
+	o.MessageTemplate = "{{.text"
+	require.NotNil(t, o.IsValid())
+
+	o.MessageTemplate = strings.Repeat("1", IncomingWebhookMessageTemplateMaxLength+1)
+	require.NotNil(t, o.IsValid())
+
+	o.MessageTemplate = "{{.text}}"
+	require.Nil(t, o.IsValid())
+}
+
+func TestIncomingWebhookRenderMessageTemplate(t *testing.T) {
+	o := IncomingWebhook{
+		MessageTemplate: `[{{.repository.full_name}}] {{.pusher.name}} pushed {{len .commits}} commit(s) to {{default "main" .ref}}
+{{range .commits}}- {{.message}}
+{{end}}`,
+	}
+
+	req, err := o.RenderMessageTemplate(strings.NewReader(`{
+		"repository": {"full_name": "mattermost/mattermost"},
+		"pusher": {"name": "octocat"},
+		"commits": [{"message": "Fix tests"}, {"message": "Update docs"}]
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, "[mattermost/mattermost] octocat pushed 2 commit(s) to main\n- Fix tests\n- Update docs", req.Text)
+
+	o.MessageTemplate = "{{json .labels}} {{join \"/\" .labels}} {{lower .name}}"
+	req, err = o.RenderMessageTemplate(strings.NewReader(`{"labels": ["a", "b"], "name": "CPU"}`))
+	require.NoError(t, err)
+	require.Equal(t, `["a","b"] a/b cpu`, req.Text)
+
+	_, err = o.RenderMessageTemplate(strings.NewReader("not json"))
+	require.Error(t, err)
 }
 
 func TestIncomingWebhookPreSave(t *testing.T) {
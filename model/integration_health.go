@@ -0,0 +1,31 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	IntegrationTypeOutgoingWebhook = "outgoing_webhook"
+	IntegrationTypeCommand         = "command"
+
+	// The circuit of an integration is closed while its requests succeed, and opens after
+	// ServiceSettings.OutgoingIntegrationsCircuitBreakerThreshold consecutive failures. Requests to an
+	// integration with an open circuit fail immediately until the cooldown has elapsed, after which
+	// the circuit is half open and a single trial request decides whether it closes or opens again.
+	IntegrationCircuitStateClosed   = "closed"
+	IntegrationCircuitStateOpen     = "open"
+	IntegrationCircuitStateHalfOpen = "half_open"
+)
+
+// IntegrationHealth describes the outbound requests made by this server to an integration.
+type IntegrationHealth struct {
+	IntegrationId       string `json:"integration_id"`
+	IntegrationType     string `json:"integration_type"`
+	CircuitState        string `json:"circuit_state"`
+	ConsecutiveFailures int    `json:"consecutive_failures"`
+	TotalRequests       int64  `json:"total_requests"`
+	TotalFailures       int64  `json:"total_failures"`
+	LastError           string `json:"last_error"`
+	LastSuccessAt       int64  `json:"last_success_at"`
+	LastFailureAt       int64  `json:"last_failure_at"`
+	OpenUntil           int64  `json:"open_until"`
+}
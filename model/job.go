@@ -13,6 +13,7 @@ const (
 	JobTypeMessageExport                = "message_export"
 	JobTypeElasticsearchPostIndexing    = "elasticsearch_post_indexing"
 	JobTypeElasticsearchPostAggregation = "elasticsearch_post_aggregation"
+	JobTypeElasticsearchIndexMigration  = "elasticsearch_index_migration"
 	JobTypeBlevePostIndexing            = "bleve_post_indexing"
 	JobTypeLdapSync                     = "ldap_sync"
 	JobTypeMigrations                   = "migrations"
@@ -33,6 +34,22 @@ const (
 	JobTypeTrialNotifyAdmin             = "trial_notify_admin"
 	JobTypeInstallPluginNotifyAdmin     = "install_plugin_notify_admin"
 	JobTypeHostedPurchaseScreening      = "hosted_purchase_screening"
+	JobTypeScheduledPosts               = "scheduled_posts"
+	JobTypeReactionSummaries            = "reaction_summaries"
+	JobTypeChannelExport                = "channel_export"
+	JobTypeColdStorage                  = "cold_storage"
+	JobTypeSlackImport                  = "slack_import"
+	JobTypeMSTeamsImport                = "msteams_import"
+	JobTypeExpiredPosts                 = "expired_posts"
+	JobTypeSemanticSearchIndexing       = "semantic_search_indexing"
+	JobTypeGuestExpiration              = "guest_expiration"
+	JobTypeChannelMemberExpiry          = "channel_member_expiry"
+	JobTypeTeamArchive                  = "team_archive"
+	JobTypeUserOffboarding              = "user_offboarding"
+	JobTypePartitionMaintenance         = "partition_maintenance"
+	JobTypePostArchive                  = "post_archive"
+	JobTypeChannelCounters              = "channel_counters"
+	JobTypeChannelMembersBulk           = "channel_members_bulk"
 
 	JobStatusPending         = "pending"
 	JobStatusInProgress      = "in_progress"
@@ -41,6 +58,14 @@ const (
 	JobStatusCancelRequested = "cancel_requested"
 	JobStatusCanceled        = "canceled"
 	JobStatusWarning         = "warning"
+
+	// The pending jobs are started by priority, then by creation.
+	JobPriorityMin = -100
+	JobPriorityMax = 100
+
+	// The checkpoint saved by the worker of a job, from which the job is resumed when it was
+	// interrupted.
+	JobDataKeyCheckpoint = "checkpoint"
 )
 
 var AllJobTypes = [...]string{
@@ -48,6 +73,7 @@ var AllJobTypes = [...]string{
 	JobTypeMessageExport,
 	JobTypeElasticsearchPostIndexing,
 	JobTypeElasticsearchPostAggregation,
+	JobTypeElasticsearchIndexMigration,
 	JobTypeBlevePostIndexing,
 	JobTypeLdapSync,
 	JobTypeMigrations,
@@ -63,6 +89,22 @@ var AllJobTypes = [...]string{
 	JobTypeExtractContent,
 	JobTypeLastAccessiblePost,
 	JobTypeLastAccessibleFile,
+	JobTypeScheduledPosts,
+	JobTypeReactionSummaries,
+	JobTypeChannelExport,
+	JobTypeColdStorage,
+	JobTypeSlackImport,
+	JobTypeMSTeamsImport,
+	JobTypeExpiredPosts,
+	JobTypeSemanticSearchIndexing,
+	JobTypeGuestExpiration,
+	JobTypeChannelMemberExpiry,
+	JobTypeTeamArchive,
+	JobTypeUserOffboarding,
+	JobTypePartitionMaintenance,
+	JobTypePostArchive,
+	JobTypeChannelCounters,
+	JobTypeChannelMembersBulk,
 }
 
 type Job struct {
@@ -100,6 +142,10 @@ func (j *Job) IsValid() *AppError {
 		return NewAppError("Job.IsValid", "model.job.is_valid.create_at.app_error", nil, "id="+j.Id, http.StatusBadRequest)
 	}
 
+	if j.Priority < JobPriorityMin || j.Priority > JobPriorityMax {
+		return NewAppError("Job.IsValid", "model.job.is_valid.priority.app_error", map[string]any{"Min": JobPriorityMin, "Max": JobPriorityMax}, "id="+j.Id, http.StatusBadRequest)
+	}
+
 	switch j.Status {
 	case JobStatusPending,
 		JobStatusInProgress,
@@ -115,6 +161,18 @@ func (j *Job) IsValid() *AppError {
 	return nil
 }
 
+// Checkpoint returns the checkpoint saved by the worker of the job, empty when there is none.
+func (j *Job) Checkpoint() string {
+	return j.Data[JobDataKeyCheckpoint]
+}
+
+// IsResumable reports whether the worker of the job saved a checkpoint the job can be resumed
+// from.
+func (j *Job) IsResumable() bool {
+	_, ok := j.Data[JobDataKeyCheckpoint]
+	return ok
+}
+
 type Worker interface {
 	Run()
 	Stop()
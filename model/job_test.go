@@ -121,3 +121,15 @@ func TestJobIsValid(t *testing.T) {
 		}
 	})
 }
+
+func TestJobCheckpoint(t *testing.T) {
+	job := &Job{Id: NewId()}
+	require.False(t, job.IsResumable())
+	require.Empty(t, job.Checkpoint())
+
+	job.Data = StringMap{JobDataKeyCheckpoint: ""}
+	require.True(t, job.IsResumable(), "an empty checkpoint should still be resumed from")
+
+	job.Data[JobDataKeyCheckpoint] = "42"
+	require.Equal(t, "42", job.Checkpoint())
+}
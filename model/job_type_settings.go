@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	JobTypeSettingsMaxConcurrency = 100
+	JobTypeSettingsScheduleMaxLen = 128
+
+	JobTypeStatsMaxRecentFailures = 5
+)
+
+// JobTypeSettings are the settings of a job type changed at runtime, shared by the nodes of the
+// cluster. The pending jobs are started by priority, the jobs of a paused type not being started
+// nor scheduled. MaxConcurrency limits how many jobs of the type can be in progress across the
+// cluster, zero meaning no limit, and Schedule is a cron expression replacing the schedule of the
+// scheduler of the type.
+type JobTypeSettings struct {
+	JobType        string `json:"job_type"`
+	Priority       int64  `json:"priority"`
+	MaxConcurrency int    `json:"max_concurrency"`
+	Paused         bool   `json:"paused"`
+	Schedule       string `json:"schedule"`
+	UpdateAt       int64  `json:"update_at"`
+}
+
+type JobTypeSettingsPatch struct {
+	Priority       *int64  `json:"priority"`
+	MaxConcurrency *int    `json:"max_concurrency"`
+	Schedule       *string `json:"schedule"`
+}
+
+func (o *JobTypeSettings) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"job_type":        o.JobType,
+		"priority":        o.Priority,
+		"max_concurrency": o.MaxConcurrency,
+		"paused":          o.Paused,
+		"schedule":        o.Schedule,
+		"update_at":       o.UpdateAt,
+	}
+}
+
+func (o *JobTypeSettings) IsValid() *AppError {
+	if o.JobType == "" || len(o.JobType) > 32 {
+		return NewAppError("JobTypeSettings.IsValid", "model.job_type_settings.is_valid.job_type.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.Priority < JobPriorityMin || o.Priority > JobPriorityMax {
+		return NewAppError("JobTypeSettings.IsValid", "model.job_type_settings.is_valid.priority.app_error", map[string]any{"Min": JobPriorityMin, "Max": JobPriorityMax}, "job_type="+o.JobType, http.StatusBadRequest)
+	}
+
+	if o.MaxConcurrency < 0 || o.MaxConcurrency > JobTypeSettingsMaxConcurrency {
+		return NewAppError("JobTypeSettings.IsValid", "model.job_type_settings.is_valid.max_concurrency.app_error", map[string]any{"Max": JobTypeSettingsMaxConcurrency}, "job_type="+o.JobType, http.StatusBadRequest)
+	}
+
+	if o.Schedule != "" {
+		if len(o.Schedule) > JobTypeSettingsScheduleMaxLen {
+			return NewAppError("JobTypeSettings.IsValid", "model.job_type_settings.is_valid.schedule.app_error", nil, "job_type="+o.JobType, http.StatusBadRequest)
+		}
+		if _, err := ParseCronSchedule(o.Schedule); err != nil {
+			return NewAppError("JobTypeSettings.IsValid", "model.job_type_settings.is_valid.schedule.app_error", nil, "job_type="+o.JobType, http.StatusBadRequest).Wrap(err)
+		}
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("JobTypeSettings.IsValid", "model.job_type_settings.is_valid.update_at.app_error", nil, "job_type="+o.JobType, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *JobTypeSettings) PreSave() {
+	o.UpdateAt = GetMillis()
+}
+
+func (o *JobTypeSettings) Patch(patch *JobTypeSettingsPatch) {
+	if patch.Priority != nil {
+		o.Priority = *patch.Priority
+	}
+
+	if patch.MaxConcurrency != nil {
+		o.MaxConcurrency = *patch.MaxConcurrency
+	}
+
+	if patch.Schedule != nil {
+		o.Schedule = *patch.Schedule
+	}
+}
+
+// JobFailure is the reason a job failed, as saved in the error of its data.
+type JobFailure struct {
+	JobId    string `json:"job_id"`
+	CreateAt int64  `json:"create_at"`
+	Error    string `json:"error"`
+}
+
+// JobTypeStats summarizes the jobs of a type created since a given time. The durations, in
+// milliseconds, are those of the jobs that finished, from their start to their last activity.
+type JobTypeStats struct {
+	JobType         string           `json:"job_type"`
+	Settings        *JobTypeSettings `json:"settings"`
+	StatusCounts    map[string]int64 `json:"status_counts"`
+	AverageDuration int64            `json:"average_duration"`
+	MaxDuration     int64            `json:"max_duration"`
+	LastStartAt     int64            `json:"last_start_at"`
+	RecentFailures  []*JobFailure    `json:"recent_failures"`
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobTypeSettingsIsValid(t *testing.T) {
+	settings := &JobTypeSettings{JobType: JobTypeDataRetention}
+	settings.PreSave()
+	require.Nil(t, settings.IsValid())
+
+	for name, tc := range map[string]struct {
+		update func(*JobTypeSettings)
+		id     string
+	}{
+		"job type":        {func(s *JobTypeSettings) { s.JobType = "" }, "model.job_type_settings.is_valid.job_type.app_error"},
+		"priority":        {func(s *JobTypeSettings) { s.Priority = JobPriorityMax + 1 }, "model.job_type_settings.is_valid.priority.app_error"},
+		"max concurrency": {func(s *JobTypeSettings) { s.MaxConcurrency = -1 }, "model.job_type_settings.is_valid.max_concurrency.app_error"},
+		"schedule":        {func(s *JobTypeSettings) { s.Schedule = "* * *" }, "model.job_type_settings.is_valid.schedule.app_error"},
+		"update at":       {func(s *JobTypeSettings) { s.UpdateAt = 0 }, "model.job_type_settings.is_valid.update_at.app_error"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			invalid := *settings
+			tc.update(&invalid)
+			appErr := invalid.IsValid()
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.id, appErr.Id)
+		})
+	}
+}
+
+func TestJobTypeSettingsPatch(t *testing.T) {
+	settings := &JobTypeSettings{
+		JobType:        JobTypeDataRetention,
+		Priority:       1,
+		MaxConcurrency: 2,
+		Schedule:       "@daily",
+	}
+
+	settings.Patch(&JobTypeSettingsPatch{MaxConcurrency: NewInt(5), Schedule: NewString("")})
+
+	assert.Equal(t, int64(1), settings.Priority)
+	assert.Equal(t, 5, settings.MaxConcurrency)
+	assert.Empty(t, settings.Schedule)
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"unicode/utf8"
+)
+
+const (
+	// The leadership of an election is leased, the leader having to renew it before the lease
+	// expires to remain the leader.
+	LeaderElectionLeaseSeconds = 30
+	LeaderElectionNameMaxRunes = 64
+)
+
+// IsValidLeaderElectionName checks the name of an election campaigned for by a product or a
+// plugin, the name being unique within the product or plugin.
+func IsValidLeaderElectionName(name string) bool {
+	return utf8.RuneCountInString(name) <= LeaderElectionNameMaxRunes && IsValidAlphaNumHyphenUnderscore(name, false)
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidLeaderElectionName(t *testing.T) {
+	assert.True(t, IsValidLeaderElectionName("metrics_updater"))
+	assert.True(t, IsValidLeaderElectionName("digest-sender"))
+	assert.True(t, IsValidLeaderElectionName(strings.Repeat("a", LeaderElectionNameMaxRunes)))
+
+	assert.False(t, IsValidLeaderElectionName(""))
+	assert.False(t, IsValidLeaderElectionName("digest sender"))
+	assert.False(t, IsValidLeaderElectionName("digest/sender"))
+	assert.False(t, IsValidLeaderElectionName(strings.Repeat("a", LeaderElectionNameMaxRunes+1)))
+}
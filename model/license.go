@@ -346,6 +346,14 @@ func (l *License) HasSharedChannels() bool {
 		l.SkuShortName == LicenseShortSkuEnterprise
 }
 
+// HasRestrictedPosts returns whether posts can be restricted to a subset of the members of their
+// channel.
+func (l *License) HasRestrictedPosts() bool {
+	return l != nil &&
+		(l.SkuShortName == LicenseShortSkuE20 ||
+			l.SkuShortName == LicenseShortSkuEnterprise)
+}
+
 // NewTestLicense returns a license that expires in the future and has the given features.
 func NewTestLicense(features ...string) *License {
 	ret := &License{
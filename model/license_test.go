@@ -393,3 +393,20 @@ func TestLicenseHasSharedChannels(t *testing.T) {
 		})
 	}
 }
+
+func TestLicenseHasRestrictedPosts(t *testing.T) {
+	var nilLicense *License
+	assert.False(t, nilLicense.HasRestrictedPosts())
+
+	for sku, expected := range map[string]bool{
+		LicenseShortSkuE10:          false,
+		LicenseShortSkuProfessional: false,
+		LicenseShortSkuE20:          true,
+		LicenseShortSkuEnterprise:   true,
+	} {
+		t.Run(sku, func(t *testing.T) {
+			license := License{Features: &Features{}, SkuShortName: sku}
+			assert.Equal(t, expected, license.HasRestrictedPosts())
+		})
+	}
+}
@@ -193,6 +193,13 @@ type Manifest struct {
 	//
 	// Use the pluginapi.Configuration.CheckRequiredServerConfiguration method to enforce this.
 	RequiredConfig *Config `json:"required_configuration,omitempty" yaml:"required_configuration,omitempty"`
+
+	// Dependencies are the plugins and products your plugin depends on. Your plugin is activated
+	// after its dependencies, and fails to activate while a required dependency isn't active or
+	// doesn't meet its minimum version.
+	//
+	// Minimum server version: 7.10
+	Dependencies []*ManifestDependency `json:"dependencies,omitempty" yaml:"dependencies,omitempty"`
 }
 
 type ManifestServer struct {
@@ -231,6 +238,35 @@ type ManifestWebapp struct {
 	BundleHash []byte `json:"-"`
 }
 
+type ManifestDependency struct {
+	// The id of the plugin or the name of the product depended on.
+	Id string `json:"id" yaml:"id"`
+
+	// The minimum version of the dependency, if any. Products are versioned as the server.
+	MinVersion string `json:"min_version,omitempty" yaml:"min_version,omitempty"`
+
+	// An optional dependency only orders the activation, your plugin being activated after it
+	// when it's enabled, but without it otherwise.
+	Optional bool `json:"optional,omitempty" yaml:"optional,omitempty"`
+}
+
+// MeetMinVersion checks the version of the dependency against its minimum version.
+func (d *ManifestDependency) MeetMinVersion(version string) (bool, error) {
+	if d.MinVersion == "" {
+		return true, nil
+	}
+
+	minVersion, err := semver.Parse(d.MinVersion)
+	if err != nil {
+		return false, errors.New("failed to parse MinVersion")
+	}
+	v, err := semver.Parse(version)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse the version of %s", d.Id)
+	}
+	return v.GTE(minVersion), nil
+}
+
 func (m *Manifest) HasClient() bool {
 	return m.Webapp != nil
 }
@@ -329,6 +365,26 @@ func (m *Manifest) IsValid() error {
 		}
 	}
 
+	dependencies := make(map[string]bool, len(m.Dependencies))
+	for _, dependency := range m.Dependencies {
+		if dependency == nil || !IsValidPluginId(dependency.Id) {
+			return errors.New("invalid dependency ID")
+		}
+		if dependency.Id == m.Id {
+			return errors.New("a plugin can't depend on itself")
+		}
+		if dependencies[dependency.Id] {
+			return errors.Errorf("duplicate dependency %s", dependency.Id)
+		}
+		dependencies[dependency.Id] = true
+
+		if dependency.MinVersion != "" {
+			if _, err := semver.Parse(dependency.MinVersion); err != nil {
+				return errors.Wrapf(err, "failed to parse MinVersion of dependency %s", dependency.Id)
+			}
+		}
+	}
+
 	if m.SettingsSchema != nil {
 		err := m.SettingsSchema.isValid()
 		if err != nil {
@@ -31,7 +31,12 @@ func TestIsValid(t *testing.T) {
 		{"SettingSchema error", &Manifest{Id: "com.company.test", Name: "some name", HomepageURL: "http://someurl.com", SupportURL: "http://someotherurl.com", Version: "5.10.0", MinServerVersion: "5.10.8", SettingsSchema: &PluginSettingsSchema{
 			Settings: []*PluginSetting{{Type: "Invalid"}},
 		}}, true},
+		{"Invalid dependency id", &Manifest{Id: "com.company.test", Name: "some name", Dependencies: []*ManifestDependency{{Id: "some id"}}}, true},
+		{"Dependency on itself", &Manifest{Id: "com.company.test", Name: "some name", Dependencies: []*ManifestDependency{{Id: "com.company.test"}}}, true},
+		{"Duplicate dependency", &Manifest{Id: "com.company.test", Name: "some name", Dependencies: []*ManifestDependency{{Id: "boards"}, {Id: "boards", Optional: true}}}, true},
+		{"Invalid dependency min version", &Manifest{Id: "com.company.test", Name: "some name", Dependencies: []*ManifestDependency{{Id: "boards", MinVersion: "version"}}}, true},
 		{"Minimal valid manifest", &Manifest{Id: "com.company.test", Name: "some name"}, false},
+		{"Valid dependencies", &Manifest{Id: "com.company.test", Name: "some name", Dependencies: []*ManifestDependency{{Id: "boards", MinVersion: "7.10.0"}, {Id: "com.company.other", Optional: true}}}, false},
 		{"Happy case", &Manifest{
 			Id:               "com.company.test",
 			Name:             "thename",
@@ -809,3 +814,54 @@ func TestManifestMeetMinServerVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestManifestDependencyMeetMinVersion(t *testing.T) {
+	for name, test := range map[string]struct {
+		MinVersion    string
+		Version       string
+		ShouldError   bool
+		ShouldFulfill bool
+	}{
+		"no min version": {
+			Version:       "abc",
+			ShouldFulfill: true,
+		},
+		"fulfilled": {
+			MinVersion:    "1.2.0",
+			Version:       "1.3.0",
+			ShouldFulfill: true,
+		},
+		"exactly fulfilled": {
+			MinVersion:    "1.2.0",
+			Version:       "1.2.0",
+			ShouldFulfill: true,
+		},
+		"not fulfilled": {
+			MinVersion:    "1.2.0",
+			Version:       "1.1.9",
+			ShouldFulfill: false,
+		},
+		"fail to parse MinVersion": {
+			MinVersion:  "abc",
+			Version:     "1.2.0",
+			ShouldError: true,
+		},
+		"fail to parse the version": {
+			MinVersion:  "1.2.0",
+			Version:     "abc",
+			ShouldError: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dependency := ManifestDependency{Id: "com.company.other", MinVersion: test.MinVersion}
+
+			fulfilled, err := dependency.MeetMinVersion(test.Version)
+			if test.ShouldError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.ShouldFulfill, fulfilled)
+		})
+	}
+}
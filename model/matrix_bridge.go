@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	MatrixBridgeBotUsername    = "matrix-bridge"
+	MatrixBridgeBotDisplayName = "Matrix Bridge"
+
+	// PostPropsFromMatrix marks the posts created from Matrix events, which aren't sent back to Matrix.
+	PostPropsFromMatrix = "from_matrix"
+
+	MatrixIdMaxLength = 255
+)
+
+// MatrixRoom is a channel bridged to a Matrix room. Messages, edits, deletions and attachments are
+// synced both ways between them.
+type MatrixRoom struct {
+	ChannelId string `json:"channel_id"`
+	RoomId    string `json:"room_id"`
+	CreatorId string `json:"creator_id"`
+	CreateAt  int64  `json:"create_at"`
+}
+
+func (o *MatrixRoom) IsValid() *AppError {
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("MatrixRoom.IsValid", "model.matrix_room.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidMatrixRoomId(o.RoomId) {
+		return NewAppError("MatrixRoom.IsValid", "model.matrix_room.is_valid.room_id.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("MatrixRoom.IsValid", "model.matrix_room.is_valid.creator_id.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("MatrixRoom.IsValid", "model.matrix_room.is_valid.create_at.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *MatrixRoom) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+func (o *MatrixRoom) Auditable() map[string]any {
+	return map[string]any{
+		"channel_id": o.ChannelId,
+		"room_id":    o.RoomId,
+		"creator_id": o.CreatorId,
+		"create_at":  o.CreateAt,
+	}
+}
+
+// MatrixEvent maps a post to the Matrix event it was bridged from or to, so that edits and
+// deletions can be synced.
+type MatrixEvent struct {
+	PostId   string `json:"post_id"`
+	EventId  string `json:"event_id"`
+	RoomId   string `json:"room_id"`
+	CreateAt int64  `json:"create_at"`
+}
+
+func (o *MatrixEvent) IsValid() *AppError {
+	if !IsValidId(o.PostId) {
+		return NewAppError("MatrixEvent.IsValid", "model.matrix_event.is_valid.post_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.EventId == "" || len(o.EventId) > MatrixIdMaxLength {
+		return NewAppError("MatrixEvent.IsValid", "model.matrix_event.is_valid.event_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if !IsValidMatrixRoomId(o.RoomId) {
+		return NewAppError("MatrixEvent.IsValid", "model.matrix_event.is_valid.room_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *MatrixEvent) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+// IsValidMatrixRoomId reports whether id is a Matrix room id, such as !abc:example.com. Room
+// aliases, which start with #, aren't room ids.
+func IsValidMatrixRoomId(id string) bool {
+	localpart, domain, ok := strings.Cut(id, ":")
+	return ok && len(id) <= MatrixIdMaxLength && len(localpart) > 1 && localpart[0] == '!' && domain != ""
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatrixRoomIsValid(t *testing.T) {
+	room := &MatrixRoom{
+		ChannelId: NewId(),
+		RoomId:    "!abc:example.com",
+		CreatorId: NewId(),
+	}
+	assert.NotNil(t, room.IsValid(), "create at is required")
+
+	room.PreSave()
+	assert.Nil(t, room.IsValid())
+
+	room.RoomId = "#alias:example.com"
+	assert.NotNil(t, room.IsValid())
+
+	room.RoomId = "!abc:example.com"
+	room.ChannelId = "junk"
+	assert.NotNil(t, room.IsValid())
+}
+
+func TestMatrixEventIsValid(t *testing.T) {
+	event := &MatrixEvent{
+		PostId:  NewId(),
+		EventId: "$event",
+		RoomId:  "!abc:example.com",
+	}
+	assert.Nil(t, event.IsValid())
+
+	event.EventId = ""
+	assert.NotNil(t, event.IsValid())
+}
+
+func TestIsValidMatrixRoomId(t *testing.T) {
+	for id, valid := range map[string]bool{
+		"!abc:example.com":      true,
+		"!abc:example.com:8448": true,
+		"#alias:example.com":    false,
+		"!abc":                  false,
+		"!:example.com":         false,
+		"!abc:":                 false,
+		"":                      false,
+	} {
+		assert.Equal(t, valid, IsValidMatrixRoomId(id), id)
+	}
+}
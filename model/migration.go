@@ -40,4 +40,5 @@ const (
 	MigrationKeyAddPlayboosksManageRolesPermissions    = "playbooks_manage_roles"
 	MigrationKeyAddProductsBoardsPermissions           = "products_boards"
 	MigrationKeyAddCustomUserGroupsPermissionRestore   = "custom_groups_permission_restore"
+	MigrationKeyAddManagePlaybooksPermissions          = "manage_playbooks_permissions"
 )
@@ -0,0 +1,148 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// NotificationRuleTypeKeywords matches posts containing any of a group of keywords.
+	NotificationRuleTypeKeywords = "keywords"
+	// NotificationRuleTypeRegex matches posts whose message matches a regular expression.
+	NotificationRuleTypeRegex = "regex"
+	// NotificationRuleTypeSender matches posts from specific users or bots.
+	NotificationRuleTypeSender = "sender"
+	// NotificationRuleTypeAttachments matches posts with file attachments.
+	NotificationRuleTypeAttachments = "attachments"
+
+	NotificationRulesMaxPerUser      = 20
+	NotificationRuleMaxPatternLength = 256
+)
+
+// NotificationRule is a rule evaluated by the server for each post in the channels of the user who
+// defined it, notifying the user as if they had been mentioned when the post matches. The rules of
+// a user are stored as JSON in the PreferenceNameNotificationRules preference.
+type NotificationRule struct {
+	Type      string      `json:"type"`
+	Keywords  []string    `json:"keywords,omitempty"`
+	Pattern   string      `json:"pattern,omitempty"`
+	SenderIds StringArray `json:"sender_ids,omitempty"`
+	// ChannelIds restricts the rule to some channels. The rule applies to all channels when empty.
+	ChannelIds StringArray `json:"channel_ids,omitempty"`
+
+	regex *regexp.Regexp
+}
+
+type NotificationRules []*NotificationRule
+
+func NotificationRulesFromJSON(data string) (NotificationRules, error) {
+	var rules NotificationRules
+	if err := json.Unmarshal([]byte(data), &rules); err != nil {
+		return nil, err
+	}
+	if err := rules.IsValid(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (rules NotificationRules) IsValid() error {
+	if len(rules) > NotificationRulesMaxPerUser {
+		return fmt.Errorf("too many notification rules, the maximum is %d", NotificationRulesMaxPerUser)
+	}
+	for i, rule := range rules {
+		if rule == nil {
+			return fmt.Errorf("notification rule %d is empty", i)
+		}
+		if err := rule.IsValid(); err != nil {
+			return fmt.Errorf("notification rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *NotificationRule) IsValid() error {
+	for _, channelID := range r.ChannelIds {
+		if !IsValidId(channelID) {
+			return fmt.Errorf("invalid channel id %q", channelID)
+		}
+	}
+
+	switch r.Type {
+	case NotificationRuleTypeKeywords:
+		if len(r.Keywords) == 0 {
+			return fmt.Errorf("no keywords")
+		}
+		for _, keyword := range r.Keywords {
+			if strings.TrimSpace(keyword) == "" {
+				return fmt.Errorf("empty keyword")
+			}
+		}
+	case NotificationRuleTypeRegex:
+		if r.Pattern == "" || len(r.Pattern) > NotificationRuleMaxPatternLength {
+			return fmt.Errorf("the pattern must be between 1 and %d characters", NotificationRuleMaxPatternLength)
+		}
+		if _, err := regexp.Compile(r.Pattern); err != nil {
+			return err
+		}
+	case NotificationRuleTypeSender:
+		if len(r.SenderIds) == 0 {
+			return fmt.Errorf("no senders")
+		}
+		for _, senderID := range r.SenderIds {
+			if !IsValidId(senderID) {
+				return fmt.Errorf("invalid sender id %q", senderID)
+			}
+		}
+	case NotificationRuleTypeAttachments:
+	default:
+		return fmt.Errorf("unknown rule type %q", r.Type)
+	}
+	return nil
+}
+
+// Matches tells whether the post matches the rule. The rule must be valid.
+func (r *NotificationRule) Matches(post *Post) bool {
+	if len(r.ChannelIds) > 0 && !r.ChannelIds.Contains(post.ChannelId) {
+		return false
+	}
+
+	switch r.Type {
+	case NotificationRuleTypeKeywords:
+		message := strings.ToLower(post.Message)
+		for _, keyword := range r.Keywords {
+			if strings.Contains(message, strings.ToLower(strings.TrimSpace(keyword))) {
+				return true
+			}
+		}
+	case NotificationRuleTypeRegex:
+		if r.regex == nil {
+			regex, err := regexp.Compile(r.Pattern)
+			if err != nil {
+				return false
+			}
+			r.regex = regex
+		}
+		return r.regex.MatchString(post.Message)
+	case NotificationRuleTypeSender:
+		return r.SenderIds.Contains(post.UserId)
+	case NotificationRuleTypeAttachments:
+		return len(post.FileIds) > 0
+	}
+	return false
+}
+
+// Matches tells whether the post matches any of the rules.
+func (rules NotificationRules) Matches(post *Post) bool {
+	for _, rule := range rules {
+		if rule.Matches(post) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationRulesFromJSON(t *testing.T) {
+	senderID := NewId()
+	rules, err := NotificationRulesFromJSON(`[{"type":"keywords","keywords":["deploy","outage"]},{"type":"sender","sender_ids":["` + senderID + `"]}]`)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, StringArray{senderID}, rules[1].SenderIds)
+
+	for name, value := range map[string]string{
+		"not json":         "garbage",
+		"unknown type":     `[{"type":"unknown"}]`,
+		"no keywords":      `[{"type":"keywords"}]`,
+		"empty keyword":    `[{"type":"keywords","keywords":[" "]}]`,
+		"invalid regex":    `[{"type":"regex","pattern":"(unclosed"}]`,
+		"long regex":       `[{"type":"regex","pattern":"` + strings.Repeat("a", NotificationRuleMaxPatternLength+1) + `"}]`,
+		"invalid sender":   `[{"type":"sender","sender_ids":["bob"]}]`,
+		"invalid channel":  `[{"type":"attachments","channel_ids":["town-square"]}]`,
+		"null rule":        `[null]`,
+		"too many rules":   "[" + strings.Repeat(`{"type":"attachments"},`, NotificationRulesMaxPerUser) + `{"type":"attachments"}]`,
+		"missing sender":   `[{"type":"sender"}]`,
+		"missing pattern":  `[{"type":"regex"}]`,
+		"object not array": `{"type":"attachments"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NotificationRulesFromJSON(value)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNotificationRuleMatches(t *testing.T) {
+	channelID := NewId()
+	senderID := NewId()
+	post := &Post{ChannelId: channelID, UserId: senderID, Message: "The Deploy of build 1234 failed"}
+
+	for name, tc := range map[string]struct {
+		rule     NotificationRule
+		expected bool
+	}{
+		"keyword, case insensitive": {NotificationRule{Type: NotificationRuleTypeKeywords, Keywords: []string{"outage", "deploy"}}, true},
+		"keyword, no match":         {NotificationRule{Type: NotificationRuleTypeKeywords, Keywords: []string{"outage"}}, false},
+		"regex":                     {NotificationRule{Type: NotificationRuleTypeRegex, Pattern: `build \d+ failed`}, true},
+		"regex, no match":           {NotificationRule{Type: NotificationRuleTypeRegex, Pattern: `^build`}, false},
+		"sender":                    {NotificationRule{Type: NotificationRuleTypeSender, SenderIds: StringArray{NewId(), senderID}}, true},
+		"sender, no match":          {NotificationRule{Type: NotificationRuleTypeSender, SenderIds: StringArray{NewId()}}, false},
+		"attachments, none":         {NotificationRule{Type: NotificationRuleTypeAttachments}, false},
+		"other channel":             {NotificationRule{Type: NotificationRuleTypeSender, SenderIds: StringArray{senderID}, ChannelIds: StringArray{NewId()}}, false},
+		"same channel":              {NotificationRule{Type: NotificationRuleTypeSender, SenderIds: StringArray{senderID}, ChannelIds: StringArray{channelID}}, true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.rule.Matches(post))
+		})
+	}
+
+	withFile := &Post{ChannelId: channelID, FileIds: StringArray{NewId()}}
+	assert.True(t, (&NotificationRule{Type: NotificationRuleTypeAttachments}).Matches(withFile))
+	assert.True(t, NotificationRules{{Type: NotificationRuleTypeRegex, Pattern: "x"}, {Type: NotificationRuleTypeAttachments}}.Matches(withFile))
+	assert.False(t, NotificationRules{}.Matches(withFile))
+}
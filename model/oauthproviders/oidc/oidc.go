@@ -0,0 +1,414 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package oauthoidc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
+)
+
+const (
+	discoveryCacheTime = time.Hour
+	requestTimeout     = 30 * time.Second
+)
+
+// OIDCProvider signs users in through a generic OpenID Connect identity provider. The endpoints
+// and the signing keys of the identity provider are read from its discovery document and key set,
+// which are cached.
+type OIDCProvider struct {
+	HTTPClient *http.Client
+
+	mutex sync.Mutex
+	// The settings the provider was last asked for, since the other methods of the OAuthProvider
+	// interface aren't given the config.
+	settings     model.OIDCSettings
+	discovery    *model.OpenIDConfiguration
+	discoveryURL string
+	discoveredAt time.Time
+	keys         map[string]any
+	keysURL      string
+}
+
+// jsonWebKey is a public key of the identity provider, see RFC 7517 and RFC 7518.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func init() {
+	einterfaces.RegisterOAuthProvider(model.ServiceOIDC, &OIDCProvider{
+		HTTPClient: &http.Client{Timeout: requestTimeout},
+	})
+}
+
+func (p *OIDCProvider) GetSSOSettings(config *model.Config, service string) (*model.SSOSettings, error) {
+	settings := config.OIDCSettings
+	discovery, err := p.getDiscovery(*settings.DiscoveryEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	p.settings = settings
+	p.mutex.Unlock()
+
+	sso := settings.SSOSettings()
+	sso.AuthEndpoint = model.NewString(discovery.AuthorizationEndpoint)
+	sso.TokenEndpoint = model.NewString(discovery.TokenEndpoint)
+	sso.UserAPIEndpoint = model.NewString(discovery.UserinfoEndpoint)
+	return sso, nil
+}
+
+// GetUserFromIdToken is GetUserFromIdTokenWithNonce for the ID tokens issued without a nonce.
+func (p *OIDCProvider) GetUserFromIdToken(idToken string) (*model.User, error) {
+	return p.GetUserFromIdTokenWithNonce(idToken, "")
+}
+
+// GetUserFromIdTokenWithNonce validates the signature, issuer, audience, expiry and nonce of the ID
+// token before reading the user out of its claims.
+func (p *OIDCProvider) GetUserFromIdTokenWithNonce(idToken, nonce string) (*model.User, error) {
+	settings, discovery, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (any, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		default:
+			return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+		}
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(discovery.JwksURI, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("the ID token has expired")
+	}
+
+	if issuer, _ := claims["iss"].(string); issuer != discovery.Issuer {
+		return nil, fmt.Errorf("unexpected issuer %q", issuer)
+	}
+
+	if !audienceContains(claims["aud"], *settings.Id) {
+		return nil, errors.New("the ID token wasn't issued for this client")
+	}
+
+	// An ID token replayed from another authorization request doesn't carry its nonce
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return nil, errors.New("the nonce of the ID token doesn't match the authorization request")
+	}
+
+	return userFromClaims(&settings, claims)
+}
+
+// GetUserFromJSON reads the user out of the response of the userinfo endpoint, completed with the
+// claims of the ID token.
+func (p *OIDCProvider) GetUserFromJSON(data io.Reader, tokenUser *model.User) (*model.User, error) {
+	settings, _, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+
+	var claims map[string]any
+	if err = json.NewDecoder(data).Decode(&claims); err != nil {
+		return nil, err
+	}
+
+	user, err := userFromClaims(&settings, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenUser != nil {
+		// Both must be about the same user, see OpenID Connect Core 1.0 section 5.3.2
+		if tokenUser.AuthData == nil || *tokenUser.AuthData != *user.AuthData {
+			return nil, errors.New("the userinfo response and the ID token are about different users")
+		}
+		if user.Username == "" {
+			user.Username = tokenUser.Username
+		}
+		if user.Email == "" {
+			user.Email = tokenUser.Email
+		}
+		if user.FirstName == "" && user.LastName == "" {
+			user.FirstName = tokenUser.FirstName
+			user.LastName = tokenUser.LastName
+		}
+	}
+
+	if user.Email == "" {
+		return nil, errors.New("user e-mail should not be empty")
+	}
+
+	if user.Username == "" {
+		user.Username = model.CleanUsername(strings.Split(user.Email, "@")[0])
+	}
+
+	return user, nil
+}
+
+func (p *OIDCProvider) GetGroupsFromJSON(data io.Reader) ([]string, error) {
+	settings, _, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+
+	groupsClaim := *settings.GroupsClaim
+	if groupsClaim == "" {
+		return nil, nil
+	}
+
+	var claims map[string]any
+	if err = json.NewDecoder(data).Decode(&claims); err != nil {
+		return nil, err
+	}
+
+	groups := []string{}
+	switch value := claims[groupsClaim].(type) {
+	case string:
+		groups = append(groups, value)
+	case []any:
+		for _, item := range value {
+			if name, ok := item.(string); ok && name != "" {
+				groups = append(groups, name)
+			}
+		}
+	}
+	return groups, nil
+}
+
+func (p *OIDCProvider) IsSameUser(dbUser, oauthUser *model.User) bool {
+	return dbUser.AuthData != nil && oauthUser.AuthData != nil && *dbUser.AuthData == *oauthUser.AuthData
+}
+
+// current returns the settings the provider was last asked for along with the discovery document of
+// the identity provider.
+func (p *OIDCProvider) current() (model.OIDCSettings, *model.OpenIDConfiguration, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.discovery == nil || p.settings.Id == nil {
+		return p.settings, nil, errors.New("the provider wasn't configured")
+	}
+	return p.settings, p.discovery, nil
+}
+
+func (p *OIDCProvider) getDiscovery(discoveryURL string) (*model.OpenIDConfiguration, error) {
+	p.mutex.Lock()
+	if p.discovery != nil && p.discoveryURL == discoveryURL && time.Since(p.discoveredAt) < discoveryCacheTime {
+		discovery := p.discovery
+		p.mutex.Unlock()
+		return discovery, nil
+	}
+	p.mutex.Unlock()
+
+	var discovery model.OpenIDConfiguration
+	if err := p.getJSON(discoveryURL, &discovery); err != nil {
+		return nil, err
+	}
+
+	if discovery.Issuer == "" || discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" ||
+		discovery.UserinfoEndpoint == "" || discovery.JwksURI == "" {
+		return nil, errors.New("the discovery document of the identity provider is missing endpoints")
+	}
+
+	p.mutex.Lock()
+	p.discovery = &discovery
+	p.discoveryURL = discoveryURL
+	p.discoveredAt = time.Now()
+	p.mutex.Unlock()
+
+	return &discovery, nil
+}
+
+// getKey returns the public key with the given id out of the key set of the identity provider. The
+// key set is fetched again when the key isn't known, the identity provider having rotated its keys.
+func (p *OIDCProvider) getKey(keysURL, kid string) (any, error) {
+	p.mutex.Lock()
+	key := findKey(p.keys, kid)
+	cached := p.keysURL == keysURL
+	p.mutex.Unlock()
+
+	if key != nil && cached {
+		return key, nil
+	}
+
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(keysURL, &keySet); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]any, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		// Keys of unsupported types are skipped rather than failing the whole key set
+		if publicKey, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = publicKey
+		}
+	}
+
+	p.mutex.Lock()
+	p.keys = keys
+	p.keysURL = keysURL
+	p.mutex.Unlock()
+
+	if key = findKey(keys, kid); key == nil {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+func (p *OIDCProvider) getJSON(url string, v any) error {
+	resp, err := p.HTTPClient.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to %s failed with status %d", url, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// findKey returns the key with the given id, or the only key when the token doesn't name one.
+func findKey(keys map[string]any, kid string) any {
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key
+		}
+	}
+	return keys[kid]
+}
+
+func (jwk *jsonWebKey) publicKey() (any, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("the key isn't on its curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func audienceContains(aud any, clientID string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == clientID
+	case []any:
+		for _, item := range aud {
+			if item == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func userFromClaims(settings *model.OIDCSettings, claims map[string]any) (*model.User, error) {
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return nil, errors.New("the sub claim is missing")
+	}
+
+	user := &model.User{}
+	if username, _ := claims[*settings.UsernameClaim].(string); username != "" {
+		user.Username = model.CleanUsername(username)
+	}
+	if email, _ := claims[*settings.EmailClaim].(string); email != "" {
+		// Identity providers may let their users set any e-mail address, which mustn't be trusted to
+		// sign in to the account holding it before the identity provider verified it
+		if verified, ok := claims["email_verified"]; ok && (verified == false || verified == "false") {
+			return nil, errors.New("the e-mail address of the user isn't verified")
+		}
+		user.Email = strings.ToLower(email)
+	}
+
+	user.FirstName, _ = claims["given_name"].(string)
+	user.LastName, _ = claims["family_name"].(string)
+	if user.FirstName == "" && user.LastName == "" {
+		name, _ := claims["name"].(string)
+		if firstName, lastName, found := strings.Cut(name, " "); found {
+			user.FirstName, user.LastName = firstName, lastName
+		} else {
+			user.FirstName = name
+		}
+	}
+	user.Nickname, _ = claims["nickname"].(string)
+
+	user.AuthData = model.NewString(sub)
+	user.AuthService = model.ServiceOIDC
+
+	return user, nil
+}
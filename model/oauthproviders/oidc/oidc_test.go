@@ -0,0 +1,327 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package oauthoidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+type testIdentityProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+}
+
+func newTestIdentityProvider(t *testing.T) *testIdentityProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	idp := &testIdentityProvider{key: key, kid: "key1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(model.OpenIDConfiguration{
+			Issuer:                idp.server.URL,
+			AuthorizationEndpoint: idp.server.URL + "/authorize",
+			TokenEndpoint:         idp.server.URL + "/token",
+			UserinfoEndpoint:      idp.server.URL + "/userinfo",
+			JwksURI:               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []jsonWebKey{{
+				Kty: "RSA",
+				Kid: idp.kid,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(idp.key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(idp.key.E)).Bytes()),
+			}},
+		})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+func (idp *testIdentityProvider) config() *model.Config {
+	config := &model.Config{}
+	config.SetDefaults()
+	config.OIDCSettings.Enable = model.NewBool(true)
+	config.OIDCSettings.Id = model.NewString("client")
+	config.OIDCSettings.DiscoveryEndpoint = model.NewString(idp.server.URL + "/.well-known/openid-configuration")
+	config.OIDCSettings.GroupsClaim = model.NewString("groups")
+	return config
+}
+
+func (idp *testIdentityProvider) idToken(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = idp.kid
+	signed, err := token.SignedString(idp.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func (idp *testIdentityProvider) validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":                idp.server.URL,
+		"aud":                "client",
+		"sub":                "subject",
+		"exp":                time.Now().Add(time.Minute).Unix(),
+		"preferred_username": "Jane.Doe",
+		"email":              "Jane@example.com",
+		"name":               "Jane Doe",
+	}
+}
+
+func TestGetSSOSettings(t *testing.T) {
+	idp := newTestIdentityProvider(t)
+	provider := &OIDCProvider{HTTPClient: http.DefaultClient}
+
+	sso, err := provider.GetSSOSettings(idp.config(), model.ServiceOIDC)
+	require.NoError(t, err)
+	assert.Equal(t, "client", *sso.Id)
+	assert.Equal(t, idp.server.URL+"/authorize", *sso.AuthEndpoint)
+	assert.Equal(t, idp.server.URL+"/token", *sso.TokenEndpoint)
+	assert.Equal(t, idp.server.URL+"/userinfo", *sso.UserAPIEndpoint)
+
+	t.Run("invalid discovery endpoint", func(t *testing.T) {
+		config := idp.config()
+		config.OIDCSettings.DiscoveryEndpoint = model.NewString(idp.server.URL + "/missing")
+		_, err := provider.GetSSOSettings(config, model.ServiceOIDC)
+		require.Error(t, err)
+	})
+}
+
+func TestGetUserFromIdToken(t *testing.T) {
+	idp := newTestIdentityProvider(t)
+	provider := &OIDCProvider{HTTPClient: http.DefaultClient}
+
+	t.Run("not configured", func(t *testing.T) {
+		_, err := provider.GetUserFromIdToken(idp.idToken(t, idp.validClaims()))
+		require.Error(t, err)
+	})
+
+	_, err := provider.GetSSOSettings(idp.config(), model.ServiceOIDC)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		user, err := provider.GetUserFromIdToken(idp.idToken(t, idp.validClaims()))
+		require.NoError(t, err)
+		assert.Equal(t, "subject", *user.AuthData)
+		assert.Equal(t, model.ServiceOIDC, user.AuthService)
+		assert.Equal(t, "jane.doe", user.Username)
+		assert.Equal(t, "jane@example.com", user.Email)
+		assert.Equal(t, "Jane", user.FirstName)
+		assert.Equal(t, "Doe", user.LastName)
+	})
+
+	t.Run("audience list", func(t *testing.T) {
+		claims := idp.validClaims()
+		claims["aud"] = []string{"other", "client"}
+		_, err := provider.GetUserFromIdToken(idp.idToken(t, claims))
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		claims := idp.validClaims()
+		claims["aud"] = "other"
+		_, err := provider.GetUserFromIdToken(idp.idToken(t, claims))
+		require.Error(t, err)
+	})
+
+	t.Run("wrong issuer", func(t *testing.T) {
+		claims := idp.validClaims()
+		claims["iss"] = "https://example.com"
+		_, err := provider.GetUserFromIdToken(idp.idToken(t, claims))
+		require.Error(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		claims := idp.validClaims()
+		claims["exp"] = time.Now().Add(-time.Minute).Unix()
+		_, err := provider.GetUserFromIdToken(idp.idToken(t, claims))
+		require.Error(t, err)
+	})
+
+	t.Run("missing expiry", func(t *testing.T) {
+		claims := idp.validClaims()
+		delete(claims, "exp")
+		_, err := provider.GetUserFromIdToken(idp.idToken(t, claims))
+		require.Error(t, err)
+	})
+
+	t.Run("signed with another key", func(t *testing.T) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, idp.validClaims())
+		token.Header["kid"] = idp.kid
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+
+		_, err = provider.GetUserFromIdToken(signed)
+		require.Error(t, err)
+	})
+
+	t.Run("symmetric signature", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, idp.validClaims())
+		signed, err := token.SignedString([]byte("secret"))
+		require.NoError(t, err)
+
+		_, err = provider.GetUserFromIdToken(signed)
+		require.Error(t, err)
+	})
+
+	t.Run("nonce", func(t *testing.T) {
+		claims := idp.validClaims()
+		claims["nonce"] = "nonce"
+		_, err := provider.GetUserFromIdTokenWithNonce(idp.idToken(t, claims), "nonce")
+		require.NoError(t, err)
+
+		_, err = provider.GetUserFromIdTokenWithNonce(idp.idToken(t, claims), "other")
+		require.Error(t, err)
+
+		_, err = provider.GetUserFromIdToken(idp.idToken(t, claims))
+		require.Error(t, err)
+	})
+
+	t.Run("missing nonce", func(t *testing.T) {
+		_, err := provider.GetUserFromIdTokenWithNonce(idp.idToken(t, idp.validClaims()), "nonce")
+		require.Error(t, err)
+	})
+
+	t.Run("unverified email", func(t *testing.T) {
+		claims := idp.validClaims()
+		claims["email_verified"] = false
+		_, err := provider.GetUserFromIdToken(idp.idToken(t, claims))
+		require.Error(t, err)
+
+		claims["email_verified"] = true
+		_, err = provider.GetUserFromIdToken(idp.idToken(t, claims))
+		require.NoError(t, err)
+	})
+
+	t.Run("rotated key", func(t *testing.T) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		idp.key = key
+		idp.kid = "key2"
+
+		_, err = provider.GetUserFromIdToken(idp.idToken(t, idp.validClaims()))
+		require.NoError(t, err)
+	})
+}
+
+func TestGetUserFromJSON(t *testing.T) {
+	idp := newTestIdentityProvider(t)
+	provider := &OIDCProvider{HTTPClient: http.DefaultClient}
+	_, err := provider.GetSSOSettings(idp.config(), model.ServiceOIDC)
+	require.NoError(t, err)
+
+	t.Run("userinfo", func(t *testing.T) {
+		user, err := provider.GetUserFromJSON(strings.NewReader(`{"sub": "subject", "preferred_username": "jdoe", "email": "jdoe@example.com", "given_name": "Jane", "family_name": "Doe"}`), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "subject", *user.AuthData)
+		assert.Equal(t, "jdoe", user.Username)
+		assert.Equal(t, "jdoe@example.com", user.Email)
+		assert.Equal(t, "Jane", user.FirstName)
+		assert.Equal(t, "Doe", user.LastName)
+	})
+
+	t.Run("completed with the id token", func(t *testing.T) {
+		tokenUser := &model.User{AuthData: model.NewString("subject"), Email: "jdoe@example.com", Username: "jdoe"}
+		user, err := provider.GetUserFromJSON(strings.NewReader(`{"sub": "subject"}`), tokenUser)
+		require.NoError(t, err)
+		assert.Equal(t, "jdoe", user.Username)
+		assert.Equal(t, "jdoe@example.com", user.Email)
+	})
+
+	t.Run("different user than the id token", func(t *testing.T) {
+		tokenUser := &model.User{AuthData: model.NewString("other")}
+		_, err := provider.GetUserFromJSON(strings.NewReader(`{"sub": "subject", "email": "jdoe@example.com"}`), tokenUser)
+		require.Error(t, err)
+	})
+
+	t.Run("username from email", func(t *testing.T) {
+		user, err := provider.GetUserFromJSON(strings.NewReader(`{"sub": "subject", "email": "jdoe@example.com"}`), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "jdoe", user.Username)
+	})
+
+	t.Run("missing email", func(t *testing.T) {
+		_, err := provider.GetUserFromJSON(strings.NewReader(`{"sub": "subject", "preferred_username": "jdoe"}`), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("unverified email", func(t *testing.T) {
+		_, err := provider.GetUserFromJSON(strings.NewReader(`{"sub": "subject", "email": "jdoe@example.com", "email_verified": false}`), nil)
+		require.Error(t, err)
+
+		_, err = provider.GetUserFromJSON(strings.NewReader(`{"sub": "subject", "email": "jdoe@example.com", "email_verified": "false"}`), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("missing sub", func(t *testing.T) {
+		_, err := provider.GetUserFromJSON(strings.NewReader(`{"email": "jdoe@example.com"}`), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("custom claims", func(t *testing.T) {
+		config := idp.config()
+		config.OIDCSettings.UsernameClaim = model.NewString("uid")
+		config.OIDCSettings.EmailClaim = model.NewString("mail")
+		_, err := provider.GetSSOSettings(config, model.ServiceOIDC)
+		require.NoError(t, err)
+
+		user, err := provider.GetUserFromJSON(strings.NewReader(`{"sub": "subject", "uid": "jdoe", "mail": "jdoe@example.com"}`), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "jdoe", user.Username)
+		assert.Equal(t, "jdoe@example.com", user.Email)
+	})
+}
+
+func TestGetGroupsFromJSON(t *testing.T) {
+	idp := newTestIdentityProvider(t)
+	provider := &OIDCProvider{HTTPClient: http.DefaultClient}
+	_, err := provider.GetSSOSettings(idp.config(), model.ServiceOIDC)
+	require.NoError(t, err)
+
+	groups, err := provider.GetGroupsFromJSON(strings.NewReader(`{"sub": "subject", "groups": ["engineering", "", "design"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"engineering", "design"}, groups)
+
+	groups, err = provider.GetGroupsFromJSON(strings.NewReader(`{"sub": "subject", "groups": "engineering"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"engineering"}, groups)
+
+	groups, err = provider.GetGroupsFromJSON(strings.NewReader(`{"sub": "subject"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, groups)
+
+	t.Run("disabled", func(t *testing.T) {
+		config := idp.config()
+		config.OIDCSettings.GroupsClaim = model.NewString("")
+		_, err := provider.GetSSOSettings(config, model.ServiceOIDC)
+		require.NoError(t, err)
+
+		groups, err := provider.GetGroupsFromJSON(strings.NewReader(`{"sub": "subject", "groups": ["engineering"]}`))
+		require.NoError(t, err)
+		assert.Nil(t, groups)
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	OnlineMigrationStatePending   = "pending"
+	OnlineMigrationStateRunning   = "running"
+	OnlineMigrationStateCompleted = "completed"
+	OnlineMigrationStateFailed    = "failed"
+)
+
+// OnlineMigrationStatus is the status of a schema migration changing a large table online, i.e.
+// without locking it, in the background of the server. Only indexes are changed online, the
+// other migrations not depending on them.
+type OnlineMigrationStatus struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+	Table   string `json:"table"`
+	State   string `json:"state"`
+	StartAt int64  `json:"start_at,omitempty"`
+	EndAt   int64  `json:"end_at,omitempty"`
+	// Progress is the fraction of the migration done, from 0 to 1, as reported by the database
+	// while the migration is running, or -1 when it's unknown.
+	Progress float64 `json:"progress"`
+	// EstimatedSecondsLeft is estimated from the progress made so far, or -1 when it's unknown.
+	EstimatedSecondsLeft int64  `json:"estimated_seconds_left"`
+	Error                string `json:"error,omitempty"`
+}
+
+// MigrationsStatus is the schema version of the database along with the status of its online
+// migrations.
+type MigrationsStatus struct {
+	SchemaVersion    int                      `json:"schema_version"`
+	OnlineMigrations []*OnlineMigrationStatus `json:"online_migrations"`
+}
+
+// EstimateSecondsLeft estimates the time left from the time the migration has been running for,
+// assuming the rest of it progresses at the same pace.
+func (s *OnlineMigrationStatus) EstimateSecondsLeft(elapsedSeconds float64) {
+	s.EstimatedSecondsLeft = -1
+	if s.Progress <= 0 || elapsedSeconds <= 0 {
+		return
+	}
+
+	if s.Progress >= 1 {
+		s.EstimatedSecondsLeft = 0
+		return
+	}
+
+	s.EstimatedSecondsLeft = int64(elapsedSeconds * (1 - s.Progress) / s.Progress)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnlineMigrationStatusEstimateSecondsLeft(t *testing.T) {
+	for name, tc := range map[string]struct {
+		Progress float64
+		Elapsed  float64
+		Expected int64
+	}{
+		"unknown progress": {Progress: -1, Elapsed: 60, Expected: -1},
+		"not started":      {Progress: 0, Elapsed: 60, Expected: -1},
+		"unknown time":     {Progress: 0.5, Elapsed: 0, Expected: -1},
+		"a quarter done":   {Progress: 0.25, Elapsed: 60, Expected: 180},
+		"half done":        {Progress: 0.5, Elapsed: 60, Expected: 60},
+		"done":             {Progress: 1, Elapsed: 60, Expected: 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			status := &OnlineMigrationStatus{Progress: tc.Progress}
+			status.EstimateSecondsLeft(tc.Elapsed)
+			assert.Equal(t, tc.Expected, status.EstimatedSecondsLeft)
+		})
+	}
+}
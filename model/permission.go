@@ -105,6 +105,7 @@ var PermissionReadOtherUsersTeams *Permission
 var PermissionEditBrand *Permission
 var PermissionManageSharedChannels *Permission
 var PermissionManageSecureConnections *Permission
+var PermissionManagePlaybooks *Permission
 var PermissionDownloadComplianceExportResult *Permission
 var PermissionCreateDataRetentionJob *Permission
 var PermissionReadDataRetentionJob *Permission
@@ -755,6 +756,12 @@ func initializePermissions() {
 		"authentication.permissions.manage_secure_connections.description",
 		PermissionScopeSystem,
 	}
+	PermissionManagePlaybooks = &Permission{
+		"manage_playbooks",
+		"authentication.permissions.manage_playbooks.name",
+		"authentication.permissions.manage_playbooks.description",
+		PermissionScopeSystem,
+	}
 
 	PermissionCreateDataRetentionJob = &Permission{
 		"create_data_retention_job",
@@ -2243,6 +2250,7 @@ func initializePermissions() {
 		PermissionEditBrand,
 		PermissionManageSharedChannels,
 		PermissionManageSecureConnections,
+		PermissionManagePlaybooks,
 		PermissionDownloadComplianceExportResult,
 		PermissionCreateDataRetentionJob,
 		PermissionReadDataRetentionJob,
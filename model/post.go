@@ -180,6 +180,8 @@ type SearchParameter struct {
 	PerPage                *int    `json:"per_page"`
 	IncludeDeletedChannels *bool   `json:"include_deleted_channels"`
 	Modifier               *string `json:"modifier"` // whether it's messages or file
+	SavedPostLabelId       *string `json:"saved_post_label_id"`
+	Semantic               *bool   `json:"semantic"` // whether results are also searched by meaning
 }
 
 type AnalyticsPostCountsOptions struct {
@@ -818,3 +820,32 @@ func (o *Post) IsUrgent() bool {
 
 	return *postPriority.Priority == PostPriorityUrgent
 }
+
+// The following are some GraphQL methods necessary to return the
+// data in float64 type. The spec doesn't support 64 bit integers,
+// so we have to pass the data in float64. The _ at the end is
+// a hack to keep the attribute name same in GraphQL schema.
+
+func (o *Post) CreateAt_() float64 {
+	return float64(o.CreateAt)
+}
+
+func (o *Post) UpdateAt_() float64 {
+	return float64(o.UpdateAt)
+}
+
+func (o *Post) EditAt_() float64 {
+	return float64(o.EditAt)
+}
+
+func (o *Post) DeleteAt_() float64 {
+	return float64(o.DeleteAt)
+}
+
+func (o *Post) ReplyCount_() float64 {
+	return float64(o.ReplyCount)
+}
+
+func (o *Post) LastReplyAt_() float64 {
+	return float64(o.LastReplyAt)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const PostEmbeddingModelMaxLength = 128
+
+// PostEmbedding is the vector representing the meaning of the message of a post, as computed by
+// an embedding model. Embeddings are only comparable with the embeddings of the same model.
+type PostEmbedding struct {
+	PostId    string    `json:"post_id"`
+	ChannelId string    `json:"channel_id"`
+	Model     string    `json:"model"`
+	Embedding []float32 `json:"embedding"`
+	CreateAt  int64     `json:"create_at"`
+}
+
+// PostEmbeddingMatch is a post found by a semantic search, with the similarity of its embedding to
+// the embedding of the search terms, between -1 and 1.
+type PostEmbeddingMatch struct {
+	PostId string  `json:"post_id"`
+	Score  float64 `json:"score"`
+}
+
+func (o *PostEmbedding) IsValid() *AppError {
+	if !IsValidId(o.PostId) {
+		return NewAppError("PostEmbedding.IsValid", "model.post_embedding.is_valid.post_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("PostEmbedding.IsValid", "model.post_embedding.is_valid.channel_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if o.Model == "" || len(o.Model) > PostEmbeddingModelMaxLength {
+		return NewAppError("PostEmbedding.IsValid", "model.post_embedding.is_valid.model.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if len(o.Embedding) == 0 {
+		return NewAppError("PostEmbedding.IsValid", "model.post_embedding.is_valid.embedding.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("PostEmbedding.IsValid", "model.post_embedding.is_valid.create_at.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *PostEmbedding) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
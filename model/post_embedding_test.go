@@ -0,0 +1,38 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostEmbeddingIsValid(t *testing.T) {
+	embedding := &PostEmbedding{
+		PostId:    NewId(),
+		ChannelId: NewId(),
+		Model:     "text-embedding-3-small",
+		Embedding: []float32{0.1, 0.2},
+	}
+	assert.NotNil(t, embedding.IsValid(), "create at is required")
+
+	embedding.PreSave()
+	assert.Nil(t, embedding.IsValid())
+
+	embedding.Embedding = nil
+	assert.NotNil(t, embedding.IsValid())
+
+	embedding.Embedding = []float32{0.1, 0.2}
+	embedding.Model = ""
+	assert.NotNil(t, embedding.IsValid())
+
+	embedding.Model = "text-embedding-3-small"
+	embedding.ChannelId = "junk"
+	assert.NotNil(t, embedding.IsValid())
+
+	embedding.ChannelId = NewId()
+	embedding.PostId = "junk"
+	assert.NotNil(t, embedding.IsValid())
+}
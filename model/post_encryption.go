@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"regexp"
+)
+
+const (
+	// PostPropsEncryptedEnvelope carries a message encrypted by the clients. The server stores and
+	// forwards it without being able to read it, so the message of the post is left empty.
+	PostPropsEncryptedEnvelope = "encrypted_envelope"
+
+	// MessageExportEncryptedMessage is exported as the message of the encrypted posts, for all
+	// formats to tell them apart from empty messages. The envelope is exported in the props as is.
+	MessageExportEncryptedMessage = "[Encrypted message]"
+
+	EncryptedEnvelopeMaxKeyIds          = 64
+	EncryptedEnvelopeMaxKeyIdLength     = 128
+	EncryptedEnvelopeMaxAlgorithmLength = 64
+)
+
+var encryptedEnvelopeAlgorithmRegex = regexp.MustCompile(`^[A-Za-z0-9._\-]+$`)
+
+// EncryptedEnvelope is a message encrypted by the clients.
+type EncryptedEnvelope struct {
+	// Algorithm identifies the encryption scheme for the clients, e.g. "x25519-aes256gcm.v1".
+	Algorithm string `json:"algorithm"`
+	// KeyIds are the ids of the keys the message is encrypted with, e.g. one for each recipient.
+	KeyIds []string `json:"key_ids"`
+	// Ciphertext is the encrypted message, base64 encoded.
+	Ciphertext string `json:"ciphertext"`
+}
+
+// IsValid checks the envelope, without being able to check its ciphertext beyond its encoding.
+// The encoded ciphertext can't be longer than maxSize.
+func (e *EncryptedEnvelope) IsValid(maxSize int) *AppError {
+	if len(e.Algorithm) == 0 || len(e.Algorithm) > EncryptedEnvelopeMaxAlgorithmLength || !encryptedEnvelopeAlgorithmRegex.MatchString(e.Algorithm) {
+		return NewAppError("EncryptedEnvelope.IsValid", "model.post.encrypted_envelope.algorithm.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(e.KeyIds) == 0 || len(e.KeyIds) > EncryptedEnvelopeMaxKeyIds {
+		return NewAppError("EncryptedEnvelope.IsValid", "model.post.encrypted_envelope.key_ids.app_error", map[string]any{"Max": EncryptedEnvelopeMaxKeyIds}, "", http.StatusBadRequest)
+	}
+	for _, keyID := range e.KeyIds {
+		if keyID == "" || len(keyID) > EncryptedEnvelopeMaxKeyIdLength {
+			return NewAppError("EncryptedEnvelope.IsValid", "model.post.encrypted_envelope.key_ids.app_error", map[string]any{"Max": EncryptedEnvelopeMaxKeyIds}, "", http.StatusBadRequest)
+		}
+	}
+
+	if e.Ciphertext == "" || len(e.Ciphertext) > maxSize {
+		return NewAppError("EncryptedEnvelope.IsValid", "model.post.encrypted_envelope.ciphertext_size.app_error", map[string]any{"Max": maxSize}, "", http.StatusBadRequest)
+	}
+	if _, err := base64.StdEncoding.DecodeString(e.Ciphertext); err != nil {
+		return NewAppError("EncryptedEnvelope.IsValid", "model.post.encrypted_envelope.ciphertext.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	return nil
+}
+
+// ParseEncryptedEnvelope returns the envelope set in the PostPropsEncryptedEnvelope prop of a post,
+// or nil when the prop isn't set.
+func ParseEncryptedEnvelope(value any) (*EncryptedEnvelope, *AppError) {
+	if value == nil {
+		return nil, nil
+	}
+
+	var envelope EncryptedEnvelope
+	b, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(b, &envelope)
+	}
+	if err != nil {
+		return nil, NewAppError("ParseEncryptedEnvelope", "model.post.encrypted_envelope.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+	return &envelope, nil
+}
+
+// IsEncrypted returns whether the post carries a message encrypted by the clients.
+func (o *Post) IsEncrypted() bool {
+	return o.GetProp(PostPropsEncryptedEnvelope) != nil
+}
+
+// IsEncrypted returns whether the exported post carries a message encrypted by the clients, which
+// is exported as is since the server can't read it.
+func (m *MessageExport) IsEncrypted() bool {
+	props := map[string]any{}
+	if m.PostProps == nil || json.Unmarshal([]byte(*m.PostProps), &props) != nil {
+		return false
+	}
+	return props[PostPropsEncryptedEnvelope] != nil
+}
+
+// SetEncryptedMessage sets MessageExportEncryptedMessage as the message of the exported post when
+// it's encrypted.
+func (m *MessageExport) SetEncryptedMessage() {
+	if m.IsEncrypted() {
+		m.PostMessage = NewString(MessageExportEncryptedMessage)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedEnvelopeIsValid(t *testing.T) {
+	ciphertext := base64.StdEncoding.EncodeToString([]byte("encrypted"))
+	valid := func() *EncryptedEnvelope {
+		return &EncryptedEnvelope{
+			Algorithm:  "x25519-aes256gcm.v1",
+			KeyIds:     []string{"key1", "key2"},
+			Ciphertext: ciphertext,
+		}
+	}
+
+	assert.Nil(t, valid().IsValid(1024))
+
+	for name, tc := range map[string]struct {
+		Modify  func(e *EncryptedEnvelope)
+		ErrorId string
+	}{
+		"no algorithm":       {func(e *EncryptedEnvelope) { e.Algorithm = "" }, "model.post.encrypted_envelope.algorithm.app_error"},
+		"invalid algorithm":  {func(e *EncryptedEnvelope) { e.Algorithm = "aes gcm" }, "model.post.encrypted_envelope.algorithm.app_error"},
+		"too long algorithm": {func(e *EncryptedEnvelope) { e.Algorithm = strings.Repeat("a", EncryptedEnvelopeMaxAlgorithmLength+1) }, "model.post.encrypted_envelope.algorithm.app_error"},
+		"no key ids":         {func(e *EncryptedEnvelope) { e.KeyIds = nil }, "model.post.encrypted_envelope.key_ids.app_error"},
+		"empty key id":       {func(e *EncryptedEnvelope) { e.KeyIds = []string{""} }, "model.post.encrypted_envelope.key_ids.app_error"},
+		"no ciphertext":      {func(e *EncryptedEnvelope) { e.Ciphertext = "" }, "model.post.encrypted_envelope.ciphertext_size.app_error"},
+		"too large":          {func(e *EncryptedEnvelope) { e.Ciphertext = strings.Repeat("A", 1028) }, "model.post.encrypted_envelope.ciphertext_size.app_error"},
+		"not base64":         {func(e *EncryptedEnvelope) { e.Ciphertext = "not base64!" }, "model.post.encrypted_envelope.ciphertext.app_error"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			envelope := valid()
+			tc.Modify(envelope)
+			appErr := envelope.IsValid(1024)
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.ErrorId, appErr.Id)
+		})
+	}
+}
+
+func TestParseEncryptedEnvelope(t *testing.T) {
+	envelope, appErr := ParseEncryptedEnvelope(nil)
+	require.Nil(t, appErr)
+	assert.Nil(t, envelope)
+
+	var props StringInterface
+	require.NoError(t, json.Unmarshal([]byte(`{"encrypted_envelope":{"algorithm":"alg","key_ids":["key"],"ciphertext":"YQ=="}}`), &props))
+	envelope, appErr = ParseEncryptedEnvelope(props[PostPropsEncryptedEnvelope])
+	require.Nil(t, appErr)
+	assert.Equal(t, &EncryptedEnvelope{Algorithm: "alg", KeyIds: []string{"key"}, Ciphertext: "YQ=="}, envelope)
+
+	_, appErr = ParseEncryptedEnvelope("ciphertext")
+	require.NotNil(t, appErr)
+}
+
+func TestMessageExportIsEncrypted(t *testing.T) {
+	export := &MessageExport{}
+	assert.False(t, export.IsEncrypted())
+
+	props := `{"encrypted_envelope":{"algorithm":"alg","key_ids":["key"],"ciphertext":"YQ=="}}`
+	export.PostProps = &props
+	assert.True(t, export.IsEncrypted())
+
+	props = `{}`
+	assert.False(t, export.IsEncrypted())
+}
+
+func TestMessageExportSetEncryptedMessage(t *testing.T) {
+	message := "hello"
+	export := &MessageExport{PostMessage: &message}
+	export.SetEncryptedMessage()
+	assert.Equal(t, "hello", *export.PostMessage)
+
+	message = ""
+	props := `{"encrypted_envelope":{"algorithm":"alg","key_ids":["key"],"ciphertext":"YQ=="}}`
+	export.PostProps = &props
+	export.SetEncryptedMessage()
+	assert.Equal(t, MessageExportEncryptedMessage, *export.PostMessage)
+	assert.Equal(t, props, *export.PostProps)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+)
+
+const (
+	// PostPropsExpireAfter is the number of seconds after which a post being created expires.
+	PostPropsExpireAfter = "expire_after"
+	// PostPropsExpireAt is the time in milliseconds at which an expiring post is deleted. It's set
+	// by the server when the post is created, and kept when the post is deleted for clients to
+	// show a placeholder instead of the expired post.
+	PostPropsExpireAt = "expire_at"
+
+	PostExpireAfterMinSeconds = 60
+)
+
+// PostExpiration is when a post expires, for the posts created with PostPropsExpireAfter.
+type PostExpiration struct {
+	PostId    string
+	ChannelId string
+	ExpireAt  int64
+}
+
+// ParsePostExpireAfter returns the number of seconds after which the post expires, or 0 when it
+// doesn't expire. The number of seconds must be between PostExpireAfterMinSeconds and max.
+func ParsePostExpireAfter(value any, max int) (int64, *AppError) {
+	var seconds float64
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		seconds = v
+	case int:
+		seconds = float64(v)
+	case int64:
+		seconds = float64(v)
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return 0, NewAppError("ParsePostExpireAfter", "model.post.expire_after.app_error", map[string]any{"Min": PostExpireAfterMinSeconds, "Max": max}, "", http.StatusBadRequest).Wrap(err)
+		}
+		seconds = f
+	default:
+		return 0, NewAppError("ParsePostExpireAfter", "model.post.expire_after.app_error", map[string]any{"Min": PostExpireAfterMinSeconds, "Max": max}, "", http.StatusBadRequest)
+	}
+
+	if seconds != math.Trunc(seconds) || seconds < PostExpireAfterMinSeconds || seconds > float64(max) {
+		return 0, NewAppError("ParsePostExpireAfter", "model.post.expire_after.app_error", map[string]any{"Min": PostExpireAfterMinSeconds, "Max": max}, "", http.StatusBadRequest)
+	}
+	return int64(seconds), nil
+}
+
+// GetExpireAt returns the time at which the post expires, or 0 when it doesn't.
+func (o *Post) GetExpireAt() int64 {
+	return propToMillis(o.GetProp(PostPropsExpireAt))
+}
+
+// IsExpired returns whether the post was deleted because it expired.
+func (o *Post) IsExpired() bool {
+	expireAt := o.GetExpireAt()
+	return expireAt != 0 && o.DeleteAt != 0 && o.DeleteAt >= expireAt
+}
+
+// ExpireAt returns the time at which the exported post expires, or 0 when it doesn't. Expired posts
+// are exported deleted, with their message.
+func (m *MessageExport) ExpireAt() int64 {
+	props := map[string]any{}
+	if m.PostProps == nil || json.Unmarshal([]byte(*m.PostProps), &props) != nil {
+		return 0
+	}
+	return propToMillis(props[PostPropsExpireAt])
+}
+
+func propToMillis(value any) int64 {
+	switch v := value.(type) {
+	case float64:
+		return int64(v)
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case json.Number:
+		millis, _ := v.Int64()
+		return millis
+	default:
+		return 0
+	}
+}
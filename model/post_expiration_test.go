@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePostExpireAfter(t *testing.T) {
+	for name, tc := range map[string]struct {
+		Value    any
+		Expected int64
+		Valid    bool
+	}{
+		"not set":        {Value: nil, Expected: 0, Valid: true},
+		"from JSON":      {Value: float64(3600), Expected: 3600, Valid: true},
+		"int":            {Value: 60, Expected: 60, Valid: true},
+		"json number":    {Value: json.Number("120"), Expected: 120, Valid: true},
+		"maximum":        {Value: 86400, Expected: 86400, Valid: true},
+		"too short":      {Value: 59, Valid: false},
+		"too long":       {Value: 86401, Valid: false},
+		"fraction":       {Value: 60.5, Valid: false},
+		"string":         {Value: "3600", Valid: false},
+		"invalid number": {Value: json.Number("abc"), Valid: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			seconds, appErr := ParsePostExpireAfter(tc.Value, 86400)
+			if !tc.Valid {
+				require.NotNil(t, appErr)
+				return
+			}
+			require.Nil(t, appErr)
+			assert.Equal(t, tc.Expected, seconds)
+		})
+	}
+}
+
+func TestPostIsExpired(t *testing.T) {
+	post := &Post{}
+	assert.Equal(t, int64(0), post.GetExpireAt())
+	assert.False(t, post.IsExpired())
+
+	post.AddProp(PostPropsExpireAt, float64(1000))
+	assert.Equal(t, int64(1000), post.GetExpireAt())
+	assert.False(t, post.IsExpired())
+
+	post.DeleteAt = 999
+	assert.False(t, post.IsExpired())
+
+	post.DeleteAt = 1001
+	assert.True(t, post.IsExpired())
+}
+
+func TestMessageExportExpireAt(t *testing.T) {
+	export := &MessageExport{}
+	assert.Equal(t, int64(0), export.ExpireAt())
+
+	props := `{"expire_at":1234}`
+	export.PostProps = &props
+	assert.Equal(t, int64(1234), export.ExpireAt())
+
+	props = `{}`
+	assert.Equal(t, int64(0), export.ExpireAt())
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	// PostPropsRestrictedTo restricts who can see a post within its channel to its author, the
+	// listed users and the members of the listed groups.
+	PostPropsRestrictedTo = "restricted_to"
+
+	PostRestrictionMaxIds = 100
+)
+
+// PostRestriction is who a restricted post is visible to, in addition to its author.
+type PostRestriction struct {
+	UserIds  []string `json:"user_ids"`
+	GroupIds []string `json:"group_ids"`
+}
+
+func (r *PostRestriction) IsValid() *AppError {
+	if len(r.UserIds) == 0 && len(r.GroupIds) == 0 {
+		return NewAppError("PostRestriction.IsValid", "model.post.restricted_to.empty.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(r.UserIds)+len(r.GroupIds) > PostRestrictionMaxIds {
+		return NewAppError("PostRestriction.IsValid", "model.post.restricted_to.too_many.app_error", map[string]any{"Max": PostRestrictionMaxIds}, "", http.StatusBadRequest)
+	}
+
+	for _, id := range append(append([]string{}, r.UserIds...), r.GroupIds...) {
+		if !IsValidId(id) {
+			return NewAppError("PostRestriction.IsValid", "model.post.restricted_to.invalid_id.app_error", nil, "id="+id, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// HasUser returns whether the user is listed in the restriction. Members of the listed groups
+// aren't.
+func (r *PostRestriction) HasUser(userID string) bool {
+	for _, id := range r.UserIds {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// ParsePostRestriction returns the restriction set in the PostPropsRestrictedTo prop of a post, or
+// nil when the prop isn't set.
+func ParsePostRestriction(value any) (*PostRestriction, *AppError) {
+	if value == nil {
+		return nil, nil
+	}
+
+	var restriction PostRestriction
+	b, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(b, &restriction)
+	}
+	if err != nil {
+		return nil, NewAppError("ParsePostRestriction", "model.post.restricted_to.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	restriction.UserIds = RemoveDuplicateStrings(restriction.UserIds)
+	restriction.GroupIds = RemoveDuplicateStrings(restriction.GroupIds)
+	return &restriction, nil
+}
+
+// GetRestriction returns who the post is restricted to, or nil when it's visible to all the
+// members of its channel. A restriction that can't be read restricts the post to its author.
+func (o *Post) GetRestriction() *PostRestriction {
+	restriction, appErr := ParsePostRestriction(o.GetProp(PostPropsRestrictedTo))
+	if appErr != nil {
+		return &PostRestriction{}
+	}
+	return restriction
+}
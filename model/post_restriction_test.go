@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePostRestriction(t *testing.T) {
+	userID := NewId()
+	groupID := NewId()
+
+	t.Run("not set", func(t *testing.T) {
+		restriction, appErr := ParsePostRestriction(nil)
+		require.Nil(t, appErr)
+		assert.Nil(t, restriction)
+	})
+
+	t.Run("from JSON", func(t *testing.T) {
+		var props StringInterface
+		require.NoError(t, json.Unmarshal([]byte(`{"restricted_to":{"user_ids":["`+userID+`","`+userID+`"],"group_ids":["`+groupID+`"]}}`), &props))
+
+		restriction, appErr := ParsePostRestriction(props[PostPropsRestrictedTo])
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{userID}, restriction.UserIds)
+		assert.Equal(t, []string{groupID}, restriction.GroupIds)
+		assert.True(t, restriction.HasUser(userID))
+		assert.False(t, restriction.HasUser(groupID))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, appErr := ParsePostRestriction("everyone")
+		require.NotNil(t, appErr)
+	})
+}
+
+func TestPostRestrictionIsValid(t *testing.T) {
+	assert.NotNil(t, (&PostRestriction{}).IsValid())
+	assert.NotNil(t, (&PostRestriction{UserIds: []string{"invalid"}}).IsValid())
+	assert.Nil(t, (&PostRestriction{UserIds: []string{NewId()}}).IsValid())
+	assert.Nil(t, (&PostRestriction{GroupIds: []string{NewId()}}).IsValid())
+
+	tooMany := &PostRestriction{}
+	for i := 0; i <= PostRestrictionMaxIds; i++ {
+		tooMany.UserIds = append(tooMany.UserIds, NewId())
+	}
+	assert.NotNil(t, tooMany.IsValid())
+}
+
+func TestPostGetRestriction(t *testing.T) {
+	post := &Post{}
+	assert.Nil(t, post.GetRestriction())
+
+	userID := NewId()
+	post.AddProp(PostPropsRestrictedTo, &PostRestriction{UserIds: []string{userID}})
+	assert.Equal(t, []string{userID}, post.GetRestriction().UserIds)
+
+	// a restriction that can't be read hides the post from everyone but its author
+	post.AddProp(PostPropsRestrictedTo, "everyone")
+	restriction := post.GetRestriction()
+	require.NotNil(t, restriction)
+	assert.Empty(t, restriction.UserIds)
+	assert.Empty(t, restriction.GroupIds)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+
+	"golang.org/x/text/language"
+)
+
+const PostTranslationLanguageMaxLength = 16
+
+// PostTranslation is the message of a post translated into another language. Translations are
+// cached per post and language, and are only valid for the revision of the post they were made
+// from, as identified by PostEditAt.
+type PostTranslation struct {
+	PostId         string `json:"post_id"`
+	Language       string `json:"language"`
+	SourceLanguage string `json:"source_language"`
+	Message        string `json:"message"`
+	Provider       string `json:"provider"`
+	PostEditAt     int64  `json:"post_edit_at"`
+	CreateAt       int64  `json:"create_at"`
+}
+
+func (o *PostTranslation) IsValid() *AppError {
+	if !IsValidId(o.PostId) {
+		return NewAppError("PostTranslation.IsValid", "model.post_translation.is_valid.post_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if !IsValidTranslationLanguage(o.Language) {
+		return NewAppError("PostTranslation.IsValid", "model.post_translation.is_valid.language.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if len(o.SourceLanguage) > PostTranslationLanguageMaxLength {
+		return NewAppError("PostTranslation.IsValid", "model.post_translation.is_valid.source_language.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("PostTranslation.IsValid", "model.post_translation.is_valid.create_at.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *PostTranslation) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+// IsValidTranslationLanguage reports whether lang is a BCP 47 language tag that messages can be translated into.
+func IsValidTranslationLanguage(lang string) bool {
+	if lang == "" || len(lang) > PostTranslationLanguageMaxLength {
+		return false
+	}
+
+	_, err := language.Parse(lang)
+	return err == nil
+}
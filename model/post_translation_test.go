@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostTranslationIsValid(t *testing.T) {
+	translation := &PostTranslation{
+		PostId:   NewId(),
+		Language: "fr",
+		Message:  "bonjour",
+	}
+	assert.NotNil(t, translation.IsValid(), "create at is required")
+
+	translation.PreSave()
+	assert.Nil(t, translation.IsValid())
+
+	translation.Language = "zh-Hant"
+	assert.Nil(t, translation.IsValid())
+
+	translation.Language = ""
+	assert.NotNil(t, translation.IsValid())
+
+	translation.Language = "not a language"
+	assert.NotNil(t, translation.IsValid())
+
+	translation.Language = "fr"
+	translation.PostId = "junk"
+	assert.NotNil(t, translation.IsValid())
+}
+
+func TestIsValidTranslationLanguage(t *testing.T) {
+	for lang, valid := range map[string]bool{
+		"en":                 true,
+		"pt-BR":              true,
+		"zh-Hans":            true,
+		"":                   false,
+		"english":            false,
+		"en-US-x-very-long1": false,
+	} {
+		assert.Equal(t, valid, IsValidTranslationLanguage(lang), lang)
+	}
+}
@@ -21,6 +21,11 @@ const (
 	PreferenceCategorySidebarSettings   = "sidebar_settings"
 	PreferenceCategoryInsights          = "insights"
 
+	// PreferenceCategorySavedPostLabel holds the labels of a user's saved posts, named after their id.
+	PreferenceCategorySavedPostLabel = "saved_post_label"
+	// PreferenceCategorySavedPostLabels holds the label ids of each saved post, named after its id.
+	PreferenceCategorySavedPostLabels = "saved_post_labels"
+
 	PreferenceCategoryDisplaySettings     = "display_settings"
 	PreferenceNameCollapsedThreadsEnabled = "collapsed_reply_threads"
 	PreferenceNameChannelDisplayMode      = "channel_display_mode"
@@ -32,6 +37,8 @@ const (
 	PreferenceNameUseMilitaryTime         = "use_military_time"
 	PreferenceRecommendedNextSteps        = "recommended_next_steps"
 	PreferenceNameInsights                = "insights_tutorial_state"
+	PreferenceNameTranslationLanguage     = "translation_language"
+	PreferenceNameShareReadReceipts       = "share_read_receipts"
 
 	// initial onboarding preferences
 	PreferenceOnboarding = "onboarding"
@@ -42,6 +49,9 @@ const (
 	PreferenceCategoryAuthorizedOAuthApp = "oauth_app"
 	// the name for oauth_app is the client_id and value is the current scope
 
+	PreferenceCategoryDirectWebhookConsent = "direct_webhook_consent"
+	// the name for direct_webhook_consent is the bot user id and value is "true" while consent is given
+
 	PreferenceCategoryLast    = "last"
 	PreferenceNameLastChannel = "channel"
 	PreferenceNameLastTeam    = "team"
@@ -49,11 +59,19 @@ const (
 	PreferenceCategoryCustomStatus          = "custom_status"
 	PreferenceNameRecentCustomStatuses      = "recent_custom_statuses"
 	PreferenceNameCustomStatusTutorialState = "custom_status_tutorial_state"
+	// the value of calendar_status_sync is a CalendarStatusSync encoded as JSON
+	PreferenceNameCalendarStatusSync = "calendar_status_sync"
 
 	PreferenceCustomStatusModalViewed = "custom_status_modal_viewed"
 
 	PreferenceCategoryNotifications = "notifications"
 	PreferenceNameEmailInterval     = "email_interval"
+	// the value of email_digest_schedule is an EmailDigestSchedule encoded as JSON
+	PreferenceNameEmailDigestSchedule = "email_digest_schedule"
+	// the value of notification_rules is a list of NotificationRule encoded as JSON
+	PreferenceNameNotificationRules = "notification_rules"
+	// the value of quiet_hours is a QuietHours encoded as JSON
+	PreferenceNameQuietHours = "quiet_hours"
 
 	PreferenceEmailIntervalNoBatchingSeconds = "30"  // the "immediate" setting is actually 30s
 	PreferenceEmailIntervalBatchingSeconds   = "900" // fifteen minutes is 900 seconds
@@ -98,6 +116,42 @@ func (o *Preference) IsValid() *AppError {
 		}
 	}
 
+	if o.Category == PreferenceCategoryNotifications && o.Name == PreferenceNameEmailDigestSchedule {
+		if _, err := EmailDigestScheduleFromJSON(o.Value); err != nil {
+			return NewAppError("Preference.IsValid", "model.preference.is_valid.email_digest_schedule.app_error", nil, "value="+o.Value, http.StatusBadRequest).Wrap(err)
+		}
+	}
+
+	if o.Category == PreferenceCategoryNotifications && o.Name == PreferenceNameNotificationRules {
+		if _, err := NotificationRulesFromJSON(o.Value); err != nil {
+			return NewAppError("Preference.IsValid", "model.preference.is_valid.notification_rules.app_error", nil, "value="+o.Value, http.StatusBadRequest).Wrap(err)
+		}
+	}
+
+	if o.Category == PreferenceCategoryNotifications && o.Name == PreferenceNameQuietHours {
+		if _, err := QuietHoursFromJSON(o.Value); err != nil {
+			return NewAppError("Preference.IsValid", "model.preference.is_valid.quiet_hours.app_error", nil, "value="+o.Value, http.StatusBadRequest).Wrap(err)
+		}
+	}
+
+	if o.Category == PreferenceCategorySavedPostLabel {
+		if label, err := SavedPostLabelFromJSON(o.Value); err != nil || label.Id != o.Name {
+			return NewAppError("Preference.IsValid", "model.preference.is_valid.saved_post_label.app_error", nil, "value="+o.Value, http.StatusBadRequest).Wrap(err)
+		}
+	}
+
+	if o.Category == PreferenceCategorySavedPostLabels {
+		if _, err := SavedPostLabelIdsFromJSON(o.Value); err != nil {
+			return NewAppError("Preference.IsValid", "model.preference.is_valid.saved_post_labels.app_error", nil, "value="+o.Value, http.StatusBadRequest).Wrap(err)
+		}
+	}
+
+	if o.Category == PreferenceCategoryCustomStatus && o.Name == PreferenceNameCalendarStatusSync {
+		if _, err := CalendarStatusSyncFromJSON(o.Value); err != nil {
+			return NewAppError("Preference.IsValid", "model.preference.is_valid.calendar_status_sync.app_error", nil, "value="+o.Value, http.StatusBadRequest).Wrap(err)
+		}
+	}
+
 	return nil
 }
 
@@ -34,6 +34,33 @@ const (
 	PushReceived    = "Received by device"
 )
 
+const (
+	PushNotificationReceiptStatusSent     = "sent"
+	PushNotificationReceiptStatusFailed   = "failed"
+	PushNotificationReceiptStatusRemoved  = "removed"
+	PushNotificationReceiptStatusReceived = "received"
+
+	// PushNotificationReceiptsPerUser is the number of receipts kept for each user.
+	PushNotificationReceiptsPerUser = 20
+)
+
+// PushNotificationReceipt records what happened to a push notification sent to a device, for
+// troubleshooting notifications which didn't arrive.
+type PushNotificationReceipt struct {
+	AckId     string `json:"ack_id"`
+	Type      string `json:"type"`
+	Platform  string `json:"platform"`
+	PostId    string `json:"post_id,omitempty"`
+	ChannelId string `json:"channel_id,omitempty"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	SentAt    int64  `json:"sent_at"`
+	// Duration is the time in milliseconds the push proxy took to respond.
+	Duration int64 `json:"duration"`
+	// ReceivedAt is set once the device acknowledged the notification.
+	ReceivedAt int64 `json:"received_at,omitempty"`
+}
+
 type PushNotificationAck struct {
 	Id               string `json:"id"`
 	ClientReceivedAt int64  `json:"received_at"`
@@ -67,10 +94,19 @@ type PushNotification struct {
 	Version          string `json:"version,omitempty"`
 	IsCRTEnabled     bool   `json:"is_crt_enabled"`
 	IsIdLoaded       bool   `json:"is_id_loaded"`
+
+	// Fields holds the additional fields of a PushNotificationTemplate.
+	Fields map[string]string `json:"fields,omitempty"`
 }
 
 func (pn *PushNotification) DeepCopy() *PushNotification {
 	copy := *pn
+	if pn.Fields != nil {
+		copy.Fields = make(map[string]string, len(pn.Fields))
+		for k, v := range pn.Fields {
+			copy.Fields[k] = v
+		}
+	}
 	return &copy
 }
 
@@ -0,0 +1,126 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/i18n"
+)
+
+// The categories of push notifications which can have their payload customized.
+const (
+	PushNotificationCategoryMention       = "mention"
+	PushNotificationCategoryDirectMessage = "direct_message"
+	PushNotificationCategoryThreadReply   = "thread_reply"
+	PushNotificationCategoryReminder      = "reminder"
+
+	PushNotificationTemplateMaxLength = 1000
+	PushNotificationTemplateMaxFields = 10
+)
+
+var pushNotificationCategories = map[string]bool{
+	PushNotificationCategoryMention:       true,
+	PushNotificationCategoryDirectMessage: true,
+	PushNotificationCategoryThreadReply:   true,
+	PushNotificationCategoryReminder:      true,
+}
+
+// PushNotificationTemplate customizes the payload of the push notifications of a category. Title,
+// Body and the values of Fields are Go text/templates rendered with a PushNotificationTemplateData.
+// The "t" function translates a string ID into the language of the recipient. An empty Title or
+// Body keeps the default contents.
+type PushNotificationTemplate struct {
+	Title  string            `json:"title"`
+	Body   string            `json:"body"`
+	Fields map[string]string `json:"fields"`
+}
+
+// PushNotificationTemplateData is the data available to the push notification templates. It never
+// contains more than what the PushNotificationContents setting allows to be sent.
+type PushNotificationTemplateData struct {
+	Category    string
+	SenderName  string
+	ChannelName string
+	ChannelType ChannelType
+	// Message is only set if the full contents of the posts can be sent.
+	Message string
+	// Title and Body are the contents the notification has without a template.
+	Title string
+	Body  string
+}
+
+func IsValidPushNotificationCategory(category string) bool {
+	return pushNotificationCategories[category]
+}
+
+// IsValid checks that all the templates can be parsed.
+func (t *PushNotificationTemplate) IsValid() bool {
+	if len(t.Fields) > PushNotificationTemplateMaxFields {
+		return false
+	}
+
+	texts := []string{t.Title, t.Body}
+	for key, value := range t.Fields {
+		if key == "" {
+			return false
+		}
+		texts = append(texts, value)
+	}
+
+	for _, text := range texts {
+		if len(text) > PushNotificationTemplateMaxLength {
+			return false
+		}
+		if _, err := parsePushNotificationTemplate(text, nil); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Render renders the templates, using tr to translate the strings they reference.
+func (t *PushNotificationTemplate) Render(data *PushNotificationTemplateData, tr i18n.TranslateFunc) (title, body string, fields map[string]string, err error) {
+	if title, err = renderPushNotificationTemplate(t.Title, data, tr); err != nil {
+		return "", "", nil, err
+	}
+	if body, err = renderPushNotificationTemplate(t.Body, data, tr); err != nil {
+		return "", "", nil, err
+	}
+
+	if len(t.Fields) > 0 {
+		fields = make(map[string]string, len(t.Fields))
+		for key, value := range t.Fields {
+			if fields[key], err = renderPushNotificationTemplate(value, data, tr); err != nil {
+				return "", "", nil, err
+			}
+		}
+	}
+	return title, body, fields, nil
+}
+
+func parsePushNotificationTemplate(text string, tr i18n.TranslateFunc) (*template.Template, error) {
+	if tr == nil {
+		tr = func(translationID string, args ...any) string { return translationID }
+	}
+	return template.New("push").Funcs(template.FuncMap{"t": tr}).Parse(text)
+}
+
+func renderPushNotificationTemplate(text string, data *PushNotificationTemplateData, tr i18n.TranslateFunc) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	tmpl, err := parsePushNotificationTemplate(text, tr)
+	if err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out.String()), nil
+}
@@ -4,8 +4,10 @@
 package model
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,3 +57,58 @@ func TestPushNotificationDeviceId(t *testing.T) {
 	msg.Platform = ""
 	msg.DeviceId = ""
 }
+
+func TestPushNotificationTemplate(t *testing.T) {
+	tr := func(translationID string, args ...any) string { return "translated " + translationID }
+
+	t.Run("IsValid", func(t *testing.T) {
+		assert.True(t, (&PushNotificationTemplate{}).IsValid())
+		assert.True(t, (&PushNotificationTemplate{Title: "{{.ChannelName}}", Fields: map[string]string{"a": "{{.Category}}"}}).IsValid())
+		assert.False(t, (&PushNotificationTemplate{Body: "{{.Message"}).IsValid())
+		assert.False(t, (&PushNotificationTemplate{Fields: map[string]string{"": "x"}}).IsValid())
+		assert.False(t, (&PushNotificationTemplate{Title: strings.Repeat("a", PushNotificationTemplateMaxLength+1)}).IsValid())
+	})
+
+	t.Run("Render", func(t *testing.T) {
+		tmpl := &PushNotificationTemplate{
+			Title:  "{{.Title}} ({{.SenderName}})",
+			Body:   `{{t "some.id"}}: {{.Message}}`,
+			Fields: map[string]string{"kind": "{{.Category}}"},
+		}
+		data := &PushNotificationTemplateData{
+			Category:   PushNotificationCategoryDirectMessage,
+			SenderName: "sender",
+			Title:      "channel",
+			Message:    "hi",
+		}
+
+		title, body, fields, err := tmpl.Render(data, tr)
+		require.NoError(t, err)
+		assert.Equal(t, "channel (sender)", title)
+		assert.Equal(t, "translated some.id: hi", body)
+		assert.Equal(t, map[string]string{"kind": PushNotificationCategoryDirectMessage}, fields)
+	})
+
+	t.Run("empty templates render empty", func(t *testing.T) {
+		title, body, fields, err := (&PushNotificationTemplate{}).Render(&PushNotificationTemplateData{}, tr)
+		require.NoError(t, err)
+		assert.Empty(t, title)
+		assert.Empty(t, body)
+		assert.Nil(t, fields)
+	})
+}
+
+func TestConfigPushNotificationTemplates(t *testing.T) {
+	c := Config{}
+	c.SetDefaults()
+	require.Nil(t, c.EmailSettings.isValid())
+
+	c.EmailSettings.PushNotificationTemplates = map[string]*PushNotificationTemplate{"unknown": {}}
+	require.NotNil(t, c.EmailSettings.isValid())
+
+	c.EmailSettings.PushNotificationTemplates = map[string]*PushNotificationTemplate{PushNotificationCategoryMention: {Title: "{{"}}
+	require.NotNil(t, c.EmailSettings.isValid())
+
+	c.EmailSettings.PushNotificationTemplates = map[string]*PushNotificationTemplate{PushNotificationCategoryMention: {Title: "{{.ChannelName}}"}}
+	require.Nil(t, c.EmailSettings.isValid())
+}
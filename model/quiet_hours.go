@@ -0,0 +1,114 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const quietHoursTimeLayout = "15:04"
+
+// QuietHours is a recurring period during which the server holds back the push and email
+// notifications of a user, sending them a summary once it ends. A user's quiet hours are stored as
+// JSON in the PreferenceNameQuietHours preference.
+type QuietHours struct {
+	// Start and End are times of the day formatted as HH:MM. Quiet hours ending before they start
+	// end on the next day.
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// Weekdays are the days of the week the quiet hours start on. They start every day when empty.
+	Weekdays []time.Weekday `json:"weekdays,omitempty"`
+	// Timezone is the IANA timezone of the schedule. The user's own timezone is used when empty.
+	Timezone string `json:"timezone,omitempty"`
+}
+
+func QuietHoursFromJSON(data string) (*QuietHours, error) {
+	var quietHours QuietHours
+	if err := json.Unmarshal([]byte(data), &quietHours); err != nil {
+		return nil, err
+	}
+	if err := quietHours.IsValid(); err != nil {
+		return nil, err
+	}
+	return &quietHours, nil
+}
+
+func (q *QuietHours) IsValid() error {
+	start, err := time.Parse(quietHoursTimeLayout, q.Start)
+	if err != nil {
+		return fmt.Errorf("invalid start %q", q.Start)
+	}
+	end, err := time.Parse(quietHoursTimeLayout, q.End)
+	if err != nil {
+		return fmt.Errorf("invalid end %q", q.End)
+	}
+	if start.Equal(end) {
+		return fmt.Errorf("quiet hours can't start and end at the same time")
+	}
+
+	for _, weekday := range q.Weekdays {
+		if weekday < time.Sunday || weekday > time.Saturday {
+			return fmt.Errorf("invalid weekday %d", weekday)
+		}
+	}
+
+	if _, err := time.LoadLocation(q.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q", q.Timezone)
+	}
+	return nil
+}
+
+// Location returns the timezone of the schedule, or fallback if it doesn't have one.
+func (q *QuietHours) Location(fallback *time.Location) *time.Location {
+	if q.Timezone != "" {
+		if loc, err := time.LoadLocation(q.Timezone); err == nil {
+			return loc
+		}
+	}
+	if fallback == nil {
+		return time.UTC
+	}
+	return fallback
+}
+
+// ActiveUntil tells whether the given time is in quiet hours and if so, when they end. The quiet
+// hours must be valid.
+func (q *QuietHours) ActiveUntil(t time.Time, loc *time.Location) (time.Time, bool) {
+	start, _ := time.Parse(quietHoursTimeLayout, q.Start)
+	end, _ := time.Parse(quietHoursTimeLayout, q.End)
+
+	local := t.In(loc)
+	// quiet hours going past midnight may have started the day before
+	for _, days := range []int{0, -1} {
+		day := time.Date(local.Year(), local.Month(), local.Day()+days, 0, 0, 0, 0, loc)
+		if !q.startsOn(day.Weekday()) {
+			continue
+		}
+
+		periodStart := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, loc)
+		periodEnd := time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), 0, 0, loc)
+		if !periodEnd.After(periodStart) {
+			periodEnd = periodEnd.AddDate(0, 0, 1)
+		}
+
+		if !t.Before(periodStart) && t.Before(periodEnd) {
+			return periodEnd, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (q *QuietHours) startsOn(weekday time.Weekday) bool {
+	if len(q.Weekdays) == 0 {
+		return true
+	}
+	for _, w := range q.Weekdays {
+		if w == weekday {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuietHoursFromJSON(t *testing.T) {
+	quietHours, err := QuietHoursFromJSON(`{"start":"22:00","end":"07:30","weekdays":[1,2],"timezone":"Europe/Paris"}`)
+	require.NoError(t, err)
+	assert.Equal(t, &QuietHours{Start: "22:00", End: "07:30", Weekdays: []time.Weekday{time.Monday, time.Tuesday}, Timezone: "Europe/Paris"}, quietHours)
+
+	for _, value := range []string{
+		"garbage",
+		`{"start":"22:00"}`,
+		`{"start":"25:00","end":"07:00"}`,
+		`{"start":"07:00","end":"07:00"}`,
+		`{"start":"22:00","end":"07:00","weekdays":[7]}`,
+		`{"start":"22:00","end":"07:00","timezone":"Mars/Olympus_Mons"}`,
+	} {
+		_, err := QuietHoursFromJSON(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestQuietHoursActiveUntil(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	at := func(day, hour, minute int) time.Time {
+		// March 2023 starts on a Wednesday
+		return time.Date(2023, time.March, day, hour, minute, 0, 0, loc)
+	}
+
+	overnight := &QuietHours{Start: "22:00", End: "07:00"}
+	daytime := &QuietHours{Start: "12:00", End: "14:00", Weekdays: []time.Weekday{time.Wednesday}}
+	mondayNights := &QuietHours{Start: "22:00", End: "07:00", Weekdays: []time.Weekday{time.Monday}}
+
+	for name, tc := range map[string]struct {
+		quietHours *QuietHours
+		t          time.Time
+		end        time.Time
+		active     bool
+	}{
+		"overnight, before":         {overnight, at(1, 21, 59), time.Time{}, false},
+		"overnight, evening":        {overnight, at(1, 22, 0), at(2, 7, 0), true},
+		"overnight, morning":        {overnight, at(2, 6, 59), at(2, 7, 0), true},
+		"overnight, after":          {overnight, at(2, 7, 0), time.Time{}, false},
+		"daytime":                   {daytime, at(1, 13, 0), at(1, 14, 0), true},
+		"daytime, other day":        {daytime, at(2, 13, 0), time.Time{}, false},
+		"weekday, started monday":   {mondayNights, at(7, 3, 0), at(7, 7, 0), true},
+		"weekday, started tuesday":  {mondayNights, at(8, 3, 0), time.Time{}, false},
+		"weekday, monday evening":   {mondayNights, at(6, 23, 0), at(7, 7, 0), true},
+		"weekday, tuesday evening":  {mondayNights, at(7, 23, 0), time.Time{}, false},
+		"evaluated in the timezone": {overnight, at(1, 23, 0).UTC(), at(2, 7, 0), true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			end, active := tc.quietHours.ActiveUntil(tc.t, loc)
+			assert.Equal(t, tc.active, active)
+			assert.True(t, tc.end.Equal(end), "expected %v, got %v", tc.end, end)
+		})
+	}
+
+	assert.Equal(t, loc, (&QuietHours{Timezone: "Europe/Paris"}).Location(time.UTC))
+	assert.Equal(t, loc, (&QuietHours{}).Location(loc))
+	assert.Equal(t, time.UTC, (&QuietHours{}).Location(nil))
+}
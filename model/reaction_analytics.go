@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import "net/http"
+
+const (
+	ReactionAnalyticsDefaultLimit = 10
+	ReactionAnalyticsMaxLimit     = 100
+
+	// ReactionSummaryBackfillDays is how many days of reactions are summarized the first time the
+	// reaction summary job runs.
+	ReactionSummaryBackfillDays = 30
+
+	dayInMillis = 24 * 60 * 60 * 1000
+)
+
+// ReactionAnalyticsOptions scopes the reaction analytics to a team or a channel, and to the days
+// between Since and Until. Reactions are summarized per UTC day, so both ends of the range are
+// rounded to the day they fall in.
+type ReactionAnalyticsOptions struct {
+	TeamId    string
+	ChannelId string
+	Since     int64
+	Until     int64
+	Limit     int
+}
+
+func (o *ReactionAnalyticsOptions) IsValid() *AppError {
+	if o.TeamId != "" && !IsValidId(o.TeamId) {
+		return NewAppError("ReactionAnalyticsOptions.IsValid", "model.reaction_analytics.is_valid.team_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.ChannelId != "" && !IsValidId(o.ChannelId) {
+		return NewAppError("ReactionAnalyticsOptions.IsValid", "model.reaction_analytics.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.Since < 0 || o.Until < o.Since {
+		return NewAppError("ReactionAnalyticsOptions.IsValid", "model.reaction_analytics.is_valid.time_range.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.Limit < 1 || o.Limit > ReactionAnalyticsMaxLimit {
+		return NewAppError("ReactionAnalyticsOptions.IsValid", "model.reaction_analytics.is_valid.limit.app_error", map[string]any{"Max": ReactionAnalyticsMaxLimit}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+type ReactionEmojiCount struct {
+	EmojiName string `json:"emoji_name"`
+	Count     int64  `json:"count"`
+}
+
+type ReactionPostCount struct {
+	PostId    string `json:"post_id"`
+	ChannelId string `json:"channel_id"`
+	Count     int64  `json:"count"`
+}
+
+// ReactionAnalytics summarizes the reactions added over a range of days.
+type ReactionAnalytics struct {
+	TopEmojis []*ReactionEmojiCount `json:"top_emojis"`
+	TopPosts  []*ReactionPostCount  `json:"top_posts"`
+	// The start of the most recent day reactions have been summarized for, or 0 if the reaction
+	// summary job hasn't run yet.
+	SummarizedDay int64 `json:"summarized_day"`
+}
+
+// ReactionSummaryDay returns the start of the UTC day the given time falls in.
+func ReactionSummaryDay(millis int64) int64 {
+	return millis - millis%dayInMillis
+}
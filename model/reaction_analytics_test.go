@@ -0,0 +1,33 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReactionAnalyticsOptionsIsValid(t *testing.T) {
+	opts := &ReactionAnalyticsOptions{Until: GetMillis(), Limit: ReactionAnalyticsDefaultLimit}
+	assert.Nil(t, opts.IsValid())
+
+	opts.TeamId = "junk"
+	assert.NotNil(t, opts.IsValid())
+	opts.TeamId = NewId()
+
+	opts.Since = opts.Until + 1
+	assert.NotNil(t, opts.IsValid())
+	opts.Since = 0
+
+	opts.Limit = ReactionAnalyticsMaxLimit + 1
+	assert.NotNil(t, opts.IsValid())
+}
+
+func TestReactionSummaryDay(t *testing.T) {
+	day := time.Date(2023, time.March, 14, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, GetMillisForTime(day), ReactionSummaryDay(GetMillisForTime(day)))
+	assert.Equal(t, GetMillisForTime(day), ReactionSummaryDay(GetMillisForTime(day.Add(23*time.Hour+59*time.Minute))))
+}
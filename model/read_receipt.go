@@ -0,0 +1,45 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import "net/http"
+
+// ReadReceipt records the most recent post a user has read in a channel. Every post of the
+// channel created up to PostCreateAt is considered read by the user.
+type ReadReceipt struct {
+	UserId       string `json:"user_id"`
+	ChannelId    string `json:"channel_id"`
+	PostId       string `json:"post_id"`
+	PostCreateAt int64  `json:"post_create_at"`
+	ReadAt       int64  `json:"read_at"`
+}
+
+func (o *ReadReceipt) IsValid() *AppError {
+	if !IsValidId(o.UserId) {
+		return NewAppError("ReadReceipt.IsValid", "model.read_receipt.is_valid.user_id.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("ReadReceipt.IsValid", "model.read_receipt.is_valid.channel_id.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.PostId) {
+		return NewAppError("ReadReceipt.IsValid", "model.read_receipt.is_valid.post_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if o.PostCreateAt == 0 {
+		return NewAppError("ReadReceipt.IsValid", "model.read_receipt.is_valid.post_create_at.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if o.ReadAt == 0 {
+		return NewAppError("ReadReceipt.IsValid", "model.read_receipt.is_valid.read_at.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// HasRead reports whether the receipt covers the given post.
+func (o *ReadReceipt) HasRead(post *Post) bool {
+	return o.ChannelId == post.ChannelId && o.PostCreateAt >= post.CreateAt
+}
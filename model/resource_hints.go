@@ -0,0 +1,17 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// ChannelResourceHints lists the resources a client needs to render the latest posts of a
+// channel, so that it can fetch them in batches when switching to the channel instead of one
+// request per post.
+type ChannelResourceHints struct {
+	ChannelId string `json:"channel_id"`
+	// UserIds are the authors of the latest posts, whose profiles and pictures are needed.
+	UserIds []string `json:"user_ids"`
+	// Emojis are the custom emojis used in the messages and reactions of the latest posts.
+	Emojis []*Emoji `json:"emojis"`
+	// LinkPreviewURLs are the links the latest posts show a preview for.
+	LinkPreviewURLs []string `json:"link_preview_urls"`
+}
@@ -15,6 +15,10 @@ var SystemUserManagerDefaultPermissions []string
 var SystemReadOnlyAdminDefaultPermissions []string
 var SystemCustomGroupAdminDefaultPermissions []string
 
+// AdminRoleCapabilities maps the capability sets custom admin roles can be created with to the
+// permissions they grant.
+var AdminRoleCapabilities map[string][]*Permission
+
 var BuiltInSchemeManagedRoleIDs []string
 
 var NewSystemRoleIDs []string
@@ -352,6 +356,49 @@ func init() {
 		PermissionManageCustomGroupMembers.Id,
 	}
 
+	AdminRoleCapabilities = map[string][]*Permission{
+		AdminRoleCapabilityUserManagement: {
+			PermissionSysconsoleReadUserManagementUsers,
+			PermissionSysconsoleWriteUserManagementUsers,
+			PermissionEditOtherUsers,
+			PermissionAddUserToTeam,
+			PermissionRemoveUserFromTeam,
+			PermissionManageTeamRoles,
+		},
+		AdminRoleCapabilityIntegrations: {
+			PermissionSysconsoleReadIntegrationsIntegrationManagement,
+			PermissionSysconsoleWriteIntegrationsIntegrationManagement,
+			PermissionManageIncomingWebhooks,
+			PermissionManageOthersIncomingWebhooks,
+			PermissionManageOutgoingWebhooks,
+			PermissionManageOthersOutgoingWebhooks,
+			PermissionManageSlashCommands,
+			PermissionManageOthersSlashCommands,
+			PermissionManageOAuth,
+			PermissionManageBots,
+			PermissionManageOthersBots,
+		},
+		AdminRoleCapabilityPlaybooks: {
+			PermissionManagePlaybooks,
+			PermissionPublicPlaybookCreate,
+			PermissionPublicPlaybookManageProperties,
+			PermissionPublicPlaybookManageMembers,
+			PermissionPublicPlaybookManageRoles,
+			PermissionPublicPlaybookView,
+			PermissionPublicPlaybookMakePrivate,
+			PermissionPrivatePlaybookCreate,
+			PermissionPrivatePlaybookManageProperties,
+			PermissionPrivatePlaybookManageMembers,
+			PermissionPrivatePlaybookManageRoles,
+			PermissionPrivatePlaybookView,
+			PermissionPrivatePlaybookMakePublic,
+			PermissionRunCreate,
+			PermissionRunManageProperties,
+			PermissionRunManageMembers,
+			PermissionRunView,
+		},
+	}
+
 	// Add the ancillary permissions to each system role
 	SystemUserManagerDefaultPermissions = AddAncillaryPermissions(SystemUserManagerDefaultPermissions)
 	SystemReadOnlyAdminDefaultPermissions = AddAncillaryPermissions(SystemReadOnlyAdminDefaultPermissions)
@@ -403,6 +450,10 @@ const (
 	RoleTypeGuest RoleType = "Guest"
 	RoleTypeUser  RoleType = "User"
 	RoleTypeAdmin RoleType = "Admin"
+
+	AdminRoleCapabilityUserManagement = "user_management"
+	AdminRoleCapabilityIntegrations   = "integrations"
+	AdminRoleCapabilityPlaybooks      = "playbooks"
 )
 
 type Role struct {
@@ -416,6 +467,8 @@ type Role struct {
 	Permissions   []string `json:"permissions"`
 	SchemeManaged bool     `json:"scheme_managed"`
 	BuiltIn       bool     `json:"built_in"`
+	// TeamIds restricts the permissions of a custom admin role to the given teams.
+	TeamIds []string `json:"team_ids"`
 }
 
 func (r *Role) Auditable() map[string]interface{} {
@@ -430,17 +483,66 @@ func (r *Role) Auditable() map[string]interface{} {
 		"permissions":    r.Permissions,
 		"scheme_managed": r.SchemeManaged,
 		"built_in":       r.BuiltIn,
+		"team_ids":       r.TeamIds,
 	}
 }
 
 type RolePatch struct {
 	Permissions *[]string `json:"permissions"`
+	TeamIds     *[]string `json:"team_ids"`
 }
 
 func (r *RolePatch) Auditable() map[string]interface{} {
 	return map[string]interface{}{
 		"permissions": r.Permissions,
+		"team_ids":    r.TeamIds,
+	}
+}
+
+// CustomAdminRole describes a delegated administration role, granting the permissions of
+// the given capability sets on top of the explicitly listed ones, optionally restricted
+// to a set of teams.
+type CustomAdminRole struct {
+	Name         string   `json:"name"`
+	DisplayName  string   `json:"display_name"`
+	Description  string   `json:"description"`
+	Capabilities []string `json:"capabilities"`
+	Permissions  []string `json:"permissions"`
+	TeamIds      []string `json:"team_ids"`
+}
+
+func (r *CustomAdminRole) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"name":         r.Name,
+		"display_name": r.DisplayName,
+		"capabilities": r.Capabilities,
+		"permissions":  r.Permissions,
+		"team_ids":     r.TeamIds,
+	}
+}
+
+// ToRole expands the capability sets of the custom admin role into a role. It returns false
+// if one of the capabilities is unknown.
+func (r *CustomAdminRole) ToRole() (*Role, bool) {
+	permissions := make([]string, 0, len(r.Permissions))
+	permissions = append(permissions, r.Permissions...)
+	for _, capability := range r.Capabilities {
+		capabilityPermissions, ok := AdminRoleCapabilities[capability]
+		if !ok {
+			return nil, false
+		}
+		for _, permission := range capabilityPermissions {
+			permissions = append(permissions, permission.Id)
+		}
 	}
+
+	return &Role{
+		Name:        r.Name,
+		DisplayName: r.DisplayName,
+		Description: r.Description,
+		Permissions: RemoveDuplicateStrings(AddAncillaryPermissions(permissions)),
+		TeamIds:     r.TeamIds,
+	}, true
 }
 
 type RolePermissions struct {
@@ -452,6 +554,36 @@ func (r *Role) Patch(patch *RolePatch) {
 	if patch.Permissions != nil {
 		r.Permissions = *patch.Permissions
 	}
+	if patch.TeamIds != nil {
+		r.TeamIds = *patch.TeamIds
+	}
+}
+
+// IsCustomAdminRole returns true for the delegated administration roles created by system admins,
+// that is the roles neither built in nor managed by a scheme.
+func (r *Role) IsCustomAdminRole() bool {
+	return !r.BuiltIn && !r.SchemeManaged
+}
+
+// AppliesToTeams returns true if the permissions of the role may be granted in all of the given
+// teams. Roles that aren't restricted to teams apply everywhere, while the ones restricted to
+// teams never apply outside of a team.
+func (r *Role) AppliesToTeams(teamIDs []string) bool {
+	if len(r.TeamIds) == 0 {
+		return true
+	}
+	if len(teamIDs) == 0 {
+		return false
+	}
+
+	roleTeamIDs := asStringBoolMap(r.TeamIds)
+	for _, teamID := range teamIDs {
+		if !roleTeamIDs[teamID] {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (r *Role) CreateAt_() float64 {
@@ -701,6 +833,16 @@ func (r *Role) IsValidWithoutId() bool {
 		}
 	}
 
+	if len(r.TeamIds) > 0 && !r.IsCustomAdminRole() {
+		return false
+	}
+
+	for _, teamID := range r.TeamIds {
+		if !IsValidId(teamID) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -0,0 +1,78 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"sort"
+)
+
+// RoleSnapshot is the permission set a role had before one of its changes. Roles can be reverted
+// to any of their snapshots.
+type RoleSnapshot struct {
+	Id          string   `json:"id"`
+	RoleId      string   `json:"role_id"`
+	Permissions []string `json:"permissions"`
+	CreateAt    int64    `json:"create_at"`
+}
+
+func (o *RoleSnapshot) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          o.Id,
+		"role_id":     o.RoleId,
+		"permissions": o.Permissions,
+		"create_at":   o.CreateAt,
+	}
+}
+
+func (o *RoleSnapshot) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("RoleSnapshot.IsValid", "model.role_snapshot.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.RoleId) {
+		return NewAppError("RoleSnapshot.IsValid", "model.role_snapshot.is_valid.role_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("RoleSnapshot.IsValid", "model.role_snapshot.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *RoleSnapshot) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+// PermissionsDiff returns the sorted permissions added to and removed from a permission set.
+func PermissionsDiff(before []string, after []string) (added []string, removed []string) {
+	beforeMap := asStringBoolMap(before)
+	afterMap := asStringBoolMap(after)
+
+	added = []string{}
+	for permission := range afterMap {
+		if !beforeMap[permission] {
+			added = append(added, permission)
+		}
+	}
+
+	removed = []string{}
+	for permission := range beforeMap {
+		if !afterMap[permission] {
+			removed = append(removed, permission)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	return added, removed
+}
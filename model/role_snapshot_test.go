@@ -0,0 +1,40 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleSnapshotIsValid(t *testing.T) {
+	snapshot := &RoleSnapshot{
+		RoleId:      NewId(),
+		Permissions: []string{PermissionCreatePost.Id},
+	}
+	snapshot.PreSave()
+	require.Nil(t, snapshot.IsValid())
+
+	snapshot.RoleId = "invalid"
+	require.NotNil(t, snapshot.IsValid())
+	snapshot.RoleId = NewId()
+
+	snapshot.CreateAt = 0
+	require.NotNil(t, snapshot.IsValid())
+}
+
+func TestPermissionsDiff(t *testing.T) {
+	added, removed := PermissionsDiff(
+		[]string{"create_post", "delete_post", "edit_post"},
+		[]string{"edit_post", "create_post", "manage_system", "add_reaction"},
+	)
+	assert.Equal(t, []string{"add_reaction", "manage_system"}, added)
+	assert.Equal(t, []string{"delete_post"}, removed)
+
+	added, removed = PermissionsDiff(nil, nil)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestChannelModeratedPermissionsChangedByPatch(t *testing.T) {
@@ -276,3 +277,66 @@ func TestGetChannelModeratedPermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestRoleAppliesToTeams(t *testing.T) {
+	teamID1 := NewId()
+	teamID2 := NewId()
+
+	role := &Role{}
+	assert.True(t, role.AppliesToTeams(nil))
+	assert.True(t, role.AppliesToTeams([]string{teamID1}))
+
+	role.TeamIds = []string{teamID1}
+	assert.False(t, role.AppliesToTeams(nil))
+	assert.True(t, role.AppliesToTeams([]string{teamID1}))
+	assert.False(t, role.AppliesToTeams([]string{teamID2}))
+	assert.False(t, role.AppliesToTeams([]string{teamID1, teamID2}))
+}
+
+func TestRoleIsValidWithTeamIds(t *testing.T) {
+	role := &Role{
+		Name:        "custom_admin",
+		DisplayName: "Custom admin",
+		Permissions: []string{PermissionAddUserToTeam.Id},
+		TeamIds:     []string{NewId()},
+	}
+	assert.True(t, role.IsValidWithoutId())
+
+	role.TeamIds = []string{"invalid"}
+	assert.False(t, role.IsValidWithoutId())
+
+	role.TeamIds = []string{NewId()}
+	role.SchemeManaged = true
+	assert.False(t, role.IsValidWithoutId())
+}
+
+func TestCustomAdminRoleToRole(t *testing.T) {
+	t.Run("expands the capabilities", func(t *testing.T) {
+		customRole := &CustomAdminRole{
+			Name:         "integrations_admin",
+			DisplayName:  "Integrations admin",
+			Capabilities: []string{AdminRoleCapabilityIntegrations},
+			Permissions:  []string{PermissionManageIncomingWebhooks.Id, PermissionReadJobs.Id},
+			TeamIds:      []string{NewId()},
+		}
+
+		role, ok := customRole.ToRole()
+		require.True(t, ok)
+		assert.Equal(t, customRole.Name, role.Name)
+		assert.Equal(t, customRole.TeamIds, role.TeamIds)
+		assert.True(t, role.IsCustomAdminRole())
+		for _, permission := range AdminRoleCapabilities[AdminRoleCapabilityIntegrations] {
+			assert.Contains(t, role.Permissions, permission.Id)
+		}
+		assert.Contains(t, role.Permissions, PermissionReadJobs.Id)
+
+		// Ancillary permissions of the system console sections are included, without duplicates
+		assert.Contains(t, role.Permissions, PermissionManageOAuth.Id)
+		assert.Len(t, role.Permissions, len(RemoveDuplicateStrings(append([]string{}, role.Permissions...))))
+	})
+
+	t.Run("unknown capability", func(t *testing.T) {
+		_, ok := (&CustomAdminRole{Capabilities: []string{"unknown"}}).ToRole()
+		assert.False(t, ok)
+	})
+}
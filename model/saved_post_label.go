@@ -0,0 +1,82 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	SavedPostLabelNameMaxRunes = 64
+	SavedPostLabelsMaxPerUser  = 100
+	SavedPostLabelsMaxPerPost  = 20
+)
+
+// SavedPostLabel is a label, or folder, a user files their saved posts under. The labels of a user
+// are stored in the PreferenceCategorySavedPostLabel preferences, named after their id, and the
+// labels of each saved post in the PreferenceCategorySavedPostLabels preferences, named after the
+// post id.
+type SavedPostLabel struct {
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	CreateAt int64  `json:"create_at"`
+}
+
+func (o *SavedPostLabel) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+	o.Name = strings.TrimSpace(o.Name)
+}
+
+func (o *SavedPostLabel) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("SavedPostLabel.IsValid", "model.saved_post_label.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if strings.TrimSpace(o.Name) == "" || utf8.RuneCountInString(o.Name) > SavedPostLabelNameMaxRunes {
+		return NewAppError("SavedPostLabel.IsValid", "model.saved_post_label.is_valid.name.app_error", map[string]any{"Max": SavedPostLabelNameMaxRunes}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("SavedPostLabel.IsValid", "model.saved_post_label.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func SavedPostLabelFromJSON(data string) (*SavedPostLabel, error) {
+	var label SavedPostLabel
+	if err := json.Unmarshal([]byte(data), &label); err != nil {
+		return nil, err
+	}
+	if err := label.IsValid(); err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+// SavedPostLabelIdsFromJSON decodes the ids of the labels of a saved post.
+func SavedPostLabelIdsFromJSON(data string) (StringArray, error) {
+	var labelIDs StringArray
+	if err := json.Unmarshal([]byte(data), &labelIDs); err != nil {
+		return nil, err
+	}
+	if len(labelIDs) > SavedPostLabelsMaxPerPost {
+		return nil, fmt.Errorf("a post can't have more than %d labels", SavedPostLabelsMaxPerPost)
+	}
+	for _, labelID := range labelIDs {
+		if !IsValidId(labelID) {
+			return nil, fmt.Errorf("invalid label id %q", labelID)
+		}
+	}
+	return labelIDs, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedPostLabelIsValid(t *testing.T) {
+	label := &SavedPostLabel{Name: "  Follow up  "}
+	label.PreSave()
+	require.Nil(t, label.IsValid())
+	assert.Equal(t, "Follow up", label.Name)
+
+	label.Name = " "
+	require.NotNil(t, label.IsValid())
+	label.Name = strings.Repeat("a", SavedPostLabelNameMaxRunes+1)
+	require.NotNil(t, label.IsValid())
+	label.Name = "Follow up"
+
+	label.Id = "junk"
+	require.NotNil(t, label.IsValid())
+}
+
+func TestSavedPostLabelPreferences(t *testing.T) {
+	label := &SavedPostLabel{Name: "Follow up"}
+	label.PreSave()
+
+	preference := Preference{
+		UserId:   NewId(),
+		Category: PreferenceCategorySavedPostLabel,
+		Name:     label.Id,
+		Value:    `{"id":"` + label.Id + `","name":"Follow up","create_at":1}`,
+	}
+	require.Nil(t, preference.IsValid())
+
+	preference.Name = NewId()
+	require.NotNil(t, preference.IsValid(), "the preference must be named after the label")
+
+	preference = Preference{
+		UserId:   NewId(),
+		Category: PreferenceCategorySavedPostLabels,
+		Name:     NewId(),
+		Value:    `["` + label.Id + `"]`,
+	}
+	require.Nil(t, preference.IsValid())
+
+	preference.Value = `["junk"]`
+	require.NotNil(t, preference.IsValid())
+
+	ids := make([]string, SavedPostLabelsMaxPerPost+1)
+	for i := range ids {
+		ids[i] = `"` + NewId() + `"`
+	}
+	preference.Value = "[" + strings.Join(ids, ",") + "]"
+	require.NotNil(t, preference.IsValid())
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	ScheduledPostErrorChannelArchived = "channel_archived"
+	ScheduledPostErrorNoChannelMember = "no_channel_member"
+	ScheduledPostErrorUserDeleted     = "user_deleted"
+	ScheduledPostErrorUnknown         = "unknown"
+
+	// ScheduledPostMaxPerUser caps the number of pending scheduled posts a single user may hold.
+	ScheduledPostMaxPerUser = 100
+)
+
+// ScheduledPost is a message the user has asked to be posted on their behalf at
+// ScheduledAt. Once the delivery job has handled it, the scheduled post is
+// deleted on success, or kept with ProcessedAt and ErrorCode set on failure so
+// that the user can see what went wrong.
+type ScheduledPost struct {
+	Id          string `json:"id"`
+	CreateAt    int64  `json:"create_at"`
+	UpdateAt    int64  `json:"update_at"`
+	UserId      string `json:"user_id"`
+	ChannelId   string `json:"channel_id"`
+	RootId      string `json:"root_id"`
+	Message     string `json:"message"`
+	ScheduledAt int64  `json:"scheduled_at"`
+	ProcessedAt int64  `json:"processed_at"`
+	ErrorCode   string `json:"error_code,omitempty"`
+
+	propsMu sync.RWMutex    `db:"-"`       // Unexported mutex used to guard ScheduledPost.Props.
+	Props   StringInterface `json:"props"` // Deprecated: use GetProps()
+	FileIds StringArray     `json:"file_ids,omitempty"`
+}
+
+func (o *ScheduledPost) Auditable() map[string]any {
+	return map[string]any{
+		"id":           o.Id,
+		"create_at":    o.CreateAt,
+		"update_at":    o.UpdateAt,
+		"user_id":      o.UserId,
+		"channel_id":   o.ChannelId,
+		"root_id":      o.RootId,
+		"scheduled_at": o.ScheduledAt,
+		"processed_at": o.ProcessedAt,
+		"error_code":   o.ErrorCode,
+		"file_ids":     o.FileIds,
+	}
+}
+
+func (o *ScheduledPost) IsValid(maxMessageSize int) *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.UserId) {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.user_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.channel_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !(IsValidId(o.RootId) || o.RootId == "") {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.root_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.ScheduledAt == 0 {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.scheduled_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.Message == "" && len(o.FileIds) == 0 {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.empty.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.Message) > maxMessageSize {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.msg.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(ArrayToJSON(o.FileIds)) > PostFileidsMaxRunes {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.file_ids.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(StringInterfaceToJSON(o.GetProps())) > PostPropsMaxRunes {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.props.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *ScheduledPost) SetProps(props StringInterface) {
+	o.propsMu.Lock()
+	defer o.propsMu.Unlock()
+	o.Props = props
+}
+
+func (o *ScheduledPost) GetProps() StringInterface {
+	o.propsMu.RLock()
+	defer o.propsMu.RUnlock()
+	return o.Props
+}
+
+func (o *ScheduledPost) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+
+	o.UpdateAt = o.CreateAt
+	o.PreCommit()
+}
+
+func (o *ScheduledPost) PreCommit() {
+	if o.GetProps() == nil {
+		o.SetProps(make(map[string]any))
+	}
+
+	if o.FileIds == nil {
+		o.FileIds = []string{}
+	}
+
+	o.FileIds = RemoveDuplicateStrings(o.FileIds)
+}
+
+func (o *ScheduledPost) PreUpdate() {
+	o.UpdateAt = GetMillis()
+	o.PreCommit()
+}
+
+// IsPending reports whether the scheduled post is still waiting to be delivered.
+func (o *ScheduledPost) IsPending() bool {
+	return o.ProcessedAt == 0
+}
+
+// ToPost builds the post that will be created when the scheduled post is delivered.
+func (o *ScheduledPost) ToPost() *Post {
+	post := &Post{
+		UserId:    o.UserId,
+		ChannelId: o.ChannelId,
+		RootId:    o.RootId,
+		Message:   o.Message,
+		FileIds:   o.FileIds,
+	}
+	props := make(StringInterface, len(o.GetProps()))
+	for key, value := range o.GetProps() {
+		props[key] = value
+	}
+	post.SetProps(props)
+	return post
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledPostIsValid(t *testing.T) {
+	newScheduledPost := func() *ScheduledPost {
+		scheduledPost := &ScheduledPost{
+			UserId:      NewId(),
+			ChannelId:   NewId(),
+			Message:     "message",
+			ScheduledAt: GetMillis() + 60000,
+		}
+		scheduledPost.PreSave()
+		return scheduledPost
+	}
+	require.Nil(t, newScheduledPost().IsValid(PostMessageMaxRunesV2))
+
+	t.Run("missing scheduled at", func(t *testing.T) {
+		invalid := newScheduledPost()
+		invalid.ScheduledAt = 0
+		appErr := invalid.IsValid(PostMessageMaxRunesV2)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.scheduled_post.is_valid.scheduled_at.app_error", appErr.Id)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		invalid := newScheduledPost()
+		invalid.Message = ""
+		invalid.FileIds = nil
+		appErr := invalid.IsValid(PostMessageMaxRunesV2)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.scheduled_post.is_valid.empty.app_error", appErr.Id)
+
+		invalid.FileIds = []string{NewId()}
+		assert.Nil(t, invalid.IsValid(PostMessageMaxRunesV2))
+	})
+
+	t.Run("message too long", func(t *testing.T) {
+		invalid := newScheduledPost()
+		invalid.Message = "too long"
+		appErr := invalid.IsValid(3)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.scheduled_post.is_valid.msg.app_error", appErr.Id)
+	})
+
+	t.Run("invalid root id", func(t *testing.T) {
+		invalid := newScheduledPost()
+		invalid.RootId = "invalid"
+		appErr := invalid.IsValid(PostMessageMaxRunesV2)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.scheduled_post.is_valid.root_id.app_error", appErr.Id)
+	})
+}
+
+func TestScheduledPostToPost(t *testing.T) {
+	scheduledPost := &ScheduledPost{
+		Id:        NewId(),
+		UserId:    NewId(),
+		ChannelId: NewId(),
+		RootId:    NewId(),
+		Message:   "message",
+		FileIds:   []string{NewId()},
+	}
+	scheduledPost.SetProps(StringInterface{"key": "value"})
+
+	post := scheduledPost.ToPost()
+	assert.Empty(t, post.Id)
+	assert.Equal(t, scheduledPost.UserId, post.UserId)
+	assert.Equal(t, scheduledPost.ChannelId, post.ChannelId)
+	assert.Equal(t, scheduledPost.RootId, post.RootId)
+	assert.Equal(t, scheduledPost.Message, post.Message)
+	assert.Equal(t, scheduledPost.FileIds, post.FileIds)
+	assert.Equal(t, "value", post.GetProp("key"))
+
+	post.AddProp("other", "value")
+	assert.NotContains(t, scheduledPost.GetProps(), "other")
+}
@@ -0,0 +1,574 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SCIMSchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMSchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMSchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SCIMSchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCIMSchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SCIMSchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	SCIMErrorTypeInvalidFilter = "invalidFilter"
+	SCIMErrorTypeInvalidPath   = "invalidPath"
+	SCIMErrorTypeInvalidValue  = "invalidValue"
+	SCIMErrorTypeUniqueness    = "uniqueness"
+
+	SCIMPatchOpAdd     = "add"
+	SCIMPatchOpReplace = "replace"
+	SCIMPatchOpRemove  = "remove"
+
+	SCIMDefaultCount = 100
+	SCIMMaxCount     = 1000
+)
+
+type SCIMMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+type SCIMName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type SCIMEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// SCIMUser is the SCIM 2.0 representation of a user provisioned by an identity provider.
+type SCIMUser struct {
+	Schemas    []string    `json:"schemas"`
+	Id         string      `json:"id,omitempty"`
+	ExternalId string      `json:"externalId,omitempty"`
+	UserName   string      `json:"userName"`
+	Name       SCIMName    `json:"name"`
+	NickName   string      `json:"nickName,omitempty"`
+	Emails     []SCIMEmail `json:"emails,omitempty"`
+	Active     *bool       `json:"active,omitempty"`
+	Meta       *SCIMMeta   `json:"meta,omitempty"`
+}
+
+type SCIMMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// SCIMGroup is the SCIM 2.0 representation of a group provisioned by an identity provider. A nil
+// Members means the members were not given, as opposed to an empty group.
+type SCIMGroup struct {
+	Schemas     []string     `json:"schemas"`
+	Id          string       `json:"id,omitempty"`
+	ExternalId  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []SCIMMember `json:"members,omitempty"`
+	Meta        *SCIMMeta    `json:"meta,omitempty"`
+}
+
+type SCIMListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type SCIMError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+type SCIMPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type SCIMPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []SCIMPatchOperation `json:"Operations"`
+}
+
+type SCIMSupported struct {
+	Supported bool `json:"supported"`
+}
+
+type SCIMBulkSupport struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type SCIMFilterSupport struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type SCIMAuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+type SCIMServiceProviderConfig struct {
+	Schemas               []string                   `json:"schemas"`
+	Patch                 SCIMSupported              `json:"patch"`
+	Bulk                  SCIMBulkSupport            `json:"bulk"`
+	Filter                SCIMFilterSupport          `json:"filter"`
+	ChangePassword        SCIMSupported              `json:"changePassword"`
+	Sort                  SCIMSupported              `json:"sort"`
+	Etag                  SCIMSupported              `json:"etag"`
+	AuthenticationSchemes []SCIMAuthenticationScheme `json:"authenticationSchemes"`
+}
+
+// NewSCIMServiceProviderConfig describes the SCIM features supported by the server.
+func NewSCIMServiceProviderConfig() *SCIMServiceProviderConfig {
+	return &SCIMServiceProviderConfig{
+		Schemas: []string{SCIMSchemaServiceProviderConfig},
+		Patch:   SCIMSupported{Supported: true},
+		Filter:  SCIMFilterSupport{Supported: true, MaxResults: SCIMMaxCount},
+		AuthenticationSchemes: []SCIMAuthenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication with a personal access token of a user allowed to manage users and groups.",
+			Primary:     true,
+		}},
+	}
+}
+
+func NewSCIMListResponse(resources []any, totalResults, startIndex int) *SCIMListResponse {
+	if resources == nil {
+		resources = []any{}
+	}
+
+	return &SCIMListResponse{
+		Schemas:      []string{SCIMSchemaListResponse},
+		TotalResults: totalResults,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+func NewSCIMError(status int, scimType, detail string) *SCIMError {
+	return &SCIMError{
+		Schemas:  []string{SCIMSchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
+
+func scimTime(millis int64) string {
+	if millis == 0 {
+		return ""
+	}
+	return GetTimeForMillis(millis).UTC().Format(time.RFC3339)
+}
+
+// SCIMUserFromUser converts a provisioned user, whose auth data holds the identity provider's
+// external id, to its SCIM representation.
+func SCIMUserFromUser(user *User) *SCIMUser {
+	scimUser := &SCIMUser{
+		Schemas:  []string{SCIMSchemaUser},
+		Id:       user.Id,
+		UserName: user.Username,
+		Name: SCIMName{
+			Formatted:  strings.TrimSpace(user.FirstName + " " + user.LastName),
+			GivenName:  user.FirstName,
+			FamilyName: user.LastName,
+		},
+		NickName: user.Nickname,
+		Active:   NewBool(user.DeleteAt == 0),
+		Meta: &SCIMMeta{
+			ResourceType: "User",
+			Created:      scimTime(user.CreateAt),
+			LastModified: scimTime(user.UpdateAt),
+		},
+	}
+
+	if user.AuthData != nil {
+		scimUser.ExternalId = *user.AuthData
+	}
+	if user.Email != "" {
+		scimUser.Emails = []SCIMEmail{{Value: user.Email, Type: "work", Primary: true}}
+	}
+
+	return scimUser
+}
+
+// SCIMGroupFromGroup converts a provisioned group and its members to its SCIM representation.
+func SCIMGroupFromGroup(group *Group, members []*User) *SCIMGroup {
+	scimGroup := &SCIMGroup{
+		Schemas:     []string{SCIMSchemaGroup},
+		Id:          group.Id,
+		DisplayName: group.DisplayName,
+		Members:     make([]SCIMMember, 0, len(members)),
+		Meta: &SCIMMeta{
+			ResourceType: "Group",
+			Created:      scimTime(group.CreateAt),
+			LastModified: scimTime(group.UpdateAt),
+		},
+	}
+
+	// Groups created without an external id use their own id as remote id.
+	if remoteID := group.GetRemoteId(); remoteID != group.Id {
+		scimGroup.ExternalId = remoteID
+	}
+	for _, member := range members {
+		scimGroup.Members = append(scimGroup.Members, SCIMMember{Value: member.Id, Display: member.Username})
+	}
+
+	return scimGroup
+}
+
+// IsActive returns whether the user should be active, which is the case unless stated otherwise.
+func (u *SCIMUser) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// PrimaryEmail returns the primary email of the user, or its first email if none is marked
+// primary.
+func (u *SCIMUser) PrimaryEmail() string {
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// Email returns the email the user is provisioned with, falling back to the user name when the
+// identity provider identifies users by email.
+func (u *SCIMUser) Email() string {
+	if email := u.PrimaryEmail(); email != "" {
+		return email
+	}
+	if IsValidEmail(strings.ToLower(u.UserName)) {
+		return u.UserName
+	}
+	return ""
+}
+
+// Username returns the Mattermost username derived from the user name, using only the local part
+// of user names that are emails.
+func (u *SCIMUser) Username() string {
+	userName := u.UserName
+	if IsValidEmail(strings.ToLower(userName)) {
+		userName = userName[:strings.LastIndex(userName, "@")]
+	}
+	return CleanUsername(userName)
+}
+
+// ApplyToUser copies the attributes of the SCIM user to the given user. The active state is left
+// for the caller to apply.
+func (u *SCIMUser) ApplyToUser(user *User) {
+	user.Username = u.Username()
+	user.Email = u.Email()
+	user.FirstName = u.Name.GivenName
+	user.LastName = u.Name.FamilyName
+	user.Nickname = u.NickName
+	if u.ExternalId != "" {
+		user.AuthData = NewString(u.ExternalId)
+	}
+}
+
+// ApplyPatch applies the given PATCH operations to the user. Both the object values sent by Okta
+// and the per-attribute paths sent by Azure AD are supported.
+func (u *SCIMUser) ApplyPatch(operations []SCIMPatchOperation) *AppError {
+	for _, operation := range operations {
+		switch strings.ToLower(operation.Op) {
+		case SCIMPatchOpAdd, SCIMPatchOpReplace:
+			if operation.Path != "" {
+				if appErr := u.setAttribute(operation.Path, operation.Value); appErr != nil {
+					return appErr
+				}
+				continue
+			}
+
+			var attributes map[string]json.RawMessage
+			if err := json.Unmarshal(operation.Value, &attributes); err != nil {
+				return newSCIMPatchValueError(operation.Path).Wrap(err)
+			}
+			for path, value := range attributes {
+				if appErr := u.setAttribute(path, value); appErr != nil {
+					return appErr
+				}
+			}
+		case SCIMPatchOpRemove:
+			switch strings.ToLower(operation.Path) {
+			case "name.givenname":
+				u.Name.GivenName = ""
+			case "name.familyname":
+				u.Name.FamilyName = ""
+			case "nickname":
+				u.NickName = ""
+			default:
+				return newSCIMPatchPathError(operation.Path)
+			}
+		default:
+			return NewAppError("SCIMUser.ApplyPatch", "model.scim.patch.op.app_error", map[string]any{"Op": operation.Op}, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+func (u *SCIMUser) setAttribute(path string, value json.RawMessage) *AppError {
+	lowerPath := strings.ToLower(path)
+
+	switch lowerPath {
+	case "active":
+		active, err := parseSCIMBool(value)
+		if err != nil {
+			return newSCIMPatchValueError(path).Wrap(err)
+		}
+		u.Active = NewBool(active)
+		return nil
+	case "name":
+		var name SCIMName
+		if err := json.Unmarshal(value, &name); err != nil {
+			return newSCIMPatchValueError(path).Wrap(err)
+		}
+		if name.GivenName != "" {
+			u.Name.GivenName = name.GivenName
+		}
+		if name.FamilyName != "" {
+			u.Name.FamilyName = name.FamilyName
+		}
+		return nil
+	case "emails":
+		var emails []SCIMEmail
+		if err := json.Unmarshal(value, &emails); err != nil {
+			return newSCIMPatchValueError(path).Wrap(err)
+		}
+		u.Emails = emails
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return newSCIMPatchValueError(path).Wrap(err)
+	}
+
+	switch {
+	case lowerPath == "username":
+		u.UserName = s
+	case lowerPath == "externalid":
+		u.ExternalId = s
+	case lowerPath == "nickname":
+		u.NickName = s
+	case lowerPath == "name.givenname":
+		u.Name.GivenName = s
+	case lowerPath == "name.familyname":
+		u.Name.FamilyName = s
+	case strings.HasPrefix(lowerPath, "emails[") && strings.HasSuffix(lowerPath, "].value"):
+		// Mattermost users have a single email, so any email filter targets it.
+		u.Emails = []SCIMEmail{{Value: s, Type: "work", Primary: true}}
+	default:
+		return newSCIMPatchPathError(path)
+	}
+
+	return nil
+}
+
+// ApplyPatch applies the given PATCH operations to the group, whose members must all be listed.
+func (g *SCIMGroup) ApplyPatch(operations []SCIMPatchOperation) *AppError {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		path := strings.ToLower(operation.Path)
+
+		if op != SCIMPatchOpAdd && op != SCIMPatchOpReplace && op != SCIMPatchOpRemove {
+			return NewAppError("SCIMGroup.ApplyPatch", "model.scim.patch.op.app_error", map[string]any{"Op": operation.Op}, "", http.StatusBadRequest)
+		}
+
+		if op == SCIMPatchOpRemove {
+			if appErr := g.removeMembers(operation); appErr != nil {
+				return appErr
+			}
+			continue
+		}
+
+		var attributes map[string]json.RawMessage
+		if path != "" {
+			attributes = map[string]json.RawMessage{path: operation.Value}
+		} else if err := json.Unmarshal(operation.Value, &attributes); err != nil {
+			return newSCIMPatchValueError(operation.Path).Wrap(err)
+		}
+
+		for attribute, value := range attributes {
+			switch strings.ToLower(attribute) {
+			case "id":
+				// Okta repeats the group id when renaming it.
+				continue
+			case "displayname":
+				if err := json.Unmarshal(value, &g.DisplayName); err != nil {
+					return newSCIMPatchValueError(attribute).Wrap(err)
+				}
+			case "externalid":
+				if err := json.Unmarshal(value, &g.ExternalId); err != nil {
+					return newSCIMPatchValueError(attribute).Wrap(err)
+				}
+			case "members":
+				var members []SCIMMember
+				if err := json.Unmarshal(value, &members); err != nil {
+					return newSCIMPatchValueError(attribute).Wrap(err)
+				}
+				if op == SCIMPatchOpReplace {
+					g.Members = nil
+				}
+				g.addMembers(members)
+			default:
+				return newSCIMPatchPathError(attribute)
+			}
+		}
+	}
+
+	return nil
+}
+
+var scimMemberPathRegexp = regexp.MustCompile(`^(?i)members\[\s*value\s+eq\s+"([^"]*)"\s*\]$`)
+
+func (g *SCIMGroup) removeMembers(operation SCIMPatchOperation) *AppError {
+	if matches := scimMemberPathRegexp.FindStringSubmatch(operation.Path); matches != nil {
+		g.deleteMembers(map[string]bool{matches[1]: true})
+		return nil
+	}
+
+	if !strings.EqualFold(operation.Path, "members") {
+		return newSCIMPatchPathError(operation.Path)
+	}
+
+	// Removing the members attribute without a value removes all the members.
+	if len(operation.Value) == 0 {
+		g.Members = []SCIMMember{}
+		return nil
+	}
+
+	var members []SCIMMember
+	if err := json.Unmarshal(operation.Value, &members); err != nil {
+		return newSCIMPatchValueError(operation.Path).Wrap(err)
+	}
+	userIDs := make(map[string]bool, len(members))
+	for _, member := range members {
+		userIDs[member.Value] = true
+	}
+	g.deleteMembers(userIDs)
+
+	return nil
+}
+
+func (g *SCIMGroup) addMembers(members []SCIMMember) {
+	existing := make(map[string]bool, len(g.Members))
+	for _, member := range g.Members {
+		existing[member.Value] = true
+	}
+
+	for _, member := range members {
+		if !existing[member.Value] {
+			existing[member.Value] = true
+			g.Members = append(g.Members, member)
+		}
+	}
+
+	if g.Members == nil {
+		g.Members = []SCIMMember{}
+	}
+}
+
+func (g *SCIMGroup) deleteMembers(userIDs map[string]bool) {
+	members := make([]SCIMMember, 0, len(g.Members))
+	for _, member := range g.Members {
+		if !userIDs[member.Value] {
+			members = append(members, member)
+		}
+	}
+	g.Members = members
+}
+
+// MemberIDs returns the ids of the users listed as members of the group.
+func (g *SCIMGroup) MemberIDs() []string {
+	userIDs := make([]string, 0, len(g.Members))
+	for _, member := range g.Members {
+		userIDs = append(userIDs, member.Value)
+	}
+	return userIDs
+}
+
+// SCIMFilter is a parsed SCIM filter. Only equality filters on a single attribute, which is what
+// identity providers use to look resources up, are supported.
+type SCIMFilter struct {
+	Attribute string
+	Value     string
+}
+
+var scimFilterRegexp = regexp.MustCompile(`^\s*([A-Za-z][A-Za-z0-9.]*)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// ParseSCIMFilter parses a filter of the form `attribute eq "value"`. It returns nil if the
+// filter is empty.
+func ParseSCIMFilter(filter string) (*SCIMFilter, *AppError) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+
+	matches := scimFilterRegexp.FindStringSubmatch(filter)
+	if matches == nil {
+		return nil, NewAppError("ParseSCIMFilter", "model.scim.filter.app_error", nil, "filter="+filter, http.StatusBadRequest)
+	}
+
+	value, err := strconv.Unquote(`"` + matches[2] + `"`)
+	if err != nil {
+		return nil, NewAppError("ParseSCIMFilter", "model.scim.filter.app_error", nil, "filter="+filter, http.StatusBadRequest).Wrap(err)
+	}
+
+	return &SCIMFilter{Attribute: matches[1], Value: value}, nil
+}
+
+// Is returns whether the filter applies to the given attribute, which is case insensitive.
+func (f *SCIMFilter) Is(attribute string) bool {
+	return strings.EqualFold(f.Attribute, attribute)
+}
+
+// parseSCIMBool parses a boolean sent either as a JSON boolean or, as Azure AD does, as a string.
+func parseSCIMBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, err
+	}
+	return strconv.ParseBool(s)
+}
+
+func newSCIMPatchPathError(path string) *AppError {
+	return NewAppError("SCIMPatch", "model.scim.patch.path.app_error", map[string]any{"Path": path}, "", http.StatusBadRequest)
+}
+
+func newSCIMPatchValueError(path string) *AppError {
+	return NewAppError("SCIMPatch", "model.scim.patch.value.app_error", map[string]any{"Path": path}, "", http.StatusBadRequest)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSCIMFilter(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		filter, appErr := ParseSCIMFilter(" ")
+		require.Nil(t, appErr)
+		assert.Nil(t, filter)
+	})
+
+	t.Run("equality", func(t *testing.T) {
+		filter, appErr := ParseSCIMFilter(`userName eq "jane@example.com"`)
+		require.Nil(t, appErr)
+		assert.Equal(t, &SCIMFilter{Attribute: "userName", Value: "jane@example.com"}, filter)
+		assert.True(t, filter.Is("username"))
+	})
+
+	t.Run("case insensitive operator and escaped value", func(t *testing.T) {
+		filter, appErr := ParseSCIMFilter(`displayName EQ "the \"core\" team"`)
+		require.Nil(t, appErr)
+		assert.Equal(t, `the "core" team`, filter.Value)
+	})
+
+	t.Run("dotted attribute", func(t *testing.T) {
+		filter, appErr := ParseSCIMFilter(`emails.value eq "jane@example.com"`)
+		require.Nil(t, appErr)
+		assert.Equal(t, "emails.value", filter.Attribute)
+	})
+
+	for _, invalid := range []string{`userName sw "jane"`, `userName eq jane`, `userName eq "a" and active eq true`} {
+		t.Run(invalid, func(t *testing.T) {
+			_, appErr := ParseSCIMFilter(invalid)
+			require.NotNil(t, appErr)
+			assert.Equal(t, "model.scim.filter.app_error", appErr.Id)
+		})
+	}
+}
+
+func TestSCIMUserAttributes(t *testing.T) {
+	t.Run("email user name", func(t *testing.T) {
+		scimUser := &SCIMUser{UserName: "Jane.Doe@example.com"}
+		assert.Equal(t, "jane.doe", scimUser.Username())
+		assert.Equal(t, "Jane.Doe@example.com", scimUser.Email())
+	})
+
+	t.Run("primary email", func(t *testing.T) {
+		scimUser := &SCIMUser{
+			UserName: "jdoe",
+			Emails:   []SCIMEmail{{Value: "home@example.com"}, {Value: "work@example.com", Primary: true}},
+		}
+		assert.Equal(t, "jdoe", scimUser.Username())
+		assert.Equal(t, "work@example.com", scimUser.Email())
+	})
+
+	t.Run("active by default", func(t *testing.T) {
+		assert.True(t, (&SCIMUser{}).IsActive())
+		assert.False(t, (&SCIMUser{Active: NewBool(false)}).IsActive())
+	})
+
+	t.Run("apply to user", func(t *testing.T) {
+		user := &User{AuthData: NewString("previous")}
+		scimUser := &SCIMUser{UserName: "jdoe", ExternalId: "00u1", Name: SCIMName{GivenName: "Jane", FamilyName: "Doe"}, Emails: []SCIMEmail{{Value: "jane@example.com"}}}
+		scimUser.ApplyToUser(user)
+
+		assert.Equal(t, "jdoe", user.Username)
+		assert.Equal(t, "jane@example.com", user.Email)
+		assert.Equal(t, "Jane", user.FirstName)
+		assert.Equal(t, "Doe", user.LastName)
+		assert.Equal(t, "00u1", *user.AuthData)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		user := &User{Id: NewId(), Username: "jdoe", Email: "jane@example.com", AuthData: NewString("00u1"), DeleteAt: 1}
+		scimUser := SCIMUserFromUser(user)
+
+		assert.Equal(t, user.Id, scimUser.Id)
+		assert.Equal(t, "00u1", scimUser.ExternalId)
+		assert.False(t, scimUser.IsActive())
+		assert.Equal(t, "jane@example.com", scimUser.PrimaryEmail())
+	})
+}
+
+func TestSCIMUserApplyPatch(t *testing.T) {
+	newUser := func() *SCIMUser {
+		return &SCIMUser{UserName: "jdoe", Name: SCIMName{GivenName: "Jane", FamilyName: "Doe"}, Active: NewBool(true)}
+	}
+
+	t.Run("okta object value", func(t *testing.T) {
+		scimUser := newUser()
+		appErr := scimUser.ApplyPatch([]SCIMPatchOperation{{Op: "replace", Value: json.RawMessage(`{"active": false}`)}})
+		require.Nil(t, appErr)
+		assert.False(t, scimUser.IsActive())
+	})
+
+	t.Run("azure paths", func(t *testing.T) {
+		scimUser := newUser()
+		appErr := scimUser.ApplyPatch([]SCIMPatchOperation{
+			{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)},
+			{Op: "Replace", Path: "name.givenName", Value: json.RawMessage(`"Janet"`)},
+			{Op: "Add", Path: `emails[type eq "work"].value`, Value: json.RawMessage(`"janet@example.com"`)},
+		})
+		require.Nil(t, appErr)
+		assert.False(t, scimUser.IsActive())
+		assert.Equal(t, "Janet", scimUser.Name.GivenName)
+		assert.Equal(t, "Doe", scimUser.Name.FamilyName)
+		assert.Equal(t, "janet@example.com", scimUser.Email())
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		scimUser := newUser()
+		appErr := scimUser.ApplyPatch([]SCIMPatchOperation{{Op: "remove", Path: "name.familyName"}})
+		require.Nil(t, appErr)
+		assert.Empty(t, scimUser.Name.FamilyName)
+	})
+
+	t.Run("unsupported path", func(t *testing.T) {
+		appErr := newUser().ApplyPatch([]SCIMPatchOperation{{Op: "replace", Path: "title", Value: json.RawMessage(`"CEO"`)}})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.scim.patch.path.app_error", appErr.Id)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		appErr := newUser().ApplyPatch([]SCIMPatchOperation{{Op: "replace", Path: "active", Value: json.RawMessage(`"maybe"`)}})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.scim.patch.value.app_error", appErr.Id)
+	})
+
+	t.Run("unsupported operation", func(t *testing.T) {
+		appErr := newUser().ApplyPatch([]SCIMPatchOperation{{Op: "move", Path: "active"}})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.scim.patch.op.app_error", appErr.Id)
+	})
+}
+
+func TestSCIMGroupApplyPatch(t *testing.T) {
+	newGroup := func() *SCIMGroup {
+		return &SCIMGroup{DisplayName: "Engineering", Members: []SCIMMember{{Value: "user1"}, {Value: "user2"}}}
+	}
+
+	t.Run("rename", func(t *testing.T) {
+		group := newGroup()
+		appErr := group.ApplyPatch([]SCIMPatchOperation{{Op: "replace", Value: json.RawMessage(`{"id": "group1", "displayName": "R&D"}`)}})
+		require.Nil(t, appErr)
+		assert.Equal(t, "R&D", group.DisplayName)
+		assert.Equal(t, []string{"user1", "user2"}, group.MemberIDs())
+	})
+
+	t.Run("add members", func(t *testing.T) {
+		group := newGroup()
+		appErr := group.ApplyPatch([]SCIMPatchOperation{{Op: "add", Path: "members", Value: json.RawMessage(`[{"value": "user2"}, {"value": "user3"}]`)}})
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{"user1", "user2", "user3"}, group.MemberIDs())
+	})
+
+	t.Run("replace members", func(t *testing.T) {
+		group := newGroup()
+		appErr := group.ApplyPatch([]SCIMPatchOperation{{Op: "replace", Path: "members", Value: json.RawMessage(`[{"value": "user3"}]`)}})
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{"user3"}, group.MemberIDs())
+	})
+
+	t.Run("remove member by filter", func(t *testing.T) {
+		group := newGroup()
+		appErr := group.ApplyPatch([]SCIMPatchOperation{{Op: "remove", Path: `members[value eq "user1"]`}})
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{"user2"}, group.MemberIDs())
+	})
+
+	t.Run("remove members by value", func(t *testing.T) {
+		group := newGroup()
+		appErr := group.ApplyPatch([]SCIMPatchOperation{{Op: "Remove", Path: "members", Value: json.RawMessage(`[{"value": "user2"}]`)}})
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{"user1"}, group.MemberIDs())
+	})
+
+	t.Run("remove all members", func(t *testing.T) {
+		group := newGroup()
+		appErr := group.ApplyPatch([]SCIMPatchOperation{{Op: "remove", Path: "members"}})
+		require.Nil(t, appErr)
+		assert.NotNil(t, group.Members)
+		assert.Empty(t, group.Members)
+	})
+
+	t.Run("unsupported path", func(t *testing.T) {
+		appErr := newGroup().ApplyPatch([]SCIMPatchOperation{{Op: "remove", Path: "displayName"}})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.scim.patch.path.app_error", appErr.Id)
+	})
+}
+
+func TestSCIMGroupFromGroup(t *testing.T) {
+	group := &Group{Id: NewId(), DisplayName: "Engineering", Source: GroupSourceSCIM}
+	members := []*User{{Id: NewId(), Username: "jdoe"}}
+
+	t.Run("without external id", func(t *testing.T) {
+		group.RemoteId = NewString(group.Id)
+		scimGroup := SCIMGroupFromGroup(group, members)
+		assert.Empty(t, scimGroup.ExternalId)
+		assert.Equal(t, []SCIMMember{{Value: members[0].Id, Display: "jdoe"}}, scimGroup.Members)
+	})
+
+	t.Run("with external id", func(t *testing.T) {
+		group.RemoteId = NewString("00g1")
+		assert.Equal(t, "00g1", SCIMGroupFromGroup(group, members).ExternalId)
+	})
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	SearchQueryTypePostSearch          = "post_search"
+	SearchQueryTypeFileSearch          = "file_search"
+	SearchQueryTypeUserSearch          = "user_search"
+	SearchQueryTypeUserAutocomplete    = "user_autocomplete"
+	SearchQueryTypeChannelAutocomplete = "channel_autocomplete"
+)
+
+// SearchEngineQueryStats describes how a search engine has been answering one type of query
+// since the server started.
+type SearchEngineQueryStats struct {
+	Engine              string  `json:"engine"`
+	QueryType           string  `json:"query_type"`
+	Count               int64   `json:"count"`
+	Failures            int64   `json:"failures"`
+	ConsecutiveFailures int     `json:"consecutive_failures"`
+	AverageLatencyMs    float64 `json:"average_latency_ms"`
+	LastFailureAt       int64   `json:"last_failure_at"`
+	Healthy             bool    `json:"healthy"`
+	RetryAt             int64   `json:"retry_at,omitempty"`
+}
@@ -27,6 +27,10 @@ type SearchParams struct {
 	ExcludedBeforeDate     string   `json:"excluded_before_date,omitempty"`
 	Extensions             []string `json:"extensions,omitempty"`
 	ExcludedExtensions     []string `json:"excluded_extensions,omitempty"`
+	FileTypes              []string `json:"file_types,omitempty"`
+	ExcludedFileTypes      []string `json:"excluded_file_types,omitempty"`
+	Has                    []string `json:"has,omitempty"`
+	ExcludedHas            []string `json:"excluded_has,omitempty"`
 	OnDate                 string   `json:"on_date,omitempty"`
 	ExcludedDate           string   `json:"excluded_date,omitempty"`
 	OrTerms                bool     `json:"or_terms,omitempty"`
@@ -109,7 +113,49 @@ func (p *SearchParams) GetExcludedDateMillis() (int64, int64) {
 	return GetStartOfDayMillis(date, p.TimeZoneOffset), GetEndOfDayMillis(date, p.TimeZoneOffset)
 }
 
-var searchFlags = [...]string{"from", "channel", "in", "before", "after", "on", "ext"}
+const (
+	SearchHasLink     = "link"
+	SearchHasReaction = "reaction"
+)
+
+// searchFileTypeExtensions maps the file types accepted by the type: search flag to the extensions
+// of the files they match. Other types are matched as an extension.
+var searchFileTypeExtensions = map[string][]string{
+	"image":        {"jpg", "jpeg", "png", "gif", "bmp", "tif", "tiff", "webp", "svg", "heic"},
+	"document":     {"doc", "docx", "odt", "rtf", "txt", "md"},
+	"spreadsheet":  {"xls", "xlsx", "ods", "csv", "tsv"},
+	"presentation": {"ppt", "pptx", "odp", "key"},
+	"pdf":          {"pdf"},
+	"audio":        {"mp3", "wav", "ogg", "flac", "m4a", "aac"},
+	"video":        {"mp4", "mov", "avi", "mkv", "webm", "wmv"},
+	"archive":      {"zip", "tar", "gz", "tgz", "bz2", "rar", "7z"},
+	"code":         {"go", "js", "jsx", "ts", "tsx", "py", "java", "c", "cpp", "h", "cs", "rb", "rs", "php", "sh", "json", "yaml", "yml", "xml", "html", "css", "sql"},
+}
+
+func getFileTypesExtensions(fileTypes []string) []string {
+	extensions := []string{}
+	for _, fileType := range fileTypes {
+		fileType = strings.ToLower(fileType)
+		if typeExtensions, ok := searchFileTypeExtensions[fileType]; ok {
+			extensions = append(extensions, typeExtensions...)
+		} else {
+			extensions = append(extensions, fileType)
+		}
+	}
+	return extensions
+}
+
+// GetFileTypeExtensions returns the extensions of the files matched by SearchParams.FileTypes
+func (p *SearchParams) GetFileTypeExtensions() []string {
+	return getFileTypesExtensions(p.FileTypes)
+}
+
+// GetExcludedFileTypeExtensions returns the extensions of the files matched by SearchParams.ExcludedFileTypes
+func (p *SearchParams) GetExcludedFileTypeExtensions() []string {
+	return getFileTypesExtensions(p.ExcludedFileTypes)
+}
+
+var searchFlags = [...]string{"from", "channel", "in", "before", "after", "on", "ext", "type", "has"}
 
 type flag struct {
 	name    string
@@ -270,6 +316,7 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 	excludedDate := ""
 	excludedExtensions := []string{}
 	extensions := []string{}
+	var excludedFileTypes, fileTypes, excludedHas, has []string
 
 	for _, flag := range flags {
 		if flag.name == "in" || flag.name == "channel" {
@@ -308,6 +355,22 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			} else {
 				extensions = append(extensions, flag.value)
 			}
+		} else if flag.name == "type" {
+			if flag.exclude {
+				excludedFileTypes = append(excludedFileTypes, flag.value)
+			} else {
+				fileTypes = append(fileTypes, flag.value)
+			}
+		} else if flag.name == "has" {
+			value := strings.ToLower(flag.value)
+			if value != SearchHasLink && value != SearchHasReaction {
+				continue
+			}
+			if flag.exclude {
+				excludedHas = append(excludedHas, value)
+			} else {
+				has = append(has, value)
+			}
 		}
 	}
 
@@ -328,6 +391,10 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			ExcludedBeforeDate: excludedBeforeDate,
 			Extensions:         extensions,
 			ExcludedExtensions: excludedExtensions,
+			FileTypes:          fileTypes,
+			ExcludedFileTypes:  excludedFileTypes,
+			Has:                has,
+			ExcludedHas:        excludedHas,
 			OnDate:             onDate,
 			ExcludedDate:       excludedDate,
 			TimeZoneOffset:     timeZoneOffset,
@@ -349,6 +416,10 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			ExcludedBeforeDate: excludedBeforeDate,
 			Extensions:         extensions,
 			ExcludedExtensions: excludedExtensions,
+			FileTypes:          fileTypes,
+			ExcludedFileTypes:  excludedFileTypes,
+			Has:                has,
+			ExcludedHas:        excludedHas,
 			OnDate:             onDate,
 			ExcludedDate:       excludedDate,
 			TimeZoneOffset:     timeZoneOffset,
@@ -361,6 +432,8 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 		(len(inChannels) != 0 || len(fromUsers) != 0 ||
 			len(excludedChannels) != 0 || len(excludedUsers) != 0 ||
 			len(extensions) != 0 || len(excludedExtensions) != 0 ||
+			len(fileTypes) != 0 || len(excludedFileTypes) != 0 ||
+			len(has) != 0 || len(excludedHas) != 0 ||
 			afterDate != "" || excludedAfterDate != "" ||
 			beforeDate != "" || excludedBeforeDate != "" ||
 			onDate != "" || excludedDate != "") {
@@ -378,6 +451,10 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			ExcludedBeforeDate: excludedBeforeDate,
 			Extensions:         extensions,
 			ExcludedExtensions: excludedExtensions,
+			FileTypes:          fileTypes,
+			ExcludedFileTypes:  excludedFileTypes,
+			Has:                has,
+			ExcludedHas:        excludedHas,
 			OnDate:             onDate,
 			ExcludedDate:       excludedDate,
 			TimeZoneOffset:     timeZoneOffset,
@@ -1665,6 +1665,45 @@ func TestParseSearchParams(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "input with type and has flags should result in FileTypes and Has",
+			Input: "report type:pdf -type:image has:LINK -has:reaction has:emoji",
+			Output: []*SearchParams{
+				{
+					Terms:              "report",
+					ExcludedTerms:      "",
+					IsHashtag:          false,
+					InChannels:         []string{},
+					ExcludedChannels:   []string{},
+					FromUsers:          []string{},
+					ExcludedUsers:      []string{},
+					Extensions:         []string{},
+					ExcludedExtensions: []string{},
+					FileTypes:          []string{"pdf"},
+					ExcludedFileTypes:  []string{"image"},
+					Has:                []string{SearchHasLink},
+					ExcludedHas:        []string{SearchHasReaction},
+				},
+			},
+		},
+		{
+			Name:  "input with only a has flag should result in a filter without terms",
+			Input: "has:reaction",
+			Output: []*SearchParams{
+				{
+					Terms:              "",
+					ExcludedTerms:      "",
+					IsHashtag:          false,
+					InChannels:         []string{},
+					ExcludedChannels:   []string{},
+					FromUsers:          []string{},
+					ExcludedUsers:      []string{},
+					Extensions:         []string{},
+					ExcludedExtensions: []string{},
+					Has:                []string{SearchHasReaction},
+				},
+			},
+		},
 	} {
 		t.Run(testCase.Name, func(t *testing.T) {
 			require.Equal(t, testCase.Output, ParseSearchParams(testCase.Input, 0))
@@ -1672,6 +1711,16 @@ func TestParseSearchParams(t *testing.T) {
 	}
 }
 
+func TestGetFileTypeExtensions(t *testing.T) {
+	params := &SearchParams{
+		FileTypes:         []string{"PDF", "presentation"},
+		ExcludedFileTypes: []string{"heic"},
+	}
+	assert.Equal(t, []string{"pdf", "ppt", "pptx", "odp", "key"}, params.GetFileTypeExtensions())
+	assert.Equal(t, []string{"heic"}, params.GetExcludedFileTypeExtensions())
+	assert.Empty(t, (&SearchParams{}).GetFileTypeExtensions())
+}
+
 func TestGetOnDateMillis(t *testing.T) {
 	for _, testCase := range []struct {
 		Name        string
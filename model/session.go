@@ -20,6 +20,9 @@ const (
 	SessionPropPlatform               = "platform"
 	SessionPropOs                     = "os"
 	SessionPropBrowser                = "browser"
+	SessionPropDeviceType             = "device_type"
+	SessionPropIpAddress              = "ip_address"
+	SessionPropCountry                = "country"
 	SessionPropType                   = "type"
 	SessionPropUserAccessTokenId      = "user_access_token_id"
 	SessionPropIsBot                  = "is_bot"
@@ -29,6 +32,9 @@ const (
 	SessionTypeUserAccessToken        = "UserAccessToken"
 	SessionTypeCloudKey               = "CloudKey"
 	SessionTypeRemoteclusterToken     = "RemoteClusterToken"
+	SessionTypeSupportAccess          = "SupportAccess"
+	SessionPropSupportAccessAdminId   = "support_access_admin_id"
+	SessionPropSupportAccessConsentId = "support_access_consent_id"
 	SessionPropIsGuest                = "is_guest"
 	SessionActivityTimeout            = 1000 * 60 * 5  // 5 minutes
 	SessionUserAccessTokenExpiryHours = 100 * 365 * 24 // 100 years
@@ -73,6 +79,47 @@ func (s *Session) Auditable() map[string]interface{} {
 	}
 }
 
+// SessionDetailed describes a session to its owner, so that they can recognize the devices they
+// are logged in from. It holds none of the secrets of the session.
+//
+//msgp:ignore SessionDetailed
+type SessionDetailed struct {
+	Id             string `json:"id"`
+	CreateAt       int64  `json:"create_at"`
+	ExpiresAt      int64  `json:"expires_at"`
+	LastActivityAt int64  `json:"last_activity_at"`
+	Type           string `json:"type"`
+	Platform       string `json:"platform"`
+	Os             string `json:"os"`
+	Browser        string `json:"browser"`
+	DeviceType     string `json:"device_type"`
+	IpAddress      string `json:"ip_address"`
+	Country        string `json:"country"`
+	IsMobile       bool   `json:"is_mobile"`
+	IsOAuth        bool   `json:"is_oauth"`
+	IsCurrent      bool   `json:"is_current"`
+}
+
+// Detailed returns the description of the session shown to its owner. Sessions created before
+// their metadata was recorded only have the properties known at the time.
+func (s *Session) Detailed() *SessionDetailed {
+	return &SessionDetailed{
+		Id:             s.Id,
+		CreateAt:       s.CreateAt,
+		ExpiresAt:      s.ExpiresAt,
+		LastActivityAt: s.LastActivityAt,
+		Type:           s.Props[SessionPropType],
+		Platform:       s.Props[SessionPropPlatform],
+		Os:             s.Props[SessionPropOs],
+		Browser:        s.Props[SessionPropBrowser],
+		DeviceType:     s.Props[SessionPropDeviceType],
+		IpAddress:      s.Props[SessionPropIpAddress],
+		Country:        s.Props[SessionPropCountry],
+		IsMobile:       s.IsMobileApp(),
+		IsOAuth:        s.IsOAuth,
+	}
+}
+
 // Returns true if the session is unrestricted, which should grant it
 // with all permissions. This is used for local mode sessions
 func (s *Session) IsUnrestricted() bool {
@@ -214,6 +261,12 @@ func (s *Session) IsOAuthUser() bool {
 	return isOAuthUser
 }
 
+// IsSupportAccess reports whether the session lets an admin view the app as the user, after
+// the user consented to it.
+func (s *Session) IsSupportAccess() bool {
+	return s.Props[SessionPropType] == SessionTypeSupportAccess
+}
+
 func (s *Session) IsSSOLogin() bool {
 	return s.IsOAuthUser() || s.IsSaml()
 }
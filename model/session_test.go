@@ -133,3 +133,41 @@ func TestSessionIsOAuthUser(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionDetailed(t *testing.T) {
+	s := Session{
+		Id:             NewId(),
+		Token:          NewId(),
+		CreateAt:       1,
+		ExpiresAt:      3,
+		LastActivityAt: 2,
+		UserId:         NewId(),
+		DeviceId:       "apple:" + NewId(),
+		Props: StringMap{
+			SessionPropPlatform:   "iPhone",
+			SessionPropOs:         "iOS",
+			SessionPropBrowser:    "Mobile App/2.0",
+			SessionPropDeviceType: "phone",
+			SessionPropIpAddress:  "192.0.2.1",
+			SessionPropCountry:    "SE",
+			"csrf":                NewId(),
+		},
+	}
+
+	detailed := s.Detailed()
+	assert.Equal(t, &SessionDetailed{
+		Id:             s.Id,
+		CreateAt:       1,
+		ExpiresAt:      3,
+		LastActivityAt: 2,
+		Platform:       "iPhone",
+		Os:             "iOS",
+		Browser:        "Mobile App/2.0",
+		DeviceType:     "phone",
+		IpAddress:      "192.0.2.1",
+		Country:        "SE",
+		IsMobile:       true,
+	}, detailed)
+
+	assert.Equal(t, &SessionDetailed{}, (&Session{}).Detailed())
+}
@@ -5,9 +5,16 @@ package model
 
 import (
 	"net/http"
+	"regexp"
 	"unicode/utf8"
 )
 
+const (
+	SharedChannelMaxRedactPatterns     = 20
+	SharedChannelRedactPatternMaxRunes = 256
+	SharedChannelRedactedText          = "[redacted]"
+)
+
 // SharedChannel represents a channel that can be synchronized with a remote cluster.
 // If "home" is true, then the shared channel is homed locally and "SharedChannelRemote"
 // table contains the remote clusters that have been invited.
@@ -26,9 +33,21 @@ type SharedChannel struct {
 	CreateAt         int64       `json:"create_at"`
 	UpdateAt         int64       `json:"update_at"`
 	RemoteId         string      `json:"remote_id,omitempty"` // if not "home"
+	StripAttachments bool        `json:"strip_attachments"`
+	RedactPatterns   StringArray `json:"redact_patterns"`
 	Type             ChannelType `db:"-"`
 }
 
+// SharedChannelFilters are applied to the content of a shared channel before it is sent
+// to the remote clusters the channel is shared with.
+type SharedChannelFilters struct {
+	// StripAttachments removes file attachments from posts.
+	StripAttachments bool `json:"strip_attachments"`
+	// RedactPatterns are regular expressions whose matches in post messages are
+	// replaced with SharedChannelRedactedText.
+	RedactPatterns []string `json:"redact_patterns"`
+}
+
 func (sc *SharedChannel) IsValid() *AppError {
 	if !IsValidId(sc.ChannelId) {
 		return NewAppError("SharedChannel.IsValid", "model.channel.is_valid.id.app_error", nil, "ChannelId="+sc.ChannelId, http.StatusBadRequest)
@@ -71,9 +90,48 @@ func (sc *SharedChannel) IsValid() *AppError {
 			return NewAppError("SharedChannel.IsValid", "model.channel.is_valid.id.app_error", nil, "RemoteId="+sc.RemoteId, http.StatusBadRequest)
 		}
 	}
+
+	if len(sc.RedactPatterns) > SharedChannelMaxRedactPatterns {
+		return NewAppError("SharedChannel.IsValid", "model.shared_channel.is_valid.redact_patterns.app_error", map[string]any{"Max": SharedChannelMaxRedactPatterns}, "id="+sc.ChannelId, http.StatusBadRequest)
+	}
+
+	for _, pattern := range sc.RedactPatterns {
+		if pattern == "" || utf8.RuneCountInString(pattern) > SharedChannelRedactPatternMaxRunes {
+			return NewAppError("SharedChannel.IsValid", "model.shared_channel.is_valid.redact_pattern_length.app_error", map[string]any{"Max": SharedChannelRedactPatternMaxRunes}, "id="+sc.ChannelId, http.StatusBadRequest)
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return NewAppError("SharedChannel.IsValid", "model.shared_channel.is_valid.redact_pattern.app_error", map[string]any{"Pattern": pattern}, "id="+sc.ChannelId, http.StatusBadRequest).Wrap(err)
+		}
+	}
 	return nil
 }
 
+func (f *SharedChannelFilters) Auditable() map[string]any {
+	return map[string]any{
+		"strip_attachments": f.StripAttachments,
+		"redact_patterns":   f.RedactPatterns,
+	}
+}
+
+// Filters returns the outbound content filters of the shared channel.
+func (sc *SharedChannel) Filters() *SharedChannelFilters {
+	return &SharedChannelFilters{
+		StripAttachments: sc.StripAttachments,
+		RedactPatterns:   sc.RedactPatterns,
+	}
+}
+
+// SetFilters replaces the outbound content filters of the shared channel.
+func (sc *SharedChannel) SetFilters(filters *SharedChannelFilters) {
+	sc.StripAttachments = filters.StripAttachments
+	sc.RedactPatterns = filters.RedactPatterns
+}
+
+// HasFilters returns true if any content is filtered before being sent to remotes.
+func (sc *SharedChannel) HasFilters() bool {
+	return sc.StripAttachments || len(sc.RedactPatterns) > 0
+}
+
 func (sc *SharedChannel) PreSave() {
 	sc.ShareName = SanitizeUnicode(sc.ShareName)
 	sc.ShareDisplayName = SanitizeUnicode(sc.ShareDisplayName)
@@ -34,6 +34,14 @@ func TestSharedChannelIsValid(t *testing.T) {
 			ShareName: "test", CreatorId: id}, valid: false},
 		{name: "Valid shared channel", sc: &SharedChannel{ChannelId: id, TeamId: id, CreateAt: now, UpdateAt: now,
 			ShareName: "test", CreatorId: id, RemoteId: id}, valid: true},
+		{name: "Invalid redact pattern", sc: &SharedChannel{ChannelId: id, TeamId: id, CreateAt: now, UpdateAt: now,
+			ShareName: "test", CreatorId: id, RemoteId: id, RedactPatterns: StringArray{"(unclosed"}}, valid: false},
+		{name: "Empty redact pattern", sc: &SharedChannel{ChannelId: id, TeamId: id, CreateAt: now, UpdateAt: now,
+			ShareName: "test", CreatorId: id, RemoteId: id, RedactPatterns: StringArray{""}}, valid: false},
+		{name: "Too many redact patterns", sc: &SharedChannel{ChannelId: id, TeamId: id, CreateAt: now, UpdateAt: now,
+			ShareName: "test", CreatorId: id, RemoteId: id, RedactPatterns: make(StringArray, SharedChannelMaxRedactPatterns+1)}, valid: false},
+		{name: "Valid shared channel with filters", sc: &SharedChannel{ChannelId: id, TeamId: id, CreateAt: now, UpdateAt: now,
+			ShareName: "test", CreatorId: id, RemoteId: id, StripAttachments: true, RedactPatterns: StringArray{`\d{3}-\d{4}`}}, valid: true},
 	}
 
 	for _, item := range data {
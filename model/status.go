@@ -16,6 +16,10 @@ const (
 	StatusCacheSize      = SessionCacheSize
 	StatusChannelTimeout = 20000  // 20 seconds
 	StatusMinUpdateTime  = 120000 // 2 minutes
+
+	// PresenceSubscriptionsMaxUsers is the maximum number of users a single websocket
+	// connection can subscribe to the presence of.
+	PresenceSubscriptionsMaxUsers = 500
 )
 
 type Status struct {
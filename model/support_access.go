@@ -0,0 +1,153 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const (
+	SupportAccessConsentDefaultDurationMinutes = 60
+	SupportAccessConsentMaxDurationMinutes     = 24 * 60
+)
+
+// SupportAccessConsent is the consent of a user for a system admin to view the app as them, in
+// read-only support access sessions lasting until the consent expires or is revoked.
+type SupportAccessConsent struct {
+	Id        string `json:"id"`
+	UserId    string `json:"user_id"`
+	AdminId   string `json:"admin_id"`
+	CreateAt  int64  `json:"create_at"`
+	ExpiresAt int64  `json:"expires_at"`
+	RevokedAt int64  `json:"revoked_at"`
+}
+
+// SupportAccessConsentRequest grants support access to the admin for DurationMinutes, or for
+// SupportAccessConsentDefaultDurationMinutes when omitted.
+type SupportAccessConsentRequest struct {
+	AdminId         string `json:"admin_id"`
+	DurationMinutes int    `json:"duration_minutes"`
+}
+
+func (o *SupportAccessConsent) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         o.Id,
+		"user_id":    o.UserId,
+		"admin_id":   o.AdminId,
+		"create_at":  o.CreateAt,
+		"expires_at": o.ExpiresAt,
+		"revoked_at": o.RevokedAt,
+	}
+}
+
+// IsActive reports whether the consent still allows support access at the given time.
+func (o *SupportAccessConsent) IsActive(now int64) bool {
+	return o.RevokedAt == 0 && o.ExpiresAt > now
+}
+
+func (o *SupportAccessConsent) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("SupportAccessConsent.IsValid", "model.support_access_consent.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.UserId) {
+		return NewAppError("SupportAccessConsent.IsValid", "model.support_access_consent.is_valid.user_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.AdminId) || o.AdminId == o.UserId {
+		return NewAppError("SupportAccessConsent.IsValid", "model.support_access_consent.is_valid.admin_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("SupportAccessConsent.IsValid", "model.support_access_consent.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.ExpiresAt <= o.CreateAt || o.ExpiresAt > o.CreateAt+SupportAccessConsentMaxDurationMinutes*60*1000 {
+		return NewAppError("SupportAccessConsent.IsValid", "model.support_access_consent.is_valid.expires_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *SupportAccessConsent) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+func (r *SupportAccessConsentRequest) IsValid() *AppError {
+	if !IsValidId(r.AdminId) {
+		return NewAppError("SupportAccessConsentRequest.IsValid", "model.support_access_consent.is_valid.admin_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if r.DurationMinutes < 0 || r.DurationMinutes > SupportAccessConsentMaxDurationMinutes {
+		return NewAppError("SupportAccessConsentRequest.IsValid", "model.support_access_consent.is_valid.duration.app_error", map[string]any{"Max": SupportAccessConsentMaxDurationMinutes}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// Duration returns the requested duration of the consent in milliseconds.
+func (r *SupportAccessConsentRequest) Duration() int64 {
+	minutes := r.DurationMinutes
+	if minutes == 0 {
+		minutes = SupportAccessConsentDefaultDurationMinutes
+	}
+	return int64(minutes) * 60 * 1000
+}
+
+// supportAccessReadOnlyRoutes are the API routes, relative to the API root, of the POST requests
+// reading data, which support access sessions may make.
+var supportAccessReadOnlyRoutes = regexp.MustCompile(`^(` + strings.Join([]string{
+	`/users/(ids|usernames|search|logout|status/ids)`,
+	`/channels/(group/)?search`,
+	`/channels/[a-z0-9]{26}/members/ids`,
+	`/teams/search`,
+	`/teams/[a-z0-9]{26}/(channels/ids|channels/search|members/ids|files/search|posts/search)`,
+	`/posts/(ids|search)`,
+	`/files/search`,
+	`/emoji/search`,
+}, "|") + `)$`)
+
+// supportAccessReadOnlyWebSocketActions are the websocket actions reading data, which support
+// access sessions may send.
+var supportAccessReadOnlyWebSocketActions = map[string]bool{
+	"ping":                     true,
+	"get_statuses":             true,
+	"get_statuses_by_ids":      true,
+	WebsocketGetMissedEvents:   true,
+	WebsocketPresenceSubscribe: true,
+}
+
+// IsSupportAccessReadOnlyRequest reports whether the API request only reads data, support access
+// sessions not being allowed to make the others. The path is relative to the subpath of the site.
+func IsSupportAccessReadOnlyRequest(method, path string) bool {
+	if method == http.MethodGet || method == http.MethodHead {
+		return true
+	}
+
+	if method != http.MethodPost {
+		return false
+	}
+
+	return strings.HasPrefix(path, APIURLSuffix+"/") && supportAccessReadOnlyRoutes.MatchString(strings.TrimPrefix(path, APIURLSuffix))
+}
+
+// IsSupportAccessReadOnlyPluginRequest reports whether the plugin request only reads data. Plugins
+// may change data in any POST request, so only the GET and HEAD ones are let through.
+func IsSupportAccessReadOnlyPluginRequest(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// IsSupportAccessReadOnlyWebSocketAction reports whether the websocket action only reads data,
+// support access sessions not being allowed to send the others.
+func IsSupportAccessReadOnlyWebSocketAction(action string) bool {
+	return supportAccessReadOnlyWebSocketActions[action]
+}
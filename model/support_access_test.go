@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportAccessConsentIsValid(t *testing.T) {
+	consent := &SupportAccessConsent{
+		UserId:  NewId(),
+		AdminId: NewId(),
+	}
+	consent.PreSave()
+	consent.ExpiresAt = consent.CreateAt + SupportAccessConsentDefaultDurationMinutes*60*1000
+	require.Nil(t, consent.IsValid())
+
+	consent.AdminId = consent.UserId
+	assert.NotNil(t, consent.IsValid(), "the user can't grant themselves support access")
+	consent.AdminId = NewId()
+
+	consent.ExpiresAt = consent.CreateAt
+	assert.NotNil(t, consent.IsValid())
+
+	consent.ExpiresAt = consent.CreateAt + (SupportAccessConsentMaxDurationMinutes+1)*60*1000
+	assert.NotNil(t, consent.IsValid())
+}
+
+func TestSupportAccessConsentIsActive(t *testing.T) {
+	now := GetMillis()
+	consent := &SupportAccessConsent{ExpiresAt: now + 1000}
+	assert.True(t, consent.IsActive(now))
+	assert.False(t, consent.IsActive(now+1000))
+
+	consent.RevokedAt = now
+	assert.False(t, consent.IsActive(now))
+}
+
+func TestSupportAccessConsentRequest(t *testing.T) {
+	assert.NotNil(t, (&SupportAccessConsentRequest{}).IsValid())
+	assert.NotNil(t, (&SupportAccessConsentRequest{AdminId: NewId(), DurationMinutes: -1}).IsValid())
+	assert.NotNil(t, (&SupportAccessConsentRequest{AdminId: NewId(), DurationMinutes: SupportAccessConsentMaxDurationMinutes + 1}).IsValid())
+
+	request := &SupportAccessConsentRequest{AdminId: NewId()}
+	require.Nil(t, request.IsValid())
+	assert.Equal(t, int64(SupportAccessConsentDefaultDurationMinutes*60*1000), request.Duration())
+
+	request.DurationMinutes = 15
+	assert.Equal(t, int64(15*60*1000), request.Duration())
+}
+
+func TestIsSupportAccessReadOnlyRequest(t *testing.T) {
+	assert.True(t, IsSupportAccessReadOnlyRequest(http.MethodGet, "/api/v4/users/me"))
+	assert.True(t, IsSupportAccessReadOnlyRequest(http.MethodPost, "/api/v4/users/ids"))
+	assert.True(t, IsSupportAccessReadOnlyRequest(http.MethodPost, "/api/v4/teams/"+NewId()+"/posts/search"))
+	assert.False(t, IsSupportAccessReadOnlyRequest(http.MethodPost, "/plugins/com.example/search"))
+	assert.False(t, IsSupportAccessReadOnlyRequest(http.MethodPost, "/plugins/com.example/api/v4/users/ids"))
+	assert.False(t, IsSupportAccessReadOnlyRequest(http.MethodPost, "/api/v4/users/"+NewId()+"/search"))
+	assert.False(t, IsSupportAccessReadOnlyRequest(http.MethodPost, "/api/v4/posts"))
+	assert.False(t, IsSupportAccessReadOnlyRequest(http.MethodPost, "/plugins/com.example/api/items"))
+	assert.False(t, IsSupportAccessReadOnlyRequest(http.MethodDelete, "/api/v4/users/ids"))
+
+	assert.True(t, IsSupportAccessReadOnlyPluginRequest(http.MethodGet))
+	assert.False(t, IsSupportAccessReadOnlyPluginRequest(http.MethodPost))
+
+	assert.True(t, IsSupportAccessReadOnlyWebSocketAction("get_statuses"))
+	assert.False(t, IsSupportAccessReadOnlyWebSocketAction("user_typing"))
+	assert.False(t, IsSupportAccessReadOnlyWebSocketAction("custom_com.example_action"))
+}
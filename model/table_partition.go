@@ -0,0 +1,19 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// TablePartition is a monthly partition of a table partitioned by time, holding the rows from
+// StartAt, included, to EndAt, excluded, in milliseconds. The default partition of a table,
+// holding the rows out of the bounds of its monthly partitions, has neither.
+type TablePartition struct {
+	Table   string `json:"table"`
+	Name    string `json:"name"`
+	StartAt int64  `json:"start_at,omitempty"`
+	EndAt   int64  `json:"end_at,omitempty"`
+}
+
+// IsDefault returns whether the partition is the default partition of its table.
+func (p *TablePartition) IsDefault() bool {
+	return p.StartAt == 0 && p.EndAt == 0
+}
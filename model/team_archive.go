@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	TeamArchiveStatusArchiving = "archiving"
+	TeamArchiveStatusArchived  = "archived"
+	TeamArchiveStatusRestoring = "restoring"
+
+	TeamArchiveActionArchive = "archive"
+	TeamArchiveActionRestore = "restore"
+)
+
+// TeamArchive tracks a team that is archived, or being archived or restored by a team_archive job.
+// The channels archived along with the team are those whose DeleteAt is the ArchivedAt of the
+// archive, and the pending jobs referencing the team that were suspended are recreated on restore.
+type TeamArchive struct {
+	TeamId          string      `json:"team_id"`
+	ArchiverId      string      `json:"archiver_id"`
+	Status          string      `json:"status"`
+	JobId           string      `json:"job_id"`
+	SuspendedJobIds StringArray `json:"suspended_job_ids"`
+	ArchivedAt      int64       `json:"archived_at"`
+	UpdateAt        int64       `json:"update_at"`
+}
+
+func (o *TeamArchive) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"team_id":     o.TeamId,
+		"archiver_id": o.ArchiverId,
+		"status":      o.Status,
+		"job_id":      o.JobId,
+		"archived_at": o.ArchivedAt,
+		"update_at":   o.UpdateAt,
+	}
+}
+
+func (o *TeamArchive) IsValid() *AppError {
+	if !IsValidId(o.TeamId) {
+		return NewAppError("TeamArchive.IsValid", "model.team_archive.is_valid.team_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ArchiverId) {
+		return NewAppError("TeamArchive.IsValid", "model.team_archive.is_valid.archiver_id.app_error", nil, "team_id="+o.TeamId, http.StatusBadRequest)
+	}
+
+	switch o.Status {
+	case TeamArchiveStatusArchiving, TeamArchiveStatusArchived, TeamArchiveStatusRestoring:
+	default:
+		return NewAppError("TeamArchive.IsValid", "model.team_archive.is_valid.status.app_error", nil, "team_id="+o.TeamId, http.StatusBadRequest)
+	}
+
+	if o.JobId != "" && !IsValidId(o.JobId) {
+		return NewAppError("TeamArchive.IsValid", "model.team_archive.is_valid.job_id.app_error", nil, "team_id="+o.TeamId, http.StatusBadRequest)
+	}
+
+	for _, jobID := range o.SuspendedJobIds {
+		if !IsValidId(jobID) {
+			return NewAppError("TeamArchive.IsValid", "model.team_archive.is_valid.suspended_job_ids.app_error", nil, "team_id="+o.TeamId, http.StatusBadRequest)
+		}
+	}
+
+	if o.ArchivedAt == 0 {
+		return NewAppError("TeamArchive.IsValid", "model.team_archive.is_valid.archived_at.app_error", nil, "team_id="+o.TeamId, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("TeamArchive.IsValid", "model.team_archive.is_valid.update_at.app_error", nil, "team_id="+o.TeamId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *TeamArchive) PreSave() {
+	if o.ArchivedAt == 0 {
+		o.ArchivedAt = GetMillis()
+	}
+	if o.Status == "" {
+		o.Status = TeamArchiveStatusArchiving
+	}
+	if o.SuspendedJobIds == nil {
+		o.SuspendedJobIds = StringArray{}
+	}
+	o.UpdateAt = GetMillis()
+}
+
+func (o *TeamArchive) PreUpdate() {
+	if o.SuspendedJobIds == nil {
+		o.SuspendedJobIds = StringArray{}
+	}
+	o.UpdateAt = GetMillis()
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamArchiveIsValid(t *testing.T) {
+	archive := &TeamArchive{
+		TeamId:     NewId(),
+		ArchiverId: NewId(),
+	}
+	archive.PreSave()
+	require.Nil(t, archive.IsValid())
+	assert.Equal(t, TeamArchiveStatusArchiving, archive.Status)
+	assert.NotNil(t, archive.SuspendedJobIds)
+
+	archive.JobId = NewId()
+	archive.SuspendedJobIds = StringArray{NewId()}
+	require.Nil(t, archive.IsValid())
+
+	archive.SuspendedJobIds = StringArray{"invalid"}
+	require.NotNil(t, archive.IsValid())
+	archive.SuspendedJobIds = StringArray{}
+
+	archive.JobId = "invalid"
+	require.NotNil(t, archive.IsValid())
+	archive.JobId = ""
+
+	archive.Status = "unknown"
+	require.NotNil(t, archive.IsValid())
+	archive.Status = TeamArchiveStatusRestoring
+
+	archive.ArchiverId = "invalid"
+	require.NotNil(t, archive.IsValid())
+}
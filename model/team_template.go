@@ -0,0 +1,236 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	TeamTemplateDisplayNameMaxRunes = 64
+	TeamTemplateDescriptionMaxRunes = 1024
+	TeamTemplateMaxChannels         = 50
+)
+
+// TeamTemplate describes the content of a team, which users able to create teams instantiate
+// as a new team in one step.
+type TeamTemplate struct {
+	Id          string              `json:"id"`
+	DisplayName string              `json:"display_name"`
+	Description string              `json:"description"`
+	CreatorId   string              `json:"creator_id"`
+	Content     TeamTemplateContent `json:"content"`
+	CreateAt    int64               `json:"create_at"`
+	UpdateAt    int64               `json:"update_at"`
+	DeleteAt    int64               `json:"delete_at"`
+}
+
+// TeamTemplateContent is what is created in the team instantiated from a template. Categories,
+// playbooks and incoming webhooks refer to the channels of the template by name.
+type TeamTemplateContent struct {
+	Channels         []*TeamTemplateChannel         `json:"channels"`
+	Categories       []*TeamTemplateCategory        `json:"categories"`
+	Playbooks        []*TeamTemplatePlaybook        `json:"playbooks"`
+	Commands         []*TeamTemplateCommand         `json:"commands"`
+	IncomingWebhooks []*TeamTemplateIncomingWebhook `json:"incoming_webhooks"`
+}
+
+type TeamTemplateChannel struct {
+	Name        string      `json:"name"`
+	DisplayName string      `json:"display_name"`
+	Type        ChannelType `json:"type"`
+	Purpose     string      `json:"purpose"`
+	Header      string      `json:"header"`
+}
+
+// TeamTemplateCategory is a sidebar category created for the user instantiating the template.
+type TeamTemplateCategory struct {
+	DisplayName string   `json:"display_name"`
+	Channels    []string `json:"channels"`
+}
+
+// TeamTemplatePlaybook is a playbook created in the team. Definition holds the playbook as the
+// Playbooks API accepts it, its title and team being set on instantiation.
+type TeamTemplatePlaybook struct {
+	Title      string          `json:"title"`
+	Definition StringInterface `json:"definition"`
+}
+
+type TeamTemplateCommand struct {
+	Trigger          string `json:"trigger"`
+	DisplayName      string `json:"display_name"`
+	Description      string `json:"description"`
+	URL              string `json:"url"`
+	Method           string `json:"method"`
+	AutoComplete     bool   `json:"auto_complete"`
+	AutoCompleteDesc string `json:"auto_complete_desc"`
+	AutoCompleteHint string `json:"auto_complete_hint"`
+}
+
+type TeamTemplateIncomingWebhook struct {
+	Channel     string `json:"channel"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description"`
+}
+
+// TeamTemplateInstantiation is the request to create a team from a template.
+type TeamTemplateInstantiation struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Type        string `json:"type"`
+}
+
+// TeamTemplateInstance is what was created from a template.
+type TeamTemplateInstance struct {
+	Team        *Team      `json:"team"`
+	Channels    []*Channel `json:"channels"`
+	PlaybookIds []string   `json:"playbook_ids"`
+}
+
+func (o *TeamTemplate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"id":           o.Id,
+		"display_name": o.DisplayName,
+		"creator_id":   o.CreatorId,
+		"channels":     len(o.Content.Channels),
+		"playbooks":    len(o.Content.Playbooks),
+		"create_at":    o.CreateAt,
+		"update_at":    o.UpdateAt,
+		"delete_at":    o.DeleteAt,
+	}
+}
+
+func (o *TeamTemplate) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if n := utf8.RuneCountInString(o.DisplayName); n == 0 || n > TeamTemplateDisplayNameMaxRunes {
+		return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.display_name.app_error", map[string]any{"MaxLength": TeamTemplateDisplayNameMaxRunes}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.Description) > TeamTemplateDescriptionMaxRunes {
+		return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.description.app_error", map[string]any{"MaxLength": TeamTemplateDescriptionMaxRunes}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.creator_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return o.Content.isValid(o.Id)
+}
+
+func (o *TeamTemplateContent) isValid(templateID string) *AppError {
+	if len(o.Channels) > TeamTemplateMaxChannels {
+		return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.channels.app_error", map[string]any{"Max": TeamTemplateMaxChannels}, "id="+templateID, http.StatusBadRequest)
+	}
+
+	channels := make(map[string]bool, len(o.Channels))
+	for _, channel := range o.Channels {
+		if channel == nil ||
+			!IsValidChannelIdentifier(channel.Name) ||
+			channel.Name == DefaultChannelName ||
+			channels[channel.Name] ||
+			utf8.RuneCountInString(channel.DisplayName) == 0 ||
+			utf8.RuneCountInString(channel.DisplayName) > ChannelDisplayNameMaxRunes ||
+			(channel.Type != ChannelTypeOpen && channel.Type != ChannelTypePrivate) ||
+			utf8.RuneCountInString(channel.Purpose) > ChannelPurposeMaxRunes ||
+			utf8.RuneCountInString(channel.Header) > ChannelHeaderMaxRunes {
+			return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.channel.app_error", nil, "id="+templateID, http.StatusBadRequest)
+		}
+		channels[channel.Name] = true
+	}
+
+	for _, category := range o.Categories {
+		if category == nil || category.DisplayName == "" {
+			return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.category.app_error", nil, "id="+templateID, http.StatusBadRequest)
+		}
+		for _, name := range category.Channels {
+			if !channels[name] {
+				return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.category.app_error", nil, "id="+templateID+", channel="+name, http.StatusBadRequest)
+			}
+		}
+	}
+
+	for _, playbook := range o.Playbooks {
+		if playbook == nil || playbook.Title == "" {
+			return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.playbook.app_error", nil, "id="+templateID, http.StatusBadRequest)
+		}
+	}
+
+	triggers := make(map[string]bool, len(o.Commands))
+	for _, command := range o.Commands {
+		if command == nil ||
+			command.Trigger == "" ||
+			triggers[command.Trigger] ||
+			!IsValidHTTPURL(command.URL) ||
+			(command.Method != CommandMethodPost && command.Method != CommandMethodGet) {
+			return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.command.app_error", nil, "id="+templateID, http.StatusBadRequest)
+		}
+		triggers[command.Trigger] = true
+	}
+
+	for _, hook := range o.IncomingWebhooks {
+		if hook == nil || !channels[hook.Channel] {
+			return NewAppError("TeamTemplate.IsValid", "model.team_template.is_valid.incoming_webhook.app_error", nil, "id="+templateID, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+func (o *TeamTemplate) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+	o.DeleteAt = 0
+}
+
+func (o *TeamTemplate) PreUpdate() {
+	o.UpdateAt = GetMillis()
+}
+
+// Scan converts database column value to TeamTemplateContent
+func (o *TeamTemplateContent) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	buf, ok := value.([]byte)
+	if ok {
+		return json.Unmarshal(buf, o)
+	}
+
+	str, ok := value.(string)
+	if ok {
+		return json.Unmarshal([]byte(str), o)
+	}
+
+	return errors.New("received value is neither a byte slice nor string")
+}
+
+// Value converts TeamTemplateContent to database value
+func (o TeamTemplateContent) Value() (driver.Value, error) {
+	j, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(j), nil
+}
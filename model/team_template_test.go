@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamTemplateIsValid(t *testing.T) {
+	newTemplate := func() *TeamTemplate {
+		template := &TeamTemplate{
+			DisplayName: "Engineering",
+			CreatorId:   NewId(),
+			Content: TeamTemplateContent{
+				Channels: []*TeamTemplateChannel{
+					{Name: "standup", DisplayName: "Standup", Type: ChannelTypeOpen},
+					{Name: "incidents", DisplayName: "Incidents", Type: ChannelTypePrivate},
+				},
+				Categories: []*TeamTemplateCategory{
+					{DisplayName: "Daily", Channels: []string{"standup"}},
+				},
+				Playbooks: []*TeamTemplatePlaybook{
+					{Title: "Incident response"},
+				},
+				Commands: []*TeamTemplateCommand{
+					{Trigger: "deploy", URL: "https://example.com/deploy", Method: CommandMethodPost},
+				},
+				IncomingWebhooks: []*TeamTemplateIncomingWebhook{
+					{Channel: "incidents", DisplayName: "Alerts"},
+				},
+			},
+		}
+		template.PreSave()
+		return template
+	}
+
+	require.Nil(t, newTemplate().IsValid())
+
+	for name, mutate := range map[string]func(*TeamTemplate){
+		"empty display name":         func(o *TeamTemplate) { o.DisplayName = "" },
+		"invalid creator":            func(o *TeamTemplate) { o.CreatorId = "invalid" },
+		"invalid channel name":       func(o *TeamTemplate) { o.Content.Channels[0].Name = "Not Valid" },
+		"town square":                func(o *TeamTemplate) { o.Content.Channels[0].Name = DefaultChannelName },
+		"duplicate channel":          func(o *TeamTemplate) { o.Content.Channels[1].Name = "standup" },
+		"direct channel":             func(o *TeamTemplate) { o.Content.Channels[0].Type = ChannelTypeDirect },
+		"unknown category channel":   func(o *TeamTemplate) { o.Content.Categories[0].Channels = []string{"unknown"} },
+		"untitled playbook":          func(o *TeamTemplate) { o.Content.Playbooks[0].Title = "" },
+		"duplicate command":          func(o *TeamTemplate) { o.Content.Commands = append(o.Content.Commands, o.Content.Commands[0]) },
+		"invalid command url":        func(o *TeamTemplate) { o.Content.Commands[0].URL = "example" },
+		"unknown webhook channel":    func(o *TeamTemplate) { o.Content.IncomingWebhooks[0].Channel = "unknown" },
+		"nil channel":                func(o *TeamTemplate) { o.Content.Channels = append(o.Content.Channels, nil) },
+		"invalid command method":     func(o *TeamTemplate) { o.Content.Commands[0].Method = "X" },
+		"display name of a category": func(o *TeamTemplate) { o.Content.Categories[0].DisplayName = "" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			template := newTemplate()
+			mutate(template)
+			assert.NotNil(t, template.IsValid())
+		})
+	}
+}
+
+func TestTeamTemplateContentScan(t *testing.T) {
+	content := TeamTemplateContent{
+		Channels: []*TeamTemplateChannel{{Name: "standup", DisplayName: "Standup", Type: ChannelTypeOpen}},
+	}
+
+	value, err := content.Value()
+	require.NoError(t, err)
+
+	var scanned TeamTemplateContent
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, content, scanned)
+
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, content, scanned)
+
+	require.Error(t, scanned.Scan(42))
+}
@@ -92,6 +92,31 @@ func (o *Thread) Etag() string {
 	return Etag(o.PostId, o.LastReplyAt)
 }
 
+// The following are some GraphQL methods necessary to return the
+// data in float64 type. The spec doesn't support 64 bit integers,
+// so we have to pass the data in float64. The _ at the end is
+// a hack to keep the attribute name same in GraphQL schema.
+
+func (o *ThreadResponse) ReplyCount_() float64 {
+	return float64(o.ReplyCount)
+}
+
+func (o *ThreadResponse) LastReplyAt_() float64 {
+	return float64(o.LastReplyAt)
+}
+
+func (o *ThreadResponse) LastViewedAt_() float64 {
+	return float64(o.LastViewedAt)
+}
+
+func (o *ThreadResponse) UnreadReplies_() float64 {
+	return float64(o.UnreadReplies)
+}
+
+func (o *ThreadResponse) UnreadMentions_() float64 {
+	return float64(o.UnreadMentions)
+}
+
 // ThreadMembership models the relationship between a user and a thread of posts, with a similar
 // data structure as ChannelMembership.
 type ThreadMembership struct {
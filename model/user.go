@@ -849,7 +849,8 @@ func (u *User) IsOAuthUser() bool {
 	return u.AuthService == ServiceGitlab ||
 		u.AuthService == ServiceGoogle ||
 		u.AuthService == ServiceOffice365 ||
-		u.AuthService == ServiceOpenid
+		u.AuthService == ServiceOpenid ||
+		u.AuthService == ServiceOIDC
 }
 
 func (u *User) IsLDAPUser() bool {
@@ -42,6 +42,21 @@ type UserGetOptions struct {
 	PerPage int
 }
 
+// UserGetByAuthServiceOptions filters and pages the users signing in with an authentication
+// service.
+type UserGetByAuthServiceOptions struct {
+	// Filters the users with the given auth data
+	AuthData string
+	// Filters the users with the given email
+	Email string
+	// Filters the users with the given username
+	Username string
+	// Number of users to skip
+	Offset int
+	// Page size
+	Limit int
+}
+
 type UserGetByIdsOptions struct {
 	// Since filters the users based on their UpdateAt timestamp.
 	Since int64
@@ -0,0 +1,125 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	OffboardingStepReassignIntegrations    = "reassign_integrations"
+	OffboardingStepReassignPlaybookRuns    = "reassign_playbook_runs"
+	OffboardingStepTransferPrivateChannels = "transfer_private_channels"
+	OffboardingStepRemoveFromChannels      = "remove_from_channels"
+
+	OffboardingStepStatusCompleted = "completed"
+	OffboardingStepStatusSkipped   = "skipped"
+	OffboardingStepStatusFailed    = "failed"
+
+	// OffboardingJobDataReport is the key of the job data holding the report of a user_offboarding job.
+	OffboardingJobDataReport = "report"
+)
+
+// UserOffboardingRequest starts the offboarding of a deactivated user. The successor is who the
+// integrations, Playbooks runs and private channels of the user are handed over to.
+type UserOffboardingRequest struct {
+	SuccessorId string `json:"successor_id"`
+}
+
+func (r *UserOffboardingRequest) IsValid() *AppError {
+	if r.SuccessorId != "" && !IsValidId(r.SuccessorId) {
+		return NewAppError("UserOffboardingRequest.IsValid", "model.user_offboarding.is_valid.successor_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// OffboardingFailure is something an offboarding step couldn't hand over or remove the user from.
+type OffboardingFailure struct {
+	Id    string `json:"id"`
+	Error string `json:"error"`
+}
+
+// OffboardingStepResult tells what an offboarding step did. Ids lists what was handed over to the
+// successor, or the channels the user was removed from.
+type OffboardingStepResult struct {
+	Step     string               `json:"step"`
+	Status   string               `json:"status"`
+	Ids      []string             `json:"ids"`
+	Failures []OffboardingFailure `json:"failures"`
+}
+
+// Add records that the step handled the item.
+func (r *OffboardingStepResult) Add(id string) {
+	r.Ids = append(r.Ids, id)
+}
+
+// Fail records that the step couldn't handle the item, failing the step.
+func (r *OffboardingStepResult) Fail(id string, err error) {
+	r.Status = OffboardingStepStatusFailed
+	r.Failures = append(r.Failures, OffboardingFailure{Id: id, Error: err.Error()})
+}
+
+// OffboardingReport describes what a user_offboarding job did for a deactivated user. The job
+// fields are filled in from the job the report is read from.
+type OffboardingReport struct {
+	UserId      string                   `json:"user_id"`
+	SuccessorId string                   `json:"successor_id"`
+	JobId       string                   `json:"job_id"`
+	JobStatus   string                   `json:"job_status"`
+	CreateAt    int64                    `json:"create_at"`
+	Steps       []*OffboardingStepResult `json:"steps"`
+}
+
+func NewOffboardingReport(userID, successorID string) *OffboardingReport {
+	return &OffboardingReport{
+		UserId:      userID,
+		SuccessorId: successorID,
+		Steps:       []*OffboardingStepResult{},
+	}
+}
+
+// AddStep adds the result of a step to the report, as completed until a failure is recorded.
+func (r *OffboardingReport) AddStep(step string) *OffboardingStepResult {
+	result := &OffboardingStepResult{
+		Step:     step,
+		Status:   OffboardingStepStatusCompleted,
+		Ids:      []string{},
+		Failures: []OffboardingFailure{},
+	}
+	r.Steps = append(r.Steps, result)
+	return result
+}
+
+// SkipStep adds a step that didn't run to the report.
+func (r *OffboardingReport) SkipStep(step string) {
+	r.AddStep(step).Status = OffboardingStepStatusSkipped
+}
+
+// HasFailures reports whether any step failed.
+func (r *OffboardingReport) HasFailures() bool {
+	for _, step := range r.Steps {
+		if step.Status == OffboardingStepStatusFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// OffboardingReportFromJob returns the report of a user_offboarding job, which has no steps until
+// the job is done.
+func OffboardingReportFromJob(job *Job) (*OffboardingReport, error) {
+	report := NewOffboardingReport(job.Data["user_id"], job.Data["successor_id"])
+	if data := job.Data[OffboardingJobDataReport]; data != "" {
+		if err := json.Unmarshal([]byte(data), report); err != nil {
+			return nil, err
+		}
+	}
+
+	report.JobId = job.Id
+	report.JobStatus = job.Status
+	report.CreateAt = job.CreateAt
+	return report, nil
+}
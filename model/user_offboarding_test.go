@@ -0,0 +1,79 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserOffboardingRequestIsValid(t *testing.T) {
+	assert.Nil(t, (&UserOffboardingRequest{}).IsValid())
+	assert.Nil(t, (&UserOffboardingRequest{SuccessorId: NewId()}).IsValid())
+	assert.NotNil(t, (&UserOffboardingRequest{SuccessorId: "invalid"}).IsValid())
+}
+
+func TestOffboardingReport(t *testing.T) {
+	report := NewOffboardingReport(NewId(), NewId())
+
+	integrations := report.AddStep(OffboardingStepReassignIntegrations)
+	integrations.Add("command")
+	report.SkipStep(OffboardingStepReassignPlaybookRuns)
+	assert.False(t, report.HasFailures())
+
+	channels := report.AddStep(OffboardingStepRemoveFromChannels)
+	channels.Add("channel1")
+	channels.Fail("channel2", errors.New("can't leave"))
+	assert.True(t, report.HasFailures())
+
+	require.Len(t, report.Steps, 3)
+	assert.Equal(t, OffboardingStepStatusCompleted, report.Steps[0].Status)
+	assert.Equal(t, OffboardingStepStatusSkipped, report.Steps[1].Status)
+	assert.Equal(t, OffboardingStepStatusFailed, report.Steps[2].Status)
+	assert.Equal(t, []OffboardingFailure{{Id: "channel2", Error: "can't leave"}}, report.Steps[2].Failures)
+}
+
+func TestOffboardingReportFromJob(t *testing.T) {
+	userID := NewId()
+	job := &Job{
+		Id:       NewId(),
+		Status:   JobStatusPending,
+		CreateAt: GetMillis(),
+		Data:     map[string]string{"user_id": userID, "successor_id": ""},
+	}
+
+	t.Run("pending job", func(t *testing.T) {
+		report, err := OffboardingReportFromJob(job)
+		require.NoError(t, err)
+		assert.Equal(t, userID, report.UserId)
+		assert.Equal(t, job.Id, report.JobId)
+		assert.Equal(t, JobStatusPending, report.JobStatus)
+		assert.Empty(t, report.Steps)
+	})
+
+	t.Run("done job", func(t *testing.T) {
+		done := NewOffboardingReport(userID, "")
+		done.AddStep(OffboardingStepRemoveFromChannels).Add("channel")
+		data, err := json.Marshal(done)
+		require.NoError(t, err)
+		job.Data[OffboardingJobDataReport] = string(data)
+		job.Status = JobStatusSuccess
+
+		report, err := OffboardingReportFromJob(job)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSuccess, report.JobStatus)
+		require.Len(t, report.Steps, 1)
+		assert.Equal(t, []string{"channel"}, report.Steps[0].Ids)
+	})
+
+	t.Run("invalid report", func(t *testing.T) {
+		job.Data[OffboardingJobDataReport] = "{"
+		_, err := OffboardingReportFromJob(job)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	// WebAuthnCredentialIdMaxLength is the length of the longest base64url encoded credential id
+	// accepted, which fits the ids of the common authenticators.
+	WebAuthnCredentialIdMaxLength  = 512
+	WebAuthnCredentialNameMaxRunes = 64
+
+	// WebAuthnChallengeExpiry is how long the clients have to answer a challenge, in milliseconds.
+	WebAuthnChallengeExpiry = 5 * 60 * 1000
+
+	WebAuthnCredentialType            = "public-key"
+	WebAuthnAttestationNone           = "none"
+	WebAuthnResidentKeyPreferred      = "preferred"
+	WebAuthnUserVerificationPreferred = "preferred"
+	WebAuthnUserVerificationRequired  = "required"
+)
+
+// WebAuthnCredential is a passkey or security key registered by a user, to sign in or as a second
+// factor.
+type WebAuthnCredential struct {
+	Id     string `json:"id"`
+	UserId string `json:"user_id"`
+	// CredentialId is the base64url encoded id the authenticator gave the credential.
+	CredentialId string `json:"credential_id"`
+	// PublicKey is the COSE encoded public key of the credential.
+	PublicKey  []byte `json:"-"`
+	SignCount  int64  `json:"-"`
+	Name       string `json:"name"`
+	CreateAt   int64  `json:"create_at"`
+	LastUsedAt int64  `json:"last_used_at"`
+}
+
+func (o *WebAuthnCredential) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.UserId) {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.user_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CredentialId == "" || len(o.CredentialId) > WebAuthnCredentialIdMaxLength {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.credential_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.PublicKey) == 0 {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.public_key.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.Name == "" || utf8.RuneCountInString(o.Name) > WebAuthnCredentialNameMaxRunes {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.name.app_error", map[string]any{"MaxRunes": WebAuthnCredentialNameMaxRunes}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *WebAuthnCredential) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+// WebAuthnCredentialDescriptor identifies a credential to the authenticators.
+type WebAuthnCredentialDescriptor struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+type WebAuthnRelyingParty struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type WebAuthnUser struct {
+	// Id is the base64url encoded user handle, which is the id of the user.
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type WebAuthnCredentialParameters struct {
+	Type string `json:"type"`
+	Alg  int    `json:"alg"`
+}
+
+type WebAuthnAuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// WebAuthnCreationOptions are the options to pass to navigator.credentials.create() for a user
+// to register a credential. The binary values are base64url encoded, as in the JSON serialization
+// of WebAuthn Level 3.
+type WebAuthnCreationOptions struct {
+	Challenge              string                         `json:"challenge"`
+	RP                     WebAuthnRelyingParty           `json:"rp"`
+	User                   WebAuthnUser                   `json:"user"`
+	PubKeyCredParams       []WebAuthnCredentialParameters `json:"pubKeyCredParams"`
+	Timeout                int64                          `json:"timeout"`
+	ExcludeCredentials     []WebAuthnCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection WebAuthnAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                         `json:"attestation"`
+}
+
+// WebAuthnRequestOptions are the options to pass to navigator.credentials.get() for a user to
+// sign in. AllowCredentials is empty when any passkey of the site may be used.
+type WebAuthnRequestOptions struct {
+	Challenge        string                         `json:"challenge"`
+	Timeout          int64                          `json:"timeout"`
+	RPId             string                         `json:"rpId"`
+	AllowCredentials []WebAuthnCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                         `json:"userVerification"`
+}
+
+// WebAuthnRegistrationResponse is the credential returned by navigator.credentials.create().
+type WebAuthnRegistrationResponse struct {
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AttestationObject string `json:"attestationObject"`
+	} `json:"response"`
+}
+
+// WebAuthnAssertionResponse is the credential returned by navigator.credentials.get().
+type WebAuthnAssertionResponse struct {
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+		UserHandle        string `json:"userHandle"`
+	} `json:"response"`
+}
+
+// WebAuthnRegistrationRequest completes the registration of a credential.
+type WebAuthnRegistrationRequest struct {
+	Name       string                        `json:"name"`
+	Credential *WebAuthnRegistrationResponse `json:"credential"`
+}
+
+// WebAuthnLoginRequest signs a user in with a passkey.
+type WebAuthnLoginRequest struct {
+	Credential *WebAuthnAssertionResponse `json:"credential"`
+	DeviceId   string                     `json:"device_id"`
+}
+
+// WebAuthnLoginBeginRequest asks for the options to sign in with. The credentials of the user
+// with the given login id are listed when it is set, for them to be used as a second factor.
+type WebAuthnLoginBeginRequest struct {
+	LoginId string `json:"login_id"`
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebAuthnCredentialIsValid(t *testing.T) {
+	credential := &WebAuthnCredential{
+		UserId:       NewId(),
+		CredentialId: "AAECAwQFBgcICQoLDA0ODw",
+		PublicKey:    []byte{0xa5},
+		Name:         "Security key",
+	}
+	credential.PreSave()
+	require.Nil(t, credential.IsValid())
+
+	credential.CredentialId = strings.Repeat("a", WebAuthnCredentialIdMaxLength+1)
+	require.NotNil(t, credential.IsValid())
+	credential.CredentialId = "AAECAwQFBgcICQoLDA0ODw"
+
+	credential.PublicKey = nil
+	require.NotNil(t, credential.IsValid())
+	credential.PublicKey = []byte{0xa5}
+
+	credential.Name = ""
+	require.NotNil(t, credential.IsValid())
+	credential.Name = strings.Repeat("é", WebAuthnCredentialNameMaxRunes)
+	require.Nil(t, credential.IsValid())
+	credential.Name += "é"
+	require.NotNil(t, credential.IsValid())
+	credential.Name = "Security key"
+
+	credential.CreateAt = 0
+	require.NotNil(t, credential.IsValid())
+}
+
+func TestWebAuthnCredentialJSON(t *testing.T) {
+	credential := &WebAuthnCredential{Id: NewId(), PublicKey: []byte{0xa5}, SignCount: 3}
+	b, err := json.Marshal(credential)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "public_key")
+	assert.NotContains(t, string(b), "PublicKey")
+	assert.NotContains(t, string(b), "SignCount")
+}
@@ -86,6 +86,12 @@ func NewWebSocketClientWithDialer(dialer *websocket.Dialer, url, authToken strin
 	return makeClient(dialer, url, url+APIURLSuffix+"/websocket", authToken, nil)
 }
 
+// NewWebSocketClientWithEncoding constructs a new WebSocket client which asks the server
+// to send its messages in the given encoding, like WebSocketEncodingMsgpack.
+func NewWebSocketClientWithEncoding(dialer *websocket.Dialer, url, authToken, encoding string) (*WebSocketClient, error) {
+	return makeClient(dialer, url, url+APIURLSuffix+"/websocket?encoding="+encoding, authToken, nil)
+}
+
 func makeClient(dialer *websocket.Dialer, url, connectURL, authToken string, header http.Header) (*WebSocketClient, error) {
 	conn, _, err := dialer.Dial(connectURL, header)
 	if err != nil {
@@ -232,7 +238,7 @@ func (wsc *WebSocketClient) Listen() {
 		for {
 			// Reset buffer.
 			buf.Reset()
-			_, r, err := wsc.Conn.NextReader()
+			msgType, r, err := wsc.Conn.NextReader()
 			if err != nil {
 				if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseNoStatusReceived) {
 					wsc.ListenError = NewAppError("NewWebSocketClient", "model.websocket_client.connect_fail.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -249,6 +255,11 @@ func (wsc *WebSocketClient) Listen() {
 				return
 			}
 
+			if msgType == websocket.BinaryMessage {
+				wsc.handleBinaryMessage(buf.Bytes())
+				continue
+			}
+
 			event, jsonErr := WebSocketEventFromJSON(bytes.NewReader(buf.Bytes()))
 			if jsonErr != nil {
 				mlog.Warn("Failed to decode from JSON", mlog.Err(jsonErr))
@@ -268,6 +279,23 @@ func (wsc *WebSocketClient) Listen() {
 	}()
 }
 
+// handleBinaryMessage dispatches a MessagePack encoded event or response.
+func (wsc *WebSocketClient) handleBinaryMessage(data []byte) {
+	event, err := WebSocketEventFromMsgpack(bytes.NewReader(data))
+	if err != nil {
+		mlog.Warn("Failed to decode from MessagePack", mlog.Err(err))
+		return
+	}
+	if event.IsValid() {
+		wsc.EventChannel <- event
+		return
+	}
+
+	if response, err := WebSocketResponseFromMsgpack(bytes.NewReader(data)); err == nil && response.IsValid() {
+		wsc.ResponseChannel <- response
+	}
+}
+
 func (wsc *WebSocketClient) SendMessage(action string, data map[string]any) {
 	req := &WebSocketRequest{}
 	req.Seq = wsc.Sequence
@@ -326,6 +354,25 @@ func (wsc *WebSocketClient) GetStatusesByIds(userIds []string) {
 	wsc.SendMessage("get_statuses_by_ids", data)
 }
 
+// SubscribePresence replaces the users whose status changes are pushed to this
+// connection. The response contains their current statuses.
+func (wsc *WebSocketClient) SubscribePresence(userIds []string) {
+	data := map[string]any{
+		"user_ids": userIds,
+	}
+	wsc.SendMessage(WebsocketPresenceSubscribe, data)
+}
+
+// GetMissedEvents asks the server to resend the events starting at the given
+// sequence number, so a gap in the received sequence can be filled without
+// reconnecting
+func (wsc *WebSocketClient) GetMissedEvents(sequence int64) {
+	data := map[string]any{
+		"sequence_number": sequence,
+	}
+	wsc.SendMessage(WebsocketGetMissedEvents, data)
+}
+
 func (wsc *WebSocketClient) configurePingHandling() {
 	wsc.Conn.SetPingHandler(wsc.pingHandler)
 	wsc.pingTimeoutTimer = time.NewTimer(time.Second * (60 + PingTimeoutBufferSeconds))
@@ -7,10 +7,20 @@ import (
 	"encoding/json"
 	"io"
 	"strconv"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const (
+	// WebSocketEncodingJSON is the default encoding of the messages sent to websocket clients.
+	WebSocketEncodingJSON = "json"
+	// WebSocketEncodingMsgpack sends messages as binary MessagePack frames instead.
+	WebSocketEncodingMsgpack = "msgpack"
 )
 
 const (
 	WebsocketEventTyping                              = "typing"
+	WebsocketEventTypingSummary                       = "typing_summary"
 	WebsocketEventPosted                              = "posted"
 	WebsocketEventPostEdited                          = "post_edited"
 	WebsocketEventPostDeleted                         = "post_deleted"
@@ -43,6 +53,8 @@ const (
 	WebsocketEventStatusChange                        = "status_change"
 	WebsocketEventHello                               = "hello"
 	WebsocketAuthenticationChallenge                  = "authentication_challenge"
+	WebsocketGetMissedEvents                          = "get_missed_events"
+	WebsocketPresenceSubscribe                        = "presence_subscribe"
 	WebsocketEventReactionAdded                       = "reaction_added"
 	WebsocketEventReactionRemoved                     = "reaction_removed"
 	WebsocketEventResponse                            = "response"
@@ -82,6 +94,19 @@ const (
 	WebsocketEventAcknowledgementAdded                = "post_acknowledgement_added"
 	WebsocketEventAcknowledgementRemoved              = "post_acknowledgement_removed"
 	WebsocketEventHostedCustomerSignupProgressUpdated = "hosted_customer_signup_progress_updated"
+	WebsocketEventScheduledPostCreated                = "scheduled_post_created"
+	WebsocketEventScheduledPostUpdated                = "scheduled_post_updated"
+	WebsocketEventScheduledPostDeleted                = "scheduled_post_deleted"
+	WebsocketEventPostRead                            = "post_read"
+	WebsocketEventPinnedPostsOrderChanged             = "pinned_posts_order_changed"
+	WebsocketEventChannelBookmarkCreated              = "channel_bookmark_created"
+	WebsocketEventChannelBookmarkUpdated              = "channel_bookmark_updated"
+	WebsocketEventChannelBookmarkDeleted              = "channel_bookmark_deleted"
+	WebsocketEventChannelBookmarkSorted               = "channel_bookmark_sorted"
+	WebsocketEventActivityAdded                       = "activity_added"
+	WebsocketEventActivitiesRead                      = "activities_read"
+	WebsocketEventChannelJoinRequestCreated           = "channel_join_request_created"
+	WebsocketEventChannelJoinRequestUpdated           = "channel_join_request_updated"
 )
 
 type WebSocketMessage interface {
@@ -305,6 +330,16 @@ func (ev *WebSocketEvent) Encode(enc *json.Encoder) error {
 	})
 }
 
+// EncodeMsgpack encodes the event to the given MessagePack encoder.
+func (ev *WebSocketEvent) EncodeMsgpack(enc *msgpack.Encoder) error {
+	return enc.Encode(webSocketEventJSON{
+		ev.event,
+		ev.data,
+		ev.broadcast,
+		ev.sequence,
+	})
+}
+
 // We write optimal code here sacrificing readability for
 // performance.
 func (ev *WebSocketEvent) precomputedJSONBuf() []byte {
@@ -346,6 +381,36 @@ func WebSocketEventFromJSON(data io.Reader) (*WebSocketEvent, error) {
 	return &ev, nil
 }
 
+func WebSocketEventFromMsgpack(data io.Reader) (*WebSocketEvent, error) {
+	var o webSocketEventJSON
+	if err := NewWebSocketMsgpackDecoder(data).Decode(&o); err != nil {
+		return nil, err
+	}
+	return &WebSocketEvent{
+		event:     o.Event,
+		data:      o.Data,
+		broadcast: o.Broadcast,
+		sequence:  o.Sequence,
+	}, nil
+}
+
+// NewWebSocketMsgpackEncoder returns a MessagePack encoder which names fields after their
+// JSON tags, so that messages have the same shape in both websocket encodings.
+func NewWebSocketMsgpackEncoder(w io.Writer) *msgpack.Encoder {
+	enc := msgpack.NewEncoder(w)
+	enc.SetCustomStructTag("json")
+	enc.UseCompactInts(true)
+	return enc
+}
+
+// NewWebSocketMsgpackDecoder returns a MessagePack decoder for messages written by an encoder
+// from NewWebSocketMsgpackEncoder.
+func NewWebSocketMsgpackDecoder(r io.Reader) *msgpack.Decoder {
+	dec := msgpack.NewDecoder(r)
+	dec.SetCustomStructTag("json")
+	return dec
+}
+
 // WebSocketResponse represents a response received through the WebSocket
 // for a request made to the server. This is available through the ResponseChannel
 // channel in WebSocketClient.
@@ -384,3 +449,8 @@ func WebSocketResponseFromJSON(data io.Reader) (*WebSocketResponse, error) {
 	var o *WebSocketResponse
 	return o, json.NewDecoder(data).Decode(&o)
 }
+
+func WebSocketResponseFromMsgpack(data io.Reader) (*WebSocketResponse, error) {
+	var o *WebSocketResponse
+	return o, NewWebSocketMsgpackDecoder(data).Decode(&o)
+}
@@ -91,6 +91,38 @@ func TestWebSocketEventFromJSON(t *testing.T) {
 	require.Equal(t, ev.GetBroadcast(), &WebsocketBroadcast{UserId: "userid"})
 }
 
+func TestWebSocketEventMsgpack(t *testing.T) {
+	ev := NewWebSocketEvent("test", "", "channelid", "", nil, "")
+	ev.Add("key", "val")
+	ev = ev.SetSequence(45)
+
+	var buf bytes.Buffer
+	require.NoError(t, ev.EncodeMsgpack(NewWebSocketMsgpackEncoder(&buf)))
+
+	decoded, err := WebSocketEventFromMsgpack(&buf)
+	require.NoError(t, err)
+	require.Equal(t, "test", decoded.EventType())
+	require.Equal(t, int64(45), decoded.GetSequence())
+	require.Equal(t, map[string]any{"key": "val"}, decoded.GetData())
+	require.Equal(t, "channelid", decoded.GetBroadcast().ChannelId)
+
+	_, err = WebSocketEventFromMsgpack(bytes.NewReader([]byte("junk")))
+	require.Error(t, err)
+}
+
+func TestWebSocketResponseMsgpack(t *testing.T) {
+	m := NewWebSocketResponse(StatusOk, 3, map[string]any{"count": 2})
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWebSocketMsgpackEncoder(&buf).Encode(m))
+
+	result, err := WebSocketResponseFromMsgpack(&buf)
+	require.NoError(t, err)
+	require.True(t, result.IsValid())
+	require.Equal(t, int64(3), result.SeqReply)
+	require.EqualValues(t, 2, result.Data["count"])
+}
+
 func TestWebSocketResponse(t *testing.T) {
 	m := NewWebSocketResponse("OK", 1, map[string]any{})
 	e := NewWebSocketError(1, &AppError{})
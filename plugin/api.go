@@ -1186,6 +1186,30 @@ type API interface {
 	// @tag Upload
 	// Minimum server version: 7.6
 	GetUploadSession(uploadID string) (*model.UploadSession, error)
+
+	// CampaignForLeadership makes the instance of the plugin on this node the leader of the
+	// named election when there is no leader, or renews its leadership when it's the leader
+	// already, returning whether it's the leader. The leadership is lost when it's not renewed
+	// within model.LeaderElectionLeaseSeconds, so the instances of the plugin should campaign
+	// more often than that, e.g. before each run of the task only the leader runs.
+	//
+	// @tag Cluster
+	// Minimum server version: 7.10
+	CampaignForLeadership(name string) (bool, error)
+
+	// ResignLeadership gives up the leadership of the named election when the instance of the
+	// plugin on this node is the leader, letting another instance be elected.
+	//
+	// @tag Cluster
+	// Minimum server version: 7.10
+	ResignLeadership(name string) error
+
+	// GetLeader returns the ID of the node whose instance of the plugin is the leader of the
+	// named election, empty when there is no leader.
+	//
+	// @tag Cluster
+	// Minimum server version: 7.10
+	GetLeader(name string) (string, error)
 }
 
 var handshake = plugin.HandshakeConfig{
@@ -1267,3 +1267,24 @@ func (api *apiTimerLayer) GetUploadSession(uploadID string) (*model.UploadSessio
 	api.recordTime(startTime, "GetUploadSession", _returnsB == nil)
 	return _returnsA, _returnsB
 }
+
+func (api *apiTimerLayer) CampaignForLeadership(name string) (bool, error) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.CampaignForLeadership(name)
+	api.recordTime(startTime, "CampaignForLeadership", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
+func (api *apiTimerLayer) ResignLeadership(name string) error {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.ResignLeadership(name)
+	api.recordTime(startTime, "ResignLeadership", _returnsA == nil)
+	return _returnsA
+}
+
+func (api *apiTimerLayer) GetLeader(name string) (string, error) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.GetLeader(name)
+	api.recordTime(startTime, "GetLeader", _returnsB == nil)
+	return _returnsA, _returnsB
+}
@@ -6028,3 +6028,92 @@ func (s *apiRPCServer) GetUploadSession(args *Z_GetUploadSessionArgs, returns *Z
 	}
 	return nil
 }
+
+type Z_CampaignForLeadershipArgs struct {
+	A string
+}
+
+type Z_CampaignForLeadershipReturns struct {
+	A bool
+	B error
+}
+
+func (g *apiRPCClient) CampaignForLeadership(name string) (bool, error) {
+	_args := &Z_CampaignForLeadershipArgs{name}
+	_returns := &Z_CampaignForLeadershipReturns{}
+	if err := g.client.Call("Plugin.CampaignForLeadership", _args, _returns); err != nil {
+		log.Printf("RPC call to CampaignForLeadership API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) CampaignForLeadership(args *Z_CampaignForLeadershipArgs, returns *Z_CampaignForLeadershipReturns) error {
+	if hook, ok := s.impl.(interface {
+		CampaignForLeadership(name string) (bool, error)
+	}); ok {
+		returns.A, returns.B = hook.CampaignForLeadership(args.A)
+		returns.B = encodableError(returns.B)
+	} else {
+		return encodableError(fmt.Errorf("API CampaignForLeadership called but not implemented."))
+	}
+	return nil
+}
+
+type Z_ResignLeadershipArgs struct {
+	A string
+}
+
+type Z_ResignLeadershipReturns struct {
+	A error
+}
+
+func (g *apiRPCClient) ResignLeadership(name string) error {
+	_args := &Z_ResignLeadershipArgs{name}
+	_returns := &Z_ResignLeadershipReturns{}
+	if err := g.client.Call("Plugin.ResignLeadership", _args, _returns); err != nil {
+		log.Printf("RPC call to ResignLeadership API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) ResignLeadership(args *Z_ResignLeadershipArgs, returns *Z_ResignLeadershipReturns) error {
+	if hook, ok := s.impl.(interface {
+		ResignLeadership(name string) error
+	}); ok {
+		returns.A = hook.ResignLeadership(args.A)
+		returns.A = encodableError(returns.A)
+	} else {
+		return encodableError(fmt.Errorf("API ResignLeadership called but not implemented."))
+	}
+	return nil
+}
+
+type Z_GetLeaderArgs struct {
+	A string
+}
+
+type Z_GetLeaderReturns struct {
+	A string
+	B error
+}
+
+func (g *apiRPCClient) GetLeader(name string) (string, error) {
+	_args := &Z_GetLeaderArgs{name}
+	_returns := &Z_GetLeaderReturns{}
+	if err := g.client.Call("Plugin.GetLeader", _args, _returns); err != nil {
+		log.Printf("RPC call to GetLeader API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) GetLeader(args *Z_GetLeaderArgs, returns *Z_GetLeaderReturns) error {
+	if hook, ok := s.impl.(interface {
+		GetLeader(name string) (string, error)
+	}); ok {
+		returns.A, returns.B = hook.GetLeader(args.A)
+		returns.B = encodableError(returns.B)
+	} else {
+		return encodableError(fmt.Errorf("API GetLeader called but not implemented."))
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// ActivationOrder orders the activation of the plugins by their dependencies. It returns the
+// plugins in batches to be activated one after the other, the plugins of a batch depending on
+// plugins of the previous batches only.
+//
+// The plugins depending on each other in a cycle, and the plugins depending on them, are returned
+// in a last batch along with an error describing the cycle.
+func ActivationOrder(plugins []*model.BundleInfo) ([][]*model.BundleInfo, error) {
+	byID := make(map[string]*model.BundleInfo, len(plugins))
+	for _, plugin := range plugins {
+		if plugin.Manifest != nil {
+			byID[plugin.Manifest.Id] = plugin
+		}
+	}
+
+	// The number of dependencies of each plugin left to activate, and the plugins depending on it.
+	pending := make(map[string]int, len(byID))
+	dependents := make(map[string][]string, len(byID))
+	for id, plugin := range byID {
+		pending[id] = 0
+		for _, dependency := range plugin.Manifest.Dependencies {
+			if _, ok := byID[dependency.Id]; ok {
+				pending[id]++
+				dependents[dependency.Id] = append(dependents[dependency.Id], id)
+			}
+		}
+	}
+
+	var batches [][]*model.BundleInfo
+	for len(pending) > 0 {
+		var ids []string
+		for id, count := range pending {
+			if count == 0 {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			break
+		}
+		sort.Strings(ids)
+
+		batch := make([]*model.BundleInfo, 0, len(ids))
+		for _, id := range ids {
+			batch = append(batch, byID[id])
+			delete(pending, id)
+			for _, dependent := range dependents[id] {
+				pending[dependent]--
+			}
+		}
+		batches = append(batches, batch)
+	}
+
+	if len(pending) == 0 {
+		return batches, nil
+	}
+
+	ids := make([]string, 0, len(pending))
+	for id := range pending {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	batch := make([]*model.BundleInfo, 0, len(ids))
+	for _, id := range ids {
+		batch = append(batch, byID[id])
+	}
+	batches = append(batches, batch)
+
+	return batches, fmt.Errorf("circular dependency between plugins: %s", strings.Join(ids, ", "))
+}
+
+// SetProducts saves the products running along with the plugins, by name with their version,
+// for the plugins to depend on.
+func (env *Environment) SetProducts(products map[string]string) {
+	env.productsLock.Lock()
+	env.products = products
+	env.productsLock.Unlock()
+}
+
+func (env *Environment) productVersion(name string) (string, bool) {
+	env.productsLock.RLock()
+	defer env.productsLock.RUnlock()
+
+	version, ok := env.products[name]
+	return version, ok
+}
+
+func (env *Environment) activeVersion(id string) (string, bool) {
+	if version, ok := env.productVersion(id); ok {
+		return version, true
+	}
+
+	rp, ok := env.registeredPlugins.Load(id)
+	if !ok || rp.(registeredPlugin).State != model.PluginStateRunning {
+		return "", false
+	}
+	return rp.(registeredPlugin).BundleInfo.Manifest.Version, true
+}
+
+// CheckDependencies checks that the dependencies of the plugin are met, its required dependencies
+// being active and all its active dependencies meeting their minimum version.
+func (env *Environment) CheckDependencies(manifest *model.Manifest) error {
+	for _, dependency := range manifest.Dependencies {
+		version, active := env.activeVersion(dependency.Id)
+		if !active {
+			if dependency.Optional {
+				continue
+			}
+			return fmt.Errorf("plugin requires %v to be active: %v", dependency.Id, manifest.Id)
+		}
+
+		fulfilled, err := dependency.MeetMinVersion(version)
+		if err != nil {
+			return fmt.Errorf("%v: %v", err.Error(), manifest.Id)
+		}
+		if !fulfilled {
+			return fmt.Errorf("plugin requires %v %v, found %v: %v", dependency.Id, dependency.MinVersion, version, manifest.Id)
+		}
+	}
+
+	return nil
+}
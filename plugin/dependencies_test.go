@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func newDependentBundle(id, version string, dependencies ...*model.ManifestDependency) *model.BundleInfo {
+	return &model.BundleInfo{
+		Manifest: &model.Manifest{
+			Id:           id,
+			Version:      version,
+			Dependencies: dependencies,
+		},
+	}
+}
+
+func bundleIDs(batches [][]*model.BundleInfo) [][]string {
+	ids := make([][]string, 0, len(batches))
+	for _, batch := range batches {
+		batchIDs := make([]string, 0, len(batch))
+		for _, bundle := range batch {
+			batchIDs = append(batchIDs, bundle.Manifest.Id)
+		}
+		ids = append(ids, batchIDs)
+	}
+	return ids
+}
+
+func TestActivationOrder(t *testing.T) {
+	t.Run("no dependencies", func(t *testing.T) {
+		batches, err := ActivationOrder([]*model.BundleInfo{
+			newDependentBundle("b", "1.0.0"),
+			newDependentBundle("a", "1.0.0"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"a", "b"}}, bundleIDs(batches))
+	})
+
+	t.Run("dependencies", func(t *testing.T) {
+		batches, err := ActivationOrder([]*model.BundleInfo{
+			newDependentBundle("a", "1.0.0", &model.ManifestDependency{Id: "b"}, &model.ManifestDependency{Id: "c", Optional: true}),
+			newDependentBundle("b", "1.0.0", &model.ManifestDependency{Id: "c"}),
+			newDependentBundle("c", "1.0.0", &model.ManifestDependency{Id: "boards"}),
+			newDependentBundle("d", "1.0.0", &model.ManifestDependency{Id: "missing"}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"c", "d"}, {"b"}, {"a"}}, bundleIDs(batches))
+	})
+
+	t.Run("circular dependency", func(t *testing.T) {
+		batches, err := ActivationOrder([]*model.BundleInfo{
+			newDependentBundle("a", "1.0.0", &model.ManifestDependency{Id: "b"}),
+			newDependentBundle("b", "1.0.0", &model.ManifestDependency{Id: "a"}),
+			newDependentBundle("c", "1.0.0", &model.ManifestDependency{Id: "a"}),
+			newDependentBundle("d", "1.0.0"),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "a, b, c")
+		assert.Equal(t, [][]string{{"d"}, {"a", "b", "c"}}, bundleIDs(batches))
+	})
+}
+
+func TestCheckDependencies(t *testing.T) {
+	env := &Environment{}
+	env.SetProducts(map[string]string{"boards": "7.10.0"})
+
+	env.registeredPlugins.Store("active", registeredPlugin{
+		BundleInfo: newDependentBundle("active", "1.2.0"),
+		State:      model.PluginStateRunning,
+	})
+	env.registeredPlugins.Store("failed", registeredPlugin{
+		BundleInfo: newDependentBundle("failed", "1.2.0"),
+		State:      model.PluginStateFailedToStart,
+	})
+
+	for name, test := range map[string]struct {
+		Dependency  *model.ManifestDependency
+		ShouldError bool
+	}{
+		"active plugin":                  {Dependency: &model.ManifestDependency{Id: "active"}},
+		"active plugin min version":      {Dependency: &model.ManifestDependency{Id: "active", MinVersion: "1.2.0"}},
+		"active plugin too old":          {Dependency: &model.ManifestDependency{Id: "active", MinVersion: "1.3.0"}, ShouldError: true},
+		"optional active plugin too old": {Dependency: &model.ManifestDependency{Id: "active", MinVersion: "1.3.0", Optional: true}, ShouldError: true},
+		"failed plugin":                  {Dependency: &model.ManifestDependency{Id: "failed"}, ShouldError: true},
+		"optional failed plugin":         {Dependency: &model.ManifestDependency{Id: "failed", Optional: true}},
+		"missing plugin":                 {Dependency: &model.ManifestDependency{Id: "missing"}, ShouldError: true},
+		"optional missing plugin":        {Dependency: &model.ManifestDependency{Id: "missing", Optional: true}},
+		"product":                        {Dependency: &model.ManifestDependency{Id: "boards", MinVersion: "7.9.0"}},
+		"product too old":                {Dependency: &model.ManifestDependency{Id: "boards", MinVersion: "7.11.0"}, ShouldError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := env.CheckDependencies(&model.Manifest{Id: "dependent", Dependencies: []*model.ManifestDependency{test.Dependency}})
+			if test.ShouldError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.Dependency.Id)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
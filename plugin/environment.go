@@ -62,6 +62,8 @@ type Environment struct {
 	patchReactDOM          bool
 	prepackagedPlugins     []*PrepackagedPlugin
 	prepackagedPluginsLock sync.RWMutex
+	products               map[string]string
+	productsLock           sync.RWMutex
 }
 
 func NewEnvironment(
@@ -316,6 +318,10 @@ func (env *Environment) Activate(id string) (manifest *model.Manifest, activated
 		}
 	}
 
+	if err := env.CheckDependencies(pluginInfo.Manifest); err != nil {
+		return nil, false, err
+	}
+
 	componentActivated := false
 
 	if pluginInfo.Manifest.HasWebapp() {
@@ -0,0 +1,110 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitActivity() {
+	api.BaseRoutes.User.Handle("/activity", api.APISessionRequired(getActivityFeed)).Methods("GET")
+	api.BaseRoutes.User.Handle("/activity/read", api.APISessionRequired(markActivitiesAsRead)).Methods("POST")
+}
+
+func getActivityFeed(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	options := model.ActivityGetOptions{
+		Page:    c.Params.Page,
+		PerPage: c.Params.PerPage,
+	}
+
+	if typesStr := r.URL.Query().Get("types"); typesStr != "" {
+		for _, activityType := range strings.Split(typesStr, ",") {
+			activityType = strings.TrimSpace(activityType)
+			if !model.IsValidActivityType(activityType) {
+				c.SetInvalidURLParam("types")
+				return
+			}
+			options.Types = append(options.Types, activityType)
+		}
+	}
+
+	if unreadOnlyStr := r.URL.Query().Get("unread_only"); unreadOnlyStr != "" {
+		unreadOnly, err := strconv.ParseBool(unreadOnlyStr)
+		if err != nil {
+			c.SetInvalidURLParam("unread_only")
+			return
+		}
+		options.UnreadOnly = unreadOnly
+	}
+
+	feed, appErr := c.App.GetActivityFeed(c.AppContext, c.Params.UserId, options)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	feed.Posts = c.App.PreparePostListForClient(c.AppContext, feed.Posts)
+	feed.Posts, appErr = c.App.SanitizePostListMetadataForUser(c.AppContext, feed.Posts, c.AppContext.Session().UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(feed); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func markActivitiesAsRead(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	var readRequest model.ActivityReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&readRequest); err != nil {
+		c.SetInvalidParamWithErr("activity_read_request", err)
+		return
+	}
+
+	if !readRequest.All && len(readRequest.ActivityIds) == 0 {
+		c.SetInvalidParam("activity_ids")
+		return
+	}
+
+	for _, activityID := range readRequest.ActivityIds {
+		if !model.IsValidId(activityID) {
+			c.SetInvalidParam("activity_ids")
+			return
+		}
+	}
+
+	if appErr := c.App.MarkActivitiesAsRead(c.AppContext, c.Params.UserId, &readRequest); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestActivityFeed(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	client := th.Client
+	userId := th.BasicUser.Id
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := client.GetActivityFeed(userId, nil, false, 0, 60)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableActivityFeed = true
+	})
+
+	activity := &model.Activity{
+		UserId:    userId,
+		Type:      model.ActivityTypeMention,
+		ActorId:   th.BasicUser2.Id,
+		TeamId:    th.BasicTeam.Id,
+		ChannelId: th.BasicChannel.Id,
+		PostId:    th.BasicPost.Id,
+	}
+	require.Nil(t, th.App.AddActivities([]*model.Activity{activity}))
+
+	t.Run("get", func(t *testing.T) {
+		feed, _, err := client.GetActivityFeed(userId, []string{model.ActivityTypeMention}, true, 0, 60)
+		require.NoError(t, err)
+		require.Len(t, feed.Activities, 1)
+		assert.Equal(t, activity.Id, feed.Activities[0].Id)
+		assert.Contains(t, feed.Posts.Posts, th.BasicPost.Id)
+		assert.EqualValues(t, 1, feed.UnreadCount)
+		assert.False(t, feed.HasNext)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, resp, err := client.GetActivityFeed(userId, []string{"junk"}, false, 0, 60)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, resp, err := client.GetActivityFeed(th.BasicUser2.Id, nil, false, 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = client.MarkActivitiesAsRead(th.BasicUser2.Id, &model.ActivityReadRequest{All: true})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("mark as read", func(t *testing.T) {
+		resp, err := client.MarkActivitiesAsRead(userId, &model.ActivityReadRequest{})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, err = client.MarkActivitiesAsRead(userId, &model.ActivityReadRequest{ActivityIds: []string{activity.Id}})
+		require.NoError(t, err)
+
+		feed, _, err := client.GetActivityFeed(userId, nil, true, 0, 60)
+		require.NoError(t, err)
+		assert.Empty(t, feed.Activities)
+		assert.Zero(t, feed.UnreadCount)
+	})
+}
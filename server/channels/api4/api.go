@@ -5,6 +5,7 @@ package api4
 
 import (
 	"net/http"
+	"sync/atomic"
 
 	"github.com/gorilla/mux"
 	graphql "github.com/graph-gophers/graphql-go"
@@ -71,11 +72,13 @@ type Routes struct {
 	Commands *mux.Router // 'api/v4/commands'
 	Command  *mux.Router // 'api/v4/commands/{command_id:[A-Za-z0-9]+}'
 
-	Hooks         *mux.Router // 'api/v4/hooks'
-	IncomingHooks *mux.Router // 'api/v4/hooks/incoming'
-	IncomingHook  *mux.Router // 'api/v4/hooks/incoming/{hook_id:[A-Za-z0-9]+}'
-	OutgoingHooks *mux.Router // 'api/v4/hooks/outgoing'
-	OutgoingHook  *mux.Router // 'api/v4/hooks/outgoing/{hook_id:[A-Za-z0-9]+}'
+	Hooks               *mux.Router // 'api/v4/hooks'
+	IncomingHooks       *mux.Router // 'api/v4/hooks/incoming'
+	IncomingHook        *mux.Router // 'api/v4/hooks/incoming/{hook_id:[A-Za-z0-9]+}'
+	OutgoingHooks       *mux.Router // 'api/v4/hooks/outgoing'
+	OutgoingHook        *mux.Router // 'api/v4/hooks/outgoing/{hook_id:[A-Za-z0-9]+}'
+	DirectOutgoingHooks *mux.Router // 'api/v4/hooks/direct_outgoing'
+	DirectOutgoingHook  *mux.Router // 'api/v4/hooks/direct_outgoing/{hook_id:[A-Za-z0-9]+}'
 
 	OAuth     *mux.Router // 'api/v4/oauth'
 	OAuthApps *mux.Router // 'api/v4/oauth/apps'
@@ -145,12 +148,19 @@ type Routes struct {
 	HostedCustomer *mux.Router // 'api/v4/hosted_customer'
 
 	Drafts *mux.Router // 'api/v4/drafts'
+
+	TeamTemplates *mux.Router // 'api/v4/team_templates'
+	TeamTemplate  *mux.Router // 'api/v4/team_templates/{team_template_id:[A-Za-z0-9]+}'
+
+	SCIM *mux.Router // 'api/v4/scim/v2'
 }
 
 type API struct {
-	srv        *app.Server
-	schema     *graphql.Schema
-	BaseRoutes *Routes
+	srv    *app.Server
+	schema *graphql.Schema
+	// scimRateLimiter holds the *app.RateLimiter of the SCIM requests, nil when it can't be created.
+	scimRateLimiter atomic.Value
+	BaseRoutes      *Routes
 }
 
 func Init(srv *app.Server) (*API, error) {
@@ -218,6 +228,8 @@ func Init(srv *app.Server) (*API, error) {
 	api.BaseRoutes.IncomingHook = api.BaseRoutes.IncomingHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
 	api.BaseRoutes.OutgoingHooks = api.BaseRoutes.Hooks.PathPrefix("/outgoing").Subrouter()
 	api.BaseRoutes.OutgoingHook = api.BaseRoutes.OutgoingHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
+	api.BaseRoutes.DirectOutgoingHooks = api.BaseRoutes.Hooks.PathPrefix("/direct_outgoing").Subrouter()
+	api.BaseRoutes.DirectOutgoingHook = api.BaseRoutes.DirectOutgoingHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
 
 	api.BaseRoutes.SAML = api.BaseRoutes.APIRoot.PathPrefix("/saml").Subrouter()
 
@@ -277,6 +289,11 @@ func Init(srv *app.Server) (*API, error) {
 
 	api.BaseRoutes.Drafts = api.BaseRoutes.APIRoot.PathPrefix("/drafts").Subrouter()
 
+	api.BaseRoutes.TeamTemplates = api.BaseRoutes.APIRoot.PathPrefix("/team_templates").Subrouter()
+	api.BaseRoutes.TeamTemplate = api.BaseRoutes.TeamTemplates.PathPrefix("/{team_template_id:[A-Za-z0-9]+}").Subrouter()
+
+	api.BaseRoutes.SCIM = api.BaseRoutes.APIRoot.PathPrefix("/scim/v2").Subrouter()
+
 	api.InitUser()
 	api.InitBot()
 	api.InitTeam()
@@ -295,6 +312,7 @@ func Init(srv *app.Server) (*API, error) {
 	api.InitLdap()
 	api.InitElasticsearch()
 	api.InitBleve()
+	api.InitSearchEngine()
 	api.InitDataRetention()
 	api.InitBrand()
 	api.InitJob()
@@ -323,6 +341,24 @@ func Init(srv *app.Server) (*API, error) {
 	api.InitWorkTemplate()
 	api.InitHostedCustomer()
 	api.InitDrafts()
+	api.InitSCIM()
+	api.InitScheduledPost()
+	api.InitSavedPostLabel()
+	api.InitActivity()
+	api.InitWebAuthn()
+	api.InitInbox()
+	api.InitBootstrap()
+	api.InitResourceHints()
+	api.InitChannelBookmark()
+	api.InitMatrixBridge()
+	api.InitGuestSponsorship()
+	api.InitChannelMemberExpiry()
+	api.InitChannelJoinRequest()
+	api.InitChannelMembersBulk()
+	api.InitTeamTemplate()
+	api.InitTeamArchive()
+	api.InitUserOffboarding()
+	api.InitSupportAccess()
 	if err := api.InitGraphQL(); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,43 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitBootstrap() {
+	api.BaseRoutes.User.Handle("/bootstrap", api.APISessionRequired(getBootstrap)).Methods("GET")
+}
+
+func getBootstrap(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	// The bootstrap is made of what the session sees, so it can't be had for other users
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	etags := model.ParseBootstrapETags(r.URL.Query().Get("etags"))
+
+	bootstrap, appErr := c.App.GetBootstrap(c.AppContext, etags)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	c.App.Srv().Platform().UpdateLastActivityAtIfNeeded(*c.AppContext.Session())
+
+	if err := json.NewEncoder(w).Encode(bootstrap); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetBootstrap(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	bootstrap, _, err := th.Client.GetBootstrap(nil)
+	require.NoError(t, err)
+	require.NotNil(t, bootstrap.User)
+	assert.Equal(t, th.BasicUser.Id, bootstrap.User.Id)
+	assert.Empty(t, bootstrap.User.Password)
+	require.Len(t, bootstrap.Teams, 1)
+	assert.Equal(t, th.BasicTeam.Id, bootstrap.Teams[0].Id)
+	require.Len(t, bootstrap.TeamMembers, 1)
+	assert.NotEmpty(t, bootstrap.ChannelMembers)
+	assert.Contains(t, bootstrap.Categories, th.BasicTeam.Id)
+	assert.NotEmpty(t, bootstrap.License)
+	assert.Nil(t, bootstrap.Limits)
+	assert.Len(t, bootstrap.ETags, 9)
+
+	t.Run("unchanged sections are left out", func(t *testing.T) {
+		again, _, err := th.Client.GetBootstrap(bootstrap.ETags)
+		require.NoError(t, err)
+		assert.Equal(t, bootstrap.ETags, again.ETags)
+		assert.Nil(t, again.User)
+		assert.Nil(t, again.Teams)
+		assert.Nil(t, again.ChannelMembers)
+		assert.Nil(t, again.Categories)
+	})
+
+	t.Run("changed sections are sent", func(t *testing.T) {
+		_, err := th.Client.UpdatePreferences(th.BasicUser.Id, model.Preferences{{
+			UserId:   th.BasicUser.Id,
+			Category: model.PreferenceCategoryDisplaySettings,
+			Name:     model.PreferenceNameUseMilitaryTime,
+			Value:    "true",
+		}})
+		require.NoError(t, err)
+
+		again, _, err := th.Client.GetBootstrap(bootstrap.ETags)
+		require.NoError(t, err)
+		assert.NotEqual(t, bootstrap.ETags[model.BootstrapSectionPreferences], again.ETags[model.BootstrapSectionPreferences])
+		assert.NotEmpty(t, again.Preferences)
+		assert.Nil(t, again.User)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		resp, err := th.SystemAdminClient.DoAPIGet("/users/"+th.BasicUser.Id+"/bootstrap", "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, model.BuildResponse(resp))
+	})
+}
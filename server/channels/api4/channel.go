@@ -49,14 +49,19 @@ func (api *API) InitChannel() {
 	api.BaseRoutes.Channel.Handle("", api.APISessionRequired(getChannel)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("", api.APISessionRequired(updateChannel)).Methods("PUT")
 	api.BaseRoutes.Channel.Handle("/patch", api.APISessionRequired(patchChannel)).Methods("PUT")
+	api.BaseRoutes.Channel.Handle("/notify_defaults", api.APISessionRequired(updateChannelNotifyDefaults)).Methods("PUT")
 	api.BaseRoutes.Channel.Handle("/privacy", api.APISessionRequired(updateChannelPrivacy)).Methods("PUT")
 	api.BaseRoutes.Channel.Handle("/restore", api.APISessionRequired(restoreChannel)).Methods("POST")
 	api.BaseRoutes.Channel.Handle("", api.APISessionRequired(deleteChannel)).Methods("DELETE")
 	api.BaseRoutes.Channel.Handle("/stats", api.APISessionRequired(getChannelStats)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("/pinned", api.APISessionRequired(getPinnedPosts)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/pinned/settings", api.APISessionRequired(getChannelPinnedPosts)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/pinned/order", api.APISessionRequired(updatePinnedPostsOrder)).Methods("PUT")
+	api.BaseRoutes.Channel.Handle("/pinned/limit", api.APISessionRequired(updatePinnedPostsLimit)).Methods("PUT")
 	api.BaseRoutes.Channel.Handle("/timezones", api.APISessionRequired(getChannelMembersTimezones)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("/members_minus_group_members", api.APISessionRequired(channelMembersMinusGroupMembers)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("/move", api.APISessionRequired(moveChannel)).Methods("POST")
+	api.BaseRoutes.Channel.Handle("/export", api.APISessionRequired(exportChannel)).Methods("POST")
 	api.BaseRoutes.Channel.Handle("/member_counts_by_group", api.APISessionRequired(channelMemberCountsByGroup)).Methods("GET")
 
 	api.BaseRoutes.ChannelForUser.Handle("/unread", api.APISessionRequired(getChannelUnread)).Methods("GET")
@@ -375,6 +380,59 @@ func patchChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func updateChannelNotifyDefaults(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var props model.StringMap
+	if err := json.NewDecoder(r.Body).Decode(&props); err != nil {
+		c.SetInvalidParamWithErr("notify_defaults", err)
+		return
+	}
+
+	channel, appErr := c.App.GetChannel(c.AppContext, c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	channel = channel.DeepCopy()
+
+	auditRec := c.MakeAuditRecord("updateChannelNotifyDefaults", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+	auditRec.AddEventPriorState(channel)
+
+	switch channel.Type {
+	case model.ChannelTypeOpen:
+		if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionManagePublicChannelProperties) {
+			c.SetPermissionError(model.PermissionManagePublicChannelProperties)
+			return
+		}
+
+	case model.ChannelTypePrivate:
+		if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionManagePrivateChannelProperties) {
+			c.SetPermissionError(model.PermissionManagePrivateChannelProperties)
+			return
+		}
+	}
+
+	rchannel, appErr := c.App.UpdateChannelNotifyDefaults(c.AppContext, channel, props)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(rchannel)
+	auditRec.AddEventObjectType("channel")
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(rchannel); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func restoreChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireChannelId()
 	if c.Err != nil {
@@ -488,6 +546,7 @@ func searchGroupChannels(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	c.CancelWithRequest(r)
 	groupChannels, appErr := c.App.SearchGroupChannels(c.AppContext, c.AppContext.Session().UserId, props.Term)
 	if appErr != nil {
 		c.Err = appErr
@@ -572,6 +631,11 @@ func getChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fields := getResponseFields(c, r)
+	if c.Err != nil {
+		return
+	}
+
 	channel, err := c.App.GetChannel(c.AppContext, c.Params.ChannelId)
 	if err != nil {
 		c.Err = err
@@ -596,9 +660,13 @@ func getChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := json.NewEncoder(w).Encode(channel); err != nil {
-		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	js, jsonErr := marshalWithFields(fields, channel)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("getChannel", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+		return
 	}
+
+	w.Write(js)
 }
 
 func getChannelUnread(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -698,7 +766,20 @@ func getPinnedPosts(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if c.HandleEtag(posts.Etag(), "Get Pinned Posts", w, r) {
+	if err = c.App.FilterRestrictedPostList(c.AppContext.Session().UserId, posts); err != nil {
+		c.Err = err
+		return
+	}
+
+	pinned, err := c.App.GetChannelPinnedPosts(c.AppContext, c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	// the posts aren't updated when they're reordered
+	etag := model.Etag(posts.Etag(), pinned.UpdateAt)
+	if c.HandleEtag(etag, "Get Pinned Posts", w, r) {
 		return
 	}
 
@@ -709,12 +790,127 @@ func getPinnedPosts(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set(model.HeaderEtagServer, clientPostList.Etag())
+	w.Header().Set(model.HeaderEtagServer, model.Etag(clientPostList.Etag(), pinned.UpdateAt))
 	if err := clientPostList.EncodeJSON(w); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
 }
 
+func getChannelPinnedPosts(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	pinned, err := c.App.GetChannelPinnedPosts(c.AppContext, c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(pinned); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updatePinnedPostsOrder(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var postIDs []string
+	if err := json.NewDecoder(r.Body).Decode(&postIDs); err != nil {
+		c.SetInvalidParamWithErr("order", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updatePinnedPostsOrder", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+
+	// anyone who can pin posts in the channel can reorder them
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	pinned, err := c.App.UpdatePinnedPostsOrder(c.AppContext, c.Params.ChannelId, postIDs)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.AddEventResultState(pinned)
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(pinned); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updatePinnedPostsLimit(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var limit model.ChannelPinnedPostsLimit
+	if err := json.NewDecoder(r.Body).Decode(&limit); err != nil {
+		c.SetInvalidParamWithErr("pin_limit", err)
+		return
+	}
+
+	channel, appErr := c.App.GetChannel(c.AppContext, c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updatePinnedPostsLimit", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+	audit.AddEventParameter(auditRec, "pin_limit", limit.PinLimit)
+
+	switch channel.Type {
+	case model.ChannelTypeOpen:
+		if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionManagePublicChannelProperties) {
+			c.SetPermissionError(model.PermissionManagePublicChannelProperties)
+			return
+		}
+
+	case model.ChannelTypePrivate:
+		if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionManagePrivateChannelProperties) {
+			c.SetPermissionError(model.PermissionManagePrivateChannelProperties)
+			return
+		}
+
+	default:
+		if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+			c.SetPermissionError(model.PermissionReadChannel)
+			return
+		}
+	}
+
+	pinned, appErr := c.App.UpdatePinnedPostsLimit(c.AppContext, c.Params.ChannelId, limit.PinLimit)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(pinned)
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(pinned); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getAllChannels(c *Context, w http.ResponseWriter, r *http.Request) {
 	permissions := []*model.Permission{
 		model.PermissionSysconsoleReadUserManagementGroups,
@@ -773,6 +969,11 @@ func getPublicChannelsForTeam(c *Context, w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	fields := getResponseFields(c, r)
+	if c.Err != nil {
+		return
+	}
+
 	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionListTeamChannels) {
 		c.SetPermissionError(model.PermissionListTeamChannels)
 		return
@@ -790,9 +991,13 @@ func getPublicChannelsForTeam(c *Context, w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if err := json.NewEncoder(w).Encode(channels); err != nil {
-		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	js, jsonErr := marshalWithFields(fields, channels)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("getPublicChannelsForTeam", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+		return
 	}
+
+	w.Write(js)
 }
 
 func getDeletedChannelsForTeam(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -893,6 +1098,11 @@ func getChannelsForTeamForUser(c *Context, w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	fields := getResponseFields(c, r)
+	if c.Err != nil {
+		return
+	}
+
 	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
 		c.SetPermissionError(model.PermissionEditOtherUsers)
 		return
@@ -922,7 +1132,8 @@ func getChannelsForTeamForUser(c *Context, w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if c.HandleEtag(channels.Etag(), "Get Channels", w, r) {
+	etag := fields.etag(channels.Etag())
+	if c.HandleEtag(etag, "Get Channels", w, r) {
 		return
 	}
 
@@ -932,10 +1143,14 @@ func getChannelsForTeamForUser(c *Context, w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	w.Header().Set(model.HeaderEtagServer, channels.Etag())
-	if err := json.NewEncoder(w).Encode(channels); err != nil {
-		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	w.Header().Set(model.HeaderEtagServer, etag)
+	js, jsonErr := marshalWithFields(fields, channels)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("getChannelsForTeamForUser", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+		return
 	}
+
+	w.Write(js)
 }
 
 func getChannelsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -1024,6 +1239,7 @@ func autocompleteChannelsForTeam(c *Context, w http.ResponseWriter, r *http.Requ
 
 	name := r.URL.Query().Get("name")
 
+	c.CancelWithRequest(r)
 	channels, err := c.App.AutocompleteChannelsForTeam(c.AppContext, c.Params.TeamId, c.AppContext.Session().UserId, name)
 	if err != nil {
 		c.Err = err
@@ -1045,6 +1261,7 @@ func autocompleteChannelsForTeamForSearch(c *Context, w http.ResponseWriter, r *
 
 	name := r.URL.Query().Get("name")
 
+	c.CancelWithRequest(r)
 	channels, err := c.App.AutocompleteChannelsForSearch(c.AppContext, c.Params.TeamId, c.AppContext.Session().UserId, name)
 	if err != nil {
 		c.Err = err
@@ -1069,6 +1286,7 @@ func searchChannelsForTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	c.CancelWithRequest(r)
 	var channels model.ChannelList
 	var appErr *model.AppError
 	if c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionListTeamChannels) {
@@ -1108,6 +1326,7 @@ func searchArchivedChannelsForTeam(c *Context, w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	c.CancelWithRequest(r)
 	var channels model.ChannelList
 	var appErr *model.AppError
 	if c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionListTeamChannels) {
@@ -1151,6 +1370,7 @@ func searchAllChannels(c *Context, w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	c.CancelWithRequest(r)
 	if !fromSysConsole {
 		// If the request is not coming from system_console, only show the user level channels
 		// from all teams.
@@ -1643,6 +1863,18 @@ func addChannelMember(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	audit.AddEventParameter(auditRec, "post_root_id", postRootId)
 
+	// An expiration time makes the membership temporary.
+	var expiresAt int64
+	if rawExpiresAt, ok := props["expires_at"]; ok {
+		value, ok := rawExpiresAt.(float64)
+		if !ok || value <= 0 {
+			c.SetInvalidParam("expires_at")
+			return
+		}
+		expiresAt = int64(value)
+		audit.AddEventParameter(auditRec, "expires_at", expiresAt)
+	}
+
 	if ok && len(postRootId) == 26 {
 		rootPost, err := c.App.GetSinglePost(postRootId, false)
 		if err != nil {
@@ -1729,6 +1961,7 @@ func addChannelMember(c *Context, w http.ResponseWriter, r *http.Request) {
 	cm, err := c.App.AddChannelMember(c.AppContext, member.UserId, channel, app.ChannelMemberOpts{
 		UserRequestorID: c.AppContext.Session().UserId,
 		PostRootID:      postRootId,
+		ExpiresAt:       expiresAt,
 	})
 	if err != nil {
 		c.Err = err
@@ -2135,3 +2368,46 @@ func moveChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
 }
+
+// exportChannel starts a job exporting the channel's history into an archive, which can be
+// downloaded through the exports API once the job has completed.
+func exportChannel(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageJobs) {
+		c.SetPermissionError(model.PermissionManageJobs)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("exportChannel", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+
+	channel, appErr := c.App.GetChannel(c.AppContext, c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddMeta("channel_name", channel.Name)
+
+	job, appErr := c.App.CreateJob(&model.Job{
+		Type: model.JobTypeChannelExport,
+		Data: map[string]string{"channel_id": channel.Id},
+	})
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(job)
+	auditRec.AddEventObjectType("job")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(job); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitChannelBookmark() {
+	api.BaseRoutes.Channel.Handle("/bookmarks", api.APISessionRequired(getChannelBookmarks)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/bookmarks", api.APISessionRequired(createChannelBookmark)).Methods("POST")
+	api.BaseRoutes.Channel.Handle("/bookmarks/order", api.APISessionRequired(updateChannelBookmarkSortOrder)).Methods("PUT")
+	api.BaseRoutes.Channel.Handle("/bookmarks/{bookmark_id:[A-Za-z0-9]+}", api.APISessionRequired(patchChannelBookmark)).Methods("PATCH")
+	api.BaseRoutes.Channel.Handle("/bookmarks/{bookmark_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteChannelBookmark)).Methods("DELETE")
+}
+
+// checkManageChannelBookmarksPermission checks that the session can manage the bookmarks of the
+// channel, which takes the same permissions as changing its header and purpose.
+func checkManageChannelBookmarksPermission(c *Context) {
+	channel, appErr := c.App.GetChannel(c.AppContext, c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	switch channel.Type {
+	case model.ChannelTypeOpen:
+		if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionManagePublicChannelProperties) {
+			c.SetPermissionError(model.PermissionManagePublicChannelProperties)
+		}
+
+	case model.ChannelTypePrivate:
+		if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionManagePrivateChannelProperties) {
+			c.SetPermissionError(model.PermissionManagePrivateChannelProperties)
+		}
+
+	case model.ChannelTypeGroup, model.ChannelTypeDirect:
+		if _, appErr := c.App.GetChannelMember(c.AppContext, c.Params.ChannelId, c.AppContext.Session().UserId); appErr != nil {
+			c.Err = model.NewAppError("checkManageChannelBookmarksPermission", "api.channel_bookmark.forbidden.app_error", nil, "", http.StatusForbidden)
+		}
+
+	default:
+		c.Err = model.NewAppError("checkManageChannelBookmarksPermission", "api.channel_bookmark.forbidden.app_error", nil, "", http.StatusForbidden)
+	}
+}
+
+func getChannelBookmarks(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	bookmarks, err := c.App.GetChannelBookmarks(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(bookmarks); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func createChannelBookmark(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var bookmark model.ChannelBookmark
+	if jsonErr := json.NewDecoder(r.Body).Decode(&bookmark); jsonErr != nil {
+		c.SetInvalidParamWithErr("channel_bookmark", jsonErr)
+		return
+	}
+	bookmark.ChannelId = c.Params.ChannelId
+	bookmark.OwnerId = c.AppContext.Session().UserId
+
+	auditRec := c.MakeAuditRecord("createChannelBookmark", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+
+	checkManageChannelBookmarksPermission(c)
+	if c.Err != nil {
+		return
+	}
+
+	saved, err := c.App.CreateChannelBookmark(c.AppContext, &bookmark, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(saved)
+	auditRec.AddEventObjectType("channel_bookmark")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func patchChannelBookmark(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireBookmarkId()
+	if c.Err != nil {
+		return
+	}
+
+	var patch model.ChannelBookmarkPatch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParamWithErr("channel_bookmark", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("patchChannelBookmark", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+	audit.AddEventParameter(auditRec, "bookmark_id", c.Params.BookmarkId)
+
+	checkManageChannelBookmarksPermission(c)
+	if c.Err != nil {
+		return
+	}
+
+	existing, err := c.App.GetChannelBookmark(c.Params.BookmarkId, false)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	if existing.ChannelId != c.Params.ChannelId {
+		c.SetInvalidURLParam("bookmark_id")
+		return
+	}
+	auditRec.AddEventPriorState(existing)
+
+	patched, err := c.App.PatchChannelBookmark(c.Params.BookmarkId, &patch, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(patched)
+	auditRec.AddEventObjectType("channel_bookmark")
+
+	if err := json.NewEncoder(w).Encode(patched); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteChannelBookmark(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireBookmarkId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteChannelBookmark", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+	audit.AddEventParameter(auditRec, "bookmark_id", c.Params.BookmarkId)
+
+	checkManageChannelBookmarksPermission(c)
+	if c.Err != nil {
+		return
+	}
+
+	existing, err := c.App.GetChannelBookmark(c.Params.BookmarkId, false)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	if existing.ChannelId != c.Params.ChannelId {
+		c.SetInvalidURLParam("bookmark_id")
+		return
+	}
+	auditRec.AddEventPriorState(existing)
+
+	deleted, err := c.App.DeleteChannelBookmark(c.Params.BookmarkId, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventObjectType("channel_bookmark")
+
+	if err := json.NewEncoder(w).Encode(deleted); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateChannelBookmarkSortOrder(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var order []string
+	if jsonErr := json.NewDecoder(r.Body).Decode(&order); jsonErr != nil {
+		c.SetInvalidParamWithErr("order", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateChannelBookmarkSortOrder", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+
+	checkManageChannelBookmarksPermission(c)
+	if c.Err != nil {
+		return
+	}
+
+	bookmarks, err := c.App.UpdateChannelBookmarkSortOrder(c.Params.ChannelId, order, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(bookmarks); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/utils/testutils"
+)
+
+func TestChannelBookmarks(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+	channelId := th.BasicChannel.Id
+
+	newLink := func(name string) *model.ChannelBookmark {
+		return &model.ChannelBookmark{
+			ChannelId:   channelId,
+			DisplayName: name,
+			LinkUrl:     "https://mattermost.com/" + name,
+			Type:        model.ChannelBookmarkLink,
+		}
+	}
+
+	first, resp, err := client.CreateChannelBookmark(newLink("first"))
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.BasicUser.Id, first.OwnerId)
+	second, _, err := client.CreateChannelBookmark(newLink("second"))
+	require.NoError(t, err)
+	assert.Greater(t, second.SortOrder, first.SortOrder)
+
+	t.Run("file", func(t *testing.T) {
+		data, err := testutils.ReadTestFile("test.png")
+		require.NoError(t, err)
+		fileResp, _, err := client.UploadFile(data, channelId, "test.png")
+		require.NoError(t, err)
+
+		bookmark, _, err := client.CreateChannelBookmark(&model.ChannelBookmark{
+			ChannelId:   channelId,
+			DisplayName: "picture",
+			FileId:      fileResp.FileInfos[0].Id,
+			Type:        model.ChannelBookmarkFile,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, bookmark.FileInfo)
+		assert.Equal(t, "test.png", bookmark.FileInfo.Name)
+
+		_, _, err = client.DeleteChannelBookmark(channelId, bookmark.Id)
+		require.NoError(t, err)
+
+		otherFile, _, err := client.UploadFile(data, th.BasicChannel2.Id, "test.png")
+		require.NoError(t, err)
+		_, resp, err := client.CreateChannelBookmark(&model.ChannelBookmark{
+			ChannelId:   channelId,
+			DisplayName: "picture",
+			FileId:      otherFile.FileInfos[0].Id,
+			Type:        model.ChannelBookmarkFile,
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("invalid link", func(t *testing.T) {
+		bookmark := newLink("invalid")
+		bookmark.LinkUrl = "mattermost"
+		_, resp, err := client.CreateChannelBookmark(bookmark)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("patch", func(t *testing.T) {
+		patched, _, err := client.PatchChannelBookmark(channelId, first.Id, &model.ChannelBookmarkPatch{
+			DisplayName: model.NewString("renamed"),
+			Emoji:       model.NewString("smile"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "renamed", patched.DisplayName)
+		assert.Equal(t, "smile", patched.Emoji)
+		assert.Equal(t, first.LinkUrl, patched.LinkUrl)
+
+		_, resp, err := client.PatchChannelBookmark(th.BasicChannel2.Id, first.Id, &model.ChannelBookmarkPatch{DisplayName: model.NewString("other")})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("sort", func(t *testing.T) {
+		bookmarks, _, err := client.UpdateChannelBookmarkSortOrder(channelId, []string{second.Id})
+		require.NoError(t, err)
+		require.Len(t, bookmarks, 2)
+		assert.Equal(t, second.Id, bookmarks[0].Id)
+		assert.Equal(t, first.Id, bookmarks[1].Id)
+
+		_, resp, err := client.UpdateChannelBookmarkSortOrder(channelId, []string{second.Id, second.Id})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("read", func(t *testing.T) {
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		bookmarks, _, err := client.GetChannelBookmarks(channelId)
+		require.NoError(t, err)
+		assert.Len(t, bookmarks, 2)
+
+		th.RemovePermissionFromRole(model.PermissionManagePublicChannelProperties.Id, model.ChannelUserRoleId)
+		defer th.AddPermissionToRole(model.PermissionManagePublicChannelProperties.Id, model.ChannelUserRoleId)
+		_, resp, err := client.CreateChannelBookmark(newLink("forbidden"))
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		deleted, _, err := client.DeleteChannelBookmark(channelId, first.Id)
+		require.NoError(t, err)
+		assert.NotZero(t, deleted.DeleteAt)
+
+		bookmarks, _, err := client.GetChannelBookmarks(channelId)
+		require.NoError(t, err)
+		require.Len(t, bookmarks, 1)
+		assert.Equal(t, second.Id, bookmarks[0].Id)
+
+		_, resp, err := client.DeleteChannelBookmark(channelId, first.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("private channel the user isn't a member of", func(t *testing.T) {
+		private := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+		_, resp, err := client.GetChannelBookmarks(private.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitChannelJoinRequest() {
+	api.BaseRoutes.Channel.Handle("/join_requests", api.APISessionRequired(createChannelJoinRequest)).Methods("POST")
+	api.BaseRoutes.Channel.Handle("/join_requests", api.APISessionRequired(getChannelJoinRequests)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/join_requests/{join_request_id:[A-Za-z0-9]+}/approve", api.APISessionRequired(approveChannelJoinRequest)).Methods("POST")
+	api.BaseRoutes.Channel.Handle("/join_requests/{join_request_id:[A-Za-z0-9]+}/deny", api.APISessionRequired(denyChannelJoinRequest)).Methods("POST")
+}
+
+func createChannelJoinRequest(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var joinRequest model.ChannelJoinRequest
+	if err := json.NewDecoder(r.Body).Decode(&joinRequest); err != nil {
+		c.SetInvalidParamWithErr("channel_join_request", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createChannelJoinRequest", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+
+	channel, appErr := c.App.GetChannel(c.AppContext, c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), channel.TeamId, model.PermissionViewTeam) {
+		c.SetPermissionError(model.PermissionViewTeam)
+		return
+	}
+
+	created, appErr := c.App.RequestToJoinChannel(c.AppContext, channel, c.AppContext.Session().UserId, joinRequest.Message)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(created)
+	auditRec.AddEventObjectType("channel_join_request")
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getChannelJoinRequests(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+	switch status {
+	case "", model.ChannelJoinRequestStatusPending, model.ChannelJoinRequestStatusApproved, model.ChannelJoinRequestStatusDenied:
+	default:
+		c.SetInvalidParam("status")
+		return
+	}
+
+	getChannelForMemberManagement(c)
+	if c.Err != nil {
+		return
+	}
+
+	requests, appErr := c.App.GetChannelJoinRequests(c.Params.ChannelId, status, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(requests); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func approveChannelJoinRequest(c *Context, w http.ResponseWriter, r *http.Request) {
+	decideChannelJoinRequest(c, w, true)
+}
+
+func denyChannelJoinRequest(c *Context, w http.ResponseWriter, r *http.Request) {
+	decideChannelJoinRequest(c, w, false)
+}
+
+func decideChannelJoinRequest(c *Context, w http.ResponseWriter, approve bool) {
+	c.RequireChannelId().RequireJoinRequestId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("decideChannelJoinRequest", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+	audit.AddEventParameter(auditRec, "join_request_id", c.Params.JoinRequestId)
+	audit.AddEventParameter(auditRec, "approve", approve)
+
+	getChannelForMemberManagement(c)
+	if c.Err != nil {
+		return
+	}
+
+	joinRequest, appErr := c.App.GetChannelJoinRequest(c.Params.JoinRequestId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if joinRequest.ChannelId != c.Params.ChannelId {
+		c.Err = model.NewAppError("decideChannelJoinRequest", "api.channel_join_request.channel_mismatch.app_error", nil, "", http.StatusBadRequest)
+		return
+	}
+	auditRec.AddEventPriorState(joinRequest)
+
+	decided, appErr := c.App.DecideChannelJoinRequest(c.AppContext, joinRequest, c.AppContext.Session().UserId, approve)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(decided)
+	auditRec.AddEventObjectType("channel_join_request")
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(decided); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestChannelJoinRequest(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	channel := th.BasicPrivateChannel2
+
+	client := th.CreateClient()
+	th.LoginBasic2WithClient(client)
+
+	t.Run("public channels can be joined without a request", func(t *testing.T) {
+		_, resp, err := client.RequestToJoinChannel(th.BasicChannel.Id, "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	joinRequest, resp, err := client.RequestToJoinChannel(channel.Id, "let me in")
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.BasicUser2.Id, joinRequest.UserId)
+	assert.Equal(t, model.ChannelJoinRequestStatusPending, joinRequest.Status)
+
+	t.Run("only the channel admins can list and decide the requests", func(t *testing.T) {
+		_, resp, err := client.GetChannelJoinRequests(channel.Id, "", 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.ApproveChannelJoinRequest(channel.Id, joinRequest.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		_, resp, err := th.Client.GetChannelJoinRequests(channel.Id, "unknown", 0, 10)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	requests, _, err := th.Client.GetChannelJoinRequests(channel.Id, model.ChannelJoinRequestStatusPending, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, joinRequest.Id, requests[0].Id)
+
+	t.Run("the request must belong to the channel", func(t *testing.T) {
+		_, resp, err := th.Client.ApproveChannelJoinRequest(th.BasicPrivateChannel.Id, joinRequest.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	approved, _, err := th.Client.ApproveChannelJoinRequest(channel.Id, joinRequest.Id)
+	require.NoError(t, err)
+	assert.Equal(t, model.ChannelJoinRequestStatusApproved, approved.Status)
+	assert.Equal(t, th.BasicUser.Id, approved.DeciderId)
+
+	_, _, err = client.GetChannelMember(channel.Id, th.BasicUser2.Id, "")
+	require.NoError(t, err)
+
+	t.Run("a decided request can't be decided again", func(t *testing.T) {
+		_, resp, err := th.Client.DenyChannelJoinRequest(channel.Id, joinRequest.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
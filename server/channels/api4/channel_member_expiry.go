@@ -0,0 +1,130 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitChannelMemberExpiry() {
+	api.BaseRoutes.Channel.Handle("/member_expiries", api.APISessionRequired(getChannelMemberExpiries)).Methods("GET")
+	api.BaseRoutes.ChannelMember.Handle("/expiry", api.APISessionRequired(setChannelMemberExpiry)).Methods("PUT")
+	api.BaseRoutes.ChannelMember.Handle("/expiry", api.APISessionRequired(deleteChannelMemberExpiry)).Methods("DELETE")
+}
+
+func getChannelMemberExpiries(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	expiries, appErr := c.App.GetChannelMemberExpiries(c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(expiries); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func setChannelMemberExpiry(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var expiry model.ChannelMemberExpiry
+	if err := json.NewDecoder(r.Body).Decode(&expiry); err != nil {
+		c.SetInvalidParamWithErr("channel_member_expiry", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("setChannelMemberExpiry", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "expires_at", expiry.ExpiresAt)
+
+	channel := getChannelForMemberManagement(c)
+	if c.Err != nil {
+		return
+	}
+
+	if _, appErr := c.App.GetChannelMember(c.AppContext, channel.Id, c.Params.UserId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	result, appErr := c.App.SetChannelMemberExpiry(channel, c.Params.UserId, expiry.ExpiresAt, c.AppContext.Session().UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(result)
+	auditRec.AddEventObjectType("channel_member_expiry")
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteChannelMemberExpiry(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteChannelMemberExpiry", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+
+	channel := getChannelForMemberManagement(c)
+	if c.Err != nil {
+		return
+	}
+
+	if appErr := c.App.DeleteChannelMemberExpiry(channel.Id, c.Params.UserId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+// getChannelForMemberManagement returns the channel of the request once it has checked the session
+// can manage its members.
+func getChannelForMemberManagement(c *Context) *model.Channel {
+	channel, appErr := c.App.GetChannel(c.AppContext, c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return nil
+	}
+
+	permission := model.PermissionManagePublicChannelMembers
+	if channel.Type == model.ChannelTypePrivate {
+		permission = model.PermissionManagePrivateChannelMembers
+	}
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), channel.Id, permission) {
+		c.SetPermissionError(permission)
+		return nil
+	}
+
+	return channel
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestChannelMemberExpiry(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.CreateUser()
+	th.LinkUserToTeam(user, th.BasicTeam)
+
+	t.Run("add rejects an expiry in the past", func(t *testing.T) {
+		_, resp, err := th.Client.AddChannelMemberWithExpiry(th.BasicChannel.Id, user.Id, model.GetMillis()-1000)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	expiresAt := model.GetMillis() + 60000
+	_, _, err := th.Client.AddChannelMemberWithExpiry(th.BasicChannel.Id, user.Id, expiresAt)
+	require.NoError(t, err)
+
+	expiries, _, err := th.Client.GetChannelMemberExpiries(th.BasicChannel.Id)
+	require.NoError(t, err)
+	require.Len(t, expiries, 1)
+	assert.Equal(t, user.Id, expiries[0].UserId)
+	assert.Equal(t, expiresAt, expiries[0].ExpiresAt)
+
+	t.Run("update the expiry", func(t *testing.T) {
+		expiresAt += 60000
+		expiry, _, err := th.Client.SetChannelMemberExpiry(th.BasicChannel.Id, user.Id, expiresAt)
+		require.NoError(t, err)
+		assert.Equal(t, expiresAt, expiry.ExpiresAt)
+		assert.Equal(t, th.BasicUser.Id, expiry.CreatorId)
+	})
+
+	t.Run("set requires a channel member", func(t *testing.T) {
+		_, resp, err := th.Client.SetChannelMemberExpiry(th.BasicChannel.Id, th.SystemAdminUser.Id, expiresAt)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("requires the permission to manage the channel members", func(t *testing.T) {
+		th.RemovePermissionFromRole(model.PermissionManagePublicChannelMembers.Id, model.ChannelUserRoleId)
+		defer th.AddPermissionToRole(model.PermissionManagePublicChannelMembers.Id, model.ChannelUserRoleId)
+
+		_, resp, err := th.Client.SetChannelMemberExpiry(th.BasicChannel.Id, user.Id, expiresAt)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.DeleteChannelMemberExpiry(th.BasicChannel.Id, user.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("non members can not read the expiries", func(t *testing.T) {
+		client := th.CreateClient()
+		th.LoginBasic2WithClient(client)
+		_, resp, err := client.GetChannelMemberExpiries(th.BasicPrivateChannel2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("delete makes the membership permanent", func(t *testing.T) {
+		_, err := th.Client.DeleteChannelMemberExpiry(th.BasicChannel.Id, user.Id)
+		require.NoError(t, err)
+
+		expiries, _, err := th.Client.GetChannelMemberExpiries(th.BasicChannel.Id)
+		require.NoError(t, err)
+		assert.Empty(t, expiries)
+
+		_, _, err = th.Client.GetChannelMember(th.BasicChannel.Id, user.Id, "")
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitChannelMembersBulk() {
+	api.BaseRoutes.ChannelMembers.Handle("/bulk", api.APISessionRequired(addChannelMembersBulk)).Methods("POST")
+	api.BaseRoutes.ChannelMembers.Handle("/bulk/remove", api.APISessionRequired(removeChannelMembersBulk)).Methods("POST")
+	api.BaseRoutes.ChannelMembers.Handle("/bulk/{job_id:[A-Za-z0-9]+}", api.APISessionRequired(getChannelMembersBulkJob)).Methods("GET")
+}
+
+func addChannelMembersBulk(c *Context, w http.ResponseWriter, r *http.Request) {
+	startChannelMembersBulkJob(c, w, r, "addChannelMembersBulk", model.ChannelMembersBulkActionAdd)
+}
+
+func removeChannelMembersBulk(c *Context, w http.ResponseWriter, r *http.Request) {
+	startChannelMembersBulkJob(c, w, r, "removeChannelMembersBulk", model.ChannelMembersBulkActionRemove)
+}
+
+func startChannelMembersBulkJob(c *Context, w http.ResponseWriter, r *http.Request, event, action string) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var bulkRequest model.ChannelMembersBulkRequest
+	if err := json.NewDecoder(r.Body).Decode(&bulkRequest); err != nil {
+		c.SetInvalidParamWithErr("user_ids", err)
+		return
+	}
+	if appErr := bulkRequest.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+	audit.AddEventParameter(auditRec, "user_ids", bulkRequest.UserIds)
+
+	channel, appErr := c.App.GetChannel(c.AppContext, c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if channel.Type != model.ChannelTypeOpen && channel.Type != model.ChannelTypePrivate {
+		c.Err = model.NewAppError(event, "app.channel_members_bulk.type.app_error", nil, "", http.StatusBadRequest)
+		return
+	}
+
+	if !checkChannelMembersBulkPermission(c, channel) {
+		return
+	}
+
+	job, appErr := c.App.StartChannelMembersBulkJob(c.AppContext, channel, action, c.AppContext.Session().UserId, bulkRequest.UserIds)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(job)
+	auditRec.AddEventObjectType("job")
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(model.ChannelMembersBulkResultFromJob(job)); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getChannelMembersBulkJob(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireJobId()
+	if c.Err != nil {
+		return
+	}
+
+	channel, appErr := c.App.GetChannel(c.AppContext, c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if !checkChannelMembersBulkPermission(c, channel) {
+		return
+	}
+
+	job, appErr := c.App.GetChannelMembersBulkJob(channel.Id, c.Params.JobId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(model.ChannelMembersBulkResultFromJob(job)); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// checkChannelMembersBulkPermission checks that the session can manage the members of the channel,
+// setting the error of the context when it can't.
+func checkChannelMembersBulkPermission(c *Context, channel *model.Channel) bool {
+	permission := model.PermissionManagePublicChannelMembers
+	if channel.Type == model.ChannelTypePrivate {
+		permission = model.PermissionManagePrivateChannelMembers
+	}
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), channel.Id, permission) {
+		c.SetPermissionError(permission)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestChannelMembersBulk(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	channel := th.BasicPrivateChannel
+	user1 := th.CreateUser()
+	th.LinkUserToTeam(user1, th.BasicTeam)
+	user2 := th.CreateUser()
+	th.LinkUserToTeam(user2, th.BasicTeam)
+	outsider := th.CreateUser()
+	userIDs := []string{user1.Id, user2.Id, outsider.Id}
+	noop := func(int, map[string]string) {}
+
+	client := th.CreateClient()
+	th.LoginBasic2WithClient(client)
+
+	t.Run("only the members managers of the channel can add members in bulk", func(t *testing.T) {
+		_, resp, err := client.AddChannelMembersBulk(channel.Id, userIDs)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid user ids", func(t *testing.T) {
+		_, resp, err := th.Client.AddChannelMembersBulk(channel.Id, []string{})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.AddChannelMembersBulk(channel.Id, []string{"invalid"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("direct channels", func(t *testing.T) {
+		dm := th.CreateDmChannel(th.BasicUser2)
+		_, resp, err := th.Client.AddChannelMembersBulk(dm.Id, userIDs)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	result, resp, err := th.Client.AddChannelMembersBulk(channel.Id, userIDs)
+	require.NoError(t, err)
+	checkHTTPStatus(t, resp, http.StatusAccepted)
+	assert.Equal(t, channel.Id, result.ChannelId)
+	assert.Equal(t, model.ChannelMembersBulkActionAdd, result.Action)
+	assert.Equal(t, 3, result.Total)
+
+	failures, appErr := th.App.ProcessChannelMembersBulk(th.Context, channel.Id, model.ChannelMembersBulkActionAdd, th.BasicUser.Id, userIDs, noop)
+	require.Nil(t, appErr)
+	require.Len(t, failures, 1)
+	assert.Contains(t, failures, outsider.Id)
+
+	for _, userID := range []string{user1.Id, user2.Id} {
+		_, appErr = th.App.GetChannelMember(th.Context, channel.Id, userID)
+		require.Nil(t, appErr)
+	}
+
+	t.Run("the job is read from its channel only", func(t *testing.T) {
+		job, _, err := th.Client.GetChannelMembersBulkJob(channel.Id, result.JobId)
+		require.NoError(t, err)
+		assert.Equal(t, result.JobId, job.JobId)
+
+		_, resp, err := th.Client.GetChannelMembersBulkJob(th.BasicChannel.Id, result.JobId)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, resp, err = client.GetChannelMembersBulkJob(channel.Id, result.JobId)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	result, resp, err = th.Client.RemoveChannelMembersBulk(channel.Id, []string{user1.Id, user2.Id})
+	require.NoError(t, err)
+	checkHTTPStatus(t, resp, http.StatusAccepted)
+	assert.Equal(t, model.ChannelMembersBulkActionRemove, result.Action)
+
+	failures, appErr = th.App.ProcessChannelMembersBulk(th.Context, channel.Id, model.ChannelMembersBulkActionRemove, th.BasicUser.Id, []string{user1.Id, user2.Id}, noop)
+	require.Nil(t, appErr)
+	assert.Empty(t, failures)
+
+	_, appErr = th.App.GetChannelMember(th.Context, channel.Id, user1.Id)
+	require.NotNil(t, appErr)
+}
@@ -252,6 +252,40 @@ func TestUpdateChannel(t *testing.T) {
 	CheckForbiddenStatus(t, resp)
 }
 
+func TestUpdateChannelNotifyDefaults(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	props := model.StringMap{model.PushNotifyProp: model.ChannelNotifyMention}
+	channel, _, err := client.UpdateChannelNotifyDefaults(th.BasicChannel.Id, props)
+	require.NoError(t, err)
+	require.Equal(t, props, channel.DefaultNotifyProps)
+
+	user := th.CreateUser()
+	th.LinkUserToTeam(user, th.BasicTeam)
+	th.AddUserToChannel(user, channel)
+	member, appErr := th.App.GetChannelMember(th.Context, th.BasicChannel.Id, user.Id)
+	require.Nil(t, appErr)
+	require.Equal(t, model.ChannelNotifyMention, member.NotifyProps[model.PushNotifyProp])
+
+	_, resp, err := client.UpdateChannelNotifyDefaults(th.BasicChannel.Id, model.StringMap{model.PushNotifyProp: "sometimes"})
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	dm, _, err := client.CreateDirectChannel(th.BasicUser.Id, th.BasicUser2.Id)
+	require.NoError(t, err)
+	_, resp, err = client.UpdateChannelNotifyDefaults(dm.Id, props)
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	th.RemovePermissionFromRole(model.PermissionManagePublicChannelProperties.Id, model.ChannelUserRoleId)
+	defer th.AddPermissionToRole(model.PermissionManagePublicChannelProperties.Id, model.ChannelUserRoleId)
+	_, resp, err = client.UpdateChannelNotifyDefaults(th.BasicChannel.Id, props)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+}
+
 func TestPatchChannel(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2631,6 +2665,70 @@ func TestGetPinnedPosts(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestPinnedPostsOrderAndLimit(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+	channel := th.BasicChannel
+
+	first := th.CreatePinnedPost()
+	second := th.CreatePinnedPost()
+	third := th.CreatePinnedPost()
+
+	posts, resp, err := client.GetPinnedPosts(channel.Id, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{first.Id, second.Id, third.Id}, posts.Order)
+	etag := resp.Etag
+
+	t.Run("reorder", func(t *testing.T) {
+		pinned, _, err := client.UpdatePinnedPostsOrder(channel.Id, []string{third.Id, first.Id})
+		require.NoError(t, err)
+		require.Equal(t, model.StringArray{third.Id, first.Id, second.Id}, pinned.PostOrder)
+
+		posts, resp, err := client.GetPinnedPosts(channel.Id, etag)
+		require.NoError(t, err)
+		require.NotEqual(t, http.StatusNotModified, resp.StatusCode, "reordering should change the etag")
+		require.Equal(t, []string{third.Id, first.Id, second.Id}, posts.Order)
+
+		_, resp, err = client.UpdatePinnedPostsOrder(channel.Id, []string{th.BasicPost.Id})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = client.UpdatePinnedPostsOrder(channel.Id, []string{first.Id, first.Id})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		pinned, _, err := client.UpdatePinnedPostsLimit(channel.Id, 3)
+		require.NoError(t, err)
+		require.Equal(t, 3, pinned.PinLimit)
+
+		resp, err := client.PinPost(th.BasicPost.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, err = client.UnpinPost(first.Id)
+		require.NoError(t, err)
+		_, err = client.PinPost(th.BasicPost.Id)
+		require.NoError(t, err)
+
+		_, resp, err = client.UpdatePinnedPostsLimit(channel.Id, model.ChannelPinnedPostsMaxLimit+1)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		th.RemovePermissionFromRole(model.PermissionManagePublicChannelProperties.Id, model.ChannelUserRoleId)
+		defer th.AddPermissionToRole(model.PermissionManagePublicChannelProperties.Id, model.ChannelUserRoleId)
+		_, resp, err = client.UpdatePinnedPostsLimit(channel.Id, 0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		pinned, _, err = client.GetChannelPinnedPosts(channel.Id)
+		require.NoError(t, err)
+		require.Equal(t, 3, pinned.PinLimit)
+	})
+}
+
 func TestUpdateChannelRoles(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -4622,3 +4720,22 @@ func TestViewChannelWithoutCollapsedThreads(t *testing.T) {
 	require.NoError(t, err)
 	require.Zero(t, threads.TotalUnreadMentions)
 }
+
+func TestExportChannel(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, resp, err := th.Client.ExportChannel(th.BasicChannel.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = th.SystemAdminClient.ExportChannel(model.NewId())
+	require.Error(t, err)
+	CheckNotFoundStatus(t, resp)
+
+	job, resp, err := th.SystemAdminClient.ExportChannel(th.BasicChannel.Id)
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, model.JobTypeChannelExport, job.Type)
+	assert.Equal(t, th.BasicChannel.Id, job.Data["channel_id"])
+}
@@ -8,10 +8,14 @@ import (
 	"net/http"
 
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
 )
 
 func (api *API) InitCluster() {
 	api.BaseRoutes.Cluster.Handle("/status", api.APISessionRequired(getClusterStatus)).Methods("GET")
+	api.BaseRoutes.Cluster.Handle("/nodes/{node_id:[A-Za-z0-9]+}/drain", api.APISessionRequired(getClusterNodeDrainState)).Methods("GET")
+	api.BaseRoutes.Cluster.Handle("/nodes/{node_id:[A-Za-z0-9]+}/drain", api.APISessionRequired(drainClusterNode)).Methods("POST")
+	api.BaseRoutes.Cluster.Handle("/nodes/{node_id:[A-Za-z0-9]+}/drain", api.APISessionRequired(undrainClusterNode)).Methods("DELETE")
 }
 
 func getClusterStatus(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -33,3 +37,76 @@ func getClusterStatus(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	w.Write(js)
 }
+
+func getClusterNodeDrainState(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireNodeId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadEnvironmentHighAvailability) {
+		c.SetPermissionError(model.PermissionSysconsoleReadEnvironmentHighAvailability)
+		return
+	}
+
+	if *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError("getClusterNodeDrainState", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	state, appErr := c.App.Srv().GetNodeDrainState(c.Params.NodeId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(state)
+	if err != nil {
+		c.Err = model.NewAppError("getClusterNodeDrainState", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+	w.Write(js)
+}
+
+func drainClusterNode(c *Context, w http.ResponseWriter, r *http.Request) {
+	setClusterNodeDraining(c, w, "drainClusterNode", true)
+}
+
+func undrainClusterNode(c *Context, w http.ResponseWriter, r *http.Request) {
+	setClusterNodeDraining(c, w, "undrainClusterNode", false)
+}
+
+func setClusterNodeDraining(c *Context, w http.ResponseWriter, where string, draining bool) {
+	c.RequireNodeId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteEnvironmentHighAvailability) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteEnvironmentHighAvailability)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(where, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "node_id", c.Params.NodeId)
+
+	if *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError(where, "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	var appErr *model.AppError
+	if draining {
+		appErr = c.App.Srv().DrainNode(c.Params.NodeId)
+	} else {
+		appErr = c.App.Srv().UndrainNode(c.Params.NodeId)
+	}
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
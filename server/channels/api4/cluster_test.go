@@ -5,7 +5,9 @@ package api4
 
 import (
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -36,3 +38,87 @@ func TestGetClusterStatus(t *testing.T) {
 		CheckForbiddenStatus(t, resp)
 	})
 }
+
+func TestClusterNodeDrain(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	nodeID := th.App.Srv().Platform().NodeID()
+
+	t.Run("as system user", func(t *testing.T) {
+		_, resp, err := th.Client.GetClusterNodeDrainState(nodeID)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.DrainClusterNode(nodeID)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.UndrainClusterNode(nodeID)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetClusterNodeDrainState(model.NewId())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		resp, err = th.SystemAdminClient.DrainClusterNode(model.NewId())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("as system admin", func(t *testing.T) {
+		state, _, err := th.SystemAdminClient.GetClusterNodeDrainState(nodeID)
+		require.NoError(t, err)
+		assert.Equal(t, nodeID, state.NodeId)
+		assert.False(t, state.Draining)
+
+		_, err = th.SystemAdminClient.DrainClusterNode(nodeID)
+		require.NoError(t, err)
+		defer th.SystemAdminClient.UndrainClusterNode(nodeID)
+
+		require.Eventually(t, func() bool {
+			state, _, err = th.SystemAdminClient.GetClusterNodeDrainState(nodeID)
+			return err == nil && state.Draining && state.Drained
+		}, 15*time.Second, 100*time.Millisecond)
+		assert.True(t, state.JobsHandedOff)
+		assert.NotZero(t, state.StartAt)
+
+		// The node reports itself unhealthy and refuses the new websocket connections.
+		status, _, err := th.SystemAdminClient.GetPing()
+		require.Error(t, err)
+		assert.Equal(t, model.StatusUnhealthy, status)
+
+		_, err = th.CreateWebSocketClient()
+		require.Error(t, err)
+
+		_, err = th.SystemAdminClient.UndrainClusterNode(nodeID)
+		require.NoError(t, err)
+
+		state, _, err = th.SystemAdminClient.GetClusterNodeDrainState(nodeID)
+		require.NoError(t, err)
+		assert.False(t, state.Draining)
+
+		status, _, err = th.SystemAdminClient.GetPing()
+		require.NoError(t, err)
+		assert.Equal(t, model.StatusOk, status)
+
+		wsClient, err := th.CreateWebSocketClient()
+		require.NoError(t, err)
+		wsClient.Close()
+	})
+
+	t.Run("as restricted system admin", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalSettings.RestrictSystemAdmin = true })
+
+		_, resp, err := th.SystemAdminClient.GetClusterNodeDrainState(nodeID)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.SystemAdminClient.DrainClusterNode(nodeID)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
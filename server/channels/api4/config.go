@@ -36,6 +36,9 @@ func (api *API) InitConfig() {
 	api.BaseRoutes.APIRoot.Handle("/config/reload", api.APISessionRequired(configReload)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/config/client", api.APIHandler(getClientConfig)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/config/environment", api.APISessionRequired(getEnvironmentConfig)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/config/validate", api.APISessionRequired(validateConfig)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/config/history", api.APISessionRequired(getConfigHistory)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/config/history/{config_change_id:[A-Za-z0-9]+}/rollback", api.APISessionRequired(rollbackConfig)).Methods("POST")
 }
 
 func init() {
@@ -121,27 +124,57 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	// audit.AddEventParameter(auditRec, "config", cfg)  // TODO We can do this but do we want to?
 	defer c.LogAuditRec(auditRec)
 
-	cfg.SetDefaults()
-
 	if !c.App.SessionHasPermissionToAny(*c.AppContext.Session(), model.SysconsoleWritePermissions) {
 		c.SetPermissionError(model.SysconsoleWritePermissions...)
 		return
 	}
 
+	cfg = applyConfigUpdate(c, auditRec, cfg, "")
+	if c.Err != nil {
+		return
+	}
+
+	//auditRec.AddEventResultState(cfg) // TODO we can do this too but do we want to? the config object is huge
+	auditRec.AddEventObjectType("config")
+	auditRec.Success()
+	c.LogAudit("updateConfig")
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if c.App.Channels().License().IsCloud() {
+		js, err := cfg.ToJSONFiltered(model.ConfigAccessTagType, model.ConfigAccessTagCloudRestrictable)
+		if err != nil {
+			c.Err = model.NewAppError("updateConfig", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			return
+		}
+		w.Write(js)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(cfg); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// applyConfigUpdate saves the config in place of the current one, within what the session is
+// allowed to change, and records the change in the config history and the audit record. It
+// returns the saved config as the session is allowed to read it.
+func applyConfigUpdate(c *Context, auditRec *audit.Record, cfg *model.Config, rollbackToID string) *model.Config {
+	cfg.SetDefaults()
+
 	appCfg := c.App.Config()
 	if *appCfg.ServiceSettings.SiteURL != "" && *cfg.ServiceSettings.SiteURL == "" {
 		c.Err = model.NewAppError("updateConfig", "api.config.update_config.clear_siteurl.app_error", nil, "", http.StatusBadRequest)
-		return
+		return nil
 	}
 
-	cfg, err = config.Merge(appCfg, cfg, &utils.MergeConfig{
+	cfg, err := config.Merge(appCfg, cfg, &utils.MergeConfig{
 		StructFieldFilter: func(structField reflect.StructField, base, patch reflect.Value) bool {
 			return writeFilter(c, structField)
 		},
 	})
 	if err != nil {
 		c.Err = model.NewAppError("updateConfig", "api.config.update_config.restricted_merge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
-		return
+		return nil
 	}
 
 	// Do not allow plugin uploads to be toggled through the API
@@ -157,9 +190,21 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		*cfg.PluginSettings.MarketplaceURL = *appCfg.PluginSettings.MarketplaceURL
 	}
 
+	// Do not allow the IP access policies to be lifted through the API
+	if ipAccessSettingsChanged(appCfg, cfg) {
+		c.Err = model.NewAppError("updateConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "IPAccessSettings"}, "", http.StatusForbidden)
+		return nil
+	}
+
+	// Do not allow the secrets backends to be changed through the API
+	if secretsSettingsChanged(appCfg, cfg) {
+		c.Err = model.NewAppError("updateConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "SecretsSettings"}, "", http.StatusForbidden)
+		return nil
+	}
+
 	if cfg.PluginSettings.PluginStates[model.PluginIdFocalboard].Enable && cfg.FeatureFlags.BoardsProduct {
 		c.Err = model.NewAppError("EnablePlugin", "app.plugin.product_mode.app_error", map[string]any{"Name": model.PluginIdFocalboard}, "", http.StatusInternalServerError)
-		return
+		return nil
 	}
 
 	// There are some settings that cannot be changed in a cloud env
@@ -168,7 +213,7 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		// and appCfg is the existing earlier config and if it's nil, server sets a default value.
 		if *appCfg.ComplianceSettings.Directory != *cfg.ComplianceSettings.Directory {
 			c.Err = model.NewAppError("updateConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "ComplianceSettings.Directory"}, "", http.StatusForbidden)
-			return
+			return nil
 		}
 	}
 
@@ -176,28 +221,30 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	if appErr := cfg.IsValid(); appErr != nil {
 		c.Err = appErr
-		return
+		return nil
 	}
 
 	oldCfg, newCfg, appErr := c.App.SaveConfig(cfg, true)
 	if appErr != nil {
 		c.Err = appErr
-		return
+		return nil
 	}
 
+	recordConfigChange(c, oldCfg, newCfg, rollbackToID)
+
 	// If the config for default server locale has changed, reinitialize the server's translations.
 	if oldCfg.LocalizationSettings.DefaultServerLocale != newCfg.LocalizationSettings.DefaultServerLocale {
 		s := newCfg.LocalizationSettings
 		if err = i18n.InitTranslations(*s.DefaultServerLocale, *s.DefaultClientLocale); err != nil {
 			c.Err = model.NewAppError("updateConfig", "api.config.update_config.translations.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
-			return
+			return nil
 		}
 	}
 
 	diffs, err := config.Diff(oldCfg, newCfg)
 	if err != nil {
 		c.Err = model.NewAppError("updateConfig", "api.config.update_config.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
-		return
+		return nil
 	}
 	auditRec.AddEventPriorState(&diffs)
 
@@ -210,19 +257,122 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	})
 	if err != nil {
 		c.Err = model.NewAppError("updateConfig", "api.config.update_config.restricted_merge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return nil
+	}
+
+	return cfg
+}
+
+// ipAccessSettingsChanged reports whether the IP access settings of the configs differ, the
+// masked emergency bypass token standing for the current one.
+func ipAccessSettingsChanged(oldCfg, newCfg *model.Config) bool {
+	settings := newCfg.IPAccessSettings
+	if settings.EmergencyBypassToken != nil && *settings.EmergencyBypassToken == model.FakeSetting {
+		settings.EmergencyBypassToken = oldCfg.IPAccessSettings.EmergencyBypassToken
+	}
+
+	return !reflect.DeepEqual(oldCfg.IPAccessSettings, settings)
+}
+
+// secretsSettingsChanged reports whether the secrets settings of the configs differ, the masked
+// Vault token standing for the current one.
+func secretsSettingsChanged(oldCfg, newCfg *model.Config) bool {
+	settings := newCfg.SecretsSettings
+	if settings.VaultToken != nil && *settings.VaultToken == model.FakeSetting {
+		settings.VaultToken = oldCfg.SecretsSettings.VaultToken
+	}
+
+	return !reflect.DeepEqual(oldCfg.SecretsSettings, settings)
+}
+
+// recordConfigChange adds the save of the config to the config history. The config was already
+// saved, so failing to record it only loses it from the history.
+func recordConfigChange(c *Context, oldCfg, newCfg *model.Config, rollbackToID string) {
+	if _, appErr := c.App.RecordConfigChange(c.AppContext, oldCfg, newCfg, rollbackToID); appErr != nil {
+		c.Logger.Warn("Failed to record the config change", mlog.Err(appErr))
+	}
+}
+
+func validateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
+	var cfg *model.Config
+	err := json.NewDecoder(r.Body).Decode(&cfg)
+	if err != nil || cfg == nil {
+		c.SetInvalidParamWithErr("config", err)
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	// The validation connects to the services of the config, like the test endpoints.
+	if *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError("validateConfig", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	report := c.App.ValidateConfig(cfg)
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getConfigHistory(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	changes, appErr := c.App.GetConfigHistory(c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if err := json.NewEncoder(w).Encode(changes); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func rollbackConfig(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireConfigChangeId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("rollbackConfig", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "config_change_id", c.Params.ConfigChangeId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	change, appErr := c.App.GetConfigChange(c.Params.ConfigChangeId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	// The config of the change goes through the same checks as any config update.
+	cfg := applyConfigUpdate(c, auditRec, change.Config, change.Id)
+	if c.Err != nil {
 		return
 	}
 
-	//auditRec.AddEventResultState(cfg) // TODO we can do this too but do we want to? the config object is huge
 	auditRec.AddEventObjectType("config")
 	auditRec.Success()
-	c.LogAudit("updateConfig")
+	c.LogAudit("rollbackConfig")
 
 	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
 	if c.App.Channels().License().IsCloud() {
 		js, err := cfg.ToJSONFiltered(model.ConfigAccessTagType, model.ConfigAccessTagCloudRestrictable)
 		if err != nil {
-			c.Err = model.NewAppError("updateConfig", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			c.Err = model.NewAppError("rollbackConfig", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 			return
 		}
 		w.Write(js)
@@ -329,6 +479,18 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Do not allow the IP access policies to be lifted through the API
+	if ipAccessSettingsChanged(appCfg, updatedCfg) {
+		c.Err = model.NewAppError("patchConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "IPAccessSettings"}, "", http.StatusForbidden)
+		return
+	}
+
+	// Do not allow the secrets backends to be changed through the API
+	if secretsSettingsChanged(appCfg, updatedCfg) {
+		c.Err = model.NewAppError("patchConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "SecretsSettings"}, "", http.StatusForbidden)
+		return
+	}
+
 	appErr := updatedCfg.IsValid()
 	if appErr != nil {
 		c.Err = appErr
@@ -341,6 +503,8 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	recordConfigChange(c, oldCfg, newCfg, "")
+
 	diffs, err := config.Diff(oldCfg, newCfg)
 	if err != nil {
 		c.Err = model.NewAppError("patchConfig", "api.config.patch_config.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -69,6 +69,8 @@ func localUpdateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	recordConfigChange(c, oldCfg, newCfg, "")
+
 	diffs, diffErr := config.Diff(oldCfg, newCfg)
 	if diffErr != nil {
 		c.Err = model.NewAppError("updateConfig", "api.config.update_config.diff.app_error", nil, diffErr.Error(), http.StatusInternalServerError)
@@ -128,6 +130,8 @@ func localPatchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	recordConfigChange(c, oldCfg, newCfg, "")
+
 	diffs, err := config.Diff(oldCfg, newCfg)
 	if err != nil {
 		c.Err = model.NewAppError("patchConfig", "api.config.patch_config.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -259,6 +259,32 @@ func TestUpdateConfig(t *testing.T) {
 		CheckForbiddenStatus(t, resp)
 	})
 
+	t.Run("Should not be able to modify SecretsSettings", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.SecretsSettings.VaultToken = "vault-token" })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.SecretsSettings.VaultToken = "" })
+
+		cfg2, _, err := th.SystemAdminClient.GetConfig()
+		require.NoError(t, err)
+
+		// Saving the config as read, with the masked Vault token, is allowed.
+		_, _, err = th.SystemAdminClient.UpdateConfig(cfg2)
+		require.NoError(t, err)
+
+		*cfg2.SecretsSettings.EnvFile = "/etc/passwd"
+		_, resp, err := th.SystemAdminClient.UpdateConfig(cfg2)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.PatchConfig(&model.Config{
+			SecretsSettings: model.SecretsSettings{VaultAddress: model.NewString("https://vault.example.com")},
+		})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		assert.Empty(t, *th.App.Config().SecretsSettings.EnvFile)
+		assert.Empty(t, *th.App.Config().SecretsSettings.VaultAddress)
+	})
+
 	t.Run("System Admin should not be able to clear Site URL", func(t *testing.T) {
 		siteURL := cfg.ServiceSettings.SiteURL
 		defer th.App.UpdateConfig(func(cfg *model.Config) { cfg.ServiceSettings.SiteURL = siteURL })
@@ -859,3 +885,84 @@ func TestMigrateConfig(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestConfigHistory(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	siteName := *th.App.Config().TeamSettings.SiteName
+
+	_, resp, err := th.Client.GetConfigHistory(0, 10)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	cfg, _, err := th.SystemAdminClient.GetConfig()
+	require.NoError(t, err)
+	*cfg.TeamSettings.SiteName = "Before"
+	_, _, err = th.SystemAdminClient.UpdateConfig(cfg)
+	require.NoError(t, err)
+
+	_, _, err = th.SystemAdminClient.PatchConfig(&model.Config{TeamSettings: model.TeamSettings{SiteName: model.NewString("After")}})
+	require.NoError(t, err)
+
+	changes, _, err := th.SystemAdminClient.GetConfigHistory(0, 2)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, th.SystemAdminUser.Id, changes[0].UserId)
+	require.Len(t, changes[0].Diffs, 1)
+	assert.Equal(t, "TeamSettings.SiteName", changes[0].Diffs[0].Path)
+	assert.Equal(t, "Before", changes[0].Diffs[0].OldValue)
+	assert.Equal(t, "After", changes[0].Diffs[0].NewValue)
+	before := changes[1]
+
+	t.Run("only admins can roll the config back", func(t *testing.T) {
+		_, resp, err := th.Client.RollbackConfig(before.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("unknown change", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.RollbackConfig(model.NewId())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	cfg, _, err = th.SystemAdminClient.RollbackConfig(before.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "Before", *cfg.TeamSettings.SiteName)
+	assert.Equal(t, "Before", *th.App.Config().TeamSettings.SiteName)
+
+	changes, _, err = th.SystemAdminClient.GetConfigHistory(0, 1)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, before.Id, changes[0].RollbackToId)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.SiteName = siteName })
+}
+
+func TestValidateConfig(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	cfg, _, err := th.SystemAdminClient.GetConfig()
+	require.NoError(t, err)
+	*cfg.EmailSettings.SMTPServer = ""
+
+	_, resp, err := th.Client.ValidateConfig(cfg)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	report, _, err := th.SystemAdminClient.ValidateConfig(cfg)
+	require.NoError(t, err)
+	assert.True(t, report.Valid)
+	require.NotEmpty(t, report.Checks)
+
+	siteURL := *th.App.Config().ServiceSettings.SiteURL
+	*cfg.ServiceSettings.SiteURL = ""
+	*cfg.GitLabSettings.Enable = true
+	report, _, err = th.SystemAdminClient.ValidateConfig(cfg)
+	require.NoError(t, err)
+	assert.False(t, report.Valid)
+	assert.Equal(t, siteURL, *th.App.Config().ServiceSettings.SiteURL, "the config isn't saved")
+	assert.False(t, *th.App.Config().GitLabSettings.Enable)
+}
@@ -17,6 +17,7 @@ func (api *API) InitDataRetention() {
 	api.BaseRoutes.DataRetention.Handle("/policies", api.APISessionRequired(getPolicies)).Methods("GET")
 	api.BaseRoutes.DataRetention.Handle("/policies_count", api.APISessionRequired(getPoliciesCount)).Methods("GET")
 	api.BaseRoutes.DataRetention.Handle("/policies", api.APISessionRequired(createPolicy)).Methods("POST")
+	api.BaseRoutes.DataRetention.Handle("/policies/preview", api.APISessionRequired(previewPolicy)).Methods("POST")
 	api.BaseRoutes.DataRetention.Handle("/policies/{policy_id:[A-Za-z0-9]+}", api.APISessionRequired(getPolicy)).Methods("GET")
 	api.BaseRoutes.DataRetention.Handle("/policies/{policy_id:[A-Za-z0-9]+}", api.APISessionRequired(patchPolicy)).Methods("PATCH")
 	api.BaseRoutes.DataRetention.Handle("/policies/{policy_id:[A-Za-z0-9]+}", api.APISessionRequired(deletePolicy)).Methods("DELETE")
@@ -147,6 +148,32 @@ func createPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write(js)
 }
 
+func previewPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	var policy model.RetentionPolicyWithTeamAndChannelIDs
+	if jsonErr := json.NewDecoder(r.Body).Decode(&policy); jsonErr != nil {
+		c.SetInvalidParamWithErr("policy", jsonErr)
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadComplianceDataRetentionPolicy) {
+		c.SetPermissionError(model.PermissionSysconsoleReadComplianceDataRetentionPolicy)
+		return
+	}
+
+	preview, appErr := c.App.PreviewRetentionPolicy(&policy)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(preview)
+	if err != nil {
+		c.Err = model.NewAppError("previewPolicy", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+	w.Write(js)
+}
+
 func patchPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
 	var patch model.RetentionPolicyWithTeamAndChannelIDs
 	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
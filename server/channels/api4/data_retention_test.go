@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
 )
 
 func TestDataRetentionGetPolicy(t *testing.T) {
@@ -17,3 +19,24 @@ func TestDataRetentionGetPolicy(t *testing.T) {
 	require.Error(t, err)
 	CheckNotImplementedStatus(t, resp)
 }
+
+func TestDataRetentionPreviewPolicy(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	policy := &model.RetentionPolicyWithTeamAndChannelIDs{
+		RetentionPolicy: model.RetentionPolicy{
+			DisplayName:      "Policy",
+			PostDurationDays: model.NewInt64(30),
+		},
+		ChannelIDs: []string{th.BasicChannel.Id},
+	}
+
+	_, resp, err := th.Client.PreviewDataRetentionPolicy(policy)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = th.SystemAdminClient.PreviewDataRetentionPolicy(policy)
+	require.Error(t, err)
+	CheckNotImplementedStatus(t, resp)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// Direct outgoing webhooks receive private conversations, so unlike the other integrations they
+// can only be managed by system admins.
+
+func createDirectOutgoingHook(c *Context, w http.ResponseWriter, r *http.Request) {
+	var hook model.DirectOutgoingWebhook
+	if jsonErr := json.NewDecoder(r.Body).Decode(&hook); jsonErr != nil {
+		c.SetInvalidParamWithErr("direct_outgoing_webhook", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createDirectOutgoingHook", audit.Fail)
+	audit.AddEventParameterAuditable(auditRec, "hook", &hook)
+	defer c.LogAuditRec(auditRec)
+	c.LogAudit("attempt")
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	hook.CreatorId = c.AppContext.Session().UserId
+
+	rhook, err := c.App.CreateDirectOutgoingWebhook(&hook)
+	if err != nil {
+		c.LogAudit("fail")
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(rhook)
+	auditRec.AddEventObjectType("direct_outgoing_webhook")
+	c.LogAudit("success")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(rhook); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getDirectOutgoingHooks(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	hooks, appErr := c.App.GetDirectOutgoingWebhooksPage(c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(hooks)
+	if err != nil {
+		c.Err = model.NewAppError("getDirectOutgoingHooks", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	w.Write(js)
+}
+
+func getDirectOutgoingHook(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireHookId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	hook, err := c.App.GetDirectOutgoingWebhook(c.Params.HookId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(hook); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateDirectOutgoingHook(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireHookId()
+	if c.Err != nil {
+		return
+	}
+
+	var updatedHook model.DirectOutgoingWebhook
+	if jsonErr := json.NewDecoder(r.Body).Decode(&updatedHook); jsonErr != nil {
+		c.SetInvalidParamWithErr("direct_outgoing_webhook", jsonErr)
+		return
+	}
+
+	// The hook being updated in the payload must be the same one as indicated in the URL.
+	if updatedHook.Id != c.Params.HookId {
+		c.SetInvalidParam("hook_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateDirectOutgoingHook", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameterAuditable(auditRec, "updated_hook", &updatedHook)
+	c.LogAudit("attempt")
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	oldHook, err := c.App.GetDirectOutgoingWebhook(c.Params.HookId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	updatedHook.CreatorId = c.AppContext.Session().UserId
+
+	rhook, err := c.App.UpdateDirectOutgoingWebhook(oldHook, &updatedHook)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(rhook)
+	auditRec.AddEventObjectType("direct_outgoing_webhook")
+	c.LogAudit("success")
+
+	if err := json.NewEncoder(w).Encode(rhook); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteDirectOutgoingHook(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireHookId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteDirectOutgoingHook", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "hook_id", c.Params.HookId)
+	c.LogAudit("attempt")
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	hook, err := c.App.GetDirectOutgoingWebhook(c.Params.HookId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddMeta("hook_display", hook.DisplayName)
+	auditRec.AddMeta("bot_user_id", hook.BotUserId)
+
+	if err := c.App.DeleteDirectOutgoingWebhook(hook.Id); err != nil {
+		c.LogAudit("fail")
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("success")
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestDirectOutgoingWebhooks(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	bot := th.CreateBotWithSystemAdminClient()
+	hook := &model.DirectOutgoingWebhook{BotUserId: bot.UserId, CallbackURLs: []string{"http://nowhere.com"}}
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.EnableOutgoingWebhooks = true
+			*cfg.ServiceSettings.EnableDirectOutgoingWebhooks = false
+		})
+
+		_, resp, err := th.SystemAdminClient.CreateDirectOutgoingWebhook(hook)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableDirectOutgoingWebhooks = true })
+
+	t.Run("not a system admin", func(t *testing.T) {
+		_, resp, err := th.Client.CreateDirectOutgoingWebhook(hook)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetDirectOutgoingWebhooks(0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("not a bot", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateDirectOutgoingWebhook(&model.DirectOutgoingWebhook{BotUserId: th.BasicUser.Id, CallbackURLs: []string{"http://nowhere.com"}})
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	rhook, resp, err := th.SystemAdminClient.CreateDirectOutgoingWebhook(hook)
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, bot.UserId, rhook.BotUserId)
+	assert.Equal(t, th.SystemAdminUser.Id, rhook.CreatorId)
+
+	hooks, _, err := th.SystemAdminClient.GetDirectOutgoingWebhooks(0, 60)
+	require.NoError(t, err)
+	require.Len(t, hooks, 1)
+	assert.Equal(t, rhook.Id, hooks[0].Id)
+
+	rhook.DisplayName = "Updated"
+	updated, _, err := th.SystemAdminClient.UpdateDirectOutgoingWebhook(rhook)
+	require.NoError(t, err)
+	assert.Equal(t, "Updated", updated.DisplayName)
+
+	fetched, _, err := th.SystemAdminClient.GetDirectOutgoingWebhook(rhook.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "Updated", fetched.DisplayName)
+
+	resp, err = th.Client.DeleteDirectOutgoingWebhook(rhook.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, err = th.SystemAdminClient.DeleteDirectOutgoingWebhook(rhook.Id)
+	require.NoError(t, err)
+
+	_, resp, err = th.SystemAdminClient.GetDirectOutgoingWebhook(rhook.Id)
+	require.Error(t, err)
+	CheckNotFoundStatus(t, resp)
+}
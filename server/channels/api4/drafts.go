@@ -13,6 +13,7 @@ import (
 
 func (api *API) InitDrafts() {
 	api.BaseRoutes.Drafts.Handle("", api.APISessionRequired(upsertDraft)).Methods("POST")
+	api.BaseRoutes.Drafts.Handle("/merge", api.APISessionRequired(mergeDraft)).Methods("POST")
 
 	api.BaseRoutes.TeamForUser.Handle("/drafts", api.APISessionRequired(getDrafts)).Methods("GET")
 
@@ -37,35 +38,100 @@ func upsertDraft(c *Context, w http.ResponseWriter, r *http.Request) {
 	draft.UserId = c.AppContext.Session().UserId
 	connectionID := r.Header.Get(model.ConnectionId)
 
-	hasPermission := false
+	if !hasPermissionToDraft(c, draft.ChannelId) {
+		c.SetPermissionError(model.PermissionCreatePost)
+		return
+	}
 
-	if c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), draft.ChannelId, model.PermissionCreatePost) {
-		hasPermission = true
-	} else if channel, err := c.App.GetChannel(c.AppContext, draft.ChannelId); err == nil {
-		// Temporary permission check method until advanced permissions, please do not copy
-		if channel.Type == model.ChannelTypeOpen && c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), channel.TeamId, model.PermissionCreatePostPublic) {
-			hasPermission = true
+	dt, err := c.App.UpsertDraft(c.AppContext, &draft, connectionID)
+	if err != nil {
+		if err.StatusCode == http.StatusConflict {
+			writeDraftConflict(c, w, err, &draft)
+			return
 		}
+		c.Err = err
+		return
 	}
 
-	if !hasPermission {
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(dt); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func mergeDraft(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !*c.App.Config().ServiceSettings.AllowSyncedDrafts {
+		c.Err = model.NewAppError("mergeDraft", "api.drafts.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	var draft model.Draft
+	if jsonErr := json.NewDecoder(r.Body).Decode(&draft); jsonErr != nil {
+		c.SetInvalidParam("draft")
+		return
+	}
+
+	draft.DeleteAt = 0
+	draft.UserId = c.AppContext.Session().UserId
+	connectionID := r.Header.Get(model.ConnectionId)
+
+	if !hasPermissionToDraft(c, draft.ChannelId) {
 		c.SetPermissionError(model.PermissionCreatePost)
 		return
 	}
 
-	dt, err := c.App.UpsertDraft(c.AppContext, &draft, connectionID)
+	dt, err := c.App.MergeDraft(c.AppContext, &draft, connectionID)
 	if err != nil {
+		if err.StatusCode == http.StatusConflict {
+			writeDraftConflict(c, w, err, &draft)
+			return
+		}
 		c.Err = err
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
-
 	if err := json.NewEncoder(w).Encode(dt); err != nil {
 		mlog.Warn("Error while writing response", mlog.Err(err))
 	}
 }
 
+func hasPermissionToDraft(c *Context, channelID string) bool {
+	if c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), channelID, model.PermissionCreatePost) {
+		return true
+	}
+
+	if channel, err := c.App.GetChannel(c.AppContext, channelID); err == nil {
+		// Temporary permission check method until advanced permissions, please do not copy
+		if channel.Type == model.ChannelTypeOpen && c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), channel.TeamId, model.PermissionCreatePostPublic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writeDraftConflict writes the error of a draft updated from an outdated version along with the
+// stored draft, for the client to resolve the conflict.
+func writeDraftConflict(c *Context, w http.ResponseWriter, appErr *model.AppError, draft *model.Draft) {
+	serverDraft, err := c.App.GetDraft(draft.UserId, draft.ChannelId, draft.RootId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	appErr.RequestId = c.AppContext.RequestId()
+	appErr.Translate(c.AppContext.T)
+	if !*c.App.Config().ServiceSettings.EnableDeveloper {
+		appErr.DetailedError = ""
+	}
+
+	w.WriteHeader(http.StatusConflict)
+	if err := json.NewEncoder(w).Encode(&model.DraftConflict{AppError: appErr, ServerDraft: serverDraft, ClientDraft: draft}); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getDrafts(c *Context, w http.ResponseWriter, r *http.Request) {
 	if c.Err != nil {
 		return
@@ -4,6 +4,9 @@
 package api4
 
 import (
+	"bytes"
+	"encoding/json"
+	"net/http"
 	"os"
 	"testing"
 
@@ -84,6 +87,120 @@ func TestUpsertDraft(t *testing.T) {
 	CheckNotImplementedStatus(t, resp)
 }
 
+func TestUpsertDraftConflict(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GLOBALDRAFTS", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GLOBALDRAFTS")
+	os.Setenv("MM_SERVICESETTINGS_ALLOWSYNCEDDRAFTS", "true")
+	defer os.Unsetenv("MM_SERVICESETTINGS_ALLOWSYNCEDDRAFTS")
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { cfg.FeatureFlags.GlobalDrafts = true })
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.AllowSyncedDrafts = true })
+
+	client := th.Client
+
+	draftResp, _, err := client.UpsertDraft(&model.Draft{ChannelId: th.BasicChannel.Id, Message: "original"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), draftResp.Version)
+
+	// a first client updates the draft from the version it got
+	draftResp, _, err = client.UpsertDraft(&model.Draft{ChannelId: th.BasicChannel.Id, Message: "original edited", Version: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), draftResp.Version)
+
+	t.Run("outdated version", func(t *testing.T) {
+		_, resp, err := client.UpsertDraft(&model.Draft{ChannelId: th.BasicChannel.Id, Message: "other edit", Version: 1})
+		require.Error(t, err)
+		checkHTTPStatus(t, resp, http.StatusConflict)
+
+		draft, appErr := th.App.GetDraft(th.BasicUser.Id, th.BasicChannel.Id, "")
+		require.Nil(t, appErr)
+		assert.Equal(t, "original edited", draft.Message)
+	})
+
+	t.Run("conflict carries both drafts", func(t *testing.T) {
+		buf, err := json.Marshal(&model.Draft{ChannelId: th.BasicChannel.Id, Message: "other edit", Version: 1})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", client.APIURL+"/drafts", bytes.NewReader(buf))
+		require.NoError(t, err)
+		req.Header.Set(model.HeaderAuth, client.AuthType+" "+client.AuthToken)
+
+		resp, err := client.HTTPClient.Do(req)
+		require.NoError(t, err)
+		defer closeBody(resp)
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		var conflict model.DraftConflict
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&conflict))
+		assert.Equal(t, "app.draft.update.conflict.app_error", conflict.Id)
+		require.NotNil(t, conflict.ServerDraft)
+		assert.Equal(t, "original edited", conflict.ServerDraft.Message)
+		assert.Equal(t, int64(2), conflict.ServerDraft.Version)
+		require.NotNil(t, conflict.ClientDraft)
+		assert.Equal(t, "other edit", conflict.ClientDraft.Message)
+		assert.Equal(t, int64(1), conflict.ClientDraft.Version)
+	})
+
+	t.Run("latest version", func(t *testing.T) {
+		draftResp, _, err := client.UpsertDraft(&model.Draft{ChannelId: th.BasicChannel.Id, Message: "resolved", Version: 2})
+		require.NoError(t, err)
+		assert.Equal(t, "resolved", draftResp.Message)
+		assert.Equal(t, int64(3), draftResp.Version)
+	})
+
+	t.Run("without version", func(t *testing.T) {
+		draftResp, _, err := client.UpsertDraft(&model.Draft{ChannelId: th.BasicChannel.Id, Message: "overwritten"})
+		require.NoError(t, err)
+		assert.Equal(t, "overwritten", draftResp.Message)
+		assert.Equal(t, int64(4), draftResp.Version)
+	})
+}
+
+func TestMergeDraft(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GLOBALDRAFTS", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GLOBALDRAFTS")
+	os.Setenv("MM_SERVICESETTINGS_ALLOWSYNCEDDRAFTS", "true")
+	defer os.Unsetenv("MM_SERVICESETTINGS_ALLOWSYNCEDDRAFTS")
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { cfg.FeatureFlags.GlobalDrafts = true })
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.AllowSyncedDrafts = true })
+
+	client := th.Client
+
+	t.Run("nothing to merge with", func(t *testing.T) {
+		draftResp, _, err := client.MergeDraft(&model.Draft{ChannelId: th.BasicChannel2.Id, Message: "new", Version: 3})
+		require.NoError(t, err)
+		assert.Equal(t, "new", draftResp.Message)
+		assert.Equal(t, int64(1), draftResp.Version)
+	})
+
+	t.Run("merge with the stored draft", func(t *testing.T) {
+		_, _, err := client.UpsertDraft(&model.Draft{ChannelId: th.BasicChannel.Id, Message: "hello"})
+		require.NoError(t, err)
+		_, _, err = client.UpsertDraft(&model.Draft{ChannelId: th.BasicChannel.Id, Message: "hello from the server", Version: 1})
+		require.NoError(t, err)
+
+		draftResp, _, err := client.MergeDraft(&model.Draft{ChannelId: th.BasicChannel.Id, Message: "hi from the client", Version: 1})
+		require.NoError(t, err)
+		assert.Equal(t, "hello from the server\n\nhi from the client", draftResp.Message)
+		assert.Equal(t, int64(3), draftResp.Version)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		privateChannel := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+
+		_, resp, err := client.MergeDraft(&model.Draft{ChannelId: privateChannel.Id, Message: "hello"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
 func TestGetDrafts(t *testing.T) {
 	os.Setenv("MM_FEATUREFLAGS_GLOBALDRAFTS", "true")
 	defer os.Unsetenv("MM_FEATUREFLAGS_GLOBALDRAFTS")
@@ -469,7 +469,7 @@ func getFile(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	fileReader, err := c.App.FileReader(info.Path)
+	fileReader, err := c.App.FileInfoReader(info)
 	if err != nil {
 		c.Err = err
 		c.Err.StatusCode = http.StatusNotFound
@@ -649,7 +649,7 @@ func getPublicFile(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	fileReader, err := c.App.FileReader(info.Path)
+	fileReader, err := c.App.FileInfoReader(info)
 	if err != nil {
 		c.Err = err
 		c.Err.StatusCode = http.StatusNotFound
@@ -728,6 +728,7 @@ func searchFiles(c *Context, w http.ResponseWriter, r *http.Request, teamID stri
 		return
 	}
 
+	c.CancelWithRequest(r)
 	startTime := time.Now()
 
 	results, err := c.App.SearchFilesInTeamForUser(c.AppContext, terms, c.AppContext.Session().UserId, teamID, isOrSearch, includeDeletedChannels, timeZoneOffset, page, perPage, modifier)
@@ -5,8 +5,11 @@ package api4
 
 import (
 	"context"
-	_ "embed"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
 	"encoding/json"
+	"io/fs"
 	"net/http"
 
 	"github.com/graph-gophers/dataloader/v6"
@@ -19,9 +22,21 @@ import (
 )
 
 type graphQLInput struct {
-	Query         string         `json:"query"`
-	OperationName string         `json:"operationName"`
-	Variables     map[string]any `json:"variables"`
+	Query         string            `json:"query"`
+	OperationName string            `json:"operationName"`
+	Variables     map[string]any    `json:"variables"`
+	Extensions    graphQLExtensions `json:"extensions"`
+}
+
+type graphQLExtensions struct {
+	PersistedQuery *graphQLPersistedQuery `json:"persistedQuery"`
+}
+
+// graphQLPersistedQuery refers to one of the queries in graphql_persisted
+// by the hex encoded SHA-256 of its file.
+type graphQLPersistedQuery struct {
+	Version    int    `json:"version"`
+	Sha256Hash string `json:"sha256Hash"`
 }
 
 // Unique type to hold our context.
@@ -33,6 +48,7 @@ const (
 	channelsLoaderCtx ctxKey = 2
 	teamsLoaderCtx    ctxKey = 3
 	usersLoaderCtx    ctxKey = 4
+	postsLoaderCtx    ctxKey = 5
 )
 
 const loaderBatchCapacity = web.PerPageMaximum
@@ -40,9 +56,40 @@ const loaderBatchCapacity = web.PerPageMaximum
 //go:embed schema.graphqls
 var schemaRaw string
 
+// The queries sent by the first-party clients. Clients may send the hash of
+// a query instead of the query, and the server can be restricted to them
+// with ServiceSettings.GraphQLPersistedQueriesOnly.
+//
+//go:embed graphql_persisted/*.graphql
+var persistedQueriesFS embed.FS
+
+// persistedQueries maps the SHA-256 of the persisted queries to the queries.
+var persistedQueries = loadPersistedQueries()
+
+func loadPersistedQueries() map[string]string {
+	queries := make(map[string]string)
+	files, err := fs.Glob(persistedQueriesFS, "graphql_persisted/*.graphql")
+	if err != nil {
+		panic(err)
+	}
+	for _, file := range files {
+		query, err := persistedQueriesFS.ReadFile(file)
+		if err != nil {
+			panic(err)
+		}
+		queries[graphQLQueryHash(string(query))] = string(query)
+	}
+	return queries
+}
+
+func graphQLQueryHash(query string) string {
+	hash := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(hash[:])
+}
+
 func (api *API) InitGraphQL() error {
-	// Guard with a feature flag.
-	if !api.srv.Config().FeatureFlags.GraphQL {
+	// Guard with the config setting, or the feature flag of the experiment.
+	if !api.srv.Config().FeatureFlags.GraphQL && !*api.srv.Config().ServiceSettings.EnableGraphQL {
 		return nil
 	}
 
@@ -102,6 +149,35 @@ func (api *API) graphQL(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if pq := params.Extensions.PersistedQuery; pq != nil {
+		query, ok := persistedQueries[pq.Sha256Hash]
+		if !ok {
+			response = &graphql.Response{Errors: []*gqlerrors.QueryError{gqlerrors.Errorf("PersistedQueryNotFound")}}
+			return
+		}
+		if params.Query != "" && params.Query != query {
+			response = &graphql.Response{Errors: []*gqlerrors.QueryError{gqlerrors.Errorf("provided sha256Hash does not match query")}}
+			return
+		}
+		params.Query = query
+	} else if *c.App.Config().ServiceSettings.GraphQLPersistedQueriesOnly {
+		if _, ok := persistedQueries[graphQLQueryHash(params.Query)]; !ok {
+			response = &graphql.Response{Errors: []*gqlerrors.QueryError{gqlerrors.Errorf("PersistedQueryNotAllowed")}}
+			return
+		}
+	}
+
+	complexity, err := estimateGraphQLComplexity(api.schema.ASTSchema(), params.Query, params.OperationName, params.Variables)
+	if err != nil {
+		response = &graphql.Response{Errors: []*gqlerrors.QueryError{gqlerrors.Errorf("invalid query: %v", err)}}
+		return
+	}
+	if maxComplexity := *c.App.Config().ServiceSettings.GraphQLMaxComplexity; complexity > maxComplexity {
+		err2 := gqlerrors.Errorf("query complexity %d higher than allowed maximum of %d", complexity, maxComplexity)
+		response = &graphql.Response{Errors: []*gqlerrors.QueryError{err2}}
+		return
+	}
+
 	c.GraphQLOperationName = params.OperationName
 
 	// Populate the context with required info.
@@ -120,6 +196,9 @@ func (api *API) graphQL(c *Context, w http.ResponseWriter, r *http.Request) {
 	usersLoader := dataloader.NewBatchedLoader(graphQLUsersLoader, dataloader.WithBatchCapacity(loaderBatchCapacity))
 	reqCtx = context.WithValue(reqCtx, usersLoaderCtx, usersLoader)
 
+	postsLoader := dataloader.NewBatchedLoader(graphQLPostsLoader, dataloader.WithBatchCapacity(loaderBatchCapacity))
+	reqCtx = context.WithValue(reqCtx, postsLoaderCtx, postsLoader)
+
 	response = api.schema.Exec(reqCtx,
 		params.Query,
 		params.OperationName,
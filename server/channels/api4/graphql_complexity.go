@@ -0,0 +1,560 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+// graphQLListComplexity is how many items a list field without a first argument is assumed to
+// return.
+const graphQLListComplexity = 10
+
+// graphQLComplexity estimates the cost of a query before running it. Every field costs one, and
+// the fields selected under a list field are counted once per item it may return: the value of
+// its first argument, or graphQLListComplexity when it doesn't have one.
+//
+// The library executing the queries doesn't expose its parser, so the query is parsed here. This
+// parser is lenient and leaves the validation of the query to the library.
+type graphQLComplexity struct {
+	schema           *types.Schema
+	variables        map[string]any
+	variableDefaults map[string]string
+
+	tokens    []string
+	pos       int
+	fragments map[string]*gqlFragment
+	visiting  map[string]bool
+}
+
+type gqlSelection struct {
+	// name is empty for inline fragments and fragment spreads.
+	name      string
+	arguments map[string]string
+	// spread is the name of the spread fragment.
+	spread string
+	// typeCondition is the type of an inline fragment, if any.
+	typeCondition string
+	selections    []*gqlSelection
+}
+
+type gqlFragment struct {
+	typeName   string
+	selections []*gqlSelection
+}
+
+type gqlOperation struct {
+	name string
+	kind string
+	// variableDefaults are the scalar defaults of the variables, as written in the query.
+	variableDefaults map[string]string
+	selections       []*gqlSelection
+}
+
+// estimateGraphQLComplexity returns the estimated cost of the operation of the query.
+func estimateGraphQLComplexity(schema *types.Schema, query, operationName string, variables map[string]any) (int, error) {
+	tokens, err := lexGraphQL(query)
+	if err != nil {
+		return 0, err
+	}
+
+	gc := &graphQLComplexity{
+		schema:    schema,
+		variables: variables,
+		tokens:    tokens,
+		fragments: map[string]*gqlFragment{},
+		visiting:  map[string]bool{},
+	}
+
+	operations, err := gc.parseDocument()
+	if err != nil {
+		return 0, err
+	}
+
+	var operation *gqlOperation
+	for _, op := range operations {
+		if operationName == "" || op.name == operationName {
+			operation = op
+			break
+		}
+	}
+	if operation == nil {
+		return 0, fmt.Errorf("operation %q not found", operationName)
+	}
+
+	root, ok := schema.RootOperationTypes[operation.kind]
+	if !ok {
+		return 0, fmt.Errorf("unknown operation type %q", operation.kind)
+	}
+	gc.variableDefaults = operation.variableDefaults
+
+	return gc.cost(operation.selections, root)
+}
+
+func (gc *graphQLComplexity) cost(selections []*gqlSelection, parent types.NamedType) (int, error) {
+	total := 0
+	for _, selection := range selections {
+		switch {
+		case selection.spread != "":
+			fragment, ok := gc.fragments[selection.spread]
+			if !ok {
+				return 0, fmt.Errorf("fragment %q not found", selection.spread)
+			}
+			if gc.visiting[selection.spread] {
+				return 0, fmt.Errorf("fragment %q spreads itself", selection.spread)
+			}
+			gc.visiting[selection.spread] = true
+			cost, err := gc.cost(fragment.selections, gc.namedType(fragment.typeName, parent))
+			gc.visiting[selection.spread] = false
+			if err != nil {
+				return 0, err
+			}
+			total += cost
+
+		case selection.name == "":
+			cost, err := gc.cost(selection.selections, gc.namedType(selection.typeCondition, parent))
+			if err != nil {
+				return 0, err
+			}
+			total += cost
+
+		default:
+			total++
+			if len(selection.selections) == 0 {
+				continue
+			}
+
+			field := gc.field(parent, selection.name)
+			if field == nil {
+				// Introspection and unknown fields, which fail validation anyway
+				cost, err := gc.cost(selection.selections, nil)
+				if err != nil {
+					return 0, err
+				}
+				total += cost
+				continue
+			}
+
+			fieldType, isList := unwrapGraphQLType(field.Type)
+			cost, err := gc.cost(selection.selections, fieldType)
+			if err != nil {
+				return 0, err
+			}
+			if isList {
+				cost *= gc.listSize(field, selection)
+			}
+			total += cost
+		}
+	}
+
+	return total, nil
+}
+
+func (gc *graphQLComplexity) namedType(name string, fallback types.NamedType) types.NamedType {
+	if t, ok := gc.schema.Types[name]; ok {
+		return t
+	}
+	return fallback
+}
+
+func (gc *graphQLComplexity) field(parent types.NamedType, name string) *types.FieldDefinition {
+	switch t := parent.(type) {
+	case *types.ObjectTypeDefinition:
+		return t.Fields.Get(name)
+	case *types.InterfaceTypeDefinition:
+		return t.Fields.Get(name)
+	}
+	return nil
+}
+
+// listSize returns how many items the list field is expected to return.
+func (gc *graphQLComplexity) listSize(field *types.FieldDefinition, selection *gqlSelection) int {
+	argument := field.Arguments.Get("first")
+	if argument == nil {
+		return graphQLListComplexity
+	}
+
+	// Resolvers use their default page size for a first of zero, so those
+	// fall back to the default of the argument like missing ones.
+	if value, ok := selection.arguments["first"]; ok {
+		if strings.HasPrefix(value, "$") {
+			name := value[1:]
+			if _, ok := gc.variables[name]; !ok {
+				value = gc.variableDefaults[name]
+			}
+		}
+		if strings.HasPrefix(value, "$") {
+			switch v := gc.variables[value[1:]].(type) {
+			case float64:
+				if v > 0 {
+					return int(v)
+				}
+			case int:
+				if v > 0 {
+					return v
+				}
+			}
+		} else if n, err := strconv.Atoi(value); err == nil && n > 0 {
+			return n
+		}
+	}
+
+	if argument.Default != nil {
+		if n, err := strconv.Atoi(argument.Default.String()); err == nil {
+			return n
+		}
+	}
+
+	return graphQLListComplexity
+}
+
+func unwrapGraphQLType(t types.Type) (types.NamedType, bool) {
+	isList := false
+	for {
+		switch wrapped := t.(type) {
+		case *types.NonNull:
+			t = wrapped.OfType
+		case *types.List:
+			isList = true
+			t = wrapped.OfType
+		case types.NamedType:
+			return wrapped, isList
+		default:
+			return nil, isList
+		}
+	}
+}
+
+func (gc *graphQLComplexity) peek() string {
+	if gc.pos < len(gc.tokens) {
+		return gc.tokens[gc.pos]
+	}
+	return ""
+}
+
+func (gc *graphQLComplexity) next() string {
+	token := gc.peek()
+	gc.pos++
+	return token
+}
+
+func (gc *graphQLComplexity) expect(token string) error {
+	if got := gc.next(); got != token {
+		return fmt.Errorf("expected %q, got %q", token, got)
+	}
+	return nil
+}
+
+func (gc *graphQLComplexity) parseDocument() ([]*gqlOperation, error) {
+	operations := []*gqlOperation{}
+	for gc.pos < len(gc.tokens) {
+		switch gc.peek() {
+		case "{":
+			selections, err := gc.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			operations = append(operations, &gqlOperation{kind: "query", selections: selections})
+
+		case "query", "mutation", "subscription":
+			operation := &gqlOperation{kind: gc.next()}
+			if isGraphQLName(gc.peek()) {
+				operation.name = gc.next()
+			}
+			if gc.peek() == "(" {
+				variableDefaults, err := gc.parseVariableDefinitions()
+				if err != nil {
+					return nil, err
+				}
+				operation.variableDefaults = variableDefaults
+			}
+			if err := gc.skipDirectives(); err != nil {
+				return nil, err
+			}
+			selections, err := gc.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			operation.selections = selections
+			operations = append(operations, operation)
+
+		case "fragment":
+			gc.next()
+			name := gc.next()
+			if err := gc.expect("on"); err != nil {
+				return nil, err
+			}
+			fragment := &gqlFragment{typeName: gc.next()}
+			if err := gc.skipDirectives(); err != nil {
+				return nil, err
+			}
+			selections, err := gc.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			fragment.selections = selections
+			gc.fragments[name] = fragment
+
+		default:
+			return nil, fmt.Errorf("unexpected %q", gc.peek())
+		}
+	}
+
+	return operations, nil
+}
+
+func (gc *graphQLComplexity) parseSelectionSet() ([]*gqlSelection, error) {
+	if err := gc.expect("{"); err != nil {
+		return nil, err
+	}
+
+	selections := []*gqlSelection{}
+	for gc.peek() != "}" {
+		if gc.pos >= len(gc.tokens) {
+			return nil, fmt.Errorf("unterminated selection set")
+		}
+
+		selection := &gqlSelection{}
+		if gc.peek() == "..." {
+			gc.next()
+			if isGraphQLName(gc.peek()) && gc.peek() != "on" {
+				selection.spread = gc.next()
+				if err := gc.skipDirectives(); err != nil {
+					return nil, err
+				}
+				selections = append(selections, selection)
+				continue
+			}
+			if gc.peek() == "on" {
+				gc.next()
+				selection.typeCondition = gc.next()
+			}
+		} else {
+			selection.name = gc.next()
+			if !isGraphQLName(selection.name) {
+				return nil, fmt.Errorf("unexpected %q", selection.name)
+			}
+			if gc.peek() == ":" {
+				gc.next()
+				selection.name = gc.next()
+			}
+			if gc.peek() == "(" {
+				arguments, err := gc.parseArguments()
+				if err != nil {
+					return nil, err
+				}
+				selection.arguments = arguments
+			}
+		}
+
+		if err := gc.skipDirectives(); err != nil {
+			return nil, err
+		}
+		if gc.peek() == "{" {
+			children, err := gc.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			selection.selections = children
+		} else if selection.name == "" {
+			return nil, fmt.Errorf("inline fragment without selection set")
+		}
+		selections = append(selections, selection)
+	}
+	gc.next()
+
+	return selections, nil
+}
+
+// parseVariableDefinitions returns the scalar defaults of the variables of an operation, as
+// written in the query.
+func (gc *graphQLComplexity) parseVariableDefinitions() (map[string]string, error) {
+	gc.next()
+	defaults := map[string]string{}
+	for gc.peek() != ")" {
+		if gc.pos >= len(gc.tokens) {
+			return nil, fmt.Errorf("unterminated variable definitions")
+		}
+		if err := gc.expect("$"); err != nil {
+			return nil, err
+		}
+		name := gc.next()
+		if err := gc.expect(":"); err != nil {
+			return nil, err
+		}
+		// Skip the type
+		for gc.pos < len(gc.tokens) && gc.peek() != "=" && gc.peek() != "$" && gc.peek() != ")" && gc.peek() != "@" {
+			gc.next()
+		}
+		if gc.peek() == "=" {
+			gc.next()
+			switch gc.peek() {
+			case "[":
+				if err := gc.skipBalanced("[", "]"); err != nil {
+					return nil, err
+				}
+			case "{":
+				if err := gc.skipBalanced("{", "}"); err != nil {
+					return nil, err
+				}
+			default:
+				defaults[name] = gc.next()
+			}
+		}
+		if err := gc.skipDirectives(); err != nil {
+			return nil, err
+		}
+	}
+	gc.next()
+
+	return defaults, nil
+}
+
+// parseArguments returns the scalar arguments of a field, as written in the query.
+func (gc *graphQLComplexity) parseArguments() (map[string]string, error) {
+	gc.next()
+	arguments := map[string]string{}
+	for gc.peek() != ")" {
+		if gc.pos >= len(gc.tokens) {
+			return nil, fmt.Errorf("unterminated arguments")
+		}
+		name := gc.next()
+		if err := gc.expect(":"); err != nil {
+			return nil, err
+		}
+		switch gc.peek() {
+		case "[":
+			if err := gc.skipBalanced("[", "]"); err != nil {
+				return nil, err
+			}
+		case "{":
+			if err := gc.skipBalanced("{", "}"); err != nil {
+				return nil, err
+			}
+		case "$":
+			gc.next()
+			arguments[name] = "$" + gc.next()
+		default:
+			arguments[name] = gc.next()
+		}
+	}
+	gc.next()
+
+	return arguments, nil
+}
+
+func (gc *graphQLComplexity) skipDirectives() error {
+	for gc.peek() == "@" {
+		gc.next()
+		gc.next()
+		if gc.peek() == "(" {
+			if err := gc.skipBalanced("(", ")"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (gc *graphQLComplexity) skipBalanced(open, closing string) error {
+	depth := 0
+	for gc.pos < len(gc.tokens) {
+		switch gc.next() {
+		case open:
+			depth++
+		case closing:
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("unterminated %q", open)
+}
+
+func isGraphQLName(token string) bool {
+	if token == "" {
+		return false
+	}
+	for i, r := range token {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// lexGraphQL splits a query into its tokens, leaving out whitespace, commas and comments. Strings
+// are kept quoted so they can't be mistaken for names.
+func lexGraphQL(query string) ([]string, error) {
+	query = strings.TrimPrefix(query, "\ufeff")
+
+	tokens := []string{}
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+
+		case c == '#':
+			for i < len(query) && query[i] != '\n' && query[i] != '\r' {
+				i++
+			}
+
+		case strings.HasPrefix(query[i:], "..."):
+			tokens = append(tokens, "...")
+			i += 3
+
+		case strings.HasPrefix(query[i:], `"""`):
+			j := i + 3
+			for j < len(query) && !strings.HasPrefix(query[j:], `"""`) {
+				if strings.HasPrefix(query[j:], `\"""`) {
+					j += 3
+				}
+				j++
+			}
+			if j >= len(query) {
+				return nil, fmt.Errorf("unterminated block string")
+			}
+			tokens = append(tokens, query[i:j+3])
+			i = j + 3
+
+		case c == '"':
+			j := i + 1
+			for j < len(query) && query[j] != '"' {
+				if query[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(query) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			tokens = append(tokens, query[i:j+1])
+			i = j + 1
+
+		case strings.ContainsRune("!$&().:=@[]{}|", rune(c)):
+			tokens = append(tokens, string(c))
+			i++
+
+		default:
+			j := i
+			for j < len(query) && !strings.ContainsRune(" \t\n\r,#\"!$&():=@[]{}|", rune(query[j])) && !strings.HasPrefix(query[j:], "...") {
+				j++
+			}
+			if j == i {
+				return nil, fmt.Errorf("unexpected character %q", c)
+			}
+			tokens = append(tokens, query[i:j])
+			i = j
+		}
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestEstimateGraphQLComplexity(t *testing.T) {
+	schema, err := graphql.ParseSchema(schemaRaw, nil)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		query         string
+		operationName string
+		variables     map[string]any
+		expected      int
+	}{
+		"scalar fields": {
+			query:    `{ config license }`,
+			expected: 2,
+		},
+		"object field": {
+			query:    `query user { user(id: "me") { id username } }`,
+			expected: 3,
+		},
+		"list with literal first": {
+			query:    `{ channels(userId: "me", first: 5) { id name } }`,
+			expected: 1 + 5*2,
+		},
+		"list with the default first": {
+			query:    `{ channels(userId: "me") { id } }`,
+			expected: 1 + 60,
+		},
+		"list with a zero first uses the default": {
+			query:    `{ channels(userId: "me", first: 0) { id } }`,
+			expected: 1 + 60,
+		},
+		"list with first in a variable": {
+			query:     `query q($first: Int) { channels(userId: "me", first: $first) { id } }`,
+			variables: map[string]any{"first": float64(20)},
+			expected:  1 + 20,
+		},
+		"list with first in the default of a variable": {
+			query:    `query q($first: Int = 100) { channels(userId: "me", first: $first) { id } }`,
+			expected: 1 + 100,
+		},
+		"list without first": {
+			query:    `{ user(id: "me") { roles { id } } }`,
+			expected: 1 + 1 + graphQLListComplexity,
+		},
+		"nested lists": {
+			query:    `{ posts(channelId: "x", first: 10) { id user { roles { id name } } } }`,
+			expected: 1 + 10*(1+1+(1+graphQLListComplexity*2)),
+		},
+		"fragments": {
+			query: `
+				query q { channels(userId: "me", first: 2) { ...channelFields ... on Channel { name } } }
+				fragment channelFields on Channel { id team { id } }
+			`,
+			expected: 1 + 2*(1+2+1),
+		},
+		"alias": {
+			query:    `{ mine: channels(userId: "me", first: 3) { id } }`,
+			expected: 1 + 3,
+		},
+		"picks the operation": {
+			query:         `query a { config } query b { config license }`,
+			operationName: "b",
+			expected:      2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			complexity, err := estimateGraphQLComplexity(schema.ASTSchema(), tc.query, tc.operationName, tc.variables)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, complexity)
+		})
+	}
+
+	t.Run("unknown operation", func(t *testing.T) {
+		_, err := estimateGraphQLComplexity(schema.ASTSchema(), `query a { config }`, "b", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("fragment spreading itself", func(t *testing.T) {
+		_, err := estimateGraphQLComplexity(schema.ASTSchema(), `
+			query q { user(id: "me") { ...f } }
+			fragment f on User { id ...f }
+		`, "", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("unterminated selection set", func(t *testing.T) {
+		_, err := estimateGraphQLComplexity(schema.ASTSchema(), `{ user(id: "me") { id `, "", nil)
+		require.Error(t, err)
+	})
+}
+
+func TestGraphQLPersistedQueries(t *testing.T) {
+	schema, err := graphql.ParseSchema(schemaRaw, nil)
+	require.NoError(t, err)
+
+	// Values for the required variables of the queries
+	variables := map[string]any{
+		"channelId": model.NewId(),
+		"teamId":    model.NewId(),
+	}
+
+	require.NotEmpty(t, persistedQueries)
+	for hash, query := range persistedQueries {
+		assert.Equal(t, graphQLQueryHash(query), hash)
+		assert.Empty(t, schema.ValidateWithVariables(query, variables), query)
+	}
+}
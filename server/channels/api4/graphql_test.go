@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
 )
 
 func TestGraphQLPayload(t *testing.T) {
@@ -32,3 +34,105 @@ func TestGraphQLPayload(t *testing.T) {
 	// to not confuse with other errors.
 	require.Contains(t, resp.Errors[0].Message, "request body too large")
 }
+
+func TestGraphQLComplexityLimit(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.GraphQLMaxComplexity = 100
+	})
+
+	input := graphQLInput{
+		OperationName: "channels",
+		Query: `
+	query channels($first: Int) {
+		channels(userId: "me", first: $first) {
+			id
+			name
+		}
+	}
+	`,
+		Variables: map[string]any{"first": 10},
+	}
+
+	resp, err := th.MakeGraphQLRequest(&input)
+	require.NoError(t, err)
+	require.Len(t, resp.Errors, 0)
+
+	input.Variables["first"] = 60
+	resp, err = th.MakeGraphQLRequest(&input)
+	require.NoError(t, err)
+	require.Len(t, resp.Errors, 1)
+	require.Contains(t, resp.Errors[0].Message, "query complexity")
+}
+
+func TestGraphQLPersistedQuery(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	persisted, err := persistedQueriesFS.ReadFile("graphql_persisted/preferences.graphql")
+	require.NoError(t, err)
+	hash := graphQLQueryHash(string(persisted))
+
+	t.Run("By hash", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "preferences",
+			Extensions: graphQLExtensions{
+				PersistedQuery: &graphQLPersistedQuery{Version: 1, Sha256Hash: hash},
+			},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+	})
+
+	t.Run("Unknown hash", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "preferences",
+			Extensions: graphQLExtensions{
+				PersistedQuery: &graphQLPersistedQuery{Version: 1, Sha256Hash: graphQLQueryHash("unknown")},
+			},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+		require.Equal(t, "PersistedQueryNotFound", resp.Errors[0].Message)
+	})
+
+	t.Run("Persisted queries only", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.GraphQLPersistedQueriesOnly = true
+		})
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.GraphQLPersistedQueriesOnly = false
+		})
+
+		input := graphQLInput{
+			OperationName: "preferences",
+			Query:         string(persisted),
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+
+		input = graphQLInput{
+			OperationName: "config",
+			Query:         `query config { config }`,
+		}
+
+		resp, err = th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+		require.Equal(t, "PersistedQueryNotAllowed", resp.Errors[0].Message)
+	})
+}
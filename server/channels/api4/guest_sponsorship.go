@@ -0,0 +1,193 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitGuestSponsorship() {
+	api.BaseRoutes.User.Handle("/guest_sponsorship", api.APISessionRequired(getGuestSponsorship)).Methods("GET")
+	api.BaseRoutes.User.Handle("/guest_sponsorship", api.APISessionRequired(patchGuestSponsorship)).Methods("PUT")
+	api.BaseRoutes.User.Handle("/guest_sponsorship/renew", api.APISessionRequired(renewGuestSponsorship)).Methods("POST")
+	api.BaseRoutes.User.Handle("/sponsored_guests", api.APISessionRequired(getSponsoredGuests)).Methods("GET")
+
+	api.BaseRoutes.Users.Handle("/guest_sponsors/report", api.APISessionRequired(getGuestSponsorReport)).Methods("GET")
+}
+
+func getGuestSponsorship(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	sponsorship, appErr := c.App.GetGuestSponsorship(c.Params.UserId)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		c.Err = appErr
+		return
+	}
+
+	// The guest and its sponsor can see the sponsorship, everyone else needs to be able to
+	// read the users in the system console.
+	sessionUserID := c.AppContext.Session().UserId
+	isSponsor := sponsorship != nil && sponsorship.SponsorId == sessionUserID
+	if sessionUserID != c.Params.UserId && !isSponsor && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementUsers)
+		return
+	}
+
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(sponsorship); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func patchGuestSponsorship(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var patch model.GuestSponsorshipPatch
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		c.SetInvalidParamWithErr("guest_sponsorship", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("patchGuestSponsorship", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	if patch.SponsorId != nil {
+		audit.AddEventParameter(auditRec, "sponsor_id", *patch.SponsorId)
+	}
+	if patch.ExpiresAt != nil {
+		audit.AddEventParameter(auditRec, "expires_at", *patch.ExpiresAt)
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementUsers)
+		return
+	}
+
+	if oldSponsorship, appErr := c.App.GetGuestSponsorship(c.Params.UserId); appErr == nil {
+		auditRec.AddEventPriorState(oldSponsorship)
+	}
+
+	sponsorship, appErr := c.App.PatchGuestSponsorship(c.Params.UserId, &patch)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(sponsorship)
+	auditRec.AddEventObjectType("guest_sponsorship")
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(sponsorship); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func renewGuestSponsorship(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	// The body is optional, without it the guest is renewed for the default expiration period.
+	var renewal model.GuestSponsorshipRenewal
+	if err := json.NewDecoder(r.Body).Decode(&renewal); err != nil && err != io.EOF {
+		c.SetInvalidParamWithErr("guest_sponsorship_renewal", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("renewGuestSponsorship", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	if renewal.ExpiresAt != nil {
+		audit.AddEventParameter(auditRec, "expires_at", *renewal.ExpiresAt)
+	}
+
+	oldSponsorship, appErr := c.App.GetGuestSponsorship(c.Params.UserId)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		c.Err = appErr
+		return
+	}
+
+	// Sponsors can renew their own guests.
+	isSponsor := oldSponsorship != nil && oldSponsorship.SponsorId == c.AppContext.Session().UserId
+	if !isSponsor && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementUsers)
+		return
+	}
+
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddEventPriorState(oldSponsorship)
+
+	sponsorship, appErr := c.App.RenewGuestSponsorship(c.Params.UserId, renewal.ExpiresAt)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(sponsorship)
+	auditRec.AddEventObjectType("guest_sponsorship")
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(sponsorship); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getSponsoredGuests(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if c.AppContext.Session().UserId != c.Params.UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementUsers)
+		return
+	}
+
+	sponsorships, appErr := c.App.GetSponsoredGuests(c.Params.UserId, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(sponsorships); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getGuestSponsorReport(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementUsers)
+		return
+	}
+
+	report, appErr := c.App.GetGuestSponsorReport(c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGuestSponsorship(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.Enable = true })
+	th.App.Srv().SetLicense(model.NewTestLicense())
+
+	id := model.NewId()
+	guest, appErr := th.App.CreateGuest(th.Context, &model.User{
+		Email:         "success+" + id + "@simulator.amazonses.com",
+		Username:      "un_" + id,
+		Password:      "Password1",
+		EmailVerified: true,
+	})
+	require.Nil(t, appErr)
+
+	t.Run("patch requires the users system console section", func(t *testing.T) {
+		_, resp, err := th.Client.PatchGuestSponsorship(guest.Id, &model.GuestSponsorshipPatch{SponsorId: &th.BasicUser.Id})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("get for an unsponsored guest", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetGuestSponsorship(guest.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	expiresAt := model.GetMillis() + 60000
+	sponsorship, _, err := th.SystemAdminClient.PatchGuestSponsorship(guest.Id, &model.GuestSponsorshipPatch{SponsorId: &th.BasicUser.Id, ExpiresAt: &expiresAt})
+	require.NoError(t, err)
+	assert.Equal(t, th.BasicUser.Id, sponsorship.SponsorId)
+	assert.Equal(t, expiresAt, sponsorship.ExpiresAt)
+
+	t.Run("sponsors can read and renew their guests", func(t *testing.T) {
+		received, _, err := th.Client.GetGuestSponsorship(guest.Id)
+		require.NoError(t, err)
+		assert.Equal(t, sponsorship.SponsorId, received.SponsorId)
+
+		renewedAt := expiresAt + 60000
+		renewed, _, err := th.Client.RenewGuestSponsorship(guest.Id, &renewedAt)
+		require.NoError(t, err)
+		assert.Equal(t, renewedAt, renewed.ExpiresAt)
+
+		sponsorships, _, err := th.Client.GetSponsoredGuests(th.BasicUser.Id, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, sponsorships, 1)
+		assert.Equal(t, guest.Id, sponsorships[0].UserId)
+	})
+
+	t.Run("other users can not read or renew the guest", func(t *testing.T) {
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err := th.Client.GetGuestSponsorship(guest.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.RenewGuestSponsorship(guest.Id, nil)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetSponsoredGuests(th.BasicUser.Id, 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("report", func(t *testing.T) {
+		_, resp, err := th.Client.GetGuestSponsorReport(0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		report, _, err := th.SystemAdminClient.GetGuestSponsorReport(0, 100)
+		require.NoError(t, err)
+		var found *model.GuestSponsorReport
+		for _, row := range report {
+			if row.SponsorId == th.BasicUser.Id {
+				found = row
+			}
+		}
+		require.NotNil(t, found)
+		assert.Equal(t, int64(1), found.GuestCount)
+	})
+}
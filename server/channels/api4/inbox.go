@@ -0,0 +1,47 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitInbox() {
+	api.BaseRoutes.User.Handle("/inbox", api.APISessionRequired(getInboxForUser)).Methods("GET")
+}
+
+func getInboxForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	cursor, ok := model.ParseInboxCursor(r.URL.Query().Get("cursor"))
+	if !ok {
+		c.SetInvalidURLParam("cursor")
+		return
+	}
+
+	inbox, appErr := c.App.GetInboxForUser(c.AppContext, c.Params.UserId, model.GetInboxOptions{
+		Cursor:  cursor,
+		PerPage: c.Params.PerPage,
+	})
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(inbox); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetInboxForUser(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	// A channel of another team, to check the inbox spans teams
+	team2 := th.CreateTeam()
+	th.LinkUserToTeam(th.BasicUser, team2)
+	channel2 := th.CreateChannelWithClientAndTeam(th.Client, model.ChannelTypeOpen, team2.Id)
+
+	for _, channel := range []*model.Channel{th.BasicChannel, channel2} {
+		_, appErr := th.App.CreatePost(th.Context, &model.Post{
+			UserId:    th.BasicUser2.Id,
+			ChannelId: channel.Id,
+			Message:   "hello",
+		}, channel, false, true)
+		require.Nil(t, appErr)
+	}
+
+	inbox, _, err := th.Client.GetInboxForUser("me", "", 60)
+	require.NoError(t, err)
+	channelIDs := []string{}
+	for _, item := range inbox.Items {
+		assert.Equal(t, model.InboxItemTypeChannel, item.Type)
+		channelIDs = append(channelIDs, item.ChannelId)
+	}
+	assert.Contains(t, channelIDs, th.BasicChannel.Id)
+	assert.Contains(t, channelIDs, channel2.Id)
+	assert.Empty(t, inbox.NextCursor)
+
+	t.Run("paging", func(t *testing.T) {
+		page, _, err := th.Client.GetInboxForUser("me", "", 1)
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		require.NotEmpty(t, page.NextCursor)
+		assert.Equal(t, inbox.Items[0].Id, page.Items[0].Id)
+
+		page, _, err = th.Client.GetInboxForUser("me", page.NextCursor, 1)
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, inbox.Items[1].Id, page.Items[0].Id)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, resp, err := th.Client.GetInboxForUser("me", "junk", 60)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, resp, err := th.Client.GetInboxForUser(th.BasicUser2.Id, "", 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestIPAccessPolicies(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	bypassToken := model.NewRandomString(model.IPAccessSettingsEmergencyBypassTokenMinLength)
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.IPAccessSettings.Enable = true
+		cfg.IPAccessSettings.Policies = []*model.IPAccessPolicy{{
+			Role:         model.NewString(model.SystemAdminRoleId),
+			AllowedCIDRs: []string{"10.0.0.0/8"},
+		}}
+		*cfg.IPAccessSettings.EmergencyBypassToken = bypassToken
+	})
+	defer th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.IPAccessSettings.Enable = false
+	})
+
+	t.Run("requests of a restricted role are denied", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetMe("")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+		CheckErrorID(t, err, "app.ip_access.denied.app_error")
+	})
+
+	t.Run("other roles are unaffected", func(t *testing.T) {
+		_, _, err := th.Client.GetMe("")
+		require.NoError(t, err)
+	})
+
+	t.Run("login of a restricted role is denied", func(t *testing.T) {
+		client := th.CreateClient()
+		_, _, err := client.Login(th.SystemAdminUser.Email, th.SystemAdminUser.Password)
+		require.Error(t, err)
+	})
+
+	t.Run("emergency bypass token", func(t *testing.T) {
+		client := th.CreateClient()
+		client.HTTPHeader = map[string]string{model.HeaderIPAccessBypassToken: bypassToken}
+		_, _, err := client.Login(th.SystemAdminUser.Email, th.SystemAdminUser.Password)
+		require.NoError(t, err)
+
+		_, _, err = client.GetMe("")
+		require.NoError(t, err)
+
+		client.HTTPHeader = map[string]string{model.HeaderIPAccessBypassToken: model.NewRandomString(model.IPAccessSettingsEmergencyBypassTokenMinLength)}
+		_, resp, err := client.GetMe("")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("websocket upgrade of a restricted role is denied", func(t *testing.T) {
+		_, err := model.NewReliableWebSocketClientWithDialer(websocket.DefaultDialer, fmt.Sprintf("ws://localhost:%v", th.App.Srv().ListenAddr.Port), th.SystemAdminClient.AuthToken, "", 0, true)
+		require.Error(t, err)
+	})
+
+	t.Run("websocket authentication challenge of a restricted role is denied", func(t *testing.T) {
+		wsClient, err := th.CreateWebSocketSystemAdminClient()
+		require.NoError(t, err)
+		defer wsClient.Close()
+		wsClient.Listen()
+
+		// The connection is closed instead of answering the challenge.
+		select {
+		case resp, ok := <-wsClient.ResponseChannel:
+			require.False(t, ok, "unexpected response %v", resp)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "the connection should have been closed")
+		}
+
+		wsClient, err = th.CreateWebSocketClient()
+		require.NoError(t, err)
+		defer wsClient.Close()
+		wsClient.Listen()
+
+		resp := <-wsClient.ResponseChannel
+		require.Equal(t, model.StatusOk, resp.Status)
+	})
+
+	t.Run("allowed range", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			cfg.IPAccessSettings.Policies[0].AllowedCIDRs = []string{"127.0.0.0/8", "::1/128"}
+		})
+
+		_, _, err := th.SystemAdminClient.GetMe("")
+		require.NoError(t, err)
+	})
+	t.Run("the policies can't be changed through the API", func(t *testing.T) {
+		cfg, _, err := th.SystemAdminClient.GetConfig()
+		require.NoError(t, err)
+
+		// Saving the config as read, with the masked bypass token, is allowed.
+		_, _, err = th.SystemAdminClient.UpdateConfig(cfg)
+		require.NoError(t, err)
+
+		*cfg.IPAccessSettings.Enable = false
+		_, resp, err := th.SystemAdminClient.UpdateConfig(cfg)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.PatchConfig(&model.Config{
+			IPAccessSettings: model.IPAccessSettings{Policies: []*model.IPAccessPolicy{}},
+		})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		require.True(t, *th.App.Config().IPAccessSettings.Enable)
+		require.Len(t, th.App.Config().IPAccessSettings.Policies, 1)
+	})
+}
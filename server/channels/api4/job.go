@@ -19,10 +19,15 @@ import (
 func (api *API) InitJob() {
 	api.BaseRoutes.Jobs.Handle("", api.APISessionRequired(getJobs)).Methods("GET")
 	api.BaseRoutes.Jobs.Handle("", api.APISessionRequired(createJob)).Methods("POST")
+	api.BaseRoutes.Jobs.Handle("/settings", api.APISessionRequired(getJobTypeSettings)).Methods("GET")
+	api.BaseRoutes.Jobs.Handle("/stats", api.APISessionRequired(getJobTypeStats)).Methods("GET")
 	api.BaseRoutes.Jobs.Handle("/{job_id:[A-Za-z0-9]+}", api.APISessionRequired(getJob)).Methods("GET")
 	api.BaseRoutes.Jobs.Handle("/{job_id:[A-Za-z0-9]+}/download", api.APISessionRequiredTrustRequester(downloadJob)).Methods("GET")
 	api.BaseRoutes.Jobs.Handle("/{job_id:[A-Za-z0-9]+}/cancel", api.APISessionRequired(cancelJob)).Methods("POST")
 	api.BaseRoutes.Jobs.Handle("/type/{job_type:[A-Za-z0-9_-]+}", api.APISessionRequired(getJobsByType)).Methods("GET")
+	api.BaseRoutes.Jobs.Handle("/type/{job_type:[A-Za-z0-9_-]+}/settings", api.APISessionRequired(patchJobTypeSettings)).Methods("PUT")
+	api.BaseRoutes.Jobs.Handle("/type/{job_type:[A-Za-z0-9_-]+}/pause", api.APISessionRequired(pauseJobType)).Methods("POST")
+	api.BaseRoutes.Jobs.Handle("/type/{job_type:[A-Za-z0-9_-]+}/resume", api.APISessionRequired(resumeJobType)).Methods("POST")
 }
 
 func getJob(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -193,7 +198,13 @@ func getJobsByType(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	jobs, appErr := c.App.GetJobsByTypePage(c.Params.JobType, c.Params.Page, c.Params.PerPage)
+	var jobs []*model.Job
+	var appErr *model.AppError
+	if status := r.URL.Query().Get("status"); status != "" {
+		jobs, appErr = c.App.GetJobsByTypeAndStatusPage(c.Params.JobType, status, c.Params.Page, c.Params.PerPage)
+	} else {
+		jobs, appErr = c.App.GetJobsByTypePage(c.Params.JobType, c.Params.Page, c.Params.PerPage)
+	}
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -248,3 +259,142 @@ func cancelJob(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	ReturnStatusOK(w)
 }
+
+// readableJobTypes returns the job types whose jobs the session can read.
+func readableJobTypes(c *Context) map[string]bool {
+	readable := make(map[string]bool)
+	for _, jobType := range model.AllJobTypes {
+		if hasPermission, permissionRequired := c.App.SessionHasPermissionToReadJob(*c.AppContext.Session(), jobType); permissionRequired != nil && hasPermission {
+			readable[jobType] = true
+		}
+	}
+	return readable
+}
+
+func getJobTypeSettings(c *Context, w http.ResponseWriter, r *http.Request) {
+	readable := readableJobTypes(c)
+	if len(readable) == 0 {
+		c.SetPermissionError()
+		return
+	}
+
+	list := []*model.JobTypeSettings{}
+	for _, settings := range c.App.GetJobTypeSettings() {
+		if readable[settings.JobType] {
+			list = append(list, settings)
+		}
+	}
+
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getJobTypeStats(c *Context, w http.ResponseWriter, r *http.Request) {
+	readable := readableJobTypes(c)
+	if len(readable) == 0 {
+		c.SetPermissionError()
+		return
+	}
+
+	// The jobs of the last week are summarized by default.
+	since := model.GetMillisForTime(time.Now().AddDate(0, 0, -7))
+	if value := r.URL.Query().Get("since"); value != "" {
+		var err error
+		if since, err = strconv.ParseInt(value, 10, 64); err != nil || since < 0 {
+			c.SetInvalidURLParam("since")
+			return
+		}
+	}
+
+	all, appErr := c.App.GetJobTypeStats(since)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	list := []*model.JobTypeStats{}
+	for _, stats := range all {
+		if readable[stats.JobType] {
+			list = append(list, stats)
+		}
+	}
+
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func patchJobTypeSettings(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireJobType()
+	if c.Err != nil {
+		return
+	}
+
+	var patch model.JobTypeSettingsPatch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParamWithErr("settings", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("patchJobTypeSettings", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "job_type", c.Params.JobType)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageJobs) {
+		c.SetPermissionError(model.PermissionManageJobs)
+		return
+	}
+
+	settings, appErr := c.App.PatchJobTypeSettings(c.Params.JobType, &patch)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(settings)
+	auditRec.AddEventObjectType("job_type_settings")
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(settings); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func pauseJobType(c *Context, w http.ResponseWriter, r *http.Request) {
+	setJobTypePaused(c, w, "pauseJobType", true)
+}
+
+func resumeJobType(c *Context, w http.ResponseWriter, r *http.Request) {
+	setJobTypePaused(c, w, "resumeJobType", false)
+}
+
+func setJobTypePaused(c *Context, w http.ResponseWriter, event string, paused bool) {
+	c.RequireJobType()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "job_type", c.Params.JobType)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageJobs) {
+		c.SetPermissionError(model.PermissionManageJobs)
+		return
+	}
+
+	settings, appErr := c.App.SetJobTypePaused(c.Params.JobType, paused)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(settings)
+	auditRec.AddEventObjectType("job_type_settings")
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(settings); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
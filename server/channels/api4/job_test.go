@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -335,3 +336,139 @@ func TestCancelJob(t *testing.T) {
 	require.Error(t, err)
 	CheckNotFoundStatus(t, resp)
 }
+
+func TestGetJobsByTypeAndStatus(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	jobType := model.JobTypeDataRetention
+
+	jobs := []*model.Job{
+		{
+			Id:       model.NewId(),
+			Type:     jobType,
+			CreateAt: 1000,
+			Status:   model.JobStatusError,
+		},
+		{
+			Id:       model.NewId(),
+			Type:     jobType,
+			CreateAt: 1001,
+			Status:   model.JobStatusSuccess,
+		},
+	}
+
+	for _, job := range jobs {
+		_, err := th.App.Srv().Store().Job().Save(job)
+		require.NoError(t, err)
+		defer th.App.Srv().Store().Job().Delete(job.Id)
+	}
+
+	received, _, err := th.SystemAdminClient.GetJobsByTypeAndStatus(jobType, model.JobStatusError, 0, 60)
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+	require.Equal(t, jobs[0].Id, received[0].Id)
+}
+
+func TestJobTypeSettings(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	jobType := model.JobTypeDataRetention
+	defer th.App.Srv().Store().Job().SaveTypeSettings(&model.JobTypeSettings{JobType: jobType})
+
+	t.Run("basic users can't read nor change the settings", func(t *testing.T) {
+		_, resp, err := th.Client.GetJobTypeSettings()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.PatchJobTypeSettings(jobType, &model.JobTypeSettingsPatch{Priority: model.NewInt64(10)})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.PauseJobType(jobType)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("patch", func(t *testing.T) {
+		settings, _, err := th.SystemAdminClient.PatchJobTypeSettings(jobType, &model.JobTypeSettingsPatch{
+			Priority:       model.NewInt64(10),
+			MaxConcurrency: model.NewInt(1),
+			Schedule:       model.NewString("0 3 * * *"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), settings.Priority)
+		assert.Equal(t, 1, settings.MaxConcurrency)
+		assert.Equal(t, "0 3 * * *", settings.Schedule)
+
+		_, resp, err := th.SystemAdminClient.PatchJobTypeSettings(jobType, &model.JobTypeSettingsPatch{Schedule: model.NewString("invalid")})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.PatchJobTypeSettings(model.NewId()[:20], &model.JobTypeSettingsPatch{Priority: model.NewInt64(1)})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		list, _, err := th.SystemAdminClient.GetJobTypeSettings()
+		require.NoError(t, err)
+		found := false
+		for _, settings := range list {
+			if settings.JobType == jobType {
+				found = true
+				assert.Equal(t, int64(10), settings.Priority)
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("pause and resume", func(t *testing.T) {
+		settings, _, err := th.SystemAdminClient.PauseJobType(jobType)
+		require.NoError(t, err)
+		assert.True(t, settings.Paused)
+		assert.True(t, th.App.Srv().Jobs.TypeSettings(jobType).Paused)
+
+		settings, _, err = th.SystemAdminClient.ResumeJobType(jobType)
+		require.NoError(t, err)
+		assert.False(t, settings.Paused)
+		assert.Equal(t, int64(10), settings.Priority, "resuming should keep the other settings")
+	})
+}
+
+func TestGetJobTypeStats(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	now := model.GetMillis()
+	job := &model.Job{
+		Id:             model.NewId(),
+		Type:           model.JobTypeDataRetention,
+		CreateAt:       now,
+		StartAt:        now,
+		LastActivityAt: now + 1000,
+		Status:         model.JobStatusError,
+		Data:           map[string]string{"error": "failed"},
+	}
+	_, err := th.App.Srv().Store().Job().Save(job)
+	require.NoError(t, err)
+	defer th.App.Srv().Store().Job().Delete(job.Id)
+
+	_, resp, err := th.Client.GetJobTypeStats(0)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	list, _, err := th.SystemAdminClient.GetJobTypeStats(now)
+	require.NoError(t, err)
+
+	var stats *model.JobTypeStats
+	for _, s := range list {
+		if s.JobType == model.JobTypeDataRetention {
+			stats = s
+		}
+	}
+	require.NotNil(t, stats)
+	assert.Equal(t, int64(1), stats.StatusCounts[model.JobStatusError])
+	require.NotEmpty(t, stats.RecentFailures)
+	assert.Equal(t, job.Id, stats.RecentFailures[0].JobId)
+	assert.Equal(t, "failed", stats.RecentFailures[0].Error)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitMatrixBridge() {
+	api.BaseRoutes.Channel.Handle("/matrix_bridge", api.APISessionRequired(getChannelMatrixBridge)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/matrix_bridge", api.APISessionRequired(bridgeChannelToMatrix)).Methods("POST")
+	api.BaseRoutes.Channel.Handle("/matrix_bridge", api.APISessionRequired(unbridgeChannelFromMatrix)).Methods("DELETE")
+}
+
+func getChannelMatrixBridge(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	room, appErr := c.App.GetMatrixRoomForChannel(c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(room); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func bridgeChannelToMatrix(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var room model.MatrixRoom
+	if jsonErr := json.NewDecoder(r.Body).Decode(&room); jsonErr != nil {
+		c.SetInvalidParamWithErr("matrix_room", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("bridgeChannelToMatrix", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+	audit.AddEventParameter(auditRec, "room_id", room.RoomId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	rroom, appErr := c.App.BridgeChannelToMatrix(c.AppContext, c.Params.ChannelId, room.RoomId, c.AppContext.Session().UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(rroom)
+	auditRec.AddEventObjectType("matrix_room")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(rroom); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func unbridgeChannelFromMatrix(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("unbridgeChannelFromMatrix", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if appErr := c.App.UnbridgeChannelFromMatrix(c.Params.ChannelId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestMatrixBridge(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	roomID := "!" + model.NewId() + ":example.com"
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.BridgeChannelToMatrix(th.BasicChannel.Id, roomID)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.MatrixBridgeSettings.Enable = true
+		*cfg.MatrixBridgeSettings.HomeserverURL = "http://localhost:8008"
+		*cfg.MatrixBridgeSettings.HomeserverDomain = "example.com"
+		*cfg.MatrixBridgeSettings.AppServiceToken = "as_token"
+		*cfg.MatrixBridgeSettings.HomeserverToken = "hs_token"
+	})
+
+	t.Run("not a system admin", func(t *testing.T) {
+		_, resp, err := th.Client.BridgeChannelToMatrix(th.BasicChannel.Id, roomID)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetChannelMatrixBridge(th.BasicChannel.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.UnbridgeChannelFromMatrix(th.BasicChannel.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid room id", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.BridgeChannelToMatrix(th.BasicChannel.Id, "#alias:example.com")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("direct messages can't be bridged", func(t *testing.T) {
+		dm := th.CreateDmChannel(th.BasicUser2)
+		_, resp, err := th.SystemAdminClient.BridgeChannelToMatrix(dm.Id, roomID)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	_, resp, err := th.SystemAdminClient.GetChannelMatrixBridge(th.BasicChannel.Id)
+	require.Error(t, err)
+	CheckNotFoundStatus(t, resp)
+
+	room, resp, err := th.SystemAdminClient.BridgeChannelToMatrix(th.BasicChannel.Id, roomID)
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, roomID, room.RoomId)
+	assert.Equal(t, th.SystemAdminUser.Id, room.CreatorId)
+
+	_, resp, err = th.SystemAdminClient.BridgeChannelToMatrix(th.BasicChannel2.Id, roomID)
+	require.Error(t, err, "a room can only be bridged to one channel")
+	CheckBadRequestStatus(t, resp)
+
+	room, _, err = th.SystemAdminClient.GetChannelMatrixBridge(th.BasicChannel.Id)
+	require.NoError(t, err)
+	assert.Equal(t, roomID, room.RoomId)
+
+	_, err = th.SystemAdminClient.UnbridgeChannelFromMatrix(th.BasicChannel.Id)
+	require.NoError(t, err)
+
+	resp, err = th.SystemAdminClient.UnbridgeChannelFromMatrix(th.BasicChannel.Id)
+	require.Error(t, err)
+	CheckNotFoundStatus(t, resp)
+}
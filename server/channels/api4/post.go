@@ -23,6 +23,8 @@ func (api *API) InitPost() {
 	api.BaseRoutes.Posts.Handle("/ids", api.APISessionRequired(getPostsByIds)).Methods("POST")
 	api.BaseRoutes.Posts.Handle("/ephemeral", api.APISessionRequired(createEphemeralPost)).Methods("POST")
 	api.BaseRoutes.Post.Handle("/edit_history", api.APISessionRequired(getEditHistoryForPost)).Methods("GET")
+	api.BaseRoutes.Post.Handle("/translate", api.APISessionRequired(translatePost)).Methods("POST")
+	api.BaseRoutes.Post.Handle("/read_receipts", api.APISessionRequired(getReadReceiptsForPost)).Methods("GET")
 	api.BaseRoutes.Post.Handle("/thread", api.APISessionRequired(getPostThread)).Methods("GET")
 	api.BaseRoutes.Post.Handle("/info", api.APISessionRequired(getPostInfo)).Methods("GET")
 	api.BaseRoutes.Post.Handle("/files/info", api.APISessionRequired(getFileInfosForPost)).Methods("GET")
@@ -43,6 +45,7 @@ func (api *API) InitPost() {
 
 	api.BaseRoutes.PostForUser.Handle("/ack", api.APISessionRequired(acknowledgePost)).Methods("POST")
 	api.BaseRoutes.PostForUser.Handle("/ack", api.APISessionRequired(unacknowledgePost)).Methods("DELETE")
+	api.BaseRoutes.PostForUser.Handle("/read", api.APISessionRequired(markPostAsRead)).Methods("POST")
 }
 
 func createPost(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -163,6 +166,11 @@ func getPostsForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fields := getResponseFields(c, r)
+	if c.Err != nil {
+		return
+	}
+
 	afterPost := r.URL.Query().Get("after")
 	if afterPost != "" && !model.IsValidId(afterPost) {
 		c.SetInvalidParam("after")
@@ -222,7 +230,7 @@ func getPostsForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 	if since > 0 {
 		list, err = c.App.GetPostsSince(model.GetPostsSinceOptions{ChannelId: channelId, Time: since, SkipFetchThreads: skipFetchThreads, CollapsedThreads: collapsedThreads, CollapsedThreadsExtended: collapsedThreadsExtended, UserId: c.AppContext.Session().UserId})
 	} else if afterPost != "" {
-		etag = c.App.GetPostsEtag(channelId, collapsedThreads)
+		etag = fields.etag(c.App.GetPostsEtag(channelId, collapsedThreads))
 
 		if c.HandleEtag(etag, "Get Posts After", w, r) {
 			return
@@ -230,7 +238,7 @@ func getPostsForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 
 		list, err = c.App.GetPostsAfterPost(model.GetPostsOptions{ChannelId: channelId, PostId: afterPost, Page: page, PerPage: perPage, SkipFetchThreads: skipFetchThreads, CollapsedThreads: collapsedThreads, UserId: c.AppContext.Session().UserId, IncludeDeleted: includeDeleted})
 	} else if beforePost != "" {
-		etag = c.App.GetPostsEtag(channelId, collapsedThreads)
+		etag = fields.etag(c.App.GetPostsEtag(channelId, collapsedThreads))
 
 		if c.HandleEtag(etag, "Get Posts Before", w, r) {
 			return
@@ -238,7 +246,7 @@ func getPostsForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 
 		list, err = c.App.GetPostsBeforePost(model.GetPostsOptions{ChannelId: channelId, PostId: beforePost, Page: page, PerPage: perPage, SkipFetchThreads: skipFetchThreads, CollapsedThreads: collapsedThreads, CollapsedThreadsExtended: collapsedThreadsExtended, UserId: c.AppContext.Session().UserId, IncludeDeleted: includeDeleted})
 	} else {
-		etag = c.App.GetPostsEtag(channelId, collapsedThreads)
+		etag = fields.etag(c.App.GetPostsEtag(channelId, collapsedThreads))
 
 		if c.HandleEtag(etag, "Get Posts", w, r) {
 			return
@@ -257,6 +265,11 @@ func getPostsForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	c.App.AddCursorIdsForPostList(list, afterPost, beforePost, since, page, perPage, collapsedThreads)
+	if err = c.App.FilterRestrictedPostList(c.AppContext.Session().UserId, list); err != nil {
+		c.Err = err
+		return
+	}
+
 	clientPostList := c.App.PreparePostListForClient(c.AppContext, list)
 	clientPostList, err = c.App.SanitizePostListMetadataForUser(c.AppContext, clientPostList, c.AppContext.Session().UserId)
 	if err != nil {
@@ -264,9 +277,13 @@ func getPostsForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := clientPostList.EncodeJSON(w); err != nil {
-		mlog.Warn("Error while writing response", mlog.Err(err))
+	js, jsonErr := marshalPostListWithFields(fields, clientPostList)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("getPostsForChannel", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+		return
 	}
+
+	w.Write(js)
 }
 
 func getPostsForChannelAroundLastUnread(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -320,6 +337,11 @@ func getPostsForChannelAroundLastUnread(c *Context, w http.ResponseWriter, r *ht
 	postList.NextPostId = c.App.GetNextPostIdFromPostList(postList, collapsedThreads)
 	postList.PrevPostId = c.App.GetPrevPostIdFromPostList(postList, collapsedThreads)
 
+	if err = c.App.FilterRestrictedPostList(c.AppContext.Session().UserId, postList); err != nil {
+		c.Err = err
+		return
+	}
+
 	clientPostList := c.App.PreparePostListForClient(c.AppContext, postList)
 	clientPostList, err = c.App.SanitizePostListMetadataForUser(c.AppContext, clientPostList, c.AppContext.Session().UserId)
 	if err != nil {
@@ -348,11 +370,18 @@ func getFlaggedPostsForUser(c *Context, w http.ResponseWriter, r *http.Request)
 
 	channelId := r.URL.Query().Get("channel_id")
 	teamId := r.URL.Query().Get("team_id")
+	labelId := r.URL.Query().Get("label_id")
 
 	var posts *model.PostList
 	var err *model.AppError
 
-	if channelId != "" {
+	if labelId != "" {
+		if !model.IsValidId(labelId) {
+			c.SetInvalidParam("label_id")
+			return
+		}
+		posts, err = c.App.GetFlaggedPostsForLabel(c.Params.UserId, labelId, c.Params.Page, c.Params.PerPage)
+	} else if channelId != "" {
 		posts, err = c.App.GetFlaggedPostsForChannel(c.Params.UserId, channelId, c.Params.Page, c.Params.PerPage)
 	} else if teamId != "" {
 		posts, err = c.App.GetFlaggedPostsForTeam(c.Params.UserId, teamId, c.Params.Page, c.Params.PerPage)
@@ -388,6 +417,11 @@ func getFlaggedPostsForUser(c *Context, w http.ResponseWriter, r *http.Request)
 		pl.AddOrder(post.Id)
 	}
 
+	if err = c.App.FilterRestrictedPostList(c.AppContext.Session().UserId, pl); err != nil {
+		c.Err = err
+		return
+	}
+
 	pl.SortByCreateAt()
 	clientPostList := c.App.PreparePostListForClient(c.AppContext, pl)
 	clientPostList, err = c.App.SanitizePostListMetadataForUser(c.AppContext, clientPostList, c.AppContext.Session().UserId)
@@ -407,6 +441,11 @@ func getPost(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fields := getResponseFields(c, r)
+	if c.Err != nil {
+		return
+	}
+
 	includeDeleted, _ := strconv.ParseBool(r.URL.Query().Get("include_deleted"))
 	if includeDeleted && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
 		c.SetPermissionError(model.PermissionManageSystem)
@@ -432,14 +471,20 @@ func getPost(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if c.HandleEtag(post.Etag(), "Get Post", w, r) {
+	etag := fields.etag(post.Etag())
+	if c.HandleEtag(etag, "Get Post", w, r) {
 		return
 	}
 
-	w.Header().Set(model.HeaderEtagServer, post.Etag())
-	if err := post.EncodeJSON(w); err != nil {
-		mlog.Warn("Error while writing response", mlog.Err(err))
+	post.StripActionIntegrations()
+	js, jsonErr := marshalWithFields(fields, post)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("getPost", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+		return
 	}
+
+	w.Header().Set(model.HeaderEtagServer, etag)
+	w.Write(js)
 }
 
 // getPostsByIds also sets a header to indicate, if posts were truncated as per the cloud plan's limit.
@@ -462,6 +507,12 @@ func getPostsByIds(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	postsList, err = c.App.FilterRestrictedPosts(c.AppContext.Session().UserId, postsList)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
 	var posts = []*model.Post{}
 	channelMap := make(map[string]*model.Channel)
 
@@ -496,24 +547,38 @@ func getPostsByIds(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// getEditHistoryForPost returns the previous revisions of a post, newest first. Authors can review
+// the history of their own posts, while compliance reviewers can review any post including deleted
+// ones.
 func getEditHistoryForPost(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequirePostId()
 	if c.Err != nil {
 		return
 	}
 
-	if !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), c.Params.PostId, model.PermissionEditPost) {
+	auditRec := c.MakeAuditRecord("getEditHistoryForPost", audit.Fail)
+	defer c.LogAuditRecWithLevel(auditRec, app.LevelContent)
+	audit.AddEventParameter(auditRec, "post_id", c.Params.PostId)
+
+	session := c.AppContext.Session()
+	isComplianceReviewer := c.App.SessionHasPermissionTo(*session, model.PermissionSysconsoleReadComplianceComplianceMonitoring)
+
+	if !isComplianceReviewer && !c.App.SessionHasPermissionToChannelByPost(*session, c.Params.PostId, model.PermissionEditPost) {
 		c.SetPermissionError(model.PermissionEditPost)
 		return
 	}
 
-	originalPost, err := c.App.GetSinglePost(c.Params.PostId, false)
+	originalPost, err := c.App.GetSinglePost(c.Params.PostId, isComplianceReviewer)
 	if err != nil {
-		c.SetPermissionError(model.PermissionEditPost)
+		if isComplianceReviewer {
+			c.Err = err
+		} else {
+			c.SetPermissionError(model.PermissionEditPost)
+		}
 		return
 	}
 
-	if c.AppContext.Session().UserId != originalPost.UserId {
+	if !isComplianceReviewer && session.UserId != originalPost.UserId {
 		c.SetPermissionError(model.PermissionEditPost)
 		return
 	}
@@ -524,6 +589,10 @@ func getEditHistoryForPost(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	auditRec.Success()
+	auditRec.AddEventResultState(originalPost)
+	auditRec.AddEventObjectType("post")
+
 	if err := json.NewEncoder(w).Encode(postsList); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
@@ -574,6 +643,11 @@ func getPostThread(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fields := getResponseFields(c, r)
+	if c.Err != nil {
+		return
+	}
+
 	// For now, by default we return all items unless it's set to maintain
 	// backwards compatibility with mobile. But when the next ESR passes, we need to
 	// change this to web.PerPageDefault.
@@ -648,7 +722,7 @@ func getPostThread(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if c.HandleEtag(list.Etag(), "Get Post Thread", w, r) {
+	if c.HandleEtag(fields.etag(list.Etag()), "Get Post Thread", w, r) {
 		return
 	}
 
@@ -659,11 +733,14 @@ func getPostThread(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set(model.HeaderEtagServer, clientPostList.Etag())
-
-	if err := clientPostList.EncodeJSON(w); err != nil {
-		mlog.Warn("Error while writing response", mlog.Err(err))
+	js, jsonErr := marshalPostListWithFields(fields, clientPostList)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("getPostThread", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+		return
 	}
+
+	w.Header().Set(model.HeaderEtagServer, fields.etag(clientPostList.Etag()))
+	w.Write(js)
 }
 
 func searchPostsInTeam(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -731,9 +808,16 @@ func searchPosts(c *Context, w http.ResponseWriter, r *http.Request, teamId stri
 		return
 	}
 
+	c.CancelWithRequest(r)
 	startTime := time.Now()
 
-	results, err := c.App.SearchPostsForUser(c.AppContext, terms, c.AppContext.Session().UserId, teamId, isOrSearch, includeDeletedChannels, timeZoneOffset, page, perPage, modifier)
+	var results *model.PostSearchResults
+	var err *model.AppError
+	if params.Semantic != nil && *params.Semantic && modifier != model.ModifierFiles {
+		results, err = c.App.SemanticSearchPostsForUser(c.AppContext, terms, c.AppContext.Session().UserId, teamId, isOrSearch, includeDeletedChannels, timeZoneOffset, page, perPage)
+	} else {
+		results, err = c.App.SearchPostsForUser(c.AppContext, terms, c.AppContext.Session().UserId, teamId, isOrSearch, includeDeletedChannels, timeZoneOffset, page, perPage, modifier)
+	}
 
 	elapsedTime := float64(time.Since(startTime)) / float64(time.Second)
 	metrics := c.App.Metrics()
@@ -747,6 +831,19 @@ func searchPosts(c *Context, w http.ResponseWriter, r *http.Request, teamId stri
 		return
 	}
 
+	// the label only narrows down the page of results, which can end up with fewer posts than requested
+	if params.SavedPostLabelId != nil && *params.SavedPostLabelId != "" {
+		if err = c.App.FilterPostListBySavedPostLabel(c.AppContext.Session().UserId, *params.SavedPostLabelId, results.PostList); err != nil {
+			c.Err = err
+			return
+		}
+		for postId := range results.Matches {
+			if _, ok := results.PostList.Posts[postId]; !ok {
+				delete(results.Matches, postId)
+			}
+		}
+	}
+
 	clientPostList := c.App.PreparePostListForClient(c.AppContext, results.PostList)
 	clientPostList, err = c.App.SanitizePostListMetadataForUser(c.AppContext, clientPostList, c.AppContext.Session().UserId)
 	if err != nil {
@@ -945,6 +1042,83 @@ func setPostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+// translatePost translates the message of a post into the requested language or, if none is given,
+// into the language the user reads translations in.
+func translatePost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.MapFromJSON(r.Body)
+
+	post, err := c.App.GetPostIfAuthorized(c.AppContext, c.Params.PostId, c.AppContext.Session(), false)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	translation, err := c.App.TranslatePost(c.AppContext, post, c.AppContext.Session().UserId, props["language"])
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(translation); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func markPostAsRead(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId().RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), c.Params.PostId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	receipt, appErr := c.App.MarkPostAsRead(c.AppContext, c.Params.PostId, c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(receipt); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getReadReceiptsForPost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	post, appErr := c.App.GetPostIfAuthorized(c.AppContext, c.Params.PostId, c.AppContext.Session(), false)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	receipts, appErr := c.App.GetReadReceiptsForPost(c.AppContext, post)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(receipts); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func saveIsPinnedPost(c *Context, w http.ResponseWriter, isPinned bool) {
 	c.RequirePostId()
 	if c.Err != nil {
@@ -1081,6 +1255,22 @@ func getFileInfosForPost(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	post, appErr := c.App.GetSinglePost(c.Params.PostId, includeDeleted)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	visible, appErr := c.App.IsPostVisibleToUser(c.AppContext.Session().UserId, post)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	if !visible {
+		c.Err = model.NewAppError("getFileInfosForPost", "app.post.get.app_error", nil, "", http.StatusNotFound)
+		return
+	}
+
 	infos, appErr := c.App.GetFileInfosForPostWithMigration(c.Params.PostId, includeDeleted)
 	if appErr != nil {
 		c.Err = appErr
@@ -2692,6 +2692,23 @@ func TestSearchPostsFromUser(t *testing.T) {
 	require.Len(t, posts.Order, 1, "wrong number of posts")
 }
 
+func TestSearchPostsSemantic(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	terms := "search"
+	semantic := true
+	searchParams := model.SearchParameter{
+		Terms:    &terms,
+		Semantic: &semantic,
+	}
+
+	_, resp, err := client.SearchPostsWithParams(th.BasicTeam.Id, &searchParams)
+	require.Error(t, err)
+	CheckNotImplementedStatus(t, resp)
+}
+
 func TestSearchPostsWithDateFlags(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -3189,6 +3206,132 @@ func TestGetEditHistoryForPost(t *testing.T) {
 		require.Error(t, err)
 		CheckForbiddenStatus(t, resp)
 	})
+
+	t.Run("compliance reviewer", func(t *testing.T) {
+		history, _, err := th.SystemAdminClient.GetEditHistoryForPost(rpost.Id)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+
+		_, appErr := th.App.DeletePost(th.Context, rpost.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		history, _, err = th.SystemAdminClient.GetEditHistoryForPost(rpost.Id)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+
+		th.LoginBasic()
+		_, resp, err := client.GetEditHistoryForPost(rpost.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
+func TestTranslatePost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Q      string `json:"q"`
+			Target string `json:"target"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"translatedText":   req.Target + ": " + req.Q,
+			"detectedLanguage": map[string]any{"language": "en"},
+		})
+	}))
+	defer server.Close()
+
+	post := th.CreatePost()
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := client.TranslatePost(post.Id, "fr")
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.TranslationSettings.Enable = true
+		*cfg.TranslationSettings.Provider = model.TranslationProviderLibreTranslate
+		*cfg.TranslationSettings.APIURL = server.URL
+	})
+
+	t.Run("translate", func(t *testing.T) {
+		translation, _, err := client.TranslatePost(post.Id, "fr")
+		require.NoError(t, err)
+		assert.Equal(t, post.Id, translation.PostId)
+		assert.Equal(t, "fr", translation.Language)
+		assert.Equal(t, "fr: "+post.Message, translation.Message)
+	})
+
+	t.Run("defaults to the user's language", func(t *testing.T) {
+		translation, _, err := client.TranslatePost(post.Id, "")
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Locale, translation.Language)
+	})
+
+	t.Run("invalid language", func(t *testing.T) {
+		_, resp, err := client.TranslatePost(post.Id, "not a language")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("no access to the channel", func(t *testing.T) {
+		privatePost := th.CreatePostWithClient(th.SystemAdminClient, th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate))
+		_, resp, err := client.TranslatePost(privatePost.Id, "fr")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
+func TestReadReceipts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	post := th.CreatePost()
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.Client.MarkPostAsRead(th.BasicUser.Id, post.Id)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableReadReceipts = true })
+
+	t.Run("mark as read", func(t *testing.T) {
+		th.LoginBasic2()
+		receipt, _, err := th.Client.MarkPostAsRead(th.BasicUser2.Id, post.Id)
+		require.NoError(t, err)
+		assert.Equal(t, post.Id, receipt.PostId)
+		assert.Equal(t, th.BasicUser2.Id, receipt.UserId)
+
+		th.LoginBasic()
+		receipts, _, err := th.Client.GetReadReceiptsForPost(post.Id)
+		require.NoError(t, err)
+		require.Len(t, receipts, 1)
+		assert.Equal(t, th.BasicUser2.Id, receipts[0].UserId)
+	})
+
+	t.Run("mark as read for another user", func(t *testing.T) {
+		_, resp, err := th.Client.MarkPostAsRead(th.BasicUser2.Id, post.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("no access to the channel", func(t *testing.T) {
+		privatePost := th.CreatePostWithClient(th.SystemAdminClient, th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate))
+
+		_, resp, err := th.Client.MarkPostAsRead(th.BasicUser.Id, privatePost.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetReadReceiptsForPost(privatePost.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
 }
 
 func TestCreatePostNotificationsWithCRT(t *testing.T) {
@@ -3797,3 +3940,56 @@ func TestUnacknowledgePost(t *testing.T) {
 	require.Error(t, err)
 	CheckUnauthorizedStatus(t, resp)
 }
+
+func TestRestrictedPost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicenseSKU(model.LicenseShortSkuEnterprise))
+	client := th.Client
+
+	post := &model.Post{ChannelId: th.BasicChannel.Id, Message: "restricted"}
+	post.AddProp(model.PostPropsRestrictedTo, map[string]any{"user_ids": []string{model.NewId()}})
+	post, _, err := client.CreatePost(post)
+	require.NoError(t, err)
+	_, err = client.PinPost(post.Id)
+	require.NoError(t, err)
+
+	t.Run("visible to the author", func(t *testing.T) {
+		_, _, err := client.GetPost(post.Id, "")
+		require.NoError(t, err)
+
+		_, _, err = client.GetFileInfosForPost(post.Id, "")
+		require.NoError(t, err)
+
+		list, _, err := client.GetPostsForChannel(th.BasicChannel.Id, 0, 60, "", false, false)
+		require.NoError(t, err)
+		assert.Contains(t, list.Order, post.Id)
+	})
+
+	t.Run("hidden from the other members", func(t *testing.T) {
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err := client.GetPost(post.Id, "")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		list, _, err := client.GetPostsForChannel(th.BasicChannel.Id, 0, 60, "", false, false)
+		require.NoError(t, err)
+		assert.NotContains(t, list.Order, post.Id)
+		assert.NotContains(t, list.Posts, post.Id)
+
+		pinned, _, err := client.GetPinnedPosts(th.BasicChannel.Id, "")
+		require.NoError(t, err)
+		assert.NotContains(t, pinned.Posts, post.Id)
+
+		_, resp, err = client.GetFileInfosForPost(post.Id, "")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, resp, err = client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, RootId: post.Id, Message: "reply"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
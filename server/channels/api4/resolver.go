@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/graph-gophers/dataloader/v6"
 
@@ -28,6 +29,8 @@ type cursorPrefix string
 const (
 	channelMemberCursorPrefix cursorPrefix = "channelMember"
 	channelCursorPrefix       cursorPrefix = "channel"
+	postCursorPrefix          cursorPrefix = "post"
+	threadCursorPrefix        cursorPrefix = "thread"
 )
 
 type resolver struct {
@@ -379,6 +382,224 @@ func (*resolver) SidebarCategories(ctx context.Context, args struct {
 	return res, nil
 }
 
+// match with api4.getPost
+func (*resolver) Post(ctx context.Context, args struct{ ID string }) (*post, error) {
+	c, err := getCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.IsValidId(args.ID) {
+		return nil, web.NewInvalidParamError("post_id")
+	}
+
+	p, appErr := c.App.GetPostIfAuthorized(c.AppContext, args.ID, c.AppContext.Session(), false)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	p = c.App.PreparePostForClientWithEmbedsAndImages(c.AppContext, p, false, false, true)
+	p, appErr = c.App.SanitizePostMetadataForUser(c.AppContext, p, c.AppContext.Session().UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return &post{p}, nil
+}
+
+// match with api4.getPostsForChannel, the posts being the newest first.
+func (*resolver) Posts(ctx context.Context, args struct {
+	ChannelID        string
+	CollapsedThreads bool
+	First            int32
+	After            string
+}) ([]*post, error) {
+	c, err := getCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.IsValidId(args.ChannelID) {
+		return nil, web.NewInvalidParamError("channel_id")
+	}
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), args.ChannelID, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return nil, c.Err
+	}
+
+	limit := int(args.First)
+	// ensure args.First limit
+	if limit == 0 {
+		limit = web.PerPageDefault
+	} else if limit > web.PerPageMaximum {
+		return nil, fmt.Errorf("first parameter %d higher than allowed maximum of %d", limit, web.PerPageMaximum)
+	}
+
+	// ensure args.After format
+	var afterPost string
+	var ok bool
+	if args.After != "" {
+		afterPost, ok = parsePostCursor(args.After)
+		if !ok {
+			return nil, fmt.Errorf("after cursor not in the correct format: %s", args.After)
+		}
+	}
+
+	if !*c.App.Config().TeamSettings.ExperimentalViewArchivedChannels {
+		ch, appErr := c.App.GetChannel(c.AppContext, args.ChannelID)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if ch.DeleteAt != 0 {
+			return nil, model.NewAppError("Api4.getPostsForChannel", "api.user.view_archived_channels.get_posts_for_channel.app_error", nil, "", http.StatusForbidden)
+		}
+	}
+
+	opts := model.GetPostsOptions{
+		ChannelId:        args.ChannelID,
+		PerPage:          limit,
+		SkipFetchThreads: true,
+		CollapsedThreads: args.CollapsedThreads,
+		UserId:           c.AppContext.Session().UserId,
+	}
+
+	var list *model.PostList
+	var appErr *model.AppError
+	// The posts are listed newest first, so the posts after
+	// the cursor are the ones posted before it.
+	if afterPost != "" {
+		opts.PostId = afterPost
+		list, appErr = c.App.GetPostsBeforePost(opts)
+	} else {
+		list, appErr = c.App.GetPostsPage(opts)
+	}
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr = c.App.FilterRestrictedPostList(c.AppContext.Session().UserId, list); appErr != nil {
+		return nil, appErr
+	}
+
+	list = c.App.PreparePostListForClient(c.AppContext, list)
+	list, appErr = c.App.SanitizePostListMetadataForUser(c.AppContext, list, c.AppContext.Session().UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	res := make([]*post, 0, len(list.Order))
+	for _, id := range list.Order {
+		if p, ok := list.Posts[id]; ok {
+			res = append(res, &post{p})
+		}
+	}
+
+	return res, nil
+}
+
+// match with api4.getThreadsForUser
+func (*resolver) Threads(ctx context.Context, args struct {
+	UserID string
+	TeamID string
+	Unread bool
+	First  int32
+	After  string
+}) ([]*thread, error) {
+	c, err := getCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.UserID == model.Me {
+		args.UserID = c.AppContext.Session().UserId
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), args.UserID) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return nil, c.Err
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), args.TeamID, model.PermissionViewTeam) {
+		c.SetPermissionError(model.PermissionViewTeam)
+		return nil, c.Err
+	}
+
+	limit := int(args.First)
+	// ensure args.First limit
+	if limit == 0 {
+		limit = web.PerPageDefault
+	} else if limit > web.PerPageMaximum {
+		return nil, fmt.Errorf("first parameter %d higher than allowed maximum of %d", limit, web.PerPageMaximum)
+	}
+
+	// ensure args.After format
+	var afterThread string
+	var ok bool
+	if args.After != "" {
+		afterThread, ok = parseThreadCursor(args.After)
+		if !ok {
+			return nil, fmt.Errorf("after cursor not in the correct format: %s", args.After)
+		}
+	}
+
+	threads, appErr := c.App.GetThreadsForUser(args.UserID, args.TeamID, model.GetUserThreadsOpts{
+		PageSize:    uint64(limit),
+		After:       afterThread,
+		Unread:      args.Unread,
+		ThreadsOnly: true,
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	res := make([]*thread, 0, len(threads.Threads))
+	for _, t := range threads.Threads {
+		res = append(res, &thread{*t})
+	}
+
+	return res, nil
+}
+
+// match with api4.getPreferences for category="",
+// and api4.getPreferencesByCategory for category != ""
+func (*resolver) Preferences(ctx context.Context, args struct {
+	UserID   string
+	Category string
+}) ([]model.Preference, error) {
+	c, err := getCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.UserID == model.Me {
+		args.UserID = c.AppContext.Session().UserId
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), args.UserID) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return nil, c.Err
+	}
+
+	if args.Category == "" {
+		preferences, appErr := c.App.GetPreferencesForUser(args.UserID)
+		if appErr != nil {
+			return nil, appErr
+		}
+		return preferences, nil
+	}
+
+	preferences, appErr := c.App.GetPreferenceByCategoryForUser(args.UserID, args.Category)
+	if appErr != nil {
+		// An empty category is not an error for a list.
+		if appErr.StatusCode == http.StatusNotFound {
+			return []model.Preference{}, nil
+		}
+		return nil, appErr
+	}
+	return preferences, nil
+}
+
 // getCtx extracts web.Context out of the usual request context.
 // Kind of an anti-pattern, but there are lots of methods attached to *web.Context
 // so we use it for now.
@@ -425,3 +646,12 @@ func getUsersLoader(ctx context.Context) (*dataloader.Loader, error) {
 	}
 	return l, nil
 }
+
+// getPostsLoader returns the posts loader out of the context.
+func getPostsLoader(ctx context.Context) (*dataloader.Loader, error) {
+	l, ok := ctx.Value(postsLoaderCtx).(*dataloader.Loader)
+	if !ok {
+		return nil, errors.New("no dataloader.Loader found in context")
+	}
+	return l, nil
+}
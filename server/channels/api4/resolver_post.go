@@ -0,0 +1,232 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/graph-gophers/dataloader/v6"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/web"
+)
+
+// post is an internal graphQL wrapper struct to add resolver methods.
+//
+// model.Post holds a mutex, so it's embedded as a pointer. Fields of embedded pointers
+// are not resolved by the graphQL library, hence the accessors below.
+type post struct {
+	*model.Post
+}
+
+func (p *post) Id() string {
+	return p.Post.Id
+}
+
+func (p *post) IsPinned() bool {
+	return p.Post.IsPinned
+}
+
+func (p *post) RootId() string {
+	return p.Post.RootId
+}
+
+func (p *post) OriginalId() string {
+	return p.Post.OriginalId
+}
+
+func (p *post) Message() string {
+	return p.Post.Message
+}
+
+func (p *post) Type() string {
+	return p.Post.Type
+}
+
+func (p *post) Props() model.StringInterface {
+	props := p.GetProps()
+	if props == nil {
+		// props is not nullable in the schema.
+		return model.StringInterface{}
+	}
+	return props
+}
+
+func (p *post) Hashtags() string {
+	return p.Post.Hashtags
+}
+
+func (p *post) FileIds() []string {
+	if p.Post.FileIds == nil {
+		return []string{}
+	}
+	return p.Post.FileIds
+}
+
+func (p *post) HasReactions() bool {
+	return p.Post.HasReactions
+}
+
+func (p *post) RemoteId() *string {
+	return p.Post.RemoteId
+}
+
+func (p *post) IsFollowing() *bool {
+	return p.Post.IsFollowing
+}
+
+// match with api4.getUser
+func (p *post) User(ctx context.Context) (*user, error) {
+	return getGraphQLUser(ctx, p.UserId)
+}
+
+// match with api4.getChannel
+func (p *post) Channel(ctx context.Context) (*channel, error) {
+	loader, err := getChannelsLoader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	thunk := loader.Load(ctx, dataloader.StringKey(p.ChannelId))
+	result, err := thunk()
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*channel), nil
+}
+
+// match with api4.getPost
+func (p *post) RootPost(ctx context.Context) (*post, error) {
+	if p.Post.RootId == "" {
+		return nil, nil
+	}
+
+	loader, err := getPostsLoader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	thunk := loader.Load(ctx, dataloader.StringKey(p.Post.RootId))
+	result, err := thunk()
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*post), nil
+}
+
+func (p *post) Cursor() *string {
+	cursor := string(postCursorPrefix) + "-" + p.Post.Id
+	encoded := base64.StdEncoding.EncodeToString([]byte(cursor))
+	return model.NewString(encoded)
+}
+
+func parsePostCursor(cursor string) (postID string, ok bool) {
+	return parseIDCursor(cursor, postCursorPrefix)
+}
+
+// parseIDCursor extracts the id out of a cursor made of a prefix and a single id.
+func parseIDCursor(cursor string, expectedPrefix cursorPrefix) (id string, ok bool) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", false
+	}
+
+	prefix, id, found := strings.Cut(string(decoded), "-")
+	if !found {
+		return "", false
+	}
+
+	if cursorPrefix(prefix) != expectedPrefix || !model.IsValidId(id) {
+		return "", false
+	}
+
+	return id, true
+}
+
+func graphQLPostsLoader(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	stringKeys := keys.Keys()
+	result := make([]*dataloader.Result, len(stringKeys))
+
+	c, err := getCtx(ctx)
+	if err != nil {
+		for i := range result {
+			result[i] = &dataloader.Result{Error: err}
+		}
+		return result
+	}
+
+	posts, err := getGraphQLPosts(ctx, c, stringKeys)
+	if err != nil {
+		for i := range result {
+			result[i] = &dataloader.Result{Error: err}
+		}
+		return result
+	}
+
+	for i, p := range posts {
+		result[i] = &dataloader.Result{Data: p}
+	}
+	return result
+}
+
+// match with api4.getPostsByIds
+func getGraphQLPosts(ctx context.Context, c *web.Context, postIDs []string) ([]*post, error) {
+	posts, _, appErr := c.App.GetPostsByIds(postIDs)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	posts, appErr = c.App.FilterRestrictedPosts(c.AppContext.Session().UserId, posts)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if len(posts) != len(postIDs) {
+		return nil, fmt.Errorf("all posts were not found. Requested %d; Found %d", len(postIDs), len(posts))
+	}
+
+	// Loading the channels checks that the session can read them,
+	// and keeps them around for the channel fields of the posts.
+	uniqueChannels := make(map[string]bool)
+	var channelIDs []string
+	for _, p := range posts {
+		if !uniqueChannels[p.ChannelId] {
+			uniqueChannels[p.ChannelId] = true
+			channelIDs = append(channelIDs, p.ChannelId)
+		}
+	}
+
+	loader, err := getChannelsLoader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	thunk := loader.LoadMany(ctx, dataloader.NewKeysFromStrings(channelIDs))
+	_, errs := thunk()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The posts need to be in the exact same order as the input slice.
+	tmp := make(map[string]*post)
+	for _, p := range posts {
+		p = c.App.PreparePostForClient(c.AppContext, p, false, false, true)
+		p.StripActionIntegrations()
+		tmp[p.Id] = &post{p}
+	}
+
+	res := make([]*post, len(postIDs))
+	for i, id := range postIDs {
+		res[i] = tmp[id]
+	}
+
+	return res, nil
+}
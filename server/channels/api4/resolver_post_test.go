@@ -0,0 +1,215 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+type graphQLPost struct {
+	ID       string  `json:"id"`
+	CreateAt float64 `json:"createAt"`
+	Message  string  `json:"message"`
+	RootID   string  `json:"rootId"`
+	User     struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	Channel struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+	RootPost *struct {
+		ID      string `json:"id"`
+		Message string `json:"message"`
+	} `json:"rootPost"`
+	Cursor string `json:"cursor"`
+}
+
+func TestGraphQLPost(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var q struct {
+		Post *graphQLPost `json:"post"`
+	}
+
+	query := `
+	query post($id: String!) {
+		post(id: $id) {
+			id
+			createAt
+			message
+			rootId
+			user {
+				id
+			}
+			channel {
+				id
+			}
+			rootPost {
+				id
+				message
+			}
+		}
+	}
+	`
+
+	t.Run("Root post", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "post",
+			Query:         query,
+			Variables:     map[string]any{"id": th.BasicPost.Id},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		require.NotNil(t, q.Post)
+		assert.Equal(t, th.BasicPost.Id, q.Post.ID)
+		assert.Equal(t, float64(th.BasicPost.CreateAt), q.Post.CreateAt)
+		assert.Equal(t, th.BasicPost.Message, q.Post.Message)
+		assert.Equal(t, th.BasicUser.Id, q.Post.User.ID)
+		assert.Equal(t, th.BasicChannel.Id, q.Post.Channel.ID)
+		assert.Nil(t, q.Post.RootPost)
+	})
+
+	t.Run("Reply", func(t *testing.T) {
+		reply, _, err := th.Client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "reply", RootId: th.BasicPost.Id})
+		require.NoError(t, err)
+
+		input := graphQLInput{
+			OperationName: "post",
+			Query:         query,
+			Variables:     map[string]any{"id": reply.Id},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		require.NotNil(t, q.Post)
+		assert.Equal(t, th.BasicPost.Id, q.Post.RootID)
+		require.NotNil(t, q.Post.RootPost)
+		assert.Equal(t, th.BasicPost.Id, q.Post.RootPost.ID)
+		assert.Equal(t, th.BasicPost.Message, q.Post.RootPost.Message)
+	})
+
+	t.Run("Private channel of others", func(t *testing.T) {
+		channel := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+		post := th.CreatePostWithClient(th.SystemAdminClient, channel)
+
+		input := graphQLInput{
+			OperationName: "post",
+			Query:         query,
+			Variables:     map[string]any{"id": post.Id},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+	})
+}
+
+func TestGraphQLPosts(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	channel := th.CreatePublicChannel()
+	var posts []*model.Post
+	for i := 0; i < 5; i++ {
+		posts = append(posts, th.CreatePostWithClient(th.Client, channel))
+	}
+
+	var q struct {
+		Posts []*graphQLPost `json:"posts"`
+	}
+
+	query := `
+	query posts($channelId: String!, $first: Int, $after: String = "") {
+		posts(channelId: $channelId, first: $first, after: $after) {
+			id
+			message
+			user {
+				id
+			}
+			channel {
+				id
+			}
+			cursor
+		}
+	}
+	`
+
+	t.Run("Pages", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "posts",
+			Query:         query,
+			Variables:     map[string]any{"channelId": channel.Id, "first": 3},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		require.Len(t, q.Posts, 3)
+		// Newest first
+		assert.Equal(t, posts[4].Id, q.Posts[0].ID)
+		assert.Equal(t, posts[3].Id, q.Posts[1].ID)
+		assert.Equal(t, posts[2].Id, q.Posts[2].ID)
+		for _, p := range q.Posts {
+			assert.Equal(t, th.BasicUser.Id, p.User.ID)
+			assert.Equal(t, channel.Id, p.Channel.ID)
+		}
+
+		input.Variables["after"] = q.Posts[2].Cursor
+		resp, err = th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		// The channel may also hold system messages from before the posts
+		require.GreaterOrEqual(t, len(q.Posts), 2)
+		assert.Equal(t, posts[1].Id, q.Posts[0].ID)
+		assert.Equal(t, posts[0].Id, q.Posts[1].ID)
+	})
+
+	t.Run("Invalid cursor", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "posts",
+			Query:         query,
+			Variables:     map[string]any{"channelId": channel.Id, "after": "invalid"},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+	})
+
+	t.Run("Private channel of others", func(t *testing.T) {
+		privateChannel := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+		th.CreatePostWithClient(th.SystemAdminClient, privateChannel)
+
+		input := graphQLInput{
+			OperationName: "posts",
+			Query:         query,
+			Variables:     map[string]any{"channelId": privateChannel.Id},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+	})
+}
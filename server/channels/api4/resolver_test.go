@@ -76,6 +76,101 @@ func TestGraphQLLicense(t *testing.T) {
 	assert.Equal(t, cfg, q.License)
 }
 
+func TestGraphQLPreferences(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	preferences := model.Preferences{
+		{UserId: th.BasicUser.Id, Category: "graphql_test", Name: "a", Value: "1"},
+		{UserId: th.BasicUser.Id, Category: "graphql_test", Name: "b", Value: "2"},
+	}
+	_, err := th.Client.UpdatePreferences(th.BasicUser.Id, preferences)
+	require.NoError(t, err)
+
+	var q struct {
+		Preferences []struct {
+			UserID   string `json:"userId"`
+			Category string `json:"category"`
+			Name     string `json:"name"`
+			Value    string `json:"value"`
+		} `json:"preferences"`
+	}
+
+	query := `
+	query preferences($userId: String = "me", $category: String = "") {
+		preferences(userId: $userId, category: $category) {
+			userId
+			category
+			name
+			value
+		}
+	}
+	`
+
+	t.Run("All", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "preferences",
+			Query:         query,
+		}
+
+		all, _, err := th.Client.GetPreferences(th.BasicUser.Id)
+		require.NoError(t, err)
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		assert.Len(t, q.Preferences, len(all))
+	})
+
+	t.Run("Category", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "preferences",
+			Query:         query,
+			Variables:     map[string]any{"category": "graphql_test"},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		require.Len(t, q.Preferences, 2)
+		for _, p := range q.Preferences {
+			assert.Equal(t, th.BasicUser.Id, p.UserID)
+			assert.Equal(t, "graphql_test", p.Category)
+		}
+	})
+
+	t.Run("Empty category", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "preferences",
+			Query:         query,
+			Variables:     map[string]any{"category": "graphql_test_empty"},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		assert.Len(t, q.Preferences, 0)
+	})
+
+	t.Run("Other user", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "preferences",
+			Query:         query,
+			Variables:     map[string]any{"userId": th.BasicUser2.Id},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+	})
+}
+
 func TestGraphQLChannelsLeft(t *testing.T) {
 	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
 	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/graph-gophers/dataloader/v6"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// thread is an internal graphQL wrapper struct to add resolver methods.
+type thread struct {
+	model.ThreadResponse
+}
+
+func (t *thread) Post() *post {
+	if t.ThreadResponse.Post == nil {
+		return nil
+	}
+	return &post{t.ThreadResponse.Post}
+}
+
+// match with api4.getUser
+func (t *thread) Participants(ctx context.Context) ([]*user, error) {
+	if len(t.ThreadResponse.Participants) == 0 {
+		return []*user{}, nil
+	}
+
+	loader, err := getUsersLoader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, len(t.ThreadResponse.Participants))
+	for i, participant := range t.ThreadResponse.Participants {
+		userIDs[i] = participant.Id
+	}
+
+	thunk := loader.LoadMany(ctx, dataloader.NewKeysFromStrings(userIDs))
+	results, errs := thunk()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	users := make([]*user, len(results))
+	for i, res := range results {
+		users[i] = &user{*res.(*model.User)}
+	}
+
+	return users, nil
+}
+
+func (t *thread) Cursor() *string {
+	cursor := string(threadCursorPrefix) + "-" + t.PostId
+	encoded := base64.StdEncoding.EncodeToString([]byte(cursor))
+	return model.NewString(encoded)
+}
+
+func parseThreadCursor(cursor string) (threadID string, ok bool) {
+	return parseIDCursor(cursor, threadCursorPrefix)
+}
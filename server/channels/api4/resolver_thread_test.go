@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGraphQLThreads(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.ThreadAutoFollow = true
+		*cfg.ServiceSettings.CollapsedThreads = model.CollapsedThreadsDefaultOn
+	})
+
+	var roots []*model.Post
+	for i := 0; i < 3; i++ {
+		root, _, err := th.Client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "root"})
+		require.NoError(t, err)
+		_, appErr := th.App.CreatePostAsUser(th.Context, &model.Post{UserId: th.BasicUser2.Id, ChannelId: th.BasicChannel.Id, Message: "reply", RootId: root.Id}, "", false)
+		require.Nil(t, appErr)
+		roots = append(roots, root)
+	}
+
+	var q struct {
+		Threads []struct {
+			Post struct {
+				ID      string `json:"id"`
+				Message string `json:"message"`
+				Channel struct {
+					ID string `json:"id"`
+				} `json:"channel"`
+			} `json:"post"`
+			ReplyCount    float64 `json:"replyCount"`
+			UnreadReplies float64 `json:"unreadReplies"`
+			Participants  []struct {
+				ID string `json:"id"`
+			} `json:"participants"`
+			Cursor string `json:"cursor"`
+		} `json:"threads"`
+	}
+
+	query := `
+	query threads($userId: String = "me", $teamId: String!, $first: Int, $after: String = "") {
+		threads(userId: $userId, teamId: $teamId, first: $first, after: $after) {
+			post {
+				id
+				message
+				channel {
+					id
+				}
+			}
+			replyCount
+			unreadReplies
+			participants {
+				id
+			}
+			cursor
+		}
+	}
+	`
+
+	t.Run("Pages", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "threads",
+			Query:         query,
+			Variables:     map[string]any{"teamId": th.BasicTeam.Id, "first": 2},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		require.Len(t, q.Threads, 2)
+		// The most recently replied to first
+		assert.Equal(t, roots[2].Id, q.Threads[0].Post.ID)
+		assert.Equal(t, roots[1].Id, q.Threads[1].Post.ID)
+		for _, thread := range q.Threads {
+			assert.Equal(t, th.BasicChannel.Id, thread.Post.Channel.ID)
+			assert.Equal(t, float64(1), thread.ReplyCount)
+			assert.Equal(t, float64(1), thread.UnreadReplies)
+			participantIDs := make([]string, 0, len(thread.Participants))
+			for _, participant := range thread.Participants {
+				participantIDs = append(participantIDs, participant.ID)
+			}
+			assert.Contains(t, participantIDs, th.BasicUser2.Id)
+		}
+
+		input.Variables["after"] = q.Threads[1].Cursor
+		resp, err = th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		require.Len(t, q.Threads, 1)
+		assert.Equal(t, roots[0].Id, q.Threads[0].Post.ID)
+	})
+
+	t.Run("Other user", func(t *testing.T) {
+		input := graphQLInput{
+			OperationName: "threads",
+			Query:         query,
+			Variables:     map[string]any{"userId": th.BasicUser2.Id, "teamId": th.BasicTeam.Id},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetChannelResourceHints(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	hints, _, err := th.Client.GetChannelResourceHints(th.BasicChannel.Id)
+	require.NoError(t, err)
+	assert.Equal(t, th.BasicChannel.Id, hints.ChannelId)
+	assert.Contains(t, hints.UserIds, th.BasicUser.Id)
+	assert.NotNil(t, hints.Emojis)
+	assert.NotNil(t, hints.LinkPreviewURLs)
+
+	t.Run("invalid channel id", func(t *testing.T) {
+		_, resp, err := th.Client.GetChannelResourceHints("junk")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("private channel of others", func(t *testing.T) {
+		channel := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+		_, resp, err := th.Client.GetChannelResourceHints(channel.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("logged out", func(t *testing.T) {
+		client := th.CreateClient()
+		_, resp, err := client.GetChannelResourceHints(th.BasicChannel.Id)
+		require.Error(t, err)
+		CheckUnauthorizedStatus(t, resp)
+	})
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const maxResponseFields = 50
+
+var validResponseField = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Default values dropped from sparse responses, as encoded by encoding/json.
+var defaultJSONValues = [][]byte{
+	[]byte(`""`),
+	[]byte(`0`),
+	[]byte(`false`),
+	[]byte(`null`),
+	[]byte(`{}`),
+	[]byte(`[]`),
+}
+
+// responseFields prunes the objects of a response down to what the client asked for, to reduce
+// the size of the responses of the heavy endpoints. Clients list the fields they want in the
+// fields query parameter, the id always being kept, and may ask with sparse=true for the fields
+// having their default value to be left out, in which case they have to default them.
+type responseFields struct {
+	fields map[string]bool
+	sparse bool
+}
+
+// getResponseFields returns the field selection of the request, or nil when the full objects
+// were asked for. It sets c.Err when the selection is invalid.
+func getResponseFields(c *Context, r *http.Request) *responseFields {
+	query := r.URL.Query()
+	sparse := query.Get("sparse") == "true"
+	fieldsParam := query.Get("fields")
+	if fieldsParam == "" && !sparse {
+		return nil
+	}
+
+	rf := &responseFields{sparse: sparse}
+	if fieldsParam != "" {
+		fields := strings.Split(fieldsParam, ",")
+		if len(fields) > maxResponseFields {
+			c.SetInvalidParam("fields")
+			return nil
+		}
+
+		rf.fields = map[string]bool{"id": true}
+		for _, field := range fields {
+			field = strings.TrimSpace(field)
+			if !validResponseField.MatchString(field) {
+				c.SetInvalidParam("fields")
+				return nil
+			}
+			rf.fields[field] = true
+		}
+	}
+
+	return rf
+}
+
+// etag returns the ETag of the pruned response, so that the clients' caches of the full and
+// pruned responses don't get mixed up.
+func (rf *responseFields) etag(etag string) string {
+	if rf == nil || etag == "" {
+		return etag
+	}
+
+	fields := make([]string, 0, len(rf.fields))
+	for field := range rf.fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	etag += "." + strings.Join(fields, ",")
+	if rf.sparse {
+		etag += ".sparse"
+	}
+	return etag
+}
+
+// marshalWithFields encodes v, an object or a list of objects, pruned as the client asked.
+func marshalWithFields(rf *responseFields, v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || rf == nil {
+		return b, err
+	}
+
+	return rf.prune(b)
+}
+
+// marshalPostListWithFields encodes a post list, with its posts pruned as the client asked.
+func marshalPostListWithFields(rf *responseFields, list *model.PostList) ([]byte, error) {
+	list.StripActionIntegrations()
+	b, err := json.Marshal(list)
+	if err != nil || rf == nil {
+		return b, err
+	}
+
+	var object map[string]json.RawMessage
+	if err = json.Unmarshal(b, &object); err != nil {
+		return nil, err
+	}
+
+	var posts map[string]json.RawMessage
+	if err = json.Unmarshal(object["posts"], &posts); err != nil {
+		return nil, err
+	}
+
+	for id, post := range posts {
+		if posts[id], err = rf.pruneObject(post); err != nil {
+			return nil, err
+		}
+	}
+
+	if object["posts"], err = json.Marshal(posts); err != nil {
+		return nil, err
+	}
+	return json.Marshal(object)
+}
+
+// prune prunes an encoded object, or each object of an encoded list.
+func (rf *responseFields) prune(b []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(b)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return rf.pruneObject(b)
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, err
+	}
+
+	for i, item := range list {
+		pruned, err := rf.pruneObject(item)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = pruned
+	}
+
+	return json.Marshal(list)
+}
+
+func (rf *responseFields) pruneObject(b []byte) ([]byte, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(b, &object); err != nil {
+		return nil, err
+	}
+
+	// null entries, for instance of missing users, are left alone
+	if object == nil {
+		return b, nil
+	}
+
+	for field, value := range object {
+		if rf.fields != nil && !rf.fields[field] {
+			delete(object, field)
+		} else if rf.sparse && isDefaultJSONValue(value) {
+			delete(object, field)
+		}
+	}
+
+	return json.Marshal(object)
+}
+
+func isDefaultJSONValue(value json.RawMessage) bool {
+	value = bytes.TrimSpace(value)
+	for _, defaultValue := range defaultJSONValues {
+		if bytes.Equal(value, defaultValue) {
+			return true
+		}
+	}
+	return false
+}
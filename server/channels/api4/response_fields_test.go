@@ -0,0 +1,161 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestResponseFieldsPrune(t *testing.T) {
+	user := &model.User{Id: model.NewId(), Username: "username", Email: "user@example.com", Nickname: ""}
+
+	t.Run("fields", func(t *testing.T) {
+		rf := &responseFields{fields: map[string]bool{"id": true, "username": true}}
+		b, err := marshalWithFields(rf, user)
+		require.NoError(t, err)
+
+		var object map[string]any
+		require.NoError(t, json.Unmarshal(b, &object))
+		assert.Equal(t, map[string]any{"id": user.Id, "username": "username"}, object)
+	})
+
+	t.Run("sparse", func(t *testing.T) {
+		rf := &responseFields{sparse: true}
+		b, err := marshalWithFields(rf, user)
+		require.NoError(t, err)
+
+		var object map[string]any
+		require.NoError(t, json.Unmarshal(b, &object))
+		assert.Equal(t, user.Id, object["id"])
+		assert.Equal(t, "user@example.com", object["email"])
+		assert.NotContains(t, object, "nickname")
+		assert.NotContains(t, object, "create_at")
+		assert.NotContains(t, object, "email_verified")
+	})
+
+	t.Run("list", func(t *testing.T) {
+		rf := &responseFields{fields: map[string]bool{"id": true}}
+		b, err := marshalWithFields(rf, []*model.User{user, nil})
+		require.NoError(t, err)
+
+		var list []map[string]any
+		require.NoError(t, json.Unmarshal(b, &list))
+		require.Len(t, list, 2)
+		assert.Equal(t, map[string]any{"id": user.Id}, list[0])
+		assert.Nil(t, list[1])
+	})
+
+	t.Run("post list", func(t *testing.T) {
+		post := &model.Post{Id: model.NewId(), Message: "message", ChannelId: model.NewId()}
+		postList := model.NewPostList()
+		postList.AddPost(post)
+		postList.AddOrder(post.Id)
+
+		rf := &responseFields{fields: map[string]bool{"id": true, "message": true}}
+		b, err := marshalPostListWithFields(rf, postList)
+		require.NoError(t, err)
+
+		var list struct {
+			Order []string                  `json:"order"`
+			Posts map[string]map[string]any `json:"posts"`
+		}
+		require.NoError(t, json.Unmarshal(b, &list))
+		assert.Equal(t, []string{post.Id}, list.Order)
+		assert.Equal(t, map[string]any{"id": post.Id, "message": "message"}, list.Posts[post.Id])
+	})
+
+	t.Run("no selection", func(t *testing.T) {
+		b, err := marshalWithFields(nil, user)
+		require.NoError(t, err)
+
+		expected, err := json.Marshal(user)
+		require.NoError(t, err)
+		assert.Equal(t, expected, b)
+	})
+}
+
+func TestResponseFieldsEtag(t *testing.T) {
+	var rf *responseFields
+	assert.Equal(t, "etag", rf.etag("etag"))
+
+	rf = &responseFields{fields: map[string]bool{"id": true, "username": true}}
+	assert.Equal(t, "etag.id,username", rf.etag("etag"))
+	assert.Equal(t, "", rf.etag(""))
+
+	rf.sparse = true
+	assert.Equal(t, "etag.id,username.sparse", rf.etag("etag"))
+}
+
+func TestGetWithResponseFields(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("user", func(t *testing.T) {
+		resp, err := th.Client.DoAPIGet("/users/"+th.BasicUser2.Id+"?fields=username,nickname", "")
+		require.NoError(t, err)
+		defer closeBody(resp)
+
+		var user map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&user))
+		assert.Equal(t, th.BasicUser2.Id, user["id"])
+		assert.Equal(t, th.BasicUser2.Username, user["username"])
+		assert.Contains(t, user, "nickname")
+		assert.NotContains(t, user, "create_at")
+		assert.NotEqual(t, "", resp.Header.Get(model.HeaderEtagServer))
+
+		fullUser, fullResp, err := th.Client.GetUser(th.BasicUser2.Id, "")
+		require.NoError(t, err)
+		require.NotNil(t, fullUser)
+		assert.NotEqual(t, fullResp.Etag, resp.Header.Get(model.HeaderEtagServer))
+	})
+
+	t.Run("users sparse", func(t *testing.T) {
+		resp, err := th.Client.DoAPIGet("/users?in_team="+th.BasicTeam.Id+"&sparse=true", "")
+		require.NoError(t, err)
+		defer closeBody(resp)
+
+		var users []map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&users))
+		require.NotEmpty(t, users)
+		for _, user := range users {
+			assert.NotContains(t, user, "delete_at")
+		}
+	})
+
+	t.Run("channel", func(t *testing.T) {
+		resp, err := th.Client.DoAPIGet("/channels/"+th.BasicChannel.Id+"?fields=name", "")
+		require.NoError(t, err)
+		defer closeBody(resp)
+
+		var channel map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&channel))
+		assert.Equal(t, map[string]any{"id": th.BasicChannel.Id, "name": th.BasicChannel.Name}, channel)
+	})
+
+	t.Run("posts", func(t *testing.T) {
+		resp, err := th.Client.DoAPIGet("/channels/"+th.BasicChannel.Id+"/posts?fields=message", "")
+		require.NoError(t, err)
+		defer closeBody(resp)
+
+		var list struct {
+			Order []string                  `json:"order"`
+			Posts map[string]map[string]any `json:"posts"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+		require.Contains(t, list.Posts, th.BasicPost.Id)
+		assert.Equal(t, map[string]any{"id": th.BasicPost.Id, "message": th.BasicPost.Message}, list.Posts[th.BasicPost.Id])
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		resp, err := th.Client.DoAPIGet("/users/"+th.BasicUser.Id+"?fields=user-name", "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, model.BuildResponse(resp))
+	})
+}
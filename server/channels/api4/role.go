@@ -9,6 +9,7 @@ import (
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/channels/utils"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
 )
 
@@ -24,6 +25,11 @@ func (api *API) InitRole() {
 	api.BaseRoutes.Roles.Handle("/name/{role_name:[a-z0-9_]+}", api.APISessionRequiredTrustRequester(getRoleByName)).Methods("GET")
 	api.BaseRoutes.Roles.Handle("/names", api.APISessionRequiredTrustRequester(getRolesByNames)).Methods("POST")
 	api.BaseRoutes.Roles.Handle("/{role_id:[A-Za-z0-9]+}/patch", api.APISessionRequired(patchRole)).Methods("PUT")
+	api.BaseRoutes.Roles.Handle("/{role_id:[A-Za-z0-9]+}/snapshots", api.APISessionRequired(getRoleSnapshots)).Methods("GET")
+	api.BaseRoutes.Roles.Handle("/{role_id:[A-Za-z0-9]+}/snapshots/{snapshot_id:[A-Za-z0-9]+}/revert", api.APISessionRequired(revertRoleToSnapshot)).Methods("POST")
+	api.BaseRoutes.Roles.Handle("/custom_admin", api.APISessionRequired(getCustomAdminRoles)).Methods("GET")
+	api.BaseRoutes.Roles.Handle("/custom_admin", api.APISessionRequired(createCustomAdminRole)).Methods("POST")
+	api.BaseRoutes.Roles.Handle("/custom_admin/{role_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteCustomAdminRole)).Methods("DELETE")
 }
 
 func getAllRoles(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -134,6 +140,82 @@ func patchRole(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.AddEventPriorState(oldRole)
 	auditRec.AddEventObjectType("role")
 
+	role := applyRolePatch(c, auditRec, oldRole, &patch)
+	if c.Err != nil {
+		return
+	}
+
+	auditRec.AddEventResultState(role)
+	auditRec.Success()
+	c.LogAudit("")
+
+	if err := json.NewEncoder(w).Encode(role); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getRoleSnapshots(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireRoleId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementPermissions) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementPermissions)
+		return
+	}
+
+	snapshots, appErr := c.App.GetRoleSnapshots(c.Params.RoleId, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(snapshots); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func revertRoleToSnapshot(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireRoleId().RequireSnapshotId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("revertRoleToSnapshot", audit.Fail)
+	audit.AddEventParameter(auditRec, "snapshot_id", c.Params.SnapshotId)
+	defer c.LogAuditRec(auditRec)
+
+	oldRole, appErr := c.App.GetRole(c.Params.RoleId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddEventPriorState(oldRole)
+	auditRec.AddEventObjectType("role")
+
+	snapshot, appErr := c.App.GetRoleSnapshot(oldRole.Id, c.Params.SnapshotId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	role := applyRolePatch(c, auditRec, oldRole, &model.RolePatch{Permissions: &snapshot.Permissions})
+	if c.Err != nil {
+		return
+	}
+
+	auditRec.AddEventResultState(role)
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(role); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// applyRolePatch checks the session is allowed to apply the patch to the role before patching it,
+// and records the permissions it adds and removes in the audit record.
+func applyRolePatch(c *Context, auditRec *audit.Record, oldRole *model.Role, patch *model.RolePatch) *model.Role {
 	// manage_system permission is required to patch system_admin
 	requiredPermission := model.PermissionSysconsoleWriteUserManagementPermissions
 	specialProtectedSystemRoles := append(model.NewSystemRoleIDs, model.SystemAdminRoleId)
@@ -142,22 +224,26 @@ func patchRole(c *Context, w http.ResponseWriter, r *http.Request) {
 			requiredPermission = model.PermissionManageSystem
 		}
 	}
+	// as well as to patch the custom admin roles
+	if oldRole.IsCustomAdminRole() {
+		requiredPermission = model.PermissionManageSystem
+	}
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), requiredPermission) {
 		c.SetPermissionError(requiredPermission)
-		return
+		return nil
 	}
 
 	isGuest := oldRole.Name == model.SystemGuestRoleId || oldRole.Name == model.TeamGuestRoleId || oldRole.Name == model.ChannelGuestRoleId
 	if c.App.Channels().License() == nil && patch.Permissions != nil {
 		if isGuest {
 			c.Err = model.NewAppError("Api4.PatchRoles", "api.roles.patch_roles.license.error", nil, "", http.StatusNotImplemented)
-			return
+			return nil
 		}
 	}
 
 	// Licensed instances can not change permissions in the blacklist set.
 	if patch.Permissions != nil {
-		deltaPermissions := model.PermissionsChangedByPatch(oldRole, &patch)
+		deltaPermissions := model.PermissionsChangedByPatch(oldRole, patch)
 
 		for _, permission := range deltaPermissions {
 			notAllowed := false
@@ -166,10 +252,13 @@ func patchRole(c *Context, w http.ResponseWriter, r *http.Request) {
 					notAllowed = true
 				}
 			}
+			if oldRole.IsCustomAdminRole() && permission == model.PermissionManageSystem.Id {
+				notAllowed = true
+			}
 
 			if notAllowed {
 				c.Err = model.NewAppError("Api4.PatchRoles", "api.roles.patch_roles.not_allowed_permission.error", nil, "Cannot add or remove permission: "+permission, http.StatusNotImplemented)
-				return
+				return nil
 			}
 		}
 
@@ -178,7 +267,7 @@ func patchRole(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	if c.App.Channels().License() != nil && isGuest && !*c.App.Channels().License().Features.GuestAccountsPermissions {
 		c.Err = model.NewAppError("Api4.PatchRoles", "api.roles.patch_roles.license.error", nil, "", http.StatusNotImplemented)
-		return
+		return nil
 	}
 
 	if oldRole.Name == model.TeamAdminRoleId ||
@@ -195,26 +284,110 @@ func patchRole(c *Context, w http.ResponseWriter, r *http.Request) {
 		oldRole.Name == model.RunMemberRoleId {
 		if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementPermissions) {
 			c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementPermissions)
-			return
+			return nil
 		}
 	} else {
 		if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementSystemRoles) {
 			c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementSystemRoles)
+			return nil
+		}
+	}
+
+	previousPermissions := oldRole.Permissions
+	role, appErr := c.App.PatchRole(oldRole, patch)
+	if appErr != nil {
+		c.Err = appErr
+		return nil
+	}
+
+	added, removed := model.PermissionsDiff(previousPermissions, role.Permissions)
+	audit.AddEventParameter(auditRec, "permissions_added", added)
+	audit.AddEventParameter(auditRec, "permissions_removed", removed)
+
+	return role
+}
+
+func getCustomAdminRoles(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	roles, appErr := c.App.GetCustomAdminRoles()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(roles); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func createCustomAdminRole(c *Context, w http.ResponseWriter, r *http.Request) {
+	var customRole model.CustomAdminRole
+	if err := json.NewDecoder(r.Body).Decode(&customRole); err != nil {
+		c.SetInvalidParamWithErr("role", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createCustomAdminRole", audit.Fail)
+	audit.AddEventParameterAuditable(auditRec, "role", &customRole)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	// Custom admin roles can't be used to hand out full system admin rights nor to manage roles.
+	for _, permission := range customRole.Permissions {
+		if permission == model.PermissionManageSystem.Id || utils.StringInSlice(permission, notAllowedPermissions) {
+			c.Err = model.NewAppError("createCustomAdminRole", "api.roles.patch_roles.not_allowed_permission.error", nil, "Cannot add permission: "+permission, http.StatusBadRequest)
 			return
 		}
 	}
 
-	role, appErr := c.App.PatchRole(oldRole, &patch)
+	role, appErr := c.App.CreateCustomAdminRole(&customRole)
 	if appErr != nil {
 		c.Err = appErr
 		return
 	}
 
 	auditRec.AddEventResultState(role)
+	auditRec.AddEventObjectType("role")
 	auditRec.Success()
-	c.LogAudit("")
 
+	w.WriteHeader(http.StatusCreated)
 	if err := json.NewEncoder(w).Encode(role); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
 }
+
+func deleteCustomAdminRole(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireRoleId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteCustomAdminRole", audit.Fail)
+	audit.AddEventParameter(auditRec, "role_id", c.Params.RoleId)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	role, appErr := c.App.DeleteCustomAdminRole(c.Params.RoleId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventPriorState(role)
+	auditRec.AddEventObjectType("role")
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
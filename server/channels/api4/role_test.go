@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/utils"
 )
 
 func TestGetAllRoles(t *testing.T) {
@@ -318,3 +319,159 @@ func TestPatchRole(t *testing.T) {
 		})
 	})
 }
+
+func TestCustomAdminRoles(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	defaultRolePermissions := th.SaveDefaultRolePermissions()
+	defer th.RestoreDefaultRolePermissions(defaultRolePermissions)
+	th.RemovePermissionFromRole(model.PermissionManageIncomingWebhooks.Id, model.TeamUserRoleId)
+	th.RemovePermissionFromRole(model.PermissionManageIncomingWebhooks.Id, model.SystemUserRoleId)
+
+	otherTeam := th.CreateTeam()
+	customRole := &model.CustomAdminRole{
+		Name:         "basic_team_integrations_admin",
+		DisplayName:  "Basic team integrations admin",
+		Capabilities: []string{model.AdminRoleCapabilityIntegrations},
+		TeamIds:      []string{th.BasicTeam.Id},
+	}
+
+	t.Run("requires manage_system", func(t *testing.T) {
+		_, resp, err := th.Client.CreateCustomAdminRole(customRole)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetCustomAdminRoles()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("can't grant manage_system", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateCustomAdminRole(&model.CustomAdminRole{
+			Name:        "escalating_admin",
+			DisplayName: "Escalating admin",
+			Permissions: []string{model.PermissionManageSystem.Id},
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	role, resp, err := th.SystemAdminClient.CreateCustomAdminRole(customRole)
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, customRole.TeamIds, role.TeamIds)
+
+	roles, _, err := th.SystemAdminClient.GetCustomAdminRoles()
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	assert.Equal(t, role.Id, roles[0].Id)
+
+	_, err = th.SystemAdminClient.UpdateUserRoles(th.BasicUser2.Id, model.SystemUserRoleId+" "+role.Name)
+	require.NoError(t, err)
+	th.LoginBasic2()
+
+	t.Run("enforced in the scoped teams only", func(t *testing.T) {
+		_, _, err := th.Client.GetIncomingWebhooksForTeam(th.BasicTeam.Id, 0, 10, "")
+		require.NoError(t, err)
+
+		_, resp, err := th.Client.GetIncomingWebhooksForTeam(otherTeam.Id, 0, 10, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetIncomingWebhooks(0, 10, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("patch the teams", func(t *testing.T) {
+		_, resp, err := th.Client.PatchRole(role.Id, &model.RolePatch{TeamIds: &[]string{otherTeam.Id}})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, _, err = th.SystemAdminClient.PatchRole(role.Id, &model.RolePatch{TeamIds: &[]string{th.BasicTeam.Id, otherTeam.Id}})
+		require.NoError(t, err)
+
+		_, _, err = th.Client.GetIncomingWebhooksForTeam(otherTeam.Id, 0, 10, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		resp, err := th.Client.DeleteCustomAdminRole(role.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, err = th.SystemAdminClient.DeleteCustomAdminRole(role.Id)
+		require.NoError(t, err)
+
+		_, resp, err = th.Client.GetIncomingWebhooksForTeam(th.BasicTeam.Id, 0, 10, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		systemUserManager, appErr := th.App.GetRoleByName(context.Background(), model.SystemUserManagerRoleId)
+		require.Nil(t, appErr)
+		resp, err = th.SystemAdminClient.DeleteCustomAdminRole(systemUserManager.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
+func TestRoleSnapshots(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	defaultRolePermissions := th.SaveDefaultRolePermissions()
+	defer th.RestoreDefaultRolePermissions(defaultRolePermissions)
+
+	role, appErr := th.App.GetRoleByName(context.Background(), model.ChannelUserRoleId)
+	require.Nil(t, appErr)
+	originalPermissions := role.Permissions
+
+	patchedPermissions := append([]string{}, originalPermissions...)
+	patchedPermissions = append(patchedPermissions, model.PermissionManageChannelRoles.Id)
+	_, _, err := th.SystemAdminClient.PatchRole(role.Id, &model.RolePatch{Permissions: &patchedPermissions})
+	require.NoError(t, err)
+
+	t.Run("requires the permissions system console section", func(t *testing.T) {
+		_, resp, err := th.Client.GetRoleSnapshots(role.Id, 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	snapshots, _, err := th.SystemAdminClient.GetRoleSnapshots(role.Id, 0, 10)
+	require.NoError(t, err)
+	require.NotEmpty(t, snapshots)
+	assert.ElementsMatch(t, originalPermissions, snapshots[0].Permissions)
+
+	t.Run("revert requires the permission to patch the role", func(t *testing.T) {
+		_, resp, err := th.Client.RevertRoleToSnapshot(role.Id, snapshots[0].Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("snapshot of another role", func(t *testing.T) {
+		otherRole, appErr := th.App.GetRoleByName(context.Background(), model.ChannelAdminRoleId)
+		require.Nil(t, appErr)
+
+		_, resp, err := th.SystemAdminClient.RevertRoleToSnapshot(otherRole.Id, snapshots[0].Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("revert", func(t *testing.T) {
+		reverted, _, err := th.SystemAdminClient.RevertRoleToSnapshot(role.Id, snapshots[0].Id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, originalPermissions, reverted.Permissions)
+
+		// Reverting snapshots the permissions it replaced, so that it can be undone
+		snapshots, _, err := th.SystemAdminClient.GetRoleSnapshots(role.Id, 0, 10)
+		require.NoError(t, err)
+		found := false
+		for _, snapshot := range snapshots {
+			if utils.StringInSlice(model.PermissionManageChannelRoles.Id, snapshot.Permissions) {
+				found = true
+			}
+		}
+		assert.True(t, found)
+	})
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitSavedPostLabel() {
+	api.BaseRoutes.User.Handle("/saved_post_labels", api.APISessionRequired(getSavedPostLabels)).Methods("GET")
+	api.BaseRoutes.User.Handle("/saved_post_labels", api.APISessionRequired(createSavedPostLabel)).Methods("POST")
+	api.BaseRoutes.User.Handle("/saved_post_labels/{label_id:[A-Za-z0-9]+}", api.APISessionRequired(updateSavedPostLabel)).Methods("PUT")
+	api.BaseRoutes.User.Handle("/saved_post_labels/{label_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteSavedPostLabel)).Methods("DELETE")
+
+	api.BaseRoutes.PostForUser.Handle("/saved_post_labels/{label_id:[A-Za-z0-9]+}", api.APISessionRequired(labelSavedPost)).Methods("POST")
+	api.BaseRoutes.PostForUser.Handle("/saved_post_labels/{label_id:[A-Za-z0-9]+}", api.APISessionRequired(unlabelSavedPost)).Methods("DELETE")
+}
+
+func getSavedPostLabels(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	labels, err := c.App.GetSavedPostLabels(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(labels); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func createSavedPostLabel(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var label model.SavedPostLabel
+	if jsonErr := json.NewDecoder(r.Body).Decode(&label); jsonErr != nil {
+		c.SetInvalidParamWithErr("saved_post_label", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createSavedPostLabel", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	saved, err := c.App.CreateSavedPostLabel(c.Params.UserId, &label)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	audit.AddEventParameter(auditRec, "label_id", saved.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateSavedPostLabel(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireLabelId()
+	if c.Err != nil {
+		return
+	}
+
+	var label model.SavedPostLabel
+	if jsonErr := json.NewDecoder(r.Body).Decode(&label); jsonErr != nil {
+		c.SetInvalidParamWithErr("saved_post_label", jsonErr)
+		return
+	}
+
+	if label.Id != c.Params.LabelId {
+		c.SetInvalidParam("id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateSavedPostLabel", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "label_id", c.Params.LabelId)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	updated, err := c.App.UpdateSavedPostLabel(c.Params.UserId, &label)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteSavedPostLabel(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireLabelId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteSavedPostLabel", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "label_id", c.Params.LabelId)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if err := c.App.DeleteSavedPostLabel(c.Params.UserId, c.Params.LabelId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func labelSavedPost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequirePostId().RequireLabelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), c.Params.PostId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	if err := c.App.LabelSavedPost(c.Params.UserId, c.Params.PostId, c.Params.LabelId); err != nil {
+		c.Err = err
+		return
+	}
+
+	ReturnStatusOK(w)
+}
+
+func unlabelSavedPost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequirePostId().RequireLabelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if err := c.App.UnlabelSavedPost(c.Params.UserId, c.Params.PostId, c.Params.LabelId); err != nil {
+		c.Err = err
+		return
+	}
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestSavedPostLabels(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	client := th.Client
+	userId := th.BasicUser.Id
+
+	label, resp, err := client.CreateSavedPostLabel(userId, &model.SavedPostLabel{Name: "Follow up"})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	require.True(t, model.IsValidId(label.Id))
+
+	t.Run("duplicate name", func(t *testing.T) {
+		_, resp, err := client.CreateSavedPostLabel(userId, &model.SavedPostLabel{Name: "follow UP"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, resp, err := client.GetSavedPostLabels(th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		label.Name = "Read later"
+		updated, _, err := client.UpdateSavedPostLabel(userId, label)
+		require.NoError(t, err)
+		assert.Equal(t, "Read later", updated.Name)
+
+		labels, _, err := client.GetSavedPostLabels(userId)
+		require.NoError(t, err)
+		require.Len(t, labels, 1)
+		assert.Equal(t, "Read later", labels[0].Name)
+	})
+
+	labeled := th.CreatePost()
+	other := th.CreatePost()
+
+	t.Run("label and list", func(t *testing.T) {
+		_, err := client.LabelSavedPost(userId, labeled.Id, label.Id)
+		require.NoError(t, err)
+		_, err = client.UpdatePreferences(userId, model.Preferences{{UserId: userId, Category: model.PreferenceCategoryFlaggedPost, Name: other.Id, Value: "true"}})
+		require.NoError(t, err)
+
+		list, _, err := client.GetFlaggedPostsForUser(userId, 0, 10)
+		require.NoError(t, err)
+		assert.Len(t, list.Order, 2, "labeling a post saves it")
+
+		list, _, err = client.GetFlaggedPostsForUserWithLabel(userId, label.Id, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{labeled.Id}, list.Order)
+	})
+
+	t.Run("post in a channel the user can't read", func(t *testing.T) {
+		privatePost := th.CreatePostWithClient(th.SystemAdminClient, th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate))
+		resp, err := client.LabelSavedPost(userId, privatePost.Id, label.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("unlabel", func(t *testing.T) {
+		_, err := client.UnlabelSavedPost(userId, labeled.Id, label.Id)
+		require.NoError(t, err)
+
+		list, _, err := client.GetFlaggedPostsForUserWithLabel(userId, label.Id, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, list.Order)
+
+		list, _, err = client.GetFlaggedPostsForUser(userId, 0, 10)
+		require.NoError(t, err)
+		assert.Len(t, list.Order, 2, "the post stays saved")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := client.LabelSavedPost(userId, labeled.Id, label.Id)
+		require.NoError(t, err)
+
+		_, err = client.DeleteSavedPostLabel(userId, label.Id)
+		require.NoError(t, err)
+
+		labels, _, err := client.GetSavedPostLabels(userId)
+		require.NoError(t, err)
+		assert.Empty(t, labels)
+
+		_, err = th.App.GetPreferenceByCategoryAndNameForUser(userId, model.PreferenceCategorySavedPostLabels, labeled.Id)
+		assert.NotNil(t, err, "the label should be removed from the post")
+
+		_, resp, err := client.GetFlaggedPostsForUserWithLabel(userId, label.Id, 0, 10)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitScheduledPost() {
+	api.BaseRoutes.Posts.Handle("/schedule", api.APISessionRequired(createScheduledPost)).Methods("POST")
+	api.BaseRoutes.Posts.Handle("/schedule/{scheduled_post_id:[A-Za-z0-9]+}", api.APISessionRequired(updateScheduledPost)).Methods("PUT")
+	api.BaseRoutes.Posts.Handle("/schedule/{scheduled_post_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteScheduledPost)).Methods("DELETE")
+
+	api.BaseRoutes.PostsForUser.Handle("/scheduled", api.APISessionRequired(getScheduledPostsForUser)).Methods("GET")
+}
+
+func createScheduledPost(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !*c.App.Config().ServiceSettings.EnableScheduledPosts {
+		c.Err = model.NewAppError("createScheduledPost", "api.scheduled_post.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	var scheduledPost model.ScheduledPost
+	if jsonErr := json.NewDecoder(r.Body).Decode(&scheduledPost); jsonErr != nil {
+		c.SetInvalidParamWithErr("scheduled_post", jsonErr)
+		return
+	}
+
+	scheduledPost.UserId = c.AppContext.Session().UserId
+
+	auditRec := c.MakeAuditRecord("createScheduledPost", audit.Fail)
+	defer c.LogAuditRecWithLevel(auditRec, app.LevelContent)
+	audit.AddEventParameter(auditRec, "channel_id", scheduledPost.ChannelId)
+	audit.AddEventParameter(auditRec, "scheduled_at", scheduledPost.ScheduledAt)
+
+	// The post is only delivered if its author is still a member of the channel by then,
+	// so there is no point in scheduling posts to channels the user isn't a member of.
+	if _, err := c.App.GetChannelMember(c.AppContext, scheduledPost.ChannelId, scheduledPost.UserId); err != nil ||
+		!c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), scheduledPost.ChannelId, model.PermissionCreatePost) {
+		c.SetPermissionError(model.PermissionCreatePost)
+		return
+	}
+
+	saved, err := c.App.CreateScheduledPost(c.AppContext, &scheduledPost, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(saved)
+	auditRec.AddEventObjectType("scheduled_post")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getScheduledPostsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	scheduledPosts, err := c.App.GetScheduledPostsForUser(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(scheduledPosts); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateScheduledPost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireScheduledPostId()
+	if c.Err != nil {
+		return
+	}
+
+	var scheduledPost model.ScheduledPost
+	if jsonErr := json.NewDecoder(r.Body).Decode(&scheduledPost); jsonErr != nil {
+		c.SetInvalidParamWithErr("scheduled_post", jsonErr)
+		return
+	}
+
+	if scheduledPost.Id != c.Params.ScheduledPostId {
+		c.SetInvalidParam("id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateScheduledPost", audit.Fail)
+	defer c.LogAuditRecWithLevel(auditRec, app.LevelContent)
+	audit.AddEventParameter(auditRec, "scheduled_post_id", c.Params.ScheduledPostId)
+
+	existing, err := c.App.GetScheduledPost(c.Params.ScheduledPostId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddEventPriorState(existing)
+
+	if existing.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditPost)
+		return
+	}
+
+	updated, err := c.App.UpdateScheduledPost(c.AppContext, &scheduledPost, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(updated)
+	auditRec.AddEventObjectType("scheduled_post")
+
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteScheduledPost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireScheduledPostId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteScheduledPost", audit.Fail)
+	defer c.LogAuditRecWithLevel(auditRec, app.LevelContent)
+	audit.AddEventParameter(auditRec, "scheduled_post_id", c.Params.ScheduledPostId)
+
+	existing, err := c.App.GetScheduledPost(c.Params.ScheduledPostId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddEventPriorState(existing)
+
+	if existing.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionDeletePost)
+		return
+	}
+
+	if _, err := c.App.DeleteScheduledPost(c.Params.ScheduledPostId, r.Header.Get(model.ConnectionId)); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventObjectType("scheduled_post")
+
+	ReturnStatusOK(w)
+}
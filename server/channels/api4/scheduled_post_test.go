@@ -0,0 +1,131 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestCreateScheduledPost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	client := th.Client
+
+	t.Run("success", func(t *testing.T) {
+		scheduledPost, resp, err := client.CreateScheduledPost(&model.ScheduledPost{
+			ChannelId:   th.BasicChannel.Id,
+			Message:     "scheduled",
+			ScheduledAt: model.GetMillis() + 60000,
+		})
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, th.BasicUser.Id, scheduledPost.UserId)
+		assert.True(t, scheduledPost.IsPending())
+	})
+
+	t.Run("in the past", func(t *testing.T) {
+		_, resp, err := client.CreateScheduledPost(&model.ScheduledPost{
+			ChannelId:   th.BasicChannel.Id,
+			Message:     "scheduled",
+			ScheduledAt: model.GetMillis() - 60000,
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("not a channel member", func(t *testing.T) {
+		channel := th.CreatePublicChannel()
+		th.RemoveUserFromChannel(th.BasicUser, channel)
+
+		_, resp, err := client.CreateScheduledPost(&model.ScheduledPost{
+			ChannelId:   channel.Id,
+			Message:     "scheduled",
+			ScheduledAt: model.GetMillis() + 60000,
+		})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableScheduledPosts = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableScheduledPosts = true })
+
+		_, resp, err := client.CreateScheduledPost(&model.ScheduledPost{
+			ChannelId:   th.BasicChannel.Id,
+			Message:     "scheduled",
+			ScheduledAt: model.GetMillis() + 60000,
+		})
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
+
+func TestScheduledPostsForUser(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	client := th.Client
+
+	scheduledPost, _, err := client.CreateScheduledPost(&model.ScheduledPost{
+		ChannelId:   th.BasicChannel.Id,
+		Message:     "scheduled",
+		ScheduledAt: model.GetMillis() + 60000,
+	})
+	require.NoError(t, err)
+
+	t.Run("get", func(t *testing.T) {
+		scheduledPosts, _, err := client.GetScheduledPosts(th.BasicUser.Id)
+		require.NoError(t, err)
+		require.Len(t, scheduledPosts, 1)
+		assert.Equal(t, scheduledPost.Id, scheduledPosts[0].Id)
+	})
+
+	t.Run("get for another user", func(t *testing.T) {
+		_, resp, err := client.GetScheduledPosts(th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		scheduledPost.Message = "rescheduled"
+		scheduledPost.ScheduledAt += 60000
+
+		updated, _, err := client.UpdateScheduledPost(scheduledPost)
+		require.NoError(t, err)
+		assert.Equal(t, "rescheduled", updated.Message)
+		assert.Equal(t, scheduledPost.ScheduledAt, updated.ScheduledAt)
+	})
+
+	t.Run("update by another user", func(t *testing.T) {
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err := th.Client.UpdateScheduledPost(scheduledPost)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.DeleteScheduledPost(scheduledPost.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := client.DeleteScheduledPost(scheduledPost.Id)
+		require.NoError(t, err)
+
+		scheduledPosts, _, err := client.GetScheduledPosts(th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Empty(t, scheduledPosts)
+
+		resp, err := client.DeleteScheduledPost(scheduledPost.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -0,0 +1,530 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const scimContentType = "application/scim+json"
+
+func (api *API) InitSCIM() {
+	api.reloadSCIMRateLimiter(api.srv.Config())
+	api.srv.Platform().AddConfigListener(func(oldCfg, newCfg *model.Config) {
+		if *oldCfg.SCIMSettings.RateLimitPerSec != *newCfg.SCIMSettings.RateLimitPerSec ||
+			*oldCfg.SCIMSettings.RateLimitMaxBurst != *newCfg.SCIMSettings.RateLimitMaxBurst ||
+			!reflect.DeepEqual(oldCfg.ServiceSettings.TrustedProxyIPHeader, newCfg.ServiceSettings.TrustedProxyIPHeader) {
+			api.reloadSCIMRateLimiter(newCfg)
+		}
+	})
+
+	manageUsers := model.PermissionSysconsoleWriteUserManagementUsers
+	manageGroups := model.PermissionSysconsoleWriteUserManagementGroups
+
+	api.BaseRoutes.SCIM.Handle("/ServiceProviderConfig", api.APISessionRequired(api.scimHandler(manageUsers, getSCIMServiceProviderConfig))).Methods("GET")
+
+	api.BaseRoutes.SCIM.Handle("/Users", api.APISessionRequired(api.scimHandler(manageUsers, getSCIMUsers))).Methods("GET")
+	api.BaseRoutes.SCIM.Handle("/Users", api.APISessionRequired(api.scimHandler(manageUsers, createSCIMUser))).Methods("POST")
+	api.BaseRoutes.SCIM.Handle("/Users/{user_id:[A-Za-z0-9]+}", api.APISessionRequired(api.scimHandler(manageUsers, getSCIMUser))).Methods("GET")
+	api.BaseRoutes.SCIM.Handle("/Users/{user_id:[A-Za-z0-9]+}", api.APISessionRequired(api.scimHandler(manageUsers, replaceSCIMUser))).Methods("PUT")
+	api.BaseRoutes.SCIM.Handle("/Users/{user_id:[A-Za-z0-9]+}", api.APISessionRequired(api.scimHandler(manageUsers, patchSCIMUser))).Methods("PATCH")
+	api.BaseRoutes.SCIM.Handle("/Users/{user_id:[A-Za-z0-9]+}", api.APISessionRequired(api.scimHandler(manageUsers, deleteSCIMUser))).Methods("DELETE")
+
+	api.BaseRoutes.SCIM.Handle("/Groups", api.APISessionRequired(api.scimHandler(manageGroups, getSCIMGroups))).Methods("GET")
+	api.BaseRoutes.SCIM.Handle("/Groups", api.APISessionRequired(api.scimHandler(manageGroups, createSCIMGroup))).Methods("POST")
+	api.BaseRoutes.SCIM.Handle("/Groups/{group_id:[A-Za-z0-9]+}", api.APISessionRequired(api.scimHandler(manageGroups, getSCIMGroup))).Methods("GET")
+	api.BaseRoutes.SCIM.Handle("/Groups/{group_id:[A-Za-z0-9]+}", api.APISessionRequired(api.scimHandler(manageGroups, replaceSCIMGroup))).Methods("PUT")
+	api.BaseRoutes.SCIM.Handle("/Groups/{group_id:[A-Za-z0-9]+}", api.APISessionRequired(api.scimHandler(manageGroups, patchSCIMGroup))).Methods("PATCH")
+	api.BaseRoutes.SCIM.Handle("/Groups/{group_id:[A-Za-z0-9]+}", api.APISessionRequired(api.scimHandler(manageGroups, deleteSCIMGroup))).Methods("DELETE")
+}
+
+// reloadSCIMRateLimiter replaces the SCIM rate limiter with one of the settings of the config. The
+// rate limits start over.
+func (api *API) reloadSCIMRateLimiter(cfg *model.Config) {
+	rateLimiter, err := app.NewRateLimiter(&model.RateLimitSettings{
+		PerSec:           cfg.SCIMSettings.RateLimitPerSec,
+		MaxBurst:         cfg.SCIMSettings.RateLimitMaxBurst,
+		MemoryStoreSize:  model.NewInt(10000),
+		VaryByUser:       model.NewBool(true),
+		VaryByRemoteAddr: model.NewBool(false),
+	}, cfg.ServiceSettings.TrustedProxyIPHeader)
+	if err != nil {
+		mlog.Warn("Unable to create the SCIM rate limiter, SCIM requests will not be rate limited", mlog.Err(err))
+	}
+	api.scimRateLimiter.Store(rateLimiter)
+}
+
+// getSCIMRateLimiter returns the rate limiter of the SCIM requests, nil when it couldn't be created.
+func (api *API) getSCIMRateLimiter() *app.RateLimiter {
+	rateLimiter, _ := api.scimRateLimiter.Load().(*app.RateLimiter)
+	return rateLimiter
+}
+
+// scimHandler wraps a SCIM handler to check that SCIM is enabled and that the session has the
+// given permission, to rate limit the identity provider and to report errors as SCIM errors.
+func (api *API) scimHandler(permission *model.Permission, h handlerFunc) handlerFunc {
+	return func(c *Context, w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if c.Err != nil {
+				writeSCIMError(c, w)
+			}
+		}()
+
+		if !*c.App.Config().SCIMSettings.Enable {
+			c.Err = model.NewAppError("scimHandler", "api.scim.disabled.app_error", nil, "", http.StatusNotFound)
+			return
+		}
+
+		if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), permission) {
+			c.SetPermissionError(permission)
+			return
+		}
+
+		if rateLimiter := api.getSCIMRateLimiter(); rateLimiter != nil && rateLimiter.UserIdRateLimit(c.AppContext.Session().UserId, w) {
+			return
+		}
+
+		h(c, w, r)
+	}
+}
+
+// writeSCIMError writes the error of the request in the format expected by identity providers.
+// The error is logged here since it is cleared to keep it from being written again.
+func writeSCIMError(c *Context, w http.ResponseWriter) {
+	appErr := c.Err
+	c.Err = nil
+
+	appErr.RequestId = c.AppContext.RequestId()
+	c.LogErrorByCode(appErr)
+	appErr.Translate(c.AppContext.T)
+
+	scimType := ""
+	switch {
+	case appErr.StatusCode == http.StatusConflict:
+		scimType = model.SCIMErrorTypeUniqueness
+	case appErr.Id == "model.scim.filter.app_error":
+		scimType = model.SCIMErrorTypeInvalidFilter
+	case appErr.Id == "model.scim.patch.path.app_error":
+		scimType = model.SCIMErrorTypeInvalidPath
+	case appErr.StatusCode == http.StatusBadRequest:
+		scimType = model.SCIMErrorTypeInvalidValue
+	}
+
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(appErr.StatusCode)
+	if err := json.NewEncoder(w).Encode(model.NewSCIMError(appErr.StatusCode, scimType, appErr.Message)); err != nil {
+		c.Logger.Warn("Error while writing SCIM error", mlog.Err(err))
+	}
+}
+
+func writeSCIMResponse(c *Context, w http.ResponseWriter, status int, resource any) {
+	js, err := json.Marshal(resource)
+	if err != nil {
+		c.Err = model.NewAppError("writeSCIMResponse", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	w.Write(js)
+}
+
+// scimListParams returns the filter, the 1-based start index and the count requested to list
+// SCIM resources.
+func scimListParams(c *Context, r *http.Request) (*model.SCIMFilter, int, int) {
+	query := r.URL.Query()
+
+	filter, appErr := model.ParseSCIMFilter(query.Get("filter"))
+	if appErr != nil {
+		c.Err = appErr
+		return nil, 0, 0
+	}
+
+	startIndex := 1
+	if value := query.Get("startIndex"); value != "" {
+		if i, err := strconv.Atoi(value); err == nil && i > 1 {
+			startIndex = i
+		}
+	}
+
+	count := model.SCIMDefaultCount
+	if value := query.Get("count"); value != "" {
+		if i, err := strconv.Atoi(value); err == nil && i >= 0 {
+			count = i
+		}
+	}
+	if count > model.SCIMMaxCount {
+		count = model.SCIMMaxCount
+	}
+
+	return filter, startIndex, count
+}
+
+func scimUserResource(c *Context, user *model.User) *model.SCIMUser {
+	scimUser := model.SCIMUserFromUser(user)
+	scimUser.Meta.Location = c.GetSiteURLHeader() + model.APIURLSuffix + "/scim/v2/Users/" + user.Id
+	return scimUser
+}
+
+func scimGroupResource(c *Context, group *model.Group, members []*model.User) *model.SCIMGroup {
+	scimGroup := model.SCIMGroupFromGroup(group, members)
+	scimGroup.Meta.Location = c.GetSiteURLHeader() + model.APIURLSuffix + "/scim/v2/Groups/" + group.Id
+	return scimGroup
+}
+
+func getSCIMServiceProviderConfig(c *Context, w http.ResponseWriter, r *http.Request) {
+	writeSCIMResponse(c, w, http.StatusOK, model.NewSCIMServiceProviderConfig())
+}
+
+func getSCIMUsers(c *Context, w http.ResponseWriter, r *http.Request) {
+	filter, startIndex, count := scimListParams(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	users, total, appErr := c.App.GetSCIMUsers(filter, startIndex, count)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	resources := make([]any, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, scimUserResource(c, user))
+	}
+
+	writeSCIMResponse(c, w, http.StatusOK, model.NewSCIMListResponse(resources, total, startIndex))
+}
+
+func getSCIMUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	user, appErr := c.App.GetSCIMUser(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	writeSCIMResponse(c, w, http.StatusOK, scimUserResource(c, user))
+}
+
+func createSCIMUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	var scimUser model.SCIMUser
+	if err := json.NewDecoder(r.Body).Decode(&scimUser); err != nil {
+		c.SetInvalidParamWithErr("user", err)
+		return
+	}
+	if scimUser.UserName == "" {
+		c.SetInvalidParam("userName")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createSCIMUser", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_name", scimUser.UserName)
+	audit.AddEventParameter(auditRec, "external_id", scimUser.ExternalId)
+
+	user, appErr := c.App.CreateSCIMUser(c.AppContext, &scimUser)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(user)
+	auditRec.AddEventObjectType("user")
+
+	writeSCIMResponse(c, w, http.StatusCreated, scimUserResource(c, user))
+}
+
+func replaceSCIMUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var scimUser model.SCIMUser
+	if err := json.NewDecoder(r.Body).Decode(&scimUser); err != nil {
+		c.SetInvalidParamWithErr("user", err)
+		return
+	}
+	if scimUser.UserName == "" {
+		c.SetInvalidParam("userName")
+		return
+	}
+
+	updateSCIMUser(c, w, "replaceSCIMUser", func(*model.SCIMUser) (*model.SCIMUser, *model.AppError) {
+		return &scimUser, nil
+	})
+}
+
+func patchSCIMUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var patch model.SCIMPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		c.SetInvalidParamWithErr("patch", err)
+		return
+	}
+
+	updateSCIMUser(c, w, "patchSCIMUser", func(scimUser *model.SCIMUser) (*model.SCIMUser, *model.AppError) {
+		return scimUser, scimUser.ApplyPatch(patch.Operations)
+	})
+}
+
+// updateSCIMUser updates the user of the request to the SCIM user returned by the given function
+// from the current one.
+func updateSCIMUser(c *Context, w http.ResponseWriter, event string, update func(*model.SCIMUser) (*model.SCIMUser, *model.AppError)) {
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+
+	user, appErr := c.App.GetSCIMUser(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddEventPriorState(user)
+
+	// Cannot update a system admin unless user making request is a systemadmin also
+	if user.IsSystemAdmin() && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	scimUser, appErr := update(model.SCIMUserFromUser(user))
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	updated, appErr := c.App.UpdateSCIMUser(c.AppContext, user, scimUser)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(updated)
+	auditRec.AddEventObjectType("user")
+
+	writeSCIMResponse(c, w, http.StatusOK, scimUserResource(c, updated))
+}
+
+func deleteSCIMUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteSCIMUser", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+
+	user, appErr := c.App.GetSCIMUser(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddEventPriorState(user)
+
+	// Cannot update a system admin unless user making request is a systemadmin also
+	if user.IsSystemAdmin() && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	// Users are deactivated rather than deleted, so that their content is kept.
+	if user.DeleteAt == 0 {
+		if _, appErr = c.App.UpdateActive(c.AppContext, user, false); appErr != nil {
+			c.Err = appErr
+			return
+		}
+	}
+
+	auditRec.Success()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func getSCIMGroups(c *Context, w http.ResponseWriter, r *http.Request) {
+	filter, startIndex, count := scimListParams(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	groups, total, appErr := c.App.GetSCIMGroups(filter, startIndex, count)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	resources := make([]any, 0, len(groups))
+	for _, group := range groups {
+		members, appErr := c.App.GetGroupMemberUsers(group.Id)
+		if appErr != nil {
+			c.Err = appErr
+			return
+		}
+		resources = append(resources, scimGroupResource(c, group, members))
+	}
+
+	writeSCIMResponse(c, w, http.StatusOK, model.NewSCIMListResponse(resources, total, startIndex))
+}
+
+func getSCIMGroup(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireGroupId()
+	if c.Err != nil {
+		return
+	}
+
+	group, members, appErr := c.App.GetSCIMGroup(c.Params.GroupId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	writeSCIMResponse(c, w, http.StatusOK, scimGroupResource(c, group, members))
+}
+
+func createSCIMGroup(c *Context, w http.ResponseWriter, r *http.Request) {
+	var scimGroup model.SCIMGroup
+	if err := json.NewDecoder(r.Body).Decode(&scimGroup); err != nil {
+		c.SetInvalidParamWithErr("group", err)
+		return
+	}
+	if scimGroup.DisplayName == "" {
+		c.SetInvalidParam("displayName")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createSCIMGroup", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "display_name", scimGroup.DisplayName)
+	audit.AddEventParameter(auditRec, "external_id", scimGroup.ExternalId)
+	audit.AddEventParameter(auditRec, "user_ids", scimGroup.MemberIDs())
+
+	group, members, appErr := c.App.CreateSCIMGroup(&scimGroup)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(group)
+	auditRec.AddEventObjectType("group")
+
+	writeSCIMResponse(c, w, http.StatusCreated, scimGroupResource(c, group, members))
+}
+
+func replaceSCIMGroup(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireGroupId()
+	if c.Err != nil {
+		return
+	}
+
+	var scimGroup model.SCIMGroup
+	if err := json.NewDecoder(r.Body).Decode(&scimGroup); err != nil {
+		c.SetInvalidParamWithErr("group", err)
+		return
+	}
+	if scimGroup.DisplayName == "" {
+		c.SetInvalidParam("displayName")
+		return
+	}
+
+	updateSCIMGroup(c, w, "replaceSCIMGroup", func(*model.SCIMGroup) (*model.SCIMGroup, *model.AppError) {
+		return &scimGroup, nil
+	})
+}
+
+func patchSCIMGroup(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireGroupId()
+	if c.Err != nil {
+		return
+	}
+
+	var patch model.SCIMPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		c.SetInvalidParamWithErr("patch", err)
+		return
+	}
+
+	updateSCIMGroup(c, w, "patchSCIMGroup", func(scimGroup *model.SCIMGroup) (*model.SCIMGroup, *model.AppError) {
+		return scimGroup, scimGroup.ApplyPatch(patch.Operations)
+	})
+}
+
+// updateSCIMGroup updates the group of the request to the SCIM group returned by the given
+// function from the current one.
+func updateSCIMGroup(c *Context, w http.ResponseWriter, event string, update func(*model.SCIMGroup) (*model.SCIMGroup, *model.AppError)) {
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "group_id", c.Params.GroupId)
+
+	group, members, appErr := c.App.GetSCIMGroup(c.Params.GroupId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddEventPriorState(group)
+
+	scimGroup, appErr := update(model.SCIMGroupFromGroup(group, members))
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	audit.AddEventParameter(auditRec, "user_ids", scimGroup.MemberIDs())
+
+	updated, members, appErr := c.App.UpdateSCIMGroup(group, members, scimGroup)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(updated)
+	auditRec.AddEventObjectType("group")
+
+	writeSCIMResponse(c, w, http.StatusOK, scimGroupResource(c, updated, members))
+}
+
+func deleteSCIMGroup(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireGroupId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteSCIMGroup", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "group_id", c.Params.GroupId)
+
+	group, _, appErr := c.App.GetSCIMGroup(c.Params.GroupId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddEventPriorState(group)
+
+	if _, appErr = c.App.DeleteGroup(group.Id); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	w.WriteHeader(http.StatusNoContent)
+}
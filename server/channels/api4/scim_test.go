@@ -0,0 +1,298 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func decodeSCIMResponse(t *testing.T, resp *http.Response, v any) {
+	t.Helper()
+	defer resp.Body.Close()
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+}
+
+func TestSCIMDisabled(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	resp, err := th.SystemAdminClient.DoAPIGet("/scim/v2/ServiceProviderConfig", "")
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestSCIMRateLimit(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	// The rate limiter follows the config changes made after the API is initialized.
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.SCIMSettings.Enable = true
+		*cfg.SCIMSettings.RateLimitPerSec = 1
+		*cfg.SCIMSettings.RateLimitMaxBurst = 1
+	})
+
+	var throttled bool
+	for i := 0; i < 5 && !throttled; i++ {
+		resp, _ := th.SystemAdminClient.DoAPIGet("/scim/v2/ServiceProviderConfig", "")
+		throttled = resp.StatusCode == http.StatusTooManyRequests
+	}
+	require.True(t, throttled)
+}
+
+func TestSCIMUsers(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.SCIMSettings.Enable = true })
+	client := th.SystemAdminClient
+
+	t.Run("requires permission", func(t *testing.T) {
+		resp, err := th.Client.DoAPIGet("/scim/v2/Users", "")
+		require.Error(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	var created model.SCIMUser
+	t.Run("create", func(t *testing.T) {
+		resp, err := client.DoAPIPost("/scim/v2/Users", `{
+			"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+			"userName": "jane.doe@example.com",
+			"externalId": "00u1",
+			"name": {"givenName": "Jane", "familyName": "Doe"},
+			"active": true
+		}`)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		decodeSCIMResponse(t, resp, &created)
+
+		assert.Equal(t, "jane.doe", created.UserName)
+		assert.Equal(t, "00u1", created.ExternalId)
+		assert.Equal(t, "jane.doe@example.com", created.PrimaryEmail())
+		assert.True(t, created.IsActive())
+
+		user, appErr := th.App.GetUser(created.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.UserAuthServiceSaml, user.AuthService)
+		assert.Equal(t, "Jane", user.FirstName)
+	})
+
+	t.Run("create existing user", func(t *testing.T) {
+		resp, err := client.DoAPIPost("/scim/v2/Users", `{"userName": "jane.doe@example.com", "externalId": "00u1"}`)
+		require.Error(t, err)
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("list with filter", func(t *testing.T) {
+		resp, err := client.DoAPIGet(`/scim/v2/Users?filter=userName+eq+%22jane.doe%40example.com%22`, "")
+		require.NoError(t, err)
+		var list model.SCIMListResponse
+		decodeSCIMResponse(t, resp, &list)
+		assert.Equal(t, 1, list.TotalResults)
+		assert.Equal(t, 1, list.StartIndex)
+
+		resp, err = client.DoAPIGet(`/scim/v2/Users?filter=externalId+eq+%22unknown%22`, "")
+		require.NoError(t, err)
+		decodeSCIMResponse(t, resp, &list)
+		assert.Equal(t, 0, list.TotalResults)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		resp, err := client.DoAPIGet(`/scim/v2/Users?filter=title+pr`, "")
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("get user not provisioned", func(t *testing.T) {
+		resp, err := client.DoAPIGet("/scim/v2/Users/"+th.BasicUser.Id, "")
+		require.Error(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("deactivate with patch", func(t *testing.T) {
+		resp, err := client.DoAPIPatchBytes("/scim/v2/Users/"+created.Id, []byte(`{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations": [{"op": "Replace", "path": "active", "value": "False"}]
+		}`))
+		require.NoError(t, err)
+		var patched model.SCIMUser
+		decodeSCIMResponse(t, resp, &patched)
+		assert.False(t, patched.IsActive())
+
+		user, appErr := th.App.GetUser(created.Id)
+		require.Nil(t, appErr)
+		assert.NotZero(t, user.DeleteAt)
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		resp, err := client.DoAPIPut("/scim/v2/Users/"+created.Id, `{
+			"userName": "jane.doe@example.com",
+			"externalId": "00u1",
+			"name": {"givenName": "Janet", "familyName": "Doe"},
+			"active": true
+		}`)
+		require.NoError(t, err)
+		var replaced model.SCIMUser
+		decodeSCIMResponse(t, resp, &replaced)
+		assert.True(t, replaced.IsActive())
+		assert.Equal(t, "Janet", replaced.Name.GivenName)
+	})
+
+	t.Run("delete deactivates", func(t *testing.T) {
+		resp, err := client.DoAPIDelete("/scim/v2/Users/" + created.Id)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		user, appErr := th.App.GetUser(created.Id)
+		require.Nil(t, appErr)
+		assert.NotZero(t, user.DeleteAt)
+	})
+}
+
+func TestSCIMUsersSystemAdmin(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.SCIMSettings.Enable = true })
+
+	resp, err := th.SystemAdminClient.DoAPIPost("/scim/v2/Users", `{"userName": "admin@example.com", "externalId": "00u2"}`)
+	require.NoError(t, err)
+	var created model.SCIMUser
+	decodeSCIMResponse(t, resp, &created)
+
+	_, appErr := th.App.UpdateUserRoles(th.Context, created.Id, model.SystemUserRoleId+" "+model.SystemAdminRoleId, false)
+	require.Nil(t, appErr)
+
+	th.AddPermissionToRole(model.PermissionSysconsoleWriteUserManagementUsers.Id, model.SystemUserRoleId)
+	defer th.RemovePermissionFromRole(model.PermissionSysconsoleWriteUserManagementUsers.Id, model.SystemUserRoleId)
+
+	t.Run("replace", func(t *testing.T) {
+		resp, err := th.Client.DoAPIPut("/scim/v2/Users/"+created.Id, `{"userName": "admin@example.com", "externalId": "attacker", "active": true}`)
+		require.Error(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("patch", func(t *testing.T) {
+		resp, err := th.Client.DoAPIPatchBytes("/scim/v2/Users/"+created.Id, []byte(`{
+			"Operations": [{"op": "Replace", "path": "emails[type eq \"work\"].value", "value": "attacker@example.com"}]
+		}`))
+		require.Error(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		resp, err := th.Client.DoAPIDelete("/scim/v2/Users/" + created.Id)
+		require.Error(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	user, appErr := th.App.GetUser(created.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, "00u2", *user.AuthData)
+	assert.Equal(t, "admin@example.com", user.Email)
+	assert.Zero(t, user.DeleteAt)
+
+	t.Run("system admin", func(t *testing.T) {
+		resp, err := th.SystemAdminClient.DoAPIDelete("/scim/v2/Users/" + created.Id)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+}
+
+func TestSCIMGroups(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.SCIMSettings.Enable = true })
+	client := th.SystemAdminClient
+
+	var created model.SCIMGroup
+	t.Run("create", func(t *testing.T) {
+		resp, err := client.DoAPIPost("/scim/v2/Groups", `{
+			"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"],
+			"displayName": "Engineering",
+			"externalId": "00g1",
+			"members": [{"value": "`+th.BasicUser.Id+`"}]
+		}`)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		decodeSCIMResponse(t, resp, &created)
+
+		assert.Equal(t, "Engineering", created.DisplayName)
+		assert.Equal(t, "00g1", created.ExternalId)
+		assert.Equal(t, []string{th.BasicUser.Id}, created.MemberIDs())
+
+		group, appErr := th.App.GetGroup(created.Id, nil, nil)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.GroupSourceSCIM, group.Source)
+	})
+
+	t.Run("create existing group", func(t *testing.T) {
+		resp, err := client.DoAPIPost("/scim/v2/Groups", `{"displayName": "Engineering", "externalId": "00g1"}`)
+		require.Error(t, err)
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("unknown member", func(t *testing.T) {
+		resp, err := client.DoAPIPost("/scim/v2/Groups", `{"displayName": "Sales", "members": [{"value": "`+model.NewId()+`"}]}`)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("patch members", func(t *testing.T) {
+		resp, err := client.DoAPIPatchBytes("/scim/v2/Groups/"+created.Id, []byte(`{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations": [
+				{"op": "add", "path": "members", "value": [{"value": "`+th.BasicUser2.Id+`"}]},
+				{"op": "remove", "path": "members[value eq \"`+th.BasicUser.Id+`\"]"},
+				{"op": "replace", "value": {"id": "`+created.Id+`", "displayName": "R&D"}}
+			]
+		}`))
+		require.NoError(t, err)
+		var patched model.SCIMGroup
+		decodeSCIMResponse(t, resp, &patched)
+		assert.Equal(t, "R&D", patched.DisplayName)
+		assert.Equal(t, []string{th.BasicUser2.Id}, patched.MemberIDs())
+
+		members, appErr := th.App.GetGroupMemberUsers(created.Id)
+		require.Nil(t, appErr)
+		require.Len(t, members, 1)
+		assert.Equal(t, th.BasicUser2.Id, members[0].Id)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := client.DoAPIGet(`/scim/v2/Groups?filter=displayName+eq+%22R%26D%22`, "")
+		require.NoError(t, err)
+		var list model.SCIMListResponse
+		decodeSCIMResponse(t, resp, &list)
+		assert.Equal(t, 1, list.TotalResults)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		resp, err := client.DoAPIDelete("/scim/v2/Groups/" + created.Id)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, err = client.DoAPIGet("/scim/v2/Groups/"+created.Id, "")
+		require.Error(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("create restores deleted group", func(t *testing.T) {
+		resp, err := client.DoAPIPost("/scim/v2/Groups", `{"displayName": "Engineering", "externalId": "00g1"}`)
+		require.NoError(t, err)
+		var restored model.SCIMGroup
+		decodeSCIMResponse(t, resp, &restored)
+		assert.Equal(t, created.Id, restored.Id)
+		assert.Equal(t, "Engineering", restored.DisplayName)
+		assert.Empty(t, restored.Members)
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitSearchEngine() {
+	api.BaseRoutes.APIRoot.Handle("/search_engines/stats", api.APISessionRequired(getSearchEngineQueryStats)).Methods("GET")
+}
+
+func getSearchEngineQueryStats(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadEnvironmentElasticsearch) {
+		c.SetPermissionError(model.PermissionSysconsoleReadEnvironmentElasticsearch)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(c.App.GetSearchEngineQueryStats()); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/searchengine/mocks"
+)
+
+func TestGetSearchEngineQueryStats(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	engine := &mocks.SearchEngineInterface{}
+	engine.On("GetName").Return("mock")
+	th.App.SearchEngine().ObserveQuery(engine, model.SearchQueryTypePostSearch, 10*time.Millisecond, nil)
+	th.App.SearchEngine().ObserveQuery(engine, model.SearchQueryTypePostSearch, 30*time.Millisecond, errors.New("failed"))
+
+	t.Run("as system user", func(t *testing.T) {
+		_, resp, err := th.Client.GetSearchEngineQueryStats()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as system admin", func(t *testing.T) {
+		stats, _, err := th.SystemAdminClient.GetSearchEngineQueryStats()
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		assert.Equal(t, "mock", stats[0].Engine)
+		assert.Equal(t, model.SearchQueryTypePostSearch, stats[0].QueryType)
+		assert.Equal(t, int64(2), stats[0].Count)
+		assert.Equal(t, int64(1), stats[0].Failures)
+		assert.Equal(t, 20.0, stats[0].AverageLatencyMs)
+		assert.True(t, stats[0].Healthy)
+	})
+}
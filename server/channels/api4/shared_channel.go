@@ -8,11 +8,14 @@ import (
 	"net/http"
 
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
 )
 
 func (api *API) InitSharedChannels() {
 	api.BaseRoutes.SharedChannels.Handle("/{team_id:[A-Za-z0-9]+}", api.APISessionRequired(getSharedChannels)).Methods("GET")
 	api.BaseRoutes.SharedChannels.Handle("/remote_info/{remote_id:[A-Za-z0-9]+}", api.APISessionRequired(getRemoteClusterInfo)).Methods("GET")
+	api.BaseRoutes.SharedChannels.Handle("/{channel_id:[A-Za-z0-9]+}/filters", api.APISessionRequired(getSharedChannelFilters)).Methods("GET")
+	api.BaseRoutes.SharedChannels.Handle("/{channel_id:[A-Za-z0-9]+}/filters", api.APISessionRequired(updateSharedChannelFilters)).Methods("PUT")
 }
 
 func getSharedChannels(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -86,3 +89,67 @@ func getRemoteClusterInfo(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	w.Write(b)
 }
+
+func getSharedChannelFilters(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSharedChannels) {
+		c.SetPermissionError(model.PermissionManageSharedChannels)
+		return
+	}
+
+	filters, appErr := c.App.GetSharedChannelFilters(c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	b, err := json.Marshal(filters)
+	if err != nil {
+		c.SetJSONEncodingError(err)
+		return
+	}
+	w.Write(b)
+}
+
+func updateSharedChannelFilters(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var filters model.SharedChannelFilters
+	if jsonErr := json.NewDecoder(r.Body).Decode(&filters); jsonErr != nil {
+		c.SetInvalidParamWithErr("filters", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateSharedChannelFilters", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "channel_id", c.Params.ChannelId)
+	audit.AddEventParameterAuditable(auditRec, "filters", &filters)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSharedChannels) {
+		c.SetPermissionError(model.PermissionManageSharedChannels)
+		return
+	}
+
+	updated, appErr := c.App.UpdateSharedChannelFilters(c.Params.ChannelId, &filters)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(updated)
+
+	b, err := json.Marshal(updated)
+	if err != nil {
+		c.SetJSONEncodingError(err)
+		return
+	}
+	w.Write(b)
+}
@@ -94,6 +94,58 @@ func randomBool() bool {
 	return rnd.Intn(2) != 0
 }
 
+func TestSharedChannelFilters(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	sc := &model.SharedChannel{
+		ChannelId: th.BasicChannel.Id,
+		TeamId:    th.BasicChannel.TeamId,
+		Home:      true,
+		ShareName: "test_share_filters",
+		CreatorId: th.BasicChannel.CreatorId,
+	}
+	_, err := th.App.SaveSharedChannel(th.Context, sc)
+	require.NoError(t, err)
+
+	filters := &model.SharedChannelFilters{
+		StripAttachments: true,
+		RedactPatterns:   []string{`\d{3}-\d{4}`},
+	}
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.UpdateSharedChannelFilters(th.BasicChannel.Id, filters)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetSharedChannelFilters(th.BasicChannel.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("update and get filters", func(t *testing.T) {
+		updated, _, err := th.SystemAdminClient.UpdateSharedChannelFilters(th.BasicChannel.Id, filters)
+		require.NoError(t, err)
+		assert.Equal(t, filters, updated)
+
+		fetched, _, err := th.SystemAdminClient.GetSharedChannelFilters(th.BasicChannel.Id)
+		require.NoError(t, err)
+		assert.Equal(t, filters, fetched)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.UpdateSharedChannelFilters(th.BasicChannel.Id, &model.SharedChannelFilters{RedactPatterns: []string{"(unclosed"}})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("channel not shared", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetSharedChannelFilters(th.BasicChannel2.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
+
 func TestGetRemoteClusterById(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
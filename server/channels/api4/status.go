@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
 )
 
@@ -22,6 +23,10 @@ func (api *API) InitStatus() {
 	// as DELETE method doesn't support request body in the mobile app.
 	api.BaseRoutes.User.Handle("/status/custom/recent", api.APISessionRequired(removeUserRecentCustomStatus)).Methods("DELETE")
 	api.BaseRoutes.User.Handle("/status/custom/recent/delete", api.APISessionRequired(removeUserRecentCustomStatus)).Methods("POST")
+
+	api.BaseRoutes.User.Handle("/status/calendar/{service:[A-Za-z0-9]+}", api.APISessionRequired(getUserCalendarConnection)).Methods("GET")
+	api.BaseRoutes.User.Handle("/status/calendar/{service:[A-Za-z0-9]+}", api.APISessionRequired(saveUserCalendarConnection)).Methods("PUT")
+	api.BaseRoutes.User.Handle("/status/calendar/{service:[A-Za-z0-9]+}", api.APISessionRequired(deleteUserCalendarConnection)).Methods("DELETE")
 }
 
 func getUserStatus(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -210,3 +215,98 @@ func removeUserRecentCustomStatus(c *Context, w http.ResponseWriter, r *http.Req
 
 	ReturnStatusOK(w)
 }
+
+func getUserCalendarConnection(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireService()
+	if c.Err != nil {
+		return
+	}
+
+	if !*c.App.Config().TeamSettings.EnableCalendarStatusSync {
+		c.Err = model.NewAppError("getUserCalendarConnection", "api.calendar_status_sync.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	connection, err := c.App.GetCalendarConnection(c.Params.UserId, c.Params.Service)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(connection); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func saveUserCalendarConnection(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireService()
+	if c.Err != nil {
+		return
+	}
+
+	if !*c.App.Config().TeamSettings.EnableCalendarStatusSync {
+		c.Err = model.NewAppError("saveUserCalendarConnection", "api.calendar_status_sync.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	var connection model.OAuthConnection
+	if jsonErr := json.NewDecoder(r.Body).Decode(&connection); jsonErr != nil {
+		c.SetInvalidParamWithErr("connection", jsonErr)
+		return
+	}
+	connection.Service = c.Params.Service
+
+	auditRec := c.MakeAuditRecord("saveUserCalendarConnection", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "service", c.Params.Service)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	saved, err := c.App.SaveCalendarConnection(c.Params.UserId, &connection)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.AddEventResultState(saved)
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteUserCalendarConnection(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireService()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteUserCalendarConnection", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "service", c.Params.Service)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	// connections can be removed even once the sync is disabled
+	if err := c.App.DeleteCalendarConnection(c.Params.UserId, c.Params.Service); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/model"
+	_ "github.com/mattermost/mattermost-server/v6/model/calendarproviders/google"
 )
 
 func TestGetUserStatus(t *testing.T) {
@@ -242,3 +243,55 @@ func TestUpdateUserStatus(t *testing.T) {
 		CheckUnauthorizedStatus(t, resp)
 	})
 }
+
+func TestUserCalendarConnection(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	connection := &model.OAuthConnection{Service: model.ServiceGoogle, AccessToken: "token", RefreshToken: "refresh"}
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := client.SaveUserCalendarConnection(th.BasicUser.Id, connection)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.TeamSettings.EnableCalendarStatusSync = true
+	})
+
+	t.Run("save and get", func(t *testing.T) {
+		saved, _, err := client.SaveUserCalendarConnection(th.BasicUser.Id, connection)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Id, saved.UserId)
+		assert.Empty(t, saved.AccessToken)
+		assert.Empty(t, saved.RefreshToken)
+
+		fetched, _, err := client.GetUserCalendarConnection(th.BasicUser.Id, model.ServiceGoogle)
+		require.NoError(t, err)
+		assert.Equal(t, model.ServiceGoogle, fetched.Service)
+		assert.Empty(t, fetched.AccessToken)
+	})
+
+	t.Run("unsupported service", func(t *testing.T) {
+		_, resp, err := client.SaveUserCalendarConnection(th.BasicUser.Id, &model.OAuthConnection{Service: model.ServiceGitlab, AccessToken: "token"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, resp, err := client.GetUserCalendarConnection(th.BasicUser2.Id, model.ServiceGoogle)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := client.DeleteUserCalendarConnection(th.BasicUser.Id, model.ServiceGoogle)
+		require.NoError(t, err)
+
+		_, resp, err := client.GetUserCalendarConnection(th.BasicUser.Id, model.ServiceGoogle)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitSupportAccess() {
+	api.BaseRoutes.User.Handle("/support_access/consent", api.APISessionRequired(grantSupportAccessConsent)).Methods("POST")
+	api.BaseRoutes.User.Handle("/support_access/consent", api.APISessionRequired(revokeSupportAccessConsents)).Methods("DELETE")
+	api.BaseRoutes.User.Handle("/support_access/consents", api.APISessionRequired(getSupportAccessConsents)).Methods("GET")
+	api.BaseRoutes.User.Handle("/support_access/session", api.APISessionRequired(createSupportAccessSession)).Methods("POST")
+}
+
+func grantSupportAccessConsent(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var consentRequest model.SupportAccessConsentRequest
+	if err := json.NewDecoder(r.Body).Decode(&consentRequest); err != nil {
+		c.SetInvalidParamWithErr("consent", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("grantSupportAccessConsent", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "admin_id", consentRequest.AdminId)
+	audit.AddEventParameter(auditRec, "duration_minutes", consentRequest.DurationMinutes)
+
+	// Only the user can let an admin view the app as them.
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	consent, appErr := c.App.GrantSupportAccessConsent(c.AppContext, c.Params.UserId, &consentRequest)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(consent)
+	auditRec.AddEventObjectType("support_access_consent")
+	auditRec.Success()
+	c.LogAudit("admin_id=" + consent.AdminId)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(consent); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getSupportAccessConsents(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if c.Params.UserId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementUsers)
+		return
+	}
+
+	consents, appErr := c.App.GetSupportAccessConsents(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(consents); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func revokeSupportAccessConsents(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("revokeSupportAccessConsents", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+
+	if c.Params.UserId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if appErr := c.App.RevokeSupportAccessConsents(c.AppContext, c.Params.UserId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	c.LogAuditWithUserId(c.Params.UserId, "")
+
+	ReturnStatusOK(w)
+}
+
+func createSupportAccessSession(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createSupportAccessSession", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	session, appErr := c.App.CreateSupportAccessSession(c.AppContext, c.Params.UserId, c.AppContext.Session().UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	audit.AddEventParameter(auditRec, "consent_id", session.Props[model.SessionPropSupportAccessConsentId])
+	auditRec.AddMeta("session_id", session.Id)
+	auditRec.Success()
+	// The user sees in their audits that the admin opened a session as them.
+	c.LogAuditWithUserId(c.Params.UserId, "support_access_session_id="+session.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(session); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
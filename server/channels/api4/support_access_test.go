@@ -0,0 +1,91 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestSupportAccess(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("only the user can grant consent", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GrantSupportAccessConsent(th.BasicUser.Id, &model.SupportAccessConsentRequest{AdminId: th.SystemAdminUser.Id})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("only admins can open support access sessions", func(t *testing.T) {
+		_, resp, err := th.Client.CreateSupportAccessSession(th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	_, resp, err := th.SystemAdminClient.CreateSupportAccessSession(th.BasicUser.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	consent, resp, err := th.Client.GrantSupportAccessConsent(th.BasicUser.Id, &model.SupportAccessConsentRequest{AdminId: th.SystemAdminUser.Id})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.SystemAdminUser.Id, consent.AdminId)
+
+	consents, _, err := th.SystemAdminClient.GetSupportAccessConsents(th.BasicUser.Id)
+	require.NoError(t, err)
+	require.Len(t, consents, 1)
+	assert.Equal(t, consent.Id, consents[0].Id)
+
+	_, resp, err = th.Client.GetSupportAccessConsents(th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	session, resp, err := th.SystemAdminClient.CreateSupportAccessSession(th.BasicUser.Id)
+	require.NoError(t, err)
+	checkHTTPStatus(t, resp, http.StatusCreated)
+
+	supportClient := th.CreateClient()
+	supportClient.SetToken(session.Token)
+
+	t.Run("support access sessions view the app as the user", func(t *testing.T) {
+		me, _, err := supportClient.GetMe("")
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Id, me.Id)
+
+		users, _, err := supportClient.GetUsersByIds([]string{th.BasicUser2.Id})
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+	})
+
+	t.Run("support access sessions are read-only", func(t *testing.T) {
+		_, resp, err := supportClient.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "support"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+		CheckErrorID(t, err, "api.context.support_access.read_only.app_error")
+	})
+
+	audits, _, err := th.Client.GetUserAudits(th.BasicUser.Id, 0, 100, "")
+	require.NoError(t, err)
+	found := false
+	for _, audit := range audits {
+		if audit.Action == "/api/v4/users/"+th.BasicUser.Id+"/support_access/session" {
+			found = true
+		}
+	}
+	assert.True(t, found, "the user sees the support access session in their audits")
+
+	resp, err = th.Client.RevokeSupportAccessConsents(th.BasicUser.Id)
+	require.NoError(t, err)
+	CheckOKStatus(t, resp)
+
+	_, resp, err = supportClient.GetMe("")
+	require.Error(t, err)
+	CheckUnauthorizedStatus(t, resp)
+}
@@ -46,12 +46,15 @@ func (api *API) InitSystem() {
 	api.BaseRoutes.APIRoot.Handle("/file/s3_test", api.APISessionRequired(testS3)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/database/recycle", api.APISessionRequired(databaseRecycle)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/caches/invalidate", api.APISessionRequired(invalidateCaches)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/caches", api.APISessionRequired(getCaches)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/caches/{cache_name:[A-Za-z0-9]+}/flush", api.APISessionRequired(flushCache)).Methods("POST")
 
 	api.BaseRoutes.APIRoot.Handle("/logs", api.APISessionRequired(getLogs)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/logs/query", api.APISessionRequired(queryLogs)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/logs", api.APIHandler(postLog)).Methods("POST")
 
 	api.BaseRoutes.APIRoot.Handle("/analytics/old", api.APISessionRequired(getAnalytics)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/analytics/reactions", api.APISessionRequired(getReactionAnalytics)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/latest_version", api.APISessionRequired(getLatestVersion)).Methods("GET")
 
 	api.BaseRoutes.APIRoot.Handle("/redirect_location", api.APISessionRequiredTrustRequester(getRedirectLocation)).Methods("GET")
@@ -73,6 +76,9 @@ func (api *API) InitSystem() {
 	api.BaseRoutes.System.Handle("/onboarding/complete", api.APISessionRequired(getOnboarding)).Methods("GET")
 	api.BaseRoutes.System.Handle("/onboarding/complete", api.APISessionRequired(completeOnboarding)).Methods("POST")
 	api.BaseRoutes.System.Handle("/schema/version", api.APISessionRequired(getAppliedSchemaMigrations)).Methods("GET")
+	api.BaseRoutes.System.Handle("/schema/migrations/status", api.APISessionRequired(getMigrationsStatus)).Methods("GET")
+	api.BaseRoutes.System.Handle("/integrations/health", api.APISessionRequired(getIntegrationsHealth)).Methods("GET")
+	api.BaseRoutes.System.Handle("/integrations/health/{integration_id:[A-Za-z0-9]+}", api.APISessionRequired(resetIntegrationHealth)).Methods("DELETE")
 }
 
 func generateSupportPacket(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -148,6 +154,12 @@ func getSystemPing(c *Context, w http.ResponseWriter, r *http.Request) {
 		s[model.STATUS] = model.StatusUnhealthy
 	}
 
+	// A draining node reports itself unhealthy for the load balancers to stop sending it requests.
+	if c.App.Srv().IsDraining() {
+		s["draining"] = "true"
+		s[model.STATUS] = model.StatusUnhealthy
+	}
+
 	// Enhanced ping health check:
 	// If an extra form value is provided then perform extra health checks for
 	// database and file storage backends.
@@ -181,6 +193,17 @@ func getSystemPing(c *Context, w http.ResponseWriter, r *http.Request) {
 			s[model.STATUS] = model.StatusUnhealthy
 		}
 
+		for name, productErr := range c.App.Srv().ProductsHealthCheck() {
+			productStatusKey := name + "_status"
+			s[productStatusKey] = model.StatusOk
+			if productErr != nil {
+				mlog.Warn("Product health check failed.", mlog.String("product", name), mlog.Err(productErr))
+				s[productStatusKey] = model.StatusUnhealthy
+				s[model.STATUS] = model.StatusUnhealthy
+			}
+			w.Header().Set(productStatusKey, s[productStatusKey])
+		}
+
 		w.Header().Set(model.STATUS, s[model.STATUS])
 		w.Header().Set(dbStatusKey, s[dbStatusKey])
 		w.Header().Set(filestoreStatusKey, s[filestoreStatusKey])
@@ -327,6 +350,62 @@ func invalidateCaches(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func getCaches(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionInvalidateCaches) {
+		c.SetPermissionError(model.PermissionInvalidateCaches)
+		return
+	}
+
+	if *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError("getCaches", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	caches, appErr := c.App.Srv().GetCaches()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(caches)
+	if err != nil {
+		c.Err = model.NewAppError("getCaches", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Write(js)
+}
+
+func flushCache(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireCacheName()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionInvalidateCaches) {
+		c.SetPermissionError(model.PermissionInvalidateCaches)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("flushCache", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "cache_name", c.Params.CacheName)
+
+	if *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError("flushCache", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	if appErr := c.App.Srv().FlushCache(c.Params.CacheName); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
 func queryLogs(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec := c.MakeAuditRecord("queryLogs", audit.Fail)
 	defer c.LogAuditRec(auditRec)
@@ -477,6 +556,81 @@ func getAnalytics(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getReactionAnalytics(c *Context, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	opts := model.ReactionAnalyticsOptions{
+		TeamId:    query.Get("team_id"),
+		ChannelId: query.Get("channel_id"),
+		Until:     model.GetMillis(),
+		Limit:     model.ReactionAnalyticsDefaultLimit,
+	}
+
+	if untilString := query.Get("until"); untilString != "" {
+		until, err := strconv.ParseInt(untilString, 10, 64)
+		if err != nil {
+			c.SetInvalidParamWithErr("until", err)
+			return
+		}
+		opts.Until = until
+	}
+
+	opts.Since = opts.Until - model.ReactionSummaryBackfillDays*24*60*60*1000
+	if sinceString := query.Get("since"); sinceString != "" {
+		since, err := strconv.ParseInt(sinceString, 10, 64)
+		if err != nil {
+			c.SetInvalidParamWithErr("since", err)
+			return
+		}
+		opts.Since = since
+	}
+
+	if limitString := query.Get("limit"); limitString != "" {
+		limit, err := strconv.Atoi(limitString)
+		if err != nil {
+			c.SetInvalidParamWithErr("limit", err)
+			return
+		}
+		opts.Limit = limit
+	}
+
+	if appErr := opts.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	// Team admins can see the analytics of their teams and of the channels in them.
+	teamID := opts.TeamId
+	if opts.ChannelId != "" {
+		channel, appErr := c.App.GetChannel(c.AppContext, opts.ChannelId)
+		if appErr != nil {
+			c.Err = appErr
+			return
+		}
+		if teamID != "" && channel.TeamId != teamID {
+			c.SetInvalidParam("channel_id")
+			return
+		}
+		teamID = channel.TeamId
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionGetAnalytics) {
+		if teamID == "" || !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), teamID, model.PermissionManageTeam) {
+			c.SetPermissionError(model.PermissionGetAnalytics)
+			return
+		}
+	}
+
+	analytics, appErr := c.App.GetReactionAnalytics(opts)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(analytics); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getLatestVersion(c *Context, w http.ResponseWriter, r *http.Request) {
 	if *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
 		c.Err = model.NewAppError("latestVersion", "api.restricted_system_admin", nil, "", http.StatusForbidden)
@@ -621,6 +775,8 @@ func pushNotificationAck(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	c.App.RecordPushNotificationAck(c.AppContext.Session().UserId, &ack)
+
 	err := c.App.SendAckToPushProxy(&ack)
 	if ack.IsIdLoaded {
 		if err != nil {
@@ -1039,6 +1195,62 @@ func getAppliedSchemaMigrations(c *Context, w http.ResponseWriter, r *http.Reque
 	auditRec.Success()
 }
 
+func getMigrationsStatus(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionToAny(*c.AppContext.Session(), model.SysconsoleReadPermissions) {
+		c.SetPermissionError(model.SysconsoleReadPermissions...)
+		return
+	}
+
+	status, appErr := c.App.GetMigrationsStatus()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getIntegrationsHealth(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadIntegrationsIntegrationManagement) {
+		c.SetPermissionError(model.PermissionSysconsoleReadIntegrationsIntegrationManagement)
+		return
+	}
+
+	js, err := json.Marshal(c.App.GetIntegrationsHealth())
+	if err != nil {
+		c.Err = model.NewAppError("getIntegrationsHealth", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	w.Write(js)
+}
+
+func resetIntegrationHealth(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireIntegrationId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("resetIntegrationHealth", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "integration_id", c.Params.IntegrationId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteIntegrationsIntegrationManagement) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteIntegrationsIntegrationManagement)
+		return
+	}
+
+	if err := c.App.ResetIntegrationHealth(c.Params.IntegrationId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
 // returns true if the data has nil fields
 // this is being used for testS3 and testEmail methods
 func checkHasNilFields(value any) bool {
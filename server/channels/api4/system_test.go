@@ -352,6 +352,54 @@ func TestInvalidateCaches(t *testing.T) {
 	})
 }
 
+func TestCaches(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+	client := th.Client
+
+	t.Run("as system user", func(t *testing.T) {
+		_, resp, err := client.GetCaches()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = client.FlushCache("Status")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as system admin", func(t *testing.T) {
+		caches, _, err := th.SystemAdminClient.GetCaches()
+		require.NoError(t, err)
+
+		var names []string
+		for _, c := range caches {
+			names = append(names, c.Name)
+			assert.False(t, c.Shared)
+		}
+		assert.Contains(t, names, "Session")
+		assert.Contains(t, names, "UserProfileByIds")
+
+		_, err = th.SystemAdminClient.FlushCache("Session")
+		require.NoError(t, err)
+
+		resp, err := th.SystemAdminClient.FlushCache("Unknown")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("as restricted system admin", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalSettings.RestrictSystemAdmin = true })
+
+		_, resp, err := th.SystemAdminClient.GetCaches()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.SystemAdminClient.FlushCache("Session")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
 func TestGetLogs(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -510,6 +558,62 @@ func TestGetAnalyticsOld(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestGetReactionAnalytics(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	post := th.CreatePost()
+	_, _, err := th.Client.SaveReaction(&model.Reaction{UserId: th.BasicUser.Id, PostId: post.Id, EmojiName: "smile"})
+	require.NoError(t, err)
+	require.Nil(t, th.App.SummarizeReactions(th.Context))
+
+	t.Run("requires analytics permission", func(t *testing.T) {
+		_, resp, err := th.Client.GetReactionAnalytics(model.ReactionAnalyticsOptions{TeamId: th.BasicTeam.Id})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetReactionAnalytics(model.ReactionAnalyticsOptions{})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("team admins can see the analytics of their team", func(t *testing.T) {
+		th.UpdateUserToTeamAdmin(th.BasicUser, th.BasicTeam)
+		defer th.UpdateUserToNonTeamAdmin(th.BasicUser, th.BasicTeam)
+		th.App.Srv().InvalidateAllCaches()
+
+		analytics, _, err := th.Client.GetReactionAnalytics(model.ReactionAnalyticsOptions{ChannelId: th.BasicChannel.Id})
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ReactionEmojiCount{{EmojiName: "smile", Count: 1}}, analytics.TopEmojis)
+		assert.Equal(t, []*model.ReactionPostCount{{PostId: post.Id, ChannelId: th.BasicChannel.Id, Count: 1}}, analytics.TopPosts)
+
+		_, resp, err := th.Client.GetReactionAnalytics(model.ReactionAnalyticsOptions{})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("channel must belong to the team", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetReactionAnalytics(model.ReactionAnalyticsOptions{TeamId: model.NewId(), ChannelId: th.BasicChannel.Id})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetReactionAnalytics(model.ReactionAnalyticsOptions{Limit: model.ReactionAnalyticsMaxLimit + 1})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.AnalyticsSettings.EnableReactionSummaries = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.AnalyticsSettings.EnableReactionSummaries = true })
+
+		_, resp, err := th.SystemAdminClient.GetReactionAnalytics(model.ReactionAnalyticsOptions{})
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
+
 func TestS3TestConnection(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -984,6 +1088,92 @@ func TestGetAppliedSchemaMigrations(t *testing.T) {
 	})
 }
 
+func TestGetMigrationsStatus(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	t.Run("as a regular user", func(t *testing.T) {
+		_, resp, err := th.Client.GetMigrationsStatus()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, c *model.Client4) {
+		status, resp, err := c.GetMigrationsStatus()
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+
+		version, err := th.App.Srv().Store().GetDBSchemaVersion()
+		require.NoError(t, err)
+		assert.Equal(t, version, status.SchemaVersion)
+
+		require.NotEmpty(t, status.OnlineMigrations)
+		for _, migration := range status.OnlineMigrations {
+			assert.Equal(t, model.OnlineMigrationStateCompleted, migration.State)
+		}
+	})
+}
+
+func TestIntegrationsHealth(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableCommands = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.1"
+		*cfg.ServiceSettings.OutgoingIntegrationsCircuitBreakerThreshold = 1
+	})
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	cmd, _, err := th.SystemAdminClient.CreateCommand(&model.Command{
+		TeamId:  th.BasicTeam.Id,
+		URL:     server.URL,
+		Method:  model.CommandMethodPost,
+		Trigger: "failing",
+	})
+	require.NoError(t, err)
+
+	_, _, err = th.Client.ExecuteCommand(th.BasicChannel.Id, "/failing")
+	require.Error(t, err)
+
+	// The circuit of the command is open, so it isn't requested again.
+	_, _, err = th.Client.ExecuteCommand(th.BasicChannel.Id, "/failing")
+	require.Error(t, err)
+	assert.Equal(t, 1, requests)
+
+	t.Run("as a regular user", func(t *testing.T) {
+		_, resp, err := th.Client.GetIntegrationsHealth()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.ResetIntegrationHealth(cmd.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as a system admin", func(t *testing.T) {
+		list, _, err := th.SystemAdminClient.GetIntegrationsHealth()
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, cmd.Id, list[0].IntegrationId)
+		assert.Equal(t, model.IntegrationTypeCommand, list[0].IntegrationType)
+		assert.Equal(t, model.IntegrationCircuitStateOpen, list[0].CircuitState)
+
+		_, err = th.SystemAdminClient.ResetIntegrationHealth(cmd.Id)
+		require.NoError(t, err)
+
+		resp, err := th.SystemAdminClient.ResetIntegrationHealth(cmd.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
+
 func TestCheckHasNilFields(t *testing.T) {
 	t.Run("check if the empty struct has nil fields", func(t *testing.T) {
 		var s model.FileSettings
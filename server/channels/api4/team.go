@@ -94,27 +94,9 @@ func createTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// On a cloud license, we must check limits before allowing to create
-	if c.App.Channels().License().IsCloud() {
-		limits, err := c.App.Cloud().GetCloudLimits(c.AppContext.Session().UserId)
-		if err != nil {
-			c.Err = model.NewAppError("Api4.createTeam", "api.cloud.app_error", nil, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		// If there are no limits for teams, for active teams, or the limit for active teams is less than 0, do nothing
-		if !(limits == nil || limits.Teams == nil || limits.Teams.Active == nil || *limits.Teams.Active <= 0) {
-			teamsUsage, appErr := c.App.GetTeamsUsage()
-			if appErr != nil {
-				c.Err = appErr
-				return
-			}
-			// if the number of active teams is greater than or equal to the limit, return 400
-			if teamsUsage.Active >= int64(*limits.Teams.Active) {
-				c.Err = model.NewAppError("Api4.createTeam", "api.cloud.teams_limit_reached.create", nil, "", http.StatusBadRequest)
-				return
-			}
-		}
+	checkCloudTeamsLimit(c, "Api4.createTeam", "api.cloud.teams_limit_reached.create")
+	if c.Err != nil {
+		return
 	}
 
 	rteam, err := c.App.CreateTeamWithUser(c.AppContext, &team, c.AppContext.Session().UserId)
@@ -135,6 +117,35 @@ func createTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// checkCloudTeamsLimit sets c.Err, with the given id when the limit is reached, when a cloud
+// workspace already has as many active teams as its limit allows.
+func checkCloudTeamsLimit(c *Context, where, limitReachedID string) {
+	if !c.App.Channels().License().IsCloud() {
+		return
+	}
+
+	limits, err := c.App.Cloud().GetCloudLimits(c.AppContext.Session().UserId)
+	if err != nil {
+		c.Err = model.NewAppError(where, "api.cloud.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// If there are no limits for teams, for active teams, or the limit for active teams is less than 0, do nothing
+	if limits == nil || limits.Teams == nil || limits.Teams.Active == nil || *limits.Teams.Active <= 0 {
+		return
+	}
+
+	teamsUsage, appErr := c.App.GetTeamsUsage()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	// if the number of active teams is greater than or equal to the limit, return 400
+	if teamsUsage.Active >= int64(*limits.Teams.Active) {
+		c.Err = model.NewAppError(where, limitReachedID, nil, "", http.StatusBadRequest)
+	}
+}
+
 func getTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireTeamId()
 	if c.Err != nil {
@@ -284,27 +295,9 @@ func restoreTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		c.SetPermissionError(model.PermissionManageTeam)
 		return
 	}
-	// On a cloud license, we must check limits before allowing to restore
-	if c.App.Channels().License().IsCloud() {
-		limits, err := c.App.Cloud().GetCloudLimits(c.AppContext.Session().UserId)
-		if err != nil {
-			c.Err = model.NewAppError("Api4.restoreTeam", "api.cloud.app_error", nil, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		// If there are no limits for teams, for active teams, or the limit for active teams is less than 0, do nothing
-		if !(limits == nil || limits.Teams == nil || limits.Teams.Active == nil || *limits.Teams.Active <= 0) {
-			teamsUsage, appErr := c.App.GetTeamsUsage()
-			if appErr != nil {
-				c.Err = appErr
-				return
-			}
-			// if the number of active teams is greater than or equal to the limit, return 400
-			if teamsUsage.Active >= int64(*limits.Teams.Active) {
-				c.Err = model.NewAppError("Api4.restoreTeam", "api.cloud.teams_limit_reached.restore", nil, "", http.StatusBadRequest)
-				return
-			}
-		}
+	checkCloudTeamsLimit(c, "Api4.restoreTeam", "api.cloud.teams_limit_reached.restore")
+	if c.Err != nil {
+		return
 	}
 
 	err := c.App.RestoreTeam(c.Params.TeamId)
@@ -1339,8 +1332,10 @@ func importTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 	data := map[string]string{}
 	switch importFrom {
 	case "slack":
+		// Large archives should rather be imported by a slack_import job, which doesn't need to
+		// complete within the request and reports its progress.
 		var err *model.AppError
-		if err, log = c.App.SlackImport(c.AppContext, fileData, fileSize, c.Params.TeamId); err != nil {
+		if err, log = c.App.SlackImport(c.AppContext, fileData, fileSize, c.Params.TeamId, nil); err != nil {
 			c.Err = err
 			c.Err.StatusCode = http.StatusBadRequest
 		}
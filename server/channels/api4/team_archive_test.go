@@ -0,0 +1,71 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTeamArchive(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	team := th.CreateTeam()
+	noop := func(int) {}
+
+	client := th.CreateClient()
+	th.LoginBasic2WithClient(client)
+
+	t.Run("only team admins can archive the team", func(t *testing.T) {
+		_, resp, err := client.ArchiveTeam(team.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("a team that isn't archived has no archive", func(t *testing.T) {
+		_, resp, err := th.Client.GetTeamArchive(team.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	archive, resp, err := th.Client.ArchiveTeam(team.Id)
+	require.NoError(t, err)
+	checkHTTPStatus(t, resp, http.StatusAccepted)
+	assert.Equal(t, model.TeamArchiveStatusArchiving, archive.Status)
+
+	t.Run("the team can't be restored while it is being archived", func(t *testing.T) {
+		_, resp, err := th.Client.RestoreArchivedTeam(team.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	require.Nil(t, th.App.ProcessTeamArchive(th.Context, team.Id, noop))
+
+	archive, _, err = th.Client.GetTeamArchive(team.Id)
+	require.NoError(t, err)
+	assert.Equal(t, model.TeamArchiveStatusArchived, archive.Status)
+
+	t.Run("the plain restore doesn't apply to archived teams", func(t *testing.T) {
+		_, resp, err := th.Client.RestoreTeam(team.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	archive, resp, err = th.Client.RestoreArchivedTeam(team.Id)
+	require.NoError(t, err)
+	checkHTTPStatus(t, resp, http.StatusAccepted)
+	assert.Equal(t, model.TeamArchiveStatusRestoring, archive.Status)
+
+	require.Nil(t, th.App.ProcessTeamArchive(th.Context, team.Id, noop))
+
+	restored, _, err := th.Client.GetTeam(team.Id, "")
+	require.NoError(t, err)
+	assert.Zero(t, restored.DeleteAt)
+}
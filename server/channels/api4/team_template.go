@@ -0,0 +1,226 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitTeamTemplate() {
+	api.BaseRoutes.TeamTemplates.Handle("", api.APISessionRequired(createTeamTemplate)).Methods("POST")
+	api.BaseRoutes.TeamTemplates.Handle("", api.APISessionRequired(getTeamTemplates)).Methods("GET")
+	api.BaseRoutes.TeamTemplate.Handle("", api.APISessionRequired(getTeamTemplate)).Methods("GET")
+	api.BaseRoutes.TeamTemplate.Handle("", api.APISessionRequired(updateTeamTemplate)).Methods("PUT")
+	api.BaseRoutes.TeamTemplate.Handle("", api.APISessionRequired(deleteTeamTemplate)).Methods("DELETE")
+	api.BaseRoutes.TeamTemplate.Handle("/instantiate", api.APISessionRequired(instantiateTeamTemplate)).Methods("POST")
+}
+
+func createTeamTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	var template model.TeamTemplate
+	if err := json.NewDecoder(r.Body).Decode(&template); err != nil {
+		c.SetInvalidParamWithErr("team_template", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createTeamTemplate", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameterAuditable(auditRec, "team_template", &template)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementTeams) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementTeams)
+		return
+	}
+
+	template.CreatorId = c.AppContext.Session().UserId
+	created, appErr := c.App.CreateTeamTemplate(&template)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(created)
+	auditRec.AddEventObjectType("team_template")
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getTeamTemplates(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !canReadTeamTemplates(c) {
+		c.SetPermissionError(model.PermissionCreateTeam)
+		return
+	}
+
+	templates, appErr := c.App.GetTeamTemplates(c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(templates); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getTeamTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamTemplateId()
+	if c.Err != nil {
+		return
+	}
+
+	if !canReadTeamTemplates(c) {
+		c.SetPermissionError(model.PermissionCreateTeam)
+		return
+	}
+
+	template, appErr := c.App.GetTeamTemplate(c.Params.TeamTemplateId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(template); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateTeamTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamTemplateId()
+	if c.Err != nil {
+		return
+	}
+
+	var template model.TeamTemplate
+	if err := json.NewDecoder(r.Body).Decode(&template); err != nil {
+		c.SetInvalidParamWithErr("team_template", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateTeamTemplate", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "team_template_id", c.Params.TeamTemplateId)
+	audit.AddEventParameterAuditable(auditRec, "team_template", &template)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementTeams) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementTeams)
+		return
+	}
+
+	existing, appErr := c.App.GetTeamTemplate(c.Params.TeamTemplateId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddEventPriorState(existing)
+
+	// Only the display name, description and content of a template can change.
+	updated := *existing
+	updated.DisplayName = template.DisplayName
+	updated.Description = template.Description
+	updated.Content = template.Content
+
+	result, appErr := c.App.UpdateTeamTemplate(&updated)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(result)
+	auditRec.AddEventObjectType("team_template")
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteTeamTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamTemplateId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteTeamTemplate", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "team_template_id", c.Params.TeamTemplateId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementTeams) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementTeams)
+		return
+	}
+
+	if appErr := c.App.DeleteTeamTemplate(c.Params.TeamTemplateId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func instantiateTeamTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamTemplateId()
+	if c.Err != nil {
+		return
+	}
+
+	var instantiation model.TeamTemplateInstantiation
+	if err := json.NewDecoder(r.Body).Decode(&instantiation); err != nil {
+		c.SetInvalidParamWithErr("team_template_instantiation", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("instantiateTeamTemplate", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "team_template_id", c.Params.TeamTemplateId)
+	audit.AddEventParameter(auditRec, "name", instantiation.Name)
+	audit.AddEventParameter(auditRec, "display_name", instantiation.DisplayName)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionCreateTeam) {
+		c.Err = model.NewAppError("instantiateTeamTemplate", "api.team.is_team_creation_allowed.disabled.app_error", nil, "", http.StatusForbidden)
+		return
+	}
+
+	checkCloudTeamsLimit(c, "Api4.instantiateTeamTemplate", "api.cloud.teams_limit_reached.create")
+	if c.Err != nil {
+		return
+	}
+
+	template, appErr := c.App.GetTeamTemplate(c.Params.TeamTemplateId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	instance, appErr := c.App.InstantiateTeamTemplate(c.AppContext, template, &instantiation, c.AppContext.Session().UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(instance.Team)
+	auditRec.AddEventObjectType("team")
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(instance); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// canReadTeamTemplates tells whether the session can see the team templates, which is the case
+// of the users able to instantiate them and of the admins managing them.
+func canReadTeamTemplates(c *Context) bool {
+	session := *c.AppContext.Session()
+	return c.App.SessionHasPermissionTo(session, model.PermissionCreateTeam) ||
+		c.App.SessionHasPermissionTo(session, model.PermissionSysconsoleReadUserManagementTeams)
+}
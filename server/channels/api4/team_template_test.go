@@ -0,0 +1,100 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTeamTemplate(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newTemplate := &model.TeamTemplate{
+		DisplayName: "Support",
+		Content: model.TeamTemplateContent{
+			Channels: []*model.TeamTemplateChannel{
+				{Name: "tickets", DisplayName: "Tickets", Type: model.ChannelTypeOpen},
+			},
+			Categories: []*model.TeamTemplateCategory{
+				{DisplayName: "Support", Channels: []string{"tickets"}},
+			},
+		},
+	}
+
+	t.Run("only admins can create templates", func(t *testing.T) {
+		_, resp, err := th.Client.CreateTeamTemplate(newTemplate)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateTeamTemplate(&model.TeamTemplate{DisplayName: ""})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	template, resp, err := th.SystemAdminClient.CreateTeamTemplate(newTemplate)
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.SystemAdminUser.Id, template.CreatorId)
+
+	templates, _, err := th.Client.GetTeamTemplates(0, 10)
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, template.Id, templates[0].Id)
+
+	t.Run("only admins can update and delete templates", func(t *testing.T) {
+		_, resp, err := th.Client.UpdateTeamTemplate(template)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.DeleteTeamTemplate(template.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	template.DisplayName = "Customer support"
+	template.CreatorId = th.BasicUser.Id
+	updated, _, err := th.SystemAdminClient.UpdateTeamTemplate(template)
+	require.NoError(t, err)
+	assert.Equal(t, "Customer support", updated.DisplayName)
+	assert.Equal(t, th.SystemAdminUser.Id, updated.CreatorId, "the creator can't change")
+
+	t.Run("users without create team permission can't instantiate templates", func(t *testing.T) {
+		th.RemovePermissionFromRole(model.PermissionCreateTeam.Id, model.SystemUserRoleId)
+		defer th.AddPermissionToRole(model.PermissionCreateTeam.Id, model.SystemUserRoleId)
+
+		_, resp, err := th.Client.InstantiateTeamTemplate(template.Id, &model.TeamTemplateInstantiation{Name: "support-" + model.NewId(), DisplayName: "Support", Type: model.TeamOpen})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetTeamTemplate(template.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	instance, resp, err := th.Client.InstantiateTeamTemplate(template.Id, &model.TeamTemplateInstantiation{Name: "support-" + model.NewId(), DisplayName: "Support", Type: model.TeamOpen})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	require.Len(t, instance.Channels, 1)
+	assert.Equal(t, "tickets", instance.Channels[0].Name)
+	assert.Equal(t, instance.Team.Id, instance.Channels[0].TeamId)
+
+	_, _, err = th.Client.GetTeam(instance.Team.Id, "")
+	require.NoError(t, err)
+
+	resp, err = th.SystemAdminClient.DeleteTeamTemplate(template.Id)
+	require.NoError(t, err)
+	CheckOKStatus(t, resp)
+
+	_, resp, err = th.Client.InstantiateTeamTemplate(template.Id, &model.TeamTemplateInstantiation{Name: "support-" + model.NewId(), DisplayName: "Support", Type: model.TeamOpen})
+	require.Error(t, err)
+	CheckNotFoundStatus(t, resp)
+}
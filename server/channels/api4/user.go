@@ -69,11 +69,16 @@ func (api *API) InitUser() {
 	api.BaseRoutes.UserByEmail.Handle("", api.APISessionRequired(getUserByEmail)).Methods("GET")
 
 	api.BaseRoutes.User.Handle("/sessions", api.APISessionRequired(getSessions)).Methods("GET")
+	api.BaseRoutes.User.Handle("/sessions/detailed", api.APISessionRequired(getSessionsDetailed)).Methods("GET")
 	api.BaseRoutes.User.Handle("/sessions/revoke", api.APISessionRequired(revokeSession)).Methods("POST")
 	api.BaseRoutes.User.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsForUser)).Methods("POST")
+	api.BaseRoutes.User.Handle("/sessions/revoke/others", api.APISessionRequired(revokeOtherSessions)).Methods("POST")
 	api.BaseRoutes.Users.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsAllUsers)).Methods("POST")
 	api.BaseRoutes.Users.Handle("/sessions/device", api.APISessionRequired(attachDeviceId)).Methods("PUT")
 	api.BaseRoutes.User.Handle("/audits", api.APISessionRequired(getUserAudits)).Methods("GET")
+	api.BaseRoutes.User.Handle("/push_notification_receipts", api.APISessionRequired(getPushNotificationReceipts)).Methods("GET")
+	api.BaseRoutes.User.Handle("/notification_rules", api.APISessionRequired(getNotificationRules)).Methods("GET")
+	api.BaseRoutes.User.Handle("/notification_rules", api.APISessionRequired(updateNotificationRules)).Methods("PUT")
 
 	api.BaseRoutes.User.Handle("/tokens", api.APISessionRequired(createUserAccessToken)).Methods("POST")
 	api.BaseRoutes.User.Handle("/tokens", api.APISessionRequired(getUserAccessTokensForUser)).Methods("GET")
@@ -180,6 +185,11 @@ func getUser(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fields := getResponseFields(c, r)
+	if c.Err != nil {
+		return
+	}
+
 	canSee, err := c.App.UserCanSeeOtherUser(c.AppContext.Session().UserId, c.Params.UserId)
 	if err != nil {
 		c.SetPermissionError(model.PermissionViewMembers)
@@ -210,7 +220,7 @@ func getUser(c *Context, w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	etag := user.Etag(*c.App.Config().PrivacySettings.ShowFullName, *c.App.Config().PrivacySettings.ShowEmailAddress)
+	etag := fields.etag(user.Etag(*c.App.Config().PrivacySettings.ShowFullName, *c.App.Config().PrivacySettings.ShowEmailAddress))
 
 	if c.HandleEtag(etag, "Get User", w, r) {
 		return
@@ -223,9 +233,13 @@ func getUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	c.App.Srv().Platform().UpdateLastActivityAtIfNeeded(*c.AppContext.Session())
 	w.Header().Set(model.HeaderEtagServer, etag)
-	if err := json.NewEncoder(w).Encode(user); err != nil {
-		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	js, jsonErr := marshalWithFields(fields, user)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("getUser", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+		return
 	}
+
+	w.Write(js)
 }
 
 func getUserByUsername(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -662,6 +676,11 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fields := getResponseFields(c, r)
+	if c.Err != nil {
+		return
+	}
+
 	if sort != "" && sort != "last_activity_at" && sort != "create_at" && sort != "status" && sort != "admin" && sort != "display_name" {
 		c.SetInvalidURLParam("sort")
 		return
@@ -831,7 +850,7 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		etag = c.App.GetUsersNotInTeamEtag(inTeamId, restrictions.Hash())
+		etag = fields.etag(c.App.GetUsersNotInTeamEtag(inTeamId, restrictions.Hash()))
 		if c.HandleEtag(etag, "Get Users Not in Team", w, r) {
 			return
 		}
@@ -848,7 +867,7 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		} else if sort == "create_at" {
 			profiles, appErr = c.App.GetNewUsersForTeamPage(inTeamId, c.Params.Page, c.Params.PerPage, c.IsSystemAdmin(), restrictions)
 		} else {
-			etag = c.App.GetUsersInTeamEtag(inTeamId, restrictions.Hash())
+			etag = fields.etag(c.App.GetUsersInTeamEtag(inTeamId, restrictions.Hash()))
 			if c.HandleEtag(etag, "Get Users in Team", w, r) {
 				return
 			}
@@ -919,7 +938,7 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	c.App.Srv().Platform().UpdateLastActivityAtIfNeeded(*c.AppContext.Session())
 
-	js, err := json.Marshal(profiles)
+	js, err := marshalWithFields(fields, profiles)
 	if err != nil {
 		c.Err = model.NewAppError("getUsers", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 		return
@@ -955,6 +974,11 @@ func getUsersByIds(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fields := getResponseFields(c, r)
+	if c.Err != nil {
+		return
+	}
+
 	sinceString := r.URL.Query().Get("since")
 
 	options := &store.UserGetByIdsOpts{
@@ -983,7 +1007,7 @@ func getUsersByIds(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	js, err := json.Marshal(users)
+	js, err := marshalWithFields(fields, users)
 	if err != nil {
 		c.Err = model.NewAppError("getUsersByIds", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 		return
@@ -1121,7 +1145,8 @@ func searchUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	profiles, appErr := c.App.SearchUsers(&props, options)
+	c.CancelWithRequest(r)
+	profiles, appErr := c.App.SearchUsers(c.AppContext, &props, options)
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -1184,6 +1209,8 @@ func autocompleteUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	c.CancelWithRequest(r)
+
 	if channelId != "" {
 		// We're using the channelId to search for users inside that channel and the team
 		// to get the not in channel list. Also we want to include the DM and GM users for
@@ -1197,7 +1224,7 @@ func autocompleteUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 			)
 			return
 		}
-		result, err := c.App.AutocompleteUsersInChannel(teamId, channelId, name, options)
+		result, err := c.App.AutocompleteUsersInChannel(c.AppContext, teamId, channelId, name, options)
 		if err != nil {
 			c.Err = err
 			return
@@ -1206,7 +1233,7 @@ func autocompleteUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		autocomplete.Users = result.InChannel
 		autocomplete.OutOfChannel = result.OutOfChannel
 	} else if teamId != "" {
-		result, err := c.App.AutocompleteUsersInTeam(teamId, name, options)
+		result, err := c.App.AutocompleteUsersInTeam(c.AppContext, teamId, name, options)
 		if err != nil {
 			c.Err = err
 			return
@@ -1214,7 +1241,7 @@ func autocompleteUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 
 		autocomplete.Users = result.InTeam
 	} else {
-		result, err := c.App.SearchUsersInTeam("", name, options)
+		result, err := c.App.SearchUsersInTeam(c.AppContext, "", name, options)
 		if err != nil {
 			c.Err = err
 			return
@@ -1829,6 +1856,9 @@ func login(c *Context, w http.ResponseWriter, r *http.Request) {
 		unmaskedErrors := []string{
 			"mfa.validate_token.authenticate.app_error",
 			"api.user.check_user_mfa.bad_code.app_error",
+			"api.user.check_user_mfa.webauthn_required.app_error",
+			"app.webauthn.invalid_assertion.app_error",
+			"app.webauthn.invalid_challenge.app_error",
 			"api.user.login.blank_pwd.app_error",
 			"api.user.login.bot_login_forbidden.app_error",
 			"api.user.login.client_side_cert.certificate.app_error",
@@ -2078,6 +2108,28 @@ func getSessions(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write(js)
 }
 
+func getSessionsDetailed(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	sessions, appErr := c.App.GetSessionsDetailed(c.Params.UserId, c.AppContext.Session().Id)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(sessions); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func revokeSession(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -2151,6 +2203,33 @@ func revokeAllSessionsForUser(c *Context, w http.ResponseWriter, r *http.Request
 	ReturnStatusOK(w)
 }
 
+func revokeOtherSessions(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("revokeOtherSessions", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+
+	// Only the owner of the sessions has a current one to keep
+	if c.AppContext.Session().UserId != c.Params.UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if err := c.App.RevokeOtherSessions(c.Params.UserId, c.AppContext.Session().Id); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("")
+
+	ReturnStatusOK(w)
+}
+
 func revokeAllSessionsAllUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
 		c.SetPermissionError(model.PermissionManageSystem)
@@ -2261,6 +2340,75 @@ func getUserAudits(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getPushNotificationReceipts(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	receipts := c.App.GetPushNotificationReceipts(c.Params.UserId)
+	if err := json.NewEncoder(w).Encode(receipts); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getNotificationRules(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	rules, err := c.App.GetNotificationRules(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(rules); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateNotificationRules(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateNotificationRules", audit.Fail)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	var rules model.NotificationRules
+	if jsonErr := json.NewDecoder(r.Body).Decode(&rules); jsonErr != nil {
+		c.SetInvalidParamWithErr("notification_rules", jsonErr)
+		return
+	}
+
+	if err := c.App.UpdateNotificationRules(c.Params.UserId, rules); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
 func verifyUserEmail(c *Context, w http.ResponseWriter, r *http.Request) {
 	props := model.MapFromJSON(r.Body)
 
@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func (api *API) InitUserOffboarding() {
+	api.BaseRoutes.User.Handle("/offboarding", api.APISessionRequired(startUserOffboarding)).Methods("POST")
+	api.BaseRoutes.User.Handle("/offboarding", api.APISessionRequired(getUserOffboardingReports)).Methods("GET")
+}
+
+func startUserOffboarding(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var offboarding model.UserOffboardingRequest
+	if err := json.NewDecoder(r.Body).Decode(&offboarding); err != nil {
+		c.SetInvalidParamWithErr("offboarding", err)
+		return
+	}
+
+	if appErr := offboarding.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("startUserOffboarding", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "successor_id", offboarding.SuccessorId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementUsers)
+		return
+	}
+
+	job, appErr := c.App.StartUserOffboarding(c.AppContext, c.Params.UserId, offboarding.SuccessorId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(job)
+	auditRec.AddEventObjectType("job")
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(job); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getUserOffboardingReports(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementUsers)
+		return
+	}
+
+	reports, appErr := c.App.GetUserOffboardingReports(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(reports); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestUserOffboarding(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.CreateUser()
+
+	t.Run("only deactivated users can be offboarded", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.StartUserOffboarding(user.Id, th.BasicUser.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	_, err := th.SystemAdminClient.UpdateUserActive(user.Id, false)
+	require.NoError(t, err)
+
+	t.Run("only admins can offboard users", func(t *testing.T) {
+		_, resp, err := th.Client.StartUserOffboarding(user.Id, th.BasicUser.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetUserOffboardingReports(user.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid successor", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.StartUserOffboarding(user.Id, user.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	job, resp, err := th.SystemAdminClient.StartUserOffboarding(user.Id, th.BasicUser.Id)
+	require.NoError(t, err)
+	checkHTTPStatus(t, resp, http.StatusAccepted)
+	assert.Equal(t, model.JobTypeUserOffboarding, job.Type)
+	assert.Equal(t, th.BasicUser.Id, job.Data["successor_id"])
+
+	reports, _, err := th.SystemAdminClient.GetUserOffboardingReports(user.Id)
+	require.NoError(t, err)
+	require.NotEmpty(t, reports)
+	assert.Equal(t, job.Id, reports[0].JobId)
+	assert.Equal(t, th.BasicUser.Id, reports[0].SuccessorId)
+}
@@ -3347,6 +3347,56 @@ func TestGetSessions(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetSessionsDetailed(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.BasicUser
+
+	otherClient := th.CreateClient()
+	otherClient.HTTPHeader = map[string]string{
+		"User-Agent": "Mozilla/5.0 (iPhone; CPU iPhone OS 9_1 like Mac OS X) AppleWebKit/601.1.46 (KHTML, like Gecko) Version/9.0 Mobile/13B137 Safari/601.1",
+	}
+	_, _, err := otherClient.Login(user.Email, user.Password)
+	require.NoError(t, err)
+
+	sessions, _, err := th.Client.GetSessionsDetailed(user.Id)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(sessions), 2)
+
+	var current, other *model.SessionDetailed
+	for _, session := range sessions {
+		if session.IsCurrent {
+			require.Nil(t, current, "only one session should be current")
+			current = session
+		} else if session.Platform == "iPhone" {
+			other = session
+		}
+	}
+	require.NotNil(t, current)
+	require.NotNil(t, other)
+	assert.Equal(t, "iPhone", other.Platform)
+	assert.Equal(t, "iOS", other.Os)
+	assert.Equal(t, "phone", other.DeviceType)
+	assert.NotEmpty(t, other.IpAddress)
+
+	_, resp, err := th.Client.GetSessionsDetailed(th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	sessions, _, err = th.SystemAdminClient.GetSessionsDetailed(user.Id)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(sessions), 2)
+	for _, session := range sessions {
+		assert.False(t, session.IsCurrent)
+	}
+
+	th.Client.Logout()
+	_, resp, err = th.Client.GetSessionsDetailed(user.Id)
+	require.Error(t, err)
+	CheckUnauthorizedStatus(t, resp)
+}
+
 func TestRevokeSessions(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -3443,6 +3493,43 @@ func TestRevokeAllSessions(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestRevokeOtherSessions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.BasicUser
+
+	otherClient := th.CreateClient()
+	_, _, err := otherClient.Login(user.Email, user.Password)
+	require.NoError(t, err)
+
+	resp, err := th.Client.RevokeOtherSessions(th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	// Administrators have no current session of the user to keep
+	resp, err = th.SystemAdminClient.RevokeOtherSessions(user.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, err = th.Client.RevokeOtherSessions(user.Id)
+	require.NoError(t, err)
+
+	sessions, _, err := th.Client.GetSessionsDetailed(user.Id)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.True(t, sessions[0].IsCurrent)
+
+	_, resp, err = otherClient.GetMe("")
+	require.Error(t, err)
+	CheckUnauthorizedStatus(t, resp)
+
+	th.Client.Logout()
+	resp, err = th.Client.RevokeOtherSessions(user.Id)
+	require.Error(t, err)
+	CheckUnauthorizedStatus(t, resp)
+}
+
 func TestRevokeSessionsFromAllUsers(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -3561,6 +3648,60 @@ func TestGetUserAudits(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetPushNotificationReceipts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	receipts, _, err := th.Client.GetPushNotificationReceipts(th.BasicUser.Id)
+	require.NoError(t, err)
+	require.Empty(t, receipts)
+
+	_, resp, err := th.Client.GetPushNotificationReceipts(th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, _, err = th.SystemAdminClient.GetPushNotificationReceipts(th.BasicUser.Id)
+	require.NoError(t, err)
+
+	th.Client.Logout()
+	_, resp, err = th.Client.GetPushNotificationReceipts(th.BasicUser.Id)
+	require.Error(t, err)
+	CheckUnauthorizedStatus(t, resp)
+}
+
+func TestNotificationRules(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	rules, _, err := th.Client.GetNotificationRules(th.BasicUser.Id)
+	require.NoError(t, err)
+	require.Empty(t, rules)
+
+	rules = model.NotificationRules{{Type: model.NotificationRuleTypeKeywords, Keywords: []string{"deploy"}}}
+	_, err = th.Client.UpdateNotificationRules(th.BasicUser.Id, rules)
+	require.NoError(t, err)
+
+	rules, _, err = th.Client.GetNotificationRules(th.BasicUser.Id)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	require.Equal(t, []string{"deploy"}, rules[0].Keywords)
+
+	resp, err := th.Client.UpdateNotificationRules(th.BasicUser.Id, model.NotificationRules{{Type: model.NotificationRuleTypeRegex, Pattern: "(unclosed"}})
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp, err = th.Client.GetNotificationRules(th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	resp, err = th.Client.UpdateNotificationRules(th.BasicUser2.Id, model.NotificationRules{})
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, _, err = th.SystemAdminClient.GetNotificationRules(th.BasicUser.Id)
+	require.NoError(t, err)
+}
+
 func TestVerifyUserEmail(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
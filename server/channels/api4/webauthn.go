@@ -0,0 +1,298 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const (
+	webAuthnLoginRateLimitPerSec   = 1
+	webAuthnLoginRateLimitMaxBurst = 10
+)
+
+func (api *API) InitWebAuthn() {
+	rateLimiter, err := app.NewRateLimiter(&model.RateLimitSettings{
+		PerSec:           model.NewInt(webAuthnLoginRateLimitPerSec),
+		MaxBurst:         model.NewInt(webAuthnLoginRateLimitMaxBurst),
+		MemoryStoreSize:  model.NewInt(10000),
+		VaryByUser:       model.NewBool(false),
+		VaryByRemoteAddr: model.NewBool(true),
+	}, api.srv.Config().ServiceSettings.TrustedProxyIPHeader)
+	if err != nil {
+		mlog.Warn("Unable to create the WebAuthn login rate limiter, WebAuthn logins will not be rate limited", mlog.Err(err))
+	}
+
+	api.BaseRoutes.User.Handle("/webauthn/register/begin", api.APISessionRequiredMfa(beginWebAuthnRegistration)).Methods("POST")
+	api.BaseRoutes.User.Handle("/webauthn/register/finish", api.APISessionRequiredMfa(finishWebAuthnRegistration)).Methods("POST")
+	api.BaseRoutes.User.Handle("/webauthn/credentials", api.APISessionRequiredMfa(getWebAuthnCredentials)).Methods("GET")
+	api.BaseRoutes.User.Handle("/webauthn/credentials/{credential_id:[A-Za-z0-9]+}", api.APISessionRequiredMfa(updateWebAuthnCredential)).Methods("PUT")
+	api.BaseRoutes.User.Handle("/webauthn/credentials/{credential_id:[A-Za-z0-9]+}", api.APISessionRequiredMfa(deleteWebAuthnCredential)).Methods("DELETE")
+
+	api.BaseRoutes.Users.Handle("/login/webauthn/begin", api.APIHandler(rateLimitWebAuthnLogin(rateLimiter, beginWebAuthnLogin))).Methods("POST")
+	api.BaseRoutes.Users.Handle("/login/webauthn", api.APIHandler(loginWithWebAuthn)).Methods("POST")
+}
+
+// requireWebAuthnSelf makes sure the credentials are managed by their owner, through a session
+// of their own.
+func requireWebAuthnSelf(c *Context) {
+	if c.AppContext.Session().IsOAuth {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		c.Err.DetailedError += ", attempted access by oauth app"
+		return
+	}
+
+	if c.AppContext.Session().UserId != c.Params.UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+	}
+}
+
+func beginWebAuthnRegistration(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	requireWebAuthnSelf(c)
+	if c.Err != nil {
+		return
+	}
+
+	user, appErr := c.App.GetUser(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	options, appErr := c.App.BeginWebAuthnRegistration(user)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(options); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func finishWebAuthnRegistration(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	requireWebAuthnSelf(c)
+	if c.Err != nil {
+		return
+	}
+
+	var registration model.WebAuthnRegistrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&registration); err != nil {
+		c.SetInvalidParamWithErr("webauthn_registration", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("registerWebAuthnCredential", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	user, appErr := c.App.GetUser(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	audit.AddEventParameterAuditable(auditRec, "user", user)
+
+	credential, appErr := c.App.FinishWebAuthnRegistration(user, &registration)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("credential_id", credential.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(credential); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getWebAuthnCredentials(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	credentials, appErr := c.App.GetWebAuthnCredentials(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(credentials); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateWebAuthnCredential(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireCredentialId()
+	if c.Err != nil {
+		return
+	}
+
+	requireWebAuthnSelf(c)
+	if c.Err != nil {
+		return
+	}
+
+	props := model.MapFromJSON(r.Body)
+	name, ok := props["name"]
+	if !ok {
+		c.SetInvalidParam("name")
+		return
+	}
+
+	credential, appErr := c.App.UpdateWebAuthnCredentialName(c.Params.UserId, c.Params.CredentialId, name)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(credential); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteWebAuthnCredential(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireCredentialId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteWebAuthnCredential", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "credential_id", c.Params.CredentialId)
+
+	if c.AppContext.Session().IsOAuth {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		c.Err.DetailedError += ", attempted access by oauth app"
+		return
+	}
+
+	// Administrators can remove the keys of users who lost them
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if appErr := c.App.DeleteWebAuthnCredential(c.Params.UserId, c.Params.CredentialId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+// rateLimitWebAuthnLogin limits by address the unauthenticated requests that save a challenge.
+func rateLimitWebAuthnLogin(rateLimiter *app.RateLimiter, h handlerFunc) handlerFunc {
+	return func(c *Context, w http.ResponseWriter, r *http.Request) {
+		if rateLimiter != nil && rateLimiter.RateLimitWriter(c.AppContext.IPAddress(), w) {
+			return
+		}
+		h(c, w, r)
+	}
+}
+
+func beginWebAuthnLogin(c *Context, w http.ResponseWriter, r *http.Request) {
+	var begin model.WebAuthnLoginBeginRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&begin); err != nil {
+			c.SetInvalidParamWithErr("webauthn_login", err)
+			return
+		}
+	}
+
+	options, appErr := c.App.BeginWebAuthnLogin(begin.LoginId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(options); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func loginWithWebAuthn(c *Context, w http.ResponseWriter, r *http.Request) {
+	var login model.WebAuthnLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&login); err != nil {
+		c.SetInvalidParamWithErr("webauthn_login", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("loginWithWebAuthn", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "device_id", login.DeviceId)
+
+	user, appErr := c.App.AuthenticateUserForWebAuthnLogin(c.AppContext, login.Credential)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddEventResultState(user)
+
+	if user.IsGuest() {
+		if c.App.Channels().License() == nil {
+			c.Err = model.NewAppError("loginWithWebAuthn", "api.user.login.guest_accounts.license.error", nil, "", http.StatusUnauthorized)
+			return
+		}
+		if !*c.App.Config().GuestAccountsSettings.Enable {
+			c.Err = model.NewAppError("loginWithWebAuthn", "api.user.login.guest_accounts.disabled.error", nil, "", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	c.LogAuditWithUserId(user.Id, "authenticated with a passkey")
+
+	if appErr = c.App.DoLogin(c.AppContext, w, r, user, login.DeviceId, false, false, false); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if r.Header.Get(model.HeaderRequestedWith) == model.HeaderRequestedWithXML {
+		c.App.AttachSessionCookies(c.AppContext, w, r)
+	}
+
+	userTermsOfService, appErr := c.App.GetUserTermsOfService(user.Id)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		c.Err = appErr
+		return
+	}
+
+	if userTermsOfService != nil {
+		user.TermsOfServiceId = userTermsOfService.TermsOfServiceId
+		user.TermsOfServiceCreateAt = userTermsOfService.CreateAt
+	}
+
+	user.Sanitize(map[string]bool{})
+
+	auditRec.Success()
+	if err := json.NewEncoder(w).Encode(user); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
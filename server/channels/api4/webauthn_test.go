@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestWebAuthn(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	client := th.Client
+	userId := th.BasicUser.Id
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := client.BeginWebAuthnRegistration(userId)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.SiteURL = "https://chat.example.com"
+		*cfg.WebAuthnSettings.Enable = true
+	})
+
+	t.Run("begin registration", func(t *testing.T) {
+		options, _, err := client.BeginWebAuthnRegistration(userId)
+		require.NoError(t, err)
+		assert.NotEmpty(t, options.Challenge)
+		assert.Equal(t, "chat.example.com", options.RP.Id)
+		assert.Equal(t, th.BasicUser.Username, options.User.Name)
+		assert.NotEmpty(t, options.PubKeyCredParams)
+		assert.Equal(t, model.WebAuthnAttestationNone, options.Attestation)
+
+		_, resp, err := client.BeginWebAuthnRegistration(th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		// Not even administrators register keys for others
+		_, resp, err = th.SystemAdminClient.BeginWebAuthnRegistration(userId)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("finish registration with an invalid credential", func(t *testing.T) {
+		_, resp, err := client.FinishWebAuthnRegistration(userId, &model.WebAuthnRegistrationRequest{Name: "Key"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("get credentials", func(t *testing.T) {
+		credential, err := th.App.Srv().Store().WebAuthnCredential().Save(&model.WebAuthnCredential{
+			UserId:       userId,
+			CredentialId: model.NewRandomString(43),
+			PublicKey:    []byte{0xa5},
+			Name:         "Key",
+		})
+		require.NoError(t, err)
+
+		credentials, _, err := client.GetWebAuthnCredentials(userId)
+		require.NoError(t, err)
+		require.Len(t, credentials, 1)
+		assert.Equal(t, credential.Id, credentials[0].Id)
+		assert.Empty(t, credentials[0].PublicKey)
+
+		_, resp, err := client.GetWebAuthnCredentials(th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		credentials, _, err = th.SystemAdminClient.GetWebAuthnCredentials(userId)
+		require.NoError(t, err)
+		require.Len(t, credentials, 1)
+
+		renamed, _, err := client.UpdateWebAuthnCredentialName(userId, credential.Id, "Laptop")
+		require.NoError(t, err)
+		assert.Equal(t, "Laptop", renamed.Name)
+
+		resp, err = client.DeleteWebAuthnCredential(th.BasicUser2.Id, credential.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		// Administrators can remove the keys of users who lost them
+		_, err = th.SystemAdminClient.DeleteWebAuthnCredential(userId, credential.Id)
+		require.NoError(t, err)
+
+		resp, err = client.DeleteWebAuthnCredential(userId, credential.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("passkey login", func(t *testing.T) {
+		th.Client.Logout()
+		defer th.LoginBasic()
+
+		_, resp, err := client.BeginWebAuthnLogin("")
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+
+		options, _, err := client.BeginWebAuthnLogin(th.BasicUser.Email)
+		require.NoError(t, err)
+		assert.Equal(t, "chat.example.com", options.RPId)
+		// Users without credentials are given made up ones, like unknown users
+		require.Len(t, options.AllowCredentials, 1)
+
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.WebAuthnSettings.EnablePasskeyLogin = true
+		})
+
+		options, _, err = client.BeginWebAuthnLogin("")
+		require.NoError(t, err)
+		assert.Equal(t, model.WebAuthnUserVerificationRequired, options.UserVerification)
+
+		_, resp, err = client.LoginWithWebAuthn(&model.WebAuthnAssertionResponse{Id: model.NewRandomString(43)}, "")
+		require.Error(t, err)
+		CheckUnauthorizedStatus(t, resp)
+	})
+
+	t.Run("login rate limit", func(t *testing.T) {
+		var throttled bool
+		for i := 0; i < 2*webAuthnLoginRateLimitMaxBurst && !throttled; i++ {
+			_, resp, _ := client.BeginWebAuthnLogin(th.BasicUser.Email)
+			throttled = resp.StatusCode == http.StatusTooManyRequests
+		}
+		require.True(t, throttled)
+	})
+}
@@ -25,6 +25,12 @@ func (api *API) InitWebhook() {
 	api.BaseRoutes.OutgoingHook.Handle("", api.APISessionRequired(updateOutgoingHook)).Methods("PUT")
 	api.BaseRoutes.OutgoingHook.Handle("", api.APISessionRequired(deleteOutgoingHook)).Methods("DELETE")
 	api.BaseRoutes.OutgoingHook.Handle("/regen_token", api.APISessionRequired(regenOutgoingHookToken)).Methods("POST")
+
+	api.BaseRoutes.DirectOutgoingHooks.Handle("", api.APISessionRequired(createDirectOutgoingHook)).Methods("POST")
+	api.BaseRoutes.DirectOutgoingHooks.Handle("", api.APISessionRequired(getDirectOutgoingHooks)).Methods("GET")
+	api.BaseRoutes.DirectOutgoingHook.Handle("", api.APISessionRequired(getDirectOutgoingHook)).Methods("GET")
+	api.BaseRoutes.DirectOutgoingHook.Handle("", api.APISessionRequired(updateDirectOutgoingHook)).Methods("PUT")
+	api.BaseRoutes.DirectOutgoingHook.Handle("", api.APISessionRequired(deleteDirectOutgoingHook)).Methods("DELETE")
 }
 
 func createIncomingHook(c *Context, w http.ResponseWriter, r *http.Request) {
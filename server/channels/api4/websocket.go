@@ -16,6 +16,7 @@ import (
 const (
 	connectionIDParam   = "connection_id"
 	sequenceNumberParam = "sequence_number"
+	encodingParam       = "encoding"
 )
 
 func (api *API) InitWebSocket() {
@@ -24,10 +25,28 @@ func (api *API) InitWebSocket() {
 }
 
 func connectWebSocket(c *Context, w http.ResponseWriter, r *http.Request) {
+	encoding := r.URL.Query().Get(encodingParam)
+	switch encoding {
+	case "":
+		encoding = model.WebSocketEncodingJSON
+	case model.WebSocketEncodingJSON, model.WebSocketEncodingMsgpack:
+	default:
+		c.SetInvalidURLParam(encodingParam)
+		return
+	}
+
+	// A draining node refuses the new connections, for the clients to connect to the other nodes.
+	if c.App.Srv().IsDraining() {
+		c.Err = model.NewAppError("connect", "api.web_socket.connect.draining.app_error", nil, "", http.StatusServiceUnavailable)
+		return
+	}
+
 	upgrader := websocket.Upgrader{
 		ReadBufferSize:  model.SocketMaxMessageSizeKb,
 		WriteBufferSize: model.SocketMaxMessageSizeKb,
 		CheckOrigin:     c.App.OriginChecker(),
+		// Compression is only used if the client also asks for permessage-deflate.
+		EnableCompression: *c.App.Config().ServiceSettings.EnableWebSocketCompression,
 	}
 
 	ws, err := upgrader.Upgrade(w, r, nil)
@@ -44,6 +63,10 @@ func connectWebSocket(c *Context, w http.ResponseWriter, r *http.Request) {
 		TFunc:     c.AppContext.T,
 		Locale:    "",
 		Active:    true,
+		Encoding:  encoding,
+
+		IPAddress:           c.AppContext.IPAddress(),
+		IPAccessBypassToken: r.Header.Get(model.HeaderIPAccessBypassToken),
 	}
 
 	cfg.ConnectionID = r.URL.Query().Get(connectionIDParam)
@@ -277,6 +277,113 @@ func TestWebSocketSendBinary(t *testing.T) {
 	require.Equal(t, model.StatusOnline, status)
 }
 
+func TestWebSocketMsgpackEncoding(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	url := fmt.Sprintf("ws://localhost:%v", th.App.Srv().ListenAddr.Port)
+
+	t.Run("invalid encoding", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(url+model.APIURLSuffix+"/websocket?encoding=xml", nil)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("msgpack", func(t *testing.T) {
+		WebSocketClient, err := model.NewWebSocketClientWithEncoding(websocket.DefaultDialer, url, th.Client.AuthToken, model.WebSocketEncodingMsgpack)
+		require.NoError(t, err)
+		defer WebSocketClient.Close()
+		WebSocketClient.Listen()
+
+		resp := <-WebSocketClient.ResponseChannel
+		require.Equal(t, model.StatusOk, resp.Status)
+
+		hello := <-WebSocketClient.EventChannel
+		require.Equal(t, model.WebsocketEventHello, hello.EventType())
+	})
+
+	t.Run("compression", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableWebSocketCompression = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableWebSocketCompression = false })
+
+		dialer := &websocket.Dialer{EnableCompression: true}
+		WebSocketClient, err := model.NewWebSocketClientWithEncoding(dialer, url, th.Client.AuthToken, model.WebSocketEncodingMsgpack)
+		require.NoError(t, err)
+		defer WebSocketClient.Close()
+		WebSocketClient.Listen()
+
+		resp := <-WebSocketClient.ResponseChannel
+		require.Equal(t, model.StatusOk, resp.Status)
+	})
+}
+
+func TestWebSocketPresenceSubscribe(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	WebSocketClient, err := th.CreateWebSocketClient()
+	require.NoError(t, err)
+	defer WebSocketClient.Close()
+	WebSocketClient.Listen()
+
+	resp := <-WebSocketClient.ResponseChannel
+	require.Equal(t, model.StatusOk, resp.Status)
+
+	t.Run("too many users", func(t *testing.T) {
+		userIds := make([]string, model.PresenceSubscriptionsMaxUsers+1)
+		for i := range userIds {
+			userIds[i] = model.NewId()
+		}
+		WebSocketClient.SubscribePresence(userIds)
+		resp := <-WebSocketClient.ResponseChannel
+		require.Equal(t, model.StatusFail, resp.Status)
+	})
+
+	t.Run("receives status changes of subscribed users", func(t *testing.T) {
+		th.App.Srv().Platform().SetStatusOnline(th.BasicUser2.Id, true)
+
+		WebSocketClient.SubscribePresence([]string{th.BasicUser2.Id})
+		resp := <-WebSocketClient.ResponseChannel
+		require.Equal(t, model.StatusOk, resp.Status)
+		require.Equal(t, model.StatusOnline, resp.Data[th.BasicUser2.Id])
+
+		th.App.Srv().Platform().SetStatusDoNotDisturb(th.BasicUser2.Id)
+
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case event := <-WebSocketClient.EventChannel:
+				if event.EventType() == model.WebsocketEventStatusChange && event.GetData()["user_id"] == th.BasicUser2.Id {
+					require.Equal(t, model.StatusDnd, event.GetData()["status"])
+					return
+				}
+			case <-timeout:
+				require.Fail(t, "did not receive the status change")
+			}
+		}
+	})
+
+	t.Run("unsubscribed users are not pushed", func(t *testing.T) {
+		WebSocketClient.SubscribePresence([]string{})
+		resp := <-WebSocketClient.ResponseChannel
+		require.Equal(t, model.StatusOk, resp.Status)
+
+		th.App.Srv().Platform().SetStatusOnline(th.BasicUser2.Id, true)
+
+		timeout := time.After(time.Second)
+		for {
+			select {
+			case event := <-WebSocketClient.EventChannel:
+				if event.EventType() == model.WebsocketEventStatusChange {
+					require.NotEqual(t, th.BasicUser2.Id, event.GetData()["user_id"])
+				}
+			case <-timeout:
+				return
+			}
+		}
+	})
+}
+
 func TestWebSocketStatuses(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
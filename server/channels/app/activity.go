@@ -0,0 +1,238 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// GetActivityFeed returns a page of the activity feed of the user along with the posts the
+// activities are about. Activities in channels the user left, or about posts they can no longer
+// see, are left out of the page.
+func (a *App) GetActivityFeed(c request.CTX, userID string, options model.ActivityGetOptions) (*model.ActivityFeed, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableActivityFeed {
+		return nil, model.NewAppError("GetActivityFeed", "app.activity.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if options.PerPage <= 0 {
+		options.PerPage = model.ActivityFeedDefaultPerPage
+	} else if options.PerPage > model.ActivityFeedMaxPerPage {
+		options.PerPage = model.ActivityFeedMaxPerPage
+	}
+
+	// Get one more activity to find out whether there's a next page
+	perPage := options.PerPage
+	options.PerPage++
+	activities, err := a.Srv().Store().Activity().GetForUser(userID, options)
+	if err != nil {
+		return nil, model.NewAppError("GetActivityFeed", "app.activity.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	feed := &model.ActivityFeed{
+		Activities: []*model.Activity{},
+		Posts:      model.NewPostList(),
+	}
+	if len(activities) > perPage {
+		feed.HasNext = true
+		activities = activities[:perPage]
+	}
+
+	feed.UnreadCount, err = a.Srv().Store().Activity().GetUnreadCountForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetActivityFeed", "app.activity.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if len(activities) == 0 {
+		return feed, nil
+	}
+
+	memberships, err := a.Srv().Store().Channel().GetAllChannelMembersForUser(userID, true, true)
+	if err != nil {
+		return nil, model.NewAppError("GetActivityFeed", "app.channel.get_channels.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	postIDs := []string{}
+	for _, activity := range activities {
+		if activity.PostId != "" {
+			postIDs = append(postIDs, activity.PostId)
+		}
+	}
+
+	if len(postIDs) > 0 {
+		posts, err := a.Srv().Store().Post().GetPostsByIds(postIDs)
+		if err != nil {
+			return nil, model.NewAppError("GetActivityFeed", "app.post.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, post := range posts {
+			if post.DeleteAt == 0 {
+				feed.Posts.AddPost(post)
+				feed.Posts.AddOrder(post.Id)
+			}
+		}
+
+		if appErr := a.filterInaccessiblePosts(feed.Posts, filterPostOptions{}); appErr != nil {
+			return nil, appErr
+		}
+
+		if appErr := a.FilterRestrictedPostList(userID, feed.Posts); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	for _, activity := range activities {
+		if _, ok := memberships[activity.ChannelId]; activity.ChannelId != "" && !ok {
+			continue
+		}
+		if _, ok := feed.Posts.Posts[activity.PostId]; activity.PostId != "" && !ok {
+			continue
+		}
+		feed.Activities = append(feed.Activities, activity)
+	}
+
+	return feed, nil
+}
+
+// MarkActivitiesAsRead marks activities of the feed of the user, or all of them, as read.
+func (a *App) MarkActivitiesAsRead(c request.CTX, userID string, readRequest *model.ActivityReadRequest) *model.AppError {
+	if !*a.Config().ServiceSettings.EnableActivityFeed {
+		return model.NewAppError("MarkActivitiesAsRead", "app.activity.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	readAt := model.GetMillis()
+	var err error
+	if readRequest.All {
+		err = a.Srv().Store().Activity().MarkAllAsRead(userID, readAt)
+	} else {
+		err = a.Srv().Store().Activity().MarkAsRead(userID, readRequest.ActivityIds, readAt)
+	}
+	if err != nil {
+		return model.NewAppError("MarkActivitiesAsRead", "app.activity.mark_read.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// Let the other sessions of the user update their feed
+	message := model.NewWebSocketEvent(model.WebsocketEventActivitiesRead, "", "", userID, nil, "")
+	message.Add("activity_ids", model.ArrayToJSON(readRequest.ActivityIds))
+	message.Add("all", readRequest.All)
+	message.Add("read_at", readAt)
+	a.Publish(message)
+
+	return nil
+}
+
+// AddActivities adds the activities to the feeds of their users, and lets them know. Nothing is
+// added while the activity feed is disabled.
+func (a *App) AddActivities(activities []*model.Activity) *model.AppError {
+	if !*a.Config().ServiceSettings.EnableActivityFeed || len(activities) == 0 {
+		return nil
+	}
+
+	if err := a.Srv().Store().Activity().Save(activities); err != nil {
+		var appErr *model.AppError
+		if errors.As(err, &appErr) {
+			return appErr
+		}
+		return model.NewAppError("AddActivities", "app.activity.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, activity := range activities {
+		activityJSON, err := json.Marshal(activity)
+		if err != nil {
+			mlog.Warn("Failed to encode activity to JSON", mlog.Err(err))
+			continue
+		}
+
+		message := model.NewWebSocketEvent(model.WebsocketEventActivityAdded, "", "", activity.UserId, nil, "")
+		message.Add("activity", string(activityJSON))
+		a.Publish(message)
+	}
+
+	return nil
+}
+
+// addPostActivities adds a new post to the activity feeds of the users it mentions and, when it's
+// a reply, of the other users following the thread. Channel-wide mentions and direct messages
+// are left out as they'd flood the feeds.
+func (a *App) addPostActivities(post *model.Post, channel *model.Channel, mentions *ExplicitMentions, followers []string) {
+	if post.IsSystemMessage() {
+		return
+	}
+
+	newActivity := func(userID, activityType string) *model.Activity {
+		return &model.Activity{
+			UserId:    userID,
+			Type:      activityType,
+			ActorId:   post.UserId,
+			TeamId:    channel.TeamId,
+			ChannelId: post.ChannelId,
+			PostId:    post.Id,
+			RootId:    post.RootId,
+		}
+	}
+
+	activities := []*model.Activity{}
+	added := map[string]bool{post.UserId: true}
+	for userID, mentionType := range mentions.Mentions {
+		if added[userID] {
+			continue
+		}
+
+		switch mentionType {
+		case KeywordMention, GroupMention:
+			activities = append(activities, newActivity(userID, model.ActivityTypeMention))
+		case ThreadMention, CommentMention:
+			activities = append(activities, newActivity(userID, model.ActivityTypeThreadReply))
+		default:
+			continue
+		}
+		added[userID] = true
+	}
+
+	if post.RootId != "" {
+		for _, userID := range followers {
+			if added[userID] {
+				continue
+			}
+			activities = append(activities, newActivity(userID, model.ActivityTypeThreadReply))
+			added[userID] = true
+		}
+	}
+
+	if appErr := a.AddActivities(activities); appErr != nil {
+		mlog.Warn("Failed to add post to the activity feeds", mlog.String("post_id", post.Id), mlog.Err(appErr))
+	}
+}
+
+// addReactionActivity adds a reaction to the activity feed of the author of the post.
+func (a *App) addReactionActivity(reaction *model.Reaction, post *model.Post, channel *model.Channel) {
+	if reaction.UserId == post.UserId || post.IsSystemMessage() {
+		return
+	}
+
+	activity := &model.Activity{
+		UserId:    post.UserId,
+		Type:      model.ActivityTypeReaction,
+		ActorId:   reaction.UserId,
+		TeamId:    channel.TeamId,
+		ChannelId: post.ChannelId,
+		PostId:    post.Id,
+		RootId:    post.RootId,
+		Props:     model.StringMap{model.ActivityPropEmojiName: reaction.EmojiName},
+	}
+
+	if appErr := a.AddActivities([]*model.Activity{activity}); appErr != nil {
+		mlog.Warn("Failed to add reaction to the activity feed", mlog.String("post_id", post.Id), mlog.Err(appErr))
+	}
+}
+
+func (a *App) deletePostActivities(postID string) {
+	if err := a.Srv().Store().Activity().DeleteForPost(postID); err != nil {
+		a.Log().Warn("Encountered error when deleting the activities of post", mlog.String("post_id", postID), mlog.Err(err))
+	}
+}
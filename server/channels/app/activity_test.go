@@ -0,0 +1,88 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestActivityFeed(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("disabled", func(t *testing.T) {
+		_, appErr := th.App.GetActivityFeed(th.Context, th.BasicUser.Id, model.ActivityGetOptions{})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.activity.disabled.app_error", appErr.Id)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableActivityFeed = true
+	})
+
+	getFeed := func(options model.ActivityGetOptions) *model.ActivityFeed {
+		feed, appErr := th.App.GetActivityFeed(th.Context, th.BasicUser.Id, options)
+		require.Nil(t, appErr)
+		return feed
+	}
+
+	t.Run("mention", func(t *testing.T) {
+		post, appErr := th.App.CreatePost(th.Context, &model.Post{
+			UserId:    th.BasicUser2.Id,
+			ChannelId: th.BasicChannel.Id,
+			Message:   "hello @" + th.BasicUser.Username,
+		}, th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		require.Eventually(t, func() bool {
+			return len(getFeed(model.ActivityGetOptions{}).Activities) == 1
+		}, 5*time.Second, 100*time.Millisecond)
+
+		feed := getFeed(model.ActivityGetOptions{})
+		assert.Equal(t, model.ActivityTypeMention, feed.Activities[0].Type)
+		assert.Equal(t, th.BasicUser2.Id, feed.Activities[0].ActorId)
+		assert.Contains(t, feed.Posts.Posts, post.Id)
+		assert.EqualValues(t, 1, feed.UnreadCount)
+	})
+
+	t.Run("reaction", func(t *testing.T) {
+		post := th.CreatePost(th.BasicChannel)
+		_, appErr := th.App.SaveReactionForPost(th.Context, &model.Reaction{
+			UserId:    th.BasicUser2.Id,
+			PostId:    post.Id,
+			EmojiName: "smile",
+		})
+		require.Nil(t, appErr)
+
+		options := model.ActivityGetOptions{Types: []string{model.ActivityTypeReaction}}
+		require.Eventually(t, func() bool {
+			return len(getFeed(options).Activities) == 1
+		}, 5*time.Second, 100*time.Millisecond)
+		assert.Equal(t, "smile", getFeed(options).Activities[0].Props[model.ActivityPropEmojiName])
+
+		t.Run("deleted post", func(t *testing.T) {
+			_, appErr := th.App.DeletePost(th.Context, post.Id, th.BasicUser.Id)
+			require.Nil(t, appErr)
+			assert.Empty(t, getFeed(options).Activities)
+		})
+	})
+
+	t.Run("mark as read", func(t *testing.T) {
+		feed := getFeed(model.ActivityGetOptions{UnreadOnly: true})
+		require.NotEmpty(t, feed.Activities)
+
+		appErr := th.App.MarkActivitiesAsRead(th.Context, th.BasicUser.Id, &model.ActivityReadRequest{All: true})
+		require.Nil(t, appErr)
+
+		feed = getFeed(model.ActivityGetOptions{UnreadOnly: true})
+		assert.Empty(t, feed.Activities)
+		assert.Zero(t, feed.UnreadCount)
+	})
+}
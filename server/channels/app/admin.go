@@ -141,6 +141,14 @@ func (s *Server) InvalidateAllCaches() *model.AppError {
 	return s.platform.InvalidateAllCaches()
 }
 
+func (s *Server) GetCaches() ([]*model.CacheInfo, *model.AppError) {
+	return s.platform.GetCaches()
+}
+
+func (s *Server) FlushCache(name string) *model.AppError {
+	return s.platform.FlushCache(name)
+}
+
 func (s *Server) InvalidateAllCachesSkipSend() {
 	s.platform.InvalidateAllCachesSkipSend()
 
@@ -29,6 +29,7 @@ import (
 	"github.com/mattermost/mattermost-server/v6/server/channels/store"
 	"github.com/mattermost/mattermost-server/v6/server/platform/services/httpservice"
 	"github.com/mattermost/mattermost-server/v6/server/platform/services/imageproxy"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/matrix"
 	"github.com/mattermost/mattermost-server/v6/server/platform/services/remotecluster"
 	"github.com/mattermost/mattermost-server/v6/server/platform/services/searchengine"
 	"github.com/mattermost/mattermost-server/v6/server/platform/services/timezones"
@@ -46,6 +47,9 @@ type AppIface interface {
 	ListAutocompleteCommands(teamID string, T i18n.TranslateFunc) ([]*model.Command, *model.AppError)
 	// @openTracingParams teamID, skipSlackParsing
 	CreateCommandPost(c request.CTX, post *model.Post, teamID string, response *model.CommandResponse, skipSlackParsing bool) (*model.Post, *model.AppError)
+	// AddActivities adds the activities to the feeds of their users, and lets them know. Nothing is
+	// added while the activity feed is disabled.
+	AddActivities(activities []*model.Activity) *model.AppError
 	// AddChannelMember adds a user to a channel. It is a wrapper over AddUserToChannel.
 	AddChannelMember(c request.CTX, userID string, channel *model.Channel, opts ChannelMemberOpts) (*model.ChannelMember, *model.AppError)
 	// AddCursorIdsForPostList adds NextPostId and PrevPostId as cursor to the PostList.
@@ -56,6 +60,28 @@ type AppIface interface {
 	AddPublicKey(name string, key io.Reader) *model.AppError
 	// AddUserToChannel adds a user to a given channel.
 	AddUserToChannel(c request.CTX, user *model.User, channel *model.Channel, skipTeamMemberIntegrityCheck bool) (*model.ChannelMember, *model.AppError)
+	// ArchivePosts moves the posts from the database to the post archive, writing a segment of the
+	// archive per channel. The posts are only deleted from the database once the segments holding
+	// them have been written, along with their locations.
+	ArchivePosts(posts []*model.Post) *model.AppError
+	// ArchiveTeam hides the team right away and starts a team_archive job freezing its channels and
+	// suspending the pending jobs referencing it. Archiving a team whose archive job failed starts
+	// a new job.
+	ArchiveTeam(c request.CTX, teamID, archiverID string) (*model.TeamArchive, *model.AppError)
+	// AuthenticateUserForWebAuthnLogin returns the user owning the passkey that signed the response,
+	// after checking the user is allowed to sign in. The authenticator must have verified the user,
+	// the passkey replacing both the password and the second factor.
+	AuthenticateUserForWebAuthnLogin(c *request.Context, response *model.WebAuthnAssertionResponse) (*model.User, *model.AppError)
+	// BeginWebAuthnLogin returns the options to sign in with. When a login id is given, the
+	// credentials of the user are listed for them to be used as a second factor. Otherwise the client
+	// is asking for a passkey to sign in with alone.
+	BeginWebAuthnLogin(loginID string) (*model.WebAuthnRequestOptions, *model.AppError)
+	// BeginWebAuthnRegistration returns the options for the user to create a new credential with.
+	// Like MFA, credentials can only be registered by the users signing in with a password.
+	BeginWebAuthnRegistration(user *model.User) (*model.WebAuthnCreationOptions, *model.AppError)
+	// BridgeChannelToMatrix bridges the channel to the Matrix room. The application service must be able
+	// to join the room, which usually means the room is public or its users have been invited.
+	BridgeChannelToMatrix(c request.CTX, channelID, roomID, creatorID string) (*model.MatrixRoom, *model.AppError)
 	// Caller must close the first return value
 	FileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
 	// ChannelMembersMinusGroupMembers returns the set of users in the given channel minus the set of users in the given
@@ -71,10 +97,17 @@ type AppIface interface {
 	// If includeRemovedMembers is true, then channel members who left or were removed from the channel will
 	// be included; otherwise, they will be excluded.
 	ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, *model.AppError)
+	// CheckIPAccess evaluates the IP access policies of the given system roles against the address
+	// the request was made from. Requests presenting the emergency bypass token are let through
+	// regardless. Both denials and bypasses are audited.
+	CheckIPAccess(c *request.Context, userID string, roles string, bypassToken string) *model.AppError
 	// CheckProviderAttributes returns the empty string if the patch can be applied without
 	// overriding attributes set by the user's login provider; otherwise, the name of the offending
 	// field is returned.
 	CheckProviderAttributes(user *model.User, patch *model.UserPatch) string
+	// CheckWebSocketIPAccess evaluates the IP access policies for a session authenticating over an
+	// already upgraded websocket connection, from the address the connection was opened from.
+	CheckWebSocketIPAccess(session *model.Session, ipAddress string, bypassToken string) *model.AppError
 	// CommandsForTeam returns all the plugin and product commands for the given team.
 	CommandsForTeam(teamID string) []*model.Command
 	// ComputeLastAccessibleFileTime updates cache with CreateAt time of the last accessible file as per the cloud plan's limit.
@@ -91,21 +124,57 @@ type AppIface interface {
 	SendSubscriptionHistoryEvent(userID string) (*model.SubscriptionHistory, error)
 	// CreateBot creates the given bot and corresponding user.
 	CreateBot(c request.CTX, bot *model.Bot) (*model.Bot, *model.AppError)
+	// CreateChannelBookmark adds a bookmark after the existing ones of its channel.
+	CreateChannelBookmark(c request.CTX, bookmark *model.ChannelBookmark, connectionID string) (*model.ChannelBookmarkWithFileInfo, *model.AppError)
 	// CreateChannelScheme creates a new Scheme of scope channel and assigns it to the channel.
 	CreateChannelScheme(c request.CTX, channel *model.Channel) (*model.Scheme, *model.AppError)
+	// CreateCustomAdminRole creates a delegated administration role granting the permissions of the
+	// requested capability sets, optionally restricted to some teams.
+	CreateCustomAdminRole(customRole *model.CustomAdminRole) (*model.Role, *model.AppError)
 	// CreateDefaultMemberships adds users to teams and channels based on their group memberships and how those groups
 	// are configured to sync with teams and channels for group members on or after the given timestamp.
 	// If includeRemovedMembers is true, then members who left or were removed from a team/channel will
 	// be re-added; otherwise, they will not be re-added.
 	CreateDefaultMemberships(c *request.Context, params model.CreateDefaultMembershipParams) error
+	// CreateDirectOutgoingWebhook creates an outgoing webhook triggered by the direct and group
+	// messages the hook's bot is a member of.
+	CreateDirectOutgoingWebhook(hook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, *model.AppError)
+	// CreateJob creates a pending job, with the priority of its type unless the job has its own.
+	CreateJob(job *model.Job) (*model.Job, *model.AppError)
 	// CreateGuest creates a guest and sets several fields of the returned User struct to
 	// their zero values.
 	CreateGuest(c request.CTX, user *model.User) (*model.User, *model.AppError)
+	// CreateSCIMGroup provisions a group. A group previously deleted with the same external id is
+	// restored instead.
+	CreateSCIMGroup(scimGroup *model.SCIMGroup) (*model.Group, []*model.User, *model.AppError)
+	// CreateSCIMUser provisions a user signing in with the authentication service configured for
+	// SCIM. The user's auth data is the external id given by the identity provider, or its user name
+	// if there is none.
+	CreateSCIMUser(c request.CTX, scimUser *model.SCIMUser) (*model.User, *model.AppError)
+	// CreateSavedPostLabel creates a label for the user to file their saved posts under.
+	CreateSavedPostLabel(userID string, label *model.SavedPostLabel) (*model.SavedPostLabel, *model.AppError)
+	// CreateScheduledPost stores a message to be posted on behalf of its author at its scheduled time.
+	CreateScheduledPost(c request.CTX, scheduledPost *model.ScheduledPost, connectionID string) (*model.ScheduledPost, *model.AppError)
+	// CreateSupportAccessSession opens a session of the user for the admin, under the active consent
+	// the user granted them. The session has the roles of the user, is read-only and ends when the
+	// consent expires.
+	CreateSupportAccessSession(c request.CTX, userID, adminID string) (*model.Session, *model.AppError)
+	// CreateTeamTemplate saves a new team template.
+	CreateTeamTemplate(template *model.TeamTemplate) (*model.TeamTemplate, *model.AppError)
 	// CreateUser creates a user and sets several fields of the returned User struct to
 	// their zero values.
 	CreateUser(c request.CTX, user *model.User) (*model.User, *model.AppError)
 	// Creates and stores FileInfos for a post created before the FileInfos table existed.
 	MigrateFilenamesToFileInfos(post *model.Post) []*model.FileInfo
+	// DeactivateExpiredGuests deactivates every active guest whose sponsorship has expired.
+	DeactivateExpiredGuests(c request.CTX) *model.AppError
+	// DecideChannelJoinRequest approves or denies a pending request to join a channel, adding the
+	// user to the channel when approved, and records who made the decision.
+	DecideChannelJoinRequest(c *request.Context, joinRequest *model.ChannelJoinRequest, deciderID string, approve bool) (*model.ChannelJoinRequest, *model.AppError)
+	// DecodeIncomingWebhookPayload decodes the payload posted to an incoming webhook. The payload of a
+	// hook with a message template can be any JSON document, rendered by the template into the text of
+	// the post.
+	DecodeIncomingWebhookPayload(hookID string, payload io.Reader) (*model.IncomingWebhookRequest, *model.AppError)
 	// DefaultChannelNames returns the list of system-wide default channel names.
 	//
 	// By default the list will be (not necessarily in this order):
@@ -117,13 +186,34 @@ type AppIface interface {
 	//
 	//	['town-square', 'game-of-thrones', 'wow']
 	DefaultChannelNames(c request.CTX) []string
+	// DeleteCalendarConnection removes the tokens the user granted to read their calendar from the service.
+	DeleteCalendarConnection(userID, service string) *model.AppError
+	// DeleteChannelBookmark removes a bookmark from its channel. The bookmarked file isn't deleted.
+	DeleteChannelBookmark(bookmarkID, connectionID string) (*model.ChannelBookmarkWithFileInfo, *model.AppError)
+	// DeleteChannelMemberExpiry makes the membership of the user in the channel permanent again.
+	DeleteChannelMemberExpiry(channelID, userID string) *model.AppError
 	// DeleteChannelScheme deletes a channels scheme and sets its SchemeId to nil.
 	DeleteChannelScheme(c request.CTX, channel *model.Channel) (*model.Channel, *model.AppError)
+	// DeleteCustomAdminRole soft deletes a delegated administration role. Users keep the role name
+	// in their roles, but deleted roles no longer grant any permission.
+	DeleteCustomAdminRole(roleID string) (*model.Role, *model.AppError)
+	// DeleteExpiredPosts deletes the posts whose expiry has passed, as if their author deleted them.
+	// The message of a deleted post is kept, so that expired posts are still included in the
+	// compliance exports run after they're deleted.
+	DeleteExpiredPosts(c request.CTX) *model.AppError
 	// DeleteGroupConstrainedMemberships deletes team and channel memberships of users who aren't members of the allowed
 	// groups of all group-constrained teams and channels.
 	DeleteGroupConstrainedMemberships(c *request.Context) error
 	// DeletePublicKey will delete plugin public key from the config.
 	DeletePublicKey(name string) *model.AppError
+	// DeleteSavedPostLabel deletes a label of the user and removes it from the posts filed under it.
+	// The posts stay saved.
+	DeleteSavedPostLabel(userID, labelID string) *model.AppError
+	// DeleteTeamTemplate deletes a team template. The teams already instantiated from it are left
+	// untouched.
+	DeleteTeamTemplate(templateID string) *model.AppError
+	// DeleteWebAuthnCredential removes a credential of the user.
+	DeleteWebAuthnCredential(userID, id string) *model.AppError
 	// DemoteUserToGuest Convert user's roles and all his membership's roles from
 	// regular user roles to guest roles.
 	DemoteUserToGuest(c request.CTX, user *model.User) *model.AppError
@@ -146,10 +236,17 @@ type AppIface interface {
 	// attributes of the attachment structure. The Slack attachment structure is
 	// documented here: https://api.slack.com/docs/attachments
 	ProcessSlackAttachments(attachments []*model.SlackAttachment) []*model.SlackAttachment
+	// ExportChannelArchive writes a zip archive of the channel's full history to w. The archive contains
+	// an index.html page that can be browsed without a server, a channel.json file with the same
+	// content for automated processing, and the files attached to the channel's posts.
+	ExportChannelArchive(c request.CTX, channelID string, w io.Writer) *model.AppError
 	// ExtendSessionExpiryIfNeeded extends Session.ExpiresAt based on session lengths in config.
 	// A new ExpiresAt is only written if enough time has elapsed since last update.
 	// Returns true only if the session was extended.
 	ExtendSessionExpiryIfNeeded(session *model.Session) bool
+	// FileInfoReader returns a reader for the content of the file, reading it from the cold storage file
+	// store if the file has been moved there. Caller must close the first return value.
+	FileInfoReader(info *model.FileInfo) (filestore.ReadCloseSeeker, *model.AppError)
 	// FillInPostProps should be invoked before saving posts to fill in properties such as
 	// channel_mentions.
 	//
@@ -161,21 +258,68 @@ type AppIface interface {
 	// FilterNonGroupTeamMembers returns the subset of the given user IDs of the users who are not members of groups
 	// associated to the team excluding bots.
 	FilterNonGroupTeamMembers(userIDs []string, team *model.Team) ([]string, error)
+	// FilterPostListBySavedPostLabel removes from the list the posts the user didn't save under the label.
+	FilterPostListBySavedPostLabel(userID, labelID string, postList *model.PostList) *model.AppError
+	// FilterRestrictedPostList removes the posts the user can't see from the post list.
+	FilterRestrictedPostList(userID string, postList *model.PostList) *model.AppError
+	// FilterRestrictedPosts returns the posts the user can see.
+	FilterRestrictedPosts(userID string, posts []*model.Post) ([]*model.Post, *model.AppError)
+	// FinishWebAuthnRegistration verifies the credential the authenticator created and saves it.
+	FinishWebAuthnRegistration(user *model.User, registration *model.WebAuthnRegistrationRequest) (*model.WebAuthnCredential, *model.AppError)
+	// GetActivityFeed returns a page of the activity feed of the user along with the posts the
+	// activities are about. Activities in channels the user left, or about posts they can no longer
+	// see, are left out of the page.
+	GetActivityFeed(c request.CTX, userID string, options model.ActivityGetOptions) (*model.ActivityFeed, *model.AppError)
 	// GetAllLdapGroupsPage retrieves all LDAP groups under the configured base DN using the default or configured group
 	// filter.
 	GetAllLdapGroupsPage(page int, perPage int, opts model.LdapGroupSearchOpts) ([]*model.Group, int, *model.AppError)
+	// GetBootstrap returns everything the user of the session needs to start a client up. Sections
+	// whose ETag matches the one in etags are left out of the response.
+	GetBootstrap(c request.CTX, etags map[string]string) (*model.Bootstrap, *model.AppError)
 	// GetBot returns the given bot.
 	GetBot(botUserId string, includeDeleted bool) (*model.Bot, *model.AppError)
 	// GetBots returns the requested page of bots.
 	GetBots(options *model.BotGetOptions) (model.BotList, *model.AppError)
+	// GetCalendarConnection returns the connection of the user to the calendar of the service, without
+	// its tokens.
+	GetCalendarConnection(userID, service string) (*model.OAuthConnection, *model.AppError)
+	// GetChannelBookmark returns a bookmark along with the file it bookmarks.
+	GetChannelBookmark(bookmarkID string, includeDeleted bool) (*model.ChannelBookmarkWithFileInfo, *model.AppError)
+	// GetChannelBookmarks returns the bookmarks of the channel in order, along with the files they
+	// bookmark.
+	GetChannelBookmarks(channelID string) ([]*model.ChannelBookmarkWithFileInfo, *model.AppError)
 	// GetChannelGroupUsers returns the users who are associated to the channel via GroupChannels and GroupMembers.
 	GetChannelGroupUsers(channelID string) ([]*model.User, *model.AppError)
+	// GetChannelJoinRequest returns the request to join a channel with the given id.
+	GetChannelJoinRequest(requestID string) (*model.ChannelJoinRequest, *model.AppError)
+	// GetChannelJoinRequests returns a page of the requests to join the channel, the most recent
+	// first. An empty status returns the requests whatever their status.
+	GetChannelJoinRequests(channelID string, status string, page int, perPage int) ([]*model.ChannelJoinRequest, *model.AppError)
+	// GetChannelMemberExpiries returns the expiries of the temporary members of the channel.
+	GetChannelMemberExpiries(channelID string) ([]*model.ChannelMemberExpiry, *model.AppError)
+	// GetChannelMembersBulkJob returns the channel_members_bulk job of the channel with the given id,
+	// with a 404 error when the job doesn't belong to the channel.
+	GetChannelMembersBulkJob(channelID, jobID string) (*model.Job, *model.AppError)
 	// GetChannelModerationsForChannel Gets a channels ChannelModerations from either the higherScoped roles or from the channel scheme roles.
 	GetChannelModerationsForChannel(c request.CTX, channel *model.Channel) ([]*model.ChannelModeration, *model.AppError)
+	// GetChannelPinnedPosts returns the order of the pinned posts of the channel and how many posts can
+	// be pinned in it.
+	GetChannelPinnedPosts(c request.CTX, channelID string) (*model.ChannelPinnedPosts, *model.AppError)
+	// GetChannelResourceHints returns what the latest posts of a channel need to be rendered by the
+	// given user: the profiles of their authors, the custom emojis of their messages and reactions,
+	// and the links they preview.
+	GetChannelResourceHints(c request.CTX, channelID, userID string) (*model.ChannelResourceHints, *model.AppError)
 	// GetClusterPluginStatuses returns the status for plugins installed anywhere in the cluster.
 	GetClusterPluginStatuses() (model.PluginStatuses, *model.AppError)
+	// GetConfigChange returns the config change along with the config it saved.
+	GetConfigChange(changeID string) (*model.ConfigChange, *model.AppError)
 	// GetConfigFile proxies access to the given configuration file to the underlying config store.
 	GetConfigFile(name string) ([]byte, error)
+	// GetConfigHistory returns a page of the config changes, the newest first, without the configs
+	// they saved.
+	GetConfigHistory(page, perPage int) ([]*model.ConfigChange, *model.AppError)
+	// GetCustomAdminRoles returns the delegated administration roles that haven't been deleted.
+	GetCustomAdminRoles() ([]*model.Role, *model.AppError)
 	// GetEmojiStaticURL returns a relative static URL for system default emojis,
 	// and the API route for custom ones. Errors if not found or if custom and deleted.
 	GetEmojiStaticURL(c request.CTX, emojiName string) (string, *model.AppError)
@@ -186,8 +330,26 @@ type AppIface interface {
 	GetFileInfosForPost(postID string, fromMaster bool, includeDeleted bool) ([]*model.FileInfo, int64, *model.AppError)
 	// GetFilteredUsersStats is used to get a count of users based on the set of filters supported by UserCountOptions.
 	GetFilteredUsersStats(options *model.UserCountOptions) (*model.UsersStats, *model.AppError)
+	// GetFlaggedPostsForLabel returns the posts the user saved and filed under the label, newest first.
+	GetFlaggedPostsForLabel(userID, labelID string, offset int, limit int) (*model.PostList, *model.AppError)
 	// GetGroupsByTeam returns the paged list and the total count of group associated to the given team.
 	GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, int, *model.AppError)
+	// GetGuestSponsorReport returns a page of the number of active guests per sponsor.
+	GetGuestSponsorReport(page, perPage int) ([]*model.GuestSponsorReport, *model.AppError)
+	// GetGuestSponsorship returns the sponsorship of the given guest.
+	GetGuestSponsorship(userID string) (*model.GuestSponsorship, *model.AppError)
+	// GetInboxForUser returns a page of the unread channels and threads of the user across all their
+	// teams. Threads are only listed separately when collapsed reply threads are enabled for the user.
+	// Muted channels are only listed when they mention the user, so a page may be shorter than asked
+	// for even though there are more pages.
+	GetInboxForUser(c request.CTX, userID string, options model.GetInboxOptions) (*model.Inbox, *model.AppError)
+	// GetIntegrationsHealth returns the health of the integrations this server has sent requests to.
+	GetIntegrationsHealth() []*model.IntegrationHealth
+	// GetJobTypeSettings returns the settings of the registered job types.
+	GetJobTypeSettings() []*model.JobTypeSettings
+	// GetJobTypeStats summarizes the jobs created since the given time by type, along with the
+	// settings of the types and the reasons of their most recent failures.
+	GetJobTypeStats(since int64) ([]*model.JobTypeStats, *model.AppError)
 	// GetKnownUsers returns the list of user ids of users with any direct
 	// relationship with a user. That means any user sharing any channel, including
 	// direct and group channels.
@@ -201,6 +363,19 @@ type AppIface interface {
 	// GetMarketplacePlugins returns a list of plugins from the marketplace-server,
 	// and plugins that are installed locally.
 	GetMarketplacePlugins(filter *model.MarketplacePluginFilter) ([]*model.MarketplacePlugin, *model.AppError)
+	// GetMigrationsStatus returns the schema version of the database along with the status of the
+	// migrations changing its large tables online, in the background of the servers.
+	GetMigrationsStatus() (*model.MigrationsStatus, *model.AppError)
+	// GetNotificationRules returns the notification rules of the user.
+	GetNotificationRules(userID string) (model.NotificationRules, *model.AppError)
+	// GetOAuthJSONWebKeySet returns the public key ID tokens are signed with.
+	GetOAuthJSONWebKeySet() (*model.JSONWebKeySet, *model.AppError)
+	// GetOAuthUserInfo returns the claims about the user of an OAuth session, limited to the scopes
+	// the app was granted.
+	GetOAuthUserInfo(session *model.Session) (*model.OpenIDUserInfo, *model.AppError)
+	// GetOpenIDConfiguration returns the OpenID Connect discovery document of the OAuth 2.0 service
+	// provider.
+	GetOpenIDConfiguration() (*model.OpenIDConfiguration, *model.AppError)
 	// GetPluginStatus returns the status for a plugin installed on this server.
 	GetPluginStatus(id string) (*model.PluginStatus, *model.AppError)
 	// GetPluginStatuses returns the status for plugins installed on this server.
@@ -220,61 +395,165 @@ type AppIface interface {
 	GetProductNotices(c *request.Context, userID, teamID string, client model.NoticeClientType, clientVersion string, locale string) (model.NoticeMessages, *model.AppError)
 	// GetPublicKey will return the actual public key saved in the `name` file.
 	GetPublicKey(name string) ([]byte, *model.AppError)
+	// GetPushNotificationReceipts returns the receipts of the last push notifications sent to the
+	// user's devices by this server, newest first.
+	GetPushNotificationReceipts(userID string) []*model.PushNotificationReceipt
+	// GetReactionAnalytics returns the emojis used the most in reactions and the posts that received
+	// the most reactions, as of the last time reactions were summarized.
+	GetReactionAnalytics(opts model.ReactionAnalyticsOptions) (*model.ReactionAnalytics, *model.AppError)
+	// GetReadReceiptsForPost returns the receipts of the channel members who have read the post, leaving
+	// out its author and the members who don't share read receipts. Read receipts are only available in
+	// channels with no more members than configured by ServiceSettings.ReadReceiptsMaxChannelMembers.
+	GetReadReceiptsForPost(c request.CTX, post *model.Post) ([]*model.ReadReceipt, *model.AppError)
+	// GetRoleSnapshot returns a snapshot of the given role.
+	GetRoleSnapshot(roleID, snapshotID string) (*model.RoleSnapshot, *model.AppError)
+	// GetRoleSnapshots returns a page of the permission sets the role had before its changes, the most
+	// recent first.
+	GetRoleSnapshots(roleID string, page, perPage int) ([]*model.RoleSnapshot, *model.AppError)
+	// GetSCIMGroup returns the group with the given id if it was provisioned through SCIM, along with
+	// its members.
+	GetSCIMGroup(groupID string) (*model.Group, []*model.User, *model.AppError)
+	// GetSCIMGroups returns the page of the groups provisioned through SCIM matching the given
+	// filter, along with the total number of matching groups.
+	GetSCIMGroups(filter *model.SCIMFilter, startIndex, count int) ([]*model.Group, int, *model.AppError)
+	// GetSCIMUser returns the user with the given id if it was provisioned through SCIM.
+	GetSCIMUser(userID string) (*model.User, *model.AppError)
+	// GetSCIMUsers returns the page of the users provisioned through SCIM matching the given filter,
+	// along with the total number of matching users. Provisioned users are the users signing in
+	// with the authentication service configured for SCIM, including the deactivated ones.
+	GetSCIMUsers(filter *model.SCIMFilter, startIndex, count int) ([]*model.User, int, *model.AppError)
 	// GetSanitizedConfig gets the configuration for a system admin without any secrets.
 	GetSanitizedConfig() *model.Config
+	// GetSavedPostLabels returns the labels the user files their saved posts under, oldest first.
+	GetSavedPostLabels(userID string) ([]*model.SavedPostLabel, *model.AppError)
+	// GetScheduledPostsForUser returns the user's scheduled posts, including those that failed
+	// to be delivered, ordered by scheduled time.
+	GetScheduledPostsForUser(userID string) ([]*model.ScheduledPost, *model.AppError)
 	// GetSchemeRolesForChannel Checks if a channel or its team has an override scheme for channel roles and returns the scheme roles or default channel roles.
 	GetSchemeRolesForChannel(c request.CTX, channelID string) (guestRoleName string, userRoleName string, adminRoleName string, err *model.AppError)
+	// GetSearchEngineQueryStats returns the latency and health of the search engines for each type of
+	// query they were sent since the server started.
+	GetSearchEngineQueryStats() []*model.SearchEngineQueryStats
 	// GetSessionLengthInMillis returns the session length, in milliseconds,
 	// based on the type of session (Mobile, SSO, Web/LDAP).
 	GetSessionLengthInMillis(session *model.Session) int64
+	// GetSessionsDetailed describes the unexpired sessions of the user to their owner, flagging the
+	// session with the given id as the current one.
+	GetSessionsDetailed(userID string, currentSessionID string) ([]*model.SessionDetailed, *model.AppError)
+	// GetSponsoredGuests returns a page of the sponsorships of the given sponsor.
+	GetSponsoredGuests(sponsorID string, page, perPage int) ([]*model.GuestSponsorship, *model.AppError)
 	// GetStorageUsage returns the sum of files' sizes stored on this instance
 	GetStorageUsage() (int64, *model.AppError)
 	// GetSuggestions returns suggestions for user input.
 	GetSuggestions(c *request.Context, commandArgs *model.CommandArgs, commands []*model.Command, roleID string) []model.AutocompleteSuggestion
+	// GetSupportAccessConsents returns the support access consents the user granted, newest first.
+	GetSupportAccessConsents(userID string) ([]*model.SupportAccessConsent, *model.AppError)
+	// GetTeamArchive returns the archive of the team, with a 404 error when the team isn't archived.
+	GetTeamArchive(teamID string) (*model.TeamArchive, *model.AppError)
 	// GetTeamGroupUsers returns the users who are associated to the team via GroupTeams and GroupMembers.
 	GetTeamGroupUsers(teamID string) ([]*model.User, *model.AppError)
 	// GetTeamSchemeChannelRoles Checks if a team has an override scheme and returns the scheme channel role names or default channel role names.
 	GetTeamSchemeChannelRoles(c request.CTX, teamID string) (guestRoleName string, userRoleName string, adminRoleName string, err *model.AppError)
+	// GetTeamTemplate returns the team template with the given id, unless it was deleted.
+	GetTeamTemplate(templateID string) (*model.TeamTemplate, *model.AppError)
+	// GetTeamTemplates returns a page of the team templates, sorted by display name.
+	GetTeamTemplates(page, perPage int) ([]*model.TeamTemplate, *model.AppError)
 	// GetTotalUsersStats is used for the DM list total
 	GetTotalUsersStats(viewRestrictions *model.ViewUsersRestrictions) (*model.UsersStats, *model.AppError)
+	// GetUserOffboardingReports returns the reports of the offboarding jobs of the user, the newest
+	// first.
+	GetUserOffboardingReports(userID string) ([]*model.OffboardingReport, *model.AppError)
 	// GetUserStatusesByIds used by apiV4
 	GetUserStatusesByIds(userIDs []string) ([]*model.Status, *model.AppError)
+	// GetWebAuthnCredentials returns the passkeys and security keys of the user.
+	GetWebAuthnCredentials(userID string) ([]*model.WebAuthnCredential, *model.AppError)
+	// GrantSupportAccessConsent lets the system admin of the request view the app as the user, in
+	// read-only support access sessions, until the consent expires or is revoked.
+	GrantSupportAccessConsent(c request.CTX, userID string, consentRequest *model.SupportAccessConsentRequest) (*model.SupportAccessConsent, *model.AppError)
+	// HandleMatrixTransaction processes the events pushed by the homeserver. The homeserver retries a
+	// transaction until it succeeds, so events that were already bridged are skipped.
+	HandleMatrixTransaction(c *request.Context, txn *matrix.Transaction) *model.AppError
 	// HasRemote returns whether a given channelID is present in the channel remotes or not.
 	HasRemote(channelID string, remoteID string) (bool, error)
 	// HubRegister registers a connection to a hub.
 	HubRegister(webConn *platform.WebConn)
 	// HubUnregister unregisters a connection from a hub.
 	HubUnregister(webConn *platform.WebConn)
+	// IndexPostEmbeddings computes the embeddings of a batch of the posts created or edited after
+	// cursor, and removes the embeddings of the posts deleted since. It returns the cursor to index
+	// the next batch from, and how many posts were read, which is zero once indexing caught up.
+	IndexPostEmbeddings(c request.CTX, cursor model.GetPostsSinceForSyncCursor) (model.GetPostsSinceForSyncCursor, int, *model.AppError)
 	// InstallPlugin unpacks and installs a plugin but does not enable or activate it.
 	InstallPlugin(pluginFile io.ReadSeeker, replace bool) (*model.Manifest, *model.AppError)
+	// InstantiateTeamTemplate creates a new team owned by the user with the channels, sidebar
+	// categories, playbooks, commands and incoming webhooks of the template. The instantiation is
+	// all or nothing: when a step fails, whatever was created is removed again.
+	InstantiateTeamTemplate(c *request.Context, template *model.TeamTemplate, instantiation *model.TeamTemplateInstantiation, userID string) (instance *model.TeamTemplateInstance, appErr *model.AppError)
+	// IsMatrixPuppet tells whether the Matrix user is in the namespace of the bridge, and so posts on
+	// behalf of a Mattermost user.
+	IsMatrixPuppet(userID string) bool
+	// IsPostVisibleToUser returns whether the user can see the post, given that they can read its
+	// channel. Restricted posts are only visible to their author, the users they're restricted to and
+	// the members of the groups they're restricted to.
+	IsPostVisibleToUser(userID string, post *model.Post) (bool, *model.AppError)
+	// LabelSavedPost files the post under the label, saving the post first if needed.
+	LabelSavedPost(userID, postID, labelID string) *model.AppError
 	// LogAuditRec logs an audit record using default LvlAuditCLI.
 	LogAuditRec(rec *audit.Record, err error)
 	// LogAuditRecWithLevel logs an audit record using specified Level.
 	LogAuditRecWithLevel(rec *audit.Record, level mlog.Level, err error)
 	// MakeAuditRecord creates a audit record pre-populated with defaults.
 	MakeAuditRecord(event string, initialStatus string) *audit.Record
+	// MarkActivitiesAsRead marks activities of the feed of the user, or all of them, as read.
+	MarkActivitiesAsRead(c request.CTX, userID string, readRequest *model.ActivityReadRequest) *model.AppError
 	// MarkChanelAsUnreadFromPost will take a post and set the channel as unread from that one.
 	MarkChannelAsUnreadFromPost(c request.CTX, postID string, userID string, collapsedThreadsSupported bool) (*model.ChannelUnreadAt, *model.AppError)
+	// MarkPostAsRead records that the user has read the channel up to the given post. Unless the user
+	// has chosen not to share read receipts, the other members of the channel are notified when the
+	// channel is small enough for read receipts to be shown.
+	MarkPostAsRead(c request.CTX, postID, userID string) (*model.ReadReceipt, *model.AppError)
 	// MentionsToPublicChannels returns all the mentions to public channels,
 	// linking them to their channels
 	MentionsToPublicChannels(c request.CTX, message, teamID string) model.ChannelMentionMap
 	// MentionsToTeamMembers returns all the @ mentions found in message that
 	// belong to users in the specified team, linking them to their users
 	MentionsToTeamMembers(c request.CTX, message, teamID string) model.UserMentionMap
+	// MergeDraft merges the draft into the stored one it conflicts with, and saves the result. The
+	// draft is saved as is when there is nothing to merge it with.
+	MergeDraft(c *request.Context, draft *model.Draft, connectionID string) (*model.Draft, *model.AppError)
 	// MoveChannel method is prone to data races if someone joins to channel during the move process. However this
 	// function is only exposed to sysadmins and the possibility of this edge case is relatively small.
 	MoveChannel(c request.CTX, team *model.Team, channel *model.Channel, user *model.User) *model.AppError
+	// MoveFileToColdStorage moves the file to the cold storage file store and marks it, along with any copy
+	// of it referencing the same path, as moved. The file is only removed from the primary file store once
+	// it has been marked, so that it can always be read from one of them.
+	MoveFileToColdStorage(info *model.FileInfo) *model.AppError
+	// NewFileWriter returns a writer streaming the data written to it into the file at the given path,
+	// keeping at most partSize bytes in memory. The file is only created once the writer is closed.
+	NewFileWriter(ctx context.Context, path string, partSize int64) (filestore.FileWriter, *model.AppError)
 	// NewWebConn returns a new WebConn instance.
 	NewWebConn(cfg *platform.WebConnConfig) *platform.WebConn
 	// NotifySessionsExpired is called periodically from the job server to notify any mobile sessions that have expired.
 	NotifySessionsExpired() error
+	// OffboardUser does the work of the user_offboarding job for the deactivated user, running the
+	// steps enabled in the configuration. A step failing for an item doesn't stop the offboarding,
+	// the failure being recorded in the returned report instead.
+	OffboardUser(c request.CTX, userID, successorID string, reportProgress func(int)) (*model.OffboardingReport, *model.AppError)
 	// OverrideIconURLIfEmoji changes the post icon override URL prop, if it has an emoji icon,
 	// so that it points to the URL (relative) of the emoji - static if emoji is default, /api if custom.
 	OverrideIconURLIfEmoji(c request.CTX, post *model.Post)
 	// PatchBot applies the given patch to the bot and corresponding user.
 	PatchBot(botUserId string, botPatch *model.BotPatch) (*model.Bot, *model.AppError)
+	// PatchChannelBookmark changes the name, the emoji, and the link or the file of a bookmark.
+	PatchChannelBookmark(bookmarkID string, patch *model.ChannelBookmarkPatch, connectionID string) (*model.ChannelBookmarkWithFileInfo, *model.AppError)
 	// PatchChannelModerationsForChannel Updates a channels scheme roles based on a given ChannelModerationPatch, if the permissions match the higher scoped role the scheme is deleted.
 	PatchChannelModerationsForChannel(c request.CTX, channel *model.Channel, channelModerationsPatch []*model.ChannelModerationPatch) ([]*model.ChannelModeration, *model.AppError)
+	// PatchJobTypeSettings changes the priority, concurrency limit or schedule of the job type, at
+	// runtime and for every node of the cluster.
+	PatchJobTypeSettings(jobType string, patch *model.JobTypeSettingsPatch) (*model.JobTypeSettings, *model.AppError)
+	// PatchGuestSponsorship changes the sponsor or the expiration of a guest. A guest without a
+	// sponsorship, such as a demoted user, gets one as long as the patch names a sponsor.
+	PatchGuestSponsorship(userID string, patch *model.GuestSponsorshipPatch) (*model.GuestSponsorship, *model.AppError)
 	// Perform an HTTP POST request to an integration's action endpoint.
 	// Caller must consume and close returned http.Response as necessary.
 	// For internal requests, requests are routed directly to a plugin ServerHTTP hook
@@ -290,24 +569,73 @@ type AppIface interface {
 	//
 	// WARNING: PostCountsByDuration PERFORMS NO AUTHORIZATION CHECKS ON THE GIVEN CHANNELS.
 	PostCountsByDuration(c request.CTX, channelIDs []string, sinceUnixMillis int64, userID *string, grouping model.PostCountGrouping, groupingLocation *time.Location) ([]*model.DurationPostCount, *model.AppError)
+	// PreviewRetentionPolicy reports how many posts and files the policy would delete if it was applied
+	// now, without saving or applying it. The policy's ID is only used to tell which channels are
+	// already assigned to it, so both new policies and changes to existing ones can be previewed.
+	PreviewRetentionPolicy(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyPreview, *model.AppError)
+	// ProcessChannelMembersBulk does the work of a channel_members_bulk job, adding the users to or
+	// removing them from the channel one after the other. A user that can't be added or removed
+	// doesn't stop the job: the id of the error is returned for the user instead.
+	ProcessChannelMembersBulk(c request.CTX, channelID, action, requesterID string, userIDs []string, reportProgress func(processed int, failures map[string]string)) (map[string]string, *model.AppError)
+	// ProcessScheduledPosts delivers every scheduled post that is due. Posts that can no longer
+	// be delivered, for instance because the author left the channel, are kept with an error
+	// code rather than deleted so that the author can edit or discard them.
+	ProcessScheduledPosts(c request.CTX) *model.AppError
+	// ProcessTeamArchive does the work of the team_archive job for the team, archiving or restoring
+	// it depending on the status of its archive. Each step can safely run again when a previous job
+	// failed part way.
+	ProcessTeamArchive(c request.CTX, teamID string, reportProgress func(int)) *model.AppError
 	// PromoteGuestToUser Convert user's roles and all his membership's roles from
 	// guest roles to regular user roles.
 	PromoteGuestToUser(c *request.Context, user *model.User, requestorId string) *model.AppError
+	// RecordConfigChange adds the save of newCfg over oldCfg by the session user to the config history,
+	// unless it changed nothing. The config is recorded before being sanitized, for rollbacks, while
+	// the secrets are masked in the recorded diffs.
+	RecordConfigChange(c request.CTX, oldCfg, newCfg *model.Config, rollbackToID string) (*model.ConfigChange, *model.AppError)
+	// RecordPushNotificationAck marks the push notification acknowledged by one of the user's devices as received.
+	RecordPushNotificationAck(userID string, ack *model.PushNotificationAck)
+	// RemoveExpiredChannelMembers removes the temporary channel members whose membership expired,
+	// recording an audit event for each of them.
+	RemoveExpiredChannelMembers(c request.CTX) *model.AppError
 	// Removes a listener function by the unique ID returned when AddConfigListener was called
 	RemoveConfigListener(id string)
 	// RenameChannel is used to rename the channel Name and the DisplayName fields
 	RenameChannel(c request.CTX, channel *model.Channel, newChannelName string, newDisplayName string) (*model.Channel, *model.AppError)
 	// RenameTeam is used to rename the team Name and the DisplayName fields
 	RenameTeam(team *model.Team, newTeamName string, newDisplayName string) (*model.Team, *model.AppError)
+	// RenewGuestSponsorship moves the expiration of a guest to expiresAt or, when nil, to the end of
+	// the configured default expiration period.
+	RenewGuestSponsorship(userID string, expiresAt *int64) (*model.GuestSponsorship, *model.AppError)
+	// RequestToJoinChannel records the request of the user to join the private channel and posts it
+	// in the channel for the channel admins to approve or deny.
+	RequestToJoinChannel(c request.CTX, channel *model.Channel, userID string, message string) (*model.ChannelJoinRequest, *model.AppError)
+	// ResetIntegrationHealth forgets the health of the integration, closing its circuit.
+	ResetIntegrationHealth(integrationID string) *model.AppError
+	// RestoreArchivedTeam starts a team_archive job restoring the channels archived along with the
+	// team and the jobs that were suspended, the team being shown again once they are.
+	RestoreArchivedTeam(c request.CTX, teamID string) (*model.TeamArchive, *model.AppError)
+	// RevokeOtherSessions revokes every session of the user but the current one, logging out the
+	// other devices of the user and closing their websocket connections.
+	RevokeOtherSessions(userID string, currentSessionID string) *model.AppError
 	// RevokeSessionsFromAllUsers will go through all the sessions active
 	// in the server and revoke them
 	RevokeSessionsFromAllUsers() *model.AppError
+	// RevokeSupportAccessConsents revokes the active support access consents of the user, along with
+	// the support access sessions opened under them.
+	RevokeSupportAccessConsents(c request.CTX, userID string) *model.AppError
+	// SaveCalendarConnection stores the tokens the user granted to read their calendar from the service.
+	// The tokens are sanitized from the returned connection.
+	SaveCalendarConnection(userID string, connection *model.OAuthConnection) (*model.OAuthConnection, *model.AppError)
 	// SaveConfig replaces the active configuration, optionally notifying cluster peers.
 	SaveConfig(newCfg *model.Config, sendConfigChangeClusterMessage bool) (*model.Config, *model.Config, *model.AppError)
 	// SearchAllChannels returns a list of channels, the total count of the results of the search (if the paginate search option is true), and an error.
 	SearchAllChannels(c request.CTX, term string, opts model.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, *model.AppError)
 	// SearchAllTeams returns a team list and the total count of the results
 	SearchAllTeams(searchOpts *model.TeamSearch) ([]*model.Team, int64, *model.AppError)
+	// SemanticSearchPostsForUser searches the posts of the channels the user is a member of by the
+	// meaning of the terms, and ranks the results together with the results of the keyword search.
+	// The search flags only narrow down the keyword results.
+	SemanticSearchPostsForUser(c *request.Context, terms string, userID string, teamID string, isOrSearch bool, includeDeletedChannels bool, timeZoneOffset int, page, perPage int) (*model.PostSearchResults, *model.AppError)
 	// SendNoCardPaymentFailedEmail
 	SendNoCardPaymentFailedEmail() *model.AppError
 	// SessionHasPermissionToChannels returns true only if user has access to all channels.
@@ -320,6 +648,12 @@ type AppIface interface {
 	SessionHasPermissionToTeams(c request.CTX, session model.Session, teamIDs []string, permission *model.Permission) bool
 	// SessionIsRegistered determines if a specific session has been registered
 	SessionIsRegistered(session model.Session) bool
+	// SetChannelMemberExpiry makes the membership of the user in the channel temporary: the user is
+	// removed from the channel at expiresAt.
+	SetChannelMemberExpiry(channel *model.Channel, userID string, expiresAt int64, creatorID string) (*model.ChannelMemberExpiry, *model.AppError)
+	// SetJobTypePaused pauses or resumes the job type. The pending jobs of a paused type aren't
+	// started and no job of the type is scheduled, the jobs in progress running to completion.
+	SetJobTypePaused(jobType string, paused bool) (*model.JobTypeSettings, *model.AppError)
 	// SetSessionExpireInHours sets the session's expiry the specified number of hours
 	// relative to either the session creation date or the current time, depending
 	// on the `ExtendSessionOnActivity` config setting.
@@ -331,6 +665,23 @@ type AppIface interface {
 	// status to away if needed. Used by the WS to set status to away if an 'online' device disconnects
 	// while an 'away' device is still connected
 	SetStatusLastActivityAt(userID string, activityAt int64)
+	// SlackImport imports the Slack export archive into the team, returning a log of what was imported.
+	// When set, reportProgress is called with the percentage of the channels imported so far.
+	SlackImport(c *request.Context, fileData io.ReaderAt, fileSize int64, teamID string, reportProgress func(int)) (*model.AppError, *bytes.Buffer)
+	// StartChannelMembersBulkJob starts a channel_members_bulk job adding the users to, or removing them
+	// from, the channel on behalf of the requester.
+	StartChannelMembersBulkJob(c request.CTX, channel *model.Channel, action, requesterID string, userIDs []string) (*model.Job, *model.AppError)
+	// StartUserOffboarding starts a user_offboarding job handing over what the deactivated user owned
+	// to the successor. Without a successor, only the user's removal from channels can take place.
+	StartUserOffboarding(c request.CTX, userID, successorID string) (*model.Job, *model.AppError)
+	// SummarizeReactions updates the daily reaction summaries the reaction analytics are computed from.
+	// The most recently summarized day is summarized again along with every day since, so that it
+	// includes the reactions added after it was last summarized. The first time it runs, the reactions
+	// of the last model.ReactionSummaryBackfillDays days are summarized.
+	SummarizeReactions(c request.CTX) *model.AppError
+	// SyncCalendarStatuses is a recurring task setting the status of the users who opted in while they
+	// are in a meeting according to their calendar.
+	SyncCalendarStatuses()
 	// SyncLdap starts an LDAP sync job.
 	// If includeRemovedMembers is true, then members who left or were removed from a team/channel will
 	// be re-added; otherwise, they will not be re-added.
@@ -365,24 +716,69 @@ type AppIface interface {
 	CreateZipFileAndAddFiles(fileBackend filestore.FileBackend, fileDatas []model.FileData, zipFileName, directory string) error
 	// This to be used for places we check the users password when they are already logged in
 	DoubleCheckPassword(user *model.User, password string) *model.AppError
+	// TranslatePost translates the message of a post into language or, when language is empty, into
+	// the language the user reads translations in. Unless disabled by the administrator, translations
+	// are stored so that each revision of a post is only sent to the provider once per language.
+	TranslatePost(c request.CTX, post *model.Post, userID, language string) (*model.PostTranslation, *model.AppError)
+	// UnbridgeChannelFromMatrix stops bridging the channel. Messages already bridged are kept on both sides.
+	UnbridgeChannelFromMatrix(channelID string) *model.AppError
+	// UnlabelSavedPost removes the label from the post, which stays saved.
+	UnlabelSavedPost(userID, postID, labelID string) *model.AppError
 	// UpdateBotActive marks a bot as active or inactive, along with its corresponding user.
 	UpdateBotActive(c request.CTX, botUserId string, active bool) (*model.Bot, *model.AppError)
 	// UpdateBotOwner changes a bot's owner to the given value.
 	UpdateBotOwner(botUserId, newOwnerId string) (*model.Bot, *model.AppError)
 	// UpdateChannel updates a given channel by its Id. It also publishes the CHANNEL_UPDATED event.
 	UpdateChannel(c request.CTX, channel *model.Channel) (*model.Channel, *model.AppError)
+	// UpdateChannelBookmarkSortOrder reorders the bookmarks of the channel. The bookmarks left out of
+	// the order come after the ordered ones, in the order they were in.
+	UpdateChannelBookmarkSortOrder(channelID string, order []string, connectionID string) ([]*model.ChannelBookmarkWithFileInfo, *model.AppError)
+	// UpdateChannelNotifyDefaults sets the notification settings of the channel members who haven't
+	// chosen their own. New members of the channel start with them.
+	UpdateChannelNotifyDefaults(c request.CTX, channel *model.Channel, props model.StringMap) (*model.Channel, *model.AppError)
 	// UpdateChannelScheme saves the new SchemeId of the channel passed.
 	UpdateChannelScheme(c request.CTX, channel *model.Channel) (*model.Channel, *model.AppError)
 	// UpdateDNDStatusOfUsers is a recurring task which is started when server starts
 	// which unsets dnd status of users if needed and saves and broadcasts it
 	UpdateDNDStatusOfUsers()
+	// UpdateDirectOutgoingWebhook updates the bot, callback URLs and description of the hook.
+	UpdateDirectOutgoingWebhook(oldHook, updatedHook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, *model.AppError)
+	// UpdateNotificationRules replaces the notification rules of the user.
+	UpdateNotificationRules(userID string, rules model.NotificationRules) *model.AppError
+	// UpdatePinnedPostsLimit sets how many posts can be pinned in the channel, 0 meaning no limit. The
+	// posts already pinned stay pinned when there are more than the new limit.
+	UpdatePinnedPostsLimit(c request.CTX, channelID string, limit int) (*model.ChannelPinnedPosts, *model.AppError)
+	// UpdatePinnedPostsOrder reorders the pinned posts of the channel. The given posts come first, followed
+	// by the other pinned posts in their current order.
+	UpdatePinnedPostsOrder(c request.CTX, channelID string, postIDs []string) (*model.ChannelPinnedPosts, *model.AppError)
 	// UpdateProductNotices is called periodically from a scheduled worker to fetch new notices and update the cache
 	UpdateProductNotices() *model.AppError
+	// UpdateRole saves the role, keeping a snapshot of the permissions it had before for them to be
+	// reverted to.
+	UpdateRole(role *model.Role) (*model.Role, *model.AppError)
+	// UpdateSCIMGroup replaces the display name of a provisioned group with the one of the given SCIM
+	// group and, if the SCIM group lists its members, synchronizes the members of the group.
+	UpdateSCIMGroup(group *model.Group, members []*model.User, scimGroup *model.SCIMGroup) (*model.Group, []*model.User, *model.AppError)
+	// UpdateSCIMUser replaces the attributes of a provisioned user, including its active state, with
+	// the ones of the given SCIM user.
+	UpdateSCIMUser(c request.CTX, user *model.User, scimUser *model.SCIMUser) (*model.User, *model.AppError)
+	// UpdateSavedPostLabel renames a label of the user.
+	UpdateSavedPostLabel(userID string, label *model.SavedPostLabel) (*model.SavedPostLabel, *model.AppError)
+	// UpdateScheduledPost changes the content or the scheduled time of a scheduled post. Updating
+	// a scheduled post that failed to be delivered queues it again.
+	UpdateScheduledPost(c request.CTX, scheduledPost *model.ScheduledPost, connectionID string) (*model.ScheduledPost, *model.AppError)
+	// UpdateSharedChannelFilters replaces the filters applied to the content of a shared channel
+	// before it is sent to remote clusters.
+	UpdateSharedChannelFilters(channelID string, filters *model.SharedChannelFilters) (*model.SharedChannelFilters, *model.AppError)
+	// UpdateTeamTemplate updates the display name, description and content of a team template.
+	UpdateTeamTemplate(template *model.TeamTemplate) (*model.TeamTemplate, *model.AppError)
 	// UpdateViewedProductNotices is called from the frontend to mark a set of notices as 'viewed' by user
 	UpdateViewedProductNotices(userID string, noticeIds []string) *model.AppError
 	// UpdateViewedProductNoticesForNewUser is called when new user is created to mark all current notices for this
 	// user as viewed in order to avoid showing them imminently on first login
 	UpdateViewedProductNoticesForNewUser(userID string)
+	// UpdateWebAuthnCredentialName renames a credential of the user.
+	UpdateWebAuthnCredentialName(userID, id, name string) (*model.WebAuthnCredential, *model.AppError)
 	// UpdateWebConnUserActivity sets the LastUserActivityAt of the hub for the given session.
 	UpdateWebConnUserActivity(session model.Session, activityAt int64)
 	// UploadFile uploads a single file in form of a completely constructed byte array for a channel.
@@ -393,9 +789,19 @@ type AppIface interface {
 	// upload, returning a rejection error. In this case FileInfo would have
 	// contained the last "good" FileInfo before the execution of that plugin.
 	UploadFileX(c *request.Context, channelID, name string, input io.Reader, opts ...func(*UploadFileTask)) (*model.FileInfo, *model.AppError)
+	// UserHasWebAuthnCredentials tells whether the user can use a security key as a second factor.
+	UserHasWebAuthnCredentials(userID string) (bool, *model.AppError)
 	// UserIsInAdminRoleGroup returns true at least one of the user's groups are configured to set the members as
 	// admins in the given syncable.
 	UserIsInAdminRoleGroup(userID, syncableID string, syncableType model.GroupSyncableType) (bool, *model.AppError)
+	// ValidateBulkImport checks the import data without writing anything. Besides validating each line,
+	// it checks that the teams, channels and users a line refers to are either imported by an earlier
+	// line or already exist. Unlike a dry run, it doesn't stop at the first error but reports them all.
+	ValidateBulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, importPath string) (*model.BulkImportValidationReport, *model.AppError)
+	// ValidateConfig checks the config without saving it, probing the file store and the SMTP server
+	// it configures. The secrets the clients send back as fake settings are checked with their values
+	// in the current config.
+	ValidateConfig(cfg *model.Config) *model.ConfigValidationReport
 	// ValidateUserPermissionsOnChannels filters channelIds based on whether userId is authorized to manage channel members. Unauthorized channels are removed from the returned list.
 	ValidateUserPermissionsOnChannels(c request.CTX, userId string, channelIds []string) []string
 	// VerifyPlugin checks that the given signature corresponds to the given plugin and matches a trusted certificate.
@@ -435,8 +841,8 @@ type AppIface interface {
 	AutocompleteChannels(c request.CTX, userID, term string) (model.ChannelListWithTeamData, *model.AppError)
 	AutocompleteChannelsForSearch(c request.CTX, teamID string, userID string, term string) (model.ChannelList, *model.AppError)
 	AutocompleteChannelsForTeam(c request.CTX, teamID, userID, term string) (model.ChannelList, *model.AppError)
-	AutocompleteUsersInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, *model.AppError)
-	AutocompleteUsersInTeam(teamID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInTeam, *model.AppError)
+	AutocompleteUsersInChannel(c request.CTX, teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, *model.AppError)
+	AutocompleteUsersInTeam(c request.CTX, teamID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInTeam, *model.AppError)
 	BuildPostReactions(ctx request.CTX, postID string) (*[]ReactionImportData, *model.AppError)
 	BuildPushNotificationMessage(c request.CTX, contentsConfig string, post *model.Post, user *model.User, channel *model.Channel, channelName string, senderName string, explicitMention bool, channelWideMention bool, replyToThreadType string) (*model.PushNotification, *model.AppError)
 	BuildSamlMetadataObject(idpMetadata []byte) (*model.SamlMetadataResponse, *model.AppError)
@@ -488,7 +894,6 @@ type AppIface interface {
 	CreateGroupChannel(c request.CTX, userIDs []string, creatorId string) (*model.Channel, *model.AppError)
 	CreateGroupWithUserIds(group *model.GroupWithUserIds) (*model.Group, *model.AppError)
 	CreateIncomingWebhookForChannel(creatorId string, channel *model.Channel, hook *model.IncomingWebhook) (*model.IncomingWebhook, *model.AppError)
-	CreateJob(job *model.Job) (*model.Job, *model.AppError)
 	CreateOAuthApp(app *model.OAuthApp) (*model.OAuthApp, *model.AppError)
 	CreateOAuthStateToken(extra string) (*model.Token, *model.AppError)
 	CreateOAuthUser(c *request.Context, service string, userData io.Reader, teamID string, tokenUser *model.User) (*model.User, *model.AppError)
@@ -524,6 +929,7 @@ type AppIface interface {
 	DeleteBrandImage() *model.AppError
 	DeleteChannel(c request.CTX, channel *model.Channel, userID string) *model.AppError
 	DeleteCommand(commandID string) *model.AppError
+	DeleteDirectOutgoingWebhook(hookID string) *model.AppError
 	DeleteDraft(userID, channelID, rootID, connectionID string) (*model.Draft, *model.AppError)
 	DeleteEmoji(c request.CTX, emoji *model.Emoji) *model.AppError
 	DeleteEphemeralPost(userID, postID string)
@@ -541,6 +947,7 @@ type AppIface interface {
 	DeleteReactionForPost(c *request.Context, reaction *model.Reaction) *model.AppError
 	DeleteRemoteCluster(remoteClusterId string) (bool, *model.AppError)
 	DeleteRetentionPolicy(policyID string) *model.AppError
+	DeleteScheduledPost(scheduledPostID, connectionID string) (*model.ScheduledPost, *model.AppError)
 	DeleteScheme(schemeId string) (*model.Scheme, *model.AppError)
 	DeleteSharedChannel(channelID string) (bool, error)
 	DeleteSharedChannelRemote(id string) (bool, error)
@@ -637,6 +1044,8 @@ type AppIface interface {
 	GetCustomStatus(userID string) (*model.CustomStatus, *model.AppError)
 	GetDefaultProfileImage(user *model.User) ([]byte, *model.AppError)
 	GetDeletedChannels(c request.CTX, teamID string, offset int, limit int, userID string) (model.ChannelList, *model.AppError)
+	GetDirectOutgoingWebhook(hookID string) (*model.DirectOutgoingWebhook, *model.AppError)
+	GetDirectOutgoingWebhooksPage(page, perPage int) ([]*model.DirectOutgoingWebhook, *model.AppError)
 	GetDraft(userID, channelID, rootID string) (*model.Draft, *model.AppError)
 	GetDraftsForUser(userID, teamID string) ([]*model.Draft, *model.AppError)
 	GetEditHistoryForPost(postID string) ([]*model.Post, *model.AppError)
@@ -677,6 +1086,7 @@ type AppIface interface {
 	GetJob(id string) (*model.Job, *model.AppError)
 	GetJobs(offset int, limit int) ([]*model.Job, *model.AppError)
 	GetJobsByType(jobType string, offset int, limit int) ([]*model.Job, *model.AppError)
+	GetJobsByTypeAndStatusPage(jobType string, status string, page int, perPage int) ([]*model.Job, *model.AppError)
 	GetJobsByTypePage(jobType string, page int, perPage int) ([]*model.Job, *model.AppError)
 	GetJobsByTypes(jobTypes []string, offset int, limit int) ([]*model.Job, *model.AppError)
 	GetJobsByTypesPage(jobType []string, page int, perPage int) ([]*model.Job, *model.AppError)
@@ -685,6 +1095,7 @@ type AppIface interface {
 	GetLatestVersion(latestVersionUrl string) (*model.GithubReleaseInfo, *model.AppError)
 	GetLogs(page, perPage int) ([]string, *model.AppError)
 	GetLogsSkipSend(page, perPage int, logFilter *model.LogFilter) ([]string, *model.AppError)
+	GetMatrixRoomForChannel(channelID string) (*model.MatrixRoom, *model.AppError)
 	GetMemberCountsByGroup(ctx context.Context, channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, *model.AppError)
 	GetMessageForNotification(post *model.Post, translateFunc i18n.TranslateFunc) string
 	GetMultipleEmojiByName(c request.CTX, names []string) ([]*model.Emoji, *model.AppError)
@@ -693,7 +1104,7 @@ type AppIface interface {
 	GetNextPostIdFromPostList(postList *model.PostList, collapsedThreads bool) string
 	GetNotificationNameFormat(user *model.User) string
 	GetNumberOfChannelsOnTeam(c request.CTX, teamID string) (int, *model.AppError)
-	GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, code, secret, refreshToken string) (*model.AccessResponse, *model.AppError)
+	GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, code, secret, refreshToken, codeVerifier string) (*model.AccessResponse, *model.AppError)
 	GetOAuthAccessTokenForImplicitFlow(userID string, authRequest *model.AuthorizeRequest) (*model.Session, *model.AppError)
 	GetOAuthApp(appID string) (*model.OAuthApp, *model.AppError)
 	GetOAuthApps(page, perPage int) ([]*model.OAuthApp, *model.AppError)
@@ -760,6 +1171,7 @@ type AppIface interface {
 	GetSamlMetadata() (string, *model.AppError)
 	GetSamlMetadataFromIdp(idpMetadataURL string) (*model.SamlMetadataResponse, *model.AppError)
 	GetSanitizeOptions(asAdmin bool) map[string]bool
+	GetScheduledPost(scheduledPostID string) (*model.ScheduledPost, *model.AppError)
 	GetScheme(id string) (*model.Scheme, *model.AppError)
 	GetSchemeByName(name string) (*model.Scheme, *model.AppError)
 	GetSchemeRolesForTeam(teamID string) (string, string, string, *model.AppError)
@@ -769,6 +1181,7 @@ type AppIface interface {
 	GetSessionById(sessionID string) (*model.Session, *model.AppError)
 	GetSessions(userID string) ([]*model.Session, *model.AppError)
 	GetSharedChannel(channelID string) (*model.SharedChannel, error)
+	GetSharedChannelFilters(channelID string) (*model.SharedChannelFilters, *model.AppError)
 	GetSharedChannelRemote(id string) (*model.SharedChannelRemote, error)
 	GetSharedChannelRemoteByIds(channelID string, remoteID string) (*model.SharedChannelRemote, error)
 	GetSharedChannelRemotes(opts model.SharedChannelRemoteFilterOpts) ([]*model.SharedChannelRemote, error)
@@ -1035,14 +1448,14 @@ type AppIface interface {
 	SearchPrivateTeams(searchOpts *model.TeamSearch) ([]*model.Team, *model.AppError)
 	SearchPublicTeams(searchOpts *model.TeamSearch) ([]*model.Team, *model.AppError)
 	SearchUserAccessTokens(term string) ([]*model.UserAccessToken, *model.AppError)
-	SearchUsers(props *model.UserSearch, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
-	SearchUsersInChannel(channelID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
-	SearchUsersInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
-	SearchUsersInTeam(teamID, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
-	SearchUsersNotInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
-	SearchUsersNotInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
-	SearchUsersNotInTeam(notInTeamId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
-	SearchUsersWithoutTeam(term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
+	SearchUsers(c request.CTX, props *model.UserSearch, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
+	SearchUsersInChannel(c request.CTX, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
+	SearchUsersInGroup(c request.CTX, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
+	SearchUsersInTeam(c request.CTX, teamID, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
+	SearchUsersNotInChannel(c request.CTX, teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
+	SearchUsersNotInGroup(c request.CTX, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
+	SearchUsersNotInTeam(c request.CTX, notInTeamId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
+	SearchUsersWithoutTeam(c request.CTX, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
 	SendAckToPushProxy(ack *model.PushNotificationAck) error
 	SendAutoResponse(c request.CTX, channel *model.Channel, receiver *model.User, post *model.Post) (bool, *model.AppError)
 	SendAutoResponseIfNecessary(c request.CTX, channel *model.Channel, sender *model.User, post *model.Post) (bool, *model.AppError)
@@ -1092,7 +1505,6 @@ type AppIface interface {
 	SetTeamIcon(teamID string, imageData *multipart.FileHeader) *model.AppError
 	SetTeamIconFromFile(team *model.Team, file io.Reader) *model.AppError
 	SetTeamIconFromMultiPartFile(teamID string, file multipart.File) *model.AppError
-	SlackImport(c *request.Context, fileData multipart.File, fileSize int64, teamID string) (*model.AppError, *bytes.Buffer)
 	SoftDeleteAllTeamsExcept(teamID string) *model.AppError
 	SoftDeleteTeam(teamID string) *model.AppError
 	Srv() *Server
@@ -1142,7 +1554,6 @@ type AppIface interface {
 	UpdatePreferences(userID string, preferences model.Preferences) *model.AppError
 	UpdateRemoteCluster(rc *model.RemoteCluster) (*model.RemoteCluster, *model.AppError)
 	UpdateRemoteClusterTopics(remoteClusterId string, topics string) (*model.RemoteCluster, *model.AppError)
-	UpdateRole(role *model.Role) (*model.Role, *model.AppError)
 	UpdateScheme(scheme *model.Scheme) (*model.Scheme, *model.AppError)
 	UpdateSharedChannel(sc *model.SharedChannel) (*model.SharedChannel, error)
 	UpdateSharedChannelRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error
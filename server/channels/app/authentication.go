@@ -199,6 +199,18 @@ func (a *App) CheckUserPostflightAuthenticationCriteria(user *model.User) *model
 }
 
 func (a *App) CheckUserMfa(user *model.User, token string) *model.AppError {
+	// Users holding security keys can use them instead of, or along with, an authenticator app
+	hasKeys, appErr := a.UserHasWebAuthnCredentials(user.Id)
+	if appErr != nil {
+		return appErr
+	}
+	if hasKeys && strings.HasPrefix(token, "{") {
+		return a.checkUserWebAuthn(user, token)
+	}
+	if hasKeys && (token == "" || !user.MfaActive || !*a.Config().ServiceSettings.EnableMultifactorAuthentication) {
+		return model.NewAppError("CheckUserMfa", "api.user.check_user_mfa.webauthn_required.app_error", map[string]any{"TotpAllowed": user.MfaActive}, "", http.StatusUnauthorized)
+	}
+
 	if !user.MfaActive || !*a.Config().ServiceSettings.EnableMultifactorAuthentication {
 		return nil
 	}
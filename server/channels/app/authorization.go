@@ -54,7 +54,7 @@ func (a *App) SessionHasPermissionToTeam(session model.Session, teamID string, p
 		}
 	}
 
-	return a.RolesGrantPermission(session.GetUserRoles(), permission.Id)
+	return a.rolesGrantPermissionInTeams(session.GetUserRoles(), []string{teamID}, permission.Id)
 }
 
 // SessionHasPermissionToTeams returns true only if user has access to all teams.
@@ -92,7 +92,7 @@ func (a *App) SessionHasPermissionToTeams(c request.CTX, session model.Session,
 		return true
 	}
 
-	return a.RolesGrantPermission(session.GetUserRoles(), permission.Id)
+	return a.rolesGrantPermissionInTeams(session.GetUserRoles(), teamIDs, permission.Id)
 }
 
 func (a *App) SessionHasPermissionToChannel(c request.CTX, session model.Session, channelID string, permission *model.Permission) bool {
@@ -290,7 +290,13 @@ func (a *App) HasPermissionToTeam(askingUserId string, teamID string, permission
 			return true
 		}
 	}
-	return a.HasPermissionTo(askingUserId, permission)
+
+	user, err := a.GetUser(askingUserId)
+	if err != nil {
+		return false
+	}
+
+	return a.rolesGrantPermissionInTeams(user.GetRoles(), []string{teamID}, permission.Id)
 }
 
 func (a *App) HasPermissionToChannel(c request.CTX, askingUserId string, channelID string, permission *model.Permission) bool {
@@ -342,6 +348,12 @@ func (a *App) HasPermissionToUser(askingUserId string, userID string) bool {
 }
 
 func (a *App) RolesGrantPermission(roleNames []string, permissionId string) bool {
+	return a.rolesGrantPermissionInTeams(roleNames, nil, permissionId)
+}
+
+// rolesGrantPermissionInTeams also takes into account the roles restricted to teams, as long as they
+// apply to all of the given teams.
+func (a *App) rolesGrantPermissionInTeams(roleNames []string, teamIDs []string, permissionId string) bool {
 	roles, err := a.GetRolesByNames(roleNames)
 	if err != nil {
 		// This should only happen if something is very broken. We can't realistically
@@ -351,7 +363,7 @@ func (a *App) RolesGrantPermission(roleNames []string, permissionId string) bool
 	}
 
 	for _, role := range roles {
-		if role.DeleteAt != 0 {
+		if role.DeleteAt != 0 || !role.AppliesToTeams(teamIDs) {
 			continue
 		}
 
@@ -69,6 +69,48 @@ func TestHasPermissionToTeam(t *testing.T) {
 	assert.True(t, th.App.HasPermissionToTeam(th.SystemAdminUser.Id, th.BasicTeam.Id, model.PermissionListTeamChannels))
 }
 
+func TestTeamScopedCustomAdminRole(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	otherTeam := th.CreateTeam()
+	role, appErr := th.App.CreateCustomAdminRole(&model.CustomAdminRole{
+		Name:         "basic_team_user_admin",
+		DisplayName:  "Basic team user admin",
+		Capabilities: []string{model.AdminRoleCapabilityUserManagement},
+		TeamIds:      []string{th.BasicTeam.Id},
+	})
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.UpdateUserRoles(th.Context, th.BasicUser2.Id, model.SystemUserRoleId+" "+role.Name, false)
+	require.Nil(t, appErr)
+	session := model.Session{UserId: th.BasicUser2.Id, Roles: model.SystemUserRoleId + " " + role.Name}
+
+	t.Run("granted in the team", func(t *testing.T) {
+		assert.True(t, th.App.HasPermissionToTeam(th.BasicUser2.Id, th.BasicTeam.Id, model.PermissionAddUserToTeam))
+		assert.True(t, th.App.SessionHasPermissionToTeam(session, th.BasicTeam.Id, model.PermissionAddUserToTeam))
+		assert.True(t, th.App.SessionHasPermissionToTeams(th.Context, session, []string{th.BasicTeam.Id}, model.PermissionAddUserToTeam))
+	})
+
+	t.Run("not granted in other teams", func(t *testing.T) {
+		assert.False(t, th.App.HasPermissionToTeam(th.BasicUser2.Id, otherTeam.Id, model.PermissionAddUserToTeam))
+		assert.False(t, th.App.SessionHasPermissionToTeam(session, otherTeam.Id, model.PermissionAddUserToTeam))
+		assert.False(t, th.App.SessionHasPermissionToTeams(th.Context, session, []string{th.BasicTeam.Id, otherTeam.Id}, model.PermissionAddUserToTeam))
+	})
+
+	t.Run("not granted system wide", func(t *testing.T) {
+		assert.False(t, th.App.HasPermissionTo(th.BasicUser2.Id, model.PermissionAddUserToTeam))
+		assert.False(t, th.App.SessionHasPermissionTo(session, model.PermissionAddUserToTeam))
+	})
+
+	t.Run("deleted roles grant nothing", func(t *testing.T) {
+		_, appErr := th.App.DeleteCustomAdminRole(role.Id)
+		require.Nil(t, appErr)
+
+		assert.False(t, th.App.HasPermissionToTeam(th.BasicUser2.Id, th.BasicTeam.Id, model.PermissionAddUserToTeam))
+	})
+}
+
 func TestSessionHasPermissionToChannel(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
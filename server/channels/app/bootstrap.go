@@ -0,0 +1,132 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const bootstrapChannelMembersPerPage = 1000
+
+// GetBootstrap returns everything the user of the session needs to start a client up. Sections
+// whose ETag matches the one in etags are left out of the response.
+func (a *App) GetBootstrap(c request.CTX, etags map[string]string) (*model.Bootstrap, *model.AppError) {
+	session := c.Session()
+	userID := session.UserId
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	user.Sanitize(map[string]bool{})
+
+	preferences, appErr := a.GetPreferencesForUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	teams, appErr := a.GetTeamsForUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	teams = a.SanitizeTeams(*session, teams)
+
+	teamMembers, appErr := a.GetTeamMembersForUser(userID, "", false)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	channelMembers := model.ChannelMembers{}
+	for page := 0; ; page++ {
+		members, appErr := a.GetChannelMembersForUserWithPagination(c, userID, page, bootstrapChannelMembersPerPage)
+		if appErr != nil {
+			return nil, appErr
+		}
+		for _, member := range members {
+			channelMembers = append(channelMembers, *member)
+		}
+		if len(members) < bootstrapChannelMembersPerPage {
+			break
+		}
+	}
+
+	categories := make(map[string]*model.OrderedSidebarCategories, len(teams))
+	for _, team := range teams {
+		teamCategories, appErr := a.GetSidebarCategoriesForTeamForUser(c, userID, team.Id)
+		if appErr != nil {
+			return nil, appErr
+		}
+		categories[team.Id] = teamCategories
+	}
+
+	unreads, appErr := a.GetTeamsUnreadForUser("", userID, a.IsCRTEnabledForUser(c, userID))
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var license map[string]string
+	if a.SessionHasPermissionTo(*session, model.PermissionReadLicenseInformation) {
+		license = a.Srv().ClientLicense()
+	} else {
+		license = a.Srv().GetSanitizedClientLicense()
+	}
+
+	var limits *model.ProductLimits
+	if a.Channels().License().IsCloud() && a.Cloud() != nil {
+		var err error
+		limits, err = a.Cloud().GetCloudLimits(userID)
+		if err != nil {
+			// Clients fall back to fetching the limits themselves
+			c.Logger().Warn("Failed to get the cloud limits for the bootstrap", mlog.Err(err))
+		}
+	}
+
+	// Keep the ETags stable by not depending on the order the sections are read in
+	sort.Slice(preferences, func(i, j int) bool {
+		if preferences[i].Category != preferences[j].Category {
+			return preferences[i].Category < preferences[j].Category
+		}
+		return preferences[i].Name < preferences[j].Name
+	})
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Id < teams[j].Id })
+	sort.Slice(teamMembers, func(i, j int) bool { return teamMembers[i].TeamId < teamMembers[j].TeamId })
+	sort.Slice(channelMembers, func(i, j int) bool { return channelMembers[i].ChannelId < channelMembers[j].ChannelId })
+	sort.Slice(unreads, func(i, j int) bool { return unreads[i].TeamId < unreads[j].TeamId })
+
+	bootstrap := &model.Bootstrap{
+		ETags: map[string]string{},
+	}
+	sections := []struct {
+		name  string
+		value any
+		set   func()
+	}{
+		{model.BootstrapSectionUser, user, func() { bootstrap.User = user }},
+		{model.BootstrapSectionPreferences, preferences, func() { bootstrap.Preferences = preferences }},
+		{model.BootstrapSectionTeams, teams, func() { bootstrap.Teams = teams }},
+		{model.BootstrapSectionTeamMembers, teamMembers, func() { bootstrap.TeamMembers = teamMembers }},
+		{model.BootstrapSectionChannelMembers, channelMembers, func() { bootstrap.ChannelMembers = channelMembers }},
+		{model.BootstrapSectionCategories, categories, func() { bootstrap.Categories = categories }},
+		{model.BootstrapSectionUnreads, unreads, func() { bootstrap.Unreads = unreads }},
+		{model.BootstrapSectionLicense, license, func() { bootstrap.License = license }},
+		{model.BootstrapSectionLimits, limits, func() { bootstrap.Limits = limits }},
+	}
+	for _, section := range sections {
+		etag, err := model.BootstrapETag(section.value)
+		if err != nil {
+			return nil, model.NewAppError("GetBootstrap", "app.bootstrap.etag.app_error", map[string]any{"Section": section.name}, "", http.StatusInternalServerError).Wrap(err)
+		}
+		bootstrap.ETags[section.name] = etag
+		if etags[section.name] != etag {
+			section.set()
+		}
+	}
+
+	return bootstrap, nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// calendarLookAhead is how far ahead the calendars are read, so that the status set for a meeting
+// lasts until the end of the meetings following it back-to-back.
+const calendarLookAhead = 12 * time.Hour
+
+// SaveCalendarConnection stores the tokens the user granted to read their calendar from the service.
+// The tokens are sanitized from the returned connection.
+func (a *App) SaveCalendarConnection(userID string, connection *model.OAuthConnection) (*model.OAuthConnection, *model.AppError) {
+	if !model.IsValidCalendarService(connection.Service) || einterfaces.GetCalendarProvider(connection.Service) == nil {
+		return nil, model.NewAppError("SaveCalendarConnection", "app.calendar.unsupported_service.app_error", map[string]any{"Service": connection.Service}, "", http.StatusBadRequest)
+	}
+
+	connection.UserId = userID
+	connection.CreateAt = 0
+	saved, err := a.Srv().Store().OAuth().SaveConnection(connection)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("SaveCalendarConnection", "app.calendar.save_connection.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	saved.Sanitize()
+	return saved, nil
+}
+
+// GetCalendarConnection returns the connection of the user to the calendar of the service, without
+// its tokens.
+func (a *App) GetCalendarConnection(userID, service string) (*model.OAuthConnection, *model.AppError) {
+	connection, err := a.Srv().Store().OAuth().GetConnection(userID, service)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetCalendarConnection", "app.calendar.get_connection.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetCalendarConnection", "app.calendar.get_connection.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	connection.Sanitize()
+	return connection, nil
+}
+
+// DeleteCalendarConnection removes the tokens the user granted to read their calendar from the service.
+func (a *App) DeleteCalendarConnection(userID, service string) *model.AppError {
+	if err := a.Srv().Store().OAuth().RemoveConnection(userID, service); err != nil {
+		return model.NewAppError("DeleteCalendarConnection", "app.calendar.delete_connection.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+// refreshCalendarConnection gets a new access token for the connection from the token endpoint of
+// the OAuth app configured for its service, and saves it.
+func (a *App) refreshCalendarConnection(connection *model.OAuthConnection) (*model.OAuthConnection, error) {
+	if connection.RefreshToken == "" {
+		return nil, errors.New("the access token has expired and can't be refreshed")
+	}
+
+	sso := a.Config().GetSSOService(connection.Service)
+	if sso == nil || sso.Id == nil || sso.Secret == nil || sso.TokenEndpoint == nil || *sso.TokenEndpoint == "" {
+		return nil, errors.New("no OAuth app is configured for the service")
+	}
+
+	p := url.Values{}
+	p.Set("client_id", *sso.Id)
+	p.Set("client_secret", *sso.Secret)
+	p.Set("grant_type", model.RefreshTokenGrantType)
+	p.Set("refresh_token", connection.RefreshToken)
+
+	req, err := http.NewRequest("POST", *sso.TokenEndpoint, strings.NewReader(p.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.HTTPService().MakeClient(true).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var ar model.AccessResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ar); err != nil || resp.StatusCode != http.StatusOK || ar.AccessToken == "" {
+		return nil, errors.New("the token endpoint didn't return an access token")
+	}
+
+	refreshed := *connection
+	refreshed.AccessToken = ar.AccessToken
+	// providers only return a new refresh token when they rotate it
+	if ar.RefreshToken != "" {
+		refreshed.RefreshToken = ar.RefreshToken
+	}
+	refreshed.ExpiresAt = 0
+	if ar.ExpiresInSeconds > 0 {
+		refreshed.ExpiresAt = model.GetMillis() + int64(ar.ExpiresInSeconds)*1000
+	}
+
+	return a.Srv().Store().OAuth().SaveConnection(&refreshed)
+}
+
+// SyncCalendarStatuses is a recurring task setting the status of the users who opted in while they
+// are in a meeting according to their calendar.
+func (a *App) SyncCalendarStatuses() {
+	if !*a.Config().TeamSettings.EnableCalendarStatusSync {
+		return
+	}
+
+	preferences, err := a.Srv().Store().Preference().GetCategoryAndName(model.PreferenceCategoryCustomStatus, model.PreferenceNameCalendarStatusSync)
+	if err != nil {
+		mlog.Warn("Failed to get the users syncing their status with their calendar", mlog.Err(err))
+		return
+	}
+
+	now := model.GetMillis()
+	for _, preference := range preferences {
+		sync, err := model.CalendarStatusSyncFromJSON(preference.Value)
+		if err != nil {
+			mlog.Debug("Ignoring invalid calendar status sync settings", mlog.String("user_id", preference.UserId), mlog.Err(err))
+			continue
+		}
+
+		if err := a.syncCalendarStatus(preference.UserId, sync, now); err != nil {
+			mlog.Warn("Failed to sync the status of the user with their calendar", mlog.String("user_id", preference.UserId), mlog.String("service", sync.Service), mlog.Err(err))
+		}
+	}
+}
+
+func (a *App) syncCalendarStatus(userID string, sync *model.CalendarStatusSync, now int64) error {
+	provider := einterfaces.GetCalendarProvider(sync.Service)
+	if provider == nil {
+		return nil
+	}
+
+	connection, err := a.Srv().Store().OAuth().GetConnection(userID, sync.Service)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil
+		}
+		return err
+	}
+
+	if connection.IsExpired() {
+		if connection, err = a.refreshCalendarConnection(connection); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	periods, err := provider.GetBusyPeriods(ctx, connection, now, now+calendarLookAhead.Milliseconds())
+	if err != nil {
+		return err
+	}
+
+	current := model.CurrentCalendarBusyPeriod(periods, now)
+	if current == nil {
+		return nil
+	}
+
+	// the statuses set during the meeting expire when it ends, and the statuses the user set
+	// themselves aren't replaced
+	if sync.SetDnd {
+		if status, appErr := a.GetStatus(userID); appErr != nil || (status.Status != model.StatusDnd && status.Status != model.StatusOutOfOffice) {
+			a.SetStatusDoNotDisturbTimed(userID, current.End/1000)
+		}
+	}
+
+	if cs := sync.CustomStatus(current.End); cs != nil && *a.Config().TeamSettings.EnableCustomUserStatuses {
+		user, appErr := a.GetUser(userID)
+		if appErr != nil {
+			return appErr
+		}
+		if existing := user.GetCustomStatus(); existing == nil || (existing.Emoji == "" && existing.Text == "") || !existing.AreDurationAndExpirationTimeValid() {
+			user.SetCustomStatus(cs)
+			if _, appErr := a.UpdateUser(request.EmptyContext(a.Log()), user, true); appErr != nil {
+				return appErr
+			}
+		}
+	}
+
+	return nil
+}
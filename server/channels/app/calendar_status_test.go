@@ -0,0 +1,88 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
+)
+
+type testCalendarProvider struct {
+	periods []*model.CalendarBusyPeriod
+}
+
+func (p *testCalendarProvider) GetBusyPeriods(ctx context.Context, connection *model.OAuthConnection, start, end int64) ([]*model.CalendarBusyPeriod, error) {
+	return p.periods, nil
+}
+
+func TestSyncCalendarStatuses(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.TeamSettings.EnableCalendarStatusSync = true
+	})
+
+	previous := einterfaces.GetCalendarProvider(model.ServiceGoogle)
+	defer einterfaces.RegisterCalendarProvider(model.ServiceGoogle, previous)
+	provider := &testCalendarProvider{}
+	einterfaces.RegisterCalendarProvider(model.ServiceGoogle, provider)
+
+	connection, appErr := th.App.SaveCalendarConnection(th.BasicUser.Id, &model.OAuthConnection{Service: model.ServiceGoogle, AccessToken: "token"})
+	require.Nil(t, appErr)
+	assert.Empty(t, connection.AccessToken, "tokens should be sanitized")
+
+	_, appErr = th.App.SaveCalendarConnection(th.BasicUser.Id, &model.OAuthConnection{Service: model.ServiceGitlab, AccessToken: "token"})
+	require.NotNil(t, appErr)
+
+	sync, err := json.Marshal(&model.CalendarStatusSync{Service: model.ServiceGoogle, SetDnd: true, Emoji: "calendar", Text: "In a meeting"})
+	require.NoError(t, err)
+	require.Nil(t, th.App.UpdatePreferences(th.BasicUser.Id, model.Preferences{{
+		UserId:   th.BasicUser.Id,
+		Category: model.PreferenceCategoryCustomStatus,
+		Name:     model.PreferenceNameCalendarStatusSync,
+		Value:    string(sync),
+	}}))
+
+	t.Run("no meeting", func(t *testing.T) {
+		th.App.SetStatusOnline(th.BasicUser.Id, true)
+		th.App.SyncCalendarStatuses()
+
+		status, appErr := th.App.GetStatus(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.StatusOnline, status.Status)
+	})
+
+	t.Run("in a meeting", func(t *testing.T) {
+		now := model.GetMillis()
+		end := now + time.Hour.Milliseconds()
+		provider.periods = []*model.CalendarBusyPeriod{{Start: now - time.Minute.Milliseconds(), End: end}}
+		th.App.SyncCalendarStatuses()
+
+		status, appErr := th.App.GetStatus(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.StatusDnd, status.Status)
+		assert.Equal(t, end/1000, status.DNDEndTime)
+
+		user, appErr := th.App.GetUser(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		cs := user.GetCustomStatus()
+		require.NotNil(t, cs)
+		assert.Equal(t, "In a meeting", cs.Text)
+	})
+
+	t.Run("disconnected", func(t *testing.T) {
+		require.Nil(t, th.App.DeleteCalendarConnection(th.BasicUser.Id, model.ServiceGoogle))
+		_, appErr := th.App.GetCalendarConnection(th.BasicUser.Id, model.ServiceGoogle)
+		require.NotNil(t, appErr)
+	})
+}
@@ -105,6 +105,16 @@ func (s *channelsWrapper) GetDirectChannelOrCreate(userID1, userID2 string) (*mo
 	return s.app.GetOrCreateDirectChannel(request.EmptyContext(s.app.Log()), userID1, userID2)
 }
 
+func (s *channelsWrapper) PatchChannel(channelID string, patch *model.ChannelPatch, userID string) (*model.Channel, *model.AppError) {
+	ctx := request.EmptyContext(s.app.Log())
+	channel, err := s.app.GetChannel(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.app.PatchChannel(ctx, channel, patch, userID)
+}
+
 // Ensure the wrapper implements the product service.
 var _ product.ChannelService = (*channelsWrapper)(nil)
 
@@ -169,7 +179,7 @@ func (a *App) JoinDefaultChannels(c request.CTX, teamID string, user *model.User
 			SchemeGuest: user.IsGuest(),
 			SchemeUser:  !user.IsGuest(),
 			SchemeAdmin: shouldBeAdmin,
-			NotifyProps: model.GetDefaultChannelNotifyProps(),
+			NotifyProps: model.GetNewChannelMemberNotifyProps(channel),
 		}
 
 		_, nErr = a.Srv().Store().Channel().SaveMember(cm)
@@ -375,7 +385,7 @@ func (a *App) CreateChannel(c request.CTX, channel *model.Channel, addMember boo
 			SchemeGuest: user.IsGuest(),
 			SchemeUser:  !user.IsGuest(),
 			SchemeAdmin: true,
-			NotifyProps: model.GetDefaultChannelNotifyProps(),
+			NotifyProps: model.GetNewChannelMemberNotifyProps(sc),
 		}
 
 		if _, nErr := a.Srv().Store().Channel().SaveMember(cm); nErr != nil {
@@ -925,6 +935,21 @@ func (a *App) RestoreChannel(c request.CTX, channel *model.Channel, userID strin
 	return channel, nil
 }
 
+// UpdateChannelNotifyDefaults sets the notification settings of the channel members who haven't
+// chosen their own. New members of the channel start with them.
+func (a *App) UpdateChannelNotifyDefaults(c request.CTX, channel *model.Channel, props model.StringMap) (*model.Channel, *model.AppError) {
+	if channel.IsGroupOrDirect() {
+		return nil, model.NewAppError("UpdateChannelNotifyDefaults", "api.channel.update_notify_defaults.direct_or_group.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if appErr := model.IsValidChannelNotifyDefaults(props); appErr != nil {
+		return nil, appErr
+	}
+
+	channel.DefaultNotifyProps = props
+	return a.UpdateChannel(c, channel)
+}
+
 func (a *App) PatchChannel(c request.CTX, channel *model.Channel, patch *model.ChannelPatch, userID string) (*model.Channel, *model.AppError) {
 	oldChannelDisplayName := channel.DisplayName
 	oldChannelHeader := channel.Header
@@ -1560,7 +1585,7 @@ func (a *App) addUserToChannel(c request.CTX, user *model.User, channel *model.C
 	newMember := &model.ChannelMember{
 		ChannelId:   channel.Id,
 		UserId:      user.Id,
-		NotifyProps: model.GetDefaultChannelNotifyProps(),
+		NotifyProps: model.GetNewChannelMemberNotifyProps(channel),
 		SchemeGuest: user.IsGuest(),
 		SchemeUser:  !user.IsGuest(),
 	}
@@ -1630,16 +1655,30 @@ type ChannelMemberOpts struct {
 	// This is useful to avoid in scenarios when we just added the team member,
 	// and thereby know that there is no need to check this.
 	SkipTeamMemberIntegrityCheck bool
+	// ExpiresAt, when set, makes the membership temporary: the user is removed from the
+	// channel at that time.
+	ExpiresAt int64
 }
 
 // AddChannelMember adds a user to a channel. It is a wrapper over AddUserToChannel.
 func (a *App) AddChannelMember(c request.CTX, userID string, channel *model.Channel, opts ChannelMemberOpts) (*model.ChannelMember, *model.AppError) {
+	if opts.ExpiresAt != 0 {
+		if appErr := checkChannelMemberExpiry(channel, opts.ExpiresAt); appErr != nil {
+			return nil, appErr
+		}
+	}
+
 	if member, err := a.Srv().Store().Channel().GetMember(context.Background(), channel.Id, userID); err != nil {
 		var nfErr *store.ErrNotFound
 		if !errors.As(err, &nfErr) {
 			return nil, model.NewAppError("AddChannelMember", "app.channel.get_member.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 		}
 	} else {
+		if opts.ExpiresAt != 0 {
+			if _, appErr := a.SetChannelMemberExpiry(channel, userID, opts.ExpiresAt, opts.UserRequestorID); appErr != nil {
+				return nil, appErr
+			}
+		}
 		return member, nil
 	}
 
@@ -1662,6 +1701,12 @@ func (a *App) AddChannelMember(c request.CTX, userID string, channel *model.Chan
 		return nil, err
 	}
 
+	if opts.ExpiresAt != 0 {
+		if _, err = a.SetChannelMemberExpiry(channel, userID, opts.ExpiresAt, opts.UserRequestorID); err != nil {
+			return nil, err
+		}
+	}
+
 	a.Srv().Go(func() {
 		pluginContext := pluginContext(c)
 		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
@@ -2132,7 +2177,26 @@ func (a *App) GetChannelMembersWithTeamDataForUserWithPagination(c request.CTX,
 	return m, nil
 }
 
+// getChannelCounters returns the counters of the channel, or nil when they're not maintained yet
+// or can't be read, for its members to be counted instead.
+func (a *App) getChannelCounters(c request.CTX, channelID string) *model.ChannelCounters {
+	counters, err := a.Srv().Store().Channel().GetCounters(channelID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			c.Logger().Warn("Failed to get the channel counters", mlog.String("channel_id", channelID), mlog.Err(err))
+		}
+		return nil
+	}
+
+	return counters
+}
+
 func (a *App) GetChannelMemberCount(c request.CTX, channelID string) (int64, *model.AppError) {
+	if counters := a.getChannelCounters(c, channelID); counters != nil {
+		return counters.MemberCount, nil
+	}
+
 	count, err := a.Srv().Store().Channel().GetMemberCount(channelID, true)
 	if err != nil {
 		return 0, model.NewAppError("GetChannelMemberCount", "app.channel.get_member_count.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -2151,6 +2215,10 @@ func (a *App) GetChannelFileCount(c request.CTX, channelID string) (int64, *mode
 }
 
 func (a *App) GetChannelGuestCount(c request.CTX, channelID string) (int64, *model.AppError) {
+	if counters := a.getChannelCounters(c, channelID); counters != nil {
+		return counters.GuestCount, nil
+	}
+
 	count, err := a.Srv().Store().Channel().GetGuestCount(channelID, true)
 	if err != nil {
 		return 0, model.NewAppError("SqlChannelStore.GetGuestCount", "app.channel.get_member_count.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -2160,6 +2228,10 @@ func (a *App) GetChannelGuestCount(c request.CTX, channelID string) (int64, *mod
 }
 
 func (a *App) GetChannelPinnedPostCount(c request.CTX, channelID string) (int64, *model.AppError) {
+	if counters := a.getChannelCounters(c, channelID); counters != nil {
+		return counters.PinnedPostCount, nil
+	}
+
 	count, err := a.Srv().Store().Channel().GetPinnedPostCount(channelID, true)
 	if err != nil {
 		return 0, model.NewAppError("GetChannelPinnedPostCount", "app.channel.get_pinnedpost_count.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -2518,6 +2590,7 @@ func (a *App) removeUserFromChannel(c request.CTX, userIDToRemove string, remove
 	if err := a.Srv().Store().ChannelMemberHistory().LogLeaveEvent(userIDToRemove, channel.Id, model.GetMillis()); err != nil {
 		return model.NewAppError("removeUserFromChannel", "app.channel_member_history.log_leave_event.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
+	a.deleteChannelMemberExpiry(c, channel.Id, userIDToRemove)
 
 	if isGuest {
 		currentMembers, err := a.GetChannelMembersForUser(c, channel.TeamId, userIDToRemove)
@@ -2835,7 +2908,7 @@ func (a *App) AutocompleteChannels(c request.CTX, userID, term string) (model.Ch
 		return nil, appErr
 	}
 
-	channelList, err := a.Srv().Store().Channel().Autocomplete(userID, term, includeDeleted, user.IsGuest())
+	channelList, err := a.Srv().Store().Channel().Autocomplete(c.Context(), userID, term, includeDeleted, user.IsGuest())
 	if err != nil {
 		return nil, model.NewAppError("AutocompleteChannels", "app.channel.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2852,7 +2925,7 @@ func (a *App) AutocompleteChannelsForTeam(c request.CTX, teamID, userID, term st
 		return nil, appErr
 	}
 
-	channelList, err := a.Srv().Store().Channel().AutocompleteInTeam(teamID, userID, term, includeDeleted, user.IsGuest())
+	channelList, err := a.Srv().Store().Channel().AutocompleteInTeam(c.Context(), teamID, userID, term, includeDeleted, user.IsGuest())
 	if err != nil {
 		return nil, model.NewAppError("AutocompleteChannels", "app.channel.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2865,7 +2938,7 @@ func (a *App) AutocompleteChannelsForSearch(c request.CTX, teamID string, userID
 
 	term = strings.TrimSpace(term)
 
-	channelList, err := a.Srv().Store().Channel().AutocompleteInTeamForSearch(teamID, userID, term, includeDeleted)
+	channelList, err := a.Srv().Store().Channel().AutocompleteInTeamForSearch(c.Context(), teamID, userID, term, includeDeleted)
 	if err != nil {
 		return nil, model.NewAppError("AutocompleteChannelsForSearch", "app.channel.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2898,7 +2971,7 @@ func (a *App) SearchAllChannels(c request.CTX, term string, opts model.ChannelSe
 
 	term = strings.TrimSpace(term)
 
-	channelList, totalCount, err := a.Srv().Store().Channel().SearchAllChannels(term, storeOpts)
+	channelList, totalCount, err := a.Srv().Store().Channel().SearchAllChannels(c.Context(), term, storeOpts)
 	if err != nil {
 		return nil, 0, model.NewAppError("SearchAllChannels", "app.channel.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2911,7 +2984,7 @@ func (a *App) SearchChannels(c request.CTX, teamID string, term string) (model.C
 
 	term = strings.TrimSpace(term)
 
-	channelList, err := a.Srv().Store().Channel().SearchInTeam(teamID, term, includeDeleted)
+	channelList, err := a.Srv().Store().Channel().SearchInTeam(c.Context(), teamID, term, includeDeleted)
 	if err != nil {
 		return nil, model.NewAppError("SearchChannels", "app.channel.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2922,7 +2995,7 @@ func (a *App) SearchChannels(c request.CTX, teamID string, term string) (model.C
 func (a *App) SearchArchivedChannels(c request.CTX, teamID string, term string, userID string) (model.ChannelList, *model.AppError) {
 	term = strings.TrimSpace(term)
 
-	channelList, err := a.Srv().Store().Channel().SearchArchivedInTeam(teamID, term, userID)
+	channelList, err := a.Srv().Store().Channel().SearchArchivedInTeam(c.Context(), teamID, term, userID)
 	if err != nil {
 		return nil, model.NewAppError("SearchArchivedChannels", "app.channel.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2935,7 +3008,7 @@ func (a *App) SearchChannelsForUser(c request.CTX, userID, teamID, term string)
 
 	term = strings.TrimSpace(term)
 
-	channelList, err := a.Srv().Store().Channel().SearchForUserInTeam(userID, teamID, term, includeDeleted)
+	channelList, err := a.Srv().Store().Channel().SearchForUserInTeam(c.Context(), userID, teamID, term, includeDeleted)
 	if err != nil {
 		return nil, model.NewAppError("SearchChannelsForUser", "app.channel.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2948,7 +3021,7 @@ func (a *App) SearchGroupChannels(c request.CTX, userID, term string) (model.Cha
 		return model.ChannelList{}, nil
 	}
 
-	channelList, err := a.Srv().Store().Channel().SearchGroupChannels(userID, term)
+	channelList, err := a.Srv().Store().Channel().SearchGroupChannels(c.Context(), userID, term)
 	if err != nil {
 		return nil, model.NewAppError("SearchGroupChannels", "app.channel.search_group_channels.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -3285,9 +3358,116 @@ func (a *App) GetPinnedPosts(c request.CTX, channelID string) (*model.PostList,
 		return nil, appErr
 	}
 
+	pinned, appErr := a.GetChannelPinnedPosts(c, channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	pinned.SortPostList(posts)
+
 	return posts, nil
 }
 
+// GetChannelPinnedPosts returns the order of the pinned posts of the channel and how many posts can
+// be pinned in it.
+func (a *App) GetChannelPinnedPosts(c request.CTX, channelID string) (*model.ChannelPinnedPosts, *model.AppError) {
+	pinned, err := a.Srv().Store().Channel().GetChannelPinnedPosts(channelID)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelPinnedPosts", "app.channel.get_pinned_posts_settings.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return pinned, nil
+}
+
+// UpdatePinnedPostsOrder reorders the pinned posts of the channel. The given posts come first, followed
+// by the other pinned posts in their current order.
+func (a *App) UpdatePinnedPostsOrder(c request.CTX, channelID string, postIDs []string) (*model.ChannelPinnedPosts, *model.AppError) {
+	posts, appErr := a.GetPinnedPosts(c, channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	pinned, appErr := a.GetChannelPinnedPosts(c, channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	order := make(model.StringArray, 0, len(posts.Order))
+	ordered := make(map[string]bool, len(postIDs))
+	for _, postID := range postIDs {
+		if _, ok := posts.Posts[postID]; !ok || ordered[postID] {
+			return nil, model.NewAppError("UpdatePinnedPostsOrder", "app.channel.update_pinned_posts_order.invalid_post.app_error", nil, "post_id="+postID, http.StatusBadRequest)
+		}
+		order = append(order, postID)
+		ordered[postID] = true
+	}
+	for _, postID := range posts.Order {
+		if !ordered[postID] {
+			order = append(order, postID)
+		}
+	}
+	pinned.PostOrder = order
+
+	saved, appErr := a.saveChannelPinnedPosts(pinned)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	orderJSON, err := json.Marshal(saved.PostOrder)
+	if err != nil {
+		c.Logger().Warn("Failed to encode the pinned posts order to JSON", mlog.Err(err))
+	}
+	message := model.NewWebSocketEvent(model.WebsocketEventPinnedPostsOrderChanged, "", channelID, "", nil, "")
+	message.Add("order", string(orderJSON))
+	a.Publish(message)
+
+	return saved, nil
+}
+
+// UpdatePinnedPostsLimit sets how many posts can be pinned in the channel, 0 meaning no limit. The
+// posts already pinned stay pinned when there are more than the new limit.
+func (a *App) UpdatePinnedPostsLimit(c request.CTX, channelID string, limit int) (*model.ChannelPinnedPosts, *model.AppError) {
+	pinned, appErr := a.GetChannelPinnedPosts(c, channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	pinned.PinLimit = limit
+	return a.saveChannelPinnedPosts(pinned)
+}
+
+func (a *App) saveChannelPinnedPosts(pinned *model.ChannelPinnedPosts) (*model.ChannelPinnedPosts, *model.AppError) {
+	saved, err := a.Srv().Store().Channel().SaveChannelPinnedPosts(pinned)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("saveChannelPinnedPosts", "app.channel.save_pinned_posts_settings.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	return saved, nil
+}
+
+// checkPinnedPostsLimit returns an error if no more posts can be pinned in the channel.
+func (a *App) checkPinnedPostsLimit(channelID string) *model.AppError {
+	pinned, err := a.Srv().Store().Channel().GetChannelPinnedPosts(channelID)
+	if err != nil {
+		return model.NewAppError("checkPinnedPostsLimit", "app.channel.get_pinned_posts_settings.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if pinned.PinLimit == 0 {
+		return nil
+	}
+
+	count, err := a.Srv().Store().Channel().GetPinnedPostCount(channelID, false)
+	if err != nil {
+		return model.NewAppError("checkPinnedPostsLimit", "app.channel.get_pinnedpost_count.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if count >= int64(pinned.PinLimit) {
+		return model.NewAppError("checkPinnedPostsLimit", "app.channel.pinned_posts_limit_reached.app_error", map[string]any{"Limit": pinned.PinLimit}, "", http.StatusBadRequest)
+	}
+	return nil
+}
+
 func (a *App) ToggleMuteChannel(c request.CTX, channelID, userID string) (*model.ChannelMember, *model.AppError) {
 	member, nErr := a.Srv().Store().Channel().GetMember(context.Background(), channelID, userID)
 	if nErr != nil {
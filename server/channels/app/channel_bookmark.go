@@ -0,0 +1,221 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// GetChannelBookmarks returns the bookmarks of the channel in order, along with the files they
+// bookmark.
+func (a *App) GetChannelBookmarks(channelID string) ([]*model.ChannelBookmarkWithFileInfo, *model.AppError) {
+	bookmarks, err := a.Srv().Store().ChannelBookmark().GetBookmarksForChannel(channelID)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelBookmarks", "app.channel_bookmark.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	withFileInfos := make([]*model.ChannelBookmarkWithFileInfo, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		withFileInfos = append(withFileInfos, a.channelBookmarkWithFileInfo(bookmark))
+	}
+	return withFileInfos, nil
+}
+
+// GetChannelBookmark returns a bookmark along with the file it bookmarks.
+func (a *App) GetChannelBookmark(bookmarkID string, includeDeleted bool) (*model.ChannelBookmarkWithFileInfo, *model.AppError) {
+	bookmark, err := a.Srv().Store().ChannelBookmark().Get(bookmarkID, includeDeleted)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetChannelBookmark", "app.channel_bookmark.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetChannelBookmark", "app.channel_bookmark.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return a.channelBookmarkWithFileInfo(bookmark), nil
+}
+
+func (a *App) channelBookmarkWithFileInfo(bookmark *model.ChannelBookmark) *model.ChannelBookmarkWithFileInfo {
+	withFileInfo := &model.ChannelBookmarkWithFileInfo{ChannelBookmark: bookmark}
+	if bookmark.Type == model.ChannelBookmarkFile {
+		// the bookmark stays when its file is deleted, for it to be replaced or deleted
+		if fileInfo, appErr := a.GetFileInfo(bookmark.FileId); appErr == nil && fileInfo.DeleteAt == 0 {
+			withFileInfo.FileInfo = fileInfo
+		}
+	}
+	return withFileInfo
+}
+
+// validateChannelBookmarkFile checks that the bookmarked file was uploaded to the bookmark's channel.
+func (a *App) validateChannelBookmarkFile(where string, bookmark *model.ChannelBookmark) *model.AppError {
+	if bookmark.Type != model.ChannelBookmarkFile {
+		return nil
+	}
+
+	fileInfo, appErr := a.GetFileInfo(bookmark.FileId)
+	if appErr != nil || fileInfo.DeleteAt != 0 || fileInfo.ChannelId != bookmark.ChannelId {
+		return model.NewAppError(where, "app.channel_bookmark.invalid_file.app_error", nil, "file_id="+bookmark.FileId, http.StatusBadRequest)
+	}
+	return nil
+}
+
+// CreateChannelBookmark adds a bookmark after the existing ones of its channel.
+func (a *App) CreateChannelBookmark(c request.CTX, bookmark *model.ChannelBookmark, connectionID string) (*model.ChannelBookmarkWithFileInfo, *model.AppError) {
+	channel, appErr := a.GetChannel(c, bookmark.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if channel.DeleteAt != 0 {
+		return nil, model.NewAppError("CreateChannelBookmark", "app.channel_bookmark.channel_archived.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	existing, err := a.Srv().Store().ChannelBookmark().GetBookmarksForChannel(bookmark.ChannelId)
+	if err != nil {
+		return nil, model.NewAppError("CreateChannelBookmark", "app.channel_bookmark.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if len(existing) >= model.ChannelBookmarksMaxPerChannel {
+		return nil, model.NewAppError("CreateChannelBookmark", "app.channel_bookmark.max_reached.app_error", map[string]any{"Max": model.ChannelBookmarksMaxPerChannel}, "", http.StatusBadRequest)
+	}
+
+	if appErr := a.validateChannelBookmarkFile("CreateChannelBookmark", bookmark); appErr != nil {
+		return nil, appErr
+	}
+
+	bookmark.Id = ""
+	bookmark.SortOrder = 0
+	if len(existing) > 0 {
+		bookmark.SortOrder = existing[len(existing)-1].SortOrder + 1
+	}
+
+	saved, err := a.Srv().Store().ChannelBookmark().Save(bookmark)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreateChannelBookmark", "app.channel_bookmark.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	withFileInfo := a.channelBookmarkWithFileInfo(saved)
+	a.publishChannelBookmarkEvent(model.WebsocketEventChannelBookmarkCreated, saved.ChannelId, "bookmark", withFileInfo, connectionID)
+
+	return withFileInfo, nil
+}
+
+// PatchChannelBookmark changes the name, the emoji, and the link or the file of a bookmark.
+func (a *App) PatchChannelBookmark(bookmarkID string, patch *model.ChannelBookmarkPatch, connectionID string) (*model.ChannelBookmarkWithFileInfo, *model.AppError) {
+	existing, appErr := a.GetChannelBookmark(bookmarkID, false)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	bookmark := existing.ChannelBookmark.Clone()
+	bookmark.Patch(patch)
+
+	if appErr := a.validateChannelBookmarkFile("PatchChannelBookmark", bookmark); appErr != nil {
+		return nil, appErr
+	}
+
+	if err := a.Srv().Store().ChannelBookmark().Update(bookmark); err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("PatchChannelBookmark", "app.channel_bookmark.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("PatchChannelBookmark", "app.channel_bookmark.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	withFileInfo := a.channelBookmarkWithFileInfo(bookmark)
+	a.publishChannelBookmarkEvent(model.WebsocketEventChannelBookmarkUpdated, bookmark.ChannelId, "bookmark", withFileInfo, connectionID)
+
+	return withFileInfo, nil
+}
+
+// DeleteChannelBookmark removes a bookmark from its channel. The bookmarked file isn't deleted.
+func (a *App) DeleteChannelBookmark(bookmarkID, connectionID string) (*model.ChannelBookmarkWithFileInfo, *model.AppError) {
+	bookmark, appErr := a.GetChannelBookmark(bookmarkID, false)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	deleteAt := model.GetMillis()
+	if err := a.Srv().Store().ChannelBookmark().Delete(bookmarkID, deleteAt); err != nil {
+		return nil, model.NewAppError("DeleteChannelBookmark", "app.channel_bookmark.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	bookmark.DeleteAt = deleteAt
+	bookmark.UpdateAt = deleteAt
+
+	a.publishChannelBookmarkEvent(model.WebsocketEventChannelBookmarkDeleted, bookmark.ChannelId, "bookmark", bookmark, connectionID)
+
+	return bookmark, nil
+}
+
+// UpdateChannelBookmarkSortOrder reorders the bookmarks of the channel. The bookmarks left out of
+// the order come after the ordered ones, in the order they were in.
+func (a *App) UpdateChannelBookmarkSortOrder(channelID string, order []string, connectionID string) ([]*model.ChannelBookmarkWithFileInfo, *model.AppError) {
+	bookmarks, err := a.Srv().Store().ChannelBookmark().GetBookmarksForChannel(channelID)
+	if err != nil {
+		return nil, model.NewAppError("UpdateChannelBookmarkSortOrder", "app.channel_bookmark.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	inChannel := make(map[string]bool, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		inChannel[bookmark.Id] = true
+	}
+
+	bookmarkIDs := make([]string, 0, len(bookmarks))
+	ordered := make(map[string]bool, len(order))
+	for _, bookmarkID := range order {
+		if !inChannel[bookmarkID] || ordered[bookmarkID] {
+			return nil, model.NewAppError("UpdateChannelBookmarkSortOrder", "app.channel_bookmark.invalid_order.app_error", nil, "bookmark_id="+bookmarkID, http.StatusBadRequest)
+		}
+		bookmarkIDs = append(bookmarkIDs, bookmarkID)
+		ordered[bookmarkID] = true
+	}
+	for _, bookmark := range bookmarks {
+		if !ordered[bookmark.Id] {
+			bookmarkIDs = append(bookmarkIDs, bookmark.Id)
+		}
+	}
+
+	if err := a.Srv().Store().ChannelBookmark().UpdateSortOrder(channelID, bookmarkIDs); err != nil {
+		return nil, model.NewAppError("UpdateChannelBookmarkSortOrder", "app.channel_bookmark.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	sorted, appErr := a.GetChannelBookmarks(channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	a.publishChannelBookmarkEvent(model.WebsocketEventChannelBookmarkSorted, channelID, "bookmarks", sorted, connectionID)
+
+	return sorted, nil
+}
+
+func (a *App) publishChannelBookmarkEvent(event, channelID, key string, data any, connectionID string) {
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		mlog.Warn("Failed to encode channel bookmarks to JSON", mlog.Err(err))
+		return
+	}
+
+	message := model.NewWebSocketEvent(event, "", channelID, "", nil, connectionID)
+	message.Add(key, string(dataJSON))
+	a.Publish(message)
+}
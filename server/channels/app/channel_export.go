@@ -0,0 +1,333 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"html/template"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const channelArchiveBatchSize = 1000
+
+// channelArchive is the JSON document included in channel archives, alongside the HTML rendering
+// of the same content.
+type channelArchive struct {
+	ExportedAt int64                          `json:"exported_at"`
+	Channel    *model.Channel                 `json:"channel"`
+	Users      map[string]*channelArchiveUser `json:"users"`
+	// The root posts of the channel, ordered by creation time. Replies are nested under their root.
+	Posts []*channelArchivePost `json:"posts"`
+}
+
+type channelArchiveUser struct {
+	Id       string `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+type channelArchivePost struct {
+	Id        string                `json:"id"`
+	RootId    string                `json:"root_id,omitempty"`
+	UserId    string                `json:"user_id"`
+	CreateAt  int64                 `json:"create_at"`
+	EditAt    int64                 `json:"edit_at,omitempty"`
+	Type      string                `json:"type,omitempty"`
+	Message   string                `json:"message"`
+	Reactions []*model.Reaction     `json:"reactions,omitempty"`
+	Files     []*channelArchiveFile `json:"files,omitempty"`
+	Replies   []*channelArchivePost `json:"replies,omitempty"`
+}
+
+type channelArchiveFile struct {
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	MimeType string `json:"mime_type"`
+	Size     int64  `json:"size"`
+	// The location of the file within the archive, or empty if it couldn't be read from the file store.
+	Path string `json:"path,omitempty"`
+
+	info *model.FileInfo
+}
+
+func (f *channelArchiveFile) IsImage() bool {
+	return f.Path != "" && strings.HasPrefix(f.MimeType, "image/")
+}
+
+// ExportChannelArchive writes a zip archive of the channel's full history to w. The archive contains
+// an index.html page that can be browsed without a server, a channel.json file with the same
+// content for automated processing, and the files attached to the channel's posts.
+func (a *App) ExportChannelArchive(c request.CTX, channelID string, w io.Writer) *model.AppError {
+	channel, appErr := a.GetChannel(c, channelID)
+	if appErr != nil {
+		return appErr
+	}
+
+	posts, err := a.getChannelArchivePosts(channelID)
+	if err != nil {
+		return model.NewAppError("ExportChannelArchive", "app.channel_export.get_posts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	users, err := a.getChannelArchiveUsers(posts)
+	if err != nil {
+		return model.NewAppError("ExportChannelArchive", "app.channel_export.get_users.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	zipWr := zip.NewWriter(w)
+
+	archive := &channelArchive{
+		ExportedAt: model.GetMillis(),
+		Channel:    channel,
+		Users:      users,
+		Posts:      threadChannelArchivePosts(posts),
+	}
+
+	for _, post := range posts {
+		for _, file := range post.Files {
+			if err := a.writeChannelArchiveFile(zipWr, file); err != nil {
+				// The rest of the history is still worth exporting when an attachment is missing.
+				c.Logger().Warn("Failed to add file to channel archive", mlog.String("channel_id", channelID), mlog.String("file_id", file.Id), mlog.Err(err))
+				file.Path = ""
+			}
+		}
+	}
+
+	jsonWr, err := zipWr.Create("channel.json")
+	if err != nil {
+		return model.NewAppError("ExportChannelArchive", "app.channel_export.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	encoder := json.NewEncoder(jsonWr)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(archive); err != nil {
+		return model.NewAppError("ExportChannelArchive", "app.channel_export.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	htmlWr, err := zipWr.Create("index.html")
+	if err != nil {
+		return model.NewAppError("ExportChannelArchive", "app.channel_export.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if err := channelArchiveTemplate.Execute(htmlWr, archive); err != nil {
+		return model.NewAppError("ExportChannelArchive", "app.channel_export.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := zipWr.Close(); err != nil {
+		return model.NewAppError("ExportChannelArchive", "app.channel_export.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// getChannelArchivePosts returns every post of the channel that hasn't been deleted, along with
+// its reactions and attachments, ordered by creation time.
+func (a *App) getChannelArchivePosts(channelID string) ([]*channelArchivePost, error) {
+	var archivePosts []*channelArchivePost
+	var cursor model.GetPostsSinceForSyncCursor
+	for {
+		var posts []*model.Post
+		var err error
+		posts, cursor, err = a.Srv().Store().Post().GetPostsSinceForSync(model.GetPostsSinceForSyncOptions{ChannelId: channelID}, cursor, channelArchiveBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(posts) == 0 {
+			break
+		}
+
+		// the archive is readable by anyone it's shared with, so restricted posts are left out
+		posts = filterUnrestrictedPosts(posts)
+		if len(posts) == 0 {
+			continue
+		}
+
+		postIDs := make([]string, 0, len(posts))
+		var fileIDs []string
+		for _, post := range posts {
+			postIDs = append(postIDs, post.Id)
+			fileIDs = append(fileIDs, post.FileIds...)
+		}
+
+		reactions, err := a.Srv().Store().Reaction().BulkGetForPosts(postIDs)
+		if err != nil {
+			return nil, err
+		}
+		reactionsByPost := make(map[string][]*model.Reaction, len(posts))
+		for _, reaction := range reactions {
+			reactionsByPost[reaction.PostId] = append(reactionsByPost[reaction.PostId], reaction)
+		}
+
+		filesByPost := make(map[string][]*channelArchiveFile, len(posts))
+		if len(fileIDs) > 0 {
+			fileInfos, err := a.Srv().Store().FileInfo().GetByIds(fileIDs)
+			if err != nil {
+				return nil, err
+			}
+			for _, info := range fileInfos {
+				filesByPost[info.PostId] = append(filesByPost[info.PostId], &channelArchiveFile{
+					Id:       info.Id,
+					Name:     info.Name,
+					MimeType: info.MimeType,
+					Size:     info.Size,
+					Path:     path.Join("files", info.Id, sanitizeChannelArchiveFileName(info.Name)),
+
+					info: info,
+				})
+			}
+		}
+
+		for _, post := range posts {
+			archivePosts = append(archivePosts, &channelArchivePost{
+				Id:        post.Id,
+				RootId:    post.RootId,
+				UserId:    post.UserId,
+				CreateAt:  post.CreateAt,
+				EditAt:    post.EditAt,
+				Type:      post.Type,
+				Message:   post.Message,
+				Reactions: reactionsByPost[post.Id],
+				Files:     filesByPost[post.Id],
+			})
+		}
+	}
+
+	sort.Slice(archivePosts, func(i, j int) bool {
+		return archivePosts[i].CreateAt < archivePosts[j].CreateAt
+	})
+
+	return archivePosts, nil
+}
+
+func (a *App) getChannelArchiveUsers(posts []*channelArchivePost) (map[string]*channelArchiveUser, error) {
+	userIDs := make([]string, 0, len(posts))
+	seen := make(map[string]bool, len(posts))
+	for _, post := range posts {
+		if !seen[post.UserId] {
+			seen[post.UserId] = true
+			userIDs = append(userIDs, post.UserId)
+		}
+	}
+
+	users := make(map[string]*channelArchiveUser, len(userIDs))
+	for i := 0; i < len(userIDs); i += channelArchiveBatchSize {
+		end := i + channelArchiveBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+
+		profiles, err := a.Srv().Store().User().GetProfileByIds(context.Background(), userIDs[i:end], &store.UserGetByIdsOpts{}, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, profile := range profiles {
+			users[profile.Id] = &channelArchiveUser{
+				Id:       profile.Id,
+				Username: profile.Username,
+				Name:     profile.GetFullName(),
+			}
+		}
+	}
+
+	return users, nil
+}
+
+// threadChannelArchivePosts nests the replies under their root post, keeping both ordered by
+// creation time. Replies whose root isn't part of the archive are kept at the top level.
+func threadChannelArchivePosts(posts []*channelArchivePost) []*channelArchivePost {
+	roots := make(map[string]*channelArchivePost, len(posts))
+	for _, post := range posts {
+		if post.RootId == "" {
+			roots[post.Id] = post
+		}
+	}
+
+	var threaded []*channelArchivePost
+	for _, post := range posts {
+		if root, ok := roots[post.RootId]; ok {
+			root.Replies = append(root.Replies, post)
+		} else {
+			threaded = append(threaded, post)
+		}
+	}
+
+	return threaded
+}
+
+func (a *App) writeChannelArchiveFile(zipWr *zip.Writer, file *channelArchiveFile) error {
+	reader, appErr := a.FileInfoReader(file.info)
+	if appErr != nil {
+		return appErr
+	}
+	defer reader.Close()
+
+	fileWr, err := zipWr.Create(file.Path)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fileWr, reader)
+	return err
+}
+
+func sanitizeChannelArchiveFileName(name string) string {
+	name = strings.NewReplacer("/", "_", "\\", "_").Replace(name)
+	if name == "" || name == "." || name == ".." {
+		return "file"
+	}
+	return name
+}
+
+var channelArchiveTemplate = template.Must(template.New("channel_archive").Funcs(template.FuncMap{
+	"formatTime": func(millis int64) string {
+		return model.GetTimeForMillis(millis).UTC().Format("2006-01-02 15:04:05 UTC")
+	},
+	"withUsers": func(post *channelArchivePost, users map[string]*channelArchiveUser) map[string]any {
+		return map[string]any{"Post": post, "Users": users}
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{if .Channel.DisplayName}}{{.Channel.DisplayName}}{{else}}{{.Channel.Name}}{{end}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em auto; max-width: 960px; color: #3f4350; }
+header { border-bottom: 1px solid #ddd; margin-bottom: 1em; }
+.post { padding: 0.5em 0; border-bottom: 1px solid #eee; }
+.replies { margin-left: 2em; border-left: 3px solid #ddd; padding-left: 1em; }
+.author { font-weight: bold; }
+.time, .edited, .reactions, .files { color: #888; font-size: 0.85em; }
+.message { white-space: pre-wrap; word-wrap: break-word; margin: 0.25em 0; }
+.files img { display: block; max-width: 480px; max-height: 360px; margin: 0.25em 0; }
+</style>
+</head>
+<body>
+<header>
+<h1>{{if .Channel.DisplayName}}{{.Channel.DisplayName}}{{else}}{{.Channel.Name}}{{end}}</h1>
+{{if .Channel.Purpose}}<p>{{.Channel.Purpose}}</p>{{end}}
+<p class="time">Exported on {{formatTime .ExportedAt}}</p>
+</header>
+{{define "post"}}
+<div class="post" id="{{.Post.Id}}">
+<span class="author">{{with index .Users .Post.UserId}}{{.Username}}{{if .Name}} ({{.Name}}){{end}}{{else}}{{.Post.UserId}}{{end}}</span>
+<span class="time">{{formatTime .Post.CreateAt}}</span>{{if .Post.EditAt}} <span class="edited">(edited)</span>{{end}}
+<div class="message">{{.Post.Message}}</div>
+{{if .Post.Files}}<div class="files">{{range .Post.Files}}{{if .IsImage}}<a href="{{.Path}}"><img src="{{.Path}}" alt="{{.Name}}"></a>{{else if .Path}}<a href="{{.Path}}">{{.Name}}</a><br>{{else}}{{.Name}} (unavailable)<br>{{end}}{{end}}</div>{{end}}
+{{if .Post.Reactions}}<div class="reactions">{{range .Post.Reactions}}:{{.EmojiName}}: {{end}}</div>{{end}}
+{{if .Post.Replies}}<div class="replies">{{$users := .Users}}{{range .Post.Replies}}{{template "post" (withUsers . $users)}}{{end}}</div>{{end}}
+</div>
+{{end}}
+{{$users := .Users}}
+{{range .Posts}}{{template "post" (withUsers . $users)}}{{end}}
+</body>
+</html>
+`))
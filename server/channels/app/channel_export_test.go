@@ -0,0 +1,102 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/utils/testutils"
+)
+
+func TestExportChannelArchive(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	channel := th.CreateChannel(th.Context, th.BasicTeam)
+
+	data, err := testutils.ReadTestFile("test.png")
+	require.NoError(t, err)
+	fileInfo, appErr := th.App.UploadFile(th.Context, data, channel.Id, "test.png")
+	require.Nil(t, appErr)
+
+	root, appErr := th.App.CreatePost(th.Context, &model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: channel.Id,
+		Message:   "<script>alert('root')</script>",
+		FileIds:   []string{fileInfo.Id},
+	}, channel, false, true)
+	require.Nil(t, appErr)
+
+	reply, appErr := th.App.CreatePost(th.Context, &model.Post{
+		UserId:    th.BasicUser2.Id,
+		ChannelId: channel.Id,
+		RootId:    root.Id,
+		Message:   "a reply",
+	}, channel, false, true)
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.SaveReactionForPost(th.Context, &model.Reaction{UserId: th.BasicUser2.Id, PostId: root.Id, EmojiName: "smile"})
+	require.Nil(t, appErr)
+
+	deleted := th.CreatePost(channel)
+	_, appErr = th.App.DeletePost(th.Context, deleted.Id, th.BasicUser.Id)
+	require.Nil(t, appErr)
+
+	var buf bytes.Buffer
+	require.Nil(t, th.App.ExportChannelArchive(th.Context, channel.Id, &buf))
+
+	zipReader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	files := map[string][]byte{}
+	for _, file := range zipReader.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		files[file.Name], err = io.ReadAll(reader)
+		require.NoError(t, err)
+		reader.Close()
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var archive channelArchive
+		require.NoError(t, json.Unmarshal(files["channel.json"], &archive))
+		assert.Equal(t, channel.Id, archive.Channel.Id)
+		assert.Equal(t, th.BasicUser2.Username, archive.Users[th.BasicUser2.Id].Username)
+
+		require.Len(t, archive.Posts, 1)
+		post := archive.Posts[0]
+		assert.Equal(t, root.Id, post.Id)
+		require.Len(t, post.Replies, 1)
+		assert.Equal(t, reply.Id, post.Replies[0].Id)
+		require.Len(t, post.Reactions, 1)
+		assert.Equal(t, "smile", post.Reactions[0].EmojiName)
+		require.Len(t, post.Files, 1)
+		assert.Equal(t, "files/"+fileInfo.Id+"/test.png", post.Files[0].Path)
+	})
+
+	t.Run("html", func(t *testing.T) {
+		html := string(files["index.html"])
+		assert.Contains(t, html, "a reply")
+		assert.Contains(t, html, th.BasicUser.Username)
+		assert.Contains(t, html, `src="files/`+fileInfo.Id+`/test.png"`)
+		assert.NotContains(t, html, "<script>alert")
+		assert.NotContains(t, html, deleted.Message)
+	})
+
+	t.Run("attachments", func(t *testing.T) {
+		assert.Equal(t, data, files["files/"+fileInfo.Id+"/test.png"])
+	})
+
+	t.Run("unknown channel", func(t *testing.T) {
+		appErr := th.App.ExportChannelArchive(th.Context, model.NewId(), io.Discard)
+		require.NotNil(t, appErr)
+	})
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const (
+	channelJoinRequestActionURLPrefix = "/channel_join_requests/"
+	channelJoinRequestActionApprove   = "approve"
+	channelJoinRequestActionDeny      = "deny"
+)
+
+// RequestToJoinChannel records the request of the user to join the private channel and posts it
+// in the channel for the channel admins to approve or deny.
+func (a *App) RequestToJoinChannel(c request.CTX, channel *model.Channel, userID string, message string) (*model.ChannelJoinRequest, *model.AppError) {
+	if channel.Type != model.ChannelTypePrivate || channel.DeleteAt != 0 {
+		return nil, model.NewAppError("RequestToJoinChannel", "app.channel_join_request.channel.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	if _, appErr := a.GetChannelMember(c, channel.Id, userID); appErr == nil {
+		return nil, model.NewAppError("RequestToJoinChannel", "app.channel_join_request.already_member.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	} else if appErr.Id != MissingChannelMemberError {
+		return nil, appErr
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if _, err := a.Srv().Store().ChannelJoinRequest().GetPending(channel.Id, userID); err == nil {
+		return nil, model.NewAppError("RequestToJoinChannel", "app.channel_join_request.pending.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	} else {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return nil, model.NewAppError("RequestToJoinChannel", "app.channel_join_request.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	joinRequest, err := a.Srv().Store().ChannelJoinRequest().Save(&model.ChannelJoinRequest{
+		ChannelId: channel.Id,
+		UserId:    userID,
+		Message:   message,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("RequestToJoinChannel", "app.channel_join_request.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	// The request stands even when the approval post can't be created, the channel admins
+	// still find it through the API.
+	if post, appErr := a.postChannelJoinRequest(c, channel, user, joinRequest); appErr != nil {
+		c.Logger().Warn("Failed to post channel join request", mlog.String("channel_id", channel.Id), mlog.String("user_id", userID), mlog.Err(appErr))
+	} else {
+		joinRequest.PostId = post.Id
+		if joinRequest, err = a.Srv().Store().ChannelJoinRequest().Update(joinRequest); err != nil {
+			return nil, model.NewAppError("RequestToJoinChannel", "app.channel_join_request.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.publishChannelJoinRequestEvent(model.WebsocketEventChannelJoinRequestCreated, joinRequest)
+
+	return joinRequest, nil
+}
+
+// GetChannelJoinRequest returns the request to join a channel with the given id.
+func (a *App) GetChannelJoinRequest(requestID string) (*model.ChannelJoinRequest, *model.AppError) {
+	joinRequest, err := a.Srv().Store().ChannelJoinRequest().Get(requestID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetChannelJoinRequest", "app.channel_join_request.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetChannelJoinRequest", "app.channel_join_request.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return joinRequest, nil
+}
+
+// GetChannelJoinRequests returns a page of the requests to join the channel, the most recent
+// first. An empty status returns the requests whatever their status.
+func (a *App) GetChannelJoinRequests(channelID string, status string, page int, perPage int) ([]*model.ChannelJoinRequest, *model.AppError) {
+	requests, err := a.Srv().Store().ChannelJoinRequest().GetForChannel(channelID, status, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelJoinRequests", "app.channel_join_request.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return requests, nil
+}
+
+// DecideChannelJoinRequest approves or denies a pending request to join a channel, adding the
+// user to the channel when approved, and records who made the decision.
+func (a *App) DecideChannelJoinRequest(c *request.Context, joinRequest *model.ChannelJoinRequest, deciderID string, approve bool) (*model.ChannelJoinRequest, *model.AppError) {
+	if !joinRequest.IsPending() {
+		return nil, model.NewAppError("DecideChannelJoinRequest", "app.channel_join_request.decided.app_error", nil, "id="+joinRequest.Id, http.StatusBadRequest)
+	}
+
+	channel, appErr := a.GetChannel(c, joinRequest.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if approve {
+		if _, appErr = a.AddChannelMember(c, joinRequest.UserId, channel, ChannelMemberOpts{UserRequestorID: deciderID}); appErr != nil {
+			return nil, appErr
+		}
+		joinRequest.Status = model.ChannelJoinRequestStatusApproved
+	} else {
+		joinRequest.Status = model.ChannelJoinRequestStatusDenied
+	}
+	joinRequest.DeciderId = deciderID
+
+	joinRequest, err := a.Srv().Store().ChannelJoinRequest().Update(joinRequest)
+	if err != nil {
+		return nil, model.NewAppError("DecideChannelJoinRequest", "app.channel_join_request.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if joinRequest.PostId != "" {
+		if appErr = a.updateChannelJoinRequestPost(c, joinRequest); appErr != nil {
+			c.Logger().Warn("Failed to update channel join request post", mlog.String("post_id", joinRequest.PostId), mlog.Err(appErr))
+		}
+	}
+
+	a.publishChannelJoinRequestEvent(model.WebsocketEventChannelJoinRequestUpdated, joinRequest)
+
+	return joinRequest, nil
+}
+
+func (a *App) postChannelJoinRequest(c request.CTX, channel *model.Channel, user *model.User, joinRequest *model.ChannelJoinRequest) (*model.Post, *model.AppError) {
+	systemBot, appErr := a.GetSystemBot()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	action := func(name, decision string) *model.PostAction {
+		return &model.PostAction{
+			Name: name,
+			Type: model.PostActionTypeButton,
+			Integration: &model.PostActionIntegration{
+				URL: channelJoinRequestActionURLPrefix + path.Join(joinRequest.Id, decision),
+			},
+		}
+	}
+
+	post := &model.Post{
+		ChannelId: channel.Id,
+		UserId:    systemBot.UserId,
+		Message:   i18n.T("app.channel_join_request.post.message", map[string]any{"Username": "@" + user.Username}),
+		Props: model.StringInterface{
+			"channel_join_request_id": joinRequest.Id,
+		},
+	}
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{{
+		Text: joinRequest.Message,
+		Actions: []*model.PostAction{
+			action(i18n.T("app.channel_join_request.post.approve"), channelJoinRequestActionApprove),
+			action(i18n.T("app.channel_join_request.post.deny"), channelJoinRequestActionDeny),
+		},
+	}})
+
+	return a.CreatePost(c, post, channel, false, true)
+}
+
+// updateChannelJoinRequestPost replaces the buttons of the approval post with the decision.
+func (a *App) updateChannelJoinRequestPost(c *request.Context, joinRequest *model.ChannelJoinRequest) *model.AppError {
+	post, appErr := a.GetSinglePost(joinRequest.PostId, false)
+	if appErr != nil {
+		return appErr
+	}
+
+	decider, appErr := a.GetUser(joinRequest.DeciderId)
+	if appErr != nil {
+		return appErr
+	}
+
+	decisionID := "app.channel_join_request.post.denied"
+	if joinRequest.Status == model.ChannelJoinRequestStatusApproved {
+		decisionID = "app.channel_join_request.post.approved"
+	}
+
+	post = post.Clone()
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{{
+		Text:   joinRequest.Message,
+		Footer: i18n.T(decisionID, map[string]any{"Username": "@" + decider.Username}),
+	}})
+
+	_, appErr = a.UpdatePost(c, post, false)
+	return appErr
+}
+
+// doLocalChannelJoinRequestAction handles a click on the buttons of an approval post, whose
+// url is /channel_join_requests/{request_id}/{approve|deny}.
+func (a *App) doLocalChannelJoinRequestAction(c *request.Context, rawURL string, upstreamRequest *model.PostActionIntegrationRequest) *model.AppError {
+	requestID, decision, _ := strings.Cut(strings.TrimPrefix(path.Clean(rawURL), channelJoinRequestActionURLPrefix), "/")
+	if !model.IsValidId(requestID) || (decision != channelJoinRequestActionApprove && decision != channelJoinRequestActionDeny) {
+		return model.NewAppError("doLocalChannelJoinRequestAction", "api.post.do_action.action_integration.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	joinRequest, appErr := a.GetChannelJoinRequest(requestID)
+	if appErr != nil {
+		return appErr
+	}
+
+	if joinRequest.ChannelId != upstreamRequest.ChannelId {
+		return model.NewAppError("doLocalChannelJoinRequestAction", "api.post.do_action.action_integration.app_error", nil, "channel_id doesn't match", http.StatusBadRequest)
+	}
+
+	channel, appErr := a.GetChannel(c, joinRequest.ChannelId)
+	if appErr != nil {
+		return appErr
+	}
+
+	permission := model.PermissionManagePrivateChannelMembers
+	if channel.Type == model.ChannelTypeOpen {
+		permission = model.PermissionManagePublicChannelMembers
+	}
+	if !a.HasPermissionToChannel(c, upstreamRequest.UserId, channel.Id, permission) {
+		return a.MakePermissionError(c.Session(), []*model.Permission{permission})
+	}
+
+	_, appErr = a.DecideChannelJoinRequest(c, joinRequest, upstreamRequest.UserId, decision == channelJoinRequestActionApprove)
+	return appErr
+}
+
+func (a *App) publishChannelJoinRequestEvent(event string, joinRequest *model.ChannelJoinRequest) {
+	requestJSON, err := json.Marshal(joinRequest)
+	if err != nil {
+		mlog.Warn("Failed to encode channel join request to JSON", mlog.Err(err))
+		return
+	}
+
+	// The requester isn't a member of the channel until the request is approved, so they get
+	// their own copy of the event.
+	message := model.NewWebSocketEvent(event, "", joinRequest.ChannelId, "", nil, "")
+	message.Add("channel_join_request", string(requestJSON))
+	addOmitUsers(message, map[string]bool{joinRequest.UserId: true})
+	a.Publish(message)
+
+	message = model.NewWebSocketEvent(event, "", "", joinRequest.UserId, nil, "")
+	message.Add("channel_join_request", string(requestJSON))
+	a.Publish(message)
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestRequestToJoinChannel(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	channel := th.CreatePrivateChannel(th.Context, th.BasicTeam)
+	user := th.CreateUser()
+	th.LinkUserToTeam(user, th.BasicTeam)
+
+	t.Run("rejects public channels", func(t *testing.T) {
+		_, appErr := th.App.RequestToJoinChannel(th.Context, th.BasicChannel, user.Id, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel_join_request.channel.app_error", appErr.Id)
+	})
+
+	t.Run("rejects channel members", func(t *testing.T) {
+		_, appErr := th.App.RequestToJoinChannel(th.Context, channel, th.BasicUser.Id, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel_join_request.already_member.app_error", appErr.Id)
+	})
+
+	joinRequest, appErr := th.App.RequestToJoinChannel(th.Context, channel, user.Id, "let me in")
+	require.Nil(t, appErr)
+	assert.Equal(t, model.ChannelJoinRequestStatusPending, joinRequest.Status)
+	require.NotEmpty(t, joinRequest.PostId)
+
+	post, appErr := th.App.GetSinglePost(joinRequest.PostId, false)
+	require.Nil(t, appErr)
+	assert.Equal(t, channel.Id, post.ChannelId)
+	assert.Equal(t, joinRequest.Id, post.GetProp("channel_join_request_id"))
+	require.Len(t, post.Attachments(), 1)
+	assert.Equal(t, "let me in", post.Attachments()[0].Text)
+	assert.Len(t, post.Attachments()[0].Actions, 2)
+
+	t.Run("rejects a second pending request", func(t *testing.T) {
+		_, appErr := th.App.RequestToJoinChannel(th.Context, channel, user.Id, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel_join_request.pending.app_error", appErr.Id)
+	})
+
+	requests, appErr := th.App.GetChannelJoinRequests(channel.Id, model.ChannelJoinRequestStatusPending, 0, 10)
+	require.Nil(t, appErr)
+	require.Len(t, requests, 1)
+	assert.Equal(t, joinRequest.Id, requests[0].Id)
+}
+
+func TestDecideChannelJoinRequest(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	channel := th.CreatePrivateChannel(th.Context, th.BasicTeam)
+
+	t.Run("approve adds the user to the channel", func(t *testing.T) {
+		user := th.CreateUser()
+		th.LinkUserToTeam(user, th.BasicTeam)
+
+		joinRequest, appErr := th.App.RequestToJoinChannel(th.Context, channel, user.Id, "")
+		require.Nil(t, appErr)
+
+		decided, appErr := th.App.DecideChannelJoinRequest(th.Context, joinRequest, th.BasicUser.Id, true)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.ChannelJoinRequestStatusApproved, decided.Status)
+		assert.Equal(t, th.BasicUser.Id, decided.DeciderId)
+
+		_, appErr = th.App.GetChannelMember(th.Context, channel.Id, user.Id)
+		require.Nil(t, appErr)
+
+		post, appErr := th.App.GetSinglePost(decided.PostId, false)
+		require.Nil(t, appErr)
+		require.Len(t, post.Attachments(), 1)
+		assert.Empty(t, post.Attachments()[0].Actions)
+
+		_, appErr = th.App.DecideChannelJoinRequest(th.Context, decided, th.BasicUser.Id, false)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel_join_request.decided.app_error", appErr.Id)
+	})
+
+	t.Run("deny leaves the user out of the channel", func(t *testing.T) {
+		user := th.CreateUser()
+		th.LinkUserToTeam(user, th.BasicTeam)
+
+		joinRequest, appErr := th.App.RequestToJoinChannel(th.Context, channel, user.Id, "")
+		require.Nil(t, appErr)
+
+		decided, appErr := th.App.DecideChannelJoinRequest(th.Context, joinRequest, th.BasicUser.Id, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.ChannelJoinRequestStatusDenied, decided.Status)
+
+		_, appErr = th.App.GetChannelMember(th.Context, channel.Id, user.Id)
+		require.NotNil(t, appErr)
+
+		// A denied user can ask again
+		_, appErr = th.App.RequestToJoinChannel(th.Context, channel, user.Id, "")
+		require.Nil(t, appErr)
+	})
+
+	t.Run("approval post buttons", func(t *testing.T) {
+		user := th.CreateUser()
+		th.LinkUserToTeam(user, th.BasicTeam)
+
+		joinRequest, appErr := th.App.RequestToJoinChannel(th.Context, channel, user.Id, "")
+		require.Nil(t, appErr)
+
+		post, appErr := th.App.GetSinglePost(joinRequest.PostId, false)
+		require.Nil(t, appErr)
+		actions := post.Attachments()[0].Actions
+		require.Len(t, actions, 2)
+
+		outsider := th.CreateUser()
+		th.LinkUserToTeam(outsider, th.BasicTeam)
+		_, appErr = th.App.DoPostAction(th.Context, post.Id, actions[0].Id, outsider.Id, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+
+		_, appErr = th.App.DoPostAction(th.Context, post.Id, actions[0].Id, th.BasicUser.Id, "")
+		require.Nil(t, appErr)
+
+		joinRequest, appErr = th.App.GetChannelJoinRequest(joinRequest.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.ChannelJoinRequestStatusApproved, joinRequest.Status)
+
+		_, appErr = th.App.GetChannelMember(th.Context, channel.Id, user.Id)
+		require.Nil(t, appErr)
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const expiredChannelMembersBatchSize = 100
+
+// SetChannelMemberExpiry makes the membership of the user in the channel temporary: the user is
+// removed from the channel at expiresAt.
+func (a *App) SetChannelMemberExpiry(channel *model.Channel, userID string, expiresAt int64, creatorID string) (*model.ChannelMemberExpiry, *model.AppError) {
+	if appErr := checkChannelMemberExpiry(channel, expiresAt); appErr != nil {
+		return nil, appErr
+	}
+
+	expiry, err := a.Srv().Store().ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{
+		ChannelId: channel.Id,
+		UserId:    userID,
+		ExpiresAt: expiresAt,
+		CreatorId: creatorID,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("SetChannelMemberExpiry", "app.channel_member_expiry.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return expiry, nil
+}
+
+// GetChannelMemberExpiries returns the expiries of the temporary members of the channel.
+func (a *App) GetChannelMemberExpiries(channelID string) ([]*model.ChannelMemberExpiry, *model.AppError) {
+	expiries, err := a.Srv().Store().ChannelMemberExpiry().GetForChannel(channelID)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelMemberExpiries", "app.channel_member_expiry.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return expiries, nil
+}
+
+// DeleteChannelMemberExpiry makes the membership of the user in the channel permanent again.
+func (a *App) DeleteChannelMemberExpiry(channelID, userID string) *model.AppError {
+	if err := a.Srv().Store().ChannelMemberExpiry().Delete(channelID, userID); err != nil {
+		return model.NewAppError("DeleteChannelMemberExpiry", "app.channel_member_expiry.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// RemoveExpiredChannelMembers removes the temporary channel members whose membership expired,
+// recording an audit event for each of them.
+func (a *App) RemoveExpiredChannelMembers(c request.CTX) *model.AppError {
+	now := model.GetMillis()
+	for {
+		expiries, err := a.Srv().Store().ChannelMemberExpiry().GetExpired(now, expiredChannelMembersBatchSize)
+		if err != nil {
+			return model.NewAppError("RemoveExpiredChannelMembers", "app.channel_member_expiry.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		// Failed removals keep their expiry, so stop at the end of the batch rather than
+		// fetching them again.
+		var lastErr *model.AppError
+		for _, expiry := range expiries {
+			if appErr := a.removeExpiredChannelMember(c, expiry); appErr != nil {
+				c.Logger().Error("Failed to remove expired channel member", mlog.String("channel_id", expiry.ChannelId), mlog.String("user_id", expiry.UserId), mlog.Err(appErr))
+				lastErr = appErr
+			}
+		}
+
+		if lastErr != nil {
+			return lastErr
+		}
+
+		if len(expiries) < expiredChannelMembersBatchSize {
+			return nil
+		}
+	}
+}
+
+func (a *App) removeExpiredChannelMember(c request.CTX, expiry *model.ChannelMemberExpiry) *model.AppError {
+	channel, appErr := a.GetChannel(c, expiry.ChannelId)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return appErr
+	}
+
+	// Nothing left to remove the user from.
+	if channel == nil || channel.DeleteAt != 0 {
+		return a.DeleteChannelMemberExpiry(expiry.ChannelId, expiry.UserId)
+	}
+
+	if _, appErr = a.GetChannelMember(c, expiry.ChannelId, expiry.UserId); appErr != nil {
+		if appErr.Id == MissingChannelMemberError {
+			return a.DeleteChannelMemberExpiry(expiry.ChannelId, expiry.UserId)
+		}
+		return appErr
+	}
+
+	auditRec := a.MakeAuditRecord("removeExpiredChannelMember", audit.Fail)
+	audit.AddEventParameter(auditRec, "channel_id", expiry.ChannelId)
+	audit.AddEventParameter(auditRec, "user_id", expiry.UserId)
+	auditRec.AddEventPriorState(expiry)
+	auditRec.AddEventObjectType("channel_member")
+
+	if appErr = a.RemoveUserFromChannel(c, expiry.UserId, "", channel); appErr != nil {
+		a.LogAuditRec(auditRec, appErr)
+		return appErr
+	}
+
+	auditRec.Success()
+	a.LogAuditRec(auditRec, nil)
+
+	return nil
+}
+
+func checkChannelMemberExpiry(channel *model.Channel, expiresAt int64) *model.AppError {
+	if expiresAt <= model.GetMillis() {
+		return model.NewAppError("checkChannelMemberExpiry", "app.channel_member_expiry.expires_at.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	if channel.IsGroupOrDirect() || channel.Name == model.DefaultChannelName {
+		return model.NewAppError("checkChannelMemberExpiry", "app.channel_member_expiry.channel.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (a *App) deleteChannelMemberExpiry(c request.CTX, channelID, userID string) {
+	if err := a.Srv().Store().ChannelMemberExpiry().Delete(channelID, userID); err != nil {
+		c.Logger().Warn("Failed to delete channel member expiry", mlog.String("channel_id", channelID), mlog.String("user_id", userID), mlog.Err(err))
+	}
+}
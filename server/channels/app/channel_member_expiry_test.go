@@ -0,0 +1,96 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestAddChannelMemberWithExpiry(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.CreateUser()
+	th.LinkUserToTeam(user, th.BasicTeam)
+
+	t.Run("rejects an expiry in the past", func(t *testing.T) {
+		_, appErr := th.App.AddChannelMember(th.Context, user.Id, th.BasicChannel, ChannelMemberOpts{ExpiresAt: model.GetMillis() - 1000})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel_member_expiry.expires_at.app_error", appErr.Id)
+
+		_, appErr = th.App.GetChannelMember(th.Context, th.BasicChannel.Id, user.Id)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("rejects direct channels", func(t *testing.T) {
+		dm := th.CreateDmChannel(user)
+		_, appErr := th.App.SetChannelMemberExpiry(dm, user.Id, model.GetMillis()+60000, th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel_member_expiry.channel.app_error", appErr.Id)
+	})
+
+	expiresAt := model.GetMillis() + 60000
+	_, appErr := th.App.AddChannelMember(th.Context, user.Id, th.BasicChannel, ChannelMemberOpts{UserRequestorID: th.BasicUser.Id, ExpiresAt: expiresAt})
+	require.Nil(t, appErr)
+
+	expiries, appErr := th.App.GetChannelMemberExpiries(th.BasicChannel.Id)
+	require.Nil(t, appErr)
+	require.Len(t, expiries, 1)
+	assert.Equal(t, user.Id, expiries[0].UserId)
+	assert.Equal(t, expiresAt, expiries[0].ExpiresAt)
+	assert.Equal(t, th.BasicUser.Id, expiries[0].CreatorId)
+
+	t.Run("leaving the channel clears the expiry", func(t *testing.T) {
+		appErr := th.App.LeaveChannel(th.Context, th.BasicChannel.Id, user.Id)
+		require.Nil(t, appErr)
+
+		expiries, appErr := th.App.GetChannelMemberExpiries(th.BasicChannel.Id)
+		require.Nil(t, appErr)
+		assert.Empty(t, expiries)
+	})
+}
+
+func TestRemoveExpiredChannelMembers(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	expiring := th.CreateUser()
+	th.LinkUserToTeam(expiring, th.BasicTeam)
+	th.AddUserToChannel(expiring, th.BasicChannel)
+
+	staying := th.CreateUser()
+	th.LinkUserToTeam(staying, th.BasicTeam)
+	th.AddUserToChannel(staying, th.BasicChannel)
+
+	_, err := th.App.Srv().Store().ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{ChannelId: th.BasicChannel.Id, UserId: expiring.Id, ExpiresAt: model.GetMillis() - 1000})
+	require.NoError(t, err)
+	_, appErr := th.App.SetChannelMemberExpiry(th.BasicChannel, staying.Id, model.GetMillis()+60000, th.BasicUser.Id)
+	require.Nil(t, appErr)
+
+	// An expiry left behind by a user who is no longer a member is simply dropped
+	_, err = th.App.Srv().Store().ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{ChannelId: th.BasicChannel.Id, UserId: th.BasicUser2.Id, ExpiresAt: model.GetMillis() - 1000})
+	require.NoError(t, err)
+	appErr = th.App.LeaveChannel(th.Context, th.BasicChannel.Id, th.BasicUser2.Id)
+	require.Nil(t, appErr)
+
+	appErr = th.App.RemoveExpiredChannelMembers(th.Context)
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.GetChannelMember(th.Context, th.BasicChannel.Id, expiring.Id)
+	require.NotNil(t, appErr)
+	assert.Equal(t, MissingChannelMemberError, appErr.Id)
+
+	_, appErr = th.App.GetChannelMember(th.Context, th.BasicChannel.Id, staying.Id)
+	require.Nil(t, appErr)
+
+	expiries, appErr := th.App.GetChannelMemberExpiries(th.BasicChannel.Id)
+	require.Nil(t, appErr)
+	require.Len(t, expiries, 1)
+	assert.Equal(t, staying.Id, expiries[0].UserId)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+)
+
+// The progress of a channel_members_bulk job is saved every so many users.
+const channelMembersBulkProgressInterval = 100
+
+// StartChannelMembersBulkJob starts a channel_members_bulk job adding the users to, or removing them
+// from, the channel on behalf of the requester.
+func (a *App) StartChannelMembersBulkJob(c request.CTX, channel *model.Channel, action, requesterID string, userIDs []string) (*model.Job, *model.AppError) {
+	if channel.Type != model.ChannelTypeOpen && channel.Type != model.ChannelTypePrivate {
+		return nil, model.NewAppError("StartChannelMembersBulkJob", "app.channel_members_bulk.type.app_error", nil, "", http.StatusBadRequest)
+	}
+	if channel.DeleteAt != 0 {
+		return nil, model.NewAppError("StartChannelMembersBulkJob", "app.channel_members_bulk.deleted.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return a.Srv().Jobs.CreateJob(model.JobTypeChannelMembersBulk, map[string]string{
+		"channel_id":   channel.Id,
+		"action":       action,
+		"requester_id": requesterID,
+		"user_ids":     strings.Join(userIDs, ","),
+	})
+}
+
+// GetChannelMembersBulkJob returns the channel_members_bulk job of the channel with the given id,
+// with a 404 error when the job doesn't belong to the channel.
+func (a *App) GetChannelMembersBulkJob(channelID, jobID string) (*model.Job, *model.AppError) {
+	job, appErr := a.GetJob(jobID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if job.Type != model.JobTypeChannelMembersBulk || job.Data["channel_id"] != channelID {
+		return nil, model.NewAppError("GetChannelMembersBulkJob", "app.job.get.app_error", nil, "", http.StatusNotFound)
+	}
+
+	return job, nil
+}
+
+// ProcessChannelMembersBulk does the work of a channel_members_bulk job, adding the users to or
+// removing them from the channel one after the other. A user that can't be added or removed
+// doesn't stop the job: the id of the error is returned for the user instead.
+func (a *App) ProcessChannelMembersBulk(c request.CTX, channelID, action, requesterID string, userIDs []string, reportProgress func(processed int, failures map[string]string)) (map[string]string, *model.AppError) {
+	if action != model.ChannelMembersBulkActionAdd && action != model.ChannelMembersBulkActionRemove {
+		return nil, model.NewAppError("ProcessChannelMembersBulk", "app.channel_members_bulk.action.app_error", nil, "action="+action, http.StatusBadRequest)
+	}
+
+	channel, appErr := a.GetChannel(c, channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	failures := make(map[string]string)
+	var denied map[string]bool
+	if channel.IsGroupConstrained() && action == model.ChannelMembersBulkActionAdd {
+		nonMembers, err := a.FilterNonGroupChannelMembers(userIDs, channel)
+		if err != nil {
+			return nil, model.NewAppError("ProcessChannelMembersBulk", "api.channel.add_members.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		denied = make(map[string]bool, len(nonMembers))
+		for _, userID := range nonMembers {
+			denied[userID] = true
+		}
+	}
+
+	for i, userID := range userIDs {
+		var appErr *model.AppError
+		switch {
+		case action == model.ChannelMembersBulkActionRemove:
+			appErr = a.removeChannelMemberInBulk(c, userID, requesterID, channel)
+		case denied[userID]:
+			appErr = model.NewAppError("ProcessChannelMembersBulk", "api.channel.add_members.user_denied", nil, "", http.StatusBadRequest)
+		default:
+			_, appErr = a.AddChannelMember(c, userID, channel, ChannelMemberOpts{UserRequestorID: requesterID})
+		}
+		if appErr != nil {
+			failures[userID] = appErr.Id
+		}
+
+		if (i+1)%channelMembersBulkProgressInterval == 0 || i+1 == len(userIDs) {
+			reportProgress(i+1, failures)
+		}
+	}
+
+	return failures, nil
+}
+
+func (a *App) removeChannelMemberInBulk(c request.CTX, userID, requesterID string, channel *model.Channel) *model.AppError {
+	if channel.IsGroupConstrained() && userID != requesterID {
+		user, appErr := a.GetUser(userID)
+		if appErr != nil {
+			return appErr
+		}
+		if !user.IsBot {
+			return model.NewAppError("ProcessChannelMembersBulk", "api.channel.remove_member.group_constrained.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	return a.RemoveUserFromChannel(c, userID, requesterID, channel)
+}
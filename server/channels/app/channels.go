@@ -67,6 +67,7 @@ type Channels struct {
 	Saml             einterfaces.SamlInterface
 	Notification     einterfaces.NotificationInterface
 	Ldap             einterfaces.LdapInterface
+	VectorStore      einterfaces.VectorStoreInterface
 
 	// These are used to prevent concurrent upload requests
 	// for a given upload session which could cause inconsistencies
@@ -83,6 +84,9 @@ type Channels struct {
 	postReminderMut  sync.Mutex
 	postReminderTask *model.ScheduledTask
 
+	calendarStatusSyncMut  sync.Mutex
+	calendarStatusSyncTask *model.ScheduledTask
+
 	// collectionTypes maps from collection types to the registering plugin id
 	collectionTypes map[string]string
 	// topicTypes maps from topic types to collection types
@@ -176,6 +180,9 @@ func NewChannels(services map[product.ServiceKey]any) (*Channels, error) {
 	if notificationInterface != nil {
 		ch.Notification = notificationInterface(New(ServerConnector(ch)))
 	}
+	if vectorStoreInterface != nil {
+		ch.VectorStore = vectorStoreInterface(New(ServerConnector(ch)))
+	}
 	if samlInterfaceNew != nil {
 		ch.Saml = samlInterfaceNew(New(ServerConnector(ch)))
 		if err := ch.Saml.ConfigureSP(); err != nil {
@@ -216,6 +223,10 @@ func NewChannels(services map[product.ServiceKey]any) (*Channels, error) {
 	pluginsRoute.HandleFunc("/public/{public_file:.*}", ch.ServePluginPublicRequest)
 	pluginsRoute.HandleFunc("/{anything:.*}", ch.ServePluginRequest)
 
+	// Products may expose endpoints restricted to the local mode socket.
+	localPluginsRoute := ch.srv.LocalRouter.PathPrefix("/plugins/{plugin_id:[A-Za-z0-9\\_\\-\\.]+}").Subrouter()
+	localPluginsRoute.HandleFunc("/{anything:.*}", ch.ServeLocalProductRequest)
+
 	services[product.ChannelKey] = &channelsWrapper{
 		app: &App{ch: ch},
 	}
@@ -250,6 +261,8 @@ func NewChannels(services map[product.ServiceKey]any) (*Channels, error) {
 
 	services[product.ThreadsKey] = &App{ch: ch}
 
+	services[product.ActivityKey] = &App{ch: ch}
+
 	return ch, nil
 }
 
@@ -308,6 +321,12 @@ func (ch *Channels) Stop() error {
 	}
 	ch.dndTaskMut.Unlock()
 
+	ch.calendarStatusSyncMut.Lock()
+	if ch.calendarStatusSyncTask != nil {
+		ch.calendarStatusSyncTask.Cancel()
+	}
+	ch.calendarStatusSyncMut.Unlock()
+
 	return nil
 }
 
@@ -70,6 +70,7 @@ func (s *Server) registerClusterHandlers() {
 	s.platform.RegisterClusterMessageHandler(model.ClusterEventInstallPlugin, s.clusterInstallPluginHandler)
 	s.platform.RegisterClusterMessageHandler(model.ClusterEventRemovePlugin, s.clusterRemovePluginHandler)
 	s.platform.RegisterClusterMessageHandler(model.ClusterEventPluginEvent, s.clusterPluginEventHandler)
+	s.platform.RegisterClusterMessageHandler(model.ClusterEventDrainNode, s.clusterDrainNodeHandler)
 
 	s.platform.RegisterClusterHandlers()
 }
@@ -0,0 +1,102 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/filestore"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// coldFileBackend returns the file backend of the cold storage file store. Files that have been moved
+// there are still readable once cold storage is disabled, so this doesn't depend on
+// ColdStorageSettings.Enable.
+func (s *Server) coldFileBackend() (filestore.FileBackend, *model.AppError) {
+	backend, err := s.platform.ColdFileBackend()
+	if err != nil {
+		return nil, model.NewAppError("coldFileBackend", "app.cold_storage.no_driver.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return backend, nil
+}
+
+// FileInfoReader returns a reader for the content of the file, reading it from the cold storage file
+// store if the file has been moved there. Caller must close the first return value.
+func (a *App) FileInfoReader(info *model.FileInfo) (filestore.ReadCloseSeeker, *model.AppError) {
+	if !info.ColdStorage {
+		return a.FileReader(info.Path)
+	}
+
+	backend, appErr := a.Srv().coldFileBackend()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	reader, err := backend.Reader(info.Path)
+	if err != nil {
+		return nil, model.NewAppError("FileInfoReader", "api.file.file_reader.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return reader, nil
+}
+
+// MoveFileToColdStorage moves the file to the cold storage file store and marks it, along with any copy
+// of it referencing the same path, as moved. The file is only removed from the primary file store once
+// it has been marked, so that it can always be read from one of them.
+func (a *App) MoveFileToColdStorage(info *model.FileInfo) *model.AppError {
+	backend, appErr := a.Srv().coldFileBackend()
+	if appErr != nil {
+		return appErr
+	}
+
+	exists, appErr := a.FileExists(info.Path)
+	if appErr != nil {
+		return appErr
+	}
+
+	if exists {
+		if appErr = a.copyFileToColdStorage(backend, info.Path); appErr != nil {
+			return appErr
+		}
+	} else if coldExists, err := backend.FileExists(info.Path); err != nil || !coldExists {
+		// A copy of the file sharing its path may already have been moved.
+		return model.NewAppError("MoveFileToColdStorage", "app.cold_storage.file_not_found.app_error", nil, "path="+info.Path, http.StatusNotFound).Wrap(err)
+	}
+
+	if err := a.Srv().Store().FileInfo().SetColdStorageForPath(info.Path); err != nil {
+		return model.NewAppError("MoveFileToColdStorage", "app.cold_storage.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if info.PostId != "" {
+		a.Srv().Store().FileInfo().InvalidateFileInfosForPostCache(info.PostId, false)
+	}
+
+	if exists {
+		if appErr = a.RemoveFile(info.Path); appErr != nil {
+			mlog.Warn("Failed to remove file moved to cold storage", mlog.String("file_info_id", info.Id), mlog.Err(appErr))
+		}
+	}
+
+	return nil
+}
+
+func (a *App) copyFileToColdStorage(backend filestore.FileBackend, path string) *model.AppError {
+	reader, appErr := a.FileReader(path)
+	if appErr != nil {
+		return appErr
+	}
+	defer reader.Close()
+
+	// Large files may take longer to copy than the request timeout, try to cancel it.
+	type TimeoutCanceler interface{ CancelTimeout() bool }
+	if tc, ok := reader.(TimeoutCanceler); ok {
+		tc.CancelTimeout()
+	}
+
+	if _, err := filestore.TryWriteFileContext(backend, context.Background(), reader, path); err != nil {
+		return model.NewAppError("MoveFileToColdStorage", "app.cold_storage.write.app_error", nil, "path="+path, http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
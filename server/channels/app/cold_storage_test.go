@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestMoveFileToColdStorage(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ColdStorageSettings.DriverName = model.ImageDriverLocal
+		*cfg.ColdStorageSettings.Directory = t.TempDir()
+	})
+
+	data := []byte("abcd")
+	info, appErr := th.App.DoUploadFile(th.Context, time.Date(2007, 2, 4, 1, 2, 3, 4, time.Local), th.BasicTeam.Id, th.BasicChannel.Id, th.BasicUser.Id, "test.txt", data)
+	require.Nil(t, appErr)
+	defer th.App.Srv().Store().FileInfo().PermanentDelete(info.Id)
+
+	copyIDs, appErr := th.App.CopyFileInfos(th.BasicUser.Id, []string{info.Id})
+	require.Nil(t, appErr)
+	require.Len(t, copyIDs, 1)
+	defer th.App.Srv().Store().FileInfo().PermanentDelete(copyIDs[0])
+
+	appErr = th.App.MoveFileToColdStorage(info)
+	require.Nil(t, appErr)
+
+	exists, appErr := th.App.FileExists(info.Path)
+	require.Nil(t, appErr)
+	require.False(t, exists, "the file should have been removed from the primary file store")
+
+	for _, id := range []string{info.Id, copyIDs[0]} {
+		moved, appErr := th.App.GetFileInfo(id)
+		require.Nil(t, appErr)
+		require.True(t, moved.ColdStorage)
+
+		reader, appErr := th.App.FileInfoReader(moved)
+		require.Nil(t, appErr)
+		content, err := io.ReadAll(reader)
+		reader.Close()
+		require.NoError(t, err)
+		require.Equal(t, data, content)
+	}
+
+	content, appErr := th.App.GetFile(info.Id)
+	require.Nil(t, appErr)
+	require.Equal(t, data, content)
+
+	t.Run("copy sharing the path", func(t *testing.T) {
+		stale, err := th.App.Srv().Store().FileInfo().Get(copyIDs[0])
+		require.NoError(t, err)
+		stale.ColdStorage = false
+
+		appErr := th.App.MoveFileToColdStorage(stale)
+		require.Nil(t, appErr)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		appErr := th.App.MoveFileToColdStorage(&model.FileInfo{Id: model.NewId(), Path: "missing/file.txt"})
+		require.NotNil(t, appErr)
+		require.Equal(t, "app.cold_storage.file_not_found.app_error", appErr.Id)
+	})
+}
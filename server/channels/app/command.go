@@ -491,34 +491,39 @@ func (a *App) tryExecuteCustomCommand(c request.CTX, args *model.CommandArgs, tr
 
 func (a *App) DoCommandRequest(cmd *model.Command, p url.Values) (*model.Command, *model.CommandResponse, *model.AppError) {
 	// Prepare the request
-	var req *http.Request
-	var err error
-	if cmd.Method == model.CommandMethodGet {
-		req, err = http.NewRequest(http.MethodGet, cmd.URL, nil)
-	} else {
-		req, err = http.NewRequest(http.MethodPost, cmd.URL, strings.NewReader(p.Encode()))
-	}
+	newRequest := func() (*http.Request, error) {
+		var req *http.Request
+		var err error
+		if cmd.Method == model.CommandMethodGet {
+			req, err = http.NewRequest(http.MethodGet, cmd.URL, nil)
+		} else {
+			req, err = http.NewRequest(http.MethodPost, cmd.URL, strings.NewReader(p.Encode()))
+		}
 
-	if err != nil {
-		return cmd, nil, model.NewAppError("command", "api.command.execute_command.failed.app_error", map[string]any{"Trigger": cmd.Trigger}, "", http.StatusInternalServerError).Wrap(err)
-	}
+		if err != nil {
+			return nil, err
+		}
 
-	if cmd.Method == model.CommandMethodGet {
-		if req.URL.RawQuery != "" {
-			req.URL.RawQuery += "&"
+		if cmd.Method == model.CommandMethodGet {
+			if req.URL.RawQuery != "" {
+				req.URL.RawQuery += "&"
+			}
+			req.URL.RawQuery += p.Encode()
 		}
-		req.URL.RawQuery += p.Encode()
-	}
 
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Authorization", "Token "+cmd.Token)
-	if cmd.Method == model.CommandMethodPost {
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Authorization", "Token "+cmd.Token)
+		if cmd.Method == model.CommandMethodPost {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		return req, nil
 	}
 
 	// Send the request
-	resp, err := a.HTTPService().MakeClient(false).Do(req)
-	if err != nil {
+	resp, err := a.doIntegrationRequest(model.IntegrationTypeCommand, cmd.Id, newRequest)
+	if errors.Is(err, errIntegrationCircuitOpen) {
+		return cmd, nil, model.NewAppError("command", "api.command.execute_command.circuit_open.app_error", map[string]any{"Trigger": cmd.Trigger}, "", http.StatusServiceUnavailable).Wrap(err)
+	} else if err != nil {
 		return cmd, nil, model.NewAppError("command", "api.command.execute_command.failed.app_error", map[string]any{"Trigger": cmd.Trigger}, "", http.StatusInternalServerError).Wrap(err)
 	}
 
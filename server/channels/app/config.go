@@ -40,8 +40,18 @@ func (a *App) UpdateConfig(f func(*model.Config)) {
 	a.Srv().platform.UpdateConfig(f)
 }
 
+// ReloadConfig reloads the config from its store, and the TLS certificate of the HTTP server, for
+// the certificates renewed in place to be served.
 func (a *App) ReloadConfig() error {
-	return a.Srv().platform.ReloadConfig()
+	if err := a.Srv().platform.ReloadConfig(); err != nil {
+		return err
+	}
+
+	if a.Srv().Server != nil {
+		return a.Srv().ReloadTLSCertificate()
+	}
+
+	return nil
 }
 
 func (a *App) ClientConfig() map[string]string {
@@ -239,8 +249,12 @@ func (a *App) HandleMessageExportConfig(cfg *model.Config, appCfg *model.Config)
 }
 
 func (s *Server) MailServiceConfig() *mail.SMTPConfig {
-	emailSettings := s.platform.Config().EmailSettings
-	hostname := utils.GetHostnameFromSiteURL(*s.platform.Config().ServiceSettings.SiteURL)
+	return mailServiceConfig(s.platform.Config())
+}
+
+func mailServiceConfig(config *model.Config) *mail.SMTPConfig {
+	emailSettings := config.EmailSettings
+	hostname := utils.GetHostnameFromSiteURL(*config.ServiceSettings.SiteURL)
 	cfg := mail.SMTPConfig{
 		Hostname:                          hostname,
 		ConnectionSecurity:                *emailSettings.ConnectionSecurity,
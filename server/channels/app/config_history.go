@@ -0,0 +1,86 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/config"
+)
+
+// RecordConfigChange adds the save of newCfg over oldCfg by the session user to the config history,
+// unless it changed nothing. The secrets are kept out of the history: the config is recorded with
+// its secret references and sanitized, rolling back to it keeping the current secrets, while the
+// secrets are masked in the recorded diffs.
+func (a *App) RecordConfigChange(c request.CTX, oldCfg, newCfg *model.Config, rollbackToID string) (*model.ConfigChange, *model.AppError) {
+	diffs, err := config.Diff(oldCfg, newCfg)
+	if err != nil {
+		return nil, model.NewAppError("RecordConfigChange", "app.config_change.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if len(diffs) == 0 {
+		return nil, nil
+	}
+
+	cfg := a.Srv().platform.GetConfigStore().RestoreSecretReferences(newCfg)
+	cfg.Sanitize()
+
+	change := &model.ConfigChange{
+		UserId:       c.Session().UserId,
+		RollbackToId: rollbackToID,
+		Diffs:        make([]*model.ConfigChangeDiff, 0, len(diffs)),
+		Config:       cfg,
+	}
+	for _, diff := range diffs.Sanitize() {
+		change.Diffs = append(change.Diffs, &model.ConfigChangeDiff{
+			Path:     diff.Path,
+			OldValue: diff.BaseVal,
+			NewValue: diff.ActualVal,
+		})
+	}
+
+	change, err = a.Srv().Store().ConfigChange().Save(change)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("RecordConfigChange", "app.config_change.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return change, nil
+}
+
+// GetConfigHistory returns a page of the config changes, the newest first, without the configs
+// they saved.
+func (a *App) GetConfigHistory(page, perPage int) ([]*model.ConfigChange, *model.AppError) {
+	changes, err := a.Srv().Store().ConfigChange().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetConfigHistory", "app.config_change.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return changes, nil
+}
+
+// GetConfigChange returns the config change along with the config it saved.
+func (a *App) GetConfigChange(changeID string) (*model.ConfigChange, *model.AppError) {
+	change, err := a.Srv().Store().ConfigChange().Get(changeID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetConfigChange", "app.config_change.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetConfigChange", "app.config_change.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return change, nil
+}
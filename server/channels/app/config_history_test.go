@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestRecordConfigChange(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	oldCfg := th.App.Config().Clone()
+
+	change, appErr := th.App.RecordConfigChange(th.Context, oldCfg, oldCfg.Clone(), "")
+	require.Nil(t, appErr)
+	assert.Nil(t, change, "saves changing nothing aren't recorded")
+
+	newCfg := oldCfg.Clone()
+	*newCfg.TeamSettings.SiteName = "history"
+	*newCfg.EmailSettings.SMTPPassword = "secret"
+
+	change, appErr = th.App.RecordConfigChange(th.Context, oldCfg, newCfg, "")
+	require.Nil(t, appErr)
+	require.NotNil(t, change)
+
+	changes, appErr := th.App.GetConfigHistory(0, 10)
+	require.Nil(t, appErr)
+	require.NotEmpty(t, changes)
+	assert.Equal(t, change.Id, changes[0].Id)
+
+	diffs := map[string]*model.ConfigChangeDiff{}
+	for _, diff := range changes[0].Diffs {
+		diffs[diff.Path] = diff
+	}
+	require.Len(t, diffs, 2)
+	assert.Equal(t, "history", diffs["TeamSettings.SiteName"].NewValue)
+	assert.Equal(t, model.FakeSetting, diffs["EmailSettings.SMTPPassword"].NewValue, "the secrets are masked in the diffs")
+
+	got, appErr := th.App.GetConfigChange(change.Id)
+	require.Nil(t, appErr)
+	require.NotNil(t, got.Config)
+	assert.Equal(t, "history", *got.Config.TeamSettings.SiteName)
+	assert.Equal(t, model.FakeSetting, *got.Config.EmailSettings.SMTPPassword, "the secrets are kept out of the history")
+	assert.Equal(t, model.FakeSetting, *got.Config.SqlSettings.DataSource)
+
+	_, appErr = th.App.GetConfigChange(model.NewId())
+	require.NotNil(t, appErr)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/config"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mail"
+)
+
+// ValidateConfig checks the config without saving it, probing the file store and the SMTP server
+// it configures. The secrets the clients send back as fake settings are checked with their values
+// in the current config.
+func (a *App) ValidateConfig(cfg *model.Config) *model.ConfigValidationReport {
+	cfg.SetDefaults()
+	config.Desanitize(a.Config(), cfg)
+
+	report := model.NewConfigValidationReport()
+
+	settings := report.AddCheck(model.ConfigValidationCheckSettings)
+	if appErr := cfg.IsValid(); appErr != nil {
+		report.Fail(settings, "", appErr)
+	}
+
+	a.validateSSOSiteURL(cfg, report)
+	a.validateFileStore(cfg, report)
+	a.validateSMTP(cfg, report)
+
+	return report
+}
+
+// validateSSOSiteURL checks that a site URL is set when SSO is enabled, the identity providers
+// redirecting the users to it.
+func (a *App) validateSSOSiteURL(cfg *model.Config, report *model.ConfigValidationReport) {
+	providers := map[string]*bool{
+		"GitLabSettings.Enable":    cfg.GitLabSettings.Enable,
+		"GoogleSettings.Enable":    cfg.GoogleSettings.Enable,
+		"Office365Settings.Enable": cfg.Office365Settings.Enable,
+		"OpenIdSettings.Enable":    cfg.OpenIdSettings.Enable,
+		"SamlSettings.Enable":      cfg.SamlSettings.Enable,
+	}
+
+	var enabled []string
+	for setting, enable := range providers {
+		if enable != nil && *enable {
+			enabled = append(enabled, setting)
+		}
+	}
+
+	sort.Strings(enabled)
+
+	if len(enabled) == 0 {
+		report.SkipCheck(model.ConfigValidationCheckSSO)
+		return
+	}
+
+	check := report.AddCheck(model.ConfigValidationCheckSSO)
+	if *cfg.ServiceSettings.SiteURL == "" {
+		for _, setting := range enabled {
+			report.Fail(check, "ServiceSettings.SiteURL", model.NewAppError("ValidateConfig", "app.config_validation.sso_site_url.app_error", map[string]any{"Setting": setting}, "", http.StatusBadRequest))
+		}
+	}
+}
+
+func (a *App) validateFileStore(cfg *model.Config, report *model.ConfigValidationReport) {
+	check := report.AddCheck(model.ConfigValidationCheckFileStore)
+
+	if *cfg.FileSettings.DriverName == model.ImageDriverS3 {
+		if appErr := a.CheckMandatoryS3Fields(&cfg.FileSettings); appErr != nil {
+			report.Fail(check, "FileSettings.AmazonS3Bucket", appErr)
+			return
+		}
+	}
+
+	if appErr := a.TestFileStoreConnectionWithConfig(&cfg.FileSettings); appErr != nil {
+		report.Fail(check, "FileSettings.DriverName", appErr)
+	}
+}
+
+// validateSMTP connects to the SMTP server, authenticating when the config requires it.
+func (a *App) validateSMTP(cfg *model.Config, report *model.ConfigValidationReport) {
+	if *cfg.EmailSettings.SMTPServer == "" {
+		report.SkipCheck(model.ConfigValidationCheckSMTP)
+		return
+	}
+
+	check := report.AddCheck(model.ConfigValidationCheckSMTP)
+	if err := mail.TestConnection(mailServiceConfig(cfg)); err != nil {
+		report.Fail(check, "EmailSettings.SMTPServer", model.NewAppError("ValidateConfig", "app.config_validation.smtp.app_error", nil, "", http.StatusBadRequest).Wrap(err))
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestValidateConfig(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	checks := func(report *model.ConfigValidationReport) map[string]*model.ConfigValidationCheck {
+		checks := map[string]*model.ConfigValidationCheck{}
+		for _, check := range report.Checks {
+			checks[check.Name] = check
+		}
+		return checks
+	}
+
+	t.Run("valid config", func(t *testing.T) {
+		cfg := th.App.Config().Clone()
+		*cfg.EmailSettings.SMTPServer = ""
+
+		report := th.App.ValidateConfig(cfg)
+		require.True(t, report.Valid)
+		assert.Equal(t, model.ConfigValidationStatusPassed, checks(report)[model.ConfigValidationCheckFileStore].Status)
+		assert.Equal(t, model.ConfigValidationStatusSkipped, checks(report)[model.ConfigValidationCheckSSO].Status)
+		assert.Equal(t, model.ConfigValidationStatusSkipped, checks(report)[model.ConfigValidationCheckSMTP].Status)
+	})
+
+	t.Run("SSO without a site URL", func(t *testing.T) {
+		cfg := th.App.Config().Clone()
+		*cfg.ServiceSettings.SiteURL = ""
+		*cfg.GitLabSettings.Enable = true
+		*cfg.EmailSettings.SMTPServer = ""
+
+		report := th.App.ValidateConfig(cfg)
+		require.False(t, report.Valid)
+		check := checks(report)[model.ConfigValidationCheckSSO]
+		assert.Equal(t, model.ConfigValidationStatusFailed, check.Status)
+		require.Len(t, check.Errors, 1)
+		assert.Equal(t, "ServiceSettings.SiteURL", check.Errors[0].Setting)
+	})
+
+	t.Run("unreachable services", func(t *testing.T) {
+		cfg := th.App.Config().Clone()
+		*cfg.FileSettings.DriverName = model.ImageDriverS3
+		*cfg.FileSettings.AmazonS3Bucket = ""
+		*cfg.EmailSettings.SMTPServer = "127.0.0.1"
+		*cfg.EmailSettings.SMTPPort = "1"
+		*cfg.EmailSettings.SMTPServerTimeout = 1
+
+		report := th.App.ValidateConfig(cfg)
+		require.False(t, report.Valid)
+		assert.Equal(t, model.ConfigValidationStatusFailed, checks(report)[model.ConfigValidationCheckFileStore].Status)
+		smtp := checks(report)[model.ConfigValidationCheckSMTP]
+		assert.Equal(t, model.ConfigValidationStatusFailed, smtp.Status)
+		require.Len(t, smtp.Errors, 1)
+		assert.NotEmpty(t, smtp.Errors[0].Detail)
+	})
+}
@@ -51,6 +51,31 @@ func (a *App) PatchRetentionPolicy(patch *model.RetentionPolicyWithTeamAndChanne
 	return a.DataRetention().PatchPolicy(patch)
 }
 
+// PreviewRetentionPolicy reports how many posts and files the policy would delete if it was applied
+// now, without saving or applying it. The policy's ID is only used to tell which channels are
+// already assigned to it, so both new policies and changes to existing ones can be previewed.
+func (a *App) PreviewRetentionPolicy(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyPreview, *model.AppError) {
+	if a.DataRetention() == nil {
+		return nil, newLicenseError("PreviewRetentionPolicy")
+	}
+
+	if policy.PostDurationDays == nil {
+		return nil, model.NewAppError("PreviewRetentionPolicy", "app.data_retention.preview.post_duration.app_error", nil, "", http.StatusBadRequest)
+	}
+	for _, id := range append(append([]string{}, policy.TeamIDs...), policy.ChannelIDs...) {
+		if !model.IsValidId(id) {
+			return nil, model.NewAppError("PreviewRetentionPolicy", "app.data_retention.preview.invalid_id.app_error", nil, "id="+id, http.StatusBadRequest)
+		}
+	}
+
+	preview, err := a.Srv().Store().RetentionPolicy().Preview(policy, model.GetMillis())
+	if err != nil {
+		return nil, model.NewAppError("PreviewRetentionPolicy", "app.data_retention.preview.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return preview, nil
+}
+
 func (a *App) DeleteRetentionPolicy(policyID string) *model.AppError {
 	if a.DataRetention() == nil {
 		return newLicenseError("DeleteRetentionPolicy")
@@ -0,0 +1,194 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func (a *App) directOutgoingWebhooksEnabled() bool {
+	return *a.Config().ServiceSettings.EnableOutgoingWebhooks && *a.Config().ServiceSettings.EnableDirectOutgoingWebhooks
+}
+
+// CreateDirectOutgoingWebhook creates an outgoing webhook triggered by the direct and group
+// messages the hook's bot is a member of.
+func (a *App) CreateDirectOutgoingWebhook(hook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, *model.AppError) {
+	if !a.directOutgoingWebhooksEnabled() {
+		return nil, model.NewAppError("CreateDirectOutgoingWebhook", "api.direct_outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if _, appErr := a.GetBot(hook.BotUserId, false); appErr != nil {
+		return nil, appErr
+	}
+
+	webhook, err := a.Srv().Store().Webhook().SaveDirectOutgoing(hook)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateDirectOutgoingWebhook", "app.webhooks.save_outgoing.override.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		default:
+			return nil, model.NewAppError("CreateDirectOutgoingWebhook", "app.webhooks.save_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return webhook, nil
+}
+
+// UpdateDirectOutgoingWebhook updates the bot, callback URLs and description of the hook.
+func (a *App) UpdateDirectOutgoingWebhook(oldHook, updatedHook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, *model.AppError) {
+	if !a.directOutgoingWebhooksEnabled() {
+		return nil, model.NewAppError("UpdateDirectOutgoingWebhook", "api.direct_outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if updatedHook.BotUserId != oldHook.BotUserId {
+		if _, appErr := a.GetBot(updatedHook.BotUserId, false); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	oldHook.BotUserId = updatedHook.BotUserId
+	oldHook.CallbackURLs = updatedHook.CallbackURLs
+	oldHook.DisplayName = updatedHook.DisplayName
+	oldHook.Description = updatedHook.Description
+	oldHook.ContentType = updatedHook.ContentType
+	oldHook.CreatorId = updatedHook.CreatorId
+	if appErr := oldHook.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	webhook, err := a.Srv().Store().Webhook().UpdateDirectOutgoing(oldHook)
+	if err != nil {
+		return nil, model.NewAppError("UpdateDirectOutgoingWebhook", "app.webhooks.update_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return webhook, nil
+}
+
+func (a *App) GetDirectOutgoingWebhook(hookID string) (*model.DirectOutgoingWebhook, *model.AppError) {
+	if !a.directOutgoingWebhooksEnabled() {
+		return nil, model.NewAppError("GetDirectOutgoingWebhook", "api.direct_outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	webhook, err := a.Srv().Store().Webhook().GetDirectOutgoing(hookID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetDirectOutgoingWebhook", "app.webhooks.get_outgoing.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetDirectOutgoingWebhook", "app.webhooks.get_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return webhook, nil
+}
+
+func (a *App) GetDirectOutgoingWebhooksPage(page, perPage int) ([]*model.DirectOutgoingWebhook, *model.AppError) {
+	if !a.directOutgoingWebhooksEnabled() {
+		return nil, model.NewAppError("GetDirectOutgoingWebhooksPage", "api.direct_outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	webhooks, err := a.Srv().Store().Webhook().GetDirectOutgoingList(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetDirectOutgoingWebhooksPage", "app.webhooks.get_direct_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return webhooks, nil
+}
+
+func (a *App) DeleteDirectOutgoingWebhook(hookID string) *model.AppError {
+	if !a.directOutgoingWebhooksEnabled() {
+		return model.NewAppError("DeleteDirectOutgoingWebhook", "api.direct_outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if err := a.Srv().Store().Webhook().DeleteDirectOutgoing(hookID, model.GetMillis()); err != nil {
+		return model.NewAppError("DeleteDirectOutgoingWebhook", "app.webhooks.delete_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// handleDirectWebhookEvents triggers the direct outgoing webhooks of the bots that are members of
+// the direct or group message, as long as every other member has consented to it.
+func (a *App) handleDirectWebhookEvents(c request.CTX, post *model.Post, channel *model.Channel, user *model.User) *model.AppError {
+	if !a.directOutgoingWebhooksEnabled() {
+		return nil
+	}
+
+	memberIDs, err := a.Srv().Store().Channel().GetAllChannelMembersById(channel.Id)
+	if err != nil {
+		return model.NewAppError("handleDirectWebhookEvents", "app.channel.get_members.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	hooks, err := a.Srv().Store().Webhook().GetDirectOutgoingByBotUsers(memberIDs)
+	if err != nil {
+		return model.NewAppError("handleDirectWebhookEvents", "app.webhooks.get_direct_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, hook := range hooks {
+		// Bots don't get their own messages back.
+		if hook.BotUserId == post.UserId {
+			continue
+		}
+
+		consented, err := a.hasDirectWebhookConsent(memberIDs, hook.BotUserId)
+		if err != nil {
+			return model.NewAppError("handleDirectWebhookEvents", "app.preference.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if !consented {
+			continue
+		}
+
+		payload := &model.OutgoingWebhookPayload{
+			Token:       hook.Token,
+			ChannelId:   post.ChannelId,
+			ChannelName: channel.Name,
+			Timestamp:   post.CreateAt,
+			UserId:      post.UserId,
+			UserName:    user.Username,
+			PostId:      post.Id,
+			Text:        post.Message,
+			FileIds:     strings.Join(post.FileIds, ","),
+		}
+		a.Srv().Go(func(hook *model.OutgoingWebhook) func() {
+			return func() {
+				a.TriggerWebhook(c, payload, hook, post, channel)
+			}
+		}(hook.ToOutgoingWebhook()))
+	}
+
+	return nil
+}
+
+// hasDirectWebhookConsent tells whether all the members other than the bot have consented to share
+// their direct and group messages with it, and haven't revoked their consent since.
+func (a *App) hasDirectWebhookConsent(memberIDs []string, botUserID string) (bool, error) {
+	consents, err := a.Srv().Store().Preference().GetCategoryAndNameForUsers(memberIDs, model.PreferenceCategoryDirectWebhookConsent, botUserID)
+	if err != nil {
+		return false, err
+	}
+
+	consented := make(map[string]bool, len(consents))
+	for _, consent := range consents {
+		consented[consent.UserId] = consent.Value == "true"
+	}
+
+	for _, memberID := range memberIDs {
+		if memberID != botUserID && !consented[memberID] {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestHasDirectWebhookConsent(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	botUserID := model.NewId()
+	memberIDs := []string{th.BasicUser.Id, th.BasicUser2.Id, botUserID}
+
+	setConsent := func(userID, value string) {
+		t.Helper()
+		appErr := th.App.UpdatePreferences(userID, model.Preferences{{
+			UserId:   userID,
+			Category: model.PreferenceCategoryDirectWebhookConsent,
+			Name:     botUserID,
+			Value:    value,
+		}})
+		require.Nil(t, appErr)
+	}
+
+	setConsent(th.BasicUser.Id, "true")
+
+	consented, err := th.App.hasDirectWebhookConsent(memberIDs, botUserID)
+	require.NoError(t, err)
+	assert.False(t, consented, "every member must consent")
+
+	setConsent(th.BasicUser2.Id, "true")
+
+	consented, err = th.App.hasDirectWebhookConsent(memberIDs, botUserID)
+	require.NoError(t, err)
+	assert.True(t, consented)
+
+	setConsent(th.BasicUser2.Id, "false")
+
+	consented, err = th.App.hasDirectWebhookConsent(memberIDs, botUserID)
+	require.NoError(t, err)
+	assert.False(t, consented, "the consent can be revoked")
+}
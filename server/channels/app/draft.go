@@ -123,9 +123,35 @@ func (a *App) UpdateDraft(c *request.Context, draft *model.Draft, connectionID s
 		return nil, model.NewAppError("UpdateDraft", "app.user.get.app_error", nil, nErr.Error(), http.StatusInternalServerError)
 	}
 
+	stored, nErr := a.Srv().Store().Draft().Get(draft.UserId, draft.ChannelId, draft.RootId, true)
+	if nErr != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(nErr, &nfErr):
+			return nil, model.NewAppError("UpdateDraft", "app.draft.get.app_error", nil, nErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("UpdateDraft", "app.draft.get.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	// clients not tracking versions overwrite the draft, and so do the ones resuming a draft that
+	// was deleted since, usually because it was sent
+	if draft.Version == 0 || stored.DeleteAt != 0 {
+		draft.Version = stored.Version
+	}
+
 	dt, nErr := a.Srv().Store().Draft().Update(draft)
 	if nErr != nil {
-		return nil, model.NewAppError("UpdateDraft", "app.draft.update.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+		var cErr *store.ErrConflict
+		var appErr *model.AppError
+		switch {
+		case errors.As(nErr, &cErr):
+			return nil, model.NewAppError("UpdateDraft", "app.draft.update.conflict.app_error", nil, nErr.Error(), http.StatusConflict)
+		case errors.As(nErr, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("UpdateDraft", "app.draft.update.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+		}
 	}
 
 	dt = a.prepareDraftWithFileInfos(draft.UserId, dt)
@@ -141,6 +167,28 @@ func (a *App) UpdateDraft(c *request.Context, draft *model.Draft, connectionID s
 	return dt, nil
 }
 
+// MergeDraft merges the draft into the stored one it conflicts with, and saves the result. The
+// draft is saved as is when there is nothing to merge it with.
+func (a *App) MergeDraft(c *request.Context, draft *model.Draft, connectionID string) (*model.Draft, *model.AppError) {
+	if !a.Config().FeatureFlags.GlobalDrafts || !*a.Config().ServiceSettings.AllowSyncedDrafts {
+		return nil, model.NewAppError("MergeDraft", "app.draft.feature_disabled", nil, "", http.StatusNotImplemented)
+	}
+
+	stored, err := a.Srv().Store().Draft().Get(draft.UserId, draft.ChannelId, draft.RootId, false)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			draft.Version = 0
+			return a.UpsertDraft(c, draft, connectionID)
+		default:
+			return nil, model.NewAppError("MergeDraft", "app.draft.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return a.UpdateDraft(c, model.MergeDrafts(stored, draft), connectionID)
+}
+
 func (a *App) GetDraftsForUser(userID, teamID string) ([]*model.Draft, *model.AppError) {
 	if !a.Config().FeatureFlags.GlobalDrafts || !*a.Config().ServiceSettings.AllowSyncedDrafts {
 		return nil, model.NewAppError("GetDraftsForUser", "app.draft.feature_disabled", nil, "", http.StatusNotImplemented)
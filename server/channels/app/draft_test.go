@@ -4,6 +4,7 @@
 package app
 
 import (
+	"net/http"
 	"os"
 	"testing"
 
@@ -255,6 +256,7 @@ func TestUpdateDraft(t *testing.T) {
 
 		draftWithFiles := draft1
 		draftWithFiles.FileIds = []string{fileResp.Id}
+		draftWithFiles.Version = draft2.Version
 
 		draftResp, err := th.App.UpdateDraft(th.Context, draft1, "")
 		assert.Nil(t, err)
@@ -264,6 +266,23 @@ func TestUpdateDraft(t *testing.T) {
 		assert.ElementsMatch(t, draftWithFiles.FileIds, draftResp.FileIds)
 	})
 
+	t.Run("update draft from outdated version", func(t *testing.T) {
+		outdated := &model.Draft{
+			UserId:    user.Id,
+			ChannelId: channel.Id,
+			Message:   "outdated",
+			Version:   1,
+		}
+
+		_, err := th.App.UpdateDraft(th.Context, outdated, "")
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusConflict, err.StatusCode)
+
+		draftResp, err := th.App.GetDraft(user.Id, channel.Id, "")
+		require.Nil(t, err)
+		assert.Equal(t, draft1.Message, draftResp.Message)
+	})
+
 	t.Run("create draft feature flag", func(t *testing.T) {
 		os.Setenv("MM_FEATUREFLAGS_GLOBALDRAFTS", "false")
 		defer os.Unsetenv("MM_FEATUREFLAGS_GLOBALDRAFTS")
@@ -427,3 +446,61 @@ func TestDeleteDraft(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestMergeDraft(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.Server.platform.SetConfigReadOnlyFF(false)
+	defer th.Server.platform.SetConfigReadOnlyFF(true)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { cfg.FeatureFlags.GlobalDrafts = true })
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.AllowSyncedDrafts = true })
+
+	user := th.BasicUser
+	channel := th.BasicChannel
+
+	stored, err := th.App.CreateDraft(th.Context, &model.Draft{
+		UserId:    user.Id,
+		ChannelId: channel.Id,
+		Message:   "hello",
+	}, "")
+	require.Nil(t, err)
+
+	_, err = th.App.UpdateDraft(th.Context, &model.Draft{
+		UserId:    user.Id,
+		ChannelId: channel.Id,
+		Message:   "hello world",
+		Version:   stored.Version,
+	}, "")
+	require.Nil(t, err)
+
+	t.Run("merge draft", func(t *testing.T) {
+		merged, err := th.App.MergeDraft(th.Context, &model.Draft{
+			UserId:    user.Id,
+			ChannelId: channel.Id,
+			Message:   "hello there",
+			Version:   1,
+		}, "")
+		require.Nil(t, err)
+
+		assert.Equal(t, "hello world\n\nhello there", merged.Message)
+		assert.Equal(t, int64(3), merged.Version)
+	})
+
+	t.Run("merge deleted draft", func(t *testing.T) {
+		_, err := th.App.DeleteDraft(user.Id, channel.Id, "", "")
+		require.Nil(t, err)
+
+		merged, err := th.App.MergeDraft(th.Context, &model.Draft{
+			UserId:    user.Id,
+			ChannelId: channel.Id,
+			Message:   "resumed",
+			Version:   1,
+		}, "")
+		require.Nil(t, err)
+
+		assert.Equal(t, "resumed", merged.Message)
+		assert.Equal(t, int64(4), merged.Version)
+	})
+}
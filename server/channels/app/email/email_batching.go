@@ -14,9 +14,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/i18n"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/templates"
 )
 
 const (
@@ -34,6 +37,8 @@ type postData struct {
 	ShowChannelIcon          bool
 	OtherChannelMembersCount int
 	MessageAttachments       []*EmailMessageAttachment
+	// ThreadReply is set when the post follows another post of the same thread in the digest.
+	ThreadReply bool
 }
 
 func (es *Service) InitEmailBatching() {
@@ -165,24 +170,9 @@ func (job *EmailBatchingJob) checkPendingNotifications(now time.Time, handler fu
 			continue
 		}
 
-		// get how long we need to wait to send notifications to the user
-		var interval int64
-		preference, err := job.service.store.Preference().Get(userID, model.PreferenceCategoryNotifications, model.PreferenceNameEmailInterval)
-		if err != nil {
-			// use the default batching interval if an error occurs while fetching user preferences
-			interval, _ = strconv.ParseInt(model.PreferenceEmailIntervalBatchingSeconds, 10, 64)
-		} else {
-			if value, err := strconv.ParseInt(preference.Value, 10, 64); err != nil {
-				// // use the default batching interval if an error occurs while deserializing user preferences
-				interval, _ = strconv.ParseInt(model.PreferenceEmailIntervalBatchingSeconds, 10, 64)
-			} else {
-				interval = value
-			}
-		}
-
 		batchStartTime := notifications[0].post.CreateAt
 		// Ignore if it isn't time yet to send.
-		if now.Sub(time.UnixMilli(batchStartTime)) <= time.Duration(interval)*time.Second {
+		if !now.After(job.dueTime(userID, time.UnixMilli(batchStartTime))) {
 			continue
 		}
 
@@ -236,6 +226,61 @@ func (job *EmailBatchingJob) checkPendingNotifications(now time.Time, handler fu
 	}
 }
 
+// dueTime returns when the notifications batched for the user since batchStart are to be sent.
+// Users with a digest schedule get them at the next scheduled time in their own timezone, the
+// others once their email interval has passed.
+func (job *EmailBatchingJob) dueTime(userID string, batchStart time.Time) time.Time {
+	if preference, err := job.service.store.Preference().Get(userID, model.PreferenceCategoryNotifications, model.PreferenceNameEmailDigestSchedule); err == nil {
+		if schedule, err := model.EmailDigestScheduleFromJSON(preference.Value); err == nil {
+			var loc *time.Location
+			if user, err := job.service.userService.GetUser(userID); err == nil {
+				loc = user.GetTimezoneLocation()
+			}
+			return schedule.Next(batchStart, loc)
+		}
+	}
+
+	// get how long we need to wait to send notifications to the user
+	var interval int64
+	preference, err := job.service.store.Preference().Get(userID, model.PreferenceCategoryNotifications, model.PreferenceNameEmailInterval)
+	if err != nil {
+		// use the default batching interval if an error occurs while fetching user preferences
+		interval, _ = strconv.ParseInt(model.PreferenceEmailIntervalBatchingSeconds, 10, 64)
+	} else {
+		if value, err := strconv.ParseInt(preference.Value, 10, 64); err != nil {
+			// // use the default batching interval if an error occurs while deserializing user preferences
+			interval, _ = strconv.ParseInt(model.PreferenceEmailIntervalBatchingSeconds, 10, 64)
+		} else {
+			interval = value
+		}
+	}
+
+	return batchStart.Add(time.Duration(interval) * time.Second)
+}
+
+// groupNotificationsByThread orders the notifications so that the replies to a thread follow each
+// other, keeping the threads in the order of their first notification.
+func groupNotificationsByThread(notifications []*batchedNotification) []*batchedNotification {
+	var threadIDs []string
+	byThread := make(map[string][]*batchedNotification)
+	for _, notification := range notifications {
+		threadID := notification.post.RootId
+		if threadID == "" {
+			threadID = notification.post.Id
+		}
+		if _, ok := byThread[threadID]; !ok {
+			threadIDs = append(threadIDs, threadID)
+		}
+		byThread[threadID] = append(byThread[threadID], notification)
+	}
+
+	grouped := make([]*batchedNotification, 0, len(notifications))
+	for _, threadID := range threadIDs {
+		grouped = append(grouped, byThread[threadID]...)
+	}
+	return grouped
+}
+
 /**
 * If the name is longer than i characters, replace remaining characters with ...
  */
@@ -279,7 +324,8 @@ func (es *Service) sendBatchedEmailNotification(userID string, notifications []*
 	}
 
 	if emailNotificationContentsType == model.EmailNotificationContentsFull {
-		for i, notification := range notifications {
+		var previousThreadID string
+		for i, notification := range groupNotificationsByThread(notifications) {
 			sender, errSender := es.userService.GetUser(notification.post.UserId)
 			if errSender != nil {
 				mlog.Warn("Unable to find sender of post for batched email notification")
@@ -342,7 +388,13 @@ func (es *Service) sendBatchedEmailNotification(userID string, notifications []*
 				ShowChannelIcon:          showChannelIcon,
 				OtherChannelMembersCount: otherChannelMembersCount,
 				MessageAttachments:       ProcessMessageAttachments(notification.post, siteURL),
+				ThreadReply:              notification.post.RootId != "" && notification.post.RootId == previousThreadID,
 			})
+
+			previousThreadID = notification.post.RootId
+			if previousThreadID == "" {
+				previousThreadID = notification.post.Id
+			}
 		}
 	}
 
@@ -367,7 +419,7 @@ func (es *Service) sendBatchedEmailNotification(userID string, notifications []*
 	data.Props["NotificationFooterInfoLogin"] = translateFunc("app.notification.footer.infoLogin")
 	data.Props["NotificationFooterInfo"] = translateFunc("app.notification.footer.info")
 
-	renderedPage, renderErr := es.templatesContainer.RenderToString("messages_notification", data)
+	renderedPage, renderErr := es.renderEmailDigest(data)
 	if renderErr != nil {
 		mlog.Error("Unable to render email", mlog.Err(renderErr))
 	}
@@ -376,3 +428,53 @@ func (es *Service) sendBatchedEmailNotification(userID string, notifications []*
 		mlog.Warn("Unable to send batched email notification", mlog.String("email", user.Email), mlog.Err(nErr))
 	}
 }
+
+// renderEmailDigest renders the batched email notifications with the digest template configured by
+// the admin, falling back to the default one. The template receives the same data as the default.
+func (es *Service) renderEmailDigest(data templates.Data) (string, error) {
+	tmpl, err := es.getEmailDigestTemplate()
+	if err != nil {
+		mlog.Warn("Unable to load the email digest template, using the default one", mlog.Err(err))
+	}
+	if tmpl == nil {
+		return es.templatesContainer.RenderToString("messages_notification", data)
+	}
+
+	var text bytes.Buffer
+	if err := tmpl.Execute(&text, data); err != nil {
+		return "", err
+	}
+	return text.String(), nil
+}
+
+// getEmailDigestTemplate returns the email digest template configured in EmailSettings, or nil if
+// there's none. The template is parsed again only when its source changes.
+func (es *Service) getEmailDigestTemplate() (*template.Template, error) {
+	source := *es.config().EmailSettings.EmailDigestTemplate
+	if path := *es.config().EmailSettings.EmailDigestTemplateFile; path != "" {
+		if es.fileBackend == nil {
+			return nil, errors.New("no file store to read the email digest template from")
+		}
+		data, err := es.fileBackend().ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the email digest template %q", path)
+		}
+		source = string(data)
+	}
+	if source == "" {
+		return nil, nil
+	}
+
+	es.digestTemplateMut.Lock()
+	defer es.digestTemplateMut.Unlock()
+
+	if es.digestTemplate == nil || es.digestTemplateSource != source {
+		tmpl, err := template.New("email_digest").Parse(source)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse the email digest template")
+		}
+		es.digestTemplate = tmpl
+		es.digestTemplateSource = source
+	}
+	return es.digestTemplate, nil
+}
@@ -283,3 +283,92 @@ func TestCheckPendingNotificationsCantParseInterval(t *testing.T) {
 
 	require.Nil(t, job.pendingNotifications[th.BasicUser.Id], "should have sent queued post")
 }
+
+func TestCheckPendingNotificationsDigestSchedule(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	job := NewEmailBatchingJob(th.service, 128)
+
+	channelMember, err := th.store.Channel().GetMember(context.Background(), th.BasicChannel.Id, th.BasicUser.Id)
+	require.NoError(t, err)
+	channelMember.LastViewedAt = 9999000
+	_, err = th.store.Channel().UpdateMember(channelMember)
+	require.NoError(t, err)
+
+	// the digest schedule takes precedence over the email interval
+	nErr := th.store.Preference().Save(model.Preferences{{
+		UserId:   th.BasicUser.Id,
+		Category: model.PreferenceCategoryNotifications,
+		Name:     model.PreferenceNameEmailInterval,
+		Value:    "30",
+	}, {
+		UserId:   th.BasicUser.Id,
+		Category: model.PreferenceCategoryNotifications,
+		Name:     model.PreferenceNameEmailDigestSchedule,
+		Value:    `{"frequency":"daily","hour":3}`,
+	}})
+	require.NoError(t, nErr)
+
+	job.pendingNotifications[th.BasicUser.Id] = []*batchedNotification{
+		{
+			post: &model.Post{
+				UserId:    th.BasicUser.Id,
+				ChannelId: th.BasicChannel.Id,
+				CreateAt:  10000000,
+			},
+			teamName: th.BasicTeam.Name,
+		},
+	}
+
+	// the post was created at 02:46:40, so the digest is due at 03:00
+	job.checkPendingNotifications(time.Unix(10799, 0), func(string, []*batchedNotification) {})
+	require.Len(t, job.pendingNotifications[th.BasicUser.Id], 1, "shouldn't have sent queued post")
+
+	job.checkPendingNotifications(time.Unix(10801, 0), func(string, []*batchedNotification) {})
+	require.Nil(t, job.pendingNotifications[th.BasicUser.Id], "should have sent queued post")
+}
+
+func TestGroupNotificationsByThread(t *testing.T) {
+	root1 := model.NewId()
+	root2 := model.NewId()
+	newNotification := func(id, rootID string) *batchedNotification {
+		return &batchedNotification{post: &model.Post{Id: id, RootId: rootID}}
+	}
+
+	notifications := []*batchedNotification{
+		newNotification(root1, ""),
+		newNotification("a", root2),
+		newNotification("b", root1),
+		newNotification("c", ""),
+		newNotification("d", root2),
+	}
+
+	var ids []string
+	for _, notification := range groupNotificationsByThread(notifications) {
+		ids = append(ids, notification.post.Id)
+	}
+	assert.Equal(t, []string{root1, "b", "a", "d", "c"}, ids)
+}
+
+func TestRenderEmailDigest(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	data := th.service.NewEmailTemplateData("en")
+	data.Props["Title"] = "You have new notifications"
+
+	th.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.EmailDigestTemplate = `<h1>{{.Props.Title}}</h1>`
+	})
+	rendered, err := th.service.renderEmailDigest(data)
+	require.NoError(t, err)
+	assert.Equal(t, "<h1>You have new notifications</h1>", rendered)
+
+	th.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.EmailDigestTemplate = `<p>{{.Props.Title}}</p>`
+	})
+	rendered, err = th.service.renderEmailDigest(data)
+	require.NoError(t, err)
+	assert.Equal(t, "<p>You have new notifications</p>", rendered, "the template should be parsed again when it changes")
+}
@@ -4,9 +4,11 @@
 package email
 
 import (
+	"html/template"
 	"io"
 	"net/url"
 	"path"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/throttled/throttled"
@@ -15,6 +17,7 @@ import (
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/channels/app/users"
 	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/filestore"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/i18n"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/templates"
@@ -42,8 +45,9 @@ func condenseSiteURL(siteURL string) string {
 }
 
 type Service struct {
-	config  func() *model.Config
-	license func() *model.License
+	config      func() *model.Config
+	license     func() *model.License
+	fileBackend func() filestore.FileBackend
 
 	userService *users.UserService
 	store       store.Store
@@ -52,11 +56,17 @@ type Service struct {
 	perHourEmailRateLimiter *throttled.GCRARateLimiter
 	perDayEmailRateLimiter  *throttled.GCRARateLimiter
 	EmailBatching           *EmailBatchingJob
+
+	digestTemplateMut    sync.Mutex
+	digestTemplateSource string
+	digestTemplate       *template.Template
 }
 
 type ServiceConfig struct {
 	ConfigFn  func() *model.Config
 	LicenseFn func() *model.License
+	// FileBackendFn is optional, and used to load the email digest template from the file store.
+	FileBackendFn func() filestore.FileBackend
 
 	TemplatesContainer *templates.Container
 	UserService        *users.UserService
@@ -71,6 +81,7 @@ func NewService(config ServiceConfig) (*Service, error) {
 		config:             config.ConfigFn,
 		templatesContainer: config.TemplatesContainer,
 		license:            config.LicenseFn,
+		fileBackend:        config.FileBackendFn,
 		store:              config.Store,
 		userService:        config.UserService,
 	}
@@ -50,6 +50,12 @@ func RegisterJobsElasticsearchIndexerInterface(f func(*Server) ejobs.IndexerJobI
 	jobsElasticsearchIndexerInterface = f
 }
 
+var jobsElasticsearchIndexMigrationInterface func(*Server) ejobs.ElasticsearchIndexMigrationInterface
+
+func RegisterJobsElasticsearchIndexMigrationInterface(f func(*Server) ejobs.ElasticsearchIndexMigrationInterface) {
+	jobsElasticsearchIndexMigrationInterface = f
+}
+
 var jobsLdapSyncInterface func(*App) ejobs.LdapSyncInterface
 
 func RegisterJobsLdapSyncInterface(f func(*App) ejobs.LdapSyncInterface) {
@@ -86,6 +92,12 @@ func RegisterNotificationInterface(f func(*App) einterfaces.NotificationInterfac
 	notificationInterface = f
 }
 
+var vectorStoreInterface func(*App) einterfaces.VectorStoreInterface
+
+func RegisterVectorStoreInterface(f func(*App) einterfaces.VectorStoreInterface) {
+	vectorStoreInterface = f
+}
+
 func (s *Server) initEnterprise() {
 	if cloudInterface != nil {
 		s.Cloud = cloudInterface(s)
@@ -89,40 +89,40 @@ func (a *App) BulkExport(ctx request.CTX, writer io.Writer, outPath string, job
 	}
 
 	ctx.Logger().Info("Bulk export: exporting teams")
-	teamNames, err := a.exportAllTeams(ctx, job, writer)
+	teamNames, err := a.exportAllTeams(ctx, job, writer, opts.Since)
 	if err != nil {
 		return err
 	}
 
 	ctx.Logger().Info("Bulk export: exporting channels")
-	if err = a.exportAllChannels(ctx, job, writer, teamNames); err != nil {
+	if err = a.exportAllChannels(ctx, job, writer, teamNames, opts.Since); err != nil {
 		return err
 	}
 
 	ctx.Logger().Info("Bulk export: exporting users")
-	if err = a.exportAllUsers(ctx, job, writer); err != nil {
+	if err = a.exportAllUsers(ctx, job, writer, opts.Since); err != nil {
 		return err
 	}
 
 	ctx.Logger().Info("Bulk export: exporting posts")
-	attachments, err := a.exportAllPosts(ctx, job, writer, opts.IncludeAttachments)
+	attachments, err := a.exportAllPosts(ctx, job, writer, opts.IncludeAttachments, opts.Since)
 	if err != nil {
 		return err
 	}
 
 	ctx.Logger().Info("Bulk export: exporting emoji")
-	emojiPaths, err := a.exportCustomEmoji(ctx, job, writer, outPath, "exported_emoji", !opts.CreateArchive)
+	emojiPaths, err := a.exportCustomEmoji(ctx, job, writer, outPath, "exported_emoji", !opts.CreateArchive, opts.Since)
 	if err != nil {
 		return err
 	}
 
 	ctx.Logger().Info("Bulk export: exporting direct channels")
-	if err = a.exportAllDirectChannels(ctx, job, writer); err != nil {
+	if err = a.exportAllDirectChannels(ctx, job, writer, opts.Since); err != nil {
 		return err
 	}
 
 	ctx.Logger().Info("Bulk export: exporting direct posts")
-	directAttachments, err := a.exportAllDirectPosts(ctx, job, writer, opts.IncludeAttachments)
+	directAttachments, err := a.exportAllDirectPosts(ctx, job, writer, opts.IncludeAttachments, opts.Since)
 	if err != nil {
 		return err
 	}
@@ -182,7 +182,7 @@ func (a *App) exportVersion(writer io.Writer) *model.AppError {
 	return a.exportWriteLine(writer, versionLine)
 }
 
-func (a *App) exportAllTeams(ctx request.CTX, job *model.Job, writer io.Writer) (map[string]bool, *model.AppError) {
+func (a *App) exportAllTeams(ctx request.CTX, job *model.Job, writer io.Writer, since int64) (map[string]bool, *model.AppError) {
 	afterId := strings.Repeat("0", 26)
 	teamNames := make(map[string]bool)
 	cnt := 0
@@ -207,6 +207,11 @@ func (a *App) exportAllTeams(ctx request.CTX, job *model.Job, writer io.Writer)
 			}
 			teamNames[team.Name] = true
 
+			// Unchanged teams are still needed to tell which channels to export.
+			if team.UpdateAt < since {
+				continue
+			}
+
 			teamLine := ImportLineFromTeam(team)
 			if err := a.exportWriteLine(writer, teamLine); err != nil {
 				return nil, err
@@ -217,7 +222,7 @@ func (a *App) exportAllTeams(ctx request.CTX, job *model.Job, writer io.Writer)
 	return teamNames, nil
 }
 
-func (a *App) exportAllChannels(ctx request.CTX, job *model.Job, writer io.Writer, teamNames map[string]bool) *model.AppError {
+func (a *App) exportAllChannels(ctx request.CTX, job *model.Job, writer io.Writer, teamNames map[string]bool, since int64) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	cnt := 0
 	for {
@@ -244,8 +249,17 @@ func (a *App) exportAllChannels(ctx request.CTX, job *model.Job, writer io.Write
 			if ok := teamNames[channel.TeamName]; !ok {
 				continue
 			}
+			// Skip unchanged.
+			if channel.UpdateAt < since {
+				continue
+			}
 
-			channelLine := ImportLineFromChannel(channel)
+			bookmarks, err := a.Srv().Store().ChannelBookmark().GetBookmarksForChannel(channel.Id)
+			if err != nil {
+				return model.NewAppError("exportAllChannels", "app.channel_bookmark.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+
+			channelLine := ImportLineFromChannel(channel, bookmarks)
 			if err := a.exportWriteLine(writer, channelLine); err != nil {
 				return err
 			}
@@ -255,7 +269,7 @@ func (a *App) exportAllChannels(ctx request.CTX, job *model.Job, writer io.Write
 	return nil
 }
 
-func (a *App) exportAllUsers(ctx request.CTX, job *model.Job, writer io.Writer) *model.AppError {
+func (a *App) exportAllUsers(ctx request.CTX, job *model.Job, writer io.Writer, since int64) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	cnt := 0
 	for {
@@ -274,6 +288,11 @@ func (a *App) exportAllUsers(ctx request.CTX, job *model.Job, writer io.Writer)
 		for _, user := range users {
 			afterId = user.Id
 
+			// Skip unchanged.
+			if user.UpdateAt < since {
+				continue
+			}
+
 			// Gathering here the exportable preferences to pass them on to ImportLineFromUser
 			exportedPrefs := make(map[string]*string)
 			allPrefs, err := a.GetPreferencesForUser(user.Id)
@@ -410,7 +429,7 @@ func (a *App) buildUserNotifyProps(notifyProps model.StringMap) *imports.UserNot
 	}
 }
 
-func (a *App) exportAllPosts(ctx request.CTX, job *model.Job, writer io.Writer, withAttachments bool) ([]imports.AttachmentImportData, *model.AppError) {
+func (a *App) exportAllPosts(ctx request.CTX, job *model.Job, writer io.Writer, withAttachments bool, since int64) ([]imports.AttachmentImportData, *model.AppError) {
 	var attachments []imports.AttachmentImportData
 	afterId := strings.Repeat("0", 26)
 	var postProcessCount uint64
@@ -442,6 +461,10 @@ func (a *App) exportAllPosts(ctx request.CTX, job *model.Job, writer io.Writer,
 			if post.DeleteAt != 0 {
 				continue
 			}
+			// Skip unchanged threads. Replying to or reacting to a post updates its root as well.
+			if post.UpdateAt < since {
+				continue
+			}
 
 			postLine := ImportLineForPost(post)
 
@@ -556,7 +579,7 @@ func (a *App) buildPostAttachments(postID string) ([]imports.AttachmentImportDat
 	return attachments, nil
 }
 
-func (a *App) exportCustomEmoji(c request.CTX, job *model.Job, writer io.Writer, outPath, exportDir string, exportFiles bool) ([]string, *model.AppError) {
+func (a *App) exportCustomEmoji(c request.CTX, job *model.Job, writer io.Writer, outPath, exportDir string, exportFiles bool, since int64) ([]string, *model.AppError) {
 	var emojiPaths []string
 	pageNumber := 0
 	cnt := 0
@@ -584,6 +607,11 @@ func (a *App) exportCustomEmoji(c request.CTX, job *model.Job, writer io.Writer,
 		}
 
 		for _, emoji := range customEmojiList {
+			// Skip unchanged.
+			if emoji.UpdateAt < since {
+				continue
+			}
+
 			emojiImagePath := filepath.Join(emojiPath, emoji.Id, "image")
 			filePath := filepath.Join(exportDir, emoji.Id, "image")
 			if exportFiles {
@@ -640,7 +668,7 @@ func (a *App) copyEmojiImages(emojiId string, emojiImagePath string, pathToDir s
 	return nil
 }
 
-func (a *App) exportAllDirectChannels(ctx request.CTX, job *model.Job, writer io.Writer) *model.AppError {
+func (a *App) exportAllDirectChannels(ctx request.CTX, job *model.Job, writer io.Writer, since int64) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	cnt := 0
 	for {
@@ -667,6 +695,10 @@ func (a *App) exportAllDirectChannels(ctx request.CTX, job *model.Job, writer io
 			if channel.DeleteAt != 0 {
 				continue
 			}
+			// Skip unchanged.
+			if channel.UpdateAt < since {
+				continue
+			}
 
 			favoritedBy, err := a.buildFavoritedByList(channel.Id)
 			if err != nil {
@@ -706,7 +738,7 @@ func (a *App) buildFavoritedByList(channelID string) ([]string, *model.AppError)
 	return userIDs, nil
 }
 
-func (a *App) exportAllDirectPosts(ctx request.CTX, job *model.Job, writer io.Writer, withAttachments bool) ([]imports.AttachmentImportData, *model.AppError) {
+func (a *App) exportAllDirectPosts(ctx request.CTX, job *model.Job, writer io.Writer, withAttachments bool, since int64) ([]imports.AttachmentImportData, *model.AppError) {
 	var attachments []imports.AttachmentImportData
 	afterId := strings.Repeat("0", 26)
 	var postProcessCount uint64
@@ -738,6 +770,10 @@ func (a *App) exportAllDirectPosts(ctx request.CTX, job *model.Job, writer io.Wr
 			if post.DeleteAt != 0 {
 				continue
 			}
+			// Skip unchanged threads.
+			if post.UpdateAt < since {
+				continue
+			}
 
 			// Handle attachments.
 			var postAttachments []imports.AttachmentImportData
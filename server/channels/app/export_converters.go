@@ -24,8 +24,8 @@ func ImportLineFromTeam(team *model.TeamForExport) *imports.LineImportData {
 	}
 }
 
-func ImportLineFromChannel(channel *model.ChannelForExport) *imports.LineImportData {
-	return &imports.LineImportData{
+func ImportLineFromChannel(channel *model.ChannelForExport, bookmarks []*model.ChannelBookmark) *imports.LineImportData {
+	line := &imports.LineImportData{
 		Type: "channel",
 		Channel: &imports.ChannelImportData{
 			Team:        &channel.TeamName,
@@ -37,6 +37,23 @@ func ImportLineFromChannel(channel *model.ChannelForExport) *imports.LineImportD
 			Scheme:      channel.SchemeName,
 		},
 	}
+
+	var bookmarksData []imports.ChannelBookmarkImportData
+	for _, bookmark := range bookmarks {
+		if bookmark.Type != model.ChannelBookmarkLink {
+			continue
+		}
+		bookmarksData = append(bookmarksData, imports.ChannelBookmarkImportData{
+			DisplayName: model.NewString(bookmark.DisplayName),
+			LinkUrl:     model.NewString(bookmark.LinkUrl),
+			Emoji:       model.NewString(bookmark.Emoji),
+		})
+	}
+	if len(bookmarksData) > 0 {
+		line.Channel.Bookmarks = &bookmarksData
+	}
+
+	return line
 }
 
 func ImportLineFromDirectChannel(channel *model.DirectChannelForExport, favoritedBy []string) *imports.LineImportData {
@@ -5,16 +5,19 @@ package app
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/imports"
 	"github.com/mattermost/mattermost-server/v6/server/channels/utils"
 	"github.com/mattermost/mattermost-server/v6/server/channels/utils/fileutils"
 )
@@ -173,7 +176,7 @@ func TestExportCustomEmoji(t *testing.T) {
 	outPath, err := filepath.Abs(filePath)
 	require.NoError(t, err)
 
-	_, appErr := th.App.exportCustomEmoji(th.Context, nil, fileWriter, outPath, dirNameToExportEmoji, false)
+	_, appErr := th.App.exportCustomEmoji(th.Context, nil, fileWriter, outPath, dirNameToExportEmoji, false, 0)
 	require.Nil(t, appErr, "should not have failed")
 }
 
@@ -663,6 +666,37 @@ func TestBulkExport(t *testing.T) {
 	require.Nil(t, appErr)
 }
 
+func TestBulkExportSince(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	time.Sleep(time.Millisecond)
+	since := model.GetMillis()
+
+	post := th.CreatePost(th.BasicChannel)
+	user := th.CreateUser()
+
+	var b bytes.Buffer
+	appErr := th.App.BulkExport(th.Context, &b, "somePath", nil, model.BulkExportOpts{Since: since})
+	require.Nil(t, appErr)
+
+	var types []string
+	decoder := json.NewDecoder(&b)
+	for decoder.More() {
+		var line imports.LineImportData
+		require.NoError(t, decoder.Decode(&line))
+		types = append(types, line.Type)
+
+		switch line.Type {
+		case "post":
+			assert.Equal(t, post.Message, *line.Post.Message)
+		case "user":
+			assert.Equal(t, user.Username, *line.User.Username)
+		}
+	}
+	assert.Equal(t, []string{"version", "user", "post"}, types)
+}
+
 func TestBuildPostReplies(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
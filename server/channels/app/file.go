@@ -47,6 +47,11 @@ const (
 	jpegEncQuality             = 90
 	maxUploadInitialBufferSize = 1024 * 1024 // 1MB
 	maxContentExtractionSize   = 1024 * 1024 // 1MB
+
+	// Object tags attached to uploaded files when FileSettings.AmazonS3ObjectTagging is enabled.
+	fileTagTeamID     = "team_id"
+	fileTagChannelID  = "channel_id"
+	fileTagUploaderID = "uploader_id"
 )
 
 // Ensure fileInfo service wrapper implements `product.FileInfoStoreService`
@@ -165,6 +170,16 @@ func (a *App) WriteFileContext(ctx context.Context, fr io.Reader, path string) (
 	return a.Srv().writeFileContext(ctx, fr, path)
 }
 
+// NewFileWriter returns a writer streaming the data written to it into the file at the given path,
+// keeping at most partSize bytes in memory. The file is only created once the writer is closed.
+func (a *App) NewFileWriter(ctx context.Context, path string, partSize int64) (filestore.FileWriter, *model.AppError) {
+	w, err := filestore.NewFileWriter(a.FileBackend(), ctx, path, partSize)
+	if err != nil {
+		return nil, model.NewAppError("NewFileWriter", "api.file.write_file.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return w, nil
+}
+
 func (a *App) WriteFile(fr io.Reader, path string) (int64, *model.AppError) {
 	return a.Srv().writeFile(fr, path)
 }
@@ -187,6 +202,32 @@ func (s *Server) writeFileContext(ctx context.Context, fr io.Reader, path string
 	return written, nil
 }
 
+// writeFileWithTags writes the file, tagging it with the given tags if the file backend supports it.
+func (s *Server) writeFileWithTags(fr io.Reader, path string, tags map[string]string) (int64, *model.AppError) {
+	written, err := filestore.TryWriteFileWithTags(s.FileBackend(), fr, path, tags)
+	if err != nil {
+		return written, model.NewAppError("WriteFile", "api.file.write_file.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return written, nil
+}
+
+// fileObjectTags returns the tags identifying the team, channel and uploader of a file,
+// leaving out the ones that don't apply.
+func fileObjectTags(teamID, channelID, userID string) map[string]string {
+	tags := make(map[string]string, 3)
+	for key, value := range map[string]string{
+		fileTagTeamID:     teamID,
+		fileTagChannelID:  channelID,
+		fileTagUploaderID: userID,
+	} {
+		if value != "" {
+			tags[key] = value
+		}
+	}
+	return tags
+}
+
 func (a *App) AppendFile(fr io.Reader, path string) (int64, *model.AppError) {
 	result, nErr := a.FileBackend().AppendFile(fr, path)
 	if nErr != nil {
@@ -611,7 +652,10 @@ func (t *UploadFileTask) init(a *App) {
 	t.teeInput = io.TeeReader(t.limitedInput, t.buf)
 
 	t.pluginsEnvironment = a.GetPluginsEnvironment()
-	t.writeFile = a.WriteFile
+	tags := fileObjectTags(t.TeamId, t.ChannelId, t.UserId)
+	t.writeFile = func(fr io.Reader, path string) (int64, *model.AppError) {
+		return a.Srv().writeFileWithTags(fr, path, tags)
+	}
 	t.saveToDatabase = a.Srv().Store().FileInfo().Save
 }
 
@@ -934,7 +978,7 @@ func (a *App) DoUploadFileExpectModification(c request.CTX, now time.Time, rawTe
 		return nil, data, rejectionError
 	}
 
-	if _, err := a.WriteFile(bytes.NewReader(data), info.Path); err != nil {
+	if _, err := a.Srv().writeFileWithTags(bytes.NewReader(data), info.Path, fileObjectTags(teamID, channelID, userID)); err != nil {
 		return nil, data, err
 	}
 
@@ -1171,12 +1215,7 @@ func (a *App) GetFile(fileID string) ([]byte, *model.AppError) {
 		return nil, err
 	}
 
-	data, err := a.ReadFile(info.Path)
-	if err != nil {
-		return nil, err
-	}
-
-	return data, nil
+	return a.readFileInfo(info)
 }
 
 func (a *App) getFileIgnoreCloudLimit(fileID string) ([]byte, *model.AppError) {
@@ -1185,11 +1224,24 @@ func (a *App) getFileIgnoreCloudLimit(fileID string) ([]byte, *model.AppError) {
 		return nil, err
 	}
 
-	data, err := a.ReadFile(info.Path)
-	if err != nil {
-		return nil, err
+	return a.readFileInfo(info)
+}
+
+func (a *App) readFileInfo(info *model.FileInfo) ([]byte, *model.AppError) {
+	if !info.ColdStorage {
+		return a.ReadFile(info.Path)
 	}
 
+	reader, appErr := a.FileInfoReader(info)
+	if appErr != nil {
+		return nil, appErr
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, model.NewAppError("ReadFile", "api.file.read_file.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
 	return data, nil
 }
 
@@ -1312,7 +1364,7 @@ func (a *App) SearchFilesInTeamForUser(c *request.Context, terms string, userId
 		return model.NewFileInfoList(), nil
 	}
 
-	fileInfoSearchResults, nErr := a.Srv().Store().FileInfo().Search(finalParamsList, userId, teamId, page, perPage)
+	fileInfoSearchResults, nErr := a.Srv().Store().FileInfo().Search(c.Context(), finalParamsList, userId, teamId, page, perPage)
 	if nErr != nil {
 		var appErr *model.AppError
 		switch {
@@ -1323,6 +1375,10 @@ func (a *App) SearchFilesInTeamForUser(c *request.Context, terms string, userId
 		}
 	}
 
+	if appErr := a.filterRestrictedFileInfoList(userId, fileInfoSearchResults); appErr != nil {
+		return nil, appErr
+	}
+
 	return fileInfoSearchResults, a.filterInaccessibleFiles(fileInfoSearchResults, filterFileOptions{assumeSortedCreatedAt: true})
 }
 
@@ -1332,7 +1388,7 @@ func (a *App) ExtractContentFromFileInfo(fileInfo *model.FileInfo) error {
 		return nil
 	}
 
-	file, aerr := a.FileReader(fileInfo.Path)
+	file, aerr := a.FileInfoReader(fileInfo)
 	if aerr != nil {
 		return errors.Wrap(aerr, "failed to open file for extract file content")
 	}
@@ -94,6 +94,19 @@ func TestDoUploadFile(t *testing.T) {
 	assert.Equal(t, value, info4.Path, "stored file at incorrect path")
 }
 
+func TestFileObjectTags(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		fileTagTeamID:     "team",
+		fileTagChannelID:  "channel",
+		fileTagUploaderID: "user",
+	}, fileObjectTags("team", "channel", "user"))
+
+	assert.Equal(t, map[string]string{
+		fileTagChannelID:  "channel",
+		fileTagUploaderID: "user",
+	}, fileObjectTags("", "channel", "user"))
+}
+
 func TestUploadFile(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
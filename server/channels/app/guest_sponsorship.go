@@ -0,0 +1,225 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const expiredGuestsBatchSize = 100
+
+// GetGuestSponsorship returns the sponsorship of the given guest.
+func (a *App) GetGuestSponsorship(userID string) (*model.GuestSponsorship, *model.AppError) {
+	sponsorship, err := a.Srv().Store().GuestSponsorship().Get(userID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetGuestSponsorship", "app.guest_sponsorship.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetGuestSponsorship", "app.guest_sponsorship.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return sponsorship, nil
+}
+
+// GetSponsoredGuests returns a page of the sponsorships of the given sponsor.
+func (a *App) GetSponsoredGuests(sponsorID string, page, perPage int) ([]*model.GuestSponsorship, *model.AppError) {
+	sponsorships, err := a.Srv().Store().GuestSponsorship().GetForSponsor(sponsorID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetSponsoredGuests", "app.guest_sponsorship.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return sponsorships, nil
+}
+
+// GetGuestSponsorReport returns a page of the number of active guests per sponsor.
+func (a *App) GetGuestSponsorReport(page, perPage int) ([]*model.GuestSponsorReport, *model.AppError) {
+	report, err := a.Srv().Store().GuestSponsorship().GetSponsorReport(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetGuestSponsorReport", "app.guest_sponsorship.get_report.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return report, nil
+}
+
+// PatchGuestSponsorship changes the sponsor or the expiration of a guest. A guest without a
+// sponsorship, such as a demoted user, gets one as long as the patch names a sponsor.
+func (a *App) PatchGuestSponsorship(userID string, patch *model.GuestSponsorshipPatch) (*model.GuestSponsorship, *model.AppError) {
+	if err := a.checkSponsoredGuest(userID); err != nil {
+		return nil, err
+	}
+
+	if patch.SponsorId != nil {
+		if err := a.checkGuestSponsor(*patch.SponsorId); err != nil {
+			return nil, err
+		}
+	}
+
+	sponsorship, appErr := a.GetGuestSponsorship(userID)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return nil, appErr
+	}
+
+	if sponsorship == nil {
+		if patch.SponsorId == nil {
+			return nil, model.NewAppError("PatchGuestSponsorship", "app.guest_sponsorship.invalid_sponsor.app_error", nil, "user_id="+userID, http.StatusBadRequest)
+		}
+
+		sponsorship = &model.GuestSponsorship{
+			UserId:    userID,
+			ExpiresAt: model.GuestExpiresAt(model.GetMillis(), *a.Config().GuestAccountsSettings.DefaultExpirationDays),
+		}
+		sponsorship.Patch(patch)
+		return a.saveGuestSponsorship(sponsorship)
+	}
+
+	sponsorship.Patch(patch)
+	return a.updateGuestSponsorship(sponsorship)
+}
+
+// RenewGuestSponsorship moves the expiration of a guest to expiresAt or, when nil, to the end of
+// the configured default expiration period.
+func (a *App) RenewGuestSponsorship(userID string, expiresAt *int64) (*model.GuestSponsorship, *model.AppError) {
+	now := model.GetMillis()
+	newExpiresAt := model.GuestExpiresAt(now, *a.Config().GuestAccountsSettings.DefaultExpirationDays)
+	if expiresAt != nil {
+		newExpiresAt = *expiresAt
+	}
+
+	if newExpiresAt != 0 && newExpiresAt <= now {
+		return nil, model.NewAppError("RenewGuestSponsorship", "app.guest_sponsorship.renew.expires_at.app_error", nil, "user_id="+userID, http.StatusBadRequest)
+	}
+
+	sponsorship, appErr := a.GetGuestSponsorship(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	sponsorship.ExpiresAt = newExpiresAt
+	return a.updateGuestSponsorship(sponsorship)
+}
+
+// DeactivateExpiredGuests deactivates every active guest whose sponsorship has expired.
+func (a *App) DeactivateExpiredGuests(c request.CTX) *model.AppError {
+	now := model.GetMillis()
+	for {
+		sponsorships, err := a.Srv().Store().GuestSponsorship().GetExpired(now, expiredGuestsBatchSize)
+		if err != nil {
+			return model.NewAppError("DeactivateExpiredGuests", "app.guest_sponsorship.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, sponsorship := range sponsorships {
+			user, appErr := a.GetUser(sponsorship.UserId)
+			if appErr != nil {
+				return appErr
+			}
+
+			if !user.IsGuest() {
+				// The guest was promoted without the sponsorship being removed.
+				if err := a.Srv().Store().GuestSponsorship().PermanentDeleteByUser(user.Id); err != nil {
+					return model.NewAppError("DeactivateExpiredGuests", "app.guest_sponsorship.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+				}
+				continue
+			}
+
+			if _, appErr := a.UpdateActive(c, user, false); appErr != nil {
+				return appErr
+			}
+
+			c.Logger().Info("Deactivated expired guest", mlog.String("user_id", user.Id), mlog.String("sponsor_id", sponsorship.SponsorId))
+		}
+
+		if len(sponsorships) < expiredGuestsBatchSize {
+			return nil
+		}
+	}
+}
+
+// sponsorInvitedGuest makes the sender of a guest invitation the sponsor of the new guest. The
+// guest is left unsponsored when the sender can no longer sponsor guests.
+func (a *App) sponsorInvitedGuest(c request.CTX, guestID, senderID string) {
+	if err := a.checkGuestSponsor(senderID); err != nil {
+		c.Logger().Warn("Unable to sponsor invited guest", mlog.String("user_id", guestID), mlog.String("sender_id", senderID), mlog.Err(err))
+		return
+	}
+
+	sponsorship := &model.GuestSponsorship{
+		UserId:    guestID,
+		SponsorId: senderID,
+		ExpiresAt: model.GuestExpiresAt(model.GetMillis(), *a.Config().GuestAccountsSettings.DefaultExpirationDays),
+	}
+	if _, err := a.saveGuestSponsorship(sponsorship); err != nil {
+		c.Logger().Warn("Unable to sponsor invited guest", mlog.String("user_id", guestID), mlog.String("sender_id", senderID), mlog.Err(err))
+	}
+}
+
+func (a *App) checkSponsoredGuest(userID string) *model.AppError {
+	user, err := a.GetUser(userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.IsGuest() {
+		return model.NewAppError("checkSponsoredGuest", "app.guest_sponsorship.not_guest.app_error", nil, "user_id="+userID, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (a *App) checkGuestSponsor(sponsorID string) *model.AppError {
+	sponsor, err := a.GetUser(sponsorID)
+	if err != nil {
+		if err.StatusCode == http.StatusNotFound {
+			return model.NewAppError("checkGuestSponsor", "app.guest_sponsorship.invalid_sponsor.app_error", nil, "sponsor_id="+sponsorID, http.StatusBadRequest).Wrap(err)
+		}
+		return err
+	}
+
+	if sponsor.IsGuest() || sponsor.IsBot || sponsor.DeleteAt != 0 {
+		return model.NewAppError("checkGuestSponsor", "app.guest_sponsorship.invalid_sponsor.app_error", nil, "sponsor_id="+sponsorID, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (a *App) saveGuestSponsorship(sponsorship *model.GuestSponsorship) (*model.GuestSponsorship, *model.AppError) {
+	saved, err := a.Srv().Store().GuestSponsorship().Save(sponsorship)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("saveGuestSponsorship", "app.guest_sponsorship.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return saved, nil
+}
+
+func (a *App) updateGuestSponsorship(sponsorship *model.GuestSponsorship) (*model.GuestSponsorship, *model.AppError) {
+	updated, err := a.Srv().Store().GuestSponsorship().Update(sponsorship)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("updateGuestSponsorship", "app.guest_sponsorship.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("updateGuestSponsorship", "app.guest_sponsorship.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPatchGuestSponsorship(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	guest := th.CreateGuest()
+
+	t.Run("requires a sponsor for an unsponsored guest", func(t *testing.T) {
+		_, appErr := th.App.PatchGuestSponsorship(guest.Id, &model.GuestSponsorshipPatch{ExpiresAt: model.NewInt64(model.GetMillis() + 1000)})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.guest_sponsorship.invalid_sponsor.app_error", appErr.Id)
+	})
+
+	t.Run("rejects guests and deactivated users as sponsors", func(t *testing.T) {
+		otherGuest := th.CreateGuest()
+		_, appErr := th.App.PatchGuestSponsorship(guest.Id, &model.GuestSponsorshipPatch{SponsorId: &otherGuest.Id})
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+
+		deactivated := th.CreateUser()
+		_, appErr = th.App.UpdateActive(th.Context, deactivated, false)
+		require.Nil(t, appErr)
+		_, appErr = th.App.PatchGuestSponsorship(guest.Id, &model.GuestSponsorshipPatch{SponsorId: &deactivated.Id})
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("rejects users who are not guests", func(t *testing.T) {
+		_, appErr := th.App.PatchGuestSponsorship(th.BasicUser2.Id, &model.GuestSponsorshipPatch{SponsorId: &th.BasicUser.Id})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.guest_sponsorship.not_guest.app_error", appErr.Id)
+	})
+
+	t.Run("creates and then updates the sponsorship", func(t *testing.T) {
+		sponsorship, appErr := th.App.PatchGuestSponsorship(guest.Id, &model.GuestSponsorshipPatch{SponsorId: &th.BasicUser.Id})
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser.Id, sponsorship.SponsorId)
+
+		expiresAt := model.GetMillis() + 60000
+		sponsorship, appErr = th.App.PatchGuestSponsorship(guest.Id, &model.GuestSponsorshipPatch{SponsorId: &th.BasicUser2.Id, ExpiresAt: &expiresAt})
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser2.Id, sponsorship.SponsorId)
+		assert.Equal(t, expiresAt, sponsorship.ExpiresAt)
+
+		sponsorships, appErr := th.App.GetSponsoredGuests(th.BasicUser2.Id, 0, 10)
+		require.Nil(t, appErr)
+		require.Len(t, sponsorships, 1)
+		assert.Equal(t, guest.Id, sponsorships[0].UserId)
+	})
+}
+
+func TestRenewGuestSponsorship(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.DefaultExpirationDays = 30 })
+
+	guest := th.CreateGuest()
+	_, appErr := th.App.RenewGuestSponsorship(guest.Id, nil)
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+
+	_, appErr = th.App.PatchGuestSponsorship(guest.Id, &model.GuestSponsorshipPatch{SponsorId: &th.BasicUser.Id, ExpiresAt: model.NewInt64(model.GetMillis() + 1000)})
+	require.Nil(t, appErr)
+
+	t.Run("renews for the default period", func(t *testing.T) {
+		before := model.GetMillis()
+		sponsorship, appErr := th.App.RenewGuestSponsorship(guest.Id, nil)
+		require.Nil(t, appErr)
+		assert.GreaterOrEqual(t, sponsorship.ExpiresAt, model.GuestExpiresAt(before, 30))
+	})
+
+	t.Run("renews to the given time", func(t *testing.T) {
+		expiresAt := model.GetMillis() + 5000
+		sponsorship, appErr := th.App.RenewGuestSponsorship(guest.Id, &expiresAt)
+		require.Nil(t, appErr)
+		assert.Equal(t, expiresAt, sponsorship.ExpiresAt)
+	})
+
+	t.Run("rejects times in the past", func(t *testing.T) {
+		_, appErr := th.App.RenewGuestSponsorship(guest.Id, model.NewInt64(model.GetMillis()-1000))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.guest_sponsorship.renew.expires_at.app_error", appErr.Id)
+	})
+}
+
+func TestDeactivateExpiredGuests(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	expired := th.CreateGuest()
+	_, appErr := th.App.PatchGuestSponsorship(expired.Id, &model.GuestSponsorshipPatch{SponsorId: &th.BasicUser.Id, ExpiresAt: model.NewInt64(model.GetMillis() - 1000)})
+	require.Nil(t, appErr)
+
+	active := th.CreateGuest()
+	_, appErr = th.App.PatchGuestSponsorship(active.Id, &model.GuestSponsorshipPatch{SponsorId: &th.BasicUser.Id, ExpiresAt: model.NewInt64(model.GetMillis() + 60000)})
+	require.Nil(t, appErr)
+
+	require.Nil(t, th.App.DeactivateExpiredGuests(th.Context))
+
+	user, appErr := th.App.GetUser(expired.Id)
+	require.Nil(t, appErr)
+	assert.NotZero(t, user.DeleteAt)
+
+	user, appErr = th.App.GetUser(active.Id)
+	require.Nil(t, appErr)
+	assert.Zero(t, user.DeleteAt)
+
+	t.Run("promoted guests lose their sponsorship", func(t *testing.T) {
+		require.Nil(t, th.App.PromoteGuestToUser(th.Context, active, th.BasicUser.Id))
+
+		_, appErr := th.App.GetGuestSponsorship(active.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+}
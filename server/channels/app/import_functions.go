@@ -312,15 +312,61 @@ func (a *App) importChannel(c request.CTX, data *imports.ChannelImportData, dryR
 	}
 
 	if channel.Id == "" {
-		if _, err := a.CreateChannel(c, channel, false); err != nil {
+		created, err := a.CreateChannel(c, channel, false)
+		if err != nil {
 			return err
 		}
+		channel = created
 	} else {
 		if _, err := a.UpdateChannel(c, channel); err != nil {
 			return err
 		}
 	}
 
+	if data.Bookmarks != nil {
+		if err := a.importChannelBookmarks(c, channel.Id, *data.Bookmarks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importChannelBookmarks adds the bookmarks the channel doesn't have yet, so that importing the
+// same channel again doesn't duplicate its bookmarks.
+func (a *App) importChannelBookmarks(c request.CTX, channelID string, data []imports.ChannelBookmarkImportData) *model.AppError {
+	existing, err := a.GetChannelBookmarks(channelID)
+	if err != nil {
+		return err
+	}
+
+	for _, bookmarkData := range data {
+		bookmark := &model.ChannelBookmark{
+			ChannelId:   channelID,
+			DisplayName: *bookmarkData.DisplayName,
+			LinkUrl:     *bookmarkData.LinkUrl,
+			Type:        model.ChannelBookmarkLink,
+		}
+		if bookmarkData.Emoji != nil {
+			bookmark.Emoji = *bookmarkData.Emoji
+		}
+
+		exists := false
+		for _, other := range existing {
+			if other.Type == model.ChannelBookmarkLink && other.LinkUrl == bookmark.LinkUrl && other.DisplayName == bookmark.DisplayName {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			continue
+		}
+
+		if _, err := a.CreateChannelBookmark(c, bookmark, ""); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1012,7 +1058,7 @@ func (a *App) importUserChannels(c request.CTX, user *model.User, team *model.Te
 		member := &model.ChannelMember{
 			ChannelId:   channel.Id,
 			UserId:      user.Id,
-			NotifyProps: model.GetDefaultChannelNotifyProps(),
+			NotifyProps: model.GetNewChannelMemberNotifyProps(channel),
 			SchemeGuest: user.IsGuest(),
 			SchemeUser:  !user.IsGuest(),
 			SchemeAdmin: false,
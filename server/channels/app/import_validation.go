@@ -0,0 +1,350 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/imports"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+// importValidator keeps track of the teams, channels and users either imported by the lines
+// validated so far or found in the database, so that references to them are only looked up once.
+type importValidator struct {
+	a        *App
+	teams    map[string]bool
+	channels map[string]bool // keyed by team name and channel name
+	users    map[string]bool
+}
+
+// ValidateBulkImport checks the import data without writing anything. Besides validating each line,
+// it checks that the teams, channels and users a line refers to are either imported by an earlier
+// line or already exist. Unlike a dry run, it doesn't stop at the first error but reports them all.
+func (a *App) ValidateBulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, importPath string) (*model.BulkImportValidationReport, *model.AppError) {
+	scanner := bufio.NewScanner(jsonlReader)
+	buf := make([]byte, 0, 64*1024)
+	scanner.Buffer(buf, maxScanTokenSize)
+
+	var attachedFiles map[string]*zip.File
+	if attachmentsReader != nil {
+		attachedFiles = make(map[string]*zip.File, len(attachmentsReader.File))
+		for _, fi := range attachmentsReader.File {
+			attachedFiles[fi.Name] = fi
+		}
+	}
+
+	v := &importValidator{
+		a:        a,
+		teams:    make(map[string]bool),
+		channels: make(map[string]bool),
+		users:    make(map[string]bool),
+	}
+	report := model.NewBulkImportValidationReport()
+
+	for scanner.Scan() {
+		report.Lines++
+		issue := func(appErr *model.AppError, lineType string) model.BulkImportValidationIssue {
+			appErr.Translate(c.GetT())
+			return model.BulkImportValidationIssue{
+				LineNumber: report.Lines,
+				LineType:   lineType,
+				Id:         appErr.Id,
+				Message:    appErr.Message,
+			}
+		}
+
+		var line imports.LineImportData
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			report.AddError(issue(model.NewAppError("ValidateBulkImport", "app.import.bulk_import.json_decode.error", nil, "", http.StatusBadRequest).Wrap(err), ""))
+			continue
+		}
+
+		if report.Lines == 1 {
+			if version, appErr := processImportDataFileVersionLine(line); appErr != nil {
+				report.AddError(issue(appErr, line.Type))
+			} else if version != 1 {
+				report.AddError(issue(model.NewAppError("ValidateBulkImport", "app.import.bulk_import.unsupported_version.error", nil, "", http.StatusBadRequest), line.Type))
+			}
+			continue
+		}
+
+		appErrs, err := v.validateLine(&line)
+		if err != nil {
+			return nil, model.NewAppError("ValidateBulkImport", "app.import.validate.lookup.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, appErr := range appErrs {
+			if stopOnError(c, imports.LineImportWorkerError{Error: appErr, LineNumber: report.Lines}) {
+				report.AddError(issue(appErr, line.Type))
+			} else {
+				report.AddWarning(issue(appErr, line.Type))
+			}
+		}
+		if len(appErrs) > 0 {
+			continue
+		}
+
+		if err := processAttachments(c, &line, importPath, attachedFiles); err != nil {
+			report.AddWarning(issue(model.NewAppError("ValidateBulkImport", "app.import.validate.attachment_not_found.error", map[string]any{"Error": err.Error()}, "", http.StatusBadRequest), line.Type))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, model.NewAppError("ValidateBulkImport", "app.import.bulk_import.file_scan.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return report, nil
+}
+
+// validateLine returns the problems found with the line. The error is only set if looking up
+// existing data failed.
+func (v *importValidator) validateLine(line *imports.LineImportData) ([]*model.AppError, error) {
+	var refs []*model.AppError
+	requireTeam := func(name *string) error {
+		if name == nil {
+			return nil
+		}
+		exists, err := v.teamExists(*name)
+		if err == nil && !exists {
+			refs = append(refs, model.NewAppError("ValidateBulkImport", "app.import.validate.team_not_found.error", map[string]any{"Name": *name}, "", http.StatusBadRequest))
+		}
+		return err
+	}
+	requireChannel := func(teamName, name *string) error {
+		if teamName == nil || name == nil {
+			return nil
+		}
+		exists, err := v.channelExists(*teamName, *name)
+		if err == nil && !exists {
+			refs = append(refs, model.NewAppError("ValidateBulkImport", "app.import.validate.channel_not_found.error", map[string]any{"Name": *name, "TeamName": *teamName}, "", http.StatusBadRequest))
+		}
+		return err
+	}
+	requireUser := func(username *string) error {
+		if username == nil {
+			return nil
+		}
+		exists, err := v.userExists(*username)
+		if err == nil && !exists {
+			refs = append(refs, model.NewAppError("ValidateBulkImport", "app.import.validate.user_not_found.error", map[string]any{"Username": *username}, "", http.StatusBadRequest))
+		}
+		return err
+	}
+	requireReplies := func(replies *[]imports.ReplyImportData) error {
+		if replies == nil {
+			return nil
+		}
+		for _, reply := range *replies {
+			if err := requireUser(reply.User); err != nil {
+				return err
+			}
+			if err := requireReactions(reply.Reactions, requireUser); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch line.Type {
+	case "scheme":
+		if line.Scheme == nil {
+			return []*model.AppError{model.NewAppError("BulkImport", "app.import.import_line.null_scheme.error", nil, "", http.StatusBadRequest)}, nil
+		}
+		if appErr := imports.ValidateSchemeImportData(line.Scheme); appErr != nil {
+			return []*model.AppError{appErr}, nil
+		}
+	case "team":
+		if line.Team == nil {
+			return []*model.AppError{model.NewAppError("BulkImport", "app.import.import_line.null_team.error", nil, "", http.StatusBadRequest)}, nil
+		}
+		if appErr := imports.ValidateTeamImportData(line.Team); appErr != nil {
+			return []*model.AppError{appErr}, nil
+		}
+		v.teams[*line.Team.Name] = true
+	case "channel":
+		if line.Channel == nil {
+			return []*model.AppError{model.NewAppError("BulkImport", "app.import.import_line.null_channel.error", nil, "", http.StatusBadRequest)}, nil
+		}
+		if appErr := imports.ValidateChannelImportData(line.Channel); appErr != nil {
+			return []*model.AppError{appErr}, nil
+		}
+		if err := requireTeam(line.Channel.Team); err != nil {
+			return nil, err
+		}
+		v.channels[*line.Channel.Team+"/"+*line.Channel.Name] = true
+	case "user":
+		if line.User == nil {
+			return []*model.AppError{model.NewAppError("BulkImport", "app.import.import_line.null_user.error", nil, "", http.StatusBadRequest)}, nil
+		}
+		if appErr := imports.ValidateUserImportData(line.User); appErr != nil {
+			return []*model.AppError{appErr}, nil
+		}
+		if line.User.Teams != nil {
+			for _, team := range *line.User.Teams {
+				if err := requireTeam(team.Name); err != nil {
+					return nil, err
+				}
+				if team.Channels == nil {
+					continue
+				}
+				for _, channel := range *team.Channels {
+					if err := requireChannel(team.Name, channel.Name); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		v.users[*line.User.Username] = true
+	case "post":
+		if line.Post == nil {
+			return []*model.AppError{model.NewAppError("BulkImport", "app.import.import_line.null_post.error", nil, "", http.StatusBadRequest)}, nil
+		}
+		if appErr := imports.ValidatePostImportData(line.Post, v.a.MaxPostSize()); appErr != nil {
+			return []*model.AppError{appErr}, nil
+		}
+		if err := requireTeam(line.Post.Team); err != nil {
+			return nil, err
+		}
+		if err := requireChannel(line.Post.Team, line.Post.Channel); err != nil {
+			return nil, err
+		}
+		if err := requireUser(line.Post.User); err != nil {
+			return nil, err
+		}
+		if err := requireReactions(line.Post.Reactions, requireUser); err != nil {
+			return nil, err
+		}
+		if err := requireReplies(line.Post.Replies); err != nil {
+			return nil, err
+		}
+	case "direct_channel":
+		if line.DirectChannel == nil {
+			return []*model.AppError{model.NewAppError("BulkImport", "app.import.import_line.null_direct_channel.error", nil, "", http.StatusBadRequest)}, nil
+		}
+		if appErr := imports.ValidateDirectChannelImportData(line.DirectChannel); appErr != nil {
+			return []*model.AppError{appErr}, nil
+		}
+		for _, member := range *line.DirectChannel.Members {
+			if err := requireUser(&member); err != nil {
+				return nil, err
+			}
+		}
+	case "direct_post":
+		if line.DirectPost == nil {
+			return []*model.AppError{model.NewAppError("BulkImport", "app.import.import_line.null_direct_post.error", nil, "", http.StatusBadRequest)}, nil
+		}
+		if appErr := imports.ValidateDirectPostImportData(line.DirectPost, v.a.MaxPostSize()); appErr != nil {
+			return []*model.AppError{appErr}, nil
+		}
+		for _, member := range *line.DirectPost.ChannelMembers {
+			if err := requireUser(&member); err != nil {
+				return nil, err
+			}
+		}
+		if err := requireUser(line.DirectPost.User); err != nil {
+			return nil, err
+		}
+		if err := requireReactions(line.DirectPost.Reactions, requireUser); err != nil {
+			return nil, err
+		}
+		if err := requireReplies(line.DirectPost.Replies); err != nil {
+			return nil, err
+		}
+	case "emoji":
+		if line.Emoji == nil {
+			return []*model.AppError{model.NewAppError("BulkImport", "app.import.import_line.null_emoji.error", nil, "", http.StatusBadRequest)}, nil
+		}
+		if appErr := imports.ValidateEmojiImportData(line.Emoji); appErr != nil {
+			return []*model.AppError{appErr}, nil
+		}
+	default:
+		return []*model.AppError{model.NewAppError("BulkImport", "app.import.import_line.unknown_line_type.error", map[string]any{"Type": line.Type}, "", http.StatusBadRequest)}, nil
+	}
+
+	return refs, nil
+}
+
+func requireReactions(reactions *[]imports.ReactionImportData, requireUser func(*string) error) error {
+	if reactions == nil {
+		return nil
+	}
+	for _, reaction := range *reactions {
+		if err := requireUser(reaction.User); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *importValidator) teamExists(name string) (bool, error) {
+	if exists, ok := v.teams[name]; ok {
+		return exists, nil
+	}
+
+	_, err := v.a.Srv().Store().Team().GetByName(name)
+	exists, err := isFound(err)
+	if err != nil {
+		return false, err
+	}
+
+	v.teams[name] = exists
+	return exists, nil
+}
+
+func (v *importValidator) channelExists(teamName, name string) (bool, error) {
+	key := teamName + "/" + name
+	if exists, ok := v.channels[key]; ok {
+		return exists, nil
+	}
+
+	team, err := v.a.Srv().Store().Team().GetByName(teamName)
+	exists, err := isFound(err)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		_, err = v.a.Srv().Store().Channel().GetByName(team.Id, name, true)
+		if exists, err = isFound(err); err != nil {
+			return false, err
+		}
+	}
+
+	v.channels[key] = exists
+	return exists, nil
+}
+
+func (v *importValidator) userExists(username string) (bool, error) {
+	if exists, ok := v.users[username]; ok {
+		return exists, nil
+	}
+
+	_, err := v.a.Srv().Store().User().GetByUsername(username)
+	exists, err := isFound(err)
+	if err != nil {
+		return false, err
+	}
+
+	v.users[username] = exists
+	return exists, nil
+}
+
+// isFound tells whether the store lookup that returned err found the entity, only returning an
+// error if the lookup failed for another reason.
+func isFound(err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	var nfErr *store.ErrNotFound
+	if errors.As(err, &nfErr) {
+		return false, nil
+	}
+	return false, err
+}
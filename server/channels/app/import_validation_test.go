@@ -0,0 +1,57 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBulkImport(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	lines := []string{
+		`{"type": "version", "version": 1}`,
+		`{"type": "team", "team": {"name": "newteam", "display_name": "New Team", "type": "O"}}`,
+		`{"type": "channel", "channel": {"team": "newteam", "name": "newchannel", "display_name": "New Channel", "type": "O"}}`,
+		`{"type": "channel", "channel": {"team": "missingteam", "name": "orphan", "display_name": "Orphan", "type": "O"}}`,
+		`{"type": "user", "user": {"username": "newuser", "email": "newuser@example.com", "teams": [{"name": "newteam", "channels": [{"name": "newchannel"}]}]}}`,
+		`{"type": "post", "post": {"team": "newteam", "channel": "newchannel", "user": "newuser", "message": "hello", "create_at": 1}}`,
+		`{"type": "post", "post": {"team": "` + th.BasicTeam.Name + `", "channel": "` + th.BasicChannel.Name + `", "user": "` + th.BasicUser.Username + `", "message": "hello", "create_at": 1}}`,
+		`{"type": "post", "post": {"team": "newteam", "channel": "newchannel", "user": "missinguser", "message": "hello", "create_at": 1}}`,
+		`{"type": "post", "post": {"team": "newteam", "channel": "newchannel", "user": "newuser"}}`,
+		`not json`,
+		`{"type": "unknown"}`,
+	}
+
+	report, appErr := th.App.ValidateBulkImport(th.Context, strings.NewReader(strings.Join(lines, "\n")), nil, "")
+	require.Nil(t, appErr)
+
+	assert.Equal(t, len(lines), report.Lines)
+	assert.True(t, report.HasErrors())
+	assert.Equal(t, 0, report.WarningCount)
+
+	errorLines := map[int]string{}
+	for _, issue := range report.Errors {
+		errorLines[issue.LineNumber] = issue.Id
+		assert.NotEqual(t, issue.Id, issue.Message, "the message should be translated")
+	}
+	assert.Equal(t, map[int]string{
+		4:  "app.import.validate.team_not_found.error",
+		8:  "app.import.validate.user_not_found.error",
+		9:  "app.import.validate_post_import_data.message_missing.error",
+		10: "app.import.bulk_import.json_decode.error",
+		11: "app.import.import_line.unknown_line_type.error",
+	}, errorLines)
+	assert.Equal(t, len(errorLines), report.ErrorCount)
+
+	t.Run("nothing is imported", func(t *testing.T) {
+		_, err := th.App.Srv().Store().Team().GetByName("newteam")
+		require.Error(t, err)
+	})
+}
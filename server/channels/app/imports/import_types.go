@@ -50,6 +50,15 @@ type ChannelImportData struct {
 	Header      *string            `json:"header,omitempty"`
 	Purpose     *string            `json:"purpose,omitempty"`
 	Scheme      *string            `json:"scheme,omitempty"`
+	// Bookmarks are the links bookmarked in the channel, in order. Bookmarked files aren't
+	// exported.
+	Bookmarks *[]ChannelBookmarkImportData `json:"bookmarks,omitempty"`
+}
+
+type ChannelBookmarkImportData struct {
+	DisplayName *string `json:"display_name"`
+	LinkUrl     *string `json:"link_url"`
+	Emoji       *string `json:"emoji,omitempty"`
 }
 
 type UserImportData struct {
@@ -190,6 +190,33 @@ func ValidateChannelImportData(data *ChannelImportData) *model.AppError {
 		return model.NewAppError("BulkImport", "app.import.validate_channel_import_data.scheme_invalid.error", nil, "", http.StatusBadRequest)
 	}
 
+	if data.Bookmarks != nil {
+		if len(*data.Bookmarks) > model.ChannelBookmarksMaxPerChannel {
+			return model.NewAppError("BulkImport", "app.import.validate_channel_import_data.bookmarks_count.error", map[string]any{"Max": model.ChannelBookmarksMaxPerChannel}, "", http.StatusBadRequest)
+		}
+		for _, bookmark := range *data.Bookmarks {
+			if err := ValidateChannelBookmarkImportData(&bookmark); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func ValidateChannelBookmarkImportData(data *ChannelBookmarkImportData) *model.AppError {
+	if data.DisplayName == nil || strings.TrimSpace(*data.DisplayName) == "" || utf8.RuneCountInString(*data.DisplayName) > model.ChannelBookmarkDisplayNameMaxRunes {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.display_name.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.LinkUrl == nil || len(*data.LinkUrl) > model.ChannelBookmarkLinkURLMaxLength || !model.IsValidHTTPURL(*data.LinkUrl) {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.link_url.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Emoji != nil && *data.Emoji != "" && (len(*data.Emoji) > model.EmojiNameMaxLength || !model.IsValidAlphaNumHyphenUnderscorePlus(*data.Emoji)) {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.emoji.error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -466,6 +466,22 @@ func TestImportValidateChannelImportData(t *testing.T) {
 	data.Scheme = ptrStr("abcdefg")
 	err = ValidateChannelImportData(&data)
 	require.Nil(t, err, "Should have succeeded with valid scheme name.")
+
+	// Test with bookmarks.
+	data.Bookmarks = &[]ChannelBookmarkImportData{
+		{DisplayName: ptrStr("Docs"), LinkUrl: ptrStr("https://docs.mattermost.com"), Emoji: ptrStr("books")},
+	}
+	err = ValidateChannelImportData(&data)
+	require.Nil(t, err, "Should have succeeded with valid bookmarks.")
+
+	(*data.Bookmarks)[0].LinkUrl = ptrStr("docs")
+	err = ValidateChannelImportData(&data)
+	require.NotNil(t, err, "Should have failed due to invalid bookmark link.")
+
+	(*data.Bookmarks)[0].LinkUrl = ptrStr("https://docs.mattermost.com")
+	(*data.Bookmarks)[0].DisplayName = nil
+	err = ValidateChannelImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing bookmark display name.")
 }
 
 func TestImportValidateUserImportData(t *testing.T) {
@@ -0,0 +1,66 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+)
+
+// GetInboxForUser returns a page of the unread channels and threads of the user across all their
+// teams. Threads are only listed separately when collapsed reply threads are enabled for the user.
+// Muted channels are only listed when they mention the user, so a page may be shorter than asked
+// for even though there are more pages.
+func (a *App) GetInboxForUser(c request.CTX, userID string, options model.GetInboxOptions) (*model.Inbox, *model.AppError) {
+	if options.PerPage <= 0 {
+		options.PerPage = model.InboxDefaultPerPage
+	} else if options.PerPage > model.InboxMaxPerPage {
+		options.PerPage = model.InboxMaxPerPage
+	}
+
+	// Get one more item of each kind to find out whether there's a next page
+	perPage := options.PerPage
+	options.PerPage++
+	options.CollapsedThreads = a.IsCRTEnabledForUser(c, userID)
+
+	items, err := a.Srv().Store().Channel().GetInboxChannelsForUser(userID, options)
+	if err != nil {
+		return nil, model.NewAppError("GetInboxForUser", "app.inbox.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if options.CollapsedThreads {
+		threads, err := a.Srv().Store().Thread().GetInboxThreadsForUser(userID, options)
+		if err != nil {
+			return nil, model.NewAppError("GetInboxForUser", "app.inbox.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		items = append(items, threads...)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Before(items[j])
+	})
+
+	inbox := &model.Inbox{
+		Items: []*model.InboxItem{},
+	}
+	if len(items) > perPage {
+		items = items[:perPage]
+		inbox.NextCursor = items[len(items)-1].Cursor().String()
+	}
+
+	for _, item := range items {
+		if item.Type == model.InboxItemTypeChannel && item.NotifyProps[model.MarkUnreadNotifyProp] == model.ChannelMarkUnreadMention {
+			if item.MentionCount == 0 {
+				continue
+			}
+			item.MsgCount = 0
+		}
+		inbox.Items = append(inbox.Items, item)
+	}
+
+	return inbox, nil
+}
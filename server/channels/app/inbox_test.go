@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetInboxForUser(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.ThreadAutoFollow = true
+		*cfg.ServiceSettings.CollapsedThreads = model.CollapsedThreadsAlwaysOn
+	})
+
+	root, appErr := th.App.CreatePost(th.Context, &model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "root",
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+	_, appErr = th.App.MarkChannelsAsViewed(th.Context, []string{th.BasicChannel.Id}, th.BasicUser.Id, "", true)
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.CreatePost(th.Context, &model.Post{
+		UserId:    th.BasicUser2.Id,
+		ChannelId: th.BasicChannel.Id,
+		RootId:    root.Id,
+		Message:   "reply",
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+
+	inbox, appErr := th.App.GetInboxForUser(th.Context, th.BasicUser.Id, model.GetInboxOptions{})
+	require.Nil(t, appErr)
+	var thread *model.InboxItem
+	for _, item := range inbox.Items {
+		assert.NotEqual(t, th.BasicChannel.Id, item.Id, "a reply only makes the thread unread with collapsed threads")
+		if item.Id == root.Id {
+			thread = item
+		}
+	}
+	require.NotNil(t, thread)
+	assert.Equal(t, model.InboxItemTypeThread, thread.Type)
+	assert.Equal(t, th.BasicTeam.Id, thread.TeamId)
+
+	t.Run("muted channel", func(t *testing.T) {
+		_, appErr := th.App.UpdateChannelMemberNotifyProps(th.Context, map[string]string{
+			model.MarkUnreadNotifyProp: model.ChannelMarkUnreadMention,
+		}, th.BasicChannel.Id, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+
+		th.CreatePost(th.BasicChannel)
+
+		inbox, appErr := th.App.GetInboxForUser(th.Context, th.BasicUser2.Id, model.GetInboxOptions{})
+		require.Nil(t, appErr)
+		for _, item := range inbox.Items {
+			assert.NotEqual(t, th.BasicChannel.Id, item.ChannelId)
+		}
+	})
+}
@@ -242,6 +242,13 @@ func (a *App) DoPostActionWithCookie(c *request.Context, postID, actionId, userI
 		return "", nil
 	}
 
+	if strings.HasPrefix(upstreamURL, channelJoinRequestActionURLPrefix) {
+		if appErr = a.doLocalChannelJoinRequestAction(c, upstreamURL, upstreamRequest); appErr != nil {
+			return "", appErr
+		}
+		return "", nil
+	}
+
 	requestJSON, err := json.Marshal(upstreamRequest)
 	if err != nil {
 		return "", model.NewAppError("DoPostActionWithCookie", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -0,0 +1,199 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// integrationRetryDelay is the delay before the first retry of a failed integration request, the
+// following retries waiting proportionally longer.
+const integrationRetryDelay = 500 * time.Millisecond
+
+var errIntegrationCircuitOpen = errors.New("requests to the integration are suspended after repeated failures")
+
+// integrationBreakers keeps a circuit breaker for every integration this server sends requests to.
+// The breakers are local to each node of a cluster.
+type integrationBreakers struct {
+	mut      sync.Mutex
+	breakers map[string]*integrationBreaker
+}
+
+type integrationBreaker struct {
+	health        model.IntegrationHealth
+	trialInFlight bool
+}
+
+func newIntegrationBreakers() *integrationBreakers {
+	return &integrationBreakers{
+		breakers: make(map[string]*integrationBreaker),
+	}
+}
+
+func (b *integrationBreakers) get(integrationType, integrationID string) *integrationBreaker {
+	breaker, ok := b.breakers[integrationID]
+	if !ok {
+		breaker = &integrationBreaker{
+			health: model.IntegrationHealth{
+				IntegrationId:   integrationID,
+				IntegrationType: integrationType,
+				CircuitState:    model.IntegrationCircuitStateClosed,
+			},
+		}
+		b.breakers[integrationID] = breaker
+	}
+	return breaker
+}
+
+// allow tells whether a request can be sent to the integration. Once the cooldown of an open
+// circuit has elapsed, a single trial request is allowed until its outcome is recorded.
+func (b *integrationBreakers) allow(integrationType, integrationID string, now int64) bool {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	breaker := b.get(integrationType, integrationID)
+	switch breaker.health.CircuitState {
+	case model.IntegrationCircuitStateOpen:
+		if now < breaker.health.OpenUntil {
+			return false
+		}
+		breaker.health.CircuitState = model.IntegrationCircuitStateHalfOpen
+	case model.IntegrationCircuitStateHalfOpen:
+		if breaker.trialInFlight {
+			return false
+		}
+	default:
+		return true
+	}
+
+	breaker.trialInFlight = true
+	return true
+}
+
+// record records the outcome of a request sent to the integration, opening its circuit after
+// threshold consecutive failures or a failed trial request. A threshold of 0 never opens it.
+func (b *integrationBreakers) record(integrationType, integrationID string, reqErr error, now int64, threshold int, cooldown time.Duration) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	breaker := b.get(integrationType, integrationID)
+	breaker.trialInFlight = false
+	health := &breaker.health
+	health.TotalRequests++
+
+	if reqErr == nil {
+		health.CircuitState = model.IntegrationCircuitStateClosed
+		health.ConsecutiveFailures = 0
+		health.LastSuccessAt = now
+		health.OpenUntil = 0
+		return
+	}
+
+	health.TotalFailures++
+	health.ConsecutiveFailures++
+	health.LastError = reqErr.Error()
+	health.LastFailureAt = now
+
+	if threshold > 0 && (health.CircuitState == model.IntegrationCircuitStateHalfOpen || health.ConsecutiveFailures >= threshold) {
+		health.CircuitState = model.IntegrationCircuitStateOpen
+		health.OpenUntil = now + cooldown.Milliseconds()
+	}
+}
+
+func (b *integrationBreakers) list() []*model.IntegrationHealth {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	list := make([]*model.IntegrationHealth, 0, len(b.breakers))
+	for _, breaker := range b.breakers {
+		health := breaker.health
+		list = append(list, &health)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].IntegrationId < list[j].IntegrationId
+	})
+
+	return list
+}
+
+func (b *integrationBreakers) reset(integrationID string) bool {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	if _, ok := b.breakers[integrationID]; !ok {
+		return false
+	}
+	delete(b.breakers, integrationID)
+
+	return true
+}
+
+// doIntegrationRequest sends the request made by newRequest to an integration, applying the timeout
+// and retries configured for outgoing integrations. Requests fail immediately while the circuit of
+// the integration is open, so that an unresponsive integration doesn't tie up the server. Only
+// connection errors and server errors count as failures and are retried, newRequest being called
+// again for every attempt.
+func (a *App) doIntegrationRequest(integrationType, integrationID string, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	settings := a.Config().ServiceSettings
+	breakers := a.Srv().integrationBreakers
+
+	if !breakers.allow(integrationType, integrationID, model.GetMillis()) {
+		return nil, errIntegrationCircuitOpen
+	}
+
+	client := a.HTTPService().MakeClient(false)
+	client.Timeout = time.Duration(*settings.OutgoingIntegrationsRequestTimeoutSeconds) * time.Second
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; attempt <= *settings.OutgoingIntegrationsMaxRetries; attempt++ {
+		if attempt > 0 {
+			if resp != nil {
+				resp.Body.Close()
+				resp = nil
+			}
+			time.Sleep(time.Duration(attempt) * integrationRetryDelay)
+		}
+
+		var req *http.Request
+		if req, err = newRequest(); err != nil {
+			break
+		}
+
+		resp, err = client.Do(req)
+		if err == nil && resp.StatusCode < http.StatusInternalServerError {
+			break
+		}
+	}
+
+	reqErr := err
+	if err == nil && resp.StatusCode >= http.StatusInternalServerError {
+		reqErr = fmt.Errorf("the integration responded with status %q", resp.Status)
+	}
+	cooldown := time.Duration(*settings.OutgoingIntegrationsCircuitBreakerCooldownSeconds) * time.Second
+	breakers.record(integrationType, integrationID, reqErr, model.GetMillis(), *settings.OutgoingIntegrationsCircuitBreakerThreshold, cooldown)
+
+	return resp, err
+}
+
+// GetIntegrationsHealth returns the health of the integrations this server has sent requests to.
+func (a *App) GetIntegrationsHealth() []*model.IntegrationHealth {
+	return a.Srv().integrationBreakers.list()
+}
+
+// ResetIntegrationHealth forgets the health of the integration, closing its circuit.
+func (a *App) ResetIntegrationHealth(integrationID string) *model.AppError {
+	if !a.Srv().integrationBreakers.reset(integrationID) {
+		return model.NewAppError("ResetIntegrationHealth", "app.integration.health.not_found.app_error", nil, "integration_id="+integrationID, http.StatusNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestIntegrationBreakers(t *testing.T) {
+	hookID := model.NewId()
+	failure := errors.New("connection refused")
+	cooldown := time.Minute
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		breakers := newIntegrationBreakers()
+
+		for i := 0; i < 3; i++ {
+			require.True(t, breakers.allow(model.IntegrationTypeOutgoingWebhook, hookID, 1000))
+			breakers.record(model.IntegrationTypeOutgoingWebhook, hookID, failure, 1000, 3, cooldown)
+		}
+		assert.False(t, breakers.allow(model.IntegrationTypeOutgoingWebhook, hookID, 2000))
+
+		list := breakers.list()
+		require.Len(t, list, 1)
+		assert.Equal(t, model.IntegrationCircuitStateOpen, list[0].CircuitState)
+		assert.Equal(t, 3, list[0].ConsecutiveFailures)
+		assert.Equal(t, int64(3), list[0].TotalFailures)
+		assert.Equal(t, failure.Error(), list[0].LastError)
+		assert.Equal(t, 1000+cooldown.Milliseconds(), list[0].OpenUntil)
+	})
+
+	t.Run("a success resets the failures", func(t *testing.T) {
+		breakers := newIntegrationBreakers()
+
+		breakers.record(model.IntegrationTypeCommand, hookID, failure, 1000, 2, cooldown)
+		breakers.record(model.IntegrationTypeCommand, hookID, nil, 1000, 2, cooldown)
+		breakers.record(model.IntegrationTypeCommand, hookID, failure, 1000, 2, cooldown)
+		assert.True(t, breakers.allow(model.IntegrationTypeCommand, hookID, 1000))
+
+		list := breakers.list()
+		require.Len(t, list, 1)
+		assert.Equal(t, model.IntegrationCircuitStateClosed, list[0].CircuitState)
+		assert.Equal(t, int64(3), list[0].TotalRequests)
+		assert.Equal(t, int64(1000), list[0].LastSuccessAt)
+	})
+
+	t.Run("a threshold of 0 never opens", func(t *testing.T) {
+		breakers := newIntegrationBreakers()
+
+		for i := 0; i < 10; i++ {
+			breakers.record(model.IntegrationTypeOutgoingWebhook, hookID, failure, 1000, 0, cooldown)
+		}
+		assert.True(t, breakers.allow(model.IntegrationTypeOutgoingWebhook, hookID, 1000))
+	})
+
+	t.Run("allows a single trial after the cooldown", func(t *testing.T) {
+		breakers := newIntegrationBreakers()
+		breakers.record(model.IntegrationTypeOutgoingWebhook, hookID, failure, 1000, 1, cooldown)
+
+		afterCooldown := 1000 + cooldown.Milliseconds()
+		require.True(t, breakers.allow(model.IntegrationTypeOutgoingWebhook, hookID, afterCooldown))
+		assert.False(t, breakers.allow(model.IntegrationTypeOutgoingWebhook, hookID, afterCooldown))
+
+		// A failed trial opens the circuit again.
+		breakers.record(model.IntegrationTypeOutgoingWebhook, hookID, failure, afterCooldown, 1, cooldown)
+		assert.False(t, breakers.allow(model.IntegrationTypeOutgoingWebhook, hookID, afterCooldown))
+
+		afterCooldown += cooldown.Milliseconds()
+		require.True(t, breakers.allow(model.IntegrationTypeOutgoingWebhook, hookID, afterCooldown))
+		breakers.record(model.IntegrationTypeOutgoingWebhook, hookID, nil, afterCooldown, 1, cooldown)
+		assert.True(t, breakers.allow(model.IntegrationTypeOutgoingWebhook, hookID, afterCooldown))
+		assert.True(t, breakers.allow(model.IntegrationTypeOutgoingWebhook, hookID, afterCooldown))
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		breakers := newIntegrationBreakers()
+		breakers.record(model.IntegrationTypeOutgoingWebhook, hookID, failure, 1000, 1, cooldown)
+
+		assert.True(t, breakers.reset(hookID))
+		assert.False(t, breakers.reset(hookID))
+		assert.Empty(t, breakers.list())
+		assert.True(t, breakers.allow(model.IntegrationTypeOutgoingWebhook, hookID, 1000))
+	})
+}
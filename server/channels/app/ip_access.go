@@ -0,0 +1,116 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/audit"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// CheckIPAccess evaluates the IP access policies of the given system roles against the address
+// the request was made from. Requests presenting the emergency bypass token are let through
+// regardless. Both denials and bypasses are audited.
+func (a *App) CheckIPAccess(c *request.Context, userID string, roles string, bypassToken string) *model.AppError {
+	settings := a.Config().IPAccessSettings
+	if !*settings.Enable || roles == "" {
+		return nil
+	}
+
+	policy := deniedByIPAccessPolicy(settings.Policies, strings.Fields(roles), net.ParseIP(c.IPAddress()))
+	if policy == nil {
+		return nil
+	}
+
+	if bypassToken != "" && *settings.EmergencyBypassToken != "" &&
+		subtle.ConstantTimeCompare([]byte(bypassToken), []byte(*settings.EmergencyBypassToken)) == 1 {
+		auditRec := a.makeIPAccessAuditRecord(c, "ipAccessBypass", userID, policy)
+		auditRec.Success()
+		a.Srv().Audit.LogRecord(LevelAPI, *auditRec)
+
+		c.Logger().Warn("IP access policy bypassed with the emergency token", mlog.String("user_id", userID), mlog.String("role", *policy.Role))
+		return nil
+	}
+
+	auditRec := a.makeIPAccessAuditRecord(c, "ipAccessDenied", userID, policy)
+	a.Srv().Audit.LogRecord(LevelPerms, *auditRec)
+
+	return model.NewAppError("CheckIPAccess", "app.ip_access.denied.app_error", nil, "role="+*policy.Role+", ip="+c.IPAddress(), http.StatusForbidden)
+}
+
+// CheckWebSocketIPAccess evaluates the IP access policies for a session authenticating over an
+// already upgraded websocket connection, from the address the connection was opened from.
+func (a *App) CheckWebSocketIPAccess(session *model.Session, ipAddress string, bypassToken string) *model.AppError {
+	c := request.EmptyContext(a.Log())
+	c.SetIPAddress(ipAddress)
+	c.SetPath(model.APIURLSuffix + "/websocket")
+	c.SetSession(session)
+
+	return a.CheckIPAccess(c, session.UserId, session.Roles, bypassToken)
+}
+
+// deniedByIPAccessPolicy returns the first policy of the roles denying the address, if any.
+// Addresses that can't be parsed are only allowed by the policies without allowed ranges.
+func deniedByIPAccessPolicy(policies []*model.IPAccessPolicy, roles []string, ip net.IP) *model.IPAccessPolicy {
+	for _, policy := range policies {
+		if !hasRole(roles, *policy.Role) {
+			continue
+		}
+
+		if ipInCIDRs(ip, policy.DeniedCIDRs) {
+			return policy
+		}
+
+		if len(policy.AllowedCIDRs) > 0 && !ipInCIDRs(ip, policy.AllowedCIDRs) {
+			return policy
+		}
+	}
+
+	return nil
+}
+
+func hasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ipInCIDRs(ip net.IP, cidrs []string) bool {
+	if ip == nil {
+		return false
+	}
+
+	for _, cidr := range cidrs {
+		// The ranges are validated with the configuration
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *App) makeIPAccessAuditRecord(c *request.Context, event string, userID string, policy *model.IPAccessPolicy) *audit.Record {
+	auditRec := a.MakeAuditRecord(event, audit.Fail)
+	auditRec.Actor = audit.EventActor{
+		UserId:    userID,
+		SessionId: c.Session().Id,
+		Client:    c.UserAgent(),
+		IpAddress: c.IPAddress(),
+	}
+	auditRec.AddMeta(audit.KeyAPIPath, c.Path())
+	audit.AddEventParameter(auditRec, "role", *policy.Role)
+
+	return auditRec
+}
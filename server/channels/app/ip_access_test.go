@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestDeniedByIPAccessPolicy(t *testing.T) {
+	adminPolicy := &model.IPAccessPolicy{
+		Role:         model.NewString(model.SystemAdminRoleId),
+		AllowedCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"},
+		DeniedCIDRs:  []string{"10.66.0.0/16"},
+	}
+	userPolicy := &model.IPAccessPolicy{
+		Role:        model.NewString(model.SystemUserRoleId),
+		DeniedCIDRs: []string{"192.0.2.0/24"},
+	}
+	policies := []*model.IPAccessPolicy{adminPolicy, userPolicy}
+
+	admin := []string{model.SystemUserRoleId, model.SystemAdminRoleId}
+	user := []string{model.SystemUserRoleId}
+	guest := []string{model.SystemGuestRoleId}
+
+	for name, test := range map[string]struct {
+		Roles    []string
+		IP       string
+		Expected *model.IPAccessPolicy
+	}{
+		"admin from an allowed range":    {admin, "10.1.2.3", nil},
+		"admin from an allowed v6 range": {admin, "2001:db8::1", nil},
+		"admin from elsewhere":           {admin, "203.0.113.7", adminPolicy},
+		"admin from a denied subrange":   {admin, "10.66.0.1", adminPolicy},
+		"admin from a denied user range": {admin, "192.0.2.1", adminPolicy},
+		"user from anywhere":             {user, "203.0.113.7", nil},
+		"user from a denied range":       {user, "192.0.2.1", userPolicy},
+		"guest without policy":           {guest, "192.0.2.1", nil},
+		"unknown address and allowlist":  {admin, "", adminPolicy},
+		"unknown address and denylist":   {user, "", nil},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.Expected, deniedByIPAccessPolicy(policies, test.Roles, net.ParseIP(test.IP)))
+		})
+	}
+}
+
+func TestCheckIPAccess(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	bypassToken := model.NewRandomString(model.IPAccessSettingsEmergencyBypassTokenMinLength)
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.IPAccessSettings.Policies = []*model.IPAccessPolicy{{
+			Role:         model.NewString(model.SystemAdminRoleId),
+			AllowedCIDRs: []string{"10.0.0.0/8"},
+		}}
+		*cfg.IPAccessSettings.EmergencyBypassToken = bypassToken
+	})
+
+	c := th.Context
+	c.SetIPAddress("203.0.113.7")
+	roles := model.SystemUserRoleId + " " + model.SystemAdminRoleId
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Nil(t, th.App.CheckIPAccess(c, model.NewId(), roles, ""))
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.IPAccessSettings.Enable = true
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		appErr := th.App.CheckIPAccess(c, model.NewId(), roles, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.ip_access.denied.app_error", appErr.Id)
+
+		appErr = th.App.CheckIPAccess(c, model.NewId(), roles, model.NewRandomString(model.IPAccessSettingsEmergencyBypassTokenMinLength))
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("bypassed", func(t *testing.T) {
+		assert.Nil(t, th.App.CheckIPAccess(c, model.NewId(), roles, bypassToken))
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		assert.Nil(t, th.App.CheckIPAccess(c, model.NewId(), model.SystemUserRoleId, ""))
+
+		c.SetIPAddress("10.0.0.1")
+		assert.Nil(t, th.App.CheckIPAccess(c, model.NewId(), roles, ""))
+	})
+}
@@ -64,7 +64,20 @@ func (a *App) GetJobsByTypes(jobTypes []string, offset int, limit int) ([]*model
 	return jobs, nil
 }
 
+func (a *App) GetJobsByTypeAndStatusPage(jobType string, status string, page int, perPage int) ([]*model.Job, *model.AppError) {
+	jobs, err := a.Srv().Store().Job().GetAllByTypeAndStatusPage(jobType, status, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetJobsByTypeAndStatusPage", "app.job.get_all.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return jobs, nil
+}
+
+// CreateJob creates a pending job, with the priority of its type unless the job has its own.
 func (a *App) CreateJob(job *model.Job) (*model.Job, *model.AppError) {
+	if job.Priority != 0 {
+		return a.Srv().Jobs.CreateJobWithPriority(job.Type, job.Priority, job.Data)
+	}
 	return a.Srv().Jobs.CreateJob(job.Type, job.Data)
 }
 
@@ -80,7 +93,7 @@ func (a *App) SessionHasPermissionToCreateJob(session model.Session, job *model.
 		return a.SessionHasPermissionTo(session, model.PermissionCreateDataRetentionJob), model.PermissionCreateDataRetentionJob
 	case model.JobTypeMessageExport:
 		return a.SessionHasPermissionTo(session, model.PermissionCreateComplianceExportJob), model.PermissionCreateComplianceExportJob
-	case model.JobTypeElasticsearchPostIndexing:
+	case model.JobTypeElasticsearchPostIndexing, model.JobTypeElasticsearchIndexMigration, model.JobTypeSemanticSearchIndexing:
 		return a.SessionHasPermissionTo(session, model.PermissionCreateElasticsearchPostIndexingJob), model.PermissionCreateElasticsearchPostIndexingJob
 	case model.JobTypeElasticsearchPostAggregation:
 		return a.SessionHasPermissionTo(session, model.PermissionCreateElasticsearchPostAggregationJob), model.PermissionCreateElasticsearchPostAggregationJob
@@ -97,7 +110,15 @@ func (a *App) SessionHasPermissionToCreateJob(session model.Session, job *model.
 		model.JobTypeExportProcess,
 		model.JobTypeExportDelete,
 		model.JobTypeCloud,
-		model.JobTypeExtractContent:
+		model.JobTypeExtractContent,
+		model.JobTypeChannelExport,
+		model.JobTypeColdStorage,
+		model.JobTypeSlackImport,
+		model.JobTypeMSTeamsImport,
+		model.JobTypeExpiredPosts,
+		model.JobTypePartitionMaintenance,
+		model.JobTypePostArchive,
+		model.JobTypeChannelCounters:
 		return a.SessionHasPermissionTo(session, model.PermissionManageJobs), model.PermissionManageJobs
 	}
 
@@ -110,7 +131,7 @@ func (a *App) SessionHasPermissionToReadJob(session model.Session, jobType strin
 		return a.SessionHasPermissionTo(session, model.PermissionReadDataRetentionJob), model.PermissionReadDataRetentionJob
 	case model.JobTypeMessageExport:
 		return a.SessionHasPermissionTo(session, model.PermissionReadComplianceExportJob), model.PermissionReadComplianceExportJob
-	case model.JobTypeElasticsearchPostIndexing:
+	case model.JobTypeElasticsearchPostIndexing, model.JobTypeElasticsearchIndexMigration, model.JobTypeSemanticSearchIndexing:
 		return a.SessionHasPermissionTo(session, model.PermissionReadElasticsearchPostIndexingJob), model.PermissionReadElasticsearchPostIndexingJob
 	case model.JobTypeElasticsearchPostAggregation:
 		return a.SessionHasPermissionTo(session, model.PermissionReadElasticsearchPostAggregationJob), model.PermissionReadElasticsearchPostAggregationJob
@@ -128,9 +149,74 @@ func (a *App) SessionHasPermissionToReadJob(session model.Session, jobType strin
 		model.JobTypeExportProcess,
 		model.JobTypeExportDelete,
 		model.JobTypeCloud,
-		model.JobTypeExtractContent:
+		model.JobTypeExtractContent,
+		model.JobTypeChannelExport,
+		model.JobTypeColdStorage,
+		model.JobTypeSlackImport,
+		model.JobTypeMSTeamsImport,
+		model.JobTypeExpiredPosts,
+		model.JobTypeTeamArchive,
+		model.JobTypeUserOffboarding,
+		model.JobTypePartitionMaintenance,
+		model.JobTypePostArchive,
+		model.JobTypeChannelCounters,
+		model.JobTypeChannelMembersBulk:
 		return a.SessionHasPermissionTo(session, model.PermissionReadJobs), model.PermissionReadJobs
 	}
 
 	return false, nil
 }
+
+// GetJobTypeSettings returns the settings of the registered job types.
+func (a *App) GetJobTypeSettings() []*model.JobTypeSettings {
+	return a.Srv().Jobs.GetAllTypeSettings()
+}
+
+// PatchJobTypeSettings changes the priority, concurrency limit or schedule of the job type, at
+// runtime and for every node of the cluster.
+func (a *App) PatchJobTypeSettings(jobType string, patch *model.JobTypeSettingsPatch) (*model.JobTypeSettings, *model.AppError) {
+	settings := a.Srv().Jobs.TypeSettings(jobType)
+	settings.Patch(patch)
+
+	return a.Srv().Jobs.SaveTypeSettings(settings)
+}
+
+// SetJobTypePaused pauses or resumes the job type. The pending jobs of a paused type aren't
+// started and no job of the type is scheduled, the jobs in progress running to completion.
+func (a *App) SetJobTypePaused(jobType string, paused bool) (*model.JobTypeSettings, *model.AppError) {
+	settings := a.Srv().Jobs.TypeSettings(jobType)
+	settings.Paused = paused
+
+	return a.Srv().Jobs.SaveTypeSettings(settings)
+}
+
+// GetJobTypeStats summarizes the jobs created since the given time by type, along with the
+// settings of the types and the reasons of their most recent failures.
+func (a *App) GetJobTypeStats(since int64) ([]*model.JobTypeStats, *model.AppError) {
+	statsList, err := a.Srv().Store().Job().GetStatsByType(since)
+	if err != nil {
+		return nil, model.NewAppError("GetJobTypeStats", "app.job.get_stats.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, stats := range statsList {
+		stats.Settings = a.Srv().Jobs.TypeSettings(stats.JobType)
+		stats.RecentFailures = []*model.JobFailure{}
+		if stats.StatusCounts[model.JobStatusError] == 0 {
+			continue
+		}
+
+		failed, err := a.Srv().Store().Job().GetAllByTypeAndStatusPage(stats.JobType, model.JobStatusError, 0, model.JobTypeStatsMaxRecentFailures)
+		if err != nil {
+			return nil, model.NewAppError("GetJobTypeStats", "app.job.get_all.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, job := range failed {
+			stats.RecentFailures = append(stats.RecentFailures, &model.JobFailure{
+				JobId:    job.Id,
+				CreateAt: job.CreateAt,
+				Error:    job.Data["error"],
+			})
+		}
+	}
+
+	return statsList, nil
+}
@@ -85,7 +85,8 @@ func fixTypeName(t string) string {
 	if t == "...func(*UploadFileTask)" {
 		t = "...func(*app.UploadFileTask)"
 	}
-	if strings.Contains(t, ".") || strings.Contains(t, "{}") || t == "map[string]any" {
+	// function types are expected to only use types qualified with their package
+	if strings.HasPrefix(t, "func(") || strings.Contains(t, ".") || strings.Contains(t, "{}") || t == "map[string]any" {
 		return t
 	}
 	typeOnly := textRegexp.FindString(t)
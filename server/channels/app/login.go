@@ -168,6 +168,10 @@ func (a *App) DoLogin(c *request.Context, w http.ResponseWriter, r *http.Request
 		return model.NewAppError("DoLogin", "Login rejected by plugin: "+rejectionReason, nil, "", http.StatusBadRequest)
 	}
 
+	if appErr := a.CheckIPAccess(c, user.Id, user.GetRawRoles(), r.Header.Get(model.HeaderIPAccessBypassToken)); appErr != nil {
+		return appErr
+	}
+
 	session := &model.Session{UserId: user.Id, Roles: user.GetRawRoles(), DeviceId: deviceID, IsOAuth: false, Props: map[string]string{
 		model.UserAuthServiceIsMobile: strconv.FormatBool(isMobile),
 		model.UserAuthServiceIsSaml:   strconv.FormatBool(isSaml),
@@ -201,6 +205,9 @@ func (a *App) DoLogin(c *request.Context, w http.ResponseWriter, r *http.Request
 	session.AddProp(model.SessionPropPlatform, plat)
 	session.AddProp(model.SessionPropOs, os)
 	session.AddProp(model.SessionPropBrowser, fmt.Sprintf("%v/%v", bname, bversion))
+	session.AddProp(model.SessionPropDeviceType, getDeviceType(ua))
+	session.AddProp(model.SessionPropIpAddress, utils.GetIPAddress(r, a.Config().ServiceSettings.TrustedProxyIPHeader))
+	session.AddProp(model.SessionPropCountry, utils.GetCountryCode(r, a.Config().ServiceSettings.TrustedProxyIPHeader))
 	if user.IsGuest() {
 		session.AddProp(model.SessionPropIsGuest, "true")
 	} else {
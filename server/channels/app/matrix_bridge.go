@@ -0,0 +1,513 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/matrix"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// The Matrix bridge registers this server as an application service of a homeserver. Messages
+// posted in Mattermost are sent to the bridged room by a Matrix user impersonating the poster,
+// while events received from the homeserver are posted by the bridge bot with the name of the
+// Matrix sender.
+
+func (a *App) matrixBridgeEnabled() bool {
+	return *a.Config().MatrixBridgeSettings.Enable
+}
+
+func (a *App) matrixClient() *matrix.Client {
+	settings := a.Config().MatrixBridgeSettings
+	return matrix.NewClient(*settings.HomeserverURL, *settings.AppServiceToken, a.HTTPService().MakeClient(true))
+}
+
+// matrixPuppetID returns the id of the Matrix user posting on behalf of the Mattermost user.
+func (a *App) matrixPuppetID(user *model.User) string {
+	settings := a.Config().MatrixBridgeSettings
+	return matrix.UserID(*settings.UserPrefix+user.Username, *settings.HomeserverDomain)
+}
+
+// IsMatrixPuppet tells whether the Matrix user is in the namespace of the bridge, and so posts on
+// behalf of a Mattermost user.
+func (a *App) IsMatrixPuppet(userID string) bool {
+	settings := a.Config().MatrixBridgeSettings
+	localpart, ok := matrix.Localpart(userID, *settings.HomeserverDomain)
+	return ok && strings.HasPrefix(localpart, *settings.UserPrefix)
+}
+
+func (a *App) getMatrixBridgeBot() (*model.Bot, *model.AppError) {
+	sysAdminList, appErr := a.GetUsersFromProfiles(&model.UserGetOptions{
+		Page:     0,
+		PerPage:  1,
+		Role:     model.SystemAdminRoleId,
+		Inactive: false,
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if len(sysAdminList) == 0 {
+		return nil, model.NewAppError("getMatrixBridgeBot", "app.bot.get_system_bot.empty_admin_list.app_error", nil, "", http.StatusInternalServerError)
+	}
+
+	T := i18n.GetUserTranslations(sysAdminList[0].Locale)
+	return a.getOrCreateBot(&model.Bot{
+		Username:    model.MatrixBridgeBotUsername,
+		DisplayName: T("app.matrix_bridge.bot_displayname"),
+		OwnerId:     sysAdminList[0].Id,
+	})
+}
+
+// BridgeChannelToMatrix bridges the channel to the Matrix room. The application service must be able
+// to join the room, which usually means the room is public or its users have been invited.
+func (a *App) BridgeChannelToMatrix(c request.CTX, channelID, roomID, creatorID string) (*model.MatrixRoom, *model.AppError) {
+	if !a.matrixBridgeEnabled() {
+		return nil, model.NewAppError("BridgeChannelToMatrix", "app.matrix_bridge.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	channel, appErr := a.GetChannel(c, channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if channel.IsGroupOrDirect() || channel.DeleteAt != 0 {
+		return nil, model.NewAppError("BridgeChannelToMatrix", "app.matrix_bridge.channel.app_error", nil, "channel_id="+channelID, http.StatusBadRequest)
+	}
+
+	room, err := a.Srv().Store().MatrixBridge().SaveRoom(&model.MatrixRoom{
+		ChannelId: channelID,
+		RoomId:    roomID,
+		CreatorId: creatorID,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("BridgeChannelToMatrix", "app.matrix_bridge.save_room.exists.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		default:
+			return nil, model.NewAppError("BridgeChannelToMatrix", "app.matrix_bridge.save_room.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return room, nil
+}
+
+func (a *App) GetMatrixRoomForChannel(channelID string) (*model.MatrixRoom, *model.AppError) {
+	room, err := a.Srv().Store().MatrixBridge().GetRoomForChannel(channelID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetMatrixRoomForChannel", "app.matrix_bridge.get_room.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetMatrixRoomForChannel", "app.matrix_bridge.get_room.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return room, nil
+}
+
+// UnbridgeChannelFromMatrix stops bridging the channel. Messages already bridged are kept on both sides.
+func (a *App) UnbridgeChannelFromMatrix(channelID string) *model.AppError {
+	if err := a.Srv().Store().MatrixBridge().DeleteRoom(channelID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("UnbridgeChannelFromMatrix", "app.matrix_bridge.get_room.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("UnbridgeChannelFromMatrix", "app.matrix_bridge.delete_room.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// HandleMatrixTransaction processes the events pushed by the homeserver. The homeserver retries a
+// transaction until it succeeds, so events that were already bridged are skipped.
+func (a *App) HandleMatrixTransaction(c *request.Context, txn *matrix.Transaction) *model.AppError {
+	if !a.matrixBridgeEnabled() {
+		return model.NewAppError("HandleMatrixTransaction", "app.matrix_bridge.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	for _, event := range txn.Events {
+		// Events sent by the bridge come back in transactions.
+		if a.IsMatrixPuppet(event.Sender) {
+			continue
+		}
+
+		room, err := a.Srv().Store().MatrixBridge().GetRoom(event.RoomID)
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if errors.As(err, &nfErr) {
+				continue
+			}
+			return model.NewAppError("HandleMatrixTransaction", "app.matrix_bridge.get_room.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		var appErr *model.AppError
+		switch event.Type {
+		case matrix.EventTypeMessage:
+			appErr = a.handleMatrixMessage(c, room, event)
+		case matrix.EventTypeRedaction:
+			appErr = a.handleMatrixRedaction(c, event)
+		}
+		if appErr != nil {
+			// A single failing event shouldn't block the whole room, so it's only logged.
+			c.Logger().Warn("Failed to bridge Matrix event", mlog.String("event_id", event.EventID), mlog.String("room_id", event.RoomID), mlog.Err(appErr))
+		}
+	}
+
+	return nil
+}
+
+// getPostForMatrixEvent returns the post bridged from or to the event, or nil if there isn't any.
+func (a *App) getPostForMatrixEvent(eventID string) (*model.Post, *model.AppError) {
+	mapping, err := a.Srv().Store().MatrixBridge().GetEvent(eventID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, nil
+		}
+		return nil, model.NewAppError("getPostForMatrixEvent", "app.matrix_bridge.get_event.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	post, appErr := a.GetSinglePost(mapping.PostId, false)
+	if appErr != nil {
+		if appErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, appErr
+	}
+
+	return post, nil
+}
+
+func (a *App) handleMatrixMessage(c *request.Context, room *model.MatrixRoom, event *matrix.Event) *model.AppError {
+	content := event.Content
+	if content.RelatesTo != nil && content.RelatesTo.RelType == matrix.RelTypeReplace {
+		return a.handleMatrixEdit(c, event)
+	}
+
+	if existing, appErr := a.getPostForMatrixEvent(event.EventID); appErr != nil || existing != nil {
+		return appErr
+	}
+
+	channel, appErr := a.GetChannel(c, room.ChannelId)
+	if appErr != nil {
+		return appErr
+	}
+
+	bot, appErr := a.getMatrixBridgeBot()
+	if appErr != nil {
+		return appErr
+	}
+
+	post := &model.Post{
+		ChannelId: channel.Id,
+		UserId:    bot.UserId,
+		Message:   content.Body,
+	}
+	post.AddProp("override_username", event.Sender)
+	post.AddProp("from_webhook", "true")
+	post.AddProp(model.PostPropsFromMatrix, "true")
+
+	switch content.MsgType {
+	case matrix.MsgTypeEmote:
+		post.Message = "*" + content.Body + "*"
+	case matrix.MsgTypeImage, matrix.MsgTypeFile, matrix.MsgTypeVideo, matrix.MsgTypeAudio:
+		info, appErr := a.downloadMatrixMedia(c, channel.Id, content)
+		if appErr != nil {
+			return appErr
+		}
+		post.FileIds = []string{info.Id}
+		post.Message = ""
+	}
+
+	if content.RelatesTo != nil && content.RelatesTo.RelType == matrix.RelTypeThread {
+		root, appErr := a.getPostForMatrixEvent(content.RelatesTo.EventID)
+		if appErr != nil {
+			return appErr
+		}
+		if root != nil {
+			post.RootId = root.Id
+			if root.RootId != "" {
+				post.RootId = root.RootId
+			}
+		}
+	}
+
+	rpost, appErr := a.CreatePost(c, post, channel, true, false)
+	if appErr != nil {
+		return appErr
+	}
+
+	if _, err := a.Srv().Store().MatrixBridge().SaveEvent(&model.MatrixEvent{PostId: rpost.Id, EventId: event.EventID, RoomId: event.RoomID}); err != nil {
+		return model.NewAppError("handleMatrixMessage", "app.matrix_bridge.save_event.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+func (a *App) handleMatrixEdit(c *request.Context, event *matrix.Event) *model.AppError {
+	content := event.Content
+	if content.NewContent == nil {
+		return nil
+	}
+
+	post, appErr := a.getPostForMatrixEvent(content.RelatesTo.EventID)
+	if appErr != nil || post == nil {
+		return appErr
+	}
+
+	// Only the messages coming from Matrix can be edited from Matrix, as the messages of the
+	// Mattermost users can only be edited by their puppets.
+	if post.GetProp(model.PostPropsFromMatrix) != "true" || post.Message == content.NewContent.Body {
+		return nil
+	}
+
+	post = post.Clone()
+	post.Message = content.NewContent.Body
+	_, appErr = a.UpdatePost(c, post, false)
+	return appErr
+}
+
+func (a *App) handleMatrixRedaction(c *request.Context, event *matrix.Event) *model.AppError {
+	post, appErr := a.getPostForMatrixEvent(event.RedactedEventID())
+	if appErr != nil || post == nil {
+		return appErr
+	}
+
+	bot, appErr := a.getMatrixBridgeBot()
+	if appErr != nil {
+		return appErr
+	}
+
+	_, appErr = a.DeletePost(c, post.Id, bot.UserId)
+	return appErr
+}
+
+func (a *App) downloadMatrixMedia(c request.CTX, channelID string, content matrix.MessageContent) (*model.FileInfo, *model.AppError) {
+	body, _, err := a.matrixClient().Download(c.Context(), content.URL)
+	if err != nil {
+		return nil, model.NewAppError("downloadMatrixMedia", "app.matrix_bridge.download.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+	defer body.Close()
+
+	maxFileSize := *a.Config().FileSettings.MaxFileSize
+	data, err := io.ReadAll(io.LimitReader(body, maxFileSize+1))
+	if err != nil {
+		return nil, model.NewAppError("downloadMatrixMedia", "app.matrix_bridge.download.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+	if int64(len(data)) > maxFileSize {
+		return nil, model.NewAppError("downloadMatrixMedia", "api.file.upload_file.too_large_detailed.app_error", map[string]any{"Length": len(data), "Limit": maxFileSize, "Filename": content.Body}, "", http.StatusRequestEntityTooLarge)
+	}
+
+	return a.UploadFile(c, data, channelID, content.Body)
+}
+
+// getMatrixRoomForPost returns the room the post is bridged to, or nil if it isn't bridged.
+func (a *App) getMatrixRoomForPost(post *model.Post) (*model.MatrixRoom, error) {
+	if post.IsSystemMessage() || post.GetProp(model.PostPropsFromMatrix) == "true" {
+		return nil, nil
+	}
+
+	room, err := a.Srv().Store().MatrixBridge().GetRoomForChannel(post.ChannelId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return room, nil
+}
+
+// ensureMatrixPuppet registers the puppet of the user and joins it to the room, returning its id.
+func (a *App) ensureMatrixPuppet(ctx context.Context, client *matrix.Client, roomID, userID string) (string, error) {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return "", appErr
+	}
+
+	puppetID := a.matrixPuppetID(user)
+	key := roomID + "/" + puppetID
+	if _, ok := a.Srv().matrixJoinedPuppets.Load(key); ok {
+		return puppetID, nil
+	}
+
+	localpart, _ := matrix.Localpart(puppetID, *a.Config().MatrixBridgeSettings.HomeserverDomain)
+	if err := client.RegisterUser(ctx, localpart); err != nil {
+		return "", err
+	}
+	if err := client.SetDisplayName(ctx, puppetID, user.GetDisplayName(model.ShowNicknameFullName)); err != nil {
+		return "", err
+	}
+	if err := client.JoinRoom(ctx, roomID, puppetID); err != nil {
+		return "", err
+	}
+	a.Srv().matrixJoinedPuppets.Store(key, struct{}{})
+
+	return puppetID, nil
+}
+
+// sendPostToMatrix sends the message and attachments of the post to the Matrix room its channel is
+// bridged to, if any.
+func (a *App) sendPostToMatrix(c request.CTX, post *model.Post) {
+	room, err := a.getMatrixRoomForPost(post)
+	if err != nil || room == nil {
+		if err != nil {
+			c.Logger().Warn("Failed to get Matrix room", mlog.String("channel_id", post.ChannelId), mlog.Err(err))
+		}
+		return
+	}
+
+	ctx := context.Background()
+	client := a.matrixClient()
+	puppetID, err := a.ensureMatrixPuppet(ctx, client, room.RoomId, post.UserId)
+	if err != nil {
+		c.Logger().Warn("Failed to join Matrix room", mlog.String("room_id", room.RoomId), mlog.String("user_id", post.UserId), mlog.Err(err))
+		return
+	}
+
+	var relatesTo *matrix.RelatesTo
+	if post.RootId != "" {
+		if rootEvent, err := a.Srv().Store().MatrixBridge().GetEventForPost(post.RootId); err == nil {
+			relatesTo = &matrix.RelatesTo{RelType: matrix.RelTypeThread, EventID: rootEvent.EventId}
+		}
+	}
+
+	var contents []*matrix.MessageContent
+	infos, _, appErr := a.GetFileInfosForPost(post.Id, true, false)
+	if appErr != nil {
+		c.Logger().Warn("Failed to get files of post bridged to Matrix", mlog.String("post_id", post.Id), mlog.Err(appErr))
+	}
+	for _, info := range infos {
+		content, err := a.uploadFileToMatrix(ctx, client, puppetID, info)
+		if err != nil {
+			c.Logger().Warn("Failed to upload file to Matrix", mlog.String("file_id", info.Id), mlog.Err(err))
+			continue
+		}
+		contents = append(contents, content)
+	}
+	if post.Message != "" {
+		contents = append(contents, &matrix.MessageContent{MsgType: matrix.MsgTypeText, Body: post.Message})
+	}
+
+	for i, content := range contents {
+		content.RelatesTo = relatesTo
+		eventID, err := client.SendMessage(ctx, room.RoomId, puppetID, post.Id+"-"+strconv.Itoa(i), content)
+		if err != nil {
+			c.Logger().Warn("Failed to send post to Matrix", mlog.String("post_id", post.Id), mlog.String("room_id", room.RoomId), mlog.Err(err))
+			return
+		}
+
+		// The post is mapped to its first event, which edits and deletions apply to.
+		if i == 0 {
+			if _, err := a.Srv().Store().MatrixBridge().SaveEvent(&model.MatrixEvent{PostId: post.Id, EventId: eventID, RoomId: room.RoomId}); err != nil {
+				c.Logger().Warn("Failed to save Matrix event", mlog.String("post_id", post.Id), mlog.Err(err))
+			}
+		}
+	}
+}
+
+func (a *App) uploadFileToMatrix(ctx context.Context, client *matrix.Client, puppetID string, info *model.FileInfo) (*matrix.MessageContent, error) {
+	data, appErr := a.readFileInfo(info)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	uri, err := client.Upload(ctx, puppetID, info.Name, info.MimeType, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	msgType := matrix.MsgTypeFile
+	switch {
+	case info.IsImage():
+		msgType = matrix.MsgTypeImage
+	case strings.HasPrefix(info.MimeType, "video/"):
+		msgType = matrix.MsgTypeVideo
+	case strings.HasPrefix(info.MimeType, "audio/"):
+		msgType = matrix.MsgTypeAudio
+	}
+
+	return &matrix.MessageContent{
+		MsgType: msgType,
+		Body:    info.Name,
+		URL:     uri,
+		Info:    &matrix.FileInfo{MimeType: info.MimeType, Size: info.Size},
+	}, nil
+}
+
+// sendPostEditToMatrix replaces the Matrix event the post was bridged to with its new message.
+func (a *App) sendPostEditToMatrix(c request.CTX, post *model.Post) {
+	room, err := a.getMatrixRoomForPost(post)
+	if err != nil || room == nil {
+		return
+	}
+
+	mapping, err := a.Srv().Store().MatrixBridge().GetEventForPost(post.Id)
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+	client := a.matrixClient()
+	puppetID, err := a.ensureMatrixPuppet(ctx, client, room.RoomId, post.UserId)
+	if err != nil {
+		c.Logger().Warn("Failed to join Matrix room", mlog.String("room_id", room.RoomId), mlog.String("user_id", post.UserId), mlog.Err(err))
+		return
+	}
+
+	content := &matrix.MessageContent{
+		MsgType:    matrix.MsgTypeText,
+		Body:       "* " + post.Message,
+		NewContent: &matrix.MessageContent{MsgType: matrix.MsgTypeText, Body: post.Message},
+		RelatesTo:  &matrix.RelatesTo{RelType: matrix.RelTypeReplace, EventID: mapping.EventId},
+	}
+	if _, err := client.SendMessage(ctx, mapping.RoomId, puppetID, post.Id+"-edit-"+strconv.FormatInt(post.EditAt, 10), content); err != nil {
+		c.Logger().Warn("Failed to send post edit to Matrix", mlog.String("post_id", post.Id), mlog.Err(err))
+	}
+}
+
+// sendPostDeletionToMatrix redacts the Matrix event the post was bridged to.
+func (a *App) sendPostDeletionToMatrix(c request.CTX, post *model.Post) {
+	room, err := a.getMatrixRoomForPost(post)
+	if err != nil || room == nil {
+		return
+	}
+
+	mapping, err := a.Srv().Store().MatrixBridge().GetEventForPost(post.Id)
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+	client := a.matrixClient()
+	puppetID, err := a.ensureMatrixPuppet(ctx, client, room.RoomId, post.UserId)
+	if err != nil {
+		c.Logger().Warn("Failed to join Matrix room", mlog.String("room_id", room.RoomId), mlog.String("user_id", post.UserId), mlog.Err(err))
+		return
+	}
+
+	if err := client.Redact(ctx, mapping.RoomId, mapping.EventId, puppetID, post.Id+"-delete"); err != nil {
+		c.Logger().Warn("Failed to send post deletion to Matrix", mlog.String("post_id", post.Id), mlog.Err(err))
+	}
+}
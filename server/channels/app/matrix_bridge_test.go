@@ -0,0 +1,108 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/matrix"
+)
+
+// makeTestHomeserver returns a homeserver recording the messages sent to it by the bridge.
+func makeTestHomeserver(t *testing.T) (*httptest.Server, func() []matrix.MessageContent) {
+	var mut sync.Mutex
+	var messages []matrix.MessageContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/send/") {
+			var content matrix.MessageContent
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&content))
+			mut.Lock()
+			messages = append(messages, content)
+			mut.Unlock()
+		}
+		w.Write([]byte(`{"event_id":"$` + model.NewId() + `"}`))
+	}))
+
+	return server, func() []matrix.MessageContent {
+		mut.Lock()
+		defer mut.Unlock()
+		return append([]matrix.MessageContent{}, messages...)
+	}
+}
+
+func TestMatrixBridgeOutbound(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	homeserver, getMessages := makeTestHomeserver(t)
+	defer homeserver.Close()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.MatrixBridgeSettings.Enable = true
+		*cfg.MatrixBridgeSettings.HomeserverURL = homeserver.URL
+		*cfg.MatrixBridgeSettings.HomeserverDomain = "example.com"
+		*cfg.MatrixBridgeSettings.AppServiceToken = "as_token"
+		*cfg.MatrixBridgeSettings.HomeserverToken = "hs_token"
+	})
+
+	roomID := "!" + model.NewId() + ":example.com"
+	_, appErr := th.App.BridgeChannelToMatrix(th.Context, th.BasicChannel.Id, roomID, th.SystemAdminUser.Id)
+	require.Nil(t, appErr)
+
+	post := &model.Post{ChannelId: th.BasicChannel.Id, UserId: th.BasicUser.Id, Message: "hello from mattermost"}
+	th.App.sendPostToMatrix(th.Context, post)
+	messages := getMessages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, matrix.MsgTypeText, messages[0].MsgType)
+	assert.Equal(t, "hello from mattermost", messages[0].Body)
+
+	mapping, err := th.App.Srv().Store().MatrixBridge().GetEventForPost(post.Id)
+	require.NoError(t, err)
+	assert.Equal(t, roomID, mapping.RoomId)
+
+	post.Message = "edited"
+	post.EditAt = model.GetMillis()
+	th.App.sendPostEditToMatrix(th.Context, post)
+	messages = getMessages()
+	require.Len(t, messages, 2)
+	require.NotNil(t, messages[1].NewContent)
+	assert.Equal(t, "edited", messages[1].NewContent.Body)
+	assert.Equal(t, matrix.RelTypeReplace, messages[1].RelatesTo.RelType)
+	assert.Equal(t, mapping.EventId, messages[1].RelatesTo.EventID)
+
+	t.Run("posts from Matrix aren't sent back", func(t *testing.T) {
+		fromMatrix := &model.Post{ChannelId: th.BasicChannel.Id, UserId: th.BasicUser.Id, Message: "echo"}
+		fromMatrix.AddProp(model.PostPropsFromMatrix, "true")
+		th.App.sendPostToMatrix(th.Context, fromMatrix)
+		assert.Len(t, getMessages(), 2)
+	})
+
+	t.Run("channels that aren't bridged", func(t *testing.T) {
+		other := th.CreateChannel(th.Context, th.BasicTeam)
+		th.App.sendPostToMatrix(th.Context, &model.Post{ChannelId: other.Id, UserId: th.BasicUser.Id, Message: "elsewhere"})
+		assert.Len(t, getMessages(), 2)
+	})
+}
+
+func TestIsMatrixPuppet(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.MatrixBridgeSettings.HomeserverDomain = "example.com"
+	})
+
+	assert.True(t, th.App.IsMatrixPuppet("@mattermost_alice:example.com"))
+	assert.False(t, th.App.IsMatrixPuppet("@alice:example.com"))
+	assert.False(t, th.App.IsMatrixPuppet("@mattermost_alice:matrix.org"))
+}
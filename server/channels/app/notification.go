@@ -95,12 +95,36 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 	}
 	profileMap := result.Data.(map[string]*model.User)
 
+	// the members who can't see a restricted post aren't notified of it
+	omitUsers, appErr := a.restrictedPostOmitUsers(post, profileMap)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(omitUsers) > 0 {
+		visibleProfileMap := make(map[string]*model.User, len(profileMap)-len(omitUsers))
+		for userID, profile := range profileMap {
+			if !omitUsers[userID] {
+				visibleProfileMap[userID] = profile
+			}
+		}
+		profileMap = visibleProfileMap
+	}
+
 	result = <-cmnchan
 	if result.NErr != nil {
 		return nil, result.NErr
 	}
 	channelMemberNotifyPropsMap := result.Data.(map[string]model.StringMap)
 
+	// the members who haven't chosen their own notification settings get the channel defaults
+	if len(channel.DefaultNotifyProps) > 0 {
+		withDefaults := make(map[string]model.StringMap, len(channelMemberNotifyPropsMap))
+		for userID, props := range channelMemberNotifyPropsMap {
+			withDefaults[userID] = model.ApplyChannelNotifyDefaults(props, channel.DefaultNotifyProps)
+		}
+		channelMemberNotifyPropsMap = withDefaults
+	}
+
 	followers := make(model.StringArray, 0)
 	if tchan != nil {
 		result = <-tchan
@@ -185,6 +209,8 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 			}
 		}
 
+		a.addNotificationRuleMentions(post, profileMap, mentions)
+
 		// prevent the user from mentioning themselves
 		if post.GetProp("from_webhook") != "true" {
 			mentions.removeMention(post.UserId)
@@ -328,6 +354,13 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 		}
 	}
 
+	if *a.Config().ServiceSettings.EnableActivityFeed {
+		activityFollowers := append(model.StringArray{}, followers...)
+		a.Srv().Go(func() {
+			a.addPostActivities(post, channel, mentions, activityFollowers)
+		})
+	}
+
 	notificationsForCRT := &CRTNotifiers{}
 	if isCRTAllowed && post.RootId != "" {
 		for _, uid := range followers {
@@ -541,6 +574,7 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 	message.Add("sender_name", notification.GetSenderName(model.ShowUsername, *a.Config().ServiceSettings.EnablePostUsernameOverride))
 	message.Add("team_id", team.Id)
 	message.Add("set_online", setOnline)
+	addOmitUsers(message, omitUsers)
 
 	if len(post.FileIds) != 0 && fchan != nil {
 		message.Add("otherFile", "true")
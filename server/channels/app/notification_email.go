@@ -23,6 +23,10 @@ import (
 )
 
 func (a *App) sendNotificationEmail(c request.CTX, notification *PostNotification, user *model.User, team *model.Team, senderProfileImage []byte) error {
+	if a.holdForQuietHours(user, false) {
+		return nil
+	}
+
 	channel := notification.Channel
 	post := notification.Post
 
@@ -60,6 +64,11 @@ func (a *App) sendNotificationEmail(c request.CTX, notification *PostNotificatio
 			sendBatched = data.Value != model.PreferenceEmailIntervalNoBatchingSeconds
 		}
 
+		// users with a digest schedule always get their notifications batched
+		if _, err := a.Srv().Store().Preference().Get(user.Id, model.PreferenceCategoryNotifications, model.PreferenceNameEmailDigestSchedule); err == nil {
+			sendBatched = true
+		}
+
 		if sendBatched {
 			if err := a.Srv().EmailService.AddNotificationEmailToBatch(user, post, team); err == nil {
 				return nil
@@ -13,6 +13,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
@@ -138,6 +139,10 @@ func (a *App) sendPushNotificationToAllSessions(msg *model.PushNotification, use
 }
 
 func (a *App) sendPushNotification(notification *PostNotification, user *model.User, explicitMention, channelWideMention bool, replyToThreadType string) {
+	if a.holdForQuietHours(user, true) {
+		return
+	}
+
 	cfg := a.Config()
 	channel := notification.Channel
 	post := notification.Post
@@ -428,22 +433,51 @@ func (a *App) sendToPushProxy(msg *model.PushNotification, session *model.Sessio
 		mlog.String("status", model.PushSendPrepare),
 	)
 
+	start := time.Now()
 	pushResponse, err := a.rawSendToPushProxy(msg)
+	elapsed := time.Since(start)
+
+	receipt := &model.PushNotificationReceipt{
+		AckId:     msg.AckId,
+		Type:      msg.Type,
+		Platform:  msg.Platform,
+		PostId:    msg.PostId,
+		ChannelId: msg.ChannelId,
+		Status:    model.PushNotificationReceiptStatusSent,
+		SentAt:    model.GetMillisForTime(start),
+		Duration:  elapsed.Milliseconds(),
+	}
+	defer a.recordPushNotificationReceipt(session.UserId, receipt, elapsed)
+
 	if err != nil {
+		receipt.Status = model.PushNotificationReceiptStatusFailed
+		receipt.Error = err.Error()
 		return err
 	}
 
 	switch pushResponse[model.PushStatus] {
 	case model.PushStatusRemove:
+		receipt.Status = model.PushNotificationReceiptStatusRemoved
 		a.AttachDeviceId(session.Id, "", session.ExpiresAt)
 		a.ClearSessionCacheForUser(session.UserId)
 		return errors.New("device was reported as removed")
 	case model.PushStatusFail:
+		receipt.Status = model.PushNotificationReceiptStatusFailed
+		receipt.Error = pushResponse[model.PushStatusErrorMsg]
 		return errors.New(pushResponse[model.PushStatusErrorMsg])
 	}
 	return nil
 }
 
+func (a *App) recordPushNotificationReceipt(userID string, receipt *model.PushNotificationReceipt, elapsed time.Duration) {
+	a.Srv().pushNotificationReceipts.add(userID, receipt)
+
+	if a.Metrics() != nil {
+		a.Metrics().IncrementPushNotificationDeliveryCounter(receipt.Platform, receipt.Status)
+		a.Metrics().ObservePushNotificationDeliveryDuration(receipt.Platform, elapsed.Seconds())
+	}
+}
+
 func (a *App) SendAckToPushProxy(ack *model.PushNotificationAck) error {
 	if ack == nil {
 		return nil
@@ -708,17 +742,72 @@ func (a *App) buildFullPushNotificationMessage(c request.CTX, contentsConfig str
 
 	hasFiles := post.FileIds != nil && len(post.FileIds) > 0
 
-	msg.Message = a.getPushNotificationMessage(
-		contentsConfig,
-		postMessage,
-		explicitMention,
-		channelWideMention,
-		hasFiles,
-		msg.SenderName,
-		channel.Type,
-		replyToThreadType,
-		userLocale,
-	)
+	if post.IsEncrypted() {
+		msg.Message = getEncryptedPushNotificationMessage(msg.SenderName, channel.Type, userLocale)
+	} else {
+		msg.Message = a.getPushNotificationMessage(
+			contentsConfig,
+			postMessage,
+			explicitMention,
+			channelWideMention,
+			hasFiles,
+			msg.SenderName,
+			channel.Type,
+			replyToThreadType,
+			userLocale,
+		)
+	}
+
+	category := getPushNotificationCategory(post, channel, explicitMention, channelWideMention, replyToThreadType)
+	if tmpl := cfg.EmailSettings.PushNotificationTemplates[category]; tmpl != nil {
+		data := &model.PushNotificationTemplateData{
+			Category:    category,
+			SenderName:  msg.SenderName,
+			ChannelName: msg.ChannelName,
+			ChannelType: channel.Type,
+			Title:       msg.ChannelName,
+			Body:        msg.Message,
+		}
+		if contentsConfig == model.FullNotification {
+			data.Message = model.ClearMentionTags(postMessage)
+		}
+		applyPushNotificationTemplate(msg, tmpl, data, userLocale)
+	}
 
 	return msg
 }
+
+// getPushNotificationCategory returns the category of a push notification, used to pick the
+// template customizing it. It returns an empty string if the notification has no category.
+func getPushNotificationCategory(post *model.Post, channel *model.Channel, explicitMention, channelWideMention bool, replyToThreadType string) string {
+	switch {
+	case post.Type == model.PostTypeReminder:
+		return model.PushNotificationCategoryReminder
+	case channel.Type == model.ChannelTypeDirect:
+		return model.PushNotificationCategoryDirectMessage
+	case explicitMention || channelWideMention:
+		return model.PushNotificationCategoryMention
+	case replyToThreadType != "":
+		return model.PushNotificationCategoryThreadReply
+	}
+	return ""
+}
+
+// applyPushNotificationTemplate replaces the contents of the notification with the rendered
+// template. The notification is left untouched if the template fails to render.
+func applyPushNotificationTemplate(msg *model.PushNotification, tmpl *model.PushNotificationTemplate, data *model.PushNotificationTemplateData, userLocale i18n.TranslateFunc) {
+	title, body, fields, err := tmpl.Render(data, userLocale)
+	if err != nil {
+		mlog.Warn("Failed to render the push notification template", mlog.String("category", data.Category), mlog.Err(err))
+		return
+	}
+
+	// The mobile apps show the channel name as the title of the notification.
+	if title != "" {
+		msg.ChannelName = title
+	}
+	if body != "" {
+		msg.Message = body
+	}
+	msg.Fields = fields
+}
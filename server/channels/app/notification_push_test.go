@@ -990,6 +990,44 @@ func TestBuildPushNotificationMessageMentions(t *testing.T) {
 	}
 }
 
+func TestBuildPushNotificationMessageTemplates(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	post := th.CreateMessagePost(th.BasicChannel, "hello @"+th.BasicUser2.Username)
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.EmailSettings.PushNotificationTemplates = map[string]*model.PushNotificationTemplate{
+			model.PushNotificationCategoryMention: {
+				Title:  "[{{.ChannelName}}]",
+				Body:   `{{.SenderName}}{{t "api.post.send_notifications_and_forget.push_explicit_mention"}} {{.Message}}`,
+				Fields: map[string]string{"category": "{{.Category}}"},
+			},
+		}
+	})
+
+	t.Run("template of the category is applied", func(t *testing.T) {
+		msg, err := th.App.BuildPushNotificationMessage(th.Context, model.FullNotification, post, th.BasicUser2, th.BasicChannel, th.BasicChannel.Name, th.BasicUser.Username, true, false, "")
+		require.Nil(t, err)
+		assert.Equal(t, "["+th.BasicChannel.Name+"]", msg.ChannelName)
+		assert.Equal(t, th.BasicUser.Username+" mentioned you. hello @"+th.BasicUser2.Username, msg.Message)
+		assert.Equal(t, map[string]string{"category": model.PushNotificationCategoryMention}, msg.Fields)
+	})
+
+	t.Run("message is not exposed to templates with generic contents", func(t *testing.T) {
+		msg, err := th.App.BuildPushNotificationMessage(th.Context, model.GenericNotification, post, th.BasicUser2, th.BasicChannel, th.BasicChannel.Name, th.BasicUser.Username, true, false, "")
+		require.Nil(t, err)
+		assert.Equal(t, th.BasicUser.Username+" mentioned you.", msg.Message)
+	})
+
+	t.Run("other categories keep the default contents", func(t *testing.T) {
+		msg, err := th.App.BuildPushNotificationMessage(th.Context, model.FullNotification, post, th.BasicUser2, th.BasicChannel, th.BasicChannel.Name, th.BasicUser.Username, false, false, model.CommentsNotifyAny)
+		require.Nil(t, err)
+		assert.Equal(t, th.BasicChannel.Name, msg.ChannelName)
+		assert.Nil(t, msg.Fields)
+	})
+}
+
 func TestSendPushNotifications(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -0,0 +1,81 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// GetNotificationRules returns the notification rules of the user.
+func (a *App) GetNotificationRules(userID string) (model.NotificationRules, *model.AppError) {
+	preference, err := a.Srv().Store().Preference().Get(userID, model.PreferenceCategoryNotifications, model.PreferenceNameNotificationRules)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.NotificationRules{}, nil
+	} else if err != nil {
+		return nil, model.NewAppError("GetNotificationRules", "app.preference.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	rules, err := model.NotificationRulesFromJSON(preference.Value)
+	if err != nil {
+		return nil, model.NewAppError("GetNotificationRules", "app.notification_rules.invalid.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return rules, nil
+}
+
+// UpdateNotificationRules replaces the notification rules of the user.
+func (a *App) UpdateNotificationRules(userID string, rules model.NotificationRules) *model.AppError {
+	if rules == nil {
+		rules = model.NotificationRules{}
+	}
+	if err := rules.IsValid(); err != nil {
+		return model.NewAppError("UpdateNotificationRules", "app.notification_rules.invalid.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	value, err := json.Marshal(rules)
+	if err != nil {
+		return model.NewAppError("UpdateNotificationRules", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return a.UpdatePreferences(userID, model.Preferences{{
+		UserId:   userID,
+		Category: model.PreferenceCategoryNotifications,
+		Name:     model.PreferenceNameNotificationRules,
+		Value:    string(value),
+	}})
+}
+
+// addNotificationRuleMentions mentions the users of profileMap whose notification rules match the post.
+func (a *App) addNotificationRuleMentions(post *model.Post, profileMap map[string]*model.User, mentions *ExplicitMentions) {
+	if post.IsSystemMessage() {
+		return
+	}
+
+	preferences, err := a.Srv().Store().Preference().GetCategoryAndName(model.PreferenceCategoryNotifications, model.PreferenceNameNotificationRules)
+	if err != nil {
+		mlog.Warn("Failed to get the notification rules", mlog.String("post_id", post.Id), mlog.Err(err))
+		return
+	}
+
+	for _, preference := range preferences {
+		if _, ok := profileMap[preference.UserId]; !ok {
+			continue
+		}
+
+		rules, err := model.NotificationRulesFromJSON(preference.Value)
+		if err != nil {
+			mlog.Debug("Ignoring invalid notification rules", mlog.String("user_id", preference.UserId), mlog.Err(err))
+			continue
+		}
+
+		if rules.Matches(post) {
+			mentions.addMention(preference.UserId, KeywordMention)
+		}
+	}
+}
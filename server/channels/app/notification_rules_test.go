@@ -0,0 +1,63 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestNotificationRules(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	rules, appErr := th.App.GetNotificationRules(th.BasicUser2.Id)
+	require.Nil(t, appErr)
+	assert.Empty(t, rules)
+
+	appErr = th.App.UpdateNotificationRules(th.BasicUser2.Id, model.NotificationRules{{Type: "unknown"}})
+	require.NotNil(t, appErr)
+	assert.Equal(t, "app.notification_rules.invalid.app_error", appErr.Id)
+
+	rules = model.NotificationRules{
+		{Type: model.NotificationRuleTypeRegex, Pattern: `build \d+ failed`},
+		{Type: model.NotificationRuleTypeSender, SenderIds: model.StringArray{th.SystemAdminUser.Id}},
+	}
+	require.Nil(t, th.App.UpdateNotificationRules(th.BasicUser2.Id, rules))
+
+	saved, appErr := th.App.GetNotificationRules(th.BasicUser2.Id)
+	require.Nil(t, appErr)
+	require.Len(t, saved, 2)
+	assert.Equal(t, rules[0].Pattern, saved[0].Pattern)
+
+	t.Run("matching posts mention the user", func(t *testing.T) {
+		post, appErr := th.App.CreatePost(th.Context, &model.Post{
+			UserId:    th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			Message:   "build 1234 failed",
+		}, th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		mentions, err := th.App.SendNotifications(th.Context, post, th.BasicTeam, th.BasicChannel, th.BasicUser, nil, true)
+		require.NoError(t, err)
+		assert.Contains(t, mentions, th.BasicUser2.Id)
+	})
+
+	t.Run("other posts don't", func(t *testing.T) {
+		post, appErr := th.App.CreatePost(th.Context, &model.Post{
+			UserId:    th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			Message:   "build 1234 passed",
+		}, th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		mentions, err := th.App.SendNotifications(th.Context, post, th.BasicTeam, th.BasicChannel, th.BasicUser, nil, true)
+		require.NoError(t, err)
+		assert.NotContains(t, mentions, th.BasicUser2.Id)
+	})
+}
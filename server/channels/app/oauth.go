@@ -163,7 +163,17 @@ func (a *App) GetOAuthImplicitRedirect(userID string, authRequest *model.Authori
 }
 
 func (a *App) GetOAuthCodeRedirect(userID string, authRequest *model.AuthorizeRequest) (string, *model.AppError) {
-	authData := &model.AuthData{UserId: userID, ClientId: authRequest.ClientId, CreateAt: model.GetMillis(), RedirectUri: authRequest.RedirectURI, State: authRequest.State, Scope: authRequest.Scope}
+	authData := &model.AuthData{
+		UserId:              userID,
+		ClientId:            authRequest.ClientId,
+		CreateAt:            model.GetMillis(),
+		RedirectUri:         authRequest.RedirectURI,
+		State:               authRequest.State,
+		Scope:               authRequest.Scope,
+		CodeChallenge:       authRequest.CodeChallenge,
+		CodeChallengeMethod: authRequest.CodeChallengeMethod,
+		Nonce:               authRequest.Nonce,
+	}
 	authData.Code = model.NewId() + model.NewId()
 
 	// parse authRequest.RedirectURI to handle query parameters see: https://mattermost.atlassian.net/browse/MM-46216
@@ -208,12 +218,22 @@ func (a *App) AllowOAuthAppAccessToUser(userID string, authRequest *model.Author
 		return "", model.NewAppError("AllowOAuthAppAccessToUser", "api.oauth.allow_oauth.redirect_callback.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	// OAuth 2.1 drops the implicit grant and requires the authorization code grant to use PKCE
+	// with the S256 method.
+	oauth21 := *a.Config().ServiceSettings.EnableOAuthServiceProviderOAuth21
+
 	var redirectURI string
 	var err *model.AppError
 	switch authRequest.ResponseType {
 	case model.AuthCodeResponseType:
+		if oauth21 && (authRequest.CodeChallenge == "" || authRequest.CodeChallengeMethod != model.CodeChallengeMethodS256) {
+			return authRequest.RedirectURI + "?error=invalid_request&state=" + authRequest.State, nil
+		}
 		redirectURI, err = a.GetOAuthCodeRedirect(userID, authRequest)
 	case model.ImplicitResponseType:
+		if oauth21 {
+			return authRequest.RedirectURI + "?error=unsupported_response_type&state=" + authRequest.State, nil
+		}
 		redirectURI, err = a.GetOAuthImplicitRedirect(userID, authRequest)
 	default:
 		return authRequest.RedirectURI + "?error=unsupported_response_type&state=" + authRequest.State, nil
@@ -269,7 +289,7 @@ func (a *App) GetOAuthAccessTokenForImplicitFlow(userID string, authRequest *mod
 	return session, nil
 }
 
-func (a *App) GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, code, secret, refreshToken string) (*model.AccessResponse, *model.AppError) {
+func (a *App) GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, code, secret, refreshToken, codeVerifier string) (*model.AccessResponse, *model.AppError) {
 	if !*a.Config().ServiceSettings.EnableOAuthServiceProvider {
 		return nil, model.NewAppError("GetOAuthAccessToken", "api.oauth.get_access_token.disabled.app_error", nil, "", http.StatusNotImplemented)
 	}
@@ -300,10 +320,18 @@ func (a *App) GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, c
 			return nil, model.NewAppError("GetOAuthAccessToken", "api.oauth.get_access_token.expired_code.app_error", nil, "", http.StatusForbidden)
 		}
 
+		if authData.ClientId != clientId {
+			return nil, model.NewAppError("GetOAuthAccessToken", "api.oauth.get_access_token.client_id.app_error", nil, "", http.StatusBadRequest)
+		}
+
 		if authData.RedirectUri != redirectURI {
 			return nil, model.NewAppError("GetOAuthAccessToken", "api.oauth.get_access_token.redirect_uri.app_error", nil, "", http.StatusBadRequest)
 		}
 
+		if !authData.VerifyCodeVerifier(codeVerifier) {
+			return nil, model.NewAppError("GetOAuthAccessToken", "api.oauth.get_access_token.code_verifier.app_error", nil, "", http.StatusBadRequest)
+		}
+
 		user, nErr = a.Srv().Store().User().Get(context.Background(), authData.UserId)
 		if nErr != nil {
 			return nil, model.NewAppError("GetOAuthAccessToken", "api.oauth.get_access_token.internal_user.app_error", nil, "", http.StatusNotFound)
@@ -356,10 +384,23 @@ func (a *App) GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, c
 		if nErr = a.Srv().Store().OAuth().RemoveAuthData(authData.Code); nErr != nil {
 			mlog.Warn("unable to remove auth data", mlog.Err(nErr))
 		}
+
+		if model.ScopeContains(authData.Scope, model.ScopeOpenID) {
+			idToken, err := a.newIDToken(user, clientId, authData.Scope, authData.Nonce)
+			if err != nil {
+				return nil, err
+			}
+			accessRsp.IdToken = idToken
+		}
 	} else {
 		// When grantType is refresh_token
 		accessData, nErr = a.Srv().Store().OAuth().GetAccessDataByRefreshToken(refreshToken)
 		if nErr != nil {
+			a.revokeOAuthGrantOnRefreshTokenReuse(clientId, refreshToken)
+			return nil, model.NewAppError("GetOAuthAccessToken", "api.oauth.get_access_token.refresh_token.app_error", nil, "", http.StatusNotFound)
+		}
+
+		if accessData.ClientId != clientId {
 			return nil, model.NewAppError("GetOAuthAccessToken", "api.oauth.get_access_token.refresh_token.app_error", nil, "", http.StatusNotFound)
 		}
 
@@ -373,11 +414,34 @@ func (a *App) GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, c
 			return nil, err
 		}
 		accessRsp = access
+
+		if model.ScopeContains(accessData.Scope, model.ScopeOpenID) {
+			idToken, err := a.newIDToken(user, clientId, accessData.Scope, "")
+			if err != nil {
+				return nil, err
+			}
+			accessRsp.IdToken = idToken
+		}
 	}
 
 	return accessRsp, nil
 }
 
+// revokeOAuthGrantOnRefreshTokenReuse revokes the tokens of a user for an app when one of their
+// rotated refresh tokens is presented again. Either the legitimate client or an attacker holds a
+// stale copy of it, and there's no telling them apart, so neither gets to keep access.
+func (a *App) revokeOAuthGrantOnRefreshTokenReuse(clientID, refreshToken string) {
+	rotated, err := a.Srv().Store().OAuth().GetRotatedRefreshToken(refreshToken)
+	if err != nil || rotated.ClientId != clientID {
+		return
+	}
+
+	mlog.Warn("OAuth refresh token reused, revoking the access of the app", mlog.String("user_id", rotated.UserId), mlog.String("client_id", clientID))
+	if appErr := a.DeauthorizeOAuthAppForUser(rotated.UserId, clientID); appErr != nil {
+		mlog.Error("Failed to revoke the access of the app", mlog.String("user_id", rotated.UserId), mlog.String("client_id", clientID), mlog.Err(appErr))
+	}
+}
+
 func (a *App) newSession(app *model.OAuthApp, user *model.User) (*model.Session, *model.AppError) {
 	// Set new token an session
 	session := &model.Session{UserId: user.Id, Roles: user.Roles, IsOAuth: true}
@@ -410,6 +474,19 @@ func (a *App) newSessionUpdateToken(app *model.OAuthApp, accessData *model.Acces
 		return nil, err
 	}
 
+	// Refresh tokens are single use, the rotated one is kept to detect it being used again
+	if accessData.RefreshToken != "" {
+		rotated := &model.OAuthRotatedRefreshToken{
+			RefreshToken: accessData.RefreshToken,
+			ClientId:     accessData.ClientId,
+			UserId:       accessData.UserId,
+			RotatedAt:    model.GetMillis(),
+		}
+		if err := a.Srv().Store().OAuth().SaveRotatedRefreshToken(rotated); err != nil {
+			return nil, model.NewAppError("newSessionUpdateToken", "app.oauth.save_rotated_refresh_token.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
 	accessData.Token = session.Token
 	accessData.RefreshToken = model.NewId()
 	accessData.ExpiresAt = session.ExpiresAt
@@ -502,6 +579,10 @@ func (a *App) DeauthorizeOAuthAppForUser(userID, appID string) *model.AppError {
 		}
 	}
 
+	if err := a.Srv().Store().OAuth().RemoveRotatedRefreshTokens(userID, appID); err != nil {
+		return model.NewAppError("DeauthorizeOAuthAppForUser", "app.oauth.remove_rotated_refresh_tokens.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
 	if err := a.Srv().Store().OAuth().RemoveAuthDataByClientId(appID, userID); err != nil {
 		return model.NewAppError("DeauthorizeOAuthAppForUser", "app.oauth.remove_auth_data_by_client_id.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -576,7 +657,8 @@ func (a *App) getSSOProvider(service string) (einterfaces.OAuthProvider, *model.
 		return nil, model.NewAppError("getSSOProvider", "api.user.authorize_oauth_user.unsupported.app_error", nil, "service="+service, http.StatusNotImplemented)
 	}
 	providerType := service
-	if strings.Contains(*sso.Scope, OpenIDScope) {
+	// The generic OpenID Connect provider has its own implementation
+	if strings.Contains(*sso.Scope, OpenIDScope) && service != model.ServiceOIDC {
 		providerType = model.ServiceOpenid
 	}
 	provider := einterfaces.GetOAuthProvider(providerType)
@@ -637,9 +719,80 @@ func (a *App) LoginByOAuth(c *request.Context, service string, userData io.Reade
 		return nil, err
 	}
 
+	if groupsProvider, ok := provider.(einterfaces.OAuthGroupsProvider); ok {
+		a.syncOAuthUserGroups(c, groupsProvider, user, buf.Bytes())
+	}
+
 	return user, nil
 }
 
+// syncOAuthUserGroups synchronizes the memberships of the user in the groups of the OAuth service
+// with the groups listed in the user data. The groups are created as they are first seen, and a
+// failure is only logged not to prevent the user from signing in.
+func (a *App) syncOAuthUserGroups(c *request.Context, provider einterfaces.OAuthGroupsProvider, user *model.User, userData []byte) {
+	names, err := provider.GetGroupsFromJSON(bytes.NewReader(userData))
+	if err != nil {
+		c.Logger().Warn("Failed to read the groups of the OAuth user", mlog.String("user_id", user.Id), mlog.Err(err))
+		return
+	}
+	if names == nil {
+		return
+	}
+
+	current, appErr := a.GetGroupsByUserId(user.Id)
+	if appErr != nil {
+		c.Logger().Warn("Failed to get the groups of the OAuth user", mlog.String("user_id", user.Id), mlog.Err(appErr))
+		return
+	}
+
+	toRemove := map[string]bool{}
+	for _, group := range current {
+		if group.Source == model.GroupSourceOIDC {
+			toRemove[group.Id] = true
+		}
+	}
+
+	for _, name := range names {
+		group, appErr := a.GetGroupByRemoteID(name, model.GroupSourceOIDC)
+		if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+			c.Logger().Warn("Failed to get the OAuth group", mlog.String("remote_id", name), mlog.Err(appErr))
+			continue
+		}
+
+		if group == nil {
+			group = &model.Group{
+				DisplayName: name,
+				Source:      model.GroupSourceOIDC,
+				RemoteId:    model.NewString(name),
+			}
+			if appErr = group.IsValidForCreate(); appErr != nil {
+				c.Logger().Warn("Skipping invalid OAuth group", mlog.String("remote_id", name), mlog.Err(appErr))
+				continue
+			}
+			if group, appErr = a.CreateGroup(group); appErr != nil {
+				c.Logger().Warn("Failed to create the OAuth group", mlog.String("remote_id", name), mlog.Err(appErr))
+				continue
+			}
+		}
+
+		// Groups deleted by an admin stay deleted
+		if group.DeleteAt != 0 {
+			continue
+		}
+
+		delete(toRemove, group.Id)
+		if _, appErr = a.UpsertGroupMember(group.Id, user.Id); appErr != nil {
+			c.Logger().Warn("Failed to add the user to the OAuth group", mlog.String("group_id", group.Id), mlog.Err(appErr))
+		}
+	}
+
+	for groupID := range toRemove {
+		if _, appErr = a.DeleteGroupMember(groupID, user.Id); appErr != nil {
+			c.Logger().Warn("Failed to remove the user from the OAuth group", mlog.String("group_id", groupID), mlog.Err(appErr))
+		}
+	}
+}
+
 func (a *App) CompleteSwitchWithOAuth(service string, userData io.Reader, email string, tokenUser *model.User) (*model.User, *model.AppError) {
 	provider, e := a.getSSOProvider(service)
 	if e != nil {
@@ -754,7 +907,13 @@ func (a *App) GetAuthorizationCode(w http.ResponseWriter, r *http.Request, servi
 	endpoint := *sso.AuthEndpoint
 	scope := *sso.Scope
 
-	tokenExtra := generateOAuthStateTokenExtra(props["email"], props["action"], cookieValue)
+	// The nonce is kept in the state token for the ID token to be checked against it
+	var nonce string
+	if _, ok := provider.(einterfaces.OAuthNonceProvider); ok {
+		nonce = model.NewId()
+	}
+
+	tokenExtra := generateOAuthStateTokenExtra(props["email"], props["action"], cookieValue, nonce)
 	stateToken, err := a.CreateOAuthStateToken(tokenExtra)
 	if err != nil {
 		return "", err
@@ -780,6 +939,10 @@ func (a *App) GetAuthorizationCode(w http.ResponseWriter, r *http.Request, servi
 		authURL += "&login_hint=" + utils.URLEncode(loginHint)
 	}
 
+	if nonce != "" {
+		authURL += "&nonce=" + nonce
+	}
+
 	return authURL, nil
 }
 
@@ -818,7 +981,13 @@ func (a *App) AuthorizeOAuthUser(w http.ResponseWriter, r *http.Request, service
 		return nil, "", stateProps, nil, model.NewAppError("AuthorizeOAuthUser", "api.user.authorize_oauth_user.invalid_state.app_error", nil, "", http.StatusBadRequest)
 	}
 
-	expectedTokenExtra := generateOAuthStateTokenExtra(stateEmail, stateAction, cookie.Value)
+	nonceProvider, checksNonce := provider.(einterfaces.OAuthNonceProvider)
+	var nonce string
+	if checksNonce {
+		nonce = expectedToken.Extra[strings.LastIndex(expectedToken.Extra, ":")+1:]
+	}
+
+	expectedTokenExtra := generateOAuthStateTokenExtra(stateEmail, stateAction, cookie.Value, nonce)
 	if expectedTokenExtra != expectedToken.Extra {
 		return nil, "", stateProps, nil, model.NewAppError("AuthorizeOAuthUser", "api.user.authorize_oauth_user.invalid_state.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -883,7 +1052,16 @@ func (a *App) AuthorizeOAuthUser(w http.ResponseWriter, r *http.Request, service
 	p.Set("access_token", ar.AccessToken)
 
 	var userFromToken *model.User
-	if ar.IdToken != "" {
+	if checksNonce {
+		// The ID token is what the nonce is checked against, so it can't be left out
+		if ar.IdToken == "" {
+			return nil, "", stateProps, nil, model.NewAppError("AuthorizeOAuthUser", "api.user.authorize_oauth_user.missing_id_token.app_error", nil, "", http.StatusInternalServerError)
+		}
+		userFromToken, err = nonceProvider.GetUserFromIdTokenWithNonce(ar.IdToken, nonce)
+		if err != nil {
+			return nil, "", stateProps, nil, model.NewAppError("AuthorizeOAuthUser", "api.user.authorize_oauth_user.token_failed.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	} else if ar.IdToken != "" {
 		userFromToken, err = provider.GetUserFromIdToken(ar.IdToken)
 		if err != nil {
 			return nil, "", stateProps, nil, model.NewAppError("AuthorizeOAuthUser", "api.user.authorize_oauth_user.token_failed.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -990,6 +1168,10 @@ func (a *App) SwitchOAuthToEmail(email, password, requesterId string) (string, *
 	return "/login?extra=signin_change", nil
 }
 
-func generateOAuthStateTokenExtra(email, action, cookie string) string {
-	return email + ":" + action + ":" + cookie
+func generateOAuthStateTokenExtra(email, action, cookie, nonce string) string {
+	extra := email + ":" + action + ":" + cookie
+	if nonce != "" {
+		extra += ":" + nonce
+	}
+	return extra
 }
@@ -0,0 +1,181 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// idTokenClaims are the claims of the ID tokens handed to OpenID Connect clients, made of the
+// claims of the user the client was granted along with the ones identifying the token.
+type idTokenClaims struct {
+	*model.OpenIDUserInfo
+	Issuer    string `json:"iss"`
+	Audience  string `json:"aud"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	Nonce     string `json:"nonce,omitempty"`
+}
+
+// Valid is part of jwt.Claims. ID tokens are only signed here, never parsed.
+func (idTokenClaims) Valid() error {
+	return nil
+}
+
+// GetOpenIDConfiguration returns the OpenID Connect discovery document of the OAuth 2.0 service
+// provider.
+func (a *App) GetOpenIDConfiguration() (*model.OpenIDConfiguration, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOAuthServiceProvider {
+		return nil, model.NewAppError("GetOpenIDConfiguration", "api.oauth.allow_oauth.turn_off.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	responseTypes := []string{model.AuthCodeResponseType, model.ImplicitResponseType}
+	grantTypes := []string{model.AccessTokenGrantType, model.RefreshTokenGrantType, "implicit"}
+	codeChallengeMethods := []string{model.CodeChallengeMethodS256, model.CodeChallengeMethodPlain}
+	if *a.Config().ServiceSettings.EnableOAuthServiceProviderOAuth21 {
+		responseTypes = []string{model.AuthCodeResponseType}
+		grantTypes = []string{model.AccessTokenGrantType, model.RefreshTokenGrantType}
+		codeChallengeMethods = []string{model.CodeChallengeMethodS256}
+	}
+
+	siteURL := a.GetSiteURL()
+	return &model.OpenIDConfiguration{
+		Issuer:                            siteURL,
+		AuthorizationEndpoint:             siteURL + "/oauth/authorize",
+		TokenEndpoint:                     siteURL + "/oauth/access_token",
+		UserinfoEndpoint:                  siteURL + "/oauth/userinfo",
+		JwksURI:                           siteURL + "/oauth/jwks",
+		ScopesSupported:                   []string{model.ScopeOpenID, model.ScopeProfile, model.ScopeEmail},
+		ResponseTypesSupported:            responseTypes,
+		GrantTypesSupported:               grantTypes,
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{model.IDTokenSigningAlg},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_post", "client_secret_basic"},
+		CodeChallengeMethodsSupported:     codeChallengeMethods,
+		ClaimsSupported: []string{
+			"sub", "iss", "aud", "exp", "iat", "nonce",
+			"name", "given_name", "family_name", "nickname", "preferred_username", "locale", "updated_at",
+			"email", "email_verified",
+		},
+	}, nil
+}
+
+// GetOAuthJSONWebKeySet returns the public key ID tokens are signed with.
+func (a *App) GetOAuthJSONWebKeySet() (*model.JSONWebKeySet, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOAuthServiceProvider {
+		return nil, model.NewAppError("GetOAuthJSONWebKeySet", "api.oauth.allow_oauth.turn_off.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	key := a.AsymmetricSigningKey()
+	if key == nil {
+		return nil, model.NewAppError("GetOAuthJSONWebKeySet", "app.oauth.id_token.signing_key.app_error", nil, "", http.StatusInternalServerError)
+	}
+
+	// The coordinates are padded to the size of the curve, see RFC 7518
+	size := (key.Curve.Params().BitSize + 7) / 8
+	return &model.JSONWebKeySet{
+		Keys: []model.JSONWebKey{{
+			Kty: "EC",
+			Crv: key.Curve.Params().Name,
+			X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, size))),
+			Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, size))),
+			Use: "sig",
+			Alg: model.IDTokenSigningAlg,
+			Kid: idTokenKeyID(&key.PublicKey),
+		}},
+	}, nil
+}
+
+// GetOAuthUserInfo returns the claims about the user of an OAuth session, limited to the scopes
+// the app was granted.
+func (a *App) GetOAuthUserInfo(session *model.Session) (*model.OpenIDUserInfo, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOAuthServiceProvider {
+		return nil, model.NewAppError("GetOAuthUserInfo", "api.oauth.allow_oauth.turn_off.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if !session.IsOAuth {
+		return nil, model.NewAppError("GetOAuthUserInfo", "api.oauth.userinfo.not_oauth.app_error", nil, "", http.StatusForbidden)
+	}
+
+	accessData, err := a.Srv().Store().OAuth().GetAccessData(session.Token)
+	if err != nil {
+		return nil, model.NewAppError("GetOAuthUserInfo", "api.oauth.userinfo.not_oauth.app_error", nil, "", http.StatusForbidden).Wrap(err)
+	}
+
+	if !model.ScopeContains(accessData.Scope, model.ScopeOpenID) {
+		return nil, model.NewAppError("GetOAuthUserInfo", "api.oauth.userinfo.scope.app_error", nil, "", http.StatusForbidden)
+	}
+
+	user, appErr := a.GetUser(session.UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return openIDUserInfo(user, accessData.Scope), nil
+}
+
+// newIDToken returns a signed OpenID Connect ID token asserting the identity of the user to the
+// app.
+func (a *App) newIDToken(user *model.User, clientID, scope, nonce string) (string, *model.AppError) {
+	key := a.AsymmetricSigningKey()
+	if key == nil {
+		return "", model.NewAppError("newIDToken", "app.oauth.id_token.signing_key.app_error", nil, "", http.StatusInternalServerError)
+	}
+
+	now := model.GetMillis() / 1000
+	claims := idTokenClaims{
+		OpenIDUserInfo: openIDUserInfo(user, scope),
+		Issuer:         a.GetSiteURL(),
+		Audience:       clientID,
+		IssuedAt:       now,
+		ExpiresAt:      now + model.IDTokenExpireTime,
+		Nonce:          nonce,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = idTokenKeyID(&key.PublicKey)
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		return "", model.NewAppError("newIDToken", "app.oauth.id_token.sign.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return signed, nil
+}
+
+func openIDUserInfo(user *model.User, scope string) *model.OpenIDUserInfo {
+	info := &model.OpenIDUserInfo{Sub: user.Id}
+
+	if model.ScopeContains(scope, model.ScopeProfile) {
+		info.Name = user.GetFullName()
+		info.GivenName = user.FirstName
+		info.FamilyName = user.LastName
+		info.Nickname = user.Nickname
+		info.PreferredUsername = user.Username
+		info.Locale = user.Locale
+		info.UpdatedAt = user.UpdateAt / 1000
+	}
+
+	if model.ScopeContains(scope, model.ScopeEmail) {
+		info.Email = user.Email
+		info.EmailVerified = model.NewBool(user.EmailVerified)
+	}
+
+	return info
+}
+
+// idTokenKeyID identifies the signing key in the header of the ID tokens, for clients to pick the
+// matching key out of the key set.
+func idTokenKeyID(key *ecdsa.PublicKey) string {
+	hash := sha256.Sum256(elliptic.Marshal(key.Curve, key.X, key.Y))
+	return base64.RawURLEncoding.EncodeToString(hash[:16])
+}
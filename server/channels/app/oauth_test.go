@@ -165,7 +165,7 @@ func TestAuthorizeOAuthUser(t *testing.T) {
 	}
 
 	makeToken := func(th *TestHelper, cookie string) *model.Token {
-		token, _ := th.App.CreateOAuthStateToken(generateOAuthStateTokenExtra("", "", cookie))
+		token, _ := th.App.CreateOAuthStateToken(generateOAuthStateTokenExtra("", "", cookie, ""))
 		return token
 	}
 
@@ -239,7 +239,7 @@ func TestAuthorizeOAuthUser(t *testing.T) {
 		action := model.OAuthActionEmailToSSO
 		cookie := model.NewId()
 
-		token, err := th.App.CreateOAuthStateToken(generateOAuthStateTokenExtra(email, action, cookie))
+		token, err := th.App.CreateOAuthStateToken(generateOAuthStateTokenExtra(email, action, cookie, ""))
 		require.Nil(t, err)
 
 		state := base64.StdEncoding.EncodeToString([]byte(model.MapToJSON(map[string]string{
@@ -535,6 +535,94 @@ func TestAuthorizeOAuthUser(t *testing.T) {
 	})
 }
 
+type testOAuthNonceProvider struct {
+	*mocks.OAuthProvider
+	nonce string
+}
+
+func (p *testOAuthNonceProvider) GetUserFromIdTokenWithNonce(idToken, nonce string) (*model.User, error) {
+	p.nonce = nonce
+	return &model.User{}, nil
+}
+
+func TestAuthorizeOAuthUserNonce(t *testing.T) {
+	setup := func(t *testing.T, idToken string) (*TestHelper, *testOAuthNonceProvider) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/token":
+				json.NewEncoder(w).Encode(&model.AccessResponse{
+					AccessToken: model.NewId(),
+					TokenType:   model.AccessTokenType,
+					IdToken:     idToken,
+				})
+			case "/user":
+				w.Write([]byte("{}"))
+			}
+		}))
+		t.Cleanup(server.Close)
+
+		th := Setup(t)
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.OIDCSettings.Enable = true
+		})
+
+		sso := &model.SSOSettings{
+			Id:              model.NewString("client"),
+			Secret:          model.NewString("secret"),
+			Scope:           model.NewString("openid"),
+			AuthEndpoint:    model.NewString(server.URL + "/authorize"),
+			TokenEndpoint:   model.NewString(server.URL + "/token"),
+			UserAPIEndpoint: model.NewString(server.URL + "/user"),
+		}
+		providerMock := &mocks.OAuthProvider{}
+		providerMock.On("GetSSOSettings", mock.Anything, model.ServiceOIDC).Return(sso, nil)
+		provider := &testOAuthNonceProvider{OAuthProvider: providerMock}
+		einterfaces.RegisterOAuthProvider(model.ServiceOIDC, provider)
+
+		return th, provider
+	}
+
+	authorize := func(t *testing.T, th *TestHelper) (string, *model.AppError) {
+		request, _ := http.NewRequest(http.MethodGet, "https://mattermost.example.com", nil)
+		recorder := httptest.NewRecorder()
+		authURL, appErr := th.App.GetAuthorizationCode(recorder, request, model.ServiceOIDC, map[string]string{}, "")
+		require.Nil(t, appErr)
+
+		parsedURL, err := url.Parse(authURL)
+		require.NoError(t, err)
+		nonce := parsedURL.Query().Get("nonce")
+		require.NotEmpty(t, nonce)
+
+		for _, cookie := range recorder.Result().Cookies() {
+			request.AddCookie(cookie)
+		}
+
+		body, _, _, _, appErr := th.App.AuthorizeOAuthUser(httptest.NewRecorder(), request, model.ServiceOIDC, "", parsedURL.Query().Get("state"), "")
+		if body != nil {
+			body.Close()
+		}
+		return nonce, appErr
+	}
+
+	t.Run("the nonce of the authorization request is checked", func(t *testing.T) {
+		th, provider := setup(t, "id_token")
+		defer th.TearDown()
+
+		nonce, appErr := authorize(t, th)
+		require.Nil(t, appErr)
+		assert.Equal(t, nonce, provider.nonce)
+	})
+
+	t.Run("without an ID token", func(t *testing.T) {
+		th, _ := setup(t, "")
+		defer th.TearDown()
+
+		_, appErr := authorize(t, th)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.authorize_oauth_user.missing_id_token.app_error", appErr.Id)
+	})
+}
+
 func TestGetAuthorizationCode(t *testing.T) {
 	t.Run("not enabled", func(t *testing.T) {
 		th := Setup(t)
@@ -633,3 +721,63 @@ func TestDeauthorizeOAuthApp(t *testing.T) {
 	require.Equal(t, store.NewErrNotFound("AuthData", fmt.Sprintf("code=%s", code)), nErr)
 	assert.Nil(t, data)
 }
+
+type testOAuthGroupsProvider struct {
+	groups []string
+}
+
+func (p *testOAuthGroupsProvider) GetGroupsFromJSON(data io.Reader) ([]string, error) {
+	return p.groups, nil
+}
+
+func TestSyncOAuthUserGroups(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	getGroupNames := func(t *testing.T) []string {
+		groups, appErr := th.App.GetGroupsByUserId(th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		names := []string{}
+		for _, group := range groups {
+			if group.Source == model.GroupSourceOIDC {
+				names = append(names, group.GetRemoteId())
+			}
+		}
+		return names
+	}
+
+	t.Run("groups are created and joined", func(t *testing.T) {
+		th.App.syncOAuthUserGroups(th.Context, &testOAuthGroupsProvider{groups: []string{"engineering", "design"}}, th.BasicUser, nil)
+		assert.ElementsMatch(t, []string{"engineering", "design"}, getGroupNames(t))
+
+		group, appErr := th.App.GetGroupByRemoteID("engineering", model.GroupSourceOIDC)
+		require.Nil(t, appErr)
+		assert.Equal(t, "engineering", group.DisplayName)
+	})
+
+	t.Run("groups no longer listed are left", func(t *testing.T) {
+		th.App.syncOAuthUserGroups(th.Context, &testOAuthGroupsProvider{groups: []string{"design"}}, th.BasicUser, nil)
+		assert.ElementsMatch(t, []string{"design"}, getGroupNames(t))
+	})
+
+	t.Run("groups of other sources are kept", func(t *testing.T) {
+		group := th.CreateGroup()
+		_, appErr := th.App.UpsertGroupMember(group.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		th.App.syncOAuthUserGroups(th.Context, &testOAuthGroupsProvider{groups: []string{}}, th.BasicUser, nil)
+		assert.Empty(t, getGroupNames(t))
+
+		groups, appErr := th.App.GetGroupsByUserId(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		require.Len(t, groups, 1)
+		assert.Equal(t, group.Id, groups[0].Id)
+	})
+
+	t.Run("groups aren't synchronized when disabled", func(t *testing.T) {
+		th.App.syncOAuthUserGroups(th.Context, &testOAuthGroupsProvider{groups: []string{"design"}}, th.BasicUser, nil)
+		th.App.syncOAuthUserGroups(th.Context, &testOAuthGroupsProvider{}, th.BasicUser, nil)
+		assert.ElementsMatch(t, []string{"design"}, getGroupNames(t))
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/cache"
+)
+
+// GetCaches returns the named caches of this node, sorted by name.
+func (ps *PlatformService) GetCaches() ([]*model.CacheInfo, *model.AppError) {
+	caches := ps.cacheProvider.Caches()
+	infos := make([]*model.CacheInfo, 0, len(caches))
+	for _, c := range caches {
+		l, err := c.Len()
+		if err != nil {
+			return nil, model.NewAppError("GetCaches", "app.cache.get_caches.app_error", map[string]any{"Name": c.Name()}, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		infos = append(infos, &model.CacheInfo{
+			Name:   c.Name(),
+			Len:    l,
+			Shared: cache.IsShared(c),
+		})
+	}
+
+	return infos, nil
+}
+
+// FlushCache purges the cache with the given name on every node of the cluster.
+func (ps *PlatformService) FlushCache(name string) *model.AppError {
+	c, appErr := ps.getCache(name)
+	if appErr != nil {
+		return appErr
+	}
+
+	if err := c.Purge(); err != nil {
+		return model.NewAppError("FlushCache", "app.cache.flush.app_error", map[string]any{"Name": name}, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// A shared cache is purged for all the nodes at once.
+	if ps.clusterIFace != nil && !cache.IsShared(c) {
+		msg := &model.ClusterMessage{
+			Event:    model.ClusterEventFlushCache,
+			SendType: model.ClusterSendReliable,
+			Data:     []byte(name),
+		}
+		ps.clusterIFace.SendClusterMessage(msg)
+	}
+
+	return nil
+}
+
+// FlushCacheSkipClusterSend purges the cache with the given name on this node.
+func (ps *PlatformService) FlushCacheSkipClusterSend(name string) *model.AppError {
+	c, appErr := ps.getCache(name)
+	if appErr != nil {
+		return appErr
+	}
+
+	if err := c.Purge(); err != nil {
+		return model.NewAppError("FlushCacheSkipClusterSend", "app.cache.flush.app_error", map[string]any{"Name": name}, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+func (ps *PlatformService) getCache(name string) (cache.Cache, *model.AppError) {
+	for _, c := range ps.cacheProvider.Caches() {
+		if c.Name() == name {
+			return c, nil
+		}
+	}
+
+	return nil, model.NewAppError("getCache", "app.cache.not_found.app_error", map[string]any{"Name": name}, "", http.StatusNotFound)
+}
@@ -44,7 +44,7 @@ func (ps *PlatformService) IsLeader() bool {
 }
 
 func (ps *PlatformService) SetCluster(impl einterfaces.ClusterInterface) { //nolint:unused
-	ps.clusterIFace = impl
+	ps.clusterIFace = newClusterTransport(ps, impl)
 }
 
 func (ps *PlatformService) PublishPluginClusterEvent(productID string, ev model.PluginClusterEvent, opts model.PluginClusterEventSendOptions) error {
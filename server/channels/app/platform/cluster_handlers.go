@@ -17,7 +17,9 @@ import (
 func (ps *PlatformService) RegisterClusterHandlers() {
 	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventPublish, ps.ClusterPublishHandler)
 	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventUpdateStatus, ps.ClusterUpdateStatusHandler)
+	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventPresenceChanged, ps.clusterPresenceChangedHandler)
 	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventInvalidateAllCaches, ps.ClusterInvalidateAllCachesHandler)
+	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventFlushCache, ps.clusterFlushCacheHandler)
 	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForChannelMembersNotifyProps, ps.clusterInvalidateCacheForChannelMembersNotifyPropHandler)
 	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForChannelByName, ps.clusterInvalidateCacheForChannelByNameHandler)
 	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForUser, ps.clusterInvalidateCacheForUserHandler)
@@ -25,6 +27,7 @@ func (ps *PlatformService) RegisterClusterHandlers() {
 	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventBusyStateChanged, ps.clusterBusyStateChgHandler)
 	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventClearSessionCacheForUser, ps.clusterClearSessionCacheForUserHandler)
 	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventClearSessionCacheForAllUsers, ps.clusterClearSessionCacheForAllUsersHandler)
+	ps.clusterIFace.RegisterClusterMessageHandler(model.ClusterEventDisconnectWebConns, ps.clusterDisconnectWebConnsHandler)
 
 	for e, h := range ps.additionalClusterHandlers {
 		ps.clusterIFace.RegisterClusterMessageHandler(e, h)
@@ -67,10 +70,26 @@ func (ps *PlatformService) ClusterUpdateStatusHandler(msg *model.ClusterMessage)
 	ps.statusCache.Set(status.UserId, status)
 }
 
+func (ps *PlatformService) clusterPresenceChangedHandler(msg *model.ClusterMessage) {
+	var status model.Status
+	if jsonErr := json.Unmarshal(msg.Data, &status); jsonErr != nil {
+		ps.logger.Warn("Failed to decode status from JSON", mlog.Err(jsonErr))
+		return
+	}
+
+	ps.publishPresenceSkipClusterSend(&status)
+}
+
 func (ps *PlatformService) ClusterInvalidateAllCachesHandler(msg *model.ClusterMessage) {
 	ps.InvalidateAllCachesSkipSend()
 }
 
+func (ps *PlatformService) clusterFlushCacheHandler(msg *model.ClusterMessage) {
+	if appErr := ps.FlushCacheSkipClusterSend(string(msg.Data)); appErr != nil {
+		ps.logger.Warn("Failed to flush cache", mlog.String("cache_name", string(msg.Data)), mlog.Err(appErr))
+	}
+}
+
 func (ps *PlatformService) clusterInvalidateCacheForChannelMembersNotifyPropHandler(msg *model.ClusterMessage) {
 	ps.invalidateCacheForChannelMembersNotifyPropsSkipClusterSend(string(msg.Data))
 }
@@ -105,6 +124,16 @@ func (ps *PlatformService) clusterClearSessionCacheForAllUsersHandler(msg *model
 	ps.ClearSessionCacheForAllUsersSkipClusterSend()
 }
 
+func (ps *PlatformService) clusterDisconnectWebConnsHandler(msg *model.ClusterMessage) {
+	var disconnect webConnDisconnect
+	if jsonErr := json.Unmarshal(msg.Data, &disconnect); jsonErr != nil {
+		ps.logger.Warn("Failed to decode websocket disconnection from JSON", mlog.Err(jsonErr))
+		return
+	}
+
+	ps.DisconnectWebConnsSkipClusterSend(disconnect.UserId, disconnect.SessionIds)
+}
+
 func (ps *PlatformService) clusterBusyStateChgHandler(msg *model.ClusterMessage) {
 	var sbs model.ServerBusyState
 	if jsonErr := json.Unmarshal(msg.Data, &sbs); jsonErr != nil {
@@ -136,6 +165,26 @@ func (ps *PlatformService) InvalidateCacheForUserSkipClusterSend(userID string)
 	ps.invalidateWebConnSessionCacheForUser(userID)
 }
 
+// webConnDisconnect is the payload of the ClusterEventDisconnectWebConns cluster messages.
+type webConnDisconnect struct {
+	UserId     string   `json:"user_id"`
+	SessionIds []string `json:"session_ids"`
+}
+
+func (ps *PlatformService) DisconnectWebConnsSkipClusterSend(userID string, sessionIDs []string) {
+	if userID == "" {
+		for _, hub := range ps.hubs {
+			hub.Disconnect("", sessionIDs)
+		}
+		return
+	}
+
+	hub := ps.GetHubForUserId(userID)
+	if hub != nil {
+		hub.Disconnect(userID, sessionIDs)
+	}
+}
+
 func (ps *PlatformService) invalidateWebConnSessionCacheForUser(userID string) {
 	hub := ps.GetHubForUserId(userID)
 	if hub != nil {
@@ -0,0 +1,176 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// clusterChunksTimeout is how long the chunks of a message are kept waiting for the missing ones,
+// e.g. lost as they were sent best effort.
+const clusterChunksTimeout = time.Minute
+
+// clusterTransport wraps the cluster interface to compress the large messages sent to the other
+// nodes and to split the largest in chunks, per the cluster settings, the handlers of the messages
+// receiving them reassembled and decompressed.
+type clusterTransport struct {
+	einterfaces.ClusterInterface
+	ps *PlatformService
+
+	chunksMut sync.Mutex
+	chunks    map[string]*clusterChunks
+}
+
+type clusterChunks struct {
+	data     [][]byte
+	received int
+	expireAt time.Time
+}
+
+func newClusterTransport(ps *PlatformService, cluster einterfaces.ClusterInterface) einterfaces.ClusterInterface {
+	if cluster == nil {
+		return nil
+	}
+
+	return &clusterTransport{
+		ClusterInterface: cluster,
+		ps:               ps,
+		chunks:           make(map[string]*clusterChunks),
+	}
+}
+
+func (t *clusterTransport) SendClusterMessage(msg *model.ClusterMessage) {
+	msgs, err := t.prepare(msg)
+	if err != nil {
+		t.ps.logger.Warn("Failed to compress the cluster message, sending it as it is", mlog.String("event", string(msg.Event)), mlog.Err(err))
+		msgs = []*model.ClusterMessage{msg}
+	}
+
+	for _, m := range msgs {
+		t.ClusterInterface.SendClusterMessage(m)
+	}
+}
+
+func (t *clusterTransport) SendClusterMessageToNode(nodeID string, msg *model.ClusterMessage) error {
+	msgs, err := t.prepare(msg)
+	if err != nil {
+		t.ps.logger.Warn("Failed to compress the cluster message, sending it as it is", mlog.String("event", string(msg.Event)), mlog.Err(err))
+		msgs = []*model.ClusterMessage{msg}
+	}
+
+	for _, m := range msgs {
+		if err := t.ClusterInterface.SendClusterMessageToNode(nodeID, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepare compresses and splits the message per the cluster settings, recording the bytes sent.
+func (t *clusterTransport) prepare(msg *model.ClusterMessage) ([]*model.ClusterMessage, error) {
+	settings := t.ps.Config().ClusterSettings
+
+	compressed, err := msg.Compressed(*settings.MessageCompressionThresholdBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.ps.metricsIFace != nil {
+		t.ps.metricsIFace.AddClusterMessageBytesSent(msg.Event, len(compressed.Data))
+		if saved := len(msg.Data) - len(compressed.Data); saved > 0 {
+			t.ps.metricsIFace.AddClusterMessageBytesSaved(msg.Event, saved)
+		}
+	}
+
+	return compressed.Split(*settings.MessageChunkSizeBytes), nil
+}
+
+func (t *clusterTransport) RegisterClusterMessageHandler(event model.ClusterEvent, handler einterfaces.ClusterMessageHandler) {
+	t.ClusterInterface.RegisterClusterMessageHandler(event, func(msg *model.ClusterMessage) {
+		if t.ps.metricsIFace != nil {
+			t.ps.metricsIFace.AddClusterMessageBytesReceived(msg.Event, len(msg.Data))
+		}
+
+		if msg.IsChunk() {
+			var err error
+			if msg, err = t.assemble(msg); err != nil {
+				t.ps.logger.Warn("Failed to reassemble the cluster message", mlog.String("event", string(event)), mlog.Err(err))
+				return
+			} else if msg == nil {
+				return
+			}
+		}
+
+		if err := msg.Decompress(); err != nil {
+			t.ps.logger.Warn("Failed to decompress the cluster message", mlog.String("event", string(event)), mlog.Err(err))
+			return
+		}
+
+		handler(msg)
+	})
+}
+
+// assemble adds a chunk to the chunks received of its message, returning the message once all of
+// its chunks were received.
+func (t *clusterTransport) assemble(chunk *model.ClusterMessage) (*model.ClusterMessage, error) {
+	id := chunk.Props[model.ClusterMessagePropChunkId]
+	index, err := strconv.Atoi(chunk.Props[model.ClusterMessagePropChunkIndex])
+	if err != nil {
+		return nil, fmt.Errorf("invalid chunk index: %w", err)
+	}
+	count, err := strconv.Atoi(chunk.Props[model.ClusterMessagePropChunkCount])
+	if err != nil || count <= 0 || index < 0 || index >= count {
+		return nil, fmt.Errorf("invalid chunk %d of %s", index, chunk.Props[model.ClusterMessagePropChunkCount])
+	}
+
+	t.chunksMut.Lock()
+	defer t.chunksMut.Unlock()
+
+	now := time.Now()
+	for chunksID, chunks := range t.chunks {
+		if now.After(chunks.expireAt) {
+			delete(t.chunks, chunksID)
+		}
+	}
+
+	chunks, ok := t.chunks[id]
+	if !ok {
+		chunks = &clusterChunks{data: make([][]byte, count), expireAt: now.Add(clusterChunksTimeout)}
+		t.chunks[id] = chunks
+	}
+	if len(chunks.data) != count {
+		return nil, fmt.Errorf("chunk %d of %d for a message of %d chunks", index, count, len(chunks.data))
+	}
+	if chunks.data[index] == nil {
+		chunks.data[index] = chunk.Data
+		chunks.received++
+	}
+	if chunks.received < count {
+		return nil, nil
+	}
+	delete(t.chunks, id)
+
+	msg := *chunk
+	msg.Props = make(map[string]string, len(chunk.Props))
+	for key, value := range chunk.Props {
+		switch key {
+		case model.ClusterMessagePropChunkId, model.ClusterMessagePropChunkIndex, model.ClusterMessagePropChunkCount:
+		default:
+			msg.Props[key] = value
+		}
+	}
+	msg.Data = nil
+	for _, data := range chunks.data {
+		msg.Data = append(msg.Data, data...)
+	}
+
+	return &msg, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces/mocks"
+)
+
+func TestClusterTransport(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	th.Service.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ClusterSettings.MessageCompressionThresholdBytes = 1024
+		*cfg.ClusterSettings.MessageChunkSizeBytes = model.ClusterMessageChunkSizeMin
+	})
+
+	var sent []*model.ClusterMessage
+	var handler einterfaces.ClusterMessageHandler
+	cm := &mocks.ClusterInterface{}
+	cm.On("SendClusterMessage", mock.AnythingOfType("*model.ClusterMessage")).Run(func(args mock.Arguments) {
+		sent = append(sent, args.Get(0).(*model.ClusterMessage))
+	})
+	cm.On("RegisterClusterMessageHandler", model.ClusterEventPublish, mock.Anything).Run(func(args mock.Arguments) {
+		handler = args.Get(1).(einterfaces.ClusterMessageHandler)
+	})
+
+	transport := newClusterTransport(th.Service, cm)
+
+	var received []*model.ClusterMessage
+	transport.RegisterClusterMessageHandler(model.ClusterEventPublish, func(msg *model.ClusterMessage) {
+		received = append(received, msg)
+	})
+	require.NotNil(t, handler)
+
+	t.Run("small message sent as it is", func(t *testing.T) {
+		sent, received = nil, nil
+		msg := &model.ClusterMessage{Event: model.ClusterEventPublish, Data: []byte("small")}
+
+		transport.SendClusterMessage(msg)
+		require.Len(t, sent, 1)
+		assert.Same(t, msg, sent[0])
+
+		handler(sent[0])
+		require.Len(t, received, 1)
+		assert.Equal(t, []byte("small"), received[0].Data)
+	})
+
+	t.Run("large message compressed", func(t *testing.T) {
+		sent, received = nil, nil
+		data := bytes.Repeat([]byte("compressible "), 1000)
+
+		transport.SendClusterMessage(&model.ClusterMessage{Event: model.ClusterEventPublish, Data: data})
+		require.Len(t, sent, 1)
+		assert.Equal(t, model.ClusterMessageCompressionGzip, sent[0].Props[model.ClusterMessagePropCompression])
+		assert.Less(t, len(sent[0].Data), len(data))
+
+		handler(sent[0])
+		require.Len(t, received, 1)
+		assert.Equal(t, data, received[0].Data)
+	})
+
+	t.Run("largest message split in chunks", func(t *testing.T) {
+		sent, received = nil, nil
+		var buf bytes.Buffer
+		for buf.Len() < 3*model.ClusterMessageChunkSizeMin {
+			buf.WriteString(model.NewId())
+		}
+		data := buf.Bytes()
+
+		transport.SendClusterMessage(&model.ClusterMessage{Event: model.ClusterEventPublish, Data: data})
+		require.Greater(t, len(sent), 1)
+
+		// The chunks are reassembled whatever the order they're received in.
+		for i := len(sent) - 1; i >= 0; i-- {
+			assert.True(t, sent[i].IsChunk())
+			handler(sent[i])
+			if i > 0 {
+				require.Empty(t, received)
+			}
+		}
+		require.Len(t, received, 1)
+		assert.Equal(t, data, received[0].Data)
+		assert.False(t, received[0].IsChunk())
+		assert.Empty(t, received[0].Props[model.ClusterMessagePropCompression])
+	})
+
+	t.Run("invalid chunk dropped", func(t *testing.T) {
+		sent, received = nil, nil
+
+		handler(&model.ClusterMessage{Event: model.ClusterEventPublish, Data: []byte("data"), Props: map[string]string{
+			model.ClusterMessagePropChunkId:    model.NewId(),
+			model.ClusterMessagePropChunkIndex: "2",
+			model.ClusterMessagePropChunkCount: "2",
+		}})
+		assert.Empty(t, received)
+	})
+}
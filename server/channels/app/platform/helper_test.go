@@ -55,6 +55,9 @@ func (ms *mockSuite) RolesGrantPermission(roleNames []string, permissionId strin
 func (ms *mockSuite) UserCanSeeOtherUser(userID string, otherUserId string) (bool, *model.AppError) {
 	return true, nil
 }
+func (ms *mockSuite) CheckWebSocketIPAccess(session *model.Session, ipAddress string, bypassToken string) *model.AppError {
+	return nil
+}
 
 func Setup(tb testing.TB, options ...Option) *TestHelper {
 	if testing.Short() {
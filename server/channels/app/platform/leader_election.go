@@ -0,0 +1,275 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/product"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// leaderElectionPluginID is the plugin ID under which the leases of the elections are saved in the
+// plugin key value store, apart from the keys of the products and plugins themselves.
+const leaderElectionPluginID = "com.mattermost.leader_election"
+
+// ensure the leader election implements `product.LeaderElection`
+var _ product.LeaderElection = (*leaderElection)(nil)
+
+func leaderElectionKey(namespace, name string) (string, *model.AppError) {
+	if !model.IsValidLeaderElectionName(name) {
+		return "", model.NewAppError("LeaderElection", "app.leader_election.name.app_error", map[string]any{"Max": model.LeaderElectionNameMaxRunes}, "name="+name, http.StatusBadRequest)
+	}
+	return namespace + "/" + name, nil
+}
+
+// NodeID returns the ID of this node in the cluster, its ID as a candidate of the elections.
+func (ps *PlatformService) NodeID() string {
+	if id := ps.GetClusterId(); id != "" {
+		return id
+	}
+	return ps.nodeID
+}
+
+// AcquireLeadership makes the candidate the leader of the named election of a product or plugin
+// when there is no leader, or renews the lease of the candidate when it's the leader already. It
+// returns whether the candidate is the leader.
+func (ps *PlatformService) AcquireLeadership(namespace, name, candidateID string, lease time.Duration) (bool, *model.AppError) {
+	key, appErr := leaderElectionKey(namespace, name)
+	if appErr != nil {
+		return false, appErr
+	}
+
+	value := []byte(candidateID)
+	// The lease of the leader is renewed first, the leader campaigning the most often.
+	for _, oldValue := range [][]byte{value, nil} {
+		elected, err := ps.Store.Plugin().SetWithOptions(leaderElectionPluginID, key, value, model.PluginKVSetOptions{
+			Atomic:          true,
+			OldValue:        oldValue,
+			ExpireInSeconds: int64(lease / time.Second),
+		})
+		if err != nil {
+			return false, model.NewAppError("AcquireLeadership", "app.leader_election.campaign.app_error", nil, "key="+key, http.StatusInternalServerError).Wrap(err)
+		}
+		if elected {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ReleaseLeadership ends the lease of the candidate when it's the leader of the election.
+func (ps *PlatformService) ReleaseLeadership(namespace, name, candidateID string) *model.AppError {
+	key, appErr := leaderElectionKey(namespace, name)
+	if appErr != nil {
+		return appErr
+	}
+
+	if _, err := ps.Store.Plugin().SetWithOptions(leaderElectionPluginID, key, nil, model.PluginKVSetOptions{
+		Atomic:   true,
+		OldValue: []byte(candidateID),
+	}); err != nil {
+		return model.NewAppError("ReleaseLeadership", "app.leader_election.resign.app_error", nil, "key="+key, http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// GetLeader returns the ID of the leader of the election, empty when there is none.
+func (ps *PlatformService) GetLeader(namespace, name string) (string, *model.AppError) {
+	key, appErr := leaderElectionKey(namespace, name)
+	if appErr != nil {
+		return "", appErr
+	}
+
+	kv, err := ps.Store.Plugin().Get(leaderElectionPluginID, key)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return "", nil
+		}
+		return "", model.NewAppError("GetLeader", "app.leader_election.get_leader.app_error", nil, "key="+key, http.StatusInternalServerError).Wrap(err)
+	}
+
+	return string(kv.Value), nil
+}
+
+// NewLeaderElection returns the named election of the product, this node campaigning for it
+// only once asked to.
+func (ps *PlatformService) NewLeaderElection(productID, name string) (product.LeaderElection, error) {
+	if _, appErr := leaderElectionKey(productID, name); appErr != nil {
+		return nil, appErr
+	}
+
+	return &leaderElection{
+		ps:        ps,
+		namespace: productID,
+		name:      name,
+		id:        ps.NodeID(),
+		lease:     model.LeaderElectionLeaseSeconds * time.Second,
+	}, nil
+}
+
+// leaderElection campaigns for an election on behalf of this node, renewing the lease of the
+// leadership in the background once elected.
+type leaderElection struct {
+	ps        *PlatformService
+	namespace string
+	name      string
+	id        string
+	lease     time.Duration
+
+	// mut protects the following fields from concurrent access.
+	mut      sync.Mutex
+	isLeader bool
+	stop     chan struct{}
+	stopped  chan struct{}
+}
+
+func (e *leaderElection) ID() string {
+	return e.id
+}
+
+func (e *leaderElection) Campaign(ctx context.Context) error {
+	ticker := time.NewTicker(e.lease / 3)
+	defer ticker.Stop()
+
+	for {
+		if e.IsLeader() {
+			return nil
+		}
+
+		elected, appErr := e.ps.AcquireLeadership(e.namespace, e.name, e.id, e.lease)
+		if appErr != nil {
+			e.ps.logger.Warn("Failed to campaign for the leader election", mlog.String("namespace", e.namespace), mlog.String("name", e.name), mlog.Err(appErr))
+		} else if elected {
+			e.setLeader()
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *leaderElection) setLeader() {
+	e.mut.Lock()
+	defer e.mut.Unlock()
+
+	e.isLeader = true
+	e.stop = make(chan struct{})
+	e.stopped = make(chan struct{})
+	go e.renew(e.stop, e.stopped)
+}
+
+// renew renews the lease of the leadership until stopped, the leadership being lost when the
+// lease couldn't be renewed before it expired.
+func (e *leaderElection) renew(stop, stopped chan struct{}) {
+	defer close(stopped)
+
+	ticker := time.NewTicker(e.lease / 3)
+	defer ticker.Stop()
+
+	renewedAt := time.Now()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		elected, appErr := e.ps.AcquireLeadership(e.namespace, e.name, e.id, e.lease)
+		if appErr != nil {
+			e.ps.logger.Warn("Failed to renew the leadership of the election", mlog.String("namespace", e.namespace), mlog.String("name", e.name), mlog.Err(appErr))
+			if time.Since(renewedAt) < e.lease {
+				continue
+			}
+		} else if elected {
+			renewedAt = time.Now()
+			continue
+		}
+
+		e.ps.logger.Info("Lost the leadership of the election", mlog.String("namespace", e.namespace), mlog.String("name", e.name))
+		e.mut.Lock()
+		e.isLeader = false
+		e.mut.Unlock()
+		return
+	}
+}
+
+func (e *leaderElection) Resign() error {
+	e.mut.Lock()
+	stop, stopped := e.stop, e.stopped
+	e.isLeader = false
+	e.stop, e.stopped = nil, nil
+	e.mut.Unlock()
+
+	if stop == nil {
+		return nil
+	}
+	close(stop)
+	<-stopped
+
+	if appErr := e.ps.ReleaseLeadership(e.namespace, e.name, e.id); appErr != nil {
+		return appErr
+	}
+	return nil
+}
+
+func (e *leaderElection) IsLeader() bool {
+	e.mut.Lock()
+	defer e.mut.Unlock()
+	return e.isLeader
+}
+
+func (e *leaderElection) Leader() (string, error) {
+	leader, appErr := e.ps.GetLeader(e.namespace, e.name)
+	if appErr != nil {
+		return "", appErr
+	}
+	return leader, nil
+}
+
+func (e *leaderElection) Observe(ctx context.Context) <-chan string {
+	leaders := make(chan string)
+
+	go func() {
+		defer close(leaders)
+
+		ticker := time.NewTicker(e.lease / 3)
+		defer ticker.Stop()
+
+		var last *string
+		for {
+			if leader, err := e.Leader(); err != nil {
+				e.ps.logger.Warn("Failed to observe the leader election", mlog.String("namespace", e.namespace), mlog.String("name", e.name), mlog.Err(err))
+			} else if last == nil || leader != *last {
+				select {
+				case leaders <- leader:
+					last = &leader
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return leaders
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestAcquireLeadership(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	namespace := "com.mattermost.test"
+	candidate1 := model.NewId()
+	candidate2 := model.NewId()
+
+	_, appErr := th.Service.AcquireLeadership(namespace, "invalid name", candidate1, time.Minute)
+	require.NotNil(t, appErr)
+	assert.Equal(t, "app.leader_election.name.app_error", appErr.Id)
+
+	leader, appErr := th.Service.GetLeader(namespace, "task")
+	require.Nil(t, appErr)
+	assert.Empty(t, leader)
+
+	elected, appErr := th.Service.AcquireLeadership(namespace, "task", candidate1, time.Minute)
+	require.Nil(t, appErr)
+	require.True(t, elected)
+
+	elected, appErr = th.Service.AcquireLeadership(namespace, "task", candidate2, time.Minute)
+	require.Nil(t, appErr)
+	require.False(t, elected)
+
+	// The elections are per namespace.
+	elected, appErr = th.Service.AcquireLeadership("com.mattermost.other", "task", candidate2, time.Minute)
+	require.Nil(t, appErr)
+	require.True(t, elected)
+
+	elected, appErr = th.Service.AcquireLeadership(namespace, "task", candidate1, time.Minute)
+	require.Nil(t, appErr)
+	require.True(t, elected, "the leader should renew its leadership")
+
+	leader, appErr = th.Service.GetLeader(namespace, "task")
+	require.Nil(t, appErr)
+	assert.Equal(t, candidate1, leader)
+
+	require.Nil(t, th.Service.ReleaseLeadership(namespace, "task", candidate2))
+	leader, appErr = th.Service.GetLeader(namespace, "task")
+	require.Nil(t, appErr)
+	assert.Equal(t, candidate1, leader, "only the leader should release the leadership")
+
+	require.Nil(t, th.Service.ReleaseLeadership(namespace, "task", candidate1))
+	elected, appErr = th.Service.AcquireLeadership(namespace, "task", candidate2, time.Minute)
+	require.Nil(t, appErr)
+	require.True(t, elected)
+
+	t.Run("expired leadership", func(t *testing.T) {
+		elected, appErr := th.Service.AcquireLeadership(namespace, "expiring", candidate1, time.Second)
+		require.Nil(t, appErr)
+		require.True(t, elected)
+
+		require.Eventually(t, func() bool {
+			elected, appErr := th.Service.AcquireLeadership(namespace, "expiring", candidate2, time.Minute)
+			require.Nil(t, appErr)
+			return elected
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+}
+
+func TestLeaderElection(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	_, err := th.Service.NewLeaderElection("playbooks", "")
+	require.Error(t, err)
+
+	newElection := func() *leaderElection {
+		return &leaderElection{
+			ps:        th.Service,
+			namespace: "playbooks",
+			name:      "metrics_updater",
+			id:        model.NewId(),
+			lease:     3 * time.Second,
+		}
+	}
+	election1 := newElection()
+	election2 := newElection()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	leaders := election2.Observe(ctx)
+	assert.Equal(t, "", <-leaders)
+
+	require.NoError(t, election1.Campaign(context.Background()))
+	assert.True(t, election1.IsLeader())
+	assert.Equal(t, election1.ID(), <-leaders)
+
+	timeoutCtx, timeoutCancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer timeoutCancel()
+	require.ErrorIs(t, election2.Campaign(timeoutCtx), context.DeadlineExceeded)
+	assert.False(t, election2.IsLeader())
+
+	// The leadership is kept past the lease as it's renewed.
+	time.Sleep(4 * time.Second)
+	leader, err := election2.Leader()
+	require.NoError(t, err)
+	assert.Equal(t, election1.ID(), leader)
+
+	require.NoError(t, election1.Resign())
+	assert.False(t, election1.IsLeader())
+
+	require.NoError(t, election2.Campaign(context.Background()))
+	assert.True(t, election2.IsLeader())
+	require.NoError(t, election2.Resign())
+}
@@ -12,10 +12,26 @@ import (
 const LinkCacheSize = 10000
 const LinkCacheDuration = 1 * time.Hour
 
-var linkCache = cache.NewLRU(cache.LRUOptions{
+var linkCache cache.Cache = cache.NewLRU(cache.LRUOptions{
 	Size: LinkCacheSize,
 })
 
+// initSharedLinkCache replaces the in-memory link cache with a cache shared by the nodes of
+// the cluster, for a link previewed by a node not to be fetched again by the others.
+func initSharedLinkCache(provider cache.Provider) error {
+	c, err := provider.NewCache(&cache.CacheOptions{
+		Size:   LinkCacheSize,
+		Name:   "LinkMetadata",
+		Shared: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	linkCache = c
+	return nil
+}
+
 func PurgeLinkCache() {
 	linkCache.Purge()
 }
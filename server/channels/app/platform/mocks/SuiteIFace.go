@@ -14,6 +14,22 @@ type SuiteIFace struct {
 	mock.Mock
 }
 
+// CheckWebSocketIPAccess provides a mock function with given fields: session, ipAddress, bypassToken
+func (_m *SuiteIFace) CheckWebSocketIPAccess(session *model.Session, ipAddress string, bypassToken string) *model.AppError {
+	ret := _m.Called(session, ipAddress, bypassToken)
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(*model.Session, string, string) *model.AppError); ok {
+		r0 = rf(session, ipAddress, bypassToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
 // GetSession provides a mock function with given fields: token
 func (_m *SuiteIFace) GetSession(token string) (*model.Session, *model.AppError) {
 	ret := _m.Called(token)
@@ -108,7 +108,7 @@ func SetLogger(logger *mlog.Logger) Option {
 
 func SetCluster(cluster einterfaces.ClusterInterface) Option {
 	return func(ps *PlatformService) error {
-		ps.clusterIFace = cluster
+		ps.clusterIFace = newClusterTransport(ps, cluster)
 		return nil
 	}
 }
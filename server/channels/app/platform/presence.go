@@ -0,0 +1,136 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// presenceSubscriber identifies a websocket connection subscribed to the presence of a user.
+type presenceSubscriber struct {
+	connectionID string
+	userID       string
+}
+
+// presenceSubscriptions keeps track of the users each websocket connection on this node
+// wants presence updates for. Connections are tracked by their connection ID, so that
+// the subscriptions survive a client reconnecting with the same connection ID.
+type presenceSubscriptions struct {
+	mut sync.RWMutex
+	// byUser maps a user ID to the connections subscribed to its presence.
+	byUser map[string]map[string]presenceSubscriber
+	// byConnection maps a connection ID to the user IDs it is subscribed to.
+	byConnection map[string][]string
+}
+
+func newPresenceSubscriptions() *presenceSubscriptions {
+	return &presenceSubscriptions{
+		byUser:       make(map[string]map[string]presenceSubscriber),
+		byConnection: make(map[string][]string),
+	}
+}
+
+// set replaces the subscriptions of the given connection.
+func (p *presenceSubscriptions) set(sub presenceSubscriber, userIDs []string) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	p.removeLocked(sub.connectionID)
+	if len(userIDs) == 0 {
+		return
+	}
+
+	for _, userID := range userIDs {
+		subs, ok := p.byUser[userID]
+		if !ok {
+			subs = make(map[string]presenceSubscriber)
+			p.byUser[userID] = subs
+		}
+		subs[sub.connectionID] = sub
+	}
+	p.byConnection[sub.connectionID] = userIDs
+}
+
+// remove drops all subscriptions of the given connection.
+func (p *presenceSubscriptions) remove(connectionID string) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	p.removeLocked(connectionID)
+}
+
+func (p *presenceSubscriptions) removeLocked(connectionID string) {
+	for _, userID := range p.byConnection[connectionID] {
+		subs := p.byUser[userID]
+		delete(subs, connectionID)
+		if len(subs) == 0 {
+			delete(p.byUser, userID)
+		}
+	}
+	delete(p.byConnection, connectionID)
+}
+
+// subscribers returns the connections subscribed to the presence of the given user.
+func (p *presenceSubscriptions) subscribers(userID string) []presenceSubscriber {
+	p.mut.RLock()
+	defer p.mut.RUnlock()
+
+	subs := make([]presenceSubscriber, 0, len(p.byUser[userID]))
+	for _, sub := range p.byUser[userID] {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+// SetPresenceSubscriptions replaces the users the given connection receives status changes for.
+// An empty list removes all of the connection's subscriptions.
+func (ps *PlatformService) SetPresenceSubscriptions(wc *WebConn, userIDs []string) {
+	ps.presence.set(presenceSubscriber{
+		connectionID: wc.GetConnectionID(),
+		userID:       wc.UserId,
+	}, userIDs)
+}
+
+// RemovePresenceSubscriptions removes all subscriptions of the given connection.
+func (ps *PlatformService) RemovePresenceSubscriptions(connectionID string) {
+	ps.presence.remove(connectionID)
+}
+
+// publishPresence sends a status change to the connections subscribed to the user's
+// presence, on this node and on the rest of the cluster.
+func (ps *PlatformService) publishPresence(status *model.Status) {
+	ps.publishPresenceSkipClusterSend(status)
+
+	if ps.clusterIFace != nil {
+		statusJSON, err := json.Marshal(status)
+		if err != nil {
+			ps.logger.Warn("Failed to encode status to JSON", mlog.Err(err))
+			return
+		}
+		ps.clusterIFace.SendClusterMessage(&model.ClusterMessage{
+			Event:    model.ClusterEventPresenceChanged,
+			SendType: model.ClusterSendBestEffort,
+			Data:     statusJSON,
+		})
+	}
+}
+
+func (ps *PlatformService) publishPresenceSkipClusterSend(status *model.Status) {
+	for _, sub := range ps.presence.subscribers(status.UserId) {
+		// The user's own connections already get the change through BroadcastStatus.
+		if sub.userID == status.UserId {
+			continue
+		}
+
+		event := model.NewWebSocketEvent(model.WebsocketEventStatusChange, "", "", sub.userID, nil, "")
+		event.GetBroadcast().ConnectionId = sub.connectionID
+		event.Add("status", status.Status)
+		event.Add("user_id", status.UserId)
+		ps.PublishSkipClusterSend(event)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPresenceSubscriptions(t *testing.T) {
+	p := newPresenceSubscriptions()
+
+	sub1 := presenceSubscriber{connectionID: model.NewId(), userID: model.NewId()}
+	sub2 := presenceSubscriber{connectionID: model.NewId(), userID: model.NewId()}
+	user1 := model.NewId()
+	user2 := model.NewId()
+
+	p.set(sub1, []string{user1, user2})
+	p.set(sub2, []string{user1})
+
+	assert.ElementsMatch(t, []presenceSubscriber{sub1, sub2}, p.subscribers(user1))
+	assert.ElementsMatch(t, []presenceSubscriber{sub1}, p.subscribers(user2))
+
+	t.Run("set replaces previous subscriptions", func(t *testing.T) {
+		p.set(sub1, []string{user2})
+		assert.ElementsMatch(t, []presenceSubscriber{sub2}, p.subscribers(user1))
+		assert.ElementsMatch(t, []presenceSubscriber{sub1}, p.subscribers(user2))
+	})
+
+	t.Run("remove drops all subscriptions of a connection", func(t *testing.T) {
+		p.remove(sub1.connectionID)
+		assert.Empty(t, p.subscribers(user2))
+		_, ok := p.byUser[user2]
+		require.False(t, ok)
+		assert.Len(t, p.subscribers(user1), 1)
+	})
+
+	t.Run("empty list unsubscribes", func(t *testing.T) {
+		p.set(sub2, nil)
+		assert.Empty(t, p.subscribers(user1))
+		assert.Empty(t, p.byConnection)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
 	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store/archivelayer"
 	"github.com/mattermost/mattermost-server/v6/server/channels/store/localcachelayer"
 	"github.com/mattermost/mattermost-server/v6/server/channels/store/retrylayer"
 	"github.com/mattermost/mattermost-server/v6/server/channels/store/searchlayer"
@@ -42,13 +43,16 @@ type PlatformService struct {
 
 	configStore *config.Store
 
-	filestore filestore.FileBackend
+	filestore   filestore.FileBackend
+	postArchive archivelayer.PostArchive
 
 	cacheProvider cache.Provider
 	statusCache   cache.Cache
 	sessionCache  cache.Cache
 	sessionPool   sync.Pool
 
+	presence *presenceSubscriptions
+
 	asymmetricSigningKey atomic.Value
 	clientConfig         atomic.Value
 	clientConfigHash     atomic.Value
@@ -79,6 +83,9 @@ type PlatformService struct {
 	clusterIFace           einterfaces.ClusterInterface
 	Busy                   *Busy
 
+	// nodeID identifies this node when clustering isn't enabled.
+	nodeID string
+
 	SearchEngine            *searchengine.Broker
 	searchConfigListenerId  string
 	searchLicenseListenerId string
@@ -110,7 +117,7 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 	ps := &PlatformService{
 		Store:               sc.Store,
 		configStore:         sc.ConfigStore,
-		clusterIFace:        sc.Cluster,
+		nodeID:              model.NewId(),
 		hashSeed:            maphash.MakeSeed(),
 		goroutineExitSignal: make(chan struct{}, 1),
 		goroutineBuffered:   make(chan struct{}, runtime.NumCPU()),
@@ -122,17 +129,11 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 				return &model.Session{}
 			},
 		},
+		presence:                  newPresenceSubscriptions(),
 		licenseListeners:          map[string]func(*model.License, *model.License){},
 		additionalClusterHandlers: map[model.ClusterEvent]einterfaces.ClusterMessageHandler{},
 	}
-
-	// Step 1: Cache provider.
-	// At the moment we only have this implementation
-	// in the future the cache provider will be built based on the loaded config
-	ps.cacheProvider = cache.NewProvider()
-	if err2 := ps.cacheProvider.Connect(); err2 != nil {
-		return nil, fmt.Errorf("unable to connect to cache provider: %w", err2)
-	}
+	ps.clusterIFace = newClusterTransport(ps, sc.Cluster)
 
 	// Apply options, some of the options overrides the default config actually.
 	for _, option := range options {
@@ -156,6 +157,13 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 		ps.configStore = configStore
 	}
 
+	// Step 1: Cache provider.
+	// Depends on the config store, built from the loaded cache settings.
+	ps.cacheProvider = newCacheProvider(ps.Config().CacheSettings)
+	if err2 := ps.cacheProvider.Connect(); err2 != nil {
+		return nil, fmt.Errorf("unable to connect to cache provider: %w", err2)
+	}
+
 	// Step 2: Start logging.
 	if err := ps.initLogging(); err != nil {
 		return nil, fmt.Errorf("failed to initialize logging: %w", err)
@@ -181,6 +189,16 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 	if metricsInterfaceFn != nil && ps.metricsIFace == nil { // if the metrics interface is set by options, do not override it
 		ps.metricsIFace = metricsInterfaceFn(ps, *ps.configStore.Get().SqlSettings.DriverName, *ps.configStore.Get().SqlSettings.DataSource)
 	}
+	if ps.metricsIFace != nil {
+		ps.SearchEngine.SetMetrics(ps.metricsIFace)
+		ps.cacheProvider.SetMetrics(ps.metricsIFace)
+	}
+
+	if *ps.Config().CacheSettings.CacheType == model.CacheTypeRedis {
+		if err := initSharedLinkCache(ps.cacheProvider); err != nil {
+			return nil, fmt.Errorf("unable to create link cache: %w", err)
+		}
+	}
 
 	// Step 6: Store.
 	// Depends on Step 0 (config), 1 (cacheProvider), 3 (search engine), 5 (metrics) and cluster.
@@ -198,8 +216,10 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 				return nil, fmt.Errorf("cannot create local cache layer: %w", err2)
 			}
 
+			ps.postArchive = archivelayer.NewFileArchive(ps.ColdFileBackend)
+
 			searchStore := searchlayer.NewSearchLayer(
-				lcl,
+				archivelayer.New(lcl, ps.postArchive),
 				ps.SearchEngine,
 				ps.Config(),
 			)
@@ -242,6 +262,7 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 	// Needed before loading license
 	ps.statusCache, err = ps.cacheProvider.NewCache(&cache.CacheOptions{
 		Size:           model.StatusCacheSize,
+		Name:           "Status",
 		Striped:        true,
 		StripedBuckets: maxInt(runtime.NumCPU()-1, 1),
 	})
@@ -251,8 +272,10 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 
 	ps.sessionCache, err = ps.cacheProvider.NewCache(&cache.CacheOptions{
 		Size:           model.SessionCacheSize,
+		Name:           "Session",
 		Striped:        true,
 		StripedBuckets: maxInt(runtime.NumCPU()-1, 1),
+		Shared:         true,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not create session cache: %w", err)
@@ -375,7 +398,7 @@ func (ps *PlatformService) SetLogger(logger *mlog.Logger) {
 
 func (ps *PlatformService) initEnterprise() {
 	if clusterInterface != nil && ps.clusterIFace == nil {
-		ps.clusterIFace = clusterInterface(ps)
+		ps.clusterIFace = newClusterTransport(ps, clusterInterface(ps))
 	}
 
 	if elasticsearchInterface != nil {
@@ -422,6 +445,19 @@ func (ps *PlatformService) Shutdown() error {
 	return nil
 }
 
+// newCacheProvider creates the cache provider of the configured cache type.
+func newCacheProvider(cfg model.CacheSettings) cache.Provider {
+	if *cfg.CacheType == model.CacheTypeRedis {
+		return cache.NewRedisProvider(cache.RedisOptions{
+			Address:  *cfg.RedisAddress,
+			Password: *cfg.RedisPassword,
+			DB:       *cfg.RedisDB,
+		})
+	}
+
+	return cache.NewProvider()
+}
+
 func (ps *PlatformService) CacheProvider() cache.Provider {
 	return ps.cacheProvider
 }
@@ -469,3 +505,16 @@ func (ps *PlatformService) GetPluginStatuses() (model.PluginStatuses, *model.App
 func (ps *PlatformService) FileBackend() filestore.FileBackend {
 	return ps.filestore
 }
+
+// ColdFileBackend returns the file backend of the cold storage file store, as currently configured.
+func (ps *PlatformService) ColdFileBackend() (filestore.FileBackend, error) {
+	cfg := ps.Config()
+	insecure := cfg.ServiceSettings.EnableInsecureOutgoingConnections
+	return filestore.NewFileBackend(cfg.ColdStorageSettings.ToFileBackendSettings(insecure != nil && *insecure, *cfg.FileSettings.AmazonS3RequestTimeoutMilliseconds))
+}
+
+// PostArchive returns the archive the posts older than ColdStorageSettings.PostsAfterDays are
+// moved to, or nil when the store has been overridden.
+func (ps *PlatformService) PostArchive() archivelayer.PostArchive {
+	return ps.postArchive
+}
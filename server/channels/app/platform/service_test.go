@@ -143,6 +143,9 @@ func TestMetrics(t *testing.T) {
 		mockMetricsImpl.On("Register").Return()
 		mockMetricsImpl.On("ObserveStoreMethodDuration", mock.Anything, mock.Anything, mock.Anything).Return()
 		mockMetricsImpl.On("RegisterDBCollector", mock.AnythingOfType("*sql.DB"), "master")
+		mockMetricsImpl.On("IncrementCacheHitCounter", mock.Anything).Maybe()
+		mockMetricsImpl.On("IncrementCacheMissCounter", mock.Anything).Maybe()
+		mockMetricsImpl.On("IncrementCacheEvictionCounter", mock.Anything).Maybe()
 
 		th := Setup(t, StartMetrics(), func(ps *PlatformService) error {
 			ps.metricsIFace = mockMetricsImpl
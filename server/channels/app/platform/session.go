@@ -132,6 +132,7 @@ func (ps *PlatformService) RevokeSessionsFromAllUsers() error {
 	}
 
 	ps.ClearAllUsersSessionCache()
+	ps.DisconnectWebConns("")
 	return nil
 }
 
@@ -164,6 +165,7 @@ func (ps *PlatformService) RevokeSession(session *model.Session) error {
 	}
 
 	ps.ClearUserSessionCache(session.UserId)
+	ps.DisconnectWebConns(session.UserId, session.Id)
 
 	return nil
 }
@@ -193,6 +195,7 @@ func (ps *PlatformService) RevokeAccessToken(token string) error {
 
 	if session != nil {
 		ps.ClearUserSessionCache(session.UserId)
+		ps.DisconnectWebConns(session.UserId, session.Id)
 	}
 
 	return nil
@@ -257,6 +260,7 @@ func (ps *PlatformService) RevokeAllSessions(userID string) error {
 	}
 
 	ps.ClearUserSessionCache(userID)
+	ps.DisconnectWebConns(userID)
 
 	return nil
 }
@@ -166,6 +166,8 @@ func (ps *PlatformService) BroadcastStatus(status *model.Status) {
 	event.Add("status", status.Status)
 	event.Add("user_id", status.UserId)
 	ps.Publish(event)
+
+	ps.publishPresence(status)
 }
 
 func (ps *PlatformService) SaveAndBroadcastStatus(status *model.Status) {
@@ -46,6 +46,16 @@ const (
 
 const websocketMessagePluginPrefix = "custom_"
 
+type missedEventsRequest struct {
+	sequence int64
+	result   chan missedEventsResult
+}
+
+type missedEventsResult struct {
+	count    int
+	complete bool
+}
+
 type pluginWSPostedHook struct {
 	connectionID string
 	userID       string
@@ -60,6 +70,13 @@ type WebConnConfig struct {
 	ConnectionID string
 	Active       bool
 	ReuseCount   int
+	// Encoding is the encoding the client asked its messages to be sent in.
+	// It defaults to model.WebSocketEncodingJSON.
+	Encoding string
+	// IPAddress and IPAccessBypassToken are those of the upgrade request. They are used
+	// to evaluate the IP access policies when the client authenticates afterwards.
+	IPAddress           string
+	IPAccessBypassToken string
 
 	// These aren't necessary to be exported to api layer.
 	sequence         int
@@ -105,13 +122,22 @@ type WebConn struct {
 	// a reused connection.
 	// It's theoretically possible for this number to wrap around. But we
 	// leave that as an edge-case.
-	reuseCount   int
+	reuseCount int
+	// encoding is the encoding messages are sent to the client in.
+	encoding     string
 	sessionToken atomic.Value
+	sessionID    atomic.Value
 	session      atomic.Value
 	connectionID atomic.Value
 	endWritePump chan struct{}
 	pumpFinished chan struct{}
 	pluginPosted chan pluginWSPostedHook
+	missedEvents chan *missedEventsRequest
+	// writePumpFinished is closed once the write pump stops serving missed events requests.
+	writePumpFinished chan struct{}
+	// ipAddress and ipBypassToken are those of the upgrade request.
+	ipAddress     string
+	ipBypassToken string
 }
 
 // CheckConnResult indicates whether a connectionID was present in the hub or not.
@@ -212,13 +238,19 @@ func (ps *PlatformService) NewWebConn(cfg *WebConnConfig, suite SuiteIFace, runn
 		Locale:             cfg.Locale,
 		active:             cfg.Active,
 		reuseCount:         cfg.ReuseCount,
+		encoding:           cfg.Encoding,
+		ipAddress:          cfg.IPAddress,
+		ipBypassToken:      cfg.IPAccessBypassToken,
 		endWritePump:       make(chan struct{}),
 		pumpFinished:       make(chan struct{}),
 		pluginPosted:       make(chan pluginWSPostedHook, 10),
+		missedEvents:       make(chan *missedEventsRequest),
+		writePumpFinished:  make(chan struct{}),
 	}
 
 	wc.SetSession(&cfg.Session)
 	wc.SetSessionToken(cfg.Session.Token)
+	wc.SetSessionID(cfg.Session.Id)
 	wc.SetSessionExpiresAt(cfg.Session.ExpiresAt)
 	wc.SetConnectionID(cfg.ConnectionID)
 
@@ -269,6 +301,17 @@ func (wc *WebConn) SetSessionToken(v string) {
 	wc.sessionToken.Store(v)
 }
 
+// GetSessionID returns the id of the session the connection authenticated with, which is kept
+// when the session is dropped from the cache.
+func (wc *WebConn) GetSessionID() string {
+	return wc.sessionID.Load().(string)
+}
+
+// SetSessionID sets the id of the session the connection authenticated with.
+func (wc *WebConn) SetSessionID(v string) {
+	wc.sessionID.Store(v)
+}
+
 // SetConnectionID sets the connection id of the connection.
 func (wc *WebConn) SetConnectionID(id string) {
 	wc.connectionID.Store(id)
@@ -377,6 +420,11 @@ func (wc *WebConn) readPump() {
 			wc.Platform.WebSocketRouter.ServeWebSocket(wc, &req)
 		}
 
+		// Support access sessions only read data, the plugins not being told about their messages.
+		if wc.GetSession().IsSupportAccess() {
+			continue
+		}
+
 		clonedReq, err := req.Clone()
 		if err != nil {
 			wc.logSocketErr("websocket.cloneRequest", err)
@@ -395,6 +443,7 @@ func (wc *WebConn) writePump() {
 		ticker.Stop()
 		authTicker.Stop()
 		wc.WebSocket.Close()
+		close(wc.writePumpFinished)
 	}()
 
 	if wc.Sequence != 0 {
@@ -447,14 +496,12 @@ func (wc *WebConn) writePump() {
 			evt, evtOk := msg.(*model.WebSocketEvent)
 
 			buf.Reset()
-			var err error
 			if evtOk {
 				evt = evt.SetSequence(wc.Sequence)
-				err = evt.Encode(enc)
+				msg = evt
 				wc.Sequence++
-			} else {
-				err = enc.Encode(msg)
 			}
+			msgType, err := wc.encodeMessage(&buf, enc, msg)
 			if err != nil {
 				mlog.Warn("Error in encoding websocket message", mlog.Err(err))
 				continue
@@ -477,7 +524,7 @@ func (wc *WebConn) writePump() {
 				wc.addToDeadQueue(evt)
 			}
 
-			if err := wc.writeMessageBuf(websocket.TextMessage, buf.Bytes()); err != nil {
+			if err := wc.writeMessageBuf(msgType, buf.Bytes()); err != nil {
 				wc.logSocketErr("websocket.send", err)
 				return
 			}
@@ -491,6 +538,14 @@ func (wc *WebConn) writePump() {
 				return
 			}
 
+		case req := <-wc.missedEvents:
+			count, complete, err := wc.replayDeadQueue(req.sequence)
+			req.result <- missedEventsResult{count: count, complete: complete}
+			if err != nil {
+				wc.logSocketErr("websocket.replayDeadQueue", err)
+				return
+			}
+
 		case <-wc.endWritePump:
 			return
 
@@ -515,14 +570,33 @@ func (wc *WebConn) writeMessage(msg *model.WebSocketEvent) error {
 	// We don't use the encoder from the write pump because it's unwieldy to pass encoders
 	// around, and this is only called during initialization of the webConn.
 	var buf bytes.Buffer
-	err := msg.Encode(json.NewEncoder(&buf))
+	msgType, err := wc.encodeMessage(&buf, json.NewEncoder(&buf), msg)
 	if err != nil {
 		mlog.Warn("Error in encoding websocket message", mlog.Err(err))
 		return nil
 	}
 	wc.Sequence++
 
-	return wc.writeMessageBuf(websocket.TextMessage, buf.Bytes())
+	return wc.writeMessageBuf(msgType, buf.Bytes())
+}
+
+// encodeMessage encodes the message into buf using the encoding the client asked for,
+// and returns the websocket message type it has to be sent as.
+func (wc *WebConn) encodeMessage(buf *bytes.Buffer, enc *json.Encoder, msg model.WebSocketMessage) (int, error) {
+	evt, evtOk := msg.(*model.WebSocketEvent)
+
+	if wc.encoding == model.WebSocketEncodingMsgpack {
+		menc := model.NewWebSocketMsgpackEncoder(buf)
+		if evtOk {
+			return websocket.BinaryMessage, evt.EncodeMsgpack(menc)
+		}
+		return websocket.BinaryMessage, menc.Encode(msg)
+	}
+
+	if evtOk {
+		return websocket.TextMessage, evt.Encode(enc)
+	}
+	return websocket.TextMessage, enc.Encode(msg)
 }
 
 // addToDeadQueue appends a message to the dead queue.
@@ -620,6 +694,61 @@ func (wc *WebConn) drainDeadQueue(index int) error {
 	return nil
 }
 
+// ReplayMissedEvents re-sends the events from the given sequence number onwards, so a client
+// that detected a gap in the sequence numbers of the events it received can catch up without
+// refetching its data. The events keep their original sequence numbers. complete is false if
+// some of the events are no longer in the dead queue, in which case the client needs to refetch.
+func (wc *WebConn) ReplayMissedEvents(seq int64) (count int, complete bool) {
+	req := &missedEventsRequest{
+		sequence: seq,
+		result:   make(chan missedEventsResult, 1),
+	}
+
+	select {
+	case wc.missedEvents <- req:
+	case <-wc.writePumpFinished:
+		return 0, false
+	}
+
+	res := <-req.result
+	return res.count, res.complete
+}
+
+// replayDeadQueue writes the events from the dead queue starting at the given sequence number.
+// It must only be called from the write pump.
+func (wc *WebConn) replayDeadQueue(seq int64) (int, bool, error) {
+	if seq >= wc.Sequence {
+		// Nothing was missed.
+		return 0, true, nil
+	}
+
+	ok, index := wc.isInDeadQueue(seq)
+	if !ok {
+		return 0, false, nil
+	}
+
+	count := 0
+	for i := 0; i < deadQueueSize; i++ {
+		evt := wc.deadQueue[(index+i)%deadQueueSize]
+		// Stop once the queue rolls over to older events.
+		if evt == nil || evt.GetSequence() < seq {
+			break
+		}
+
+		var buf bytes.Buffer
+		msgType, err := wc.encodeMessage(&buf, json.NewEncoder(&buf), evt)
+		if err != nil {
+			mlog.Warn("Error in encoding websocket message", mlog.Err(err))
+			continue
+		}
+		if err := wc.writeMessageBuf(msgType, buf.Bytes()); err != nil {
+			return count, false, err
+		}
+		count++
+	}
+	return count, true, nil
+}
+
 // InvalidateCache resets all internal data of the WebConn.
 func (wc *WebConn) InvalidateCache() {
 	wc.allChannelMembers = nil
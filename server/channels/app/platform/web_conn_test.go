@@ -241,3 +241,57 @@ func TestWebConnDrainDeadQueue(t *testing.T) {
 		t.Run("Overwritten First", func(t *testing.T) { run(int64(128), deadQueueSize+10) })
 	})
 }
+
+func TestWebConnReplayDeadQueue(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	run := func(t *testing.T, seqNum int64, limit int, expectedCount int, expectedComplete bool) {
+		received := make(chan []int64, 1)
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			upgrader := &websocket.Upgrader{}
+			conn, err := upgrader.Upgrade(w, req, nil)
+			var seqs []int64
+			var buf []byte
+			for err == nil {
+				_, buf, err = conn.ReadMessage()
+				if err == nil {
+					ev, jsonErr := model.WebSocketEventFromJSON(bytes.NewReader(buf))
+					require.NoError(t, jsonErr)
+					seqs = append(seqs, ev.GetSequence())
+				}
+			}
+			received <- seqs
+		}))
+		defer s.Close()
+
+		d := websocket.Dialer{}
+		c, _, err := d.Dial("ws://"+s.Listener.Addr().String()+"/ws", nil)
+		require.NoError(t, err)
+		wc := th.Service.NewWebConn(&WebConnConfig{WebSocket: c}, th.Suite, &hookRunner{})
+
+		for i := 0; i < limit; i++ {
+			msg := model.NewWebSocketEvent("", "", "", "", map[string]bool{}, "")
+			msg = msg.SetSequence(int64(i))
+			wc.addToDeadQueue(msg)
+		}
+		wc.Sequence = int64(limit)
+
+		count, complete, err := wc.replayDeadQueue(seqNum)
+		require.NoError(t, err)
+		assert.Equal(t, expectedCount, count)
+		assert.Equal(t, expectedComplete, complete)
+		wc.WebSocket.Close()
+
+		seqs := <-received
+		require.Len(t, seqs, expectedCount)
+		for i, seq := range seqs {
+			assert.Equal(t, seqNum+int64(i), seq)
+		}
+	}
+
+	t.Run("Middle", func(t *testing.T) { run(t, 4, 10, 6, true) })
+	t.Run("Nothing missed", func(t *testing.T) { run(t, 10, 10, 0, true) })
+	t.Run("Cycled Queue", func(t *testing.T) { run(t, 130, deadQueueSize+10, 8, true) })
+	t.Run("Overwritten", func(t *testing.T) { run(t, 5, deadQueueSize+10, 0, false) })
+}
@@ -4,6 +4,7 @@
 package platform
 
 import (
+	"encoding/json"
 	"hash/maphash"
 	"runtime"
 	"runtime/debug"
@@ -24,6 +25,7 @@ type SuiteIFace interface {
 	GetSession(token string) (*model.Session, *model.AppError)
 	RolesGrantPermission(roleNames []string, permissionId string) bool
 	UserCanSeeOtherUser(userID string, otherUserId string) (bool, *model.AppError)
+	CheckWebSocketIPAccess(session *model.Session, ipAddress string, bypassToken string) *model.AppError
 }
 
 type webConnActivityMessage struct {
@@ -43,6 +45,11 @@ type webConnSessionMessage struct {
 	isRegistered chan bool
 }
 
+type webConnDisconnectMessage struct {
+	userID     string
+	sessionIDs map[string]bool
+}
+
 type webConnCheckMessage struct {
 	userID       string
 	connectionID string
@@ -64,6 +71,7 @@ type Hub struct {
 	stop            chan struct{}
 	didStop         chan struct{}
 	invalidateUser  chan string
+	disconnect      chan *webConnDisconnectMessage
 	activity        chan *webConnActivityMessage
 	directMsg       chan *webConnDirectMessage
 	explicitStop    bool
@@ -81,6 +89,7 @@ func newWebHub(ps *PlatformService) *Hub {
 		stop:            make(chan struct{}),
 		didStop:         make(chan struct{}),
 		invalidateUser:  make(chan string),
+		disconnect:      make(chan *webConnDisconnectMessage),
 		activity:        make(chan *webConnActivityMessage),
 		directMsg:       make(chan *webConnDirectMessage),
 		checkRegistered: make(chan *webConnSessionMessage),
@@ -234,6 +243,31 @@ func (ps *PlatformService) InvalidateCacheForUserTeams(userID string) {
 	}
 }
 
+// DisconnectWebConns closes, on every node of the cluster, the websocket connections of the
+// user opened with one of the given sessions, or all of the user's connections if no session id
+// is given. An empty userID closes every websocket connection.
+func (ps *PlatformService) DisconnectWebConns(userID string, sessionIDs ...string) {
+	ps.DisconnectWebConnsSkipClusterSend(userID, sessionIDs)
+
+	if ps.clusterIFace != nil {
+		data, err := json.Marshal(&webConnDisconnect{
+			UserId:     userID,
+			SessionIds: sessionIDs,
+		})
+		if err != nil {
+			ps.logger.Warn("Failed to encode websocket disconnection to JSON", mlog.Err(err))
+			return
+		}
+
+		msg := &model.ClusterMessage{
+			Event:    model.ClusterEventDisconnectWebConns,
+			SendType: model.ClusterSendReliable,
+			Data:     data,
+		}
+		ps.clusterIFace.SendClusterMessage(msg)
+	}
+}
+
 // UpdateWebConnUserActivity sets the LastUserActivityAt of the hub for the given session.
 func (ps *PlatformService) UpdateWebConnUserActivity(session model.Session, activityAt int64) {
 	hub := ps.GetHubForUserId(session.UserId)
@@ -333,6 +367,24 @@ func (h *Hub) InvalidateUser(userID string) {
 	}
 }
 
+// Disconnect closes the connections of the user opened with one of the given
+// session tokens, or all of the user's connections if no token is given. An
+// empty userID closes every connection of the hub.
+func (h *Hub) Disconnect(userID string, sessionIDs []string) {
+	msg := &webConnDisconnectMessage{
+		userID:     userID,
+		sessionIDs: make(map[string]bool, len(sessionIDs)),
+	}
+	for _, id := range sessionIDs {
+		msg.sessionIDs[id] = true
+	}
+
+	select {
+	case h.disconnect <- msg:
+	case <-h.stop:
+	}
+}
+
 // UpdateActivity sets the LastUserActivityAt field for the connection
 // of the user.
 func (h *Hub) UpdateActivity(userID, sessionToken string, activityAt int64) {
@@ -460,6 +512,35 @@ func (h *Hub) Start() {
 				for _, webConn := range connIndex.ForUser(userID) {
 					webConn.InvalidateCache()
 				}
+			case disconnect := <-h.disconnect:
+				var conns []*WebConn
+				if disconnect.userID == "" {
+					for webConn := range connIndex.All() {
+						conns = append(conns, webConn)
+					}
+				} else {
+					// Copied, as removing connections reorders the index.
+					conns = append(conns, connIndex.ForUser(disconnect.userID)...)
+				}
+
+				for _, webConn := range conns {
+					if len(disconnect.sessionIDs) > 0 && !disconnect.sessionIDs[webConn.GetSessionID()] {
+						continue
+					}
+
+					// Keeps the connection from authenticating again while it closes.
+					webConn.SetSessionToken("")
+					webConn.InvalidateCache()
+
+					// Inactive connections have no write pump left to close.
+					if webConn.active {
+						close(webConn.send)
+					}
+					connIndex.Remove(webConn)
+					h.platform.RemovePresenceSubscriptions(webConn.GetConnectionID())
+				}
+
+				atomic.StoreInt64(&h.connectionCount, int64(connIndex.AllActive()))
 			case activity := <-h.activity:
 				for _, webConn := range connIndex.ForUser(activity.userID) {
 					if !webConn.active {
@@ -479,6 +560,7 @@ func (h *Hub) Start() {
 					mlog.Error("webhub.broadcast: cannot send, closing websocket for user", mlog.String("user_id", directMsg.conn.UserId))
 					close(directMsg.conn.send)
 					connIndex.Remove(directMsg.conn)
+					h.platform.RemovePresenceSubscriptions(directMsg.conn.GetConnectionID())
 				}
 			case msg := <-h.broadcast:
 				if metrics := h.platform.metricsIFace; metrics != nil {
@@ -496,6 +578,7 @@ func (h *Hub) Start() {
 							mlog.Error("webhub.broadcast: cannot send, closing websocket for user", mlog.String("user_id", webConn.UserId))
 							close(webConn.send)
 							connIndex.Remove(webConn)
+							h.platform.RemovePresenceSubscriptions(webConn.GetConnectionID())
 						}
 					}
 				}
@@ -646,6 +729,7 @@ func (i *hubConnectionIndex) RemoveInactiveConnections() {
 	for conn := range i.byConnection {
 		if !conn.active && now-conn.lastUserActivityAt > i.staleThreshold.Milliseconds() {
 			i.Remove(conn)
+			conn.Platform.RemovePresenceSubscriptions(conn.GetConnectionID())
 		}
 	}
 }
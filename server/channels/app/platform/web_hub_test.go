@@ -493,6 +493,55 @@ func TestHubIsRegistered(t *testing.T) {
 	assert.False(t, th.Service.SessionIsRegistered(*session4))
 }
 
+func TestHubDisconnect(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	session, err := th.Service.CreateSession(&model.Session{
+		UserId: th.BasicUser.Id,
+	})
+	require.NoError(t, err)
+
+	session2, err := th.Service.CreateSession(&model.Session{
+		UserId: th.BasicUser.Id,
+	})
+	require.NoError(t, err)
+
+	mockSuite := &platform_mocks.SuiteIFace{}
+	mockSuite.On("GetSession", session.Token).Return(session, nil)
+	mockSuite.On("GetSession", session2.Token).Return(session2, nil)
+	th.Suite = mockSuite
+
+	s := httptest.NewServer(dummyWebsocketHandler(t))
+	defer s.Close()
+
+	th.Service.Start()
+	wc1 := registerDummyWebConn(t, th, s.Listener.Addr(), session)
+	wc2 := registerDummyWebConn(t, th, s.Listener.Addr(), session2)
+	defer wc1.Close()
+	defer wc2.Close()
+
+	require.True(t, th.Service.SessionIsRegistered(*session))
+	require.True(t, th.Service.SessionIsRegistered(*session2))
+
+	th.Service.DisconnectWebConns(th.BasicUser.Id, session.Id)
+
+	assert.False(t, th.Service.SessionIsRegistered(*session))
+	assert.True(t, th.Service.SessionIsRegistered(*session2))
+	assert.Eventually(t, func() bool {
+		select {
+		case <-wc1.pumpFinished:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.False(t, wc1.IsAuthenticated())
+
+	th.Service.DisconnectWebConns(th.BasicUser.Id)
+	assert.False(t, th.Service.SessionIsRegistered(*session2))
+}
+
 // Always run this with -benchtime=0.1s
 // See: https://github.com/golang/go/issues/27217.
 func BenchmarkHubConnIndex(b *testing.B) {
@@ -52,8 +52,15 @@ func (wr *WebSocketRouter) ServeWebSocket(conn *WebConn, r *model.WebSocketReque
 			conn.WebSocket.Close()
 			return
 		}
+
+		if appErr := conn.Suite.CheckWebSocketIPAccess(session, conn.ipAddress, conn.ipBypassToken); appErr != nil {
+			conn.WebSocket.Close()
+			return
+		}
+
 		conn.SetSession(session)
 		conn.SetSessionToken(session.Token)
+		conn.SetSessionID(session.Id)
 		conn.UserId = session.UserId
 
 		conn.Platform.HubRegister(conn)
@@ -79,6 +86,22 @@ func (wr *WebSocketRouter) ServeWebSocket(conn *WebConn, r *model.WebSocketReque
 		return
 	}
 
+	if conn.GetSession().IsSupportAccess() && !model.IsSupportAccessReadOnlyWebSocketAction(r.Action) {
+		err := model.NewAppError("ServeWebSocket", "api.web_socket_router.support_access.read_only.app_error", nil, "", http.StatusForbidden)
+		returnWebSocketError(conn.Platform, conn, r, err)
+		return
+	}
+
+	if r.Action == model.WebsocketGetMissedEvents {
+		serveMissedEvents(conn, r)
+		return
+	}
+
+	if r.Action == model.WebsocketPresenceSubscribe {
+		servePresenceSubscribe(conn, r)
+		return
+	}
+
 	handler, ok := wr.handlers[r.Action]
 	if !ok {
 		err := model.NewAppError("ServeWebSocket", "api.web_socket_router.bad_action.app_error", nil, "", http.StatusInternalServerError)
@@ -111,3 +134,88 @@ func returnWebSocketError(ps *PlatformService, conn *WebConn, r *model.WebSocket
 	errorResp := model.NewWebSocketError(r.Seq, err)
 	hub.SendMessage(conn, errorResp)
 }
+
+// serveMissedEvents replays the events a client missed, as identified by the sequence number of
+// the first missing event. The response tells the client whether all of them could be replayed.
+func serveMissedEvents(conn *WebConn, r *model.WebSocketRequest) {
+	seq, ok := sequenceNumberFromData(r.Data["sequence_number"])
+	if !ok || seq < 0 {
+		err := model.NewAppError("ServeWebSocket", "api.websocket_handler.invalid_param.app_error", map[string]any{"Name": "sequence_number"}, "", http.StatusBadRequest)
+		returnWebSocketError(conn.Platform, conn, r, err)
+		return
+	}
+
+	count, complete := conn.ReplayMissedEvents(seq)
+
+	hub := conn.Platform.GetHubForUserId(conn.UserId)
+	if hub == nil {
+		return
+	}
+	hub.SendMessage(conn, model.NewWebSocketResponse(model.StatusOk, r.Seq, map[string]any{
+		"count":    count,
+		"complete": complete,
+	}))
+}
+
+// servePresenceSubscribe replaces the users the connection gets status changes for, and
+// responds with their current statuses.
+func servePresenceSubscribe(conn *WebConn, r *model.WebSocketRequest) {
+	userIDs := model.ArrayFromInterface(r.Data["user_ids"])
+	if len(userIDs) > model.PresenceSubscriptionsMaxUsers {
+		err := model.NewAppError("ServeWebSocket", "api.websocket_handler.presence_subscribe.too_many_users.app_error", map[string]any{"Max": model.PresenceSubscriptionsMaxUsers}, "", http.StatusBadRequest)
+		returnWebSocketError(conn.Platform, conn, r, err)
+		return
+	}
+	for _, userID := range userIDs {
+		if !model.IsValidId(userID) {
+			err := model.NewAppError("ServeWebSocket", "api.websocket_handler.invalid_param.app_error", map[string]any{"Name": "user_ids"}, "", http.StatusBadRequest)
+			returnWebSocketError(conn.Platform, conn, r, err)
+			return
+		}
+	}
+
+	conn.Platform.SetPresenceSubscriptions(conn, userIDs)
+
+	statuses := map[string]any{}
+	if len(userIDs) > 0 {
+		var err *model.AppError
+		if statuses, err = conn.Platform.GetStatusesByIds(userIDs); err != nil {
+			returnWebSocketError(conn.Platform, conn, r, err)
+			return
+		}
+	}
+
+	hub := conn.Platform.GetHubForUserId(conn.UserId)
+	if hub == nil {
+		return
+	}
+	hub.SendMessage(conn, model.NewWebSocketResponse(model.StatusOk, r.Seq, statuses))
+}
+
+// sequenceNumberFromData converts a number decoded from either a JSON or a msgpack request.
+func sequenceNumberFromData(v any) (int64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return int64(n), true
+	case int64:
+		return n, true
+	case int32:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int8:
+		return int64(n), true
+	case int:
+		return int64(n), true
+	case uint64:
+		return int64(n), true
+	case uint32:
+		return int64(n), true
+	case uint16:
+		return int64(n), true
+	case uint8:
+		return int64(n), true
+	default:
+		return 0, false
+	}
+}
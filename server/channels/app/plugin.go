@@ -44,11 +44,14 @@ var _ product.RouterService = (*routerService)(nil)
 type routerService struct {
 	mu        sync.Mutex
 	routerMap map[string]*mux.Router
+	// localRouterMap holds the routers only served through the local mode socket.
+	localRouterMap map[string]*mux.Router
 }
 
 func newRouterService() *routerService {
 	return &routerService{
-		routerMap: make(map[string]*mux.Router),
+		routerMap:      make(map[string]*mux.Router),
+		localRouterMap: make(map[string]*mux.Router),
 	}
 }
 
@@ -58,11 +61,26 @@ func (rs *routerService) RegisterRouter(productID string, sub *mux.Router) {
 	rs.routerMap[productID] = sub
 }
 
+// RegisterLocalRouter registers the router of the product endpoints only reachable through the
+// local mode socket. These are never served on the public listener.
+func (rs *routerService) RegisterLocalRouter(productID string, sub *mux.Router) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.localRouterMap[productID] = sub
+}
+
 func (rs *routerService) getHandler(productID string) (http.Handler, bool) {
 	handler, ok := rs.routerMap[productID]
 	return handler, ok
 }
 
+func (rs *routerService) getLocalHandler(productID string) (http.Handler, bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	handler, ok := rs.localRouterMap[productID]
+	return handler, ok
+}
+
 // GetPluginsEnvironment returns the plugin environment for use if plugins are enabled and
 // initialized.
 //
@@ -136,46 +154,51 @@ func (ch *Channels) syncPluginsActiveState() {
 			}
 		}
 
-		// Concurrently activate/deactivate each plugin appropriately.
+		// Concurrently deactivate any plugins that have been disabled.
 		var wg sync.WaitGroup
-
-		// Deactivate any plugins that have been disabled.
 		for _, plugin := range disabledPlugins {
 			wg.Add(1)
 			go func(plugin *model.BundleInfo) {
 				defer wg.Done()
-
-				deactivated := pluginsEnvironment.Deactivate(plugin.Manifest.Id)
-				if deactivated && plugin.Manifest.HasClient() {
-					message := model.NewWebSocketEvent(model.WebsocketEventPluginDisabled, "", "", "", nil, "")
-					message.Add("manifest", plugin.Manifest.ClientManifest())
-					ch.srv.platform.Publish(message)
-				}
+				ch.deactivatePlugin(pluginsEnvironment, plugin)
 			}(plugin)
 		}
+		wg.Wait()
 
-		// Activate any plugins that have been enabled
-		for _, plugin := range enabledPlugins {
-			wg.Add(1)
-			go func(plugin *model.BundleInfo) {
-				defer wg.Done()
+		// Activate any plugins that have been enabled, after the plugins they depend on.
+		batches, err := plugin.ActivationOrder(enabledPlugins)
+		if err != nil {
+			ch.srv.Log().Error("Unable to order the activation of plugins", mlog.Err(err))
+		}
+		for _, batch := range batches {
+			for _, plugin := range batch {
+				wg.Add(1)
+				go func(plugin *model.BundleInfo) {
+					defer wg.Done()
+
+					// The plugin is restarted to fail its activation when one of its
+					// dependencies was deactivated.
+					if pluginsEnvironment.IsActive(plugin.Manifest.Id) && pluginsEnvironment.CheckDependencies(plugin.Manifest) != nil {
+						ch.deactivatePlugin(pluginsEnvironment, plugin)
+					}
 
-				pluginID := plugin.Manifest.Id
-				updatedManifest, activated, err := pluginsEnvironment.Activate(pluginID)
-				if err != nil {
-					plugin.WrapLogger(ch.srv.Log()).Error("Unable to activate plugin", mlog.Err(err))
-					return
-				}
+					pluginID := plugin.Manifest.Id
+					updatedManifest, activated, err := pluginsEnvironment.Activate(pluginID)
+					if err != nil {
+						plugin.WrapLogger(ch.srv.Log()).Error("Unable to activate plugin", mlog.Err(err))
+						return
+					}
 
-				if activated {
-					// Notify all cluster clients if ready
-					if err := ch.notifyPluginEnabled(updatedManifest); err != nil {
-						ch.srv.Log().Error("Failed to notify cluster on plugin enable", mlog.Err(err))
+					if activated {
+						// Notify all cluster clients if ready
+						if err := ch.notifyPluginEnabled(updatedManifest); err != nil {
+							ch.srv.Log().Error("Failed to notify cluster on plugin enable", mlog.Err(err))
+						}
 					}
-				}
-			}(plugin)
+				}(plugin)
+			}
+			wg.Wait()
 		}
-		wg.Wait()
 	} else { // If plugins are disabled, shutdown plugins.
 		pluginsEnvironment.Shutdown()
 	}
@@ -185,6 +208,15 @@ func (ch *Channels) syncPluginsActiveState() {
 	}
 }
 
+func (ch *Channels) deactivatePlugin(pluginsEnvironment *plugin.Environment, pluginInfo *model.BundleInfo) {
+	deactivated := pluginsEnvironment.Deactivate(pluginInfo.Manifest.Id)
+	if deactivated && pluginInfo.Manifest.HasClient() {
+		message := model.NewWebSocketEvent(model.WebsocketEventPluginDisabled, "", "", "", nil, "")
+		message.Add("manifest", pluginInfo.Manifest.ClientManifest())
+		ch.srv.platform.Publish(message)
+	}
+}
+
 func (a *App) NewPluginAPI(c *request.Context, manifest *model.Manifest) plugin.API {
 	return NewPluginAPI(a, c, manifest)
 }
@@ -239,6 +271,13 @@ func (ch *Channels) initPlugins(c *request.Context, pluginDir, webappPluginDir s
 		mlog.Error("Failed to start up plugins", mlog.Err(err))
 		return
 	}
+	// The products run along with the plugins, as part of the server.
+	products := make(map[string]string, len(ch.srv.products))
+	for name := range ch.srv.products {
+		products[name] = model.CurrentVersion
+	}
+	env.SetProducts(products)
+
 	ch.pluginsLock.Lock()
 	ch.pluginsEnvironment = env
 	ch.pluginsLock.Unlock()
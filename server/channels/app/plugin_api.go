@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
@@ -504,7 +505,7 @@ func (api *PluginAPI) SearchUsers(search *model.UserSearch) ([]*model.User, *mod
 		AllowInactive: search.AllowInactive,
 		Limit:         search.Limit,
 	}
-	return api.app.SearchUsers(search, pluginSearchUsersOptions)
+	return api.app.SearchUsers(api.ctx, search, pluginSearchUsersOptions)
 }
 
 func (api *PluginAPI) SearchPostsInTeam(teamID string, paramsList []*model.SearchParams) ([]*model.Post, *model.AppError) {
@@ -1263,3 +1264,28 @@ func (api *PluginAPI) GetUploadSession(uploadID string) (*model.UploadSession, e
 	}
 	return fi, nil
 }
+
+func (api *PluginAPI) CampaignForLeadership(name string) (bool, error) {
+	ps := api.app.Srv().Platform()
+	elected, appErr := ps.AcquireLeadership(api.id, name, ps.NodeID(), model.LeaderElectionLeaseSeconds*time.Second)
+	if appErr != nil {
+		return false, appErr
+	}
+	return elected, nil
+}
+
+func (api *PluginAPI) ResignLeadership(name string) error {
+	ps := api.app.Srv().Platform()
+	if appErr := ps.ReleaseLeadership(api.id, name, ps.NodeID()); appErr != nil {
+		return appErr
+	}
+	return nil
+}
+
+func (api *PluginAPI) GetLeader(name string) (string, error) {
+	leader, appErr := api.app.Srv().Platform().GetLeader(api.id, name)
+	if appErr != nil {
+		return "", appErr
+	}
+	return leader, nil
+}
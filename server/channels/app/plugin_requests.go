@@ -15,6 +15,7 @@ import (
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/plugin"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
 	"github.com/mattermost/mattermost-server/v6/server/channels/utils"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
 )
@@ -51,6 +52,24 @@ func (ch *Channels) ServePluginRequest(w http.ResponseWriter, r *http.Request) {
 	ch.servePluginRequest(w, r, hooks.ServeHTTP)
 }
 
+// ServeLocalProductRequest serves requests received through the local mode socket by forwarding
+// them to the local router registered by the product. Plugins can't be reached this way, and no
+// user is associated with the request.
+func (ch *Channels) ServeLocalProductRequest(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	handler, ok := ch.routerSvc.getLocalHandler(params["plugin_id"])
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	r.Header.Del("Mattermost-Plugin-ID")
+	r.Header.Del("Mattermost-User-Id")
+	r.URL.Path = strings.TrimPrefix(r.URL.Path, path.Join("/plugins", params["plugin_id"]))
+
+	handler.ServeHTTP(w, r)
+}
+
 func (a *App) ServeInterPluginRequest(w http.ResponseWriter, r *http.Request, sourcePluginId, destinationPluginId string) {
 	pluginsEnvironment := a.ch.GetPluginsEnvironment()
 	if pluginsEnvironment == nil {
@@ -206,6 +225,27 @@ func (ch *Channels) servePluginRequest(w http.ResponseWriter, r *http.Request, h
 		}
 
 		if (session != nil && session.Id != "") && err == nil && csrfCheckPassed {
+			// Support access sessions only read data, be it from the plugins.
+			if session.IsSupportAccess() && !model.IsSupportAccessReadOnlyPluginRequest(r.Method) {
+				appErr := model.NewAppError("ServePluginRequest", "api.context.support_access.read_only.app_error", nil, "", http.StatusForbidden)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(appErr.StatusCode)
+				w.Write([]byte(appErr.ToJSON()))
+				return
+			}
+
+			c := request.EmptyContext(ch.srv.Log())
+			c.SetIPAddress(context.IPAddress)
+			c.SetPath(r.URL.Path)
+			c.SetUserAgent(r.UserAgent())
+			c.SetSession(session)
+			if appErr := New(ServerConnector(ch)).CheckIPAccess(c, session.UserId, session.Roles, r.Header.Get(model.HeaderIPAccessBypassToken)); appErr != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(appErr.StatusCode)
+				w.Write([]byte(appErr.ToJSON()))
+				return
+			}
+
 			r.Header.Set("Mattermost-User-Id", session.UserId)
 			context.SessionId = session.Id
 		}
@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -151,3 +152,139 @@ func TestServePluginPublicRequest(t *testing.T) {
 		assert.Equal(t, "/subpath/plugins/testplugin2/file.txt", rr.Header()["Location"][0])
 	})
 }
+
+func TestServePluginRequestSupportAccess(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var served int
+	router := mux.NewRouter()
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	}
+	router.HandleFunc("/api/items", handler)
+	router.HandleFunc("/search", handler)
+	th.App.ch.routerSvc.RegisterRouter("testproduct", router)
+
+	_, appErr := th.App.GrantSupportAccessConsent(th.Context, th.BasicUser.Id, &model.SupportAccessConsentRequest{AdminId: th.SystemAdminUser.Id})
+	require.Nil(t, appErr)
+	session, appErr := th.App.CreateSupportAccessSession(th.Context, th.BasicUser.Id, th.SystemAdminUser.Id)
+	require.Nil(t, appErr)
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, "/plugins/testproduct"+path, nil)
+		require.NoError(t, err)
+		req.Header.Set(model.HeaderAuth, model.HeaderBearer+" "+session.Token)
+		req = mux.SetURLVars(req, map[string]string{"plugin_id": "testproduct"})
+
+		rr := httptest.NewRecorder()
+		th.App.ch.ServePluginRequest(rr, req)
+		return rr
+	}
+
+	rr := serve(http.MethodGet, "/api/items")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, 1, served)
+
+	rr = serve(http.MethodPost, "/api/items")
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Equal(t, 1, served, "support access sessions can't write through the plugins")
+
+	// Unlike the API routes, the plugin routes can't be told apart by their path
+	rr = serve(http.MethodPost, "/search")
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Equal(t, 1, served)
+}
+
+func TestServePluginRequestIPAccess(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var served int
+	router := mux.NewRouter()
+	router.HandleFunc("/api/items", func(w http.ResponseWriter, r *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	})
+	th.App.ch.routerSvc.RegisterRouter("testproduct", router)
+
+	bypassToken := model.NewRandomString(model.IPAccessSettingsEmergencyBypassTokenMinLength)
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.IPAccessSettings.Enable = true
+		cfg.IPAccessSettings.Policies = []*model.IPAccessPolicy{{
+			Role:         model.NewString(model.SystemAdminRoleId),
+			AllowedCIDRs: []string{"10.0.0.0/8"},
+		}}
+		*cfg.IPAccessSettings.EmergencyBypassToken = bypassToken
+	})
+
+	serve := func(user *model.User, remoteAddr string, header map[string]string) *httptest.ResponseRecorder {
+		session, appErr := th.App.CreateSession(&model.Session{UserId: user.Id, Roles: user.GetRawRoles()})
+		require.Nil(t, appErr)
+
+		req, err := http.NewRequest(http.MethodGet, "/plugins/testproduct/api/items", nil)
+		require.NoError(t, err)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set(model.HeaderAuth, model.HeaderBearer+" "+session.Token)
+		for key, value := range header {
+			req.Header.Set(key, value)
+		}
+		req = mux.SetURLVars(req, map[string]string{"plugin_id": "testproduct"})
+
+		rr := httptest.NewRecorder()
+		th.App.ch.ServePluginRequest(rr, req)
+		return rr
+	}
+
+	rr := serve(th.SystemAdminUser, "192.0.2.1:1234", nil)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Equal(t, 0, served)
+
+	rr = serve(th.SystemAdminUser, "10.0.0.1:1234", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, 1, served)
+
+	rr = serve(th.SystemAdminUser, "192.0.2.1:1234", map[string]string{model.HeaderIPAccessBypassToken: bypassToken})
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, 2, served)
+
+	rr = serve(th.BasicUser, "192.0.2.1:1234", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, 3, served)
+}
+
+func TestServeLocalProductRequest(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/items", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	localRouter := mux.NewRouter()
+	localRouter.HandleFunc("/local/reset", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	th.App.ch.routerSvc.RegisterRouter("testproduct", router)
+	th.App.ch.routerSvc.RegisterLocalRouter("testproduct", localRouter)
+
+	serve := func(handler http.HandlerFunc, url string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, url, nil)
+		require.NoError(t, err)
+		req = mux.SetURLVars(req, map[string]string{"plugin_id": "testproduct"})
+
+		rr := httptest.NewRecorder()
+		handler(rr, req)
+		return rr
+	}
+
+	rr := serve(th.App.ch.ServeLocalProductRequest, "/plugins/testproduct/local/reset")
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = serve(th.App.ch.ServeLocalProductRequest, "/plugins/testproduct/api/items")
+	assert.Equal(t, http.StatusNotFound, rr.Code, "the public routes aren't served on the local socket")
+
+	rr = serve(th.App.ch.ServePluginRequest, "/plugins/testproduct/local/reset")
+	assert.Equal(t, http.StatusNotFound, rr.Code, "the local routes aren't served on the public listener")
+}
@@ -198,6 +198,12 @@ func (a *App) CreatePost(c request.CTX, post *model.Post, channel *model.Channel
 
 	post.SanitizeProps()
 
+	if post.IsPinned {
+		if err = a.checkPinnedPostsLimit(channel.Id); err != nil {
+			return nil, err
+		}
+	}
+
 	var pchan chan store.StoreResult
 	if post.RootId != "" {
 		pchan = make(chan store.StoreResult, 1)
@@ -244,6 +250,7 @@ func (a *App) CreatePost(c request.CTX, post *model.Post, channel *model.Channel
 
 	// Verify the parent/child relationships are correct
 	var parentPostList *model.PostList
+	var rootPost *model.Post
 	if pchan != nil {
 		result := <-pchan
 		if result.NErr != nil {
@@ -254,7 +261,7 @@ func (a *App) CreatePost(c request.CTX, post *model.Post, channel *model.Channel
 			return nil, model.NewAppError("createPost", "api.post.create_post.channel_root_id.app_error", nil, "", http.StatusInternalServerError)
 		}
 
-		rootPost := parentPostList.Posts[post.RootId]
+		rootPost = parentPostList.Posts[post.RootId]
 		if rootPost.RootId != "" {
 			return nil, model.NewAppError("createPost", "api.post.create_post.root_id.app_error", nil, "", http.StatusBadRequest)
 		}
@@ -320,6 +327,18 @@ func (a *App) CreatePost(c request.CTX, post *model.Post, channel *model.Channel
 		post.CreateAt = model.GetMillis()
 	}
 
+	if appErr := a.setPostExpiry(post); appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.setPostRestriction(post, rootPost); appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.checkEncryptedEnvelope(post, channel); appErr != nil {
+		return nil, appErr
+	}
+
 	post = a.getEmbedsAndImages(c, post, true)
 	previewPost := post.GetPreviewPost()
 	if previewPost != nil {
@@ -368,6 +387,13 @@ func (a *App) CreatePost(c request.CTX, post *model.Post, channel *model.Channel
 		}
 	}
 
+	// Files are attached to the post first, so that they are bridged with it.
+	if a.matrixBridgeEnabled() {
+		a.Srv().Go(func() {
+			a.sendPostToMatrix(c, pluginPost)
+		})
+	}
+
 	// Normally, we would let the API layer call PreparePostForClient, but we do it here since it also needs
 	// to be done when we send the post over the websocket in handlePostEvents
 	// PS: we don't want to include PostPriority from the db to avoid the replica lag,
@@ -653,6 +679,18 @@ func (a *App) UpdatePost(c *request.Context, post *model.Post, safeUpdate bool)
 		newPost.HasReactions = post.HasReactions
 		newPost.FileIds = post.FileIds
 		newPost.SetProps(post.GetProps())
+		keepPostExpiry(newPost, oldPost)
+		keepPostRestriction(newPost, oldPost)
+	}
+
+	if err = a.checkUpdatedEncryptedEnvelope(newPost, oldPost, channel); err != nil {
+		return nil, err
+	}
+
+	if newPost.IsPinned && !oldPost.IsPinned {
+		if err = a.checkPinnedPostsLimit(channel.Id); err != nil {
+			return nil, err
+		}
 	}
 
 	// Avoid deep-equal checks if EditAt was already modified through message change
@@ -701,6 +739,12 @@ func (a *App) UpdatePost(c *request.Context, post *model.Post, safeUpdate bool)
 		}, plugin.MessageHasBeenUpdatedID)
 	})
 
+	if a.matrixBridgeEnabled() {
+		a.Srv().Go(func() {
+			a.sendPostEditToMatrix(c, pluginNewPost)
+		})
+	}
+
 	rpost = a.PreparePostForClientWithEmbedsAndImages(c, rpost, false, true, true)
 
 	// Ensure IsFollowing is nil since this updated post will be broadcast to all users
@@ -719,6 +763,9 @@ func (a *App) UpdatePost(c *request.Context, post *model.Post, safeUpdate bool)
 		return nil, model.NewAppError("UpdatePost", "app.post.marshal.app_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
 	}
 	message.Add("post", postJSON)
+	if appErr := a.omitRestrictedPostUsers(message, rpost); appErr != nil {
+		return nil, appErr
+	}
 
 	published, err := a.publishWebsocketEventForPermalinkPost(c, rpost, message)
 	if err != nil {
@@ -771,6 +818,11 @@ func (a *App) publishWebsocketEventForPermalinkPost(c request.CTX, post *model.P
 
 	permalinkPreviewedPost := post.GetPreviewPost()
 	for _, cm := range channelMembers {
+		// events sent to a user aren't checked against the omitted users
+		if message.GetBroadcast().OmitUsers[cm.UserId] {
+			continue
+		}
+
 		if permalinkPreviewedPost != nil {
 			post.Metadata.Embeds[0].Data = permalinkPreviewedPost
 		}
@@ -1267,7 +1319,17 @@ func (a *App) DeletePost(c request.CTX, postID, deleteByID string) (*model.Post,
 		return nil, appErr
 	}
 
-	err = a.Srv().Store().Post().Delete(postID, model.GetMillis(), deleteByID)
+	return a.deletePost(c, post, deleteByID)
+}
+
+func (a *App) deletePost(c request.CTX, post *model.Post, deleteByID string) (*model.Post, *model.AppError) {
+	postID := post.Id
+	omitUsers, appErr := a.restrictedPostOmitUsers(post, nil)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	err := a.Srv().Store().Post().Delete(postID, model.GetMillis(), deleteByID)
 	if err != nil {
 		var nfErr *store.ErrNotFound
 		switch {
@@ -1286,12 +1348,14 @@ func (a *App) DeletePost(c request.CTX, postID, deleteByID string) (*model.Post,
 	userMessage := model.NewWebSocketEvent(model.WebsocketEventPostDeleted, "", post.ChannelId, "", nil, "")
 	userMessage.Add("post", string(postJSON))
 	userMessage.GetBroadcast().ContainsSanitizedData = true
+	addOmitUsers(userMessage, omitUsers)
 	a.Publish(userMessage)
 
 	adminMessage := model.NewWebSocketEvent(model.WebsocketEventPostDeleted, "", post.ChannelId, "", nil, "")
 	adminMessage.Add("post", string(postJSON))
 	adminMessage.Add("delete_by", deleteByID)
 	adminMessage.GetBroadcast().ContainsSensitiveData = true
+	addOmitUsers(adminMessage, omitUsers)
 	a.Publish(adminMessage)
 
 	if len(post.FileIds) > 0 {
@@ -1304,6 +1368,20 @@ func (a *App) DeletePost(c request.CTX, postID, deleteByID string) (*model.Post,
 	a.Srv().Go(func() {
 		a.deleteFlaggedPosts(post.Id)
 	})
+	a.Srv().Go(func() {
+		a.deletePostTranslations(post.Id)
+	})
+	a.Srv().Go(func() {
+		a.deletePostEmbeddings(post.Id)
+	})
+	a.Srv().Go(func() {
+		a.deletePostActivities(post.Id)
+	})
+	if a.matrixBridgeEnabled() {
+		a.Srv().Go(func() {
+			a.sendPostDeletionToMatrix(c, post)
+		})
+	}
 
 	a.invalidateCacheForChannelPosts(post.ChannelId)
 
@@ -1568,7 +1646,7 @@ func (a *App) SearchPostsForUser(c *request.Context, terms string, userID string
 		return model.MakePostSearchResults(model.NewPostList(), nil), nil
 	}
 
-	postSearchResults, err := a.Srv().Store().Post().SearchPostsForUser(finalParamsList, userID, teamID, page, perPage)
+	postSearchResults, err := a.Srv().Store().Post().SearchPostsForUser(c.Context(), finalParamsList, userID, teamID, page, perPage)
 	if err != nil {
 		var appErr *model.AppError
 		switch {
@@ -1583,6 +1661,10 @@ func (a *App) SearchPostsForUser(c *request.Context, terms string, userID string
 		return nil, appErr
 	}
 
+	if appErr := a.FilterRestrictedPostList(userID, postSearchResults.PostList); appErr != nil {
+		return nil, appErr
+	}
+
 	return postSearchResults, nil
 }
 
@@ -1969,6 +2051,14 @@ func (a *App) GetPostIfAuthorized(c request.CTX, postID string, session *model.S
 		}
 	}
 
+	visible, err := a.IsPostVisibleToUser(session.UserId, post)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, model.NewAppError("GetPostIfAuthorized", "app.post.get.app_error", nil, "", http.StatusNotFound)
+	}
+
 	return post, nil
 }
 
@@ -1993,6 +2083,9 @@ func (a *App) GetPostsByIds(postIDs []string) ([]*model.Post, int64, *model.AppE
 	return posts, firstInaccessiblePostTime, nil
 }
 
+// GetEditHistoryForPost returns the previous revisions of the post, newest first. The revisions
+// replaced before the message retention cutoff of the data retention policy are left out, as they
+// are due to be deleted.
 func (a *App) GetEditHistoryForPost(postID string) ([]*model.Post, *model.AppError) {
 	posts, err := a.Srv().Store().Post().GetEditHistoryForPost(postID)
 
@@ -2006,6 +2099,28 @@ func (a *App) GetEditHistoryForPost(postID string) ([]*model.Post, *model.AppErr
 		}
 	}
 
+	if a.DataRetention() != nil {
+		policy, appErr := a.DataRetention().GetGlobalPolicy()
+		if appErr != nil {
+			return nil, appErr
+		}
+		if !policy.MessageDeletionEnabled {
+			return posts, nil
+		}
+
+		retained := make([]*model.Post, 0, len(posts))
+		for _, post := range posts {
+			// The DeleteAt of a revision is when it was replaced by the next one.
+			if post.DeleteAt >= policy.MessageRetentionCutoff {
+				retained = append(retained, post)
+			}
+		}
+		if len(retained) == 0 {
+			return nil, model.NewAppError("GetEditHistoryForPost", "app.post.get.app_error", nil, "", http.StatusNotFound)
+		}
+		posts = retained
+	}
+
 	return posts, nil
 }
 
@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// ArchivePosts moves the posts from the database to the post archive, writing a segment of the
+// archive per channel. The posts are only deleted from the database once the segments holding
+// them have been written, along with their locations.
+func (a *App) ArchivePosts(posts []*model.Post) *model.AppError {
+	archive := a.Srv().Platform().PostArchive()
+	if archive == nil {
+		return model.NewAppError("ArchivePosts", "app.post_archive.no_archive.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	byChannel := make(map[string][]*model.Post)
+	for _, post := range posts {
+		byChannel[post.ChannelId] = append(byChannel[post.ChannelId], post)
+	}
+
+	archived := make([]*model.ArchivedPost, 0, len(posts))
+	for channelID, channelPosts := range byChannel {
+		segment, err := archive.WriteSegment(channelID, channelPosts)
+		if err != nil {
+			return model.NewAppError("ArchivePosts", "app.post_archive.write.app_error", nil, "channel_id="+channelID, http.StatusInternalServerError).Wrap(err)
+		}
+		for _, post := range channelPosts {
+			archived = append(archived, model.NewArchivedPost(post, segment))
+		}
+	}
+
+	if err := a.Srv().Store().ArchivedPost().Archive(archived); err != nil {
+		return model.NewAppError("ArchivePosts", "app.post_archive.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
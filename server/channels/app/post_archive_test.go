@@ -0,0 +1,59 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestArchivePosts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ColdStorageSettings.DriverName = model.ImageDriverLocal
+		*cfg.ColdStorageSettings.Directory = t.TempDir()
+	})
+
+	root := th.CreatePost(th.BasicChannel)
+	reply, appErr := th.App.CreatePost(th.Context, &model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		RootId:    root.Id,
+		Message:   "reply_" + model.NewId(),
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+	other := th.CreatePost(th.CreateChannel(th.Context, th.BasicTeam))
+
+	posts, err := th.App.Srv().Store().Post().GetPostsByIds([]string{root.Id, reply.Id, other.Id})
+	require.NoError(t, err)
+	appErr = th.App.ArchivePosts(posts)
+	require.Nil(t, appErr)
+
+	archived, err := th.App.Srv().Store().ArchivedPost().GetByIds([]string{root.Id, reply.Id, other.Id})
+	require.NoError(t, err)
+	require.Len(t, archived, 3)
+
+	t.Run("archived posts are still served", func(t *testing.T) {
+		post, appErr := th.App.GetSinglePost(root.Id, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, root.Message, post.Message)
+
+		thread, err := th.App.Srv().Store().Post().Get(context.Background(), reply.Id, model.GetPostsOptions{}, th.BasicUser.Id, nil)
+		require.NoError(t, err)
+		assert.Contains(t, thread.Posts, root.Id)
+		assert.Contains(t, thread.Posts, reply.Id)
+	})
+
+	t.Run("archived posts are read-only", func(t *testing.T) {
+		_, err := th.App.Srv().Store().Post().Update(root.Clone(), root.Clone())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/i18n"
+)
+
+// checkEncryptedEnvelope checks the envelope of a post carrying a message encrypted by the clients.
+// Encrypted messages can only be sent in direct and group messages when they're enabled, and the
+// message of the post must be empty for nothing to be readable, searchable or notified in clear.
+func (a *App) checkEncryptedEnvelope(post *model.Post, channel *model.Channel) *model.AppError {
+	envelope, appErr := model.ParseEncryptedEnvelope(post.GetProp(model.PostPropsEncryptedEnvelope))
+	if appErr != nil {
+		return appErr
+	}
+	if envelope == nil {
+		return nil
+	}
+
+	if !*a.Config().ServiceSettings.EnableEncryptedMessages {
+		return model.NewAppError("checkEncryptedEnvelope", "app.post.encrypted_envelope.disabled.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !channel.IsGroupOrDirect() {
+		return model.NewAppError("checkEncryptedEnvelope", "app.post.encrypted_envelope.channel_type.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if post.Message != "" {
+		return model.NewAppError("checkEncryptedEnvelope", "app.post.encrypted_envelope.message.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if appErr := envelope.IsValid(*a.Config().ServiceSettings.EncryptedMessageMaxSize); appErr != nil {
+		return appErr
+	}
+
+	post.AddProp(model.PostPropsEncryptedEnvelope, envelope)
+	return nil
+}
+
+// checkUpdatedEncryptedEnvelope checks the envelope of an updated post. The envelope of an
+// encrypted post can be replaced, but a post can't become encrypted or stop being encrypted.
+func (a *App) checkUpdatedEncryptedEnvelope(updated, original *model.Post, channel *model.Channel) *model.AppError {
+	if updated.IsEncrypted() != original.IsEncrypted() {
+		return model.NewAppError("checkUpdatedEncryptedEnvelope", "app.post.encrypted_envelope.update.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return a.checkEncryptedEnvelope(updated, channel)
+}
+
+// getEncryptedPushNotificationMessage returns the generic text of the push notifications for
+// encrypted messages, which the server can't read.
+func getEncryptedPushNotificationMessage(senderName string, channelType model.ChannelType, userLocale i18n.TranslateFunc) string {
+	if channelType == model.ChannelTypeDirect {
+		return userLocale("api.post.send_notifications_and_forget.push_encrypted_message")
+	}
+	return senderName + userLocale("api.post.send_notifications_and_forget.push_encrypted_message_group")
+}
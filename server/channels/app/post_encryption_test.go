@@ -0,0 +1,85 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestCreateEncryptedPost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	dm := th.CreateDmChannel(th.BasicUser2)
+	newPost := func(channel *model.Channel, message string, ciphertext []byte) *model.Post {
+		post := &model.Post{
+			ChannelId: channel.Id,
+			UserId:    th.BasicUser.Id,
+			Message:   message,
+		}
+		post.AddProp(model.PostPropsEncryptedEnvelope, map[string]any{
+			"algorithm":  "x25519-aes256gcm.v1",
+			"key_ids":    []string{model.NewId()},
+			"ciphertext": base64.StdEncoding.EncodeToString(ciphertext),
+		})
+		return post
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost(dm, "", []byte("encrypted")), dm, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.encrypted_envelope.disabled.app_error", appErr.Id)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableEncryptedMessages = true
+		*cfg.ServiceSettings.EncryptedMessageMaxSize = 1024
+	})
+
+	t.Run("not in a direct message", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost(th.BasicChannel, "", []byte("encrypted")), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.encrypted_envelope.channel_type.app_error", appErr.Id)
+	})
+
+	t.Run("message in clear", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost(dm, "hello", []byte("encrypted")), dm, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.encrypted_envelope.message.app_error", appErr.Id)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost(dm, "", []byte(strings.Repeat("a", 1024))), dm, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.post.encrypted_envelope.ciphertext_size.app_error", appErr.Id)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		post, appErr := th.App.CreatePost(th.Context, newPost(dm, "", []byte("encrypted")), dm, false, true)
+		require.Nil(t, appErr)
+		assert.True(t, post.IsEncrypted())
+
+		t.Run("can't be edited in clear", func(t *testing.T) {
+			_, appErr := th.App.PatchPost(th.Context, post.Id, &model.PostPatch{Message: model.NewString("hello")})
+			require.NotNil(t, appErr)
+			assert.Equal(t, "app.post.encrypted_envelope.message.app_error", appErr.Id)
+
+			_, appErr = th.App.PatchPost(th.Context, post.Id, &model.PostPatch{Props: &model.StringInterface{}})
+			require.NotNil(t, appErr)
+			assert.Equal(t, "app.post.encrypted_envelope.update.app_error", appErr.Id)
+		})
+
+		t.Run("generic push notification", func(t *testing.T) {
+			msg := th.App.buildFullPushNotificationMessage(th.Context, model.FullNotification, post, th.BasicUser2, dm, dm.Name, th.BasicUser.Username, false, false, "")
+			assert.Equal(t, "Sent you an encrypted message.", msg.Message)
+		})
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const expiredPostsBatchSize = 100
+
+// setPostExpiry replaces the number of seconds after which the post being created expires with the
+// time at which it expires. The expiry can only be set this way, and only when expiring posts are
+// enabled.
+func (a *App) setPostExpiry(post *model.Post) *model.AppError {
+	expireAfter := post.GetProp(model.PostPropsExpireAfter)
+	if expireAfter != nil {
+		post.DelProp(model.PostPropsExpireAfter)
+	}
+	if post.GetProp(model.PostPropsExpireAt) != nil {
+		post.DelProp(model.PostPropsExpireAt)
+	}
+	if expireAfter == nil {
+		return nil
+	}
+
+	if !*a.Config().ServiceSettings.EnableExpiringPosts {
+		return model.NewAppError("setPostExpiry", "app.post.expire_after.disabled.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	seconds, appErr := model.ParsePostExpireAfter(expireAfter, *a.Config().ServiceSettings.ExpiringPostsMaxSeconds)
+	if appErr != nil {
+		return appErr
+	}
+
+	post.AddProp(model.PostPropsExpireAt, post.CreateAt+seconds*1000)
+	return nil
+}
+
+// keepPostExpiry sets the expiry of the updated post back to the one of the original post, since it
+// can't be changed.
+func keepPostExpiry(updated, original *model.Post) {
+	expireAt := original.GetExpireAt()
+	switch {
+	case expireAt != 0:
+		updated.AddProp(model.PostPropsExpireAt, expireAt)
+	case updated.GetProp(model.PostPropsExpireAt) != nil:
+		updated.DelProp(model.PostPropsExpireAt)
+	}
+}
+
+// DeleteExpiredPosts deletes the posts whose expiry has passed, as if their author deleted them.
+// The message of a deleted post is kept, so that expired posts are still included in the
+// compliance exports run after they're deleted.
+func (a *App) DeleteExpiredPosts(c request.CTX) *model.AppError {
+	now := model.GetMillis()
+	for {
+		expirations, err := a.Srv().Store().PostExpiration().GetExpiredBefore(now, expiredPostsBatchSize)
+		if err != nil {
+			return model.NewAppError("DeleteExpiredPosts", "app.post.get_expired.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		deleted := make([]string, 0, len(expirations))
+		for _, expiration := range expirations {
+			if appErr := a.deleteExpiredPost(c, expiration.PostId); appErr != nil {
+				// the post is deleted on the next run
+				c.Logger().Warn("Failed to delete expired post", mlog.String("post_id", expiration.PostId), mlog.Err(appErr))
+				continue
+			}
+			deleted = append(deleted, expiration.PostId)
+		}
+
+		if len(deleted) > 0 {
+			if err := a.Srv().Store().PostExpiration().Delete(deleted); err != nil {
+				return model.NewAppError("DeleteExpiredPosts", "app.post.delete_expired.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+
+		if len(expirations) < expiredPostsBatchSize || len(deleted) == 0 {
+			return nil
+		}
+	}
+}
+
+func (a *App) deleteExpiredPost(c request.CTX, postID string) *model.AppError {
+	post, err := a.Srv().Store().Post().GetSingle(postID, true)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil
+		}
+		return model.NewAppError("deleteExpiredPost", "app.post.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if post.DeleteAt != 0 {
+		return nil
+	}
+
+	// posts expire in archived channels too
+	_, appErr := a.deletePost(c, post, post.UserId)
+	return appErr
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestCreateExpiringPost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newPost := func(expireAfter any) *model.Post {
+		post := &model.Post{
+			ChannelId: th.BasicChannel.Id,
+			UserId:    th.BasicUser.Id,
+			Message:   "expiring",
+		}
+		post.AddProp(model.PostPropsExpireAfter, expireAfter)
+		return post
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost(float64(3600)), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.expire_after.disabled.app_error", appErr.Id)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableExpiringPosts = true
+		*cfg.ServiceSettings.ExpiringPostsMaxSeconds = 86400
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost(float64(86401)), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.post.expire_after.app_error", appErr.Id)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		post, appErr := th.App.CreatePost(th.Context, newPost(float64(3600)), th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+		assert.Equal(t, post.CreateAt+3600*1000, post.GetExpireAt())
+		assert.Nil(t, post.GetProp(model.PostPropsExpireAfter))
+	})
+
+	t.Run("expiry set by the client", func(t *testing.T) {
+		post := &model.Post{
+			ChannelId: th.BasicChannel.Id,
+			UserId:    th.BasicUser.Id,
+			Message:   "not expiring",
+		}
+		post.AddProp(model.PostPropsExpireAt, float64(model.GetMillis()))
+
+		post, appErr := th.App.CreatePost(th.Context, post, th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+		assert.Equal(t, int64(0), post.GetExpireAt())
+	})
+
+	t.Run("expiry kept on edit", func(t *testing.T) {
+		post, appErr := th.App.CreatePost(th.Context, newPost(float64(3600)), th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+		expireAt := post.GetExpireAt()
+
+		patched, appErr := th.App.PatchPost(th.Context, post.Id, &model.PostPatch{
+			Message: model.NewString("edited"),
+			Props:   &model.StringInterface{model.PostPropsExpireAt: float64(expireAt + 1000000)},
+		})
+		require.Nil(t, appErr)
+		assert.Equal(t, expireAt, patched.GetExpireAt())
+	})
+}
+
+func TestDeleteExpiredPosts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableExpiringPosts = true })
+
+	createPost := func(channel *model.Channel, createAt int64) *model.Post {
+		post := &model.Post{
+			ChannelId: channel.Id,
+			UserId:    th.BasicUser.Id,
+			Message:   "expiring",
+			CreateAt:  createAt,
+		}
+		post.AddProp(model.PostPropsExpireAfter, float64(model.PostExpireAfterMinSeconds))
+
+		post, appErr := th.App.CreatePost(th.Context, post, channel, false, true)
+		require.Nil(t, appErr)
+		return post
+	}
+
+	past := model.GetMillis() - 2*model.PostExpireAfterMinSeconds*1000
+	expired := createPost(th.BasicChannel, past)
+	notExpired := createPost(th.BasicChannel, 0)
+
+	archivedChannel := th.CreateChannel(th.Context, th.BasicTeam)
+	expiredInArchived := createPost(archivedChannel, past)
+	appErr := th.App.DeleteChannel(th.Context, archivedChannel, th.BasicUser.Id)
+	require.Nil(t, appErr)
+
+	appErr = th.App.DeleteExpiredPosts(th.Context)
+	require.Nil(t, appErr)
+
+	for _, postID := range []string{expired.Id, expiredInArchived.Id} {
+		post, err := th.App.Srv().Store().Post().GetSingle(postID, true)
+		require.NoError(t, err)
+		assert.NotZero(t, post.DeleteAt)
+		assert.True(t, post.IsExpired())
+		assert.Equal(t, "expiring", post.Message)
+	}
+
+	post, err := th.App.Srv().Store().Post().GetSingle(notExpired.Id, true)
+	require.NoError(t, err)
+	assert.Zero(t, post.DeleteAt)
+
+	expirations, err := th.App.Srv().Store().PostExpiration().GetExpiredBefore(model.GetMillis(), 100)
+	require.NoError(t, err)
+	for _, expiration := range expirations {
+		assert.NotContains(t, []string{expired.Id, expiredInArchived.Id}, expiration.PostId)
+	}
+}
@@ -196,6 +196,11 @@ func (a *App) sanitizePostMetadataForUserAndChannel(c request.CTX, post *model.P
 
 	if previewedChannel != nil && !a.HasPermissionToReadChannel(c, userID, previewedChannel) {
 		post.Metadata.Embeds[0].Data = nil
+		return post
+	}
+
+	if visible, appErr := a.IsPostVisibleToUser(userID, previewedPost.Post); appErr != nil || !visible {
+		post.Metadata.Embeds[0].Data = nil
 	}
 
 	return post
@@ -220,6 +225,18 @@ func (a *App) SanitizePostMetadataForUser(c request.CTX, post *model.Post, userI
 		for _, embed := range post.Metadata.Embeds {
 			embed.Data = nil
 		}
+		return post, nil
+	}
+
+	// restricted posts are only previewed to who can see them
+	visible, err := a.IsPostVisibleToUser(userID, previewPost.Post)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		for _, embed := range post.Metadata.Embeds {
+			embed.Data = nil
+		}
 	}
 
 	return post, nil
@@ -0,0 +1,310 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+// setPostRestriction checks the restriction of the post being created. Replies get the restriction
+// of their thread, so that they're only visible to who can see the root post.
+func (a *App) setPostRestriction(post *model.Post, rootPost *model.Post) *model.AppError {
+	if rootPost != nil {
+		visible, appErr := a.IsPostVisibleToUser(post.UserId, rootPost)
+		if appErr != nil {
+			return appErr
+		}
+		if !visible {
+			return model.NewAppError("setPostRestriction", "api.post.create_post.root_id.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		if rootPost.GetProp(model.PostPropsRestrictedTo) != nil {
+			post.AddProp(model.PostPropsRestrictedTo, rootPost.GetRestriction())
+		} else if post.GetProp(model.PostPropsRestrictedTo) != nil {
+			post.DelProp(model.PostPropsRestrictedTo)
+		}
+		return nil
+	}
+
+	restriction, appErr := model.ParsePostRestriction(post.GetProp(model.PostPropsRestrictedTo))
+	if appErr != nil {
+		return appErr
+	}
+	if restriction == nil {
+		return nil
+	}
+
+	if !a.Srv().License().HasRestrictedPosts() {
+		return model.NewAppError("setPostRestriction", "app.post.restricted_to.license.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if appErr := restriction.IsValid(); appErr != nil {
+		return appErr
+	}
+
+	post.AddProp(model.PostPropsRestrictedTo, restriction)
+	return nil
+}
+
+// keepPostRestriction sets the restriction of the updated post back to the one of the original
+// post, since it can't be changed.
+func keepPostRestriction(updated, original *model.Post) {
+	restriction := original.GetProp(model.PostPropsRestrictedTo)
+	switch {
+	case restriction != nil:
+		updated.AddProp(model.PostPropsRestrictedTo, restriction)
+	case updated.GetProp(model.PostPropsRestrictedTo) != nil:
+		updated.DelProp(model.PostPropsRestrictedTo)
+	}
+}
+
+// postVisibility tells whether a user can see restricted posts. The groups of the user are only
+// loaded once a post restricted to groups is checked.
+type postVisibility struct {
+	a        *App
+	userID   string
+	groupIDs map[string]bool
+}
+
+func (v *postVisibility) canSee(post *model.Post) (bool, *model.AppError) {
+	restriction := post.GetRestriction()
+	if restriction == nil || post.UserId == v.userID || restriction.HasUser(v.userID) {
+		return true, nil
+	}
+
+	if len(restriction.GroupIds) == 0 {
+		return false, nil
+	}
+
+	if v.groupIDs == nil {
+		groups, err := v.a.Srv().Store().Group().GetByUser(v.userID)
+		if err != nil {
+			return false, model.NewAppError("canSee", "app.select_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		v.groupIDs = make(map[string]bool, len(groups))
+		for _, group := range groups {
+			v.groupIDs[group.Id] = true
+		}
+	}
+
+	for _, groupID := range restriction.GroupIds {
+		if v.groupIDs[groupID] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsPostVisibleToUser returns whether the user can see the post, given that they can read its
+// channel. Restricted posts are only visible to their author, the users they're restricted to and
+// the members of the groups they're restricted to.
+func (a *App) IsPostVisibleToUser(userID string, post *model.Post) (bool, *model.AppError) {
+	v := &postVisibility{a: a, userID: userID}
+	return v.canSee(post)
+}
+
+// FilterRestrictedPostList removes the posts the user can't see from the post list.
+func (a *App) FilterRestrictedPostList(userID string, postList *model.PostList) *model.AppError {
+	if postList == nil {
+		return nil
+	}
+
+	v := &postVisibility{a: a, userID: userID}
+	hidden := map[string]bool{}
+	for id, post := range postList.Posts {
+		visible, appErr := v.canSee(post)
+		if appErr != nil {
+			return appErr
+		}
+		if !visible {
+			hidden[id] = true
+			delete(postList.Posts, id)
+		}
+	}
+
+	if len(hidden) == 0 {
+		return nil
+	}
+
+	order := make([]string, 0, len(postList.Order))
+	for _, id := range postList.Order {
+		if !hidden[id] {
+			order = append(order, id)
+		}
+	}
+	postList.Order = order
+	return nil
+}
+
+// FilterRestrictedPosts returns the posts the user can see.
+func (a *App) FilterRestrictedPosts(userID string, posts []*model.Post) ([]*model.Post, *model.AppError) {
+	v := &postVisibility{a: a, userID: userID}
+	visiblePosts := make([]*model.Post, 0, len(posts))
+	for _, post := range posts {
+		visible, appErr := v.canSee(post)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if visible {
+			visiblePosts = append(visiblePosts, post)
+		}
+	}
+	return visiblePosts, nil
+}
+
+// filterRestrictedFileInfoList removes from the list the files attached to posts the user can't see.
+func (a *App) filterRestrictedFileInfoList(userID string, fileList *model.FileInfoList) *model.AppError {
+	if fileList == nil || len(fileList.FileInfos) == 0 {
+		return nil
+	}
+
+	postIDs := make([]string, 0, len(fileList.FileInfos))
+	seen := map[string]bool{}
+	for _, info := range fileList.FileInfos {
+		if info.PostId != "" && !seen[info.PostId] {
+			seen[info.PostId] = true
+			postIDs = append(postIDs, info.PostId)
+		}
+	}
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	posts, err := a.Srv().Store().Post().GetPostsByIds(postIDs)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil
+		}
+		return model.NewAppError("filterRestrictedFileInfoList", "app.post.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	v := &postVisibility{a: a, userID: userID}
+	hiddenPosts := map[string]bool{}
+	for _, post := range posts {
+		visible, appErr := v.canSee(post)
+		if appErr != nil {
+			return appErr
+		}
+		if !visible {
+			hiddenPosts[post.Id] = true
+		}
+	}
+
+	if len(hiddenPosts) == 0 {
+		return nil
+	}
+
+	order := make([]string, 0, len(fileList.Order))
+	for _, id := range fileList.Order {
+		if info, ok := fileList.FileInfos[id]; ok && hiddenPosts[info.PostId] {
+			delete(fileList.FileInfos, id)
+			continue
+		}
+		order = append(order, id)
+	}
+	fileList.Order = order
+	return nil
+}
+
+// filterRestrictedThreads returns the threads whose root post the user can see.
+func (a *App) filterRestrictedThreads(userID string, threads []*model.ThreadResponse) ([]*model.ThreadResponse, *model.AppError) {
+	v := &postVisibility{a: a, userID: userID}
+	visibleThreads := make([]*model.ThreadResponse, 0, len(threads))
+	for _, thread := range threads {
+		if thread.Post != nil {
+			visible, appErr := v.canSee(thread.Post)
+			if appErr != nil {
+				return nil, appErr
+			}
+			if !visible {
+				continue
+			}
+		}
+		visibleThreads = append(visibleThreads, thread)
+	}
+	return visibleThreads, nil
+}
+
+// filterUnrestrictedPosts returns the posts visible to all the members of their channel.
+func filterUnrestrictedPosts(posts []*model.Post) []*model.Post {
+	unrestricted := make([]*model.Post, 0, len(posts))
+	for _, post := range posts {
+		if post.GetProp(model.PostPropsRestrictedTo) == nil {
+			unrestricted = append(unrestricted, post)
+		}
+	}
+	return unrestricted
+}
+
+// restrictedPostOmitUsers returns the members of the channel of the post who can't see it, to be
+// left out of the websocket events and notifications about it. It returns nil when the post isn't
+// restricted. The channel members are loaded when they aren't given.
+func (a *App) restrictedPostOmitUsers(post *model.Post, channelMembers map[string]*model.User) (map[string]bool, *model.AppError) {
+	restriction := post.GetRestriction()
+	if restriction == nil {
+		return nil, nil
+	}
+
+	if channelMembers == nil {
+		var err error
+		channelMembers, err = a.Srv().Store().User().GetAllProfilesInChannel(context.Background(), post.ChannelId, true)
+		if err != nil {
+			return nil, model.NewAppError("restrictedPostOmitUsers", "app.user.get_profiles.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	allowed := map[string]bool{post.UserId: true}
+	for _, userID := range restriction.UserIds {
+		allowed[userID] = true
+	}
+	for _, groupID := range restriction.GroupIds {
+		members, err := a.Srv().Store().Group().GetMemberUsers(groupID)
+		if err != nil {
+			return nil, model.NewAppError("restrictedPostOmitUsers", "app.select_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, member := range members {
+			allowed[member.Id] = true
+		}
+	}
+
+	omitUsers := map[string]bool{}
+	for userID := range channelMembers {
+		if !allowed[userID] {
+			omitUsers[userID] = true
+		}
+	}
+	return omitUsers, nil
+}
+
+// omitRestrictedPostUsers leaves the channel members who can't see the restricted post out of the
+// websocket event about it.
+func (a *App) omitRestrictedPostUsers(message *model.WebSocketEvent, post *model.Post) *model.AppError {
+	omitUsers, appErr := a.restrictedPostOmitUsers(post, nil)
+	if appErr != nil {
+		return appErr
+	}
+	addOmitUsers(message, omitUsers)
+	return nil
+}
+
+func addOmitUsers(message *model.WebSocketEvent, omitUsers map[string]bool) {
+	if len(omitUsers) == 0 {
+		return
+	}
+
+	broadcast := message.GetBroadcast()
+	if broadcast.OmitUsers == nil {
+		broadcast.OmitUsers = make(map[string]bool, len(omitUsers))
+	}
+	for userID := range omitUsers {
+		broadcast.OmitUsers[userID] = true
+	}
+}
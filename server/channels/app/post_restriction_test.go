@@ -0,0 +1,269 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestCreateRestrictedPost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newPost := func(restriction *model.PostRestriction) *model.Post {
+		post := &model.Post{
+			ChannelId: th.BasicChannel.Id,
+			UserId:    th.BasicUser.Id,
+			Message:   "restricted",
+		}
+		post.AddProp(model.PostPropsRestrictedTo, restriction)
+		return post
+	}
+
+	t.Run("not licensed", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost(&model.PostRestriction{UserIds: []string{th.BasicUser2.Id}}), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.restricted_to.license.app_error", appErr.Id)
+	})
+
+	th.App.Srv().SetLicense(model.NewTestLicenseSKU(model.LicenseShortSkuEnterprise))
+
+	t.Run("invalid", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost(&model.PostRestriction{}), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.post.restricted_to.empty.app_error", appErr.Id)
+	})
+
+	t.Run("replies get the restriction of their thread", func(t *testing.T) {
+		root, appErr := th.App.CreatePost(th.Context, newPost(&model.PostRestriction{UserIds: []string{th.BasicUser2.Id}}), th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		reply, appErr := th.App.CreatePost(th.Context, &model.Post{
+			ChannelId: th.BasicChannel.Id,
+			UserId:    th.BasicUser2.Id,
+			RootId:    root.Id,
+			Message:   "reply",
+		}, th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{th.BasicUser2.Id}, reply.GetRestriction().UserIds)
+
+		// users who can't see the thread can't reply to it
+		user := th.CreateUser()
+		th.LinkUserToTeam(user, th.BasicTeam)
+		th.AddUserToChannel(user, th.BasicChannel)
+		_, appErr = th.App.CreatePost(th.Context, &model.Post{
+			ChannelId: th.BasicChannel.Id,
+			UserId:    user.Id,
+			RootId:    root.Id,
+			Message:   "reply",
+		}, th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("restriction kept on edit", func(t *testing.T) {
+		post, appErr := th.App.CreatePost(th.Context, newPost(&model.PostRestriction{UserIds: []string{th.BasicUser2.Id}}), th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		patched, appErr := th.App.PatchPost(th.Context, post.Id, &model.PostPatch{
+			Message: model.NewString("edited"),
+			Props:   &model.StringInterface{},
+		})
+		require.Nil(t, appErr)
+		require.NotNil(t, patched.GetRestriction())
+		assert.Equal(t, []string{th.BasicUser2.Id}, patched.GetRestriction().UserIds)
+	})
+}
+
+func TestFilterRestrictedPostList(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicenseSKU(model.LicenseShortSkuEnterprise))
+
+	groupMember := th.CreateUser()
+	outsider := th.CreateUser()
+	for _, user := range []*model.User{groupMember, outsider} {
+		th.LinkUserToTeam(user, th.BasicTeam)
+		th.AddUserToChannel(user, th.BasicChannel)
+	}
+
+	group := th.CreateGroup()
+	_, appErr := th.App.UpsertGroupMember(group.Id, groupMember.Id)
+	require.Nil(t, appErr)
+
+	public := th.CreatePost(th.BasicChannel)
+	restricted := &model.Post{
+		ChannelId: th.BasicChannel.Id,
+		UserId:    th.BasicUser.Id,
+		Message:   "restricted",
+	}
+	restricted.AddProp(model.PostPropsRestrictedTo, &model.PostRestriction{
+		UserIds:  []string{th.BasicUser2.Id},
+		GroupIds: []string{group.Id},
+	})
+	restricted, appErr = th.App.CreatePost(th.Context, restricted, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+
+	for name, tc := range map[string]struct {
+		UserId  string
+		Visible bool
+	}{
+		"author":       {UserId: th.BasicUser.Id, Visible: true},
+		"listed user":  {UserId: th.BasicUser2.Id, Visible: true},
+		"group member": {UserId: groupMember.Id, Visible: true},
+		"outsider":     {UserId: outsider.Id, Visible: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			list := model.NewPostList()
+			for _, post := range []*model.Post{public, restricted} {
+				list.AddPost(post)
+				list.AddOrder(post.Id)
+			}
+
+			appErr := th.App.FilterRestrictedPostList(tc.UserId, list)
+			require.Nil(t, appErr)
+			assert.Contains(t, list.Order, public.Id)
+			if tc.Visible {
+				assert.Contains(t, list.Order, restricted.Id)
+				assert.Contains(t, list.Posts, restricted.Id)
+			} else {
+				assert.Equal(t, []string{public.Id}, list.Order)
+				assert.NotContains(t, list.Posts, restricted.Id)
+			}
+
+			visible, appErr := th.App.IsPostVisibleToUser(tc.UserId, restricted)
+			require.Nil(t, appErr)
+			assert.Equal(t, tc.Visible, visible)
+		})
+	}
+
+	t.Run("websocket events omit who can't see the post", func(t *testing.T) {
+		omitUsers, appErr := th.App.restrictedPostOmitUsers(restricted, nil)
+		require.Nil(t, appErr)
+		assert.Equal(t, map[string]bool{outsider.Id: true}, omitUsers)
+
+		omitUsers, appErr = th.App.restrictedPostOmitUsers(public, nil)
+		require.Nil(t, appErr)
+		assert.Nil(t, omitUsers)
+	})
+}
+
+func TestRestrictedPostPreviewsFilesAndThreads(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicenseSKU(model.LicenseShortSkuEnterprise))
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.ThreadAutoFollow = true
+		*cfg.ServiceSettings.CollapsedThreads = model.CollapsedThreadsDefaultOn
+	})
+
+	outsider := th.CreateUser()
+	th.LinkUserToTeam(outsider, th.BasicTeam)
+	th.AddUserToChannel(outsider, th.BasicChannel)
+
+	restricted := &model.Post{
+		ChannelId: th.BasicChannel.Id,
+		UserId:    th.BasicUser.Id,
+		Message:   "restricted",
+	}
+	restricted.AddProp(model.PostPropsRestrictedTo, &model.PostRestriction{UserIds: []string{th.BasicUser2.Id}})
+	restricted, appErr := th.App.CreatePost(th.Context, restricted, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+
+	t.Run("permalink previews", func(t *testing.T) {
+		newPermalinkPost := func() *model.Post {
+			return &model.Post{
+				Id:        model.NewId(),
+				ChannelId: th.BasicChannel.Id,
+				Metadata: &model.PostMetadata{
+					Embeds: []*model.PostEmbed{{
+						Type: model.PostEmbedPermalink,
+						Data: model.NewPreviewPost(restricted, th.BasicTeam, th.BasicChannel),
+					}},
+				},
+			}
+		}
+
+		post, appErr := th.App.SanitizePostMetadataForUser(th.Context, newPermalinkPost(), th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		assert.NotNil(t, post.Metadata.Embeds[0].Data)
+
+		post, appErr = th.App.SanitizePostMetadataForUser(th.Context, newPermalinkPost(), outsider.Id)
+		require.Nil(t, appErr)
+		assert.Nil(t, post.Metadata.Embeds[0].Data)
+
+		post = newPermalinkPost()
+		post = th.App.sanitizePostMetadataForUserAndChannel(th.Context, post, post.GetPreviewPost(), th.BasicChannel, outsider.Id)
+		assert.Nil(t, post.Metadata.Embeds[0].Data)
+	})
+
+	t.Run("attachments", func(t *testing.T) {
+		restrictedInfo, err := th.App.Srv().Store().FileInfo().Save(&model.FileInfo{
+			CreatorId: th.BasicUser.Id,
+			PostId:    restricted.Id,
+			Path:      "restricted.txt",
+		})
+		require.NoError(t, err)
+		publicInfo, err := th.App.Srv().Store().FileInfo().Save(&model.FileInfo{
+			CreatorId: th.BasicUser.Id,
+			PostId:    th.BasicPost.Id,
+			Path:      "public.txt",
+		})
+		require.NoError(t, err)
+
+		newList := func() *model.FileInfoList {
+			list := model.NewFileInfoList()
+			for _, info := range []*model.FileInfo{restrictedInfo, publicInfo} {
+				list.AddFileInfo(info)
+				list.AddOrder(info.Id)
+			}
+			return list
+		}
+
+		list := newList()
+		require.Nil(t, th.App.filterRestrictedFileInfoList(th.BasicUser2.Id, list))
+		assert.Equal(t, []string{restrictedInfo.Id, publicInfo.Id}, list.Order)
+
+		list = newList()
+		require.Nil(t, th.App.filterRestrictedFileInfoList(outsider.Id, list))
+		assert.Equal(t, []string{publicInfo.Id}, list.Order)
+		assert.NotContains(t, list.FileInfos, restrictedInfo.Id)
+	})
+
+	t.Run("threads", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, &model.Post{
+			ChannelId: th.BasicChannel.Id,
+			UserId:    th.BasicUser2.Id,
+			RootId:    restricted.Id,
+			Message:   "reply",
+		}, th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		// a membership the outsider shouldn't have, to check it isn't relied on
+		appErr = th.App.UpdateThreadFollowForUser(outsider.Id, th.BasicTeam.Id, restricted.Id, true)
+		require.Nil(t, appErr)
+
+		threads, appErr := th.App.GetThreadsForUser(th.BasicUser2.Id, th.BasicTeam.Id, model.GetUserThreadsOpts{})
+		require.Nil(t, appErr)
+		require.Len(t, threads.Threads, 1)
+		assert.Equal(t, restricted.Id, threads.Threads[0].PostId)
+
+		threads, appErr = th.App.GetThreadsForUser(outsider.Id, th.BasicTeam.Id, model.GetUserThreadsOpts{})
+		require.Nil(t, appErr)
+		assert.Empty(t, threads.Threads)
+
+		membership, appErr := th.App.GetThreadMembershipForUser(outsider.Id, restricted.Id)
+		require.Nil(t, appErr)
+		_, appErr = th.App.GetThreadForUser(membership, false)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+}
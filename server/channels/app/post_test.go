@@ -3169,6 +3169,8 @@ func TestGetEditHistoryForPost(t *testing.T) {
 	_, err1 := th.App.PatchPost(th.Context, rpost.Id, patch)
 	require.Nil(t, err1)
 
+	time.Sleep(1 * time.Millisecond)
+
 	// update the post message again
 	patch = &model.PostPatch{
 		Message: model.NewString("new message edited again"),
@@ -3192,6 +3194,31 @@ func TestGetEditHistoryForPost(t *testing.T) {
 		require.NotNil(t, err)
 		require.Empty(t, edits)
 	})
+
+	t.Run("should leave out the revisions replaced before the retention cutoff", func(t *testing.T) {
+		dataRetention := th.App.ch.DataRetention
+		defer func() { th.App.ch.DataRetention = dataRetention }()
+
+		policy := &model.GlobalRetentionPolicy{MessageDeletionEnabled: true, MessageRetentionCutoff: edits[0].DeleteAt}
+		mockDataRetention := &eMocks.DataRetentionInterface{}
+		mockDataRetention.On("GetGlobalPolicy").Return(policy, nil)
+		th.App.ch.DataRetention = mockDataRetention
+
+		retained, err := th.App.GetEditHistoryForPost(post.Id)
+		require.Nil(t, err)
+		require.Len(t, retained, 1)
+		require.Equal(t, "new message edited", retained[0].Message)
+
+		policy.MessageRetentionCutoff = model.GetMillis()
+		_, err = th.App.GetEditHistoryForPost(post.Id)
+		require.NotNil(t, err)
+		require.Equal(t, http.StatusNotFound, err.StatusCode)
+
+		policy.MessageDeletionEnabled = false
+		retained, err = th.App.GetEditHistoryForPost(post.Id)
+		require.Nil(t, err)
+		require.Len(t, retained, 2)
+	})
 }
 
 func TestGetTopDMsForUserSince(t *testing.T) {
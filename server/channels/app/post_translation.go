@@ -0,0 +1,110 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/translation"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// TranslatePost translates the message of a post into language or, when language is empty, into
+// the language the user reads translations in. Unless disabled by the administrator, translations
+// are stored so that each revision of a post is only sent to the provider once per language.
+func (a *App) TranslatePost(c request.CTX, post *model.Post, userID, language string) (*model.PostTranslation, *model.AppError) {
+	settings := a.Config().TranslationSettings
+	if !*settings.Enable {
+		return nil, model.NewAppError("TranslatePost", "app.post_translation.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if language == "" {
+		var appErr *model.AppError
+		if language, appErr = a.getTranslationLanguageForUser(userID); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	if !model.IsValidTranslationLanguage(language) {
+		return nil, model.NewAppError("TranslatePost", "app.post_translation.language.app_error", map[string]any{"Language": language}, "", http.StatusBadRequest)
+	}
+
+	if post.Message == "" {
+		return nil, model.NewAppError("TranslatePost", "app.post_translation.empty.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *settings.StoreTranslations {
+		postTranslation, err := a.Srv().Store().PostTranslation().Get(post.Id, language)
+		var nfErr *store.ErrNotFound
+		switch {
+		case err == nil && postTranslation.PostEditAt == post.EditAt:
+			return postTranslation, nil
+		case err != nil && !errors.As(err, &nfErr):
+			c.Logger().Warn("Failed to get stored translation", mlog.String("post_id", post.Id), mlog.Err(err))
+		}
+	}
+
+	provider, err := translation.NewProvider(settings, a.HTTPService().MakeClient(true))
+	if err != nil {
+		return nil, model.NewAppError("TranslatePost", "app.post_translation.provider.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	result, err := provider.Translate(c.Context(), post.Message, language)
+	if err != nil {
+		return nil, model.NewAppError("TranslatePost", "app.post_translation.translate.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	postTranslation := &model.PostTranslation{
+		PostId:         post.Id,
+		Language:       language,
+		SourceLanguage: result.SourceLanguage,
+		Message:        result.Text,
+		Provider:       provider.Name(),
+		PostEditAt:     post.EditAt,
+	}
+
+	if !*settings.StoreTranslations {
+		postTranslation.PreSave()
+		return postTranslation, nil
+	}
+
+	saved, err := a.Srv().Store().PostTranslation().Save(postTranslation)
+	if err != nil {
+		// The translation is still worth returning even though it will be requested from the provider again next time.
+		c.Logger().Warn("Failed to store translation", mlog.String("post_id", post.Id), mlog.Err(err))
+		return postTranslation, nil
+	}
+
+	return saved, nil
+}
+
+// getTranslationLanguageForUser returns the language the user chose to read translations in,
+// defaulting to the language of their user interface.
+func (a *App) getTranslationLanguageForUser(userID string) (string, *model.AppError) {
+	preference, appErr := a.GetPreferenceByCategoryAndNameForUser(userID, model.PreferenceCategoryDisplaySettings, model.PreferenceNameTranslationLanguage)
+	if appErr == nil && preference.Value != "" {
+		return preference.Value, nil
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return "", appErr
+	}
+
+	if user.Locale != "" {
+		return user.Locale, nil
+	}
+
+	return *a.Config().LocalizationSettings.DefaultClientLocale, nil
+}
+
+func (a *App) deletePostTranslations(postID string) {
+	if err := a.Srv().Store().PostTranslation().DeleteForPost(postID); err != nil {
+		a.Log().Warn("Encountered error when deleting translations for post", mlog.String("post_id", postID), mlog.Err(err))
+	}
+}
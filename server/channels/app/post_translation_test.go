@@ -0,0 +1,133 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// makeTestTranslationServer returns a LibreTranslate compatible server that prefixes messages with
+// the target language, along with the number of translations it served.
+func makeTestTranslationServer(t *testing.T) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+
+		var req struct {
+			Q      string `json:"q"`
+			Target string `json:"target"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"translatedText":   req.Target + ": " + req.Q,
+			"detectedLanguage": map[string]any{"language": "en"},
+		})
+	}))
+	return server, &calls
+}
+
+func TestTranslatePost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	server, calls := makeTestTranslationServer(t)
+	defer server.Close()
+
+	post := th.CreatePost(th.BasicChannel)
+
+	t.Run("disabled", func(t *testing.T) {
+		_, appErr := th.App.TranslatePost(th.Context, post, th.BasicUser.Id, "fr")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post_translation.disabled.app_error", appErr.Id)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.TranslationSettings.Enable = true
+		*cfg.TranslationSettings.Provider = model.TranslationProviderLibreTranslate
+		*cfg.TranslationSettings.APIURL = server.URL
+	})
+
+	t.Run("invalid language", func(t *testing.T) {
+		_, appErr := th.App.TranslatePost(th.Context, post, th.BasicUser.Id, "not a language")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post_translation.language.app_error", appErr.Id)
+	})
+
+	t.Run("translations are stored per language", func(t *testing.T) {
+		atomic.StoreInt32(calls, 0)
+
+		translation, appErr := th.App.TranslatePost(th.Context, post, th.BasicUser.Id, "fr")
+		require.Nil(t, appErr)
+		assert.Equal(t, "fr: "+post.Message, translation.Message)
+		assert.Equal(t, "en", translation.SourceLanguage)
+		assert.Equal(t, model.TranslationProviderLibreTranslate, translation.Provider)
+
+		translation, appErr = th.App.TranslatePost(th.Context, post, th.BasicUser.Id, "fr")
+		require.Nil(t, appErr)
+		assert.Equal(t, "fr: "+post.Message, translation.Message)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+
+		_, appErr = th.App.TranslatePost(th.Context, post, th.BasicUser.Id, "de")
+		require.Nil(t, appErr)
+		assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+	})
+
+	t.Run("editing the post invalidates stored translations", func(t *testing.T) {
+		atomic.StoreInt32(calls, 0)
+
+		edited := post.Clone()
+		edited.Message = "edited message"
+		edited, appErr := th.App.UpdatePost(th.Context, edited, false)
+		require.Nil(t, appErr)
+
+		translation, appErr := th.App.TranslatePost(th.Context, edited, th.BasicUser.Id, "fr")
+		require.Nil(t, appErr)
+		assert.Equal(t, "fr: edited message", translation.Message)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+	})
+
+	t.Run("defaults to the user's translation language", func(t *testing.T) {
+		translation, appErr := th.App.TranslatePost(th.Context, post, th.BasicUser.Id, "")
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser.Locale, translation.Language)
+
+		appErr = th.App.UpdatePreferences(th.BasicUser.Id, model.Preferences{{
+			UserId:   th.BasicUser.Id,
+			Category: model.PreferenceCategoryDisplaySettings,
+			Name:     model.PreferenceNameTranslationLanguage,
+			Value:    "es",
+		}})
+		require.Nil(t, appErr)
+
+		translation, appErr = th.App.TranslatePost(th.Context, post, th.BasicUser.Id, "")
+		require.Nil(t, appErr)
+		assert.Equal(t, "es", translation.Language)
+	})
+
+	t.Run("translations are not stored when disabled by the administrator", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TranslationSettings.StoreTranslations = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TranslationSettings.StoreTranslations = true })
+		atomic.StoreInt32(calls, 0)
+
+		otherPost := th.CreatePost(th.BasicChannel)
+		for i := 0; i < 2; i++ {
+			_, appErr := th.App.TranslatePost(th.Context, otherPost, th.BasicUser.Id, "fr")
+			require.Nil(t, appErr)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+
+		_, err := th.App.Srv().Store().PostTranslation().Get(otherPost.Id, "fr")
+		require.Error(t, err)
+	})
+}
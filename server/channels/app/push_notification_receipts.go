@@ -0,0 +1,94 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sync"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/cache"
+)
+
+const pushNotificationReceiptsCacheSize = 10000
+
+// pushNotificationReceipts keeps the receipts of the last push notifications sent to each user,
+// newest first. Receipts are kept in memory by the node which sent the notification.
+type pushNotificationReceipts struct {
+	mut   sync.Mutex
+	cache cache.Cache
+}
+
+func newPushNotificationReceipts() *pushNotificationReceipts {
+	return &pushNotificationReceipts{
+		cache: cache.NewLRU(cache.LRUOptions{
+			Name: "PushNotificationReceipts",
+			Size: pushNotificationReceiptsCacheSize,
+		}),
+	}
+}
+
+func (r *pushNotificationReceipts) get(userID string) []*model.PushNotificationReceipt {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	return r.getLocked(userID)
+}
+
+func (r *pushNotificationReceipts) getLocked(userID string) []*model.PushNotificationReceipt {
+	var receipts []*model.PushNotificationReceipt
+	if err := r.cache.Get(userID, &receipts); err != nil {
+		return []*model.PushNotificationReceipt{}
+	}
+	return receipts
+}
+
+func (r *pushNotificationReceipts) add(userID string, receipt *model.PushNotificationReceipt) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	receipts := append([]*model.PushNotificationReceipt{receipt}, r.getLocked(userID)...)
+	if len(receipts) > model.PushNotificationReceiptsPerUser {
+		receipts = receipts[:model.PushNotificationReceiptsPerUser]
+	}
+	r.cache.Set(userID, receipts)
+}
+
+// markReceived records that the device acknowledged the notification. It returns false if the
+// notification isn't known to this node.
+func (r *pushNotificationReceipts) markReceived(userID, ackID string, receivedAt int64) bool {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	receipts := r.getLocked(userID)
+	for _, receipt := range receipts {
+		if receipt.AckId == ackID {
+			receipt.Status = model.PushNotificationReceiptStatusReceived
+			receipt.ReceivedAt = receivedAt
+			r.cache.Set(userID, receipts)
+			return true
+		}
+	}
+	return false
+}
+
+// GetPushNotificationReceipts returns the receipts of the last push notifications sent to the
+// user's devices by this server, newest first.
+func (a *App) GetPushNotificationReceipts(userID string) []*model.PushNotificationReceipt {
+	return a.Srv().pushNotificationReceipts.get(userID)
+}
+
+// RecordPushNotificationAck marks the push notification acknowledged by one of the user's devices as received.
+func (a *App) RecordPushNotificationAck(userID string, ack *model.PushNotificationAck) {
+	if ack == nil {
+		return
+	}
+
+	receivedAt := ack.ClientReceivedAt
+	if receivedAt == 0 {
+		receivedAt = model.GetMillis()
+	}
+	if a.Srv().pushNotificationReceipts.markReceived(userID, ack.Id, receivedAt) && a.Metrics() != nil {
+		a.Metrics().IncrementPushNotificationDeliveryCounter(ack.ClientPlatform, model.PushNotificationReceiptStatusReceived)
+	}
+}
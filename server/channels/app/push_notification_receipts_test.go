@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPushNotificationReceipts(t *testing.T) {
+	receipts := newPushNotificationReceipts()
+	userID := model.NewId()
+
+	assert.Empty(t, receipts.get(userID))
+
+	var ackIDs []string
+	for i := 0; i < model.PushNotificationReceiptsPerUser+5; i++ {
+		ackID := model.NewId()
+		ackIDs = append(ackIDs, ackID)
+		receipts.add(userID, &model.PushNotificationReceipt{AckId: ackID, Status: model.PushNotificationReceiptStatusSent})
+	}
+
+	got := receipts.get(userID)
+	require.Len(t, got, model.PushNotificationReceiptsPerUser)
+	assert.Equal(t, ackIDs[len(ackIDs)-1], got[0].AckId, "the newest receipt should come first")
+	assert.Empty(t, receipts.get(model.NewId()))
+
+	t.Run("mark received", func(t *testing.T) {
+		assert.True(t, receipts.markReceived(userID, ackIDs[len(ackIDs)-1], 1234))
+		got := receipts.get(userID)
+		assert.Equal(t, model.PushNotificationReceiptStatusReceived, got[0].Status)
+		assert.Equal(t, int64(1234), got[0].ReceivedAt)
+
+		assert.False(t, receipts.markReceived(userID, ackIDs[0], 1234), "evicted receipts can't be marked")
+		assert.False(t, receipts.markReceived(model.NewId(), ackIDs[1], 1234))
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// quietHoursSummaries counts the notifications held back while users are in quiet hours, until
+// the summary is sent when their quiet hours end. Each node of a cluster counts the notifications
+// it held back.
+type quietHoursSummaries struct {
+	mut     sync.Mutex
+	pending map[string]*quietHoursSummary
+}
+
+type quietHoursSummary struct {
+	push  int
+	email int
+}
+
+func newQuietHoursSummaries() *quietHoursSummaries {
+	return &quietHoursSummaries{
+		pending: make(map[string]*quietHoursSummary),
+	}
+}
+
+// add counts a notification held back for the user. It returns true if it's the first one since
+// the last summary, in which case the next summary has to be scheduled.
+func (q *quietHoursSummaries) add(userID string, push bool) bool {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	summary, ok := q.pending[userID]
+	if !ok {
+		summary = &quietHoursSummary{}
+		q.pending[userID] = summary
+	}
+	if push {
+		summary.push++
+	} else {
+		summary.email++
+	}
+	return !ok
+}
+
+// take returns the notifications held back for the user and resets them.
+func (q *quietHoursSummaries) take(userID string) *quietHoursSummary {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	summary := q.pending[userID]
+	delete(q.pending, userID)
+	return summary
+}
+
+// quietHoursEnd tells whether the user is in quiet hours at the given time, and if so when they end.
+func (a *App) quietHoursEnd(user *model.User, now time.Time) (time.Time, bool) {
+	preference, err := a.Srv().Store().Preference().Get(user.Id, model.PreferenceCategoryNotifications, model.PreferenceNameQuietHours)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	quietHours, err := model.QuietHoursFromJSON(preference.Value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return quietHours.ActiveUntil(now, quietHours.Location(user.GetTimezoneLocation()))
+}
+
+// holdForQuietHours tells whether a notification to the user has to be held back because they're
+// in quiet hours, counting it in the summary sent once they end.
+func (a *App) holdForQuietHours(user *model.User, push bool) bool {
+	end, ok := a.quietHoursEnd(user, time.Now())
+	if !ok {
+		return false
+	}
+
+	if a.Srv().quietHoursSummaries.add(user.Id, push) {
+		userID := user.Id
+		time.AfterFunc(time.Until(end), func() {
+			a.sendQuietHoursSummary(userID)
+		})
+	}
+	return true
+}
+
+func (a *App) sendQuietHoursSummary(userID string) {
+	summary := a.Srv().quietHoursSummaries.take(userID)
+	if summary == nil {
+		return
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		mlog.Warn("Unable to find the recipient of the quiet hours summary", mlog.String("user_id", userID), mlog.Err(appErr))
+		return
+	}
+	T := i18n.GetUserTranslations(user.Locale)
+
+	if summary.push > 0 && a.canSendPushNotifications() {
+		msg := &model.PushNotification{
+			Version: model.PushMessageV2,
+			Type:    model.PushTypeMessage,
+			Message: T("app.quiet_hours.summary.push", summary.push),
+		}
+		if appErr := a.sendPushNotificationToAllSessions(msg, userID, ""); appErr != nil {
+			mlog.Warn("Unable to send the quiet hours summary push notification", mlog.String("user_id", userID), mlog.Err(appErr))
+		}
+	}
+
+	if summary.email > 0 {
+		subject := T("app.quiet_hours.summary.email.subject", map[string]any{"SiteName": *a.Config().TeamSettings.SiteName})
+		body := T("app.quiet_hours.summary.email.body", summary.email, map[string]any{"SiteURL": a.GetSiteURL()})
+		if err := a.Srv().EmailService.SendNotificationMail(user.Email, subject, body); err != nil {
+			mlog.Warn("Unable to send the quiet hours summary email", mlog.String("user_id", userID), mlog.Err(err))
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestQuietHoursSummaries(t *testing.T) {
+	summaries := newQuietHoursSummaries()
+	userID := model.NewId()
+
+	assert.True(t, summaries.add(userID, true), "the first notification should schedule a summary")
+	assert.False(t, summaries.add(userID, true))
+	assert.False(t, summaries.add(userID, false))
+
+	assert.Equal(t, &quietHoursSummary{push: 2, email: 1}, summaries.take(userID))
+	assert.Nil(t, summaries.take(userID))
+	assert.True(t, summaries.add(userID, false), "a new summary should be scheduled after the previous one was taken")
+}
+
+func TestHoldForQuietHours(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	assert.False(t, th.App.holdForQuietHours(th.BasicUser, true), "users without quiet hours get their notifications")
+
+	now := time.Now().UTC()
+	quietHours, err := json.Marshal(&model.QuietHours{
+		Start:    now.Add(-time.Hour).Format("15:04"),
+		End:      now.Add(time.Hour).Format("15:04"),
+		Timezone: "UTC",
+	})
+	require.NoError(t, err)
+	require.Nil(t, th.App.UpdatePreferences(th.BasicUser.Id, model.Preferences{{
+		UserId:   th.BasicUser.Id,
+		Category: model.PreferenceCategoryNotifications,
+		Name:     model.PreferenceNameQuietHours,
+		Value:    string(quietHours),
+	}}))
+
+	end, ok := th.App.quietHoursEnd(th.BasicUser, now)
+	require.True(t, ok)
+	assert.WithinDuration(t, now.Add(time.Hour), end, time.Minute)
+
+	assert.True(t, th.App.holdForQuietHours(th.BasicUser, true))
+	assert.True(t, th.App.holdForQuietHours(th.BasicUser, false))
+	assert.Equal(t, &quietHoursSummary{push: 1, email: 1}, th.App.Srv().quietHoursSummaries.take(th.BasicUser.Id))
+
+	_, ok = th.App.quietHoursEnd(th.BasicUser, now.Add(2*time.Hour))
+	assert.False(t, ok)
+}
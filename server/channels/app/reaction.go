@@ -55,6 +55,12 @@ func (a *App) SaveReactionForPost(c *request.Context, reaction *model.Reaction)
 		a.sendReactionEvent(model.WebsocketEventReactionAdded, reaction, post)
 	})
 
+	if *a.Config().ServiceSettings.EnableActivityFeed {
+		a.Srv().Go(func() {
+			a.addReactionActivity(reaction, post, channel)
+		})
+	}
+
 	return reaction, nil
 }
 
@@ -163,5 +169,9 @@ func (a *App) sendReactionEvent(event string, reaction *model.Reaction, post *mo
 		a.Log().Warn("Failed to encode reaction to JSON", mlog.Err(err))
 	}
 	message.Add("reaction", string(reactionJSON))
+	if appErr := a.omitRestrictedPostUsers(message, post); appErr != nil {
+		a.Log().Warn("Failed to get the users who can't see the post", mlog.String("post_id", post.Id), mlog.Err(appErr))
+		return
+	}
 	a.Publish(message)
 }
@@ -0,0 +1,72 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const reactionSummaryDayMillis = 24 * 60 * 60 * 1000
+
+// SummarizeReactions updates the daily reaction summaries the reaction analytics are computed from.
+// The most recently summarized day is summarized again along with every day since, so that it
+// includes the reactions added after it was last summarized. The first time it runs, the reactions
+// of the last model.ReactionSummaryBackfillDays days are summarized.
+func (a *App) SummarizeReactions(c request.CTX) *model.AppError {
+	today := model.ReactionSummaryDay(model.GetMillis())
+
+	day, err := a.Srv().Store().ReactionSummary().GetLatestDay()
+	if err != nil {
+		return model.NewAppError("SummarizeReactions", "app.reaction_summary.get_latest_day.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if day == 0 {
+		day = today - model.ReactionSummaryBackfillDays*reactionSummaryDayMillis
+	}
+
+	for ; day <= today; day += reactionSummaryDayMillis {
+		if err := a.Srv().Store().ReactionSummary().SummarizeDay(day); err != nil {
+			return model.NewAppError("SummarizeReactions", "app.reaction_summary.summarize_day.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		c.Logger().Debug("Summarized reactions", mlog.Int64("day", day))
+	}
+
+	return nil
+}
+
+// GetReactionAnalytics returns the emojis used the most in reactions and the posts that received
+// the most reactions, as of the last time reactions were summarized.
+func (a *App) GetReactionAnalytics(opts model.ReactionAnalyticsOptions) (*model.ReactionAnalytics, *model.AppError) {
+	if !*a.Config().AnalyticsSettings.EnableReactionSummaries {
+		return nil, model.NewAppError("GetReactionAnalytics", "app.reaction_summary.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if appErr := opts.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	summarizedDay, err := a.Srv().Store().ReactionSummary().GetLatestDay()
+	if err != nil {
+		return nil, model.NewAppError("GetReactionAnalytics", "app.reaction_summary.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	emojis, err := a.Srv().Store().ReactionSummary().GetTopEmojis(opts)
+	if err != nil {
+		return nil, model.NewAppError("GetReactionAnalytics", "app.reaction_summary.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	posts, err := a.Srv().Store().ReactionSummary().GetTopPosts(opts)
+	if err != nil {
+		return nil, model.NewAppError("GetReactionAnalytics", "app.reaction_summary.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return &model.ReactionAnalytics{
+		TopEmojis:     emojis,
+		TopPosts:      posts,
+		SummarizedDay: summarizedDay,
+	}, nil
+}
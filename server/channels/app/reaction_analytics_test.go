@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestReactionAnalytics(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	post := th.CreatePost(th.BasicChannel)
+	for _, userID := range []string{th.BasicUser.Id, th.BasicUser2.Id} {
+		_, appErr := th.App.SaveReactionForPost(th.Context, &model.Reaction{UserId: userID, PostId: post.Id, EmojiName: "smile"})
+		require.Nil(t, appErr)
+	}
+
+	opts := model.ReactionAnalyticsOptions{
+		ChannelId: th.BasicChannel.Id,
+		Until:     model.GetMillis(),
+		Limit:     model.ReactionAnalyticsDefaultLimit,
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.AnalyticsSettings.EnableReactionSummaries = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.AnalyticsSettings.EnableReactionSummaries = true })
+
+		_, appErr := th.App.GetReactionAnalytics(opts)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.reaction_summary.disabled.app_error", appErr.Id)
+	})
+
+	t.Run("reactions are counted once summarized", func(t *testing.T) {
+		analytics, appErr := th.App.GetReactionAnalytics(opts)
+		require.Nil(t, appErr)
+		assert.Empty(t, analytics.TopEmojis)
+
+		require.Nil(t, th.App.SummarizeReactions(th.Context))
+
+		analytics, appErr = th.App.GetReactionAnalytics(opts)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.ReactionSummaryDay(model.GetMillis()), analytics.SummarizedDay)
+		assert.Equal(t, []*model.ReactionEmojiCount{{EmojiName: "smile", Count: 2}}, analytics.TopEmojis)
+		assert.Equal(t, []*model.ReactionPostCount{{PostId: post.Id, ChannelId: th.BasicChannel.Id, Count: 2}}, analytics.TopPosts)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		invalidOpts := opts
+		invalidOpts.Limit = 0
+		_, appErr := th.App.GetReactionAnalytics(invalidOpts)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.reaction_analytics.is_valid.limit.app_error", appErr.Id)
+	})
+}
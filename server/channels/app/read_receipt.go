@@ -0,0 +1,150 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// MarkPostAsRead records that the user has read the channel up to the given post. Unless the user
+// has chosen not to share read receipts, the other members of the channel are notified when the
+// channel is small enough for read receipts to be shown.
+func (a *App) MarkPostAsRead(c request.CTX, postID, userID string) (*model.ReadReceipt, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableReadReceipts {
+		return nil, model.NewAppError("MarkPostAsRead", "app.read_receipt.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	post, appErr := a.GetSinglePost(postID, false)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	readAt := model.GetMillis()
+	receipt, err := a.Srv().Store().ReadReceipt().Save(&model.ReadReceipt{
+		UserId:       userID,
+		ChannelId:    post.ChannelId,
+		PostId:       post.Id,
+		PostCreateAt: post.CreateAt,
+		ReadAt:       readAt,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("MarkPostAsRead", "app.read_receipt.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	// Nothing changed if the user had already read a more recent post of the channel.
+	if receipt.ReadAt == readAt && a.sharesReadReceipts(userID) {
+		a.Srv().Go(func() {
+			a.sendReadReceiptEvent(c, receipt)
+		})
+	}
+
+	return receipt, nil
+}
+
+// GetReadReceiptsForPost returns the receipts of the channel members who have read the post, leaving
+// out its author, the members who don't share read receipts and the users who left the channel. Read receipts are only available in
+// channels with no more members than configured by ServiceSettings.ReadReceiptsMaxChannelMembers.
+func (a *App) GetReadReceiptsForPost(c request.CTX, post *model.Post) ([]*model.ReadReceipt, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableReadReceipts {
+		return nil, model.NewAppError("GetReadReceiptsForPost", "app.read_receipt.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if ok, appErr := a.channelAllowsReadReceipts(c, post.ChannelId); appErr != nil {
+		return nil, appErr
+	} else if !ok {
+		return nil, model.NewAppError("GetReadReceiptsForPost", "app.read_receipt.too_many_members.app_error", map[string]any{"Max": *a.Config().ServiceSettings.ReadReceiptsMaxChannelMembers}, "", http.StatusBadRequest)
+	}
+
+	receipts, err := a.Srv().Store().ReadReceipt().GetForChannelSince(post.ChannelId, post.CreateAt)
+	if err != nil {
+		return nil, model.NewAppError("GetReadReceiptsForPost", "app.read_receipt.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if len(receipts) == 0 {
+		return receipts, nil
+	}
+
+	// The receipts of the users who left the channel are left out.
+	userIDs := make([]string, 0, len(receipts))
+	for _, receipt := range receipts {
+		userIDs = append(userIDs, receipt.UserId)
+	}
+	members, err := a.Srv().Store().Channel().GetMembersByIds(post.ChannelId, userIDs)
+	if err != nil {
+		return nil, model.NewAppError("GetReadReceiptsForPost", "app.read_receipt.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	memberIDs := make([]string, 0, len(members))
+	shown := make(map[string]bool, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.UserId)
+		shown[member.UserId] = true
+	}
+	shown[post.UserId] = false
+
+	optedOut, err := a.Srv().Store().Preference().GetCategoryAndNameForUsers(memberIDs, model.PreferenceCategoryDisplaySettings, model.PreferenceNameShareReadReceipts)
+	if err != nil {
+		return nil, model.NewAppError("GetReadReceiptsForPost", "app.read_receipt.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	for _, preference := range optedOut {
+		if preference.Value == "false" {
+			shown[preference.UserId] = false
+		}
+	}
+
+	filtered := make([]*model.ReadReceipt, 0, len(receipts))
+	for _, receipt := range receipts {
+		if shown[receipt.UserId] {
+			filtered = append(filtered, receipt)
+		}
+	}
+
+	return filtered, nil
+}
+
+func (a *App) channelAllowsReadReceipts(c request.CTX, channelID string) (bool, *model.AppError) {
+	count, appErr := a.GetChannelMemberCount(c, channelID)
+	if appErr != nil {
+		return false, appErr
+	}
+
+	return count <= int64(*a.Config().ServiceSettings.ReadReceiptsMaxChannelMembers), nil
+}
+
+// sharesReadReceipts reports whether the user lets other channel members know what they have read.
+func (a *App) sharesReadReceipts(userID string) bool {
+	preference, appErr := a.GetPreferenceByCategoryAndNameForUser(userID, model.PreferenceCategoryDisplaySettings, model.PreferenceNameShareReadReceipts)
+	if appErr != nil {
+		return true
+	}
+
+	return preference.Value != "false"
+}
+
+func (a *App) sendReadReceiptEvent(c request.CTX, receipt *model.ReadReceipt) {
+	if ok, appErr := a.channelAllowsReadReceipts(c, receipt.ChannelId); appErr != nil || !ok {
+		return
+	}
+
+	receiptJSON, err := json.Marshal(receipt)
+	if err != nil {
+		a.Log().Warn("Failed to encode read receipt to JSON", mlog.Err(err))
+		return
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventPostRead, "", receipt.ChannelId, "", nil, "")
+	message.Add("read_receipt", string(receiptJSON))
+	a.Publish(message)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestReadReceipts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	post := th.CreatePost(th.BasicChannel)
+
+	t.Run("disabled", func(t *testing.T) {
+		_, appErr := th.App.MarkPostAsRead(th.Context, post.Id, th.BasicUser2.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.read_receipt.disabled.app_error", appErr.Id)
+
+		_, appErr = th.App.GetReadReceiptsForPost(th.Context, post)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.read_receipt.disabled.app_error", appErr.Id)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableReadReceipts = true })
+
+	t.Run("reading a later post marks earlier posts as read", func(t *testing.T) {
+		laterPost := th.CreatePost(th.BasicChannel)
+
+		receipt, appErr := th.App.MarkPostAsRead(th.Context, laterPost.Id, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, laterPost.Id, receipt.PostId)
+
+		receipts, appErr := th.App.GetReadReceiptsForPost(th.Context, post)
+		require.Nil(t, appErr)
+		require.Len(t, receipts, 1)
+		assert.Equal(t, th.BasicUser2.Id, receipts[0].UserId)
+
+		// Reading an earlier post afterwards keeps the receipt for the later one.
+		receipt, appErr = th.App.MarkPostAsRead(th.Context, post.Id, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, laterPost.Id, receipt.PostId)
+	})
+
+	t.Run("the author is left out", func(t *testing.T) {
+		_, appErr := th.App.MarkPostAsRead(th.Context, post.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		receipts, appErr := th.App.GetReadReceiptsForPost(th.Context, post)
+		require.Nil(t, appErr)
+		for _, receipt := range receipts {
+			assert.NotEqual(t, th.BasicUser.Id, receipt.UserId)
+		}
+	})
+
+	t.Run("users can stop sharing read receipts", func(t *testing.T) {
+		appErr := th.App.UpdatePreferences(th.BasicUser2.Id, model.Preferences{{
+			UserId:   th.BasicUser2.Id,
+			Category: model.PreferenceCategoryDisplaySettings,
+			Name:     model.PreferenceNameShareReadReceipts,
+			Value:    "false",
+		}})
+		require.Nil(t, appErr)
+
+		receipts, appErr := th.App.GetReadReceiptsForPost(th.Context, post)
+		require.Nil(t, appErr)
+		assert.Empty(t, receipts)
+	})
+
+	t.Run("users who left the channel are left out", func(t *testing.T) {
+		user := th.CreateUser()
+		th.LinkUserToTeam(user, th.BasicTeam)
+		th.AddUserToChannel(user, th.BasicChannel)
+
+		_, appErr := th.App.MarkPostAsRead(th.Context, post.Id, user.Id)
+		require.Nil(t, appErr)
+
+		receipts, appErr := th.App.GetReadReceiptsForPost(th.Context, post)
+		require.Nil(t, appErr)
+		require.Len(t, receipts, 1)
+		assert.Equal(t, user.Id, receipts[0].UserId)
+
+		appErr = th.App.RemoveUserFromChannel(th.Context, user.Id, user.Id, th.BasicChannel)
+		require.Nil(t, appErr)
+
+		receipts, appErr = th.App.GetReadReceiptsForPost(th.Context, post)
+		require.Nil(t, appErr)
+		assert.Empty(t, receipts)
+	})
+
+	t.Run("channel with too many members", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.ReadReceiptsMaxChannelMembers = 2 })
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.ReadReceiptsMaxChannelMembers = model.ServiceSettingsDefaultReadReceiptsMaxChannelMembers
+		})
+		th.AddUserToChannel(th.SystemAdminUser, th.BasicChannel)
+
+		_, appErr := th.App.GetReadReceiptsForPost(th.Context, post)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.read_receipt.too_many_members.app_error", appErr.Id)
+	})
+}
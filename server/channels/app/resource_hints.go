@@ -0,0 +1,92 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+)
+
+// resourceHintsPostsCount is the number of posts a client shows on switching to a channel.
+const resourceHintsPostsCount = 60
+
+// GetChannelResourceHints returns what the latest posts of a channel need to be rendered by the
+// given user: the profiles of their authors, the custom emojis of their messages and reactions,
+// and the links they preview.
+func (a *App) GetChannelResourceHints(c request.CTX, channelID, userID string) (*model.ChannelResourceHints, *model.AppError) {
+	postList, appErr := a.GetPostsPage(model.GetPostsOptions{
+		ChannelId:        channelID,
+		UserId:           userID,
+		PerPage:          resourceHintsPostsCount,
+		CollapsedThreads: a.IsCRTEnabledForUser(c, userID),
+		SkipFetchThreads: true,
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr = a.FilterRestrictedPostList(userID, postList); appErr != nil {
+		return nil, appErr
+	}
+
+	hints := &model.ChannelResourceHints{
+		ChannelId:       channelID,
+		UserIds:         []string{},
+		Emojis:          []*model.Emoji{},
+		LinkPreviewURLs: []string{},
+	}
+
+	var postIDsWithReactions []string
+	for _, postID := range postList.Order {
+		if post := postList.Posts[postID]; post != nil && post.HasReactions {
+			postIDsWithReactions = append(postIDsWithReactions, postID)
+		}
+	}
+
+	reactions := map[string][]*model.Reaction{}
+	if len(postIDsWithReactions) > 0 && *a.Config().ServiceSettings.EnableCustomEmoji {
+		reactions, appErr = a.GetBulkReactionsForPosts(postIDsWithReactions)
+		if appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	// Permalink previews are embedded in the posts, so only the other links are hinted.
+	siteURL := a.GetSiteURL()
+	enableLinkPreviews := *a.Config().ServiceSettings.EnableLinkPreviews
+
+	var emojiNames []string
+	for _, postID := range postList.Order {
+		post := postList.Posts[postID]
+		if post == nil {
+			continue
+		}
+
+		hints.UserIds = append(hints.UserIds, post.UserId)
+		emojiNames = append(emojiNames, getEmojiNamesForPost(post, reactions[postID])...)
+
+		if enableLinkPreviews {
+			if firstLink, _ := a.getFirstLinkAndImages(post.Message); firstLink != "" && !looksLikeAPermalink(firstLink, siteURL) {
+				hints.LinkPreviewURLs = append(hints.LinkPreviewURLs, firstLink)
+			}
+		}
+	}
+
+	hints.UserIds = model.RemoveDuplicateStrings(hints.UserIds)
+	hints.LinkPreviewURLs = model.RemoveDuplicateStrings(hints.LinkPreviewURLs)
+
+	// Only custom emojis need to be fetched, the system ones ship with the clients.
+	emojiNames = model.RemoveDuplicateStrings(emojiNames)
+	if len(emojiNames) > 0 && *a.Config().ServiceSettings.EnableCustomEmoji {
+		emojis, appErr := a.GetMultipleEmojiByName(c, emojiNames)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if len(emojis) > 0 {
+			hints.Emojis = emojis
+		}
+	}
+
+	return hints, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetChannelResourceHints(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableCustomEmoji = true
+		*cfg.ServiceSettings.EnableLinkPreviews = true
+	})
+
+	messageEmoji := th.CreateEmoji()
+	reactionEmoji := th.CreateEmoji()
+
+	channel := th.CreateChannel(th.Context, th.BasicTeam)
+	th.AddUserToChannel(th.BasicUser2, channel)
+
+	post := th.CreateMessagePost(channel, "hello :"+messageEmoji.Name+": :smile: https://example.com/page")
+	th.AddReactionToPost(post, th.BasicUser2, reactionEmoji.Name)
+
+	_, appErr := th.App.CreatePost(th.Context, &model.Post{
+		UserId:    th.BasicUser2.Id,
+		ChannelId: channel.Id,
+		Message:   "see " + th.App.GetSiteURL() + "/" + th.BasicTeam.Name + "/pl/" + post.Id,
+	}, channel, false, true)
+	require.Nil(t, appErr)
+
+	t.Run("gathers authors, custom emojis and links", func(t *testing.T) {
+		hints, appErr := th.App.GetChannelResourceHints(th.Context, channel.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		assert.Equal(t, channel.Id, hints.ChannelId)
+		assert.Contains(t, hints.UserIds, th.BasicUser.Id)
+		assert.Contains(t, hints.UserIds, th.BasicUser2.Id)
+
+		var emojiNames []string
+		for _, emoji := range hints.Emojis {
+			emojiNames = append(emojiNames, emoji.Name)
+		}
+		assert.ElementsMatch(t, []string{messageEmoji.Name, reactionEmoji.Name}, emojiNames)
+
+		// The permalink is embedded in its post
+		assert.Equal(t, []string{"https://example.com/page"}, hints.LinkPreviewURLs)
+	})
+
+	t.Run("custom emojis and link previews disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.EnableCustomEmoji = false
+			*cfg.ServiceSettings.EnableLinkPreviews = false
+		})
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.EnableCustomEmoji = true
+			*cfg.ServiceSettings.EnableLinkPreviews = true
+		})
+
+		hints, appErr := th.App.GetChannelResourceHints(th.Context, channel.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Len(t, hints.UserIds, 2)
+		assert.Empty(t, hints.Emojis)
+		assert.Empty(t, hints.LinkPreviewURLs)
+	})
+}
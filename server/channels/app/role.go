@@ -14,6 +14,7 @@ import (
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/channels/store"
 	"github.com/mattermost/mattermost-server/v6/server/channels/utils"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
 )
 
 func (a *App) GetRole(id string) (*model.Role, *model.AppError) {
@@ -127,10 +128,16 @@ func (a *App) mergeChannelHigherScopedPermissions(roles []*model.Role) *model.Ap
 
 func (a *App) PatchRole(role *model.Role, patch *model.RolePatch) (*model.Role, *model.AppError) {
 	// If patch is a no-op then short-circuit the store.
-	if patch.Permissions != nil && reflect.DeepEqual(*patch.Permissions, role.Permissions) {
+	if patch.Permissions != nil && reflect.DeepEqual(*patch.Permissions, role.Permissions) && patch.TeamIds == nil {
 		return role, nil
 	}
 
+	if patch.TeamIds != nil {
+		if appErr := a.checkTeamsExist(*patch.TeamIds); appErr != nil {
+			return nil, appErr
+		}
+	}
+
 	role.Patch(patch)
 	role, err := a.UpdateRole(role)
 	if err != nil {
@@ -167,7 +174,125 @@ func (a *App) CreateRole(role *model.Role) (*model.Role, *model.AppError) {
 	return role, nil
 }
 
+// CreateCustomAdminRole creates a delegated administration role granting the permissions of the
+// requested capability sets, optionally restricted to some teams.
+func (a *App) CreateCustomAdminRole(customRole *model.CustomAdminRole) (*model.Role, *model.AppError) {
+	role, ok := customRole.ToRole()
+	if !ok {
+		return nil, model.NewAppError("CreateCustomAdminRole", "app.role.custom_admin.invalid_capability.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if appErr := a.checkTeamsExist(role.TeamIds); appErr != nil {
+		return nil, appErr
+	}
+
+	return a.CreateRole(role)
+}
+
+// GetCustomAdminRoles returns the delegated administration roles that haven't been deleted.
+func (a *App) GetCustomAdminRoles() ([]*model.Role, *model.AppError) {
+	roles, appErr := a.GetAllRoles()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	customRoles := []*model.Role{}
+	for _, role := range roles {
+		if role.IsCustomAdminRole() && role.DeleteAt == 0 {
+			customRoles = append(customRoles, role)
+		}
+	}
+
+	return customRoles, nil
+}
+
+// DeleteCustomAdminRole soft deletes a delegated administration role. Users keep the role name
+// in their roles, but deleted roles no longer grant any permission.
+func (a *App) DeleteCustomAdminRole(roleID string) (*model.Role, *model.AppError) {
+	role, appErr := a.GetRole(roleID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !role.IsCustomAdminRole() {
+		return nil, model.NewAppError("DeleteCustomAdminRole", "app.role.custom_admin.not_custom.app_error", nil, "role="+role.Name, http.StatusBadRequest)
+	}
+
+	deletedRole, err := a.Srv().Store().Role().Delete(roleID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("DeleteCustomAdminRole", "app.role.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("DeleteCustomAdminRole", "app.role.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if appErr := a.sendUpdatedRoleEvent(deletedRole); appErr != nil {
+		return nil, appErr
+	}
+
+	return deletedRole, nil
+}
+
+// GetRoleSnapshots returns a page of the permission sets the role had before its changes, the most
+// recent first.
+func (a *App) GetRoleSnapshots(roleID string, page, perPage int) ([]*model.RoleSnapshot, *model.AppError) {
+	snapshots, err := a.Srv().Store().RoleSnapshot().GetForRole(roleID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetRoleSnapshots", "app.role_snapshot.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return snapshots, nil
+}
+
+// GetRoleSnapshot returns a snapshot of the given role.
+func (a *App) GetRoleSnapshot(roleID, snapshotID string) (*model.RoleSnapshot, *model.AppError) {
+	snapshot, err := a.Srv().Store().RoleSnapshot().Get(snapshotID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetRoleSnapshot", "app.role_snapshot.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetRoleSnapshot", "app.role_snapshot.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if snapshot.RoleId != roleID {
+		return nil, model.NewAppError("GetRoleSnapshot", "app.role_snapshot.get.app_error", nil, "role_id="+roleID+", snapshot_id="+snapshotID, http.StatusNotFound)
+	}
+
+	return snapshot, nil
+}
+
+func (a *App) checkTeamsExist(teamIDs []string) *model.AppError {
+	for _, teamID := range teamIDs {
+		if _, appErr := a.GetTeam(teamID); appErr != nil {
+			if appErr.StatusCode == http.StatusNotFound {
+				return model.NewAppError("checkTeamsExist", "app.role.custom_admin.invalid_team.app_error", nil, "team_id="+teamID, http.StatusBadRequest)
+			}
+			return appErr
+		}
+	}
+
+	return nil
+}
+
+// UpdateRole saves the role, keeping a snapshot of the permissions it had before for them to be
+// reverted to.
 func (a *App) UpdateRole(role *model.Role) (*model.Role, *model.AppError) {
+	var previousRole *model.Role
+	if role.Id != "" {
+		var err error
+		previousRole, err = a.Srv().Store().Role().Get(role.Id)
+		var nfErr *store.ErrNotFound
+		if err != nil && !errors.As(err, &nfErr) {
+			return nil, model.NewAppError("UpdateRole", "app.role.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
 	savedRole, err := a.Srv().Store().Role().Save(role)
 	if err != nil {
 		var invErr *store.ErrInvalidInput
@@ -179,6 +304,13 @@ func (a *App) UpdateRole(role *model.Role) (*model.Role, *model.AppError) {
 		}
 	}
 
+	if previousRole != nil && !reflect.DeepEqual(previousRole.Permissions, savedRole.Permissions) {
+		// The role was already updated, so failing to snapshot it only loses the ability to revert.
+		if _, snapshotErr := a.Srv().Store().RoleSnapshot().Save(&model.RoleSnapshot{RoleId: savedRole.Id, Permissions: previousRole.Permissions}); snapshotErr != nil {
+			a.Log().Warn("Failed to save the role snapshot", mlog.String("role_id", savedRole.Id), mlog.Err(snapshotErr))
+		}
+	}
+
 	builtInChannelRoles := []string{
 		model.ChannelGuestRoleId,
 		model.ChannelUserRoleId,
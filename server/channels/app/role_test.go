@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/csv"
 	"io"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -241,3 +242,104 @@ func testPermissionInheritance(t *testing.T, testCallback func(t *testing.T, th
 	// test 24 combinations where the higher-scoped scheme is a TEAM scheme
 	test(teamScheme.DefaultChannelGuestRole, teamScheme.DefaultChannelUserRole, teamScheme.DefaultChannelAdminRole)
 }
+
+func TestCreateCustomAdminRole(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("capabilities are expanded", func(t *testing.T) {
+		role, appErr := th.App.CreateCustomAdminRole(&model.CustomAdminRole{
+			Name:         "playbooks_admin",
+			DisplayName:  "Playbooks admin",
+			Capabilities: []string{model.AdminRoleCapabilityPlaybooks},
+		})
+		require.Nil(t, appErr)
+		require.True(t, role.IsCustomAdminRole())
+		require.Contains(t, role.Permissions, model.PermissionManagePlaybooks.Id)
+
+		roles, appErr := th.App.GetCustomAdminRoles()
+		require.Nil(t, appErr)
+		roleIDs := []string{}
+		for _, r := range roles {
+			roleIDs = append(roleIDs, r.Id)
+		}
+		require.Contains(t, roleIDs, role.Id)
+	})
+
+	t.Run("unknown capability", func(t *testing.T) {
+		_, appErr := th.App.CreateCustomAdminRole(&model.CustomAdminRole{
+			Name:         "unknown_admin",
+			DisplayName:  "Unknown admin",
+			Capabilities: []string{"unknown"},
+		})
+		require.NotNil(t, appErr)
+		require.Equal(t, "app.role.custom_admin.invalid_capability.app_error", appErr.Id)
+	})
+
+	t.Run("unknown team", func(t *testing.T) {
+		_, appErr := th.App.CreateCustomAdminRole(&model.CustomAdminRole{
+			Name:         "team_admin_elsewhere",
+			DisplayName:  "Team admin elsewhere",
+			Capabilities: []string{model.AdminRoleCapabilityIntegrations},
+			TeamIds:      []string{model.NewId()},
+		})
+		require.NotNil(t, appErr)
+		require.Equal(t, "app.role.custom_admin.invalid_team.app_error", appErr.Id)
+	})
+
+	t.Run("built in roles can't be deleted", func(t *testing.T) {
+		role, appErr := th.App.GetRoleByName(context.Background(), model.SystemUserManagerRoleId)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.DeleteCustomAdminRole(role.Id)
+		require.NotNil(t, appErr)
+		require.Equal(t, "app.role.custom_admin.not_custom.app_error", appErr.Id)
+	})
+}
+
+func TestPatchRoleSnapshots(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	role, appErr := th.App.CreateRole(&model.Role{
+		Name:        "snapshotted_role",
+		DisplayName: "Snapshotted role",
+		Permissions: []string{model.PermissionCreatePost.Id},
+	})
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.PatchRole(role, &model.RolePatch{Permissions: &[]string{model.PermissionCreatePost.Id, model.PermissionAddReaction.Id}})
+	require.Nil(t, appErr)
+
+	// Patches that don't change the permissions aren't recorded
+	_, appErr = th.App.PatchRole(role, &model.RolePatch{Permissions: &[]string{model.PermissionCreatePost.Id, model.PermissionAddReaction.Id}})
+	require.Nil(t, appErr)
+
+	snapshots, appErr := th.App.GetRoleSnapshots(role.Id, 0, 10)
+	require.Nil(t, appErr)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, []string{model.PermissionCreatePost.Id}, snapshots[0].Permissions)
+
+	snapshot, appErr := th.App.GetRoleSnapshot(role.Id, snapshots[0].Id)
+	require.Nil(t, appErr)
+	require.Equal(t, snapshots[0], snapshot)
+
+	_, appErr = th.App.GetRoleSnapshot(model.NewId(), snapshots[0].Id)
+	require.NotNil(t, appErr)
+	require.Equal(t, http.StatusNotFound, appErr.StatusCode)
+
+	// The updates made by the scheme imports, the channel moderation and the other paths are
+	// recorded as well
+	role.Permissions = []string{model.PermissionAddReaction.Id}
+	_, appErr = th.App.UpdateRole(role)
+	require.Nil(t, appErr)
+
+	snapshots, appErr = th.App.GetRoleSnapshots(role.Id, 0, 10)
+	require.Nil(t, appErr)
+	require.Len(t, snapshots, 2)
+	var permissions [][]string
+	for _, snapshot := range snapshots {
+		permissions = append(permissions, snapshot.Permissions)
+	}
+	require.Contains(t, permissions, []string{model.PermissionCreatePost.Id, model.PermissionAddReaction.Id})
+}
@@ -0,0 +1,333 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// GetSavedPostLabels returns the labels the user files their saved posts under, oldest first.
+func (a *App) GetSavedPostLabels(userID string) ([]*model.SavedPostLabel, *model.AppError) {
+	preferences, err := a.Srv().Store().Preference().GetCategory(userID, model.PreferenceCategorySavedPostLabel)
+	if err != nil {
+		return nil, model.NewAppError("GetSavedPostLabels", "app.saved_post_label.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	labels := make([]*model.SavedPostLabel, 0, len(preferences))
+	for _, preference := range preferences {
+		label, err := model.SavedPostLabelFromJSON(preference.Value)
+		if err != nil {
+			continue
+		}
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].CreateAt < labels[j].CreateAt
+	})
+
+	return labels, nil
+}
+
+func (a *App) getSavedPostLabel(userID, labelID string) (*model.SavedPostLabel, *model.AppError) {
+	labels, appErr := a.GetSavedPostLabels(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	for _, label := range labels {
+		if label.Id == labelID {
+			return label, nil
+		}
+	}
+	return nil, model.NewAppError("getSavedPostLabel", "app.saved_post_label.get.not_found.app_error", nil, "label_id="+labelID, http.StatusNotFound)
+}
+
+// CreateSavedPostLabel creates a label for the user to file their saved posts under.
+func (a *App) CreateSavedPostLabel(userID string, label *model.SavedPostLabel) (*model.SavedPostLabel, *model.AppError) {
+	labels, appErr := a.GetSavedPostLabels(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(labels) >= model.SavedPostLabelsMaxPerUser {
+		return nil, model.NewAppError("CreateSavedPostLabel", "app.saved_post_label.create.too_many.app_error", map[string]any{"Max": model.SavedPostLabelsMaxPerUser}, "", http.StatusBadRequest)
+	}
+
+	label.Id = ""
+	label.CreateAt = 0
+	label.PreSave()
+
+	return a.saveSavedPostLabel(userID, label, labels)
+}
+
+// UpdateSavedPostLabel renames a label of the user.
+func (a *App) UpdateSavedPostLabel(userID string, label *model.SavedPostLabel) (*model.SavedPostLabel, *model.AppError) {
+	existing, appErr := a.getSavedPostLabel(userID, label.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	labels, appErr := a.GetSavedPostLabels(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	existing.Name = label.Name
+	existing.PreSave()
+
+	return a.saveSavedPostLabel(userID, existing, labels)
+}
+
+func (a *App) saveSavedPostLabel(userID string, label *model.SavedPostLabel, labels []*model.SavedPostLabel) (*model.SavedPostLabel, *model.AppError) {
+	if appErr := label.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	for _, other := range labels {
+		if other.Id != label.Id && strings.EqualFold(other.Name, label.Name) {
+			return nil, model.NewAppError("saveSavedPostLabel", "app.saved_post_label.save.name_exists.app_error", map[string]any{"Name": label.Name}, "", http.StatusBadRequest)
+		}
+	}
+
+	value, err := json.Marshal(label)
+	if err != nil {
+		return nil, model.NewAppError("saveSavedPostLabel", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	preference := model.Preference{
+		UserId:   userID,
+		Category: model.PreferenceCategorySavedPostLabel,
+		Name:     label.Id,
+		Value:    string(value),
+	}
+	if appErr := a.UpdatePreferences(userID, model.Preferences{preference}); appErr != nil {
+		return nil, appErr
+	}
+
+	return label, nil
+}
+
+// DeleteSavedPostLabel deletes a label of the user and removes it from the posts filed under it.
+// The posts stay saved.
+func (a *App) DeleteSavedPostLabel(userID, labelID string) *model.AppError {
+	if _, appErr := a.getSavedPostLabel(userID, labelID); appErr != nil {
+		return appErr
+	}
+
+	postLabels, err := a.Srv().Store().Preference().GetCategory(userID, model.PreferenceCategorySavedPostLabels)
+	if err != nil {
+		return model.NewAppError("DeleteSavedPostLabel", "app.saved_post_label.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	var updated, deleted model.Preferences
+	for _, preference := range postLabels {
+		labelIDs, err := model.SavedPostLabelIdsFromJSON(preference.Value)
+		if err != nil || !labelIDs.Contains(labelID) {
+			continue
+		}
+
+		labelIDs = labelIDs.Remove(labelID)
+		if len(labelIDs) == 0 {
+			deleted = append(deleted, preference)
+			continue
+		}
+		value, _ := json.Marshal(labelIDs)
+		preference.Value = string(value)
+		updated = append(updated, preference)
+	}
+
+	if len(updated) > 0 {
+		if appErr := a.UpdatePreferences(userID, updated); appErr != nil {
+			return appErr
+		}
+	}
+
+	deleted = append(deleted, model.Preference{
+		UserId:   userID,
+		Category: model.PreferenceCategorySavedPostLabel,
+		Name:     labelID,
+	})
+	return a.DeletePreferences(userID, deleted)
+}
+
+// getSavedPostLabelIds returns the ids of the labels of the post, which is empty when it has none.
+func (a *App) getSavedPostLabelIds(userID, postID string) model.StringArray {
+	preference, err := a.Srv().Store().Preference().Get(userID, model.PreferenceCategorySavedPostLabels, postID)
+	if err != nil {
+		return model.StringArray{}
+	}
+
+	labelIDs, err := model.SavedPostLabelIdsFromJSON(preference.Value)
+	if err != nil {
+		return model.StringArray{}
+	}
+	return labelIDs
+}
+
+// LabelSavedPost files the post under the label, saving the post first if needed.
+func (a *App) LabelSavedPost(userID, postID, labelID string) *model.AppError {
+	if _, appErr := a.getSavedPostLabel(userID, labelID); appErr != nil {
+		return appErr
+	}
+
+	labelIDs := a.getSavedPostLabelIds(userID, postID)
+	if !labelIDs.Contains(labelID) {
+		if len(labelIDs) >= model.SavedPostLabelsMaxPerPost {
+			return model.NewAppError("LabelSavedPost", "app.saved_post_label.label.too_many.app_error", map[string]any{"Max": model.SavedPostLabelsMaxPerPost}, "", http.StatusBadRequest)
+		}
+		labelIDs = append(labelIDs, labelID)
+	}
+
+	value, err := json.Marshal(labelIDs)
+	if err != nil {
+		return model.NewAppError("LabelSavedPost", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return a.UpdatePreferences(userID, model.Preferences{
+		{
+			UserId:   userID,
+			Category: model.PreferenceCategoryFlaggedPost,
+			Name:     postID,
+			Value:    "true",
+		},
+		{
+			UserId:   userID,
+			Category: model.PreferenceCategorySavedPostLabels,
+			Name:     postID,
+			Value:    string(value),
+		},
+	})
+}
+
+// UnlabelSavedPost removes the label from the post, which stays saved.
+func (a *App) UnlabelSavedPost(userID, postID, labelID string) *model.AppError {
+	labelIDs := a.getSavedPostLabelIds(userID, postID)
+	if !labelIDs.Contains(labelID) {
+		return nil
+	}
+
+	labelIDs = labelIDs.Remove(labelID)
+	preference := model.Preference{
+		UserId:   userID,
+		Category: model.PreferenceCategorySavedPostLabels,
+		Name:     postID,
+	}
+	if len(labelIDs) == 0 {
+		return a.DeletePreferences(userID, model.Preferences{preference})
+	}
+
+	value, err := json.Marshal(labelIDs)
+	if err != nil {
+		return model.NewAppError("UnlabelSavedPost", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	preference.Value = string(value)
+	return a.UpdatePreferences(userID, model.Preferences{preference})
+}
+
+// getSavedPostIdsForLabel returns the ids of the posts the user saved and filed under the label.
+func (a *App) getSavedPostIdsForLabel(userID, labelID string) (map[string]bool, *model.AppError) {
+	postLabels, err := a.Srv().Store().Preference().GetCategory(userID, model.PreferenceCategorySavedPostLabels)
+	if err != nil {
+		return nil, model.NewAppError("getSavedPostIdsForLabel", "app.saved_post_label.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	flagged, err := a.Srv().Store().Preference().GetCategory(userID, model.PreferenceCategoryFlaggedPost)
+	if err != nil {
+		return nil, model.NewAppError("getSavedPostIdsForLabel", "app.post.get_flagged_posts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	saved := make(map[string]bool, len(flagged))
+	for _, preference := range flagged {
+		saved[preference.Name] = true
+	}
+
+	postIDs := make(map[string]bool)
+	for _, preference := range postLabels {
+		// the labels of a post are kept when it's unsaved, and apply again if it's saved again
+		if !saved[preference.Name] {
+			continue
+		}
+		if labelIDs, err := model.SavedPostLabelIdsFromJSON(preference.Value); err == nil && labelIDs.Contains(labelID) {
+			postIDs[preference.Name] = true
+		}
+	}
+	return postIDs, nil
+}
+
+// GetFlaggedPostsForLabel returns the posts the user saved and filed under the label, newest first.
+func (a *App) GetFlaggedPostsForLabel(userID, labelID string, offset int, limit int) (*model.PostList, *model.AppError) {
+	if _, appErr := a.getSavedPostLabel(userID, labelID); appErr != nil {
+		return nil, appErr
+	}
+
+	postIDs, appErr := a.getSavedPostIdsForLabel(userID, labelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	postList := model.NewPostList()
+	if len(postIDs) == 0 || offset >= len(postIDs) {
+		return postList, nil
+	}
+
+	ids := make([]string, 0, len(postIDs))
+	for postID := range postIDs {
+		ids = append(ids, postID)
+	}
+	posts, err := a.Srv().Store().Post().GetPostsByIds(ids)
+	if err != nil {
+		return nil, model.NewAppError("GetFlaggedPostsForLabel", "app.post.get_flagged_posts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// GetPostsByIds returns the posts newest first
+	var kept []*model.Post
+	for _, post := range posts {
+		if post.DeleteAt == 0 {
+			kept = append(kept, post)
+		}
+	}
+	if offset >= len(kept) {
+		return postList, nil
+	}
+	end := offset + limit
+	if end > len(kept) {
+		end = len(kept)
+	}
+	for _, post := range kept[offset:end] {
+		postList.AddPost(post)
+		postList.AddOrder(post.Id)
+	}
+
+	if appErr := a.filterInaccessiblePosts(postList, filterPostOptions{assumeSortedCreatedAt: true}); appErr != nil {
+		return nil, appErr
+	}
+
+	return postList, nil
+}
+
+// FilterPostListBySavedPostLabel removes from the list the posts the user didn't save under the label.
+func (a *App) FilterPostListBySavedPostLabel(userID, labelID string, postList *model.PostList) *model.AppError {
+	if _, appErr := a.getSavedPostLabel(userID, labelID); appErr != nil {
+		return appErr
+	}
+
+	postIDs, appErr := a.getSavedPostIdsForLabel(userID, labelID)
+	if appErr != nil {
+		return appErr
+	}
+
+	order := make([]string, 0, len(postList.Order))
+	for _, postID := range postList.Order {
+		if postIDs[postID] {
+			order = append(order, postID)
+		} else {
+			delete(postList.Posts, postID)
+		}
+	}
+	postList.Order = order
+	return nil
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"unicode/utf8"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// scheduledPostsBatchSize is the number of due scheduled posts loaded at a time by the delivery job.
+const scheduledPostsBatchSize = 100
+
+// CreateScheduledPost stores a message to be posted on behalf of its author at its scheduled time.
+func (a *App) CreateScheduledPost(c request.CTX, scheduledPost *model.ScheduledPost, connectionID string) (*model.ScheduledPost, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableScheduledPosts {
+		return nil, model.NewAppError("CreateScheduledPost", "app.scheduled_post.feature_disabled", nil, "", http.StatusNotImplemented)
+	}
+
+	if appErr := a.validateScheduledPost("CreateScheduledPost", scheduledPost); appErr != nil {
+		return nil, appErr
+	}
+
+	count, err := a.Srv().Store().ScheduledPost().CountPendingForUser(scheduledPost.UserId)
+	if err != nil {
+		return nil, model.NewAppError("CreateScheduledPost", "app.scheduled_post.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if count >= model.ScheduledPostMaxPerUser {
+		return nil, model.NewAppError("CreateScheduledPost", "app.scheduled_post.max_reached.app_error", map[string]any{"Max": model.ScheduledPostMaxPerUser}, "", http.StatusBadRequest)
+	}
+
+	scheduledPost.Id = ""
+	scheduledPost.CreateAt = 0
+	scheduledPost.ProcessedAt = 0
+	scheduledPost.ErrorCode = ""
+
+	saved, err := a.Srv().Store().ScheduledPost().Save(scheduledPost)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreateScheduledPost", "app.scheduled_post.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.publishScheduledPostEvent(model.WebsocketEventScheduledPostCreated, saved, connectionID)
+
+	return saved, nil
+}
+
+func (a *App) GetScheduledPost(scheduledPostID string) (*model.ScheduledPost, *model.AppError) {
+	scheduledPost, err := a.Srv().Store().ScheduledPost().Get(scheduledPostID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetScheduledPost", "app.scheduled_post.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetScheduledPost", "app.scheduled_post.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return scheduledPost, nil
+}
+
+// GetScheduledPostsForUser returns the user's scheduled posts, including those that failed
+// to be delivered, ordered by scheduled time.
+func (a *App) GetScheduledPostsForUser(userID string) ([]*model.ScheduledPost, *model.AppError) {
+	scheduledPosts, err := a.Srv().Store().ScheduledPost().GetForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetScheduledPostsForUser", "app.scheduled_post.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return scheduledPosts, nil
+}
+
+// UpdateScheduledPost changes the content or the scheduled time of a scheduled post. Updating
+// a scheduled post that failed to be delivered queues it again.
+func (a *App) UpdateScheduledPost(c request.CTX, scheduledPost *model.ScheduledPost, connectionID string) (*model.ScheduledPost, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableScheduledPosts {
+		return nil, model.NewAppError("UpdateScheduledPost", "app.scheduled_post.feature_disabled", nil, "", http.StatusNotImplemented)
+	}
+
+	existing, appErr := a.GetScheduledPost(scheduledPost.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	existing.Message = scheduledPost.Message
+	existing.SetProps(scheduledPost.GetProps())
+	existing.FileIds = scheduledPost.FileIds
+	existing.ScheduledAt = scheduledPost.ScheduledAt
+	existing.ProcessedAt = 0
+	existing.ErrorCode = ""
+
+	if appErr := a.validateScheduledPost("UpdateScheduledPost", existing); appErr != nil {
+		return nil, appErr
+	}
+
+	updated, err := a.Srv().Store().ScheduledPost().Update(existing)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("UpdateScheduledPost", "app.scheduled_post.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.publishScheduledPostEvent(model.WebsocketEventScheduledPostUpdated, updated, connectionID)
+
+	return updated, nil
+}
+
+func (a *App) DeleteScheduledPost(scheduledPostID, connectionID string) (*model.ScheduledPost, *model.AppError) {
+	scheduledPost, appErr := a.GetScheduledPost(scheduledPostID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if err := a.Srv().Store().ScheduledPost().Delete(scheduledPostID); err != nil {
+		return nil, model.NewAppError("DeleteScheduledPost", "app.scheduled_post.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	a.publishScheduledPostEvent(model.WebsocketEventScheduledPostDeleted, scheduledPost, connectionID)
+
+	return scheduledPost, nil
+}
+
+// ProcessScheduledPosts delivers every scheduled post that is due. Posts that can no longer
+// be delivered, for instance because the author left the channel, are kept with an error
+// code rather than deleted so that the author can edit or discard them.
+func (a *App) ProcessScheduledPosts(c request.CTX) *model.AppError {
+	now := model.GetMillis()
+	// Scheduled posts that couldn't be marked as processed are returned again by the store,
+	// so remember what has been handled to avoid looping over them within a single run.
+	handled := make(map[string]bool)
+
+	for {
+		scheduledPosts, err := a.Srv().Store().ScheduledPost().GetPendingBefore(now, scheduledPostsBatchSize)
+		if err != nil {
+			return model.NewAppError("ProcessScheduledPosts", "app.scheduled_post.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		progressed := false
+		for _, scheduledPost := range scheduledPosts {
+			if handled[scheduledPost.Id] {
+				continue
+			}
+			handled[scheduledPost.Id] = true
+			progressed = true
+			a.deliverScheduledPost(c, scheduledPost)
+		}
+
+		if len(scheduledPosts) < scheduledPostsBatchSize || !progressed {
+			return nil
+		}
+	}
+}
+
+func (a *App) deliverScheduledPost(c request.CTX, scheduledPost *model.ScheduledPost) {
+	logger := c.Logger().With(mlog.String("scheduled_post_id", scheduledPost.Id), mlog.String("user_id", scheduledPost.UserId), mlog.String("channel_id", scheduledPost.ChannelId))
+
+	channel, errorCode := a.checkScheduledPostDeliverable(c, scheduledPost)
+	if errorCode != "" {
+		logger.Info("Unable to deliver scheduled post", mlog.String("error_code", errorCode))
+		a.markScheduledPostFailed(c, scheduledPost, errorCode)
+		return
+	}
+
+	if _, appErr := a.CreatePost(c, scheduledPost.ToPost(), channel, true, false); appErr != nil {
+		logger.Warn("Failed to create post from scheduled post", mlog.Err(appErr))
+		a.markScheduledPostFailed(c, scheduledPost, model.ScheduledPostErrorUnknown)
+		return
+	}
+
+	if err := a.Srv().Store().ScheduledPost().Delete(scheduledPost.Id); err != nil {
+		// The post has been created, so leaving the scheduled post behind would deliver it twice.
+		// Marking it as processed without an error code keeps the job from picking it up again.
+		logger.Error("Failed to delete delivered scheduled post", mlog.Err(err))
+		a.markScheduledPostFailed(c, scheduledPost, "")
+		return
+	}
+
+	a.publishScheduledPostEvent(model.WebsocketEventScheduledPostDeleted, scheduledPost, "")
+}
+
+// checkScheduledPostDeliverable returns the channel the scheduled post is to be delivered to, or
+// the error code explaining why the author can't post there anymore.
+func (a *App) checkScheduledPostDeliverable(c request.CTX, scheduledPost *model.ScheduledPost) (*model.Channel, string) {
+	user, appErr := a.GetUser(scheduledPost.UserId)
+	if appErr != nil || user.DeleteAt != 0 {
+		return nil, model.ScheduledPostErrorUserDeleted
+	}
+
+	channel, appErr := a.GetChannel(c, scheduledPost.ChannelId)
+	if appErr != nil {
+		return nil, model.ScheduledPostErrorUnknown
+	}
+	if channel.DeleteAt != 0 {
+		return nil, model.ScheduledPostErrorChannelArchived
+	}
+
+	// Membership is checked explicitly since team level permissions would otherwise allow
+	// posting to public channels the author has left since scheduling the post.
+	if _, appErr = a.GetChannelMember(c, scheduledPost.ChannelId, scheduledPost.UserId); appErr != nil {
+		return nil, model.ScheduledPostErrorNoChannelMember
+	}
+
+	if !a.HasPermissionToChannel(c, scheduledPost.UserId, scheduledPost.ChannelId, model.PermissionCreatePost) {
+		return nil, model.ScheduledPostErrorNoChannelMember
+	}
+
+	return channel, ""
+}
+
+func (a *App) markScheduledPostFailed(c request.CTX, scheduledPost *model.ScheduledPost, errorCode string) {
+	scheduledPost.ProcessedAt = model.GetMillis()
+	scheduledPost.ErrorCode = errorCode
+
+	updated, err := a.Srv().Store().ScheduledPost().Update(scheduledPost)
+	if err != nil {
+		c.Logger().Error("Failed to mark scheduled post as failed", mlog.String("scheduled_post_id", scheduledPost.Id), mlog.Err(err))
+		return
+	}
+
+	a.publishScheduledPostEvent(model.WebsocketEventScheduledPostUpdated, updated, "")
+}
+
+func (a *App) validateScheduledPost(where string, scheduledPost *model.ScheduledPost) *model.AppError {
+	if scheduledPost.ScheduledAt <= model.GetMillis() {
+		return model.NewAppError(where, "app.scheduled_post.scheduled_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(scheduledPost.Message) > a.MaxPostSize() {
+		return model.NewAppError(where, "app.scheduled_post.message_length.app_error", map[string]any{"Max": a.MaxPostSize()}, "", http.StatusBadRequest)
+	}
+
+	channel, err := a.Srv().Store().Channel().Get(scheduledPost.ChannelId, true)
+	if err != nil {
+		return model.NewAppError(where, "api.context.invalid_param.app_error", map[string]any{"Name": "scheduled_post.channel_id"}, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if channel.DeleteAt != 0 {
+		return model.NewAppError(where, "app.scheduled_post.channel_archived.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (a *App) publishScheduledPostEvent(event string, scheduledPost *model.ScheduledPost, connectionID string) {
+	scheduledPostJSON, err := json.Marshal(scheduledPost)
+	if err != nil {
+		mlog.Warn("Failed to encode scheduled post to JSON", mlog.Err(err))
+		return
+	}
+
+	message := model.NewWebSocketEvent(event, "", "", scheduledPost.UserId, nil, connectionID)
+	message.Add("scheduled_post", string(scheduledPostJSON))
+	a.Publish(message)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestCreateScheduledPost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("in the past", func(t *testing.T) {
+		_, appErr := th.App.CreateScheduledPost(th.Context, &model.ScheduledPost{
+			UserId:      th.BasicUser.Id,
+			ChannelId:   th.BasicChannel.Id,
+			Message:     "message",
+			ScheduledAt: model.GetMillis() - 1000,
+		}, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.scheduled_post.scheduled_at.app_error", appErr.Id)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableScheduledPosts = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableScheduledPosts = true })
+
+		_, appErr := th.App.CreateScheduledPost(th.Context, &model.ScheduledPost{
+			UserId:      th.BasicUser.Id,
+			ChannelId:   th.BasicChannel.Id,
+			Message:     "message",
+			ScheduledAt: model.GetMillis() + 60000,
+		}, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.scheduled_post.feature_disabled", appErr.Id)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		scheduledPost, appErr := th.App.CreateScheduledPost(th.Context, &model.ScheduledPost{
+			UserId:      th.BasicUser.Id,
+			ChannelId:   th.BasicChannel.Id,
+			Message:     "message",
+			ScheduledAt: model.GetMillis() + 60000,
+		}, "")
+		require.Nil(t, appErr)
+		assert.True(t, scheduledPost.IsPending())
+
+		scheduledPosts, appErr := th.App.GetScheduledPostsForUser(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		require.Len(t, scheduledPosts, 1)
+		assert.Equal(t, scheduledPost.Id, scheduledPosts[0].Id)
+	})
+}
+
+func TestProcessScheduledPosts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	saveDue := func(t *testing.T, channelID, message string) *model.ScheduledPost {
+		t.Helper()
+		scheduledPost, err := th.App.Srv().Store().ScheduledPost().Save(&model.ScheduledPost{
+			UserId:      th.BasicUser.Id,
+			ChannelId:   channelID,
+			Message:     message,
+			ScheduledAt: model.GetMillis() - 1000,
+		})
+		require.NoError(t, err)
+		return scheduledPost
+	}
+
+	t.Run("delivers due posts", func(t *testing.T) {
+		scheduledPost := saveDue(t, th.BasicChannel.Id, "delivered "+model.NewId())
+
+		require.Nil(t, th.App.ProcessScheduledPosts(th.Context))
+
+		posts, appErr := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: th.BasicChannel.Id, Page: 0, PerPage: 1})
+		require.Nil(t, appErr)
+		require.Len(t, posts.Order, 1)
+		post := posts.Posts[posts.Order[0]]
+		assert.Equal(t, scheduledPost.Message, post.Message)
+		assert.Equal(t, th.BasicUser.Id, post.UserId)
+
+		_, appErr = th.App.GetScheduledPost(scheduledPost.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, 404, appErr.StatusCode)
+	})
+
+	t.Run("keeps posts for channels the user left", func(t *testing.T) {
+		channel := th.CreateChannel(th.Context, th.BasicTeam)
+		scheduledPost := saveDue(t, channel.Id, "not delivered")
+		require.Nil(t, th.App.RemoveUserFromChannel(th.Context, th.BasicUser.Id, th.SystemAdminUser.Id, channel))
+
+		require.Nil(t, th.App.ProcessScheduledPosts(th.Context))
+
+		failed, appErr := th.App.GetScheduledPost(scheduledPost.Id)
+		require.Nil(t, appErr)
+		assert.False(t, failed.IsPending())
+		assert.Equal(t, model.ScheduledPostErrorNoChannelMember, failed.ErrorCode)
+
+		t.Run("updating queues it again", func(t *testing.T) {
+			th.AddUserToChannel(th.BasicUser, channel)
+
+			failed.ScheduledAt = model.GetMillis() + 60000
+			updated, appErr := th.App.UpdateScheduledPost(th.Context, failed, "")
+			require.Nil(t, appErr)
+			assert.True(t, updated.IsPending())
+			assert.Empty(t, updated.ErrorCode)
+		})
+	})
+
+	t.Run("keeps posts for archived channels", func(t *testing.T) {
+		channel := th.CreateChannel(th.Context, th.BasicTeam)
+		scheduledPost := saveDue(t, channel.Id, "not delivered")
+		require.Nil(t, th.App.DeleteChannel(th.Context, channel, th.SystemAdminUser.Id))
+
+		require.Nil(t, th.App.ProcessScheduledPosts(th.Context))
+
+		failed, appErr := th.App.GetScheduledPost(scheduledPost.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.ScheduledPostErrorChannelArchived, failed.ErrorCode)
+	})
+}
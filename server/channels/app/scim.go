@@ -0,0 +1,357 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+)
+
+// scimPageBounds returns the bounds of the page of a list of the given length starting at the
+// given 1-based index.
+func scimPageBounds(length, startIndex, count int) (int, int) {
+	start := startIndex - 1
+	if start > length {
+		start = length
+	}
+	end := start + count
+	if end > length {
+		end = length
+	}
+	return start, end
+}
+
+// GetSCIMUsers returns the page of the users provisioned through SCIM matching the given filter,
+// along with the total number of matching users. Provisioned users are the users signing in
+// with the authentication service configured for SCIM, including the deactivated ones.
+func (a *App) GetSCIMUsers(filter *model.SCIMFilter, startIndex, count int) ([]*model.User, int, *model.AppError) {
+	options := model.UserGetByAuthServiceOptions{
+		Offset: startIndex - 1,
+		Limit:  count,
+	}
+
+	switch {
+	case filter == nil:
+	case filter.Is("externalId"):
+		options.AuthData = filter.Value
+	case filter.Is("emails.value"):
+		options.Email = filter.Value
+	case filter.Is("userName"):
+		// Identity providers commonly use emails as user names, from which only the local part is
+		// kept for the username.
+		if model.IsValidEmail(strings.ToLower(filter.Value)) {
+			options.Email = filter.Value
+		} else {
+			options.Username = filter.Value
+		}
+	default:
+		return nil, 0, model.NewAppError("GetSCIMUsers", "model.scim.filter.app_error", nil, "attribute="+filter.Attribute, http.StatusBadRequest)
+	}
+
+	// An empty filter value matches no user rather than all of them.
+	if filter != nil && filter.Value == "" {
+		return []*model.User{}, 0, nil
+	}
+
+	users, total, err := a.Srv().Store().User().GetPageUsingAuthService(*a.Config().SCIMSettings.AuthService, options)
+	if err != nil {
+		return nil, 0, model.NewAppError("GetSCIMUsers", "app.user.get_profiles.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return users, int(total), nil
+}
+
+// GetSCIMUser returns the user with the given id if it was provisioned through SCIM.
+func (a *App) GetSCIMUser(userID string) (*model.User, *model.AppError) {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if user.AuthService != *a.Config().SCIMSettings.AuthService {
+		return nil, model.NewAppError("GetSCIMUser", "app.scim.user_not_provisioned.app_error", nil, "user_id="+userID, http.StatusNotFound)
+	}
+
+	return user, nil
+}
+
+// CreateSCIMUser provisions a user signing in with the authentication service configured for
+// SCIM. The user's auth data is the external id given by the identity provider, or its user name
+// if there is none.
+func (a *App) CreateSCIMUser(c request.CTX, scimUser *model.SCIMUser) (*model.User, *model.AppError) {
+	authService := *a.Config().SCIMSettings.AuthService
+
+	user := &model.User{
+		AuthService:   authService,
+		AuthData:      model.NewString(scimUser.UserName),
+		EmailVerified: true,
+	}
+	scimUser.ApplyToUser(user)
+
+	if user.Email == "" {
+		return nil, model.NewAppError("CreateSCIMUser", "app.scim.email_required.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if _, appErr := a.GetUserByAuth(user.AuthData, authService); appErr == nil {
+		return nil, model.NewAppError("CreateSCIMUser", "app.scim.user_exists.app_error", nil, "", http.StatusConflict)
+	}
+
+	ruser, appErr := a.CreateUser(c, user)
+	if appErr != nil {
+		return nil, scimConflictError(appErr)
+	}
+
+	if !scimUser.IsActive() {
+		return a.UpdateActive(c, ruser, false)
+	}
+
+	return ruser, nil
+}
+
+// UpdateSCIMUser replaces the attributes of a provisioned user, including its active state, with
+// the ones of the given SCIM user.
+func (a *App) UpdateSCIMUser(c request.CTX, user *model.User, scimUser *model.SCIMUser) (*model.User, *model.AppError) {
+	updated := user.DeepCopy()
+	scimUser.ApplyToUser(updated)
+
+	if updated.Email == "" {
+		return nil, model.NewAppError("UpdateSCIMUser", "app.scim.email_required.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if updated.AuthData != nil && (user.AuthData == nil || *updated.AuthData != *user.AuthData) {
+		if _, appErr := a.UpdateUserAuth(user.Id, &model.UserAuth{AuthService: user.AuthService, AuthData: updated.AuthData}); appErr != nil {
+			return nil, scimConflictError(appErr)
+		}
+	}
+
+	ruser, appErr := a.UpdateUser(c, updated, false)
+	if appErr != nil {
+		return nil, scimConflictError(appErr)
+	}
+
+	if active := ruser.DeleteAt == 0; active != scimUser.IsActive() {
+		return a.UpdateActive(c, ruser, scimUser.IsActive())
+	}
+
+	return ruser, nil
+}
+
+// scimConflictError reports the users conflicting with a provisioned user as conflicts, which
+// identity providers expect to match existing accounts.
+func scimConflictError(appErr *model.AppError) *model.AppError {
+	switch appErr.Id {
+	case "app.user.save.email_exists.app_error", "app.user.save.username_exists.app_error", "app.user.update_auth_data.email_exists.app_error":
+		appErr.StatusCode = http.StatusConflict
+	}
+	return appErr
+}
+
+// GetSCIMGroups returns the page of the groups provisioned through SCIM matching the given
+// filter, along with the total number of matching groups.
+func (a *App) GetSCIMGroups(filter *model.SCIMFilter, startIndex, count int) ([]*model.Group, int, *model.AppError) {
+	if filter != nil && !filter.Is("displayName") && !filter.Is("externalId") {
+		return nil, 0, model.NewAppError("GetSCIMGroups", "model.scim.filter.app_error", nil, "attribute="+filter.Attribute, http.StatusBadRequest)
+	}
+
+	groups, appErr := a.GetGroupsBySource(model.GroupSourceSCIM)
+	if appErr != nil {
+		return nil, 0, appErr
+	}
+
+	if filter != nil {
+		matching := []*model.Group{}
+		for _, group := range groups {
+			if (filter.Is("displayName") && strings.EqualFold(group.DisplayName, filter.Value)) ||
+				(filter.Is("externalId") && group.GetRemoteId() == filter.Value) {
+				matching = append(matching, group)
+			}
+		}
+		groups = matching
+	}
+
+	start, end := scimPageBounds(len(groups), startIndex, count)
+	return groups[start:end], len(groups), nil
+}
+
+// GetSCIMGroup returns the group with the given id if it was provisioned through SCIM, along with
+// its members.
+func (a *App) GetSCIMGroup(groupID string) (*model.Group, []*model.User, *model.AppError) {
+	group, appErr := a.GetGroup(groupID, nil, nil)
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	if group.Source != model.GroupSourceSCIM || group.DeleteAt != 0 {
+		return nil, nil, model.NewAppError("GetSCIMGroup", "app.group.no_rows", nil, "group_id="+groupID, http.StatusNotFound)
+	}
+
+	members, appErr := a.GetGroupMemberUsers(groupID)
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	return group, members, nil
+}
+
+// CreateSCIMGroup provisions a group. A group previously deleted with the same external id is
+// restored instead.
+func (a *App) CreateSCIMGroup(scimGroup *model.SCIMGroup) (*model.Group, []*model.User, *model.AppError) {
+	if scimGroup.ExternalId != "" {
+		existing, appErr := a.GetGroupByRemoteID(scimGroup.ExternalId, model.GroupSourceSCIM)
+		if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+			return nil, nil, appErr
+		}
+		if existing != nil {
+			if existing.DeleteAt == 0 {
+				return nil, nil, model.NewAppError("CreateSCIMGroup", "app.scim.group_exists.app_error", nil, "", http.StatusConflict)
+			}
+			return a.restoreSCIMGroup(existing, scimGroup)
+		}
+	}
+
+	memberIDs := scimGroup.MemberIDs()
+	if appErr := a.checkSCIMGroupMembers(memberIDs); appErr != nil {
+		return nil, nil, appErr
+	}
+
+	group := &model.Group{
+		Id:          model.NewId(),
+		DisplayName: scimGroup.DisplayName,
+		Source:      model.GroupSourceSCIM,
+	}
+	group.RemoteId = model.NewString(group.Id)
+	if scimGroup.ExternalId != "" {
+		group.RemoteId = model.NewString(scimGroup.ExternalId)
+	}
+
+	if appErr := group.IsValidForCreate(); appErr != nil {
+		return nil, nil, appErr
+	}
+
+	group, appErr := a.CreateGroup(group)
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	if len(memberIDs) > 0 {
+		if _, appErr := a.UpsertGroupMembers(group.Id, memberIDs); appErr != nil {
+			return nil, nil, appErr
+		}
+	}
+
+	members, appErr := a.GetGroupMemberUsers(group.Id)
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	return group, members, nil
+}
+
+func (a *App) restoreSCIMGroup(group *model.Group, scimGroup *model.SCIMGroup) (*model.Group, []*model.User, *model.AppError) {
+	restored, appErr := a.RestoreGroup(group.Id)
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	members, appErr := a.GetGroupMemberUsers(restored.Id)
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	// The members of the restored group are replaced even if none are given.
+	if scimGroup.Members == nil {
+		scimGroup.Members = []model.SCIMMember{}
+	}
+
+	return a.UpdateSCIMGroup(restored, members, scimGroup)
+}
+
+// UpdateSCIMGroup replaces the display name of a provisioned group with the one of the given SCIM
+// group and, if the SCIM group lists its members, synchronizes the members of the group.
+func (a *App) UpdateSCIMGroup(group *model.Group, members []*model.User, scimGroup *model.SCIMGroup) (*model.Group, []*model.User, *model.AppError) {
+	if scimGroup.DisplayName != group.DisplayName {
+		updated := *group
+		updated.DisplayName = scimGroup.DisplayName
+		if appErr := updated.IsValidForUpdate(); appErr != nil {
+			return nil, nil, appErr
+		}
+
+		var appErr *model.AppError
+		if group, appErr = a.UpdateGroup(&updated); appErr != nil {
+			return nil, nil, appErr
+		}
+	}
+
+	if scimGroup.Members == nil {
+		return group, members, nil
+	}
+
+	memberIDs := scimGroup.MemberIDs()
+	if appErr := a.checkSCIMGroupMembers(memberIDs); appErr != nil {
+		return nil, nil, appErr
+	}
+
+	current := make(map[string]bool, len(members))
+	for _, member := range members {
+		current[member.Id] = true
+	}
+
+	toAdd := []string{}
+	for _, userID := range memberIDs {
+		if !current[userID] {
+			toAdd = append(toAdd, userID)
+		}
+		delete(current, userID)
+	}
+	toRemove := make([]string, 0, len(current))
+	for userID := range current {
+		toRemove = append(toRemove, userID)
+	}
+
+	if len(toAdd) > 0 {
+		if _, appErr := a.UpsertGroupMembers(group.Id, toAdd); appErr != nil {
+			return nil, nil, appErr
+		}
+	}
+	if len(toRemove) > 0 {
+		if _, appErr := a.DeleteGroupMembers(group.Id, toRemove); appErr != nil {
+			return nil, nil, appErr
+		}
+	}
+
+	members, appErr := a.GetGroupMemberUsers(group.Id)
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	return group, members, nil
+}
+
+// checkSCIMGroupMembers checks that the given ids, as listed by an identity provider as the
+// members of a group, are the ids of existing users.
+func (a *App) checkSCIMGroupMembers(userIDs []string) *model.AppError {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	users, appErr := a.GetUsers(userIDs)
+	if appErr != nil {
+		return appErr
+	}
+
+	found := make(map[string]bool, len(users))
+	for _, user := range users {
+		found[user.Id] = true
+	}
+	for _, userID := range userIDs {
+		if !found[userID] {
+			return model.NewAppError("checkSCIMGroupMembers", "app.scim.unknown_member.app_error", map[string]any{"UserId": userID}, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
@@ -60,3 +60,9 @@ func (a *App) PurgeBleveIndexes() *model.AppError {
 	}
 	return nil
 }
+
+// GetSearchEngineQueryStats returns the latency and health of the search engines for each type of
+// query they were sent since the server started.
+func (a *App) GetSearchEngineQueryStats() []*model.SearchEngineQueryStats {
+	return a.SearchEngine().GetQueryStats()
+}
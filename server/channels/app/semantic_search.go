@@ -0,0 +1,312 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/embedding"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// semanticSearchRankConstant dampens the difference between the top ranked results when the
+// keyword and semantic results are ranked together, as in reciprocal rank fusion.
+const semanticSearchRankConstant = 60
+
+// databaseVectorStore stores the embeddings in the PostEmbeddings table.
+type databaseVectorStore struct {
+	store store.PostEmbeddingStore
+}
+
+func (s *databaseVectorStore) SaveEmbeddings(embeddings []*model.PostEmbedding) *model.AppError {
+	if err := s.store.Save(embeddings); err != nil {
+		var appErr *model.AppError
+		if errors.As(err, &appErr) {
+			return appErr
+		}
+		return model.NewAppError("SaveEmbeddings", "app.post_embedding.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+func (s *databaseVectorStore) SearchEmbeddings(embedding []float32, embeddingModel string, channelIDs []string, limit int) ([]*model.PostEmbeddingMatch, *model.AppError) {
+	matches, err := s.store.Search(embedding, embeddingModel, channelIDs, limit)
+	if err != nil {
+		var niErr *store.ErrNotImplemented
+		if errors.As(err, &niErr) {
+			return nil, model.NewAppError("SearchEmbeddings", "app.post_embedding.search.not_supported.app_error", nil, "", http.StatusNotImplemented).Wrap(err)
+		}
+		return nil, model.NewAppError("SearchEmbeddings", "app.post_embedding.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return matches, nil
+}
+
+func (s *databaseVectorStore) DeleteEmbeddings(postIDs []string) *model.AppError {
+	if err := s.store.Delete(postIDs); err != nil {
+		return model.NewAppError("DeleteEmbeddings", "app.post_embedding.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+// vectorStore returns where the embeddings of the posts are stored, as configured.
+func (a *App) vectorStore() (einterfaces.VectorStoreInterface, *model.AppError) {
+	if *a.Config().SemanticSearchSettings.VectorStore == model.SemanticSearchVectorStoreExternal {
+		if a.ch.VectorStore == nil {
+			return nil, model.NewAppError("vectorStore", "app.post_embedding.vector_store_unavailable.app_error", nil, "", http.StatusNotImplemented)
+		}
+		return a.ch.VectorStore, nil
+	}
+	return &databaseVectorStore{store: a.Srv().Store().PostEmbedding()}, nil
+}
+
+// isEmbeddablePost returns whether the message of a post is worth indexing for semantic search.
+// The messages of encrypted posts can't be read by the server.
+func isEmbeddablePost(post *model.Post) bool {
+	return post.Type == model.PostTypeDefault && !post.IsEncrypted() && strings.TrimSpace(post.Message) != ""
+}
+
+// IndexPostEmbeddings computes the embeddings of a batch of the posts created or edited after
+// cursor, and removes the embeddings of the posts deleted since. It returns the cursor to index
+// the next batch from, and how many posts were read, which is zero once indexing caught up.
+func (a *App) IndexPostEmbeddings(c request.CTX, cursor model.GetPostsSinceForSyncCursor) (model.GetPostsSinceForSyncCursor, int, *model.AppError) {
+	settings := a.Config().SemanticSearchSettings
+
+	posts, nextCursor, err := a.Srv().Store().Post().GetPostsSinceForSync(model.GetPostsSinceForSyncOptions{IncludeDeleted: true}, cursor, *settings.IndexingBatchSize)
+	if err != nil {
+		return cursor, 0, model.NewAppError("IndexPostEmbeddings", "app.post_embedding.get_posts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if len(posts) == 0 {
+		return cursor, 0, nil
+	}
+
+	vectorStore, appErr := a.vectorStore()
+	if appErr != nil {
+		return cursor, 0, appErr
+	}
+
+	var postsToIndex []*model.Post
+	var texts []string
+	var deletedPostIDs []string
+	for _, post := range posts {
+		if post.DeleteAt != 0 {
+			deletedPostIDs = append(deletedPostIDs, post.Id)
+			continue
+		}
+		if isEmbeddablePost(post) {
+			postsToIndex = append(postsToIndex, post)
+			texts = append(texts, post.Message)
+		}
+	}
+
+	if len(deletedPostIDs) > 0 {
+		if appErr := vectorStore.DeleteEmbeddings(deletedPostIDs); appErr != nil {
+			return cursor, 0, appErr
+		}
+	}
+
+	if len(postsToIndex) > 0 {
+		provider, err := embedding.NewProvider(settings, a.HTTPService().MakeClient(true))
+		if err != nil {
+			return cursor, 0, model.NewAppError("IndexPostEmbeddings", "app.post_embedding.provider.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		vectors, err := provider.Embed(c.Context(), texts)
+		if err != nil {
+			return cursor, 0, model.NewAppError("IndexPostEmbeddings", "app.post_embedding.embed.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		embeddings := make([]*model.PostEmbedding, 0, len(postsToIndex))
+		for i, post := range postsToIndex {
+			embeddings = append(embeddings, &model.PostEmbedding{
+				PostId:    post.Id,
+				ChannelId: post.ChannelId,
+				Model:     provider.Model(),
+				Embedding: vectors[i],
+			})
+		}
+
+		if appErr := vectorStore.SaveEmbeddings(embeddings); appErr != nil {
+			return cursor, 0, appErr
+		}
+	}
+
+	return nextCursor, len(posts), nil
+}
+
+func (a *App) deletePostEmbeddings(postID string) {
+	if !*a.Config().SemanticSearchSettings.EnableIndexing {
+		return
+	}
+
+	vectorStore, appErr := a.vectorStore()
+	if appErr != nil {
+		return
+	}
+
+	if appErr := vectorStore.DeleteEmbeddings([]string{postID}); appErr != nil {
+		a.Log().Warn("Encountered error when deleting the embedding of post", mlog.String("post_id", postID), mlog.Err(appErr))
+	}
+}
+
+// SemanticSearchPostsForUser searches the posts of the channels the user is a member of by the
+// meaning of the terms, and ranks the results together with the results of the keyword search.
+// The search flags only narrow down the keyword results.
+func (a *App) SemanticSearchPostsForUser(c *request.Context, terms string, userID string, teamID string, isOrSearch bool, includeDeletedChannels bool, timeZoneOffset int, page, perPage int) (*model.PostSearchResults, *model.AppError) {
+	settings := a.Config().SemanticSearchSettings
+	if !*settings.EnableSearching {
+		return nil, model.NewAppError("SemanticSearchPostsForUser", "app.post_embedding.search.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	query := semanticSearchQuery(terms, timeZoneOffset)
+	if query == "" {
+		return a.SearchPostsForUser(c, terms, userID, teamID, isOrSearch, includeDeletedChannels, timeZoneOffset, page, perPage, "")
+	}
+
+	// Enough results of both searches are needed to rank the requested page
+	limit := (page + 1) * perPage
+
+	keywordResults, appErr := a.SearchPostsForUser(c, terms, userID, teamID, isOrSearch, includeDeletedChannels, timeZoneOffset, 0, limit, "")
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	provider, err := embedding.NewProvider(settings, a.HTTPService().MakeClient(true))
+	if err != nil {
+		return nil, model.NewAppError("SemanticSearchPostsForUser", "app.post_embedding.provider.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	vectors, err := provider.Embed(c.Context(), []string{query})
+	if err != nil {
+		return nil, model.NewAppError("SemanticSearchPostsForUser", "app.post_embedding.embed.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	includeDeleted := includeDeletedChannels && *a.Config().TeamSettings.ExperimentalViewArchivedChannels
+	channels, err := a.Srv().Store().Channel().GetChannels(teamID, userID, &model.ChannelSearchOpts{IncludeDeleted: includeDeleted})
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return nil, model.NewAppError("SemanticSearchPostsForUser", "app.channel.get_channels.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	channelIDs := make([]string, 0, len(channels))
+	for _, channel := range channels {
+		channelIDs = append(channelIDs, channel.Id)
+	}
+
+	vectorStore, appErr := a.vectorStore()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	matches, appErr := vectorStore.SearchEmbeddings(vectors[0], provider.Model(), channelIDs, limit)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	rankedIDs := rankSearchResults(keywordResults.PostList.Order, matches, *settings.SemanticWeightPercent)
+
+	start := page * perPage
+	if start >= len(rankedIDs) {
+		return model.MakePostSearchResults(model.NewPostList(), nil), nil
+	}
+	end := start + perPage
+	if end > len(rankedIDs) {
+		end = len(rankedIDs)
+	}
+	rankedIDs = rankedIDs[start:end]
+
+	var missingIDs []string
+	for _, postID := range rankedIDs {
+		if _, ok := keywordResults.PostList.Posts[postID]; !ok {
+			missingIDs = append(missingIDs, postID)
+		}
+	}
+
+	posts := keywordResults.PostList.Posts
+	if len(missingIDs) > 0 {
+		semanticPosts, err := a.Srv().Store().Post().GetPostsByIds(missingIDs)
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if !errors.As(err, &nfErr) {
+				return nil, model.NewAppError("SemanticSearchPostsForUser", "app.post.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+		for _, post := range semanticPosts {
+			posts[post.Id] = post
+		}
+	}
+
+	postList := model.NewPostList()
+	matchedTerms := model.PostSearchMatches{}
+	for _, postID := range rankedIDs {
+		post, ok := posts[postID]
+		if !ok || post.DeleteAt != 0 {
+			continue
+		}
+		postList.AddPost(post)
+		postList.AddOrder(postID)
+		if terms, ok := keywordResults.Matches[postID]; ok {
+			matchedTerms[postID] = terms
+		}
+	}
+
+	if appErr := a.filterInaccessiblePosts(postList, filterPostOptions{}); appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.FilterRestrictedPostList(userID, postList); appErr != nil {
+		return nil, appErr
+	}
+
+	return model.MakePostSearchResults(postList, matchedTerms), nil
+}
+
+// semanticSearchQuery returns the terms of a search without its flags, which have no meaning to
+// compare the posts with.
+func semanticSearchQuery(terms string, timeZoneOffset int) string {
+	var query []string
+	for _, params := range model.ParseSearchParams(strings.TrimSpace(terms), timeZoneOffset) {
+		if params.Terms != "" && params.Terms != "*" {
+			query = append(query, params.Terms)
+		}
+	}
+	return strings.Join(query, " ")
+}
+
+// rankSearchResults ranks the posts found by the keyword and semantic searches together, by the
+// weighted reciprocal rank of each post in both lists of results. semanticWeightPercent is how
+// much the rank in the semantic results weighs, as a percentage.
+func rankSearchResults(keywordIDs []string, matches []*model.PostEmbeddingMatch, semanticWeightPercent int) []string {
+	semanticWeight := float64(semanticWeightPercent) / 100
+	keywordWeight := 1 - semanticWeight
+
+	scores := make(map[string]float64, len(keywordIDs)+len(matches))
+	for i, postID := range keywordIDs {
+		scores[postID] += keywordWeight / float64(semanticSearchRankConstant+i+1)
+	}
+	for i, match := range matches {
+		scores[match.PostId] += semanticWeight / float64(semanticSearchRankConstant+i+1)
+	}
+
+	rankedIDs := make([]string, 0, len(scores))
+	for postID, score := range scores {
+		if score > 0 {
+			rankedIDs = append(rankedIDs, postID)
+		}
+	}
+	sort.Slice(rankedIDs, func(i, j int) bool {
+		if scores[rankedIDs[i]] != scores[rankedIDs[j]] {
+			return scores[rankedIDs[i]] > scores[rankedIDs[j]]
+		}
+		return rankedIDs[i] < rankedIDs[j]
+	})
+	return rankedIDs
+}
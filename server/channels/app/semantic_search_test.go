@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces/mocks"
+)
+
+// makeTestEmbeddingServer returns an Ollama compatible server embedding every text into the same
+// vector.
+func makeTestEmbeddingServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Input []string `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		embeddings := make([][]float32, len(req.Input))
+		for i := range embeddings {
+			embeddings[i] = []float32{1, 0}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"embeddings": embeddings})
+	}))
+}
+
+func setupSemanticSearch(th *TestHelper, serverURL string) *mocks.VectorStoreInterface {
+	vectorStore := &mocks.VectorStoreInterface{}
+	th.App.ch.VectorStore = vectorStore
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.SemanticSearchSettings.EnableIndexing = true
+		*cfg.SemanticSearchSettings.EnableSearching = true
+		*cfg.SemanticSearchSettings.EmbeddingProvider = model.EmbeddingProviderOllama
+		*cfg.SemanticSearchSettings.EmbeddingAPIURL = serverURL
+		*cfg.SemanticSearchSettings.EmbeddingModel = "nomic-embed-text"
+		*cfg.SemanticSearchSettings.VectorStore = model.SemanticSearchVectorStoreExternal
+	})
+	return vectorStore
+}
+
+func TestIndexPostEmbeddings(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	server := makeTestEmbeddingServer(t)
+	defer server.Close()
+	vectorStore := setupSemanticSearch(th, server.URL)
+
+	vectorStore.On("DeleteEmbeddings", mock.Anything).Return(nil)
+
+	cursor := model.GetPostsSinceForSyncCursor{LastPostUpdateAt: model.GetMillis()}
+	post := th.CreatePost(th.BasicChannel)
+	deletedPost := th.CreatePost(th.BasicChannel)
+	_, appErr := th.App.DeletePost(th.Context, deletedPost.Id, th.BasicUser.Id)
+	require.Nil(t, appErr)
+	vectorStore.On("SaveEmbeddings", mock.MatchedBy(func(embeddings []*model.PostEmbedding) bool {
+		return len(embeddings) == 1 &&
+			embeddings[0].PostId == post.Id &&
+			embeddings[0].ChannelId == th.BasicChannel.Id &&
+			embeddings[0].Model == "nomic-embed-text"
+	})).Return(nil).Once()
+
+	next, count, appErr := th.App.IndexPostEmbeddings(th.Context, cursor)
+	require.Nil(t, appErr)
+	assert.Equal(t, 2, count)
+	vectorStore.AssertCalled(t, "DeleteEmbeddings", []string{deletedPost.Id})
+
+	t.Run("indexing caught up", func(t *testing.T) {
+		_, count, appErr := th.App.IndexPostEmbeddings(th.Context, next)
+		require.Nil(t, appErr)
+		assert.Zero(t, count)
+	})
+
+	vectorStore.AssertExpectations(t)
+}
+
+func TestSemanticSearchPostsForUser(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("disabled", func(t *testing.T) {
+		_, appErr := th.App.SemanticSearchPostsForUser(th.Context, "hello", th.BasicUser.Id, th.BasicTeam.Id, false, false, 0, 0, 20)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post_embedding.search.disabled.app_error", appErr.Id)
+	})
+
+	server := makeTestEmbeddingServer(t)
+	defer server.Close()
+	vectorStore := setupSemanticSearch(th, server.URL)
+
+	keywordPost, appErr := th.App.CreatePost(th.Context, &model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "greetings everyone",
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+	semanticPost, appErr := th.App.CreatePost(th.Context, &model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "hi all",
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+
+	vectorStore.On("SearchEmbeddings", []float32{1, 0}, "nomic-embed-text", mock.Anything, 20).Return([]*model.PostEmbeddingMatch{
+		{PostId: semanticPost.Id, Score: 0.9},
+	}, nil)
+
+	results, appErr := th.App.SemanticSearchPostsForUser(th.Context, "greetings", th.BasicUser.Id, th.BasicTeam.Id, false, false, 0, 0, 20)
+	require.Nil(t, appErr)
+	assert.ElementsMatch(t, []string{keywordPost.Id, semanticPost.Id}, results.PostList.Order)
+	assert.Contains(t, results.Matches, keywordPost.Id)
+	assert.NotContains(t, results.Matches, semanticPost.Id)
+}
+
+func TestRankSearchResults(t *testing.T) {
+	keywordIDs := []string{"a", "b", "c"}
+	matches := []*model.PostEmbeddingMatch{{PostId: "c"}, {PostId: "d"}}
+
+	t.Run("posts found by both searches rank first", func(t *testing.T) {
+		assert.Equal(t, []string{"c", "a", "b", "d"}, rankSearchResults(keywordIDs, matches, 50))
+	})
+
+	t.Run("keyword results only", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b", "c"}, rankSearchResults(keywordIDs, matches, 0))
+	})
+
+	t.Run("semantic results only", func(t *testing.T) {
+		assert.Equal(t, []string{"c", "d"}, rankSearchResults(keywordIDs, matches, 100))
+	})
+}
+
+func TestSemanticSearchQuery(t *testing.T) {
+	assert.Equal(t, "release plans", semanticSearchQuery("from:alice release plans in:town-square", 0))
+	assert.Equal(t, "", semanticSearchQuery("from:alice", 0))
+}
@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -38,19 +39,35 @@ import (
 	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/active_users"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/channel_counters"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/channel_export"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/channel_member_expiry"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/channel_members_bulk"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/cold_storage"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/expired_posts"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/expirynotify"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/export_delete"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/export_process"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/extract_content"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/guest_expiration"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/hosted_purchase_screening"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/import_delete"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/import_process"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/last_accessible_file"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/last_accessible_post"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/migrations"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/msteams_import"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/notify_admin"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/partition_maintenance"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/post_archive"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/product_notices"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/reaction_summaries"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/resend_invitation_email"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/scheduled_posts"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/semantic_search_indexing"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/slack_import"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/team_archive"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs/user_offboarding"
 	"github.com/mattermost/mattermost-server/v6/server/channels/product"
 	"github.com/mattermost/mattermost-server/v6/server/channels/store"
 	"github.com/mattermost/mattermost-server/v6/server/channels/utils"
@@ -88,13 +105,22 @@ type Server struct {
 	// from RootRouter only if the SiteURL contains a /subpath.
 	Router *mux.Router
 
-	Server      *http.Server
-	ListenAddr  *net.TCPAddr
-	RateLimiter *RateLimiter
+	Server     *http.Server
+	ListenAddr *net.TCPAddr
+
+	// rateLimiter holds the *RateLimiter of the HTTP server, nil when rate limiting is disabled.
+	rateLimiter atomic.Value
+	// tlsCertificate holds the *tls.Certificate loaded from the TLSCertFile and TLSKeyFile.
+	tlsCertificate atomic.Value
 
 	localModeServer *http.Server
 
-	didFinishListen chan struct{}
+	// httpListenerMut guards the listener of the HTTP server, replaced when it's rebound, along
+	// with the channel closed once it's done serving.
+	httpListenerMut      sync.Mutex
+	httpListener         net.Listener
+	didFinishListen      chan struct{}
+	httpConfigListenerId string
 
 	EmailService email.ServiceInterface
 
@@ -141,6 +167,15 @@ type Server struct {
 	services map[product.ServiceKey]any
 
 	hooksManager *product.HooksManager
+
+	integrationBreakers      *integrationBreakers
+	typingAggregator         *typingAggregator
+	pushNotificationReceipts *pushNotificationReceipts
+	quietHoursSummaries      *quietHoursSummaries
+	nodeDrain                *nodeDrain
+
+	// The Matrix puppets known to have joined a bridged room, keyed by room and puppet id.
+	matrixJoinedPuppets sync.Map
 }
 
 func (s *Server) Store() store.Store {
@@ -162,11 +197,17 @@ func NewServer(options ...Option) (*Server, error) {
 	localRouter := mux.NewRouter()
 
 	s := &Server{
-		RootRouter:  rootRouter,
-		LocalRouter: localRouter,
-		timezones:   timezones.New(),
-		products:    make(map[string]product.Product),
-		services:    make(map[product.ServiceKey]any),
+		RootRouter:          rootRouter,
+		LocalRouter:         localRouter,
+		timezones:           timezones.New(),
+		products:            make(map[string]product.Product),
+		services:            make(map[product.ServiceKey]any),
+		integrationBreakers: newIntegrationBreakers(),
+		typingAggregator:    newTypingAggregator(),
+
+		pushNotificationReceipts: newPushNotificationReceipts(),
+		quietHoursSummaries:      newQuietHoursSummaries(),
+		nodeDrain:                newNodeDrain(),
 	}
 
 	for _, option := range options {
@@ -331,11 +372,13 @@ func NewServer(options ...Option) (*Server, error) {
 
 	if s.seenPendingPostIdsCache, err = s.platform.CacheProvider().NewCache(&cache.CacheOptions{
 		Size: PendingPostIDsCacheSize,
+		Name: "PendingPostIds",
 	}); err != nil {
 		return nil, errors.Wrap(err, "Unable to create pending post ids cache")
 	}
 	if s.openGraphDataCache, err = s.platform.CacheProvider().NewCache(&cache.CacheOptions{
 		Size: openGraphMetadataCacheSize,
+		Name: "OpenGraphData",
 	}); err != nil {
 		return nil, errors.Wrap(err, "Unable to create opengraphdata cache")
 	}
@@ -371,6 +414,7 @@ func NewServer(options ...Option) (*Server, error) {
 	emailService, err := email.NewService(email.ServiceConfig{
 		ConfigFn:           s.platform.Config,
 		LicenseFn:          s.License,
+		FileBackendFn:      s.FileBackend,
 		TemplatesContainer: s.TemplatesContainer(),
 		UserService:        s.userService,
 		Store:              s.GetStore(),
@@ -487,6 +531,7 @@ func NewServer(options ...Option) (*Server, error) {
 			s.runInactivityCheckJob()
 			runDNDStatusExpireJob(appInstance)
 			runPostReminderJob(appInstance)
+			runCalendarStatusSyncJob(appInstance)
 		})
 		s.runJobs()
 	}
@@ -541,6 +586,12 @@ func (s *Server) runJobs() {
 		complianceI.StartComplianceDailyJob()
 	}
 
+	if s.Jobs != nil {
+		if appErr := s.Jobs.LoadTypeSettings(); appErr != nil {
+			mlog.Warn("Failed to load the job type settings", mlog.Err(appErr))
+		}
+	}
+
 	if *s.platform.Config().JobSettings.RunJobs && s.Jobs != nil {
 		if err := s.Jobs.StartWorkers(); err != nil {
 			mlog.Error("Failed to start job server workers", mlog.Err(err))
@@ -645,17 +696,27 @@ func (s *Server) startInterClusterServices(license *model.License) error {
 const TimeToWaitForConnectionsToCloseOnServerShutdown = time.Second
 
 func (s *Server) StopHTTPServer() {
+	if s.httpConfigListenerId != "" {
+		s.platform.RemoveConfigListener(s.httpConfigListenerId)
+		s.httpConfigListenerId = ""
+	}
+
 	if s.Server != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), TimeToWaitForConnectionsToCloseOnServerShutdown)
 		defer cancel()
+
+		s.httpListenerMut.Lock()
+		didFinishListen := s.didFinishListen
+		s.httpListenerMut.Unlock()
+
 		didShutdown := false
-		for s.didFinishListen != nil && !didShutdown {
+		for didFinishListen != nil && !didShutdown {
 			if err := s.Server.Shutdown(ctx); err != nil {
 				mlog.Warn("Unable to shutdown server", mlog.Err(err))
 			}
 			timer := time.NewTimer(time.Millisecond * 50)
 			select {
-			case <-s.didFinishListen:
+			case <-didFinishListen:
 				didShutdown = true
 			case <-timer.C:
 			}
@@ -663,6 +724,10 @@ func (s *Server) StopHTTPServer() {
 		}
 		s.Server.Close()
 		s.Server = nil
+
+		s.httpListenerMut.Lock()
+		s.httpListener = nil
+		s.httpListenerMut.Unlock()
 	}
 }
 
@@ -674,6 +739,9 @@ func (s *Server) Shutdown() {
 	s.RemoveLicenseListener(s.loggerLicenseListenerId)
 	s.RemoveClusterLeaderChangedListener(s.clusterLeaderListenerId)
 
+	// The drain state of the node is removed while the store is available.
+	s.stopDraining()
+
 	if s.tracer != nil {
 		if err := s.tracer.Close(); err != nil {
 			s.Log().Warn("Unable to cleanly shutdown opentracing client", mlog.Err(err))
@@ -930,17 +998,12 @@ func (s *Server) Start() error {
 		handler = corsWrapper.Handler(handler)
 	}
 
-	if *s.platform.Config().RateLimitSettings.Enable {
-		mlog.Info("RateLimiter is enabled")
-
-		rateLimiter, err2 := NewRateLimiter(&s.platform.Config().RateLimitSettings, s.platform.Config().ServiceSettings.TrustedProxyIPHeader)
-		if err2 != nil {
-			return err2
-		}
-
-		s.RateLimiter = rateLimiter
-		handler = rateLimiter.RateLimitHandler(handler)
+	// The rate limiter is always in the chain, for rate limiting to be enabled by a config reload.
+	if err = s.reloadRateLimiter(s.platform.Config()); err != nil {
+		return err
 	}
+	handler = s.rateLimitHandler(handler)
+	handler = s.drainHandler(handler)
 
 	// Creating a logger for logging errors from http.Server at error level
 	errStdLog := s.Log().With(mlog.String("source", "httpserver")).StdLogger(mlog.LvlError)
@@ -953,14 +1016,7 @@ func (s *Server) Start() error {
 		ErrorLog:     errStdLog,
 	}
 
-	addr := *s.platform.Config().ServiceSettings.ListenAddress
-	if addr == "" {
-		if *s.platform.Config().ServiceSettings.ConnectionSecurity == model.ConnSecurityTLS {
-			addr = ":https"
-		} else {
-			addr = ":http"
-		}
-	}
+	addr := httpListenAddress(s.platform.Config())
 
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -1012,81 +1068,73 @@ func (s *Server) Start() error {
 		return errors.New(i18n.T("api.server.start_server.forward80to443.disabled_while_using_lets_encrypt"))
 	}
 
-	s.didFinishListen = make(chan struct{})
-	go func() {
-		var err error
-		if *s.platform.Config().ServiceSettings.ConnectionSecurity == model.ConnSecurityTLS {
-
-			tlsConfig := &tls.Config{
-				PreferServerCipherSuites: true,
-				CurvePreferences:         []tls.CurveID{tls.CurveP521, tls.CurveP384, tls.CurveP256},
-			}
-
-			switch *s.platform.Config().ServiceSettings.TLSMinVer {
-			case "1.0":
-				tlsConfig.MinVersion = tls.VersionTLS10
-			case "1.1":
-				tlsConfig.MinVersion = tls.VersionTLS11
-			default:
-				tlsConfig.MinVersion = tls.VersionTLS12
-			}
-
-			defaultCiphers := []uint16{
-				tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-				tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-				tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
-				tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
-			}
+	if *s.platform.Config().ServiceSettings.ConnectionSecurity == model.ConnSecurityTLS {
+		tlsConfig := &tls.Config{
+			PreferServerCipherSuites: true,
+			CurvePreferences:         []tls.CurveID{tls.CurveP521, tls.CurveP384, tls.CurveP256},
+		}
 
-			if len(s.platform.Config().ServiceSettings.TLSOverwriteCiphers) == 0 {
-				tlsConfig.CipherSuites = defaultCiphers
-			} else {
-				var cipherSuites []uint16
-				for _, cipher := range s.platform.Config().ServiceSettings.TLSOverwriteCiphers {
-					value, ok := model.ServerTLSSupportedCiphers[cipher]
+		switch *s.platform.Config().ServiceSettings.TLSMinVer {
+		case "1.0":
+			tlsConfig.MinVersion = tls.VersionTLS10
+		case "1.1":
+			tlsConfig.MinVersion = tls.VersionTLS11
+		default:
+			tlsConfig.MinVersion = tls.VersionTLS12
+		}
 
-					if !ok {
-						mlog.Warn("Unsupported cipher passed", mlog.String("cipher", cipher))
-						continue
-					}
+		defaultCiphers := []uint16{
+			tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+		}
 
-					cipherSuites = append(cipherSuites, value)
-				}
+		if len(s.platform.Config().ServiceSettings.TLSOverwriteCiphers) == 0 {
+			tlsConfig.CipherSuites = defaultCiphers
+		} else {
+			var cipherSuites []uint16
+			for _, cipher := range s.platform.Config().ServiceSettings.TLSOverwriteCiphers {
+				value, ok := model.ServerTLSSupportedCiphers[cipher]
 
-				if len(cipherSuites) == 0 {
-					mlog.Warn("No supported ciphers passed, fallback to default cipher suite")
-					cipherSuites = defaultCiphers
+				if !ok {
+					mlog.Warn("Unsupported cipher passed", mlog.String("cipher", cipher))
+					continue
 				}
 
-				tlsConfig.CipherSuites = cipherSuites
+				cipherSuites = append(cipherSuites, value)
 			}
 
-			certFile := ""
-			keyFile := ""
-
-			if *s.platform.Config().ServiceSettings.UseLetsEncrypt {
-				tlsConfig.GetCertificate = m.GetCertificate
-				tlsConfig.NextProtos = append(tlsConfig.NextProtos, "h2")
-			} else {
-				certFile = *s.platform.Config().ServiceSettings.TLSCertFile
-				keyFile = *s.platform.Config().ServiceSettings.TLSKeyFile
+			if len(cipherSuites) == 0 {
+				mlog.Warn("No supported ciphers passed, fallback to default cipher suite")
+				cipherSuites = defaultCiphers
 			}
 
-			s.Server.TLSConfig = tlsConfig
-			err = s.Server.ServeTLS(listener, certFile, keyFile)
-		} else {
-			err = s.Server.Serve(listener)
+			tlsConfig.CipherSuites = cipherSuites
 		}
 
-		if err != nil && err != http.ErrServerClosed {
-			mlog.Fatal("Error starting server", mlog.Err(err))
-			time.Sleep(time.Second)
+		if *s.platform.Config().ServiceSettings.UseLetsEncrypt {
+			tlsConfig.GetCertificate = m.GetCertificate
+			tlsConfig.NextProtos = append(tlsConfig.NextProtos, "h2")
+		} else {
+			// The certificate is served from memory for it to be reloaded with the config.
+			if err = s.ReloadTLSCertificate(); err != nil {
+				listener.Close()
+				return err
+			}
+			tlsConfig.GetCertificate = s.getTLSCertificate
 		}
 
-		close(s.didFinishListen)
-	}()
+		s.Server.TLSConfig = tlsConfig
+	}
+
+	s.httpListenerMut.Lock()
+	s.serveHTTP(listener)
+	s.httpListenerMut.Unlock()
+
+	s.httpConfigListenerId = s.platform.AddConfigListener(s.reloadHTTPServer)
 
 	if *s.platform.Config().ServiceSettings.EnableLocalMode {
 		if err := s.startLocalModeServer(); err != nil {
@@ -1450,6 +1498,19 @@ func (s *Server) ClusterHealthScore() int {
 	return s.platform.Cluster().HealthScore()
 }
 
+// ProductsHealthCheck runs the health check of every product able to report its health, returning
+// the error of each of them by product name. A nil error means the product is healthy.
+func (s *Server) ProductsHealthCheck() map[string]error {
+	results := make(map[string]error)
+	for name, p := range s.products {
+		if checker, ok := p.(product.HealthChecker); ok {
+			results[name] = checker.HealthCheck()
+		}
+	}
+
+	return results
+}
+
 func (ch *Channels) ClientConfigHash() string {
 	return ch.srv.Platform().ClientConfigHash()
 }
@@ -1477,6 +1538,11 @@ func (s *Server) initJobs() {
 		s.Jobs.RegisterJobType(model.JobTypeElasticsearchPostIndexing, builder.MakeWorker(), nil)
 	}
 
+	if jobsElasticsearchIndexMigrationInterface != nil {
+		builder := jobsElasticsearchIndexMigrationInterface(s)
+		s.Jobs.RegisterJobType(model.JobTypeElasticsearchIndexMigration, builder.MakeWorker(), nil)
+	}
+
 	if jobsLdapSyncInterface != nil {
 		builder := jobsLdapSyncInterface(New(ServerConnector(s.Channels())))
 		s.Jobs.RegisterJobType(model.JobTypeLdapSync, builder.MakeWorker(), builder.MakeScheduler())
@@ -1536,6 +1602,12 @@ func (s *Server) initJobs() {
 		nil,
 	)
 
+	s.Jobs.RegisterJobType(
+		model.JobTypeChannelExport,
+		channel_export.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
 	s.Jobs.RegisterJobType(
 		model.JobTypeActiveUsers,
 		active_users.MakeWorker(s.Jobs, s.Store(), func() einterfaces.MetricsInterface { return s.GetMetrics() }),
@@ -1590,6 +1662,96 @@ func (s *Server) initJobs() {
 		hosted_purchase_screening.MakeScheduler(s.Jobs, s.License()),
 	)
 
+	s.Jobs.RegisterJobType(
+		model.JobTypeScheduledPosts,
+		scheduled_posts.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		scheduled_posts.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeExpiredPosts,
+		expired_posts.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		expired_posts.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeSemanticSearchIndexing,
+		semantic_search_indexing.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		semantic_search_indexing.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeReactionSummaries,
+		reaction_summaries.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		reaction_summaries.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeColdStorage,
+		cold_storage.MakeWorker(s.Jobs, New(ServerConnector(s.Channels())), s.Store()),
+		cold_storage.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypePartitionMaintenance,
+		partition_maintenance.MakeWorker(s.Jobs, New(ServerConnector(s.Channels())), s.Store()),
+		partition_maintenance.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypePostArchive,
+		post_archive.MakeWorker(s.Jobs, New(ServerConnector(s.Channels())), s.Store()),
+		post_archive.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeChannelCounters,
+		channel_counters.MakeWorker(s.Jobs, s.Store()),
+		channel_counters.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeGuestExpiration,
+		guest_expiration.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		guest_expiration.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeChannelMemberExpiry,
+		channel_member_expiry.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		channel_member_expiry.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeSlackImport,
+		slack_import.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeMSTeamsImport,
+		msteams_import.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeTeamArchive,
+		team_archive.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeUserOffboarding,
+		user_offboarding.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeChannelMembersBulk,
+		channel_members_bulk.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
 	s.platform.Jobs = s.Jobs
 }
 
@@ -1756,6 +1918,24 @@ func runPostReminderJob(a *App) {
 	})
 }
 
+func runCalendarStatusSyncJob(a *App) {
+	if a.IsLeader() {
+		withMut(&a.ch.calendarStatusSyncMut, func() {
+			a.ch.calendarStatusSyncTask = model.CreateRecurringTaskFromNextIntervalTime("Sync statuses with calendars", a.SyncCalendarStatuses, model.CalendarStatusSyncInterval*time.Minute)
+		})
+	}
+	a.ch.srv.AddClusterLeaderChangedListener(func() {
+		mlog.Info("Cluster leader changed. Determining if calendar status sync task should be running", mlog.Bool("isLeader", a.IsLeader()))
+		if a.IsLeader() {
+			withMut(&a.ch.calendarStatusSyncMut, func() {
+				a.ch.calendarStatusSyncTask = model.CreateRecurringTaskFromNextIntervalTime("Sync statuses with calendars", a.SyncCalendarStatuses, model.CalendarStatusSyncInterval*time.Minute)
+			})
+		} else {
+			cancelTask(&a.ch.calendarStatusSyncMut, &a.ch.calendarStatusSyncTask)
+		}
+	})
+}
+
 func (a *App) GetAppliedSchemaMigrations() ([]model.AppliedMigration, *model.AppError) {
 	table, err := a.Srv().Store().GetAppliedMigrations()
 	if err != nil {
@@ -1764,6 +1944,25 @@ func (a *App) GetAppliedSchemaMigrations() ([]model.AppliedMigration, *model.App
 	return table, nil
 }
 
+// GetMigrationsStatus returns the schema version of the database along with the status of the
+// migrations changing its large tables online, in the background of the servers.
+func (a *App) GetMigrationsStatus() (*model.MigrationsStatus, *model.AppError) {
+	version, err := a.Srv().Store().GetDBSchemaVersion()
+	if err != nil {
+		return nil, model.NewAppError("GetMigrationsStatus", "app.migrations_status.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	onlineMigrations, err := a.Srv().Store().GetOnlineMigrationsStatus()
+	if err != nil {
+		return nil, model.NewAppError("GetMigrationsStatus", "app.migrations_status.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return &model.MigrationsStatus{
+		SchemaVersion:    version,
+		OnlineMigrations: onlineMigrations,
+	}, nil
+}
+
 // Expose platform service from server, this should be replaced with server itself in time.
 func (s *Server) Platform() *platform.PlatformService {
 	return s.platform
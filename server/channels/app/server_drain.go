@@ -0,0 +1,311 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// nodeDrainPluginID is the plugin ID under which the drain states of the draining nodes are saved
+// in the plugin key value store, for any node to report the state of the others.
+const nodeDrainPluginID = "com.mattermost.cluster_drain"
+
+// nodeDrainRefreshInterval is how often a draining node refreshes its drain state.
+// (Defining as `var` rather than `const` allows tests to lower the interval.)
+var nodeDrainRefreshInterval = 5 * time.Second
+
+// nodeDrain tracks the requests handled by this node and whether it's being drained.
+type nodeDrain struct {
+	draining int32 // protected via atomic for fast IsDraining calls
+	inFlight int64 // protected via atomic as it's updated by every request
+
+	// mut protects the following fields from concurrent access.
+	mut           sync.Mutex
+	startAt       int64
+	jobsHandedOff bool
+	stop          chan struct{}
+	stopped       chan struct{}
+}
+
+func newNodeDrain() *nodeDrain {
+	return &nodeDrain{}
+}
+
+// IsDraining returns true if this node is being drained.
+func (s *Server) IsDraining() bool {
+	return atomic.LoadInt32(&s.nodeDrain.draining) != 0
+}
+
+// drainHandler counts the requests in flight for the drain state of the node, apart from the
+// websocket connections lasting as long as the connections.
+func (s *Server) drainHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !websocket.IsWebSocketUpgrade(r) {
+			atomic.AddInt64(&s.nodeDrain.inFlight, 1)
+			defer atomic.AddInt64(&s.nodeDrain.inFlight, -1)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// checkClusterNode returns whether the node is this node, failing when it's not a node of the
+// cluster.
+func (s *Server) checkClusterNode(where, nodeID string) (bool, *model.AppError) {
+	if nodeID == s.platform.NodeID() {
+		return true, nil
+	}
+
+	if cluster := s.platform.Cluster(); cluster != nil {
+		for _, info := range cluster.GetClusterInfos() {
+			if info.Id == nodeID {
+				return false, nil
+			}
+		}
+	}
+
+	return false, model.NewAppError(where, "app.cluster.node_not_found.app_error", nil, "node_id="+nodeID, http.StatusNotFound)
+}
+
+// DrainNode starts draining the node of the cluster, for it to be stopped once drained without
+// interrupting the users, e.g. during a rolling restart.
+func (s *Server) DrainNode(nodeID string) *model.AppError {
+	return s.setNodeDraining("DrainNode", nodeID, true)
+}
+
+// UndrainNode stops draining the node of the cluster, the node handling requests and jobs again.
+func (s *Server) UndrainNode(nodeID string) *model.AppError {
+	return s.setNodeDraining("UndrainNode", nodeID, false)
+}
+
+func (s *Server) setNodeDraining(where, nodeID string, draining bool) *model.AppError {
+	local, appErr := s.checkClusterNode(where, nodeID)
+	if appErr != nil {
+		return appErr
+	}
+
+	if local {
+		s.setNodeDrainingSkipClusterSend(draining)
+		return nil
+	}
+
+	data, err := json.Marshal(&model.ClusterNodeDrain{Draining: draining})
+	if err != nil {
+		return model.NewAppError(where, "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	msg := &model.ClusterMessage{
+		Event:    model.ClusterEventDrainNode,
+		SendType: model.ClusterSendReliable,
+		Data:     data,
+	}
+	if err := s.platform.Cluster().SendClusterMessageToNode(nodeID, msg); err != nil {
+		return model.NewAppError(where, "app.cluster.drain.send.app_error", nil, "node_id="+nodeID, http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+func (s *Server) clusterDrainNodeHandler(msg *model.ClusterMessage) {
+	var drain model.ClusterNodeDrain
+	if jsonErr := json.Unmarshal(msg.Data, &drain); jsonErr != nil {
+		s.Log().Warn("Failed to decode node drain from JSON", mlog.Err(jsonErr))
+		return
+	}
+
+	s.setNodeDrainingSkipClusterSend(drain.Draining)
+}
+
+func (s *Server) setNodeDrainingSkipClusterSend(draining bool) {
+	if !draining {
+		if s.stopDraining() {
+			s.resumeJobs()
+		}
+		return
+	}
+
+	d := s.nodeDrain
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	if d.stop != nil {
+		return
+	}
+
+	s.Log().Info("Draining the node.")
+	atomic.StoreInt32(&d.draining, 1)
+	d.startAt = model.GetMillis()
+	d.jobsHandedOff = false
+	d.stop = make(chan struct{})
+	d.stopped = make(chan struct{})
+	go s.drainNode(d.stop, d.stopped)
+}
+
+// stopDraining stops draining the node, removing its drain state. It returns whether the jobs of
+// the node were handed off.
+func (s *Server) stopDraining() bool {
+	d := s.nodeDrain
+	d.mut.Lock()
+	stop, stopped := d.stop, d.stopped
+	d.stop, d.stopped = nil, nil
+	d.mut.Unlock()
+
+	if stop == nil {
+		return false
+	}
+
+	s.Log().Info("Stopping draining the node.")
+	close(stop)
+	<-stopped
+	atomic.StoreInt32(&d.draining, 0)
+
+	if err := s.Store().Plugin().Delete(nodeDrainPluginID, s.platform.NodeID()); err != nil {
+		s.Log().Warn("Failed to remove the drain state of the node", mlog.Err(err))
+	}
+
+	d.mut.Lock()
+	defer d.mut.Unlock()
+	jobsHandedOff := d.jobsHandedOff
+	d.jobsHandedOff = false
+	return jobsHandedOff
+}
+
+// drainNode hands off the jobs of the node to the other nodes, waiting for the jobs in progress
+// to finish, then refreshes the drain state of the node until stopped.
+func (s *Server) drainNode(stop, stopped chan struct{}) {
+	defer close(stopped)
+
+	s.saveNodeDrainState()
+	s.handOffJobs()
+	s.nodeDrain.mut.Lock()
+	s.nodeDrain.jobsHandedOff = true
+	s.nodeDrain.mut.Unlock()
+
+	ticker := time.NewTicker(nodeDrainRefreshInterval)
+	defer ticker.Stop()
+
+	drained := false
+	for {
+		state := s.saveNodeDrainState()
+		if state != nil && state.Drained != drained {
+			drained = state.Drained
+			if drained {
+				s.Log().Info("The node is drained.", mlog.Int("websocket_connections", state.WebSocketConnections))
+			}
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// handOffJobs stops the job workers and schedulers of the node, the pending jobs being run by the
+// other nodes.
+func (s *Server) handOffJobs() {
+	if s.Jobs == nil {
+		return
+	}
+
+	if err := s.Jobs.StopWorkers(); err != nil && !errors.Is(err, jobs.ErrWorkersNotRunning) {
+		s.Log().Warn("Failed to stop job server workers", mlog.Err(err))
+	}
+	if err := s.Jobs.StopSchedulers(); err != nil && !errors.Is(err, jobs.ErrSchedulersNotRunning) {
+		s.Log().Warn("Failed to stop job server schedulers", mlog.Err(err))
+	}
+}
+
+// resumeJobs starts again the job workers and schedulers stopped by handOffJobs, per the config.
+func (s *Server) resumeJobs() {
+	if s.Jobs == nil {
+		return
+	}
+
+	if *s.platform.Config().JobSettings.RunJobs {
+		if err := s.Jobs.StartWorkers(); err != nil && !errors.Is(err, jobs.ErrWorkersRunning) {
+			s.Log().Error("Failed to start job server workers", mlog.Err(err))
+		}
+	}
+	if *s.platform.Config().JobSettings.RunScheduler {
+		if err := s.Jobs.StartSchedulers(); err != nil && !errors.Is(err, jobs.ErrSchedulersRunning) {
+			s.Log().Error("Failed to start job server schedulers", mlog.Err(err))
+		}
+	}
+}
+
+// nodeDrainState returns the drain state of this node.
+func (s *Server) nodeDrainState() *model.ClusterNodeDrainState {
+	d := s.nodeDrain
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	state := &model.ClusterNodeDrainState{
+		NodeId:               s.platform.NodeID(),
+		Draining:             d.stop != nil,
+		UpdateAt:             model.GetMillis(),
+		InFlightRequests:     atomic.LoadInt64(&d.inFlight),
+		WebSocketConnections: s.TotalWebsocketConnections(),
+	}
+	if state.Draining {
+		state.StartAt = d.startAt
+		state.JobsHandedOff = d.jobsHandedOff
+		state.Drained = d.jobsHandedOff && state.InFlightRequests == 0
+	}
+
+	return state
+}
+
+func (s *Server) saveNodeDrainState() *model.ClusterNodeDrainState {
+	state := s.nodeDrainState()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		s.Log().Warn("Failed to encode the drain state of the node to JSON", mlog.Err(err))
+		return nil
+	}
+	if _, err := s.Store().Plugin().SetWithOptions(nodeDrainPluginID, state.NodeId, data, model.PluginKVSetOptions{
+		ExpireInSeconds: model.ClusterNodeDrainStateExpirySeconds,
+	}); err != nil {
+		s.Log().Warn("Failed to save the drain state of the node", mlog.Err(err))
+	}
+
+	return state
+}
+
+// GetNodeDrainState returns the drain state of the node of the cluster, as last saved by the node
+// when it's draining.
+func (s *Server) GetNodeDrainState(nodeID string) (*model.ClusterNodeDrainState, *model.AppError) {
+	if _, appErr := s.checkClusterNode("GetNodeDrainState", nodeID); appErr != nil {
+		return nil, appErr
+	}
+
+	kv, err := s.Store().Plugin().Get(nodeDrainPluginID, nodeID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return &model.ClusterNodeDrainState{NodeId: nodeID}, nil
+		}
+		return nil, model.NewAppError("GetNodeDrainState", "app.cluster.drain.get_state.app_error", nil, "node_id="+nodeID, http.StatusInternalServerError).Wrap(err)
+	}
+
+	var state model.ClusterNodeDrainState
+	if err := json.Unmarshal(kv.Value, &state); err != nil {
+		return nil, model.NewAppError("GetNodeDrainState", "app.cluster.drain.get_state.app_error", nil, "node_id="+nodeID, http.StatusInternalServerError).Wrap(err)
+	}
+
+	return &state, nil
+}
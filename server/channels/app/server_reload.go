@@ -0,0 +1,187 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// httpListenAddress returns the address the HTTP server listens on, defaulting to the port of the
+// connection security.
+func httpListenAddress(cfg *model.Config) string {
+	addr := *cfg.ServiceSettings.ListenAddress
+	if addr == "" {
+		if *cfg.ServiceSettings.ConnectionSecurity == model.ConnSecurityTLS {
+			addr = ":https"
+		} else {
+			addr = ":http"
+		}
+	}
+	return addr
+}
+
+// serveHTTP serves the HTTP server on the listener. A listener that was rebound, and closed, is
+// done serving without failing the server. The caller must hold the listener lock.
+func (s *Server) serveHTTP(listener net.Listener) {
+	didFinishListen := make(chan struct{})
+	s.httpListener = listener
+	s.didFinishListen = didFinishListen
+
+	// The TLS config is set once, before the server is first served.
+	useTLS := s.Server.TLSConfig != nil
+
+	go func() {
+		var err error
+		if useTLS {
+			// The certificate comes from the GetCertificate of the TLS config.
+			err = s.Server.ServeTLS(listener, "", "")
+		} else {
+			err = s.Server.Serve(listener)
+		}
+
+		s.httpListenerMut.Lock()
+		rebound := s.httpListener != listener
+		s.httpListenerMut.Unlock()
+
+		if err != nil && err != http.ErrServerClosed && !(rebound && errors.Is(err, net.ErrClosed)) {
+			mlog.Fatal("Error starting server", mlog.Err(err))
+			time.Sleep(time.Second)
+		}
+
+		close(didFinishListen)
+	}()
+}
+
+// reloadHTTPServer applies the changes of the listener settings of the config without restarting
+// the HTTP server: it's rebound to the new listen address, its TLS certificate is reloaded and its
+// rate limiter replaced. The other changes of the ServiceSettings still require a restart.
+func (s *Server) reloadHTTPServer(oldCfg, newCfg *model.Config) {
+	if httpListenAddress(oldCfg) != httpListenAddress(newCfg) {
+		if err := s.rebindHTTPServer(newCfg); err != nil {
+			mlog.Error("Failed to rebind the server, it keeps listening on the previous address", mlog.String("address", httpListenAddress(newCfg)), mlog.Err(err))
+		}
+	}
+
+	if *oldCfg.ServiceSettings.TLSCertFile != *newCfg.ServiceSettings.TLSCertFile || *oldCfg.ServiceSettings.TLSKeyFile != *newCfg.ServiceSettings.TLSKeyFile {
+		if err := s.ReloadTLSCertificate(); err != nil {
+			mlog.Error("Failed to reload the TLS certificate, the previous one is still served", mlog.Err(err))
+		}
+	}
+
+	if !reflect.DeepEqual(oldCfg.RateLimitSettings, newCfg.RateLimitSettings) || !reflect.DeepEqual(oldCfg.ServiceSettings.TrustedProxyIPHeader, newCfg.ServiceSettings.TrustedProxyIPHeader) {
+		if err := s.reloadRateLimiter(newCfg); err != nil {
+			mlog.Error("Failed to reload the rate limiter, the previous settings still apply", mlog.Err(err))
+		}
+	}
+}
+
+// rebindHTTPServer hands the HTTP server over to a listener on the address of the config. The
+// previous listener is only closed once the new one is served, its open connections being left to
+// complete, so that no request is refused in between.
+func (s *Server) rebindHTTPServer(cfg *model.Config) error {
+	addr := httpListenAddress(cfg)
+
+	if *cfg.ServiceSettings.Forward80To443 {
+		if _, port, err := net.SplitHostPort(addr); err == nil && port != "443" {
+			return errors.Errorf("Forward80To443 requires listening on port 443, not %s", port)
+		}
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on %s", addr)
+	}
+
+	s.httpListenerMut.Lock()
+	defer s.httpListenerMut.Unlock()
+
+	if s.Server == nil || s.httpListener == nil {
+		listener.Close()
+		return errors.New("the server is not started")
+	}
+
+	previous := s.httpListener
+	s.serveHTTP(listener)
+	s.ListenAddr = listener.Addr().(*net.TCPAddr)
+
+	if err := previous.Close(); err != nil {
+		mlog.Warn("Failed to close the previous listener of the server", mlog.Err(err))
+	}
+
+	mlog.Info("Server was rebound", mlog.String("address", listener.Addr().String()), mlog.String("previous_address", previous.Addr().String()))
+
+	return nil
+}
+
+// ReloadTLSCertificate loads the certificate of the TLSCertFile and TLSKeyFile, served to the new
+// TLS connections. It's reloaded with the config, for renewed certificates to be served without a
+// restart.
+func (s *Server) ReloadTLSCertificate() error {
+	cfg := s.platform.Config()
+	if *cfg.ServiceSettings.ConnectionSecurity != model.ConnSecurityTLS || *cfg.ServiceSettings.UseLetsEncrypt {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(*cfg.ServiceSettings.TLSCertFile, *cfg.ServiceSettings.TLSKeyFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to load the TLS certificate")
+	}
+
+	s.tlsCertificate.Store(&cert)
+
+	return nil
+}
+
+func (s *Server) getTLSCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert, _ := s.tlsCertificate.Load().(*tls.Certificate)
+	if cert == nil {
+		return nil, errors.New("no TLS certificate is loaded")
+	}
+	return cert, nil
+}
+
+// RateLimiter returns the rate limiter of the HTTP server, nil when rate limiting is disabled.
+func (s *Server) RateLimiter() *RateLimiter {
+	rateLimiter, _ := s.rateLimiter.Load().(*RateLimiter)
+	return rateLimiter
+}
+
+// reloadRateLimiter replaces the rate limiter with one of the settings of the config. The rate
+// limits start over.
+func (s *Server) reloadRateLimiter(cfg *model.Config) error {
+	if !*cfg.RateLimitSettings.Enable {
+		s.rateLimiter.Store((*RateLimiter)(nil))
+		return nil
+	}
+
+	rateLimiter, err := NewRateLimiter(&cfg.RateLimitSettings, cfg.ServiceSettings.TrustedProxyIPHeader)
+	if err != nil {
+		return err
+	}
+
+	mlog.Info("RateLimiter is enabled")
+	s.rateLimiter.Store(rateLimiter)
+
+	return nil
+}
+
+// rateLimitHandler rate limits the requests with the current rate limiter, if any.
+func (s *Server) rateLimitHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rateLimiter := s.RateLimiter(); rateLimiter != nil && rateLimiter.RateLimitWriter(rateLimiter.GenerateKey(r), w) {
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -275,6 +275,140 @@ func TestStartServerTLSOverwriteCipher(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestReloadHTTPServer(t *testing.T) {
+	t.Run("rebinds to the new listen address", func(t *testing.T) {
+		s, err := newServerWithConfig(t, func(cfg *model.Config) {
+			*cfg.ServiceSettings.ListenAddress = ":0"
+		})
+		require.NoError(t, err)
+
+		serverErr := s.Start()
+		defer s.Shutdown()
+		require.NoError(t, serverErr)
+
+		oldPort := s.ListenAddr.Port
+		require.NoError(t, checkEndpoint(t, http.DefaultClient, "http://localhost:"+strconv.Itoa(oldPort)+"/"))
+
+		s.platform.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.ListenAddress = "localhost:0"
+		})
+
+		require.NotEqual(t, oldPort, s.ListenAddr.Port)
+		require.NoError(t, checkEndpoint(t, http.DefaultClient, "http://localhost:"+strconv.Itoa(s.ListenAddr.Port)+"/"))
+
+		_, err = net.Dial("tcp", "localhost:"+strconv.Itoa(oldPort))
+		require.Error(t, err)
+	})
+
+	t.Run("keeps listening when the new address is unavailable", func(t *testing.T) {
+		s, err := newServerWithConfig(t, func(cfg *model.Config) {
+			*cfg.ServiceSettings.ListenAddress = ":0"
+		})
+		require.NoError(t, err)
+
+		serverErr := s.Start()
+		defer s.Shutdown()
+		require.NoError(t, serverErr)
+
+		taken, err := net.Listen("tcp", "localhost:0")
+		require.NoError(t, err)
+		defer taken.Close()
+
+		oldPort := s.ListenAddr.Port
+		s.platform.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.ListenAddress = taken.Addr().String()
+		})
+
+		require.Equal(t, oldPort, s.ListenAddr.Port)
+		require.NoError(t, checkEndpoint(t, http.DefaultClient, "http://localhost:"+strconv.Itoa(oldPort)+"/"))
+	})
+
+	t.Run("reloads the rate limiter", func(t *testing.T) {
+		s, err := newServerWithConfig(t, func(cfg *model.Config) {
+			*cfg.ServiceSettings.ListenAddress = ":0"
+			*cfg.RateLimitSettings.Enable = false
+		})
+		require.NoError(t, err)
+
+		serverErr := s.Start()
+		defer s.Shutdown()
+		require.NoError(t, serverErr)
+		require.Nil(t, s.RateLimiter())
+
+		s.platform.UpdateConfig(func(cfg *model.Config) {
+			*cfg.RateLimitSettings.Enable = true
+			*cfg.RateLimitSettings.PerSec = 1
+			*cfg.RateLimitSettings.MaxBurst = 1
+			*cfg.RateLimitSettings.VaryByRemoteAddr = true
+		})
+		require.NotNil(t, s.RateLimiter())
+
+		var limited bool
+		for i := 0; i < 5 && !limited; i++ {
+			res, err := http.Get("http://localhost:" + strconv.Itoa(s.ListenAddr.Port) + "/")
+			require.NoError(t, err)
+			res.Body.Close()
+			limited = res.StatusCode == http.StatusTooManyRequests
+		}
+		require.True(t, limited)
+
+		s.platform.UpdateConfig(func(cfg *model.Config) {
+			*cfg.RateLimitSettings.Enable = false
+		})
+		require.Nil(t, s.RateLimiter())
+	})
+
+	t.Run("reloads the TLS certificate", func(t *testing.T) {
+		testDir, _ := fileutils.FindDir("tests")
+		certDir := t.TempDir()
+		certFile := path.Join(certDir, "cert.pem")
+		keyFile := path.Join(certDir, "key.pem")
+
+		s, err := newServerWithConfig(t, func(cfg *model.Config) {
+			*cfg.ServiceSettings.ListenAddress = ":0"
+			*cfg.ServiceSettings.ConnectionSecurity = "TLS"
+			*cfg.ServiceSettings.TLSKeyFile = path.Join(testDir, "tls_test_key.pem")
+			*cfg.ServiceSettings.TLSCertFile = path.Join(testDir, "tls_test_cert.pem")
+		})
+		require.NoError(t, err)
+
+		serverErr := s.Start()
+		defer s.Shutdown()
+		require.NoError(t, serverErr)
+
+		served, err := s.getTLSCertificate(nil)
+		require.NoError(t, err)
+
+		certData, err := os.ReadFile(path.Join(testDir, "tls_test_cert.pem"))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(certFile, certData, 0600))
+		keyData, err := os.ReadFile(path.Join(testDir, "tls_test_key.pem"))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(keyFile, keyData, 0600))
+
+		s.platform.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.TLSCertFile = certFile
+			*cfg.ServiceSettings.TLSKeyFile = keyFile
+		})
+
+		reloaded, err := s.getTLSCertificate(nil)
+		require.NoError(t, err)
+		require.NotSame(t, served, reloaded)
+
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+		require.NoError(t, checkEndpoint(t, client, "https://localhost:"+strconv.Itoa(s.ListenAddr.Port)+"/"))
+
+		// A missing certificate leaves the loaded one served.
+		s.platform.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.TLSCertFile = path.Join(certDir, "missing.pem")
+		})
+
+		current, err := s.getTLSCertificate(nil)
+		require.NoError(t, err)
+		require.Same(t, reloaded, current)
+	})
+}
+
 func checkEndpoint(t *testing.T, client *http.Client, url string) error {
 	res, err := client.Get(url)
 	if err != nil {
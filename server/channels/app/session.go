@@ -133,6 +133,49 @@ func (a *App) GetSessions(userID string) ([]*model.Session, *model.AppError) {
 	return sessions, nil
 }
 
+// GetSessionsDetailed describes the unexpired sessions of the user to their owner, flagging the
+// session with the given id as the current one.
+func (a *App) GetSessionsDetailed(userID string, currentSessionID string) ([]*model.SessionDetailed, *model.AppError) {
+	sessions, appErr := a.GetSessions(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	detailed := make([]*model.SessionDetailed, 0, len(sessions))
+	for _, session := range sessions {
+		if session.IsExpired() {
+			continue
+		}
+
+		sessionDetailed := session.Detailed()
+		sessionDetailed.IsCurrent = session.Id == currentSessionID
+		detailed = append(detailed, sessionDetailed)
+	}
+
+	return detailed, nil
+}
+
+// RevokeOtherSessions revokes every session of the user but the current one, logging out the
+// other devices of the user and closing their websocket connections.
+func (a *App) RevokeOtherSessions(userID string, currentSessionID string) *model.AppError {
+	sessions, appErr := a.GetSessions(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	for _, session := range sessions {
+		if session.Id == currentSessionID {
+			continue
+		}
+
+		if appErr := a.RevokeSession(session); appErr != nil {
+			return appErr
+		}
+	}
+
+	return nil
+}
+
 func (a *App) RevokeAllSessions(userID string) *model.AppError {
 	if err := a.ch.srv.platform.RevokeAllSessions(userID); err != nil {
 		switch {
@@ -247,6 +290,11 @@ func (a *App) ExtendSessionExpiryIfNeeded(session *model.Session) bool {
 		return false
 	}
 
+	// Support access sessions end with the consent they were opened under.
+	if session.IsSupportAccess() {
+		return false
+	}
+
 	sessionLength := a.GetSessionLengthInMillis(session)
 
 	// Only extend the expiry if the lessor of 1% or 1 day has elapsed within the
@@ -90,6 +90,47 @@ func (a *App) UpdateSharedChannel(sc *model.SharedChannel) (*model.SharedChannel
 	return a.Srv().Store().SharedChannel().Update(sc)
 }
 
+func (a *App) getSharedChannelForFilters(channelID string) (*model.SharedChannel, *model.AppError) {
+	sc, err := a.GetSharedChannel(channelID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, model.NewAppError("getSharedChannelForFilters", "app.shared_channel.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		}
+		return nil, model.NewAppError("getSharedChannelForFilters", "app.shared_channel.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return sc, nil
+}
+
+func (a *App) GetSharedChannelFilters(channelID string) (*model.SharedChannelFilters, *model.AppError) {
+	sc, appErr := a.getSharedChannelForFilters(channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	return sc.Filters(), nil
+}
+
+// UpdateSharedChannelFilters replaces the filters applied to the content of a shared channel
+// before it is sent to remote clusters.
+func (a *App) UpdateSharedChannelFilters(channelID string, filters *model.SharedChannelFilters) (*model.SharedChannelFilters, *model.AppError) {
+	sc, appErr := a.getSharedChannelForFilters(channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	sc.SetFilters(filters)
+	sc.PreUpdate()
+	if appErr = sc.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	sc, err := a.UpdateSharedChannel(sc)
+	if err != nil {
+		return nil, model.NewAppError("UpdateSharedChannelFilters", "app.shared_channel.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return sc.Filters(), nil
+}
+
 func (a *App) DeleteSharedChannel(channelID string) (bool, error) {
 	return a.Srv().Store().SharedChannel().Delete(channelID)
 }
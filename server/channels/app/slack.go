@@ -8,7 +8,7 @@ import (
 	"context"
 	"fmt"
 	"image"
-	"mime/multipart"
+	"io"
 	"regexp"
 	"strings"
 	"time"
@@ -19,7 +19,9 @@ import (
 	"github.com/mattermost/mattermost-server/v6/server/platform/services/slackimport"
 )
 
-func (a *App) SlackImport(c *request.Context, fileData multipart.File, fileSize int64, teamID string) (*model.AppError, *bytes.Buffer) {
+// SlackImport imports the Slack export archive into the team, returning a log of what was imported.
+// When set, reportProgress is called with the percentage of the channels imported so far.
+func (a *App) SlackImport(c *request.Context, fileData io.ReaderAt, fileSize int64, teamID string, reportProgress func(int)) (*model.AppError, *bytes.Buffer) {
 	actions := slackimport.Actions{
 		UpdateActive: func(user *model.User, active bool) (*model.User, *model.AppError) {
 			return a.UpdateActive(c, user, active)
@@ -47,6 +49,7 @@ func (a *App) SlackImport(c *request.Context, fileData multipart.File, fileSize
 			}
 			return img, imgType, release, err
 		},
+		ReportProgress: reportProgress,
 	}
 
 	importer := slackimport.New(a.Srv().Store(), actions, a.Config())
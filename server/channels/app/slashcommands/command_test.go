@@ -11,13 +11,11 @@ import (
 	"net/url"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/model"
-	"github.com/mattermost/mattermost-server/v6/server/platform/services/httpservice"
 )
 
 type InfiniteReader struct {
@@ -432,10 +430,10 @@ func TestDoCommandRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		th.App.HTTPService().(*httpservice.HTTPServiceImpl).RequestTimeout = 100 * time.Millisecond
-		defer func() {
-			th.App.HTTPService().(*httpservice.HTTPServiceImpl).RequestTimeout = httpservice.RequestTimeout
-		}()
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.OutgoingIntegrationsRequestTimeoutSeconds = 1 })
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.OutgoingIntegrationsRequestTimeoutSeconds = model.ServiceSettingsDefaultOutgoingIntegrationsRequestTimeoutSeconds
+		})
 
 		_, _, err := th.App.DoCommandRequest(&model.Command{URL: server.URL}, url.Values{})
 		require.NotNil(t, err)
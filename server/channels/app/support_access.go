@@ -0,0 +1,126 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// GrantSupportAccessConsent lets the system admin of the request view the app as the user, in
+// read-only support access sessions, until the consent expires or is revoked.
+func (a *App) GrantSupportAccessConsent(c request.CTX, userID string, consentRequest *model.SupportAccessConsentRequest) (*model.SupportAccessConsent, *model.AppError) {
+	if appErr := consentRequest.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	admin, appErr := a.GetUser(consentRequest.AdminId)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return nil, appErr
+	}
+	if admin == nil || admin.Id == userID || admin.DeleteAt != 0 || !admin.IsSystemAdmin() {
+		return nil, model.NewAppError("GrantSupportAccessConsent", "app.support_access.invalid_admin.app_error", nil, "admin_id="+consentRequest.AdminId, http.StatusBadRequest)
+	}
+
+	now := model.GetMillis()
+	consent, err := a.Srv().Store().SupportAccessConsent().Save(&model.SupportAccessConsent{
+		UserId:    userID,
+		AdminId:   admin.Id,
+		CreateAt:  now,
+		ExpiresAt: now + consentRequest.Duration(),
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("GrantSupportAccessConsent", "app.support_access.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return consent, nil
+}
+
+// GetSupportAccessConsents returns the support access consents the user granted, newest first.
+func (a *App) GetSupportAccessConsents(userID string) ([]*model.SupportAccessConsent, *model.AppError) {
+	consents, err := a.Srv().Store().SupportAccessConsent().GetForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetSupportAccessConsents", "app.support_access.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return consents, nil
+}
+
+// RevokeSupportAccessConsents revokes the active support access consents of the user, along with
+// the support access sessions opened under them.
+func (a *App) RevokeSupportAccessConsents(c request.CTX, userID string) *model.AppError {
+	if err := a.Srv().Store().SupportAccessConsent().RevokeForUser(userID, model.GetMillis()); err != nil {
+		return model.NewAppError("RevokeSupportAccessConsents", "app.support_access.revoke.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	sessions, appErr := a.GetSessions(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	for _, session := range sessions {
+		if !session.IsSupportAccess() {
+			continue
+		}
+
+		if appErr := a.RevokeSession(session); appErr != nil {
+			c.Logger().Warn("Failed to revoke a support access session", mlog.String("user_id", userID), mlog.String("session_id", session.Id), mlog.Err(appErr))
+		}
+	}
+
+	return nil
+}
+
+// CreateSupportAccessSession opens a session of the user for the admin, under the active consent
+// the user granted them. The session has the roles of the user, is read-only and ends when the
+// consent expires.
+func (a *App) CreateSupportAccessSession(c request.CTX, userID, adminID string) (*model.Session, *model.AppError) {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if user.DeleteAt != 0 {
+		return nil, model.NewAppError("CreateSupportAccessSession", "app.support_access.inactive_user.app_error", nil, "user_id="+userID, http.StatusBadRequest)
+	}
+
+	consent, err := a.Srv().Store().SupportAccessConsent().GetActive(userID, adminID, model.GetMillis())
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("CreateSupportAccessSession", "app.support_access.no_consent.app_error", nil, "", http.StatusForbidden)
+		default:
+			return nil, model.NewAppError("CreateSupportAccessSession", "app.support_access.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	session := &model.Session{
+		UserId:    user.Id,
+		Roles:     user.GetRawRoles(),
+		ExpiresAt: consent.ExpiresAt,
+	}
+
+	session.AddProp(model.SessionPropType, model.SessionTypeSupportAccess)
+	session.AddProp(model.SessionPropSupportAccessAdminId, adminID)
+	session.AddProp(model.SessionPropSupportAccessConsentId, consent.Id)
+	if user.IsGuest() {
+		session.AddProp(model.SessionPropIsGuest, "true")
+	} else {
+		session.AddProp(model.SessionPropIsGuest, "false")
+	}
+
+	return a.CreateSession(session)
+}
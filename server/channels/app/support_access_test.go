@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestSupportAccess(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	admin := th.SystemAdminUser
+	user := th.BasicUser
+
+	t.Run("only system admins can be granted support access", func(t *testing.T) {
+		_, appErr := th.App.GrantSupportAccessConsent(th.Context, user.Id, &model.SupportAccessConsentRequest{AdminId: th.BasicUser2.Id})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.support_access.invalid_admin.app_error", appErr.Id)
+
+		_, appErr = th.App.GrantSupportAccessConsent(th.Context, admin.Id, &model.SupportAccessConsentRequest{AdminId: admin.Id})
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("support access requires consent", func(t *testing.T) {
+		_, appErr := th.App.CreateSupportAccessSession(th.Context, user.Id, admin.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.support_access.no_consent.app_error", appErr.Id)
+	})
+
+	consent, appErr := th.App.GrantSupportAccessConsent(th.Context, user.Id, &model.SupportAccessConsentRequest{AdminId: admin.Id, DurationMinutes: 30})
+	require.Nil(t, appErr)
+	assert.Equal(t, consent.CreateAt+30*60*1000, consent.ExpiresAt)
+
+	session, appErr := th.App.CreateSupportAccessSession(th.Context, user.Id, admin.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, user.Id, session.UserId)
+	assert.Equal(t, consent.ExpiresAt, session.ExpiresAt)
+	assert.True(t, session.IsSupportAccess())
+	assert.Equal(t, admin.Id, session.Props[model.SessionPropSupportAccessAdminId])
+	assert.Equal(t, consent.Id, session.Props[model.SessionPropSupportAccessConsentId])
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.ExtendSessionLengthWithActivity = true })
+	assert.False(t, th.App.ExtendSessionExpiryIfNeeded(session), "support access sessions end with the consent")
+
+	require.Nil(t, th.App.RevokeSupportAccessConsents(th.Context, user.Id))
+
+	consents, appErr := th.App.GetSupportAccessConsents(user.Id)
+	require.Nil(t, appErr)
+	require.Len(t, consents, 1)
+	assert.NotZero(t, consents[0].RevokedAt)
+
+	_, appErr = th.App.GetSession(session.Token)
+	require.NotNil(t, appErr, "the support access session is revoked with the consent")
+
+	_, appErr = th.App.CreateSupportAccessSession(th.Context, user.Id, admin.Id)
+	require.NotNil(t, appErr)
+}
@@ -60,6 +60,10 @@ func (w *teamServiceWrapper) GetGroupMemberUsers(groupID string, page, perPage i
 	return users, err
 }
 
+func (w *teamServiceWrapper) GetGroupsByUserID(userID string) ([]*model.Group, *model.AppError) {
+	return w.app.GetGroupsByUserId(userID)
+}
+
 // Ensure the wrapper implements the product service.
 var _ product.TeamService = (*teamServiceWrapper)(nil)
 
@@ -1957,6 +1961,10 @@ func (a *App) PermanentDeleteTeam(c request.CTX, team *model.Team) *model.AppErr
 		return model.NewAppError("PermanentDeleteTeam", "app.team.permanentdeleteteam.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	if err := a.Srv().Store().TeamArchive().Delete(team.Id); err != nil {
+		return model.NewAppError("PermanentDeleteTeam", "app.team_archive.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
 	if err := a.Srv().Store().Team().PermanentDelete(team.Id); err != nil {
 		return model.NewAppError("PermanentDeleteTeam", "app.team.permanent_delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2002,6 +2010,13 @@ func (a *App) RestoreTeam(teamID string) *model.AppError {
 		return err
 	}
 
+	// The channels of an archived team are restored along with it by RestoreArchivedTeam.
+	if _, err = a.GetTeamArchive(teamID); err == nil {
+		return model.NewAppError("RestoreTeam", "app.team_archive.restore.app_error", nil, "", http.StatusBadRequest)
+	} else if err.StatusCode != http.StatusNotFound {
+		return err
+	}
+
 	team.DeleteAt = 0
 	team, nErr := a.Srv().Store().Team().Update(team)
 	if nErr != nil {
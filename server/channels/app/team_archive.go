@@ -0,0 +1,219 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// ArchiveTeam hides the team right away and starts a team_archive job freezing its channels and
+// suspending the pending jobs referencing it. Archiving a team whose archive job failed starts
+// a new job.
+func (a *App) ArchiveTeam(c request.CTX, teamID, archiverID string) (*model.TeamArchive, *model.AppError) {
+	archive, appErr := a.GetTeamArchive(teamID)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return nil, appErr
+	}
+
+	if archive == nil {
+		team, appErr := a.GetTeam(teamID)
+		if appErr != nil {
+			return nil, appErr
+		}
+
+		if team.DeleteAt == 0 {
+			if appErr = a.SoftDeleteTeam(teamID); appErr != nil {
+				return nil, appErr
+			}
+		}
+
+		var err error
+		archive, err = a.Srv().Store().TeamArchive().Save(&model.TeamArchive{
+			TeamId:     teamID,
+			ArchiverId: archiverID,
+			Status:     model.TeamArchiveStatusArchiving,
+		})
+		if err != nil {
+			var appErr *model.AppError
+			var invErr *store.ErrInvalidInput
+			switch {
+			case errors.As(err, &appErr):
+				return nil, appErr
+			case errors.As(err, &invErr):
+				return nil, model.NewAppError("ArchiveTeam", "app.team_archive.archived.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+			default:
+				return nil, model.NewAppError("ArchiveTeam", "app.team_archive.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+	} else if archive.Status != model.TeamArchiveStatusArchiving {
+		return nil, model.NewAppError("ArchiveTeam", "app.team_archive.archived.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return a.startTeamArchiveJob(archive, model.TeamArchiveActionArchive)
+}
+
+// RestoreArchivedTeam starts a team_archive job restoring the channels archived along with the
+// team and the jobs that were suspended, the team being shown again once they are.
+func (a *App) RestoreArchivedTeam(c request.CTX, teamID string) (*model.TeamArchive, *model.AppError) {
+	archive, appErr := a.GetTeamArchive(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if archive.Status == model.TeamArchiveStatusArchiving {
+		return nil, model.NewAppError("RestoreArchivedTeam", "app.team_archive.in_progress.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	archive.Status = model.TeamArchiveStatusRestoring
+	return a.startTeamArchiveJob(archive, model.TeamArchiveActionRestore)
+}
+
+// GetTeamArchive returns the archive of the team, with a 404 error when the team isn't archived.
+func (a *App) GetTeamArchive(teamID string) (*model.TeamArchive, *model.AppError) {
+	archive, err := a.Srv().Store().TeamArchive().Get(teamID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeamArchive", "app.team_archive.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetTeamArchive", "app.team_archive.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return archive, nil
+}
+
+func (a *App) startTeamArchiveJob(archive *model.TeamArchive, action string) (*model.TeamArchive, *model.AppError) {
+	job, appErr := a.Srv().Jobs.CreateJob(model.JobTypeTeamArchive, map[string]string{
+		"team_id": archive.TeamId,
+		"action":  action,
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	archive.JobId = job.Id
+	archive, err := a.Srv().Store().TeamArchive().Update(archive)
+	if err != nil {
+		return nil, model.NewAppError("startTeamArchiveJob", "app.team_archive.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return archive, nil
+}
+
+// ProcessTeamArchive does the work of the team_archive job for the team, archiving or restoring
+// it depending on the status of its archive. Each step can safely run again when a previous job
+// failed part way.
+func (a *App) ProcessTeamArchive(c request.CTX, teamID string, reportProgress func(int)) *model.AppError {
+	archive, appErr := a.GetTeamArchive(teamID)
+	if appErr != nil {
+		return appErr
+	}
+
+	switch archive.Status {
+	case model.TeamArchiveStatusArchiving:
+		return a.archiveTeamContent(c, archive, reportProgress)
+	case model.TeamArchiveStatusRestoring:
+		return a.restoreTeamContent(c, archive, reportProgress)
+	}
+
+	return nil
+}
+
+func (a *App) archiveTeamContent(c request.CTX, archive *model.TeamArchive, reportProgress func(int)) *model.AppError {
+	channels, err := a.Srv().Store().Channel().GetAll(archive.TeamId)
+	if err != nil {
+		return model.NewAppError("ProcessTeamArchive", "app.channel.get_channels.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// The channels are archived at the time the team was, which tells them apart from the
+	// channels that were already archived when restoring the team.
+	for i, channel := range channels {
+		if channel.DeleteAt == 0 {
+			if err := a.Srv().Store().Channel().Delete(channel.Id, archive.ArchivedAt); err != nil {
+				return model.NewAppError("ProcessTeamArchive", "app.channel.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			a.Srv().Platform().InvalidateCacheForChannel(channel)
+		}
+		reportProgress((i + 1) * 90 / len(channels))
+	}
+
+	jobs, err := a.Srv().Store().Job().GetAllByStatus(model.JobStatusPending)
+	if err != nil {
+		return model.NewAppError("ProcessTeamArchive", "app.job.get_all.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, job := range jobs {
+		if job.Type == model.JobTypeTeamArchive || !isJobReferencingTeam(job, archive.TeamId) {
+			continue
+		}
+
+		canceled, err := a.Srv().Store().Job().UpdateStatusOptimistically(job.Id, model.JobStatusPending, model.JobStatusCanceled)
+		if err != nil {
+			return model.NewAppError("ProcessTeamArchive", "app.job.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if canceled {
+			archive.SuspendedJobIds = append(archive.SuspendedJobIds, job.Id)
+		}
+	}
+
+	archive.Status = model.TeamArchiveStatusArchived
+	if _, err := a.Srv().Store().TeamArchive().Update(archive); err != nil {
+		return model.NewAppError("ProcessTeamArchive", "app.team_archive.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	reportProgress(100)
+
+	return nil
+}
+
+func (a *App) restoreTeamContent(c request.CTX, archive *model.TeamArchive, reportProgress func(int)) *model.AppError {
+	channels, err := a.Srv().Store().Channel().GetAll(archive.TeamId)
+	if err != nil {
+		return model.NewAppError("ProcessTeamArchive", "app.channel.get_channels.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for i, channel := range channels {
+		if channel.DeleteAt == archive.ArchivedAt {
+			if err := a.Srv().Store().Channel().Restore(channel.Id, model.GetMillis()); err != nil {
+				return model.NewAppError("ProcessTeamArchive", "app.channel.restore.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			a.Srv().Platform().InvalidateCacheForChannel(channel)
+		}
+		reportProgress((i + 1) * 90 / len(channels))
+	}
+
+	for _, jobID := range archive.SuspendedJobIds {
+		job, err := a.Srv().Store().Job().Get(jobID)
+		if err != nil {
+			c.Logger().Warn("Failed to get a job suspended by the team archive", mlog.String("job_id", jobID), mlog.Err(err))
+			continue
+		}
+		if _, appErr := a.Srv().Jobs.CreateJob(job.Type, job.Data); appErr != nil {
+			c.Logger().Warn("Failed to resume a job suspended by the team archive", mlog.String("job_id", jobID), mlog.Err(appErr))
+		}
+	}
+
+	if err := a.Srv().Store().TeamArchive().Delete(archive.TeamId); err != nil {
+		return model.NewAppError("ProcessTeamArchive", "app.team_archive.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if appErr := a.RestoreTeam(archive.TeamId); appErr != nil {
+		return appErr
+	}
+	reportProgress(100)
+
+	return nil
+}
+
+// isJobReferencingTeam tells whether the job works on the team, such as an import into it.
+func isJobReferencingTeam(job *model.Job, teamID string) bool {
+	return job.Data["team_id"] == teamID || job.Data["teamID"] == teamID
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestArchiveTeam(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	team := th.CreateTeam()
+	channel := th.CreateChannel(th.Context, team)
+	archivedChannel := th.CreatePrivateChannel(th.Context, team)
+	require.Nil(t, th.App.DeleteChannel(th.Context, archivedChannel, th.BasicUser.Id))
+	archivedChannel, appErr := th.App.GetChannel(th.Context, archivedChannel.Id)
+	require.Nil(t, appErr)
+
+	importJob, err := th.App.Srv().Store().Job().Save(&model.Job{
+		Id:     model.NewId(),
+		Type:   model.JobTypeSlackImport,
+		Status: model.JobStatusPending,
+		Data:   map[string]string{"team_id": team.Id, "import_file": "slack.zip"},
+	})
+	require.NoError(t, err)
+
+	noop := func(int) {}
+
+	archive, appErr := th.App.ArchiveTeam(th.Context, team.Id, th.SystemAdminUser.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, model.TeamArchiveStatusArchiving, archive.Status)
+	assert.NotEmpty(t, archive.JobId)
+
+	hidden, appErr := th.App.GetTeam(team.Id)
+	require.Nil(t, appErr)
+	assert.NotZero(t, hidden.DeleteAt, "the team is hidden before the job runs")
+
+	require.Nil(t, th.App.ProcessTeamArchive(th.Context, team.Id, noop))
+
+	archive, appErr = th.App.GetTeamArchive(team.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, model.TeamArchiveStatusArchived, archive.Status)
+	assert.Equal(t, model.StringArray{importJob.Id}, archive.SuspendedJobIds)
+
+	frozen, appErr := th.App.GetChannel(th.Context, channel.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, archive.ArchivedAt, frozen.DeleteAt)
+
+	canceled, err := th.App.Srv().Store().Job().Get(importJob.Id)
+	require.NoError(t, err)
+	assert.Equal(t, model.JobStatusCanceled, canceled.Status)
+
+	t.Run("an archived team can't be archived again", func(t *testing.T) {
+		_, appErr := th.App.ArchiveTeam(th.Context, team.Id, th.SystemAdminUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.team_archive.archived.app_error", appErr.Id)
+	})
+
+	t.Run("an archived team is restored through its archive", func(t *testing.T) {
+		appErr := th.App.RestoreTeam(team.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.team_archive.restore.app_error", appErr.Id)
+	})
+
+	archive, appErr = th.App.RestoreArchivedTeam(th.Context, team.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, model.TeamArchiveStatusRestoring, archive.Status)
+
+	require.Nil(t, th.App.ProcessTeamArchive(th.Context, team.Id, noop))
+
+	_, appErr = th.App.GetTeamArchive(team.Id)
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+
+	restored, appErr := th.App.GetTeam(team.Id)
+	require.Nil(t, appErr)
+	assert.Zero(t, restored.DeleteAt)
+
+	unfrozen, appErr := th.App.GetChannel(th.Context, channel.Id)
+	require.Nil(t, appErr)
+	assert.Zero(t, unfrozen.DeleteAt)
+
+	stillArchived, appErr := th.App.GetChannel(th.Context, archivedChannel.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, archivedChannel.DeleteAt, stillArchived.DeleteAt, "channels archived before the team stay archived")
+
+	jobs, err := th.App.Srv().Store().Job().GetAllByTypeAndStatus(model.JobTypeSlackImport, model.JobStatusPending)
+	require.NoError(t, err)
+	var resumed bool
+	for _, job := range jobs {
+		if job.Data["team_id"] == team.Id && job.Data["import_file"] == "slack.zip" {
+			resumed = true
+		}
+	}
+	assert.True(t, resumed, "the suspended job is created again")
+}
@@ -0,0 +1,295 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const teamTemplatePlaybooksURL = "/plugins/playbooks/api/v0/playbooks"
+
+// CreateTeamTemplate saves a new team template.
+func (a *App) CreateTeamTemplate(template *model.TeamTemplate) (*model.TeamTemplate, *model.AppError) {
+	template, err := a.Srv().Store().TeamTemplate().Save(template)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateTeamTemplate", "app.team_template.save.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		default:
+			return nil, model.NewAppError("CreateTeamTemplate", "app.team_template.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return template, nil
+}
+
+// GetTeamTemplate returns the team template with the given id, unless it was deleted.
+func (a *App) GetTeamTemplate(templateID string) (*model.TeamTemplate, *model.AppError) {
+	template, err := a.Srv().Store().TeamTemplate().Get(templateID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeamTemplate", "app.team_template.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetTeamTemplate", "app.team_template.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return template, nil
+}
+
+// GetTeamTemplates returns a page of the team templates, sorted by display name.
+func (a *App) GetTeamTemplates(page, perPage int) ([]*model.TeamTemplate, *model.AppError) {
+	templates, err := a.Srv().Store().TeamTemplate().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamTemplates", "app.team_template.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return templates, nil
+}
+
+// UpdateTeamTemplate updates the display name, description and content of a team template.
+func (a *App) UpdateTeamTemplate(template *model.TeamTemplate) (*model.TeamTemplate, *model.AppError) {
+	template, err := a.Srv().Store().TeamTemplate().Update(template)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UpdateTeamTemplate", "app.team_template.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("UpdateTeamTemplate", "app.team_template.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return template, nil
+}
+
+// DeleteTeamTemplate deletes a team template. The teams already instantiated from it are left
+// untouched.
+func (a *App) DeleteTeamTemplate(templateID string) *model.AppError {
+	if err := a.Srv().Store().TeamTemplate().Delete(templateID, model.GetMillis()); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteTeamTemplate", "app.team_template.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("DeleteTeamTemplate", "app.team_template.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// InstantiateTeamTemplate creates a new team owned by the user with the channels, sidebar
+// categories, playbooks, commands and incoming webhooks of the template. The instantiation is
+// all or nothing: when a step fails, whatever was created is removed again.
+func (a *App) InstantiateTeamTemplate(c *request.Context, template *model.TeamTemplate, instantiation *model.TeamTemplateInstantiation, userID string) (instance *model.TeamTemplateInstance, appErr *model.AppError) {
+	if appErr = a.checkTeamTemplateInstantiable(template); appErr != nil {
+		return nil, appErr
+	}
+
+	team, appErr := a.CreateTeamWithUser(c, &model.Team{
+		Name:        instantiation.Name,
+		DisplayName: instantiation.DisplayName,
+		Type:        instantiation.Type,
+	}, userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var categoryIDs, playbookIDs []string
+	defer func() {
+		if appErr != nil {
+			a.rollbackTeamTemplateInstance(c, team, categoryIDs, playbookIDs)
+		}
+	}()
+
+	channels := make(map[string]*model.Channel, len(template.Content.Channels))
+	instance = &model.TeamTemplateInstance{
+		Team:        team,
+		Channels:    make([]*model.Channel, 0, len(template.Content.Channels)),
+		PlaybookIds: []string{},
+	}
+	for _, templateChannel := range template.Content.Channels {
+		var channel *model.Channel
+		channel, appErr = a.instantiateTeamTemplateChannel(c, team, templateChannel, userID)
+		if appErr != nil {
+			return nil, appErr
+		}
+		channels[templateChannel.Name] = channel
+		instance.Channels = append(instance.Channels, channel)
+	}
+
+	for _, templateCategory := range template.Content.Categories {
+		category := &model.SidebarCategoryWithChannels{
+			SidebarCategory: model.SidebarCategory{
+				UserId:      userID,
+				TeamId:      team.Id,
+				DisplayName: templateCategory.DisplayName,
+				Type:        model.SidebarCategoryCustom,
+			},
+			Channels: make([]string, 0, len(templateCategory.Channels)),
+		}
+		for _, name := range templateCategory.Channels {
+			category.Channels = append(category.Channels, channels[name].Id)
+		}
+
+		category, appErr = a.CreateSidebarCategory(c, userID, team.Id, category)
+		if appErr != nil {
+			return nil, appErr
+		}
+		categoryIDs = append(categoryIDs, category.Id)
+	}
+
+	for _, templateCommand := range template.Content.Commands {
+		if _, appErr = a.CreateCommand(&model.Command{
+			CreatorId:        userID,
+			TeamId:           team.Id,
+			Trigger:          templateCommand.Trigger,
+			DisplayName:      templateCommand.DisplayName,
+			Description:      templateCommand.Description,
+			URL:              templateCommand.URL,
+			Method:           templateCommand.Method,
+			AutoComplete:     templateCommand.AutoComplete,
+			AutoCompleteDesc: templateCommand.AutoCompleteDesc,
+			AutoCompleteHint: templateCommand.AutoCompleteHint,
+		}); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	for _, templateHook := range template.Content.IncomingWebhooks {
+		if _, appErr = a.CreateIncomingWebhookForChannel(userID, channels[templateHook.Channel], &model.IncomingWebhook{
+			ChannelId:   channels[templateHook.Channel].Id,
+			DisplayName: templateHook.DisplayName,
+			Description: templateHook.Description,
+		}); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	for _, templatePlaybook := range template.Content.Playbooks {
+		var playbookID string
+		playbookID, appErr = a.createTeamTemplatePlaybook(c, team, templatePlaybook)
+		if appErr != nil {
+			return nil, appErr
+		}
+		playbookIDs = append(playbookIDs, playbookID)
+		instance.PlaybookIds = append(instance.PlaybookIds, playbookID)
+	}
+
+	return instance, nil
+}
+
+// checkTeamTemplateInstantiable fails early, before anything is created, when the template
+// needs a feature that is disabled on the server.
+func (a *App) checkTeamTemplateInstantiable(template *model.TeamTemplate) *model.AppError {
+	if len(template.Content.Playbooks) > 0 {
+		if active, err := a.IsPluginActive(model.PluginIdPlaybooks); err != nil || !active {
+			return model.NewAppError("InstantiateTeamTemplate", "app.team_template.playbooks_disabled.app_error", nil, "", http.StatusNotImplemented)
+		}
+	}
+
+	if len(template.Content.Commands) > 0 && !*a.Config().ServiceSettings.EnableCommands {
+		return model.NewAppError("InstantiateTeamTemplate", "api.command.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if len(template.Content.IncomingWebhooks) > 0 && !*a.Config().ServiceSettings.EnableIncomingWebhooks {
+		return model.NewAppError("InstantiateTeamTemplate", "api.incoming_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	return nil
+}
+
+// instantiateTeamTemplateChannel creates the channel of the template in the team, or returns the
+// channel of the same name the team was created with, such as off-topic.
+func (a *App) instantiateTeamTemplateChannel(c *request.Context, team *model.Team, templateChannel *model.TeamTemplateChannel, userID string) (*model.Channel, *model.AppError) {
+	if channel, err := a.Srv().Store().Channel().GetByName(team.Id, templateChannel.Name, true); err == nil {
+		return channel, nil
+	} else {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return nil, model.NewAppError("InstantiateTeamTemplate", "app.channel.get_by_name.existing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return a.CreateChannelWithUser(c, &model.Channel{
+		TeamId:      team.Id,
+		Name:        templateChannel.Name,
+		DisplayName: templateChannel.DisplayName,
+		Type:        templateChannel.Type,
+		Purpose:     templateChannel.Purpose,
+		Header:      templateChannel.Header,
+		CreatorId:   userID,
+	}, userID)
+}
+
+func (a *App) createTeamTemplatePlaybook(c *request.Context, team *model.Team, templatePlaybook *model.TeamTemplatePlaybook) (string, *model.AppError) {
+	definition := model.StringInterface{}
+	for key, value := range templatePlaybook.Definition {
+		definition[key] = value
+	}
+	definition["title"] = templatePlaybook.Title
+	definition["team_id"] = team.Id
+
+	data, err := json.Marshal(definition)
+	if err != nil {
+		return "", model.NewAppError("InstantiateTeamTemplate", "app.team_template.playbook.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	resp, appErr := a.doPluginRequest(c, http.MethodPost, teamTemplatePlaybooksURL, nil, data)
+	if appErr != nil {
+		return "", appErr
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", model.NewAppError("InstantiateTeamTemplate", "app.team_template.playbook.app_error", nil, "status="+resp.Status, http.StatusBadRequest)
+	}
+
+	var created playbookCreateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return "", model.NewAppError("InstantiateTeamTemplate", "app.team_template.playbook.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return created.ID, nil
+}
+
+// rollbackTeamTemplateInstance removes what a failed instantiation created. Playbooks live in
+// the Playbooks plugin and can only be archived.
+func (a *App) rollbackTeamTemplateInstance(c *request.Context, team *model.Team, categoryIDs []string, playbookIDs []string) {
+	for _, playbookID := range playbookIDs {
+		resp, appErr := a.doPluginRequest(c, http.MethodDelete, teamTemplatePlaybooksURL+"/"+playbookID, nil, nil)
+		if appErr != nil {
+			c.Logger().Warn("Failed to archive playbook of a team template instance", mlog.String("playbook_id", playbookID), mlog.Err(appErr))
+			continue
+		}
+		resp.Body.Close()
+	}
+
+	for _, categoryID := range categoryIDs {
+		if err := a.Srv().Store().Channel().DeleteSidebarCategory(categoryID); err != nil {
+			c.Logger().Warn("Failed to delete sidebar category of a team template instance", mlog.String("category_id", categoryID), mlog.Err(err))
+		}
+	}
+
+	if appErr := a.PermanentDeleteTeam(c, team); appErr != nil {
+		c.Logger().Error("Failed to delete team of a team template instance", mlog.String("team_id", team.Id), mlog.Err(appErr))
+	}
+}
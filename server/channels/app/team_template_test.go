@@ -0,0 +1,160 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func newTestTeamTemplate(creatorID string) *model.TeamTemplate {
+	return &model.TeamTemplate{
+		DisplayName: "Support",
+		CreatorId:   creatorID,
+		Content: model.TeamTemplateContent{
+			Channels: []*model.TeamTemplateChannel{
+				{Name: "off-topic", DisplayName: "Off-Topic", Type: model.ChannelTypeOpen},
+				{Name: "tickets", DisplayName: "Tickets", Type: model.ChannelTypeOpen, Purpose: "Incoming tickets"},
+				{Name: "escalations", DisplayName: "Escalations", Type: model.ChannelTypePrivate},
+			},
+			Categories: []*model.TeamTemplateCategory{
+				{DisplayName: "Support", Channels: []string{"tickets", "escalations"}},
+			},
+			Commands: []*model.TeamTemplateCommand{
+				{Trigger: "ticket", URL: "https://tickets.example.com/command", Method: model.CommandMethodPost},
+			},
+			IncomingWebhooks: []*model.TeamTemplateIncomingWebhook{
+				{Channel: "tickets", DisplayName: "Ticketing"},
+			},
+		},
+	}
+}
+
+func TestTeamTemplateCRUD(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	template, appErr := th.App.CreateTeamTemplate(newTestTeamTemplate(th.BasicUser.Id))
+	require.Nil(t, appErr)
+
+	received, appErr := th.App.GetTeamTemplate(template.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, template.Content, received.Content)
+
+	received.DisplayName = "Customer support"
+	updated, appErr := th.App.UpdateTeamTemplate(received)
+	require.Nil(t, appErr)
+	assert.Equal(t, "Customer support", updated.DisplayName)
+
+	templates, appErr := th.App.GetTeamTemplates(0, 10)
+	require.Nil(t, appErr)
+	require.Len(t, templates, 1)
+	assert.Equal(t, template.Id, templates[0].Id)
+
+	require.Nil(t, th.App.DeleteTeamTemplate(template.Id))
+
+	_, appErr = th.App.GetTeamTemplate(template.Id)
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+
+	appErr = th.App.DeleteTeamTemplate(template.Id)
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+}
+
+func TestInstantiateTeamTemplate(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableCommands = true
+		*cfg.ServiceSettings.EnableIncomingWebhooks = true
+	})
+
+	template, appErr := th.App.CreateTeamTemplate(newTestTeamTemplate(th.SystemAdminUser.Id))
+	require.Nil(t, appErr)
+
+	t.Run("creates the team with the content of the template", func(t *testing.T) {
+		instance, appErr := th.App.InstantiateTeamTemplate(th.Context, template, &model.TeamTemplateInstantiation{
+			Name:        "support-" + model.NewId(),
+			DisplayName: "Support",
+			Type:        model.TeamOpen,
+		}, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		team := instance.Team
+		member, appErr := th.App.GetTeamMember(team.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.True(t, member.SchemeAdmin)
+
+		require.Len(t, instance.Channels, 3)
+		offTopic, appErr := th.App.GetChannelByName(th.Context, "off-topic", team.Id, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, offTopic.Id, instance.Channels[0].Id, "the default channel is reused")
+		assert.Equal(t, "Incoming tickets", instance.Channels[1].Purpose)
+		assert.Equal(t, model.ChannelTypePrivate, instance.Channels[2].Type)
+
+		categories, appErr := th.App.GetSidebarCategoriesForTeamForUser(th.Context, th.BasicUser.Id, team.Id)
+		require.Nil(t, appErr)
+		var supportCategory *model.SidebarCategoryWithChannels
+		for _, category := range categories.Categories {
+			if category.DisplayName == "Support" {
+				supportCategory = category
+			}
+		}
+		require.NotNil(t, supportCategory)
+		assert.Equal(t, []string{instance.Channels[1].Id, instance.Channels[2].Id}, supportCategory.Channels)
+
+		commands, appErr := th.App.ListTeamCommands(team.Id)
+		require.Nil(t, appErr)
+		require.Len(t, commands, 1)
+		assert.Equal(t, "ticket", commands[0].Trigger)
+
+		hooks, appErr := th.App.GetIncomingWebhooksForTeamPage(team.Id, 0, 10)
+		require.Nil(t, appErr)
+		require.Len(t, hooks, 1)
+		assert.Equal(t, instance.Channels[1].Id, hooks[0].ChannelId)
+	})
+
+	t.Run("fails before creating anything when playbooks are unavailable", func(t *testing.T) {
+		withPlaybook := *template
+		withPlaybook.Content.Playbooks = []*model.TeamTemplatePlaybook{{Title: "Escalation"}}
+
+		name := "support-" + model.NewId()
+		_, appErr := th.App.InstantiateTeamTemplate(th.Context, &withPlaybook, &model.TeamTemplateInstantiation{
+			Name:        name,
+			DisplayName: "Support",
+			Type:        model.TeamOpen,
+		}, th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.team_template.playbooks_disabled.app_error", appErr.Id)
+
+		_, appErr = th.App.GetTeamByName(name)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("removes the team when a step fails", func(t *testing.T) {
+		clashing := *template
+		clashing.Content.Commands = []*model.TeamTemplateCommand{
+			{Trigger: "join", URL: "https://tickets.example.com/command", Method: model.CommandMethodPost},
+		}
+
+		name := "support-" + model.NewId()
+		_, appErr := th.App.InstantiateTeamTemplate(th.Context, &clashing, &model.TeamTemplateInstantiation{
+			Name:        name,
+			DisplayName: "Support",
+			Type:        model.TeamOpen,
+		}, th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.command.duplicate_trigger.app_error", appErr.Id)
+
+		_, appErr = th.App.GetTeamByName(name)
+		require.NotNil(t, appErr)
+	})
+}
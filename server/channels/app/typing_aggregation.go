@@ -0,0 +1,95 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// typingAggregator collects the users typing in large channels, so that a single summary event
+// is published per channel or thread every interval instead of an event for every user. Each node
+// of a cluster aggregates the users typing through it.
+type typingAggregator struct {
+	mut     sync.Mutex
+	pending map[typingKey]map[string]bool
+}
+
+type typingKey struct {
+	channelID string
+	parentID  string
+}
+
+func newTypingAggregator() *typingAggregator {
+	return &typingAggregator{
+		pending: make(map[typingKey]map[string]bool),
+	}
+}
+
+// add records that the user is typing. It returns true if the user is the first one typing since
+// the last summary, in which case the next summary has to be scheduled.
+func (t *typingAggregator) add(key typingKey, userID string) bool {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	users, ok := t.pending[key]
+	if !ok {
+		users = make(map[string]bool)
+		t.pending[key] = users
+	}
+	users[userID] = true
+	return !ok
+}
+
+// take returns the users who typed since the last summary and resets them.
+func (t *typingAggregator) take(key typingKey) map[string]bool {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	users := t.pending[key]
+	delete(t.pending, key)
+	return users
+}
+
+// shouldAggregateTyping tells whether the typing events of a channel are published as summaries.
+func (a *App) shouldAggregateTyping(channelID string) bool {
+	threshold := *a.Config().ServiceSettings.TypingAggregationMemberThreshold
+	if threshold == 0 {
+		return false
+	}
+
+	count, err := a.Srv().Store().Channel().GetMemberCount(channelID, true)
+	if err != nil {
+		mlog.Warn("Failed to get the member count of the channel", mlog.String("channel_id", channelID), mlog.Err(err))
+		return false
+	}
+	return count >= int64(threshold)
+}
+
+func (a *App) aggregateUserTyping(userID, channelID, parentID string) {
+	key := typingKey{channelID: channelID, parentID: parentID}
+	if !a.Srv().typingAggregator.add(key, userID) {
+		return
+	}
+
+	interval := time.Duration(*a.Config().ServiceSettings.TypingAggregationIntervalMilliseconds) * time.Millisecond
+	time.AfterFunc(interval, func() {
+		a.publishTypingSummary(key)
+	})
+}
+
+func (a *App) publishTypingSummary(key typingKey) {
+	users := a.Srv().typingAggregator.take(key)
+	if len(users) == 0 {
+		return
+	}
+
+	event := model.NewWebSocketEvent(model.WebsocketEventTypingSummary, "", key.channelID, "", nil, "")
+	event.Add("parent_id", key.parentID)
+	event.Add("count", len(users))
+	a.Publish(event)
+}
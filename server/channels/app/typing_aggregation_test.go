@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTypingAggregator(t *testing.T) {
+	agg := newTypingAggregator()
+	key := typingKey{channelID: model.NewId()}
+	user1 := model.NewId()
+	user2 := model.NewId()
+
+	assert.True(t, agg.add(key, user1), "the first user should schedule a summary")
+	assert.False(t, agg.add(key, user2))
+	assert.False(t, agg.add(key, user1))
+	assert.True(t, agg.add(typingKey{channelID: key.channelID, parentID: model.NewId()}, user1), "threads are summarized separately")
+
+	users := agg.take(key)
+	assert.Equal(t, map[string]bool{user1: true, user2: true}, users)
+	assert.Empty(t, agg.take(key))
+
+	assert.True(t, agg.add(key, user2), "a new summary should be scheduled after the previous one was taken")
+}
+
+func TestShouldAggregateTyping(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.TypingAggregationMemberThreshold = 0 })
+		assert.False(t, th.App.shouldAggregateTyping(th.BasicChannel.Id))
+	})
+
+	t.Run("below threshold", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.TypingAggregationMemberThreshold = 1000 })
+		assert.False(t, th.App.shouldAggregateTyping(th.BasicChannel.Id))
+	})
+
+	t.Run("above threshold", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.TypingAggregationMemberThreshold = 1 })
+		assert.True(t, th.App.shouldAggregateTyping(th.BasicChannel.Id))
+
+		require.Nil(t, th.App.PublishUserTyping(th.BasicUser.Id, th.BasicChannel.Id, ""))
+		users := th.App.Srv().typingAggregator.take(typingKey{channelID: th.BasicChannel.Id})
+		assert.Equal(t, map[string]bool{th.BasicUser.Id: true}, users)
+	})
+}
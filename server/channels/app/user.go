@@ -38,6 +38,8 @@ const (
 	TokenTypeTeamInvitation    = "team_invitation"
 	TokenTypeGuestInvitation   = "guest_invitation"
 	TokenTypeCWSAccess         = "cws_access_token"
+	TokenTypeWebAuthnRegister  = "webauthn_registration"
+	TokenTypeWebAuthnLogin     = "webauthn_login"
 	PasswordRecoverExpiryTime  = 1000 * 60 * 60 * 24 // 24 hours
 	InvitationExpiryTime       = 1000 * 60 * 60 * 48 // 48 hours
 	ImageProfilePixelDimension = 128
@@ -102,6 +104,10 @@ func (a *App) CreateUserWithToken(c request.CTX, user *model.User, token *model.
 		return nil, err
 	}
 
+	if token.Type == TokenTypeGuestInvitation {
+		a.sponsorInvitedGuest(c, ruser.Id, senderId)
+	}
+
 	if _, err := a.JoinUserToTeam(c, team, ruser, ""); err != nil {
 		return nil, err
 	}
@@ -989,6 +995,7 @@ func (a *App) UpdateActive(c request.CTX, user *model.User, active bool) (*model
 		if err := a.userDeactivated(c, ruser.Id); err != nil {
 			return nil, err
 		}
+		a.startUserOffboarding(c, ruser)
 	}
 
 	a.invalidateUserChannelMembersCaches(c, user.Id)
@@ -1616,6 +1623,30 @@ func (a *App) PermanentDeleteUser(c *request.Context, user *model.User) *model.A
 		return model.NewAppError("PermanentDeleteUser", "app.channel.permanent_delete_members_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	if err := a.Srv().Store().ReadReceipt().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.read_receipt.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().Store().WebAuthnCredential().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.webauthn.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().Store().GuestSponsorship().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.guest_sponsorship.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().Store().ChannelMemberExpiry().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.channel_member_expiry.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().Store().ChannelJoinRequest().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.channel_join_request.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().Store().SupportAccessConsent().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.support_access.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
 	if err := a.Srv().Store().Group().PermanentDeleteMembersByUser(user.Id); err != nil {
 		return model.NewAppError("PermanentDeleteUser", "app.group.permanent_delete_members_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -1864,31 +1895,31 @@ func (a *App) VerifyUserEmail(userID, email string) *model.AppError {
 	return nil
 }
 
-func (a *App) SearchUsers(props *model.UserSearch, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+func (a *App) SearchUsers(c request.CTX, props *model.UserSearch, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
 	if props.WithoutTeam {
-		return a.SearchUsersWithoutTeam(props.Term, options)
+		return a.SearchUsersWithoutTeam(c, props.Term, options)
 	}
 	if props.InChannelId != "" {
-		return a.SearchUsersInChannel(props.InChannelId, props.Term, options)
+		return a.SearchUsersInChannel(c, props.InChannelId, props.Term, options)
 	}
 	if props.NotInChannelId != "" {
-		return a.SearchUsersNotInChannel(props.TeamId, props.NotInChannelId, props.Term, options)
+		return a.SearchUsersNotInChannel(c, props.TeamId, props.NotInChannelId, props.Term, options)
 	}
 	if props.NotInTeamId != "" {
-		return a.SearchUsersNotInTeam(props.NotInTeamId, props.Term, options)
+		return a.SearchUsersNotInTeam(c, props.NotInTeamId, props.Term, options)
 	}
 	if props.InGroupId != "" {
-		return a.SearchUsersInGroup(props.InGroupId, props.Term, options)
+		return a.SearchUsersInGroup(c, props.InGroupId, props.Term, options)
 	}
 	if props.NotInGroupId != "" {
-		return a.SearchUsersNotInGroup(props.NotInGroupId, props.Term, options)
+		return a.SearchUsersNotInGroup(c, props.NotInGroupId, props.Term, options)
 	}
-	return a.SearchUsersInTeam(props.TeamId, props.Term, options)
+	return a.SearchUsersInTeam(c, props.TeamId, props.Term, options)
 }
 
-func (a *App) SearchUsersInChannel(channelID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+func (a *App) SearchUsersInChannel(c request.CTX, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
 	term = strings.TrimSpace(term)
-	users, err := a.Srv().Store().User().SearchInChannel(channelID, term, options)
+	users, err := a.Srv().Store().User().SearchInChannel(c.Context(), channelID, term, options)
 	if err != nil {
 		return nil, model.NewAppError("SearchUsersInChannel", "app.user.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -1899,9 +1930,9 @@ func (a *App) SearchUsersInChannel(channelID string, term string, options *model
 	return users, nil
 }
 
-func (a *App) SearchUsersNotInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+func (a *App) SearchUsersNotInChannel(c request.CTX, teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
 	term = strings.TrimSpace(term)
-	users, err := a.Srv().Store().User().SearchNotInChannel(teamID, channelID, term, options)
+	users, err := a.Srv().Store().User().SearchNotInChannel(c.Context(), teamID, channelID, term, options)
 	if err != nil {
 		return nil, model.NewAppError("SearchUsersNotInChannel", "app.user.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -1913,10 +1944,10 @@ func (a *App) SearchUsersNotInChannel(teamID string, channelID string, term stri
 	return users, nil
 }
 
-func (a *App) SearchUsersInTeam(teamID, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+func (a *App) SearchUsersInTeam(c request.CTX, teamID, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
 	term = strings.TrimSpace(term)
 
-	users, err := a.Srv().Store().User().Search(teamID, term, options)
+	users, err := a.Srv().Store().User().Search(c.Context(), teamID, term, options)
 	if err != nil {
 		return nil, model.NewAppError("SearchUsersInTeam", "app.user.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -1928,9 +1959,9 @@ func (a *App) SearchUsersInTeam(teamID, term string, options *model.UserSearchOp
 	return users, nil
 }
 
-func (a *App) SearchUsersNotInTeam(notInTeamId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+func (a *App) SearchUsersNotInTeam(c request.CTX, notInTeamId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
 	term = strings.TrimSpace(term)
-	users, err := a.Srv().Store().User().SearchNotInTeam(notInTeamId, term, options)
+	users, err := a.Srv().Store().User().SearchNotInTeam(c.Context(), notInTeamId, term, options)
 	if err != nil {
 		return nil, model.NewAppError("SearchUsersNotInTeam", "app.user.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -1942,9 +1973,9 @@ func (a *App) SearchUsersNotInTeam(notInTeamId string, term string, options *mod
 	return users, nil
 }
 
-func (a *App) SearchUsersWithoutTeam(term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+func (a *App) SearchUsersWithoutTeam(c request.CTX, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
 	term = strings.TrimSpace(term)
-	users, err := a.Srv().Store().User().SearchWithoutTeam(term, options)
+	users, err := a.Srv().Store().User().SearchWithoutTeam(c.Context(), term, options)
 	if err != nil {
 		return nil, model.NewAppError("SearchUsersWithoutTeam", "app.user.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -1956,9 +1987,9 @@ func (a *App) SearchUsersWithoutTeam(term string, options *model.UserSearchOptio
 	return users, nil
 }
 
-func (a *App) SearchUsersInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+func (a *App) SearchUsersInGroup(c request.CTX, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
 	term = strings.TrimSpace(term)
-	users, err := a.Srv().Store().User().SearchInGroup(groupID, term, options)
+	users, err := a.Srv().Store().User().SearchInGroup(c.Context(), groupID, term, options)
 	if err != nil {
 		return nil, model.NewAppError("SearchUsersInGroup", "app.user.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -1970,9 +2001,9 @@ func (a *App) SearchUsersInGroup(groupID string, term string, options *model.Use
 	return users, nil
 }
 
-func (a *App) SearchUsersNotInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+func (a *App) SearchUsersNotInGroup(c request.CTX, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
 	term = strings.TrimSpace(term)
-	users, err := a.Srv().Store().User().SearchNotInGroup(groupID, term, options)
+	users, err := a.Srv().Store().User().SearchNotInGroup(c.Context(), groupID, term, options)
 	if err != nil {
 		return nil, model.NewAppError("SearchUsersNotInGroup", "app.user.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -1984,10 +2015,10 @@ func (a *App) SearchUsersNotInGroup(groupID string, term string, options *model.
 	return users, nil
 }
 
-func (a *App) AutocompleteUsersInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, *model.AppError) {
+func (a *App) AutocompleteUsersInChannel(c request.CTX, teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, *model.AppError) {
 	term = strings.TrimSpace(term)
 
-	autocomplete, err := a.Srv().Store().User().AutocompleteUsersInChannel(teamID, channelID, term, options)
+	autocomplete, err := a.Srv().Store().User().AutocompleteUsersInChannel(c.Context(), teamID, channelID, term, options)
 	if err != nil {
 		return nil, model.NewAppError("AutocompleteUsersInChannel", "app.user.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2003,10 +2034,10 @@ func (a *App) AutocompleteUsersInChannel(teamID string, channelID string, term s
 	return autocomplete, nil
 }
 
-func (a *App) AutocompleteUsersInTeam(teamID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInTeam, *model.AppError) {
+func (a *App) AutocompleteUsersInTeam(c request.CTX, teamID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInTeam, *model.AppError) {
 	term = strings.TrimSpace(term)
 
-	users, err := a.Srv().Store().User().Search(teamID, term, options)
+	users, err := a.Srv().Store().User().Search(c.Context(), teamID, term, options)
 	if err != nil {
 		return nil, model.NewAppError("AutocompleteUsersInTeam", "app.user.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2226,6 +2257,11 @@ func (a *App) PromoteGuestToUser(c *request.Context, user *model.User, requestor
 	if nErr != nil {
 		return model.NewAppError("PromoteGuestToUser", "app.user.promote_guest.user_update.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
 	}
+
+	if err := a.Srv().Store().GuestSponsorship().PermanentDeleteByUser(user.Id); err != nil {
+		c.Logger().Warn("Failed to remove guest sponsorship on promote guest to user", mlog.String("user_id", user.Id), mlog.Err(err))
+	}
+
 	userTeams, nErr := a.Srv().Store().Team().GetTeamsByUserId(user.Id)
 	if nErr != nil {
 		return model.NewAppError("PromoteGuestToUser", "app.team.get_all.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
@@ -2324,6 +2360,11 @@ func (a *App) DemoteUserToGuest(c request.CTX, user *model.User) *model.AppError
 }
 
 func (a *App) PublishUserTyping(userID, channelID, parentId string) *model.AppError {
+	if a.shouldAggregateTyping(channelID) {
+		a.aggregateUserTyping(userID, channelID, parentId)
+		return nil
+	}
+
 	omitUsers := make(map[string]bool, 1)
 	omitUsers[userID] = true
 
@@ -2485,6 +2526,12 @@ func (a *App) GetThreadsForUser(userID, teamID string, options model.GetUserThre
 		result.Total = result.TotalUnreadThreads
 	}
 
+	threads, appErr := a.filterRestrictedThreads(userID, result.Threads)
+	if appErr != nil {
+		return nil, appErr
+	}
+	result.Threads = threads
+
 	for _, thread := range result.Threads {
 		a.sanitizeProfiles(thread.Participants, false)
 		thread.Post.SanitizeProps()
@@ -2519,6 +2566,14 @@ func (a *App) GetThreadForUser(threadMembership *model.ThreadMembership, extende
 		}
 	}
 
+	visible, appErr := a.IsPostVisibleToUser(threadMembership.UserId, thread.Post)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if !visible {
+		return nil, model.NewAppError("GetThreadForUser", "app.user.get_threads_for_user.not_found", nil, "thread not found/followed", http.StatusNotFound)
+	}
+
 	a.sanitizeProfiles(thread.Participants, false)
 	thread.Post.SanitizeProps()
 	return thread, nil
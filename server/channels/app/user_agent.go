@@ -32,6 +32,20 @@ func getPlatformName(ua *uasurfer.UserAgent) string {
 	return name
 }
 
+var deviceTypeNames = map[uasurfer.DeviceType]string{
+	uasurfer.DeviceUnknown:  "",
+	uasurfer.DeviceComputer: "computer",
+	uasurfer.DeviceTablet:   "tablet",
+	uasurfer.DevicePhone:    "phone",
+	uasurfer.DeviceConsole:  "console",
+	uasurfer.DeviceWearable: "wearable",
+	uasurfer.DeviceTV:       "tv",
+}
+
+func getDeviceType(ua *uasurfer.UserAgent) string {
+	return deviceTypeNames[ua.DeviceType]
+}
+
 var osNames = map[uasurfer.OSName]string{
 	uasurfer.OSUnknown:      "",
 	uasurfer.OSWindowsPhone: "Windows Phone",
@@ -65,6 +65,36 @@ func TestGetPlatformName(t *testing.T) {
 	}
 }
 
+func TestGetDeviceType(t *testing.T) {
+	expected := []string{
+		"computer",
+		"computer",
+		"phone",
+		"phone",
+		"computer",
+		"computer",
+		"computer",
+		"computer",
+		"computer",
+		"computer",
+		"computer",
+		"computer",
+		"computer",
+		"computer",
+		"computer",
+		"phone",
+	}
+
+	for i, userAgent := range testUserAgents {
+		t.Run(fmt.Sprintf("GetDeviceType_%v", i), func(t *testing.T) {
+			ua := uasurfer.Parse(userAgent.UserAgent)
+
+			actual := getDeviceType(ua)
+			assert.Equal(t, expected[i], actual)
+		})
+	}
+}
+
 func TestGetOSName(t *testing.T) {
 	expected := []string{
 		"Windows 7",
@@ -0,0 +1,479 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const (
+	offboardingPageSize = 100
+
+	offboardingPlaybookRunsURL = "/plugins/playbooks/api/v0/runs"
+
+	// offboardingSessionLifetime is how long the session the Playbooks runs are reassigned with
+	// lasts, the session being revoked as soon as they are.
+	offboardingSessionLifetime = 10 * 60 * 1000
+)
+
+type playbookRunList struct {
+	HasMore bool `json:"has_more"`
+	Items   []struct {
+		ID string `json:"id"`
+	} `json:"items"`
+}
+
+// StartUserOffboarding starts a user_offboarding job handing over what the deactivated user owned
+// to the successor. Without a successor, only the user's removal from channels can take place.
+func (a *App) StartUserOffboarding(c request.CTX, userID, successorID string) (*model.Job, *model.AppError) {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if user.DeleteAt == 0 {
+		return nil, model.NewAppError("StartUserOffboarding", "app.user_offboarding.active_user.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if successorID != "" {
+		if _, appErr := a.getOffboardingSuccessor(userID, successorID); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	return a.Srv().Jobs.CreateJob(model.JobTypeUserOffboarding, map[string]string{
+		"user_id":      userID,
+		"successor_id": successorID,
+	})
+}
+
+// GetUserOffboardingReports returns the reports of the offboarding jobs of the user, the newest
+// first.
+func (a *App) GetUserOffboardingReports(userID string) ([]*model.OffboardingReport, *model.AppError) {
+	jobs, err := a.Srv().Store().Job().GetAllByType(model.JobTypeUserOffboarding)
+	if err != nil {
+		return nil, model.NewAppError("GetUserOffboardingReports", "app.job.get_all.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	reports := []*model.OffboardingReport{}
+	for _, job := range jobs {
+		if job.Data["user_id"] != userID {
+			continue
+		}
+
+		report, err := model.OffboardingReportFromJob(job)
+		if err != nil {
+			return nil, model.NewAppError("GetUserOffboardingReports", "app.user_offboarding.report.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// OffboardUser does the work of the user_offboarding job for the deactivated user, running the
+// steps enabled in the configuration. A step failing for an item doesn't stop the offboarding,
+// the failure being recorded in the returned report instead.
+func (a *App) OffboardUser(c request.CTX, userID, successorID string, reportProgress func(int)) (*model.OffboardingReport, *model.AppError) {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if user.DeleteAt == 0 {
+		return nil, model.NewAppError("OffboardUser", "app.user_offboarding.active_user.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	var successor *model.User
+	if successorID != "" {
+		if successor, appErr = a.getOffboardingSuccessor(userID, successorID); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	settings := a.Config().OffboardingSettings
+	report := model.NewOffboardingReport(userID, successorID)
+
+	if *settings.ReassignIntegrations && successor != nil {
+		a.reassignIntegrations(c, user, successor, report.AddStep(model.OffboardingStepReassignIntegrations))
+	} else {
+		report.SkipStep(model.OffboardingStepReassignIntegrations)
+	}
+	reportProgress(25)
+
+	if *settings.ReassignPlaybookRuns && successor != nil {
+		a.reassignPlaybookRuns(c, user, successor, report.AddStep(model.OffboardingStepReassignPlaybookRuns))
+	} else {
+		report.SkipStep(model.OffboardingStepReassignPlaybookRuns)
+	}
+	reportProgress(50)
+
+	// The private channels are transferred before the user is removed from them, as the user has
+	// to be found among their admins.
+	if *settings.TransferPrivateChannels && successor != nil {
+		a.transferPrivateChannels(c, user, successor, report.AddStep(model.OffboardingStepTransferPrivateChannels))
+	} else {
+		report.SkipStep(model.OffboardingStepTransferPrivateChannels)
+	}
+	reportProgress(75)
+
+	if *settings.RemoveFromChannels {
+		a.removeOffboardedUserFromChannels(c, user, report.AddStep(model.OffboardingStepRemoveFromChannels))
+	} else {
+		report.SkipStep(model.OffboardingStepRemoveFromChannels)
+	}
+	reportProgress(100)
+
+	return report, nil
+}
+
+// startUserOffboarding starts the offboarding of a user who was just deactivated, when enabled.
+// The user deactivating someone else becomes their successor.
+func (a *App) startUserOffboarding(c request.CTX, user *model.User) {
+	if !*a.Config().OffboardingSettings.Enable || user.IsBot {
+		return
+	}
+
+	successorID := c.Session().UserId
+	if successorID == user.Id {
+		successorID = ""
+	}
+	if successorID != "" {
+		if _, appErr := a.getOffboardingSuccessor(user.Id, successorID); appErr != nil {
+			successorID = ""
+		}
+	}
+
+	if _, appErr := a.StartUserOffboarding(c, user.Id, successorID); appErr != nil {
+		c.Logger().Warn("Failed to start the offboarding of a deactivated user", mlog.String("user_id", user.Id), mlog.Err(appErr))
+	}
+}
+
+// getOffboardingSuccessor returns the successor of the user, who has to be another active user.
+func (a *App) getOffboardingSuccessor(userID, successorID string) (*model.User, *model.AppError) {
+	if successorID == userID {
+		return nil, model.NewAppError("getOffboardingSuccessor", "app.user_offboarding.invalid_successor.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	successor, appErr := a.GetUser(successorID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if successor.DeleteAt != 0 || successor.IsBot {
+		return nil, model.NewAppError("getOffboardingSuccessor", "app.user_offboarding.invalid_successor.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return successor, nil
+}
+
+func (a *App) reassignIntegrations(c request.CTX, user, successor *model.User, result *model.OffboardingStepResult) {
+	commands, err := a.Srv().Store().Command().GetByCreator(user.Id)
+	if err != nil {
+		result.Fail(user.Id, err)
+	}
+	for _, command := range commands {
+		command.CreatorId = successor.Id
+		if _, err := a.Srv().Store().Command().Update(command); err != nil {
+			result.Fail(command.Id, err)
+			continue
+		}
+		result.Add(command.Id)
+	}
+
+	var incomingHooks []*model.IncomingWebhook
+	for offset := 0; ; offset += offboardingPageSize {
+		page, err := a.Srv().Store().Webhook().GetIncomingListByUser(user.Id, offset, offboardingPageSize)
+		if err != nil {
+			result.Fail(user.Id, err)
+			break
+		}
+		incomingHooks = append(incomingHooks, page...)
+		if len(page) < offboardingPageSize {
+			break
+		}
+	}
+	for _, hook := range incomingHooks {
+		if err := a.Srv().Store().Webhook().UpdateIncomingUser(hook.Id, successor.Id); err != nil {
+			result.Fail(hook.Id, err)
+			continue
+		}
+		a.Srv().Platform().InvalidateCacheForWebhook(hook.Id)
+		result.Add(hook.Id)
+	}
+
+	var outgoingHooks []*model.OutgoingWebhook
+	for offset := 0; ; offset += offboardingPageSize {
+		page, err := a.Srv().Store().Webhook().GetOutgoingListByUser(user.Id, offset, offboardingPageSize)
+		if err != nil {
+			result.Fail(user.Id, err)
+			break
+		}
+		outgoingHooks = append(outgoingHooks, page...)
+		if len(page) < offboardingPageSize {
+			break
+		}
+	}
+	for _, hook := range outgoingHooks {
+		hook.CreatorId = successor.Id
+		if _, err := a.Srv().Store().Webhook().UpdateOutgoing(hook); err != nil {
+			result.Fail(hook.Id, err)
+			continue
+		}
+		result.Add(hook.Id)
+	}
+
+	var oauthApps []*model.OAuthApp
+	for offset := 0; ; offset += offboardingPageSize {
+		page, err := a.Srv().Store().OAuth().GetAppByUser(user.Id, offset, offboardingPageSize)
+		if err != nil {
+			result.Fail(user.Id, err)
+			break
+		}
+		oauthApps = append(oauthApps, page...)
+		if len(page) < offboardingPageSize {
+			break
+		}
+	}
+	for _, oauthApp := range oauthApps {
+		if err := a.Srv().Store().OAuth().UpdateAppCreator(oauthApp.Id, successor.Id); err != nil {
+			result.Fail(oauthApp.Id, err)
+			continue
+		}
+		result.Add(oauthApp.Id)
+	}
+
+	var bots model.BotList
+	for page := 0; ; page++ {
+		botPage, appErr := a.GetBots(&model.BotGetOptions{
+			OwnerId:        user.Id,
+			IncludeDeleted: true,
+			Page:           page,
+			PerPage:        offboardingPageSize,
+		})
+		if appErr != nil {
+			result.Fail(user.Id, appErr)
+			break
+		}
+		bots = append(bots, botPage...)
+		if len(botPage) < offboardingPageSize {
+			break
+		}
+	}
+	for _, bot := range bots {
+		if _, appErr := a.UpdateBotOwner(bot.UserId, successor.Id); appErr != nil {
+			result.Fail(bot.UserId, appErr)
+			continue
+		}
+		result.Add(bot.UserId)
+	}
+}
+
+// reassignPlaybookRuns makes the successor the owner of the in progress Playbooks runs of the user.
+// The requests to the Playbooks plugin are made on behalf of the successor, through a session
+// revoked once they're done.
+func (a *App) reassignPlaybookRuns(c request.CTX, user, successor *model.User, result *model.OffboardingStepResult) {
+	if active, err := a.IsPluginActive(model.PluginIdPlaybooks); err != nil || !active {
+		result.Status = model.OffboardingStepStatusSkipped
+		return
+	}
+
+	session, appErr := a.CreateSession(&model.Session{
+		UserId:    successor.Id,
+		Roles:     successor.GetRawRoles(),
+		ExpiresAt: model.GetMillis() + offboardingSessionLifetime,
+	})
+	if appErr != nil {
+		result.Fail(user.Id, appErr)
+		return
+	}
+	defer func() {
+		if appErr := a.RevokeSession(session); appErr != nil {
+			c.Logger().Warn("Failed to revoke the offboarding session", mlog.String("user_id", successor.Id), mlog.Err(appErr))
+		}
+	}()
+
+	pluginContext := request.EmptyContext(c.Logger())
+	pluginContext.SetSession(session)
+
+	var runIDs []string
+	for page := 0; ; page++ {
+		values := url.Values{}
+		values.Set("owner_user_id", user.Id)
+		values.Set("statuses", "InProgress")
+		values.Set("page", strconv.Itoa(page))
+		values.Set("per_page", strconv.Itoa(offboardingPageSize))
+
+		runs, err := a.getPlaybookRuns(pluginContext, values)
+		if err != nil {
+			result.Fail(user.Id, err)
+			return
+		}
+		for _, run := range runs.Items {
+			runIDs = append(runIDs, run.ID)
+		}
+		if !runs.HasMore {
+			break
+		}
+	}
+
+	body, err := json.Marshal(map[string]string{"owner_id": successor.Id})
+	if err != nil {
+		result.Fail(user.Id, err)
+		return
+	}
+
+	for _, runID := range runIDs {
+		resp, appErr := a.doPluginRequest(pluginContext, http.MethodPost, offboardingPlaybookRunsURL+"/"+runID+"/owner", nil, body)
+		if appErr != nil {
+			result.Fail(runID, appErr)
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			result.Fail(runID, errors.New("unexpected status "+resp.Status))
+			continue
+		}
+		result.Add(runID)
+	}
+}
+
+func (a *App) getPlaybookRuns(c *request.Context, values url.Values) (*playbookRunList, error) {
+	resp, appErr := a.doPluginRequest(c, http.MethodGet, offboardingPlaybookRunsURL, values, nil)
+	if appErr != nil {
+		return nil, appErr
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("unexpected status " + resp.Status)
+	}
+
+	var runs playbookRunList
+	if err := json.NewDecoder(resp.Body).Decode(&runs); err != nil {
+		return nil, err
+	}
+	return &runs, nil
+}
+
+// transferPrivateChannels makes the successor an admin of the private channels the user was the
+// only admin of, adding the successor to them when needed.
+func (a *App) transferPrivateChannels(c request.CTX, user, successor *model.User, result *model.OffboardingStepResult) {
+	channels, err := a.getOffboardedUserChannels(c, user.Id)
+	if err != nil {
+		result.Fail(user.Id, err)
+		return
+	}
+
+	for _, channel := range channels {
+		if channel.Type != model.ChannelTypePrivate {
+			continue
+		}
+
+		onlyAdmin, err := a.isOnlyChannelAdmin(channel.Id, user.Id)
+		if err != nil {
+			result.Fail(channel.Id, err)
+			continue
+		}
+		if !onlyAdmin {
+			continue
+		}
+
+		if _, appErr := a.GetChannelMember(c, channel.Id, successor.Id); appErr != nil {
+			if _, appErr = a.AddChannelMember(c, successor.Id, channel, ChannelMemberOpts{}); appErr != nil {
+				result.Fail(channel.Id, appErr)
+				continue
+			}
+		}
+
+		if _, appErr := a.UpdateChannelMemberSchemeRoles(c, channel.Id, successor.Id, false, true, true); appErr != nil {
+			result.Fail(channel.Id, appErr)
+			continue
+		}
+		result.Add(channel.Id)
+	}
+}
+
+// isOnlyChannelAdmin tells whether the user is an admin of the channel and none of the other
+// members are.
+func (a *App) isOnlyChannelAdmin(channelID, userID string) (bool, error) {
+	isAdmin := false
+	for offset := 0; ; offset += offboardingPageSize {
+		members, err := a.Srv().Store().Channel().GetMembers(channelID, offset, offboardingPageSize)
+		if err != nil {
+			return false, err
+		}
+		for _, member := range members {
+			if !member.SchemeAdmin {
+				continue
+			}
+			if member.UserId != userID {
+				return false, nil
+			}
+			isAdmin = true
+		}
+		if len(members) < offboardingPageSize {
+			break
+		}
+	}
+	return isAdmin, nil
+}
+
+// removeOffboardedUserFromChannels removes the user from the channels of their teams, except the
+// default channels which members can't leave.
+func (a *App) removeOffboardedUserFromChannels(c request.CTX, user *model.User, result *model.OffboardingStepResult) {
+	channels, err := a.getOffboardedUserChannels(c, user.Id)
+	if err != nil {
+		result.Fail(user.Id, err)
+		return
+	}
+
+	for _, channel := range channels {
+		if channel.Name == model.DefaultChannelName {
+			continue
+		}
+
+		if appErr := a.RemoveUserFromChannel(c, user.Id, "", channel); appErr != nil {
+			result.Fail(channel.Id, appErr)
+			continue
+		}
+		result.Add(channel.Id)
+	}
+}
+
+// getOffboardedUserChannels returns the public and private channels the user is a member of.
+func (a *App) getOffboardedUserChannels(c request.CTX, userID string) ([]*model.Channel, error) {
+	teams, appErr := a.GetTeamsForUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var channels []*model.Channel
+	for _, team := range teams {
+		teamChannels, err := a.Srv().Store().Channel().GetChannels(team.Id, userID, &model.ChannelSearchOpts{})
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if errors.As(err, &nfErr) {
+				continue
+			}
+			return nil, err
+		}
+		for _, channel := range teamChannels {
+			if channel.Type == model.ChannelTypeOpen || channel.Type == model.ChannelTypePrivate {
+				channels = append(channels, channel)
+			}
+		}
+	}
+	return channels, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestOffboardUser(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableCommands = true
+		*cfg.ServiceSettings.EnableIncomingWebhooks = true
+		*cfg.OffboardingSettings.Enable = true
+		*cfg.OffboardingSettings.RemoveFromChannels = true
+	})
+
+	user := th.CreateUser()
+	th.LinkUserToTeam(user, th.BasicTeam)
+	th.AddUserToChannel(user, th.BasicChannel)
+
+	privateChannel, appErr := th.App.CreateChannelWithUser(th.Context, &model.Channel{
+		TeamId:      th.BasicTeam.Id,
+		Name:        "private-" + model.NewId(),
+		DisplayName: "Private",
+		Type:        model.ChannelTypePrivate,
+	}, user.Id)
+	require.Nil(t, appErr)
+
+	command, appErr := th.App.CreateCommand(&model.Command{
+		CreatorId: user.Id,
+		TeamId:    th.BasicTeam.Id,
+		Trigger:   "offboarding",
+		URL:       "https://example.com/command",
+		Method:    model.CommandMethodPost,
+	})
+	require.Nil(t, appErr)
+
+	hook, appErr := th.App.CreateIncomingWebhookForChannel(user.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id})
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.OffboardUser(th.Context, user.Id, th.BasicUser.Id, func(int) {})
+	require.NotNil(t, appErr)
+	assert.Equal(t, "app.user_offboarding.active_user.app_error", appErr.Id)
+
+	user, appErr = th.App.UpdateActive(th.Context, user, false)
+	require.Nil(t, appErr)
+
+	reports, appErr := th.App.GetUserOffboardingReports(user.Id)
+	require.Nil(t, appErr)
+	require.Len(t, reports, 1, "the deactivation starts the offboarding")
+	assert.Equal(t, model.JobStatusPending, reports[0].JobStatus)
+
+	t.Run("the successor can't be the user", func(t *testing.T) {
+		_, appErr := th.App.StartUserOffboarding(th.Context, user.Id, user.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.user_offboarding.invalid_successor.app_error", appErr.Id)
+	})
+
+	report, appErr := th.App.OffboardUser(th.Context, user.Id, th.BasicUser.Id, func(int) {})
+	require.Nil(t, appErr)
+	require.Len(t, report.Steps, 4)
+	assert.False(t, report.HasFailures())
+
+	integrations := report.Steps[0]
+	assert.Equal(t, model.OffboardingStepReassignIntegrations, integrations.Step)
+	assert.ElementsMatch(t, []string{command.Id, hook.Id}, integrations.Ids)
+
+	reassignedCommand, appErr := th.App.GetCommand(command.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, th.BasicUser.Id, reassignedCommand.CreatorId)
+
+	reassignedHook, appErr := th.App.GetIncomingWebhook(hook.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, th.BasicUser.Id, reassignedHook.UserId)
+
+	assert.Equal(t, model.OffboardingStepStatusSkipped, report.Steps[1].Status, "playbooks aren't available")
+
+	transfer := report.Steps[2]
+	assert.Equal(t, []string{privateChannel.Id}, transfer.Ids)
+	member, appErr := th.App.GetChannelMember(th.Context, privateChannel.Id, th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.True(t, member.SchemeAdmin)
+
+	removal := report.Steps[3]
+	assert.Contains(t, removal.Ids, th.BasicChannel.Id)
+	assert.Contains(t, removal.Ids, privateChannel.Id)
+	_, appErr = th.App.GetChannelMember(th.Context, th.BasicChannel.Id, user.Id)
+	require.NotNil(t, appErr, "the user is removed from the channels")
+}
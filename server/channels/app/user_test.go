@@ -939,6 +939,10 @@ func TestCreateUserWithToken(t *testing.T) {
 		require.Nil(t, err)
 		require.Len(t, members, 1)
 		assert.Equal(t, members[0].ChannelId, th.BasicChannel.Id)
+
+		sponsorship, err := th.App.GetGuestSponsorship(newGuest.Id)
+		require.Nil(t, err)
+		assert.Equal(t, th.BasicUser.Id, sponsorship.SponsorId)
 	})
 
 	t.Run("create guest having email domain restrictions", func(t *testing.T) {
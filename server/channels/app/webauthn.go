@@ -0,0 +1,573 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// webAuthnAlgorithms are the signature algorithms of the credentials that can be registered, in
+// order of preference.
+var webAuthnAlgorithms = []webauthncose.COSEAlgorithmIdentifier{
+	webauthncose.AlgES256,
+	webauthncose.AlgEdDSA,
+	webauthncose.AlgES384,
+	webauthncose.AlgES512,
+	webauthncose.AlgPS256,
+	webauthncose.AlgRS256,
+}
+
+// webAuthnRelyingParty returns the relying party the credentials are scoped to, which is the
+// host of the site URL.
+func (a *App) webAuthnRelyingParty() (*webauthn.WebAuthn, *model.AppError) {
+	if !*a.Config().WebAuthnSettings.Enable {
+		return nil, model.NewAppError("webAuthnRelyingParty", "app.webauthn.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	siteURL, err := url.Parse(*a.Config().ServiceSettings.SiteURL)
+	if err != nil || siteURL.Hostname() == "" {
+		return nil, model.NewAppError("webAuthnRelyingParty", "app.webauthn.site_url.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	displayName := *a.Config().TeamSettings.SiteName
+	if displayName == "" {
+		displayName = model.TeamSettingsDefaultSiteName
+	}
+
+	rp, err := webauthn.New(&webauthn.Config{
+		RPDisplayName: displayName,
+		RPID:          siteURL.Hostname(),
+		RPOrigins:     []string{siteURL.Scheme + "://" + siteURL.Host},
+		Timeout:       model.WebAuthnChallengeExpiry,
+	})
+	if err != nil {
+		return nil, model.NewAppError("webAuthnRelyingParty", "app.webauthn.site_url.app_error", nil, "", http.StatusNotImplemented).Wrap(err)
+	}
+
+	return rp, nil
+}
+
+// webAuthnUser presents a user and their credentials to the WebAuthn library. The user handle is
+// the id of the user.
+type webAuthnUser struct {
+	user        *model.User
+	credentials []*model.WebAuthnCredential
+}
+
+func (u *webAuthnUser) WebAuthnID() []byte {
+	return []byte(u.user.Id)
+}
+
+func (u *webAuthnUser) WebAuthnName() string {
+	return u.user.Username
+}
+
+func (u *webAuthnUser) WebAuthnDisplayName() string {
+	return u.user.GetDisplayName(model.ShowFullName)
+}
+
+func (u *webAuthnUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0, len(u.credentials))
+	for _, credential := range u.credentials {
+		id, err := base64.RawURLEncoding.DecodeString(credential.CredentialId)
+		if err != nil {
+			continue
+		}
+		credentials = append(credentials, webauthn.Credential{
+			ID:            id,
+			PublicKey:     credential.PublicKey,
+			Authenticator: webauthn.Authenticator{SignCount: uint32(credential.SignCount)},
+		})
+	}
+	return credentials
+}
+
+// webAuthnSession returns the state of the ceremony the token is the challenge of.
+func webAuthnSession(token *model.Token, userID string, requireUserVerification bool) webauthn.SessionData {
+	userVerification := protocol.VerificationPreferred
+	if requireUserVerification {
+		userVerification = protocol.VerificationRequired
+	}
+
+	return webauthn.SessionData{
+		Challenge:        base64.RawURLEncoding.EncodeToString([]byte(token.Token)),
+		UserID:           []byte(userID),
+		UserVerification: userVerification,
+	}
+}
+
+// parseWebAuthnRegistration parses the credential the authenticator created. The library also
+// expects the raw id, which is the id the clients send.
+func parseWebAuthnRegistration(response *model.WebAuthnRegistrationResponse) (*protocol.ParsedCredentialCreationData, error) {
+	body, err := json.Marshal(struct {
+		*model.WebAuthnRegistrationResponse
+		RawId string `json:"rawId"`
+	}{response, response.Id})
+	if err != nil {
+		return nil, err
+	}
+	return protocol.ParseCredentialCreationResponseBody(bytes.NewReader(body))
+}
+
+// parseWebAuthnAssertion parses the assertion the authenticator signed, like
+// parseWebAuthnRegistration.
+func parseWebAuthnAssertion(response *model.WebAuthnAssertionResponse) (*protocol.ParsedCredentialAssertionData, error) {
+	body, err := json.Marshal(struct {
+		*model.WebAuthnAssertionResponse
+		RawId string `json:"rawId"`
+	}{response, response.Id})
+	if err != nil {
+		return nil, err
+	}
+	return protocol.ParseCredentialRequestResponseBody(bytes.NewReader(body))
+}
+
+func webAuthnCredentialDescriptors(credentials []*model.WebAuthnCredential) []model.WebAuthnCredentialDescriptor {
+	descriptors := make([]model.WebAuthnCredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		descriptors = append(descriptors, model.WebAuthnCredentialDescriptor{
+			Type: model.WebAuthnCredentialType,
+			Id:   credential.CredentialId,
+		})
+	}
+	return descriptors
+}
+
+// createWebAuthnChallenge saves a single use challenge, bound to the given user when set.
+func (a *App) createWebAuthnChallenge(tokenType, userID string) (*model.Token, *model.AppError) {
+	token := model.NewToken(tokenType, userID)
+	if err := a.Srv().Store().Token().Save(token); err != nil {
+		return nil, model.NewAppError("createWebAuthnChallenge", "app.webauthn.save_challenge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return token, nil
+}
+
+// fakeWebAuthnCredentialDescriptors returns the credentials listed for the login ids without
+// any, alike those of the other users and the same on every call, so that the options don't
+// reveal which accounts exist or hold security keys.
+func (a *App) fakeWebAuthnCredentialDescriptors(loginID string) []model.WebAuthnCredentialDescriptor {
+	mac := hmac.New(sha256.New, a.PostActionCookieSecret())
+	mac.Write([]byte("webauthn:" + strings.ToLower(loginID)))
+
+	return []model.WebAuthnCredentialDescriptor{{
+		Type: model.WebAuthnCredentialType,
+		Id:   base64.RawURLEncoding.EncodeToString(mac.Sum(nil)),
+	}}
+}
+
+// consumeWebAuthnChallenge finds the given challenge, as answered in the client data, and deletes
+// it, so that it can't be answered twice.
+func (a *App) consumeWebAuthnChallenge(encodedChallenge string, tokenType string) (*model.Token, *model.AppError) {
+	invalidErr := model.NewAppError("consumeWebAuthnChallenge", "app.webauthn.invalid_challenge.app_error", nil, "", http.StatusBadRequest)
+
+	challenge, err := base64.RawURLEncoding.DecodeString(encodedChallenge)
+	if err != nil || len(challenge) != model.TokenSize {
+		return nil, invalidErr
+	}
+
+	token, err := a.Srv().Store().Token().GetByToken(string(challenge))
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, invalidErr.Wrap(err)
+		}
+		return nil, model.NewAppError("consumeWebAuthnChallenge", "app.webauthn.get_challenge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if appErr := a.DeleteToken(token); appErr != nil {
+		return nil, appErr
+	}
+
+	if token.Type != tokenType || model.GetMillis()-token.CreateAt > model.WebAuthnChallengeExpiry {
+		return nil, invalidErr
+	}
+
+	return token, nil
+}
+
+// GetWebAuthnCredentials returns the passkeys and security keys of the user.
+func (a *App) GetWebAuthnCredentials(userID string) ([]*model.WebAuthnCredential, *model.AppError) {
+	credentials, err := a.Srv().Store().WebAuthnCredential().GetForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetWebAuthnCredentials", "app.webauthn.get_credentials.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return credentials, nil
+}
+
+// UserHasWebAuthnCredentials tells whether the user can use a security key as a second factor.
+func (a *App) UserHasWebAuthnCredentials(userID string) (bool, *model.AppError) {
+	if !*a.Config().WebAuthnSettings.Enable {
+		return false, nil
+	}
+
+	credentials, appErr := a.GetWebAuthnCredentials(userID)
+	if appErr != nil {
+		return false, appErr
+	}
+	return len(credentials) > 0, nil
+}
+
+func (a *App) getWebAuthnCredentialForUser(userID, id string) (*model.WebAuthnCredential, *model.AppError) {
+	credential, err := a.Srv().Store().WebAuthnCredential().Get(id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, model.NewAppError("getWebAuthnCredentialForUser", "app.webauthn.credential_not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		}
+		return nil, model.NewAppError("getWebAuthnCredentialForUser", "app.webauthn.get_credentials.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if credential.UserId != userID {
+		return nil, model.NewAppError("getWebAuthnCredentialForUser", "app.webauthn.credential_not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	return credential, nil
+}
+
+// BeginWebAuthnRegistration returns the options for the user to create a new credential with.
+// Like MFA, credentials can only be registered by the users signing in with a password.
+func (a *App) BeginWebAuthnRegistration(user *model.User) (*model.WebAuthnCreationOptions, *model.AppError) {
+	rp, appErr := a.webAuthnRelyingParty()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if user.AuthService != "" && user.AuthService != model.UserAuthServiceLdap {
+		return nil, model.NewAppError("BeginWebAuthnRegistration", "app.webauthn.email_and_ldap_only.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	credentials, appErr := a.GetWebAuthnCredentials(user.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if maxCredentials := *a.Config().WebAuthnSettings.MaxCredentialsPerUser; len(credentials) >= maxCredentials {
+		return nil, model.NewAppError("BeginWebAuthnRegistration", "app.webauthn.too_many_credentials.app_error", map[string]any{"Max": maxCredentials}, "", http.StatusBadRequest)
+	}
+
+	token, appErr := a.createWebAuthnChallenge(TokenTypeWebAuthnRegister, user.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	params := make([]model.WebAuthnCredentialParameters, 0, len(webAuthnAlgorithms))
+	for _, alg := range webAuthnAlgorithms {
+		params = append(params, model.WebAuthnCredentialParameters{Type: model.WebAuthnCredentialType, Alg: int(alg)})
+	}
+
+	userVerification := model.WebAuthnUserVerificationPreferred
+	if *a.Config().WebAuthnSettings.RequireUserVerification {
+		userVerification = model.WebAuthnUserVerificationRequired
+	}
+
+	return &model.WebAuthnCreationOptions{
+		Challenge: base64.RawURLEncoding.EncodeToString([]byte(token.Token)),
+		RP: model.WebAuthnRelyingParty{
+			Id:   rp.Config.RPID,
+			Name: rp.Config.RPDisplayName,
+		},
+		User: model.WebAuthnUser{
+			Id:          base64.RawURLEncoding.EncodeToString([]byte(user.Id)),
+			Name:        user.Username,
+			DisplayName: user.GetDisplayName(model.ShowFullName),
+		},
+		PubKeyCredParams:   params,
+		Timeout:            model.WebAuthnChallengeExpiry,
+		ExcludeCredentials: webAuthnCredentialDescriptors(credentials),
+		AuthenticatorSelection: model.WebAuthnAuthenticatorSelection{
+			ResidentKey:      model.WebAuthnResidentKeyPreferred,
+			UserVerification: userVerification,
+		},
+		Attestation: model.WebAuthnAttestationNone,
+	}, nil
+}
+
+// FinishWebAuthnRegistration verifies the credential the authenticator created and saves it.
+func (a *App) FinishWebAuthnRegistration(user *model.User, registration *model.WebAuthnRegistrationRequest) (*model.WebAuthnCredential, *model.AppError) {
+	rp, appErr := a.webAuthnRelyingParty()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	invalidErr := model.NewAppError("FinishWebAuthnRegistration", "app.webauthn.invalid_credential.app_error", nil, "", http.StatusBadRequest)
+	if registration.Credential == nil {
+		return nil, invalidErr
+	}
+
+	parsed, err := parseWebAuthnRegistration(registration.Credential)
+	if err != nil {
+		return nil, invalidErr.Wrap(err)
+	}
+
+	token, appErr := a.consumeWebAuthnChallenge(parsed.Response.CollectedClientData.Challenge, TokenTypeWebAuthnRegister)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if token.Extra != user.Id {
+		return nil, model.NewAppError("FinishWebAuthnRegistration", "app.webauthn.invalid_challenge.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	session := webAuthnSession(token, user.Id, *a.Config().WebAuthnSettings.RequireUserVerification)
+	created, err := rp.CreateCredential(&webAuthnUser{user: user}, session, parsed)
+	if err != nil {
+		return nil, invalidErr.Wrap(err)
+	}
+
+	// Make sure the key can be used before storing it
+	if _, err = webauthncose.ParsePublicKey(created.PublicKey); err != nil {
+		return nil, invalidErr.Wrap(err)
+	}
+
+	credential := &model.WebAuthnCredential{
+		UserId:       user.Id,
+		CredentialId: base64.RawURLEncoding.EncodeToString(created.ID),
+		PublicKey:    created.PublicKey,
+		SignCount:    int64(created.Authenticator.SignCount),
+		Name:         strings.TrimSpace(registration.Name),
+	}
+
+	credential, err = a.Srv().Store().WebAuthnCredential().Save(credential)
+	if err != nil {
+		var appErr *model.AppError
+		var conflictErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &conflictErr):
+			return nil, model.NewAppError("FinishWebAuthnRegistration", "app.webauthn.credential_exists.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		default:
+			return nil, model.NewAppError("FinishWebAuthnRegistration", "app.webauthn.save_credential.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return credential, nil
+}
+
+// UpdateWebAuthnCredentialName renames a credential of the user.
+func (a *App) UpdateWebAuthnCredentialName(userID, id, name string) (*model.WebAuthnCredential, *model.AppError) {
+	credential, appErr := a.getWebAuthnCredentialForUser(userID, id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	credential.Name = strings.TrimSpace(name)
+	if appErr = credential.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	if err := a.Srv().Store().WebAuthnCredential().UpdateName(credential.Id, credential.Name); err != nil {
+		return nil, model.NewAppError("UpdateWebAuthnCredentialName", "app.webauthn.update_credential.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return credential, nil
+}
+
+// DeleteWebAuthnCredential removes a credential of the user.
+func (a *App) DeleteWebAuthnCredential(userID, id string) *model.AppError {
+	credential, appErr := a.getWebAuthnCredentialForUser(userID, id)
+	if appErr != nil {
+		return appErr
+	}
+
+	if err := a.Srv().Store().WebAuthnCredential().Delete(credential.Id); err != nil {
+		return model.NewAppError("DeleteWebAuthnCredential", "app.webauthn.delete_credential.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// BeginWebAuthnLogin returns the options to sign in with. When a login id is given, the
+// credentials of the user are listed for them to be used as a second factor, and made up ones
+// when there are none. Otherwise the client is asking for a passkey to sign in with alone.
+func (a *App) BeginWebAuthnLogin(loginID string) (*model.WebAuthnRequestOptions, *model.AppError) {
+	rp, appErr := a.webAuthnRelyingParty()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	userVerification := model.WebAuthnUserVerificationPreferred
+	if *a.Config().WebAuthnSettings.RequireUserVerification {
+		userVerification = model.WebAuthnUserVerificationRequired
+	}
+
+	var userID string
+	allowCredentials := []model.WebAuthnCredentialDescriptor{}
+	if loginID == "" {
+		if !*a.Config().WebAuthnSettings.EnablePasskeyLogin {
+			return nil, model.NewAppError("BeginWebAuthnLogin", "app.webauthn.passkey_login_disabled.app_error", nil, "", http.StatusNotImplemented)
+		}
+		userVerification = model.WebAuthnUserVerificationRequired
+	} else {
+		var credentials []*model.WebAuthnCredential
+		if user, appErr := a.GetUserForLogin("", loginID); appErr == nil {
+			credentials, appErr = a.GetWebAuthnCredentials(user.Id)
+			if appErr != nil {
+				return nil, appErr
+			}
+			userID = user.Id
+		}
+
+		if len(credentials) > 0 {
+			allowCredentials = webAuthnCredentialDescriptors(credentials)
+		} else {
+			// Unknown users get a challenge too, not to reveal which accounts exist
+			allowCredentials = a.fakeWebAuthnCredentialDescriptors(loginID)
+		}
+	}
+
+	token, appErr := a.createWebAuthnChallenge(TokenTypeWebAuthnLogin, userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return &model.WebAuthnRequestOptions{
+		Challenge:        base64.RawURLEncoding.EncodeToString([]byte(token.Token)),
+		Timeout:          model.WebAuthnChallengeExpiry,
+		RPId:             rp.Config.RPID,
+		AllowCredentials: allowCredentials,
+		UserVerification: userVerification,
+	}, nil
+}
+
+// verifyWebAuthnAssertion verifies that the response was signed by a credential of the user and
+// records the use of the credential.
+func (a *App) verifyWebAuthnAssertion(user *model.User, response *model.WebAuthnAssertionResponse, requireUserVerification bool) *model.AppError {
+	rp, appErr := a.webAuthnRelyingParty()
+	if appErr != nil {
+		return appErr
+	}
+
+	invalidErr := model.NewAppError("verifyWebAuthnAssertion", "app.webauthn.invalid_assertion.app_error", nil, "", http.StatusUnauthorized)
+
+	parsed, err := parseWebAuthnAssertion(response)
+	if err != nil {
+		return invalidErr.Wrap(err)
+	}
+
+	token, appErr := a.consumeWebAuthnChallenge(parsed.Response.CollectedClientData.Challenge, TokenTypeWebAuthnLogin)
+	if appErr != nil {
+		return appErr
+	}
+	if token.Extra != "" && token.Extra != user.Id {
+		return invalidErr
+	}
+
+	credential, err := a.Srv().Store().WebAuthnCredential().GetByCredentialId(response.Id)
+	if err != nil {
+		return invalidErr.Wrap(err)
+	}
+	if credential.UserId != user.Id {
+		return invalidErr
+	}
+
+	owner := &webAuthnUser{user: user, credentials: []*model.WebAuthnCredential{credential}}
+	validated, err := rp.ValidateLogin(owner, webAuthnSession(token, user.Id, requireUserVerification), parsed)
+	if err != nil {
+		return invalidErr.Wrap(err)
+	}
+	if validated.Authenticator.CloneWarning {
+		a.Log().Warn("The signature counter of a WebAuthn credential went backwards, the authenticator may have been cloned", mlog.String("user_id", user.Id), mlog.String("credential_id", credential.Id))
+		return invalidErr
+	}
+
+	if err = a.Srv().Store().WebAuthnCredential().UpdateSignCount(credential.Id, int64(validated.Authenticator.SignCount), model.GetMillis()); err != nil {
+		// A concurrent use of the credential already moved the counter forward
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return invalidErr.Wrap(err)
+		}
+		return model.NewAppError("verifyWebAuthnAssertion", "app.webauthn.update_credential.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// checkUserWebAuthn verifies the second factor of a user holding security keys, given as the
+// JSON encoded response of the authenticator.
+func (a *App) checkUserWebAuthn(user *model.User, token string) *model.AppError {
+	var response model.WebAuthnAssertionResponse
+	if err := json.Unmarshal([]byte(token), &response); err != nil {
+		return model.NewAppError("checkUserWebAuthn", "app.webauthn.invalid_assertion.app_error", nil, "", http.StatusUnauthorized).Wrap(err)
+	}
+
+	return a.verifyWebAuthnAssertion(user, &response, *a.Config().WebAuthnSettings.RequireUserVerification)
+}
+
+// AuthenticateUserForWebAuthnLogin returns the user owning the passkey that signed the response,
+// after checking the user is allowed to sign in. The authenticator must have verified the user,
+// the passkey replacing both the password and the second factor.
+func (a *App) AuthenticateUserForWebAuthnLogin(c *request.Context, response *model.WebAuthnAssertionResponse) (*model.User, *model.AppError) {
+	if !*a.Config().WebAuthnSettings.EnablePasskeyLogin {
+		return nil, model.NewAppError("AuthenticateUserForWebAuthnLogin", "app.webauthn.passkey_login_disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	invalidErr := model.NewAppError("AuthenticateUserForWebAuthnLogin", "app.webauthn.invalid_assertion.app_error", nil, "", http.StatusUnauthorized)
+	if response == nil || response.Id == "" {
+		return nil, invalidErr
+	}
+
+	credential, err := a.Srv().Store().WebAuthnCredential().GetByCredentialId(response.Id)
+	if err != nil {
+		return nil, invalidErr.Wrap(err)
+	}
+
+	// Authenticators return the user handle of discoverable credentials, which must be the one
+	// the credential was registered with
+	if response.Response.UserHandle != "" {
+		userHandle, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(response.Response.UserHandle, "="))
+		if err != nil || string(userHandle) != credential.UserId {
+			return nil, invalidErr
+		}
+	}
+
+	user, appErr := a.GetUser(credential.UserId)
+	if appErr != nil {
+		return nil, invalidErr.Wrap(appErr)
+	}
+
+	if appErr = a.CheckUserPreflightAuthenticationCriteria(user, ""); appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr = a.verifyWebAuthnAssertion(user, response, true); appErr != nil {
+		if err := a.Srv().Store().User().UpdateFailedPasswordAttempts(user.Id, user.FailedAttempts+1); err != nil {
+			c.Logger().Warn("Failed to update the failed login attempts", mlog.String("user_id", user.Id), mlog.Err(err))
+		}
+		a.InvalidateCacheForUser(user.Id)
+		return nil, appErr
+	}
+
+	if user.FailedAttempts > 0 {
+		if err := a.Srv().Store().User().UpdateFailedPasswordAttempts(user.Id, 0); err != nil {
+			return nil, model.NewAppError("AuthenticateUserForWebAuthnLogin", "app.user.update_failed_pwd_attempts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		a.InvalidateCacheForUser(user.Id)
+	}
+
+	if appErr = a.CheckUserPostflightAuthenticationCriteria(user); appErr != nil {
+		return nil, appErr
+	}
+
+	return user, nil
+}
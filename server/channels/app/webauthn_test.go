@@ -0,0 +1,303 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// testWebAuthnAuthenticator is a software authenticator holding a single P-256 credential.
+type testWebAuthnAuthenticator struct {
+	key       *ecdsa.PrivateKey
+	id        []byte
+	signCount uint32
+	rpID      string
+	origin    string
+}
+
+func newTestWebAuthnAuthenticator(t *testing.T, rpID, origin string) *testWebAuthnAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	id := make([]byte, 16)
+	_, err = rand.Read(id)
+	require.NoError(t, err)
+	return &testWebAuthnAuthenticator{key: key, id: id, rpID: rpID, origin: origin}
+}
+
+func (a *testWebAuthnAuthenticator) publicKey(t *testing.T) []byte {
+	b, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  1, // P-256
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(t, err)
+	return b
+}
+
+func (a *testWebAuthnAuthenticator) authData(t *testing.T, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+	b := append([]byte(nil), rpIDHash[:]...)
+	// User present and verified
+	flags := byte(0x05)
+	if attested {
+		flags |= 0x40
+	}
+	b = append(b, flags)
+	b = binary.BigEndian.AppendUint32(b, a.signCount)
+	if attested {
+		b = append(b, make([]byte, 16)...)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(a.id)))
+		b = append(b, a.id...)
+		b = append(b, a.publicKey(t)...)
+	}
+	return b
+}
+
+func (a *testWebAuthnAuthenticator) clientData(t *testing.T, clientDataType, challenge string) []byte {
+	b, err := json.Marshal(map[string]string{"type": clientDataType, "challenge": challenge, "origin": a.origin})
+	require.NoError(t, err)
+	return b
+}
+
+func (a *testWebAuthnAuthenticator) create(t *testing.T, options *model.WebAuthnCreationOptions) *model.WebAuthnRegistrationResponse {
+	attestationObject, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": a.authData(t, true),
+	})
+	require.NoError(t, err)
+
+	response := &model.WebAuthnRegistrationResponse{
+		Id:   base64.RawURLEncoding.EncodeToString(a.id),
+		Type: model.WebAuthnCredentialType,
+	}
+	response.Response.ClientDataJSON = base64.RawURLEncoding.EncodeToString(a.clientData(t, "webauthn.create", options.Challenge))
+	response.Response.AttestationObject = base64.RawURLEncoding.EncodeToString(attestationObject)
+	return response
+}
+
+func (a *testWebAuthnAuthenticator) get(t *testing.T, options *model.WebAuthnRequestOptions, userID string) *model.WebAuthnAssertionResponse {
+	a.signCount++
+	authData := a.authData(t, false)
+	clientDataJSON := a.clientData(t, "webauthn.get", options.Challenge)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	hash := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, hash[:])
+	require.NoError(t, err)
+
+	response := &model.WebAuthnAssertionResponse{
+		Id:   base64.RawURLEncoding.EncodeToString(a.id),
+		Type: model.WebAuthnCredentialType,
+	}
+	response.Response.ClientDataJSON = base64.RawURLEncoding.EncodeToString(clientDataJSON)
+	response.Response.AuthenticatorData = base64.RawURLEncoding.EncodeToString(authData)
+	response.Response.Signature = base64.RawURLEncoding.EncodeToString(signature)
+	response.Response.UserHandle = base64.RawURLEncoding.EncodeToString([]byte(userID))
+	return response
+}
+
+func TestWebAuthn(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.BasicUser
+
+	t.Run("disabled", func(t *testing.T) {
+		_, appErr := th.App.BeginWebAuthnRegistration(user)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.disabled.app_error", appErr.Id)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.SiteURL = "https://chat.example.com"
+		*cfg.WebAuthnSettings.Enable = true
+		*cfg.WebAuthnSettings.MaxCredentialsPerUser = 2
+	})
+
+	authenticator := newTestWebAuthnAuthenticator(t, "chat.example.com", "https://chat.example.com")
+
+	register := func(t *testing.T, authenticator *testWebAuthnAuthenticator, name string) (*model.WebAuthnCredential, *model.AppError) {
+		options, appErr := th.App.BeginWebAuthnRegistration(user)
+		require.Nil(t, appErr)
+		return th.App.FinishWebAuthnRegistration(user, &model.WebAuthnRegistrationRequest{
+			Name:       name,
+			Credential: authenticator.create(t, options),
+		})
+	}
+
+	secondFactor := func(t *testing.T, authenticator *testWebAuthnAuthenticator) string {
+		options, appErr := th.App.BeginWebAuthnLogin(user.Email)
+		require.Nil(t, appErr)
+		b, err := json.Marshal(authenticator.get(t, options, user.Id))
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	t.Run("register", func(t *testing.T) {
+		credential, appErr := register(t, authenticator, "Security key")
+		require.Nil(t, appErr)
+		assert.Equal(t, user.Id, credential.UserId)
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString(authenticator.id), credential.CredentialId)
+
+		credentials, appErr := th.App.GetWebAuthnCredentials(user.Id)
+		require.Nil(t, appErr)
+		require.Len(t, credentials, 1)
+
+		options, appErr := th.App.BeginWebAuthnRegistration(user)
+		require.Nil(t, appErr)
+		require.Len(t, options.ExcludeCredentials, 1)
+		assert.Equal(t, credential.CredentialId, options.ExcludeCredentials[0].Id)
+	})
+
+	t.Run("register the same key twice", func(t *testing.T) {
+		_, appErr := register(t, authenticator, "Again")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.credential_exists.app_error", appErr.Id)
+	})
+
+	t.Run("replayed registration", func(t *testing.T) {
+		other := newTestWebAuthnAuthenticator(t, "chat.example.com", "https://chat.example.com")
+		options, appErr := th.App.BeginWebAuthnRegistration(user)
+		require.Nil(t, appErr)
+		registration := &model.WebAuthnRegistrationRequest{Name: "Other", Credential: other.create(t, options)}
+
+		credential, appErr := th.App.FinishWebAuthnRegistration(user, registration)
+		require.Nil(t, appErr)
+		_, appErr = th.App.FinishWebAuthnRegistration(user, registration)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.invalid_challenge.app_error", appErr.Id)
+
+		require.Nil(t, th.App.DeleteWebAuthnCredential(user.Id, credential.Id))
+	})
+
+	t.Run("wrong origin", func(t *testing.T) {
+		other := newTestWebAuthnAuthenticator(t, "chat.example.com", "https://evil.example.com")
+		_, appErr := register(t, other, "Other")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.invalid_credential.app_error", appErr.Id)
+	})
+
+	t.Run("second factor", func(t *testing.T) {
+		appErr := th.App.CheckUserMfa(user, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.check_user_mfa.webauthn_required.app_error", appErr.Id)
+
+		token := secondFactor(t, authenticator)
+		require.Nil(t, th.App.CheckUserMfa(user, token))
+
+		// The challenge can't be answered twice
+		appErr = th.App.CheckUserMfa(user, token)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.invalid_challenge.app_error", appErr.Id)
+	})
+
+	t.Run("login ids without credentials", func(t *testing.T) {
+		options, appErr := th.App.BeginWebAuthnLogin("nobody@example.com")
+		require.Nil(t, appErr)
+		require.Len(t, options.AllowCredentials, 1)
+
+		again, appErr := th.App.BeginWebAuthnLogin("nobody@example.com")
+		require.Nil(t, appErr)
+		assert.Equal(t, options.AllowCredentials, again.AllowCredentials, "the credentials of a login id must not change between calls")
+		assert.NotEqual(t, options.Challenge, again.Challenge)
+
+		withoutKeys, appErr := th.App.BeginWebAuthnLogin(th.BasicUser2.Email)
+		require.Nil(t, appErr)
+		require.Len(t, withoutKeys.AllowCredentials, 1)
+		assert.NotEqual(t, options.AllowCredentials, withoutKeys.AllowCredentials)
+	})
+
+	t.Run("second factor of another user", func(t *testing.T) {
+		token := secondFactor(t, authenticator)
+		appErr := th.App.CheckUserMfa(th.BasicUser2, token)
+		assert.Nil(t, appErr, "users without keys aren't asked for a second factor")
+
+		otherAuthenticator := newTestWebAuthnAuthenticator(t, "chat.example.com", "https://chat.example.com")
+		options, appErr := th.App.BeginWebAuthnRegistration(th.BasicUser2)
+		require.Nil(t, appErr)
+		credential, appErr := th.App.FinishWebAuthnRegistration(th.BasicUser2, &model.WebAuthnRegistrationRequest{
+			Name:       "Key",
+			Credential: otherAuthenticator.create(t, options),
+		})
+		require.Nil(t, appErr)
+		defer th.App.DeleteWebAuthnCredential(th.BasicUser2.Id, credential.Id)
+
+		appErr = th.App.CheckUserMfa(th.BasicUser2, secondFactor(t, authenticator))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.invalid_assertion.app_error", appErr.Id)
+	})
+
+	t.Run("passkey login", func(t *testing.T) {
+		_, appErr := th.App.BeginWebAuthnLogin("")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.passkey_login_disabled.app_error", appErr.Id)
+
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.WebAuthnSettings.EnablePasskeyLogin = true
+		})
+
+		options, appErr := th.App.BeginWebAuthnLogin("")
+		require.Nil(t, appErr)
+		assert.Empty(t, options.AllowCredentials)
+		assert.Equal(t, model.WebAuthnUserVerificationRequired, options.UserVerification)
+
+		loggedIn, appErr := th.App.AuthenticateUserForWebAuthnLogin(th.Context, authenticator.get(t, options, user.Id))
+		require.Nil(t, appErr)
+		assert.Equal(t, user.Id, loggedIn.Id)
+
+		options, appErr = th.App.BeginWebAuthnLogin("")
+		require.Nil(t, appErr)
+		_, appErr = th.App.AuthenticateUserForWebAuthnLogin(th.Context, authenticator.get(t, options, th.BasicUser2.Id))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.invalid_assertion.app_error", appErr.Id)
+	})
+
+	t.Run("rename and delete", func(t *testing.T) {
+		credentials, appErr := th.App.GetWebAuthnCredentials(user.Id)
+		require.Nil(t, appErr)
+		require.Len(t, credentials, 1)
+
+		_, appErr = th.App.UpdateWebAuthnCredentialName(th.BasicUser2.Id, credentials[0].Id, "Mine")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.credential_not_found.app_error", appErr.Id)
+
+		credential, appErr := th.App.UpdateWebAuthnCredentialName(user.Id, credentials[0].Id, "Laptop")
+		require.Nil(t, appErr)
+		assert.Equal(t, "Laptop", credential.Name)
+
+		require.Nil(t, th.App.DeleteWebAuthnCredential(user.Id, credential.Id))
+		require.Nil(t, th.App.CheckUserMfa(user, ""))
+	})
+
+	t.Run("too many credentials", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.WebAuthnSettings.MaxCredentialsPerUser = 1
+		})
+
+		_, appErr := register(t, newTestWebAuthnAuthenticator(t, "chat.example.com", "https://chat.example.com"), "First")
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.BeginWebAuthnRegistration(user)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.too_many_credentials.app_error", appErr.Id)
+	})
+}
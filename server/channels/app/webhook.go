@@ -33,6 +33,10 @@ func (a *App) handleWebhookEvents(c request.CTX, post *model.Post, team *model.T
 		return nil
 	}
 
+	if channel.IsGroupOrDirect() {
+		return a.handleDirectWebhookEvents(c, post, channel, user)
+	}
+
 	if channel.Type != model.ChannelTypeOpen {
 		return nil
 	}
@@ -95,17 +99,17 @@ func (a *App) handleWebhookEvents(c request.CTX, post *model.Post, team *model.T
 }
 
 func (a *App) TriggerWebhook(c request.CTX, payload *model.OutgoingWebhookPayload, hook *model.OutgoingWebhook, post *model.Post, channel *model.Channel) {
-	var body io.Reader
+	var body []byte
 	var contentType string
 	if hook.ContentType == "application/json" {
 		js, err := json.Marshal(payload)
 		if err != nil {
 			c.Logger().Warn("Failed to encode to JSON", mlog.Err(err))
 		}
-		body = bytes.NewReader(js)
+		body = js
 		contentType = "application/json"
 	} else {
-		body = strings.NewReader(payload.ToFormValues())
+		body = []byte(payload.ToFormValues())
 		contentType = "application/x-www-form-urlencoded"
 	}
 
@@ -114,7 +118,7 @@ func (a *App) TriggerWebhook(c request.CTX, payload *model.OutgoingWebhookPayloa
 		url := hook.CallbackURLs[i]
 
 		a.Srv().Go(func() {
-			webhookResp, err := a.doOutgoingWebhookRequest(url, body, contentType)
+			webhookResp, err := a.doOutgoingWebhookRequest(hook.Id, url, body, contentType)
 			if err != nil {
 				c.Logger().Error("Event POST failed.", mlog.Err(err))
 				return
@@ -154,16 +158,17 @@ func (a *App) TriggerWebhook(c request.CTX, payload *model.OutgoingWebhookPayloa
 	}
 }
 
-func (a *App) doOutgoingWebhookRequest(url string, body io.Reader, contentType string) (*model.OutgoingWebhookResponse, error) {
-	req, err := http.NewRequest("POST", url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Content-Type", contentType)
-	req.Header.Set("Accept", "application/json")
+func (a *App) doOutgoingWebhookRequest(hookID string, url string, body []byte, contentType string) (*model.OutgoingWebhookResponse, error) {
+	resp, err := a.doIntegrationRequest(model.IntegrationTypeOutgoingWebhook, hookID, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
 
-	resp, err := a.HTTPService().MakeClient(false).Do(req)
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Accept", "application/json")
+		return req, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -374,6 +379,10 @@ func (a *App) UpdateIncomingWebhook(oldHook, updatedHook *model.IncomingWebhook)
 		return nil, model.NewAppError("UpdateIncomingWebhook", "api.incoming_webhook.invalid_username.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if appErr := updatedHook.IsValidMessageTemplate(); appErr != nil {
+		return nil, appErr
+	}
+
 	updatedHook.Id = oldHook.Id
 	updatedHook.UserId = oldHook.UserId
 	updatedHook.CreateAt = oldHook.CreateAt
@@ -657,6 +666,24 @@ func (a *App) RegenOutgoingWebhookToken(hook *model.OutgoingWebhook) (*model.Out
 	return webhook, nil
 }
 
+// DecodeIncomingWebhookPayload decodes the payload posted to an incoming webhook. The payload of a
+// hook with a message template can be any JSON document, rendered by the template into the text of
+// the post.
+func (a *App) DecodeIncomingWebhookPayload(hookID string, payload io.Reader) (*model.IncomingWebhookRequest, *model.AppError) {
+	// Unknown hooks are reported when handling the request.
+	hook, err := a.Srv().Store().Webhook().GetIncoming(hookID, true)
+	if err != nil || hook.MessageTemplate == "" {
+		return model.IncomingWebhookRequestFromJSON(payload)
+	}
+
+	req, err := hook.RenderMessageTemplate(payload)
+	if err != nil {
+		return nil, model.NewAppError("DecodeIncomingWebhookPayload", "web.incoming_webhook.message_template.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	return req, nil
+}
+
 func (a *App) HandleIncomingWebhook(c *request.Context, hookID string, req *model.IncomingWebhookRequest) *model.AppError {
 	if !*a.Config().ServiceSettings.EnableIncomingWebhooks {
 		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS PostTranslations (
+    PostId varchar(26) NOT NULL,
+    Language varchar(16) NOT NULL,
+    SourceLanguage varchar(16) DEFAULT '',
+    Message text,
+    Provider varchar(32) DEFAULT '',
+    PostEditAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT NULL,
+    PRIMARY KEY (PostId, Language)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
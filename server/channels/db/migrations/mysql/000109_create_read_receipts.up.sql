@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS ReadReceipts (
+    ChannelId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    PostId varchar(26) NOT NULL,
+    PostCreateAt bigint(20) NOT NULL,
+    ReadAt bigint(20) NOT NULL,
+    PRIMARY KEY (ChannelId, UserId),
+    KEY idx_readreceipts_user_id (UserId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
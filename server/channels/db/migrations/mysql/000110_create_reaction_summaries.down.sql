@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ReactionPostSummaries;
+DROP TABLE IF EXISTS ReactionEmojiSummaries;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS ReactionEmojiSummaries (
+    Day bigint(20) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    EmojiName varchar(64) NOT NULL,
+    Count bigint(20) NOT NULL,
+    PRIMARY KEY (Day, ChannelId, EmojiName),
+    KEY idx_reactionemojisummaries_team_id_day (TeamId, Day)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS ReactionPostSummaries (
+    Day bigint(20) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    PostId varchar(26) NOT NULL,
+    Count bigint(20) NOT NULL,
+    PRIMARY KEY (Day, ChannelId, PostId),
+    KEY idx_reactionpostsummaries_team_id_day (TeamId, Day)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
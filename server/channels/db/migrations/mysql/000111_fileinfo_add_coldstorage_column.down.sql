@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+	EXISTS (
+		SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE table_name = 'FileInfo'
+		AND table_schema = DATABASE()
+		AND column_name = 'ColdStorage'
+	),
+	'ALTER TABLE FileInfo DROP COLUMN ColdStorage;',
+	'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
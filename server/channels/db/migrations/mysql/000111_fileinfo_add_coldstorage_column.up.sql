@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+	NOT EXISTS(
+		SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE table_name = 'FileInfo'
+		AND table_schema = DATABASE()
+		AND column_name = 'ColdStorage'
+	),
+	'ALTER TABLE FileInfo ADD COLUMN ColdStorage boolean NOT NULL DEFAULT false;',
+	'SELECT 1'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS DirectOutgoingWebhooks (
+    Id varchar(26) NOT NULL,
+    Token varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    DeleteAt bigint(20) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    BotUserId varchar(26) NOT NULL,
+    CallbackURLs text,
+    DisplayName varchar(64) DEFAULT NULL,
+    Description varchar(500) DEFAULT NULL,
+    ContentType varchar(128) DEFAULT NULL,
+    PRIMARY KEY (Id),
+    KEY idx_directoutgoingwebhooks_bot_user_id (BotUserId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
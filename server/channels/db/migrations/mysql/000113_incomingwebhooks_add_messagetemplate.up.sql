@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+	NOT EXISTS(
+		SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE table_name = 'IncomingWebhooks'
+		AND table_schema = DATABASE()
+		AND column_name = 'MessageTemplate'
+	),
+	'ALTER TABLE IncomingWebhooks ADD COLUMN MessageTemplate varchar(4000) NOT NULL DEFAULT \'\';',
+	'SELECT 1'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS MatrixEvents;
+DROP TABLE IF EXISTS MatrixRooms;
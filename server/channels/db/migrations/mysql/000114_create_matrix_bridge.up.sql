@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS MatrixRooms (
+    ChannelId varchar(26) NOT NULL,
+    RoomId varchar(255) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    CreateAt bigint(20) DEFAULT NULL,
+    PRIMARY KEY (ChannelId),
+    UNIQUE KEY idx_matrixrooms_roomid (RoomId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS MatrixEvents (
+    PostId varchar(26) NOT NULL,
+    EventId varchar(255) NOT NULL,
+    RoomId varchar(255) NOT NULL,
+    CreateAt bigint(20) DEFAULT NULL,
+    PRIMARY KEY (PostId),
+    UNIQUE KEY idx_matrixevents_eventid (EventId),
+    KEY idx_matrixevents_roomid (RoomId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,29 @@
+SET @preparedStatement = (SELECT IF(
+	EXISTS (
+		SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE table_name = 'SharedChannels'
+		AND table_schema = DATABASE()
+		AND column_name = 'StripAttachments'
+	),
+	'ALTER TABLE SharedChannels DROP COLUMN StripAttachments;',
+	'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+	EXISTS (
+		SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE table_name = 'SharedChannels'
+		AND table_schema = DATABASE()
+		AND column_name = 'RedactPatterns'
+	),
+	'ALTER TABLE SharedChannels DROP COLUMN RedactPatterns;',
+	'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
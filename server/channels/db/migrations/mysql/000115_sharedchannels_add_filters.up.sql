@@ -0,0 +1,29 @@
+SET @preparedStatement = (SELECT IF(
+	NOT EXISTS(
+		SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE table_name = 'SharedChannels'
+		AND table_schema = DATABASE()
+		AND column_name = 'StripAttachments'
+	),
+	'ALTER TABLE SharedChannels ADD COLUMN StripAttachments boolean NOT NULL DEFAULT false;',
+	'SELECT 1'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+	NOT EXISTS(
+		SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE table_name = 'SharedChannels'
+		AND table_schema = DATABASE()
+		AND column_name = 'RedactPatterns'
+	),
+	'ALTER TABLE SharedChannels ADD COLUMN RedactPatterns text;',
+	'SELECT 1'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
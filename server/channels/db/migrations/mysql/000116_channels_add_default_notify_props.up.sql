@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+	NOT EXISTS(
+		SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE table_name = 'Channels'
+		AND table_schema = DATABASE()
+		AND column_name = 'DefaultNotifyProps'
+	),
+	'ALTER TABLE Channels ADD COLUMN DefaultNotifyProps JSON;',
+	'SELECT 1'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
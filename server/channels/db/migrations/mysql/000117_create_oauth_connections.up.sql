@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS OAuthConnections (
+    UserId varchar(26) NOT NULL,
+    Service varchar(32) NOT NULL,
+    AccessToken text NOT NULL,
+    RefreshToken text,
+    ExpiresAt bigint(20) DEFAULT NULL,
+    CreateAt bigint(20) DEFAULT NULL,
+    UpdateAt bigint(20) DEFAULT NULL,
+    PRIMARY KEY (UserId, Service)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS ChannelPinnedPosts (
+    ChannelId varchar(26) NOT NULL,
+    PostOrder JSON,
+    PinLimit int DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT NULL,
+    PRIMARY KEY (ChannelId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS ChannelBookmarks (
+    Id varchar(26) NOT NULL,
+    CreateAt bigint(20) DEFAULT NULL,
+    UpdateAt bigint(20) DEFAULT NULL,
+    DeleteAt bigint(20) DEFAULT 0,
+    ChannelId varchar(26) NOT NULL,
+    OwnerId varchar(26) NOT NULL,
+    FileId varchar(26) DEFAULT '',
+    DisplayName text,
+    SortOrder bigint(20) DEFAULT 0,
+    LinkUrl text,
+    Emoji varchar(64) DEFAULT '',
+    Type varchar(16) NOT NULL,
+    PRIMARY KEY (Id),
+    KEY idx_channelbookmarks_channelid_deleteat (ChannelId, DeleteAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Drafts'
+        AND table_schema = DATABASE()
+        AND column_name = 'Version'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE Drafts ADD COLUMN Version bigint NOT NULL DEFAULT 0;'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
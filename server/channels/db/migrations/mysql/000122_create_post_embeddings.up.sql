@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS PostEmbeddings (
+    PostId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    Model varchar(128) NOT NULL,
+    Embedding longtext NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    PRIMARY KEY (PostId),
+    KEY idx_postembeddings_channelid (ChannelId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS Activities (
+    Id varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    Type varchar(32) NOT NULL,
+    ActorId varchar(26) NOT NULL DEFAULT '',
+    TeamId varchar(26) NOT NULL DEFAULT '',
+    ChannelId varchar(26) NOT NULL DEFAULT '',
+    PostId varchar(26) NOT NULL DEFAULT '',
+    RootId varchar(26) NOT NULL DEFAULT '',
+    Props json,
+    CreateAt bigint(20) NOT NULL,
+    ReadAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_activities_userid_createat (UserId, CreateAt),
+    KEY idx_activities_userid_readat (UserId, ReadAt),
+    KEY idx_activities_postid (PostId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
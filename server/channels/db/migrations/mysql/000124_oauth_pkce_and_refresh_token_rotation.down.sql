@@ -0,0 +1,46 @@
+DROP TABLE IF EXISTS OAuthRotatedRefreshTokens;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OAuthAuthData'
+        AND table_schema = DATABASE()
+        AND column_name = 'CodeChallenge'
+    ) > 0,
+    'ALTER TABLE OAuthAuthData DROP COLUMN CodeChallenge;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OAuthAuthData'
+        AND table_schema = DATABASE()
+        AND column_name = 'CodeChallengeMethod'
+    ) > 0,
+    'ALTER TABLE OAuthAuthData DROP COLUMN CodeChallengeMethod;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OAuthAuthData'
+        AND table_schema = DATABASE()
+        AND column_name = 'Nonce'
+    ) > 0,
+    'ALTER TABLE OAuthAuthData DROP COLUMN Nonce;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
@@ -0,0 +1,54 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OAuthAuthData'
+        AND table_schema = DATABASE()
+        AND column_name = 'CodeChallenge'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE OAuthAuthData ADD COLUMN CodeChallenge varchar(128) NOT NULL DEFAULT "";'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OAuthAuthData'
+        AND table_schema = DATABASE()
+        AND column_name = 'CodeChallengeMethod'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE OAuthAuthData ADD COLUMN CodeChallengeMethod varchar(32) NOT NULL DEFAULT "";'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OAuthAuthData'
+        AND table_schema = DATABASE()
+        AND column_name = 'Nonce'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE OAuthAuthData ADD COLUMN Nonce varchar(256) NOT NULL DEFAULT "";'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+CREATE TABLE IF NOT EXISTS OAuthRotatedRefreshTokens (
+    RefreshToken varchar(26) NOT NULL,
+    ClientId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    RotatedAt bigint(20) NOT NULL,
+    PRIMARY KEY (RefreshToken),
+    KEY idx_oauthrotatedrefreshtokens_userid_clientid (UserId, ClientId),
+    KEY idx_oauthrotatedrefreshtokens_clientid (ClientId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Roles'
+        AND table_schema = DATABASE()
+        AND column_name = 'TeamIds'
+    ) > 0,
+    'ALTER TABLE Roles DROP COLUMN TeamIds;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
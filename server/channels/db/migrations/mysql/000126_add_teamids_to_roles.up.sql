@@ -0,0 +1,16 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Roles'
+        AND table_schema = DATABASE()
+        AND column_name = 'TeamIds'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE Roles ADD COLUMN TeamIds text;'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+UPDATE Roles SET TeamIds = '' WHERE TeamIds IS NULL;
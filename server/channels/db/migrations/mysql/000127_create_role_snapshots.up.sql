@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS RoleSnapshots (
+    Id varchar(26) NOT NULL,
+    RoleId varchar(26) NOT NULL,
+    Permissions text NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    PRIMARY KEY (Id),
+    KEY idx_rolesnapshots_roleid_createat (RoleId, CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
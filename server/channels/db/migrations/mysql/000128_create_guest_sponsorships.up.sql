@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS GuestSponsorships (
+    UserId varchar(26) NOT NULL,
+    SponsorId varchar(26) NOT NULL,
+    ExpiresAt bigint(20) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    PRIMARY KEY (UserId),
+    KEY idx_guestsponsorships_sponsorid (SponsorId),
+    KEY idx_guestsponsorships_expiresat (ExpiresAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
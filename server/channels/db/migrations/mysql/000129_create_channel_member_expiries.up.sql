@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS ChannelMemberExpiries (
+    ChannelId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    ExpiresAt bigint(20) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    PRIMARY KEY (ChannelId, UserId),
+    KEY idx_channelmemberexpiries_userid (UserId),
+    KEY idx_channelmemberexpiries_expiresat (ExpiresAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS ChannelJoinRequests (
+    Id varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    Message text,
+    Status varchar(16) NOT NULL,
+    DeciderId varchar(26) NOT NULL,
+    PostId varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    PRIMARY KEY (Id),
+    KEY idx_channeljoinrequests_channelid_status (ChannelId, Status),
+    KEY idx_channeljoinrequests_userid (UserId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS TeamTemplates (
+    Id varchar(26) NOT NULL,
+    DisplayName varchar(64) NOT NULL,
+    Description text,
+    CreatorId varchar(26) NOT NULL,
+    Content JSON,
+    CreateAt bigint(20) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    DeleteAt bigint(20) NOT NULL,
+    PRIMARY KEY (Id),
+    KEY idx_teamtemplates_deleteat (DeleteAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
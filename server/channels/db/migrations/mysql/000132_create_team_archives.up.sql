@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS TeamArchives (
+    TeamId varchar(26) NOT NULL,
+    ArchiverId varchar(26) NOT NULL,
+    Status varchar(32) NOT NULL,
+    JobId varchar(26),
+    SuspendedJobIds text,
+    ArchivedAt bigint(20) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    PRIMARY KEY (TeamId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
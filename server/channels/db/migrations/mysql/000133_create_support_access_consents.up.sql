@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS SupportAccessConsents (
+    Id varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    AdminId varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    ExpiresAt bigint(20) NOT NULL,
+    RevokedAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_supportaccessconsents_userid_adminid (UserId, AdminId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
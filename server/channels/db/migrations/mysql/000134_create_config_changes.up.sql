@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS ConfigChanges (
+    Id varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    RollbackToId varchar(26) NOT NULL DEFAULT '',
+    Diffs mediumtext NOT NULL,
+    Config mediumtext NOT NULL,
+    PRIMARY KEY (Id),
+    KEY idx_configchanges_createat (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
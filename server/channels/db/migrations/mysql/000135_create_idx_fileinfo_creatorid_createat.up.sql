@@ -0,0 +1,15 @@
+-- online: FileInfo
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'FileInfo'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_fileinfo_creatorid_createat'
+    ) > 0,
+    'SELECT 1;',
+    'CREATE INDEX idx_fileinfo_creatorid_createat ON FileInfo(CreatorId, CreateAt) ALGORITHM=INPLACE LOCK=NONE;'
+));
+
+PREPARE createIndexIfNotExists FROM @preparedStatement;
+EXECUTE createIndexIfNotExists;
+DEALLOCATE PREPARE createIndexIfNotExists;
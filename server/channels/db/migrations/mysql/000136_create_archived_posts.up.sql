@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS ArchivedPosts (
+    PostId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    RootId varchar(26) NOT NULL DEFAULT '',
+    CreateAt bigint(20) NOT NULL,
+    DeleteAt bigint(20) NOT NULL DEFAULT 0,
+    Segment varchar(512) NOT NULL,
+    PRIMARY KEY (PostId),
+    KEY idx_archivedposts_channelid_createat (ChannelId, CreateAt),
+    KEY idx_archivedposts_rootid (RootId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS ChannelCounters (
+    ChannelId varchar(26) NOT NULL,
+    MemberCount bigint(20) NOT NULL DEFAULT 0,
+    GuestCount bigint(20) NOT NULL DEFAULT 0,
+    PinnedPostCount bigint(20) NOT NULL DEFAULT 0,
+    UpdateAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (ChannelId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
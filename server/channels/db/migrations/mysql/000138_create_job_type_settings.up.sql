@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS JobTypeSettings (
+    JobType varchar(32) NOT NULL,
+    Priority bigint(20) NOT NULL DEFAULT 0,
+    MaxConcurrency int(11) NOT NULL DEFAULT 0,
+    Paused tinyint(1) NOT NULL DEFAULT 0,
+    Schedule varchar(128) NOT NULL DEFAULT '',
+    UpdateAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (JobType)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS scheduledposts (
+    id VARCHAR(26) PRIMARY KEY,
+    createat bigint,
+    updateat bigint,
+    userid VARCHAR(26) NOT NULL,
+    channelid VARCHAR(26) NOT NULL,
+    rootid VARCHAR(26) DEFAULT '',
+    message VARCHAR(65535),
+    props VARCHAR(8000),
+    fileids VARCHAR(300),
+    scheduledat bigint NOT NULL,
+    processedat bigint DEFAULT 0,
+    errorcode VARCHAR(64) DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduledposts_userid_scheduledat ON scheduledposts(userid, scheduledat);
+CREATE INDEX IF NOT EXISTS idx_scheduledposts_processedat_scheduledat ON scheduledposts(processedat, scheduledat);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS posttranslations (
+    postid VARCHAR(26) NOT NULL,
+    language VARCHAR(16) NOT NULL,
+    sourcelanguage VARCHAR(16) DEFAULT '',
+    message VARCHAR(65535),
+    provider VARCHAR(32) DEFAULT '',
+    posteditat bigint DEFAULT 0,
+    createat bigint,
+    PRIMARY KEY (postid, language)
+);
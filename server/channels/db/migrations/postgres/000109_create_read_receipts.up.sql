@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS readreceipts (
+    channelid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    postid VARCHAR(26) NOT NULL,
+    postcreateat bigint NOT NULL,
+    readat bigint NOT NULL,
+    PRIMARY KEY (channelid, userid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_readreceipts_user_id ON readreceipts(userid);
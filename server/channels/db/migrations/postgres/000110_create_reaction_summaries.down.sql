@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS reactionpostsummaries;
+DROP TABLE IF EXISTS reactionemojisummaries;
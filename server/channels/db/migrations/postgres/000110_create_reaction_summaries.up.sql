@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS reactionemojisummaries (
+    day bigint NOT NULL,
+    teamid VARCHAR(26) NOT NULL,
+    channelid VARCHAR(26) NOT NULL,
+    emojiname VARCHAR(64) NOT NULL,
+    count bigint NOT NULL,
+    PRIMARY KEY (day, channelid, emojiname)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reactionemojisummaries_team_id_day ON reactionemojisummaries(teamid, day);
+
+CREATE TABLE IF NOT EXISTS reactionpostsummaries (
+    day bigint NOT NULL,
+    teamid VARCHAR(26) NOT NULL,
+    channelid VARCHAR(26) NOT NULL,
+    postid VARCHAR(26) NOT NULL,
+    count bigint NOT NULL,
+    PRIMARY KEY (day, channelid, postid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reactionpostsummaries_team_id_day ON reactionpostsummaries(teamid, day);
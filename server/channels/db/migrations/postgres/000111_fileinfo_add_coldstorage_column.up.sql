@@ -0,0 +1 @@
+ALTER TABLE fileinfo ADD COLUMN IF NOT EXISTS coldstorage boolean NOT NULL DEFAULT false;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS directoutgoingwebhooks (
+    id VARCHAR(26) PRIMARY KEY,
+    token VARCHAR(26) NOT NULL,
+    createat bigint NOT NULL,
+    updateat bigint NOT NULL,
+    deleteat bigint NOT NULL,
+    creatorid VARCHAR(26) NOT NULL,
+    botuserid VARCHAR(26) NOT NULL,
+    callbackurls VARCHAR(1024),
+    displayname VARCHAR(64),
+    description VARCHAR(500),
+    contenttype VARCHAR(128)
+);
+
+CREATE INDEX IF NOT EXISTS idx_directoutgoingwebhooks_bot_user_id ON directoutgoingwebhooks (botuserid);
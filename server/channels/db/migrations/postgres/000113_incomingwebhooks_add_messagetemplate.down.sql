@@ -0,0 +1 @@
+ALTER TABLE incomingwebhooks DROP COLUMN IF EXISTS messagetemplate;
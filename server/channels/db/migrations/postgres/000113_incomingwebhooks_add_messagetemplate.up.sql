@@ -0,0 +1 @@
+ALTER TABLE incomingwebhooks ADD COLUMN IF NOT EXISTS messagetemplate varchar(4000) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS matrixevents;
+DROP TABLE IF EXISTS matrixrooms;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS matrixrooms (
+    channelid VARCHAR(26) PRIMARY KEY,
+    roomid VARCHAR(255) NOT NULL,
+    creatorid VARCHAR(26) NOT NULL,
+    createat bigint,
+    CONSTRAINT matrixrooms_roomid_key UNIQUE (roomid)
+);
+
+CREATE TABLE IF NOT EXISTS matrixevents (
+    postid VARCHAR(26) PRIMARY KEY,
+    eventid VARCHAR(255) NOT NULL,
+    roomid VARCHAR(255) NOT NULL,
+    createat bigint,
+    CONSTRAINT matrixevents_eventid_key UNIQUE (eventid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_matrixevents_roomid ON matrixevents (roomid);
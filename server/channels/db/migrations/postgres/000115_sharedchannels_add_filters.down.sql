@@ -0,0 +1,2 @@
+ALTER TABLE sharedchannels DROP COLUMN IF EXISTS redactpatterns;
+ALTER TABLE sharedchannels DROP COLUMN IF EXISTS stripattachments;
@@ -0,0 +1,2 @@
+ALTER TABLE sharedchannels ADD COLUMN IF NOT EXISTS stripattachments boolean NOT NULL DEFAULT false;
+ALTER TABLE sharedchannels ADD COLUMN IF NOT EXISTS redactpatterns text;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS oauthconnections (
+    userid VARCHAR(26) NOT NULL,
+    service VARCHAR(32) NOT NULL,
+    accesstoken VARCHAR(4096) NOT NULL,
+    refreshtoken VARCHAR(4096),
+    expiresat bigint,
+    createat bigint,
+    updateat bigint,
+    PRIMARY KEY (userid, service)
+);
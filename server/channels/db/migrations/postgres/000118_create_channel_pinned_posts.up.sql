@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS channelpinnedposts (
+    channelid VARCHAR(26) PRIMARY KEY,
+    postorder jsonb,
+    pinlimit integer DEFAULT 0,
+    updateat bigint
+);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS channelbookmarks (
+    id VARCHAR(26) PRIMARY KEY,
+    createat bigint,
+    updateat bigint,
+    deleteat bigint DEFAULT 0,
+    channelid VARCHAR(26) NOT NULL,
+    ownerid VARCHAR(26) NOT NULL,
+    fileid VARCHAR(26) DEFAULT '',
+    displayname VARCHAR(256),
+    sortorder bigint DEFAULT 0,
+    linkurl VARCHAR(1024),
+    emoji VARCHAR(64) DEFAULT '',
+    type VARCHAR(16) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_channelbookmarks_channelid_deleteat ON channelbookmarks(channelid, deleteat);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS postexpirations (
+    postid VARCHAR(26) PRIMARY KEY,
+    channelid VARCHAR(26) NOT NULL,
+    expireat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_postexpirations_expireat ON postexpirations(expireat);
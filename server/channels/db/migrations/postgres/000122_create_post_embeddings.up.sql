@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS postembeddings (
+    postid VARCHAR(26) PRIMARY KEY,
+    channelid VARCHAR(26) NOT NULL,
+    model VARCHAR(128) NOT NULL,
+    embedding text NOT NULL,
+    createat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_postembeddings_channelid ON postembeddings(channelid);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS activities (
+    id VARCHAR(26) PRIMARY KEY,
+    userid VARCHAR(26) NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    actorid VARCHAR(26) NOT NULL DEFAULT '',
+    teamid VARCHAR(26) NOT NULL DEFAULT '',
+    channelid VARCHAR(26) NOT NULL DEFAULT '',
+    postid VARCHAR(26) NOT NULL DEFAULT '',
+    rootid VARCHAR(26) NOT NULL DEFAULT '',
+    props jsonb,
+    createat bigint NOT NULL,
+    readat bigint NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_activities_userid_createat ON activities(userid, createat);
+CREATE INDEX IF NOT EXISTS idx_activities_userid_readat ON activities(userid, readat);
+CREATE INDEX IF NOT EXISTS idx_activities_postid ON activities(postid);
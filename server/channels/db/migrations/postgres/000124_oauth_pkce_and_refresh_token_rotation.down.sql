@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS oauthrotatedrefreshtokens;
+
+ALTER TABLE oauthauthdata DROP COLUMN IF EXISTS codechallenge;
+ALTER TABLE oauthauthdata DROP COLUMN IF EXISTS codechallengemethod;
+ALTER TABLE oauthauthdata DROP COLUMN IF EXISTS nonce;
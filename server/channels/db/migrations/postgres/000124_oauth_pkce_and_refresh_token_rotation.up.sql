@@ -0,0 +1,13 @@
+ALTER TABLE oauthauthdata ADD COLUMN IF NOT EXISTS codechallenge varchar(128) NOT NULL DEFAULT '';
+ALTER TABLE oauthauthdata ADD COLUMN IF NOT EXISTS codechallengemethod varchar(32) NOT NULL DEFAULT '';
+ALTER TABLE oauthauthdata ADD COLUMN IF NOT EXISTS nonce varchar(256) NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS oauthrotatedrefreshtokens (
+    refreshtoken VARCHAR(26) PRIMARY KEY,
+    clientid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    rotatedat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_oauthrotatedrefreshtokens_userid_clientid ON oauthrotatedrefreshtokens(userid, clientid);
+CREATE INDEX IF NOT EXISTS idx_oauthrotatedrefreshtokens_clientid ON oauthrotatedrefreshtokens(clientid);
@@ -0,0 +1 @@
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS teamids text NOT NULL DEFAULT '';
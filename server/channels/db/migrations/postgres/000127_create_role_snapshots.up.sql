@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS rolesnapshots (
+    id VARCHAR(26) PRIMARY KEY,
+    roleid VARCHAR(26) NOT NULL,
+    permissions text NOT NULL,
+    createat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rolesnapshots_roleid_createat ON rolesnapshots(roleid, createat);
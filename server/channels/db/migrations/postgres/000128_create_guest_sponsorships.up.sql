@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS guestsponsorships (
+    userid VARCHAR(26) PRIMARY KEY,
+    sponsorid VARCHAR(26) NOT NULL,
+    expiresat bigint NOT NULL,
+    createat bigint NOT NULL,
+    updateat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_guestsponsorships_sponsorid ON guestsponsorships(sponsorid);
+CREATE INDEX IF NOT EXISTS idx_guestsponsorships_expiresat ON guestsponsorships(expiresat);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS channelmemberexpiries (
+    channelid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    expiresat bigint NOT NULL,
+    creatorid VARCHAR(26) NOT NULL,
+    createat bigint NOT NULL,
+    PRIMARY KEY (channelid, userid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_channelmemberexpiries_userid ON channelmemberexpiries(userid);
+CREATE INDEX IF NOT EXISTS idx_channelmemberexpiries_expiresat ON channelmemberexpiries(expiresat);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS channeljoinrequests (
+    id VARCHAR(26) PRIMARY KEY,
+    channelid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    message VARCHAR(1024),
+    status VARCHAR(16) NOT NULL,
+    deciderid VARCHAR(26) NOT NULL,
+    postid VARCHAR(26) NOT NULL,
+    createat bigint NOT NULL,
+    updateat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_channeljoinrequests_channelid_status ON channeljoinrequests(channelid, status);
+CREATE INDEX IF NOT EXISTS idx_channeljoinrequests_userid ON channeljoinrequests(userid);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS teamtemplates (
+    id VARCHAR(26) PRIMARY KEY,
+    displayname VARCHAR(64) NOT NULL,
+    description VARCHAR(1024),
+    creatorid VARCHAR(26) NOT NULL,
+    content jsonb,
+    createat bigint NOT NULL,
+    updateat bigint NOT NULL,
+    deleteat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_teamtemplates_deleteat ON teamtemplates(deleteat);
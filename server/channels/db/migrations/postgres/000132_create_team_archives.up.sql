@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS teamarchives (
+    teamid VARCHAR(26) PRIMARY KEY,
+    archiverid VARCHAR(26) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    jobid VARCHAR(26),
+    suspendedjobids text,
+    archivedat bigint NOT NULL,
+    updateat bigint NOT NULL
+);
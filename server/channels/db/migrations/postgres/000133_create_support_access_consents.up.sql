@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS supportaccessconsents (
+    id VARCHAR(26) PRIMARY KEY,
+    userid VARCHAR(26) NOT NULL,
+    adminid VARCHAR(26) NOT NULL,
+    createat bigint NOT NULL,
+    expiresat bigint NOT NULL,
+    revokedat bigint NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_supportaccessconsents_userid_adminid ON supportaccessconsents(userid, adminid);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS configchanges (
+    id VARCHAR(26) PRIMARY KEY,
+    userid VARCHAR(26) NOT NULL,
+    createat bigint NOT NULL,
+    rollbacktoid VARCHAR(26) NOT NULL DEFAULT '',
+    diffs text NOT NULL,
+    config text NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_configchanges_createat ON configchanges(createat);
@@ -0,0 +1,2 @@
+-- online: FileInfo
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_fileinfo_creatorid_createat ON fileinfo(creatorid, createat);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS archivedposts (
+    postid VARCHAR(26) PRIMARY KEY,
+    channelid VARCHAR(26) NOT NULL,
+    rootid VARCHAR(26) NOT NULL DEFAULT '',
+    createat bigint NOT NULL,
+    deleteat bigint NOT NULL DEFAULT 0,
+    segment VARCHAR(512) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_archivedposts_channelid_createat ON archivedposts(channelid, createat);
+CREATE INDEX IF NOT EXISTS idx_archivedposts_rootid ON archivedposts(rootid);
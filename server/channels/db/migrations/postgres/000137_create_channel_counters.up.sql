@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS channelcounters (
+    channelid VARCHAR(26) PRIMARY KEY,
+    membercount bigint NOT NULL DEFAULT 0,
+    guestcount bigint NOT NULL DEFAULT 0,
+    pinnedpostcount bigint NOT NULL DEFAULT 0,
+    updateat bigint NOT NULL DEFAULT 0
+);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS jobtypesettings (
+    jobtype VARCHAR(32) PRIMARY KEY,
+    priority bigint NOT NULL DEFAULT 0,
+    maxconcurrency integer NOT NULL DEFAULT 0,
+    paused boolean NOT NULL DEFAULT false,
+    schedule VARCHAR(128) NOT NULL DEFAULT '',
+    updateat bigint NOT NULL DEFAULT 0
+);
@@ -0,0 +1,31 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package einterfaces
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// CalendarProvider reads the free/busy data of a user's calendar from an external service.
+type CalendarProvider interface {
+	// GetBusyPeriods returns the periods between start and end, in milliseconds, during which the
+	// user is busy. The access token of the connection is valid.
+	GetBusyPeriods(ctx context.Context, connection *model.OAuthConnection, start, end int64) ([]*model.CalendarBusyPeriod, error)
+}
+
+var calendarProviders = make(map[string]CalendarProvider)
+
+func RegisterCalendarProvider(service string, newProvider CalendarProvider) {
+	calendarProviders[service] = newProvider
+}
+
+func GetCalendarProvider(service string) CalendarProvider {
+	provider, ok := calendarProviders[service]
+	if ok {
+		return provider
+	}
+	return nil
+}
@@ -11,6 +11,12 @@ type ElasticsearchIndexerInterface interface {
 	MakeWorker() model.Worker
 }
 
+// ElasticsearchIndexMigrationInterface migrates the documents of the existing indexes into new
+// indexes, e.g. when moving to OpenSearch or to indexes managed by a lifecycle policy.
+type ElasticsearchIndexMigrationInterface interface {
+	MakeWorker() model.Worker
+}
+
 type ElasticsearchAggregatorInterface interface {
 	MakeWorker() model.Worker
 	MakeScheduler() model.Scheduler
@@ -18,6 +18,8 @@ type MetricsInterface interface {
 	IncrementWebhookPost()
 	IncrementPostSentEmail()
 	IncrementPostSentPush()
+	IncrementPushNotificationDeliveryCounter(platform, status string)
+	ObservePushNotificationDeliveryDuration(platform string, elapsed float64)
 	IncrementPostBroadcast()
 	IncrementPostFileAttachment(count int)
 
@@ -27,6 +29,9 @@ type MetricsInterface interface {
 	IncrementClusterRequest()
 	ObserveClusterRequestDuration(elapsed float64)
 	IncrementClusterEventType(eventType model.ClusterEvent)
+	AddClusterMessageBytesSent(eventType model.ClusterEvent, bytes int)
+	AddClusterMessageBytesReceived(eventType model.ClusterEvent, bytes int)
+	AddClusterMessageBytesSaved(eventType model.ClusterEvent, bytes int)
 
 	IncrementLogin()
 	IncrementLoginFail()
@@ -41,6 +46,10 @@ type MetricsInterface interface {
 	IncrementMemCacheHitCounterSession()
 	IncrementMemCacheInvalidationCounterSession()
 
+	IncrementCacheHitCounter(cacheName string)
+	IncrementCacheMissCounter(cacheName string)
+	IncrementCacheEvictionCounter(cacheName string)
+
 	IncrementWebsocketEvent(eventType string)
 	IncrementWebSocketBroadcast(eventType string)
 	IncrementWebSocketBroadcastBufferSize(hub string, amount float64)
@@ -56,7 +65,10 @@ type MetricsInterface interface {
 	ObservePostsSearchDuration(elapsed float64)
 	IncrementFilesSearchCounter()
 	ObserveFilesSearchDuration(elapsed float64)
+	ObserveSearchEngineQueryDuration(engine, queryType string, success bool, elapsed float64)
 	ObserveStoreMethodDuration(method, success string, elapsed float64)
+	IncrementStoreQueryCancelledCounter(reason string)
+	ObserveStoreSlowQueryDuration(label string, elapsed float64)
 	ObserveAPIEndpointDuration(endpoint, method, statusCode string, elapsed float64)
 	IncrementPostIndexCounter()
 	IncrementFileIndexCounter()
@@ -78,6 +90,9 @@ type MetricsInterface interface {
 	ObserveRemoteClusterClockSkew(remoteID string, skew float64)
 	IncrementRemoteClusterConnStateChangeCounter(remoteID string, online bool)
 
+	ObserveSharedChannelsSyncLag(remoteID string, elapsed float64)
+	IncrementSharedChannelsSyncConflictCounter(remoteID string)
+
 	IncrementJobActive(jobType string)
 	DecrementJobActive(jobType string)
 
@@ -18,6 +18,21 @@ type MetricsInterface struct {
 	mock.Mock
 }
 
+// AddClusterMessageBytesReceived provides a mock function with given fields: eventType, bytes
+func (_m *MetricsInterface) AddClusterMessageBytesReceived(eventType model.ClusterEvent, bytes int) {
+	_m.Called(eventType, bytes)
+}
+
+// AddClusterMessageBytesSaved provides a mock function with given fields: eventType, bytes
+func (_m *MetricsInterface) AddClusterMessageBytesSaved(eventType model.ClusterEvent, bytes int) {
+	_m.Called(eventType, bytes)
+}
+
+// AddClusterMessageBytesSent provides a mock function with given fields: eventType, bytes
+func (_m *MetricsInterface) AddClusterMessageBytesSent(eventType model.ClusterEvent, bytes int) {
+	_m.Called(eventType, bytes)
+}
+
 // AddMemCacheHitCounter provides a mock function with given fields: cacheName, amount
 func (_m *MetricsInterface) AddMemCacheHitCounter(cacheName string, amount float64) {
 	_m.Called(cacheName, amount)
@@ -59,6 +74,21 @@ func (_m *MetricsInterface) GetLoggerMetricsCollector() logr.MetricsCollector {
 	return r0
 }
 
+// IncrementCacheEvictionCounter provides a mock function with given fields: cacheName
+func (_m *MetricsInterface) IncrementCacheEvictionCounter(cacheName string) {
+	_m.Called(cacheName)
+}
+
+// IncrementCacheHitCounter provides a mock function with given fields: cacheName
+func (_m *MetricsInterface) IncrementCacheHitCounter(cacheName string) {
+	_m.Called(cacheName)
+}
+
+// IncrementCacheMissCounter provides a mock function with given fields: cacheName
+func (_m *MetricsInterface) IncrementCacheMissCounter(cacheName string) {
+	_m.Called(cacheName)
+}
+
 // IncrementChannelIndexCounter provides a mock function with given fields:
 func (_m *MetricsInterface) IncrementChannelIndexCounter() {
 	_m.Called()
@@ -184,6 +214,11 @@ func (_m *MetricsInterface) IncrementPostsSearchCounter() {
 	_m.Called()
 }
 
+// IncrementPushNotificationDeliveryCounter provides a mock function with given fields: platform, status
+func (_m *MetricsInterface) IncrementPushNotificationDeliveryCounter(platform string, status string) {
+	_m.Called(platform, status)
+}
+
 // IncrementRemoteClusterConnStateChangeCounter provides a mock function with given fields: remoteID, online
 func (_m *MetricsInterface) IncrementRemoteClusterConnStateChangeCounter(remoteID string, online bool) {
 	_m.Called(remoteID, online)
@@ -204,6 +239,16 @@ func (_m *MetricsInterface) IncrementRemoteClusterMsgSentCounter(remoteID string
 	_m.Called(remoteID)
 }
 
+// IncrementSharedChannelsSyncConflictCounter provides a mock function with given fields: remoteID
+func (_m *MetricsInterface) IncrementSharedChannelsSyncConflictCounter(remoteID string) {
+	_m.Called(remoteID)
+}
+
+// IncrementStoreQueryCancelledCounter provides a mock function with given fields: reason
+func (_m *MetricsInterface) IncrementStoreQueryCancelledCounter(reason string) {
+	_m.Called(reason)
+}
+
 // IncrementUserIndexCounter provides a mock function with given fields:
 func (_m *MetricsInterface) IncrementUserIndexCounter() {
 	_m.Called()
@@ -284,6 +329,11 @@ func (_m *MetricsInterface) ObservePostsSearchDuration(elapsed float64) {
 	_m.Called(elapsed)
 }
 
+// ObservePushNotificationDeliveryDuration provides a mock function with given fields: platform, elapsed
+func (_m *MetricsInterface) ObservePushNotificationDeliveryDuration(platform string, elapsed float64) {
+	_m.Called(platform, elapsed)
+}
+
 // ObserveRemoteClusterClockSkew provides a mock function with given fields: remoteID, skew
 func (_m *MetricsInterface) ObserveRemoteClusterClockSkew(remoteID string, skew float64) {
 	_m.Called(remoteID, skew)
@@ -294,11 +344,26 @@ func (_m *MetricsInterface) ObserveRemoteClusterPingDuration(remoteID string, el
 	_m.Called(remoteID, elapsed)
 }
 
+// ObserveSharedChannelsSyncLag provides a mock function with given fields: remoteID, elapsed
+func (_m *MetricsInterface) ObserveSharedChannelsSyncLag(remoteID string, elapsed float64) {
+	_m.Called(remoteID, elapsed)
+}
+
+// ObserveSearchEngineQueryDuration provides a mock function with given fields: engine, queryType, success, elapsed
+func (_m *MetricsInterface) ObserveSearchEngineQueryDuration(engine string, queryType string, success bool, elapsed float64) {
+	_m.Called(engine, queryType, success, elapsed)
+}
+
 // ObserveStoreMethodDuration provides a mock function with given fields: method, success, elapsed
 func (_m *MetricsInterface) ObserveStoreMethodDuration(method string, success string, elapsed float64) {
 	_m.Called(method, success, elapsed)
 }
 
+// ObserveStoreSlowQueryDuration provides a mock function with given fields: label, elapsed
+func (_m *MetricsInterface) ObserveStoreSlowQueryDuration(label string, elapsed float64) {
+	_m.Called(label, elapsed)
+}
+
 // Register provides a mock function with given fields:
 func (_m *MetricsInterface) Register() {
 	_m.Called()
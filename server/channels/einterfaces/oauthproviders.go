@@ -16,6 +16,22 @@ type OAuthProvider interface {
 	IsSameUser(dbUser, oAuthUser *model.User) bool
 }
 
+// OAuthGroupsProvider is implemented by the OAuth providers able to tell the groups of the users
+// signing in, for their memberships to be synchronized.
+type OAuthGroupsProvider interface {
+	// GetGroupsFromJSON returns the names of the groups listed in the user data, or nil when groups
+	// aren't synchronized.
+	GetGroupsFromJSON(data io.Reader) ([]string, error)
+}
+
+// OAuthNonceProvider is implemented by the OAuth providers checking the nonce of the ID tokens, for
+// an ID token to only be accepted for the authorization request it was issued for.
+type OAuthNonceProvider interface {
+	// GetUserFromIdTokenWithNonce is GetUserFromIdToken, the nonce claim of the ID token having to be
+	// the nonce sent along the authorization request.
+	GetUserFromIdTokenWithNonce(idToken, nonce string) (*model.User, error)
+}
+
 var oauthProviders = make(map[string]OAuthProvider)
 
 func RegisterOAuthProvider(name string, newProvider OAuthProvider) {
@@ -0,0 +1,18 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package einterfaces
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// VectorStoreInterface stores the embeddings of the posts in an external vector database, used by
+// semantic search when SemanticSearchSettings.VectorStore is external.
+type VectorStoreInterface interface {
+	SaveEmbeddings(embeddings []*model.PostEmbedding) *model.AppError
+	// SearchEmbeddings returns the posts of the channels whose embeddings, computed by
+	// embeddingModel, are the most similar to embedding, the most similar first.
+	SearchEmbeddings(embedding []float32, embeddingModel string, channelIDs []string, limit int) ([]*model.PostEmbeddingMatch, *model.AppError)
+	DeleteEmbeddings(postIDs []string) *model.AppError
+}
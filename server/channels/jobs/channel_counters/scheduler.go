@@ -0,0 +1,20 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package channel_counters
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+)
+
+const schedFreq = 24 * time.Hour
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(_ *model.Config) bool {
+		return true
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeChannelCounters, schedFreq, isEnabled)
+}
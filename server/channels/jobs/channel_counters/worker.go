@@ -0,0 +1,49 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package channel_counters
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const (
+	jobName   = "ChannelCounters"
+	batchSize = 500
+)
+
+// MakeWorker returns a worker that reconciles the counters of the channels with the counts of
+// their members and pinned posts, fixing the drift of the changes not maintaining them, and
+// creating the counters of the channels created before they were maintained. The last channel
+// reconciled is kept in the job's data, for an interrupted job to resume from it.
+func MakeWorker(jobServer *jobs.JobServer, store store.Store) model.Worker {
+	isEnabled := func(_ *model.Config) bool {
+		return true
+	}
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		afterChannelID := job.Data["last_channel_id"]
+		for {
+			lastChannelID, err := store.Channel().ReconcileCounters(afterChannelID, batchSize)
+			if err != nil {
+				return err
+			}
+			if lastChannelID == "" {
+				break
+			}
+			afterChannelID = lastChannelID
+
+			job.Data["last_channel_id"] = lastChannelID
+			if appErr := jobServer.UpdateInProgressJobData(job); appErr != nil {
+				mlog.Error("Worker: Failed to update job data", mlog.String("worker", model.JobTypeChannelCounters), mlog.String("job_id", job.Id), mlog.Err(appErr))
+			}
+		}
+
+		return nil
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package channel_export
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/configservice"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/filestore"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const jobName = "ChannelExport"
+
+type AppIface interface {
+	configservice.ConfigService
+	NewFileWriter(ctx context.Context, path string, partSize int64) (filestore.FileWriter, *model.AppError)
+	ExportChannelArchive(c request.CTX, channelID string, w io.Writer) *model.AppError
+	Log() *mlog.Logger
+}
+
+// MakeWorker returns a worker that exports the channel given by the job's channel_id into an
+// archive in the export directory. The name of the archive is stored in the job's export_file, so
+// that it can be downloaded through the exports API.
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(cfg *model.Config) bool { return true }
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		channelID := job.Data["channel_id"]
+		if !model.IsValidId(channelID) {
+			return model.NewAppError("ChannelExportWorker", "app.channel_export.invalid_channel_id.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		exportFilename := job.Id + "_channel_export.zip"
+
+		partSize := int64(*app.Config().ExportSettings.UploadPartSizeMB) * 1024 * 1024
+		wr, appErr := app.NewFileWriter(context.Background(), filepath.Join(*app.Config().ExportSettings.Directory, exportFilename), partSize)
+		if appErr != nil {
+			return appErr
+		}
+
+		logger := app.Log().With(mlog.String("job_id", job.Id), mlog.String("channel_id", channelID))
+		if appErr = app.ExportChannelArchive(request.EmptyContext(logger), channelID, wr); appErr != nil {
+			if err := wr.Abort(); err != nil {
+				logger.Warn("Worker: Failed to discard the incomplete archive", mlog.String("worker", model.JobTypeChannelExport), mlog.Err(err))
+			}
+			return appErr
+		}
+
+		// The archive is only available once fully written.
+		if err := wr.Close(); err != nil {
+			return model.NewAppError("ChannelExportWorker", "app.export.file_writer.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		job.Data["export_file"] = exportFilename
+		return nil
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
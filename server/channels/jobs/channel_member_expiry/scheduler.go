@@ -0,0 +1,19 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package channel_member_expiry
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+)
+
+// The job scheduler only wakes up once a minute, so there is no point in a shorter frequency.
+const schedFreq = 1 * time.Minute
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(cfg *model.Config) bool { return true }
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeChannelMemberExpiry, schedFreq, isEnabled)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package channel_members_bulk
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const jobName = "ChannelMembersBulk"
+
+type AppIface interface {
+	Log() *mlog.Logger
+	ProcessChannelMembersBulk(c request.CTX, channelID, action, requesterID string, userIDs []string, reportProgress func(processed int, failures map[string]string)) (map[string]string, *model.AppError)
+}
+
+// MakeWorker returns a worker adding the users of the job's user_ids to the job's channel, or
+// removing them from it, saving how many were processed and the users that failed as it goes.
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(cfg *model.Config) bool { return true }
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		channelID := job.Data["channel_id"]
+		if !model.IsValidId(channelID) {
+			return model.NewAppError("ChannelMembersBulkWorker", "channel_members_bulk.worker.do_job.invalid_channel_id", nil, "", http.StatusBadRequest)
+		}
+
+		var userIDs []string
+		if job.Data["user_ids"] != "" {
+			userIDs = strings.Split(job.Data["user_ids"], ",")
+		}
+
+		logger := app.Log().With(mlog.String("job_id", job.Id), mlog.String("channel_id", channelID))
+
+		// An interrupted job is resumed after the users it already processed.
+		start, previousFailures := resumeState(job, len(userIDs))
+		if start > 0 {
+			logger.Info("Worker: Resuming the job from its checkpoint", mlog.String("worker", model.JobTypeChannelMembersBulk), mlog.Int("processed", start))
+		}
+		mergeFailures := func(failures map[string]string) map[string]string {
+			for userID, failure := range previousFailures {
+				failures[userID] = failure
+			}
+			return failures
+		}
+
+		reportProgress := func(processed int, failures map[string]string) {
+			processed += start
+			if err := setJobData(job, processed, mergeFailures(failures)); err != nil {
+				logger.Warn("Worker: Failed to encode the failures of the job", mlog.String("worker", model.JobTypeChannelMembersBulk), mlog.Err(err))
+				return
+			}
+			if err := jobServer.SaveCheckpoint(job, strconv.Itoa(processed), int64(processed*100/len(userIDs))); err != nil {
+				logger.Warn("Worker: Failed to update progress for job", mlog.String("worker", model.JobTypeChannelMembersBulk), mlog.Err(err))
+			}
+		}
+
+		failures, appErr := app.ProcessChannelMembersBulk(request.EmptyContext(logger), channelID, job.Data["action"], job.Data["requester_id"], userIDs[start:], reportProgress)
+		if appErr != nil {
+			logger.Error("Worker: Failed to process channel members in bulk", mlog.String("worker", model.JobTypeChannelMembersBulk), mlog.Err(appErr))
+			return appErr
+		}
+		failures = mergeFailures(failures)
+
+		if len(failures) > 0 {
+			logger.Info("Worker: Some users could not be processed", mlog.String("worker", model.JobTypeChannelMembersBulk), mlog.Int("failed", len(failures)))
+		}
+		// The data is saved along with the final progress of the job.
+		return setJobData(job, len(userIDs), failures)
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
+
+func setJobData(job *model.Job, processed int, failures map[string]string) error {
+	b, err := json.Marshal(failures)
+	if err != nil {
+		return err
+	}
+
+	job.Data["processed"] = strconv.Itoa(processed)
+	job.Data["failed"] = strconv.Itoa(len(failures))
+	job.Data["failures"] = string(b)
+	return nil
+}
+
+// resumeState returns how many users the job processed and the users that failed as of its
+// checkpoint, none when the job was never checkpointed.
+func resumeState(job *model.Job, total int) (int, map[string]string) {
+	failures := make(map[string]string)
+	if !job.IsResumable() {
+		return 0, failures
+	}
+
+	processed, err := strconv.Atoi(job.Checkpoint())
+	if err != nil || processed < 0 || processed > total {
+		return 0, failures
+	}
+	if job.Data["failures"] != "" {
+		if err := json.Unmarshal([]byte(job.Data["failures"]), &failures); err != nil {
+			return 0, make(map[string]string)
+		}
+	}
+
+	return processed, failures
+}
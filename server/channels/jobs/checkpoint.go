@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package jobs
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// CheckpointStaleTimeout is how long a checkpointed job can stay in progress without activity
+// before it's considered interrupted, e.g. by the server running it having been restarted.
+// (Defining as `var` rather than `const` allows tests to lower the timeout.)
+var CheckpointStaleTimeout = 5 * time.Minute
+
+// SaveCheckpoint saves the progress of a job in progress along with a checkpoint from which the
+// job is resumed when it's interrupted. The checkpoint is opaque to the job server, each worker
+// deciding what it needs to resume its job.
+func (srv *JobServer) SaveCheckpoint(job *model.Job, checkpoint string, progress int64) *model.AppError {
+	if job.Data == nil {
+		job.Data = make(map[string]string)
+	}
+	job.Data[model.JobDataKeyCheckpoint] = checkpoint
+	job.Status = model.JobStatusInProgress
+	job.Progress = progress
+	job.LastActivityAt = model.GetMillis()
+
+	if _, err := srv.Store.Job().UpdateOptimistically(job, model.JobStatusInProgress); err != nil {
+		return model.NewAppError("SaveCheckpoint", "app.job.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+// ResumeInterruptedJobs returns to the pending jobs the checkpointed jobs in progress without
+// activity for longer than CheckpointStaleTimeout, for their workers to resume them from their
+// checkpoint. The jobs that never saved a checkpoint are left as they are.
+func (srv *JobServer) ResumeInterruptedJobs() *model.AppError {
+	jobs, err := srv.Store.Job().GetAllByStatus(model.JobStatusInProgress)
+	if err != nil {
+		return model.NewAppError("ResumeInterruptedJobs", "app.job.get_all.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	staleAt := model.GetMillisForTime(time.Now().Add(-CheckpointStaleTimeout))
+	for _, job := range jobs {
+		if !job.IsResumable() || job.LastActivityAt >= staleAt {
+			continue
+		}
+
+		updated, err := srv.Store.Job().UpdateStatusOptimistically(job.Id, model.JobStatusInProgress, model.JobStatusPending)
+		if err != nil {
+			return model.NewAppError("ResumeInterruptedJobs", "app.job.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if updated {
+			mlog.Info("Resuming an interrupted job from its checkpoint.", mlog.String("job_id", job.Id), mlog.String("job_type", job.Type), mlog.String("checkpoint", job.Checkpoint()))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestSaveCheckpoint(t *testing.T) {
+	t.Run("error saving checkpoint", func(t *testing.T) {
+		jobServer, mockStore, _ := makeJobServer(t)
+
+		job := &model.Job{Id: "job_id", Type: "job_type"}
+		mockStore.JobStore.On("UpdateOptimistically", job, model.JobStatusInProgress).Return(false, &model.AppError{Message: "message"})
+
+		err := jobServer.SaveCheckpoint(job, "10", 50)
+		expectErrorId(t, "app.job.update.app_error", err)
+	})
+
+	t.Run("checkpoint saved", func(t *testing.T) {
+		jobServer, mockStore, _ := makeJobServer(t)
+
+		job := &model.Job{Id: "job_id", Type: "job_type"}
+		mockStore.JobStore.On("UpdateOptimistically", job, model.JobStatusInProgress).Return(true, nil)
+
+		err := jobServer.SaveCheckpoint(job, "10", 50)
+		require.Nil(t, err)
+		require.Equal(t, "10", job.Checkpoint())
+		require.Equal(t, int64(50), job.Progress)
+		require.NotZero(t, job.LastActivityAt)
+	})
+}
+
+func TestResumeInterruptedJobs(t *testing.T) {
+	stale := model.GetMillisForTime(time.Now().Add(-2 * CheckpointStaleTimeout))
+
+	t.Run("error getting jobs", func(t *testing.T) {
+		jobServer, mockStore, _ := makeJobServer(t)
+
+		mockStore.JobStore.On("GetAllByStatus", model.JobStatusInProgress).Return(nil, &model.AppError{Message: "message"})
+
+		err := jobServer.ResumeInterruptedJobs()
+		expectErrorId(t, "app.job.get_all.app_error", err)
+	})
+
+	t.Run("only stale checkpointed jobs are resumed", func(t *testing.T) {
+		jobServer, mockStore, _ := makeJobServer(t)
+
+		mockStore.JobStore.On("GetAllByStatus", model.JobStatusInProgress).Return([]*model.Job{
+			{Id: "stale", LastActivityAt: stale, Data: model.StringMap{model.JobDataKeyCheckpoint: "1"}},
+			{Id: "active", LastActivityAt: model.GetMillis(), Data: model.StringMap{model.JobDataKeyCheckpoint: "1"}},
+			{Id: "not_checkpointed", LastActivityAt: stale},
+		}, nil)
+		mockStore.JobStore.On("UpdateStatusOptimistically", "stale", model.JobStatusInProgress, model.JobStatusPending).Return(true, nil).Once()
+
+		err := jobServer.ResumeInterruptedJobs()
+		require.Nil(t, err)
+		mockStore.JobStore.AssertNumberOfCalls(t, "UpdateStatusOptimistically", 1)
+	})
+
+	t.Run("error resuming job", func(t *testing.T) {
+		jobServer, mockStore, _ := makeJobServer(t)
+
+		mockStore.JobStore.On("GetAllByStatus", model.JobStatusInProgress).Return([]*model.Job{
+			{Id: "stale", LastActivityAt: stale, Data: model.StringMap{model.JobDataKeyCheckpoint: "1"}},
+		}, nil)
+		mockStore.JobStore.On("UpdateStatusOptimistically", mock.Anything, model.JobStatusInProgress, model.JobStatusPending).Return(false, &model.AppError{Message: "message"})
+
+		err := jobServer.ResumeInterruptedJobs()
+		expectErrorId(t, "app.job.update.app_error", err)
+	})
+}
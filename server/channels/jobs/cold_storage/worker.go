@@ -0,0 +1,86 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cold_storage
+
+import (
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/configservice"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const (
+	jobName   = "ColdStorage"
+	batchSize = 100
+)
+
+type AppIface interface {
+	configservice.ConfigService
+	MoveFileToColdStorage(info *model.FileInfo) *model.AppError
+	Log() *mlog.Logger
+}
+
+// MakeWorker returns a worker that moves the files older than ColdStorageSettings.AfterDays to the cold
+// storage file store. The number of files to move, moved and failed to move are kept up to date in
+// the job's data, along with its progress.
+func MakeWorker(jobServer *jobs.JobServer, app AppIface, store store.Store) model.Worker {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.ColdStorageSettings.Enable
+	}
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		logger := app.Log().With(mlog.String("job_id", job.Id))
+		before := model.GetMillis() - int64(*app.Config().ColdStorageSettings.AfterDays)*24*60*60*1000
+
+		total, err := store.FileInfo().CountForColdStorage(before)
+		if err != nil {
+			return err
+		}
+		job.Data["total"] = strconv.FormatInt(total, 10)
+
+		var moved, failed int64
+		var afterCreateAt int64
+		var afterID string
+		for {
+			infos, err := store.FileInfo().GetForColdStorage(before, afterCreateAt, afterID, batchSize)
+			if err != nil {
+				return err
+			}
+			if len(infos) == 0 {
+				break
+			}
+
+			for _, info := range infos {
+				if appErr := app.MoveFileToColdStorage(info); appErr != nil {
+					logger.Warn("Worker: Failed to move file to cold storage", mlog.String("file_info_id", info.Id), mlog.Err(appErr))
+					failed++
+					continue
+				}
+				moved++
+			}
+			last := infos[len(infos)-1]
+			afterCreateAt, afterID = last.CreateAt, last.Id
+
+			job.Data["moved"] = strconv.FormatInt(moved, 10)
+			job.Data["failed"] = strconv.FormatInt(failed, 10)
+			// Files uploaded while the job runs aren't counted, so the progress is capped.
+			progress := int64(99)
+			if done := moved + failed; done < total {
+				progress = done * 100 / total
+			}
+			if appErr := jobServer.SetJobProgress(job, progress); appErr != nil {
+				logger.Error("Worker: Failed to update job progress", mlog.String("worker", model.JobTypeColdStorage), mlog.Err(appErr))
+			}
+		}
+
+		job.Data["moved"] = strconv.FormatInt(moved, 10)
+		job.Data["failed"] = strconv.FormatInt(failed, 10)
+		return nil
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
@@ -6,12 +6,15 @@ package export_process
 import (
 	"context"
 	"io"
+	"net/http"
 	"path/filepath"
+	"strconv"
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
 	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
 	"github.com/mattermost/mattermost-server/v6/server/platform/services/configservice"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/filestore"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
 )
 
@@ -19,8 +22,7 @@ const jobName = "ExportProcess"
 
 type AppIface interface {
 	configservice.ConfigService
-	WriteFile(fr io.Reader, path string) (int64, *model.AppError)
-	WriteFileContext(ctx context.Context, fr io.Reader, path string) (int64, *model.AppError)
+	NewFileWriter(ctx context.Context, path string, partSize int64) (filestore.FileWriter, *model.AppError)
 	BulkExport(ctx request.CTX, writer io.Writer, outPath string, job *model.Job, opts model.BulkExportOpts) *model.AppError
 	Log() *mlog.Logger
 }
@@ -39,30 +41,38 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
 			opts.IncludeAttachments = true
 		}
 
+		// An incremental export only includes what changed since the given time, typically the
+		// start time of the previous export job.
+		if since, ok := job.Data["since"]; ok && since != "" {
+			var err error
+			if opts.Since, err = strconv.ParseInt(since, 10, 64); err != nil || opts.Since < 0 {
+				return model.NewAppError("ExportProcessWorker", "app.export.invalid_since.app_error", nil, "since="+since, http.StatusBadRequest).Wrap(err)
+			}
+		}
+
 		outPath := *app.Config().ExportSettings.Directory
 		exportFilename := job.Id + "_export.zip"
 
-		rd, wr := io.Pipe()
-
-		go func() {
-			_, appErr := app.WriteFileContext(context.Background(), rd, filepath.Join(outPath, exportFilename))
-			if appErr != nil {
-				// we close the reader here to prevent a deadlock when the bulk exporter tries to
-				// write into the pipe while app.WriteFile has already returned. The error will be
-				// returned by the writer part of the pipe when app.BulkExport tries to call
-				// wr.Write() on it.
-				rd.CloseWithError(appErr) // CloseWithError never returns an error
-			}
-		}()
+		// The archive is streamed to the file store, so that exports of large installations don't
+		// need to be stored anywhere else first.
+		partSize := int64(*app.Config().ExportSettings.UploadPartSizeMB) * 1024 * 1024
+		wr, appErr := app.NewFileWriter(context.Background(), filepath.Join(outPath, exportFilename), partSize)
+		if appErr != nil {
+			return appErr
+		}
 
 		logger := app.Log().With(mlog.String("job_id", job.Id))
-		appErr := app.BulkExport(request.EmptyContext(logger), wr, outPath, job, opts)
-		wr.Close() // Close never returns an error
-
-		if appErr != nil {
+		if appErr = app.BulkExport(request.EmptyContext(logger), wr, outPath, job, opts); appErr != nil {
+			if err := wr.Abort(); err != nil {
+				logger.Warn("Worker: Failed to discard the incomplete export", mlog.String("worker", model.JobTypeExportProcess), mlog.Err(err))
+			}
 			return appErr
 		}
 
+		if err := wr.Close(); err != nil {
+			return model.NewAppError("ExportProcessWorker", "app.export.file_writer.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
 		return nil
 	}
 	worker := jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
@@ -0,0 +1,35 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package guest_expiration
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const jobName = "GuestExpiration"
+
+type AppIface interface {
+	Log() *mlog.Logger
+	DeactivateExpiredGuests(c request.CTX) *model.AppError
+}
+
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.GuestAccountsSettings.Enable
+	}
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		logger := app.Log().With(mlog.String("job_id", job.Id))
+		if appErr := app.DeactivateExpiredGuests(request.EmptyContext(logger)); appErr != nil {
+			logger.Error("Worker: Failed to deactivate expired guests", mlog.String("worker", model.JobTypeGuestExpiration), mlog.Err(appErr))
+			return appErr
+		}
+		return nil
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
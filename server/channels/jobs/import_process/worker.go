@@ -5,6 +5,7 @@ package import_process
 
 import (
 	"archive/zip"
+	"encoding/json"
 	"io"
 	"net/http"
 	"path/filepath"
@@ -29,6 +30,7 @@ type AppIface interface {
 	FileSize(path string) (int64, *model.AppError)
 	FileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
 	BulkImportWithPath(c *request.Context, jsonlReader io.Reader, attachmentsReader *zip.Reader, dryRun bool, workers int, importPath string) (*model.AppError, int)
+	ValidateBulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, importPath string) (*model.BulkImportValidationReport, *model.AppError)
 	Log() *mlog.Logger
 }
 
@@ -100,6 +102,24 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
 			return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.missing_jsonl", nil, "jsonFile was nil", http.StatusBadRequest)
 		}
 
+		// A dry run only validates the import, keeping the file so that it can be imported once
+		// the problems found have been fixed.
+		if job.Data["dry_run"] == "true" {
+			report, appErr := app.ValidateBulkImport(appContext, jsonFile, importZipReader, model.ExportDataDir)
+			if appErr != nil {
+				return appErr
+			}
+
+			reportJSON, err := json.Marshal(report)
+			if err != nil {
+				return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.report", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			job.Data["validation_report"] = string(reportJSON)
+			job.Data["error_count"] = strconv.Itoa(report.ErrorCount)
+			job.Data["warning_count"] = strconv.Itoa(report.WarningCount)
+			return nil
+		}
+
 		// do the actual import.
 		appErr, lineNumber := app.BulkImportWithPath(appContext, jsonFile, importZipReader, false, runtime.NumCPU(), model.ExportDataDir)
 		if appErr != nil {
@@ -21,10 +21,18 @@ const (
 	CancelWatcherPollingInterval = 5000
 )
 
+// CreateJob creates a pending job with the priority of its type.
 func (srv *JobServer) CreateJob(jobType string, jobData map[string]string) (*model.Job, *model.AppError) {
+	return srv.CreateJobWithPriority(jobType, srv.TypeSettings(jobType).Priority, jobData)
+}
+
+// CreateJobWithPriority creates a pending job started before the pending jobs of its type with a
+// lower priority.
+func (srv *JobServer) CreateJobWithPriority(jobType string, priority int64, jobData map[string]string) (*model.Job, *model.AppError) {
 	job := model.Job{
 		Id:       model.NewId(),
 		Type:     jobType,
+		Priority: priority,
 		CreateAt: model.GetMillis(),
 		Status:   model.JobStatusPending,
 		Data:     jobData,
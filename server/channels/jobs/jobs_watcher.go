@@ -66,20 +66,49 @@ func (watcher *Watcher) Stop() {
 	watcher.stopped = make(chan struct{})
 }
 
+// PollAndNotify hands the pending jobs to their workers by priority, leaving those of the paused
+// job types pending, along with those of the types with as many jobs in progress as they allow.
+// The nodes polling at the same time may briefly exceed these limits.
 func (watcher *Watcher) PollAndNotify() {
+	if appErr := watcher.srv.LoadTypeSettings(); appErr != nil {
+		mlog.Warn("Failed to reload the job type settings.", mlog.Err(appErr))
+	}
+
 	jobs, err := watcher.srv.Store.Job().GetAllByStatus(model.JobStatusPending)
 	if err != nil {
 		mlog.Error("Error occurred getting all pending statuses.", mlog.Err(err))
 		return
 	}
 
+	inProgress := make(map[string]int64)
 	for _, job := range jobs {
 		worker := watcher.workers.Get(job.Type)
-		if worker != nil {
-			select {
-			case worker.JobChannel() <- *job:
-			default:
+		if worker == nil {
+			continue
+		}
+
+		settings := watcher.srv.TypeSettings(job.Type)
+		if settings.Paused {
+			continue
+		}
+		if settings.MaxConcurrency > 0 {
+			if _, ok := inProgress[job.Type]; !ok {
+				count, err := watcher.srv.Store.Job().GetCountByStatusAndType(model.JobStatusInProgress, job.Type)
+				if err != nil {
+					mlog.Error("Error occurred counting the jobs in progress.", mlog.String("job_type", job.Type), mlog.Err(err))
+					continue
+				}
+				inProgress[job.Type] = count
 			}
+			if inProgress[job.Type] >= int64(settings.MaxConcurrency) {
+				continue
+			}
+		}
+
+		select {
+		case worker.JobChannel() <- *job:
+			inProgress[job.Type]++
+		default:
 		}
 	}
 }
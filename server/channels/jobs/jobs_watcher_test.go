@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store/storetest"
+)
+
+type MockWorker struct {
+	jobs chan model.Job
+}
+
+func (worker *MockWorker) Run()                             {}
+func (worker *MockWorker) Stop()                            {}
+func (worker *MockWorker) JobChannel() chan<- model.Job     { return worker.jobs }
+func (worker *MockWorker) IsEnabled(cfg *model.Config) bool { return true }
+
+func (worker *MockWorker) received() []string {
+	ids := []string{}
+	for len(worker.jobs) > 0 {
+		job := <-worker.jobs
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
+
+func TestWatcherPollAndNotify(t *testing.T) {
+	makeWatcher := func(t *testing.T) (*Watcher, *MockWorker, *MockWorker, *storetest.Store) {
+		jobServer, mockStore, _ := makeJobServer(t)
+
+		worker1 := &MockWorker{jobs: make(chan model.Job, 10)}
+		worker2 := &MockWorker{jobs: make(chan model.Job, 10)}
+		workers := NewWorkers(jobServer.ConfigService)
+		workers.AddWorker("type1", worker1)
+		workers.AddWorker("type2", worker2)
+
+		return jobServer.MakeWatcher(workers, 0), worker1, worker2, mockStore
+	}
+
+	pending := []*model.Job{
+		{Id: "job1", Type: "type1", Priority: 10},
+		{Id: "job2", Type: "type2", Priority: 5},
+		{Id: "job3", Type: "type1"},
+		{Id: "job4", Type: "unknown"},
+		{Id: "job5", Type: "type2"},
+	}
+
+	t.Run("dispatches the pending jobs in order", func(t *testing.T) {
+		watcher, worker1, worker2, mockStore := makeWatcher(t)
+		mockStore.JobStore.On("GetTypeSettings").Return([]*model.JobTypeSettings{}, nil)
+		mockStore.JobStore.On("GetAllByStatus", model.JobStatusPending).Return(pending, nil)
+
+		watcher.PollAndNotify()
+
+		assert.Equal(t, []string{"job1", "job3"}, worker1.received())
+		assert.Equal(t, []string{"job2", "job5"}, worker2.received())
+	})
+
+	t.Run("skips the paused job types", func(t *testing.T) {
+		watcher, worker1, worker2, mockStore := makeWatcher(t)
+		mockStore.JobStore.On("GetTypeSettings").Return([]*model.JobTypeSettings{{JobType: "type2", Paused: true}}, nil)
+		mockStore.JobStore.On("GetAllByStatus", model.JobStatusPending).Return(pending, nil)
+
+		watcher.PollAndNotify()
+
+		assert.Equal(t, []string{"job1", "job3"}, worker1.received())
+		assert.Empty(t, worker2.received())
+	})
+
+	t.Run("respects the max concurrency", func(t *testing.T) {
+		watcher, worker1, worker2, mockStore := makeWatcher(t)
+		mockStore.JobStore.On("GetTypeSettings").Return([]*model.JobTypeSettings{
+			{JobType: "type1", MaxConcurrency: 2},
+			{JobType: "type2", MaxConcurrency: 1},
+		}, nil)
+		mockStore.JobStore.On("GetAllByStatus", model.JobStatusPending).Return(pending, nil)
+		mockStore.JobStore.On("GetCountByStatusAndType", model.JobStatusInProgress, "type1").Return(int64(1), nil).Once()
+		mockStore.JobStore.On("GetCountByStatusAndType", model.JobStatusInProgress, "type2").Return(int64(1), nil).Once()
+
+		watcher.PollAndNotify()
+
+		assert.Equal(t, []string{"job1"}, worker1.received())
+		assert.Empty(t, worker2.received())
+	})
+
+	t.Run("uses the settings saved on this node when they can't be reloaded", func(t *testing.T) {
+		watcher, worker1, _, mockStore := makeWatcher(t)
+		watcher.srv.typeSettings = map[string]*model.JobTypeSettings{"type1": {JobType: "type1", Paused: true}}
+		mockStore.JobStore.On("GetTypeSettings").Return(nil, &model.AppError{Message: "message"})
+		mockStore.JobStore.On("GetAllByStatus", model.JobStatusPending).Return(pending, nil)
+
+		watcher.PollAndNotify()
+
+		require.Empty(t, worker1.received())
+	})
+}
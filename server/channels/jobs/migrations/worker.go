@@ -111,6 +111,12 @@ func (worker *Worker) DoJob(job *model.Job) {
 			return
 
 		case <-worker.stop:
+			// The job is resumed from its checkpoint once the workers are started again.
+			if job.IsResumable() {
+				mlog.Debug("Worker: Job has been interrupted via Worker Stop", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+				worker.setJobPending(job)
+				return
+			}
 			mlog.Debug("Worker: Job has been canceled via Worker Stop", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
 			worker.setJobCanceled(job)
 			return
@@ -126,8 +132,9 @@ func (worker *Worker) DoJob(job *model.Job) {
 				worker.setJobSuccess(job)
 				return
 			} else {
+				// The last done is also kept for the jobs scheduled after a canceled one.
 				job.Data[JobDataKeyMigrationLastDone] = progress
-				if err := worker.jobServer.UpdateInProgressJobData(job); err != nil {
+				if err := worker.jobServer.SaveCheckpoint(job, progress, job.Progress); err != nil {
 					mlog.Error("Worker: Failed to update migration status data for job", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
 					worker.setJobError(job, err)
 					return
@@ -150,6 +157,12 @@ func (worker *Worker) setJobError(job *model.Job, appError *model.AppError) {
 	}
 }
 
+func (worker *Worker) setJobPending(job *model.Job) {
+	if err := worker.jobServer.SetJobPending(job); err != nil {
+		mlog.Error("Worker: Failed to return job to pending", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
+
 func (worker *Worker) setJobCanceled(job *model.Job) {
 	if err := worker.jobServer.SetJobCanceled(job); err != nil {
 		mlog.Error("Worker: Failed to mark job as canceled", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
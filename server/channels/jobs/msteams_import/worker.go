@@ -0,0 +1,135 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package msteams_import
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/configservice"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/msteamsimport"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/filestore"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const jobName = "MSTeamsImport"
+
+type AppIface interface {
+	configservice.ConfigService
+	RemoveFile(path string) *model.AppError
+	FileExists(path string) (bool, *model.AppError)
+	FileSize(path string) (int64, *model.AppError)
+	FileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
+	BulkImportWithPath(c *request.Context, jsonlReader io.Reader, attachmentsReader *zip.Reader, dryRun bool, workers int, importPath string) (*model.AppError, int)
+	Log() *mlog.Logger
+}
+
+// MakeWorker returns a worker importing the Microsoft Teams export archive given by the job's
+// import_file, uploaded to the import directory. The export is converted to the bulk import format
+// on the fly, applying the mapping configuration given by the optional mapping_file, a JSON file
+// also uploaded to the import directory.
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	appContext := request.EmptyContext(app.Log())
+	isEnabled := func(cfg *model.Config) bool { return true }
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		importFileName, ok := job.Data["import_file"]
+		if !ok {
+			return model.NewAppError("MSTeamsImportWorker", "import_process.worker.do_job.missing_file", nil, "", http.StatusBadRequest)
+		}
+
+		importFilePath := filepath.Join(*app.Config().ImportSettings.Directory, importFileName)
+		if ok, err := app.FileExists(importFilePath); err != nil {
+			return err
+		} else if !ok {
+			return model.NewAppError("MSTeamsImportWorker", "import_process.worker.do_job.file_exists", nil, "", http.StatusBadRequest)
+		}
+
+		mapping, appErr := readMapping(app, job.Data["mapping_file"])
+		if appErr != nil {
+			return appErr
+		}
+
+		importFileSize, appErr := app.FileSize(importFilePath)
+		if appErr != nil {
+			return appErr
+		}
+
+		importFile, appErr := app.FileReader(importFilePath)
+		if appErr != nil {
+			return appErr
+		}
+		defer importFile.Close()
+
+		// The import is a long running operation, try to cancel any timeouts attached to the reader.
+		type TimeoutCanceler interface{ CancelTimeout() bool }
+		if tc, ok := importFile.(TimeoutCanceler); ok {
+			if !tc.CancelTimeout() {
+				appContext.Logger().Warn("Could not cancel the timeout for the file reader. The import may fail due to a timeout.")
+			}
+		}
+
+		importReader, ok := importFile.(io.ReaderAt)
+		if !ok {
+			return model.NewAppError("MSTeamsImportWorker", "import_process.worker.do_job.open_file", nil, "the file reader doesn't support random access", http.StatusInternalServerError)
+		}
+
+		archive, err := zip.NewReader(importReader, importFileSize)
+		if err != nil {
+			return model.NewAppError("MSTeamsImportWorker", "import_process.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		// The converted data is imported as it is written, without being held in memory.
+		pr, pw := io.Pipe()
+		convertErr := make(chan error, 1)
+		go func() {
+			err := msteamsimport.Convert(archive, mapping, pw)
+			pw.CloseWithError(err)
+			convertErr <- err
+		}()
+
+		appErr, lineNumber := app.BulkImportWithPath(appContext, pr, nil, false, runtime.NumCPU(), "")
+		pr.CloseWithError(io.ErrClosedPipe)
+		if err := <-convertErr; err != nil && err != io.ErrClosedPipe {
+			return model.NewAppError("MSTeamsImportWorker", "msteams_import.worker.do_job.convert", nil, "", http.StatusBadRequest).Wrap(err)
+		}
+		if appErr != nil {
+			job.Data["line_number"] = strconv.Itoa(lineNumber)
+			return appErr
+		}
+
+		// remove import file when done.
+		return app.RemoveFile(importFilePath)
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
+
+// readMapping reads the mapping configuration from the import directory, returning nil if the job
+// doesn't have one.
+func readMapping(app AppIface, mappingFileName string) (*msteamsimport.Mapping, *model.AppError) {
+	if mappingFileName == "" {
+		return nil, nil
+	}
+
+	mappingFile, appErr := app.FileReader(filepath.Join(*app.Config().ImportSettings.Directory, mappingFileName))
+	if appErr != nil {
+		return nil, appErr
+	}
+	defer mappingFile.Close()
+
+	var mapping msteamsimport.Mapping
+	if err := json.NewDecoder(mappingFile).Decode(&mapping); err != nil {
+		return nil, model.NewAppError("MSTeamsImportWorker", "msteams_import.worker.do_job.mapping_file", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+	return &mapping, nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package partition_maintenance
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/configservice"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const (
+	jobName   = "PartitionMaintenance"
+	batchSize = 1000
+)
+
+type AppIface interface {
+	configservice.ConfigService
+	License() *model.License
+	Log() *mlog.Logger
+}
+
+// MakeWorker returns a worker that creates the monthly partitions of the partitioned tables
+// SqlSettings.PartitionsPrecreateMonths ahead, and drops the partitions past the global message
+// retention period along with the rows left referencing their posts. Partitions are only dropped
+// without granular retention policies, which could keep their posts longer. The partitions created
+// and dropped are kept in the job's data.
+func MakeWorker(jobServer *jobs.JobServer, app AppIface, store store.Store) model.Worker {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.SqlSettings.DriverName == model.DatabaseDriverPostgres
+	}
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		logger := app.Log().With(mlog.String("job_id", job.Id))
+		cfg := app.Config()
+
+		created, err := store.CreateTablePartitions(time.Now().AddDate(0, *cfg.SqlSettings.PartitionsPrecreateMonths, 0))
+		if err != nil {
+			return err
+		}
+		job.Data["created"] = partitionNames(created)
+
+		license := app.License()
+		if !*cfg.DataRetentionSettings.EnableMessageDeletion || license == nil || license.Features.DataRetention == nil || !*license.Features.DataRetention {
+			return nil
+		}
+
+		policies, err := store.RetentionPolicy().GetCount()
+		if err != nil {
+			return err
+		}
+		if policies > 0 {
+			logger.Info("Worker: Not dropping partitions with granular data retention policies", mlog.Int64("policies", policies))
+			return nil
+		}
+
+		before := model.GetMillis() - int64(*cfg.DataRetentionSettings.MessageRetentionDays)*24*60*60*1000
+		dropped, err := store.DropTablePartitions(before)
+		job.Data["dropped"] = partitionNames(dropped)
+		if err != nil {
+			return err
+		}
+		if len(dropped) == 0 {
+			return nil
+		}
+
+		deleted, err := deleteOrphanedRows(store)
+		job.Data["orphaned_rows_deleted"] = strconv.FormatInt(deleted, 10)
+		return err
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
+
+// deleteOrphanedRows deletes the rows referencing the posts of the partitions dropped.
+func deleteOrphanedRows(store store.Store) (int64, error) {
+	deleteFuncs := []func(limit int) (int64, error){
+		store.Reaction().DeleteOrphanedRows,
+		store.Preference().DeleteOrphanedRows,
+		store.PostTranslation().DeleteOrphanedRows,
+		store.PostEmbedding().DeleteOrphanedRows,
+	}
+
+	var total int64
+	for _, deleteFunc := range deleteFuncs {
+		for {
+			deleted, err := deleteFunc(batchSize)
+			if err != nil {
+				return total, err
+			}
+			total += deleted
+			if deleted < batchSize {
+				break
+			}
+		}
+	}
+
+	return total, nil
+}
+
+func partitionNames(partitions []*model.TablePartition) string {
+	names := make([]string, 0, len(partitions))
+	for _, partition := range partitions {
+		names = append(names, partition.Name)
+	}
+	return strings.Join(names, ",")
+}
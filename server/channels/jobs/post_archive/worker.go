@@ -0,0 +1,81 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package post_archive
+
+import (
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/configservice"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const (
+	jobName   = "PostArchive"
+	batchSize = 500
+)
+
+type AppIface interface {
+	configservice.ConfigService
+	ArchivePosts(posts []*model.Post) *model.AppError
+	Log() *mlog.Logger
+}
+
+// MakeWorker returns a worker that moves the posts older than ColdStorageSettings.PostsAfterDays
+// from the database to the post archive, oldest first. The number of posts archived is kept up to
+// date in the job's data.
+func MakeWorker(jobServer *jobs.JobServer, app AppIface, store store.Store) model.Worker {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.ColdStorageSettings.Enable && *cfg.ColdStorageSettings.PostsAfterDays > 0
+	}
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		logger := app.Log().With(mlog.String("job_id", job.Id))
+		before := model.GetMillis() - int64(*app.Config().ColdStorageSettings.PostsAfterDays)*24*60*60*1000
+
+		var archived int64
+		var afterCreateAt int64
+		var afterID string
+		for {
+			batch, err := store.Post().GetPostsBatchForIndexing(afterCreateAt, afterID, batchSize)
+			if err != nil {
+				return err
+			}
+
+			posts := make([]*model.Post, 0, len(batch))
+			for i := range batch {
+				if batch[i].CreateAt >= before {
+					break
+				}
+				posts = append(posts, &batch[i].Post)
+			}
+			if len(posts) == 0 {
+				break
+			}
+
+			if appErr := app.ArchivePosts(posts); appErr != nil {
+				job.Data["archived"] = strconv.FormatInt(archived, 10)
+				return appErr
+			}
+			archived += int64(len(posts))
+			last := posts[len(posts)-1]
+			afterCreateAt, afterID = last.CreateAt, last.Id
+
+			job.Data["archived"] = strconv.FormatInt(archived, 10)
+			if appErr := jobServer.UpdateInProgressJobData(job); appErr != nil {
+				logger.Error("Worker: Failed to update job data", mlog.String("worker", model.JobTypePostArchive), mlog.Err(appErr))
+			}
+			if len(posts) < len(batch) {
+				break
+			}
+		}
+
+		job.Data["archived"] = strconv.FormatInt(archived, 10)
+		return nil
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
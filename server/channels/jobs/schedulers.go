@@ -24,6 +24,8 @@ type Schedulers struct {
 
 	schedulers   map[string]model.Scheduler
 	nextRunTimes map[string]*time.Time
+	// schedules are the cron expressions the next run times were set from, if any.
+	schedules map[string]string
 }
 
 var (
@@ -70,16 +72,36 @@ func (schedulers *Schedulers) Start() {
 			case now = <-timer.C:
 				cfg := schedulers.jobs.Config()
 
+				if schedulers.isLeader {
+					if appErr := schedulers.jobs.LoadTypeSettings(); appErr != nil {
+						mlog.Warn("Failed to reload the job type settings.", mlog.Err(appErr))
+					}
+					if appErr := schedulers.jobs.ResumeInterruptedJobs(); appErr != nil {
+						mlog.Warn("Failed to resume the interrupted jobs.", mlog.Err(appErr))
+					}
+				}
+
 				for name, nextTime := range schedulers.nextRunTimes {
 					if nextTime == nil {
 						continue
 					}
 
+					// The schedule of the job type was changed at runtime.
+					if schedulers.jobs.TypeSettings(name).Schedule != schedulers.schedules[name] {
+						schedulers.setNextRunTime(cfg, name, now, false)
+						continue
+					}
+
 					if time.Now().After(*nextTime) {
 						scheduler := schedulers.schedulers[name]
 						if scheduler == nil || !schedulers.isLeader || !scheduler.Enabled(cfg) {
 							continue
 						}
+						// The runs of the paused job types are skipped.
+						if schedulers.jobs.TypeSettings(name).Paused {
+							schedulers.setNextRunTime(cfg, name, now, false)
+							continue
+						}
 						if _, err := schedulers.scheduleJob(cfg, name, scheduler); err != nil {
 							mlog.Error("Failed to schedule job", mlog.String("scheduler", name), mlog.Err(err))
 							continue
@@ -126,6 +148,14 @@ func (schedulers *Schedulers) Stop() {
 func (schedulers *Schedulers) setNextRunTime(cfg *model.Config, name string, now time.Time, pendingJobs bool) {
 	scheduler := schedulers.schedulers[name]
 
+	schedule := schedulers.jobs.TypeSettings(name).Schedule
+	schedulers.schedules[name] = schedule
+	if schedule != "" {
+		schedulers.nextRunTimes[name] = nextCronTime(name, schedule, now)
+		mlog.Debug("Next run time for scheduler", mlog.String("scheduler_name", name), mlog.String("schedule", schedule), mlog.String("next_runtime", fmt.Sprintf("%v", schedulers.nextRunTimes[name])))
+		return
+	}
+
 	if !pendingJobs {
 		pj, err := schedulers.jobs.CheckForPendingJobsByType(name)
 		if err != nil {
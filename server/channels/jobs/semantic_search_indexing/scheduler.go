@@ -0,0 +1,20 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package semantic_search_indexing
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+)
+
+const schedFreq = 5 * time.Minute
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.SemanticSearchSettings.EnableIndexing
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeSemanticSearchIndexing, schedFreq, isEnabled)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package semantic_search_indexing
+
+import (
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const (
+	jobName = "SemanticSearchIndexing"
+
+	// maxBatchesPerJob bounds how long a job runs, the next job carrying on from where it stopped.
+	maxBatchesPerJob = 100
+
+	lastPostUpdateAtKey = "last_post_update_at"
+	lastPostIdKey       = "last_post_id"
+)
+
+type AppIface interface {
+	Log() *mlog.Logger
+	IndexPostEmbeddings(c request.CTX, cursor model.GetPostsSinceForSyncCursor) (model.GetPostsSinceForSyncCursor, int, *model.AppError)
+}
+
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.SemanticSearchSettings.EnableIndexing
+	}
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		logger := app.Log().With(mlog.String("job_id", job.Id))
+
+		// Each job indexes the posts changed since the last one that succeeded
+		cursor := model.GetPostsSinceForSyncCursor{}
+		lastJob, appErr := jobServer.GetLastSuccessfulJobByType(model.JobTypeSemanticSearchIndexing)
+		if appErr != nil {
+			return appErr
+		}
+		if lastJob != nil {
+			cursor = getCursor(lastJob)
+		}
+
+		if job.Data == nil {
+			job.Data = make(model.StringMap)
+		}
+
+		for i := 0; i < maxBatchesPerJob; i++ {
+			var count int
+			cursor, count, appErr = app.IndexPostEmbeddings(request.EmptyContext(logger), cursor)
+			if appErr != nil {
+				logger.Error("Worker: Failed to index post embeddings", mlog.String("worker", model.JobTypeSemanticSearchIndexing), mlog.Err(appErr))
+				return appErr
+			}
+			if count == 0 {
+				break
+			}
+
+			setCursor(job, cursor)
+			if appErr = jobServer.UpdateInProgressJobData(job); appErr != nil {
+				return appErr
+			}
+		}
+
+		setCursor(job, cursor)
+		return nil
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
+
+func getCursor(job *model.Job) model.GetPostsSinceForSyncCursor {
+	lastPostUpdateAt, _ := strconv.ParseInt(job.Data[lastPostUpdateAtKey], 10, 64)
+	return model.GetPostsSinceForSyncCursor{
+		LastPostUpdateAt: lastPostUpdateAt,
+		LastPostId:       job.Data[lastPostIdKey],
+	}
+}
+
+func setCursor(job *model.Job, cursor model.GetPostsSinceForSyncCursor) {
+	job.Data[lastPostUpdateAtKey] = strconv.FormatInt(cursor.LastPostUpdateAt, 10)
+	job.Data[lastPostIdKey] = cursor.LastPostId
+}
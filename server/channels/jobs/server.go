@@ -22,6 +22,10 @@ type JobServer struct {
 	mut        sync.Mutex
 	workers    *Workers
 	schedulers *Schedulers
+
+	// settingsMut protects the settings of the job types, reloaded from the database.
+	settingsMut  sync.RWMutex
+	typeSettings map[string]*model.JobTypeSettings
 }
 
 func NewJobServer(configService configservice.ConfigService, store store.Store, metrics einterfaces.MetricsInterface) *JobServer {
@@ -49,6 +53,7 @@ func (srv *JobServer) initSchedulers() {
 		isLeader:             true,
 		schedulers:           make(map[string]model.Scheduler),
 		nextRunTimes:         make(map[string]*time.Time),
+		schedules:            make(map[string]string),
 	}
 
 	srv.schedulers = schedulers
@@ -0,0 +1,105 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slack_import
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"path/filepath"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/configservice"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/filestore"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const jobName = "SlackImport"
+
+type AppIface interface {
+	configservice.ConfigService
+	RemoveFile(path string) *model.AppError
+	FileExists(path string) (bool, *model.AppError)
+	FileSize(path string) (int64, *model.AppError)
+	FileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
+	GetTeam(teamID string) (*model.Team, *model.AppError)
+	SlackImport(c *request.Context, fileData io.ReaderAt, fileSize int64, teamID string, reportProgress func(int)) (*model.AppError, *bytes.Buffer)
+	Log() *mlog.Logger
+}
+
+// MakeWorker returns a worker importing the Slack export archive given by the job's import_file,
+// uploaded to the import directory, into the team given by its team_id. The import log is stored in
+// the job's import_log.
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(cfg *model.Config) bool { return true }
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		teamID := job.Data["team_id"]
+		if !model.IsValidId(teamID) {
+			return model.NewAppError("SlackImportWorker", "slack_import.worker.do_job.invalid_team_id", nil, "", http.StatusBadRequest)
+		}
+		if _, appErr := app.GetTeam(teamID); appErr != nil {
+			return appErr
+		}
+
+		importFileName, ok := job.Data["import_file"]
+		if !ok {
+			return model.NewAppError("SlackImportWorker", "import_process.worker.do_job.missing_file", nil, "", http.StatusBadRequest)
+		}
+
+		importFilePath := filepath.Join(*app.Config().ImportSettings.Directory, importFileName)
+		if ok, err := app.FileExists(importFilePath); err != nil {
+			return err
+		} else if !ok {
+			return model.NewAppError("SlackImportWorker", "import_process.worker.do_job.file_exists", nil, "", http.StatusBadRequest)
+		}
+
+		importFileSize, appErr := app.FileSize(importFilePath)
+		if appErr != nil {
+			return appErr
+		}
+
+		importFile, appErr := app.FileReader(importFilePath)
+		if appErr != nil {
+			return appErr
+		}
+		defer importFile.Close()
+
+		logger := app.Log().With(mlog.String("job_id", job.Id), mlog.String("team_id", teamID))
+
+		// The import is a long running operation, try to cancel any timeouts attached to the reader.
+		type TimeoutCanceler interface{ CancelTimeout() bool }
+		if tc, ok := importFile.(TimeoutCanceler); ok {
+			if !tc.CancelTimeout() {
+				logger.Warn("Could not cancel the timeout for the file reader. The import may fail due to a timeout.")
+			}
+		}
+
+		importReader, ok := importFile.(io.ReaderAt)
+		if !ok {
+			return model.NewAppError("SlackImportWorker", "import_process.worker.do_job.open_file", nil, "the file reader doesn't support random access", http.StatusInternalServerError)
+		}
+
+		reportProgress := func(percent int) {
+			if err := jobServer.SetJobProgress(job, int64(percent)); err != nil {
+				logger.Warn("Worker: Failed to update progress for job", mlog.String("worker", model.JobTypeSlackImport), mlog.Err(err))
+			}
+		}
+
+		appErr, importLog := app.SlackImport(request.EmptyContext(logger), importReader, importFileSize, teamID, reportProgress)
+		if importLog != nil {
+			job.Data["import_log"] = importLog.String()
+		}
+		if appErr != nil {
+			return appErr
+		}
+
+		// remove import file when done.
+		return app.RemoveFile(importFilePath)
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package team_archive
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const jobName = "TeamArchive"
+
+type AppIface interface {
+	Log() *mlog.Logger
+	ProcessTeamArchive(c request.CTX, teamID string, reportProgress func(int)) *model.AppError
+}
+
+// MakeWorker returns a worker archiving or restoring the team given by the job's team_id,
+// depending on the status of the team's archive.
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(cfg *model.Config) bool { return true }
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		teamID := job.Data["team_id"]
+		if !model.IsValidId(teamID) {
+			return model.NewAppError("TeamArchiveWorker", "team_archive.worker.do_job.invalid_team_id", nil, "", http.StatusBadRequest)
+		}
+
+		logger := app.Log().With(mlog.String("job_id", job.Id), mlog.String("team_id", teamID))
+
+		reportProgress := func(percent int) {
+			if err := jobServer.SetJobProgress(job, int64(percent)); err != nil {
+				logger.Warn("Worker: Failed to update progress for job", mlog.String("worker", model.JobTypeTeamArchive), mlog.Err(err))
+			}
+		}
+
+		if appErr := app.ProcessTeamArchive(request.EmptyContext(logger), teamID, reportProgress); appErr != nil {
+			logger.Error("Worker: Failed to process team archive", mlog.String("worker", model.JobTypeTeamArchive), mlog.Err(appErr))
+			return appErr
+		}
+		return nil
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
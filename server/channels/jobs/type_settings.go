@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package jobs
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// LoadTypeSettings reloads the settings of the job types from the database, where the settings
+// changed on any node of the cluster are saved. The watcher and the schedulers reload them as
+// they poll.
+func (srv *JobServer) LoadTypeSettings() *model.AppError {
+	list, err := srv.Store.Job().GetTypeSettings()
+	if err != nil {
+		return model.NewAppError("LoadTypeSettings", "app.job.get_type_settings.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	typeSettings := make(map[string]*model.JobTypeSettings, len(list))
+	for _, settings := range list {
+		typeSettings[settings.JobType] = settings
+	}
+
+	srv.settingsMut.Lock()
+	srv.typeSettings = typeSettings
+	srv.settingsMut.Unlock()
+
+	return nil
+}
+
+// TypeSettings returns a copy of the settings of the job type, or the default settings when none
+// were saved.
+func (srv *JobServer) TypeSettings(jobType string) *model.JobTypeSettings {
+	srv.settingsMut.RLock()
+	defer srv.settingsMut.RUnlock()
+
+	if settings, ok := srv.typeSettings[jobType]; ok {
+		settingsCopy := *settings
+		return &settingsCopy
+	}
+
+	return &model.JobTypeSettings{JobType: jobType}
+}
+
+// GetAllTypeSettings returns the settings of the job types registered, sorted by type.
+func (srv *JobServer) GetAllTypeSettings() []*model.JobTypeSettings {
+	srv.mut.Lock()
+	jobTypes := make([]string, 0, len(srv.workers.workers))
+	for jobType := range srv.workers.workers {
+		jobTypes = append(jobTypes, jobType)
+	}
+	srv.mut.Unlock()
+
+	sort.Strings(jobTypes)
+	list := make([]*model.JobTypeSettings, 0, len(jobTypes))
+	for _, jobType := range jobTypes {
+		list = append(list, srv.TypeSettings(jobType))
+	}
+
+	return list
+}
+
+// SaveTypeSettings saves the settings of a registered job type, taking effect on this node right
+// away and on the other nodes as they reload them.
+func (srv *JobServer) SaveTypeSettings(settings *model.JobTypeSettings) (*model.JobTypeSettings, *model.AppError) {
+	if srv.workers.Get(settings.JobType) == nil {
+		return nil, model.NewAppError("SaveTypeSettings", "model.job.is_valid.type.app_error", nil, "job_type="+settings.JobType, http.StatusBadRequest)
+	}
+
+	saved, err := srv.Store.Job().SaveTypeSettings(settings)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("SaveTypeSettings", "app.job.save_type_settings.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	srv.settingsMut.Lock()
+	if srv.typeSettings == nil {
+		srv.typeSettings = make(map[string]*model.JobTypeSettings)
+	}
+	settingsCopy := *saved
+	srv.typeSettings[saved.JobType] = &settingsCopy
+	srv.settingsMut.Unlock()
+
+	return saved, nil
+}
+
+// nextCronTime returns the next time matching the cron expression after now, or nil when there
+// is none.
+func nextCronTime(name, schedule string, now time.Time) *time.Time {
+	cronSchedule, err := model.ParseCronSchedule(schedule)
+	if err != nil {
+		mlog.Error("Invalid schedule for the job type", mlog.String("scheduler", name), mlog.String("schedule", schedule), mlog.Err(err))
+		return nil
+	}
+
+	next := cronSchedule.Next(now)
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
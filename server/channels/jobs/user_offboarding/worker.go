@@ -0,0 +1,60 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package user_offboarding
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	"github.com/mattermost/mattermost-server/v6/server/channels/jobs"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const jobName = "UserOffboarding"
+
+type AppIface interface {
+	Log() *mlog.Logger
+	OffboardUser(c request.CTX, userID, successorID string, reportProgress func(int)) (*model.OffboardingReport, *model.AppError)
+}
+
+// MakeWorker returns a worker offboarding the deactivated user given by the job's user_id, the
+// report of the offboarding being kept in the job's data.
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(cfg *model.Config) bool { return true }
+	execute := func(job *model.Job) error {
+		defer jobServer.HandleJobPanic(job)
+
+		userID := job.Data["user_id"]
+		if !model.IsValidId(userID) {
+			return model.NewAppError("UserOffboardingWorker", "user_offboarding.worker.do_job.invalid_user_id", nil, "", http.StatusBadRequest)
+		}
+
+		logger := app.Log().With(mlog.String("job_id", job.Id), mlog.String("user_id", userID))
+
+		reportProgress := func(percent int) {
+			if err := jobServer.SetJobProgress(job, int64(percent)); err != nil {
+				logger.Warn("Worker: Failed to update progress for job", mlog.String("worker", model.JobTypeUserOffboarding), mlog.Err(err))
+			}
+		}
+
+		report, appErr := app.OffboardUser(request.EmptyContext(logger), userID, job.Data["successor_id"], reportProgress)
+		if appErr != nil {
+			logger.Error("Worker: Failed to offboard user", mlog.String("worker", model.JobTypeUserOffboarding), mlog.Err(appErr))
+			return appErr
+		}
+
+		reportJSON, err := json.Marshal(report)
+		if err != nil {
+			return model.NewAppError("UserOffboardingWorker", "user_offboarding.worker.do_job.report", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		job.Data[model.OffboardingJobDataReport] = string(reportJSON)
+		if appErr := jobServer.UpdateInProgressJobData(job); appErr != nil {
+			return appErr
+		}
+		return nil
+	}
+	return jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+}
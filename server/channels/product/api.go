@@ -4,6 +4,7 @@
 package product
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/gorilla/mux"
@@ -24,6 +25,8 @@ import (
 // The service shall be registered via app.RouterKey service key.
 type RouterService interface {
 	RegisterRouter(productID string, sub *mux.Router)
+	// RegisterLocalRouter registers the endpoints only served through the local mode socket.
+	RegisterLocalRouter(productID string, sub *mux.Router)
 }
 
 // PostService provides posts related utilities.  For now, the service implementation
@@ -59,6 +62,26 @@ type PermissionService interface {
 type ClusterService interface {
 	PublishPluginClusterEvent(productID string, ev model.PluginClusterEvent, opts model.PluginClusterEventSendOptions) error
 	PublishWebSocketEvent(productID string, event string, payload map[string]any, broadcast *model.WebsocketBroadcast)
+	NewLeaderElection(productID, name string) (LeaderElection, error)
+}
+
+// LeaderElection elects one of the nodes of the cluster to run a singleton task, e.g. a metrics
+// updater, each node campaigning for the named election of the product. The leadership is kept
+// until resigned, or lost when the leader can't renew it, e.g. after losing access to the
+// database.
+type LeaderElection interface {
+	// ID returns the ID of this node as a candidate of the election.
+	ID() string
+	// Campaign blocks until this node is elected or the context is done.
+	Campaign(ctx context.Context) error
+	// Resign gives up the leadership, letting another node be elected.
+	Resign() error
+	// IsLeader reports whether this node is the leader of the election.
+	IsLeader() bool
+	// Leader returns the ID of the current leader, empty when there is none.
+	Leader() (string, error)
+	// Observe sends the ID of the leader each time it changes, until the context is done.
+	Observe(ctx context.Context) <-chan string
 }
 
 // ChannelService provides channel related API  The service implementation is provided by
@@ -81,6 +104,7 @@ type ChannelService interface {
 	UpdateChannelMemberRoles(channelID, userID, newRoles string) (*model.ChannelMember, *model.AppError)
 	DeleteChannelMember(channelID, userID string) *model.AppError
 	AddChannelMember(channelID, userID string) (*model.ChannelMember, *model.AppError)
+	PatchChannel(channelID string, patch *model.ChannelPatch, userID string) (*model.Channel, *model.AppError)
 }
 
 // LicenseService provides license related utilities.
@@ -114,6 +138,7 @@ type TeamService interface {
 	GetGroup(groupId string) (*model.Group, *model.AppError)
 	GetTeam(teamID string) (*model.Team, *model.AppError)
 	GetGroupMemberUsers(groupID string, page, perPage int) ([]*model.User, *model.AppError)
+	GetGroupsByUserID(userID string) ([]*model.Group, *model.AppError)
 }
 
 // BotService is just a copy implementation of mattermost-plugin-api EnsureBot method.
@@ -262,3 +287,10 @@ type CommandService interface {
 type ThreadsService interface {
 	RegisterCollectionAndTopic(productID string, collectionType, topicType string) error
 }
+
+// ActivityService is the API for adding activities to the activity feeds of the users.
+//
+// The service shall be registered via app.ActivityKey service key.
+type ActivityService interface {
+	AddActivities(activities []*model.Activity) *model.AppError
+}
@@ -8,6 +8,12 @@ type Product interface {
 	Stop() error
 }
 
+// HealthChecker is implemented by the products able to report their health. The health of these
+// products is included in the server health check.
+type HealthChecker interface {
+	HealthCheck() error
+}
+
 type Manifest struct {
 	Initializer  func(map[ServiceKey]any) (Product, error)
 	Dependencies map[ServiceKey]struct{}
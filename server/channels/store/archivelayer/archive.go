@@ -0,0 +1,99 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package archivelayer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"path"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/filestore"
+)
+
+// PostArchive is the archival backend of the posts moved from the database, holding them in
+// immutable segments, e.g. files of a columnar format in an object storage. The database keeps
+// track of the segment holding each post, see store.ArchivedPostStore.
+type PostArchive interface {
+	// WriteSegment writes posts of a channel to a new segment, returning its name.
+	WriteSegment(channelID string, posts []*model.Post) (string, error)
+	// ReadSegment returns the posts of the segment.
+	ReadSegment(segment string) ([]*model.Post, error)
+}
+
+const fileArchiveDir = "posts_archive"
+
+// FileArchive is a PostArchive writing the segments to a file store, as gzipped JSON lines files.
+type FileArchive struct {
+	backend func() (filestore.FileBackend, error)
+}
+
+// NewFileArchive returns an archive writing the segments to the file store returned by backend,
+// called on each access for the file store to follow the changes of the config.
+func NewFileArchive(backend func() (filestore.FileBackend, error)) *FileArchive {
+	return &FileArchive{backend: backend}
+}
+
+func (a *FileArchive) WriteSegment(channelID string, posts []*model.Post) (string, error) {
+	backend, err := a.backend()
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(gz)
+	for _, post := range posts {
+		if err = encoder.Encode(post); err != nil {
+			return "", errors.Wrapf(err, "failed to encode post with id=%s", post.Id)
+		}
+	}
+	if err = gz.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to compress the segment")
+	}
+
+	segment := path.Join(fileArchiveDir, channelID, model.NewId()+".jsonl.gz")
+	if _, err = backend.WriteFile(&buf, segment); err != nil {
+		return "", errors.Wrapf(err, "failed to write the segment %s", segment)
+	}
+
+	return segment, nil
+}
+
+func (a *FileArchive) ReadSegment(segment string) ([]*model.Post, error) {
+	backend, err := a.backend()
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := backend.Reader(segment)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the segment %s", segment)
+	}
+	defer reader.Close()
+
+	gz, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress the segment %s", segment)
+	}
+	defer gz.Close()
+
+	posts := []*model.Post{}
+	decoder := json.NewDecoder(gz)
+	for {
+		var post model.Post
+		if err := decoder.Decode(&post); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode the segment %s", segment)
+		}
+		posts = append(posts, &post)
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package archivelayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/filestore"
+)
+
+func TestFileArchive(t *testing.T) {
+	dir := t.TempDir()
+	archive := NewFileArchive(func() (filestore.FileBackend, error) {
+		return filestore.NewFileBackend(filestore.FileBackendSettings{
+			DriverName: model.ImageDriverLocal,
+			Directory:  dir,
+		})
+	})
+
+	channelID := model.NewId()
+	posts := []*model.Post{
+		{Id: model.NewId(), ChannelId: channelID, Message: "first", CreateAt: 1},
+		{Id: model.NewId(), ChannelId: channelID, Message: "second", CreateAt: 2},
+	}
+
+	segment, err := archive.WriteSegment(channelID, posts)
+	require.NoError(t, err)
+	assert.Contains(t, segment, channelID)
+
+	read, err := archive.ReadSegment(segment)
+	require.NoError(t, err)
+	require.Len(t, read, 2)
+	assert.Equal(t, posts[0].Id, read[0].Id)
+	assert.Equal(t, "second", read[1].Message)
+
+	_, err = archive.ReadSegment(fileArchiveDir + "/missing.jsonl.gz")
+	require.Error(t, err)
+}
+
+func TestArchiveSearch(t *testing.T) {
+	assert.Equal(t, []string{"hello", "big world", "pre"}, parseSearchTerms(`Hello "big world" pre*`))
+
+	post := &model.Post{Id: model.NewId(), ChannelId: model.NewId(), UserId: model.NewId(), Message: "Hello big world", CreateAt: 10}
+
+	t.Run("all terms", func(t *testing.T) {
+		assert.True(t, (&archiveSearch{terms: []string{"hello", "big world"}}).matches(post))
+		assert.False(t, (&archiveSearch{terms: []string{"hello", "moon"}}).matches(post))
+	})
+
+	t.Run("any term", func(t *testing.T) {
+		assert.True(t, (&archiveSearch{terms: []string{"moon", "hello"}, orTerms: true}).matches(post))
+		assert.False(t, (&archiveSearch{terms: []string{"moon"}, orTerms: true}).matches(post))
+	})
+
+	t.Run("excluded terms", func(t *testing.T) {
+		assert.False(t, (&archiveSearch{terms: []string{"hello"}, excludedTerms: []string{"world"}}).matches(post))
+	})
+
+	t.Run("dates", func(t *testing.T) {
+		assert.False(t, (&archiveSearch{terms: []string{"hello"}, after: 11}).matches(post))
+		assert.False(t, (&archiveSearch{terms: []string{"hello"}, before: 10}).matches(post))
+		assert.True(t, (&archiveSearch{terms: []string{"hello"}, after: 10, before: 11}).matches(post))
+	})
+
+	t.Run("channels and users", func(t *testing.T) {
+		assert.False(t, (&archiveSearch{terms: []string{"hello"}, channels: map[string]bool{model.NewId(): true}}).matches(post))
+		assert.True(t, (&archiveSearch{terms: []string{"hello"}, channels: map[string]bool{post.ChannelId: true}}).matches(post))
+		assert.False(t, (&archiveSearch{terms: []string{"hello"}, excludedUsers: map[string]bool{post.UserId: true}}).matches(post))
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package archivelayer
+
+import (
+	"sort"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+// ArchiveStore serves the posts moved from the database to the post archive along with the posts
+// of the database, stitching them together where the posts are fetched or searched. The archived
+// posts are read-only.
+type ArchiveStore struct {
+	store.Store
+	archive PostArchive
+	post    *ArchivePostStore
+}
+
+func New(baseStore store.Store, archive PostArchive) *ArchiveStore {
+	archiveStore := &ArchiveStore{
+		Store:   baseStore,
+		archive: archive,
+	}
+	archiveStore.post = &ArchivePostStore{PostStore: baseStore.Post(), rootStore: archiveStore}
+
+	return archiveStore
+}
+
+func (s *ArchiveStore) Post() store.PostStore {
+	return s.post
+}
+
+// readPosts reads the archived posts from the segments holding them, sorted the newest first.
+func (s *ArchiveStore) readPosts(archived []*model.ArchivedPost) ([]*model.Post, error) {
+	bySegment := make(map[string]map[string]bool)
+	for _, a := range archived {
+		if bySegment[a.Segment] == nil {
+			bySegment[a.Segment] = make(map[string]bool)
+		}
+		bySegment[a.Segment][a.PostId] = true
+	}
+
+	posts := make([]*model.Post, 0, len(archived))
+	for segment, ids := range bySegment {
+		segmentPosts, err := s.archive.ReadSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		for _, post := range segmentPosts {
+			if ids[post.Id] {
+				posts = append(posts, post)
+			}
+		}
+	}
+
+	sortPosts(posts)
+	return posts, nil
+}
+
+// getArchivedPosts returns the archived posts among the posts with the given ids.
+func (s *ArchiveStore) getArchivedPosts(ids []string) ([]*model.Post, error) {
+	archived, err := s.ArchivedPost().GetByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(archived) == 0 {
+		return []*model.Post{}, nil
+	}
+
+	return s.readPosts(archived)
+}
+
+func sortPosts(posts []*model.Post) {
+	sort.Slice(posts, func(i, j int) bool {
+		if posts[i].CreateAt == posts[j].CreateAt {
+			return posts[i].Id > posts[j].Id
+		}
+		return posts[i].CreateAt > posts[j].CreateAt
+	})
+}
@@ -0,0 +1,453 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package archivelayer
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+const (
+	// searchMaxSegments is the number of segments, the most recent first, searched for the posts
+	// matching a search.
+	searchMaxSegments = 50
+	searchMaxResults  = 100
+)
+
+var searchTermRegex = regexp.MustCompile(`"[^"]+"|\S+`)
+
+type ArchivePostStore struct {
+	store.PostStore
+	rootStore *ArchiveStore
+}
+
+func isNotFound(err error) bool {
+	var nfErr *store.ErrNotFound
+	return errors.As(err, &nfErr)
+}
+
+func (s ArchivePostStore) GetSingle(id string, inclDeleted bool) (*model.Post, error) {
+	post, err := s.PostStore.GetSingle(id, inclDeleted)
+	if err == nil || !isNotFound(err) {
+		return post, err
+	}
+
+	archived, archiveErr := s.rootStore.getArchivedPosts([]string{id})
+	if archiveErr != nil {
+		return nil, archiveErr
+	}
+	if len(archived) == 0 || (!inclDeleted && archived[0].DeleteAt != 0) {
+		return nil, err
+	}
+
+	return archived[0], nil
+}
+
+func (s ArchivePostStore) GetPostsByIds(postIds []string) ([]*model.Post, error) {
+	posts, err := s.PostStore.GetPostsByIds(postIds)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+
+	found := make(map[string]bool, len(posts))
+	for _, post := range posts {
+		found[post.Id] = true
+	}
+	missing := []string{}
+	for _, id := range postIds {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return posts, nil
+	}
+
+	archived, archiveErr := s.rootStore.getArchivedPosts(missing)
+	if archiveErr != nil {
+		return nil, archiveErr
+	}
+	if len(posts)+len(archived) == 0 {
+		return nil, err
+	}
+
+	posts = append(posts, archived...)
+	sortPosts(posts)
+	return posts, nil
+}
+
+// Get returns the post along with its thread, stitching the archived posts of the thread with the
+// replies of the database when its root post has been archived.
+func (s ArchivePostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
+	list, err := s.PostStore.Get(ctx, id, opts, userID, sanitizeOptions)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+
+	fromArchive := list == nil
+	if fromArchive {
+		archived, archiveErr := s.rootStore.getArchivedPosts([]string{id})
+		if archiveErr != nil {
+			return nil, archiveErr
+		}
+		if len(archived) == 0 || archived[0].DeleteAt != 0 {
+			return nil, err
+		}
+
+		list = model.NewPostList()
+		list.AddPost(archived[0])
+		list.AddOrder(id)
+	}
+
+	if opts.SkipFetchThreads {
+		return list, nil
+	}
+
+	post := list.Posts[id]
+	rootID := post.RootId
+	if rootID == "" {
+		rootID = post.Id
+	}
+	// The replies of a root post of the database are all in the database too.
+	if _, ok := list.Posts[rootID]; ok && !fromArchive {
+		return list, nil
+	}
+
+	archived, err := s.rootStore.ArchivedPost().GetForThread(rootID)
+	if err != nil {
+		return nil, err
+	}
+	if len(archived) == 0 {
+		return list, nil
+	}
+
+	threadPosts, err := s.rootStore.readPosts(archived)
+	if err != nil {
+		return nil, err
+	}
+	replies, err := s.PostStore.GetPostsByThread(rootID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, threadPost := range append(threadPosts, replies...) {
+		if threadPost.DeleteAt == 0 {
+			list.AddPost(threadPost)
+		}
+	}
+
+	return list, nil
+}
+
+// GetPostsBefore returns the posts of the database before the given post, followed by the
+// archived posts once the database runs out of them. Only the first page of posts before a post is
+// stitched, the following pages of the database being requested before the given post.
+func (s ArchivePostStore) GetPostsBefore(options model.GetPostsOptions, sanitizeOptions map[string]bool) (*model.PostList, error) {
+	list, err := s.PostStore.GetPostsBefore(options, sanitizeOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := options.PerPage - len(list.Order)
+	if missing <= 0 || options.Page > 0 {
+		return list, nil
+	}
+
+	var before int64
+	if len(list.Order) > 0 {
+		before = list.Posts[list.Order[len(list.Order)-1]].CreateAt
+	} else {
+		post, getErr := s.GetSingle(options.PostId, true)
+		if getErr != nil {
+			if isNotFound(getErr) {
+				return list, nil
+			}
+			return nil, getErr
+		}
+		before = post.CreateAt
+	}
+
+	archived, err := s.rootStore.ArchivedPost().GetForChannelBefore(options.ChannelId, before, options.CollapsedThreads, missing)
+	if err != nil {
+		return nil, err
+	}
+	if len(archived) == 0 {
+		return list, nil
+	}
+
+	posts, err := s.rootStore.readPosts(archived)
+	if err != nil {
+		return nil, err
+	}
+
+	rootIDs := []string{}
+	for _, post := range posts {
+		list.AddPost(post)
+		list.AddOrder(post.Id)
+		if post.RootId != "" && !options.CollapsedThreads {
+			rootIDs = append(rootIDs, post.RootId)
+		}
+	}
+
+	// The root posts of the replies are returned along with them.
+	if len(rootIDs) > 0 && !options.SkipFetchThreads {
+		roots, err := s.GetPostsByIds(rootIDs)
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		}
+		for _, root := range roots {
+			if _, ok := list.Posts[root.Id]; !ok {
+				list.AddPost(root)
+			}
+		}
+	}
+
+	return list, nil
+}
+
+func (s ArchivePostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error) {
+	if err := s.checkNotArchived(newPost.Id); err != nil {
+		return nil, err
+	}
+
+	return s.PostStore.Update(newPost, oldPost)
+}
+
+func (s ArchivePostStore) Delete(postID string, timestamp int64, deleteByID string) error {
+	if err := s.checkNotArchived(postID); err != nil {
+		return err
+	}
+
+	return s.PostStore.Delete(postID, timestamp, deleteByID)
+}
+
+func (s ArchivePostStore) checkNotArchived(postID string) error {
+	archived, err := s.rootStore.ArchivedPost().GetByIds([]string{postID})
+	if err != nil {
+		return err
+	}
+	if len(archived) > 0 {
+		return store.NewErrInvalidInput("Post", "Id", postID)
+	}
+
+	return nil
+}
+
+// SearchPostsForUser searches the database, and the archive once the database runs out of posts
+// matching the search. The archived posts only match the terms of a search, with its date, user
+// and channel filters, searching the most recent segments of the channels of the user.
+func (s ArchivePostStore) SearchPostsForUser(ctx context.Context, paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.PostSearchResults, error) {
+	results, err := s.PostStore.SearchPostsForUser(ctx, paramsList, userID, teamID, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := perPage - len(results.Order)
+	if missing <= 0 || page > 0 {
+		return results, nil
+	}
+	if missing > searchMaxResults {
+		missing = searchMaxResults
+	}
+
+	for _, params := range paramsList {
+		posts, err := s.searchArchive(params, userID, teamID, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, post := range posts {
+			if _, ok := results.Posts[post.Id]; ok {
+				continue
+			}
+			results.AddPost(post)
+			results.AddOrder(post.Id)
+			missing--
+		}
+		if missing <= 0 {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// archiveSearch is a search of the archived posts.
+type archiveSearch struct {
+	terms         []string
+	excludedTerms []string
+	orTerms       bool
+	isHashtag     bool
+	after         int64
+	before        int64
+	channels      map[string]bool
+	excludedChans map[string]bool
+	users         map[string]bool
+	excludedUsers map[string]bool
+}
+
+func (s ArchivePostStore) searchArchive(params *model.SearchParams, userID, teamID string, limit int) ([]*model.Post, error) {
+	// The archive doesn't keep the files of the posts to search them by.
+	if len(params.Extensions) > 0 || len(params.FileTypes) > 0 || len(params.Has) > 0 {
+		return nil, nil
+	}
+
+	search, err := s.newArchiveSearch(params, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if search == nil {
+		return nil, nil
+	}
+
+	segments, err := s.rootStore.ArchivedPost().GetSegmentsForUser(userID, teamID, search.after, search.before, searchMaxSegments)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []*model.Post{}
+	for _, segment := range segments {
+		posts, err := s.rootStore.archive.ReadSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		for _, post := range posts {
+			if search.matches(post) {
+				matches = append(matches, post)
+			}
+		}
+		if len(matches) >= limit {
+			break
+		}
+	}
+
+	sortPosts(matches)
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches, nil
+}
+
+// newArchiveSearch returns the search of the archived posts matching the params, or nil when none
+// can match, e.g. when the channels to search in don't exist.
+func (s ArchivePostStore) newArchiveSearch(params *model.SearchParams, teamID string) (*archiveSearch, error) {
+	search := &archiveSearch{
+		terms:         parseSearchTerms(params.Terms),
+		excludedTerms: parseSearchTerms(params.ExcludedTerms),
+		orTerms:       params.OrTerms,
+		isHashtag:     params.IsHashtag,
+		after:         params.GetAfterDateMillis(),
+		before:        params.GetBeforeDateMillis(),
+	}
+	if params.OnDate != "" {
+		search.after, search.before = params.GetOnDateMillis()
+	}
+	if len(search.terms) == 0 {
+		return nil, nil
+	}
+
+	var err error
+	if search.channels, err = s.channelIDs(teamID, params.InChannels); err != nil || (len(params.InChannels) > 0 && len(search.channels) == 0) {
+		return nil, err
+	}
+	if search.excludedChans, err = s.channelIDs(teamID, params.ExcludedChannels); err != nil {
+		return nil, err
+	}
+	if search.users, err = s.userIDs(params.FromUsers); err != nil || (len(params.FromUsers) > 0 && len(search.users) == 0) {
+		return nil, err
+	}
+	if search.excludedUsers, err = s.userIDs(params.ExcludedUsers); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+func (s ArchivePostStore) channelIDs(teamID string, names []string) (map[string]bool, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	channels, err := s.rootStore.Channel().GetByNames(teamID, names, true)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		ids[channel.Id] = true
+	}
+
+	return ids, nil
+}
+
+func (s ArchivePostStore) userIDs(usernames []string) (map[string]bool, error) {
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	users, err := s.rootStore.User().GetProfilesByUsernames(usernames, nil)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]bool, len(users))
+	for _, user := range users {
+		ids[user.Id] = true
+	}
+
+	return ids, nil
+}
+
+// parseSearchTerms splits the terms, keeping the quoted phrases whole, in lower case.
+func parseSearchTerms(terms string) []string {
+	parsed := []string{}
+	for _, term := range searchTermRegex.FindAllString(strings.ToLower(terms), -1) {
+		term = strings.TrimSuffix(strings.Trim(term, `"`), "*")
+		if term != "" {
+			parsed = append(parsed, term)
+		}
+	}
+	return parsed
+}
+
+func (search *archiveSearch) matches(post *model.Post) bool {
+	if post.DeleteAt != 0 || post.IsSystemMessage() {
+		return false
+	}
+	if (search.after > 0 && post.CreateAt < search.after) || (search.before > 0 && post.CreateAt >= search.before) {
+		return false
+	}
+	if (search.channels != nil && !search.channels[post.ChannelId]) || search.excludedChans[post.ChannelId] {
+		return false
+	}
+	if (search.users != nil && !search.users[post.UserId]) || search.excludedUsers[post.UserId] {
+		return false
+	}
+
+	text := strings.ToLower(post.Message)
+	if search.isHashtag {
+		text = strings.ToLower(post.Hashtags)
+	}
+
+	for _, term := range search.excludedTerms {
+		if strings.Contains(text, term) {
+			return false
+		}
+	}
+	for _, term := range search.terms {
+		found := strings.Contains(text, term)
+		if found && search.orTerms {
+			return true
+		}
+		if !found && !search.orTerms {
+			return false
+		}
+	}
+
+	return !search.orTerms
+}
@@ -221,6 +221,7 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		Name:                   "ChannelMemberCounts",
 		DefaultExpiry:          ChannelMembersCountsCacheSec * time.Second,
 		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForChannelMemberCounts,
+		Shared:                 true,
 	}); err != nil {
 		return
 	}
@@ -288,6 +289,7 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForProfileByIds,
 		Striped:                true,
 		StripedBuckets:         maxInt(runtime.NumCPU()-1, 1),
+		Shared:                 true,
 	}); err != nil {
 		return
 	}
@@ -296,6 +298,7 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		Name:                   "ProfilesInChannel",
 		DefaultExpiry:          ProfilesInChannelCacheSec * time.Second,
 		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForProfileInChannel,
+		Shared:                 true,
 	}); err != nil {
 		return
 	}
@@ -398,7 +401,7 @@ func (s LocalCacheStore) DropAllTables() {
 
 func (s *LocalCacheStore) doInvalidateCacheCluster(cache cache.Cache, key string) {
 	cache.Remove(key)
-	if s.cluster != nil {
+	if s.cluster != nil && cache.GetInvalidateClusterEvent() != model.ClusterEventNone {
 		msg := &model.ClusterMessage{
 			Event:    cache.GetInvalidateClusterEvent(),
 			SendType: model.ClusterSendBestEffort,
@@ -428,7 +431,7 @@ func (s *LocalCacheStore) doStandardReadCache(cache cache.Cache, key string, val
 
 func (s *LocalCacheStore) doClearCacheCluster(cache cache.Cache) {
 	cache.Purge()
-	if s.cluster != nil {
+	if s.cluster != nil && cache.GetInvalidateClusterEvent() != model.ClusterEventNone {
 		msg := &model.ClusterMessage{
 			Event:    cache.GetInvalidateClusterEvent(),
 			SendType: model.ClusterSendBestEffort,
@@ -22,39 +22,57 @@ const mySQLDeadlockCode = uint16(1213)
 
 type RetryLayer struct {
 	store.Store
+	ActivityStore             store.ActivityStore
+	ArchivedPostStore         store.ArchivedPostStore
 	AuditStore                store.AuditStore
 	BotStore                  store.BotStore
 	ChannelStore              store.ChannelStore
+	ChannelBookmarkStore      store.ChannelBookmarkStore
+	ChannelJoinRequestStore   store.ChannelJoinRequestStore
+	ChannelMemberExpiryStore  store.ChannelMemberExpiryStore
 	ChannelMemberHistoryStore store.ChannelMemberHistoryStore
 	ClusterDiscoveryStore     store.ClusterDiscoveryStore
 	CommandStore              store.CommandStore
 	CommandWebhookStore       store.CommandWebhookStore
 	ComplianceStore           store.ComplianceStore
+	ConfigChangeStore         store.ConfigChangeStore
 	DraftStore                store.DraftStore
 	EmojiStore                store.EmojiStore
 	FileInfoStore             store.FileInfoStore
 	GroupStore                store.GroupStore
+	GuestSponsorshipStore     store.GuestSponsorshipStore
 	JobStore                  store.JobStore
 	LicenseStore              store.LicenseStore
 	LinkMetadataStore         store.LinkMetadataStore
+	MatrixBridgeStore         store.MatrixBridgeStore
 	NotifyAdminStore          store.NotifyAdminStore
 	OAuthStore                store.OAuthStore
 	PluginStore               store.PluginStore
 	PostStore                 store.PostStore
 	PostAcknowledgementStore  store.PostAcknowledgementStore
+	PostEmbeddingStore        store.PostEmbeddingStore
+	PostExpirationStore       store.PostExpirationStore
 	PostPriorityStore         store.PostPriorityStore
+	PostTranslationStore      store.PostTranslationStore
 	PreferenceStore           store.PreferenceStore
 	ProductNoticesStore       store.ProductNoticesStore
 	ReactionStore             store.ReactionStore
+	ReactionSummaryStore      store.ReactionSummaryStore
+	ReadReceiptStore          store.ReadReceiptStore
 	RemoteClusterStore        store.RemoteClusterStore
 	RetentionPolicyStore      store.RetentionPolicyStore
 	RoleStore                 store.RoleStore
+	RoleSnapshotStore         store.RoleSnapshotStore
+	ScheduledPostStore        store.ScheduledPostStore
 	SchemeStore               store.SchemeStore
 	SessionStore              store.SessionStore
 	SharedChannelStore        store.SharedChannelStore
 	StatusStore               store.StatusStore
+	SupportAccessConsentStore store.SupportAccessConsentStore
 	SystemStore               store.SystemStore
 	TeamStore                 store.TeamStore
+	TeamArchiveStore          store.TeamArchiveStore
+	TeamTemplateStore         store.TeamTemplateStore
 	TermsOfServiceStore       store.TermsOfServiceStore
 	ThreadStore               store.ThreadStore
 	TokenStore                store.TokenStore
@@ -63,9 +81,18 @@ type RetryLayer struct {
 	UserStore                 store.UserStore
 	UserAccessTokenStore      store.UserAccessTokenStore
 	UserTermsOfServiceStore   store.UserTermsOfServiceStore
+	WebAuthnCredentialStore   store.WebAuthnCredentialStore
 	WebhookStore              store.WebhookStore
 }
 
+func (s *RetryLayer) Activity() store.ActivityStore {
+	return s.ActivityStore
+}
+
+func (s *RetryLayer) ArchivedPost() store.ArchivedPostStore {
+	return s.ArchivedPostStore
+}
+
 func (s *RetryLayer) Audit() store.AuditStore {
 	return s.AuditStore
 }
@@ -78,6 +105,18 @@ func (s *RetryLayer) Channel() store.ChannelStore {
 	return s.ChannelStore
 }
 
+func (s *RetryLayer) ChannelBookmark() store.ChannelBookmarkStore {
+	return s.ChannelBookmarkStore
+}
+
+func (s *RetryLayer) ChannelJoinRequest() store.ChannelJoinRequestStore {
+	return s.ChannelJoinRequestStore
+}
+
+func (s *RetryLayer) ChannelMemberExpiry() store.ChannelMemberExpiryStore {
+	return s.ChannelMemberExpiryStore
+}
+
 func (s *RetryLayer) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	return s.ChannelMemberHistoryStore
 }
@@ -98,6 +137,10 @@ func (s *RetryLayer) Compliance() store.ComplianceStore {
 	return s.ComplianceStore
 }
 
+func (s *RetryLayer) ConfigChange() store.ConfigChangeStore {
+	return s.ConfigChangeStore
+}
+
 func (s *RetryLayer) Draft() store.DraftStore {
 	return s.DraftStore
 }
@@ -114,6 +157,10 @@ func (s *RetryLayer) Group() store.GroupStore {
 	return s.GroupStore
 }
 
+func (s *RetryLayer) GuestSponsorship() store.GuestSponsorshipStore {
+	return s.GuestSponsorshipStore
+}
+
 func (s *RetryLayer) Job() store.JobStore {
 	return s.JobStore
 }
@@ -126,6 +173,10 @@ func (s *RetryLayer) LinkMetadata() store.LinkMetadataStore {
 	return s.LinkMetadataStore
 }
 
+func (s *RetryLayer) MatrixBridge() store.MatrixBridgeStore {
+	return s.MatrixBridgeStore
+}
+
 func (s *RetryLayer) NotifyAdmin() store.NotifyAdminStore {
 	return s.NotifyAdminStore
 }
@@ -146,10 +197,22 @@ func (s *RetryLayer) PostAcknowledgement() store.PostAcknowledgementStore {
 	return s.PostAcknowledgementStore
 }
 
+func (s *RetryLayer) PostEmbedding() store.PostEmbeddingStore {
+	return s.PostEmbeddingStore
+}
+
+func (s *RetryLayer) PostExpiration() store.PostExpirationStore {
+	return s.PostExpirationStore
+}
+
 func (s *RetryLayer) PostPriority() store.PostPriorityStore {
 	return s.PostPriorityStore
 }
 
+func (s *RetryLayer) PostTranslation() store.PostTranslationStore {
+	return s.PostTranslationStore
+}
+
 func (s *RetryLayer) Preference() store.PreferenceStore {
 	return s.PreferenceStore
 }
@@ -162,6 +225,14 @@ func (s *RetryLayer) Reaction() store.ReactionStore {
 	return s.ReactionStore
 }
 
+func (s *RetryLayer) ReactionSummary() store.ReactionSummaryStore {
+	return s.ReactionSummaryStore
+}
+
+func (s *RetryLayer) ReadReceipt() store.ReadReceiptStore {
+	return s.ReadReceiptStore
+}
+
 func (s *RetryLayer) RemoteCluster() store.RemoteClusterStore {
 	return s.RemoteClusterStore
 }
@@ -174,6 +245,14 @@ func (s *RetryLayer) Role() store.RoleStore {
 	return s.RoleStore
 }
 
+func (s *RetryLayer) RoleSnapshot() store.RoleSnapshotStore {
+	return s.RoleSnapshotStore
+}
+
+func (s *RetryLayer) ScheduledPost() store.ScheduledPostStore {
+	return s.ScheduledPostStore
+}
+
 func (s *RetryLayer) Scheme() store.SchemeStore {
 	return s.SchemeStore
 }
@@ -190,6 +269,10 @@ func (s *RetryLayer) Status() store.StatusStore {
 	return s.StatusStore
 }
 
+func (s *RetryLayer) SupportAccessConsent() store.SupportAccessConsentStore {
+	return s.SupportAccessConsentStore
+}
+
 func (s *RetryLayer) System() store.SystemStore {
 	return s.SystemStore
 }
@@ -198,6 +281,14 @@ func (s *RetryLayer) Team() store.TeamStore {
 	return s.TeamStore
 }
 
+func (s *RetryLayer) TeamArchive() store.TeamArchiveStore {
+	return s.TeamArchiveStore
+}
+
+func (s *RetryLayer) TeamTemplate() store.TeamTemplateStore {
+	return s.TeamTemplateStore
+}
+
 func (s *RetryLayer) TermsOfService() store.TermsOfServiceStore {
 	return s.TermsOfServiceStore
 }
@@ -230,10 +321,24 @@ func (s *RetryLayer) UserTermsOfService() store.UserTermsOfServiceStore {
 	return s.UserTermsOfServiceStore
 }
 
+func (s *RetryLayer) WebAuthnCredential() store.WebAuthnCredentialStore {
+	return s.WebAuthnCredentialStore
+}
+
 func (s *RetryLayer) Webhook() store.WebhookStore {
 	return s.WebhookStore
 }
 
+type RetryLayerActivityStore struct {
+	store.ActivityStore
+	Root *RetryLayer
+}
+
+type RetryLayerArchivedPostStore struct {
+	store.ArchivedPostStore
+	Root *RetryLayer
+}
+
 type RetryLayerAuditStore struct {
 	store.AuditStore
 	Root *RetryLayer
@@ -249,6 +354,21 @@ type RetryLayerChannelStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerChannelBookmarkStore struct {
+	store.ChannelBookmarkStore
+	Root *RetryLayer
+}
+
+type RetryLayerChannelJoinRequestStore struct {
+	store.ChannelJoinRequestStore
+	Root *RetryLayer
+}
+
+type RetryLayerChannelMemberExpiryStore struct {
+	store.ChannelMemberExpiryStore
+	Root *RetryLayer
+}
+
 type RetryLayerChannelMemberHistoryStore struct {
 	store.ChannelMemberHistoryStore
 	Root *RetryLayer
@@ -274,6 +394,11 @@ type RetryLayerComplianceStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerConfigChangeStore struct {
+	store.ConfigChangeStore
+	Root *RetryLayer
+}
+
 type RetryLayerDraftStore struct {
 	store.DraftStore
 	Root *RetryLayer
@@ -294,6 +419,11 @@ type RetryLayerGroupStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerGuestSponsorshipStore struct {
+	store.GuestSponsorshipStore
+	Root *RetryLayer
+}
+
 type RetryLayerJobStore struct {
 	store.JobStore
 	Root *RetryLayer
@@ -309,6 +439,11 @@ type RetryLayerLinkMetadataStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerMatrixBridgeStore struct {
+	store.MatrixBridgeStore
+	Root *RetryLayer
+}
+
 type RetryLayerNotifyAdminStore struct {
 	store.NotifyAdminStore
 	Root *RetryLayer
@@ -334,11 +469,26 @@ type RetryLayerPostAcknowledgementStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerPostEmbeddingStore struct {
+	store.PostEmbeddingStore
+	Root *RetryLayer
+}
+
+type RetryLayerPostExpirationStore struct {
+	store.PostExpirationStore
+	Root *RetryLayer
+}
+
 type RetryLayerPostPriorityStore struct {
 	store.PostPriorityStore
 	Root *RetryLayer
 }
 
+type RetryLayerPostTranslationStore struct {
+	store.PostTranslationStore
+	Root *RetryLayer
+}
+
 type RetryLayerPreferenceStore struct {
 	store.PreferenceStore
 	Root *RetryLayer
@@ -354,6 +504,16 @@ type RetryLayerReactionStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerReactionSummaryStore struct {
+	store.ReactionSummaryStore
+	Root *RetryLayer
+}
+
+type RetryLayerReadReceiptStore struct {
+	store.ReadReceiptStore
+	Root *RetryLayer
+}
+
 type RetryLayerRemoteClusterStore struct {
 	store.RemoteClusterStore
 	Root *RetryLayer
@@ -369,6 +529,16 @@ type RetryLayerRoleStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerRoleSnapshotStore struct {
+	store.RoleSnapshotStore
+	Root *RetryLayer
+}
+
+type RetryLayerScheduledPostStore struct {
+	store.ScheduledPostStore
+	Root *RetryLayer
+}
+
 type RetryLayerSchemeStore struct {
 	store.SchemeStore
 	Root *RetryLayer
@@ -389,6 +559,11 @@ type RetryLayerStatusStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerSupportAccessConsentStore struct {
+	store.SupportAccessConsentStore
+	Root *RetryLayer
+}
+
 type RetryLayerSystemStore struct {
 	store.SystemStore
 	Root *RetryLayer
@@ -399,6 +574,16 @@ type RetryLayerTeamStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerTeamArchiveStore struct {
+	store.TeamArchiveStore
+	Root *RetryLayer
+}
+
+type RetryLayerTeamTemplateStore struct {
+	store.TeamTemplateStore
+	Root *RetryLayer
+}
+
 type RetryLayerTermsOfServiceStore struct {
 	store.TermsOfServiceStore
 	Root *RetryLayer
@@ -439,6 +624,11 @@ type RetryLayerUserTermsOfServiceStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerWebAuthnCredentialStore struct {
+	store.WebAuthnCredentialStore
+	Root *RetryLayer
+}
+
 type RetryLayerWebhookStore struct {
 	store.WebhookStore
 	Root *RetryLayer
@@ -460,32 +650,11 @@ func isRepeatableError(err error) bool {
 	return false
 }
 
-func (s *RetryLayerAuditStore) Get(user_id string, offset int, limit int) (model.Audits, error) {
-
-	tries := 0
-	for {
-		result, err := s.AuditStore.Get(user_id, offset, limit)
-		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
-		}
-		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
-		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
-	}
-
-}
-
-func (s *RetryLayerAuditStore) PermanentDeleteByUser(userID string) error {
+func (s *RetryLayerActivityStore) DeleteForPost(postID string) error {
 
 	tries := 0
 	for {
-		err := s.AuditStore.PermanentDeleteByUser(userID)
+		err := s.ActivityStore.DeleteForPost(postID)
 		if err == nil {
 			return nil
 		}
@@ -502,32 +671,32 @@ func (s *RetryLayerAuditStore) PermanentDeleteByUser(userID string) error {
 
 }
 
-func (s *RetryLayerAuditStore) Save(audit *model.Audit) error {
+func (s *RetryLayerActivityStore) GetForUser(userID string, options model.ActivityGetOptions) ([]*model.Activity, error) {
 
 	tries := 0
 	for {
-		err := s.AuditStore.Save(audit)
+		result, err := s.ActivityStore.GetForUser(userID, options)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
+func (s *RetryLayerActivityStore) GetUnreadCountForUser(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.BotStore.Get(userID, includeDeleted)
+		result, err := s.ActivityStore.GetUnreadCountForUser(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -544,32 +713,32 @@ func (s *RetryLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot
 
 }
 
-func (s *RetryLayerBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot, error) {
+func (s *RetryLayerActivityStore) MarkAllAsRead(userID string, readAt int64) error {
 
 	tries := 0
 	for {
-		result, err := s.BotStore.GetAll(options)
+		err := s.ActivityStore.MarkAllAsRead(userID, readAt)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerBotStore) PermanentDelete(userID string) error {
+func (s *RetryLayerActivityStore) MarkAsRead(userID string, activityIDs []string, readAt int64) error {
 
 	tries := 0
 	for {
-		err := s.BotStore.PermanentDelete(userID)
+		err := s.ActivityStore.MarkAsRead(userID, activityIDs, readAt)
 		if err == nil {
 			return nil
 		}
@@ -586,53 +755,53 @@ func (s *RetryLayerBotStore) PermanentDelete(userID string) error {
 
 }
 
-func (s *RetryLayerBotStore) Save(bot *model.Bot) (*model.Bot, error) {
+func (s *RetryLayerActivityStore) Save(activities []*model.Activity) error {
 
 	tries := 0
 	for {
-		result, err := s.BotStore.Save(bot)
+		err := s.ActivityStore.Save(activities)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerBotStore) Update(bot *model.Bot) (*model.Bot, error) {
+func (s *RetryLayerArchivedPostStore) Archive(archived []*model.ArchivedPost) error {
 
 	tries := 0
 	for {
-		result, err := s.BotStore.Update(bot)
+		err := s.ArchivedPostStore.Archive(archived)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) AnalyticsDeletedTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
+func (s *RetryLayerArchivedPostStore) GetByIds(postIDs []string) ([]*model.ArchivedPost, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.AnalyticsDeletedTypeCount(teamID, channelType)
+		result, err := s.ArchivedPostStore.GetByIds(postIDs)
 		if err == nil {
 			return result, nil
 		}
@@ -649,11 +818,11 @@ func (s *RetryLayerChannelStore) AnalyticsDeletedTypeCount(teamID string, channe
 
 }
 
-func (s *RetryLayerChannelStore) AnalyticsTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
+func (s *RetryLayerArchivedPostStore) GetForChannelBefore(channelID string, before int64, rootsOnly bool, limit int) ([]*model.ArchivedPost, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.AnalyticsTypeCount(teamID, channelType)
+		result, err := s.ArchivedPostStore.GetForChannelBefore(channelID, before, rootsOnly, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -670,11 +839,11 @@ func (s *RetryLayerChannelStore) AnalyticsTypeCount(teamID string, channelType m
 
 }
 
-func (s *RetryLayerChannelStore) Autocomplete(userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelListWithTeamData, error) {
+func (s *RetryLayerArchivedPostStore) GetForThread(rootID string) ([]*model.ArchivedPost, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.Autocomplete(userID, term, includeDeleted, isGuest)
+		result, err := s.ArchivedPostStore.GetForThread(rootID)
 		if err == nil {
 			return result, nil
 		}
@@ -691,11 +860,11 @@ func (s *RetryLayerChannelStore) Autocomplete(userID string, term string, includ
 
 }
 
-func (s *RetryLayerChannelStore) AutocompleteInTeam(teamID string, userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelList, error) {
+func (s *RetryLayerArchivedPostStore) GetSegmentsForUser(userID string, teamID string, after int64, before int64, limit int) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.AutocompleteInTeam(teamID, userID, term, includeDeleted, isGuest)
+		result, err := s.ArchivedPostStore.GetSegmentsForUser(userID, teamID, after, before, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -712,11 +881,11 @@ func (s *RetryLayerChannelStore) AutocompleteInTeam(teamID string, userID string
 
 }
 
-func (s *RetryLayerChannelStore) AutocompleteInTeamForSearch(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *RetryLayerAuditStore) Get(user_id string, offset int, limit int) (model.Audits, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.AutocompleteInTeamForSearch(teamID, userID, term, includeDeleted)
+		result, err := s.AuditStore.Get(user_id, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -733,11 +902,11 @@ func (s *RetryLayerChannelStore) AutocompleteInTeamForSearch(teamID string, user
 
 }
 
-func (s *RetryLayerChannelStore) ClearAllCustomRoleAssignments() error {
+func (s *RetryLayerAuditStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.ClearAllCustomRoleAssignments()
+		err := s.AuditStore.PermanentDeleteByUser(userID)
 		if err == nil {
 			return nil
 		}
@@ -754,23 +923,11 @@ func (s *RetryLayerChannelStore) ClearAllCustomRoleAssignments() error {
 
 }
 
-func (s *RetryLayerChannelStore) ClearCaches() {
-
-	s.ChannelStore.ClearCaches()
-
-}
-
-func (s *RetryLayerChannelStore) ClearMembersForUserCache() {
-
-	s.ChannelStore.ClearMembersForUserCache()
-
-}
-
-func (s *RetryLayerChannelStore) ClearSidebarOnTeamLeave(userID string, teamID string) error {
+func (s *RetryLayerAuditStore) Save(audit *model.Audit) error {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.ClearSidebarOnTeamLeave(userID, teamID)
+		err := s.AuditStore.Save(audit)
 		if err == nil {
 			return nil
 		}
@@ -787,32 +944,32 @@ func (s *RetryLayerChannelStore) ClearSidebarOnTeamLeave(userID string, teamID s
 
 }
 
-func (s *RetryLayerChannelStore) CountPostsAfter(channelID string, timestamp int64, userID string) (int, int, error) {
+func (s *RetryLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ChannelStore.CountPostsAfter(channelID, timestamp, userID)
+		result, err := s.BotStore.Get(userID, includeDeleted)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) CountUrgentPostsAfter(channelID string, timestamp int64, userID string) (int, error) {
+func (s *RetryLayerBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.CountUrgentPostsAfter(channelID, timestamp, userID)
+		result, err := s.BotStore.GetAll(options)
 		if err == nil {
 			return result, nil
 		}
@@ -829,32 +986,32 @@ func (s *RetryLayerChannelStore) CountUrgentPostsAfter(channelID string, timesta
 
 }
 
-func (s *RetryLayerChannelStore) CreateDirectChannel(userID *model.User, otherUserID *model.User, channelOptions ...model.ChannelOption) (*model.Channel, error) {
+func (s *RetryLayerBotStore) PermanentDelete(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.CreateDirectChannel(userID, otherUserID, channelOptions...)
+		err := s.BotStore.PermanentDelete(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) CreateInitialSidebarCategories(userID string, opts *store.SidebarCategorySearchOpts) (*model.OrderedSidebarCategories, error) {
+func (s *RetryLayerBotStore) Save(bot *model.Bot) (*model.Bot, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.CreateInitialSidebarCategories(userID, opts)
+		result, err := s.BotStore.Save(bot)
 		if err == nil {
 			return result, nil
 		}
@@ -871,11 +1028,11 @@ func (s *RetryLayerChannelStore) CreateInitialSidebarCategories(userID string, o
 
 }
 
-func (s *RetryLayerChannelStore) CreateSidebarCategory(userID string, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, error) {
+func (s *RetryLayerBotStore) Update(bot *model.Bot) (*model.Bot, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.CreateSidebarCategory(userID, teamID, newCategory)
+		result, err := s.BotStore.Update(bot)
 		if err == nil {
 			return result, nil
 		}
@@ -892,74 +1049,74 @@ func (s *RetryLayerChannelStore) CreateSidebarCategory(userID string, teamID str
 
 }
 
-func (s *RetryLayerChannelStore) Delete(channelID string, timestamp int64) error {
+func (s *RetryLayerChannelStore) AnalyticsDeletedTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.Delete(channelID, timestamp)
+		result, err := s.ChannelStore.AnalyticsDeletedTypeCount(teamID, channelType)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) DeleteSidebarCategory(categoryID string) error {
+func (s *RetryLayerChannelStore) AnalyticsTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.DeleteSidebarCategory(categoryID)
+		result, err := s.ChannelStore.AnalyticsTypeCount(teamID, channelType)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) DeleteSidebarChannelsByPreferences(preferences model.Preferences) error {
+func (s *RetryLayerChannelStore) Autocomplete(ctx context.Context, userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelListWithTeamData, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.DeleteSidebarChannelsByPreferences(preferences)
+		result, err := s.ChannelStore.Autocomplete(ctx, userID, term, includeDeleted, isGuest)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) Get(id string, allowFromCache bool) (*model.Channel, error) {
+func (s *RetryLayerChannelStore) AutocompleteInTeam(ctx context.Context, teamID string, userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelList, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.Get(id, allowFromCache)
+		result, err := s.ChannelStore.AutocompleteInTeam(ctx, teamID, userID, term, includeDeleted, isGuest)
 		if err == nil {
 			return result, nil
 		}
@@ -976,11 +1133,11 @@ func (s *RetryLayerChannelStore) Get(id string, allowFromCache bool) (*model.Cha
 
 }
 
-func (s *RetryLayerChannelStore) GetAll(teamID string) ([]*model.Channel, error) {
+func (s *RetryLayerChannelStore) AutocompleteInTeamForSearch(ctx context.Context, teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAll(teamID)
+		result, err := s.ChannelStore.AutocompleteInTeamForSearch(ctx, teamID, userID, term, includeDeleted)
 		if err == nil {
 			return result, nil
 		}
@@ -997,74 +1154,86 @@ func (s *RetryLayerChannelStore) GetAll(teamID string) ([]*model.Channel, error)
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannelMembersById(id string) ([]string, error) {
+func (s *RetryLayerChannelStore) ClearAllCustomRoleAssignments() error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannelMembersById(id)
+		err := s.ChannelStore.ClearAllCustomRoleAssignments()
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannelMembersForUser(userID string, allowFromCache bool, includeDeleted bool) (map[string]string, error) {
+func (s *RetryLayerChannelStore) ClearCaches() {
+
+	s.ChannelStore.ClearCaches()
+
+}
+
+func (s *RetryLayerChannelStore) ClearMembersForUserCache() {
+
+	s.ChannelStore.ClearMembersForUserCache()
+
+}
+
+func (s *RetryLayerChannelStore) ClearSidebarOnTeamLeave(userID string, teamID string) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannelMembersForUser(userID, allowFromCache, includeDeleted)
+		err := s.ChannelStore.ClearSidebarOnTeamLeave(userID, teamID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannelMembersNotifyPropsForChannel(channelID string, allowFromCache bool) (map[string]model.StringMap, error) {
+func (s *RetryLayerChannelStore) CountPostsAfter(channelID string, timestamp int64, userID string) (int, int, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannelMembersNotifyPropsForChannel(channelID, allowFromCache)
+		result, resultVar1, err := s.ChannelStore.CountPostsAfter(channelID, timestamp, userID)
 		if err == nil {
-			return result, nil
+			return result, resultVar1, nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return result, resultVar1, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return result, resultVar1, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannels(page int, perPage int, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, error) {
+func (s *RetryLayerChannelStore) CountUrgentPostsAfter(channelID string, timestamp int64, userID string) (int, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannels(page, perPage, opts)
+		result, err := s.ChannelStore.CountUrgentPostsAfter(channelID, timestamp, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -1081,11 +1250,11 @@ func (s *RetryLayerChannelStore) GetAllChannels(page int, perPage int, opts stor
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannelsCount(opts store.ChannelSearchOpts) (int64, error) {
+func (s *RetryLayerChannelStore) CreateDirectChannel(userID *model.User, otherUserID *model.User, channelOptions ...model.ChannelOption) (*model.Channel, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannelsCount(opts)
+		result, err := s.ChannelStore.CreateDirectChannel(userID, otherUserID, channelOptions...)
 		if err == nil {
 			return result, nil
 		}
@@ -1102,11 +1271,11 @@ func (s *RetryLayerChannelStore) GetAllChannelsCount(opts store.ChannelSearchOpt
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannelsForExportAfter(limit int, afterID string) ([]*model.ChannelForExport, error) {
+func (s *RetryLayerChannelStore) CreateInitialSidebarCategories(userID string, opts *store.SidebarCategorySearchOpts) (*model.OrderedSidebarCategories, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannelsForExportAfter(limit, afterID)
+		result, err := s.ChannelStore.CreateInitialSidebarCategories(userID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -1123,11 +1292,11 @@ func (s *RetryLayerChannelStore) GetAllChannelsForExportAfter(limit int, afterID
 
 }
 
-func (s *RetryLayerChannelStore) GetAllDirectChannelsForExportAfter(limit int, afterID string) ([]*model.DirectChannelForExport, error) {
+func (s *RetryLayerChannelStore) CreateSidebarCategory(userID string, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllDirectChannelsForExportAfter(limit, afterID)
+		result, err := s.ChannelStore.CreateSidebarCategory(userID, teamID, newCategory)
 		if err == nil {
 			return result, nil
 		}
@@ -1144,74 +1313,74 @@ func (s *RetryLayerChannelStore) GetAllDirectChannelsForExportAfter(limit int, a
 
 }
 
-func (s *RetryLayerChannelStore) GetByName(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+func (s *RetryLayerChannelStore) Delete(channelID string, timestamp int64) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetByName(team_id, name, allowFromCache)
+		err := s.ChannelStore.Delete(channelID, timestamp)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetByNameIncludeDeleted(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+func (s *RetryLayerChannelStore) DeleteSidebarCategory(categoryID string) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetByNameIncludeDeleted(team_id, name, allowFromCache)
+		err := s.ChannelStore.DeleteSidebarCategory(categoryID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetByNames(team_id string, names []string, allowFromCache bool) ([]*model.Channel, error) {
+func (s *RetryLayerChannelStore) DeleteSidebarChannelsByPreferences(preferences model.Preferences) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetByNames(team_id, names, allowFromCache)
+		err := s.ChannelStore.DeleteSidebarChannelsByPreferences(preferences)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelCounts(teamID string, userID string) (*model.ChannelCounts, error) {
+func (s *RetryLayerChannelStore) Get(id string, allowFromCache bool) (*model.Channel, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelCounts(teamID, userID)
+		result, err := s.ChannelStore.Get(id, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -1228,11 +1397,11 @@ func (s *RetryLayerChannelStore) GetChannelCounts(teamID string, userID string)
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelMembersForExport(userID string, teamID string) ([]*model.ChannelMemberForExport, error) {
+func (s *RetryLayerChannelStore) GetAll(teamID string) ([]*model.Channel, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelMembersForExport(userID, teamID)
+		result, err := s.ChannelStore.GetAll(teamID)
 		if err == nil {
 			return result, nil
 		}
@@ -1249,11 +1418,11 @@ func (s *RetryLayerChannelStore) GetChannelMembersForExport(userID string, teamI
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelMembersTimezones(channelID string) ([]model.StringMap, error) {
+func (s *RetryLayerChannelStore) GetAllChannelMembersById(id string) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelMembersTimezones(channelID)
+		result, err := s.ChannelStore.GetAllChannelMembersById(id)
 		if err == nil {
 			return result, nil
 		}
@@ -1270,11 +1439,11 @@ func (s *RetryLayerChannelStore) GetChannelMembersTimezones(channelID string) ([
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelUnread(channelID string, userID string) (*model.ChannelUnread, error) {
+func (s *RetryLayerChannelStore) GetAllChannelMembersForUser(userID string, allowFromCache bool, includeDeleted bool) (map[string]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelUnread(channelID, userID)
+		result, err := s.ChannelStore.GetAllChannelMembersForUser(userID, allowFromCache, includeDeleted)
 		if err == nil {
 			return result, nil
 		}
@@ -1291,11 +1460,11 @@ func (s *RetryLayerChannelStore) GetChannelUnread(channelID string, userID strin
 
 }
 
-func (s *RetryLayerChannelStore) GetChannels(teamID string, userID string, opts *model.ChannelSearchOpts) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetAllChannelMembersNotifyPropsForChannel(channelID string, allowFromCache bool) (map[string]model.StringMap, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannels(teamID, userID, opts)
+		result, err := s.ChannelStore.GetAllChannelMembersNotifyPropsForChannel(channelID, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -1312,11 +1481,11 @@ func (s *RetryLayerChannelStore) GetChannels(teamID string, userID string, opts
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsBatchForIndexing(startTime int64, startChannelID string, limit int) ([]*model.Channel, error) {
+func (s *RetryLayerChannelStore) GetAllChannels(page int, perPage int, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsBatchForIndexing(startTime, startChannelID, limit)
+		result, err := s.ChannelStore.GetAllChannels(page, perPage, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -1333,11 +1502,11 @@ func (s *RetryLayerChannelStore) GetChannelsBatchForIndexing(startTime int64, st
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsByIds(channelIds []string, includeDeleted bool) ([]*model.Channel, error) {
+func (s *RetryLayerChannelStore) GetAllChannelsCount(opts store.ChannelSearchOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsByIds(channelIds, includeDeleted)
+		result, err := s.ChannelStore.GetAllChannelsCount(opts)
 		if err == nil {
 			return result, nil
 		}
@@ -1354,11 +1523,11 @@ func (s *RetryLayerChannelStore) GetChannelsByIds(channelIds []string, includeDe
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsByScheme(schemeID string, offset int, limit int) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetAllChannelsForExportAfter(limit int, afterID string) ([]*model.ChannelForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsByScheme(schemeID, offset, limit)
+		result, err := s.ChannelStore.GetAllChannelsForExportAfter(limit, afterID)
 		if err == nil {
 			return result, nil
 		}
@@ -1375,11 +1544,11 @@ func (s *RetryLayerChannelStore) GetChannelsByScheme(schemeID string, offset int
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsByUser(userID string, includeDeleted bool, lastDeleteAt int, pageSize int, fromChannelID string) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetAllDirectChannelsForExportAfter(limit int, afterID string) ([]*model.DirectChannelForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsByUser(userID, includeDeleted, lastDeleteAt, pageSize, fromChannelID)
+		result, err := s.ChannelStore.GetAllDirectChannelsForExportAfter(limit, afterID)
 		if err == nil {
 			return result, nil
 		}
@@ -1396,11 +1565,11 @@ func (s *RetryLayerChannelStore) GetChannelsByUser(userID string, includeDeleted
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsWithCursor(teamId string, userId string, opts *model.ChannelSearchOpts, afterChannelID string) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetByName(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsWithCursor(teamId, userId, opts, afterChannelID)
+		result, err := s.ChannelStore.GetByName(team_id, name, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -1417,11 +1586,11 @@ func (s *RetryLayerChannelStore) GetChannelsWithCursor(teamId string, userId str
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsWithTeamDataByIds(channelIds []string, includeDeleted bool) ([]*model.ChannelWithTeamData, error) {
+func (s *RetryLayerChannelStore) GetByNameIncludeDeleted(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsWithTeamDataByIds(channelIds, includeDeleted)
+		result, err := s.ChannelStore.GetByNameIncludeDeleted(team_id, name, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -1438,11 +1607,11 @@ func (s *RetryLayerChannelStore) GetChannelsWithTeamDataByIds(channelIds []strin
 
 }
 
-func (s *RetryLayerChannelStore) GetDeleted(team_id string, offset int, limit int, userID string) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetByNames(team_id string, names []string, allowFromCache bool) ([]*model.Channel, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetDeleted(team_id, offset, limit, userID)
+		result, err := s.ChannelStore.GetByNames(team_id, names, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -1459,11 +1628,11 @@ func (s *RetryLayerChannelStore) GetDeleted(team_id string, offset int, limit in
 
 }
 
-func (s *RetryLayerChannelStore) GetDeletedByName(team_id string, name string) (*model.Channel, error) {
+func (s *RetryLayerChannelStore) GetChannelCounts(teamID string, userID string) (*model.ChannelCounts, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetDeletedByName(team_id, name)
+		result, err := s.ChannelStore.GetChannelCounts(teamID, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -1480,11 +1649,11 @@ func (s *RetryLayerChannelStore) GetDeletedByName(team_id string, name string) (
 
 }
 
-func (s *RetryLayerChannelStore) GetFileCount(channelID string) (int64, error) {
+func (s *RetryLayerChannelStore) GetChannelMembersForExport(userID string, teamID string) ([]*model.ChannelMemberForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetFileCount(channelID)
+		result, err := s.ChannelStore.GetChannelMembersForExport(userID, teamID)
 		if err == nil {
 			return result, nil
 		}
@@ -1501,11 +1670,11 @@ func (s *RetryLayerChannelStore) GetFileCount(channelID string) (int64, error) {
 
 }
 
-func (s *RetryLayerChannelStore) GetForPost(postID string) (*model.Channel, error) {
+func (s *RetryLayerChannelStore) GetChannelMembersTimezones(channelID string) ([]model.StringMap, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetForPost(postID)
+		result, err := s.ChannelStore.GetChannelMembersTimezones(channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -1522,11 +1691,11 @@ func (s *RetryLayerChannelStore) GetForPost(postID string) (*model.Channel, erro
 
 }
 
-func (s *RetryLayerChannelStore) GetGuestCount(channelID string, allowFromCache bool) (int64, error) {
+func (s *RetryLayerChannelStore) GetChannelPinnedPosts(channelID string) (*model.ChannelPinnedPosts, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetGuestCount(channelID, allowFromCache)
+		result, err := s.ChannelStore.GetChannelPinnedPosts(channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -1543,11 +1712,11 @@ func (s *RetryLayerChannelStore) GetGuestCount(channelID string, allowFromCache
 
 }
 
-func (s *RetryLayerChannelStore) GetMany(ids []string, allowFromCache bool) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetChannelUnread(channelID string, userID string) (*model.ChannelUnread, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMany(ids, allowFromCache)
+		result, err := s.ChannelStore.GetChannelUnread(channelID, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -1564,11 +1733,11 @@ func (s *RetryLayerChannelStore) GetMany(ids []string, allowFromCache bool) (mod
 
 }
 
-func (s *RetryLayerChannelStore) GetMember(ctx context.Context, channelID string, userID string) (*model.ChannelMember, error) {
+func (s *RetryLayerChannelStore) GetChannels(teamID string, userID string, opts *model.ChannelSearchOpts) (model.ChannelList, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMember(ctx, channelID, userID)
+		result, err := s.ChannelStore.GetChannels(teamID, userID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -1585,11 +1754,11 @@ func (s *RetryLayerChannelStore) GetMember(ctx context.Context, channelID string
 
 }
 
-func (s *RetryLayerChannelStore) GetMemberCount(channelID string, allowFromCache bool) (int64, error) {
+func (s *RetryLayerChannelStore) GetChannelsBatchForIndexing(startTime int64, startChannelID string, limit int) ([]*model.Channel, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMemberCount(channelID, allowFromCache)
+		result, err := s.ChannelStore.GetChannelsBatchForIndexing(startTime, startChannelID, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -1606,17 +1775,11 @@ func (s *RetryLayerChannelStore) GetMemberCount(channelID string, allowFromCache
 
 }
 
-func (s *RetryLayerChannelStore) GetMemberCountFromCache(channelID string) int64 {
-
-	return s.ChannelStore.GetMemberCountFromCache(channelID)
-
-}
-
-func (s *RetryLayerChannelStore) GetMemberCountsByGroup(ctx context.Context, channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, error) {
+func (s *RetryLayerChannelStore) GetChannelsByIds(channelIds []string, includeDeleted bool) ([]*model.Channel, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMemberCountsByGroup(ctx, channelID, includeTimezones)
+		result, err := s.ChannelStore.GetChannelsByIds(channelIds, includeDeleted)
 		if err == nil {
 			return result, nil
 		}
@@ -1633,11 +1796,11 @@ func (s *RetryLayerChannelStore) GetMemberCountsByGroup(ctx context.Context, cha
 
 }
 
-func (s *RetryLayerChannelStore) GetMemberForPost(postID string, userID string) (*model.ChannelMember, error) {
+func (s *RetryLayerChannelStore) GetChannelsByScheme(schemeID string, offset int, limit int) (model.ChannelList, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMemberForPost(postID, userID)
+		result, err := s.ChannelStore.GetChannelsByScheme(schemeID, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -1654,11 +1817,11 @@ func (s *RetryLayerChannelStore) GetMemberForPost(postID string, userID string)
 
 }
 
-func (s *RetryLayerChannelStore) GetMembers(channelID string, offset int, limit int) (model.ChannelMembers, error) {
+func (s *RetryLayerChannelStore) GetChannelsByUser(userID string, includeDeleted bool, lastDeleteAt int, pageSize int, fromChannelID string) (model.ChannelList, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembers(channelID, offset, limit)
+		result, err := s.ChannelStore.GetChannelsByUser(userID, includeDeleted, lastDeleteAt, pageSize, fromChannelID)
 		if err == nil {
 			return result, nil
 		}
@@ -1675,11 +1838,11 @@ func (s *RetryLayerChannelStore) GetMembers(channelID string, offset int, limit
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersByChannelIds(channelIds []string, userID string) (model.ChannelMembers, error) {
+func (s *RetryLayerChannelStore) GetChannelsWithCursor(teamId string, userId string, opts *model.ChannelSearchOpts, afterChannelID string) (model.ChannelList, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersByChannelIds(channelIds, userID)
+		result, err := s.ChannelStore.GetChannelsWithCursor(teamId, userId, opts, afterChannelID)
 		if err == nil {
 			return result, nil
 		}
@@ -1696,11 +1859,11 @@ func (s *RetryLayerChannelStore) GetMembersByChannelIds(channelIds []string, use
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersByIds(channelID string, userIds []string) (model.ChannelMembers, error) {
+func (s *RetryLayerChannelStore) GetChannelsWithTeamDataByIds(channelIds []string, includeDeleted bool) ([]*model.ChannelWithTeamData, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersByIds(channelID, userIds)
+		result, err := s.ChannelStore.GetChannelsWithTeamDataByIds(channelIds, includeDeleted)
 		if err == nil {
 			return result, nil
 		}
@@ -1717,11 +1880,11 @@ func (s *RetryLayerChannelStore) GetMembersByIds(channelID string, userIds []str
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersForUser(teamID string, userID string) (model.ChannelMembers, error) {
+func (s *RetryLayerChannelStore) GetCounters(channelID string) (*model.ChannelCounters, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersForUser(teamID, userID)
+		result, err := s.ChannelStore.GetCounters(channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -1738,11 +1901,11 @@ func (s *RetryLayerChannelStore) GetMembersForUser(teamID string, userID string)
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersForUserWithCursor(userID string, teamID string, opts *store.ChannelMemberGraphQLSearchOpts) (model.ChannelMembers, error) {
+func (s *RetryLayerChannelStore) GetDeleted(team_id string, offset int, limit int, userID string) (model.ChannelList, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersForUserWithCursor(userID, teamID, opts)
+		result, err := s.ChannelStore.GetDeleted(team_id, offset, limit, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -1759,11 +1922,11 @@ func (s *RetryLayerChannelStore) GetMembersForUserWithCursor(userID string, team
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersForUserWithPagination(userID string, page int, perPage int) (model.ChannelMembersWithTeamData, error) {
+func (s *RetryLayerChannelStore) GetDeletedByName(team_id string, name string) (*model.Channel, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersForUserWithPagination(userID, page, perPage)
+		result, err := s.ChannelStore.GetDeletedByName(team_id, name)
 		if err == nil {
 			return result, nil
 		}
@@ -1780,11 +1943,11 @@ func (s *RetryLayerChannelStore) GetMembersForUserWithPagination(userID string,
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersInfoByChannelIds(channelIDs []string) (map[string][]*model.User, error) {
+func (s *RetryLayerChannelStore) GetFileCount(channelID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersInfoByChannelIds(channelIDs)
+		result, err := s.ChannelStore.GetFileCount(channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -1801,11 +1964,11 @@ func (s *RetryLayerChannelStore) GetMembersInfoByChannelIds(channelIDs []string)
 
 }
 
-func (s *RetryLayerChannelStore) GetMoreChannels(teamID string, userID string, offset int, limit int) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetForPost(postID string) (*model.Channel, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMoreChannels(teamID, userID, offset, limit)
+		result, err := s.ChannelStore.GetForPost(postID)
 		if err == nil {
 			return result, nil
 		}
@@ -1822,11 +1985,11 @@ func (s *RetryLayerChannelStore) GetMoreChannels(teamID string, userID string, o
 
 }
 
-func (s *RetryLayerChannelStore) GetPinnedPostCount(channelID string, allowFromCache bool) (int64, error) {
+func (s *RetryLayerChannelStore) GetGuestCount(channelID string, allowFromCache bool) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetPinnedPostCount(channelID, allowFromCache)
+		result, err := s.ChannelStore.GetGuestCount(channelID, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -1843,11 +2006,11 @@ func (s *RetryLayerChannelStore) GetPinnedPostCount(channelID string, allowFromC
 
 }
 
-func (s *RetryLayerChannelStore) GetPinnedPosts(channelID string) (*model.PostList, error) {
+func (s *RetryLayerChannelStore) GetInboxChannelsForUser(userID string, options model.GetInboxOptions) ([]*model.InboxItem, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetPinnedPosts(channelID)
+		result, err := s.ChannelStore.GetInboxChannelsForUser(userID, options)
 		if err == nil {
 			return result, nil
 		}
@@ -1864,11 +2027,11 @@ func (s *RetryLayerChannelStore) GetPinnedPosts(channelID string) (*model.PostLi
 
 }
 
-func (s *RetryLayerChannelStore) GetPrivateChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetMany(ids []string, allowFromCache bool) (model.ChannelList, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetPrivateChannelsForTeam(teamID, offset, limit)
+		result, err := s.ChannelStore.GetMany(ids, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -1885,11 +2048,11 @@ func (s *RetryLayerChannelStore) GetPrivateChannelsForTeam(teamID string, offset
 
 }
 
-func (s *RetryLayerChannelStore) GetPublicChannelsByIdsForTeam(teamID string, channelIds []string) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetMember(ctx context.Context, channelID string, userID string) (*model.ChannelMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetPublicChannelsByIdsForTeam(teamID, channelIds)
+		result, err := s.ChannelStore.GetMember(ctx, channelID, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -1906,11 +2069,11 @@ func (s *RetryLayerChannelStore) GetPublicChannelsByIdsForTeam(teamID string, ch
 
 }
 
-func (s *RetryLayerChannelStore) GetPublicChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetMemberCount(channelID string, allowFromCache bool) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetPublicChannelsForTeam(teamID, offset, limit)
+		result, err := s.ChannelStore.GetMemberCount(channelID, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -1927,11 +2090,17 @@ func (s *RetryLayerChannelStore) GetPublicChannelsForTeam(teamID string, offset
 
 }
 
-func (s *RetryLayerChannelStore) GetSidebarCategories(userID string, opts *store.SidebarCategorySearchOpts) (*model.OrderedSidebarCategories, error) {
+func (s *RetryLayerChannelStore) GetMemberCountFromCache(channelID string) int64 {
+
+	return s.ChannelStore.GetMemberCountFromCache(channelID)
+
+}
+
+func (s *RetryLayerChannelStore) GetMemberCountsByGroup(ctx context.Context, channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetSidebarCategories(userID, opts)
+		result, err := s.ChannelStore.GetMemberCountsByGroup(ctx, channelID, includeTimezones)
 		if err == nil {
 			return result, nil
 		}
@@ -1948,11 +2117,11 @@ func (s *RetryLayerChannelStore) GetSidebarCategories(userID string, opts *store
 
 }
 
-func (s *RetryLayerChannelStore) GetSidebarCategoriesForTeamForUser(userID string, teamID string) (*model.OrderedSidebarCategories, error) {
+func (s *RetryLayerChannelStore) GetMemberForPost(postID string, userID string) (*model.ChannelMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetSidebarCategoriesForTeamForUser(userID, teamID)
+		result, err := s.ChannelStore.GetMemberForPost(postID, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -1969,11 +2138,11 @@ func (s *RetryLayerChannelStore) GetSidebarCategoriesForTeamForUser(userID strin
 
 }
 
-func (s *RetryLayerChannelStore) GetSidebarCategory(categoryID string) (*model.SidebarCategoryWithChannels, error) {
+func (s *RetryLayerChannelStore) GetMembers(channelID string, offset int, limit int) (model.ChannelMembers, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetSidebarCategory(categoryID)
+		result, err := s.ChannelStore.GetMembers(channelID, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -1990,11 +2159,11 @@ func (s *RetryLayerChannelStore) GetSidebarCategory(categoryID string) (*model.S
 
 }
 
-func (s *RetryLayerChannelStore) GetSidebarCategoryOrder(userID string, teamID string) ([]string, error) {
+func (s *RetryLayerChannelStore) GetMembersByChannelIds(channelIds []string, userID string) (model.ChannelMembers, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetSidebarCategoryOrder(userID, teamID)
+		result, err := s.ChannelStore.GetMembersByChannelIds(channelIds, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -2011,11 +2180,11 @@ func (s *RetryLayerChannelStore) GetSidebarCategoryOrder(userID string, teamID s
 
 }
 
-func (s *RetryLayerChannelStore) GetTeamChannels(teamID string) (model.ChannelList, error) {
+func (s *RetryLayerChannelStore) GetMembersByIds(channelID string, userIds []string) (model.ChannelMembers, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetTeamChannels(teamID)
+		result, err := s.ChannelStore.GetMembersByIds(channelID, userIds)
 		if err == nil {
 			return result, nil
 		}
@@ -2032,11 +2201,11 @@ func (s *RetryLayerChannelStore) GetTeamChannels(teamID string) (model.ChannelLi
 
 }
 
-func (s *RetryLayerChannelStore) GetTeamForChannel(channelID string) (*model.Team, error) {
+func (s *RetryLayerChannelStore) GetMembersForUser(teamID string, userID string) (model.ChannelMembers, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetTeamForChannel(channelID)
+		result, err := s.ChannelStore.GetMembersForUser(teamID, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -2053,11 +2222,11 @@ func (s *RetryLayerChannelStore) GetTeamForChannel(channelID string) (*model.Tea
 
 }
 
-func (s *RetryLayerChannelStore) GetTeamMembersForChannel(channelID string) ([]string, error) {
+func (s *RetryLayerChannelStore) GetMembersForUserWithCursor(userID string, teamID string, opts *store.ChannelMemberGraphQLSearchOpts) (model.ChannelMembers, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetTeamMembersForChannel(channelID)
+		result, err := s.ChannelStore.GetMembersForUserWithCursor(userID, teamID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -2074,11 +2243,11 @@ func (s *RetryLayerChannelStore) GetTeamMembersForChannel(channelID string) ([]s
 
 }
 
-func (s *RetryLayerChannelStore) GetTopChannelsForTeamSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopChannelList, error) {
+func (s *RetryLayerChannelStore) GetMembersForUserWithPagination(userID string, page int, perPage int) (model.ChannelMembersWithTeamData, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetTopChannelsForTeamSince(teamID, userID, since, offset, limit)
+		result, err := s.ChannelStore.GetMembersForUserWithPagination(userID, page, perPage)
 		if err == nil {
 			return result, nil
 		}
@@ -2095,11 +2264,11 @@ func (s *RetryLayerChannelStore) GetTopChannelsForTeamSince(teamID string, userI
 
 }
 
-func (s *RetryLayerChannelStore) GetTopChannelsForUserSince(userID string, teamID string, since int64, offset int, limit int) (*model.TopChannelList, error) {
+func (s *RetryLayerChannelStore) GetMembersInfoByChannelIds(channelIDs []string) (map[string][]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetTopChannelsForUserSince(userID, teamID, since, offset, limit)
+		result, err := s.ChannelStore.GetMembersInfoByChannelIds(channelIDs)
 		if err == nil {
 			return result, nil
 		}
@@ -2116,7 +2285,322 @@ func (s *RetryLayerChannelStore) GetTopChannelsForUserSince(userID string, teamI
 
 }
 
-func (s *RetryLayerChannelStore) GetTopInactiveChannelsForTeamSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopInactiveChannelList, error) {
+func (s *RetryLayerChannelStore) GetMoreChannels(teamID string, userID string, offset int, limit int) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetMoreChannels(teamID, userID, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetPinnedPostCount(channelID string, allowFromCache bool) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetPinnedPostCount(channelID, allowFromCache)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetPinnedPosts(channelID string) (*model.PostList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetPinnedPosts(channelID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetPrivateChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetPrivateChannelsForTeam(teamID, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetPublicChannelsByIdsForTeam(teamID string, channelIds []string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetPublicChannelsByIdsForTeam(teamID, channelIds)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetPublicChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetPublicChannelsForTeam(teamID, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetSidebarCategories(userID string, opts *store.SidebarCategorySearchOpts) (*model.OrderedSidebarCategories, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetSidebarCategories(userID, opts)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetSidebarCategoriesForTeamForUser(userID string, teamID string) (*model.OrderedSidebarCategories, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetSidebarCategoriesForTeamForUser(userID, teamID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetSidebarCategory(categoryID string) (*model.SidebarCategoryWithChannels, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetSidebarCategory(categoryID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetSidebarCategoryOrder(userID string, teamID string) ([]string, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetSidebarCategoryOrder(userID, teamID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetTeamChannels(teamID string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetTeamChannels(teamID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetTeamForChannel(channelID string) (*model.Team, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetTeamForChannel(channelID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetTeamMembersForChannel(channelID string) ([]string, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetTeamMembersForChannel(channelID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetTopChannelsForTeamSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetTopChannelsForTeamSince(teamID, userID, since, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetTopChannelsForUserSince(userID string, teamID string, since int64, offset int, limit int) (*model.TopChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetTopChannelsForUserSince(userID, teamID, since, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetTopInactiveChannelsForTeamSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopInactiveChannelList, error) {
 
 	tries := 0
 	for {
@@ -2137,11 +2621,2507 @@ func (s *RetryLayerChannelStore) GetTopInactiveChannelsForTeamSince(teamID strin
 
 }
 
-func (s *RetryLayerChannelStore) GetTopInactiveChannelsForUserSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopInactiveChannelList, error) {
+func (s *RetryLayerChannelStore) GetTopInactiveChannelsForUserSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopInactiveChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetTopInactiveChannelsForUserSince(teamID, userID, since, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GroupSyncedChannelCount() (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GroupSyncedChannelCount()
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) IncrementMentionCount(channelID string, userIDs []string, isRoot bool, isUrgent bool) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.IncrementMentionCount(channelID, userIDs, isRoot, isUrgent)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateAllChannelMembersForUser(userID string) {
+
+	s.ChannelStore.InvalidateAllChannelMembersForUser(userID)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateCacheForChannelMembersNotifyProps(channelID string) {
+
+	s.ChannelStore.InvalidateCacheForChannelMembersNotifyProps(channelID)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateChannel(id string) {
+
+	s.ChannelStore.InvalidateChannel(id)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateChannelByName(teamID string, name string) {
+
+	s.ChannelStore.InvalidateChannelByName(teamID, name)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateGuestCount(channelID string) {
+
+	s.ChannelStore.InvalidateGuestCount(channelID)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateMemberCount(channelID string) {
+
+	s.ChannelStore.InvalidateMemberCount(channelID)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidatePinnedPostCount(channelID string) {
+
+	s.ChannelStore.InvalidatePinnedPostCount(channelID)
+
+}
+
+func (s *RetryLayerChannelStore) IsUserInChannelUseCache(userID string, channelID string) bool {
+
+	return s.ChannelStore.IsUserInChannelUseCache(userID, channelID)
+
+}
+
+func (s *RetryLayerChannelStore) MigrateChannelMembers(fromChannelID string, fromUserID string) (map[string]string, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.MigrateChannelMembers(fromChannelID, fromUserID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) PermanentDelete(channelID string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.PermanentDelete(channelID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) PermanentDeleteByTeam(teamID string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.PermanentDeleteByTeam(teamID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) PermanentDeleteMembersByChannel(channelID string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.PermanentDeleteMembersByChannel(channelID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) PermanentDeleteMembersByUser(userID string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.PermanentDeleteMembersByUser(userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) PostCountsByDuration(channelIDs []string, sinceUnixMillis int64, userID *string, duration model.PostCountGrouping, groupingLocation *time.Location) ([]*model.DurationPostCount, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.PostCountsByDuration(channelIDs, sinceUnixMillis, userID, duration, groupingLocation)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) ReconcileCounters(afterChannelID string, limit int) (string, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.ReconcileCounters(afterChannelID, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) RemoveAllDeactivatedMembers(channelID string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.RemoveAllDeactivatedMembers(channelID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) RemoveMember(channelID string, userID string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.RemoveMember(channelID, userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) RemoveMembers(channelID string, userIds []string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.RemoveMembers(channelID, userIds)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) ResetAllChannelSchemes() error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.ResetAllChannelSchemes()
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) Restore(channelID string, timestamp int64) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.Restore(channelID, timestamp)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.Save(channel, maxChannelsPerTeam)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SaveChannelPinnedPosts(pinned *model.ChannelPinnedPosts) (*model.ChannelPinnedPosts, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.SaveChannelPinnedPosts(pinned)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SaveDirectChannel(channel *model.Channel, member1 *model.ChannelMember, member2 *model.ChannelMember) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.SaveDirectChannel(channel, member1, member2)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SaveMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.SaveMember(member)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.SaveMultipleMembers(members)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchAllChannels(ctx context.Context, term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
+
+	tries := 0
+	for {
+		result, resultVar1, err := s.ChannelStore.SearchAllChannels(ctx, term, opts)
+		if err == nil {
+			return result, resultVar1, nil
+		}
+		if !isRepeatableError(err) {
+			return result, resultVar1, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, resultVar1, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchArchivedInTeam(ctx context.Context, teamID string, term string, userID string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.SearchArchivedInTeam(ctx, teamID, term, userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchForUserInTeam(ctx context.Context, userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.SearchForUserInTeam(ctx, userID, teamID, term, includeDeleted)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchGroupChannels(ctx context.Context, userID string, term string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.SearchGroupChannels(ctx, userID, term)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchInTeam(ctx context.Context, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.SearchInTeam(ctx, teamID, term, includeDeleted)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchMore(userID string, teamID string, term string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.SearchMore(userID, teamID, term)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SetDeleteAt(channelID string, deleteAt int64, updateAt int64) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.SetDeleteAt(channelID, deleteAt, updateAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SetShared(channelId string, shared bool) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.SetShared(channelId, shared)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) Update(channel *model.Channel) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.Update(channel)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateLastViewedAt(channelIds []string, userID string) (map[string]int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.UpdateLastViewedAt(channelIds, userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID string, mentionCount int, mentionCountRoot int, urgentMentionCount int, setUnreadCountRoot bool) (*model.ChannelUnreadAt, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.UpdateLastViewedAtPost(unreadPost, userID, mentionCount, mentionCountRoot, urgentMentionCount, setUnreadCountRoot)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.UpdateMember(member)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateMemberNotifyProps(channelID string, userID string, props map[string]string) (*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.UpdateMemberNotifyProps(channelID, userID, props)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateMembersRole(channelID string, userIDs []string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.UpdateMembersRole(channelID, userIDs)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.UpdateMultipleMembers(members)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateSidebarCategories(userID string, teamID string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, []*model.SidebarCategoryWithChannels, error) {
+
+	tries := 0
+	for {
+		result, resultVar1, err := s.ChannelStore.UpdateSidebarCategories(userID, teamID, categories)
+		if err == nil {
+			return result, resultVar1, nil
+		}
+		if !isRepeatableError(err) {
+			return result, resultVar1, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, resultVar1, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateSidebarCategoryOrder(userID string, teamID string, categoryOrder []string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.UpdateSidebarCategoryOrder(userID, teamID, categoryOrder)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateSidebarChannelCategoryOnMove(channel *model.Channel, newTeamID string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.UpdateSidebarChannelCategoryOnMove(channel, newTeamID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateSidebarChannelsByPreferences(preferences model.Preferences) error {
+
+	tries := 0
+	for {
+		err := s.ChannelStore.UpdateSidebarChannelsByPreferences(preferences)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UserBelongsToChannels(userID string, channelIds []string) (bool, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.UserBelongsToChannels(userID, channelIds)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelBookmarkStore) Delete(id string, deleteAt int64) error {
+
+	tries := 0
+	for {
+		err := s.ChannelBookmarkStore.Delete(id, deleteAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelBookmarkStore) Get(id string, includeDeleted bool) (*model.ChannelBookmark, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelBookmarkStore.Get(id, includeDeleted)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelBookmarkStore) GetBookmarksForChannel(channelID string) ([]*model.ChannelBookmark, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelBookmarkStore.GetBookmarksForChannel(channelID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelBookmarkStore) Save(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelBookmarkStore.Save(bookmark)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelBookmarkStore) Update(bookmark *model.ChannelBookmark) error {
+
+	tries := 0
+	for {
+		err := s.ChannelBookmarkStore.Update(bookmark)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelBookmarkStore) UpdateSortOrder(channelID string, bookmarkIDs []string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelBookmarkStore.UpdateSortOrder(channelID, bookmarkIDs)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelJoinRequestStore) Get(id string) (*model.ChannelJoinRequest, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelJoinRequestStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelJoinRequestStore) GetForChannel(channelID string, status string, offset int, limit int) ([]*model.ChannelJoinRequest, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelJoinRequestStore.GetForChannel(channelID, status, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelJoinRequestStore) GetPending(channelID string, userID string) (*model.ChannelJoinRequest, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelJoinRequestStore.GetPending(channelID, userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelJoinRequestStore) PermanentDeleteByUser(userID string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelJoinRequestStore.PermanentDeleteByUser(userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelJoinRequestStore) Save(request *model.ChannelJoinRequest) (*model.ChannelJoinRequest, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelJoinRequestStore.Save(request)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelJoinRequestStore) Update(request *model.ChannelJoinRequest) (*model.ChannelJoinRequest, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelJoinRequestStore.Update(request)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberExpiryStore) Delete(channelID string, userID string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelMemberExpiryStore.Delete(channelID, userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberExpiryStore) Get(channelID string, userID string) (*model.ChannelMemberExpiry, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelMemberExpiryStore.Get(channelID, userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberExpiryStore) GetExpired(now int64, limit int) ([]*model.ChannelMemberExpiry, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelMemberExpiryStore.GetExpired(now, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberExpiryStore) GetForChannel(channelID string) ([]*model.ChannelMemberExpiry, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelMemberExpiryStore.GetForChannel(channelID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberExpiryStore) PermanentDeleteByUser(userID string) error {
+
+	tries := 0
+	for {
+		err := s.ChannelMemberExpiryStore.PermanentDeleteByUser(userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberExpiryStore) Save(expiry *model.ChannelMemberExpiry) (*model.ChannelMemberExpiry, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelMemberExpiryStore.Save(expiry)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelMemberHistoryStore.DeleteOrphanedRows(limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since int64) ([]string, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelMemberHistoryStore.GetChannelsLeftSince(userID, since)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) LogJoinEvent(userID string, channelID string, joinTime int64) error {
+
+	tries := 0
+	for {
+		err := s.ChannelMemberHistoryStore.LogJoinEvent(userID, channelID, joinTime)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) LogLeaveEvent(userID string, channelID string, leaveTime int64) error {
+
+	tries := 0
+	for {
+		err := s.ChannelMemberHistoryStore.LogLeaveEvent(userID, channelID, leaveTime)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+
+	tries := 0
+	for {
+		result, resultVar1, err := s.ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+		if err == nil {
+			return result, resultVar1, nil
+		}
+		if !isRepeatableError(err) {
+			return result, resultVar1, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, resultVar1, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) Cleanup() error {
+
+	tries := 0
+	for {
+		err := s.ClusterDiscoveryStore.Cleanup()
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
+
+	tries := 0
+	for {
+		result, err := s.ClusterDiscoveryStore.Delete(discovery)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
+
+	tries := 0
+	for {
+		result, err := s.ClusterDiscoveryStore.Exists(discovery)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
+
+	tries := 0
+	for {
+		result, err := s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
+
+	tries := 0
+	for {
+		err := s.ClusterDiscoveryStore.Save(discovery)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
+
+	tries := 0
+	for {
+		err := s.ClusterDiscoveryStore.SetLastPingAt(discovery)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.CommandStore.AnalyticsCommandCount(teamID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandStore) Delete(commandID string, timestamp int64) error {
+
+	tries := 0
+	for {
+		err := s.CommandStore.Delete(commandID, timestamp)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandStore) Get(id string) (*model.Command, error) {
+
+	tries := 0
+	for {
+		result, err := s.CommandStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandStore) GetByCreator(creatorID string) ([]*model.Command, error) {
+
+	tries := 0
+	for {
+		result, err := s.CommandStore.GetByCreator(creatorID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, error) {
+
+	tries := 0
+	for {
+		result, err := s.CommandStore.GetByTeam(teamID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandStore) GetByTrigger(teamID string, trigger string) (*model.Command, error) {
+
+	tries := 0
+	for {
+		result, err := s.CommandStore.GetByTrigger(teamID, trigger)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
+
+	tries := 0
+	for {
+		err := s.CommandStore.PermanentDeleteByTeam(teamID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandStore) PermanentDeleteByUser(userID string) error {
+
+	tries := 0
+	for {
+		err := s.CommandStore.PermanentDeleteByUser(userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
+
+	tries := 0
+	for {
+		result, err := s.CommandStore.Save(webhook)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
+
+	tries := 0
+	for {
+		result, err := s.CommandStore.Update(hook)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandWebhookStore) Cleanup() {
+
+	s.CommandWebhookStore.Cleanup()
+
+}
+
+func (s *RetryLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, error) {
+
+	tries := 0
+	for {
+		result, err := s.CommandWebhookStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, error) {
+
+	tries := 0
+	for {
+		result, err := s.CommandWebhookStore.Save(webhook)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCommandWebhookStore) TryUse(id string, limit int) error {
+
+	tries := 0
+	for {
+		err := s.CommandWebhookStore.TryUse(id, limit)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) ComplianceExport(compliance *model.Compliance, cursor model.ComplianceExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceExportCursor, error) {
+
+	tries := 0
+	for {
+		result, resultVar1, err := s.ComplianceStore.ComplianceExport(compliance, cursor, limit)
+		if err == nil {
+			return result, resultVar1, nil
+		}
+		if !isRepeatableError(err) {
+			return result, resultVar1, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, resultVar1, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) Get(id string) (*model.Compliance, error) {
+
+	tries := 0
+	for {
+		result, err := s.ComplianceStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, error) {
+
+	tries := 0
+	for {
+		result, err := s.ComplianceStore.GetAll(offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) MessageExport(ctx context.Context, cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
+
+	tries := 0
+	for {
+		result, resultVar1, err := s.ComplianceStore.MessageExport(ctx, cursor, limit)
+		if err == nil {
+			return result, resultVar1, nil
+		}
+		if !isRepeatableError(err) {
+			return result, resultVar1, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, resultVar1, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, error) {
+
+	tries := 0
+	for {
+		result, err := s.ComplianceStore.Save(compliance)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, error) {
+
+	tries := 0
+	for {
+		result, err := s.ComplianceStore.Update(compliance)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerConfigChangeStore) Get(id string) (*model.ConfigChange, error) {
+
+	tries := 0
+	for {
+		result, err := s.ConfigChangeStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerConfigChangeStore) GetAll(offset int, limit int) ([]*model.ConfigChange, error) {
+
+	tries := 0
+	for {
+		result, err := s.ConfigChangeStore.GetAll(offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerConfigChangeStore) Save(change *model.ConfigChange) (*model.ConfigChange, error) {
+
+	tries := 0
+	for {
+		result, err := s.ConfigChangeStore.Save(change)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerDraftStore) Delete(userID string, channelID string, rootID string) error {
+
+	tries := 0
+	for {
+		err := s.DraftStore.Delete(userID, channelID, rootID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerDraftStore) Get(userID string, channelID string, rootID string, includeDeleted bool) (*model.Draft, error) {
+
+	tries := 0
+	for {
+		result, err := s.DraftStore.Get(userID, channelID, rootID, includeDeleted)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerDraftStore) GetDraftsForUser(userID string, teamID string) ([]*model.Draft, error) {
+
+	tries := 0
+	for {
+		result, err := s.DraftStore.GetDraftsForUser(userID, teamID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerDraftStore) Save(d *model.Draft) (*model.Draft, error) {
+
+	tries := 0
+	for {
+		result, err := s.DraftStore.Save(d)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerDraftStore) Update(d *model.Draft) (*model.Draft, error) {
+
+	tries := 0
+	for {
+		result, err := s.DraftStore.Update(d)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerEmojiStore) Delete(emoji *model.Emoji, timestamp int64) error {
+
+	tries := 0
+	for {
+		err := s.EmojiStore.Delete(emoji, timestamp)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerEmojiStore) Get(ctx context.Context, id string, allowFromCache bool) (*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.Get(ctx, id, allowFromCache)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerEmojiStore) GetByName(ctx context.Context, name string, allowFromCache bool) (*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.GetByName(ctx, name, allowFromCache)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.GetList(offset, limit, sort)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.GetMultipleByName(names)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.Save(emoji)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.Search(name, prefixOnly, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) AttachToPost(fileID string, postID string, channelID string, creatorID string) error {
+
+	tries := 0
+	for {
+		err := s.FileInfoStore.AttachToPost(fileID, postID, channelID, creatorID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) ClearCaches() {
+
+	s.FileInfoStore.ClearCaches()
+
+}
+
+func (s *RetryLayerFileInfoStore) CountAll() (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.CountAll()
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) CountForColdStorage(before int64) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.CountForColdStorage(before)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.DeleteForPost(postID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.GetByIds(ids)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.GetByPath(path)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, startFileID string, limit int) ([]*model.FileForIndexing, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.GetFilesBatchForIndexing(startTime, startFileID, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetForColdStorage(before int64, afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.GetForColdStorage(before, afterCreateAt, afterID, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.GetForPost(postID, readFromMaster, includeDeleted, allowFromCache)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.GetForUser(userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetTopInactiveChannelsForUserSince(teamID, userID, since, offset, limit)
+		result, err := s.FileInfoStore.GetFromMaster(id)
 		if err == nil {
 			return result, nil
 		}
@@ -2158,11 +5138,11 @@ func (s *RetryLayerChannelStore) GetTopInactiveChannelsForUserSince(teamID strin
 
 }
 
-func (s *RetryLayerChannelStore) GroupSyncedChannelCount() (int64, error) {
+func (s *RetryLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDeleted bool) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GroupSyncedChannelCount()
+		result, err := s.FileInfoStore.GetStorageUsage(allowFromCache, includeDeleted)
 		if err == nil {
 			return result, nil
 		}
@@ -2179,80 +5159,32 @@ func (s *RetryLayerChannelStore) GroupSyncedChannelCount() (int64, error) {
 
 }
 
-func (s *RetryLayerChannelStore) IncrementMentionCount(channelID string, userIDs []string, isRoot bool, isUrgent bool) error {
+func (s *RetryLayerFileInfoStore) GetUptoNSizeFileTime(n int64) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.IncrementMentionCount(channelID, userIDs, isRoot, isUrgent)
+		result, err := s.FileInfoStore.GetUptoNSizeFileTime(n)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) InvalidateAllChannelMembersForUser(userID string) {
-
-	s.ChannelStore.InvalidateAllChannelMembersForUser(userID)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidateCacheForChannelMembersNotifyProps(channelID string) {
-
-	s.ChannelStore.InvalidateCacheForChannelMembersNotifyProps(channelID)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidateChannel(id string) {
-
-	s.ChannelStore.InvalidateChannel(id)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidateChannelByName(teamID string, name string) {
-
-	s.ChannelStore.InvalidateChannelByName(teamID, name)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidateGuestCount(channelID string) {
-
-	s.ChannelStore.InvalidateGuestCount(channelID)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidateMemberCount(channelID string) {
-
-	s.ChannelStore.InvalidateMemberCount(channelID)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidatePinnedPostCount(channelID string) {
-
-	s.ChannelStore.InvalidatePinnedPostCount(channelID)
-
-}
-
-func (s *RetryLayerChannelStore) IsUserInChannelUseCache(userID string, channelID string) bool {
-
-	return s.ChannelStore.IsUserInChannelUseCache(userID, channelID)
-
-}
-
-func (s *RetryLayerChannelStore) MigrateChannelMembers(fromChannelID string, fromUserID string) (map[string]string, error) {
+func (s *RetryLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.MigrateChannelMembers(fromChannelID, fromUserID)
+		result, err := s.FileInfoStore.GetWithOptions(page, perPage, opt)
 		if err == nil {
 			return result, nil
 		}
@@ -2269,11 +5201,17 @@ func (s *RetryLayerChannelStore) MigrateChannelMembers(fromChannelID string, fro
 
 }
 
-func (s *RetryLayerChannelStore) PermanentDelete(channelID string) error {
+func (s *RetryLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
+
+	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
+
+}
+
+func (s *RetryLayerFileInfoStore) PermanentDelete(fileID string) error {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.PermanentDelete(channelID)
+		err := s.FileInfoStore.PermanentDelete(fileID)
 		if err == nil {
 			return nil
 		}
@@ -2290,74 +5228,74 @@ func (s *RetryLayerChannelStore) PermanentDelete(channelID string) error {
 
 }
 
-func (s *RetryLayerChannelStore) PermanentDeleteByTeam(teamID string) error {
+func (s *RetryLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.PermanentDeleteByTeam(teamID)
+		result, err := s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) PermanentDeleteMembersByChannel(channelID string) error {
+func (s *RetryLayerFileInfoStore) PermanentDeleteByUser(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.PermanentDeleteMembersByChannel(channelID)
+		result, err := s.FileInfoStore.PermanentDeleteByUser(userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) PermanentDeleteMembersByUser(userID string) error {
+func (s *RetryLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.PermanentDeleteMembersByUser(userID)
+		result, err := s.FileInfoStore.Save(info)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) PostCountsByDuration(channelIDs []string, sinceUnixMillis int64, userID *string, duration model.PostCountGrouping, groupingLocation *time.Location) ([]*model.DurationPostCount, error) {
+func (s *RetryLayerFileInfoStore) Search(ctx context.Context, paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.PostCountsByDuration(channelIDs, sinceUnixMillis, userID, duration, groupingLocation)
+		result, err := s.FileInfoStore.Search(ctx, paramsList, userID, teamID, page, perPage)
 		if err == nil {
 			return result, nil
 		}
@@ -2374,11 +5312,11 @@ func (s *RetryLayerChannelStore) PostCountsByDuration(channelIDs []string, since
 
 }
 
-func (s *RetryLayerChannelStore) RemoveAllDeactivatedMembers(channelID string) error {
+func (s *RetryLayerFileInfoStore) SetColdStorageForPath(path string) error {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.RemoveAllDeactivatedMembers(channelID)
+		err := s.FileInfoStore.SetColdStorageForPath(path)
 		if err == nil {
 			return nil
 		}
@@ -2395,11 +5333,11 @@ func (s *RetryLayerChannelStore) RemoveAllDeactivatedMembers(channelID string) e
 
 }
 
-func (s *RetryLayerChannelStore) RemoveMember(channelID string, userID string) error {
+func (s *RetryLayerFileInfoStore) SetContent(fileID string, content string) error {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.RemoveMember(channelID, userID)
+		err := s.FileInfoStore.SetContent(fileID, content)
 		if err == nil {
 			return nil
 		}
@@ -2416,74 +5354,74 @@ func (s *RetryLayerChannelStore) RemoveMember(channelID string, userID string) e
 
 }
 
-func (s *RetryLayerChannelStore) RemoveMembers(channelID string, userIds []string) error {
+func (s *RetryLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.RemoveMembers(channelID, userIds)
+		result, err := s.FileInfoStore.Upsert(info)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) ResetAllChannelSchemes() error {
+func (s *RetryLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.ResetAllChannelSchemes()
+		result, err := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) Restore(channelID string, timestamp int64) error {
+func (s *RetryLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.Restore(channelID, timestamp)
+		result, err := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64) (*model.Channel, error) {
+func (s *RetryLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.Save(channel, maxChannelsPerTeam)
+		result, err := s.GroupStore.ChannelMembersToAdd(since, channelID, includeRemovedMembers)
 		if err == nil {
 			return result, nil
 		}
@@ -2500,11 +5438,11 @@ func (s *RetryLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam
 
 }
 
-func (s *RetryLayerChannelStore) SaveDirectChannel(channel *model.Channel, member1 *model.ChannelMember, member2 *model.ChannelMember) (*model.Channel, error) {
+func (s *RetryLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SaveDirectChannel(channel, member1, member2)
+		result, err := s.GroupStore.ChannelMembersToRemove(channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -2521,11 +5459,11 @@ func (s *RetryLayerChannelStore) SaveDirectChannel(channel *model.Channel, membe
 
 }
 
-func (s *RetryLayerChannelStore) SaveMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+func (s *RetryLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SaveMember(member)
+		result, err := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
 		if err == nil {
 			return result, nil
 		}
@@ -2542,11 +5480,11 @@ func (s *RetryLayerChannelStore) SaveMember(member *model.ChannelMember) (*model
 
 }
 
-func (s *RetryLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+func (s *RetryLayerGroupStore) CountGroupsByChannel(channelID string, opts model.GroupSearchOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SaveMultipleMembers(members)
+		result, err := s.GroupStore.CountGroupsByChannel(channelID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -2563,32 +5501,32 @@ func (s *RetryLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMem
 
 }
 
-func (s *RetryLayerChannelStore) SearchAllChannels(term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
+func (s *RetryLayerGroupStore) CountGroupsByTeam(teamID string, opts model.GroupSearchOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ChannelStore.SearchAllChannels(term, opts)
+		result, err := s.GroupStore.CountGroupsByTeam(teamID, opts)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SearchArchivedInTeam(teamID string, term string, userID string) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SearchArchivedInTeam(teamID, term, userID)
+		result, err := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
 		if err == nil {
 			return result, nil
 		}
@@ -2605,11 +5543,11 @@ func (s *RetryLayerChannelStore) SearchArchivedInTeam(teamID string, term string
 
 }
 
-func (s *RetryLayerChannelStore) SearchForUserInTeam(userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) Create(group *model.Group) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SearchForUserInTeam(userID, teamID, term, includeDeleted)
+		result, err := s.GroupStore.Create(group)
 		if err == nil {
 			return result, nil
 		}
@@ -2626,11 +5564,11 @@ func (s *RetryLayerChannelStore) SearchForUserInTeam(userID string, teamID strin
 
 }
 
-func (s *RetryLayerChannelStore) SearchGroupChannels(userID string, term string) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SearchGroupChannels(userID, term)
+		result, err := s.GroupStore.CreateGroupSyncable(groupSyncable)
 		if err == nil {
 			return result, nil
 		}
@@ -2647,11 +5585,11 @@ func (s *RetryLayerChannelStore) SearchGroupChannels(userID string, term string)
 
 }
 
-func (s *RetryLayerChannelStore) SearchInTeam(teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SearchInTeam(teamID, term, includeDeleted)
+		result, err := s.GroupStore.CreateWithUserIds(group)
 		if err == nil {
 			return result, nil
 		}
@@ -2668,11 +5606,11 @@ func (s *RetryLayerChannelStore) SearchInTeam(teamID string, term string, includ
 
 }
 
-func (s *RetryLayerChannelStore) SearchMore(userID string, teamID string, term string) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) Delete(groupID string) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SearchMore(userID, teamID, term)
+		result, err := s.GroupStore.Delete(groupID)
 		if err == nil {
 			return result, nil
 		}
@@ -2689,53 +5627,53 @@ func (s *RetryLayerChannelStore) SearchMore(userID string, teamID string, term s
 
 }
 
-func (s *RetryLayerChannelStore) SetDeleteAt(channelID string, deleteAt int64, updateAt int64) error {
+func (s *RetryLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.SetDeleteAt(channelID, deleteAt, updateAt)
+		result, err := s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SetShared(channelId string, shared bool) error {
+func (s *RetryLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.SetShared(channelId, shared)
+		result, err := s.GroupStore.DeleteMember(groupID, userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) Update(channel *model.Channel) (*model.Channel, error) {
+func (s *RetryLayerGroupStore) DeleteMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.Update(channel)
+		result, err := s.GroupStore.DeleteMembers(groupID, userIDs)
 		if err == nil {
 			return result, nil
 		}
@@ -2752,11 +5690,11 @@ func (s *RetryLayerChannelStore) Update(channel *model.Channel) (*model.Channel,
 
 }
 
-func (s *RetryLayerChannelStore) UpdateLastViewedAt(channelIds []string, userID string) (map[string]int64, error) {
+func (s *RetryLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UpdateLastViewedAt(channelIds, userID)
+		result, err := s.GroupStore.DistinctGroupMemberCount()
 		if err == nil {
 			return result, nil
 		}
@@ -2773,11 +5711,11 @@ func (s *RetryLayerChannelStore) UpdateLastViewedAt(channelIds []string, userID
 
 }
 
-func (s *RetryLayerChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID string, mentionCount int, mentionCountRoot int, urgentMentionCount int, setUnreadCountRoot bool) (*model.ChannelUnreadAt, error) {
+func (s *RetryLayerGroupStore) DistinctGroupMemberCountForSource(source model.GroupSource) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UpdateLastViewedAtPost(unreadPost, userID, mentionCount, mentionCountRoot, urgentMentionCount, setUnreadCountRoot)
+		result, err := s.GroupStore.DistinctGroupMemberCountForSource(source)
 		if err == nil {
 			return result, nil
 		}
@@ -2794,11 +5732,11 @@ func (s *RetryLayerChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post,
 
 }
 
-func (s *RetryLayerChannelStore) UpdateMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+func (s *RetryLayerGroupStore) Get(groupID string) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UpdateMember(member)
+		result, err := s.GroupStore.Get(groupID)
 		if err == nil {
 			return result, nil
 		}
@@ -2815,11 +5753,11 @@ func (s *RetryLayerChannelStore) UpdateMember(member *model.ChannelMember) (*mod
 
 }
 
-func (s *RetryLayerChannelStore) UpdateMemberNotifyProps(channelID string, userID string, props map[string]string) (*model.ChannelMember, error) {
+func (s *RetryLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UpdateMemberNotifyProps(channelID, userID, props)
+		result, err := s.GroupStore.GetAllBySource(groupSource)
 		if err == nil {
 			return result, nil
 		}
@@ -2836,32 +5774,32 @@ func (s *RetryLayerChannelStore) UpdateMemberNotifyProps(channelID string, userI
 
 }
 
-func (s *RetryLayerChannelStore) UpdateMembersRole(channelID string, userIDs []string) error {
+func (s *RetryLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.UpdateMembersRole(channelID, userIDs)
+		result, err := s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+func (s *RetryLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UpdateMultipleMembers(members)
+		result, err := s.GroupStore.GetByIDs(groupIDs)
 		if err == nil {
 			return result, nil
 		}
@@ -2878,95 +5816,95 @@ func (s *RetryLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelM
 
 }
 
-func (s *RetryLayerChannelStore) UpdateSidebarCategories(userID string, teamID string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, []*model.SidebarCategoryWithChannels, error) {
+func (s *RetryLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ChannelStore.UpdateSidebarCategories(userID, teamID, categories)
+		result, err := s.GroupStore.GetByName(name, opts)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateSidebarCategoryOrder(userID string, teamID string, categoryOrder []string) error {
+func (s *RetryLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.UpdateSidebarCategoryOrder(userID, teamID, categoryOrder)
+		result, err := s.GroupStore.GetByRemoteID(remoteID, groupSource)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateSidebarChannelCategoryOnMove(channel *model.Channel, newTeamID string) error {
+func (s *RetryLayerGroupStore) GetByUser(userID string) ([]*model.Group, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.UpdateSidebarChannelCategoryOnMove(channel, newTeamID)
+		result, err := s.GroupStore.GetByUser(userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateSidebarChannelsByPreferences(preferences model.Preferences) error {
+func (s *RetryLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.UpdateSidebarChannelsByPreferences(preferences)
+		result, err := s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UserBelongsToChannels(userID string, channelIds []string) (bool, error) {
+func (s *RetryLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts, viewRestrictions *model.ViewUsersRestrictions) ([]*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UserBelongsToChannels(userID, channelIds)
+		result, err := s.GroupStore.GetGroups(page, perPage, opts, viewRestrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -2983,11 +5921,11 @@ func (s *RetryLayerChannelStore) UserBelongsToChannels(userID string, channelIds
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamID string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelMemberHistoryStore.DeleteOrphanedRows(limit)
+		result, err := s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -3004,11 +5942,11 @@ func (s *RetryLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since int64) ([]string, error) {
+func (s *RetryLayerGroupStore) GetGroupsByChannel(channelID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelMemberHistoryStore.GetChannelsLeftSince(userID, since)
+		result, err := s.GroupStore.GetGroupsByChannel(channelID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -3025,11 +5963,11 @@ func (s *RetryLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
+func (s *RetryLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelID)
+		result, err := s.GroupStore.GetGroupsByTeam(teamID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -3046,53 +5984,53 @@ func (s *RetryLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) LogJoinEvent(userID string, channelID string, joinTime int64) error {
+func (s *RetryLayerGroupStore) GetMember(groupID string, userID string) (*model.GroupMember, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelMemberHistoryStore.LogJoinEvent(userID, channelID, joinTime)
+		result, err := s.GroupStore.GetMember(groupID, userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) LogLeaveEvent(userID string, channelID string, leaveTime int64) error {
+func (s *RetryLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelMemberHistoryStore.LogLeaveEvent(userID, channelID, leaveTime)
+		result, err := s.GroupStore.GetMemberCount(groupID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *RetryLayerGroupStore) GetMemberCountWithRestrictions(groupID string, viewRestrictions *model.ViewUsersRestrictions) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+		result, err := s.GroupStore.GetMemberCountWithRestrictions(groupID, viewRestrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -3109,53 +6047,53 @@ func (s *RetryLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+func (s *RetryLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+		result, err := s.GroupStore.GetMemberUsers(groupID)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) Cleanup() error {
+func (s *RetryLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		err := s.ClusterDiscoveryStore.Cleanup()
+		result, err := s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
+func (s *RetryLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.ClusterDiscoveryStore.Delete(discovery)
+		result, err := s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -3172,11 +6110,11 @@ func (s *RetryLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscove
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
+func (s *RetryLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.ClusterDiscoveryStore.Exists(discovery)
+		result, err := s.GroupStore.GetMemberUsersPage(groupID, page, perPage, viewRestrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -3193,11 +6131,11 @@ func (s *RetryLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscove
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
+func (s *RetryLayerGroupStore) GetMemberUsersSortedPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions, teammateNameDisplay string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
+		result, err := s.GroupStore.GetMemberUsersSortedPage(groupID, page, perPage, viewRestrictions, teammateNameDisplay)
 		if err == nil {
 			return result, nil
 		}
@@ -3214,53 +6152,53 @@ func (s *RetryLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterNa
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
+func (s *RetryLayerGroupStore) GetNonMemberUsersPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		err := s.ClusterDiscoveryStore.Save(discovery)
+		result, err := s.GroupStore.GetNonMemberUsersPage(groupID, page, perPage, viewRestrictions)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
+func (s *RetryLayerGroupStore) GroupChannelCount() (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ClusterDiscoveryStore.SetLastPingAt(discovery)
+		result, err := s.GroupStore.GroupChannelCount()
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, error) {
+func (s *RetryLayerGroupStore) GroupCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.AnalyticsCommandCount(teamID)
+		result, err := s.GroupStore.GroupCount()
 		if err == nil {
 			return result, nil
 		}
@@ -3277,32 +6215,32 @@ func (s *RetryLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, er
 
 }
 
-func (s *RetryLayerCommandStore) Delete(commandID string, timestamp int64) error {
+func (s *RetryLayerGroupStore) GroupCountBySource(source model.GroupSource) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.CommandStore.Delete(commandID, timestamp)
+		result, err := s.GroupStore.GroupCountBySource(source)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) Get(id string) (*model.Command, error) {
+func (s *RetryLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.Get(id)
+		result, err := s.GroupStore.GroupCountWithAllowReference()
 		if err == nil {
 			return result, nil
 		}
@@ -3319,11 +6257,11 @@ func (s *RetryLayerCommandStore) Get(id string) (*model.Command, error) {
 
 }
 
-func (s *RetryLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, error) {
+func (s *RetryLayerGroupStore) GroupMemberCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.GetByTeam(teamID)
+		result, err := s.GroupStore.GroupMemberCount()
 		if err == nil {
 			return result, nil
 		}
@@ -3340,11 +6278,11 @@ func (s *RetryLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, err
 
 }
 
-func (s *RetryLayerCommandStore) GetByTrigger(teamID string, trigger string) (*model.Command, error) {
+func (s *RetryLayerGroupStore) GroupTeamCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.GetByTrigger(teamID, trigger)
+		result, err := s.GroupStore.GroupTeamCount()
 		if err == nil {
 			return result, nil
 		}
@@ -3361,11 +6299,11 @@ func (s *RetryLayerCommandStore) GetByTrigger(teamID string, trigger string) (*m
 
 }
 
-func (s *RetryLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
+func (s *RetryLayerGroupStore) PermanentDeleteMembersByUser(userID string) error {
 
 	tries := 0
 	for {
-		err := s.CommandStore.PermanentDeleteByTeam(teamID)
+		err := s.GroupStore.PermanentDeleteMembersByUser(userID)
 		if err == nil {
 			return nil
 		}
@@ -3382,32 +6320,32 @@ func (s *RetryLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
 
 }
 
-func (s *RetryLayerCommandStore) PermanentDeleteByUser(userID string) error {
+func (s *RetryLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
 
 	tries := 0
 	for {
-		err := s.CommandStore.PermanentDeleteByUser(userID)
+		result, err := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
+func (s *RetryLayerGroupStore) Restore(groupID string) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.Save(webhook)
+		result, err := s.GroupStore.Restore(groupID)
 		if err == nil {
 			return result, nil
 		}
@@ -3424,11 +6362,11 @@ func (s *RetryLayerCommandStore) Save(webhook *model.Command) (*model.Command, e
 
 }
 
-func (s *RetryLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
+func (s *RetryLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.Update(hook)
+		result, err := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
 		if err == nil {
 			return result, nil
 		}
@@ -3445,17 +6383,32 @@ func (s *RetryLayerCommandStore) Update(hook *model.Command) (*model.Command, er
 
 }
 
-func (s *RetryLayerCommandWebhookStore) Cleanup() {
+func (s *RetryLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, includeRemovedMembers bool) ([]*model.UserTeamIDPair, error) {
 
-	s.CommandWebhookStore.Cleanup()
+	tries := 0
+	for {
+		result, err := s.GroupStore.TeamMembersToAdd(since, teamID, includeRemovedMembers)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
 
 }
 
-func (s *RetryLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, error) {
+func (s *RetryLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandWebhookStore.Get(id)
+		result, err := s.GroupStore.TeamMembersToRemove(teamID)
 		if err == nil {
 			return result, nil
 		}
@@ -3472,11 +6425,11 @@ func (s *RetryLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, e
 
 }
 
-func (s *RetryLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, error) {
+func (s *RetryLayerGroupStore) Update(group *model.Group) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandWebhookStore.Save(webhook)
+		result, err := s.GroupStore.Update(group)
 		if err == nil {
 			return result, nil
 		}
@@ -3493,53 +6446,53 @@ func (s *RetryLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*mo
 
 }
 
-func (s *RetryLayerCommandWebhookStore) TryUse(id string, limit int) error {
+func (s *RetryLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
 
 	tries := 0
 	for {
-		err := s.CommandWebhookStore.TryUse(id, limit)
+		result, err := s.GroupStore.UpdateGroupSyncable(groupSyncable)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerComplianceStore) ComplianceExport(compliance *model.Compliance, cursor model.ComplianceExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceExportCursor, error) {
+func (s *RetryLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ComplianceStore.ComplianceExport(compliance, cursor, limit)
+		result, err := s.GroupStore.UpsertMember(groupID, userID)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerComplianceStore) Get(id string) (*model.Compliance, error) {
+func (s *RetryLayerGroupStore) UpsertMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.ComplianceStore.Get(id)
+		result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
 		if err == nil {
 			return result, nil
 		}
@@ -3556,11 +6509,11 @@ func (s *RetryLayerComplianceStore) Get(id string) (*model.Compliance, error) {
 
 }
 
-func (s *RetryLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, error) {
+func (s *RetryLayerGuestSponsorshipStore) Get(userID string) (*model.GuestSponsorship, error) {
 
 	tries := 0
 	for {
-		result, err := s.ComplianceStore.GetAll(offset, limit)
+		result, err := s.GuestSponsorshipStore.Get(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -3577,32 +6530,32 @@ func (s *RetryLayerComplianceStore) GetAll(offset int, limit int) (model.Complia
 
 }
 
-func (s *RetryLayerComplianceStore) MessageExport(ctx context.Context, cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
+func (s *RetryLayerGuestSponsorshipStore) GetExpired(now int64, limit int) ([]*model.GuestSponsorship, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ComplianceStore.MessageExport(ctx, cursor, limit)
+		result, err := s.GuestSponsorshipStore.GetExpired(now, limit)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, error) {
+func (s *RetryLayerGuestSponsorshipStore) GetForSponsor(sponsorID string, offset int, limit int) ([]*model.GuestSponsorship, error) {
 
 	tries := 0
 	for {
-		result, err := s.ComplianceStore.Save(compliance)
+		result, err := s.GuestSponsorshipStore.GetForSponsor(sponsorID, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -3619,11 +6572,11 @@ func (s *RetryLayerComplianceStore) Save(compliance *model.Compliance) (*model.C
 
 }
 
-func (s *RetryLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, error) {
+func (s *RetryLayerGuestSponsorshipStore) GetSponsorReport(offset int, limit int) ([]*model.GuestSponsorReport, error) {
 
 	tries := 0
 	for {
-		result, err := s.ComplianceStore.Update(compliance)
+		result, err := s.GuestSponsorshipStore.GetSponsorReport(offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -3640,11 +6593,11 @@ func (s *RetryLayerComplianceStore) Update(compliance *model.Compliance) (*model
 
 }
 
-func (s *RetryLayerDraftStore) Delete(userID string, channelID string, rootID string) error {
+func (s *RetryLayerGuestSponsorshipStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		err := s.DraftStore.Delete(userID, channelID, rootID)
+		err := s.GuestSponsorshipStore.PermanentDeleteByUser(userID)
 		if err == nil {
 			return nil
 		}
@@ -3661,11 +6614,11 @@ func (s *RetryLayerDraftStore) Delete(userID string, channelID string, rootID st
 
 }
 
-func (s *RetryLayerDraftStore) Get(userID string, channelID string, rootID string, includeDeleted bool) (*model.Draft, error) {
+func (s *RetryLayerGuestSponsorshipStore) Save(sponsorship *model.GuestSponsorship) (*model.GuestSponsorship, error) {
 
 	tries := 0
 	for {
-		result, err := s.DraftStore.Get(userID, channelID, rootID, includeDeleted)
+		result, err := s.GuestSponsorshipStore.Save(sponsorship)
 		if err == nil {
 			return result, nil
 		}
@@ -3682,11 +6635,11 @@ func (s *RetryLayerDraftStore) Get(userID string, channelID string, rootID strin
 
 }
 
-func (s *RetryLayerDraftStore) GetDraftsForUser(userID string, teamID string) ([]*model.Draft, error) {
+func (s *RetryLayerGuestSponsorshipStore) Update(sponsorship *model.GuestSponsorship) (*model.GuestSponsorship, error) {
 
 	tries := 0
 	for {
-		result, err := s.DraftStore.GetDraftsForUser(userID, teamID)
+		result, err := s.GuestSponsorshipStore.Update(sponsorship)
 		if err == nil {
 			return result, nil
 		}
@@ -3703,11 +6656,32 @@ func (s *RetryLayerDraftStore) GetDraftsForUser(userID string, teamID string) ([
 
 }
 
-func (s *RetryLayerDraftStore) Save(d *model.Draft) (*model.Draft, error) {
+func (s *RetryLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
+
+	tries := 0
+	for {
+		err := s.JobStore.Cleanup(expiryTime, batchSize)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerJobStore) Delete(id string) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.DraftStore.Save(d)
+		result, err := s.JobStore.Delete(id)
 		if err == nil {
 			return result, nil
 		}
@@ -3724,11 +6698,11 @@ func (s *RetryLayerDraftStore) Save(d *model.Draft) (*model.Draft, error) {
 
 }
 
-func (s *RetryLayerDraftStore) Update(d *model.Draft) (*model.Draft, error) {
+func (s *RetryLayerJobStore) Get(id string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.DraftStore.Update(d)
+		result, err := s.JobStore.Get(id)
 		if err == nil {
 			return result, nil
 		}
@@ -3745,32 +6719,32 @@ func (s *RetryLayerDraftStore) Update(d *model.Draft) (*model.Draft, error) {
 
 }
 
-func (s *RetryLayerEmojiStore) Delete(emoji *model.Emoji, timestamp int64) error {
+func (s *RetryLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		err := s.EmojiStore.Delete(emoji, timestamp)
+		result, err := s.JobStore.GetAllByStatus(status)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerEmojiStore) Get(ctx context.Context, id string, allowFromCache bool) (*model.Emoji, error) {
+func (s *RetryLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.Get(ctx, id, allowFromCache)
+		result, err := s.JobStore.GetAllByType(jobType)
 		if err == nil {
 			return result, nil
 		}
@@ -3787,11 +6761,11 @@ func (s *RetryLayerEmojiStore) Get(ctx context.Context, id string, allowFromCach
 
 }
 
-func (s *RetryLayerEmojiStore) GetByName(ctx context.Context, name string, allowFromCache bool) (*model.Emoji, error) {
+func (s *RetryLayerJobStore) GetAllByTypeAndStatus(jobType string, status string) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.GetByName(ctx, name, allowFromCache)
+		result, err := s.JobStore.GetAllByTypeAndStatus(jobType, status)
 		if err == nil {
 			return result, nil
 		}
@@ -3808,11 +6782,11 @@ func (s *RetryLayerEmojiStore) GetByName(ctx context.Context, name string, allow
 
 }
 
-func (s *RetryLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
+func (s *RetryLayerJobStore) GetAllByTypeAndStatusPage(jobType string, status string, offset int, limit int) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.GetList(offset, limit, sort)
+		result, err := s.JobStore.GetAllByTypeAndStatusPage(jobType, status, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -3829,11 +6803,11 @@ func (s *RetryLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*m
 
 }
 
-func (s *RetryLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
+func (s *RetryLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.GetMultipleByName(names)
+		result, err := s.JobStore.GetAllByTypePage(jobType, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -3850,11 +6824,11 @@ func (s *RetryLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji
 
 }
 
-func (s *RetryLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+func (s *RetryLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, limit int) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.Save(emoji)
+		result, err := s.JobStore.GetAllByTypesPage(jobTypes, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -3871,11 +6845,11 @@ func (s *RetryLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
 
 }
 
-func (s *RetryLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
+func (s *RetryLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.Search(name, prefixOnly, limit)
+		result, err := s.JobStore.GetAllPage(offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -3892,38 +6866,32 @@ func (s *RetryLayerEmojiStore) Search(name string, prefixOnly bool, limit int) (
 
 }
 
-func (s *RetryLayerFileInfoStore) AttachToPost(fileID string, postID string, channelID string, creatorID string) error {
+func (s *RetryLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.FileInfoStore.AttachToPost(fileID, postID, channelID, creatorID)
+		result, err := s.JobStore.GetCountByStatusAndType(status, jobType)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) ClearCaches() {
-
-	s.FileInfoStore.ClearCaches()
-
-}
-
-func (s *RetryLayerFileInfoStore) CountAll() (int64, error) {
+func (s *RetryLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.CountAll()
+		result, err := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
 		if err == nil {
 			return result, nil
 		}
@@ -3940,11 +6908,11 @@ func (s *RetryLayerFileInfoStore) CountAll() (int64, error) {
 
 }
 
-func (s *RetryLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
+func (s *RetryLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.DeleteForPost(postID)
+		result, err := s.JobStore.GetNewestJobByStatusesAndType(statuses, jobType)
 		if err == nil {
 			return result, nil
 		}
@@ -3961,11 +6929,11 @@ func (s *RetryLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
 
 }
 
-func (s *RetryLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
+func (s *RetryLayerJobStore) GetStatsByType(since int64) ([]*model.JobTypeStats, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Get(id)
+		result, err := s.JobStore.GetStatsByType(since)
 		if err == nil {
 			return result, nil
 		}
@@ -3982,11 +6950,11 @@ func (s *RetryLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
 
 }
 
-func (s *RetryLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
+func (s *RetryLayerJobStore) GetTypeSettings() ([]*model.JobTypeSettings, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetByIds(ids)
+		result, err := s.JobStore.GetTypeSettings()
 		if err == nil {
 			return result, nil
 		}
@@ -4003,11 +6971,11 @@ func (s *RetryLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, err
 
 }
 
-func (s *RetryLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
+func (s *RetryLayerJobStore) Save(job *model.Job) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetByPath(path)
+		result, err := s.JobStore.Save(job)
 		if err == nil {
 			return result, nil
 		}
@@ -4024,11 +6992,11 @@ func (s *RetryLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error
 
 }
 
-func (s *RetryLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, startFileID string, limit int) ([]*model.FileForIndexing, error) {
+func (s *RetryLayerJobStore) SaveTypeSettings(settings *model.JobTypeSettings) (*model.JobTypeSettings, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetFilesBatchForIndexing(startTime, startFileID, limit)
+		result, err := s.JobStore.SaveTypeSettings(settings)
 		if err == nil {
 			return result, nil
 		}
@@ -4045,11 +7013,11 @@ func (s *RetryLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, star
 
 }
 
-func (s *RetryLayerFileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
+func (s *RetryLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetForPost(postID, readFromMaster, includeDeleted, allowFromCache)
+		result, err := s.JobStore.UpdateOptimistically(job, currentStatus)
 		if err == nil {
 			return result, nil
 		}
@@ -4066,11 +7034,11 @@ func (s *RetryLayerFileInfoStore) GetForPost(postID string, readFromMaster bool,
 
 }
 
-func (s *RetryLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
+func (s *RetryLayerJobStore) UpdateStatus(id string, status string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetForUser(userID)
+		result, err := s.JobStore.UpdateStatus(id, status)
 		if err == nil {
 			return result, nil
 		}
@@ -4087,11 +7055,11 @@ func (s *RetryLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo,
 
 }
 
-func (s *RetryLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
+func (s *RetryLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetFromMaster(id)
+		result, err := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
 		if err == nil {
 			return result, nil
 		}
@@ -4108,11 +7076,11 @@ func (s *RetryLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, err
 
 }
 
-func (s *RetryLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDeleted bool) (int64, error) {
+func (s *RetryLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetStorageUsage(allowFromCache, includeDeleted)
+		result, err := s.LicenseStore.Get(id)
 		if err == nil {
 			return result, nil
 		}
@@ -4129,11 +7097,11 @@ func (s *RetryLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDe
 
 }
 
-func (s *RetryLayerFileInfoStore) GetUptoNSizeFileTime(n int64) (int64, error) {
+func (s *RetryLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetUptoNSizeFileTime(n)
+		result, err := s.LicenseStore.GetAll()
 		if err == nil {
 			return result, nil
 		}
@@ -4150,11 +7118,11 @@ func (s *RetryLayerFileInfoStore) GetUptoNSizeFileTime(n int64) (int64, error) {
 
 }
 
-func (s *RetryLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error) {
+func (s *RetryLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetWithOptions(page, perPage, opt)
+		result, err := s.LicenseStore.Save(license)
 		if err == nil {
 			return result, nil
 		}
@@ -4171,38 +7139,32 @@ func (s *RetryLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *mod
 
 }
 
-func (s *RetryLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
-
-	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
-
-}
-
-func (s *RetryLayerFileInfoStore) PermanentDelete(fileID string) error {
+func (s *RetryLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
 
 	tries := 0
 	for {
-		err := s.FileInfoStore.PermanentDelete(fileID)
+		result, err := s.LinkMetadataStore.Get(url, timestamp)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *RetryLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
+		result, err := s.LinkMetadataStore.Save(linkMetadata)
 		if err == nil {
 			return result, nil
 		}
@@ -4219,32 +7181,32 @@ func (s *RetryLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int6
 
 }
 
-func (s *RetryLayerFileInfoStore) PermanentDeleteByUser(userID string) (int64, error) {
+func (s *RetryLayerMatrixBridgeStore) DeleteRoom(channelID string) error {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.PermanentDeleteByUser(userID)
+		err := s.MatrixBridgeStore.DeleteRoom(channelID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
+func (s *RetryLayerMatrixBridgeStore) GetAllRooms() ([]*model.MatrixRoom, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Save(info)
+		result, err := s.MatrixBridgeStore.GetAllRooms()
 		if err == nil {
 			return result, nil
 		}
@@ -4261,11 +7223,11 @@ func (s *RetryLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, e
 
 }
 
-func (s *RetryLayerFileInfoStore) Search(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
+func (s *RetryLayerMatrixBridgeStore) GetEvent(eventID string) (*model.MatrixEvent, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Search(paramsList, userID, teamID, page, perPage)
+		result, err := s.MatrixBridgeStore.GetEvent(eventID)
 		if err == nil {
 			return result, nil
 		}
@@ -4282,32 +7244,32 @@ func (s *RetryLayerFileInfoStore) Search(paramsList []*model.SearchParams, userI
 
 }
 
-func (s *RetryLayerFileInfoStore) SetContent(fileID string, content string) error {
+func (s *RetryLayerMatrixBridgeStore) GetEventForPost(postID string) (*model.MatrixEvent, error) {
 
 	tries := 0
 	for {
-		err := s.FileInfoStore.SetContent(fileID, content)
+		result, err := s.MatrixBridgeStore.GetEventForPost(postID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error) {
+func (s *RetryLayerMatrixBridgeStore) GetRoom(roomID string) (*model.MatrixRoom, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Upsert(info)
+		result, err := s.MatrixBridgeStore.GetRoom(roomID)
 		if err == nil {
 			return result, nil
 		}
@@ -4324,11 +7286,11 @@ func (s *RetryLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo,
 
 }
 
-func (s *RetryLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+func (s *RetryLayerMatrixBridgeStore) GetRoomForChannel(channelID string) (*model.MatrixRoom, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
+		result, err := s.MatrixBridgeStore.GetRoomForChannel(channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -4345,11 +7307,11 @@ func (s *RetryLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, s
 
 }
 
-func (s *RetryLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+func (s *RetryLayerMatrixBridgeStore) SaveEvent(event *model.MatrixEvent) (*model.MatrixEvent, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
+		result, err := s.MatrixBridgeStore.SaveEvent(event)
 		if err == nil {
 			return result, nil
 		}
@@ -4366,11 +7328,11 @@ func (s *RetryLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string,
 
 }
 
-func (s *RetryLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, error) {
+func (s *RetryLayerMatrixBridgeStore) SaveRoom(room *model.MatrixRoom) (*model.MatrixRoom, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.ChannelMembersToAdd(since, channelID, includeRemovedMembers)
+		result, err := s.MatrixBridgeStore.SaveRoom(room)
 		if err == nil {
 			return result, nil
 		}
@@ -4387,32 +7349,32 @@ func (s *RetryLayerGroupStore) ChannelMembersToAdd(since int64, channelID *strin
 
 }
 
-func (s *RetryLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, error) {
+func (s *RetryLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.ChannelMembersToRemove(channelID)
+		err := s.NotifyAdminStore.DeleteBefore(trial, now)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, error) {
+func (s *RetryLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
+		result, err := s.NotifyAdminStore.Get(trial)
 		if err == nil {
 			return result, nil
 		}
@@ -4429,11 +7391,11 @@ func (s *RetryLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID st
 
 }
 
-func (s *RetryLayerGroupStore) CountGroupsByChannel(channelID string, opts model.GroupSearchOpts) (int64, error) {
+func (s *RetryLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, feature model.MattermostFeature) ([]*model.NotifyAdminData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountGroupsByChannel(channelID, opts)
+		result, err := s.NotifyAdminStore.GetDataByUserIdAndFeature(userId, feature)
 		if err == nil {
 			return result, nil
 		}
@@ -4450,11 +7412,11 @@ func (s *RetryLayerGroupStore) CountGroupsByChannel(channelID string, opts model
 
 }
 
-func (s *RetryLayerGroupStore) CountGroupsByTeam(teamID string, opts model.GroupSearchOpts) (int64, error) {
+func (s *RetryLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.NotifyAdminData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountGroupsByTeam(teamID, opts)
+		result, err := s.NotifyAdminStore.Save(data)
 		if err == nil {
 			return result, nil
 		}
@@ -4471,53 +7433,53 @@ func (s *RetryLayerGroupStore) CountGroupsByTeam(teamID string, opts model.Group
 
 }
 
-func (s *RetryLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, error) {
+func (s *RetryLayerNotifyAdminStore) Update(userId string, requiredPlan string, requiredFeature model.MattermostFeature, now int64) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
+		err := s.NotifyAdminStore.Update(userId, requiredPlan, requiredFeature, now)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) Create(group *model.Group) (*model.Group, error) {
+func (s *RetryLayerOAuthStore) DeleteApp(id string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.Create(group)
+		err := s.OAuthStore.DeleteApp(id)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
+func (s *RetryLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CreateGroupSyncable(groupSyncable)
+		result, err := s.OAuthStore.GetAccessData(token)
 		if err == nil {
 			return result, nil
 		}
@@ -4534,11 +7496,11 @@ func (s *RetryLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyn
 
 }
 
-func (s *RetryLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds) (*model.Group, error) {
+func (s *RetryLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CreateWithUserIds(group)
+		result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
 		if err == nil {
 			return result, nil
 		}
@@ -4555,11 +7517,11 @@ func (s *RetryLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds)
 
 }
 
-func (s *RetryLayerGroupStore) Delete(groupID string) (*model.Group, error) {
+func (s *RetryLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId string) ([]*model.AccessData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.Delete(groupID)
+		result, err := s.OAuthStore.GetAccessDataByUserForApp(userID, clientId)
 		if err == nil {
 			return result, nil
 		}
@@ -4576,11 +7538,11 @@ func (s *RetryLayerGroupStore) Delete(groupID string) (*model.Group, error) {
 
 }
 
-func (s *RetryLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
+func (s *RetryLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
+		result, err := s.OAuthStore.GetApp(id)
 		if err == nil {
 			return result, nil
 		}
@@ -4597,11 +7559,11 @@ func (s *RetryLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID st
 
 }
 
-func (s *RetryLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *RetryLayerOAuthStore) GetAppByUser(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.DeleteMember(groupID, userID)
+		result, err := s.OAuthStore.GetAppByUser(userID, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -4618,11 +7580,11 @@ func (s *RetryLayerGroupStore) DeleteMember(groupID string, userID string) (*mod
 
 }
 
-func (s *RetryLayerGroupStore) DeleteMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
+func (s *RetryLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.DeleteMembers(groupID, userIDs)
+		result, err := s.OAuthStore.GetApps(offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -4639,11 +7601,11 @@ func (s *RetryLayerGroupStore) DeleteMembers(groupID string, userIDs []string) (
 
 }
 
-func (s *RetryLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
+func (s *RetryLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.DistinctGroupMemberCount()
+		result, err := s.OAuthStore.GetAuthData(code)
 		if err == nil {
 			return result, nil
 		}
@@ -4660,11 +7622,11 @@ func (s *RetryLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
 
 }
 
-func (s *RetryLayerGroupStore) DistinctGroupMemberCountForSource(source model.GroupSource) (int64, error) {
+func (s *RetryLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.DistinctGroupMemberCountForSource(source)
+		result, err := s.OAuthStore.GetAuthorizedApps(userID, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -4681,11 +7643,11 @@ func (s *RetryLayerGroupStore) DistinctGroupMemberCountForSource(source model.Gr
 
 }
 
-func (s *RetryLayerGroupStore) Get(groupID string) (*model.Group, error) {
+func (s *RetryLayerOAuthStore) GetConnection(userID string, service string) (*model.OAuthConnection, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.Get(groupID)
+		result, err := s.OAuthStore.GetConnection(userID, service)
 		if err == nil {
 			return result, nil
 		}
@@ -4702,11 +7664,11 @@ func (s *RetryLayerGroupStore) Get(groupID string) (*model.Group, error) {
 
 }
 
-func (s *RetryLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, error) {
+func (s *RetryLayerOAuthStore) GetPreviousAccessData(userID string, clientId string) (*model.AccessData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetAllBySource(groupSource)
+		result, err := s.OAuthStore.GetPreviousAccessData(userID, clientId)
 		if err == nil {
 			return result, nil
 		}
@@ -4723,11 +7685,11 @@ func (s *RetryLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]
 
 }
 
-func (s *RetryLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, error) {
+func (s *RetryLayerOAuthStore) GetRotatedRefreshToken(refreshToken string) (*model.OAuthRotatedRefreshToken, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
+		result, err := s.OAuthStore.GetRotatedRefreshToken(refreshToken)
 		if err == nil {
 			return result, nil
 		}
@@ -4744,158 +7706,158 @@ func (s *RetryLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syn
 
 }
 
-func (s *RetryLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, error) {
+func (s *RetryLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetByIDs(groupIDs)
+		err := s.OAuthStore.PermanentDeleteAuthDataByUser(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, error) {
+func (s *RetryLayerOAuthStore) RemoveAccessData(token string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetByName(name, opts)
+		err := s.OAuthStore.RemoveAccessData(token)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, error) {
+func (s *RetryLayerOAuthStore) RemoveAllAccessData() error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetByRemoteID(remoteID, groupSource)
+		err := s.OAuthStore.RemoveAllAccessData()
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetByUser(userID string) ([]*model.Group, error) {
+func (s *RetryLayerOAuthStore) RemoveAuthData(code string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetByUser(userID)
+		err := s.OAuthStore.RemoveAuthData(code)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
+func (s *RetryLayerOAuthStore) RemoveAuthDataByClientId(clientId string, userId string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
+		err := s.OAuthStore.RemoveAuthDataByClientId(clientId, userId)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts, viewRestrictions *model.ViewUsersRestrictions) ([]*model.Group, error) {
+func (s *RetryLayerOAuthStore) RemoveConnection(userID string, service string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetGroups(page, perPage, opts, viewRestrictions)
+		err := s.OAuthStore.RemoveConnection(userID, service)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamID string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, error) {
+func (s *RetryLayerOAuthStore) RemoveRotatedRefreshTokens(userID string, clientID string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamID, opts)
+		err := s.OAuthStore.RemoveRotatedRefreshTokens(userID, clientID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetGroupsByChannel(channelID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
+func (s *RetryLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetGroupsByChannel(channelID, opts)
+		result, err := s.OAuthStore.SaveAccessData(accessData)
 		if err == nil {
 			return result, nil
 		}
@@ -4912,11 +7874,11 @@ func (s *RetryLayerGroupStore) GetGroupsByChannel(channelID string, opts model.G
 
 }
 
-func (s *RetryLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
+func (s *RetryLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetGroupsByTeam(teamID, opts)
+		result, err := s.OAuthStore.SaveApp(app)
 		if err == nil {
 			return result, nil
 		}
@@ -4933,11 +7895,11 @@ func (s *RetryLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSe
 
 }
 
-func (s *RetryLayerGroupStore) GetMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *RetryLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMember(groupID, userID)
+		result, err := s.OAuthStore.SaveAuthData(authData)
 		if err == nil {
 			return result, nil
 		}
@@ -4954,11 +7916,11 @@ func (s *RetryLayerGroupStore) GetMember(groupID string, userID string) (*model.
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
+func (s *RetryLayerOAuthStore) SaveConnection(connection *model.OAuthConnection) (*model.OAuthConnection, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberCount(groupID)
+		result, err := s.OAuthStore.SaveConnection(connection)
 		if err == nil {
 			return result, nil
 		}
@@ -4975,32 +7937,32 @@ func (s *RetryLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberCountWithRestrictions(groupID string, viewRestrictions *model.ViewUsersRestrictions) (int64, error) {
+func (s *RetryLayerOAuthStore) SaveRotatedRefreshToken(token *model.OAuthRotatedRefreshToken) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberCountWithRestrictions(groupID, viewRestrictions)
+		err := s.OAuthStore.SaveRotatedRefreshToken(token)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, error) {
+func (s *RetryLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberUsers(groupID)
+		result, err := s.OAuthStore.UpdateAccessData(accessData)
 		if err == nil {
 			return result, nil
 		}
@@ -5017,11 +7979,11 @@ func (s *RetryLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, er
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, error) {
+func (s *RetryLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
+		result, err := s.OAuthStore.UpdateApp(app)
 		if err == nil {
 			return result, nil
 		}
@@ -5038,32 +8000,32 @@ func (s *RetryLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID strin
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, error) {
+func (s *RetryLayerOAuthStore) UpdateAppCreator(appID string, creatorID string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
+		err := s.OAuthStore.UpdateAppCreator(appID, creatorID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
+func (s *RetryLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberUsersPage(groupID, page, perPage, viewRestrictions)
+		result, err := s.PluginStore.CompareAndDelete(keyVal, oldValue)
 		if err == nil {
 			return result, nil
 		}
@@ -5080,11 +8042,11 @@ func (s *RetryLayerGroupStore) GetMemberUsersPage(groupID string, page int, perP
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberUsersSortedPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions, teammateNameDisplay string) ([]*model.User, error) {
+func (s *RetryLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberUsersSortedPage(groupID, page, perPage, viewRestrictions, teammateNameDisplay)
+		result, err := s.PluginStore.CompareAndSet(keyVal, oldValue)
 		if err == nil {
 			return result, nil
 		}
@@ -5101,74 +8063,74 @@ func (s *RetryLayerGroupStore) GetMemberUsersSortedPage(groupID string, page int
 
 }
 
-func (s *RetryLayerGroupStore) GetNonMemberUsersPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
+func (s *RetryLayerPluginStore) Delete(pluginID string, key string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetNonMemberUsersPage(groupID, page, perPage, viewRestrictions)
+		err := s.PluginStore.Delete(pluginID, key)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GroupChannelCount() (int64, error) {
+func (s *RetryLayerPluginStore) DeleteAllExpired() error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupChannelCount()
+		err := s.PluginStore.DeleteAllExpired()
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GroupCount() (int64, error) {
+func (s *RetryLayerPluginStore) DeleteAllForPlugin(PluginID string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupCount()
+		err := s.PluginStore.DeleteAllForPlugin(PluginID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GroupCountBySource(source model.GroupSource) (int64, error) {
+func (s *RetryLayerPluginStore) Get(pluginID string, key string) (*model.PluginKeyValue, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupCountBySource(source)
+		result, err := s.PluginStore.Get(pluginID, key)
 		if err == nil {
 			return result, nil
 		}
@@ -5185,11 +8147,11 @@ func (s *RetryLayerGroupStore) GroupCountBySource(source model.GroupSource) (int
 
 }
 
-func (s *RetryLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
+func (s *RetryLayerPluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupCountWithAllowReference()
+		result, err := s.PluginStore.List(pluginID, page, perPage)
 		if err == nil {
 			return result, nil
 		}
@@ -5206,11 +8168,11 @@ func (s *RetryLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
 
 }
 
-func (s *RetryLayerGroupStore) GroupMemberCount() (int64, error) {
+func (s *RetryLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupMemberCount()
+		result, err := s.PluginStore.SaveOrUpdate(keyVal)
 		if err == nil {
 			return result, nil
 		}
@@ -5227,11 +8189,11 @@ func (s *RetryLayerGroupStore) GroupMemberCount() (int64, error) {
 
 }
 
-func (s *RetryLayerGroupStore) GroupTeamCount() (int64, error) {
+func (s *RetryLayerPluginStore) SetWithOptions(pluginID string, key string, value []byte, options model.PluginKVSetOptions) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupTeamCount()
+		result, err := s.PluginStore.SetWithOptions(pluginID, key, value, options)
 		if err == nil {
 			return result, nil
 		}
@@ -5248,32 +8210,32 @@ func (s *RetryLayerGroupStore) GroupTeamCount() (int64, error) {
 
 }
 
-func (s *RetryLayerGroupStore) PermanentDeleteMembersByUser(userID string) error {
+func (s *RetryLayerPostStore) AnalyticsPostCount(options *model.PostCountOptions) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.GroupStore.PermanentDeleteMembersByUser(userID)
+		result, err := s.PostStore.AnalyticsPostCount(options)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+func (s *RetryLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
+		result, err := s.PostStore.AnalyticsPostCountsByDay(options)
 		if err == nil {
 			return result, nil
 		}
@@ -5290,11 +8252,11 @@ func (s *RetryLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncab
 
 }
 
-func (s *RetryLayerGroupStore) Restore(groupID string) (*model.Group, error) {
+func (s *RetryLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamID string) (model.AnalyticsRows, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.Restore(groupID)
+		result, err := s.PostStore.AnalyticsUserCountsWithPostsByDay(teamID)
 		if err == nil {
 			return result, nil
 		}
@@ -5311,32 +8273,38 @@ func (s *RetryLayerGroupStore) Restore(groupID string) (*model.Group, error) {
 
 }
 
-func (s *RetryLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+func (s *RetryLayerPostStore) ClearCaches() {
+
+	s.PostStore.ClearCaches()
+
+}
+
+func (s *RetryLayerPostStore) Delete(postID string, timestamp int64, deleteByID string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
+		err := s.PostStore.Delete(postID, timestamp, deleteByID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, includeRemovedMembers bool) ([]*model.UserTeamIDPair, error) {
+func (s *RetryLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.TeamMembersToAdd(since, teamID, includeRemovedMembers)
+		result, err := s.PostStore.DeleteOrphanedRows(limit)
 		if err == nil {
 			return result, nil
 		}
@@ -5353,11 +8321,11 @@ func (s *RetryLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, inc
 
 }
 
-func (s *RetryLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, error) {
+func (s *RetryLayerPostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.TeamMembersToRemove(teamID)
+		result, err := s.PostStore.Get(ctx, id, opts, userID, sanitizeOptions)
 		if err == nil {
 			return result, nil
 		}
@@ -5374,11 +8342,11 @@ func (s *RetryLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.Tea
 
 }
 
-func (s *RetryLayerGroupStore) Update(group *model.Group) (*model.Group, error) {
+func (s *RetryLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.Update(group)
+		result, err := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterID)
 		if err == nil {
 			return result, nil
 		}
@@ -5395,11 +8363,11 @@ func (s *RetryLayerGroupStore) Update(group *model.Group) (*model.Group, error)
 
 }
 
-func (s *RetryLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
+func (s *RetryLayerPostStore) GetEditHistoryForPost(postId string) ([]*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.UpdateGroupSyncable(groupSyncable)
+		result, err := s.PostStore.GetEditHistoryForPost(postId)
 		if err == nil {
 			return result, nil
 		}
@@ -5416,11 +8384,17 @@ func (s *RetryLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyn
 
 }
 
-func (s *RetryLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *RetryLayerPostStore) GetEtag(channelID string, allowFromCache bool, collapsedThreads bool) string {
+
+	return s.PostStore.GetEtag(channelID, allowFromCache, collapsedThreads)
+
+}
+
+func (s *RetryLayerPostStore) GetFlaggedPosts(userID string, offset int, limit int) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.UpsertMember(groupID, userID)
+		result, err := s.PostStore.GetFlaggedPosts(userID, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -5437,11 +8411,11 @@ func (s *RetryLayerGroupStore) UpsertMember(groupID string, userID string) (*mod
 
 }
 
-func (s *RetryLayerGroupStore) UpsertMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
+func (s *RetryLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID string, offset int, limit int) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
+		result, err := s.PostStore.GetFlaggedPostsForChannel(userID, channelID, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -5458,32 +8432,32 @@ func (s *RetryLayerGroupStore) UpsertMembers(groupID string, userIDs []string) (
 
 }
 
-func (s *RetryLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
+func (s *RetryLayerPostStore) GetFlaggedPostsForTeam(userID string, teamID string, offset int, limit int) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		err := s.JobStore.Cleanup(expiryTime, batchSize)
+		result, err := s.PostStore.GetFlaggedPostsForTeam(userID, teamID, offset, limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) Delete(id string) (string, error) {
+func (s *RetryLayerPostStore) GetLastPostRowCreateAt() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.Delete(id)
+		result, err := s.PostStore.GetLastPostRowCreateAt()
 		if err == nil {
 			return result, nil
 		}
@@ -5500,11 +8474,17 @@ func (s *RetryLayerJobStore) Delete(id string) (string, error) {
 
 }
 
-func (s *RetryLayerJobStore) Get(id string) (*model.Job, error) {
+func (s *RetryLayerPostStore) GetMaxPostSize() int {
+
+	return s.PostStore.GetMaxPostSize()
+
+}
+
+func (s *RetryLayerPostStore) GetNthRecentPostTime(n int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.Get(id)
+		result, err := s.PostStore.GetNthRecentPostTime(n)
 		if err == nil {
 			return result, nil
 		}
@@ -5521,11 +8501,11 @@ func (s *RetryLayerJobStore) Get(id string) (*model.Job, error) {
 
 }
 
-func (s *RetryLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error) {
+func (s *RetryLayerPostStore) GetOldest() (*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByStatus(status)
+		result, err := s.PostStore.GetOldest()
 		if err == nil {
 			return result, nil
 		}
@@ -5542,11 +8522,11 @@ func (s *RetryLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error)
 
 }
 
-func (s *RetryLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error) {
+func (s *RetryLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByType(jobType)
+		result, err := s.PostStore.GetOldestEntityCreationTime()
 		if err == nil {
 			return result, nil
 		}
@@ -5563,11 +8543,11 @@ func (s *RetryLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error)
 
 }
 
-func (s *RetryLayerJobStore) GetAllByTypeAndStatus(jobType string, status string) ([]*model.Job, error) {
+func (s *RetryLayerPostStore) GetParentsForExportAfter(limit int, afterID string) ([]*model.PostForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByTypeAndStatus(jobType, status)
+		result, err := s.PostStore.GetParentsForExportAfter(limit, afterID)
 		if err == nil {
 			return result, nil
 		}
@@ -5584,11 +8564,11 @@ func (s *RetryLayerJobStore) GetAllByTypeAndStatus(jobType string, status string
 
 }
 
-func (s *RetryLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error) {
+func (s *RetryLayerPostStore) GetPostAfterTime(channelID string, timestamp int64, collapsedThreads bool) (*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByTypePage(jobType, offset, limit)
+		result, err := s.PostStore.GetPostAfterTime(channelID, timestamp, collapsedThreads)
 		if err == nil {
 			return result, nil
 		}
@@ -5605,11 +8585,11 @@ func (s *RetryLayerJobStore) GetAllByTypePage(jobType string, offset int, limit
 
 }
 
-func (s *RetryLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, limit int) ([]*model.Job, error) {
+func (s *RetryLayerPostStore) GetPostIdAfterTime(channelID string, timestamp int64, collapsedThreads bool) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByTypesPage(jobTypes, offset, limit)
+		result, err := s.PostStore.GetPostIdAfterTime(channelID, timestamp, collapsedThreads)
 		if err == nil {
 			return result, nil
 		}
@@ -5626,11 +8606,11 @@ func (s *RetryLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, li
 
 }
 
-func (s *RetryLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, error) {
+func (s *RetryLayerPostStore) GetPostIdBeforeTime(channelID string, timestamp int64, collapsedThreads bool) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllPage(offset, limit)
+		result, err := s.PostStore.GetPostIdBeforeTime(channelID, timestamp, collapsedThreads)
 		if err == nil {
 			return result, nil
 		}
@@ -5647,11 +8627,11 @@ func (s *RetryLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, er
 
 }
 
-func (s *RetryLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, error) {
+func (s *RetryLayerPostStore) GetPostReminderMetadata(postID string) (*store.PostReminderMetadata, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetCountByStatusAndType(status, jobType)
+		result, err := s.PostStore.GetPostReminderMetadata(postID)
 		if err == nil {
 			return result, nil
 		}
@@ -5668,11 +8648,11 @@ func (s *RetryLayerJobStore) GetCountByStatusAndType(status string, jobType stri
 
 }
 
-func (s *RetryLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
+func (s *RetryLayerPostStore) GetPostReminders(now int64) ([]*model.PostReminder, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
+		result, err := s.PostStore.GetPostReminders(now)
 		if err == nil {
 			return result, nil
 		}
@@ -5689,11 +8669,11 @@ func (s *RetryLayerJobStore) GetNewestJobByStatusAndType(status string, jobType
 
 }
 
-func (s *RetryLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error) {
+func (s *RetryLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetNewestJobByStatusesAndType(statuses, jobType)
+		result, err := s.PostStore.GetPosts(options, allowFromCache, sanitizeOptions)
 		if err == nil {
 			return result, nil
 		}
@@ -5710,11 +8690,11 @@ func (s *RetryLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jo
 
 }
 
-func (s *RetryLayerJobStore) Save(job *model.Job) (*model.Job, error) {
+func (s *RetryLayerPostStore) GetPostsAfter(options model.GetPostsOptions, sanitizeOptions map[string]bool) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.Save(job)
+		result, err := s.PostStore.GetPostsAfter(options, sanitizeOptions)
 		if err == nil {
 			return result, nil
 		}
@@ -5731,11 +8711,11 @@ func (s *RetryLayerJobStore) Save(job *model.Job) (*model.Job, error) {
 
 }
 
-func (s *RetryLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
+func (s *RetryLayerPostStore) GetPostsBatchForIndexing(startTime int64, startPostID string, limit int) ([]*model.PostForIndexing, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.UpdateOptimistically(job, currentStatus)
+		result, err := s.PostStore.GetPostsBatchForIndexing(startTime, startPostID, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -5752,11 +8732,11 @@ func (s *RetryLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus
 
 }
 
-func (s *RetryLayerJobStore) UpdateStatus(id string, status string) (*model.Job, error) {
+func (s *RetryLayerPostStore) GetPostsBefore(options model.GetPostsOptions, sanitizeOptions map[string]bool) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.UpdateStatus(id, status)
+		result, err := s.PostStore.GetPostsBefore(options, sanitizeOptions)
 		if err == nil {
 			return result, nil
 		}
@@ -5773,11 +8753,11 @@ func (s *RetryLayerJobStore) UpdateStatus(id string, status string) (*model.Job,
 
 }
 
-func (s *RetryLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, error) {
+func (s *RetryLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
+		result, err := s.PostStore.GetPostsByIds(postIds)
 		if err == nil {
 			return result, nil
 		}
@@ -5794,11 +8774,11 @@ func (s *RetryLayerJobStore) UpdateStatusOptimistically(id string, currentStatus
 
 }
 
-func (s *RetryLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
+func (s *RetryLayerPostStore) GetPostsByThread(threadID string, since int64) ([]*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.LicenseStore.Get(id)
+		result, err := s.PostStore.GetPostsByThread(threadID, since)
 		if err == nil {
 			return result, nil
 		}
@@ -5815,11 +8795,11 @@ func (s *RetryLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
 
 }
 
-func (s *RetryLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
+func (s *RetryLayerPostStore) GetPostsCreatedAt(channelID string, timestamp int64) ([]*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.LicenseStore.GetAll()
+		result, err := s.PostStore.GetPostsCreatedAt(channelID, timestamp)
 		if err == nil {
 			return result, nil
 		}
@@ -5836,11 +8816,11 @@ func (s *RetryLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
 
 }
 
-func (s *RetryLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
+func (s *RetryLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.LicenseStore.Save(license)
+		result, err := s.PostStore.GetPostsSince(options, allowFromCache, sanitizeOptions)
 		if err == nil {
 			return result, nil
 		}
@@ -5857,32 +8837,32 @@ func (s *RetryLayerLicenseStore) Save(license *model.LicenseRecord) (*model.Lice
 
 }
 
-func (s *RetryLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
+func (s *RetryLayerPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error) {
 
 	tries := 0
 	for {
-		result, err := s.LinkMetadataStore.Get(url, timestamp)
+		result, resultVar1, err := s.PostStore.GetPostsSinceForSync(options, cursor, limit)
 		if err == nil {
-			return result, nil
+			return result, resultVar1, nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return result, resultVar1, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return result, resultVar1, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
+func (s *RetryLayerPostStore) GetRecentSearchesForUser(userID string) ([]*model.SearchParams, error) {
 
 	tries := 0
 	for {
-		result, err := s.LinkMetadataStore.Save(linkMetadata)
+		result, err := s.PostStore.GetRecentSearchesForUser(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -5899,32 +8879,32 @@ func (s *RetryLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*m
 
 }
 
-func (s *RetryLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
+func (s *RetryLayerPostStore) GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error) {
 
 	tries := 0
 	for {
-		err := s.NotifyAdminStore.DeleteBefore(trial, now)
+		result, err := s.PostStore.GetRepliesForExport(parentID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData, error) {
+func (s *RetryLayerPostStore) GetSingle(id string, inclDeleted bool) (*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.NotifyAdminStore.Get(trial)
+		result, err := s.PostStore.GetSingle(id, inclDeleted)
 		if err == nil {
 			return result, nil
 		}
@@ -5941,11 +8921,11 @@ func (s *RetryLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData,
 
 }
 
-func (s *RetryLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, feature model.MattermostFeature) ([]*model.NotifyAdminData, error) {
+func (s *RetryLayerPostStore) GetTopDMsForUserSince(userID string, since int64, offset int, limit int) (*model.TopDMList, error) {
 
 	tries := 0
 	for {
-		result, err := s.NotifyAdminStore.GetDataByUserIdAndFeature(userId, feature)
+		result, err := s.PostStore.GetTopDMsForUserSince(userID, since, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -5962,11 +8942,11 @@ func (s *RetryLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, fe
 
 }
 
-func (s *RetryLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.NotifyAdminData, error) {
+func (s *RetryLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userId string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.NotifyAdminStore.Save(data)
+		result, err := s.PostStore.HasAutoResponsePostByUserSince(options, userId)
 		if err == nil {
 			return result, nil
 		}
@@ -5983,11 +8963,17 @@ func (s *RetryLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.N
 
 }
 
-func (s *RetryLayerNotifyAdminStore) Update(userId string, requiredPlan string, requiredFeature model.MattermostFeature, now int64) error {
+func (s *RetryLayerPostStore) InvalidateLastPostTimeCache(channelID string) {
+
+	s.PostStore.InvalidateLastPostTimeCache(channelID)
+
+}
+
+func (s *RetryLayerPostStore) LogRecentSearch(userID string, searchQuery []byte, createAt int64) error {
 
 	tries := 0
 	for {
-		err := s.NotifyAdminStore.Update(userId, requiredPlan, requiredFeature, now)
+		err := s.PostStore.LogRecentSearch(userID, searchQuery, createAt)
 		if err == nil {
 			return nil
 		}
@@ -6004,53 +8990,53 @@ func (s *RetryLayerNotifyAdminStore) Update(userId string, requiredPlan string,
 
 }
 
-func (s *RetryLayerOAuthStore) DeleteApp(id string) error {
+func (s *RetryLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.DeleteApp(id)
+		result, err := s.PostStore.Overwrite(post)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+func (s *RetryLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAccessData(token)
+		result, resultVar1, err := s.PostStore.OverwriteMultiple(posts)
 		if err == nil {
-			return result, nil
+			return result, resultVar1, nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return result, resultVar1, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return result, resultVar1, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+func (s *RetryLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
+		result, err := s.PostStore.PermanentDeleteBatch(endTime, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -6067,74 +9053,74 @@ func (s *RetryLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model
 
 }
 
-func (s *RetryLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId string) ([]*model.AccessData, error) {
+func (s *RetryLayerPostStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAccessDataByUserForApp(userID, clientId)
+		result, resultVar1, err := s.PostStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
 		if err == nil {
-			return result, nil
+			return result, resultVar1, nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return result, resultVar1, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return result, resultVar1, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
+func (s *RetryLayerPostStore) PermanentDeleteByChannel(channelID string) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetApp(id)
+		err := s.PostStore.PermanentDeleteByChannel(channelID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAppByUser(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *RetryLayerPostStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAppByUser(userID, offset, limit)
+		err := s.PostStore.PermanentDeleteByUser(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *RetryLayerPostStore) Save(post *model.Post) (*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetApps(offset, limit)
+		result, err := s.PostStore.Save(post)
 		if err == nil {
 			return result, nil
 		}
@@ -6151,32 +9137,32 @@ func (s *RetryLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp
 
 }
 
-func (s *RetryLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
+func (s *RetryLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAuthData(code)
+		result, resultVar1, err := s.PostStore.SaveMultiple(posts)
 		if err == nil {
-			return result, nil
+			return result, resultVar1, nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return result, resultVar1, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return result, resultVar1, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *RetryLayerPostStore) Search(teamID string, userID string, params *model.SearchParams) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAuthorizedApps(userID, offset, limit)
+		result, err := s.PostStore.Search(teamID, userID, params)
 		if err == nil {
 			return result, nil
 		}
@@ -6193,11 +9179,11 @@ func (s *RetryLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limi
 
 }
 
-func (s *RetryLayerOAuthStore) GetPreviousAccessData(userID string, clientId string) (*model.AccessData, error) {
+func (s *RetryLayerPostStore) SearchPostsForUser(ctx context.Context, paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.PostSearchResults, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetPreviousAccessData(userID, clientId)
+		result, err := s.PostStore.SearchPostsForUser(ctx, paramsList, userID, teamID, page, perPage)
 		if err == nil {
 			return result, nil
 		}
@@ -6214,11 +9200,11 @@ func (s *RetryLayerOAuthStore) GetPreviousAccessData(userID string, clientId str
 
 }
 
-func (s *RetryLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
+func (s *RetryLayerPostStore) SetPostReminder(reminder *model.PostReminder) error {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.PermanentDeleteAuthDataByUser(userID)
+		err := s.PostStore.SetPostReminder(reminder)
 		if err == nil {
 			return nil
 		}
@@ -6235,32 +9221,32 @@ func (s *RetryLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) erro
 
 }
 
-func (s *RetryLayerOAuthStore) RemoveAccessData(token string) error {
+func (s *RetryLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error) {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.RemoveAccessData(token)
+		result, err := s.PostStore.Update(newPost, oldPost)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) RemoveAllAccessData() error {
+func (s *RetryLayerPostAcknowledgementStore) Delete(acknowledgement *model.PostAcknowledgement) error {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.RemoveAllAccessData()
+		err := s.PostAcknowledgementStore.Delete(acknowledgement)
 		if err == nil {
 			return nil
 		}
@@ -6277,53 +9263,53 @@ func (s *RetryLayerOAuthStore) RemoveAllAccessData() error {
 
 }
 
-func (s *RetryLayerOAuthStore) RemoveAuthData(code string) error {
+func (s *RetryLayerPostAcknowledgementStore) Get(postID string, userID string) (*model.PostAcknowledgement, error) {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.RemoveAuthData(code)
+		result, err := s.PostAcknowledgementStore.Get(postID, userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) RemoveAuthDataByClientId(clientId string, userId string) error {
+func (s *RetryLayerPostAcknowledgementStore) GetForPost(postID string) ([]*model.PostAcknowledgement, error) {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.RemoveAuthDataByClientId(clientId, userId)
+		result, err := s.PostAcknowledgementStore.GetForPost(postID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+func (s *RetryLayerPostAcknowledgementStore) GetForPosts(postIds []string) ([]*model.PostAcknowledgement, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.SaveAccessData(accessData)
+		result, err := s.PostAcknowledgementStore.GetForPosts(postIds)
 		if err == nil {
 			return result, nil
 		}
@@ -6340,11 +9326,11 @@ func (s *RetryLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*mo
 
 }
 
-func (s *RetryLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+func (s *RetryLayerPostAcknowledgementStore) Save(postID string, userID string, acknowledgedAt int64) (*model.PostAcknowledgement, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.SaveApp(app)
+		result, err := s.PostAcknowledgementStore.Save(postID, userID, acknowledgedAt)
 		if err == nil {
 			return result, nil
 		}
@@ -6361,32 +9347,32 @@ func (s *RetryLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, er
 
 }
 
-func (s *RetryLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
+func (s *RetryLayerPostEmbeddingStore) Delete(postIDs []string) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.SaveAuthData(authData)
+		err := s.PostEmbeddingStore.Delete(postIDs)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+func (s *RetryLayerPostEmbeddingStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.UpdateAccessData(accessData)
+		result, err := s.PostEmbeddingStore.DeleteOrphanedRows(limit)
 		if err == nil {
 			return result, nil
 		}
@@ -6403,11 +9389,11 @@ func (s *RetryLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*
 
 }
 
-func (s *RetryLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+func (s *RetryLayerPostEmbeddingStore) Get(postID string) (*model.PostEmbedding, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.UpdateApp(app)
+		result, err := s.PostEmbeddingStore.Get(postID)
 		if err == nil {
 			return result, nil
 		}
@@ -6424,32 +9410,32 @@ func (s *RetryLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp,
 
 }
 
-func (s *RetryLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
+func (s *RetryLayerPostEmbeddingStore) Save(embeddings []*model.PostEmbedding) error {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.CompareAndDelete(keyVal, oldValue)
+		err := s.PostEmbeddingStore.Save(embeddings)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
+func (s *RetryLayerPostEmbeddingStore) Search(embedding []float32, embeddingModel string, channelIDs []string, limit int) ([]*model.PostEmbeddingMatch, error) {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.CompareAndSet(keyVal, oldValue)
+		result, err := s.PostEmbeddingStore.Search(embedding, embeddingModel, channelIDs, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -6466,11 +9452,11 @@ func (s *RetryLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldV
 
 }
 
-func (s *RetryLayerPluginStore) Delete(pluginID string, key string) error {
+func (s *RetryLayerPostExpirationStore) Delete(postIDs []string) error {
 
 	tries := 0
 	for {
-		err := s.PluginStore.Delete(pluginID, key)
+		err := s.PostExpirationStore.Delete(postIDs)
 		if err == nil {
 			return nil
 		}
@@ -6487,53 +9473,53 @@ func (s *RetryLayerPluginStore) Delete(pluginID string, key string) error {
 
 }
 
-func (s *RetryLayerPluginStore) DeleteAllExpired() error {
+func (s *RetryLayerPostExpirationStore) GetExpiredBefore(until int64, limit int) ([]*model.PostExpiration, error) {
 
 	tries := 0
 	for {
-		err := s.PluginStore.DeleteAllExpired()
+		result, err := s.PostExpirationStore.GetExpiredBefore(until, limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) DeleteAllForPlugin(PluginID string) error {
+func (s *RetryLayerPostPriorityStore) GetForPost(postId string) (*model.PostPriority, error) {
 
 	tries := 0
 	for {
-		err := s.PluginStore.DeleteAllForPlugin(PluginID)
+		result, err := s.PostPriorityStore.GetForPost(postId)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) Get(pluginID string, key string) (*model.PluginKeyValue, error) {
+func (s *RetryLayerPostPriorityStore) GetForPosts(ids []string) ([]*model.PostPriority, error) {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.Get(pluginID, key)
+		result, err := s.PostPriorityStore.GetForPosts(ids)
 		if err == nil {
 			return result, nil
 		}
@@ -6550,11 +9536,32 @@ func (s *RetryLayerPluginStore) Get(pluginID string, key string) (*model.PluginK
 
 }
 
-func (s *RetryLayerPluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
+func (s *RetryLayerPostTranslationStore) DeleteForPost(postID string) error {
+
+	tries := 0
+	for {
+		err := s.PostTranslationStore.DeleteForPost(postID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPostTranslationStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.List(pluginID, page, perPage)
+		result, err := s.PostTranslationStore.DeleteOrphanedRows(limit)
 		if err == nil {
 			return result, nil
 		}
@@ -6571,11 +9578,11 @@ func (s *RetryLayerPluginStore) List(pluginID string, page int, perPage int) ([]
 
 }
 
-func (s *RetryLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
+func (s *RetryLayerPostTranslationStore) Get(postID string, language string) (*model.PostTranslation, error) {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.SaveOrUpdate(keyVal)
+		result, err := s.PostTranslationStore.Get(postID, language)
 		if err == nil {
 			return result, nil
 		}
@@ -6592,11 +9599,11 @@ func (s *RetryLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*mod
 
 }
 
-func (s *RetryLayerPluginStore) SetWithOptions(pluginID string, key string, value []byte, options model.PluginKVSetOptions) (bool, error) {
+func (s *RetryLayerPostTranslationStore) Save(translation *model.PostTranslation) (*model.PostTranslation, error) {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.SetWithOptions(pluginID, key, value, options)
+		result, err := s.PostTranslationStore.Save(translation)
 		if err == nil {
 			return result, nil
 		}
@@ -6613,11 +9620,11 @@ func (s *RetryLayerPluginStore) SetWithOptions(pluginID string, key string, valu
 
 }
 
-func (s *RetryLayerPostStore) AnalyticsPostCount(options *model.PostCountOptions) (int64, error) {
+func (s *RetryLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.AnalyticsPostCount(options)
+		result, err := s.PreferenceStore.CleanupFlagsBatch(limit)
 		if err == nil {
 			return result, nil
 		}
@@ -6634,59 +9641,53 @@ func (s *RetryLayerPostStore) AnalyticsPostCount(options *model.PostCountOptions
 
 }
 
-func (s *RetryLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, error) {
+func (s *RetryLayerPreferenceStore) Delete(userID string, category string, name string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.AnalyticsPostCountsByDay(options)
+		err := s.PreferenceStore.Delete(userID, category, name)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamID string) (model.AnalyticsRows, error) {
+func (s *RetryLayerPreferenceStore) DeleteCategory(userID string, category string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.AnalyticsUserCountsWithPostsByDay(teamID)
+		err := s.PreferenceStore.DeleteCategory(userID, category)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) ClearCaches() {
-
-	s.PostStore.ClearCaches()
-
-}
-
-func (s *RetryLayerPostStore) Delete(postID string, timestamp int64, deleteByID string) error {
+func (s *RetryLayerPreferenceStore) DeleteCategoryAndName(category string, name string) error {
 
 	tries := 0
 	for {
-		err := s.PostStore.Delete(postID, timestamp, deleteByID)
+		err := s.PreferenceStore.DeleteCategoryAndName(category, name)
 		if err == nil {
 			return nil
 		}
@@ -6703,11 +9704,11 @@ func (s *RetryLayerPostStore) Delete(postID string, timestamp int64, deleteByID
 
 }
 
-func (s *RetryLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.DeleteOrphanedRows(limit)
+		result, err := s.PreferenceStore.DeleteOrphanedRows(limit)
 		if err == nil {
 			return result, nil
 		}
@@ -6724,11 +9725,11 @@ func (s *RetryLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 }
 
-func (s *RetryLayerPostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *RetryLayerPreferenceStore) Get(userID string, category string, name string) (*model.Preference, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Get(ctx, id, opts, userID, sanitizeOptions)
+		result, err := s.PreferenceStore.Get(userID, category, name)
 		if err == nil {
 			return result, nil
 		}
@@ -6745,11 +9746,11 @@ func (s *RetryLayerPostStore) Get(ctx context.Context, id string, opts model.Get
 
 }
 
-func (s *RetryLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error) {
+func (s *RetryLayerPreferenceStore) GetAll(userID string) (model.Preferences, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterID)
+		result, err := s.PreferenceStore.GetAll(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -6766,11 +9767,11 @@ func (s *RetryLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afte
 
 }
 
-func (s *RetryLayerPostStore) GetEditHistoryForPost(postId string) ([]*model.Post, error) {
+func (s *RetryLayerPreferenceStore) GetCategory(userID string, category string) (model.Preferences, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetEditHistoryForPost(postId)
+		result, err := s.PreferenceStore.GetCategory(userID, category)
 		if err == nil {
 			return result, nil
 		}
@@ -6787,17 +9788,11 @@ func (s *RetryLayerPostStore) GetEditHistoryForPost(postId string) ([]*model.Pos
 
 }
 
-func (s *RetryLayerPostStore) GetEtag(channelID string, allowFromCache bool, collapsedThreads bool) string {
-
-	return s.PostStore.GetEtag(channelID, allowFromCache, collapsedThreads)
-
-}
-
-func (s *RetryLayerPostStore) GetFlaggedPosts(userID string, offset int, limit int) (*model.PostList, error) {
+func (s *RetryLayerPreferenceStore) GetCategoryAndName(category string, nane string) (model.Preferences, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetFlaggedPosts(userID, offset, limit)
+		result, err := s.PreferenceStore.GetCategoryAndName(category, nane)
 		if err == nil {
 			return result, nil
 		}
@@ -6814,11 +9809,11 @@ func (s *RetryLayerPostStore) GetFlaggedPosts(userID string, offset int, limit i
 
 }
 
-func (s *RetryLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID string, offset int, limit int) (*model.PostList, error) {
+func (s *RetryLayerPreferenceStore) GetCategoryAndNameForUsers(userIDs []string, category string, name string) (model.Preferences, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetFlaggedPostsForChannel(userID, channelID, offset, limit)
+		result, err := s.PreferenceStore.GetCategoryAndNameForUsers(userIDs, category, name)
 		if err == nil {
 			return result, nil
 		}
@@ -6835,101 +9830,95 @@ func (s *RetryLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID
 
 }
 
-func (s *RetryLayerPostStore) GetFlaggedPostsForTeam(userID string, teamID string, offset int, limit int) (*model.PostList, error) {
+func (s *RetryLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetFlaggedPostsForTeam(userID, teamID, offset, limit)
+		err := s.PreferenceStore.PermanentDeleteByUser(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetLastPostRowCreateAt() (int64, error) {
+func (s *RetryLayerPreferenceStore) Save(preferences model.Preferences) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetLastPostRowCreateAt()
+		err := s.PreferenceStore.Save(preferences)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetMaxPostSize() int {
-
-	return s.PostStore.GetMaxPostSize()
-
-}
-
-func (s *RetryLayerPostStore) GetNthRecentPostTime(n int64) (int64, error) {
+func (s *RetryLayerProductNoticesStore) Clear(notices []string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetNthRecentPostTime(n)
+		err := s.ProductNoticesStore.Clear(notices)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetOldest() (*model.Post, error) {
+func (s *RetryLayerProductNoticesStore) ClearOldNotices(currentNotices model.ProductNotices) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetOldest()
+		err := s.ProductNoticesStore.ClearOldNotices(currentNotices)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
+func (s *RetryLayerProductNoticesStore) GetViews(userID string) ([]model.ProductNoticeViewState, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetOldestEntityCreationTime()
+		result, err := s.ProductNoticesStore.GetViews(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -6946,32 +9935,32 @@ func (s *RetryLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
 
 }
 
-func (s *RetryLayerPostStore) GetParentsForExportAfter(limit int, afterID string) ([]*model.PostForExport, error) {
+func (s *RetryLayerProductNoticesStore) View(userID string, notices []string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetParentsForExportAfter(limit, afterID)
+		err := s.ProductNoticesStore.View(userID, notices)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostAfterTime(channelID string, timestamp int64, collapsedThreads bool) (*model.Post, error) {
+func (s *RetryLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostAfterTime(channelID, timestamp, collapsedThreads)
+		result, err := s.ReactionStore.BulkGetForPosts(postIds)
 		if err == nil {
 			return result, nil
 		}
@@ -6988,11 +9977,11 @@ func (s *RetryLayerPostStore) GetPostAfterTime(channelID string, timestamp int64
 
 }
 
-func (s *RetryLayerPostStore) GetPostIdAfterTime(channelID string, timestamp int64, collapsedThreads bool) (string, error) {
+func (s *RetryLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostIdAfterTime(channelID, timestamp, collapsedThreads)
+		result, err := s.ReactionStore.Delete(reaction)
 		if err == nil {
 			return result, nil
 		}
@@ -7009,32 +9998,32 @@ func (s *RetryLayerPostStore) GetPostIdAfterTime(channelID string, timestamp int
 
 }
 
-func (s *RetryLayerPostStore) GetPostIdBeforeTime(channelID string, timestamp int64, collapsedThreads bool) (string, error) {
+func (s *RetryLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostIdBeforeTime(channelID, timestamp, collapsedThreads)
+		err := s.ReactionStore.DeleteAllWithEmojiName(emojiName)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostReminderMetadata(postID string) (*store.PostReminderMetadata, error) {
+func (s *RetryLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostReminderMetadata(postID)
+		result, err := s.ReactionStore.DeleteOrphanedRows(limit)
 		if err == nil {
 			return result, nil
 		}
@@ -7051,11 +10040,11 @@ func (s *RetryLayerPostStore) GetPostReminderMetadata(postID string) (*store.Pos
 
 }
 
-func (s *RetryLayerPostStore) GetPostReminders(now int64) ([]*model.PostReminder, error) {
+func (s *RetryLayerReactionStore) GetForPost(postID string, allowFromCache bool) ([]*model.Reaction, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostReminders(now)
+		result, err := s.ReactionStore.GetForPost(postID, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -7072,11 +10061,11 @@ func (s *RetryLayerPostStore) GetPostReminders(now int64) ([]*model.PostReminder
 
 }
 
-func (s *RetryLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *RetryLayerReactionStore) GetForPostSince(postId string, since int64, excludeRemoteId string, inclDeleted bool) ([]*model.Reaction, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPosts(options, allowFromCache, sanitizeOptions)
+		result, err := s.ReactionStore.GetForPostSince(postId, since, excludeRemoteId, inclDeleted)
 		if err == nil {
 			return result, nil
 		}
@@ -7093,11 +10082,11 @@ func (s *RetryLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromC
 
 }
 
-func (s *RetryLayerPostStore) GetPostsAfter(options model.GetPostsOptions, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *RetryLayerReactionStore) GetTopForTeamSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopReactionList, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsAfter(options, sanitizeOptions)
+		result, err := s.ReactionStore.GetTopForTeamSince(teamID, userID, since, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -7114,11 +10103,11 @@ func (s *RetryLayerPostStore) GetPostsAfter(options model.GetPostsOptions, sanit
 
 }
 
-func (s *RetryLayerPostStore) GetPostsBatchForIndexing(startTime int64, startPostID string, limit int) ([]*model.PostForIndexing, error) {
+func (s *RetryLayerReactionStore) GetTopForUserSince(userID string, teamID string, since int64, offset int, limit int) (*model.TopReactionList, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsBatchForIndexing(startTime, startPostID, limit)
+		result, err := s.ReactionStore.GetTopForUserSince(userID, teamID, since, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -7135,11 +10124,11 @@ func (s *RetryLayerPostStore) GetPostsBatchForIndexing(startTime int64, startPos
 
 }
 
-func (s *RetryLayerPostStore) GetPostsBefore(options model.GetPostsOptions, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *RetryLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsBefore(options, sanitizeOptions)
+		result, err := s.ReactionStore.PermanentDeleteBatch(endTime, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -7156,11 +10145,11 @@ func (s *RetryLayerPostStore) GetPostsBefore(options model.GetPostsOptions, sani
 
 }
 
-func (s *RetryLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, error) {
+func (s *RetryLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsByIds(postIds)
+		result, err := s.ReactionStore.Save(reaction)
 		if err == nil {
 			return result, nil
 		}
@@ -7177,11 +10166,11 @@ func (s *RetryLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, er
 
 }
 
-func (s *RetryLayerPostStore) GetPostsByThread(threadID string, since int64) ([]*model.Post, error) {
+func (s *RetryLayerReactionSummaryStore) GetLatestDay() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsByThread(threadID, since)
+		result, err := s.ReactionSummaryStore.GetLatestDay()
 		if err == nil {
 			return result, nil
 		}
@@ -7198,11 +10187,11 @@ func (s *RetryLayerPostStore) GetPostsByThread(threadID string, since int64) ([]
 
 }
 
-func (s *RetryLayerPostStore) GetPostsCreatedAt(channelID string, timestamp int64) ([]*model.Post, error) {
+func (s *RetryLayerReactionSummaryStore) GetTopEmojis(opts model.ReactionAnalyticsOptions) ([]*model.ReactionEmojiCount, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsCreatedAt(channelID, timestamp)
+		result, err := s.ReactionSummaryStore.GetTopEmojis(opts)
 		if err == nil {
 			return result, nil
 		}
@@ -7219,11 +10208,11 @@ func (s *RetryLayerPostStore) GetPostsCreatedAt(channelID string, timestamp int6
 
 }
 
-func (s *RetryLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *RetryLayerReactionSummaryStore) GetTopPosts(opts model.ReactionAnalyticsOptions) ([]*model.ReactionPostCount, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsSince(options, allowFromCache, sanitizeOptions)
+		result, err := s.ReactionSummaryStore.GetTopPosts(opts)
 		if err == nil {
 			return result, nil
 		}
@@ -7240,32 +10229,32 @@ func (s *RetryLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions,
 
 }
 
-func (s *RetryLayerPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error) {
+func (s *RetryLayerReactionSummaryStore) SummarizeDay(dayStart int64) error {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.PostStore.GetPostsSinceForSync(options, cursor, limit)
+		err := s.ReactionSummaryStore.SummarizeDay(dayStart)
 		if err == nil {
-			return result, resultVar1, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetRecentSearchesForUser(userID string) ([]*model.SearchParams, error) {
+func (s *RetryLayerReadReceiptStore) Get(channelID string, userID string) (*model.ReadReceipt, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetRecentSearchesForUser(userID)
+		result, err := s.ReadReceiptStore.Get(channelID, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -7282,11 +10271,11 @@ func (s *RetryLayerPostStore) GetRecentSearchesForUser(userID string) ([]*model.
 
 }
 
-func (s *RetryLayerPostStore) GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error) {
+func (s *RetryLayerReadReceiptStore) GetForChannelSince(channelID string, postCreateAt int64) ([]*model.ReadReceipt, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetRepliesForExport(parentID)
+		result, err := s.ReadReceiptStore.GetForChannelSince(channelID, postCreateAt)
 		if err == nil {
 			return result, nil
 		}
@@ -7303,32 +10292,32 @@ func (s *RetryLayerPostStore) GetRepliesForExport(parentID string) ([]*model.Rep
 
 }
 
-func (s *RetryLayerPostStore) GetSingle(id string, inclDeleted bool) (*model.Post, error) {
+func (s *RetryLayerReadReceiptStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetSingle(id, inclDeleted)
+		err := s.ReadReceiptStore.PermanentDeleteByUser(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetTopDMsForUserSince(userID string, since int64, offset int, limit int) (*model.TopDMList, error) {
+func (s *RetryLayerReadReceiptStore) Save(receipt *model.ReadReceipt) (*model.ReadReceipt, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetTopDMsForUserSince(userID, since, offset, limit)
+		result, err := s.ReadReceiptStore.Save(receipt)
 		if err == nil {
 			return result, nil
 		}
@@ -7345,11 +10334,11 @@ func (s *RetryLayerPostStore) GetTopDMsForUserSince(userID string, since int64,
 
 }
 
-func (s *RetryLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userId string) (bool, error) {
+func (s *RetryLayerRemoteClusterStore) Delete(remoteClusterId string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.HasAutoResponsePostByUserSince(options, userId)
+		result, err := s.RemoteClusterStore.Delete(remoteClusterId)
 		if err == nil {
 			return result, nil
 		}
@@ -7366,38 +10355,53 @@ func (s *RetryLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPo
 
 }
 
-func (s *RetryLayerPostStore) InvalidateLastPostTimeCache(channelID string) {
+func (s *RetryLayerRemoteClusterStore) Get(remoteClusterId string) (*model.RemoteCluster, error) {
 
-	s.PostStore.InvalidateLastPostTimeCache(channelID)
+	tries := 0
+	for {
+		result, err := s.RemoteClusterStore.Get(remoteClusterId)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
 
 }
 
-func (s *RetryLayerPostStore) LogRecentSearch(userID string, searchQuery []byte, createAt int64) error {
+func (s *RetryLayerRemoteClusterStore) GetAll(filter model.RemoteClusterQueryFilter) ([]*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		err := s.PostStore.LogRecentSearch(userID, searchQuery, createAt)
+		result, err := s.RemoteClusterStore.GetAll(filter)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
+func (s *RetryLayerRemoteClusterStore) Save(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Overwrite(post)
+		result, err := s.RemoteClusterStore.Save(rc)
 		if err == nil {
 			return result, nil
 		}
@@ -7414,32 +10418,32 @@ func (s *RetryLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
 
 }
 
-func (s *RetryLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+func (s *RetryLayerRemoteClusterStore) SetLastPingAt(remoteClusterId string) error {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.PostStore.OverwriteMultiple(posts)
+		err := s.RemoteClusterStore.SetLastPingAt(remoteClusterId)
 		if err == nil {
-			return result, resultVar1, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *RetryLayerRemoteClusterStore) Update(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.PermanentDeleteBatch(endTime, limit)
+		result, err := s.RemoteClusterStore.Update(rc)
 		if err == nil {
 			return result, nil
 		}
@@ -7456,32 +10460,32 @@ func (s *RetryLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (
 
 }
 
-func (s *RetryLayerPostStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+func (s *RetryLayerRemoteClusterStore) UpdateTopics(remoteClusterId string, topics string) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.PostStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+		result, err := s.RemoteClusterStore.UpdateTopics(remoteClusterId, topics)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) PermanentDeleteByChannel(channelID string) error {
+func (s *RetryLayerRetentionPolicyStore) AddChannels(policyId string, channelIds []string) error {
 
 	tries := 0
 	for {
-		err := s.PostStore.PermanentDeleteByChannel(channelID)
+		err := s.RetentionPolicyStore.AddChannels(policyId, channelIds)
 		if err == nil {
 			return nil
 		}
@@ -7498,11 +10502,11 @@ func (s *RetryLayerPostStore) PermanentDeleteByChannel(channelID string) error {
 
 }
 
-func (s *RetryLayerPostStore) PermanentDeleteByUser(userID string) error {
+func (s *RetryLayerRetentionPolicyStore) AddTeams(policyId string, teamIds []string) error {
 
 	tries := 0
 	for {
-		err := s.PostStore.PermanentDeleteByUser(userID)
+		err := s.RetentionPolicyStore.AddTeams(policyId, teamIds)
 		if err == nil {
 			return nil
 		}
@@ -7519,53 +10523,53 @@ func (s *RetryLayerPostStore) PermanentDeleteByUser(userID string) error {
 
 }
 
-func (s *RetryLayerPostStore) Save(post *model.Post) (*model.Post, error) {
+func (s *RetryLayerRetentionPolicyStore) Delete(id string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Save(post)
+		err := s.RetentionPolicyStore.Delete(id)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+func (s *RetryLayerRetentionPolicyStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.PostStore.SaveMultiple(posts)
+		result, err := s.RetentionPolicyStore.DeleteOrphanedRows(limit)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) Search(teamID string, userID string, params *model.SearchParams) (*model.PostList, error) {
+func (s *RetryLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Search(teamID, userID, params)
+		result, err := s.RetentionPolicyStore.Get(id)
 		if err == nil {
 			return result, nil
 		}
@@ -7582,11 +10586,11 @@ func (s *RetryLayerPostStore) Search(teamID string, userID string, params *model
 
 }
 
-func (s *RetryLayerPostStore) SearchPostsForUser(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.PostSearchResults, error) {
+func (s *RetryLayerRetentionPolicyStore) GetAll(offset int, limit int) ([]*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.SearchPostsForUser(paramsList, userID, teamID, page, perPage)
+		result, err := s.RetentionPolicyStore.GetAll(offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -7603,32 +10607,32 @@ func (s *RetryLayerPostStore) SearchPostsForUser(paramsList []*model.SearchParam
 
 }
 
-func (s *RetryLayerPostStore) SetPostReminder(reminder *model.PostReminder) error {
+func (s *RetryLayerRetentionPolicyStore) GetChannelPoliciesCountForUser(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.PostStore.SetPostReminder(reminder)
+		result, err := s.RetentionPolicyStore.GetChannelPoliciesCountForUser(userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error) {
+func (s *RetryLayerRetentionPolicyStore) GetChannelPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForChannel, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Update(newPost, oldPost)
+		result, err := s.RetentionPolicyStore.GetChannelPoliciesForUser(userID, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -7645,32 +10649,32 @@ func (s *RetryLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (
 
 }
 
-func (s *RetryLayerPostAcknowledgementStore) Delete(acknowledgement *model.PostAcknowledgement) error {
+func (s *RetryLayerRetentionPolicyStore) GetChannels(policyId string, offset int, limit int) (model.ChannelListWithTeamData, error) {
 
 	tries := 0
 	for {
-		err := s.PostAcknowledgementStore.Delete(acknowledgement)
+		result, err := s.RetentionPolicyStore.GetChannels(policyId, offset, limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostAcknowledgementStore) Get(postID string, userID string) (*model.PostAcknowledgement, error) {
+func (s *RetryLayerRetentionPolicyStore) GetChannelsCount(policyId string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostAcknowledgementStore.Get(postID, userID)
+		result, err := s.RetentionPolicyStore.GetChannelsCount(policyId)
 		if err == nil {
 			return result, nil
 		}
@@ -7687,11 +10691,11 @@ func (s *RetryLayerPostAcknowledgementStore) Get(postID string, userID string) (
 
 }
 
-func (s *RetryLayerPostAcknowledgementStore) GetForPost(postID string) ([]*model.PostAcknowledgement, error) {
+func (s *RetryLayerRetentionPolicyStore) GetCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostAcknowledgementStore.GetForPost(postID)
+		result, err := s.RetentionPolicyStore.GetCount()
 		if err == nil {
 			return result, nil
 		}
@@ -7708,11 +10712,11 @@ func (s *RetryLayerPostAcknowledgementStore) GetForPost(postID string) ([]*model
 
 }
 
-func (s *RetryLayerPostAcknowledgementStore) GetForPosts(postIds []string) ([]*model.PostAcknowledgement, error) {
+func (s *RetryLayerRetentionPolicyStore) GetTeamPoliciesCountForUser(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostAcknowledgementStore.GetForPosts(postIds)
+		result, err := s.RetentionPolicyStore.GetTeamPoliciesCountForUser(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -7729,11 +10733,11 @@ func (s *RetryLayerPostAcknowledgementStore) GetForPosts(postIds []string) ([]*m
 
 }
 
-func (s *RetryLayerPostAcknowledgementStore) Save(postID string, userID string, acknowledgedAt int64) (*model.PostAcknowledgement, error) {
+func (s *RetryLayerRetentionPolicyStore) GetTeamPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForTeam, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostAcknowledgementStore.Save(postID, userID, acknowledgedAt)
+		result, err := s.RetentionPolicyStore.GetTeamPoliciesForUser(userID, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -7750,11 +10754,11 @@ func (s *RetryLayerPostAcknowledgementStore) Save(postID string, userID string,
 
 }
 
-func (s *RetryLayerPostPriorityStore) GetForPost(postId string) (*model.PostPriority, error) {
+func (s *RetryLayerRetentionPolicyStore) GetTeams(policyId string, offset int, limit int) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostPriorityStore.GetForPost(postId)
+		result, err := s.RetentionPolicyStore.GetTeams(policyId, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -7771,11 +10775,11 @@ func (s *RetryLayerPostPriorityStore) GetForPost(postId string) (*model.PostPrio
 
 }
 
-func (s *RetryLayerPostPriorityStore) GetForPosts(ids []string) ([]*model.PostPriority, error) {
+func (s *RetryLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostPriorityStore.GetForPosts(ids)
+		result, err := s.RetentionPolicyStore.GetTeamsCount(policyId)
 		if err == nil {
 			return result, nil
 		}
@@ -7792,11 +10796,11 @@ func (s *RetryLayerPostPriorityStore) GetForPosts(ids []string) ([]*model.PostPr
 
 }
 
-func (s *RetryLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error) {
+func (s *RetryLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.CleanupFlagsBatch(limit)
+		result, err := s.RetentionPolicyStore.Patch(patch)
 		if err == nil {
 			return result, nil
 		}
@@ -7813,32 +10817,32 @@ func (s *RetryLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error
 
 }
 
-func (s *RetryLayerPreferenceStore) Delete(userID string, category string, name string) error {
+func (s *RetryLayerRetentionPolicyStore) Preview(policy *model.RetentionPolicyWithTeamAndChannelIDs, now int64) (*model.RetentionPolicyPreview, error) {
 
 	tries := 0
 	for {
-		err := s.PreferenceStore.Delete(userID, category, name)
+		result, err := s.RetentionPolicyStore.Preview(policy, now)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) DeleteCategory(userID string, category string) error {
+func (s *RetryLayerRetentionPolicyStore) RemoveChannels(policyId string, channelIds []string) error {
 
 	tries := 0
 	for {
-		err := s.PreferenceStore.DeleteCategory(userID, category)
+		err := s.RetentionPolicyStore.RemoveChannels(policyId, channelIds)
 		if err == nil {
 			return nil
 		}
@@ -7855,11 +10859,11 @@ func (s *RetryLayerPreferenceStore) DeleteCategory(userID string, category strin
 
 }
 
-func (s *RetryLayerPreferenceStore) DeleteCategoryAndName(category string, name string) error {
+func (s *RetryLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []string) error {
 
 	tries := 0
 	for {
-		err := s.PreferenceStore.DeleteCategoryAndName(category, name)
+		err := s.RetentionPolicyStore.RemoveTeams(policyId, teamIds)
 		if err == nil {
 			return nil
 		}
@@ -7876,11 +10880,11 @@ func (s *RetryLayerPreferenceStore) DeleteCategoryAndName(category string, name
 
 }
 
-func (s *RetryLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.DeleteOrphanedRows(limit)
+		result, err := s.RetentionPolicyStore.Save(policy)
 		if err == nil {
 			return result, nil
 		}
@@ -7897,11 +10901,11 @@ func (s *RetryLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error)
 
 }
 
-func (s *RetryLayerPreferenceStore) Get(userID string, category string, name string) (*model.Preference, error) {
+func (s *RetryLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.Get(userID, category, name)
+		result, err := s.RoleStore.AllChannelSchemeRoles()
 		if err == nil {
 			return result, nil
 		}
@@ -7918,11 +10922,11 @@ func (s *RetryLayerPreferenceStore) Get(userID string, category string, name str
 
 }
 
-func (s *RetryLayerPreferenceStore) GetAll(userID string) (model.Preferences, error) {
+func (s *RetryLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, error) {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.GetAll(userID)
+		result, err := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
 		if err == nil {
 			return result, nil
 		}
@@ -7939,11 +10943,11 @@ func (s *RetryLayerPreferenceStore) GetAll(userID string) (model.Preferences, er
 
 }
 
-func (s *RetryLayerPreferenceStore) GetCategory(userID string, category string) (model.Preferences, error) {
+func (s *RetryLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.GetCategory(userID, category)
+		result, err := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
 		if err == nil {
 			return result, nil
 		}
@@ -7960,11 +10964,11 @@ func (s *RetryLayerPreferenceStore) GetCategory(userID string, category string)
 
 }
 
-func (s *RetryLayerPreferenceStore) GetCategoryAndName(category string, nane string) (model.Preferences, error) {
+func (s *RetryLayerRoleStore) Delete(roleID string) (*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.GetCategoryAndName(category, nane)
+		result, err := s.RoleStore.Delete(roleID)
 		if err == nil {
 			return result, nil
 		}
@@ -7981,95 +10985,74 @@ func (s *RetryLayerPreferenceStore) GetCategoryAndName(category string, nane str
 
 }
 
-func (s *RetryLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
-
-	tries := 0
-	for {
-		err := s.PreferenceStore.PermanentDeleteByUser(userID)
-		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
-		}
-		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
-		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
-	}
-
-}
-
-func (s *RetryLayerPreferenceStore) Save(preferences model.Preferences) error {
+func (s *RetryLayerRoleStore) Get(roleID string) (*model.Role, error) {
 
 	tries := 0
 	for {
-		err := s.PreferenceStore.Save(preferences)
+		result, err := s.RoleStore.Get(roleID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerProductNoticesStore) Clear(notices []string) error {
+func (s *RetryLayerRoleStore) GetAll() ([]*model.Role, error) {
 
 	tries := 0
 	for {
-		err := s.ProductNoticesStore.Clear(notices)
+		result, err := s.RoleStore.GetAll()
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerProductNoticesStore) ClearOldNotices(currentNotices model.ProductNotices) error {
+func (s *RetryLayerRoleStore) GetByName(ctx context.Context, name string) (*model.Role, error) {
 
 	tries := 0
 	for {
-		err := s.ProductNoticesStore.ClearOldNotices(currentNotices)
+		result, err := s.RoleStore.GetByName(ctx, name)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerProductNoticesStore) GetViews(userID string) ([]model.ProductNoticeViewState, error) {
+func (s *RetryLayerRoleStore) GetByNames(names []string) ([]*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.ProductNoticesStore.GetViews(userID)
+		result, err := s.RoleStore.GetByNames(names)
 		if err == nil {
 			return result, nil
 		}
@@ -8086,11 +11069,11 @@ func (s *RetryLayerProductNoticesStore) GetViews(userID string) ([]model.Product
 
 }
 
-func (s *RetryLayerProductNoticesStore) View(userID string, notices []string) error {
+func (s *RetryLayerRoleStore) PermanentDeleteAll() error {
 
 	tries := 0
 	for {
-		err := s.ProductNoticesStore.View(userID, notices)
+		err := s.RoleStore.PermanentDeleteAll()
 		if err == nil {
 			return nil
 		}
@@ -8107,11 +11090,11 @@ func (s *RetryLayerProductNoticesStore) View(userID string, notices []string) er
 
 }
 
-func (s *RetryLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
+func (s *RetryLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.BulkGetForPosts(postIds)
+		result, err := s.RoleStore.Save(role)
 		if err == nil {
 			return result, nil
 		}
@@ -8128,11 +11111,11 @@ func (s *RetryLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Re
 
 }
 
-func (s *RetryLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
+func (s *RetryLayerRoleSnapshotStore) Get(id string) (*model.RoleSnapshot, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.Delete(reaction)
+		result, err := s.RoleSnapshotStore.Get(id)
 		if err == nil {
 			return result, nil
 		}
@@ -8149,32 +11132,11 @@ func (s *RetryLayerReactionStore) Delete(reaction *model.Reaction) (*model.React
 
 }
 
-func (s *RetryLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
-
-	tries := 0
-	for {
-		err := s.ReactionStore.DeleteAllWithEmojiName(emojiName)
-		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
-		}
-		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
-		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
-	}
-
-}
-
-func (s *RetryLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerRoleSnapshotStore) GetForRole(roleID string, offset int, limit int) ([]*model.RoleSnapshot, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.DeleteOrphanedRows(limit)
+		result, err := s.RoleSnapshotStore.GetForRole(roleID, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -8191,32 +11153,32 @@ func (s *RetryLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 }
 
-func (s *RetryLayerReactionStore) GetForPost(postID string, allowFromCache bool) ([]*model.Reaction, error) {
+func (s *RetryLayerRoleSnapshotStore) PermanentDeleteByRole(roleID string) error {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.GetForPost(postID, allowFromCache)
+		err := s.RoleSnapshotStore.PermanentDeleteByRole(roleID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerReactionStore) GetForPostSince(postId string, since int64, excludeRemoteId string, inclDeleted bool) ([]*model.Reaction, error) {
+func (s *RetryLayerRoleSnapshotStore) Save(snapshot *model.RoleSnapshot) (*model.RoleSnapshot, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.GetForPostSince(postId, since, excludeRemoteId, inclDeleted)
+		result, err := s.RoleSnapshotStore.Save(snapshot)
 		if err == nil {
 			return result, nil
 		}
@@ -8233,11 +11195,11 @@ func (s *RetryLayerReactionStore) GetForPostSince(postId string, since int64, ex
 
 }
 
-func (s *RetryLayerReactionStore) GetTopForTeamSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopReactionList, error) {
+func (s *RetryLayerScheduledPostStore) CountPendingForUser(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.GetTopForTeamSince(teamID, userID, since, offset, limit)
+		result, err := s.ScheduledPostStore.CountPendingForUser(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -8254,32 +11216,32 @@ func (s *RetryLayerReactionStore) GetTopForTeamSince(teamID string, userID strin
 
 }
 
-func (s *RetryLayerReactionStore) GetTopForUserSince(userID string, teamID string, since int64, offset int, limit int) (*model.TopReactionList, error) {
+func (s *RetryLayerScheduledPostStore) Delete(id string) error {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.GetTopForUserSince(userID, teamID, since, offset, limit)
+		err := s.ScheduledPostStore.Delete(id)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *RetryLayerScheduledPostStore) Get(id string) (*model.ScheduledPost, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.PermanentDeleteBatch(endTime, limit)
+		result, err := s.ScheduledPostStore.Get(id)
 		if err == nil {
 			return result, nil
 		}
@@ -8296,11 +11258,11 @@ func (s *RetryLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int6
 
 }
 
-func (s *RetryLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
+func (s *RetryLayerScheduledPostStore) GetForUser(userID string) ([]*model.ScheduledPost, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.Save(reaction)
+		result, err := s.ScheduledPostStore.GetForUser(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -8317,11 +11279,11 @@ func (s *RetryLayerReactionStore) Save(reaction *model.Reaction) (*model.Reactio
 
 }
 
-func (s *RetryLayerRemoteClusterStore) Delete(remoteClusterId string) (bool, error) {
+func (s *RetryLayerScheduledPostStore) GetPendingBefore(until int64, limit int) ([]*model.ScheduledPost, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.Delete(remoteClusterId)
+		result, err := s.ScheduledPostStore.GetPendingBefore(until, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -8338,11 +11300,11 @@ func (s *RetryLayerRemoteClusterStore) Delete(remoteClusterId string) (bool, err
 
 }
 
-func (s *RetryLayerRemoteClusterStore) Get(remoteClusterId string) (*model.RemoteCluster, error) {
+func (s *RetryLayerScheduledPostStore) Save(scheduledPost *model.ScheduledPost) (*model.ScheduledPost, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.Get(remoteClusterId)
+		result, err := s.ScheduledPostStore.Save(scheduledPost)
 		if err == nil {
 			return result, nil
 		}
@@ -8359,11 +11321,11 @@ func (s *RetryLayerRemoteClusterStore) Get(remoteClusterId string) (*model.Remot
 
 }
 
-func (s *RetryLayerRemoteClusterStore) GetAll(filter model.RemoteClusterQueryFilter) ([]*model.RemoteCluster, error) {
+func (s *RetryLayerScheduledPostStore) Update(scheduledPost *model.ScheduledPost) (*model.ScheduledPost, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.GetAll(filter)
+		result, err := s.ScheduledPostStore.Update(scheduledPost)
 		if err == nil {
 			return result, nil
 		}
@@ -8380,11 +11342,11 @@ func (s *RetryLayerRemoteClusterStore) GetAll(filter model.RemoteClusterQueryFil
 
 }
 
-func (s *RetryLayerRemoteClusterStore) Save(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
+func (s *RetryLayerSchemeStore) CountByScope(scope string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.Save(rc)
+		result, err := s.SchemeStore.CountByScope(scope)
 		if err == nil {
 			return result, nil
 		}
@@ -8401,32 +11363,32 @@ func (s *RetryLayerRemoteClusterStore) Save(rc *model.RemoteCluster) (*model.Rem
 
 }
 
-func (s *RetryLayerRemoteClusterStore) SetLastPingAt(remoteClusterId string) error {
+func (s *RetryLayerSchemeStore) CountWithoutPermission(scope string, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.RemoteClusterStore.SetLastPingAt(remoteClusterId)
+		result, err := s.SchemeStore.CountWithoutPermission(scope, permissionID, roleScope, roleType)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRemoteClusterStore) Update(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
+func (s *RetryLayerSchemeStore) Delete(schemeID string) (*model.Scheme, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.Update(rc)
+		result, err := s.SchemeStore.Delete(schemeID)
 		if err == nil {
 			return result, nil
 		}
@@ -8443,11 +11405,11 @@ func (s *RetryLayerRemoteClusterStore) Update(rc *model.RemoteCluster) (*model.R
 
 }
 
-func (s *RetryLayerRemoteClusterStore) UpdateTopics(remoteClusterId string, topics string) (*model.RemoteCluster, error) {
+func (s *RetryLayerSchemeStore) Get(schemeID string) (*model.Scheme, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.UpdateTopics(remoteClusterId, topics)
+		result, err := s.SchemeStore.Get(schemeID)
 		if err == nil {
 			return result, nil
 		}
@@ -8464,53 +11426,53 @@ func (s *RetryLayerRemoteClusterStore) UpdateTopics(remoteClusterId string, topi
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) AddChannels(policyId string, channelIds []string) error {
+func (s *RetryLayerSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
 
 	tries := 0
 	for {
-		err := s.RetentionPolicyStore.AddChannels(policyId, channelIds)
+		result, err := s.SchemeStore.GetAllPage(scope, offset, limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) AddTeams(policyId string, teamIds []string) error {
+func (s *RetryLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, error) {
 
 	tries := 0
 	for {
-		err := s.RetentionPolicyStore.AddTeams(policyId, teamIds)
+		result, err := s.SchemeStore.GetByName(schemeName)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) Delete(id string) error {
+func (s *RetryLayerSchemeStore) PermanentDeleteAll() error {
 
 	tries := 0
 	for {
-		err := s.RetentionPolicyStore.Delete(id)
+		err := s.SchemeStore.PermanentDeleteAll()
 		if err == nil {
 			return nil
 		}
@@ -8527,11 +11489,11 @@ func (s *RetryLayerRetentionPolicyStore) Delete(id string) error {
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.DeleteOrphanedRows(limit)
+		result, err := s.SchemeStore.Save(scheme)
 		if err == nil {
 			return result, nil
 		}
@@ -8548,11 +11510,11 @@ func (s *RetryLayerRetentionPolicyStore) DeleteOrphanedRows(limit int) (int64, e
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *RetryLayerSessionStore) AnalyticsSessionCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.Get(id)
+		result, err := s.SessionStore.AnalyticsSessionCount()
 		if err == nil {
 			return result, nil
 		}
@@ -8569,32 +11531,32 @@ func (s *RetryLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicyW
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetAll(offset int, limit int) ([]*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *RetryLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) error {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetAll(offset, limit)
+		err := s.SessionStore.Cleanup(expiryTime, batchSize)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetChannelPoliciesCountForUser(userID string) (int64, error) {
+func (s *RetryLayerSessionStore) Get(ctx context.Context, sessionIDOrToken string) (*model.Session, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetChannelPoliciesCountForUser(userID)
+		result, err := s.SessionStore.Get(ctx, sessionIDOrToken)
 		if err == nil {
 			return result, nil
 		}
@@ -8611,11 +11573,11 @@ func (s *RetryLayerRetentionPolicyStore) GetChannelPoliciesCountForUser(userID s
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetChannelPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForChannel, error) {
+func (s *RetryLayerSessionStore) GetLastSessionRowCreateAt() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetChannelPoliciesForUser(userID, offset, limit)
+		result, err := s.SessionStore.GetLastSessionRowCreateAt()
 		if err == nil {
 			return result, nil
 		}
@@ -8632,11 +11594,11 @@ func (s *RetryLayerRetentionPolicyStore) GetChannelPoliciesForUser(userID string
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetChannels(policyId string, offset int, limit int) (model.ChannelListWithTeamData, error) {
+func (s *RetryLayerSessionStore) GetSessions(userID string) ([]*model.Session, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetChannels(policyId, offset, limit)
+		result, err := s.SessionStore.GetSessions(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -8653,11 +11615,11 @@ func (s *RetryLayerRetentionPolicyStore) GetChannels(policyId string, offset int
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetChannelsCount(policyId string) (int64, error) {
+func (s *RetryLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobileOnly bool, unnotifiedOnly bool) ([]*model.Session, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetChannelsCount(policyId)
+		result, err := s.SessionStore.GetSessionsExpired(thresholdMillis, mobileOnly, unnotifiedOnly)
 		if err == nil {
 			return result, nil
 		}
@@ -8674,11 +11636,11 @@ func (s *RetryLayerRetentionPolicyStore) GetChannelsCount(policyId string) (int6
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetCount() (int64, error) {
+func (s *RetryLayerSessionStore) GetSessionsWithActiveDeviceIds(userID string) ([]*model.Session, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetCount()
+		result, err := s.SessionStore.GetSessionsWithActiveDeviceIds(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -8695,74 +11657,74 @@ func (s *RetryLayerRetentionPolicyStore) GetCount() (int64, error) {
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetTeamPoliciesCountForUser(userID string) (int64, error) {
+func (s *RetryLayerSessionStore) PermanentDeleteSessionsByUser(teamID string) error {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetTeamPoliciesCountForUser(userID)
+		err := s.SessionStore.PermanentDeleteSessionsByUser(teamID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetTeamPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForTeam, error) {
+func (s *RetryLayerSessionStore) Remove(sessionIDOrToken string) error {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetTeamPoliciesForUser(userID, offset, limit)
+		err := s.SessionStore.Remove(sessionIDOrToken)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetTeams(policyId string, offset int, limit int) ([]*model.Team, error) {
+func (s *RetryLayerSessionStore) RemoveAllSessions() error {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetTeams(policyId, offset, limit)
+		err := s.SessionStore.RemoveAllSessions()
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64, error) {
+func (s *RetryLayerSessionStore) Save(session *model.Session) (*model.Session, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetTeamsCount(policyId)
+		result, err := s.SessionStore.Save(session)
 		if err == nil {
 			return result, nil
 		}
@@ -8779,11 +11741,11 @@ func (s *RetryLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64,
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *RetryLayerSessionStore) UpdateDeviceId(id string, deviceID string, expiresAt int64) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.Patch(patch)
+		result, err := s.SessionStore.UpdateDeviceId(id, deviceID, expiresAt)
 		if err == nil {
 			return result, nil
 		}
@@ -8800,11 +11762,11 @@ func (s *RetryLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithT
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) RemoveChannels(policyId string, channelIds []string) error {
+func (s *RetryLayerSessionStore) UpdateExpiredNotify(sessionid string, notified bool) error {
 
 	tries := 0
 	for {
-		err := s.RetentionPolicyStore.RemoveChannels(policyId, channelIds)
+		err := s.SessionStore.UpdateExpiredNotify(sessionid, notified)
 		if err == nil {
 			return nil
 		}
@@ -8821,11 +11783,11 @@ func (s *RetryLayerRetentionPolicyStore) RemoveChannels(policyId string, channel
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []string) error {
+func (s *RetryLayerSessionStore) UpdateExpiresAt(sessionID string, timestamp int64) error {
 
 	tries := 0
 	for {
-		err := s.RetentionPolicyStore.RemoveTeams(policyId, teamIds)
+		err := s.SessionStore.UpdateExpiresAt(sessionID, timestamp)
 		if err == nil {
 			return nil
 		}
@@ -8842,53 +11804,53 @@ func (s *RetryLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *RetryLayerSessionStore) UpdateLastActivityAt(sessionID string, timestamp int64) error {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.Save(policy)
+		err := s.SessionStore.UpdateLastActivityAt(sessionID, timestamp)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
+func (s *RetryLayerSessionStore) UpdateProps(session *model.Session) error {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.AllChannelSchemeRoles()
+		err := s.SessionStore.UpdateProps(session)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, error) {
+func (s *RetryLayerSessionStore) UpdateRoles(userID string, roles string) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+		result, err := s.SessionStore.UpdateRoles(userID, roles)
 		if err == nil {
 			return result, nil
 		}
@@ -8905,11 +11867,11 @@ func (s *RetryLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string)
 
 }
 
-func (s *RetryLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) Delete(channelId string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
+		result, err := s.SharedChannelStore.Delete(channelId)
 		if err == nil {
 			return result, nil
 		}
@@ -8926,11 +11888,11 @@ func (s *RetryLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*mod
 
 }
 
-func (s *RetryLayerRoleStore) Delete(roleID string) (*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) DeleteRemote(remoteId string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.Delete(roleID)
+		result, err := s.SharedChannelStore.DeleteRemote(remoteId)
 		if err == nil {
 			return result, nil
 		}
@@ -8947,11 +11909,11 @@ func (s *RetryLayerRoleStore) Delete(roleID string) (*model.Role, error) {
 
 }
 
-func (s *RetryLayerRoleStore) Get(roleID string) (*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) Get(channelId string) (*model.SharedChannel, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.Get(roleID)
+		result, err := s.SharedChannelStore.Get(channelId)
 		if err == nil {
 			return result, nil
 		}
@@ -8968,11 +11930,11 @@ func (s *RetryLayerRoleStore) Get(roleID string) (*model.Role, error) {
 
 }
 
-func (s *RetryLayerRoleStore) GetAll() ([]*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetAll(offset int, limit int, opts model.SharedChannelFilterOpts) ([]*model.SharedChannel, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.GetAll()
+		result, err := s.SharedChannelStore.GetAll(offset, limit, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -8989,11 +11951,11 @@ func (s *RetryLayerRoleStore) GetAll() ([]*model.Role, error) {
 
 }
 
-func (s *RetryLayerRoleStore) GetByName(ctx context.Context, name string) (*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetAllCount(opts model.SharedChannelFilterOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.GetByName(ctx, name)
+		result, err := s.SharedChannelStore.GetAllCount(opts)
 		if err == nil {
 			return result, nil
 		}
@@ -9010,11 +11972,11 @@ func (s *RetryLayerRoleStore) GetByName(ctx context.Context, name string) (*mode
 
 }
 
-func (s *RetryLayerRoleStore) GetByNames(names []string) ([]*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetAttachment(fileId string, remoteId string) (*model.SharedChannelAttachment, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.GetByNames(names)
+		result, err := s.SharedChannelStore.GetAttachment(fileId, remoteId)
 		if err == nil {
 			return result, nil
 		}
@@ -9031,32 +11993,11 @@ func (s *RetryLayerRoleStore) GetByNames(names []string) ([]*model.Role, error)
 
 }
 
-func (s *RetryLayerRoleStore) PermanentDeleteAll() error {
-
-	tries := 0
-	for {
-		err := s.RoleStore.PermanentDeleteAll()
-		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
-		}
-		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
-		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
-	}
-
-}
-
-func (s *RetryLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetRemote(id string) (*model.SharedChannelRemote, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.Save(role)
+		result, err := s.SharedChannelStore.GetRemote(id)
 		if err == nil {
 			return result, nil
 		}
@@ -9073,11 +12014,11 @@ func (s *RetryLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
 
 }
 
-func (s *RetryLayerSchemeStore) CountByScope(scope string) (int64, error) {
+func (s *RetryLayerSharedChannelStore) GetRemoteByIds(channelId string, remoteId string) (*model.SharedChannelRemote, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.CountByScope(scope)
+		result, err := s.SharedChannelStore.GetRemoteByIds(channelId, remoteId)
 		if err == nil {
 			return result, nil
 		}
@@ -9094,11 +12035,11 @@ func (s *RetryLayerSchemeStore) CountByScope(scope string) (int64, error) {
 
 }
 
-func (s *RetryLayerSchemeStore) CountWithoutPermission(scope string, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error) {
+func (s *RetryLayerSharedChannelStore) GetRemoteForUser(remoteId string, userId string) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.CountWithoutPermission(scope, permissionID, roleScope, roleType)
+		result, err := s.SharedChannelStore.GetRemoteForUser(remoteId, userId)
 		if err == nil {
 			return result, nil
 		}
@@ -9115,11 +12056,11 @@ func (s *RetryLayerSchemeStore) CountWithoutPermission(scope string, permissionI
 
 }
 
-func (s *RetryLayerSchemeStore) Delete(schemeID string) (*model.Scheme, error) {
+func (s *RetryLayerSharedChannelStore) GetRemotes(opts model.SharedChannelRemoteFilterOpts) ([]*model.SharedChannelRemote, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.Delete(schemeID)
+		result, err := s.SharedChannelStore.GetRemotes(opts)
 		if err == nil {
 			return result, nil
 		}
@@ -9136,11 +12077,11 @@ func (s *RetryLayerSchemeStore) Delete(schemeID string) (*model.Scheme, error) {
 
 }
 
-func (s *RetryLayerSchemeStore) Get(schemeID string) (*model.Scheme, error) {
+func (s *RetryLayerSharedChannelStore) GetRemotesStatus(channelId string) ([]*model.SharedChannelRemoteStatus, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.Get(schemeID)
+		result, err := s.SharedChannelStore.GetRemotesStatus(channelId)
 		if err == nil {
 			return result, nil
 		}
@@ -9157,11 +12098,11 @@ func (s *RetryLayerSchemeStore) Get(schemeID string) (*model.Scheme, error) {
 
 }
 
-func (s *RetryLayerSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
+func (s *RetryLayerSharedChannelStore) GetSingleUser(userID string, channelID string, remoteID string) (*model.SharedChannelUser, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.GetAllPage(scope, offset, limit)
+		result, err := s.SharedChannelStore.GetSingleUser(userID, channelID, remoteID)
 		if err == nil {
 			return result, nil
 		}
@@ -9178,11 +12119,11 @@ func (s *RetryLayerSchemeStore) GetAllPage(scope string, offset int, limit int)
 
 }
 
-func (s *RetryLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, error) {
+func (s *RetryLayerSharedChannelStore) GetUsersForSync(filter model.GetUsersForSyncFilter) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.GetByName(schemeName)
+		result, err := s.SharedChannelStore.GetUsersForSync(filter)
 		if err == nil {
 			return result, nil
 		}
@@ -9199,32 +12140,32 @@ func (s *RetryLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, err
 
 }
 
-func (s *RetryLayerSchemeStore) PermanentDeleteAll() error {
+func (s *RetryLayerSharedChannelStore) GetUsersForUser(userID string) ([]*model.SharedChannelUser, error) {
 
 	tries := 0
 	for {
-		err := s.SchemeStore.PermanentDeleteAll()
+		result, err := s.SharedChannelStore.GetUsersForUser(userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error) {
+func (s *RetryLayerSharedChannelStore) HasChannel(channelID string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.Save(scheme)
+		result, err := s.SharedChannelStore.HasChannel(channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -9241,11 +12182,11 @@ func (s *RetryLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error
 
 }
 
-func (s *RetryLayerSessionStore) AnalyticsSessionCount() (int64, error) {
+func (s *RetryLayerSharedChannelStore) HasRemote(channelID string, remoteId string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.AnalyticsSessionCount()
+		result, err := s.SharedChannelStore.HasRemote(channelID, remoteId)
 		if err == nil {
 			return result, nil
 		}
@@ -9262,32 +12203,32 @@ func (s *RetryLayerSessionStore) AnalyticsSessionCount() (int64, error) {
 
 }
 
-func (s *RetryLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) error {
+func (s *RetryLayerSharedChannelStore) Save(sc *model.SharedChannel) (*model.SharedChannel, error) {
 
 	tries := 0
 	for {
-		err := s.SessionStore.Cleanup(expiryTime, batchSize)
+		result, err := s.SharedChannelStore.Save(sc)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) Get(ctx context.Context, sessionIDOrToken string) (*model.Session, error) {
+func (s *RetryLayerSharedChannelStore) SaveAttachment(remote *model.SharedChannelAttachment) (*model.SharedChannelAttachment, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.Get(ctx, sessionIDOrToken)
+		result, err := s.SharedChannelStore.SaveAttachment(remote)
 		if err == nil {
 			return result, nil
 		}
@@ -9304,11 +12245,11 @@ func (s *RetryLayerSessionStore) Get(ctx context.Context, sessionIDOrToken strin
 
 }
 
-func (s *RetryLayerSessionStore) GetLastSessionRowCreateAt() (int64, error) {
+func (s *RetryLayerSharedChannelStore) SaveRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.GetLastSessionRowCreateAt()
+		result, err := s.SharedChannelStore.SaveRemote(remote)
 		if err == nil {
 			return result, nil
 		}
@@ -9325,11 +12266,11 @@ func (s *RetryLayerSessionStore) GetLastSessionRowCreateAt() (int64, error) {
 
 }
 
-func (s *RetryLayerSessionStore) GetSessions(userID string) ([]*model.Session, error) {
+func (s *RetryLayerSharedChannelStore) SaveUser(remote *model.SharedChannelUser) (*model.SharedChannelUser, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.GetSessions(userID)
+		result, err := s.SharedChannelStore.SaveUser(remote)
 		if err == nil {
 			return result, nil
 		}
@@ -9346,11 +12287,11 @@ func (s *RetryLayerSessionStore) GetSessions(userID string) ([]*model.Session, e
 
 }
 
-func (s *RetryLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobileOnly bool, unnotifiedOnly bool) ([]*model.Session, error) {
+func (s *RetryLayerSharedChannelStore) Update(sc *model.SharedChannel) (*model.SharedChannel, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.GetSessionsExpired(thresholdMillis, mobileOnly, unnotifiedOnly)
+		result, err := s.SharedChannelStore.Update(sc)
 		if err == nil {
 			return result, nil
 		}
@@ -9367,53 +12308,53 @@ func (s *RetryLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobil
 
 }
 
-func (s *RetryLayerSessionStore) GetSessionsWithActiveDeviceIds(userID string) ([]*model.Session, error) {
+func (s *RetryLayerSharedChannelStore) UpdateAttachmentLastSyncAt(id string, syncTime int64) error {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.GetSessionsWithActiveDeviceIds(userID)
+		err := s.SharedChannelStore.UpdateAttachmentLastSyncAt(id, syncTime)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) PermanentDeleteSessionsByUser(teamID string) error {
+func (s *RetryLayerSharedChannelStore) UpdateRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
 
 	tries := 0
 	for {
-		err := s.SessionStore.PermanentDeleteSessionsByUser(teamID)
+		result, err := s.SharedChannelStore.UpdateRemote(remote)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) Remove(sessionIDOrToken string) error {
+func (s *RetryLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error {
 
 	tries := 0
 	for {
-		err := s.SessionStore.Remove(sessionIDOrToken)
+		err := s.SharedChannelStore.UpdateRemoteCursor(id, cursor)
 		if err == nil {
 			return nil
 		}
@@ -9430,11 +12371,11 @@ func (s *RetryLayerSessionStore) Remove(sessionIDOrToken string) error {
 
 }
 
-func (s *RetryLayerSessionStore) RemoveAllSessions() error {
+func (s *RetryLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, channelID string, remoteID string) error {
 
 	tries := 0
 	for {
-		err := s.SessionStore.RemoveAllSessions()
+		err := s.SharedChannelStore.UpdateUserLastSyncAt(userID, channelID, remoteID)
 		if err == nil {
 			return nil
 		}
@@ -9451,11 +12392,11 @@ func (s *RetryLayerSessionStore) RemoveAllSessions() error {
 
 }
 
-func (s *RetryLayerSessionStore) Save(session *model.Session) (*model.Session, error) {
+func (s *RetryLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChannelAttachment) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.Save(session)
+		result, err := s.SharedChannelStore.UpsertAttachment(remote)
 		if err == nil {
 			return result, nil
 		}
@@ -9472,11 +12413,11 @@ func (s *RetryLayerSessionStore) Save(session *model.Session) (*model.Session, e
 
 }
 
-func (s *RetryLayerSessionStore) UpdateDeviceId(id string, deviceID string, expiresAt int64) (string, error) {
+func (s *RetryLayerStatusStore) Get(userID string) (*model.Status, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.UpdateDeviceId(id, deviceID, expiresAt)
+		result, err := s.StatusStore.Get(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -9493,53 +12434,53 @@ func (s *RetryLayerSessionStore) UpdateDeviceId(id string, deviceID string, expi
 
 }
 
-func (s *RetryLayerSessionStore) UpdateExpiredNotify(sessionid string, notified bool) error {
+func (s *RetryLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, error) {
 
 	tries := 0
 	for {
-		err := s.SessionStore.UpdateExpiredNotify(sessionid, notified)
+		result, err := s.StatusStore.GetByIds(userIds)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) UpdateExpiresAt(sessionID string, timestamp int64) error {
+func (s *RetryLayerStatusStore) GetTotalActiveUsersCount() (int64, error) {
 
 	tries := 0
 	for {
-		err := s.SessionStore.UpdateExpiresAt(sessionID, timestamp)
+		result, err := s.StatusStore.GetTotalActiveUsersCount()
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) UpdateLastActivityAt(sessionID string, timestamp int64) error {
+func (s *RetryLayerStatusStore) ResetAll() error {
 
 	tries := 0
 	for {
-		err := s.SessionStore.UpdateLastActivityAt(sessionID, timestamp)
+		err := s.StatusStore.ResetAll()
 		if err == nil {
 			return nil
 		}
@@ -9556,11 +12497,11 @@ func (s *RetryLayerSessionStore) UpdateLastActivityAt(sessionID string, timestam
 
 }
 
-func (s *RetryLayerSessionStore) UpdateProps(session *model.Session) error {
+func (s *RetryLayerStatusStore) SaveOrUpdate(status *model.Status) error {
 
 	tries := 0
 	for {
-		err := s.SessionStore.UpdateProps(session)
+		err := s.StatusStore.SaveOrUpdate(status)
 		if err == nil {
 			return nil
 		}
@@ -9577,11 +12518,11 @@ func (s *RetryLayerSessionStore) UpdateProps(session *model.Session) error {
 
 }
 
-func (s *RetryLayerSessionStore) UpdateRoles(userID string, roles string) (string, error) {
+func (s *RetryLayerStatusStore) UpdateExpiredDNDStatuses() ([]*model.Status, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.UpdateRoles(userID, roles)
+		result, err := s.StatusStore.UpdateExpiredDNDStatuses()
 		if err == nil {
 			return result, nil
 		}
@@ -9598,32 +12539,32 @@ func (s *RetryLayerSessionStore) UpdateRoles(userID string, roles string) (strin
 
 }
 
-func (s *RetryLayerSharedChannelStore) Delete(channelId string) (bool, error) {
+func (s *RetryLayerStatusStore) UpdateLastActivityAt(userID string, lastActivityAt int64) error {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.Delete(channelId)
+		err := s.StatusStore.UpdateLastActivityAt(userID, lastActivityAt)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) DeleteRemote(remoteId string) (bool, error) {
+func (s *RetryLayerSupportAccessConsentStore) GetActive(userID string, adminID string, now int64) (*model.SupportAccessConsent, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.DeleteRemote(remoteId)
+		result, err := s.SupportAccessConsentStore.GetActive(userID, adminID, now)
 		if err == nil {
 			return result, nil
 		}
@@ -9640,11 +12581,11 @@ func (s *RetryLayerSharedChannelStore) DeleteRemote(remoteId string) (bool, erro
 
 }
 
-func (s *RetryLayerSharedChannelStore) Get(channelId string) (*model.SharedChannel, error) {
+func (s *RetryLayerSupportAccessConsentStore) GetForUser(userID string) ([]*model.SupportAccessConsent, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.Get(channelId)
+		result, err := s.SupportAccessConsentStore.GetForUser(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -9661,53 +12602,53 @@ func (s *RetryLayerSharedChannelStore) Get(channelId string) (*model.SharedChann
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetAll(offset int, limit int, opts model.SharedChannelFilterOpts) ([]*model.SharedChannel, error) {
+func (s *RetryLayerSupportAccessConsentStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetAll(offset, limit, opts)
+		err := s.SupportAccessConsentStore.PermanentDeleteByUser(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetAllCount(opts model.SharedChannelFilterOpts) (int64, error) {
+func (s *RetryLayerSupportAccessConsentStore) RevokeForUser(userID string, revokedAt int64) error {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetAllCount(opts)
+		err := s.SupportAccessConsentStore.RevokeForUser(userID, revokedAt)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetAttachment(fileId string, remoteId string) (*model.SharedChannelAttachment, error) {
+func (s *RetryLayerSupportAccessConsentStore) Save(consent *model.SupportAccessConsent) (*model.SupportAccessConsent, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetAttachment(fileId, remoteId)
+		result, err := s.SupportAccessConsentStore.Save(consent)
 		if err == nil {
 			return result, nil
 		}
@@ -9724,11 +12665,11 @@ func (s *RetryLayerSharedChannelStore) GetAttachment(fileId string, remoteId str
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetRemote(id string) (*model.SharedChannelRemote, error) {
+func (s *RetryLayerSystemStore) Get() (model.StringMap, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetRemote(id)
+		result, err := s.SystemStore.Get()
 		if err == nil {
 			return result, nil
 		}
@@ -9745,11 +12686,11 @@ func (s *RetryLayerSharedChannelStore) GetRemote(id string) (*model.SharedChanne
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetRemoteByIds(channelId string, remoteId string) (*model.SharedChannelRemote, error) {
+func (s *RetryLayerSystemStore) GetByName(name string) (*model.System, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetRemoteByIds(channelId, remoteId)
+		result, err := s.SystemStore.GetByName(name)
 		if err == nil {
 			return result, nil
 		}
@@ -9766,11 +12707,11 @@ func (s *RetryLayerSharedChannelStore) GetRemoteByIds(channelId string, remoteId
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetRemoteForUser(remoteId string, userId string) (*model.RemoteCluster, error) {
+func (s *RetryLayerSystemStore) InsertIfExists(system *model.System) (*model.System, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetRemoteForUser(remoteId, userId)
+		result, err := s.SystemStore.InsertIfExists(system)
 		if err == nil {
 			return result, nil
 		}
@@ -9787,11 +12728,11 @@ func (s *RetryLayerSharedChannelStore) GetRemoteForUser(remoteId string, userId
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetRemotes(opts model.SharedChannelRemoteFilterOpts) ([]*model.SharedChannelRemote, error) {
+func (s *RetryLayerSystemStore) PermanentDeleteByName(name string) (*model.System, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetRemotes(opts)
+		result, err := s.SystemStore.PermanentDeleteByName(name)
 		if err == nil {
 			return result, nil
 		}
@@ -9808,95 +12749,95 @@ func (s *RetryLayerSharedChannelStore) GetRemotes(opts model.SharedChannelRemote
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetRemotesStatus(channelId string) ([]*model.SharedChannelRemoteStatus, error) {
+func (s *RetryLayerSystemStore) Save(system *model.System) error {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetRemotesStatus(channelId)
+		err := s.SystemStore.Save(system)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetSingleUser(userID string, channelID string, remoteID string) (*model.SharedChannelUser, error) {
+func (s *RetryLayerSystemStore) SaveOrUpdate(system *model.System) error {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetSingleUser(userID, channelID, remoteID)
+		err := s.SystemStore.SaveOrUpdate(system)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetUsersForSync(filter model.GetUsersForSyncFilter) ([]*model.User, error) {
+func (s *RetryLayerSystemStore) SaveOrUpdateWithWarnMetricHandling(system *model.System) error {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetUsersForSync(filter)
+		err := s.SystemStore.SaveOrUpdateWithWarnMetricHandling(system)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetUsersForUser(userID string) ([]*model.SharedChannelUser, error) {
+func (s *RetryLayerSystemStore) Update(system *model.System) error {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetUsersForUser(userID)
+		err := s.SystemStore.Update(system)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) HasChannel(channelID string) (bool, error) {
+func (s *RetryLayerTeamStore) AnalyticsGetTeamCountForScheme(schemeID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.HasChannel(channelID)
+		result, err := s.TeamStore.AnalyticsGetTeamCountForScheme(schemeID)
 		if err == nil {
 			return result, nil
 		}
@@ -9913,11 +12854,11 @@ func (s *RetryLayerSharedChannelStore) HasChannel(channelID string) (bool, error
 
 }
 
-func (s *RetryLayerSharedChannelStore) HasRemote(channelID string, remoteId string) (bool, error) {
+func (s *RetryLayerTeamStore) AnalyticsTeamCount(opts *model.TeamSearch) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.HasRemote(channelID, remoteId)
+		result, err := s.TeamStore.AnalyticsTeamCount(opts)
 		if err == nil {
 			return result, nil
 		}
@@ -9934,32 +12875,38 @@ func (s *RetryLayerSharedChannelStore) HasRemote(channelID string, remoteId stri
 
 }
 
-func (s *RetryLayerSharedChannelStore) Save(sc *model.SharedChannel) (*model.SharedChannel, error) {
+func (s *RetryLayerTeamStore) ClearAllCustomRoleAssignments() error {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.Save(sc)
+		err := s.TeamStore.ClearAllCustomRoleAssignments()
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) SaveAttachment(remote *model.SharedChannelAttachment) (*model.SharedChannelAttachment, error) {
+func (s *RetryLayerTeamStore) ClearCaches() {
+
+	s.TeamStore.ClearCaches()
+
+}
+
+func (s *RetryLayerTeamStore) Get(id string) (*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.SaveAttachment(remote)
+		result, err := s.TeamStore.Get(id)
 		if err == nil {
 			return result, nil
 		}
@@ -9976,11 +12923,11 @@ func (s *RetryLayerSharedChannelStore) SaveAttachment(remote *model.SharedChanne
 
 }
 
-func (s *RetryLayerSharedChannelStore) SaveRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
+func (s *RetryLayerTeamStore) GetActiveMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.SaveRemote(remote)
+		result, err := s.TeamStore.GetActiveMemberCount(teamID, restrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -9997,11 +12944,11 @@ func (s *RetryLayerSharedChannelStore) SaveRemote(remote *model.SharedChannelRem
 
 }
 
-func (s *RetryLayerSharedChannelStore) SaveUser(remote *model.SharedChannelUser) (*model.SharedChannelUser, error) {
+func (s *RetryLayerTeamStore) GetAll() ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.SaveUser(remote)
+		result, err := s.TeamStore.GetAll()
 		if err == nil {
 			return result, nil
 		}
@@ -10018,11 +12965,11 @@ func (s *RetryLayerSharedChannelStore) SaveUser(remote *model.SharedChannelUser)
 
 }
 
-func (s *RetryLayerSharedChannelStore) Update(sc *model.SharedChannel) (*model.SharedChannel, error) {
+func (s *RetryLayerTeamStore) GetAllForExportAfter(limit int, afterID string) ([]*model.TeamForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.Update(sc)
+		result, err := s.TeamStore.GetAllForExportAfter(limit, afterID)
 		if err == nil {
 			return result, nil
 		}
@@ -10039,32 +12986,32 @@ func (s *RetryLayerSharedChannelStore) Update(sc *model.SharedChannel) (*model.S
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpdateAttachmentLastSyncAt(id string, syncTime int64) error {
+func (s *RetryLayerTeamStore) GetAllPage(offset int, limit int, opts *model.TeamSearch) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		err := s.SharedChannelStore.UpdateAttachmentLastSyncAt(id, syncTime)
+		result, err := s.TeamStore.GetAllPage(offset, limit, opts)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpdateRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
+func (s *RetryLayerTeamStore) GetAllPrivateTeamListing() ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.UpdateRemote(remote)
+		result, err := s.TeamStore.GetAllPrivateTeamListing()
 		if err == nil {
 			return result, nil
 		}
@@ -10081,53 +13028,53 @@ func (s *RetryLayerSharedChannelStore) UpdateRemote(remote *model.SharedChannelR
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error {
+func (s *RetryLayerTeamStore) GetAllTeamListing() ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		err := s.SharedChannelStore.UpdateRemoteCursor(id, cursor)
+		result, err := s.TeamStore.GetAllTeamListing()
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, channelID string, remoteID string) error {
+func (s *RetryLayerTeamStore) GetByEmptyInviteID() ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		err := s.SharedChannelStore.UpdateUserLastSyncAt(userID, channelID, remoteID)
+		result, err := s.TeamStore.GetByEmptyInviteID()
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChannelAttachment) (string, error) {
+func (s *RetryLayerTeamStore) GetByInviteId(inviteID string) (*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.UpsertAttachment(remote)
+		result, err := s.TeamStore.GetByInviteId(inviteID)
 		if err == nil {
 			return result, nil
 		}
@@ -10144,11 +13091,11 @@ func (s *RetryLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChan
 
 }
 
-func (s *RetryLayerStatusStore) Get(userID string) (*model.Status, error) {
+func (s *RetryLayerTeamStore) GetByName(name string) (*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.StatusStore.Get(userID)
+		result, err := s.TeamStore.GetByName(name)
 		if err == nil {
 			return result, nil
 		}
@@ -10165,11 +13112,11 @@ func (s *RetryLayerStatusStore) Get(userID string) (*model.Status, error) {
 
 }
 
-func (s *RetryLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, error) {
+func (s *RetryLayerTeamStore) GetByNames(name []string) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.StatusStore.GetByIds(userIds)
+		result, err := s.TeamStore.GetByNames(name)
 		if err == nil {
 			return result, nil
 		}
@@ -10186,11 +13133,11 @@ func (s *RetryLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, err
 
 }
 
-func (s *RetryLayerStatusStore) GetTotalActiveUsersCount() (int64, error) {
+func (s *RetryLayerTeamStore) GetChannelUnreadsForAllTeams(excludeTeamID string, userID string) ([]*model.ChannelUnread, error) {
 
 	tries := 0
 	for {
-		result, err := s.StatusStore.GetTotalActiveUsersCount()
+		result, err := s.TeamStore.GetChannelUnreadsForAllTeams(excludeTeamID, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -10207,53 +13154,53 @@ func (s *RetryLayerStatusStore) GetTotalActiveUsersCount() (int64, error) {
 
 }
 
-func (s *RetryLayerStatusStore) ResetAll() error {
+func (s *RetryLayerTeamStore) GetChannelUnreadsForTeam(teamID string, userID string) ([]*model.ChannelUnread, error) {
 
 	tries := 0
 	for {
-		err := s.StatusStore.ResetAll()
+		result, err := s.TeamStore.GetChannelUnreadsForTeam(teamID, userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerStatusStore) SaveOrUpdate(status *model.Status) error {
+func (s *RetryLayerTeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUserID string) ([]string, error) {
 
 	tries := 0
 	for {
-		err := s.StatusStore.SaveOrUpdate(status)
+		result, err := s.TeamStore.GetCommonTeamIDsForTwoUsers(userID, otherUserID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerStatusStore) UpdateExpiredDNDStatuses() ([]*model.Status, error) {
+func (s *RetryLayerTeamStore) GetMany(ids []string) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.StatusStore.UpdateExpiredDNDStatuses()
+		result, err := s.TeamStore.GetMany(ids)
 		if err == nil {
 			return result, nil
 		}
@@ -10270,32 +13217,32 @@ func (s *RetryLayerStatusStore) UpdateExpiredDNDStatuses() ([]*model.Status, err
 
 }
 
-func (s *RetryLayerStatusStore) UpdateLastActivityAt(userID string, lastActivityAt int64) error {
+func (s *RetryLayerTeamStore) GetMember(ctx context.Context, teamID string, userID string) (*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		err := s.StatusStore.UpdateLastActivityAt(userID, lastActivityAt)
+		result, err := s.TeamStore.GetMember(ctx, teamID, userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) Get() (model.StringMap, error) {
+func (s *RetryLayerTeamStore) GetMembers(teamID string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.SystemStore.Get()
+		result, err := s.TeamStore.GetMembers(teamID, offset, limit, teamMembersGetOptions)
 		if err == nil {
 			return result, nil
 		}
@@ -10312,11 +13259,11 @@ func (s *RetryLayerSystemStore) Get() (model.StringMap, error) {
 
 }
 
-func (s *RetryLayerSystemStore) GetByName(name string) (*model.System, error) {
+func (s *RetryLayerTeamStore) GetMembersByIds(teamID string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.SystemStore.GetByName(name)
+		result, err := s.TeamStore.GetMembersByIds(teamID, userIds, restrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -10333,32 +13280,32 @@ func (s *RetryLayerSystemStore) GetByName(name string) (*model.System, error) {
 
 }
 
-func (s *RetryLayerSystemStore) InsertIfExists(system *model.System) (*model.System, error) {
+func (s *RetryLayerTeamStore) GetNewTeamMembersSince(teamID string, since int64, offset int, limit int) (*model.NewTeamMembersList, int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.SystemStore.InsertIfExists(system)
+		result, resultVar1, err := s.TeamStore.GetNewTeamMembersSince(teamID, since, offset, limit)
 		if err == nil {
-			return result, nil
+			return result, resultVar1, nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return result, resultVar1, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return result, resultVar1, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) PermanentDeleteByName(name string) (*model.System, error) {
+func (s *RetryLayerTeamStore) GetTeamMembersForExport(userID string) ([]*model.TeamMemberForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.SystemStore.PermanentDeleteByName(name)
+		result, err := s.TeamStore.GetTeamMembersForExport(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -10375,95 +13322,95 @@ func (s *RetryLayerSystemStore) PermanentDeleteByName(name string) (*model.Syste
 
 }
 
-func (s *RetryLayerSystemStore) Save(system *model.System) error {
+func (s *RetryLayerTeamStore) GetTeamsByScheme(schemeID string, offset int, limit int) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		err := s.SystemStore.Save(system)
+		result, err := s.TeamStore.GetTeamsByScheme(schemeID, offset, limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) SaveOrUpdate(system *model.System) error {
+func (s *RetryLayerTeamStore) GetTeamsByUserId(userID string) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		err := s.SystemStore.SaveOrUpdate(system)
+		result, err := s.TeamStore.GetTeamsByUserId(userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) SaveOrUpdateWithWarnMetricHandling(system *model.System) error {
+func (s *RetryLayerTeamStore) GetTeamsForUser(ctx context.Context, userID string, excludeTeamID string, includeDeleted bool) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		err := s.SystemStore.SaveOrUpdateWithWarnMetricHandling(system)
+		result, err := s.TeamStore.GetTeamsForUser(ctx, userID, excludeTeamID, includeDeleted)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) Update(system *model.System) error {
+func (s *RetryLayerTeamStore) GetTeamsForUserWithPagination(userID string, page int, perPage int) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		err := s.SystemStore.Update(system)
+		result, err := s.TeamStore.GetTeamsForUserWithPagination(userID, page, perPage)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) AnalyticsGetTeamCountForScheme(schemeID string) (int64, error) {
+func (s *RetryLayerTeamStore) GetTotalMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.AnalyticsGetTeamCountForScheme(schemeID)
+		result, err := s.TeamStore.GetTotalMemberCount(teamID, restrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -10480,11 +13427,11 @@ func (s *RetryLayerTeamStore) AnalyticsGetTeamCountForScheme(schemeID string) (i
 
 }
 
-func (s *RetryLayerTeamStore) AnalyticsTeamCount(opts *model.TeamSearch) (int64, error) {
+func (s *RetryLayerTeamStore) GetUserTeamIds(userID string, allowFromCache bool) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.AnalyticsTeamCount(opts)
+		result, err := s.TeamStore.GetUserTeamIds(userID, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -10501,38 +13448,38 @@ func (s *RetryLayerTeamStore) AnalyticsTeamCount(opts *model.TeamSearch) (int64,
 
 }
 
-func (s *RetryLayerTeamStore) ClearAllCustomRoleAssignments() error {
+func (s *RetryLayerTeamStore) GroupSyncedTeamCount() (int64, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.ClearAllCustomRoleAssignments()
+		result, err := s.TeamStore.GroupSyncedTeamCount()
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) ClearCaches() {
+func (s *RetryLayerTeamStore) InvalidateAllTeamIdsForUser(userID string) {
 
-	s.TeamStore.ClearCaches()
+	s.TeamStore.InvalidateAllTeamIdsForUser(userID)
 
 }
 
-func (s *RetryLayerTeamStore) Get(id string) (*model.Team, error) {
+func (s *RetryLayerTeamStore) MigrateTeamMembers(fromTeamID string, fromUserID string) (map[string]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.Get(id)
+		result, err := s.TeamStore.MigrateTeamMembers(fromTeamID, fromUserID)
 		if err == nil {
 			return result, nil
 		}
@@ -10549,137 +13496,137 @@ func (s *RetryLayerTeamStore) Get(id string) (*model.Team, error) {
 
 }
 
-func (s *RetryLayerTeamStore) GetActiveMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
+func (s *RetryLayerTeamStore) PermanentDelete(teamID string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetActiveMemberCount(teamID, restrictions)
+		err := s.TeamStore.PermanentDelete(teamID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetAll() ([]*model.Team, error) {
+func (s *RetryLayerTeamStore) RemoveAllMembersByTeam(teamID string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetAll()
+		err := s.TeamStore.RemoveAllMembersByTeam(teamID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetAllForExportAfter(limit int, afterID string) ([]*model.TeamForExport, error) {
+func (s *RetryLayerTeamStore) RemoveAllMembersByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetAllForExportAfter(limit, afterID)
+		err := s.TeamStore.RemoveAllMembersByUser(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetAllPage(offset int, limit int, opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *RetryLayerTeamStore) RemoveMember(teamID string, userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetAllPage(offset, limit, opts)
+		err := s.TeamStore.RemoveMember(teamID, userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetAllPrivateTeamListing() ([]*model.Team, error) {
+func (s *RetryLayerTeamStore) RemoveMembers(teamID string, userIds []string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetAllPrivateTeamListing()
+		err := s.TeamStore.RemoveMembers(teamID, userIds)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetAllTeamListing() ([]*model.Team, error) {
+func (s *RetryLayerTeamStore) ResetAllTeamSchemes() error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetAllTeamListing()
+		err := s.TeamStore.ResetAllTeamSchemes()
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetByEmptyInviteID() ([]*model.Team, error) {
+func (s *RetryLayerTeamStore) Save(team *model.Team) (*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetByEmptyInviteID()
+		result, err := s.TeamStore.Save(team)
 		if err == nil {
 			return result, nil
 		}
@@ -10696,11 +13643,11 @@ func (s *RetryLayerTeamStore) GetByEmptyInviteID() ([]*model.Team, error) {
 
 }
 
-func (s *RetryLayerTeamStore) GetByInviteId(inviteID string) (*model.Team, error) {
+func (s *RetryLayerTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetByInviteId(inviteID)
+		result, err := s.TeamStore.SaveMember(member, maxUsersPerTeam)
 		if err == nil {
 			return result, nil
 		}
@@ -10717,11 +13664,11 @@ func (s *RetryLayerTeamStore) GetByInviteId(inviteID string) (*model.Team, error
 
 }
 
-func (s *RetryLayerTeamStore) GetByName(name string) (*model.Team, error) {
+func (s *RetryLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetByName(name)
+		result, err := s.TeamStore.SaveMultipleMembers(members, maxUsersPerTeam)
 		if err == nil {
 			return result, nil
 		}
@@ -10738,11 +13685,11 @@ func (s *RetryLayerTeamStore) GetByName(name string) (*model.Team, error) {
 
 }
 
-func (s *RetryLayerTeamStore) GetByNames(name []string) ([]*model.Team, error) {
+func (s *RetryLayerTeamStore) SearchAll(opts *model.TeamSearch) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetByNames(name)
+		result, err := s.TeamStore.SearchAll(opts)
 		if err == nil {
 			return result, nil
 		}
@@ -10759,32 +13706,32 @@ func (s *RetryLayerTeamStore) GetByNames(name []string) ([]*model.Team, error) {
 
 }
 
-func (s *RetryLayerTeamStore) GetChannelUnreadsForAllTeams(excludeTeamID string, userID string) ([]*model.ChannelUnread, error) {
+func (s *RetryLayerTeamStore) SearchAllPaged(opts *model.TeamSearch) ([]*model.Team, int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetChannelUnreadsForAllTeams(excludeTeamID, userID)
+		result, resultVar1, err := s.TeamStore.SearchAllPaged(opts)
 		if err == nil {
-			return result, nil
+			return result, resultVar1, nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return result, resultVar1, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return result, resultVar1, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetChannelUnreadsForTeam(teamID string, userID string) ([]*model.ChannelUnread, error) {
+func (s *RetryLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetChannelUnreadsForTeam(teamID, userID)
+		result, err := s.TeamStore.SearchOpen(opts)
 		if err == nil {
 			return result, nil
 		}
@@ -10801,11 +13748,11 @@ func (s *RetryLayerTeamStore) GetChannelUnreadsForTeam(teamID string, userID str
 
 }
 
-func (s *RetryLayerTeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUserID string) ([]string, error) {
+func (s *RetryLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetCommonTeamIDsForTwoUsers(userID, otherUserID)
+		result, err := s.TeamStore.SearchPrivate(opts)
 		if err == nil {
 			return result, nil
 		}
@@ -10822,11 +13769,11 @@ func (s *RetryLayerTeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUs
 
 }
 
-func (s *RetryLayerTeamStore) GetMany(ids []string) ([]*model.Team, error) {
+func (s *RetryLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetMany(ids)
+		result, err := s.TeamStore.Update(team)
 		if err == nil {
 			return result, nil
 		}
@@ -10843,32 +13790,32 @@ func (s *RetryLayerTeamStore) GetMany(ids []string) ([]*model.Team, error) {
 
 }
 
-func (s *RetryLayerTeamStore) GetMember(ctx context.Context, teamID string, userID string) (*model.TeamMember, error) {
+func (s *RetryLayerTeamStore) UpdateLastTeamIconUpdate(teamID string, curTime int64) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetMember(ctx, teamID, userID)
+		err := s.TeamStore.UpdateLastTeamIconUpdate(teamID, curTime)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetMembers(teamID string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, error) {
+func (s *RetryLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetMembers(teamID, offset, limit, teamMembersGetOptions)
+		result, err := s.TeamStore.UpdateMember(member)
 		if err == nil {
 			return result, nil
 		}
@@ -10885,53 +13832,53 @@ func (s *RetryLayerTeamStore) GetMembers(teamID string, offset int, limit int, t
 
 }
 
-func (s *RetryLayerTeamStore) GetMembersByIds(teamID string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, error) {
+func (s *RetryLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetMembersByIds(teamID, userIds, restrictions)
+		err := s.TeamStore.UpdateMembersRole(teamID, userIDs)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetNewTeamMembersSince(teamID string, since int64, offset int, limit int) (*model.NewTeamMembersList, int64, error) {
+func (s *RetryLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.TeamStore.GetNewTeamMembersSince(teamID, since, offset, limit)
+		result, err := s.TeamStore.UpdateMultipleMembers(members)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetTeamMembersForExport(userID string) ([]*model.TeamMemberForExport, error) {
+func (s *RetryLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTeamMembersForExport(userID)
+		result, err := s.TeamStore.UserBelongsToTeams(userID, teamIds)
 		if err == nil {
 			return result, nil
 		}
@@ -10948,32 +13895,32 @@ func (s *RetryLayerTeamStore) GetTeamMembersForExport(userID string) ([]*model.T
 
 }
 
-func (s *RetryLayerTeamStore) GetTeamsByScheme(schemeID string, offset int, limit int) ([]*model.Team, error) {
+func (s *RetryLayerTeamArchiveStore) Delete(teamID string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTeamsByScheme(schemeID, offset, limit)
+		err := s.TeamArchiveStore.Delete(teamID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetTeamsByUserId(userID string) ([]*model.Team, error) {
+func (s *RetryLayerTeamArchiveStore) Get(teamID string) (*model.TeamArchive, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTeamsByUserId(userID)
+		result, err := s.TeamArchiveStore.Get(teamID)
 		if err == nil {
 			return result, nil
 		}
@@ -10990,11 +13937,11 @@ func (s *RetryLayerTeamStore) GetTeamsByUserId(userID string) ([]*model.Team, er
 
 }
 
-func (s *RetryLayerTeamStore) GetTeamsForUser(ctx context.Context, userID string, excludeTeamID string, includeDeleted bool) ([]*model.TeamMember, error) {
+func (s *RetryLayerTeamArchiveStore) Save(archive *model.TeamArchive) (*model.TeamArchive, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTeamsForUser(ctx, userID, excludeTeamID, includeDeleted)
+		result, err := s.TeamArchiveStore.Save(archive)
 		if err == nil {
 			return result, nil
 		}
@@ -11011,11 +13958,11 @@ func (s *RetryLayerTeamStore) GetTeamsForUser(ctx context.Context, userID string
 
 }
 
-func (s *RetryLayerTeamStore) GetTeamsForUserWithPagination(userID string, page int, perPage int) ([]*model.TeamMember, error) {
+func (s *RetryLayerTeamArchiveStore) Update(archive *model.TeamArchive) (*model.TeamArchive, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTeamsForUserWithPagination(userID, page, perPage)
+		result, err := s.TeamArchiveStore.Update(archive)
 		if err == nil {
 			return result, nil
 		}
@@ -11032,32 +13979,32 @@ func (s *RetryLayerTeamStore) GetTeamsForUserWithPagination(userID string, page
 
 }
 
-func (s *RetryLayerTeamStore) GetTotalMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
+func (s *RetryLayerTeamTemplateStore) Delete(id string, deleteAt int64) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTotalMemberCount(teamID, restrictions)
+		err := s.TeamTemplateStore.Delete(id, deleteAt)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetUserTeamIds(userID string, allowFromCache bool) ([]string, error) {
+func (s *RetryLayerTeamTemplateStore) Get(id string) (*model.TeamTemplate, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetUserTeamIds(userID, allowFromCache)
+		result, err := s.TeamTemplateStore.Get(id)
 		if err == nil {
 			return result, nil
 		}
@@ -11074,11 +14021,11 @@ func (s *RetryLayerTeamStore) GetUserTeamIds(userID string, allowFromCache bool)
 
 }
 
-func (s *RetryLayerTeamStore) GroupSyncedTeamCount() (int64, error) {
+func (s *RetryLayerTeamTemplateStore) GetAll(offset int, limit int) ([]*model.TeamTemplate, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GroupSyncedTeamCount()
+		result, err := s.TeamTemplateStore.GetAll(offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -11095,17 +14042,11 @@ func (s *RetryLayerTeamStore) GroupSyncedTeamCount() (int64, error) {
 
 }
 
-func (s *RetryLayerTeamStore) InvalidateAllTeamIdsForUser(userID string) {
-
-	s.TeamStore.InvalidateAllTeamIdsForUser(userID)
-
-}
-
-func (s *RetryLayerTeamStore) MigrateTeamMembers(fromTeamID string, fromUserID string) (map[string]string, error) {
+func (s *RetryLayerTeamTemplateStore) Save(template *model.TeamTemplate) (*model.TeamTemplate, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.MigrateTeamMembers(fromTeamID, fromUserID)
+		result, err := s.TeamTemplateStore.Save(template)
 		if err == nil {
 			return result, nil
 		}
@@ -11122,95 +14063,95 @@ func (s *RetryLayerTeamStore) MigrateTeamMembers(fromTeamID string, fromUserID s
 
 }
 
-func (s *RetryLayerTeamStore) PermanentDelete(teamID string) error {
+func (s *RetryLayerTeamTemplateStore) Update(template *model.TeamTemplate) (*model.TeamTemplate, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.PermanentDelete(teamID)
+		result, err := s.TeamTemplateStore.Update(template)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) RemoveAllMembersByTeam(teamID string) error {
+func (s *RetryLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*model.TermsOfService, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.RemoveAllMembersByTeam(teamID)
+		result, err := s.TermsOfServiceStore.Get(id, allowFromCache)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) RemoveAllMembersByUser(userID string) error {
+func (s *RetryLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.TermsOfService, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.RemoveAllMembersByUser(userID)
+		result, err := s.TermsOfServiceStore.GetLatest(allowFromCache)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) RemoveMember(teamID string, userID string) error {
+func (s *RetryLayerTermsOfServiceStore) Save(termsOfService *model.TermsOfService) (*model.TermsOfService, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.RemoveMember(teamID, userID)
+		result, err := s.TermsOfServiceStore.Save(termsOfService)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) RemoveMembers(teamID string, userIds []string) error {
+func (s *RetryLayerThreadStore) DeleteMembershipForUser(userId string, postID string) error {
 
 	tries := 0
 	for {
-		err := s.TeamStore.RemoveMembers(teamID, userIds)
+		err := s.ThreadStore.DeleteMembershipForUser(userId, postID)
 		if err == nil {
 			return nil
 		}
@@ -11227,32 +14168,32 @@ func (s *RetryLayerTeamStore) RemoveMembers(teamID string, userIds []string) err
 
 }
 
-func (s *RetryLayerTeamStore) ResetAllTeamSchemes() error {
+func (s *RetryLayerThreadStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.ResetAllTeamSchemes()
+		result, err := s.ThreadStore.DeleteOrphanedRows(limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) Save(team *model.Team) (*model.Team, error) {
+func (s *RetryLayerThreadStore) Get(id string) (*model.Thread, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.Save(team)
+		result, err := s.ThreadStore.Get(id)
 		if err == nil {
 			return result, nil
 		}
@@ -11269,11 +14210,11 @@ func (s *RetryLayerTeamStore) Save(team *model.Team) (*model.Team, error) {
 
 }
 
-func (s *RetryLayerTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
+func (s *RetryLayerThreadStore) GetInboxThreadsForUser(userID string, options model.GetInboxOptions) ([]*model.InboxItem, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.SaveMember(member, maxUsersPerTeam)
+		result, err := s.ThreadStore.GetInboxThreadsForUser(userID, options)
 		if err == nil {
 			return result, nil
 		}
@@ -11290,11 +14231,11 @@ func (s *RetryLayerTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTe
 
 }
 
-func (s *RetryLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
+func (s *RetryLayerThreadStore) GetMembershipForUser(userId string, postID string) (*model.ThreadMembership, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.SaveMultipleMembers(members, maxUsersPerTeam)
+		result, err := s.ThreadStore.GetMembershipForUser(userId, postID)
 		if err == nil {
 			return result, nil
 		}
@@ -11311,11 +14252,11 @@ func (s *RetryLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, m
 
 }
 
-func (s *RetryLayerTeamStore) SearchAll(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *RetryLayerThreadStore) GetMembershipsForUser(userId string, teamID string) ([]*model.ThreadMembership, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.SearchAll(opts)
+		result, err := s.ThreadStore.GetMembershipsForUser(userId, teamID)
 		if err == nil {
 			return result, nil
 		}
@@ -11332,32 +14273,32 @@ func (s *RetryLayerTeamStore) SearchAll(opts *model.TeamSearch) ([]*model.Team,
 
 }
 
-func (s *RetryLayerTeamStore) SearchAllPaged(opts *model.TeamSearch) ([]*model.Team, int64, error) {
+func (s *RetryLayerThreadStore) GetTeamsUnreadForUser(userID string, teamIDs []string, includeUrgentMentionCount bool) (map[string]*model.TeamUnread, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.TeamStore.SearchAllPaged(opts)
+		result, err := s.ThreadStore.GetTeamsUnreadForUser(userID, teamIDs, includeUrgentMentionCount)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *RetryLayerThreadStore) GetThreadFollowers(threadID string, fetchOnlyActive bool) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.SearchOpen(opts)
+		result, err := s.ThreadStore.GetThreadFollowers(threadID, fetchOnlyActive)
 		if err == nil {
 			return result, nil
 		}
@@ -11374,11 +14315,11 @@ func (s *RetryLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team,
 
 }
 
-func (s *RetryLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *RetryLayerThreadStore) GetThreadForUser(threadMembership *model.ThreadMembership, extended bool, postPriorityIsEnabled bool) (*model.ThreadResponse, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.SearchPrivate(opts)
+		result, err := s.ThreadStore.GetThreadForUser(threadMembership, extended, postPriorityIsEnabled)
 		if err == nil {
 			return result, nil
 		}
@@ -11395,11 +14336,11 @@ func (s *RetryLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Te
 
 }
 
-func (s *RetryLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
+func (s *RetryLayerThreadStore) GetThreadUnreadReplyCount(threadMembership *model.ThreadMembership) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.Update(team)
+		result, err := s.ThreadStore.GetThreadUnreadReplyCount(threadMembership)
 		if err == nil {
 			return result, nil
 		}
@@ -11416,32 +14357,32 @@ func (s *RetryLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
 
 }
 
-func (s *RetryLayerTeamStore) UpdateLastTeamIconUpdate(teamID string, curTime int64) error {
+func (s *RetryLayerThreadStore) GetThreadsForUser(userId string, teamID string, opts model.GetUserThreadsOpts) ([]*model.ThreadResponse, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.UpdateLastTeamIconUpdate(teamID, curTime)
+		result, err := s.ThreadStore.GetThreadsForUser(userId, teamID, opts)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, error) {
+func (s *RetryLayerThreadStore) GetTopThreadsForTeamSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopThreadList, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.UpdateMember(member)
+		result, err := s.ThreadStore.GetTopThreadsForTeamSince(teamID, userID, since, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -11458,32 +14399,32 @@ func (s *RetryLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.Tea
 
 }
 
-func (s *RetryLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string) error {
+func (s *RetryLayerThreadStore) GetTopThreadsForUserSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopThreadList, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.UpdateMembersRole(teamID, userIDs)
+		result, err := s.ThreadStore.GetTopThreadsForUserSince(teamID, userID, since, offset, limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, error) {
+func (s *RetryLayerThreadStore) GetTotalThreads(userId string, teamID string, opts model.GetUserThreadsOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.UpdateMultipleMembers(members)
+		result, err := s.ThreadStore.GetTotalThreads(userId, teamID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -11500,11 +14441,11 @@ func (s *RetryLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember)
 
 }
 
-func (s *RetryLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string) (bool, error) {
+func (s *RetryLayerThreadStore) GetTotalUnreadMentions(userId string, teamID string, opts model.GetUserThreadsOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.UserBelongsToTeams(userID, teamIds)
+		result, err := s.ThreadStore.GetTotalUnreadMentions(userId, teamID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -11521,11 +14462,11 @@ func (s *RetryLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string
 
 }
 
-func (s *RetryLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*model.TermsOfService, error) {
+func (s *RetryLayerThreadStore) GetTotalUnreadThreads(userId string, teamID string, opts model.GetUserThreadsOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.TermsOfServiceStore.Get(id, allowFromCache)
+		result, err := s.ThreadStore.GetTotalUnreadThreads(userId, teamID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -11542,11 +14483,11 @@ func (s *RetryLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*mo
 
 }
 
-func (s *RetryLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.TermsOfService, error) {
+func (s *RetryLayerThreadStore) GetTotalUnreadUrgentMentions(userId string, teamID string, opts model.GetUserThreadsOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.TermsOfServiceStore.GetLatest(allowFromCache)
+		result, err := s.ThreadStore.GetTotalUnreadUrgentMentions(userId, teamID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -11563,11 +14504,11 @@ func (s *RetryLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.T
 
 }
 
-func (s *RetryLayerTermsOfServiceStore) Save(termsOfService *model.TermsOfService) (*model.TermsOfService, error) {
+func (s *RetryLayerThreadStore) MaintainMembership(userID string, postID string, opts store.ThreadMembershipOpts) (*model.ThreadMembership, error) {
 
 	tries := 0
 	for {
-		result, err := s.TermsOfServiceStore.Save(termsOfService)
+		result, err := s.ThreadStore.MaintainMembership(userID, postID, opts)
 		if err == nil {
 			return result, nil
 		}
@@ -11584,11 +14525,11 @@ func (s *RetryLayerTermsOfServiceStore) Save(termsOfService *model.TermsOfServic
 
 }
 
-func (s *RetryLayerThreadStore) DeleteMembershipForUser(userId string, postID string) error {
+func (s *RetryLayerThreadStore) MarkAllAsRead(userID string, threadIds []string) error {
 
 	tries := 0
 	for {
-		err := s.ThreadStore.DeleteMembershipForUser(userId, postID)
+		err := s.ThreadStore.MarkAllAsRead(userID, threadIds)
 		if err == nil {
 			return nil
 		}
@@ -11605,116 +14546,116 @@ func (s *RetryLayerThreadStore) DeleteMembershipForUser(userId string, postID st
 
 }
 
-func (s *RetryLayerThreadStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerThreadStore) MarkAllAsReadByChannels(userID string, channelIDs []string) error {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.DeleteOrphanedRows(limit)
+		err := s.ThreadStore.MarkAllAsReadByChannels(userID, channelIDs)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) Get(id string) (*model.Thread, error) {
+func (s *RetryLayerThreadStore) MarkAllAsReadByTeam(userID string, teamID string) error {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.Get(id)
+		err := s.ThreadStore.MarkAllAsReadByTeam(userID, teamID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) GetMembershipForUser(userId string, postID string) (*model.ThreadMembership, error) {
+func (s *RetryLayerThreadStore) MarkAsRead(userID string, threadID string, timestamp int64) error {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetMembershipForUser(userId, postID)
+		err := s.ThreadStore.MarkAsRead(userID, threadID, timestamp)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) GetMembershipsForUser(userId string, teamID string) ([]*model.ThreadMembership, error) {
+func (s *RetryLayerThreadStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetMembershipsForUser(userId, teamID)
+		result, resultVar1, err := s.ThreadStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
 		if err == nil {
-			return result, nil
+			return result, resultVar1, nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return result, resultVar1, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return result, resultVar1, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) GetTeamsUnreadForUser(userID string, teamIDs []string, includeUrgentMentionCount bool) (map[string]*model.TeamUnread, error) {
+func (s *RetryLayerThreadStore) PermanentDeleteBatchThreadMembershipsForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetTeamsUnreadForUser(userID, teamIDs, includeUrgentMentionCount)
+		result, resultVar1, err := s.ThreadStore.PermanentDeleteBatchThreadMembershipsForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
 		if err == nil {
-			return result, nil
+			return result, resultVar1, nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return result, resultVar1, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return result, resultVar1, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) GetThreadFollowers(threadID string, fetchOnlyActive bool) ([]string, error) {
+func (s *RetryLayerThreadStore) UpdateMembership(membership *model.ThreadMembership) (*model.ThreadMembership, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetThreadFollowers(threadID, fetchOnlyActive)
+		result, err := s.ThreadStore.UpdateMembership(membership)
 		if err == nil {
 			return result, nil
 		}
@@ -11731,32 +14672,38 @@ func (s *RetryLayerThreadStore) GetThreadFollowers(threadID string, fetchOnlyAct
 
 }
 
-func (s *RetryLayerThreadStore) GetThreadForUser(threadMembership *model.ThreadMembership, extended bool, postPriorityIsEnabled bool) (*model.ThreadResponse, error) {
+func (s *RetryLayerTokenStore) Cleanup(expiryTime int64) {
+
+	s.TokenStore.Cleanup(expiryTime)
+
+}
+
+func (s *RetryLayerTokenStore) Delete(token string) error {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetThreadForUser(threadMembership, extended, postPriorityIsEnabled)
+		err := s.TokenStore.Delete(token)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) GetThreadUnreadReplyCount(threadMembership *model.ThreadMembership) (int64, error) {
+func (s *RetryLayerTokenStore) GetAllTokensByType(tokenType string) ([]*model.Token, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetThreadUnreadReplyCount(threadMembership)
+		result, err := s.TokenStore.GetAllTokensByType(tokenType)
 		if err == nil {
 			return result, nil
 		}
@@ -11773,11 +14720,11 @@ func (s *RetryLayerThreadStore) GetThreadUnreadReplyCount(threadMembership *mode
 
 }
 
-func (s *RetryLayerThreadStore) GetThreadsForUser(userId string, teamID string, opts model.GetUserThreadsOpts) ([]*model.ThreadResponse, error) {
+func (s *RetryLayerTokenStore) GetByToken(token string) (*model.Token, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetThreadsForUser(userId, teamID, opts)
+		result, err := s.TokenStore.GetByToken(token)
 		if err == nil {
 			return result, nil
 		}
@@ -11794,53 +14741,53 @@ func (s *RetryLayerThreadStore) GetThreadsForUser(userId string, teamID string,
 
 }
 
-func (s *RetryLayerThreadStore) GetTopThreadsForTeamSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopThreadList, error) {
+func (s *RetryLayerTokenStore) RemoveAllTokensByType(tokenType string) error {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetTopThreadsForTeamSince(teamID, userID, since, offset, limit)
+		err := s.TokenStore.RemoveAllTokensByType(tokenType)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) GetTopThreadsForUserSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopThreadList, error) {
+func (s *RetryLayerTokenStore) Save(recovery *model.Token) error {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetTopThreadsForUserSince(teamID, userID, since, offset, limit)
+		err := s.TokenStore.Save(recovery)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) GetTotalThreads(userId string, teamID string, opts model.GetUserThreadsOpts) (int64, error) {
+func (s *RetryLayerTrueUpReviewStore) CreateTrueUpReviewStatusRecord(reviewStatus *model.TrueUpReviewStatus) (*model.TrueUpReviewStatus, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetTotalThreads(userId, teamID, opts)
+		result, err := s.TrueUpReviewStore.CreateTrueUpReviewStatusRecord(reviewStatus)
 		if err == nil {
 			return result, nil
 		}
@@ -11857,11 +14804,11 @@ func (s *RetryLayerThreadStore) GetTotalThreads(userId string, teamID string, op
 
 }
 
-func (s *RetryLayerThreadStore) GetTotalUnreadMentions(userId string, teamID string, opts model.GetUserThreadsOpts) (int64, error) {
+func (s *RetryLayerTrueUpReviewStore) GetTrueUpReviewStatus(dueDate int64) (*model.TrueUpReviewStatus, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetTotalUnreadMentions(userId, teamID, opts)
+		result, err := s.TrueUpReviewStore.GetTrueUpReviewStatus(dueDate)
 		if err == nil {
 			return result, nil
 		}
@@ -11878,11 +14825,11 @@ func (s *RetryLayerThreadStore) GetTotalUnreadMentions(userId string, teamID str
 
 }
 
-func (s *RetryLayerThreadStore) GetTotalUnreadThreads(userId string, teamID string, opts model.GetUserThreadsOpts) (int64, error) {
+func (s *RetryLayerTrueUpReviewStore) Update(reviewStatus *model.TrueUpReviewStatus) (*model.TrueUpReviewStatus, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetTotalUnreadThreads(userId, teamID, opts)
+		result, err := s.TrueUpReviewStore.Update(reviewStatus)
 		if err == nil {
 			return result, nil
 		}
@@ -11899,32 +14846,32 @@ func (s *RetryLayerThreadStore) GetTotalUnreadThreads(userId string, teamID stri
 
 }
 
-func (s *RetryLayerThreadStore) GetTotalUnreadUrgentMentions(userId string, teamID string, opts model.GetUserThreadsOpts) (int64, error) {
+func (s *RetryLayerUploadSessionStore) Delete(id string) error {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetTotalUnreadUrgentMentions(userId, teamID, opts)
+		err := s.UploadSessionStore.Delete(id)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) MaintainMembership(userID string, postID string, opts store.ThreadMembershipOpts) (*model.ThreadMembership, error) {
+func (s *RetryLayerUploadSessionStore) Get(ctx context.Context, id string) (*model.UploadSession, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.MaintainMembership(userID, postID, opts)
+		result, err := s.UploadSessionStore.Get(ctx, id)
 		if err == nil {
 			return result, nil
 		}
@@ -11941,53 +14888,53 @@ func (s *RetryLayerThreadStore) MaintainMembership(userID string, postID string,
 
 }
 
-func (s *RetryLayerThreadStore) MarkAllAsRead(userID string, threadIds []string) error {
+func (s *RetryLayerUploadSessionStore) GetForUser(userID string) ([]*model.UploadSession, error) {
 
 	tries := 0
 	for {
-		err := s.ThreadStore.MarkAllAsRead(userID, threadIds)
+		result, err := s.UploadSessionStore.GetForUser(userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) MarkAllAsReadByChannels(userID string, channelIDs []string) error {
+func (s *RetryLayerUploadSessionStore) Save(session *model.UploadSession) (*model.UploadSession, error) {
 
 	tries := 0
 	for {
-		err := s.ThreadStore.MarkAllAsReadByChannels(userID, channelIDs)
+		result, err := s.UploadSessionStore.Save(session)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) MarkAllAsReadByTeam(userID string, teamID string) error {
+func (s *RetryLayerUploadSessionStore) Update(session *model.UploadSession) error {
 
 	tries := 0
 	for {
-		err := s.ThreadStore.MarkAllAsReadByTeam(userID, teamID)
+		err := s.UploadSessionStore.Update(session)
 		if err == nil {
 			return nil
 		}
@@ -12004,74 +14951,74 @@ func (s *RetryLayerThreadStore) MarkAllAsReadByTeam(userID string, teamID string
 
 }
 
-func (s *RetryLayerThreadStore) MarkAsRead(userID string, threadID string, timestamp int64) error {
+func (s *RetryLayerUserStore) AnalyticsActiveCount(timestamp int64, options model.UserCountOptions) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ThreadStore.MarkAsRead(userID, threadID, timestamp)
+		result, err := s.UserStore.AnalyticsActiveCount(timestamp, options)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+func (s *RetryLayerUserStore) AnalyticsActiveCountForPeriod(startTime int64, endTime int64, options model.UserCountOptions) (int64, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ThreadStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+		result, err := s.UserStore.AnalyticsActiveCountForPeriod(startTime, endTime, options)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) PermanentDeleteBatchThreadMembershipsForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+func (s *RetryLayerUserStore) AnalyticsGetExternalUsers(hostDomain string) (bool, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ThreadStore.PermanentDeleteBatchThreadMembershipsForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+		result, err := s.UserStore.AnalyticsGetExternalUsers(hostDomain)
 		if err == nil {
-			return result, resultVar1, nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerThreadStore) UpdateMembership(membership *model.ThreadMembership) (*model.ThreadMembership, error) {
+func (s *RetryLayerUserStore) AnalyticsGetGuestCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.UpdateMembership(membership)
+		result, err := s.UserStore.AnalyticsGetGuestCount()
 		if err == nil {
 			return result, nil
 		}
@@ -12088,38 +15035,32 @@ func (s *RetryLayerThreadStore) UpdateMembership(membership *model.ThreadMembers
 
 }
 
-func (s *RetryLayerTokenStore) Cleanup(expiryTime int64) {
-
-	s.TokenStore.Cleanup(expiryTime)
-
-}
-
-func (s *RetryLayerTokenStore) Delete(token string) error {
+func (s *RetryLayerUserStore) AnalyticsGetInactiveUsersCount() (int64, error) {
 
 	tries := 0
 	for {
-		err := s.TokenStore.Delete(token)
+		result, err := s.UserStore.AnalyticsGetInactiveUsersCount()
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTokenStore) GetAllTokensByType(tokenType string) ([]*model.Token, error) {
+func (s *RetryLayerUserStore) AnalyticsGetSystemAdminCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.TokenStore.GetAllTokensByType(tokenType)
+		result, err := s.UserStore.AnalyticsGetSystemAdminCount()
 		if err == nil {
 			return result, nil
 		}
@@ -12136,11 +15077,11 @@ func (s *RetryLayerTokenStore) GetAllTokensByType(tokenType string) ([]*model.To
 
 }
 
-func (s *RetryLayerTokenStore) GetByToken(token string) (*model.Token, error) {
+func (s *RetryLayerUserStore) AutocompleteUsersInChannel(ctx context.Context, teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
 
 	tries := 0
 	for {
-		result, err := s.TokenStore.GetByToken(token)
+		result, err := s.UserStore.AutocompleteUsersInChannel(ctx, teamID, channelID, term, options)
 		if err == nil {
 			return result, nil
 		}
@@ -12157,11 +15098,11 @@ func (s *RetryLayerTokenStore) GetByToken(token string) (*model.Token, error) {
 
 }
 
-func (s *RetryLayerTokenStore) RemoveAllTokensByType(tokenType string) error {
+func (s *RetryLayerUserStore) ClearAllCustomRoleAssignments() error {
 
 	tries := 0
 	for {
-		err := s.TokenStore.RemoveAllTokensByType(tokenType)
+		err := s.UserStore.ClearAllCustomRoleAssignments()
 		if err == nil {
 			return nil
 		}
@@ -12178,32 +15119,38 @@ func (s *RetryLayerTokenStore) RemoveAllTokensByType(tokenType string) error {
 
 }
 
-func (s *RetryLayerTokenStore) Save(recovery *model.Token) error {
+func (s *RetryLayerUserStore) ClearCaches() {
+
+	s.UserStore.ClearCaches()
+
+}
+
+func (s *RetryLayerUserStore) Count(options model.UserCountOptions) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.TokenStore.Save(recovery)
+		result, err := s.UserStore.Count(options)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTrueUpReviewStore) CreateTrueUpReviewStatusRecord(reviewStatus *model.TrueUpReviewStatus) (*model.TrueUpReviewStatus, error) {
+func (s *RetryLayerUserStore) DeactivateGuests() ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.TrueUpReviewStore.CreateTrueUpReviewStatusRecord(reviewStatus)
+		result, err := s.UserStore.DeactivateGuests()
 		if err == nil {
 			return result, nil
 		}
@@ -12220,11 +15167,11 @@ func (s *RetryLayerTrueUpReviewStore) CreateTrueUpReviewStatusRecord(reviewStatu
 
 }
 
-func (s *RetryLayerTrueUpReviewStore) GetTrueUpReviewStatus(dueDate int64) (*model.TrueUpReviewStatus, error) {
+func (s *RetryLayerUserStore) DemoteUserToGuest(userID string) (*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.TrueUpReviewStore.GetTrueUpReviewStatus(dueDate)
+		result, err := s.UserStore.DemoteUserToGuest(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -12241,11 +15188,11 @@ func (s *RetryLayerTrueUpReviewStore) GetTrueUpReviewStatus(dueDate int64) (*mod
 
 }
 
-func (s *RetryLayerTrueUpReviewStore) Update(reviewStatus *model.TrueUpReviewStatus) (*model.TrueUpReviewStatus, error) {
+func (s *RetryLayerUserStore) Get(ctx context.Context, id string) (*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.TrueUpReviewStore.Update(reviewStatus)
+		result, err := s.UserStore.Get(ctx, id)
 		if err == nil {
 			return result, nil
 		}
@@ -12262,32 +15209,32 @@ func (s *RetryLayerTrueUpReviewStore) Update(reviewStatus *model.TrueUpReviewSta
 
 }
 
-func (s *RetryLayerUploadSessionStore) Delete(id string) error {
+func (s *RetryLayerUserStore) GetAll() ([]*model.User, error) {
 
 	tries := 0
 	for {
-		err := s.UploadSessionStore.Delete(id)
+		result, err := s.UserStore.GetAll()
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUploadSessionStore) Get(ctx context.Context, id string) (*model.UploadSession, error) {
+func (s *RetryLayerUserStore) GetAllAfter(limit int, afterID string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UploadSessionStore.Get(ctx, id)
+		result, err := s.UserStore.GetAllAfter(limit, afterID)
 		if err == nil {
 			return result, nil
 		}
@@ -12304,11 +15251,11 @@ func (s *RetryLayerUploadSessionStore) Get(ctx context.Context, id string) (*mod
 
 }
 
-func (s *RetryLayerUploadSessionStore) GetForUser(userID string) ([]*model.UploadSession, error) {
+func (s *RetryLayerUserStore) GetAllNotInAuthService(authServices []string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UploadSessionStore.GetForUser(userID)
+		result, err := s.UserStore.GetAllNotInAuthService(authServices)
 		if err == nil {
 			return result, nil
 		}
@@ -12325,11 +15272,11 @@ func (s *RetryLayerUploadSessionStore) GetForUser(userID string) ([]*model.Uploa
 
 }
 
-func (s *RetryLayerUploadSessionStore) Save(session *model.UploadSession) (*model.UploadSession, error) {
+func (s *RetryLayerUserStore) GetAllProfiles(options *model.UserGetOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UploadSessionStore.Save(session)
+		result, err := s.UserStore.GetAllProfiles(options)
 		if err == nil {
 			return result, nil
 		}
@@ -12346,32 +15293,32 @@ func (s *RetryLayerUploadSessionStore) Save(session *model.UploadSession) (*mode
 
 }
 
-func (s *RetryLayerUploadSessionStore) Update(session *model.UploadSession) error {
+func (s *RetryLayerUserStore) GetAllProfilesInChannel(ctx context.Context, channelID string, allowFromCache bool) (map[string]*model.User, error) {
 
 	tries := 0
 	for {
-		err := s.UploadSessionStore.Update(session)
+		result, err := s.UserStore.GetAllProfilesInChannel(ctx, channelID, allowFromCache)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) AnalyticsActiveCount(timestamp int64, options model.UserCountOptions) (int64, error) {
+func (s *RetryLayerUserStore) GetAllUsingAuthService(authService string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsActiveCount(timestamp, options)
+		result, err := s.UserStore.GetAllUsingAuthService(authService)
 		if err == nil {
 			return result, nil
 		}
@@ -12388,11 +15335,11 @@ func (s *RetryLayerUserStore) AnalyticsActiveCount(timestamp int64, options mode
 
 }
 
-func (s *RetryLayerUserStore) AnalyticsActiveCountForPeriod(startTime int64, endTime int64, options model.UserCountOptions) (int64, error) {
+func (s *RetryLayerUserStore) GetAnyUnreadPostCountForChannel(userID string, channelID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsActiveCountForPeriod(startTime, endTime, options)
+		result, err := s.UserStore.GetAnyUnreadPostCountForChannel(userID, channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -12409,11 +15356,11 @@ func (s *RetryLayerUserStore) AnalyticsActiveCountForPeriod(startTime int64, end
 
 }
 
-func (s *RetryLayerUserStore) AnalyticsGetExternalUsers(hostDomain string) (bool, error) {
+func (s *RetryLayerUserStore) GetByAuth(authData *string, authService string) (*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsGetExternalUsers(hostDomain)
+		result, err := s.UserStore.GetByAuth(authData, authService)
 		if err == nil {
 			return result, nil
 		}
@@ -12430,11 +15377,11 @@ func (s *RetryLayerUserStore) AnalyticsGetExternalUsers(hostDomain string) (bool
 
 }
 
-func (s *RetryLayerUserStore) AnalyticsGetGuestCount() (int64, error) {
+func (s *RetryLayerUserStore) GetByEmail(email string) (*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsGetGuestCount()
+		result, err := s.UserStore.GetByEmail(email)
 		if err == nil {
 			return result, nil
 		}
@@ -12451,11 +15398,11 @@ func (s *RetryLayerUserStore) AnalyticsGetGuestCount() (int64, error) {
 
 }
 
-func (s *RetryLayerUserStore) AnalyticsGetInactiveUsersCount() (int64, error) {
+func (s *RetryLayerUserStore) GetByUsername(username string) (*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsGetInactiveUsersCount()
+		result, err := s.UserStore.GetByUsername(username)
 		if err == nil {
 			return result, nil
 		}
@@ -12472,11 +15419,11 @@ func (s *RetryLayerUserStore) AnalyticsGetInactiveUsersCount() (int64, error) {
 
 }
 
-func (s *RetryLayerUserStore) AnalyticsGetSystemAdminCount() (int64, error) {
+func (s *RetryLayerUserStore) GetChannelGroupUsers(channelID string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsGetSystemAdminCount()
+		result, err := s.UserStore.GetChannelGroupUsers(channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -12493,11 +15440,29 @@ func (s *RetryLayerUserStore) AnalyticsGetSystemAdminCount() (int64, error) {
 
 }
 
-func (s *RetryLayerUserStore) AutocompleteUsersInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
+func (s *RetryLayerUserStore) GetEtagForAllProfiles() string {
+
+	return s.UserStore.GetEtagForAllProfiles()
+
+}
+
+func (s *RetryLayerUserStore) GetEtagForProfiles(teamID string) string {
+
+	return s.UserStore.GetEtagForProfiles(teamID)
+
+}
+
+func (s *RetryLayerUserStore) GetEtagForProfilesNotInTeam(teamID string) string {
+
+	return s.UserStore.GetEtagForProfilesNotInTeam(teamID)
+
+}
+
+func (s *RetryLayerUserStore) GetFirstSystemAdminID() (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AutocompleteUsersInChannel(teamID, channelID, term, options)
+		result, err := s.UserStore.GetFirstSystemAdminID()
 		if err == nil {
 			return result, nil
 		}
@@ -12514,38 +15479,32 @@ func (s *RetryLayerUserStore) AutocompleteUsersInChannel(teamID string, channelI
 
 }
 
-func (s *RetryLayerUserStore) ClearAllCustomRoleAssignments() error {
+func (s *RetryLayerUserStore) GetForLogin(loginID string, allowSignInWithUsername bool, allowSignInWithEmail bool) (*model.User, error) {
 
 	tries := 0
 	for {
-		err := s.UserStore.ClearAllCustomRoleAssignments()
+		result, err := s.UserStore.GetForLogin(loginID, allowSignInWithUsername, allowSignInWithEmail)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) ClearCaches() {
-
-	s.UserStore.ClearCaches()
-
-}
-
-func (s *RetryLayerUserStore) Count(options model.UserCountOptions) (int64, error) {
+func (s *RetryLayerUserStore) GetKnownUsers(userID string) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.Count(options)
+		result, err := s.UserStore.GetKnownUsers(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -12562,11 +15521,11 @@ func (s *RetryLayerUserStore) Count(options model.UserCountOptions) (int64, erro
 
 }
 
-func (s *RetryLayerUserStore) DeactivateGuests() ([]string, error) {
+func (s *RetryLayerUserStore) GetMany(ctx context.Context, ids []string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.DeactivateGuests()
+		result, err := s.UserStore.GetMany(ctx, ids)
 		if err == nil {
 			return result, nil
 		}
@@ -12583,11 +15542,11 @@ func (s *RetryLayerUserStore) DeactivateGuests() ([]string, error) {
 
 }
 
-func (s *RetryLayerUserStore) DemoteUserToGuest(userID string) (*model.User, error) {
+func (s *RetryLayerUserStore) GetNewUsersForTeam(teamID string, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.DemoteUserToGuest(userID)
+		result, err := s.UserStore.GetNewUsersForTeam(teamID, offset, limit, viewRestrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -12604,32 +15563,32 @@ func (s *RetryLayerUserStore) DemoteUserToGuest(userID string) (*model.User, err
 
 }
 
-func (s *RetryLayerUserStore) Get(ctx context.Context, id string) (*model.User, error) {
+func (s *RetryLayerUserStore) GetPageUsingAuthService(authService string, options model.UserGetByAuthServiceOptions) ([]*model.User, int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.Get(ctx, id)
+		result, resultVar1, err := s.UserStore.GetPageUsingAuthService(authService, options)
 		if err == nil {
-			return result, nil
+			return result, resultVar1, nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return result, resultVar1, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return result, resultVar1, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) GetAll() ([]*model.User, error) {
+func (s *RetryLayerUserStore) GetProfileByGroupChannelIdsForUser(userID string, channelIds []string) (map[string][]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAll()
+		result, err := s.UserStore.GetProfileByGroupChannelIdsForUser(userID, channelIds)
 		if err == nil {
 			return result, nil
 		}
@@ -12646,11 +15605,11 @@ func (s *RetryLayerUserStore) GetAll() ([]*model.User, error) {
 
 }
 
-func (s *RetryLayerUserStore) GetAllAfter(limit int, afterID string) ([]*model.User, error) {
+func (s *RetryLayerUserStore) GetProfileByIds(ctx context.Context, userIds []string, options *store.UserGetByIdsOpts, allowFromCache bool) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAllAfter(limit, afterID)
+		result, err := s.UserStore.GetProfileByIds(ctx, userIds, options, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -12667,11 +15626,11 @@ func (s *RetryLayerUserStore) GetAllAfter(limit int, afterID string) ([]*model.U
 
 }
 
-func (s *RetryLayerUserStore) GetAllNotInAuthService(authServices []string) ([]*model.User, error) {
+func (s *RetryLayerUserStore) GetProfiles(options *model.UserGetOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAllNotInAuthService(authServices)
+		result, err := s.UserStore.GetProfiles(options)
 		if err == nil {
 			return result, nil
 		}
@@ -12688,11 +15647,11 @@ func (s *RetryLayerUserStore) GetAllNotInAuthService(authServices []string) ([]*
 
 }
 
-func (s *RetryLayerUserStore) GetAllProfiles(options *model.UserGetOptions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) GetProfilesByUsernames(usernames []string, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAllProfiles(options)
+		result, err := s.UserStore.GetProfilesByUsernames(usernames, viewRestrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -12709,11 +15668,11 @@ func (s *RetryLayerUserStore) GetAllProfiles(options *model.UserGetOptions) ([]*
 
 }
 
-func (s *RetryLayerUserStore) GetAllProfilesInChannel(ctx context.Context, channelID string, allowFromCache bool) (map[string]*model.User, error) {
+func (s *RetryLayerUserStore) GetProfilesInChannel(options *model.UserGetOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAllProfilesInChannel(ctx, channelID, allowFromCache)
+		result, err := s.UserStore.GetProfilesInChannel(options)
 		if err == nil {
 			return result, nil
 		}
@@ -12730,11 +15689,11 @@ func (s *RetryLayerUserStore) GetAllProfilesInChannel(ctx context.Context, chann
 
 }
 
-func (s *RetryLayerUserStore) GetAllUsingAuthService(authService string) ([]*model.User, error) {
+func (s *RetryLayerUserStore) GetProfilesInChannelByAdmin(options *model.UserGetOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAllUsingAuthService(authService)
+		result, err := s.UserStore.GetProfilesInChannelByAdmin(options)
 		if err == nil {
 			return result, nil
 		}
@@ -12751,11 +15710,11 @@ func (s *RetryLayerUserStore) GetAllUsingAuthService(authService string) ([]*mod
 
 }
 
-func (s *RetryLayerUserStore) GetAnyUnreadPostCountForChannel(userID string, channelID string) (int64, error) {
+func (s *RetryLayerUserStore) GetProfilesInChannelByStatus(options *model.UserGetOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAnyUnreadPostCountForChannel(userID, channelID)
+		result, err := s.UserStore.GetProfilesInChannelByStatus(options)
 		if err == nil {
 			return result, nil
 		}
@@ -12772,11 +15731,11 @@ func (s *RetryLayerUserStore) GetAnyUnreadPostCountForChannel(userID string, cha
 
 }
 
-func (s *RetryLayerUserStore) GetByAuth(authData *string, authService string) (*model.User, error) {
+func (s *RetryLayerUserStore) GetProfilesNotInChannel(teamID string, channelId string, groupConstrained bool, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetByAuth(authData, authService)
+		result, err := s.UserStore.GetProfilesNotInChannel(teamID, channelId, groupConstrained, offset, limit, viewRestrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -12793,11 +15752,11 @@ func (s *RetryLayerUserStore) GetByAuth(authData *string, authService string) (*
 
 }
 
-func (s *RetryLayerUserStore) GetByEmail(email string) (*model.User, error) {
+func (s *RetryLayerUserStore) GetProfilesNotInTeam(teamID string, groupConstrained bool, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetByEmail(email)
+		result, err := s.UserStore.GetProfilesNotInTeam(teamID, groupConstrained, offset, limit, viewRestrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -12814,11 +15773,11 @@ func (s *RetryLayerUserStore) GetByEmail(email string) (*model.User, error) {
 
 }
 
-func (s *RetryLayerUserStore) GetByUsername(username string) (*model.User, error) {
+func (s *RetryLayerUserStore) GetProfilesWithoutTeam(options *model.UserGetOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetByUsername(username)
+		result, err := s.UserStore.GetProfilesWithoutTeam(options)
 		if err == nil {
 			return result, nil
 		}
@@ -12835,11 +15794,11 @@ func (s *RetryLayerUserStore) GetByUsername(username string) (*model.User, error
 
 }
 
-func (s *RetryLayerUserStore) GetChannelGroupUsers(channelID string) ([]*model.User, error) {
+func (s *RetryLayerUserStore) GetRecentlyActiveUsersForTeam(teamID string, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetChannelGroupUsers(channelID)
+		result, err := s.UserStore.GetRecentlyActiveUsersForTeam(teamID, offset, limit, viewRestrictions)
 		if err == nil {
 			return result, nil
 		}
@@ -12856,29 +15815,11 @@ func (s *RetryLayerUserStore) GetChannelGroupUsers(channelID string) ([]*model.U
 
 }
 
-func (s *RetryLayerUserStore) GetEtagForAllProfiles() string {
-
-	return s.UserStore.GetEtagForAllProfiles()
-
-}
-
-func (s *RetryLayerUserStore) GetEtagForProfiles(teamID string) string {
-
-	return s.UserStore.GetEtagForProfiles(teamID)
-
-}
-
-func (s *RetryLayerUserStore) GetEtagForProfilesNotInTeam(teamID string) string {
-
-	return s.UserStore.GetEtagForProfilesNotInTeam(teamID)
-
-}
-
-func (s *RetryLayerUserStore) GetFirstSystemAdminID() (string, error) {
+func (s *RetryLayerUserStore) GetSystemAdminProfiles() (map[string]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetFirstSystemAdminID()
+		result, err := s.UserStore.GetSystemAdminProfiles()
 		if err == nil {
 			return result, nil
 		}
@@ -12895,11 +15836,11 @@ func (s *RetryLayerUserStore) GetFirstSystemAdminID() (string, error) {
 
 }
 
-func (s *RetryLayerUserStore) GetForLogin(loginID string, allowSignInWithUsername bool, allowSignInWithEmail bool) (*model.User, error) {
+func (s *RetryLayerUserStore) GetTeamGroupUsers(teamID string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetForLogin(loginID, allowSignInWithUsername, allowSignInWithEmail)
+		result, err := s.UserStore.GetTeamGroupUsers(teamID)
 		if err == nil {
 			return result, nil
 		}
@@ -12916,11 +15857,11 @@ func (s *RetryLayerUserStore) GetForLogin(loginID string, allowSignInWithUsernam
 
 }
 
-func (s *RetryLayerUserStore) GetKnownUsers(userID string) ([]string, error) {
+func (s *RetryLayerUserStore) GetUnreadCount(userID string, isCRTEnabled bool) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetKnownUsers(userID)
+		result, err := s.UserStore.GetUnreadCount(userID, isCRTEnabled)
 		if err == nil {
 			return result, nil
 		}
@@ -12937,11 +15878,11 @@ func (s *RetryLayerUserStore) GetKnownUsers(userID string) ([]string, error) {
 
 }
 
-func (s *RetryLayerUserStore) GetMany(ctx context.Context, ids []string) ([]*model.User, error) {
+func (s *RetryLayerUserStore) GetUnreadCountForChannel(userID string, channelID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetMany(ctx, ids)
+		result, err := s.UserStore.GetUnreadCountForChannel(userID, channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -12958,11 +15899,11 @@ func (s *RetryLayerUserStore) GetMany(ctx context.Context, ids []string) ([]*mod
 
 }
 
-func (s *RetryLayerUserStore) GetNewUsersForTeam(teamID string, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) GetUsersBatchForIndexing(startTime int64, startFileID string, limit int) ([]*model.UserForIndexing, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetNewUsersForTeam(teamID, offset, limit, viewRestrictions)
+		result, err := s.UserStore.GetUsersBatchForIndexing(startTime, startFileID, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -12979,11 +15920,11 @@ func (s *RetryLayerUserStore) GetNewUsersForTeam(teamID string, offset int, limi
 
 }
 
-func (s *RetryLayerUserStore) GetProfileByGroupChannelIdsForUser(userID string, channelIds []string) (map[string][]*model.User, error) {
+func (s *RetryLayerUserStore) GetUsersWithInvalidEmails(page int, perPage int, restrictedDomains string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfileByGroupChannelIdsForUser(userID, channelIds)
+		result, err := s.UserStore.GetUsersWithInvalidEmails(page, perPage, restrictedDomains)
 		if err == nil {
 			return result, nil
 		}
@@ -13000,11 +15941,11 @@ func (s *RetryLayerUserStore) GetProfileByGroupChannelIdsForUser(userID string,
 
 }
 
-func (s *RetryLayerUserStore) GetProfileByIds(ctx context.Context, userIds []string, options *store.UserGetByIdsOpts, allowFromCache bool) ([]*model.User, error) {
+func (s *RetryLayerUserStore) InferSystemInstallDate() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfileByIds(ctx, userIds, options, allowFromCache)
+		result, err := s.UserStore.InferSystemInstallDate()
 		if err == nil {
 			return result, nil
 		}
@@ -13021,32 +15962,50 @@ func (s *RetryLayerUserStore) GetProfileByIds(ctx context.Context, userIds []str
 
 }
 
-func (s *RetryLayerUserStore) GetProfiles(options *model.UserGetOptions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) InsertUsers(users []*model.User) error {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfiles(options)
+		err := s.UserStore.InsertUsers(users)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) GetProfilesByUsernames(usernames []string, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) InvalidateProfileCacheForUser(userID string) {
+
+	s.UserStore.InvalidateProfileCacheForUser(userID)
+
+}
+
+func (s *RetryLayerUserStore) InvalidateProfilesInChannelCache(channelID string) {
+
+	s.UserStore.InvalidateProfilesInChannelCache(channelID)
+
+}
+
+func (s *RetryLayerUserStore) InvalidateProfilesInChannelCacheByUser(userID string) {
+
+	s.UserStore.InvalidateProfilesInChannelCacheByUser(userID)
+
+}
+
+func (s *RetryLayerUserStore) IsEmpty(excludeBots bool) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesByUsernames(usernames, viewRestrictions)
+		result, err := s.UserStore.IsEmpty(excludeBots)
 		if err == nil {
 			return result, nil
 		}
@@ -13063,53 +16022,53 @@ func (s *RetryLayerUserStore) GetProfilesByUsernames(usernames []string, viewRes
 
 }
 
-func (s *RetryLayerUserStore) GetProfilesInChannel(options *model.UserGetOptions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) PermanentDelete(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesInChannel(options)
+		err := s.UserStore.PermanentDelete(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) GetProfilesInChannelByAdmin(options *model.UserGetOptions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) PromoteGuestToUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesInChannelByAdmin(options)
+		err := s.UserStore.PromoteGuestToUser(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) GetProfilesInChannelByStatus(options *model.UserGetOptions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) ResetAuthDataToEmailForUsers(service string, userIDs []string, includeDeleted bool, dryRun bool) (int, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesInChannelByStatus(options)
+		result, err := s.UserStore.ResetAuthDataToEmailForUsers(service, userIDs, includeDeleted, dryRun)
 		if err == nil {
 			return result, nil
 		}
@@ -13126,32 +16085,32 @@ func (s *RetryLayerUserStore) GetProfilesInChannelByStatus(options *model.UserGe
 
 }
 
-func (s *RetryLayerUserStore) GetProfilesNotInChannel(teamID string, channelId string, groupConstrained bool, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) ResetLastPictureUpdate(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesNotInChannel(teamID, channelId, groupConstrained, offset, limit, viewRestrictions)
+		err := s.UserStore.ResetLastPictureUpdate(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) GetProfilesNotInTeam(teamID string, groupConstrained bool, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) Save(user *model.User) (*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesNotInTeam(teamID, groupConstrained, offset, limit, viewRestrictions)
+		result, err := s.UserStore.Save(user)
 		if err == nil {
 			return result, nil
 		}
@@ -13168,11 +16127,11 @@ func (s *RetryLayerUserStore) GetProfilesNotInTeam(teamID string, groupConstrain
 
 }
 
-func (s *RetryLayerUserStore) GetProfilesWithoutTeam(options *model.UserGetOptions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) Search(ctx context.Context, teamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesWithoutTeam(options)
+		result, err := s.UserStore.Search(ctx, teamID, term, options)
 		if err == nil {
 			return result, nil
 		}
@@ -13189,11 +16148,11 @@ func (s *RetryLayerUserStore) GetProfilesWithoutTeam(options *model.UserGetOptio
 
 }
 
-func (s *RetryLayerUserStore) GetRecentlyActiveUsersForTeam(teamID string, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) SearchInChannel(ctx context.Context, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetRecentlyActiveUsersForTeam(teamID, offset, limit, viewRestrictions)
+		result, err := s.UserStore.SearchInChannel(ctx, channelID, term, options)
 		if err == nil {
 			return result, nil
 		}
@@ -13210,11 +16169,11 @@ func (s *RetryLayerUserStore) GetRecentlyActiveUsersForTeam(teamID string, offse
 
 }
 
-func (s *RetryLayerUserStore) GetSystemAdminProfiles() (map[string]*model.User, error) {
+func (s *RetryLayerUserStore) SearchInGroup(ctx context.Context, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetSystemAdminProfiles()
+		result, err := s.UserStore.SearchInGroup(ctx, groupID, term, options)
 		if err == nil {
 			return result, nil
 		}
@@ -13231,11 +16190,11 @@ func (s *RetryLayerUserStore) GetSystemAdminProfiles() (map[string]*model.User,
 
 }
 
-func (s *RetryLayerUserStore) GetTeamGroupUsers(teamID string) ([]*model.User, error) {
+func (s *RetryLayerUserStore) SearchNotInChannel(ctx context.Context, teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetTeamGroupUsers(teamID)
+		result, err := s.UserStore.SearchNotInChannel(ctx, teamID, channelID, term, options)
 		if err == nil {
 			return result, nil
 		}
@@ -13252,11 +16211,11 @@ func (s *RetryLayerUserStore) GetTeamGroupUsers(teamID string) ([]*model.User, e
 
 }
 
-func (s *RetryLayerUserStore) GetUnreadCount(userID string, isCRTEnabled bool) (int64, error) {
+func (s *RetryLayerUserStore) SearchNotInGroup(ctx context.Context, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetUnreadCount(userID, isCRTEnabled)
+		result, err := s.UserStore.SearchNotInGroup(ctx, groupID, term, options)
 		if err == nil {
 			return result, nil
 		}
@@ -13273,11 +16232,11 @@ func (s *RetryLayerUserStore) GetUnreadCount(userID string, isCRTEnabled bool) (
 
 }
 
-func (s *RetryLayerUserStore) GetUnreadCountForChannel(userID string, channelID string) (int64, error) {
+func (s *RetryLayerUserStore) SearchNotInTeam(ctx context.Context, notInTeamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetUnreadCountForChannel(userID, channelID)
+		result, err := s.UserStore.SearchNotInTeam(ctx, notInTeamID, term, options)
 		if err == nil {
 			return result, nil
 		}
@@ -13294,11 +16253,11 @@ func (s *RetryLayerUserStore) GetUnreadCountForChannel(userID string, channelID
 
 }
 
-func (s *RetryLayerUserStore) GetUsersBatchForIndexing(startTime int64, startFileID string, limit int) ([]*model.UserForIndexing, error) {
+func (s *RetryLayerUserStore) SearchWithoutTeam(ctx context.Context, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetUsersBatchForIndexing(startTime, startFileID, limit)
+		result, err := s.UserStore.SearchWithoutTeam(ctx, term, options)
 		if err == nil {
 			return result, nil
 		}
@@ -13315,11 +16274,11 @@ func (s *RetryLayerUserStore) GetUsersBatchForIndexing(startTime int64, startFil
 
 }
 
-func (s *RetryLayerUserStore) GetUsersWithInvalidEmails(page int, perPage int, restrictedDomains string) ([]*model.User, error) {
+func (s *RetryLayerUserStore) Update(user *model.User, allowRoleUpdate bool) (*model.UserUpdate, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetUsersWithInvalidEmails(page, perPage, restrictedDomains)
+		result, err := s.UserStore.Update(user, allowRoleUpdate)
 		if err == nil {
 			return result, nil
 		}
@@ -13336,11 +16295,11 @@ func (s *RetryLayerUserStore) GetUsersWithInvalidEmails(page int, perPage int, r
 
 }
 
-func (s *RetryLayerUserStore) InferSystemInstallDate() (int64, error) {
+func (s *RetryLayerUserStore) UpdateAuthData(userID string, service string, authData *string, email string, resetMfa bool) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.InferSystemInstallDate()
+		result, err := s.UserStore.UpdateAuthData(userID, service, authData, email, resetMfa)
 		if err == nil {
 			return result, nil
 		}
@@ -13357,11 +16316,11 @@ func (s *RetryLayerUserStore) InferSystemInstallDate() (int64, error) {
 
 }
 
-func (s *RetryLayerUserStore) InsertUsers(users []*model.User) error {
+func (s *RetryLayerUserStore) UpdateFailedPasswordAttempts(userID string, attempts int) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.InsertUsers(users)
+		err := s.UserStore.UpdateFailedPasswordAttempts(userID, attempts)
 		if err == nil {
 			return nil
 		}
@@ -13378,50 +16337,32 @@ func (s *RetryLayerUserStore) InsertUsers(users []*model.User) error {
 
 }
 
-func (s *RetryLayerUserStore) InvalidateProfileCacheForUser(userID string) {
-
-	s.UserStore.InvalidateProfileCacheForUser(userID)
-
-}
-
-func (s *RetryLayerUserStore) InvalidateProfilesInChannelCache(channelID string) {
-
-	s.UserStore.InvalidateProfilesInChannelCache(channelID)
-
-}
-
-func (s *RetryLayerUserStore) InvalidateProfilesInChannelCacheByUser(userID string) {
-
-	s.UserStore.InvalidateProfilesInChannelCacheByUser(userID)
-
-}
-
-func (s *RetryLayerUserStore) IsEmpty(excludeBots bool) (bool, error) {
+func (s *RetryLayerUserStore) UpdateLastPictureUpdate(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.IsEmpty(excludeBots)
+		err := s.UserStore.UpdateLastPictureUpdate(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) PermanentDelete(userID string) error {
+func (s *RetryLayerUserStore) UpdateMfaActive(userID string, active bool) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.PermanentDelete(userID)
+		err := s.UserStore.UpdateMfaActive(userID, active)
 		if err == nil {
 			return nil
 		}
@@ -13438,11 +16379,11 @@ func (s *RetryLayerUserStore) PermanentDelete(userID string) error {
 
 }
 
-func (s *RetryLayerUserStore) PromoteGuestToUser(userID string) error {
+func (s *RetryLayerUserStore) UpdateMfaSecret(userID string, secret string) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.PromoteGuestToUser(userID)
+		err := s.UserStore.UpdateMfaSecret(userID, secret)
 		if err == nil {
 			return nil
 		}
@@ -13459,32 +16400,32 @@ func (s *RetryLayerUserStore) PromoteGuestToUser(userID string) error {
 
 }
 
-func (s *RetryLayerUserStore) ResetAuthDataToEmailForUsers(service string, userIDs []string, includeDeleted bool, dryRun bool) (int, error) {
+func (s *RetryLayerUserStore) UpdateNotifyProps(userID string, props map[string]string) error {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.ResetAuthDataToEmailForUsers(service, userIDs, includeDeleted, dryRun)
+		err := s.UserStore.UpdateNotifyProps(userID, props)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) ResetLastPictureUpdate(userID string) error {
+func (s *RetryLayerUserStore) UpdatePassword(userID string, newPassword string) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.ResetLastPictureUpdate(userID)
+		err := s.UserStore.UpdatePassword(userID, newPassword)
 		if err == nil {
 			return nil
 		}
@@ -13501,11 +16442,11 @@ func (s *RetryLayerUserStore) ResetLastPictureUpdate(userID string) error {
 
 }
 
-func (s *RetryLayerUserStore) Save(user *model.User) (*model.User, error) {
+func (s *RetryLayerUserStore) UpdateUpdateAt(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.Save(user)
+		result, err := s.UserStore.UpdateUpdateAt(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -13522,11 +16463,11 @@ func (s *RetryLayerUserStore) Save(user *model.User) (*model.User, error) {
 
 }
 
-func (s *RetryLayerUserStore) Search(teamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *RetryLayerUserStore) VerifyEmail(userID string, email string) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.Search(teamID, term, options)
+		result, err := s.UserStore.VerifyEmail(userID, email)
 		if err == nil {
 			return result, nil
 		}
@@ -13543,53 +16484,53 @@ func (s *RetryLayerUserStore) Search(teamID string, term string, options *model.
 
 }
 
-func (s *RetryLayerUserStore) SearchInChannel(channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *RetryLayerUserAccessTokenStore) Delete(tokenID string) error {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchInChannel(channelID, term, options)
+		err := s.UserAccessTokenStore.Delete(tokenID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) SearchInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *RetryLayerUserAccessTokenStore) DeleteAllForUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchInGroup(groupID, term, options)
+		err := s.UserAccessTokenStore.DeleteAllForUser(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) SearchNotInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *RetryLayerUserAccessTokenStore) Get(tokenID string) (*model.UserAccessToken, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchNotInChannel(teamID, channelID, term, options)
+		result, err := s.UserAccessTokenStore.Get(tokenID)
 		if err == nil {
 			return result, nil
 		}
@@ -13606,11 +16547,11 @@ func (s *RetryLayerUserStore) SearchNotInChannel(teamID string, channelID string
 
 }
 
-func (s *RetryLayerUserStore) SearchNotInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *RetryLayerUserAccessTokenStore) GetAll(offset int, limit int) ([]*model.UserAccessToken, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchNotInGroup(groupID, term, options)
+		result, err := s.UserAccessTokenStore.GetAll(offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -13627,11 +16568,11 @@ func (s *RetryLayerUserStore) SearchNotInGroup(groupID string, term string, opti
 
 }
 
-func (s *RetryLayerUserStore) SearchNotInTeam(notInTeamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *RetryLayerUserAccessTokenStore) GetByToken(tokenString string) (*model.UserAccessToken, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchNotInTeam(notInTeamID, term, options)
+		result, err := s.UserAccessTokenStore.GetByToken(tokenString)
 		if err == nil {
 			return result, nil
 		}
@@ -13648,11 +16589,11 @@ func (s *RetryLayerUserStore) SearchNotInTeam(notInTeamID string, term string, o
 
 }
 
-func (s *RetryLayerUserStore) SearchWithoutTeam(term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *RetryLayerUserAccessTokenStore) GetByUser(userID string, page int, perPage int) ([]*model.UserAccessToken, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchWithoutTeam(term, options)
+		result, err := s.UserAccessTokenStore.GetByUser(userID, page, perPage)
 		if err == nil {
 			return result, nil
 		}
@@ -13669,11 +16610,11 @@ func (s *RetryLayerUserStore) SearchWithoutTeam(term string, options *model.User
 
 }
 
-func (s *RetryLayerUserStore) Update(user *model.User, allowRoleUpdate bool) (*model.UserUpdate, error) {
+func (s *RetryLayerUserAccessTokenStore) Save(token *model.UserAccessToken) (*model.UserAccessToken, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.Update(user, allowRoleUpdate)
+		result, err := s.UserAccessTokenStore.Save(token)
 		if err == nil {
 			return result, nil
 		}
@@ -13690,11 +16631,11 @@ func (s *RetryLayerUserStore) Update(user *model.User, allowRoleUpdate bool) (*m
 
 }
 
-func (s *RetryLayerUserStore) UpdateAuthData(userID string, service string, authData *string, email string, resetMfa bool) (string, error) {
+func (s *RetryLayerUserAccessTokenStore) Search(term string) ([]*model.UserAccessToken, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.UpdateAuthData(userID, service, authData, email, resetMfa)
+		result, err := s.UserAccessTokenStore.Search(term)
 		if err == nil {
 			return result, nil
 		}
@@ -13711,11 +16652,11 @@ func (s *RetryLayerUserStore) UpdateAuthData(userID string, service string, auth
 
 }
 
-func (s *RetryLayerUserStore) UpdateFailedPasswordAttempts(userID string, attempts int) error {
+func (s *RetryLayerUserAccessTokenStore) UpdateTokenDisable(tokenID string) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdateFailedPasswordAttempts(userID, attempts)
+		err := s.UserAccessTokenStore.UpdateTokenDisable(tokenID)
 		if err == nil {
 			return nil
 		}
@@ -13732,11 +16673,11 @@ func (s *RetryLayerUserStore) UpdateFailedPasswordAttempts(userID string, attemp
 
 }
 
-func (s *RetryLayerUserStore) UpdateLastPictureUpdate(userID string) error {
+func (s *RetryLayerUserAccessTokenStore) UpdateTokenEnable(tokenID string) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdateLastPictureUpdate(userID)
+		err := s.UserAccessTokenStore.UpdateTokenEnable(tokenID)
 		if err == nil {
 			return nil
 		}
@@ -13753,11 +16694,11 @@ func (s *RetryLayerUserStore) UpdateLastPictureUpdate(userID string) error {
 
 }
 
-func (s *RetryLayerUserStore) UpdateMfaActive(userID string, active bool) error {
+func (s *RetryLayerUserTermsOfServiceStore) Delete(userID string, termsOfServiceId string) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdateMfaActive(userID, active)
+		err := s.UserTermsOfServiceStore.Delete(userID, termsOfServiceId)
 		if err == nil {
 			return nil
 		}
@@ -13774,53 +16715,53 @@ func (s *RetryLayerUserStore) UpdateMfaActive(userID string, active bool) error
 
 }
 
-func (s *RetryLayerUserStore) UpdateMfaSecret(userID string, secret string) error {
+func (s *RetryLayerUserTermsOfServiceStore) GetByUser(userID string) (*model.UserTermsOfService, error) {
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdateMfaSecret(userID, secret)
+		result, err := s.UserTermsOfServiceStore.GetByUser(userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) UpdateNotifyProps(userID string, props map[string]string) error {
+func (s *RetryLayerUserTermsOfServiceStore) Save(userTermsOfService *model.UserTermsOfService) (*model.UserTermsOfService, error) {
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdateNotifyProps(userID, props)
+		result, err := s.UserTermsOfServiceStore.Save(userTermsOfService)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserStore) UpdatePassword(userID string, newPassword string) error {
+func (s *RetryLayerWebAuthnCredentialStore) Delete(id string) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdatePassword(userID, newPassword)
+		err := s.WebAuthnCredentialStore.Delete(id)
 		if err == nil {
 			return nil
 		}
@@ -13837,11 +16778,11 @@ func (s *RetryLayerUserStore) UpdatePassword(userID string, newPassword string)
 
 }
 
-func (s *RetryLayerUserStore) UpdateUpdateAt(userID string) (int64, error) {
+func (s *RetryLayerWebAuthnCredentialStore) Get(id string) (*model.WebAuthnCredential, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.UpdateUpdateAt(userID)
+		result, err := s.WebAuthnCredentialStore.Get(id)
 		if err == nil {
 			return result, nil
 		}
@@ -13858,11 +16799,11 @@ func (s *RetryLayerUserStore) UpdateUpdateAt(userID string) (int64, error) {
 
 }
 
-func (s *RetryLayerUserStore) VerifyEmail(userID string, email string) (string, error) {
+func (s *RetryLayerWebAuthnCredentialStore) GetByCredentialId(credentialID string) (*model.WebAuthnCredential, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.VerifyEmail(userID, email)
+		result, err := s.WebAuthnCredentialStore.GetByCredentialId(credentialID)
 		if err == nil {
 			return result, nil
 		}
@@ -13879,32 +16820,32 @@ func (s *RetryLayerUserStore) VerifyEmail(userID string, email string) (string,
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) Delete(tokenID string) error {
+func (s *RetryLayerWebAuthnCredentialStore) GetForUser(userID string) ([]*model.WebAuthnCredential, error) {
 
 	tries := 0
 	for {
-		err := s.UserAccessTokenStore.Delete(tokenID)
+		result, err := s.WebAuthnCredentialStore.GetForUser(userID)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) DeleteAllForUser(userID string) error {
+func (s *RetryLayerWebAuthnCredentialStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		err := s.UserAccessTokenStore.DeleteAllForUser(userID)
+		err := s.WebAuthnCredentialStore.PermanentDeleteByUser(userID)
 		if err == nil {
 			return nil
 		}
@@ -13921,11 +16862,11 @@ func (s *RetryLayerUserAccessTokenStore) DeleteAllForUser(userID string) error {
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) Get(tokenID string) (*model.UserAccessToken, error) {
+func (s *RetryLayerWebAuthnCredentialStore) Save(credential *model.WebAuthnCredential) (*model.WebAuthnCredential, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.Get(tokenID)
+		result, err := s.WebAuthnCredentialStore.Save(credential)
 		if err == nil {
 			return result, nil
 		}
@@ -13942,53 +16883,53 @@ func (s *RetryLayerUserAccessTokenStore) Get(tokenID string) (*model.UserAccessT
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) GetAll(offset int, limit int) ([]*model.UserAccessToken, error) {
+func (s *RetryLayerWebAuthnCredentialStore) UpdateName(id string, name string) error {
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.GetAll(offset, limit)
+		err := s.WebAuthnCredentialStore.UpdateName(id, name)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) GetByToken(tokenString string) (*model.UserAccessToken, error) {
+func (s *RetryLayerWebAuthnCredentialStore) UpdateSignCount(id string, signCount int64, lastUsedAt int64) error {
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.GetByToken(tokenString)
+		err := s.WebAuthnCredentialStore.UpdateSignCount(id, signCount, lastUsedAt)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) GetByUser(userID string, page int, perPage int) ([]*model.UserAccessToken, error) {
+func (s *RetryLayerWebhookStore) AnalyticsIncomingCount(teamID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.GetByUser(userID, page, perPage)
+		result, err := s.WebhookStore.AnalyticsIncomingCount(teamID)
 		if err == nil {
 			return result, nil
 		}
@@ -14005,11 +16946,11 @@ func (s *RetryLayerUserAccessTokenStore) GetByUser(userID string, page int, perP
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) Save(token *model.UserAccessToken) (*model.UserAccessToken, error) {
+func (s *RetryLayerWebhookStore) AnalyticsOutgoingCount(teamID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.Save(token)
+		result, err := s.WebhookStore.AnalyticsOutgoingCount(teamID)
 		if err == nil {
 			return result, nil
 		}
@@ -14026,32 +16967,17 @@ func (s *RetryLayerUserAccessTokenStore) Save(token *model.UserAccessToken) (*mo
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) Search(term string) ([]*model.UserAccessToken, error) {
+func (s *RetryLayerWebhookStore) ClearCaches() {
 
-	tries := 0
-	for {
-		result, err := s.UserAccessTokenStore.Search(term)
-		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
-		}
-		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
-		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
-	}
+	s.WebhookStore.ClearCaches()
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) UpdateTokenDisable(tokenID string) error {
+func (s *RetryLayerWebhookStore) DeleteDirectOutgoing(webhookID string, timestamp int64) error {
 
 	tries := 0
 	for {
-		err := s.UserAccessTokenStore.UpdateTokenDisable(tokenID)
+		err := s.WebhookStore.DeleteDirectOutgoing(webhookID, timestamp)
 		if err == nil {
 			return nil
 		}
@@ -14068,11 +16994,11 @@ func (s *RetryLayerUserAccessTokenStore) UpdateTokenDisable(tokenID string) erro
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) UpdateTokenEnable(tokenID string) error {
+func (s *RetryLayerWebhookStore) DeleteIncoming(webhookID string, timestamp int64) error {
 
 	tries := 0
 	for {
-		err := s.UserAccessTokenStore.UpdateTokenEnable(tokenID)
+		err := s.WebhookStore.DeleteIncoming(webhookID, timestamp)
 		if err == nil {
 			return nil
 		}
@@ -14089,11 +17015,11 @@ func (s *RetryLayerUserAccessTokenStore) UpdateTokenEnable(tokenID string) error
 
 }
 
-func (s *RetryLayerUserTermsOfServiceStore) Delete(userID string, termsOfServiceId string) error {
+func (s *RetryLayerWebhookStore) DeleteOutgoing(webhookID string, timestamp int64) error {
 
 	tries := 0
 	for {
-		err := s.UserTermsOfServiceStore.Delete(userID, termsOfServiceId)
+		err := s.WebhookStore.DeleteOutgoing(webhookID, timestamp)
 		if err == nil {
 			return nil
 		}
@@ -14110,32 +17036,11 @@ func (s *RetryLayerUserTermsOfServiceStore) Delete(userID string, termsOfService
 
 }
 
-func (s *RetryLayerUserTermsOfServiceStore) GetByUser(userID string) (*model.UserTermsOfService, error) {
-
-	tries := 0
-	for {
-		result, err := s.UserTermsOfServiceStore.GetByUser(userID)
-		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
-		}
-		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
-		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
-	}
-
-}
-
-func (s *RetryLayerUserTermsOfServiceStore) Save(userTermsOfService *model.UserTermsOfService) (*model.UserTermsOfService, error) {
+func (s *RetryLayerWebhookStore) GetDirectOutgoing(id string) (*model.DirectOutgoingWebhook, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserTermsOfServiceStore.Save(userTermsOfService)
+		result, err := s.WebhookStore.GetDirectOutgoing(id)
 		if err == nil {
 			return result, nil
 		}
@@ -14152,11 +17057,11 @@ func (s *RetryLayerUserTermsOfServiceStore) Save(userTermsOfService *model.UserT
 
 }
 
-func (s *RetryLayerWebhookStore) AnalyticsIncomingCount(teamID string) (int64, error) {
+func (s *RetryLayerWebhookStore) GetDirectOutgoingByBotUsers(botUserIDs []string) ([]*model.DirectOutgoingWebhook, error) {
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.AnalyticsIncomingCount(teamID)
+		result, err := s.WebhookStore.GetDirectOutgoingByBotUsers(botUserIDs)
 		if err == nil {
 			return result, nil
 		}
@@ -14173,11 +17078,11 @@ func (s *RetryLayerWebhookStore) AnalyticsIncomingCount(teamID string) (int64, e
 
 }
 
-func (s *RetryLayerWebhookStore) AnalyticsOutgoingCount(teamID string) (int64, error) {
+func (s *RetryLayerWebhookStore) GetDirectOutgoingList(offset int, limit int) ([]*model.DirectOutgoingWebhook, error) {
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.AnalyticsOutgoingCount(teamID)
+		result, err := s.WebhookStore.GetDirectOutgoingList(offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -14194,54 +17099,6 @@ func (s *RetryLayerWebhookStore) AnalyticsOutgoingCount(teamID string) (int64, e
 
 }
 
-func (s *RetryLayerWebhookStore) ClearCaches() {
-
-	s.WebhookStore.ClearCaches()
-
-}
-
-func (s *RetryLayerWebhookStore) DeleteIncoming(webhookID string, timestamp int64) error {
-
-	tries := 0
-	for {
-		err := s.WebhookStore.DeleteIncoming(webhookID, timestamp)
-		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
-		}
-		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
-		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
-	}
-
-}
-
-func (s *RetryLayerWebhookStore) DeleteOutgoing(webhookID string, timestamp int64) error {
-
-	tries := 0
-	for {
-		err := s.WebhookStore.DeleteOutgoing(webhookID, timestamp)
-		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
-		}
-		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
-		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
-	}
-
-}
-
 func (s *RetryLayerWebhookStore) GetIncoming(id string, allowFromCache bool) (*model.IncomingWebhook, error) {
 
 	tries := 0
@@ -14605,6 +17462,27 @@ func (s *RetryLayerWebhookStore) PermanentDeleteOutgoingByUser(userID string) er
 
 }
 
+func (s *RetryLayerWebhookStore) SaveDirectOutgoing(webhook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebhookStore.SaveDirectOutgoing(webhook)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerWebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 
 	tries := 0
@@ -14647,6 +17525,27 @@ func (s *RetryLayerWebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*
 
 }
 
+func (s *RetryLayerWebhookStore) UpdateDirectOutgoing(hook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebhookStore.UpdateDirectOutgoing(hook)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerWebhookStore) UpdateIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 
 	tries := 0
@@ -14668,6 +17567,27 @@ func (s *RetryLayerWebhookStore) UpdateIncoming(webhook *model.IncomingWebhook)
 
 }
 
+func (s *RetryLayerWebhookStore) UpdateIncomingUser(hookID string, userID string) error {
+
+	tries := 0
+	for {
+		err := s.WebhookStore.UpdateIncomingUser(hookID, userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerWebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, error) {
 
 	tries := 0
@@ -14730,39 +17650,57 @@ func New(childStore store.Store) *RetryLayer {
 		Store: childStore,
 	}
 
+	newStore.ActivityStore = &RetryLayerActivityStore{ActivityStore: childStore.Activity(), Root: &newStore}
+	newStore.ArchivedPostStore = &RetryLayerArchivedPostStore{ArchivedPostStore: childStore.ArchivedPost(), Root: &newStore}
 	newStore.AuditStore = &RetryLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
 	newStore.BotStore = &RetryLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
 	newStore.ChannelStore = &RetryLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
+	newStore.ChannelBookmarkStore = &RetryLayerChannelBookmarkStore{ChannelBookmarkStore: childStore.ChannelBookmark(), Root: &newStore}
+	newStore.ChannelJoinRequestStore = &RetryLayerChannelJoinRequestStore{ChannelJoinRequestStore: childStore.ChannelJoinRequest(), Root: &newStore}
+	newStore.ChannelMemberExpiryStore = &RetryLayerChannelMemberExpiryStore{ChannelMemberExpiryStore: childStore.ChannelMemberExpiry(), Root: &newStore}
 	newStore.ChannelMemberHistoryStore = &RetryLayerChannelMemberHistoryStore{ChannelMemberHistoryStore: childStore.ChannelMemberHistory(), Root: &newStore}
 	newStore.ClusterDiscoveryStore = &RetryLayerClusterDiscoveryStore{ClusterDiscoveryStore: childStore.ClusterDiscovery(), Root: &newStore}
 	newStore.CommandStore = &RetryLayerCommandStore{CommandStore: childStore.Command(), Root: &newStore}
 	newStore.CommandWebhookStore = &RetryLayerCommandWebhookStore{CommandWebhookStore: childStore.CommandWebhook(), Root: &newStore}
 	newStore.ComplianceStore = &RetryLayerComplianceStore{ComplianceStore: childStore.Compliance(), Root: &newStore}
+	newStore.ConfigChangeStore = &RetryLayerConfigChangeStore{ConfigChangeStore: childStore.ConfigChange(), Root: &newStore}
 	newStore.DraftStore = &RetryLayerDraftStore{DraftStore: childStore.Draft(), Root: &newStore}
 	newStore.EmojiStore = &RetryLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
 	newStore.FileInfoStore = &RetryLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
 	newStore.GroupStore = &RetryLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.GuestSponsorshipStore = &RetryLayerGuestSponsorshipStore{GuestSponsorshipStore: childStore.GuestSponsorship(), Root: &newStore}
 	newStore.JobStore = &RetryLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
 	newStore.LicenseStore = &RetryLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
 	newStore.LinkMetadataStore = &RetryLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
+	newStore.MatrixBridgeStore = &RetryLayerMatrixBridgeStore{MatrixBridgeStore: childStore.MatrixBridge(), Root: &newStore}
 	newStore.NotifyAdminStore = &RetryLayerNotifyAdminStore{NotifyAdminStore: childStore.NotifyAdmin(), Root: &newStore}
 	newStore.OAuthStore = &RetryLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
 	newStore.PluginStore = &RetryLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
 	newStore.PostStore = &RetryLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
 	newStore.PostAcknowledgementStore = &RetryLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
+	newStore.PostEmbeddingStore = &RetryLayerPostEmbeddingStore{PostEmbeddingStore: childStore.PostEmbedding(), Root: &newStore}
+	newStore.PostExpirationStore = &RetryLayerPostExpirationStore{PostExpirationStore: childStore.PostExpiration(), Root: &newStore}
 	newStore.PostPriorityStore = &RetryLayerPostPriorityStore{PostPriorityStore: childStore.PostPriority(), Root: &newStore}
+	newStore.PostTranslationStore = &RetryLayerPostTranslationStore{PostTranslationStore: childStore.PostTranslation(), Root: &newStore}
 	newStore.PreferenceStore = &RetryLayerPreferenceStore{PreferenceStore: childStore.Preference(), Root: &newStore}
 	newStore.ProductNoticesStore = &RetryLayerProductNoticesStore{ProductNoticesStore: childStore.ProductNotices(), Root: &newStore}
 	newStore.ReactionStore = &RetryLayerReactionStore{ReactionStore: childStore.Reaction(), Root: &newStore}
+	newStore.ReactionSummaryStore = &RetryLayerReactionSummaryStore{ReactionSummaryStore: childStore.ReactionSummary(), Root: &newStore}
+	newStore.ReadReceiptStore = &RetryLayerReadReceiptStore{ReadReceiptStore: childStore.ReadReceipt(), Root: &newStore}
 	newStore.RemoteClusterStore = &RetryLayerRemoteClusterStore{RemoteClusterStore: childStore.RemoteCluster(), Root: &newStore}
 	newStore.RetentionPolicyStore = &RetryLayerRetentionPolicyStore{RetentionPolicyStore: childStore.RetentionPolicy(), Root: &newStore}
 	newStore.RoleStore = &RetryLayerRoleStore{RoleStore: childStore.Role(), Root: &newStore}
+	newStore.RoleSnapshotStore = &RetryLayerRoleSnapshotStore{RoleSnapshotStore: childStore.RoleSnapshot(), Root: &newStore}
+	newStore.ScheduledPostStore = &RetryLayerScheduledPostStore{ScheduledPostStore: childStore.ScheduledPost(), Root: &newStore}
 	newStore.SchemeStore = &RetryLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
 	newStore.SessionStore = &RetryLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
 	newStore.SharedChannelStore = &RetryLayerSharedChannelStore{SharedChannelStore: childStore.SharedChannel(), Root: &newStore}
 	newStore.StatusStore = &RetryLayerStatusStore{StatusStore: childStore.Status(), Root: &newStore}
+	newStore.SupportAccessConsentStore = &RetryLayerSupportAccessConsentStore{SupportAccessConsentStore: childStore.SupportAccessConsent(), Root: &newStore}
 	newStore.SystemStore = &RetryLayerSystemStore{SystemStore: childStore.System(), Root: &newStore}
 	newStore.TeamStore = &RetryLayerTeamStore{TeamStore: childStore.Team(), Root: &newStore}
+	newStore.TeamArchiveStore = &RetryLayerTeamArchiveStore{TeamArchiveStore: childStore.TeamArchive(), Root: &newStore}
+	newStore.TeamTemplateStore = &RetryLayerTeamTemplateStore{TeamTemplateStore: childStore.TeamTemplate(), Root: &newStore}
 	newStore.TermsOfServiceStore = &RetryLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
 	newStore.ThreadStore = &RetryLayerThreadStore{ThreadStore: childStore.Thread(), Root: &newStore}
 	newStore.TokenStore = &RetryLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
@@ -14771,6 +17709,7 @@ func New(childStore store.Store) *RetryLayer {
 	newStore.UserStore = &RetryLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &RetryLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
 	newStore.UserTermsOfServiceStore = &RetryLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
+	newStore.WebAuthnCredentialStore = &RetryLayerWebAuthnCredentialStore{WebAuthnCredentialStore: childStore.WebAuthnCredential(), Root: &newStore}
 	newStore.WebhookStore = &RetryLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
 	return &newStore
 }
@@ -58,6 +58,25 @@ func genStore() *mocks.Store {
 	mock.On("PostPriority").Return(&mocks.PostPriorityStore{})
 	mock.On("PostAcknowledgement").Return(&mocks.PostAcknowledgementStore{})
 	mock.On("TrueUpReview").Return(&mocks.TrueUpReviewStore{})
+	mock.On("ScheduledPost").Return(&mocks.ScheduledPostStore{})
+	mock.On("PostTranslation").Return(&mocks.PostTranslationStore{})
+	mock.On("PostEmbedding").Return(&mocks.PostEmbeddingStore{})
+	mock.On("Activity").Return(&mocks.ActivityStore{})
+	mock.On("ReadReceipt").Return(&mocks.ReadReceiptStore{})
+	mock.On("ReactionSummary").Return(&mocks.ReactionSummaryStore{})
+	mock.On("MatrixBridge").Return(&mocks.MatrixBridgeStore{})
+	mock.On("ChannelBookmark").Return(&mocks.ChannelBookmarkStore{})
+	mock.On("PostExpiration").Return(&mocks.PostExpirationStore{})
+	mock.On("WebAuthnCredential").Return(&mocks.WebAuthnCredentialStore{})
+	mock.On("RoleSnapshot").Return(&mocks.RoleSnapshotStore{})
+	mock.On("GuestSponsorship").Return(&mocks.GuestSponsorshipStore{})
+	mock.On("ChannelMemberExpiry").Return(&mocks.ChannelMemberExpiryStore{})
+	mock.On("ChannelJoinRequest").Return(&mocks.ChannelJoinRequestStore{})
+	mock.On("TeamTemplate").Return(&mocks.TeamTemplateStore{})
+	mock.On("TeamArchive").Return(&mocks.TeamArchiveStore{})
+	mock.On("SupportAccessConsent").Return(&mocks.SupportAccessConsentStore{})
+	mock.On("ConfigChange").Return(&mocks.ConfigChangeStore{})
+	mock.On("ArchivedPost").Return(&mocks.ArchivedPostStore{})
 	return mock
 }
 
@@ -5,6 +5,7 @@ package searchlayer
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -161,27 +162,27 @@ func (c *SearchChannelStore) SaveDirectChannel(directchannel *model.Channel, mem
 	return channel, err
 }
 
-func (c *SearchChannelStore) Autocomplete(userID, term string, includeDeleted, isGuest bool) (model.ChannelListWithTeamData, error) {
+func (c *SearchChannelStore) Autocomplete(ctx context.Context, userID, term string, includeDeleted, isGuest bool) (model.ChannelListWithTeamData, error) {
 	var channelList model.ChannelListWithTeamData
 	var err error
 
 	allFailed := true
-	for _, engine := range c.rootStore.searchEngine.GetActiveEngines() {
-		if engine.IsAutocompletionEnabled() {
-			channelList, err = c.searchAutocompleteChannelsAllTeams(engine, userID, term, includeDeleted, isGuest)
-			if err != nil {
-				mlog.Warn("Encountered error on AutocompleteChannels through SearchEngine. Falling back to default autocompletion.", mlog.String("search_engine", engine.GetName()), mlog.Err(err))
-				continue
-			}
-			allFailed = false
-			mlog.Debug("Using the first available search engine", mlog.String("search_engine", engine.GetName()))
-			break
+	for _, engine := range c.rootStore.searchEngine.GetSearchEngines(model.SearchQueryTypeChannelAutocomplete) {
+		start := time.Now()
+		channelList, err = c.searchAutocompleteChannelsAllTeams(ctx, engine, userID, term, includeDeleted, isGuest)
+		c.rootStore.searchEngine.ObserveQuery(engine, model.SearchQueryTypeChannelAutocomplete, time.Since(start), err)
+		if err != nil {
+			mlog.Warn("Encountered error on AutocompleteChannels through SearchEngine. Falling back to default autocompletion.", mlog.String("search_engine", engine.GetName()), mlog.Err(err))
+			continue
 		}
+		allFailed = false
+		mlog.Debug("Using the first available search engine", mlog.String("search_engine", engine.GetName()))
+		break
 	}
 
 	if allFailed {
 		mlog.Debug("Using database search because no other search engine is available")
-		channelList, err = c.ChannelStore.Autocomplete(userID, term, includeDeleted, isGuest)
+		channelList, err = c.ChannelStore.Autocomplete(ctx, userID, term, includeDeleted, isGuest)
 		if err != nil {
 			return nil, errors.Wrap(err, "Failed to autocomplete channels in team")
 		}
@@ -194,27 +195,27 @@ func (c *SearchChannelStore) Autocomplete(userID, term string, includeDeleted, i
 	return channelList, nil
 }
 
-func (c *SearchChannelStore) AutocompleteInTeam(teamID, userID, term string, includeDeleted, isGuest bool) (model.ChannelList, error) {
+func (c *SearchChannelStore) AutocompleteInTeam(ctx context.Context, teamID, userID, term string, includeDeleted, isGuest bool) (model.ChannelList, error) {
 	var channelList model.ChannelList
 	var err error
 
 	allFailed := true
-	for _, engine := range c.rootStore.searchEngine.GetActiveEngines() {
-		if engine.IsAutocompletionEnabled() {
-			channelList, err = c.searchAutocompleteChannels(engine, teamID, userID, term, includeDeleted, isGuest)
-			if err != nil {
-				mlog.Warn("Encountered error on AutocompleteChannels through SearchEngine. Falling back to default autocompletion.", mlog.String("search_engine", engine.GetName()), mlog.Err(err))
-				continue
-			}
-			allFailed = false
-			mlog.Debug("Using the first available search engine", mlog.String("search_engine", engine.GetName()))
-			break
+	for _, engine := range c.rootStore.searchEngine.GetSearchEngines(model.SearchQueryTypeChannelAutocomplete) {
+		start := time.Now()
+		channelList, err = c.searchAutocompleteChannels(ctx, engine, teamID, userID, term, includeDeleted, isGuest)
+		c.rootStore.searchEngine.ObserveQuery(engine, model.SearchQueryTypeChannelAutocomplete, time.Since(start), err)
+		if err != nil {
+			mlog.Warn("Encountered error on AutocompleteChannels through SearchEngine. Falling back to default autocompletion.", mlog.String("search_engine", engine.GetName()), mlog.Err(err))
+			continue
 		}
+		allFailed = false
+		mlog.Debug("Using the first available search engine", mlog.String("search_engine", engine.GetName()))
+		break
 	}
 
 	if allFailed {
 		mlog.Debug("Using database search because no other search engine is available")
-		channelList, err = c.ChannelStore.AutocompleteInTeam(teamID, userID, term, includeDeleted, isGuest)
+		channelList, err = c.ChannelStore.AutocompleteInTeam(ctx, teamID, userID, term, includeDeleted, isGuest)
 		if err != nil {
 			return nil, errors.Wrap(err, "Failed to autocomplete channels in team")
 		}
@@ -227,8 +228,8 @@ func (c *SearchChannelStore) AutocompleteInTeam(teamID, userID, term string, inc
 	return channelList, nil
 }
 
-func (c *SearchChannelStore) searchAutocompleteChannels(engine searchengine.SearchEngineInterface, teamId, userID, term string, includeDeleted, isGuest bool) (model.ChannelList, error) {
-	channelIds, err := engine.SearchChannels(teamId, userID, term, isGuest)
+func (c *SearchChannelStore) searchAutocompleteChannels(ctx context.Context, engine searchengine.SearchEngineInterface, teamId, userID, term string, includeDeleted, isGuest bool) (model.ChannelList, error) {
+	channelIds, err := engine.SearchChannels(ctx, teamId, userID, term, isGuest)
 	if err != nil {
 		return nil, err
 	}
@@ -245,8 +246,8 @@ func (c *SearchChannelStore) searchAutocompleteChannels(engine searchengine.Sear
 	return channelList, nil
 }
 
-func (c *SearchChannelStore) searchAutocompleteChannelsAllTeams(engine searchengine.SearchEngineInterface, userID, term string, includeDeleted, isGuest bool) (model.ChannelListWithTeamData, error) {
-	channelIds, err := engine.SearchChannels("", userID, term, isGuest)
+func (c *SearchChannelStore) searchAutocompleteChannelsAllTeams(ctx context.Context, engine searchengine.SearchEngineInterface, userID, term string, includeDeleted, isGuest bool) (model.ChannelListWithTeamData, error) {
+	channelIds, err := engine.SearchChannels(ctx, "", userID, term, isGuest)
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,9 @@
 package searchlayer
 
 import (
+	"context"
+	"time"
+
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/channels/store"
 	"github.com/mattermost/mattermost-server/v6/server/platform/services/searchengine"
@@ -155,41 +158,42 @@ func (s SearchFileInfoStore) PermanentDeleteByUser(userId string) (int64, error)
 	return result, err
 }
 
-func (s SearchFileInfoStore) Search(paramsList []*model.SearchParams, userId, teamId string, page, perPage int) (*model.FileInfoList, error) {
-	for _, engine := range s.rootStore.searchEngine.GetActiveEngines() {
-		if engine.IsSearchEnabled() {
-			userChannels, nErr := s.rootStore.Channel().GetChannels(teamId, userId, &model.ChannelSearchOpts{
-				IncludeDeleted: paramsList[0].IncludeDeletedChannels,
-				LastDeleteAt:   0,
-			})
+func (s SearchFileInfoStore) Search(ctx context.Context, paramsList []*model.SearchParams, userId, teamId string, page, perPage int) (*model.FileInfoList, error) {
+	for _, engine := range s.rootStore.searchEngine.GetSearchEngines(model.SearchQueryTypeFileSearch) {
+		userChannels, nErr := s.rootStore.Channel().GetChannels(teamId, userId, &model.ChannelSearchOpts{
+			IncludeDeleted: paramsList[0].IncludeDeletedChannels,
+			LastDeleteAt:   0,
+		})
+		if nErr != nil {
+			return nil, nErr
+		}
+		start := time.Now()
+		fileIds, appErr := engine.SearchFiles(ctx, userChannels, paramsList, page, perPage)
+		if appErr != nil {
+			s.rootStore.searchEngine.ObserveQuery(engine, model.SearchQueryTypeFileSearch, time.Since(start), appErr)
+			mlog.Error("Encountered error on Search.", mlog.String("search_engine", engine.GetName()), mlog.Err(appErr))
+			continue
+		}
+		s.rootStore.searchEngine.ObserveQuery(engine, model.SearchQueryTypeFileSearch, time.Since(start), nil)
+
+		// Get the files
+		filesList := model.NewFileInfoList()
+		if len(fileIds) > 0 {
+			files, nErr := s.FileInfoStore.GetByIds(fileIds)
 			if nErr != nil {
 				return nil, nErr
 			}
-			fileIds, appErr := engine.SearchFiles(userChannels, paramsList, page, perPage)
-			if appErr != nil {
-				mlog.Error("Encountered error on Search.", mlog.String("search_engine", engine.GetName()), mlog.Err(appErr))
-				continue
-			}
-
-			// Get the files
-			filesList := model.NewFileInfoList()
-			if len(fileIds) > 0 {
-				files, nErr := s.FileInfoStore.GetByIds(fileIds)
-				if nErr != nil {
-					return nil, nErr
-				}
-				for _, f := range files {
-					filesList.AddFileInfo(f)
-					filesList.AddOrder(f.Id)
-				}
+			for _, f := range files {
+				filesList.AddFileInfo(f)
+				filesList.AddOrder(f.Id)
 			}
-			return filesList, nil
 		}
+		return filesList, nil
 	}
 
 	if *s.rootStore.getConfig().SqlSettings.DisableDatabaseSearch {
 		return model.NewFileInfoList(), nil
 	}
 
-	return s.FileInfoStore.Search(paramsList, userId, teamId, page, perPage)
+	return s.FileInfoStore.Search(ctx, paramsList, userId, teamId, page, perPage)
 }
@@ -5,6 +5,7 @@ package searchlayer
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -20,6 +21,11 @@ type SearchPostStore struct {
 }
 
 func (s SearchPostStore) indexPost(post *model.Post) {
+	// encrypted posts can't be searched
+	if post.IsEncrypted() {
+		return
+	}
+
 	for _, engine := range s.rootStore.searchEngine.GetActiveEngines() {
 		if engine.IsIndexingEnabled() {
 			runIndexFn(engine, func(engineCopy searchengine.SearchEngineInterface) {
@@ -137,7 +143,7 @@ func (s SearchPostStore) PermanentDeleteByChannel(channelID string) error {
 	return err
 }
 
-func (s SearchPostStore) searchPostsForUserByEngine(engine searchengine.SearchEngineInterface, paramsList []*model.SearchParams, userId, teamId string, page, perPage int) (*model.PostSearchResults, error) {
+func (s SearchPostStore) searchPostsForUserByEngine(ctx context.Context, engine searchengine.SearchEngineInterface, paramsList []*model.SearchParams, userId, teamId string, page, perPage int) (*model.PostSearchResults, error) {
 	if err := model.IsSearchParamsListValid(paramsList); err != nil {
 		return nil, err
 	}
@@ -152,7 +158,7 @@ func (s SearchPostStore) searchPostsForUserByEngine(engine searchengine.SearchEn
 		return nil, errors.Wrap(err2, "error getting channel for user")
 	}
 
-	postIds, matches, err := engine.SearchPosts(userChannels, paramsList, page, perPage)
+	postIds, matches, err := engine.SearchPosts(ctx, userChannels, paramsList, page, perPage)
 	if err != nil {
 		return nil, err
 	}
@@ -175,21 +181,21 @@ func (s SearchPostStore) searchPostsForUserByEngine(engine searchengine.SearchEn
 	return model.MakePostSearchResults(postList, matches), nil
 }
 
-func (s SearchPostStore) SearchPostsForUser(paramsList []*model.SearchParams, userId, teamId string, page, perPage int) (*model.PostSearchResults, error) {
-	for _, engine := range s.rootStore.searchEngine.GetActiveEngines() {
-		if engine.IsSearchEnabled() {
-			results, err := s.searchPostsForUserByEngine(engine, paramsList, userId, teamId, page, perPage)
-			if err != nil {
-				mlog.Warn("Encountered error on SearchPostsInTeamForUser.", mlog.String("search_engine", engine.GetName()), mlog.Err(err))
-				continue
-			}
-			return results, err
+func (s SearchPostStore) SearchPostsForUser(ctx context.Context, paramsList []*model.SearchParams, userId, teamId string, page, perPage int) (*model.PostSearchResults, error) {
+	for _, engine := range s.rootStore.searchEngine.GetSearchEngines(model.SearchQueryTypePostSearch) {
+		start := time.Now()
+		results, err := s.searchPostsForUserByEngine(ctx, engine, paramsList, userId, teamId, page, perPage)
+		s.rootStore.searchEngine.ObserveQuery(engine, model.SearchQueryTypePostSearch, time.Since(start), err)
+		if err != nil {
+			mlog.Warn("Encountered error on SearchPostsInTeamForUser.", mlog.String("search_engine", engine.GetName()), mlog.Err(err))
+			continue
 		}
+		return results, err
 	}
 
 	if *s.rootStore.getConfig().SqlSettings.DisableDatabaseSearch {
 		return &model.PostSearchResults{PostList: model.NewPostList(), Matches: model.PostSearchMatches{}}, nil
 	}
 
-	return s.PostStore.SearchPostsForUser(paramsList, userId, teamId, page, perPage)
+	return s.PostStore.SearchPostsForUser(ctx, paramsList, userId, teamId, page, perPage)
 }
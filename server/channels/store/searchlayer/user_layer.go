@@ -6,6 +6,7 @@ package searchlayer
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -34,41 +35,42 @@ func (s *SearchUserStore) deleteUserIndex(user *model.User) {
 	}
 }
 
-func (s *SearchUserStore) Search(teamId, term string, options *model.UserSearchOptions) ([]*model.User, error) {
-	for _, engine := range s.rootStore.searchEngine.GetActiveEngines() {
-		if engine.IsSearchEnabled() {
-			listOfAllowedChannels, nErr := s.getListOfAllowedChannels(teamId, "", options.ViewRestrictions)
-			if nErr != nil {
-				mlog.Warn("Encountered error on Search.", mlog.String("search_engine", engine.GetName()), mlog.Err(nErr))
-				continue
-			}
-
-			if listOfAllowedChannels != nil && len(listOfAllowedChannels) == 0 {
-				return []*model.User{}, nil
-			}
+func (s *SearchUserStore) Search(ctx context.Context, teamId, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+	for _, engine := range s.rootStore.searchEngine.GetSearchEngines(model.SearchQueryTypeUserSearch) {
+		listOfAllowedChannels, nErr := s.getListOfAllowedChannels(teamId, "", options.ViewRestrictions)
+		if nErr != nil {
+			mlog.Warn("Encountered error on Search.", mlog.String("search_engine", engine.GetName()), mlog.Err(nErr))
+			continue
+		}
 
-			sanitizedTerm := sanitizeSearchTerm(term)
+		if listOfAllowedChannels != nil && len(listOfAllowedChannels) == 0 {
+			return []*model.User{}, nil
+		}
 
-			usersIds, err := engine.SearchUsersInTeam(teamId, listOfAllowedChannels, sanitizedTerm, options)
-			if err != nil {
-				mlog.Warn("Encountered error on Search", mlog.String("search_engine", engine.GetName()), mlog.Err(err))
-				continue
-			}
+		sanitizedTerm := sanitizeSearchTerm(term)
 
-			users, nErr := s.UserStore.GetProfileByIds(context.Background(), usersIds, nil, false)
-			if nErr != nil {
-				mlog.Warn("Encountered error on Search", mlog.String("search_engine", engine.GetName()), mlog.Err(nErr))
-				continue
-			}
+		start := time.Now()
+		usersIds, err := engine.SearchUsersInTeam(ctx, teamId, listOfAllowedChannels, sanitizedTerm, options)
+		if err != nil {
+			s.rootStore.searchEngine.ObserveQuery(engine, model.SearchQueryTypeUserSearch, time.Since(start), err)
+			mlog.Warn("Encountered error on Search", mlog.String("search_engine", engine.GetName()), mlog.Err(err))
+			continue
+		}
+		s.rootStore.searchEngine.ObserveQuery(engine, model.SearchQueryTypeUserSearch, time.Since(start), nil)
 
-			mlog.Debug("Using the first available search engine", mlog.String("search_engine", engine.GetName()))
-			return users, nil
+		users, nErr := s.UserStore.GetProfileByIds(context.Background(), usersIds, nil, false)
+		if nErr != nil {
+			mlog.Warn("Encountered error on Search", mlog.String("search_engine", engine.GetName()), mlog.Err(nErr))
+			continue
 		}
+
+		mlog.Debug("Using the first available search engine", mlog.String("search_engine", engine.GetName()))
+		return users, nil
 	}
 
 	mlog.Debug("Using database search because no other search engine is available")
 
-	return s.UserStore.Search(teamId, term, options)
+	return s.UserStore.Search(ctx, teamId, term, options)
 }
 
 func (s *SearchUserStore) Update(user *model.User, trustedUpdateData bool) (*model.UserUpdate, error) {
@@ -101,15 +103,15 @@ func (s *SearchUserStore) PermanentDelete(userId string) error {
 	return err
 }
 
-func (s *SearchUserStore) autocompleteUsersInChannelByEngine(engine searchengine.SearchEngineInterface, teamId, channelId, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
+func (s *SearchUserStore) autocompleteUsersInChannelByEngine(ctx context.Context, engine searchengine.SearchEngineInterface, teamId, channelId, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
 	var err *model.AppError
 	uchanIds := []string{}
 	nuchanIds := []string{}
 	sanitizedTerm := sanitizeSearchTerm(term)
 	if channelId != "" && options.ListOfAllowedChannels != nil && !strings.Contains(strings.Join(options.ListOfAllowedChannels, "."), channelId) {
-		nuchanIds, err = engine.SearchUsersInTeam(teamId, options.ListOfAllowedChannels, sanitizedTerm, options)
+		nuchanIds, err = engine.SearchUsersInTeam(ctx, teamId, options.ListOfAllowedChannels, sanitizedTerm, options)
 	} else {
-		uchanIds, nuchanIds, err = engine.SearchUsersInChannel(teamId, channelId, options.ListOfAllowedChannels, sanitizedTerm, options)
+		uchanIds, nuchanIds, err = engine.SearchUsersInChannel(ctx, teamId, channelId, options.ListOfAllowedChannels, sanitizedTerm, options)
 	}
 	if err != nil {
 		return nil, err
@@ -208,29 +210,29 @@ func (s *SearchUserStore) getListOfAllowedChannels(teamId, channelId string, vie
 	return []string{}, nil
 }
 
-func (s *SearchUserStore) AutocompleteUsersInChannel(teamId, channelId, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
-	for _, engine := range s.rootStore.searchEngine.GetActiveEngines() {
-		if engine.IsAutocompletionEnabled() {
-			listOfAllowedChannels, nErr := s.getListOfAllowedChannels(teamId, channelId, options.ViewRestrictions)
-			if nErr != nil {
-				mlog.Warn("Encountered error on AutocompleteUsersInChannel.", mlog.String("search_engine", engine.GetName()), mlog.Err(nErr))
-				continue
-			}
-			if listOfAllowedChannels != nil && len(listOfAllowedChannels) == 0 {
-				return &model.UserAutocompleteInChannel{}, nil
-			}
-			options.ListOfAllowedChannels = listOfAllowedChannels
-
-			autocomplete, nErr := s.autocompleteUsersInChannelByEngine(engine, teamId, channelId, term, options)
-			if nErr != nil {
-				mlog.Warn("Encountered error on AutocompleteUsersInChannel.", mlog.String("search_engine", engine.GetName()), mlog.Err(nErr))
-				continue
-			}
-			mlog.Debug("Using the first available search engine", mlog.String("search_engine", engine.GetName()))
-			return autocomplete, nil
+func (s *SearchUserStore) AutocompleteUsersInChannel(ctx context.Context, teamId, channelId, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
+	for _, engine := range s.rootStore.searchEngine.GetSearchEngines(model.SearchQueryTypeUserAutocomplete) {
+		listOfAllowedChannels, nErr := s.getListOfAllowedChannels(teamId, channelId, options.ViewRestrictions)
+		if nErr != nil {
+			mlog.Warn("Encountered error on AutocompleteUsersInChannel.", mlog.String("search_engine", engine.GetName()), mlog.Err(nErr))
+			continue
+		}
+		if listOfAllowedChannels != nil && len(listOfAllowedChannels) == 0 {
+			return &model.UserAutocompleteInChannel{}, nil
+		}
+		options.ListOfAllowedChannels = listOfAllowedChannels
+
+		start := time.Now()
+		autocomplete, nErr := s.autocompleteUsersInChannelByEngine(ctx, engine, teamId, channelId, term, options)
+		s.rootStore.searchEngine.ObserveQuery(engine, model.SearchQueryTypeUserAutocomplete, time.Since(start), nErr)
+		if nErr != nil {
+			mlog.Warn("Encountered error on AutocompleteUsersInChannel.", mlog.String("search_engine", engine.GetName()), mlog.Err(nErr))
+			continue
 		}
+		mlog.Debug("Using the first available search engine", mlog.String("search_engine", engine.GetName()))
+		return autocomplete, nil
 	}
 
 	mlog.Debug("Using database search because no other search engine is available")
-	return s.UserStore.AutocompleteUsersInChannel(teamId, channelId, term, options)
+	return s.UserStore.AutocompleteUsersInChannel(ctx, teamId, channelId, term, options)
 }
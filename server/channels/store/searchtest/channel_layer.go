@@ -4,6 +4,7 @@
 package searchtest
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -94,11 +95,11 @@ func testAutocompleteChannelByName(t *testing.T, th *SearchTestHelper) {
 	require.NoError(t, err)
 	defer th.deleteChannel(private)
 
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "channel-a", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "channel-a", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, alternate.Id, private.Id}, res)
 
-	res2, err := th.Store.Channel().Autocomplete(th.User.Id, "channel-a", false, false)
+	res2, err := th.Store.Channel().Autocomplete(context.Background(), th.User.Id, "channel-a", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatchWithTeamData(t, []string{th.ChannelBasic.Id, alternate.Id, private.Id, th.ChannelAnotherTeam.Id}, res2)
 }
@@ -107,7 +108,7 @@ func testAutocompleteChannelByNamePostgres(t *testing.T, th *SearchTestHelper) {
 	alternate, err := th.createChannel(th.Team.Id, "channel-alternate", "Channel Alternate", "Channel Alternate", model.ChannelTypeOpen, th.User, false)
 	require.NoError(t, err)
 	defer th.deleteChannel(alternate)
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "channel-a", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "channel-a", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, th.ChannelPrivate.Id, alternate.Id}, res)
 }
@@ -121,11 +122,11 @@ func testAutocompleteChannelByDisplayName(t *testing.T, th *SearchTestHelper) {
 	require.NoError(t, err)
 	defer th.deleteChannel(private)
 
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "ChannelA", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "ChannelA", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, alternate.Id, private.Id}, res)
 
-	res2, err := th.Store.Channel().Autocomplete(th.User.Id, "ChannelA", false, false)
+	res2, err := th.Store.Channel().Autocomplete(context.Background(), th.User.Id, "ChannelA", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatchWithTeamData(t, []string{th.ChannelBasic.Id, alternate.Id, private.Id, th.ChannelAnotherTeam.Id}, res2)
 }
@@ -134,7 +135,7 @@ func testAutocompleteChannelByNameSplittedWithDashChar(t *testing.T, th *SearchT
 	alternate, err := th.createChannel(th.Team.Id, "channel-alternate", "ChannelAlternate", "", model.ChannelTypeOpen, th.User, false)
 	require.NoError(t, err)
 	defer th.deleteChannel(alternate)
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "channel-a", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "channel-a", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, alternate.Id}, res)
 }
@@ -143,7 +144,7 @@ func testAutocompleteChannelByNameSplittedWithDashCharPostgres(t *testing.T, th
 	alternate, err := th.createChannel(th.Team.Id, "channel-alternate", "ChannelAlternate", "", model.ChannelTypeOpen, th.User, false)
 	require.NoError(t, err)
 	defer th.deleteChannel(alternate)
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "channel-a", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "channel-a", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, th.ChannelPrivate.Id, alternate.Id}, res)
 }
@@ -152,11 +153,11 @@ func testAutocompleteChannelByNameSplittedWithUnderscoreChar(t *testing.T, th *S
 	alternate, err := th.createChannel(th.Team.Id, "channel_alternate", "ChannelAlternate", "", model.ChannelTypeOpen, th.User, false)
 	require.NoError(t, err)
 	defer th.deleteChannel(alternate)
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "channel_a", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "channel_a", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{alternate.Id}, res)
 
-	res2, err := th.Store.Channel().Autocomplete(th.User.Id, "channel_a", false, false)
+	res2, err := th.Store.Channel().Autocomplete(context.Background(), th.User.Id, "channel_a", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatchWithTeamData(t, []string{alternate.Id}, res2)
 }
@@ -166,7 +167,7 @@ func testAutocompleteChannelByDisplayNameSplittedByWhitespaces(t *testing.T, th
 	require.NoError(t, err)
 
 	defer th.deleteChannel(alternate)
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "Channel A", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "Channel A", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{alternate.Id}, res)
 }
@@ -177,7 +178,7 @@ func testAutocompleteAllChannelsIfTermIsEmpty(t *testing.T, th *SearchTestHelper
 	require.NoError(t, err)
 	defer th.deleteChannel(alternate)
 	defer th.deleteChannel(other)
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, th.ChannelPrivate.Id, alternate.Id, other.Id}, res)
 }
@@ -186,17 +187,17 @@ func testSearchChannelsInCaseInsensitiveManner(t *testing.T, th *SearchTestHelpe
 	alternate, err := th.createChannel(th.Team.Id, "channel-alternate", "ChannelAlternate", "", model.ChannelTypeOpen, th.User, false)
 	require.NoError(t, err)
 	defer th.deleteChannel(alternate)
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "channela", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "channela", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, alternate.Id}, res)
-	res, err = th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "ChAnNeL-a", false, false)
+	res, err = th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "ChAnNeL-a", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, alternate.Id}, res)
 
-	res2, err := th.Store.Channel().Autocomplete(th.User.Id, "channela", false, false)
+	res2, err := th.Store.Channel().Autocomplete(context.Background(), th.User.Id, "channela", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatchWithTeamData(t, []string{th.ChannelAnotherTeam.Id, th.ChannelBasic.Id, alternate.Id}, res2)
-	res2, err = th.Store.Channel().Autocomplete(th.User.Id, "ChAnNeL-a", false, false)
+	res2, err = th.Store.Channel().Autocomplete(context.Background(), th.User.Id, "ChAnNeL-a", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatchWithTeamData(t, []string{th.ChannelAnotherTeam.Id, th.ChannelBasic.Id, alternate.Id}, res2)
 }
@@ -205,10 +206,10 @@ func testSearchChannelsInCaseInsensitiveMannerPostgres(t *testing.T, th *SearchT
 	alternate, err := th.createChannel(th.Team.Id, "channel-alternate", "ChannelAlternate", "", model.ChannelTypeOpen, th.User, false)
 	require.NoError(t, err)
 	defer th.deleteChannel(alternate)
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "channela", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "channela", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, alternate.Id}, res)
-	res, err = th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "ChAnNeL-a", false, false)
+	res, err = th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "ChAnNeL-a", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, th.ChannelPrivate.Id, alternate.Id}, res)
 }
@@ -217,17 +218,17 @@ func testSearchShouldSupportHavingHyphenAsLastCharacter(t *testing.T, th *Search
 	alternate, err := th.createChannel(th.Team.Id, "channel-alternate", "ChannelAlternate", "", model.ChannelTypeOpen, th.User, false)
 	require.NoError(t, err)
 	defer th.deleteChannel(alternate)
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "channel-", false, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "channel-", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, th.ChannelPrivate.Id, alternate.Id}, res)
 
-	res2, err := th.Store.Channel().Autocomplete(th.User.Id, "channel-", false, false)
+	res2, err := th.Store.Channel().Autocomplete(context.Background(), th.User.Id, "channel-", false, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatchWithTeamData(t, []string{th.ChannelAnotherTeam.Id, th.ChannelBasic.Id, th.ChannelPrivate.Id, alternate.Id}, res2)
 }
 
 func testSearchShouldSupportAutocompleteWithArchivedChannels(t *testing.T, th *SearchTestHelper) {
-	res, err := th.Store.Channel().AutocompleteInTeam(th.Team.Id, th.User.Id, "channel-", true, false)
+	res, err := th.Store.Channel().AutocompleteInTeam(context.Background(), th.Team.Id, th.User.Id, "channel-", true, false)
 	require.NoError(t, err)
 	th.checkChannelIdsMatch(t, []string{th.ChannelBasic.Id, th.ChannelPrivate.Id, th.ChannelDeleted.Id}, res)
 }
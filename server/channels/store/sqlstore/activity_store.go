@@ -0,0 +1,174 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlActivityStore struct {
+	*SqlStore
+}
+
+func activitySliceColumns() []string {
+	return []string{
+		"Id",
+		"UserId",
+		"Type",
+		"ActorId",
+		"TeamId",
+		"ChannelId",
+		"PostId",
+		"RootId",
+		"Props",
+		"CreateAt",
+		"ReadAt",
+	}
+}
+
+func activityToSlice(activity *model.Activity) []any {
+	return []any{
+		activity.Id,
+		activity.UserId,
+		activity.Type,
+		activity.ActorId,
+		activity.TeamId,
+		activity.ChannelId,
+		activity.PostId,
+		activity.RootId,
+		model.MapToJSON(activity.Props),
+		activity.CreateAt,
+		activity.ReadAt,
+	}
+}
+
+func newSqlActivityStore(sqlStore *SqlStore) store.ActivityStore {
+	return &SqlActivityStore{
+		SqlStore: sqlStore,
+	}
+}
+
+// Save adds the activities to the feeds of their users.
+func (s *SqlActivityStore) Save(activities []*model.Activity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	query := s.getQueryBuilder().
+		Insert("Activities").
+		Columns(activitySliceColumns()...)
+
+	for _, activity := range activities {
+		activity.PreSave()
+		if err := activity.IsValid(); err != nil {
+			return err
+		}
+		query = query.Values(activityToSlice(activity)...)
+	}
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrap(err, "failed to save Activities")
+	}
+
+	return nil
+}
+
+// GetForUser returns a page of the activity feed of the user, the most recent activities first.
+func (s *SqlActivityStore) GetForUser(userID string, options model.ActivityGetOptions) ([]*model.Activity, error) {
+	query := s.getQueryBuilder().
+		Select(activitySliceColumns()...).
+		From("Activities").
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("CreateAt DESC", "Id DESC").
+		Limit(uint64(options.PerPage)).
+		Offset(uint64(options.Page * options.PerPage))
+
+	if len(options.Types) > 0 {
+		query = query.Where(sq.Eq{"Type": options.Types})
+	}
+
+	if options.UnreadOnly {
+		query = query.Where(sq.Eq{"ReadAt": 0})
+	}
+
+	activities := []*model.Activity{}
+	if err := s.GetReplicaX().SelectBuilder(&activities, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Activities for userId=%s", userID)
+	}
+
+	return activities, nil
+}
+
+func (s *SqlActivityStore) GetUnreadCountForUser(userID string) (int64, error) {
+	query := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("Activities").
+		Where(sq.Eq{
+			"UserId": userID,
+			"ReadAt": 0,
+		})
+
+	var count int64
+	if err := s.GetReplicaX().GetBuilder(&count, query); err != nil {
+		return 0, errors.Wrapf(err, "failed to count unread Activities for userId=%s", userID)
+	}
+
+	return count, nil
+}
+
+// MarkAsRead marks the given activities of the user as read, leaving the ones already read alone.
+func (s *SqlActivityStore) MarkAsRead(userID string, activityIDs []string, readAt int64) error {
+	if len(activityIDs) == 0 {
+		return nil
+	}
+
+	query := s.getQueryBuilder().
+		Update("Activities").
+		Set("ReadAt", readAt).
+		Where(sq.Eq{
+			"UserId": userID,
+			"Id":     activityIDs,
+			"ReadAt": 0,
+		})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to mark Activities as read for userId=%s", userID)
+	}
+
+	return nil
+}
+
+// MarkAllAsRead marks all the unread activities of the user as read.
+func (s *SqlActivityStore) MarkAllAsRead(userID string, readAt int64) error {
+	query := s.getQueryBuilder().
+		Update("Activities").
+		Set("ReadAt", readAt).
+		Where(sq.Eq{
+			"UserId": userID,
+			"ReadAt": 0,
+		})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to mark all Activities as read for userId=%s", userID)
+	}
+
+	return nil
+}
+
+// DeleteForPost removes the activities about the post from all the feeds.
+func (s *SqlActivityStore) DeleteForPost(postID string) error {
+	query := s.getQueryBuilder().
+		Delete("Activities").
+		Where(sq.Eq{"PostId": postID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete Activities for postId=%s", postID)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlArchivedPostStore struct {
+	*SqlStore
+}
+
+func newSqlArchivedPostStore(sqlStore *SqlStore) store.ArchivedPostStore {
+	return &SqlArchivedPostStore{
+		SqlStore: sqlStore,
+	}
+}
+
+var archivedPostColumns = []string{"PostId", "ChannelId", "RootId", "CreateAt", "DeleteAt", "Segment"}
+
+func (s *SqlArchivedPostStore) Archive(archived []*model.ArchivedPost) (err error) {
+	if len(archived) == 0 {
+		return nil
+	}
+
+	insert := s.getQueryBuilder().
+		Insert("ArchivedPosts").
+		Columns(archivedPostColumns...)
+	postIDs := make([]string, 0, len(archived))
+	for _, a := range archived {
+		insert = insert.Values(a.PostId, a.ChannelId, a.RootId, a.CreateAt, a.DeleteAt, a.Segment)
+		postIDs = append(postIDs, a.PostId)
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if _, err = transaction.ExecBuilder(insert); err != nil {
+		return errors.Wrap(err, "failed to save ArchivedPosts")
+	}
+
+	if _, err = transaction.ExecBuilder(s.getQueryBuilder().Delete("Posts").Where(sq.Eq{"Id": postIDs})); err != nil {
+		return errors.Wrap(err, "failed to delete the archived Posts")
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s *SqlArchivedPostStore) GetByIds(postIDs []string) ([]*model.ArchivedPost, error) {
+	archived := []*model.ArchivedPost{}
+	if len(postIDs) == 0 {
+		return archived, nil
+	}
+
+	query := s.getQueryBuilder().
+		Select(archivedPostColumns...).
+		From("ArchivedPosts").
+		Where(sq.Eq{"PostId": postIDs})
+
+	if err := s.GetReplicaX().SelectBuilder(&archived, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get ArchivedPosts")
+	}
+
+	return archived, nil
+}
+
+func (s *SqlArchivedPostStore) GetForThread(rootID string) ([]*model.ArchivedPost, error) {
+	query := s.getQueryBuilder().
+		Select(archivedPostColumns...).
+		From("ArchivedPosts").
+		Where(sq.Or{
+			sq.Eq{"PostId": rootID},
+			sq.Eq{"RootId": rootID},
+		}).
+		OrderBy("CreateAt DESC")
+
+	archived := []*model.ArchivedPost{}
+	if err := s.GetReplicaX().SelectBuilder(&archived, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ArchivedPosts for thread with rootId=%s", rootID)
+	}
+
+	return archived, nil
+}
+
+func (s *SqlArchivedPostStore) GetForChannelBefore(channelID string, before int64, rootsOnly bool, limit int) ([]*model.ArchivedPost, error) {
+	query := s.getQueryBuilder().
+		Select(archivedPostColumns...).
+		From("ArchivedPosts").
+		Where(sq.Eq{"ChannelId": channelID, "DeleteAt": 0}).
+		Where(sq.Lt{"CreateAt": before}).
+		OrderBy("CreateAt DESC").
+		Limit(uint64(limit))
+	if rootsOnly {
+		query = query.Where(sq.Eq{"RootId": ""})
+	}
+
+	archived := []*model.ArchivedPost{}
+	if err := s.GetReplicaX().SelectBuilder(&archived, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ArchivedPosts for channel with channelId=%s", channelID)
+	}
+
+	return archived, nil
+}
+
+func (s *SqlArchivedPostStore) GetSegmentsForUser(userID, teamID string, after, before int64, limit int) ([]string, error) {
+	query := s.getQueryBuilder().
+		Select("ArchivedPosts.Segment").
+		From("ArchivedPosts").
+		Join("ChannelMembers ON ChannelMembers.ChannelId = ArchivedPosts.ChannelId").
+		Join("Channels ON Channels.Id = ArchivedPosts.ChannelId").
+		Where(sq.Eq{"ChannelMembers.UserId": userID}).
+		GroupBy("ArchivedPosts.Segment").
+		OrderBy("MAX(ArchivedPosts.CreateAt) DESC").
+		Limit(uint64(limit))
+	if teamID != "" {
+		query = query.Where(sq.Or{
+			sq.Eq{"Channels.TeamId": teamID},
+			sq.Eq{"Channels.TeamId": ""},
+		})
+	}
+	if after > 0 {
+		query = query.Where(sq.GtOrEq{"ArchivedPosts.CreateAt": after})
+	}
+	if before > 0 {
+		query = query.Where(sq.Lt{"ArchivedPosts.CreateAt": before})
+	}
+
+	segments := []string{}
+	if err := s.GetReplicaX().SelectBuilder(&segments, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the ArchivedPosts segments for user with userId=%s", userID)
+	}
+
+	return segments, nil
+}
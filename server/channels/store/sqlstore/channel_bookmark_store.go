@@ -0,0 +1,197 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlChannelBookmarkStore struct {
+	*SqlStore
+}
+
+func channelBookmarkSliceColumns() []string {
+	return []string{
+		"Id",
+		"CreateAt",
+		"UpdateAt",
+		"DeleteAt",
+		"ChannelId",
+		"OwnerId",
+		"FileId",
+		"DisplayName",
+		"SortOrder",
+		"LinkUrl",
+		"Emoji",
+		"Type",
+	}
+}
+
+func channelBookmarkToSlice(bookmark *model.ChannelBookmark) []any {
+	return []any{
+		bookmark.Id,
+		bookmark.CreateAt,
+		bookmark.UpdateAt,
+		bookmark.DeleteAt,
+		bookmark.ChannelId,
+		bookmark.OwnerId,
+		bookmark.FileId,
+		bookmark.DisplayName,
+		bookmark.SortOrder,
+		bookmark.LinkUrl,
+		bookmark.Emoji,
+		bookmark.Type,
+	}
+}
+
+func newSqlChannelBookmarkStore(sqlStore *SqlStore) store.ChannelBookmarkStore {
+	return &SqlChannelBookmarkStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlChannelBookmarkStore) Save(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
+	bookmark.PreSave()
+	if err := bookmark.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("ChannelBookmarks").
+		Columns(channelBookmarkSliceColumns()...).
+		Values(channelBookmarkToSlice(bookmark)...)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrap(err, "failed to save ChannelBookmark")
+	}
+
+	return bookmark, nil
+}
+
+func (s *SqlChannelBookmarkStore) Get(id string, includeDeleted bool) (*model.ChannelBookmark, error) {
+	query := s.getQueryBuilder().
+		Select(channelBookmarkSliceColumns()...).
+		From("ChannelBookmarks").
+		Where(sq.Eq{"Id": id})
+
+	if !includeDeleted {
+		query = query.Where(sq.Eq{"DeleteAt": 0})
+	}
+
+	var bookmark model.ChannelBookmark
+	if err := s.GetReplicaX().GetBuilder(&bookmark, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelBookmark", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelBookmark with id=%s", id)
+	}
+
+	return &bookmark, nil
+}
+
+// GetBookmarksForChannel returns the bookmarks of the channel that haven't been deleted, in order.
+func (s *SqlChannelBookmarkStore) GetBookmarksForChannel(channelID string) ([]*model.ChannelBookmark, error) {
+	query := s.getQueryBuilder().
+		Select(channelBookmarkSliceColumns()...).
+		From("ChannelBookmarks").
+		Where(sq.Eq{
+			"ChannelId": channelID,
+			"DeleteAt":  0,
+		}).
+		OrderBy("SortOrder ASC", "CreateAt ASC")
+
+	bookmarks := []*model.ChannelBookmark{}
+	if err := s.GetReplicaX().SelectBuilder(&bookmarks, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ChannelBookmarks for channelId=%s", channelID)
+	}
+
+	return bookmarks, nil
+}
+
+func (s *SqlChannelBookmarkStore) Update(bookmark *model.ChannelBookmark) error {
+	bookmark.PreUpdate()
+	if err := bookmark.IsValid(); err != nil {
+		return err
+	}
+
+	query := s.getQueryBuilder().
+		Update("ChannelBookmarks").
+		Set("UpdateAt", bookmark.UpdateAt).
+		Set("FileId", bookmark.FileId).
+		Set("DisplayName", bookmark.DisplayName).
+		Set("LinkUrl", bookmark.LinkUrl).
+		Set("Emoji", bookmark.Emoji).
+		Where(sq.Eq{
+			"Id":       bookmark.Id,
+			"DeleteAt": 0,
+		})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update ChannelBookmark with id=%s", bookmark.Id)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return store.NewErrNotFound("ChannelBookmark", bookmark.Id)
+	}
+
+	return nil
+}
+
+// UpdateSortOrder orders the bookmarks of the channel as given. The ids of bookmarks of other
+// channels are ignored.
+func (s *SqlChannelBookmarkStore) UpdateSortOrder(channelID string, bookmarkIDs []string) (err error) {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	now := model.GetMillis()
+	for i, bookmarkID := range bookmarkIDs {
+		query := s.getQueryBuilder().
+			Update("ChannelBookmarks").
+			Set("SortOrder", i).
+			Set("UpdateAt", now).
+			Where(sq.Eq{
+				"Id":        bookmarkID,
+				"ChannelId": channelID,
+			})
+		if _, err = transaction.ExecBuilder(query); err != nil {
+			return errors.Wrapf(err, "failed to update the sort order of ChannelBookmark with id=%s", bookmarkID)
+		}
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s *SqlChannelBookmarkStore) Delete(id string, deleteAt int64) error {
+	query := s.getQueryBuilder().
+		Update("ChannelBookmarks").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{
+			"Id":       id,
+			"DeleteAt": 0,
+		})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelBookmark with id=%s", id)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return store.NewErrNotFound("ChannelBookmark", id)
+	}
+
+	return nil
+}
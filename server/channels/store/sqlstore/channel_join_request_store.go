@@ -0,0 +1,161 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlChannelJoinRequestStore struct {
+	*SqlStore
+}
+
+func channelJoinRequestSliceColumns() []string {
+	return []string{
+		"Id",
+		"ChannelId",
+		"UserId",
+		"Message",
+		"Status",
+		"DeciderId",
+		"PostId",
+		"CreateAt",
+		"UpdateAt",
+	}
+}
+
+func newSqlChannelJoinRequestStore(sqlStore *SqlStore) store.ChannelJoinRequestStore {
+	return &SqlChannelJoinRequestStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlChannelJoinRequestStore) Save(request *model.ChannelJoinRequest) (*model.ChannelJoinRequest, error) {
+	if request.Id != "" {
+		return nil, store.NewErrInvalidInput("ChannelJoinRequest", "id", request.Id)
+	}
+
+	request.PreSave()
+	if err := request.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("ChannelJoinRequests").
+		Columns(channelJoinRequestSliceColumns()...).
+		Values(request.Id, request.ChannelId, request.UserId, request.Message, request.Status, request.DeciderId, request.PostId, request.CreateAt, request.UpdateAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ChannelJoinRequest with id=%s", request.Id)
+	}
+
+	return request, nil
+}
+
+// Update stores the status, decider and approval post of the request.
+func (s *SqlChannelJoinRequestStore) Update(request *model.ChannelJoinRequest) (*model.ChannelJoinRequest, error) {
+	request.PreUpdate()
+	if err := request.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Update("ChannelJoinRequests").
+		Set("Status", request.Status).
+		Set("DeciderId", request.DeciderId).
+		Set("PostId", request.PostId).
+		Set("UpdateAt", request.UpdateAt).
+		Where(sq.Eq{"Id": request.Id})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update ChannelJoinRequest with id=%s", request.Id)
+	}
+
+	if rows, err := result.RowsAffected(); err != nil {
+		return nil, errors.Wrap(err, "failed to get affected rows")
+	} else if rows == 0 {
+		return nil, store.NewErrNotFound("ChannelJoinRequest", request.Id)
+	}
+
+	return request, nil
+}
+
+func (s *SqlChannelJoinRequestStore) Get(id string) (*model.ChannelJoinRequest, error) {
+	query := s.getQueryBuilder().
+		Select(channelJoinRequestSliceColumns()...).
+		From("ChannelJoinRequests").
+		Where(sq.Eq{"Id": id})
+
+	var request model.ChannelJoinRequest
+	if err := s.GetReplicaX().GetBuilder(&request, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelJoinRequest", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelJoinRequest with id=%s", id)
+	}
+
+	return &request, nil
+}
+
+// GetPending returns the request of the user to join the channel that is yet to be decided.
+func (s *SqlChannelJoinRequestStore) GetPending(channelID string, userID string) (*model.ChannelJoinRequest, error) {
+	query := s.getQueryBuilder().
+		Select(channelJoinRequestSliceColumns()...).
+		From("ChannelJoinRequests").
+		Where(sq.Eq{"ChannelId": channelID, "UserId": userID, "Status": model.ChannelJoinRequestStatusPending}).
+		OrderBy("CreateAt DESC").
+		Limit(1)
+
+	var request model.ChannelJoinRequest
+	if err := s.GetMasterX().GetBuilder(&request, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelJoinRequest", channelID+":"+userID)
+		}
+		return nil, errors.Wrapf(err, "failed to get pending ChannelJoinRequest with channelId=%s, userId=%s", channelID, userID)
+	}
+
+	return &request, nil
+}
+
+// GetForChannel returns a page of the requests to join the channel, the most recent first. An
+// empty status returns the requests whatever their status.
+func (s *SqlChannelJoinRequestStore) GetForChannel(channelID string, status string, offset int, limit int) ([]*model.ChannelJoinRequest, error) {
+	query := s.getQueryBuilder().
+		Select(channelJoinRequestSliceColumns()...).
+		From("ChannelJoinRequests").
+		Where(sq.Eq{"ChannelId": channelID}).
+		OrderBy("CreateAt DESC", "Id").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	if status != "" {
+		query = query.Where(sq.Eq{"Status": status})
+	}
+
+	requests := []*model.ChannelJoinRequest{}
+	if err := s.GetReplicaX().SelectBuilder(&requests, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ChannelJoinRequests for channelId=%s", channelID)
+	}
+
+	return requests, nil
+}
+
+func (s *SqlChannelJoinRequestStore) PermanentDeleteByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("ChannelJoinRequests").
+		Where(sq.Eq{"UserId": userID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelJoinRequests for userId=%s", userID)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlChannelMemberExpiryStore struct {
+	*SqlStore
+}
+
+func channelMemberExpirySliceColumns() []string {
+	return []string{
+		"ChannelId",
+		"UserId",
+		"ExpiresAt",
+		"CreatorId",
+		"CreateAt",
+	}
+}
+
+func newSqlChannelMemberExpiryStore(sqlStore *SqlStore) store.ChannelMemberExpiryStore {
+	return &SqlChannelMemberExpiryStore{
+		SqlStore: sqlStore,
+	}
+}
+
+// Save stores the expiry of a channel member, replacing any previous one.
+func (s *SqlChannelMemberExpiryStore) Save(expiry *model.ChannelMemberExpiry) (*model.ChannelMemberExpiry, error) {
+	expiry.PreSave()
+	if err := expiry.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("ChannelMemberExpiries").
+		Columns(channelMemberExpirySliceColumns()...).
+		Values(expiry.ChannelId, expiry.UserId, expiry.ExpiresAt, expiry.CreatorId, expiry.CreateAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE ExpiresAt = ?, CreatorId = ?, CreateAt = ?", expiry.ExpiresAt, expiry.CreatorId, expiry.CreateAt))
+	} else if s.DriverName() == model.DatabaseDriverPostgres {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (channelid, userid) DO UPDATE SET ExpiresAt = ?, CreatorId = ?, CreateAt = ?", expiry.ExpiresAt, expiry.CreatorId, expiry.CreateAt))
+	} else {
+		return nil, store.NewErrNotImplemented("failed to save ChannelMemberExpiry because of missing driver")
+	}
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ChannelMemberExpiry with channelId=%s, userId=%s", expiry.ChannelId, expiry.UserId)
+	}
+
+	return expiry, nil
+}
+
+func (s *SqlChannelMemberExpiryStore) Get(channelID string, userID string) (*model.ChannelMemberExpiry, error) {
+	query := s.getQueryBuilder().
+		Select(channelMemberExpirySliceColumns()...).
+		From("ChannelMemberExpiries").
+		Where(sq.Eq{"ChannelId": channelID, "UserId": userID})
+
+	var expiry model.ChannelMemberExpiry
+	if err := s.GetReplicaX().GetBuilder(&expiry, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelMemberExpiry", channelID+":"+userID)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelMemberExpiry with channelId=%s, userId=%s", channelID, userID)
+	}
+
+	return &expiry, nil
+}
+
+// GetForChannel returns the expiries of the members of the channel, the soonest first.
+func (s *SqlChannelMemberExpiryStore) GetForChannel(channelID string) ([]*model.ChannelMemberExpiry, error) {
+	query := s.getQueryBuilder().
+		Select(channelMemberExpirySliceColumns()...).
+		From("ChannelMemberExpiries").
+		Where(sq.Eq{"ChannelId": channelID}).
+		OrderBy("ExpiresAt", "UserId")
+
+	expiries := []*model.ChannelMemberExpiry{}
+	if err := s.GetReplicaX().SelectBuilder(&expiries, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ChannelMemberExpiries for channelId=%s", channelID)
+	}
+
+	return expiries, nil
+}
+
+// GetExpired returns up to limit expiries that are due at or before now, the oldest first.
+func (s *SqlChannelMemberExpiryStore) GetExpired(now int64, limit int) ([]*model.ChannelMemberExpiry, error) {
+	query := s.getQueryBuilder().
+		Select(channelMemberExpirySliceColumns()...).
+		From("ChannelMemberExpiries").
+		Where(sq.LtOrEq{"ExpiresAt": now}).
+		OrderBy("ExpiresAt", "ChannelId", "UserId").
+		Limit(uint64(limit))
+
+	expiries := []*model.ChannelMemberExpiry{}
+	if err := s.GetReplicaX().SelectBuilder(&expiries, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get expired ChannelMemberExpiries")
+	}
+
+	return expiries, nil
+}
+
+func (s *SqlChannelMemberExpiryStore) Delete(channelID string, userID string) error {
+	query := s.getQueryBuilder().
+		Delete("ChannelMemberExpiries").
+		Where(sq.Eq{"ChannelId": channelID, "UserId": userID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelMemberExpiry with channelId=%s, userId=%s", channelID, userID)
+	}
+
+	return nil
+}
+
+func (s *SqlChannelMemberExpiryStore) PermanentDeleteByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("ChannelMemberExpiries").
+		Where(sq.Eq{"UserId": userID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelMemberExpiries for userId=%s", userID)
+	}
+
+	return nil
+}
@@ -604,6 +604,10 @@ func (s SqlChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64)
 		return nil, errors.Wrap(err, "upsert_public_channel")
 	}
 
+	if err = s.saveChannelCountersT(transaction, newChannel.Id); err != nil {
+		return nil, err
+	}
+
 	if err = transaction.Commit(); err != nil {
 		return nil, errors.Wrap(err, "commit_transaction")
 	}
@@ -705,9 +709,9 @@ func (s SqlChannelStore) saveChannelT(transaction *sqlxTxWrapper, channel *model
 	}
 
 	if _, err := transaction.NamedExec(`INSERT INTO Channels
-		(Id, CreateAt, UpdateAt, DeleteAt, TeamId, Type, DisplayName, Name, Header, Purpose, LastPostAt, TotalMsgCount, ExtraUpdateAt, CreatorId, SchemeId, GroupConstrained, Shared, TotalMsgCountRoot, LastRootPostAt)
+		(Id, CreateAt, UpdateAt, DeleteAt, TeamId, Type, DisplayName, Name, Header, Purpose, LastPostAt, TotalMsgCount, ExtraUpdateAt, CreatorId, SchemeId, GroupConstrained, Shared, TotalMsgCountRoot, LastRootPostAt, DefaultNotifyProps)
 		VALUES
-		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :TeamId, :Type, :DisplayName, :Name, :Header, :Purpose, :LastPostAt, :TotalMsgCount, :ExtraUpdateAt, :CreatorId, :SchemeId, :GroupConstrained, :Shared, :TotalMsgCountRoot, :LastRootPostAt)`, channel); err != nil {
+		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :TeamId, :Type, :DisplayName, :Name, :Header, :Purpose, :LastPostAt, :TotalMsgCount, :ExtraUpdateAt, :CreatorId, :SchemeId, :GroupConstrained, :Shared, :TotalMsgCountRoot, :LastRootPostAt, :DefaultNotifyProps)`, channel); err != nil {
 		if IsUniqueConstraintError(err, []string{"Name", "channels_name_teamid_key"}) {
 			dupChannel := model.Channel{}
 			if serr := s.GetMasterX().Get(&dupChannel, "SELECT * FROM Channels WHERE TeamId = ? AND Name = ?", channel.TeamId, channel.Name); serr != nil {
@@ -773,7 +777,8 @@ func (s SqlChannelStore) updateChannelT(transaction *sqlxTxWrapper, channel *mod
 			GroupConstrained=:GroupConstrained,
 			Shared=:Shared,
 			TotalMsgCountRoot=:TotalMsgCountRoot,
-			LastRootPostAt=:LastRootPostAt
+			LastRootPostAt=:LastRootPostAt,
+			DefaultNotifyProps=:DefaultNotifyProps
 		WHERE Id=:Id`, channel)
 	if err != nil {
 		if IsUniqueConstraintError(err, []string{"Name", "channels_name_teamid_key"}) {
@@ -821,6 +826,64 @@ func (s SqlChannelStore) GetChannelUnread(channelId, userId string) (*model.Chan
 	return &unreadChannel, nil
 }
 
+// GetInboxChannelsForUser returns a page of the unread channels of the user across all their
+// teams, the most recently active first.
+func (s SqlChannelStore) GetInboxChannelsForUser(userID string, options model.GetInboxOptions) ([]*model.InboxItem, error) {
+	lastPostAt := "Channels.LastPostAt"
+	msgCount := "(Channels.TotalMsgCount - ChannelMembers.MsgCount)"
+	mentionCount := "ChannelMembers.MentionCount"
+	if options.CollapsedThreads {
+		lastPostAt = "Channels.LastRootPostAt"
+		msgCount = "(Channels.TotalMsgCountRoot - ChannelMembers.MsgCountRoot)"
+		mentionCount = "ChannelMembers.MentionCountRoot"
+	}
+
+	query := s.getQueryBuilder().
+		Select(
+			"Channels.Id AS Id",
+			"Channels.TeamId AS TeamId",
+			"Channels.Id AS ChannelId",
+			lastPostAt+" AS LastActivityAt",
+			msgCount+" AS MsgCount",
+			mentionCount+" AS MentionCount",
+			"COALESCE(ChannelMembers.UrgentMentionCount, 0) AS UrgentMentionCount",
+			"ChannelMembers.NotifyProps AS NotifyProps",
+		).
+		From("Channels").
+		Join("ChannelMembers ON ChannelMembers.ChannelId = Channels.Id").
+		Where(sq.Eq{
+			"ChannelMembers.UserId": userID,
+			"Channels.DeleteAt":     0,
+		}).
+		Where(sq.Or{
+			sq.Gt{msgCount: 0},
+			sq.Gt{mentionCount: 0},
+		}).
+		OrderBy(lastPostAt+" DESC", "Channels.Id DESC").
+		Limit(uint64(options.PerPage))
+
+	if !options.Cursor.IsZero() {
+		query = query.Where(sq.Or{
+			sq.Lt{lastPostAt: options.Cursor.LastActivityAt},
+			sq.And{
+				sq.Eq{lastPostAt: options.Cursor.LastActivityAt},
+				sq.Lt{"Channels.Id": options.Cursor.Id},
+			},
+		})
+	}
+
+	items := []*model.InboxItem{}
+	if err := s.GetReplicaX().SelectBuilder(&items, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find unread Channels with userId=%s", userID)
+	}
+
+	for _, item := range items {
+		item.Type = model.InboxItemTypeChannel
+	}
+
+	return items, nil
+}
+
 //nolint:unparam
 func (s SqlChannelStore) InvalidateChannel(id string) {
 }
@@ -1011,6 +1074,18 @@ func (s SqlChannelStore) permanentDeleteT(transaction *sqlxTxWrapper, channelId
 		return errors.Wrapf(err, "failed to delete channel with id=%s", channelId)
 	}
 
+	if _, err := transaction.Exec("DELETE FROM ChannelPinnedPosts WHERE ChannelId = ?", channelId); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelPinnedPosts with channelId=%s", channelId)
+	}
+
+	if _, err := transaction.Exec("DELETE FROM ChannelBookmarks WHERE ChannelId = ?", channelId); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelBookmarks with channelId=%s", channelId)
+	}
+
+	if _, err := transaction.Exec("DELETE FROM ChannelCounters WHERE ChannelId = ?", channelId); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelCounters with channelId=%s", channelId)
+	}
+
 	return nil
 }
 
@@ -1020,6 +1095,15 @@ func (s SqlChannelStore) PermanentDeleteMembersByChannel(channelId string) error
 		return errors.Wrapf(err, "failed to delete Channel with channelId=%s", channelId)
 	}
 
+	if _, err = s.GetMasterX().ExecBuilder(s.getQueryBuilder().
+		Update("ChannelCounters").
+		Set("MemberCount", 0).
+		Set("GuestCount", 0).
+		Set("UpdateAt", model.GetMillis()).
+		Where(sq.Eq{"ChannelId": channelId})); err != nil {
+		return errors.Wrapf(err, "failed to update ChannelCounters with channelId=%s", channelId)
+	}
+
 	return nil
 }
 
@@ -1791,8 +1875,10 @@ func (s SqlChannelStore) saveMultipleMembers(members []*model.ChannelMember) ([]
 	}
 
 	query := s.getQueryBuilder().Insert("ChannelMembers").Columns(channelMemberSliceColumns()...)
+	savedMembers := sq.Or{}
 	for _, member := range members {
 		query = query.Values(channelMemberToSlice(member)...)
+		savedMembers = append(savedMembers, sq.Eq{"ChannelMembers.ChannelId": member.ChannelId, "ChannelMembers.UserId": member.UserId})
 	}
 
 	sql, args, err := query.ToSql()
@@ -1800,13 +1886,27 @@ func (s SqlChannelStore) saveMultipleMembers(members []*model.ChannelMember) ([]
 		return nil, errors.Wrap(err, "channel_members_tosql")
 	}
 
-	if _, err := s.GetMasterX().Exec(sql, args...); err != nil {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if _, err = transaction.Exec(sql, args...); err != nil {
 		if IsUniqueConstraintError(err, []string{"ChannelId", "channelmembers_pkey", "PRIMARY"}) {
 			return nil, store.NewErrConflict("ChannelMembers", err, "")
 		}
 		return nil, errors.Wrap(err, "channel_members_save")
 	}
 
+	if err = updateMemberCounters(transaction, s.getQueryBuilder(), savedMembers, 1); err != nil {
+		return nil, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
 	newMembers := []*model.ChannelMember{}
 	for _, member := range members {
 		defaultTeamGuestRole := defaultTeamRolesByChannel[member.ChannelId].Guest.String
@@ -2369,6 +2469,51 @@ func (s SqlChannelStore) GetPinnedPostCount(channelId string, allowFromCache boo
 	return count, nil
 }
 
+func (s SqlChannelStore) GetChannelPinnedPosts(channelID string) (*model.ChannelPinnedPosts, error) {
+	pinned := model.ChannelPinnedPosts{}
+
+	query := s.getQueryBuilder().
+		Select("ChannelId", "PostOrder", "PinLimit", "UpdateAt").
+		From("ChannelPinnedPosts").
+		Where(sq.Eq{"ChannelId": channelID})
+
+	if err := s.GetReplicaX().GetBuilder(&pinned, query); err != nil {
+		if err == sql.ErrNoRows {
+			// channels whose pinned posts were never reordered or limited
+			return &model.ChannelPinnedPosts{ChannelId: channelID, PostOrder: model.StringArray{}}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelPinnedPosts with channelId=%s", channelID)
+	}
+
+	return &pinned, nil
+}
+
+func (s SqlChannelStore) SaveChannelPinnedPosts(pinned *model.ChannelPinnedPosts) (*model.ChannelPinnedPosts, error) {
+	pinned.PreSave()
+	if err := pinned.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("ChannelPinnedPosts").
+		Columns("ChannelId", "PostOrder", "PinLimit", "UpdateAt").
+		Values(pinned.ChannelId, pinned.PostOrder, pinned.PinLimit, pinned.UpdateAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE PostOrder = ?, PinLimit = ?, UpdateAt = ?", pinned.PostOrder, pinned.PinLimit, pinned.UpdateAt))
+	} else if s.DriverName() == model.DatabaseDriverPostgres {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (channelid) DO UPDATE SET PostOrder = ?, PinLimit = ?, UpdateAt = ?", pinned.PostOrder, pinned.PinLimit, pinned.UpdateAt))
+	} else {
+		return nil, store.NewErrNotImplemented("failed to save ChannelPinnedPosts because of missing driver")
+	}
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ChannelPinnedPosts with channelId=%s", pinned.ChannelId)
+	}
+
+	return pinned, nil
+}
+
 //nolint:unparam
 func (s SqlChannelStore) InvalidateGuestCount(channelId string) {
 }
@@ -2397,7 +2542,7 @@ func (s SqlChannelStore) GetGuestCount(channelId string, allowFromCache bool) (i
 	return count, nil
 }
 
-func (s SqlChannelStore) RemoveMembers(channelId string, userIds []string) error {
+func (s SqlChannelStore) RemoveMembers(channelId string, userIds []string) (err error) {
 	builder := s.getQueryBuilder().
 		Delete("ChannelMembers").
 		Where(sq.Eq{"ChannelId": channelId}).
@@ -2406,11 +2551,25 @@ func (s SqlChannelStore) RemoveMembers(channelId string, userIds []string) error
 	if err != nil {
 		return errors.Wrap(err, "channel_tosql")
 	}
-	_, err = s.GetMasterX().Exec(query, args...)
+
+	transaction, err := s.GetMasterX().Beginx()
 	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if err = updateMemberCounters(transaction, s.getQueryBuilder(), sq.Eq{"ChannelMembers.ChannelId": channelId, "ChannelMembers.UserId": userIds}, -1); err != nil {
+		return err
+	}
+
+	if _, err = transaction.Exec(query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete ChannelMembers")
 	}
 
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
 	// cleanup sidebarchannels table if the user is no longer a member of that channel
 	query, args, err = s.getQueryBuilder().
 		Delete("SidebarChannels").
@@ -2457,10 +2616,24 @@ func (s SqlChannelStore) RemoveAllDeactivatedMembers(channelId string) error {
 	return nil
 }
 
-func (s SqlChannelStore) PermanentDeleteMembersByUser(userId string) error {
-	if _, err := s.GetMasterX().Exec("DELETE FROM ChannelMembers WHERE UserId = ?", userId); err != nil {
+func (s SqlChannelStore) PermanentDeleteMembersByUser(userId string) (err error) {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if err = updateMemberCounters(transaction, s.getQueryBuilder(), sq.Eq{"ChannelMembers.UserId": userId}, -1); err != nil {
+		return err
+	}
+
+	if _, err = transaction.Exec("DELETE FROM ChannelMembers WHERE UserId = ?", userId); err != nil {
 		return errors.Wrapf(err, "failed to permanent delete ChannelMembers with userId=%s", userId)
 	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
 	return nil
 }
 
@@ -2859,7 +3032,7 @@ func (s SqlChannelStore) AnalyticsTypeCount(teamId string, channelType model.Cha
 	}
 
 	var value int64
-	err = s.GetReplicaX().Get(&value, sql, args...)
+	err = s.GetAnalyticsReplicaX().Get(&value, sql, args...)
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to count Channels")
 	}
@@ -2885,7 +3058,7 @@ func (s SqlChannelStore) AnalyticsDeletedTypeCount(teamId string, channelType mo
 	}
 
 	var v int64
-	err = s.GetReplicaX().Get(&v, sql, args...)
+	err = s.GetAnalyticsReplicaX().Get(&v, sql, args...)
 	if err != nil {
 		return 0, errors.Wrapf(err, "failed to count Channels with teamId=%s and channelType=%s", teamId, channelType)
 	}
@@ -3024,7 +3197,7 @@ func (s SqlChannelStore) GetTeamMembersForChannel(channelID string) ([]string, e
 	return teamMemberIDs, nil
 }
 
-func (s SqlChannelStore) Autocomplete(userID, term string, includeDeleted, isGuest bool) (model.ChannelListWithTeamData, error) {
+func (s SqlChannelStore) Autocomplete(ctx context.Context, userID, term string, includeDeleted, isGuest bool) (model.ChannelListWithTeamData, error) {
 	query := s.getQueryBuilder().Select("c.*",
 		"t.DisplayName AS TeamDisplayName",
 		"t.Name AS TeamName",
@@ -3071,14 +3244,14 @@ func (s SqlChannelStore) Autocomplete(userID, term string, includeDeleted, isGue
 	}
 
 	channels := model.ChannelListWithTeamData{}
-	err = s.GetReplicaX().Select(&channels, sql, args...)
+	err = s.GetReplicaX().withContext(ctx).Select(&channels, sql, args...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not find channel with term=%s", term)
 	}
 	return channels, nil
 }
 
-func (s SqlChannelStore) AutocompleteInTeam(teamID, userID, term string, includeDeleted, isGuest bool) (model.ChannelList, error) {
+func (s SqlChannelStore) AutocompleteInTeam(ctx context.Context, teamID, userID, term string, includeDeleted, isGuest bool) (model.ChannelList, error) {
 	query := s.getQueryBuilder().Select("*").
 		From("Channels c").
 		Where(sq.Eq{"c.TeamId": teamID}).
@@ -3110,10 +3283,10 @@ func (s SqlChannelStore) AutocompleteInTeam(teamID, userID, term string, include
 		query = query.Where(searchClause)
 	}
 
-	return s.performSearch(query, term)
+	return s.performSearch(ctx, query, term)
 }
 
-func (s SqlChannelStore) AutocompleteInTeamForSearch(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s SqlChannelStore) AutocompleteInTeamForSearch(ctx context.Context, teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
 	// shared query
 	query := s.getSubQueryBuilder().Select("C.*").
 		From("Channels AS C").
@@ -3184,12 +3357,12 @@ func (s SqlChannelStore) AutocompleteInTeamForSearch(teamID string, userID strin
 	}
 
 	// query the database
-	err = s.GetReplicaX().Select(&channels, sql, args...)
+	err = s.GetReplicaX().withContext(ctx).Select(&channels, sql, args...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to find Channels with term='%s'", term)
 	}
 
-	directChannels, err := s.autocompleteInTeamForSearchDirectMessages(userID, term)
+	directChannels, err := s.autocompleteInTeamForSearchDirectMessages(ctx, userID, term)
 	if err != nil {
 		return nil, err
 	}
@@ -3203,7 +3376,7 @@ func (s SqlChannelStore) AutocompleteInTeamForSearch(teamID string, userID strin
 	return channels, nil
 }
 
-func (s SqlChannelStore) autocompleteInTeamForSearchDirectMessages(userID string, term string) ([]*model.Channel, error) {
+func (s SqlChannelStore) autocompleteInTeamForSearchDirectMessages(ctx context.Context, userID string, term string) ([]*model.Channel, error) {
 	// create the main query
 	query := s.getQueryBuilder().Select("C.*", "OtherUsers.Username as DisplayName").
 		From("Channels AS C").
@@ -3241,14 +3414,14 @@ func (s SqlChannelStore) autocompleteInTeamForSearchDirectMessages(userID string
 
 	// query the channel list from the database using SQLX
 	channels := model.ChannelList{}
-	if err := s.GetReplicaX().Select(&channels, sql, args...); err != nil {
+	if err := s.GetReplicaX().withContext(ctx).Select(&channels, sql, args...); err != nil {
 		return nil, errors.Wrapf(err, "failed to find Channels with term='%s' (%s %% %v)", term, sql, args)
 	}
 
 	return channels, nil
 }
 
-func (s SqlChannelStore) SearchInTeam(teamId string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s SqlChannelStore) SearchInTeam(ctx context.Context, teamId string, term string, includeDeleted bool) (model.ChannelList, error) {
 	query := s.getQueryBuilder().Select("Channels.*").
 		From("Channels").
 		Join("PublicChannels c ON (c.Id = Channels.Id)").
@@ -3267,10 +3440,10 @@ func (s SqlChannelStore) SearchInTeam(teamId string, term string, includeDeleted
 		}
 	}
 
-	return s.performSearch(query, term)
+	return s.performSearch(ctx, query, term)
 }
 
-func (s SqlChannelStore) SearchArchivedInTeam(teamId string, term string, userId string) (model.ChannelList, error) {
+func (s SqlChannelStore) SearchArchivedInTeam(ctx context.Context, teamId string, term string, userId string) (model.ChannelList, error) {
 	queryBase := s.getQueryBuilder().Select("Channels.*").
 		From("Channels").
 		Join("Channels c ON (c.Id = Channels.Id)").
@@ -3298,12 +3471,12 @@ func (s SqlChannelStore) SearchArchivedInTeam(teamId string, term string, userId
 					Where(sq.Eq{"UserId": userId})),
 			})
 
-	publicChannels, err := s.performSearch(publicQuery, term)
+	publicChannels, err := s.performSearch(ctx, publicQuery, term)
 	if err != nil {
 		return nil, err
 	}
 
-	privateChannels, err := s.performSearch(privateQuery, term)
+	privateChannels, err := s.performSearch(ctx, privateQuery, term)
 	if err != nil {
 		return nil, err
 	}
@@ -3314,7 +3487,7 @@ func (s SqlChannelStore) SearchArchivedInTeam(teamId string, term string, userId
 	return output, nil
 }
 
-func (s SqlChannelStore) SearchForUserInTeam(userId string, teamId string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s SqlChannelStore) SearchForUserInTeam(ctx context.Context, userId string, teamId string, term string, includeDeleted bool) (model.ChannelList, error) {
 	query := s.getQueryBuilder().Select("Channels.*").
 		From("Channels").
 		Join("PublicChannels c ON (c.Id = Channels.Id)").
@@ -3335,7 +3508,7 @@ func (s SqlChannelStore) SearchForUserInTeam(userId string, teamId string, term
 		query = query.Where(searchClause)
 	}
 
-	return s.performSearch(query, term)
+	return s.performSearch(ctx, query, term)
 }
 
 func (s SqlChannelStore) channelSearchQuery(opts *store.ChannelSearchOpts) sq.SelectBuilder {
@@ -3452,7 +3625,7 @@ func (s SqlChannelStore) channelSearchQuery(opts *store.ChannelSearchOpts) sq.Se
 	return query
 }
 
-func (s SqlChannelStore) SearchAllChannels(term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
+func (s SqlChannelStore) SearchAllChannels(ctx context.Context, term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
 	opts.Term = term
 	opts.IncludeTeamInfo = true
 	queryString, args, err := s.channelSearchQuery(&opts).ToSql()
@@ -3460,7 +3633,7 @@ func (s SqlChannelStore) SearchAllChannels(term string, opts store.ChannelSearch
 		return nil, 0, errors.Wrap(err, "channel_tosql")
 	}
 	channels := model.ChannelListWithTeamData{}
-	if err2 := s.GetReplicaX().Select(&channels, queryString, args...); err2 != nil {
+	if err2 := s.GetReplicaX().withContext(ctx).Select(&channels, queryString, args...); err2 != nil {
 		return nil, 0, errors.Wrapf(err2, "failed to find Channels with term='%s'", term)
 	}
 
@@ -3473,7 +3646,7 @@ func (s SqlChannelStore) SearchAllChannels(term string, opts store.ChannelSearch
 		if err != nil {
 			return nil, 0, errors.Wrap(err, "channel_tosql")
 		}
-		if err2 := s.GetReplicaX().Get(&totalCount, queryString, args...); err2 != nil {
+		if err2 := s.GetReplicaX().withContext(ctx).Get(&totalCount, queryString, args...); err2 != nil {
 			return nil, 0, errors.Wrapf(err2, "failed to find Channels with term='%s'", term)
 		}
 	} else {
@@ -3509,7 +3682,7 @@ func (s SqlChannelStore) SearchMore(userId string, teamId string, term string) (
 		query = query.Where(searchClause)
 	}
 
-	return s.performSearch(query, term)
+	return s.performSearch(context.Background(), query, term)
 }
 
 func (s SqlChannelStore) buildLIKEClause(term string, searchColumns string) (likeClause, likeTerm string) {
@@ -3642,14 +3815,14 @@ func (s SqlChannelStore) buildFulltextClauseX(term string, searchColumns ...stri
 	return sq.Expr(expr, fulltextTerm)
 }
 
-func (s SqlChannelStore) performSearch(searchQuery sq.SelectBuilder, term string) (model.ChannelList, error) {
+func (s SqlChannelStore) performSearch(ctx context.Context, searchQuery sq.SelectBuilder, term string) (model.ChannelList, error) {
 	sql, args, err := searchQuery.ToSql()
 	if err != nil {
 		return model.ChannelList{}, errors.Wrapf(err, "performSearch_ToSql")
 	}
 
 	channels := model.ChannelList{}
-	err = s.GetReplicaX().Select(&channels, sql, args...)
+	err = s.GetReplicaX().withContext(ctx).Select(&channels, sql, args...)
 	if err != nil {
 		return channels, errors.Wrapf(err, "failed to find Channels with term='%s'", term)
 	}
@@ -3733,7 +3906,7 @@ func (s SqlChannelStore) searchGroupChannelsQuery(userId, term string, isPostgre
 		Limit(model.ChannelSearchDefaultLimit)
 }
 
-func (s SqlChannelStore) SearchGroupChannels(userId, term string) (model.ChannelList, error) {
+func (s SqlChannelStore) SearchGroupChannels(ctx context.Context, userId, term string) (model.ChannelList, error) {
 	isPostgreSQL := s.DriverName() == model.DatabaseDriverPostgres
 	query := s.searchGroupChannelsQuery(userId, term, isPostgreSQL)
 
@@ -3743,7 +3916,7 @@ func (s SqlChannelStore) SearchGroupChannels(userId, term string) (model.Channel
 	}
 
 	groupChannels := model.ChannelList{}
-	if err := s.GetReplicaX().Select(&groupChannels, sql, params...); err != nil {
+	if err := s.GetReplicaX().withContext(ctx).Select(&groupChannels, sql, params...); err != nil {
 		return nil, errors.Wrapf(err, "failed to find Channels with term='%s' and userId=%s", term, userId)
 	}
 	return groupChannels, nil
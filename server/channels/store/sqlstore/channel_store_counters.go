@@ -0,0 +1,206 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+// The counters of the channels are only updated when they exist: they're created along with the
+// channels, or by the reconciliation of the channels created before them. The counters of the
+// direct channels aren't maintained, their members being counted quickly.
+
+var channelCountersColumns = []string{"ChannelId", "MemberCount", "GuestCount", "PinnedPostCount", "UpdateAt"}
+
+func (s SqlChannelStore) GetCounters(channelID string) (*model.ChannelCounters, error) {
+	query := s.getQueryBuilder().
+		Select(channelCountersColumns...).
+		From("ChannelCounters").
+		Where(sq.Eq{"ChannelId": channelID})
+
+	var counters model.ChannelCounters
+	if err := s.GetReplicaX().GetBuilder(&counters, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelCounters", channelID)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelCounters with channelId=%s", channelID)
+	}
+
+	return &counters, nil
+}
+
+// ReconcileCounters counts the members and pinned posts of the limit channels, other than direct
+// channels, following the one with the given id, and saves the counts as their counters. It
+// returns the id of the last channel reconciled, or an empty string when there are none left.
+func (s SqlChannelStore) ReconcileCounters(afterChannelID string, limit int) (string, error) {
+	var channelIDs []string
+	query := s.getQueryBuilder().
+		Select("Id").
+		From("Channels").
+		Where(sq.Gt{"Id": afterChannelID}).
+		Where(sq.NotEq{"Type": model.ChannelTypeDirect}).
+		OrderBy("Id").
+		Limit(uint64(limit))
+	if err := s.GetReplicaX().SelectBuilder(&channelIDs, query); err != nil {
+		return "", errors.Wrap(err, "failed to get the Channels to reconcile")
+	}
+	if len(channelIDs) == 0 {
+		return "", nil
+	}
+
+	memberCounts := []struct {
+		ChannelId string
+		Members   int64
+		Guests    int64
+	}{}
+	query = s.getQueryBuilder().
+		Select(
+			"ChannelMembers.ChannelId AS ChannelId",
+			"COUNT(*) AS Members",
+			"COALESCE(SUM(CASE WHEN ChannelMembers.SchemeGuest THEN 1 ELSE 0 END), 0) AS Guests",
+		).
+		From("ChannelMembers").
+		Join("Users ON Users.Id = ChannelMembers.UserId").
+		Where(sq.Eq{"ChannelMembers.ChannelId": channelIDs, "Users.DeleteAt": 0}).
+		GroupBy("ChannelMembers.ChannelId")
+	if err := s.GetMasterX().SelectBuilder(&memberCounts, query); err != nil {
+		return "", errors.Wrap(err, "failed to count the ChannelMembers")
+	}
+
+	pinnedCounts := []struct {
+		ChannelId string
+		Pinned    int64
+	}{}
+	query = s.getQueryBuilder().
+		Select("ChannelId", "COUNT(*) AS Pinned").
+		From("Posts").
+		Where(sq.Eq{"ChannelId": channelIDs, "IsPinned": true, "DeleteAt": 0}).
+		GroupBy("ChannelId")
+	if err := s.GetMasterX().SelectBuilder(&pinnedCounts, query); err != nil {
+		return "", errors.Wrap(err, "failed to count the pinned Posts")
+	}
+
+	counters := make(map[string]*model.ChannelCounters, len(channelIDs))
+	updateAt := model.GetMillis()
+	for _, channelID := range channelIDs {
+		counters[channelID] = &model.ChannelCounters{ChannelId: channelID, UpdateAt: updateAt}
+	}
+	for _, count := range memberCounts {
+		counters[count.ChannelId].MemberCount = count.Members
+		counters[count.ChannelId].GuestCount = count.Guests
+	}
+	for _, count := range pinnedCounts {
+		counters[count.ChannelId].PinnedPostCount = count.Pinned
+	}
+
+	insert := s.getQueryBuilder().
+		Insert("ChannelCounters").
+		Columns(channelCountersColumns...)
+	for _, channelID := range channelIDs {
+		c := counters[channelID]
+		insert = insert.Values(c.ChannelId, c.MemberCount, c.GuestCount, c.PinnedPostCount, c.UpdateAt)
+	}
+	if s.DriverName() == model.DatabaseDriverMysql {
+		insert = insert.Suffix("ON DUPLICATE KEY UPDATE MemberCount = VALUES(MemberCount), GuestCount = VALUES(GuestCount), PinnedPostCount = VALUES(PinnedPostCount), UpdateAt = VALUES(UpdateAt)")
+	} else {
+		insert = insert.Suffix("ON CONFLICT (channelid) DO UPDATE SET MemberCount = excluded.MemberCount, GuestCount = excluded.GuestCount, PinnedPostCount = excluded.PinnedPostCount, UpdateAt = excluded.UpdateAt")
+	}
+	if _, err := s.GetMasterX().ExecBuilder(insert); err != nil {
+		return "", errors.Wrap(err, "failed to save the ChannelCounters")
+	}
+
+	if len(channelIDs) < limit {
+		return "", nil
+	}
+	return channelIDs[len(channelIDs)-1], nil
+}
+
+// saveChannelCountersT creates the counters of a new channel, without members yet.
+func (s SqlChannelStore) saveChannelCountersT(transaction *sqlxTxWrapper, channelID string) error {
+	query := s.getQueryBuilder().
+		Insert("ChannelCounters").
+		Columns(channelCountersColumns...).
+		Values(channelID, 0, 0, 0, model.GetMillis())
+	if _, err := transaction.ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to save ChannelCounters with channelId=%s", channelID)
+	}
+
+	return nil
+}
+
+// updateMemberCounters adds the active members matching where, and the guests among them, to the
+// counters of their channels, or removes them when sign is negative.
+func updateMemberCounters(ex sqlxExecutor, builder sq.StatementBuilderType, where sq.Sqlizer, sign int64) error {
+	counts := []struct {
+		ChannelId string
+		Members   int64
+		Guests    int64
+	}{}
+	query := builder.
+		Select(
+			"ChannelMembers.ChannelId AS ChannelId",
+			"COUNT(*) AS Members",
+			"COALESCE(SUM(CASE WHEN ChannelMembers.SchemeGuest THEN 1 ELSE 0 END), 0) AS Guests",
+		).
+		From("ChannelMembers").
+		Join("Users ON Users.Id = ChannelMembers.UserId").
+		Where(where).
+		Where(sq.Eq{"Users.DeleteAt": 0}).
+		GroupBy("ChannelMembers.ChannelId")
+	if err := ex.SelectBuilder(&counts, query); err != nil {
+		return errors.Wrap(err, "failed to count the ChannelMembers")
+	}
+
+	for _, count := range counts {
+		update := builder.
+			Update("ChannelCounters").
+			Set("MemberCount", sq.Expr("MemberCount + ?", sign*count.Members)).
+			Set("GuestCount", sq.Expr("GuestCount + ?", sign*count.Guests)).
+			Set("UpdateAt", model.GetMillis()).
+			Where(sq.Eq{"ChannelId": count.ChannelId})
+		if _, err := ex.ExecBuilder(update); err != nil {
+			return errors.Wrapf(err, "failed to update ChannelCounters with channelId=%s", count.ChannelId)
+		}
+	}
+
+	return nil
+}
+
+// addToChannelCounters adds the deltas to the counters of the channels of the memberships matching
+// where, e.g. when a user is deactivated or promoted.
+func addToChannelCounters(ex sqlxExecutor, builder sq.StatementBuilderType, where sq.Sqlizer, memberDelta, guestDelta int64) error {
+	// The subquery is expanded into the update, which replaces its placeholders.
+	memberships := sq.Select("ChannelId").From("ChannelMembers").Where(where)
+	update := builder.
+		Update("ChannelCounters").
+		Set("MemberCount", sq.Expr("MemberCount + ?", memberDelta)).
+		Set("GuestCount", sq.Expr("GuestCount + ?", guestDelta)).
+		Set("UpdateAt", model.GetMillis()).
+		Where(sq.Expr("ChannelId IN (?)", memberships))
+	if _, err := ex.ExecBuilder(update); err != nil {
+		return errors.Wrap(err, "failed to update ChannelCounters")
+	}
+
+	return nil
+}
+
+// addToPinnedPostCount adds delta to the count of pinned posts of the channel.
+func addToPinnedPostCount(ex sqlxExecutor, builder sq.StatementBuilderType, channelID string, delta int64) error {
+	update := builder.
+		Update("ChannelCounters").
+		Set("PinnedPostCount", sq.Expr("PinnedPostCount + ?", delta)).
+		Set("UpdateAt", model.GetMillis()).
+		Where(sq.Eq{"ChannelId": channelID})
+	if _, err := ex.ExecBuilder(update); err != nil {
+		return errors.Wrapf(err, "failed to update ChannelCounters with channelId=%s", channelID)
+	}
+
+	return nil
+}
@@ -87,6 +87,22 @@ func (s SqlCommandStore) GetByTeam(teamId string) ([]*model.Command, error) {
 	return commands, nil
 }
 
+// GetByCreator returns the commands created by the user, across all teams.
+func (s SqlCommandStore) GetByCreator(creatorId string) ([]*model.Command, error) {
+	commands := []*model.Command{}
+
+	sql, args, err := s.commandsQuery.
+		Where(sq.Eq{"CreatorId": creatorId, "DeleteAt": 0}).ToSql()
+	if err != nil {
+		return nil, errors.Wrapf(err, "commands_tosql")
+	}
+	if err := s.GetReplicaX().Select(&commands, sql, args...); err != nil {
+		return nil, errors.Wrapf(err, "select: creator_id=%s", creatorId)
+	}
+
+	return commands, nil
+}
+
 func (s SqlCommandStore) GetByTrigger(teamId string, trigger string) (*model.Command, error) {
 	var command model.Command
 	var triggerStr string
@@ -222,7 +238,7 @@ func (s SqlCommandStore) AnalyticsCommandCount(teamId string) (int64, error) {
 	}
 
 	var c int64
-	err = s.GetReplicaX().Get(&c, sql, args...)
+	err = s.GetAnalyticsReplicaX().Get(&c, sql, args...)
 	if err != nil {
 		return 0, errors.Wrapf(err, "unable to count the commands: team_id=%s", teamId)
 	}
@@ -321,6 +321,9 @@ func (s SqlComplianceStore) MessageExport(ctx context.Context, cursor model.Mess
 	if err := s.GetReplicaX().SelectCtx(ctx, &cposts, query, args...); err != nil {
 		return nil, cursor, errors.Wrap(err, "unable to export messages")
 	}
+	for _, cpost := range cposts {
+		cpost.SetEncryptedMessage()
+	}
 	if len(cposts) > 0 {
 		cursor.LastPostUpdateAt = *cposts[len(cposts)-1].PostUpdateAt
 		cursor.LastPostId = *cposts[len(cposts)-1].PostId
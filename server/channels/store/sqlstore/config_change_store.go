@@ -0,0 +1,133 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlConfigChangeStore struct {
+	*SqlStore
+}
+
+func newSqlConfigChangeStore(sqlStore *SqlStore) store.ConfigChangeStore {
+	return &SqlConfigChangeStore{
+		SqlStore: sqlStore,
+	}
+}
+
+// configChangeRow is a ConfigChange as stored in the database, the diffs and the config being
+// stored as JSON.
+type configChangeRow struct {
+	Id           string
+	UserId       string
+	CreateAt     int64
+	RollbackToId string
+	Diffs        string
+	Config       string
+}
+
+func (row *configChangeRow) toModel() (*model.ConfigChange, error) {
+	change := &model.ConfigChange{
+		Id:           row.Id,
+		UserId:       row.UserId,
+		CreateAt:     row.CreateAt,
+		RollbackToId: row.RollbackToId,
+	}
+
+	if err := json.Unmarshal([]byte(row.Diffs), &change.Diffs); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the diffs of ConfigChange with id=%s", row.Id)
+	}
+
+	if row.Config != "" {
+		if err := json.Unmarshal([]byte(row.Config), &change.Config); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode the config of ConfigChange with id=%s", row.Id)
+		}
+	}
+
+	return change, nil
+}
+
+func (s *SqlConfigChangeStore) Save(change *model.ConfigChange) (*model.ConfigChange, error) {
+	if change.Id != "" {
+		return nil, store.NewErrInvalidInput("ConfigChange", "Id", change.Id)
+	}
+
+	change.PreSave()
+	if err := change.IsValid(); err != nil {
+		return nil, err
+	}
+
+	diffs, err := json.Marshal(change.Diffs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode the diffs of ConfigChange with id=%s", change.Id)
+	}
+
+	cfg, err := json.Marshal(change.Config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode the config of ConfigChange with id=%s", change.Id)
+	}
+
+	query := s.getQueryBuilder().
+		Insert("ConfigChanges").
+		Columns("Id", "UserId", "CreateAt", "RollbackToId", "Diffs", "Config").
+		Values(change.Id, change.UserId, change.CreateAt, change.RollbackToId, string(diffs), string(cfg))
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ConfigChange with id=%s", change.Id)
+	}
+
+	return change, nil
+}
+
+// Get returns the change along with the config it saved.
+func (s *SqlConfigChangeStore) Get(id string) (*model.ConfigChange, error) {
+	query := s.getQueryBuilder().
+		Select("Id", "UserId", "CreateAt", "RollbackToId", "Diffs", "Config").
+		From("ConfigChanges").
+		Where(sq.Eq{"Id": id})
+
+	var row configChangeRow
+	if err := s.GetReplicaX().GetBuilder(&row, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ConfigChange", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get ConfigChange with id=%s", id)
+	}
+
+	return row.toModel()
+}
+
+// GetAll returns a page of the changes, the newest first. The configs they saved aren't loaded.
+func (s *SqlConfigChangeStore) GetAll(offset, limit int) ([]*model.ConfigChange, error) {
+	query := s.getQueryBuilder().
+		Select("Id", "UserId", "CreateAt", "RollbackToId", "Diffs").
+		From("ConfigChanges").
+		OrderBy("CreateAt DESC", "Id").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	rows := []*configChangeRow{}
+	if err := s.GetReplicaX().SelectBuilder(&rows, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get ConfigChanges")
+	}
+
+	changes := make([]*model.ConfigChange, 0, len(rows))
+	for _, row := range rows {
+		change, err := row.toModel()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
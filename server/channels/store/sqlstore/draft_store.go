@@ -5,6 +5,7 @@ package sqlstore
 
 import (
 	"database/sql"
+	"fmt"
 	"sync"
 
 	sq "github.com/mattermost/squirrel"
@@ -35,6 +36,7 @@ func draftSliceColumns() []string {
 		"FileIds",
 		"Props",
 		"Priority",
+		"Version",
 	}
 }
 
@@ -50,6 +52,7 @@ func draftToSlice(draft *model.Draft) []interface{} {
 		model.ArrayToJSON(draft.FileIds),
 		model.StringInterfaceToJSON(draft.Props),
 		model.StringInterfaceToJSON(draft.Priority),
+		draft.Version,
 	}
 }
 
@@ -125,16 +128,28 @@ func (s *SqlDraftStore) Update(draft *model.Draft) (*model.Draft, error) {
 		Set("FileIds", draft.FileIds).
 		Set("Priority", draft.Priority).
 		Set("DeleteAt", 0).
+		Set("Version", sq.Expr("Version + 1")).
 		Where(sq.Eq{
 			"UserId":    draft.UserId,
 			"ChannelId": draft.ChannelId,
 			"RootId":    draft.RootId,
+			"Version":   draft.Version,
 		})
 
-	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
 		return nil, errors.Wrapf(err, "failed to update Draft with channelid=%s", draft.ChannelId)
 	}
 
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get affected rows after updating Draft with channelid=%s", draft.ChannelId)
+	}
+	if rowsAffected == 0 {
+		return nil, store.NewErrConflict("Draft", nil, fmt.Sprintf("channelid=%s version=%d", draft.ChannelId, draft.Version))
+	}
+
+	draft.Version++
 	return draft, nil
 }
 
@@ -152,6 +167,7 @@ func (s *SqlDraftStore) GetDraftsForUser(userID, teamID string) ([]*model.Draft,
 			"Drafts.FileIds",
 			"Drafts.Props",
 			"Drafts.Priority",
+			"Drafts.Version",
 		).
 		From("Drafts").
 		InnerJoin("ChannelMembers ON ChannelMembers.ChannelId = Drafts.ChannelId").
@@ -4,6 +4,7 @@
 package sqlstore
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -106,6 +107,7 @@ func newSqlFileInfoStore(sqlStore *SqlStore, metrics einterfaces.MetricsInterfac
 		"Coalesce(FileInfo.Content, '') AS Content",
 		"Coalesce(FileInfo.RemoteId, '') AS RemoteId",
 		"FileInfo.Archived",
+		"FileInfo.ColdStorage",
 	}
 
 	return s
@@ -120,10 +122,10 @@ func (fs SqlFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
 	query := `
 		INSERT INTO FileInfo
 		(Id, CreatorId, PostId, ChannelId, CreateAt, UpdateAt, DeleteAt, Path, ThumbnailPath, PreviewPath,
-			Name, Extension, Size, MimeType, Width, Height, HasPreviewImage, MiniPreview, Content, RemoteId)
+			Name, Extension, Size, MimeType, Width, Height, HasPreviewImage, MiniPreview, Content, RemoteId, ColdStorage)
 		VALUES
 		(:Id, :CreatorId, :PostId, :ChannelId, :CreateAt, :UpdateAt, :DeleteAt, :Path, :ThumbnailPath, :PreviewPath,
-			:Name, :Extension, :Size, :MimeType, :Width, :Height, :HasPreviewImage, :MiniPreview, :Content, :RemoteId)
+			:Name, :Extension, :Size, :MimeType, :Width, :Height, :HasPreviewImage, :MiniPreview, :Content, :RemoteId, :ColdStorage)
 	`
 
 	if _, err := fs.GetMasterX().NamedExec(query, info); err != nil {
@@ -186,6 +188,7 @@ func (fs SqlFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error)
 			"MiniPreview":     info.MiniPreview,
 			"Content":         info.Content,
 			"RemoteId":        info.RemoteId,
+			"ColdStorage":     info.ColdStorage,
 		}).
 		Where(sq.Eq{"Id": info.Id}).
 		ToSql()
@@ -499,7 +502,7 @@ func (fs SqlFileInfoStore) PermanentDeleteByUser(userId string) (int64, error) {
 	return rowsAffected, nil
 }
 
-func (fs SqlFileInfoStore) Search(paramsList []*model.SearchParams, userId, teamId string, page, perPage int) (*model.FileInfoList, error) {
+func (fs SqlFileInfoStore) Search(ctx context.Context, paramsList []*model.SearchParams, userId, teamId string, page, perPage int) (*model.FileInfoList, error) {
 	// Since we don't support paging for DB search, we just return nothing for later pages
 	if page > 0 {
 		return model.NewFileInfoList(), nil
@@ -561,6 +564,14 @@ func (fs SqlFileInfoStore) Search(paramsList []*model.SearchParams, userId, team
 			query = query.Where(sq.NotEq{"FileInfo.Extension": params.ExcludedExtensions})
 		}
 
+		if len(params.FileTypes) != 0 {
+			query = query.Where(sq.Eq{"FileInfo.Extension": params.GetFileTypeExtensions()})
+		}
+
+		if len(params.ExcludedFileTypes) != 0 {
+			query = query.Where(sq.NotEq{"FileInfo.Extension": params.GetExcludedFileTypeExtensions()})
+		}
+
 		if len(params.ExcludedChannels) != 0 {
 			query = query.Where(sq.NotEq{"C.Id": params.ExcludedChannels})
 		}
@@ -679,7 +690,7 @@ func (fs SqlFileInfoStore) Search(paramsList []*model.SearchParams, userId, team
 	list := model.NewFileInfoList()
 
 	items := []fileInfoWithChannelID{}
-	err = fs.GetSearchReplicaX().Select(&items, queryString, args...)
+	err = fs.GetSearchReplicaX().withContext(ctx).Select(&items, queryString, args...)
 	if err != nil {
 		mlog.Warn("Query error searching files.", mlog.Err(err))
 		// Don't return the error to the caller as it is of no use to the user. Instead return an empty set of search results.
@@ -799,3 +810,62 @@ func (fs *SqlFileInfoStore) GetUptoNSizeFileTime(n int64) (int64, error) {
 
 	return createAt, nil
 }
+
+func coldStorageCandidates(before int64) sq.And {
+	return sq.And{
+		sq.Eq{"FileInfo.ColdStorage": false},
+		sq.Eq{"FileInfo.DeleteAt": 0},
+		sq.Lt{"FileInfo.CreateAt": before},
+	}
+}
+
+func (fs SqlFileInfoStore) GetForColdStorage(before, afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error) {
+	query := fs.getQueryBuilder().
+		Select(fs.queryFields...).
+		From("FileInfo").
+		Where(coldStorageCandidates(before)).
+		Where(sq.Or{
+			sq.Gt{"FileInfo.CreateAt": afterCreateAt},
+			sq.And{
+				sq.Eq{"FileInfo.CreateAt": afterCreateAt},
+				sq.Gt{"FileInfo.Id": afterID},
+			},
+		}).
+		OrderBy("FileInfo.CreateAt ASC, FileInfo.Id ASC").
+		Limit(uint64(limit))
+
+	infos := []*model.FileInfo{}
+	if err := fs.GetReplicaX().SelectBuilder(&infos, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find FileInfos for cold storage")
+	}
+
+	return infos, nil
+}
+
+func (fs SqlFileInfoStore) CountForColdStorage(before int64) (int64, error) {
+	query := fs.getQueryBuilder().
+		Select("COUNT(*)").
+		From("FileInfo").
+		Where(coldStorageCandidates(before))
+
+	var count int64
+	if err := fs.GetReplicaX().GetBuilder(&count, query); err != nil {
+		return 0, errors.Wrap(err, "failed to count FileInfos for cold storage")
+	}
+
+	return count, nil
+}
+
+func (fs SqlFileInfoStore) SetColdStorageForPath(path string) error {
+	query := fs.getQueryBuilder().
+		Update("FileInfo").
+		Set("ColdStorage", true).
+		Set("UpdateAt", model.GetMillis()).
+		Where(sq.Eq{"Path": path})
+
+	if _, err := fs.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to set ColdStorage for FileInfo with path=%s", path)
+	}
+
+	return nil
+}
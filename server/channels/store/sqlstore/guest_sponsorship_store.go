@@ -0,0 +1,180 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlGuestSponsorshipStore struct {
+	*SqlStore
+}
+
+func guestSponsorshipSliceColumns(prefix ...string) []string {
+	var p string
+	if len(prefix) == 1 {
+		p = prefix[0] + "."
+	}
+
+	return []string{
+		p + "UserId",
+		p + "SponsorId",
+		p + "ExpiresAt",
+		p + "CreateAt",
+		p + "UpdateAt",
+	}
+}
+
+func newSqlGuestSponsorshipStore(sqlStore *SqlStore) store.GuestSponsorshipStore {
+	return &SqlGuestSponsorshipStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlGuestSponsorshipStore) Save(sponsorship *model.GuestSponsorship) (*model.GuestSponsorship, error) {
+	sponsorship.PreSave()
+	if err := sponsorship.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("GuestSponsorships").
+		Columns(guestSponsorshipSliceColumns()...).
+		Values(sponsorship.UserId, sponsorship.SponsorId, sponsorship.ExpiresAt, sponsorship.CreateAt, sponsorship.UpdateAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save GuestSponsorship for userId=%s", sponsorship.UserId)
+	}
+
+	return sponsorship, nil
+}
+
+func (s *SqlGuestSponsorshipStore) Update(sponsorship *model.GuestSponsorship) (*model.GuestSponsorship, error) {
+	sponsorship.PreUpdate()
+	if err := sponsorship.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Update("GuestSponsorships").
+		Set("SponsorId", sponsorship.SponsorId).
+		Set("ExpiresAt", sponsorship.ExpiresAt).
+		Set("UpdateAt", sponsorship.UpdateAt).
+		Where(sq.Eq{"UserId": sponsorship.UserId})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update GuestSponsorship for userId=%s", sponsorship.UserId)
+	}
+
+	if rows, err := result.RowsAffected(); err != nil {
+		return nil, errors.Wrap(err, "failed to get affected rows")
+	} else if rows == 0 {
+		return nil, store.NewErrNotFound("GuestSponsorship", sponsorship.UserId)
+	}
+
+	return sponsorship, nil
+}
+
+func (s *SqlGuestSponsorshipStore) Get(userID string) (*model.GuestSponsorship, error) {
+	query := s.getQueryBuilder().
+		Select(guestSponsorshipSliceColumns()...).
+		From("GuestSponsorships").
+		Where(sq.Eq{"UserId": userID})
+
+	var sponsorship model.GuestSponsorship
+	if err := s.GetReplicaX().GetBuilder(&sponsorship, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("GuestSponsorship", userID)
+		}
+		return nil, errors.Wrapf(err, "failed to get GuestSponsorship for userId=%s", userID)
+	}
+
+	return &sponsorship, nil
+}
+
+// GetForSponsor returns a page of the sponsorships of the sponsor, the soonest to expire first
+// and the guests that never expire last.
+func (s *SqlGuestSponsorshipStore) GetForSponsor(sponsorID string, offset int, limit int) ([]*model.GuestSponsorship, error) {
+	query := s.getQueryBuilder().
+		Select(guestSponsorshipSliceColumns()...).
+		From("GuestSponsorships").
+		Where(sq.Eq{"SponsorId": sponsorID}).
+		OrderBy("CASE WHEN ExpiresAt = 0 THEN 1 ELSE 0 END", "ExpiresAt", "UserId").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	sponsorships := []*model.GuestSponsorship{}
+	if err := s.GetReplicaX().SelectBuilder(&sponsorships, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get GuestSponsorships for sponsorId=%s", sponsorID)
+	}
+
+	return sponsorships, nil
+}
+
+// GetExpired returns up to limit sponsorships of active users that expired at or before now.
+func (s *SqlGuestSponsorshipStore) GetExpired(now int64, limit int) ([]*model.GuestSponsorship, error) {
+	query := s.getQueryBuilder().
+		Select(guestSponsorshipSliceColumns("gs")...).
+		From("GuestSponsorships gs").
+		Join("Users u ON u.Id = gs.UserId").
+		Where(sq.And{
+			sq.Gt{"gs.ExpiresAt": 0},
+			sq.LtOrEq{"gs.ExpiresAt": now},
+			sq.Eq{"u.DeleteAt": 0},
+		}).
+		OrderBy("gs.ExpiresAt", "gs.UserId").
+		Limit(uint64(limit))
+
+	sponsorships := []*model.GuestSponsorship{}
+	if err := s.GetReplicaX().SelectBuilder(&sponsorships, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get expired GuestSponsorships")
+	}
+
+	return sponsorships, nil
+}
+
+// GetSponsorReport returns a page of the sponsors of active guests, the sponsors with the most
+// guests first.
+func (s *SqlGuestSponsorshipStore) GetSponsorReport(offset int, limit int) ([]*model.GuestSponsorReport, error) {
+	query := s.getQueryBuilder().
+		Select(
+			"gs.SponsorId AS SponsorId",
+			"COUNT(gs.UserId) AS GuestCount",
+			"COALESCE(MIN(CASE WHEN gs.ExpiresAt > 0 THEN gs.ExpiresAt END), 0) AS NextExpiresAt",
+			"SUM(CASE WHEN gs.ExpiresAt = 0 THEN 1 ELSE 0 END) AS NonExpiringGuests",
+		).
+		From("GuestSponsorships gs").
+		Join("Users u ON u.Id = gs.UserId").
+		Where(sq.Eq{"u.DeleteAt": 0}).
+		GroupBy("gs.SponsorId").
+		OrderBy("GuestCount DESC", "gs.SponsorId").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	report := []*model.GuestSponsorReport{}
+	if err := s.GetReplicaX().SelectBuilder(&report, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get guest sponsor report")
+	}
+
+	return report, nil
+}
+
+func (s *SqlGuestSponsorshipStore) PermanentDeleteByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("GuestSponsorships").
+		Where(sq.Eq{"UserId": userID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete GuestSponsorship for userId=%s", userID)
+	}
+
+	return nil
+}
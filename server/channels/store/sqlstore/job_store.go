@@ -223,6 +223,25 @@ func (jss SqlJobStore) GetAllByTypeAndStatus(jobType string, status string) ([]*
 	return jobs, nil
 }
 
+func (jss SqlJobStore) GetAllByTypeAndStatusPage(jobType string, status string, offset int, limit int) ([]*model.Job, error) {
+	query, args, err := jss.getQueryBuilder().
+		Select("*").
+		From("Jobs").
+		Where(sq.Eq{"Type": jobType, "Status": status}).
+		OrderBy("CreateAt DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "job_tosql")
+	}
+
+	jobs := []*model.Job{}
+	if err = jss.GetReplicaX().Select(&jobs, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Jobs with type=%s and status=%s", jobType, status)
+	}
+	return jobs, nil
+}
+
 func (jss SqlJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error) {
 	query, args, err := jss.getQueryBuilder().
 		Select("*").
@@ -248,7 +267,7 @@ func (jss SqlJobStore) GetAllByStatus(status string) ([]*model.Job, error) {
 		Select("*").
 		From("Jobs").
 		Where(sq.Eq{"Status": status}).
-		OrderBy("CreateAt ASC").ToSql()
+		OrderBy("Priority DESC", "CreateAt ASC").ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "job_tosql")
 	}
@@ -342,3 +361,104 @@ func (jss SqlJobStore) Cleanup(expiryTime int64, batchSize int) error {
 
 	return nil
 }
+
+// GetStatsByType summarizes the jobs created since the given time by type. The settings and the
+// recent failures of the types are left to the caller.
+func (jss SqlJobStore) GetStatsByType(since int64) ([]*model.JobTypeStats, error) {
+	rows := []struct {
+		Type          string
+		Status        string
+		Count         int64
+		Started       int64
+		TotalDuration int64
+		MaxDuration   int64
+		LastStartAt   int64
+	}{}
+	query := jss.getQueryBuilder().
+		Select(
+			"Type",
+			"Status",
+			"COUNT(*) AS Count",
+			"COALESCE(SUM(CASE WHEN StartAt > 0 THEN 1 ELSE 0 END), 0) AS Started",
+			"COALESCE(SUM(CASE WHEN StartAt > 0 THEN LastActivityAt - StartAt ELSE 0 END), 0) AS TotalDuration",
+			"COALESCE(MAX(CASE WHEN StartAt > 0 THEN LastActivityAt - StartAt ELSE 0 END), 0) AS MaxDuration",
+			"COALESCE(MAX(StartAt), 0) AS LastStartAt",
+		).
+		From("Jobs").
+		Where(sq.GtOrEq{"CreateAt": since}).
+		GroupBy("Type", "Status").
+		OrderBy("Type")
+	if err := jss.GetReplicaX().SelectBuilder(&rows, query); err != nil {
+		return nil, errors.Wrap(err, "failed to count Jobs by type and status")
+	}
+
+	statsList := []*model.JobTypeStats{}
+	finished := map[string]int64{}
+	totalDurations := map[string]int64{}
+	var stats *model.JobTypeStats
+	for _, row := range rows {
+		if stats == nil || stats.JobType != row.Type {
+			stats = &model.JobTypeStats{JobType: row.Type, StatusCounts: map[string]int64{}}
+			statsList = append(statsList, stats)
+		}
+		stats.StatusCounts[row.Status] = row.Count
+		if row.LastStartAt > stats.LastStartAt {
+			stats.LastStartAt = row.LastStartAt
+		}
+
+		switch row.Status {
+		case model.JobStatusSuccess, model.JobStatusWarning, model.JobStatusError, model.JobStatusCanceled:
+			finished[row.Type] += row.Started
+			totalDurations[row.Type] += row.TotalDuration
+			if row.MaxDuration > stats.MaxDuration {
+				stats.MaxDuration = row.MaxDuration
+			}
+		}
+	}
+
+	for _, stats := range statsList {
+		if finished[stats.JobType] > 0 {
+			stats.AverageDuration = totalDurations[stats.JobType] / finished[stats.JobType]
+		}
+	}
+
+	return statsList, nil
+}
+
+var jobTypeSettingsColumns = []string{"JobType", "Priority", "MaxConcurrency", "Paused", "Schedule", "UpdateAt"}
+
+func (jss SqlJobStore) GetTypeSettings() ([]*model.JobTypeSettings, error) {
+	query := jss.getQueryBuilder().
+		Select(jobTypeSettingsColumns...).
+		From("JobTypeSettings").
+		OrderBy("JobType")
+
+	settings := []*model.JobTypeSettings{}
+	if err := jss.GetReplicaX().SelectBuilder(&settings, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get JobTypeSettings")
+	}
+
+	return settings, nil
+}
+
+func (jss SqlJobStore) SaveTypeSettings(settings *model.JobTypeSettings) (*model.JobTypeSettings, error) {
+	settings.PreSave()
+	if err := settings.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := jss.getQueryBuilder().
+		Insert("JobTypeSettings").
+		Columns(jobTypeSettingsColumns...).
+		Values(settings.JobType, settings.Priority, settings.MaxConcurrency, settings.Paused, settings.Schedule, settings.UpdateAt)
+	if jss.DriverName() == model.DatabaseDriverMysql {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE Priority = VALUES(Priority), MaxConcurrency = VALUES(MaxConcurrency), Paused = VALUES(Paused), Schedule = VALUES(Schedule), UpdateAt = VALUES(UpdateAt)")
+	} else {
+		query = query.Suffix("ON CONFLICT (jobtype) DO UPDATE SET Priority = excluded.Priority, MaxConcurrency = excluded.MaxConcurrency, Paused = excluded.Paused, Schedule = excluded.Schedule, UpdateAt = excluded.UpdateAt")
+	}
+	if _, err := jss.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save JobTypeSettings with jobType=%s", settings.JobType)
+	}
+
+	return settings, nil
+}
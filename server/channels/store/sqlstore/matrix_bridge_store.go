@@ -0,0 +1,152 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlMatrixBridgeStore struct {
+	*SqlStore
+}
+
+func newSqlMatrixBridgeStore(sqlStore *SqlStore) store.MatrixBridgeStore {
+	return &SqlMatrixBridgeStore{sqlStore}
+}
+
+func (s *SqlMatrixBridgeStore) roomsSelectQuery() sq.SelectBuilder {
+	return s.getQueryBuilder().
+		Select("ChannelId", "RoomId", "CreatorId", "CreateAt").
+		From("MatrixRooms")
+}
+
+func (s *SqlMatrixBridgeStore) eventsSelectQuery() sq.SelectBuilder {
+	return s.getQueryBuilder().
+		Select("PostId", "EventId", "RoomId", "CreateAt").
+		From("MatrixEvents")
+}
+
+func (s *SqlMatrixBridgeStore) SaveRoom(room *model.MatrixRoom) (*model.MatrixRoom, error) {
+	room.PreSave()
+	if err := room.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("MatrixRooms").
+		Columns("ChannelId", "RoomId", "CreatorId", "CreateAt").
+		Values(room.ChannelId, room.RoomId, room.CreatorId, room.CreateAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "matrixrooms_pkey", "idx_matrixrooms_roomid", "matrixrooms_roomid_key"}) {
+			return nil, store.NewErrConflict("MatrixRoom", err, "channelId="+room.ChannelId+", roomId="+room.RoomId)
+		}
+		return nil, errors.Wrapf(err, "failed to save MatrixRoom with channelId=%s", room.ChannelId)
+	}
+
+	return room, nil
+}
+
+func (s *SqlMatrixBridgeStore) getRoom(query sq.SelectBuilder, id string) (*model.MatrixRoom, error) {
+	var room model.MatrixRoom
+	if err := s.GetReplicaX().GetBuilder(&room, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("MatrixRoom", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get MatrixRoom with id=%s", id)
+	}
+
+	return &room, nil
+}
+
+func (s *SqlMatrixBridgeStore) GetRoomForChannel(channelID string) (*model.MatrixRoom, error) {
+	return s.getRoom(s.roomsSelectQuery().Where(sq.Eq{"ChannelId": channelID}), channelID)
+}
+
+func (s *SqlMatrixBridgeStore) GetRoom(roomID string) (*model.MatrixRoom, error) {
+	return s.getRoom(s.roomsSelectQuery().Where(sq.Eq{"RoomId": roomID}), roomID)
+}
+
+func (s *SqlMatrixBridgeStore) GetAllRooms() ([]*model.MatrixRoom, error) {
+	rooms := []*model.MatrixRoom{}
+	if err := s.GetReplicaX().SelectBuilder(&rooms, s.roomsSelectQuery().OrderBy("CreateAt")); err != nil {
+		return nil, errors.Wrap(err, "failed to get MatrixRooms")
+	}
+
+	return rooms, nil
+}
+
+func (s *SqlMatrixBridgeStore) DeleteRoom(channelID string) error {
+	room, err := s.GetRoomForChannel(channelID)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if _, err = transaction.ExecBuilder(s.getQueryBuilder().Delete("MatrixEvents").Where(sq.Eq{"RoomId": room.RoomId})); err != nil {
+		return errors.Wrapf(err, "failed to delete MatrixEvents with roomId=%s", room.RoomId)
+	}
+
+	if _, err = transaction.ExecBuilder(s.getQueryBuilder().Delete("MatrixRooms").Where(sq.Eq{"ChannelId": channelID})); err != nil {
+		return errors.Wrapf(err, "failed to delete MatrixRoom with channelId=%s", channelID)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s *SqlMatrixBridgeStore) SaveEvent(event *model.MatrixEvent) (*model.MatrixEvent, error) {
+	event.PreSave()
+	if err := event.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("MatrixEvents").
+		Columns("PostId", "EventId", "RoomId", "CreateAt").
+		Values(event.PostId, event.EventId, event.RoomId, event.CreateAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "matrixevents_pkey", "idx_matrixevents_eventid", "matrixevents_eventid_key"}) {
+			return nil, store.NewErrConflict("MatrixEvent", err, "postId="+event.PostId+", eventId="+event.EventId)
+		}
+		return nil, errors.Wrapf(err, "failed to save MatrixEvent with postId=%s", event.PostId)
+	}
+
+	return event, nil
+}
+
+func (s *SqlMatrixBridgeStore) getEvent(query sq.SelectBuilder, id string) (*model.MatrixEvent, error) {
+	var event model.MatrixEvent
+	if err := s.GetReplicaX().GetBuilder(&event, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("MatrixEvent", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get MatrixEvent with id=%s", id)
+	}
+
+	return &event, nil
+}
+
+func (s *SqlMatrixBridgeStore) GetEventForPost(postID string) (*model.MatrixEvent, error) {
+	return s.getEvent(s.eventsSelectQuery().Where(sq.Eq{"PostId": postID}), postID)
+}
+
+func (s *SqlMatrixBridgeStore) GetEvent(eventID string) (*model.MatrixEvent, error) {
+	return s.getEvent(s.eventsSelectQuery().Where(sq.Eq{"EventId": eventID}), eventID)
+}
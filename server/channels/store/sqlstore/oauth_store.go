@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	sq "github.com/mattermost/squirrel"
 	"github.com/pkg/errors"
 
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -78,6 +79,19 @@ func (as SqlOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error)
 	return app, nil
 }
 
+// UpdateAppCreator changes the user an OAuth app belongs to, which UpdateApp keeps.
+func (as SqlOAuthStore) UpdateAppCreator(appId string, creatorId string) error {
+	result, err := as.GetMasterX().Exec(`UPDATE OAuthApps SET CreatorId=?, UpdateAt=? WHERE Id=?`, creatorId, model.GetMillis(), appId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update the creator of OAuthApp with id=%s", appId)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return store.NewErrNotFound("OAuthApp", appId)
+	}
+
+	return nil
+}
+
 func (as SqlOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
 	var app model.OAuthApp
 	if err := as.GetReplicaX().Get(&app, `SELECT * FROM OAuthApps WHERE Id=?`, id); err != nil {
@@ -223,6 +237,34 @@ func (as SqlOAuthStore) RemoveAllAccessData() error {
 	return nil
 }
 
+func (as SqlOAuthStore) SaveRotatedRefreshToken(token *model.OAuthRotatedRefreshToken) error {
+	if _, err := as.GetMasterX().NamedExec(`INSERT INTO OAuthRotatedRefreshTokens
+		(RefreshToken, ClientId, UserId, RotatedAt)
+		VALUES
+		(:RefreshToken, :ClientId, :UserId, :RotatedAt)`, token); err != nil {
+		return errors.Wrapf(err, "failed to save OAuthRotatedRefreshToken with userId=%s and clientId=%s", token.UserId, token.ClientId)
+	}
+	return nil
+}
+
+func (as SqlOAuthStore) GetRotatedRefreshToken(refreshToken string) (*model.OAuthRotatedRefreshToken, error) {
+	var token model.OAuthRotatedRefreshToken
+	if err := as.GetMasterX().Get(&token, "SELECT * FROM OAuthRotatedRefreshTokens WHERE RefreshToken = ?", refreshToken); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("OAuthRotatedRefreshToken", refreshToken)
+		}
+		return nil, errors.Wrap(err, "failed to get OAuthRotatedRefreshToken")
+	}
+	return &token, nil
+}
+
+func (as SqlOAuthStore) RemoveRotatedRefreshTokens(userID, clientID string) error {
+	if _, err := as.GetMasterX().Exec("DELETE FROM OAuthRotatedRefreshTokens WHERE UserId = ? AND ClientId = ?", userID, clientID); err != nil {
+		return errors.Wrapf(err, "failed to delete OAuthRotatedRefreshTokens with userId=%s and clientId=%s", userID, clientID)
+	}
+	return nil
+}
+
 func (as SqlOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
 	authData.PreSave()
 	if err := authData.IsValid(); err != nil {
@@ -230,9 +272,9 @@ func (as SqlOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData,
 	}
 
 	if _, err := as.GetMasterX().NamedExec(`INSERT INTO OAuthAuthData
-		(ClientId, UserId, Code, ExpiresIn, CreateAt, RedirectUri, State, Scope)
+		(ClientId, UserId, Code, ExpiresIn, CreateAt, RedirectUri, State, Scope, CodeChallenge, CodeChallengeMethod, Nonce)
 		VALUES
-		(:ClientId, :UserId, :Code, :ExpiresIn, :CreateAt, :RedirectUri, :State, :Scope)`, authData); err != nil {
+		(:ClientId, :UserId, :Code, :ExpiresIn, :CreateAt, :RedirectUri, :State, :Scope, :CodeChallenge, :CodeChallengeMethod, :Nonce)`, authData); err != nil {
 		return nil, errors.Wrap(err, "failed to save AuthData")
 	}
 	return authData, nil
@@ -274,6 +316,71 @@ func (as SqlOAuthStore) PermanentDeleteAuthDataByUser(userId string) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to delete OAuthAccessData with userId=%s", userId)
 	}
+
+	_, err = as.GetMasterX().Exec("DELETE FROM OAuthConnections WHERE UserId = ?", userId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete OAuthConnections with userId=%s", userId)
+	}
+
+	_, err = as.GetMasterX().Exec("DELETE FROM OAuthRotatedRefreshTokens WHERE UserId = ?", userId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete OAuthRotatedRefreshTokens with userId=%s", userId)
+	}
+	return nil
+}
+
+func (as SqlOAuthStore) SaveConnection(connection *model.OAuthConnection) (*model.OAuthConnection, error) {
+	connection.PreSave()
+	if err := connection.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := as.getQueryBuilder().
+		Insert("OAuthConnections").
+		Columns("UserId", "Service", "AccessToken", "RefreshToken", "ExpiresAt", "CreateAt", "UpdateAt").
+		Values(connection.UserId, connection.Service, connection.AccessToken, connection.RefreshToken, connection.ExpiresAt, connection.CreateAt, connection.UpdateAt)
+
+	if as.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE AccessToken = ?, RefreshToken = ?, ExpiresAt = ?, UpdateAt = ?",
+			connection.AccessToken, connection.RefreshToken, connection.ExpiresAt, connection.UpdateAt))
+	} else if as.DriverName() == model.DatabaseDriverPostgres {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (userid, service) DO UPDATE SET AccessToken = ?, RefreshToken = ?, ExpiresAt = ?, UpdateAt = ?",
+			connection.AccessToken, connection.RefreshToken, connection.ExpiresAt, connection.UpdateAt))
+	} else {
+		return nil, store.NewErrNotImplemented("failed to save OAuthConnection because of missing driver")
+	}
+
+	if _, err := as.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save OAuthConnection with userId=%s and service=%s", connection.UserId, connection.Service)
+	}
+	return connection, nil
+}
+
+func (as SqlOAuthStore) GetConnection(userID, service string) (*model.OAuthConnection, error) {
+	var connection model.OAuthConnection
+
+	query := as.getQueryBuilder().
+		Select("UserId", "Service", "AccessToken", "RefreshToken", "ExpiresAt", "CreateAt", "UpdateAt").
+		From("OAuthConnections").
+		Where(sq.Eq{"UserId": userID, "Service": service})
+
+	if err := as.GetReplicaX().GetBuilder(&connection, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("OAuthConnection", userID+"/"+service)
+		}
+		return nil, errors.Wrapf(err, "failed to get OAuthConnection with userId=%s and service=%s", userID, service)
+	}
+	return &connection, nil
+}
+
+func (as SqlOAuthStore) RemoveConnection(userID, service string) error {
+	query := as.getQueryBuilder().
+		Delete("OAuthConnections").
+		Where(sq.Eq{"UserId": userID, "Service": service})
+
+	if _, err := as.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete OAuthConnection with userId=%s and service=%s", userID, service)
+	}
 	return nil
 }
 
@@ -305,6 +412,10 @@ func (as SqlOAuthStore) deleteOAuthTokens(transaction *sqlxTxWrapper, clientId s
 		return errors.Wrapf(err, "failed to delete OAuthAccessData with id=%s", clientId)
 	}
 
+	if _, err := transaction.Exec("DELETE FROM OAuthRotatedRefreshTokens WHERE ClientId = ?", clientId); err != nil {
+		return errors.Wrapf(err, "failed to delete OAuthRotatedRefreshTokens with clientId=%s", clientId)
+	}
+
 	return as.deleteAppExtras(transaction, clientId)
 }
 
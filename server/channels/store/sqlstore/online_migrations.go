@@ -0,0 +1,500 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattermost/morph"
+	"github.com/mattermost/morph/drivers"
+	"github.com/mattermost/morph/models"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/db"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// onlineMigrationMinRows is the estimated number of rows from which the online migrations of a
+// table are deferred to the background rather than delaying the start of the server.
+var onlineMigrationMinRows int64 = 1000000
+
+// onlineMigrationHeader marks the migrations changing the indexes of a large table online, as the
+// first line of their up script: "-- online: <Table>". Their statements are run outside of a
+// transaction, for Postgres to build the indexes concurrently and MySQL to build them in place
+// without locking the table.
+//
+// The later migrations are applied while the online ones are deferred, the migrations of the same
+// table waiting for them though. An online migration must thus only change the indexes of its
+// table, which the migrations of the other tables can't depend on.
+var onlineMigrationHeader = regexp.MustCompile(`^--\s*online:\s*(\w+)`)
+
+// onlineMigration is a migration to apply online, on its table.
+type onlineMigration struct {
+	migration *models.Migration
+	table     string
+}
+
+func newOnlineMigration(migration *models.Migration) *onlineMigration {
+	if migration.Direction != models.Up {
+		return nil
+	}
+
+	matches := onlineMigrationHeader.FindStringSubmatch(migration.Query())
+	if matches == nil {
+		return nil
+	}
+
+	return &onlineMigration{migration: migration, table: matches[1]}
+}
+
+// referencesTable tells whether the script of the migration names the table.
+func (online *onlineMigration) referencesTable(migration *models.Migration) bool {
+	return regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(online.table) + `\b`).MatchString(migration.Query())
+}
+
+// applyMigrations applies the pending migrations. The online ones of the large tables are deferred
+// to the background, the migrations after them still being applied unless they name the table of
+// a deferred migration, which is then applied first.
+func (ss *SqlStore) applyMigrations(engine *morph.Morph, driver drivers.Driver) error {
+	pending, err := engine.Diff(models.Up)
+	if err != nil {
+		return err
+	}
+
+	var deferred []*onlineMigration
+	for _, migration := range pending {
+		online := newOnlineMigration(migration)
+
+		// The migrations of the table of a deferred migration could depend on its indexes, or
+		// change them.
+		remaining := deferred[:0]
+		for _, previous := range deferred {
+			if online != nil || !previous.referencesTable(migration) {
+				remaining = append(remaining, previous)
+				continue
+			}
+
+			mlog.Info("Applying the deferred online migration a later migration depends on", mlog.String("migration", previous.migration.RawName), mlog.String("dependent_migration", migration.RawName))
+			if err := ss.applyOnlineMigration(context.Background(), driver, previous); err != nil {
+				return err
+			}
+		}
+		deferred = remaining
+
+		if online == nil {
+			if err := ss.applyMigration(driver, migration); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rows, err := ss.estimateTableRows(online.table)
+		if err != nil {
+			return err
+		}
+
+		if rows >= onlineMigrationMinRows {
+			mlog.Info("Deferring the online migration of a large table to the background", mlog.String("migration", migration.RawName), mlog.String("table", online.table), mlog.Int64("rows", rows))
+			deferred = append(deferred, online)
+			continue
+		}
+
+		if err := ss.applyOnlineMigration(context.Background(), driver, online); err != nil {
+			return err
+		}
+	}
+
+	ss.onlineMigrationsMut.Lock()
+	ss.onlineMigrationsPending = append(ss.onlineMigrationsPending, deferred...)
+	ss.onlineMigrationsMut.Unlock()
+
+	return nil
+}
+
+func (ss *SqlStore) applyMigration(driver drivers.Driver, migration *models.Migration) error {
+	start := time.Now()
+	if err := driver.Apply(migration, true); err != nil {
+		return err
+	}
+
+	mlog.Info("Applied migration", mlog.String("migration", migration.RawName), mlog.Duration("duration", time.Since(start)))
+
+	return nil
+}
+
+// applyOnlineMigration runs the statements of the migration one by one, outside of a transaction,
+// then records it as applied.
+func (ss *SqlStore) applyOnlineMigration(ctx context.Context, driver drivers.Driver, online *onlineMigration) error {
+	migration := online.migration
+	status := ss.setOnlineMigrationRunning(online)
+
+	err := ss.runOnlineMigration(ctx, online)
+	if err == nil {
+		// The version is recorded through the driver, in its migrations table. The statements of
+		// the migration already ran, without its transaction.
+		err = driver.Apply(&models.Migration{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			RawName:   migration.RawName,
+			Direction: migration.Direction,
+			Bytes:     []byte("SELECT 1;"),
+		}, true)
+	}
+
+	ss.onlineMigrationsMut.Lock()
+	defer ss.onlineMigrationsMut.Unlock()
+
+	status.EndAt = model.GetMillis()
+	if err != nil {
+		status.State = model.OnlineMigrationStateFailed
+		status.Error = err.Error()
+		return errors.Wrapf(err, "failed to apply the online migration %s", migration.RawName)
+	}
+
+	status.State = model.OnlineMigrationStateCompleted
+	status.Progress = 1
+	status.EstimatedSecondsLeft = 0
+	mlog.Info("Applied online migration", mlog.String("migration", migration.RawName), mlog.String("table", online.table), mlog.Duration("duration", time.Duration(status.EndAt-status.StartAt)*time.Millisecond))
+
+	return nil
+}
+
+func (ss *SqlStore) runOnlineMigration(ctx context.Context, online *onlineMigration) error {
+	// A single connection keeps the session of the statements, e.g. the variables of MySQL.
+	conn, err := ss.GetMasterX().DB.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get a connection")
+	}
+	defer conn.Close()
+
+	if ss.DriverName() == model.DatabaseDriverPostgres {
+		// An interrupted concurrent build leaves an invalid index behind, which CREATE INDEX
+		// CONCURRENTLY IF NOT EXISTS would keep. Only one server builds the indexes at a time,
+		// holding the lock of the migrations.
+		var invalidIndexes []string
+		rows, err := conn.QueryContext(ctx, `SELECT quote_ident(c.relname)
+			FROM pg_index i JOIN pg_class c ON c.oid = i.indexrelid
+			WHERE i.indrelid = to_regclass($1) AND NOT i.indisvalid`, strings.ToLower(online.table))
+		if err != nil {
+			return errors.Wrap(err, "failed to find the invalid indexes")
+		}
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				rows.Close()
+				return errors.Wrap(err, "failed to find the invalid indexes")
+			}
+			invalidIndexes = append(invalidIndexes, name)
+		}
+		rows.Close()
+
+		for _, name := range invalidIndexes {
+			mlog.Warn("Dropping the invalid index left by an interrupted online migration", mlog.String("index", name))
+			if _, err := conn.ExecContext(ctx, "DROP INDEX CONCURRENTLY IF EXISTS "+name); err != nil {
+				return errors.Wrapf(err, "failed to drop the invalid index %s", name)
+			}
+		}
+	}
+
+	for _, statement := range splitSQLStatements(online.migration.Query()) {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to run %q", statement)
+		}
+	}
+
+	return nil
+}
+
+// splitSQLStatements splits the script on the semicolons ending its statements, skipping the
+// comments and the semicolons quoted in strings.
+func splitSQLStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	var quote rune
+
+	lines := strings.Split(script, "\n")
+	for _, line := range lines {
+		if quote == 0 && strings.HasPrefix(strings.TrimSpace(line), "--") {
+			continue
+		}
+
+		for _, r := range line {
+			switch {
+			case quote != 0:
+				if r == quote {
+					quote = 0
+				}
+			case r == '\'' || r == '"' || r == '`':
+				quote = r
+			case r == ';':
+				if statement := strings.TrimSpace(current.String()); statement != "" {
+					statements = append(statements, statement)
+				}
+				current.Reset()
+				continue
+			}
+			current.WriteRune(r)
+		}
+		current.WriteRune('\n')
+	}
+
+	if statement := strings.TrimSpace(current.String()); statement != "" {
+		statements = append(statements, statement)
+	}
+
+	return statements
+}
+
+// estimateTableRows returns the number of rows of the table estimated from the statistics of the
+// database, the tables being too large to be counted.
+func (ss *SqlStore) estimateTableRows(table string) (int64, error) {
+	var rows int64
+	var err error
+	if ss.DriverName() == model.DatabaseDriverPostgres {
+		err = ss.GetMasterX().Get(&rows, "SELECT COALESCE(MAX(reltuples), 0)::bigint FROM pg_class WHERE oid = to_regclass($1)", strings.ToLower(table))
+	} else {
+		err = ss.GetMasterX().Get(&rows, "SELECT COALESCE(MAX(TABLE_ROWS), 0) FROM information_schema.TABLES WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?", table)
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to estimate the rows of %s", table)
+	}
+
+	return rows, nil
+}
+
+func (ss *SqlStore) setOnlineMigrationRunning(online *onlineMigration) *model.OnlineMigrationStatus {
+	ss.onlineMigrationsMut.Lock()
+	defer ss.onlineMigrationsMut.Unlock()
+
+	if ss.onlineMigrationsStatus == nil {
+		ss.onlineMigrationsStatus = map[int]*model.OnlineMigrationStatus{}
+	}
+
+	status := &model.OnlineMigrationStatus{
+		Version:              int(online.migration.Version),
+		Name:                 online.migration.Name,
+		Table:                online.table,
+		State:                model.OnlineMigrationStateRunning,
+		StartAt:              model.GetMillis(),
+		Progress:             -1,
+		EstimatedSecondsLeft: -1,
+	}
+	ss.onlineMigrationsStatus[status.Version] = status
+
+	return status
+}
+
+// startOnlineMigrations applies the deferred online migrations in the background. They're applied
+// by a single server of the cluster, holding a lock of its own for the other servers to start
+// meanwhile.
+func (ss *SqlStore) startOnlineMigrations() {
+	ss.onlineMigrationsMut.Lock()
+	defer ss.onlineMigrationsMut.Unlock()
+
+	if len(ss.onlineMigrationsPending) == 0 || ss.onlineMigrationsDone != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ss.onlineMigrationsCancel = cancel
+	ss.onlineMigrationsDone = make(chan struct{})
+
+	go func() {
+		defer close(ss.onlineMigrationsDone)
+
+		if err := ss.runOnlineMigrations(ctx); err != nil && ctx.Err() == nil {
+			mlog.Error("Failed to apply the online migrations", mlog.Err(err))
+		}
+	}()
+}
+
+func (ss *SqlStore) runOnlineMigrations(ctx context.Context) error {
+	engine, driver, closeEngine, err := ss.newMigrationEngine(ctx, onlineMigrationsLockKey)
+	if err != nil {
+		return err
+	}
+	defer closeEngine()
+
+	// Another server may have applied them while this one was waiting for the lock.
+	pending, err := engine.Diff(models.Up)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range pending {
+		online := newOnlineMigration(migration)
+		if online == nil {
+			continue
+		}
+
+		mlog.Info("Applying online migration", mlog.String("migration", migration.RawName), mlog.String("table", online.table))
+		if err := ss.applyOnlineMigration(ctx, driver, online); err != nil {
+			return err
+		}
+	}
+
+	ss.onlineMigrationsMut.Lock()
+	ss.onlineMigrationsPending = nil
+	ss.onlineMigrationsMut.Unlock()
+
+	return nil
+}
+
+// stopOnlineMigrations interrupts the online migrations running in the background, the
+// interrupted ones being resumed on the next start.
+func (ss *SqlStore) stopOnlineMigrations() {
+	ss.onlineMigrationsMut.Lock()
+	cancel, done := ss.onlineMigrationsCancel, ss.onlineMigrationsDone
+	ss.onlineMigrationsMut.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+}
+
+// GetOnlineMigrationsStatus returns the status of the online migrations, the progress of the
+// running ones being read from the database, whichever server of the cluster runs them.
+func (ss *SqlStore) GetOnlineMigrationsStatus() ([]*model.OnlineMigrationStatus, error) {
+	applied, err := ss.GetAppliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	appliedVersions := make(map[int]bool, len(applied))
+	for _, migration := range applied {
+		appliedVersions[migration.Version] = true
+	}
+
+	migrations, err := ss.sourceOnlineMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*model.OnlineMigrationStatus, 0, len(migrations))
+	for _, online := range migrations {
+		version := int(online.migration.Version)
+
+		status := &model.OnlineMigrationStatus{
+			Version:              version,
+			Name:                 online.migration.Name,
+			Table:                online.table,
+			State:                model.OnlineMigrationStatePending,
+			Progress:             -1,
+			EstimatedSecondsLeft: -1,
+		}
+
+		ss.onlineMigrationsMut.Lock()
+		if local, ok := ss.onlineMigrationsStatus[version]; ok {
+			*status = *local
+		}
+		ss.onlineMigrationsMut.Unlock()
+
+		if appliedVersions[version] {
+			status.State = model.OnlineMigrationStateCompleted
+			status.Progress = 1
+			status.EstimatedSecondsLeft = 0
+		} else if status.State != model.OnlineMigrationStateFailed {
+			ss.readOnlineMigrationProgress(status)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// readOnlineMigrationProgress reads the progress of the index build of the migration, if it's
+// running, from the progress reporting of Postgres 12+ or the performance schema of MySQL.
+func (ss *SqlStore) readOnlineMigrationProgress(status *model.OnlineMigrationStatus) {
+	var progress struct {
+		Done    float64
+		Total   float64
+		Elapsed float64
+	}
+
+	var err error
+	if ss.DriverName() == model.DatabaseDriverPostgres {
+		err = ss.GetMasterX().Get(&progress, `SELECT
+				CASE WHEN p.blocks_total > 0 THEN p.blocks_done ELSE p.tuples_done END AS "Done",
+				CASE WHEN p.blocks_total > 0 THEN p.blocks_total ELSE p.tuples_total END AS "Total",
+				EXTRACT(EPOCH FROM now() - a.query_start) AS "Elapsed"
+			FROM pg_stat_progress_create_index p
+			JOIN pg_stat_activity a ON a.pid = p.pid
+			WHERE p.relid = to_regclass($1)
+			LIMIT 1`, strings.ToLower(status.Table))
+	} else {
+		// The stages aren't reported by table, a single online migration running at a time.
+		err = ss.GetMasterX().Get(&progress, `SELECT
+				COALESCE(WORK_COMPLETED, 0) AS Done,
+				COALESCE(WORK_ESTIMATED, 0) AS Total,
+				0 AS Elapsed
+			FROM performance_schema.events_stages_current
+			WHERE EVENT_NAME LIKE 'stage/innodb/alter%'
+			LIMIT 1`)
+	}
+	if err != nil {
+		// Not running, or the progress isn't reported by the database.
+		return
+	}
+
+	status.State = model.OnlineMigrationStateRunning
+	if progress.Total > 0 {
+		status.Progress = progress.Done / progress.Total
+	}
+
+	elapsed := progress.Elapsed
+	if elapsed == 0 && status.StartAt > 0 {
+		elapsed = time.Since(time.UnixMilli(status.StartAt)).Seconds()
+	}
+	status.EstimateSecondsLeft(elapsed)
+}
+
+// sourceOnlineMigrations returns the online migrations of the driver, by version.
+func (ss *SqlStore) sourceOnlineMigrations() ([]*onlineMigration, error) {
+	assets := db.Assets()
+	dir := path.Join("migrations", ss.DriverName())
+
+	entries, err := assets.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []*onlineMigration
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), ".up.sql") {
+			continue
+		}
+
+		data, err := assets.ReadFile(path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, err := models.NewMigration(io.NopCloser(bytes.NewReader(data)), entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		if online := newOnlineMigration(migration); online != nil {
+			migrations = append(migrations, online)
+		}
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].migration.Version < migrations[j].migration.Version
+	})
+
+	return migrations, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/mattermost/morph/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestSplitSQLStatements(t *testing.T) {
+	t.Run("postgres", func(t *testing.T) {
+		statements := splitSQLStatements(`-- online: FileInfo
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_a ON fileinfo(creatorid);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_b ON fileinfo(createat)`)
+
+		assert.Equal(t, []string{
+			"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_a ON fileinfo(creatorid)",
+			"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_b ON fileinfo(createat)",
+		}, statements)
+	})
+
+	t.Run("mysql prepared statement", func(t *testing.T) {
+		statements := splitSQLStatements(`-- online: FileInfo
+SET @preparedStatement = (SELECT IF(
+    (SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS WHERE index_name = 'idx_a') > 0,
+    'SELECT 1;',
+    'CREATE INDEX idx_a ON FileInfo(CreatorId) ALGORITHM=INPLACE LOCK=NONE;'
+));
+
+PREPARE createIndexIfNotExists FROM @preparedStatement;
+EXECUTE createIndexIfNotExists;
+DEALLOCATE PREPARE createIndexIfNotExists;
+`)
+
+		require.Len(t, statements, 4)
+		assert.Contains(t, statements[0], "'SELECT 1;'")
+		assert.Contains(t, statements[0], "ALGORITHM=INPLACE LOCK=NONE;'")
+		assert.Equal(t, "PREPARE createIndexIfNotExists FROM @preparedStatement", statements[1])
+		assert.Equal(t, "EXECUTE createIndexIfNotExists", statements[2])
+		assert.Equal(t, "DEALLOCATE PREPARE createIndexIfNotExists", statements[3])
+	})
+}
+
+func TestNewOnlineMigration(t *testing.T) {
+	newMigration := func(t *testing.T, name, query string) *models.Migration {
+		migration, err := models.NewMigration(io.NopCloser(bytes.NewReader([]byte(query))), name)
+		require.NoError(t, err)
+		return migration
+	}
+
+	online := newOnlineMigration(newMigration(t, "000135_create_idx.up.sql", "-- online: FileInfo\nCREATE INDEX CONCURRENTLY idx ON fileinfo(creatorid);"))
+	require.NotNil(t, online)
+	assert.Equal(t, "FileInfo", online.table)
+
+	assert.Nil(t, newOnlineMigration(newMigration(t, "000135_create_idx.down.sql", "-- online: FileInfo\nDROP INDEX idx;")))
+	assert.Nil(t, newOnlineMigration(newMigration(t, "000134_create_table.up.sql", "CREATE TABLE t (id varchar(26));")))
+}
+
+func TestOnlineMigrationReferencesTable(t *testing.T) {
+	newMigration := func(t *testing.T, name, query string) *models.Migration {
+		migration, err := models.NewMigration(io.NopCloser(bytes.NewReader([]byte(query))), name)
+		require.NoError(t, err)
+		return migration
+	}
+
+	online := newOnlineMigration(newMigration(t, "000135_create_idx.up.sql", "-- online: FileInfo\nCREATE INDEX CONCURRENTLY idx ON fileinfo(creatorid);"))
+	require.NotNil(t, online)
+
+	assert.True(t, online.referencesTable(newMigration(t, "000136_drop_idx.up.sql", "DROP INDEX IF EXISTS idx ON FileInfo;")))
+	assert.True(t, online.referencesTable(newMigration(t, "000136_alter_fileinfo.up.sql", "ALTER TABLE fileinfo ADD COLUMN IF NOT EXISTS archived boolean;")))
+	assert.False(t, online.referencesTable(newMigration(t, "000136_alter_fileinfos.up.sql", "ALTER TABLE fileinfos ADD COLUMN IF NOT EXISTS archived boolean;")))
+	assert.False(t, online.referencesTable(newMigration(t, "000136_create_table.up.sql", "CREATE TABLE t (id varchar(26));")))
+}
+
+func TestGetOnlineMigrationsStatus(t *testing.T) {
+	testDrivers := []string{
+		model.DatabaseDriverPostgres,
+		model.DatabaseDriverMysql,
+	}
+
+	for _, d := range testDrivers {
+		driver := d
+		t.Run("Should return the online migrations for "+driver, func(t *testing.T) {
+			t.Parallel()
+			settings, err := makeSqlSettings(driver)
+			if err != nil {
+				t.Skip(err)
+			}
+			store := New(*settings, nil)
+			defer store.Close()
+
+			migrations, err := store.sourceOnlineMigrations()
+			require.NoError(t, err)
+			require.NotEmpty(t, migrations)
+
+			statuses, err := store.GetOnlineMigrationsStatus()
+			require.NoError(t, err)
+			require.Len(t, statuses, len(migrations))
+
+			// The tables of a new database are small, their online migrations applied on start.
+			for _, status := range statuses {
+				assert.Equal(t, model.OnlineMigrationStateCompleted, status.State)
+				assert.Equal(t, float64(1), status.Progress)
+				assert.Equal(t, int64(0), status.EstimatedSecondsLeft)
+			}
+
+			assert.Equal(t, 135, statuses[0].Version)
+			assert.Equal(t, "FileInfo", statuses[0].Table)
+		})
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// partitionedTable is a large table that can be partitioned by month on Postgres, on the column
+// holding the time of its rows. The partition key being part of the primary key of a partitioned
+// table, the primary key is extended with it.
+type partitionedTable struct {
+	name       string
+	column     string
+	primaryKey []string
+}
+
+var partitionedTables = []partitionedTable{
+	{name: "posts", column: "createat", primaryKey: []string{"id", "createat"}},
+	{name: "threadmemberships", column: "lastupdated", primaryKey: []string{"postid", "userid", "lastupdated"}},
+}
+
+// partitionNameRegex matches the names of the monthly partitions, <table>_y<year>m<month>.
+var partitionNameRegex = regexp.MustCompile(`^(\w+)_y(\d{4})m(\d{2})$`)
+
+func partitionName(table string, month time.Time) string {
+	return fmt.Sprintf("%s_y%04dm%02d", table, month.Year(), int(month.Month()))
+}
+
+func defaultPartitionName(table string) string {
+	return table + "_default"
+}
+
+// startOfMonth returns the start of the month of t, in UTC.
+func startOfMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// newTablePartition returns the partition of the table with the given name, with the bounds of
+// its month when it's a monthly partition.
+func newTablePartition(table, name string) *model.TablePartition {
+	partition := &model.TablePartition{Table: table, Name: name}
+
+	matches := partitionNameRegex.FindStringSubmatch(name)
+	if matches == nil || matches[1] != table {
+		return partition
+	}
+	year, _ := strconv.Atoi(matches[2])
+	month, _ := strconv.Atoi(matches[3])
+	if month < 1 || month > 12 {
+		return partition
+	}
+
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	partition.StartAt = model.GetMillisForTime(start)
+	partition.EndAt = model.GetMillisForTime(start.AddDate(0, 1, 0))
+
+	return partition
+}
+
+// loadPartitionedTables reads which of the tables are partitioned, for the queries on them to
+// target a single partition where they can.
+func (ss *SqlStore) loadPartitionedTables() {
+	if ss.DriverName() != model.DatabaseDriverPostgres {
+		return
+	}
+
+	var names []string
+	err := ss.GetMasterX().Select(&names, `SELECT pg_class.relname
+		FROM pg_class
+		JOIN pg_namespace ON pg_namespace.oid = pg_class.relnamespace
+		WHERE pg_class.relkind = 'p' AND pg_namespace.nspname = current_schema()`)
+	if err != nil {
+		mlog.Warn("Failed to read the partitioned tables.", mlog.Err(err))
+		return
+	}
+
+	partitioned := make(map[string]bool, len(names))
+	for _, name := range names {
+		partitioned[name] = true
+	}
+
+	ss.partitionedTablesMut.Lock()
+	ss.partitionedTables = partitioned
+	ss.partitionedTablesMut.Unlock()
+}
+
+// isPartitioned returns whether the table with the given name, in lower case, is partitioned.
+func (ss *SqlStore) isPartitioned(table string) bool {
+	ss.partitionedTablesMut.RLock()
+	defer ss.partitionedTablesMut.RUnlock()
+
+	return ss.partitionedTables[table]
+}
+
+// GetTablePartitions returns the partitions of the tables partitioned by month, sorted by table
+// and month, after the default partition of each table.
+func (ss *SqlStore) GetTablePartitions() ([]*model.TablePartition, error) {
+	if ss.DriverName() != model.DatabaseDriverPostgres {
+		return []*model.TablePartition{}, nil
+	}
+
+	tables := make([]string, 0, len(partitionedTables))
+	for _, table := range partitionedTables {
+		tables = append(tables, table.name)
+	}
+
+	rows := []struct {
+		Parent string
+		Child  string
+	}{}
+	query := ss.getQueryBuilder().
+		Select("parent.relname AS Parent", "child.relname AS Child").
+		From("pg_inherits").
+		Join("pg_class parent ON parent.oid = pg_inherits.inhparent").
+		Join("pg_class child ON child.oid = pg_inherits.inhrelid").
+		Join("pg_namespace ON pg_namespace.oid = parent.relnamespace").
+		Where("pg_namespace.nspname = current_schema()").
+		Where(map[string]any{"parent.relname": tables}).
+		OrderBy("parent.relname", "child.relname")
+	if err := ss.GetMasterX().SelectBuilder(&rows, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get the table partitions")
+	}
+
+	partitions := make([]*model.TablePartition, 0, len(rows))
+	for _, row := range rows {
+		partitions = append(partitions, newTablePartition(row.Parent, row.Child))
+	}
+
+	return partitions, nil
+}
+
+// CreateTablePartitions creates the monthly partitions of the partitioned tables missing up to
+// the month of until, included. It returns the partitions created.
+func (ss *SqlStore) CreateTablePartitions(until time.Time) ([]*model.TablePartition, error) {
+	partitions, err := ss.GetTablePartitions()
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(partitions))
+	for _, partition := range partitions {
+		existing[partition.Name] = true
+	}
+
+	created := []*model.TablePartition{}
+	for _, table := range partitionedTables {
+		if !ss.isPartitioned(table.name) {
+			continue
+		}
+
+		for month := startOfMonth(time.Now()); !month.After(until); month = month.AddDate(0, 1, 0) {
+			name := partitionName(table.name, month)
+			if existing[name] {
+				continue
+			}
+
+			if _, err := ss.GetMasterX().ExecRaw(createPartitionQuery(table.name, month)); err != nil {
+				return created, errors.Wrapf(err, "failed to create the partition %s", name)
+			}
+			created = append(created, newTablePartition(table.name, name))
+		}
+	}
+
+	return created, nil
+}
+
+// DropTablePartitions drops the monthly partitions of the partitioned tables holding only rows
+// older than before, in milliseconds. It returns the partitions dropped.
+func (ss *SqlStore) DropTablePartitions(before int64) ([]*model.TablePartition, error) {
+	partitions, err := ss.GetTablePartitions()
+	if err != nil {
+		return nil, err
+	}
+
+	dropped := []*model.TablePartition{}
+	for _, partition := range partitions {
+		if partition.IsDefault() || partition.EndAt > before {
+			continue
+		}
+
+		if _, err := ss.GetMasterX().ExecRaw("DROP TABLE IF EXISTS " + partition.Name); err != nil {
+			return dropped, errors.Wrapf(err, "failed to drop the partition %s", partition.Name)
+		}
+		dropped = append(dropped, partition)
+	}
+
+	return dropped, nil
+}
+
+func createPartitionQuery(table string, month time.Time) string {
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%d) TO (%d)",
+		partitionName(table, month), table,
+		model.GetMillisForTime(month), model.GetMillisForTime(month.AddDate(0, 1, 0)))
+}
+
+// PartitionTables converts the tables that can be partitioned by month, and aren't yet, into
+// partitioned tables, with the monthly partitions of the rows they hold and of the given number of
+// months ahead. Each table is locked while its rows are copied into the partitions, for as long as
+// it takes: the servers are meant to be stopped, and the database backed up, beforehand.
+func (ss *SqlStore) PartitionTables(monthsAhead int) error {
+	if ss.DriverName() != model.DatabaseDriverPostgres {
+		return errors.New("partitioned tables are only supported on PostgreSQL")
+	}
+
+	for _, table := range partitionedTables {
+		if ss.isPartitioned(table.name) {
+			mlog.Info("Table already partitioned.", mlog.String("table", table.name))
+			continue
+		}
+
+		mlog.Info("Partitioning table.", mlog.String("table", table.name))
+		if err := ss.partitionTable(table, time.Now().AddDate(0, monthsAhead, 0)); err != nil {
+			return errors.Wrapf(err, "failed to partition the table %s", table.name)
+		}
+	}
+
+	ss.loadPartitionedTables()
+
+	return nil
+}
+
+func (ss *SqlStore) partitionTable(table partitionedTable, until time.Time) (err error) {
+	tx, err := ss.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(tx, &err)
+
+	exec := func(query string) error {
+		_, execErr := tx.ExecNoTimeout(query)
+		return errors.Wrap(execErr, query)
+	}
+
+	if err = exec(fmt.Sprintf("LOCK TABLE %s IN ACCESS EXCLUSIVE MODE", table.name)); err != nil {
+		return err
+	}
+
+	// The indexes, other than the primary key, are recreated on the partitioned table, as they
+	// are defined on the table being replaced.
+	var indexes []string
+	if err = tx.Select(&indexes, `SELECT indexdef FROM pg_indexes
+		WHERE schemaname = current_schema() AND tablename = ? AND indexname <> ?`,
+		table.name, table.name+"_pkey"); err != nil {
+		return errors.Wrap(err, "failed to get the indexes")
+	}
+
+	var bounds struct {
+		Min sql.NullInt64
+		Max sql.NullInt64
+	}
+	if err = tx.Get(&bounds, fmt.Sprintf("SELECT MIN(%[1]s) AS Min, MAX(%[1]s) AS Max FROM %[2]s", table.column, table.name)); err != nil {
+		return errors.Wrap(err, "failed to get the bounds of the rows")
+	}
+
+	from := startOfMonth(time.Now())
+	if bounds.Min.Valid {
+		from = startOfMonth(model.GetTimeForMillis(bounds.Min.Int64))
+	}
+	if bounds.Max.Valid && model.GetTimeForMillis(bounds.Max.Int64).After(until) {
+		until = model.GetTimeForMillis(bounds.Max.Int64)
+	}
+
+	unpartitioned := table.name + "_unpartitioned"
+	statements := []string{
+		// The partition key is part of the primary key, which can't be null.
+		fmt.Sprintf("UPDATE %[1]s SET %[2]s = 0 WHERE %[2]s IS NULL", table.name, table.column),
+		fmt.Sprintf("ALTER TABLE %s RENAME TO %s", table.name, unpartitioned),
+		fmt.Sprintf("ALTER INDEX %s_pkey RENAME TO %s_pkey", table.name, unpartitioned),
+		fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING CONSTRAINTS) PARTITION BY RANGE (%s)", table.name, unpartitioned, table.column),
+		fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (%s)", table.name, strings.Join(table.primaryKey, ", ")),
+		fmt.Sprintf("CREATE TABLE %s PARTITION OF %s DEFAULT", defaultPartitionName(table.name), table.name),
+	}
+	for month := from; !month.After(until); month = month.AddDate(0, 1, 0) {
+		statements = append(statements, createPartitionQuery(table.name, month))
+	}
+	statements = append(statements,
+		fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", table.name, unpartitioned),
+		fmt.Sprintf("DROP TABLE %s", unpartitioned),
+	)
+	statements = append(statements, indexes...)
+
+	for _, statement := range statements {
+		if err = exec(statement); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestNewTablePartition(t *testing.T) {
+	t.Run("monthly partition", func(t *testing.T) {
+		month := time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC)
+		name := partitionName("posts", month)
+		assert.Equal(t, "posts_y2023m12", name)
+
+		partition := newTablePartition("posts", name)
+		assert.False(t, partition.IsDefault())
+		assert.Equal(t, model.GetMillisForTime(month), partition.StartAt)
+		assert.Equal(t, model.GetMillisForTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)), partition.EndAt)
+	})
+
+	t.Run("default partition", func(t *testing.T) {
+		partition := newTablePartition("posts", defaultPartitionName("posts"))
+		assert.True(t, partition.IsDefault())
+	})
+
+	t.Run("partition of another table", func(t *testing.T) {
+		partition := newTablePartition("posts", "threadmemberships_y2023m12")
+		assert.True(t, partition.IsDefault())
+	})
+}
+
+func TestStartOfMonth(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	start := startOfMonth(time.Date(2023, time.March, 1, 1, 0, 0, 0, loc))
+	require.Equal(t, time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC), start)
+}
+
+func TestTablePartitions(t *testing.T) {
+	settings, err := makeSqlSettings(model.DatabaseDriverPostgres)
+	if err != nil {
+		t.Skip(err)
+	}
+	store := New(*settings, nil)
+	defer store.Close()
+
+	// The tables of a new database aren't partitioned, until converted.
+	partitions, err := store.GetTablePartitions()
+	require.NoError(t, err)
+	assert.Empty(t, partitions)
+
+	created, err := store.CreateTablePartitions(time.Now().AddDate(0, 3, 0))
+	require.NoError(t, err)
+	assert.Empty(t, created)
+
+	dropped, err := store.DropTablePartitions(model.GetMillis())
+	require.NoError(t, err)
+	assert.Empty(t, dropped)
+}
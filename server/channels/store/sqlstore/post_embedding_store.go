@@ -0,0 +1,182 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlPostEmbeddingStore struct {
+	*SqlStore
+}
+
+func newSqlPostEmbeddingStore(sqlStore *SqlStore) store.PostEmbeddingStore {
+	return &SqlPostEmbeddingStore{sqlStore}
+}
+
+// postEmbeddingRow is a PostEmbedding as stored in the database. The embedding is stored in the
+// text representation of pgvector, which is also a JSON array, so that the pgvector extension is
+// only required to search the embeddings.
+type postEmbeddingRow struct {
+	PostId    string
+	ChannelId string
+	Model     string
+	Embedding string
+	CreateAt  int64
+}
+
+// Save stores the embeddings, replacing the existing embeddings of the same posts.
+func (s *SqlPostEmbeddingStore) Save(embeddings []*model.PostEmbedding) error {
+	if len(embeddings) == 0 {
+		return nil
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	for _, embedding := range embeddings {
+		embedding.PreSave()
+		if appErr := embedding.IsValid(); appErr != nil {
+			return appErr
+		}
+
+		var vector []byte
+		vector, err = json.Marshal(embedding.Embedding)
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode PostEmbedding with postId=%s", embedding.PostId)
+		}
+
+		query := s.getQueryBuilder().
+			Insert("PostEmbeddings").
+			Columns("PostId", "ChannelId", "Model", "Embedding", "CreateAt").
+			Values(embedding.PostId, embedding.ChannelId, embedding.Model, string(vector), embedding.CreateAt)
+
+		if s.DriverName() == model.DatabaseDriverMysql {
+			query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE ChannelId = ?, Model = ?, Embedding = ?, CreateAt = ?",
+				embedding.ChannelId, embedding.Model, string(vector), embedding.CreateAt))
+		} else {
+			query = query.SuffixExpr(sq.Expr("ON CONFLICT (postid) DO UPDATE SET ChannelId = ?, Model = ?, Embedding = ?, CreateAt = ?",
+				embedding.ChannelId, embedding.Model, string(vector), embedding.CreateAt))
+		}
+
+		if _, err = transaction.ExecBuilder(query); err != nil {
+			return errors.Wrapf(err, "failed to save PostEmbedding with postId=%s", embedding.PostId)
+		}
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s *SqlPostEmbeddingStore) Get(postID string) (*model.PostEmbedding, error) {
+	query := s.getQueryBuilder().
+		Select("PostId", "ChannelId", "Model", "Embedding", "CreateAt").
+		From("PostEmbeddings").
+		Where(sq.Eq{"PostId": postID})
+
+	var row postEmbeddingRow
+	if err := s.GetReplicaX().GetBuilder(&row, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PostEmbedding", postID)
+		}
+		return nil, errors.Wrapf(err, "failed to get PostEmbedding with postId=%s", postID)
+	}
+
+	embedding := &model.PostEmbedding{
+		PostId:    row.PostId,
+		ChannelId: row.ChannelId,
+		Model:     row.Model,
+		CreateAt:  row.CreateAt,
+	}
+	if err := json.Unmarshal([]byte(row.Embedding), &embedding.Embedding); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode PostEmbedding with postId=%s", postID)
+	}
+
+	return embedding, nil
+}
+
+// Search returns the posts of the channels whose embeddings are the most similar to embedding, by
+// cosine similarity. Only the embeddings computed by embeddingModel are compared. Searching requires
+// the pgvector extension, and isn't supported on MySQL.
+func (s *SqlPostEmbeddingStore) Search(embedding []float32, embeddingModel string, channelIDs []string, limit int) ([]*model.PostEmbeddingMatch, error) {
+	if s.DriverName() != model.DatabaseDriverPostgres {
+		return nil, store.NewErrNotImplemented("semantic search requires PostgreSQL with the pgvector extension")
+	}
+
+	if len(channelIDs) == 0 {
+		return []*model.PostEmbeddingMatch{}, nil
+	}
+
+	vector, err := json.Marshal(embedding)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode the search embedding")
+	}
+
+	query := s.getQueryBuilder().
+		Select("PostId").
+		Column(sq.Expr("1 - (Embedding::vector <=> ?::vector) AS Score", string(vector))).
+		From("PostEmbeddings").
+		Where(sq.Eq{
+			"Model":     embeddingModel,
+			"ChannelId": channelIDs,
+		}).
+		OrderByClause("Embedding::vector <=> ?::vector", string(vector)).
+		Limit(uint64(limit))
+
+	matches := []*model.PostEmbeddingMatch{}
+	if err := s.GetSearchReplicaX().SelectBuilder(&matches, query); err != nil {
+		return nil, errors.Wrap(err, "failed to search PostEmbeddings")
+	}
+
+	return matches, nil
+}
+
+func (s *SqlPostEmbeddingStore) Delete(postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	query := s.getQueryBuilder().
+		Delete("PostEmbeddings").
+		Where(sq.Eq{"PostId": postIDs})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrap(err, "failed to delete PostEmbeddings")
+	}
+
+	return nil
+}
+
+// DeleteOrphanedRows removes entries from PostEmbeddings when a corresponding post no longer exists.
+func (s *SqlPostEmbeddingStore) DeleteOrphanedRows(limit int) (deleted int64, err error) {
+	// We need the extra level of nesting to deal with MySQL's locking
+	const query = `
+	DELETE FROM PostEmbeddings WHERE PostId IN (
+		SELECT * FROM (
+			SELECT PostId FROM PostEmbeddings
+			LEFT JOIN Posts ON PostEmbeddings.PostId = Posts.Id
+			WHERE Posts.Id IS NULL
+			LIMIT ?
+		) AS A
+	)`
+	result, err := s.GetMasterX().Exec(query, limit)
+	if err != nil {
+		return
+	}
+	deleted, err = result.RowsAffected()
+	return
+}
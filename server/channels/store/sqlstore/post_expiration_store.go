@@ -0,0 +1,50 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlPostExpirationStore struct {
+	*SqlStore
+}
+
+func newSqlPostExpirationStore(sqlStore *SqlStore) store.PostExpirationStore {
+	return &SqlPostExpirationStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlPostExpirationStore) GetExpiredBefore(until int64, limit int) ([]*model.PostExpiration, error) {
+	query := s.getQueryBuilder().
+		Select("PostId", "ChannelId", "ExpireAt").
+		From("PostExpirations").
+		Where(sq.LtOrEq{"ExpireAt": until}).
+		OrderBy("ExpireAt", "PostId").
+		Limit(uint64(limit))
+
+	expirations := []*model.PostExpiration{}
+	if err := s.GetMasterX().SelectBuilder(&expirations, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get expired PostExpirations")
+	}
+
+	return expirations, nil
+}
+
+func (s *SqlPostExpirationStore) Delete(postIDs []string) error {
+	query := s.getQueryBuilder().
+		Delete("PostExpirations").
+		Where(sq.Eq{"PostId": postIDs})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrap(err, "failed to delete PostExpirations")
+	}
+
+	return nil
+}
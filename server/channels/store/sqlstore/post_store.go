@@ -140,6 +140,7 @@ func (s *SqlPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, er
 	maxDateNewRootPosts := make(map[string]int64)
 	rootIds := make(map[string]int)
 	maxDateRootIds := make(map[string]int64)
+	channelPinnedPosts := make(map[string]int64)
 	for idx, post := range posts {
 		if post.Id != "" && !post.IsRemote() {
 			return nil, idx, store.NewErrInvalidInput("Post", "id", post.Id)
@@ -150,6 +151,10 @@ func (s *SqlPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, er
 			return nil, idx, err
 		}
 
+		if post.IsPinned && post.DeleteAt == 0 {
+			channelPinnedPosts[post.ChannelId]++
+		}
+
 		if currentChannelCount, ok := channelNewPosts[post.ChannelId]; !ok {
 			if post.IsJoinLeaveMessage() {
 				channelNewPosts[post.ChannelId] = 0
@@ -223,6 +228,10 @@ func (s *SqlPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, er
 		return nil, -1, errors.Wrap(err, "failed to save PostPriority")
 	}
 
+	if err = s.savePostsExpiration(transaction, posts); err != nil {
+		return nil, -1, errors.Wrap(err, "failed to save PostExpiration")
+	}
+
 	if err = transaction.Commit(); err != nil {
 		// don't need to rollback here since the transaction is already closed
 		return posts, -1, errors.Wrap(err, "commit_transaction")
@@ -247,6 +256,12 @@ func (s *SqlPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, er
 		}
 	}
 
+	for channelId, count := range channelPinnedPosts {
+		if err = addToPinnedPostCount(s.GetMasterX(), s.getQueryBuilder(), channelId, count); err != nil {
+			mlog.Warn("Error updating ChannelCounters PinnedPostCount.", mlog.Err(err))
+		}
+	}
+
 	for rootId := range rootIds {
 		if _, err = s.GetMasterX().Exec("UPDATE Posts SET UpdateAt = ? WHERE Id = ?", maxDateRootIds[rootId], rootId); err != nil {
 			mlog.Warn("Error updating Post UpdateAt.", mlog.Err(err))
@@ -324,6 +339,14 @@ func (s *SqlPostStore) populateReplyCount(posts []*model.Post) error {
 }
 
 func (s *SqlPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error) {
+	var pinnedDelta int64
+	if wasPinned, isPinned := oldPost.IsPinned && oldPost.DeleteAt == 0, newPost.IsPinned && newPost.DeleteAt == 0; wasPinned != isPinned {
+		pinnedDelta = 1
+		if wasPinned {
+			pinnedDelta = -1
+		}
+	}
+
 	newPost.UpdateAt = model.GetMillis()
 	newPost.PreCommit()
 
@@ -339,6 +362,12 @@ func (s *SqlPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.
 		return nil, err
 	}
 
+	// The post stays in the partition of its creation time, for the update to target it only.
+	where := "Id=:Id"
+	if s.isPartitioned("posts") && newPost.CreateAt == oldPost.CreateAt {
+		where = "Id=:Id AND CreateAt=:CreateAt"
+	}
+
 	if _, err := s.GetMasterX().NamedExec(`UPDATE Posts
 		SET CreateAt=:CreateAt,
 			UpdateAt=:UpdateAt,
@@ -358,8 +387,7 @@ func (s *SqlPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.
 			HasReactions=:HasReactions,
 			RemoteId=:RemoteId
 		WHERE
-			Id=:Id
-		`, newPost); err != nil {
+			`+where, newPost); err != nil {
 		return nil, errors.Wrapf(err, "failed to update Post with id=%s", newPost.Id)
 	}
 
@@ -368,6 +396,12 @@ func (s *SqlPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.
 		return nil, errors.Wrap(err, "failed to update lastpostat of channels")
 	}
 
+	if pinnedDelta != 0 {
+		if err := addToPinnedPostCount(s.GetMasterX(), s.getQueryBuilder(), newPost.ChannelId, pinnedDelta); err != nil {
+			return nil, err
+		}
+	}
+
 	if newPost.RootId != "" {
 		if _, err := s.GetMasterX().Exec("UPDATE Posts SET UpdateAt = ? WHERE Id = ? AND UpdateAt < ?", time, newPost.RootId, time); err != nil {
 			return nil, errors.Wrap(err, "failed to update updateAt of posts")
@@ -878,7 +912,7 @@ func (s *SqlPostStore) Delete(postID string, time int64, deleteByID string) (err
 
 	id := postIds{}
 	// TODO: change this to later delete thread directly from postID
-	err = transaction.Get(&id, "SELECT RootId, UserId FROM Posts WHERE Id = ?", postID)
+	err = transaction.Get(&id, "SELECT RootId, UserId, ChannelId FROM Posts WHERE Id = ?", postID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return store.NewErrNotFound("Post", postID)
@@ -887,6 +921,17 @@ func (s *SqlPostStore) Delete(postID string, time int64, deleteByID string) (err
 		return errors.Wrapf(err, "failed to delete Post with id=%s", postID)
 	}
 
+	// The pinned posts of the thread are deleted along with it.
+	var pinned int64
+	if err = transaction.Get(&pinned, "SELECT COUNT(*) FROM Posts WHERE (Id = ? OR RootId = ?) AND IsPinned = true AND DeleteAt = 0", postID, postID); err != nil {
+		return errors.Wrap(err, "failed to count the pinned Posts")
+	}
+	if pinned > 0 {
+		if err = addToPinnedPostCount(transaction, s.getQueryBuilder(), id.ChannelId, -pinned); err != nil {
+			return err
+		}
+	}
+
 	if s.DriverName() == model.DatabaseDriverPostgres {
 		_, err = transaction.Exec(`UPDATE Posts
 			SET DeleteAt = $1,
@@ -955,9 +1000,10 @@ func (s *SqlPostStore) permanentDelete(postId string) (err error) {
 }
 
 type postIds struct {
-	Id     string
-	RootId string
-	UserId string
+	Id        string
+	RootId    string
+	UserId    string
+	ChannelId string
 }
 
 func (s *SqlPostStore) permanentDeleteAllCommentByUser(userId string) (err error) {
@@ -1866,6 +1912,58 @@ func (s *SqlPostStore) buildCreateDateFilterClause(params *model.SearchParams, b
 	return builder
 }
 
+func (s *SqlPostStore) buildSearchFileTypeFilterClause(params *model.SearchParams, builder sq.SelectBuilder) (sq.SelectBuilder, error) {
+	// handle type: filters, matching the posts by the extensions of their files
+	if len(params.FileTypes) != 0 {
+		subQuery, subQueryArgs, err := s.getSubQueryBuilder().
+			Select("PostId").
+			From("FileInfo").
+			Where(sq.Eq{"DeleteAt": 0, "Extension": params.GetFileTypeExtensions()}).
+			ToSql()
+		if err != nil {
+			return sq.SelectBuilder{}, err
+		}
+		builder = builder.Where("q2.Id IN ("+subQuery+")", subQueryArgs...)
+	}
+
+	if len(params.ExcludedFileTypes) != 0 {
+		subQuery, subQueryArgs, err := s.getSubQueryBuilder().
+			Select("PostId").
+			From("FileInfo").
+			Where(sq.Eq{"DeleteAt": 0, "Extension": params.GetExcludedFileTypeExtensions()}).
+			ToSql()
+		if err != nil {
+			return sq.SelectBuilder{}, err
+		}
+		builder = builder.Where("q2.Id NOT IN ("+subQuery+")", subQueryArgs...)
+	}
+
+	return builder, nil
+}
+
+func (s *SqlPostStore) buildSearchHasFilterClause(params *model.SearchParams, builder sq.SelectBuilder) sq.SelectBuilder {
+	// handle has: filters
+	for _, has := range params.Has {
+		switch has {
+		case model.SearchHasLink:
+			builder = builder.Where(sq.Or{sq.Like{"q2.Message": "%http://%"}, sq.Like{"q2.Message": "%https://%"}})
+		case model.SearchHasReaction:
+			builder = builder.Where(sq.Eq{"q2.HasReactions": true})
+		}
+	}
+
+	for _, has := range params.ExcludedHas {
+		switch has {
+		case model.SearchHasLink:
+			builder = builder.Where(sq.And{sq.NotLike{"q2.Message": "%http://%"}, sq.NotLike{"q2.Message": "%https://%"}})
+		case model.SearchHasReaction:
+			builder = builder.Where(sq.Eq{"q2.HasReactions": false})
+		}
+	}
+
+	return builder
+}
+
 func (s *SqlPostStore) buildSearchTeamFilterClause(teamId string, builder sq.SelectBuilder) sq.SelectBuilder {
 	if teamId == "" {
 		return builder
@@ -1941,15 +2039,17 @@ func (s *SqlPostStore) buildSearchPostFilterClause(teamID string, fromUsers []st
 }
 
 func (s *SqlPostStore) Search(teamId string, userId string, params *model.SearchParams) (*model.PostList, error) {
-	return s.search(teamId, userId, params, true, true)
+	return s.search(context.Background(), teamId, userId, params, true, true)
 }
 
-func (s *SqlPostStore) search(teamId string, userId string, params *model.SearchParams, channelsByName bool, userByUsername bool) (*model.PostList, error) {
+func (s *SqlPostStore) search(ctx context.Context, teamId string, userId string, params *model.SearchParams, channelsByName bool, userByUsername bool) (*model.PostList, error) {
 	list := model.NewPostList()
 	if params.Terms == "" && params.ExcludedTerms == "" &&
 		len(params.InChannels) == 0 && len(params.ExcludedChannels) == 0 &&
 		len(params.FromUsers) == 0 && len(params.ExcludedUsers) == 0 &&
-		params.OnDate == "" && params.AfterDate == "" && params.BeforeDate == "" {
+		params.OnDate == "" && params.AfterDate == "" && params.BeforeDate == "" &&
+		len(params.FileTypes) == 0 && len(params.ExcludedFileTypes) == 0 &&
+		len(params.Has) == 0 && len(params.ExcludedHas) == 0 {
 		return list, nil
 	}
 
@@ -1968,6 +2068,11 @@ func (s *SqlPostStore) search(teamId string, userId string, params *model.Search
 		return nil, errors.Wrap(err, "failed to build search post filter clause")
 	}
 	baseQuery = s.buildCreateDateFilterClause(params, baseQuery)
+	baseQuery, err = s.buildSearchFileTypeFilterClause(params, baseQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build search file type filter clause")
+	}
+	baseQuery = s.buildSearchHasFilterClause(params, baseQuery)
 
 	termMap := map[string]bool{}
 	terms := params.Terms
@@ -2074,7 +2179,7 @@ func (s *SqlPostStore) search(teamId string, userId string, params *model.Search
 
 	var posts []*model.Post
 
-	if err := s.GetSearchReplicaX().Select(&posts, searchQuery, searchQueryArgs...); err != nil {
+	if err := s.GetSearchReplicaX().withContext(ctx).Select(&posts, searchQuery, searchQueryArgs...); err != nil {
 		mlog.Warn("Query error searching posts.", mlog.Err(err))
 		// Don't return the error to the caller as it is of no use to the user. Instead return an empty set of search results.
 	} else {
@@ -2163,7 +2268,7 @@ func (s *SqlPostStore) AnalyticsUserCountsWithPostsByDay(teamId string) (model.A
 	args = append(args, start, end)
 
 	rows := model.AnalyticsRows{}
-	err := s.GetReplicaX().Select(
+	err := s.GetAnalyticsReplicaX().Select(
 		&rows,
 		query,
 		args...)
@@ -2232,7 +2337,7 @@ func (s *SqlPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCoun
 	args = append(args, end, start)
 
 	rows := model.AnalyticsRows{}
-	err := s.GetReplicaX().Select(
+	err := s.GetAnalyticsReplicaX().Select(
 		&rows,
 		query,
 		args...)
@@ -2292,7 +2397,7 @@ func (s *SqlPostStore) AnalyticsPostCount(options *model.PostCountOptions) (int6
 	}
 
 	var v int64
-	err = s.GetReplicaX().Get(&v, queryString, args...)
+	err = s.GetAnalyticsReplicaX().Get(&v, queryString, args...)
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to count Posts")
 	}
@@ -2704,7 +2809,7 @@ func (s *SqlPostStore) GetDirectPostParentsForExportAfter(limit int, afterId str
 }
 
 //nolint:unparam
-func (s *SqlPostStore) SearchPostsForUser(paramsList []*model.SearchParams, userID, teamId string, page, perPage int) (*model.PostSearchResults, error) {
+func (s *SqlPostStore) SearchPostsForUser(ctx context.Context, paramsList []*model.SearchParams, userID, teamId string, page, perPage int) (*model.PostSearchResults, error) {
 	// Since we don't support paging for DB search, we just return nothing for later pages
 	if page > 0 {
 		return model.MakePostSearchResults(model.NewPostList(), nil), nil
@@ -2738,7 +2843,7 @@ func (s *SqlPostStore) SearchPostsForUser(paramsList []*model.SearchParams, user
 
 		go func(params *model.SearchParams) {
 			defer wg.Done()
-			postList, err := s.search(teamId, userID, params, false, false)
+			postList, err := s.search(ctx, teamId, userID, params, false, false)
 			pchan <- store.StoreResult{Data: postList, NErr: err}
 		}(params)
 	}
@@ -3003,6 +3108,22 @@ func (s *SqlPostStore) savePostsPriority(transaction *sqlxTxWrapper, posts []*mo
 	return nil
 }
 
+func (s *SqlPostStore) savePostsExpiration(transaction *sqlxTxWrapper, posts []*model.Post) error {
+	for _, post := range posts {
+		if expireAt := post.GetExpireAt(); expireAt != 0 {
+			postExpiration := &model.PostExpiration{
+				PostId:    post.Id,
+				ChannelId: post.ChannelId,
+				ExpireAt:  expireAt,
+			}
+			if _, err := transaction.NamedExec(`INSERT INTO PostExpirations (PostId, ChannelId, ExpireAt) VALUES (:PostId, :ChannelId, :ExpireAt)`, postExpiration); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (s *SqlPostStore) updateThreadsFromPosts(transaction *sqlxTxWrapper, posts []*model.Post) error {
 	postsByRoot := map[string][]*model.Post{}
 	var rootIds []string
@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlPostTranslationStore struct {
+	*SqlStore
+}
+
+func newSqlPostTranslationStore(sqlStore *SqlStore) store.PostTranslationStore {
+	return &SqlPostTranslationStore{sqlStore}
+}
+
+// Save stores the translation, replacing any translation of the post into the same language.
+func (s *SqlPostTranslationStore) Save(translation *model.PostTranslation) (*model.PostTranslation, error) {
+	translation.PreSave()
+	if err := translation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("PostTranslations").
+		Columns("PostId", "Language", "SourceLanguage", "Message", "Provider", "PostEditAt", "CreateAt").
+		Values(translation.PostId, translation.Language, translation.SourceLanguage, translation.Message, translation.Provider, translation.PostEditAt, translation.CreateAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE SourceLanguage = ?, Message = ?, Provider = ?, PostEditAt = ?, CreateAt = ?",
+			translation.SourceLanguage, translation.Message, translation.Provider, translation.PostEditAt, translation.CreateAt))
+	} else {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (postid, language) DO UPDATE SET SourceLanguage = ?, Message = ?, Provider = ?, PostEditAt = ?, CreateAt = ?",
+			translation.SourceLanguage, translation.Message, translation.Provider, translation.PostEditAt, translation.CreateAt))
+	}
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save PostTranslation with postId=%s", translation.PostId)
+	}
+
+	return translation, nil
+}
+
+func (s *SqlPostTranslationStore) Get(postID, language string) (*model.PostTranslation, error) {
+	query := s.getQueryBuilder().
+		Select("PostId", "Language", "SourceLanguage", "Message", "Provider", "PostEditAt", "CreateAt").
+		From("PostTranslations").
+		Where(sq.Eq{
+			"PostId":   postID,
+			"Language": language,
+		})
+
+	var translation model.PostTranslation
+	if err := s.GetReplicaX().GetBuilder(&translation, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PostTranslation", postID)
+		}
+		return nil, errors.Wrapf(err, "failed to get PostTranslation with postId=%s", postID)
+	}
+
+	return &translation, nil
+}
+
+func (s *SqlPostTranslationStore) DeleteForPost(postID string) error {
+	query := s.getQueryBuilder().
+		Delete("PostTranslations").
+		Where(sq.Eq{"PostId": postID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete PostTranslations with postId=%s", postID)
+	}
+
+	return nil
+}
+
+// DeleteOrphanedRows removes entries from PostTranslations when a corresponding post no longer exists.
+func (s *SqlPostTranslationStore) DeleteOrphanedRows(limit int) (deleted int64, err error) {
+	// We need the extra level of nesting to deal with MySQL's locking
+	const query = `
+	DELETE FROM PostTranslations WHERE PostId IN (
+		SELECT * FROM (
+			SELECT PostId FROM PostTranslations
+			LEFT JOIN Posts ON PostTranslations.PostId = Posts.Id
+			WHERE Posts.Id IS NULL
+			LIMIT ?
+		) AS A
+	)`
+	result, err := s.GetMasterX().Exec(query, limit)
+	if err != nil {
+		return
+	}
+	deleted, err = result.RowsAffected()
+	return
+}
@@ -157,6 +157,28 @@ func (s SqlPreferenceStore) GetCategoryAndName(category string, name string) (mo
 	return preferences, nil
 }
 
+func (s SqlPreferenceStore) GetCategoryAndNameForUsers(userIDs []string, category string, name string) (model.Preferences, error) {
+	if len(userIDs) == 0 {
+		return model.Preferences{}, nil
+	}
+
+	var preferences model.Preferences
+	query, args, err := s.getQueryBuilder().
+		Select("*").
+		From("Preferences").
+		Where(sq.Eq{"UserId": userIDs}).
+		Where(sq.Eq{"Category": category}).
+		Where(sq.Eq{"Name": name}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not build sql query to get preference")
+	}
+	if err = s.GetReplicaX().Select(&preferences, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Preference with category=%s, name=%s", category, name)
+	}
+	return preferences, nil
+}
+
 func (s SqlPreferenceStore) GetCategory(userId string, category string) (model.Preferences, error) {
 	var preferences model.Preferences
 	query, args, err := s.getQueryBuilder().
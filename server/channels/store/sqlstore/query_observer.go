@@ -0,0 +1,174 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// queryCancelledReason is the reason a query was cancelled for, as counted by the metrics.
+type queryCancelledReason string
+
+const (
+	queryCancelledTimeout queryCancelledReason = "timeout"
+	queryCancelledRequest queryCancelledReason = "cancelled"
+)
+
+const (
+	// slowQueryExplainTimeout is the time the EXPLAIN of a slow query is given to complete.
+	slowQueryExplainTimeout = 10 * time.Second
+	unknownQueryLabel       = "unknown"
+)
+
+// queryObserver traces the queries run by the sqlx wrappers, logs the slow ones and counts the
+// cancelled ones.
+type queryObserver struct {
+	trace bool
+	// slowQueryThreshold is the duration from which a query is logged as slow, 0 not to log them.
+	slowQueryThreshold time.Duration
+	metrics            einterfaces.MetricsInterface
+	// explainDB captures the plans of the slow queries, on Postgres only.
+	explainDB *sqlx.DB
+}
+
+// observeCancellation counts the failed queries which were cancelled, either timed out or
+// cancelled along with their request.
+func (o queryObserver) observeCancellation(ctx context.Context, err error) {
+	if err == nil || o.metrics == nil {
+		return
+	}
+
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		o.metrics.IncrementStoreQueryCancelledCounter(string(queryCancelledTimeout))
+	case context.Canceled:
+		o.metrics.IncrementStoreQueryCancelledCounter(string(queryCancelledRequest))
+	}
+}
+
+// observeQuery traces the query started at then, and logs it when it's slow. It's deferred by the
+// wrappers, args being the arguments of a positional query or the argument of a named one.
+func (o queryObserver) observeQuery(query string, then time.Time, args any) {
+	elapsed := time.Since(then)
+
+	if o.trace {
+		printArgs(query, elapsed, args)
+	}
+
+	if o.slowQueryThreshold > 0 && elapsed >= o.slowQueryThreshold {
+		o.logSlowQuery(query, elapsed, args)
+	}
+}
+
+func (o queryObserver) logSlowQuery(query string, elapsed time.Duration, args any) {
+	label := queryLabel()
+	if o.metrics != nil {
+		o.metrics.ObserveStoreSlowQueryDuration(label, elapsed.Seconds())
+	}
+
+	fields := []mlog.Field{
+		mlog.String("label", label),
+		mlog.Duration("duration", elapsed),
+		mlog.String("query", strings.Map(removeSpace, query)),
+		mlog.Any("args", sanitizeQueryArgs(args)),
+	}
+
+	positionalArgs, ok := args.([]any)
+	if !ok || o.explainDB == nil || o.explainDB.DriverName() != model.DatabaseDriverPostgres || !isExplainable(query) {
+		mlog.Warn("Slow query", fields...)
+		return
+	}
+
+	// The plan is captured in the background, not to hold the caller of the query any longer.
+	go func() {
+		plan, err := explainQuery(o.explainDB, query, positionalArgs)
+		if err != nil {
+			fields = append(fields, mlog.NamedErr("explain_error", err))
+		} else {
+			fields = append(fields, mlog.String("plan", plan))
+		}
+		mlog.Warn("Slow query", fields...)
+	}()
+}
+
+// queryLabel names a query after the store method running it, e.g. PostStore.search, from the
+// first caller of the wrappers in the store.
+func queryLabel() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+
+		function := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		if strings.HasPrefix(function, "sqlstore.") &&
+			!strings.HasPrefix(function, "sqlstore.(*sqlxDBWrapper)") &&
+			!strings.HasPrefix(function, "sqlstore.(*sqlxTxWrapper)") &&
+			!strings.HasPrefix(function, "sqlstore.queryObserver") {
+			label := strings.TrimPrefix(function, "sqlstore.")
+			label = strings.NewReplacer("(*", "", ")", "").Replace(label)
+			return strings.TrimPrefix(label, "Sql")
+		}
+
+		if !more {
+			return unknownQueryLabel
+		}
+	}
+}
+
+// sanitizeQueryArgs returns the arguments of a query safe to be logged: only the numbers, booleans
+// and times are kept, the strings possibly being tokens, passwords or message contents.
+func sanitizeQueryArgs(args any) any {
+	positionalArgs, ok := args.([]any)
+	if !ok {
+		return sanitizeQueryArg(args)
+	}
+
+	sanitized := make([]any, len(positionalArgs))
+	for i, arg := range positionalArgs {
+		sanitized[i] = sanitizeQueryArg(arg)
+	}
+	return sanitized
+}
+
+func sanitizeQueryArg(arg any) any {
+	switch v := arg.(type) {
+	case nil, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, time.Time:
+		return v
+	case string:
+		return fmt.Sprintf("<string of %d characters>", len(v))
+	default:
+		return fmt.Sprintf("<%T>", v)
+	}
+}
+
+// isExplainable returns whether the query is a SELECT, which can be explained without side effects.
+func isExplainable(query string) bool {
+	statement := strings.ToUpper(strings.TrimSpace(query))
+	return strings.HasPrefix(statement, "SELECT") || strings.HasPrefix(statement, "WITH")
+}
+
+// explainQuery returns the plan of the query, as planned by Postgres without running it.
+func explainQuery(db *sqlx.DB, query string, args []any) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), slowQueryExplainTimeout)
+	defer cancel()
+
+	var lines []string
+	if err := db.SelectContext(ctx, &lines, "EXPLAIN "+query, args...); err != nil {
+		return "", errors.Wrap(err, "failed to explain the query")
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
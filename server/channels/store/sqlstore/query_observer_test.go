@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces/mocks"
+)
+
+func TestSanitizeQueryArgs(t *testing.T) {
+	createAt := time.Unix(1600000000, 0)
+
+	assert.Equal(t, []any{
+		"<string of 26 characters>",
+		int64(10),
+		true,
+		nil,
+		createAt,
+		"<[]uint8>",
+	}, sanitizeQueryArgs([]any{"aq5sxctc9pgs8mnwkhwafm5qfr", int64(10), true, nil, createAt, []byte("secret")}))
+
+	assert.Equal(t, "<struct { Token string }>", sanitizeQueryArgs(struct{ Token string }{Token: "secret"}))
+}
+
+func TestIsExplainable(t *testing.T) {
+	assert.True(t, isExplainable("SELECT * FROM Posts"))
+	assert.True(t, isExplainable("\n\t  select 1"))
+	assert.True(t, isExplainable("WITH t AS (SELECT 1) SELECT * FROM t"))
+	assert.False(t, isExplainable("UPDATE Posts SET DeleteAt = 1"))
+	assert.False(t, isExplainable("DELETE FROM Posts"))
+}
+
+func TestObserveSlowQuery(t *testing.T) {
+	t.Run("logs the queries slower than the threshold", func(t *testing.T) {
+		mockMetrics := &mocks.MetricsInterface{}
+		mockMetrics.On("ObserveStoreSlowQueryDuration", "TestObserveSlowQuery.func1", mock.AnythingOfType("float64")).Once()
+
+		observer := queryObserver{slowQueryThreshold: time.Millisecond, metrics: mockMetrics}
+		observer.observeQuery("SELECT * FROM Posts WHERE Id = ?", time.Now().Add(-time.Second), []any{"postid"})
+
+		mockMetrics.AssertExpectations(t)
+	})
+
+	t.Run("ignores the faster queries", func(t *testing.T) {
+		mockMetrics := &mocks.MetricsInterface{}
+
+		observer := queryObserver{slowQueryThreshold: time.Minute, metrics: mockMetrics}
+		observer.observeQuery("SELECT * FROM Posts WHERE Id = ?", time.Now(), []any{"postid"})
+
+		mockMetrics.AssertNotCalled(t, "ObserveStoreSlowQueryDuration", mock.Anything, mock.Anything)
+	})
+
+	t.Run("is disabled without threshold", func(t *testing.T) {
+		mockMetrics := &mocks.MetricsInterface{}
+
+		observer := queryObserver{metrics: mockMetrics}
+		observer.observeQuery("SELECT * FROM Posts WHERE Id = ?", time.Now().Add(-time.Hour), []any{"postid"})
+
+		mockMetrics.AssertNotCalled(t, "ObserveStoreSlowQueryDuration", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"strconv"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+const reactionSummaryDayMillis = 24 * 60 * 60 * 1000
+
+type SqlReactionSummaryStore struct {
+	*SqlStore
+}
+
+func newSqlReactionSummaryStore(sqlStore *SqlStore) store.ReactionSummaryStore {
+	return &SqlReactionSummaryStore{sqlStore}
+}
+
+// SummarizeDay replaces the summaries of the day starting at dayStart with counts of the reactions
+// that were added that day and haven't been removed since.
+func (s *SqlReactionSummaryStore) SummarizeDay(dayStart int64) (err error) {
+	dayEnd := dayStart + reactionSummaryDayMillis
+
+	// The day is inlined rather than passed as an argument since Postgres can't infer the type of a
+	// placeholder in the select list.
+	reactionsOfDay := func(columns ...string) sq.SelectBuilder {
+		return sq.Select(strconv.FormatInt(dayStart, 10)).
+			Columns(columns...).
+			From("Reactions").
+			Join("Posts ON Posts.Id = Reactions.PostId").
+			Join("Channels ON Channels.Id = Posts.ChannelId").
+			Where(sq.And{
+				sq.GtOrEq{"Reactions.CreateAt": dayStart},
+				sq.Lt{"Reactions.CreateAt": dayEnd},
+				sq.Eq{"Reactions.DeleteAt": 0},
+				sq.Eq{"Posts.DeleteAt": 0},
+			})
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	for _, table := range []string{"ReactionEmojiSummaries", "ReactionPostSummaries"} {
+		query := s.getQueryBuilder().Delete(table).Where(sq.Eq{"Day": dayStart})
+		if _, err = transaction.ExecBuilder(query); err != nil {
+			return errors.Wrapf(err, "failed to delete %s for day=%d", table, dayStart)
+		}
+	}
+
+	emojiQuery := s.getQueryBuilder().
+		Insert("ReactionEmojiSummaries").
+		Columns("Day", "TeamId", "ChannelId", "EmojiName", "Count").
+		Select(reactionsOfDay("Channels.TeamId", "Posts.ChannelId", "Reactions.EmojiName", "COUNT(*)").
+			GroupBy("Channels.TeamId", "Posts.ChannelId", "Reactions.EmojiName"))
+	if _, err = transaction.ExecBuilder(emojiQuery); err != nil {
+		return errors.Wrapf(err, "failed to save ReactionEmojiSummaries for day=%d", dayStart)
+	}
+
+	postQuery := s.getQueryBuilder().
+		Insert("ReactionPostSummaries").
+		Columns("Day", "TeamId", "ChannelId", "PostId", "Count").
+		Select(reactionsOfDay("Channels.TeamId", "Posts.ChannelId", "Reactions.PostId", "COUNT(*)").
+			GroupBy("Channels.TeamId", "Posts.ChannelId", "Reactions.PostId"))
+	if _, err = transaction.ExecBuilder(postQuery); err != nil {
+		return errors.Wrapf(err, "failed to save ReactionPostSummaries for day=%d", dayStart)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+// GetLatestDay returns the start of the most recent day that has been summarized, or 0 if no day
+// has been summarized yet.
+func (s *SqlReactionSummaryStore) GetLatestDay() (int64, error) {
+	query := s.getQueryBuilder().
+		Select("COALESCE(MAX(Day), 0)").
+		From("ReactionEmojiSummaries")
+
+	var day int64
+	if err := s.GetReplicaX().GetBuilder(&day, query); err != nil {
+		return 0, errors.Wrap(err, "failed to get the latest day of ReactionEmojiSummaries")
+	}
+
+	return day, nil
+}
+
+func (s *SqlReactionSummaryStore) applyAnalyticsOptions(query sq.SelectBuilder, opts model.ReactionAnalyticsOptions) sq.SelectBuilder {
+	query = query.Where(sq.And{
+		sq.GtOrEq{"Day": model.ReactionSummaryDay(opts.Since)},
+		sq.LtOrEq{"Day": model.ReactionSummaryDay(opts.Until)},
+	})
+
+	if opts.TeamId != "" {
+		query = query.Where(sq.Eq{"TeamId": opts.TeamId})
+	}
+	if opts.ChannelId != "" {
+		query = query.Where(sq.Eq{"ChannelId": opts.ChannelId})
+	}
+
+	return query.OrderBy("Count DESC").Limit(uint64(opts.Limit))
+}
+
+// GetTopEmojis returns the emojis used the most in reactions over the days covered by opts.
+func (s *SqlReactionSummaryStore) GetTopEmojis(opts model.ReactionAnalyticsOptions) ([]*model.ReactionEmojiCount, error) {
+	query := s.getQueryBuilder().
+		Select("EmojiName", "SUM(Count) AS Count").
+		From("ReactionEmojiSummaries").
+		GroupBy("EmojiName")
+	query = s.applyAnalyticsOptions(query, opts).OrderBy("EmojiName ASC")
+
+	emojis := []*model.ReactionEmojiCount{}
+	if err := s.GetReplicaX().SelectBuilder(&emojis, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get top emojis from ReactionEmojiSummaries")
+	}
+
+	return emojis, nil
+}
+
+// GetTopPosts returns the posts that received the most reactions over the days covered by opts.
+func (s *SqlReactionSummaryStore) GetTopPosts(opts model.ReactionAnalyticsOptions) ([]*model.ReactionPostCount, error) {
+	query := s.getQueryBuilder().
+		Select("PostId", "ChannelId", "SUM(Count) AS Count").
+		From("ReactionPostSummaries").
+		GroupBy("PostId", "ChannelId")
+	query = s.applyAnalyticsOptions(query, opts).OrderBy("PostId ASC")
+
+	posts := []*model.ReactionPostCount{}
+	if err := s.GetReplicaX().SelectBuilder(&posts, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get top posts from ReactionPostSummaries")
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlReadReceiptStore struct {
+	*SqlStore
+}
+
+func newSqlReadReceiptStore(sqlStore *SqlStore) store.ReadReceiptStore {
+	return &SqlReadReceiptStore{sqlStore}
+}
+
+func (s *SqlReadReceiptStore) readReceiptsSelectQuery() sq.SelectBuilder {
+	return s.getQueryBuilder().
+		Select("ChannelId", "UserId", "PostId", "PostCreateAt", "ReadAt").
+		From("ReadReceipts")
+}
+
+// Save records that the user has read the channel up to the receipt's post. Receipts only ever move
+// forward, so saving a receipt for an older post than the one already recorded has no effect. The
+// receipt stored once the operation completes is returned.
+func (s *SqlReadReceiptStore) Save(receipt *model.ReadReceipt) (*model.ReadReceipt, error) {
+	if err := receipt.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("ReadReceipts").
+		Columns("ChannelId", "UserId", "PostId", "PostCreateAt", "ReadAt").
+		Values(receipt.ChannelId, receipt.UserId, receipt.PostId, receipt.PostCreateAt, receipt.ReadAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		// MySQL evaluates the assignments from left to right, so PostCreateAt has to be updated last.
+		query = query.Suffix(`ON DUPLICATE KEY UPDATE
+			PostId = IF(PostCreateAt < VALUES(PostCreateAt), VALUES(PostId), PostId),
+			ReadAt = IF(PostCreateAt < VALUES(PostCreateAt), VALUES(ReadAt), ReadAt),
+			PostCreateAt = GREATEST(PostCreateAt, VALUES(PostCreateAt))`)
+	} else {
+		query = query.Suffix(`ON CONFLICT (channelid, userid) DO UPDATE SET
+			PostId = EXCLUDED.PostId,
+			PostCreateAt = EXCLUDED.PostCreateAt,
+			ReadAt = EXCLUDED.ReadAt
+			WHERE ReadReceipts.PostCreateAt < EXCLUDED.PostCreateAt`)
+	}
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ReadReceipt with channelId=%s userId=%s", receipt.ChannelId, receipt.UserId)
+	}
+
+	var saved model.ReadReceipt
+	selectQuery := s.readReceiptsSelectQuery().Where(sq.Eq{"ChannelId": receipt.ChannelId, "UserId": receipt.UserId})
+	if err := s.GetMasterX().GetBuilder(&saved, selectQuery); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ReadReceipt with channelId=%s userId=%s", receipt.ChannelId, receipt.UserId)
+	}
+
+	return &saved, nil
+}
+
+func (s *SqlReadReceiptStore) Get(channelID, userID string) (*model.ReadReceipt, error) {
+	query := s.readReceiptsSelectQuery().Where(sq.Eq{"ChannelId": channelID, "UserId": userID})
+
+	var receipt model.ReadReceipt
+	if err := s.GetReplicaX().GetBuilder(&receipt, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ReadReceipt", channelID+"_"+userID)
+		}
+		return nil, errors.Wrapf(err, "failed to get ReadReceipt with channelId=%s userId=%s", channelID, userID)
+	}
+
+	return &receipt, nil
+}
+
+// GetForChannelSince returns the receipts of the users who have read the channel at least up to the
+// post created at postCreateAt, ordered by the time they read it.
+func (s *SqlReadReceiptStore) GetForChannelSince(channelID string, postCreateAt int64) ([]*model.ReadReceipt, error) {
+	query := s.readReceiptsSelectQuery().
+		Where(sq.And{
+			sq.Eq{"ChannelId": channelID},
+			sq.GtOrEq{"PostCreateAt": postCreateAt},
+		}).
+		OrderBy("ReadAt ASC", "UserId ASC")
+
+	receipts := []*model.ReadReceipt{}
+	if err := s.GetReplicaX().SelectBuilder(&receipts, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ReadReceipts for channelId=%s", channelID)
+	}
+
+	return receipts, nil
+}
+
+func (s *SqlReadReceiptStore) PermanentDeleteByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("ReadReceipts").
+		Where(sq.Eq{"UserId": userID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete ReadReceipts for userId=%s", userID)
+	}
+
+	return nil
+}
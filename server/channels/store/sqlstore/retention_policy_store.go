@@ -817,6 +817,64 @@ func (s *SqlRetentionPolicyStore) GetChannelPoliciesCountForUser(userID string)
 	return count, nil
 }
 
+// Preview counts the posts, and the files attached to them, that the policy would delete if it was
+// applied at now. As when policies are applied, channel policies take precedence over team
+// policies, so the channels of the policy's teams that are assigned to another policy are left out.
+func (s *SqlRetentionPolicyStore) Preview(policy *model.RetentionPolicyWithTeamAndChannelIDs, now int64) (*model.RetentionPolicyPreview, error) {
+	const millisecondsInADay = 24 * 60 * 60 * 1000
+
+	preview := &model.RetentionPolicyPreview{}
+	if policy.PostDurationDays == nil || *policy.PostDurationDays < 0 {
+		return preview, nil
+	}
+	preview.PostCutoff = now - *policy.PostDurationDays*millisecondsInADay
+
+	scope := sq.Or{}
+	if len(policy.ChannelIDs) > 0 {
+		scope = append(scope, sq.Eq{"Posts.ChannelId": policy.ChannelIDs})
+	}
+	if len(policy.TeamIDs) > 0 {
+		teamScope := sq.And{
+			sq.Eq{"Channels.TeamId": policy.TeamIDs},
+			sq.Expr(`NOT EXISTS (
+				SELECT 1 FROM RetentionPoliciesChannels
+				WHERE RetentionPoliciesChannels.ChannelId = Posts.ChannelId
+				AND RetentionPoliciesChannels.PolicyId != ?
+			)`, policy.ID),
+		}
+		if len(policy.ChannelIDs) > 0 {
+			teamScope = append(teamScope, sq.NotEq{"Posts.ChannelId": policy.ChannelIDs})
+		}
+		scope = append(scope, teamScope)
+	}
+	if len(scope) == 0 {
+		return preview, nil
+	}
+
+	postsQuery := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("Posts").
+		InnerJoin("Channels ON Posts.ChannelId = Channels.Id").
+		Where(sq.Lt{"Posts.CreateAt": preview.PostCutoff}).
+		Where(scope)
+	if err := s.GetReplicaX().GetBuilder(&preview.PostCount, postsQuery); err != nil {
+		return nil, errors.Wrap(err, "failed to count Posts for RetentionPolicy preview")
+	}
+
+	filesQuery := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("FileInfo").
+		InnerJoin("Posts ON FileInfo.PostId = Posts.Id").
+		InnerJoin("Channels ON Posts.ChannelId = Channels.Id").
+		Where(sq.Lt{"Posts.CreateAt": preview.PostCutoff}).
+		Where(scope)
+	if err := s.GetReplicaX().GetBuilder(&preview.FileCount, filesQuery); err != nil {
+		return nil, errors.Wrap(err, "failed to count FileInfo for RetentionPolicy preview")
+	}
+
+	return preview, nil
+}
+
 // RetentionPolicyBatchDeletionInfo gives information on how to delete records
 // under a retention policy; see `genericPermanentDeleteBatchForRetentionPolicies`.
 //
@@ -0,0 +1,121 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"strings"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlRoleSnapshotStore struct {
+	*SqlStore
+}
+
+// roleSnapshot stores the permissions space separated, the same way roles do.
+type roleSnapshot struct {
+	Id          string
+	RoleId      string
+	Permissions string
+	CreateAt    int64
+}
+
+func (o roleSnapshot) ToModel() *model.RoleSnapshot {
+	return &model.RoleSnapshot{
+		Id:          o.Id,
+		RoleId:      o.RoleId,
+		Permissions: strings.Fields(o.Permissions),
+		CreateAt:    o.CreateAt,
+	}
+}
+
+func roleSnapshotSliceColumns() []string {
+	return []string{
+		"Id",
+		"RoleId",
+		"Permissions",
+		"CreateAt",
+	}
+}
+
+func newSqlRoleSnapshotStore(sqlStore *SqlStore) store.RoleSnapshotStore {
+	return &SqlRoleSnapshotStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlRoleSnapshotStore) Save(snapshot *model.RoleSnapshot) (*model.RoleSnapshot, error) {
+	snapshot.PreSave()
+	if err := snapshot.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("RoleSnapshots").
+		Columns(roleSnapshotSliceColumns()...).
+		Values(snapshot.Id, snapshot.RoleId, strings.Join(snapshot.Permissions, " "), snapshot.CreateAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrap(err, "failed to save RoleSnapshot")
+	}
+
+	return snapshot, nil
+}
+
+func (s *SqlRoleSnapshotStore) Get(id string) (*model.RoleSnapshot, error) {
+	query := s.getQueryBuilder().
+		Select(roleSnapshotSliceColumns()...).
+		From("RoleSnapshots").
+		Where(sq.Eq{"Id": id})
+
+	var snapshot roleSnapshot
+	if err := s.GetReplicaX().GetBuilder(&snapshot, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("RoleSnapshot", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get RoleSnapshot with id=%s", id)
+	}
+
+	return snapshot.ToModel(), nil
+}
+
+// GetForRole returns a page of the snapshots of the role, the most recent first.
+func (s *SqlRoleSnapshotStore) GetForRole(roleID string, offset int, limit int) ([]*model.RoleSnapshot, error) {
+	query := s.getQueryBuilder().
+		Select(roleSnapshotSliceColumns()...).
+		From("RoleSnapshots").
+		Where(sq.Eq{"RoleId": roleID}).
+		OrderBy("CreateAt DESC", "Id DESC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	dbSnapshots := []roleSnapshot{}
+	if err := s.GetReplicaX().SelectBuilder(&dbSnapshots, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get RoleSnapshots for roleId=%s", roleID)
+	}
+
+	snapshots := make([]*model.RoleSnapshot, 0, len(dbSnapshots))
+	for _, snapshot := range dbSnapshots {
+		snapshots = append(snapshots, snapshot.ToModel())
+	}
+
+	return snapshots, nil
+}
+
+func (s *SqlRoleSnapshotStore) PermanentDeleteByRole(roleID string) error {
+	query := s.getQueryBuilder().
+		Delete("RoleSnapshots").
+		Where(sq.Eq{"RoleId": roleID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete RoleSnapshots for roleId=%s", roleID)
+	}
+
+	return nil
+}
@@ -31,6 +31,7 @@ type Role struct {
 	Permissions   string
 	SchemeManaged bool
 	BuiltIn       bool
+	TeamIds       string
 }
 
 type channelRolesPermissions struct {
@@ -64,6 +65,7 @@ func NewRoleFromModel(role *model.Role) *Role {
 		Permissions:   permissions,
 		SchemeManaged: role.SchemeManaged,
 		BuiltIn:       role.BuiltIn,
+		TeamIds:       strings.Join(role.TeamIds, " "),
 	}
 }
 
@@ -79,6 +81,7 @@ func (role Role) ToModel() *model.Role {
 		Permissions:   strings.Fields(role.Permissions),
 		SchemeManaged: role.SchemeManaged,
 		BuiltIn:       role.BuiltIn,
+		TeamIds:       strings.Fields(role.TeamIds),
 	}
 }
 
@@ -113,7 +116,8 @@ func (s *SqlRoleStore) Save(role *model.Role) (_ *model.Role, err error) {
 
 	res, err := s.GetMasterX().NamedExec(`UPDATE Roles
 		SET UpdateAt=:UpdateAt, DeleteAt=:DeleteAt, CreateAt=:CreateAt,  Name=:Name, DisplayName=:DisplayName,
-		Description=:Description, Permissions=:Permissions, SchemeManaged=:SchemeManaged, BuiltIn=:BuiltIn
+		Description=:Description, Permissions=:Permissions, SchemeManaged=:SchemeManaged, BuiltIn=:BuiltIn,
+		TeamIds=:TeamIds
 		 WHERE Id=:Id`, &dbRole)
 
 	if err != nil {
@@ -145,9 +149,9 @@ func (s *SqlRoleStore) createRole(role *model.Role, transaction *sqlxTxWrapper)
 	dbRole.UpdateAt = dbRole.CreateAt
 
 	if _, err := transaction.NamedExec(`INSERT INTO Roles
-		(Id, Name, DisplayName, Description, Permissions, CreateAt, UpdateAt, DeleteAt, SchemeManaged, BuiltIn)
+		(Id, Name, DisplayName, Description, Permissions, CreateAt, UpdateAt, DeleteAt, SchemeManaged, BuiltIn, TeamIds)
 		VALUES
-		(:Id, :Name, :DisplayName, :Description, :Permissions, :CreateAt, :UpdateAt, :DeleteAt, :SchemeManaged, :BuiltIn)`, dbRole); err != nil {
+		(:Id, :Name, :DisplayName, :Description, :Permissions, :CreateAt, :UpdateAt, :DeleteAt, :SchemeManaged, :BuiltIn, :TeamIds)`, dbRole); err != nil {
 		return nil, errors.Wrap(err, "failed to save Role")
 	}
 
@@ -199,7 +203,7 @@ func (s *SqlRoleStore) GetByNames(names []string) ([]*model.Role, error) {
 	}
 
 	query := s.getQueryBuilder().
-		Select("Id, Name, DisplayName, Description, CreateAt, UpdateAt, DeleteAt, Permissions, SchemeManaged, BuiltIn").
+		Select("Id, Name, DisplayName, Description, CreateAt, UpdateAt, DeleteAt, Permissions, SchemeManaged, BuiltIn, TeamIds").
 		From("Roles").
 		Where(sq.Eq{"Name": names})
 	queryString, args, err := query.ToSql()
@@ -219,7 +223,7 @@ func (s *SqlRoleStore) GetByNames(names []string) ([]*model.Role, error) {
 		err = rows.Scan(
 			&role.Id, &role.Name, &role.DisplayName, &role.Description,
 			&role.CreateAt, &role.UpdateAt, &role.DeleteAt, &role.Permissions,
-			&role.SchemeManaged, &role.BuiltIn)
+			&role.SchemeManaged, &role.BuiltIn, &role.TeamIds)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to scan values")
 		}
@@ -0,0 +1,182 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlScheduledPostStore struct {
+	*SqlStore
+}
+
+func scheduledPostSliceColumns() []string {
+	return []string{
+		"Id",
+		"CreateAt",
+		"UpdateAt",
+		"UserId",
+		"ChannelId",
+		"RootId",
+		"Message",
+		"Props",
+		"FileIds",
+		"ScheduledAt",
+		"ProcessedAt",
+		"ErrorCode",
+	}
+}
+
+func scheduledPostToSlice(scheduledPost *model.ScheduledPost) []any {
+	return []any{
+		scheduledPost.Id,
+		scheduledPost.CreateAt,
+		scheduledPost.UpdateAt,
+		scheduledPost.UserId,
+		scheduledPost.ChannelId,
+		scheduledPost.RootId,
+		scheduledPost.Message,
+		model.StringInterfaceToJSON(scheduledPost.GetProps()),
+		model.ArrayToJSON(scheduledPost.FileIds),
+		scheduledPost.ScheduledAt,
+		scheduledPost.ProcessedAt,
+		scheduledPost.ErrorCode,
+	}
+}
+
+func newSqlScheduledPostStore(sqlStore *SqlStore) store.ScheduledPostStore {
+	return &SqlScheduledPostStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlScheduledPostStore) Save(scheduledPost *model.ScheduledPost) (*model.ScheduledPost, error) {
+	scheduledPost.PreSave()
+	if err := scheduledPost.IsValid(model.PostMessageMaxRunesV2); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("ScheduledPosts").
+		Columns(scheduledPostSliceColumns()...).
+		Values(scheduledPostToSlice(scheduledPost)...)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrap(err, "failed to save ScheduledPost")
+	}
+
+	return scheduledPost, nil
+}
+
+func (s *SqlScheduledPostStore) Get(id string) (*model.ScheduledPost, error) {
+	query := s.getQueryBuilder().
+		Select(scheduledPostSliceColumns()...).
+		From("ScheduledPosts").
+		Where(sq.Eq{"Id": id})
+
+	var scheduledPost model.ScheduledPost
+	if err := s.GetReplicaX().GetBuilder(&scheduledPost, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ScheduledPost", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get ScheduledPost with id=%s", id)
+	}
+
+	return &scheduledPost, nil
+}
+
+func (s *SqlScheduledPostStore) GetForUser(userID string) ([]*model.ScheduledPost, error) {
+	query := s.getQueryBuilder().
+		Select(scheduledPostSliceColumns()...).
+		From("ScheduledPosts").
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("ScheduledAt ASC", "Id ASC")
+
+	scheduledPosts := []*model.ScheduledPost{}
+	if err := s.GetReplicaX().SelectBuilder(&scheduledPosts, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ScheduledPosts for userId=%s", userID)
+	}
+
+	return scheduledPosts, nil
+}
+
+func (s *SqlScheduledPostStore) CountPendingForUser(userID string) (int64, error) {
+	query := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("ScheduledPosts").
+		Where(sq.Eq{
+			"UserId":      userID,
+			"ProcessedAt": 0,
+		})
+
+	var count int64
+	if err := s.GetReplicaX().GetBuilder(&count, query); err != nil {
+		return 0, errors.Wrapf(err, "failed to count pending ScheduledPosts for userId=%s", userID)
+	}
+
+	return count, nil
+}
+
+// GetPendingBefore returns up to limit scheduled posts that have not been processed yet
+// and are due at or before until, oldest first.
+func (s *SqlScheduledPostStore) GetPendingBefore(until int64, limit int) ([]*model.ScheduledPost, error) {
+	query := s.getQueryBuilder().
+		Select(scheduledPostSliceColumns()...).
+		From("ScheduledPosts").
+		Where(sq.And{
+			sq.Eq{"ProcessedAt": 0},
+			sq.LtOrEq{"ScheduledAt": until},
+		}).
+		OrderBy("ScheduledAt ASC", "Id ASC").
+		Limit(uint64(limit))
+
+	scheduledPosts := []*model.ScheduledPost{}
+	if err := s.GetMasterX().SelectBuilder(&scheduledPosts, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get pending ScheduledPosts")
+	}
+
+	return scheduledPosts, nil
+}
+
+func (s *SqlScheduledPostStore) Update(scheduledPost *model.ScheduledPost) (*model.ScheduledPost, error) {
+	scheduledPost.PreUpdate()
+	if err := scheduledPost.IsValid(model.PostMessageMaxRunesV2); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Update("ScheduledPosts").
+		Set("UpdateAt", scheduledPost.UpdateAt).
+		Set("Message", scheduledPost.Message).
+		Set("Props", model.StringInterfaceToJSON(scheduledPost.GetProps())).
+		Set("FileIds", model.ArrayToJSON(scheduledPost.FileIds)).
+		Set("ScheduledAt", scheduledPost.ScheduledAt).
+		Set("ProcessedAt", scheduledPost.ProcessedAt).
+		Set("ErrorCode", scheduledPost.ErrorCode).
+		Where(sq.Eq{"Id": scheduledPost.Id})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to update ScheduledPost with id=%s", scheduledPost.Id)
+	}
+
+	return scheduledPost, nil
+}
+
+func (s *SqlScheduledPostStore) Delete(id string) error {
+	query := s.getQueryBuilder().
+		Delete("ScheduledPosts").
+		Where(sq.Eq{"Id": id})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete ScheduledPost with id=%s", id)
+	}
+
+	return nil
+}
@@ -293,7 +293,7 @@ func (me SqlSessionStore) AnalyticsSessionCount() (int64, error) {
 		FROM
 			Sessions
 		WHERE ExpiresAt > ?`
-	if err := me.GetReplicaX().Get(&count, query, model.GetMillis()); err != nil {
+	if err := me.GetAnalyticsReplicaX().Get(&count, query, model.GetMillis()); err != nil {
 		return int64(0), errors.Wrap(err, "failed to count Sessions")
 	}
 	return count, nil
@@ -48,8 +48,8 @@ func (s SqlSharedChannelStore) Save(sc *model.SharedChannel) (sh *model.SharedCh
 	defer finalizeTransactionX(transaction, &err)
 
 	query, args, err := s.getQueryBuilder().Insert("SharedChannels").
-		Columns("ChannelId", "TeamId", "Home", "ReadOnly", "ShareName", "ShareDisplayName", "SharePurpose", "ShareHeader", "CreatorId", "CreateAt", "UpdateAt", "RemoteId").
-		Values(sc.ChannelId, sc.TeamId, sc.Home, sc.ReadOnly, sc.ShareName, sc.ShareDisplayName, sc.SharePurpose, sc.ShareHeader, sc.CreatorId, sc.CreateAt, sc.UpdateAt, sc.RemoteId).
+		Columns("ChannelId", "TeamId", "Home", "ReadOnly", "ShareName", "ShareDisplayName", "SharePurpose", "ShareHeader", "CreatorId", "CreateAt", "UpdateAt", "RemoteId", "StripAttachments", "RedactPatterns").
+		Values(sc.ChannelId, sc.TeamId, sc.Home, sc.ReadOnly, sc.ShareName, sc.ShareDisplayName, sc.SharePurpose, sc.ShareHeader, sc.CreatorId, sc.CreateAt, sc.UpdateAt, sc.RemoteId, sc.StripAttachments, sc.RedactPatterns).
 		ToSql()
 	if err != nil {
 		return nil, errors.Wrapf(err, "savesharedchannel_tosql")
@@ -226,6 +226,8 @@ func (s SqlSharedChannelStore) Update(sc *model.SharedChannel) (*model.SharedCha
 		Set("CreateAt", sc.CreateAt).
 		Set("UpdateAt", sc.UpdateAt).
 		Set("RemoteId", sc.RemoteId).
+		Set("StripAttachments", sc.StripAttachments).
+		Set("RedactPatterns", sc.RedactPatterns).
 		Where(sq.Eq{"ChannelId": sc.ChannelId}).ToSql()
 	if err != nil {
 		return nil, errors.Wrapf(err, "updatesharedchannel_tosql")
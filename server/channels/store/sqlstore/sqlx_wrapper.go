@@ -15,6 +15,7 @@ import (
 	"github.com/jmoiron/sqlx"
 
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
 	"github.com/mattermost/mattermost-server/v6/server/channels/store/storetest"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
 )
@@ -64,52 +65,93 @@ var namedParamRegex = regexp.MustCompile(`:\w+`)
 
 type sqlxDBWrapper struct {
 	*sqlx.DB
+	// ctx is the context the queries are run with, to cancel them along with the request they
+	// are run for. It's nil for the queries run in the background.
+	ctx          context.Context
 	queryTimeout time.Duration
-	trace        bool
+	queryObserver
 }
 
-func newSqlxDBWrapper(db *sqlx.DB, timeout time.Duration, trace bool) *sqlxDBWrapper {
+func newSqlxDBWrapper(db *sqlx.DB, timeout time.Duration, trace bool, slowQueryThreshold time.Duration, metrics einterfaces.MetricsInterface) *sqlxDBWrapper {
 	return &sqlxDBWrapper{
 		DB:           db,
 		queryTimeout: timeout,
-		trace:        trace,
+		queryObserver: queryObserver{
+			trace:              trace,
+			slowQueryThreshold: slowQueryThreshold,
+			metrics:            metrics,
+			explainDB:          db,
+		},
 	}
 }
 
+// withContext returns a copy of the wrapper running its queries with the context, cancelled when
+// the context is.
+func (w *sqlxDBWrapper) withContext(ctx context.Context) *sqlxDBWrapper {
+	if ctx == nil {
+		return w
+	}
+
+	wrapper := *w
+	wrapper.ctx = ctx
+	return &wrapper
+}
+
+// withTimeout returns a copy of the wrapper timing its queries out after the timeout, the wrapper
+// itself when it already does.
+func (w *sqlxDBWrapper) withTimeout(timeout time.Duration) *sqlxDBWrapper {
+	if timeout <= 0 || timeout == w.queryTimeout {
+		return w
+	}
+
+	wrapper := *w
+	wrapper.queryTimeout = timeout
+	return &wrapper
+}
+
+// baseContext returns the context of the wrapper, the background context when it has none.
+func (w *sqlxDBWrapper) baseContext() context.Context {
+	if w.ctx == nil {
+		return context.Background()
+	}
+	return w.ctx
+}
+
+// queryContext returns the context of a query, derived from the context of the wrapper and
+// timing out after its query timeout.
+func (w *sqlxDBWrapper) queryContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(w.baseContext(), w.queryTimeout)
+}
+
 func (w *sqlxDBWrapper) Stats() sql.DBStats {
 	return w.DB.Stats()
 }
 
 func (w *sqlxDBWrapper) Beginx() (*sqlxTxWrapper, error) {
-	tx, err := w.DB.Beginx()
-	if err != nil {
-		return nil, err
-	}
-
-	return newSqlxTxWrapper(tx, w.queryTimeout, w.trace), nil
+	return w.BeginXWithIsolation(nil)
 }
 
 func (w *sqlxDBWrapper) BeginXWithIsolation(opts *sql.TxOptions) (*sqlxTxWrapper, error) {
-	tx, err := w.DB.BeginTxx(context.Background(), opts)
+	// The transaction is rolled back if its context is cancelled.
+	ctx := w.baseContext()
+	tx, err := w.DB.BeginTxx(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	return newSqlxTxWrapper(tx, w.queryTimeout, w.trace), nil
+	return newSqlxTxWrapper(tx, ctx, w.queryTimeout, w.queryObserver), nil
 }
 
 func (w *sqlxDBWrapper) Get(dest any, query string, args ...any) error {
 	query = w.DB.Rebind(query)
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
-	return w.DB.GetContext(ctx, dest, query, args...)
+	err := w.DB.GetContext(ctx, dest, query, args...)
+	w.observeCancellation(ctx, err)
+	return err
 }
 
 func (w *sqlxDBWrapper) GetBuilder(dest any, builder Builder) error {
@@ -125,16 +167,14 @@ func (w *sqlxDBWrapper) NamedExec(query string, arg any) (sql.Result, error) {
 	if w.DB.DriverName() == model.DatabaseDriverPostgres {
 		query = namedParamRegex.ReplaceAllStringFunc(query, strings.ToLower)
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), arg)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), arg)
 
-	return w.DB.NamedExecContext(ctx, query, arg)
+	res, err := w.DB.NamedExecContext(ctx, query, arg)
+	w.observeCancellation(ctx, err)
+	return res, err
 }
 
 func (w *sqlxDBWrapper) Exec(query string, args ...any) (sql.Result, error) {
@@ -155,11 +195,7 @@ func (w *sqlxDBWrapper) ExecBuilder(builder Builder) (sql.Result, error) {
 func (w *sqlxDBWrapper) ExecNoTimeout(query string, args ...any) (sql.Result, error) {
 	query = w.DB.Rebind(query)
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
 	return w.DB.ExecContext(context.Background(), query, args...)
 }
@@ -167,64 +203,56 @@ func (w *sqlxDBWrapper) ExecNoTimeout(query string, args ...any) (sql.Result, er
 // ExecRaw is like Exec but without any rebinding of params. You need to pass
 // the exact param types of your target database.
 func (w *sqlxDBWrapper) ExecRaw(query string, args ...any) (sql.Result, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
-	return w.DB.ExecContext(ctx, query, args...)
+	res, err := w.DB.ExecContext(ctx, query, args...)
+	w.observeCancellation(ctx, err)
+	return res, err
 }
 
 func (w *sqlxDBWrapper) NamedQuery(query string, arg any) (*sqlx.Rows, error) {
 	if w.DB.DriverName() == model.DatabaseDriverPostgres {
 		query = namedParamRegex.ReplaceAllStringFunc(query, strings.ToLower)
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), arg)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), arg)
 
-	return w.DB.NamedQueryContext(ctx, query, arg)
+	res, err := w.DB.NamedQueryContext(ctx, query, arg)
+	w.observeCancellation(ctx, err)
+	return res, err
 }
 
 func (w *sqlxDBWrapper) QueryRowX(query string, args ...any) *sqlx.Row {
 	query = w.DB.Rebind(query)
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
-	return w.DB.QueryRowxContext(ctx, query, args...)
+	row := w.DB.QueryRowxContext(ctx, query, args...)
+	w.observeCancellation(ctx, row.Err())
+	return row
 }
 
 func (w *sqlxDBWrapper) QueryX(query string, args ...any) (*sqlx.Rows, error) {
 	query = w.DB.Rebind(query)
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
-	return w.DB.QueryxContext(ctx, query, args)
+	res, err := w.DB.QueryxContext(ctx, query, args)
+	w.observeCancellation(ctx, err)
+	return res, err
 }
 
 func (w *sqlxDBWrapper) Select(dest any, query string, args ...any) error {
-	return w.SelectCtx(context.Background(), dest, query, args...)
+	return w.SelectCtx(w.baseContext(), dest, query, args...)
 }
 
 func (w *sqlxDBWrapper) SelectCtx(ctx context.Context, dest any, query string, args ...any) error {
@@ -232,13 +260,11 @@ func (w *sqlxDBWrapper) SelectCtx(ctx context.Context, dest any, query string, a
 	ctx, cancel := context.WithTimeout(ctx, w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
-	return w.DB.SelectContext(ctx, dest, query, args...)
+	err := w.DB.SelectContext(ctx, dest, query, args...)
+	w.observeCancellation(ctx, err)
+	return err
 }
 
 func (w *sqlxDBWrapper) SelectBuilder(dest any, builder Builder) error {
@@ -252,30 +278,36 @@ func (w *sqlxDBWrapper) SelectBuilder(dest any, builder Builder) error {
 
 type sqlxTxWrapper struct {
 	*sqlx.Tx
+	ctx          context.Context
 	queryTimeout time.Duration
-	trace        bool
+	queryObserver
 }
 
-func newSqlxTxWrapper(tx *sqlx.Tx, timeout time.Duration, trace bool) *sqlxTxWrapper {
+func newSqlxTxWrapper(tx *sqlx.Tx, ctx context.Context, timeout time.Duration, observer queryObserver) *sqlxTxWrapper {
 	return &sqlxTxWrapper{
-		Tx:           tx,
-		queryTimeout: timeout,
-		trace:        trace,
+		Tx:            tx,
+		ctx:           ctx,
+		queryTimeout:  timeout,
+		queryObserver: observer,
 	}
 }
 
+// queryContext returns the context of a query, derived from the context the transaction was
+// begun with and timing out after its query timeout.
+func (w *sqlxTxWrapper) queryContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(w.ctx, w.queryTimeout)
+}
+
 func (w *sqlxTxWrapper) Get(dest any, query string, args ...any) error {
 	query = w.Tx.Rebind(query)
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
-	return w.Tx.GetContext(ctx, dest, query, args...)
+	err := w.Tx.GetContext(ctx, dest, query, args...)
+	w.observeCancellation(ctx, err)
+	return err
 }
 
 func (w *sqlxTxWrapper) GetBuilder(dest any, builder Builder) error {
@@ -296,11 +328,7 @@ func (w *sqlxTxWrapper) Exec(query string, args ...any) (sql.Result, error) {
 func (w *sqlxTxWrapper) ExecNoTimeout(query string, args ...any) (sql.Result, error) {
 	query = w.Tx.Rebind(query)
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
 	return w.Tx.ExecContext(context.Background(), query, args...)
 }
@@ -317,46 +345,38 @@ func (w *sqlxTxWrapper) ExecBuilder(builder Builder) (sql.Result, error) {
 // ExecRaw is like Exec but without any rebinding of params. You need to pass
 // the exact param types of your target database.
 func (w *sqlxTxWrapper) ExecRaw(query string, args ...any) (sql.Result, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
-	return w.Tx.ExecContext(ctx, query, args...)
+	res, err := w.Tx.ExecContext(ctx, query, args...)
+	w.observeCancellation(ctx, err)
+	return res, err
 }
 
 func (w *sqlxTxWrapper) NamedExec(query string, arg any) (sql.Result, error) {
 	if w.Tx.DriverName() == model.DatabaseDriverPostgres {
 		query = namedParamRegex.ReplaceAllStringFunc(query, strings.ToLower)
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), arg)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), arg)
 
-	return w.Tx.NamedExecContext(ctx, query, arg)
+	res, err := w.Tx.NamedExecContext(ctx, query, arg)
+	w.observeCancellation(ctx, err)
+	return res, err
 }
 
 func (w *sqlxTxWrapper) NamedQuery(query string, arg any) (*sqlx.Rows, error) {
 	if w.Tx.DriverName() == model.DatabaseDriverPostgres {
 		query = namedParamRegex.ReplaceAllStringFunc(query, strings.ToLower)
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), arg)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), arg)
 
 	// There is no tx.NamedQueryContext support in the sqlx API. (https://github.com/jmoiron/sqlx/issues/447)
 	// So we need to implement this ourselves.
@@ -385,50 +405,45 @@ func (w *sqlxTxWrapper) NamedQuery(query string, arg any) (*sqlx.Rows, error) {
 			err:  ctx.Err(),
 		}
 	}
+	w.observeCancellation(ctx, res.err)
 
 	return res.rows, res.err
 }
 
 func (w *sqlxTxWrapper) QueryRowX(query string, args ...any) *sqlx.Row {
 	query = w.Tx.Rebind(query)
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
-	return w.Tx.QueryRowxContext(ctx, query, args...)
+	row := w.Tx.QueryRowxContext(ctx, query, args...)
+	w.observeCancellation(ctx, row.Err())
+	return row
 }
 
 func (w *sqlxTxWrapper) QueryX(query string, args ...any) (*sqlx.Rows, error) {
 	query = w.Tx.Rebind(query)
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
-	return w.Tx.QueryxContext(ctx, query, args)
+	res, err := w.Tx.QueryxContext(ctx, query, args)
+	w.observeCancellation(ctx, err)
+	return res, err
 }
 
 func (w *sqlxTxWrapper) Select(dest any, query string, args ...any) error {
 	query = w.Tx.Rebind(query)
-	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
+	ctx, cancel := w.queryContext()
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.observeQuery(query, time.Now(), args)
 
-	return w.Tx.SelectContext(ctx, dest, query, args...)
+	err := w.Tx.SelectContext(ctx, dest, query, args...)
+	w.observeCancellation(ctx, err)
+	return err
 }
 
 func (w *sqlxTxWrapper) SelectBuilder(dest any, builder Builder) error {
@@ -7,11 +7,14 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces/mocks"
 )
 
 func TestSqlX(t *testing.T) {
@@ -84,4 +87,61 @@ func TestSqlX(t *testing.T) {
 			assert.Equal(t, q.out, out)
 		}
 	})
+	t.Run("Cancellation", func(t *testing.T) {
+		testDrivers := []string{
+			model.DatabaseDriverPostgres,
+			model.DatabaseDriverMysql,
+		}
+
+		for _, driver := range testDrivers {
+			settings, err := makeSqlSettings(driver)
+			if err != nil {
+				continue
+			}
+			*settings.QueryTimeout = 1
+			mockMetrics := &mocks.MetricsInterface{}
+			mockMetrics.On("RegisterDBCollector", mock.Anything, mock.Anything)
+			store := &SqlStore{
+				rrCounter: 0,
+				srCounter: 0,
+				settings:  settings,
+				metrics:   mockMetrics,
+			}
+
+			store.initConnection()
+
+			defer store.Close()
+
+			var query string
+			if store.DriverName() == model.DatabaseDriverMysql {
+				query = `SELECT SLEEP(2)`
+			} else if store.DriverName() == model.DatabaseDriverPostgres {
+				query = `SELECT pg_sleep(2)`
+			}
+
+			mockMetrics.On("IncrementStoreQueryCancelledCounter", "timeout").Once()
+			_, err = store.GetMasterX().Exec(query)
+			require.Error(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(100*time.Millisecond, cancel)
+
+			mockMetrics.On("IncrementStoreQueryCancelledCounter", "cancelled").Once()
+			_, err = store.GetMasterX().withContext(ctx).Exec(query)
+			require.Error(t, err)
+
+			mockMetrics.AssertExpectations(t)
+		}
+	})
+
+	t.Run("withTimeout", func(t *testing.T) {
+		wrapper := newSqlxDBWrapper(nil, 30*time.Second, false, 0, nil)
+
+		assert.Same(t, wrapper, wrapper.withTimeout(30*time.Second))
+		assert.Same(t, wrapper, wrapper.withTimeout(0))
+
+		withTimeout := wrapper.withTimeout(time.Minute)
+		assert.Equal(t, time.Minute, withTimeout.queryTimeout)
+		assert.Equal(t, 30*time.Second, wrapper.queryTimeout)
+	})
 }
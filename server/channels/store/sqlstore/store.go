@@ -63,6 +63,9 @@ const (
 	migrationsDirectionUp   migrationDirection = "up"
 	migrationsDirectionDown migrationDirection = "down"
 
+	migrationsLockKey       = "mm-lock-key"
+	onlineMigrationsLockKey = "mm-online-migrations-lock-key"
+
 	replicaLagPrefix = "replica-lag"
 
 	RemoteClusterSiteURLUniqueIndex = "remote_clusters_site_url_unique"
@@ -113,6 +116,25 @@ type SqlStoreStores struct {
 	postPriority         store.PostPriorityStore
 	postAcknowledgement  store.PostAcknowledgementStore
 	trueUpReview         store.TrueUpReviewStore
+	scheduledPost        store.ScheduledPostStore
+	postTranslation      store.PostTranslationStore
+	postEmbedding        store.PostEmbeddingStore
+	activity             store.ActivityStore
+	readReceipt          store.ReadReceiptStore
+	reactionSummary      store.ReactionSummaryStore
+	matrixBridge         store.MatrixBridgeStore
+	channelBookmark      store.ChannelBookmarkStore
+	postExpiration       store.PostExpirationStore
+	webAuthnCredential   store.WebAuthnCredentialStore
+	roleSnapshot         store.RoleSnapshotStore
+	guestSponsorship     store.GuestSponsorshipStore
+	channelMemberExpiry  store.ChannelMemberExpiryStore
+	channelJoinRequest   store.ChannelJoinRequestStore
+	teamTemplate         store.TeamTemplateStore
+	teamArchive          store.TeamArchiveStore
+	supportAccessConsent store.SupportAccessConsentStore
+	configChange         store.ConfigChangeStore
+	archivedPost         store.ArchivedPostStore
 }
 
 type SqlStore struct {
@@ -138,6 +160,17 @@ type SqlStore struct {
 
 	isBinaryParam             bool
 	pgDefaultTextSearchConfig string
+
+	// onlineMigrations are the online migrations deferred to the background, and their status.
+	onlineMigrationsMut     sync.Mutex
+	onlineMigrationsPending []*onlineMigration
+	onlineMigrationsStatus  map[int]*model.OnlineMigrationStatus
+	onlineMigrationsCancel  context.CancelFunc
+	onlineMigrationsDone    chan struct{}
+
+	// partitionedTables are the tables partitioned by month, by lower case name.
+	partitionedTablesMut sync.RWMutex
+	partitionedTables    map[string]bool
 }
 
 func New(settings model.SqlSettings, metrics einterfaces.MetricsInterface) *SqlStore {
@@ -222,6 +255,28 @@ func New(settings model.SqlSettings, metrics einterfaces.MetricsInterface) *SqlS
 	store.stores.postPriority = newSqlPostPriorityStore(store)
 	store.stores.postAcknowledgement = newSqlPostAcknowledgementStore(store)
 	store.stores.trueUpReview = newSqlTrueUpReviewStore(store)
+	store.stores.scheduledPost = newSqlScheduledPostStore(store)
+	store.stores.postTranslation = newSqlPostTranslationStore(store)
+	store.stores.postEmbedding = newSqlPostEmbeddingStore(store)
+	store.stores.activity = newSqlActivityStore(store)
+	store.stores.readReceipt = newSqlReadReceiptStore(store)
+	store.stores.reactionSummary = newSqlReactionSummaryStore(store)
+	store.stores.matrixBridge = newSqlMatrixBridgeStore(store)
+	store.stores.channelBookmark = newSqlChannelBookmarkStore(store)
+	store.stores.postExpiration = newSqlPostExpirationStore(store)
+	store.stores.webAuthnCredential = newSqlWebAuthnCredentialStore(store)
+	store.stores.roleSnapshot = newSqlRoleSnapshotStore(store)
+	store.stores.guestSponsorship = newSqlGuestSponsorshipStore(store)
+	store.stores.channelMemberExpiry = newSqlChannelMemberExpiryStore(store)
+	store.stores.channelJoinRequest = newSqlChannelJoinRequestStore(store)
+	store.stores.teamTemplate = newSqlTeamTemplateStore(store)
+	store.stores.teamArchive = newSqlTeamArchiveStore(store)
+	store.stores.supportAccessConsent = newSqlSupportAccessConsentStore(store)
+	store.stores.configChange = newSqlConfigChangeStore(store)
+	store.stores.archivedPost = newSqlArchivedPostStore(store)
+
+	store.startOnlineMigrations()
+	store.loadPartitionedTables()
 
 	store.stores.preference.(*SqlPreferenceStore).deleteUnusedFeatures()
 
@@ -299,7 +354,9 @@ func (ss *SqlStore) initConnection() {
 	handle := SetupConnection("master", dataSource, ss.settings)
 	ss.masterX = newSqlxDBWrapper(sqlx.NewDb(handle, ss.DriverName()),
 		time.Duration(*ss.settings.QueryTimeout)*time.Second,
-		*ss.settings.Trace)
+		*ss.settings.Trace,
+		time.Duration(*ss.settings.SlowQueryThresholdMilliseconds)*time.Millisecond,
+		ss.metrics)
 	if ss.DriverName() == model.DatabaseDriverMysql {
 		ss.masterX.MapperFunc(noOpMapper)
 	}
@@ -313,7 +370,9 @@ func (ss *SqlStore) initConnection() {
 			handle := SetupConnection(fmt.Sprintf("replica-%v", i), replica, ss.settings)
 			ss.ReplicaXs[i] = newSqlxDBWrapper(sqlx.NewDb(handle, ss.DriverName()),
 				time.Duration(*ss.settings.QueryTimeout)*time.Second,
-				*ss.settings.Trace)
+				*ss.settings.Trace,
+				time.Duration(*ss.settings.SlowQueryThresholdMilliseconds)*time.Millisecond,
+				ss.metrics)
 			if ss.DriverName() == model.DatabaseDriverMysql {
 				ss.ReplicaXs[i].MapperFunc(noOpMapper)
 			}
@@ -328,8 +387,10 @@ func (ss *SqlStore) initConnection() {
 		for i, replica := range ss.settings.DataSourceSearchReplicas {
 			handle := SetupConnection(fmt.Sprintf("search-replica-%v", i), replica, ss.settings)
 			ss.searchReplicaXs[i] = newSqlxDBWrapper(sqlx.NewDb(handle, ss.DriverName()),
-				time.Duration(*ss.settings.QueryTimeout)*time.Second,
-				*ss.settings.Trace)
+				time.Duration(*ss.settings.SearchQueryTimeout)*time.Second,
+				*ss.settings.Trace,
+				time.Duration(*ss.settings.SlowQueryThresholdMilliseconds)*time.Millisecond,
+				ss.metrics)
 			if ss.DriverName() == model.DatabaseDriverMysql {
 				ss.searchReplicaXs[i].MapperFunc(noOpMapper)
 			}
@@ -434,7 +495,9 @@ func (ss *SqlStore) GetMasterX() *sqlxDBWrapper {
 func (ss *SqlStore) SetMasterX(db *sql.DB) {
 	ss.masterX = newSqlxDBWrapper(sqlx.NewDb(db, ss.DriverName()),
 		time.Duration(*ss.settings.QueryTimeout)*time.Second,
-		*ss.settings.Trace)
+		*ss.settings.Trace,
+		time.Duration(*ss.settings.SlowQueryThresholdMilliseconds)*time.Millisecond,
+		ss.metrics)
 	if ss.DriverName() == model.DatabaseDriverMysql {
 		ss.masterX.MapperFunc(noOpMapper)
 	}
@@ -444,13 +507,15 @@ func (ss *SqlStore) GetInternalMasterDB() *sql.DB {
 	return ss.GetMasterX().DB.DB
 }
 
+// GetSearchReplicaX returns a search replica, timing its queries out after the SearchQueryTimeout.
+// It falls back to the replicas or the master when there are none.
 func (ss *SqlStore) GetSearchReplicaX() *sqlxDBWrapper {
 	if !ss.hasLicense() {
-		return ss.GetMasterX()
+		return ss.GetMasterX().withTimeout(time.Duration(*ss.settings.SearchQueryTimeout) * time.Second)
 	}
 
 	if len(ss.settings.DataSourceSearchReplicas) == 0 {
-		return ss.GetReplicaX()
+		return ss.GetReplicaX().withTimeout(time.Duration(*ss.settings.SearchQueryTimeout) * time.Second)
 	}
 
 	rrNum := atomic.AddInt64(&ss.srCounter, 1) % int64(len(ss.searchReplicaXs))
@@ -466,6 +531,12 @@ func (ss *SqlStore) GetReplicaX() *sqlxDBWrapper {
 	return ss.ReplicaXs[rrNum]
 }
 
+// GetAnalyticsReplicaX returns a replica timing its queries out after the AnalyticsQueryTimeout,
+// the analytics scanning whole tables.
+func (ss *SqlStore) GetAnalyticsReplicaX() *sqlxDBWrapper {
+	return ss.GetReplicaX().withTimeout(time.Duration(*ss.settings.AnalyticsQueryTimeout) * time.Second)
+}
+
 func (ss *SqlStore) GetInternalReplicaDBs() []*sql.DB {
 	if len(ss.settings.DataSourceReplicas) == 0 || ss.lockedToMaster || !ss.hasLicense() {
 		return []*sql.DB{
@@ -804,6 +875,8 @@ func (ss *SqlStore) RecycleDBConnections(d time.Duration) {
 }
 
 func (ss *SqlStore) Close() {
+	ss.stopOnlineMigrations()
+
 	ss.masterX.Close()
 	for _, replica := range ss.ReplicaXs {
 		replica.Close()
@@ -990,6 +1063,82 @@ func (ss *SqlStore) TrueUpReview() store.TrueUpReviewStore {
 	return ss.stores.trueUpReview
 }
 
+func (ss *SqlStore) ScheduledPost() store.ScheduledPostStore {
+	return ss.stores.scheduledPost
+}
+
+func (ss *SqlStore) PostTranslation() store.PostTranslationStore {
+	return ss.stores.postTranslation
+}
+
+func (ss *SqlStore) PostEmbedding() store.PostEmbeddingStore {
+	return ss.stores.postEmbedding
+}
+
+func (ss *SqlStore) Activity() store.ActivityStore {
+	return ss.stores.activity
+}
+
+func (ss *SqlStore) ReadReceipt() store.ReadReceiptStore {
+	return ss.stores.readReceipt
+}
+
+func (ss *SqlStore) ReactionSummary() store.ReactionSummaryStore {
+	return ss.stores.reactionSummary
+}
+
+func (ss *SqlStore) MatrixBridge() store.MatrixBridgeStore {
+	return ss.stores.matrixBridge
+}
+
+func (ss *SqlStore) ChannelBookmark() store.ChannelBookmarkStore {
+	return ss.stores.channelBookmark
+}
+
+func (ss *SqlStore) PostExpiration() store.PostExpirationStore {
+	return ss.stores.postExpiration
+}
+
+func (ss *SqlStore) WebAuthnCredential() store.WebAuthnCredentialStore {
+	return ss.stores.webAuthnCredential
+}
+
+func (ss *SqlStore) RoleSnapshot() store.RoleSnapshotStore {
+	return ss.stores.roleSnapshot
+}
+
+func (ss *SqlStore) GuestSponsorship() store.GuestSponsorshipStore {
+	return ss.stores.guestSponsorship
+}
+
+func (ss *SqlStore) ChannelMemberExpiry() store.ChannelMemberExpiryStore {
+	return ss.stores.channelMemberExpiry
+}
+
+func (ss *SqlStore) ChannelJoinRequest() store.ChannelJoinRequestStore {
+	return ss.stores.channelJoinRequest
+}
+
+func (ss *SqlStore) TeamTemplate() store.TeamTemplateStore {
+	return ss.stores.teamTemplate
+}
+
+func (ss *SqlStore) TeamArchive() store.TeamArchiveStore {
+	return ss.stores.teamArchive
+}
+
+func (ss *SqlStore) SupportAccessConsent() store.SupportAccessConsentStore {
+	return ss.stores.supportAccessConsent
+}
+
+func (ss *SqlStore) ConfigChange() store.ConfigChangeStore {
+	return ss.stores.configChange
+}
+
+func (ss *SqlStore) ArchivedPost() store.ArchivedPostStore {
+	return ss.stores.archivedPost
+}
+
 func (ss *SqlStore) DropAllTables() {
 	if ss.DriverName() == model.DatabaseDriverPostgres {
 		ss.masterX.Exec(`DO
@@ -1056,11 +1205,29 @@ func (ss *SqlStore) hasLicense() bool {
 }
 
 func (ss *SqlStore) migrate(direction migrationDirection) error {
+	engine, driver, closeEngine, err := ss.newMigrationEngine(context.Background(), migrationsLockKey)
+	if err != nil {
+		return err
+	}
+	defer closeEngine()
+
+	switch direction {
+	case migrationsDirectionDown:
+		_, err = engine.ApplyDown(-1)
+		return err
+	default:
+		return ss.applyMigrations(engine, driver)
+	}
+}
+
+// newMigrationEngine returns a migration engine holding the lock of the key, for the migrations
+// to be applied by a single server, along with its driver and the function closing both.
+func (ss *SqlStore) newMigrationEngine(ctx context.Context, lockKey string) (*morph.Morph, drivers.Driver, func(), error) {
 	assets := db.Assets()
 
 	assetsList, err := assets.ReadDir(path.Join("migrations", ss.DriverName()))
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
 	assetNamesForDriver := make([]string, len(assetsList))
@@ -1075,51 +1242,57 @@ func (ss *SqlStore) migrate(direction migrationDirection) error {
 		},
 	})
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
 	var driver drivers.Driver
+	var conn *dbsql.DB
 	switch ss.DriverName() {
 	case model.DatabaseDriverMysql:
 		dataSource, rErr := ResetReadTimeout(*ss.settings.DataSource)
 		if rErr != nil {
 			mlog.Fatal("Failed to reset read timeout from datasource.", mlog.Err(rErr), mlog.String("src", *ss.settings.DataSource))
-			return rErr
+			return nil, nil, nil, rErr
 		}
 		dataSource, err = AppendMultipleStatementsFlag(dataSource)
 		if err != nil {
-			return err
+			return nil, nil, nil, err
 		}
-		db := SetupConnection("master", dataSource, ss.settings)
-		driver, err = ms.WithInstance(db)
-		defer db.Close()
+		conn = SetupConnection("master", dataSource, ss.settings)
+		driver, err = ms.WithInstance(conn)
 	case model.DatabaseDriverPostgres:
 		driver, err = ps.WithInstance(ss.GetMasterX().DB.DB)
 	default:
 		err = fmt.Errorf("unsupported database type %s for migration", ss.DriverName())
 	}
 	if err != nil {
-		return err
+		if conn != nil {
+			conn.Close()
+		}
+		return nil, nil, nil, err
 	}
 
 	opts := []morph.EngineOption{
 		morph.WithLogger(log.New(&morphWriter{}, "", log.Lshortfile)),
-		morph.WithLock("mm-lock-key"),
+		morph.WithLock(lockKey),
 		morph.SetStatementTimeoutInSeconds(*ss.settings.MigrationsStatementTimeoutSeconds),
 	}
-	engine, err := morph.New(context.Background(), driver, src, opts...)
+	engine, err := morph.New(ctx, driver, src, opts...)
 	if err != nil {
-		return err
+		if conn != nil {
+			conn.Close()
+		}
+		return nil, nil, nil, err
 	}
-	defer engine.Close()
 
-	switch direction {
-	case migrationsDirectionDown:
-		_, err = engine.ApplyDown(-1)
-		return err
-	default:
-		return engine.ApplyAll()
+	closeEngine := func() {
+		engine.Close()
+		if conn != nil {
+			conn.Close()
+		}
 	}
+
+	return engine, driver, closeEngine, nil
 }
 
 func convertMySQLFullTextColumnsToPostgres(columnNames string) string {
@@ -0,0 +1,123 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlSupportAccessConsentStore struct {
+	*SqlStore
+}
+
+func supportAccessConsentSliceColumns() []string {
+	return []string{
+		"Id",
+		"UserId",
+		"AdminId",
+		"CreateAt",
+		"ExpiresAt",
+		"RevokedAt",
+	}
+}
+
+func newSqlSupportAccessConsentStore(sqlStore *SqlStore) store.SupportAccessConsentStore {
+	return &SqlSupportAccessConsentStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlSupportAccessConsentStore) Save(consent *model.SupportAccessConsent) (*model.SupportAccessConsent, error) {
+	if consent.Id != "" {
+		return nil, store.NewErrInvalidInput("SupportAccessConsent", "Id", consent.Id)
+	}
+
+	consent.PreSave()
+	if err := consent.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("SupportAccessConsents").
+		Columns(supportAccessConsentSliceColumns()...).
+		Values(consent.Id, consent.UserId, consent.AdminId, consent.CreateAt, consent.ExpiresAt, consent.RevokedAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save SupportAccessConsent with id=%s", consent.Id)
+	}
+
+	return consent, nil
+}
+
+// GetForUser returns the consents the user granted, the newest first.
+func (s *SqlSupportAccessConsentStore) GetForUser(userID string) ([]*model.SupportAccessConsent, error) {
+	query := s.getQueryBuilder().
+		Select(supportAccessConsentSliceColumns()...).
+		From("SupportAccessConsents").
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("CreateAt DESC", "Id")
+
+	consents := []*model.SupportAccessConsent{}
+	if err := s.GetReplicaX().SelectBuilder(&consents, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get SupportAccessConsents for userId=%s", userID)
+	}
+
+	return consents, nil
+}
+
+// GetActive returns the consent of the user letting the admin access their account at the given
+// time, the one expiring last when there are several. It reads from the master so that a consent
+// that was just revoked is never used.
+func (s *SqlSupportAccessConsentStore) GetActive(userID, adminID string, now int64) (*model.SupportAccessConsent, error) {
+	query := s.getQueryBuilder().
+		Select(supportAccessConsentSliceColumns()...).
+		From("SupportAccessConsents").
+		Where(sq.Eq{"UserId": userID, "AdminId": adminID, "RevokedAt": 0}).
+		Where(sq.Gt{"ExpiresAt": now}).
+		OrderBy("ExpiresAt DESC").
+		Limit(1)
+
+	var consent model.SupportAccessConsent
+	if err := s.GetMasterX().GetBuilder(&consent, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("SupportAccessConsent", "userId="+userID+", adminId="+adminID)
+		}
+		return nil, errors.Wrapf(err, "failed to get SupportAccessConsent for userId=%s and adminId=%s", userID, adminID)
+	}
+
+	return &consent, nil
+}
+
+// RevokeForUser revokes the consents of the user that haven't expired or been revoked yet.
+func (s *SqlSupportAccessConsentStore) RevokeForUser(userID string, revokedAt int64) error {
+	query := s.getQueryBuilder().
+		Update("SupportAccessConsents").
+		Set("RevokedAt", revokedAt).
+		Where(sq.Eq{"UserId": userID, "RevokedAt": 0}).
+		Where(sq.Gt{"ExpiresAt": revokedAt})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to revoke SupportAccessConsents for userId=%s", userID)
+	}
+
+	return nil
+}
+
+func (s *SqlSupportAccessConsentStore) PermanentDeleteByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("SupportAccessConsents").
+		Where(sq.Or{sq.Eq{"UserId": userID}, sq.Eq{"AdminId": userID}})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete SupportAccessConsents for userId=%s", userID)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlTeamArchiveStore struct {
+	*SqlStore
+}
+
+func teamArchiveSliceColumns() []string {
+	return []string{
+		"TeamId",
+		"ArchiverId",
+		"Status",
+		"JobId",
+		"SuspendedJobIds",
+		"ArchivedAt",
+		"UpdateAt",
+	}
+}
+
+func newSqlTeamArchiveStore(sqlStore *SqlStore) store.TeamArchiveStore {
+	return &SqlTeamArchiveStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlTeamArchiveStore) Save(archive *model.TeamArchive) (*model.TeamArchive, error) {
+	archive.PreSave()
+	if err := archive.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("TeamArchives").
+		Columns(teamArchiveSliceColumns()...).
+		Values(archive.TeamId, archive.ArchiverId, archive.Status, archive.JobId, archive.SuspendedJobIds, archive.ArchivedAt, archive.UpdateAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "teamarchives_pkey"}) {
+			return nil, store.NewErrInvalidInput("TeamArchive", "TeamId", archive.TeamId)
+		}
+		return nil, errors.Wrapf(err, "failed to save TeamArchive for teamId=%s", archive.TeamId)
+	}
+
+	return archive, nil
+}
+
+func (s *SqlTeamArchiveStore) Update(archive *model.TeamArchive) (*model.TeamArchive, error) {
+	archive.PreUpdate()
+	if err := archive.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Update("TeamArchives").
+		Set("ArchiverId", archive.ArchiverId).
+		Set("Status", archive.Status).
+		Set("JobId", archive.JobId).
+		Set("SuspendedJobIds", archive.SuspendedJobIds).
+		Set("UpdateAt", archive.UpdateAt).
+		Where(sq.Eq{"TeamId": archive.TeamId})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update TeamArchive for teamId=%s", archive.TeamId)
+	}
+
+	if rows, err := result.RowsAffected(); err != nil {
+		return nil, errors.Wrap(err, "failed to get affected rows")
+	} else if rows == 0 {
+		return nil, store.NewErrNotFound("TeamArchive", archive.TeamId)
+	}
+
+	return archive, nil
+}
+
+// Get reads from the master, the archive being updated by the job right before it is read again.
+func (s *SqlTeamArchiveStore) Get(teamID string) (*model.TeamArchive, error) {
+	query := s.getQueryBuilder().
+		Select(teamArchiveSliceColumns()...).
+		From("TeamArchives").
+		Where(sq.Eq{"TeamId": teamID})
+
+	var archive model.TeamArchive
+	if err := s.GetMasterX().GetBuilder(&archive, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("TeamArchive", teamID)
+		}
+		return nil, errors.Wrapf(err, "failed to get TeamArchive for teamId=%s", teamID)
+	}
+
+	return &archive, nil
+}
+
+func (s *SqlTeamArchiveStore) Delete(teamID string) error {
+	query := s.getQueryBuilder().
+		Delete("TeamArchives").
+		Where(sq.Eq{"TeamId": teamID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete TeamArchive for teamId=%s", teamID)
+	}
+
+	return nil
+}
@@ -700,7 +700,7 @@ func (s SqlTeamStore) AnalyticsTeamCount(opts *model.TeamSearch) (int64, error)
 	}
 
 	var c int64
-	err = s.GetReplicaX().Get(&c, queryString, args...)
+	err = s.GetAnalyticsReplicaX().Get(&c, queryString, args...)
 
 	if err != nil {
 		return int64(0), errors.Wrap(err, "failed to count Teams")
@@ -1434,7 +1434,7 @@ func (s SqlTeamStore) AnalyticsGetTeamCountForScheme(schemeId string) (int64, er
 	}
 
 	var count int64
-	err = s.GetReplicaX().Get(&count, query, args...)
+	err = s.GetAnalyticsReplicaX().Get(&count, query, args...)
 	if err != nil {
 		return 0, errors.Wrapf(err, "failed to count Teams with schemeId=%s", schemeId)
 	}
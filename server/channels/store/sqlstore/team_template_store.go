@@ -0,0 +1,143 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlTeamTemplateStore struct {
+	*SqlStore
+}
+
+func teamTemplateSliceColumns() []string {
+	return []string{
+		"Id",
+		"DisplayName",
+		"Description",
+		"CreatorId",
+		"Content",
+		"CreateAt",
+		"UpdateAt",
+		"DeleteAt",
+	}
+}
+
+func newSqlTeamTemplateStore(sqlStore *SqlStore) store.TeamTemplateStore {
+	return &SqlTeamTemplateStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlTeamTemplateStore) Save(template *model.TeamTemplate) (*model.TeamTemplate, error) {
+	if template.Id != "" {
+		return nil, store.NewErrInvalidInput("TeamTemplate", "id", template.Id)
+	}
+
+	template.PreSave()
+	if err := template.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("TeamTemplates").
+		Columns(teamTemplateSliceColumns()...).
+		Values(template.Id, template.DisplayName, template.Description, template.CreatorId, template.Content, template.CreateAt, template.UpdateAt, template.DeleteAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save TeamTemplate with id=%s", template.Id)
+	}
+
+	return template, nil
+}
+
+func (s *SqlTeamTemplateStore) Update(template *model.TeamTemplate) (*model.TeamTemplate, error) {
+	template.PreUpdate()
+	if err := template.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Update("TeamTemplates").
+		Set("DisplayName", template.DisplayName).
+		Set("Description", template.Description).
+		Set("Content", template.Content).
+		Set("UpdateAt", template.UpdateAt).
+		Where(sq.Eq{"Id": template.Id, "DeleteAt": 0})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update TeamTemplate with id=%s", template.Id)
+	}
+
+	if rows, err := result.RowsAffected(); err != nil {
+		return nil, errors.Wrap(err, "failed to get affected rows")
+	} else if rows == 0 {
+		return nil, store.NewErrNotFound("TeamTemplate", template.Id)
+	}
+
+	return template, nil
+}
+
+func (s *SqlTeamTemplateStore) Get(id string) (*model.TeamTemplate, error) {
+	query := s.getQueryBuilder().
+		Select(teamTemplateSliceColumns()...).
+		From("TeamTemplates").
+		Where(sq.Eq{"Id": id, "DeleteAt": 0})
+
+	var template model.TeamTemplate
+	if err := s.GetReplicaX().GetBuilder(&template, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("TeamTemplate", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get TeamTemplate with id=%s", id)
+	}
+
+	return &template, nil
+}
+
+// GetAll returns a page of the templates that aren't deleted, sorted by display name.
+func (s *SqlTeamTemplateStore) GetAll(offset int, limit int) ([]*model.TeamTemplate, error) {
+	query := s.getQueryBuilder().
+		Select(teamTemplateSliceColumns()...).
+		From("TeamTemplates").
+		Where(sq.Eq{"DeleteAt": 0}).
+		OrderBy("DisplayName", "Id").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	templates := []*model.TeamTemplate{}
+	if err := s.GetReplicaX().SelectBuilder(&templates, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get TeamTemplates")
+	}
+
+	return templates, nil
+}
+
+func (s *SqlTeamTemplateStore) Delete(id string, deleteAt int64) error {
+	query := s.getQueryBuilder().
+		Update("TeamTemplates").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete TeamTemplate with id=%s", id)
+	}
+
+	if rows, err := result.RowsAffected(); err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	} else if rows == 0 {
+		return store.NewErrNotFound("TeamTemplate", id)
+	}
+
+	return nil
+}
@@ -377,6 +377,51 @@ func (s *SqlThreadStore) GetThreadsForUser(userId, teamId string, opts model.Get
 	return result, nil
 }
 
+// GetInboxThreadsForUser returns a page of the unread threads the user follows across all their
+// teams, the most recently replied to first.
+func (s *SqlThreadStore) GetInboxThreadsForUser(userID string, options model.GetInboxOptions) ([]*model.InboxItem, error) {
+	query := s.getQueryBuilder().
+		Select(
+			"Threads.PostId AS Id",
+			"COALESCE(Threads.ThreadTeamId, '') AS TeamId",
+			"Threads.ChannelId AS ChannelId",
+			"Threads.LastReplyAt AS LastActivityAt",
+			"ThreadMemberships.UnreadMentions AS MentionCount",
+		).
+		From("ThreadMemberships").
+		Join("Threads ON Threads.PostId = ThreadMemberships.PostId").
+		Join("ChannelMembers ON ChannelMembers.ChannelId = Threads.ChannelId AND ChannelMembers.UserId = ThreadMemberships.UserId").
+		Where(sq.Eq{
+			"ThreadMemberships.UserId":            userID,
+			"ThreadMemberships.Following":         true,
+			"COALESCE(Threads.ThreadDeleteAt, 0)": 0,
+		}).
+		Where(sq.Expr("ThreadMemberships.LastViewed < Threads.LastReplyAt")).
+		OrderBy("Threads.LastReplyAt DESC", "Threads.PostId DESC").
+		Limit(uint64(options.PerPage))
+
+	if !options.Cursor.IsZero() {
+		query = query.Where(sq.Or{
+			sq.Lt{"Threads.LastReplyAt": options.Cursor.LastActivityAt},
+			sq.And{
+				sq.Eq{"Threads.LastReplyAt": options.Cursor.LastActivityAt},
+				sq.Lt{"Threads.PostId": options.Cursor.Id},
+			},
+		})
+	}
+
+	items := []*model.InboxItem{}
+	if err := s.GetReplicaX().SelectBuilder(&items, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find unread threads for user id=%s", userID)
+	}
+
+	for _, item := range items {
+		item.Type = model.InboxItemTypeThread
+	}
+
+	return items, nil
+}
+
 // GetTeamsUnreadForUser returns the total unread threads and unread mentions
 // for a user from all teams.
 func (s *SqlThreadStore) GetTeamsUnreadForUser(userID string, teamIDs []string, includeUrgentMentionCount bool) (map[string]*model.TeamUnread, error) {
@@ -779,6 +824,14 @@ func (s *SqlThreadStore) MaintainMembership(userId, postId string, opts store.Th
 	}
 	defer finalizeTransactionX(trx, &err)
 
+	// The primary key of a partitioned table including its partition key, the membership is
+	// locked for it not to be saved twice concurrently.
+	if s.isPartitioned("threadmemberships") {
+		if _, err = trx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", postId+userId); err != nil {
+			return nil, errors.Wrap(err, "failed to lock thread membership")
+		}
+	}
+
 	membership, err := s.getMembershipForUser(trx, userId, postId)
 	now := utils.MillisFromTime(time.Now())
 	// if membership exists, update it if:
@@ -245,6 +245,20 @@ func (us SqlUserStore) Update(user *model.User, trustedUpdateData bool) (*model.
 		return nil, fmt.Errorf("multiple users were update: userId=%s, count=%d", user.Id, count)
 	}
 
+	// Only the active users are counted as the members of their channels.
+	if (oldUser.DeleteAt == 0) != (user.DeleteAt == 0) {
+		delta := int64(1)
+		if user.DeleteAt != 0 {
+			delta = -1
+		}
+		if err = addToChannelCounters(us.GetMasterX(), us.getQueryBuilder(), sq.Eq{"UserId": user.Id}, delta, 0); err != nil {
+			return nil, err
+		}
+		if err = addToChannelCounters(us.GetMasterX(), us.getQueryBuilder(), sq.Eq{"UserId": user.Id, "SchemeGuest": true}, 0, delta); err != nil {
+			return nil, err
+		}
+	}
+
 	user.Sanitize(map[string]bool{})
 	oldUser.Sanitize(map[string]bool{})
 	return &model.UserUpdate{New: user.DeepCopy(), Old: &oldUser}, nil
@@ -1215,6 +1229,50 @@ func (us SqlUserStore) GetAllUsingAuthService(authService string) ([]*model.User
 	return users, nil
 }
 
+// GetPageUsingAuthService returns the page of the users signing in with the authentication service
+// matching the options, ordered by username, along with the total number of matching users.
+func (us SqlUserStore) GetPageUsingAuthService(authService string, options model.UserGetByAuthServiceOptions) ([]*model.User, int64, error) {
+	filter := sq.And{sq.Eq{"u.AuthService": authService}}
+	if options.AuthData != "" {
+		filter = append(filter, sq.Eq{"u.AuthData": options.AuthData})
+	}
+	if options.Email != "" {
+		filter = append(filter, sq.Eq{"u.Email": model.NormalizeEmail(options.Email)})
+	}
+	if options.Username != "" {
+		filter = append(filter, sq.Eq{"u.Username": strings.ToLower(options.Username)})
+	}
+
+	countQuery := us.getQueryBuilder().Select("COUNT(*)").From("Users u").Where(filter)
+	queryString, args, err := countQuery.ToSql()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "get_page_using_auth_service_count_tosql")
+	}
+
+	var total int64
+	if err := us.GetReplicaX().Get(&total, queryString, args...); err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to count Users with authService=%s", authService)
+	}
+
+	query := us.usersQuery.
+		Where(filter).
+		OrderBy("u.Username ASC").
+		Offset(uint64(options.Offset)).
+		Limit(uint64(options.Limit))
+
+	queryString, args, err = query.ToSql()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "get_page_using_auth_service_tosql")
+	}
+
+	users := []*model.User{}
+	if err := us.GetReplicaX().Select(&users, queryString, args...); err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to find Users with authService=%s", authService)
+	}
+
+	return users, total, nil
+}
+
 func (us SqlUserStore) GetAllNotInAuthService(authServices []string) ([]*model.User, error) {
 	query := us.usersQuery.
 		Where(sq.NotEq{"u.AuthService": authServices}).
@@ -1368,7 +1426,7 @@ func (us SqlUserStore) AnalyticsActiveCount(timePeriod int64, options model.User
 	}
 
 	var v int64
-	err = us.GetReplicaX().Get(&v, queryStr, args...)
+	err = us.GetAnalyticsReplicaX().Get(&v, queryStr, args...)
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to count Users")
 	}
@@ -1393,7 +1451,7 @@ func (us SqlUserStore) AnalyticsActiveCountForPeriod(startTime int64, endTime in
 	}
 
 	var v int64
-	err = us.GetReplicaX().Get(&v, queryStr, args...)
+	err = us.GetAnalyticsReplicaX().Get(&v, queryStr, args...)
 	if err != nil {
 		return 0, errors.Wrap(err, "Unable to get the active users during the requested period.")
 	}
@@ -1442,7 +1500,7 @@ func (us SqlUserStore) GetAnyUnreadPostCountForChannel(userId string, channelId
 	return count, nil
 }
 
-func (us SqlUserStore) Search(teamId string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (us SqlUserStore) Search(ctx context.Context, teamId string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	query := us.usersQuery.
 		OrderBy("Username ASC").
 		Limit(uint64(options.Limit))
@@ -1450,10 +1508,10 @@ func (us SqlUserStore) Search(teamId string, term string, options *model.UserSea
 	if teamId != "" {
 		query = query.Join("TeamMembers tm ON ( tm.UserId = u.Id AND tm.DeleteAt = 0 AND tm.TeamId = ? )", teamId)
 	}
-	return us.performSearch(query, term, options)
+	return us.performSearch(ctx, query, term, options)
 }
 
-func (us SqlUserStore) SearchWithoutTeam(term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (us SqlUserStore) SearchWithoutTeam(ctx context.Context, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	query := us.usersQuery.
 		Where(`(
 				SELECT
@@ -1467,10 +1525,10 @@ func (us SqlUserStore) SearchWithoutTeam(term string, options *model.UserSearchO
 		OrderBy("u.Username ASC").
 		Limit(uint64(options.Limit))
 
-	return us.performSearch(query, term, options)
+	return us.performSearch(ctx, query, term, options)
 }
 
-func (us SqlUserStore) SearchNotInTeam(notInTeamId string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (us SqlUserStore) SearchNotInTeam(ctx context.Context, notInTeamId string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	query := us.usersQuery.
 		LeftJoin("TeamMembers tm ON ( tm.UserId = u.Id AND tm.DeleteAt = 0 AND tm.TeamId = ? )", notInTeamId).
 		Where("tm.UserId IS NULL").
@@ -1481,10 +1539,10 @@ func (us SqlUserStore) SearchNotInTeam(notInTeamId string, term string, options
 		query = applyTeamGroupConstrainedFilter(query, notInTeamId)
 	}
 
-	return us.performSearch(query, term, options)
+	return us.performSearch(ctx, query, term, options)
 }
 
-func (us SqlUserStore) SearchNotInChannel(teamId string, channelId string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (us SqlUserStore) SearchNotInChannel(ctx context.Context, teamId string, channelId string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	query := us.usersQuery.
 		LeftJoin("ChannelMembers cm ON ( cm.UserId = u.Id AND cm.ChannelId = ? )", channelId).
 		Where("cm.UserId IS NULL").
@@ -1499,35 +1557,35 @@ func (us SqlUserStore) SearchNotInChannel(teamId string, channelId string, term
 		query = applyChannelGroupConstrainedFilter(query, channelId)
 	}
 
-	return us.performSearch(query, term, options)
+	return us.performSearch(ctx, query, term, options)
 }
 
-func (us SqlUserStore) SearchInChannel(channelId string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (us SqlUserStore) SearchInChannel(ctx context.Context, channelId string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	query := us.usersQuery.
 		Join("ChannelMembers cm ON ( cm.UserId = u.Id AND cm.ChannelId = ? )", channelId).
 		OrderBy("Username ASC").
 		Limit(uint64(options.Limit))
 
-	return us.performSearch(query, term, options)
+	return us.performSearch(ctx, query, term, options)
 }
 
-func (us SqlUserStore) SearchInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (us SqlUserStore) SearchInGroup(ctx context.Context, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	query := us.usersQuery.
 		Join("GroupMembers gm ON ( gm.UserId = u.Id AND gm.GroupId = ? AND gm.DeleteAt = 0 )", groupID).
 		OrderBy("Username ASC").
 		Limit(uint64(options.Limit))
 
-	return us.performSearch(query, term, options)
+	return us.performSearch(ctx, query, term, options)
 }
 
-func (us SqlUserStore) SearchNotInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (us SqlUserStore) SearchNotInGroup(ctx context.Context, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	query := us.usersQuery.
 		LeftJoin("GroupMembers gm ON ( gm.UserId = u.Id AND gm.GroupId = ? )", groupID).
 		Where("(gm.UserId IS NULL OR gm.deleteat != 0)").
 		OrderBy("Username ASC").
 		Limit(uint64(options.Limit))
 
-	return us.performSearch(query, term, options)
+	return us.performSearch(ctx, query, term, options)
 }
 
 func generateSearchQuery(query sq.SelectBuilder, terms []string, fields []string, isPostgreSQL bool) sq.SelectBuilder {
@@ -1548,7 +1606,7 @@ func generateSearchQuery(query sq.SelectBuilder, terms []string, fields []string
 	return query
 }
 
-func (us SqlUserStore) performSearch(query sq.SelectBuilder, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (us SqlUserStore) performSearch(ctx context.Context, query sq.SelectBuilder, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	term = sanitizeSearchTerm(term, "*")
 
 	var searchType []string
@@ -1587,7 +1645,7 @@ func (us SqlUserStore) performSearch(query sq.SelectBuilder, term string, option
 	}
 
 	users := []*model.User{}
-	if err := us.GetReplicaX().Select(&users, queryString, args...); err != nil {
+	if err := us.GetReplicaX().withContext(ctx).Select(&users, queryString, args...); err != nil {
 		return nil, errors.Wrapf(err, "failed to find Users with term=%s and searchType=%v", term, searchType)
 	}
 	for _, u := range users {
@@ -1599,7 +1657,7 @@ func (us SqlUserStore) performSearch(query sq.SelectBuilder, term string, option
 
 func (us SqlUserStore) AnalyticsGetInactiveUsersCount() (int64, error) {
 	var count int64
-	err := us.GetReplicaX().Get(&count, "SELECT COUNT(Id) FROM Users WHERE DeleteAt > 0")
+	err := us.GetAnalyticsReplicaX().Get(&count, "SELECT COUNT(Id) FROM Users WHERE DeleteAt > 0")
 	if err != nil {
 		return int64(0), errors.Wrap(err, "failed to count inactive Users")
 	}
@@ -1608,7 +1666,7 @@ func (us SqlUserStore) AnalyticsGetInactiveUsersCount() (int64, error) {
 
 func (us SqlUserStore) AnalyticsGetExternalUsers(hostDomain string) (bool, error) {
 	var count int64
-	err := us.GetReplicaX().Get(&count, "SELECT COUNT(Id) FROM Users WHERE LOWER(Email) NOT LIKE ?", "%@"+strings.ToLower(hostDomain))
+	err := us.GetAnalyticsReplicaX().Get(&count, "SELECT COUNT(Id) FROM Users WHERE LOWER(Email) NOT LIKE ?", "%@"+strings.ToLower(hostDomain))
 	if err != nil {
 		return false, errors.Wrap(err, "failed to count inactive Users")
 	}
@@ -1617,7 +1675,7 @@ func (us SqlUserStore) AnalyticsGetExternalUsers(hostDomain string) (bool, error
 
 func (us SqlUserStore) AnalyticsGetGuestCount() (int64, error) {
 	var count int64
-	err := us.GetReplicaX().Get(&count, "SELECT count(*) FROM Users WHERE Roles LIKE ? and DeleteAt = 0", "%system_guest%")
+	err := us.GetAnalyticsReplicaX().Get(&count, "SELECT count(*) FROM Users WHERE Roles LIKE ? and DeleteAt = 0", "%system_guest%")
 	if err != nil {
 		return int64(0), errors.Wrap(err, "failed to count guest Users")
 	}
@@ -1626,7 +1684,7 @@ func (us SqlUserStore) AnalyticsGetGuestCount() (int64, error) {
 
 func (us SqlUserStore) AnalyticsGetSystemAdminCount() (int64, error) {
 	var count int64
-	err := us.GetReplicaX().Get(&count, "SELECT count(*) FROM Users WHERE Roles LIKE ? and DeleteAt = 0", "%system_admin%")
+	err := us.GetAnalyticsReplicaX().Get(&count, "SELECT count(*) FROM Users WHERE Roles LIKE ? and DeleteAt = 0", "%system_admin%")
 	if err != nil {
 		return int64(0), errors.Wrap(err, "failed to count system admin Users")
 	}
@@ -1982,6 +2040,12 @@ func (us SqlUserStore) PromoteGuestToUser(userId string) (err error) {
 		return errors.Wrapf(err, "failed to update User with userId=%s", userId)
 	}
 
+	if user.DeleteAt == 0 {
+		if err = addToChannelCounters(transaction, us.getQueryBuilder(), sq.Eq{"UserId": userId, "SchemeGuest": true}, 0, -1); err != nil {
+			return err
+		}
+	}
+
 	query = us.getQueryBuilder().Update("ChannelMembers").
 		Set("SchemeUser", true).
 		Set("SchemeGuest", false).
@@ -2058,6 +2122,13 @@ func (us SqlUserStore) DemoteUserToGuest(userID string) (_ *model.User, err erro
 	user.Roles = newRolesDBStr
 	user.UpdateAt = curTime
 
+	if user.DeleteAt == 0 {
+		notGuest := sq.And{sq.Eq{"UserId": userID}, sq.Or{sq.Eq{"SchemeGuest": false}, sq.Eq{"SchemeGuest": nil}}}
+		if err = addToChannelCounters(transaction, us.getQueryBuilder(), notGuest, 0, 1); err != nil {
+			return nil, err
+		}
+	}
+
 	query = us.getQueryBuilder().Update("ChannelMembers").
 		Set("SchemeUser", false).
 		Set("SchemeAdmin", false).
@@ -2094,15 +2165,15 @@ func (us SqlUserStore) DemoteUserToGuest(userID string) (_ *model.User, err erro
 	return user, nil
 }
 
-func (us SqlUserStore) AutocompleteUsersInChannel(teamId, channelId, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
+func (us SqlUserStore) AutocompleteUsersInChannel(ctx context.Context, teamId, channelId, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
 	var usersInChannel, usersNotInChannel []*model.User
 	g := errgroup.Group{}
 	g.Go(func() (err error) {
-		usersInChannel, err = us.SearchInChannel(channelId, term, options)
+		usersInChannel, err = us.SearchInChannel(ctx, channelId, term, options)
 		return err
 	})
 	g.Go(func() (err error) {
-		usersNotInChannel, err = us.SearchNotInChannel(teamId, channelId, term, options)
+		usersNotInChannel, err = us.SearchNotInChannel(ctx, teamId, channelId, term, options)
 		return err
 	})
 	err := g.Wait()
@@ -0,0 +1,176 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+type SqlWebAuthnCredentialStore struct {
+	*SqlStore
+}
+
+func webAuthnCredentialSliceColumns() []string {
+	return []string{
+		"Id",
+		"UserId",
+		"CredentialId",
+		"PublicKey",
+		"SignCount",
+		"Name",
+		"CreateAt",
+		"LastUsedAt",
+	}
+}
+
+func webAuthnCredentialToSlice(credential *model.WebAuthnCredential) []any {
+	return []any{
+		credential.Id,
+		credential.UserId,
+		credential.CredentialId,
+		credential.PublicKey,
+		credential.SignCount,
+		credential.Name,
+		credential.CreateAt,
+		credential.LastUsedAt,
+	}
+}
+
+func newSqlWebAuthnCredentialStore(sqlStore *SqlStore) store.WebAuthnCredentialStore {
+	return &SqlWebAuthnCredentialStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlWebAuthnCredentialStore) Save(credential *model.WebAuthnCredential) (*model.WebAuthnCredential, error) {
+	credential.PreSave()
+	if err := credential.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("WebAuthnCredentials").
+		Columns(webAuthnCredentialSliceColumns()...).
+		Values(webAuthnCredentialToSlice(credential)...)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		if IsUniqueConstraintError(err, []string{"CredentialId", "idx_webauthncredentials_credentialid"}) {
+			return nil, store.NewErrConflict("WebAuthnCredential", err, "credentialId="+credential.CredentialId)
+		}
+		return nil, errors.Wrap(err, "failed to save WebAuthnCredential")
+	}
+
+	return credential, nil
+}
+
+func (s *SqlWebAuthnCredentialStore) get(where sq.Eq, what string) (*model.WebAuthnCredential, error) {
+	query := s.getQueryBuilder().
+		Select(webAuthnCredentialSliceColumns()...).
+		From("WebAuthnCredentials").
+		Where(where)
+
+	var credential model.WebAuthnCredential
+	if err := s.GetMasterX().GetBuilder(&credential, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("WebAuthnCredential", what)
+		}
+		return nil, errors.Wrapf(err, "failed to get WebAuthnCredential with %s", what)
+	}
+
+	return &credential, nil
+}
+
+func (s *SqlWebAuthnCredentialStore) Get(id string) (*model.WebAuthnCredential, error) {
+	return s.get(sq.Eq{"Id": id}, "id="+id)
+}
+
+// GetByCredentialId returns the credential with the given base64url encoded id, as sent by the
+// authenticators.
+func (s *SqlWebAuthnCredentialStore) GetByCredentialId(credentialID string) (*model.WebAuthnCredential, error) {
+	return s.get(sq.Eq{"CredentialId": credentialID}, "credentialId="+credentialID)
+}
+
+// GetForUser returns the credentials of the user, the oldest first.
+func (s *SqlWebAuthnCredentialStore) GetForUser(userID string) ([]*model.WebAuthnCredential, error) {
+	query := s.getQueryBuilder().
+		Select(webAuthnCredentialSliceColumns()...).
+		From("WebAuthnCredentials").
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("CreateAt", "Id")
+
+	credentials := []*model.WebAuthnCredential{}
+	if err := s.GetMasterX().SelectBuilder(&credentials, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get WebAuthnCredentials for userId=%s", userID)
+	}
+
+	return credentials, nil
+}
+
+// UpdateSignCount records a use of the credential. The signature counter only moves forward, so
+// that two concurrent uses of a cloned authenticator can't both succeed.
+func (s *SqlWebAuthnCredentialStore) UpdateSignCount(id string, signCount int64, lastUsedAt int64) error {
+	query := s.getQueryBuilder().
+		Update("WebAuthnCredentials").
+		Set("SignCount", signCount).
+		Set("LastUsedAt", lastUsedAt).
+		Where(sq.Eq{"Id": id})
+
+	if signCount != 0 {
+		query = query.Where(sq.Lt{"SignCount": signCount})
+	}
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update WebAuthnCredential with id=%s", id)
+	}
+
+	if count, _ := result.RowsAffected(); count == 0 {
+		return store.NewErrNotFound("WebAuthnCredential", "id="+id)
+	}
+
+	return nil
+}
+
+func (s *SqlWebAuthnCredentialStore) UpdateName(id string, name string) error {
+	query := s.getQueryBuilder().
+		Update("WebAuthnCredentials").
+		Set("Name", name).
+		Where(sq.Eq{"Id": id})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to update WebAuthnCredential with id=%s", id)
+	}
+
+	return nil
+}
+
+func (s *SqlWebAuthnCredentialStore) Delete(id string) error {
+	query := s.getQueryBuilder().
+		Delete("WebAuthnCredentials").
+		Where(sq.Eq{"Id": id})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete WebAuthnCredential with id=%s", id)
+	}
+
+	return nil
+}
+
+func (s *SqlWebAuthnCredentialStore) PermanentDeleteByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("WebAuthnCredentials").
+		Where(sq.Eq{"UserId": userID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete WebAuthnCredentials for userId=%s", userID)
+	}
+
+	return nil
+}
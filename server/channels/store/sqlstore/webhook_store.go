@@ -43,9 +43,9 @@ func (s SqlWebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.In
 	}
 
 	if _, err := s.GetMasterX().NamedExec(`INSERT INTO IncomingWebhooks
-		(Id, CreateAt, UpdateAt, DeleteAt, UserId, ChannelId, TeamId, DisplayName, Description, Username, IconURL, ChannelLocked)
+		(Id, CreateAt, UpdateAt, DeleteAt, UserId, ChannelId, TeamId, DisplayName, Description, Username, IconURL, ChannelLocked, MessageTemplate)
 		VALUES
-		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :UserId, :ChannelId, :TeamId, :DisplayName, :Description, :Username, :IconURL, :ChannelLocked)`, webhook); err != nil {
+		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :UserId, :ChannelId, :TeamId, :DisplayName, :Description, :Username, :IconURL, :ChannelLocked, :MessageTemplate)`, webhook); err != nil {
 		return nil, errors.Wrapf(err, "failed to save IncomingWebhook with id=%s", webhook.Id)
 	}
 
@@ -57,7 +57,7 @@ func (s SqlWebhookStore) UpdateIncoming(hook *model.IncomingWebhook) (*model.Inc
 
 	_, err := s.GetMasterX().NamedExec(`UPDATE IncomingWebhooks SET
 			CreateAt=:CreateAt, UpdateAt=:UpdateAt, DeleteAt=:DeleteAt, ChannelId=:ChannelId, TeamId=:TeamId, DisplayName=:DisplayName,
-			Description=:Description, Username=:Username, IconURL=:IconURL, ChannelLocked=:ChannelLocked
+			Description=:Description, Username=:Username, IconURL=:IconURL, ChannelLocked=:ChannelLocked, MessageTemplate=:MessageTemplate
 			WHERE Id=:Id`, hook)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to update IncomingWebhook with id=%s", hook.Id)
@@ -66,6 +66,25 @@ func (s SqlWebhookStore) UpdateIncoming(hook *model.IncomingWebhook) (*model.Inc
 	return hook, nil
 }
 
+// UpdateIncomingUser changes the user an incoming webhook belongs to, which UpdateIncoming keeps.
+func (s SqlWebhookStore) UpdateIncomingUser(hookId string, userId string) error {
+	query := s.getQueryBuilder().
+		Update("IncomingWebhooks").
+		Set("UserId", userId).
+		Set("UpdateAt", model.GetMillis()).
+		Where(sq.Eq{"Id": hookId})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update the user of IncomingWebhook with id=%s", hookId)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return store.NewErrNotFound("IncomingWebhook", hookId)
+	}
+
+	return nil
+}
+
 func (s SqlWebhookStore) GetIncoming(id string, allowFromCache bool) (*model.IncomingWebhook, error) {
 	var webhook model.IncomingWebhook
 	if err := s.GetReplicaX().Get(&webhook, "SELECT * FROM IncomingWebhooks WHERE Id = ? AND DeleteAt = 0", id); err != nil {
@@ -355,6 +374,112 @@ func (s SqlWebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.Out
 	return hook, nil
 }
 
+func (s SqlWebhookStore) SaveDirectOutgoing(webhook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, error) {
+	if webhook.Id != "" {
+		return nil, store.NewErrInvalidInput("DirectOutgoingWebhook", "id", webhook.Id)
+	}
+
+	webhook.PreSave()
+	if err := webhook.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetMasterX().NamedExec(`INSERT INTO DirectOutgoingWebhooks
+			(Id, Token, CreateAt, UpdateAt, DeleteAt, CreatorId, BotUserId, CallbackURLs, DisplayName, Description, ContentType)
+			VALUES
+			(:Id, :Token, :CreateAt, :UpdateAt, :DeleteAt, :CreatorId, :BotUserId, :CallbackURLs, :DisplayName, :Description, :ContentType)`, webhook); err != nil {
+		return nil, errors.Wrapf(err, "failed to save DirectOutgoingWebhook with id=%s", webhook.Id)
+	}
+
+	return webhook, nil
+}
+
+func (s SqlWebhookStore) GetDirectOutgoing(id string) (*model.DirectOutgoingWebhook, error) {
+	var webhook model.DirectOutgoingWebhook
+
+	if err := s.GetReplicaX().Get(&webhook, "SELECT * FROM DirectOutgoingWebhooks WHERE Id = ? AND DeleteAt = 0", id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("DirectOutgoingWebhook", id)
+		}
+
+		return nil, errors.Wrapf(err, "failed to get DirectOutgoingWebhook with id=%s", id)
+	}
+
+	return &webhook, nil
+}
+
+func (s SqlWebhookStore) GetDirectOutgoingList(offset, limit int) ([]*model.DirectOutgoingWebhook, error) {
+	webhooks := []*model.DirectOutgoingWebhook{}
+
+	query := s.getQueryBuilder().
+		Select("*").
+		From("DirectOutgoingWebhooks").
+		Where(sq.Eq{"DeleteAt": int(0)}).
+		OrderBy("CreateAt").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "direct_outgoing_webhook_tosql")
+	}
+
+	if err := s.GetReplicaX().Select(&webhooks, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find DirectOutgoingWebhooks")
+	}
+
+	return webhooks, nil
+}
+
+func (s SqlWebhookStore) GetDirectOutgoingByBotUsers(botUserIDs []string) ([]*model.DirectOutgoingWebhook, error) {
+	webhooks := []*model.DirectOutgoingWebhook{}
+	if len(botUserIDs) == 0 {
+		return webhooks, nil
+	}
+
+	query := s.getQueryBuilder().
+		Select("*").
+		From("DirectOutgoingWebhooks").
+		Where(sq.And{
+			sq.Eq{"BotUserId": botUserIDs},
+			sq.Eq{"DeleteAt": int(0)},
+		})
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "direct_outgoing_webhook_tosql")
+	}
+
+	if err := s.GetReplicaX().Select(&webhooks, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find DirectOutgoingWebhooks")
+	}
+
+	return webhooks, nil
+}
+
+func (s SqlWebhookStore) UpdateDirectOutgoing(hook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, error) {
+	hook.UpdateAt = model.GetMillis()
+
+	_, err := s.GetMasterX().NamedExec(`UPDATE DirectOutgoingWebhooks SET
+			CreateAt = :CreateAt, UpdateAt = :UpdateAt, DeleteAt = :DeleteAt, Token = :Token, CreatorId = :CreatorId,
+			BotUserId = :BotUserId, CallbackURLs = :CallbackURLs, DisplayName = :DisplayName,
+			Description = :Description, ContentType = :ContentType WHERE Id = :Id`, hook)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update DirectOutgoingWebhook with id=%s", hook.Id)
+	}
+
+	return hook, nil
+}
+
+func (s SqlWebhookStore) DeleteDirectOutgoing(webhookId string, time int64) error {
+	_, err := s.GetMasterX().Exec("Update DirectOutgoingWebhooks SET DeleteAt = ?, UpdateAt = ? WHERE Id = ?", time, time, webhookId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update DirectOutgoingWebhook with id=%s", webhookId)
+	}
+
+	return nil
+}
+
 func (s SqlWebhookStore) AnalyticsIncomingCount(teamId string) (int64, error) {
 	queryBuilder :=
 		s.getQueryBuilder().
@@ -372,7 +497,7 @@ func (s SqlWebhookStore) AnalyticsIncomingCount(teamId string) (int64, error) {
 	}
 
 	var count int64
-	if err := s.GetReplicaX().Get(&count, queryString, args...); err != nil {
+	if err := s.GetAnalyticsReplicaX().Get(&count, queryString, args...); err != nil {
 		return 0, errors.Wrap(err, "failed to count IncomingWebhooks")
 	}
 	return count, nil
@@ -395,7 +520,7 @@ func (s SqlWebhookStore) AnalyticsOutgoingCount(teamId string) (int64, error) {
 	}
 
 	var count int64
-	if err := s.GetReplicaX().Get(&count, queryString, args...); err != nil {
+	if err := s.GetAnalyticsReplicaX().Get(&count, queryString, args...); err != nil {
 		return 0, errors.Wrap(err, "failed to count OutgoingWebhooks")
 	}
 	return count, nil
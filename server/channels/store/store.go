@@ -68,6 +68,14 @@ type Store interface {
 	RecycleDBConnections(d time.Duration)
 	GetDBSchemaVersion() (int, error)
 	GetAppliedMigrations() ([]model.AppliedMigration, error)
+	// GetOnlineMigrationsStatus returns the status of the migrations changing large tables online.
+	GetOnlineMigrationsStatus() ([]*model.OnlineMigrationStatus, error)
+	// GetTablePartitions returns the monthly partitions of the tables partitioned by time.
+	GetTablePartitions() ([]*model.TablePartition, error)
+	// CreateTablePartitions creates the monthly partitions missing up to the month of until.
+	CreateTablePartitions(until time.Time) ([]*model.TablePartition, error)
+	// DropTablePartitions drops the monthly partitions holding only rows older than before.
+	DropTablePartitions(before int64) ([]*model.TablePartition, error)
 	GetDbVersion(numerical bool) (string, error)
 	// GetInternalMasterDB allows access to the raw master DB
 	// handle for the multi-product architecture.
@@ -88,6 +96,25 @@ type Store interface {
 	PostPriority() PostPriorityStore
 	PostAcknowledgement() PostAcknowledgementStore
 	TrueUpReview() TrueUpReviewStore
+	ScheduledPost() ScheduledPostStore
+	PostTranslation() PostTranslationStore
+	PostEmbedding() PostEmbeddingStore
+	Activity() ActivityStore
+	ReadReceipt() ReadReceiptStore
+	ReactionSummary() ReactionSummaryStore
+	MatrixBridge() MatrixBridgeStore
+	ChannelBookmark() ChannelBookmarkStore
+	PostExpiration() PostExpirationStore
+	WebAuthnCredential() WebAuthnCredentialStore
+	RoleSnapshot() RoleSnapshotStore
+	GuestSponsorship() GuestSponsorshipStore
+	ChannelMemberExpiry() ChannelMemberExpiryStore
+	ChannelJoinRequest() ChannelJoinRequestStore
+	TeamTemplate() TeamTemplateStore
+	TeamArchive() TeamArchiveStore
+	SupportAccessConsent() SupportAccessConsentStore
+	ConfigChange() ConfigChangeStore
+	ArchivedPost() ArchivedPostStore
 }
 
 type RetentionPolicyStore interface {
@@ -110,6 +137,7 @@ type RetentionPolicyStore interface {
 	GetTeamPoliciesCountForUser(userID string) (int64, error)
 	GetChannelPoliciesForUser(userID string, offset, limit int) ([]*model.RetentionPolicyForChannel, error)
 	GetChannelPoliciesCountForUser(userID string) (int64, error)
+	Preview(policy *model.RetentionPolicyWithTeamAndChannelIDs, now int64) (*model.RetentionPolicyPreview, error)
 }
 
 type TeamStore interface {
@@ -238,7 +266,13 @@ type ChannelStore interface {
 	GetPinnedPostCount(channelID string, allowFromCache bool) (int64, error)
 	InvalidateGuestCount(channelID string)
 	GetGuestCount(channelID string, allowFromCache bool) (int64, error)
+	// GetCounters returns the counters of the channel, or a not found error when they're not
+	// maintained yet.
+	GetCounters(channelID string) (*model.ChannelCounters, error)
+	ReconcileCounters(afterChannelID string, limit int) (string, error)
 	GetPinnedPosts(channelID string) (*model.PostList, error)
+	GetChannelPinnedPosts(channelID string) (*model.ChannelPinnedPosts, error)
+	SaveChannelPinnedPosts(pinned *model.ChannelPinnedPosts) (*model.ChannelPinnedPosts, error)
 	RemoveMember(channelID string, userID string) error
 	RemoveMembers(channelID string, userIds []string) error
 	PermanentDeleteMembersByUser(userID string) error
@@ -253,20 +287,21 @@ type ChannelStore interface {
 	GetTeamMembersForChannel(channelID string) ([]string, error)
 	GetMembersForUserWithPagination(userID string, page, perPage int) (model.ChannelMembersWithTeamData, error)
 	GetMembersForUserWithCursor(userID, teamID string, opts *ChannelMemberGraphQLSearchOpts) (model.ChannelMembers, error)
-	Autocomplete(userID, term string, includeDeleted, isGuest bool) (model.ChannelListWithTeamData, error)
-	AutocompleteInTeam(teamID, userID, term string, includeDeleted, isGuest bool) (model.ChannelList, error)
-	AutocompleteInTeamForSearch(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error)
-	SearchAllChannels(term string, opts ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error)
-	SearchInTeam(teamID string, term string, includeDeleted bool) (model.ChannelList, error)
-	SearchArchivedInTeam(teamID string, term string, userID string) (model.ChannelList, error)
-	SearchForUserInTeam(userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error)
+	Autocomplete(ctx context.Context, userID, term string, includeDeleted, isGuest bool) (model.ChannelListWithTeamData, error)
+	AutocompleteInTeam(ctx context.Context, teamID, userID, term string, includeDeleted, isGuest bool) (model.ChannelList, error)
+	AutocompleteInTeamForSearch(ctx context.Context, teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error)
+	SearchAllChannels(ctx context.Context, term string, opts ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error)
+	SearchInTeam(ctx context.Context, teamID string, term string, includeDeleted bool) (model.ChannelList, error)
+	SearchArchivedInTeam(ctx context.Context, teamID string, term string, userID string) (model.ChannelList, error)
+	SearchForUserInTeam(ctx context.Context, userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error)
 	SearchMore(userID string, teamID string, term string) (model.ChannelList, error)
-	SearchGroupChannels(userID, term string) (model.ChannelList, error)
+	SearchGroupChannels(ctx context.Context, userID, term string) (model.ChannelList, error)
 	GetMembersByIds(channelID string, userIds []string) (model.ChannelMembers, error)
 	GetMembersByChannelIds(channelIds []string, userID string) (model.ChannelMembers, error)
 	GetMembersInfoByChannelIds(channelIDs []string) (map[string][]*model.User, error)
 	AnalyticsDeletedTypeCount(teamID string, channelType model.ChannelType) (int64, error)
 	GetChannelUnread(channelID, userID string) (*model.ChannelUnread, error)
+	GetInboxChannelsForUser(userID string, options model.GetInboxOptions) ([]*model.InboxItem, error)
 	ClearCaches()
 	ClearMembersForUserCache()
 	GetChannelsByScheme(schemeID string, offset int, limit int) (model.ChannelList, error)
@@ -332,6 +367,7 @@ type ThreadStore interface {
 	GetThreadsForUser(userId, teamID string, opts model.GetUserThreadsOpts) ([]*model.ThreadResponse, error)
 	GetThreadForUser(threadMembership *model.ThreadMembership, extended, postPriorityIsEnabled bool) (*model.ThreadResponse, error)
 	GetTeamsUnreadForUser(userID string, teamIDs []string, includeUrgentMentionCount bool) (map[string]*model.TeamUnread, error)
+	GetInboxThreadsForUser(userID string, options model.GetInboxOptions) ([]*model.InboxItem, error)
 
 	MarkAllAsRead(userID string, threadIds []string) error
 	MarkAllAsReadByTeam(userID, teamID string) error
@@ -396,7 +432,7 @@ type PostStore interface {
 	GetParentsForExportAfter(limit int, afterID string) ([]*model.PostForExport, error)
 	GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error)
 	GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error)
-	SearchPostsForUser(paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.PostSearchResults, error)
+	SearchPostsForUser(ctx context.Context, paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.PostSearchResults, error)
 	GetRecentSearchesForUser(userID string) ([]*model.SearchParams, error)
 	LogRecentSearch(userID string, searchQuery []byte, createAt int64) error
 	GetOldestEntityCreationTime() (int64, error)
@@ -445,6 +481,7 @@ type UserStore interface {
 	GetByEmail(email string) (*model.User, error)
 	GetByAuth(authData *string, authService string) (*model.User, error)
 	GetAllUsingAuthService(authService string) ([]*model.User, error)
+	GetPageUsingAuthService(authService string, options model.UserGetByAuthServiceOptions) ([]*model.User, int64, error)
 	GetAllNotInAuthService(authServices []string) ([]*model.User, error)
 	GetByUsername(username string) (*model.User, error)
 	GetForLogin(loginID string, allowSignInWithUsername, allowSignInWithEmail bool) (*model.User, error)
@@ -461,13 +498,13 @@ type UserStore interface {
 	GetAnyUnreadPostCountForChannel(userID string, channelID string) (int64, error)
 	GetRecentlyActiveUsersForTeam(teamID string, offset, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error)
 	GetNewUsersForTeam(teamID string, offset, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error)
-	Search(teamID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
-	SearchNotInTeam(notInTeamID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
-	SearchInChannel(channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
-	SearchNotInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
-	SearchWithoutTeam(term string, options *model.UserSearchOptions) ([]*model.User, error)
-	SearchInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
-	SearchNotInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
+	Search(ctx context.Context, teamID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
+	SearchNotInTeam(ctx context.Context, notInTeamID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
+	SearchInChannel(ctx context.Context, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
+	SearchNotInChannel(ctx context.Context, teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
+	SearchWithoutTeam(ctx context.Context, term string, options *model.UserSearchOptions) ([]*model.User, error)
+	SearchInGroup(ctx context.Context, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
+	SearchNotInGroup(ctx context.Context, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
 	AnalyticsGetInactiveUsersCount() (int64, error)
 	AnalyticsGetExternalUsers(hostDomain string) (bool, error)
 	AnalyticsGetSystemAdminCount() (int64, error)
@@ -484,7 +521,7 @@ type UserStore interface {
 	PromoteGuestToUser(userID string) error
 	DemoteUserToGuest(userID string) (*model.User, error)
 	DeactivateGuests() ([]string, error)
-	AutocompleteUsersInChannel(teamID, channelID, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error)
+	AutocompleteUsersInChannel(ctx context.Context, teamID, channelID, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error)
 	GetKnownUsers(userID string) ([]string, error)
 	IsEmpty(excludeBots bool) (bool, error)
 	GetUsersWithInvalidEmails(page int, perPage int, restrictedDomains string) ([]*model.User, error)
@@ -557,6 +594,7 @@ type ComplianceStore interface {
 type OAuthStore interface {
 	SaveApp(app *model.OAuthApp) (*model.OAuthApp, error)
 	UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error)
+	UpdateAppCreator(appID string, creatorID string) error
 	GetApp(id string) (*model.OAuthApp, error)
 	GetAppByUser(userID string, offset, limit int) ([]*model.OAuthApp, error)
 	GetApps(offset, limit int) ([]*model.OAuthApp, error)
@@ -575,6 +613,12 @@ type OAuthStore interface {
 	GetPreviousAccessData(userID, clientId string) (*model.AccessData, error)
 	RemoveAccessData(token string) error
 	RemoveAllAccessData() error
+	SaveRotatedRefreshToken(token *model.OAuthRotatedRefreshToken) error
+	GetRotatedRefreshToken(refreshToken string) (*model.OAuthRotatedRefreshToken, error)
+	RemoveRotatedRefreshTokens(userID, clientID string) error
+	SaveConnection(connection *model.OAuthConnection) (*model.OAuthConnection, error)
+	GetConnection(userID, service string) (*model.OAuthConnection, error)
+	RemoveConnection(userID, service string) error
 }
 
 type SystemStore interface {
@@ -596,6 +640,7 @@ type WebhookStore interface {
 	GetIncomingByTeam(teamID string, offset, limit int) ([]*model.IncomingWebhook, error)
 	GetIncomingByTeamByUser(teamID string, userID string, offset, limit int) ([]*model.IncomingWebhook, error)
 	UpdateIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error)
+	UpdateIncomingUser(hookID string, userID string) error
 	GetIncomingByChannel(channelID string) ([]*model.IncomingWebhook, error)
 	DeleteIncoming(webhookID string, timestamp int64) error
 	PermanentDeleteIncomingByChannel(channelID string) error
@@ -614,6 +659,14 @@ type WebhookStore interface {
 	PermanentDeleteOutgoingByUser(userID string) error
 	UpdateOutgoing(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, error)
 
+	SaveDirectOutgoing(webhook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, error)
+	GetDirectOutgoing(id string) (*model.DirectOutgoingWebhook, error)
+	GetDirectOutgoingList(offset, limit int) ([]*model.DirectOutgoingWebhook, error)
+	// GetDirectOutgoingByBotUsers returns the hooks of any of the given bot accounts.
+	GetDirectOutgoingByBotUsers(botUserIDs []string) ([]*model.DirectOutgoingWebhook, error)
+	UpdateDirectOutgoing(hook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, error)
+	DeleteDirectOutgoing(webhookID string, timestamp int64) error
+
 	AnalyticsIncomingCount(teamID string) (int64, error)
 	AnalyticsOutgoingCount(teamID string) (int64, error)
 	InvalidateWebhookCache(webhook string)
@@ -625,6 +678,7 @@ type CommandStore interface {
 	GetByTrigger(teamID string, trigger string) (*model.Command, error)
 	Get(id string) (*model.Command, error)
 	GetByTeam(teamID string) ([]*model.Command, error)
+	GetByCreator(creatorID string) ([]*model.Command, error)
 	Delete(commandID string, timestamp int64) error
 	PermanentDeleteByTeam(teamID string) error
 	PermanentDeleteByUser(userID string) error
@@ -643,6 +697,7 @@ type PreferenceStore interface {
 	Save(preferences model.Preferences) error
 	GetCategory(userID string, category string) (model.Preferences, error)
 	GetCategoryAndName(category string, nane string) (model.Preferences, error)
+	GetCategoryAndNameForUsers(userIDs []string, category string, name string) (model.Preferences, error)
 	Get(userID string, category string, name string) (*model.Preference, error)
 	GetAll(userID string) (model.Preferences, error)
 	Delete(userID, category, name string) error
@@ -705,13 +760,21 @@ type FileInfoStore interface {
 	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
 	PermanentDeleteByUser(userID string) (int64, error)
 	SetContent(fileID, content string) error
-	Search(paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.FileInfoList, error)
+	Search(ctx context.Context, paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.FileInfoList, error)
 	CountAll() (int64, error)
 	GetFilesBatchForIndexing(startTime int64, startFileID string, limit int) ([]*model.FileForIndexing, error)
 	ClearCaches()
 	GetStorageUsage(allowFromCache, includeDeleted bool) (int64, error)
 	// GetUptoNSizeFileTime returns the CreateAt time of the last accessible file with a running-total size upto n bytes.
 	GetUptoNSizeFileTime(n int64) (int64, error)
+	// GetForColdStorage returns the files created before the given time that have not been moved to
+	// cold storage yet, ordered by creation time and starting after the given file.
+	GetForColdStorage(before, afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error)
+	// CountForColdStorage returns the number of files created before the given time that have not been
+	// moved to cold storage yet.
+	CountForColdStorage(before int64) (int64, error)
+	// SetColdStorageForPath marks every file stored at the given path as moved to cold storage.
+	SetColdStorageForPath(path string) error
 }
 
 type UploadSessionStore interface {
@@ -744,6 +807,7 @@ type JobStore interface {
 	GetAllPage(offset int, limit int) ([]*model.Job, error)
 	GetAllByType(jobType string) ([]*model.Job, error)
 	GetAllByTypeAndStatus(jobType string, status string) ([]*model.Job, error)
+	GetAllByTypeAndStatusPage(jobType string, status string, offset int, limit int) ([]*model.Job, error)
 	GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error)
 	GetAllByTypesPage(jobTypes []string, offset int, limit int) ([]*model.Job, error)
 	GetAllByStatus(status string) ([]*model.Job, error)
@@ -752,6 +816,9 @@ type JobStore interface {
 	GetCountByStatusAndType(status string, jobType string) (int64, error)
 	Delete(id string) (string, error)
 	Cleanup(expiryTime int64, batchSize int) error
+	GetStatsByType(since int64) ([]*model.JobTypeStats, error)
+	GetTypeSettings() ([]*model.JobTypeSettings, error)
+	SaveTypeSettings(settings *model.JobTypeSettings) (*model.JobTypeSettings, error)
 }
 
 type UserAccessTokenStore interface {
@@ -991,6 +1058,8 @@ type DraftStore interface {
 	Get(userID, channelID, rootID string, includeDeleted bool) (*model.Draft, error)
 	Delete(userID, channelID, rootID string) error
 	GetDraftsForUser(userID, teamID string) ([]*model.Draft, error)
+	// Update saves the draft when its version is the stored one, and increments it. It returns
+	// ErrConflict when the draft has been updated since.
 	Update(d *model.Draft) (*model.Draft, error)
 }
 
@@ -1008,6 +1077,80 @@ type TrueUpReviewStore interface {
 	Update(reviewStatus *model.TrueUpReviewStatus) (*model.TrueUpReviewStatus, error)
 }
 
+type ScheduledPostStore interface {
+	Save(scheduledPost *model.ScheduledPost) (*model.ScheduledPost, error)
+	Get(id string) (*model.ScheduledPost, error)
+	GetForUser(userID string) ([]*model.ScheduledPost, error)
+	CountPendingForUser(userID string) (int64, error)
+	GetPendingBefore(until int64, limit int) ([]*model.ScheduledPost, error)
+	Update(scheduledPost *model.ScheduledPost) (*model.ScheduledPost, error)
+	Delete(id string) error
+}
+
+type PostTranslationStore interface {
+	Save(translation *model.PostTranslation) (*model.PostTranslation, error)
+	Get(postID, language string) (*model.PostTranslation, error)
+	DeleteForPost(postID string) error
+	DeleteOrphanedRows(limit int) (deleted int64, err error)
+}
+
+type PostEmbeddingStore interface {
+	Save(embeddings []*model.PostEmbedding) error
+	Get(postID string) (*model.PostEmbedding, error)
+	Search(embedding []float32, embeddingModel string, channelIDs []string, limit int) ([]*model.PostEmbeddingMatch, error)
+	Delete(postIDs []string) error
+	DeleteOrphanedRows(limit int) (deleted int64, err error)
+}
+
+type ActivityStore interface {
+	Save(activities []*model.Activity) error
+	GetForUser(userID string, options model.ActivityGetOptions) ([]*model.Activity, error)
+	GetUnreadCountForUser(userID string) (int64, error)
+	MarkAsRead(userID string, activityIDs []string, readAt int64) error
+	MarkAllAsRead(userID string, readAt int64) error
+	DeleteForPost(postID string) error
+}
+
+type ReadReceiptStore interface {
+	Save(receipt *model.ReadReceipt) (*model.ReadReceipt, error)
+	Get(channelID, userID string) (*model.ReadReceipt, error)
+	GetForChannelSince(channelID string, postCreateAt int64) ([]*model.ReadReceipt, error)
+	PermanentDeleteByUser(userID string) error
+}
+
+type ReactionSummaryStore interface {
+	SummarizeDay(dayStart int64) error
+	GetLatestDay() (int64, error)
+	GetTopEmojis(opts model.ReactionAnalyticsOptions) ([]*model.ReactionEmojiCount, error)
+	GetTopPosts(opts model.ReactionAnalyticsOptions) ([]*model.ReactionPostCount, error)
+}
+
+type MatrixBridgeStore interface {
+	SaveRoom(room *model.MatrixRoom) (*model.MatrixRoom, error)
+	GetRoomForChannel(channelID string) (*model.MatrixRoom, error)
+	GetRoom(roomID string) (*model.MatrixRoom, error)
+	GetAllRooms() ([]*model.MatrixRoom, error)
+	// DeleteRoom deletes the room bridged to the channel along with its event mappings.
+	DeleteRoom(channelID string) error
+	SaveEvent(event *model.MatrixEvent) (*model.MatrixEvent, error)
+	GetEventForPost(postID string) (*model.MatrixEvent, error)
+	GetEvent(eventID string) (*model.MatrixEvent, error)
+}
+
+type PostExpirationStore interface {
+	GetExpiredBefore(until int64, limit int) ([]*model.PostExpiration, error)
+	Delete(postIDs []string) error
+}
+
+type ChannelBookmarkStore interface {
+	Save(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error)
+	Get(id string, includeDeleted bool) (*model.ChannelBookmark, error)
+	GetBookmarksForChannel(channelID string) ([]*model.ChannelBookmark, error)
+	Update(bookmark *model.ChannelBookmark) error
+	UpdateSortOrder(channelID string, bookmarkIDs []string) error
+	Delete(id string, deleteAt int64) error
+}
+
 // ChannelSearchOpts contains options for searching channels.
 //
 // NotAssociatedToGroup will exclude channels that have associated, active GroupChannels records.
@@ -1116,3 +1259,93 @@ func NewStoreServiceAdapter(store Store) *StoreServiceAdapter {
 func (a *StoreServiceAdapter) GetMasterDB() *sql.DB {
 	return a.store.GetInternalMasterDB()
 }
+
+type WebAuthnCredentialStore interface {
+	Save(credential *model.WebAuthnCredential) (*model.WebAuthnCredential, error)
+	Get(id string) (*model.WebAuthnCredential, error)
+	GetByCredentialId(credentialID string) (*model.WebAuthnCredential, error)
+	GetForUser(userID string) ([]*model.WebAuthnCredential, error)
+	UpdateSignCount(id string, signCount int64, lastUsedAt int64) error
+	UpdateName(id string, name string) error
+	Delete(id string) error
+	PermanentDeleteByUser(userID string) error
+}
+
+type RoleSnapshotStore interface {
+	Save(snapshot *model.RoleSnapshot) (*model.RoleSnapshot, error)
+	Get(id string) (*model.RoleSnapshot, error)
+	GetForRole(roleID string, offset int, limit int) ([]*model.RoleSnapshot, error)
+	PermanentDeleteByRole(roleID string) error
+}
+
+type GuestSponsorshipStore interface {
+	Save(sponsorship *model.GuestSponsorship) (*model.GuestSponsorship, error)
+	Update(sponsorship *model.GuestSponsorship) (*model.GuestSponsorship, error)
+	Get(userID string) (*model.GuestSponsorship, error)
+	GetForSponsor(sponsorID string, offset int, limit int) ([]*model.GuestSponsorship, error)
+	GetExpired(now int64, limit int) ([]*model.GuestSponsorship, error)
+	GetSponsorReport(offset int, limit int) ([]*model.GuestSponsorReport, error)
+	PermanentDeleteByUser(userID string) error
+}
+
+type ChannelMemberExpiryStore interface {
+	Save(expiry *model.ChannelMemberExpiry) (*model.ChannelMemberExpiry, error)
+	Get(channelID string, userID string) (*model.ChannelMemberExpiry, error)
+	GetForChannel(channelID string) ([]*model.ChannelMemberExpiry, error)
+	GetExpired(now int64, limit int) ([]*model.ChannelMemberExpiry, error)
+	Delete(channelID string, userID string) error
+	PermanentDeleteByUser(userID string) error
+}
+
+type ChannelJoinRequestStore interface {
+	Save(request *model.ChannelJoinRequest) (*model.ChannelJoinRequest, error)
+	Update(request *model.ChannelJoinRequest) (*model.ChannelJoinRequest, error)
+	Get(id string) (*model.ChannelJoinRequest, error)
+	GetPending(channelID string, userID string) (*model.ChannelJoinRequest, error)
+	GetForChannel(channelID string, status string, offset int, limit int) ([]*model.ChannelJoinRequest, error)
+	PermanentDeleteByUser(userID string) error
+}
+
+type TeamTemplateStore interface {
+	Save(template *model.TeamTemplate) (*model.TeamTemplate, error)
+	Update(template *model.TeamTemplate) (*model.TeamTemplate, error)
+	Get(id string) (*model.TeamTemplate, error)
+	GetAll(offset int, limit int) ([]*model.TeamTemplate, error)
+	Delete(id string, deleteAt int64) error
+}
+
+type TeamArchiveStore interface {
+	Save(archive *model.TeamArchive) (*model.TeamArchive, error)
+	Update(archive *model.TeamArchive) (*model.TeamArchive, error)
+	Get(teamID string) (*model.TeamArchive, error)
+	Delete(teamID string) error
+}
+
+type SupportAccessConsentStore interface {
+	Save(consent *model.SupportAccessConsent) (*model.SupportAccessConsent, error)
+	GetForUser(userID string) ([]*model.SupportAccessConsent, error)
+	GetActive(userID, adminID string, now int64) (*model.SupportAccessConsent, error)
+	RevokeForUser(userID string, revokedAt int64) error
+	PermanentDeleteByUser(userID string) error
+}
+
+type ConfigChangeStore interface {
+	Save(change *model.ConfigChange) (*model.ConfigChange, error)
+	Get(id string) (*model.ConfigChange, error)
+	GetAll(offset, limit int) ([]*model.ConfigChange, error)
+}
+
+// ArchivedPostStore locates the posts moved from the database to the post archive.
+type ArchivedPostStore interface {
+	// Archive saves the locations of the posts moved to the archive, deleting them from the posts.
+	Archive(archived []*model.ArchivedPost) error
+	GetByIds(postIDs []string) ([]*model.ArchivedPost, error)
+	// GetForThread returns the root post of the thread along with its replies.
+	GetForThread(rootID string) ([]*model.ArchivedPost, error)
+	// GetForChannelBefore returns the posts of the channel, not deleted, created before the given
+	// time, the newest first.
+	GetForChannelBefore(channelID string, before int64, rootsOnly bool, limit int) ([]*model.ArchivedPost, error)
+	// GetSegmentsForUser returns the segments holding the posts created in the given time range in
+	// the channels of the user, in the team or direct and group channels, the newest first.
+	GetSegmentsForUser(userID, teamID string, after, before int64, limit int) ([]string, error)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestActivityStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGetForUser", func(t *testing.T) { testActivitySaveAndGetForUser(t, ss) })
+	t.Run("MarkAsRead", func(t *testing.T) { testActivityMarkAsRead(t, ss) })
+	t.Run("DeleteForPost", func(t *testing.T) { testActivityDeleteForPost(t, ss) })
+}
+
+func testActivitySaveAndGetForUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	actorID := model.NewId()
+
+	err := ss.Activity().Save([]*model.Activity{{UserId: userID, Type: "unknown"}})
+	require.Error(t, err)
+
+	mention := &model.Activity{UserId: userID, Type: model.ActivityTypeMention, ActorId: actorID, PostId: model.NewId(), CreateAt: 1000}
+	reaction := &model.Activity{
+		UserId:   userID,
+		Type:     model.ActivityTypeReaction,
+		ActorId:  actorID,
+		PostId:   model.NewId(),
+		Props:    model.StringMap{model.ActivityPropEmojiName: "smile"},
+		CreateAt: 2000,
+	}
+	reply := &model.Activity{UserId: userID, Type: model.ActivityTypeThreadReply, ActorId: actorID, PostId: model.NewId(), CreateAt: 3000}
+	otherUsers := &model.Activity{UserId: model.NewId(), Type: model.ActivityTypeMention, ActorId: actorID, PostId: model.NewId()}
+	require.NoError(t, ss.Activity().Save([]*model.Activity{mention, reaction, reply, otherUsers}))
+
+	t.Run("most recent first", func(t *testing.T) {
+		activities, err := ss.Activity().GetForUser(userID, model.ActivityGetOptions{PerPage: 10})
+		require.NoError(t, err)
+		require.Len(t, activities, 3)
+		assert.Equal(t, reply.Id, activities[0].Id)
+		assert.Equal(t, reaction.Id, activities[1].Id)
+		assert.Equal(t, "smile", activities[1].Props[model.ActivityPropEmojiName])
+		assert.Equal(t, mention.Id, activities[2].Id)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		activities, err := ss.Activity().GetForUser(userID, model.ActivityGetOptions{Page: 1, PerPage: 2})
+		require.NoError(t, err)
+		require.Len(t, activities, 1)
+		assert.Equal(t, mention.Id, activities[0].Id)
+	})
+
+	t.Run("filtered by type", func(t *testing.T) {
+		activities, err := ss.Activity().GetForUser(userID, model.ActivityGetOptions{
+			Types:   []string{model.ActivityTypeMention, model.ActivityTypeThreadReply},
+			PerPage: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, activities, 2)
+		assert.Equal(t, reply.Id, activities[0].Id)
+		assert.Equal(t, mention.Id, activities[1].Id)
+	})
+}
+
+func testActivityMarkAsRead(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+
+	activities := []*model.Activity{
+		{UserId: userID, Type: model.ActivityTypeMention, PostId: model.NewId()},
+		{UserId: userID, Type: model.ActivityTypeMention, PostId: model.NewId()},
+		{UserId: userID, Type: model.ActivityTypeMention, PostId: model.NewId()},
+	}
+	require.NoError(t, ss.Activity().Save(activities))
+
+	count, err := ss.Activity().GetUnreadCountForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	t.Run("only the user's activities are marked", func(t *testing.T) {
+		require.NoError(t, ss.Activity().MarkAsRead(model.NewId(), []string{activities[0].Id}, 1000))
+
+		count, err := ss.Activity().GetUnreadCountForUser(userID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+
+	require.NoError(t, ss.Activity().MarkAsRead(userID, []string{activities[0].Id}, 1000))
+
+	count, err = ss.Activity().GetUnreadCountForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	unread, err := ss.Activity().GetForUser(userID, model.ActivityGetOptions{UnreadOnly: true, PerPage: 10})
+	require.NoError(t, err)
+	assert.Len(t, unread, 2)
+
+	require.NoError(t, ss.Activity().MarkAllAsRead(userID, 2000))
+
+	count, err = ss.Activity().GetUnreadCountForUser(userID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	all, err := ss.Activity().GetForUser(userID, model.ActivityGetOptions{PerPage: 10})
+	require.NoError(t, err)
+	for _, activity := range all {
+		if activity.Id == activities[0].Id {
+			assert.Equal(t, int64(1000), activity.ReadAt, "the first read is kept")
+		} else {
+			assert.Equal(t, int64(2000), activity.ReadAt)
+		}
+	}
+}
+
+func testActivityDeleteForPost(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	postID := model.NewId()
+
+	require.NoError(t, ss.Activity().Save([]*model.Activity{
+		{UserId: userID, Type: model.ActivityTypeMention, PostId: postID},
+		{UserId: userID, Type: model.ActivityTypeReaction, PostId: postID},
+		{UserId: userID, Type: model.ActivityTypeMention, PostId: model.NewId()},
+	}))
+
+	require.NoError(t, ss.Activity().DeleteForPost(postID))
+
+	activities, err := ss.Activity().GetForUser(userID, model.ActivityGetOptions{PerPage: 10})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.NotEqual(t, postID, activities[0].PostId)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestArchivedPostStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("Archive", func(t *testing.T) { testArchivedPostArchive(t, ss) })
+	t.Run("GetForThread", func(t *testing.T) { testArchivedPostGetForThread(t, ss) })
+	t.Run("GetForChannelBefore", func(t *testing.T) { testArchivedPostGetForChannelBefore(t, ss) })
+	t.Run("GetSegmentsForUser", func(t *testing.T) { testArchivedPostGetSegmentsForUser(t, ss) })
+}
+
+func saveTestArchivedPosts(t *testing.T, ss store.Store, segment string, posts ...*model.Post) []*model.Post {
+	archived := make([]*model.ArchivedPost, 0, len(posts))
+	saved := make([]*model.Post, 0, len(posts))
+	for _, post := range posts {
+		if post.UserId == "" {
+			post.UserId = model.NewId()
+		}
+		if post.Message == "" {
+			post.Message = "archived " + model.NewId()
+		}
+		p, err := ss.Post().Save(post)
+		require.NoError(t, err)
+		saved = append(saved, p)
+		archived = append(archived, model.NewArchivedPost(p, segment))
+	}
+
+	require.NoError(t, ss.ArchivedPost().Archive(archived))
+	return saved
+}
+
+func archivedPostIds(archived []*model.ArchivedPost) []string {
+	ids := make([]string, 0, len(archived))
+	for _, a := range archived {
+		ids = append(ids, a.PostId)
+	}
+	return ids
+}
+
+func testArchivedPostArchive(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	posts := saveTestArchivedPosts(t, ss, "segment",
+		&model.Post{ChannelId: channelID, CreateAt: 1000},
+		&model.Post{ChannelId: channelID, CreateAt: 2000},
+	)
+
+	_, err := ss.Post().GetSingle(posts[0].Id, true)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr), "the archived posts are deleted from the posts")
+
+	archived, err := ss.ArchivedPost().GetByIds([]string{posts[0].Id, posts[1].Id, model.NewId()})
+	require.NoError(t, err)
+	require.Len(t, archived, 2)
+	assert.ElementsMatch(t, []string{posts[0].Id, posts[1].Id}, archivedPostIds(archived))
+	for _, a := range archived {
+		assert.Equal(t, channelID, a.ChannelId)
+		assert.Equal(t, "segment", a.Segment)
+	}
+
+	require.NoError(t, ss.ArchivedPost().Archive(nil))
+}
+
+func testArchivedPostGetForThread(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	root := saveTestArchivedPosts(t, ss, "segment", &model.Post{ChannelId: channelID, CreateAt: 1000})[0]
+	replies := saveTestArchivedPosts(t, ss, "segment",
+		&model.Post{ChannelId: channelID, RootId: root.Id, CreateAt: 2000},
+		&model.Post{ChannelId: channelID, RootId: root.Id, CreateAt: 3000},
+	)
+	saveTestArchivedPosts(t, ss, "segment", &model.Post{ChannelId: channelID, CreateAt: 4000})
+
+	archived, err := ss.ArchivedPost().GetForThread(root.Id)
+	require.NoError(t, err)
+	assert.Equal(t, []string{replies[1].Id, replies[0].Id, root.Id}, archivedPostIds(archived))
+}
+
+func testArchivedPostGetForChannelBefore(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	posts := saveTestArchivedPosts(t, ss, "segment",
+		&model.Post{ChannelId: channelID, CreateAt: 1000},
+		&model.Post{ChannelId: channelID, CreateAt: 2000},
+		&model.Post{ChannelId: channelID, CreateAt: 3000},
+		&model.Post{ChannelId: channelID, CreateAt: 4000, DeleteAt: 5000},
+	)
+	reply := saveTestArchivedPosts(t, ss, "segment", &model.Post{ChannelId: channelID, RootId: posts[0].Id, CreateAt: 3500})[0]
+	saveTestArchivedPosts(t, ss, "segment", &model.Post{ChannelId: model.NewId(), CreateAt: 2500})
+
+	archived, err := ss.ArchivedPost().GetForChannelBefore(channelID, 5000, false, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{reply.Id, posts[2].Id, posts[1].Id, posts[0].Id}, archivedPostIds(archived), "deleted posts are skipped")
+
+	archived, err = ss.ArchivedPost().GetForChannelBefore(channelID, 5000, true, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{posts[2].Id, posts[1].Id}, archivedPostIds(archived))
+
+	archived, err = ss.ArchivedPost().GetForChannelBefore(channelID, 2000, false, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{posts[0].Id}, archivedPostIds(archived))
+}
+
+func testArchivedPostGetSegmentsForUser(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+	userID := model.NewId()
+
+	channel, err := ss.Channel().Save(&model.Channel{
+		TeamId:      teamID,
+		DisplayName: "Archived",
+		Name:        "archived-" + model.NewId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+	_, err = ss.Channel().SaveMember(&model.ChannelMember{
+		ChannelId:   channel.Id,
+		UserId:      userID,
+		NotifyProps: model.GetDefaultChannelNotifyProps(),
+	})
+	require.NoError(t, err)
+
+	otherChannel, err := ss.Channel().Save(&model.Channel{
+		TeamId:      teamID,
+		DisplayName: "Other",
+		Name:        "other-" + model.NewId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+
+	saveTestArchivedPosts(t, ss, "older", &model.Post{ChannelId: channel.Id, CreateAt: 1000})
+	saveTestArchivedPosts(t, ss, "newer", &model.Post{ChannelId: channel.Id, CreateAt: 2000})
+	saveTestArchivedPosts(t, ss, "other", &model.Post{ChannelId: otherChannel.Id, CreateAt: 3000})
+
+	segments, err := ss.ArchivedPost().GetSegmentsForUser(userID, teamID, 0, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"newer", "older"}, segments, "only the segments of the channels of the user are returned")
+
+	segments, err = ss.ArchivedPost().GetSegmentsForUser(userID, teamID, 0, 1500, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"older"}, segments)
+
+	segments, err = ss.ArchivedPost().GetSegmentsForUser(userID, model.NewId(), 0, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, segments)
+}
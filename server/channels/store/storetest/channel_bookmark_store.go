@@ -0,0 +1,139 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestChannelBookmarkStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testChannelBookmarkSaveAndGet(t, ss) })
+	t.Run("Update", func(t *testing.T) { testChannelBookmarkUpdate(t, ss) })
+	t.Run("UpdateSortOrder", func(t *testing.T) { testChannelBookmarkUpdateSortOrder(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testChannelBookmarkDelete(t, ss) })
+}
+
+func newTestChannelBookmark(channelID string, sortOrder int64) *model.ChannelBookmark {
+	return &model.ChannelBookmark{
+		ChannelId:   channelID,
+		OwnerId:     model.NewId(),
+		DisplayName: "bookmark " + model.NewId(),
+		SortOrder:   sortOrder,
+		LinkUrl:     "https://mattermost.com",
+		Type:        model.ChannelBookmarkLink,
+	}
+}
+
+func testChannelBookmarkSaveAndGet(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	second, err := ss.ChannelBookmark().Save(newTestChannelBookmark(channelID, 1))
+	require.NoError(t, err)
+	first, err := ss.ChannelBookmark().Save(newTestChannelBookmark(channelID, 0))
+	require.NoError(t, err)
+	_, err = ss.ChannelBookmark().Save(newTestChannelBookmark(model.NewId(), 0))
+	require.NoError(t, err)
+
+	t.Run("get", func(t *testing.T) {
+		received, err := ss.ChannelBookmark().Get(first.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, first.DisplayName, received.DisplayName)
+		assert.Equal(t, first.LinkUrl, received.LinkUrl)
+		assert.Equal(t, model.ChannelBookmarkLink, received.Type)
+	})
+
+	t.Run("get not found", func(t *testing.T) {
+		_, err := ss.ChannelBookmark().Get(model.NewId(), false)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("get for channel", func(t *testing.T) {
+		bookmarks, err := ss.ChannelBookmark().GetBookmarksForChannel(channelID)
+		require.NoError(t, err)
+		require.Len(t, bookmarks, 2)
+		assert.Equal(t, first.Id, bookmarks[0].Id)
+		assert.Equal(t, second.Id, bookmarks[1].Id)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		bookmark := newTestChannelBookmark(channelID, 0)
+		bookmark.LinkUrl = "junk"
+		_, err := ss.ChannelBookmark().Save(bookmark)
+		require.Error(t, err)
+	})
+}
+
+func testChannelBookmarkUpdate(t *testing.T, ss store.Store) {
+	bookmark, err := ss.ChannelBookmark().Save(newTestChannelBookmark(model.NewId(), 0))
+	require.NoError(t, err)
+
+	bookmark.DisplayName = "updated"
+	bookmark.Emoji = "smile"
+	require.NoError(t, ss.ChannelBookmark().Update(bookmark))
+
+	received, err := ss.ChannelBookmark().Get(bookmark.Id, false)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", received.DisplayName)
+	assert.Equal(t, "smile", received.Emoji)
+
+	t.Run("not found", func(t *testing.T) {
+		missing := newTestChannelBookmark(model.NewId(), 0)
+		missing.PreSave()
+		err := ss.ChannelBookmark().Update(missing)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testChannelBookmarkUpdateSortOrder(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	first, err := ss.ChannelBookmark().Save(newTestChannelBookmark(channelID, 0))
+	require.NoError(t, err)
+	second, err := ss.ChannelBookmark().Save(newTestChannelBookmark(channelID, 1))
+	require.NoError(t, err)
+	other, err := ss.ChannelBookmark().Save(newTestChannelBookmark(model.NewId(), 5))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ChannelBookmark().UpdateSortOrder(channelID, []string{other.Id, second.Id, first.Id}))
+
+	bookmarks, err := ss.ChannelBookmark().GetBookmarksForChannel(channelID)
+	require.NoError(t, err)
+	require.Len(t, bookmarks, 2)
+	assert.Equal(t, second.Id, bookmarks[0].Id)
+	assert.Equal(t, first.Id, bookmarks[1].Id)
+
+	received, err := ss.ChannelBookmark().Get(other.Id, false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), received.SortOrder, "bookmarks of other channels shouldn't be reordered")
+}
+
+func testChannelBookmarkDelete(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	bookmark, err := ss.ChannelBookmark().Save(newTestChannelBookmark(channelID, 0))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ChannelBookmark().Delete(bookmark.Id, model.GetMillis()))
+
+	_, err = ss.ChannelBookmark().Get(bookmark.Id, false)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	deleted, err := ss.ChannelBookmark().Get(bookmark.Id, true)
+	require.NoError(t, err)
+	assert.NotZero(t, deleted.DeleteAt)
+
+	bookmarks, err := ss.ChannelBookmark().GetBookmarksForChannel(channelID)
+	require.NoError(t, err)
+	assert.Empty(t, bookmarks)
+
+	err = ss.ChannelBookmark().Delete(bookmark.Id, model.GetMillis())
+	assert.True(t, errors.As(err, &nfErr), "deleting twice should fail")
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestChannelJoinRequestStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testChannelJoinRequestSaveAndGet(t, ss) })
+	t.Run("Update", func(t *testing.T) { testChannelJoinRequestUpdate(t, ss) })
+	t.Run("GetForChannel", func(t *testing.T) { testChannelJoinRequestGetForChannel(t, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testChannelJoinRequestPermanentDeleteByUser(t, ss) })
+}
+
+func testChannelJoinRequestSaveAndGet(t *testing.T, ss store.Store) {
+	_, err := ss.ChannelJoinRequest().Save(&model.ChannelJoinRequest{Id: model.NewId(), ChannelId: model.NewId(), UserId: model.NewId()})
+	require.Error(t, err)
+
+	_, err = ss.ChannelJoinRequest().Save(&model.ChannelJoinRequest{ChannelId: model.NewId(), UserId: "invalid"})
+	require.Error(t, err)
+
+	request, err := ss.ChannelJoinRequest().Save(&model.ChannelJoinRequest{
+		ChannelId: model.NewId(),
+		UserId:    model.NewId(),
+		Message:   "let me in",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.ChannelJoinRequestStatusPending, request.Status)
+
+	received, err := ss.ChannelJoinRequest().Get(request.Id)
+	require.NoError(t, err)
+	assert.Equal(t, request, received)
+
+	pending, err := ss.ChannelJoinRequest().GetPending(request.ChannelId, request.UserId)
+	require.NoError(t, err)
+	assert.Equal(t, request, pending)
+
+	_, err = ss.ChannelJoinRequest().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.ChannelJoinRequest().GetPending(request.ChannelId, model.NewId())
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testChannelJoinRequestUpdate(t *testing.T, ss store.Store) {
+	request, err := ss.ChannelJoinRequest().Save(&model.ChannelJoinRequest{ChannelId: model.NewId(), UserId: model.NewId()})
+	require.NoError(t, err)
+
+	request.PostId = model.NewId()
+	_, err = ss.ChannelJoinRequest().Update(request)
+	require.NoError(t, err)
+
+	request.Status = model.ChannelJoinRequestStatusDenied
+	_, err = ss.ChannelJoinRequest().Update(request)
+	require.Error(t, err, "a decided request needs a decider")
+
+	request.DeciderId = model.NewId()
+	updated, err := ss.ChannelJoinRequest().Update(request)
+	require.NoError(t, err)
+
+	received, err := ss.ChannelJoinRequest().Get(request.Id)
+	require.NoError(t, err)
+	assert.Equal(t, updated, received)
+
+	_, err = ss.ChannelJoinRequest().GetPending(request.ChannelId, request.UserId)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.ChannelJoinRequest().Update(&model.ChannelJoinRequest{Id: model.NewId(), ChannelId: model.NewId(), UserId: model.NewId(), Status: model.ChannelJoinRequestStatusPending, CreateAt: 1})
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testChannelJoinRequestGetForChannel(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	denied, err := ss.ChannelJoinRequest().Save(&model.ChannelJoinRequest{ChannelId: channelID, UserId: model.NewId()})
+	require.NoError(t, err)
+	denied.Status = model.ChannelJoinRequestStatusDenied
+	denied.DeciderId = model.NewId()
+	_, err = ss.ChannelJoinRequest().Update(denied)
+	require.NoError(t, err)
+
+	time.Sleep(time.Millisecond)
+	pending, err := ss.ChannelJoinRequest().Save(&model.ChannelJoinRequest{ChannelId: channelID, UserId: model.NewId()})
+	require.NoError(t, err)
+	_, err = ss.ChannelJoinRequest().Save(&model.ChannelJoinRequest{ChannelId: model.NewId(), UserId: model.NewId()})
+	require.NoError(t, err)
+
+	requests, err := ss.ChannelJoinRequest().GetForChannel(channelID, "", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	assert.Equal(t, pending.Id, requests[0].Id)
+	assert.Equal(t, denied.Id, requests[1].Id)
+
+	requests, err = ss.ChannelJoinRequest().GetForChannel(channelID, model.ChannelJoinRequestStatusPending, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, pending.Id, requests[0].Id)
+
+	requests, err = ss.ChannelJoinRequest().GetForChannel(channelID, "", 1, 10)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, denied.Id, requests[0].Id)
+}
+
+func testChannelJoinRequestPermanentDeleteByUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	request, err := ss.ChannelJoinRequest().Save(&model.ChannelJoinRequest{ChannelId: model.NewId(), UserId: userID})
+	require.NoError(t, err)
+	other, err := ss.ChannelJoinRequest().Save(&model.ChannelJoinRequest{ChannelId: request.ChannelId, UserId: model.NewId()})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ChannelJoinRequest().PermanentDeleteByUser(userID))
+
+	_, err = ss.ChannelJoinRequest().Get(request.Id)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.ChannelJoinRequest().Get(other.Id)
+	require.NoError(t, err)
+}
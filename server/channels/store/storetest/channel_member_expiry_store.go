@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestChannelMemberExpiryStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testChannelMemberExpirySaveAndGet(t, ss) })
+	t.Run("GetForChannel", func(t *testing.T) { testChannelMemberExpiryGetForChannel(t, ss) })
+	t.Run("GetExpired", func(t *testing.T) { testChannelMemberExpiryGetExpired(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testChannelMemberExpiryDelete(t, ss) })
+}
+
+func testChannelMemberExpirySaveAndGet(t *testing.T, ss store.Store) {
+	_, err := ss.ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{ChannelId: model.NewId(), UserId: model.NewId()})
+	require.Error(t, err)
+
+	expiry, err := ss.ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{
+		ChannelId: model.NewId(),
+		UserId:    model.NewId(),
+		ExpiresAt: model.GetMillis() + 1000,
+		CreatorId: model.NewId(),
+	})
+	require.NoError(t, err)
+
+	received, err := ss.ChannelMemberExpiry().Get(expiry.ChannelId, expiry.UserId)
+	require.NoError(t, err)
+	assert.Equal(t, expiry, received)
+
+	// Saving again replaces the previous expiry
+	replaced, err := ss.ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{
+		ChannelId: expiry.ChannelId,
+		UserId:    expiry.UserId,
+		ExpiresAt: expiry.ExpiresAt + 1000,
+	})
+	require.NoError(t, err)
+
+	received, err = ss.ChannelMemberExpiry().Get(expiry.ChannelId, expiry.UserId)
+	require.NoError(t, err)
+	assert.Equal(t, replaced, received)
+
+	_, err = ss.ChannelMemberExpiry().Get(expiry.ChannelId, model.NewId())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testChannelMemberExpiryGetForChannel(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	later, err := ss.ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{ChannelId: channelID, UserId: model.NewId(), ExpiresAt: 2000})
+	require.NoError(t, err)
+	sooner, err := ss.ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{ChannelId: channelID, UserId: model.NewId(), ExpiresAt: 1000})
+	require.NoError(t, err)
+	_, err = ss.ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{ChannelId: model.NewId(), UserId: model.NewId(), ExpiresAt: 1000})
+	require.NoError(t, err)
+
+	expiries, err := ss.ChannelMemberExpiry().GetForChannel(channelID)
+	require.NoError(t, err)
+	require.Len(t, expiries, 2)
+	assert.Equal(t, sooner.UserId, expiries[0].UserId)
+	assert.Equal(t, later.UserId, expiries[1].UserId)
+}
+
+func testChannelMemberExpiryGetExpired(t *testing.T, ss store.Store) {
+	now := model.GetMillis()
+	expired, err := ss.ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{ChannelId: model.NewId(), UserId: model.NewId(), ExpiresAt: now - 1000})
+	require.NoError(t, err)
+	notYet, err := ss.ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{ChannelId: model.NewId(), UserId: model.NewId(), ExpiresAt: now + 60000})
+	require.NoError(t, err)
+
+	expiries, err := ss.ChannelMemberExpiry().GetExpired(now, 1000)
+	require.NoError(t, err)
+
+	channelIDs := make(map[string]bool, len(expiries))
+	for _, expiry := range expiries {
+		channelIDs[expiry.ChannelId] = true
+	}
+	assert.True(t, channelIDs[expired.ChannelId])
+	assert.False(t, channelIDs[notYet.ChannelId])
+}
+
+func testChannelMemberExpiryDelete(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	first, err := ss.ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{ChannelId: model.NewId(), UserId: userID, ExpiresAt: 1000})
+	require.NoError(t, err)
+	second, err := ss.ChannelMemberExpiry().Save(&model.ChannelMemberExpiry{ChannelId: model.NewId(), UserId: userID, ExpiresAt: 1000})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ChannelMemberExpiry().Delete(first.ChannelId, userID))
+	_, err = ss.ChannelMemberExpiry().Get(first.ChannelId, userID)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.ChannelMemberExpiry().Get(second.ChannelId, userID)
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ChannelMemberExpiry().PermanentDeleteByUser(userID))
+	_, err = ss.ChannelMemberExpiry().Get(second.ChannelId, userID)
+	require.True(t, errors.As(err, &nfErr))
+}
@@ -71,6 +71,7 @@ func TestChannelStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("CreateDirectChannel", func(t *testing.T) { testChannelStoreCreateDirectChannel(t, ss) })
 	t.Run("Update", func(t *testing.T) { testChannelStoreUpdate(t, ss) })
 	t.Run("GetChannelUnread", func(t *testing.T) { testGetChannelUnread(t, ss) })
+	t.Run("GetInboxChannelsForUser", func(t *testing.T) { testGetInboxChannelsForUser(t, ss) })
 	t.Run("Get", func(t *testing.T) { testChannelStoreGet(t, ss, s) })
 	t.Run("GetMany", func(t *testing.T) { testChannelStoreGetMany(t, ss, s) })
 	t.Run("GetChannelsByIds", func(t *testing.T) { testChannelStoreGetChannelsByIds(t, ss) })
@@ -113,6 +114,7 @@ func TestChannelStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("GetMemberCount", func(t *testing.T) { testGetMemberCount(t, ss) })
 	t.Run("GetMemberCountsByGroup", func(t *testing.T) { testGetMemberCountsByGroup(t, ss) })
 	t.Run("GetGuestCount", func(t *testing.T) { testGetGuestCount(t, ss) })
+	t.Run("Counters", func(t *testing.T) { testChannelStoreCounters(t, ss) })
 	t.Run("SearchMore", func(t *testing.T) { testChannelStoreSearchMore(t, ss) })
 	t.Run("SearchInTeam", func(t *testing.T) { testChannelStoreSearchInTeam(t, ss) })
 	t.Run("Autocomplete", func(t *testing.T) { testAutocomplete(t, ss) })
@@ -125,6 +127,7 @@ func TestChannelStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("SearchGroupChannels", func(t *testing.T) { testChannelStoreSearchGroupChannels(t, ss) })
 	t.Run("AnalyticsDeletedTypeCount", func(t *testing.T) { testChannelStoreAnalyticsDeletedTypeCount(t, ss) })
 	t.Run("GetPinnedPosts", func(t *testing.T) { testChannelStoreGetPinnedPosts(t, ss) })
+	t.Run("ChannelPinnedPosts", func(t *testing.T) { testChannelStoreChannelPinnedPosts(t, ss) })
 	t.Run("GetPinnedPostCount", func(t *testing.T) { testChannelStoreGetPinnedPostCount(t, ss) })
 	t.Run("MaxChannelsPerTeam", func(t *testing.T) { testChannelStoreMaxChannelsPerTeam(t, ss) })
 	t.Run("GetChannelsByScheme", func(t *testing.T) { testChannelStoreGetChannelsByScheme(t, ss) })
@@ -405,6 +408,60 @@ func testGetChannelUnread(t *testing.T, ss store.Store) {
 	require.EqualValues(t, 10, ch2.MsgCount, "wrong MsgCount for channel 2")
 }
 
+func testGetInboxChannelsForUser(t *testing.T, ss store.Store) {
+	uid := model.NewId()
+	notifyProps := model.GetDefaultChannelNotifyProps()
+
+	saveChannel := func(lastPostAt, msgCount, mentionCount int64) *model.Channel {
+		channel, err := ss.Channel().Save(&model.Channel{
+			TeamId:            model.NewId(),
+			Name:              model.NewId(),
+			DisplayName:       "Inbox",
+			Type:              model.ChannelTypeOpen,
+			LastPostAt:        lastPostAt,
+			LastRootPostAt:    lastPostAt,
+			TotalMsgCount:     10,
+			TotalMsgCountRoot: 10,
+		}, -1)
+		require.NoError(t, err)
+
+		_, err = ss.Channel().SaveMember(&model.ChannelMember{
+			ChannelId:        channel.Id,
+			UserId:           uid,
+			NotifyProps:      notifyProps,
+			MsgCount:         10 - msgCount,
+			MsgCountRoot:     10 - msgCount,
+			MentionCount:     mentionCount,
+			MentionCountRoot: mentionCount,
+		})
+		require.NoError(t, err)
+
+		return channel
+	}
+
+	oldest := saveChannel(1000, 2, 0)
+	mentioned := saveChannel(2000, 0, 1)
+	saveChannel(3000, 0, 0)
+	newest := saveChannel(4000, 5, 0)
+
+	options := model.GetInboxOptions{PerPage: 2}
+	items, err := ss.Channel().GetInboxChannelsForUser(uid, options)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, newest.Id, items[0].Id)
+	assert.Equal(t, model.InboxItemTypeChannel, items[0].Type)
+	assert.Equal(t, newest.TeamId, items[0].TeamId)
+	assert.EqualValues(t, 5, items[0].MsgCount)
+	assert.Equal(t, mentioned.Id, items[1].Id)
+	assert.EqualValues(t, 1, items[1].MentionCount)
+
+	options.Cursor = items[1].Cursor()
+	items, err = ss.Channel().GetInboxChannelsForUser(uid, options)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, oldest.Id, items[0].Id)
+}
+
 func testChannelStoreGet(t *testing.T, ss store.Store, s SqlStore) {
 	o1 := model.Channel{}
 	o1.TeamId = model.NewId()
@@ -5435,6 +5492,115 @@ func testGetMemberCountsByGroup(t *testing.T, ss store.Store) {
 	})
 }
 
+func testChannelStoreCounters(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+
+	channel := &model.Channel{
+		TeamId:      teamID,
+		DisplayName: "Channel1",
+		Name:        NewTestId(),
+		Type:        model.ChannelTypeOpen,
+	}
+	_, err := ss.Channel().Save(channel, -1)
+	require.NoError(t, err)
+
+	requireCounters := func(t *testing.T, members, guests, pinned int64) {
+		t.Helper()
+		counters, err := ss.Channel().GetCounters(channel.Id)
+		require.NoError(t, err)
+		assert.Equal(t, members, counters.MemberCount, "member count")
+		assert.Equal(t, guests, counters.GuestCount, "guest count")
+		assert.Equal(t, pinned, counters.PinnedPostCount, "pinned post count")
+	}
+
+	user, err := ss.User().Save(&model.User{Email: MakeEmail(), Roles: model.SystemUserRoleId})
+	require.NoError(t, err)
+	guest, err := ss.User().Save(&model.User{Email: MakeEmail(), Roles: model.SystemGuestRoleId})
+	require.NoError(t, err)
+
+	t.Run("new channel", func(t *testing.T) {
+		requireCounters(t, 0, 0, 0)
+	})
+
+	t.Run("members saved and removed", func(t *testing.T) {
+		_, err := ss.Channel().SaveMultipleMembers([]*model.ChannelMember{
+			{ChannelId: channel.Id, UserId: user.Id, NotifyProps: model.GetDefaultChannelNotifyProps(), SchemeUser: true},
+			{ChannelId: channel.Id, UserId: guest.Id, NotifyProps: model.GetDefaultChannelNotifyProps(), SchemeGuest: true},
+		})
+		require.NoError(t, err)
+		requireCounters(t, 2, 1, 0)
+
+		require.NoError(t, ss.Channel().RemoveMember(channel.Id, guest.Id))
+		requireCounters(t, 1, 0, 0)
+	})
+
+	t.Run("member deactivated and activated", func(t *testing.T) {
+		user.DeleteAt = model.GetMillis()
+		_, err := ss.User().Update(user, true)
+		require.NoError(t, err)
+		requireCounters(t, 0, 0, 0)
+
+		user.DeleteAt = 0
+		_, err = ss.User().Update(user, true)
+		require.NoError(t, err)
+		requireCounters(t, 1, 0, 0)
+	})
+
+	t.Run("posts pinned and deleted", func(t *testing.T) {
+		post, err := ss.Post().Save(&model.Post{ChannelId: channel.Id, UserId: user.Id, Message: NewTestId(), IsPinned: true})
+		require.NoError(t, err)
+		requireCounters(t, 1, 0, 1)
+
+		unpinned := post.Clone()
+		unpinned.IsPinned = false
+		_, err = ss.Post().Update(unpinned, post.Clone())
+		require.NoError(t, err)
+		requireCounters(t, 1, 0, 0)
+
+		pinned := unpinned.Clone()
+		pinned.IsPinned = true
+		_, err = ss.Post().Update(pinned, unpinned)
+		require.NoError(t, err)
+		requireCounters(t, 1, 0, 1)
+
+		require.NoError(t, ss.Post().Delete(post.Id, model.GetMillis(), user.Id))
+		requireCounters(t, 1, 0, 0)
+	})
+
+	t.Run("reconciled", func(t *testing.T) {
+		other := &model.Channel{
+			TeamId:      teamID,
+			DisplayName: "Channel2",
+			Name:        NewTestId(),
+			Type:        model.ChannelTypeOpen,
+		}
+		_, err := ss.Channel().Save(other, -1)
+		require.NoError(t, err)
+		_, err = ss.Channel().SaveMember(&model.ChannelMember{ChannelId: other.Id, UserId: guest.Id, NotifyProps: model.GetDefaultChannelNotifyProps(), SchemeGuest: true})
+		require.NoError(t, err)
+
+		for after := ""; ; {
+			after, err = ss.Channel().ReconcileCounters(after, 100)
+			require.NoError(t, err)
+			if after == "" {
+				break
+			}
+		}
+
+		requireCounters(t, 1, 0, 0)
+		counters, err := ss.Channel().GetCounters(other.Id)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), counters.MemberCount)
+		assert.Equal(t, int64(1), counters.GuestCount)
+	})
+
+	t.Run("not maintained", func(t *testing.T) {
+		_, err := ss.Channel().GetCounters(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+}
+
 func testGetGuestCount(t *testing.T, ss store.Store) {
 	teamId := model.NewId()
 
@@ -5742,7 +5908,7 @@ func testChannelStoreSearchArchivedInTeam(t *testing.T, ss store.Store, s SqlSto
 	require.NoError(t, nErr)
 
 	t.Run("empty result", func(t *testing.T) {
-		list, err := ss.Channel().SearchArchivedInTeam(teamId, "term", userId)
+		list, err := ss.Channel().SearchArchivedInTeam(context.Background(), teamId, "term", userId)
 		require.NoError(t, err)
 		require.NotNil(t, list)
 		require.Empty(t, list)
@@ -5753,13 +5919,13 @@ func testChannelStoreSearchArchivedInTeam(t *testing.T, ss store.Store, s SqlSto
 		s.GetMasterX().Exec("ALTER TABLE Channels RENAME TO Channels_renamed")
 		defer s.GetMasterX().Exec("ALTER TABLE Channels_renamed RENAME TO Channels")
 
-		list, err := ss.Channel().SearchArchivedInTeam(teamId, "term", userId)
+		list, err := ss.Channel().SearchArchivedInTeam(context.Background(), teamId, "term", userId)
 		require.Error(t, err)
 		require.Nil(t, list)
 	})
 
 	t.Run("find term", func(t *testing.T) {
-		list, err := ss.Channel().SearchArchivedInTeam(teamId, "Channel", userId)
+		list, err := ss.Channel().SearchArchivedInTeam(context.Background(), teamId, "Channel", userId)
 		require.NoError(t, err)
 		require.NotNil(t, list)
 		require.Equal(t, len(list), 1)
@@ -5957,7 +6123,7 @@ func testChannelStoreSearchInTeam(t *testing.T, ss store.Store) {
 
 	for _, testCase := range testCases {
 		t.Run("SearchInTeam/"+testCase.Description, func(t *testing.T) {
-			channels, err := ss.Channel().SearchInTeam(testCase.TeamID, testCase.Term, testCase.IncludeDeleted)
+			channels, err := ss.Channel().SearchInTeam(context.Background(), testCase.TeamID, testCase.Term, testCase.IncludeDeleted)
 			require.NoError(t, err)
 			require.Equal(t, testCase.ExpectedResults, channels)
 		})
@@ -5978,7 +6144,7 @@ func testChannelStoreSearchInTeam(t *testing.T, ss store.Store) {
 
 	for _, testCase := range testCases {
 		t.Run("AutoCompleteInTeam/"+testCase.Description, func(t *testing.T) {
-			channels, err := ss.Channel().AutocompleteInTeam(testCase.TeamID, testCase.UserID, testCase.Term, testCase.IncludeDeleted, false)
+			channels, err := ss.Channel().AutocompleteInTeam(context.Background(), testCase.TeamID, testCase.UserID, testCase.Term, testCase.IncludeDeleted, false)
 			require.NoError(t, err)
 			sort.Sort(ByChannelDisplayName(channels))
 			require.Equal(t, testCase.ExpectedResults, channels)
@@ -6166,7 +6332,7 @@ func testAutocomplete(t *testing.T, ss store.Store) {
 
 	for _, testCase := range testCases {
 		t.Run("Autocomplete/"+testCase.Description, func(t *testing.T) {
-			channels, err := ss.Channel().Autocomplete(testCase.UserID, testCase.Term, testCase.IncludeDeleted, testCase.IsGuest)
+			channels, err := ss.Channel().Autocomplete(context.Background(), testCase.UserID, testCase.Term, testCase.IncludeDeleted, testCase.IsGuest)
 			require.NoError(t, err)
 			var gotChannelIds []string
 			var gotTeamNames []string
@@ -6242,7 +6408,7 @@ func testChannelStoreSearchForUserInTeam(t *testing.T, ss store.Store) {
 	}
 
 	searchAndCheck := func(t *testing.T, term string, includeDeleted bool, expectedDisplayNames []string) {
-		res, searchErr := ss.Channel().SearchForUserInTeam(userId, teamId, term, includeDeleted)
+		res, searchErr := ss.Channel().SearchForUserInTeam(context.Background(), userId, teamId, term, includeDeleted)
 		require.NoError(t, searchErr)
 		require.Len(t, res, len(expectedDisplayNames))
 
@@ -6531,7 +6697,7 @@ func testChannelStoreSearchAllChannels(t *testing.T, ss store.Store) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Description, func(t *testing.T) {
-			channels, count, err := ss.Channel().SearchAllChannels(testCase.Term, testCase.Opts)
+			channels, count, err := ss.Channel().SearchAllChannels(context.Background(), testCase.Term, testCase.Opts)
 			require.NoError(t, err)
 			require.Equal(t, len(testCase.ExpectedResults), len(channels))
 			for i, expected := range testCase.ExpectedResults {
@@ -6833,7 +6999,7 @@ func testChannelStoreSearchGroupChannels(t *testing.T, ss store.Store) {
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			result, err := ss.Channel().SearchGroupChannels(tc.UserId, tc.Term)
+			result, err := ss.Channel().SearchGroupChannels(context.Background(), tc.UserId, tc.Term)
 			require.NoError(t, err)
 
 			resultIds := []string{}
@@ -7364,7 +7530,7 @@ func testMaterializedPublicChannels(t *testing.T, ss store.Store, s SqlStore) {
 	require.NoError(t, nErr)
 
 	t.Run("o1 and o2 initially listed in public channels", func(t *testing.T) {
-		channels, channelErr := ss.Channel().SearchInTeam(teamId, "", true)
+		channels, channelErr := ss.Channel().SearchInTeam(context.Background(), teamId, "", true)
 		require.NoError(t, channelErr)
 		require.Equal(t, model.ChannelList{&o1, &o2}, channels)
 	})
@@ -7376,7 +7542,7 @@ func testMaterializedPublicChannels(t *testing.T, ss store.Store, s SqlStore) {
 	require.NoError(t, e, "channel should have been deleted")
 
 	t.Run("o1 still listed in public channels when marked as deleted", func(t *testing.T) {
-		channels, channelErr := ss.Channel().SearchInTeam(teamId, "", true)
+		channels, channelErr := ss.Channel().SearchInTeam(context.Background(), teamId, "", true)
 		require.NoError(t, channelErr)
 		require.Equal(t, model.ChannelList{&o1, &o2}, channels)
 	})
@@ -7384,7 +7550,7 @@ func testMaterializedPublicChannels(t *testing.T, ss store.Store, s SqlStore) {
 	ss.Channel().PermanentDelete(o1.Id)
 
 	t.Run("o1 no longer listed in public channels when permanently deleted", func(t *testing.T) {
-		channels, channelErr := ss.Channel().SearchInTeam(teamId, "", true)
+		channels, channelErr := ss.Channel().SearchInTeam(context.Background(), teamId, "", true)
 		require.NoError(t, channelErr)
 		require.Equal(t, model.ChannelList{&o2}, channels)
 	})
@@ -7394,7 +7560,7 @@ func testMaterializedPublicChannels(t *testing.T, ss store.Store, s SqlStore) {
 	require.NoError(t, err)
 
 	t.Run("o2 no longer listed since now private", func(t *testing.T) {
-		channels, channelErr := ss.Channel().SearchInTeam(teamId, "", true)
+		channels, channelErr := ss.Channel().SearchInTeam(context.Background(), teamId, "", true)
 		require.NoError(t, channelErr)
 		require.Equal(t, model.ChannelList{}, channels)
 	})
@@ -7404,7 +7570,7 @@ func testMaterializedPublicChannels(t *testing.T, ss store.Store, s SqlStore) {
 	require.NoError(t, err)
 
 	t.Run("o2 listed once again since now public", func(t *testing.T) {
-		channels, channelErr := ss.Channel().SearchInTeam(teamId, "", true)
+		channels, channelErr := ss.Channel().SearchInTeam(context.Background(), teamId, "", true)
 		require.NoError(t, channelErr)
 		require.Equal(t, model.ChannelList{&o2}, channels)
 	})
@@ -7461,7 +7627,7 @@ func testMaterializedPublicChannels(t *testing.T, ss store.Store, s SqlStore) {
 	require.NoError(t, execerr)
 
 	t.Run("verify o3 INSERT converted to UPDATE", func(t *testing.T) {
-		channels, channelErr := ss.Channel().SearchInTeam(teamId, "", true)
+		channels, channelErr := ss.Channel().SearchInTeam(context.Background(), teamId, "", true)
 		require.NoError(t, channelErr)
 		require.Equal(t, model.ChannelList{&o2, &o3}, channels)
 	})
@@ -7490,7 +7656,7 @@ func testMaterializedPublicChannels(t *testing.T, ss store.Store, s SqlStore) {
 	require.NoError(t, err)
 
 	t.Run("verify o4 UPDATE converted to INSERT", func(t *testing.T) {
-		channels, err := ss.Channel().SearchInTeam(teamId, "", true)
+		channels, err := ss.Channel().SearchInTeam(context.Background(), teamId, "", true)
 		require.NoError(t, err)
 		require.Equal(t, model.ChannelList{&o2, &o3, &o4}, channels)
 	})
@@ -8224,3 +8390,32 @@ func testGetTopInactiveChannels(t *testing.T, ss store.Store) {
 	})
 
 }
+
+func testChannelStoreChannelPinnedPosts(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+
+	pinned, err := ss.Channel().GetChannelPinnedPosts(channelID)
+	require.NoError(t, err)
+	assert.Equal(t, &model.ChannelPinnedPosts{ChannelId: channelID, PostOrder: model.StringArray{}}, pinned)
+
+	pinned.PostOrder = model.StringArray{model.NewId(), model.NewId()}
+	pinned.PinLimit = 10
+	_, err = ss.Channel().SaveChannelPinnedPosts(pinned)
+	require.NoError(t, err)
+
+	saved, err := ss.Channel().GetChannelPinnedPosts(channelID)
+	require.NoError(t, err)
+	assert.Equal(t, pinned, saved)
+
+	saved.PinLimit = 0
+	saved.PostOrder = model.StringArray{saved.PostOrder[1]}
+	_, err = ss.Channel().SaveChannelPinnedPosts(saved)
+	require.NoError(t, err)
+
+	updated, err := ss.Channel().GetChannelPinnedPosts(channelID)
+	require.NoError(t, err)
+	assert.Equal(t, saved, updated)
+
+	_, err = ss.Channel().SaveChannelPinnedPosts(&model.ChannelPinnedPosts{ChannelId: channelID, PinLimit: -1})
+	require.Error(t, err)
+}
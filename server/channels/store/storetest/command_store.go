@@ -17,6 +17,7 @@ func TestCommandStore(t *testing.T, ss store.Store) {
 	t.Run("Save", func(t *testing.T) { testCommandStoreSave(t, ss) })
 	t.Run("Get", func(t *testing.T) { testCommandStoreGet(t, ss) })
 	t.Run("GetByTeam", func(t *testing.T) { testCommandStoreGetByTeam(t, ss) })
+	t.Run("GetByCreator", func(t *testing.T) { testCommandStoreGetByCreator(t, ss) })
 	t.Run("GetByTrigger", func(t *testing.T) { testCommandStoreGetByTrigger(t, ss) })
 	t.Run("Delete", func(t *testing.T) { testCommandStoreDelete(t, ss) })
 	t.Run("DeleteByTeam", func(t *testing.T) { testCommandStoreDeleteByTeam(t, ss) })
@@ -82,6 +83,40 @@ func testCommandStoreGetByTeam(t *testing.T, ss store.Store) {
 	require.Empty(t, result, "no commands should have returned")
 }
 
+func testCommandStoreGetByCreator(t *testing.T, ss store.Store) {
+	creatorID := model.NewId()
+
+	o1 := &model.Command{}
+	o1.CreatorId = creatorID
+	o1.Method = model.CommandMethodPost
+	o1.TeamId = model.NewId()
+	o1.URL = "http://nowhere.com/"
+	o1.Trigger = "trigger"
+
+	o1, nErr := ss.Command().Save(o1)
+	require.NoError(t, nErr)
+
+	o2 := &model.Command{}
+	o2.CreatorId = creatorID
+	o2.Method = model.CommandMethodPost
+	o2.TeamId = model.NewId()
+	o2.URL = "http://nowhere.com/"
+	o2.Trigger = "trigger"
+
+	o2, nErr = ss.Command().Save(o2)
+	require.NoError(t, nErr)
+	require.NoError(t, ss.Command().Delete(o2.Id, model.GetMillis()))
+
+	r1, nErr := ss.Command().GetByCreator(creatorID)
+	require.NoError(t, nErr)
+	require.Len(t, r1, 1, "deleted commands aren't returned")
+	require.Equal(t, o1.Id, r1[0].Id)
+
+	result, nErr := ss.Command().GetByCreator(model.NewId())
+	require.NoError(t, nErr)
+	require.Empty(t, result, "no commands should have returned")
+}
+
 func testCommandStoreGetByTrigger(t *testing.T, ss store.Store) {
 	o1 := &model.Command{}
 	o1.CreatorId = model.NewId()
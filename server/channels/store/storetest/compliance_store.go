@@ -54,6 +54,7 @@ func TestComplianceStore(t *testing.T, ss store.Store) {
 	t.Run("MessageExportPrivateChannel", func(t *testing.T) { testMessageExportPrivateChannel(t, ss) })
 	t.Run("MessageExportDirectMessageChannel", func(t *testing.T) { testMessageExportDirectMessageChannel(t, ss) })
 	t.Run("MessageExportGroupMessageChannel", func(t *testing.T) { testMessageExportGroupMessageChannel(t, ss) })
+	t.Run("MessageExportEncryptedMessage", func(t *testing.T) { testMessageExportEncryptedMessage(t, ss) })
 	t.Run("MessageEditExportMessage", func(t *testing.T) { testEditExportMessage(t, ss) })
 	t.Run("MessageEditAfterExportMessage", func(t *testing.T) { testEditAfterExportMessage(t, ss) })
 	t.Run("MessageDeleteExportMessage", func(t *testing.T) { testDeleteExportMessage(t, ss) })
@@ -784,6 +785,55 @@ func testMessageExportGroupMessageChannel(t *testing.T, ss store.Store) {
 }
 
 // post,edit,export
+func testMessageExportEncryptedMessage(t *testing.T, ss store.Store) {
+	defer cleanupStoreState(t, ss)
+
+	startTime := model.GetMillis()
+
+	user1, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+	require.NoError(t, err)
+	user2, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+	require.NoError(t, err)
+
+	directMessageChannel, nErr := ss.Channel().CreateDirectChannel(user1, user2)
+	require.NoError(t, nErr)
+
+	envelope := &model.EncryptedEnvelope{Algorithm: "alg", KeyIds: []string{"key"}, Ciphertext: "YQ=="}
+	encryptedPost := &model.Post{
+		ChannelId: directMessageChannel.Id,
+		UserId:    user1.Id,
+		CreateAt:  startTime + 10,
+	}
+	encryptedPost.AddProp(model.PostPropsEncryptedEnvelope, envelope)
+	encryptedPost, err = ss.Post().Save(encryptedPost)
+	require.NoError(t, err)
+
+	post, err := ss.Post().Save(&model.Post{
+		ChannelId: directMessageChannel.Id,
+		UserId:    user2.Id,
+		CreateAt:  startTime + 20,
+		Message:   NewTestId(),
+	})
+	require.NoError(t, err)
+
+	messages, _, err := ss.Compliance().MessageExport(context.Background(), model.MessageExportCursor{LastPostUpdateAt: startTime - 10}, 10)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+
+	messageExportMap := map[string]*model.MessageExport{}
+	for _, v := range messages {
+		messageExportMap[*v.PostId] = v
+	}
+
+	// Encrypted messages are exported as such, along with their envelope
+	assert.True(t, messageExportMap[encryptedPost.Id].IsEncrypted())
+	assert.Equal(t, model.MessageExportEncryptedMessage, *messageExportMap[encryptedPost.Id].PostMessage)
+	assert.Contains(t, *messageExportMap[encryptedPost.Id].PostProps, envelope.Ciphertext)
+
+	assert.False(t, messageExportMap[post.Id].IsEncrypted())
+	assert.Equal(t, post.Message, *messageExportMap[post.Id].PostMessage)
+}
+
 func testEditExportMessage(t *testing.T, ss store.Store) {
 	defer cleanupStoreState(t, ss)
 	// get the starting number of message export entries
@@ -0,0 +1,85 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestConfigChangeStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testConfigChangeSaveAndGet(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testConfigChangeGetAll(t, ss) })
+}
+
+func newTestConfigChange(createAt int64, siteURL string) *model.ConfigChange {
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+	cfg.ServiceSettings.SiteURL = model.NewString(siteURL)
+
+	return &model.ConfigChange{
+		UserId:   model.NewId(),
+		CreateAt: createAt,
+		Diffs: []*model.ConfigChangeDiff{
+			{Path: "ServiceSettings.SiteURL", OldValue: "", NewValue: siteURL},
+		},
+		Config: cfg,
+	}
+}
+
+func testConfigChangeSaveAndGet(t *testing.T, ss store.Store) {
+	invalid := newTestConfigChange(model.GetMillis(), "http://example.com")
+	invalid.Config = nil
+	_, err := ss.ConfigChange().Save(invalid)
+	require.Error(t, err)
+
+	change, err := ss.ConfigChange().Save(newTestConfigChange(model.GetMillis(), "http://example.com"))
+	require.NoError(t, err)
+	require.NotEmpty(t, change.Id)
+
+	_, err = ss.ConfigChange().Save(change)
+	var invErr *store.ErrInvalidInput
+	require.True(t, errors.As(err, &invErr), "a change can't be saved twice")
+
+	got, err := ss.ConfigChange().Get(change.Id)
+	require.NoError(t, err)
+	assert.Equal(t, change.UserId, got.UserId)
+	require.Len(t, got.Diffs, 1)
+	assert.Equal(t, "ServiceSettings.SiteURL", got.Diffs[0].Path)
+	assert.Equal(t, "http://example.com", got.Diffs[0].NewValue)
+	require.NotNil(t, got.Config)
+	assert.Equal(t, "http://example.com", *got.Config.ServiceSettings.SiteURL)
+
+	_, err = ss.ConfigChange().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testConfigChangeGetAll(t *testing.T, ss store.Store) {
+	// The changes are made in the future to be the newest ones.
+	now := model.GetMillis() + 60*1000
+	older, err := ss.ConfigChange().Save(newTestConfigChange(now, "http://older.example.com"))
+	require.NoError(t, err)
+	newer, err := ss.ConfigChange().Save(newTestConfigChange(now+1, "http://newer.example.com"))
+	require.NoError(t, err)
+
+	changes, err := ss.ConfigChange().GetAll(0, 2)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, newer.Id, changes[0].Id)
+	assert.Equal(t, older.Id, changes[1].Id)
+	assert.Nil(t, changes[0].Config, "the configs aren't loaded")
+	require.Len(t, changes[0].Diffs, 1)
+
+	changes, err = ss.ConfigChange().GetAll(1, 1)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, older.Id, changes[0].Id)
+}
@@ -128,18 +128,44 @@ func testUpdateDraft(t *testing.T, ss store.Store) {
 		Message:   "draft2",
 	}
 
+	_, err = ss.Draft().Save(draft1)
+	require.NoError(t, err)
+
+	_, err = ss.Draft().Save(draft2)
+	require.NoError(t, err)
+
 	t.Run("update drafts", func(t *testing.T) {
 		draftResp, err := ss.Draft().Update(draft1)
 		assert.NoError(t, err)
 
 		assert.Equal(t, draft1.Message, draftResp.Message)
 		assert.Equal(t, draft1.ChannelId, draftResp.ChannelId)
+		assert.Equal(t, int64(2), draftResp.Version)
 
 		draftResp, err = ss.Draft().Update(draft2)
 		assert.NoError(t, err)
 
 		assert.Equal(t, draft2.Message, draftResp.Message)
 		assert.Equal(t, draft2.ChannelId, draftResp.ChannelId)
+		assert.Equal(t, int64(2), draftResp.Version)
+	})
+
+	t.Run("update outdated draft", func(t *testing.T) {
+		outdated := &model.Draft{
+			CreateAt:  draft1.CreateAt,
+			UserId:    user.Id,
+			ChannelId: channel.Id,
+			Message:   "outdated",
+			Version:   1,
+		}
+		_, err := ss.Draft().Update(outdated)
+		var cErr *store.ErrConflict
+		require.ErrorAs(t, err, &cErr)
+
+		stored, err := ss.Draft().Get(user.Id, channel.Id, "", false)
+		require.NoError(t, err)
+		assert.Equal(t, draft1.Message, stored.Message)
+		assert.Equal(t, int64(2), stored.Version)
 	})
 }
 
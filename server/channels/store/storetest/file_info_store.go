@@ -36,6 +36,7 @@ func TestFileInfoStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("CountAll", func(t *testing.T) { testFileInfoStoreCountAll(t, ss) })
 	t.Run("GetStorageUsage", func(t *testing.T) { testFileInfoGetStorageUsage(t, ss) })
 	t.Run("GetUptoNSizeFileTime", func(t *testing.T) { testGetUptoNSizeFileTime(t, ss, s) })
+	t.Run("ColdStorage", func(t *testing.T) { testFileInfoColdStorage(t, ss, s) })
 }
 
 func testFileInfoSaveGet(t *testing.T, ss store.Store) {
@@ -921,3 +922,59 @@ func testGetUptoNSizeFileTime(t *testing.T, ss store.Store, s SqlStore) {
 	require.NoError(t, err)
 	assert.Equal(t, f2.CreateAt, createAt)
 }
+
+func testFileInfoColdStorage(t *testing.T, ss store.Store, s SqlStore) {
+	_, err := s.GetMasterX().Exec("TRUNCATE FileInfo")
+	require.NoError(t, err)
+
+	creatorID := model.NewId()
+	save := func(path string, createAt, deleteAt int64) *model.FileInfo {
+		info, err := ss.FileInfo().Save(&model.FileInfo{
+			CreatorId: creatorID,
+			Path:      path,
+			CreateAt:  createAt,
+			DeleteAt:  deleteAt,
+		})
+		require.NoError(t, err)
+		return info
+	}
+
+	old1 := save("old1.txt", 1000, 0)
+	old2 := save("old2.txt", 2000, 0)
+	copyOfOld2 := save("old2.txt", 2000, 0)
+	save("deleted.txt", 2000, 3000)
+	save("recent.txt", 10000, 0)
+
+	count, err := ss.FileInfo().CountForColdStorage(5000)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	infos, err := ss.FileInfo().GetForColdStorage(5000, 0, "", 10)
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+	require.Equal(t, old1.Id, infos[0].Id)
+
+	infos, err = ss.FileInfo().GetForColdStorage(5000, old1.CreateAt, old1.Id, 1)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, int64(2000), infos[0].CreateAt)
+
+	err = ss.FileInfo().SetColdStorageForPath("old2.txt")
+	require.NoError(t, err)
+
+	for _, id := range []string{old2.Id, copyOfOld2.Id} {
+		info, err := ss.FileInfo().Get(id)
+		require.NoError(t, err)
+		require.True(t, info.ColdStorage)
+	}
+
+	infos, err = ss.FileInfo().GetForColdStorage(5000, 0, "", 10)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, old1.Id, infos[0].Id)
+	require.False(t, infos[0].ColdStorage)
+
+	count, err = ss.FileInfo().CountForColdStorage(5000)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestGuestSponsorshipStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testGuestSponsorshipSaveAndGet(t, ss) })
+	t.Run("Update", func(t *testing.T) { testGuestSponsorshipUpdate(t, ss) })
+	t.Run("GetForSponsor", func(t *testing.T) { testGuestSponsorshipGetForSponsor(t, ss) })
+	t.Run("GetExpired", func(t *testing.T) { testGuestSponsorshipGetExpired(t, ss) })
+	t.Run("GetSponsorReport", func(t *testing.T) { testGuestSponsorshipGetSponsorReport(t, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testGuestSponsorshipPermanentDeleteByUser(t, ss) })
+}
+
+func saveSponsoredGuest(t *testing.T, ss store.Store, sponsorID string, expiresAt int64, deleteAt int64) *model.GuestSponsorship {
+	t.Helper()
+
+	user, err := ss.User().Save(&model.User{
+		Email:    MakeEmail(),
+		Username: model.NewId(),
+		Roles:    model.SystemGuestRoleId,
+		DeleteAt: deleteAt,
+	})
+	require.NoError(t, err)
+
+	sponsorship, err := ss.GuestSponsorship().Save(&model.GuestSponsorship{
+		UserId:    user.Id,
+		SponsorId: sponsorID,
+		ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+
+	return sponsorship
+}
+
+func testGuestSponsorshipSaveAndGet(t *testing.T, ss store.Store) {
+	_, err := ss.GuestSponsorship().Save(&model.GuestSponsorship{UserId: "invalid", SponsorId: model.NewId()})
+	require.Error(t, err)
+
+	sponsorship, err := ss.GuestSponsorship().Save(&model.GuestSponsorship{
+		UserId:    model.NewId(),
+		SponsorId: model.NewId(),
+		ExpiresAt: model.GetMillis(),
+	})
+	require.NoError(t, err)
+
+	_, err = ss.GuestSponsorship().Save(&model.GuestSponsorship{UserId: sponsorship.UserId, SponsorId: model.NewId()})
+	require.Error(t, err)
+
+	received, err := ss.GuestSponsorship().Get(sponsorship.UserId)
+	require.NoError(t, err)
+	assert.Equal(t, sponsorship, received)
+
+	_, err = ss.GuestSponsorship().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testGuestSponsorshipUpdate(t *testing.T, ss store.Store) {
+	sponsorship, err := ss.GuestSponsorship().Save(&model.GuestSponsorship{
+		UserId:    model.NewId(),
+		SponsorId: model.NewId(),
+		CreateAt:  model.GetMillis() - 1000,
+	})
+	require.NoError(t, err)
+
+	sponsorship.SponsorId = model.NewId()
+	sponsorship.ExpiresAt = model.GetMillis()
+	updated, err := ss.GuestSponsorship().Update(sponsorship)
+	require.NoError(t, err)
+	assert.Greater(t, updated.UpdateAt, updated.CreateAt)
+
+	received, err := ss.GuestSponsorship().Get(sponsorship.UserId)
+	require.NoError(t, err)
+	assert.Equal(t, updated, received)
+
+	_, err = ss.GuestSponsorship().Update(&model.GuestSponsorship{UserId: model.NewId(), SponsorId: model.NewId(), CreateAt: 1})
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testGuestSponsorshipGetForSponsor(t *testing.T, ss store.Store) {
+	sponsorID := model.NewId()
+	never := saveSponsoredGuest(t, ss, sponsorID, 0, 0)
+	later := saveSponsoredGuest(t, ss, sponsorID, 2000, 0)
+	sooner := saveSponsoredGuest(t, ss, sponsorID, 1000, 0)
+	saveSponsoredGuest(t, ss, model.NewId(), 1000, 0)
+
+	sponsorships, err := ss.GuestSponsorship().GetForSponsor(sponsorID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, sponsorships, 3)
+	assert.Equal(t, sooner.UserId, sponsorships[0].UserId)
+	assert.Equal(t, later.UserId, sponsorships[1].UserId)
+	assert.Equal(t, never.UserId, sponsorships[2].UserId)
+
+	sponsorships, err = ss.GuestSponsorship().GetForSponsor(sponsorID, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, sponsorships, 1)
+	assert.Equal(t, later.UserId, sponsorships[0].UserId)
+}
+
+func testGuestSponsorshipGetExpired(t *testing.T, ss store.Store) {
+	now := model.GetMillis()
+	sponsorID := model.NewId()
+	expired := saveSponsoredGuest(t, ss, sponsorID, now-1000, 0)
+	deactivated := saveSponsoredGuest(t, ss, sponsorID, now-1000, now-500)
+	notYet := saveSponsoredGuest(t, ss, sponsorID, now+60000, 0)
+	never := saveSponsoredGuest(t, ss, sponsorID, 0, 0)
+
+	sponsorships, err := ss.GuestSponsorship().GetExpired(now, 1000)
+	require.NoError(t, err)
+
+	userIDs := make(map[string]bool, len(sponsorships))
+	for _, sponsorship := range sponsorships {
+		userIDs[sponsorship.UserId] = true
+	}
+	assert.True(t, userIDs[expired.UserId])
+	assert.False(t, userIDs[deactivated.UserId])
+	assert.False(t, userIDs[notYet.UserId])
+	assert.False(t, userIDs[never.UserId])
+}
+
+func testGuestSponsorshipGetSponsorReport(t *testing.T, ss store.Store) {
+	sponsorID := model.NewId()
+	saveSponsoredGuest(t, ss, sponsorID, 3000, 0)
+	saveSponsoredGuest(t, ss, sponsorID, 2000, 0)
+	saveSponsoredGuest(t, ss, sponsorID, 0, 0)
+	saveSponsoredGuest(t, ss, sponsorID, 1000, model.GetMillis())
+
+	report, err := ss.GuestSponsorship().GetSponsorReport(0, 1000)
+	require.NoError(t, err)
+
+	var found *model.GuestSponsorReport
+	for _, row := range report {
+		if row.SponsorId == sponsorID {
+			found = row
+		}
+	}
+	require.NotNil(t, found)
+	assert.Equal(t, int64(3), found.GuestCount)
+	assert.Equal(t, int64(2000), found.NextExpiresAt)
+	assert.Equal(t, int64(1), found.NonExpiringGuests)
+}
+
+func testGuestSponsorshipPermanentDeleteByUser(t *testing.T, ss store.Store) {
+	sponsorship, err := ss.GuestSponsorship().Save(&model.GuestSponsorship{UserId: model.NewId(), SponsorId: model.NewId()})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.GuestSponsorship().PermanentDeleteByUser(sponsorship.UserId))
+
+	_, err = ss.GuestSponsorship().Get(sponsorship.UserId)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
@@ -31,6 +31,10 @@ func TestJobStore(t *testing.T, ss store.Store) {
 	t.Run("JobUpdateStatusUpdateStatusOptimistically", func(t *testing.T) { testJobUpdateStatusUpdateStatusOptimistically(t, ss) })
 	t.Run("JobDelete", func(t *testing.T) { testJobDelete(t, ss) })
 	t.Run("JobCleanup", func(t *testing.T) { testJobCleanup(t, ss) })
+	t.Run("JobGetAllByTypeAndStatusPage", func(t *testing.T) { testJobGetAllByTypeAndStatusPage(t, ss) })
+	t.Run("JobGetAllByStatusPriority", func(t *testing.T) { testJobGetAllByStatusPriority(t, ss) })
+	t.Run("JobGetStatsByType", func(t *testing.T) { testJobGetStatsByType(t, ss) })
+	t.Run("JobTypeSettings", func(t *testing.T) { testJobTypeSettings(t, ss) })
 }
 
 func testJobSaveGet(t *testing.T, ss store.Store) {
@@ -622,3 +626,203 @@ func testJobCleanup(t *testing.T, ss store.Store) {
 	require.NoError(t, err)
 	assert.Len(t, jobs, 0)
 }
+
+func testJobGetAllByTypeAndStatusPage(t *testing.T, ss store.Store) {
+	jobType := model.NewId()
+
+	jobs := []*model.Job{
+		{
+			Id:       model.NewId(),
+			Type:     jobType,
+			CreateAt: 1000,
+			Status:   model.JobStatusError,
+		},
+		{
+			Id:       model.NewId(),
+			Type:     jobType,
+			CreateAt: 1001,
+			Status:   model.JobStatusError,
+		},
+		{
+			Id:       model.NewId(),
+			Type:     jobType,
+			CreateAt: 1002,
+			Status:   model.JobStatusSuccess,
+		},
+		{
+			Id:       model.NewId(),
+			Type:     model.NewId(),
+			CreateAt: 1003,
+			Status:   model.JobStatusError,
+		},
+	}
+
+	for _, job := range jobs {
+		_, err := ss.Job().Save(job)
+		require.NoError(t, err)
+		defer ss.Job().Delete(job.Id)
+	}
+
+	received, err := ss.Job().GetAllByTypeAndStatusPage(jobType, model.JobStatusError, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, received, 2)
+	require.Equal(t, jobs[1].Id, received[0].Id)
+	require.Equal(t, jobs[0].Id, received[1].Id)
+
+	received, err = ss.Job().GetAllByTypeAndStatusPage(jobType, model.JobStatusError, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+	require.Equal(t, jobs[0].Id, received[0].Id)
+}
+
+func testJobGetAllByStatusPriority(t *testing.T, ss store.Store) {
+	status := model.NewId()
+
+	jobs := []*model.Job{
+		{
+			Id:       model.NewId(),
+			Type:     model.NewId(),
+			CreateAt: 1000,
+			Status:   status,
+		},
+		{
+			Id:       model.NewId(),
+			Type:     model.NewId(),
+			CreateAt: 1001,
+			Priority: 10,
+			Status:   status,
+		},
+		{
+			Id:       model.NewId(),
+			Type:     model.NewId(),
+			CreateAt: 999,
+			Priority: -10,
+			Status:   status,
+		},
+	}
+
+	for _, job := range jobs {
+		_, err := ss.Job().Save(job)
+		require.NoError(t, err)
+		defer ss.Job().Delete(job.Id)
+	}
+
+	received, err := ss.Job().GetAllByStatus(status)
+	require.NoError(t, err)
+	require.Len(t, received, 3)
+	require.Equal(t, jobs[1].Id, received[0].Id)
+	require.Equal(t, jobs[0].Id, received[1].Id)
+	require.Equal(t, jobs[2].Id, received[2].Id)
+}
+
+func testJobGetStatsByType(t *testing.T, ss store.Store) {
+	jobType := model.NewId()
+	now := model.GetMillis()
+
+	jobs := []*model.Job{
+		{
+			Id:             model.NewId(),
+			Type:           jobType,
+			CreateAt:       now,
+			StartAt:        now + 100,
+			LastActivityAt: now + 200,
+			Status:         model.JobStatusSuccess,
+		},
+		{
+			Id:             model.NewId(),
+			Type:           jobType,
+			CreateAt:       now,
+			StartAt:        now + 100,
+			LastActivityAt: now + 400,
+			Status:         model.JobStatusError,
+		},
+		{
+			Id:             model.NewId(),
+			Type:           jobType,
+			CreateAt:       now,
+			StartAt:        now + 500,
+			LastActivityAt: now + 5000,
+			Status:         model.JobStatusInProgress,
+		},
+		{
+			Id:       model.NewId(),
+			Type:     jobType,
+			CreateAt: now,
+			Status:   model.JobStatusPending,
+		},
+		{
+			Id:             model.NewId(),
+			Type:           jobType,
+			CreateAt:       now - 10000,
+			StartAt:        now - 9000,
+			LastActivityAt: now,
+			Status:         model.JobStatusSuccess,
+		},
+	}
+
+	for _, job := range jobs {
+		_, err := ss.Job().Save(job)
+		require.NoError(t, err)
+		defer ss.Job().Delete(job.Id)
+	}
+
+	statsList, err := ss.Job().GetStatsByType(now)
+	require.NoError(t, err)
+
+	var stats *model.JobTypeStats
+	for _, s := range statsList {
+		if s.JobType == jobType {
+			stats = s
+		}
+	}
+	require.NotNil(t, stats)
+
+	assert.Equal(t, map[string]int64{
+		model.JobStatusSuccess:    1,
+		model.JobStatusError:      1,
+		model.JobStatusInProgress: 1,
+		model.JobStatusPending:    1,
+	}, stats.StatusCounts)
+	assert.Equal(t, int64(200), stats.AverageDuration)
+	assert.Equal(t, int64(300), stats.MaxDuration)
+	assert.Equal(t, now+500, stats.LastStartAt)
+}
+
+func testJobTypeSettings(t *testing.T, ss store.Store) {
+	jobType := model.NewId()[:20]
+
+	_, err := ss.Job().SaveTypeSettings(&model.JobTypeSettings{JobType: jobType, Schedule: "invalid"})
+	var appErr *model.AppError
+	require.True(t, errors.As(err, &appErr))
+	assert.Equal(t, "model.job_type_settings.is_valid.schedule.app_error", appErr.Id)
+
+	saved, err := ss.Job().SaveTypeSettings(&model.JobTypeSettings{
+		JobType:        jobType,
+		Priority:       5,
+		MaxConcurrency: 2,
+		Schedule:       "@daily",
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, saved.UpdateAt)
+
+	saved.Paused = true
+	saved.Schedule = ""
+	_, err = ss.Job().SaveTypeSettings(saved)
+	require.NoError(t, err)
+
+	list, err := ss.Job().GetTypeSettings()
+	require.NoError(t, err)
+
+	var received *model.JobTypeSettings
+	for _, settings := range list {
+		if settings.JobType == jobType {
+			require.Nil(t, received, "the settings of a job type should be saved once")
+			received = settings
+		}
+	}
+	require.NotNil(t, received)
+	assert.Equal(t, int64(5), received.Priority)
+	assert.Equal(t, 2, received.MaxConcurrency)
+	assert.True(t, received.Paused)
+	assert.Empty(t, received.Schedule)
+}
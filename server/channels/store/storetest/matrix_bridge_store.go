@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestMatrixBridgeStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("Rooms", func(t *testing.T) { testMatrixBridgeRooms(t, ss) })
+	t.Run("Events", func(t *testing.T) { testMatrixBridgeEvents(t, ss) })
+	t.Run("DeleteRoom", func(t *testing.T) { testMatrixBridgeDeleteRoom(t, ss) })
+}
+
+func testMatrixBridgeRooms(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	roomID := "!" + model.NewId() + ":example.com"
+
+	_, err := ss.MatrixBridge().SaveRoom(&model.MatrixRoom{ChannelId: channelID, RoomId: "#alias:example.com", CreatorId: model.NewId()})
+	require.Error(t, err)
+
+	saved, err := ss.MatrixBridge().SaveRoom(&model.MatrixRoom{ChannelId: channelID, RoomId: roomID, CreatorId: model.NewId()})
+	require.NoError(t, err)
+	assert.NotZero(t, saved.CreateAt)
+
+	var cErr *store.ErrConflict
+	_, err = ss.MatrixBridge().SaveRoom(&model.MatrixRoom{ChannelId: channelID, RoomId: "!" + model.NewId() + ":example.com", CreatorId: model.NewId()})
+	require.True(t, errors.As(err, &cErr), "a channel can only be bridged to one room")
+	_, err = ss.MatrixBridge().SaveRoom(&model.MatrixRoom{ChannelId: model.NewId(), RoomId: roomID, CreatorId: model.NewId()})
+	require.True(t, errors.As(err, &cErr), "a room can only be bridged to one channel")
+
+	room, err := ss.MatrixBridge().GetRoomForChannel(channelID)
+	require.NoError(t, err)
+	assert.Equal(t, saved, room)
+
+	room, err = ss.MatrixBridge().GetRoom(roomID)
+	require.NoError(t, err)
+	assert.Equal(t, saved, room)
+
+	rooms, err := ss.MatrixBridge().GetAllRooms()
+	require.NoError(t, err)
+	assert.Contains(t, rooms, saved)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.MatrixBridge().GetRoomForChannel(model.NewId())
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testMatrixBridgeEvents(t *testing.T, ss store.Store) {
+	postID := model.NewId()
+	eventID := "$" + model.NewId()
+
+	_, err := ss.MatrixBridge().SaveEvent(&model.MatrixEvent{PostId: postID, RoomId: "!room:example.com"})
+	require.Error(t, err)
+
+	saved, err := ss.MatrixBridge().SaveEvent(&model.MatrixEvent{PostId: postID, EventId: eventID, RoomId: "!room:example.com"})
+	require.NoError(t, err)
+
+	var cErr *store.ErrConflict
+	_, err = ss.MatrixBridge().SaveEvent(&model.MatrixEvent{PostId: model.NewId(), EventId: eventID, RoomId: "!room:example.com"})
+	require.True(t, errors.As(err, &cErr))
+
+	event, err := ss.MatrixBridge().GetEventForPost(postID)
+	require.NoError(t, err)
+	assert.Equal(t, saved, event)
+
+	event, err = ss.MatrixBridge().GetEvent(eventID)
+	require.NoError(t, err)
+	assert.Equal(t, saved, event)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.MatrixBridge().GetEvent("$" + model.NewId())
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testMatrixBridgeDeleteRoom(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	roomID := "!" + model.NewId() + ":example.com"
+	postID := model.NewId()
+
+	_, err := ss.MatrixBridge().SaveRoom(&model.MatrixRoom{ChannelId: channelID, RoomId: roomID, CreatorId: model.NewId()})
+	require.NoError(t, err)
+	_, err = ss.MatrixBridge().SaveEvent(&model.MatrixEvent{PostId: postID, EventId: "$" + model.NewId(), RoomId: roomID})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.MatrixBridge().DeleteRoom(channelID))
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.MatrixBridge().GetRoomForChannel(channelID)
+	require.True(t, errors.As(err, &nfErr))
+	_, err = ss.MatrixBridge().GetEventForPost(postID)
+	require.True(t, errors.As(err, &nfErr), "the events of the room are deleted with it")
+
+	err = ss.MatrixBridge().DeleteRoom(channelID)
+	require.True(t, errors.As(err, &nfErr))
+}
@@ -62,13 +62,13 @@ func (_m *ChannelStore) AnalyticsTypeCount(teamID string, channelType model.Chan
 	return r0, r1
 }
 
-// Autocomplete provides a mock function with given fields: userID, term, includeDeleted, isGuest
-func (_m *ChannelStore) Autocomplete(userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelListWithTeamData, error) {
-	ret := _m.Called(userID, term, includeDeleted, isGuest)
+// Autocomplete provides a mock function with given fields: ctx, userID, term, includeDeleted, isGuest
+func (_m *ChannelStore) Autocomplete(ctx context.Context, userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelListWithTeamData, error) {
+	ret := _m.Called(ctx, userID, term, includeDeleted, isGuest)
 
 	var r0 model.ChannelListWithTeamData
-	if rf, ok := ret.Get(0).(func(string, string, bool, bool) model.ChannelListWithTeamData); ok {
-		r0 = rf(userID, term, includeDeleted, isGuest)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, bool) model.ChannelListWithTeamData); ok {
+		r0 = rf(ctx, userID, term, includeDeleted, isGuest)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.ChannelListWithTeamData)
@@ -76,8 +76,8 @@ func (_m *ChannelStore) Autocomplete(userID string, term string, includeDeleted
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, bool, bool) error); ok {
-		r1 = rf(userID, term, includeDeleted, isGuest)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool, bool) error); ok {
+		r1 = rf(ctx, userID, term, includeDeleted, isGuest)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -85,13 +85,13 @@ func (_m *ChannelStore) Autocomplete(userID string, term string, includeDeleted
 	return r0, r1
 }
 
-// AutocompleteInTeam provides a mock function with given fields: teamID, userID, term, includeDeleted, isGuest
-func (_m *ChannelStore) AutocompleteInTeam(teamID string, userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelList, error) {
-	ret := _m.Called(teamID, userID, term, includeDeleted, isGuest)
+// AutocompleteInTeam provides a mock function with given fields: ctx, teamID, userID, term, includeDeleted, isGuest
+func (_m *ChannelStore) AutocompleteInTeam(ctx context.Context, teamID string, userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelList, error) {
+	ret := _m.Called(ctx, teamID, userID, term, includeDeleted, isGuest)
 
 	var r0 model.ChannelList
-	if rf, ok := ret.Get(0).(func(string, string, string, bool, bool) model.ChannelList); ok {
-		r0 = rf(teamID, userID, term, includeDeleted, isGuest)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool, bool) model.ChannelList); ok {
+		r0 = rf(ctx, teamID, userID, term, includeDeleted, isGuest)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.ChannelList)
@@ -99,8 +99,8 @@ func (_m *ChannelStore) AutocompleteInTeam(teamID string, userID string, term st
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, bool, bool) error); ok {
-		r1 = rf(teamID, userID, term, includeDeleted, isGuest)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool, bool) error); ok {
+		r1 = rf(ctx, teamID, userID, term, includeDeleted, isGuest)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -108,13 +108,13 @@ func (_m *ChannelStore) AutocompleteInTeam(teamID string, userID string, term st
 	return r0, r1
 }
 
-// AutocompleteInTeamForSearch provides a mock function with given fields: teamID, userID, term, includeDeleted
-func (_m *ChannelStore) AutocompleteInTeamForSearch(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
-	ret := _m.Called(teamID, userID, term, includeDeleted)
+// AutocompleteInTeamForSearch provides a mock function with given fields: ctx, teamID, userID, term, includeDeleted
+func (_m *ChannelStore) AutocompleteInTeamForSearch(ctx context.Context, teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
+	ret := _m.Called(ctx, teamID, userID, term, includeDeleted)
 
 	var r0 model.ChannelList
-	if rf, ok := ret.Get(0).(func(string, string, string, bool) model.ChannelList); ok {
-		r0 = rf(teamID, userID, term, includeDeleted)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) model.ChannelList); ok {
+		r0 = rf(ctx, teamID, userID, term, includeDeleted)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.ChannelList)
@@ -122,8 +122,8 @@ func (_m *ChannelStore) AutocompleteInTeamForSearch(teamID string, userID string
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, bool) error); ok {
-		r1 = rf(teamID, userID, term, includeDeleted)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool) error); ok {
+		r1 = rf(ctx, teamID, userID, term, includeDeleted)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -679,6 +679,29 @@ func (_m *ChannelStore) GetChannelMembersTimezones(channelID string) ([]model.St
 	return r0, r1
 }
 
+// GetChannelPinnedPosts provides a mock function with given fields: channelID
+func (_m *ChannelStore) GetChannelPinnedPosts(channelID string) (*model.ChannelPinnedPosts, error) {
+	ret := _m.Called(channelID)
+
+	var r0 *model.ChannelPinnedPosts
+	if rf, ok := ret.Get(0).(func(string) *model.ChannelPinnedPosts); ok {
+		r0 = rf(channelID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ChannelPinnedPosts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(channelID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetChannelUnread provides a mock function with given fields: channelID, userID
 func (_m *ChannelStore) GetChannelUnread(channelID string, userID string) (*model.ChannelUnread, error) {
 	ret := _m.Called(channelID, userID)
@@ -863,6 +886,29 @@ func (_m *ChannelStore) GetChannelsWithTeamDataByIds(channelIds []string, includ
 	return r0, r1
 }
 
+// GetCounters provides a mock function with given fields: channelID
+func (_m *ChannelStore) GetCounters(channelID string) (*model.ChannelCounters, error) {
+	ret := _m.Called(channelID)
+
+	var r0 *model.ChannelCounters
+	if rf, ok := ret.Get(0).(func(string) *model.ChannelCounters); ok {
+		r0 = rf(channelID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ChannelCounters)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(channelID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDeleted provides a mock function with given fields: team_id, offset, limit, userID
 func (_m *ChannelStore) GetDeleted(team_id string, offset int, limit int, userID string) (model.ChannelList, error) {
 	ret := _m.Called(team_id, offset, limit, userID)
@@ -974,6 +1020,29 @@ func (_m *ChannelStore) GetGuestCount(channelID string, allowFromCache bool) (in
 	return r0, r1
 }
 
+// GetInboxChannelsForUser provides a mock function with given fields: userID, options
+func (_m *ChannelStore) GetInboxChannelsForUser(userID string, options model.GetInboxOptions) ([]*model.InboxItem, error) {
+	ret := _m.Called(userID, options)
+
+	var r0 []*model.InboxItem
+	if rf, ok := ret.Get(0).(func(string, model.GetInboxOptions) []*model.InboxItem); ok {
+		r0 = rf(userID, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.InboxItem)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, model.GetInboxOptions) error); ok {
+		r1 = rf(userID, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMany provides a mock function with given fields: ids, allowFromCache
 func (_m *ChannelStore) GetMany(ids []string, allowFromCache bool) (model.ChannelList, error) {
 	ret := _m.Called(ids, allowFromCache)
@@ -1837,6 +1906,27 @@ func (_m *ChannelStore) PostCountsByDuration(channelIDs []string, sinceUnixMilli
 	return r0, r1
 }
 
+// ReconcileCounters provides a mock function with given fields: afterChannelID, limit
+func (_m *ChannelStore) ReconcileCounters(afterChannelID string, limit int) (string, error) {
+	ret := _m.Called(afterChannelID, limit)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, int) string); ok {
+		r0 = rf(afterChannelID, limit)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(afterChannelID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveAllDeactivatedMembers provides a mock function with given fields: channelID
 func (_m *ChannelStore) RemoveAllDeactivatedMembers(channelID string) error {
 	ret := _m.Called(channelID)
@@ -1930,6 +2020,29 @@ func (_m *ChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64) (
 	return r0, r1
 }
 
+// SaveChannelPinnedPosts provides a mock function with given fields: pinned
+func (_m *ChannelStore) SaveChannelPinnedPosts(pinned *model.ChannelPinnedPosts) (*model.ChannelPinnedPosts, error) {
+	ret := _m.Called(pinned)
+
+	var r0 *model.ChannelPinnedPosts
+	if rf, ok := ret.Get(0).(func(*model.ChannelPinnedPosts) *model.ChannelPinnedPosts); ok {
+		r0 = rf(pinned)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ChannelPinnedPosts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.ChannelPinnedPosts) error); ok {
+		r1 = rf(pinned)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveDirectChannel provides a mock function with given fields: channel, member1, member2
 func (_m *ChannelStore) SaveDirectChannel(channel *model.Channel, member1 *model.ChannelMember, member2 *model.ChannelMember) (*model.Channel, error) {
 	ret := _m.Called(channel, member1, member2)
@@ -1999,13 +2112,13 @@ func (_m *ChannelStore) SaveMultipleMembers(members []*model.ChannelMember) ([]*
 	return r0, r1
 }
 
-// SearchAllChannels provides a mock function with given fields: term, opts
-func (_m *ChannelStore) SearchAllChannels(term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
-	ret := _m.Called(term, opts)
+// SearchAllChannels provides a mock function with given fields: ctx, term, opts
+func (_m *ChannelStore) SearchAllChannels(ctx context.Context, term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
+	ret := _m.Called(ctx, term, opts)
 
 	var r0 model.ChannelListWithTeamData
-	if rf, ok := ret.Get(0).(func(string, store.ChannelSearchOpts) model.ChannelListWithTeamData); ok {
-		r0 = rf(term, opts)
+	if rf, ok := ret.Get(0).(func(context.Context, string, store.ChannelSearchOpts) model.ChannelListWithTeamData); ok {
+		r0 = rf(ctx, term, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.ChannelListWithTeamData)
@@ -2013,15 +2126,15 @@ func (_m *ChannelStore) SearchAllChannels(term string, opts store.ChannelSearchO
 	}
 
 	var r1 int64
-	if rf, ok := ret.Get(1).(func(string, store.ChannelSearchOpts) int64); ok {
-		r1 = rf(term, opts)
+	if rf, ok := ret.Get(1).(func(context.Context, string, store.ChannelSearchOpts) int64); ok {
+		r1 = rf(ctx, term, opts)
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func(string, store.ChannelSearchOpts) error); ok {
-		r2 = rf(term, opts)
+	if rf, ok := ret.Get(2).(func(context.Context, string, store.ChannelSearchOpts) error); ok {
+		r2 = rf(ctx, term, opts)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -2029,13 +2142,13 @@ func (_m *ChannelStore) SearchAllChannels(term string, opts store.ChannelSearchO
 	return r0, r1, r2
 }
 
-// SearchArchivedInTeam provides a mock function with given fields: teamID, term, userID
-func (_m *ChannelStore) SearchArchivedInTeam(teamID string, term string, userID string) (model.ChannelList, error) {
-	ret := _m.Called(teamID, term, userID)
+// SearchArchivedInTeam provides a mock function with given fields: ctx, teamID, term, userID
+func (_m *ChannelStore) SearchArchivedInTeam(ctx context.Context, teamID string, term string, userID string) (model.ChannelList, error) {
+	ret := _m.Called(ctx, teamID, term, userID)
 
 	var r0 model.ChannelList
-	if rf, ok := ret.Get(0).(func(string, string, string) model.ChannelList); ok {
-		r0 = rf(teamID, term, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) model.ChannelList); ok {
+		r0 = rf(ctx, teamID, term, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.ChannelList)
@@ -2043,8 +2156,8 @@ func (_m *ChannelStore) SearchArchivedInTeam(teamID string, term string, userID
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
-		r1 = rf(teamID, term, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, teamID, term, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2052,13 +2165,13 @@ func (_m *ChannelStore) SearchArchivedInTeam(teamID string, term string, userID
 	return r0, r1
 }
 
-// SearchForUserInTeam provides a mock function with given fields: userID, teamID, term, includeDeleted
-func (_m *ChannelStore) SearchForUserInTeam(userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
-	ret := _m.Called(userID, teamID, term, includeDeleted)
+// SearchForUserInTeam provides a mock function with given fields: ctx, userID, teamID, term, includeDeleted
+func (_m *ChannelStore) SearchForUserInTeam(ctx context.Context, userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+	ret := _m.Called(ctx, userID, teamID, term, includeDeleted)
 
 	var r0 model.ChannelList
-	if rf, ok := ret.Get(0).(func(string, string, string, bool) model.ChannelList); ok {
-		r0 = rf(userID, teamID, term, includeDeleted)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) model.ChannelList); ok {
+		r0 = rf(ctx, userID, teamID, term, includeDeleted)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.ChannelList)
@@ -2066,8 +2179,8 @@ func (_m *ChannelStore) SearchForUserInTeam(userID string, teamID string, term s
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, bool) error); ok {
-		r1 = rf(userID, teamID, term, includeDeleted)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool) error); ok {
+		r1 = rf(ctx, userID, teamID, term, includeDeleted)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2075,13 +2188,13 @@ func (_m *ChannelStore) SearchForUserInTeam(userID string, teamID string, term s
 	return r0, r1
 }
 
-// SearchGroupChannels provides a mock function with given fields: userID, term
-func (_m *ChannelStore) SearchGroupChannels(userID string, term string) (model.ChannelList, error) {
-	ret := _m.Called(userID, term)
+// SearchGroupChannels provides a mock function with given fields: ctx, userID, term
+func (_m *ChannelStore) SearchGroupChannels(ctx context.Context, userID string, term string) (model.ChannelList, error) {
+	ret := _m.Called(ctx, userID, term)
 
 	var r0 model.ChannelList
-	if rf, ok := ret.Get(0).(func(string, string) model.ChannelList); ok {
-		r0 = rf(userID, term)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) model.ChannelList); ok {
+		r0 = rf(ctx, userID, term)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.ChannelList)
@@ -2089,8 +2202,8 @@ func (_m *ChannelStore) SearchGroupChannels(userID string, term string) (model.C
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(userID, term)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, term)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2098,13 +2211,13 @@ func (_m *ChannelStore) SearchGroupChannels(userID string, term string) (model.C
 	return r0, r1
 }
 
-// SearchInTeam provides a mock function with given fields: teamID, term, includeDeleted
-func (_m *ChannelStore) SearchInTeam(teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
-	ret := _m.Called(teamID, term, includeDeleted)
+// SearchInTeam provides a mock function with given fields: ctx, teamID, term, includeDeleted
+func (_m *ChannelStore) SearchInTeam(ctx context.Context, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+	ret := _m.Called(ctx, teamID, term, includeDeleted)
 
 	var r0 model.ChannelList
-	if rf, ok := ret.Get(0).(func(string, string, bool) model.ChannelList); ok {
-		r0 = rf(teamID, term, includeDeleted)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) model.ChannelList); ok {
+		r0 = rf(ctx, teamID, term, includeDeleted)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.ChannelList)
@@ -2112,8 +2225,8 @@ func (_m *ChannelStore) SearchInTeam(teamID string, term string, includeDeleted
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, bool) error); ok {
-		r1 = rf(teamID, term, includeDeleted)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = rf(ctx, teamID, term, includeDeleted)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,6 +72,29 @@ func (_m *CommandStore) Get(id string) (*model.Command, error) {
 	return r0, r1
 }
 
+// GetByCreator provides a mock function with given fields: creatorID
+func (_m *CommandStore) GetByCreator(creatorID string) ([]*model.Command, error) {
+	ret := _m.Called(creatorID)
+
+	var r0 []*model.Command
+	if rf, ok := ret.Get(0).(func(string) []*model.Command); ok {
+		r0 = rf(creatorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Command)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(creatorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByTeam provides a mock function with given fields: teamID
 func (_m *CommandStore) GetByTeam(teamID string) ([]*model.Command, error) {
 	ret := _m.Called(teamID)
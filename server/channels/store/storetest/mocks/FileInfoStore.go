@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	context "context"
+
 	model "github.com/mattermost/mattermost-server/v6/model"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -54,6 +56,27 @@ func (_m *FileInfoStore) CountAll() (int64, error) {
 	return r0, r1
 }
 
+// CountForColdStorage provides a mock function with given fields: before
+func (_m *FileInfoStore) CountForColdStorage(before int64) (int64, error) {
+	ret := _m.Called(before)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteForPost provides a mock function with given fields: postID
 func (_m *FileInfoStore) DeleteForPost(postID string) (string, error) {
 	ret := _m.Called(postID)
@@ -167,6 +190,29 @@ func (_m *FileInfoStore) GetFilesBatchForIndexing(startTime int64, startFileID s
 	return r0, r1
 }
 
+// GetForColdStorage provides a mock function with given fields: before, afterCreateAt, afterID, limit
+func (_m *FileInfoStore) GetForColdStorage(before int64, afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error) {
+	ret := _m.Called(before, afterCreateAt, afterID, limit)
+
+	var r0 []*model.FileInfo
+	if rf, ok := ret.Get(0).(func(int64, int64, string, int) []*model.FileInfo); ok {
+		r0 = rf(before, afterCreateAt, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FileInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, string, int) error); ok {
+		r1 = rf(before, afterCreateAt, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetForPost provides a mock function with given fields: postID, readFromMaster, includeDeleted, allowFromCache
 func (_m *FileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
 	ret := _m.Called(postID, readFromMaster, includeDeleted, allowFromCache)
@@ -385,13 +431,13 @@ func (_m *FileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
 	return r0, r1
 }
 
-// Search provides a mock function with given fields: paramsList, userID, teamID, page, perPage
-func (_m *FileInfoStore) Search(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
-	ret := _m.Called(paramsList, userID, teamID, page, perPage)
+// Search provides a mock function with given fields: ctx, paramsList, userID, teamID, page, perPage
+func (_m *FileInfoStore) Search(ctx context.Context, paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
+	ret := _m.Called(ctx, paramsList, userID, teamID, page, perPage)
 
 	var r0 *model.FileInfoList
-	if rf, ok := ret.Get(0).(func([]*model.SearchParams, string, string, int, int) *model.FileInfoList); ok {
-		r0 = rf(paramsList, userID, teamID, page, perPage)
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.SearchParams, string, string, int, int) *model.FileInfoList); ok {
+		r0 = rf(ctx, paramsList, userID, teamID, page, perPage)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.FileInfoList)
@@ -399,8 +445,8 @@ func (_m *FileInfoStore) Search(paramsList []*model.SearchParams, userID string,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*model.SearchParams, string, string, int, int) error); ok {
-		r1 = rf(paramsList, userID, teamID, page, perPage)
+	if rf, ok := ret.Get(1).(func(context.Context, []*model.SearchParams, string, string, int, int) error); ok {
+		r1 = rf(ctx, paramsList, userID, teamID, page, perPage)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -408,6 +454,20 @@ func (_m *FileInfoStore) Search(paramsList []*model.SearchParams, userID string,
 	return r0, r1
 }
 
+// SetColdStorageForPath provides a mock function with given fields: path
+func (_m *FileInfoStore) SetColdStorageForPath(path string) error {
+	ret := _m.Called(path)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetContent provides a mock function with given fields: fileID, content
 func (_m *FileInfoStore) SetContent(fileID string, content string) error {
 	ret := _m.Called(fileID, content)
@@ -141,6 +141,29 @@ func (_m *JobStore) GetAllByTypeAndStatus(jobType string, status string) ([]*mod
 	return r0, r1
 }
 
+// GetAllByTypeAndStatusPage provides a mock function with given fields: jobType, status, offset, limit
+func (_m *JobStore) GetAllByTypeAndStatusPage(jobType string, status string, offset int, limit int) ([]*model.Job, error) {
+	ret := _m.Called(jobType, status, offset, limit)
+
+	var r0 []*model.Job
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []*model.Job); ok {
+		r0 = rf(jobType, status, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Job)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, int, int) error); ok {
+		r1 = rf(jobType, status, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllByTypePage provides a mock function with given fields: jobType, offset, limit
 func (_m *JobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error) {
 	ret := _m.Called(jobType, offset, limit)
@@ -277,6 +300,52 @@ func (_m *JobStore) GetNewestJobByStatusesAndType(statuses []string, jobType str
 	return r0, r1
 }
 
+// GetStatsByType provides a mock function with given fields: since
+func (_m *JobStore) GetStatsByType(since int64) ([]*model.JobTypeStats, error) {
+	ret := _m.Called(since)
+
+	var r0 []*model.JobTypeStats
+	if rf, ok := ret.Get(0).(func(int64) []*model.JobTypeStats); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.JobTypeStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTypeSettings provides a mock function with given fields:
+func (_m *JobStore) GetTypeSettings() ([]*model.JobTypeSettings, error) {
+	ret := _m.Called()
+
+	var r0 []*model.JobTypeSettings
+	if rf, ok := ret.Get(0).(func() []*model.JobTypeSettings); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.JobTypeSettings)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: job
 func (_m *JobStore) Save(job *model.Job) (*model.Job, error) {
 	ret := _m.Called(job)
@@ -300,6 +369,29 @@ func (_m *JobStore) Save(job *model.Job) (*model.Job, error) {
 	return r0, r1
 }
 
+// SaveTypeSettings provides a mock function with given fields: settings
+func (_m *JobStore) SaveTypeSettings(settings *model.JobTypeSettings) (*model.JobTypeSettings, error) {
+	ret := _m.Called(settings)
+
+	var r0 *model.JobTypeSettings
+	if rf, ok := ret.Get(0).(func(*model.JobTypeSettings) *model.JobTypeSettings); ok {
+		r0 = rf(settings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.JobTypeSettings)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.JobTypeSettings) error); ok {
+		r1 = rf(settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOptimistically provides a mock function with given fields: job, currentStatus
 func (_m *JobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
 	ret := _m.Called(job, currentStatus)
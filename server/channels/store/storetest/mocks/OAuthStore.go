@@ -212,6 +212,29 @@ func (_m *OAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([
 	return r0, r1
 }
 
+// GetConnection provides a mock function with given fields: userID, service
+func (_m *OAuthStore) GetConnection(userID string, service string) (*model.OAuthConnection, error) {
+	ret := _m.Called(userID, service)
+
+	var r0 *model.OAuthConnection
+	if rf, ok := ret.Get(0).(func(string, string) *model.OAuthConnection); ok {
+		r0 = rf(userID, service)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OAuthConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(userID, service)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPreviousAccessData provides a mock function with given fields: userID, clientId
 func (_m *OAuthStore) GetPreviousAccessData(userID string, clientId string) (*model.AccessData, error) {
 	ret := _m.Called(userID, clientId)
@@ -235,6 +258,29 @@ func (_m *OAuthStore) GetPreviousAccessData(userID string, clientId string) (*mo
 	return r0, r1
 }
 
+// GetRotatedRefreshToken provides a mock function with given fields: refreshToken
+func (_m *OAuthStore) GetRotatedRefreshToken(refreshToken string) (*model.OAuthRotatedRefreshToken, error) {
+	ret := _m.Called(refreshToken)
+
+	var r0 *model.OAuthRotatedRefreshToken
+	if rf, ok := ret.Get(0).(func(string) *model.OAuthRotatedRefreshToken); ok {
+		r0 = rf(refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OAuthRotatedRefreshToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PermanentDeleteAuthDataByUser provides a mock function with given fields: userID
 func (_m *OAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
 	ret := _m.Called(userID)
@@ -305,6 +351,34 @@ func (_m *OAuthStore) RemoveAuthDataByClientId(clientId string, userId string) e
 	return r0
 }
 
+// RemoveConnection provides a mock function with given fields: userID, service
+func (_m *OAuthStore) RemoveConnection(userID string, service string) error {
+	ret := _m.Called(userID, service)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, service)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveRotatedRefreshTokens provides a mock function with given fields: userID, clientID
+func (_m *OAuthStore) RemoveRotatedRefreshTokens(userID string, clientID string) error {
+	ret := _m.Called(userID, clientID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, clientID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveAccessData provides a mock function with given fields: accessData
 func (_m *OAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
 	ret := _m.Called(accessData)
@@ -374,6 +448,43 @@ func (_m *OAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, e
 	return r0, r1
 }
 
+// SaveConnection provides a mock function with given fields: connection
+func (_m *OAuthStore) SaveConnection(connection *model.OAuthConnection) (*model.OAuthConnection, error) {
+	ret := _m.Called(connection)
+
+	var r0 *model.OAuthConnection
+	if rf, ok := ret.Get(0).(func(*model.OAuthConnection) *model.OAuthConnection); ok {
+		r0 = rf(connection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OAuthConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.OAuthConnection) error); ok {
+		r1 = rf(connection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveRotatedRefreshToken provides a mock function with given fields: token
+func (_m *OAuthStore) SaveRotatedRefreshToken(token *model.OAuthRotatedRefreshToken) error {
+	ret := _m.Called(token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.OAuthRotatedRefreshToken) error); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateAccessData provides a mock function with given fields: accessData
 func (_m *OAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
 	ret := _m.Called(accessData)
@@ -419,3 +530,17 @@ func (_m *OAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 
 	return r0, r1
 }
+
+// UpdateAppCreator provides a mock function with given fields: appID, creatorID
+func (_m *OAuthStore) UpdateAppCreator(appID string, creatorID string) error {
+	ret := _m.Called(appID, creatorID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(appID, creatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
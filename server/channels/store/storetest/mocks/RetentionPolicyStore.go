@@ -343,6 +343,29 @@ func (_m *RetentionPolicyStore) Patch(patch *model.RetentionPolicyWithTeamAndCha
 	return r0, r1
 }
 
+// Preview provides a mock function with given fields: policy, now
+func (_m *RetentionPolicyStore) Preview(policy *model.RetentionPolicyWithTeamAndChannelIDs, now int64) (*model.RetentionPolicyPreview, error) {
+	ret := _m.Called(policy, now)
+
+	var r0 *model.RetentionPolicyPreview
+	if rf, ok := ret.Get(0).(func(*model.RetentionPolicyWithTeamAndChannelIDs, int64) *model.RetentionPolicyPreview); ok {
+		r0 = rf(policy, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RetentionPolicyPreview)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.RetentionPolicyWithTeamAndChannelIDs, int64) error); ok {
+		r1 = rf(policy, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveChannels provides a mock function with given fields: policyId, channelIds
 func (_m *RetentionPolicyStore) RemoveChannels(policyId string, channelIds []string) error {
 	ret := _m.Called(policyId, channelIds)
@@ -22,6 +22,38 @@ type Store struct {
 	mock.Mock
 }
 
+// Activity provides a mock function with given fields:
+func (_m *Store) Activity() store.ActivityStore {
+	ret := _m.Called()
+
+	var r0 store.ActivityStore
+	if rf, ok := ret.Get(0).(func() store.ActivityStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ActivityStore)
+		}
+	}
+
+	return r0
+}
+
+// ArchivedPost provides a mock function with given fields:
+func (_m *Store) ArchivedPost() store.ArchivedPostStore {
+	ret := _m.Called()
+
+	var r0 store.ArchivedPostStore
+	if rf, ok := ret.Get(0).(func() store.ArchivedPostStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ArchivedPostStore)
+		}
+	}
+
+	return r0
+}
+
 // Audit provides a mock function with given fields:
 func (_m *Store) Audit() store.AuditStore {
 	ret := _m.Called()
@@ -70,6 +102,54 @@ func (_m *Store) Channel() store.ChannelStore {
 	return r0
 }
 
+// ChannelBookmark provides a mock function with given fields:
+func (_m *Store) ChannelBookmark() store.ChannelBookmarkStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelBookmarkStore
+	if rf, ok := ret.Get(0).(func() store.ChannelBookmarkStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelBookmarkStore)
+		}
+	}
+
+	return r0
+}
+
+// ChannelJoinRequest provides a mock function with given fields:
+func (_m *Store) ChannelJoinRequest() store.ChannelJoinRequestStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelJoinRequestStore
+	if rf, ok := ret.Get(0).(func() store.ChannelJoinRequestStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelJoinRequestStore)
+		}
+	}
+
+	return r0
+}
+
+// ChannelMemberExpiry provides a mock function with given fields:
+func (_m *Store) ChannelMemberExpiry() store.ChannelMemberExpiryStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelMemberExpiryStore
+	if rf, ok := ret.Get(0).(func() store.ChannelMemberExpiryStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelMemberExpiryStore)
+		}
+	}
+
+	return r0
+}
+
 // ChannelMemberHistory provides a mock function with given fields:
 func (_m *Store) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	ret := _m.Called()
@@ -171,6 +251,22 @@ func (_m *Store) Compliance() store.ComplianceStore {
 	return r0
 }
 
+// ConfigChange provides a mock function with given fields:
+func (_m *Store) ConfigChange() store.ConfigChangeStore {
+	ret := _m.Called()
+
+	var r0 store.ConfigChangeStore
+	if rf, ok := ret.Get(0).(func() store.ConfigChangeStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ConfigChangeStore)
+		}
+	}
+
+	return r0
+}
+
 // Context provides a mock function with given fields:
 func (_m *Store) Context() context.Context {
 	ret := _m.Called()
@@ -187,6 +283,29 @@ func (_m *Store) Context() context.Context {
 	return r0
 }
 
+// CreateTablePartitions provides a mock function with given fields: until
+func (_m *Store) CreateTablePartitions(until time.Time) ([]*model.TablePartition, error) {
+	ret := _m.Called(until)
+
+	var r0 []*model.TablePartition
+	if rf, ok := ret.Get(0).(func(time.Time) []*model.TablePartition); ok {
+		r0 = rf(until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TablePartition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Draft provides a mock function with given fields:
 func (_m *Store) Draft() store.DraftStore {
 	ret := _m.Called()
@@ -208,6 +327,29 @@ func (_m *Store) DropAllTables() {
 	_m.Called()
 }
 
+// DropTablePartitions provides a mock function with given fields: before
+func (_m *Store) DropTablePartitions(before int64) ([]*model.TablePartition, error) {
+	ret := _m.Called(before)
+
+	var r0 []*model.TablePartition
+	if rf, ok := ret.Get(0).(func(int64) []*model.TablePartition); ok {
+		r0 = rf(before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TablePartition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Emoji provides a mock function with given fields:
 func (_m *Store) Emoji() store.EmojiStore {
 	ret := _m.Called()
@@ -353,6 +495,52 @@ func (_m *Store) GetInternalReplicaDBs() []*sql.DB {
 	return r0
 }
 
+// GetOnlineMigrationsStatus provides a mock function with given fields:
+func (_m *Store) GetOnlineMigrationsStatus() ([]*model.OnlineMigrationStatus, error) {
+	ret := _m.Called()
+
+	var r0 []*model.OnlineMigrationStatus
+	if rf, ok := ret.Get(0).(func() []*model.OnlineMigrationStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OnlineMigrationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTablePartitions provides a mock function with given fields:
+func (_m *Store) GetTablePartitions() ([]*model.TablePartition, error) {
+	ret := _m.Called()
+
+	var r0 []*model.TablePartition
+	if rf, ok := ret.Get(0).(func() []*model.TablePartition); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TablePartition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Group provides a mock function with given fields:
 func (_m *Store) Group() store.GroupStore {
 	ret := _m.Called()
@@ -369,6 +557,22 @@ func (_m *Store) Group() store.GroupStore {
 	return r0
 }
 
+// GuestSponsorship provides a mock function with given fields:
+func (_m *Store) GuestSponsorship() store.GuestSponsorshipStore {
+	ret := _m.Called()
+
+	var r0 store.GuestSponsorshipStore
+	if rf, ok := ret.Get(0).(func() store.GuestSponsorshipStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.GuestSponsorshipStore)
+		}
+	}
+
+	return r0
+}
+
 // Job provides a mock function with given fields:
 func (_m *Store) Job() store.JobStore {
 	ret := _m.Called()
@@ -427,6 +631,22 @@ func (_m *Store) MarkSystemRanUnitTests() {
 	_m.Called()
 }
 
+// MatrixBridge provides a mock function with given fields:
+func (_m *Store) MatrixBridge() store.MatrixBridgeStore {
+	ret := _m.Called()
+
+	var r0 store.MatrixBridgeStore
+	if rf, ok := ret.Get(0).(func() store.MatrixBridgeStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.MatrixBridgeStore)
+		}
+	}
+
+	return r0
+}
+
 // NotifyAdmin provides a mock function with given fields:
 func (_m *Store) NotifyAdmin() store.NotifyAdminStore {
 	ret := _m.Called()
@@ -507,6 +727,38 @@ func (_m *Store) PostAcknowledgement() store.PostAcknowledgementStore {
 	return r0
 }
 
+// PostEmbedding provides a mock function with given fields:
+func (_m *Store) PostEmbedding() store.PostEmbeddingStore {
+	ret := _m.Called()
+
+	var r0 store.PostEmbeddingStore
+	if rf, ok := ret.Get(0).(func() store.PostEmbeddingStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostEmbeddingStore)
+		}
+	}
+
+	return r0
+}
+
+// PostExpiration provides a mock function with given fields:
+func (_m *Store) PostExpiration() store.PostExpirationStore {
+	ret := _m.Called()
+
+	var r0 store.PostExpirationStore
+	if rf, ok := ret.Get(0).(func() store.PostExpirationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostExpirationStore)
+		}
+	}
+
+	return r0
+}
+
 // PostPriority provides a mock function with given fields:
 func (_m *Store) PostPriority() store.PostPriorityStore {
 	ret := _m.Called()
@@ -523,6 +775,22 @@ func (_m *Store) PostPriority() store.PostPriorityStore {
 	return r0
 }
 
+// PostTranslation provides a mock function with given fields:
+func (_m *Store) PostTranslation() store.PostTranslationStore {
+	ret := _m.Called()
+
+	var r0 store.PostTranslationStore
+	if rf, ok := ret.Get(0).(func() store.PostTranslationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostTranslationStore)
+		}
+	}
+
+	return r0
+}
+
 // Preference provides a mock function with given fields:
 func (_m *Store) Preference() store.PreferenceStore {
 	ret := _m.Called()
@@ -555,6 +823,22 @@ func (_m *Store) ProductNotices() store.ProductNoticesStore {
 	return r0
 }
 
+// ReadReceipt provides a mock function with given fields:
+func (_m *Store) ReadReceipt() store.ReadReceiptStore {
+	ret := _m.Called()
+
+	var r0 store.ReadReceiptStore
+	if rf, ok := ret.Get(0).(func() store.ReadReceiptStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ReadReceiptStore)
+		}
+	}
+
+	return r0
+}
+
 // Reaction provides a mock function with given fields:
 func (_m *Store) Reaction() store.ReactionStore {
 	ret := _m.Called()
@@ -571,6 +855,22 @@ func (_m *Store) Reaction() store.ReactionStore {
 	return r0
 }
 
+// ReactionSummary provides a mock function with given fields:
+func (_m *Store) ReactionSummary() store.ReactionSummaryStore {
+	ret := _m.Called()
+
+	var r0 store.ReactionSummaryStore
+	if rf, ok := ret.Get(0).(func() store.ReactionSummaryStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ReactionSummaryStore)
+		}
+	}
+
+	return r0
+}
+
 // RecycleDBConnections provides a mock function with given fields: d
 func (_m *Store) RecycleDBConnections(d time.Duration) {
 	_m.Called(d)
@@ -652,6 +952,38 @@ func (_m *Store) Role() store.RoleStore {
 	return r0
 }
 
+// ScheduledPost provides a mock function with given fields:
+func (_m *Store) ScheduledPost() store.ScheduledPostStore {
+	ret := _m.Called()
+
+	var r0 store.ScheduledPostStore
+	if rf, ok := ret.Get(0).(func() store.ScheduledPostStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ScheduledPostStore)
+		}
+	}
+
+	return r0
+}
+
+// RoleSnapshot provides a mock function with given fields:
+func (_m *Store) RoleSnapshot() store.RoleSnapshotStore {
+	ret := _m.Called()
+
+	var r0 store.RoleSnapshotStore
+	if rf, ok := ret.Get(0).(func() store.RoleSnapshotStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.RoleSnapshotStore)
+		}
+	}
+
+	return r0
+}
+
 // Scheme provides a mock function with given fields:
 func (_m *Store) Scheme() store.SchemeStore {
 	ret := _m.Called()
@@ -721,6 +1053,22 @@ func (_m *Store) Status() store.StatusStore {
 	return r0
 }
 
+// SupportAccessConsent provides a mock function with given fields:
+func (_m *Store) SupportAccessConsent() store.SupportAccessConsentStore {
+	ret := _m.Called()
+
+	var r0 store.SupportAccessConsentStore
+	if rf, ok := ret.Get(0).(func() store.SupportAccessConsentStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.SupportAccessConsentStore)
+		}
+	}
+
+	return r0
+}
+
 // System provides a mock function with given fields:
 func (_m *Store) System() store.SystemStore {
 	ret := _m.Called()
@@ -753,6 +1101,38 @@ func (_m *Store) Team() store.TeamStore {
 	return r0
 }
 
+// TeamArchive provides a mock function with given fields:
+func (_m *Store) TeamArchive() store.TeamArchiveStore {
+	ret := _m.Called()
+
+	var r0 store.TeamArchiveStore
+	if rf, ok := ret.Get(0).(func() store.TeamArchiveStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamArchiveStore)
+		}
+	}
+
+	return r0
+}
+
+// TeamTemplate provides a mock function with given fields:
+func (_m *Store) TeamTemplate() store.TeamTemplateStore {
+	ret := _m.Called()
+
+	var r0 store.TeamTemplateStore
+	if rf, ok := ret.Get(0).(func() store.TeamTemplateStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamTemplateStore)
+		}
+	}
+
+	return r0
+}
+
 // TermsOfService provides a mock function with given fields:
 func (_m *Store) TermsOfService() store.TermsOfServiceStore {
 	ret := _m.Called()
@@ -928,6 +1308,22 @@ func (_m *Store) UserTermsOfService() store.UserTermsOfServiceStore {
 	return r0
 }
 
+// WebAuthnCredential provides a mock function with given fields:
+func (_m *Store) WebAuthnCredential() store.WebAuthnCredentialStore {
+	ret := _m.Called()
+
+	var r0 store.WebAuthnCredentialStore
+	if rf, ok := ret.Get(0).(func() store.WebAuthnCredentialStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.WebAuthnCredentialStore)
+		}
+	}
+
+	return r0
+}
+
 // Webhook provides a mock function with given fields:
 func (_m *Store) Webhook() store.WebhookStore {
 	ret := _m.Called()
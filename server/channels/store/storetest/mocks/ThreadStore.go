@@ -73,6 +73,29 @@ func (_m *ThreadStore) Get(id string) (*model.Thread, error) {
 	return r0, r1
 }
 
+// GetInboxThreadsForUser provides a mock function with given fields: userID, options
+func (_m *ThreadStore) GetInboxThreadsForUser(userID string, options model.GetInboxOptions) ([]*model.InboxItem, error) {
+	ret := _m.Called(userID, options)
+
+	var r0 []*model.InboxItem
+	if rf, ok := ret.Get(0).(func(string, model.GetInboxOptions) []*model.InboxItem); ok {
+		r0 = rf(userID, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.InboxItem)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, model.GetInboxOptions) error); ok {
+		r1 = rf(userID, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMembershipForUser provides a mock function with given fields: userId, postID
 func (_m *ThreadStore) GetMembershipForUser(userId string, postID string) (*model.ThreadMembership, error) {
 	ret := _m.Called(userId, postID)
@@ -144,13 +144,13 @@ func (_m *UserStore) AnalyticsGetSystemAdminCount() (int64, error) {
 	return r0, r1
 }
 
-// AutocompleteUsersInChannel provides a mock function with given fields: teamID, channelID, term, options
-func (_m *UserStore) AutocompleteUsersInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
-	ret := _m.Called(teamID, channelID, term, options)
+// AutocompleteUsersInChannel provides a mock function with given fields: ctx, teamID, channelID, term, options
+func (_m *UserStore) AutocompleteUsersInChannel(ctx context.Context, teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
+	ret := _m.Called(ctx, teamID, channelID, term, options)
 
 	var r0 *model.UserAutocompleteInChannel
-	if rf, ok := ret.Get(0).(func(string, string, string, *model.UserSearchOptions) *model.UserAutocompleteInChannel); ok {
-		r0 = rf(teamID, channelID, term, options)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *model.UserSearchOptions) *model.UserAutocompleteInChannel); ok {
+		r0 = rf(ctx, teamID, channelID, term, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.UserAutocompleteInChannel)
@@ -158,8 +158,8 @@ func (_m *UserStore) AutocompleteUsersInChannel(teamID string, channelID string,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, *model.UserSearchOptions) error); ok {
-		r1 = rf(teamID, channelID, term, options)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *model.UserSearchOptions) error); ok {
+		r1 = rf(ctx, teamID, channelID, term, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -682,6 +682,36 @@ func (_m *UserStore) GetNewUsersForTeam(teamID string, offset int, limit int, vi
 	return r0, r1
 }
 
+// GetPageUsingAuthService provides a mock function with given fields: authService, options
+func (_m *UserStore) GetPageUsingAuthService(authService string, options model.UserGetByAuthServiceOptions) ([]*model.User, int64, error) {
+	ret := _m.Called(authService, options)
+
+	var r0 []*model.User
+	if rf, ok := ret.Get(0).(func(string, model.UserGetByAuthServiceOptions) []*model.User); ok {
+		r0 = rf(authService, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(string, model.UserGetByAuthServiceOptions) int64); ok {
+		r1 = rf(authService, options)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, model.UserGetByAuthServiceOptions) error); ok {
+		r2 = rf(authService, options)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetProfileByGroupChannelIdsForUser provides a mock function with given fields: userID, channelIds
 func (_m *UserStore) GetProfileByGroupChannelIdsForUser(userID string, channelIds []string) (map[string][]*model.User, error) {
 	ret := _m.Called(userID, channelIds)
@@ -1226,13 +1256,13 @@ func (_m *UserStore) Save(user *model.User) (*model.User, error) {
 	return r0, r1
 }
 
-// Search provides a mock function with given fields: teamID, term, options
-func (_m *UserStore) Search(teamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
-	ret := _m.Called(teamID, term, options)
+// Search provides a mock function with given fields: ctx, teamID, term, options
+func (_m *UserStore) Search(ctx context.Context, teamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+	ret := _m.Called(ctx, teamID, term, options)
 
 	var r0 []*model.User
-	if rf, ok := ret.Get(0).(func(string, string, *model.UserSearchOptions) []*model.User); ok {
-		r0 = rf(teamID, term, options)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.UserSearchOptions) []*model.User); ok {
+		r0 = rf(ctx, teamID, term, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.User)
@@ -1240,8 +1270,8 @@ func (_m *UserStore) Search(teamID string, term string, options *model.UserSearc
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, *model.UserSearchOptions) error); ok {
-		r1 = rf(teamID, term, options)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *model.UserSearchOptions) error); ok {
+		r1 = rf(ctx, teamID, term, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1249,13 +1279,13 @@ func (_m *UserStore) Search(teamID string, term string, options *model.UserSearc
 	return r0, r1
 }
 
-// SearchInChannel provides a mock function with given fields: channelID, term, options
-func (_m *UserStore) SearchInChannel(channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
-	ret := _m.Called(channelID, term, options)
+// SearchInChannel provides a mock function with given fields: ctx, channelID, term, options
+func (_m *UserStore) SearchInChannel(ctx context.Context, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+	ret := _m.Called(ctx, channelID, term, options)
 
 	var r0 []*model.User
-	if rf, ok := ret.Get(0).(func(string, string, *model.UserSearchOptions) []*model.User); ok {
-		r0 = rf(channelID, term, options)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.UserSearchOptions) []*model.User); ok {
+		r0 = rf(ctx, channelID, term, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.User)
@@ -1263,8 +1293,8 @@ func (_m *UserStore) SearchInChannel(channelID string, term string, options *mod
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, *model.UserSearchOptions) error); ok {
-		r1 = rf(channelID, term, options)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *model.UserSearchOptions) error); ok {
+		r1 = rf(ctx, channelID, term, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1272,13 +1302,13 @@ func (_m *UserStore) SearchInChannel(channelID string, term string, options *mod
 	return r0, r1
 }
 
-// SearchInGroup provides a mock function with given fields: groupID, term, options
-func (_m *UserStore) SearchInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
-	ret := _m.Called(groupID, term, options)
+// SearchInGroup provides a mock function with given fields: ctx, groupID, term, options
+func (_m *UserStore) SearchInGroup(ctx context.Context, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+	ret := _m.Called(ctx, groupID, term, options)
 
 	var r0 []*model.User
-	if rf, ok := ret.Get(0).(func(string, string, *model.UserSearchOptions) []*model.User); ok {
-		r0 = rf(groupID, term, options)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.UserSearchOptions) []*model.User); ok {
+		r0 = rf(ctx, groupID, term, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.User)
@@ -1286,8 +1316,8 @@ func (_m *UserStore) SearchInGroup(groupID string, term string, options *model.U
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, *model.UserSearchOptions) error); ok {
-		r1 = rf(groupID, term, options)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *model.UserSearchOptions) error); ok {
+		r1 = rf(ctx, groupID, term, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1295,13 +1325,13 @@ func (_m *UserStore) SearchInGroup(groupID string, term string, options *model.U
 	return r0, r1
 }
 
-// SearchNotInChannel provides a mock function with given fields: teamID, channelID, term, options
-func (_m *UserStore) SearchNotInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
-	ret := _m.Called(teamID, channelID, term, options)
+// SearchNotInChannel provides a mock function with given fields: ctx, teamID, channelID, term, options
+func (_m *UserStore) SearchNotInChannel(ctx context.Context, teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+	ret := _m.Called(ctx, teamID, channelID, term, options)
 
 	var r0 []*model.User
-	if rf, ok := ret.Get(0).(func(string, string, string, *model.UserSearchOptions) []*model.User); ok {
-		r0 = rf(teamID, channelID, term, options)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *model.UserSearchOptions) []*model.User); ok {
+		r0 = rf(ctx, teamID, channelID, term, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.User)
@@ -1309,8 +1339,8 @@ func (_m *UserStore) SearchNotInChannel(teamID string, channelID string, term st
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, *model.UserSearchOptions) error); ok {
-		r1 = rf(teamID, channelID, term, options)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *model.UserSearchOptions) error); ok {
+		r1 = rf(ctx, teamID, channelID, term, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1318,13 +1348,13 @@ func (_m *UserStore) SearchNotInChannel(teamID string, channelID string, term st
 	return r0, r1
 }
 
-// SearchNotInGroup provides a mock function with given fields: groupID, term, options
-func (_m *UserStore) SearchNotInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
-	ret := _m.Called(groupID, term, options)
+// SearchNotInGroup provides a mock function with given fields: ctx, groupID, term, options
+func (_m *UserStore) SearchNotInGroup(ctx context.Context, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+	ret := _m.Called(ctx, groupID, term, options)
 
 	var r0 []*model.User
-	if rf, ok := ret.Get(0).(func(string, string, *model.UserSearchOptions) []*model.User); ok {
-		r0 = rf(groupID, term, options)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.UserSearchOptions) []*model.User); ok {
+		r0 = rf(ctx, groupID, term, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.User)
@@ -1332,8 +1362,8 @@ func (_m *UserStore) SearchNotInGroup(groupID string, term string, options *mode
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, *model.UserSearchOptions) error); ok {
-		r1 = rf(groupID, term, options)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *model.UserSearchOptions) error); ok {
+		r1 = rf(ctx, groupID, term, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1341,13 +1371,13 @@ func (_m *UserStore) SearchNotInGroup(groupID string, term string, options *mode
 	return r0, r1
 }
 
-// SearchNotInTeam provides a mock function with given fields: notInTeamID, term, options
-func (_m *UserStore) SearchNotInTeam(notInTeamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
-	ret := _m.Called(notInTeamID, term, options)
+// SearchNotInTeam provides a mock function with given fields: ctx, notInTeamID, term, options
+func (_m *UserStore) SearchNotInTeam(ctx context.Context, notInTeamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+	ret := _m.Called(ctx, notInTeamID, term, options)
 
 	var r0 []*model.User
-	if rf, ok := ret.Get(0).(func(string, string, *model.UserSearchOptions) []*model.User); ok {
-		r0 = rf(notInTeamID, term, options)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.UserSearchOptions) []*model.User); ok {
+		r0 = rf(ctx, notInTeamID, term, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.User)
@@ -1355,8 +1385,8 @@ func (_m *UserStore) SearchNotInTeam(notInTeamID string, term string, options *m
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, *model.UserSearchOptions) error); ok {
-		r1 = rf(notInTeamID, term, options)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *model.UserSearchOptions) error); ok {
+		r1 = rf(ctx, notInTeamID, term, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1364,13 +1394,13 @@ func (_m *UserStore) SearchNotInTeam(notInTeamID string, term string, options *m
 	return r0, r1
 }
 
-// SearchWithoutTeam provides a mock function with given fields: term, options
-func (_m *UserStore) SearchWithoutTeam(term string, options *model.UserSearchOptions) ([]*model.User, error) {
-	ret := _m.Called(term, options)
+// SearchWithoutTeam provides a mock function with given fields: ctx, term, options
+func (_m *UserStore) SearchWithoutTeam(ctx context.Context, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+	ret := _m.Called(ctx, term, options)
 
 	var r0 []*model.User
-	if rf, ok := ret.Get(0).(func(string, *model.UserSearchOptions) []*model.User); ok {
-		r0 = rf(term, options)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.UserSearchOptions) []*model.User); ok {
+		r0 = rf(ctx, term, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.User)
@@ -1378,8 +1408,8 @@ func (_m *UserStore) SearchWithoutTeam(term string, options *model.UserSearchOpt
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, *model.UserSearchOptions) error); ok {
-		r1 = rf(term, options)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.UserSearchOptions) error); ok {
+		r1 = rf(ctx, term, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,6 +61,20 @@ func (_m *WebhookStore) ClearCaches() {
 	_m.Called()
 }
 
+// DeleteDirectOutgoing provides a mock function with given fields: webhookID, timestamp
+func (_m *WebhookStore) DeleteDirectOutgoing(webhookID string, timestamp int64) error {
+	ret := _m.Called(webhookID, timestamp)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(webhookID, timestamp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteIncoming provides a mock function with given fields: webhookID, timestamp
 func (_m *WebhookStore) DeleteIncoming(webhookID string, timestamp int64) error {
 	ret := _m.Called(webhookID, timestamp)
@@ -89,6 +103,75 @@ func (_m *WebhookStore) DeleteOutgoing(webhookID string, timestamp int64) error
 	return r0
 }
 
+// GetDirectOutgoing provides a mock function with given fields: id
+func (_m *WebhookStore) GetDirectOutgoing(id string) (*model.DirectOutgoingWebhook, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.DirectOutgoingWebhook
+	if rf, ok := ret.Get(0).(func(string) *model.DirectOutgoingWebhook); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DirectOutgoingWebhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDirectOutgoingByBotUsers provides a mock function with given fields: botUserIDs
+func (_m *WebhookStore) GetDirectOutgoingByBotUsers(botUserIDs []string) ([]*model.DirectOutgoingWebhook, error) {
+	ret := _m.Called(botUserIDs)
+
+	var r0 []*model.DirectOutgoingWebhook
+	if rf, ok := ret.Get(0).(func([]string) []*model.DirectOutgoingWebhook); ok {
+		r0 = rf(botUserIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DirectOutgoingWebhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(botUserIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDirectOutgoingList provides a mock function with given fields: offset, limit
+func (_m *WebhookStore) GetDirectOutgoingList(offset int, limit int) ([]*model.DirectOutgoingWebhook, error) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []*model.DirectOutgoingWebhook
+	if rf, ok := ret.Get(0).(func(int, int) []*model.DirectOutgoingWebhook); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DirectOutgoingWebhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetIncoming provides a mock function with given fields: id, allowFromCache
 func (_m *WebhookStore) GetIncoming(id string, allowFromCache bool) (*model.IncomingWebhook, error) {
 	ret := _m.Called(id, allowFromCache)
@@ -449,6 +532,29 @@ func (_m *WebhookStore) PermanentDeleteOutgoingByUser(userID string) error {
 	return r0
 }
 
+// SaveDirectOutgoing provides a mock function with given fields: webhook
+func (_m *WebhookStore) SaveDirectOutgoing(webhook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, error) {
+	ret := _m.Called(webhook)
+
+	var r0 *model.DirectOutgoingWebhook
+	if rf, ok := ret.Get(0).(func(*model.DirectOutgoingWebhook) *model.DirectOutgoingWebhook); ok {
+		r0 = rf(webhook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DirectOutgoingWebhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.DirectOutgoingWebhook) error); ok {
+		r1 = rf(webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveIncoming provides a mock function with given fields: webhook
 func (_m *WebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	ret := _m.Called(webhook)
@@ -495,6 +601,29 @@ func (_m *WebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*model.Out
 	return r0, r1
 }
 
+// UpdateDirectOutgoing provides a mock function with given fields: hook
+func (_m *WebhookStore) UpdateDirectOutgoing(hook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, error) {
+	ret := _m.Called(hook)
+
+	var r0 *model.DirectOutgoingWebhook
+	if rf, ok := ret.Get(0).(func(*model.DirectOutgoingWebhook) *model.DirectOutgoingWebhook); ok {
+		r0 = rf(hook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DirectOutgoingWebhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.DirectOutgoingWebhook) error); ok {
+		r1 = rf(hook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateIncoming provides a mock function with given fields: webhook
 func (_m *WebhookStore) UpdateIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	ret := _m.Called(webhook)
@@ -518,6 +647,20 @@ func (_m *WebhookStore) UpdateIncoming(webhook *model.IncomingWebhook) (*model.I
 	return r0, r1
 }
 
+// UpdateIncomingUser provides a mock function with given fields: hookID, userID
+func (_m *WebhookStore) UpdateIncomingUser(hookID string, userID string) error {
+	ret := _m.Called(hookID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(hookID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateOutgoing provides a mock function with given fields: hook
 func (_m *WebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, error) {
 	ret := _m.Called(hook)
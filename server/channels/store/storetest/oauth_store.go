@@ -18,6 +18,7 @@ func TestOAuthStore(t *testing.T, ss store.Store) {
 	t.Run("SaveApp", func(t *testing.T) { testOAuthStoreSaveApp(t, ss) })
 	t.Run("GetApp", func(t *testing.T) { testOAuthStoreGetApp(t, ss) })
 	t.Run("UpdateApp", func(t *testing.T) { testOAuthStoreUpdateApp(t, ss) })
+	t.Run("UpdateAppCreator", func(t *testing.T) { testOAuthStoreUpdateAppCreator(t, ss) })
 	t.Run("SaveAccessData", func(t *testing.T) { testOAuthStoreSaveAccessData(t, ss) })
 	t.Run("OAuthUpdateAccessData", func(t *testing.T) { testOAuthUpdateAccessData(t, ss) })
 	t.Run("GetAccessData", func(t *testing.T) { testOAuthStoreGetAccessData(t, ss) })
@@ -30,6 +31,8 @@ func TestOAuthStore(t *testing.T, ss store.Store) {
 	t.Run("OAuthGetAuthorizedApps", func(t *testing.T) { testOAuthGetAuthorizedApps(t, ss) })
 	t.Run("OAuthGetAccessDataByUserForApp", func(t *testing.T) { testOAuthGetAccessDataByUserForApp(t, ss) })
 	t.Run("DeleteApp", func(t *testing.T) { testOAuthStoreDeleteApp(t, ss) })
+	t.Run("Connections", func(t *testing.T) { testOAuthStoreConnections(t, ss) })
+	t.Run("RotatedRefreshTokens", func(t *testing.T) { testOAuthStoreRotatedRefreshTokens(t, ss) })
 }
 
 func testOAuthStoreSaveApp(t *testing.T, ss store.Store) {
@@ -123,6 +126,27 @@ func testOAuthStoreUpdateApp(t *testing.T, ss store.Store) {
 	require.NotEqual(t, ua.CreatorId, "12345678901234567890123456", "creator id should not have updated")
 }
 
+func testOAuthStoreUpdateAppCreator(t *testing.T, ss store.Store) {
+	a1 := model.OAuthApp{}
+	a1.CreatorId = model.NewId()
+	a1.Name = "TestApp" + model.NewId()
+	a1.CallbackUrls = []string{"https://nowhere.com"}
+	a1.Homepage = "https://nowhere.com"
+	_, err := ss.OAuth().SaveApp(&a1)
+	require.NoError(t, err)
+
+	creatorID := model.NewId()
+	require.NoError(t, ss.OAuth().UpdateAppCreator(a1.Id, creatorID))
+
+	app, err := ss.OAuth().GetApp(a1.Id)
+	require.NoError(t, err)
+	assert.Equal(t, creatorID, app.CreatorId)
+	assert.Equal(t, a1.Name, app.Name)
+
+	err = ss.OAuth().UpdateAppCreator(model.NewId(), creatorID)
+	require.Error(t, err, "should have failed for a missing app")
+}
+
 func testOAuthStoreSaveAccessData(t *testing.T, ss store.Store) {
 	a1 := model.AccessData{}
 	a1.ClientId = model.NewId()
@@ -259,6 +283,26 @@ func testOAuthStoreGetAuthData(t *testing.T, ss store.Store) {
 
 	_, err = ss.OAuth().GetAuthData(a1.Code)
 	require.NoError(t, err)
+
+	t.Run("code challenge and nonce", func(t *testing.T) {
+		a2 := model.AuthData{
+			ClientId:            model.NewId(),
+			UserId:              model.NewId(),
+			Code:                model.NewId(),
+			RedirectUri:         "http://example.com",
+			CodeChallenge:       "_qSw4VBgLvxy28bURR6lnolxtMzN2ZP8Zz6yyjZJAIk",
+			CodeChallengeMethod: model.CodeChallengeMethodS256,
+			Nonce:               "nonce",
+		}
+		_, err := ss.OAuth().SaveAuthData(&a2)
+		require.NoError(t, err)
+
+		authData, err := ss.OAuth().GetAuthData(a2.Code)
+		require.NoError(t, err)
+		assert.Equal(t, a2.CodeChallenge, authData.CodeChallenge)
+		assert.Equal(t, a2.CodeChallengeMethod, authData.CodeChallengeMethod)
+		assert.Equal(t, a2.Nonce, authData.Nonce)
+	})
 }
 
 func testOAuthStoreRemoveAuthData(t *testing.T, ss store.Store) {
@@ -396,3 +440,75 @@ func testOAuthStoreDeleteApp(t *testing.T, ss store.Store) {
 	_, err = ss.OAuth().GetAccessData(s1.Token)
 	require.Error(t, err, "should error - access data should be deleted")
 }
+
+func testOAuthStoreConnections(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	connection := &model.OAuthConnection{
+		UserId:       userID,
+		Service:      model.ServiceGoogle,
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		ExpiresAt:    model.GetMillis() + 3600000,
+	}
+	_, err := ss.OAuth().SaveConnection(connection)
+	require.NoError(t, err)
+
+	_, err = ss.OAuth().SaveConnection(&model.OAuthConnection{UserId: userID, Service: "unknown", AccessToken: "access"})
+	require.Error(t, err)
+
+	saved, err := ss.OAuth().GetConnection(userID, model.ServiceGoogle)
+	require.NoError(t, err)
+	assert.Equal(t, connection, saved)
+
+	_, err = ss.OAuth().GetConnection(userID, model.ServiceOffice365)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+
+	// saving the connection again replaces the tokens
+	updated := &model.OAuthConnection{UserId: userID, Service: model.ServiceGoogle, AccessToken: "new access"}
+	_, err = ss.OAuth().SaveConnection(updated)
+	require.NoError(t, err)
+	saved, err = ss.OAuth().GetConnection(userID, model.ServiceGoogle)
+	require.NoError(t, err)
+	assert.Equal(t, "new access", saved.AccessToken)
+	assert.Empty(t, saved.RefreshToken)
+
+	err = ss.OAuth().RemoveConnection(userID, model.ServiceGoogle)
+	require.NoError(t, err)
+	_, err = ss.OAuth().GetConnection(userID, model.ServiceGoogle)
+	require.ErrorAs(t, err, &nfErr)
+
+	_, err = ss.OAuth().SaveConnection(&model.OAuthConnection{UserId: userID, Service: model.ServiceOffice365, AccessToken: "access"})
+	require.NoError(t, err)
+	err = ss.OAuth().PermanentDeleteAuthDataByUser(userID)
+	require.NoError(t, err)
+	_, err = ss.OAuth().GetConnection(userID, model.ServiceOffice365)
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testOAuthStoreRotatedRefreshTokens(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	clientID := model.NewId()
+
+	t1 := &model.OAuthRotatedRefreshToken{RefreshToken: model.NewId(), ClientId: clientID, UserId: userID, RotatedAt: model.GetMillis()}
+	t2 := &model.OAuthRotatedRefreshToken{RefreshToken: model.NewId(), ClientId: model.NewId(), UserId: userID, RotatedAt: model.GetMillis()}
+	require.NoError(t, ss.OAuth().SaveRotatedRefreshToken(t1))
+	require.NoError(t, ss.OAuth().SaveRotatedRefreshToken(t2))
+
+	token, err := ss.OAuth().GetRotatedRefreshToken(t1.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, t1, token)
+
+	_, err = ss.OAuth().GetRotatedRefreshToken(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+
+	require.NoError(t, ss.OAuth().RemoveRotatedRefreshTokens(userID, clientID))
+
+	_, err = ss.OAuth().GetRotatedRefreshToken(t1.RefreshToken)
+	require.ErrorAs(t, err, &nfErr)
+
+	// Tokens of other apps are kept
+	_, err = ss.OAuth().GetRotatedRefreshToken(t2.RefreshToken)
+	require.NoError(t, err)
+}
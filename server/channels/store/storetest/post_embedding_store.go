@@ -0,0 +1,118 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestPostEmbeddingStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testPostEmbeddingSaveAndGet(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testPostEmbeddingDelete(t, ss) })
+	t.Run("DeleteOrphanedRows", func(t *testing.T) { testPostEmbeddingDeleteOrphanedRows(t, ss) })
+}
+
+func testPostEmbeddingSaveAndGet(t *testing.T, ss store.Store) {
+	postID := model.NewId()
+	channelID := model.NewId()
+
+	err := ss.PostEmbedding().Save([]*model.PostEmbedding{{PostId: postID, ChannelId: channelID, Model: "model"}})
+	require.Error(t, err)
+
+	err = ss.PostEmbedding().Save([]*model.PostEmbedding{{
+		PostId:    postID,
+		ChannelId: channelID,
+		Model:     "model",
+		Embedding: []float32{0.1, 0.2, 0.3},
+	}})
+	require.NoError(t, err)
+
+	embedding, err := ss.PostEmbedding().Get(postID)
+	require.NoError(t, err)
+	assert.Equal(t, channelID, embedding.ChannelId)
+	assert.Equal(t, "model", embedding.Model)
+	assert.Equal(t, []float32{0.1, 0.2, 0.3}, embedding.Embedding)
+	assert.NotZero(t, embedding.CreateAt)
+
+	t.Run("save replaces the embedding of the post", func(t *testing.T) {
+		err := ss.PostEmbedding().Save([]*model.PostEmbedding{{
+			PostId:    postID,
+			ChannelId: channelID,
+			Model:     "other-model",
+			Embedding: []float32{0.4, 0.5},
+		}})
+		require.NoError(t, err)
+
+		embedding, err := ss.PostEmbedding().Get(postID)
+		require.NoError(t, err)
+		assert.Equal(t, "other-model", embedding.Model)
+		assert.Equal(t, []float32{0.4, 0.5}, embedding.Embedding)
+	})
+
+	t.Run("get a post without an embedding", func(t *testing.T) {
+		_, err := ss.PostEmbedding().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testPostEmbeddingDelete(t *testing.T, ss store.Store) {
+	postIDs := []string{model.NewId(), model.NewId(), model.NewId()}
+
+	embeddings := make([]*model.PostEmbedding, 0, len(postIDs))
+	for _, postID := range postIDs {
+		embeddings = append(embeddings, &model.PostEmbedding{
+			PostId:    postID,
+			ChannelId: model.NewId(),
+			Model:     "model",
+			Embedding: []float32{1, 0},
+		})
+	}
+	require.NoError(t, ss.PostEmbedding().Save(embeddings))
+
+	require.NoError(t, ss.PostEmbedding().Delete(postIDs[:2]))
+
+	for _, postID := range postIDs[:2] {
+		_, err := ss.PostEmbedding().Get(postID)
+		require.Error(t, err)
+	}
+	_, err := ss.PostEmbedding().Get(postIDs[2])
+	require.NoError(t, err)
+}
+
+func testPostEmbeddingDeleteOrphanedRows(t *testing.T, ss store.Store) {
+	post, err := ss.Post().Save(&model.Post{
+		ChannelId: model.NewId(),
+		UserId:    model.NewId(),
+		Message:   "hello",
+	})
+	require.NoError(t, err)
+	orphanedPostID := model.NewId()
+
+	for _, postID := range []string{post.Id, orphanedPostID} {
+		err = ss.PostEmbedding().Save([]*model.PostEmbedding{{
+			PostId:    postID,
+			ChannelId: post.ChannelId,
+			Model:     "model",
+			Embedding: []float32{1, 0},
+		}})
+		require.NoError(t, err)
+	}
+
+	deleted, err := ss.PostEmbedding().DeleteOrphanedRows(1000)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+
+	_, err = ss.PostEmbedding().Get(post.Id)
+	require.NoError(t, err)
+	_, err = ss.PostEmbedding().Get(orphanedPostID)
+	require.Error(t, err)
+}
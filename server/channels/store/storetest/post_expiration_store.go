@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestPostExpirationStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("GetExpiredBefore", func(t *testing.T) { testPostExpirationGetExpiredBefore(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testPostExpirationDelete(t, ss) })
+}
+
+func saveTestExpiringPost(t *testing.T, ss store.Store, channelID string, expireAt int64) *model.Post {
+	post := &model.Post{
+		ChannelId: channelID,
+		UserId:    model.NewId(),
+		Message:   "expiring " + model.NewId(),
+	}
+	if expireAt != 0 {
+		post.AddProp(model.PostPropsExpireAt, expireAt)
+	}
+
+	saved, err := ss.Post().Save(post)
+	require.NoError(t, err)
+	return saved
+}
+
+func testPostExpirationGetExpiredBefore(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	// far in the future, not to collide with the posts of the other tests
+	now := model.GetMillis() + 1000*model.DayInMilliseconds
+
+	later := saveTestExpiringPost(t, ss, channelID, now+1000)
+	second := saveTestExpiringPost(t, ss, channelID, now-1000)
+	first := saveTestExpiringPost(t, ss, channelID, now-2000)
+	saveTestExpiringPost(t, ss, channelID, 0)
+
+	expirations, err := ss.PostExpiration().GetExpiredBefore(now, 100)
+	require.NoError(t, err)
+
+	var postIDs []string
+	for _, expiration := range expirations {
+		if expiration.ChannelId == channelID {
+			postIDs = append(postIDs, expiration.PostId)
+		}
+	}
+	assert.Equal(t, []string{first.Id, second.Id}, postIDs)
+
+	expirations, err = ss.PostExpiration().GetExpiredBefore(now+1000, 100)
+	require.NoError(t, err)
+	postIDs = nil
+	for _, expiration := range expirations {
+		if expiration.ChannelId == channelID {
+			postIDs = append(postIDs, expiration.PostId)
+			if expiration.PostId == later.Id {
+				assert.Equal(t, now+1000, expiration.ExpireAt)
+			}
+		}
+	}
+	assert.Equal(t, []string{first.Id, second.Id, later.Id}, postIDs)
+}
+
+func testPostExpirationDelete(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	now := model.GetMillis() + 2000*model.DayInMilliseconds
+
+	post := saveTestExpiringPost(t, ss, channelID, now-1000)
+
+	require.NoError(t, ss.PostExpiration().Delete([]string{post.Id}))
+
+	expirations, err := ss.PostExpiration().GetExpiredBefore(now, 1000)
+	require.NoError(t, err)
+	for _, expiration := range expirations {
+		assert.NotEqual(t, post.Id, expiration.PostId)
+	}
+}
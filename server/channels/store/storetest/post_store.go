@@ -5072,4 +5072,32 @@ func testGetEditHistoryForPost(t *testing.T, ss store.Store) {
 		require.NoError(t, err)
 	})
 
+	t.Run("should delete edit history along with the post on data retention", func(t *testing.T) {
+		post := &model.Post{
+			ChannelId: model.NewId(),
+			UserId:    model.NewId(),
+			Message:   "test",
+			CreateAt:  1000,
+		}
+		originalPost, err := ss.Post().Save(post)
+		require.NoError(t, err)
+		updatedPost := originalPost.Clone()
+		updatedPost.Message = "test edited"
+		savedUpdatedPost, err := ss.Post().Update(updatedPost, originalPost)
+		require.NoError(t, err)
+
+		edits, err := ss.Post().GetEditHistoryForPost(savedUpdatedPost.Id)
+		require.NoError(t, err)
+		require.Len(t, edits, 1)
+		// revisions keep the creation time of the post, so retention policies apply to both alike
+		require.Equal(t, savedUpdatedPost.CreateAt, edits[0].CreateAt)
+
+		_, err = ss.Post().PermanentDeleteBatch(2000, 1000)
+		require.NoError(t, err)
+
+		_, err = ss.Post().GetSingle(savedUpdatedPost.Id, true)
+		require.Error(t, err)
+		_, err = ss.Post().GetEditHistoryForPost(savedUpdatedPost.Id)
+		require.Error(t, err)
+	})
 }
@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestPostTranslationStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testPostTranslationSaveAndGet(t, ss) })
+	t.Run("DeleteForPost", func(t *testing.T) { testPostTranslationDeleteForPost(t, ss) })
+	t.Run("DeleteOrphanedRows", func(t *testing.T) { testPostTranslationDeleteOrphanedRows(t, ss) })
+}
+
+func testPostTranslationSaveAndGet(t *testing.T, ss store.Store) {
+	postID := model.NewId()
+
+	_, err := ss.PostTranslation().Save(&model.PostTranslation{PostId: postID, Language: ""})
+	require.Error(t, err)
+
+	saved, err := ss.PostTranslation().Save(&model.PostTranslation{
+		PostId:         postID,
+		Language:       "fr",
+		SourceLanguage: "en",
+		Message:        "bonjour",
+		Provider:       model.TranslationProviderDeepL,
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, saved.CreateAt)
+
+	translation, err := ss.PostTranslation().Get(postID, "fr")
+	require.NoError(t, err)
+	assert.Equal(t, saved, translation)
+
+	t.Run("save replaces the translation into the same language", func(t *testing.T) {
+		_, err := ss.PostTranslation().Save(&model.PostTranslation{
+			PostId:     postID,
+			Language:   "fr",
+			Message:    "salut",
+			Provider:   model.TranslationProviderAzure,
+			PostEditAt: 1234,
+		})
+		require.NoError(t, err)
+
+		translation, err := ss.PostTranslation().Get(postID, "fr")
+		require.NoError(t, err)
+		assert.Equal(t, "salut", translation.Message)
+		assert.Equal(t, model.TranslationProviderAzure, translation.Provider)
+		assert.Equal(t, int64(1234), translation.PostEditAt)
+	})
+
+	t.Run("get another language", func(t *testing.T) {
+		_, err := ss.PostTranslation().Get(postID, "de")
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testPostTranslationDeleteForPost(t *testing.T, ss store.Store) {
+	postID := model.NewId()
+	otherPostID := model.NewId()
+
+	for _, translation := range []*model.PostTranslation{
+		{PostId: postID, Language: "fr", Message: "bonjour"},
+		{PostId: postID, Language: "de", Message: "hallo"},
+		{PostId: otherPostID, Language: "fr", Message: "bonjour"},
+	} {
+		_, err := ss.PostTranslation().Save(translation)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, ss.PostTranslation().DeleteForPost(postID))
+
+	_, err := ss.PostTranslation().Get(postID, "fr")
+	require.Error(t, err)
+	_, err = ss.PostTranslation().Get(postID, "de")
+	require.Error(t, err)
+
+	_, err = ss.PostTranslation().Get(otherPostID, "fr")
+	require.NoError(t, err)
+}
+
+func testPostTranslationDeleteOrphanedRows(t *testing.T, ss store.Store) {
+	post, err := ss.Post().Save(&model.Post{
+		ChannelId: model.NewId(),
+		UserId:    model.NewId(),
+		Message:   "hello",
+	})
+	require.NoError(t, err)
+	orphanedPostID := model.NewId()
+
+	for _, postID := range []string{post.Id, orphanedPostID} {
+		_, err = ss.PostTranslation().Save(&model.PostTranslation{PostId: postID, Language: "fr", Message: "bonjour"})
+		require.NoError(t, err)
+	}
+
+	deleted, err := ss.PostTranslation().DeleteOrphanedRows(1000)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+
+	_, err = ss.PostTranslation().Get(post.Id, "fr")
+	require.NoError(t, err)
+	_, err = ss.PostTranslation().Get(orphanedPostID, "fr")
+	require.Error(t, err)
+}
@@ -17,6 +17,7 @@ func TestPreferenceStore(t *testing.T, ss store.Store) {
 	t.Run("PreferenceSave", func(t *testing.T) { testPreferenceSave(t, ss) })
 	t.Run("PreferenceGet", func(t *testing.T) { testPreferenceGet(t, ss) })
 	t.Run("PreferenceGetCategory", func(t *testing.T) { testPreferenceGetCategory(t, ss) })
+	t.Run("PreferenceGetCategoryAndNameForUsers", func(t *testing.T) { testPreferenceGetCategoryAndNameForUsers(t, ss) })
 	t.Run("PreferenceGetAll", func(t *testing.T) { testPreferenceGetAll(t, ss) })
 	t.Run("PreferenceDeleteByUser", func(t *testing.T) { testPreferenceDeleteByUser(t, ss) })
 	t.Run("PreferenceDelete", func(t *testing.T) { testPreferenceDelete(t, ss) })
@@ -150,6 +151,51 @@ func testPreferenceGetCategory(t *testing.T, ss store.Store) {
 	require.Equal(t, 0, len(preferencesByCategory), "shouldn't have got any preferences")
 }
 
+func testPreferenceGetCategoryAndNameForUsers(t *testing.T, ss store.Store) {
+	userID1 := model.NewId()
+	userID2 := model.NewId()
+	category := model.NewId()
+	name := model.NewId()
+
+	preferences := model.Preferences{
+		{
+			UserId:   userID1,
+			Category: category,
+			Name:     name,
+			Value:    "1",
+		},
+		{
+			UserId:   userID2,
+			Category: category,
+			Name:     name,
+			Value:    "2",
+		},
+		// same user/category, different name
+		{
+			UserId:   userID1,
+			Category: category,
+			Name:     model.NewId(),
+		},
+		// same name/category, other user
+		{
+			UserId:   model.NewId(),
+			Category: category,
+			Name:     name,
+		},
+	}
+
+	err := ss.Preference().Save(preferences)
+	require.NoError(t, err)
+
+	got, err := ss.Preference().GetCategoryAndNameForUsers([]string{userID1, userID2}, category, name)
+	require.NoError(t, err)
+	require.ElementsMatch(t, model.Preferences{preferences[0], preferences[1]}, got)
+
+	got, err = ss.Preference().GetCategoryAndNameForUsers([]string{}, category, name)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
 func testPreferenceGetAll(t *testing.T, ss store.Store) {
 	userId := model.NewId()
 	category := model.PreferenceCategoryDirectChannelShow
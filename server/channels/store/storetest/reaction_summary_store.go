@@ -0,0 +1,139 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestReactionSummaryStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SummarizeDay", func(t *testing.T) { testReactionSummarySummarizeDay(t, ss) })
+}
+
+func testReactionSummarySummarizeDay(t *testing.T, ss store.Store) {
+	// Use a day far in the past so that reactions saved by other tests aren't summarized.
+	day := model.GetMillisForTime(time.Date(2001, time.February, 3, 0, 0, 0, 0, time.UTC))
+	nextDay := day + 24*60*60*1000
+
+	team, err := ss.Team().Save(&model.Team{
+		DisplayName: "DisplayName",
+		Name:        NewTestId(),
+		Email:       MakeEmail(),
+		Type:        model.TeamOpen,
+	})
+	require.NoError(t, err)
+
+	makeChannel := func() *model.Channel {
+		channel, err := ss.Channel().Save(&model.Channel{
+			TeamId:      team.Id,
+			DisplayName: "DisplayName",
+			Name:        NewTestId(),
+			Type:        model.ChannelTypeOpen,
+		}, -1)
+		require.NoError(t, err)
+		return channel
+	}
+	channel1 := makeChannel()
+	channel2 := makeChannel()
+
+	makePost := func(channelID string) *model.Post {
+		post, err := ss.Post().Save(&model.Post{ChannelId: channelID, UserId: model.NewId()})
+		require.NoError(t, err)
+		return post
+	}
+	post1 := makePost(channel1.Id)
+	post2 := makePost(channel1.Id)
+	post3 := makePost(channel2.Id)
+
+	react := func(post *model.Post, emojiName string, createAt int64) *model.Reaction {
+		reaction, err := ss.Reaction().Save(&model.Reaction{
+			UserId:    model.NewId(),
+			PostId:    post.Id,
+			EmojiName: emojiName,
+			CreateAt:  createAt,
+		})
+		require.NoError(t, err)
+		return reaction
+	}
+	react(post1, "smile", day+1)
+	react(post1, "smile", day+2)
+	react(post1, "tada", day+3)
+	react(post2, "smile", day+4)
+	react(post3, "tada", nextDay-1)
+	react(post3, "tada", nextDay)
+	removed := react(post2, "tada", day+5)
+	_, err = ss.Reaction().Delete(removed)
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ReactionSummary().SummarizeDay(day))
+
+	latest, err := ss.ReactionSummary().GetLatestDay()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, latest, day)
+
+	opts := model.ReactionAnalyticsOptions{TeamId: team.Id, Since: day, Until: nextDay, Limit: 10}
+
+	t.Run("top emojis of the team", func(t *testing.T) {
+		emojis, err := ss.ReactionSummary().GetTopEmojis(opts)
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ReactionEmojiCount{
+			{EmojiName: "smile", Count: 3},
+			{EmojiName: "tada", Count: 2},
+		}, emojis)
+	})
+
+	t.Run("top posts of a channel", func(t *testing.T) {
+		channelOpts := opts
+		channelOpts.TeamId = ""
+		channelOpts.ChannelId = channel1.Id
+		posts, err := ss.ReactionSummary().GetTopPosts(channelOpts)
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ReactionPostCount{
+			{PostId: post1.Id, ChannelId: channel1.Id, Count: 3},
+			{PostId: post2.Id, ChannelId: channel1.Id, Count: 1},
+		}, posts)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		limitOpts := opts
+		limitOpts.Limit = 1
+		posts, err := ss.ReactionSummary().GetTopPosts(limitOpts)
+		require.NoError(t, err)
+		require.Len(t, posts, 1)
+		assert.Equal(t, post1.Id, posts[0].PostId)
+	})
+
+	t.Run("summarizing a day again replaces its summaries", func(t *testing.T) {
+		react(post3, "tada", day+6)
+		require.NoError(t, ss.ReactionSummary().SummarizeDay(day))
+
+		emojis, err := ss.ReactionSummary().GetTopEmojis(opts)
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ReactionEmojiCount{
+			{EmojiName: "smile", Count: 3},
+			{EmojiName: "tada", Count: 3},
+		}, emojis)
+	})
+
+	t.Run("days outside of the range are ignored", func(t *testing.T) {
+		require.NoError(t, ss.ReactionSummary().SummarizeDay(nextDay))
+
+		emojis, err := ss.ReactionSummary().GetTopEmojis(opts)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), emojis[0].Count)
+
+		dayOpts := opts
+		dayOpts.Until = day
+		emojis, err = ss.ReactionSummary().GetTopEmojis(dayOpts)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), emojis[0].Count)
+	})
+}
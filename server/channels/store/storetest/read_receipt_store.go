@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestReadReceiptStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testReadReceiptSaveAndGet(t, ss) })
+	t.Run("GetForChannelSince", func(t *testing.T) { testReadReceiptGetForChannelSince(t, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testReadReceiptPermanentDeleteByUser(t, ss) })
+}
+
+func testReadReceiptSaveAndGet(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	userID := model.NewId()
+
+	_, err := ss.ReadReceipt().Get(channelID, userID)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.ReadReceipt().Save(&model.ReadReceipt{ChannelId: channelID, UserId: userID})
+	require.Error(t, err)
+
+	receipt := &model.ReadReceipt{
+		ChannelId:    channelID,
+		UserId:       userID,
+		PostId:       model.NewId(),
+		PostCreateAt: 2000,
+		ReadAt:       3000,
+	}
+	saved, err := ss.ReadReceipt().Save(receipt)
+	require.NoError(t, err)
+	assert.Equal(t, receipt, saved)
+
+	t.Run("receipts move forward", func(t *testing.T) {
+		newer := &model.ReadReceipt{
+			ChannelId:    channelID,
+			UserId:       userID,
+			PostId:       model.NewId(),
+			PostCreateAt: 2500,
+			ReadAt:       4000,
+		}
+		saved, err := ss.ReadReceipt().Save(newer)
+		require.NoError(t, err)
+		assert.Equal(t, newer, saved)
+
+		got, err := ss.ReadReceipt().Get(channelID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, newer, got)
+	})
+
+	t.Run("older receipts are ignored", func(t *testing.T) {
+		older := &model.ReadReceipt{
+			ChannelId:    channelID,
+			UserId:       userID,
+			PostId:       model.NewId(),
+			PostCreateAt: 1000,
+			ReadAt:       5000,
+		}
+		saved, err := ss.ReadReceipt().Save(older)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2500), saved.PostCreateAt)
+		assert.Equal(t, int64(4000), saved.ReadAt)
+		assert.NotEqual(t, older.PostId, saved.PostId)
+	})
+}
+
+func testReadReceiptGetForChannelSince(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	userIDs := []string{model.NewId(), model.NewId(), model.NewId()}
+
+	for i, userID := range userIDs {
+		_, err := ss.ReadReceipt().Save(&model.ReadReceipt{
+			ChannelId:    channelID,
+			UserId:       userID,
+			PostId:       model.NewId(),
+			PostCreateAt: int64(1000 * (i + 1)),
+			ReadAt:       int64(5000 - i),
+		})
+		require.NoError(t, err)
+	}
+	_, err := ss.ReadReceipt().Save(&model.ReadReceipt{
+		ChannelId:    model.NewId(),
+		UserId:       userIDs[0],
+		PostId:       model.NewId(),
+		PostCreateAt: 5000,
+		ReadAt:       5000,
+	})
+	require.NoError(t, err)
+
+	receipts, err := ss.ReadReceipt().GetForChannelSince(channelID, 2000)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	assert.Equal(t, userIDs[2], receipts[0].UserId)
+	assert.Equal(t, userIDs[1], receipts[1].UserId)
+
+	receipts, err = ss.ReadReceipt().GetForChannelSince(channelID, 4000)
+	require.NoError(t, err)
+	assert.Empty(t, receipts)
+}
+
+func testReadReceiptPermanentDeleteByUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	otherUserID := model.NewId()
+	channelID := model.NewId()
+
+	for _, userID := range []string{userID, otherUserID} {
+		_, err := ss.ReadReceipt().Save(&model.ReadReceipt{
+			ChannelId:    channelID,
+			UserId:       userID,
+			PostId:       model.NewId(),
+			PostCreateAt: 1000,
+			ReadAt:       1000,
+		})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, ss.ReadReceipt().PermanentDeleteByUser(userID))
+
+	_, err := ss.ReadReceipt().Get(channelID, userID)
+	require.Error(t, err)
+	_, err = ss.ReadReceipt().Get(channelID, otherUserID)
+	require.NoError(t, err)
+}
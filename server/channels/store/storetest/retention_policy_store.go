@@ -28,6 +28,7 @@ func TestRetentionPolicyStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("RemoveTeams", func(t *testing.T) { testRetentionPolicyStoreRemoveTeams(t, ss, s) })
 	t.Run("RemoveOrphanedRows", func(t *testing.T) { testRetentionPolicyStoreRemoveOrphanedRows(t, ss, s) })
 	t.Run("GetPoliciesForUser", func(t *testing.T) { testRetentionPolicyStoreGetPoliciesForUser(t, ss, s) })
+	t.Run("Preview", func(t *testing.T) { testRetentionPolicyStorePreview(t, ss, s) })
 }
 
 func getRetentionPolicyWithTeamAndChannelIds(t *testing.T, ss store.Store, policyID string) *model.RetentionPolicyWithTeamAndChannelIDs {
@@ -679,3 +680,68 @@ func testRetentionPolicyStoreRemoveOrphanedRows(t *testing.T, ss store.Store, s
 	policy.TeamIDs = make([]string, 0)
 	checkRetentionPolicyLikeThisExists(t, ss, policy)
 }
+
+func testRetentionPolicyStorePreview(t *testing.T, ss store.Store, s SqlStore) {
+	const millisecondsInADay = 24 * 60 * 60 * 1000
+	now := model.GetMillis()
+	old := now - 40*millisecondsInADay
+
+	teamIDs, channelIDs := createTeamsAndChannelsForRetentionPolicy(t, ss)
+	defer deleteTeamsAndChannels(ss, teamIDs, channelIDs)
+	defer cleanupRetentionPolicyTest(s)
+
+	// channelIDs[0] belongs to teamIDs[0], channelIDs[1] and channelIDs[2] belong to teamIDs[1].
+	savePost := func(channelID string, createAt int64) *model.Post {
+		post, err := ss.Post().Save(&model.Post{ChannelId: channelID, UserId: model.NewId(), CreateAt: createAt})
+		require.NoError(t, err)
+		return post
+	}
+	savePost(channelIDs[0], old)
+	savePost(channelIDs[0], now)
+	postWithFile := savePost(channelIDs[1], old)
+	savePost(channelIDs[2], old)
+
+	_, err := ss.FileInfo().Save(&model.FileInfo{
+		PostId:    postWithFile.Id,
+		ChannelId: channelIDs[1],
+		CreatorId: postWithFile.UserId,
+		Path:      "file.txt",
+	})
+	require.NoError(t, err)
+
+	otherPolicy := saveRetentionPolicyWithTeamAndChannelIds(t, ss, "Policy 1", nil, []string{channelIDs[2]})
+
+	t.Run("channels of another channel policy are left out of team scope", func(t *testing.T) {
+		policy := createRetentionPolicyWithTeamAndChannelIds("Policy 2", []string{teamIDs[1]}, []string{channelIDs[0]})
+		preview, err := ss.RetentionPolicy().Preview(policy, now)
+		require.NoError(t, err)
+		require.Equal(t, now-30*millisecondsInADay, preview.PostCutoff)
+		require.Equal(t, int64(2), preview.PostCount)
+		require.Equal(t, int64(1), preview.FileCount)
+	})
+
+	t.Run("own channels of an existing policy are included", func(t *testing.T) {
+		otherPolicy.TeamIDs = []string{teamIDs[1]}
+		otherPolicy.ChannelIDs = nil
+		preview, err := ss.RetentionPolicy().Preview(otherPolicy, now)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), preview.PostCount)
+	})
+
+	t.Run("posts within the duration are kept", func(t *testing.T) {
+		policy := createRetentionPolicyWithTeamAndChannelIds("Policy 2", nil, []string{channelIDs[0]})
+		policy.PostDurationDays = model.NewInt64(50)
+		preview, err := ss.RetentionPolicy().Preview(policy, now)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), preview.PostCount)
+	})
+
+	t.Run("unlimited duration", func(t *testing.T) {
+		policy := createRetentionPolicyWithTeamAndChannelIds("Policy 2", teamIDs, channelIDs)
+		policy.PostDurationDays = model.NewInt64(-1)
+		preview, err := ss.RetentionPolicy().Preview(policy, now)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), preview.PostCount)
+		require.Equal(t, int64(0), preview.FileCount)
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestRoleSnapshotStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testRoleSnapshotSaveAndGet(t, ss) })
+	t.Run("GetForRole", func(t *testing.T) { testRoleSnapshotGetForRole(t, ss) })
+	t.Run("PermanentDeleteByRole", func(t *testing.T) { testRoleSnapshotPermanentDeleteByRole(t, ss) })
+}
+
+func testRoleSnapshotSaveAndGet(t *testing.T, ss store.Store) {
+	_, err := ss.RoleSnapshot().Save(&model.RoleSnapshot{RoleId: "invalid"})
+	require.Error(t, err)
+
+	snapshot, err := ss.RoleSnapshot().Save(&model.RoleSnapshot{
+		RoleId:      model.NewId(),
+		Permissions: []string{model.PermissionCreatePost.Id, model.PermissionAddReaction.Id},
+	})
+	require.NoError(t, err)
+	require.True(t, model.IsValidId(snapshot.Id))
+
+	received, err := ss.RoleSnapshot().Get(snapshot.Id)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, received)
+
+	_, err = ss.RoleSnapshot().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testRoleSnapshotGetForRole(t *testing.T, ss store.Store) {
+	roleID := model.NewId()
+	createAt := model.GetMillis()
+	for i := 0; i < 3; i++ {
+		_, err := ss.RoleSnapshot().Save(&model.RoleSnapshot{
+			RoleId:      roleID,
+			Permissions: []string{model.PermissionCreatePost.Id},
+			CreateAt:    createAt + int64(i),
+		})
+		require.NoError(t, err)
+	}
+	_, err := ss.RoleSnapshot().Save(&model.RoleSnapshot{RoleId: model.NewId()})
+	require.NoError(t, err)
+
+	snapshots, err := ss.RoleSnapshot().GetForRole(roleID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	assert.Equal(t, createAt+2, snapshots[0].CreateAt)
+	assert.Equal(t, createAt, snapshots[2].CreateAt)
+
+	snapshots, err = ss.RoleSnapshot().GetForRole(roleID, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, createAt, snapshots[0].CreateAt)
+}
+
+func testRoleSnapshotPermanentDeleteByRole(t *testing.T, ss store.Store) {
+	roleID := model.NewId()
+	_, err := ss.RoleSnapshot().Save(&model.RoleSnapshot{RoleId: roleID})
+	require.NoError(t, err)
+	other, err := ss.RoleSnapshot().Save(&model.RoleSnapshot{RoleId: model.NewId()})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.RoleSnapshot().PermanentDeleteByRole(roleID))
+
+	snapshots, err := ss.RoleSnapshot().GetForRole(roleID, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, snapshots)
+
+	_, err = ss.RoleSnapshot().Get(other.Id)
+	require.NoError(t, err)
+}
@@ -98,6 +98,31 @@ func testRoleStoreSave(t *testing.T, ss store.Store) {
 
 	_, err = ss.Role().Save(r4)
 	assert.Error(t, err)
+
+	// Save a custom admin role restricted to teams.
+	r5 := &model.Role{
+		Name:        model.NewId(),
+		DisplayName: model.NewId(),
+		Description: model.NewId(),
+		Permissions: []string{
+			"add_user_to_team",
+		},
+		TeamIds: []string{model.NewId(), model.NewId()},
+	}
+
+	d5, err := ss.Role().Save(r5)
+	require.NoError(t, err)
+	assert.Equal(t, r5.TeamIds, d5.TeamIds)
+
+	d5.TeamIds = []string{r5.TeamIds[0]}
+	d6, err := ss.Role().Save(d5)
+	require.NoError(t, err)
+	assert.Equal(t, d5.TeamIds, d6.TeamIds)
+
+	roles, err := ss.Role().GetByNames([]string{r5.Name})
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	assert.Equal(t, d5.TeamIds, roles[0].TeamIds)
 }
 
 func testRoleStoreGetAll(t *testing.T, ss store.Store) {
@@ -0,0 +1,146 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestScheduledPostStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testScheduledPostSaveAndGet(t, ss) })
+	t.Run("Update", func(t *testing.T) { testScheduledPostUpdate(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testScheduledPostDelete(t, ss) })
+	t.Run("GetForUser", func(t *testing.T) { testScheduledPostGetForUser(t, ss) })
+	t.Run("GetPendingBefore", func(t *testing.T) { testScheduledPostGetPendingBefore(t, ss) })
+}
+
+func newTestScheduledPost(userID string, scheduledAt int64) *model.ScheduledPost {
+	return &model.ScheduledPost{
+		UserId:      userID,
+		ChannelId:   model.NewId(),
+		Message:     "message " + model.NewId(),
+		ScheduledAt: scheduledAt,
+	}
+}
+
+func testScheduledPostSaveAndGet(t *testing.T, ss store.Store) {
+	scheduledPost := newTestScheduledPost(model.NewId(), model.GetMillis()+60000)
+	scheduledPost.FileIds = []string{"file1", "file1"}
+	scheduledPost.SetProps(model.StringInterface{"key": "value"})
+
+	saved, err := ss.ScheduledPost().Save(scheduledPost)
+	require.NoError(t, err)
+	require.NotEmpty(t, saved.Id)
+	assert.NotZero(t, saved.CreateAt)
+	assert.Equal(t, []string{"file1"}, []string(saved.FileIds))
+
+	t.Run("get", func(t *testing.T) {
+		received, err := ss.ScheduledPost().Get(saved.Id)
+		require.NoError(t, err)
+		assert.Equal(t, saved.Message, received.Message)
+		assert.Equal(t, saved.ScheduledAt, received.ScheduledAt)
+		assert.Equal(t, "value", received.GetProps()["key"])
+		assert.True(t, received.IsPending())
+	})
+
+	t.Run("get not found", func(t *testing.T) {
+		_, err := ss.ScheduledPost().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.ScheduledPost().Save(&model.ScheduledPost{UserId: model.NewId(), ChannelId: model.NewId(), ScheduledAt: 1})
+		require.Error(t, err)
+	})
+}
+
+func testScheduledPostUpdate(t *testing.T, ss store.Store) {
+	saved, err := ss.ScheduledPost().Save(newTestScheduledPost(model.NewId(), model.GetMillis()+60000))
+	require.NoError(t, err)
+
+	saved.Message = "updated"
+	saved.ScheduledAt += 60000
+	_, err = ss.ScheduledPost().Update(saved)
+	require.NoError(t, err)
+
+	received, err := ss.ScheduledPost().Get(saved.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", received.Message)
+	assert.Equal(t, saved.ScheduledAt, received.ScheduledAt)
+	assert.GreaterOrEqual(t, received.UpdateAt, received.CreateAt)
+}
+
+func testScheduledPostDelete(t *testing.T, ss store.Store) {
+	saved, err := ss.ScheduledPost().Save(newTestScheduledPost(model.NewId(), model.GetMillis()+60000))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ScheduledPost().Delete(saved.Id))
+
+	_, err = ss.ScheduledPost().Get(saved.Id)
+	require.Error(t, err)
+}
+
+func testScheduledPostGetForUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	now := model.GetMillis()
+
+	later, err := ss.ScheduledPost().Save(newTestScheduledPost(userID, now+120000))
+	require.NoError(t, err)
+	sooner, err := ss.ScheduledPost().Save(newTestScheduledPost(userID, now+60000))
+	require.NoError(t, err)
+	failed := newTestScheduledPost(userID, now-60000)
+	failed.ProcessedAt = now
+	failed.ErrorCode = model.ScheduledPostErrorNoChannelMember
+	_, err = ss.ScheduledPost().Save(failed)
+	require.NoError(t, err)
+	_, err = ss.ScheduledPost().Save(newTestScheduledPost(model.NewId(), now+60000))
+	require.NoError(t, err)
+
+	scheduledPosts, err := ss.ScheduledPost().GetForUser(userID)
+	require.NoError(t, err)
+	require.Len(t, scheduledPosts, 3)
+	assert.Equal(t, failed.Id, scheduledPosts[0].Id)
+	assert.Equal(t, sooner.Id, scheduledPosts[1].Id)
+	assert.Equal(t, later.Id, scheduledPosts[2].Id)
+
+	count, err := ss.ScheduledPost().CountPendingForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func testScheduledPostGetPendingBefore(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	now := model.GetMillis()
+
+	due, err := ss.ScheduledPost().Save(newTestScheduledPost(userID, now-1000))
+	require.NoError(t, err)
+	_, err = ss.ScheduledPost().Save(newTestScheduledPost(userID, now+3600000))
+	require.NoError(t, err)
+	processed := newTestScheduledPost(userID, now-2000)
+	processed.ProcessedAt = now
+	processed.ErrorCode = model.ScheduledPostErrorUnknown
+	_, err = ss.ScheduledPost().Save(processed)
+	require.NoError(t, err)
+
+	scheduledPosts, err := ss.ScheduledPost().GetPendingBefore(now, 1000)
+	require.NoError(t, err)
+
+	var ids []string
+	for _, scheduledPost := range scheduledPosts {
+		ids = append(ids, scheduledPost.Id)
+	}
+	assert.Contains(t, ids, due.Id)
+	assert.NotContains(t, ids, processed.Id)
+	for _, scheduledPost := range scheduledPosts {
+		assert.LessOrEqual(t, scheduledPost.ScheduledAt, now)
+	}
+}
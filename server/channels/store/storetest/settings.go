@@ -175,6 +175,9 @@ func databaseSettings(driver, dataSource string) *model.SqlSettings {
 		Trace:                             model.NewBool(false),
 		AtRestEncryptKey:                  model.NewString(model.NewRandomString(32)),
 		QueryTimeout:                      new(int),
+		SearchQueryTimeout:                new(int),
+		AnalyticsQueryTimeout:             new(int),
+		SlowQueryThresholdMilliseconds:    new(int),
 		MigrationsStatementTimeoutSeconds: new(int),
 	}
 	*settings.MaxIdleConns = 10
@@ -182,6 +185,8 @@ func databaseSettings(driver, dataSource string) *model.SqlSettings {
 	*settings.ConnMaxIdleTimeMilliseconds = 300000
 	*settings.MaxOpenConns = 100
 	*settings.QueryTimeout = 60
+	*settings.SearchQueryTimeout = 60
+	*settings.AnalyticsQueryTimeout = 60
 	*settings.MigrationsStatementTimeoutSeconds = 10
 
 	return settings
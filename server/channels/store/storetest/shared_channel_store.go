@@ -358,6 +358,20 @@ func testUpdateSharedChannel(t *testing.T, ss store.Store) {
 		require.Equal(t, id, scUpdated.RemoteId)
 	})
 
+	t.Run("Update filters of shared channel", func(t *testing.T) {
+		scMod := scSaved
+		scMod.StripAttachments = true
+		scMod.RedactPatterns = model.StringArray{`\d{4}-\d{4}`, "secret"}
+
+		_, err := ss.SharedChannel().Update(scMod)
+		require.NoError(t, err, "couldn't update shared channel", err)
+
+		scFetched, err := ss.SharedChannel().Get(scMod.ChannelId)
+		require.NoError(t, err)
+		require.True(t, scFetched.StripAttachments)
+		require.Equal(t, model.StringArray{`\d{4}-\d{4}`, "secret"}, scFetched.RedactPatterns)
+	})
+
 	t.Run("Update non-existent shared channel", func(t *testing.T) {
 		sc := &model.SharedChannel{
 			ChannelId: model.NewId(),
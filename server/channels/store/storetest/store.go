@@ -60,6 +60,25 @@ type Store struct {
 	PostPriorityStore         mocks.PostPriorityStore
 	PostAcknowledgementStore  mocks.PostAcknowledgementStore
 	TrueUpReviewStore         mocks.TrueUpReviewStore
+	ScheduledPostStore        mocks.ScheduledPostStore
+	PostTranslationStore      mocks.PostTranslationStore
+	PostEmbeddingStore        mocks.PostEmbeddingStore
+	ActivityStore             mocks.ActivityStore
+	ReadReceiptStore          mocks.ReadReceiptStore
+	ReactionSummaryStore      mocks.ReactionSummaryStore
+	MatrixBridgeStore         mocks.MatrixBridgeStore
+	ChannelBookmarkStore      mocks.ChannelBookmarkStore
+	PostExpirationStore       mocks.PostExpirationStore
+	WebAuthnCredentialStore   mocks.WebAuthnCredentialStore
+	RoleSnapshotStore         mocks.RoleSnapshotStore
+	GuestSponsorshipStore     mocks.GuestSponsorshipStore
+	ChannelMemberExpiryStore  mocks.ChannelMemberExpiryStore
+	ChannelJoinRequestStore   mocks.ChannelJoinRequestStore
+	TeamTemplateStore         mocks.TeamTemplateStore
+	TeamArchiveStore          mocks.TeamArchiveStore
+	SupportAccessConsentStore mocks.SupportAccessConsentStore
+	ConfigChangeStore         mocks.ConfigChangeStore
+	ArchivedPostStore         mocks.ArchivedPostStore
 }
 
 func (s *Store) SetContext(context context.Context)                { s.context = context }
@@ -101,15 +120,62 @@ func (s *Store) Draft() store.DraftStore                           { return &s.D
 func (s *Store) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	return &s.ChannelMemberHistoryStore
 }
-func (s *Store) TrueUpReview() store.TrueUpReviewStore   { return &s.TrueUpReviewStore }
-func (s *Store) NotifyAdmin() store.NotifyAdminStore     { return &s.NotifyAdminStore }
-func (s *Store) Group() store.GroupStore                 { return &s.GroupStore }
-func (s *Store) LinkMetadata() store.LinkMetadataStore   { return &s.LinkMetadataStore }
-func (s *Store) SharedChannel() store.SharedChannelStore { return &s.SharedChannelStore }
-func (s *Store) PostPriority() store.PostPriorityStore   { return &s.PostPriorityStore }
+func (s *Store) TrueUpReview() store.TrueUpReviewStore       { return &s.TrueUpReviewStore }
+func (s *Store) ScheduledPost() store.ScheduledPostStore     { return &s.ScheduledPostStore }
+func (s *Store) PostTranslation() store.PostTranslationStore { return &s.PostTranslationStore }
+func (s *Store) PostEmbedding() store.PostEmbeddingStore     { return &s.PostEmbeddingStore }
+func (s *Store) Activity() store.ActivityStore               { return &s.ActivityStore }
+func (s *Store) ReadReceipt() store.ReadReceiptStore         { return &s.ReadReceiptStore }
+func (s *Store) ReactionSummary() store.ReactionSummaryStore { return &s.ReactionSummaryStore }
+func (s *Store) MatrixBridge() store.MatrixBridgeStore       { return &s.MatrixBridgeStore }
+func (s *Store) ChannelBookmark() store.ChannelBookmarkStore { return &s.ChannelBookmarkStore }
+func (s *Store) PostExpiration() store.PostExpirationStore   { return &s.PostExpirationStore }
+func (s *Store) NotifyAdmin() store.NotifyAdminStore         { return &s.NotifyAdminStore }
+func (s *Store) Group() store.GroupStore                     { return &s.GroupStore }
+func (s *Store) LinkMetadata() store.LinkMetadataStore       { return &s.LinkMetadataStore }
+func (s *Store) SharedChannel() store.SharedChannelStore     { return &s.SharedChannelStore }
+func (s *Store) PostPriority() store.PostPriorityStore       { return &s.PostPriorityStore }
 func (s *Store) PostAcknowledgement() store.PostAcknowledgementStore {
 	return &s.PostAcknowledgementStore
 }
+func (s *Store) WebAuthnCredential() store.WebAuthnCredentialStore {
+	return &s.WebAuthnCredentialStore
+}
+func (s *Store) RoleSnapshot() store.RoleSnapshotStore {
+	return &s.RoleSnapshotStore
+}
+
+func (s *Store) GuestSponsorship() store.GuestSponsorshipStore {
+	return &s.GuestSponsorshipStore
+}
+
+func (s *Store) ChannelMemberExpiry() store.ChannelMemberExpiryStore {
+	return &s.ChannelMemberExpiryStore
+}
+
+func (s *Store) ChannelJoinRequest() store.ChannelJoinRequestStore {
+	return &s.ChannelJoinRequestStore
+}
+
+func (s *Store) TeamTemplate() store.TeamTemplateStore {
+	return &s.TeamTemplateStore
+}
+
+func (s *Store) TeamArchive() store.TeamArchiveStore {
+	return &s.TeamArchiveStore
+}
+
+func (s *Store) SupportAccessConsent() store.SupportAccessConsentStore {
+	return &s.SupportAccessConsentStore
+}
+
+func (s *Store) ConfigChange() store.ConfigChangeStore {
+	return &s.ConfigChangeStore
+}
+
+func (s *Store) ArchivedPost() store.ArchivedPostStore {
+	return &s.ArchivedPostStore
+}
 func (s *Store) MarkSystemRanUnitTests()            { /* do nothing */ }
 func (s *Store) Close()                             { /* do nothing */ }
 func (s *Store) LockToMaster()                      { /* do nothing */ }
@@ -124,6 +190,22 @@ func (s *Store) GetDBSchemaVersion() (int, error)   { return 1, nil }
 func (s *Store) GetAppliedMigrations() ([]model.AppliedMigration, error) {
 	return []model.AppliedMigration{}, nil
 }
+
+func (s *Store) GetOnlineMigrationsStatus() ([]*model.OnlineMigrationStatus, error) {
+	return []*model.OnlineMigrationStatus{}, nil
+}
+
+func (s *Store) GetTablePartitions() ([]*model.TablePartition, error) {
+	return []*model.TablePartition{}, nil
+}
+
+func (s *Store) CreateTablePartitions(time.Time) ([]*model.TablePartition, error) {
+	return []*model.TablePartition{}, nil
+}
+
+func (s *Store) DropTablePartitions(int64) ([]*model.TablePartition, error) {
+	return []*model.TablePartition{}, nil
+}
 func (s *Store) TotalMasterDbConnections() int { return 1 }
 func (s *Store) TotalReadDbConnections() int   { return 1 }
 func (s *Store) TotalSearchDbConnections() int { return 1 }
@@ -171,5 +253,24 @@ func (s *Store) AssertExpectations(t mock.TestingT) bool {
 		&s.NotifyAdminStore,
 		&s.PostPriorityStore,
 		&s.PostAcknowledgementStore,
+		&s.ScheduledPostStore,
+		&s.PostTranslationStore,
+		&s.PostEmbeddingStore,
+		&s.ActivityStore,
+		&s.ReadReceiptStore,
+		&s.ReactionSummaryStore,
+		&s.MatrixBridgeStore,
+		&s.ChannelBookmarkStore,
+		&s.PostExpirationStore,
+		&s.WebAuthnCredentialStore,
+		&s.RoleSnapshotStore,
+		&s.GuestSponsorshipStore,
+		&s.ChannelMemberExpiryStore,
+		&s.ChannelJoinRequestStore,
+		&s.TeamTemplateStore,
+		&s.TeamArchiveStore,
+		&s.SupportAccessConsentStore,
+		&s.ConfigChangeStore,
+		&s.ArchivedPostStore,
 	)
 }
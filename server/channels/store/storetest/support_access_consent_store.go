@@ -0,0 +1,127 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestSupportAccessConsentStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGetForUser", func(t *testing.T) { testSupportAccessConsentSaveAndGetForUser(t, ss) })
+	t.Run("GetActive", func(t *testing.T) { testSupportAccessConsentGetActive(t, ss) })
+	t.Run("RevokeForUser", func(t *testing.T) { testSupportAccessConsentRevokeForUser(t, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testSupportAccessConsentPermanentDeleteByUser(t, ss) })
+}
+
+func newTestSupportAccessConsent(userID, adminID string, expiresIn int64) *model.SupportAccessConsent {
+	now := model.GetMillis()
+	return &model.SupportAccessConsent{
+		UserId:    userID,
+		AdminId:   adminID,
+		CreateAt:  now,
+		ExpiresAt: now + expiresIn,
+	}
+}
+
+func testSupportAccessConsentSaveAndGetForUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+
+	_, err := ss.SupportAccessConsent().Save(newTestSupportAccessConsent(userID, userID, 60000))
+	require.Error(t, err, "a user can't consent to themselves")
+
+	consent, err := ss.SupportAccessConsent().Save(newTestSupportAccessConsent(userID, model.NewId(), 60000))
+	require.NoError(t, err)
+	require.NotEmpty(t, consent.Id)
+
+	_, err = ss.SupportAccessConsent().Save(consent)
+	var invErr *store.ErrInvalidInput
+	require.True(t, errors.As(err, &invErr), "a saved consent can't be saved again")
+
+	consents, err := ss.SupportAccessConsent().GetForUser(userID)
+	require.NoError(t, err)
+	require.Len(t, consents, 1)
+	assert.Equal(t, consent, consents[0])
+
+	consents, err = ss.SupportAccessConsent().GetForUser(model.NewId())
+	require.NoError(t, err)
+	assert.Empty(t, consents)
+}
+
+func testSupportAccessConsentGetActive(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	adminID := model.NewId()
+
+	expired := newTestSupportAccessConsent(userID, adminID, 60000)
+	expired.CreateAt -= 120000
+	expired.ExpiresAt -= 120000
+	_, err := ss.SupportAccessConsent().Save(expired)
+	require.NoError(t, err)
+
+	_, err = ss.SupportAccessConsent().GetActive(userID, adminID, model.GetMillis())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr), "expired consents aren't active")
+
+	consent, err := ss.SupportAccessConsent().Save(newTestSupportAccessConsent(userID, adminID, 60000))
+	require.NoError(t, err)
+
+	active, err := ss.SupportAccessConsent().GetActive(userID, adminID, model.GetMillis())
+	require.NoError(t, err)
+	assert.Equal(t, consent.Id, active.Id)
+
+	_, err = ss.SupportAccessConsent().GetActive(userID, model.NewId(), model.GetMillis())
+	require.True(t, errors.As(err, &nfErr), "the consent is only for the admin it was granted to")
+}
+
+func testSupportAccessConsentRevokeForUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	adminID := model.NewId()
+
+	consent, err := ss.SupportAccessConsent().Save(newTestSupportAccessConsent(userID, adminID, 60000))
+	require.NoError(t, err)
+
+	revokedAt := model.GetMillis()
+	require.NoError(t, ss.SupportAccessConsent().RevokeForUser(userID, revokedAt))
+
+	_, err = ss.SupportAccessConsent().GetActive(userID, adminID, model.GetMillis())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	consents, err := ss.SupportAccessConsent().GetForUser(userID)
+	require.NoError(t, err)
+	require.Len(t, consents, 1)
+	assert.Equal(t, consent.Id, consents[0].Id)
+	assert.Equal(t, revokedAt, consents[0].RevokedAt)
+}
+
+func testSupportAccessConsentPermanentDeleteByUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	otherUserID := model.NewId()
+	adminID := model.NewId()
+
+	_, err := ss.SupportAccessConsent().Save(newTestSupportAccessConsent(userID, adminID, 60000))
+	require.NoError(t, err)
+	_, err = ss.SupportAccessConsent().Save(newTestSupportAccessConsent(otherUserID, adminID, 60000))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.SupportAccessConsent().PermanentDeleteByUser(userID))
+	consents, err := ss.SupportAccessConsent().GetForUser(userID)
+	require.NoError(t, err)
+	assert.Empty(t, consents)
+
+	consents, err = ss.SupportAccessConsent().GetForUser(otherUserID)
+	require.NoError(t, err)
+	assert.Len(t, consents, 1)
+
+	require.NoError(t, ss.SupportAccessConsent().PermanentDeleteByUser(adminID))
+	consents, err = ss.SupportAccessConsent().GetForUser(otherUserID)
+	require.NoError(t, err)
+	assert.Empty(t, consents, "the consents granted to a deleted admin are deleted too")
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestTeamArchiveStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testTeamArchiveSaveAndGet(t, ss) })
+	t.Run("Update", func(t *testing.T) { testTeamArchiveUpdate(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testTeamArchiveDelete(t, ss) })
+}
+
+func testTeamArchiveSaveAndGet(t *testing.T, ss store.Store) {
+	_, err := ss.TeamArchive().Save(&model.TeamArchive{TeamId: model.NewId(), ArchiverId: "invalid"})
+	require.Error(t, err)
+
+	archive, err := ss.TeamArchive().Save(&model.TeamArchive{
+		TeamId:     model.NewId(),
+		ArchiverId: model.NewId(),
+		JobId:      model.NewId(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.TeamArchiveStatusArchiving, archive.Status)
+
+	received, err := ss.TeamArchive().Get(archive.TeamId)
+	require.NoError(t, err)
+	assert.Equal(t, archive, received)
+
+	_, err = ss.TeamArchive().Save(&model.TeamArchive{TeamId: archive.TeamId, ArchiverId: model.NewId()})
+	var invErr *store.ErrInvalidInput
+	require.True(t, errors.As(err, &invErr), "a team can only be archived once")
+
+	_, err = ss.TeamArchive().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testTeamArchiveUpdate(t *testing.T, ss store.Store) {
+	archive, err := ss.TeamArchive().Save(&model.TeamArchive{TeamId: model.NewId(), ArchiverId: model.NewId()})
+	require.NoError(t, err)
+
+	archive.Status = model.TeamArchiveStatusArchived
+	archive.SuspendedJobIds = model.StringArray{model.NewId(), model.NewId()}
+	updated, err := ss.TeamArchive().Update(archive)
+	require.NoError(t, err)
+
+	received, err := ss.TeamArchive().Get(archive.TeamId)
+	require.NoError(t, err)
+	assert.Equal(t, updated, received)
+
+	archive.Status = "unknown"
+	_, err = ss.TeamArchive().Update(archive)
+	require.Error(t, err)
+
+	_, err = ss.TeamArchive().Update(&model.TeamArchive{TeamId: model.NewId(), ArchiverId: model.NewId(), Status: model.TeamArchiveStatusArchived, ArchivedAt: 1})
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testTeamArchiveDelete(t *testing.T, ss store.Store) {
+	archive, err := ss.TeamArchive().Save(&model.TeamArchive{TeamId: model.NewId(), ArchiverId: model.NewId()})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.TeamArchive().Delete(archive.TeamId))
+
+	_, err = ss.TeamArchive().Get(archive.TeamId)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestTeamTemplateStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testTeamTemplateSaveAndGet(t, ss) })
+	t.Run("Update", func(t *testing.T) { testTeamTemplateUpdate(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testTeamTemplateGetAll(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testTeamTemplateDelete(t, ss) })
+}
+
+func newTestTeamTemplate(displayName string) *model.TeamTemplate {
+	return &model.TeamTemplate{
+		DisplayName: displayName,
+		CreatorId:   model.NewId(),
+		Content: model.TeamTemplateContent{
+			Channels: []*model.TeamTemplateChannel{
+				{Name: "announcements", DisplayName: "Announcements", Type: model.ChannelTypeOpen},
+			},
+			Categories: []*model.TeamTemplateCategory{
+				{DisplayName: "Team", Channels: []string{"announcements"}},
+			},
+		},
+	}
+}
+
+func testTeamTemplateSaveAndGet(t *testing.T, ss store.Store) {
+	_, err := ss.TeamTemplate().Save(&model.TeamTemplate{Id: model.NewId(), DisplayName: "Template", CreatorId: model.NewId()})
+	require.Error(t, err)
+
+	_, err = ss.TeamTemplate().Save(&model.TeamTemplate{DisplayName: "", CreatorId: model.NewId()})
+	require.Error(t, err)
+
+	template, err := ss.TeamTemplate().Save(newTestTeamTemplate("Template"))
+	require.NoError(t, err)
+
+	received, err := ss.TeamTemplate().Get(template.Id)
+	require.NoError(t, err)
+	assert.Equal(t, template, received)
+
+	_, err = ss.TeamTemplate().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testTeamTemplateUpdate(t *testing.T, ss store.Store) {
+	template, err := ss.TeamTemplate().Save(newTestTeamTemplate("Template"))
+	require.NoError(t, err)
+
+	template.DisplayName = "Renamed"
+	template.Content.Channels = append(template.Content.Channels, &model.TeamTemplateChannel{Name: "support", DisplayName: "Support", Type: model.ChannelTypePrivate})
+	updated, err := ss.TeamTemplate().Update(template)
+	require.NoError(t, err)
+
+	received, err := ss.TeamTemplate().Get(template.Id)
+	require.NoError(t, err)
+	assert.Equal(t, updated, received)
+
+	_, err = ss.TeamTemplate().Update(&model.TeamTemplate{Id: model.NewId(), DisplayName: "Template", CreatorId: model.NewId(), CreateAt: 1})
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testTeamTemplateGetAll(t *testing.T, ss store.Store) {
+	second, err := ss.TeamTemplate().Save(newTestTeamTemplate("zz template " + model.NewId()))
+	require.NoError(t, err)
+	first, err := ss.TeamTemplate().Save(newTestTeamTemplate("zz template " + second.DisplayName))
+	require.NoError(t, err)
+	deleted, err := ss.TeamTemplate().Save(newTestTeamTemplate("zz template"))
+	require.NoError(t, err)
+	require.NoError(t, ss.TeamTemplate().Delete(deleted.Id, model.GetMillis()))
+
+	templates, err := ss.TeamTemplate().GetAll(0, 1000)
+	require.NoError(t, err)
+
+	var ids []string
+	for _, template := range templates {
+		if template.Id == first.Id || template.Id == second.Id || template.Id == deleted.Id {
+			ids = append(ids, template.Id)
+		}
+	}
+	assert.Equal(t, []string{first.Id, second.Id}, ids)
+}
+
+func testTeamTemplateDelete(t *testing.T, ss store.Store) {
+	template, err := ss.TeamTemplate().Save(newTestTeamTemplate("Template"))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.TeamTemplate().Delete(template.Id, model.GetMillis()))
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.TeamTemplate().Get(template.Id)
+	require.True(t, errors.As(err, &nfErr))
+
+	err = ss.TeamTemplate().Delete(template.Id, model.GetMillis())
+	require.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.TeamTemplate().Update(template)
+	require.True(t, errors.As(err, &nfErr))
+}
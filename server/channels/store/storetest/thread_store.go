@@ -29,6 +29,7 @@ func TestThreadStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("MarkAllAsReadByChannels", func(t *testing.T) { testMarkAllAsReadByChannels(t, ss) })
 	t.Run("GetTopThreads", func(t *testing.T) { testGetTopThreads(t, ss) })
 	t.Run("MarkAllAsReadByTeam", func(t *testing.T) { testMarkAllAsReadByTeam(t, ss) })
+	t.Run("GetInboxThreadsForUser", func(t *testing.T) { testGetInboxThreadsForUser(t, ss) })
 }
 
 func testThreadStorePopulation(t *testing.T, ss store.Store) {
@@ -1204,6 +1205,91 @@ func testVarious(t *testing.T, ss store.Store) {
 	})
 }
 
+func testGetInboxThreadsForUser(t *testing.T, ss store.Store) {
+	postingUserID := model.NewId()
+	userID := model.NewId()
+
+	team, err := ss.Team().Save(&model.Team{
+		DisplayName: "Team1",
+		Name:        "team" + model.NewId(),
+		Email:       MakeEmail(),
+		Type:        model.TeamOpen,
+	})
+	require.NoError(t, err)
+
+	saveChannel := func(member bool) *model.Channel {
+		channel, err := ss.Channel().Save(&model.Channel{
+			TeamId:      team.Id,
+			DisplayName: "Channel",
+			Name:        "channel" + model.NewId(),
+			Type:        model.ChannelTypeOpen,
+		}, -1)
+		require.NoError(t, err)
+
+		if member {
+			_, err = ss.Channel().SaveMember(&model.ChannelMember{
+				ChannelId:   channel.Id,
+				UserId:      userID,
+				NotifyProps: model.GetDefaultChannelNotifyProps(),
+			})
+			require.NoError(t, err)
+		}
+		return channel
+	}
+	channel := saveChannel(true)
+	leftChannel := saveChannel(false)
+
+	saveThread := func(channelID string, createAt int64) *model.Post {
+		root, err := ss.Post().Save(&model.Post{
+			ChannelId: channelID,
+			UserId:    postingUserID,
+			Message:   "Root",
+			CreateAt:  createAt,
+		})
+		require.NoError(t, err)
+
+		_, err = ss.Post().Save(&model.Post{
+			ChannelId: channelID,
+			UserId:    postingUserID,
+			RootId:    root.Id,
+			Message:   "Reply",
+			CreateAt:  createAt + 1,
+		})
+		require.NoError(t, err)
+
+		_, err = ss.Thread().MaintainMembership(userID, root.Id, store.ThreadMembershipOpts{
+			Following:       true,
+			UpdateFollowing: true,
+		})
+		require.NoError(t, err)
+		return root
+	}
+
+	older := saveThread(channel.Id, 1000)
+	newer := saveThread(channel.Id, 2000)
+	saveThread(leftChannel.Id, 3000)
+
+	options := model.GetInboxOptions{PerPage: 1}
+	items, err := ss.Thread().GetInboxThreadsForUser(userID, options)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, newer.Id, items[0].Id)
+	assert.Equal(t, model.InboxItemTypeThread, items[0].Type)
+	assert.Equal(t, channel.Id, items[0].ChannelId)
+	assert.Equal(t, team.Id, items[0].TeamId)
+
+	options.Cursor = items[0].Cursor()
+	items, err = ss.Thread().GetInboxThreadsForUser(userID, options)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, older.Id, items[0].Id)
+
+	options.Cursor = items[0].Cursor()
+	items, err = ss.Thread().GetInboxThreadsForUser(userID, options)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
+
 func testMarkAllAsReadByChannels(t *testing.T, ss store.Store) {
 	postingUserId := model.NewId()
 	userAID := model.NewId()
@@ -50,6 +50,7 @@ func TestUserStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("UpdateFailedPasswordAttempts", func(t *testing.T) { testUserStoreUpdateFailedPasswordAttempts(t, ss) })
 	t.Run("Get", func(t *testing.T) { testUserStoreGet(t, ss) })
 	t.Run("GetAllUsingAuthService", func(t *testing.T) { testGetAllUsingAuthService(t, ss) })
+	t.Run("GetPageUsingAuthService", func(t *testing.T) { testGetPageUsingAuthService(t, ss) })
 	t.Run("GetAllProfiles", func(t *testing.T) { testUserStoreGetAllProfiles(t, ss) })
 	t.Run("GetProfiles", func(t *testing.T) { testUserStoreGetProfiles(t, ss) })
 	t.Run("GetProfilesInChannel", func(t *testing.T) { testUserStoreGetProfilesInChannel(t, ss) })
@@ -405,6 +406,76 @@ func testGetAllUsingAuthService(t *testing.T, ss store.Store) {
 	})
 }
 
+func testGetPageUsingAuthService(t *testing.T, ss store.Store) {
+	authService := "service" + model.NewId()
+
+	u1, err := ss.User().Save(&model.User{
+		Email:       MakeEmail(),
+		Username:    "u1" + model.NewId(),
+		AuthService: authService,
+		AuthData:    model.NewString("u1" + model.NewId()),
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(u1.Id)) }()
+
+	u2, err := ss.User().Save(&model.User{
+		Email:       MakeEmail(),
+		Username:    "u2" + model.NewId(),
+		AuthService: authService,
+		AuthData:    model.NewString("u2" + model.NewId()),
+		DeleteAt:    model.GetMillis(),
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(u2.Id)) }()
+
+	u3, err := ss.User().Save(&model.User{
+		Email:       MakeEmail(),
+		Username:    "u3" + model.NewId(),
+		AuthService: "other" + model.NewId(),
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(u3.Id)) }()
+
+	t.Run("pages", func(t *testing.T) {
+		users, total, err := ss.User().GetPageUsingAuthService(authService, model.UserGetByAuthServiceOptions{Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, []*model.User{u1}, users)
+
+		users, total, err = ss.User().GetPageUsingAuthService(authService, model.UserGetByAuthServiceOptions{Offset: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, []*model.User{u2}, users)
+
+		users, total, err = ss.User().GetPageUsingAuthService(authService, model.UserGetByAuthServiceOptions{Offset: 2, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Empty(t, users)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		users, total, err := ss.User().GetPageUsingAuthService(authService, model.UserGetByAuthServiceOptions{AuthData: *u2.AuthData, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, []*model.User{u2}, users)
+
+		users, total, err = ss.User().GetPageUsingAuthService(authService, model.UserGetByAuthServiceOptions{Email: strings.ToUpper(u1.Email), Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, []*model.User{u1}, users)
+
+		users, total, err = ss.User().GetPageUsingAuthService(authService, model.UserGetByAuthServiceOptions{Username: strings.ToUpper(u1.Username), Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, []*model.User{u1}, users)
+
+		users, total, err = ss.User().GetPageUsingAuthService(authService, model.UserGetByAuthServiceOptions{Username: u3.Username, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.Empty(t, users)
+	})
+}
+
 func sanitized(user *model.User) *model.User {
 	clonedUser := user.DeepCopy()
 	clonedUser.Sanitize(map[string]bool{})
@@ -2866,6 +2937,7 @@ func testUserStoreSearch(t *testing.T, ss store.Store) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Description, func(t *testing.T) {
 			users, err := ss.User().Search(
+				context.Background(),
 				testCase.TeamId,
 				testCase.Term,
 				testCase.Options,
@@ -3091,6 +3163,7 @@ func testUserStoreSearchNotInChannel(t *testing.T, ss store.Store) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Description, func(t *testing.T) {
 			users, err := ss.User().SearchNotInChannel(
+				context.Background(),
 				testCase.TeamId,
 				testCase.ChannelId,
 				testCase.Term,
@@ -3326,6 +3399,7 @@ func testUserStoreSearchInChannel(t *testing.T, ss store.Store) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Description, func(t *testing.T) {
 			users, err := ss.User().SearchInChannel(
+				context.Background(),
 				testCase.ChannelId,
 				testCase.Term,
 				testCase.Options,
@@ -3518,6 +3592,7 @@ func testUserStoreSearchNotInTeam(t *testing.T, ss store.Store) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Description, func(t *testing.T) {
 			users, err := ss.User().SearchNotInTeam(
+				context.Background(),
 				testCase.TeamId,
 				testCase.Term,
 				testCase.Options,
@@ -3624,6 +3699,7 @@ func testUserStoreSearchWithoutTeam(t *testing.T, ss store.Store) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Description, func(t *testing.T) {
 			users, err := ss.User().SearchWithoutTeam(
+				context.Background(),
 				testCase.Term,
 				testCase.Options,
 			)
@@ -3766,6 +3842,7 @@ func testUserStoreSearchInGroup(t *testing.T, ss store.Store) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Description, func(t *testing.T) {
 			users, err := ss.User().SearchInGroup(
+				context.Background(),
 				testCase.GroupId,
 				testCase.Term,
 				testCase.Options,
@@ -3898,6 +3975,7 @@ func testUserStoreSearchNotInGroup(t *testing.T, ss store.Store) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Description, func(t *testing.T) {
 			users, err := ss.User().SearchNotInGroup(
+				context.Background(),
 				testCase.GroupId,
 				testCase.Term,
 				testCase.Options,
@@ -0,0 +1,127 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+)
+
+func TestWebAuthnCredentialStore(t *testing.T, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testWebAuthnCredentialSaveAndGet(t, ss) })
+	t.Run("UpdateSignCount", func(t *testing.T) { testWebAuthnCredentialUpdateSignCount(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testWebAuthnCredentialDelete(t, ss) })
+}
+
+func newTestWebAuthnCredential(userID string) *model.WebAuthnCredential {
+	return &model.WebAuthnCredential{
+		UserId:       userID,
+		CredentialId: model.NewRandomString(43),
+		PublicKey:    []byte{0xa5, 0x01, 0x02},
+		Name:         "Security key",
+	}
+}
+
+func testWebAuthnCredentialSaveAndGet(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+
+	_, err := ss.WebAuthnCredential().Save(&model.WebAuthnCredential{UserId: userID})
+	require.Error(t, err)
+
+	first, err := ss.WebAuthnCredential().Save(newTestWebAuthnCredential(userID))
+	require.NoError(t, err)
+	second := newTestWebAuthnCredential(userID)
+	second.CreateAt = first.CreateAt + 1
+	_, err = ss.WebAuthnCredential().Save(second)
+	require.NoError(t, err)
+	_, err = ss.WebAuthnCredential().Save(newTestWebAuthnCredential(model.NewId()))
+	require.NoError(t, err)
+
+	t.Run("duplicate credential id", func(t *testing.T) {
+		duplicate := newTestWebAuthnCredential(model.NewId())
+		duplicate.CredentialId = first.CredentialId
+		_, err := ss.WebAuthnCredential().Save(duplicate)
+		var conflictErr *store.ErrConflict
+		require.True(t, errors.As(err, &conflictErr))
+	})
+
+	t.Run("get", func(t *testing.T) {
+		credential, err := ss.WebAuthnCredential().Get(first.Id)
+		require.NoError(t, err)
+		assert.Equal(t, first, credential)
+
+		_, err = ss.WebAuthnCredential().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("get by credential id", func(t *testing.T) {
+		credential, err := ss.WebAuthnCredential().GetByCredentialId(second.CredentialId)
+		require.NoError(t, err)
+		assert.Equal(t, second.Id, credential.Id)
+		assert.Equal(t, second.PublicKey, credential.PublicKey)
+	})
+
+	t.Run("get for user", func(t *testing.T) {
+		credentials, err := ss.WebAuthnCredential().GetForUser(userID)
+		require.NoError(t, err)
+		require.Len(t, credentials, 2)
+		assert.Equal(t, first.Id, credentials[0].Id)
+		assert.Equal(t, second.Id, credentials[1].Id)
+	})
+
+	t.Run("update name", func(t *testing.T) {
+		require.NoError(t, ss.WebAuthnCredential().UpdateName(first.Id, "Laptop"))
+		credential, err := ss.WebAuthnCredential().Get(first.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "Laptop", credential.Name)
+	})
+}
+
+func testWebAuthnCredentialUpdateSignCount(t *testing.T, ss store.Store) {
+	credential, err := ss.WebAuthnCredential().Save(newTestWebAuthnCredential(model.NewId()))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.WebAuthnCredential().UpdateSignCount(credential.Id, 5, 1000))
+
+	// The counter doesn't go backwards
+	err = ss.WebAuthnCredential().UpdateSignCount(credential.Id, 5, 2000)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	credential, err = ss.WebAuthnCredential().Get(credential.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), credential.SignCount)
+	assert.Equal(t, int64(1000), credential.LastUsedAt)
+
+	// Authenticators without a counter always send 0
+	other, err := ss.WebAuthnCredential().Save(newTestWebAuthnCredential(model.NewId()))
+	require.NoError(t, err)
+	require.NoError(t, ss.WebAuthnCredential().UpdateSignCount(other.Id, 0, 1000))
+	require.NoError(t, ss.WebAuthnCredential().UpdateSignCount(other.Id, 0, 2000))
+}
+
+func testWebAuthnCredentialDelete(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	first, err := ss.WebAuthnCredential().Save(newTestWebAuthnCredential(userID))
+	require.NoError(t, err)
+	_, err = ss.WebAuthnCredential().Save(newTestWebAuthnCredential(userID))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.WebAuthnCredential().Delete(first.Id))
+	credentials, err := ss.WebAuthnCredential().GetForUser(userID)
+	require.NoError(t, err)
+	require.Len(t, credentials, 1)
+
+	require.NoError(t, ss.WebAuthnCredential().PermanentDeleteByUser(userID))
+	credentials, err = ss.WebAuthnCredential().GetForUser(userID)
+	require.NoError(t, err)
+	require.Empty(t, credentials)
+}
@@ -17,6 +17,7 @@ import (
 func TestWebhookStore(t *testing.T, ss store.Store) {
 	t.Run("SaveIncoming", func(t *testing.T) { testWebhookStoreSaveIncoming(t, ss) })
 	t.Run("UpdateIncoming", func(t *testing.T) { testWebhookStoreUpdateIncoming(t, ss) })
+	t.Run("UpdateIncomingUser", func(t *testing.T) { testWebhookStoreUpdateIncomingUser(t, ss) })
 	t.Run("GetIncoming", func(t *testing.T) { testWebhookStoreGetIncoming(t, ss) })
 	t.Run("GetIncomingList", func(t *testing.T) { testWebhookStoreGetIncomingList(t, ss) })
 	t.Run("GetIncomingListByUser", func(t *testing.T) { testWebhookStoreGetIncomingListByUser(t, ss) })
@@ -38,6 +39,11 @@ func TestWebhookStore(t *testing.T, ss store.Store) {
 	t.Run("DeleteOutgoingByChannel", func(t *testing.T) { testWebhookStoreDeleteOutgoingByChannel(t, ss) })
 	t.Run("DeleteOutgoingByUser", func(t *testing.T) { testWebhookStoreDeleteOutgoingByUser(t, ss) })
 	t.Run("UpdateOutgoing", func(t *testing.T) { testWebhookStoreUpdateOutgoing(t, ss) })
+	t.Run("SaveDirectOutgoing", func(t *testing.T) { testWebhookStoreSaveDirectOutgoing(t, ss) })
+	t.Run("GetDirectOutgoing", func(t *testing.T) { testWebhookStoreGetDirectOutgoing(t, ss) })
+	t.Run("GetDirectOutgoingByBotUsers", func(t *testing.T) { testWebhookStoreGetDirectOutgoingByBotUsers(t, ss) })
+	t.Run("UpdateDirectOutgoing", func(t *testing.T) { testWebhookStoreUpdateDirectOutgoing(t, ss) })
+	t.Run("DeleteDirectOutgoing", func(t *testing.T) { testWebhookStoreDeleteDirectOutgoing(t, ss) })
 	t.Run("CountIncoming", func(t *testing.T) { testWebhookStoreCountIncoming(t, ss) })
 	t.Run("CountOutgoing", func(t *testing.T) { testWebhookStoreCountOutgoing(t, ss) })
 }
@@ -63,6 +69,7 @@ func testWebhookStoreUpdateIncoming(t *testing.T, ss store.Store) {
 	previousUpdatedAt := o1.UpdateAt
 
 	o1.DisplayName = "TestHook"
+	o1.MessageTemplate = "{{.text}}"
 	time.Sleep(10 * time.Millisecond)
 
 	webhook, err := ss.Webhook().UpdateIncoming(o1)
@@ -71,6 +78,26 @@ func testWebhookStoreUpdateIncoming(t *testing.T, ss store.Store) {
 	require.NotEqual(t, webhook.UpdateAt, previousUpdatedAt, "should have updated the UpdatedAt of the hook")
 
 	require.Equal(t, "TestHook", webhook.DisplayName, "display name is not updated")
+
+	webhook, err = ss.Webhook().GetIncoming(o1.Id, false)
+	require.NoError(t, err)
+	require.Equal(t, "{{.text}}", webhook.MessageTemplate, "message template is not updated")
+}
+
+func testWebhookStoreUpdateIncomingUser(t *testing.T, ss store.Store) {
+	o1, err := ss.Webhook().SaveIncoming(buildIncomingWebhook())
+	require.NoError(t, err, "unable to save webhook")
+
+	userID := model.NewId()
+	require.NoError(t, ss.Webhook().UpdateIncomingUser(o1.Id, userID))
+
+	webhook, err := ss.Webhook().GetIncoming(o1.Id, false)
+	require.NoError(t, err)
+	require.Equal(t, userID, webhook.UserId, "user is not updated")
+
+	err = ss.Webhook().UpdateIncomingUser(model.NewId(), userID)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr), "should have failed for a missing webhook")
 }
 
 func testWebhookStoreGetIncoming(t *testing.T, ss store.Store) {
@@ -597,3 +624,92 @@ func testWebhookStoreCountOutgoing(t *testing.T, ss store.Store) {
 	require.NoError(t, err)
 	require.NotEqual(t, 0, r, "should have at least 1 outgoing hook")
 }
+
+func buildDirectOutgoingWebhook() *model.DirectOutgoingWebhook {
+	return &model.DirectOutgoingWebhook{
+		CreatorId:    model.NewId(),
+		BotUserId:    model.NewId(),
+		CallbackURLs: []string{"http://nowhere.com/"},
+	}
+}
+
+func testWebhookStoreSaveDirectOutgoing(t *testing.T, ss store.Store) {
+	o1 := buildDirectOutgoingWebhook()
+
+	_, err := ss.Webhook().SaveDirectOutgoing(o1)
+	require.NoError(t, err, "couldn't save item")
+
+	_, err = ss.Webhook().SaveDirectOutgoing(o1)
+	require.Error(t, err, "shouldn't be able to update from save")
+}
+
+func testWebhookStoreGetDirectOutgoing(t *testing.T, ss store.Store) {
+	o1, err := ss.Webhook().SaveDirectOutgoing(buildDirectOutgoingWebhook())
+	require.NoError(t, err)
+
+	webhook, err := ss.Webhook().GetDirectOutgoing(o1.Id)
+	require.NoError(t, err)
+	require.Equal(t, o1.BotUserId, webhook.BotUserId)
+	require.Equal(t, o1.CallbackURLs, webhook.CallbackURLs)
+
+	hooks, err := ss.Webhook().GetDirectOutgoingList(0, 1000)
+	require.NoError(t, err)
+	found := false
+	for _, hook := range hooks {
+		if hook.Id == o1.Id {
+			found = true
+		}
+	}
+	require.True(t, found, "missing webhook in the list")
+
+	_, err = ss.Webhook().GetDirectOutgoing("123")
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr), "Missing id should have failed")
+}
+
+func testWebhookStoreGetDirectOutgoingByBotUsers(t *testing.T, ss store.Store) {
+	o1, err := ss.Webhook().SaveDirectOutgoing(buildDirectOutgoingWebhook())
+	require.NoError(t, err)
+	o2, err := ss.Webhook().SaveDirectOutgoing(buildDirectOutgoingWebhook())
+	require.NoError(t, err)
+	_, err = ss.Webhook().SaveDirectOutgoing(buildDirectOutgoingWebhook())
+	require.NoError(t, err)
+
+	hooks, err := ss.Webhook().GetDirectOutgoingByBotUsers([]string{o1.BotUserId, o2.BotUserId, model.NewId()})
+	require.NoError(t, err)
+	require.Len(t, hooks, 2)
+
+	hooks, err = ss.Webhook().GetDirectOutgoingByBotUsers(nil)
+	require.NoError(t, err)
+	require.Empty(t, hooks)
+}
+
+func testWebhookStoreUpdateDirectOutgoing(t *testing.T, ss store.Store) {
+	o1, err := ss.Webhook().SaveDirectOutgoing(buildDirectOutgoingWebhook())
+	require.NoError(t, err)
+
+	o1.Token = model.NewId()
+	o1.DisplayName = "Compliance"
+	_, err = ss.Webhook().UpdateDirectOutgoing(o1)
+	require.NoError(t, err)
+
+	webhook, err := ss.Webhook().GetDirectOutgoing(o1.Id)
+	require.NoError(t, err)
+	require.Equal(t, o1.Token, webhook.Token)
+	require.Equal(t, "Compliance", webhook.DisplayName)
+}
+
+func testWebhookStoreDeleteDirectOutgoing(t *testing.T, ss store.Store) {
+	o1, err := ss.Webhook().SaveDirectOutgoing(buildDirectOutgoingWebhook())
+	require.NoError(t, err)
+
+	err = ss.Webhook().DeleteDirectOutgoing(o1.Id, model.GetMillis())
+	require.NoError(t, err)
+
+	_, err = ss.Webhook().GetDirectOutgoing(o1.Id)
+	require.Error(t, err, "Missing id should have failed")
+
+	hooks, err := ss.Webhook().GetDirectOutgoingByBotUsers([]string{o1.BotUserId})
+	require.NoError(t, err)
+	require.Empty(t, hooks)
+}
@@ -18,39 +18,57 @@ import (
 type TimerLayer struct {
 	store.Store
 	Metrics                   einterfaces.MetricsInterface
+	ActivityStore             store.ActivityStore
+	ArchivedPostStore         store.ArchivedPostStore
 	AuditStore                store.AuditStore
 	BotStore                  store.BotStore
 	ChannelStore              store.ChannelStore
+	ChannelBookmarkStore      store.ChannelBookmarkStore
+	ChannelJoinRequestStore   store.ChannelJoinRequestStore
+	ChannelMemberExpiryStore  store.ChannelMemberExpiryStore
 	ChannelMemberHistoryStore store.ChannelMemberHistoryStore
 	ClusterDiscoveryStore     store.ClusterDiscoveryStore
 	CommandStore              store.CommandStore
 	CommandWebhookStore       store.CommandWebhookStore
 	ComplianceStore           store.ComplianceStore
+	ConfigChangeStore         store.ConfigChangeStore
 	DraftStore                store.DraftStore
 	EmojiStore                store.EmojiStore
 	FileInfoStore             store.FileInfoStore
 	GroupStore                store.GroupStore
+	GuestSponsorshipStore     store.GuestSponsorshipStore
 	JobStore                  store.JobStore
 	LicenseStore              store.LicenseStore
 	LinkMetadataStore         store.LinkMetadataStore
+	MatrixBridgeStore         store.MatrixBridgeStore
 	NotifyAdminStore          store.NotifyAdminStore
 	OAuthStore                store.OAuthStore
 	PluginStore               store.PluginStore
 	PostStore                 store.PostStore
 	PostAcknowledgementStore  store.PostAcknowledgementStore
+	PostEmbeddingStore        store.PostEmbeddingStore
+	PostExpirationStore       store.PostExpirationStore
 	PostPriorityStore         store.PostPriorityStore
+	PostTranslationStore      store.PostTranslationStore
 	PreferenceStore           store.PreferenceStore
 	ProductNoticesStore       store.ProductNoticesStore
 	ReactionStore             store.ReactionStore
+	ReactionSummaryStore      store.ReactionSummaryStore
+	ReadReceiptStore          store.ReadReceiptStore
 	RemoteClusterStore        store.RemoteClusterStore
 	RetentionPolicyStore      store.RetentionPolicyStore
 	RoleStore                 store.RoleStore
+	RoleSnapshotStore         store.RoleSnapshotStore
+	ScheduledPostStore        store.ScheduledPostStore
 	SchemeStore               store.SchemeStore
 	SessionStore              store.SessionStore
 	SharedChannelStore        store.SharedChannelStore
 	StatusStore               store.StatusStore
+	SupportAccessConsentStore store.SupportAccessConsentStore
 	SystemStore               store.SystemStore
 	TeamStore                 store.TeamStore
+	TeamArchiveStore          store.TeamArchiveStore
+	TeamTemplateStore         store.TeamTemplateStore
 	TermsOfServiceStore       store.TermsOfServiceStore
 	ThreadStore               store.ThreadStore
 	TokenStore                store.TokenStore
@@ -59,9 +77,18 @@ type TimerLayer struct {
 	UserStore                 store.UserStore
 	UserAccessTokenStore      store.UserAccessTokenStore
 	UserTermsOfServiceStore   store.UserTermsOfServiceStore
+	WebAuthnCredentialStore   store.WebAuthnCredentialStore
 	WebhookStore              store.WebhookStore
 }
 
+func (s *TimerLayer) Activity() store.ActivityStore {
+	return s.ActivityStore
+}
+
+func (s *TimerLayer) ArchivedPost() store.ArchivedPostStore {
+	return s.ArchivedPostStore
+}
+
 func (s *TimerLayer) Audit() store.AuditStore {
 	return s.AuditStore
 }
@@ -74,6 +101,18 @@ func (s *TimerLayer) Channel() store.ChannelStore {
 	return s.ChannelStore
 }
 
+func (s *TimerLayer) ChannelBookmark() store.ChannelBookmarkStore {
+	return s.ChannelBookmarkStore
+}
+
+func (s *TimerLayer) ChannelJoinRequest() store.ChannelJoinRequestStore {
+	return s.ChannelJoinRequestStore
+}
+
+func (s *TimerLayer) ChannelMemberExpiry() store.ChannelMemberExpiryStore {
+	return s.ChannelMemberExpiryStore
+}
+
 func (s *TimerLayer) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	return s.ChannelMemberHistoryStore
 }
@@ -94,6 +133,10 @@ func (s *TimerLayer) Compliance() store.ComplianceStore {
 	return s.ComplianceStore
 }
 
+func (s *TimerLayer) ConfigChange() store.ConfigChangeStore {
+	return s.ConfigChangeStore
+}
+
 func (s *TimerLayer) Draft() store.DraftStore {
 	return s.DraftStore
 }
@@ -110,6 +153,10 @@ func (s *TimerLayer) Group() store.GroupStore {
 	return s.GroupStore
 }
 
+func (s *TimerLayer) GuestSponsorship() store.GuestSponsorshipStore {
+	return s.GuestSponsorshipStore
+}
+
 func (s *TimerLayer) Job() store.JobStore {
 	return s.JobStore
 }
@@ -122,6 +169,10 @@ func (s *TimerLayer) LinkMetadata() store.LinkMetadataStore {
 	return s.LinkMetadataStore
 }
 
+func (s *TimerLayer) MatrixBridge() store.MatrixBridgeStore {
+	return s.MatrixBridgeStore
+}
+
 func (s *TimerLayer) NotifyAdmin() store.NotifyAdminStore {
 	return s.NotifyAdminStore
 }
@@ -142,10 +193,22 @@ func (s *TimerLayer) PostAcknowledgement() store.PostAcknowledgementStore {
 	return s.PostAcknowledgementStore
 }
 
+func (s *TimerLayer) PostEmbedding() store.PostEmbeddingStore {
+	return s.PostEmbeddingStore
+}
+
+func (s *TimerLayer) PostExpiration() store.PostExpirationStore {
+	return s.PostExpirationStore
+}
+
 func (s *TimerLayer) PostPriority() store.PostPriorityStore {
 	return s.PostPriorityStore
 }
 
+func (s *TimerLayer) PostTranslation() store.PostTranslationStore {
+	return s.PostTranslationStore
+}
+
 func (s *TimerLayer) Preference() store.PreferenceStore {
 	return s.PreferenceStore
 }
@@ -158,6 +221,14 @@ func (s *TimerLayer) Reaction() store.ReactionStore {
 	return s.ReactionStore
 }
 
+func (s *TimerLayer) ReactionSummary() store.ReactionSummaryStore {
+	return s.ReactionSummaryStore
+}
+
+func (s *TimerLayer) ReadReceipt() store.ReadReceiptStore {
+	return s.ReadReceiptStore
+}
+
 func (s *TimerLayer) RemoteCluster() store.RemoteClusterStore {
 	return s.RemoteClusterStore
 }
@@ -170,6 +241,14 @@ func (s *TimerLayer) Role() store.RoleStore {
 	return s.RoleStore
 }
 
+func (s *TimerLayer) RoleSnapshot() store.RoleSnapshotStore {
+	return s.RoleSnapshotStore
+}
+
+func (s *TimerLayer) ScheduledPost() store.ScheduledPostStore {
+	return s.ScheduledPostStore
+}
+
 func (s *TimerLayer) Scheme() store.SchemeStore {
 	return s.SchemeStore
 }
@@ -186,6 +265,10 @@ func (s *TimerLayer) Status() store.StatusStore {
 	return s.StatusStore
 }
 
+func (s *TimerLayer) SupportAccessConsent() store.SupportAccessConsentStore {
+	return s.SupportAccessConsentStore
+}
+
 func (s *TimerLayer) System() store.SystemStore {
 	return s.SystemStore
 }
@@ -194,6 +277,14 @@ func (s *TimerLayer) Team() store.TeamStore {
 	return s.TeamStore
 }
 
+func (s *TimerLayer) TeamArchive() store.TeamArchiveStore {
+	return s.TeamArchiveStore
+}
+
+func (s *TimerLayer) TeamTemplate() store.TeamTemplateStore {
+	return s.TeamTemplateStore
+}
+
 func (s *TimerLayer) TermsOfService() store.TermsOfServiceStore {
 	return s.TermsOfServiceStore
 }
@@ -226,10 +317,24 @@ func (s *TimerLayer) UserTermsOfService() store.UserTermsOfServiceStore {
 	return s.UserTermsOfServiceStore
 }
 
+func (s *TimerLayer) WebAuthnCredential() store.WebAuthnCredentialStore {
+	return s.WebAuthnCredentialStore
+}
+
 func (s *TimerLayer) Webhook() store.WebhookStore {
 	return s.WebhookStore
 }
 
+type TimerLayerActivityStore struct {
+	store.ActivityStore
+	Root *TimerLayer
+}
+
+type TimerLayerArchivedPostStore struct {
+	store.ArchivedPostStore
+	Root *TimerLayer
+}
+
 type TimerLayerAuditStore struct {
 	store.AuditStore
 	Root *TimerLayer
@@ -245,6 +350,21 @@ type TimerLayerChannelStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerChannelBookmarkStore struct {
+	store.ChannelBookmarkStore
+	Root *TimerLayer
+}
+
+type TimerLayerChannelJoinRequestStore struct {
+	store.ChannelJoinRequestStore
+	Root *TimerLayer
+}
+
+type TimerLayerChannelMemberExpiryStore struct {
+	store.ChannelMemberExpiryStore
+	Root *TimerLayer
+}
+
 type TimerLayerChannelMemberHistoryStore struct {
 	store.ChannelMemberHistoryStore
 	Root *TimerLayer
@@ -270,6 +390,11 @@ type TimerLayerComplianceStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerConfigChangeStore struct {
+	store.ConfigChangeStore
+	Root *TimerLayer
+}
+
 type TimerLayerDraftStore struct {
 	store.DraftStore
 	Root *TimerLayer
@@ -290,6 +415,11 @@ type TimerLayerGroupStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerGuestSponsorshipStore struct {
+	store.GuestSponsorshipStore
+	Root *TimerLayer
+}
+
 type TimerLayerJobStore struct {
 	store.JobStore
 	Root *TimerLayer
@@ -305,6 +435,11 @@ type TimerLayerLinkMetadataStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerMatrixBridgeStore struct {
+	store.MatrixBridgeStore
+	Root *TimerLayer
+}
+
 type TimerLayerNotifyAdminStore struct {
 	store.NotifyAdminStore
 	Root *TimerLayer
@@ -330,11 +465,26 @@ type TimerLayerPostAcknowledgementStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerPostEmbeddingStore struct {
+	store.PostEmbeddingStore
+	Root *TimerLayer
+}
+
+type TimerLayerPostExpirationStore struct {
+	store.PostExpirationStore
+	Root *TimerLayer
+}
+
 type TimerLayerPostPriorityStore struct {
 	store.PostPriorityStore
 	Root *TimerLayer
 }
 
+type TimerLayerPostTranslationStore struct {
+	store.PostTranslationStore
+	Root *TimerLayer
+}
+
 type TimerLayerPreferenceStore struct {
 	store.PreferenceStore
 	Root *TimerLayer
@@ -350,6 +500,16 @@ type TimerLayerReactionStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerReactionSummaryStore struct {
+	store.ReactionSummaryStore
+	Root *TimerLayer
+}
+
+type TimerLayerReadReceiptStore struct {
+	store.ReadReceiptStore
+	Root *TimerLayer
+}
+
 type TimerLayerRemoteClusterStore struct {
 	store.RemoteClusterStore
 	Root *TimerLayer
@@ -365,6 +525,16 @@ type TimerLayerRoleStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerRoleSnapshotStore struct {
+	store.RoleSnapshotStore
+	Root *TimerLayer
+}
+
+type TimerLayerScheduledPostStore struct {
+	store.ScheduledPostStore
+	Root *TimerLayer
+}
+
 type TimerLayerSchemeStore struct {
 	store.SchemeStore
 	Root *TimerLayer
@@ -385,6 +555,11 @@ type TimerLayerStatusStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerSupportAccessConsentStore struct {
+	store.SupportAccessConsentStore
+	Root *TimerLayer
+}
+
 type TimerLayerSystemStore struct {
 	store.SystemStore
 	Root *TimerLayer
@@ -395,6 +570,16 @@ type TimerLayerTeamStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerTeamArchiveStore struct {
+	store.TeamArchiveStore
+	Root *TimerLayer
+}
+
+type TimerLayerTeamTemplateStore struct {
+	store.TeamTemplateStore
+	Root *TimerLayer
+}
+
 type TimerLayerTermsOfServiceStore struct {
 	store.TermsOfServiceStore
 	Root *TimerLayer
@@ -435,11 +620,192 @@ type TimerLayerUserTermsOfServiceStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerWebAuthnCredentialStore struct {
+	store.WebAuthnCredentialStore
+	Root *TimerLayer
+}
+
 type TimerLayerWebhookStore struct {
 	store.WebhookStore
 	Root *TimerLayer
 }
 
+func (s *TimerLayerActivityStore) DeleteForPost(postID string) error {
+	start := time.Now()
+
+	err := s.ActivityStore.DeleteForPost(postID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityStore.DeleteForPost", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerActivityStore) GetForUser(userID string, options model.ActivityGetOptions) ([]*model.Activity, error) {
+	start := time.Now()
+
+	result, err := s.ActivityStore.GetForUser(userID, options)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityStore.GetForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerActivityStore) GetUnreadCountForUser(userID string) (int64, error) {
+	start := time.Now()
+
+	result, err := s.ActivityStore.GetUnreadCountForUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityStore.GetUnreadCountForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerActivityStore) MarkAllAsRead(userID string, readAt int64) error {
+	start := time.Now()
+
+	err := s.ActivityStore.MarkAllAsRead(userID, readAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityStore.MarkAllAsRead", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerActivityStore) MarkAsRead(userID string, activityIDs []string, readAt int64) error {
+	start := time.Now()
+
+	err := s.ActivityStore.MarkAsRead(userID, activityIDs, readAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityStore.MarkAsRead", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerActivityStore) Save(activities []*model.Activity) error {
+	start := time.Now()
+
+	err := s.ActivityStore.Save(activities)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityStore.Save", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerArchivedPostStore) Archive(archived []*model.ArchivedPost) error {
+	start := time.Now()
+
+	err := s.ArchivedPostStore.Archive(archived)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ArchivedPostStore.Archive", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerArchivedPostStore) GetByIds(postIDs []string) ([]*model.ArchivedPost, error) {
+	start := time.Now()
+
+	result, err := s.ArchivedPostStore.GetByIds(postIDs)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ArchivedPostStore.GetByIds", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerArchivedPostStore) GetForChannelBefore(channelID string, before int64, rootsOnly bool, limit int) ([]*model.ArchivedPost, error) {
+	start := time.Now()
+
+	result, err := s.ArchivedPostStore.GetForChannelBefore(channelID, before, rootsOnly, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ArchivedPostStore.GetForChannelBefore", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerArchivedPostStore) GetForThread(rootID string) ([]*model.ArchivedPost, error) {
+	start := time.Now()
+
+	result, err := s.ArchivedPostStore.GetForThread(rootID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ArchivedPostStore.GetForThread", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerArchivedPostStore) GetSegmentsForUser(userID string, teamID string, after int64, before int64, limit int) ([]string, error) {
+	start := time.Now()
+
+	result, err := s.ArchivedPostStore.GetSegmentsForUser(userID, teamID, after, before, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ArchivedPostStore.GetSegmentsForUser", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerAuditStore) Get(user_id string, offset int, limit int) (model.Audits, error) {
 	start := time.Now()
 
@@ -600,10 +966,10 @@ func (s *TimerLayerChannelStore) AnalyticsTypeCount(teamID string, channelType m
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) Autocomplete(userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelListWithTeamData, error) {
+func (s *TimerLayerChannelStore) Autocomplete(ctx context.Context, userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelListWithTeamData, error) {
 	start := time.Now()
 
-	result, err := s.ChannelStore.Autocomplete(userID, term, includeDeleted, isGuest)
+	result, err := s.ChannelStore.Autocomplete(ctx, userID, term, includeDeleted, isGuest)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -616,10 +982,10 @@ func (s *TimerLayerChannelStore) Autocomplete(userID string, term string, includ
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) AutocompleteInTeam(teamID string, userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelList, error) {
+func (s *TimerLayerChannelStore) AutocompleteInTeam(ctx context.Context, teamID string, userID string, term string, includeDeleted bool, isGuest bool) (model.ChannelList, error) {
 	start := time.Now()
 
-	result, err := s.ChannelStore.AutocompleteInTeam(teamID, userID, term, includeDeleted, isGuest)
+	result, err := s.ChannelStore.AutocompleteInTeam(ctx, teamID, userID, term, includeDeleted, isGuest)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -632,10 +998,10 @@ func (s *TimerLayerChannelStore) AutocompleteInTeam(teamID string, userID string
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) AutocompleteInTeamForSearch(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *TimerLayerChannelStore) AutocompleteInTeamForSearch(ctx context.Context, teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
 	start := time.Now()
 
-	result, err := s.ChannelStore.AutocompleteInTeamForSearch(teamID, userID, term, includeDeleted)
+	result, err := s.ChannelStore.AutocompleteInTeamForSearch(ctx, teamID, userID, term, includeDeleted)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -1078,6 +1444,22 @@ func (s *TimerLayerChannelStore) GetChannelMembersTimezones(channelID string) ([
 	return result, err
 }
 
+func (s *TimerLayerChannelStore) GetChannelPinnedPosts(channelID string) (*model.ChannelPinnedPosts, error) {
+	start := time.Now()
+
+	result, err := s.ChannelStore.GetChannelPinnedPosts(channelID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelPinnedPosts", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerChannelStore) GetChannelUnread(channelID string, userID string) (*model.ChannelUnread, error) {
 	start := time.Now()
 
@@ -1206,10 +1588,10 @@ func (s *TimerLayerChannelStore) GetChannelsWithTeamDataByIds(channelIds []strin
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetDeleted(team_id string, offset int, limit int, userID string) (model.ChannelList, error) {
+func (s *TimerLayerChannelStore) GetCounters(channelID string) (*model.ChannelCounters, error) {
 	start := time.Now()
 
-	result, err := s.ChannelStore.GetDeleted(team_id, offset, limit, userID)
+	result, err := s.ChannelStore.GetCounters(channelID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -1217,12 +1599,28 @@ func (s *TimerLayerChannelStore) GetDeleted(team_id string, offset int, limit in
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetDeleted", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetCounters", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetDeletedByName(team_id string, name string) (*model.Channel, error) {
+func (s *TimerLayerChannelStore) GetDeleted(team_id string, offset int, limit int, userID string) (model.ChannelList, error) {
+	start := time.Now()
+
+	result, err := s.ChannelStore.GetDeleted(team_id, offset, limit, userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetDeleted", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetDeletedByName(team_id string, name string) (*model.Channel, error) {
 	start := time.Now()
 
 	result, err := s.ChannelStore.GetDeletedByName(team_id, name)
@@ -1286,6 +1684,22 @@ func (s *TimerLayerChannelStore) GetGuestCount(channelID string, allowFromCache
 	return result, err
 }
 
+func (s *TimerLayerChannelStore) GetInboxChannelsForUser(userID string, options model.GetInboxOptions) ([]*model.InboxItem, error) {
+	start := time.Now()
+
+	result, err := s.ChannelStore.GetInboxChannelsForUser(userID, options)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetInboxChannelsForUser", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerChannelStore) GetMany(ids []string, allowFromCache bool) (model.ChannelList, error) {
 	start := time.Now()
 
@@ -2015,6 +2429,22 @@ func (s *TimerLayerChannelStore) PostCountsByDuration(channelIDs []string, since
 	return result, err
 }
 
+func (s *TimerLayerChannelStore) ReconcileCounters(afterChannelID string, limit int) (string, error) {
+	start := time.Now()
+
+	result, err := s.ChannelStore.ReconcileCounters(afterChannelID, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.ReconcileCounters", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerChannelStore) RemoveAllDeactivatedMembers(channelID string) error {
 	start := time.Now()
 
@@ -2111,6 +2541,22 @@ func (s *TimerLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam
 	return result, err
 }
 
+func (s *TimerLayerChannelStore) SaveChannelPinnedPosts(pinned *model.ChannelPinnedPosts) (*model.ChannelPinnedPosts, error) {
+	start := time.Now()
+
+	result, err := s.ChannelStore.SaveChannelPinnedPosts(pinned)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SaveChannelPinnedPosts", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerChannelStore) SaveDirectChannel(channel *model.Channel, member1 *model.ChannelMember, member2 *model.ChannelMember) (*model.Channel, error) {
 	start := time.Now()
 
@@ -2159,10 +2605,10 @@ func (s *TimerLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMem
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SearchAllChannels(term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
+func (s *TimerLayerChannelStore) SearchAllChannels(ctx context.Context, term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
 	start := time.Now()
 
-	result, resultVar1, err := s.ChannelStore.SearchAllChannels(term, opts)
+	result, resultVar1, err := s.ChannelStore.SearchAllChannels(ctx, term, opts)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2175,10 +2621,10 @@ func (s *TimerLayerChannelStore) SearchAllChannels(term string, opts store.Chann
 	return result, resultVar1, err
 }
 
-func (s *TimerLayerChannelStore) SearchArchivedInTeam(teamID string, term string, userID string) (model.ChannelList, error) {
+func (s *TimerLayerChannelStore) SearchArchivedInTeam(ctx context.Context, teamID string, term string, userID string) (model.ChannelList, error) {
 	start := time.Now()
 
-	result, err := s.ChannelStore.SearchArchivedInTeam(teamID, term, userID)
+	result, err := s.ChannelStore.SearchArchivedInTeam(ctx, teamID, term, userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2191,10 +2637,10 @@ func (s *TimerLayerChannelStore) SearchArchivedInTeam(teamID string, term string
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SearchForUserInTeam(userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *TimerLayerChannelStore) SearchForUserInTeam(ctx context.Context, userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
 	start := time.Now()
 
-	result, err := s.ChannelStore.SearchForUserInTeam(userID, teamID, term, includeDeleted)
+	result, err := s.ChannelStore.SearchForUserInTeam(ctx, userID, teamID, term, includeDeleted)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2207,10 +2653,10 @@ func (s *TimerLayerChannelStore) SearchForUserInTeam(userID string, teamID strin
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SearchGroupChannels(userID string, term string) (model.ChannelList, error) {
+func (s *TimerLayerChannelStore) SearchGroupChannels(ctx context.Context, userID string, term string) (model.ChannelList, error) {
 	start := time.Now()
 
-	result, err := s.ChannelStore.SearchGroupChannels(userID, term)
+	result, err := s.ChannelStore.SearchGroupChannels(ctx, userID, term)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2223,10 +2669,10 @@ func (s *TimerLayerChannelStore) SearchGroupChannels(userID string, term string)
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SearchInTeam(teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *TimerLayerChannelStore) SearchInTeam(ctx context.Context, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
 	start := time.Now()
 
-	result, err := s.ChannelStore.SearchInTeam(teamID, term, includeDeleted)
+	result, err := s.ChannelStore.SearchInTeam(ctx, teamID, term, includeDeleted)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2479,10 +2925,10 @@ func (s *TimerLayerChannelStore) UserBelongsToChannels(userID string, channelIds
 	return result, err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *TimerLayerChannelBookmarkStore) Delete(id string, deleteAt int64) error {
 	start := time.Now()
 
-	result, err := s.ChannelMemberHistoryStore.DeleteOrphanedRows(limit)
+	err := s.ChannelBookmarkStore.Delete(id, deleteAt)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2490,15 +2936,15 @@ func (s *TimerLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.DeleteOrphanedRows", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since int64) ([]string, error) {
+func (s *TimerLayerChannelBookmarkStore) Get(id string, includeDeleted bool) (*model.ChannelBookmark, error) {
 	start := time.Now()
 
-	result, err := s.ChannelMemberHistoryStore.GetChannelsLeftSince(userID, since)
+	result, err := s.ChannelBookmarkStore.Get(id, includeDeleted)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2506,15 +2952,15 @@ func (s *TimerLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetChannelsLeftSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
+func (s *TimerLayerChannelBookmarkStore) GetBookmarksForChannel(channelID string) ([]*model.ChannelBookmark, error) {
 	start := time.Now()
 
-	result, err := s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelID)
+	result, err := s.ChannelBookmarkStore.GetBookmarksForChannel(channelID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2522,15 +2968,15 @@ func (s *TimerLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetUsersInChannelDuring", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.GetBookmarksForChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) LogJoinEvent(userID string, channelID string, joinTime int64) error {
+func (s *TimerLayerChannelBookmarkStore) Save(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
 	start := time.Now()
 
-	err := s.ChannelMemberHistoryStore.LogJoinEvent(userID, channelID, joinTime)
+	result, err := s.ChannelBookmarkStore.Save(bookmark)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2538,15 +2984,15 @@ func (s *TimerLayerChannelMemberHistoryStore) LogJoinEvent(userID string, channe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogJoinEvent", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) LogLeaveEvent(userID string, channelID string, leaveTime int64) error {
+func (s *TimerLayerChannelBookmarkStore) Update(bookmark *model.ChannelBookmark) error {
 	start := time.Now()
 
-	err := s.ChannelMemberHistoryStore.LogLeaveEvent(userID, channelID, leaveTime)
+	err := s.ChannelBookmarkStore.Update(bookmark)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2554,15 +3000,15 @@ func (s *TimerLayerChannelMemberHistoryStore) LogLeaveEvent(userID string, chann
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogLeaveEvent", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.Update", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *TimerLayerChannelBookmarkStore) UpdateSortOrder(channelID string, bookmarkIDs []string) error {
 	start := time.Now()
 
-	result, err := s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+	err := s.ChannelBookmarkStore.UpdateSortOrder(channelID, bookmarkIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2570,15 +3016,15 @@ func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.UpdateSortOrder", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+func (s *TimerLayerChannelJoinRequestStore) Get(id string) (*model.ChannelJoinRequest, error) {
 	start := time.Now()
 
-	result, resultVar1, err := s.ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+	result, err := s.ChannelJoinRequestStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2586,15 +3032,15 @@ func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatchForRetentionPo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelJoinRequestStore.Get", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Cleanup() error {
+func (s *TimerLayerChannelJoinRequestStore) GetForChannel(channelID string, status string, offset int, limit int) ([]*model.ChannelJoinRequest, error) {
 	start := time.Now()
 
-	err := s.ClusterDiscoveryStore.Cleanup()
+	result, err := s.ChannelJoinRequestStore.GetForChannel(channelID, status, offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2602,15 +3048,15 @@ func (s *TimerLayerClusterDiscoveryStore) Cleanup() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Cleanup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelJoinRequestStore.GetForChannel", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
+func (s *TimerLayerChannelJoinRequestStore) GetPending(channelID string, userID string) (*model.ChannelJoinRequest, error) {
 	start := time.Now()
 
-	result, err := s.ClusterDiscoveryStore.Delete(discovery)
+	result, err := s.ChannelJoinRequestStore.GetPending(channelID, userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2618,15 +3064,15 @@ func (s *TimerLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscove
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelJoinRequestStore.GetPending", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
+func (s *TimerLayerChannelJoinRequestStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
-	result, err := s.ClusterDiscoveryStore.Exists(discovery)
+	err := s.ChannelJoinRequestStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2634,15 +3080,15 @@ func (s *TimerLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscove
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Exists", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelJoinRequestStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
+func (s *TimerLayerChannelJoinRequestStore) Save(request *model.ChannelJoinRequest) (*model.ChannelJoinRequest, error) {
 	start := time.Now()
 
-	result, err := s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
+	result, err := s.ChannelJoinRequestStore.Save(request)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2650,15 +3096,15 @@ func (s *TimerLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterNa
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelJoinRequestStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
+func (s *TimerLayerChannelJoinRequestStore) Update(request *model.ChannelJoinRequest) (*model.ChannelJoinRequest, error) {
 	start := time.Now()
 
-	err := s.ClusterDiscoveryStore.Save(discovery)
+	result, err := s.ChannelJoinRequestStore.Update(request)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2666,15 +3112,15 @@ func (s *TimerLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelJoinRequestStore.Update", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
+func (s *TimerLayerChannelMemberExpiryStore) Delete(channelID string, userID string) error {
 	start := time.Now()
 
-	err := s.ClusterDiscoveryStore.SetLastPingAt(discovery)
+	err := s.ChannelMemberExpiryStore.Delete(channelID, userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2682,15 +3128,15 @@ func (s *TimerLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.Cluster
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.SetLastPingAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberExpiryStore.Delete", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, error) {
+func (s *TimerLayerChannelMemberExpiryStore) Get(channelID string, userID string) (*model.ChannelMemberExpiry, error) {
 	start := time.Now()
 
-	result, err := s.CommandStore.AnalyticsCommandCount(teamID)
+	result, err := s.ChannelMemberExpiryStore.Get(channelID, userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2698,15 +3144,15 @@ func (s *TimerLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.AnalyticsCommandCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberExpiryStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandStore) Delete(commandID string, timestamp int64) error {
+func (s *TimerLayerChannelMemberExpiryStore) GetExpired(now int64, limit int) ([]*model.ChannelMemberExpiry, error) {
 	start := time.Now()
 
-	err := s.CommandStore.Delete(commandID, timestamp)
+	result, err := s.ChannelMemberExpiryStore.GetExpired(now, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2714,15 +3160,15 @@ func (s *TimerLayerCommandStore) Delete(commandID string, timestamp int64) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberExpiryStore.GetExpired", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerCommandStore) Get(id string) (*model.Command, error) {
+func (s *TimerLayerChannelMemberExpiryStore) GetForChannel(channelID string) ([]*model.ChannelMemberExpiry, error) {
 	start := time.Now()
 
-	result, err := s.CommandStore.Get(id)
+	result, err := s.ChannelMemberExpiryStore.GetForChannel(channelID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2730,15 +3176,15 @@ func (s *TimerLayerCommandStore) Get(id string) (*model.Command, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberExpiryStore.GetForChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, error) {
+func (s *TimerLayerChannelMemberExpiryStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
-	result, err := s.CommandStore.GetByTeam(teamID)
+	err := s.ChannelMemberExpiryStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2746,15 +3192,15 @@ func (s *TimerLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberExpiryStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerCommandStore) GetByTrigger(teamID string, trigger string) (*model.Command, error) {
+func (s *TimerLayerChannelMemberExpiryStore) Save(expiry *model.ChannelMemberExpiry) (*model.ChannelMemberExpiry, error) {
 	start := time.Now()
 
-	result, err := s.CommandStore.GetByTrigger(teamID, trigger)
+	result, err := s.ChannelMemberExpiryStore.Save(expiry)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2762,15 +3208,15 @@ func (s *TimerLayerCommandStore) GetByTrigger(teamID string, trigger string) (*m
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTrigger", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberExpiryStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
+func (s *TimerLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int64, error) {
 	start := time.Now()
 
-	err := s.CommandStore.PermanentDeleteByTeam(teamID)
+	result, err := s.ChannelMemberHistoryStore.DeleteOrphanedRows(limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2778,15 +3224,15 @@ func (s *TimerLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.DeleteOrphanedRows", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerCommandStore) PermanentDeleteByUser(userID string) error {
+func (s *TimerLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since int64) ([]string, error) {
 	start := time.Now()
 
-	err := s.CommandStore.PermanentDeleteByUser(userID)
+	result, err := s.ChannelMemberHistoryStore.GetChannelsLeftSince(userID, since)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2794,15 +3240,15 @@ func (s *TimerLayerCommandStore) PermanentDeleteByUser(userID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetChannelsLeftSince", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
+func (s *TimerLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
 	start := time.Now()
 
-	result, err := s.CommandStore.Save(webhook)
+	result, err := s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2810,15 +3256,15 @@ func (s *TimerLayerCommandStore) Save(webhook *model.Command) (*model.Command, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetUsersInChannelDuring", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
+func (s *TimerLayerChannelMemberHistoryStore) LogJoinEvent(userID string, channelID string, joinTime int64) error {
 	start := time.Now()
 
-	result, err := s.CommandStore.Update(hook)
+	err := s.ChannelMemberHistoryStore.LogJoinEvent(userID, channelID, joinTime)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2826,30 +3272,31 @@ func (s *TimerLayerCommandStore) Update(hook *model.Command) (*model.Command, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogJoinEvent", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerCommandWebhookStore) Cleanup() {
+func (s *TimerLayerChannelMemberHistoryStore) LogLeaveEvent(userID string, channelID string, leaveTime int64) error {
 	start := time.Now()
 
-	s.CommandWebhookStore.Cleanup()
+	err := s.ChannelMemberHistoryStore.LogLeaveEvent(userID, channelID, leaveTime)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Cleanup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogLeaveEvent", success, elapsed)
 	}
+	return err
 }
 
-func (s *TimerLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, error) {
+func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := time.Now()
 
-	result, err := s.CommandWebhookStore.Get(id)
+	result, err := s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2857,15 +3304,15 @@ func (s *TimerLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, error) {
+func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
 	start := time.Now()
 
-	result, err := s.CommandWebhookStore.Save(webhook)
+	result, resultVar1, err := s.ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2873,15 +3320,15 @@ func (s *TimerLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*mo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies", success, elapsed)
 	}
-	return result, err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerCommandWebhookStore) TryUse(id string, limit int) error {
+func (s *TimerLayerClusterDiscoveryStore) Cleanup() error {
 	start := time.Now()
 
-	err := s.CommandWebhookStore.TryUse(id, limit)
+	err := s.ClusterDiscoveryStore.Cleanup()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2889,15 +3336,15 @@ func (s *TimerLayerCommandWebhookStore) TryUse(id string, limit int) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.TryUse", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Cleanup", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerComplianceStore) ComplianceExport(compliance *model.Compliance, cursor model.ComplianceExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceExportCursor, error) {
+func (s *TimerLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
 	start := time.Now()
 
-	result, resultVar1, err := s.ComplianceStore.ComplianceExport(compliance, cursor, limit)
+	result, err := s.ClusterDiscoveryStore.Delete(discovery)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2905,15 +3352,15 @@ func (s *TimerLayerComplianceStore) ComplianceExport(compliance *model.Complianc
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.ComplianceExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Delete", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerComplianceStore) Get(id string) (*model.Compliance, error) {
+func (s *TimerLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
 	start := time.Now()
 
-	result, err := s.ComplianceStore.Get(id)
+	result, err := s.ClusterDiscoveryStore.Exists(discovery)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2921,15 +3368,15 @@ func (s *TimerLayerComplianceStore) Get(id string) (*model.Compliance, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Exists", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, error) {
+func (s *TimerLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
 	start := time.Now()
 
-	result, err := s.ComplianceStore.GetAll(offset, limit)
+	result, err := s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2937,15 +3384,15 @@ func (s *TimerLayerComplianceStore) GetAll(offset int, limit int) (model.Complia
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerComplianceStore) MessageExport(ctx context.Context, cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
+func (s *TimerLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
 	start := time.Now()
 
-	result, resultVar1, err := s.ComplianceStore.MessageExport(ctx, cursor, limit)
+	err := s.ClusterDiscoveryStore.Save(discovery)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2953,15 +3400,15 @@ func (s *TimerLayerComplianceStore) MessageExport(ctx context.Context, cursor mo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.MessageExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Save", success, elapsed)
 	}
-	return result, resultVar1, err
+	return err
 }
 
-func (s *TimerLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, error) {
+func (s *TimerLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
 	start := time.Now()
 
-	result, err := s.ComplianceStore.Save(compliance)
+	err := s.ClusterDiscoveryStore.SetLastPingAt(discovery)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2969,15 +3416,15 @@ func (s *TimerLayerComplianceStore) Save(compliance *model.Compliance) (*model.C
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.SetLastPingAt", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, error) {
+func (s *TimerLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, error) {
 	start := time.Now()
 
-	result, err := s.ComplianceStore.Update(compliance)
+	result, err := s.CommandStore.AnalyticsCommandCount(teamID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -2985,15 +3432,15 @@ func (s *TimerLayerComplianceStore) Update(compliance *model.Compliance) (*model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.AnalyticsCommandCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerDraftStore) Delete(userID string, channelID string, rootID string) error {
+func (s *TimerLayerCommandStore) Delete(commandID string, timestamp int64) error {
 	start := time.Now()
 
-	err := s.DraftStore.Delete(userID, channelID, rootID)
+	err := s.CommandStore.Delete(commandID, timestamp)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3001,15 +3448,15 @@ func (s *TimerLayerDraftStore) Delete(userID string, channelID string, rootID st
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Delete", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerDraftStore) Get(userID string, channelID string, rootID string, includeDeleted bool) (*model.Draft, error) {
+func (s *TimerLayerCommandStore) Get(id string) (*model.Command, error) {
 	start := time.Now()
 
-	result, err := s.DraftStore.Get(userID, channelID, rootID, includeDeleted)
+	result, err := s.CommandStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3017,15 +3464,15 @@ func (s *TimerLayerDraftStore) Get(userID string, channelID string, rootID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerDraftStore) GetDraftsForUser(userID string, teamID string) ([]*model.Draft, error) {
+func (s *TimerLayerCommandStore) GetByCreator(creatorID string) ([]*model.Command, error) {
 	start := time.Now()
 
-	result, err := s.DraftStore.GetDraftsForUser(userID, teamID)
+	result, err := s.CommandStore.GetByCreator(creatorID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3033,15 +3480,15 @@ func (s *TimerLayerDraftStore) GetDraftsForUser(userID string, teamID string) ([
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.GetDraftsForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByCreator", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerDraftStore) Save(d *model.Draft) (*model.Draft, error) {
+func (s *TimerLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, error) {
 	start := time.Now()
 
-	result, err := s.DraftStore.Save(d)
+	result, err := s.CommandStore.GetByTeam(teamID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3049,15 +3496,15 @@ func (s *TimerLayerDraftStore) Save(d *model.Draft) (*model.Draft, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerDraftStore) Update(d *model.Draft) (*model.Draft, error) {
+func (s *TimerLayerCommandStore) GetByTrigger(teamID string, trigger string) (*model.Command, error) {
 	start := time.Now()
 
-	result, err := s.DraftStore.Update(d)
+	result, err := s.CommandStore.GetByTrigger(teamID, trigger)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3065,15 +3512,15 @@ func (s *TimerLayerDraftStore) Update(d *model.Draft) (*model.Draft, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTrigger", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, timestamp int64) error {
+func (s *TimerLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
 	start := time.Now()
 
-	err := s.EmojiStore.Delete(emoji, timestamp)
+	err := s.CommandStore.PermanentDeleteByTeam(teamID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3081,15 +3528,15 @@ func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, timestamp int64) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByTeam", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerEmojiStore) Get(ctx context.Context, id string, allowFromCache bool) (*model.Emoji, error) {
+func (s *TimerLayerCommandStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
-	result, err := s.EmojiStore.Get(ctx, id, allowFromCache)
+	err := s.CommandStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3097,15 +3544,15 @@ func (s *TimerLayerEmojiStore) Get(ctx context.Context, id string, allowFromCach
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerEmojiStore) GetByName(ctx context.Context, name string, allowFromCache bool) (*model.Emoji, error) {
+func (s *TimerLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
 	start := time.Now()
 
-	result, err := s.EmojiStore.GetByName(ctx, name, allowFromCache)
+	result, err := s.CommandStore.Save(webhook)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3113,15 +3560,15 @@ func (s *TimerLayerEmojiStore) GetByName(ctx context.Context, name string, allow
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
+func (s *TimerLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
 	start := time.Now()
 
-	result, err := s.EmojiStore.GetList(offset, limit, sort)
+	result, err := s.CommandStore.Update(hook)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3129,31 +3576,30 @@ func (s *TimerLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*m
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetList", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
+func (s *TimerLayerCommandWebhookStore) Cleanup() {
 	start := time.Now()
 
-	result, err := s.EmojiStore.GetMultipleByName(names)
+	s.CommandWebhookStore.Cleanup()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetMultipleByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Cleanup", success, elapsed)
 	}
-	return result, err
 }
 
-func (s *TimerLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+func (s *TimerLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, error) {
 	start := time.Now()
 
-	result, err := s.EmojiStore.Save(emoji)
+	result, err := s.CommandWebhookStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3161,15 +3607,15 @@ func (s *TimerLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
+func (s *TimerLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, error) {
 	start := time.Now()
 
-	result, err := s.EmojiStore.Search(name, prefixOnly, limit)
+	result, err := s.CommandWebhookStore.Save(webhook)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3177,15 +3623,15 @@ func (s *TimerLayerEmojiStore) Search(name string, prefixOnly bool, limit int) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Search", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) AttachToPost(fileID string, postID string, channelID string, creatorID string) error {
+func (s *TimerLayerCommandWebhookStore) TryUse(id string, limit int) error {
 	start := time.Now()
 
-	err := s.FileInfoStore.AttachToPost(fileID, postID, channelID, creatorID)
+	err := s.CommandWebhookStore.TryUse(id, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3193,30 +3639,31 @@ func (s *TimerLayerFileInfoStore) AttachToPost(fileID string, postID string, cha
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.AttachToPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.TryUse", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerFileInfoStore) ClearCaches() {
+func (s *TimerLayerComplianceStore) ComplianceExport(compliance *model.Compliance, cursor model.ComplianceExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceExportCursor, error) {
 	start := time.Now()
 
-	s.FileInfoStore.ClearCaches()
+	result, resultVar1, err := s.ComplianceStore.ComplianceExport(compliance, cursor, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.ClearCaches", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.ComplianceExport", success, elapsed)
 	}
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerFileInfoStore) CountAll() (int64, error) {
+func (s *TimerLayerComplianceStore) Get(id string) (*model.Compliance, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.CountAll()
+	result, err := s.ComplianceStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3224,15 +3671,15 @@ func (s *TimerLayerFileInfoStore) CountAll() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.CountAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
+func (s *TimerLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.DeleteForPost(postID)
+	result, err := s.ComplianceStore.GetAll(offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3240,15 +3687,15 @@ func (s *TimerLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.DeleteForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
+func (s *TimerLayerComplianceStore) MessageExport(ctx context.Context, cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.Get(id)
+	result, resultVar1, err := s.ComplianceStore.MessageExport(ctx, cursor, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3256,15 +3703,15 @@ func (s *TimerLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.MessageExport", success, elapsed)
 	}
-	return result, err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
+func (s *TimerLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.GetByIds(ids)
+	result, err := s.ComplianceStore.Save(compliance)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3272,15 +3719,15 @@ func (s *TimerLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
+func (s *TimerLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.GetByPath(path)
+	result, err := s.ComplianceStore.Update(compliance)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3288,15 +3735,15 @@ func (s *TimerLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByPath", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, startFileID string, limit int) ([]*model.FileForIndexing, error) {
+func (s *TimerLayerConfigChangeStore) Get(id string) (*model.ConfigChange, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.GetFilesBatchForIndexing(startTime, startFileID, limit)
+	result, err := s.ConfigChangeStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3304,15 +3751,15 @@ func (s *TimerLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, star
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetFilesBatchForIndexing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ConfigChangeStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
+func (s *TimerLayerConfigChangeStore) GetAll(offset int, limit int) ([]*model.ConfigChange, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.GetForPost(postID, readFromMaster, includeDeleted, allowFromCache)
+	result, err := s.ConfigChangeStore.GetAll(offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3320,15 +3767,15 @@ func (s *TimerLayerFileInfoStore) GetForPost(postID string, readFromMaster bool,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ConfigChangeStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
+func (s *TimerLayerConfigChangeStore) Save(change *model.ConfigChange) (*model.ConfigChange, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.GetForUser(userID)
+	result, err := s.ConfigChangeStore.Save(change)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3336,15 +3783,15 @@ func (s *TimerLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ConfigChangeStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
+func (s *TimerLayerDraftStore) Delete(userID string, channelID string, rootID string) error {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.GetFromMaster(id)
+	err := s.DraftStore.Delete(userID, channelID, rootID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3352,15 +3799,15 @@ func (s *TimerLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetFromMaster", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDeleted bool) (int64, error) {
+func (s *TimerLayerDraftStore) Get(userID string, channelID string, rootID string, includeDeleted bool) (*model.Draft, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.GetStorageUsage(allowFromCache, includeDeleted)
+	result, err := s.DraftStore.Get(userID, channelID, rootID, includeDeleted)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3368,15 +3815,15 @@ func (s *TimerLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetStorageUsage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetUptoNSizeFileTime(n int64) (int64, error) {
+func (s *TimerLayerDraftStore) GetDraftsForUser(userID string, teamID string) ([]*model.Draft, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.GetUptoNSizeFileTime(n)
+	result, err := s.DraftStore.GetDraftsForUser(userID, teamID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3384,15 +3831,15 @@ func (s *TimerLayerFileInfoStore) GetUptoNSizeFileTime(n int64) (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetUptoNSizeFileTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.GetDraftsForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error) {
+func (s *TimerLayerDraftStore) Save(d *model.Draft) (*model.Draft, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.GetWithOptions(page, perPage, opt)
+	result, err := s.DraftStore.Save(d)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3400,30 +3847,31 @@ func (s *TimerLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetWithOptions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
+func (s *TimerLayerDraftStore) Update(d *model.Draft) (*model.Draft, error) {
 	start := time.Now()
 
-	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
+	result, err := s.DraftStore.Update(d)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.InvalidateFileInfosForPostCache", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Update", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) PermanentDelete(fileID string) error {
+func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, timestamp int64) error {
 	start := time.Now()
 
-	err := s.FileInfoStore.PermanentDelete(fileID)
+	err := s.EmojiStore.Delete(emoji, timestamp)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3431,15 +3879,15 @@ func (s *TimerLayerFileInfoStore) PermanentDelete(fileID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDelete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Delete", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *TimerLayerEmojiStore) Get(ctx context.Context, id string, allowFromCache bool) (*model.Emoji, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
+	result, err := s.EmojiStore.Get(ctx, id, allowFromCache)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3447,15 +3895,15 @@ func (s *TimerLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int6
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) PermanentDeleteByUser(userID string) (int64, error) {
+func (s *TimerLayerEmojiStore) GetByName(ctx context.Context, name string, allowFromCache bool) (*model.Emoji, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.PermanentDeleteByUser(userID)
+	result, err := s.EmojiStore.GetByName(ctx, name, allowFromCache)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3463,15 +3911,15 @@ func (s *TimerLayerFileInfoStore) PermanentDeleteByUser(userID string) (int64, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetByName", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
+func (s *TimerLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.Save(info)
+	result, err := s.EmojiStore.GetList(offset, limit, sort)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3479,15 +3927,15 @@ func (s *TimerLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetList", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) Search(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
+func (s *TimerLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.Search(paramsList, userID, teamID, page, perPage)
+	result, err := s.EmojiStore.GetMultipleByName(names)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3495,15 +3943,15 @@ func (s *TimerLayerFileInfoStore) Search(paramsList []*model.SearchParams, userI
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Search", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetMultipleByName", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) SetContent(fileID string, content string) error {
+func (s *TimerLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
 	start := time.Now()
 
-	err := s.FileInfoStore.SetContent(fileID, content)
+	result, err := s.EmojiStore.Save(emoji)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3511,15 +3959,15 @@ func (s *TimerLayerFileInfoStore) SetContent(fileID string, content string) erro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.SetContent", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error) {
+func (s *TimerLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
 	start := time.Now()
 
-	result, err := s.FileInfoStore.Upsert(info)
+	result, err := s.EmojiStore.Search(name, prefixOnly, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3527,15 +3975,15 @@ func (s *TimerLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Upsert", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Search", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+func (s *TimerLayerFileInfoStore) AttachToPost(fileID string, postID string, channelID string, creatorID string) error {
 	start := time.Now()
 
-	result, err := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
+	err := s.FileInfoStore.AttachToPost(fileID, postID, channelID, creatorID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3543,31 +3991,30 @@ func (s *TimerLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, s
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.AdminRoleGroupsForSyncableMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.AttachToPost", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+func (s *TimerLayerFileInfoStore) ClearCaches() {
 	start := time.Now()
 
-	result, err := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
+	s.FileInfoStore.ClearCaches()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.ClearCaches", success, elapsed)
 	}
-	return result, err
 }
 
-func (s *TimerLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, error) {
+func (s *TimerLayerFileInfoStore) CountAll() (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.ChannelMembersToAdd(since, channelID, includeRemovedMembers)
+	result, err := s.FileInfoStore.CountAll()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3575,15 +4022,15 @@ func (s *TimerLayerGroupStore) ChannelMembersToAdd(since int64, channelID *strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToAdd", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.CountAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, error) {
+func (s *TimerLayerFileInfoStore) CountForColdStorage(before int64) (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.ChannelMembersToRemove(channelID)
+	result, err := s.FileInfoStore.CountForColdStorage(before)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3591,15 +4038,15 @@ func (s *TimerLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToRemove", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.CountForColdStorage", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, error) {
+func (s *TimerLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
+	result, err := s.FileInfoStore.DeleteForPost(postID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3607,15 +4054,15 @@ func (s *TimerLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID st
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountChannelMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.DeleteForPost", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CountGroupsByChannel(channelID string, opts model.GroupSearchOpts) (int64, error) {
+func (s *TimerLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.CountGroupsByChannel(channelID, opts)
+	result, err := s.FileInfoStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3623,15 +4070,15 @@ func (s *TimerLayerGroupStore) CountGroupsByChannel(channelID string, opts model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CountGroupsByTeam(teamID string, opts model.GroupSearchOpts) (int64, error) {
+func (s *TimerLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.CountGroupsByTeam(teamID, opts)
+	result, err := s.FileInfoStore.GetByIds(ids)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3639,15 +4086,15 @@ func (s *TimerLayerGroupStore) CountGroupsByTeam(teamID string, opts model.Group
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByIds", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, error) {
+func (s *TimerLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
+	result, err := s.FileInfoStore.GetByPath(path)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3655,15 +4102,15 @@ func (s *TimerLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountTeamMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByPath", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) Create(group *model.Group) (*model.Group, error) {
+func (s *TimerLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, startFileID string, limit int) ([]*model.FileForIndexing, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.Create(group)
+	result, err := s.FileInfoStore.GetFilesBatchForIndexing(startTime, startFileID, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3671,15 +4118,15 @@ func (s *TimerLayerGroupStore) Create(group *model.Group) (*model.Group, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Create", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetFilesBatchForIndexing", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
+func (s *TimerLayerFileInfoStore) GetForColdStorage(before int64, afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.CreateGroupSyncable(groupSyncable)
+	result, err := s.FileInfoStore.GetForColdStorage(before, afterCreateAt, afterID, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3687,15 +4134,15 @@ func (s *TimerLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyn
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CreateGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForColdStorage", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds) (*model.Group, error) {
+func (s *TimerLayerFileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.CreateWithUserIds(group)
+	result, err := s.FileInfoStore.GetForPost(postID, readFromMaster, includeDeleted, allowFromCache)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3703,15 +4150,15 @@ func (s *TimerLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CreateWithUserIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForPost", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) Delete(groupID string) (*model.Group, error) {
+func (s *TimerLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.Delete(groupID)
+	result, err := s.FileInfoStore.GetForUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3719,15 +4166,15 @@ func (s *TimerLayerGroupStore) Delete(groupID string) (*model.Group, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
+func (s *TimerLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
+	result, err := s.FileInfoStore.GetFromMaster(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3735,15 +4182,15 @@ func (s *TimerLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID st
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetFromMaster", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *TimerLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDeleted bool) (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.DeleteMember(groupID, userID)
+	result, err := s.FileInfoStore.GetStorageUsage(allowFromCache, includeDeleted)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3751,15 +4198,15 @@ func (s *TimerLayerGroupStore) DeleteMember(groupID string, userID string) (*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetStorageUsage", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) DeleteMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
+func (s *TimerLayerFileInfoStore) GetUptoNSizeFileTime(n int64) (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.DeleteMembers(groupID, userIDs)
+	result, err := s.FileInfoStore.GetUptoNSizeFileTime(n)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3767,15 +4214,15 @@ func (s *TimerLayerGroupStore) DeleteMembers(groupID string, userIDs []string) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetUptoNSizeFileTime", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
+func (s *TimerLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.DistinctGroupMemberCount()
+	result, err := s.FileInfoStore.GetWithOptions(page, perPage, opt)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3783,31 +4230,30 @@ func (s *TimerLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DistinctGroupMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetWithOptions", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) DistinctGroupMemberCountForSource(source model.GroupSource) (int64, error) {
+func (s *TimerLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
 	start := time.Now()
 
-	result, err := s.GroupStore.DistinctGroupMemberCountForSource(source)
+	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DistinctGroupMemberCountForSource", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.InvalidateFileInfosForPostCache", success, elapsed)
 	}
-	return result, err
 }
 
-func (s *TimerLayerGroupStore) Get(groupID string) (*model.Group, error) {
+func (s *TimerLayerFileInfoStore) PermanentDelete(fileID string) error {
 	start := time.Now()
 
-	result, err := s.GroupStore.Get(groupID)
+	err := s.FileInfoStore.PermanentDelete(fileID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3815,15 +4261,15 @@ func (s *TimerLayerGroupStore) Get(groupID string) (*model.Group, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDelete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, error) {
+func (s *TimerLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetAllBySource(groupSource)
+	result, err := s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3831,15 +4277,15 @@ func (s *TimerLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllBySource", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, error) {
+func (s *TimerLayerFileInfoStore) PermanentDeleteByUser(userID string) (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
+	result, err := s.FileInfoStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3847,15 +4293,15 @@ func (s *TimerLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syn
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllGroupSyncablesByGroupId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteByUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, error) {
+func (s *TimerLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetByIDs(groupIDs)
+	result, err := s.FileInfoStore.Save(info)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3863,15 +4309,15 @@ func (s *TimerLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, erro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByIDs", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, error) {
+func (s *TimerLayerFileInfoStore) Search(ctx context.Context, paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetByName(name, opts)
+	result, err := s.FileInfoStore.Search(ctx, paramsList, userID, teamID, page, perPage)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3879,15 +4325,15 @@ func (s *TimerLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Search", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, error) {
+func (s *TimerLayerFileInfoStore) SetColdStorageForPath(path string) error {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetByRemoteID(remoteID, groupSource)
+	err := s.FileInfoStore.SetColdStorageForPath(path)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3895,15 +4341,15 @@ func (s *TimerLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByRemoteID", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.SetColdStorageForPath", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) GetByUser(userID string) ([]*model.Group, error) {
+func (s *TimerLayerFileInfoStore) SetContent(fileID string, content string) error {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetByUser(userID)
+	err := s.FileInfoStore.SetContent(fileID, content)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3911,15 +4357,15 @@ func (s *TimerLayerGroupStore) GetByUser(userID string) ([]*model.Group, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.SetContent", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
+func (s *TimerLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
+	result, err := s.FileInfoStore.Upsert(info)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3927,15 +4373,15 @@ func (s *TimerLayerGroupStore) GetGroupSyncable(groupID string, syncableID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Upsert", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts, viewRestrictions *model.ViewUsersRestrictions) ([]*model.Group, error) {
+func (s *TimerLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetGroups(page, perPage, opts, viewRestrictions)
+	result, err := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3943,15 +4389,15 @@ func (s *TimerLayerGroupStore) GetGroups(page int, perPage int, opts model.Group
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroups", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.AdminRoleGroupsForSyncableMember", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamID string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, error) {
+func (s *TimerLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamID, opts)
+	result, err := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3959,15 +4405,15 @@ func (s *TimerLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamID string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsAssociatedToChannelsByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersMinusGroupMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetGroupsByChannel(channelID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
+func (s *TimerLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetGroupsByChannel(channelID, opts)
+	result, err := s.GroupStore.ChannelMembersToAdd(since, channelID, includeRemovedMembers)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3975,15 +4421,15 @@ func (s *TimerLayerGroupStore) GetGroupsByChannel(channelID string, opts model.G
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToAdd", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
+func (s *TimerLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetGroupsByTeam(teamID, opts)
+	result, err := s.GroupStore.ChannelMembersToRemove(channelID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3991,15 +4437,15 @@ func (s *TimerLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToRemove", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *TimerLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetMember(groupID, userID)
+	result, err := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4007,15 +4453,15 @@ func (s *TimerLayerGroupStore) GetMember(groupID string, userID string) (*model.
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountChannelMembersMinusGroupMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
+func (s *TimerLayerGroupStore) CountGroupsByChannel(channelID string, opts model.GroupSearchOpts) (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetMemberCount(groupID)
+	result, err := s.GroupStore.CountGroupsByChannel(channelID, opts)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4023,15 +4469,15 @@ func (s *TimerLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberCountWithRestrictions(groupID string, viewRestrictions *model.ViewUsersRestrictions) (int64, error) {
+func (s *TimerLayerGroupStore) CountGroupsByTeam(teamID string, opts model.GroupSearchOpts) (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetMemberCountWithRestrictions(groupID, viewRestrictions)
+	result, err := s.GroupStore.CountGroupsByTeam(teamID, opts)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4039,15 +4485,15 @@ func (s *TimerLayerGroupStore) GetMemberCountWithRestrictions(groupID string, vi
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberCountWithRestrictions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, error) {
+func (s *TimerLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetMemberUsers(groupID)
+	result, err := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4055,15 +4501,15 @@ func (s *TimerLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountTeamMembersMinusGroupMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, error) {
+func (s *TimerLayerGroupStore) Create(group *model.Group) (*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
+	result, err := s.GroupStore.Create(group)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4071,15 +4517,15 @@ func (s *TimerLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersInTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Create", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, error) {
+func (s *TimerLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
+	result, err := s.GroupStore.CreateGroupSyncable(groupSyncable)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4087,15 +4533,15 @@ func (s *TimerLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersNotInChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CreateGroupSyncable", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
+func (s *TimerLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds) (*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetMemberUsersPage(groupID, page, perPage, viewRestrictions)
+	result, err := s.GroupStore.CreateWithUserIds(group)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4103,15 +4549,15 @@ func (s *TimerLayerGroupStore) GetMemberUsersPage(groupID string, page int, perP
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CreateWithUserIds", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsersSortedPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions, teammateNameDisplay string) ([]*model.User, error) {
+func (s *TimerLayerGroupStore) Delete(groupID string) (*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetMemberUsersSortedPage(groupID, page, perPage, viewRestrictions, teammateNameDisplay)
+	result, err := s.GroupStore.Delete(groupID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4119,15 +4565,15 @@ func (s *TimerLayerGroupStore) GetMemberUsersSortedPage(groupID string, page int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersSortedPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Delete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetNonMemberUsersPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
+func (s *TimerLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GetNonMemberUsersPage(groupID, page, perPage, viewRestrictions)
+	result, err := s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4135,15 +4581,15 @@ func (s *TimerLayerGroupStore) GetNonMemberUsersPage(groupID string, page int, p
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetNonMemberUsersPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteGroupSyncable", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupChannelCount() (int64, error) {
+func (s *TimerLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GroupChannelCount()
+	result, err := s.GroupStore.DeleteMember(groupID, userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4151,15 +4597,15 @@ func (s *TimerLayerGroupStore) GroupChannelCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupChannelCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteMember", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupCount() (int64, error) {
+func (s *TimerLayerGroupStore) DeleteMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GroupCount()
+	result, err := s.GroupStore.DeleteMembers(groupID, userIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4167,15 +4613,15 @@ func (s *TimerLayerGroupStore) GroupCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupCountBySource(source model.GroupSource) (int64, error) {
+func (s *TimerLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GroupCountBySource(source)
+	result, err := s.GroupStore.DistinctGroupMemberCount()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4183,15 +4629,15 @@ func (s *TimerLayerGroupStore) GroupCountBySource(source model.GroupSource) (int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCountBySource", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DistinctGroupMemberCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
+func (s *TimerLayerGroupStore) DistinctGroupMemberCountForSource(source model.GroupSource) (int64, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GroupCountWithAllowReference()
+	result, err := s.GroupStore.DistinctGroupMemberCountForSource(source)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4199,15 +4645,15 @@ func (s *TimerLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCountWithAllowReference", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DistinctGroupMemberCountForSource", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupMemberCount() (int64, error) {
+func (s *TimerLayerGroupStore) Get(groupID string) (*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GroupMemberCount()
+	result, err := s.GroupStore.Get(groupID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4215,15 +4661,15 @@ func (s *TimerLayerGroupStore) GroupMemberCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupTeamCount() (int64, error) {
+func (s *TimerLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GroupTeamCount()
+	result, err := s.GroupStore.GetAllBySource(groupSource)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4231,15 +4677,15 @@ func (s *TimerLayerGroupStore) GroupTeamCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupTeamCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllBySource", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userID string) error {
+func (s *TimerLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, error) {
 	start := time.Now()
 
-	err := s.GroupStore.PermanentDeleteMembersByUser(userID)
+	result, err := s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4247,15 +4693,15 @@ func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userID string) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermanentDeleteMembersByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllGroupSyncablesByGroupId", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+func (s *TimerLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
+	result, err := s.GroupStore.GetByIDs(groupIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4263,15 +4709,15 @@ func (s *TimerLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncab
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermittedSyncableAdmins", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByIDs", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) Restore(groupID string) (*model.Group, error) {
+func (s *TimerLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.Restore(groupID)
+	result, err := s.GroupStore.GetByName(name, opts)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4279,15 +4725,15 @@ func (s *TimerLayerGroupStore) Restore(groupID string) (*model.Group, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Restore", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByName", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+func (s *TimerLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
+	result, err := s.GroupStore.GetByRemoteID(remoteID, groupSource)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4295,15 +4741,15 @@ func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, group
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByRemoteID", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, includeRemovedMembers bool) ([]*model.UserTeamIDPair, error) {
+func (s *TimerLayerGroupStore) GetByUser(userID string) ([]*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.TeamMembersToAdd(since, teamID, includeRemovedMembers)
+	result, err := s.GroupStore.GetByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4311,15 +4757,15 @@ func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, inc
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToAdd", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, error) {
+func (s *TimerLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.TeamMembersToRemove(teamID)
+	result, err := s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4327,15 +4773,15 @@ func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.Tea
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToRemove", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupSyncable", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, error) {
+func (s *TimerLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts, viewRestrictions *model.ViewUsersRestrictions) ([]*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.Update(group)
+	result, err := s.GroupStore.GetGroups(page, perPage, opts, viewRestrictions)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4343,15 +4789,15 @@ func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroups", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
+func (s *TimerLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamID string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.UpdateGroupSyncable(groupSyncable)
+	result, err := s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamID, opts)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4359,15 +4805,15 @@ func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyn
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpdateGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsAssociatedToChannelsByTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *TimerLayerGroupStore) GetGroupsByChannel(channelID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.UpsertMember(groupID, userID)
+	result, err := s.GroupStore.GetGroupsByChannel(channelID, opts)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4375,15 +4821,15 @@ func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) UpsertMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
+func (s *TimerLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
+	result, err := s.GroupStore.GetGroupsByTeam(teamID, opts)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4391,15 +4837,15 @@ func (s *TimerLayerGroupStore) UpsertMembers(groupID string, userIDs []string) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
+func (s *TimerLayerGroupStore) GetMember(groupID string, userID string) (*model.GroupMember, error) {
 	start := time.Now()
 
-	err := s.JobStore.Cleanup(expiryTime, batchSize)
+	result, err := s.GroupStore.GetMember(groupID, userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4407,15 +4853,15 @@ func (s *TimerLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Cleanup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMember", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerJobStore) Delete(id string) (string, error) {
+func (s *TimerLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.Delete(id)
+	result, err := s.GroupStore.GetMemberCount(groupID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4423,15 +4869,15 @@ func (s *TimerLayerJobStore) Delete(id string) (string, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) Get(id string) (*model.Job, error) {
+func (s *TimerLayerGroupStore) GetMemberCountWithRestrictions(groupID string, viewRestrictions *model.ViewUsersRestrictions) (int64, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.Get(id)
+	result, err := s.GroupStore.GetMemberCountWithRestrictions(groupID, viewRestrictions)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4439,15 +4885,15 @@ func (s *TimerLayerJobStore) Get(id string) (*model.Job, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberCountWithRestrictions", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error) {
+func (s *TimerLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.GetAllByStatus(status)
+	result, err := s.GroupStore.GetMemberUsers(groupID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4455,15 +4901,15 @@ func (s *TimerLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByStatus", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error) {
+func (s *TimerLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.GetAllByType(jobType)
+	result, err := s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4471,15 +4917,15 @@ func (s *TimerLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersInTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetAllByTypeAndStatus(jobType string, status string) ([]*model.Job, error) {
+func (s *TimerLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.GetAllByTypeAndStatus(jobType, status)
+	result, err := s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4487,15 +4933,15 @@ func (s *TimerLayerJobStore) GetAllByTypeAndStatus(jobType string, status string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypeAndStatus", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersNotInChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error) {
+func (s *TimerLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.GetAllByTypePage(jobType, offset, limit)
+	result, err := s.GroupStore.GetMemberUsersPage(groupID, page, perPage, viewRestrictions)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4503,15 +4949,15 @@ func (s *TimerLayerJobStore) GetAllByTypePage(jobType string, offset int, limit
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypePage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersPage", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, limit int) ([]*model.Job, error) {
+func (s *TimerLayerGroupStore) GetMemberUsersSortedPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions, teammateNameDisplay string) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.GetAllByTypesPage(jobTypes, offset, limit)
+	result, err := s.GroupStore.GetMemberUsersSortedPage(groupID, page, perPage, viewRestrictions, teammateNameDisplay)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4519,15 +4965,15 @@ func (s *TimerLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, li
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypesPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersSortedPage", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, error) {
+func (s *TimerLayerGroupStore) GetNonMemberUsersPage(groupID string, page int, perPage int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.GetAllPage(offset, limit)
+	result, err := s.GroupStore.GetNonMemberUsersPage(groupID, page, perPage, viewRestrictions)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4535,15 +4981,15 @@ func (s *TimerLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetNonMemberUsersPage", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, error) {
+func (s *TimerLayerGroupStore) GroupChannelCount() (int64, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.GetCountByStatusAndType(status, jobType)
+	result, err := s.GroupStore.GroupChannelCount()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4551,15 +4997,15 @@ func (s *TimerLayerJobStore) GetCountByStatusAndType(status string, jobType stri
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetCountByStatusAndType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupChannelCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
+func (s *TimerLayerGroupStore) GroupCount() (int64, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
+	result, err := s.GroupStore.GroupCount()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4567,15 +5013,15 @@ func (s *TimerLayerJobStore) GetNewestJobByStatusAndType(status string, jobType
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetNewestJobByStatusAndType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error) {
+func (s *TimerLayerGroupStore) GroupCountBySource(source model.GroupSource) (int64, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.GetNewestJobByStatusesAndType(statuses, jobType)
+	result, err := s.GroupStore.GroupCountBySource(source)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4583,15 +5029,15 @@ func (s *TimerLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetNewestJobByStatusesAndType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCountBySource", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) Save(job *model.Job) (*model.Job, error) {
+func (s *TimerLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.Save(job)
+	result, err := s.GroupStore.GroupCountWithAllowReference()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4599,15 +5045,15 @@ func (s *TimerLayerJobStore) Save(job *model.Job) (*model.Job, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCountWithAllowReference", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
+func (s *TimerLayerGroupStore) GroupMemberCount() (int64, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.UpdateOptimistically(job, currentStatus)
+	result, err := s.GroupStore.GroupMemberCount()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4615,15 +5061,15 @@ func (s *TimerLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateOptimistically", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupMemberCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) UpdateStatus(id string, status string) (*model.Job, error) {
+func (s *TimerLayerGroupStore) GroupTeamCount() (int64, error) {
 	start := time.Now()
 
-	result, err := s.JobStore.UpdateStatus(id, status)
+	result, err := s.GroupStore.GroupTeamCount()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4631,15 +5077,15 @@ func (s *TimerLayerJobStore) UpdateStatus(id string, status string) (*model.Job,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatus", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupTeamCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, error) {
+func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userID string) error {
 	start := time.Now()
 
-	result, err := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
+	err := s.GroupStore.PermanentDeleteMembersByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4647,15 +5093,15 @@ func (s *TimerLayerJobStore) UpdateStatusOptimistically(id string, currentStatus
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatusOptimistically", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermanentDeleteMembersByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
+func (s *TimerLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
 	start := time.Now()
 
-	result, err := s.LicenseStore.Get(id)
+	result, err := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4663,15 +5109,15 @@ func (s *TimerLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermittedSyncableAdmins", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
+func (s *TimerLayerGroupStore) Restore(groupID string) (*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.LicenseStore.GetAll()
+	result, err := s.GroupStore.Restore(groupID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4679,15 +5125,15 @@ func (s *TimerLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Restore", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
+func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
 	start := time.Now()
 
-	result, err := s.LicenseStore.Save(license)
+	result, err := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4695,15 +5141,15 @@ func (s *TimerLayerLicenseStore) Save(license *model.LicenseRecord) (*model.Lice
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersMinusGroupMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
+func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, includeRemovedMembers bool) ([]*model.UserTeamIDPair, error) {
 	start := time.Now()
 
-	result, err := s.LinkMetadataStore.Get(url, timestamp)
+	result, err := s.GroupStore.TeamMembersToAdd(since, teamID, includeRemovedMembers)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4711,15 +5157,15 @@ func (s *TimerLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.L
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToAdd", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
+func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, error) {
 	start := time.Now()
 
-	result, err := s.LinkMetadataStore.Save(linkMetadata)
+	result, err := s.GroupStore.TeamMembersToRemove(teamID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4727,15 +5173,15 @@ func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*m
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToRemove", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
+func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, error) {
 	start := time.Now()
 
-	err := s.NotifyAdminStore.DeleteBefore(trial, now)
+	result, err := s.GroupStore.Update(group)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4743,15 +5189,15 @@ func (s *TimerLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.DeleteBefore", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Update", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData, error) {
+func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
 	start := time.Now()
 
-	result, err := s.NotifyAdminStore.Get(trial)
+	result, err := s.GroupStore.UpdateGroupSyncable(groupSyncable)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4759,15 +5205,15 @@ func (s *TimerLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpdateGroupSyncable", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, feature model.MattermostFeature) ([]*model.NotifyAdminData, error) {
+func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
 	start := time.Now()
 
-	result, err := s.NotifyAdminStore.GetDataByUserIdAndFeature(userId, feature)
+	result, err := s.GroupStore.UpsertMember(groupID, userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4775,15 +5221,15 @@ func (s *TimerLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, fe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.GetDataByUserIdAndFeature", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMember", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.NotifyAdminData, error) {
+func (s *TimerLayerGroupStore) UpsertMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
 	start := time.Now()
 
-	result, err := s.NotifyAdminStore.Save(data)
+	result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4791,15 +5237,15 @@ func (s *TimerLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.N
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerNotifyAdminStore) Update(userId string, requiredPlan string, requiredFeature model.MattermostFeature, now int64) error {
+func (s *TimerLayerGuestSponsorshipStore) Get(userID string) (*model.GuestSponsorship, error) {
 	start := time.Now()
 
-	err := s.NotifyAdminStore.Update(userId, requiredPlan, requiredFeature, now)
+	result, err := s.GuestSponsorshipStore.Get(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4807,15 +5253,15 @@ func (s *TimerLayerNotifyAdminStore) Update(userId string, requiredPlan string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestSponsorshipStore.Get", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
+func (s *TimerLayerGuestSponsorshipStore) GetExpired(now int64, limit int) ([]*model.GuestSponsorship, error) {
 	start := time.Now()
 
-	err := s.OAuthStore.DeleteApp(id)
+	result, err := s.GuestSponsorshipStore.GetExpired(now, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4823,15 +5269,15 @@ func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.DeleteApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestSponsorshipStore.GetExpired", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+func (s *TimerLayerGuestSponsorshipStore) GetForSponsor(sponsorID string, offset int, limit int) ([]*model.GuestSponsorship, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetAccessData(token)
+	result, err := s.GuestSponsorshipStore.GetForSponsor(sponsorID, offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4839,15 +5285,15 @@ func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestSponsorshipStore.GetForSponsor", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+func (s *TimerLayerGuestSponsorshipStore) GetSponsorReport(offset int, limit int) ([]*model.GuestSponsorReport, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
+	result, err := s.GuestSponsorshipStore.GetSponsorReport(offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4855,15 +5301,15 @@ func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByRefreshToken", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestSponsorshipStore.GetSponsorReport", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId string) ([]*model.AccessData, error) {
+func (s *TimerLayerGuestSponsorshipStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetAccessDataByUserForApp(userID, clientId)
+	err := s.GuestSponsorshipStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4871,15 +5317,15 @@ func (s *TimerLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByUserForApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestSponsorshipStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
+func (s *TimerLayerGuestSponsorshipStore) Save(sponsorship *model.GuestSponsorship) (*model.GuestSponsorship, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetApp(id)
+	result, err := s.GuestSponsorshipStore.Save(sponsorship)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4887,15 +5333,15 @@ func (s *TimerLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestSponsorshipStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAppByUser(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *TimerLayerGuestSponsorshipStore) Update(sponsorship *model.GuestSponsorship) (*model.GuestSponsorship, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetAppByUser(userID, offset, limit)
+	result, err := s.GuestSponsorshipStore.Update(sponsorship)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4903,15 +5349,15 @@ func (s *TimerLayerOAuthStore) GetAppByUser(userID string, offset int, limit int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAppByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestSponsorshipStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *TimerLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetApps(offset, limit)
+	err := s.JobStore.Cleanup(expiryTime, batchSize)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4919,15 +5365,15 @@ func (s *TimerLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApps", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Cleanup", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
+func (s *TimerLayerJobStore) Delete(id string) (string, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetAuthData(code)
+	result, err := s.JobStore.Delete(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4935,15 +5381,15 @@ func (s *TimerLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Delete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *TimerLayerJobStore) Get(id string) (*model.Job, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetAuthorizedApps(userID, offset, limit)
+	result, err := s.JobStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4951,15 +5397,15 @@ func (s *TimerLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limi
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthorizedApps", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetPreviousAccessData(userID string, clientId string) (*model.AccessData, error) {
+func (s *TimerLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetPreviousAccessData(userID, clientId)
+	result, err := s.JobStore.GetAllByStatus(status)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4967,15 +5413,15 @@ func (s *TimerLayerOAuthStore) GetPreviousAccessData(userID string, clientId str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetPreviousAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByStatus", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
+func (s *TimerLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error) {
 	start := time.Now()
 
-	err := s.OAuthStore.PermanentDeleteAuthDataByUser(userID)
+	result, err := s.JobStore.GetAllByType(jobType)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4983,15 +5429,15 @@ func (s *TimerLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) erro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.PermanentDeleteAuthDataByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByType", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) RemoveAccessData(token string) error {
+func (s *TimerLayerJobStore) GetAllByTypeAndStatus(jobType string, status string) ([]*model.Job, error) {
 	start := time.Now()
 
-	err := s.OAuthStore.RemoveAccessData(token)
+	result, err := s.JobStore.GetAllByTypeAndStatus(jobType, status)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4999,15 +5445,15 @@ func (s *TimerLayerOAuthStore) RemoveAccessData(token string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypeAndStatus", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) RemoveAllAccessData() error {
+func (s *TimerLayerJobStore) GetAllByTypeAndStatusPage(jobType string, status string, offset int, limit int) ([]*model.Job, error) {
 	start := time.Now()
 
-	err := s.OAuthStore.RemoveAllAccessData()
+	result, err := s.JobStore.GetAllByTypeAndStatusPage(jobType, status, offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5015,15 +5461,15 @@ func (s *TimerLayerOAuthStore) RemoveAllAccessData() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAllAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypeAndStatusPage", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) RemoveAuthData(code string) error {
+func (s *TimerLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error) {
 	start := time.Now()
 
-	err := s.OAuthStore.RemoveAuthData(code)
+	result, err := s.JobStore.GetAllByTypePage(jobType, offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5031,15 +5477,15 @@ func (s *TimerLayerOAuthStore) RemoveAuthData(code string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAuthData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypePage", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) RemoveAuthDataByClientId(clientId string, userId string) error {
+func (s *TimerLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, limit int) ([]*model.Job, error) {
 	start := time.Now()
 
-	err := s.OAuthStore.RemoveAuthDataByClientId(clientId, userId)
+	result, err := s.JobStore.GetAllByTypesPage(jobTypes, offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5047,15 +5493,15 @@ func (s *TimerLayerOAuthStore) RemoveAuthDataByClientId(clientId string, userId
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAuthDataByClientId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypesPage", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+func (s *TimerLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.SaveAccessData(accessData)
+	result, err := s.JobStore.GetAllPage(offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5063,15 +5509,15 @@ func (s *TimerLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*mo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllPage", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+func (s *TimerLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.SaveApp(app)
+	result, err := s.JobStore.GetCountByStatusAndType(status, jobType)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5079,15 +5525,15 @@ func (s *TimerLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetCountByStatusAndType", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
+func (s *TimerLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.SaveAuthData(authData)
+	result, err := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5095,15 +5541,15 @@ func (s *TimerLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.Au
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAuthData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetNewestJobByStatusAndType", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+func (s *TimerLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.UpdateAccessData(accessData)
+	result, err := s.JobStore.GetNewestJobByStatusesAndType(statuses, jobType)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5111,15 +5557,15 @@ func (s *TimerLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetNewestJobByStatusesAndType", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+func (s *TimerLayerJobStore) GetStatsByType(since int64) ([]*model.JobTypeStats, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.UpdateApp(app)
+	result, err := s.JobStore.GetStatsByType(since)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5127,15 +5573,15 @@ func (s *TimerLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetStatsByType", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
+func (s *TimerLayerJobStore) GetTypeSettings() ([]*model.JobTypeSettings, error) {
 	start := time.Now()
 
-	result, err := s.PluginStore.CompareAndDelete(keyVal, oldValue)
+	result, err := s.JobStore.GetTypeSettings()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5143,15 +5589,15 @@ func (s *TimerLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, o
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndDelete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetTypeSettings", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
+func (s *TimerLayerJobStore) Save(job *model.Job) (*model.Job, error) {
 	start := time.Now()
 
-	result, err := s.PluginStore.CompareAndSet(keyVal, oldValue)
+	result, err := s.JobStore.Save(job)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5159,15 +5605,15 @@ func (s *TimerLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldV
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndSet", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPluginStore) Delete(pluginID string, key string) error {
+func (s *TimerLayerJobStore) SaveTypeSettings(settings *model.JobTypeSettings) (*model.JobTypeSettings, error) {
 	start := time.Now()
 
-	err := s.PluginStore.Delete(pluginID, key)
+	result, err := s.JobStore.SaveTypeSettings(settings)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5175,15 +5621,15 @@ func (s *TimerLayerPluginStore) Delete(pluginID string, key string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.SaveTypeSettings", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPluginStore) DeleteAllExpired() error {
+func (s *TimerLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
 	start := time.Now()
 
-	err := s.PluginStore.DeleteAllExpired()
+	result, err := s.JobStore.UpdateOptimistically(job, currentStatus)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5191,15 +5637,15 @@ func (s *TimerLayerPluginStore) DeleteAllExpired() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllExpired", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateOptimistically", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPluginStore) DeleteAllForPlugin(PluginID string) error {
+func (s *TimerLayerJobStore) UpdateStatus(id string, status string) (*model.Job, error) {
 	start := time.Now()
 
-	err := s.PluginStore.DeleteAllForPlugin(PluginID)
+	result, err := s.JobStore.UpdateStatus(id, status)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5207,15 +5653,15 @@ func (s *TimerLayerPluginStore) DeleteAllForPlugin(PluginID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllForPlugin", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatus", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPluginStore) Get(pluginID string, key string) (*model.PluginKeyValue, error) {
+func (s *TimerLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, error) {
 	start := time.Now()
 
-	result, err := s.PluginStore.Get(pluginID, key)
+	result, err := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5223,15 +5669,15 @@ func (s *TimerLayerPluginStore) Get(pluginID string, key string) (*model.PluginK
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatusOptimistically", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
+func (s *TimerLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
 	start := time.Now()
 
-	result, err := s.PluginStore.List(pluginID, page, perPage)
+	result, err := s.LicenseStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5239,15 +5685,15 @@ func (s *TimerLayerPluginStore) List(pluginID string, page int, perPage int) ([]
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.List", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
+func (s *TimerLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
 	start := time.Now()
 
-	result, err := s.PluginStore.SaveOrUpdate(keyVal)
+	result, err := s.LicenseStore.GetAll()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5255,15 +5701,15 @@ func (s *TimerLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SaveOrUpdate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPluginStore) SetWithOptions(pluginID string, key string, value []byte, options model.PluginKVSetOptions) (bool, error) {
+func (s *TimerLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
 	start := time.Now()
 
-	result, err := s.PluginStore.SetWithOptions(pluginID, key, value, options)
+	result, err := s.LicenseStore.Save(license)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5271,15 +5717,15 @@ func (s *TimerLayerPluginStore) SetWithOptions(pluginID string, key string, valu
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SetWithOptions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) AnalyticsPostCount(options *model.PostCountOptions) (int64, error) {
+func (s *TimerLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.AnalyticsPostCount(options)
+	result, err := s.LinkMetadataStore.Get(url, timestamp)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5287,15 +5733,15 @@ func (s *TimerLayerPostStore) AnalyticsPostCount(options *model.PostCountOptions
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, error) {
+func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.AnalyticsPostCountsByDay(options)
+	result, err := s.LinkMetadataStore.Save(linkMetadata)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5303,15 +5749,15 @@ func (s *TimerLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsP
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCountsByDay", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamID string) (model.AnalyticsRows, error) {
+func (s *TimerLayerMatrixBridgeStore) DeleteRoom(channelID string) error {
 	start := time.Now()
 
-	result, err := s.PostStore.AnalyticsUserCountsWithPostsByDay(teamID)
+	err := s.MatrixBridgeStore.DeleteRoom(channelID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5319,30 +5765,47 @@ func (s *TimerLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamID string) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsUserCountsWithPostsByDay", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("MatrixBridgeStore.DeleteRoom", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerMatrixBridgeStore) GetAllRooms() ([]*model.MatrixRoom, error) {
+	start := time.Now()
+
+	result, err := s.MatrixBridgeStore.GetAllRooms()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("MatrixBridgeStore.GetAllRooms", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) ClearCaches() {
+func (s *TimerLayerMatrixBridgeStore) GetEvent(eventID string) (*model.MatrixEvent, error) {
 	start := time.Now()
 
-	s.PostStore.ClearCaches()
+	result, err := s.MatrixBridgeStore.GetEvent(eventID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.ClearCaches", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("MatrixBridgeStore.GetEvent", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerPostStore) Delete(postID string, timestamp int64, deleteByID string) error {
+func (s *TimerLayerMatrixBridgeStore) GetEventForPost(postID string) (*model.MatrixEvent, error) {
 	start := time.Now()
 
-	err := s.PostStore.Delete(postID, timestamp, deleteByID)
+	result, err := s.MatrixBridgeStore.GetEventForPost(postID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5350,15 +5813,15 @@ func (s *TimerLayerPostStore) Delete(postID string, timestamp int64, deleteByID
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("MatrixBridgeStore.GetEventForPost", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *TimerLayerMatrixBridgeStore) GetRoom(roomID string) (*model.MatrixRoom, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.DeleteOrphanedRows(limit)
+	result, err := s.MatrixBridgeStore.GetRoom(roomID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5366,15 +5829,15 @@ func (s *TimerLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.DeleteOrphanedRows", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("MatrixBridgeStore.GetRoom", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *TimerLayerMatrixBridgeStore) GetRoomForChannel(channelID string) (*model.MatrixRoom, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.Get(ctx, id, opts, userID, sanitizeOptions)
+	result, err := s.MatrixBridgeStore.GetRoomForChannel(channelID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5382,15 +5845,15 @@ func (s *TimerLayerPostStore) Get(ctx context.Context, id string, opts model.Get
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("MatrixBridgeStore.GetRoomForChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error) {
+func (s *TimerLayerMatrixBridgeStore) SaveEvent(event *model.MatrixEvent) (*model.MatrixEvent, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterID)
+	result, err := s.MatrixBridgeStore.SaveEvent(event)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5398,15 +5861,15 @@ func (s *TimerLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afte
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetDirectPostParentsForExportAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("MatrixBridgeStore.SaveEvent", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetEditHistoryForPost(postId string) ([]*model.Post, error) {
+func (s *TimerLayerMatrixBridgeStore) SaveRoom(room *model.MatrixRoom) (*model.MatrixRoom, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetEditHistoryForPost(postId)
+	result, err := s.MatrixBridgeStore.SaveRoom(room)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5414,31 +5877,31 @@ func (s *TimerLayerPostStore) GetEditHistoryForPost(postId string) ([]*model.Pos
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetEditHistoryForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("MatrixBridgeStore.SaveRoom", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetEtag(channelID string, allowFromCache bool, collapsedThreads bool) string {
+func (s *TimerLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
 	start := time.Now()
 
-	result := s.PostStore.GetEtag(channelID, allowFromCache, collapsedThreads)
+	err := s.NotifyAdminStore.DeleteBefore(trial, now)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetEtag", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.DeleteBefore", success, elapsed)
 	}
-	return result
+	return err
 }
 
-func (s *TimerLayerPostStore) GetFlaggedPosts(userID string, offset int, limit int) (*model.PostList, error) {
+func (s *TimerLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetFlaggedPosts(userID, offset, limit)
+	result, err := s.NotifyAdminStore.Get(trial)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5446,15 +5909,15 @@ func (s *TimerLayerPostStore) GetFlaggedPosts(userID string, offset int, limit i
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID string, offset int, limit int) (*model.PostList, error) {
+func (s *TimerLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, feature model.MattermostFeature) ([]*model.NotifyAdminData, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetFlaggedPostsForChannel(userID, channelID, offset, limit)
+	result, err := s.NotifyAdminStore.GetDataByUserIdAndFeature(userId, feature)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5462,15 +5925,15 @@ func (s *TimerLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.GetDataByUserIdAndFeature", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetFlaggedPostsForTeam(userID string, teamID string, offset int, limit int) (*model.PostList, error) {
+func (s *TimerLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.NotifyAdminData, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetFlaggedPostsForTeam(userID, teamID, offset, limit)
+	result, err := s.NotifyAdminStore.Save(data)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5478,15 +5941,15 @@ func (s *TimerLayerPostStore) GetFlaggedPostsForTeam(userID string, teamID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetLastPostRowCreateAt() (int64, error) {
+func (s *TimerLayerNotifyAdminStore) Update(userId string, requiredPlan string, requiredFeature model.MattermostFeature, now int64) error {
 	start := time.Now()
 
-	result, err := s.PostStore.GetLastPostRowCreateAt()
+	err := s.NotifyAdminStore.Update(userId, requiredPlan, requiredFeature, now)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5494,31 +5957,31 @@ func (s *TimerLayerPostStore) GetLastPostRowCreateAt() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetLastPostRowCreateAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Update", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) GetMaxPostSize() int {
+func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
 	start := time.Now()
 
-	result := s.PostStore.GetMaxPostSize()
+	err := s.OAuthStore.DeleteApp(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetMaxPostSize", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.DeleteApp", success, elapsed)
 	}
-	return result
+	return err
 }
 
-func (s *TimerLayerPostStore) GetNthRecentPostTime(n int64) (int64, error) {
+func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetNthRecentPostTime(n)
+	result, err := s.OAuthStore.GetAccessData(token)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5526,15 +5989,15 @@ func (s *TimerLayerPostStore) GetNthRecentPostTime(n int64) (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetNthRecentPostTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessData", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetOldest() (*model.Post, error) {
+func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetOldest()
+	result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5542,15 +6005,15 @@ func (s *TimerLayerPostStore) GetOldest() (*model.Post, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldest", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByRefreshToken", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
+func (s *TimerLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId string) ([]*model.AccessData, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetOldestEntityCreationTime()
+	result, err := s.OAuthStore.GetAccessDataByUserForApp(userID, clientId)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5558,15 +6021,15 @@ func (s *TimerLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldestEntityCreationTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByUserForApp", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetParentsForExportAfter(limit int, afterID string) ([]*model.PostForExport, error) {
+func (s *TimerLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetParentsForExportAfter(limit, afterID)
+	result, err := s.OAuthStore.GetApp(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5574,15 +6037,15 @@ func (s *TimerLayerPostStore) GetParentsForExportAfter(limit int, afterID string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetParentsForExportAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApp", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostAfterTime(channelID string, timestamp int64, collapsedThreads bool) (*model.Post, error) {
+func (s *TimerLayerOAuthStore) GetAppByUser(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostAfterTime(channelID, timestamp, collapsedThreads)
+	result, err := s.OAuthStore.GetAppByUser(userID, offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5590,15 +6053,15 @@ func (s *TimerLayerPostStore) GetPostAfterTime(channelID string, timestamp int64
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostAfterTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAppByUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostIdAfterTime(channelID string, timestamp int64, collapsedThreads bool) (string, error) {
+func (s *TimerLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostIdAfterTime(channelID, timestamp, collapsedThreads)
+	result, err := s.OAuthStore.GetApps(offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5606,15 +6069,15 @@ func (s *TimerLayerPostStore) GetPostIdAfterTime(channelID string, timestamp int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdAfterTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApps", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostIdBeforeTime(channelID string, timestamp int64, collapsedThreads bool) (string, error) {
+func (s *TimerLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostIdBeforeTime(channelID, timestamp, collapsedThreads)
+	result, err := s.OAuthStore.GetAuthData(code)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5622,15 +6085,15 @@ func (s *TimerLayerPostStore) GetPostIdBeforeTime(channelID string, timestamp in
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdBeforeTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthData", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostReminderMetadata(postID string) (*store.PostReminderMetadata, error) {
+func (s *TimerLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostReminderMetadata(postID)
+	result, err := s.OAuthStore.GetAuthorizedApps(userID, offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5638,15 +6101,15 @@ func (s *TimerLayerPostStore) GetPostReminderMetadata(postID string) (*store.Pos
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostReminderMetadata", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthorizedApps", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostReminders(now int64) ([]*model.PostReminder, error) {
+func (s *TimerLayerOAuthStore) GetConnection(userID string, service string) (*model.OAuthConnection, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostReminders(now)
+	result, err := s.OAuthStore.GetConnection(userID, service)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5654,15 +6117,15 @@ func (s *TimerLayerPostStore) GetPostReminders(now int64) ([]*model.PostReminder
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostReminders", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetConnection", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *TimerLayerOAuthStore) GetPreviousAccessData(userID string, clientId string) (*model.AccessData, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPosts(options, allowFromCache, sanitizeOptions)
+	result, err := s.OAuthStore.GetPreviousAccessData(userID, clientId)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5670,15 +6133,15 @@ func (s *TimerLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromC
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetPreviousAccessData", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsAfter(options model.GetPostsOptions, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *TimerLayerOAuthStore) GetRotatedRefreshToken(refreshToken string) (*model.OAuthRotatedRefreshToken, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostsAfter(options, sanitizeOptions)
+	result, err := s.OAuthStore.GetRotatedRefreshToken(refreshToken)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5686,15 +6149,15 @@ func (s *TimerLayerPostStore) GetPostsAfter(options model.GetPostsOptions, sanit
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetRotatedRefreshToken", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsBatchForIndexing(startTime int64, startPostID string, limit int) ([]*model.PostForIndexing, error) {
+func (s *TimerLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostsBatchForIndexing(startTime, startPostID, limit)
+	err := s.OAuthStore.PermanentDeleteAuthDataByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5702,15 +6165,1149 @@ func (s *TimerLayerPostStore) GetPostsBatchForIndexing(startTime int64, startPos
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBatchForIndexing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.PermanentDeleteAuthDataByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) GetPostsBefore(options model.GetPostsOptions, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *TimerLayerOAuthStore) RemoveAccessData(token string) error {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostsBefore(options, sanitizeOptions)
+	err := s.OAuthStore.RemoveAccessData(token)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAccessData", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerOAuthStore) RemoveAllAccessData() error {
+	start := time.Now()
+
+	err := s.OAuthStore.RemoveAllAccessData()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAllAccessData", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerOAuthStore) RemoveAuthData(code string) error {
+	start := time.Now()
+
+	err := s.OAuthStore.RemoveAuthData(code)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAuthData", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerOAuthStore) RemoveAuthDataByClientId(clientId string, userId string) error {
+	start := time.Now()
+
+	err := s.OAuthStore.RemoveAuthDataByClientId(clientId, userId)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAuthDataByClientId", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerOAuthStore) RemoveConnection(userID string, service string) error {
+	start := time.Now()
+
+	err := s.OAuthStore.RemoveConnection(userID, service)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveConnection", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerOAuthStore) RemoveRotatedRefreshTokens(userID string, clientID string) error {
+	start := time.Now()
+
+	err := s.OAuthStore.RemoveRotatedRefreshTokens(userID, clientID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveRotatedRefreshTokens", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+	start := time.Now()
+
+	result, err := s.OAuthStore.SaveAccessData(accessData)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAccessData", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+	start := time.Now()
+
+	result, err := s.OAuthStore.SaveApp(app)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveApp", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
+	start := time.Now()
+
+	result, err := s.OAuthStore.SaveAuthData(authData)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAuthData", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerOAuthStore) SaveConnection(connection *model.OAuthConnection) (*model.OAuthConnection, error) {
+	start := time.Now()
+
+	result, err := s.OAuthStore.SaveConnection(connection)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveConnection", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerOAuthStore) SaveRotatedRefreshToken(token *model.OAuthRotatedRefreshToken) error {
+	start := time.Now()
+
+	err := s.OAuthStore.SaveRotatedRefreshToken(token)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveRotatedRefreshToken", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+	start := time.Now()
+
+	result, err := s.OAuthStore.UpdateAccessData(accessData)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateAccessData", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+	start := time.Now()
+
+	result, err := s.OAuthStore.UpdateApp(app)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateApp", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerOAuthStore) UpdateAppCreator(appID string, creatorID string) error {
+	start := time.Now()
+
+	err := s.OAuthStore.UpdateAppCreator(appID, creatorID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateAppCreator", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
+	start := time.Now()
+
+	result, err := s.PluginStore.CompareAndDelete(keyVal, oldValue)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndDelete", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
+	start := time.Now()
+
+	result, err := s.PluginStore.CompareAndSet(keyVal, oldValue)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndSet", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPluginStore) Delete(pluginID string, key string) error {
+	start := time.Now()
+
+	err := s.PluginStore.Delete(pluginID, key)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPluginStore) DeleteAllExpired() error {
+	start := time.Now()
+
+	err := s.PluginStore.DeleteAllExpired()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllExpired", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPluginStore) DeleteAllForPlugin(PluginID string) error {
+	start := time.Now()
+
+	err := s.PluginStore.DeleteAllForPlugin(PluginID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllForPlugin", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPluginStore) Get(pluginID string, key string) (*model.PluginKeyValue, error) {
+	start := time.Now()
+
+	result, err := s.PluginStore.Get(pluginID, key)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
+	start := time.Now()
+
+	result, err := s.PluginStore.List(pluginID, page, perPage)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.List", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
+	start := time.Now()
+
+	result, err := s.PluginStore.SaveOrUpdate(keyVal)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SaveOrUpdate", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPluginStore) SetWithOptions(pluginID string, key string, value []byte, options model.PluginKVSetOptions) (bool, error) {
+	start := time.Now()
+
+	result, err := s.PluginStore.SetWithOptions(pluginID, key, value, options)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SetWithOptions", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) AnalyticsPostCount(options *model.PostCountOptions) (int64, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.AnalyticsPostCount(options)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCount", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.AnalyticsPostCountsByDay(options)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCountsByDay", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamID string) (model.AnalyticsRows, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.AnalyticsUserCountsWithPostsByDay(teamID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsUserCountsWithPostsByDay", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) ClearCaches() {
+	start := time.Now()
+
+	s.PostStore.ClearCaches()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.ClearCaches", success, elapsed)
+	}
+}
+
+func (s *TimerLayerPostStore) Delete(postID string, timestamp int64, deleteByID string) error {
+	start := time.Now()
+
+	err := s.PostStore.Delete(postID, timestamp, deleteByID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.DeleteOrphanedRows(limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.DeleteOrphanedRows", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.Get(ctx, id, opts, userID, sanitizeOptions)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetDirectPostParentsForExportAfter", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetEditHistoryForPost(postId string) ([]*model.Post, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetEditHistoryForPost(postId)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetEditHistoryForPost", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetEtag(channelID string, allowFromCache bool, collapsedThreads bool) string {
+	start := time.Now()
+
+	result := s.PostStore.GetEtag(channelID, allowFromCache, collapsedThreads)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetEtag", success, elapsed)
+	}
+	return result
+}
+
+func (s *TimerLayerPostStore) GetFlaggedPosts(userID string, offset int, limit int) (*model.PostList, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetFlaggedPosts(userID, offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPosts", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID string, offset int, limit int) (*model.PostList, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetFlaggedPostsForChannel(userID, channelID, offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForChannel", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetFlaggedPostsForTeam(userID string, teamID string, offset int, limit int) (*model.PostList, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetFlaggedPostsForTeam(userID, teamID, offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForTeam", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetLastPostRowCreateAt() (int64, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetLastPostRowCreateAt()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetLastPostRowCreateAt", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetMaxPostSize() int {
+	start := time.Now()
+
+	result := s.PostStore.GetMaxPostSize()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetMaxPostSize", success, elapsed)
+	}
+	return result
+}
+
+func (s *TimerLayerPostStore) GetNthRecentPostTime(n int64) (int64, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetNthRecentPostTime(n)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetNthRecentPostTime", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetOldest() (*model.Post, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetOldest()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldest", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetOldestEntityCreationTime()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldestEntityCreationTime", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetParentsForExportAfter(limit int, afterID string) ([]*model.PostForExport, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetParentsForExportAfter(limit, afterID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetParentsForExportAfter", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostAfterTime(channelID string, timestamp int64, collapsedThreads bool) (*model.Post, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostAfterTime(channelID, timestamp, collapsedThreads)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostAfterTime", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostIdAfterTime(channelID string, timestamp int64, collapsedThreads bool) (string, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostIdAfterTime(channelID, timestamp, collapsedThreads)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdAfterTime", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostIdBeforeTime(channelID string, timestamp int64, collapsedThreads bool) (string, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostIdBeforeTime(channelID, timestamp, collapsedThreads)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdBeforeTime", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostReminderMetadata(postID string) (*store.PostReminderMetadata, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostReminderMetadata(postID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostReminderMetadata", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostReminders(now int64) ([]*model.PostReminder, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostReminders(now)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostReminders", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPosts(options, allowFromCache, sanitizeOptions)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPosts", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostsAfter(options model.GetPostsOptions, sanitizeOptions map[string]bool) (*model.PostList, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostsAfter(options, sanitizeOptions)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsAfter", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostsBatchForIndexing(startTime int64, startPostID string, limit int) ([]*model.PostForIndexing, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostsBatchForIndexing(startTime, startPostID, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBatchForIndexing", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostsBefore(options model.GetPostsOptions, sanitizeOptions map[string]bool) (*model.PostList, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostsBefore(options, sanitizeOptions)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBefore", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostsByIds(postIds)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsByIds", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostsByThread(threadID string, since int64) ([]*model.Post, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostsByThread(threadID, since)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsByThread", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostsCreatedAt(channelID string, timestamp int64) ([]*model.Post, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostsCreatedAt(channelID, timestamp)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsCreatedAt", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostsSince(options, allowFromCache, sanitizeOptions)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsSince", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error) {
+	start := time.Now()
+
+	result, resultVar1, err := s.PostStore.GetPostsSinceForSync(options, cursor, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsSinceForSync", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerPostStore) GetRecentSearchesForUser(userID string) ([]*model.SearchParams, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetRecentSearchesForUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetRecentSearchesForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetRepliesForExport(parentID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetRepliesForExport", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetSingle(id string, inclDeleted bool) (*model.Post, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetSingle(id, inclDeleted)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetSingle", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) GetTopDMsForUserSince(userID string, since int64, offset int, limit int) (*model.TopDMList, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetTopDMsForUserSince(userID, since, offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetTopDMsForUserSince", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userId string) (bool, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.HasAutoResponsePostByUserSince(options, userId)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.HasAutoResponsePostByUserSince", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) InvalidateLastPostTimeCache(channelID string) {
+	start := time.Now()
+
+	s.PostStore.InvalidateLastPostTimeCache(channelID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.InvalidateLastPostTimeCache", success, elapsed)
+	}
+}
+
+func (s *TimerLayerPostStore) LogRecentSearch(userID string, searchQuery []byte, createAt int64) error {
+	start := time.Now()
+
+	err := s.PostStore.LogRecentSearch(userID, searchQuery, createAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.LogRecentSearch", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.Overwrite(post)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Overwrite", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+	start := time.Now()
+
+	result, resultVar1, err := s.PostStore.OverwriteMultiple(posts)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.OverwriteMultiple", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.PermanentDeleteBatch(endTime, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatch", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+	start := time.Now()
+
+	result, resultVar1, err := s.PostStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatchForRetentionPolicies", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerPostStore) PermanentDeleteByChannel(channelID string) error {
+	start := time.Now()
+
+	err := s.PostStore.PermanentDeleteByChannel(channelID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByChannel", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPostStore) PermanentDeleteByUser(userID string) error {
+	start := time.Now()
+
+	err := s.PostStore.PermanentDeleteByUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPostStore) Save(post *model.Post) (*model.Post, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.Save(post)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+	start := time.Now()
+
+	result, resultVar1, err := s.PostStore.SaveMultiple(posts)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SaveMultiple", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerPostStore) Search(teamID string, userID string, params *model.SearchParams) (*model.PostList, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.Search(teamID, userID, params)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5718,15 +7315,15 @@ func (s *TimerLayerPostStore) GetPostsBefore(options model.GetPostsOptions, sani
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBefore", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Search", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, error) {
+func (s *TimerLayerPostStore) SearchPostsForUser(ctx context.Context, paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.PostSearchResults, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostsByIds(postIds)
+	result, err := s.PostStore.SearchPostsForUser(ctx, paramsList, userID, teamID, page, perPage)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5734,15 +7331,15 @@ func (s *TimerLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SearchPostsForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsByThread(threadID string, since int64) ([]*model.Post, error) {
+func (s *TimerLayerPostStore) SetPostReminder(reminder *model.PostReminder) error {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostsByThread(threadID, since)
+	err := s.PostStore.SetPostReminder(reminder)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5750,15 +7347,15 @@ func (s *TimerLayerPostStore) GetPostsByThread(threadID string, since int64) ([]
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsByThread", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SetPostReminder", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) GetPostsCreatedAt(channelID string, timestamp int64) ([]*model.Post, error) {
+func (s *TimerLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostsCreatedAt(channelID, timestamp)
+	result, err := s.PostStore.Update(newPost, oldPost)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5766,15 +7363,15 @@ func (s *TimerLayerPostStore) GetPostsCreatedAt(channelID string, timestamp int6
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsCreatedAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *TimerLayerPostAcknowledgementStore) Delete(acknowledgement *model.PostAcknowledgement) error {
 	start := time.Now()
 
-	result, err := s.PostStore.GetPostsSince(options, allowFromCache, sanitizeOptions)
+	err := s.PostAcknowledgementStore.Delete(acknowledgement)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5782,15 +7379,15 @@ func (s *TimerLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error) {
+func (s *TimerLayerPostAcknowledgementStore) Get(postID string, userID string) (*model.PostAcknowledgement, error) {
 	start := time.Now()
 
-	result, resultVar1, err := s.PostStore.GetPostsSinceForSync(options, cursor, limit)
+	result, err := s.PostAcknowledgementStore.Get(postID, userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5798,15 +7395,15 @@ func (s *TimerLayerPostStore) GetPostsSinceForSync(options model.GetPostsSinceFo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsSinceForSync", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Get", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) GetRecentSearchesForUser(userID string) ([]*model.SearchParams, error) {
+func (s *TimerLayerPostAcknowledgementStore) GetForPost(postID string) ([]*model.PostAcknowledgement, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetRecentSearchesForUser(userID)
+	result, err := s.PostAcknowledgementStore.GetForPost(postID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5814,15 +7411,15 @@ func (s *TimerLayerPostStore) GetRecentSearchesForUser(userID string) ([]*model.
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetRecentSearchesForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.GetForPost", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error) {
+func (s *TimerLayerPostAcknowledgementStore) GetForPosts(postIds []string) ([]*model.PostAcknowledgement, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetRepliesForExport(parentID)
+	result, err := s.PostAcknowledgementStore.GetForPosts(postIds)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5830,15 +7427,15 @@ func (s *TimerLayerPostStore) GetRepliesForExport(parentID string) ([]*model.Rep
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetRepliesForExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.GetForPosts", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetSingle(id string, inclDeleted bool) (*model.Post, error) {
+func (s *TimerLayerPostAcknowledgementStore) Save(postID string, userID string, acknowledgedAt int64) (*model.PostAcknowledgement, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.GetSingle(id, inclDeleted)
+	result, err := s.PostAcknowledgementStore.Save(postID, userID, acknowledgedAt)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5846,15 +7443,15 @@ func (s *TimerLayerPostStore) GetSingle(id string, inclDeleted bool) (*model.Pos
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetSingle", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetTopDMsForUserSince(userID string, since int64, offset int, limit int) (*model.TopDMList, error) {
+func (s *TimerLayerPostEmbeddingStore) Delete(postIDs []string) error {
 	start := time.Now()
 
-	result, err := s.PostStore.GetTopDMsForUserSince(userID, since, offset, limit)
+	err := s.PostEmbeddingStore.Delete(postIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5862,15 +7459,15 @@ func (s *TimerLayerPostStore) GetTopDMsForUserSince(userID string, since int64,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetTopDMsForUserSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostEmbeddingStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userId string) (bool, error) {
+func (s *TimerLayerPostEmbeddingStore) DeleteOrphanedRows(limit int) (int64, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.HasAutoResponsePostByUserSince(options, userId)
+	result, err := s.PostEmbeddingStore.DeleteOrphanedRows(limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5878,30 +7475,31 @@ func (s *TimerLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.HasAutoResponsePostByUserSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostEmbeddingStore.DeleteOrphanedRows", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) InvalidateLastPostTimeCache(channelID string) {
+func (s *TimerLayerPostEmbeddingStore) Get(postID string) (*model.PostEmbedding, error) {
 	start := time.Now()
 
-	s.PostStore.InvalidateLastPostTimeCache(channelID)
+	result, err := s.PostEmbeddingStore.Get(postID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.InvalidateLastPostTimeCache", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostEmbeddingStore.Get", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerPostStore) LogRecentSearch(userID string, searchQuery []byte, createAt int64) error {
+func (s *TimerLayerPostEmbeddingStore) Save(embeddings []*model.PostEmbedding) error {
 	start := time.Now()
 
-	err := s.PostStore.LogRecentSearch(userID, searchQuery, createAt)
+	err := s.PostEmbeddingStore.Save(embeddings)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5909,15 +7507,15 @@ func (s *TimerLayerPostStore) LogRecentSearch(userID string, searchQuery []byte,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.LogRecentSearch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostEmbeddingStore.Save", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
+func (s *TimerLayerPostEmbeddingStore) Search(embedding []float32, embeddingModel string, channelIDs []string, limit int) ([]*model.PostEmbeddingMatch, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.Overwrite(post)
+	result, err := s.PostEmbeddingStore.Search(embedding, embeddingModel, channelIDs, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5925,15 +7523,15 @@ func (s *TimerLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Overwrite", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostEmbeddingStore.Search", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+func (s *TimerLayerPostExpirationStore) Delete(postIDs []string) error {
 	start := time.Now()
 
-	result, resultVar1, err := s.PostStore.OverwriteMultiple(posts)
+	err := s.PostExpirationStore.Delete(postIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5941,15 +7539,15 @@ func (s *TimerLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.P
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.OverwriteMultiple", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostExpirationStore.Delete", success, elapsed)
 	}
-	return result, resultVar1, err
+	return err
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *TimerLayerPostExpirationStore) GetExpiredBefore(until int64, limit int) ([]*model.PostExpiration, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.PermanentDeleteBatch(endTime, limit)
+	result, err := s.PostExpirationStore.GetExpiredBefore(until, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5957,15 +7555,15 @@ func (s *TimerLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostExpirationStore.GetExpiredBefore", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+func (s *TimerLayerPostPriorityStore) GetForPost(postId string) (*model.PostPriority, error) {
 	start := time.Now()
 
-	result, resultVar1, err := s.PostStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+	result, err := s.PostPriorityStore.GetForPost(postId)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5973,15 +7571,15 @@ func (s *TimerLayerPostStore) PermanentDeleteBatchForRetentionPolicies(now int64
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatchForRetentionPolicies", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostPriorityStore.GetForPost", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteByChannel(channelID string) error {
+func (s *TimerLayerPostPriorityStore) GetForPosts(ids []string) ([]*model.PostPriority, error) {
 	start := time.Now()
 
-	err := s.PostStore.PermanentDeleteByChannel(channelID)
+	result, err := s.PostPriorityStore.GetForPosts(ids)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5989,15 +7587,15 @@ func (s *TimerLayerPostStore) PermanentDeleteByChannel(channelID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostPriorityStore.GetForPosts", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteByUser(userID string) error {
+func (s *TimerLayerPostTranslationStore) DeleteForPost(postID string) error {
 	start := time.Now()
 
-	err := s.PostStore.PermanentDeleteByUser(userID)
+	err := s.PostTranslationStore.DeleteForPost(postID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6005,15 +7603,15 @@ func (s *TimerLayerPostStore) PermanentDeleteByUser(userID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostTranslationStore.DeleteForPost", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerPostStore) Save(post *model.Post) (*model.Post, error) {
+func (s *TimerLayerPostTranslationStore) DeleteOrphanedRows(limit int) (int64, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.Save(post)
+	result, err := s.PostTranslationStore.DeleteOrphanedRows(limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6021,15 +7619,15 @@ func (s *TimerLayerPostStore) Save(post *model.Post) (*model.Post, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostTranslationStore.DeleteOrphanedRows", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+func (s *TimerLayerPostTranslationStore) Get(postID string, language string) (*model.PostTranslation, error) {
 	start := time.Now()
 
-	result, resultVar1, err := s.PostStore.SaveMultiple(posts)
+	result, err := s.PostTranslationStore.Get(postID, language)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6037,15 +7635,15 @@ func (s *TimerLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SaveMultiple", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostTranslationStore.Get", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) Search(teamID string, userID string, params *model.SearchParams) (*model.PostList, error) {
+func (s *TimerLayerPostTranslationStore) Save(translation *model.PostTranslation) (*model.PostTranslation, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.Search(teamID, userID, params)
+	result, err := s.PostTranslationStore.Save(translation)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6053,15 +7651,15 @@ func (s *TimerLayerPostStore) Search(teamID string, userID string, params *model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Search", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostTranslationStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) SearchPostsForUser(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.PostSearchResults, error) {
+func (s *TimerLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error) {
 	start := time.Now()
 
-	result, err := s.PostStore.SearchPostsForUser(paramsList, userID, teamID, page, perPage)
+	result, err := s.PreferenceStore.CleanupFlagsBatch(limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6069,15 +7667,15 @@ func (s *TimerLayerPostStore) SearchPostsForUser(paramsList []*model.SearchParam
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SearchPostsForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.CleanupFlagsBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) SetPostReminder(reminder *model.PostReminder) error {
+func (s *TimerLayerPreferenceStore) Delete(userID string, category string, name string) error {
 	start := time.Now()
 
-	err := s.PostStore.SetPostReminder(reminder)
+	err := s.PreferenceStore.Delete(userID, category, name)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6085,31 +7683,15 @@ func (s *TimerLayerPostStore) SetPostReminder(reminder *model.PostReminder) erro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SetPostReminder", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Delete", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error) {
-	start := time.Now()
-
-	result, err := s.PostStore.Update(newPost, oldPost)
-
-	elapsed := float64(time.Since(start)) / float64(time.Second)
-	if s.Root.Metrics != nil {
-		success := "false"
-		if err == nil {
-			success = "true"
-		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Update", success, elapsed)
-	}
-	return result, err
-}
-
-func (s *TimerLayerPostAcknowledgementStore) Delete(acknowledgement *model.PostAcknowledgement) error {
+func (s *TimerLayerPreferenceStore) DeleteCategory(userID string, category string) error {
 	start := time.Now()
 
-	err := s.PostAcknowledgementStore.Delete(acknowledgement)
+	err := s.PreferenceStore.DeleteCategory(userID, category)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6117,15 +7699,15 @@ func (s *TimerLayerPostAcknowledgementStore) Delete(acknowledgement *model.PostA
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategory", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerPostAcknowledgementStore) Get(postID string, userID string) (*model.PostAcknowledgement, error) {
+func (s *TimerLayerPreferenceStore) DeleteCategoryAndName(category string, name string) error {
 	start := time.Now()
 
-	result, err := s.PostAcknowledgementStore.Get(postID, userID)
+	err := s.PreferenceStore.DeleteCategoryAndName(category, name)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6133,15 +7715,15 @@ func (s *TimerLayerPostAcknowledgementStore) Get(postID string, userID string) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategoryAndName", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostAcknowledgementStore) GetForPost(postID string) ([]*model.PostAcknowledgement, error) {
+func (s *TimerLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error) {
 	start := time.Now()
 
-	result, err := s.PostAcknowledgementStore.GetForPost(postID)
+	result, err := s.PreferenceStore.DeleteOrphanedRows(limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6149,15 +7731,15 @@ func (s *TimerLayerPostAcknowledgementStore) GetForPost(postID string) ([]*model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.GetForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteOrphanedRows", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostAcknowledgementStore) GetForPosts(postIds []string) ([]*model.PostAcknowledgement, error) {
+func (s *TimerLayerPreferenceStore) Get(userID string, category string, name string) (*model.Preference, error) {
 	start := time.Now()
 
-	result, err := s.PostAcknowledgementStore.GetForPosts(postIds)
+	result, err := s.PreferenceStore.Get(userID, category, name)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6165,15 +7747,15 @@ func (s *TimerLayerPostAcknowledgementStore) GetForPosts(postIds []string) ([]*m
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.GetForPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostAcknowledgementStore) Save(postID string, userID string, acknowledgedAt int64) (*model.PostAcknowledgement, error) {
+func (s *TimerLayerPreferenceStore) GetAll(userID string) (model.Preferences, error) {
 	start := time.Now()
 
-	result, err := s.PostAcknowledgementStore.Save(postID, userID, acknowledgedAt)
+	result, err := s.PreferenceStore.GetAll(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6181,15 +7763,15 @@ func (s *TimerLayerPostAcknowledgementStore) Save(postID string, userID string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostPriorityStore) GetForPost(postId string) (*model.PostPriority, error) {
+func (s *TimerLayerPreferenceStore) GetCategory(userID string, category string) (model.Preferences, error) {
 	start := time.Now()
 
-	result, err := s.PostPriorityStore.GetForPost(postId)
+	result, err := s.PreferenceStore.GetCategory(userID, category)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6197,15 +7779,15 @@ func (s *TimerLayerPostPriorityStore) GetForPost(postId string) (*model.PostPrio
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostPriorityStore.GetForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetCategory", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostPriorityStore) GetForPosts(ids []string) ([]*model.PostPriority, error) {
+func (s *TimerLayerPreferenceStore) GetCategoryAndName(category string, nane string) (model.Preferences, error) {
 	start := time.Now()
 
-	result, err := s.PostPriorityStore.GetForPosts(ids)
+	result, err := s.PreferenceStore.GetCategoryAndName(category, nane)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6213,15 +7795,15 @@ func (s *TimerLayerPostPriorityStore) GetForPosts(ids []string) ([]*model.PostPr
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostPriorityStore.GetForPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetCategoryAndName", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error) {
+func (s *TimerLayerPreferenceStore) GetCategoryAndNameForUsers(userIDs []string, category string, name string) (model.Preferences, error) {
 	start := time.Now()
 
-	result, err := s.PreferenceStore.CleanupFlagsBatch(limit)
+	result, err := s.PreferenceStore.GetCategoryAndNameForUsers(userIDs, category, name)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6229,15 +7811,15 @@ func (s *TimerLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.CleanupFlagsBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetCategoryAndNameForUsers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) Delete(userID string, category string, name string) error {
+func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
-	err := s.PreferenceStore.Delete(userID, category, name)
+	err := s.PreferenceStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6245,15 +7827,15 @@ func (s *TimerLayerPreferenceStore) Delete(userID string, category string, name
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.PermanentDeleteByUser", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerPreferenceStore) DeleteCategory(userID string, category string) error {
+func (s *TimerLayerPreferenceStore) Save(preferences model.Preferences) error {
 	start := time.Now()
 
-	err := s.PreferenceStore.DeleteCategory(userID, category)
+	err := s.PreferenceStore.Save(preferences)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6261,15 +7843,15 @@ func (s *TimerLayerPreferenceStore) DeleteCategory(userID string, category strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategory", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Save", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerPreferenceStore) DeleteCategoryAndName(category string, name string) error {
+func (s *TimerLayerProductNoticesStore) Clear(notices []string) error {
 	start := time.Now()
 
-	err := s.PreferenceStore.DeleteCategoryAndName(category, name)
+	err := s.ProductNoticesStore.Clear(notices)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6277,15 +7859,15 @@ func (s *TimerLayerPreferenceStore) DeleteCategoryAndName(category string, name
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategoryAndName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.Clear", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *TimerLayerProductNoticesStore) ClearOldNotices(currentNotices model.ProductNotices) error {
 	start := time.Now()
 
-	result, err := s.PreferenceStore.DeleteOrphanedRows(limit)
+	err := s.ProductNoticesStore.ClearOldNotices(currentNotices)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6293,15 +7875,15 @@ func (s *TimerLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteOrphanedRows", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.ClearOldNotices", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPreferenceStore) Get(userID string, category string, name string) (*model.Preference, error) {
+func (s *TimerLayerProductNoticesStore) GetViews(userID string) ([]model.ProductNoticeViewState, error) {
 	start := time.Now()
 
-	result, err := s.PreferenceStore.Get(userID, category, name)
+	result, err := s.ProductNoticesStore.GetViews(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6309,15 +7891,15 @@ func (s *TimerLayerPreferenceStore) Get(userID string, category string, name str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.GetViews", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) GetAll(userID string) (model.Preferences, error) {
+func (s *TimerLayerProductNoticesStore) View(userID string, notices []string) error {
 	start := time.Now()
 
-	result, err := s.PreferenceStore.GetAll(userID)
+	err := s.ProductNoticesStore.View(userID, notices)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6325,15 +7907,15 @@ func (s *TimerLayerPreferenceStore) GetAll(userID string) (model.Preferences, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.View", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPreferenceStore) GetCategory(userID string, category string) (model.Preferences, error) {
+func (s *TimerLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
 	start := time.Now()
 
-	result, err := s.PreferenceStore.GetCategory(userID, category)
+	result, err := s.ReactionStore.BulkGetForPosts(postIds)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6341,15 +7923,15 @@ func (s *TimerLayerPreferenceStore) GetCategory(userID string, category string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetCategory", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.BulkGetForPosts", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) GetCategoryAndName(category string, nane string) (model.Preferences, error) {
+func (s *TimerLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
 	start := time.Now()
 
-	result, err := s.PreferenceStore.GetCategoryAndName(category, nane)
+	result, err := s.ReactionStore.Delete(reaction)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6357,15 +7939,15 @@ func (s *TimerLayerPreferenceStore) GetCategoryAndName(category string, nane str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetCategoryAndName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Delete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
+func (s *TimerLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
 	start := time.Now()
 
-	err := s.PreferenceStore.PermanentDeleteByUser(userID)
+	err := s.ReactionStore.DeleteAllWithEmojiName(emojiName)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6373,15 +7955,15 @@ func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.DeleteAllWithEmojiName", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerPreferenceStore) Save(preferences model.Preferences) error {
+func (s *TimerLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
 	start := time.Now()
 
-	err := s.PreferenceStore.Save(preferences)
+	result, err := s.ReactionStore.DeleteOrphanedRows(limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6389,15 +7971,15 @@ func (s *TimerLayerPreferenceStore) Save(preferences model.Preferences) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.DeleteOrphanedRows", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerProductNoticesStore) Clear(notices []string) error {
+func (s *TimerLayerReactionStore) GetForPost(postID string, allowFromCache bool) ([]*model.Reaction, error) {
 	start := time.Now()
 
-	err := s.ProductNoticesStore.Clear(notices)
+	result, err := s.ReactionStore.GetForPost(postID, allowFromCache)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6405,15 +7987,15 @@ func (s *TimerLayerProductNoticesStore) Clear(notices []string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.Clear", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForPost", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerProductNoticesStore) ClearOldNotices(currentNotices model.ProductNotices) error {
+func (s *TimerLayerReactionStore) GetForPostSince(postId string, since int64, excludeRemoteId string, inclDeleted bool) ([]*model.Reaction, error) {
 	start := time.Now()
 
-	err := s.ProductNoticesStore.ClearOldNotices(currentNotices)
+	result, err := s.ReactionStore.GetForPostSince(postId, since, excludeRemoteId, inclDeleted)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6421,15 +8003,15 @@ func (s *TimerLayerProductNoticesStore) ClearOldNotices(currentNotices model.Pro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.ClearOldNotices", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForPostSince", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerProductNoticesStore) GetViews(userID string) ([]model.ProductNoticeViewState, error) {
+func (s *TimerLayerReactionStore) GetTopForTeamSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopReactionList, error) {
 	start := time.Now()
 
-	result, err := s.ProductNoticesStore.GetViews(userID)
+	result, err := s.ReactionStore.GetTopForTeamSince(teamID, userID, since, offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6437,15 +8019,15 @@ func (s *TimerLayerProductNoticesStore) GetViews(userID string) ([]model.Product
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.GetViews", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetTopForTeamSince", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerProductNoticesStore) View(userID string, notices []string) error {
+func (s *TimerLayerReactionStore) GetTopForUserSince(userID string, teamID string, since int64, offset int, limit int) (*model.TopReactionList, error) {
 	start := time.Now()
 
-	err := s.ProductNoticesStore.View(userID, notices)
+	result, err := s.ReactionStore.GetTopForUserSince(userID, teamID, since, offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6453,15 +8035,15 @@ func (s *TimerLayerProductNoticesStore) View(userID string, notices []string) er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.View", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetTopForUserSince", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
+func (s *TimerLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := time.Now()
 
-	result, err := s.ReactionStore.BulkGetForPosts(postIds)
+	result, err := s.ReactionStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6469,15 +8051,15 @@ func (s *TimerLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Re
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.BulkGetForPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
+func (s *TimerLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
 	start := time.Now()
 
-	result, err := s.ReactionStore.Delete(reaction)
+	result, err := s.ReactionStore.Save(reaction)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6485,15 +8067,15 @@ func (s *TimerLayerReactionStore) Delete(reaction *model.Reaction) (*model.React
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
+func (s *TimerLayerReactionSummaryStore) GetLatestDay() (int64, error) {
 	start := time.Now()
 
-	err := s.ReactionStore.DeleteAllWithEmojiName(emojiName)
+	result, err := s.ReactionSummaryStore.GetLatestDay()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6501,15 +8083,15 @@ func (s *TimerLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.DeleteAllWithEmojiName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionSummaryStore.GetLatestDay", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *TimerLayerReactionSummaryStore) GetTopEmojis(opts model.ReactionAnalyticsOptions) ([]*model.ReactionEmojiCount, error) {
 	start := time.Now()
 
-	result, err := s.ReactionStore.DeleteOrphanedRows(limit)
+	result, err := s.ReactionSummaryStore.GetTopEmojis(opts)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6517,15 +8099,15 @@ func (s *TimerLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.DeleteOrphanedRows", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionSummaryStore.GetTopEmojis", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) GetForPost(postID string, allowFromCache bool) ([]*model.Reaction, error) {
+func (s *TimerLayerReactionSummaryStore) GetTopPosts(opts model.ReactionAnalyticsOptions) ([]*model.ReactionPostCount, error) {
 	start := time.Now()
 
-	result, err := s.ReactionStore.GetForPost(postID, allowFromCache)
+	result, err := s.ReactionSummaryStore.GetTopPosts(opts)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6533,15 +8115,15 @@ func (s *TimerLayerReactionStore) GetForPost(postID string, allowFromCache bool)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionSummaryStore.GetTopPosts", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) GetForPostSince(postId string, since int64, excludeRemoteId string, inclDeleted bool) ([]*model.Reaction, error) {
+func (s *TimerLayerReactionSummaryStore) SummarizeDay(dayStart int64) error {
 	start := time.Now()
 
-	result, err := s.ReactionStore.GetForPostSince(postId, since, excludeRemoteId, inclDeleted)
+	err := s.ReactionSummaryStore.SummarizeDay(dayStart)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6549,15 +8131,15 @@ func (s *TimerLayerReactionStore) GetForPostSince(postId string, since int64, ex
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForPostSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionSummaryStore.SummarizeDay", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerReactionStore) GetTopForTeamSince(teamID string, userID string, since int64, offset int, limit int) (*model.TopReactionList, error) {
+func (s *TimerLayerReadReceiptStore) Get(channelID string, userID string) (*model.ReadReceipt, error) {
 	start := time.Now()
 
-	result, err := s.ReactionStore.GetTopForTeamSince(teamID, userID, since, offset, limit)
+	result, err := s.ReadReceiptStore.Get(channelID, userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6565,15 +8147,15 @@ func (s *TimerLayerReactionStore) GetTopForTeamSince(teamID string, userID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetTopForTeamSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReadReceiptStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) GetTopForUserSince(userID string, teamID string, since int64, offset int, limit int) (*model.TopReactionList, error) {
+func (s *TimerLayerReadReceiptStore) GetForChannelSince(channelID string, postCreateAt int64) ([]*model.ReadReceipt, error) {
 	start := time.Now()
 
-	result, err := s.ReactionStore.GetTopForUserSince(userID, teamID, since, offset, limit)
+	result, err := s.ReadReceiptStore.GetForChannelSince(channelID, postCreateAt)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6581,15 +8163,15 @@ func (s *TimerLayerReactionStore) GetTopForUserSince(userID string, teamID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetTopForUserSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReadReceiptStore.GetForChannelSince", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *TimerLayerReadReceiptStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
-	result, err := s.ReactionStore.PermanentDeleteBatch(endTime, limit)
+	err := s.ReadReceiptStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6597,15 +8179,15 @@ func (s *TimerLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int6
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReadReceiptStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
+func (s *TimerLayerReadReceiptStore) Save(receipt *model.ReadReceipt) (*model.ReadReceipt, error) {
 	start := time.Now()
 
-	result, err := s.ReactionStore.Save(reaction)
+	result, err := s.ReadReceiptStore.Save(receipt)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6613,7 +8195,7 @@ func (s *TimerLayerReactionStore) Save(reaction *model.Reaction) (*model.Reactio
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReadReceiptStore.Save", success, elapsed)
 	}
 	return result, err
 }
@@ -6986,6 +8568,22 @@ func (s *TimerLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithT
 	return result, err
 }
 
+func (s *TimerLayerRetentionPolicyStore) Preview(policy *model.RetentionPolicyWithTeamAndChannelIDs, now int64) (*model.RetentionPolicyPreview, error) {
+	start := time.Now()
+
+	result, err := s.RetentionPolicyStore.Preview(policy, now)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Preview", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerRetentionPolicyStore) RemoveChannels(policyId string, channelIds []string) error {
 	start := time.Now()
 
@@ -7002,10 +8600,186 @@ func (s *TimerLayerRetentionPolicyStore) RemoveChannels(policyId string, channel
 	return err
 }
 
-func (s *TimerLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []string) error {
+func (s *TimerLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []string) error {
+	start := time.Now()
+
+	err := s.RetentionPolicyStore.RemoveTeams(policyId, teamIds)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.RemoveTeams", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+	start := time.Now()
+
+	result, err := s.RetentionPolicyStore.Save(policy)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
+	start := time.Now()
+
+	result, err := s.RoleStore.AllChannelSchemeRoles()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.AllChannelSchemeRoles", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, error) {
+	start := time.Now()
+
+	result, err := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelHigherScopedPermissions", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, error) {
+	start := time.Now()
+
+	result, err := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelRolesUnderTeamRole", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) Delete(roleID string) (*model.Role, error) {
+	start := time.Now()
+
+	result, err := s.RoleStore.Delete(roleID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Delete", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) Get(roleID string) (*model.Role, error) {
+	start := time.Now()
+
+	result, err := s.RoleStore.Get(roleID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, error) {
+	start := time.Now()
+
+	result, err := s.RoleStore.GetAll()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) GetByName(ctx context.Context, name string) (*model.Role, error) {
+	start := time.Now()
+
+	result, err := s.RoleStore.GetByName(ctx, name)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByName", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, error) {
+	start := time.Now()
+
+	result, err := s.RoleStore.GetByNames(names)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByNames", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) PermanentDeleteAll() error {
+	start := time.Now()
+
+	err := s.RoleStore.PermanentDeleteAll()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.PermanentDeleteAll", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
 	start := time.Now()
 
-	err := s.RetentionPolicyStore.RemoveTeams(policyId, teamIds)
+	result, err := s.RoleStore.Save(role)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7013,15 +8787,15 @@ func (s *TimerLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.RemoveTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *TimerLayerRoleSnapshotStore) Get(id string) (*model.RoleSnapshot, error) {
 	start := time.Now()
 
-	result, err := s.RetentionPolicyStore.Save(policy)
+	result, err := s.RoleSnapshotStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7029,15 +8803,15 @@ func (s *TimerLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithT
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleSnapshotStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
+func (s *TimerLayerRoleSnapshotStore) GetForRole(roleID string, offset int, limit int) ([]*model.RoleSnapshot, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.AllChannelSchemeRoles()
+	result, err := s.RoleSnapshotStore.GetForRole(roleID, offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7045,15 +8819,15 @@ func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.AllChannelSchemeRoles", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleSnapshotStore.GetForRole", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, error) {
+func (s *TimerLayerRoleSnapshotStore) PermanentDeleteByRole(roleID string) error {
 	start := time.Now()
 
-	result, err := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+	err := s.RoleSnapshotStore.PermanentDeleteByRole(roleID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7061,15 +8835,15 @@ func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelHigherScopedPermissions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleSnapshotStore.PermanentDeleteByRole", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, error) {
+func (s *TimerLayerRoleSnapshotStore) Save(snapshot *model.RoleSnapshot) (*model.RoleSnapshot, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
+	result, err := s.RoleSnapshotStore.Save(snapshot)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7077,15 +8851,15 @@ func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelRolesUnderTeamRole", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleSnapshotStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) Delete(roleID string) (*model.Role, error) {
+func (s *TimerLayerScheduledPostStore) CountPendingForUser(userID string) (int64, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.Delete(roleID)
+	result, err := s.ScheduledPostStore.CountPendingForUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7093,15 +8867,15 @@ func (s *TimerLayerRoleStore) Delete(roleID string) (*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledPostStore.CountPendingForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) Get(roleID string) (*model.Role, error) {
+func (s *TimerLayerScheduledPostStore) Delete(id string) error {
 	start := time.Now()
 
-	result, err := s.RoleStore.Get(roleID)
+	err := s.ScheduledPostStore.Delete(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7109,15 +8883,15 @@ func (s *TimerLayerRoleStore) Get(roleID string) (*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledPostStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, error) {
+func (s *TimerLayerScheduledPostStore) Get(id string) (*model.ScheduledPost, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.GetAll()
+	result, err := s.ScheduledPostStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7125,15 +8899,15 @@ func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledPostStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) GetByName(ctx context.Context, name string) (*model.Role, error) {
+func (s *TimerLayerScheduledPostStore) GetForUser(userID string) ([]*model.ScheduledPost, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.GetByName(ctx, name)
+	result, err := s.ScheduledPostStore.GetForUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7141,15 +8915,15 @@ func (s *TimerLayerRoleStore) GetByName(ctx context.Context, name string) (*mode
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledPostStore.GetForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, error) {
+func (s *TimerLayerScheduledPostStore) GetPendingBefore(until int64, limit int) ([]*model.ScheduledPost, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.GetByNames(names)
+	result, err := s.ScheduledPostStore.GetPendingBefore(until, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7157,15 +8931,15 @@ func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByNames", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledPostStore.GetPendingBefore", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) PermanentDeleteAll() error {
+func (s *TimerLayerScheduledPostStore) Save(scheduledPost *model.ScheduledPost) (*model.ScheduledPost, error) {
 	start := time.Now()
 
-	err := s.RoleStore.PermanentDeleteAll()
+	result, err := s.ScheduledPostStore.Save(scheduledPost)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7173,15 +8947,15 @@ func (s *TimerLayerRoleStore) PermanentDeleteAll() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.PermanentDeleteAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledPostStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
+func (s *TimerLayerScheduledPostStore) Update(scheduledPost *model.ScheduledPost) (*model.ScheduledPost, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.Save(role)
+	result, err := s.ScheduledPostStore.Update(scheduledPost)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7189,7 +8963,7 @@ func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledPostStore.Update", success, elapsed)
 	}
 	return result, err
 }
@@ -8122,6 +9896,86 @@ func (s *TimerLayerStatusStore) UpdateLastActivityAt(userID string, lastActivity
 	return err
 }
 
+func (s *TimerLayerSupportAccessConsentStore) GetActive(userID string, adminID string, now int64) (*model.SupportAccessConsent, error) {
+	start := time.Now()
+
+	result, err := s.SupportAccessConsentStore.GetActive(userID, adminID, now)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SupportAccessConsentStore.GetActive", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerSupportAccessConsentStore) GetForUser(userID string) ([]*model.SupportAccessConsent, error) {
+	start := time.Now()
+
+	result, err := s.SupportAccessConsentStore.GetForUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SupportAccessConsentStore.GetForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerSupportAccessConsentStore) PermanentDeleteByUser(userID string) error {
+	start := time.Now()
+
+	err := s.SupportAccessConsentStore.PermanentDeleteByUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SupportAccessConsentStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerSupportAccessConsentStore) RevokeForUser(userID string, revokedAt int64) error {
+	start := time.Now()
+
+	err := s.SupportAccessConsentStore.RevokeForUser(userID, revokedAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SupportAccessConsentStore.RevokeForUser", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerSupportAccessConsentStore) Save(consent *model.SupportAccessConsent) (*model.SupportAccessConsent, error) {
+	start := time.Now()
+
+	result, err := s.SupportAccessConsentStore.Save(consent)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SupportAccessConsentStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerSystemStore) Get() (model.StringMap, error) {
 	start := time.Now()
 
@@ -8923,7 +10777,151 @@ func (s *TimerLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, m
 func (s *TimerLayerTeamStore) SearchAll(opts *model.TeamSearch) ([]*model.Team, error) {
 	start := time.Now()
 
-	result, err := s.TeamStore.SearchAll(opts)
+	result, err := s.TeamStore.SearchAll(opts)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerTeamStore) SearchAllPaged(opts *model.TeamSearch) ([]*model.Team, int64, error) {
+	start := time.Now()
+
+	result, resultVar1, err := s.TeamStore.SearchAllPaged(opts)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAllPaged", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team, error) {
+	start := time.Now()
+
+	result, err := s.TeamStore.SearchOpen(opts)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchOpen", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Team, error) {
+	start := time.Now()
+
+	result, err := s.TeamStore.SearchPrivate(opts)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchPrivate", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
+	start := time.Now()
+
+	result, err := s.TeamStore.Update(team)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerTeamStore) UpdateLastTeamIconUpdate(teamID string, curTime int64) error {
+	start := time.Now()
+
+	err := s.TeamStore.UpdateLastTeamIconUpdate(teamID, curTime)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateLastTeamIconUpdate", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, error) {
+	start := time.Now()
+
+	result, err := s.TeamStore.UpdateMember(member)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMember", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string) error {
+	start := time.Now()
+
+	err := s.TeamStore.UpdateMembersRole(teamID, userIDs)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMembersRole", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, error) {
+	start := time.Now()
+
+	result, err := s.TeamStore.UpdateMultipleMembers(members)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMultipleMembers", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string) (bool, error) {
+	start := time.Now()
+
+	result, err := s.TeamStore.UserBelongsToTeams(userID, teamIds)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -8931,15 +10929,15 @@ func (s *TimerLayerTeamStore) SearchAll(opts *model.TeamSearch) ([]*model.Team,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UserBelongsToTeams", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) SearchAllPaged(opts *model.TeamSearch) ([]*model.Team, int64, error) {
+func (s *TimerLayerTeamArchiveStore) Delete(teamID string) error {
 	start := time.Now()
 
-	result, resultVar1, err := s.TeamStore.SearchAllPaged(opts)
+	err := s.TeamArchiveStore.Delete(teamID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -8947,15 +10945,15 @@ func (s *TimerLayerTeamStore) SearchAllPaged(opts *model.TeamSearch) ([]*model.T
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAllPaged", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamArchiveStore.Delete", success, elapsed)
 	}
-	return result, resultVar1, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *TimerLayerTeamArchiveStore) Get(teamID string) (*model.TeamArchive, error) {
 	start := time.Now()
 
-	result, err := s.TeamStore.SearchOpen(opts)
+	result, err := s.TeamArchiveStore.Get(teamID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -8963,15 +10961,15 @@ func (s *TimerLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchOpen", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamArchiveStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *TimerLayerTeamArchiveStore) Save(archive *model.TeamArchive) (*model.TeamArchive, error) {
 	start := time.Now()
 
-	result, err := s.TeamStore.SearchPrivate(opts)
+	result, err := s.TeamArchiveStore.Save(archive)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -8979,15 +10977,15 @@ func (s *TimerLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Te
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchPrivate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamArchiveStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
+func (s *TimerLayerTeamArchiveStore) Update(archive *model.TeamArchive) (*model.TeamArchive, error) {
 	start := time.Now()
 
-	result, err := s.TeamStore.Update(team)
+	result, err := s.TeamArchiveStore.Update(archive)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -8995,15 +10993,15 @@ func (s *TimerLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamArchiveStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) UpdateLastTeamIconUpdate(teamID string, curTime int64) error {
+func (s *TimerLayerTeamTemplateStore) Delete(id string, deleteAt int64) error {
 	start := time.Now()
 
-	err := s.TeamStore.UpdateLastTeamIconUpdate(teamID, curTime)
+	err := s.TeamTemplateStore.Delete(id, deleteAt)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -9011,15 +11009,15 @@ func (s *TimerLayerTeamStore) UpdateLastTeamIconUpdate(teamID string, curTime in
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateLastTeamIconUpdate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamTemplateStore.Delete", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, error) {
+func (s *TimerLayerTeamTemplateStore) Get(id string) (*model.TeamTemplate, error) {
 	start := time.Now()
 
-	result, err := s.TeamStore.UpdateMember(member)
+	result, err := s.TeamTemplateStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -9027,15 +11025,15 @@ func (s *TimerLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.Tea
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamTemplateStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string) error {
+func (s *TimerLayerTeamTemplateStore) GetAll(offset int, limit int) ([]*model.TeamTemplate, error) {
 	start := time.Now()
 
-	err := s.TeamStore.UpdateMembersRole(teamID, userIDs)
+	result, err := s.TeamTemplateStore.GetAll(offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -9043,15 +11041,15 @@ func (s *TimerLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMembersRole", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamTemplateStore.GetAll", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, error) {
+func (s *TimerLayerTeamTemplateStore) Save(template *model.TeamTemplate) (*model.TeamTemplate, error) {
 	start := time.Now()
 
-	result, err := s.TeamStore.UpdateMultipleMembers(members)
+	result, err := s.TeamTemplateStore.Save(template)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -9059,15 +11057,15 @@ func (s *TimerLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMultipleMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamTemplateStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string) (bool, error) {
+func (s *TimerLayerTeamTemplateStore) Update(template *model.TeamTemplate) (*model.TeamTemplate, error) {
 	start := time.Now()
 
-	result, err := s.TeamStore.UserBelongsToTeams(userID, teamIds)
+	result, err := s.TeamTemplateStore.Update(template)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -9075,7 +11073,7 @@ func (s *TimerLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UserBelongsToTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamTemplateStore.Update", success, elapsed)
 	}
 	return result, err
 }
@@ -9176,6 +11174,22 @@ func (s *TimerLayerThreadStore) Get(id string) (*model.Thread, error) {
 	return result, err
 }
 
+func (s *TimerLayerThreadStore) GetInboxThreadsForUser(userID string, options model.GetInboxOptions) ([]*model.InboxItem, error) {
+	start := time.Now()
+
+	result, err := s.ThreadStore.GetInboxThreadsForUser(userID, options)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ThreadStore.GetInboxThreadsForUser", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerThreadStore) GetMembershipForUser(userId string, postID string) (*model.ThreadMembership, error) {
 	start := time.Now()
 
@@ -9831,10 +11845,10 @@ func (s *TimerLayerUserStore) AnalyticsGetSystemAdminCount() (int64, error) {
 	return result, err
 }
 
-func (s *TimerLayerUserStore) AutocompleteUsersInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
+func (s *TimerLayerUserStore) AutocompleteUsersInChannel(ctx context.Context, teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, error) {
 	start := time.Now()
 
-	result, err := s.UserStore.AutocompleteUsersInChannel(teamID, channelID, term, options)
+	result, err := s.UserStore.AutocompleteUsersInChannel(ctx, teamID, channelID, term, options)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -10246,6 +12260,22 @@ func (s *TimerLayerUserStore) GetNewUsersForTeam(teamID string, offset int, limi
 	return result, err
 }
 
+func (s *TimerLayerUserStore) GetPageUsingAuthService(authService string, options model.UserGetByAuthServiceOptions) ([]*model.User, int64, error) {
+	start := time.Now()
+
+	result, resultVar1, err := s.UserStore.GetPageUsingAuthService(authService, options)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.GetPageUsingAuthService", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
 func (s *TimerLayerUserStore) GetProfileByGroupChannelIdsForUser(userID string, channelIds []string) (map[string][]*model.User, error) {
 	start := time.Now()
 
@@ -10691,10 +12721,10 @@ func (s *TimerLayerUserStore) Save(user *model.User) (*model.User, error) {
 	return result, err
 }
 
-func (s *TimerLayerUserStore) Search(teamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *TimerLayerUserStore) Search(ctx context.Context, teamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.UserStore.Search(teamID, term, options)
+	result, err := s.UserStore.Search(ctx, teamID, term, options)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -10707,10 +12737,10 @@ func (s *TimerLayerUserStore) Search(teamID string, term string, options *model.
 	return result, err
 }
 
-func (s *TimerLayerUserStore) SearchInChannel(channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *TimerLayerUserStore) SearchInChannel(ctx context.Context, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.UserStore.SearchInChannel(channelID, term, options)
+	result, err := s.UserStore.SearchInChannel(ctx, channelID, term, options)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -10723,10 +12753,10 @@ func (s *TimerLayerUserStore) SearchInChannel(channelID string, term string, opt
 	return result, err
 }
 
-func (s *TimerLayerUserStore) SearchInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *TimerLayerUserStore) SearchInGroup(ctx context.Context, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.UserStore.SearchInGroup(groupID, term, options)
+	result, err := s.UserStore.SearchInGroup(ctx, groupID, term, options)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -10739,10 +12769,10 @@ func (s *TimerLayerUserStore) SearchInGroup(groupID string, term string, options
 	return result, err
 }
 
-func (s *TimerLayerUserStore) SearchNotInChannel(teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *TimerLayerUserStore) SearchNotInChannel(ctx context.Context, teamID string, channelID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.UserStore.SearchNotInChannel(teamID, channelID, term, options)
+	result, err := s.UserStore.SearchNotInChannel(ctx, teamID, channelID, term, options)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -10755,10 +12785,10 @@ func (s *TimerLayerUserStore) SearchNotInChannel(teamID string, channelID string
 	return result, err
 }
 
-func (s *TimerLayerUserStore) SearchNotInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *TimerLayerUserStore) SearchNotInGroup(ctx context.Context, groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.UserStore.SearchNotInGroup(groupID, term, options)
+	result, err := s.UserStore.SearchNotInGroup(ctx, groupID, term, options)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -10771,10 +12801,10 @@ func (s *TimerLayerUserStore) SearchNotInGroup(groupID string, term string, opti
 	return result, err
 }
 
-func (s *TimerLayerUserStore) SearchNotInTeam(notInTeamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *TimerLayerUserStore) SearchNotInTeam(ctx context.Context, notInTeamID string, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.UserStore.SearchNotInTeam(notInTeamID, term, options)
+	result, err := s.UserStore.SearchNotInTeam(ctx, notInTeamID, term, options)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -10787,10 +12817,10 @@ func (s *TimerLayerUserStore) SearchNotInTeam(notInTeamID string, term string, o
 	return result, err
 }
 
-func (s *TimerLayerUserStore) SearchWithoutTeam(term string, options *model.UserSearchOptions) ([]*model.User, error) {
+func (s *TimerLayerUserStore) SearchWithoutTeam(ctx context.Context, term string, options *model.UserSearchOptions) ([]*model.User, error) {
 	start := time.Now()
 
-	result, err := s.UserStore.SearchWithoutTeam(term, options)
+	result, err := s.UserStore.SearchWithoutTeam(ctx, term, options)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -11171,6 +13201,134 @@ func (s *TimerLayerUserTermsOfServiceStore) Save(userTermsOfService *model.UserT
 	return result, err
 }
 
+func (s *TimerLayerWebAuthnCredentialStore) Delete(id string) error {
+	start := time.Now()
+
+	err := s.WebAuthnCredentialStore.Delete(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) Get(id string) (*model.WebAuthnCredential, error) {
+	start := time.Now()
+
+	result, err := s.WebAuthnCredentialStore.Get(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) GetByCredentialId(credentialID string) (*model.WebAuthnCredential, error) {
+	start := time.Now()
+
+	result, err := s.WebAuthnCredentialStore.GetByCredentialId(credentialID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.GetByCredentialId", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) GetForUser(userID string) ([]*model.WebAuthnCredential, error) {
+	start := time.Now()
+
+	result, err := s.WebAuthnCredentialStore.GetForUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.GetForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) PermanentDeleteByUser(userID string) error {
+	start := time.Now()
+
+	err := s.WebAuthnCredentialStore.PermanentDeleteByUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) Save(credential *model.WebAuthnCredential) (*model.WebAuthnCredential, error) {
+	start := time.Now()
+
+	result, err := s.WebAuthnCredentialStore.Save(credential)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) UpdateName(id string, name string) error {
+	start := time.Now()
+
+	err := s.WebAuthnCredentialStore.UpdateName(id, name)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.UpdateName", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) UpdateSignCount(id string, signCount int64, lastUsedAt int64) error {
+	start := time.Now()
+
+	err := s.WebAuthnCredentialStore.UpdateSignCount(id, signCount, lastUsedAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.UpdateSignCount", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerWebhookStore) AnalyticsIncomingCount(teamID string) (int64, error) {
 	start := time.Now()
 
@@ -11218,6 +13376,22 @@ func (s *TimerLayerWebhookStore) ClearCaches() {
 	}
 }
 
+func (s *TimerLayerWebhookStore) DeleteDirectOutgoing(webhookID string, timestamp int64) error {
+	start := time.Now()
+
+	err := s.WebhookStore.DeleteDirectOutgoing(webhookID, timestamp)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.DeleteDirectOutgoing", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerWebhookStore) DeleteIncoming(webhookID string, timestamp int64) error {
 	start := time.Now()
 
@@ -11250,6 +13424,54 @@ func (s *TimerLayerWebhookStore) DeleteOutgoing(webhookID string, timestamp int6
 	return err
 }
 
+func (s *TimerLayerWebhookStore) GetDirectOutgoing(id string) (*model.DirectOutgoingWebhook, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.GetDirectOutgoing(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.GetDirectOutgoing", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerWebhookStore) GetDirectOutgoingByBotUsers(botUserIDs []string) ([]*model.DirectOutgoingWebhook, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.GetDirectOutgoingByBotUsers(botUserIDs)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.GetDirectOutgoingByBotUsers", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerWebhookStore) GetDirectOutgoingList(offset int, limit int) ([]*model.DirectOutgoingWebhook, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.GetDirectOutgoingList(offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.GetDirectOutgoingList", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerWebhookStore) GetIncoming(id string, allowFromCache bool) (*model.IncomingWebhook, error) {
 	start := time.Now()
 
@@ -11537,6 +13759,22 @@ func (s *TimerLayerWebhookStore) PermanentDeleteOutgoingByUser(userID string) er
 	return err
 }
 
+func (s *TimerLayerWebhookStore) SaveDirectOutgoing(webhook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.SaveDirectOutgoing(webhook)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.SaveDirectOutgoing", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerWebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	start := time.Now()
 
@@ -11569,6 +13807,22 @@ func (s *TimerLayerWebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*
 	return result, err
 }
 
+func (s *TimerLayerWebhookStore) UpdateDirectOutgoing(hook *model.DirectOutgoingWebhook) (*model.DirectOutgoingWebhook, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.UpdateDirectOutgoing(hook)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.UpdateDirectOutgoing", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerWebhookStore) UpdateIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	start := time.Now()
 
@@ -11585,6 +13839,22 @@ func (s *TimerLayerWebhookStore) UpdateIncoming(webhook *model.IncomingWebhook)
 	return result, err
 }
 
+func (s *TimerLayerWebhookStore) UpdateIncomingUser(hookID string, userID string) error {
+	start := time.Now()
+
+	err := s.WebhookStore.UpdateIncomingUser(hookID, userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.UpdateIncomingUser", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerWebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, error) {
 	start := time.Now()
 
@@ -11643,39 +13913,57 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 		Metrics: metrics,
 	}
 
+	newStore.ActivityStore = &TimerLayerActivityStore{ActivityStore: childStore.Activity(), Root: &newStore}
+	newStore.ArchivedPostStore = &TimerLayerArchivedPostStore{ArchivedPostStore: childStore.ArchivedPost(), Root: &newStore}
 	newStore.AuditStore = &TimerLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
 	newStore.BotStore = &TimerLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
 	newStore.ChannelStore = &TimerLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
+	newStore.ChannelBookmarkStore = &TimerLayerChannelBookmarkStore{ChannelBookmarkStore: childStore.ChannelBookmark(), Root: &newStore}
+	newStore.ChannelJoinRequestStore = &TimerLayerChannelJoinRequestStore{ChannelJoinRequestStore: childStore.ChannelJoinRequest(), Root: &newStore}
+	newStore.ChannelMemberExpiryStore = &TimerLayerChannelMemberExpiryStore{ChannelMemberExpiryStore: childStore.ChannelMemberExpiry(), Root: &newStore}
 	newStore.ChannelMemberHistoryStore = &TimerLayerChannelMemberHistoryStore{ChannelMemberHistoryStore: childStore.ChannelMemberHistory(), Root: &newStore}
 	newStore.ClusterDiscoveryStore = &TimerLayerClusterDiscoveryStore{ClusterDiscoveryStore: childStore.ClusterDiscovery(), Root: &newStore}
 	newStore.CommandStore = &TimerLayerCommandStore{CommandStore: childStore.Command(), Root: &newStore}
 	newStore.CommandWebhookStore = &TimerLayerCommandWebhookStore{CommandWebhookStore: childStore.CommandWebhook(), Root: &newStore}
 	newStore.ComplianceStore = &TimerLayerComplianceStore{ComplianceStore: childStore.Compliance(), Root: &newStore}
+	newStore.ConfigChangeStore = &TimerLayerConfigChangeStore{ConfigChangeStore: childStore.ConfigChange(), Root: &newStore}
 	newStore.DraftStore = &TimerLayerDraftStore{DraftStore: childStore.Draft(), Root: &newStore}
 	newStore.EmojiStore = &TimerLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
 	newStore.FileInfoStore = &TimerLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
 	newStore.GroupStore = &TimerLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.GuestSponsorshipStore = &TimerLayerGuestSponsorshipStore{GuestSponsorshipStore: childStore.GuestSponsorship(), Root: &newStore}
 	newStore.JobStore = &TimerLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
 	newStore.LicenseStore = &TimerLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
 	newStore.LinkMetadataStore = &TimerLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
+	newStore.MatrixBridgeStore = &TimerLayerMatrixBridgeStore{MatrixBridgeStore: childStore.MatrixBridge(), Root: &newStore}
 	newStore.NotifyAdminStore = &TimerLayerNotifyAdminStore{NotifyAdminStore: childStore.NotifyAdmin(), Root: &newStore}
 	newStore.OAuthStore = &TimerLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
 	newStore.PluginStore = &TimerLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
 	newStore.PostStore = &TimerLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
 	newStore.PostAcknowledgementStore = &TimerLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
+	newStore.PostEmbeddingStore = &TimerLayerPostEmbeddingStore{PostEmbeddingStore: childStore.PostEmbedding(), Root: &newStore}
+	newStore.PostExpirationStore = &TimerLayerPostExpirationStore{PostExpirationStore: childStore.PostExpiration(), Root: &newStore}
 	newStore.PostPriorityStore = &TimerLayerPostPriorityStore{PostPriorityStore: childStore.PostPriority(), Root: &newStore}
+	newStore.PostTranslationStore = &TimerLayerPostTranslationStore{PostTranslationStore: childStore.PostTranslation(), Root: &newStore}
 	newStore.PreferenceStore = &TimerLayerPreferenceStore{PreferenceStore: childStore.Preference(), Root: &newStore}
 	newStore.ProductNoticesStore = &TimerLayerProductNoticesStore{ProductNoticesStore: childStore.ProductNotices(), Root: &newStore}
 	newStore.ReactionStore = &TimerLayerReactionStore{ReactionStore: childStore.Reaction(), Root: &newStore}
+	newStore.ReactionSummaryStore = &TimerLayerReactionSummaryStore{ReactionSummaryStore: childStore.ReactionSummary(), Root: &newStore}
+	newStore.ReadReceiptStore = &TimerLayerReadReceiptStore{ReadReceiptStore: childStore.ReadReceipt(), Root: &newStore}
 	newStore.RemoteClusterStore = &TimerLayerRemoteClusterStore{RemoteClusterStore: childStore.RemoteCluster(), Root: &newStore}
 	newStore.RetentionPolicyStore = &TimerLayerRetentionPolicyStore{RetentionPolicyStore: childStore.RetentionPolicy(), Root: &newStore}
 	newStore.RoleStore = &TimerLayerRoleStore{RoleStore: childStore.Role(), Root: &newStore}
+	newStore.RoleSnapshotStore = &TimerLayerRoleSnapshotStore{RoleSnapshotStore: childStore.RoleSnapshot(), Root: &newStore}
+	newStore.ScheduledPostStore = &TimerLayerScheduledPostStore{ScheduledPostStore: childStore.ScheduledPost(), Root: &newStore}
 	newStore.SchemeStore = &TimerLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
 	newStore.SessionStore = &TimerLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
 	newStore.SharedChannelStore = &TimerLayerSharedChannelStore{SharedChannelStore: childStore.SharedChannel(), Root: &newStore}
 	newStore.StatusStore = &TimerLayerStatusStore{StatusStore: childStore.Status(), Root: &newStore}
+	newStore.SupportAccessConsentStore = &TimerLayerSupportAccessConsentStore{SupportAccessConsentStore: childStore.SupportAccessConsent(), Root: &newStore}
 	newStore.SystemStore = &TimerLayerSystemStore{SystemStore: childStore.System(), Root: &newStore}
 	newStore.TeamStore = &TimerLayerTeamStore{TeamStore: childStore.Team(), Root: &newStore}
+	newStore.TeamArchiveStore = &TimerLayerTeamArchiveStore{TeamArchiveStore: childStore.TeamArchive(), Root: &newStore}
+	newStore.TeamTemplateStore = &TimerLayerTeamTemplateStore{TeamTemplateStore: childStore.TeamTemplate(), Root: &newStore}
 	newStore.TermsOfServiceStore = &TimerLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
 	newStore.ThreadStore = &TimerLayerThreadStore{ThreadStore: childStore.Thread(), Root: &newStore}
 	newStore.TokenStore = &TimerLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
@@ -11684,6 +13972,7 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	newStore.UserStore = &TimerLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &TimerLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
 	newStore.UserTermsOfServiceStore = &TimerLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
+	newStore.WebAuthnCredentialStore = &TimerLayerWebAuthnCredentialStore{WebAuthnCredentialStore: childStore.WebAuthnCredential(), Root: &newStore}
 	newStore.WebhookStore = &TimerLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
 	return &newStore
 }
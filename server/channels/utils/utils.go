@@ -119,6 +119,34 @@ func GetIPAddress(r *http.Request, trustedProxyIPHeader []string) string {
 	return address
 }
 
+// countryHeaders are the headers in which the common CDNs and load balancers pass the country
+// the request was made from.
+var countryHeaders = []string{
+	"CloudFront-Viewer-Country",
+	"CF-IPCountry",
+	"X-Country-Code",
+	"X-AppEngine-Country",
+}
+
+// GetCountryCode returns the ISO 3166-1 alpha-2 code of the country the request was made from, as
+// determined by the proxy in front of the server. Since these headers can be set by anyone, they
+// are only read when the server is configured to trust its proxy.
+func GetCountryCode(r *http.Request, trustedProxyIPHeader []string) string {
+	if len(trustedProxyIPHeader) == 0 {
+		return ""
+	}
+
+	for _, countryHeader := range countryHeaders {
+		country := strings.ToUpper(strings.TrimSpace(r.Header.Get(countryHeader)))
+		// Cloudflare uses XX for unknown countries and T1 for Tor
+		if len(country) == 2 && country != "XX" && country != "T1" {
+			return country
+		}
+	}
+
+	return ""
+}
+
 func GetHostnameFromSiteURL(siteURL string) string {
 	u, err := url.Parse(siteURL)
 	if err != nil {
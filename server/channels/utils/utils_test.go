@@ -165,6 +165,31 @@ func TestGetIPAddress(t *testing.T) {
 	assert.Equal(t, "10.0.0.1", GetIPAddress(&httpRequest11, []string{"X-Forwarded-For"}))
 }
 
+func TestGetCountryCode(t *testing.T) {
+	httpRequest := http.Request{
+		Header: http.Header{
+			"Cloudfront-Viewer-Country": []string{"se"},
+			"Cf-Ipcountry":              []string{"NO"},
+		},
+		RemoteAddr: "10.2.0.1:12345",
+	}
+
+	// The headers can be forged without a trusted proxy in front of the server
+	assert.Equal(t, "", GetCountryCode(&httpRequest, []string{}))
+
+	assert.Equal(t, "SE", GetCountryCode(&httpRequest, []string{"X-Forwarded-For"}))
+
+	httpRequest.Header.Del("Cloudfront-Viewer-Country")
+	assert.Equal(t, "NO", GetCountryCode(&httpRequest, []string{"X-Forwarded-For"}))
+
+	// Unknown countries
+	httpRequest.Header.Set("Cf-Ipcountry", "XX")
+	assert.Equal(t, "", GetCountryCode(&httpRequest, []string{"X-Forwarded-For"}))
+
+	httpRequest.Header.Set("Cf-Ipcountry", "Sweden")
+	assert.Equal(t, "", GetCountryCode(&httpRequest, []string{"X-Forwarded-For"}))
+}
+
 func TestRemoveStringFromSlice(t *testing.T) {
 	a := []string{"one", "two", "three", "four", "five", "six"}
 	expected := []string{"one", "two", "three", "five", "six"}
@@ -9,6 +9,8 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/opentracing/opentracing-go"
+
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/channels/app"
 	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
@@ -189,12 +191,57 @@ func (c *Context) MfaRequired() {
 		return
 	}
 
-	if !user.MfaActive {
+	if user.MfaActive {
+		return
+	}
+
+	// Security keys count as a second factor
+	if hasKeys, appErr := c.App.UserHasWebAuthnCredentials(user.Id); appErr != nil || !hasKeys {
 		c.Err = model.NewAppError("MfaRequired", "api.context.mfa_required.app_error", nil, "", http.StatusForbidden)
 		return
 	}
 }
 
+// IPAccessRequired checks the address of the request against the IP access policies of the
+// roles of the session.
+func (c *Context) IPAccessRequired(r *http.Request) {
+	session := c.AppContext.Session()
+	if appErr := c.App.CheckIPAccess(c.AppContext, session.UserId, session.Roles, r.Header.Get(model.HeaderIPAccessBypassToken)); appErr != nil {
+		c.Err = appErr
+	}
+}
+
+// SupportAccessReadOnly audits the requests of support access sessions and only lets those
+// reading data through.
+func (c *Context) SupportAccessReadOnly(r *http.Request) {
+	session := c.AppContext.Session()
+
+	auditRec := c.MakeAuditRecord("supportAccessRequest", audit.Success)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "admin_id", session.Props[model.SessionPropSupportAccessAdminId])
+	audit.AddEventParameter(auditRec, "consent_id", session.Props[model.SessionPropSupportAccessConsentId])
+	audit.AddEventParameter(auditRec, "method", r.Method)
+
+	subpath, _ := utils.GetSubpathFromConfig(c.App.Config())
+	if model.IsSupportAccessReadOnlyRequest(r.Method, strings.TrimPrefix(r.URL.Path, strings.TrimSuffix(subpath, "/"))) {
+		return
+	}
+
+	auditRec.Fail()
+	c.Err = model.NewAppError("SupportAccessReadOnly", "api.context.support_access.read_only.app_error", nil, "", http.StatusForbidden)
+}
+
+// CancelWithRequest binds the context of the app to the request, for the queries of the handler
+// to be cancelled when the request is aborted. It's only meant for the handlers running long
+// queries without starting any work outliving the request, e.g. the searches.
+func (c *Context) CancelWithRequest(r *http.Request) {
+	ctx := r.Context()
+	if span := opentracing.SpanFromContext(c.AppContext.Context()); span != nil {
+		ctx = opentracing.ContextWithSpan(ctx, span)
+	}
+	c.AppContext.SetContext(ctx)
+}
+
 // ExtendSessionExpiryIfNeeded will update Session.ExpiresAt based on session lengths in config.
 // Session cookies will be resent to the client with updated max age.
 func (c *Context) ExtendSessionExpiryIfNeeded(w http.ResponseWriter, r *http.Request) {
@@ -738,6 +785,127 @@ func (c *Context) RequireBotUserId() *Context {
 	return c
 }
 
+func (c *Context) RequireScheduledPostId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.ScheduledPostId) {
+		c.SetInvalidURLParam("scheduled_post_id")
+	}
+	return c
+}
+
+func (c *Context) RequireIntegrationId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.IntegrationId) {
+		c.SetInvalidURLParam("integration_id")
+	}
+	return c
+}
+
+func (c *Context) RequireCacheName() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if c.Params.CacheName == "" {
+		c.SetInvalidURLParam("cache_name")
+	}
+	return c
+}
+
+func (c *Context) RequireLabelId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.LabelId) {
+		c.SetInvalidURLParam("label_id")
+	}
+	return c
+}
+
+func (c *Context) RequireBookmarkId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.BookmarkId) {
+		c.SetInvalidURLParam("bookmark_id")
+	}
+	return c
+}
+
+func (c *Context) RequireCredentialId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.CredentialId) {
+		c.SetInvalidURLParam("credential_id")
+	}
+	return c
+}
+
+func (c *Context) RequireSnapshotId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.SnapshotId) {
+		c.SetInvalidURLParam("snapshot_id")
+	}
+	return c
+}
+
+func (c *Context) RequireJoinRequestId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.JoinRequestId) {
+		c.SetInvalidURLParam("join_request_id")
+	}
+	return c
+}
+
+func (c *Context) RequireTeamTemplateId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.TeamTemplateId) {
+		c.SetInvalidURLParam("team_template_id")
+	}
+	return c
+}
+
+func (c *Context) RequireConfigChangeId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.ConfigChangeId) {
+		c.SetInvalidURLParam("config_change_id")
+	}
+	return c
+}
+
+func (c *Context) RequireNodeId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.NodeId) {
+		c.SetInvalidURLParam("node_id")
+	}
+	return c
+}
+
 func (c *Context) RequireInvoiceId() *Context {
 	if c.Err != nil {
 		return c
@@ -191,6 +191,7 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	c.AppContext.SetUserAgent(r.UserAgent())
 	c.AppContext.SetAcceptLanguage(r.Header.Get("Accept-Language"))
 	c.AppContext.SetPath(r.URL.Path)
+	// The work started by the request may outlive it, so the context isn't cancelled with it.
 	c.AppContext.SetContext(context.Background())
 	c.Params = ParamsFromRequest(r)
 	c.Logger = c.App.Log()
@@ -296,7 +297,7 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 
 		// Rate limit by UserID
-		if c.App.Srv().RateLimiter != nil && c.App.Srv().RateLimiter.UserIdRateLimit(c.AppContext.Session().UserId, w) {
+		if rateLimiter := c.App.Srv().RateLimiter(); rateLimiter != nil && rateLimiter.UserIdRateLimit(c.AppContext.Session().UserId, w) {
 			return
 		}
 
@@ -344,6 +345,14 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		c.MfaRequired()
 	}
 
+	if c.Err == nil && c.AppContext.Session().UserId != "" {
+		c.IPAccessRequired(r)
+	}
+
+	if c.Err == nil && c.AppContext.Session().IsSupportAccess() {
+		c.SupportAccessReadOnly(r)
+	}
+
 	if c.Err == nil && h.DisableWhenBusy && c.App.Srv().Platform().Busy.IsBusy() {
 		c.SetServerBusyError()
 	}
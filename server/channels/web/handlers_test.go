@@ -4,6 +4,7 @@
 package web
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -885,3 +886,45 @@ func TestCheckCSRFToken(t *testing.T) {
 		assert.Nil(t, c.Err)
 	})
 }
+
+func TestHandlerContextOutlivesRequest(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	web := New(th.Server)
+
+	t.Run("work started by the request isn't cancelled with it", func(t *testing.T) {
+		release := make(chan struct{})
+		done := make(chan error)
+		handler := web.NewHandler(func(c *Context, w http.ResponseWriter, r *http.Request) {
+			c.App.Srv().Go(func() {
+				<-release
+				done <- c.AppContext.Context().Err()
+			})
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		request := httptest.NewRequest("GET", "/api/v4/test", nil).WithContext(ctx)
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+		cancel()
+
+		close(release)
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("searches are cancelled with the request", func(t *testing.T) {
+		var appCtx context.Context
+		handler := web.NewHandler(func(c *Context, w http.ResponseWriter, r *http.Request) {
+			c.CancelWithRequest(r)
+			appCtx = c.AppContext.Context()
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		request := httptest.NewRequest("GET", "/api/v4/test", nil).WithContext(ctx)
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+		cancel()
+
+		require.NotNil(t, appCtx)
+		assert.ErrorIs(t, appCtx.Err(), context.Canceled)
+	})
+}
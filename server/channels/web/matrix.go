@@ -0,0 +1,88 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package web
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/matrix"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// InitMatrix registers the routes of the Matrix Application Service API, which the homeserver
+// pushes room events to. See https://spec.matrix.org/v1.8/application-service-api.
+func (w *Web) InitMatrix() {
+	w.MainRouter.Handle("/_matrix/app/v1/transactions/{txn_id}", w.APIHandlerTrustRequester(matrixTransaction)).Methods("PUT")
+	w.MainRouter.Handle("/_matrix/app/v1/users/{user_id}", w.APIHandlerTrustRequester(matrixQuery)).Methods("GET")
+	w.MainRouter.Handle("/_matrix/app/v1/rooms/{room_alias}", w.APIHandlerTrustRequester(matrixQuery)).Methods("GET")
+}
+
+func writeMatrixError(w http.ResponseWriter, statusCode int, errCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(&matrix.Error{ErrCode: errCode, Message: message})
+}
+
+// authorizeMatrixRequest checks that the request comes from the homeserver, which authenticates
+// with the hs_token of the application service registration.
+func authorizeMatrixRequest(c *Context, w http.ResponseWriter, r *http.Request) bool {
+	settings := c.App.Config().MatrixBridgeSettings
+	if !*settings.Enable {
+		writeMatrixError(w, http.StatusNotFound, "M_UNRECOGNIZED", "The Matrix bridge is disabled.")
+		return false
+	}
+
+	token := r.URL.Query().Get("access_token")
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		token = strings.TrimPrefix(auth, "Bearer ")
+	}
+
+	if token == "" {
+		writeMatrixError(w, http.StatusUnauthorized, "M_UNAUTHORIZED", "Missing token.")
+		return false
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(*settings.HomeserverToken)) != 1 {
+		writeMatrixError(w, http.StatusForbidden, "M_FORBIDDEN", "Invalid token.")
+		return false
+	}
+
+	return true
+}
+
+func matrixTransaction(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !authorizeMatrixRequest(c, w, r) {
+		return
+	}
+
+	var txn matrix.Transaction
+	if err := json.NewDecoder(r.Body).Decode(&txn); err != nil {
+		writeMatrixError(w, http.StatusBadRequest, "M_NOT_JSON", "Invalid transaction.")
+		return
+	}
+
+	if appErr := c.App.HandleMatrixTransaction(c.AppContext, &txn); appErr != nil {
+		c.Logger.Warn("Failed to handle Matrix transaction", mlog.String("txn_id", mux.Vars(r)["txn_id"]), mlog.Err(appErr))
+		writeMatrixError(w, appErr.StatusCode, "M_UNKNOWN", appErr.Id)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte("{}"))
+}
+
+// matrixQuery answers the queries of the homeserver about users and room aliases in the namespaces
+// of the application service. The bridge registers its users itself and doesn't manage aliases, so
+// none are reported to exist.
+func matrixQuery(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !authorizeMatrixRequest(c, w, r) {
+		return
+	}
+
+	writeMatrixError(w, http.StatusNotFound, "M_NOT_FOUND", "Not found.")
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestMatrixTransaction(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	homeserver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"event_id":"$` + model.NewId() + `"}`))
+	}))
+	defer homeserver.Close()
+
+	sendTransaction := func(token, body string) *http.Response {
+		req, err := http.NewRequest(http.MethodPut, apiClient.URL+"/_matrix/app/v1/transactions/"+model.NewId(), strings.NewReader(body))
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	lastPost := func() *model.Post {
+		posts, appErr := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: th.BasicChannel.Id, Page: 0, PerPage: 1})
+		require.Nil(t, appErr)
+		require.Len(t, posts.Order, 1)
+		return posts.Posts[posts.Order[0]]
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		resp := sendTransaction("hs_token", `{"events": []}`)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.MatrixBridgeSettings.Enable = true
+		*cfg.MatrixBridgeSettings.HomeserverURL = homeserver.URL
+		*cfg.MatrixBridgeSettings.HomeserverDomain = "example.com"
+		*cfg.MatrixBridgeSettings.AppServiceToken = "as_token"
+		*cfg.MatrixBridgeSettings.HomeserverToken = "hs_token"
+		*cfg.ServiceSettings.EnablePostUsernameOverride = true
+	})
+
+	roomID := "!" + model.NewId() + ":example.com"
+	_, appErr := th.App.BridgeChannelToMatrix(th.Context, th.BasicChannel.Id, roomID, th.SystemAdminUser.Id)
+	require.Nil(t, appErr)
+
+	t.Run("authentication", func(t *testing.T) {
+		resp := sendTransaction("", `{"events": []}`)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp = sendTransaction("as_token", `{"events": []}`)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("message, edit and redaction", func(t *testing.T) {
+		eventID := "$" + model.NewId()
+		message := `{"events": [{"type": "m.room.message", "event_id": "` + eventID + `", "room_id": "` + roomID + `", "sender": "@alice:matrix.org", "content": {"msgtype": "m.text", "body": "hello from matrix"}}]}`
+
+		resp := sendTransaction("hs_token", message)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		post := lastPost()
+		assert.Equal(t, "hello from matrix", post.Message)
+		assert.Equal(t, "@alice:matrix.org", post.GetProp("override_username"))
+		assert.Equal(t, "true", post.GetProp(model.PostPropsFromMatrix))
+
+		// Retried transactions don't duplicate posts.
+		resp = sendTransaction("hs_token", message)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, post.Id, lastPost().Id)
+
+		resp = sendTransaction("hs_token", `{"events": [{"type": "m.room.message", "event_id": "$`+model.NewId()+`", "room_id": "`+roomID+`", "sender": "@alice:matrix.org", "content": {"msgtype": "m.text", "body": "* edited", "m.new_content": {"msgtype": "m.text", "body": "edited"}, "m.relates_to": {"rel_type": "m.replace", "event_id": "`+eventID+`"}}}]}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		edited, appErr := th.App.GetSinglePost(post.Id, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, "edited", edited.Message)
+
+		resp = sendTransaction("hs_token", `{"events": [{"type": "m.room.redaction", "event_id": "$`+model.NewId()+`", "room_id": "`+roomID+`", "sender": "@alice:matrix.org", "redacts": "`+eventID+`", "content": {}}]}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		_, appErr = th.App.GetSinglePost(post.Id, false)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("events sent by the bridge are ignored", func(t *testing.T) {
+		before := lastPost()
+		resp := sendTransaction("hs_token", `{"events": [{"type": "m.room.message", "event_id": "$`+model.NewId()+`", "room_id": "`+roomID+`", "sender": "@mattermost_`+th.BasicUser.Username+`:example.com", "content": {"msgtype": "m.text", "body": "echo"}}]}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, before.Id, lastPost().Id)
+	})
+
+	t.Run("events of rooms that aren't bridged are ignored", func(t *testing.T) {
+		before := lastPost()
+		resp := sendTransaction("hs_token", `{"events": [{"type": "m.room.message", "event_id": "$`+model.NewId()+`", "room_id": "!other:example.com", "sender": "@alice:matrix.org", "content": {"msgtype": "m.text", "body": "elsewhere"}}]}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, before.Id, lastPost().Id)
+	})
+}
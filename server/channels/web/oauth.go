@@ -27,6 +27,11 @@ func (w *Web) InitOAuth() {
 	w.MainRouter.Handle("/oauth/deauthorize", w.APISessionRequired(deauthorizeOAuthApp)).Methods("POST")
 	w.MainRouter.Handle("/oauth/access_token", w.APIHandlerTrustRequester(getAccessToken)).Methods("POST")
 
+	// OpenID Connect endpoints
+	w.MainRouter.Handle("/.well-known/openid-configuration", w.APIHandlerTrustRequester(getOpenIDConfiguration)).Methods("GET")
+	w.MainRouter.Handle("/oauth/jwks", w.APIHandlerTrustRequester(getOAuthJSONWebKeySet)).Methods("GET")
+	w.MainRouter.Handle("/oauth/userinfo", w.APISessionRequired(getOAuthUserInfo)).Methods("GET", "POST")
+
 	// API version independent OAuth as a client endpoints
 	w.MainRouter.Handle("/oauth/{service:[A-Za-z0-9]+}/complete", w.APIHandler(completeOAuth)).Methods("GET")
 	w.MainRouter.Handle("/oauth/{service:[A-Za-z0-9]+}/login", w.APIHandler(loginWithOAuth)).Methods("GET")
@@ -116,6 +121,10 @@ func authorizeOAuthPage(c *Context, w http.ResponseWriter, r *http.Request) {
 		RedirectURI:  r.URL.Query().Get("redirect_uri"),
 		Scope:        r.URL.Query().Get("scope"),
 		State:        r.URL.Query().Get("state"),
+
+		CodeChallenge:       r.URL.Query().Get("code_challenge"),
+		CodeChallengeMethod: r.URL.Query().Get("code_challenge_method"),
+		Nonce:               r.URL.Query().Get("nonce"),
 	}
 
 	loginHint := r.URL.Query().Get("login_hint")
@@ -189,6 +198,7 @@ func getAccessToken(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	code := r.FormValue("code")
 	refreshToken := r.FormValue("refresh_token")
+	codeVerifier := r.FormValue("code_verifier")
 
 	grantType := r.FormValue("grant_type")
 	switch grantType {
@@ -207,13 +217,19 @@ func getAccessToken(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	clientId := r.FormValue("client_id")
+	// The client credentials are either in the form or, as with most OpenID Connect
+	// clients, in a basic authorization header.
+	clientId, secret, ok := r.BasicAuth()
+	if !ok {
+		clientId = r.FormValue("client_id")
+		secret = r.FormValue("client_secret")
+	}
+
 	if !model.IsValidId(clientId) {
 		c.Err = model.NewAppError("getAccessToken", "api.oauth.get_access_token.bad_client_id.app_error", nil, "", http.StatusBadRequest)
 		return
 	}
 
-	secret := r.FormValue("client_secret")
 	if secret == "" {
 		c.Err = model.NewAppError("getAccessToken", "api.oauth.get_access_token.bad_client_secret.app_error", nil, "", http.StatusBadRequest)
 		return
@@ -227,7 +243,7 @@ func getAccessToken(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("client_id", clientId)
 	c.LogAudit("attempt")
 
-	accessRsp, err := c.App.GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, code, secret, refreshToken)
+	accessRsp, err := c.App.GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, code, secret, refreshToken, codeVerifier)
 	if err != nil {
 		c.Err = err
 		return
@@ -245,6 +261,46 @@ func getAccessToken(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getOpenIDConfiguration(c *Context, w http.ResponseWriter, r *http.Request) {
+	configuration, err := c.App.GetOpenIDConfiguration()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(configuration); err != nil {
+		c.Logger.Warn("Error writing response", mlog.Err(err))
+	}
+}
+
+func getOAuthJSONWebKeySet(c *Context, w http.ResponseWriter, r *http.Request) {
+	keySet, err := c.App.GetOAuthJSONWebKeySet()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(keySet); err != nil {
+		c.Logger.Warn("Error writing response", mlog.Err(err))
+	}
+}
+
+func getOAuthUserInfo(c *Context, w http.ResponseWriter, r *http.Request) {
+	userInfo, err := c.App.GetOAuthUserInfo(c.AppContext.Session())
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(userInfo); err != nil {
+		c.Logger.Warn("Error writing response", mlog.Err(err))
+	}
+}
+
 func completeOAuth(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireService()
 	if c.Err != nil {
@@ -14,6 +14,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -390,6 +391,294 @@ func TestOAuthAccessToken(t *testing.T) {
 	apiClient.ClearOAuthToken()
 }
 
+// setupOAuthServiceProvider enables the OAuth 2.0 service provider and returns an app for the
+// tests to authorize.
+func setupOAuthServiceProvider(t *testing.T, th *TestHelper) *model.OAuthApp {
+	enableOAuth := *th.App.Config().ServiceSettings.EnableOAuthServiceProvider
+	t.Cleanup(func() {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOAuthServiceProvider = enableOAuth })
+	})
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOAuthServiceProvider = true })
+
+	oauthApp, appErr := th.App.CreateOAuthApp(&model.OAuthApp{
+		Name:         "TestApp" + model.NewId(),
+		Homepage:     "https://nowhere.com",
+		Description:  "test",
+		CallbackUrls: []string{"https://nowhere.com"},
+		CreatorId:    th.SystemAdminUser.Id,
+	})
+	require.Nil(t, appErr)
+
+	return oauthApp
+}
+
+func authorizeOAuthAppForCode(t *testing.T, authRequest *model.AuthorizeRequest) string {
+	redirect, _, err := apiClient.AuthorizeOAuthApp(authRequest)
+	require.NoError(t, err)
+	rurl, err := url.Parse(redirect)
+	require.NoError(t, err)
+	require.Empty(t, rurl.Query().Get("error"))
+	return rurl.Query().Get("code")
+}
+
+func TestOAuthAccessTokenWithPKCE(t *testing.T) {
+	th := Setup(t).InitBasic()
+	th.Login(apiClient, th.SystemAdminUser)
+	defer th.TearDown()
+
+	oauthApp := setupOAuthServiceProvider(t, th)
+
+	verifier := "dBjftJeZ4CVP-mJ92K9qHiBI5xqW3_9OMe2ds_6XB3Hh"
+	code := authorizeOAuthAppForCode(t, &model.AuthorizeRequest{
+		ResponseType:        model.AuthCodeResponseType,
+		ClientId:            oauthApp.Id,
+		RedirectURI:         oauthApp.CallbackUrls[0],
+		State:               "123",
+		CodeChallenge:       "_qSw4VBgLvxy28bURR6lnolxtMzN2ZP8Zz6yyjZJAIk",
+		CodeChallengeMethod: model.CodeChallengeMethodS256,
+	})
+
+	data := url.Values{
+		"grant_type":    []string{model.AccessTokenGrantType},
+		"client_id":     []string{oauthApp.Id},
+		"client_secret": []string{oauthApp.ClientSecret},
+		"code":          []string{code},
+		"redirect_uri":  []string{oauthApp.CallbackUrls[0]},
+	}
+
+	_, resp, err := apiClient.GetOAuthAccessToken(data)
+	require.Error(t, err, "should have failed - missing code verifier")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	data.Set("code_verifier", strings.Repeat("a", 43))
+	_, _, err = apiClient.GetOAuthAccessToken(data)
+	require.Error(t, err, "should have failed - wrong code verifier")
+
+	data.Set("code_verifier", verifier)
+	rsp, _, err := apiClient.GetOAuthAccessToken(data)
+	require.NoError(t, err)
+	require.NotEmpty(t, rsp.AccessToken)
+
+	t.Run("verifier without a challenge", func(t *testing.T) {
+		code := authorizeOAuthAppForCode(t, &model.AuthorizeRequest{
+			ResponseType: model.AuthCodeResponseType,
+			ClientId:     oauthApp.Id,
+			RedirectURI:  oauthApp.CallbackUrls[0],
+		})
+
+		data.Set("code", code)
+		_, _, err := apiClient.GetOAuthAccessToken(data)
+		require.Error(t, err)
+	})
+
+	t.Run("code of another app", func(t *testing.T) {
+		otherApp := setupOAuthServiceProvider(t, th)
+		code := authorizeOAuthAppForCode(t, &model.AuthorizeRequest{
+			ResponseType: model.AuthCodeResponseType,
+			ClientId:     otherApp.Id,
+			RedirectURI:  otherApp.CallbackUrls[0],
+		})
+
+		data.Set("code", code)
+		data.Del("code_verifier")
+		_, _, err := apiClient.GetOAuthAccessToken(data)
+		var appErr *model.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, "api.oauth.get_access_token.client_id.app_error", appErr.Id)
+	})
+}
+
+func TestOAuth21Mode(t *testing.T) {
+	th := Setup(t).InitBasic()
+	th.Login(apiClient, th.SystemAdminUser)
+	defer th.TearDown()
+
+	oauthApp := setupOAuthServiceProvider(t, th)
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOAuthServiceProviderOAuth21 = true })
+	defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOAuthServiceProviderOAuth21 = false })
+
+	t.Run("discovery", func(t *testing.T) {
+		configuration, _, err := apiClient.GetOpenIDConfiguration()
+		require.NoError(t, err)
+		assert.Equal(t, []string{model.AuthCodeResponseType}, configuration.ResponseTypesSupported)
+		assert.NotContains(t, configuration.GrantTypesSupported, "implicit")
+		assert.Equal(t, []string{model.CodeChallengeMethodS256}, configuration.CodeChallengeMethodsSupported)
+	})
+
+	authorizeError := func(t *testing.T, authRequest *model.AuthorizeRequest) string {
+		redirect, _, err := apiClient.AuthorizeOAuthApp(authRequest)
+		require.NoError(t, err)
+		rurl, err := url.Parse(redirect)
+		require.NoError(t, err)
+		return rurl.Query().Get("error")
+	}
+
+	t.Run("implicit grant", func(t *testing.T) {
+		assert.Equal(t, "unsupported_response_type", authorizeError(t, &model.AuthorizeRequest{
+			ResponseType: model.ImplicitResponseType,
+			ClientId:     oauthApp.Id,
+			RedirectURI:  oauthApp.CallbackUrls[0],
+		}))
+	})
+
+	t.Run("code without a challenge", func(t *testing.T) {
+		assert.Equal(t, "invalid_request", authorizeError(t, &model.AuthorizeRequest{
+			ResponseType: model.AuthCodeResponseType,
+			ClientId:     oauthApp.Id,
+			RedirectURI:  oauthApp.CallbackUrls[0],
+		}))
+	})
+
+	t.Run("plain challenge", func(t *testing.T) {
+		assert.Equal(t, "invalid_request", authorizeError(t, &model.AuthorizeRequest{
+			ResponseType:        model.AuthCodeResponseType,
+			ClientId:            oauthApp.Id,
+			RedirectURI:         oauthApp.CallbackUrls[0],
+			CodeChallenge:       "dBjftJeZ4CVP-mJ92K9qHiBI5xqW3_9OMe2ds_6XB3Hh",
+			CodeChallengeMethod: model.CodeChallengeMethodPlain,
+		}))
+	})
+
+	t.Run("S256 challenge", func(t *testing.T) {
+		code := authorizeOAuthAppForCode(t, &model.AuthorizeRequest{
+			ResponseType:        model.AuthCodeResponseType,
+			ClientId:            oauthApp.Id,
+			RedirectURI:         oauthApp.CallbackUrls[0],
+			CodeChallenge:       "_qSw4VBgLvxy28bURR6lnolxtMzN2ZP8Zz6yyjZJAIk",
+			CodeChallengeMethod: model.CodeChallengeMethodS256,
+		})
+		assert.NotEmpty(t, code)
+	})
+}
+
+func TestOAuthRefreshTokenReuse(t *testing.T) {
+	th := Setup(t).InitBasic()
+	th.Login(apiClient, th.SystemAdminUser)
+	defer th.TearDown()
+	defer apiClient.ClearOAuthToken()
+
+	oauthApp := setupOAuthServiceProvider(t, th)
+	code := authorizeOAuthAppForCode(t, &model.AuthorizeRequest{
+		ResponseType: model.AuthCodeResponseType,
+		ClientId:     oauthApp.Id,
+		RedirectURI:  oauthApp.CallbackUrls[0],
+	})
+
+	data := url.Values{
+		"grant_type":    []string{model.AccessTokenGrantType},
+		"client_id":     []string{oauthApp.Id},
+		"client_secret": []string{oauthApp.ClientSecret},
+		"code":          []string{code},
+		"redirect_uri":  []string{oauthApp.CallbackUrls[0]},
+	}
+	rsp, _, err := apiClient.GetOAuthAccessToken(data)
+	require.NoError(t, err)
+	firstRefreshToken := rsp.RefreshToken
+
+	data.Set("grant_type", model.RefreshTokenGrantType)
+	data.Set("refresh_token", firstRefreshToken)
+	data.Del("code")
+	rsp, _, err = apiClient.GetOAuthAccessToken(data)
+	require.NoError(t, err)
+	require.NotEqual(t, firstRefreshToken, rsp.RefreshToken)
+
+	apiClient.SetOAuthToken(rsp.AccessToken)
+	_, err = apiClient.DoAPIGet("/oauth_test", "")
+	require.NoError(t, err)
+
+	// Using the rotated refresh token again revokes all the tokens of the app
+	_, _, err = apiClient.GetOAuthAccessToken(data)
+	require.Error(t, err)
+
+	data.Set("refresh_token", rsp.RefreshToken)
+	_, _, err = apiClient.GetOAuthAccessToken(data)
+	require.Error(t, err, "should have failed - the latest refresh token was revoked")
+
+	_, err = apiClient.DoAPIGet("/oauth_test", "")
+	require.Error(t, err, "should have failed - the access token was revoked")
+}
+
+func TestOpenIDConnect(t *testing.T) {
+	th := Setup(t).InitBasic()
+	th.Login(apiClient, th.SystemAdminUser)
+	defer th.TearDown()
+	defer apiClient.ClearOAuthToken()
+
+	oauthApp := setupOAuthServiceProvider(t, th)
+	siteURL := th.App.GetSiteURL()
+
+	configuration, _, err := apiClient.GetOpenIDConfiguration()
+	require.NoError(t, err)
+	assert.Equal(t, siteURL, configuration.Issuer)
+	assert.Equal(t, siteURL+"/oauth/access_token", configuration.TokenEndpoint)
+	assert.Contains(t, configuration.CodeChallengeMethodsSupported, model.CodeChallengeMethodS256)
+
+	keySet, _, err := apiClient.GetOAuthJSONWebKeySet()
+	require.NoError(t, err)
+	require.Len(t, keySet.Keys, 1)
+
+	code := authorizeOAuthAppForCode(t, &model.AuthorizeRequest{
+		ResponseType: model.AuthCodeResponseType,
+		ClientId:     oauthApp.Id,
+		RedirectURI:  oauthApp.CallbackUrls[0],
+		Scope:        "openid profile email",
+		Nonce:        "n-0S6_WzA2Mj",
+	})
+
+	// OpenID Connect clients send their credentials in a basic authorization header
+	form := url.Values{
+		"grant_type":   []string{model.AccessTokenGrantType},
+		"code":         []string{code},
+		"redirect_uri": []string{oauthApp.CallbackUrls[0]},
+	}
+	rq, err := http.NewRequest(http.MethodPost, apiClient.URL+"/oauth/access_token", strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	rq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rq.SetBasicAuth(oauthApp.Id, oauthApp.ClientSecret)
+	httpResp, err := apiClient.HTTPClient.Do(rq)
+	require.NoError(t, err)
+	defer httpResp.Body.Close()
+	require.Equal(t, http.StatusOK, httpResp.StatusCode)
+
+	var rsp model.AccessResponse
+	require.NoError(t, json.NewDecoder(httpResp.Body).Decode(&rsp))
+	require.NotEmpty(t, rsp.IdToken)
+
+	t.Run("id token", func(t *testing.T) {
+		key := th.App.AsymmetricSigningKey()
+		claims := jwt.MapClaims{}
+		token, err := jwt.ParseWithClaims(rsp.IdToken, claims, func(token *jwt.Token) (any, error) {
+			assert.Equal(t, keySet.Keys[0].Kid, token.Header["kid"])
+			return &key.PublicKey, nil
+		})
+		require.NoError(t, err)
+		require.True(t, token.Valid)
+		assert.Equal(t, model.IDTokenSigningAlg, token.Method.Alg())
+		assert.Equal(t, siteURL, claims["iss"])
+		assert.Equal(t, oauthApp.Id, claims["aud"])
+		assert.Equal(t, th.SystemAdminUser.Id, claims["sub"])
+		assert.Equal(t, "n-0S6_WzA2Mj", claims["nonce"])
+		assert.Equal(t, th.SystemAdminUser.Email, claims["email"])
+		assert.Equal(t, th.SystemAdminUser.Username, claims["preferred_username"])
+	})
+
+	t.Run("user info", func(t *testing.T) {
+		apiClient.SetOAuthToken(rsp.AccessToken)
+		userInfo, _, err := apiClient.GetOAuthUserInfo()
+		require.NoError(t, err)
+		assert.Equal(t, th.SystemAdminUser.Id, userInfo.Sub)
+		assert.Equal(t, th.SystemAdminUser.Email, userInfo.Email)
+		assert.Equal(t, th.SystemAdminUser.Username, userInfo.PreferredUsername)
+	})
+
+	t.Run("user info without an oauth token", func(t *testing.T) {
+		th.Login(apiClient, th.SystemAdminUser)
+		_, resp, err := apiClient.GetOAuthUserInfo()
+		require.Error(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+}
+
 func TestMobileLoginWithOAuth(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -91,6 +91,17 @@ type Params struct {
 	GroupSource               model.GroupSource
 	FilterHasMember           string
 	IncludeChannelMemberCount string
+	ScheduledPostId           string
+	IntegrationId             string
+	CacheName                 string
+	LabelId                   string
+	BookmarkId                string
+	CredentialId              string
+	SnapshotId                string
+	JoinRequestId             string
+	TeamTemplateId            string
+	ConfigChangeId            string
+	NodeId                    string
 
 	// Cloud
 	InvoiceId string
@@ -143,6 +154,17 @@ func ParamsFromRequest(r *http.Request) *Params {
 	params.GroupId = props["group_id"]
 	params.RemoteId = props["remote_id"]
 	params.InvoiceId = props["invoice_id"]
+	params.ScheduledPostId = props["scheduled_post_id"]
+	params.IntegrationId = props["integration_id"]
+	params.CacheName = props["cache_name"]
+	params.LabelId = props["label_id"]
+	params.BookmarkId = props["bookmark_id"]
+	params.CredentialId = props["credential_id"]
+	params.SnapshotId = props["snapshot_id"]
+	params.JoinRequestId = props["join_request_id"]
+	params.TeamTemplateId = props["team_template_id"]
+	params.ConfigChangeId = props["config_change_id"]
+	params.NodeId = props["node_id"]
 	params.Scope = query.Get("scope")
 
 	if val, err := strconv.Atoi(query.Get("page")); err != nil || val < 0 {
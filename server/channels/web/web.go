@@ -32,6 +32,7 @@ func New(srv *app.Server) *Web {
 
 	web.InitOAuth()
 	web.InitWebhooks()
+	web.InitMatrix()
 	web.InitSaml()
 	web.InitStatic()
 
@@ -5,7 +5,6 @@ package web
 
 import (
 	"encoding/json"
-	"io"
 	"mime"
 	"net/http"
 	"strings"
@@ -66,7 +65,7 @@ func incomingWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
 	if mediaType == "application/x-www-form-urlencoded" {
 		payload := strings.NewReader(r.FormValue("payload"))
 
-		incomingWebhookPayload, err = decodePayload(payload)
+		incomingWebhookPayload, err = c.App.DecodeIncomingWebhookPayload(id, payload)
 		if err != nil {
 			c.Err = err
 			return
@@ -87,7 +86,7 @@ func incomingWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	} else {
-		incomingWebhookPayload, err = decodePayload(r.Body)
+		incomingWebhookPayload, err = c.App.DecodeIncomingWebhookPayload(id, r.Body)
 		if err != nil {
 			c.Err = err
 			return
@@ -123,13 +122,3 @@ func commandWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/plain")
 	w.Write([]byte("ok"))
 }
-
-func decodePayload(payload io.Reader) (*model.IncomingWebhookRequest, *model.AppError) {
-	incomingWebhookPayload, decodeError := model.IncomingWebhookRequestFromJSON(payload)
-
-	if decodeError != nil {
-		return nil, decodeError
-	}
-
-	return incomingWebhookPayload, nil
-}
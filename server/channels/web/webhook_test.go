@@ -229,6 +229,28 @@ func TestIncomingWebhook(t *testing.T) {
 		assert.True(t, resp.StatusCode == http.StatusForbidden)
 	})
 
+	t.Run("MessageTemplateWebhook", func(t *testing.T) {
+		templateHook, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{
+			ChannelId:       th.BasicChannel.Id,
+			MessageTemplate: `{{.alert.title}} is {{.status | upper}}: {{join ", " .labels}}`,
+		})
+		require.Nil(t, appErr)
+		templateURL := apiClient.URL + "/hooks/" + templateHook.Id
+
+		resp, err := http.Post(templateURL, "application/json", strings.NewReader(`{"alert": {"title": "High CPU"}, "status": "firing", "labels": ["prod", "db"]}`))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		posts, appErr := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: th.BasicChannel.Id, Page: 0, PerPage: 1})
+		require.Nil(t, appErr)
+		require.Len(t, posts.Order, 1)
+		assert.Equal(t, "High CPU is FIRING: prod, db", posts.Posts[posts.Order[0]].Message)
+
+		resp, err = http.Post(templateURL, "application/json", strings.NewReader("not json"))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
 	t.Run("DisableWebhooks", func(t *testing.T) {
 		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = false })
 		resp, err := http.Post(url, "application/json", strings.NewReader("{\"text\":\"this is a test\"}"))
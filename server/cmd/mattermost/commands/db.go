@@ -59,8 +59,19 @@ var DBVersionCmd = &cobra.Command{
 	RunE:  dbVersionCmdF,
 }
 
+var PartitionCmd = &cobra.Command{
+	Use:   "partition",
+	Short: "Partition the posts and thread memberships tables by month",
+	Long: `Convert the Posts and ThreadMemberships tables of a PostgreSQL database into tables partitioned by month, whose partitions are then created and dropped by the partition maintenance job.
+
+The tables are locked while their rows are copied into the partitions: stop the servers and back up the database first.`,
+	Args: cobra.NoArgs,
+	RunE: partitionCmdF,
+}
+
 func init() {
 	ResetCmd.Flags().Bool("confirm", false, "Confirm you really want to delete everything and a DB backup has been performed.")
+	PartitionCmd.Flags().Bool("confirm", false, "Confirm the servers are stopped and a DB backup has been performed.")
 	DBVersionCmd.Flags().Bool("all", false, "Returns all applied migrations")
 
 	DbCmd.AddCommand(
@@ -68,6 +79,7 @@ func init() {
 		ResetCmd,
 		MigrateCmd,
 		DBVersionCmd,
+		PartitionCmd,
 	)
 
 	RootCmd.AddCommand(
@@ -179,3 +191,33 @@ func dbVersionCmdF(command *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func partitionCmdF(command *cobra.Command, args []string) error {
+	confirmFlag, _ := command.Flags().GetBool("confirm")
+	if !confirmFlag {
+		var confirm string
+		CommandPrettyPrintln("Have you stopped the servers and performed a database backup? (YES/NO): ")
+		fmt.Scanln(&confirm)
+		if confirm != "YES" {
+			return errors.New("ABORTED: You did not answer YES exactly, in all capitals.")
+		}
+	}
+
+	cfgDSN := getConfigDSN(command, config.GetEnvironment())
+	cfgStore, err := config.NewStoreFromDSN(cfgDSN, true, nil, true)
+	if err != nil {
+		return errors.Wrap(err, "failed to load configuration")
+	}
+	config := cfgStore.Get()
+
+	store := sqlstore.New(config.SqlSettings, nil)
+	defer store.Close()
+
+	if err := store.PartitionTables(*config.SqlSettings.PartitionsPrecreateMonths); err != nil {
+		return errors.Wrap(err, "failed to partition the tables")
+	}
+
+	CommandPrettyPrintln("Database tables successfully partitioned")
+
+	return nil
+}
@@ -61,7 +61,7 @@ var BulkExportCmd = &cobra.Command{
 	Use:     "bulk [file]",
 	Short:   "Export bulk data.",
 	Long:    "Export data to a file compatible with the Mattermost Bulk Import format.",
-	Example: "export bulk bulk_data.json",
+	Example: "export bulk bulk_data.json --since=1672531200000",
 	RunE:    bulkExportCmdF,
 	Args:    cobra.ExactArgs(1),
 }
@@ -83,6 +83,7 @@ func init() {
 	BulkExportCmd.Flags().Bool("all-teams", true, "Export all teams from the server.")
 	BulkExportCmd.Flags().Bool("attachments", false, "Also export file attachments.")
 	BulkExportCmd.Flags().Bool("archive", false, "Outputs a single archive file.")
+	BulkExportCmd.Flags().Int64("since", 0, "Only export the data created or updated after the given timestamp, expressed in milliseconds since the unix epoch.")
 
 	ExportCmd.AddCommand(ScheduleExportCmd)
 	ExportCmd.AddCommand(CsvExportCmd)
@@ -226,6 +227,11 @@ func bulkExportCmdF(command *cobra.Command, args []string) error {
 		return errors.Wrap(err, "archive flag error")
 	}
 
+	since, err := command.Flags().GetInt64("since")
+	if err != nil {
+		return errors.Wrap(err, "since flag error")
+	}
+
 	fileWriter, err := os.Create(args[0])
 	if err != nil {
 		return err
@@ -240,6 +246,7 @@ func bulkExportCmdF(command *cobra.Command, args []string) error {
 	var opts model.BulkExportOpts
 	opts.IncludeAttachments = attachments
 	opts.CreateArchive = archive
+	opts.Since = since
 	if err := a.BulkExport(request.EmptyContext(a.Log()), fileWriter, filepath.Dir(outPath), nil /* nil job since it's spawned from CLI */, opts); err != nil {
 		CommandPrintErrorln(err.Error())
 		return err
@@ -248,6 +255,7 @@ func bulkExportCmdF(command *cobra.Command, args []string) error {
 	auditRec := a.MakeAuditRecord("bulkExport", audit.Success)
 	auditRec.AddMeta("all_teams", allTeams)
 	auditRec.AddMeta("file", args[0])
+	auditRec.AddMeta("since", since)
 	a.LogAuditRec(auditRec, nil)
 
 	return nil
@@ -11,6 +11,10 @@ import (
 	_ "github.com/mattermost/mattermost-server/v6/server/channels/app/slashcommands"
 	// Plugins
 	_ "github.com/mattermost/mattermost-server/v6/model/oauthproviders/gitlab"
+	_ "github.com/mattermost/mattermost-server/v6/model/oauthproviders/oidc"
+	// Calendar providers
+	_ "github.com/mattermost/mattermost-server/v6/model/calendarproviders/google"
+	_ "github.com/mattermost/mattermost-server/v6/model/calendarproviders/office365"
 
 	// Enterprise Imports
 	_ "github.com/mattermost/mattermost-server/v6/server/channels/imports"
@@ -16,6 +16,7 @@ func GenerateClientConfig(c *model.Config, telemetryID string, license *model.Li
 	props := GenerateLimitedClientConfig(c, telemetryID, license)
 
 	props["EnableCustomUserStatuses"] = strconv.FormatBool(*c.TeamSettings.EnableCustomUserStatuses)
+	props["EnableCalendarStatusSync"] = strconv.FormatBool(*c.TeamSettings.EnableCalendarStatusSync)
 	props["EnableLastActiveTime"] = strconv.FormatBool(*c.TeamSettings.EnableLastActiveTime)
 	props["EnableUserDeactivation"] = strconv.FormatBool(*c.TeamSettings.EnableUserDeactivation)
 	props["RestrictDirectMessage"] = *c.TeamSettings.RestrictDirectMessage
@@ -134,6 +135,17 @@ func GenerateClientConfig(c *model.Config, telemetryID string, license *model.Li
 	props["InsightsEnabled"] = strconv.FormatBool(c.FeatureFlags.InsightsEnabled)
 	props["PostPriority"] = strconv.FormatBool(*c.ServiceSettings.PostPriority)
 	props["AllowSyncedDrafts"] = strconv.FormatBool(*c.ServiceSettings.AllowSyncedDrafts)
+	props["EnableScheduledPosts"] = strconv.FormatBool(*c.ServiceSettings.EnableScheduledPosts)
+	props["EnableMessageTranslation"] = strconv.FormatBool(*c.TranslationSettings.Enable)
+	props["EnableSemanticSearch"] = strconv.FormatBool(*c.SemanticSearchSettings.EnableSearching)
+	props["EnableReadReceipts"] = strconv.FormatBool(*c.ServiceSettings.EnableReadReceipts)
+	props["ReadReceiptsMaxChannelMembers"] = strconv.Itoa(*c.ServiceSettings.ReadReceiptsMaxChannelMembers)
+	props["EnableExpiringPosts"] = strconv.FormatBool(*c.ServiceSettings.EnableExpiringPosts)
+	props["ExpiringPostsMaxSeconds"] = strconv.Itoa(*c.ServiceSettings.ExpiringPostsMaxSeconds)
+	props["EnableEncryptedMessages"] = strconv.FormatBool(*c.ServiceSettings.EnableEncryptedMessages)
+	props["EnableActivityFeed"] = strconv.FormatBool(*c.ServiceSettings.EnableActivityFeed)
+	props["EnableGraphQL"] = strconv.FormatBool(*c.ServiceSettings.EnableGraphQL || c.FeatureFlags.GraphQL)
+	props["EncryptedMessageMaxSize"] = strconv.Itoa(*c.ServiceSettings.EncryptedMessageMaxSize)
 
 	if license != nil {
 		props["ExperimentalEnableAuthenticationTransfer"] = strconv.FormatBool(*c.ServiceSettings.ExperimentalEnableAuthenticationTransfer)
@@ -260,6 +272,10 @@ func GenerateLimitedClientConfig(c *model.Config, telemetryID string, license *m
 	props["GitLabButtonColor"] = *c.GitLabSettings.ButtonColor
 	props["GitLabButtonText"] = *c.GitLabSettings.ButtonText
 
+	props["EnableSignUpWithOIDC"] = strconv.FormatBool(*c.OIDCSettings.Enable)
+	props["OIDCButtonColor"] = *c.OIDCSettings.ButtonColor
+	props["OIDCButtonText"] = *c.OIDCSettings.ButtonText
+
 	props["TermsOfServiceLink"] = *c.SupportSettings.TermsOfServiceLink
 	props["PrivacyPolicyLink"] = *c.SupportSettings.PrivacyPolicyLink
 	props["AboutLink"] = *c.SupportSettings.AboutLink
@@ -314,6 +330,8 @@ func GenerateLimitedClientConfig(c *model.Config, telemetryID string, license *m
 	props["CustomDescriptionText"] = *c.TeamSettings.CustomDescriptionText
 	props["EnableMultifactorAuthentication"] = strconv.FormatBool(*c.ServiceSettings.EnableMultifactorAuthentication)
 	props["EnforceMultifactorAuthentication"] = "false"
+	props["EnableWebAuthn"] = strconv.FormatBool(*c.WebAuthnSettings.Enable)
+	props["EnablePasskeyLogin"] = strconv.FormatBool(*c.WebAuthnSettings.Enable && *c.WebAuthnSettings.EnablePasskeyLogin)
 	props["EnableGuestAccounts"] = strconv.FormatBool(*c.GuestAccountsSettings.Enable)
 	props["GuestAccountsEnforceMultifactorAuthentication"] = strconv.FormatBool(*c.GuestAccountsSettings.EnforceMultifactorAuthentication)
 
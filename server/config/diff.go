@@ -49,12 +49,20 @@ var configSensitivePaths = map[string]bool{
 	"GoogleSettings.Secret":                                  true,
 	"Office365Settings.Secret":                               true,
 	"OpenIdSettings.Secret":                                  true,
+	"OIDCSettings.Secret":                                    true,
 	"ElasticsearchSettings.Password":                         true,
 	"MessageExportSettings.GlobalRelaySettings.SMTPUsername": true,
 	"MessageExportSettings.GlobalRelaySettings.SMTPPassword": true,
 	"MessageExportSettings.GlobalRelaySettings.EmailAddress": true,
 	"ServiceSettings.GfycatAPISecret":                        true,
 	"ServiceSettings.SplitKey":                               true,
+	"TranslationSettings.APIKey":                             true,
+	"SemanticSearchSettings.EmbeddingAPIKey":                 true,
+	"ColdStorageSettings.AmazonS3SecretAccessKey":            true,
+	"MatrixBridgeSettings.AppServiceToken":                   true,
+	"MatrixBridgeSettings.HomeserverToken":                   true,
+	"SecretsSettings.VaultToken":                             true,
+	"CacheSettings.RedisPassword":                            true,
 	"PluginSettings.Plugins":                                 true,
 }
 
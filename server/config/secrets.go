@@ -0,0 +1,373 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const (
+	// SecretReferencePrefix prefixes the config values referencing a secret, as
+	// secret://<backend>/<name>[#<key>], which is resolved when the config is loaded.
+	SecretReferencePrefix = "secret://"
+
+	SecretBackendVault   = "vault"
+	SecretBackendAWS     = "aws"
+	SecretBackendEnvFile = "envfile"
+
+	secretsRequestTimeout = 10 * time.Second
+)
+
+// IsSecretReference returns whether the config value references a secret.
+func IsSecretReference(value string) bool {
+	return strings.HasPrefix(value, SecretReferencePrefix)
+}
+
+// secretReference is a parsed secret://<backend>/<name>[#<key>] reference.
+type secretReference struct {
+	backend string
+	name    string
+	key     string
+}
+
+func parseSecretReference(value string) (*secretReference, error) {
+	rest := strings.TrimPrefix(value, SecretReferencePrefix)
+
+	ref := &secretReference{}
+	if i := strings.LastIndex(rest, "#"); i >= 0 {
+		rest, ref.key = rest[:i], rest[i+1:]
+	}
+
+	var found bool
+	ref.backend, ref.name, found = strings.Cut(rest, "/")
+	if !found || ref.backend == "" || ref.name == "" {
+		return nil, errors.Errorf("malformed secret reference %q, expected secret://<backend>/<name>[#<key>]", value)
+	}
+
+	return ref, nil
+}
+
+// resolvedSecret is a config value resolved from the secret it references.
+type resolvedSecret struct {
+	Reference string
+	Value     string
+}
+
+// secretResolver resolves the secret references of a config with the backends of its
+// SecretsSettings. Secrets are fetched once per resolver, for the references to the keys of a
+// same secret to only fetch it once.
+type secretResolver struct {
+	settings   *model.SecretsSettings
+	httpClient *http.Client
+	secrets    map[string]map[string]string
+	envFile    map[string]string
+}
+
+func newSecretResolver(settings *model.SecretsSettings) *secretResolver {
+	return &secretResolver{
+		settings:   settings,
+		httpClient: &http.Client{Timeout: secretsRequestTimeout},
+		secrets:    map[string]map[string]string{},
+	}
+}
+
+func (r *secretResolver) resolve(value string) (string, error) {
+	ref, err := parseSecretReference(value)
+	if err != nil {
+		return "", err
+	}
+
+	switch ref.backend {
+	case SecretBackendVault:
+		return r.resolveVault(ref)
+	case SecretBackendAWS:
+		return r.resolveAWS(ref)
+	case SecretBackendEnvFile:
+		return r.resolveEnvFile(ref)
+	default:
+		return "", errors.Errorf("unknown secret backend %q", ref.backend)
+	}
+}
+
+// resolveVault reads the secret at the path from the Vault KV secrets engine, in either of its
+// versions. The key defaults to "value".
+func (r *secretResolver) resolveVault(ref *secretReference) (string, error) {
+	cacheKey := SecretBackendVault + "/" + ref.name
+	data, ok := r.secrets[cacheKey]
+	if !ok {
+		if *r.settings.VaultAddress == "" {
+			return "", errors.New("SecretsSettings.VaultAddress is not set")
+		}
+
+		req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(*r.settings.VaultAddress, "/")+"/v1/"+strings.TrimPrefix(ref.name, "/"), nil)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to create the Vault request")
+		}
+		req.Header.Set("X-Vault-Token", *r.settings.VaultToken)
+
+		resp, err := r.httpClient.Do(req)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read the Vault secret %s", ref.name)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return "", errors.Errorf("failed to read the Vault secret %s: status %d", ref.name, resp.StatusCode)
+		}
+
+		var body struct {
+			Data map[string]any `json:"data"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return "", errors.Wrapf(err, "failed to decode the Vault secret %s", ref.name)
+		}
+
+		// The KV version 2 engine nests the secret in a data field, next to its metadata.
+		fields := body.Data
+		if nested, ok := body.Data["data"].(map[string]any); ok {
+			if _, hasMetadata := body.Data["metadata"]; hasMetadata {
+				fields = nested
+			}
+		}
+
+		data = make(map[string]string, len(fields))
+		for k, v := range fields {
+			if s, ok := v.(string); ok {
+				data[k] = s
+			} else {
+				data[k] = fmt.Sprint(v)
+			}
+		}
+		r.secrets[cacheKey] = data
+	}
+
+	key := ref.key
+	if key == "" {
+		key = "value"
+	}
+
+	value, ok := data[key]
+	if !ok {
+		return "", errors.Errorf("the Vault secret %s has no key %q", ref.name, key)
+	}
+
+	return value, nil
+}
+
+// resolveAWS reads the secret from AWS Secrets Manager. The whole secret string is used unless a
+// key is given, the secret being a JSON object then.
+func (r *secretResolver) resolveAWS(ref *secretReference) (string, error) {
+	cacheKey := SecretBackendAWS + "/" + ref.name
+	data, ok := r.secrets[cacheKey]
+	if !ok {
+		config := &aws.Config{}
+		if *r.settings.AWSRegion != "" {
+			config.Region = r.settings.AWSRegion
+		}
+
+		sess, err := session.NewSession(config)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to create the AWS session")
+		}
+
+		out, err := secretsmanager.New(sess).GetSecretValue(&secretsmanager.GetSecretValueInput{
+			SecretId: aws.String(ref.name),
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read the AWS secret %s", ref.name)
+		}
+
+		data = map[string]string{"": aws.StringValue(out.SecretString)}
+		r.secrets[cacheKey] = data
+	}
+
+	if ref.key == "" {
+		return data[""], nil
+	}
+
+	if value, ok := data[ref.key]; ok {
+		return value, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(data[""]), &fields); err != nil {
+		return "", errors.Wrapf(err, "the AWS secret %s is not a JSON object", ref.name)
+	}
+
+	v, ok := fields[ref.key]
+	if !ok {
+		return "", errors.Errorf("the AWS secret %s has no key %q", ref.name, ref.key)
+	}
+
+	value, ok := v.(string)
+	if !ok {
+		value = fmt.Sprint(v)
+	}
+	data[ref.key] = value
+
+	return value, nil
+}
+
+// resolveEnvFile reads the variable from the KEY=VALUE lines of the env file.
+func (r *secretResolver) resolveEnvFile(ref *secretReference) (string, error) {
+	if r.envFile == nil {
+		if *r.settings.EnvFile == "" {
+			return "", errors.New("SecretsSettings.EnvFile is not set")
+		}
+
+		data, err := os.ReadFile(*r.settings.EnvFile)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to read the secrets env file")
+		}
+
+		r.envFile = parseEnvFile(data)
+	}
+
+	value, ok := r.envFile[ref.name]
+	if !ok {
+		return "", errors.Errorf("the secrets env file has no variable %s", ref.name)
+	}
+
+	return value, nil
+}
+
+// parseEnvFile parses the KEY=VALUE lines of an env file, ignoring the blank lines and the
+// comments. The values may be quoted.
+func parseEnvFile(data []byte) map[string]string {
+	vars := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, found := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		if !found {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		} else if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			value = value[1 : len(value)-1]
+		}
+
+		vars[strings.TrimSpace(key)] = value
+	}
+
+	return vars
+}
+
+// resolveSecretReferences returns a copy of the config with its secret references replaced by
+// the secrets, along with the references it resolved by path. Only the settings masked by
+// Config.Sanitize are resolved, for the secrets not to be served in clear text along with the
+// config, and the SecretsSettings can't reference secrets themselves.
+func resolveSecretReferences(cfg *model.Config) (*model.Config, map[string]resolvedSecret, error) {
+	resolved := cfg.Clone()
+	refs := map[string]resolvedSecret{}
+	resolver := newSecretResolver(&resolved.SecretsSettings)
+	secretPaths := sanitizedPaths(cfg)
+
+	var resolveErr error
+	walkConfigStrings(reflect.ValueOf(resolved).Elem(), "", func(path string, value reflect.Value) {
+		if resolveErr != nil || !secretPaths[path] || strings.HasPrefix(path, "SecretsSettings.") || !IsSecretReference(value.String()) {
+			return
+		}
+
+		secret, err := resolver.resolve(value.String())
+		if err != nil {
+			resolveErr = errors.Wrapf(err, "failed to resolve the secret of %s", path)
+			return
+		}
+
+		refs[path] = resolvedSecret{Reference: value.String(), Value: secret}
+		value.SetString(secret)
+	})
+	if resolveErr != nil {
+		return nil, nil, resolveErr
+	}
+
+	return resolved, refs, nil
+}
+
+// sanitizedPaths returns the paths of the string settings of the config masked by
+// Config.Sanitize.
+func sanitizedPaths(cfg *model.Config) map[string]bool {
+	sanitized := cfg.Clone()
+	sanitized.Sanitize()
+
+	paths := map[string]bool{}
+	walkConfigStrings(reflect.ValueOf(sanitized).Elem(), "", func(path string, value reflect.Value) {
+		if value.String() == model.FakeSetting {
+			paths[path] = true
+		}
+	})
+
+	return paths
+}
+
+// restoreSecretReferences puts the references back in the config where it still has the secrets
+// they were resolved to, so that the secrets aren't persisted with it.
+func restoreSecretReferences(cfg *model.Config, refs map[string]resolvedSecret) {
+	if len(refs) == 0 {
+		return
+	}
+
+	walkConfigStrings(reflect.ValueOf(cfg).Elem(), "", func(path string, value reflect.Value) {
+		if ref, ok := refs[path]; ok && value.String() == ref.Value {
+			value.SetString(ref.Reference)
+		}
+	})
+}
+
+// walkConfigStrings calls fn with the settable string values in v, the strings of the slices
+// being identified by their index in the path, e.g. SqlSettings.DataSourceReplicas.0.
+func walkConfigStrings(v reflect.Value, path string, fn func(path string, value reflect.Value)) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			walkConfigStrings(v.Elem(), path, fn)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			fieldPath := field.Name
+			if path != "" {
+				fieldPath = path + "." + field.Name
+			}
+			walkConfigStrings(v.Field(i), fieldPath, fn)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkConfigStrings(v.Index(i), path+"."+strconv.Itoa(i), fn)
+		}
+	case reflect.String:
+		if v.CanSet() {
+			fn(path, v)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package config
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestParseSecretReference(t *testing.T) {
+	ref, err := parseSecretReference("secret://vault/secret/data/mattermost#smtp_password")
+	require.NoError(t, err)
+	assert.Equal(t, &secretReference{backend: "vault", name: "secret/data/mattermost", key: "smtp_password"}, ref)
+
+	ref, err = parseSecretReference("secret://envfile/SMTP_PASSWORD")
+	require.NoError(t, err)
+	assert.Equal(t, &secretReference{backend: "envfile", name: "SMTP_PASSWORD"}, ref)
+
+	for _, value := range []string{"secret://", "secret://vault", "secret://vault/", "secret:///name"} {
+		_, err = parseSecretReference(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestParseEnvFile(t *testing.T) {
+	vars := parseEnvFile([]byte(`
+# Mattermost secrets
+SMTP_PASSWORD=plain
+export S3_SECRET="quoted # value"
+DB_PASSWORD='single=quoted'
+invalid line
+`))
+
+	assert.Equal(t, map[string]string{
+		"SMTP_PASSWORD": "plain",
+		"S3_SECRET":     "quoted # value",
+		"DB_PASSWORD":   "single=quoted",
+	}, vars)
+}
+
+func writeSecretsEnvFile(t *testing.T, path string, vars map[string]string) {
+	t.Helper()
+
+	var data []byte
+	for k, v := range vars {
+		data = append(data, []byte(k+"="+v+"\n")...)
+	}
+	require.NoError(t, os.WriteFile(path, data, 0600))
+}
+
+func TestResolveSecretReferences(t *testing.T) {
+	envFile := filepath.Join(t.TempDir(), "secrets.env")
+	writeSecretsEnvFile(t, envFile, map[string]string{"SMTP_PASSWORD": "smtp-secret"})
+
+	var vaultRequests int
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vaultRequests++
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/secret/data/mattermost":
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"data":     map[string]any{"es_password": "es-secret", "secret_key": "s3-secret"},
+					"metadata": map[string]any{"version": 3},
+				},
+			})
+		case "/v1/kv/mattermost":
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"value": "replica-dsn"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer vault.Close()
+
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+	cfg.SecretsSettings.EnvFile = model.NewString(envFile)
+	cfg.SecretsSettings.VaultAddress = model.NewString(vault.URL)
+	cfg.SecretsSettings.VaultToken = model.NewString("vault-token")
+	cfg.EmailSettings.SMTPPassword = model.NewString("secret://envfile/SMTP_PASSWORD")
+	cfg.ElasticsearchSettings.Password = model.NewString("secret://vault/secret/data/mattermost#es_password")
+	cfg.FileSettings.AmazonS3SecretAccessKey = model.NewString("secret://vault/secret/data/mattermost#secret_key")
+	cfg.SqlSettings.DataSourceReplicas = []string{"secret://vault/kv/mattermost"}
+
+	t.Run("resolves the references", func(t *testing.T) {
+		vaultRequests = 0
+
+		resolved, refs, err := resolveSecretReferences(cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, "smtp-secret", *resolved.EmailSettings.SMTPPassword)
+		assert.Equal(t, "es-secret", *resolved.ElasticsearchSettings.Password)
+		assert.Equal(t, "s3-secret", *resolved.FileSettings.AmazonS3SecretAccessKey)
+		assert.Equal(t, []string{"replica-dsn"}, resolved.SqlSettings.DataSourceReplicas)
+		assert.Len(t, refs, 4)
+		assert.Equal(t, resolvedSecret{Reference: "secret://vault/kv/mattermost", Value: "replica-dsn"}, refs["SqlSettings.DataSourceReplicas.0"])
+
+		// The secret is only read once for its two keys.
+		assert.Equal(t, 2, vaultRequests)
+
+		// The config itself is left as is.
+		assert.Equal(t, "secret://envfile/SMTP_PASSWORD", *cfg.EmailSettings.SMTPPassword)
+
+		restoreSecretReferences(resolved, refs)
+		assert.Equal(t, cfg, resolved)
+	})
+
+	t.Run("keeps the changed values when restoring the references", func(t *testing.T) {
+		resolved, refs, err := resolveSecretReferences(cfg)
+		require.NoError(t, err)
+
+		resolved.EmailSettings.SMTPPassword = model.NewString("new-password")
+		restoreSecretReferences(resolved, refs)
+
+		assert.Equal(t, "new-password", *resolved.EmailSettings.SMTPPassword)
+		assert.Equal(t, "secret://vault/secret/data/mattermost#secret_key", *resolved.FileSettings.AmazonS3SecretAccessKey)
+	})
+
+	t.Run("only resolves the sanitized settings", func(t *testing.T) {
+		unsanitized := cfg.Clone()
+		unsanitized.TeamSettings.SiteName = model.NewString("secret://envfile/SMTP_PASSWORD")
+		unsanitized.FileSettings.AmazonS3AccessKeyId = model.NewString("secret://vault/secret/data/mattermost#secret_key")
+
+		resolved, refs, err := resolveSecretReferences(unsanitized)
+		require.NoError(t, err)
+
+		assert.Equal(t, "secret://envfile/SMTP_PASSWORD", *resolved.TeamSettings.SiteName)
+		assert.Equal(t, "secret://vault/secret/data/mattermost#secret_key", *resolved.FileSettings.AmazonS3AccessKeyId)
+		assert.NotContains(t, refs, "TeamSettings.SiteName")
+		assert.NotContains(t, refs, "FileSettings.AmazonS3AccessKeyId")
+		assert.Equal(t, "smtp-secret", *resolved.EmailSettings.SMTPPassword)
+	})
+
+	t.Run("fails on a missing secret", func(t *testing.T) {
+		missing := cfg.Clone()
+		missing.LdapSettings.BindPassword = model.NewString("secret://envfile/LDAP_PASSWORD")
+
+		_, _, err := resolveSecretReferences(missing)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "LdapSettings.BindPassword")
+	})
+
+	t.Run("fails on an unknown backend", func(t *testing.T) {
+		unknown := cfg.Clone()
+		unknown.LdapSettings.BindPassword = model.NewString("secret://keychain/ldap")
+
+		_, _, err := resolveSecretReferences(unknown)
+		require.Error(t, err)
+	})
+
+	t.Run("fails on a denied Vault token", func(t *testing.T) {
+		denied := cfg.Clone()
+		denied.SecretsSettings.VaultToken = model.NewString("wrong-token")
+
+		_, _, err := resolveSecretReferences(denied)
+		require.Error(t, err)
+	})
+}
+
+func TestStoreSecretReferences(t *testing.T) {
+	envFile := filepath.Join(t.TempDir(), "secrets.env")
+	writeSecretsEnvFile(t, envFile, map[string]string{"SMTP_PASSWORD": "smtp-secret"})
+
+	initialCfg := &model.Config{}
+	initialCfg.SetDefaults()
+	initialCfg.SecretsSettings.EnvFile = model.NewString(envFile)
+	initialCfg.EmailSettings.SMTPPassword = model.NewString("secret://envfile/SMTP_PASSWORD")
+
+	ms, err := NewMemoryStoreWithOptions(&MemoryStoreOptions{InitialConfig: initialCfg})
+	require.NoError(t, err)
+
+	store, err := NewStoreFromBacking(ms, nil, false)
+	require.NoError(t, err)
+	defer store.Close()
+
+	assert.Equal(t, "smtp-secret", *store.Get().EmailSettings.SMTPPassword)
+	assert.Equal(t, "secret://envfile/SMTP_PASSWORD", *store.GetNoEnv().EmailSettings.SMTPPassword)
+
+	t.Run("persists the references on save", func(t *testing.T) {
+		cfg := store.Get().Clone()
+		cfg.EmailSettings.SMTPUsername = model.NewString("mattermost")
+
+		_, newCfg, err := store.Set(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "smtp-secret", *newCfg.EmailSettings.SMTPPassword)
+
+		saved, err := ms.Load()
+		require.NoError(t, err)
+		assert.Contains(t, string(saved), "secret://envfile/SMTP_PASSWORD")
+		assert.NotContains(t, string(saved), "smtp-secret")
+	})
+
+	t.Run("restores the references in place of the secrets", func(t *testing.T) {
+		cfg := store.RestoreSecretReferences(store.Get())
+		assert.Equal(t, "secret://envfile/SMTP_PASSWORD", *cfg.EmailSettings.SMTPPassword)
+		assert.Equal(t, "smtp-secret", *store.Get().EmailSettings.SMTPPassword)
+	})
+
+	t.Run("refreshes the rotated secrets", func(t *testing.T) {
+		var notified *model.Config
+		listenerID := store.AddListener(func(oldCfg, newCfg *model.Config) {
+			notified = newCfg
+		})
+		defer store.RemoveListener(listenerID)
+
+		require.NoError(t, store.RefreshSecrets())
+		assert.Nil(t, notified)
+
+		writeSecretsEnvFile(t, envFile, map[string]string{"SMTP_PASSWORD": "rotated-secret"})
+
+		require.NoError(t, store.RefreshSecrets())
+		require.NotNil(t, notified)
+		assert.Equal(t, "rotated-secret", *notified.EmailSettings.SMTPPassword)
+		assert.Equal(t, "rotated-secret", *store.Get().EmailSettings.SMTPPassword)
+		assert.Equal(t, "secret://envfile/SMTP_PASSWORD", *store.GetNoEnv().EmailSettings.SMTPPassword)
+	})
+}
+
+func TestStoreResolvesSecretsWithoutLock(t *testing.T) {
+	requested := make(chan struct{})
+	release := make(chan struct{})
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(requested)
+		<-release
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"value": "smtp-secret"},
+		})
+	}))
+	defer vault.Close()
+
+	ms, err := NewMemoryStore()
+	require.NoError(t, err)
+
+	store, err := NewStoreFromBacking(ms, nil, false)
+	require.NoError(t, err)
+	defer store.Close()
+
+	cfg := store.Get().Clone()
+	cfg.SecretsSettings.VaultAddress = model.NewString(vault.URL)
+	cfg.EmailSettings.SMTPPassword = model.NewString("secret://vault/kv/smtp")
+
+	done := make(chan error)
+	go func() {
+		_, _, err := store.Set(cfg)
+		done <- err
+	}()
+
+	<-requested
+
+	// The config can be read while the secrets are being fetched.
+	read := make(chan *model.Config)
+	go func() {
+		read <- store.Get()
+	}()
+	select {
+	case current := <-read:
+		assert.Equal(t, "", *current.EmailSettings.SMTPPassword)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "reading the config was blocked by the secrets being fetched")
+	}
+
+	close(release)
+	require.NoError(t, <-done)
+	assert.Equal(t, "smtp-secret", *store.Get().EmailSettings.SMTPPassword)
+}
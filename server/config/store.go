@@ -14,6 +14,7 @@ import (
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/channels/utils/jsonutils"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
 )
 
 var (
@@ -28,6 +29,9 @@ type Store struct {
 	emitter
 	backingStore BackingStore
 
+	// writeLock serializes the changes of the config, for the secrets to be resolved without
+	// holding configLock.
+	writeLock            sync.Mutex
 	configLock           sync.RWMutex
 	config               *model.Config
 	configNoEnv          *model.Config
@@ -35,6 +39,12 @@ type Store struct {
 
 	readOnly   bool
 	readOnlyFF bool
+
+	// secretReferences are the secret references resolved in config, by path.
+	secretReferences   map[string]resolvedSecret
+	secretsRefreshOnce sync.Once
+	secretsRefreshStop chan struct{}
+	secretsRefreshDone chan struct{}
 }
 
 // BackingStore defines the behaviour exposed by the underlying store
@@ -74,6 +84,8 @@ func NewStoreFromBacking(backingStore BackingStore, customDefaults *model.Config
 		configCustomDefaults: customDefaults,
 		readOnly:             readOnly,
 		readOnlyFF:           true,
+		secretsRefreshStop:   make(chan struct{}),
+		secretsRefreshDone:   make(chan struct{}),
 	}
 
 	if err := store.Load(); err != nil {
@@ -155,6 +167,17 @@ func (s *Store) RemoveEnvironmentOverrides(cfg *model.Config) *model.Config {
 	return removeEnvOverrides(cfg, s.configNoEnv, s.GetEnvironmentOverrides())
 }
 
+// RestoreSecretReferences returns a copy of the config with the secret references in place of the
+// secrets they were resolved to.
+func (s *Store) RestoreSecretReferences(cfg *model.Config) *model.Config {
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+
+	cfg = cfg.Clone()
+	restoreSecretReferences(cfg, s.secretReferences)
+	return cfg
+}
+
 // SetReadOnlyFF sets whether feature flags should be written out to
 // config or treated as read-only.
 func (s *Store) SetReadOnlyFF(readOnly bool) {
@@ -166,16 +189,36 @@ func (s *Store) SetReadOnlyFF(readOnly bool) {
 // Set replaces the current configuration in its entirety and updates the backing store.
 // It returns both old and new versions of the config.
 func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error) {
-	s.configLock.Lock()
-	defer s.configLock.Unlock()
+	s.writeLock.Lock()
+	oldCfg, newCfgCopy, hasChanged, err := s.set(newCfg)
+	s.writeLock.Unlock()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if s.readOnly {
-		return nil, nil, ErrReadOnlyStore
+	if hasChanged {
+		s.invokeConfigListeners(oldCfg, newCfgCopy.Clone())
 	}
 
-	newCfg = newCfg.Clone()
+	return oldCfg, newCfgCopy, nil
+}
+
+// set replaces the current configuration, returning whether it changed. The secrets are resolved
+// without holding the config lock, as they may be fetched from remote services. The caller must
+// hold the write lock.
+func (s *Store) set(newCfg *model.Config) (*model.Config, *model.Config, bool, error) {
+	s.configLock.RLock()
+	readOnly := s.readOnly
 	oldCfg := s.config.Clone()
 	oldCfgNoEnv := s.configNoEnv
+	oldSecretReferences := s.secretReferences
+	s.configLock.RUnlock()
+
+	if readOnly {
+		return nil, nil, false, ErrReadOnlyStore
+	}
+
+	newCfg = newCfg.Clone()
 
 	// Setting defaults allows us to accept partial config objects.
 	newCfg.SetDefaults()
@@ -184,17 +227,29 @@ func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error)
 	// data from the existing config as necessary.
 	desanitize(oldCfg, newCfg)
 
+	// The input config may have the resolved secrets in place of their references.
+	restoreSecretReferences(newCfg, oldSecretReferences)
+
 	// We apply back environment overrides since the input config may or
 	// may not have them applied.
 	newCfg = applyEnvironmentMap(newCfg, GetEnvironment())
 	fixConfig(newCfg)
 
+	// We attempt to remove any environment override that may be present in the input config.
+	// The secret references are persisted rather than the secrets.
+	newCfgNoEnv := removeEnvOverrides(newCfg, oldCfgNoEnv, s.GetEnvironmentOverrides())
+
+	newCfg, secretReferences, err := resolveSecretReferences(newCfg)
+	if err != nil {
+		return nil, nil, false, errors.Wrap(err, "failed to resolve secrets")
+	}
+
 	if err := newCfg.IsValid(); err != nil {
-		return nil, nil, errors.Wrap(err, "new configuration is invalid")
+		return nil, nil, false, errors.Wrap(err, "new configuration is invalid")
 	}
 
-	// We attempt to remove any environment override that may be present in the input config.
-	newCfgNoEnv := removeEnvOverrides(newCfg, oldCfgNoEnv, s.GetEnvironmentOverrides())
+	s.configLock.Lock()
+	defer s.configLock.Unlock()
 
 	// Don't store feature flags unless we are on MM cloud
 	// MM cloud uses config in the DB as a cache of the feature flag
@@ -211,12 +266,12 @@ func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error)
 	}
 
 	if err := s.backingStore.Set(newCfgNoEnv); err != nil {
-		return nil, nil, errors.Wrap(err, "failed to persist")
+		return nil, nil, false, errors.Wrap(err, "failed to persist")
 	}
 
 	hasChanged, err := equal(oldCfg, newCfg)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "failed to compare configs")
+		return nil, nil, false, errors.Wrap(err, "failed to compare configs")
 	}
 
 	// We restore the previously cleared feature flags sections back.
@@ -228,37 +283,43 @@ func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error)
 
 	s.configNoEnv = newCfgNoEnv
 	s.config = newCfg
+	s.secretReferences = secretReferences
+	s.startSecretsRefresh()
+
+	return oldCfg, newCfg.Clone(), hasChanged, nil
+}
 
-	newCfgCopy := newCfg.Clone()
+// Load updates the current configuration from the backing store, possibly initializing.
+func (s *Store) Load() error {
+	s.writeLock.Lock()
+	oldCfg, loadedCfgCopy, hasChanged, err := s.load()
+	s.writeLock.Unlock()
+	if err != nil {
+		return err
+	}
 
 	if hasChanged {
-		s.configLock.Unlock()
-		s.invokeConfigListeners(oldCfg, newCfgCopy.Clone())
-		s.configLock.Lock()
+		s.invokeConfigListeners(oldCfg, loadedCfgCopy)
 	}
 
-	return oldCfg, newCfgCopy, nil
+	return nil
 }
 
-// Load updates the current configuration from the backing store, possibly initializing.
-func (s *Store) Load() error {
+// loadBackingStore reads the configuration from the backing store, merged on top of the custom
+// defaults on the first load.
+func (s *Store) loadBackingStore() (*model.Config, []byte, error) {
 	s.configLock.Lock()
 	defer s.configLock.Unlock()
 
-	oldCfg := &model.Config{}
-	if s.config != nil {
-		oldCfg = s.config.Clone()
-	}
-
 	configBytes, err := s.backingStore.Load()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	loadedCfg := &model.Config{}
 	if len(configBytes) != 0 {
 		if err = json.Unmarshal(configBytes, &loadedCfg); err != nil {
-			return jsonutils.HumanizeJSONError(err, configBytes)
+			return nil, nil, jsonutils.HumanizeJSONError(err, configBytes)
 		}
 	}
 
@@ -269,11 +330,30 @@ func (s *Store) Load() error {
 		var mErr error
 		loadedCfg, mErr = Merge(s.configCustomDefaults, loadedCfg, nil)
 		if mErr != nil {
-			return errors.Wrap(mErr, "failed to merge custom config defaults")
+			return nil, nil, errors.Wrap(mErr, "failed to merge custom config defaults")
 		}
 		s.configCustomDefaults = nil
 	}
 
+	return loadedCfg, configBytes, nil
+}
+
+// load updates the current configuration from the backing store, returning whether it changed.
+// The secrets are resolved without holding the config lock, as they may be fetched from remote
+// services. The caller must hold the write lock.
+func (s *Store) load() (*model.Config, *model.Config, bool, error) {
+	s.configLock.RLock()
+	oldCfg := &model.Config{}
+	if s.config != nil {
+		oldCfg = s.config.Clone()
+	}
+	s.configLock.RUnlock()
+
+	loadedCfg, configBytes, err := s.loadBackingStore()
+	if err != nil {
+		return nil, nil, false, err
+	}
+
 	// We set the SiteURL to empty (if nil) so that the following call to
 	// SetDefaults() will generate missing data. This avoids an additional write
 	// to the backing store.
@@ -291,13 +371,22 @@ func (s *Store) Load() error {
 
 	loadedCfg = applyEnvironmentMap(loadedCfg, GetEnvironment())
 	fixConfig(loadedCfg)
+
+	loadedCfg, secretReferences, err := resolveSecretReferences(loadedCfg)
+	if err != nil {
+		return nil, nil, false, errors.Wrap(err, "failed to resolve secrets")
+	}
+
 	if appErr := loadedCfg.IsValid(); appErr != nil {
 		// Translating the error before displaying it in the console.
 		// Defaulting to english for server side language.
 		appErr.Translate(i18n.GetUserTranslations("en"))
-		return errors.Wrap(appErr, "invalid config")
+		return nil, nil, false, errors.Wrap(appErr, "invalid config")
 	}
 
+	s.configLock.Lock()
+	defer s.configLock.Unlock()
+
 	// Backing up feature flags section in case we need to restore them later on.
 	oldCfgFF := oldCfg.FeatureFlags
 	loadedCfgFF := loadedCfg.FeatureFlags
@@ -312,7 +401,7 @@ func (s *Store) Load() error {
 	// Check for changes that may have happened on load to the backing store.
 	hasChanged, err := equal(oldCfg, loadedCfg)
 	if err != nil {
-		return errors.Wrap(err, "failed to compare configs")
+		return nil, nil, false, errors.Wrap(err, "failed to compare configs")
 	}
 
 	// We write back to the backing store only if the store is not read-only
@@ -320,7 +409,7 @@ func (s *Store) Load() error {
 	if !s.readOnly && (hasChanged || len(configBytes) == 0) {
 		err := s.backingStore.Set(loadedCfgNoEnv)
 		if err != nil && !errors.Is(err, ErrReadOnlyConfiguration) {
-			return errors.Wrap(err, "failed to persist")
+			return nil, nil, false, errors.Wrap(err, "failed to persist")
 		}
 	}
 
@@ -333,16 +422,10 @@ func (s *Store) Load() error {
 
 	s.config = loadedCfg
 	s.configNoEnv = loadedCfgNoEnv
+	s.secretReferences = secretReferences
+	s.startSecretsRefresh()
 
-	loadedCfgCopy := loadedCfg.Clone()
-
-	if hasChanged {
-		s.configLock.Unlock()
-		s.invokeConfigListeners(oldCfg, loadedCfgCopy)
-		s.configLock.Lock()
-	}
-
-	return nil
+	return oldCfg, loadedCfg.Clone(), hasChanged, nil
 }
 
 // GetFile fetches the contents of a previously persisted configuration file.
@@ -385,8 +468,115 @@ func (s *Store) String() string {
 	return s.backingStore.String()
 }
 
+// RefreshSecrets resolves the secret references of the config again, for the rotated secrets to
+// be used. The config listeners are invoked if any secret changed.
+func (s *Store) RefreshSecrets() error {
+	s.writeLock.Lock()
+	oldCfg, newCfg, err := s.refreshSecrets()
+	s.writeLock.Unlock()
+	if err != nil || newCfg == nil {
+		return err
+	}
+
+	s.invokeConfigListeners(oldCfg, newCfg)
+
+	return nil
+}
+
+// refreshSecrets resolves the secret references of the config again, returning the new config if
+// any secret changed. The secrets are resolved without holding the config lock, as they may be
+// fetched from remote services. The caller must hold the write lock.
+func (s *Store) refreshSecrets() (*model.Config, *model.Config, error) {
+	s.configLock.RLock()
+	oldCfg := s.config.Clone()
+	oldSecretReferences := s.secretReferences
+	s.configLock.RUnlock()
+
+	if len(oldSecretReferences) == 0 {
+		return nil, nil, nil
+	}
+
+	cfg := oldCfg.Clone()
+	restoreSecretReferences(cfg, oldSecretReferences)
+
+	newCfg, secretReferences, err := resolveSecretReferences(cfg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to resolve secrets")
+	}
+
+	hasChanged, err := equal(oldCfg, newCfg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to compare configs")
+	}
+
+	if !hasChanged {
+		return nil, nil, nil
+	}
+
+	if err := newCfg.IsValid(); err != nil {
+		return nil, nil, errors.Wrap(err, "configuration with the refreshed secrets is invalid")
+	}
+
+	s.configLock.Lock()
+	s.config = newCfg
+	s.secretReferences = secretReferences
+	s.configLock.Unlock()
+
+	return oldCfg, newCfg.Clone(), nil
+}
+
+// startSecretsRefresh starts refreshing the secrets in the background the first time the config
+// references any. The caller must hold the config lock.
+func (s *Store) startSecretsRefresh() {
+	if len(s.secretReferences) == 0 {
+		return
+	}
+
+	s.secretsRefreshOnce.Do(func() {
+		go s.refreshSecretsLoop()
+	})
+}
+
+func (s *Store) refreshSecretsLoop() {
+	defer close(s.secretsRefreshDone)
+
+	for {
+		// The interval is read on every iteration for its changes to apply, the refresh being
+		// checked for every minute while it's disabled.
+		interval := time.Minute
+		refresh := false
+		if minutes := *s.Get().SecretsSettings.RefreshIntervalMinutes; minutes > 0 {
+			interval = time.Duration(minutes) * time.Minute
+			refresh = true
+		}
+
+		select {
+		case <-s.secretsRefreshStop:
+			return
+		case <-time.After(interval):
+		}
+
+		if refresh {
+			if err := s.RefreshSecrets(); err != nil {
+				mlog.Warn("Failed to refresh the config secrets", mlog.Err(err))
+			}
+		}
+	}
+}
+
 // Close cleans up resources associated with the store.
 func (s *Store) Close() error {
+	s.secretsRefreshOnce.Do(func() {
+		// The refresh was never started.
+		close(s.secretsRefreshDone)
+	})
+	select {
+	case <-s.secretsRefreshStop:
+	default:
+		close(s.secretsRefreshStop)
+	}
+	<-s.secretsRefreshDone
+
 	s.configLock.Lock()
 	defer s.configLock.Unlock()
 	return s.backingStore.Close()
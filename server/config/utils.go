@@ -21,6 +21,12 @@ func marshalConfig(cfg *model.Config) ([]byte, error) {
 	return json.MarshalIndent(cfg, "", "    ")
 }
 
+// Desanitize replaces the fake settings of the target, as sent back by clients, with their values
+// in the actual config.
+func Desanitize(actual, target *model.Config) {
+	desanitize(actual, target)
+}
+
 // desanitize replaces fake settings with their actual values.
 func desanitize(actual, target *model.Config) {
 	if target.LdapSettings.BindPassword != nil && *target.LdapSettings.BindPassword == model.FakeSetting {
@@ -54,6 +60,10 @@ func desanitize(actual, target *model.Config) {
 		target.OpenIdSettings.Secret = actual.OpenIdSettings.Secret
 	}
 
+	if target.OIDCSettings.Secret != nil && *target.OIDCSettings.Secret == model.FakeSetting {
+		target.OIDCSettings.Secret = actual.OIDCSettings.Secret
+	}
+
 	if *target.SqlSettings.DataSource == model.FakeSetting {
 		*target.SqlSettings.DataSource = *actual.SqlSettings.DataSource
 	}
@@ -92,6 +102,38 @@ func desanitize(actual, target *model.Config) {
 	if *target.ServiceSettings.SplitKey == model.FakeSetting {
 		*target.ServiceSettings.SplitKey = *actual.ServiceSettings.SplitKey
 	}
+
+	if target.TranslationSettings.APIKey != nil && *target.TranslationSettings.APIKey == model.FakeSetting {
+		*target.TranslationSettings.APIKey = *actual.TranslationSettings.APIKey
+	}
+
+	if target.SemanticSearchSettings.EmbeddingAPIKey != nil && *target.SemanticSearchSettings.EmbeddingAPIKey == model.FakeSetting {
+		*target.SemanticSearchSettings.EmbeddingAPIKey = *actual.SemanticSearchSettings.EmbeddingAPIKey
+	}
+
+	if target.IPAccessSettings.EmergencyBypassToken != nil && *target.IPAccessSettings.EmergencyBypassToken == model.FakeSetting {
+		*target.IPAccessSettings.EmergencyBypassToken = *actual.IPAccessSettings.EmergencyBypassToken
+	}
+
+	if target.SecretsSettings.VaultToken != nil && *target.SecretsSettings.VaultToken == model.FakeSetting {
+		*target.SecretsSettings.VaultToken = *actual.SecretsSettings.VaultToken
+	}
+
+	if target.CacheSettings.RedisPassword != nil && *target.CacheSettings.RedisPassword == model.FakeSetting {
+		*target.CacheSettings.RedisPassword = *actual.CacheSettings.RedisPassword
+	}
+
+	if target.ColdStorageSettings.AmazonS3SecretAccessKey != nil && *target.ColdStorageSettings.AmazonS3SecretAccessKey == model.FakeSetting {
+		*target.ColdStorageSettings.AmazonS3SecretAccessKey = *actual.ColdStorageSettings.AmazonS3SecretAccessKey
+	}
+
+	if target.MatrixBridgeSettings.AppServiceToken != nil && *target.MatrixBridgeSettings.AppServiceToken == model.FakeSetting {
+		*target.MatrixBridgeSettings.AppServiceToken = *actual.MatrixBridgeSettings.AppServiceToken
+	}
+
+	if target.MatrixBridgeSettings.HomeserverToken != nil && *target.MatrixBridgeSettings.HomeserverToken == model.FakeSetting {
+		*target.MatrixBridgeSettings.HomeserverToken = *actual.MatrixBridgeSettings.HomeserverToken
+	}
 }
 
 // fixConfig patches invalid or missing data in the configuration.
@@ -8,9 +8,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/tinylib/msgp/msgp"
-	"github.com/vmihailenco/msgpack/v5"
-
 	"github.com/mattermost/mattermost-server/v6/model"
 )
 
@@ -25,6 +22,7 @@ type LRU struct {
 	defaultExpiry          time.Duration
 	name                   string
 	invalidateClusterEvent model.ClusterEvent
+	onEvict                func()
 }
 
 // LRUOptions contains options for initializing LRU cache
@@ -36,6 +34,8 @@ type LRUOptions struct {
 	// StripedBuckets is used only by LRUStriped and shouldn't be greater than the number
 	// of CPUs available on the machine running this cache.
 	StripedBuckets int
+	// OnEvict is called when an item is evicted to make room for a new one.
+	OnEvict func()
 }
 
 // entry is used to hold a value in the evictList.
@@ -55,6 +55,7 @@ func NewLRU(opts LRUOptions) Cache {
 		items:                  make(map[string]*list.Element, opts.Size),
 		defaultExpiry:          opts.DefaultExpiry,
 		invalidateClusterEvent: opts.InvalidateClusterEvent,
+		onEvict:                opts.OnEvict,
 	}
 }
 
@@ -143,15 +144,7 @@ func (l *LRU) set(key string, value any, ttl time.Duration) error {
 		expires = time.Now().Add(ttl)
 	}
 
-	var buf []byte
-	var err error
-	// We use a fast path for hot structs.
-	if msgpVal, ok := value.(msgp.Marshaler); ok {
-		buf, err = msgpVal.MarshalMsg(nil)
-	} else {
-		// Slow path for other structs.
-		buf, err = msgpack.Marshal(value)
-	}
+	buf, err := encode(value)
 	if err != nil {
 		return err
 	}
@@ -174,12 +167,16 @@ func (l *LRU) set(key string, value any, ttl time.Duration) error {
 
 	// Add new item
 	ent := &entry{key, buf, expires, l.currentGeneration}
-	entry := l.evictList.PushFront(ent)
-	l.items[key] = entry
+	l.items[key] = l.evictList.PushFront(ent)
 	l.len++
 
 	if l.evictList.Len() > l.size {
-		l.removeElement(l.evictList.Back())
+		back := l.evictList.Back()
+		// Items left over from a purge are dropped, not evicted.
+		if l.onEvict != nil && back.Value.(*entry).generation == l.currentGeneration {
+			l.onEvict()
+		}
+		l.removeElement(back)
 	}
 	return nil
 }
@@ -190,35 +187,7 @@ func (l *LRU) get(key string, value any) error {
 		return err
 	}
 
-	// We use a fast path for hot structs.
-	if msgpVal, ok := value.(msgp.Unmarshaler); ok {
-		_, err := msgpVal.UnmarshalMsg(val)
-		return err
-	}
-
-	// This is ugly and makes the cache package aware of the model package.
-	// But this is due to 2 things.
-	// 1. The msgp package works on methods on structs rather than functions.
-	// 2. Our cache interface passes pointers to empty pointers, and not pointers
-	// to values. This is mainly how all our model structs are passed around.
-	// It might be technically possible to use values _just_ for hot structs
-	// like these and then return a pointer while returning from the cache function,
-	// but it will make the codebase inconsistent, and has some edge-cases to take care of.
-	switch v := value.(type) {
-	case **model.User:
-		var u model.User
-		_, err := u.UnmarshalMsg(val)
-		*v = &u
-		return err
-	case *map[string]*model.User:
-		var u model.UserMap
-		_, err := u.UnmarshalMsg(val)
-		*v = u
-		return err
-	}
-
-	// Slow path for other structs.
-	return msgpack.Unmarshal(val, value)
+	return decode(val, value)
 }
 
 func (l *LRU) getItem(key string) ([]byte, error) {
@@ -0,0 +1,28 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cache
+
+// Metrics records the hits, misses and evictions of the caches created by a provider.
+type Metrics interface {
+	IncrementCacheHitCounter(cacheName string)
+	IncrementCacheMissCounter(cacheName string)
+	IncrementCacheEvictionCounter(cacheName string)
+}
+
+// instrumentedCache records the hits and misses of the cache it wraps.
+type instrumentedCache struct {
+	Cache
+	metrics Metrics
+}
+
+// Get does the same as Cache.Get, counting a hit or a miss.
+func (c *instrumentedCache) Get(key string, value any) error {
+	err := c.Cache.Get(key, value)
+	if err == nil {
+		c.metrics.IncrementCacheHitCounter(c.Name())
+	} else if err == ErrKeyNotFound {
+		c.metrics.IncrementCacheMissCounter(c.Name())
+	}
+	return err
+}
@@ -13,6 +13,22 @@ type Provider struct {
 	mock.Mock
 }
 
+// Caches provides a mock function with given fields:
+func (_m *Provider) Caches() []cache.Cache {
+	ret := _m.Called()
+
+	var r0 []cache.Cache
+	if rf, ok := ret.Get(0).(func() []cache.Cache); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]cache.Cache)
+		}
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *Provider) Close() error {
 	ret := _m.Called()
@@ -63,3 +79,8 @@ func (_m *Provider) NewCache(opts *cache.CacheOptions) (cache.Cache, error) {
 
 	return r0, r1
 }
+
+// SetMetrics provides a mock function with given fields: metrics
+func (_m *Provider) SetMetrics(metrics cache.Metrics) {
+	_m.Called(metrics)
+}
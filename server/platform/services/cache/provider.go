@@ -4,6 +4,8 @@
 package cache
 
 import (
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -17,6 +19,9 @@ type CacheOptions struct {
 	InvalidateClusterEvent model.ClusterEvent
 	Striped                bool
 	StripedBuckets         int
+	// Shared caches hold the data worth sharing between the nodes of a cluster, served from the
+	// shared cache of the providers having one. The other providers serve them from memory.
+	Shared bool
 }
 
 // Provider is a provider for Cache
@@ -27,9 +32,17 @@ type Provider interface {
 	Connect() error
 	// Close releases any resources used by the cache provider.
 	Close() error
+	// SetMetrics sets the metrics recording the hits, misses and evictions of the named caches
+	// created afterwards.
+	SetMetrics(metrics Metrics)
+	// Caches returns the named caches created by the provider, sorted by name.
+	Caches() []Cache
 }
 
 type cacheProvider struct {
+	mut     sync.RWMutex
+	metrics Metrics
+	caches  map[string]Cache
 }
 
 // NewProvider creates a new CacheProvider
@@ -39,21 +52,27 @@ func NewProvider() Provider {
 
 // NewCache creates a new cache with given opts
 func (c *cacheProvider) NewCache(opts *CacheOptions) (Cache, error) {
-	if opts.Striped {
-		return NewLRUStriped(LRUOptions{
-			Name:                   opts.Name,
-			Size:                   opts.Size,
-			DefaultExpiry:          opts.DefaultExpiry,
-			InvalidateClusterEvent: opts.InvalidateClusterEvent,
-			StripedBuckets:         opts.StripedBuckets,
-		})
-	}
-	return NewLRU(LRUOptions{
+	lruOpts := LRUOptions{
 		Name:                   opts.Name,
 		Size:                   opts.Size,
 		DefaultExpiry:          opts.DefaultExpiry,
 		InvalidateClusterEvent: opts.InvalidateClusterEvent,
-	}), nil
+	}
+	if metrics := c.getMetrics(); metrics != nil && opts.Name != "" {
+		lruOpts.OnEvict = func() {
+			metrics.IncrementCacheEvictionCounter(opts.Name)
+		}
+	}
+
+	if opts.Striped {
+		lruOpts.StripedBuckets = opts.StripedBuckets
+		lru, err := NewLRUStriped(lruOpts)
+		if err != nil {
+			return nil, err
+		}
+		return c.register(lru), nil
+	}
+	return c.register(NewLRU(lruOpts)), nil
 }
 
 // Connect opens a new connection to the cache using specific provider parameters.
@@ -65,3 +84,52 @@ func (c *cacheProvider) Connect() error {
 func (c *cacheProvider) Close() error {
 	return nil
 }
+
+// SetMetrics sets the metrics recording the hits, misses and evictions of the named caches
+// created afterwards.
+func (c *cacheProvider) SetMetrics(metrics Metrics) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.metrics = metrics
+}
+
+func (c *cacheProvider) getMetrics() Metrics {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+	return c.metrics
+}
+
+// Caches returns the named caches created by the provider, sorted by name.
+func (c *cacheProvider) Caches() []Cache {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+
+	caches := make([]Cache, 0, len(c.caches))
+	for _, cache := range c.caches {
+		caches = append(caches, cache)
+	}
+	sort.Slice(caches, func(i, j int) bool {
+		return caches[i].Name() < caches[j].Name()
+	})
+	return caches
+}
+
+// register keeps track of a named cache, recording its hits and misses when the provider has
+// metrics. A cache created again with the same name replaces the previous one.
+func (c *cacheProvider) register(cache Cache) Cache {
+	if cache.Name() == "" {
+		return cache
+	}
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if c.metrics != nil {
+		cache = &instrumentedCache{Cache: cache, metrics: c.metrics}
+	}
+	if c.caches == nil {
+		c.caches = make(map[string]Cache)
+	}
+	c.caches[cache.Name()] = cache
+	return cache
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -185,3 +186,75 @@ func TestConnectClose(t *testing.T) {
 	err = p.Close()
 	require.NoError(t, err)
 }
+
+type testMetrics struct {
+	hits      map[string]int
+	misses    map[string]int
+	evictions map[string]int
+}
+
+func newTestMetrics() *testMetrics {
+	return &testMetrics{
+		hits:      map[string]int{},
+		misses:    map[string]int{},
+		evictions: map[string]int{},
+	}
+}
+
+func (m *testMetrics) IncrementCacheHitCounter(cacheName string)      { m.hits[cacheName]++ }
+func (m *testMetrics) IncrementCacheMissCounter(cacheName string)     { m.misses[cacheName]++ }
+func (m *testMetrics) IncrementCacheEvictionCounter(cacheName string) { m.evictions[cacheName]++ }
+
+func TestProviderMetrics(t *testing.T) {
+	p := NewProvider()
+	metrics := newTestMetrics()
+	p.SetMetrics(metrics)
+
+	c, err := p.NewCache(&CacheOptions{
+		Size: 1,
+		Name: "name",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Set("key1", "val1"))
+	require.NoError(t, c.Set("key2", "val2"))
+
+	var v string
+	require.Equal(t, ErrKeyNotFound, c.Get("key1", &v))
+	require.NoError(t, c.Get("key2", &v))
+	require.Equal(t, "val2", v)
+
+	assert.Equal(t, 1, metrics.hits["name"])
+	assert.Equal(t, 1, metrics.misses["name"])
+	assert.Equal(t, 1, metrics.evictions["name"])
+
+	t.Run("purged items aren't evicted", func(t *testing.T) {
+		require.NoError(t, c.Purge())
+		require.NoError(t, c.Set("key3", "val3"))
+		assert.Equal(t, 1, metrics.evictions["name"])
+	})
+
+	t.Run("unnamed caches aren't instrumented", func(t *testing.T) {
+		unnamed, err := p.NewCache(&CacheOptions{
+			Size: 1,
+		})
+		require.NoError(t, err)
+		require.IsType(t, &LRU{}, unnamed)
+	})
+}
+
+func TestProviderCaches(t *testing.T) {
+	p := NewProvider()
+
+	_, err := p.NewCache(&CacheOptions{Size: 1, Name: "b"})
+	require.NoError(t, err)
+	_, err = p.NewCache(&CacheOptions{Size: 1, Name: "a", Striped: true, StripedBuckets: 1})
+	require.NoError(t, err)
+	_, err = p.NewCache(&CacheOptions{Size: 1})
+	require.NoError(t, err)
+
+	caches := p.Caches()
+	require.Len(t, caches, 2)
+	assert.Equal(t, "a", caches[0].Name())
+	assert.Equal(t, "b", caches[1].Name())
+}
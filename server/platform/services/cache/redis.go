@@ -0,0 +1,212 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const (
+	redisKeyPrefix      = "cache:"
+	redisScanBatchSize  = 1000
+	redisConnectTimeout = 5 * time.Second
+)
+
+// RedisOptions contains the options to connect to Redis.
+type RedisOptions struct {
+	Address  string
+	Password string
+	DB       int
+}
+
+// redisProvider serves the shared caches from Redis, for all the nodes of a cluster to read from
+// and invalidate the same cache, and the other caches from memory.
+type redisProvider struct {
+	*cacheProvider
+	client *redis.Client
+}
+
+// NewRedisProvider creates a new Provider serving the shared caches from Redis.
+func NewRedisProvider(opts RedisOptions) Provider {
+	return &redisProvider{
+		client: redis.NewClient(&redis.Options{
+			Addr:     opts.Address,
+			Password: opts.Password,
+			DB:       opts.DB,
+		}),
+		cacheProvider: &cacheProvider{},
+	}
+}
+
+// NewCache creates a new cache with given opts, in Redis when it's shared.
+func (p *redisProvider) NewCache(opts *CacheOptions) (Cache, error) {
+	if !opts.Shared {
+		return p.cacheProvider.NewCache(opts)
+	}
+
+	if opts.Name == "" {
+		return nil, errors.New("a shared cache must have a name")
+	}
+
+	return p.register(&Redis{
+		name:          opts.Name,
+		client:        p.client,
+		defaultExpiry: opts.DefaultExpiry,
+	}), nil
+}
+
+// Connect checks that Redis can be reached.
+func (p *redisProvider) Connect() error {
+	ctx, cancel := context.WithTimeout(context.Background(), redisConnectTimeout)
+	defer cancel()
+
+	return p.client.Ping(ctx).Err()
+}
+
+// Close closes the connections to Redis.
+func (p *redisProvider) Close() error {
+	return p.client.Close()
+}
+
+// Redis is a cache stored in Redis, under the keys prefixed with its name. It's shared by the nodes
+// of a cluster: an item removed by a node is removed for all of them, without the removal being
+// sent across the cluster.
+type Redis struct {
+	name          string
+	client        *redis.Client
+	defaultExpiry time.Duration
+}
+
+// IsShared returns whether the cache is shared by the nodes of a cluster.
+func IsShared(c Cache) bool {
+	if ic, ok := c.(*instrumentedCache); ok {
+		c = ic.Cache
+	}
+	_, ok := c.(*Redis)
+	return ok
+}
+
+func (r *Redis) key(key string) string {
+	return redisKeyPrefix + r.name + ":" + key
+}
+
+// Purge is used to completely clear the cache.
+func (r *Redis) Purge() error {
+	return r.scan(func(keys []string) error {
+		return r.client.Unlink(context.Background(), keys...).Err()
+	})
+}
+
+// Set adds the given key and value to the store without an expiry. If the key already exists,
+// it will overwrite the previous value.
+func (r *Redis) Set(key string, value any) error {
+	return r.SetWithExpiry(key, value, 0)
+}
+
+// SetWithDefaultExpiry adds the given key and value to the store with the default expiry. If
+// the key already exists, it will overwrite the previous value
+func (r *Redis) SetWithDefaultExpiry(key string, value any) error {
+	return r.SetWithExpiry(key, value, r.defaultExpiry)
+}
+
+// SetWithExpiry adds the given key and value to the cache with the given expiry. If the key
+// already exists, it will overwrite the previous value
+func (r *Redis) SetWithExpiry(key string, value any, ttl time.Duration) error {
+	buf, err := encode(value)
+	if err != nil {
+		return err
+	}
+
+	return r.client.Set(context.Background(), r.key(key), buf, ttl).Err()
+}
+
+// Get the content stored in the cache for the given key, and decode it into the value interface.
+// return ErrKeyNotFound if the key is missing from the cache
+func (r *Redis) Get(key string, value any) error {
+	buf, err := r.client.Get(context.Background(), r.key(key)).Bytes()
+	if err == redis.Nil {
+		return ErrKeyNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return decode(buf, value)
+}
+
+// Remove deletes the value for a key.
+func (r *Redis) Remove(key string) error {
+	return r.client.Del(context.Background(), r.key(key)).Err()
+}
+
+// Keys returns a slice of the keys in the cache.
+func (r *Redis) Keys() ([]string, error) {
+	var keys []string
+	err := r.scan(func(batch []string) error {
+		for _, key := range batch {
+			keys = append(keys, strings.TrimPrefix(key, r.key("")))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// Len returns the number of items in the cache.
+func (r *Redis) Len() (int, error) {
+	var count int
+	err := r.scan(func(keys []string) error {
+		count += len(keys)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetInvalidateClusterEvent returns no event: the cache is shared, an item invalidated by a node
+// is invalidated for all of them.
+func (r *Redis) GetInvalidateClusterEvent() model.ClusterEvent {
+	return model.ClusterEventNone
+}
+
+// Name returns the name of the cache
+func (r *Redis) Name() string {
+	return r.name
+}
+
+// scan calls f with the batches of the keys of the cache, scanned without blocking Redis.
+func (r *Redis) scan(f func(keys []string) error) error {
+	ctx := context.Background()
+
+	var cursor uint64
+	for {
+		keys, next, err := r.client.Scan(ctx, cursor, r.key("*"), redisScanBatchSize).Result()
+		if err != nil {
+			return err
+		}
+
+		if len(keys) > 0 {
+			if err := f(keys); err != nil {
+				return err
+			}
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestRedisProviderNewCache(t *testing.T) {
+	p := NewRedisProvider(RedisOptions{Address: "localhost:6379"})
+	defer p.Close()
+
+	t.Run("not shared caches are kept in memory", func(t *testing.T) {
+		c, err := p.NewCache(&CacheOptions{
+			Size:                   1,
+			Name:                   "name",
+			InvalidateClusterEvent: model.ClusterEventInvalidateCacheForRoles,
+		})
+		require.NoError(t, err)
+		require.IsType(t, &LRU{}, c)
+		assert.Equal(t, model.ClusterEventInvalidateCacheForRoles, c.GetInvalidateClusterEvent())
+	})
+
+	t.Run("shared caches are kept in redis", func(t *testing.T) {
+		c, err := p.NewCache(&CacheOptions{
+			Size:                   1,
+			Name:                   "name",
+			InvalidateClusterEvent: model.ClusterEventInvalidateCacheForProfileByIds,
+			Striped:                true,
+			Shared:                 true,
+		})
+		require.NoError(t, err)
+		require.IsType(t, &Redis{}, c)
+		assert.Equal(t, "name", c.Name())
+		assert.Equal(t, model.ClusterEventNone, c.GetInvalidateClusterEvent())
+		assert.Equal(t, "cache:name:key", c.(*Redis).key("key"))
+	})
+
+	t.Run("shared caches must have a name", func(t *testing.T) {
+		_, err := p.NewCache(&CacheOptions{
+			Size:   1,
+			Shared: true,
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestCodec(t *testing.T) {
+	user := &model.User{Id: model.NewId(), Username: "username"}
+
+	buf, err := encode(user)
+	require.NoError(t, err)
+
+	var decoded *model.User
+	require.NoError(t, decode(buf, &decoded))
+	assert.Equal(t, user.Id, decoded.Id)
+	assert.Equal(t, user.Username, decoded.Username)
+
+	buf, err = encode(map[string]string{"key": "value"})
+	require.NoError(t, err)
+
+	var m map[string]string
+	require.NoError(t, decode(buf, &m))
+	assert.Equal(t, map[string]string{"key": "value"}, m)
+}
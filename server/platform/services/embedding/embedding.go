@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package embedding implements clients for the services computing the embeddings posts are
+// searched by meaning with.
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// maxErrorBodySize is the number of bytes of an error response that are included in the returned error.
+const maxErrorBodySize = 512
+
+// A Provider computes embeddings through an embedding service.
+type Provider interface {
+	// Name returns the identifier of the provider, as used in the SemanticSearchSettings.
+	Name() string
+
+	// Model returns the name of the model computing the embeddings.
+	Model() string
+
+	// Embed returns the embeddings of texts, in the same order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// NewProvider returns the provider configured by settings, sending requests through client.
+func NewProvider(settings model.SemanticSearchSettings, client *http.Client) (Provider, error) {
+	apiURL := strings.TrimSuffix(*settings.EmbeddingAPIURL, "/")
+
+	switch *settings.EmbeddingProvider {
+	case model.EmbeddingProviderOpenAI:
+		if apiURL == "" {
+			apiURL = openAIDefaultAPIURL
+		}
+		return &openAIProvider{client: client, apiURL: apiURL, apiKey: *settings.EmbeddingAPIKey, model: *settings.EmbeddingModel}, nil
+	case model.EmbeddingProviderOllama:
+		if apiURL == "" {
+			return nil, errors.New("an API URL is required for Ollama")
+		}
+		return &ollamaProvider{client: client, apiURL: apiURL, model: *settings.EmbeddingModel}, nil
+	default:
+		return nil, errors.Errorf("unknown embedding provider %q", *settings.EmbeddingProvider)
+	}
+}
+
+// checkEmbeddings checks that the provider returned one embedding per text.
+func checkEmbeddings(texts []string, embeddings [][]float32) error {
+	if len(embeddings) != len(texts) {
+		return errors.Errorf("embedding request returned %d embeddings for %d texts", len(embeddings), len(texts))
+	}
+	for _, embedding := range embeddings {
+		if len(embedding) == 0 {
+			return errors.New("embedding request returned an empty embedding")
+		}
+	}
+	return nil
+}
+
+// doJSONRequest sends body encoded as JSON to url and decodes the JSON response into v.
+func doJSONRequest(ctx context.Context, client *http.Client, url string, header http.Header, body, v any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode embedding request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err, "failed to create embedding request")
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send embedding request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return errors.Errorf("embedding request failed with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return errors.Wrap(err, "failed to decode embedding response")
+	}
+
+	return nil
+}
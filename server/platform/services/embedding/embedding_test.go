@@ -0,0 +1,118 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package embedding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func makeSettings(provider, apiURL, apiKey string) model.SemanticSearchSettings {
+	settings := model.SemanticSearchSettings{
+		EnableIndexing:    model.NewBool(true),
+		EmbeddingProvider: model.NewString(provider),
+		EmbeddingAPIURL:   model.NewString(apiURL),
+		EmbeddingAPIKey:   model.NewString(apiKey),
+	}
+	settings.SetDefaults()
+	return settings
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Run("Ollama requires an API URL", func(t *testing.T) {
+		_, err := NewProvider(makeSettings(model.EmbeddingProviderOllama, "", ""), http.DefaultClient)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		_, err := NewProvider(makeSettings("unknown", "", ""), http.DefaultClient)
+		require.Error(t, err)
+	})
+
+	t.Run("OpenAI API URL defaults to the global endpoint", func(t *testing.T) {
+		provider, err := NewProvider(makeSettings(model.EmbeddingProviderOpenAI, "", "key"), http.DefaultClient)
+		require.NoError(t, err)
+		assert.Equal(t, openAIDefaultAPIURL, provider.(*openAIProvider).apiURL)
+		assert.Equal(t, model.SemanticSearchSettingsDefaultEmbeddingModel, provider.Model())
+	})
+
+	t.Run("configured API URL takes precedence", func(t *testing.T) {
+		provider, err := NewProvider(makeSettings(model.EmbeddingProviderOpenAI, "http://localhost:8080/v1/", ""), http.DefaultClient)
+		require.NoError(t, err)
+		assert.Equal(t, "http://localhost:8080/v1", provider.(*openAIProvider).apiURL)
+	})
+}
+
+func TestOpenAI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/embeddings", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var req openAIRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, model.SemanticSearchSettingsDefaultEmbeddingModel, req.Model)
+		assert.Equal(t, []string{"hello", "world"}, req.Input)
+
+		w.Write([]byte(`{"data": [{"index": 1, "embedding": [0.3, 0.4]}, {"index": 0, "embedding": [0.1, 0.2]}]}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(makeSettings(model.EmbeddingProviderOpenAI, server.URL, "secret"), server.Client())
+	require.NoError(t, err)
+
+	embeddings, err := provider.Embed(context.Background(), []string{"hello", "world"})
+	require.NoError(t, err)
+	assert.Equal(t, [][]float32{{0.1, 0.2}, {0.3, 0.4}}, embeddings)
+}
+
+func TestOllama(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/embed", r.URL.Path)
+
+		var req ollamaRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "nomic-embed-text", req.Model)
+
+		w.Write([]byte(`{"model": "nomic-embed-text", "embeddings": [[0.1, 0.2]]}`))
+	}))
+	defer server.Close()
+
+	settings := makeSettings(model.EmbeddingProviderOllama, server.URL, "")
+	settings.EmbeddingModel = model.NewString("nomic-embed-text")
+	provider, err := NewProvider(settings, server.Client())
+	require.NoError(t, err)
+
+	embeddings, err := provider.Embed(context.Background(), []string{"hello"})
+	require.NoError(t, err)
+	assert.Equal(t, [][]float32{{0.1, 0.2}}, embeddings)
+
+	t.Run("missing embeddings", func(t *testing.T) {
+		_, err := provider.Embed(context.Background(), []string{"hello", "world"})
+		require.Error(t, err)
+	})
+}
+
+func TestRequestError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error": {"message": "Incorrect API key provided"}}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(makeSettings(model.EmbeddingProviderOpenAI, server.URL, "wrong"), server.Client())
+	require.NoError(t, err)
+
+	_, err = provider.Embed(context.Background(), []string{"hello"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+	assert.Contains(t, err.Error(), "Incorrect API key provided")
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package embedding
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// ollamaProvider computes embeddings through an Ollama instance, usually self-hosted.
+// See https://github.com/ollama/ollama/blob/main/docs/api.md#generate-embeddings.
+type ollamaProvider struct {
+	client *http.Client
+	apiURL string
+	model  string
+}
+
+type ollamaRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type ollamaResponse struct {
+	Embeddings [][]float32 `json:"embeddings"`
+}
+
+func (p *ollamaProvider) Name() string {
+	return model.EmbeddingProviderOllama
+}
+
+func (p *ollamaProvider) Model() string {
+	return p.model
+}
+
+func (p *ollamaProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	var resp ollamaResponse
+	if err := doJSONRequest(ctx, p.client, p.apiURL+"/api/embed", nil, ollamaRequest{Model: p.model, Input: texts}, &resp); err != nil {
+		return nil, err
+	}
+
+	if err := checkEmbeddings(texts, resp.Embeddings); err != nil {
+		return nil, err
+	}
+	return resp.Embeddings, nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package embedding
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const openAIDefaultAPIURL = "https://api.openai.com/v1"
+
+// openAIProvider computes embeddings through the OpenAI API, or any service compatible with it.
+// See https://platform.openai.com/docs/api-reference/embeddings.
+type openAIProvider struct {
+	client *http.Client
+	apiURL string
+	apiKey string
+	model  string
+}
+
+type openAIRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type openAIResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (p *openAIProvider) Name() string {
+	return model.EmbeddingProviderOpenAI
+}
+
+func (p *openAIProvider) Model() string {
+	return p.model
+}
+
+func (p *openAIProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	header := http.Header{}
+	if p.apiKey != "" {
+		header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	var resp openAIResponse
+	if err := doJSONRequest(ctx, p.client, p.apiURL+"/embeddings", header, openAIRequest{Model: p.model, Input: texts}, &resp); err != nil {
+		return nil, err
+	}
+
+	// The embeddings are identified by the index of their text, rather than by their order
+	sort.Slice(resp.Data, func(i, j int) bool {
+		return resp.Data[i].Index < resp.Data[j].Index
+	})
+
+	embeddings := make([][]float32, 0, len(resp.Data))
+	for _, data := range resp.Data {
+		embeddings = append(embeddings, data.Embedding)
+	}
+
+	if err := checkEmbeddings(texts, embeddings); err != nil {
+		return nil, err
+	}
+	return embeddings, nil
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package matrix implements the parts of the Matrix Application Service and Client-Server APIs
+// used to bridge channels to Matrix rooms. See https://spec.matrix.org/v1.8/application-service-api.
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxErrorBodySize is the number of bytes of an error response that are included in the returned error.
+const maxErrorBodySize = 512
+
+const errCodeUserInUse = "M_USER_IN_USE"
+
+// Client sends requests to a homeserver on behalf of the application service, impersonating the
+// users in its namespace through the user_id query parameter.
+type Client struct {
+	client        *http.Client
+	homeserverURL string
+	asToken       string
+}
+
+// Error is an error response of the homeserver.
+type Error struct {
+	StatusCode int    `json:"-"`
+	ErrCode    string `json:"errcode"`
+	Message    string `json:"error"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("matrix request failed with status code %d: %s %s", e.StatusCode, e.ErrCode, e.Message)
+}
+
+// NewClient returns a client for the homeserver at homeserverURL, authenticating with the
+// application service token asToken.
+func NewClient(homeserverURL, asToken string, client *http.Client) *Client {
+	return &Client{
+		client:        client,
+		homeserverURL: strings.TrimSuffix(homeserverURL, "/"),
+		asToken:       asToken,
+	}
+}
+
+// RegisterUser registers the user with the localpart in the namespace of the application service.
+// Registering a user that already exists isn't an error.
+func (c *Client) RegisterUser(ctx context.Context, localpart string) error {
+	body := map[string]string{
+		"type":     "m.login.application_service",
+		"username": localpart,
+	}
+	err := c.doJSON(ctx, http.MethodPost, "/_matrix/client/v3/register", "", body, nil)
+	var matrixErr *Error
+	if errors.As(err, &matrixErr) && matrixErr.ErrCode == errCodeUserInUse {
+		return nil
+	}
+	return err
+}
+
+// SetDisplayName sets the display name of the user.
+func (c *Client) SetDisplayName(ctx context.Context, userID, displayName string) error {
+	body := map[string]string{"displayname": displayName}
+	return c.doJSON(ctx, http.MethodPut, "/_matrix/client/v3/profile/"+url.PathEscape(userID)+"/displayname", userID, body, nil)
+}
+
+// JoinRoom joins the user to the room.
+func (c *Client) JoinRoom(ctx context.Context, roomID, userID string) error {
+	return c.doJSON(ctx, http.MethodPost, "/_matrix/client/v3/rooms/"+url.PathEscape(roomID)+"/join", userID, struct{}{}, nil)
+}
+
+// SendMessage sends a m.room.message event to the room as the user, returning the id of the event.
+// The transaction id makes retries of the same request idempotent.
+func (c *Client) SendMessage(ctx context.Context, roomID, userID, txnID string, content *MessageContent) (string, error) {
+	var resp struct {
+		EventID string `json:"event_id"`
+	}
+	path := "/_matrix/client/v3/rooms/" + url.PathEscape(roomID) + "/send/" + EventTypeMessage + "/" + url.PathEscape(txnID)
+	if err := c.doJSON(ctx, http.MethodPut, path, userID, content, &resp); err != nil {
+		return "", err
+	}
+	return resp.EventID, nil
+}
+
+// Redact redacts the event as the user.
+func (c *Client) Redact(ctx context.Context, roomID, eventID, userID, txnID string) error {
+	path := "/_matrix/client/v3/rooms/" + url.PathEscape(roomID) + "/redact/" + url.PathEscape(eventID) + "/" + url.PathEscape(txnID)
+	return c.doJSON(ctx, http.MethodPut, path, userID, struct{}{}, nil)
+}
+
+// Upload uploads media to the content repository of the homeserver, returning its mxc:// URI.
+func (c *Client) Upload(ctx context.Context, userID, name, contentType string, data io.Reader) (string, error) {
+	query := url.Values{}
+	query.Set("filename", name)
+	req, err := c.newRequest(ctx, http.MethodPost, "/_matrix/media/v3/upload", userID, query, data)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	var resp struct {
+		ContentURI string `json:"content_uri"`
+	}
+	if err := c.do(req, &resp); err != nil {
+		return "", err
+	}
+	return resp.ContentURI, nil
+}
+
+// Download downloads the media of a mxc:// URI. The caller must close the returned body.
+func (c *Client) Download(ctx context.Context, mxcURI string) (io.ReadCloser, string, error) {
+	serverName, mediaID, ok := strings.Cut(strings.TrimPrefix(mxcURI, "mxc://"), "/")
+	if !ok || !strings.HasPrefix(mxcURI, "mxc://") || serverName == "" || mediaID == "" {
+		return nil, "", errors.Errorf("invalid content URI %q", mxcURI)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, "/_matrix/media/v3/download/"+url.PathEscape(serverName)+"/"+url.PathEscape(mediaID), "", nil, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to send matrix request")
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, "", readError(resp)
+	}
+
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
+
+func (c *Client) newRequest(ctx context.Context, method, path, userID string, query url.Values, body io.Reader) (*http.Request, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	if userID != "" {
+		query.Set("user_id", userID)
+	}
+	reqURL := c.homeserverURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create matrix request")
+	}
+	req.Header.Set("Authorization", "Bearer "+c.asToken)
+
+	return req, nil
+}
+
+// doJSON sends body encoded as JSON as the user and decodes the JSON response into v, if not nil.
+func (c *Client) doJSON(ctx context.Context, method, path, userID string, body, v any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode matrix request")
+	}
+
+	req, err := c.newRequest(ctx, method, path, userID, nil, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.do(req, v)
+}
+
+func (c *Client) do(req *http.Request, v any) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send matrix request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return readError(resp)
+	}
+
+	if v == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return errors.Wrap(err, "failed to decode matrix response")
+	}
+
+	return nil
+}
+
+func readError(resp *http.Response) error {
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	matrixErr := &Error{StatusCode: resp.StatusCode}
+	if err := json.Unmarshal(respBody, matrixErr); err != nil || matrixErr.ErrCode == "" {
+		matrixErr.ErrCode = "M_UNKNOWN"
+		matrixErr.Message = strings.TrimSpace(string(respBody))
+	}
+	return matrixErr
+}
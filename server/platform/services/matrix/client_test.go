@@ -0,0 +1,115 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("register ignores existing users", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/_matrix/client/v3/register", r.URL.Path)
+			assert.Equal(t, "Bearer as_token", r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errcode":"M_USER_IN_USE","error":"User ID already taken."}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL+"/", "as_token", server.Client())
+		require.NoError(t, client.RegisterUser(context.Background(), "mattermost_alice"))
+	})
+
+	t.Run("register fails on other errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errcode":"M_EXCLUSIVE","error":"User ID is not in the namespace."}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, "as_token", server.Client())
+		err := client.RegisterUser(context.Background(), "alice")
+		var matrixErr *Error
+		require.ErrorAs(t, err, &matrixErr)
+		assert.Equal(t, http.StatusForbidden, matrixErr.StatusCode)
+		assert.Equal(t, "M_EXCLUSIVE", matrixErr.ErrCode)
+	})
+
+	t.Run("send message as a user", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/_matrix/client/v3/rooms/!room:example.com/send/m.room.message/txn1", r.URL.Path)
+			assert.Equal(t, "@mattermost_alice:example.com", r.URL.Query().Get("user_id"))
+
+			var content MessageContent
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&content))
+			assert.Equal(t, MsgTypeText, content.MsgType)
+			assert.Equal(t, "hello", content.Body)
+
+			w.Write([]byte(`{"event_id":"$event"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, "as_token", server.Client())
+		eventID, err := client.SendMessage(context.Background(), "!room:example.com", "@mattermost_alice:example.com", "txn1", &MessageContent{MsgType: MsgTypeText, Body: "hello"})
+		require.NoError(t, err)
+		assert.Equal(t, "$event", eventID)
+	})
+
+	t.Run("upload and download media", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/_matrix/media/v3/upload":
+				assert.Equal(t, "image.png", r.URL.Query().Get("filename"))
+				assert.Equal(t, "image/png", r.Header.Get("Content-Type"))
+				data, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "data", string(data))
+				w.Write([]byte(`{"content_uri":"mxc://example.com/media"}`))
+			case "/_matrix/media/v3/download/example.com/media":
+				w.Header().Set("Content-Type", "image/png")
+				w.Write([]byte("data"))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, "as_token", server.Client())
+		uri, err := client.Upload(context.Background(), "@mattermost_alice:example.com", "image.png", "image/png", strings.NewReader("data"))
+		require.NoError(t, err)
+		assert.Equal(t, "mxc://example.com/media", uri)
+
+		body, contentType, err := client.Download(context.Background(), uri)
+		require.NoError(t, err)
+		defer body.Close()
+		data, _ := io.ReadAll(body)
+		assert.Equal(t, "data", string(data))
+		assert.Equal(t, "image/png", contentType)
+
+		_, _, err = client.Download(context.Background(), "https://example.com/media")
+		require.Error(t, err)
+	})
+}
+
+func TestIDs(t *testing.T) {
+	assert.Equal(t, "@mattermost_alice:example.com", UserID("mattermost_alice", "example.com"))
+
+	localpart, ok := Localpart("@mattermost_alice:example.com", "example.com")
+	assert.True(t, ok)
+	assert.Equal(t, "mattermost_alice", localpart)
+
+	_, ok = Localpart("@alice:matrix.org", "example.com")
+	assert.False(t, ok)
+	_, ok = Localpart("alice", "example.com")
+	assert.False(t, ok)
+}
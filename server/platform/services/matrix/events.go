@@ -0,0 +1,88 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package matrix
+
+import (
+	"strings"
+)
+
+const (
+	EventTypeMessage   = "m.room.message"
+	EventTypeRedaction = "m.room.redaction"
+
+	MsgTypeText   = "m.text"
+	MsgTypeNotice = "m.notice"
+	MsgTypeEmote  = "m.emote"
+	MsgTypeImage  = "m.image"
+	MsgTypeFile   = "m.file"
+	MsgTypeVideo  = "m.video"
+	MsgTypeAudio  = "m.audio"
+
+	RelTypeReplace = "m.replace"
+	RelTypeThread  = "m.thread"
+)
+
+// Transaction is a batch of events pushed by the homeserver to the application service.
+type Transaction struct {
+	Events []*Event `json:"events"`
+}
+
+// Event is a room event. Only the fields used by the bridge are decoded.
+type Event struct {
+	EventID string `json:"event_id"`
+	Type    string `json:"type"`
+	RoomID  string `json:"room_id"`
+	Sender  string `json:"sender"`
+	// The event redacted by a m.room.redaction event.
+	Redacts string         `json:"redacts,omitempty"`
+	Content MessageContent `json:"content"`
+}
+
+// MessageContent is the content of a m.room.message event.
+type MessageContent struct {
+	MsgType string `json:"msgtype,omitempty"`
+	Body    string `json:"body"`
+	// The mxc:// URI of the media of m.image, m.file, m.video and m.audio messages.
+	URL  string    `json:"url,omitempty"`
+	Info *FileInfo `json:"info,omitempty"`
+	// The replaced content of an edit, sent along with a fallback body.
+	NewContent *MessageContent `json:"m.new_content,omitempty"`
+	RelatesTo  *RelatesTo      `json:"m.relates_to,omitempty"`
+	// Redactions sent by room versions 11 and later carry the redacted event in their content.
+	Redacts string `json:"redacts,omitempty"`
+}
+
+// FileInfo describes the media of a message.
+type FileInfo struct {
+	MimeType string `json:"mimetype,omitempty"`
+	Size     int64  `json:"size,omitempty"`
+}
+
+// RelatesTo relates an event to another one, as an edit or a thread reply.
+type RelatesTo struct {
+	RelType string `json:"rel_type,omitempty"`
+	EventID string `json:"event_id,omitempty"`
+}
+
+// RedactedEventID returns the event redacted by a m.room.redaction event.
+func (e *Event) RedactedEventID() string {
+	if e.Redacts != "" {
+		return e.Redacts
+	}
+	return e.Content.Redacts
+}
+
+// UserID returns the Matrix user id of the localpart on the homeserver domain.
+func UserID(localpart, domain string) string {
+	return "@" + localpart + ":" + domain
+}
+
+// Localpart returns the localpart of a Matrix user id, and whether it belongs to the domain.
+func Localpart(userID, domain string) (string, bool) {
+	localpart, userDomain, ok := strings.Cut(strings.TrimPrefix(userID, "@"), ":")
+	if !ok || !strings.HasPrefix(userID, "@") {
+		return "", false
+	}
+	return localpart, userDomain == domain
+}
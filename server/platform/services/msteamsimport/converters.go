@@ -0,0 +1,96 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package msteamsimport
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// msReactionEmojis maps the reactions available in Microsoft Teams to emoji names.
+var msReactionEmojis = map[string]string{
+	"like":      "+1",
+	"heart":     "heart",
+	"laugh":     "laughing",
+	"surprised": "open_mouth",
+	"sad":       "cry",
+	"angry":     "angry",
+}
+
+var (
+	msMentionRegex     = regexp.MustCompile(`(?s)<at id="(\d+)">(.*?)</at>`)
+	msLinkRegex        = regexp.MustCompile(`(?s)<a [^>]*href="([^"]*)"[^>]*>(.*?)</a>`)
+	msLineBreakRegex   = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|</li>`)
+	msBoldRegex        = regexp.MustCompile(`(?i)</?(b|strong)>`)
+	msItalicRegex      = regexp.MustCompile(`(?i)</?(i|em)>`)
+	msCodeRegex        = regexp.MustCompile(`(?i)</?code>`)
+	msTagRegex         = regexp.MustCompile(`<[^>]*>`)
+	msBlankLinesRegex  = regexp.MustCompile(`\n{3,}`)
+	msInvalidNameRegex = regexp.MustCompile(`[^a-z0-9_-]+`)
+)
+
+// convertBody converts the body of the message to Markdown, replacing the mentions of known users
+// with their usernames.
+func (c *converter) convertBody(message msMessage) string {
+	if message.Body.ContentType != "html" {
+		return strings.TrimSpace(message.Body.Content)
+	}
+
+	text := msMentionRegex.ReplaceAllStringFunc(message.Body.Content, func(match string) string {
+		groups := msMentionRegex.FindStringSubmatch(match)
+		id, _ := strconv.Atoi(groups[1])
+		for _, mention := range message.Mentions {
+			if mention.Id != id || mention.Mentioned.User == nil {
+				continue
+			}
+			if username, ok := c.usernames[mention.Mentioned.User.Id]; ok {
+				return "@" + username
+			}
+		}
+		return groups[2]
+	})
+	text = msLinkRegex.ReplaceAllString(text, "[$2]($1)")
+	text = msLineBreakRegex.ReplaceAllString(text, "\n")
+	text = msBoldRegex.ReplaceAllString(text, "**")
+	text = msItalicRegex.ReplaceAllString(text, "_")
+	text = msCodeRegex.ReplaceAllString(text, "`")
+	text = msTagRegex.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+	text = strings.ReplaceAll(text, "\u00a0", " ")
+	text = msBlankLinesRegex.ReplaceAllString(text, "\n\n")
+	return strings.TrimSpace(text)
+}
+
+// msConvertChannelName derives a channel name from the display name of a Microsoft Teams channel,
+// falling back to a random name for display names without any usable character.
+func msConvertChannelName(displayName string) string {
+	name := msInvalidNameRegex.ReplaceAllString(strings.ToLower(displayName), "-")
+	name = strings.Trim(truncate(name, model.ChannelNameMaxLength-3), "-_")
+	if !model.IsValidChannelIdentifier(name) {
+		return model.NewId()
+	}
+	return name
+}
+
+// uniqueName returns name, suffixed with a number if it is already taken, and marks it as taken.
+func uniqueName(name string, taken map[string]bool) string {
+	unique := name
+	for i := 2; taken[unique]; i++ {
+		unique = name + "-" + strconv.Itoa(i)
+	}
+	taken[unique] = true
+	return unique
+}
+
+func truncate(s string, maxRunes int) string {
+	runes := []rune(s)
+	if len(runes) <= maxRunes {
+		return s
+	}
+	return string(runes[:maxRunes])
+}
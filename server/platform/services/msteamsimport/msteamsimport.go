@@ -0,0 +1,488 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package msteamsimport converts Microsoft Teams exports into the bulk import format.
+//
+// The export is expected to be a zip archive of the JSON returned by the Microsoft Graph API, laid
+// out as follows. Every file holds either a JSON array or a Graph API page with the array in its
+// "value" field.
+//
+//	users.json                                           the users of the tenant
+//	teams.json                                           the teams
+//	teams/<team id>/members.json                         the members of a team
+//	teams/<team id>/channels.json                        the channels of a team
+//	teams/<team id>/channels/<channel id>/members.json   the members of a private channel
+//	teams/<team id>/channels/<channel id>/messages.json  the messages of a channel, replies included
+//
+// File attachments are stored in SharePoint rather than in the export, so they aren't imported.
+package msteamsimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/imports"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+// Mapping configures how the entities of the export are imported. The maps are keyed by the
+// Microsoft Teams id of the entity and default to names derived from the exported data.
+type Mapping struct {
+	// Users maps users to the usernames they are imported as, allowing to merge them with
+	// existing users.
+	Users map[string]string `json:"users"`
+	// Teams maps teams to the names of the teams they are imported into.
+	Teams map[string]string `json:"teams"`
+	// Channels maps channels to the names of the channels they are imported into.
+	Channels map[string]string `json:"channels"`
+	// AuthService, if set, makes the imported users log in through that service, using their
+	// Azure AD object id as their auth data. This is what the office365 service expects.
+	AuthService string `json:"auth_service"`
+}
+
+type msUser struct {
+	Id                string `json:"id"`
+	DisplayName       string `json:"displayName"`
+	GivenName         string `json:"givenName"`
+	Surname           string `json:"surname"`
+	JobTitle          string `json:"jobTitle"`
+	Mail              string `json:"mail"`
+	UserPrincipalName string `json:"userPrincipalName"`
+}
+
+type msTeam struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+}
+
+type msChannel struct {
+	Id             string `json:"id"`
+	DisplayName    string `json:"displayName"`
+	Description    string `json:"description"`
+	MembershipType string `json:"membershipType"`
+}
+
+type msMember struct {
+	UserId string   `json:"userId"`
+	Roles  []string `json:"roles"`
+}
+
+type msIdentity struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type msIdentitySet struct {
+	User *msIdentity `json:"user"`
+}
+
+type msItemBody struct {
+	ContentType string `json:"contentType"`
+	Content     string `json:"content"`
+}
+
+type msMention struct {
+	Id          int           `json:"id"`
+	MentionText string        `json:"mentionText"`
+	Mentioned   msIdentitySet `json:"mentioned"`
+}
+
+type msReaction struct {
+	ReactionType    string        `json:"reactionType"`
+	CreatedDateTime time.Time     `json:"createdDateTime"`
+	User            msIdentitySet `json:"user"`
+}
+
+type msMessage struct {
+	Id                   string         `json:"id"`
+	ReplyToId            string         `json:"replyToId"`
+	MessageType          string         `json:"messageType"`
+	CreatedDateTime      time.Time      `json:"createdDateTime"`
+	LastModifiedDateTime *time.Time     `json:"lastModifiedDateTime"`
+	DeletedDateTime      *time.Time     `json:"deletedDateTime"`
+	From                 *msIdentitySet `json:"from"`
+	Body                 msItemBody     `json:"body"`
+	Mentions             []msMention    `json:"mentions"`
+	Reactions            []msReaction   `json:"reactions"`
+}
+
+// converter holds the names the entities of the export are imported as.
+type converter struct {
+	files     map[string]*zip.File
+	mapping   *Mapping
+	usernames map[string]string // keyed by Microsoft Teams user id
+}
+
+// Convert reads the Microsoft Teams export archive and writes the bulk import data for it to w,
+// applying the mapping, which may be nil.
+func Convert(archive *zip.Reader, mapping *Mapping, w io.Writer) error {
+	if mapping == nil {
+		mapping = &Mapping{}
+	}
+	c := &converter{
+		files:     make(map[string]*zip.File, len(archive.File)),
+		mapping:   mapping,
+		usernames: make(map[string]string),
+	}
+	for _, file := range archive.File {
+		c.files[file.Name] = file
+	}
+
+	var users []msUser
+	if err := c.decode("users.json", &users); err != nil {
+		return err
+	}
+	var teams []msTeam
+	if err := c.decode("teams.json", &teams); err != nil {
+		return err
+	}
+
+	userLines := c.convertUsers(users)
+
+	encoder := json.NewEncoder(w)
+	version := 1
+	if err := encoder.Encode(&imports.LineImportData{Type: "version", Version: &version}); err != nil {
+		return err
+	}
+
+	teamNames := make(map[string]bool, len(teams))
+	var channelLines []imports.LineImportData
+	var postLines []imports.LineImportData
+	for _, team := range teams {
+		teamName := uniqueName(c.teamName(team), teamNames)
+		teamType := model.TeamInvite
+		if team.Visibility == "public" {
+			teamType = model.TeamOpen
+		}
+		if err := encoder.Encode(&imports.LineImportData{
+			Type: "team",
+			Team: &imports.TeamImportData{
+				Name:        model.NewString(teamName),
+				DisplayName: model.NewString(truncate(team.DisplayName, model.TeamDisplayNameMaxRunes)),
+				Type:        model.NewString(teamType),
+				Description: model.NewString(truncate(team.Description, model.TeamDescriptionMaxLength)),
+			},
+		}); err != nil {
+			return err
+		}
+
+		channels, posts, err := c.convertTeam(team, teamName, userLines)
+		if err != nil {
+			return err
+		}
+		channelLines = append(channelLines, channels...)
+		postLines = append(postLines, posts...)
+	}
+
+	for _, lines := range [][]imports.LineImportData{channelLines, userLinesOf(users, userLines), postLines} {
+		for i := range lines {
+			if err := encoder.Encode(&lines[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// convertUsers returns the user lines keyed by Microsoft Teams user id, skipping the users without
+// an email address.
+func (c *converter) convertUsers(users []msUser) map[string]*imports.UserImportData {
+	lines := make(map[string]*imports.UserImportData, len(users))
+	taken := make(map[string]bool, len(users))
+	for _, username := range c.mapping.Users {
+		taken[username] = true
+	}
+	for _, user := range users {
+		email := user.Mail
+		if email == "" {
+			email = user.UserPrincipalName
+		}
+		if email == "" {
+			mlog.Warn("Microsoft Teams Import: Skipping a user without an email address.", mlog.String("user_id", user.Id))
+			continue
+		}
+
+		username, ok := c.mapping.Users[user.Id]
+		if !ok {
+			local, _, _ := strings.Cut(email, "@")
+			username = uniqueName(truncate(model.CleanUsername(local), model.UserNameMaxLength-3), taken)
+		}
+		c.usernames[user.Id] = username
+
+		line := &imports.UserImportData{
+			Username:  model.NewString(username),
+			Email:     model.NewString(strings.ToLower(email)),
+			FirstName: model.NewString(truncate(user.GivenName, model.UserFirstNameMaxRunes)),
+			LastName:  model.NewString(truncate(user.Surname, model.UserLastNameMaxRunes)),
+			Position:  model.NewString(truncate(user.JobTitle, model.UserPositionMaxRunes)),
+			Teams:     &[]imports.UserTeamImportData{},
+		}
+		if c.mapping.AuthService != "" {
+			line.AuthService = model.NewString(c.mapping.AuthService)
+			line.AuthData = model.NewString(user.Id)
+		}
+		lines[user.Id] = line
+	}
+	return lines
+}
+
+// convertTeam returns the channel and post lines of the team, adding the team and channel
+// memberships to the user lines.
+func (c *converter) convertTeam(team msTeam, teamName string, userLines map[string]*imports.UserImportData) ([]imports.LineImportData, []imports.LineImportData, error) {
+	teamDir := path.Join("teams", team.Id)
+
+	var members []msMember
+	if err := c.decode(path.Join(teamDir, "members.json"), &members); err != nil {
+		return nil, nil, err
+	}
+	var channels []msChannel
+	if err := c.decode(path.Join(teamDir, "channels.json"), &channels); err != nil {
+		return nil, nil, err
+	}
+
+	// Team members belong to all the standard channels.
+	memberships := make(map[string]*imports.UserTeamImportData, len(members))
+	for _, member := range members {
+		line, ok := userLines[member.UserId]
+		if !ok {
+			continue
+		}
+		roles := model.TeamUserRoleId
+		if isOwner(member.Roles) {
+			roles += " " + model.TeamAdminRoleId
+		}
+		*line.Teams = append(*line.Teams, imports.UserTeamImportData{
+			Name:     model.NewString(teamName),
+			Roles:    model.NewString(roles),
+			Channels: &[]imports.UserChannelImportData{},
+		})
+		memberships[member.UserId] = &(*line.Teams)[len(*line.Teams)-1]
+	}
+
+	channelNames := make(map[string]bool, len(channels))
+	var channelLines []imports.LineImportData
+	var postLines []imports.LineImportData
+	for _, channel := range channels {
+		channelName := uniqueName(c.channelName(channel), channelNames)
+		channelType := model.ChannelTypeOpen
+		channelMembers := members
+		if channel.MembershipType == "private" {
+			channelType = model.ChannelTypePrivate
+			channelMembers = nil
+			if err := c.decode(path.Join(teamDir, "channels", channel.Id, "members.json"), &channelMembers); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		channelLines = append(channelLines, imports.LineImportData{
+			Type: "channel",
+			Channel: &imports.ChannelImportData{
+				Team:        model.NewString(teamName),
+				Name:        model.NewString(channelName),
+				DisplayName: model.NewString(truncate(channel.DisplayName, model.ChannelDisplayNameMaxRunes)),
+				Type:        &channelType,
+				Purpose:     model.NewString(truncate(channel.Description, model.ChannelPurposeMaxRunes)),
+			},
+		})
+
+		for _, member := range channelMembers {
+			membership, ok := memberships[member.UserId]
+			if !ok {
+				continue
+			}
+			roles := model.ChannelUserRoleId
+			if channel.MembershipType == "private" && isOwner(member.Roles) {
+				roles += " " + model.ChannelAdminRoleId
+			}
+			*membership.Channels = append(*membership.Channels, imports.UserChannelImportData{
+				Name:  model.NewString(channelName),
+				Roles: model.NewString(roles),
+			})
+		}
+
+		var messages []msMessage
+		if err := c.decode(path.Join(teamDir, "channels", channel.Id, "messages.json"), &messages); err != nil {
+			return nil, nil, err
+		}
+		postLines = append(postLines, c.convertMessages(teamName, channelName, messages)...)
+	}
+
+	return channelLines, postLines, nil
+}
+
+// convertMessages returns the post lines of a channel, with the replies nested in their thread's
+// root post. System messages, deleted messages and messages by unknown users are skipped.
+func (c *converter) convertMessages(teamName, channelName string, messages []msMessage) []imports.LineImportData {
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].CreatedDateTime.Before(messages[j].CreatedDateTime)
+	})
+
+	var lines []imports.LineImportData
+	roots := make(map[string]*imports.PostImportData)
+	for _, message := range messages {
+		if message.MessageType != "message" || message.DeletedDateTime != nil {
+			continue
+		}
+		if message.From == nil || message.From.User == nil {
+			continue
+		}
+		username, ok := c.usernames[message.From.User.Id]
+		if !ok {
+			mlog.Debug("Microsoft Teams Import: Skipping a message by an unknown user.", mlog.String("message_id", message.Id))
+			continue
+		}
+
+		createAt := message.CreatedDateTime.UnixMilli()
+		text := truncate(c.convertBody(message), model.PostMessageMaxRunesV2)
+		reactions := c.convertReactions(message.Reactions, createAt)
+		var editAt *int64
+		if message.LastModifiedDateTime != nil && message.LastModifiedDateTime.After(message.CreatedDateTime) {
+			editAt = model.NewInt64(message.LastModifiedDateTime.UnixMilli())
+		}
+
+		if message.ReplyToId != "" {
+			root, ok := roots[message.ReplyToId]
+			if !ok {
+				mlog.Debug("Microsoft Teams Import: Skipping a reply to an unknown message.", mlog.String("message_id", message.Id))
+				continue
+			}
+			*root.Replies = append(*root.Replies, imports.ReplyImportData{
+				User:      model.NewString(username),
+				Message:   model.NewString(text),
+				CreateAt:  model.NewInt64(createAt),
+				EditAt:    editAt,
+				Reactions: reactions,
+			})
+			continue
+		}
+
+		post := &imports.PostImportData{
+			Team:      model.NewString(teamName),
+			Channel:   model.NewString(channelName),
+			User:      model.NewString(username),
+			Message:   model.NewString(text),
+			CreateAt:  model.NewInt64(createAt),
+			EditAt:    editAt,
+			Reactions: reactions,
+			Replies:   &[]imports.ReplyImportData{},
+		}
+		roots[message.Id] = post
+		lines = append(lines, imports.LineImportData{Type: "post", Post: post})
+	}
+	return lines
+}
+
+func (c *converter) convertReactions(reactions []msReaction, parentCreateAt int64) *[]imports.ReactionImportData {
+	var converted []imports.ReactionImportData
+	for _, reaction := range reactions {
+		if reaction.User.User == nil {
+			continue
+		}
+		username, ok := c.usernames[reaction.User.User.Id]
+		if !ok {
+			continue
+		}
+		emojiName, ok := msReactionEmojis[reaction.ReactionType]
+		if !ok {
+			continue
+		}
+		createAt := reaction.CreatedDateTime.UnixMilli()
+		if createAt < parentCreateAt {
+			createAt = parentCreateAt
+		}
+		converted = append(converted, imports.ReactionImportData{
+			User:      model.NewString(username),
+			EmojiName: model.NewString(emojiName),
+			CreateAt:  model.NewInt64(createAt),
+		})
+	}
+	if len(converted) == 0 {
+		return nil
+	}
+	return &converted
+}
+
+func (c *converter) teamName(team msTeam) string {
+	if name, ok := c.mapping.Teams[team.Id]; ok {
+		return name
+	}
+	return strings.Trim(truncate(model.CleanTeamName(team.DisplayName), model.TeamNameMaxLength-3), "-")
+}
+
+func (c *converter) channelName(channel msChannel) string {
+	if name, ok := c.mapping.Channels[channel.Id]; ok {
+		return name
+	}
+	return msConvertChannelName(channel.DisplayName)
+}
+
+// decode reads the list in the file of the archive into list. Files missing from the archive are
+// treated as empty lists.
+func (c *converter) decode(name string, list any) error {
+	file, ok := c.files[name]
+	if !ok {
+		mlog.Debug("Microsoft Teams Import: File not found in the export.", mlog.String("file", name))
+		return nil
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	// Lists retrieved from the Graph API are wrapped in a page.
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '{' {
+		var page struct {
+			Value json.RawMessage `json:"value"`
+		}
+		if err := json.Unmarshal(data, &page); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+		if page.Value == nil {
+			return fmt.Errorf("failed to parse %s: %w", name, errors.New("no value in page"))
+		}
+		data = page.Value
+	}
+
+	if err := json.Unmarshal(data, list); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return nil
+}
+
+// userLinesOf returns the user lines in the order of the exported users.
+func userLinesOf(users []msUser, userLines map[string]*imports.UserImportData) []imports.LineImportData {
+	lines := make([]imports.LineImportData, 0, len(userLines))
+	for _, user := range users {
+		if line, ok := userLines[user.Id]; ok {
+			lines = append(lines, imports.LineImportData{Type: "user", User: line})
+		}
+	}
+	return lines
+}
+
+func isOwner(roles []string) bool {
+	for _, role := range roles {
+		if role == "owner" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package msteamsimport
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/imports"
+)
+
+func makeExport(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	return r
+}
+
+func convert(t *testing.T, files map[string]string, mapping *Mapping) []imports.LineImportData {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, Convert(makeExport(t, files), mapping, &buf))
+
+	var lines []imports.LineImportData
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line imports.LineImportData
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestConvert(t *testing.T) {
+	files := map[string]string{
+		"users.json": `{"value": [
+			{"id": "u1", "displayName": "Ada Lovelace", "givenName": "Ada", "surname": "Lovelace", "mail": "Ada.Lovelace@example.com"},
+			{"id": "u2", "displayName": "Charles Babbage", "userPrincipalName": "charles@example.com"},
+			{"id": "u3", "displayName": "No Mail"}
+		]}`,
+		"teams.json":                        `[{"id": "t1", "displayName": "Engine Design", "visibility": "private"}]`,
+		"teams/t1/members.json":             `[{"userId": "u1", "roles": ["owner"]}, {"userId": "u2", "roles": []}, {"userId": "u3"}]`,
+		"teams/t1/channels.json":            `[{"id": "c1", "displayName": "General"}, {"id": "c2", "displayName": "Secret Plans", "membershipType": "private"}]`,
+		"teams/t1/channels/c2/members.json": `[{"userId": "u1", "roles": ["owner"]}]`,
+		"teams/t1/channels/c1/messages.json": `[
+			{"id": "m2", "replyToId": "m1", "messageType": "message", "createdDateTime": "2022-01-01T10:05:00Z",
+			 "from": {"user": {"id": "u2"}}, "body": {"contentType": "text", "content": "a reply"}},
+			{"id": "m1", "messageType": "message", "createdDateTime": "2022-01-01T10:00:00Z", "lastModifiedDateTime": "2022-01-01T11:00:00Z",
+			 "from": {"user": {"id": "u1"}}, "body": {"contentType": "html", "content": "<p>Hi <at id=\"0\">Charles</at>, <b>look</b>&nbsp;at <a href=\"https://example.com\">this</a></p>"},
+			 "mentions": [{"id": 0, "mentionText": "Charles", "mentioned": {"user": {"id": "u2"}}}],
+			 "reactions": [{"reactionType": "like", "createdDateTime": "2022-01-01T10:01:00Z", "user": {"user": {"id": "u2"}}}]},
+			{"id": "m3", "messageType": "systemEventMessage", "createdDateTime": "2022-01-01T10:02:00Z"},
+			{"id": "m4", "messageType": "message", "createdDateTime": "2022-01-01T10:03:00Z", "deletedDateTime": "2022-01-01T10:04:00Z",
+			 "from": {"user": {"id": "u1"}}, "body": {"contentType": "text", "content": "deleted"}}
+		]`,
+	}
+
+	lines := convert(t, files, &Mapping{Channels: map[string]string{"c1": "town-square"}})
+
+	var types []string
+	for _, line := range lines {
+		types = append(types, line.Type)
+	}
+	require.Equal(t, []string{"version", "team", "channel", "channel", "user", "user", "post"}, types)
+
+	team := lines[1].Team
+	assert.Equal(t, "engine-design", *team.Name)
+	assert.Equal(t, model.TeamInvite, *team.Type)
+
+	assert.Equal(t, "town-square", *lines[2].Channel.Name)
+	assert.Equal(t, model.ChannelTypeOpen, *lines[2].Channel.Type)
+	assert.Equal(t, "secret-plans", *lines[3].Channel.Name)
+	assert.Equal(t, model.ChannelTypePrivate, *lines[3].Channel.Type)
+
+	ada := lines[4].User
+	assert.Equal(t, "ada.lovelace", *ada.Username)
+	assert.Equal(t, "ada.lovelace@example.com", *ada.Email)
+	require.Len(t, *ada.Teams, 1)
+	assert.Equal(t, "team_user team_admin", *(*ada.Teams)[0].Roles)
+	require.Len(t, *(*ada.Teams)[0].Channels, 2)
+	assert.Equal(t, "channel_user channel_admin", *(*(*ada.Teams)[0].Channels)[1].Roles)
+
+	charles := lines[5].User
+	assert.Equal(t, "charles", *charles.Username)
+	require.Len(t, *(*charles.Teams)[0].Channels, 1, "only team members of private channels should be added to them")
+
+	post := lines[6].Post
+	assert.Equal(t, "ada.lovelace", *post.User)
+	assert.Equal(t, "Hi @charles, **look** at [this](https://example.com)", *post.Message)
+	assert.Equal(t, int64(1641031200000), *post.CreateAt)
+	assert.Equal(t, int64(1641034800000), *post.EditAt)
+	require.Len(t, *post.Reactions, 1)
+	assert.Equal(t, "+1", *(*post.Reactions)[0].EmojiName)
+	require.Len(t, *post.Replies, 1)
+	assert.Equal(t, "charles", *(*post.Replies)[0].User)
+	assert.Equal(t, "a reply", *(*post.Replies)[0].Message)
+
+	for _, line := range lines[1:] {
+		switch line.Type {
+		case "team":
+			require.Nil(t, imports.ValidateTeamImportData(line.Team))
+		case "channel":
+			require.Nil(t, imports.ValidateChannelImportData(line.Channel))
+		case "user":
+			require.Nil(t, imports.ValidateUserImportData(line.User))
+		case "post":
+			require.Nil(t, imports.ValidatePostImportData(line.Post, model.PostMessageMaxRunesV2))
+		}
+	}
+}
+
+func TestConvertMapping(t *testing.T) {
+	files := map[string]string{
+		"users.json": `[{"id": "u1", "mail": "ada@example.com"}, {"id": "u2", "mail": "ada@example.org"}]`,
+		"teams.json": `[{"id": "t1", "displayName": "Engine Design", "visibility": "public"}]`,
+	}
+
+	lines := convert(t, files, &Mapping{
+		Users:       map[string]string{"u2": "ada"},
+		Teams:       map[string]string{"t1": "existing-team"},
+		AuthService: model.ServiceOffice365,
+	})
+	require.Len(t, lines, 4)
+
+	assert.Equal(t, "existing-team", *lines[1].Team.Name)
+	assert.Equal(t, model.TeamOpen, *lines[1].Team.Type)
+	assert.Equal(t, "ada-2", *lines[2].User.Username, "derived usernames shouldn't clash with mapped ones")
+	assert.Equal(t, "ada", *lines[3].User.Username)
+	assert.Equal(t, model.ServiceOffice365, *lines[3].User.AuthService)
+	assert.Equal(t, "u2", *lines[3].User.AuthData)
+}
+
+func TestConvertInvalidFile(t *testing.T) {
+	err := Convert(makeExport(t, map[string]string{"users.json": `{"value": [`}), nil, &bytes.Buffer{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "users.json")
+}
+
+func TestMsConvertChannelName(t *testing.T) {
+	assert.Equal(t, "general", msConvertChannelName("General"))
+	assert.Equal(t, "q3-planning-review", msConvertChannelName("Q3 Planning & Review!"))
+	assert.Len(t, msConvertChannelName("???"), 26)
+}
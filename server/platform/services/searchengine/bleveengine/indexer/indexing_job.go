@@ -381,7 +381,8 @@ func (worker *BleveIndexerWorker) BulkIndexPosts(posts []*model.PostForIndexing,
 	batch := worker.engine.PostIndex.NewBatch()
 
 	for _, post := range posts {
-		if post.DeleteAt == 0 {
+		// encrypted posts can't be searched
+		if post.DeleteAt == 0 && !post.IsEncrypted() {
 			searchPost := bleveengine.BLVPostFromPostForIndexing(post)
 			batch.Index(searchPost.Id, searchPost)
 		} else {
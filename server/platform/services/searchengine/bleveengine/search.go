@@ -4,6 +4,7 @@
 package bleveengine
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -28,7 +29,7 @@ func (b *BleveEngine) IndexPost(post *model.Post, teamId string) *model.AppError
 	return nil
 }
 
-func (b *BleveEngine) SearchPosts(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.AppError) {
+func (b *BleveEngine) SearchPosts(ctx context.Context, channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.AppError) {
 	channelQueries := []query.Query{}
 	for _, channel := range channels {
 		channelIdQ := bleve.NewTermQuery(channel.Id)
@@ -212,7 +213,7 @@ func (b *BleveEngine) SearchPosts(channels model.ChannelList, searchParams []*mo
 
 	search := bleve.NewSearchRequestOptions(query, perPage, page*perPage, false)
 	search.SortBy([]string{"-CreateAt"})
-	results, err := b.PostIndex.Search(search)
+	results, err := b.PostIndex.SearchInContext(ctx, search)
 	if err != nil {
 		return nil, nil, model.NewAppError("Bleveengine.SearchPosts", "bleveengine.search_posts.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -314,7 +315,7 @@ func (b *BleveEngine) IndexChannel(channel *model.Channel, userIDs, teamMemberID
 	return nil
 }
 
-func (b *BleveEngine) SearchChannels(teamId, userID, term string, isGuest bool) ([]string, *model.AppError) {
+func (b *BleveEngine) SearchChannels(ctx context.Context, teamId, userID, term string, isGuest bool) ([]string, *model.AppError) {
 	// This query essentially boils down to (if teamID is passed):
 	// match teamID == <>
 	// AND
@@ -379,7 +380,7 @@ func (b *BleveEngine) SearchChannels(teamId, userID, term string, isGuest bool)
 
 	query := bleve.NewSearchRequest(bleve.NewConjunctionQuery(queries...))
 	query.Size = model.ChannelSearchDefaultLimit
-	results, err := b.ChannelIndex.Search(query)
+	results, err := b.ChannelIndex.SearchInContext(ctx, query)
 	if err != nil {
 		return nil, model.NewAppError("Bleveengine.SearchChannels", "bleveengine.search_channels.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -413,7 +414,7 @@ func (b *BleveEngine) IndexUser(user *model.User, teamsIds, channelsIds []string
 	return nil
 }
 
-func (b *BleveEngine) SearchUsersInChannel(teamId, channelId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, []string, *model.AppError) {
+func (b *BleveEngine) SearchUsersInChannel(ctx context.Context, teamId, channelId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, []string, *model.AppError) {
 	if restrictedToChannels != nil && len(restrictedToChannels) == 0 {
 		return []string{}, []string{}, nil
 	}
@@ -438,7 +439,7 @@ func (b *BleveEngine) SearchUsersInChannel(teamId, channelId string, restrictedT
 
 	uchanSearch := bleve.NewSearchRequest(query)
 	uchanSearch.Size = options.Limit
-	uchan, err := b.UserIndex.Search(uchanSearch)
+	uchan, err := b.UserIndex.SearchInContext(ctx, uchanSearch)
 	if err != nil {
 		return nil, nil, model.NewAppError("Bleveengine.SearchUsersInChannel", "bleveengine.search_users_in_channel.uchan.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -475,7 +476,7 @@ func (b *BleveEngine) SearchUsersInChannel(teamId, channelId string, restrictedT
 
 	nuchanSearch := bleve.NewSearchRequest(boolQ)
 	nuchanSearch.Size = options.Limit
-	nuchan, err := b.UserIndex.Search(nuchanSearch)
+	nuchan, err := b.UserIndex.SearchInContext(ctx, nuchanSearch)
 	if err != nil {
 		return nil, nil, model.NewAppError("Bleveengine.SearchUsersInChannel", "bleveengine.search_users_in_channel.nuchan.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -493,7 +494,7 @@ func (b *BleveEngine) SearchUsersInChannel(teamId, channelId string, restrictedT
 	return uchanIds, nuchanIds, nil
 }
 
-func (b *BleveEngine) SearchUsersInTeam(teamId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, *model.AppError) {
+func (b *BleveEngine) SearchUsersInTeam(ctx context.Context, teamId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, *model.AppError) {
 	if restrictedToChannels != nil && len(restrictedToChannels) == 0 {
 		return []string{}, nil
 	}
@@ -538,7 +539,7 @@ func (b *BleveEngine) SearchUsersInTeam(teamId string, restrictedToChannels []st
 
 	search := bleve.NewSearchRequest(rootQ)
 	search.Size = options.Limit
-	results, err := b.UserIndex.Search(search)
+	results, err := b.UserIndex.SearchInContext(ctx, search)
 	if err != nil {
 		return nil, model.NewAppError("Bleveengine.SearchUsersInTeam", "bleveengine.search_users_in_team.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -572,7 +573,7 @@ func (b *BleveEngine) IndexFile(file *model.FileInfo, channelId string) *model.A
 	return nil
 }
 
-func (b *BleveEngine) SearchFiles(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, *model.AppError) {
+func (b *BleveEngine) SearchFiles(ctx context.Context, channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, *model.AppError) {
 	channelQueries := []query.Query{}
 	for _, channel := range channels {
 		channelIdQ := bleve.NewTermQuery(channel.Id)
@@ -766,7 +767,7 @@ func (b *BleveEngine) SearchFiles(channels model.ChannelList, searchParams []*mo
 
 	search := bleve.NewSearchRequestOptions(query, perPage, page*perPage, false)
 	search.SortBy([]string{"-CreateAt"})
-	results, err := b.FileIndex.Search(search)
+	results, err := b.FileIndex.SearchInContext(ctx, search)
 	if err != nil {
 		return nil, model.NewAppError("Bleveengine.SearchFiles", "bleveengine.search_files.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
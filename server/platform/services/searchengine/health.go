@@ -0,0 +1,163 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package searchengine
+
+import (
+	"sort"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+const (
+	// unhealthyThreshold is the number of consecutive failures after which an engine stops being
+	// queried for a type of query.
+	unhealthyThreshold = 3
+	// unhealthyRetryInterval is how long an unhealthy engine is skipped before a query is sent to
+	// it again to find out whether it recovered.
+	unhealthyRetryInterval = 30 * time.Second
+)
+
+type queryHealthKey struct {
+	engine    string
+	queryType string
+}
+
+type queryHealth struct {
+	count               int64
+	failures            int64
+	consecutiveFailures int
+	totalLatency        time.Duration
+	lastFailureAt       time.Time
+	retryAt             time.Time
+}
+
+func (h *queryHealth) isHealthy() bool {
+	return h.consecutiveFailures < unhealthyThreshold
+}
+
+// isAutocompletion returns whether the query type is served by the autocompletion of the engines
+// rather than by their search.
+func isAutocompletion(queryType string) bool {
+	return queryType == model.SearchQueryTypeUserAutocomplete || queryType == model.SearchQueryTypeChannelAutocomplete
+}
+
+// GetSearchEngines returns the engines a query of the given type should be sent to, in the order
+// they should be tried in: Elasticsearch first, then Bleve. The caller falls back to the database
+// when none of them succeeds. Engines that failed too many queries of that type in a row are left
+// out until they're due to be retried, at which point a single query is let through.
+func (seb *Broker) GetSearchEngines(queryType string) []SearchEngineInterface {
+	engines := []SearchEngineInterface{}
+	for _, engine := range seb.GetActiveEngines() {
+		if isAutocompletion(queryType) && !engine.IsAutocompletionEnabled() {
+			continue
+		}
+		if !isAutocompletion(queryType) && !engine.IsSearchEnabled() {
+			continue
+		}
+		if !seb.allowQuery(engine.GetName(), queryType) {
+			continue
+		}
+		engines = append(engines, engine)
+	}
+	return engines
+}
+
+func (seb *Broker) allowQuery(engineName, queryType string) bool {
+	seb.healthMut.Lock()
+	defer seb.healthMut.Unlock()
+
+	health, ok := seb.health[queryHealthKey{engineName, queryType}]
+	if !ok || health.isHealthy() {
+		return true
+	}
+
+	now := seb.now()
+	if now.Before(health.retryAt) {
+		return false
+	}
+
+	// Let this query through, and hold the others back until it reports back
+	health.retryAt = now.Add(unhealthyRetryInterval)
+	return true
+}
+
+// ObserveQuery records how long an engine took to answer a query of the given type, and whether it
+// failed. Failures count towards the engine being considered unhealthy for that type of query.
+func (seb *Broker) ObserveQuery(engine SearchEngineInterface, queryType string, elapsed time.Duration, err error) {
+	engineName := engine.GetName()
+
+	seb.healthMut.Lock()
+	key := queryHealthKey{engineName, queryType}
+	health, ok := seb.health[key]
+	if !ok {
+		health = &queryHealth{}
+		seb.health[key] = health
+	}
+
+	health.count++
+	health.totalLatency += elapsed
+	wasHealthy := health.isHealthy()
+	if err != nil {
+		health.failures++
+		health.consecutiveFailures++
+		health.lastFailureAt = seb.now()
+		if !health.isHealthy() {
+			health.retryAt = health.lastFailureAt.Add(unhealthyRetryInterval)
+		}
+	} else {
+		health.consecutiveFailures = 0
+		health.retryAt = time.Time{}
+	}
+	isHealthy := health.isHealthy()
+	metrics := seb.metrics
+	seb.healthMut.Unlock()
+
+	if metrics != nil {
+		metrics.ObserveSearchEngineQueryDuration(engineName, queryType, err == nil, elapsed.Seconds())
+	}
+
+	if wasHealthy && !isHealthy {
+		mlog.Warn("Search engine failed too many queries in a row, skipping it for a while.", mlog.String("search_engine", engineName), mlog.String("query_type", queryType), mlog.Err(err))
+	} else if !wasHealthy && isHealthy {
+		mlog.Info("Search engine recovered.", mlog.String("search_engine", engineName), mlog.String("query_type", queryType))
+	}
+}
+
+// GetQueryStats returns the latency and health of every engine for each type of query it was sent.
+func (seb *Broker) GetQueryStats() []*model.SearchEngineQueryStats {
+	seb.healthMut.Lock()
+	defer seb.healthMut.Unlock()
+
+	stats := make([]*model.SearchEngineQueryStats, 0, len(seb.health))
+	for key, health := range seb.health {
+		stat := &model.SearchEngineQueryStats{
+			Engine:              key.engine,
+			QueryType:           key.queryType,
+			Count:               health.count,
+			Failures:            health.failures,
+			ConsecutiveFailures: health.consecutiveFailures,
+			Healthy:             health.isHealthy(),
+		}
+		if health.count > 0 {
+			stat.AverageLatencyMs = float64(health.totalLatency) / float64(time.Millisecond) / float64(health.count)
+		}
+		if !health.lastFailureAt.IsZero() {
+			stat.LastFailureAt = model.GetMillisForTime(health.lastFailureAt)
+		}
+		if !stat.Healthy {
+			stat.RetryAt = model.GetMillisForTime(health.retryAt)
+		}
+		stats = append(stats, stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Engine != stats[j].Engine {
+			return stats[i].Engine < stats[j].Engine
+		}
+		return stats[i].QueryType < stats[j].QueryType
+	})
+	return stats
+}
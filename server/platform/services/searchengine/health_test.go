@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package searchengine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	emocks "github.com/mattermost/mattermost-server/v6/server/channels/einterfaces/mocks"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/searchengine/mocks"
+)
+
+func newMockEngine(name string, searchEnabled, autocompletionEnabled bool) *mocks.SearchEngineInterface {
+	engine := &mocks.SearchEngineInterface{}
+	engine.On("GetName").Return(name)
+	engine.On("IsActive").Return(true)
+	engine.On("IsSearchEnabled").Return(searchEnabled)
+	engine.On("IsAutocompletionEnabled").Return(autocompletionEnabled)
+	return engine
+}
+
+func TestGetSearchEngines(t *testing.T) {
+	broker := NewBroker(&model.Config{})
+	es := newMockEngine("elasticsearch", true, false)
+	bleve := newMockEngine("bleve", true, true)
+	broker.RegisterElasticsearchEngine(es)
+	broker.RegisterBleveEngine(bleve)
+
+	assert.Equal(t, []SearchEngineInterface{es, bleve}, broker.GetSearchEngines(model.SearchQueryTypePostSearch))
+	assert.Equal(t, []SearchEngineInterface{bleve}, broker.GetSearchEngines(model.SearchQueryTypeChannelAutocomplete))
+}
+
+func TestSearchEngineFailover(t *testing.T) {
+	broker := NewBroker(&model.Config{})
+	now := time.Now()
+	broker.now = func() time.Time { return now }
+	es := newMockEngine("elasticsearch", true, true)
+	bleve := newMockEngine("bleve", true, true)
+	broker.RegisterElasticsearchEngine(es)
+	broker.RegisterBleveEngine(bleve)
+
+	metrics := &emocks.MetricsInterface{}
+	metrics.On("ObserveSearchEngineQueryDuration", "elasticsearch", model.SearchQueryTypePostSearch, false, mock.AnythingOfType("float64")).Times(unhealthyThreshold)
+	metrics.On("ObserveSearchEngineQueryDuration", "elasticsearch", model.SearchQueryTypePostSearch, true, mock.AnythingOfType("float64")).Once()
+	broker.SetMetrics(metrics)
+
+	for i := 0; i < unhealthyThreshold; i++ {
+		require.Len(t, broker.GetSearchEngines(model.SearchQueryTypePostSearch), 2)
+		broker.ObserveQuery(es, model.SearchQueryTypePostSearch, time.Millisecond, errors.New("unavailable"))
+	}
+
+	t.Run("unhealthy engine is skipped", func(t *testing.T) {
+		assert.Equal(t, []SearchEngineInterface{bleve}, broker.GetSearchEngines(model.SearchQueryTypePostSearch))
+	})
+
+	t.Run("other query types are unaffected", func(t *testing.T) {
+		assert.Equal(t, []SearchEngineInterface{es, bleve}, broker.GetSearchEngines(model.SearchQueryTypeUserSearch))
+	})
+
+	t.Run("a single query is let through once due", func(t *testing.T) {
+		now = now.Add(unhealthyRetryInterval)
+		assert.Equal(t, []SearchEngineInterface{es, bleve}, broker.GetSearchEngines(model.SearchQueryTypePostSearch))
+		assert.Equal(t, []SearchEngineInterface{bleve}, broker.GetSearchEngines(model.SearchQueryTypePostSearch))
+	})
+
+	t.Run("engine recovers after a success", func(t *testing.T) {
+		broker.ObserveQuery(es, model.SearchQueryTypePostSearch, time.Millisecond, nil)
+		assert.Equal(t, []SearchEngineInterface{es, bleve}, broker.GetSearchEngines(model.SearchQueryTypePostSearch))
+	})
+
+	metrics.AssertExpectations(t)
+}
+
+func TestGetQueryStats(t *testing.T) {
+	broker := NewBroker(&model.Config{})
+	now := time.Now()
+	broker.now = func() time.Time { return now }
+	es := newMockEngine("elasticsearch", true, true)
+	bleve := newMockEngine("bleve", true, true)
+
+	broker.ObserveQuery(es, model.SearchQueryTypePostSearch, 10*time.Millisecond, nil)
+	broker.ObserveQuery(es, model.SearchQueryTypePostSearch, 20*time.Millisecond, errors.New("unavailable"))
+	broker.ObserveQuery(bleve, model.SearchQueryTypeUserSearch, 5*time.Millisecond, nil)
+
+	assert.Equal(t, []*model.SearchEngineQueryStats{
+		{
+			Engine:           "bleve",
+			QueryType:        model.SearchQueryTypeUserSearch,
+			Count:            1,
+			AverageLatencyMs: 5,
+			Healthy:          true,
+		},
+		{
+			Engine:              "elasticsearch",
+			QueryType:           model.SearchQueryTypePostSearch,
+			Count:               2,
+			Failures:            1,
+			ConsecutiveFailures: 1,
+			AverageLatencyMs:    15,
+			LastFailureAt:       model.GetMillisForTime(now),
+			Healthy:             true,
+		},
+	}, broker.GetQueryStats())
+}
@@ -4,6 +4,7 @@
 package searchengine
 
 import (
+	"context"
 	"time"
 
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -23,21 +24,21 @@ type SearchEngineInterface interface {
 	IsAutocompletionEnabled() bool
 	IsIndexingSync() bool
 	IndexPost(post *model.Post, teamId string) *model.AppError
-	SearchPosts(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.AppError)
+	SearchPosts(ctx context.Context, channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.AppError)
 	DeletePost(post *model.Post) *model.AppError
 	DeleteChannelPosts(channelID string) *model.AppError
 	DeleteUserPosts(userID string) *model.AppError
 	// IndexChannel indexes a given channel. The userIDs are only populated
 	// for private channels.
 	IndexChannel(channel *model.Channel, userIDs, teamMemberIDs []string) *model.AppError
-	SearchChannels(teamId, userID, term string, isGuest bool) ([]string, *model.AppError)
+	SearchChannels(ctx context.Context, teamId, userID, term string, isGuest bool) ([]string, *model.AppError)
 	DeleteChannel(channel *model.Channel) *model.AppError
 	IndexUser(user *model.User, teamsIds, channelsIds []string) *model.AppError
-	SearchUsersInChannel(teamId, channelId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, []string, *model.AppError)
-	SearchUsersInTeam(teamId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, *model.AppError)
+	SearchUsersInChannel(ctx context.Context, teamId, channelId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, []string, *model.AppError)
+	SearchUsersInTeam(ctx context.Context, teamId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, *model.AppError)
 	DeleteUser(user *model.User) *model.AppError
 	IndexFile(file *model.FileInfo, channelId string) *model.AppError
-	SearchFiles(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, *model.AppError)
+	SearchFiles(ctx context.Context, channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, *model.AppError)
 	DeleteFile(fileID string) *model.AppError
 	DeletePostFiles(postID string) *model.AppError
 	DeleteUserFiles(userID string) *model.AppError
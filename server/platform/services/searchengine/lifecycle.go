@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package searchengine
+
+import (
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const (
+	lifecyclePolicyName   = "posts_lifecycle"
+	postIndexRolloverName = "posts"
+)
+
+// LifecyclePolicyName returns the name of the policy rolling the post indexes over.
+func LifecyclePolicyName(settings *model.ElasticsearchSettings) string {
+	return *settings.IndexPrefix + lifecyclePolicyName
+}
+
+// PostIndexRolloverAlias returns the alias the post indexes are written and searched through
+// when they're rolled over.
+func PostIndexRolloverAlias(settings *model.ElasticsearchSettings) string {
+	return *settings.IndexPrefix + postIndexRolloverName
+}
+
+// PostIndexRolloverPattern returns the pattern matching the post indexes created by rollovers.
+func PostIndexRolloverPattern(settings *model.ElasticsearchSettings) string {
+	return PostIndexRolloverAlias(settings) + "-*"
+}
+
+// InitialPostIndexName returns the name of the first post index, which the rollovers increment.
+func InitialPostIndexName(settings *model.ElasticsearchSettings) string {
+	return PostIndexRolloverAlias(settings) + "-000001"
+}
+
+// LifecyclePolicy returns the body of the request creating the policy that rolls the post indexes
+// over once they're larger than RolloverMaxSizeGB or older than RolloverMaxAgeDays. It's an ILM
+// policy on Elasticsearch, and an ISM policy on OpenSearch.
+func LifecyclePolicy(settings *model.ElasticsearchSettings) map[string]any {
+	maxSize := strconv.Itoa(*settings.RolloverMaxSizeGB) + "gb"
+	maxAge := strconv.Itoa(*settings.RolloverMaxAgeDays) + "d"
+
+	if *settings.Backend == model.ElasticsearchSettingsOSBackend {
+		return map[string]any{
+			"policy": map[string]any{
+				"description":   "Rolls the Mattermost post indexes over.",
+				"default_state": "hot",
+				"states": []any{
+					map[string]any{
+						"name": "hot",
+						"actions": []any{
+							map[string]any{
+								"rollover": map[string]any{
+									"min_size":      maxSize,
+									"min_index_age": maxAge,
+								},
+							},
+						},
+						"transitions": []any{},
+					},
+				},
+				// OpenSearch attaches the policy to the indexes when they're created
+				"ism_template": []any{
+					map[string]any{
+						"index_patterns": []string{PostIndexRolloverPattern(settings)},
+						"priority":       100,
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]any{
+		"policy": map[string]any{
+			"phases": map[string]any{
+				"hot": map[string]any{
+					"min_age": "0ms",
+					"actions": map[string]any{
+						"rollover": map[string]any{
+							"max_size": maxSize,
+							"max_age":  maxAge,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// IndexSettings returns the settings of an index with the given number of shards and replicas.
+// With lifecycle management, the settings of the post indexes also point at their rollover alias,
+// and at the policy on Elasticsearch.
+func IndexSettings(settings *model.ElasticsearchSettings, shards, replicas int, isPostIndex bool) map[string]any {
+	indexSettings := map[string]any{
+		"number_of_shards":   shards,
+		"number_of_replicas": replicas,
+	}
+
+	if !isPostIndex || !*settings.EnableLifecycleManagement {
+		return indexSettings
+	}
+
+	if *settings.Backend == model.ElasticsearchSettingsOSBackend {
+		indexSettings["plugins.index_state_management.rollover_alias"] = PostIndexRolloverAlias(settings)
+	} else {
+		indexSettings["lifecycle.name"] = LifecyclePolicyName(settings)
+		indexSettings["lifecycle.rollover_alias"] = PostIndexRolloverAlias(settings)
+	}
+	return indexSettings
+}
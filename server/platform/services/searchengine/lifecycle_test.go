@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package searchengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestLifecyclePolicy(t *testing.T) {
+	settings := &model.ElasticsearchSettings{}
+	settings.SetDefaults()
+	settings.IndexPrefix = model.NewString("mm_")
+	settings.RolloverMaxSizeGB = model.NewInt(10)
+	settings.RolloverMaxAgeDays = model.NewInt(7)
+
+	t.Run("elasticsearch", func(t *testing.T) {
+		policy := LifecyclePolicy(settings)
+		rollover := policy["policy"].(map[string]any)["phases"].(map[string]any)["hot"].(map[string]any)["actions"].(map[string]any)["rollover"]
+		assert.Equal(t, map[string]any{"max_size": "10gb", "max_age": "7d"}, rollover)
+	})
+
+	t.Run("opensearch", func(t *testing.T) {
+		settings.Backend = model.NewString(model.ElasticsearchSettingsOSBackend)
+		defer func() { settings.Backend = model.NewString(model.ElasticsearchSettingsESBackend) }()
+
+		policy := LifecyclePolicy(settings)["policy"].(map[string]any)
+		state := policy["states"].([]any)[0].(map[string]any)
+		rollover := state["actions"].([]any)[0].(map[string]any)["rollover"]
+		assert.Equal(t, map[string]any{"min_size": "10gb", "min_index_age": "7d"}, rollover)
+
+		template := policy["ism_template"].([]any)[0].(map[string]any)
+		assert.Equal(t, []string{"mm_posts-*"}, template["index_patterns"])
+	})
+}
+
+func TestIndexSettings(t *testing.T) {
+	settings := &model.ElasticsearchSettings{}
+	settings.SetDefaults()
+	settings.IndexPrefix = model.NewString("mm_")
+
+	t.Run("without lifecycle management", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"number_of_shards": 2, "number_of_replicas": 1}, IndexSettings(settings, 2, 1, true))
+	})
+
+	settings.EnableLifecycleManagement = model.NewBool(true)
+
+	t.Run("not a post index", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"number_of_shards": 1, "number_of_replicas": 0}, IndexSettings(settings, 1, 0, false))
+	})
+
+	t.Run("elasticsearch", func(t *testing.T) {
+		assert.Equal(t, map[string]any{
+			"number_of_shards":         1,
+			"number_of_replicas":       1,
+			"lifecycle.name":           "mm_posts_lifecycle",
+			"lifecycle.rollover_alias": "mm_posts",
+		}, IndexSettings(settings, 1, 1, true))
+	})
+
+	t.Run("opensearch", func(t *testing.T) {
+		settings.Backend = model.NewString(model.ElasticsearchSettingsOSBackend)
+		assert.Equal(t, map[string]any{
+			"number_of_shards":                              1,
+			"number_of_replicas":                            1,
+			"plugins.index_state_management.rollover_alias": "mm_posts",
+		}, IndexSettings(settings, 1, 1, true))
+		assert.Equal(t, "mm_posts-000001", InitialPostIndexName(settings))
+	})
+}
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	context "context"
+
 	model "github.com/mattermost/mattermost-server/v6/model"
 	mock "github.com/stretchr/testify/mock"
 
@@ -400,13 +402,13 @@ func (_m *SearchEngineInterface) RefreshIndexes() *model.AppError {
 	return r0
 }
 
-// SearchChannels provides a mock function with given fields: teamId, userID, term, isGuest
-func (_m *SearchEngineInterface) SearchChannels(teamId string, userID string, term string, isGuest bool) ([]string, *model.AppError) {
-	ret := _m.Called(teamId, userID, term, isGuest)
+// SearchChannels provides a mock function with given fields: ctx, teamId, userID, term, isGuest
+func (_m *SearchEngineInterface) SearchChannels(ctx context.Context, teamId string, userID string, term string, isGuest bool) ([]string, *model.AppError) {
+	ret := _m.Called(ctx, teamId, userID, term, isGuest)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(string, string, string, bool) []string); ok {
-		r0 = rf(teamId, userID, term, isGuest)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) []string); ok {
+		r0 = rf(ctx, teamId, userID, term, isGuest)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -414,8 +416,8 @@ func (_m *SearchEngineInterface) SearchChannels(teamId string, userID string, te
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, string, string, bool) *model.AppError); ok {
-		r1 = rf(teamId, userID, term, isGuest)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool) *model.AppError); ok {
+		r1 = rf(ctx, teamId, userID, term, isGuest)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -425,13 +427,13 @@ func (_m *SearchEngineInterface) SearchChannels(teamId string, userID string, te
 	return r0, r1
 }
 
-// SearchFiles provides a mock function with given fields: channels, searchParams, page, perPage
-func (_m *SearchEngineInterface) SearchFiles(channels model.ChannelList, searchParams []*model.SearchParams, page int, perPage int) ([]string, *model.AppError) {
-	ret := _m.Called(channels, searchParams, page, perPage)
+// SearchFiles provides a mock function with given fields: ctx, channels, searchParams, page, perPage
+func (_m *SearchEngineInterface) SearchFiles(ctx context.Context, channels model.ChannelList, searchParams []*model.SearchParams, page int, perPage int) ([]string, *model.AppError) {
+	ret := _m.Called(ctx, channels, searchParams, page, perPage)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(model.ChannelList, []*model.SearchParams, int, int) []string); ok {
-		r0 = rf(channels, searchParams, page, perPage)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ChannelList, []*model.SearchParams, int, int) []string); ok {
+		r0 = rf(ctx, channels, searchParams, page, perPage)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -439,8 +441,8 @@ func (_m *SearchEngineInterface) SearchFiles(channels model.ChannelList, searchP
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(model.ChannelList, []*model.SearchParams, int, int) *model.AppError); ok {
-		r1 = rf(channels, searchParams, page, perPage)
+	if rf, ok := ret.Get(1).(func(context.Context, model.ChannelList, []*model.SearchParams, int, int) *model.AppError); ok {
+		r1 = rf(ctx, channels, searchParams, page, perPage)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -450,13 +452,13 @@ func (_m *SearchEngineInterface) SearchFiles(channels model.ChannelList, searchP
 	return r0, r1
 }
 
-// SearchPosts provides a mock function with given fields: channels, searchParams, page, perPage
-func (_m *SearchEngineInterface) SearchPosts(channels model.ChannelList, searchParams []*model.SearchParams, page int, perPage int) ([]string, model.PostSearchMatches, *model.AppError) {
-	ret := _m.Called(channels, searchParams, page, perPage)
+// SearchPosts provides a mock function with given fields: ctx, channels, searchParams, page, perPage
+func (_m *SearchEngineInterface) SearchPosts(ctx context.Context, channels model.ChannelList, searchParams []*model.SearchParams, page int, perPage int) ([]string, model.PostSearchMatches, *model.AppError) {
+	ret := _m.Called(ctx, channels, searchParams, page, perPage)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(model.ChannelList, []*model.SearchParams, int, int) []string); ok {
-		r0 = rf(channels, searchParams, page, perPage)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ChannelList, []*model.SearchParams, int, int) []string); ok {
+		r0 = rf(ctx, channels, searchParams, page, perPage)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -464,8 +466,8 @@ func (_m *SearchEngineInterface) SearchPosts(channels model.ChannelList, searchP
 	}
 
 	var r1 model.PostSearchMatches
-	if rf, ok := ret.Get(1).(func(model.ChannelList, []*model.SearchParams, int, int) model.PostSearchMatches); ok {
-		r1 = rf(channels, searchParams, page, perPage)
+	if rf, ok := ret.Get(1).(func(context.Context, model.ChannelList, []*model.SearchParams, int, int) model.PostSearchMatches); ok {
+		r1 = rf(ctx, channels, searchParams, page, perPage)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(model.PostSearchMatches)
@@ -473,8 +475,8 @@ func (_m *SearchEngineInterface) SearchPosts(channels model.ChannelList, searchP
 	}
 
 	var r2 *model.AppError
-	if rf, ok := ret.Get(2).(func(model.ChannelList, []*model.SearchParams, int, int) *model.AppError); ok {
-		r2 = rf(channels, searchParams, page, perPage)
+	if rf, ok := ret.Get(2).(func(context.Context, model.ChannelList, []*model.SearchParams, int, int) *model.AppError); ok {
+		r2 = rf(ctx, channels, searchParams, page, perPage)
 	} else {
 		if ret.Get(2) != nil {
 			r2 = ret.Get(2).(*model.AppError)
@@ -484,13 +486,13 @@ func (_m *SearchEngineInterface) SearchPosts(channels model.ChannelList, searchP
 	return r0, r1, r2
 }
 
-// SearchUsersInChannel provides a mock function with given fields: teamId, channelId, restrictedToChannels, term, options
-func (_m *SearchEngineInterface) SearchUsersInChannel(teamId string, channelId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, []string, *model.AppError) {
-	ret := _m.Called(teamId, channelId, restrictedToChannels, term, options)
+// SearchUsersInChannel provides a mock function with given fields: ctx, teamId, channelId, restrictedToChannels, term, options
+func (_m *SearchEngineInterface) SearchUsersInChannel(ctx context.Context, teamId string, channelId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, []string, *model.AppError) {
+	ret := _m.Called(ctx, teamId, channelId, restrictedToChannels, term, options)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(string, string, []string, string, *model.UserSearchOptions) []string); ok {
-		r0 = rf(teamId, channelId, restrictedToChannels, term, options)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string, string, *model.UserSearchOptions) []string); ok {
+		r0 = rf(ctx, teamId, channelId, restrictedToChannels, term, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -498,8 +500,8 @@ func (_m *SearchEngineInterface) SearchUsersInChannel(teamId string, channelId s
 	}
 
 	var r1 []string
-	if rf, ok := ret.Get(1).(func(string, string, []string, string, *model.UserSearchOptions) []string); ok {
-		r1 = rf(teamId, channelId, restrictedToChannels, term, options)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string, string, *model.UserSearchOptions) []string); ok {
+		r1 = rf(ctx, teamId, channelId, restrictedToChannels, term, options)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]string)
@@ -507,8 +509,8 @@ func (_m *SearchEngineInterface) SearchUsersInChannel(teamId string, channelId s
 	}
 
 	var r2 *model.AppError
-	if rf, ok := ret.Get(2).(func(string, string, []string, string, *model.UserSearchOptions) *model.AppError); ok {
-		r2 = rf(teamId, channelId, restrictedToChannels, term, options)
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, []string, string, *model.UserSearchOptions) *model.AppError); ok {
+		r2 = rf(ctx, teamId, channelId, restrictedToChannels, term, options)
 	} else {
 		if ret.Get(2) != nil {
 			r2 = ret.Get(2).(*model.AppError)
@@ -518,13 +520,13 @@ func (_m *SearchEngineInterface) SearchUsersInChannel(teamId string, channelId s
 	return r0, r1, r2
 }
 
-// SearchUsersInTeam provides a mock function with given fields: teamId, restrictedToChannels, term, options
-func (_m *SearchEngineInterface) SearchUsersInTeam(teamId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, *model.AppError) {
-	ret := _m.Called(teamId, restrictedToChannels, term, options)
+// SearchUsersInTeam provides a mock function with given fields: ctx, teamId, restrictedToChannels, term, options
+func (_m *SearchEngineInterface) SearchUsersInTeam(ctx context.Context, teamId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, *model.AppError) {
+	ret := _m.Called(ctx, teamId, restrictedToChannels, term, options)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(string, []string, string, *model.UserSearchOptions) []string); ok {
-		r0 = rf(teamId, restrictedToChannels, term, options)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, string, *model.UserSearchOptions) []string); ok {
+		r0 = rf(ctx, teamId, restrictedToChannels, term, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -532,8 +534,8 @@ func (_m *SearchEngineInterface) SearchUsersInTeam(teamId string, restrictedToCh
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, []string, string, *model.UserSearchOptions) *model.AppError); ok {
-		r1 = rf(teamId, restrictedToChannels, term, options)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, string, *model.UserSearchOptions) *model.AppError); ok {
+		r1 = rf(ctx, teamId, restrictedToChannels, term, options)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -4,12 +4,18 @@
 package searchengine
 
 import (
+	"sync"
+	"time"
+
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
 )
 
 func NewBroker(cfg *model.Config) *Broker {
 	return &Broker{
-		cfg: cfg,
+		cfg:    cfg,
+		health: map[queryHealthKey]*queryHealth{},
+		now:    time.Now,
 	}
 }
 
@@ -25,6 +31,18 @@ type Broker struct {
 	cfg                 *model.Config
 	ElasticsearchEngine SearchEngineInterface
 	BleveEngine         SearchEngineInterface
+
+	metrics   einterfaces.MetricsInterface
+	healthMut sync.Mutex
+	health    map[queryHealthKey]*queryHealth
+	now       func() time.Time
+}
+
+// SetMetrics sets the metrics the latency of the queries sent to the engines is reported to.
+func (seb *Broker) SetMetrics(metrics einterfaces.MetricsInterface) {
+	seb.healthMut.Lock()
+	defer seb.healthMut.Unlock()
+	seb.metrics = metrics
 }
 
 func (seb *Broker) UpdateConfig(cfg *model.Config) *model.AppError {
@@ -13,6 +13,8 @@ import (
 	remotecluster "github.com/mattermost/mattermost-server/v6/server/platform/services/remotecluster"
 
 	store "github.com/mattermost/mattermost-server/v6/server/channels/store"
+
+	einterfaces "github.com/mattermost/mattermost-server/v6/server/channels/einterfaces"
 )
 
 // MockServerIface is an autogenerated mock type for the ServerIface type
@@ -50,6 +52,22 @@ func (_m *MockServerIface) Config() *model.Config {
 	return r0
 }
 
+// GetMetrics provides a mock function with given fields:
+func (_m *MockServerIface) GetMetrics() einterfaces.MetricsInterface {
+	ret := _m.Called()
+
+	var r0 einterfaces.MetricsInterface
+	if rf, ok := ret.Get(0).(func() einterfaces.MetricsInterface); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(einterfaces.MetricsInterface)
+		}
+	}
+
+	return r0
+}
+
 // GetRemoteClusterService provides a mock function with given fields:
 func (_m *MockServerIface) GetRemoteClusterService() remotecluster.RemoteClusterServiceIFace {
 	ret := _m.Called()
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -87,6 +88,12 @@ func (scs *Service) processSyncMessage(c request.CTX, syncMsg *syncMsg, rc *mode
 		}
 	}
 
+	// thread roots are processed before replies so a reply arriving in the same message as its
+	// root doesn't fail because the root doesn't exist yet.
+	sort.SliceStable(syncMsg.Posts, func(i, j int) bool {
+		return syncMsg.Posts[i].RootId == "" && syncMsg.Posts[j].RootId != ""
+	})
+
 	for _, post := range syncMsg.Posts {
 		if syncMsg.ChannelId != post.ChannelId {
 			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "ChannelId mismatch",
@@ -338,6 +345,15 @@ func (scs *Service) upsertSyncPost(post *model.Post, channel *model.Channel, rc
 	}
 
 	if rpost == nil {
+		if post.DeleteAt > 0 {
+			// post was deleted before it was ever sync'd; nothing to create.
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceDebug, "Deleted sync post ignored",
+				mlog.String("post_id", post.Id),
+				mlog.String("channel_id", post.ChannelId),
+			)
+			return post, nil
+		}
+
 		// post doesn't exist; create new one
 		rpost, appErr = scs.app.CreatePost(request.EmptyContext(scs.server.Log()), post, channel, true, true)
 		if appErr == nil {
@@ -346,6 +362,15 @@ func (scs *Service) upsertSyncPost(post *model.Post, channel *model.Channel, rc
 				mlog.String("channel_id", post.ChannelId),
 			)
 		}
+	} else if rpost.DeleteAt > 0 {
+		// a deletion always wins; a post deleted here is never edited or restored by a remote.
+		if post.DeleteAt == 0 {
+			scs.onSyncConflict(rc)
+		}
+		scs.server.Log().Log(mlog.LvlSharedChannelServiceDebug, "Update to deleted sync post ignored",
+			mlog.String("post_id", post.Id),
+			mlog.String("channel_id", post.ChannelId),
+		)
 	} else if post.DeleteAt > 0 {
 		// delete post
 		rpost, appErr = scs.app.DeletePost(request.EmptyContext(scs.server.Log()), post.Id, post.UserId)
@@ -355,6 +380,15 @@ func (scs *Service) upsertSyncPost(post *model.Post, channel *model.Channel, rc
 				mlog.String("channel_id", post.ChannelId),
 			)
 		}
+	} else if isStaleSyncPostEdit(post, rpost, rc) {
+		// the post was edited here after the remote edit was made; the last edit wins.
+		scs.onSyncConflict(rc)
+		scs.server.Log().Log(mlog.LvlSharedChannelServiceDebug, "Stale edit to sync post ignored",
+			mlog.String("post_id", post.Id),
+			mlog.String("channel_id", post.ChannelId),
+			mlog.Int64("edit_at", post.EditAt),
+			mlog.Int64("local_edit_at", rpost.EditAt),
+		)
 	} else if post.EditAt > rpost.EditAt || post.Message != rpost.Message {
 		// update post
 		rpost, appErr = scs.app.UpdatePost(request.EmptyContext(scs.server.Log()), post, false)
@@ -379,21 +413,66 @@ func (scs *Service) upsertSyncPost(post *model.Post, channel *model.Channel, rc
 	return rpost, rerr
 }
 
+// isStaleSyncPostEdit returns true if the local copy of a post was edited after the incoming
+// edit was made. The remote a post originates from is authoritative for it, so edits from that
+// remote are never considered stale.
+func isStaleSyncPostEdit(post *model.Post, rpost *model.Post, rc *model.RemoteCluster) bool {
+	if rpost.GetRemoteID() == rc.RemoteId {
+		return false
+	}
+	return post.EditAt < rpost.EditAt
+}
+
 func (scs *Service) upsertSyncReaction(reaction *model.Reaction, rc *model.RemoteCluster) (*model.Reaction, error) {
 	savedReaction := reaction
 	var appErr *model.AppError
 
+	// a reaction changed here after the incoming change was made wins, unless the local copy
+	// came from the same remote which is then just sending its changes in order.
+	existing, err := scs.getSyncReaction(reaction)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.GetRemoteID() != rc.RemoteId && existing.UpdateAt > reaction.UpdateAt {
+		scs.onSyncConflict(rc)
+		scs.server.Log().Log(mlog.LvlSharedChannelServiceDebug, "Stale sync reaction ignored",
+			mlog.String("post_id", reaction.PostId),
+			mlog.String("user_id", reaction.UserId),
+			mlog.String("emoji", reaction.EmojiName),
+		)
+		return existing, nil
+	}
+
 	reaction.RemoteId = model.NewString(rc.RemoteId)
 
 	if reaction.DeleteAt == 0 {
 		savedReaction, appErr = scs.app.SaveReactionForPost(request.EmptyContext(scs.server.Log()), reaction)
-	} else {
+	} else if existing != nil && existing.DeleteAt == 0 {
 		appErr = scs.app.DeleteReactionForPost(request.EmptyContext(scs.server.Log()), reaction)
 	}
 
-	var err error
 	if appErr != nil {
 		err = errors.New(appErr.Error())
 	}
 	return savedReaction, err
 }
+
+// getSyncReaction returns the local copy of a reaction, including deleted ones, or nil if there is none.
+func (scs *Service) getSyncReaction(reaction *model.Reaction) (*model.Reaction, error) {
+	reactions, err := scs.server.GetStore().Reaction().GetForPostSince(reaction.PostId, 0, "", true)
+	if err != nil {
+		return nil, fmt.Errorf("error checking sync reaction: %w", err)
+	}
+	for _, r := range reactions {
+		if r.UserId == reaction.UserId && r.EmojiName == reaction.EmojiName {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+func (scs *Service) onSyncConflict(rc *model.RemoteCluster) {
+	if metrics := scs.server.GetMetrics(); metrics != nil {
+		metrics.IncrementSharedChannelsSyncConflictCounter(rc.RemoteId)
+	}
+}
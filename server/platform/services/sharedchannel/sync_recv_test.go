@@ -0,0 +1,246 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sharedchannel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/plugin/plugintest/mock"
+	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
+	einterfacesMocks "github.com/mattermost/mattermost-server/v6/server/channels/einterfaces/mocks"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store"
+	"github.com/mattermost/mattermost-server/v6/server/channels/store/storetest/mocks"
+	"github.com/mattermost/mattermost-server/v6/server/platform/services/remotecluster"
+	"github.com/mattermost/mattermost-server/v6/server/platform/shared/mlog"
+)
+
+func setupSyncRecvTest(t *testing.T) (*Service, *MockAppIface, *mocks.PostStore, *mocks.ReactionStore, *einterfacesMocks.MetricsInterface) {
+	mockServer := &MockServerIface{}
+	mockLogger, err := mlog.NewLogger()
+	require.NoError(t, err)
+	mockServer.On("Log").Return(mockLogger)
+
+	mockPostStore := &mocks.PostStore{}
+	mockReactionStore := &mocks.ReactionStore{}
+	mockStore := &mocks.Store{}
+	mockStore.On("Post").Return(mockPostStore)
+	mockStore.On("Reaction").Return(mockReactionStore)
+	mockServer.On("GetStore").Return(mockStore)
+
+	mockMetrics := &einterfacesMocks.MetricsInterface{}
+	mockServer.On("GetMetrics").Return(mockMetrics)
+
+	mockApp := &MockAppIface{}
+	scs := &Service{
+		server: mockServer,
+		app:    mockApp,
+	}
+	return scs, mockApp, mockPostStore, mockReactionStore, mockMetrics
+}
+
+func TestUpsertSyncPost(t *testing.T) {
+	rc := &model.RemoteCluster{RemoteId: model.NewId(), Name: "remote"}
+	channel := &model.Channel{Id: model.NewId(), Type: model.ChannelTypeOpen}
+
+	newPost := func() *model.Post {
+		return &model.Post{Id: model.NewId(), ChannelId: channel.Id, UserId: model.NewId(), Message: "hello", CreateAt: 1000, UpdateAt: 1000}
+	}
+
+	t.Run("deleted post that was never synced is not created", func(t *testing.T) {
+		scs, mockApp, mockPostStore, _, _ := setupSyncRecvTest(t)
+		post := newPost()
+		post.DeleteAt = 2000
+		mockPostStore.On("GetSingle", post.Id, true).Return(nil, store.NewErrNotFound("Post", post.Id))
+
+		_, err := scs.upsertSyncPost(post, channel, rc)
+		require.NoError(t, err)
+		mockApp.AssertNotCalled(t, "CreatePost", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("post deleted locally is not edited by the remote", func(t *testing.T) {
+		scs, mockApp, mockPostStore, _, mockMetrics := setupSyncRecvTest(t)
+		post := newPost()
+		post.Message = "edited"
+		post.EditAt = 3000
+		local := post.Clone()
+		local.Message = "hello"
+		local.DeleteAt = 2000
+		mockPostStore.On("GetSingle", post.Id, true).Return(local, nil)
+		mockMetrics.On("IncrementSharedChannelsSyncConflictCounter", rc.RemoteId).Once()
+
+		rpost, err := scs.upsertSyncPost(post, channel, rc)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", rpost.Message)
+		mockApp.AssertNotCalled(t, "UpdatePost", mock.Anything, mock.Anything, mock.Anything)
+		mockMetrics.AssertExpectations(t)
+	})
+
+	t.Run("stale edit of a local post is ignored", func(t *testing.T) {
+		scs, mockApp, mockPostStore, _, mockMetrics := setupSyncRecvTest(t)
+		post := newPost()
+		post.Message = "remote edit"
+		post.EditAt = 2000
+		local := post.Clone()
+		local.Message = "local edit"
+		local.EditAt = 3000
+		local.RemoteId = nil
+		mockPostStore.On("GetSingle", post.Id, true).Return(local, nil)
+		mockMetrics.On("IncrementSharedChannelsSyncConflictCounter", rc.RemoteId).Once()
+
+		rpost, err := scs.upsertSyncPost(post, channel, rc)
+		require.NoError(t, err)
+		assert.Equal(t, "local edit", rpost.Message)
+		mockApp.AssertNotCalled(t, "UpdatePost", mock.Anything, mock.Anything, mock.Anything)
+		mockMetrics.AssertExpectations(t)
+	})
+
+	t.Run("newer edit of a local post is applied", func(t *testing.T) {
+		scs, mockApp, mockPostStore, _, _ := setupSyncRecvTest(t)
+		post := newPost()
+		post.Message = "remote edit"
+		post.EditAt = 4000
+		local := post.Clone()
+		local.Message = "local edit"
+		local.EditAt = 3000
+		local.RemoteId = nil
+		mockPostStore.On("GetSingle", post.Id, true).Return(local, nil)
+		mockApp.On("UpdatePost", mock.Anything, post, false).Return(post, nil).Once()
+
+		rpost, err := scs.upsertSyncPost(post, channel, rc)
+		require.NoError(t, err)
+		assert.Equal(t, "remote edit", rpost.Message)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("edits from the remote the post originates from are applied", func(t *testing.T) {
+		scs, mockApp, mockPostStore, _, _ := setupSyncRecvTest(t)
+		post := newPost()
+		post.Message = "remote edit"
+		post.EditAt = 2000
+		local := post.Clone()
+		local.Message = "hello"
+		local.EditAt = 3000
+		local.RemoteId = model.NewString(rc.RemoteId)
+		mockPostStore.On("GetSingle", post.Id, true).Return(local, nil)
+		mockApp.On("UpdatePost", mock.Anything, post, false).Return(post, nil).Once()
+
+		_, err := scs.upsertSyncPost(post, channel, rc)
+		require.NoError(t, err)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("deletion wins over a local edit", func(t *testing.T) {
+		scs, mockApp, mockPostStore, _, _ := setupSyncRecvTest(t)
+		post := newPost()
+		post.DeleteAt = 2000
+		local := post.Clone()
+		local.DeleteAt = 0
+		local.EditAt = 3000
+		local.RemoteId = nil
+		mockPostStore.On("GetSingle", post.Id, true).Return(local, nil)
+		mockApp.On("DeletePost", mock.Anything, post.Id, post.UserId).Return(post, nil).Once()
+
+		_, err := scs.upsertSyncPost(post, channel, rc)
+		require.NoError(t, err)
+		mockApp.AssertExpectations(t)
+	})
+}
+
+func TestUpsertSyncReaction(t *testing.T) {
+	rc := &model.RemoteCluster{RemoteId: model.NewId(), Name: "remote"}
+
+	newReaction := func() *model.Reaction {
+		return &model.Reaction{PostId: model.NewId(), UserId: model.NewId(), EmojiName: "smile", CreateAt: 1000, UpdateAt: 2000}
+	}
+
+	t.Run("stale reaction is ignored", func(t *testing.T) {
+		scs, mockApp, _, mockReactionStore, mockMetrics := setupSyncRecvTest(t)
+		reaction := newReaction()
+		reaction.DeleteAt = 2000
+		local := *reaction
+		local.RemoteId = model.NewString("")
+		local.DeleteAt = 0
+		local.UpdateAt = 3000
+		mockReactionStore.On("GetForPostSince", reaction.PostId, int64(0), "", true).Return([]*model.Reaction{&local}, nil)
+		mockMetrics.On("IncrementSharedChannelsSyncConflictCounter", rc.RemoteId).Once()
+
+		_, err := scs.upsertSyncReaction(reaction, rc)
+		require.NoError(t, err)
+		mockApp.AssertNotCalled(t, "DeleteReactionForPost", mock.Anything, mock.Anything)
+		mockMetrics.AssertExpectations(t)
+	})
+
+	t.Run("changes from the same remote are applied in order", func(t *testing.T) {
+		scs, mockApp, _, mockReactionStore, _ := setupSyncRecvTest(t)
+		reaction := newReaction()
+		reaction.DeleteAt = 2000
+		local := *reaction
+		local.RemoteId = model.NewString(rc.RemoteId)
+		local.DeleteAt = 0
+		local.UpdateAt = 3000
+		mockReactionStore.On("GetForPostSince", reaction.PostId, int64(0), "", true).Return([]*model.Reaction{&local}, nil)
+		mockApp.On("DeleteReactionForPost", mock.Anything, reaction).Return(nil).Once()
+
+		_, err := scs.upsertSyncReaction(reaction, rc)
+		require.NoError(t, err)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("removing a reaction that doesn't exist does nothing", func(t *testing.T) {
+		scs, mockApp, _, mockReactionStore, _ := setupSyncRecvTest(t)
+		reaction := newReaction()
+		reaction.DeleteAt = 2000
+		mockReactionStore.On("GetForPostSince", reaction.PostId, int64(0), "", true).Return([]*model.Reaction{}, nil)
+
+		_, err := scs.upsertSyncReaction(reaction, rc)
+		require.NoError(t, err)
+		mockApp.AssertNotCalled(t, "DeleteReactionForPost", mock.Anything, mock.Anything)
+	})
+
+	t.Run("new reaction is saved", func(t *testing.T) {
+		scs, mockApp, _, mockReactionStore, _ := setupSyncRecvTest(t)
+		reaction := newReaction()
+		mockReactionStore.On("GetForPostSince", reaction.PostId, int64(0), "", true).Return([]*model.Reaction{}, nil)
+		mockApp.On("SaveReactionForPost", mock.Anything, reaction).Return(reaction, nil).Once()
+
+		saved, err := scs.upsertSyncReaction(reaction, rc)
+		require.NoError(t, err)
+		assert.Equal(t, rc.RemoteId, saved.GetRemoteID())
+		mockApp.AssertExpectations(t)
+	})
+}
+
+func TestProcessSyncMessageCreatesThreadRootsFirst(t *testing.T) {
+	scs, mockApp, mockPostStore, _, _ := setupSyncRecvTest(t)
+	rc := &model.RemoteCluster{RemoteId: model.NewId(), Name: "remote"}
+	channel := &model.Channel{Id: model.NewId(), Type: model.ChannelTypeDirect}
+
+	mockChannelStore := &mocks.ChannelStore{}
+	mockChannelStore.On("Get", channel.Id, true).Return(channel, nil)
+	scs.server.GetStore().(*mocks.Store).On("Channel").Return(mockChannelStore)
+
+	root := &model.Post{Id: model.NewId(), ChannelId: channel.Id, UserId: model.NewId(), Message: "root", UpdateAt: 1000}
+	reply := &model.Post{Id: model.NewId(), ChannelId: channel.Id, UserId: model.NewId(), RootId: root.Id, Message: "reply", UpdateAt: 1000}
+
+	var created []string
+	mockPostStore.On("GetSingle", mock.AnythingOfType("string"), true).Return(nil, store.NewErrNotFound("Post", ""))
+	mockApp.On("CreatePost", mock.Anything, mock.AnythingOfType("*model.Post"), channel, true, true).Return(
+		func(_ request.CTX, post *model.Post, _ *model.Channel, _ bool, _ bool) *model.Post {
+			created = append(created, post.Id)
+			return post
+		},
+		nil,
+	)
+
+	msg := newSyncMsg(channel.Id)
+	msg.Posts = []*model.Post{reply, root}
+
+	err := scs.processSyncMessage(request.EmptyContext(mlog.CreateConsoleTestLogger(true, mlog.LvlDebug)), msg, rc, &remotecluster.Response{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{root.Id, reply.Id}, created)
+}
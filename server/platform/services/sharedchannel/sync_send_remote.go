@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"sync"
 
 	"github.com/wiggin77/merror"
@@ -27,6 +28,7 @@ type attachment struct {
 type syncData struct {
 	task syncTask
 	rc   *model.RemoteCluster
+	sc   *model.SharedChannel
 	scr  *model.SharedChannelRemote
 
 	users         map[string]*model.User
@@ -39,10 +41,11 @@ type syncData struct {
 	resultNextCursor model.GetPostsSinceForSyncCursor
 }
 
-func newSyncData(task syncTask, rc *model.RemoteCluster, scr *model.SharedChannelRemote) *syncData {
+func newSyncData(task syncTask, rc *model.RemoteCluster, sc *model.SharedChannel, scr *model.SharedChannelRemote) *syncData {
 	return &syncData{
 		task:             task,
 		rc:               rc,
+		sc:               sc,
 		scr:              scr,
 		users:            make(map[string]*model.User),
 		profileImages:    make(map[string]*model.User),
@@ -69,6 +72,11 @@ func (scs *Service) syncForRemote(task syncTask, rc *model.RemoteCluster) error
 		return fmt.Errorf("cannot update remote cluster %s for channel id %s; Remote Cluster Service not enabled", rc.Name, task.channelID)
 	}
 
+	sc, err := scs.server.GetStore().SharedChannel().Get(task.channelID)
+	if err != nil {
+		return err
+	}
+
 	scr, err := scs.server.GetStore().SharedChannel().GetRemoteByIds(task.channelID, rc.RemoteId)
 	if err != nil {
 		return err
@@ -76,14 +84,14 @@ func (scs *Service) syncForRemote(task syncTask, rc *model.RemoteCluster) error
 
 	// if this is retrying a failed msg, just send it again.
 	if task.retryMsg != nil {
-		sd := newSyncData(task, rc, scr)
+		sd := newSyncData(task, rc, sc, scr)
 		sd.users = task.retryMsg.Users
 		sd.posts = task.retryMsg.Posts
 		sd.reactions = task.retryMsg.Reactions
 		return scs.sendSyncData(sd)
 	}
 
-	sd := newSyncData(task, rc, scr)
+	sd := newSyncData(task, rc, sc, scr)
 
 	// schedule another sync if the repeat flag is set at some point.
 	defer func(rpt *bool) {
@@ -251,11 +259,6 @@ func (scs *Service) fetchReactionsForSync(sd *syncData) error {
 
 // fetchPostUsersForSync populates the sync data with all users associated with posts.
 func (scs *Service) fetchPostUsersForSync(sd *syncData) error {
-	sc, err := scs.server.GetStore().SharedChannel().Get(sd.task.channelID)
-	if err != nil {
-		return fmt.Errorf("cannot determine teamID: %w", err)
-	}
-
 	type p2mm struct {
 		post       *model.Post
 		mentionMap model.UserMentionMap
@@ -272,7 +275,7 @@ func (scs *Service) fetchPostUsersForSync(sd *syncData) error {
 		userIDs[post.UserId] = p2mm{}
 
 		// get mentions and users for each mention
-		mentionMap := scs.app.MentionsToTeamMembers(request.EmptyContext(scs.server.Log()), post.Message, sc.TeamId)
+		mentionMap := scs.app.MentionsToTeamMembers(request.EmptyContext(scs.server.Log()), post.Message, sd.sc.TeamId)
 		for _, userID := range mentionMap {
 			userIDs[userID] = p2mm{
 				post:       post,
@@ -372,6 +375,10 @@ func (scs *Service) sendSyncData(sd *syncData) error {
 
 	sanitizeSyncData(sd)
 
+	if err := filterSyncData(sd); err != nil {
+		return fmt.Errorf("cannot apply shared channel filters: %w", err)
+	}
+
 	// send users
 	if len(sd.users) != 0 {
 		if err := scs.sendUserSyncData(sd); err != nil {
@@ -456,6 +463,16 @@ func (scs *Service) sendPostSyncData(sd *syncData) error {
 	msg.Posts = sd.posts
 
 	return scs.sendSyncMsgToRemote(msg, sd.rc, func(syncResp SyncResponse, errResp error) {
+		if errResp == nil {
+			oldest := sd.posts[0].UpdateAt
+			for _, p := range sd.posts {
+				if p.UpdateAt < oldest {
+					oldest = p.UpdateAt
+				}
+			}
+			scs.observeSyncLag(sd.rc, oldest)
+		}
+
 		if len(syncResp.PostErrors) != 0 {
 			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Response indicates error for post(s) sync",
 				mlog.String("channel_id", sd.task.channelID),
@@ -477,6 +494,16 @@ func (scs *Service) sendReactionSyncData(sd *syncData) error {
 	msg.Reactions = sd.reactions
 
 	return scs.sendSyncMsgToRemote(msg, sd.rc, func(syncResp SyncResponse, errResp error) {
+		if errResp == nil {
+			oldest := sd.reactions[0].UpdateAt
+			for _, r := range sd.reactions {
+				if r.UpdateAt < oldest {
+					oldest = r.UpdateAt
+				}
+			}
+			scs.observeSyncLag(sd.rc, oldest)
+		}
+
 		if len(syncResp.ReactionErrors) != 0 {
 			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Response indicates error for reactions(s) sync",
 				mlog.String("channel_id", sd.task.channelID),
@@ -487,6 +514,14 @@ func (scs *Service) sendReactionSyncData(sd *syncData) error {
 	})
 }
 
+// observeSyncLag records the time between the oldest change in a sync message and the
+// remote cluster acknowledging it.
+func (scs *Service) observeSyncLag(rc *model.RemoteCluster, updateAt int64) {
+	if metrics := scs.server.GetMetrics(); metrics != nil {
+		metrics.ObserveSharedChannelsSyncLag(rc.RemoteId, float64(model.GetMillis()-updateAt)/1000)
+	}
+}
+
 // sendProfileImageSyncData sends the collected user profile image updates to the remote cluster.
 func (scs *Service) sendProfileImageSyncData(sd *syncData) {
 	for _, user := range sd.profileImages {
@@ -543,3 +578,39 @@ func sanitizeSyncData(sd *syncData) {
 		sd.profileImages[id] = sanitizeUserForSync(user)
 	}
 }
+
+// filterSyncData applies the content filters of the shared channel to the posts about to be sent.
+// Patterns are validated when saved; should one still fail to compile nothing is sent, rather than
+// sending content that should have been redacted.
+func filterSyncData(sd *syncData) error {
+	if !sd.sc.HasFilters() {
+		return nil
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(sd.sc.RedactPatterns))
+	for _, pattern := range sd.sc.RedactPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid redact pattern %q: %w", pattern, err)
+		}
+		patterns = append(patterns, re)
+	}
+
+	for _, p := range sd.posts {
+		for _, re := range patterns {
+			p.Message = re.ReplaceAllLiteralString(p.Message, model.SharedChannelRedactedText)
+		}
+
+		if sd.sc.StripAttachments {
+			p.FileIds = nil
+			if p.Metadata != nil {
+				p.Metadata.Files = nil
+			}
+		}
+	}
+
+	if sd.sc.StripAttachments {
+		sd.attachments = nil
+	}
+	return nil
+}
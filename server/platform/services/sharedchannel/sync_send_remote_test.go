@@ -0,0 +1,51 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sharedchannel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestFilterSyncData(t *testing.T) {
+	newSd := func(sc *model.SharedChannel) *syncData {
+		post := &model.Post{Id: model.NewId(), Message: "call 555-1234 about secret plans", FileIds: model.StringArray{model.NewId()}}
+		sd := newSyncData(syncTask{}, &model.RemoteCluster{}, sc, &model.SharedChannelRemote{})
+		sd.posts = []*model.Post{post}
+		sd.attachments = []attachment{{fi: &model.FileInfo{Id: post.FileIds[0]}, post: post}}
+		return sd
+	}
+
+	t.Run("no filters", func(t *testing.T) {
+		sd := newSd(&model.SharedChannel{})
+		require.NoError(t, filterSyncData(sd))
+		assert.Equal(t, "call 555-1234 about secret plans", sd.posts[0].Message)
+		assert.Len(t, sd.posts[0].FileIds, 1)
+		assert.Len(t, sd.attachments, 1)
+	})
+
+	t.Run("redact patterns", func(t *testing.T) {
+		sd := newSd(&model.SharedChannel{RedactPatterns: model.StringArray{`\d{3}-\d{4}`, "(?i)SECRET"}})
+		require.NoError(t, filterSyncData(sd))
+		assert.Equal(t, "call [redacted] about [redacted] plans", sd.posts[0].Message)
+		assert.Len(t, sd.attachments, 1)
+	})
+
+	t.Run("strip attachments", func(t *testing.T) {
+		sd := newSd(&model.SharedChannel{StripAttachments: true})
+		require.NoError(t, filterSyncData(sd))
+		assert.Equal(t, "call 555-1234 about secret plans", sd.posts[0].Message)
+		assert.Empty(t, sd.posts[0].FileIds)
+		assert.Empty(t, sd.attachments)
+	})
+
+	t.Run("invalid pattern sends nothing", func(t *testing.T) {
+		sd := newSd(&model.SharedChannel{RedactPatterns: model.StringArray{"(unclosed"}})
+		require.Error(t, filterSyncData(sd))
+	})
+}
@@ -9,7 +9,6 @@ import (
 	"errors"
 	"image"
 	"io"
-	"mime/multipart"
 	"net/http"
 	"path/filepath"
 	"regexp"
@@ -71,12 +70,19 @@ type slackPost struct {
 	File        *slackFile               `json:"file"`
 	Files       []*slackFile             `json:"files"`
 	Attachments []*model.SlackAttachment `json:"attachments"`
+	Reactions   []*slackReaction         `json:"reactions"`
+	PinnedTo    []string                 `json:"pinned_to"`
 }
 
 var isValidChannelNameCharacters = regexp.MustCompile(`^[a-zA-Z0-9\-_]+$`).MatchString
 
 const slackImportMaxFileSize = 1024 * 1024 * 70
 
+type slackReaction struct {
+	Name  string   `json:"name"`
+	Users []string `json:"users"`
+}
+
 type slackComment struct {
 	User    string `json:"user"`
 	Comment string `json:"comment"`
@@ -96,6 +102,8 @@ type Actions struct {
 	InvalidateAllCaches    func()
 	MaxPostSize            func() int
 	PrepareImage           func(fileData []byte) (image.Image, string, func(), error)
+	// ReportProgress, when set, is called with the percentage of the channels imported so far.
+	ReportProgress func(int)
 }
 
 // SlackImporter is a service that allows to import slack dumps into mattermost
@@ -115,7 +123,7 @@ func New(store store.Store, actions Actions, config *model.Config) *SlackImporte
 	}
 }
 
-func (si *SlackImporter) SlackImport(c request.CTX, fileData multipart.File, fileSize int64, teamID string) (*model.AppError, *bytes.Buffer) {
+func (si *SlackImporter) SlackImport(c request.CTX, fileData io.ReaderAt, fileSize int64, teamID string) (*model.AppError, *bytes.Buffer) {
 	// Create log file
 	log := bytes.NewBufferString(i18n.T("api.slackimport.slack_import.log"))
 
@@ -336,6 +344,7 @@ func (si *SlackImporter) slackAddPosts(teamId string, channel *model.Channel, po
 				ChannelId: channel.Id,
 				Message:   sPost.Text,
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+				IsPinned:  len(sPost.PinnedTo) > 0,
 			}
 			if sPost.Upload {
 				if sPost.File != nil {
@@ -350,11 +359,9 @@ func (si *SlackImporter) slackAddPosts(teamId string, channel *model.Channel, po
 					}
 				}
 			}
-			// If post in thread
-			if sPost.ThreadTS != "" && sPost.ThreadTS != sPost.TimeStamp {
-				newPost.RootId = threads[sPost.ThreadTS]
-			}
+			newPost.RootId = slackThreadRootId(sPost, threads)
 			postId := si.oldImportPost(&newPost)
+			si.slackAddReactions(postId, newPost.CreateAt, sPost.Reactions, users)
 			// If post is thread starter
 			if sPost.ThreadTS == sPost.TimeStamp {
 				threads[sPost.ThreadTS] = postId
@@ -401,9 +408,12 @@ func (si *SlackImporter) slackAddPosts(teamId string, channel *model.Channel, po
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
 				Message:   sPost.Text,
 				Type:      model.PostTypeSlackAttachment,
+				IsPinned:  len(sPost.PinnedTo) > 0,
+				RootId:    slackThreadRootId(sPost, threads),
 			}
 
 			postId := si.oldImportIncomingWebhookPost(post, props)
+			si.slackAddReactions(postId, post.CreateAt, sPost.Reactions, users)
 			// If post is thread starter
 			if sPost.ThreadTS == sPost.TimeStamp {
 				threads[sPost.ThreadTS] = postId
@@ -450,8 +460,11 @@ func (si *SlackImporter) slackAddPosts(teamId string, channel *model.Channel, po
 				ChannelId: channel.Id,
 				Message:   "*" + sPost.Text + "*",
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+				IsPinned:  len(sPost.PinnedTo) > 0,
+				RootId:    slackThreadRootId(sPost, threads),
 			}
 			postId := si.oldImportPost(&newPost)
+			si.slackAddReactions(postId, newPost.CreateAt, sPost.Reactions, users)
 			// If post is thread starter
 			if sPost.ThreadTS == sPost.TimeStamp {
 				threads[sPost.ThreadTS] = postId
@@ -517,6 +530,41 @@ func (si *SlackImporter) slackAddPosts(teamId string, channel *model.Channel, po
 	}
 }
 
+// slackThreadRootId returns the id of the post starting the thread the Slack post is a reply to, if
+// it has been imported.
+func slackThreadRootId(sPost slackPost, threads map[string]string) string {
+	if sPost.ThreadTS == "" || sPost.ThreadTS == sPost.TimeStamp {
+		return ""
+	}
+	return threads[sPost.ThreadTS]
+}
+
+func (si *SlackImporter) slackAddReactions(postId string, createAt int64, reactions []*slackReaction, users map[string]*model.User) {
+	if postId == "" {
+		return
+	}
+
+	for _, sReaction := range reactions {
+		// Slack appends the skin tone to the name of the emoji, e.g. "thumbsup::skin-tone-2".
+		emojiName, _, _ := strings.Cut(sReaction.Name, "::")
+		for _, sUser := range sReaction.Users {
+			if users[sUser] == nil {
+				mlog.Debug("Slack Import: Unable to add the reaction as the Slack user does not exist in Mattermost.", mlog.String("user", sUser))
+				continue
+			}
+			reaction := &model.Reaction{
+				UserId:    users[sUser].Id,
+				PostId:    postId,
+				EmojiName: emojiName,
+				CreateAt:  createAt,
+			}
+			if _, err := si.store.Reaction().Save(reaction); err != nil {
+				mlog.Debug("Slack Import: Unable to add the reaction.", mlog.String("post_id", postId), mlog.String("emoji_name", emojiName), mlog.Err(err))
+			}
+		}
+	}
+}
+
 func (si *SlackImporter) slackUploadFile(slackPostFile *slackFile, uploads map[string]*zip.File, teamId string, channelId string, userId string, slackTimestamp string) (*model.FileInfo, bool) {
 	if slackPostFile == nil {
 		mlog.Warn("Slack Import: Unable to attach the file to the post as the latter has no file section present in Slack export.")
@@ -593,7 +641,11 @@ func (si *SlackImporter) slackAddChannels(c request.CTX, teamId string, slackcha
 	importerLog.WriteString("=================\r\n\r\n")
 
 	addedChannels := make(map[string]*model.Channel)
-	for _, sChannel := range slackchannels {
+	for i, sChannel := range slackchannels {
+		if si.actions.ReportProgress != nil && i > 0 {
+			si.actions.ReportProgress(i * 100 / len(slackchannels))
+		}
+
 		newChannel := model.Channel{
 			TeamId:      teamId,
 			Type:        sChannel.Type,
@@ -652,6 +704,7 @@ func (si *SlackImporter) slackAddChannels(c request.CTX, teamId string, slackcha
 func (si *SlackImporter) oldImportPost(post *model.Post) string {
 	// Workaround for empty messages, which may be the case if they are webhook posts.
 	firstIteration := true
+	importedPostId := ""
 	firstPostId := ""
 	if post.RootId != "" {
 		firstPostId = post.RootId
@@ -676,6 +729,7 @@ func (si *SlackImporter) oldImportPost(post *model.Post) string {
 		}
 
 		if firstIteration {
+			importedPostId = post.Id
 			if firstPostId == "" {
 				firstPostId = post.Id
 			}
@@ -691,6 +745,7 @@ func (si *SlackImporter) oldImportPost(post *model.Post) string {
 				}
 			}
 			post.FileIds = nil
+			post.IsPinned = false
 		}
 
 		post.Id = ""
@@ -698,7 +753,7 @@ func (si *SlackImporter) oldImportPost(post *model.Post) string {
 		post.Message = remainder
 		firstIteration = false
 	}
-	return firstPostId
+	return importedPostId
 }
 
 func (si *SlackImporter) oldImportUser(team *model.Team, user *model.User) *model.User {
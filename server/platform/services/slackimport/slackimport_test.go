@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -196,6 +197,51 @@ func TestSlackParseMultipleAttachments(t *testing.T) {
 	assert.Equal(t, 2, len(posts[8].Files))
 }
 
+func TestSlackParseReactionsAndPins(t *testing.T) {
+	posts, err := slackParsePosts(strings.NewReader(`[{
+		"type": "message",
+		"user": "U1",
+		"text": "hello",
+		"ts": "1469785419.000033",
+		"pinned_to": ["C1"],
+		"reactions": [{"name": "thumbsup::skin-tone-2", "users": ["U1", "U2"], "count": 2}]
+	}]`))
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, []string{"C1"}, posts[0].PinnedTo)
+	require.Len(t, posts[0].Reactions, 1)
+	assert.Equal(t, "thumbsup::skin-tone-2", posts[0].Reactions[0].Name)
+	assert.Equal(t, []string{"U1", "U2"}, posts[0].Reactions[0].Users)
+}
+
+func TestSlackThreadRootId(t *testing.T) {
+	threads := map[string]string{"1.0": "rootid"}
+
+	assert.Equal(t, "", slackThreadRootId(slackPost{TimeStamp: "1.0"}, threads))
+	assert.Equal(t, "", slackThreadRootId(slackPost{TimeStamp: "1.0", ThreadTS: "1.0"}, threads))
+	assert.Equal(t, "rootid", slackThreadRootId(slackPost{TimeStamp: "2.0", ThreadTS: "1.0"}, threads))
+	assert.Equal(t, "", slackThreadRootId(slackPost{TimeStamp: "2.0", ThreadTS: "0.5"}, threads))
+}
+
+func TestSlackAddReactions(t *testing.T) {
+	user := &model.User{Id: model.NewId()}
+	postID := model.NewId()
+
+	reactionStore := &mocks.ReactionStore{}
+	reactionStore.On("Save", mock.MatchedBy(func(reaction *model.Reaction) bool {
+		return reaction.UserId == user.Id && reaction.PostId == postID && reaction.EmojiName == "thumbsup" && reaction.CreateAt == 1234
+	})).Return(&model.Reaction{}, nil).Once()
+	store := &mocks.Store{}
+	store.On("Reaction").Return(reactionStore)
+
+	importer := New(store, Actions{}, &model.Config{})
+	importer.slackAddReactions(postID, 1234, []*slackReaction{
+		{Name: "thumbsup::skin-tone-2", Users: []string{"U1", "unknown"}},
+	}, map[string]*model.User{"U1": user})
+
+	reactionStore.AssertExpectations(t)
+}
+
 func TestSlackSanitiseChannelProperties(t *testing.T) {
 	c1 := model.Channel{
 		DisplayName: "display-name",
@@ -70,6 +70,14 @@ const (
 	TrackConfigImageProxy        = "config_image_proxy"
 	TrackConfigBleve             = "config_bleve"
 	TrackConfigExport            = "config_export"
+	TrackConfigTranslation       = "config_translation"
+	TrackConfigSemanticSearch    = "config_semantic_search"
+	TrackConfigColdStorage       = "config_cold_storage"
+	TrackConfigMatrixBridge      = "config_matrix_bridge"
+	TrackConfigOffboarding       = "config_offboarding"
+	TrackConfigSecrets           = "config_secrets"
+	TrackConfigCache             = "config_cache"
+	TrackConfigIPAccess          = "config_ip_access"
 	TrackFeatureFlags            = "config_feature_flags"
 	TrackConfigProducts          = "products"
 	TrackPermissionsGeneral      = "permissions_general"
@@ -396,6 +404,7 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_insecure_outgoing_connections":                    *cfg.ServiceSettings.EnableInsecureOutgoingConnections,
 		"enable_incoming_webhooks":                                cfg.ServiceSettings.EnableIncomingWebhooks,
 		"enable_outgoing_webhooks":                                cfg.ServiceSettings.EnableOutgoingWebhooks,
+		"enable_direct_outgoing_webhooks":                         *cfg.ServiceSettings.EnableDirectOutgoingWebhooks,
 		"enable_commands":                                         *cfg.ServiceSettings.EnableCommands,
 		"enable_post_username_override":                           cfg.ServiceSettings.EnablePostUsernameOverride,
 		"enable_post_icon_override":                               cfg.ServiceSettings.EnablePostIconOverride,
@@ -412,7 +421,10 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_client_performance_debugging":                     *cfg.ServiceSettings.EnableClientPerformanceDebugging,
 		"enable_multifactor_authentication":                       *cfg.ServiceSettings.EnableMultifactorAuthentication,
 		"enforce_multifactor_authentication":                      *cfg.ServiceSettings.EnforceMultifactorAuthentication,
+		"enable_webauthn":                                         *cfg.WebAuthnSettings.Enable,
+		"enable_passkey_login":                                    *cfg.WebAuthnSettings.EnablePasskeyLogin,
 		"enable_oauth_service_provider":                           cfg.ServiceSettings.EnableOAuthServiceProvider,
+		"enable_oauth_service_provider_oauth21":                   *cfg.ServiceSettings.EnableOAuthServiceProviderOAuth21,
 		"connection_security":                                     *cfg.ServiceSettings.ConnectionSecurity,
 		"tls_strict_transport":                                    *cfg.ServiceSettings.TLSStrictTransport,
 		"uses_letsencrypt":                                        *cfg.ServiceSettings.UseLetsEncrypt,
@@ -477,6 +489,24 @@ func (ts *TelemetryService) trackConfig() {
 		"self_hosted_purchase":                                    *cfg.ServiceSettings.SelfHostedPurchase,
 		"allow_synced_drafts":                                     *cfg.ServiceSettings.AllowSyncedDrafts,
 		"self_hosted_expansion":                                   *cfg.ServiceSettings.SelfHostedExpansion,
+		"enable_scheduled_posts":                                  *cfg.ServiceSettings.EnableScheduledPosts,
+		"enable_read_receipts":                                    *cfg.ServiceSettings.EnableReadReceipts,
+		"read_receipts_max_channel_members":                       *cfg.ServiceSettings.ReadReceiptsMaxChannelMembers,
+		"outgoing_integrations_request_timeout_seconds":           *cfg.ServiceSettings.OutgoingIntegrationsRequestTimeoutSeconds,
+		"outgoing_integrations_max_retries":                       *cfg.ServiceSettings.OutgoingIntegrationsMaxRetries,
+		"outgoing_integrations_circuit_breaker_threshold":         *cfg.ServiceSettings.OutgoingIntegrationsCircuitBreakerThreshold,
+		"outgoing_integrations_circuit_breaker_cooldown_seconds":  *cfg.ServiceSettings.OutgoingIntegrationsCircuitBreakerCooldownSeconds,
+		"enable_websocket_compression":                            *cfg.ServiceSettings.EnableWebSocketCompression,
+		"typing_aggregation_member_threshold":                     *cfg.ServiceSettings.TypingAggregationMemberThreshold,
+		"typing_aggregation_interval_milliseconds":                *cfg.ServiceSettings.TypingAggregationIntervalMilliseconds,
+		"enable_expiring_posts":                                   *cfg.ServiceSettings.EnableExpiringPosts,
+		"expiring_posts_max_seconds":                              *cfg.ServiceSettings.ExpiringPostsMaxSeconds,
+		"enable_encrypted_messages":                               *cfg.ServiceSettings.EnableEncryptedMessages,
+		"encrypted_message_max_size":                              *cfg.ServiceSettings.EncryptedMessageMaxSize,
+		"enable_activity_feed":                                    *cfg.ServiceSettings.EnableActivityFeed,
+		"enable_graphql":                                          *cfg.ServiceSettings.EnableGraphQL,
+		"graphql_max_complexity":                                  *cfg.ServiceSettings.GraphQLMaxComplexity,
+		"graphql_persisted_queries_only":                          *cfg.ServiceSettings.GraphQLPersistedQueriesOnly,
 	})
 
 	ts.SendTelemetry(TrackConfigTeam, map[string]any{
@@ -484,6 +514,7 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_open_server":                      *cfg.TeamSettings.EnableOpenServer,
 		"enable_user_deactivation":                *cfg.TeamSettings.EnableUserDeactivation,
 		"enable_custom_user_statuses":             *cfg.TeamSettings.EnableCustomUserStatuses,
+		"enable_calendar_status_sync":             *cfg.TeamSettings.EnableCalendarStatusSync,
 		"enable_last_active_time":                 *cfg.TeamSettings.EnableLastActiveTime,
 		"enable_custom_brand":                     *cfg.TeamSettings.EnableCustomBrand,
 		"restrict_direct_message":                 *cfg.TeamSettings.RestrictDirectMessage,
@@ -520,8 +551,12 @@ func (ts *TelemetryService) trackConfig() {
 		"data_source_replicas":                 len(cfg.SqlSettings.DataSourceReplicas),
 		"data_source_search_replicas":          len(cfg.SqlSettings.DataSourceSearchReplicas),
 		"query_timeout":                        *cfg.SqlSettings.QueryTimeout,
+		"search_query_timeout":                 *cfg.SqlSettings.SearchQueryTimeout,
+		"analytics_query_timeout":              *cfg.SqlSettings.AnalyticsQueryTimeout,
+		"slow_query_threshold_milliseconds":    *cfg.SqlSettings.SlowQueryThresholdMilliseconds,
 		"disable_database_search":              *cfg.SqlSettings.DisableDatabaseSearch,
 		"migrations_statement_timeout_seconds": *cfg.SqlSettings.MigrationsStatementTimeoutSeconds,
+		"partitions_precreate_months":          *cfg.SqlSettings.PartitionsPrecreateMonths,
 	})
 
 	ts.SendTelemetry(TrackConfigLog, map[string]any{
@@ -566,22 +601,24 @@ func (ts *TelemetryService) trackConfig() {
 	})
 
 	ts.SendTelemetry(TrackConfigFile, map[string]any{
-		"enable_public_links":           cfg.FileSettings.EnablePublicLink,
-		"driver_name":                   *cfg.FileSettings.DriverName,
-		"isdefault_directory":           isDefault(*cfg.FileSettings.Directory, model.FileSettingsDefaultDirectory),
-		"isabsolute_directory":          filepath.IsAbs(*cfg.FileSettings.Directory),
-		"extract_content":               *cfg.FileSettings.ExtractContent,
-		"archive_recursion":             *cfg.FileSettings.ArchiveRecursion,
-		"amazon_s3_ssl":                 *cfg.FileSettings.AmazonS3SSL,
-		"amazon_s3_sse":                 *cfg.FileSettings.AmazonS3SSE,
-		"amazon_s3_signv2":              *cfg.FileSettings.AmazonS3SignV2,
-		"amazon_s3_trace":               *cfg.FileSettings.AmazonS3Trace,
-		"max_file_size":                 *cfg.FileSettings.MaxFileSize,
-		"max_image_resolution":          *cfg.FileSettings.MaxImageResolution,
-		"max_image_decoder_concurrency": *cfg.FileSettings.MaxImageDecoderConcurrency,
-		"enable_file_attachments":       *cfg.FileSettings.EnableFileAttachments,
-		"enable_mobile_upload":          *cfg.FileSettings.EnableMobileUpload,
-		"enable_mobile_download":        *cfg.FileSettings.EnableMobileDownload,
+		"enable_public_links":                cfg.FileSettings.EnablePublicLink,
+		"driver_name":                        *cfg.FileSettings.DriverName,
+		"isdefault_directory":                isDefault(*cfg.FileSettings.Directory, model.FileSettingsDefaultDirectory),
+		"isabsolute_directory":               filepath.IsAbs(*cfg.FileSettings.Directory),
+		"extract_content":                    *cfg.FileSettings.ExtractContent,
+		"archive_recursion":                  *cfg.FileSettings.ArchiveRecursion,
+		"amazon_s3_ssl":                      *cfg.FileSettings.AmazonS3SSL,
+		"amazon_s3_sse":                      *cfg.FileSettings.AmazonS3SSE,
+		"isdefault_amazon_s3_sse_kms_key_id": isDefault(*cfg.FileSettings.AmazonS3SSEKMSKeyId, ""),
+		"amazon_s3_object_tagging":           *cfg.FileSettings.AmazonS3ObjectTagging,
+		"amazon_s3_signv2":                   *cfg.FileSettings.AmazonS3SignV2,
+		"amazon_s3_trace":                    *cfg.FileSettings.AmazonS3Trace,
+		"max_file_size":                      *cfg.FileSettings.MaxFileSize,
+		"max_image_resolution":               *cfg.FileSettings.MaxImageResolution,
+		"max_image_decoder_concurrency":      *cfg.FileSettings.MaxImageDecoderConcurrency,
+		"enable_file_attachments":            *cfg.FileSettings.EnableFileAttachments,
+		"enable_mobile_upload":               *cfg.FileSettings.EnableMobileUpload,
+		"enable_mobile_download":             *cfg.FileSettings.EnableMobileDownload,
 	})
 
 	ts.SendTelemetry(TrackConfigEmail, map[string]any{
@@ -642,6 +679,8 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_office365": cfg.Office365Settings.Enable,
 		"openid_office365": *cfg.Office365Settings.Enable && strings.Contains(*cfg.Office365Settings.Scope, model.ServiceOpenid),
 		"enable_openid":    cfg.OpenIdSettings.Enable,
+		"enable_oidc":      cfg.OIDCSettings.Enable,
+		"oidc_groups":      *cfg.OIDCSettings.Enable && *cfg.OIDCSettings.GroupsClaim != "",
 	})
 
 	ts.SendTelemetry(TrackConfigSupport, map[string]any{
@@ -764,6 +803,7 @@ func (ts *TelemetryService) trackConfig() {
 
 	ts.SendTelemetry(TrackConfigAnalytics, map[string]any{
 		"isdefault_max_users_for_statistics": isDefault(*cfg.AnalyticsSettings.MaxUsersForStatistics, model.AnalyticsSettingsDefaultMaxUsersForStatistics),
+		"enable_reaction_summaries":          *cfg.AnalyticsSettings.EnableReactionSummaries,
 	})
 
 	ts.SendTelemetry(TrackConfigAnnouncement, map[string]any{
@@ -776,28 +816,34 @@ func (ts *TelemetryService) trackConfig() {
 	})
 
 	ts.SendTelemetry(TrackConfigElasticsearch, map[string]any{
-		"isdefault_connection_url": isDefault(*cfg.ElasticsearchSettings.ConnectionURL, model.ElasticsearchSettingsDefaultConnectionURL),
-		"isdefault_username":       isDefault(*cfg.ElasticsearchSettings.Username, model.ElasticsearchSettingsDefaultUsername),
-		"isdefault_password":       isDefault(*cfg.ElasticsearchSettings.Password, model.ElasticsearchSettingsDefaultPassword),
-		"enable_indexing":          *cfg.ElasticsearchSettings.EnableIndexing,
-		"enable_searching":         *cfg.ElasticsearchSettings.EnableSearching,
-		"enable_autocomplete":      *cfg.ElasticsearchSettings.EnableAutocomplete,
-		"sniff":                    *cfg.ElasticsearchSettings.Sniff,
-		"post_index_replicas":      *cfg.ElasticsearchSettings.PostIndexReplicas,
-		"post_index_shards":        *cfg.ElasticsearchSettings.PostIndexShards,
-		"channel_index_replicas":   *cfg.ElasticsearchSettings.ChannelIndexReplicas,
-		"channel_index_shards":     *cfg.ElasticsearchSettings.ChannelIndexShards,
-		"user_index_replicas":      *cfg.ElasticsearchSettings.UserIndexReplicas,
-		"user_index_shards":        *cfg.ElasticsearchSettings.UserIndexShards,
-		"isdefault_index_prefix":   isDefault(*cfg.ElasticsearchSettings.IndexPrefix, model.ElasticsearchSettingsDefaultIndexPrefix),
-		"live_indexing_batch_size": *cfg.ElasticsearchSettings.LiveIndexingBatchSize,
-		"bulk_indexing_batch_size": *cfg.ElasticsearchSettings.BatchSize,
-		"request_timeout_seconds":  *cfg.ElasticsearchSettings.RequestTimeoutSeconds,
-		"skip_tls_verification":    *cfg.ElasticsearchSettings.SkipTLSVerification,
-		"isdefault_ca":             isDefault(*cfg.ElasticsearchSettings.CA, ""),
-		"isdefault_client_cert":    isDefault(*cfg.ElasticsearchSettings.ClientCert, ""),
-		"isdefault_client_key":     isDefault(*cfg.ElasticsearchSettings.ClientKey, ""),
-		"trace":                    *cfg.ElasticsearchSettings.Trace,
+		"isdefault_connection_url":    isDefault(*cfg.ElasticsearchSettings.ConnectionURL, model.ElasticsearchSettingsDefaultConnectionURL),
+		"isdefault_username":          isDefault(*cfg.ElasticsearchSettings.Username, model.ElasticsearchSettingsDefaultUsername),
+		"isdefault_password":          isDefault(*cfg.ElasticsearchSettings.Password, model.ElasticsearchSettingsDefaultPassword),
+		"enable_indexing":             *cfg.ElasticsearchSettings.EnableIndexing,
+		"enable_searching":            *cfg.ElasticsearchSettings.EnableSearching,
+		"enable_autocomplete":         *cfg.ElasticsearchSettings.EnableAutocomplete,
+		"sniff":                       *cfg.ElasticsearchSettings.Sniff,
+		"post_index_replicas":         *cfg.ElasticsearchSettings.PostIndexReplicas,
+		"post_index_shards":           *cfg.ElasticsearchSettings.PostIndexShards,
+		"channel_index_replicas":      *cfg.ElasticsearchSettings.ChannelIndexReplicas,
+		"channel_index_shards":        *cfg.ElasticsearchSettings.ChannelIndexShards,
+		"user_index_replicas":         *cfg.ElasticsearchSettings.UserIndexReplicas,
+		"user_index_shards":           *cfg.ElasticsearchSettings.UserIndexShards,
+		"file_index_replicas":         *cfg.ElasticsearchSettings.FileIndexReplicas,
+		"file_index_shards":           *cfg.ElasticsearchSettings.FileIndexShards,
+		"isdefault_index_prefix":      isDefault(*cfg.ElasticsearchSettings.IndexPrefix, model.ElasticsearchSettingsDefaultIndexPrefix),
+		"live_indexing_batch_size":    *cfg.ElasticsearchSettings.LiveIndexingBatchSize,
+		"bulk_indexing_batch_size":    *cfg.ElasticsearchSettings.BatchSize,
+		"request_timeout_seconds":     *cfg.ElasticsearchSettings.RequestTimeoutSeconds,
+		"skip_tls_verification":       *cfg.ElasticsearchSettings.SkipTLSVerification,
+		"isdefault_ca":                isDefault(*cfg.ElasticsearchSettings.CA, ""),
+		"isdefault_client_cert":       isDefault(*cfg.ElasticsearchSettings.ClientCert, ""),
+		"isdefault_client_key":        isDefault(*cfg.ElasticsearchSettings.ClientKey, ""),
+		"trace":                       *cfg.ElasticsearchSettings.Trace,
+		"backend":                     *cfg.ElasticsearchSettings.Backend,
+		"enable_lifecycle_management": *cfg.ElasticsearchSettings.EnableLifecycleManagement,
+		"rollover_max_size_gb":        *cfg.ElasticsearchSettings.RolloverMaxSizeGB,
+		"rollover_max_age_days":       *cfg.ElasticsearchSettings.RolloverMaxAgeDays,
 	})
 
 	ts.trackPluginConfig(cfg, model.PluginSettingsDefaultMarketplaceURL)
@@ -839,6 +885,7 @@ func (ts *TelemetryService) trackConfig() {
 		"allow_email_accounts":                   *cfg.GuestAccountsSettings.AllowEmailAccounts,
 		"enforce_multifactor_authentication":     *cfg.GuestAccountsSettings.EnforceMultifactorAuthentication,
 		"isdefault_restrict_creation_to_domains": isDefault(*cfg.GuestAccountsSettings.RestrictCreationToDomains, ""),
+		"default_expiration_days":                *cfg.GuestAccountsSettings.DefaultExpirationDays,
 	})
 
 	ts.SendTelemetry(TrackConfigImageProxy, map[string]any{
@@ -856,7 +903,64 @@ func (ts *TelemetryService) trackConfig() {
 	})
 
 	ts.SendTelemetry(TrackConfigExport, map[string]any{
-		"retention_days": *cfg.ExportSettings.RetentionDays,
+		"retention_days":      *cfg.ExportSettings.RetentionDays,
+		"upload_part_size_mb": *cfg.ExportSettings.UploadPartSizeMB,
+	})
+
+	ts.SendTelemetry(TrackConfigTranslation, map[string]any{
+		"enable":                 *cfg.TranslationSettings.Enable,
+		"provider":               *cfg.TranslationSettings.Provider,
+		"isdefault_api_url":      isDefault(*cfg.TranslationSettings.APIURL, ""),
+		"isdefault_azure_region": isDefault(*cfg.TranslationSettings.AzureRegion, ""),
+		"store_translations":     *cfg.TranslationSettings.StoreTranslations,
+	})
+
+	ts.SendTelemetry(TrackConfigSemanticSearch, map[string]any{
+		"enable_indexing":         *cfg.SemanticSearchSettings.EnableIndexing,
+		"enable_searching":        *cfg.SemanticSearchSettings.EnableSearching,
+		"embedding_provider":      *cfg.SemanticSearchSettings.EmbeddingProvider,
+		"isdefault_api_url":       isDefault(*cfg.SemanticSearchSettings.EmbeddingAPIURL, ""),
+		"embedding_model":         *cfg.SemanticSearchSettings.EmbeddingModel,
+		"vector_store":            *cfg.SemanticSearchSettings.VectorStore,
+		"indexing_batch_size":     *cfg.SemanticSearchSettings.IndexingBatchSize,
+		"semantic_weight_percent": *cfg.SemanticSearchSettings.SemanticWeightPercent,
+	})
+
+	ts.SendTelemetry(TrackConfigColdStorage, map[string]any{
+		"enable":                  *cfg.ColdStorageSettings.Enable,
+		"after_days":              *cfg.ColdStorageSettings.AfterDays,
+		"posts_after_days":        *cfg.ColdStorageSettings.PostsAfterDays,
+		"driver_name":             *cfg.ColdStorageSettings.DriverName,
+		"amazon_s3_ssl":           *cfg.ColdStorageSettings.AmazonS3SSL,
+		"amazon_s3_storage_class": *cfg.ColdStorageSettings.AmazonS3StorageClass,
+	})
+
+	ts.SendTelemetry(TrackConfigMatrixBridge, map[string]any{
+		"enable":                *cfg.MatrixBridgeSettings.Enable,
+		"isdefault_user_prefix": isDefault(*cfg.MatrixBridgeSettings.UserPrefix, model.MatrixBridgeSettingsDefaultUserPrefix),
+	})
+
+	ts.SendTelemetry(TrackConfigOffboarding, map[string]any{
+		"enable":                    *cfg.OffboardingSettings.Enable,
+		"reassign_integrations":     *cfg.OffboardingSettings.ReassignIntegrations,
+		"reassign_playbook_runs":    *cfg.OffboardingSettings.ReassignPlaybookRuns,
+		"transfer_private_channels": *cfg.OffboardingSettings.TransferPrivateChannels,
+		"remove_from_channels":      *cfg.OffboardingSettings.RemoveFromChannels,
+	})
+
+	ts.SendTelemetry(TrackConfigSecrets, map[string]any{
+		"isdefault_vault_address":  isDefault(*cfg.SecretsSettings.VaultAddress, ""),
+		"refresh_interval_minutes": *cfg.SecretsSettings.RefreshIntervalMinutes,
+	})
+
+	ts.SendTelemetry(TrackConfigCache, map[string]any{
+		"cache_type": *cfg.CacheSettings.CacheType,
+	})
+
+	ts.SendTelemetry(TrackConfigIPAccess, map[string]any{
+		"enable":                     *cfg.IPAccessSettings.Enable,
+		"policies_count":             len(cfg.IPAccessSettings.Policies),
+		"isdefault_emergency_bypass": *cfg.IPAccessSettings.EmergencyBypassToken == "",
 	})
 
 	ts.SendTelemetry(TrackConfigProducts, map[string]any{
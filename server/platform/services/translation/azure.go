@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package translation
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const azureDefaultAPIURL = "https://api.cognitive.microsofttranslator.com"
+
+// azureProvider translates through Azure AI Translator. Geographical endpoints, such as
+// https://api-eur.cognitive.microsofttranslator.com, keep requests within that geography.
+// See https://learn.microsoft.com/azure/ai-services/translator/reference/v3-0-translate.
+type azureProvider struct {
+	client *http.Client
+	apiURL string
+	apiKey string
+	region string
+}
+
+type azureRequestItem struct {
+	Text string `json:"Text"`
+}
+
+type azureResponseItem struct {
+	DetectedLanguage struct {
+		Language string `json:"language"`
+	} `json:"detectedLanguage"`
+	Translations []struct {
+		Text string `json:"text"`
+	} `json:"translations"`
+}
+
+func (p *azureProvider) Name() string {
+	return model.TranslationProviderAzure
+}
+
+func (p *azureProvider) Translate(ctx context.Context, text, targetLanguage string) (*Result, error) {
+	query := url.Values{}
+	query.Set("api-version", "3.0")
+	query.Set("to", azureLanguage(targetLanguage))
+
+	header := http.Header{}
+	header.Set("Ocp-Apim-Subscription-Key", p.apiKey)
+	if p.region != "" {
+		header.Set("Ocp-Apim-Subscription-Region", p.region)
+	}
+
+	var resp []azureResponseItem
+	if err := doJSONRequest(ctx, p.client, p.apiURL+"/translate?"+query.Encode(), header, []azureRequestItem{{Text: text}}, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp) == 0 || len(resp[0].Translations) == 0 {
+		return nil, errors.New("no translation returned by Azure")
+	}
+
+	return &Result{
+		Text:           resp[0].Translations[0].Text,
+		SourceLanguage: resp[0].DetectedLanguage.Language,
+	}, nil
+}
+
+// azureLanguage converts a language tag into an Azure Translator language code, which uses
+// scripts rather than regions to tell Chinese variants apart.
+func azureLanguage(lang string) string {
+	switch strings.ToLower(lang) {
+	case "zh", "zh-cn", "zh-sg":
+		return "zh-Hans"
+	case "zh-tw", "zh-hk":
+		return "zh-Hant"
+	case "pt-br":
+		return "pt"
+	}
+	return lang
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package translation
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const (
+	deepLAPIURL     = "https://api.deepl.com"
+	deepLFreeAPIURL = "https://api-free.deepl.com"
+)
+
+// deepLProvider translates through the DeepL API. See https://developers.deepl.com/docs.
+type deepLProvider struct {
+	client *http.Client
+	apiURL string
+	apiKey string
+}
+
+type deepLRequest struct {
+	Text       []string `json:"text"`
+	TargetLang string   `json:"target_lang"`
+}
+
+type deepLResponse struct {
+	Translations []struct {
+		DetectedSourceLanguage string `json:"detected_source_language"`
+		Text                   string `json:"text"`
+	} `json:"translations"`
+}
+
+// deepLDefaultAPIURL returns the API URL matching the plan of the key, as DeepL Free API keys
+// can't be used with the DeepL Pro API and the other way around.
+func deepLDefaultAPIURL(apiKey string) string {
+	if strings.HasSuffix(apiKey, ":fx") {
+		return deepLFreeAPIURL
+	}
+	return deepLAPIURL
+}
+
+func (p *deepLProvider) Name() string {
+	return model.TranslationProviderDeepL
+}
+
+func (p *deepLProvider) Translate(ctx context.Context, text, targetLanguage string) (*Result, error) {
+	req := deepLRequest{
+		Text:       []string{text},
+		TargetLang: deepLLanguage(targetLanguage),
+	}
+	header := http.Header{}
+	header.Set("Authorization", "DeepL-Auth-Key "+p.apiKey)
+
+	var resp deepLResponse
+	if err := doJSONRequest(ctx, p.client, p.apiURL+"/v2/translate", header, req, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Translations) == 0 {
+		return nil, errors.New("no translation returned by DeepL")
+	}
+
+	return &Result{
+		Text:           resp.Translations[0].Text,
+		SourceLanguage: strings.ToLower(resp.Translations[0].DetectedSourceLanguage),
+	}, nil
+}
+
+// deepLLanguage converts a language tag into a DeepL target language code. DeepL only accepts
+// regional variants for a few languages, and requires one for English and Portuguese.
+func deepLLanguage(lang string) string {
+	lang = strings.ToUpper(lang)
+
+	switch lang {
+	case "EN-GB", "EN-US", "PT-BR", "PT-PT", "ZH-HANS", "ZH-HANT":
+		return lang
+	case "ZH-CN":
+		return "ZH-HANS"
+	case "ZH-TW", "ZH-HK":
+		return "ZH-HANT"
+	}
+
+	base, _, _ := strings.Cut(lang, "-")
+	switch base {
+	case "EN":
+		return "EN-US"
+	case "PT":
+		return "PT-PT"
+	}
+	return base
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package translation
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// libreTranslateProvider translates through a LibreTranslate instance, usually self-hosted.
+// See https://libretranslate.com/docs.
+type libreTranslateProvider struct {
+	client *http.Client
+	apiURL string
+	apiKey string
+}
+
+type libreTranslateRequest struct {
+	Q      string `json:"q"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Format string `json:"format"`
+	APIKey string `json:"api_key,omitempty"`
+}
+
+type libreTranslateResponse struct {
+	TranslatedText   string `json:"translatedText"`
+	DetectedLanguage struct {
+		Language string `json:"language"`
+	} `json:"detectedLanguage"`
+}
+
+func (p *libreTranslateProvider) Name() string {
+	return model.TranslationProviderLibreTranslate
+}
+
+func (p *libreTranslateProvider) Translate(ctx context.Context, text, targetLanguage string) (*Result, error) {
+	req := libreTranslateRequest{
+		Q:      text,
+		Source: "auto",
+		Target: libreTranslateLanguage(targetLanguage),
+		Format: "text",
+		APIKey: p.apiKey,
+	}
+
+	var resp libreTranslateResponse
+	if err := doJSONRequest(ctx, p.client, p.apiURL+"/translate", nil, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Text:           resp.TranslatedText,
+		SourceLanguage: resp.DetectedLanguage.Language,
+	}, nil
+}
+
+// libreTranslateLanguage converts a language tag into the ISO 639-1 code LibreTranslate expects.
+func libreTranslateLanguage(lang string) string {
+	base, _, _ := strings.Cut(lang, "-")
+	return strings.ToLower(base)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package translation implements clients for the machine translation services messages can be
+// translated through.
+package translation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// maxErrorBodySize is the number of bytes of an error response that are included in the returned error.
+const maxErrorBodySize = 512
+
+// Result is a message translated by a provider.
+type Result struct {
+	Text string
+	// The language the provider detected the original message to be written in, if any.
+	SourceLanguage string
+}
+
+// A Provider translates text through a machine translation service.
+type Provider interface {
+	// Name returns the identifier of the provider, as used in the TranslationSettings.
+	Name() string
+
+	// Translate translates text into the targetLanguage, detecting the language of the original text.
+	Translate(ctx context.Context, text, targetLanguage string) (*Result, error)
+}
+
+// NewProvider returns the provider configured by settings, sending requests through client.
+func NewProvider(settings model.TranslationSettings, client *http.Client) (Provider, error) {
+	apiURL := strings.TrimSuffix(*settings.APIURL, "/")
+
+	switch *settings.Provider {
+	case model.TranslationProviderLibreTranslate:
+		if apiURL == "" {
+			return nil, errors.New("an API URL is required for LibreTranslate")
+		}
+		return &libreTranslateProvider{client: client, apiURL: apiURL, apiKey: *settings.APIKey}, nil
+	case model.TranslationProviderDeepL:
+		if apiURL == "" {
+			apiURL = deepLDefaultAPIURL(*settings.APIKey)
+		}
+		return &deepLProvider{client: client, apiURL: apiURL, apiKey: *settings.APIKey}, nil
+	case model.TranslationProviderAzure:
+		if apiURL == "" {
+			apiURL = azureDefaultAPIURL
+		}
+		return &azureProvider{client: client, apiURL: apiURL, apiKey: *settings.APIKey, region: *settings.AzureRegion}, nil
+	default:
+		return nil, errors.Errorf("unknown translation provider %q", *settings.Provider)
+	}
+}
+
+// doJSONRequest sends body encoded as JSON to url and decodes the JSON response into v.
+func doJSONRequest(ctx context.Context, client *http.Client, url string, header http.Header, body, v any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode translation request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err, "failed to create translation request")
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send translation request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return errors.Errorf("translation request failed with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return errors.Wrap(err, "failed to decode translation response")
+	}
+
+	return nil
+}
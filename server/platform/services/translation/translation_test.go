@@ -0,0 +1,172 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package translation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func makeSettings(provider, apiURL, apiKey string) model.TranslationSettings {
+	settings := model.TranslationSettings{
+		Enable:   model.NewBool(true),
+		Provider: model.NewString(provider),
+		APIURL:   model.NewString(apiURL),
+		APIKey:   model.NewString(apiKey),
+	}
+	settings.SetDefaults()
+	return settings
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Run("LibreTranslate requires an API URL", func(t *testing.T) {
+		_, err := NewProvider(makeSettings(model.TranslationProviderLibreTranslate, "", ""), http.DefaultClient)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		_, err := NewProvider(makeSettings("unknown", "", ""), http.DefaultClient)
+		require.Error(t, err)
+	})
+
+	t.Run("DeepL API URL depends on the key", func(t *testing.T) {
+		provider, err := NewProvider(makeSettings(model.TranslationProviderDeepL, "", "key:fx"), http.DefaultClient)
+		require.NoError(t, err)
+		assert.Equal(t, deepLFreeAPIURL, provider.(*deepLProvider).apiURL)
+
+		provider, err = NewProvider(makeSettings(model.TranslationProviderDeepL, "", "key"), http.DefaultClient)
+		require.NoError(t, err)
+		assert.Equal(t, deepLAPIURL, provider.(*deepLProvider).apiURL)
+	})
+
+	t.Run("configured API URL takes precedence", func(t *testing.T) {
+		provider, err := NewProvider(makeSettings(model.TranslationProviderAzure, "https://api-eur.cognitive.microsofttranslator.com/", "key"), http.DefaultClient)
+		require.NoError(t, err)
+		assert.Equal(t, "https://api-eur.cognitive.microsofttranslator.com", provider.(*azureProvider).apiURL)
+	})
+}
+
+func TestLibreTranslate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/translate", r.URL.Path)
+
+		var req libreTranslateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "hello", req.Q)
+		assert.Equal(t, "auto", req.Source)
+		assert.Equal(t, "pt", req.Target)
+		assert.Equal(t, "secret", req.APIKey)
+
+		w.Write([]byte(`{"translatedText": "olá", "detectedLanguage": {"confidence": 90, "language": "en"}}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(makeSettings(model.TranslationProviderLibreTranslate, server.URL, "secret"), server.Client())
+	require.NoError(t, err)
+
+	result, err := provider.Translate(context.Background(), "hello", "pt-BR")
+	require.NoError(t, err)
+	assert.Equal(t, "olá", result.Text)
+	assert.Equal(t, "en", result.SourceLanguage)
+}
+
+func TestDeepL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/translate", r.URL.Path)
+		assert.Equal(t, "DeepL-Auth-Key secret", r.Header.Get("Authorization"))
+
+		var req deepLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, []string{"hello"}, req.Text)
+		assert.Equal(t, "DE", req.TargetLang)
+
+		w.Write([]byte(`{"translations": [{"detected_source_language": "EN", "text": "hallo"}]}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(makeSettings(model.TranslationProviderDeepL, server.URL, "secret"), server.Client())
+	require.NoError(t, err)
+
+	result, err := provider.Translate(context.Background(), "hello", "de")
+	require.NoError(t, err)
+	assert.Equal(t, "hallo", result.Text)
+	assert.Equal(t, "en", result.SourceLanguage)
+}
+
+func TestAzure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/translate", r.URL.Path)
+		assert.Equal(t, "3.0", r.URL.Query().Get("api-version"))
+		assert.Equal(t, "zh-Hans", r.URL.Query().Get("to"))
+		assert.Equal(t, "secret", r.Header.Get("Ocp-Apim-Subscription-Key"))
+		assert.Equal(t, "westeurope", r.Header.Get("Ocp-Apim-Subscription-Region"))
+
+		var req []azureRequestItem
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Len(t, req, 1)
+		assert.Equal(t, "hello", req[0].Text)
+
+		w.Write([]byte(`[{"detectedLanguage": {"language": "en", "score": 1.0}, "translations": [{"text": "你好", "to": "zh-Hans"}]}]`))
+	}))
+	defer server.Close()
+
+	settings := makeSettings(model.TranslationProviderAzure, server.URL, "secret")
+	settings.AzureRegion = model.NewString("westeurope")
+	provider, err := NewProvider(settings, server.Client())
+	require.NoError(t, err)
+
+	result, err := provider.Translate(context.Background(), "hello", "zh-CN")
+	require.NoError(t, err)
+	assert.Equal(t, "你好", result.Text)
+	assert.Equal(t, "en", result.SourceLanguage)
+}
+
+func TestProviderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "Wrong endpoint"}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(makeSettings(model.TranslationProviderDeepL, server.URL, "secret"), server.Client())
+	require.NoError(t, err)
+
+	_, err = provider.Translate(context.Background(), "hello", "de")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+	assert.Contains(t, err.Error(), "Wrong endpoint")
+}
+
+func TestLanguageCodes(t *testing.T) {
+	for lang, expected := range map[string]string{
+		"en":      "EN-US",
+		"en-GB":   "EN-GB",
+		"pt":      "PT-PT",
+		"pt-BR":   "PT-BR",
+		"zh-CN":   "ZH-HANS",
+		"zh-Hant": "ZH-HANT",
+		"fr-CA":   "FR",
+	} {
+		assert.Equal(t, expected, deepLLanguage(lang), lang)
+	}
+
+	for lang, expected := range map[string]string{
+		"zh-CN": "zh-Hans",
+		"zh-TW": "zh-Hant",
+		"pt-BR": "pt",
+		"fr":    "fr",
+	} {
+		assert.Equal(t, expected, azureLanguage(lang), lang)
+	}
+
+	assert.Equal(t, "zh", libreTranslateLanguage("zh-CN"))
+}
@@ -40,6 +40,13 @@ type FileBackend interface {
 	RemoveDirectory(path string) error
 }
 
+// FileWriter writes a file to the file backend as data is written to it. The file is only complete once
+// Close returns without error, while Abort discards the data written so far.
+type FileWriter interface {
+	io.WriteCloser
+	Abort() error
+}
+
 type FileBackendSettings struct {
 	DriverName                         string
 	Directory                          string
@@ -52,6 +59,9 @@ type FileBackendSettings struct {
 	AmazonS3SSL                        bool
 	AmazonS3SignV2                     bool
 	AmazonS3SSE                        bool
+	AmazonS3SSEKMSKeyId                string
+	AmazonS3ObjectTagging              bool
+	AmazonS3StorageClass               string
 	AmazonS3Trace                      bool
 	SkipVerify                         bool
 	AmazonS3RequestTimeoutMilliseconds int64
@@ -100,3 +110,75 @@ func TryWriteFileContext(fb FileBackend, ctx context.Context, fr io.Reader, path
 
 	return fb.WriteFile(fr, path)
 }
+
+// TryWriteFileWithTags checks if the file backend supports object tags and attaches them to the written
+// file in that case. Should the file backend not support tags, the file is written without them.
+func TryWriteFileWithTags(fb FileBackend, fr io.Reader, path string, tags map[string]string) (int64, error) {
+	type TagWriter interface {
+		WriteFileWithTags(io.Reader, string, map[string]string) (int64, error)
+	}
+
+	if tw, ok := fb.(TagWriter); ok {
+		return tw.WriteFileWithTags(fr, path, tags)
+	}
+
+	return fb.WriteFile(fr, path)
+}
+
+// NewFileWriter returns a writer streaming the data written to it into the file at the given path, so
+// that large files don't need to be stored anywhere else first. Backends uploading files in parts keep
+// at most partSize bytes in memory. Should the file backend not support streaming writes, the data is
+// passed to WriteFile through a pipe instead.
+func NewFileWriter(fb FileBackend, ctx context.Context, path string, partSize int64) (FileWriter, error) {
+	type StreamWriter interface {
+		NewFileWriter(context.Context, string, int64) (FileWriter, error)
+	}
+
+	if sw, ok := fb.(StreamWriter); ok {
+		return sw.NewFileWriter(ctx, path, partSize)
+	}
+
+	return newPipeFileWriter(fb, ctx, path), nil
+}
+
+type pipeFileWriter struct {
+	*io.PipeWriter
+	fb       FileBackend
+	path     string
+	writeErr chan error
+}
+
+func newPipeFileWriter(fb FileBackend, ctx context.Context, path string) *pipeFileWriter {
+	rd, wr := io.Pipe()
+	w := &pipeFileWriter{
+		PipeWriter: wr,
+		fb:         fb,
+		path:       path,
+		writeErr:   make(chan error, 1),
+	}
+
+	go func() {
+		_, err := TryWriteFileContext(fb, ctx, rd, path)
+		// Closing the reader makes further writes fail instead of blocking on a pipe nobody
+		// reads from anymore.
+		rd.CloseWithError(err) // CloseWithError never returns an error
+		w.writeErr <- err
+	}()
+
+	return w
+}
+
+func (w *pipeFileWriter) Close() error {
+	w.PipeWriter.Close() // Close never returns an error
+	return <-w.writeErr
+}
+
+func (w *pipeFileWriter) Abort() error {
+	w.PipeWriter.CloseWithError(errors.New("write aborted")) // CloseWithError never returns an error
+	<-w.writeErr
+
+	if exists, err := w.fb.FileExists(w.path); err != nil || !exists {
+		return err
+	}
+	return w.fb.RemoveFile(w.path)
+}
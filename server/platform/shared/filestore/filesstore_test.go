@@ -124,6 +124,61 @@ func (s *FileBackendTestSuite) TestReadWriteFile() {
 	s.EqualValues(readString, "test")
 }
 
+func (s *FileBackendTestSuite) TestWriteFileWithTags() {
+	b := []byte("test")
+	path := "tests/" + randomString()
+
+	written, err := TryWriteFileWithTags(s.backend, bytes.NewReader(b), path, map[string]string{"channel_id": "channel", "uploader_id": "user"})
+	s.Nil(err)
+	s.EqualValues(len(b), written, "expected given number of bytes to have been written")
+	defer s.backend.RemoveFile(path)
+
+	read, err := s.backend.ReadFile(path)
+	s.Nil(err)
+	s.EqualValues("test", string(read))
+}
+
+func (s *FileBackendTestSuite) TestFileWriter() {
+	s.Run("close", func() {
+		// Large enough to be uploaded in more than one part.
+		data := make([]byte, s3MinPartSize+1024)
+		_, err := rand.Read(data)
+		s.Require().NoError(err)
+		path := "tests/" + randomString()
+
+		w, err := NewFileWriter(s.backend, context.Background(), path, s3MinPartSize)
+		s.Require().NoError(err)
+		for offset := 0; offset < len(data); offset += 1000000 {
+			end := offset + 1000000
+			if end > len(data) {
+				end = len(data)
+			}
+			_, err = w.Write(data[offset:end])
+			s.Require().NoError(err)
+		}
+		s.Require().NoError(w.Close())
+		defer s.backend.RemoveFile(path)
+
+		read, err := s.backend.ReadFile(path)
+		s.Require().NoError(err)
+		s.Equal(data, read)
+	})
+
+	s.Run("abort", func() {
+		path := "tests/" + randomString()
+
+		w, err := NewFileWriter(s.backend, context.Background(), path, s3MinPartSize)
+		s.Require().NoError(err)
+		_, err = w.Write([]byte("test"))
+		s.Require().NoError(err)
+		s.Require().NoError(w.Abort())
+
+		exists, err := s.backend.FileExists(path)
+		s.Require().NoError(err)
+		s.False(exists)
+	})
+}
+
 func (s *FileBackendTestSuite) TestReadWriteFileContext() {
 	type ContextWriter interface {
 		WriteFileContext(context.Context, io.Reader, string) (int64, error)
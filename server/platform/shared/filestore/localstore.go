@@ -5,6 +5,7 @@ package filestore
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"os"
 	"path/filepath"
@@ -163,6 +164,30 @@ func writeFileLocally(fr io.Reader, path string) (int64, error) {
 	return written, nil
 }
 
+// NewFileWriter returns a writer creating the file at the given path. The part size is ignored since
+// the data is written to the file as it comes.
+func (b *LocalFileBackend) NewFileWriter(_ context.Context, path string, _ int64) (FileWriter, error) {
+	fp := filepath.Join(b.directory, path)
+	if err := os.MkdirAll(filepath.Dir(fp), 0750); err != nil {
+		directory, _ := filepath.Abs(filepath.Dir(fp))
+		return nil, errors.Wrapf(err, "unable to create the directory %s for the file %s", directory, path)
+	}
+	fw, err := os.OpenFile(fp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open the file %s to write the data", path)
+	}
+	return &localFileWriter{File: fw}, nil
+}
+
+type localFileWriter struct {
+	*os.File
+}
+
+func (w *localFileWriter) Abort() error {
+	w.File.Close()
+	return os.Remove(w.File.Name())
+}
+
 func (b *LocalFileBackend) AppendFile(fr io.Reader, path string) (int64, error) {
 	fp := filepath.Join(b.directory, path)
 	if _, err := os.Stat(fp); err != nil {
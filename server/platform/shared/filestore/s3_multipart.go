@@ -0,0 +1,156 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filestore
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	s3 "github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+)
+
+const (
+	// S3 rejects parts smaller than 5MiB, except for the last one, and uploads of more than 10000 parts.
+	s3MinPartSize  = 5 * 1024 * 1024
+	s3MaxPartCount = 10000
+
+	s3MaxPartAttempts = 3
+)
+
+// s3MultipartWriter uploads the data written to it as an S3 multipart upload, keeping a single part in
+// memory. Parts failing to upload are retried, so that a transient error doesn't require the whole file
+// to be written again.
+type s3MultipartWriter struct {
+	ctx      context.Context
+	core     s3.Core
+	bucket   string
+	path     string
+	uploadID string
+	options  s3.PutObjectOptions
+	timeout  time.Duration
+
+	buf   []byte
+	parts []s3.CompletePart
+	err   error
+}
+
+// NewFileWriter returns a writer uploading the file at the given path in parts of partSize bytes. Since
+// an upload can't be made of more than 10000 parts, the part size bounds the size of the file.
+func (b *S3FileBackend) NewFileWriter(ctx context.Context, path string, partSize int64) (FileWriter, error) {
+	if partSize < s3MinPartSize {
+		partSize = s3MinPartSize
+	}
+
+	path = filepath.Join(b.pathPrefix, path)
+	options := s3PutOptions(b.serverSideEncryption(), s3ContentType(path))
+	options.StorageClass = b.storageClass
+
+	core := s3.Core{Client: b.client}
+	uploadID, err := core.NewMultipartUpload(ctx, b.bucket, path, options)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to start the upload of the file %s", path)
+	}
+
+	return &s3MultipartWriter{
+		ctx:      ctx,
+		core:     core,
+		bucket:   b.bucket,
+		path:     path,
+		uploadID: uploadID,
+		options:  options,
+		timeout:  b.timeout,
+		buf:      make([]byte, 0, partSize),
+	}, nil
+}
+
+func (w *s3MultipartWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	written := 0
+	for len(p) > 0 {
+		n := cap(w.buf) - len(w.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+
+		if len(w.buf) == cap(w.buf) {
+			if w.err = w.uploadPart(); w.err != nil {
+				return written, w.err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+func (w *s3MultipartWriter) uploadPart() error {
+	partNumber := len(w.parts) + 1
+	if partNumber > s3MaxPartCount {
+		return errors.Errorf("unable to upload the file %s in more than %d parts of %d bytes", w.path, s3MaxPartCount, cap(w.buf))
+	}
+
+	var err error
+	for attempt := 1; attempt <= s3MaxPartAttempts; attempt++ {
+		var part s3.ObjectPart
+		// Server-side encryption was requested when starting the upload, parts only need it for SSE-C.
+		part, err = w.core.PutObjectPart(w.ctx, w.bucket, w.path, w.uploadID, partNumber, bytes.NewReader(w.buf), int64(len(w.buf)), "", "", nil)
+		if err == nil {
+			w.parts = append(w.parts, s3.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+			w.buf = w.buf[:0]
+			return nil
+		}
+
+		select {
+		case <-w.ctx.Done():
+			return errors.Wrapf(w.ctx.Err(), "unable to upload part %d of the file %s", partNumber, w.path)
+		case <-time.After(time.Duration(attempt) * time.Second):
+		}
+	}
+
+	return errors.Wrapf(err, "unable to upload part %d of the file %s", partNumber, w.path)
+}
+
+// Close uploads the last part and completes the upload, creating the file.
+func (w *s3MultipartWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	// An upload needs at least one part, even if it's empty.
+	if len(w.buf) > 0 || len(w.parts) == 0 {
+		if w.err = w.uploadPart(); w.err != nil {
+			return w.err
+		}
+	}
+
+	if _, err := w.core.CompleteMultipartUpload(w.ctx, w.bucket, w.path, w.uploadID, w.parts, w.options); err != nil {
+		w.err = errors.Wrapf(err, "unable to complete the upload of the file %s", w.path)
+		return w.err
+	}
+
+	w.err = errors.Errorf("the upload of the file %s is already complete", w.path)
+	return nil
+}
+
+// Abort discards the parts uploaded so far, so that they don't keep using storage.
+func (w *s3MultipartWriter) Abort() error {
+	w.err = errors.Errorf("the upload of the file %s has been aborted", w.path)
+
+	// The writer's context may be the reason for aborting, so it can't be used.
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+	if err := w.core.AbortMultipartUpload(ctx, w.bucket, w.path, w.uploadID); err != nil {
+		return errors.Wrapf(err, "unable to abort the upload of the file %s", w.path)
+	}
+
+	return nil
+}
@@ -25,19 +25,22 @@ import (
 // S3FileBackend contains all necessary information to communicate with
 // an AWS S3 compatible API backend.
 type S3FileBackend struct {
-	endpoint   string
-	accessKey  string
-	secretKey  string
-	secure     bool
-	signV2     bool
-	region     string
-	bucket     string
-	pathPrefix string
-	encrypt    bool
-	trace      bool
-	client     *s3.Client
-	skipVerify bool
-	timeout    time.Duration
+	endpoint     string
+	accessKey    string
+	secretKey    string
+	secure       bool
+	signV2       bool
+	region       string
+	bucket       string
+	pathPrefix   string
+	encrypt      bool
+	kmsKeyID     string
+	tagging      bool
+	storageClass string
+	trace        bool
+	client       *s3.Client
+	skipVerify   bool
+	timeout      time.Duration
 }
 
 type S3FileBackendAuthError struct {
@@ -87,18 +90,21 @@ func (s *S3FileBackendNoBucketError) Error() string {
 func NewS3FileBackend(settings FileBackendSettings) (*S3FileBackend, error) {
 	timeout := time.Duration(settings.AmazonS3RequestTimeoutMilliseconds) * time.Millisecond
 	backend := &S3FileBackend{
-		endpoint:   settings.AmazonS3Endpoint,
-		accessKey:  settings.AmazonS3AccessKeyId,
-		secretKey:  settings.AmazonS3SecretAccessKey,
-		secure:     settings.AmazonS3SSL,
-		signV2:     settings.AmazonS3SignV2,
-		region:     settings.AmazonS3Region,
-		bucket:     settings.AmazonS3Bucket,
-		pathPrefix: settings.AmazonS3PathPrefix,
-		encrypt:    settings.AmazonS3SSE,
-		trace:      settings.AmazonS3Trace,
-		skipVerify: settings.SkipVerify,
-		timeout:    timeout,
+		endpoint:     settings.AmazonS3Endpoint,
+		accessKey:    settings.AmazonS3AccessKeyId,
+		secretKey:    settings.AmazonS3SecretAccessKey,
+		secure:       settings.AmazonS3SSL,
+		signV2:       settings.AmazonS3SignV2,
+		region:       settings.AmazonS3Region,
+		bucket:       settings.AmazonS3Bucket,
+		pathPrefix:   settings.AmazonS3PathPrefix,
+		encrypt:      settings.AmazonS3SSE,
+		kmsKeyID:     settings.AmazonS3SSEKMSKeyId,
+		tagging:      settings.AmazonS3ObjectTagging,
+		storageClass: settings.AmazonS3StorageClass,
+		trace:        settings.AmazonS3Trace,
+		skipVerify:   settings.SkipVerify,
+		timeout:      timeout,
 	}
 	cli, err := backend.s3New()
 	if err != nil {
@@ -319,7 +325,7 @@ func (b *S3FileBackend) CopyFile(oldPath, newPath string) error {
 		Object: oldPath,
 	}
 	if b.encrypt {
-		srcOpts.Encryption = encrypt.NewSSE()
+		srcOpts.Encryption = b.serverSideEncryption()
 	}
 
 	dstOpts := s3.CopyDestOptions{
@@ -327,7 +333,7 @@ func (b *S3FileBackend) CopyFile(oldPath, newPath string) error {
 		Object: newPath,
 	}
 	if b.encrypt {
-		dstOpts.Encryption = encrypt.NewSSE()
+		dstOpts.Encryption = b.serverSideEncryption()
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
@@ -347,7 +353,7 @@ func (b *S3FileBackend) MoveFile(oldPath, newPath string) error {
 		Object: oldPath,
 	}
 	if b.encrypt {
-		srcOpts.Encryption = encrypt.NewSSE()
+		srcOpts.Encryption = b.serverSideEncryption()
 	}
 
 	dstOpts := s3.CopyDestOptions{
@@ -355,7 +361,7 @@ func (b *S3FileBackend) MoveFile(oldPath, newPath string) error {
 		Object: newPath,
 	}
 	if b.encrypt {
-		dstOpts.Encryption = encrypt.NewSSE()
+		dstOpts.Encryption = b.serverSideEncryption()
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
@@ -381,15 +387,25 @@ func (b *S3FileBackend) WriteFile(fr io.Reader, path string) (int64, error) {
 }
 
 func (b *S3FileBackend) WriteFileContext(ctx context.Context, fr io.Reader, path string) (int64, error) {
-	var contentType string
+	return b.writeFile(ctx, fr, path, nil)
+}
+
+// WriteFileWithTags writes the file and, when object tagging is enabled, attaches the given tags
+// to it so that bucket lifecycle and access rules can target files by tag.
+func (b *S3FileBackend) WriteFileWithTags(fr io.Reader, path string, tags map[string]string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	defer cancel()
+
+	return b.writeFile(ctx, fr, path, tags)
+}
+
+func (b *S3FileBackend) writeFile(ctx context.Context, fr io.Reader, path string, tags map[string]string) (int64, error) {
 	path = filepath.Join(b.pathPrefix, path)
-	if ext := filepath.Ext(path); isFileExtImage(ext) {
-		contentType = getImageMimeType(ext)
-	} else {
-		contentType = "binary/octet-stream"
+	options := s3PutOptions(b.serverSideEncryption(), s3ContentType(path))
+	if b.tagging {
+		options.UserTags = tags
 	}
-
-	options := s3PutOptions(b.encrypt, contentType)
+	options.StorageClass = b.storageClass
 
 	objSize := int64(-1)
 	isCloud := os.Getenv("MM_CLOUD_FILESTORE_BIFROST") != ""
@@ -424,14 +440,7 @@ func (b *S3FileBackend) AppendFile(fr io.Reader, path string) (int64, error) {
 		return 0, errors.Wrapf(err, "unable to find the file %s to append the data", path)
 	}
 
-	var contentType string
-	if ext := filepath.Ext(fp); isFileExtImage(ext) {
-		contentType = getImageMimeType(ext)
-	} else {
-		contentType = "binary/octet-stream"
-	}
-
-	options := s3PutOptions(b.encrypt, contentType)
+	options := s3PutOptions(b.serverSideEncryption(), s3ContentType(fp))
 	sse := options.ServerSideEncryption
 	partName := fp + ".part"
 	ctx2, cancel2 := context.WithTimeout(context.Background(), b.timeout)
@@ -570,11 +579,33 @@ func (b *S3FileBackend) RemoveDirectory(path string) error {
 	return nil
 }
 
-func s3PutOptions(encrypted bool, contentType string) s3.PutObjectOptions {
-	options := s3.PutObjectOptions{}
-	if encrypted {
-		options.ServerSideEncryption = encrypt.NewSSE()
+// serverSideEncryption returns the encryption to request for written objects, or nil if
+// server-side encryption is disabled. Objects are encrypted with the configured KMS key
+// when one is set, and with S3 managed keys otherwise.
+func (b *S3FileBackend) serverSideEncryption() encrypt.ServerSide {
+	if !b.encrypt {
+		return nil
+	}
+
+	if b.kmsKeyID != "" {
+		// Without an encryption context this never fails.
+		sse, _ := encrypt.NewSSEKMS(b.kmsKeyID, nil)
+		return sse
+	}
+
+	return encrypt.NewSSE()
+}
+
+func s3ContentType(path string) string {
+	if ext := filepath.Ext(path); isFileExtImage(ext) {
+		return getImageMimeType(ext)
 	}
+	return "binary/octet-stream"
+}
+
+func s3PutOptions(sse encrypt.ServerSide, contentType string) s3.PutObjectOptions {
+	options := s3.PutObjectOptions{}
+	options.ServerSideEncryption = sse
 	options.ContentType = contentType
 	// We set the part size to the minimum allowed value of 5MBs
 	// to avoid an excessive allocation in minio.PutObject implementation.
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	s3 "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/stretchr/testify/require"
 )
 
@@ -195,6 +196,30 @@ func TestInsecureMakeBucket(t *testing.T) {
 		})
 	}
 }
+
+func TestS3ServerSideEncryption(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		backend := &S3FileBackend{kmsKeyID: "key"}
+		require.Nil(t, backend.serverSideEncryption())
+		require.Nil(t, s3PutOptions(backend.serverSideEncryption(), "image/png").ServerSideEncryption)
+	})
+
+	t.Run("S3 managed keys", func(t *testing.T) {
+		backend := &S3FileBackend{encrypt: true}
+		require.Equal(t, encrypt.S3, backend.serverSideEncryption().Type())
+	})
+
+	t.Run("KMS key", func(t *testing.T) {
+		backend := &S3FileBackend{encrypt: true, kmsKeyID: "arn:aws:kms:us-east-1:123456789012:key/example"}
+		sse := backend.serverSideEncryption()
+		require.Equal(t, encrypt.KMS, sse.Type())
+
+		header := make(map[string][]string)
+		sse.Marshal(header)
+		require.Equal(t, []string{"arn:aws:kms:us-east-1:123456789012:key/example"}, header["X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"])
+	})
+}
+
 func newTLSProxyServer(backend *url.URL) *httptest.Server {
 	return httptest.NewTLSServer(httputil.NewSingleHostReverseProxy(backend))
 }
@@ -30,6 +30,10 @@ type CategorizeChannelPayload struct {
 	CategoryName string `json:"category_name" mapstructure:"category_name"`
 }
 
+type ChannelHeaderPayload struct {
+	Header string `json:"header" mapstructure:"header"`
+}
+
 type WelcomeMessageAction struct {
 	GenericChannelActionWithoutPayload
 	Payload WelcomeMessagePayload `json:"payload"`
@@ -40,10 +44,12 @@ const (
 	ActionTypeWelcomeMessage    = "send_welcome_message"
 	ActionTypePromptRunPlaybook = "prompt_run_playbook"
 	ActionTypeCategorizeChannel = "categorize_channel"
+	ActionTypeSetChannelHeader  = "set_channel_header"
 
 	// Trigger types
 	TriggerTypeNewMemberJoins = "new_member_joins"
 	TriggerTypeKeywordsPosted = "keywords"
+	TriggerTypeRunActive      = "run_active"
 )
 
 // ChannelActionListOptions specifies the optional parameters to the
@@ -0,0 +1,57 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Capabilities reports which Playbooks features are available under the current license and
+// configuration. All fields are read-only.
+type Capabilities struct {
+	// PrivatePlaybooks is true when playbooks can be restricted to their members.
+	PrivatePlaybooks bool `json:"private_playbooks"`
+	// Retrospective is true when runs can have a retrospective.
+	Retrospective bool `json:"retrospective"`
+	// Timeline is true when messages can be added to a run's timeline.
+	Timeline bool `json:"timeline"`
+	// Stats is true when playbook statistics can be viewed.
+	Stats bool `json:"stats"`
+	// Metrics is true when playbooks can define metrics and runs can report them.
+	Metrics bool `json:"metrics"`
+	// ChecklistItemDueDate is true when due dates can be set on checklist items.
+	ChecklistItemDueDate bool `json:"checklist_item_due_date"`
+	// ChannelExport is true when a run's channel can be exported.
+	ChannelExport bool `json:"channel_export"`
+	// RequestUpdate is true when participants can request a status update.
+	RequestUpdate bool `json:"request_update"`
+	// MultipleChecklists is true when playbooks and runs can have more than one checklist.
+	MultipleChecklists bool `json:"multiple_checklists"`
+	// ExperimentalFeatures is true when experimental features are enabled in the configuration.
+	ExperimentalFeatures bool `json:"experimental_features"`
+}
+
+// CapabilitiesService handles communication with the capabilities related methods.
+type CapabilitiesService struct {
+	client *Client
+}
+
+// Get the features available under the current license and configuration.
+func (s *CapabilitiesService) Get(ctx context.Context) (*Capabilities, error) {
+	capabilitiesURL := "capabilities"
+	req, err := s.client.newRequest(http.MethodGet, capabilitiesURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := new(Capabilities)
+	resp, err := s.client.do(ctx, req, capabilities)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return capabilities, nil
+}
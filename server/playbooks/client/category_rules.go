@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CategoryRule files the runs matching all of its non-empty criteria into the sidebar category
+// named CategoryName of each of their participants.
+type CategoryRule struct {
+	ID           string `json:"id"`
+	CategoryName string `json:"category_name"`
+	PlaybookID   string `json:"playbook_id"`
+	Severity     string `json:"severity"`
+	TeamID       string `json:"team_id"`
+	CreateAt     int64  `json:"create_at"`
+	UpdateAt     int64  `json:"update_at"`
+}
+
+// CategoryRulesService handles communication with the category rules related methods. Category
+// rules can only be managed by system administrators.
+type CategoryRulesService struct {
+	client *Client
+}
+
+// List the category rules, oldest first.
+func (s *CategoryRulesService) List(ctx context.Context) ([]CategoryRule, error) {
+	req, err := s.client.newRequest(http.MethodGet, "category_rules", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := []CategoryRule{}
+	resp, err := s.client.do(ctx, req, &rules)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return rules, nil
+}
+
+// Create a category rule, returning its ID.
+func (s *CategoryRulesService) Create(ctx context.Context, rule CategoryRule) (string, error) {
+	req, err := s.client.newRequest(http.MethodPost, "category_rules", rule)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	resp, err := s.client.do(ctx, req, &result)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("expected status code %d", http.StatusCreated)
+	}
+
+	return result.ID, nil
+}
+
+// Update a category rule.
+func (s *CategoryRulesService) Update(ctx context.Context, rule CategoryRule) error {
+	req, err := s.client.newRequest(http.MethodPut, fmt.Sprintf("category_rules/%s", rule.ID), rule)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(ctx, req, nil)
+	return err
+}
+
+// Delete a category rule.
+func (s *CategoryRulesService) Delete(ctx context.Context, ruleID string) error {
+	req, err := s.client.newRequest(http.MethodDelete, fmt.Sprintf("category_rules/%s", ruleID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(ctx, req, nil)
+	return err
+}
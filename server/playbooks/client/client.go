@@ -50,6 +50,14 @@ type Client struct {
 	Reminders *RemindersService
 	// Telemetry is a collection of methods used to interact with telemetry.
 	Telemetry *TelemetryService
+	// Tours is a collection of methods used to interact with the onboarding tour state.
+	Tours *ToursService
+	// Capabilities is a collection of methods used to interact with the licensed capabilities.
+	Capabilities *CapabilitiesService
+	// CategoryRules is a collection of methods used to interact with the category rules.
+	CategoryRules *CategoryRulesService
+	// Health is a collection of methods used to interact with the health check.
+	Health *HealthService
 }
 
 // New creates a new instance of Client using the configuration from the given Mattermost Client.
@@ -77,6 +85,10 @@ func newClient(mattermostSiteURL string, httpClient *http.Client) (*Client, erro
 	c.Stats = &StatsService{c}
 	c.Reminders = &RemindersService{c}
 	c.Telemetry = &TelemetryService{c}
+	c.Tours = &ToursService{c}
+	c.Capabilities = &CapabilitiesService{c}
+	c.CategoryRules = &CategoryRulesService{c}
+	c.Health = &HealthService{c}
 	return c, nil
 }
 
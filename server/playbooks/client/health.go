@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	HealthStatusOK        = "OK"
+	HealthStatusUnhealthy = "UNHEALTHY"
+	HealthStatusDisabled  = "DISABLED"
+)
+
+// ComponentHealth is the health of one of the components Playbooks depends on.
+type ComponentHealth struct {
+	Status string `json:"status"`
+}
+
+// MigrationsHealth is the health of the database schema: it is unhealthy while migrations are
+// pending.
+type MigrationsHealth struct {
+	Status         string `json:"status"`
+	CurrentVersion string `json:"current_version"`
+	LatestVersion  string `json:"latest_version"`
+}
+
+// Health is the result of the Playbooks health check.
+type Health struct {
+	Status        string           `json:"status"`
+	Database      ComponentHealth  `json:"database"`
+	Migrations    MigrationsHealth `json:"migrations"`
+	Scheduler     ComponentHealth  `json:"scheduler"`
+	MetricsServer ComponentHealth  `json:"metrics_server"`
+}
+
+// HealthService handles communication with the health check. It does not require
+// authentication.
+type HealthService struct {
+	client *Client
+}
+
+// Get runs the health check. An error with status code 503 is returned if Playbooks is
+// unhealthy.
+func (s *HealthService) Get(ctx context.Context) (*Health, error) {
+	req, err := s.client.newRequest(http.MethodGet, "health", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	health := new(Health)
+	resp, err := s.client.do(ctx, req, health)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return health, nil
+}
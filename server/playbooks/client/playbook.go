@@ -23,11 +23,13 @@ type Playbook struct {
 	NumSteps                                int64                  `json:"num_steps"`
 	Checklists                              []Checklist            `json:"checklists"`
 	Members                                 []PlaybookMember       `json:"members"`
+	MemberGroupIDs                          []string               `json:"member_group_ids"`
 	ReminderMessageTemplate                 string                 `json:"reminder_message_template"`
 	ReminderTimerDefaultSeconds             int64                  `json:"reminder_timer_default_seconds"`
 	InvitedUserIDs                          []string               `json:"invited_user_ids"`
 	InvitedGroupIDs                         []string               `json:"invited_group_ids"`
 	InviteUsersEnabled                      bool                   `json:"invite_users_enabled"`
+	SyncInvitedGroupsEnabled                bool                   `json:"sync_invited_groups_enabled"`
 	DefaultOwnerID                          string                 `json:"default_owner_id"`
 	DefaultOwnerEnabled                     bool                   `json:"default_owner_enabled"`
 	BroadcastChannelIDs                     []string               `json:"broadcast_channel_ids"`
@@ -35,10 +37,30 @@ type Playbook struct {
 	WebhookOnCreationURLs                   []string               `json:"webhook_on_creation_urls"`
 	WebhookOnCreationEnabled                bool                   `json:"webhook_on_creation_enabled"`
 	Metrics                                 []PlaybookMetricConfig `json:"metrics"`
+	PropertyFields                          []PropertyField        `json:"property_fields"`
 	CreateChannelMemberOnNewParticipant     bool                   `json:"create_channel_member_on_new_participant"`
 	RemoveChannelMemberOnRemovedParticipant bool                   `json:"remove_channel_member_on_removed_participant"`
 	ChannelID                               string                 `json:"channel_id" export:"channel_id"`
 	ChannelMode                             ChannelPlaybookMode    `json:"channel_mode" export:"channel_mode"`
+	BoardSync                               BoardSync              `json:"board_sync"`
+	SeverityLevels                          []SeverityLevel        `json:"severity_levels"`
+	FollowUpReminderEnabled                 bool                   `json:"follow_up_reminder_enabled"`
+	FollowUpReminderDelaySeconds            int64                  `json:"follow_up_reminder_delay_seconds"`
+	FollowUpReminderMessage                 string                 `json:"follow_up_reminder_message"`
+}
+
+const (
+	BoardSyncGranularityRun           = "run"
+	BoardSyncGranularityChecklistItem = "checklist_item"
+)
+
+// BoardSync configures how the runs of a playbook are mirrored as cards in a board.
+type BoardSync struct {
+	Enabled          bool              `json:"enabled"`
+	BoardID          string            `json:"board_id"`
+	Granularity      string            `json:"granularity"`
+	StatusPropertyID string            `json:"status_property_id"`
+	StatusOptions    map[string]string `json:"status_options"`
 }
 
 type PlaybookMember struct {
@@ -74,6 +96,7 @@ type ChecklistItem struct {
 	LastSkipped      int64        `json:"delete_at"`
 	DueDate          int64        `json:"due_date"`
 	TaskActions      []TaskAction `json:"task_actions"`
+	BoardCardID      string       `json:"board_card_id,omitempty"`
 }
 
 // TaskAction represents a task action in an item
@@ -97,21 +120,29 @@ type PlaybookCreateOptions struct {
 	CreatePublicPlaybookRun                 bool                   `json:"create_public_playbook_run"`
 	Checklists                              []Checklist            `json:"checklists"`
 	Members                                 []PlaybookMember       `json:"members"`
+	MemberGroupIDs                          []string               `json:"member_group_ids"`
 	BroadcastChannelID                      string                 `json:"broadcast_channel_id"`
 	ReminderMessageTemplate                 string                 `json:"reminder_message_template"`
 	ReminderTimerDefaultSeconds             int64                  `json:"reminder_timer_default_seconds"`
 	InvitedUserIDs                          []string               `json:"invited_user_ids"`
 	InvitedGroupIDs                         []string               `json:"invited_group_ids"`
 	InviteUsersEnabled                      bool                   `json:"invite_users_enabled"`
+	SyncInvitedGroupsEnabled                bool                   `json:"sync_invited_groups_enabled"`
 	DefaultOwnerID                          string                 `json:"default_owner_id"`
 	DefaultOwnerEnabled                     bool                   `json:"default_owner_enabled"`
 	BroadcastChannelIDs                     []string               `json:"broadcast_channel_ids"`
 	BroadcastEnabled                        bool                   `json:"broadcast_enabled"`
 	Metrics                                 []PlaybookMetricConfig `json:"metrics"`
+	PropertyFields                          []PropertyField        `json:"property_fields"`
 	CreateChannelMemberOnNewParticipant     bool                   `json:"create_channel_member_on_new_participant"`
 	RemoveChannelMemberOnRemovedParticipant bool                   `json:"remove_channel_member_on_removed_participant"`
 	ChannelID                               string                 `json:"channel_id" export:"channel_id"`
 	ChannelMode                             ChannelPlaybookMode    `json:"channel_mode" export:"channel_mode"`
+	BoardSync                               BoardSync              `json:"board_sync"`
+	SeverityLevels                          []SeverityLevel        `json:"severity_levels"`
+	FollowUpReminderEnabled                 bool                   `json:"follow_up_reminder_enabled"`
+	FollowUpReminderDelaySeconds            int64                  `json:"follow_up_reminder_delay_seconds"`
+	FollowUpReminderMessage                 string                 `json:"follow_up_reminder_message"`
 }
 
 type PlaybookMetricConfig struct {
@@ -123,6 +154,43 @@ type PlaybookMetricConfig struct {
 	Target      null.Int `json:"target"`
 }
 
+const (
+	PropertyFieldTypeText   = "text"
+	PropertyFieldTypeSelect = "select"
+	PropertyFieldTypeUser   = "user"
+	PropertyFieldTypeDate   = "date"
+)
+
+// PropertyField is a custom field defined by a playbook and set on each of its runs.
+type PropertyField struct {
+	ID         string           `json:"id"`
+	PlaybookID string           `json:"playbook_id"`
+	Name       string           `json:"name"`
+	Type       string           `json:"type"`
+	Options    []PropertyOption `json:"options"`
+}
+
+// PropertyOption is one of the values a select property field can take.
+type PropertyOption struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+const (
+	SeveritySEV1 = "SEV1"
+	SeveritySEV2 = "SEV2"
+	SeveritySEV3 = "SEV3"
+	SeveritySEV4 = "SEV4"
+)
+
+// SeverityLevel is a severity a run of a playbook can be declared with, along with the defaults
+// applied to the runs created at that severity.
+type SeverityLevel struct {
+	Name                        string   `json:"name"`
+	BroadcastChannelIDs         []string `json:"broadcast_channel_ids"`
+	ReminderTimerDefaultSeconds int64    `json:"reminder_timer_default_seconds"`
+}
+
 // PlaybookListOptions specifies the optional parameters to the
 // PlaybooksService.List method.
 type PlaybookListOptions struct {
@@ -45,6 +45,7 @@ type PlaybookRun struct {
 	ReminderMessageTemplate                 string          `json:"reminder_message_template"`
 	InvitedUserIDs                          []string        `json:"invited_user_ids"`
 	InvitedGroupIDs                         []string        `json:"invited_group_ids"`
+	SyncInvitedGroups                       bool            `json:"sync_invited_groups"`
 	TimelineEvents                          []TimelineEvent `json:"timeline_events"`
 	DefaultOwnerID                          string          `json:"default_owner_id"`
 	WebhookOnCreationURLs                   []string        `json:"webhook_on_creation_urls"`
@@ -59,6 +60,18 @@ type PlaybookRun struct {
 	MetricsData                             []RunMetricData `json:"metrics_data"`
 	CreateChannelMemberOnNewParticipant     bool            `json:"create_channel_member_on_new_participant"`
 	RemoveChannelMemberOnRemovedParticipant bool            `json:"remove_channel_member_on_removed_participant"`
+	BoardID                                 string          `json:"board_id"`
+	BoardCardID                             string          `json:"board_card_id"`
+	PropertyValues                          []PropertyValue `json:"property_values"`
+	Severity                                string          `json:"severity"`
+	FollowUpReminderDelaySeconds            int64           `json:"follow_up_reminder_delay_seconds"`
+	FollowUpReminderMessage                 string          `json:"follow_up_reminder_message"`
+}
+
+// PropertyValue is the value of a property field for a playbook run.
+type PropertyValue struct {
+	FieldID string `json:"field_id"`
+	Value   string `json:"value"`
 }
 
 // StatusPost is information added to the playbook run when selecting from the db and sent to the
@@ -82,12 +95,37 @@ type StatusPostComplete struct {
 
 // Metadata tracks ancillary metadata about a playbook run.
 type Metadata struct {
-	ChannelName        string   `json:"channel_name"`
-	ChannelDisplayName string   `json:"channel_display_name"`
-	TeamName           string   `json:"team_name"`
-	NumParticipants    int64    `json:"num_participants"`
-	TotalPosts         int64    `json:"total_posts"`
-	Followers          []string `json:"followers"`
+	ChannelName        string      `json:"channel_name"`
+	ChannelDisplayName string      `json:"channel_display_name"`
+	TeamName           string      `json:"team_name"`
+	NumParticipants    int64       `json:"num_participants"`
+	TotalPosts         int64       `json:"total_posts"`
+	Followers          []string    `json:"followers"`
+	LinkedRuns         []LinkedRun `json:"linked_runs"`
+	ChildTasks         int64       `json:"child_tasks"`
+	ChildTasksClosed   int64       `json:"child_tasks_closed"`
+}
+
+const (
+	RunLinkTypeParent  = "parent"
+	RunLinkTypeChild   = "child"
+	RunLinkTypeRelated = "related"
+)
+
+// RunRelation is a link from a run to another run, typed relative to the former.
+type RunRelation struct {
+	RunID    string `json:"run_id"`
+	Type     string `json:"type"`
+	CreateAt int64  `json:"create_at"`
+}
+
+// LinkedRun summarizes a run linked to another run.
+type LinkedRun struct {
+	RunRelation
+	Name           string `json:"name"`
+	CurrentStatus  string `json:"current_status"`
+	NumTasks       int64  `json:"num_tasks"`
+	NumTasksClosed int64  `json:"num_tasks_closed"`
 }
 
 // TimelineEventType describes a type of timeline event.
@@ -109,6 +147,9 @@ const (
 	RunRestored            TimelineEventType = "run_restored"
 	StatusUpdatesEnabled   TimelineEventType = "status_updates_enabled"
 	StatusUpdatesDisabled  TimelineEventType = "status_updates_disabled"
+	CallStarted            TimelineEventType = "call_started"
+	CallEnded              TimelineEventType = "call_ended"
+	SeverityChanged        TimelineEventType = "severity_changed"
 )
 
 // TimelineEvent represents an event recorded to a playbook run's timeline.
@@ -137,6 +178,7 @@ type PlaybookRunCreateOptions struct {
 	PlaybookID      string `json:"playbook_id"`
 	CreatePublicRun *bool  `json:"create_public_run"`
 	Type            string `json:"type"`
+	Severity        string `json:"severity"`
 }
 
 // RunAction represents the run action settings. Frontend passes this struct to update settings.
@@ -245,6 +287,13 @@ type PlaybookRunListOptions struct {
 	// StartedLT filters playbook runs that were started before the unix time given (in millis).
 	// A value of 0 means the filter is ignored (which is the default).
 	StartedLT int64 `url:"started_lt,omitempty"`
+
+	// Properties filters playbook runs that have all the given property values, each formatted as
+	// "field_id:value".
+	Properties []string `url:"property,omitempty"`
+
+	// Severities filters playbook runs declared with any of the given severities.
+	Severities []string `url:"severity,omitempty"`
 }
 
 // PlaybookRunList contains the paginated result.
@@ -6,6 +6,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 )
@@ -190,6 +191,144 @@ func (s *PlaybookRunService) Finish(ctx context.Context, playbookRunID string) e
 	return nil
 }
 
+// SetPropertyValue sets the value of a property field for a playbook run. An empty value clears it.
+func (s *PlaybookRunService) SetPropertyValue(ctx context.Context, playbookRunID, fieldID, value string) error {
+	propertyURL := fmt.Sprintf("runs/%s/property-values/%s", playbookRunID, fieldID)
+	body := struct {
+		Value string `json:"value"`
+	}{value}
+
+	req, err := s.client.newRequest(http.MethodPut, propertyURL, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(ctx, req, nil)
+	return err
+}
+
+// ChangeSeverity changes the severity of a playbook run. An empty severity clears it.
+func (s *PlaybookRunService) ChangeSeverity(ctx context.Context, playbookRunID, severity string) error {
+	severityURL := fmt.Sprintf("runs/%s/severity", playbookRunID)
+	body := struct {
+		Severity string `json:"severity"`
+	}{severity}
+
+	req, err := s.client.newRequest(http.MethodPut, severityURL, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(ctx, req, nil)
+	return err
+}
+
+// SetFollowUpReminder sets the delay and message of the reminder sent to the owner of a playbook
+// run after it finishes. A delay of 0 disables the reminder.
+func (s *PlaybookRunService) SetFollowUpReminder(ctx context.Context, playbookRunID string, delaySeconds int64, message string) error {
+	followUpURL := fmt.Sprintf("runs/%s/follow-up", playbookRunID)
+	body := struct {
+		DelaySeconds int64  `json:"delay_seconds"`
+		Message      string `json:"message"`
+	}{delaySeconds, message}
+
+	req, err := s.client.newRequest(http.MethodPut, followUpURL, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(ctx, req, nil)
+	return err
+}
+
+// StartCall starts a call in the playbook run's channel through the Calls plugin.
+func (s *PlaybookRunService) StartCall(ctx context.Context, playbookRunID string) error {
+	callURL := fmt.Sprintf("runs/%s/call", playbookRunID)
+	req, err := s.client.newRequest(http.MethodPost, callURL, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Export returns the playbook run in export format, including the recordings of its calls.
+func (s *PlaybookRunService) Export(ctx context.Context, playbookRunID string) ([]byte, error) {
+	url := fmt.Sprintf("runs/%s/export", playbookRunID)
+	req, err := s.client.newRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	result, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected status code %d", http.StatusOK)
+	}
+
+	return result, nil
+}
+
+// GetLinks returns the links from a playbook run to the other runs the user can view.
+func (s *PlaybookRunService) GetLinks(ctx context.Context, playbookRunID string) ([]RunRelation, error) {
+	linksURL := fmt.Sprintf("runs/%s/links", playbookRunID)
+	req, err := s.client.newRequest(http.MethodGet, linksURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var links []RunRelation
+	resp, err := s.client.do(ctx, req, &links)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return links, nil
+}
+
+// Link links a playbook run to another run, with a type relative to the former.
+func (s *PlaybookRunService) Link(ctx context.Context, playbookRunID, linkedRunID, linkType string) error {
+	linksURL := fmt.Sprintf("runs/%s/links", playbookRunID)
+	body := struct {
+		RunID string `json:"run_id"`
+		Type  string `json:"type"`
+	}{linkedRunID, linkType}
+
+	req, err := s.client.newRequest(http.MethodPost, linksURL, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(ctx, req, nil)
+	return err
+}
+
+// Unlink removes the link between two playbook runs.
+func (s *PlaybookRunService) Unlink(ctx context.Context, playbookRunID, linkedRunID string) error {
+	linkURL := fmt.Sprintf("runs/%s/links/%s", playbookRunID, linkedRunID)
+	req, err := s.client.newRequest(http.MethodDelete, linkURL, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(ctx, req, nil)
+	return err
+}
+
 func (s *PlaybookRunService) CreateChecklist(ctx context.Context, playbookRunID string, checklist Checklist) error {
 	createURL := fmt.Sprintf("runs/%s/checklists", playbookRunID)
 	req, err := s.client.newRequest(http.MethodPost, createURL, checklist)
@@ -47,3 +47,52 @@ func (s *TelemetryService) CreateEvent(ctx context.Context, name string, eventTy
 
 	return nil
 }
+
+// TelemetryEvent is a telemetry event recorded by the local telemetry sink, holding exactly what
+// would have been sent to Rudder.
+type TelemetryEvent struct {
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	Name       string                 `json:"name"`
+	UserID     string                 `json:"user_id"`
+	Properties map[string]interface{} `json:"properties"`
+	CreateAt   int64                  `json:"create_at"`
+}
+
+// GetTelemetryEventsResults is a page of the events recorded by the local telemetry sink.
+type GetTelemetryEventsResults struct {
+	TotalCount int              `json:"total_count"`
+	PageCount  int              `json:"page_count"`
+	HasMore    bool             `json:"has_more"`
+	Items      []TelemetryEvent `json:"items"`
+}
+
+// GetEvents lists the events recorded by the local telemetry sink, newest first. Only system
+// admins can audit the telemetry.
+func (s *TelemetryService) GetEvents(ctx context.Context, page, perPage int) (*GetTelemetryEventsResults, error) {
+	eventsURL := fmt.Sprintf("telemetry/events?page=%d&per_page=%d", page, perPage)
+	req, err := s.client.newRequest(http.MethodGet, eventsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GetTelemetryEventsResults{}
+	resp, err := s.client.do(ctx, req, result)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return result, nil
+}
+
+// DeleteEvents clears the events recorded by the local telemetry sink.
+func (s *TelemetryService) DeleteEvents(ctx context.Context) error {
+	req, err := s.client.newRequest(http.MethodDelete, "telemetry/events", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(ctx, req, nil)
+	return err
+}
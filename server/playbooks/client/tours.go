@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TourState maps an onboarding tour category to the last step of that tour the user has reached.
+type TourState map[string]int
+
+// ToursService handles communication with the onboarding tour related methods.
+type ToursService struct {
+	client *Client
+}
+
+// Get the onboarding tour state of the given user.
+func (s *ToursService) Get(ctx context.Context, userID string) (TourState, error) {
+	toursURL := fmt.Sprintf("users/%s/tours", userID)
+	req, err := s.client.newRequest(http.MethodGet, toursURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tourState := TourState{}
+	resp, err := s.client.do(ctx, req, &tourState)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return tourState, nil
+}
+
+// UpdateStep records the step the given user has reached in the tour of the given category.
+func (s *ToursService) UpdateStep(ctx context.Context, userID, category string, step int) (TourState, error) {
+	toursURL := fmt.Sprintf("users/%s/tours/%s", userID, category)
+	body := struct {
+		Step int `json:"step"`
+	}{step}
+	req, err := s.client.newRequest(http.MethodPut, toursURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	tourState := TourState{}
+	resp, err := s.client.do(ctx, req, &tourState)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return tourState, nil
+}
+
+// Reset restarts every onboarding tour for the given user.
+func (s *ToursService) Reset(ctx context.Context, userID string) error {
+	toursURL := fmt.Sprintf("users/%s/tours", userID)
+	req, err := s.client.newRequest(http.MethodDelete, toursURL, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.do(ctx, req, nil)
+	return err
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	mm_model "github.com/mattermost/mattermost-server/v6/model"
+	fb_model "github.com/mattermost/mattermost-server/v6/server/boards/model"
 	"github.com/mattermost/mattermost-server/v6/server/channels/app/request"
 	"github.com/mattermost/mattermost-server/v6/server/platform/shared/i18n"
 	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/app"
@@ -104,6 +105,11 @@ func (a *serviceAPIAdapter) CreateChannel(channel *mm_model.Channel) error {
 	return normalizeAppErr(appErr)
 }
 
+func (a *serviceAPIAdapter) PatchChannel(channelID string, patch *mm_model.ChannelPatch, userID string) (*mm_model.Channel, error) {
+	channel, appErr := a.api.channelService.PatchChannel(channelID, patch, userID)
+	return channel, normalizeAppErr(appErr)
+}
+
 func (a *serviceAPIAdapter) AddMemberToChannel(channelID, userID string) (*mm_model.ChannelMember, error) {
 	channelMember, appErr := a.api.channelService.AddChannelMember(channelID, userID)
 	return channelMember, normalizeAppErr(appErr)
@@ -233,6 +239,11 @@ func (a *serviceAPIAdapter) GetGroupMemberUsers(groupID string, page, perPage in
 	return users, normalizeAppErr(appErr)
 }
 
+func (a *serviceAPIAdapter) GetGroupsByUserID(userID string) ([]*mm_model.Group, error) {
+	groups, appErr := a.api.teamService.GetGroupsByUserID(userID)
+	return groups, normalizeAppErr(appErr)
+}
+
 //
 // Permissions service.
 //
@@ -493,5 +504,99 @@ func (a *serviceAPIAdapter) RegisterCollectionAndTopic(collectionType, topicType
 	return a.api.threadsService.RegisterCollectionAndTopic(playbooksProductID, collectionType, topicType)
 }
 
+//
+// Activity service
+//
+
+func (a *serviceAPIAdapter) AddActivities(activities []*mm_model.Activity) error {
+	return normalizeAppErr(a.api.activityService.AddActivities(activities))
+}
+
+//
+// Boards service
+//
+
+func (a *serviceAPIAdapter) GetBoardCard(cardID string) (*playbooks.BoardCard, error) {
+	boardsService, ok := a.api.boardsService()
+	if !ok {
+		return nil, playbooks.ErrBoardsUnavailable
+	}
+
+	card, err := boardsService.GetCard(cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBoardCard(card), nil
+}
+
+func (a *serviceAPIAdapter) CreateBoardCard(card *playbooks.BoardCard, userID string) (*playbooks.BoardCard, error) {
+	boardsService, ok := a.api.boardsService()
+	if !ok {
+		return nil, playbooks.ErrBoardsUnavailable
+	}
+
+	created, err := boardsService.CreateCard(&fb_model.Card{
+		BoardID:    card.BoardID,
+		Title:      card.Title,
+		Properties: card.Properties,
+	}, card.BoardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBoardCard(created), nil
+}
+
+func (a *serviceAPIAdapter) PatchBoardCard(cardID string, patch *playbooks.BoardCardPatch, userID string) (*playbooks.BoardCard, error) {
+	boardsService, ok := a.api.boardsService()
+	if !ok {
+		return nil, playbooks.ErrBoardsUnavailable
+	}
+
+	card, err := boardsService.PatchCard(&fb_model.CardPatch{
+		Title:             patch.Title,
+		UpdatedProperties: patch.UpdatedProperties,
+	}, cardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBoardCard(card), nil
+}
+
+func (a *serviceAPIAdapter) CanManageBoardCards(userID, boardID string) bool {
+	boardsService, ok := a.api.boardsService()
+	if !ok {
+		return false
+	}
+
+	return boardsService.HasPermissionToBoard(userID, boardID, fb_model.PermissionManageBoardCards)
+}
+
+func toBoardCard(card *fb_model.Card) *playbooks.BoardCard {
+	return &playbooks.BoardCard{
+		ID:         card.ID,
+		BoardID:    card.BoardID,
+		Title:      card.Title,
+		ModifiedBy: card.ModifiedBy,
+		Properties: card.Properties,
+		DeleteAt:   card.DeleteAt,
+	}
+}
+
+//
+// Plugins service
+//
+
+func (a *serviceAPIAdapter) IsPluginActive(pluginID string) bool {
+	env := a.api.server.Channels().GetPluginsEnvironment()
+	if env == nil {
+		return false
+	}
+
+	return env.IsActive(pluginID)
+}
+
 // Ensure the adapter implements ServicesAPI.
 var _ playbooks.ServicesAPI = &serviceAPIAdapter{}
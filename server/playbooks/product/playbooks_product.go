@@ -38,8 +38,16 @@ const (
 const (
 	updateMetricsTaskFrequency = 15 * time.Minute
 
+	boardSyncTaskFrequency = time.Minute
+
+	groupSyncTaskFrequency = 5 * time.Minute
+
 	metricsExposePort = ":9093"
 
+	// defaultShutdownDrainTimeout is how long Stop waits for the in-flight API requests to
+	// complete, unless configured otherwise.
+	defaultShutdownDrainTimeout = 30 * time.Second
+
 	// Topic represents a start of a thread. In playbooks we support 2 types of topics:
 	// status topic - indicating the start of the thread below status update and
 	// task topic - indicating the start of the thread below task(checklist item)
@@ -100,6 +108,7 @@ func init() {
 			product.FrontendKey:      {},
 			product.CommandKey:       {},
 			product.ThreadsKey:       {},
+			product.ActivityKey:      {},
 		},
 	})
 }
@@ -129,6 +138,7 @@ type playbooksProduct struct {
 	frontendService      product.FrontendService
 	commandService       product.CommandService
 	threadsService       product.ThreadsService
+	activityService      product.ActivityService
 
 	handler              *api.Handler
 	config               *config.ServiceImpl
@@ -137,6 +147,7 @@ type playbooksProduct struct {
 	permissions          *app.PermissionsService
 	channelActionService app.ChannelActionService
 	categoryService      app.CategoryService
+	healthService        app.HealthService
 	bot                  *bot.Bot
 	userInfoStore        app.UserInfoStore
 	telemetryClient      TelemetryClient
@@ -144,14 +155,22 @@ type playbooksProduct struct {
 	metricsService       *metrics.Metrics
 	playbookStore        app.PlaybookStore
 	playbookRunStore     app.PlaybookRunStore
+	telemetryEventStore  app.TelemetryEventStore
 	metricsServer        *metrics.Service
 	metricsUpdaterTask   *scheduler.ScheduledTask
+	boardSyncTask        *scheduler.ScheduledTask
+	groupSyncTask        *scheduler.ScheduledTask
+	jobOnceScheduler     *cluster.JobOnceScheduler
 
 	serviceAdapter playbooks.ServicesAPI
+
+	// services holds every product service, including the optional ones that are registered
+	// after Playbooks is initialized, such as Boards.
+	services map[product.ServiceKey]interface{}
 }
 
 func newPlaybooksProduct(services map[product.ServiceKey]interface{}) (product.Product, error) {
-	playbooks := &playbooksProduct{}
+	playbooks := &playbooksProduct{services: services}
 	err := playbooks.setProductServices(services)
 	if err != nil {
 		return nil, err
@@ -184,40 +203,44 @@ func newPlaybooksProduct(services map[product.ServiceKey]interface{}) (product.P
 
 	playbooks.handler = api.NewHandler(playbooks.config)
 
-	if rudderDataplaneURL == "" || rudderWriteKey == "" {
-		logrus.Warn("Rudder credentials are not set. Disabling analytics.")
+	diagnosticID := playbooks.serviceAdapter.GetDiagnosticID()
+	serverVersion := playbooks.serviceAdapter.GetServerVersion()
+	rudderTelemetry, err := telemetry.NewRudderWithSink(telemetry.Sink{}, diagnosticID, model.BuildHashPlaybooks, serverVersion)
+	if err != nil {
+		logrus.WithError(err).Warn("Telemetry client could not be created. Disabling analytics.")
 		playbooks.telemetryClient = &telemetry.NoopTelemetry{}
 	} else {
-		diagnosticID := playbooks.serviceAdapter.GetDiagnosticID()
-		serverVersion := playbooks.serviceAdapter.GetServerVersion()
-		playbooks.telemetryClient, err = telemetry.NewRudder(rudderDataplaneURL, rudderWriteKey, diagnosticID, model.BuildHashPlaybooks, serverVersion)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed init telemetry client")
-		}
+		playbooks.telemetryClient = rudderTelemetry
 	}
 
+	// toggleTelemetry applies the telemetry configuration, so that the sink can be changed or
+	// disabled without restarting the server.
 	toggleTelemetry := func() {
-		diagnosticsFlag := playbooks.serviceAdapter.GetConfig().LogSettings.EnableDiagnostics
-		telemetryEnabled := diagnosticsFlag != nil && *diagnosticsFlag
+		if rudderTelemetry == nil {
+			return
+		}
 
-		if telemetryEnabled {
-			if err = playbooks.telemetryClient.Enable(); err != nil {
-				logrus.WithError(err).Error("Telemetry could not be enabled")
+		sink, telemetryEnabled := playbooks.telemetrySink()
+
+		if !telemetryEnabled {
+			if err := rudderTelemetry.Disable(); err != nil {
+				logrus.WithError(err).Error("Telemetry could not be disabled")
 			}
 			return
 		}
 
-		if err = playbooks.telemetryClient.Disable(); err != nil {
-			logrus.WithError(err).Error("Telemetry could not be disabled")
+		if err := rudderTelemetry.Reconfigure(sink); err != nil {
+			logrus.WithError(err).Error("Telemetry could not be reconfigured")
+		}
+		if err := rudderTelemetry.Enable(); err != nil {
+			logrus.WithError(err).Error("Telemetry could not be enabled")
 		}
 	}
 
-	toggleTelemetry()
-	playbooks.config.RegisterConfigChangeListener(toggleTelemetry)
-
 	apiClient := sqlstore.NewClient(playbooks.serviceAdapter)
 	playbooks.bot = bot.New(playbooks.serviceAdapter, playbooks.config.GetConfiguration().BotUserID, playbooks.config, playbooks.telemetryClient)
 	scheduler := cluster.GetJobOnceScheduler(playbooks.serviceAdapter)
+	playbooks.jobOnceScheduler = scheduler
 
 	sqlStore, err := sqlstore.New(apiClient, scheduler)
 	if err != nil {
@@ -230,6 +253,11 @@ func newPlaybooksProduct(services map[product.ServiceKey]interface{}) (product.P
 	playbooks.userInfoStore = sqlstore.NewUserInfoStore(sqlStore)
 	channelActionStore := sqlstore.NewChannelActionStore(apiClient, sqlStore)
 	categoryStore := sqlstore.NewCategoryStore(apiClient, sqlStore)
+	playbooks.telemetryEventStore = sqlstore.NewTelemetryEventStore(sqlStore)
+
+	// The telemetry is only configured once the store is available, since the local sink needs it.
+	toggleTelemetry()
+	playbooks.config.RegisterConfigChangeListener(toggleTelemetry)
 
 	playbooks.handler = api.NewHandler(playbooks.config)
 
@@ -240,6 +268,7 @@ func newPlaybooksProduct(services map[product.ServiceKey]interface{}) (product.P
 	playbooks.categoryService = app.NewCategoryService(categoryStore, playbooks.serviceAdapter, playbooks.telemetryClient)
 
 	playbooks.licenseChecker = enterprise.NewLicenseChecker(playbooks.serviceAdapter)
+	boardSyncService := app.NewBoardSyncService(playbooks.playbookRunStore, playbooks.playbookService, playbooks.serviceAdapter)
 
 	playbooks.playbookRunService = app.NewPlaybookRunService(
 		playbooks.playbookRunStore,
@@ -253,6 +282,8 @@ func newPlaybooksProduct(services map[product.ServiceKey]interface{}) (product.P
 		playbooks.channelActionService,
 		playbooks.licenseChecker,
 		playbooks.metricsService,
+		boardSyncService,
+		playbooks.categoryService,
 	)
 
 	if err = scheduler.SetCallback(playbooks.playbookRunService.HandleReminder); err != nil {
@@ -274,6 +305,8 @@ func newPlaybooksProduct(services map[product.ServiceKey]interface{}) (product.P
 	}
 	mutex.Unlock()
 
+	playbooks.healthService = app.NewHealthService(sqlStore, sqlstore.LatestVersion(), scheduler, playbooks.metricsServerStatus)
+
 	playbooks.permissions = app.NewPermissionsService(
 		playbooks.playbookService,
 		playbooks.playbookRunService,
@@ -334,6 +367,11 @@ func newPlaybooksProduct(services map[product.ServiceKey]interface{}) (product.P
 		playbooks.playbookRunService,
 		playbooks.userInfoStore,
 	)
+	api.NewTourHandler(
+		playbooks.handler.APIRouter,
+		playbooks.serviceAdapter,
+		playbooks.userInfoStore,
+	)
 	api.NewTelemetryHandler(
 		playbooks.handler.APIRouter,
 		playbooks.playbookRunService,
@@ -344,6 +382,7 @@ func newPlaybooksProduct(services map[product.ServiceKey]interface{}) (product.P
 		playbooks.telemetryClient,
 		playbooks.telemetryClient,
 		playbooks.permissions,
+		playbooks.telemetryEventStore,
 	)
 	api.NewSignalHandler(
 		playbooks.handler.APIRouter,
@@ -357,6 +396,11 @@ func newPlaybooksProduct(services map[product.ServiceKey]interface{}) (product.P
 		playbooks.serviceAdapter,
 		playbooks.config,
 	)
+	api.NewCapabilitiesHandler(
+		playbooks.handler.APIRouter,
+		playbooks.licenseChecker,
+		playbooks.config,
+	)
 	api.NewActionsHandler(
 		playbooks.handler.APIRouter,
 		playbooks.channelActionService,
@@ -370,6 +414,18 @@ func newPlaybooksProduct(services map[product.ServiceKey]interface{}) (product.P
 		playbooks.playbookService,
 		playbooks.playbookRunService,
 	)
+	api.NewHealthHandler(
+		playbooks.handler.HealthRouter,
+		playbooks.healthService,
+	)
+
+	api.NewTestingHandler(
+		playbooks.handler.APIRouter,
+		playbooks.handler.LocalRouter,
+		playbooks.serviceAdapter,
+		playbooks.playbookRunService,
+		app.NewTestDataGenerator(playbooks.playbookService, playbooks.playbookRunService, playbooks.serviceAdapter),
+	)
 
 	isTestingEnabled := false
 	flag := playbooks.serviceAdapter.GetConfig().ServiceSettings.EnableTesting
@@ -525,11 +581,23 @@ func (pp *playbooksProduct) setProductServices(services map[product.ServiceKey]i
 				return fmt.Errorf("invalid service key '%s': %w", key, errServiceTypeAssert)
 			}
 			pp.threadsService = threadsService
+		case product.ActivityKey:
+			activityService, ok := service.(product.ActivityService)
+			if !ok {
+				return fmt.Errorf("invalid service key '%s': %w", key, errServiceTypeAssert)
+			}
+			pp.activityService = activityService
 		}
 	}
 	return nil
 }
 
+// boardsService returns the Boards product service, if Boards is enabled and running.
+func (pp *playbooksProduct) boardsService() (product.BoardsService, bool) {
+	boardsService, ok := pp.services[product.BoardsKey].(product.BoardsService)
+	return boardsService, ok
+}
+
 func (pp *playbooksProduct) Start() error {
 	if err := pp.hooksService.RegisterHooks(playbooksProductName, pp); err != nil {
 		return fmt.Errorf("failed to register hooks: %w", err)
@@ -546,13 +614,30 @@ func (pp *playbooksProduct) Start() error {
 		pp.handler.APIRouter.Use(pp.getErrorCounterHandler())
 	}
 
+	pp.runBoardSyncTask(pp.playbookRunStore, boardSyncTaskFrequency)
+	pp.runGroupSyncTask(pp.playbookRunStore, groupSyncTaskFrequency)
+
 	pp.routerService.RegisterRouter(playbooksProductName, pp.handler.APIRouter)
+	pp.routerService.RegisterLocalRouter(playbooksProductName, pp.handler.LocalRouter)
 
 	logrus.Debug("Playbooks product successfully started.")
 	return nil
 }
 
 func (pp *playbooksProduct) Stop() error {
+	// Drain the API first, so that the requests in flight, such as status updates, can still
+	// schedule their reminders and send their telemetry.
+	drainTimeout := defaultShutdownDrainTimeout
+	if seconds := pp.config.GetConfiguration().ShutdownDrainTimeoutSeconds; seconds > 0 {
+		drainTimeout = time.Duration(seconds) * time.Second
+	}
+	if err := pp.handler.Drain(drainTimeout); err != nil {
+		logrus.WithError(err).Warn("unable to drain the in-flight API requests")
+	}
+
+	// The scheduled jobs are kept, and run by the other servers of the cluster or after restart.
+	pp.jobOnceScheduler.Stop()
+
 	if pp.metricsServer != nil {
 		err := pp.metricsServer.Shutdown()
 		if err != nil {
@@ -562,9 +647,76 @@ func (pp *playbooksProduct) Stop() error {
 	if pp.metricsUpdaterTask != nil {
 		pp.metricsUpdaterTask.Cancel()
 	}
+	if pp.boardSyncTask != nil {
+		pp.boardSyncTask.Cancel()
+	}
+	if pp.groupSyncTask != nil {
+		pp.groupSyncTask.Cancel()
+	}
+
+	// Disabling the telemetry client closes it, flushing the pending events.
+	if err := pp.telemetryClient.Disable(); err != nil {
+		logrus.WithError(err).Warn("unable to flush telemetry")
+	}
 	return nil
 }
 
+// telemetrySink returns where the telemetry events are sent according to the configuration, and
+// whether they are sent at all.
+func (pp *playbooksProduct) telemetrySink() (telemetry.Sink, bool) {
+	diagnosticsFlag := pp.serviceAdapter.GetConfig().LogSettings.EnableDiagnostics
+	diagnosticsEnabled := diagnosticsFlag != nil && *diagnosticsFlag
+
+	cfg := pp.config.GetConfiguration()
+	switch cfg.TelemetryMode {
+	case config.TelemetryModeDisabled:
+		return telemetry.Sink{}, false
+
+	case config.TelemetryModeFile:
+		if cfg.TelemetryFilePath == "" {
+			logrus.Warn("Telemetry file path is not set. Disabling analytics.")
+			return telemetry.Sink{}, false
+		}
+		return telemetry.Sink{FilePath: cfg.TelemetryFilePath}, true
+
+	case config.TelemetryModeLocal:
+		return telemetry.Sink{Store: pp.telemetryEventStore}, true
+
+	case config.TelemetryModeCustom:
+		if cfg.TelemetryDataPlaneURL == "" || cfg.TelemetryWriteKey == "" {
+			logrus.Warn("Telemetry endpoint is not set. Disabling analytics.")
+			return telemetry.Sink{}, false
+		}
+		return telemetry.Sink{DataPlaneURL: cfg.TelemetryDataPlaneURL, WriteKey: cfg.TelemetryWriteKey}, diagnosticsEnabled
+
+	case config.TelemetryModeDefault:
+		if rudderDataplaneURL == "" || rudderWriteKey == "" {
+			logrus.Warn("Rudder credentials are not set. Disabling analytics.")
+			return telemetry.Sink{}, false
+		}
+		return telemetry.Sink{DataPlaneURL: rudderDataplaneURL, WriteKey: rudderWriteKey}, diagnosticsEnabled
+
+	default:
+		logrus.WithField("telemetry_mode", cfg.TelemetryMode).Warn("Unknown telemetry mode. Disabling analytics.")
+		return telemetry.Sink{}, false
+	}
+}
+
+// HealthCheck implements product.HealthChecker, including Playbooks in the server health check.
+func (pp *playbooksProduct) HealthCheck() error {
+	return pp.healthService.Check().Err()
+}
+
+// metricsServerStatus reports whether the metrics server is enabled and, if so, whether it is
+// running.
+func (pp *playbooksProduct) metricsServerStatus() (enabled, running bool) {
+	if pp.metricsServer == nil {
+		return false, false
+	}
+
+	return true, pp.metricsServer.IsRunning()
+}
+
 func newMetricsInstance() *metrics.Metrics {
 	// Init metrics
 	instanceInfo := metrics.InstanceInfo{
@@ -629,6 +781,49 @@ func (pp *playbooksProduct) runMetricsUpdaterTask(playbookStore app.PlaybookStor
 	pp.metricsUpdaterTask = scheduler.CreateRecurringTask("metricsUpdater", metricsUpdater, updateMetricsTaskFrequency)
 }
 
+// runBoardSyncTask periodically applies the changes made from Boards to the cards mirroring runs.
+func (pp *playbooksProduct) runBoardSyncTask(playbookRunStore app.PlaybookRunStore, boardSyncTaskFrequency time.Duration) {
+	boardSync := func() {
+		if _, ok := pp.boardsService(); !ok {
+			return
+		}
+
+		runIDs, err := playbookRunStore.GetBoardLinkedRunIDs()
+		if err != nil {
+			logrus.WithError(err).Error("failed to get the runs linked to a board")
+			return
+		}
+
+		for _, runID := range runIDs {
+			if err := pp.playbookRunService.SyncFromBoard(runID); err != nil {
+				logrus.WithError(err).WithField("playbook_run_id", runID).Warn("failed to sync playbook run from board")
+			}
+		}
+	}
+
+	pp.boardSyncTask = scheduler.CreateRecurringTask("boardSync", boardSync, boardSyncTaskFrequency)
+}
+
+// runGroupSyncTask periodically keeps the participants of runs in sync with their invited groups.
+// Polling is required since the group memberships changed by the LDAP sync do not notify products.
+func (pp *playbooksProduct) runGroupSyncTask(playbookRunStore app.PlaybookRunStore, groupSyncTaskFrequency time.Duration) {
+	groupSync := func() {
+		runIDs, err := playbookRunStore.GetGroupSyncedRunIDs()
+		if err != nil {
+			logrus.WithError(err).Error("failed to get the runs synced with their invited groups")
+			return
+		}
+
+		for _, runID := range runIDs {
+			if err := pp.playbookRunService.SyncInvitedGroups(runID); err != nil {
+				logrus.WithError(err).WithField("playbook_run_id", runID).Warn("failed to sync playbook run participants with invited groups")
+			}
+		}
+	}
+
+	pp.groupSyncTask = scheduler.CreateRecurringTask("groupSync", groupSync, groupSyncTaskFrequency)
+}
+
 func (pp *playbooksProduct) getErrorCounterHandler() func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -695,6 +890,10 @@ func (pp *playbooksProduct) MessageHasBeenPosted(c *plugin.Context, post *model.
 	pp.playbookRunService.MessageHasBeenPosted(post)
 }
 
+func (pp *playbooksProduct) MessageHasBeenUpdated(c *plugin.Context, newPost, oldPost *model.Post) {
+	pp.playbookRunService.MessageHasBeenUpdated(newPost, oldPost)
+}
+
 func (pp *playbooksProduct) UserHasPermissionToCollection(c *plugin.Context, userID string, collectionType, collectionID string, permission *model.Permission) (bool, error) {
 	if collectionType != CollectionTypeRun {
 		return false, errors.Errorf("collection %s is not registered by playbooks", collectionType)
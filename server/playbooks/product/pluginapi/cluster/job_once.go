@@ -75,6 +75,18 @@ func (j *JobOnce) Cancel() {
 	})
 }
 
+// stop terminates the job's goroutine on this plugin instance, waiting for its callback to return
+// if it is being run. Unlike Cancel, the job is kept in the db.
+func (j *JobOnce) stop() {
+	j.doneOnce.Do(func() {
+		close(j.done)
+	})
+
+	j.joinOnce.Do(func() {
+		<-j.join
+	})
+}
+
 func newJobOnce(pluginAPI JobPluginAPI, key string, runAt time.Time, callback *syncedCallback, jobs *syncedJobs) (*JobOnce, error) {
 	mutex, err := NewMutex(pluginAPI, key)
 	if err != nil {
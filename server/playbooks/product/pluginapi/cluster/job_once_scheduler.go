@@ -29,6 +29,8 @@ type JobOnceScheduler struct {
 
 	startedMu sync.RWMutex
 	started   bool
+	// stop signals the poller to exit. It is created every time the scheduler is started.
+	stop chan struct{}
 
 	activeJobs     *syncedJobs
 	storedCallback *syncedCallback
@@ -64,13 +66,54 @@ func (s *JobOnceScheduler) Start() error {
 		return errors.Wrap(err, "could not start JobOnceScheduler due to error")
 	}
 
-	go s.pollForNewScheduledJobs()
+	s.stop = make(chan struct{})
+	go s.pollForNewScheduledJobs(s.stop)
 
 	s.started = true
 
 	return nil
 }
 
+// Stop stops the scheduler, waiting for the callbacks being run to return. Unlike Cancel, the
+// jobs are kept in the db, so that they are run by another server of the cluster, or once the
+// scheduler is started again.
+func (s *JobOnceScheduler) Stop() {
+	// using an anonymous function because the callbacks being waited for below may schedule new
+	// jobs, which needs the started mutex
+	jobs := func() []*JobOnce {
+		s.startedMu.Lock()
+		defer s.startedMu.Unlock()
+		if !s.started {
+			return nil
+		}
+
+		close(s.stop)
+		s.started = false
+
+		s.activeJobs.mu.Lock()
+		defer s.activeJobs.mu.Unlock()
+		jobs := make([]*JobOnce, 0, len(s.activeJobs.jobs))
+		for key, job := range s.activeJobs.jobs {
+			jobs = append(jobs, job)
+			delete(s.activeJobs.jobs, key)
+		}
+
+		return jobs
+	}()
+
+	for _, job := range jobs {
+		job.stop()
+	}
+}
+
+// IsRunning returns true once the scheduler has been started.
+func (s *JobOnceScheduler) IsRunning() bool {
+	s.startedMu.RLock()
+	defer s.startedMu.RUnlock()
+
+	return s.started
+}
+
 // SetCallback sets the scheduler's callback. When a job fires, the callback will be called with
 // the job's id.
 func (s *JobOnceScheduler) SetCallback(callback func(string)) error {
@@ -209,10 +252,15 @@ func (s *JobOnceScheduler) runAndTrack(job *JobOnce) {
 	s.activeJobs.jobs[job.key] = job
 }
 
-// pollForNewScheduledJobs will only be started once per plugin. It doesn't need to be stopped.
-func (s *JobOnceScheduler) pollForNewScheduledJobs() {
+// pollForNewScheduledJobs is started with the scheduler, and runs until the given channel is
+// closed by Stop.
+func (s *JobOnceScheduler) pollForNewScheduledJobs(stop chan struct{}) {
 	for {
-		<-time.After(pollNewJobsInterval + addJitter())
+		select {
+		case <-stop:
+			return
+		case <-time.After(pollNewJobsInterval + addJitter()):
+		}
 
 		if err := s.scheduleNewJobsFromDB(); err != nil {
 			logrus.WithError(err).Error("scheduleOnce poller encountered an error but is still polling")
@@ -6,6 +6,8 @@ package api
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -28,8 +30,13 @@ const MaxRequestSize = 5 * 1024 * 1024 // 5MB
 type Handler struct {
 	*ErrorHandler
 	APIRouter *mux.Router
-	root      *mux.Router
-	config    config.Service
+	// LocalRouter serves the endpoints only reachable through the local mode socket.
+	LocalRouter *mux.Router
+	// HealthRouter serves the health check, which does not require authentication.
+	HealthRouter *mux.Router
+	root         *mux.Router
+	config       config.Service
+	drainer      *drainer
 }
 
 // NewHandler constructs a new handler.
@@ -37,9 +44,20 @@ func NewHandler(config config.Service) *Handler {
 	handler := &Handler{
 		ErrorHandler: &ErrorHandler{},
 		config:       config,
+		drainer:      newDrainer(),
 	}
 
 	root := mux.NewRouter()
+	root.Use(handler.drainer.Middleware)
+
+	local := root.PathPrefix("/api/v0/local").Subrouter()
+	local.Use(LogRequest)
+	local.Use(LocalModeRequired)
+
+	// The health check is registered before the API router, which would otherwise reject the
+	// unauthenticated requests of load balancers.
+	health := root.PathPrefix("/api/v0/health").Subrouter()
+
 	api := root.PathPrefix("/api/v0").Subrouter()
 	api.Use(LogRequest)
 	api.Use(MattermostAuthorizationRequired)
@@ -48,12 +66,21 @@ func NewHandler(config config.Service) *Handler {
 	api.NotFoundHandler = http.NotFoundHandler()
 
 	handler.APIRouter = api
+	handler.LocalRouter = local
+	handler.HealthRouter = health
 	handler.root = root
 	handler.config = config
 
 	return handler
 }
 
+// Drain stops accepting requests and waits for the in-flight ones to complete. The requests
+// received afterwards are rejected with 503. If the in-flight requests do not complete before
+// the timeout, their context is cancelled and an error is returned.
+func (h *Handler) Drain(timeout time.Duration) error {
+	return h.drainer.drain(timeout)
+}
+
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	r.Body = http.MaxBytesReader(w, r.Body, MaxRequestSize)
 	h.root.ServeHTTP(w, r)
@@ -118,3 +145,17 @@ func MattermostAuthorizationRequired(next http.Handler) http.Handler {
 		http.Error(w, "Not authorized", http.StatusUnauthorized)
 	})
 }
+
+// LocalModeRequired checks if the request was received through the local mode socket.
+func LocalModeRequired(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// if the connection is local, RemoteAddr doesn't have the
+		// shape IP:PORT (it will be "@" in Linux, for example)
+		if !strings.Contains(r.RemoteAddr, ":") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		http.Error(w, "Local origin required", http.StatusUnauthorized)
+	})
+}
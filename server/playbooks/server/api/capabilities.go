@@ -0,0 +1,52 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/client"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/app"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/config"
+)
+
+// CapabilitiesHandler is the API handler for the features available under the current license
+// and configuration.
+type CapabilitiesHandler struct {
+	*ErrorHandler
+	licenseChecker app.LicenseChecker
+	config         config.Service
+}
+
+// NewCapabilitiesHandler returns a new capabilities api handler
+func NewCapabilitiesHandler(router *mux.Router, licenseChecker app.LicenseChecker, configService config.Service) *CapabilitiesHandler {
+	handler := &CapabilitiesHandler{
+		ErrorHandler:   &ErrorHandler{},
+		licenseChecker: licenseChecker,
+		config:         configService,
+	}
+
+	capabilitiesRouter := router.PathPrefix("/capabilities").Subrouter()
+	capabilitiesRouter.HandleFunc("", handler.getCapabilities).Methods(http.MethodGet)
+
+	return handler
+}
+
+func (h *CapabilitiesHandler) getCapabilities(w http.ResponseWriter, r *http.Request) {
+	capabilities := client.Capabilities{
+		PrivatePlaybooks:     h.licenseChecker.PlaybookAllowed(false),
+		Retrospective:        h.licenseChecker.RetrospectiveAllowed(),
+		Timeline:             h.licenseChecker.TimelineAllowed(),
+		Stats:                h.licenseChecker.StatsAllowed(),
+		Metrics:              h.licenseChecker.MetricsAllowed(),
+		ChecklistItemDueDate: h.licenseChecker.ChecklistItemDueDateAllowed(),
+		ChannelExport:        h.licenseChecker.ChannelExportAllowed(),
+		RequestUpdate:        h.licenseChecker.RequestUpdateAllowed(),
+		MultipleChecklists:   h.licenseChecker.MultipleChecklistsAllowed(),
+		ExperimentalFeatures: h.config.GetConfiguration().EnableExperimentalFeatures,
+	}
+
+	ReturnJSON(w, &capabilities, http.StatusOK)
+}
@@ -44,6 +44,14 @@ func NewCategoryHandler(router *mux.Router, api playbooks.ServicesAPI, categoryS
 	categoryRouter.HandleFunc("", withContext(handler.deleteMyCategory)).Methods(http.MethodDelete)
 	categoryRouter.HandleFunc("/collapse", withContext(handler.collapseMyCategory)).Methods(http.MethodPut)
 
+	rulesRouter := router.PathPrefix("/category_rules").Subrouter()
+	rulesRouter.HandleFunc("", withContext(handler.getCategoryRules)).Methods(http.MethodGet)
+	rulesRouter.HandleFunc("", withContext(handler.createCategoryRule)).Methods(http.MethodPost)
+
+	ruleRouter := rulesRouter.PathPrefix("/{id:[A-Za-z0-9]+}").Subrouter()
+	ruleRouter.HandleFunc("", withContext(handler.updateCategoryRule)).Methods(http.MethodPut)
+	ruleRouter.HandleFunc("", withContext(handler.deleteCategoryRule)).Methods(http.MethodDelete)
+
 	return handler
 }
 
@@ -353,3 +361,102 @@ func filterEmptyCategories(categories []app.Category) []app.Category {
 	}
 	return newCategories
 }
+
+// isCategoryRulesAdmin checks that the user can manage the category rules, which apply to every user.
+func (h *CategoryHandler) isCategoryRulesAdmin(c *Context, w http.ResponseWriter, userID string) bool {
+	if !app.IsSystemAdmin(userID, h.api) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusForbidden, "Managing category rules is restricted to system administrators.", nil)
+		return false
+	}
+	return true
+}
+
+// getCategoryRules handles the GET /category_rules endpoint.
+func (h *CategoryHandler) getCategoryRules(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !h.isCategoryRulesAdmin(c, w, r.Header.Get("Mattermost-User-ID")) {
+		return
+	}
+
+	rules, err := h.categoryService.GetRules()
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	ReturnJSON(w, rules, http.StatusOK)
+}
+
+// createCategoryRule handles the POST /category_rules endpoint.
+func (h *CategoryHandler) createCategoryRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !h.isCategoryRulesAdmin(c, w, r.Header.Get("Mattermost-User-ID")) {
+		return
+	}
+
+	var rule app.CategoryRule
+	if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "unable to decode category rule", err)
+		return
+	}
+
+	if rule.ID != "" {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "Category rule given already has ID", nil)
+		return
+	}
+
+	if err := rule.IsValid(); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "invalid category rule", err)
+		return
+	}
+
+	id, err := h.categoryService.CreateRule(rule)
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	w.Header().Add("Location", makeAPIURL(h.api, "category_rules/%s", id))
+	ReturnJSON(w, map[string]string{"id": id}, http.StatusCreated)
+}
+
+// updateCategoryRule handles the PUT /category_rules/{id} endpoint.
+func (h *CategoryHandler) updateCategoryRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !h.isCategoryRulesAdmin(c, w, r.Header.Get("Mattermost-User-ID")) {
+		return
+	}
+
+	var rule app.CategoryRule
+	if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "unable to decode category rule", err)
+		return
+	}
+
+	rule.ID = mux.Vars(r)["id"]
+	if err := rule.IsValid(); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "invalid category rule", err)
+		return
+	}
+
+	if err := h.categoryService.UpdateRule(rule); errors.Is(err, app.ErrNotFound) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusNotFound, "category rule not found", err)
+		return
+	} else if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// deleteCategoryRule handles the DELETE /category_rules/{id} endpoint.
+func (h *CategoryHandler) deleteCategoryRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !h.isCategoryRulesAdmin(c, w, r.Header.Get("Mattermost-User-ID")) {
+		return
+	}
+
+	if err := h.categoryService.DeleteRule(mux.Vars(r)["id"]); err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// drainer tracks the in-flight requests, so that they can complete before Playbooks stops.
+type drainer struct {
+	// mu guards draining, and orders the additions to inFlight before the wait in drain.
+	mu       sync.RWMutex
+	draining bool
+	inFlight sync.WaitGroup
+
+	// ctx is cancelled when the in-flight requests did not complete in time.
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newDrainer() *drainer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &drainer{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// begin registers a new in-flight request, returning false if the requests are being drained.
+func (d *drainer) begin() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.draining {
+		return false
+	}
+
+	d.inFlight.Add(1)
+	return true
+}
+
+// Middleware rejects the requests received while draining, and cancels the context of the
+// in-flight requests that did not complete in time.
+func (d *drainer) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !d.begin() {
+			http.Error(w, "Playbooks is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		defer d.inFlight.Done()
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		go func() {
+			select {
+			case <-d.ctx.Done():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// drain stops accepting requests and waits for the in-flight ones to complete. If they do not
+// complete before the timeout, their context is cancelled and an error is returned.
+func (d *drainer) drain(timeout time.Duration) error {
+	d.mu.Lock()
+	d.draining = true
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+		d.cancel()
+		return errors.Errorf("in-flight requests did not complete within %s", timeout)
+	}
+}
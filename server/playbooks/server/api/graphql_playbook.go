@@ -59,6 +59,10 @@ func (r *PlaybookResolver) RetrospectiveReminderIntervalSeconds() float64 {
 	return float64(r.Playbook.RetrospectiveReminderIntervalSeconds)
 }
 
+func (r *PlaybookResolver) FollowUpReminderDelaySeconds() float64 {
+	return float64(r.Playbook.FollowUpReminderDelaySeconds)
+}
+
 func (r *PlaybookResolver) ReminderTimerDefaultSeconds() float64 {
 	return float64(r.Playbook.ReminderTimerDefaultSeconds)
 }
@@ -84,6 +88,55 @@ func (r *MetricConfigResolver) Target() *int32 {
 	return nil
 }
 
+func (r *PlaybookResolver) PropertyFields() []*PropertyFieldResolver {
+	propertyFieldResolvers := make([]*PropertyFieldResolver, 0, len(r.Playbook.PropertyFields))
+	for _, field := range r.Playbook.PropertyFields {
+		propertyFieldResolvers = append(propertyFieldResolvers, &PropertyFieldResolver{field})
+	}
+
+	return propertyFieldResolvers
+}
+
+type PropertyFieldResolver struct {
+	app.PropertyField
+}
+
+func (r *PropertyFieldResolver) Options() []*app.PropertyOption {
+	options := make([]*app.PropertyOption, 0, len(r.PropertyField.Options))
+	for i := range r.PropertyField.Options {
+		options = append(options, &r.PropertyField.Options[i])
+	}
+
+	return options
+}
+
+// SeverityLevels returns the levels available to the runs of the playbook, which are the default
+// levels unless the playbook configures its own.
+func (r *PlaybookResolver) SeverityLevels() []*SeverityLevelResolver {
+	levels := r.Playbook.GetSeverityLevels()
+	severityLevelResolvers := make([]*SeverityLevelResolver, 0, len(levels))
+	for _, level := range levels {
+		severityLevelResolvers = append(severityLevelResolvers, &SeverityLevelResolver{level})
+	}
+
+	return severityLevelResolvers
+}
+
+type SeverityLevelResolver struct {
+	app.SeverityLevel
+}
+
+func (r *SeverityLevelResolver) BroadcastChannelIDs() []string {
+	if r.SeverityLevel.BroadcastChannelIDs == nil {
+		return []string{}
+	}
+	return r.SeverityLevel.BroadcastChannelIDs
+}
+
+func (r *SeverityLevelResolver) ReminderTimerDefaultSeconds() float64 {
+	return float64(r.SeverityLevel.ReminderTimerDefaultSeconds)
+}
+
 func (r *PlaybookResolver) Checklists() []*ChecklistResolver {
 	checklistResolvers := make([]*ChecklistResolver, 0, len(r.Playbook.Checklists))
 	for _, checklist := range r.Playbook.Checklists {
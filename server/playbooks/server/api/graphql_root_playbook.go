@@ -66,7 +66,7 @@ func (r *PlaybookRootResolver) Playbooks(ctx context.Context, args struct {
 	requesterInfo := app.RequesterInfo{
 		UserID:  userID,
 		TeamID:  args.TeamID,
-		IsAdmin: app.IsSystemAdmin(userID, c.api),
+		IsAdmin: app.IsPlaybooksAdmin(userID, c.api),
 	}
 
 	opts := app.PlaybookFilterOptions{
@@ -156,6 +156,7 @@ func (r *PlaybookRootResolver) UpdatePlaybook(ctx context.Context, args struct {
 		InvitedUserIDs                          *[]string
 		InvitedGroupIDs                         *[]string
 		InviteUsersEnabled                      *bool
+		SyncInvitedGroupsEnabled                *bool
 		DefaultOwnerID                          *string
 		DefaultOwnerEnabled                     *bool
 		BroadcastChannelIDs                     *[]string
@@ -167,6 +168,9 @@ func (r *PlaybookRootResolver) UpdatePlaybook(ctx context.Context, args struct {
 		RetrospectiveReminderIntervalSeconds    *float64
 		RetrospectiveTemplate                   *string
 		RetrospectiveEnabled                    *bool
+		FollowUpReminderEnabled                 *bool
+		FollowUpReminderDelaySeconds            *float64
+		FollowUpReminderMessage                 *string
 		WebhookOnStatusUpdateURLs               *[]string
 		WebhookOnStatusUpdateEnabled            *bool
 		SignalAnyKeywords                       *[]string
@@ -238,6 +242,7 @@ func (r *PlaybookRootResolver) UpdatePlaybook(ctx context.Context, args struct {
 	}
 
 	addToSetmap(setmap, "InviteUsersEnabled", args.Updates.InviteUsersEnabled)
+	addToSetmap(setmap, "SyncInvitedGroupsEnabled", args.Updates.SyncInvitedGroupsEnabled)
 	if args.Updates.DefaultOwnerID != nil {
 		if !c.api.HasPermissionToTeam(*args.Updates.DefaultOwnerID, currentPlaybook.TeamID, model.PermissionViewTeam) {
 			return "", errors.Wrap(app.ErrNoPermissions, "default owner can't view team")
@@ -266,6 +271,14 @@ func (r *PlaybookRootResolver) UpdatePlaybook(ctx context.Context, args struct {
 	addToSetmap(setmap, "RetrospectiveReminderIntervalSeconds", args.Updates.RetrospectiveReminderIntervalSeconds)
 	addToSetmap(setmap, "RetrospectiveTemplate", args.Updates.RetrospectiveTemplate)
 	addToSetmap(setmap, "RetrospectiveEnabled", args.Updates.RetrospectiveEnabled)
+	addToSetmap(setmap, "FollowUpReminderEnabled", args.Updates.FollowUpReminderEnabled)
+	if args.Updates.FollowUpReminderDelaySeconds != nil {
+		if err := app.ValidateFollowUpReminder(int64(*args.Updates.FollowUpReminderDelaySeconds)); err != nil {
+			return "", err
+		}
+		addToSetmap(setmap, "FollowUpReminderDelaySeconds", args.Updates.FollowUpReminderDelaySeconds)
+	}
+	addToSetmap(setmap, "FollowUpReminderMessage", args.Updates.FollowUpReminderMessage)
 	if args.Updates.WebhookOnStatusUpdateURLs != nil {
 		if err := app.ValidateWebhookURLs(*args.Updates.WebhookOnStatusUpdateURLs); err != nil {
 			return "", err
@@ -47,6 +47,8 @@ func (r *RunRootResolver) Runs(ctx context.Context, args struct {
 	First                   *int32
 	After                   *string
 	Types                   []string
+	PropertyValues          []app.PropertyValue
+	Severities              []string
 }) (*RunConnectionResolver, error) {
 	c, err := getContext(ctx)
 	if err != nil {
@@ -57,7 +59,7 @@ func (r *RunRootResolver) Runs(ctx context.Context, args struct {
 	requesterInfo := app.RequesterInfo{
 		UserID:  userID,
 		TeamID:  args.TeamID,
-		IsAdmin: app.IsSystemAdmin(userID, c.api),
+		IsAdmin: app.IsPlaybooksAdmin(userID, c.api),
 	}
 
 	if args.ParticipantOrFollowerID == client.Me {
@@ -86,6 +88,8 @@ func (r *RunRootResolver) Runs(ctx context.Context, args struct {
 		ChannelID:               args.ChannelID,
 		IncludeFavorites:        true,
 		Types:                   args.Types,
+		PropertyValues:          args.PropertyValues,
+		Severities:              args.Severities,
 		Page:                    page,
 		PerPage:                 perPage,
 	}
@@ -325,3 +329,49 @@ func (r *RunRootResolver) UpdateRunTaskActions(ctx context.Context, args struct
 
 	return "", nil
 }
+
+func (r *RunRootResolver) SetRunPropertyValue(ctx context.Context, args struct {
+	RunID   string
+	FieldID string
+	Value   string
+}) (string, error) {
+	c, err := getContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	userID := c.r.Header.Get("Mattermost-User-ID")
+
+	if err := c.permissions.RunManageProperties(userID, args.RunID); err != nil {
+		return "", errors.Wrap(err, "attempted to set a property value without permissions")
+	}
+
+	if err := c.playbookRunService.SetPropertyValue(args.RunID, userID, app.PropertyValue{
+		FieldID: args.FieldID,
+		Value:   args.Value,
+	}); err != nil {
+		return "", errors.Wrap(err, "failed to set the property value")
+	}
+
+	return "", nil
+}
+
+func (r *RunRootResolver) ChangeRunSeverity(ctx context.Context, args struct {
+	RunID    string
+	Severity string
+}) (string, error) {
+	c, err := getContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	userID := c.r.Header.Get("Mattermost-User-ID")
+
+	if err := c.permissions.RunManageProperties(userID, args.RunID); err != nil {
+		return "", errors.Wrap(err, "attempted to change the severity without permissions")
+	}
+
+	if err := c.playbookRunService.ChangeSeverity(args.RunID, userID, args.Severity); err != nil {
+		return "", errors.Wrap(err, "failed to change the severity")
+	}
+
+	return "", nil
+}
@@ -74,6 +74,10 @@ func (r *RunResolver) RetrospectiveReminderIntervalSeconds() float64 {
 	return float64(r.PlaybookRun.RetrospectiveReminderIntervalSeconds)
 }
 
+func (r *RunResolver) FollowUpReminderDelaySeconds() float64 {
+	return float64(r.PlaybookRun.FollowUpReminderDelaySeconds)
+}
+
 func (r *RunResolver) Checklists() []*ChecklistResolver {
 	checklistResolvers := make([]*ChecklistResolver, 0, len(r.PlaybookRun.Checklists))
 	for _, checklist := range r.PlaybookRun.Checklists {
@@ -101,6 +105,15 @@ func (r *RunResolver) TimelineEvents() []*TimelineEventResolver {
 	return timelineEventResolvers
 }
 
+func (r *RunResolver) PropertyValues() []*app.PropertyValue {
+	propertyValues := make([]*app.PropertyValue, 0, len(r.PlaybookRun.PropertyValues))
+	for i := range r.PlaybookRun.PropertyValues {
+		propertyValues = append(propertyValues, &r.PlaybookRun.PropertyValues[i])
+	}
+
+	return propertyValues
+}
+
 func (r *RunResolver) IsFavorite(ctx context.Context) (bool, error) {
 	c, err := getContext(ctx)
 	if err != nil {
@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/app"
+)
+
+// HealthHandler is the API handler for the health check. It does not require authentication, so
+// that it can be used by load balancers.
+type HealthHandler struct {
+	*ErrorHandler
+	healthService app.HealthService
+}
+
+// NewHealthHandler returns a new health api handler
+func NewHealthHandler(router *mux.Router, healthService app.HealthService) *HealthHandler {
+	handler := &HealthHandler{
+		ErrorHandler:  &ErrorHandler{},
+		healthService: healthService,
+	}
+
+	router.HandleFunc("", handler.getHealth).Methods(http.MethodGet)
+
+	return handler
+}
+
+// getHealth responds with 200 if Playbooks is healthy, and 503 otherwise.
+func (h *HealthHandler) getHealth(w http.ResponseWriter, r *http.Request) {
+	health := h.healthService.Check()
+
+	status := http.StatusOK
+	if health.Status != app.HealthStatusOK {
+		status = http.StatusServiceUnavailable
+	}
+
+	ReturnJSON(w, &health, status)
+}
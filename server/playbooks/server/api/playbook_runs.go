@@ -76,11 +76,14 @@ func NewPlaybookRunHandler(
 	playbookRunRouter.HandleFunc("/status-updates", withContext(handler.getStatusUpdates)).Methods(http.MethodGet)
 	playbookRunRouter.HandleFunc("/request-update", withContext(handler.requestUpdate)).Methods(http.MethodPost)
 	playbookRunRouter.HandleFunc("/request-join-channel", withContext(handler.requestJoinChannel)).Methods(http.MethodPost)
+	playbookRunRouter.HandleFunc("/export", withContext(handler.exportPlaybookRun)).Methods(http.MethodGet)
+	playbookRunRouter.HandleFunc("/links", withContext(handler.getRunLinks)).Methods(http.MethodGet)
 
 	playbookRunRouterAuthorized := playbookRunRouter.PathPrefix("").Subrouter()
 	playbookRunRouterAuthorized.Use(handler.checkEditPermissions)
 	playbookRunRouterAuthorized.HandleFunc("", withContext(handler.updatePlaybookRun)).Methods(http.MethodPatch)
 	playbookRunRouterAuthorized.HandleFunc("/owner", withContext(handler.changeOwner)).Methods(http.MethodPost)
+	playbookRunRouterAuthorized.HandleFunc("/severity", withContext(handler.changeSeverity)).Methods(http.MethodPut)
 	playbookRunRouterAuthorized.HandleFunc("/status", withContext(handler.status)).Methods(http.MethodPost)
 	playbookRunRouterAuthorized.HandleFunc("/finish", withContext(handler.finish)).Methods(http.MethodPut)
 	playbookRunRouterAuthorized.HandleFunc("/finish-dialog", withContext(handler.finishDialog)).Methods(http.MethodPost)
@@ -90,7 +93,13 @@ func NewPlaybookRunHandler(
 	playbookRunRouterAuthorized.HandleFunc("/no-retrospective-button", withContext(handler.noRetrospectiveButton)).Methods(http.MethodPost)
 	playbookRunRouterAuthorized.HandleFunc("/timeline/{eventID:[A-Za-z0-9]+}", withContext(handler.removeTimelineEvent)).Methods(http.MethodDelete)
 	playbookRunRouterAuthorized.HandleFunc("/restore", withContext(handler.restore)).Methods(http.MethodPut)
+	playbookRunRouterAuthorized.HandleFunc("/follow-up", withContext(handler.setFollowUpReminder)).Methods(http.MethodPut)
+	playbookRunRouterAuthorized.HandleFunc("/follow-up/reopen", withContext(handler.followUpReopenButton)).Methods(http.MethodPost)
 	playbookRunRouterAuthorized.HandleFunc("/status-update-enabled", withContext(handler.toggleStatusUpdates)).Methods(http.MethodPut)
+	playbookRunRouterAuthorized.HandleFunc("/call", withContext(handler.startCall)).Methods(http.MethodPost)
+	playbookRunRouterAuthorized.HandleFunc("/property-values/{fieldID:[A-Za-z0-9]+}", withContext(handler.setPropertyValue)).Methods(http.MethodPut)
+	playbookRunRouterAuthorized.HandleFunc("/links", withContext(handler.linkRuns)).Methods(http.MethodPost)
+	playbookRunRouterAuthorized.HandleFunc("/links/{linkedRunID:[A-Za-z0-9]+}", withContext(handler.unlinkRuns)).Methods(http.MethodDelete)
 
 	channelRouter := playbookRunsRouter.PathPrefix("/channel/{channel_id:[A-Za-z0-9]+}").Subrouter()
 	channelRouter.HandleFunc("", withContext(handler.getPlaybookRunByChannel)).Methods(http.MethodGet)
@@ -174,6 +183,7 @@ func (h *PlaybookRunHandler) createPlaybookRunFromPost(c *Context, w http.Respon
 			PostID:      playbookRunCreateOptions.PostID,
 			PlaybookID:  playbookRunCreateOptions.PlaybookID,
 			Type:        playbookRunCreateOptions.Type,
+			Severity:    playbookRunCreateOptions.Severity,
 		},
 		userID,
 		playbookRunCreateOptions.CreatePublicRun,
@@ -466,6 +476,10 @@ func (h *PlaybookRunHandler) createPlaybookRun(playbookRun app.PlaybookRun, user
 		playbookRun.SetConfigurationFromPlaybook(*playbook, source)
 	}
 
+	if err = app.ValidateSeverity(playbook, playbookRun.Severity); err != nil {
+		return nil, errors.Wrap(app.ErrMalformedPlaybookRun, err.Error())
+	}
+
 	// Check the permissions on the channel: the user must be able to create it or,
 	// if one's already provided, they need to be able to manage it.
 	if channel == nil {
@@ -580,6 +594,15 @@ func (h *PlaybookRunHandler) getPlaybookRunMetadata(c *Context, w http.ResponseW
 		return
 	}
 
+	// Only name the linked runs the user can view; the rollup of children progress stays whole.
+	visibleLinkedRuns := make([]app.LinkedRun, 0, len(playbookRunMetadata.LinkedRuns))
+	for _, linkedRun := range playbookRunMetadata.LinkedRuns {
+		if h.permissions.RunView(userID, linkedRun.RunID) == nil {
+			visibleLinkedRuns = append(visibleLinkedRuns, linkedRun)
+		}
+	}
+	playbookRunMetadata.LinkedRuns = visibleLinkedRuns
+
 	ReturnJSON(w, playbookRunMetadata, http.StatusOK)
 }
 
@@ -701,6 +724,31 @@ func (h *PlaybookRunHandler) changeOwner(c *Context, w http.ResponseWriter, r *h
 	ReturnJSON(w, map[string]interface{}{}, http.StatusOK)
 }
 
+// changeSeverity handles the PUT /runs/{id}/severity endpoint. An empty severity clears it.
+func (h *PlaybookRunHandler) changeSeverity(c *Context, w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userID := r.Header.Get("Mattermost-User-ID")
+
+	var params struct {
+		Severity string `json:"severity"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "could not decode request body", err)
+		return
+	}
+
+	err := h.playbookRunService.ChangeSeverity(vars["id"], userID, params.Severity)
+	if errors.Is(err, app.ErrMalformedPlaybookRun) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "invalid severity", err)
+		return
+	} else if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	ReturnJSON(w, map[string]interface{}{}, http.StatusOK)
+}
+
 // updateStatusD handles the POST /runs/{id}/status endpoint, user has edit permissions
 func (h *PlaybookRunHandler) status(c *Context, w http.ResponseWriter, r *http.Request) {
 	playbookRunID := mux.Vars(r)["id"]
@@ -832,6 +880,223 @@ func (h *PlaybookRunHandler) restore(c *Context, w http.ResponseWriter, r *http.
 	_, _ = w.Write([]byte(`{"status":"OK"}`))
 }
 
+// setFollowUpReminder handles the PUT /runs/{id}/follow-up endpoint. A delay of 0 disables the
+// follow-up reminder.
+func (h *PlaybookRunHandler) setFollowUpReminder(c *Context, w http.ResponseWriter, r *http.Request) {
+	playbookRunID := mux.Vars(r)["id"]
+
+	var params struct {
+		DelaySeconds int64  `json:"delay_seconds"`
+		Message      string `json:"message"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "could not decode request body", err)
+		return
+	}
+
+	err := h.playbookRunService.SetFollowUpReminder(playbookRunID, params.DelaySeconds, params.Message)
+	if errors.Is(err, app.ErrMalformedPlaybookRun) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "invalid follow-up reminder", err)
+		return
+	} else if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	ReturnJSON(w, map[string]interface{}{}, http.StatusOK)
+}
+
+// followUpReopenButton handles the POST /runs/{id}/follow-up/reopen endpoint, called when a user
+// clicks on the button of a follow-up reminder to reopen the run.
+func (h *PlaybookRunHandler) followUpReopenButton(c *Context, w http.ResponseWriter, r *http.Request) {
+	playbookRunID := mux.Vars(r)["id"]
+	var requestData *model.PostActionIntegrationRequest
+	err := json.NewDecoder(r.Body).Decode(&requestData)
+	if err != nil || requestData == nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "missing request data", nil)
+		return
+	}
+
+	if !h.PermissionsCheck(w, c.logger, h.permissions.RunManageProperties(requestData.UserId, playbookRunID)) {
+		return
+	}
+
+	if err = h.playbookRunService.RestorePlaybookRun(playbookRunID, requestData.UserId); err != nil {
+		h.HandleError(w, c.logger, errors.Wrap(err, "followUpReopenButton failed to restore playbook run"))
+		return
+	}
+
+	// Remove the button so the run is not reopened twice from the same reminder
+	post, err := h.api.GetPost(requestData.PostId)
+	if err != nil {
+		c.logger.WithError(err).Warn("failed to get follow-up reminder post")
+		ReturnJSON(w, &model.PostActionIntegrationResponse{}, http.StatusOK)
+		return
+	}
+	post.DelProp("attachments")
+	post.Message += "\n\n_The run was reopened._"
+
+	ReturnJSON(w, &model.PostActionIntegrationResponse{Update: post}, http.StatusOK)
+}
+
+// setPropertyValue handles the PUT /runs/{id}/property-values/{fieldID} endpoint. An empty value
+// clears the field.
+func (h *PlaybookRunHandler) setPropertyValue(c *Context, w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	playbookRunID := vars["id"]
+	userID := r.Header.Get("Mattermost-User-ID")
+
+	var params struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "failed to unmarshal property value", err)
+		return
+	}
+
+	err := h.playbookRunService.SetPropertyValue(playbookRunID, userID, app.PropertyValue{
+		FieldID: vars["fieldID"],
+		Value:   params.Value,
+	})
+	if errors.Is(err, app.ErrNotFound) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusNotFound, "property field not found", err)
+		return
+	} else if errors.Is(err, app.ErrMalformedPlaybookRun) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "invalid property value", err)
+		return
+	} else if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"status":"OK"}`))
+}
+
+// getRunLinks handles the GET /runs/{id}/links endpoint, returning the links to the runs the user
+// can view.
+func (h *PlaybookRunHandler) getRunLinks(c *Context, w http.ResponseWriter, r *http.Request) {
+	playbookRunID := mux.Vars(r)["id"]
+	userID := r.Header.Get("Mattermost-User-ID")
+
+	if !h.PermissionsCheck(w, c.logger, h.permissions.RunView(userID, playbookRunID)) {
+		return
+	}
+
+	links, err := h.playbookRunService.GetRunLinks(playbookRunID)
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	visibleLinks := make([]app.RunRelation, 0, len(links))
+	for _, link := range links {
+		if h.permissions.RunView(userID, link.RunID) == nil {
+			visibleLinks = append(visibleLinks, link)
+		}
+	}
+
+	ReturnJSON(w, visibleLinks, http.StatusOK)
+}
+
+// linkRuns handles the POST /runs/{id}/links endpoint. The type of the link is relative to the
+// run in the path: linking with type "parent" makes the other run its parent.
+func (h *PlaybookRunHandler) linkRuns(c *Context, w http.ResponseWriter, r *http.Request) {
+	playbookRunID := mux.Vars(r)["id"]
+	userID := r.Header.Get("Mattermost-User-ID")
+
+	var params struct {
+		RunID string `json:"run_id"`
+		Type  string `json:"type"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "could not decode request body", err)
+		return
+	}
+
+	if !h.PermissionsCheck(w, c.logger, h.permissions.RunView(userID, params.RunID)) {
+		return
+	}
+
+	err := h.playbookRunService.LinkRuns(playbookRunID, params.RunID, params.Type, userID)
+	if errors.Is(err, app.ErrMalformedPlaybookRun) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "unable to link runs", err)
+		return
+	} else if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	ReturnJSON(w, map[string]interface{}{}, http.StatusCreated)
+}
+
+// unlinkRuns handles the DELETE /runs/{id}/links/{linkedRunID} endpoint.
+func (h *PlaybookRunHandler) unlinkRuns(c *Context, w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userID := r.Header.Get("Mattermost-User-ID")
+
+	err := h.playbookRunService.UnlinkRuns(vars["id"], vars["linkedRunID"], userID)
+	if errors.Is(err, app.ErrNotFound) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusNotFound, "runs are not linked", err)
+		return
+	} else if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// startCall handles the POST /runs/{id}/call endpoint, starting a call in the run's channel.
+func (h *PlaybookRunHandler) startCall(c *Context, w http.ResponseWriter, r *http.Request) {
+	playbookRunID := mux.Vars(r)["id"]
+	userID := r.Header.Get("Mattermost-User-ID")
+
+	err := h.playbookRunService.StartCall(playbookRunID, userID)
+	if errors.Is(err, app.ErrCallsUnavailable) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusNotImplemented, "Calls are not available on this server.", err)
+		return
+	} else if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"status":"OK"}`))
+}
+
+// exportPlaybookRun handles the GET /runs/{id}/export endpoint.
+func (h *PlaybookRunHandler) exportPlaybookRun(c *Context, w http.ResponseWriter, r *http.Request) {
+	playbookRunID := mux.Vars(r)["id"]
+	userID := r.Header.Get("Mattermost-User-ID")
+
+	if !h.PermissionsCheck(w, c.logger, h.permissions.RunView(userID, playbookRunID)) {
+		return
+	}
+
+	playbookRun, err := h.playbookRunService.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	recordings, err := h.playbookRunService.GetCallRecordings(playbookRunID)
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	export, err := app.GeneratePlaybookRunExport(*playbookRun, recordings)
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(export)
+}
+
 // requestUpdate posts a status update request message in the run's channel
 func (h *PlaybookRunHandler) requestUpdate(c *Context, w http.ResponseWriter, r *http.Request) {
 	playbookRunID := mux.Vars(r)["id"]
@@ -1860,6 +2125,19 @@ func parsePlaybookRunsFilterOptions(u *url.URL, currentUserID string) (*app.Play
 	// Parse types= query string parameters as an array.
 	types := u.Query()["types"]
 
+	// Parse severity= query string parameters as an array.
+	severities := u.Query()["severity"]
+
+	// Parse property=fieldID:value query string parameters as an array.
+	var propertyValues []app.PropertyValue
+	for _, param := range u.Query()["property"] {
+		fieldID, value, found := strings.Cut(param, ":")
+		if !found {
+			return nil, errors.Errorf("bad parameter 'property': '%s' must be formatted as field_id:value", param)
+		}
+		propertyValues = append(propertyValues, app.PropertyValue{FieldID: fieldID, Value: value})
+	}
+
 	options := app.PlaybookRunFilterOptions{
 		TeamID:                  teamID,
 		Page:                    page,
@@ -1877,6 +2155,8 @@ func parsePlaybookRunsFilterOptions(u *url.URL, currentUserID string) (*app.Play
 		StartedGTE:              startedGTE,
 		StartedLT:               startedLT,
 		Types:                   types,
+		PropertyValues:          propertyValues,
+		Severities:              severities,
 	}
 
 	options, err = options.Validate()
@@ -113,6 +113,41 @@ func (h *PlaybookHandler) validPlaybook(w http.ResponseWriter, logger logrus.Fie
 			}
 		}
 	}
+
+	if err := app.ValidateBoardSync(playbook.BoardSync); err != nil {
+		h.HandleErrorWithCode(w, logger, http.StatusBadRequest, "invalid board sync settings", err)
+		return false
+	}
+
+	if err := app.ValidatePropertyFields(playbook.PropertyFields); err != nil {
+		h.HandleErrorWithCode(w, logger, http.StatusBadRequest, "invalid property fields", err)
+		return false
+	}
+
+	if err := app.ValidateFollowUpReminder(playbook.FollowUpReminderDelaySeconds); err != nil {
+		h.HandleErrorWithCode(w, logger, http.StatusBadRequest, "invalid follow-up reminder", err)
+		return false
+	}
+
+	if err := app.ValidateSeverityLevels(playbook.SeverityLevels); err != nil {
+		h.HandleErrorWithCode(w, logger, http.StatusBadRequest, "invalid severity levels", err)
+		return false
+	}
+
+	for _, level := range playbook.SeverityLevels {
+		for _, channelID := range level.BroadcastChannelIDs {
+			channel, err := h.api.GetChannelByID(channelID)
+			if err != nil {
+				h.HandleErrorWithCode(w, logger, http.StatusBadRequest, "broadcasting to invalid channel ID", err)
+				return false
+			}
+			if channel.DeleteAt != 0 {
+				h.HandleErrorWithCode(w, logger, http.StatusBadRequest, "broadcasting to archived channel", nil)
+				return false
+			}
+		}
+	}
+
 	for listIndex := range playbook.Checklists {
 		for itemIndex := range playbook.Checklists[listIndex].Items {
 			if err := validateTaskActions(playbook.Checklists[listIndex].Items[itemIndex].TaskActions); err != nil {
@@ -125,6 +160,21 @@ func (h *PlaybookHandler) validPlaybook(w http.ResponseWriter, logger logrus.Fie
 	return true
 }
 
+// canSyncToBoard checks that the user is allowed to create cards in the board configured by the
+// given board sync settings, unless that board was already configured before.
+func (h *PlaybookHandler) canSyncToBoard(w http.ResponseWriter, logger logrus.FieldLogger, userID string, boardSync, oldBoardSync app.BoardSync) bool {
+	if !boardSync.Enabled || (oldBoardSync.Enabled && boardSync.BoardID == oldBoardSync.BoardID) {
+		return true
+	}
+
+	if !h.api.CanManageBoardCards(userID, boardSync.BoardID) {
+		h.HandleErrorWithCode(w, logger, http.StatusForbidden, "not authorized to manage the cards of the board", nil)
+		return false
+	}
+
+	return true
+}
+
 func (h *PlaybookHandler) createPlaybook(c *Context, w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("Mattermost-User-ID")
 	var playbook app.Playbook
@@ -161,6 +211,10 @@ func (h *PlaybookHandler) createPlaybook(c *Context, w http.ResponseWriter, r *h
 		return
 	}
 
+	if !h.canSyncToBoard(w, c.logger, userID, playbook.BoardSync, app.BoardSync{}) {
+		return
+	}
+
 	if err := h.validateMetrics(playbook); err != nil {
 		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "invalid metrics configs", err)
 		return
@@ -242,6 +296,10 @@ func (h *PlaybookHandler) updatePlaybook(c *Context, w http.ResponseWriter, r *h
 		return
 	}
 
+	if !h.canSyncToBoard(w, c.logger, userID, playbook.BoardSync, oldPlaybook.BoardSync) {
+		return
+	}
+
 	app.CleanUpChecklists(playbook.Checklists)
 
 	if err = validatePreAssignment(playbook); err != nil {
@@ -344,7 +402,7 @@ func (h *PlaybookHandler) getPlaybooks(c *Context, w http.ResponseWriter, r *htt
 	requesterInfo := app.RequesterInfo{
 		UserID:  userID,
 		TeamID:  teamID,
-		IsAdmin: app.IsSystemAdmin(userID, h.api),
+		IsAdmin: app.IsPlaybooksAdmin(userID, h.api),
 	}
 
 	playbookResults, err := h.playbookService.GetPlaybooksForTeam(requesterInfo, teamID, opts)
@@ -368,7 +426,7 @@ func (h *PlaybookHandler) getPlaybooksAutoComplete(c *Context, w http.ResponseWr
 	requesterInfo := app.RequesterInfo{
 		UserID:  userID,
 		TeamID:  teamID,
-		IsAdmin: app.IsSystemAdmin(userID, h.api),
+		IsAdmin: app.IsPlaybooksAdmin(userID, h.api),
 	}
 
 	playbooksResult, err := h.playbookService.GetPlaybooksForTeam(requesterInfo, teamID, app.PlaybookFilterOptions{
@@ -469,7 +527,7 @@ func (h *PlaybookHandler) autoFollow(c *Context, w http.ResponseWriter, r *http.
 	currentUserID := r.Header.Get("Mattermost-User-ID")
 	userID := mux.Vars(r)["userID"]
 
-	if currentUserID != userID && !app.IsSystemAdmin(currentUserID, h.api) {
+	if currentUserID != userID && !app.IsPlaybooksAdmin(currentUserID, h.api) {
 		h.HandleErrorWithCode(w, c.logger, http.StatusForbidden, "User doesn't have permissions to make another user autofollow the playbook.", nil)
 		return
 	}
@@ -491,7 +549,7 @@ func (h *PlaybookHandler) autoUnfollow(c *Context, w http.ResponseWriter, r *htt
 	currentUserID := r.Header.Get("Mattermost-User-ID")
 	userID := mux.Vars(r)["userID"]
 
-	if currentUserID != userID && !app.IsSystemAdmin(currentUserID, h.api) {
+	if currentUserID != userID && !app.IsPlaybooksAdmin(currentUserID, h.api) {
 		h.HandleErrorWithCode(w, c.logger, http.StatusForbidden, "User doesn't have permissions to make another user autofollow the playbook.", nil)
 		return
 	}
@@ -6,6 +6,8 @@ package api
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
@@ -15,6 +17,11 @@ import (
 	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/playbooks"
 )
 
+const (
+	defaultTelemetryEventsPerPage = 100
+	maxTelemetryEventsPerPage     = 1000
+)
+
 // TelemetryHandler is the API handler.
 type TelemetryHandler struct {
 	*ErrorHandler
@@ -26,6 +33,7 @@ type TelemetryHandler struct {
 	genericTelemetry     app.GenericTelemetry
 	botTelemetry         bot.Telemetry
 	api                  playbooks.ServicesAPI
+	telemetryEventStore  app.TelemetryEventStore
 }
 
 // NewTelemetryHandler Creates a new Plugin API handler.
@@ -39,6 +47,7 @@ func NewTelemetryHandler(
 	genericTelemetry app.GenericTelemetry,
 	botTelemetry bot.Telemetry,
 	permissions *app.PermissionsService,
+	telemetryEventStore app.TelemetryEventStore,
 ) *TelemetryHandler {
 	handler := &TelemetryHandler{
 		ErrorHandler:         &ErrorHandler{},
@@ -50,11 +59,16 @@ func NewTelemetryHandler(
 		botTelemetry:         botTelemetry,
 		api:                  api,
 		permissions:          permissions,
+		telemetryEventStore:  telemetryEventStore,
 	}
 
 	telemetryRouter := router.PathPrefix("/telemetry").Subrouter()
 	telemetryRouter.HandleFunc("", withContext(handler.createEvent)).Methods(http.MethodPost)
 
+	eventsRouter := telemetryRouter.PathPrefix("/events").Subrouter()
+	eventsRouter.HandleFunc("", withContext(handler.getEvents)).Methods(http.MethodGet)
+	eventsRouter.HandleFunc("", withContext(handler.deleteEvents)).Methods(http.MethodDelete)
+
 	startTrialRouter := telemetryRouter.PathPrefix("/start-trial").Subrouter()
 	startTrialRouter.HandleFunc("", withContext(handler.startTrial)).Methods(http.MethodPost)
 
@@ -113,6 +127,68 @@ func (h *TelemetryHandler) createEvent(c *Context, w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// getEvents lists the events recorded by the local telemetry sink, newest first. Only system
+// admins can audit the telemetry.
+func (h *TelemetryHandler) getEvents(c *Context, w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("Mattermost-User-ID")
+	if !app.IsSystemAdmin(userID, h.api) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusForbidden, "Not authorized", nil)
+		return
+	}
+
+	page, perPage, err := parseTelemetryEventsPagination(r.URL)
+	if err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "invalid pagination", err)
+		return
+	}
+
+	results, err := h.telemetryEventStore.GetEvents(page, perPage)
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	ReturnJSON(w, results, http.StatusOK)
+}
+
+// parseTelemetryEventsPagination parses the page and per_page parameters, defaulting to the first
+// page of defaultTelemetryEventsPerPage events.
+func parseTelemetryEventsPagination(u *url.URL) (int, int, error) {
+	page := 0
+	if pageParam := u.Query().Get("page"); pageParam != "" {
+		var err error
+		if page, err = strconv.Atoi(pageParam); err != nil || page < 0 {
+			return 0, 0, errors.Errorf("bad parameter 'page': it should be a non-negative number")
+		}
+	}
+
+	perPage := defaultTelemetryEventsPerPage
+	if perPageParam := u.Query().Get("per_page"); perPageParam != "" {
+		var err error
+		if perPage, err = strconv.Atoi(perPageParam); err != nil || perPage <= 0 || perPage > maxTelemetryEventsPerPage {
+			return 0, 0, errors.Errorf("bad parameter 'per_page': it should be a number between 1 and %d", maxTelemetryEventsPerPage)
+		}
+	}
+
+	return page, perPage, nil
+}
+
+// deleteEvents clears the events recorded by the local telemetry sink.
+func (h *TelemetryHandler) deleteEvents(c *Context, w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("Mattermost-User-ID")
+	if !app.IsSystemAdmin(userID, h.api) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusForbidden, "Not authorized", nil)
+		return
+	}
+
+	if err := h.telemetryEventStore.DeleteAll(); err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *TelemetryHandler) checkPlaybookRunViewPermissions(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
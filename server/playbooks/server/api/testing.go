@@ -0,0 +1,141 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/app"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/playbooks"
+)
+
+// TestingHandler is the API handler for the testing-only endpoints. Every endpoint requires
+// ServiceSettings.EnableTesting to be on.
+type TestingHandler struct {
+	*ErrorHandler
+	api                playbooks.ServicesAPI
+	playbookRunService app.PlaybookRunService
+	testDataGenerator  *app.TestDataGenerator
+}
+
+// NewTestingHandler returns a new testing api handler
+func NewTestingHandler(router *mux.Router, localRouter *mux.Router, api playbooks.ServicesAPI, playbookRunService app.PlaybookRunService, testDataGenerator *app.TestDataGenerator) *TestingHandler {
+	handler := &TestingHandler{
+		ErrorHandler:       &ErrorHandler{},
+		api:                api,
+		playbookRunService: playbookRunService,
+		testDataGenerator:  testDataGenerator,
+	}
+
+	testingRouter := router.PathPrefix("/testing").Subrouter()
+	testingRouter.HandleFunc("/data", withContext(handler.generateTestData)).Methods(http.MethodPost)
+
+	localTestingRouter := localRouter.PathPrefix("/testing").Subrouter()
+	localTestingRouter.HandleFunc("/teams/{teamID:[A-Za-z0-9]+}/reset", withContext(handler.resetTeam)).Methods(http.MethodPost)
+
+	return handler
+}
+
+// isTestingEnabled writes a not found error and returns false unless ServiceSettings.EnableTesting is on.
+func (h *TestingHandler) isTestingEnabled(w http.ResponseWriter, c *Context) bool {
+	enableTesting := h.api.GetConfig().ServiceSettings.EnableTesting
+	if enableTesting == nil || !*enableTesting {
+		h.HandleErrorWithCode(w, c.logger, http.StatusNotFound, "Not found", errors.New("ServiceSettings.EnableTesting is off"))
+		return false
+	}
+
+	return true
+}
+
+// generateTestData handles the POST /testing/data endpoint, generating synthetic playbooks and runs.
+func (h *TestingHandler) generateTestData(c *Context, w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("Mattermost-User-ID")
+	if !h.isTestingEnabled(w, c) {
+		return
+	}
+
+	if !app.IsSystemAdmin(userID, h.api) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusForbidden, "Generating test data is restricted to system administrators.", nil)
+		return
+	}
+
+	var options app.TestDataOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "unable to decode test data options", err)
+		return
+	}
+	if options.Seed == 0 {
+		options.Seed = model.GetMillis()
+	}
+
+	if err := options.Validate(); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+
+	result, err := h.testDataGenerator.Generate(userID, options)
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	ReturnJSON(w, result, http.StatusCreated)
+}
+
+// resetTeamPayload describes the data to reseed a team with after wiping it. Every field is
+// optional: without a user, the team is only wiped.
+type resetTeamPayload struct {
+	UserID string `json:"user_id"`
+	app.TestDataOptions
+}
+
+// resetTeam handles the POST /local/testing/teams/{teamID}/reset endpoint, wiping every playbook,
+// run and category of the team and then reseeding it. It is meant for E2E suites and is only
+// reachable through the local mode socket.
+func (h *TestingHandler) resetTeam(c *Context, w http.ResponseWriter, r *http.Request) {
+	teamID := mux.Vars(r)["teamID"]
+	if !h.isTestingEnabled(w, c) {
+		return
+	}
+
+	var payload resetTeamPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil && err != io.EOF {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "unable to decode reset options", err)
+		return
+	}
+	payload.TeamID = teamID
+
+	if payload.UserID != "" {
+		if err := payload.Validate(); err != nil {
+			h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, err.Error(), err)
+			return
+		}
+	}
+
+	if err := h.playbookRunService.NukeTeamData(teamID); err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	result := &app.TestDataResult{
+		Playbooks: []app.Playbook{},
+		Runs:      []app.TestDataRun{},
+	}
+	if payload.UserID != "" {
+		var err error
+		result, err = h.testDataGenerator.Generate(payload.UserID, payload.TestDataOptions)
+		if err != nil {
+			h.HandleError(w, c.logger, err)
+			return
+		}
+	}
+
+	ReturnJSON(w, result, http.StatusOK)
+}
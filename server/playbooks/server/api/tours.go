@@ -0,0 +1,141 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/app"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/playbooks"
+)
+
+// TourHandler is the API handler for the onboarding tour state.
+type TourHandler struct {
+	*ErrorHandler
+	api           playbooks.ServicesAPI
+	userInfoStore app.UserInfoStore
+}
+
+// NewTourHandler returns a new onboarding tour api handler
+func NewTourHandler(router *mux.Router, api playbooks.ServicesAPI, userInfoStore app.UserInfoStore) *TourHandler {
+	handler := &TourHandler{
+		ErrorHandler:  &ErrorHandler{},
+		api:           api,
+		userInfoStore: userInfoStore,
+	}
+
+	toursRouter := router.PathPrefix("/users/{userID:[A-Za-z0-9]+}/tours").Subrouter()
+	toursRouter.HandleFunc("", withContext(handler.getTourState)).Methods(http.MethodGet)
+	toursRouter.HandleFunc("", withContext(handler.resetTourState)).Methods(http.MethodDelete)
+	toursRouter.HandleFunc("/{category}", withContext(handler.updateTourStep)).Methods(http.MethodPut)
+
+	return handler
+}
+
+type tourStepPayload struct {
+	Step int `json:"step"`
+}
+
+// getTourState handles the GET /users/{userID}/tours endpoint.
+func (h *TourHandler) getTourState(c *Context, w http.ResponseWriter, r *http.Request) {
+	userID := mux.Vars(r)["userID"]
+	if !h.canAccessTourState(w, c, r, userID) {
+		return
+	}
+
+	info, err := h.getUserInfo(userID)
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	tourState := info.TourState
+	if tourState == nil {
+		tourState = app.TourState{}
+	}
+
+	ReturnJSON(w, tourState, http.StatusOK)
+}
+
+// updateTourStep handles the PUT /users/{userID}/tours/{category} endpoint.
+func (h *TourHandler) updateTourStep(c *Context, w http.ResponseWriter, r *http.Request) {
+	userID := mux.Vars(r)["userID"]
+	category := mux.Vars(r)["category"]
+	if !h.canAccessTourState(w, c, r, userID) {
+		return
+	}
+
+	var payload tourStepPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		h.HandleErrorWithCode(w, c.logger, http.StatusBadRequest, "unable to decode tour step", err)
+		return
+	}
+
+	info, err := h.getUserInfo(userID)
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	if info.TourState == nil {
+		info.TourState = app.TourState{}
+	}
+	info.TourState[category] = payload.Step
+
+	if err := h.userInfoStore.Upsert(info); err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	ReturnJSON(w, info.TourState, http.StatusOK)
+}
+
+// resetTourState handles the DELETE /users/{userID}/tours endpoint, restarting every tour for the user.
+func (h *TourHandler) resetTourState(c *Context, w http.ResponseWriter, r *http.Request) {
+	userID := mux.Vars(r)["userID"]
+	if !h.canAccessTourState(w, c, r, userID) {
+		return
+	}
+
+	info, err := h.getUserInfo(userID)
+	if err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	info.TourState = nil
+	if err := h.userInfoStore.Upsert(info); err != nil {
+		h.HandleError(w, c.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// canAccessTourState writes a forbidden error and returns false unless the current user is the
+// owner of the tour state or a system admin.
+func (h *TourHandler) canAccessTourState(w http.ResponseWriter, c *Context, r *http.Request, userID string) bool {
+	currentUserID := r.Header.Get("Mattermost-User-ID")
+	if currentUserID != userID && !app.IsSystemAdmin(currentUserID, h.api) {
+		h.HandleErrorWithCode(w, c.logger, http.StatusForbidden, "User doesn't have permissions to access another user's tour state.", nil)
+		return false
+	}
+
+	return true
+}
+
+func (h *TourHandler) getUserInfo(userID string) (app.UserInfo, error) {
+	info, err := h.userInfoStore.Get(userID)
+	if errors.Is(err, app.ErrNotFound) {
+		return app.UserInfo{ID: userID}, nil
+	} else if err != nil {
+		return app.UserInfo{}, err
+	}
+
+	return info, nil
+}
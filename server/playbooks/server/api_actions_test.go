@@ -6,12 +6,14 @@ package main
 import (
 	"context"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/playbooks/client"
 	"github.com/mitchellh/mapstructure"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestActionCreation(t *testing.T) {
@@ -458,3 +460,67 @@ func TestActionUpdate(t *testing.T) {
 	})
 
 }
+
+func TestActionChannelHeader(t *testing.T) {
+	e, teardown := Setup(t)
+	defer teardown()
+	e.CreateBasic()
+
+	channel, _, err := e.ServerAdminClient.CreateChannel(&model.Channel{
+		DisplayName: "channel-header",
+		Name:        "channel-header",
+		Header:      "Regular header",
+		Type:        model.ChannelTypeOpen,
+		TeamId:      e.BasicTeam.Id,
+	})
+	require.NoError(t, err)
+
+	_, _, err = e.ServerAdminClient.AddChannelMember(channel.Id, e.RegularUser.Id)
+	require.NoError(t, err)
+
+	t.Run("create invalid action - header too long", func(t *testing.T) {
+		_, err := e.PlaybooksClient.Actions.Create(context.Background(), channel.Id, client.ChannelActionCreateOptions{
+			ChannelID:   channel.Id,
+			Enabled:     true,
+			ActionType:  client.ActionTypeSetChannelHeader,
+			TriggerType: client.TriggerTypeRunActive,
+			Payload: client.ChannelHeaderPayload{
+				Header: strings.Repeat("a", model.ChannelHeaderMaxRunes+1),
+			},
+		})
+		requireErrorWithStatusCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("header is set while a run is active", func(t *testing.T) {
+		_, err := e.PlaybooksClient.Actions.Create(context.Background(), channel.Id, client.ChannelActionCreateOptions{
+			ChannelID:   channel.Id,
+			Enabled:     true,
+			ActionType:  client.ActionTypeSetChannelHeader,
+			TriggerType: client.TriggerTypeRunActive,
+			Payload: client.ChannelHeaderPayload{
+				Header: ":rotating_light: Incident in progress",
+			},
+		})
+		require.NoError(t, err)
+
+		run, err := e.PlaybooksClient.PlaybookRuns.Create(context.Background(), client.PlaybookRunCreateOptions{
+			Name:        "run in channel",
+			OwnerUserID: e.RegularUser.Id,
+			TeamID:      e.BasicTeam.Id,
+			PlaybookID:  e.BasicPlaybook.ID,
+			ChannelID:   channel.Id,
+		})
+		require.NoError(t, err)
+
+		updated, _, err := e.ServerAdminClient.GetChannel(channel.Id, "")
+		require.NoError(t, err)
+		require.Equal(t, ":rotating_light: Incident in progress", updated.Header)
+
+		err = e.PlaybooksClient.PlaybookRuns.Finish(context.Background(), run.ID)
+		require.NoError(t, err)
+
+		updated, _, err = e.ServerAdminClient.GetChannel(channel.Id, "")
+		require.NoError(t, err)
+		require.Equal(t, "Regular header", updated.Header)
+	})
+}
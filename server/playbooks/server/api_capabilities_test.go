@@ -0,0 +1,66 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapabilities(t *testing.T) {
+	e, teardown := Setup(t)
+	defer teardown()
+	e.CreateBasic()
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		capabilities, err := e.UnauthenticatedPlaybooksClient.Capabilities.Get(context.Background())
+		assert.Nil(t, capabilities)
+		requireErrorWithStatusCode(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("unlicensed", func(t *testing.T) {
+		e.RemoveLicence()
+
+		capabilities, err := e.PlaybooksClient.Capabilities.Get(context.Background())
+		require.NoError(t, err)
+		assert.False(t, capabilities.PrivatePlaybooks)
+		assert.False(t, capabilities.Retrospective)
+		assert.False(t, capabilities.Stats)
+		assert.False(t, capabilities.Metrics)
+		assert.True(t, capabilities.MultipleChecklists)
+	})
+
+	t.Run("E10 license", func(t *testing.T) {
+		e.SetE10Licence()
+
+		capabilities, err := e.PlaybooksClient.Capabilities.Get(context.Background())
+		require.NoError(t, err)
+		assert.False(t, capabilities.PrivatePlaybooks)
+		assert.True(t, capabilities.Retrospective)
+		assert.True(t, capabilities.Timeline)
+		assert.True(t, capabilities.ChecklistItemDueDate)
+		assert.True(t, capabilities.RequestUpdate)
+		assert.False(t, capabilities.Stats)
+		assert.False(t, capabilities.Metrics)
+		assert.False(t, capabilities.ChannelExport)
+		assert.True(t, capabilities.MultipleChecklists)
+	})
+
+	t.Run("E20 license", func(t *testing.T) {
+		e.SetE20Licence()
+
+		capabilities, err := e.PlaybooksClient.Capabilities.Get(context.Background())
+		require.NoError(t, err)
+		assert.True(t, capabilities.PrivatePlaybooks)
+		assert.True(t, capabilities.Retrospective)
+		assert.True(t, capabilities.Stats)
+		assert.True(t, capabilities.Metrics)
+		assert.True(t, capabilities.ChannelExport)
+		assert.True(t, capabilities.MultipleChecklists)
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryRules(t *testing.T) {
+	e, teardown := Setup(t)
+	defer teardown()
+	e.CreateBasic()
+
+	t.Run("regular users cannot manage rules", func(t *testing.T) {
+		_, err := e.PlaybooksClient.CategoryRules.List(context.Background())
+		requireErrorWithStatusCode(t, err, http.StatusForbidden)
+
+		_, err = e.PlaybooksClient.CategoryRules.Create(context.Background(), client.CategoryRule{
+			CategoryName: "Incidents",
+			PlaybookID:   e.BasicPlaybook.ID,
+		})
+		requireErrorWithStatusCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		_, err := e.PlaybooksAdminClient.CategoryRules.Create(context.Background(), client.CategoryRule{
+			CategoryName: "Incidents",
+		})
+		requireErrorWithStatusCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("create, update and delete", func(t *testing.T) {
+		id, err := e.PlaybooksAdminClient.CategoryRules.Create(context.Background(), client.CategoryRule{
+			CategoryName: "Incidents",
+			PlaybookID:   e.BasicPlaybook.ID,
+		})
+		require.NoError(t, err)
+
+		rules, err := e.PlaybooksAdminClient.CategoryRules.List(context.Background())
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, id, rules[0].ID)
+		assert.Equal(t, "Incidents", rules[0].CategoryName)
+
+		rules[0].Severity = "SEV1"
+		err = e.PlaybooksAdminClient.CategoryRules.Update(context.Background(), rules[0])
+		require.NoError(t, err)
+
+		rules, err = e.PlaybooksAdminClient.CategoryRules.List(context.Background())
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, "SEV1", rules[0].Severity)
+
+		err = e.PlaybooksAdminClient.CategoryRules.Delete(context.Background(), id)
+		require.NoError(t, err)
+
+		rules, err = e.PlaybooksAdminClient.CategoryRules.List(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+	})
+
+	t.Run("update unknown rule", func(t *testing.T) {
+		err := e.PlaybooksAdminClient.CategoryRules.Update(context.Background(), client.CategoryRule{
+			ID:           "unknownunknownunknownunkno",
+			CategoryName: "Incidents",
+			Severity:     "SEV1",
+		})
+		requireErrorWithStatusCode(t, err, http.StatusNotFound)
+	})
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealth(t *testing.T) {
+	e, teardown := Setup(t)
+	defer teardown()
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		health, err := e.UnauthenticatedPlaybooksClient.Health.Get(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, client.HealthStatusOK, health.Status)
+		assert.Equal(t, client.HealthStatusOK, health.Database.Status)
+		assert.Equal(t, client.HealthStatusOK, health.Migrations.Status)
+		assert.Equal(t, health.Migrations.LatestVersion, health.Migrations.CurrentVersion)
+		assert.Equal(t, client.HealthStatusOK, health.Scheduler.Status)
+	})
+}
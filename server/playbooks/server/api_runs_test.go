@@ -1777,3 +1777,145 @@ func TestGetOwners(t *testing.T) {
 		})
 	}
 }
+
+func TestRunLinks(t *testing.T) {
+	e, teardown := Setup(t)
+	defer teardown()
+	e.CreateBasic()
+
+	createRun := func(name string) *client.PlaybookRun {
+		run, err := e.PlaybooksClient.PlaybookRuns.Create(context.Background(), client.PlaybookRunCreateOptions{
+			Name:        name,
+			OwnerUserID: e.RegularUser.Id,
+			TeamID:      e.BasicTeam.Id,
+			PlaybookID:  e.BasicPlaybook.ID,
+		})
+		require.NoError(t, err)
+		return run
+	}
+	parent := e.BasicRun
+	child := createRun("child run")
+	related := createRun("related run")
+
+	t.Run("link a child and a related run", func(t *testing.T) {
+		err := e.PlaybooksClient.PlaybookRuns.Link(context.Background(), parent.ID, child.ID, client.RunLinkTypeChild)
+		require.NoError(t, err)
+		err = e.PlaybooksClient.PlaybookRuns.Link(context.Background(), related.ID, parent.ID, client.RunLinkTypeRelated)
+		require.NoError(t, err)
+
+		links, err := e.PlaybooksClient.PlaybookRuns.GetLinks(context.Background(), parent.ID)
+		require.NoError(t, err)
+		require.Len(t, links, 2)
+		require.Equal(t, child.ID, links[0].RunID)
+		require.Equal(t, client.RunLinkTypeChild, links[0].Type)
+		require.Equal(t, related.ID, links[1].RunID)
+		require.Equal(t, client.RunLinkTypeRelated, links[1].Type)
+
+		links, err = e.PlaybooksClient.PlaybookRuns.GetLinks(context.Background(), child.ID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		require.Equal(t, parent.ID, links[0].RunID)
+		require.Equal(t, client.RunLinkTypeParent, links[0].Type)
+	})
+
+	t.Run("invalid links", func(t *testing.T) {
+		err := e.PlaybooksClient.PlaybookRuns.Link(context.Background(), parent.ID, parent.ID, client.RunLinkTypeRelated)
+		requireErrorWithStatusCode(t, err, http.StatusBadRequest)
+
+		err = e.PlaybooksClient.PlaybookRuns.Link(context.Background(), parent.ID, child.ID, client.RunLinkTypeRelated)
+		requireErrorWithStatusCode(t, err, http.StatusBadRequest)
+
+		err = e.PlaybooksClient.PlaybookRuns.Link(context.Background(), related.ID, child.ID, client.RunLinkTypeChild)
+		requireErrorWithStatusCode(t, err, http.StatusBadRequest)
+
+		err = e.PlaybooksClient.PlaybookRuns.Link(context.Background(), child.ID, related.ID, "sibling")
+		requireErrorWithStatusCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("cycles are rejected", func(t *testing.T) {
+		grandchild := createRun("grandchild run")
+		err := e.PlaybooksClient.PlaybookRuns.Link(context.Background(), grandchild.ID, child.ID, client.RunLinkTypeParent)
+		require.NoError(t, err)
+
+		err = e.PlaybooksClient.PlaybookRuns.Link(context.Background(), grandchild.ID, parent.ID, client.RunLinkTypeChild)
+		requireErrorWithStatusCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("metadata rolls up the progress of children", func(t *testing.T) {
+		metadata, err := e.PlaybooksClient.PlaybookRuns.GetMetadata(context.Background(), parent.ID)
+		require.NoError(t, err)
+		require.Len(t, metadata.LinkedRuns, 2)
+		require.Equal(t, "child run", metadata.LinkedRuns[0].Name)
+
+		childRun, err := e.PlaybooksClient.PlaybookRuns.Get(context.Background(), child.ID)
+		require.NoError(t, err)
+		numTasks := 0
+		for _, checklist := range childRun.Checklists {
+			numTasks += len(checklist.Items)
+		}
+		require.Equal(t, int64(numTasks), metadata.ChildTasks)
+	})
+
+	t.Run("unlink", func(t *testing.T) {
+		err := e.PlaybooksClient.PlaybookRuns.Unlink(context.Background(), child.ID, parent.ID)
+		require.NoError(t, err)
+
+		err = e.PlaybooksClient.PlaybookRuns.Unlink(context.Background(), child.ID, parent.ID)
+		requireErrorWithStatusCode(t, err, http.StatusNotFound)
+
+		links, err := e.PlaybooksClient.PlaybookRuns.GetLinks(context.Background(), parent.ID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+	})
+
+	t.Run("runs the user cannot view cannot be linked", func(t *testing.T) {
+		createPublicRun := false
+		privateRun, err := e.PlaybooksAdminClient.PlaybookRuns.Create(context.Background(), client.PlaybookRunCreateOptions{
+			Name:            "private run",
+			OwnerUserID:     e.AdminUser.Id,
+			TeamID:          e.BasicTeam.Id,
+			CreatePublicRun: &createPublicRun,
+		})
+		require.NoError(t, err)
+
+		err = e.PlaybooksClient.PlaybookRuns.Link(context.Background(), parent.ID, privateRun.ID, client.RunLinkTypeRelated)
+		requireErrorWithStatusCode(t, err, http.StatusForbidden)
+	})
+}
+
+func TestFollowUpReminder(t *testing.T) {
+	e, teardown := Setup(t)
+	defer teardown()
+	e.CreateBasic()
+
+	t.Run("set the follow-up reminder", func(t *testing.T) {
+		err := e.PlaybooksClient.PlaybookRuns.SetFollowUpReminder(context.Background(), e.BasicRun.ID, 7*24*60*60, "Check the error rate")
+		require.NoError(t, err)
+
+		run, err := e.PlaybooksClient.PlaybookRuns.Get(context.Background(), e.BasicRun.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(7*24*60*60), run.FollowUpReminderDelaySeconds)
+		require.Equal(t, "Check the error rate", run.FollowUpReminderMessage)
+	})
+
+	t.Run("reschedule on a finished run", func(t *testing.T) {
+		err := e.PlaybooksClient.PlaybookRuns.Finish(context.Background(), e.BasicRun.ID)
+		require.NoError(t, err)
+
+		err = e.PlaybooksClient.PlaybookRuns.SetFollowUpReminder(context.Background(), e.BasicRun.ID, 24*60*60, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid delay", func(t *testing.T) {
+		err := e.PlaybooksClient.PlaybookRuns.SetFollowUpReminder(context.Background(), e.BasicRun.ID, -1, "")
+		requireErrorWithStatusCode(t, err, http.StatusBadRequest)
+
+		err = e.PlaybooksClient.PlaybookRuns.SetFollowUpReminder(context.Background(), e.BasicRun.ID, 2*365*24*60*60, "")
+		requireErrorWithStatusCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("no permissions", func(t *testing.T) {
+		err := e.PlaybooksClientNotInTeam.PlaybookRuns.SetFollowUpReminder(context.Background(), e.BasicRun.ID, 60, "")
+		requireErrorWithStatusCode(t, err, http.StatusForbidden)
+	})
+}
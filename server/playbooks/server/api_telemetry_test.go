@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -39,3 +40,32 @@ func TestCreateEvent(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestTelemetryEvents(t *testing.T) {
+	e, teardown := Setup(t)
+	defer teardown()
+	e.CreateBasic()
+
+	t.Run("regular users cannot audit the telemetry", func(t *testing.T) {
+		_, err := e.PlaybooksClient.Telemetry.GetEvents(context.Background(), 0, 100)
+		requireErrorWithStatusCode(t, err, http.StatusForbidden)
+
+		err = e.PlaybooksClient.Telemetry.DeleteEvents(context.Background())
+		requireErrorWithStatusCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("invalid pagination", func(t *testing.T) {
+		_, err := e.PlaybooksAdminClient.Telemetry.GetEvents(context.Background(), 0, 5000)
+		requireErrorWithStatusCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("admins can list and clear the events", func(t *testing.T) {
+		err := e.PlaybooksAdminClient.Telemetry.DeleteEvents(context.Background())
+		require.NoError(t, err)
+
+		results, err := e.PlaybooksAdminClient.Telemetry.GetEvents(context.Background(), 0, 100)
+		require.NoError(t, err)
+		require.Equal(t, 0, results.TotalCount)
+		require.Empty(t, results.Items)
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTestData(t *testing.T) {
+	e, teardown := Setup(t)
+	defer teardown()
+	e.CreateBasic()
+
+	generateTestData := func(serverClient *model.Client4, options app.TestDataOptions) (*http.Response, error) {
+		optionsBytes, err := json.Marshal(options)
+		require.NoError(t, err)
+
+		return serverClient.DoAPIRequestBytes("POST", e.ServerClient.URL+"/plugins/"+"playbooks"+"/api/v0/testing/data", optionsBytes, "")
+	}
+
+	setEnableTesting := func(enable bool) {
+		cfg := e.Srv.Config()
+		cfg.ServiceSettings.EnableTesting = model.NewBool(enable)
+		_, _, err := e.ServerAdminClient.UpdateConfig(cfg)
+		require.NoError(t, err)
+	}
+
+	options := app.TestDataOptions{
+		TeamID:         e.BasicTeam.Id,
+		NumPlaybooks:   2,
+		NumOngoingRuns: 1,
+		NumEndedRuns:   1,
+		Days:           3,
+		Seed:           1,
+	}
+
+	t.Run("not found when testing is disabled", func(t *testing.T) {
+		setEnableTesting(false)
+
+		resp, err := generateTestData(e.ServerAdminClient, options)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("regular user cannot generate test data", func(t *testing.T) {
+		setEnableTesting(true)
+
+		resp, err := generateTestData(e.ServerClient, options)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		setEnableTesting(true)
+
+		resp, err := generateTestData(e.ServerAdminClient, app.TestDataOptions{TeamID: e.BasicTeam.Id, NumPlaybooks: app.MaxTestDataPlaybooks + 1})
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, err = generateTestData(e.ServerAdminClient, app.TestDataOptions{NumPlaybooks: 1})
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("admin generates test data", func(t *testing.T) {
+		setEnableTesting(true)
+
+		resp, err := generateTestData(e.ServerAdminClient, options)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var result app.TestDataResult
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		require.Len(t, result.Playbooks, 2)
+		for _, playbook := range result.Playbooks {
+			assert.Equal(t, e.BasicTeam.Id, playbook.TeamID)
+		}
+
+		require.Len(t, result.Runs, 2)
+		ended := 0
+		for _, run := range result.Runs {
+			assert.NotEmpty(t, run.ChannelID)
+			if run.Ended {
+				ended++
+			}
+		}
+		assert.Equal(t, 1, ended)
+	})
+}
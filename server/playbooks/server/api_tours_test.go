@@ -0,0 +1,60 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTours(t *testing.T) {
+	e, teardown := Setup(t)
+	defer teardown()
+	e.CreateBasic()
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		tourState, err := e.UnauthenticatedPlaybooksClient.Tours.Get(context.Background(), e.RegularUser.Id)
+		assert.Nil(t, tourState)
+		requireErrorWithStatusCode(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("empty tour state", func(t *testing.T) {
+		tourState, err := e.PlaybooksClient.Tours.Get(context.Background(), e.RegularUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, client.TourState{}, tourState)
+	})
+
+	t.Run("update and persist tour steps", func(t *testing.T) {
+		_, err := e.PlaybooksClient.Tours.UpdateStep(context.Background(), e.RegularUser.Id, "playbook_edit", 2)
+		require.NoError(t, err)
+		_, err = e.PlaybooksClient.Tours.UpdateStep(context.Background(), e.RegularUser.Id, "tutorial_pb_run_details", 999)
+		require.NoError(t, err)
+
+		tourState, err := e.PlaybooksClient.Tours.Get(context.Background(), e.RegularUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, client.TourState{"playbook_edit": 2, "tutorial_pb_run_details": 999}, tourState)
+	})
+
+	t.Run("cannot access another user's tour state", func(t *testing.T) {
+		_, err := e.PlaybooksClient2.Tours.Get(context.Background(), e.RegularUser.Id)
+		requireErrorWithStatusCode(t, err, http.StatusForbidden)
+
+		err = e.PlaybooksClient2.Tours.Reset(context.Background(), e.RegularUser.Id)
+		requireErrorWithStatusCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("admin can reset another user's tour state", func(t *testing.T) {
+		err := e.PlaybooksAdminClient.Tours.Reset(context.Background(), e.RegularUser.Id)
+		require.NoError(t, err)
+
+		tourState, err := e.PlaybooksClient.Tours.Get(context.Background(), e.RegularUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, client.TourState{}, tourState)
+	})
+}
@@ -32,6 +32,10 @@ type CategorizeChannelPayload struct {
 	CategoryName string `json:"category_name" mapstructure:"category_name"`
 }
 
+type ChannelHeaderPayload struct {
+	Header string `json:"header" mapstructure:"header"`
+}
+
 type ActionType string
 type TriggerType string
 
@@ -40,21 +44,25 @@ const (
 	ActionTypeWelcomeMessage    ActionType = "send_welcome_message"
 	ActionTypePromptRunPlaybook ActionType = "prompt_run_playbook"
 	ActionTypeCategorizeChannel ActionType = "categorize_channel"
+	ActionTypeSetChannelHeader  ActionType = "set_channel_header"
 
 	// Trigger types: add new types to the ValidTriggerTypes array below
 	TriggerTypeNewMemberJoins TriggerType = "new_member_joins"
 	TriggerTypeKeywordsPosted TriggerType = "keywords"
+	TriggerTypeRunActive      TriggerType = "run_active"
 )
 
 var ValidActionTypes = []ActionType{
 	ActionTypeWelcomeMessage,
 	ActionTypePromptRunPlaybook,
 	ActionTypeCategorizeChannel,
+	ActionTypeSetChannelHeader,
 }
 
 var ValidTriggerTypes = []TriggerType{
 	TriggerTypeNewMemberJoins,
 	TriggerTypeKeywordsPosted,
+	TriggerTypeRunActive,
 }
 
 type GetChannelActionOptions struct {
@@ -90,6 +98,14 @@ type ChannelActionService interface {
 
 	// MessageHasBeenPosted suggests playbooks to the user if triggered
 	MessageHasBeenPosted(post *model.Post)
+
+	// RunActivated is called when a run in channelID starts or is restored. It sets the header of
+	// the channel registered in the ActionTypeSetChannelHeader action, saving the current one.
+	RunActivated(channelID, userID string)
+
+	// RunsDeactivated is called when the last active run in channelID finishes. It restores the
+	// channel header saved by RunActivated, if any.
+	RunsDeactivated(channelID, userID string)
 }
 
 type ChannelActionStore interface {
@@ -4,10 +4,12 @@
 package app
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/bot"
@@ -134,6 +136,10 @@ func (a *channelActionServiceImpl) Validate(action GenericChannelAction) error {
 		if action.ActionType != ActionTypePromptRunPlaybook {
 			return fmt.Errorf("action type %q is not valid for trigger type %q", action.ActionType, action.TriggerType)
 		}
+	case TriggerTypeRunActive:
+		if action.ActionType != ActionTypeSetChannelHeader {
+			return fmt.Errorf("action type %q is not valid for trigger type %q", action.ActionType, action.TriggerType)
+		}
 	default:
 		return fmt.Errorf("trigger type %q not recognized", action.TriggerType)
 	}
@@ -158,6 +164,14 @@ func (a *channelActionServiceImpl) Validate(action GenericChannelAction) error {
 		if err := mapstructure.Decode(action.Payload, &payload); err != nil {
 			return fmt.Errorf("unable to decode payload from action")
 		}
+	case ActionTypeSetChannelHeader:
+		var payload ChannelHeaderPayload
+		if err := mapstructure.Decode(action.Payload, &payload); err != nil {
+			return fmt.Errorf("unable to decode payload from action")
+		}
+		if utf8.RuneCountInString(payload.Header) > model.ChannelHeaderMaxRunes {
+			return fmt.Errorf("payload field 'header' must be at most %d characters long", model.ChannelHeaderMaxRunes)
+		}
 
 	default:
 		return fmt.Errorf("action type %q not recognized", action.ActionType)
@@ -340,6 +354,102 @@ func sliceContains(strs []string, target string) bool {
 	return false
 }
 
+// savedChannelHeaderKey is the key under which the header of channelID is saved while a run is
+// active in it.
+func savedChannelHeaderKey(channelID string) string {
+	return "saved_channel_header_" + channelID
+}
+
+// RunActivated is called when a run in channelID starts or is restored. It sets the header of
+// the channel registered in the ActionTypeSetChannelHeader action, saving the current one.
+func (a *channelActionServiceImpl) RunActivated(channelID, userID string) {
+	logger := logrus.WithField("channel_id", channelID)
+
+	actions, err := a.GetChannelActions(channelID, GetChannelActionOptions{
+		ActionType:  ActionTypeSetChannelHeader,
+		TriggerType: TriggerTypeRunActive,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to get the channel actions")
+		return
+	}
+
+	if len(actions) != 1 || !actions[0].Enabled {
+		return
+	}
+	action := actions[0]
+
+	var payload ChannelHeaderPayload
+	if err = mapstructure.Decode(action.Payload, &payload); err != nil {
+		logger.WithError(err).Error("unable to decode payload of ChannelHeaderPayload")
+		return
+	}
+
+	if payload.Header == "" {
+		return
+	}
+
+	channel, err := a.api.GetChannelByID(channelID)
+	if err != nil {
+		logger.WithError(err).Error("failed to resolve channel")
+		return
+	}
+
+	// Only the header set before the first active run is saved, so that several runs active at
+	// the same time don't save the header of the action itself.
+	savedHeader, err := json.Marshal(channel.Header)
+	if err != nil {
+		logger.WithError(err).Error("failed to marshal channel header")
+		return
+	}
+	if _, err = a.api.KVSetWithOptions(savedChannelHeaderKey(channelID), savedHeader, model.PluginKVSetOptions{Atomic: true}); err != nil {
+		logger.WithError(err).Error("failed to save channel header")
+		return
+	}
+
+	if channel.Header == payload.Header {
+		return
+	}
+
+	if _, err = a.api.PatchChannel(channelID, &model.ChannelPatch{Header: &payload.Header}, a.configService.GetConfiguration().BotUserID); err != nil {
+		logger.WithError(err).Error("failed to set channel header")
+		return
+	}
+
+	a.telemetry.RunChannelAction(action, userID)
+}
+
+// RunsDeactivated is called when the last active run in channelID finishes. It restores the
+// channel header saved by RunActivated, if any.
+func (a *channelActionServiceImpl) RunsDeactivated(channelID, userID string) {
+	logger := logrus.WithField("channel_id", channelID)
+
+	data, err := a.api.KVGet(savedChannelHeaderKey(channelID))
+	if err != nil {
+		logger.WithError(err).Error("failed to get saved channel header")
+		return
+	}
+
+	if data == nil {
+		return
+	}
+
+	var header string
+	if err = json.Unmarshal(data, &header); err != nil {
+		logger.WithError(err).Error("failed to unmarshal saved channel header")
+		return
+	}
+
+	if _, err = a.api.PatchChannel(channelID, &model.ChannelPatch{Header: &header}, a.configService.GetConfiguration().BotUserID); err != nil {
+		logger.WithError(err).Error("failed to restore channel header")
+		return
+	}
+
+	if err = a.api.KVDelete(savedChannelHeaderKey(channelID)); err != nil {
+		logger.WithError(err).Error("failed to delete saved channel header")
+	}
+}
+
 // CheckAndSendMessageOnJoin checks if userID has viewed channelID and sends
 // playbookRun.MessageOnJoin if it exists. Returns true if the message was sent.
 func (a *channelActionServiceImpl) CheckAndSendMessageOnJoin(userID, channelID string) bool {
@@ -0,0 +1,157 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	// BoardSyncGranularityRun mirrors every run as a single card.
+	BoardSyncGranularityRun = "run"
+
+	// BoardSyncGranularityChecklistItem mirrors every checklist item of a run as its own card.
+	BoardSyncGranularityChecklistItem = "checklist_item"
+)
+
+// BoardSync configures how the runs of a playbook are mirrored as cards in a board of the
+// Boards product.
+type BoardSync struct {
+	// Enabled is true if cards are created and kept in sync for new runs.
+	Enabled bool `json:"enabled"`
+
+	// BoardID is the identifier of the board where the cards are created.
+	BoardID string `json:"board_id"`
+
+	// Granularity is either BoardSyncGranularityRun or BoardSyncGranularityChecklistItem.
+	Granularity string `json:"granularity"`
+
+	// StatusPropertyID is the identifier of the board's select property that mirrors the run
+	// status or the checklist item state. If empty, only the card titles are kept in sync.
+	StatusPropertyID string `json:"status_property_id"`
+
+	// StatusOptions maps a run status (StatusInProgress, StatusFinished) or a checklist item
+	// state (ChecklistItemStateOpen, ChecklistItemStateInProgress, ChecklistItemStateClosed,
+	// ChecklistItemStateSkipped) to the identifier of an option of the status property.
+	StatusOptions map[string]string `json:"status_options"`
+}
+
+// IsActive returns true if cards should be created for the runs of the playbook.
+func (b BoardSync) IsActive() bool {
+	return b.Enabled && b.BoardID != ""
+}
+
+// OptionForStatus returns the status property option mapped to the given run status or
+// checklist item state, or the empty string if there is none.
+func (b BoardSync) OptionForStatus(status string) string {
+	if b.StatusOptions == nil {
+		return ""
+	}
+
+	return b.StatusOptions[status]
+}
+
+// StatusForOption is the inverse of OptionForStatus: it returns the run status or checklist item
+// state mapped to the given option. The second value is false if the option is not mapped.
+func (b BoardSync) StatusForOption(optionID string) (string, bool) {
+	if optionID == "" {
+		return "", false
+	}
+
+	for status, option := range b.StatusOptions {
+		if option == optionID {
+			return status, true
+		}
+	}
+
+	return "", false
+}
+
+// BoardItemStateChange is a checklist item state changed from Boards.
+type BoardItemStateChange struct {
+	ChecklistNumber int
+	ItemNumber      int
+	State           string
+
+	// UserID is the identifier of the user that last modified the card.
+	UserID string
+}
+
+// BoardChanges holds the changes made from Boards to the cards mirroring a run.
+type BoardChanges struct {
+	// RunStatus is the new status of the run, or the empty string if it was not changed.
+	RunStatus string
+
+	// RunStatusUserID is the identifier of the user that last modified the run card.
+	RunStatusUserID string
+
+	// ItemStates holds the checklist items whose state was changed.
+	ItemStates []BoardItemStateChange
+}
+
+// BoardSyncService mirrors runs and checklist items as cards in the Boards product.
+type BoardSyncService interface {
+	// SyncRunToBoard creates the missing cards for the given run and, if the whole run is
+	// mirrored as a single card, updates its title and status on behalf of userID.
+	SyncRunToBoard(playbookRunID, userID string) error
+
+	// SyncChecklistItemToBoard updates the title and the status of the card mirroring the given
+	// checklist item on behalf of userID, creating it if needed.
+	SyncChecklistItemToBoard(playbookRunID, userID string, checklistNumber, itemNumber int) error
+
+	// GetBoardChanges compares the cards mirroring the given run with the run itself, and
+	// returns the status and checklist item states that were changed from Boards.
+	GetBoardChanges(playbookRun *PlaybookRun) (*BoardChanges, error)
+}
+
+// ValidateBoardSync checks that the given settings can be used to create cards.
+func ValidateBoardSync(boardSync BoardSync) error {
+	if !boardSync.Enabled {
+		return nil
+	}
+
+	if boardSync.BoardID == "" {
+		return errors.New("board ID must be set when board sync is enabled")
+	}
+
+	var validStatuses []string
+	switch boardSync.Granularity {
+	case BoardSyncGranularityRun:
+		validStatuses = []string{StatusInProgress, StatusFinished}
+	case BoardSyncGranularityChecklistItem:
+		validStatuses = []string{ChecklistItemStateOpen, ChecklistItemStateInProgress, ChecklistItemStateClosed, ChecklistItemStateSkipped}
+	default:
+		return errors.Errorf("unknown board sync granularity '%s'", boardSync.Granularity)
+	}
+
+	if boardSync.StatusPropertyID == "" && len(boardSync.StatusOptions) > 0 {
+		return errors.New("status options require a status property")
+	}
+
+	seenOptions := make(map[string]bool, len(boardSync.StatusOptions))
+	for status, option := range boardSync.StatusOptions {
+		if !containsString(validStatuses, status) {
+			return errors.Errorf("status '%s' is not valid for board sync granularity '%s'", status, boardSync.Granularity)
+		}
+		if option == "" {
+			return errors.Errorf("status '%s' must be mapped to an option", status)
+		}
+		if seenOptions[option] {
+			return errors.Errorf("option '%s' is mapped to more than one status", option)
+		}
+		seenOptions[option] = true
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/playbooks"
+)
+
+type boardSyncService struct {
+	store           PlaybookRunStore
+	playbookService PlaybookService
+	api             playbooks.ServicesAPI
+}
+
+// NewBoardSyncService returns a new board sync service
+func NewBoardSyncService(store PlaybookRunStore, playbookService PlaybookService, api playbooks.ServicesAPI) BoardSyncService {
+	return &boardSyncService{
+		store:           store,
+		playbookService: playbookService,
+		api:             api,
+	}
+}
+
+// SyncRunToBoard creates the missing cards for the given run and, if the whole run is mirrored
+// as a single card, updates its title and status on behalf of userID.
+func (b *boardSyncService) SyncRunToBoard(playbookRunID, userID string) error {
+	playbookRun, err := b.store.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get playbook run '%s'", playbookRunID)
+	}
+
+	boardSync, ok, err := b.getBoardSync(playbookRun)
+	if err != nil || !ok {
+		return err
+	}
+
+	modified := false
+	if playbookRun.BoardID == "" {
+		if !b.api.CanManageBoardCards(userID, boardSync.BoardID) {
+			return errors.Errorf("user '%s' cannot manage the cards of board '%s'", userID, boardSync.BoardID)
+		}
+		playbookRun.BoardID = boardSync.BoardID
+		modified = true
+	}
+
+	var syncErr error
+	switch boardSync.Granularity {
+	case BoardSyncGranularityRun:
+		if playbookRun.BoardCardID != "" {
+			syncErr = b.updateCard(boardSync, playbookRun.BoardCardID, playbookRun.Name, playbookRun.CurrentStatus, userID)
+			break
+		}
+
+		var card *playbooks.BoardCard
+		card, syncErr = b.createCard(boardSync, playbookRun.Name, playbookRun.CurrentStatus, userID)
+		if syncErr == nil {
+			playbookRun.BoardCardID = card.ID
+			modified = true
+		}
+	case BoardSyncGranularityChecklistItem:
+	checklists:
+		for i := range playbookRun.Checklists {
+			for j := range playbookRun.Checklists[i].Items {
+				item := &playbookRun.Checklists[i].Items[j]
+				if item.BoardCardID != "" {
+					continue
+				}
+
+				var card *playbooks.BoardCard
+				card, syncErr = b.createCard(boardSync, item.Title, item.State, userID)
+				if syncErr != nil {
+					break checklists
+				}
+				item.BoardCardID = card.ID
+				modified = true
+			}
+		}
+	}
+
+	// Save the cards created so far even if a later one failed, so that they are not duplicated
+	// by the next sync.
+	if modified {
+		if _, err = b.store.UpdatePlaybookRun(playbookRun); err != nil {
+			return errors.Wrapf(err, "failed to save the board cards of playbook run '%s'", playbookRunID)
+		}
+	}
+
+	return syncErr
+}
+
+// SyncChecklistItemToBoard updates the title and the status of the card mirroring the given
+// checklist item on behalf of userID, creating it if needed.
+func (b *boardSyncService) SyncChecklistItemToBoard(playbookRunID, userID string, checklistNumber, itemNumber int) error {
+	playbookRun, err := b.store.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get playbook run '%s'", playbookRunID)
+	}
+
+	boardSync, ok, err := b.getBoardSync(playbookRun)
+	if err != nil || !ok || boardSync.Granularity != BoardSyncGranularityChecklistItem {
+		return err
+	}
+
+	if !IsValidChecklistItemIndex(playbookRun.Checklists, checklistNumber, itemNumber) {
+		return errors.New("invalid checklist item indices")
+	}
+
+	item := playbookRun.Checklists[checklistNumber].Items[itemNumber]
+	if item.BoardCardID == "" {
+		return b.SyncRunToBoard(playbookRunID, userID)
+	}
+
+	return b.updateCard(boardSync, item.BoardCardID, item.Title, item.State, userID)
+}
+
+// GetBoardChanges compares the cards mirroring the given run with the run itself, and returns
+// the status and checklist item states that were changed from Boards.
+func (b *boardSyncService) GetBoardChanges(playbookRun *PlaybookRun) (*BoardChanges, error) {
+	changes := &BoardChanges{}
+
+	boardSync, ok, err := b.getBoardSync(playbookRun)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || playbookRun.BoardID == "" || boardSync.StatusPropertyID == "" {
+		return changes, nil
+	}
+
+	switch boardSync.Granularity {
+	case BoardSyncGranularityRun:
+		if playbookRun.BoardCardID == "" {
+			break
+		}
+
+		status, modifiedBy, found, err := b.getCardStatus(boardSync, playbookRun.BoardCardID)
+		if err != nil {
+			return nil, err
+		}
+		if found && status != playbookRun.CurrentStatus {
+			changes.RunStatus = status
+			changes.RunStatusUserID = modifiedBy
+		}
+	case BoardSyncGranularityChecklistItem:
+		for i, checklist := range playbookRun.Checklists {
+			for j, item := range checklist.Items {
+				if item.BoardCardID == "" {
+					continue
+				}
+
+				state, modifiedBy, found, err := b.getCardStatus(boardSync, item.BoardCardID)
+				if err != nil {
+					return nil, err
+				}
+				if found && state != item.State {
+					changes.ItemStates = append(changes.ItemStates, BoardItemStateChange{
+						ChecklistNumber: i,
+						ItemNumber:      j,
+						State:           state,
+						UserID:          modifiedBy,
+					})
+				}
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// getBoardSync returns the board sync settings of the run's playbook. The second value is false
+// if the run is not mirrored in a board.
+func (b *boardSyncService) getBoardSync(playbookRun *PlaybookRun) (BoardSync, bool, error) {
+	if playbookRun.PlaybookID == "" {
+		return BoardSync{}, false, nil
+	}
+
+	playbook, err := b.playbookService.Get(playbookRun.PlaybookID)
+	if err != nil {
+		return BoardSync{}, false, errors.Wrapf(err, "failed to get playbook '%s'", playbookRun.PlaybookID)
+	}
+
+	if !playbook.BoardSync.IsActive() {
+		return playbook.BoardSync, false, nil
+	}
+
+	// The status options belong to a specific board, so runs whose cards were created before the
+	// playbook was moved to another board are no longer synced.
+	if playbookRun.BoardID != "" && playbookRun.BoardID != playbook.BoardSync.BoardID {
+		return playbook.BoardSync, false, nil
+	}
+
+	return playbook.BoardSync, true, nil
+}
+
+func (b *boardSyncService) createCard(boardSync BoardSync, title, status, userID string) (*playbooks.BoardCard, error) {
+	properties := map[string]any{}
+	if option := boardSync.OptionForStatus(status); boardSync.StatusPropertyID != "" && option != "" {
+		properties[boardSync.StatusPropertyID] = option
+	}
+
+	card, err := b.api.CreateBoardCard(&playbooks.BoardCard{
+		BoardID:    boardSync.BoardID,
+		Title:      title,
+		Properties: properties,
+	}, userID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create card in board '%s'", boardSync.BoardID)
+	}
+
+	return card, nil
+}
+
+func (b *boardSyncService) updateCard(boardSync BoardSync, cardID, title, status, userID string) error {
+	card, err := b.api.GetBoardCard(cardID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get card '%s'", cardID)
+	}
+
+	if card.DeleteAt != 0 {
+		return nil
+	}
+
+	patch := &playbooks.BoardCardPatch{}
+	modified := false
+	if card.Title != title {
+		patch.Title = &title
+		modified = true
+	}
+
+	option := boardSync.OptionForStatus(status)
+	if boardSync.StatusPropertyID != "" && option != "" && card.Properties[boardSync.StatusPropertyID] != option {
+		patch.UpdatedProperties = map[string]any{boardSync.StatusPropertyID: option}
+		modified = true
+	}
+
+	if !modified {
+		return nil
+	}
+
+	if _, err := b.api.PatchBoardCard(cardID, patch, userID); err != nil {
+		return errors.Wrapf(err, "failed to patch card '%s'", cardID)
+	}
+
+	return nil
+}
+
+// getCardStatus returns the run status or checklist item state mapped to the card's status
+// option, and the user that last modified the card. The third value is false if the card was
+// deleted or its option is not mapped.
+func (b *boardSyncService) getCardStatus(boardSync BoardSync, cardID string) (string, string, bool, error) {
+	card, err := b.api.GetBoardCard(cardID)
+	if err != nil {
+		return "", "", false, errors.Wrapf(err, "failed to get card '%s'", cardID)
+	}
+
+	if card.DeleteAt != 0 {
+		return "", "", false, nil
+	}
+
+	optionID, _ := card.Properties[boardSync.StatusPropertyID].(string)
+	status, ok := boardSync.StatusForOption(optionID)
+	if !ok {
+		return "", "", false, nil
+	}
+
+	return status, card.ModifiedBy, true, nil
+}
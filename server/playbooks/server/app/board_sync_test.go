@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBoardSync(t *testing.T) {
+	boardID := model.NewId()
+
+	tests := []struct {
+		name      string
+		boardSync BoardSync
+		wantErr   bool
+	}{
+		{"disabled", BoardSync{Granularity: "unknown"}, false},
+		{"run granularity", BoardSync{Enabled: true, BoardID: boardID, Granularity: BoardSyncGranularityRun}, false},
+		{"run statuses", BoardSync{Enabled: true, BoardID: boardID, Granularity: BoardSyncGranularityRun, StatusPropertyID: "p1", StatusOptions: map[string]string{StatusInProgress: "o1", StatusFinished: "o2"}}, false},
+		{"item states", BoardSync{Enabled: true, BoardID: boardID, Granularity: BoardSyncGranularityChecklistItem, StatusPropertyID: "p1", StatusOptions: map[string]string{ChecklistItemStateOpen: "o1", ChecklistItemStateClosed: "o2"}}, false},
+		{"missing board", BoardSync{Enabled: true, Granularity: BoardSyncGranularityRun}, true},
+		{"unknown granularity", BoardSync{Enabled: true, BoardID: boardID, Granularity: "team"}, true},
+		{"options without property", BoardSync{Enabled: true, BoardID: boardID, Granularity: BoardSyncGranularityRun, StatusOptions: map[string]string{StatusFinished: "o1"}}, true},
+		{"item state for run granularity", BoardSync{Enabled: true, BoardID: boardID, Granularity: BoardSyncGranularityRun, StatusPropertyID: "p1", StatusOptions: map[string]string{ChecklistItemStateClosed: "o1"}}, true},
+		{"empty option", BoardSync{Enabled: true, BoardID: boardID, Granularity: BoardSyncGranularityRun, StatusPropertyID: "p1", StatusOptions: map[string]string{StatusFinished: ""}}, true},
+		{"duplicated option", BoardSync{Enabled: true, BoardID: boardID, Granularity: BoardSyncGranularityRun, StatusPropertyID: "p1", StatusOptions: map[string]string{StatusInProgress: "o1", StatusFinished: "o1"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBoardSync(tt.boardSync)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBoardSyncStatusOptions(t *testing.T) {
+	boardSync := BoardSync{
+		StatusOptions: map[string]string{
+			ChecklistItemStateOpen:   "o1",
+			ChecklistItemStateClosed: "o2",
+		},
+	}
+
+	require.Equal(t, "o2", boardSync.OptionForStatus(ChecklistItemStateClosed))
+	require.Equal(t, "", boardSync.OptionForStatus(ChecklistItemStateSkipped))
+
+	state, ok := boardSync.StatusForOption("o1")
+	require.True(t, ok)
+	require.Equal(t, ChecklistItemStateOpen, state)
+
+	_, ok = boardSync.StatusForOption("o3")
+	require.False(t, ok)
+
+	_, ok = boardSync.StatusForOption("")
+	require.False(t, ok)
+
+	require.Equal(t, "", BoardSync{}.OptionForStatus(StatusFinished))
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const (
+	// CallsPluginID is the identifier of the Calls plugin.
+	CallsPluginID = model.PluginIdCalls
+
+	// CallPostType is the type of the post created by the Calls plugin when a call starts. The
+	// same post is updated when the call ends.
+	CallPostType = "custom_calls"
+
+	// callStartCommand is the slash command of the Calls plugin starting a call in the channel.
+	callStartCommand = "/call start"
+)
+
+// CallDetails holds the details of a call held in a run's channel. It is stored as the details
+// of the "call_started" and "call_ended" timeline events.
+type CallDetails struct {
+	// StartAt is the timestamp, in milliseconds since epoch, of the time the call started.
+	StartAt int64 `json:"start_at"`
+
+	// EndAt is the timestamp, in milliseconds since epoch, of the time the call ended. 0 if the
+	// call is still ongoing.
+	EndAt int64 `json:"end_at,omitempty"`
+
+	// Duration is the duration of the call in milliseconds, set once it has ended.
+	Duration int64 `json:"duration,omitempty"`
+
+	// RecordingFileIDs holds the identifiers of the files recorded during the call.
+	RecordingFileIDs []string `json:"recording_file_ids,omitempty"`
+}
+
+// CallRecording is a recording of a call held in a run's channel.
+type CallRecording struct {
+	// CallPostID is the identifier of the post created by the Calls plugin for the call.
+	CallPostID string `json:"call_post_id"`
+
+	// FileID is the identifier of the recording file.
+	FileID string `json:"file_id"`
+
+	// Name is the name of the recording file.
+	Name string `json:"name"`
+
+	// Size is the size of the recording file in bytes.
+	Size int64 `json:"size"`
+
+	// CreateAt is the timestamp, in milliseconds since epoch, of the time the recording was saved.
+	CreateAt int64 `json:"create_at"`
+}
+
+// GetCallDetails reads the details of a call from the post created by the Calls plugin. The
+// second value is false if the post was not created by the Calls plugin.
+func GetCallDetails(post *model.Post) (CallDetails, bool) {
+	if post == nil || post.Type != CallPostType {
+		return CallDetails{}, false
+	}
+
+	details := CallDetails{
+		StartAt:          int64Prop(post, "start_at"),
+		EndAt:            int64Prop(post, "end_at"),
+		RecordingFileIDs: stringSliceProp(post, "recording_files"),
+	}
+	if details.StartAt == 0 {
+		details.StartAt = post.CreateAt
+	}
+	if details.EndAt > details.StartAt {
+		details.Duration = details.EndAt - details.StartAt
+	}
+
+	return details, true
+}
+
+// formatCallDuration returns a human readable call duration, rounded to the second.
+func formatCallDuration(duration int64) string {
+	return (time.Duration(duration) * time.Millisecond).Round(time.Second).String()
+}
+
+// int64Prop reads a numeric post prop, which is a float64 once the post went through JSON.
+func int64Prop(post *model.Post, key string) int64 {
+	switch value := post.GetProp(key).(type) {
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	case float64:
+		return int64(value)
+	case json.Number:
+		n, _ := value.Int64()
+		return n
+	}
+
+	return 0
+}
+
+func stringSliceProp(post *model.Post, key string) []string {
+	switch value := post.GetProp(key).(type) {
+	case []string:
+		return value
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			if s, ok := v.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+
+	return nil
+}
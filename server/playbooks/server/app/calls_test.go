@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetCallDetails(t *testing.T) {
+	t.Run("not a call post", func(t *testing.T) {
+		_, ok := GetCallDetails(&model.Post{Type: model.PostTypeDefault})
+		require.False(t, ok)
+
+		_, ok = GetCallDetails(nil)
+		require.False(t, ok)
+	})
+
+	t.Run("ongoing call", func(t *testing.T) {
+		post := &model.Post{Type: CallPostType, CreateAt: 900}
+		post.AddProp("start_at", int64(1000))
+
+		details, ok := GetCallDetails(post)
+		require.True(t, ok)
+		require.Equal(t, CallDetails{StartAt: 1000}, details)
+	})
+
+	t.Run("ended call decoded from JSON", func(t *testing.T) {
+		var post model.Post
+		err := json.Unmarshal([]byte(`{"type": "custom_calls", "props": {"start_at": 1000, "end_at": 91000, "recording_files": ["file1", "file2"]}}`), &post)
+		require.NoError(t, err)
+
+		details, ok := GetCallDetails(&post)
+		require.True(t, ok)
+		require.Equal(t, CallDetails{
+			StartAt:          1000,
+			EndAt:            91000,
+			Duration:         90000,
+			RecordingFileIDs: []string{"file1", "file2"},
+		}, details)
+		require.Equal(t, "1m30s", formatCallDuration(details.Duration))
+	})
+}
+
+func TestGeneratePlaybookRunExport(t *testing.T) {
+	run := PlaybookRun{ID: "run1", Name: "Run"}
+
+	t.Run("without recordings", func(t *testing.T) {
+		result, err := GeneratePlaybookRunExport(run, nil)
+		require.NoError(t, err)
+
+		var export map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(result, &export))
+		require.JSONEq(t, "[]", string(export["call_recordings"]))
+		require.JSONEq(t, "1", string(export["version"]))
+	})
+
+	t.Run("with recordings", func(t *testing.T) {
+		recordings := []CallRecording{{CallPostID: "post1", FileID: "file1", Name: "call.mp4", Size: 42, CreateAt: 1000}}
+		result, err := GeneratePlaybookRunExport(run, recordings)
+		require.NoError(t, err)
+
+		var export struct {
+			Run            PlaybookRun     `json:"run"`
+			CallRecordings []CallRecording `json:"call_recordings"`
+		}
+		require.NoError(t, json.Unmarshal(result, &export))
+		require.Equal(t, "run1", export.Run.ID)
+		require.Equal(t, recordings, export.CallRecordings)
+	})
+}
@@ -112,6 +112,25 @@ type CategoryService interface {
 	IsItemFavorite(item CategoryItem, teamID, userID string) (bool, error)
 
 	AreItemsFavorites(items []CategoryItem, teamID, userID string) ([]bool, error)
+
+	// CreateRule creates a new category rule
+	CreateRule(rule CategoryRule) (string, error)
+
+	// GetRule retrieves a category rule. Returns ErrNotFound if not found.
+	GetRule(ruleID string) (CategoryRule, error)
+
+	// GetRules retrieves all category rules, oldest first
+	GetRules() ([]CategoryRule, error)
+
+	// UpdateRule updates a category rule
+	UpdateRule(rule CategoryRule) error
+
+	// DeleteRule deletes a category rule
+	DeleteRule(ruleID string) error
+
+	// ApplyRules files the run into the category of the rule matching it, for each of userIDs,
+	// and removes it from the categories of the rules no longer matching it.
+	ApplyRules(run *PlaybookRun, userIDs []string) error
 }
 
 type CategoryStore interface {
@@ -142,6 +161,21 @@ type CategoryStore interface {
 
 	// DeleteItemFromCategory adds an item to category
 	DeleteItemFromCategory(item CategoryItem, categoryID string) error
+
+	// CreateRule creates a new category rule
+	CreateRule(rule CategoryRule) error
+
+	// GetRule retrieves a category rule. Returns ErrNotFound if not found.
+	GetRule(ruleID string) (CategoryRule, error)
+
+	// GetRules retrieves all category rules, oldest first
+	GetRules() ([]CategoryRule, error)
+
+	// UpdateRule updates a category rule
+	UpdateRule(rule CategoryRule) error
+
+	// DeleteRule deletes a category rule
+	DeleteRule(ruleID string) error
 }
 
 type CategoryTelemetry interface {
@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/pkg/errors"
+)
+
+// MaxCategoryRules is the maximum number of category rules that can be defined.
+const MaxCategoryRules = 100
+
+// CategoryRule files the runs matching all of its non-empty criteria into the sidebar category
+// named CategoryName of each of their participants, creating the category if needed.
+type CategoryRule struct {
+	ID           string `json:"id"`
+	CategoryName string `json:"category_name"`
+	PlaybookID   string `json:"playbook_id"`
+	Severity     string `json:"severity"`
+	TeamID       string `json:"team_id"`
+	CreateAt     int64  `json:"create_at"`
+	UpdateAt     int64  `json:"update_at"`
+}
+
+// IsValid checks that the rule has a category name and at least one criterion.
+func (r *CategoryRule) IsValid() error {
+	if strings.TrimSpace(r.CategoryName) == "" {
+		return errors.New("category name cannot be empty")
+	}
+
+	if err := ValidateCategoryName(r.CategoryName); err != nil {
+		return err
+	}
+
+	if r.PlaybookID == "" && r.Severity == "" && r.TeamID == "" {
+		return errors.New("rule must match on at least one of playbook, severity or team")
+	}
+
+	if r.PlaybookID != "" && !model.IsValidId(r.PlaybookID) {
+		return errors.New("playbook ID is not valid")
+	}
+
+	if r.TeamID != "" && !model.IsValidId(r.TeamID) {
+		return errors.New("team ID is not valid")
+	}
+
+	if len(r.Severity) > MaxSeverityNameLength {
+		return errors.Errorf("severity is longer than %d characters", MaxSeverityNameLength)
+	}
+
+	return nil
+}
+
+// Matches returns true if the run matches all the criteria of the rule.
+func (r *CategoryRule) Matches(run *PlaybookRun) bool {
+	if r.PlaybookID != "" && r.PlaybookID != run.PlaybookID {
+		return false
+	}
+	if r.Severity != "" && r.Severity != run.Severity {
+		return false
+	}
+	if r.TeamID != "" && r.TeamID != run.TeamID {
+		return false
+	}
+
+	return true
+}
+
+// specificity is the number of criteria of the rule.
+func (r *CategoryRule) specificity() int {
+	count := 0
+	for _, criterion := range []string{r.PlaybookID, r.Severity, r.TeamID} {
+		if criterion != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// MatchCategoryRule returns the rule that files the run: among the rules matching it, the one
+// with the most criteria and, on ties, the oldest one. The second value is false if no rule
+// matches the run.
+func MatchCategoryRule(rules []CategoryRule, run *PlaybookRun) (CategoryRule, bool) {
+	var match CategoryRule
+	found := false
+	for _, rule := range rules {
+		if !rule.Matches(run) {
+			continue
+		}
+
+		if !found ||
+			rule.specificity() > match.specificity() ||
+			(rule.specificity() == match.specificity() && rule.CreateAt < match.CreateAt) {
+			match = rule
+			found = true
+		}
+	}
+
+	return match, found
+}
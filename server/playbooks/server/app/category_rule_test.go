@@ -0,0 +1,71 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryRuleIsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    CategoryRule
+		wantErr bool
+	}{
+		{"playbook", CategoryRule{CategoryName: "Incidents", PlaybookID: model.NewId()}, false},
+		{"all criteria", CategoryRule{CategoryName: "Incidents", PlaybookID: model.NewId(), Severity: SeveritySEV1, TeamID: model.NewId()}, false},
+		{"empty name", CategoryRule{CategoryName: " ", Severity: SeveritySEV1}, true},
+		{"long name", CategoryRule{CategoryName: strings.Repeat("a", 23), Severity: SeveritySEV1}, true},
+		{"no criteria", CategoryRule{CategoryName: "Incidents"}, true},
+		{"invalid playbook", CategoryRule{CategoryName: "Incidents", PlaybookID: "playbook"}, true},
+		{"invalid team", CategoryRule{CategoryName: "Incidents", TeamID: "team"}, true},
+		{"long severity", CategoryRule{CategoryName: "Incidents", Severity: strings.Repeat("a", MaxSeverityNameLength+1)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.IsValid()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMatchCategoryRule(t *testing.T) {
+	playbookID := model.NewId()
+	teamID := model.NewId()
+	run := &PlaybookRun{PlaybookID: playbookID, TeamID: teamID, Severity: SeveritySEV1}
+
+	bySeverity := CategoryRule{ID: "severity", Severity: SeveritySEV1, CreateAt: 1}
+	byTeam := CategoryRule{ID: "team", TeamID: teamID, CreateAt: 2}
+	byPlaybookAndSeverity := CategoryRule{ID: "playbook_severity", PlaybookID: playbookID, Severity: SeveritySEV1, CreateAt: 3}
+	otherPlaybook := CategoryRule{ID: "other", PlaybookID: model.NewId(), Severity: SeveritySEV1, TeamID: teamID, CreateAt: 0}
+
+	tests := []struct {
+		name   string
+		rules  []CategoryRule
+		wantID string
+	}{
+		{"no rules", nil, ""},
+		{"no match", []CategoryRule{otherPlaybook}, ""},
+		{"single match", []CategoryRule{otherPlaybook, byTeam}, "team"},
+		{"oldest wins on ties", []CategoryRule{byTeam, bySeverity}, "severity"},
+		{"most specific wins", []CategoryRule{bySeverity, byPlaybookAndSeverity, byTeam}, "playbook_severity"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, found := MatchCategoryRule(tt.rules, run)
+			require.Equal(t, tt.wantID != "", found)
+			require.Equal(t, tt.wantID, rule.ID)
+		})
+	}
+}
@@ -5,6 +5,7 @@ package app
 
 import (
 	"database/sql"
+	"strings"
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/playbooks"
@@ -165,3 +166,147 @@ func (c *categoryService) AreItemsFavorites(items []CategoryItem, teamID, userID
 	}
 	return result, nil
 }
+
+// CreateRule creates a new category rule
+func (c *categoryService) CreateRule(rule CategoryRule) (string, error) {
+	if rule.ID != "" {
+		return "", errors.New("ID should be empty")
+	}
+	if err := rule.IsValid(); err != nil {
+		return "", errors.Wrap(err, "invalid category rule")
+	}
+
+	rules, err := c.store.GetRules()
+	if err != nil {
+		return "", errors.Wrap(err, "can't get category rules")
+	}
+	if len(rules) >= MaxCategoryRules {
+		return "", errors.Errorf("cannot have more than %d category rules", MaxCategoryRules)
+	}
+
+	rule.ID = model.NewId()
+	rule.CreateAt = model.GetMillis()
+	rule.UpdateAt = rule.CreateAt
+	if err := c.store.CreateRule(rule); err != nil {
+		return "", errors.Wrap(err, "can't create category rule")
+	}
+	return rule.ID, nil
+}
+
+// GetRule retrieves a category rule. Returns ErrNotFound if not found.
+func (c *categoryService) GetRule(ruleID string) (CategoryRule, error) {
+	return c.store.GetRule(ruleID)
+}
+
+// GetRules retrieves all category rules, oldest first
+func (c *categoryService) GetRules() ([]CategoryRule, error) {
+	return c.store.GetRules()
+}
+
+// UpdateRule updates a category rule
+func (c *categoryService) UpdateRule(rule CategoryRule) error {
+	if rule.ID == "" {
+		return errors.New("id should not be empty")
+	}
+	if err := rule.IsValid(); err != nil {
+		return errors.Wrap(err, "invalid category rule")
+	}
+
+	rule.UpdateAt = model.GetMillis()
+	if err := c.store.UpdateRule(rule); err != nil {
+		return errors.Wrap(err, "can't update category rule")
+	}
+	return nil
+}
+
+// DeleteRule deletes a category rule
+func (c *categoryService) DeleteRule(ruleID string) error {
+	if err := c.store.DeleteRule(ruleID); err != nil {
+		return errors.Wrap(err, "can't delete category rule")
+	}
+	return nil
+}
+
+// ApplyRules files the run into the category of the rule matching it, for each of userIDs,
+// and removes it from the categories of the rules no longer matching it.
+func (c *categoryService) ApplyRules(run *PlaybookRun, userIDs []string) error {
+	rules, err := c.store.GetRules()
+	if err != nil {
+		return errors.Wrap(err, "can't get category rules")
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	categoryName := ""
+	if match, found := MatchCategoryRule(rules, run); found {
+		categoryName = match.CategoryName
+	}
+
+	// The categories of the other rules may hold the run from before it changed
+	staleCategoryNames := make(map[string]bool)
+	for _, rule := range rules {
+		if !strings.EqualFold(rule.CategoryName, categoryName) {
+			staleCategoryNames[strings.ToLower(rule.CategoryName)] = true
+		}
+	}
+
+	item := CategoryItem{ItemID: run.ID, Type: RunItemType}
+	for _, userID := range userIDs {
+		if err := c.fileItem(item, run.TeamID, userID, categoryName, staleCategoryNames); err != nil {
+			return errors.Wrapf(err, "can't file run for user %q", userID)
+		}
+	}
+
+	return nil
+}
+
+// fileItem adds the item to the user's category named categoryName, creating it if needed, and
+// removes it from the user's categories named in staleCategoryNames. An empty categoryName only
+// removes the item.
+func (c *categoryService) fileItem(item CategoryItem, teamID, userID, categoryName string, staleCategoryNames map[string]bool) error {
+	categories, err := c.store.GetCategories(teamID, userID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return errors.Wrap(err, "can't get categories")
+	}
+
+	filed := false
+	for _, category := range categories {
+		if category.DeleteAt != 0 {
+			continue
+		}
+
+		if categoryName != "" && strings.EqualFold(category.Name, categoryName) {
+			filed = true
+			if !category.ContainsItem(item) {
+				if err := c.store.AddItemToCategory(item, category.ID); err != nil {
+					return errors.Wrap(err, "can't add item to category")
+				}
+			}
+		} else if staleCategoryNames[strings.ToLower(category.Name)] && category.ContainsItem(item) {
+			if err := c.store.DeleteItemFromCategory(item, category.ID); err != nil {
+				return errors.Wrap(err, "can't delete item from category")
+			}
+		}
+	}
+
+	if categoryName == "" || filed {
+		return nil
+	}
+
+	categoryID, err := c.Create(Category{
+		Name:   categoryName,
+		TeamID: teamID,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := c.store.AddItemToCategory(item, categoryID); err != nil {
+		return errors.Wrap(err, "can't add item to category")
+	}
+
+	return nil
+}
@@ -22,3 +22,6 @@ var ErrMalformedPlaybookRun = errors.New("malformed")
 
 // ErrDuplicateEntry occurs when failing to insert because the entry already existed.
 var ErrDuplicateEntry = errors.New("duplicate entry")
+
+// ErrCallsUnavailable occurs when trying to start a call while the Calls plugin is not active.
+var ErrCallsUnavailable = errors.New("calls plugin is not active")
@@ -10,6 +10,8 @@ import (
 
 const CurrentPlaybookExportVersion = 1
 
+const CurrentPlaybookRunExportVersion = 1
+
 func getFieldsForExport(in interface{}) map[string]interface{} {
 	out := map[string]interface{}{}
 
@@ -58,6 +60,32 @@ func generateMetricsExport(metrics []PlaybookMetricConfig) []interface{} {
 	return exported
 }
 
+func generatePropertyFieldsExport(fields []PropertyField) []interface{} {
+	exported := make([]interface{}, 0, len(fields))
+	for _, field := range fields {
+		exportField := getFieldsForExport(field)
+		if len(field.Options) > 0 {
+			options := make([]interface{}, 0, len(field.Options))
+			for _, option := range field.Options {
+				options = append(options, getFieldsForExport(option))
+			}
+			exportField["options"] = options
+		}
+		exported = append(exported, exportField)
+	}
+
+	return exported
+}
+
+func generateSeverityLevelsExport(levels []SeverityLevel) []interface{} {
+	exported := make([]interface{}, 0, len(levels))
+	for _, level := range levels {
+		exported = append(exported, getFieldsForExport(level))
+	}
+
+	return exported
+}
+
 // GeneratePlaybookExport returns a playbook in export format.
 // Fields marked with the stuct tag "export" are included using the given string.
 func GeneratePlaybookExport(playbook Playbook) ([]byte, error) {
@@ -65,6 +93,29 @@ func GeneratePlaybookExport(playbook Playbook) ([]byte, error) {
 	export["version"] = CurrentPlaybookExportVersion
 	export["checklists"] = generateChecklistExport(playbook.Checklists)
 	export["metrics"] = generateMetricsExport(playbook.Metrics)
+	export["property_fields"] = generatePropertyFieldsExport(playbook.PropertyFields)
+	export["severity_levels"] = generateSeverityLevelsExport(playbook.SeverityLevels)
+
+	result, err := json.MarshalIndent(export, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GeneratePlaybookRunExport returns a playbook run in export format, along with the recordings
+// of the calls held in its channel.
+func GeneratePlaybookRunExport(playbookRun PlaybookRun, recordings []CallRecording) ([]byte, error) {
+	if recordings == nil {
+		recordings = []CallRecording{}
+	}
+
+	export := map[string]interface{}{
+		"version":         CurrentPlaybookRunExportVersion,
+		"run":             playbookRun,
+		"call_recordings": recordings,
+	}
 
 	result, err := json.MarshalIndent(export, "", "    ")
 	if err != nil {
@@ -40,6 +40,22 @@ func TestGeneratePlaybookExport(t *testing.T) {
 				Target:      null.IntFrom(147),
 			},
 		},
+		PropertyFields: []PropertyField{
+			{
+				ID:         "2",
+				PlaybookID: "11",
+				Name:       "Region",
+				Type:       PropertyFieldTypeSelect,
+				Options:    []PropertyOption{{ID: "3", Name: "EMEA"}},
+			},
+		},
+		SeverityLevels: []SeverityLevel{
+			{
+				Name:                        SeveritySEV1,
+				BroadcastChannelIDs:         []string{"4"},
+				ReminderTimerDefaultSeconds: 900,
+			},
+		},
 	}
 
 	output, err := GeneratePlaybookExport(pb)
@@ -62,6 +78,18 @@ func TestGeneratePlaybookExport(t *testing.T) {
 	pb.Metrics[0].PlaybookID = ""
 	assert.Equal(t, result.Metrics, pb.Metrics)
 
+	// Shouldn't copy property field and option IDs
+	assert.Equal(t, []PropertyField{{
+		Name:    "Region",
+		Type:    PropertyFieldTypeSelect,
+		Options: []PropertyOption{{Name: "EMEA"}},
+	}}, result.PropertyFields)
+
+	// Shouldn't copy the broadcast channels of severity levels
+	assert.Equal(t, []SeverityLevel{{
+		Name:                        SeveritySEV1,
+		ReminderTimerDefaultSeconds: 900,
+	}}, result.SeverityLevels)
 }
 
 func definesExports(t *testing.T, thing interface{}) {
@@ -79,4 +107,7 @@ func TestPlaybookDefinesExports(t *testing.T) {
 	definesExports(t, Playbook{})
 	definesExports(t, Checklist{})
 	definesExports(t, ChecklistItem{})
+	definesExports(t, PropertyField{})
+	definesExports(t, PropertyOption{})
+	definesExports(t, SeverityLevel{})
 }
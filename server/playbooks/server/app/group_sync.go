@@ -0,0 +1,66 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+// groupSyncChanges computes how the participants of a run must change to follow the current
+// members of its invited groups. Only the users who joined the groups since the last sync are
+// added, so that members who left the run on their own are not invited again. The users who left
+// the groups are removed, except for the owner, the reporter and the users invited explicitly.
+func groupSyncChanges(playbookRun *PlaybookRun, memberIDs []string) (toAdd, toRemove []string) {
+	participants := make(map[string]bool, len(playbookRun.ParticipantIDs))
+	for _, userID := range playbookRun.ParticipantIDs {
+		participants[userID] = true
+	}
+
+	synced := make(map[string]bool, len(playbookRun.SyncedGroupMemberIDs))
+	for _, userID := range playbookRun.SyncedGroupMemberIDs {
+		synced[userID] = true
+	}
+
+	members := make(map[string]bool, len(memberIDs))
+	for _, userID := range memberIDs {
+		if members[userID] {
+			continue
+		}
+		members[userID] = true
+
+		if !synced[userID] && !participants[userID] {
+			toAdd = append(toAdd, userID)
+		}
+	}
+
+	protected := map[string]bool{
+		playbookRun.OwnerUserID:    true,
+		playbookRun.ReporterUserID: true,
+	}
+	for _, userID := range playbookRun.InvitedUserIDs {
+		protected[userID] = true
+	}
+
+	for _, userID := range playbookRun.SyncedGroupMemberIDs {
+		if !members[userID] && participants[userID] && !protected[userID] {
+			toRemove = append(toRemove, userID)
+		}
+	}
+
+	return toAdd, toRemove
+}
+
+// sameIDs returns true if both lists hold the same identifiers, regardless of order and duplicates.
+func sameIDs(a, b []string) bool {
+	inA := make(map[string]bool, len(a))
+	for _, id := range a {
+		inA[id] = true
+	}
+
+	inB := make(map[string]bool, len(b))
+	for _, id := range b {
+		if !inA[id] {
+			return false
+		}
+		inB[id] = true
+	}
+
+	return len(inA) == len(inB)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupSyncChanges(t *testing.T) {
+	run := &PlaybookRun{
+		OwnerUserID:          "owner",
+		ReporterUserID:       "reporter",
+		InvitedUserIDs:       []string{"invited"},
+		ParticipantIDs:       []string{"owner", "reporter", "invited", "member", "former", "manual"},
+		SyncedGroupMemberIDs: []string{"owner", "reporter", "invited", "member", "former", "left"},
+	}
+
+	tests := []struct {
+		name         string
+		memberIDs    []string
+		wantToAdd    []string
+		wantToRemove []string
+	}{
+		{"no changes", []string{"owner", "reporter", "invited", "member", "former", "left"}, nil, nil},
+		{"new member", []string{"owner", "reporter", "invited", "member", "former", "left", "new", "new"}, []string{"new"}, nil},
+		{"member who left the run is not added back", []string{"member", "former", "left"}, nil, nil},
+		{"new member already participating", []string{"owner", "reporter", "invited", "member", "former", "left", "manual"}, nil, nil},
+		{"former members are removed", []string{"member"}, nil, []string{"former"}},
+		{"protected users are kept", []string{}, nil, []string{"member", "former"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toAdd, toRemove := groupSyncChanges(run, tt.memberIDs)
+			require.Equal(t, tt.wantToAdd, toAdd)
+			require.Equal(t, tt.wantToRemove, toRemove)
+		})
+	}
+}
+
+func TestSameIDs(t *testing.T) {
+	require.True(t, sameIDs(nil, []string{}))
+	require.True(t, sameIDs([]string{"a", "b"}, []string{"b", "a", "a"}))
+	require.False(t, sameIDs([]string{"a"}, []string{"a", "b"}))
+	require.False(t, sameIDs([]string{"a", "b"}, []string{"a"}))
+}
+
+func TestSetConfigurationFromPlaybookSyncInvitedGroups(t *testing.T) {
+	playbook := Playbook{
+		InviteUsersEnabled:       true,
+		InvitedGroupIDs:          []string{"group"},
+		SyncInvitedGroupsEnabled: true,
+	}
+
+	run := PlaybookRun{}
+	run.SetConfigurationFromPlaybook(playbook, RunSourcePost)
+	require.True(t, run.SyncInvitedGroups)
+
+	playbook.InviteUsersEnabled = false
+	run = PlaybookRun{}
+	run.SetConfigurationFromPlaybook(playbook, RunSourcePost)
+	require.False(t, run.SyncInvitedGroups)
+
+	playbook.InviteUsersEnabled = true
+	playbook.InvitedGroupIDs = nil
+	run = PlaybookRun{}
+	run.SetConfigurationFromPlaybook(playbook, RunSourcePost)
+	require.False(t, run.SyncInvitedGroups)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	HealthStatusOK        = "OK"
+	HealthStatusUnhealthy = "UNHEALTHY"
+	HealthStatusDisabled  = "DISABLED"
+)
+
+// ComponentHealth is the health of one of the components Playbooks depends on.
+type ComponentHealth struct {
+	Status string `json:"status"`
+}
+
+// MigrationsHealth is the health of the database schema: it is unhealthy while migrations are
+// pending.
+type MigrationsHealth struct {
+	Status         string `json:"status"`
+	CurrentVersion string `json:"current_version"`
+	LatestVersion  string `json:"latest_version"`
+}
+
+// Health is the result of a health check. Status is unhealthy if the database, the migrations or
+// the scheduler are unhealthy. The metrics server is reported, but does not affect Status.
+type Health struct {
+	Status        string           `json:"status"`
+	Database      ComponentHealth  `json:"database"`
+	Migrations    MigrationsHealth `json:"migrations"`
+	Scheduler     ComponentHealth  `json:"scheduler"`
+	MetricsServer ComponentHealth  `json:"metrics_server"`
+}
+
+// Err returns an error listing the unhealthy components, or nil if the health check passed.
+func (h Health) Err() error {
+	if h.Status == HealthStatusOK {
+		return nil
+	}
+
+	var unhealthy []string
+	if h.Database.Status != HealthStatusOK {
+		unhealthy = append(unhealthy, "database")
+	}
+	if h.Migrations.Status != HealthStatusOK {
+		unhealthy = append(unhealthy, "migrations")
+	}
+	if h.Scheduler.Status != HealthStatusOK {
+		unhealthy = append(unhealthy, "scheduler")
+	}
+
+	return errors.Errorf("unhealthy components: %v", unhealthy)
+}
+
+// HealthStore is the subset of the store used to check the health of the database.
+type HealthStore interface {
+	// Ping checks the connection to the database.
+	Ping() error
+
+	// GetCurrentVersion returns the version the database schema was migrated to.
+	GetCurrentVersion() (semver.Version, error)
+}
+
+// RunningChecker is implemented by the background components that can report whether they are
+// running.
+type RunningChecker interface {
+	IsRunning() bool
+}
+
+// HealthService checks the health of Playbooks.
+type HealthService interface {
+	// Check runs the health check.
+	Check() Health
+}
+
+type healthService struct {
+	store         HealthStore
+	latestVersion semver.Version
+	scheduler     RunningChecker
+	metricsServer func() (enabled, running bool)
+}
+
+// NewHealthService creates a new HealthService. metricsServer reports whether the metrics server
+// is enabled and, if so, whether it is running.
+func NewHealthService(store HealthStore, latestVersion semver.Version, scheduler RunningChecker, metricsServer func() (enabled, running bool)) HealthService {
+	return &healthService{
+		store:         store,
+		latestVersion: latestVersion,
+		scheduler:     scheduler,
+		metricsServer: metricsServer,
+	}
+}
+
+// Check runs the health check.
+func (s *healthService) Check() Health {
+	health := Health{
+		Status:        HealthStatusOK,
+		Database:      ComponentHealth{Status: HealthStatusOK},
+		Migrations:    MigrationsHealth{Status: HealthStatusOK, LatestVersion: s.latestVersion.String()},
+		Scheduler:     ComponentHealth{Status: HealthStatusOK},
+		MetricsServer: ComponentHealth{Status: HealthStatusDisabled},
+	}
+
+	if err := s.store.Ping(); err != nil {
+		logrus.WithError(err).Warn("health check failed to reach the database")
+		health.Database.Status = HealthStatusUnhealthy
+		health.Migrations.Status = HealthStatusUnhealthy
+	} else if currentVersion, err := s.store.GetCurrentVersion(); err != nil {
+		logrus.WithError(err).Warn("health check failed to get the database schema version")
+		health.Migrations.Status = HealthStatusUnhealthy
+	} else {
+		health.Migrations.CurrentVersion = currentVersion.String()
+		if currentVersion.LT(s.latestVersion) {
+			health.Migrations.Status = HealthStatusUnhealthy
+		}
+	}
+
+	if !s.scheduler.IsRunning() {
+		health.Scheduler.Status = HealthStatusUnhealthy
+	}
+
+	if enabled, running := s.metricsServer(); enabled {
+		health.MetricsServer.Status = HealthStatusOK
+		if !running {
+			health.MetricsServer.Status = HealthStatusUnhealthy
+		}
+	}
+
+	if health.Database.Status != HealthStatusOK ||
+		health.Migrations.Status != HealthStatusOK ||
+		health.Scheduler.Status != HealthStatusOK {
+		health.Status = HealthStatusUnhealthy
+	}
+
+	return health
+}
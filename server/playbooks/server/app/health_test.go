@@ -0,0 +1,125 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHealthStore struct {
+	pingErr    error
+	version    semver.Version
+	versionErr error
+}
+
+func (s *fakeHealthStore) Ping() error {
+	return s.pingErr
+}
+
+func (s *fakeHealthStore) GetCurrentVersion() (semver.Version, error) {
+	return s.version, s.versionErr
+}
+
+type fakeRunningChecker bool
+
+func (c fakeRunningChecker) IsRunning() bool {
+	return bool(c)
+}
+
+func TestHealthServiceCheck(t *testing.T) {
+	latest := semver.MustParse("0.70.0")
+	metricsDisabled := func() (bool, bool) { return false, false }
+	metricsDown := func() (bool, bool) { return true, false }
+
+	tests := []struct {
+		name          string
+		store         *fakeHealthStore
+		scheduler     fakeRunningChecker
+		metricsServer func() (bool, bool)
+		expected      Health
+	}{
+		{
+			name:          "healthy",
+			store:         &fakeHealthStore{version: latest},
+			scheduler:     true,
+			metricsServer: func() (bool, bool) { return true, true },
+			expected: Health{
+				Status:        HealthStatusOK,
+				Database:      ComponentHealth{Status: HealthStatusOK},
+				Migrations:    MigrationsHealth{Status: HealthStatusOK, CurrentVersion: "0.70.0", LatestVersion: "0.70.0"},
+				Scheduler:     ComponentHealth{Status: HealthStatusOK},
+				MetricsServer: ComponentHealth{Status: HealthStatusOK},
+			},
+		},
+		{
+			name:          "database unreachable",
+			store:         &fakeHealthStore{pingErr: errors.New("connection refused")},
+			scheduler:     true,
+			metricsServer: metricsDisabled,
+			expected: Health{
+				Status:        HealthStatusUnhealthy,
+				Database:      ComponentHealth{Status: HealthStatusUnhealthy},
+				Migrations:    MigrationsHealth{Status: HealthStatusUnhealthy, LatestVersion: "0.70.0"},
+				Scheduler:     ComponentHealth{Status: HealthStatusOK},
+				MetricsServer: ComponentHealth{Status: HealthStatusDisabled},
+			},
+		},
+		{
+			name:          "pending migrations",
+			store:         &fakeHealthStore{version: semver.MustParse("0.69.0")},
+			scheduler:     true,
+			metricsServer: metricsDisabled,
+			expected: Health{
+				Status:        HealthStatusUnhealthy,
+				Database:      ComponentHealth{Status: HealthStatusOK},
+				Migrations:    MigrationsHealth{Status: HealthStatusUnhealthy, CurrentVersion: "0.69.0", LatestVersion: "0.70.0"},
+				Scheduler:     ComponentHealth{Status: HealthStatusOK},
+				MetricsServer: ComponentHealth{Status: HealthStatusDisabled},
+			},
+		},
+		{
+			name:          "scheduler stopped",
+			store:         &fakeHealthStore{version: latest},
+			scheduler:     false,
+			metricsServer: metricsDisabled,
+			expected: Health{
+				Status:        HealthStatusUnhealthy,
+				Database:      ComponentHealth{Status: HealthStatusOK},
+				Migrations:    MigrationsHealth{Status: HealthStatusOK, CurrentVersion: "0.70.0", LatestVersion: "0.70.0"},
+				Scheduler:     ComponentHealth{Status: HealthStatusUnhealthy},
+				MetricsServer: ComponentHealth{Status: HealthStatusDisabled},
+			},
+		},
+		{
+			name:          "metrics server down does not affect status",
+			store:         &fakeHealthStore{version: latest},
+			scheduler:     true,
+			metricsServer: metricsDown,
+			expected: Health{
+				Status:        HealthStatusOK,
+				Database:      ComponentHealth{Status: HealthStatusOK},
+				Migrations:    MigrationsHealth{Status: HealthStatusOK, CurrentVersion: "0.70.0", LatestVersion: "0.70.0"},
+				Scheduler:     ComponentHealth{Status: HealthStatusOK},
+				MetricsServer: ComponentHealth{Status: HealthStatusUnhealthy},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := NewHealthService(tt.store, latest, tt.scheduler, tt.metricsServer).Check()
+			require.Equal(t, tt.expected, health)
+
+			if tt.expected.Status == HealthStatusOK {
+				require.NoError(t, health.Err())
+			} else {
+				require.Error(t, health.Err())
+			}
+		})
+	}
+}
@@ -26,6 +26,10 @@ type LicenseChecker interface {
 	TimelineAllowed() bool
 	StatsAllowed() bool
 	ChecklistItemDueDateAllowed() bool
+	MetricsAllowed() bool
+	ChannelExportAllowed() bool
+	RequestUpdateAllowed() bool
+	MultipleChecklistsAllowed() bool
 }
 
 type PermissionsService struct {
@@ -67,6 +71,11 @@ func (p *PermissionsService) getPlaybookRole(userID string, playbook Playbook) [
 		}
 	}
 
+	// Members of the playbook's groups are granted the member role.
+	if p.isMemberOfAnyGroup(userID, playbook.MemberGroupIDs) {
+		return []string{PlaybookRoleMember}
+	}
+
 	// Public playbooks
 	if playbook.Public {
 		if playbook.DefaultPlaybookMemberRole == "" {
@@ -98,6 +107,29 @@ func (p *PermissionsService) HasPermissionsToRun(userID string, run *PlaybookRun
 	return p.api.HasPermissionToTeam(userID, run.TeamID, permission)
 }
 
+// isMemberOfAnyGroup returns true if the user currently belongs to one of the given groups.
+func (p *PermissionsService) isMemberOfAnyGroup(userID string, groupIDs []string) bool {
+	if len(groupIDs) == 0 {
+		return false
+	}
+
+	groups, err := p.api.GetGroupsByUserID(userID)
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Warn("failed to get the groups of the user")
+		return false
+	}
+
+	for _, group := range groups {
+		for _, groupID := range groupIDs {
+			if group.Id == groupID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (p *PermissionsService) canViewTeam(userID string, teamID string) bool {
 	if teamID == "" || userID == "" {
 		return false
@@ -144,6 +176,21 @@ func (p *PermissionsService) PlaybookCreate(userID string, playbook Playbook) er
 		}
 	}
 
+	// Only custom groups can be playbook members.
+	for _, groupID := range playbook.MemberGroupIDs {
+		group, err := p.api.GetGroup(groupID)
+		if err != nil {
+			return errors.Wrap(err, "invalid group")
+		}
+
+		if group.Source != model.GroupSourceCustom {
+			return errors.Errorf(
+				"group `%s` is not a custom group",
+				groupID,
+			)
+		}
+	}
+
 	// Check general permissions
 	permission := model.PermissionPrivatePlaybookCreate
 	if p.PlaybookIsPublic(playbook) {
@@ -184,6 +231,13 @@ func (p *PermissionsService) PlaybookModifyWithFixes(userID string, playbook *Pl
 		return err
 	}
 
+	for _, level := range playbook.SeverityLevels {
+		oldLevel, _ := findSeverityLevel(oldPlaybook.SeverityLevels, level.Name)
+		if err := p.NoAddedBroadcastChannelsWithoutPermission(userID, level.BroadcastChannelIDs, oldLevel.BroadcastChannelIDs); err != nil {
+			return err
+		}
+	}
+
 	filteredUsers := p.FilterInvitedUserIDs(playbook.InvitedUserIDs, playbook.TeamID)
 	playbook.InvitedUserIDs = filteredUsers
 
@@ -201,6 +255,15 @@ func (p *PermissionsService) PlaybookModifyWithFixes(userID string, playbook *Pl
 		}
 	}
 
+	playbook.MemberGroupIDs = p.FilterMemberGroupIDs(playbook.MemberGroupIDs)
+
+	// Changing the member groups requires the same permission as adding members.
+	if !sameIDs(oldPlaybook.MemberGroupIDs, playbook.MemberGroupIDs) {
+		if err := p.PlaybookManageMembers(userID, oldPlaybook); err != nil {
+			return errors.Wrap(err, "attempted to modify member groups without permissions")
+		}
+	}
+
 	// Check if we have changed members, if so check that permission.
 	if !reflect.DeepEqual(oldPlaybook.Members, playbook.Members) {
 		if err := p.PlaybookManageMembers(userID, oldPlaybook); err != nil {
@@ -281,6 +344,27 @@ func (p *PermissionsService) FilterInvitedGroupIDs(invitedGroupIDs []string) []s
 	return filteredGroups
 }
 
+// FilterMemberGroupIDs returns the given groups that can be playbook members, that is the
+// existing custom groups.
+func (p *PermissionsService) FilterMemberGroupIDs(memberGroupIDs []string) []string {
+	filteredGroups := []string{}
+	for _, groupID := range memberGroupIDs {
+		group, err := p.api.GetGroup(groupID)
+		if err != nil {
+			logrus.WithField("group_id", groupID).Error("failed to query group")
+			continue
+		}
+
+		if group.Source != model.GroupSourceCustom {
+			logrus.WithField("group_id", groupID).Warn("group is not a custom group, removing from playbook members")
+			continue
+		}
+
+		filteredGroups = append(filteredGroups, groupID)
+	}
+	return filteredGroups
+}
+
 func (p *PermissionsService) DeletePlaybook(userID string, playbook Playbook) error {
 	return p.PlaybookManageProperties(userID, playbook)
 }
@@ -419,7 +503,7 @@ func (p *PermissionsService) runManagePropertiesWithPlaybookRun(userID string, r
 		}
 	}
 
-	if IsSystemAdmin(userID, p.api) {
+	if IsPlaybooksAdmin(userID, p.api) {
 		return nil
 	}
 
@@ -449,7 +533,7 @@ func (p *PermissionsService) RunView(userID, runID string) error {
 }
 
 func (p *PermissionsService) ChannelActionCreate(userID, channelID string) error {
-	if IsSystemAdmin(userID, p.api) || CanManageChannelProperties(userID, channelID, p.api) {
+	if IsPlaybooksAdmin(userID, p.api) || CanManageChannelProperties(userID, channelID, p.api) {
 		return nil
 	}
 
@@ -465,7 +549,7 @@ func (p *PermissionsService) ChannelActionView(userID, channelID string) error {
 }
 
 func (p *PermissionsService) ChannelActionUpdate(userID, channelID string) error {
-	if IsSystemAdmin(userID, p.api) || CanManageChannelProperties(userID, channelID, p.api) {
+	if IsPlaybooksAdmin(userID, p.api) || CanManageChannelProperties(userID, channelID, p.api) {
 		return nil
 	}
 
@@ -477,6 +561,12 @@ func IsSystemAdmin(userID string, api playbooks.ServicesAPI) bool {
 	return api.HasPermissionTo(userID, model.PermissionManageSystem)
 }
 
+// IsPlaybooksAdmin returns true if the userID administers Playbooks, either as a system admin or
+// through a custom admin role granting the manage_playbooks permission
+func IsPlaybooksAdmin(userID string, api playbooks.ServicesAPI) bool {
+	return IsSystemAdmin(userID, api) || api.HasPermissionTo(userID, model.PermissionManagePlaybooks)
+}
+
 // CanManageChannelProperties returns true if the userID is allowed to manage the properties of channelID
 func CanManageChannelProperties(userID, channelID string, api playbooks.ServicesAPI) bool {
 	channel, err := api.GetChannelByID(channelID)
@@ -525,7 +615,7 @@ func IsGuest(userID string, api playbooks.ServicesAPI) (bool, error) {
 }
 
 func GetRequesterInfo(userID string, api playbooks.ServicesAPI) (RequesterInfo, error) {
-	isAdmin := IsSystemAdmin(userID, api)
+	isAdmin := IsPlaybooksAdmin(userID, api)
 
 	isGuest, err := IsGuest(userID, api)
 	if err != nil {
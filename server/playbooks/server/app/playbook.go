@@ -38,12 +38,14 @@ type Playbook struct {
 	LastRunAt                               int64                  `json:"last_run_at" export:"-"`
 	Checklists                              []Checklist            `json:"checklists" export:"-"`
 	Members                                 []PlaybookMember       `json:"members" export:"-"`
+	MemberGroupIDs                          []string               `json:"member_group_ids" export:"-"`
 	ReminderMessageTemplate                 string                 `json:"reminder_message_template" export:"reminder_message_template"`
 	ReminderTimerDefaultSeconds             int64                  `json:"reminder_timer_default_seconds" export:"reminder_timer_default_seconds"`
 	StatusUpdateEnabled                     bool                   `json:"status_update_enabled" export:"status_update_enabled"`
 	InvitedUserIDs                          []string               `json:"invited_user_ids" export:"-"`
 	InvitedGroupIDs                         []string               `json:"invited_group_ids" export:"-"`
 	InviteUsersEnabled                      bool                   `json:"invite_users_enabled" export:"-"`
+	SyncInvitedGroupsEnabled                bool                   `json:"sync_invited_groups_enabled" export:"-"`
 	DefaultOwnerID                          string                 `json:"default_owner_id" export:"-"`
 	DefaultOwnerEnabled                     bool                   `json:"default_owner_enabled" export:"-"`
 	BroadcastChannelIDs                     []string               `json:"broadcast_channel_ids" export:"-"`
@@ -67,6 +69,7 @@ type Playbook struct {
 	DefaultRunAdminRole                     string                 `json:"default_run_admin_role" export:"-"`
 	DefaultRunMemberRole                    string                 `json:"default_run_member_role" export:"-"`
 	Metrics                                 []PlaybookMetricConfig `json:"metrics" export:"metrics"`
+	PropertyFields                          []PropertyField        `json:"property_fields" export:"property_fields"`
 	ActiveRuns                              int64                  `json:"active_runs" export:"-"`
 	CreateChannelMemberOnNewParticipant     bool                   `json:"create_channel_member_on_new_participant" export:"create_channel_member_on_new_participant"`
 	RemoveChannelMemberOnRemovedParticipant bool                   `json:"remove_channel_member_on_removed_participant" export:"create_channel_member_on_removed_participant"`
@@ -78,6 +81,19 @@ type Playbook struct {
 	// ChannelMode is the playbook>run>channel flow used
 	ChannelMode ChannelPlaybookMode `json:"channel_mode" export:"channel_mode"`
 
+	// BoardSync configures the Boards cards created and kept in sync for the runs of this playbook.
+	BoardSync BoardSync `json:"board_sync" export:"-"`
+
+	// SeverityLevels, if not empty, replaces the default SEV1 to SEV4 levels available to the runs
+	// of this playbook, with the defaults applied to the runs created at each level.
+	SeverityLevels []SeverityLevel `json:"severity_levels" export:"severity_levels"`
+
+	// FollowUpReminderEnabled, if true, schedules a follow-up reminder for the owner of every run
+	// of this playbook, FollowUpReminderDelaySeconds after the run finishes.
+	FollowUpReminderEnabled      bool   `json:"follow_up_reminder_enabled" export:"follow_up_reminder_enabled"`
+	FollowUpReminderDelaySeconds int64  `json:"follow_up_reminder_delay_seconds" export:"follow_up_reminder_delay_seconds"`
+	FollowUpReminderMessage      string `json:"follow_up_reminder_message" export:"follow_up_reminder_message"`
+
 	// Deprecated: preserved for backwards compatibility with v1.27
 	BroadcastEnabled             bool `json:"broadcast_enabled" export:"-"`
 	WebhookOnStatusUpdateEnabled bool `json:"webhook_on_status_update_enabled" export:"-"`
@@ -130,11 +146,24 @@ func (p Playbook) Clone() Playbook {
 	}
 	newPlaybook.Checklists = newChecklists
 	newPlaybook.Metrics = append([]PlaybookMetricConfig(nil), p.Metrics...)
+	var newPropertyFields []PropertyField
+	for _, f := range p.PropertyFields {
+		newPropertyFields = append(newPropertyFields, f.Clone())
+	}
+	newPlaybook.PropertyFields = newPropertyFields
+	var newSeverityLevels []SeverityLevel
+	for _, l := range p.SeverityLevels {
+		newSeverityLevels = append(newSeverityLevels, l.Clone())
+	}
+	newPlaybook.SeverityLevels = newSeverityLevels
 	var newMembers []PlaybookMember
 	for _, m := range p.Members {
 		newMembers = append(newMembers, m.Clone())
 	}
 	newPlaybook.Members = newMembers
+	if len(p.MemberGroupIDs) != 0 {
+		newPlaybook.MemberGroupIDs = append([]string(nil), p.MemberGroupIDs...)
+	}
 	if len(p.InvitedUserIDs) != 0 {
 		newPlaybook.InvitedUserIDs = append([]string(nil), p.InvitedUserIDs...)
 	}
@@ -153,6 +182,12 @@ func (p Playbook) Clone() Playbook {
 	if len(p.WebhookOnStatusUpdateURLs) != 0 {
 		newPlaybook.WebhookOnStatusUpdateURLs = append([]string(nil), p.WebhookOnStatusUpdateURLs...)
 	}
+	if len(p.BoardSync.StatusOptions) != 0 {
+		newPlaybook.BoardSync.StatusOptions = make(map[string]string, len(p.BoardSync.StatusOptions))
+		for status, option := range p.BoardSync.StatusOptions {
+			newPlaybook.BoardSync.StatusOptions[status] = option
+		}
+	}
 	return newPlaybook
 }
 
@@ -172,9 +207,28 @@ func (p Playbook) MarshalJSON() ([]byte, error) {
 	if old.Members == nil {
 		old.Members = []PlaybookMember{}
 	}
+	if old.MemberGroupIDs == nil {
+		old.MemberGroupIDs = []string{}
+	}
 	if old.Metrics == nil {
 		old.Metrics = []PlaybookMetricConfig{}
 	}
+	if old.PropertyFields == nil {
+		old.PropertyFields = []PropertyField{}
+	}
+	for j, f := range old.PropertyFields {
+		if f.Options == nil {
+			old.PropertyFields[j].Options = []PropertyOption{}
+		}
+	}
+	if old.SeverityLevels == nil {
+		old.SeverityLevels = []SeverityLevel{}
+	}
+	for j, l := range old.SeverityLevels {
+		if l.BroadcastChannelIDs == nil {
+			old.SeverityLevels[j].BroadcastChannelIDs = []string{}
+		}
+	}
 	if old.InvitedUserIDs == nil {
 		old.InvitedUserIDs = []string{}
 	}
@@ -289,6 +343,10 @@ type ChecklistItem struct {
 
 	// TaskActions is an array of all the task actions associated with this task.
 	TaskActions []TaskAction `json:"task_actions" export:"-"`
+
+	// BoardCardID is the identifier of the Boards card mirroring this item, if the run's playbook
+	// syncs its checklist items to a board.
+	BoardCardID string `json:"board_card_id,omitempty" export:"-"`
 }
 
 func (ci *ChecklistItem) GetAssigneeID() string {
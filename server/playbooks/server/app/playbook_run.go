@@ -128,6 +128,14 @@ type PlaybookRun struct {
 	// were automatically invited to the playbook run when it was created.
 	InvitedGroupIDs []string `json:"invited_group_ids"`
 
+	// SyncInvitedGroups, if true, keeps the participants of the playbook run in sync with the
+	// members of the invited groups while the run is in progress.
+	SyncInvitedGroups bool `json:"sync_invited_groups"`
+
+	// SyncedGroupMemberIDs are the members of the invited groups as of the last sync. Users who
+	// join the groups afterwards are added as participants, and users who leave them are removed.
+	SyncedGroupMemberIDs []string `json:"-"`
+
 	// TimelineEvents is an array of the events saved to the timeline of the playbook run.
 	TimelineEvents []TimelineEvent `json:"timeline_events"`
 
@@ -187,6 +195,9 @@ type PlaybookRun struct {
 	// Playbook run metric values
 	MetricsData []RunMetricData `json:"metrics_data"`
 
+	// PropertyValues holds the values set for the property fields of the run's playbook.
+	PropertyValues []PropertyValue `json:"property_values"`
+
 	// CreateChannelMemberOnNewParticipant is the Run action flag that defines if a new channel member will be added
 	// to the run's channel when a new participant is added to the run (by themselve or by other members).
 	CreateChannelMemberOnNewParticipant bool `json:"create_channel_member_on_new_participant" export:"create_channel_member_on_new_participant"`
@@ -198,6 +209,25 @@ type PlaybookRun struct {
 	// Type determines a type of a run.
 	// It can be RunTypePlaybook ("playbook") or RunTypeChannelChecklist ("channel")
 	Type string `json:"type"`
+
+	// BoardID is the identifier of the board where the cards mirroring this run live, if any.
+	BoardID string `json:"board_id"`
+
+	// BoardCardID is the identifier of the Boards card mirroring this run, if the run's playbook
+	// syncs whole runs to a board.
+	BoardCardID string `json:"board_card_id"`
+
+	// Severity, if not empty, is the name of the severity level the run was declared with: one of
+	// the levels of its playbook, or one of DefaultSeverityLevels.
+	Severity string `json:"severity"`
+
+	// FollowUpReminderDelaySeconds is the delay, in seconds, after the run finishes before its
+	// owner is reminded to follow up on it. If 0, no follow-up reminder is sent.
+	FollowUpReminderDelaySeconds int64 `json:"follow_up_reminder_delay_seconds"`
+
+	// FollowUpReminderMessage, if not empty, is included in the follow-up reminder, e.g. to
+	// describe what to verify.
+	FollowUpReminderMessage string `json:"follow_up_reminder_message"`
 }
 
 func (r *PlaybookRun) Clone() *PlaybookRun {
@@ -212,10 +242,12 @@ func (r *PlaybookRun) Clone() *PlaybookRun {
 	newPlaybookRun.TimelineEvents = append([]TimelineEvent(nil), r.TimelineEvents...)
 	newPlaybookRun.InvitedUserIDs = append([]string(nil), r.InvitedUserIDs...)
 	newPlaybookRun.InvitedGroupIDs = append([]string(nil), r.InvitedGroupIDs...)
+	newPlaybookRun.SyncedGroupMemberIDs = append([]string(nil), r.SyncedGroupMemberIDs...)
 	newPlaybookRun.ParticipantIDs = append([]string(nil), r.ParticipantIDs...)
 	newPlaybookRun.WebhookOnCreationURLs = append([]string(nil), r.WebhookOnCreationURLs...)
 	newPlaybookRun.WebhookOnStatusUpdateURLs = append([]string(nil), r.WebhookOnStatusUpdateURLs...)
 	newPlaybookRun.MetricsData = append([]RunMetricData(nil), r.MetricsData...)
+	newPlaybookRun.PropertyValues = append([]PropertyValue(nil), r.PropertyValues...)
 
 	return &newPlaybookRun
 }
@@ -260,6 +292,9 @@ func (r PlaybookRun) MarshalJSON() ([]byte, error) {
 	if old.MetricsData == nil {
 		old.MetricsData = []RunMetricData{}
 	}
+	if old.PropertyValues == nil {
+		old.PropertyValues = []PropertyValue{}
+	}
 
 	return json.Marshal(old)
 }
@@ -298,6 +333,7 @@ func (r *PlaybookRun) SetConfigurationFromPlaybook(playbook Playbook, source str
 	if playbook.InviteUsersEnabled {
 		r.InvitedUserIDs = playbook.InvitedUserIDs
 		r.InvitedGroupIDs = playbook.InvitedGroupIDs
+		r.SyncInvitedGroups = playbook.SyncInvitedGroupsEnabled && len(playbook.InvitedGroupIDs) > 0
 	}
 
 	if playbook.DefaultOwnerEnabled {
@@ -323,10 +359,27 @@ func (r *PlaybookRun) SetConfigurationFromPlaybook(playbook Playbook, source str
 		r.Retrospective = playbook.RetrospectiveTemplate
 	}
 
+	if playbook.FollowUpReminderEnabled {
+		r.FollowUpReminderDelaySeconds = playbook.FollowUpReminderDelaySeconds
+		r.FollowUpReminderMessage = playbook.FollowUpReminderMessage
+	}
+
 	r.CreateChannelMemberOnNewParticipant = playbook.CreateChannelMemberOnNewParticipant
 	r.RemoveChannelMemberOnRemovedParticipant = playbook.RemoveChannelMemberOnRemovedParticipant
 
 	r.Type = RunTypePlaybook
+
+	// The defaults of the run's severity level take precedence over the playbook's own
+	if level, ok := playbook.GetSeverityLevel(r.Severity); ok {
+		if level.ReminderTimerDefaultSeconds > 0 {
+			r.PreviousReminder = time.Duration(level.ReminderTimerDefaultSeconds) * time.Second
+			r.ReminderTimerDefaultSeconds = level.ReminderTimerDefaultSeconds
+		}
+		if len(level.BroadcastChannelIDs) > 0 {
+			r.StatusUpdateBroadcastChannelsEnabled = true
+			r.BroadcastChannelIDs = level.BroadcastChannelIDs
+		}
+	}
 }
 
 type StatusPost struct {
@@ -396,6 +449,13 @@ type Metadata struct {
 	NumParticipants    int64    `json:"num_participants"`
 	TotalPosts         int64    `json:"total_posts"`
 	Followers          []string `json:"followers"`
+
+	// LinkedRuns summarizes the runs linked to the run.
+	LinkedRuns []LinkedRun `json:"linked_runs"`
+
+	// ChildTasks and ChildTasksClosed roll up the checklist progress of the run's children.
+	ChildTasks       int64 `json:"child_tasks"`
+	ChildTasksClosed int64 `json:"child_tasks_closed"`
 }
 
 type timelineEventType string
@@ -418,6 +478,9 @@ const (
 	StatusUpdateSnoozed    timelineEventType = "status_update_snoozed"
 	StatusUpdatesEnabled   timelineEventType = "status_updates_enabled"
 	StatusUpdatesDisabled  timelineEventType = "status_updates_disabled"
+	CallStarted            timelineEventType = "call_started"
+	CallEnded              timelineEventType = "call_ended"
+	SeverityChanged        timelineEventType = "severity_changed"
 )
 
 type TimelineEvent struct {
@@ -440,7 +503,8 @@ type TimelineEvent struct {
 
 	// EventType is the type of this event. It can be "incident_created", "task_state_modified",
 	// "status_updated", "owner_changed", "assignee_changed", "ran_slash_command",
-	// "event_from_post", "user_joined_left", "published_retrospective", "canceled_retrospective" or "status_update_snoozed".
+	// "event_from_post", "user_joined_left", "published_retrospective", "canceled_retrospective",
+	// "status_update_snoozed", "call_started", "call_ended" or "severity_changed".
 	EventType timelineEventType `json:"event_type"`
 
 	// Summary is a short description of the event.
@@ -720,6 +784,9 @@ type PlaybookRunService interface {
 	// NukeDB removes all playbook run related data.
 	NukeDB() error
 
+	// NukeTeamData removes all the playbooks, runs and categories of the given team.
+	NukeTeamData(teamID string) error
+
 	// SetReminder sets a reminder. After time.Now().Add(fromNow) in the future,
 	// the owner will be reminded to update the playbook run's status.
 	SetReminder(playbookRunID string, fromNow time.Duration) error
@@ -780,6 +847,39 @@ type PlaybookRunService interface {
 	// RestorePlaybookRun reverts a run from the Finished state. If run was not in Finished state, the call is a noop.
 	RestorePlaybookRun(playbookRunID, userID string) error
 
+	// SyncFromBoard applies the changes made from Boards to the cards mirroring the given run.
+	SyncFromBoard(playbookRunID string) error
+
+	// SyncInvitedGroups adds the new members of the run's invited groups as participants, and
+	// removes the participants who were synced from the groups but are no longer members.
+	SyncInvitedGroups(playbookRunID string) error
+
+	// SetPropertyValue sets the value of a property field of the run's playbook.
+	SetPropertyValue(playbookRunID, userID string, value PropertyValue) error
+
+	// ChangeSeverity changes the severity of the run, on behalf of userID.
+	ChangeSeverity(playbookRunID, userID, severity string) error
+
+	// SetFollowUpReminder sets the delay and message of the reminder sent to the run's owner after
+	// the run finishes, rescheduling the pending reminder if the run is already finished. A delay
+	// of 0 disables the reminder.
+	SetFollowUpReminder(playbookRunID string, delaySeconds int64, message string) error
+
+	// GetRunLinks returns the links from the run to other runs.
+	GetRunLinks(playbookRunID string) ([]RunRelation, error)
+
+	// LinkRuns links the run to linkedRunID with the given type, relative to the run.
+	LinkRuns(playbookRunID, linkedRunID, linkType, userID string) error
+
+	// UnlinkRuns removes the link between the run and linkedRunID.
+	UnlinkRuns(playbookRunID, linkedRunID, userID string) error
+
+	// StartCall starts a call in the run's channel on behalf of userID, through the Calls plugin.
+	StartCall(playbookRunID, userID string) error
+
+	// GetCallRecordings returns the recordings of the calls held in the run's channel.
+	GetCallRecordings(playbookRunID string) ([]CallRecording, error)
+
 	// RequestUpdate posts a status update request message in the run's channel
 	RequestUpdate(playbookRunID, requesterID string) error
 
@@ -810,6 +910,9 @@ type PlaybookRunService interface {
 
 	// MessageHasBeenPosted checks posted messages for triggers that may trigger task actions
 	MessageHasBeenPosted(post *model.Post)
+
+	// MessageHasBeenUpdated checks updated messages for calls that ended
+	MessageHasBeenUpdated(newPost, oldPost *model.Post)
 }
 
 // PlaybookRunStore defines the methods the PlaybookRunServiceImpl needs from the interfaceStore.
@@ -861,6 +964,9 @@ type PlaybookRunStore interface {
 	// NukeDB removes all playbook run related data.
 	NukeDB() error
 
+	// NukeTeamData removes all the playbooks, runs and categories of the given team.
+	NukeTeamData(teamID string) error
+
 	// ChangeCreationDate changes the creation date of the specified playbook run.
 	ChangeCreationDate(playbookRunID string, creationTimestamp time.Time) error
 
@@ -893,6 +999,25 @@ type PlaybookRunStore interface {
 	// GetRunsActiveTotal returns number of active runs
 	GetRunsActiveTotal() (int64, error)
 
+	// GetBoardLinkedRunIDs returns the IDs of the in-progress runs that are mirrored in a board.
+	GetBoardLinkedRunIDs() ([]string, error)
+
+	// GetGroupSyncedRunIDs returns the IDs of the in-progress runs whose participants are kept in
+	// sync with their invited groups.
+	GetGroupSyncedRunIDs() ([]string, error)
+
+	// SetPropertyValue sets the value of a property field for a run, clearing it if the value is empty.
+	SetPropertyValue(playbookRunID string, value PropertyValue) error
+
+	// GetRunLinks returns the links from the run to other runs, typed relative to the run.
+	GetRunLinks(playbookRunID string) ([]RunRelation, error)
+
+	// CreateRunLink links the run to linkedRunID with the given type, relative to the run.
+	CreateRunLink(playbookRunID, linkedRunID, linkType string) error
+
+	// DeleteRunLink removes the link between the two runs, in whichever direction it was created.
+	DeleteRunLink(playbookRunID, linkedRunID string) error
+
 	// GetOverdueUpdateRunsTotal returns number of runs that have overdue status updates
 	GetOverdueUpdateRunsTotal() (int64, error)
 
@@ -1098,6 +1223,12 @@ type PlaybookRunFilterOptions struct {
 
 	// Types filters by all run types in the list (inclusive)
 	Types []string
+
+	// PropertyValues filters playbook runs that have all the given property values.
+	PropertyValues []PropertyValue
+
+	// Severities filters by all severities in the list (inclusive)
+	Severities []string
 }
 
 // Clone duplicates the given options.
@@ -1109,6 +1240,12 @@ func (o *PlaybookRunFilterOptions) Clone() PlaybookRunFilterOptions {
 	if len(o.Types) > 0 {
 		newPlaybookRunFilterOptions.Types = append([]string{}, o.Types...)
 	}
+	if len(o.PropertyValues) > 0 {
+		newPlaybookRunFilterOptions.PropertyValues = append([]PropertyValue{}, o.PropertyValues...)
+	}
+	if len(o.Severities) > 0 {
+		newPlaybookRunFilterOptions.Severities = append([]string{}, o.Severities...)
+	}
 
 	return newPlaybookRunFilterOptions
 }
@@ -1197,6 +1334,12 @@ func (o PlaybookRunFilterOptions) Validate() (PlaybookRunFilterOptions, error) {
 		}
 	}
 
+	for _, v := range options.PropertyValues {
+		if !model.IsValidId(v.FieldID) {
+			return PlaybookRunFilterOptions{}, errors.New("bad parameter in 'property': field ID must be 26 characters")
+		}
+	}
+
 	return options, nil
 }
 
@@ -50,6 +50,8 @@ type PlaybookRunServiceImpl struct {
 	permissions      *PermissionsService
 	licenseChecker   LicenseChecker
 	metricsService   *metrics.Metrics
+	boardSync        BoardSyncService
+	categoryService  CategoryService
 }
 
 var allNonSpaceNonWordRegex = regexp.MustCompile(`[^\w\s]`)
@@ -100,6 +102,8 @@ func NewPlaybookRunService(
 	channelActionService ChannelActionService,
 	licenseChecker LicenseChecker,
 	metricsService *metrics.Metrics,
+	boardSyncService BoardSyncService,
+	categoryService CategoryService,
 ) *PlaybookRunServiceImpl {
 	service := &PlaybookRunServiceImpl{
 		store:            store,
@@ -114,6 +118,8 @@ func NewPlaybookRunService(
 		actionService:    channelActionService,
 		licenseChecker:   licenseChecker,
 		metricsService:   metricsService,
+		boardSync:        boardSyncService,
+		categoryService:  categoryService,
 	}
 
 	service.permissions = NewPermissionsService(service.playbookService, service, api, service.configService, service.licenseChecker)
@@ -320,6 +326,8 @@ func (s *PlaybookRunServiceImpl) CreatePlaybookRun(playbookRun *PlaybookRun, pb
 
 	s.telemetry.CreatePlaybookRun(playbookRun, userID, public)
 	s.metricsService.IncrementRunsCreatedCount(1)
+	s.metricsService.IncrementRunsCreatedBySeverityCount(playbookRun.Severity, 1)
+	s.actionService.RunActivated(playbookRun.ChannelID, userID)
 
 	err = s.addPlaybookRunInitialMemberships(playbookRun, channel)
 	if err != nil {
@@ -328,38 +336,17 @@ func (s *PlaybookRunServiceImpl) CreatePlaybookRun(playbookRun *PlaybookRun, pb
 
 	invitedUserIDs := playbookRun.InvitedUserIDs
 
+	var groupMemberIDs []string
 	for _, groupID := range playbookRun.InvitedGroupIDs {
-		groupLogger := logger.WithField("group_id", groupID)
-
-		var group *model.Group
-		group, err = s.api.GetGroup(groupID)
+		var memberIDs []string
+		memberIDs, err = s.getGroupMemberIDs(groupID)
 		if err != nil {
-			groupLogger.WithError(err).Error("failed to query group")
-			continue
-		}
-
-		if !group.AllowReference {
-			groupLogger.Warn("group that does not allow references")
+			logger.WithError(err).WithField("group_id", groupID).Error("failed to query group")
 			continue
 		}
-
-		perPage := 1000
-		for page := 0; ; page++ {
-			var users []*model.User
-			users, err = s.api.GetGroupMemberUsers(groupID, page, perPage)
-			if err != nil {
-				groupLogger.WithError(err).Error("failed to query group")
-				break
-			}
-			for _, user := range users {
-				invitedUserIDs = append(invitedUserIDs, user.Id)
-			}
-
-			if len(users) < perPage {
-				break
-			}
-		}
+		groupMemberIDs = append(groupMemberIDs, memberIDs...)
 	}
+	invitedUserIDs = append(invitedUserIDs, groupMemberIDs...)
 
 	err = s.AddParticipants(playbookRun.ID, invitedUserIDs, s.configService.GetConfiguration().BotUserID, false)
 	if err != nil {
@@ -369,6 +356,12 @@ func (s *PlaybookRunServiceImpl) CreatePlaybookRun(playbookRun *PlaybookRun, pb
 		}).Warn("failed to add invited users on playbook run creation")
 	}
 
+	if playbookRun.SyncInvitedGroups {
+		if err = s.setSyncedGroupMemberIDs(playbookRun.ID, groupMemberIDs); err != nil {
+			logger.WithError(err).Warn("failed to record the members of the invited groups")
+		}
+	}
+
 	if len(invitedUserIDs) > 0 {
 		s.genericTelemetry.Track(
 			telemetryRunParticipate,
@@ -450,6 +443,8 @@ func (s *PlaybookRunServiceImpl) CreatePlaybookRun(playbookRun *PlaybookRun, pb
 		s.sendWebhooksOnCreation(*playbookRun)
 	}
 
+	s.syncRunToBoard(playbookRun.ID, userID)
+
 	if playbookRun.PostID == "" {
 		return playbookRun, nil
 	}
@@ -1017,6 +1012,12 @@ func (s *PlaybookRunServiceImpl) FinishPlaybookRun(playbookRunID, userID string)
 		}
 	}
 
+	playbookRunToModify.CurrentStatus = StatusFinished
+	playbookRunToModify.EndAt = endAt
+	if err = s.scheduleFollowUpReminder(playbookRunToModify); err != nil {
+		logger.WithError(err).Error("failed to schedule the follow-up reminder")
+	}
+
 	event := &TimelineEvent{
 		PlaybookRunID: playbookRunID,
 		CreateAt:      endAt,
@@ -1030,8 +1031,15 @@ func (s *PlaybookRunServiceImpl) FinishPlaybookRun(playbookRunID, userID string)
 		return errors.Wrap(err, "failed to create timeline event")
 	}
 
+	// Restore the channel header once no other run is active in the channel
+	if _, err = s.store.GetPlaybookRunIDsForChannel(playbookRunToModify.ChannelID); errors.Is(err, ErrNotFound) {
+		s.actionService.RunsDeactivated(playbookRunToModify.ChannelID, userID)
+	}
+
 	s.telemetry.FinishPlaybookRun(playbookRunToModify, userID)
 	s.metricsService.IncrementRunsFinishedCount(1)
+	s.metricsService.IncrementRunsFinishedBySeverityCount(playbookRunToModify.Severity, 1)
+	s.syncRunToBoard(playbookRunID, userID)
 	s.sendPlaybookRunUpdatedWS(playbookRunID)
 
 	if playbookRunToModify.StatusUpdateBroadcastWebhooksEnabled {
@@ -1159,6 +1167,9 @@ func (s *PlaybookRunServiceImpl) RestorePlaybookRun(playbookRunID, userID string
 		return err
 	}
 
+	// There is nothing to follow up on anymore
+	s.scheduler.Cancel(FollowUpPrefix + playbookRunID)
+
 	user, err := s.api.GetUserByID(userID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to to resolve user %s", userID)
@@ -1191,7 +1202,9 @@ func (s *PlaybookRunServiceImpl) RestorePlaybookRun(playbookRunID, userID string
 		return errors.Wrap(err, "failed to create timeline event")
 	}
 
+	s.actionService.RunActivated(playbookRunToRestore.ChannelID, userID)
 	s.telemetry.RestorePlaybookRun(playbookRunToRestore, userID)
+	s.syncRunToBoard(playbookRunID, userID)
 	s.sendPlaybookRunUpdatedWS(playbookRunID)
 
 	if playbookRunToRestore.StatusUpdateBroadcastWebhooksEnabled {
@@ -1284,14 +1297,53 @@ func (s *PlaybookRunServiceImpl) GetPlaybookRunMetadata(playbookRunID string) (*
 		return nil, errors.Wrapf(err, "failed to get followers of playbook run %s", playbookRunID)
 	}
 
-	return &Metadata{
+	metadata := &Metadata{
 		ChannelName:        channel.Name,
 		ChannelDisplayName: channel.DisplayName,
 		TeamName:           team.Name,
 		TotalPosts:         channel.TotalMsgCount,
 		NumParticipants:    numParticipants,
 		Followers:          followers,
-	}, nil
+	}
+
+	if err := s.addLinkedRunsToMetadata(playbookRunID, metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// addLinkedRunsToMetadata summarizes the runs linked to the given run in its metadata, rolling up
+// the checklist progress of its children.
+func (s *PlaybookRunServiceImpl) addLinkedRunsToMetadata(playbookRunID string, metadata *Metadata) error {
+	links, err := s.store.GetRunLinks(playbookRunID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get links of playbook run %s", playbookRunID)
+	}
+
+	metadata.LinkedRuns = make([]LinkedRun, 0, len(links))
+	for _, link := range links {
+		linkedRun, err := s.store.GetPlaybookRun(link.RunID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get linked playbook run %s", link.RunID)
+		}
+
+		numTasks, numTasksClosed := linkedRun.TaskProgress()
+		metadata.LinkedRuns = append(metadata.LinkedRuns, LinkedRun{
+			RunRelation:    link,
+			Name:           linkedRun.Name,
+			CurrentStatus:  linkedRun.CurrentStatus,
+			NumTasks:       numTasks,
+			NumTasksClosed: numTasksClosed,
+		})
+
+		if link.Type == RunLinkTypeChild {
+			metadata.ChildTasks += numTasks
+			metadata.ChildTasksClosed += numTasksClosed
+		}
+	}
+
+	return nil
 }
 
 // GetPlaybookRunsForChannelByUser get the playbookRuns list associated with this channel and user.
@@ -1325,8 +1377,8 @@ func (s *PlaybookRunServiceImpl) GetOwners(requesterInfo RequesterInfo, options
 		return nil, errors.Wrap(err, "can't get owners from the store")
 	}
 
-	// System admin can see fullname no matter the settings
-	if IsSystemAdmin(requesterInfo.UserID, s.api) {
+	// Playbooks admins can see fullname no matter the settings
+	if IsPlaybooksAdmin(requesterInfo.UserID, s.api) {
 		return owners, nil
 	}
 	// If ShowFullName is true return owners info unedited
@@ -1397,6 +1449,8 @@ func (s *PlaybookRunServiceImpl) ChangeOwner(playbookRunID, userID, ownerID stri
 		if err = s.poster.DM(ownerID, &model.Post{Message: msg}); err != nil {
 			return errors.Wrapf(err, "failed to send DM in ChangeOwner")
 		}
+
+		s.addAssignmentActivity(playbookRunToModify, userID, ownerID, fmt.Sprintf("made you the owner of run %s", playbookRunToModify.Name))
 	}
 
 	eventTime := model.GetMillis()
@@ -1489,6 +1543,7 @@ func (s *PlaybookRunServiceImpl) ModifyCheckedState(playbookRunID, userID, newSt
 	if _, err = s.store.CreateTimelineEvent(event); err != nil {
 		return errors.Wrap(err, "failed to create timeline event")
 	}
+	s.syncChecklistItemToBoard(playbookRunID, userID, checklistNumber, itemNumber)
 	s.sendPlaybookRunUpdatedWS(playbookRunID)
 
 	return nil
@@ -1592,6 +1647,9 @@ func (s *PlaybookRunServiceImpl) SetAssignee(playbookRunID, userID, assigneeID s
 		if err = s.poster.DM(itemToCheck.AssigneeID, &model.Post{Message: modifyMessage}); err != nil {
 			return errors.Wrapf(err, "failed to send DM in SetAssignee")
 		}
+
+		s.addAssignmentActivity(playbookRunToModify, userID, itemToCheck.AssigneeID,
+			fmt.Sprintf("assigned you the task %s for run %s", stripmd.Strip(itemToCheck.Title), playbookRunToModify.Name))
 	}
 
 	s.telemetry.SetAssignee(playbookRunID, userID, itemToCheck)
@@ -1616,6 +1674,26 @@ func (s *PlaybookRunServiceImpl) SetAssignee(playbookRunID, userID, assigneeID s
 	return nil
 }
 
+// addAssignmentActivity adds an assignment to the activity feed of the assignee. The assignment is
+// already made at this point, so failing to add it to the feed is only logged.
+func (s *PlaybookRunServiceImpl) addAssignmentActivity(playbookRun *PlaybookRun, userID, assigneeID, message string) {
+	activity := &model.Activity{
+		UserId:    assigneeID,
+		Type:      model.ActivityTypePlaybookAssignment,
+		ActorId:   userID,
+		TeamId:    playbookRun.TeamID,
+		ChannelId: playbookRun.ChannelID,
+		Props: model.StringMap{
+			model.ActivityPropPlaybookRunId: playbookRun.ID,
+			model.ActivityPropMessage:       message,
+		},
+	}
+
+	if err := s.api.AddActivities([]*model.Activity{activity}); err != nil {
+		logrus.WithError(err).WithField("playbook_run_id", playbookRun.ID).Warn("failed to add assignment to the activity feed")
+	}
+}
+
 // SetCommandToChecklistItem sets command to checklist item
 func (s *PlaybookRunServiceImpl) SetCommandToChecklistItem(playbookRunID, userID string, checklistNumber, itemNumber int, newCommand string) error {
 	playbookRunToModify, err := s.checklistItemParamsVerify(playbookRunID, userID, checklistNumber, itemNumber)
@@ -2347,11 +2425,551 @@ func (s *PlaybookRunServiceImpl) checklistItemParamsVerify(playbookRunID, userID
 	return playbookRunToModify, nil
 }
 
+// SyncFromBoard applies the changes made from Boards to the cards mirroring the given run.
+func (s *PlaybookRunServiceImpl) SyncFromBoard(playbookRunID string) error {
+	playbookRun, err := s.store.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve playbook run")
+	}
+
+	changes, err := s.boardSync.GetBoardChanges(playbookRun)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the changes made from the board")
+	}
+
+	for _, change := range changes.ItemStates {
+		userID := change.UserID
+		if userID == "" {
+			userID = playbookRun.OwnerUserID
+		}
+		if err = s.ModifyCheckedState(playbookRunID, userID, change.State, change.ChecklistNumber, change.ItemNumber); err != nil {
+			return errors.Wrapf(err, "failed to modify the state of checklist item %d/%d", change.ChecklistNumber, change.ItemNumber)
+		}
+	}
+
+	userID := changes.RunStatusUserID
+	if userID == "" {
+		userID = playbookRun.OwnerUserID
+	}
+
+	switch changes.RunStatus {
+	case StatusFinished:
+		return s.FinishPlaybookRun(playbookRunID, userID)
+	case StatusInProgress:
+		return s.RestorePlaybookRun(playbookRunID, userID)
+	}
+
+	return nil
+}
+
+// SyncInvitedGroups adds the new members of the run's invited groups as participants, and
+// removes the participants who were synced from the groups but are no longer members. The run is
+// left untouched if any of the groups cannot be resolved, so that an unavailable group never
+// removes its members from the run.
+func (s *PlaybookRunServiceImpl) SyncInvitedGroups(playbookRunID string) error {
+	playbookRun, err := s.store.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve playbook run")
+	}
+
+	if !playbookRun.SyncInvitedGroups || playbookRun.CurrentStatus != StatusInProgress {
+		return nil
+	}
+
+	var memberIDs []string
+	for _, groupID := range playbookRun.InvitedGroupIDs {
+		groupMemberIDs, err := s.getGroupMemberIDs(groupID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the members of group '%s'", groupID)
+		}
+		memberIDs = append(memberIDs, groupMemberIDs...)
+	}
+
+	if sameIDs(playbookRun.SyncedGroupMemberIDs, memberIDs) {
+		return nil
+	}
+
+	toAdd, toRemove := groupSyncChanges(playbookRun, memberIDs)
+
+	botID := s.configService.GetConfiguration().BotUserID
+	if len(toAdd) > 0 {
+		if err := s.AddParticipants(playbookRunID, toAdd, botID, false); err != nil {
+			return errors.Wrap(err, "failed to add the new group members as participants")
+		}
+	}
+	if len(toRemove) > 0 {
+		if err := s.RemoveParticipants(playbookRunID, toRemove, botID); err != nil {
+			return errors.Wrap(err, "failed to remove the former group members from the participants")
+		}
+	}
+
+	return s.setSyncedGroupMemberIDs(playbookRunID, memberIDs)
+}
+
+// getGroupMemberIDs returns the IDs of the members of the given group, which must allow being
+// referenced.
+func (s *PlaybookRunServiceImpl) getGroupMemberIDs(groupID string) ([]string, error) {
+	group, err := s.api.GetGroup(groupID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get group '%s'", groupID)
+	}
+
+	if !group.AllowReference {
+		return nil, errors.Errorf("group '%s' does not allow references", groupID)
+	}
+
+	var memberIDs []string
+	perPage := 1000
+	for page := 0; ; page++ {
+		users, err := s.api.GetGroupMemberUsers(groupID, page, perPage)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the members of group '%s'", groupID)
+		}
+		for _, user := range users {
+			memberIDs = append(memberIDs, user.Id)
+		}
+
+		if len(users) < perPage {
+			break
+		}
+	}
+
+	return memberIDs, nil
+}
+
+// setSyncedGroupMemberIDs records the members of the run's invited groups, as of now.
+func (s *PlaybookRunServiceImpl) setSyncedGroupMemberIDs(playbookRunID string, memberIDs []string) error {
+	playbookRun, err := s.store.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve playbook run")
+	}
+
+	seen := make(map[string]bool, len(memberIDs))
+	playbookRun.SyncedGroupMemberIDs = []string{}
+	for _, userID := range memberIDs {
+		if !seen[userID] {
+			seen[userID] = true
+			playbookRun.SyncedGroupMemberIDs = append(playbookRun.SyncedGroupMemberIDs, userID)
+		}
+	}
+
+	if _, err = s.store.UpdatePlaybookRun(playbookRun); err != nil {
+		return errors.Wrap(err, "failed to update playbook run")
+	}
+
+	return nil
+}
+
+// syncRunToBoard mirrors the run in the board configured by its playbook, if any. Failures are
+// only logged, since Boards being unavailable must not prevent the run from being updated.
+func (s *PlaybookRunServiceImpl) syncRunToBoard(playbookRunID, userID string) {
+	if err := s.boardSync.SyncRunToBoard(playbookRunID, userID); err != nil {
+		logrus.WithError(err).WithField("playbook_run_id", playbookRunID).Warn("failed to sync playbook run to board")
+	}
+}
+
+// syncChecklistItemToBoard mirrors the checklist item in the board configured by the run's
+// playbook, if any. Failures are only logged.
+func (s *PlaybookRunServiceImpl) syncChecklistItemToBoard(playbookRunID, userID string, checklistNumber, itemNumber int) {
+	if err := s.boardSync.SyncChecklistItemToBoard(playbookRunID, userID, checklistNumber, itemNumber); err != nil {
+		logrus.WithError(err).WithField("playbook_run_id", playbookRunID).Warn("failed to sync checklist item to board")
+	}
+}
+
+// SetPropertyValue sets the value of a property field of the run's playbook.
+func (s *PlaybookRunServiceImpl) SetPropertyValue(playbookRunID, userID string, value PropertyValue) error {
+	playbookRun, err := s.store.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to retrieve playbook run")
+	}
+
+	if playbookRun.PlaybookID == "" {
+		return errors.Wrapf(ErrNotFound, "property field '%s' does not exist", value.FieldID)
+	}
+
+	playbook, err := s.playbookService.Get(playbookRun.PlaybookID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get playbook '%s'", playbookRun.PlaybookID)
+	}
+
+	field, err := GetPropertyField(playbook.PropertyFields, value.FieldID)
+	if err != nil {
+		return err
+	}
+
+	if err = field.ValidateValue(value.Value); err != nil {
+		return errors.Wrap(ErrMalformedPlaybookRun, err.Error())
+	}
+
+	if field.Type == PropertyFieldTypeUser && value.Value != "" {
+		if _, err = s.api.GetUserByID(value.Value); err != nil {
+			return errors.Wrapf(err, "failed to get user '%s'", value.Value)
+		}
+	}
+
+	if err = s.store.SetPropertyValue(playbookRunID, value); err != nil {
+		return errors.Wrapf(err, "failed to set value of property field '%s'", value.FieldID)
+	}
+
+	s.sendPlaybookRunUpdatedWS(playbookRunID)
+	return nil
+}
+
+// ChangeSeverity changes the severity of the run, on behalf of userID. The severity must be one of
+// the levels of the run's playbook, or one of the default levels if the run has no playbook.
+// Changing the severity does not apply the defaults of the new level to the run.
+func (s *PlaybookRunServiceImpl) ChangeSeverity(playbookRunID, userID, severity string) error {
+	playbookRunToModify, err := s.store.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to retrieve playbook run")
+	}
+
+	if playbookRunToModify.Severity == severity {
+		return nil
+	}
+
+	var playbook *Playbook
+	if playbookRunToModify.PlaybookID != "" {
+		pb, err := s.playbookService.Get(playbookRunToModify.PlaybookID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get playbook '%s'", playbookRunToModify.PlaybookID)
+		}
+		playbook = &pb
+	}
+
+	if err = ValidateSeverity(playbook, severity); err != nil {
+		return errors.Wrap(ErrMalformedPlaybookRun, err.Error())
+	}
+
+	subjectUser, err := s.api.GetUserByID(userID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to to resolve user %s", userID)
+	}
+
+	oldSeverity := playbookRunToModify.Severity
+	playbookRunToModify.Severity = severity
+	playbookRunToModify, err = s.store.UpdatePlaybookRun(playbookRunToModify)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update playbook run")
+	}
+
+	var summary, message string
+	switch {
+	case oldSeverity == "":
+		summary = fmt.Sprintf("Severity set to %s", severity)
+		message = fmt.Sprintf("@%s set the severity to **%s**", subjectUser.Username, severity)
+	case severity == "":
+		summary = fmt.Sprintf("Severity %s cleared", oldSeverity)
+		message = fmt.Sprintf("@%s cleared the severity **%s**", subjectUser.Username, oldSeverity)
+	default:
+		summary = fmt.Sprintf("Severity changed from %s to %s", oldSeverity, severity)
+		message = fmt.Sprintf("@%s changed the severity from **%s** to **%s**", subjectUser.Username, oldSeverity, severity)
+	}
+
+	eventTime := model.GetMillis()
+	event := &TimelineEvent{
+		PlaybookRunID: playbookRunID,
+		CreateAt:      eventTime,
+		EventAt:       eventTime,
+		EventType:     SeverityChanged,
+		Summary:       summary,
+		SubjectUserID: userID,
+	}
+
+	if _, err = s.store.CreateTimelineEvent(event); err != nil {
+		return errors.Wrap(err, "failed to create timeline event")
+	}
+
+	if _, err = s.poster.PostMessage(playbookRunToModify.ChannelID, "%s", message); err != nil {
+		return errors.Wrap(err, "failed to post severity change to the run channel")
+	}
+
+	// The severity may file the run into other categories
+	s.applyCategoryRules(playbookRunToModify, playbookRunToModify.ParticipantIDs)
+
+	s.sendPlaybookRunUpdatedWS(playbookRunID)
+
+	return nil
+}
+
+// GetRunLinks returns the links from the run to other runs.
+func (s *PlaybookRunServiceImpl) GetRunLinks(playbookRunID string) ([]RunRelation, error) {
+	return s.store.GetRunLinks(playbookRunID)
+}
+
+// LinkRuns links the run to linkedRunID with the given type, relative to the run. A run has at
+// most one parent, and a run cannot be linked as a parent of any of its ancestors.
+func (s *PlaybookRunServiceImpl) LinkRuns(playbookRunID, linkedRunID, linkType, userID string) error {
+	if !ValidRunLinkType(linkType) {
+		return errors.Wrapf(ErrMalformedPlaybookRun, "unknown link type '%s'", linkType)
+	}
+
+	if playbookRunID == linkedRunID {
+		return errors.Wrap(ErrMalformedPlaybookRun, "a run cannot be linked to itself")
+	}
+
+	if _, err := s.store.GetPlaybookRun(linkedRunID); err != nil {
+		return errors.Wrapf(err, "failed to retrieve linked playbook run")
+	}
+
+	links, err := s.store.GetRunLinks(playbookRunID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get links of playbook run %s", playbookRunID)
+	}
+
+	if len(links) >= MaxRunLinks {
+		return errors.Wrapf(ErrMalformedPlaybookRun, "a run cannot be linked to more than %d runs", MaxRunLinks)
+	}
+
+	for _, link := range links {
+		if link.RunID == linkedRunID {
+			return errors.Wrapf(ErrMalformedPlaybookRun, "run is already linked to run '%s'", linkedRunID)
+		}
+	}
+
+	parentRunID, childRunID := playbookRunID, linkedRunID
+	if linkType == RunLinkTypeParent {
+		parentRunID, childRunID = linkedRunID, playbookRunID
+	}
+
+	if linkType != RunLinkTypeRelated {
+		if err = s.validateParentLink(parentRunID, childRunID); err != nil {
+			return err
+		}
+	}
+
+	if err = s.store.CreateRunLink(playbookRunID, linkedRunID, linkType); err != nil {
+		return errors.Wrap(err, "failed to link runs")
+	}
+
+	s.sendPlaybookRunUpdatedWS(playbookRunID)
+	s.sendPlaybookRunUpdatedWS(linkedRunID)
+
+	return nil
+}
+
+// validateParentLink checks that childRunID has no parent yet, and that it is not an ancestor of
+// parentRunID.
+func (s *PlaybookRunServiceImpl) validateParentLink(parentRunID, childRunID string) error {
+	if _, hasParent, err := s.getParentRunID(childRunID); err != nil {
+		return err
+	} else if hasParent {
+		return errors.Wrapf(ErrMalformedPlaybookRun, "run '%s' already has a parent", childRunID)
+	}
+
+	visited := map[string]bool{}
+	for runID := parentRunID; !visited[runID]; {
+		visited[runID] = true
+
+		ancestorID, hasParent, err := s.getParentRunID(runID)
+		if err != nil {
+			return err
+		}
+		if !hasParent {
+			return nil
+		}
+		if ancestorID == childRunID {
+			return errors.Wrapf(ErrMalformedPlaybookRun, "run '%s' is an ancestor of run '%s'", childRunID, parentRunID)
+		}
+		runID = ancestorID
+	}
+
+	return nil
+}
+
+func (s *PlaybookRunServiceImpl) getParentRunID(playbookRunID string) (string, bool, error) {
+	links, err := s.store.GetRunLinks(playbookRunID)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "failed to get links of playbook run %s", playbookRunID)
+	}
+
+	for _, link := range links {
+		if link.Type == RunLinkTypeParent {
+			return link.RunID, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// UnlinkRuns removes the link between the run and linkedRunID.
+func (s *PlaybookRunServiceImpl) UnlinkRuns(playbookRunID, linkedRunID, userID string) error {
+	links, err := s.store.GetRunLinks(playbookRunID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get links of playbook run %s", playbookRunID)
+	}
+
+	linked := false
+	for _, link := range links {
+		if link.RunID == linkedRunID {
+			linked = true
+			break
+		}
+	}
+	if !linked {
+		return errors.Wrapf(ErrNotFound, "run is not linked to run '%s'", linkedRunID)
+	}
+
+	if err = s.store.DeleteRunLink(playbookRunID, linkedRunID); err != nil {
+		return errors.Wrap(err, "failed to unlink runs")
+	}
+
+	s.sendPlaybookRunUpdatedWS(playbookRunID)
+	s.sendPlaybookRunUpdatedWS(linkedRunID)
+
+	return nil
+}
+
+// StartCall starts a call in the run's channel on behalf of userID, through the Calls plugin.
+// The call is recorded in the run's timeline once the Calls plugin posts it in the channel.
+func (s *PlaybookRunServiceImpl) StartCall(playbookRunID, userID string) error {
+	if !s.api.IsPluginActive(CallsPluginID) {
+		return ErrCallsUnavailable
+	}
+
+	playbookRun, err := s.store.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to retrieve playbook run")
+	}
+
+	if _, err := s.api.Execute(&model.CommandArgs{
+		Command:   callStartCommand,
+		UserId:    userID,
+		TeamId:    playbookRun.TeamID,
+		ChannelId: playbookRun.ChannelID,
+	}); err != nil {
+		return errors.Wrap(err, "failed to start call")
+	}
+
+	return nil
+}
+
+// GetCallRecordings returns the recordings of the calls recorded in the run's timeline.
+func (s *PlaybookRunServiceImpl) GetCallRecordings(playbookRunID string) ([]CallRecording, error) {
+	playbookRun, err := s.store.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve playbook run")
+	}
+
+	recordings := []CallRecording{}
+	for _, event := range playbookRun.TimelineEvents {
+		if event.EventType != CallStarted || event.DeleteAt != 0 || event.PostID == "" {
+			continue
+		}
+
+		// The recordings are attached to the call post after the call ended, so they are read
+		// from the post rather than from the timeline event.
+		post, err := s.api.GetPost(event.PostID)
+		if err != nil {
+			logrus.WithError(err).WithField("post_id", event.PostID).Warn("failed to get call post")
+			continue
+		}
+
+		details, ok := GetCallDetails(post)
+		if !ok {
+			continue
+		}
+
+		for _, fileID := range details.RecordingFileIDs {
+			fileInfo, err := s.api.GetFileInfo(fileID)
+			if err != nil {
+				logrus.WithError(err).WithField("file_id", fileID).Warn("failed to get call recording")
+				continue
+			}
+
+			recordings = append(recordings, CallRecording{
+				CallPostID: post.Id,
+				FileID:     fileInfo.Id,
+				Name:       fileInfo.Name,
+				Size:       fileInfo.Size,
+				CreateAt:   fileInfo.CreateAt,
+			})
+		}
+	}
+
+	return recordings, nil
+}
+
+// MessageHasBeenUpdated records in the timeline of the channel's runs the end of the calls
+// started in the channel.
+func (s *PlaybookRunServiceImpl) MessageHasBeenUpdated(newPost, oldPost *model.Post) {
+	details, ok := GetCallDetails(newPost)
+	if !ok || details.EndAt == 0 {
+		return
+	}
+
+	if oldDetails, ok := GetCallDetails(oldPost); ok && oldDetails.EndAt != 0 {
+		return
+	}
+
+	runIDs, err := s.store.GetPlaybookRunIDsForChannel(newPost.ChannelId)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			logrus.WithError(err).WithField("channel_id", newPost.ChannelId).Error("unable retrieve run ID from post")
+		}
+		return
+	}
+
+	s.createCallTimelineEvents(runIDs, newPost, CallEnded, details)
+}
+
+// createCallTimelineEvents records the start or the end of a call in the timeline of the given
+// runs, skipping the runs created after the call started.
+func (s *PlaybookRunServiceImpl) createCallTimelineEvents(runIDs []string, post *model.Post, eventType timelineEventType, details CallDetails) {
+	detailsJSON, err := json.Marshal(details)
+	if err != nil {
+		logrus.WithError(err).WithField("post_id", post.Id).Error("failed to marshal call details")
+		return
+	}
+
+	summary := "Call started"
+	eventAt := details.StartAt
+	if eventType == CallEnded {
+		summary = fmt.Sprintf("Call ended after %s", formatCallDuration(details.Duration))
+		eventAt = details.EndAt
+	}
+
+	for _, runID := range runIDs {
+		playbookRun, err := s.store.GetPlaybookRun(runID)
+		if err != nil {
+			logrus.WithError(err).WithField("playbook_run_id", runID).Error("unable retrieve run from ID")
+			continue
+		}
+
+		if playbookRun.CreateAt > details.StartAt {
+			continue
+		}
+
+		event := &TimelineEvent{
+			PlaybookRunID: runID,
+			CreateAt:      model.GetMillis(),
+			EventAt:       eventAt,
+			EventType:     eventType,
+			Summary:       summary,
+			Details:       string(detailsJSON),
+			PostID:        post.Id,
+			SubjectUserID: post.UserId,
+		}
+
+		if _, err := s.store.CreateTimelineEvent(event); err != nil {
+			logrus.WithError(err).WithField("playbook_run_id", runID).Error("failed to create call timeline event")
+			continue
+		}
+
+		s.sendPlaybookRunUpdatedWS(runID)
+	}
+}
+
 // NukeDB removes all playbook run related data.
 func (s *PlaybookRunServiceImpl) NukeDB() error {
 	return s.store.NukeDB()
 }
 
+// NukeTeamData removes all the playbooks, runs and categories of the given team.
+func (s *PlaybookRunServiceImpl) NukeTeamData(teamID string) error {
+	return s.store.NukeTeamData(teamID)
+}
+
 // ChangeCreationDate changes the creation date of the playbook run.
 func (s *PlaybookRunServiceImpl) ChangeCreationDate(playbookRunID string, creationTimestamp time.Time) error {
 	return s.store.ChangeCreationDate(playbookRunID, creationTimestamp)
@@ -3111,6 +3729,8 @@ func (s *PlaybookRunServiceImpl) AddParticipants(playbookRunID string, userIDs [
 		return err
 	}
 
+	s.applyCategoryRules(playbookRun, usersToInvite)
+
 	// ws send run
 	if len(usersToInvite) > 0 {
 		s.sendPlaybookRunUpdatedWS(playbookRun.ID, withAdditionalUserIDs(usersToInvite), withAdditionalUserIDs([]string{requesterUserID}))
@@ -3582,6 +4202,11 @@ func (s *PlaybookRunServiceImpl) MessageHasBeenPosted(post *model.Post) {
 		return
 	}
 
+	if details, ok := GetCallDetails(post); ok {
+		s.createCallTimelineEvents(runIDs, post, CallStarted, details)
+		return
+	}
+
 	for _, runID := range runIDs {
 		// Get run
 		run, err := s.GetPlaybookRun(runID)
@@ -3668,6 +4293,18 @@ func (s *PlaybookRunServiceImpl) GetTaskMetadataByIDs(taskIDs []string) ([]Topic
 	return s.store.GetTaskAsTopicMetadataByIDs(taskIDs)
 }
 
+// applyCategoryRules files the run into the sidebar categories of the given users, as configured
+// by the category rules.
+func (s *PlaybookRunServiceImpl) applyCategoryRules(playbookRun *PlaybookRun, userIDs []string) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	if err := s.categoryService.ApplyRules(playbookRun, userIDs); err != nil {
+		logrus.WithError(err).WithField("playbook_run_id", playbookRun.ID).Warn("failed to apply category rules")
+	}
+}
+
 // GetStatusMetadataByIDs gets PlaybookRunIDs and TeamIDs from runs by statusIDs
 func (s *PlaybookRunServiceImpl) GetStatusMetadataByIDs(statusIDs []string) ([]TopicMetadata, error) {
 	return s.store.GetStatusAsTopicMetadataByIDs(statusIDs)
@@ -189,6 +189,9 @@ func (s *playbookService) Duplicate(playbook Playbook, userID string) (string, e
 	for i := range newPlaybook.Metrics {
 		newPlaybook.Metrics[i].ID = ""
 	}
+	for i := range newPlaybook.PropertyFields {
+		newPlaybook.PropertyFields[i].ID = ""
+	}
 	newPlaybook.Title = "Copy of " + playbook.Title
 
 	// On duplicating, make the current user the administrator.
@@ -0,0 +1,148 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const (
+	PropertyFieldTypeText   = "text"
+	PropertyFieldTypeSelect = "select"
+	PropertyFieldTypeUser   = "user"
+	PropertyFieldTypeDate   = "date"
+)
+
+const (
+	MaxPropertyFieldsPerPlaybook = 20
+	MaxPropertyFieldNameLength   = 128
+	MaxPropertyTextValueLength   = 1024
+)
+
+// PropertyOption is one of the values a select property field can take.
+type PropertyOption struct {
+	ID   string `json:"id" export:"-"`
+	Name string `json:"name" export:"name"`
+}
+
+// PropertyField is a custom field defined by the admins of a playbook, set on each of its runs.
+type PropertyField struct {
+	ID         string `json:"id" export:"-"`
+	PlaybookID string `json:"playbook_id" export:"-"`
+	Name       string `json:"name" export:"name"`
+
+	// Type is one of PropertyFieldTypeText, PropertyFieldTypeSelect, PropertyFieldTypeUser or
+	// PropertyFieldTypeDate.
+	Type string `json:"type" export:"type"`
+
+	// Options holds the values a select field can take. Empty for other types.
+	Options []PropertyOption `json:"options" export:"options"`
+}
+
+// PropertyValue is the value of a property field for a run. The value is the identifier of the
+// option for select fields, the identifier of the user for user fields, and a timestamp in
+// milliseconds since epoch for date fields.
+type PropertyValue struct {
+	FieldID string `json:"field_id"`
+	Value   string `json:"value"`
+}
+
+// Clone duplicates the given field.
+func (f PropertyField) Clone() PropertyField {
+	newField := f
+	newField.Options = append([]PropertyOption(nil), f.Options...)
+	return newField
+}
+
+// ValidateValue checks that the given value can be set for the field. The empty value, which
+// clears the field, is always valid.
+func (f PropertyField) ValidateValue(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	switch f.Type {
+	case PropertyFieldTypeText:
+		if len(value) > MaxPropertyTextValueLength {
+			return errors.Errorf("value of field '%s' is longer than %d characters", f.Name, MaxPropertyTextValueLength)
+		}
+	case PropertyFieldTypeSelect:
+		for _, option := range f.Options {
+			if option.ID == value {
+				return nil
+			}
+		}
+		return errors.Errorf("value of field '%s' is not one of its options", f.Name)
+	case PropertyFieldTypeUser:
+		if !model.IsValidId(value) {
+			return errors.Errorf("value of field '%s' is not a valid user ID", f.Name)
+		}
+	case PropertyFieldTypeDate:
+		if timestamp, err := strconv.ParseInt(value, 10, 64); err != nil || timestamp <= 0 {
+			return errors.Errorf("value of field '%s' is not a valid timestamp", f.Name)
+		}
+	default:
+		return errors.Errorf("unknown type '%s' for field '%s'", f.Type, f.Name)
+	}
+
+	return nil
+}
+
+// ValidatePropertyFields checks the property fields defined by a playbook.
+func ValidatePropertyFields(fields []PropertyField) error {
+	if len(fields) > MaxPropertyFieldsPerPlaybook {
+		return errors.Errorf("playbook cannot have more than %d property fields", MaxPropertyFieldsPerPlaybook)
+	}
+
+	names := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		name := strings.TrimSpace(field.Name)
+		if name == "" {
+			return errors.New("property field name must not be empty")
+		}
+		if len(name) > MaxPropertyFieldNameLength {
+			return errors.Errorf("property field name '%s' is longer than %d characters", name, MaxPropertyFieldNameLength)
+		}
+		if names[strings.ToLower(name)] {
+			return errors.Errorf("property field name '%s' is used more than once", name)
+		}
+		names[strings.ToLower(name)] = true
+
+		switch field.Type {
+		case PropertyFieldTypeSelect:
+			if len(field.Options) == 0 {
+				return errors.Errorf("select property field '%s' must have options", name)
+			}
+			for _, option := range field.Options {
+				if strings.TrimSpace(option.Name) == "" {
+					return errors.Errorf("options of property field '%s' must have a name", name)
+				}
+			}
+		case PropertyFieldTypeText, PropertyFieldTypeUser, PropertyFieldTypeDate:
+			if len(field.Options) > 0 {
+				return errors.Errorf("%s property field '%s' cannot have options", field.Type, name)
+			}
+		default:
+			return errors.Errorf("unknown type '%s' for property field '%s'", field.Type, name)
+		}
+	}
+
+	return nil
+}
+
+// GetPropertyField returns the field with the given identifier, or ErrNotFound.
+func GetPropertyField(fields []PropertyField, fieldID string) (PropertyField, error) {
+	for _, field := range fields {
+		if field.ID == fieldID {
+			return field, nil
+		}
+	}
+
+	return PropertyField{}, errors.Wrapf(ErrNotFound, "property field '%s' does not exist", fieldID)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePropertyFields(t *testing.T) {
+	tooMany := make([]PropertyField, MaxPropertyFieldsPerPlaybook+1)
+	for i := range tooMany {
+		tooMany[i] = PropertyField{Name: model.NewId(), Type: PropertyFieldTypeText}
+	}
+
+	tests := []struct {
+		name    string
+		fields  []PropertyField
+		wantErr bool
+	}{
+		{"no fields", nil, false},
+		{"all types", []PropertyField{{Name: "Service", Type: PropertyFieldTypeText}, {Name: "Region", Type: PropertyFieldTypeSelect, Options: []PropertyOption{{Name: "EMEA"}}}, {Name: "Owner", Type: PropertyFieldTypeUser}, {Name: "Due", Type: PropertyFieldTypeDate}}, false},
+		{"too many fields", tooMany, true},
+		{"empty name", []PropertyField{{Name: " ", Type: PropertyFieldTypeText}}, true},
+		{"long name", []PropertyField{{Name: strings.Repeat("a", MaxPropertyFieldNameLength+1), Type: PropertyFieldTypeText}}, true},
+		{"duplicated name", []PropertyField{{Name: "Service", Type: PropertyFieldTypeText}, {Name: "service", Type: PropertyFieldTypeUser}}, true},
+		{"unknown type", []PropertyField{{Name: "Service", Type: "number"}}, true},
+		{"select without options", []PropertyField{{Name: "Region", Type: PropertyFieldTypeSelect}}, true},
+		{"option without name", []PropertyField{{Name: "Region", Type: PropertyFieldTypeSelect, Options: []PropertyOption{{Name: ""}}}}, true},
+		{"text with options", []PropertyField{{Name: "Service", Type: PropertyFieldTypeText, Options: []PropertyOption{{Name: "api"}}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePropertyFields(tt.fields)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPropertyFieldValidateValue(t *testing.T) {
+	selectField := PropertyField{Name: "Region", Type: PropertyFieldTypeSelect, Options: []PropertyOption{{ID: "o1", Name: "EMEA"}}}
+
+	tests := []struct {
+		name    string
+		field   PropertyField
+		value   string
+		wantErr bool
+	}{
+		{"clear", selectField, "", false},
+		{"text", PropertyField{Type: PropertyFieldTypeText}, "api", false},
+		{"long text", PropertyField{Type: PropertyFieldTypeText}, strings.Repeat("a", MaxPropertyTextValueLength+1), true},
+		{"select option", selectField, "o1", false},
+		{"unknown option", selectField, "o2", true},
+		{"user", PropertyField{Type: PropertyFieldTypeUser}, model.NewId(), false},
+		{"invalid user", PropertyField{Type: PropertyFieldTypeUser}, "someone", true},
+		{"date", PropertyField{Type: PropertyFieldTypeDate}, "1660000000000", false},
+		{"invalid date", PropertyField{Type: PropertyFieldTypeDate}, "tomorrow", true},
+		{"negative date", PropertyField{Type: PropertyFieldTypeDate}, "-1", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.field.ValidateValue(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -15,10 +15,19 @@ import (
 
 const RetrospectivePrefix = "retro_"
 
+// FollowUpPrefix prefixes the keys of the follow-up reminders scheduled when runs finish.
+const FollowUpPrefix = "followup_"
+
+// MaxFollowUpReminderDelaySeconds is the longest delay, one year, after which a follow-up
+// reminder can be sent.
+const MaxFollowUpReminderDelaySeconds = 365 * 24 * 60 * 60
+
 // HandleReminder is the handler for all reminder events.
 func (s *PlaybookRunServiceImpl) HandleReminder(key string) {
 	if strings.HasPrefix(key, RetrospectivePrefix) {
 		s.handleReminderToFillRetro(strings.TrimPrefix(key, RetrospectivePrefix))
+	} else if strings.HasPrefix(key, FollowUpPrefix) {
+		s.handleFollowUpReminder(strings.TrimPrefix(key, FollowUpPrefix))
 	} else {
 		s.handleStatusUpdateReminder(key)
 	}
@@ -58,6 +67,128 @@ func (s *PlaybookRunServiceImpl) handleReminderToFillRetro(playbookRunID string)
 	}()
 }
 
+func (s *PlaybookRunServiceImpl) handleFollowUpReminder(playbookRunID string) {
+	logger := logrus.WithField("playbook_run_id", playbookRunID)
+
+	playbookRunToRemind, err := s.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		logger.WithError(err).Error("handleFollowUpReminder failed to get playbook run")
+		return
+	}
+
+	// The run was restored in the meantime, so there is nothing to follow up on.
+	if playbookRunToRemind.CurrentStatus != StatusFinished {
+		return
+	}
+
+	message := fmt.Sprintf("It's time to follow up on [%s](%s), which finished %s ago. Check that the fix is holding up.",
+		playbookRunToRemind.Name,
+		GetRunDetailsRelativeURL(playbookRunID),
+		formatFollowUpDelay(playbookRunToRemind.FollowUpReminderDelaySeconds))
+	if playbookRunToRemind.FollowUpReminderMessage != "" {
+		message += "\n\n" + playbookRunToRemind.FollowUpReminderMessage
+	}
+
+	attachments := []*model.SlackAttachment{
+		{
+			Actions: []*model.PostAction{
+				{
+					Type: "button",
+					Name: "Reopen run",
+					Integration: &model.PostActionIntegration{
+						URL: fmt.Sprintf("/plugins/%s/api/v0/runs/%s/follow-up/reopen",
+							"playbooks",
+							playbookRunToRemind.ID),
+					},
+				},
+			},
+		},
+	}
+
+	post := &model.Post{Message: message}
+	model.ParseSlackAttachment(post, attachments)
+
+	if err = s.poster.DM(playbookRunToRemind.OwnerUserID, post); err != nil {
+		logger.WithError(err).Error("handleFollowUpReminder failed to DM the owner")
+	}
+}
+
+// formatFollowUpDelay describes the delay of a follow-up reminder in days, or in hours for
+// delays shorter than a day.
+func formatFollowUpDelay(delaySeconds int64) string {
+	delay := time.Duration(delaySeconds) * time.Second
+	if delay < 24*time.Hour {
+		hours := int64(delay.Hours())
+		if hours == 1 {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", hours)
+	}
+
+	days := int64(delay.Hours() / 24)
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
+
+// ValidateFollowUpReminder checks the delay of a follow-up reminder.
+func ValidateFollowUpReminder(delaySeconds int64) error {
+	if delaySeconds < 0 {
+		return errors.New("follow-up reminder delay must not be negative")
+	}
+	if delaySeconds > MaxFollowUpReminderDelaySeconds {
+		return errors.Errorf("follow-up reminder delay must not be longer than %d seconds", MaxFollowUpReminderDelaySeconds)
+	}
+
+	return nil
+}
+
+// scheduleFollowUpReminder schedules the follow-up reminder of a finished run, replacing any
+// pending one. Reminders already due are sent right away.
+func (s *PlaybookRunServiceImpl) scheduleFollowUpReminder(playbookRun *PlaybookRun) error {
+	s.scheduler.Cancel(FollowUpPrefix + playbookRun.ID)
+
+	if playbookRun.FollowUpReminderDelaySeconds == 0 || playbookRun.CurrentStatus != StatusFinished {
+		return nil
+	}
+
+	remindAt := time.UnixMilli(playbookRun.EndAt).Add(time.Duration(playbookRun.FollowUpReminderDelaySeconds) * time.Second)
+	if _, err := s.scheduler.ScheduleOnce(FollowUpPrefix+playbookRun.ID, remindAt); err != nil {
+		return errors.Wrap(err, "unable to schedule follow-up reminder")
+	}
+
+	return nil
+}
+
+// SetFollowUpReminder sets the delay and message of the reminder sent to the run's owner after
+// the run finishes, rescheduling the pending reminder if the run is already finished.
+func (s *PlaybookRunServiceImpl) SetFollowUpReminder(playbookRunID string, delaySeconds int64, message string) error {
+	if err := ValidateFollowUpReminder(delaySeconds); err != nil {
+		return errors.Wrap(ErrMalformedPlaybookRun, err.Error())
+	}
+
+	playbookRunToModify, err := s.store.GetPlaybookRun(playbookRunID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to retrieve playbook run")
+	}
+
+	playbookRunToModify.FollowUpReminderDelaySeconds = delaySeconds
+	playbookRunToModify.FollowUpReminderMessage = message
+	playbookRunToModify, err = s.store.UpdatePlaybookRun(playbookRunToModify)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update playbook run")
+	}
+
+	if err = s.scheduleFollowUpReminder(playbookRunToModify); err != nil {
+		return err
+	}
+
+	s.sendPlaybookRunUpdatedWS(playbookRunID)
+
+	return nil
+}
+
 func (s *PlaybookRunServiceImpl) handleStatusUpdateReminder(playbookRunID string) {
 	logger := logrus.WithField("playbook_run_id", playbookRunID)
 
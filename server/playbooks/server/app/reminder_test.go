@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFollowUpReminder(t *testing.T) {
+	require.NoError(t, ValidateFollowUpReminder(0))
+	require.NoError(t, ValidateFollowUpReminder(7*24*60*60))
+	require.NoError(t, ValidateFollowUpReminder(MaxFollowUpReminderDelaySeconds))
+	require.Error(t, ValidateFollowUpReminder(-1))
+	require.Error(t, ValidateFollowUpReminder(MaxFollowUpReminderDelaySeconds+1))
+}
+
+func TestFormatFollowUpDelay(t *testing.T) {
+	require.Equal(t, "1 hour", formatFollowUpDelay(60*60))
+	require.Equal(t, "5 hours", formatFollowUpDelay(5*60*60))
+	require.Equal(t, "1 day", formatFollowUpDelay(24*60*60))
+	require.Equal(t, "7 days", formatFollowUpDelay(7*24*60*60))
+}
+
+func TestSetConfigurationFromPlaybookFollowUpReminder(t *testing.T) {
+	playbook := Playbook{
+		FollowUpReminderDelaySeconds: 3 * 24 * 60 * 60,
+		FollowUpReminderMessage:      "Check the error rate",
+	}
+
+	run := PlaybookRun{}
+	run.SetConfigurationFromPlaybook(playbook, RunSourcePost)
+	require.Zero(t, run.FollowUpReminderDelaySeconds)
+	require.Empty(t, run.FollowUpReminderMessage)
+
+	playbook.FollowUpReminderEnabled = true
+	run.SetConfigurationFromPlaybook(playbook, RunSourcePost)
+	require.Equal(t, int64(3*24*60*60), run.FollowUpReminderDelaySeconds)
+	require.Equal(t, "Check the error rate", run.FollowUpReminderMessage)
+}
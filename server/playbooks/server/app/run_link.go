@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+const (
+	// RunLinkTypeParent links a run to the run coordinating it.
+	RunLinkTypeParent = "parent"
+
+	// RunLinkTypeChild links a run to one of the runs it coordinates.
+	RunLinkTypeChild = "child"
+
+	// RunLinkTypeRelated links two runs without any hierarchy between them.
+	RunLinkTypeRelated = "related"
+)
+
+// MaxRunLinks is the maximum number of runs a run can be linked to.
+const MaxRunLinks = 50
+
+// RunRelation is a link from a run to another run. The type is relative to the run the link belongs
+// to: a run linked to its parent has a link of type RunLinkTypeParent, and the parent has a link
+// of type RunLinkTypeChild to it.
+type RunRelation struct {
+	// RunID is the identifier of the linked run.
+	RunID string `json:"run_id"`
+
+	// Type is one of RunLinkTypeParent, RunLinkTypeChild or RunLinkTypeRelated.
+	Type string `json:"type"`
+
+	// CreateAt is the timestamp, in milliseconds since epoch, of when the runs were linked.
+	CreateAt int64 `json:"create_at"`
+}
+
+// LinkedRun summarizes a linked run, as shown in the metadata of the run it is linked to.
+type LinkedRun struct {
+	RunRelation
+
+	Name           string `json:"name"`
+	CurrentStatus  string `json:"current_status"`
+	NumTasks       int64  `json:"num_tasks"`
+	NumTasksClosed int64  `json:"num_tasks_closed"`
+}
+
+// ValidRunLinkType returns true if the given type is one of the types of run links.
+func ValidRunLinkType(linkType string) bool {
+	return linkType == RunLinkTypeParent || linkType == RunLinkTypeChild || linkType == RunLinkTypeRelated
+}
+
+// TaskProgress returns the number of tasks of the run, and how many of them are either closed or
+// skipped.
+func (r *PlaybookRun) TaskProgress() (total, closed int64) {
+	for _, checklist := range r.Checklists {
+		for _, item := range checklist.Items {
+			total++
+			if item.State == ChecklistItemStateClosed || item.State == ChecklistItemStateSkipped {
+				closed++
+			}
+		}
+	}
+
+	return total, closed
+}
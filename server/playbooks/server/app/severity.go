@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	SeveritySEV1 = "SEV1"
+	SeveritySEV2 = "SEV2"
+	SeveritySEV3 = "SEV3"
+	SeveritySEV4 = "SEV4"
+)
+
+const (
+	MaxSeverityLevelsPerPlaybook = 10
+	MaxSeverityNameLength        = 64
+)
+
+// DefaultSeverityLevels are the severity levels available to the runs of playbooks that do not
+// configure their own, and to runs created without a playbook.
+var DefaultSeverityLevels = []SeverityLevel{
+	{Name: SeveritySEV1},
+	{Name: SeveritySEV2},
+	{Name: SeveritySEV3},
+	{Name: SeveritySEV4},
+}
+
+// SeverityLevel is a severity a run can be declared with, along with the defaults applied to the
+// runs created at that severity. The levels are ordered from the most to the least severe.
+type SeverityLevel struct {
+	Name string `json:"name" export:"name"`
+
+	// BroadcastChannelIDs, if not empty, replaces the playbook's broadcast channels for the runs
+	// created at this severity.
+	BroadcastChannelIDs []string `json:"broadcast_channel_ids" export:"-"`
+
+	// ReminderTimerDefaultSeconds, if not 0, replaces the playbook's status update reminder
+	// cadence for the runs created at this severity.
+	ReminderTimerDefaultSeconds int64 `json:"reminder_timer_default_seconds" export:"reminder_timer_default_seconds"`
+}
+
+// Clone duplicates the given severity level.
+func (l SeverityLevel) Clone() SeverityLevel {
+	newLevel := l
+	newLevel.BroadcastChannelIDs = append([]string(nil), l.BroadcastChannelIDs...)
+	return newLevel
+}
+
+// GetSeverityLevels returns the severity levels available to the runs of the playbook.
+func (p Playbook) GetSeverityLevels() []SeverityLevel {
+	if len(p.SeverityLevels) == 0 {
+		return DefaultSeverityLevels
+	}
+	return p.SeverityLevels
+}
+
+// GetSeverityLevel returns the severity level of the playbook with the given name. The second
+// value is false if the playbook has no such level.
+func (p Playbook) GetSeverityLevel(name string) (SeverityLevel, bool) {
+	return findSeverityLevel(p.GetSeverityLevels(), name)
+}
+
+// ValidateSeverity checks that a run can be declared with the given severity, either one of the
+// levels of its playbook or, if the run has no playbook, one of the default levels. The empty
+// severity is always valid.
+func ValidateSeverity(playbook *Playbook, severity string) error {
+	if severity == "" {
+		return nil
+	}
+
+	levels := DefaultSeverityLevels
+	if playbook != nil {
+		levels = playbook.GetSeverityLevels()
+	}
+	if _, ok := findSeverityLevel(levels, severity); !ok {
+		return errors.Errorf("unknown severity '%s'", severity)
+	}
+
+	return nil
+}
+
+// ValidateSeverityLevels checks the severity levels configured by a playbook.
+func ValidateSeverityLevels(levels []SeverityLevel) error {
+	if len(levels) > MaxSeverityLevelsPerPlaybook {
+		return errors.Errorf("playbook cannot have more than %d severity levels", MaxSeverityLevelsPerPlaybook)
+	}
+
+	names := make(map[string]bool, len(levels))
+	for _, level := range levels {
+		if strings.TrimSpace(level.Name) == "" {
+			return errors.New("severity level name must not be empty")
+		}
+		if len(level.Name) > MaxSeverityNameLength {
+			return errors.Errorf("severity level name '%s' is longer than %d characters", level.Name, MaxSeverityNameLength)
+		}
+		if names[strings.ToLower(level.Name)] {
+			return errors.Errorf("severity level name '%s' is used more than once", level.Name)
+		}
+		names[strings.ToLower(level.Name)] = true
+
+		if level.ReminderTimerDefaultSeconds < 0 {
+			return errors.Errorf("reminder timer of severity level '%s' must not be negative", level.Name)
+		}
+	}
+
+	return nil
+}
+
+func findSeverityLevel(levels []SeverityLevel, name string) (SeverityLevel, bool) {
+	for _, level := range levels {
+		if level.Name == name {
+			return level, true
+		}
+	}
+
+	return SeverityLevel{}, false
+}
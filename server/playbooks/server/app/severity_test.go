@@ -0,0 +1,88 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSeverityLevels(t *testing.T) {
+	tests := []struct {
+		name    string
+		levels  []SeverityLevel
+		wantErr bool
+	}{
+		{"no levels", nil, false},
+		{"custom levels", []SeverityLevel{{Name: "Critical", ReminderTimerDefaultSeconds: 900}, {Name: "Minor"}}, false},
+		{"too many levels", make([]SeverityLevel, MaxSeverityLevelsPerPlaybook+1), true},
+		{"empty name", []SeverityLevel{{Name: " "}}, true},
+		{"long name", []SeverityLevel{{Name: strings.Repeat("a", MaxSeverityNameLength+1)}}, true},
+		{"duplicated name", []SeverityLevel{{Name: "SEV1"}, {Name: "sev1"}}, true},
+		{"negative reminder", []SeverityLevel{{Name: "SEV1", ReminderTimerDefaultSeconds: -1}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSeverityLevels(tt.levels)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSeverity(t *testing.T) {
+	custom := &Playbook{SeverityLevels: []SeverityLevel{{Name: "Critical"}}}
+
+	require.NoError(t, ValidateSeverity(nil, ""))
+	require.NoError(t, ValidateSeverity(nil, SeveritySEV2))
+	require.Error(t, ValidateSeverity(nil, "Critical"))
+	require.NoError(t, ValidateSeverity(&Playbook{}, SeveritySEV4))
+	require.NoError(t, ValidateSeverity(custom, "Critical"))
+	require.Error(t, ValidateSeverity(custom, SeveritySEV1))
+}
+
+func TestSetConfigurationFromPlaybookSeverity(t *testing.T) {
+	playbook := Playbook{
+		ReminderTimerDefaultSeconds: 3600,
+		BroadcastEnabled:            true,
+		BroadcastChannelIDs:         []string{"channel1"},
+		SeverityLevels: []SeverityLevel{
+			{Name: SeveritySEV1, ReminderTimerDefaultSeconds: 600, BroadcastChannelIDs: []string{"channel2"}},
+			{Name: SeveritySEV2},
+		},
+	}
+
+	t.Run("level defaults take precedence", func(t *testing.T) {
+		run := PlaybookRun{Severity: SeveritySEV1}
+		run.SetConfigurationFromPlaybook(playbook, RunSourcePost)
+
+		require.Equal(t, int64(600), run.ReminderTimerDefaultSeconds)
+		require.Equal(t, 10*time.Minute, run.PreviousReminder)
+		require.Equal(t, []string{"channel2"}, run.BroadcastChannelIDs)
+		require.True(t, run.StatusUpdateBroadcastChannelsEnabled)
+	})
+
+	t.Run("level without defaults", func(t *testing.T) {
+		run := PlaybookRun{Severity: SeveritySEV2}
+		run.SetConfigurationFromPlaybook(playbook, RunSourcePost)
+
+		require.Equal(t, int64(3600), run.ReminderTimerDefaultSeconds)
+		require.Equal(t, []string{"channel1"}, run.BroadcastChannelIDs)
+	})
+
+	t.Run("no severity", func(t *testing.T) {
+		run := PlaybookRun{}
+		run.SetConfigurationFromPlaybook(playbook, RunSourcePost)
+
+		require.Equal(t, int64(3600), run.ReminderTimerDefaultSeconds)
+		require.Equal(t, []string{"channel1"}, run.BroadcastChannelIDs)
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// TelemetryEventTypeTrack is the type of the events tracking an action.
+	TelemetryEventTypeTrack = "track"
+
+	// TelemetryEventTypePage is the type of the events tracking a page view.
+	TelemetryEventTypePage = "page"
+)
+
+// TelemetryEventRetention is how long the local telemetry sink keeps the events it records.
+const TelemetryEventRetention = 30 * 24 * time.Hour
+
+// TelemetryEvent is a telemetry event recorded by the local telemetry sink. It holds exactly
+// what would have been sent to Rudder, so that admins can audit it before enabling Rudder.
+type TelemetryEvent struct {
+	ID string `json:"id"`
+
+	// Type is either TelemetryEventTypeTrack or TelemetryEventTypePage.
+	Type string `json:"type"`
+
+	// Name is the name of the event or of the page.
+	Name string `json:"name"`
+
+	// UserID is the identifier the event would have been sent with: the diagnostic ID of the
+	// server, not the ID of a user.
+	UserID string `json:"user_id"`
+
+	Properties map[string]interface{} `json:"properties"`
+
+	CreateAt int64 `json:"create_at"`
+}
+
+// GetTelemetryEventsResults is a page of the events recorded by the local telemetry sink.
+type GetTelemetryEventsResults struct {
+	TotalCount int              `json:"total_count"`
+	PageCount  int              `json:"page_count"`
+	HasMore    bool             `json:"has_more"`
+	Items      []TelemetryEvent `json:"items"`
+}
+
+// MarshalJSON customizes the JSON marshalling for GetTelemetryEventsResults by rendering a nil
+// Items as an empty slice instead.
+func (r GetTelemetryEventsResults) MarshalJSON() ([]byte, error) {
+	type Alias GetTelemetryEventsResults
+
+	if r.Items == nil {
+		r.Items = []TelemetryEvent{}
+	}
+
+	aux := &struct {
+		*Alias
+	}{
+		Alias: (*Alias)(&r),
+	}
+
+	return json.Marshal(aux)
+}
+
+// TelemetryEventStore stores the events recorded by the local telemetry sink.
+type TelemetryEventStore interface {
+	// Create stores a new event.
+	Create(event TelemetryEvent) error
+
+	// GetEvents returns a page of the events, newest first.
+	GetEvents(page, perPage int) (GetTelemetryEventsResults, error)
+
+	// DeleteBefore deletes the events created before the given timestamp, in milliseconds.
+	DeleteBefore(createAt int64) error
+
+	// DeleteAll deletes all the events.
+	DeleteAll() error
+}
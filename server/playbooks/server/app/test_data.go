@@ -0,0 +1,623 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/playbooks"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/timeutils"
+)
+
+// MaxTestDataPlaybooks is the maximum number of synthetic playbooks that can be generated at once.
+const MaxTestDataPlaybooks = 5
+
+// MaxTestDataRuns is the maximum number of synthetic runs that can be generated at once.
+const MaxTestDataRuns = 1000
+
+// TestDataOptions configures the synthetic playbooks and runs generated by the TestDataGenerator.
+type TestDataOptions struct {
+	// TeamID is the team the playbooks and runs are created in.
+	TeamID string `json:"team_id"`
+
+	// NumPlaybooks is the number of synthetic playbooks to create. When zero, runs are created
+	// from the team's existing playbooks, falling back to the synthetic ones if there are none.
+	NumPlaybooks int `json:"playbooks"`
+
+	// NumOngoingRuns is the number of runs left in progress.
+	NumOngoingRuns int `json:"ongoing_runs"`
+
+	// NumEndedRuns is the number of runs that are finished after being created.
+	NumEndedRuns int `json:"ended_runs"`
+
+	// Days is the age, in days, of the oldest run. Creation dates are spread randomly over the period.
+	Days int `json:"days"`
+
+	// Seed controls the randomness, making the generated data reproducible.
+	Seed int64 `json:"seed"`
+}
+
+// Validate returns an error if the options are out of bounds.
+func (o TestDataOptions) Validate() error {
+	if o.TeamID == "" {
+		return errors.New("team_id must be set")
+	}
+	if o.NumPlaybooks < 0 || o.NumPlaybooks > MaxTestDataPlaybooks {
+		return errors.Errorf("playbooks must be between 0 and %d", MaxTestDataPlaybooks)
+	}
+	if o.NumOngoingRuns < 0 || o.NumEndedRuns < 0 {
+		return errors.New("the number of runs must not be negative")
+	}
+	if o.NumOngoingRuns+o.NumEndedRuns > MaxTestDataRuns {
+		return errors.Errorf("at most %d runs can be generated at once", MaxTestDataRuns)
+	}
+	if o.NumOngoingRuns+o.NumEndedRuns > 0 && o.Days < 1 {
+		return errors.New("days must be greater than 0")
+	}
+
+	return nil
+}
+
+// TestDataRun summarizes a synthetic run created by the TestDataGenerator.
+type TestDataRun struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ChannelID string `json:"channel_id"`
+	CreateAt  int64  `json:"create_at"`
+	Ended     bool   `json:"ended"`
+}
+
+// TestDataResult lists what the TestDataGenerator created.
+type TestDataResult struct {
+	Playbooks []Playbook    `json:"playbooks"`
+	Runs      []TestDataRun `json:"runs"`
+}
+
+// TestDataGenerator creates realistic synthetic playbooks and runs for load testing and demo
+// environments. It must only be exposed when ServiceSettings.EnableTesting is on.
+type TestDataGenerator struct {
+	playbookService    PlaybookService
+	playbookRunService PlaybookRunService
+	api                playbooks.ServicesAPI
+}
+
+// NewTestDataGenerator returns a new test data generator.
+func NewTestDataGenerator(playbookService PlaybookService, playbookRunService PlaybookRunService, api playbooks.ServicesAPI) *TestDataGenerator {
+	return &TestDataGenerator{
+		playbookService:    playbookService,
+		playbookRunService: playbookRunService,
+		api:                api,
+	}
+}
+
+// CreatePlaybooks creates numPlaybooks synthetic playbooks, picked randomly, with userID as their admin.
+func (g *TestDataGenerator) CreatePlaybooks(userID, teamID string, numPlaybooks int, rnd *rand.Rand) ([]Playbook, error) {
+	if numPlaybooks > len(testDataPlaybooks) {
+		return nil, errors.Errorf("maximum number of playbooks is %d", len(testDataPlaybooks))
+	}
+
+	created := make([]Playbook, 0, numPlaybooks)
+	for _, i := range rnd.Perm(len(testDataPlaybooks))[:numPlaybooks] {
+		playbook := testDataPlaybooks[i]
+		playbook.TeamID = teamID
+		playbook.Members = []PlaybookMember{
+			{
+				UserID: userID,
+				Roles:  []string{PlaybookRoleMember, PlaybookRoleAdmin},
+			},
+		}
+
+		playbookID, err := g.playbookService.Create(playbook, userID)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create playbook")
+		}
+
+		newPlaybook, err := g.playbookService.Get(playbookID)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get playbook")
+		}
+
+		created = append(created, newPlaybook)
+	}
+
+	return created, nil
+}
+
+// Generate creates the playbooks and runs described by options on behalf of userID. Runs are
+// created with a playbook randomly picked from the generated ones or, if none were requested, from
+// the ones the user is a member of, and their creation timestamps lie randomly within the last
+// options.Days days.
+func (g *TestDataGenerator) Generate(userID string, options TestDataOptions) (*TestDataResult, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	rnd := rand.New(rand.NewSource(options.Seed))
+	result := &TestDataResult{
+		Playbooks: []Playbook{},
+		Runs:      []TestDataRun{},
+	}
+
+	created, err := g.CreatePlaybooks(userID, options.TeamID, options.NumPlaybooks, rnd)
+	if err != nil {
+		return nil, err
+	}
+	result.Playbooks = append(result.Playbooks, created...)
+
+	numRuns := options.NumOngoingRuns + options.NumEndedRuns
+	if numRuns == 0 {
+		return result, nil
+	}
+
+	runPlaybooks := created
+	if len(runPlaybooks) == 0 {
+		runPlaybooks, err = g.getTeamPlaybooks(userID, options.TeamID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(runPlaybooks) == 0 {
+		created, err = g.CreatePlaybooks(userID, options.TeamID, len(testDataPlaybooks), rnd)
+		if err != nil {
+			return nil, err
+		}
+		result.Playbooks = append(result.Playbooks, created...)
+		runPlaybooks = created
+	}
+
+	endMillis := time.Now().Unix() * 1000
+	beginMillis := time.Now().AddDate(0, 0, -options.Days).Unix() * 1000
+
+	for i := 0; i < numRuns; i++ {
+		playbook := runPlaybooks[rnd.Intn(len(runPlaybooks))]
+
+		runName := testDataRunNames[rnd.Intn(len(testDataRunNames))]
+		// Give a company name to 1/3 of the playbook runs created
+		if rnd.Intn(3) == 0 {
+			companyName := testDataCompanyNames[rnd.Intn(len(testDataCompanyNames))]
+			runName = fmt.Sprintf("[%s] %s", companyName, runName)
+		}
+
+		run, err := g.playbookRunService.CreatePlaybookRun(
+			&PlaybookRun{
+				Name:                 runName,
+				OwnerUserID:          userID,
+				TeamID:               options.TeamID,
+				PlaybookID:           playbook.ID,
+				Checklists:           playbook.Checklists,
+				RetrospectiveEnabled: playbook.RetrospectiveEnabled,
+				StatusUpdateEnabled:  playbook.StatusUpdateEnabled,
+				Type:                 RunTypePlaybook,
+			},
+			&playbook,
+			userID,
+			true,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create playbook run")
+		}
+
+		createAt := rnd.Int63n(endMillis-beginMillis) + beginMillis
+		if err = g.playbookRunService.ChangeCreationDate(run.ID, timeutils.GetTimeForMillis(createAt)); err != nil {
+			return nil, errors.Wrap(err, "unable to change creation date")
+		}
+
+		ended := i < options.NumEndedRuns
+		if ended {
+			if err = g.playbookRunService.FinishPlaybookRun(run.ID, userID); err != nil {
+				return nil, errors.Wrap(err, "unable to end the playbook run")
+			}
+		}
+
+		result.Runs = append(result.Runs, TestDataRun{
+			ID:        run.ID,
+			Name:      run.Name,
+			ChannelID: run.ChannelID,
+			CreateAt:  createAt,
+			Ended:     ended,
+		})
+	}
+
+	return result, nil
+}
+
+func (g *TestDataGenerator) getTeamPlaybooks(userID, teamID string) ([]Playbook, error) {
+	requesterInfo := RequesterInfo{
+		UserID:  userID,
+		TeamID:  teamID,
+		IsAdmin: IsSystemAdmin(userID, g.api),
+	}
+
+	playbooksResult, err := g.playbookService.GetPlaybooksForTeam(requesterInfo, teamID, PlaybookFilterOptions{
+		Page:    0,
+		PerPage: PerPageDefault,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get playbooks")
+	}
+
+	teamPlaybooks := make([]Playbook, 0, len(playbooksResult.Items))
+	for _, thePlaybook := range playbooksResult.Items {
+		wholePlaybook, err := g.playbookService.Get(thePlaybook.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get playbook")
+		}
+
+		teamPlaybooks = append(teamPlaybooks, wholePlaybook)
+	}
+
+	return teamPlaybooks, nil
+}
+
+var testDataCompanyNames = []string{
+	"Dach Inc",
+	"Schuster LLC",
+	"Kirlin Group",
+	"Kohler Group",
+	"Ruelas S.L.",
+	"Armenta S.L.",
+	"Vega S.A.",
+	"Delarosa S.A.",
+	"Sarabia S.A.",
+	"Torp - Reilly",
+	"Heathcote Inc",
+	"Swift - Bruen",
+	"Stracke - Lemke",
+	"Shields LLC",
+	"Bruen Group",
+	"Senger - Stehr",
+	"Krogh - Eide",
+	"Andresen BA",
+	"Hagen - Holm",
+	"Martinsen BA",
+	"Holm BA",
+	"Berg BA",
+	"Fossum RFH",
+	"Nordskaug - Torp",
+	"Gran - Lunde",
+	"Nordby BA",
+	"Ryan Gruppen",
+	"Karlsson AB",
+	"Nilsson HB",
+	"Karlsson Group",
+	"Miller - Harber",
+	"Yost Group",
+	"Leuschke Group",
+	"Mertz Group",
+	"Welch LLC",
+	"Baumbach Group",
+	"Ward - Schmitt",
+	"Romaguera Group",
+	"Hickle - Kemmer",
+	"Stewart Corp",
+}
+
+var testDataRunNames = []string{
+	"Cluster servers are down",
+	"API performance degradation",
+	"Customers unable to login",
+	"Deployment failed",
+	"Build failed",
+	"Build timeout failure",
+	"Server is unresponsive",
+	"Server is crashing on start-up",
+	"MM crashes on start-up",
+	"Provider is down",
+	"Database is unresponsive",
+	"Database servers are down",
+	"Database replica lag",
+	"LDAP fails to sync",
+	"LDAP account unable to login",
+	"Broken MFA process",
+	"MFA fails to login users",
+	"UI is unresponsive",
+	"Security threat",
+	"Security breach",
+	"Customers data breach",
+	"SLA broken",
+	"MySQL max connections error",
+	"Postgres max connections error",
+	"Elastic Search unresponsive",
+	"Posts deleted",
+	"Mentions deleted",
+	"Replies deleted",
+	"Cloud server is down",
+	"Cloud deployment failed",
+	"Cloud provisioner is down",
+	"Cloud running out of memory",
+	"Unable to create new users",
+	"Installations in crashloop",
+	"Compliance report timeout",
+	"RN crash",
+	"RN out of memory",
+	"RN performance issues",
+	"MM fails to start",
+	"MM HA sync errors",
+}
+
+var testDataPlaybooks = []Playbook{
+	{
+		Title:       "Blank Playbook",
+		Description: "This is an example of an empty playbook",
+	},
+	{
+		Title:                "Test playbook",
+		RetrospectiveEnabled: true,
+		StatusUpdateEnabled:  true,
+		Checklists: []Checklist{
+			{
+				Title: "Identification",
+				Items: []ChecklistItem{
+					{
+						Title: "Create Jira ticket",
+					},
+					{
+						Title: "Add on-call team members",
+						State: ChecklistItemStateClosed,
+					},
+					{
+						Title: "Identify blast radius",
+					},
+					{
+						Title: "Identify impacted services",
+					},
+					{
+						Title: "Collect server data logs",
+					},
+					{
+						Title: "Identify blast Analyze data logs",
+					},
+				},
+			},
+			{
+				Title: "Resolution",
+				Items: []ChecklistItem{
+					{
+						Title: "Align on plan of attack",
+					},
+					{
+						Title: "Confirm resolution",
+					},
+				},
+			},
+			{
+				Title: "Analysis",
+				Items: []ChecklistItem{
+					{
+						Title: "Writeup root-cause analysis",
+					},
+					{
+						Title: "Review post-mortem",
+					},
+				},
+			},
+		},
+	},
+	{
+		Title:                "Release 2.4",
+		RetrospectiveEnabled: true,
+		StatusUpdateEnabled:  true,
+		Checklists: []Checklist{
+			{
+				Title: "Preparation",
+				Items: []ChecklistItem{
+					{
+						Title:   "Invite Feature Team to Channel",
+						Command: "/echo ''",
+					},
+					{
+						Title: "Acknowledge Alert",
+					},
+					{
+						Title:   "Get Alert Info",
+						Command: "/announce ~release-checklist",
+					},
+					{
+						Title:   "Invite Escalators",
+						Command: "/github mvp-2.4",
+					},
+					{
+						Title: "Determine Priority",
+					},
+					{
+						Title: "Update Alert Priority",
+					},
+				},
+			},
+			{
+				Title: "Meeting",
+				Items: []ChecklistItem{
+					{
+						Title: "Final Testing by QA",
+					},
+					{
+						Title: "Prepare Deployment Documentation",
+					},
+					{
+						Title: "Create New Alert for User",
+					},
+				},
+			},
+			{
+				Title: "Deployment",
+				Items: []ChecklistItem{
+					{
+						Title: "Database Backup",
+					},
+					{
+						Title: "Migrate New migration File",
+					},
+					{
+						Title: "Deploy Backend API",
+					},
+					{
+						Title: "Deploy Front-end",
+					},
+					{
+						Title: "Create new tag in gitlab",
+					},
+				},
+			},
+		},
+	},
+	{
+		Title:                "Incident #4281",
+		Description:          "There is an error when accessing message from deleted channel",
+		RetrospectiveEnabled: true,
+		StatusUpdateEnabled:  true,
+		Checklists: []Checklist{
+			{
+				Title: "Prepare the Jira card for this task",
+				Items: []ChecklistItem{
+					{
+						Title: "Create new Jira Card and fill the description",
+					},
+					{
+						Title: "Set someone to be asignee for this task",
+					},
+					{
+						Title: "Set story point for this card",
+					},
+				},
+			},
+			{
+				Title: "Resolve the issue",
+				Items: []ChecklistItem{
+					{
+						Title: "Check the root cause of the issue",
+					},
+					{
+						Title: "Fix the bug",
+					},
+					{
+						Title: "Testing the issue manually by programmer",
+					},
+				},
+			},
+			{
+				Title: "QA",
+				Items: []ChecklistItem{
+					{
+						Title: "Create several scenario testing",
+					},
+					{
+						Title: "Implement it using cypress",
+					},
+					{
+						Title: "Run the testing and check the result",
+					},
+				},
+			},
+			{
+				Title: "Deployment",
+				Items: []ChecklistItem{
+					{
+						Title: "Merge the result to branch 'master'",
+					},
+					{
+						Title: "Create new Merge Request",
+					},
+					{
+						Title: "Run deployment pipeline",
+					},
+					{
+						Title: "Test the result in production",
+					},
+				},
+			},
+		},
+	},
+	{
+		Title:                "Playbooks Playbook",
+		Description:          "Sample playbook",
+		RetrospectiveEnabled: true,
+		StatusUpdateEnabled:  true,
+		Checklists: []Checklist{
+			{
+				Title: "Triage",
+				Items: []ChecklistItem{
+					{
+						Title: "Announce incident type and resources",
+					},
+					{
+						Title: "Acknowledge alert",
+					},
+					{
+						Title: "Get alert info",
+					},
+					{
+						Title: "Invite escalators",
+					},
+					{
+						Title: "Determine priority",
+					},
+					{
+						Title: "Update alert priority",
+					},
+					{
+						Title: "Update alert priority",
+					},
+					{
+						Title:   "Create a JIRA ticket",
+						Command: "/jira create",
+					},
+					{
+						Title:   "Find out who’s on call",
+						Command: "/genie whoisoncall",
+					},
+					{
+						Title: "Announce incident",
+					},
+					{
+						Title: "Invite on-call lead",
+					},
+				},
+			}, {
+				Title: "Investigation",
+				Items: []ChecklistItem{
+					{
+						Title: "Perform initial investigation",
+					},
+					{
+						Title: "Escalate to other on-call members (optional)",
+					},
+					{
+						Title: "Escalate to other engineering teams (optional)",
+					},
+				},
+			}, {
+				Title: "Resolution",
+				Items: []ChecklistItem{
+					{
+						Title: "Close alert",
+					},
+					{
+						Title:   "End the incident",
+						Command: "/playbook end",
+					},
+					{
+						Title: "Schedule a post-mortem",
+					},
+					{
+						Title: "Record post-mortem action items",
+					},
+					{
+						Title: "Update playbook with learnings",
+					},
+					{
+						Title:   "Export channel message history",
+						Command: "/export",
+					},
+					{
+						Title: "Archive this channel",
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestDataOptionsValidate(t *testing.T) {
+	teamID := model.NewId()
+
+	tests := []struct {
+		name    string
+		options TestDataOptions
+		wantErr bool
+	}{
+		{"valid", TestDataOptions{TeamID: teamID, NumPlaybooks: 2, NumOngoingRuns: 5, NumEndedRuns: 5, Days: 30}, false},
+		{"playbooks only", TestDataOptions{TeamID: teamID, NumPlaybooks: MaxTestDataPlaybooks}, false},
+		{"missing team", TestDataOptions{NumOngoingRuns: 1, Days: 1}, true},
+		{"too many playbooks", TestDataOptions{TeamID: teamID, NumPlaybooks: MaxTestDataPlaybooks + 1}, true},
+		{"negative runs", TestDataOptions{TeamID: teamID, NumEndedRuns: -1, Days: 1}, true},
+		{"too many runs", TestDataOptions{TeamID: teamID, NumOngoingRuns: MaxTestDataRuns, NumEndedRuns: 1, Days: 1}, true},
+		{"runs without days", TestDataOptions{TeamID: teamID, NumOngoingRuns: 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.options.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
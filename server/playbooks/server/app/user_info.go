@@ -10,10 +10,15 @@ type DigestNotificationSettings struct {
 	DisableWeeklyDigest bool `json:"disable_weekly_digest"`
 }
 
+// TourState maps an onboarding tour category to the last step of that tour the user has reached.
+// It is stored as JSON in the sqlstore so that tours persist across devices.
+type TourState map[string]int
+
 type UserInfo struct {
 	ID                string
 	LastDailyTodoDMAt int64
 	DigestNotificationSettings
+	TourState TourState
 }
 
 type UserInfoStore interface {
@@ -5,7 +5,6 @@ package command
 
 import (
 	"fmt"
-	"math/rand"
 	"strconv"
 	"strings"
 	"time"
@@ -257,7 +256,7 @@ func (r *Runner) actionRun(args []string) {
 	requesterInfo := app.RequesterInfo{
 		UserID:  r.args.UserId,
 		TeamID:  r.args.TeamId,
-		IsAdmin: app.IsSystemAdmin(r.args.UserId, r.api),
+		IsAdmin: app.IsPlaybooksAdmin(r.args.UserId, r.api),
 	}
 
 	playbooksResults, err := r.playbookService.GetPlaybooksForTeam(requesterInfo, r.args.TeamId,
@@ -292,7 +291,7 @@ func (r *Runner) actionRunPlaybook(args []string) {
 	requesterInfo := app.RequesterInfo{
 		UserID:  r.args.UserId,
 		TeamID:  r.args.TeamId,
-		IsAdmin: app.IsSystemAdmin(r.args.UserId, r.api),
+		IsAdmin: app.IsPlaybooksAdmin(r.args.UserId, r.api),
 	}
 
 	// Using the GetPlaybooksForTeam so that requesterInfo and the expected security restrictions
@@ -1305,38 +1304,26 @@ func (r *Runner) actionTestGeneratePlaybooks(params []string) {
 		return
 	}
 
-	if numPlaybooks > 5 {
-		r.postCommandResponse("Maximum number of playbooks is 5")
+	if numPlaybooks > app.MaxTestDataPlaybooks {
+		r.postCommandResponse(fmt.Sprintf("Maximum number of playbooks is %d", app.MaxTestDataPlaybooks))
 		return
 	}
 
-	rand.Shuffle(len(dummyListPlaybooks), func(i, j int) {
-		dummyListPlaybooks[i], dummyListPlaybooks[j] = dummyListPlaybooks[j], dummyListPlaybooks[i]
+	generator := app.NewTestDataGenerator(r.playbookService, r.playbookRunService, r.api)
+	result, err := generator.Generate(r.args.UserId, app.TestDataOptions{
+		TeamID:       r.args.TeamId,
+		NumPlaybooks: numPlaybooks,
+		Seed:         time.Now().UnixNano(),
 	})
-
-	playbookIds := make([]string, 0, numPlaybooks)
-	for i := 0; i < numPlaybooks; i++ {
-		dummyPlaybook := dummyListPlaybooks[i]
-		dummyPlaybook.TeamID = r.args.TeamId
-		dummyPlaybook.Members = []app.PlaybookMember{
-			{
-				UserID: r.args.UserId,
-				Roles:  []string{app.PlaybookRoleMember, app.PlaybookRoleAdmin},
-			},
-		}
-		newPlaybookID, errCreatePlaybook := r.playbookService.Create(dummyPlaybook, r.args.UserId)
-		if errCreatePlaybook != nil {
-			r.warnUserAndLogErrorf("unable to create playbook: %v", err)
-			return
-		}
-
-		playbookIds = append(playbookIds, newPlaybookID)
+	if err != nil {
+		r.warnUserAndLogErrorf("unable to create playbooks: %v", err)
+		return
 	}
 
 	msg := "Playbooks successfully created"
-	for i, playbookID := range playbookIds {
-		url := fmt.Sprintf("/playbooks/playbooks/%s", playbookID)
-		msg += fmt.Sprintf("\n- [%s](%s)", dummyListPlaybooks[i].Title, url)
+	for _, playbook := range result.Playbooks {
+		url := fmt.Sprintf("/playbooks/playbooks/%s", playbook.ID)
+		msg += fmt.Sprintf("\n- [%s](%s)", playbook.Title, url)
 	}
 
 	r.postCommandResponse(msg)
@@ -1429,9 +1416,6 @@ func (r *Runner) actionTestData(params []string) {
 		return
 	}
 
-	begin := time.Now().AddDate(0, 0, -days)
-	end := time.Now()
-
 	seed := time.Now().Unix()
 	if len(params) > 3 {
 		parsedSeed, err := strconv.ParseInt(params[3], 10, 0)
@@ -1443,515 +1427,48 @@ func (r *Runner) actionTestData(params []string) {
 		seed = parsedSeed
 	}
 
-	r.generateTestData(ongoing, ended, begin, end, seed)
-}
-
-var fakeCompanyNames = []string{
-	"Dach Inc",
-	"Schuster LLC",
-	"Kirlin Group",
-	"Kohler Group",
-	"Ruelas S.L.",
-	"Armenta S.L.",
-	"Vega S.A.",
-	"Delarosa S.A.",
-	"Sarabia S.A.",
-	"Torp - Reilly",
-	"Heathcote Inc",
-	"Swift - Bruen",
-	"Stracke - Lemke",
-	"Shields LLC",
-	"Bruen Group",
-	"Senger - Stehr",
-	"Krogh - Eide",
-	"Andresen BA",
-	"Hagen - Holm",
-	"Martinsen BA",
-	"Holm BA",
-	"Berg BA",
-	"Fossum RFH",
-	"Nordskaug - Torp",
-	"Gran - Lunde",
-	"Nordby BA",
-	"Ryan Gruppen",
-	"Karlsson AB",
-	"Nilsson HB",
-	"Karlsson Group",
-	"Miller - Harber",
-	"Yost Group",
-	"Leuschke Group",
-	"Mertz Group",
-	"Welch LLC",
-	"Baumbach Group",
-	"Ward - Schmitt",
-	"Romaguera Group",
-	"Hickle - Kemmer",
-	"Stewart Corp",
-}
-
-var playbookRunNames = []string{
-	"Cluster servers are down",
-	"API performance degradation",
-	"Customers unable to login",
-	"Deployment failed",
-	"Build failed",
-	"Build timeout failure",
-	"Server is unresponsive",
-	"Server is crashing on start-up",
-	"MM crashes on start-up",
-	"Provider is down",
-	"Database is unresponsive",
-	"Database servers are down",
-	"Database replica lag",
-	"LDAP fails to sync",
-	"LDAP account unable to login",
-	"Broken MFA process",
-	"MFA fails to login users",
-	"UI is unresponsive",
-	"Security threat",
-	"Security breach",
-	"Customers data breach",
-	"SLA broken",
-	"MySQL max connections error",
-	"Postgres max connections error",
-	"Elastic Search unresponsive",
-	"Posts deleted",
-	"Mentions deleted",
-	"Replies deleted",
-	"Cloud server is down",
-	"Cloud deployment failed",
-	"Cloud provisioner is down",
-	"Cloud running out of memory",
-	"Unable to create new users",
-	"Installations in crashloop",
-	"Compliance report timeout",
-	"RN crash",
-	"RN out of memory",
-	"RN performance issues",
-	"MM fails to start",
-	"MM HA sync errors",
-}
-
-var dummyListPlaybooks = []app.Playbook{
-	{
-		Title:       "Blank Playbook",
-		Description: "This is an example of an empty playbook",
-	},
-	{
-		Title:                "Test playbook",
-		RetrospectiveEnabled: true,
-		StatusUpdateEnabled:  true,
-		Checklists: []app.Checklist{
-			{
-				Title: "Identification",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Create Jira ticket",
-					},
-					{
-						Title: "Add on-call team members",
-						State: app.ChecklistItemStateClosed,
-					},
-					{
-						Title: "Identify blast radius",
-					},
-					{
-						Title: "Identify impacted services",
-					},
-					{
-						Title: "Collect server data logs",
-					},
-					{
-						Title: "Identify blast Analyze data logs",
-					},
-				},
-			},
-			{
-				Title: "Resolution",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Align on plan of attack",
-					},
-					{
-						Title: "Confirm resolution",
-					},
-				},
-			},
-			{
-				Title: "Analysis",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Writeup root-cause analysis",
-					},
-					{
-						Title: "Review post-mortem",
-					},
-				},
-			},
-		},
-	},
-	{
-		Title:                "Release 2.4",
-		RetrospectiveEnabled: true,
-		StatusUpdateEnabled:  true,
-		Checklists: []app.Checklist{
-			{
-				Title: "Preparation",
-				Items: []app.ChecklistItem{
-					{
-						Title:   "Invite Feature Team to Channel",
-						Command: "/echo ''",
-					},
-					{
-						Title: "Acknowledge Alert",
-					},
-					{
-						Title:   "Get Alert Info",
-						Command: "/announce ~release-checklist",
-					},
-					{
-						Title:   "Invite Escalators",
-						Command: "/github mvp-2.4",
-					},
-					{
-						Title: "Determine Priority",
-					},
-					{
-						Title: "Update Alert Priority",
-					},
-				},
-			},
-			{
-				Title: "Meeting",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Final Testing by QA",
-					},
-					{
-						Title: "Prepare Deployment Documentation",
-					},
-					{
-						Title: "Create New Alert for User",
-					},
-				},
-			},
-			{
-				Title: "Deployment",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Database Backup",
-					},
-					{
-						Title: "Migrate New migration File",
-					},
-					{
-						Title: "Deploy Backend API",
-					},
-					{
-						Title: "Deploy Front-end",
-					},
-					{
-						Title: "Create new tag in gitlab",
-					},
-				},
-			},
-		},
-	},
-	{
-		Title:                "Incident #4281",
-		Description:          "There is an error when accessing message from deleted channel",
-		RetrospectiveEnabled: true,
-		StatusUpdateEnabled:  true,
-		Checklists: []app.Checklist{
-			{
-				Title: "Prepare the Jira card for this task",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Create new Jira Card and fill the description",
-					},
-					{
-						Title: "Set someone to be asignee for this task",
-					},
-					{
-						Title: "Set story point for this card",
-					},
-				},
-			},
-			{
-				Title: "Resolve the issue",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Check the root cause of the issue",
-					},
-					{
-						Title: "Fix the bug",
-					},
-					{
-						Title: "Testing the issue manually by programmer",
-					},
-				},
-			},
-			{
-				Title: "QA",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Create several scenario testing",
-					},
-					{
-						Title: "Implement it using cypress",
-					},
-					{
-						Title: "Run the testing and check the result",
-					},
-				},
-			},
-			{
-				Title: "Deployment",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Merge the result to branch 'master'",
-					},
-					{
-						Title: "Create new Merge Request",
-					},
-					{
-						Title: "Run deployment pipeline",
-					},
-					{
-						Title: "Test the result in production",
-					},
-				},
-			},
-		},
-	},
-	{
-		Title:                "Playbooks Playbook",
-		Description:          "Sample playbook",
-		RetrospectiveEnabled: true,
-		StatusUpdateEnabled:  true,
-		Checklists: []app.Checklist{
-			{
-				Title: "Triage",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Announce incident type and resources",
-					},
-					{
-						Title: "Acknowledge alert",
-					},
-					{
-						Title: "Get alert info",
-					},
-					{
-						Title: "Invite escalators",
-					},
-					{
-						Title: "Determine priority",
-					},
-					{
-						Title: "Update alert priority",
-					},
-					{
-						Title: "Update alert priority",
-					},
-					{
-						Title:   "Create a JIRA ticket",
-						Command: "/jira create",
-					},
-					{
-						Title:   "Find out who’s on call",
-						Command: "/genie whoisoncall",
-					},
-					{
-						Title: "Announce incident",
-					},
-					{
-						Title: "Invite on-call lead",
-					},
-				},
-			}, {
-				Title: "Investigation",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Perform initial investigation",
-					},
-					{
-						Title: "Escalate to other on-call members (optional)",
-					},
-					{
-						Title: "Escalate to other engineering teams (optional)",
-					},
-				},
-			}, {
-				Title: "Resolution",
-				Items: []app.ChecklistItem{
-					{
-						Title: "Close alert",
-					},
-					{
-						Title:   "End the incident",
-						Command: "/playbook end",
-					},
-					{
-						Title: "Schedule a post-mortem",
-					},
-					{
-						Title: "Record post-mortem action items",
-					},
-					{
-						Title: "Update playbook with learnings",
-					},
-					{
-						Title:   "Export channel message history",
-						Command: "/export",
-					},
-					{
-						Title: "Archive this channel",
-					},
-				},
-			},
-		},
-	},
+	r.generateTestData(ongoing, ended, days, seed)
 }
 
 // generateTestData generates `numActivePlaybookRuns` ongoing playbook runs and
 // `numEndedPlaybookRuns` ended playbook runs, whose creation timestamp lies randomly
-// between the `begin` and `end` timestamps.
+// within the last `days` days.
 // All playbook runs are created with a playbook randomly picked from the ones the
 // user is a member of, and the randomness is controlled by the `seed` parameter
 // to create reproducible results if needed.
-func (r *Runner) generateTestData(numActivePlaybookRuns, numEndedPlaybookRuns int, begin, end time.Time, seed int64) {
-	rand.Seed(seed)
-
-	beginMillis := begin.Unix() * 1000
-	endMillis := end.Unix() * 1000
-
-	numPlaybookRuns := numActivePlaybookRuns + numEndedPlaybookRuns
-
-	if numPlaybookRuns == 0 {
+func (r *Runner) generateTestData(numActivePlaybookRuns, numEndedPlaybookRuns, days int, seed int64) {
+	if numActivePlaybookRuns+numEndedPlaybookRuns == 0 {
 		r.postCommandResponse("Zero playbook runs created.")
 		return
 	}
 
-	timestamps := make([]int64, 0, numPlaybookRuns)
-	for i := 0; i < numPlaybookRuns; i++ {
-		timestamp := rand.Int63n(endMillis-beginMillis) + beginMillis
-		timestamps = append(timestamps, timestamp)
-	}
-
-	requesterInfo := app.RequesterInfo{
-		UserID:  r.args.UserId,
-		TeamID:  r.args.TeamId,
-		IsAdmin: app.IsSystemAdmin(r.args.UserId, r.api),
-	}
-
-	playbooksResult, err := r.playbookService.GetPlaybooksForTeam(requesterInfo, r.args.TeamId, app.PlaybookFilterOptions{
-		Page:    0,
-		PerPage: app.PerPageDefault,
+	generator := app.NewTestDataGenerator(r.playbookService, r.playbookRunService, r.api)
+	result, err := generator.Generate(r.args.UserId, app.TestDataOptions{
+		TeamID:         r.args.TeamId,
+		NumOngoingRuns: numActivePlaybookRuns,
+		NumEndedRuns:   numEndedPlaybookRuns,
+		Days:           days,
+		Seed:           seed,
 	})
 	if err != nil {
-		r.warnUserAndLogErrorf("Error getting playbooks: %v", err)
+		r.warnUserAndLogErrorf("Error generating test data: %v", err)
 		return
 	}
 
-	var playbooks []app.Playbook
-	if len(playbooksResult.Items) == 0 {
-		for _, dummyPlaybook := range dummyListPlaybooks {
-			dummyPlaybook.TeamID = r.args.TeamId
-			dummyPlaybook.Members = []app.PlaybookMember{
-				{
-					UserID: r.args.UserId,
-					Roles:  []string{app.PlaybookRoleMember, app.PlaybookRoleAdmin},
-				},
-			}
-			newPlaybookID, err := r.playbookService.Create(dummyPlaybook, r.args.UserId)
-			if err != nil {
-				r.warnUserAndLogErrorf("unable to create playbook: %v", err)
-				return
-			}
-
-			newPlaybook, err := r.playbookService.Get(newPlaybookID)
-			if err != nil {
-				r.warnUserAndLogErrorf("Error getting playbook: %v", err)
-				return
-			}
-
-			playbooks = append(playbooks, newPlaybook)
-		}
-	} else {
-		playbooks = make([]app.Playbook, 0, len(playbooksResult.Items))
-		for _, thePlaybook := range playbooksResult.Items {
-			wholePlaybook, err := r.playbookService.Get(thePlaybook.ID)
-			if err != nil {
-				r.warnUserAndLogErrorf("Error getting playbook: %v", err)
-				return
-			}
-
-			playbooks = append(playbooks, wholePlaybook)
-		}
-	}
-
 	tableMsg := "| Run name | Created at | Status |\n|-	|-	|-	|\n"
-	playbookRuns := make([]*app.PlaybookRun, 0, numPlaybookRuns)
-	for i := 0; i < numPlaybookRuns; i++ {
-		playbook := playbooks[rand.Intn(len(playbooks))]
-
-		playbookRunName := playbookRunNames[rand.Intn(len(playbookRunNames))]
-		// Give a company name to 1/3 of the playbook runs created
-		if rand.Intn(3) == 0 {
-			companyName := fakeCompanyNames[rand.Intn(len(fakeCompanyNames))]
-			playbookRunName = fmt.Sprintf("[%s] %s", companyName, playbookRunName)
-		}
-
-		playbookRun, err := r.playbookRunService.CreatePlaybookRun(
-			&app.PlaybookRun{
-				Name:                 playbookRunName,
-				OwnerUserID:          r.args.UserId,
-				TeamID:               r.args.TeamId,
-				PlaybookID:           playbook.ID,
-				Checklists:           playbook.Checklists,
-				RetrospectiveEnabled: playbook.RetrospectiveEnabled,
-				StatusUpdateEnabled:  playbook.StatusUpdateEnabled,
-				Type:                 app.RunTypePlaybook,
-			},
-			&playbook,
-			r.args.UserId,
-			true,
-		)
-
-		if err != nil {
-			r.warnUserAndLogErrorf("Error creating playbook run: %v", err)
-			return
-		}
-
-		createAt := timeutils.GetTimeForMillis(timestamps[i])
-		err = r.playbookRunService.ChangeCreationDate(playbookRun.ID, createAt)
-		if err != nil {
-			r.warnUserAndLogErrorf("Error changing creation date: %v", err)
-			return
-		}
-
-		channel, err := r.api.GetChannelByID(playbookRun.ChannelID)
+	for _, run := range result.Runs {
+		channel, err := r.api.GetChannelByID(run.ChannelID)
 		if err != nil {
 			r.warnUserAndLogErrorf("Error retrieveing playbook run's channel: %v", err)
 			return
 		}
 
-		status := "Ended"
-		if i >= numEndedPlaybookRuns {
-			status = "Ongoing"
+		status := "Ongoing"
+		if run.Ended {
+			status = "Ended"
 		}
+		createAt := timeutils.GetTimeForMillis(run.CreateAt)
 		tableMsg += fmt.Sprintf("|~%s|%s|%s|\n", channel.Name, createAt.Format("2006-01-02"), status)
-
-		playbookRuns = append(playbookRuns, playbookRun)
-	}
-
-	for i := 0; i < numEndedPlaybookRuns; i++ {
-		err := r.playbookRunService.FinishPlaybookRun(playbookRuns[i].ID, r.args.UserId)
-		if err != nil {
-			r.warnUserAndLogErrorf("Error ending the playbook run: %v", err)
-			return
-		}
 	}
 
 	r.postCommandResponse(fmt.Sprintf("The test data was successfully generated:\n\n%s\n", tableMsg))
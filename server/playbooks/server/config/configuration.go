@@ -21,6 +21,22 @@ type Configuration struct {
 	// EnableExperimentalFeatures determines if experimental features are enabled.
 	EnableExperimentalFeatures bool
 
+	// ShutdownDrainTimeoutSeconds is how long Playbooks waits for the in-flight API requests to
+	// complete when stopping. A default timeout is used when it is not positive.
+	ShutdownDrainTimeoutSeconds int
+
+	// TelemetryMode selects where the telemetry events are sent: one of TelemetryModeDefault,
+	// TelemetryModeCustom, TelemetryModeFile, TelemetryModeLocal or TelemetryModeDisabled.
+	TelemetryMode string
+
+	// TelemetryDataPlaneURL and TelemetryWriteKey identify the Rudder endpoint the events are
+	// sent to in TelemetryModeCustom.
+	TelemetryDataPlaneURL string
+	TelemetryWriteKey     string
+
+	// TelemetryFilePath is the file the events are appended to in TelemetryModeFile.
+	TelemetryFilePath string
+
 	// ** The following are NOT stored on the server
 	// AdminUserIDs contains a list of user IDs that are allowed
 	// to administer plugin functions, even if not Mattermost sysadmins.
@@ -36,6 +52,28 @@ type Configuration struct {
 	AdminLogVerbose bool
 }
 
+const (
+	// TelemetryModeDefault sends the events to the Rudder endpoint set at build time, if any, when
+	// diagnostics are enabled.
+	TelemetryModeDefault = ""
+
+	// TelemetryModeCustom sends the events to the configured Rudder endpoint when diagnostics are
+	// enabled.
+	TelemetryModeCustom = "custom"
+
+	// TelemetryModeFile appends the events to a local file, for diagnostics on air-gapped servers.
+	// The events never leave the server, so they are written even if diagnostics are disabled.
+	TelemetryModeFile = "file"
+
+	// TelemetryModeLocal records the events in the database instead of sending them, so that admins
+	// can audit them through the API before enabling Rudder. The events never leave the server, so
+	// they are recorded even if diagnostics are disabled.
+	TelemetryModeLocal = "local"
+
+	// TelemetryModeDisabled never sends the events.
+	TelemetryModeDisabled = "disabled"
+)
+
 // Clone shallow copies the configuration. Your implementation may require a deep copy if
 // your configuration has reference types.
 func (c *Configuration) Clone() *Configuration {
@@ -59,3 +59,24 @@ func (e *LicenseChecker) StatsAllowed() bool {
 func (e *LicenseChecker) ChecklistItemDueDateAllowed() bool {
 	return e.isAtLeastE10Licensed()
 }
+
+// MetricsAllowed returns true if the playbook and run metrics feature is allowed with the current license.
+func (e *LicenseChecker) MetricsAllowed() bool {
+	return e.isAtLeastE20Licensed()
+}
+
+// ChannelExportAllowed returns true if exporting a run's channel is allowed with the current license.
+func (e *LicenseChecker) ChannelExportAllowed() bool {
+	return e.isAtLeastE20Licensed()
+}
+
+// RequestUpdateAllowed returns true if requesting a status update is allowed with the current license.
+func (e *LicenseChecker) RequestUpdateAllowed() bool {
+	return e.isAtLeastE10Licensed()
+}
+
+// MultipleChecklistsAllowed returns true if playbooks and runs can have more than one checklist.
+// Multiple checklists are available with any license.
+func (e *LicenseChecker) MultipleChecklistsAllowed() bool {
+	return true
+}
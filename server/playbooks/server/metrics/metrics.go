@@ -17,6 +17,10 @@ const (
 	MetricsSubsystemSystem    = "system"
 
 	MetricsCloudInstallationLabel = "installationId"
+	MetricsSeverityLabel          = "severity"
+
+	// metricsNoSeverity is the severity label of the runs declared without a severity.
+	metricsNoSeverity = "none"
 )
 
 type InstanceInfo struct {
@@ -37,6 +41,9 @@ type Metrics struct {
 	runsFinishedCount      prometheus.Counter
 	errorsCount            prometheus.Counter
 
+	runsCreatedBySeverityCount  *prometheus.CounterVec
+	runsFinishedBySeverityCount *prometheus.CounterVec
+
 	playbooksActiveTotal      prometheus.Gauge
 	runsActiveTotal           prometheus.Gauge
 	remindersOutstandingTotal prometheus.Gauge
@@ -116,6 +123,24 @@ func NewMetrics(info InstanceInfo) *Metrics {
 	})
 	m.registry.MustRegister(m.runsFinishedCount)
 
+	m.runsCreatedBySeverityCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemRuns,
+		Name:        "runs_created_by_severity_count",
+		Help:        "Number of runs created since the last launch, by severity.",
+		ConstLabels: additionalLabels,
+	}, []string{MetricsSeverityLabel})
+	m.registry.MustRegister(m.runsCreatedBySeverityCount)
+
+	m.runsFinishedBySeverityCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemRuns,
+		Name:        "runs_finished_by_severity_count",
+		Help:        "Number of runs finished since the last launch, by severity.",
+		ConstLabels: additionalLabels,
+	}, []string{MetricsSeverityLabel})
+	m.registry.MustRegister(m.runsFinishedBySeverityCount)
+
 	m.errorsCount = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace:   MetricsNamespace,
 		Subsystem:   MetricsSubsystemSystem,
@@ -211,6 +236,18 @@ func (m *Metrics) IncrementRunsFinishedCount(num int) {
 	}
 }
 
+func (m *Metrics) IncrementRunsCreatedBySeverityCount(severity string, num int) {
+	if m != nil {
+		m.runsCreatedBySeverityCount.WithLabelValues(severityLabel(severity)).Add(float64(num))
+	}
+}
+
+func (m *Metrics) IncrementRunsFinishedBySeverityCount(severity string, num int) {
+	if m != nil {
+		m.runsFinishedBySeverityCount.WithLabelValues(severityLabel(severity)).Add(float64(num))
+	}
+}
+
 func (m *Metrics) IncrementErrorsCount(num int) {
 	if m != nil {
 		m.errorsCount.Add(float64(num))
@@ -252,3 +289,10 @@ func (m *Metrics) ObserveParticipantsActiveTotal(count int64) {
 		m.participantsActiveTotal.Set(float64(count))
 	}
 }
+
+func severityLabel(severity string) string {
+	if severity == "" {
+		return metricsNoSeverity
+	}
+	return severity
+}
@@ -5,6 +5,7 @@ package metrics
 
 import (
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -15,6 +16,8 @@ import (
 // Service prometheus to run the server.
 type Service struct {
 	*http.Server
+
+	running atomic.Bool
 }
 
 type ErrorLoggerWrapper struct {
@@ -27,7 +30,7 @@ func (el *ErrorLoggerWrapper) Println(v ...interface{}) {
 // NewMetricsServer factory method to create a new prometheus server.
 func NewMetricsServer(address string, metricsService *Metrics) *Service {
 	return &Service{
-		&http.Server{
+		Server: &http.Server{
 			ReadTimeout: 30 * time.Second,
 			Addr:        address,
 			Handler: promhttp.HandlerFor(metricsService.registry, promhttp.HandlerOpts{
@@ -39,9 +42,17 @@ func NewMetricsServer(address string, metricsService *Metrics) *Service {
 
 // Run will start the prometheus server.
 func (h *Service) Run() error {
+	h.running.Store(true)
+	defer h.running.Store(false)
+
 	return errors.Wrap(h.Server.ListenAndServe(), "prometheus ListenAndServe")
 }
 
+// IsRunning returns true while the prometheus server is serving.
+func (h *Service) IsRunning() bool {
+	return h.running.Load()
+}
+
 // Shutdown will shutdown the prometheus server.
 func (h *Service) Shutdown() error {
 	return errors.Wrap(h.Server.Close(), "prometheus Close")
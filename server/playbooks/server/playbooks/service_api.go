@@ -7,6 +7,7 @@ package playbooks
 
 import (
 	"database/sql"
+	"errors"
 
 	"github.com/gorilla/mux"
 
@@ -27,6 +28,25 @@ var PlaybooksBot = &mm_model.Bot{
 	OwnerId:     ownerID,
 }
 
+// ErrBoardsUnavailable is returned by the Boards service methods when the Boards product is not running.
+var ErrBoardsUnavailable = errors.New("boards product is unavailable")
+
+// BoardCard is the subset of a Boards card that Playbooks reads and writes.
+type BoardCard struct {
+	ID         string
+	BoardID    string
+	Title      string
+	ModifiedBy string
+	Properties map[string]any
+	DeleteAt   int64
+}
+
+// BoardCardPatch describes the changes to apply to a Boards card. Nil fields are left untouched.
+type BoardCardPatch struct {
+	Title             *string
+	UpdatedProperties map[string]any
+}
+
 type ServicesAPI interface {
 	// Channels service
 	GetDirectChannel(userID1, userID2 string) (*mm_model.Channel, error)
@@ -44,6 +64,7 @@ type ServicesAPI interface {
 	DeleteChannelMember(channelID, userID string) error
 	AddChannelMember(channelID, userID string) (*mm_model.ChannelMember, error)
 	GetDirectChannelOrCreate(userID1, userID2 string) (*mm_model.Channel, error)
+	PatchChannel(channelID string, patch *mm_model.ChannelPatch, userID string) (*mm_model.Channel, error)
 
 	// Post service
 	CreatePost(post *mm_model.Post) (*mm_model.Post, error)
@@ -66,6 +87,7 @@ type ServicesAPI interface {
 	GetGroup(groupID string) (*mm_model.Group, error)
 	GetTeam(teamID string) (*mm_model.Team, error)
 	GetGroupMemberUsers(groupID string, page, perPage int) ([]*mm_model.User, error)
+	GetGroupsByUserID(userID string) ([]*mm_model.Group, error)
 
 	// Permissions service
 	HasPermissionTo(userID string, permission *mm_model.Permission) bool
@@ -131,5 +153,17 @@ type ServicesAPI interface {
 	// Threads service
 	RegisterCollectionAndTopic(collectionType, topicType string) error
 
+	// Activity service
+	AddActivities(activities []*mm_model.Activity) error
+
+	// Boards service
+	GetBoardCard(cardID string) (*BoardCard, error)
+	CreateBoardCard(card *BoardCard, userID string) (*BoardCard, error)
+	PatchBoardCard(cardID string, patch *BoardCardPatch, userID string) (*BoardCard, error)
+	CanManageBoardCards(userID, boardID string) bool
+
+	// Plugins service
+	IsPluginActive(pluginID string) bool
+
 	IsEnterpriseReady() bool
 }
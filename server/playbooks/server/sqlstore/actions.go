@@ -162,6 +162,18 @@ func (c *channelActionStore) GetChannelActions(channelID string, options app.Get
 				Payload:                            categorizeChannelPayload,
 			}
 
+			actions = append(actions, action)
+		case app.ActionTypeSetChannelHeader:
+			var channelHeaderPayload app.ChannelHeaderPayload
+			if err := json.Unmarshal(sqlAction.Payload, &channelHeaderPayload); err != nil {
+				return nil, errors.Wrapf(err, fmt.Sprintf("unable to unmarshal payload for action with ID %q and type %q", sqlAction.ID, sqlAction.ActionType), channelID)
+			}
+
+			action := app.GenericChannelAction{
+				GenericChannelActionWithoutPayload: sqlAction.GenericChannelActionWithoutPayload,
+				Payload:                            channelHeaderPayload,
+			}
+
 			actions = append(actions, action)
 		}
 	}
@@ -19,6 +19,7 @@ type categoryStore struct {
 	queryBuilder       sq.StatementBuilderType
 	categorySelect     sq.SelectBuilder
 	categoryItemSelect sq.SelectBuilder
+	categoryRuleSelect sq.SelectBuilder
 }
 
 // Ensure playbookStore implements the playbook.Store interface.
@@ -45,12 +46,25 @@ func NewCategoryStore(pluginAPI PluginAPIClient, sqlStore *SQLStore) app.Categor
 		).
 		From("IR_Category_Item ci")
 
+	categoryRuleSelect := sqlStore.builder.
+		Select(
+			"cr.ID",
+			"cr.CategoryName",
+			"cr.PlaybookID",
+			"cr.Severity",
+			"cr.TeamID",
+			"cr.CreateAt",
+			"cr.UpdateAt",
+		).
+		From("IR_CategoryRule cr")
+
 	return &categoryStore{
 		pluginAPI:          pluginAPI,
 		store:              sqlStore,
 		queryBuilder:       sqlStore.builder,
 		categorySelect:     categorySelect,
 		categoryItemSelect: categoryItemSelect,
+		categoryRuleSelect: categoryRuleSelect,
 	}
 }
 
@@ -269,3 +283,83 @@ func (c *categoryStore) DeleteItemFromCategory(item app.CategoryItem, categoryID
 	}
 	return nil
 }
+
+// CreateRule creates a new category rule
+func (c *categoryStore) CreateRule(rule app.CategoryRule) error {
+	if _, err := c.store.execBuilder(c.store.db, sq.
+		Insert("IR_CategoryRule").
+		SetMap(map[string]interface{}{
+			"ID":           rule.ID,
+			"CategoryName": rule.CategoryName,
+			"PlaybookID":   rule.PlaybookID,
+			"Severity":     rule.Severity,
+			"TeamID":       rule.TeamID,
+			"CreateAt":     rule.CreateAt,
+			"UpdateAt":     rule.UpdateAt,
+		})); err != nil {
+		return errors.Wrap(err, "failed to store new category rule")
+	}
+
+	return nil
+}
+
+// GetRule retrieves a category rule. Returns ErrNotFound if not found.
+func (c *categoryStore) GetRule(ruleID string) (app.CategoryRule, error) {
+	if !model.IsValidId(ruleID) {
+		return app.CategoryRule{}, errors.New("ID is not valid")
+	}
+
+	var rule app.CategoryRule
+	err := c.store.getBuilder(c.store.db, &rule, c.categoryRuleSelect.Where(sq.Eq{"cr.ID": ruleID}))
+	if err == sql.ErrNoRows {
+		return app.CategoryRule{}, errors.Wrapf(app.ErrNotFound, "category rule does not exist for id %q", ruleID)
+	} else if err != nil {
+		return app.CategoryRule{}, errors.Wrapf(err, "failed to get category rule by id %q", ruleID)
+	}
+
+	return rule, nil
+}
+
+// GetRules retrieves all category rules, oldest first
+func (c *categoryStore) GetRules() ([]app.CategoryRule, error) {
+	rules := []app.CategoryRule{}
+	err := c.store.selectBuilder(c.store.db, &rules, c.categoryRuleSelect.OrderBy("cr.CreateAt ASC", "cr.ID ASC"))
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.Wrap(err, "failed to get category rules")
+	}
+
+	return rules, nil
+}
+
+// UpdateRule updates a category rule
+func (c *categoryStore) UpdateRule(rule app.CategoryRule) error {
+	result, err := c.store.execBuilder(c.store.db, sq.
+		Update("IR_CategoryRule").
+		SetMap(map[string]interface{}{
+			"CategoryName": rule.CategoryName,
+			"PlaybookID":   rule.PlaybookID,
+			"Severity":     rule.Severity,
+			"TeamID":       rule.TeamID,
+			"UpdateAt":     rule.UpdateAt,
+		}).
+		Where(sq.Eq{"ID": rule.ID}))
+	if err != nil {
+		return errors.Wrapf(err, "failed to update category rule with id '%s'", rule.ID)
+	}
+
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return errors.Wrapf(app.ErrNotFound, "category rule does not exist for id %q", rule.ID)
+	}
+
+	return nil
+}
+
+// DeleteRule deletes a category rule
+func (c *categoryStore) DeleteRule(ruleID string) error {
+	if _, err := c.store.execBuilder(c.store.db, sq.
+		Delete("IR_CategoryRule").
+		Where(sq.Eq{"ID": ruleID})); err != nil {
+		return errors.Wrapf(err, "failed to delete category rule with id '%s'", ruleID)
+	}
+	return nil
+}
@@ -2474,4 +2474,353 @@ var migrations = []Migration{
 			return nil
 		},
 	},
+	{
+		fromVersion: semver.MustParse("0.63.0"),
+		toVersion:   semver.MustParse("0.64.0"),
+		migrationFunc: func(e sqlx.Ext, sqlStore *SQLStore) error {
+			if e.DriverName() == model.DatabaseDriverMysql {
+				if err := addColumnToMySQLTable(e, "IR_UserInfo", "TourStateJSON", "JSON"); err != nil {
+					return errors.Wrapf(err, "failed adding column TourStateJSON to table IR_UserInfo")
+				}
+			} else {
+				if err := addColumnToPGTable(e, "IR_UserInfo", "TourStateJSON", "JSON"); err != nil {
+					return errors.Wrapf(err, "failed adding column TourStateJSON to table IR_UserInfo")
+				}
+			}
+			return nil
+		},
+	},
+	{
+		fromVersion: semver.MustParse("0.64.0"),
+		toVersion:   semver.MustParse("0.65.0"),
+		migrationFunc: func(e sqlx.Ext, sqlStore *SQLStore) error {
+			if e.DriverName() == model.DatabaseDriverMysql {
+				if err := addColumnToMySQLTable(e, "IR_Playbook", "BoardSyncJSON", "JSON"); err != nil {
+					return errors.Wrapf(err, "failed adding column BoardSyncJSON to table IR_Playbook")
+				}
+				if err := addColumnToMySQLTable(e, "IR_Incident", "BoardID", "VARCHAR(26) DEFAULT ''"); err != nil {
+					return errors.Wrapf(err, "failed adding column BoardID to table IR_Incident")
+				}
+				if err := addColumnToMySQLTable(e, "IR_Incident", "BoardCardID", "VARCHAR(26) DEFAULT ''"); err != nil {
+					return errors.Wrapf(err, "failed adding column BoardCardID to table IR_Incident")
+				}
+			} else {
+				if err := addColumnToPGTable(e, "IR_Playbook", "BoardSyncJSON", "JSON"); err != nil {
+					return errors.Wrapf(err, "failed adding column BoardSyncJSON to table IR_Playbook")
+				}
+				if err := addColumnToPGTable(e, "IR_Incident", "BoardID", "VARCHAR(26) DEFAULT ''"); err != nil {
+					return errors.Wrapf(err, "failed adding column BoardID to table IR_Incident")
+				}
+				if err := addColumnToPGTable(e, "IR_Incident", "BoardCardID", "VARCHAR(26) DEFAULT ''"); err != nil {
+					return errors.Wrapf(err, "failed adding column BoardCardID to table IR_Incident")
+				}
+			}
+			return nil
+		},
+	},
+	{
+		fromVersion: semver.MustParse("0.65.0"),
+		toVersion:   semver.MustParse("0.66.0"),
+		migrationFunc: func(e sqlx.Ext, sqlStore *SQLStore) error {
+			if e.DriverName() == model.DatabaseDriverMysql {
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_PropertyField (
+						ID VARCHAR(26) PRIMARY KEY,
+						PlaybookID VARCHAR(26) NOT NULL REFERENCES IR_Playbook(ID),
+						Name VARCHAR(128) NOT NULL,
+						Type VARCHAR(32) NOT NULL,
+						OptionsJSON JSON,
+						Ordering TINYINT NOT NULL DEFAULT 0,
+						DeleteAt BIGINT NOT NULL DEFAULT 0,
+						INDEX IR_PropertyField_PlaybookID (PlaybookID)
+					)
+				` + MySQLCharset); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_PropertyField")
+				}
+
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_PropertyValue (
+						IncidentID VARCHAR(26) NOT NULL REFERENCES IR_Incident(ID),
+						FieldID VARCHAR(26) NOT NULL REFERENCES IR_PropertyField(ID),
+						Value VARCHAR(1024) NOT NULL,
+						UpdateAt BIGINT NOT NULL DEFAULT 0,
+						PRIMARY KEY (IncidentID, FieldID),
+						INDEX IR_PropertyValue_FieldID_Value (FieldID, Value(64))
+					)
+				` + MySQLCharset); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_PropertyValue")
+				}
+			} else {
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_PropertyField (
+						ID TEXT PRIMARY KEY,
+						PlaybookID TEXT NOT NULL REFERENCES IR_Playbook(ID),
+						Name TEXT NOT NULL,
+						Type TEXT NOT NULL,
+						OptionsJSON JSON,
+						Ordering SMALLINT NOT NULL DEFAULT 0,
+						DeleteAt BIGINT NOT NULL DEFAULT 0
+					)
+				`); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_PropertyField")
+				}
+
+				if _, err := e.Exec(createPGIndex("IR_PropertyField_PlaybookID", "IR_PropertyField", "PlaybookID")); err != nil {
+					return errors.Wrapf(err, "failed creating index IR_PropertyField_PlaybookID")
+				}
+
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_PropertyValue (
+						IncidentID TEXT NOT NULL REFERENCES IR_Incident(ID),
+						FieldID TEXT NOT NULL REFERENCES IR_PropertyField(ID),
+						Value TEXT NOT NULL,
+						UpdateAt BIGINT NOT NULL DEFAULT 0,
+						PRIMARY KEY (IncidentID, FieldID)
+					)
+				`); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_PropertyValue")
+				}
+
+				if _, err := e.Exec(createPGIndex("IR_PropertyValue_FieldID_Value", "IR_PropertyValue", "FieldID, Value")); err != nil {
+					return errors.Wrapf(err, "failed creating index IR_PropertyValue_FieldID_Value")
+				}
+			}
+			return nil
+		},
+	},
+	{
+		fromVersion: semver.MustParse("0.66.0"),
+		toVersion:   semver.MustParse("0.67.0"),
+		migrationFunc: func(e sqlx.Ext, sqlStore *SQLStore) error {
+			if e.DriverName() == model.DatabaseDriverMysql {
+				if err := addColumnToMySQLTable(e, "IR_Playbook", "SeverityLevelsJSON", "JSON"); err != nil {
+					return errors.Wrapf(err, "failed adding column SeverityLevelsJSON to table IR_Playbook")
+				}
+				if err := addColumnToMySQLTable(e, "IR_Incident", "Severity", "VARCHAR(64) DEFAULT ''"); err != nil {
+					return errors.Wrapf(err, "failed adding column Severity to table IR_Incident")
+				}
+			} else {
+				if err := addColumnToPGTable(e, "IR_Playbook", "SeverityLevelsJSON", "JSON"); err != nil {
+					return errors.Wrapf(err, "failed adding column SeverityLevelsJSON to table IR_Playbook")
+				}
+				if err := addColumnToPGTable(e, "IR_Incident", "Severity", "VARCHAR(64) DEFAULT ''"); err != nil {
+					return errors.Wrapf(err, "failed adding column Severity to table IR_Incident")
+				}
+			}
+			return nil
+		},
+	},
+	{
+		fromVersion: semver.MustParse("0.67.0"),
+		toVersion:   semver.MustParse("0.68.0"),
+		migrationFunc: func(e sqlx.Ext, sqlStore *SQLStore) error {
+			if e.DriverName() == model.DatabaseDriverMysql {
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_RunLink (
+						FromIncidentID VARCHAR(26) NOT NULL REFERENCES IR_Incident(ID),
+						ToIncidentID VARCHAR(26) NOT NULL REFERENCES IR_Incident(ID),
+						Type VARCHAR(32) NOT NULL,
+						CreateAt BIGINT NOT NULL DEFAULT 0,
+						PRIMARY KEY (FromIncidentID, ToIncidentID),
+						INDEX IR_RunLink_ToIncidentID (ToIncidentID)
+					)
+				` + MySQLCharset); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_RunLink")
+				}
+			} else {
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_RunLink (
+						FromIncidentID TEXT NOT NULL REFERENCES IR_Incident(ID),
+						ToIncidentID TEXT NOT NULL REFERENCES IR_Incident(ID),
+						Type TEXT NOT NULL,
+						CreateAt BIGINT NOT NULL DEFAULT 0,
+						PRIMARY KEY (FromIncidentID, ToIncidentID)
+					)
+				`); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_RunLink")
+				}
+
+				if _, err := e.Exec(createPGIndex("IR_RunLink_ToIncidentID", "IR_RunLink", "ToIncidentID")); err != nil {
+					return errors.Wrapf(err, "failed creating index IR_RunLink_ToIncidentID")
+				}
+			}
+			return nil
+		},
+	},
+	{
+		fromVersion: semver.MustParse("0.68.0"),
+		toVersion:   semver.MustParse("0.69.0"),
+		migrationFunc: func(e sqlx.Ext, sqlStore *SQLStore) error {
+			if e.DriverName() == model.DatabaseDriverMysql {
+				if err := addColumnToMySQLTable(e, "IR_Playbook", "FollowUpReminderEnabled", "BOOLEAN DEFAULT FALSE"); err != nil {
+					return errors.Wrapf(err, "failed adding column FollowUpReminderEnabled to table IR_Playbook")
+				}
+				if err := addColumnToMySQLTable(e, "IR_Playbook", "FollowUpReminderDelaySeconds", "BIGINT DEFAULT 0"); err != nil {
+					return errors.Wrapf(err, "failed adding column FollowUpReminderDelaySeconds to table IR_Playbook")
+				}
+				if err := addColumnToMySQLTable(e, "IR_Playbook", "FollowUpReminderMessage", "TEXT"); err != nil {
+					return errors.Wrapf(err, "failed adding column FollowUpReminderMessage to table IR_Playbook")
+				}
+				if err := addColumnToMySQLTable(e, "IR_Incident", "FollowUpReminderDelaySeconds", "BIGINT DEFAULT 0"); err != nil {
+					return errors.Wrapf(err, "failed adding column FollowUpReminderDelaySeconds to table IR_Incident")
+				}
+				if err := addColumnToMySQLTable(e, "IR_Incident", "FollowUpReminderMessage", "TEXT"); err != nil {
+					return errors.Wrapf(err, "failed adding column FollowUpReminderMessage to table IR_Incident")
+				}
+			} else {
+				if err := addColumnToPGTable(e, "IR_Playbook", "FollowUpReminderEnabled", "BOOLEAN DEFAULT FALSE"); err != nil {
+					return errors.Wrapf(err, "failed adding column FollowUpReminderEnabled to table IR_Playbook")
+				}
+				if err := addColumnToPGTable(e, "IR_Playbook", "FollowUpReminderDelaySeconds", "BIGINT DEFAULT 0"); err != nil {
+					return errors.Wrapf(err, "failed adding column FollowUpReminderDelaySeconds to table IR_Playbook")
+				}
+				if err := addColumnToPGTable(e, "IR_Playbook", "FollowUpReminderMessage", "TEXT"); err != nil {
+					return errors.Wrapf(err, "failed adding column FollowUpReminderMessage to table IR_Playbook")
+				}
+				if err := addColumnToPGTable(e, "IR_Incident", "FollowUpReminderDelaySeconds", "BIGINT DEFAULT 0"); err != nil {
+					return errors.Wrapf(err, "failed adding column FollowUpReminderDelaySeconds to table IR_Incident")
+				}
+				if err := addColumnToPGTable(e, "IR_Incident", "FollowUpReminderMessage", "TEXT"); err != nil {
+					return errors.Wrapf(err, "failed adding column FollowUpReminderMessage to table IR_Incident")
+				}
+			}
+			return nil
+		},
+	},
+	{
+		fromVersion: semver.MustParse("0.69.0"),
+		toVersion:   semver.MustParse("0.70.0"),
+		migrationFunc: func(e sqlx.Ext, sqlStore *SQLStore) error {
+			if e.DriverName() == model.DatabaseDriverMysql {
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_CategoryRule (
+						ID VARCHAR(26) PRIMARY KEY,
+						CategoryName VARCHAR(512) NOT NULL,
+						PlaybookID VARCHAR(26) NOT NULL DEFAULT '',
+						Severity VARCHAR(64) NOT NULL DEFAULT '',
+						TeamID VARCHAR(26) NOT NULL DEFAULT '',
+						CreateAt BIGINT NOT NULL DEFAULT 0,
+						UpdateAt BIGINT NOT NULL DEFAULT 0
+					)
+				` + MySQLCharset); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_CategoryRule")
+				}
+			} else {
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_CategoryRule (
+						ID TEXT PRIMARY KEY,
+						CategoryName TEXT NOT NULL,
+						PlaybookID TEXT NOT NULL DEFAULT '',
+						Severity TEXT NOT NULL DEFAULT '',
+						TeamID TEXT NOT NULL DEFAULT '',
+						CreateAt BIGINT NOT NULL DEFAULT 0,
+						UpdateAt BIGINT NOT NULL DEFAULT 0
+					)
+				`); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_CategoryRule")
+				}
+			}
+			return nil
+		},
+	},
+	{
+		fromVersion: semver.MustParse("0.70.0"),
+		toVersion:   semver.MustParse("0.71.0"),
+		migrationFunc: func(e sqlx.Ext, sqlStore *SQLStore) error {
+			if e.DriverName() == model.DatabaseDriverMysql {
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_TelemetryEvent (
+						ID VARCHAR(26) PRIMARY KEY,
+						Type VARCHAR(32) NOT NULL DEFAULT '',
+						Name VARCHAR(256) NOT NULL DEFAULT '',
+						UserID VARCHAR(26) NOT NULL DEFAULT '',
+						PropertiesJSON TEXT,
+						CreateAt BIGINT NOT NULL DEFAULT 0,
+						INDEX IR_TelemetryEvent_CreateAt (CreateAt)
+					)
+				` + MySQLCharset); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_TelemetryEvent")
+				}
+			} else {
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_TelemetryEvent (
+						ID TEXT PRIMARY KEY,
+						Type TEXT NOT NULL DEFAULT '',
+						Name TEXT NOT NULL DEFAULT '',
+						UserID TEXT NOT NULL DEFAULT '',
+						PropertiesJSON TEXT,
+						CreateAt BIGINT NOT NULL DEFAULT 0
+					)
+				`); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_TelemetryEvent")
+				}
+
+				if _, err := e.Exec(createPGIndex("IR_TelemetryEvent_CreateAt", "IR_TelemetryEvent", "CreateAt")); err != nil {
+					return errors.Wrapf(err, "failed creating index IR_TelemetryEvent_CreateAt")
+				}
+			}
+			return nil
+		},
+	},
+	{
+		fromVersion: semver.MustParse("0.71.0"),
+		toVersion:   semver.MustParse("0.72.0"),
+		migrationFunc: func(e sqlx.Ext, sqlStore *SQLStore) error {
+			if e.DriverName() == model.DatabaseDriverMysql {
+				if err := addColumnToMySQLTable(e, "IR_Playbook", "SyncInvitedGroupsEnabled", "BOOLEAN DEFAULT FALSE"); err != nil {
+					return errors.Wrapf(err, "failed adding column SyncInvitedGroupsEnabled to table IR_Playbook")
+				}
+				if err := addColumnToMySQLTable(e, "IR_Incident", "SyncInvitedGroups", "BOOLEAN DEFAULT FALSE"); err != nil {
+					return errors.Wrapf(err, "failed adding column SyncInvitedGroups to table IR_Incident")
+				}
+				if err := addColumnToMySQLTable(e, "IR_Incident", "ConcatenatedSyncedGroupMemberIDs", "TEXT"); err != nil {
+					return errors.Wrapf(err, "failed adding column ConcatenatedSyncedGroupMemberIDs to table IR_Incident")
+				}
+			} else {
+				if err := addColumnToPGTable(e, "IR_Playbook", "SyncInvitedGroupsEnabled", "BOOLEAN DEFAULT FALSE"); err != nil {
+					return errors.Wrapf(err, "failed adding column SyncInvitedGroupsEnabled to table IR_Playbook")
+				}
+				if err := addColumnToPGTable(e, "IR_Incident", "SyncInvitedGroups", "BOOLEAN DEFAULT FALSE"); err != nil {
+					return errors.Wrapf(err, "failed adding column SyncInvitedGroups to table IR_Incident")
+				}
+				if err := addColumnToPGTable(e, "IR_Incident", "ConcatenatedSyncedGroupMemberIDs", "TEXT"); err != nil {
+					return errors.Wrapf(err, "failed adding column ConcatenatedSyncedGroupMemberIDs to table IR_Incident")
+				}
+			}
+			return nil
+		},
+	},
+	{
+		fromVersion: semver.MustParse("0.72.0"),
+		toVersion:   semver.MustParse("0.73.0"),
+		migrationFunc: func(e sqlx.Ext, sqlStore *SQLStore) error {
+			if e.DriverName() == model.DatabaseDriverMysql {
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_PlaybookMemberGroup (
+						PlaybookID VARCHAR(26) NOT NULL,
+						GroupID VARCHAR(26) NOT NULL,
+						PRIMARY KEY (PlaybookID, GroupID),
+						INDEX IR_PlaybookMemberGroup_GroupID (GroupID)
+					)
+				` + MySQLCharset); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_PlaybookMemberGroup")
+				}
+			} else {
+				if _, err := e.Exec(`
+					CREATE TABLE IF NOT EXISTS IR_PlaybookMemberGroup (
+						PlaybookID TEXT NOT NULL,
+						GroupID TEXT NOT NULL,
+						PRIMARY KEY (PlaybookID, GroupID)
+					)
+				`); err != nil {
+					return errors.Wrapf(err, "failed creating table IR_PlaybookMemberGroup")
+				}
+
+				if _, err := e.Exec(createPGIndex("IR_PlaybookMemberGroup_GroupID", "IR_PlaybookMemberGroup", "GroupID")); err != nil {
+					return errors.Wrapf(err, "failed creating index IR_PlaybookMemberGroup_GroupID")
+				}
+			}
+			return nil
+		},
+	},
 }
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'IR_UserInfo'
+        AND table_schema = DATABASE()
+        AND column_name = 'TourStateJSON'
+    ),
+    'ALTER TABLE IR_UserInfo DROP COLUMN TourStateJSON;',
+    'SELECT 1;'
+));
+
+PREPARE dropColumnIfExists FROM @preparedStatement;
+EXECUTE dropColumnIfExists;
+DEALLOCATE PREPARE dropColumnIfExists;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS IR_PropertyField (
+    ID VARCHAR(26) PRIMARY KEY,
+    PlaybookID VARCHAR(26) NOT NULL REFERENCES IR_Playbook(ID),
+    Name VARCHAR(128) NOT NULL,
+    Type VARCHAR(32) NOT NULL,
+    OptionsJSON JSON,
+    Ordering TINYINT NOT NULL DEFAULT 0,
+    DeleteAt BIGINT NOT NULL DEFAULT 0,
+    INDEX IR_PropertyField_PlaybookID (PlaybookID)
+) DEFAULT CHARACTER SET utf8mb4;
+
+CREATE TABLE IF NOT EXISTS IR_PropertyValue (
+    IncidentID VARCHAR(26) NOT NULL REFERENCES IR_Incident(ID),
+    FieldID VARCHAR(26) NOT NULL REFERENCES IR_PropertyField(ID),
+    Value VARCHAR(1024) NOT NULL,
+    UpdateAt BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (IncidentID, FieldID),
+    INDEX IR_PropertyValue_FieldID_Value (FieldID, Value(64))
+) DEFAULT CHARACTER SET utf8mb4;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS IR_RunLink (
+    FromIncidentID VARCHAR(26) NOT NULL REFERENCES IR_Incident(ID),
+    ToIncidentID VARCHAR(26) NOT NULL REFERENCES IR_Incident(ID),
+    Type VARCHAR(32) NOT NULL,
+    CreateAt BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (FromIncidentID, ToIncidentID),
+    INDEX IR_RunLink_ToIncidentID (ToIncidentID)
+) DEFAULT CHARACTER SET utf8mb4;
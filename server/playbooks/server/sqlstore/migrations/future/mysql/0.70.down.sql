@@ -0,0 +1 @@
+DROP TABLE IF EXISTS IR_CategoryRule;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS IR_CategoryRule (
+    ID VARCHAR(26) PRIMARY KEY,
+    CategoryName VARCHAR(512) NOT NULL,
+    PlaybookID VARCHAR(26) NOT NULL DEFAULT '',
+    Severity VARCHAR(64) NOT NULL DEFAULT '',
+    TeamID VARCHAR(26) NOT NULL DEFAULT '',
+    CreateAt BIGINT NOT NULL DEFAULT 0,
+    UpdateAt BIGINT NOT NULL DEFAULT 0
+) DEFAULT CHARACTER SET utf8mb4;
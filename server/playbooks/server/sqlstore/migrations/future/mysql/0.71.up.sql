@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS IR_TelemetryEvent (
+    ID VARCHAR(26) PRIMARY KEY,
+    Type VARCHAR(32) NOT NULL DEFAULT '',
+    Name VARCHAR(256) NOT NULL DEFAULT '',
+    UserID VARCHAR(26) NOT NULL DEFAULT '',
+    PropertiesJSON TEXT,
+    CreateAt BIGINT NOT NULL DEFAULT 0,
+    INDEX IR_TelemetryEvent_CreateAt (CreateAt)
+) DEFAULT CHARACTER SET utf8mb4;
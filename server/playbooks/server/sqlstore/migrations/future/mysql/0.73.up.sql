@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS IR_PlaybookMemberGroup (
+    PlaybookID VARCHAR(26) NOT NULL,
+    GroupID VARCHAR(26) NOT NULL,
+    PRIMARY KEY (PlaybookID, GroupID),
+    INDEX IR_PlaybookMemberGroup_GroupID (GroupID)
+) DEFAULT CHARACTER SET utf8mb4;
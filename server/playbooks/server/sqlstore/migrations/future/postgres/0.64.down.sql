@@ -0,0 +1 @@
+ALTER TABLE IR_UserInfo DROP COLUMN IF EXISTS TourStateJSON;
@@ -0,0 +1 @@
+ALTER TABLE IR_UserInfo ADD COLUMN IF NOT EXISTS TourStateJSON JSON;
@@ -0,0 +1,3 @@
+ALTER TABLE IR_Playbook DROP COLUMN IF EXISTS BoardSyncJSON;
+ALTER TABLE IR_Incident DROP COLUMN IF EXISTS BoardID;
+ALTER TABLE IR_Incident DROP COLUMN IF EXISTS BoardCardID;
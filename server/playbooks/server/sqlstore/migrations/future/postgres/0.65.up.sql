@@ -0,0 +1,3 @@
+ALTER TABLE IR_Playbook ADD COLUMN IF NOT EXISTS BoardSyncJSON JSON;
+ALTER TABLE IR_Incident ADD COLUMN IF NOT EXISTS BoardID VARCHAR(26) DEFAULT '';
+ALTER TABLE IR_Incident ADD COLUMN IF NOT EXISTS BoardCardID VARCHAR(26) DEFAULT '';
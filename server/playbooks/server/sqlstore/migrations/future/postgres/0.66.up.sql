@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS IR_PropertyField (
+    ID TEXT PRIMARY KEY,
+    PlaybookID TEXT NOT NULL REFERENCES IR_Playbook(ID),
+    Name TEXT NOT NULL,
+    Type TEXT NOT NULL,
+    OptionsJSON JSON,
+    Ordering SMALLINT NOT NULL DEFAULT 0,
+    DeleteAt BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS IR_PropertyField_PlaybookID ON IR_PropertyField (PlaybookID);
+
+CREATE TABLE IF NOT EXISTS IR_PropertyValue (
+    IncidentID TEXT NOT NULL REFERENCES IR_Incident(ID),
+    FieldID TEXT NOT NULL REFERENCES IR_PropertyField(ID),
+    Value TEXT NOT NULL,
+    UpdateAt BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (IncidentID, FieldID)
+);
+
+CREATE INDEX IF NOT EXISTS IR_PropertyValue_FieldID_Value ON IR_PropertyValue (FieldID, Value);
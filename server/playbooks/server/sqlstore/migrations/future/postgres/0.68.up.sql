@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS IR_RunLink (
+    FromIncidentID TEXT NOT NULL REFERENCES IR_Incident(ID),
+    ToIncidentID TEXT NOT NULL REFERENCES IR_Incident(ID),
+    Type TEXT NOT NULL,
+    CreateAt BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (FromIncidentID, ToIncidentID)
+);
+
+CREATE INDEX IF NOT EXISTS IR_RunLink_ToIncidentID ON IR_RunLink (ToIncidentID);
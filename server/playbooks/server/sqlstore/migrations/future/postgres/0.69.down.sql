@@ -0,0 +1,5 @@
+ALTER TABLE IR_Playbook DROP COLUMN IF EXISTS FollowUpReminderEnabled;
+ALTER TABLE IR_Playbook DROP COLUMN IF EXISTS FollowUpReminderDelaySeconds;
+ALTER TABLE IR_Playbook DROP COLUMN IF EXISTS FollowUpReminderMessage;
+ALTER TABLE IR_Incident DROP COLUMN IF EXISTS FollowUpReminderDelaySeconds;
+ALTER TABLE IR_Incident DROP COLUMN IF EXISTS FollowUpReminderMessage;
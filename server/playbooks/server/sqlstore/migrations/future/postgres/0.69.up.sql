@@ -0,0 +1,5 @@
+ALTER TABLE IR_Playbook ADD COLUMN IF NOT EXISTS FollowUpReminderEnabled BOOLEAN DEFAULT FALSE;
+ALTER TABLE IR_Playbook ADD COLUMN IF NOT EXISTS FollowUpReminderDelaySeconds BIGINT DEFAULT 0;
+ALTER TABLE IR_Playbook ADD COLUMN IF NOT EXISTS FollowUpReminderMessage TEXT;
+ALTER TABLE IR_Incident ADD COLUMN IF NOT EXISTS FollowUpReminderDelaySeconds BIGINT DEFAULT 0;
+ALTER TABLE IR_Incident ADD COLUMN IF NOT EXISTS FollowUpReminderMessage TEXT;
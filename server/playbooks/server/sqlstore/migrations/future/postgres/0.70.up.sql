@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS IR_CategoryRule (
+    ID TEXT PRIMARY KEY,
+    CategoryName TEXT NOT NULL,
+    PlaybookID TEXT NOT NULL DEFAULT '',
+    Severity TEXT NOT NULL DEFAULT '',
+    TeamID TEXT NOT NULL DEFAULT '',
+    CreateAt BIGINT NOT NULL DEFAULT 0,
+    UpdateAt BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS IR_TelemetryEvent (
+    ID TEXT PRIMARY KEY,
+    Type TEXT NOT NULL DEFAULT '',
+    Name TEXT NOT NULL DEFAULT '',
+    UserID TEXT NOT NULL DEFAULT '',
+    PropertiesJSON TEXT,
+    CreateAt BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS IR_TelemetryEvent_CreateAt ON IR_TelemetryEvent (CreateAt);
@@ -0,0 +1,3 @@
+ALTER TABLE IR_Playbook DROP COLUMN IF EXISTS SyncInvitedGroupsEnabled;
+ALTER TABLE IR_Incident DROP COLUMN IF EXISTS SyncInvitedGroups;
+ALTER TABLE IR_Incident DROP COLUMN IF EXISTS ConcatenatedSyncedGroupMemberIDs;
@@ -0,0 +1,3 @@
+ALTER TABLE IR_Playbook ADD COLUMN IF NOT EXISTS SyncInvitedGroupsEnabled BOOLEAN DEFAULT FALSE;
+ALTER TABLE IR_Incident ADD COLUMN IF NOT EXISTS SyncInvitedGroups BOOLEAN DEFAULT FALSE;
+ALTER TABLE IR_Incident ADD COLUMN IF NOT EXISTS ConcatenatedSyncedGroupMemberIDs TEXT;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS IR_PlaybookMemberGroup (
+    PlaybookID TEXT NOT NULL,
+    GroupID TEXT NOT NULL,
+    PRIMARY KEY (PlaybookID, GroupID)
+);
+
+CREATE INDEX IF NOT EXISTS IR_PlaybookMemberGroup_GroupID ON IR_PlaybookMemberGroup (GroupID);
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/app"
 	"github.com/pkg/errors"
@@ -19,6 +20,8 @@ import (
 type sqlPlaybook struct {
 	app.Playbook
 	ChecklistsJSON                        json.RawMessage
+	BoardSyncJSON                         json.RawMessage
+	SeverityLevelsJSON                    json.RawMessage
 	ConcatenatedInvitedUserIDs            string
 	ConcatenatedInvitedGroupIDs           string
 	ConcatenatedSignalAnyKeywords         string
@@ -27,6 +30,11 @@ type sqlPlaybook struct {
 	ConcatenatedWebhookOnStatusUpdateURLs string
 }
 
+type sqlPropertyField struct {
+	app.PropertyField
+	OptionsJSON json.RawMessage
+}
+
 // playbookStore is a sql store for playbooks. Use NewPlaybookStore to create it.
 type playbookStore struct {
 	pluginAPI      PluginAPIClient
@@ -35,6 +43,10 @@ type playbookStore struct {
 	playbookSelect sq.SelectBuilder
 	membersSelect  sq.SelectBuilder
 	metricsSelect  sq.SelectBuilder
+
+	memberGroupsSelect sq.SelectBuilder
+
+	propertyFieldsSelect sq.SelectBuilder
 }
 
 // Ensure playbookStore implements the playbook.Store interface.
@@ -46,6 +58,11 @@ type playbookMember struct {
 	Roles      string
 }
 
+type playbookMemberGroup struct {
+	PlaybookID string
+	GroupID    string
+}
+
 // definied to call a common insights query builder for both user and team insights
 const insightsQueryTypeUser = "insights_query_type_user"
 const insightsQueryTypeTeam = "insights_query_type_team"
@@ -141,6 +158,7 @@ func NewPlaybookStore(pluginAPI PluginAPIClient, sqlStore *SQLStore) app.Playboo
 			"p.ConcatenatedInvitedUserIDs",
 			"p.ConcatenatedInvitedGroupIDs",
 			"p.InviteUsersEnabled",
+			"p.SyncInvitedGroupsEnabled",
 			"p.DefaultCommanderID AS DefaultOwnerID",
 			"p.DefaultCommanderEnabled AS DefaultOwnerEnabled",
 			"p.ConcatenatedBroadcastChannelIDs",
@@ -162,6 +180,11 @@ func NewPlaybookStore(pluginAPI PluginAPIClient, sqlStore *SQLStore) app.Playboo
 			"p.ChannelID",
 			"p.ChannelMode",
 			"p.ChecklistsJSON",
+			"p.BoardSyncJSON",
+			"p.SeverityLevelsJSON",
+			"p.FollowUpReminderEnabled",
+			"p.FollowUpReminderDelaySeconds",
+			"COALESCE(p.FollowUpReminderMessage, '') FollowUpReminderMessage",
 			"COALESCE(p.CategoryName, '') CategoryName",
 			"p.RunSummaryTemplateEnabled",
 			"COALESCE(p.RunSummaryTemplate, '') RunSummaryTemplate",
@@ -184,6 +207,14 @@ func NewPlaybookStore(pluginAPI PluginAPIClient, sqlStore *SQLStore) app.Playboo
 		From("IR_PlaybookMember").
 		OrderBy("MemberID ASC") // Entirely for consistency for the tests
 
+	memberGroupsSelect := sqlStore.builder.
+		Select(
+			"PlaybookID",
+			"GroupID",
+		).
+		From("IR_PlaybookMemberGroup").
+		OrderBy("GroupID ASC")
+
 	metricsSelect := sqlStore.builder.
 		Select(
 			"ID",
@@ -197,13 +228,27 @@ func NewPlaybookStore(pluginAPI PluginAPIClient, sqlStore *SQLStore) app.Playboo
 		Where(sq.Eq{"DeleteAt": 0}).
 		OrderBy("Ordering ASC")
 
+	propertyFieldsSelect := sqlStore.builder.
+		Select(
+			"ID",
+			"PlaybookID",
+			"Name",
+			"Type",
+			"COALESCE(OptionsJSON, 'null') OptionsJSON",
+		).
+		From("IR_PropertyField").
+		Where(sq.Eq{"DeleteAt": 0}).
+		OrderBy("Ordering ASC")
+
 	newStore := &playbookStore{
-		pluginAPI:      pluginAPI,
-		store:          sqlStore,
-		queryBuilder:   sqlStore.builder,
-		playbookSelect: playbookSelect,
-		membersSelect:  membersSelect,
-		metricsSelect:  metricsSelect,
+		pluginAPI:            pluginAPI,
+		store:                sqlStore,
+		queryBuilder:         sqlStore.builder,
+		playbookSelect:       playbookSelect,
+		membersSelect:        membersSelect,
+		memberGroupsSelect:   memberGroupsSelect,
+		metricsSelect:        metricsSelect,
+		propertyFieldsSelect: propertyFieldsSelect,
 	}
 	return newStore
 }
@@ -239,6 +284,11 @@ func (p *playbookStore) Create(playbook app.Playbook) (id string, err error) {
 			"UpdateAt":                                rawPlaybook.UpdateAt,
 			"DeleteAt":                                rawPlaybook.DeleteAt,
 			"ChecklistsJSON":                          rawPlaybook.ChecklistsJSON,
+			"BoardSyncJSON":                           rawPlaybook.BoardSyncJSON,
+			"SeverityLevelsJSON":                      rawPlaybook.SeverityLevelsJSON,
+			"FollowUpReminderEnabled":                 rawPlaybook.FollowUpReminderEnabled,
+			"FollowUpReminderDelaySeconds":            rawPlaybook.FollowUpReminderDelaySeconds,
+			"FollowUpReminderMessage":                 rawPlaybook.FollowUpReminderMessage,
 			"NumStages":                               len(rawPlaybook.Checklists),
 			"NumSteps":                                getSteps(rawPlaybook.Playbook),
 			"ReminderMessageTemplate":                 rawPlaybook.ReminderMessageTemplate,
@@ -247,6 +297,7 @@ func (p *playbookStore) Create(playbook app.Playbook) (id string, err error) {
 			"ConcatenatedInvitedUserIDs":              rawPlaybook.ConcatenatedInvitedUserIDs,
 			"ConcatenatedInvitedGroupIDs":             rawPlaybook.ConcatenatedInvitedGroupIDs,
 			"InviteUsersEnabled":                      rawPlaybook.InviteUsersEnabled,
+			"SyncInvitedGroupsEnabled":                rawPlaybook.SyncInvitedGroupsEnabled,
 			"DefaultCommanderID":                      rawPlaybook.DefaultOwnerID,
 			"DefaultCommanderEnabled":                 rawPlaybook.DefaultOwnerEnabled,
 			"ConcatenatedBroadcastChannelIDs":         rawPlaybook.ConcatenatedBroadcastChannelIDs,
@@ -280,10 +331,18 @@ func (p *playbookStore) Create(playbook app.Playbook) (id string, err error) {
 		return "", errors.Wrap(err, "failed to replace playbook members")
 	}
 
+	if err = p.replacePlaybookMemberGroups(tx, rawPlaybook.Playbook); err != nil {
+		return "", errors.Wrap(err, "failed to replace playbook member groups")
+	}
+
 	if err = p.replacePlaybookMetrics(tx, rawPlaybook.Playbook); err != nil {
 		return "", errors.Wrap(err, "failed to replace playbook metrics configs")
 	}
 
+	if err = p.replacePlaybookPropertyFields(tx, rawPlaybook.Playbook); err != nil {
+		return "", errors.Wrap(err, "failed to replace playbook property fields")
+	}
+
 	if err = tx.Commit(); err != nil {
 		return "", errors.Wrap(err, "could not commit transaction")
 	}
@@ -322,18 +381,31 @@ func (p *playbookStore) Get(id string) (app.Playbook, error) {
 		return app.Playbook{}, errors.Wrapf(err, "failed to get memberIDs for playbook with id '%s'", id)
 	}
 
+	var memberGroups []playbookMemberGroup
+	err = p.store.selectBuilder(tx, &memberGroups, p.memberGroupsSelect.Where(sq.Eq{"PlaybookID": id}))
+	if err != nil && err != sql.ErrNoRows {
+		return app.Playbook{}, errors.Wrapf(err, "failed to get member groups for playbook with id '%s'", id)
+	}
+
 	var metrics []app.PlaybookMetricConfig
 	err = p.store.selectBuilder(tx, &metrics, p.metricsSelect.Where(sq.Eq{"PlaybookID": id}))
 	if err != nil && err != sql.ErrNoRows {
 		return app.Playbook{}, errors.Wrapf(err, "failed to get metrics configs for playbook with id '%s'", id)
 	}
 
+	propertyFields, err := p.getPropertyFields(tx, []string{id})
+	if err != nil {
+		return app.Playbook{}, errors.Wrapf(err, "failed to get property fields for playbook with id '%s'", id)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return app.Playbook{}, errors.Wrap(err, "could not commit transaction")
 	}
 
 	addMembersToPlaybook(members, &playbook)
+	addMemberGroupsToPlaybook(memberGroups, &playbook)
 	playbook.Metrics = metrics
+	playbook.PropertyFields = propertyFields
 	return playbook, nil
 }
 
@@ -406,7 +478,13 @@ func (p *playbookStore) GetPlaybooksForTeam(requesterInfo app.RequesterInfo, tea
 				FROM IR_PlaybookMember as pm
 				WHERE pm.PlaybookID = p.ID
 				AND pm.MemberID = ?)
-		)`, requesterInfo.UserID)
+			OR EXISTS(SELECT 1
+				FROM IR_PlaybookMemberGroup as pmg
+				JOIN GroupMembers as gm ON gm.GroupId = pmg.GroupID
+				WHERE pmg.PlaybookID = p.ID
+				AND gm.UserId = ?
+				AND gm.DeleteAt = 0)
+		)`, requesterInfo.UserID, requesterInfo.UserID)
 	if !opts.WithMembershipOnly { // return all public playbooks and private ones user is member of
 		permissionsAndFilter = sq.Or{sq.Expr(`p.Public = true`), permissionsAndFilter}
 	}
@@ -513,14 +591,25 @@ func (p *playbookStore) GetPlaybooksForTeam(requesterInfo app.RequesterInfo, tea
 	if err != nil {
 		return app.GetPlaybooksResults{}, errors.Wrap(err, "failed to get playbook members")
 	}
+	var memberGroups []playbookMemberGroup
+	err = p.store.selectBuilder(p.store.db, &memberGroups, p.memberGroupsSelect.Where(sq.Eq{"PlaybookID": ids}))
+	if err != nil {
+		return app.GetPlaybooksResults{}, errors.Wrap(err, "failed to get playbook member groups")
+	}
 	var metrics []app.PlaybookMetricConfig
 	err = p.store.selectBuilder(p.store.db, &metrics, p.metricsSelect.Where(sq.Eq{"PlaybookID": ids}))
 	if err != nil {
 		return app.GetPlaybooksResults{}, errors.Wrap(err, "failed to get playbooks metrics")
 	}
+	propertyFields, err := p.getPropertyFields(p.store.db, ids)
+	if err != nil {
+		return app.GetPlaybooksResults{}, errors.Wrap(err, "failed to get playbooks property fields")
+	}
 
 	addMembersToPlaybooks(members, playbooks)
+	addMemberGroupsToPlaybooks(memberGroups, playbooks)
 	addMetricsToPlaybooks(metrics, playbooks)
+	addPropertyFieldsToPlaybooks(propertyFields, playbooks)
 
 	pageCount := 0
 	if opts.PerPage > 0 {
@@ -595,10 +684,16 @@ func (p *playbookStore) GetPlaybookIDsForUser(userID string, teamID string) ([]s
 				FROM IR_PlaybookMember as pm
 				WHERE pm.PlaybookID = p.ID
 				AND pm.MemberID = ?)
+		OR EXISTS(SELECT 1
+				FROM IR_PlaybookMemberGroup as pmg
+				JOIN GroupMembers as gm ON gm.GroupId = pmg.GroupID
+				WHERE pmg.PlaybookID = p.ID
+				AND gm.UserId = ?
+				AND gm.DeleteAt = 0)
 		OR NOT EXISTS(SELECT 1
 				FROM IR_PlaybookMember as pm
 				WHERE pm.PlaybookID = p.ID)
-	)`, userID)
+	)`, userID, userID)
 
 	queryForResults := p.store.builder.
 		Select("ID").
@@ -668,6 +763,11 @@ func (p *playbookStore) Update(playbook app.Playbook) (err error) {
 			"UpdateAt":                                rawPlaybook.UpdateAt,
 			"DeleteAt":                                rawPlaybook.DeleteAt,
 			"ChecklistsJSON":                          rawPlaybook.ChecklistsJSON,
+			"BoardSyncJSON":                           rawPlaybook.BoardSyncJSON,
+			"SeverityLevelsJSON":                      rawPlaybook.SeverityLevelsJSON,
+			"FollowUpReminderEnabled":                 rawPlaybook.FollowUpReminderEnabled,
+			"FollowUpReminderDelaySeconds":            rawPlaybook.FollowUpReminderDelaySeconds,
+			"FollowUpReminderMessage":                 rawPlaybook.FollowUpReminderMessage,
 			"NumStages":                               len(rawPlaybook.Checklists),
 			"NumSteps":                                getSteps(rawPlaybook.Playbook),
 			"ReminderMessageTemplate":                 rawPlaybook.ReminderMessageTemplate,
@@ -676,6 +776,7 @@ func (p *playbookStore) Update(playbook app.Playbook) (err error) {
 			"ConcatenatedInvitedUserIDs":              rawPlaybook.ConcatenatedInvitedUserIDs,
 			"ConcatenatedInvitedGroupIDs":             rawPlaybook.ConcatenatedInvitedGroupIDs,
 			"InviteUsersEnabled":                      rawPlaybook.InviteUsersEnabled,
+			"SyncInvitedGroupsEnabled":                rawPlaybook.SyncInvitedGroupsEnabled,
 			"DefaultCommanderID":                      rawPlaybook.DefaultOwnerID,
 			"DefaultCommanderEnabled":                 rawPlaybook.DefaultOwnerEnabled,
 			"ConcatenatedBroadcastChannelIDs":         rawPlaybook.ConcatenatedBroadcastChannelIDs,
@@ -711,10 +812,18 @@ func (p *playbookStore) Update(playbook app.Playbook) (err error) {
 		return errors.Wrapf(err, "failed to replace playbook members for playbook with id '%s'", rawPlaybook.ID)
 	}
 
+	if err = p.replacePlaybookMemberGroups(tx, rawPlaybook.Playbook); err != nil {
+		return errors.Wrapf(err, "failed to replace playbook member groups for playbook with id '%s'", rawPlaybook.ID)
+	}
+
 	if err = p.replacePlaybookMetrics(tx, rawPlaybook.Playbook); err != nil {
 		return errors.Wrapf(err, "failed to replace playbook metrics configs for playbook with id '%s'", rawPlaybook.ID)
 	}
 
+	if err = p.replacePlaybookPropertyFields(tx, rawPlaybook.Playbook); err != nil {
+		return errors.Wrapf(err, "failed to replace playbook property fields for playbook with id '%s'", rawPlaybook.ID)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return errors.Wrap(err, "could not commit transaction")
 	}
@@ -852,6 +961,38 @@ func (p *playbookStore) replacePlaybookMembers(q queryExecer, playbook app.Playb
 	return nil
 }
 
+// replacePlaybookMemberGroups replaces the groups whose members are members of a playbook
+func (p *playbookStore) replacePlaybookMemberGroups(q queryExecer, playbook app.Playbook) error {
+	delBuilder := sq.Delete("IR_PlaybookMemberGroup").
+		Where(sq.Eq{"PlaybookID": playbook.ID})
+	if _, err := p.store.execBuilder(q, delBuilder); err != nil {
+		return err
+	}
+
+	if len(playbook.MemberGroupIDs) == 0 {
+		return nil
+	}
+
+	insert := sq.
+		Insert("IR_PlaybookMemberGroup").
+		Columns("PlaybookID", "GroupID")
+
+	seen := make(map[string]bool, len(playbook.MemberGroupIDs))
+	for _, groupID := range playbook.MemberGroupIDs {
+		if seen[groupID] {
+			continue
+		}
+		seen[groupID] = true
+		insert = insert.Values(playbook.ID, groupID)
+	}
+
+	if _, err := p.store.execBuilder(q, insert); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // replacePlaybookMetrics replaces the metric configs of a playbook
 func (p *playbookStore) replacePlaybookMetrics(q queryExecer, playbook app.Playbook) error {
 	// First, we mark as deleted all existing metrics for this playbook, then restore those which are in the playbook object.
@@ -893,6 +1034,78 @@ func (p *playbookStore) replacePlaybookMetrics(q queryExecer, playbook app.Playb
 	return nil
 }
 
+// replacePlaybookPropertyFields replaces the property fields of a playbook. The type of an
+// existing field is never changed, so that the values already set for it stay valid.
+func (p *playbookStore) replacePlaybookPropertyFields(q queryExecer, playbook app.Playbook) error {
+	// First, we mark as deleted all existing fields for this playbook, then restore those which are in the playbook object.
+	updateBuilder := sq.Update("IR_PropertyField").
+		Set("DeleteAt", model.GetMillis()).
+		Where(sq.Eq{"PlaybookID": playbook.ID}).
+		Where(sq.Eq{"DeleteAt": 0})
+
+	if _, err := p.store.execBuilder(q, updateBuilder); err != nil {
+		return err
+	}
+
+	for i, f := range playbook.PropertyFields {
+		options := make([]app.PropertyOption, 0, len(f.Options))
+		for _, option := range f.Options {
+			if option.ID == "" {
+				option.ID = model.NewId()
+			}
+			options = append(options, option)
+		}
+
+		optionsJSON, err := json.Marshal(options)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal options of property field '%s'", f.Name)
+		}
+
+		if f.ID == "" {
+			_, err = p.store.execBuilder(q, sq.
+				Insert("IR_PropertyField").
+				Columns("ID", "PlaybookID", "Name", "Type", "OptionsJSON", "Ordering").
+				Values(model.NewId(), playbook.ID, f.Name, f.Type, optionsJSON, i))
+		} else {
+			_, err = p.store.execBuilder(q, sq.
+				Update("IR_PropertyField").
+				SetMap(map[string]interface{}{
+					"Name":        f.Name,
+					"OptionsJSON": optionsJSON,
+					"Ordering":    i,
+					"DeleteAt":    0,
+				}).
+				Where(sq.Eq{"ID": f.ID, "PlaybookID": playbook.ID}),
+			)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getPropertyFields returns the property fields of the given playbooks.
+func (p *playbookStore) getPropertyFields(q sqlx.Queryer, playbookIDs []string) ([]app.PropertyField, error) {
+	var rawFields []sqlPropertyField
+	err := p.store.selectBuilder(q, &rawFields, p.propertyFieldsSelect.Where(sq.Eq{"PlaybookID": playbookIDs}))
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	fields := make([]app.PropertyField, 0, len(rawFields))
+	for _, rawField := range rawFields {
+		field := rawField.PropertyField
+		if err := json.Unmarshal(rawField.OptionsJSON, &field.Options); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal options of property field '%s'", field.ID)
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
 func (p *playbookStore) AutoFollow(playbookID, userID string) error {
 	var err error
 	if p.store.db.DriverName() == model.DatabaseDriverMysql {
@@ -1057,6 +1270,23 @@ func addMembersToPlaybook(members []playbookMember, playbook *app.Playbook) {
 	}
 }
 
+func addMemberGroupsToPlaybooks(memberGroups []playbookMemberGroup, playbooks []app.Playbook) {
+	playbookToMemberGroups := make(map[string][]playbookMemberGroup)
+	for _, memberGroup := range memberGroups {
+		playbookToMemberGroups[memberGroup.PlaybookID] = append(playbookToMemberGroups[memberGroup.PlaybookID], memberGroup)
+	}
+
+	for i, playbook := range playbooks {
+		addMemberGroupsToPlaybook(playbookToMemberGroups[playbook.ID], &(playbooks[i]))
+	}
+}
+
+func addMemberGroupsToPlaybook(memberGroups []playbookMemberGroup, playbook *app.Playbook) {
+	for _, mg := range memberGroups {
+		playbook.MemberGroupIDs = append(playbook.MemberGroupIDs, mg.GroupID)
+	}
+}
+
 func addMetricsToPlaybooks(metrics []app.PlaybookMetricConfig, playbooks []app.Playbook) {
 	playbookToMetrics := make(map[string][]app.PlaybookMetricConfig)
 	for _, metric := range metrics {
@@ -1068,6 +1298,17 @@ func addMetricsToPlaybooks(metrics []app.PlaybookMetricConfig, playbooks []app.P
 	}
 }
 
+func addPropertyFieldsToPlaybooks(fields []app.PropertyField, playbooks []app.Playbook) {
+	playbookToFields := make(map[string][]app.PropertyField)
+	for _, field := range fields {
+		playbookToFields[field.PlaybookID] = append(playbookToFields[field.PlaybookID], field)
+	}
+
+	for i, playbook := range playbooks {
+		playbooks[i].PropertyFields = playbookToFields[playbook.ID]
+	}
+}
+
 func getSteps(playbook app.Playbook) int {
 	steps := 0
 	for _, p := range playbook.Checklists {
@@ -1087,9 +1328,21 @@ func toSQLPlaybook(playbook app.Playbook) (*sqlPlaybook, error) {
 		return nil, errors.Wrapf(errors.New("invalid data"), "checklist json for playbook id '%s' is too long (max %d)", playbook.ID, maxJSONLength)
 	}
 
+	boardSyncJSON, err := json.Marshal(playbook.BoardSync)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal board sync json for playbook id: '%s'", playbook.ID)
+	}
+
+	severityLevelsJSON, err := json.Marshal(playbook.SeverityLevels)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal severity levels json for playbook id: '%s'", playbook.ID)
+	}
+
 	return &sqlPlaybook{
 		Playbook:                              playbook,
 		ChecklistsJSON:                        checklistsJSON,
+		BoardSyncJSON:                         boardSyncJSON,
+		SeverityLevelsJSON:                    severityLevelsJSON,
 		ConcatenatedInvitedUserIDs:            strings.Join(playbook.InvitedUserIDs, ","),
 		ConcatenatedInvitedGroupIDs:           strings.Join(playbook.InvitedGroupIDs, ","),
 		ConcatenatedSignalAnyKeywords:         strings.Join(playbook.SignalAnyKeywords, ","),
@@ -1107,6 +1360,18 @@ func toPlaybook(rawPlaybook sqlPlaybook) (app.Playbook, error) {
 		}
 	}
 
+	if len(rawPlaybook.BoardSyncJSON) > 0 {
+		if err := json.Unmarshal(rawPlaybook.BoardSyncJSON, &p.BoardSync); err != nil {
+			return app.Playbook{}, errors.Wrapf(err, "failed to unmarshal board sync json for playbook id: '%s'", p.ID)
+		}
+	}
+
+	if len(rawPlaybook.SeverityLevelsJSON) > 0 {
+		if err := json.Unmarshal(rawPlaybook.SeverityLevelsJSON, &p.SeverityLevels); err != nil {
+			return app.Playbook{}, errors.Wrapf(err, "failed to unmarshal severity levels json for playbook id: '%s'", p.ID)
+		}
+	}
+
 	p.InvitedUserIDs = []string(nil)
 	if rawPlaybook.ConcatenatedInvitedUserIDs != "" {
 		p.InvitedUserIDs = strings.Split(rawPlaybook.ConcatenatedInvitedUserIDs, ",")
@@ -34,6 +34,7 @@ type sqlPlaybookRun struct {
 	ConcatenatedBroadcastChannelIDs       string
 	ConcatenatedWebhookOnCreationURLs     string
 	ConcatenatedWebhookOnStatusUpdateURLs string
+	ConcatenatedSyncedGroupMemberIDs      string
 	Metric                                null.Int
 }
 
@@ -43,6 +44,12 @@ type sqlRunMetricData struct {
 	Value          null.Int
 }
 
+type sqlRunPropertyValue struct {
+	IncidentID string
+	FieldID    string
+	Value      string
+}
+
 // playbookRunStore holds the information needed to fulfill the methods in the store interface.
 type playbookRunStore struct {
 	pluginAPI                        PluginAPIClient
@@ -53,6 +60,7 @@ type playbookRunStore struct {
 	timelineEventsSelect             sq.SelectBuilder
 	metricsDataSelectSingleRun       sq.SelectBuilder
 	sqlMetricsDataSelectMultipleRuns sq.SelectBuilder
+	propertyValuesSelect             sq.SelectBuilder
 }
 
 // Ensure playbookRunStore implements the app.PlaybookRunStore interface.
@@ -190,7 +198,11 @@ func NewPlaybookRunStore(pluginAPI PluginAPIClient, sqlStore *SQLStore) app.Play
 			"ConcatenatedBroadcastChannelIDs", "ConcatenatedWebhookOnCreationURLs", "Retrospective", "RetrospectiveEnabled", "MessageOnJoin", "RetrospectivePublishedAt", "RetrospectiveReminderIntervalSeconds",
 			"RetrospectiveWasCanceled", "ConcatenatedWebhookOnStatusUpdateURLs", "StatusUpdateBroadcastChannelsEnabled", "StatusUpdateBroadcastWebhooksEnabled",
 			"CreateChannelMemberOnNewParticipant", "RemoveChannelMemberOnRemovedParticipant",
-			"COALESCE(CategoryName, '') CategoryName", "SummaryModifiedAt", "i.RunType AS Type").
+			"COALESCE(CategoryName, '') CategoryName", "SummaryModifiedAt", "i.RunType AS Type",
+			"COALESCE(i.BoardID, '') BoardID", "COALESCE(i.BoardCardID, '') BoardCardID",
+			"COALESCE(i.Severity, '') Severity", "i.FollowUpReminderDelaySeconds",
+			"COALESCE(i.FollowUpReminderMessage, '') FollowUpReminderMessage", "i.SyncInvitedGroups",
+			"COALESCE(i.ConcatenatedSyncedGroupMemberIDs, '') ConcatenatedSyncedGroupMemberIDs").
 		Column(participantsCol).
 		From("IR_Incident AS i")
 
@@ -239,6 +251,13 @@ func NewPlaybookRunStore(pluginAPI PluginAPIClient, sqlStore *SQLStore) app.Play
 		Where("mc.DeleteAt = 0").
 		OrderBy("mc.Ordering ASC")
 
+	propertyValuesSelect := sqlStore.builder.
+		Select("pv.IncidentID", "pv.FieldID", "pv.Value").
+		From("IR_PropertyValue AS pv").
+		Join("IR_PropertyField AS pf ON (pf.ID = pv.FieldID)").
+		Where("pf.DeleteAt = 0").
+		OrderBy("pf.Ordering ASC")
+
 	return &playbookRunStore{
 		pluginAPI:                        pluginAPI,
 		store:                            sqlStore,
@@ -248,6 +267,7 @@ func NewPlaybookRunStore(pluginAPI PluginAPIClient, sqlStore *SQLStore) app.Play
 		timelineEventsSelect:             timelineEventsSelect,
 		metricsDataSelectSingleRun:       metricsDataSelectSingleRun,
 		sqlMetricsDataSelectMultipleRuns: sqlMetricsDataSelectMultipleRuns,
+		propertyValuesSelect:             propertyValuesSelect,
 	}
 }
 
@@ -276,6 +296,11 @@ func (s *playbookRunStore) GetPlaybookRuns(requesterInfo app.RequesterInfo, opti
 		queryForTotal = queryForTotal.Where(sq.Eq{"i.RunType": options.Types})
 	}
 
+	if len(options.Severities) != 0 {
+		queryForResults = queryForResults.Where(sq.Eq{"i.Severity": options.Severities})
+		queryForTotal = queryForTotal.Where(sq.Eq{"i.Severity": options.Severities})
+	}
+
 	if options.OwnerID != "" {
 		queryForResults = queryForResults.Where(sq.Eq{"i.CommanderUserID": options.OwnerID})
 		queryForTotal = queryForTotal.Where(sq.Eq{"i.CommanderUserID": options.OwnerID})
@@ -329,6 +354,17 @@ func (s *playbookRunStore) GetPlaybookRuns(requesterInfo app.RequesterInfo, opti
 		queryForTotal = queryForTotal.Where(sq.Eq{"i.PlaybookID": options.PlaybookID})
 	}
 
+	for _, propertyValue := range options.PropertyValues {
+		propertyValueExpr := sq.Expr(`EXISTS(SELECT 1
+			FROM IR_PropertyValue AS pv
+			WHERE pv.IncidentID = i.ID
+			AND pv.FieldID = ?
+			AND pv.Value = ?)`, propertyValue.FieldID, propertyValue.Value)
+
+		queryForResults = queryForResults.Where(propertyValueExpr)
+		queryForTotal = queryForTotal.Where(propertyValueExpr)
+	}
+
 	// TODO: do we need to sanitize (replace any '%'s in the search term)?
 	if options.SearchTerm != "" {
 		column := "i.Name"
@@ -415,6 +451,11 @@ func (s *playbookRunStore) GetPlaybookRuns(requesterInfo app.RequesterInfo, opti
 		return nil, err
 	}
 
+	propertyValues, err := s.getPropertyValuesForPlaybookRun(tx, playbookRunIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, errors.Wrap(err, "could not commit transaction")
 	}
@@ -422,6 +463,7 @@ func (s *playbookRunStore) GetPlaybookRuns(requesterInfo app.RequesterInfo, opti
 	addStatusPostsToPlaybookRuns(statusPosts, playbookRuns)
 	addTimelineEventsToPlaybookRuns(timelineEvents, playbookRuns)
 	addMetricsToPlaybookRuns(metricsData, playbookRuns)
+	addPropertyValuesToPlaybookRuns(propertyValues, playbookRuns)
 
 	return &app.GetPlaybookRunsResults{
 		TotalCount: total,
@@ -497,6 +539,13 @@ func (s *playbookRunStore) CreatePlaybookRun(playbookRun *app.PlaybookRun) (*app
 			"CreateChannelMemberOnNewParticipant":     rawPlaybookRun.CreateChannelMemberOnNewParticipant,
 			"RemoveChannelMemberOnRemovedParticipant": rawPlaybookRun.RemoveChannelMemberOnRemovedParticipant,
 			"RunType":                                 rawPlaybookRun.Type,
+			"BoardID":                                 rawPlaybookRun.BoardID,
+			"BoardCardID":                             rawPlaybookRun.BoardCardID,
+			"Severity":                                rawPlaybookRun.Severity,
+			"FollowUpReminderDelaySeconds":            rawPlaybookRun.FollowUpReminderDelaySeconds,
+			"FollowUpReminderMessage":                 rawPlaybookRun.FollowUpReminderMessage,
+			"SyncInvitedGroups":                       rawPlaybookRun.SyncInvitedGroups,
+			"ConcatenatedSyncedGroupMemberIDs":        rawPlaybookRun.ConcatenatedSyncedGroupMemberIDs,
 			// Preserved for backwards compatibility with v1.2
 			"ActiveStage":      0,
 			"ActiveStageTitle": "",
@@ -561,7 +610,13 @@ func (s *playbookRunStore) UpdatePlaybookRun(playbookRun *app.PlaybookRun) (*app
 			"StatusUpdateEnabled":                     rawPlaybookRun.StatusUpdateEnabled,
 			"CreateChannelMemberOnNewParticipant":     rawPlaybookRun.CreateChannelMemberOnNewParticipant,
 			"RemoveChannelMemberOnRemovedParticipant": rawPlaybookRun.RemoveChannelMemberOnRemovedParticipant,
-			"RunType": rawPlaybookRun.Type,
+			"RunType":                          rawPlaybookRun.Type,
+			"BoardID":                          rawPlaybookRun.BoardID,
+			"BoardCardID":                      rawPlaybookRun.BoardCardID,
+			"Severity":                         rawPlaybookRun.Severity,
+			"FollowUpReminderDelaySeconds":     rawPlaybookRun.FollowUpReminderDelaySeconds,
+			"FollowUpReminderMessage":          rawPlaybookRun.FollowUpReminderMessage,
+			"ConcatenatedSyncedGroupMemberIDs": rawPlaybookRun.ConcatenatedSyncedGroupMemberIDs,
 		}).
 		Where(sq.Eq{"ID": rawPlaybookRun.ID}))
 
@@ -765,6 +820,11 @@ func (s *playbookRunStore) GetPlaybookRun(playbookRunID string) (*app.PlaybookRu
 		return nil, errors.Wrapf(err, "failed to get metrics data for run with id `%s`", playbookRunID)
 	}
 
+	propertyValues, err := s.getPropertyValuesForPlaybookRun(tx, []string{playbookRunID})
+	if err != nil {
+		return nil, err
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, errors.Wrap(err, "could not commit transaction")
 	}
@@ -775,6 +835,9 @@ func (s *playbookRunStore) GetPlaybookRun(playbookRunID string) (*app.PlaybookRu
 
 	playbookRun.TimelineEvents = append(playbookRun.TimelineEvents, timelineEvents...)
 	playbookRun.MetricsData = metricsData
+	for _, v := range propertyValues {
+		playbookRun.PropertyValues = append(playbookRun.PropertyValues, app.PropertyValue{FieldID: v.FieldID, Value: v.Value})
+	}
 
 	return playbookRun, nil
 }
@@ -808,6 +871,126 @@ func (s *playbookRunStore) getMetricsForPlaybookRun(q sqlx.Queryer, playbookRunI
 	return metricsData, nil
 }
 
+func (s *playbookRunStore) getPropertyValuesForPlaybookRun(q sqlx.Queryer, playbookRunIDs []string) ([]sqlRunPropertyValue, error) {
+	var propertyValues []sqlRunPropertyValue
+
+	err := s.store.selectBuilder(q, &propertyValues, s.propertyValuesSelect.Where(sq.Eq{"pv.IncidentID": playbookRunIDs}))
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.Wrap(err, "failed to get property values")
+	}
+
+	return propertyValues, nil
+}
+
+// SetPropertyValue sets the value of a property field for a run, clearing it if the value is empty.
+func (s *playbookRunStore) SetPropertyValue(playbookRunID string, value app.PropertyValue) error {
+	if value.Value == "" {
+		if _, err := s.store.execBuilder(s.store.db, sq.
+			Delete("IR_PropertyValue").
+			Where(sq.Eq{"IncidentID": playbookRunID, "FieldID": value.FieldID})); err != nil {
+			return errors.Wrapf(err, "failed to clear property value '%s' for run '%s'", value.FieldID, playbookRunID)
+		}
+		return nil
+	}
+
+	now := model.GetMillis()
+	insert := sq.
+		Insert("IR_PropertyValue").
+		Columns("IncidentID", "FieldID", "Value", "UpdateAt").
+		Values(playbookRunID, value.FieldID, value.Value, now)
+
+	var err error
+	if s.store.db.DriverName() == model.DatabaseDriverMysql {
+		_, err = s.store.execBuilder(s.store.db, insert.
+			Suffix("ON DUPLICATE KEY UPDATE Value = ?, UpdateAt = ?", value.Value, now))
+	} else {
+		_, err = s.store.execBuilder(s.store.db, insert.
+			Suffix("ON CONFLICT (IncidentID,FieldID) DO UPDATE SET Value = ?, UpdateAt = ?", value.Value, now))
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to upsert property value '%s' for run '%s'", value.FieldID, playbookRunID)
+	}
+
+	return nil
+}
+
+// GetRunLinks returns the links from the run to other runs, typed relative to the run.
+func (s *playbookRunStore) GetRunLinks(playbookRunID string) ([]app.RunRelation, error) {
+	query := s.store.builder.
+		Select("FromIncidentID", "ToIncidentID", "Type", "CreateAt").
+		From("IR_RunLink").
+		Where(sq.Or{
+			sq.Eq{"FromIncidentID": playbookRunID},
+			sq.Eq{"ToIncidentID": playbookRunID},
+		}).
+		OrderBy("CreateAt ASC")
+
+	var rawLinks []struct {
+		FromIncidentID string
+		ToIncidentID   string
+		Type           string
+		CreateAt       int64
+	}
+	if err := s.store.selectBuilder(s.store.db, &rawLinks, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get links of run '%s'", playbookRunID)
+	}
+
+	links := make([]app.RunRelation, 0, len(rawLinks))
+	for _, rawLink := range rawLinks {
+		link := app.RunRelation{
+			RunID:    rawLink.ToIncidentID,
+			Type:     rawLink.Type,
+			CreateAt: rawLink.CreateAt,
+		}
+		if rawLink.ToIncidentID == playbookRunID {
+			link.RunID = rawLink.FromIncidentID
+			if rawLink.Type == app.RunLinkTypeChild {
+				link.Type = app.RunLinkTypeParent
+			}
+		}
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+// CreateRunLink links the run to linkedRunID with the given type, relative to the run. Links are
+// stored from the parent to the child, so that each of them reads the same from both runs.
+func (s *playbookRunStore) CreateRunLink(playbookRunID, linkedRunID, linkType string) error {
+	fromRunID, toRunID := playbookRunID, linkedRunID
+	if linkType == app.RunLinkTypeParent {
+		fromRunID, toRunID = linkedRunID, playbookRunID
+		linkType = app.RunLinkTypeChild
+	}
+
+	if _, err := s.store.execBuilder(s.store.db, sq.
+		Insert("IR_RunLink").
+		SetMap(map[string]interface{}{
+			"FromIncidentID": fromRunID,
+			"ToIncidentID":   toRunID,
+			"Type":           linkType,
+			"CreateAt":       model.GetMillis(),
+		})); err != nil {
+		return errors.Wrapf(err, "failed to link run '%s' to run '%s'", playbookRunID, linkedRunID)
+	}
+
+	return nil
+}
+
+// DeleteRunLink removes the link between the two runs, in whichever direction it was created.
+func (s *playbookRunStore) DeleteRunLink(playbookRunID, linkedRunID string) error {
+	if _, err := s.store.execBuilder(s.store.db, sq.
+		Delete("IR_RunLink").
+		Where(sq.Or{
+			sq.Eq{"FromIncidentID": playbookRunID, "ToIncidentID": linkedRunID},
+			sq.Eq{"FromIncidentID": linkedRunID, "ToIncidentID": playbookRunID},
+		})); err != nil {
+		return errors.Wrapf(err, "failed to unlink run '%s' from run '%s'", playbookRunID, linkedRunID)
+	}
+
+	return nil
+}
+
 // GetTimelineEvent returns the timeline event by id for the given playbook run.
 func (s *playbookRunStore) GetTimelineEvent(playbookRunID, eventID string) (*app.TimelineEvent, error) {
 	var event app.TimelineEvent
@@ -894,7 +1077,7 @@ func (s *playbookRunStore) NukeDB() (err error) {
 	}
 	defer s.store.finalizeTransaction(tx)
 
-	if _, err := tx.Exec("DROP TABLE IF EXISTS IR_Metric, IR_MetricConfig, IR_PlaybookMember, IR_Run_Participants, IR_PlaybookAutoFollow, IR_StatusPosts, IR_TimelineEvent, IR_Incident, IR_Playbook, IR_System"); err != nil {
+	if _, err := tx.Exec("DROP TABLE IF EXISTS IR_TelemetryEvent, IR_CategoryRule, IR_RunLink, IR_PropertyValue, IR_PropertyField, IR_Metric, IR_MetricConfig, IR_PlaybookMemberGroup, IR_PlaybookMember, IR_Run_Participants, IR_PlaybookAutoFollow, IR_StatusPosts, IR_TimelineEvent, IR_Incident, IR_Playbook, IR_System"); err != nil {
 		return errors.Wrap(err, "could not delete all IR tables")
 	}
 
@@ -905,6 +1088,62 @@ func (s *playbookRunStore) NukeDB() (err error) {
 	return s.store.RunMigrations()
 }
 
+// NukeTeamData removes all the playbooks, runs and categories of the given team, along with
+// their related data.
+func (s *playbookRunStore) NukeTeamData(teamID string) (err error) {
+	tx, err := s.store.db.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "could not begin transaction")
+	}
+	defer s.store.finalizeTransaction(tx)
+
+	runIDs := sq.Select("ID").From("IR_Incident").Where(sq.Eq{"TeamID": teamID})
+	playbookIDs := sq.Select("ID").From("IR_Playbook").Where(sq.Eq{"TeamID": teamID})
+	categoryIDs := sq.Select("ID").From("IR_Category").Where(sq.Eq{"TeamID": teamID})
+
+	deletes := []struct {
+		table  string
+		column string
+		ids    sq.SelectBuilder
+	}{
+		{"IR_RunLink", "FromIncidentID", runIDs},
+		{"IR_RunLink", "ToIncidentID", runIDs},
+		{"IR_PropertyValue", "IncidentID", runIDs},
+		{"IR_Metric", "IncidentID", runIDs},
+		{"IR_Run_Participants", "IncidentID", runIDs},
+		{"IR_StatusPosts", "IncidentID", runIDs},
+		{"IR_TimelineEvent", "IncidentID", runIDs},
+		{"IR_PropertyField", "PlaybookID", playbookIDs},
+		{"IR_MetricConfig", "PlaybookID", playbookIDs},
+		{"IR_PlaybookMember", "PlaybookID", playbookIDs},
+		{"IR_PlaybookMemberGroup", "PlaybookID", playbookIDs},
+		{"IR_PlaybookAutoFollow", "PlaybookID", playbookIDs},
+		{"IR_Category_Item", "CategoryID", categoryIDs},
+	}
+	for _, d := range deletes {
+		subQuery, args, err := d.ids.ToSql()
+		if err != nil {
+			return errors.Wrapf(err, "failed to build the query to delete from %s", d.table)
+		}
+
+		if _, err := s.store.execBuilder(tx, sq.Delete(d.table).Where(d.column+" IN ("+subQuery+")", args...)); err != nil {
+			return errors.Wrapf(err, "failed to delete from %s for team %s", d.table, teamID)
+		}
+	}
+
+	for _, table := range []string{"IR_CategoryRule", "IR_Category", "IR_Incident", "IR_Playbook"} {
+		if _, err := s.store.execBuilder(tx, sq.Delete(table).Where(sq.Eq{"TeamID": teamID})); err != nil {
+			return errors.Wrapf(err, "failed to delete from %s for team %s", table, teamID)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "could not commit")
+	}
+
+	return nil
+}
+
 func (s *playbookRunStore) ChangeCreationDate(playbookRunID string, creationTimestamp time.Time) error {
 	updateQuery := s.queryBuilder.Update("IR_Incident").
 		Where(sq.Eq{"ID": playbookRunID}).
@@ -1007,7 +1246,14 @@ func (s *playbookRunStore) buildPermissionsExpr(info app.RequesterInfo) sq.Sqliz
 							FROM IR_PlaybookMember
 							WHERE PlaybookID = i.PlaybookID
 							  AND MemberID = ?)
-		))`, info.UserID, info.UserID)
+				  OR EXISTS(
+						SELECT 1
+							FROM IR_PlaybookMemberGroup AS pmg
+							JOIN GroupMembers AS gm ON gm.GroupId = pmg.GroupID
+							WHERE pmg.PlaybookID = i.PlaybookID
+							  AND gm.UserId = ?
+							  AND gm.DeleteAt = 0)
+		))`, info.UserID, info.UserID, info.UserID)
 }
 
 func buildTeamLimitExpr(info app.RequesterInfo, teamID, tableName string) sq.Sqlizer {
@@ -1054,6 +1300,11 @@ func (s *playbookRunStore) toPlaybookRun(rawPlaybookRun sqlPlaybookRun) (*app.Pl
 		playbookRun.InvitedGroupIDs = strings.Split(rawPlaybookRun.ConcatenatedInvitedGroupIDs, ",")
 	}
 
+	playbookRun.SyncedGroupMemberIDs = []string(nil)
+	if rawPlaybookRun.ConcatenatedSyncedGroupMemberIDs != "" {
+		playbookRun.SyncedGroupMemberIDs = strings.Split(rawPlaybookRun.ConcatenatedSyncedGroupMemberIDs, ",")
+	}
+
 	playbookRun.ParticipantIDs = []string(nil)
 	if rawPlaybookRun.ConcatenatedParticipantIDs != "" {
 		playbookRun.ParticipantIDs = strings.Split(rawPlaybookRun.ConcatenatedParticipantIDs, ",")
@@ -1266,6 +1517,39 @@ func (s *playbookRunStore) GetRunsActiveTotal() (int64, error) {
 	return count, nil
 }
 
+// GetBoardLinkedRunIDs returns the IDs of the in-progress runs that are mirrored in a board.
+func (s *playbookRunStore) GetBoardLinkedRunIDs() ([]string, error) {
+	query := s.store.builder.
+		Select("ID").
+		From("IR_Incident").
+		Where(sq.Eq{"CurrentStatus": app.StatusInProgress}).
+		Where(sq.NotEq{"BoardID": ""})
+
+	var runIDs []string
+	if err := s.store.selectBuilder(s.store.db, &runIDs, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get runs linked to a board")
+	}
+
+	return runIDs, nil
+}
+
+// GetGroupSyncedRunIDs returns the IDs of the in-progress runs whose participants are kept in
+// sync with their invited groups.
+func (s *playbookRunStore) GetGroupSyncedRunIDs() ([]string, error) {
+	query := s.store.builder.
+		Select("ID").
+		From("IR_Incident").
+		Where(sq.Eq{"CurrentStatus": app.StatusInProgress}).
+		Where(sq.Eq{"SyncInvitedGroups": true})
+
+	var runIDs []string
+	if err := s.store.selectBuilder(s.store.db, &runIDs, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get runs synced with their invited groups")
+	}
+
+	return runIDs, nil
+}
+
 // GetOverdueUpdateRunsTotal returns number of runs that have overdue status updates.
 func (s *playbookRunStore) GetOverdueUpdateRunsTotal() (int64, error) {
 	query := s.store.builder.
@@ -1627,6 +1911,7 @@ func toSQLPlaybookRun(playbookRun app.PlaybookRun) (*sqlPlaybookRun, error) {
 		ConcatenatedBroadcastChannelIDs:       strings.Join(playbookRun.BroadcastChannelIDs, ","),
 		ConcatenatedWebhookOnCreationURLs:     strings.Join(playbookRun.WebhookOnCreationURLs, ","),
 		ConcatenatedWebhookOnStatusUpdateURLs: strings.Join(playbookRun.WebhookOnStatusUpdateURLs, ","),
+		ConcatenatedSyncedGroupMemberIDs:      strings.Join(playbookRun.SyncedGroupMemberIDs, ","),
 	}, nil
 }
 
@@ -1697,6 +1982,21 @@ func addMetricsToPlaybookRuns(metrics []sqlRunMetricData, playbookRuns []app.Pla
 	}
 }
 
+func addPropertyValuesToPlaybookRuns(propertyValues []sqlRunPropertyValue, playbookRuns []app.PlaybookRun) {
+	playbookRunToValues := make(map[string][]app.PropertyValue)
+	for _, v := range propertyValues {
+		playbookRunToValues[v.IncidentID] = append(playbookRunToValues[v.IncidentID],
+			app.PropertyValue{
+				FieldID: v.FieldID,
+				Value:   v.Value,
+			})
+	}
+
+	for i, run := range playbookRuns {
+		playbookRuns[i].PropertyValues = playbookRunToValues[run.ID]
+	}
+}
+
 // queryActiveBetweenTimes will modify the query only if one (or both) of start and end are non-zero.
 // If both are non-zero, return the playbook runs active between those two times.
 // If start is zero, return the playbook run active before the end (not active after the end).
@@ -628,6 +628,67 @@ func TestNukeDB(t *testing.T) {
 	})
 }
 
+func TestNukeTeamData(t *testing.T) {
+	team1id := model.NewId()
+	team2id := model.NewId()
+
+	alice := userInfo{
+		ID:   model.NewId(),
+		Name: "alice",
+	}
+
+	db := setupTestDB(t)
+	store := setupSQLStore(t, db)
+
+	setupChannelsTable(t, db)
+	setupTeamMembersTable(t, db)
+
+	playbookRunStore := setupPlaybookRunStore(t, db)
+	playbookStore := setupPlaybookStore(t, db)
+
+	members := []userInfo{alice}
+	addUsers(t, store, members)
+	addUsersToTeam(t, store, members, team1id)
+	addUsersToTeam(t, store, members, team2id)
+
+	for _, teamID := range []string{team1id, team2id} {
+		for i := 0; i < 3; i++ {
+			newPlaybook := NewPBBuilder().WithTeamID(teamID).WithMembers(members).ToPlaybook()
+			_, err := playbookStore.Create(newPlaybook)
+			require.NoError(t, err)
+
+			newPlaybookRun := NewBuilder(t).WithTeamID(teamID).WithParticipant(alice).ToPlaybookRun()
+			_, err = playbookRunStore.CreatePlaybookRun(newPlaybookRun)
+			require.NoError(t, err)
+			createPlaybookRunChannel(t, store, newPlaybookRun)
+		}
+	}
+
+	err := playbookRunStore.NukeTeamData(team1id)
+	require.NoError(t, err)
+
+	var rows int64
+	err = db.Get(&rows, db.Rebind("SELECT COUNT(*) FROM IR_Incident WHERE TeamID = ?"), team1id)
+	require.NoError(t, err)
+	require.Equal(t, 0, int(rows))
+
+	err = db.Get(&rows, db.Rebind("SELECT COUNT(*) FROM IR_Playbook WHERE TeamID = ?"), team1id)
+	require.NoError(t, err)
+	require.Equal(t, 0, int(rows))
+
+	err = db.Get(&rows, db.Rebind("SELECT COUNT(*) FROM IR_Incident WHERE TeamID = ?"), team2id)
+	require.NoError(t, err)
+	require.Equal(t, 3, int(rows))
+
+	err = db.Get(&rows, db.Rebind("SELECT COUNT(*) FROM IR_Playbook WHERE TeamID = ?"), team2id)
+	require.NoError(t, err)
+	require.Equal(t, 3, int(rows))
+
+	err = db.Get(&rows, "SELECT COUNT(*) FROM IR_PlaybookMember")
+	require.NoError(t, err)
+	require.Equal(t, 3, int(rows))
+}
+
 func TestTasksAndRunsDigest(t *testing.T) {
 	db := setupTestDB(t)
 	store := setupSQLStore(t, db)
@@ -866,6 +927,239 @@ func TestGetRunsActiveTotal(t *testing.T) {
 	})
 }
 
+func TestPropertyValues(t *testing.T) {
+	db := setupTestDB(t)
+	playbookRunStore := setupPlaybookRunStore(t, db)
+	playbookStore := setupPlaybookStore(t, db)
+	store := setupSQLStore(t, db)
+
+	setupChannelsTable(t, db)
+
+	playbook := NewPBBuilder().WithTitle("playbook").ToPlaybook()
+	playbook.PropertyFields = []app.PropertyField{
+		{Name: "Region", Type: app.PropertyFieldTypeSelect, Options: []app.PropertyOption{{Name: "EMEA"}, {Name: "APAC"}}},
+		{Name: "Service", Type: app.PropertyFieldTypeText},
+	}
+	id, err := playbookStore.Create(playbook)
+	require.NoError(t, err)
+	playbook, err = playbookStore.Get(id)
+	require.NoError(t, err)
+	require.Len(t, playbook.PropertyFields, 2)
+
+	region := playbook.PropertyFields[0]
+	service := playbook.PropertyFields[1]
+	require.Equal(t, "Region", region.Name)
+	require.Len(t, region.Options, 2)
+	require.NotEmpty(t, region.Options[0].ID)
+
+	createRun := func() *app.PlaybookRun {
+		playbookRun, err := playbookRunStore.CreatePlaybookRun(NewBuilder(t).WithPlaybookID(playbook.ID).ToPlaybookRun())
+		require.NoError(t, err)
+		createPlaybookRunChannel(t, store, playbookRun)
+		return playbookRun
+	}
+	run1 := createRun()
+	run2 := createRun()
+
+	t.Run("set, update and clear a value", func(t *testing.T) {
+		err := playbookRunStore.SetPropertyValue(run1.ID, app.PropertyValue{FieldID: service.ID, Value: "api"})
+		require.NoError(t, err)
+		err = playbookRunStore.SetPropertyValue(run1.ID, app.PropertyValue{FieldID: service.ID, Value: "web"})
+		require.NoError(t, err)
+
+		actual, err := playbookRunStore.GetPlaybookRun(run1.ID)
+		require.NoError(t, err)
+		require.Equal(t, []app.PropertyValue{{FieldID: service.ID, Value: "web"}}, actual.PropertyValues)
+
+		err = playbookRunStore.SetPropertyValue(run1.ID, app.PropertyValue{FieldID: service.ID, Value: ""})
+		require.NoError(t, err)
+
+		actual, err = playbookRunStore.GetPlaybookRun(run1.ID)
+		require.NoError(t, err)
+		require.Empty(t, actual.PropertyValues)
+	})
+
+	t.Run("filter runs by value", func(t *testing.T) {
+		emea := app.PropertyValue{FieldID: region.ID, Value: region.Options[0].ID}
+		apac := app.PropertyValue{FieldID: region.ID, Value: region.Options[1].ID}
+		require.NoError(t, playbookRunStore.SetPropertyValue(run1.ID, emea))
+		require.NoError(t, playbookRunStore.SetPropertyValue(run2.ID, apac))
+
+		results, err := playbookRunStore.GetPlaybookRuns(app.RequesterInfo{
+			UserID:  "testID",
+			IsAdmin: true,
+		}, app.PlaybookRunFilterOptions{
+			Page:           0,
+			PerPage:        10,
+			PropertyValues: []app.PropertyValue{emea},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, results.TotalCount)
+		require.Equal(t, run1.ID, results.Items[0].ID)
+		require.Equal(t, []app.PropertyValue{emea}, results.Items[0].PropertyValues)
+	})
+
+	t.Run("values of deleted fields are omitted", func(t *testing.T) {
+		playbook.PropertyFields = playbook.PropertyFields[1:]
+		require.NoError(t, playbookStore.Update(playbook))
+
+		actual, err := playbookRunStore.GetPlaybookRun(run2.ID)
+		require.NoError(t, err)
+		require.Empty(t, actual.PropertyValues)
+	})
+}
+
+func TestSeverity(t *testing.T) {
+	db := setupTestDB(t)
+	playbookRunStore := setupPlaybookRunStore(t, db)
+	store := setupSQLStore(t, db)
+
+	setupChannelsTable(t, db)
+
+	createRun := func(severity string) *app.PlaybookRun {
+		run := NewBuilder(t).ToPlaybookRun()
+		run.Severity = severity
+		playbookRun, err := playbookRunStore.CreatePlaybookRun(run)
+		require.NoError(t, err)
+		createPlaybookRunChannel(t, store, playbookRun)
+		return playbookRun
+	}
+	run1 := createRun(app.SeveritySEV1)
+	run2 := createRun(app.SeveritySEV3)
+	createRun("")
+
+	t.Run("severity is stored and updated", func(t *testing.T) {
+		actual, err := playbookRunStore.GetPlaybookRun(run1.ID)
+		require.NoError(t, err)
+		require.Equal(t, app.SeveritySEV1, actual.Severity)
+
+		actual.Severity = app.SeveritySEV2
+		_, err = playbookRunStore.UpdatePlaybookRun(actual)
+		require.NoError(t, err)
+
+		actual, err = playbookRunStore.GetPlaybookRun(run1.ID)
+		require.NoError(t, err)
+		require.Equal(t, app.SeveritySEV2, actual.Severity)
+	})
+
+	t.Run("filter runs by severity", func(t *testing.T) {
+		results, err := playbookRunStore.GetPlaybookRuns(app.RequesterInfo{
+			UserID:  "testID",
+			IsAdmin: true,
+		}, app.PlaybookRunFilterOptions{
+			Page:       0,
+			PerPage:    10,
+			Severities: []string{app.SeveritySEV2, app.SeveritySEV3},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, results.TotalCount)
+
+		ids := []string{results.Items[0].ID, results.Items[1].ID}
+		require.ElementsMatch(t, []string{run1.ID, run2.ID}, ids)
+	})
+}
+
+func TestGetBoardLinkedRunIDs(t *testing.T) {
+	db := setupTestDB(t)
+	playbookRunStore := setupPlaybookRunStore(t, db)
+	store := setupSQLStore(t, db)
+
+	createRun := func(status, boardID, cardID string) string {
+		run := NewBuilder(t).
+			WithCurrentStatus(status).
+			WithBoardCard(boardID, cardID).
+			ToPlaybookRun()
+
+		returned, err := playbookRunStore.CreatePlaybookRun(run)
+		require.NoError(t, err)
+		createPlaybookRunChannel(t, store, returned)
+
+		return returned.ID
+	}
+
+	t.Run("no runs linked to a board", func(t *testing.T) {
+		createRun(app.StatusInProgress, "", "")
+
+		actual, err := playbookRunStore.GetBoardLinkedRunIDs()
+		require.NoError(t, err)
+		require.Empty(t, actual)
+	})
+
+	t.Run("only in-progress runs linked to a board are returned", func(t *testing.T) {
+		linkedRunID := createRun(app.StatusInProgress, model.NewId(), model.NewId())
+		createRun(app.StatusFinished, model.NewId(), model.NewId())
+
+		actual, err := playbookRunStore.GetBoardLinkedRunIDs()
+		require.NoError(t, err)
+		require.Equal(t, []string{linkedRunID}, actual)
+	})
+
+	t.Run("board card is persisted", func(t *testing.T) {
+		boardID := model.NewId()
+		cardID := model.NewId()
+		runID := createRun(app.StatusInProgress, boardID, cardID)
+
+		run, err := playbookRunStore.GetPlaybookRun(runID)
+		require.NoError(t, err)
+		require.Equal(t, boardID, run.BoardID)
+		require.Equal(t, cardID, run.BoardCardID)
+	})
+}
+
+func TestGetGroupSyncedRunIDs(t *testing.T) {
+	db := setupTestDB(t)
+	playbookRunStore := setupPlaybookRunStore(t, db)
+	store := setupSQLStore(t, db)
+
+	createRun := func(status string, memberIDs []string) string {
+		builder := NewBuilder(t).WithCurrentStatus(status)
+		if memberIDs != nil {
+			builder = builder.WithSyncedGroupMembers(memberIDs)
+		}
+
+		returned, err := playbookRunStore.CreatePlaybookRun(builder.ToPlaybookRun())
+		require.NoError(t, err)
+		createPlaybookRunChannel(t, store, returned)
+
+		return returned.ID
+	}
+
+	t.Run("no runs synced with groups", func(t *testing.T) {
+		createRun(app.StatusInProgress, nil)
+
+		actual, err := playbookRunStore.GetGroupSyncedRunIDs()
+		require.NoError(t, err)
+		require.Empty(t, actual)
+	})
+
+	t.Run("only in-progress runs synced with groups are returned", func(t *testing.T) {
+		syncedRunID := createRun(app.StatusInProgress, []string{})
+		createRun(app.StatusFinished, []string{})
+
+		actual, err := playbookRunStore.GetGroupSyncedRunIDs()
+		require.NoError(t, err)
+		require.Equal(t, []string{syncedRunID}, actual)
+	})
+
+	t.Run("synced group members are persisted", func(t *testing.T) {
+		memberIDs := []string{model.NewId(), model.NewId()}
+		runID := createRun(app.StatusInProgress, memberIDs)
+
+		run, err := playbookRunStore.GetPlaybookRun(runID)
+		require.NoError(t, err)
+		require.True(t, run.SyncInvitedGroups)
+		require.Equal(t, memberIDs, run.SyncedGroupMemberIDs)
+
+		run.SyncedGroupMemberIDs = memberIDs[:1]
+		_, err = playbookRunStore.UpdatePlaybookRun(run)
+		require.NoError(t, err)
+
+		run, err = playbookRunStore.GetPlaybookRun(runID)
+		require.NoError(t, err)
+		require.Equal(t, memberIDs[:1], run.SyncedGroupMemberIDs)
+	})
+}
+
 func TestGetOverdueUpdateRunsTotal(t *testing.T) {
 	// overdue: 0 means no reminders at all. -1 means set only due reminders. 1 means set only overdue reminders.
 	createRuns := func(store *SQLStore, playbookRunStore app.PlaybookRunStore, num int, status string, overdue int) {
@@ -1658,6 +1952,20 @@ func (ib *PlaybookRunBuilder) WithRetrospectiveReminderInterval(interval int64)
 	return ib
 }
 
+func (ib *PlaybookRunBuilder) WithBoardCard(boardID, cardID string) *PlaybookRunBuilder {
+	ib.playbookRun.BoardID = boardID
+	ib.playbookRun.BoardCardID = cardID
+
+	return ib
+}
+
+func (ib *PlaybookRunBuilder) WithSyncedGroupMembers(memberIDs []string) *PlaybookRunBuilder {
+	ib.playbookRun.SyncInvitedGroups = true
+	ib.playbookRun.SyncedGroupMemberIDs = memberIDs
+
+	return ib
+}
+
 func generateMetricData(playbook app.Playbook) []app.RunMetricData {
 	metrics := make([]app.RunMetricData, 0)
 	for i, mc := range playbook.Metrics {
@@ -932,6 +932,73 @@ func TestGetPlaybooksForTeam(t *testing.T) {
 	}
 }
 
+func TestPlaybookMemberGroups(t *testing.T) {
+	teamID := model.NewId()
+	groupID := model.NewId()
+
+	alice := userInfo{ID: model.NewId(), Name: "alice"}
+	bob := userInfo{ID: model.NewId(), Name: "bob"}
+	jon := userInfo{ID: model.NewId(), Name: "jon"}
+
+	db := setupTestDB(t)
+	playbookStore := setupPlaybookStore(t, db)
+	store := setupSQLStore(t, db)
+	addUsers(t, store, []userInfo{alice, bob, jon})
+	addUsersToTeam(t, store, []userInfo{alice, bob, jon}, teamID)
+	addUsersToGroup(t, store, []userInfo{bob}, groupID)
+
+	playbook := NewPBBuilder().
+		WithTitle("private").
+		WithTeamID(teamID).
+		WithMembers([]userInfo{alice}).
+		ToPlaybook()
+	playbook.MemberGroupIDs = []string{groupID, groupID}
+
+	id, err := playbookStore.Create(playbook)
+	require.NoError(t, err)
+
+	t.Run("member groups are persisted", func(t *testing.T) {
+		actual, err := playbookStore.Get(id)
+		require.NoError(t, err)
+		require.Equal(t, []string{groupID}, actual.MemberGroupIDs)
+	})
+
+	t.Run("group members can list the playbook", func(t *testing.T) {
+		for _, tc := range []struct {
+			user     userInfo
+			expected []string
+		}{
+			{alice, []string{"private"}},
+			{bob, []string{"private"}},
+			{jon, []string{}},
+		} {
+			actual, err := playbookStore.GetPlaybooksForTeam(app.RequesterInfo{UserID: tc.user.ID, TeamID: teamID}, teamID, app.PlaybookFilterOptions{PerPage: 100})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expected, justTitles(actual.Items), tc.user.Name)
+
+			playbookIDs, err := playbookStore.GetPlaybookIDsForUser(tc.user.ID, teamID)
+			require.NoError(t, err)
+			require.Len(t, playbookIDs, len(tc.expected), tc.user.Name)
+		}
+	})
+
+	t.Run("member groups are replaced on update", func(t *testing.T) {
+		updated, err := playbookStore.Get(id)
+		require.NoError(t, err)
+
+		updated.MemberGroupIDs = nil
+		require.NoError(t, playbookStore.Update(updated))
+
+		actual, err := playbookStore.Get(id)
+		require.NoError(t, err)
+		require.Empty(t, actual.MemberGroupIDs)
+
+		results, err := playbookStore.GetPlaybooksForTeam(app.RequesterInfo{UserID: bob.ID, TeamID: teamID}, teamID, app.PlaybookFilterOptions{PerPage: 100})
+		require.NoError(t, err)
+		require.Empty(t, results.Items)
+	})
+}
+
 func justTitles(playbooks []app.Playbook) []string {
 	titles := []string{}
 	for _, pb := range playbooks {
@@ -4,7 +4,9 @@
 package sqlstore
 
 import (
+	"context"
 	"database/sql"
+	"time"
 
 	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/app"
 	"github.com/sirupsen/logrus"
@@ -20,6 +22,10 @@ import (
 // that we'll control manually.
 const maxJSONLength = 256 * 1024 // 256KB
 
+// pingTimeout bounds the time spent checking the connection to the database, so that health
+// checks fail instead of hanging when the database is unreachable.
+const pingTimeout = 5 * time.Second
+
 type SQLStore struct {
 	db        *sqlx.DB
 	builder   sq.StatementBuilderType
@@ -52,6 +58,14 @@ func New(pluginAPI PluginAPIClient, scheduler app.JobOnceScheduler) (*SQLStore,
 	}, nil
 }
 
+// Ping checks the connection to the database.
+func (sqlStore *SQLStore) Ping() error {
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+
+	return errors.Wrap(sqlStore.db.PingContext(ctx), "failed to ping the database")
+}
+
 // queryer is an interface describing a resource that can query.
 //
 // It exactly matches sqlx.Queryer, existing simply to constrain sqlx usage to this file.
@@ -82,6 +82,7 @@ func setupTables(t *testing.T, db *sqlx.DB) *SQLStore {
 	setupRolesTable(t, db)
 	setupSchemesTable(t, db)
 	setupTeamMembersTable(t, db)
+	setupGroupMembersTable(t, db)
 
 	return sqlStore
 }
@@ -252,6 +253,36 @@ func setupTeamMembersTable(t *testing.T, db *sqlx.DB) {
 	require.NoError(t, err)
 }
 
+func setupGroupMembersTable(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+
+	if db.DriverName() == model.DatabaseDriverPostgres {
+		_, err := db.Exec(`
+			CREATE TABLE IF NOT EXISTS public.groupmembers (
+				groupid character varying(26) NOT NULL,
+				userid character varying(26) NOT NULL,
+				createat bigint,
+				deleteat bigint NOT NULL,
+				PRIMARY KEY (groupid, userid)
+			);
+		`)
+		require.NoError(t, err)
+
+		return
+	}
+
+	_, err := db.Exec(`
+			CREATE TABLE IF NOT EXISTS GroupMembers (
+			  GroupId varchar(26) NOT NULL,
+			  UserId varchar(26) NOT NULL,
+			  CreateAt bigint(20) DEFAULT NULL,
+			  DeleteAt bigint(20) NOT NULL,
+			  PRIMARY KEY (GroupId,UserId)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+		`)
+	require.NoError(t, err)
+}
+
 func setupChannelMembersTable(t *testing.T, db *sqlx.DB) {
 	t.Helper()
 
@@ -704,6 +735,19 @@ func addUsersToTeam(t *testing.T, store *SQLStore, users []userInfo, teamID stri
 	require.NoError(t, err)
 }
 
+func addUsersToGroup(t *testing.T, store *SQLStore, users []userInfo, groupID string) {
+	t.Helper()
+
+	insertBuilder := store.builder.Insert("GroupMembers").Columns("GroupId", "UserId", "CreateAt", "DeleteAt")
+
+	for _, u := range users {
+		insertBuilder = insertBuilder.Values(groupID, u.ID, model.GetMillis(), 0)
+	}
+
+	_, err := store.execBuilder(store.db, insertBuilder)
+	require.NoError(t, err)
+}
+
 func addUsersToChannels(t *testing.T, store *SQLStore, users []userInfo, channelIDs []string) {
 	t.Helper()
 
@@ -0,0 +1,147 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/app"
+	"github.com/pkg/errors"
+)
+
+type sqlTelemetryEvent struct {
+	ID             string
+	Type           string
+	Name           string
+	UserID         string
+	PropertiesJSON string
+	CreateAt       int64
+}
+
+// telemetryEventStore is a sql store for the events recorded by the local telemetry sink. Use
+// NewTelemetryEventStore to create it.
+type telemetryEventStore struct {
+	store                *SQLStore
+	telemetryEventSelect sq.SelectBuilder
+}
+
+// NewTelemetryEventStore creates a new store for the local telemetry sink.
+func NewTelemetryEventStore(sqlStore *SQLStore) app.TelemetryEventStore {
+	telemetryEventSelect := sqlStore.builder.
+		Select(
+			"e.ID",
+			"e.Type",
+			"e.Name",
+			"e.UserID",
+			"COALESCE(e.PropertiesJSON, '') PropertiesJSON",
+			"e.CreateAt",
+		).
+		From("IR_TelemetryEvent e")
+
+	return &telemetryEventStore{
+		store:                sqlStore,
+		telemetryEventSelect: telemetryEventSelect,
+	}
+}
+
+// Create stores a new event.
+func (s *telemetryEventStore) Create(event app.TelemetryEvent) error {
+	if event.ID == "" {
+		event.ID = model.NewId()
+	}
+
+	propertiesJSON, err := json.Marshal(event.Properties)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal properties json for telemetry event %q", event.Name)
+	}
+
+	if len(propertiesJSON) > maxJSONLength {
+		return errors.Errorf("properties json for telemetry event %q is too long (max %d)", event.Name, maxJSONLength)
+	}
+
+	if _, err = s.store.execBuilder(s.store.db, sq.
+		Insert("IR_TelemetryEvent").
+		SetMap(map[string]interface{}{
+			"ID":             event.ID,
+			"Type":           event.Type,
+			"Name":           event.Name,
+			"UserID":         event.UserID,
+			"PropertiesJSON": string(propertiesJSON),
+			"CreateAt":       event.CreateAt,
+		})); err != nil {
+		return errors.Wrapf(err, "failed to store telemetry event %q", event.Name)
+	}
+
+	return nil
+}
+
+// GetEvents returns a page of the events, newest first.
+func (s *telemetryEventStore) GetEvents(page, perPage int) (app.GetTelemetryEventsResults, error) {
+	var rawEvents []sqlTelemetryEvent
+	err := s.store.selectBuilder(s.store.db, &rawEvents, s.telemetryEventSelect.
+		OrderBy("e.CreateAt DESC", "e.ID ASC").
+		Offset(uint64(page*perPage)).
+		Limit(uint64(perPage)))
+	if err != nil && err != sql.ErrNoRows {
+		return app.GetTelemetryEventsResults{}, errors.Wrap(err, "failed to get telemetry events")
+	}
+
+	var total int
+	if err = s.store.getBuilder(s.store.db, &total, s.store.builder.Select("COUNT(*)").From("IR_TelemetryEvent")); err != nil {
+		return app.GetTelemetryEventsResults{}, errors.Wrap(err, "failed to get total count")
+	}
+
+	events := make([]app.TelemetryEvent, 0, len(rawEvents))
+	for _, rawEvent := range rawEvents {
+		event := app.TelemetryEvent{
+			ID:       rawEvent.ID,
+			Type:     rawEvent.Type,
+			Name:     rawEvent.Name,
+			UserID:   rawEvent.UserID,
+			CreateAt: rawEvent.CreateAt,
+		}
+		if rawEvent.PropertiesJSON != "" {
+			if err = json.Unmarshal([]byte(rawEvent.PropertiesJSON), &event.Properties); err != nil {
+				return app.GetTelemetryEventsResults{}, errors.Wrapf(err, "failed to unmarshal properties json for telemetry event %q", rawEvent.ID)
+			}
+		}
+		events = append(events, event)
+	}
+
+	pageCount := 0
+	if perPage > 0 {
+		pageCount = int(math.Ceil(float64(total) / float64(perPage)))
+	}
+
+	return app.GetTelemetryEventsResults{
+		TotalCount: total,
+		PageCount:  pageCount,
+		HasMore:    page+1 < pageCount,
+		Items:      events,
+	}, nil
+}
+
+// DeleteBefore deletes the events created before the given timestamp, in milliseconds.
+func (s *telemetryEventStore) DeleteBefore(createAt int64) error {
+	if _, err := s.store.execBuilder(s.store.db, sq.
+		Delete("IR_TelemetryEvent").
+		Where(sq.Lt{"CreateAt": createAt})); err != nil {
+		return errors.Wrap(err, "failed to delete old telemetry events")
+	}
+
+	return nil
+}
+
+// DeleteAll deletes all the events.
+func (s *telemetryEventStore) DeleteAll() error {
+	if _, err := s.store.execBuilder(s.store.db, sq.Delete("IR_TelemetryEvent")); err != nil {
+		return errors.Wrap(err, "failed to delete telemetry events")
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 type sqlUserInfo struct {
 	app.UserInfo
 	DigestNotificationSettingsJSON json.RawMessage
+	TourStateJSON                  json.RawMessage
 }
 
 type userInfoStore struct {
@@ -30,7 +31,7 @@ var _ app.UserInfoStore = (*userInfoStore)(nil)
 
 func NewUserInfoStore(sqlStore *SQLStore) app.UserInfoStore {
 	userInfoSelect := sqlStore.builder.
-		Select("ID", "LastDailyTodoDMAt", "COALESCE(DigestNotificationSettingsJSON, '{}') DigestNotificationSettingsJSON").
+		Select("ID", "LastDailyTodoDMAt", "COALESCE(DigestNotificationSettingsJSON, '{}') DigestNotificationSettingsJSON", "TourStateJSON").
 		From("IR_UserInfo")
 
 	newStore := &userInfoStore{
@@ -67,17 +68,17 @@ func (s *userInfoStore) Upsert(info app.UserInfo) error {
 	if s.store.db.DriverName() == model.DatabaseDriverMysql {
 		_, err = s.store.execBuilder(s.store.db,
 			sq.Insert("IR_UserInfo").
-				Columns("ID", "LastDailyTodoDMAt", "DigestNotificationSettingsJSON").
-				Values(raw.ID, raw.LastDailyTodoDMAt, raw.DigestNotificationSettingsJSON).
-				Suffix("ON DUPLICATE KEY UPDATE LastDailyTodoDMAt = ?, DigestNotificationSettingsJSON = ?",
-					raw.LastDailyTodoDMAt, raw.DigestNotificationSettingsJSON))
+				Columns("ID", "LastDailyTodoDMAt", "DigestNotificationSettingsJSON", "TourStateJSON").
+				Values(raw.ID, raw.LastDailyTodoDMAt, raw.DigestNotificationSettingsJSON, raw.TourStateJSON).
+				Suffix("ON DUPLICATE KEY UPDATE LastDailyTodoDMAt = ?, DigestNotificationSettingsJSON = ?, TourStateJSON = ?",
+					raw.LastDailyTodoDMAt, raw.DigestNotificationSettingsJSON, raw.TourStateJSON))
 	} else {
 		_, err = s.store.execBuilder(s.store.db,
 			sq.Insert("IR_UserInfo").
-				Columns("ID", "LastDailyTodoDMAt", "DigestNotificationSettingsJSON").
-				Values(raw.ID, raw.LastDailyTodoDMAt, raw.DigestNotificationSettingsJSON).
-				Suffix("ON CONFLICT (ID) DO UPDATE SET LastDailyTodoDMAt = ?, DigestNotificationSettingsJSON = ?",
-					raw.LastDailyTodoDMAt, raw.DigestNotificationSettingsJSON))
+				Columns("ID", "LastDailyTodoDMAt", "DigestNotificationSettingsJSON", "TourStateJSON").
+				Values(raw.ID, raw.LastDailyTodoDMAt, raw.DigestNotificationSettingsJSON, raw.TourStateJSON).
+				Suffix("ON CONFLICT (ID) DO UPDATE SET LastDailyTodoDMAt = ?, DigestNotificationSettingsJSON = ?, TourStateJSON = ?",
+					raw.LastDailyTodoDMAt, raw.DigestNotificationSettingsJSON, raw.TourStateJSON))
 	}
 
 	if err != nil {
@@ -89,12 +90,16 @@ func (s *userInfoStore) Upsert(info app.UserInfo) error {
 
 func toUserInfo(rawUserInfo sqlUserInfo) (app.UserInfo, error) {
 	userInfo := rawUserInfo.UserInfo
-	if len(rawUserInfo.DigestNotificationSettingsJSON) == 0 {
-		return userInfo, nil
+	if len(rawUserInfo.DigestNotificationSettingsJSON) > 0 {
+		if err := json.Unmarshal(rawUserInfo.DigestNotificationSettingsJSON, &userInfo.DigestNotificationSettings); err != nil {
+			return userInfo, errors.Wrapf(err, "failed to unmarshal DigestNotificationSettings for userid: %s", userInfo.ID)
+		}
 	}
 
-	if err := json.Unmarshal(rawUserInfo.DigestNotificationSettingsJSON, &userInfo.DigestNotificationSettings); err != nil {
-		return userInfo, errors.Wrapf(err, "failed to unmarshal DigestNotificationSettings for userid: %s", userInfo.ID)
+	if len(rawUserInfo.TourStateJSON) > 0 {
+		if err := json.Unmarshal(rawUserInfo.TourStateJSON, &userInfo.TourState); err != nil {
+			return userInfo, errors.Wrapf(err, "failed to unmarshal TourState for userid: %s", userInfo.ID)
+		}
 	}
 
 	return userInfo, nil
@@ -110,8 +115,18 @@ func toSQLUserInfo(userInfo app.UserInfo) (*sqlUserInfo, error) {
 		return nil, errors.Wrapf(errors.New("invalid data"), "digestNotificationSettings json for user id '%s' is too long (max %d)", userInfo.ID, maxJSONLength)
 	}
 
+	tourStateJSON, err := json.Marshal(userInfo.TourState)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal TourState for userid: %s", userInfo.ID)
+	}
+
+	if len(tourStateJSON) > maxJSONLength {
+		return nil, errors.Wrapf(errors.New("invalid data"), "tourState json for user id '%s' is too long (max %d)", userInfo.ID, maxJSONLength)
+	}
+
 	return &sqlUserInfo{
 		UserInfo:                       userInfo,
 		DigestNotificationSettingsJSON: digestNotificationSettingsJSON,
+		TourStateJSON:                  tourStateJSON,
 	}, nil
 }
@@ -151,6 +151,29 @@ func Test_userInfoStore_Upsert(t *testing.T) {
 			t.Errorf("Get() actual = %#v, expected %#v", actual, expected)
 		}
 	})
+
+	t.Run("upserts tour state correctly", func(t *testing.T) {
+		expected := app.UserInfo{
+			ID:        model.NewId(),
+			TourState: app.TourState{"playbook_edit": 2},
+		}
+
+		err := userInfoStore.Upsert(expected)
+		require.NoError(t, err)
+
+		actual, err := userInfoStore.Get(expected.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+
+		// reset:
+		expected.TourState = nil
+		err = userInfoStore.Upsert(expected)
+		require.NoError(t, err)
+
+		actual, err = userInfoStore.Get(expected.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
 }
 
 func setupUserInfoStore(t *testing.T, db *sqlx.DB) app.UserInfoStore {
@@ -0,0 +1,89 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package telemetry
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	rudder "github.com/rudderlabs/analytics-go"
+)
+
+// fileClient implements rudder.Client by appending the events to a local file, one JSON object
+// per line, so that diagnostics can be collected on air-gapped servers.
+type fileClient struct {
+	mutex  sync.Mutex
+	file   *os.File
+	closed bool
+}
+
+// fileEvent is the line written for each event.
+type fileEvent struct {
+	Type      string         `json:"type"`
+	Timestamp time.Time      `json:"timestamp"`
+	Message   rudder.Message `json:"message"`
+}
+
+func newFileClient(path string) (*fileClient, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open telemetry file %s", path)
+	}
+
+	return &fileClient{file: file}, nil
+}
+
+// Enqueue writes the event to the file.
+func (c *fileClient) Enqueue(message rudder.Message) error {
+	if err := message.Validate(); err != nil {
+		return err
+	}
+
+	event := fileEvent{
+		Timestamp: time.Now().UTC(),
+		Message:   message,
+	}
+	switch message.(type) {
+	case rudder.Track:
+		event.Type = "track"
+	case rudder.Page:
+		event.Type = "page"
+	default:
+		event.Type = "other"
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal telemetry event")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return rudder.ErrClosed
+	}
+
+	if _, err := c.file.Write(append(data, '\n')); err != nil {
+		return errors.Wrap(err, "failed to write telemetry event")
+	}
+
+	return nil
+}
+
+// Close closes the file. Events are written as they are enqueued, so there is nothing to flush.
+func (c *fileClient) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return rudder.ErrClosed
+	}
+	c.closed = true
+
+	return c.file.Close()
+}
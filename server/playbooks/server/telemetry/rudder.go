@@ -20,6 +20,8 @@ type RudderTelemetry struct {
 	serverVersion string
 	writeKey      string
 	dataPlaneURL  string
+	filePath      string
+	store         app.TelemetryEventStore
 	enabled       bool
 	mutex         sync.RWMutex
 }
@@ -95,21 +97,23 @@ const (
 // Migrated
 // actionRunActionsUpdate = "update_playbookrun_actions" => playbookrun_update_actions
 
+// Sink is where the telemetry events are sent: the Rudder data plane at DataPlaneURL, identified
+// with WriteKey or, if FilePath is set, a local file the events are appended to or, if Store is
+// set, the database, where admins can inspect them.
+type Sink struct {
+	DataPlaneURL string
+	WriteKey     string
+	FilePath     string
+	Store        app.TelemetryEventStore
+}
+
 // NewRudder builds a new RudderTelemetry client that will send the events to
 // dataPlaneURL with the writeKey, identified with the diagnosticID. The
 // version of the server is also sent with every event tracked.
 // If either diagnosticID or serverVersion are empty, an error is returned.
 func NewRudder(dataPlaneURL, writeKey, diagnosticID, pluginVersion, serverVersion string) (*RudderTelemetry, error) {
-	if diagnosticID == "" {
-		return nil, errors.New("diagnosticID should not be empty")
-	}
-
-	if pluginVersion == "" {
-		return nil, errors.New("pluginVersion should not be empty")
-	}
-
-	if serverVersion == "" {
-		return nil, errors.New("serverVersion should not be empty")
+	if err := validateVersions(diagnosticID, pluginVersion, serverVersion); err != nil {
+		return nil, err
 	}
 
 	client, err := rudder.NewWithConfig(writeKey, dataPlaneURL, rudder.Config{})
@@ -128,6 +132,41 @@ func NewRudder(dataPlaneURL, writeKey, diagnosticID, pluginVersion, serverVersio
 	}, nil
 }
 
+// NewRudderWithSink builds a new RudderTelemetry client that will send the events to the given
+// sink, once enabled. Unlike NewRudder, the client starts disabled, so that the sink can be
+// changed with Reconfigure before any event is sent.
+func NewRudderWithSink(sink Sink, diagnosticID, pluginVersion, serverVersion string) (*RudderTelemetry, error) {
+	if err := validateVersions(diagnosticID, pluginVersion, serverVersion); err != nil {
+		return nil, err
+	}
+
+	return &RudderTelemetry{
+		diagnosticID:  diagnosticID,
+		pluginVersion: pluginVersion,
+		serverVersion: serverVersion,
+		writeKey:      sink.WriteKey,
+		dataPlaneURL:  sink.DataPlaneURL,
+		filePath:      sink.FilePath,
+		store:         sink.Store,
+	}, nil
+}
+
+func validateVersions(diagnosticID, pluginVersion, serverVersion string) error {
+	if diagnosticID == "" {
+		return errors.New("diagnosticID should not be empty")
+	}
+
+	if pluginVersion == "" {
+		return errors.New("pluginVersion should not be empty")
+	}
+
+	if serverVersion == "" {
+		return errors.New("serverVersion should not be empty")
+	}
+
+	return nil
+}
+
 // trackOld is the generic tracker for events to rudderstack that is backwards compatible with
 // old events (string based instead of enum).
 //
@@ -612,7 +651,7 @@ func (t *RudderTelemetry) Enable() error {
 		return nil
 	}
 
-	newClient, err := rudder.NewWithConfig(t.writeKey, t.dataPlaneURL, rudder.Config{})
+	newClient, err := t.newClient()
 	if err != nil {
 		return errors.Wrap(err, "creating a new Rudder client in Enable failed")
 	}
@@ -622,6 +661,53 @@ func (t *RudderTelemetry) Enable() error {
 	return nil
 }
 
+// Reconfigure changes the sink the events are sent to. If the telemetry is enabled, the client
+// of the previous sink is closed, flushing its pending events, and the new sink is used for all
+// future events.
+func (t *RudderTelemetry) Reconfigure(sink Sink) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if sink.DataPlaneURL == t.dataPlaneURL && sink.WriteKey == t.writeKey && sink.FilePath == t.filePath && sink.Store == t.store {
+		return nil
+	}
+
+	t.writeKey = sink.WriteKey
+	t.dataPlaneURL = sink.DataPlaneURL
+	t.filePath = sink.FilePath
+	t.store = sink.Store
+
+	if !t.enabled {
+		return nil
+	}
+
+	if err := t.client.Close(); err != nil {
+		return errors.Wrap(err, "closing the Rudder client in Reconfigure failed")
+	}
+
+	newClient, err := t.newClient()
+	if err != nil {
+		t.enabled = false
+		return errors.Wrap(err, "creating a new Rudder client in Reconfigure failed")
+	}
+
+	t.client = newClient
+	return nil
+}
+
+// newClient creates a client sending the events to the configured sink.
+func (t *RudderTelemetry) newClient() (rudder.Client, error) {
+	if t.store != nil {
+		return newStoreClient(t.store), nil
+	}
+
+	if t.filePath != "" {
+		return newFileClient(t.filePath)
+	}
+
+	return rudder.NewWithConfig(t.writeKey, t.dataPlaneURL, rudder.Config{})
+}
+
 // Disable disables telemetry for all future events. It does nothing if the
 // client is already disabled.
 func (t *RudderTelemetry) Disable() error {
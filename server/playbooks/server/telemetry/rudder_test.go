@@ -8,6 +8,9 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -557,3 +560,117 @@ func TestRunActionProperties(t *testing.T) {
 
 	require.Equal(t, expectedProperties, properties)
 }
+
+func readFileEvents(t *testing.T, path string) []fileEvent {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var events []fileEvent
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if line == "" {
+			continue
+		}
+
+		var event struct {
+			fileEvent
+			Message json.RawMessage `json:"message"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+		events = append(events, event.fileEvent)
+	}
+
+	return events
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	firstPath := filepath.Join(dir, "first.jsonl")
+	secondPath := filepath.Join(dir, "second.jsonl")
+
+	rudderClient, err := NewRudderWithSink(Sink{FilePath: firstPath}, diagnosticID, pluginVersion, serverVersion)
+	require.NoError(t, err)
+
+	t.Run("disabled until enabled", func(t *testing.T) {
+		rudderClient.Track(app.TelemetryTrack(0), map[string]interface{}{})
+		require.NoFileExists(t, firstPath)
+	})
+
+	t.Run("events are appended to the file", func(t *testing.T) {
+		require.NoError(t, rudderClient.Enable())
+
+		rudderClient.CreatePlaybookRun(dummyPlaybookRun, dummyUserID, true)
+		rudderClient.Page(app.TelemetryPage(0), map[string]interface{}{})
+
+		events := readFileEvents(t, firstPath)
+		require.Len(t, events, 2)
+		require.Equal(t, "track", events[0].Type)
+		require.Equal(t, "page", events[1].Type)
+	})
+
+	t.Run("reconfigure switches the file", func(t *testing.T) {
+		require.NoError(t, rudderClient.Reconfigure(Sink{FilePath: secondPath}))
+
+		rudderClient.CreatePlaybookRun(dummyPlaybookRun, dummyUserID, true)
+
+		require.Len(t, readFileEvents(t, firstPath), 2)
+		require.Len(t, readFileEvents(t, secondPath), 1)
+	})
+
+	t.Run("no events once disabled", func(t *testing.T) {
+		require.NoError(t, rudderClient.Disable())
+
+		rudderClient.CreatePlaybookRun(dummyPlaybookRun, dummyUserID, true)
+
+		require.Len(t, readFileEvents(t, secondPath), 1)
+	})
+}
+
+type memoryEventStore struct {
+	events       []app.TelemetryEvent
+	deleteBefore int64
+}
+
+func (s *memoryEventStore) Create(event app.TelemetryEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *memoryEventStore) GetEvents(page, perPage int) (app.GetTelemetryEventsResults, error) {
+	return app.GetTelemetryEventsResults{TotalCount: len(s.events), Items: s.events}, nil
+}
+
+func (s *memoryEventStore) DeleteBefore(createAt int64) error {
+	s.deleteBefore = createAt
+	return nil
+}
+
+func (s *memoryEventStore) DeleteAll() error {
+	s.events = nil
+	return nil
+}
+
+func TestStoreSink(t *testing.T) {
+	store := &memoryEventStore{}
+
+	rudderClient, err := NewRudderWithSink(Sink{Store: store}, diagnosticID, pluginVersion, serverVersion)
+	require.NoError(t, err)
+
+	require.NoError(t, rudderClient.Enable())
+	require.NotZero(t, store.deleteBefore, "old events should be pruned when the sink is set up")
+
+	rudderClient.CreatePlaybookRun(dummyPlaybookRun, dummyUserID, true)
+
+	require.Len(t, store.events, 1)
+	event := store.events[0]
+	require.Equal(t, app.TelemetryEventTypeTrack, event.Type)
+	require.Equal(t, eventPlaybookRun, event.Name)
+	require.Equal(t, diagnosticID, event.UserID)
+	require.Equal(t, actionCreate, event.Properties["Action"])
+	require.Equal(t, pluginVersion, event.Properties["PluginVersion"])
+
+	require.NoError(t, rudderClient.Disable())
+	rudderClient.CreatePlaybookRun(dummyPlaybookRun, dummyUserID, true)
+	require.Len(t, store.events, 1)
+}
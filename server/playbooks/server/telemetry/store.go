@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package telemetry
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/server/playbooks/server/app"
+	"github.com/pkg/errors"
+	rudder "github.com/rudderlabs/analytics-go"
+	"github.com/sirupsen/logrus"
+)
+
+// storeClient implements rudder.Client by recording the events in the database instead of
+// sending them, so that admins can audit what would be sent before enabling Rudder.
+type storeClient struct {
+	mutex  sync.RWMutex
+	store  app.TelemetryEventStore
+	closed bool
+}
+
+func newStoreClient(store app.TelemetryEventStore) *storeClient {
+	// The events are only pruned when the sink is set up, which is enough to keep the table
+	// bounded without running a dedicated job.
+	cutoff := model.GetMillisForTime(time.Now().Add(-app.TelemetryEventRetention))
+	if err := store.DeleteBefore(cutoff); err != nil {
+		logrus.WithError(err).Warn("failed to prune old telemetry events")
+	}
+
+	return &storeClient{store: store}
+}
+
+// Enqueue records the event.
+func (c *storeClient) Enqueue(message rudder.Message) error {
+	if err := message.Validate(); err != nil {
+		return err
+	}
+
+	event := app.TelemetryEvent{
+		ID:       model.NewId(),
+		CreateAt: model.GetMillis(),
+	}
+	switch m := message.(type) {
+	case rudder.Track:
+		event.Type = app.TelemetryEventTypeTrack
+		event.Name = m.Event
+		event.UserID = m.UserId
+		event.Properties = m.Properties
+	case rudder.Page:
+		event.Type = app.TelemetryEventTypePage
+		event.Name = m.Name
+		event.UserID = m.UserId
+		event.Properties = m.Properties
+	default:
+		return errors.Errorf("unsupported telemetry message %T", message)
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if c.closed {
+		return rudder.ErrClosed
+	}
+
+	return c.store.Create(event)
+}
+
+// Close stops recording events. Events are recorded as they are enqueued, so there is nothing to
+// flush.
+func (c *storeClient) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return rudder.ErrClosed
+	}
+	c.closed = true
+
+	return nil
+}
@@ -98,10 +98,10 @@ export function getOAuthAppInfo(clientId) {
     });
 }
 
-export function allowOAuth2({responseType, clientId, redirectUri, state, scope}) {
+export function allowOAuth2({responseType, clientId, redirectUri, state, scope, codeChallenge, codeChallengeMethod, nonce}) {
     return bindClientFunc({
         clientFunc: Client4.authorizeOAuthApp,
-        params: [responseType, clientId, redirectUri, state, scope],
+        params: [responseType, clientId, redirectUri, state, scope, codeChallenge, codeChallengeMethod, nonce],
     });
 }
 
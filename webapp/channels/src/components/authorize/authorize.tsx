@@ -17,6 +17,9 @@ export type Params = {
     redirectUri: string | null;
     state: string | null;
     scope: string | null;
+    codeChallenge: string | null;
+    codeChallengeMethod: string | null;
+    nonce: string | null;
 }
 
 type Props = {
@@ -67,7 +70,10 @@ export default class Authorize extends React.PureComponent<Props, State> {
             clientId: searchParams.get('client_id'),
             redirectUri: searchParams.get('redirect_uri'),
             state: searchParams.get('state'),
-            scope: searchParams.get('store'),
+            scope: searchParams.get('scope'),
+            codeChallenge: searchParams.get('code_challenge'),
+            codeChallengeMethod: searchParams.get('code_challenge_method'),
+            nonce: searchParams.get('nonce'),
         };
 
         this.props.actions.allowOAuth2(params).then(
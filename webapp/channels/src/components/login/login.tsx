@@ -86,12 +86,15 @@ const Login = ({onCustomizeHeader}: LoginProps) => {
         EnableSignUpWithOffice365,
         EnableSignUpWithGoogle,
         EnableSignUpWithOpenId,
+        EnableSignUpWithOIDC,
         EnableOpenServer,
         LdapLoginFieldName,
         GitLabButtonText,
         GitLabButtonColor,
         OpenIdButtonText,
         OpenIdButtonColor,
+        OIDCButtonText,
+        OIDCButtonColor,
         SamlLoginButtonText,
         EnableCustomBrand,
         CustomBrandText,
@@ -133,13 +136,14 @@ const Login = ({onCustomizeHeader}: LoginProps) => {
     const enableSignUpWithGoogle = EnableSignUpWithGoogle === 'true';
     const enableSignUpWithOffice365 = EnableSignUpWithOffice365 === 'true';
     const enableSignUpWithOpenId = EnableSignUpWithOpenId === 'true';
+    const enableSignUpWithOIDC = EnableSignUpWithOIDC === 'true';
     const isLicensed = IsLicensed === 'true';
     const ldapEnabled = isLicensed && enableLdap;
     const enableSignUpWithSaml = isLicensed && enableSaml;
     const siteName = SiteName ?? '';
 
     const enableBaseLogin = enableSignInWithEmail || enableSignInWithUsername || ldapEnabled;
-    const enableExternalSignup = enableSignUpWithGitLab || enableSignUpWithOffice365 || enableSignUpWithGoogle || enableSignUpWithOpenId || enableSignUpWithSaml;
+    const enableExternalSignup = enableSignUpWithGitLab || enableSignUpWithOffice365 || enableSignUpWithGoogle || enableSignUpWithOpenId || enableSignUpWithOIDC || enableSignUpWithSaml;
     const showSignup = enableOpenServer && (enableExternalSignup || enableSignUpWithEmail || enableLdap);
 
     const getExternalLoginOptions = () => {
@@ -187,6 +191,16 @@ const Login = ({onCustomizeHeader}: LoginProps) => {
             });
         }
 
+        if (enableSignUpWithOIDC) {
+            externalLoginOptions.push({
+                id: 'oidc',
+                url: `${Client4.getOAuthRoute()}/oidc/login${search}`,
+                icon: <LoginOpenIDIcon/>,
+                label: OIDCButtonText || formatMessage({id: 'login.oidc', defaultMessage: 'OpenID Connect'}),
+                style: {color: OIDCButtonColor, borderColor: OIDCButtonColor},
+            });
+        }
+
         if (enableSignUpWithSaml) {
             externalLoginOptions.push({
                 id: 'saml',
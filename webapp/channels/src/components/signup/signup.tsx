@@ -83,6 +83,7 @@ const Signup = ({onCustomizeHeader}: SignupProps) => {
         EnableSignUpWithGoogle,
         EnableSignUpWithOffice365,
         EnableSignUpWithOpenId,
+        EnableSignUpWithOIDC,
         EnableLdap,
         EnableSaml,
         SamlLoginButtonText,
@@ -93,6 +94,8 @@ const Signup = ({onCustomizeHeader}: SignupProps) => {
         GitLabButtonColor,
         OpenIdButtonText,
         OpenIdButtonColor,
+        OIDCButtonText,
+        OIDCButtonColor,
         EnableCustomBrand,
         CustomBrandText,
         TermsOfServiceLink,
@@ -116,6 +119,7 @@ const Signup = ({onCustomizeHeader}: SignupProps) => {
     const enableSignUpWithGoogle = EnableSignUpWithGoogle === 'true';
     const enableSignUpWithOffice365 = EnableSignUpWithOffice365 === 'true';
     const enableSignUpWithOpenId = EnableSignUpWithOpenId === 'true';
+    const enableSignUpWithOIDC = EnableSignUpWithOIDC === 'true';
     const enableLDAP = EnableLdap === 'true';
     const enableSAML = EnableSaml === 'true';
     const enableCustomBrand = EnableCustomBrand === 'true';
@@ -136,7 +140,7 @@ const Signup = ({onCustomizeHeader}: SignupProps) => {
     const [alertBanner, setAlertBanner] = useState<AlertBannerProps | null>(null);
     const [isMobileView, setIsMobileView] = useState(false);
 
-    const enableExternalSignup = enableSignUpWithGitLab || enableSignUpWithOffice365 || enableSignUpWithGoogle || enableSignUpWithOpenId || enableLDAP || enableSAML;
+    const enableExternalSignup = enableSignUpWithGitLab || enableSignUpWithOffice365 || enableSignUpWithGoogle || enableSignUpWithOpenId || enableSignUpWithOIDC || enableLDAP || enableSAML;
     const hasError = Boolean(emailError || nameError || passwordError || serverError || alertBanner);
     const canSubmit = Boolean(email && name && password) && !hasError && !loading;
     const {error: passwordInfo} = isValidPassword('', getPasswordConfig(config), intl);
@@ -186,6 +190,16 @@ const Signup = ({onCustomizeHeader}: SignupProps) => {
             });
         }
 
+        if (enableSignUpWithOIDC) {
+            externalLoginOptions.push({
+                id: 'oidc',
+                url: `${Client4.getOAuthRoute()}/oidc/signup${search}`,
+                icon: <LoginOpenIDIcon/>,
+                label: OIDCButtonText || formatMessage({id: 'login.oidc', defaultMessage: 'OpenID Connect'}),
+                style: {color: OIDCButtonColor, borderColor: OIDCButtonColor},
+            });
+        }
+
         if (isLicensed && enableLDAP) {
             const newSearchParam = new URLSearchParams(search);
             newSearchParam.set('extra', Constants.CREATE_LDAP);
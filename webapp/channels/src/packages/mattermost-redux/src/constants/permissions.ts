@@ -250,6 +250,7 @@ const values = {
     SYSCONSOLE_WRITE_PERMISSIONS: [] as string[],
     MANAGE_SHARED_CHANNELS: 'manage_shared_channels',
     MANAGE_SECURE_CONNECTIONS: 'manage_secure_connections',
+    MANAGE_PLAYBOOKS: 'manage_playbooks',
 
     CREATE_CUSTOM_GROUP: 'create_custom_group',
     MANAGE_CUSTOM_GROUP_MEMBERS: 'manage_custom_group_members',
@@ -7,7 +7,7 @@ import FormData from 'form-data';
 
 import {PreferenceType} from '@mattermost/types/preferences';
 import {SystemSetting} from '@mattermost/types/general';
-import {ClusterInfo, AnalyticsRow, SchemaMigration, LogFilter} from '@mattermost/types/admin';
+import {ClusterInfo, AnalyticsRow, SchemaMigration, MigrationsStatus, LogFilter} from '@mattermost/types/admin';
 import type {AppBinding, AppCallRequest, AppCallResponse} from '@mattermost/types/apps';
 import {Audit} from '@mattermost/types/audits';
 import {UserAutocomplete, AutocompleteSuggestion} from '@mattermost/types/autocomplete';
@@ -37,7 +37,10 @@ import {ChannelCategory, OrderedChannelCategories} from '@mattermost/types/chann
 
 import {
     Channel,
+    ChannelJoinRequest,
+    ChannelJoinRequestStatus,
     ChannelMemberCountsByGroup,
+    ChannelMemberExpiry,
     ChannelMembership,
     ChannelModeration,
     ChannelModerationPatch,
@@ -57,6 +60,8 @@ import {
     DataRetentionPolicy,
     License,
     AdminConfig,
+    ConfigChange,
+    ConfigValidationReport,
     EnvironmentConfig,
     RequestLicenseBody,
 } from '@mattermost/types/config';
@@ -101,13 +106,14 @@ import type {
 import {Post, PostList, PostSearchResults, OpenGraphMetadata, PostsUsageResponse, TeamsUsageResponse, PaginatedPostList, FilesUsageResponse, PostAcknowledgement, PostAnalytics} from '@mattermost/types/posts';
 import {Draft} from '@mattermost/types/drafts';
 import {Reaction} from '@mattermost/types/reactions';
-import {Role} from '@mattermost/types/roles';
+import {CustomAdminRole, Role, RoleSnapshot} from '@mattermost/types/roles';
 import {SamlCertificateStatus, SamlMetadataResponse} from '@mattermost/types/saml';
 import {Scheme} from '@mattermost/types/schemes';
-import {Session} from '@mattermost/types/sessions';
+import {Session, SessionDetailed} from '@mattermost/types/sessions';
 import {
     GetTeamMembersOpts,
     Team,
+    TeamArchive,
     TeamInviteWithError,
     TeamMembership,
     TeamMemberWithError,
@@ -116,6 +122,11 @@ import {
     TeamUnread,
     TeamSearchOpts,
 } from '@mattermost/types/teams';
+import {
+    TeamTemplate,
+    TeamTemplateInstance,
+    TeamTemplateInstantiation,
+} from '@mattermost/types/team_templates';
 import {TermsOfService} from '@mattermost/types/terms_of_service';
 import {
     AuthChangeResponse,
@@ -125,6 +136,11 @@ import {
     UserStatus,
     GetFilteredUsersStatsOpts,
     UserCustomStatus,
+    GuestSponsorship,
+    GuestSponsorshipPatch,
+    GuestSponsorReport,
+    OffboardingReport,
+    SupportAccessConsent,
 } from '@mattermost/types/users';
 import {DeepPartial, RelationOneToOne} from '@mattermost/types/utilities';
 import {ProductNotices} from '@mattermost/types/product_notices';
@@ -291,6 +307,14 @@ export default class Client4 {
         return `${this.getTeamsRoute()}/name/${teamName}`;
     }
 
+    getTeamTemplatesRoute() {
+        return `${this.getBaseRoute()}/team_templates`;
+    }
+
+    getTeamTemplateRoute(templateId: string) {
+        return `${this.getTeamTemplatesRoute()}/${templateId}`;
+    }
+
     getTeamMembersRoute(teamId: string) {
         return `${this.getTeamRoute(teamId)}/members`;
     }
@@ -631,6 +655,83 @@ export default class Client4 {
         );
     }
 
+    getGuestSponsorship = (userId: string) => {
+        return this.doFetch<GuestSponsorship>(
+            `${this.getUserRoute(userId)}/guest_sponsorship`,
+            {method: 'get'},
+        );
+    }
+
+    patchGuestSponsorship = (userId: string, patch: GuestSponsorshipPatch) => {
+        return this.doFetch<GuestSponsorship>(
+            `${this.getUserRoute(userId)}/guest_sponsorship`,
+            {method: 'put', body: JSON.stringify(patch)},
+        );
+    }
+
+    renewGuestSponsorship = (userId: string, expiresAt?: number) => {
+        return this.doFetch<GuestSponsorship>(
+            `${this.getUserRoute(userId)}/guest_sponsorship/renew`,
+            {method: 'post', body: JSON.stringify({expires_at: expiresAt})},
+        );
+    }
+
+    getSponsoredGuests = (sponsorId: string, page = 0, perPage = PER_PAGE_DEFAULT) => {
+        return this.doFetch<GuestSponsorship[]>(
+            `${this.getUserRoute(sponsorId)}/sponsored_guests${buildQueryString({page, per_page: perPage})}`,
+            {method: 'get'},
+        );
+    }
+
+    getGuestSponsorReport = (page = 0, perPage = PER_PAGE_DEFAULT) => {
+        return this.doFetch<GuestSponsorReport[]>(
+            `${this.getUsersRoute()}/guest_sponsors/report${buildQueryString({page, per_page: perPage})}`,
+            {method: 'get'},
+        );
+    }
+
+    startUserOffboarding = (userId: string, successorId = '') => {
+        return this.doFetch<Job>(
+            `${this.getUserRoute(userId)}/offboarding`,
+            {method: 'post', body: JSON.stringify({successor_id: successorId})},
+        );
+    }
+
+    getUserOffboardingReports = (userId: string) => {
+        return this.doFetch<OffboardingReport[]>(
+            `${this.getUserRoute(userId)}/offboarding`,
+            {method: 'get'},
+        );
+    }
+
+    grantSupportAccessConsent = (userId: string, adminId: string, durationMinutes = 0) => {
+        return this.doFetch<SupportAccessConsent>(
+            `${this.getUserRoute(userId)}/support_access/consent`,
+            {method: 'post', body: JSON.stringify({admin_id: adminId, duration_minutes: durationMinutes})},
+        );
+    }
+
+    getSupportAccessConsents = (userId: string) => {
+        return this.doFetch<SupportAccessConsent[]>(
+            `${this.getUserRoute(userId)}/support_access/consents`,
+            {method: 'get'},
+        );
+    }
+
+    revokeSupportAccessConsents = (userId: string) => {
+        return this.doFetch<StatusOK>(
+            `${this.getUserRoute(userId)}/support_access/consent`,
+            {method: 'delete'},
+        );
+    }
+
+    createSupportAccessSession = (userId: string) => {
+        return this.doFetch<Session>(
+            `${this.getUserRoute(userId)}/support_access/session`,
+            {method: 'post'},
+        );
+    }
+
     updateUserRoles = (userId: string, roles: string) => {
         this.trackEvent('api', 'api_users_update_roles');
 
@@ -974,6 +1075,13 @@ export default class Client4 {
         );
     };
 
+    getSessionsDetailed = (userId: string) => {
+        return this.doFetch<SessionDetailed[]>(
+            `${this.getUserRoute(userId)}/sessions/detailed`,
+            {method: 'get'},
+        );
+    };
+
     revokeSession = (userId: string, sessionId: string) => {
         return this.doFetch<StatusOK>(
             `${this.getUserRoute(userId)}/sessions/revoke`,
@@ -988,6 +1096,13 @@ export default class Client4 {
         );
     };
 
+    revokeOtherSessions = (userId: string) => {
+        return this.doFetch<StatusOK>(
+            `${this.getUserRoute(userId)}/sessions/revoke/others`,
+            {method: 'post'},
+        );
+    };
+
     revokeSessionsForAllUsers = () => {
         return this.doFetch<StatusOK>(
             `${this.getUsersRoute()}/sessions/revoke/all`,
@@ -1113,10 +1228,22 @@ export default class Client4 {
         );
     }
 
-    authorizeOAuthApp = (responseType: string, clientId: string, redirectUri: string, state: string, scope: string) => {
+    authorizeOAuthApp = (responseType: string, clientId: string, redirectUri: string, state: string, scope: string, codeChallenge = '', codeChallengeMethod = '', nonce = '') => {
         return this.doFetch<void>(
             `${this.url}/oauth/authorize`,
-            {method: 'post', body: JSON.stringify({client_id: clientId, response_type: responseType, redirect_uri: redirectUri, state, scope})},
+            {
+                method: 'post',
+                body: JSON.stringify({
+                    client_id: clientId,
+                    response_type: responseType,
+                    redirect_uri: redirectUri,
+                    state,
+                    scope,
+                    code_challenge: codeChallenge,
+                    code_challenge_method: codeChallengeMethod,
+                    nonce,
+                }),
+            },
         );
     }
 
@@ -1207,6 +1334,29 @@ export default class Client4 {
         );
     }
 
+    archiveTeam = (teamId: string) => {
+        this.trackEvent('api', 'api_teams_archive');
+
+        return this.doFetch<TeamArchive>(
+            `${this.getTeamRoute(teamId)}/archive`,
+            {method: 'post'},
+        );
+    };
+
+    getTeamArchive = (teamId: string) => {
+        return this.doFetch<TeamArchive>(
+            `${this.getTeamRoute(teamId)}/archive`,
+            {method: 'get'},
+        );
+    };
+
+    restoreArchivedTeam = (teamId: string) => {
+        return this.doFetch<TeamArchive>(
+            `${this.getTeamRoute(teamId)}/archive/restore`,
+            {method: 'post'},
+        );
+    };
+
     archiveAllTeamsExcept = (teamId: string) => {
         return this.doFetch<StatusOK>(
             `${this.getTeamRoute(teamId)}/except`,
@@ -1540,6 +1690,50 @@ export default class Client4 {
         );
     };
 
+    createTeamTemplate = (template: Partial<TeamTemplate>) => {
+        return this.doFetch<TeamTemplate>(
+            this.getTeamTemplatesRoute(),
+            {method: 'post', body: JSON.stringify(template)},
+        );
+    };
+
+    getTeamTemplates = (page = 0, perPage = PER_PAGE_DEFAULT) => {
+        return this.doFetch<TeamTemplate[]>(
+            `${this.getTeamTemplatesRoute()}${buildQueryString({page, per_page: perPage})}`,
+            {method: 'get'},
+        );
+    };
+
+    getTeamTemplate = (templateId: string) => {
+        return this.doFetch<TeamTemplate>(
+            this.getTeamTemplateRoute(templateId),
+            {method: 'get'},
+        );
+    };
+
+    updateTeamTemplate = (template: TeamTemplate) => {
+        return this.doFetch<TeamTemplate>(
+            this.getTeamTemplateRoute(template.id),
+            {method: 'put', body: JSON.stringify(template)},
+        );
+    };
+
+    deleteTeamTemplate = (templateId: string) => {
+        return this.doFetch<StatusOK>(
+            this.getTeamTemplateRoute(templateId),
+            {method: 'delete'},
+        );
+    };
+
+    instantiateTeamTemplate = (templateId: string, instantiation: TeamTemplateInstantiation) => {
+        this.trackEvent('api', 'api_team_templates_instantiate');
+
+        return this.doFetch<TeamTemplateInstance>(
+            `${this.getTeamTemplateRoute(templateId)}/instantiate`,
+            {method: 'post', body: JSON.stringify(instantiation)},
+        );
+    };
+
     updateTeamMemberSchemeRoles = (teamId: string, userId: string, isSchemeUser: boolean, isSchemeAdmin: boolean) => {
         const body = {scheme_user: isSchemeUser, scheme_admin: isSchemeAdmin};
         return this.doFetch<StatusOK>(
@@ -1756,16 +1950,65 @@ export default class Client4 {
         );
     };
 
-    addToChannel = (userId: string, channelId: string, postRootId = '') => {
+    addToChannel = (userId: string, channelId: string, postRootId = '', expiresAt?: number) => {
         this.trackEvent('api', 'api_channels_add_member', {channel_id: channelId});
 
-        const member = {user_id: userId, channel_id: channelId, post_root_id: postRootId};
+        const member = {user_id: userId, channel_id: channelId, post_root_id: postRootId, expires_at: expiresAt};
         return this.doFetch<ChannelMembership>(
             `${this.getChannelMembersRoute(channelId)}`,
             {method: 'post', body: JSON.stringify(member)},
         );
     };
 
+    getChannelMemberExpiries = (channelId: string) => {
+        return this.doFetch<ChannelMemberExpiry[]>(
+            `${this.getChannelRoute(channelId)}/member_expiries`,
+            {method: 'get'},
+        );
+    };
+
+    setChannelMemberExpiry = (channelId: string, userId: string, expiresAt: number) => {
+        return this.doFetch<ChannelMemberExpiry>(
+            `${this.getChannelMemberRoute(channelId, userId)}/expiry`,
+            {method: 'put', body: JSON.stringify({expires_at: expiresAt})},
+        );
+    };
+
+    deleteChannelMemberExpiry = (channelId: string, userId: string) => {
+        return this.doFetch<StatusOK>(
+            `${this.getChannelMemberRoute(channelId, userId)}/expiry`,
+            {method: 'delete'},
+        );
+    };
+
+    requestToJoinChannel = (channelId: string, message = '') => {
+        return this.doFetch<ChannelJoinRequest>(
+            `${this.getChannelRoute(channelId)}/join_requests`,
+            {method: 'post', body: JSON.stringify({message})},
+        );
+    };
+
+    getChannelJoinRequests = (channelId: string, status: ChannelJoinRequestStatus | '' = '', page = 0, perPage = PER_PAGE_DEFAULT) => {
+        return this.doFetch<ChannelJoinRequest[]>(
+            `${this.getChannelRoute(channelId)}/join_requests${buildQueryString({status, page, per_page: perPage})}`,
+            {method: 'get'},
+        );
+    };
+
+    approveChannelJoinRequest = (channelId: string, requestId: string) => {
+        return this.doFetch<ChannelJoinRequest>(
+            `${this.getChannelRoute(channelId)}/join_requests/${requestId}/approve`,
+            {method: 'post'},
+        );
+    };
+
+    denyChannelJoinRequest = (channelId: string, requestId: string) => {
+        return this.doFetch<ChannelJoinRequest>(
+            `${this.getChannelRoute(channelId)}/join_requests/${requestId}/deny`,
+            {method: 'post'},
+        );
+    };
+
     removeFromChannel = (userId: string, channelId: string) => {
         this.trackEvent('api', 'api_channels_remove_member', {channel_id: channelId});
 
@@ -3047,6 +3290,27 @@ export default class Client4 {
         );
     };
 
+    validateConfig = (config: AdminConfig) => {
+        return this.doFetch<ConfigValidationReport>(
+            `${this.getBaseRoute()}/config/validate`,
+            {method: 'post', body: JSON.stringify(config)},
+        );
+    };
+
+    getConfigHistory = (page = 0, perPage = PER_PAGE_DEFAULT) => {
+        return this.doFetch<ConfigChange[]>(
+            `${this.getBaseRoute()}/config/history${buildQueryString({page, per_page: perPage})}`,
+            {method: 'get'},
+        );
+    };
+
+    rollbackConfig = (changeId: string) => {
+        return this.doFetch<AdminConfig>(
+            `${this.getBaseRoute()}/config/history/${changeId}/rollback`,
+            {method: 'post'},
+        );
+    };
+
     reloadConfig = () => {
         return this.doFetch<StatusOK>(
             `${this.getBaseRoute()}/config/reload`,
@@ -3387,6 +3651,41 @@ export default class Client4 {
         );
     };
 
+    getRoleSnapshots = (roleId: string, page = 0, perPage = PER_PAGE_DEFAULT) => {
+        return this.doFetch<RoleSnapshot[]>(
+            `${this.getRolesRoute()}/${roleId}/snapshots${buildQueryString({page, per_page: perPage})}`,
+            {method: 'get'},
+        );
+    };
+
+    revertRoleToSnapshot = (roleId: string, snapshotId: string) => {
+        return this.doFetch<Role>(
+            `${this.getRolesRoute()}/${roleId}/snapshots/${snapshotId}/revert`,
+            {method: 'post'},
+        );
+    };
+
+    getCustomAdminRoles = () => {
+        return this.doFetch<Role[]>(
+            `${this.getRolesRoute()}/custom_admin`,
+            {method: 'get'},
+        );
+    };
+
+    createCustomAdminRole = (customRole: CustomAdminRole) => {
+        return this.doFetch<Role>(
+            `${this.getRolesRoute()}/custom_admin`,
+            {method: 'post', body: JSON.stringify(customRole)},
+        );
+    };
+
+    deleteCustomAdminRole = (roleId: string) => {
+        return this.doFetch<StatusOK>(
+            `${this.getRolesRoute()}/custom_admin/${roleId}`,
+            {method: 'delete'},
+        );
+    };
+
     // Scheme Routes
 
     getSchemes = (scope = '', page = 0, perPage = PER_PAGE_DEFAULT) => {
@@ -4114,6 +4413,13 @@ export default class Client4 {
         );
     }
 
+    getMigrationsStatus = () => {
+        return this.doFetch<MigrationsStatus>(
+            `${this.getSystemRoute()}/schema/migrations/status`,
+            {method: 'get'},
+        );
+    }
+
     /**
      * @param query string query of graphQL, pass the json stringified version of the query
      * eg.  const query = JSON.stringify({query: `{license, config}`, operationName: 'queryForLicenseAndConfig'});
@@ -91,3 +91,22 @@ export type SchemaMigration = {
     version: number;
     name: string;
 };
+
+export type OnlineMigrationState = 'pending' | 'running' | 'completed' | 'failed';
+
+export type OnlineMigrationStatus = {
+    version: number;
+    name: string;
+    table: string;
+    state: OnlineMigrationState;
+    start_at?: number;
+    end_at?: number;
+    progress: number;
+    estimated_seconds_left: number;
+    error?: string;
+};
+
+export type MigrationsStatus = {
+    schema_version: number;
+    online_migrations: OnlineMigrationStatus[];
+};
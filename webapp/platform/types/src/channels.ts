@@ -115,6 +115,28 @@ export type ChannelMembership = {
     post_root_id?: string;
 };
 
+export type ChannelJoinRequestStatus = 'pending' | 'approved' | 'denied';
+
+export type ChannelJoinRequest = {
+    id: string;
+    channel_id: string;
+    user_id: string;
+    message: string;
+    status: ChannelJoinRequestStatus;
+    decider_id: string;
+    post_id: string;
+    create_at: number;
+    update_at: number;
+};
+
+export type ChannelMemberExpiry = {
+    channel_id: string;
+    user_id: string;
+    expires_at: number;
+    creator_id: string;
+    create_at: number;
+};
+
 export type ChannelUnread = {
     channel_id: string;
     user_id: string;
@@ -87,6 +87,7 @@ export type ClientConfig = {
     EnablePostUsernameOverride: string;
     EnablePreviewFeatures: string;
     EnablePreviewModeBanner: string;
+    EnablePasskeyLogin: string;
     EnablePublicLink: string;
     EnableReliableWebSockets: string;
     EnableSaml: string;
@@ -97,6 +98,7 @@ export type ClientConfig = {
     EnableSignUpWithGoogle: string;
     EnableSignUpWithOffice365: string;
     EnableSignUpWithOpenId: string;
+    EnableSignUpWithOIDC: string;
     EnableSVGs: string;
     EnableTesting: string;
     EnableThemeSelection: string;
@@ -106,6 +108,7 @@ export type ClientConfig = {
     EnableUserCreation: string;
     EnableUserDeactivation: string;
     EnableUserTypingMessages: string;
+    EnableWebAuthn: string;
     EnforceMultifactorAuthentication: string;
     ExperimentalClientSideCertCheck: string;
     ExperimentalClientSideCertEnable: string;
@@ -154,6 +157,8 @@ export type ClientConfig = {
     GitLabButtonColor: string;
     OpenIdButtonText: string;
     OpenIdButtonColor: string;
+    OIDCButtonText: string;
+    OIDCButtonColor: string;
     PasswordMinimumLength: string;
     PasswordRequireLowercase: string;
     PasswordRequireNumber: string;
@@ -283,6 +288,7 @@ export type ServiceSettings = {
     GoroutineHealthThreshold: number;
     GoogleDeveloperKey: string;
     EnableOAuthServiceProvider: boolean;
+    EnableOAuthServiceProviderOAuth21: boolean;
     EnableIncomingWebhooks: boolean;
     EnableOutgoingWebhooks: boolean;
     EnableCommands: boolean;
@@ -411,8 +417,12 @@ export type SqlSettings = {
     Trace: boolean;
     AtRestEncryptKey: string;
     QueryTimeout: number;
+    SearchQueryTimeout: number;
+    AnalyticsQueryTimeout: number;
+    SlowQueryThresholdMilliseconds: number;
     DisableDatabaseSearch: boolean;
     MigrationsStatementTimeoutSeconds: number;
+    PartitionsPrecreateMonths: number;
     ReplicaLagSettings: ReplicaLagSetting[];
 };
 
@@ -596,6 +606,38 @@ export type Office365Settings = {
     DirectoryId: string;
 };
 
+export type OIDCSettings = {
+    Enable: boolean;
+    Secret: string;
+    Id: string;
+    Scope: string;
+    DiscoveryEndpoint: string;
+    ButtonText: string;
+    ButtonColor: string;
+    UsernameClaim: string;
+    EmailClaim: string;
+    GroupsClaim: string;
+};
+
+export type WebAuthnSettings = {
+    Enable: boolean;
+    EnablePasskeyLogin: boolean;
+    RequireUserVerification: boolean;
+    MaxCredentialsPerUser: number;
+};
+
+export type IPAccessPolicy = {
+    Role: string;
+    AllowedCIDRs: string[];
+    DeniedCIDRs: string[];
+};
+
+export type IPAccessSettings = {
+    Enable: boolean;
+    Policies: IPAccessPolicy[];
+    EmergencyBypassToken: string;
+};
+
 export type LdapSettings = {
     Enable: boolean;
     EnableSync: boolean;
@@ -832,6 +874,7 @@ export type GuestAccountsSettings = {
     AllowEmailAccounts: boolean;
     EnforceMultifactorAuthentication: boolean;
     RestrictCreationToDomains: string;
+    DefaultExpirationDays: number;
 };
 
 export type ImageProxySettings = {
@@ -878,6 +921,9 @@ export type AdminConfig = {
     GoogleSettings: SSOSettings;
     Office365Settings: Office365Settings;
     OpenIdSettings: SSOSettings;
+    OIDCSettings: OIDCSettings;
+    WebAuthnSettings: WebAuthnSettings;
+    IPAccessSettings: IPAccessSettings;
     LdapSettings: LdapSettings;
     ComplianceSettings: ComplianceSettings;
     LocalizationSettings: LocalizationSettings;
@@ -917,6 +963,38 @@ export type EnvironmentConfig = {
     [P in keyof AdminConfig]: EnvironmentConfigSettings<AdminConfig[P]>;
 }
 
+export type ConfigChangeDiff = {
+    path: string;
+    old_value: unknown;
+    new_value: unknown;
+};
+
+export type ConfigChange = {
+    id: string;
+    user_id: string;
+    create_at: number;
+    rollback_to_id?: string;
+    diffs: ConfigChangeDiff[];
+};
+
+export type ConfigValidationError = {
+    setting?: string;
+    id: string;
+    message: string;
+    detail?: string;
+};
+
+export type ConfigValidationCheck = {
+    name: 'settings' | 'sso_site_url' | 'file_store' | 'smtp';
+    status: 'passed' | 'failed' | 'skipped';
+    errors: ConfigValidationError[];
+};
+
+export type ConfigValidationReport = {
+    valid: boolean;
+    checks: ConfigValidationCheck[];
+};
+
 export type WarnMetricStatus = {
     id: string;
     limit: number;
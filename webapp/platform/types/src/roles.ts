@@ -12,4 +12,23 @@ export type Role = {
     permissions: string[];
     scheme_managed: boolean;
     built_in: boolean;
+    team_ids?: string[];
+};
+
+export type RoleSnapshot = {
+    id: string;
+    role_id: string;
+    permissions: string[];
+    create_at: number;
+};
+
+export type AdminRoleCapability = 'user_management' | 'integrations' | 'playbooks';
+
+export type CustomAdminRole = {
+    name: string;
+    display_name: string;
+    description?: string;
+    capabilities: AdminRoleCapability[];
+    permissions?: string[];
+    team_ids?: string[];
 };
@@ -17,3 +17,20 @@ export type Session = {
     team_members: TeamMembership[];
     local: boolean;
 }
+
+export type SessionDetailed = {
+    id: string;
+    create_at: number;
+    expires_at: number;
+    last_activity_at: number;
+    type: string;
+    platform: string;
+    os: string;
+    browser: string;
+    device_type: string;
+    ip_address: string;
+    country: string;
+    is_mobile: boolean;
+    is_oauth: boolean;
+    is_current: boolean;
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+import {Channel, ChannelType} from './channels';
+import {Team, TeamType} from './teams';
+
+export type TeamTemplateChannel = {
+    name: string;
+    display_name: string;
+    type: ChannelType;
+    purpose: string;
+    header: string;
+};
+
+export type TeamTemplateCategory = {
+    display_name: string;
+    channels: string[];
+};
+
+export type TeamTemplatePlaybook = {
+    title: string;
+    definition: Record<string, unknown>;
+};
+
+export type TeamTemplateCommand = {
+    trigger: string;
+    display_name: string;
+    description: string;
+    url: string;
+    method: 'P' | 'G';
+    auto_complete: boolean;
+    auto_complete_desc: string;
+    auto_complete_hint: string;
+};
+
+export type TeamTemplateIncomingWebhook = {
+    channel: string;
+    display_name: string;
+    description: string;
+};
+
+export type TeamTemplateContent = {
+    channels: TeamTemplateChannel[];
+    categories: TeamTemplateCategory[];
+    playbooks: TeamTemplatePlaybook[];
+    commands: TeamTemplateCommand[];
+    incoming_webhooks: TeamTemplateIncomingWebhook[];
+};
+
+export type TeamTemplate = {
+    id: string;
+    display_name: string;
+    description: string;
+    creator_id: string;
+    content: TeamTemplateContent;
+    create_at: number;
+    update_at: number;
+    delete_at: number;
+};
+
+export type TeamTemplateInstantiation = {
+    name: string;
+    display_name: string;
+    type: TeamType;
+};
+
+export type TeamTemplateInstance = {
+    team: Team;
+    channels: Channel[];
+    playbook_ids: string[];
+};
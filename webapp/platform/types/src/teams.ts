@@ -41,6 +41,18 @@ export type Team = {
     policy_id?: string | null;
 };
 
+export type TeamArchiveStatus = 'archiving' | 'archived' | 'restoring';
+
+export type TeamArchive = {
+    team_id: string;
+    archiver_id: string;
+    status: TeamArchiveStatus;
+    job_id: string;
+    suspended_job_ids: string[];
+    archived_at: number;
+    update_at: number;
+};
+
 export type TeamsState = {
     currentTeamId: string;
     teams: Record<string, Team>;
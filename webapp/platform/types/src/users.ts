@@ -139,3 +139,53 @@ export type GetFilteredUsersStatsOpts = {
 export type AuthChangeResponse = {
     follow_link: string;
 };
+
+export type GuestSponsorship = {
+    user_id: string;
+    sponsor_id: string;
+    expires_at: number;
+    create_at: number;
+    update_at: number;
+};
+
+export type GuestSponsorshipPatch = {
+    sponsor_id?: string;
+    expires_at?: number;
+};
+
+export type GuestSponsorReport = {
+    sponsor_id: string;
+    guest_count: number;
+    next_expires_at: number;
+    non_expiring_guests: number;
+};
+
+export type OffboardingFailure = {
+    id: string;
+    error: string;
+};
+
+export type OffboardingStepResult = {
+    step: 'reassign_integrations' | 'reassign_playbook_runs' | 'transfer_private_channels' | 'remove_from_channels';
+    status: 'completed' | 'skipped' | 'failed';
+    ids: string[];
+    failures: OffboardingFailure[];
+};
+
+export type OffboardingReport = {
+    user_id: string;
+    successor_id: string;
+    job_id: string;
+    job_status: string;
+    create_at: number;
+    steps: OffboardingStepResult[];
+};
+
+export type SupportAccessConsent = {
+    id: string;
+    user_id: string;
+    admin_id: string;
+    create_at: number;
+    expires_at: number;
+    revoked_at: number;
+};
@@ -11,7 +11,7 @@ import {makeModalDefinition as makeUpdateRunNameModalDefinition} from 'src/compo
 import {makeModalDefinition as makeUpdateRunChannelModalDefinition} from 'src/components/modals/run_update_channel';
 import {makeModalDefinition as makePlaybookRunModalDefinition} from 'src/components/modals/run_playbook_modal';
 import {PlaybookRun} from 'src/types/playbook_run';
-import {canIPostUpdateForRun, selectToggleRHS} from 'src/selectors';
+import {canIPostUpdateForRun, selectToggleRHS, tourState} from 'src/selectors';
 import {BackstageRHSSection, BackstageRHSViewMode} from 'src/types/backstage_rhs';
 import {
     CLOSE_BACKSTAGE_RHS,
@@ -42,11 +42,13 @@ import {
     RECEIVED_PLAYBOOK_RUNS,
     RECEIVED_TEAM_PLAYBOOK_RUNS,
     RECEIVED_TOGGLE_RHS_ACTION,
+    RECEIVED_TOUR_STATE,
     REMOVED_FROM_CHANNEL,
     ReceivedGlobalSettings,
     ReceivedPlaybookRuns,
     ReceivedTeamPlaybookRuns,
     ReceivedToggleRHSAction,
+    ReceivedTourState,
     RemovedFromChannel,
     SET_ALL_CHECKLISTS_COLLAPSED_STATE,
     SET_CHECKLIST_COLLAPSED_STATE,
@@ -74,8 +76,9 @@ import {
     ShowPostMenuModal,
     ShowRunActionsModal,
 } from 'src/types/actions';
-import {clientExecuteCommand} from 'src/client';
+import {clientExecuteCommand, updateTourStep} from 'src/client';
 import {GlobalSettings} from 'src/types/settings';
+import {TourState} from 'src/types/tours';
 import {ChecklistItemsFilter, TaskAction as TaskActionType} from 'src/types/playbook';
 import {modals} from 'src/webapp_globals';
 import {makeModalDefinition as makeUpdateRunStatusModalDefinition} from 'src/components/modals/update_run_status_modal';
@@ -191,6 +194,15 @@ export function finishRun(teamId: string, playbookRunId: string) {
     };
 }
 
+export function setTourStep(userId: string, category: string, step: number) {
+    return async (dispatch: Dispatch, getState: GetStateFunc) => {
+        // Move the tour forward right away, the server echoes back the whole persisted state.
+        dispatch(actionSetTourState({...tourState(getState()), [category]: step}));
+
+        dispatch(actionSetTourState(await updateTourStep(userId, category, step)));
+    };
+}
+
 export function addToTimeline(postId: string) {
     return async (dispatch: Dispatch, getState: GetStateFunc) => {
         const currentTeamId = getCurrentTeamId(getState());
@@ -282,6 +294,11 @@ export const actionSetGlobalSettings = (settings: GlobalSettings): ReceivedGloba
     settings,
 });
 
+export const actionSetTourState = (state: TourState): ReceivedTourState => ({
+    type: RECEIVED_TOUR_STATE,
+    tourState: state,
+});
+
 export const showPostMenuModal = (): ShowPostMenuModal => ({
     type: SHOW_POST_MENU_MODAL,
 });
@@ -43,6 +43,7 @@ import {EmptyPlaybookStats, PlaybookStats, SiteStats} from 'src/types/stats';
 
 import {pluginId} from './manifest';
 import {GlobalSettings, globalSettingsSetDefaults} from './types/settings';
+import {TourState} from './types/tours';
 import {Category} from './types/category';
 import {InsightsResponse} from './types/insights';
 
@@ -534,6 +535,16 @@ export async function fetchGlobalSettings(): Promise<GlobalSettings> {
     return globalSettingsSetDefaults(data);
 }
 
+export async function fetchTourState(userId: string): Promise<TourState> {
+    const data = await doGet<TourState>(`${apiUrl}/users/${userId}/tours`);
+    return data || {};
+}
+
+export async function updateTourStep(userId: string, category: string, step: number): Promise<TourState> {
+    const data = await doPut<TourState>(`${apiUrl}/users/${userId}/tours/${category}`, JSON.stringify({step}));
+    return data || {};
+}
+
 export async function updateRetrospective(playbookRunID: string, updatedText: string, metrics: RunMetricData[]) {
     const data = await doPost(`${apiUrl}/runs/${playbookRunID}/retrospective`,
         JSON.stringify({
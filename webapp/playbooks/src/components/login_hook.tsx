@@ -3,10 +3,11 @@ import {useDispatch, useSelector} from 'react-redux';
 import {GlobalState} from '@mattermost/types/store';
 
 import {loadRolesIfNeeded} from 'mattermost-redux/actions/roles';
+import {getCurrentUserId} from 'mattermost-redux/selectors/entities/common';
 
 import {globalSettings} from 'src/selectors';
-import {actionSetGlobalSettings} from 'src/actions';
-import {fetchGlobalSettings, notifyConnect} from 'src/client';
+import {actionSetGlobalSettings, actionSetTourState} from 'src/actions';
+import {fetchGlobalSettings, fetchTourState, notifyConnect} from 'src/client';
 import {PlaybookRole} from 'src/types/permissions';
 
 // This component is meant to be registered as RootComponent.
@@ -15,6 +16,8 @@ const LoginHook = () => {
     const dispatch = useDispatch();
     const hasGlobalSettings = useSelector((state: GlobalState) => Boolean(globalSettings(state)));
     const fetchAndStoreSettings = async () => dispatch(actionSetGlobalSettings(await fetchGlobalSettings()));
+    const currentUserId = useSelector(getCurrentUserId);
+    const fetchAndStoreTourState = async () => dispatch(actionSetTourState(await fetchTourState(currentUserId)));
 
     useEffect(() => {
         // Ensure settings fetch
@@ -22,6 +25,9 @@ const LoginHook = () => {
             fetchAndStoreSettings();
         }
 
+        // Grab the onboarding tour state
+        fetchAndStoreTourState();
+
         // Grab roles
         dispatch(loadRolesIfNeeded([PlaybookRole.Member, PlaybookRole.Admin]));
 
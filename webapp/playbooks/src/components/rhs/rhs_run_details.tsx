@@ -66,7 +66,7 @@ const RHSRunDetails = (props: Props) => {
 
     const prevStatus = usePrevious(playbookRun?.current_status);
 
-    const {currentStep: runDetailsStep, setStep: setRunDetailsStep} = useTutorialStepper(TutorialTourCategories.RUN_DETAILS);
+    const {loaded: runDetailsTourLoaded, currentStep: runDetailsStep, setStep: setRunDetailsStep} = useTutorialStepper(TutorialTourCategories.RUN_DETAILS);
     const [showParticipants, setShowParticipants] = useState(false);
 
     useEffect(() => {
@@ -76,6 +76,10 @@ const RHSRunDetails = (props: Props) => {
     }, [playbookRun?.current_status]);
 
     useEffect(() => {
+        if (!runDetailsTourLoaded) {
+            return;
+        }
+
         let isRunDetailTour = false;
         const url = new URL(window.location.href);
         const searchParams = new URLSearchParams(url.searchParams);
@@ -84,13 +88,13 @@ const RHSRunDetails = (props: Props) => {
             searchParams.delete('openTakeATourDialog');
             browserHistory.replace({pathname: url.pathname, search: searchParams.toString()});
         }
-        if ((runDetailsStep === null || parseInt(runDetailsStep, 10) === FINISHED) && isRunDetailTour) {
+        if ((runDetailsStep === null || runDetailsStep === FINISHED) && isRunDetailTour) {
             dispatch(displayRhsRunDetailsTourDialog({
                 onConfirm: () => setRunDetailsStep(RunDetailsTutorialSteps.SidePanel),
                 onDismiss: () => setRunDetailsStep(SKIPPED),
             }));
         }
-    }, [runDetailsStep]);
+    }, [runDetailsTourLoaded, runDetailsStep]);
 
     const {ParticipateConfirmModal, showParticipateConfirm} = useParticipateInRun(playbookRun ?? undefined, 'channel_rhs');
     const addToast = useToaster().add;
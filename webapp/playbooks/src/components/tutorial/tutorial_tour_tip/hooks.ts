@@ -10,12 +10,10 @@ import {
 import {useSelector} from 'react-redux';
 import throttle from 'lodash/throttle';
 
-import {get, getInt} from 'mattermost-redux/selectors/entities/preferences';
-
-import {getCurrentUserId} from 'mattermost-redux/selectors/entities/common';
-
 import {GlobalState} from '@mattermost/types/store';
 
+import {tourState} from 'src/selectors';
+
 import {TutorialTourTipPunchout} from './backdrop';
 
 type PunchoutOffset = {
@@ -77,13 +75,15 @@ export function useMeasurePunchouts(
 }
 
 export const useShowTutorialStep = (stepToShow: number, category: string, defaultAutostart = true): boolean => {
-    const currentUserId = useSelector(getCurrentUserId);
     const step = useSelector<GlobalState, number | null>((state: GlobalState) => {
-        if (defaultAutostart) {
-            return getInt(state, category, currentUserId, 0);
+        const tours = tourState(state);
+
+        // Don't show any step until the tour state has been fetched from the server.
+        if (tours === null) {
+            return null;
         }
-        const value = get(state, category, currentUserId, null);
-        return value === null ? null : parseInt(value, 10);
+
+        return tours[category] ?? (defaultAutostart ? 0 : null);
     });
 
     return step === stepToShow;
@@ -5,14 +5,14 @@ import React, {useCallback, useEffect, useState} from 'react';
 
 import {useDispatch, useSelector} from 'react-redux';
 
-import {get, getInt} from 'mattermost-redux/selectors/entities/preferences';
 import {GlobalState} from '@mattermost/types/store';
 
-import {savePreferences as storeSavePreferences} from 'mattermost-redux/actions/preferences';
 import {getCurrentUserId} from 'mattermost-redux/selectors/entities/common';
 import {Client4} from 'mattermost-redux/client';
 
 import {FINISHED, SKIPPED, TTCategoriesMapToSteps} from 'src/components/tutorial/tours';
+import {setTourStep} from 'src/actions';
+import {tourState} from 'src/selectors';
 
 import {KeyCodes, isKeyPressed} from 'src/utils';
 
@@ -54,31 +54,20 @@ const useTutorialTourTipManager = ({
     const [show, setShow] = useState(false);
     const tourSteps = TTCategoriesMapToSteps[tutorialCategory];
 
-    // Function to save the tutorial step in redux store start here which needs to be modified
     const dispatch = useDispatch();
     const currentUserId = useSelector(getCurrentUserId);
-    const currentStep = useSelector((state: GlobalState) => getInt(state, tutorialCategory, currentUserId, 0));
-    const savePreferences = useCallback(
-        (userId: string, stepValue: string) => {
-            const preferences = [
-                {
-                    user_id: userId,
-                    category: tutorialCategory,
-                    name: userId,
-                    value: stepValue,
-                },
-            ];
-            dispatch(storeSavePreferences(currentUserId, preferences));
+    const currentStep = useSelector((state: GlobalState) => tourState(state)?.[tutorialCategory] ?? 0);
+    const saveStep = useCallback(
+        (stepValue: number) => {
+            dispatch(setTourStep(currentUserId, tutorialCategory, stepValue));
         },
-        [dispatch],
+        [dispatch, currentUserId, tutorialCategory],
     );
 
     const trackEvent = useCallback((category, event, props?) => {
         Client4.trackEvent(category, event, props);
     }, []);
 
-    // Function to save the tutorial step in redux store end here
-
     const handleEventPropagationAndDefault = (e: React.MouseEvent | KeyboardEvent) => {
         if (stopPropagation) {
             e.stopPropagation();
@@ -127,7 +116,7 @@ const useTutorialTourTipManager = ({
             stepValue = nextStep;
         }
         handleHide();
-        savePreferences(currentUserId, stepValue.toString());
+        saveStep(stepValue);
         if (onNextNavigateTo && nextStep === true && autoTour) {
             onNextNavigateTo();
         } else if (onPrevNavigateTo && nextStep === false && autoTour) {
@@ -195,26 +184,20 @@ export default useTutorialTourTipManager;
 
 export const useTutorialStepper = (category: string, telemetryTag?: string) => {
     const currentUserId = useSelector(getCurrentUserId);
-    const currentStep = useSelector((state: GlobalState) => get(state, category, currentUserId, null));
+    const state = useSelector(tourState);
+    const currentStep = state?.[category] ?? null;
     const dispatch = useDispatch();
 
     return {
+        loaded: state !== null,
         currentStep,
         setStep: (step: number) => {
-            const preferences = [
-                {
-                    user_id: currentUserId,
-                    category,
-                    name: currentUserId,
-                    value: step.toString(),
-                },
-            ];
             if (step === SKIPPED && telemetryTag) {
                 const tag = telemetryTag + '_skip';
                 Client4.trackEvent('tutorial', tag);
             }
 
-            dispatch(storeSavePreferences(currentUserId, preferences));
+            dispatch(setTourStep(currentUserId, category, step));
         },
     };
 };
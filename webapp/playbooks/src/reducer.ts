@@ -28,11 +28,13 @@ import {
     RECEIVED_PLAYBOOK_RUNS,
     RECEIVED_TEAM_PLAYBOOK_RUNS,
     RECEIVED_TOGGLE_RHS_ACTION,
+    RECEIVED_TOUR_STATE,
     REMOVED_FROM_CHANNEL,
     ReceivedGlobalSettings,
     ReceivedPlaybookRuns,
     ReceivedTeamPlaybookRuns,
     ReceivedToggleRHSAction,
+    ReceivedTourState,
     RemovedFromChannel,
     SET_ALL_CHECKLISTS_COLLAPSED_STATE,
     SET_CHECKLIST_COLLAPSED_STATE,
@@ -60,6 +62,7 @@ import {
     ShowRunActionsModal,
 } from 'src/types/actions';
 import {GlobalSettings} from 'src/types/settings';
+import {TourState} from 'src/types/tours';
 import {ChecklistItemsFilter} from 'src/types/playbook';
 
 function toggleRHSFunction(state = null, action: ReceivedToggleRHSAction) {
@@ -252,6 +255,18 @@ const globalSettings = (state: GlobalSettings | null = null, action: ReceivedGlo
     }
 };
 
+/**
+ * @returns the onboarding tour state of the current user, or null until it has been fetched from the server.
+ */
+const tourState = (state: TourState | null = null, action: ReceivedTourState) => {
+    switch (action.type) {
+    case RECEIVED_TOUR_STATE:
+        return action.tourState;
+    default:
+        return state;
+    }
+};
+
 const postMenuModalVisibility = (state = false, action: ShowPostMenuModal | HidePostMenuModal) => {
     switch (action.type) {
     case SHOW_POST_MENU_MODAL:
@@ -409,6 +424,7 @@ const reducer = combineReducers({
     myPlaybookRuns,
     myPlaybookRunsByTeam,
     globalSettings,
+    tourState,
     postMenuModalVisibility,
     channelActionsModalVisibility,
     runActionsModalVisibility,
@@ -24,6 +24,7 @@ import {pluginId} from 'src/manifest';
 import {PlaybookRunStatus, playbookRunIsActive} from 'src/types/playbook_run';
 import {findLastUpdated} from 'src/utils';
 import {GlobalSettings} from 'src/types/settings';
+import {TourState} from 'src/types/tours';
 import {
     ChecklistItem,
     ChecklistItemState,
@@ -57,6 +58,8 @@ export const clientId = (state: GlobalState): string => pluginState(state).clien
 
 export const globalSettings = (state: GlobalState): GlobalSettings | null => pluginState(state).globalSettings;
 
+export const tourState = (state: GlobalState): TourState | null => pluginState(state).tourState;
+
 /**
  * @returns runs indexed by playbookRunId->playbookRun
  */
@@ -7,6 +7,7 @@ import {PlaybookRun} from 'src/types/playbook_run';
 import {BackstageRHSSection, BackstageRHSViewMode} from 'src/types/backstage_rhs';
 import {pluginId} from 'src/manifest';
 import {GlobalSettings} from 'src/types/settings';
+import {TourState} from 'src/types/tours';
 import {ChecklistItemsFilter} from 'src/types/playbook';
 import {PresetTemplate} from 'src/components/templates/template_data';
 
@@ -22,6 +23,7 @@ export const RECEIVED_PLAYBOOK_RUNS = pluginId + '_received_playbook_runs';
 export const RECEIVED_TEAM_PLAYBOOK_RUNS = pluginId + '_received_team_playbook_run_channels';
 export const REMOVED_FROM_CHANNEL = pluginId + '_removed_from_playbook_run_channel';
 export const RECEIVED_GLOBAL_SETTINGS = pluginId + '_received_global_settings';
+export const RECEIVED_TOUR_STATE = pluginId + '_received_tour_state';
 export const SHOW_POST_MENU_MODAL = pluginId + '_show_post_menu_modal';
 export const HIDE_POST_MENU_MODAL = pluginId + '_hide_post_menu_modal';
 export const SHOW_CHANNEL_ACTIONS_MODAL = pluginId + '_show_channel_actions_modal';
@@ -113,6 +115,11 @@ export interface ReceivedGlobalSettings {
     settings: GlobalSettings;
 }
 
+export interface ReceivedTourState {
+    type: typeof RECEIVED_TOUR_STATE;
+    tourState: TourState;
+}
+
 export interface ShowPostMenuModal {
     type: typeof SHOW_POST_MENU_MODAL;
 }
@@ -0,0 +1,5 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// TourState maps an onboarding tour category to the last step of that tour the user has reached.
+export type TourState = Record<string, number>;